@@ -0,0 +1,248 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// DefaultColumnBatchSize is the number of rows in each batch returned by QueryColumns when no batch size is given.
+const DefaultColumnBatchSize = 1024
+
+// QueryColumns executes |query| like Query, and returns the schema of its result along with an iterator over the result
+// in batches of at most |batchSize| rows. The values of each column of a batch are stored together in a typed slice,
+// rather than in rows of interface{} values, for embedders that process results numerically. A |batchSize| of zero or
+// less uses DefaultColumnBatchSize. The iterator must be closed once the caller is done with it.
+func (e *Engine) QueryColumns(ctx *sql.Context, query string, batchSize int) (sql.Schema, *ColumnBatchIter, error) {
+	sch, iter, _, err := e.Query(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sch, NewColumnBatchIter(sch, iter, batchSize), nil
+}
+
+// ColumnBatch is a batch of rows of a query result, stored column-wise.
+type ColumnBatch struct {
+	// Len is the number of rows in the batch.
+	Len int
+	// Columns are the values of each column of the result schema, in order.
+	Columns []ColumnVector
+}
+
+// ColumnVector is the values of a single column of a ColumnBatch. Its concrete type depends on the type of the column:
+//   - *Vector[int64] for signed integers
+//   - *Vector[uint64] for unsigned integers
+//   - *Vector[float64] for FLOAT and DOUBLE
+//   - *Vector[*apd.Decimal] for DECIMAL
+//   - *Vector[string] for CHAR, VARCHAR and TEXT
+//   - *Vector[[]byte] for BINARY, VARBINARY and BLOB
+//   - *Vector[time.Time] for DATE, DATETIME and TIMESTAMP
+//   - *Vector[interface{}] for all other types, holding the same values as the rows returned by Query
+type ColumnVector interface {
+	// Len returns the number of values in the vector.
+	Len() int
+	// IsNull returns whether the value at |i| is NULL.
+	IsNull(i int) bool
+	// Value returns the value at |i|, or nil if it's NULL.
+	Value(i int) interface{}
+	// append appends |v|, a value of the column from a row of the result, to the vector.
+	append(ctx *sql.Context, v interface{}) error
+}
+
+// Vector is a ColumnVector whose values have the Go type T. The value in |Values| of a NULL is the zero value of T.
+type Vector[T any] struct {
+	Values []T
+	Nulls  NullBitmap
+	// convert converts a value of the column to T
+	convert func(ctx *sql.Context, v interface{}) (T, error)
+}
+
+var _ ColumnVector = (*Vector[int64])(nil)
+
+// Len implements the ColumnVector interface.
+func (v *Vector[T]) Len() int {
+	return len(v.Values)
+}
+
+// IsNull implements the ColumnVector interface.
+func (v *Vector[T]) IsNull(i int) bool {
+	return v.Nulls.IsNull(i)
+}
+
+// Value implements the ColumnVector interface.
+func (v *Vector[T]) Value(i int) interface{} {
+	if v.IsNull(i) {
+		return nil
+	}
+	return v.Values[i]
+}
+
+func (v *Vector[T]) append(ctx *sql.Context, val interface{}) error {
+	var t T
+	if val == nil {
+		v.Nulls.set(len(v.Values))
+	} else {
+		var err error
+		if t, err = v.convert(ctx, val); err != nil {
+			return err
+		}
+	}
+	v.Values = append(v.Values, t)
+	return nil
+}
+
+// NullBitmap records which values of a ColumnVector are NULL. The value at i is NULL if bit i%64 of the word at i/64
+// is set. Words past the end of the bitmap have no bits set.
+type NullBitmap []uint64
+
+// IsNull returns whether the value at |i| is NULL.
+func (b NullBitmap) IsNull(i int) bool {
+	w := i / 64
+	return w < len(b) && b[w]&(1<<(uint(i)%64)) != 0
+}
+
+func (b *NullBitmap) set(i int) {
+	w := i / 64
+	for len(*b) <= w {
+		*b = append(*b, 0)
+	}
+	(*b)[w] |= 1 << (uint(i) % 64)
+}
+
+// newColumnVector returns an empty ColumnVector for the values of a column of type |typ|, with room for |size| values.
+func newColumnVector(typ sql.Type, size int) ColumnVector {
+	qt := typ.Type()
+	switch {
+	case sqltypes.IsSigned(qt):
+		return newVector[int64](types.Int64, size)
+	case sqltypes.IsUnsigned(qt):
+		return newVector[uint64](types.Uint64, size)
+	case sqltypes.IsFloat(qt):
+		return newVector[float64](types.Float64, size)
+	}
+	switch qt {
+	case sqltypes.Decimal:
+		return newVector[*apd.Decimal](typ, size)
+	case sqltypes.Char, sqltypes.VarChar, sqltypes.Text:
+		return newVector[string](types.LongText, size)
+	case sqltypes.Binary, sqltypes.VarBinary, sqltypes.Blob:
+		return newVector[[]byte](types.LongBlob, size)
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		return newVector[time.Time](types.DatetimeMaxPrecision, size)
+	default:
+		return &Vector[interface{}]{
+			Values: make([]interface{}, 0, size),
+			convert: func(ctx *sql.Context, v interface{}) (interface{}, error) {
+				return v, nil
+			},
+		}
+	}
+}
+
+// newVector returns an empty Vector with room for |size| values, which converts the values that aren't already of type
+// T with |target|.
+func newVector[T any](target sql.Type, size int) *Vector[T] {
+	return &Vector[T]{
+		Values: make([]T, 0, size),
+		convert: func(ctx *sql.Context, v interface{}) (T, error) {
+			var zero T
+			if t, ok := v.(T); ok {
+				return t, nil
+			}
+			converted, _, err := target.Convert(ctx, v)
+			if err != nil {
+				return zero, err
+			}
+			// strings and binary strings are converted to each other as needed
+			switch c := converted.(type) {
+			case T:
+				return c, nil
+			case string:
+				if t, ok := any([]byte(c)).(T); ok {
+					return t, nil
+				}
+			case []byte:
+				if t, ok := any(string(c)).(T); ok {
+					return t, nil
+				}
+			}
+			return zero, fmt.Errorf("cannot convert value of type %T to %T", v, zero)
+		},
+	}
+}
+
+// ColumnBatchIter is an iterator over the result of a query in ColumnBatches.
+type ColumnBatchIter struct {
+	schema    sql.Schema
+	iter      sql.RowIter
+	batchSize int
+	done      bool
+}
+
+// NewColumnBatchIter returns a new ColumnBatchIter over the rows of |iter|, which have the schema |sch|, in batches of
+// at most |batchSize| rows. A |batchSize| of zero or less uses DefaultColumnBatchSize.
+func NewColumnBatchIter(sch sql.Schema, iter sql.RowIter, batchSize int) *ColumnBatchIter {
+	if batchSize <= 0 {
+		batchSize = DefaultColumnBatchSize
+	}
+	return &ColumnBatchIter{schema: sch, iter: iter, batchSize: batchSize}
+}
+
+// Next returns the next batch of the result, or io.EOF once there are no more rows. Every batch but the last has
+// exactly the batch size of the iterator, and each is newly allocated, so callers may keep them.
+func (i *ColumnBatchIter) Next(ctx *sql.Context) (*ColumnBatch, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+
+	batch := &ColumnBatch{Columns: make([]ColumnVector, len(i.schema))}
+	for c, col := range i.schema {
+		batch.Columns[c] = newColumnVector(col.Type, i.batchSize)
+	}
+	for batch.Len < i.batchSize {
+		row, err := i.iter.Next(ctx)
+		if err == io.EOF {
+			i.done = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for c, vec := range batch.Columns {
+			if err = vec.append(ctx, row[c]); err != nil {
+				return nil, err
+			}
+		}
+		batch.Len++
+	}
+
+	if batch.Len == 0 {
+		return nil, io.EOF
+	}
+	return batch, nil
+}
+
+// Close closes the iterator over the rows of the result.
+func (i *ColumnBatchIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filetable
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// CSVOptions configures how a CSV file is read.
+type CSVOptions struct {
+	// Delimiter separates the fields of a record. It defaults to a comma.
+	Delimiter rune
+	// NoHeader is set when the first record of the file holds data rather than the names of its columns. The columns
+	// are then named c1, c2 and so on.
+	NoHeader bool
+	// NullString is a field value that represents NULL, such as \N. Empty fields of columns that aren't text are
+	// always NULL.
+	NullString string
+	// Schema is the schema of the file's records, by position. It's inferred from the file when it isn't given.
+	Schema sql.Schema
+	// SampleRows is the number of records read to infer the schema. It defaults to 1000.
+	SampleRows int
+}
+
+// NewCSVTable returns a table named |name| over the CSV file at |path|.
+func NewCSVTable(name, path string, opts CSVOptions) (*Table, error) {
+	src := &csvSource{path: path, opts: opts}
+	schema := opts.Schema
+	if schema == nil {
+		var err error
+		if schema, err = src.inferSchema(name); err != nil {
+			return nil, err
+		}
+	} else {
+		schema = withSource(name, schema)
+	}
+	if len(schema) == 0 {
+		return nil, ErrEmptySchema.New(path)
+	}
+	return &Table{name: name, path: path, schema: schema, source: src}, nil
+}
+
+type csvSource struct {
+	path string
+	opts CSVOptions
+}
+
+func (s *csvSource) newReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	if s.opts.Delimiter != 0 {
+		reader.Comma = s.opts.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+	return reader
+}
+
+// inferSchema returns the schema of the file, inferred from its header and first records.
+func (s *csvSource) inferSchema(name string) (sql.Schema, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	reader := s.newReader(f)
+
+	var names []string
+	var kinds []inferredKind
+	if !s.opts.NoHeader {
+		header, err := reader.Read()
+		if err == io.EOF {
+			return nil, ErrEmptySchema.New(s.path)
+		} else if err != nil {
+			return nil, ErrMalformedFile.New(s.path, 0, err)
+		}
+		names = append(names, header...)
+		kinds = make([]inferredKind, len(names))
+	}
+
+	sampleRows := s.opts.SampleRows
+	if sampleRows <= 0 {
+		sampleRows = defaultSampleRows
+	}
+	for i := 0; i < sampleRows; i++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, ErrMalformedFile.New(s.path, i+1, err)
+		}
+		for j, field := range record {
+			if j >= len(names) {
+				if !s.opts.NoHeader {
+					// fields beyond the header are ignored
+					break
+				}
+				names = append(names, fmt.Sprintf("c%d", j+1))
+				kinds = append(kinds, kindNull)
+			}
+			if field == "" || (s.opts.NullString != "" && field == s.opts.NullString) {
+				continue
+			}
+			kinds[j] = kinds[j].merge(textKind(field))
+		}
+	}
+	return inferredSchema(name, names, kinds), nil
+}
+
+// rows implements the source interface.
+func (s *csvSource) rows(ctx *sql.Context, schema sql.Schema, columns []int) (sql.RowIter, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	iter := &csvRowIter{source: s, file: f, reader: s.newReader(f), schema: schema, columns: columns}
+	if !s.opts.NoHeader {
+		if _, err = iter.reader.Read(); err != nil && err != io.EOF {
+			f.Close()
+			return nil, ErrMalformedFile.New(s.path, 0, err)
+		}
+	}
+	return iter, nil
+}
+
+type csvRowIter struct {
+	source  *csvSource
+	file    *os.File
+	reader  *csv.Reader
+	schema  sql.Schema
+	columns []int
+	row     int
+}
+
+func (i *csvRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	record, err := i.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	i.row++
+	if err != nil {
+		return nil, ErrMalformedFile.New(i.source.path, i.row, err)
+	}
+
+	values := make([]interface{}, len(record))
+	for j, field := range record {
+		if j >= len(i.schema) {
+			break
+		}
+		if (i.source.opts.NullString != "" && field == i.source.opts.NullString) || (field == "" && !types.IsText(i.schema[j].Type)) {
+			continue
+		}
+		if types.IsBoolean(i.schema[j].Type) && (strings.EqualFold(field, "true") || strings.EqualFold(field, "false")) {
+			values[j] = strings.EqualFold(field, "true")
+		} else {
+			values[j] = field
+		}
+	}
+	return project(ctx, i.schema, i.columns, values)
+}
+
+func (i *csvRowIter) Close(*sql.Context) error {
+	return i.file.Close()
+}
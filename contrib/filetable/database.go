@@ -0,0 +1,82 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filetable
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Database is a read-only database of file tables.
+type Database struct {
+	name   string
+	mu     sync.RWMutex
+	tables map[string]*Table
+}
+
+var _ sql.Database = (*Database)(nil)
+
+// NewDatabase returns a new Database named |name|, with no tables.
+func NewDatabase(name string) *Database {
+	return &Database{name: name, tables: make(map[string]*Table)}
+}
+
+// Name implements the sql.Database interface.
+func (d *Database) Name() string {
+	return d.name
+}
+
+// AddTable adds |table| to the database, replacing any table of the same name.
+func (d *Database) AddTable(table *Table) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tables[strings.ToLower(table.Name())] = table
+}
+
+// DropTable removes the table named |name| from the database, and returns whether there was one.
+func (d *Database) DropTable(name string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	name = strings.ToLower(name)
+	_, ok := d.tables[name]
+	delete(d.tables, name)
+	return ok
+}
+
+// GetTableInsensitive implements the sql.Database interface.
+func (d *Database) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	table, ok := d.tables[strings.ToLower(tblName)]
+	if !ok {
+		return nil, false, nil
+	}
+	return table, true, nil
+}
+
+// GetTableNames implements the sql.Database interface.
+func (d *Database) GetTableNames(ctx *sql.Context) ([]string, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	names := make([]string, 0, len(d.tables))
+	for _, table := range d.tables {
+		names = append(names, table.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filetable_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/contrib/filetable"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func writeFile(t *testing.T, name, contents string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+type engineHarness struct {
+	engine *sqle.Engine
+	ctx    *sql.Context
+}
+
+// newEngine returns an engine over a memory database named "mydb", with a table of owners, and |files|.
+func newEngine(t *testing.T, files *filetable.Database) *engineHarness {
+	pro := memory.NewDBProvider(memory.NewDatabase("mydb"), files)
+	sess := memory.NewSession(sql.NewBaseSession(), pro)
+	sess.SetCurrentDatabase("mydb")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(sqle.NewProcessList()))
+	h := &engineHarness{engine: sqle.NewDefault(pro), ctx: ctx}
+	h.query(t, "create table owners (id int primary key, name varchar(10))")
+	h.query(t, "insert into owners values (1, 'ann'), (2, 'bob')")
+	return h
+}
+
+func (h *engineHarness) query(t *testing.T, q string) []sql.Row {
+	_, iter, _, err := h.engine.Query(h.ctx, q)
+	require.NoError(t, err, q)
+	rows, err := sql.RowIterToRows(h.ctx, iter)
+	require.NoError(t, err, q)
+	return rows
+}
+
+func TestCSVTable(t *testing.T) {
+	require := require.New(t)
+	path := writeFile(t, "pets.csv", "id,name,owner,weight,born,vaccinated\n"+
+		"1,rex,1,12.5,2019-03-04,true\n"+
+		"2,tom,2,4,2020-11-30,false\n"+
+		"3,\"fish, blue\",,0.1,,\n"+
+		"4,\\N,1,30,2018-01-01 10:00:00,true\n")
+
+	table, err := filetable.NewCSVTable("pets", path, filetable.CSVOptions{NullString: `\N`})
+	require.NoError(err)
+	ctx := sql.NewEmptyContext()
+	schema := table.Schema(ctx)
+	require.Equal([]string{"id", "name", "owner", "weight", "born", "vaccinated"}, columnNames(schema))
+	require.Equal(types.Int64, schema[0].Type)
+	require.Equal(types.LongText, schema[1].Type)
+	require.Equal(types.Float64, schema[3].Type)
+	require.Equal(types.DatetimeMaxPrecision, schema[4].Type)
+	require.Equal(types.Boolean, schema[5].Type)
+	require.Equal("pets", schema[0].Source)
+
+	files := filetable.NewDatabase("files")
+	files.AddTable(table)
+	h := newEngine(t, files)
+	require.Equal([]sql.Row{
+		{int64(1), "rex", 12.5},
+		{int64(2), "tom", float64(4)},
+		{int64(3), "fish, blue", 0.1},
+		{int64(4), nil, float64(30)},
+	}, h.query(t, "select id, name, weight from files.pets order by id"))
+	require.Equal([]sql.Row{{int64(3)}}, h.query(t, "select id from files.pets where owner is null and born is null"))
+	require.Equal([]sql.Row{{"ann", int64(2)}, {"bob", int64(1)}},
+		h.query(t, "select o.name, count(*) from files.pets p join owners o on p.owner = o.id group by o.name order by o.name"))
+	require.Equal([]sql.Row{{"files", "pets", "file " + path}},
+		h.query(t, "select table_schema, table_name, table_comment from information_schema.tables where table_schema = 'files'"))
+}
+
+func TestCSVTableOptions(t *testing.T) {
+	require := require.New(t)
+	path := writeFile(t, "points.tsv", "1\ta\n2\tb\t\n")
+
+	table, err := filetable.NewCSVTable("points", path, filetable.CSVOptions{Delimiter: '\t', NoHeader: true})
+	require.NoError(err)
+	require.Equal([]string{"c1", "c2", "c3"}, columnNames(table.Schema(sql.NewEmptyContext())))
+
+	table, err = filetable.NewCSVTable("points", path, filetable.CSVOptions{
+		Delimiter: '\t',
+		NoHeader:  true,
+		Schema: sql.Schema{
+			{Name: "x", Type: types.Int32},
+			{Name: "label", Type: types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_Default)},
+		},
+	})
+	require.NoError(err)
+	files := filetable.NewDatabase("files")
+	files.AddTable(table)
+	h := newEngine(t, files)
+	require.Equal([]sql.Row{{int32(2), "b"}, {int32(1), "a"}}, h.query(t, "select x, label from files.points order by x desc"))
+
+	_, err = filetable.NewCSVTable("empty", writeFile(t, "empty.csv", ""), filetable.CSVOptions{})
+	require.True(filetable.ErrEmptySchema.Is(err))
+}
+
+func TestJSONTable(t *testing.T) {
+	require := require.New(t)
+	path := writeFile(t, "events.json", `{"id": 1, "kind": "click", "at": "2024-01-02 03:04:05", "meta": {"x": 1}}
+{"id": 2, "Kind": "view", "ratio": 0.5, "meta": [1, 2]}
+{"kind": null, "id": 3, "ratio": 2, "at": "2024-02-03"}
+`)
+
+	table, err := filetable.NewJSONTable("events", path, filetable.JSONOptions{})
+	require.NoError(err)
+	schema := table.Schema(sql.NewEmptyContext())
+	require.Equal([]string{"id", "kind", "at", "meta", "ratio"}, columnNames(schema))
+	require.Equal(types.Int64, schema[0].Type)
+	require.Equal(types.LongText, schema[1].Type)
+	require.Equal(types.DatetimeMaxPrecision, schema[2].Type)
+	require.Equal(types.JSON, schema[3].Type)
+	require.Equal(types.Float64, schema[4].Type)
+
+	files := filetable.NewDatabase("files")
+	files.AddTable(table)
+	h := newEngine(t, files)
+	require.Equal([]sql.Row{
+		{int64(1), "click", nil},
+		{int64(2), "view", 0.5},
+		{int64(3), nil, float64(2)},
+	}, h.query(t, "select id, kind, ratio from files.events order by id"))
+	require.Equal([]sql.Row{{int64(1), "1"}, {int64(2), "[1, 2]"}},
+		h.query(t, "select id, cast(meta->'$.x' as char) from files.events where meta is not null and json_type(meta) = 'OBJECT' union all select id, cast(meta as char) from files.events where json_type(meta) = 'ARRAY'"))
+	require.Equal([]sql.Row{{int64(1), time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, {int64(3), time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)}},
+		h.query(t, "select id, at from files.events where at is not null order by id"))
+
+	_, err = filetable.NewJSONTable("bad", writeFile(t, "bad.json", `{"id": 1} [2]`), filetable.JSONOptions{})
+	require.True(filetable.ErrMalformedFile.Is(err))
+}
+
+type parquetRow struct {
+	ID     int64     `parquet:"id"`
+	Name   string    `parquet:"name"`
+	Score  *float64  `parquet:"score,optional"`
+	Small  int8      `parquet:"small"`
+	At     time.Time `parquet:"at,timestamp(microsecond)"`
+	Day    int32     `parquet:"day,date"`
+	Tags   []string  `parquet:"tags"`
+	Blob   []byte    `parquet:"blob"`
+	Active bool      `parquet:"active"`
+}
+
+func writeParquet(t *testing.T, rows []parquetRow) string {
+	path := filepath.Join(t.TempDir(), "scores.parquet")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	// small row groups, so that rows are read from several of them
+	w := parquet.NewGenericWriter[parquetRow](f, parquet.MaxRowsPerRowGroup(2))
+	_, err = w.Write(rows)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+	return path
+}
+
+func TestParquetTable(t *testing.T) {
+	require := require.New(t)
+	score := func(f float64) *float64 { return &f }
+	at := time.Date(2024, 5, 6, 7, 8, 9, 123000, time.UTC)
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	var rows []parquetRow
+	for i := 1; i <= 5; i++ {
+		r := parquetRow{ID: int64(i), Name: fmt.Sprintf("n%d", i), Small: int8(-i), At: at.Add(time.Duration(i) * time.Hour), Day: int32(day.Unix() / (24 * 60 * 60)), Blob: []byte{byte(i)}, Active: i%2 == 0}
+		if i != 3 {
+			r.Score = score(float64(i) * 1.5)
+		}
+		rows = append(rows, r)
+	}
+	path := writeParquet(t, rows)
+
+	table, err := filetable.NewParquetTable("scores", path)
+	require.NoError(err)
+	ctx := sql.NewEmptyContext()
+	schema := table.Schema(ctx)
+	// the repeated column tags is skipped
+	require.Equal([]string{"id", "name", "score", "small", "at", "day", "blob", "active"}, columnNames(schema))
+	require.Equal(types.Int64, schema[0].Type)
+	require.Equal(types.LongText, schema[1].Type)
+	require.Equal(types.Float64, schema[2].Type)
+	require.True(schema[2].Nullable)
+	require.False(schema[0].Nullable)
+	require.Equal(types.Int8, schema[3].Type)
+	require.Equal(types.DatetimeMaxPrecision, schema[4].Type)
+	require.Equal(types.Date, schema[5].Type)
+	require.Equal(types.LongBlob, schema[6].Type)
+	require.Equal(types.Boolean, schema[7].Type)
+
+	// a projected table only reads the projected columns
+	projected, err := table.WithProjections(ctx, []string{"score", "id"})
+	require.NoError(err)
+	iter, err := projected.PartitionRows(ctx, nil)
+	require.NoError(err)
+	read, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{1.5, int64(1)}, {float64(3), int64(2)}, {nil, int64(3)}, {float64(6), int64(4)}, {7.5, int64(5)}}, read)
+
+	files := filetable.NewDatabase("files")
+	files.AddTable(table)
+	h := newEngine(t, files)
+	require.Equal([]sql.Row{
+		{int64(2), "n2", int8(-2), at.Add(2 * time.Hour), day, []byte{2}, int8(1)},
+		{int64(4), "n4", int8(-4), at.Add(4 * time.Hour), day, []byte{4}, int8(1)},
+	}, h.query(t, "select id, name, small, at, day, `blob`, active from files.scores where active order by id"))
+	require.Equal([]sql.Row{{int64(5)}}, h.query(t, "select count(*) from files.scores"))
+	require.Equal([]sql.Row{{"ann", 1.5}, {"bob", float64(3)}},
+		h.query(t, "select o.name, s.score from files.scores s join owners o on o.id = s.id order by o.id"))
+}
+
+func columnNames(schema sql.Schema) []string {
+	names := make([]string, len(schema))
+	for i, col := range schema {
+		names[i] = col.Name
+	}
+	return names
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filetable
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// defaultSampleRows is the number of rows read to infer the schema of a file when the options don't give one.
+const defaultSampleRows = 1000
+
+// inferredKind is the kind of values seen in a column while inferring its type. Kinds are ordered so that a column
+// holding values of two kinds has the greater of them, other than where merge says otherwise.
+type inferredKind byte
+
+const (
+	kindNull inferredKind = iota
+	kindBoolean
+	kindInteger
+	kindDouble
+	kindDate
+	kindDatetime
+	kindText
+	kindJSON
+)
+
+// merge returns the kind of a column holding values of kinds |k| and |other|.
+func (k inferredKind) merge(other inferredKind) inferredKind {
+	if k == other || other == kindNull {
+		return k
+	}
+	if k == kindNull {
+		return other
+	}
+	if k > other {
+		k, other = other, k
+	}
+	switch {
+	case other == kindJSON:
+		return kindJSON
+	case k == kindInteger && other == kindDouble, k == kindDate && other == kindDatetime:
+		return other
+	default:
+		return kindText
+	}
+}
+
+// sqlType returns the type of a column with values of kind |k|.
+func (k inferredKind) sqlType() sql.Type {
+	switch k {
+	case kindBoolean:
+		return types.Boolean
+	case kindInteger:
+		return types.Int64
+	case kindDouble:
+		return types.Float64
+	case kindDate:
+		return types.Date
+	case kindDatetime:
+		return types.DatetimeMaxPrecision
+	case kindJSON:
+		return types.JSON
+	default:
+		return types.LongText
+	}
+}
+
+var datetimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+}
+
+// textKind returns the kind of the text value |s|.
+func textKind(s string) inferredKind {
+	if strings.EqualFold(s, "true") || strings.EqualFold(s, "false") {
+		return kindBoolean
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return kindInteger
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return kindDouble
+	}
+	if _, err := time.Parse("2006-01-02", s); err == nil {
+		return kindDate
+	}
+	for _, layout := range datetimeLayouts {
+		if _, err := time.Parse(layout, s); err == nil {
+			return kindDatetime
+		}
+	}
+	return kindText
+}
+
+// jsonKind returns the kind of |v|, a value decoded from JSON with numbers decoded as json.Number.
+func jsonKind(v interface{}) inferredKind {
+	switch v := v.(type) {
+	case nil:
+		return kindNull
+	case bool:
+		return kindBoolean
+	case json.Number:
+		return textKind(v.String())
+	case string:
+		switch kind := textKind(v); kind {
+		case kindDate, kindDatetime:
+			return kind
+		default:
+			// numbers in strings stay strings
+			return kindText
+		}
+	default:
+		return kindJSON
+	}
+}
+
+// inferredSchema returns the schema of a table named |name| with the columns in |names|, whose values were seen to be
+// of the kinds in |kinds|. Every inferred column is nullable.
+func inferredSchema(name string, names []string, kinds []inferredKind) sql.Schema {
+	schema := make(sql.Schema, len(names))
+	for i, colName := range names {
+		schema[i] = &sql.Column{
+			Name:     colName,
+			Type:     kinds[i].sqlType(),
+			Nullable: true,
+			Source:   name,
+		}
+	}
+	return schema
+}
+
+// withSource returns a copy of |schema|, given in the options of a table named |name|, with its columns' source set.
+func withSource(name string, schema sql.Schema) sql.Schema {
+	ret := make(sql.Schema, len(schema))
+	for i, col := range schema {
+		c := col.Copy()
+		c.Source = name
+		ret[i] = c
+	}
+	return ret
+}
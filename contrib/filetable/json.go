@@ -0,0 +1,221 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filetable
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// JSONOptions configures how a newline-delimited JSON file is read.
+type JSONOptions struct {
+	// Schema is the schema of the file's objects, whose columns are read from the members of the same name. It's
+	// inferred from the file when it isn't given.
+	Schema sql.Schema
+	// SampleRows is the number of objects read to infer the schema. It defaults to 1000.
+	SampleRows int
+}
+
+// NewJSONTable returns a table named |name| over the file at |path|, which holds a JSON object for each row. The
+// objects are usually written one per line, but may be separated by any whitespace. The inferred columns of the table
+// are the members of the objects, in the order they're first seen.
+func NewJSONTable(name, path string, opts JSONOptions) (*Table, error) {
+	src := &jsonSource{path: path}
+	schema := opts.Schema
+	if schema == nil {
+		var err error
+		if schema, err = src.inferSchema(name, opts.SampleRows); err != nil {
+			return nil, err
+		}
+	} else {
+		schema = withSource(name, schema)
+	}
+	if len(schema) == 0 {
+		return nil, ErrEmptySchema.New(path)
+	}
+	return &Table{name: name, path: path, schema: schema, source: src}, nil
+}
+
+type jsonSource struct {
+	path string
+}
+
+// jsonMember is a member of a JSON object.
+type jsonMember struct {
+	name  string
+	value json.RawMessage
+}
+
+// readObject returns the members of the next object of |dec|, in order.
+func readObject(dec *json.Decoder) ([]jsonMember, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expected a JSON object, found %v", tok)
+	}
+	var members []jsonMember
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err = dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		members = append(members, jsonMember{name: tok.(string), value: value})
+	}
+	// the closing brace
+	if _, err = dec.Token(); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// decodeValue returns the value of |raw| decoded with numbers as json.Number.
+func decodeValue(raw json.RawMessage) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v interface{}
+	err := dec.Decode(&v)
+	return v, err
+}
+
+// inferSchema returns the schema of the file, inferred from its first objects.
+func (s *jsonSource) inferSchema(name string, sampleRows int) (sql.Schema, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(bufio.NewReader(f))
+
+	if sampleRows <= 0 {
+		sampleRows = defaultSampleRows
+	}
+	var names []string
+	var kinds []inferredKind
+	indexes := make(map[string]int)
+	for i := 0; i < sampleRows; i++ {
+		members, err := readObject(dec)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, ErrMalformedFile.New(s.path, i+1, err)
+		}
+		for _, m := range members {
+			idx, ok := indexes[strings.ToLower(m.name)]
+			if !ok {
+				idx = len(names)
+				indexes[strings.ToLower(m.name)] = idx
+				names = append(names, m.name)
+				kinds = append(kinds, kindNull)
+			}
+			v, err := decodeValue(m.value)
+			if err != nil {
+				return nil, ErrMalformedFile.New(s.path, i+1, err)
+			}
+			kinds[idx] = kinds[idx].merge(jsonKind(v))
+		}
+	}
+	return inferredSchema(name, names, kinds), nil
+}
+
+// rows implements the source interface.
+func (s *jsonSource) rows(ctx *sql.Context, schema sql.Schema, columns []int) (sql.RowIter, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int, len(schema))
+	for i, col := range schema {
+		indexes[strings.ToLower(col.Name)] = i
+	}
+	return &jsonRowIter{
+		path:    s.path,
+		file:    f,
+		dec:     json.NewDecoder(bufio.NewReader(f)),
+		schema:  schema,
+		columns: columns,
+		indexes: indexes,
+	}, nil
+}
+
+type jsonRowIter struct {
+	path    string
+	file    *os.File
+	dec     *json.Decoder
+	schema  sql.Schema
+	columns []int
+	indexes map[string]int
+	row     int
+}
+
+func (i *jsonRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	members, err := readObject(i.dec)
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	i.row++
+	if err != nil {
+		return nil, ErrMalformedFile.New(i.path, i.row, err)
+	}
+
+	values := make([]interface{}, len(i.schema))
+	for _, m := range members {
+		idx, ok := i.indexes[strings.ToLower(m.name)]
+		if !ok {
+			continue
+		}
+		if values[idx], err = jsonColumnValue(i.schema[idx].Type, m.value); err != nil {
+			return nil, ErrMalformedFile.New(i.path, i.row, err)
+		}
+	}
+	return project(ctx, i.schema, i.columns, values)
+}
+
+func (i *jsonRowIter) Close(*sql.Context) error {
+	return i.file.Close()
+}
+
+// jsonColumnValue returns the value of a column of type |typ| for the JSON value |raw|, to be converted to |typ|.
+func jsonColumnValue(typ sql.Type, raw json.RawMessage) (interface{}, error) {
+	v, err := decodeValue(raw)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	if types.IsJSON(typ) {
+		return []byte(raw), nil
+	}
+	switch v := v.(type) {
+	case string, bool:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		// objects and arrays are kept as their JSON text
+		return string(raw), nil
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filetable
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/deprecated"
+	"github.com/parquet-go/parquet-go/format"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// NewParquetTable returns a table named |name| over the Parquet file at |path|. The columns of the table are the
+// top-level columns of the file that aren't repeated, with types mapped from their physical and logical types.
+// Queries of the table only read the columns they use.
+func NewParquetTable(name, path string) (*Table, error) {
+	f, pf, err := openParquet(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	src := &parquetSource{path: path}
+	var schema sql.Schema
+	for leaf, columnPath := range pf.Schema().Columns() {
+		if len(columnPath) != 1 {
+			continue
+		}
+		field, ok := pf.Schema().Lookup(columnPath...)
+		if !ok || field.Node.Repeated() {
+			continue
+		}
+		typ, convert := parquetColumnType(field.Node.Type())
+		schema = append(schema, &sql.Column{
+			Name:     columnPath[0],
+			Type:     typ,
+			Nullable: field.Node.Optional(),
+			Source:   name,
+		})
+		src.leaves = append(src.leaves, leaf)
+		src.converters = append(src.converters, convert)
+	}
+	if len(schema) == 0 {
+		return nil, ErrEmptySchema.New(path)
+	}
+	return &Table{name: name, path: path, schema: schema, source: src}, nil
+}
+
+func openParquet(path string) (*os.File, *parquet.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	pf, err := parquet.OpenFile(f, stat.Size(), parquet.SkipBloomFilters(true))
+	if err != nil {
+		f.Close()
+		return nil, nil, ErrMalformedFile.New(path, 0, err)
+	}
+	return f, pf, nil
+}
+
+// valueConverter converts a non-null value of a Parquet column to a value of the column's SQL type.
+type valueConverter func(v parquet.Value) (interface{}, error)
+
+// parquetColumnType returns the SQL type of a Parquet column of type |typ|, and the converter of its values.
+func parquetColumnType(typ parquet.Type) (sql.Type, valueConverter) {
+	logical := typ.LogicalType()
+	var converted deprecated.ConvertedType = -1
+	if c := typ.ConvertedType(); c != nil {
+		converted = *c
+	}
+
+	switch typ.Kind() {
+	case parquet.Boolean:
+		return types.Boolean, func(v parquet.Value) (interface{}, error) { return v.Boolean(), nil }
+	case parquet.Int32, parquet.Int64:
+		toInt := func(v parquet.Value) int64 {
+			if typ.Kind() == parquet.Int32 {
+				return int64(v.Int32())
+			}
+			return v.Int64()
+		}
+		if logical != nil {
+			switch lt := logical.Value.(type) {
+			case *format.IntType:
+				return intType(lt), func(v parquet.Value) (interface{}, error) {
+					if !lt.IsSigned {
+						if typ.Kind() == parquet.Int32 {
+							return uint64(v.Uint32()), nil
+						}
+						return v.Uint64(), nil
+					}
+					return toInt(v), nil
+				}
+			case *format.DateType:
+				return types.Date, func(v parquet.Value) (interface{}, error) {
+					return time.Unix(toInt(v)*24*60*60, 0).UTC(), nil
+				}
+			case *format.TimestampType:
+				return types.DatetimeMaxPrecision, func(v parquet.Value) (interface{}, error) {
+					return fromTimeUnit(lt.Unit, toInt(v)).UTC(), nil
+				}
+			case *format.TimeType:
+				return types.Time, func(v parquet.Value) (interface{}, error) {
+					d := fromTimeUnit(lt.Unit, toInt(v)).Sub(time.Unix(0, 0))
+					return fmt.Sprintf("%02d:%02d:%02d.%06d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60, d.Microseconds()%1_000_000), nil
+				}
+			case *format.DecimalType:
+				return decimalType(lt), func(v parquet.Value) (interface{}, error) {
+					return decimalString(big.NewInt(toInt(v)), lt.Scale), nil
+				}
+			}
+		}
+		if converted == deprecated.Date {
+			return types.Date, func(v parquet.Value) (interface{}, error) {
+				return time.Unix(toInt(v)*24*60*60, 0).UTC(), nil
+			}
+		}
+		if typ.Kind() == parquet.Int32 {
+			return types.Int32, func(v parquet.Value) (interface{}, error) { return v.Int32(), nil }
+		}
+		return types.Int64, func(v parquet.Value) (interface{}, error) { return v.Int64(), nil }
+	case parquet.Int96:
+		// legacy timestamps: nanoseconds of the day, followed by the Julian day
+		return types.DatetimeMaxPrecision, func(v parquet.Value) (interface{}, error) {
+			i96 := v.Int96()
+			nanos := int64(i96[1])<<32 | int64(i96[0])
+			days := int64(i96[2]) - 2440588
+			return time.Unix(days*24*60*60, nanos).UTC(), nil
+		}
+	case parquet.Float:
+		return types.Float32, func(v parquet.Value) (interface{}, error) { return v.Float(), nil }
+	case parquet.Double:
+		return types.Float64, func(v parquet.Value) (interface{}, error) { return v.Double(), nil }
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		if logical != nil {
+			switch lt := logical.Value.(type) {
+			case *format.StringType, *format.EnumType:
+				return types.LongText, stringValue
+			case *format.JsonType:
+				return types.JSON, bytesValue
+			case *format.UUIDType:
+				return types.MustCreateString(sqltypes.Char, 36, sql.Collation_Default), func(v parquet.Value) (interface{}, error) {
+					id, err := uuid.FromBytes(v.ByteArray())
+					if err != nil {
+						return nil, err
+					}
+					return id.String(), nil
+				}
+			case *format.DecimalType:
+				return decimalType(lt), func(v parquet.Value) (interface{}, error) {
+					return decimalString(twosComplement(v.ByteArray()), lt.Scale), nil
+				}
+			}
+		}
+		switch converted {
+		case deprecated.UTF8, deprecated.Enum:
+			return types.LongText, stringValue
+		case deprecated.Json:
+			return types.JSON, bytesValue
+		}
+		if typ.Kind() == parquet.FixedLenByteArray && typ.Length() <= 255 {
+			return types.MustCreateBinary(sqltypes.Binary, int64(typ.Length())), bytesValue
+		}
+		return types.LongBlob, bytesValue
+	}
+	return types.LongBlob, bytesValue
+}
+
+func stringValue(v parquet.Value) (interface{}, error) {
+	return string(v.ByteArray()), nil
+}
+
+func bytesValue(v parquet.Value) (interface{}, error) {
+	return append([]byte(nil), v.ByteArray()...), nil
+}
+
+// intType returns the SQL integer type for the Parquet integer type |t|.
+func intType(t *format.IntType) sql.Type {
+	switch {
+	case t.BitWidth <= 8 && t.IsSigned:
+		return types.Int8
+	case t.BitWidth <= 8:
+		return types.Uint8
+	case t.BitWidth <= 16 && t.IsSigned:
+		return types.Int16
+	case t.BitWidth <= 16:
+		return types.Uint16
+	case t.BitWidth <= 32 && t.IsSigned:
+		return types.Int32
+	case t.BitWidth <= 32:
+		return types.Uint32
+	case t.IsSigned:
+		return types.Int64
+	default:
+		return types.Uint64
+	}
+}
+
+// decimalType returns the SQL decimal type for the Parquet decimal type |t|, which is limited to the largest precision
+// the engine supports.
+func decimalType(t *format.DecimalType) sql.Type {
+	precision, scale := t.Precision, t.Scale
+	if precision > types.DecimalTypeMaxPrecision {
+		precision = types.DecimalTypeMaxPrecision
+	}
+	if scale > types.DecimalTypeMaxScale {
+		scale = types.DecimalTypeMaxScale
+	}
+	if scale > precision {
+		scale = precision
+	}
+	return types.MustCreateDecimalType(uint8(precision), uint8(scale))
+}
+
+// decimalString returns the text of the decimal with the unscaled value |unscaled| and scale |scale|.
+func decimalString(unscaled *big.Int, scale int32) string {
+	s := new(big.Int).Abs(unscaled).String()
+	if scale > 0 {
+		for len(s) <= int(scale) {
+			s = "0" + s
+		}
+		s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	}
+	if unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// twosComplement returns the integer of the big-endian two's complement bytes |b|.
+func twosComplement(b []byte) *big.Int {
+	i := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		i.Sub(i, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return i
+}
+
+// fromTimeUnit returns the time |v| units of |unit| after the Unix epoch.
+func fromTimeUnit(unit format.TimeUnit, v int64) time.Time {
+	switch unit.Value.(type) {
+	case *format.MilliSeconds:
+		return time.UnixMilli(v)
+	case *format.NanoSeconds:
+		return time.Unix(0, v)
+	default:
+		return time.UnixMicro(v)
+	}
+}
+
+type parquetSource struct {
+	path string
+	// leaves holds the index of the leaf column of the file for each column of the table, and converters the
+	// converters of their values
+	leaves     []int
+	converters []valueConverter
+}
+
+// rows implements the source interface. Only the column chunks of |columns| are read.
+func (s *parquetSource) rows(ctx *sql.Context, schema sql.Schema, columns []int) (sql.RowIter, error) {
+	f, pf, err := openParquet(s.path)
+	if err != nil {
+		return nil, err
+	}
+	return &parquetRowIter{source: s, file: f, rowGroups: pf.RowGroups(), schema: schema, columns: columns}, nil
+}
+
+type parquetRowIter struct {
+	source    *parquetSource
+	file      *os.File
+	rowGroups []parquet.RowGroup
+	schema    sql.Schema
+	columns   []int
+
+	// readers holds a reader for each projected column of the current row group, and remaining the number of rows
+	// left to read from it
+	readers   []*columnValueReader
+	remaining int64
+}
+
+func (i *parquetRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for i.remaining == 0 {
+		if err := i.closeReaders(); err != nil {
+			return nil, err
+		}
+		if len(i.rowGroups) == 0 {
+			return nil, io.EOF
+		}
+		rg := i.rowGroups[0]
+		i.rowGroups = i.rowGroups[1:]
+		chunks := rg.ColumnChunks()
+		for _, idx := range i.columns {
+			i.readers = append(i.readers, &columnValueReader{pages: chunks[i.source.leaves[idx]].Pages()})
+		}
+		i.remaining = rg.NumRows()
+	}
+	i.remaining--
+
+	row := make(sql.Row, len(i.columns))
+	for j, idx := range i.columns {
+		v, err := i.readers[j].next()
+		if err != nil {
+			return nil, ErrMalformedFile.New(i.source.path, 0, err)
+		}
+		if v.IsNull() {
+			continue
+		}
+		converted, err := i.source.converters[idx](v)
+		if err != nil {
+			return nil, err
+		}
+		if row[j], _, err = i.schema[idx].Type.Convert(ctx, converted); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+func (i *parquetRowIter) closeReaders() error {
+	var err error
+	for _, r := range i.readers {
+		if cerr := r.pages.Close(); err == nil {
+			err = cerr
+		}
+	}
+	i.readers = nil
+	return err
+}
+
+func (i *parquetRowIter) Close(*sql.Context) error {
+	err := i.closeReaders()
+	if cerr := i.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// columnValueReader reads the values of a column chunk one at a time, a page at a time.
+type columnValueReader struct {
+	pages  parquet.Pages
+	values parquet.ValueReader
+	buf    []parquet.Value
+	pos    int
+}
+
+func (r *columnValueReader) next() (parquet.Value, error) {
+	for r.pos == len(r.buf) {
+		if r.values == nil {
+			page, err := r.pages.ReadPage()
+			if err != nil {
+				if err == io.EOF {
+					return parquet.Value{}, io.ErrUnexpectedEOF
+				}
+				return parquet.Value{}, err
+			}
+			r.values = page.Values()
+		}
+		if r.buf == nil {
+			r.buf = make([]parquet.Value, 1024)
+		}
+		n, err := r.values.ReadValues(r.buf[:cap(r.buf)])
+		r.buf, r.pos = r.buf[:n], 0
+		if err == io.EOF {
+			r.values = nil
+		} else if err != nil {
+			return parquet.Value{}, err
+		}
+	}
+	v := r.buf[r.pos]
+	r.pos++
+	return v, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filetable provides read-only tables over local CSV, newline-delimited JSON and Parquet files, so that files
+// can be queried with SQL. The tables are collected in a Database, which can be served by any database provider, such
+// as the one of the memory package, alongside other databases:
+//
+//	files := filetable.NewDatabase("files")
+//	orders, err := filetable.NewCSVTable("orders", "/data/orders.csv", filetable.CSVOptions{})
+//	...
+//	files.AddTable(orders)
+//	provider := memory.NewDBProvider(memory.NewDatabase("mydb"), files)
+//
+// The schemas of CSV and JSON files are inferred from their first rows unless they're given, while those of Parquet
+// files are read from the files' metadata. The files are read again by every query of their tables.
+package filetable
+
+import (
+	"fmt"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrEmptySchema is returned when no columns can be found for a file.
+var ErrEmptySchema = errors.NewKind("could not find any columns in file %s")
+
+// ErrMalformedFile is returned when a file can't be read as the format of its table.
+var ErrMalformedFile = errors.NewKind("malformed file %s at row %d: %s")
+
+// source reads the rows of a file of one of the supported formats.
+type source interface {
+	// rows returns the rows of the file, with only the columns of |schema|, the table's schema, at |columns|.
+	rows(ctx *sql.Context, schema sql.Schema, columns []int) (sql.RowIter, error)
+}
+
+// Table is a read-only table over a file.
+type Table struct {
+	name   string
+	path   string
+	schema sql.Schema
+	source source
+
+	// projection holds the names of the projected columns, and columns their indexes in |schema|
+	projection []string
+	columns    []int
+}
+
+var _ sql.Table = (*Table)(nil)
+var _ sql.ProjectedTable = (*Table)(nil)
+var _ sql.CommentedTable = (*Table)(nil)
+var _ sql.PrimaryKeyTable = (*Table)(nil)
+
+// Name implements the sql.Table interface.
+func (t *Table) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *Table) String() string {
+	return t.name
+}
+
+// Path returns the path of the file the table reads.
+func (t *Table) Path() string {
+	return t.path
+}
+
+// Comment implements the sql.CommentedTable interface.
+func (t *Table) Comment() string {
+	return fmt.Sprintf("file %s", t.path)
+}
+
+// Schema implements the sql.Table interface.
+func (t *Table) Schema(ctx *sql.Context) sql.Schema {
+	if t.projection == nil {
+		return t.schema
+	}
+	schema := make(sql.Schema, len(t.columns))
+	for i, idx := range t.columns {
+		schema[i] = t.schema[idx]
+	}
+	return schema
+}
+
+// PrimaryKeySchema implements the sql.PrimaryKeyTable interface. Files have no primary key, but the full schema of a
+// projected table is needed to resolve its columns.
+func (t *Table) PrimaryKeySchema(ctx *sql.Context) sql.PrimaryKeySchema {
+	return sql.NewPrimaryKeySchema(t.schema)
+}
+
+// Collation implements the sql.Table interface.
+func (t *Table) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Projections implements the sql.ProjectedTable interface.
+func (t *Table) Projections() []string {
+	return t.projection
+}
+
+// WithProjections implements the sql.ProjectedTable interface. Parquet files only read the projected columns; the
+// other formats read whole rows and drop the columns that aren't projected.
+func (t *Table) WithProjections(ctx *sql.Context, colNames []string) (sql.Table, error) {
+	columns := make([]int, len(colNames))
+	for i, name := range colNames {
+		columns[i] = t.schema.IndexOfColName(name)
+		if columns[i] < 0 {
+			return nil, sql.ErrTableColumnNotFound.New(t.name, name)
+		}
+	}
+	nt := *t
+	nt.projection = colNames
+	nt.columns = columns
+	return &nt, nil
+}
+
+// Partitions implements the sql.Table interface. A file has a single partition.
+func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(partition{}), nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *Table) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	columns := t.columns
+	if t.projection == nil {
+		columns = make([]int, len(t.schema))
+		for i := range columns {
+			columns[i] = i
+		}
+	}
+	return t.source.rows(ctx, t.schema, columns)
+}
+
+type partition struct{}
+
+func (partition) Key() []byte {
+	return []byte("file")
+}
+
+// project returns the values of |values| at |columns|, converted to the types of the columns of |schema| there. A
+// value missing from |values| is NULL.
+func project(ctx *sql.Context, schema sql.Schema, columns []int, values []interface{}) (sql.Row, error) {
+	row := make(sql.Row, len(columns))
+	for i, idx := range columns {
+		if idx >= len(values) || values[idx] == nil {
+			continue
+		}
+		v, _, err := schema[idx].Type.Convert(ctx, values[idx])
+		if err != nil {
+			return nil, err
+		}
+		row[i] = v
+	}
+	return row, nil
+}
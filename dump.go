@@ -0,0 +1,523 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// defaultMaxInsertSize is the default length of the INSERT statements of a dump, which is the default
+// net_buffer_length of mysqldump.
+const defaultMaxInsertSize = 1046528
+
+// DumpOptions configures the output of Engine.Dump. The zero value dumps everything, like mysqldump with the
+// --databases, --routines, --events and --triggers options.
+type DumpOptions struct {
+	// NoData omits the rows of tables, so that only the definitions of objects are dumped.
+	NoData bool
+	// NoCreateInfo omits the definitions of databases, tables and views, so that only the rows of tables, and the
+	// triggers, routines and events that aren't skipped, are dumped.
+	NoCreateInfo bool
+	// SkipTriggers omits triggers.
+	SkipTriggers bool
+	// SkipRoutines omits stored procedures.
+	SkipRoutines bool
+	// SkipEvents omits events.
+	SkipEvents bool
+	// MaxInsertSize is the length in bytes after which the rows of a table continue in a new INSERT statement. Each
+	// statement holds at least one row. It defaults to 1046528, the default of mysqldump.
+	MaxInsertSize int
+}
+
+// Dump writes the SQL statements that recreate |databases| to |w|, in the format of mysqldump. Each database is
+// created if it doesn't exist, and its tables, views, triggers, stored procedures and events are dropped and created
+// again, with the rows of its tables inserted in batched INSERT statements. Views are first created as stand-ins
+// and defined after every other object, so that they may refer to each other in any order, and foreign key checks
+// are disabled while restoring, so that tables may be created and filled in any order.
+//
+// The statements that read the databases are run in the session of |ctx|, which should be in a transaction for a
+// consistent dump of databases that are being written to. The output can be restored with Engine.Restore, or with
+// the mysql client.
+func (e *Engine) Dump(ctx *sql.Context, w io.Writer, opts DumpOptions, databases ...string) error {
+	bw := bufio.NewWriter(w)
+	d := &dumper{engine: e, ctx: ctx, opts: opts, out: func(s string) error {
+		_, err := bw.WriteString(s)
+		return err
+	}}
+	if d.opts.MaxInsertSize <= 0 {
+		d.opts.MaxInsertSize = defaultMaxInsertSize
+	}
+	if err := d.dump(databases); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// dumper writes the dump of databases, as lines of comments and statements, to |out|.
+type dumper struct {
+	engine *Engine
+	ctx    *sql.Context
+	opts   DumpOptions
+	out    func(string) error
+	err    error
+
+	// db is the name of the database being dumped
+	db string
+}
+
+// printf writes a formatted line to the output. Once writing fails, nothing more is written and the error is kept in
+// |d.err|.
+func (d *dumper) printf(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = d.out(fmt.Sprintf(format, args...) + "\n")
+	}
+}
+
+// comment writes a comment block with the line |text|.
+func (d *dumper) comment(text string) {
+	d.printf("")
+	d.printf("--")
+	d.printf("-- %s", text)
+	d.printf("--")
+	d.printf("")
+}
+
+// query runs |query| and returns its rows and schema.
+func (d *dumper) query(query string) ([]sql.Row, sql.Schema, error) {
+	sch, iter, _, err := d.engine.Query(d.ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := sql.RowIterToRows(d.ctx, iter)
+	return rows, sch, err
+}
+
+// queryStrings runs |query| and returns the values of its column at |col|, as strings.
+func (d *dumper) queryStrings(query string, col int) ([]string, error) {
+	rows, _, err := d.query(query)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(rows))
+	for i, row := range rows {
+		values[i] = fmt.Sprint(row[col])
+	}
+	return values, nil
+}
+
+func (d *dumper) dump(databases []string) error {
+	version, err := d.queryStrings("SELECT @@version", 0)
+	if err != nil {
+		return err
+	}
+	d.printf("-- go-mysql-server dump")
+	d.printf("--")
+	d.printf("-- Server version\t%s", version[0])
+	d.printf("")
+	d.printf("/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;")
+	d.printf("/*!40101 SET @OLD_CHARACTER_SET_RESULTS=@@CHARACTER_SET_RESULTS */;")
+	d.printf("/*!40101 SET @OLD_COLLATION_CONNECTION=@@COLLATION_CONNECTION */;")
+	d.printf("/*!50503 SET NAMES utf8mb4 */;")
+	d.printf("/*!40103 SET @OLD_TIME_ZONE=@@TIME_ZONE */;")
+	d.printf("/*!40103 SET TIME_ZONE='+00:00' */;")
+	d.printf("/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */;")
+	d.printf("/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */;")
+	d.printf("/*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;")
+	d.printf("/*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;")
+
+	// values are dumped in UTC, as mysqldump does, so that TIMESTAMP columns restore to the same instants
+	timeZone, err := d.ctx.GetSessionVariable(d.ctx, "time_zone")
+	if err != nil {
+		return err
+	}
+	if err = d.ctx.SetSessionVariable(d.ctx, "time_zone", "+00:00"); err != nil {
+		return err
+	}
+	for _, db := range databases {
+		if err = d.dumpDatabase(db); err != nil {
+			break
+		}
+	}
+	if resetErr := d.ctx.SetSessionVariable(d.ctx, "time_zone", timeZone); err == nil {
+		err = resetErr
+	}
+	if err != nil {
+		return err
+	}
+
+	d.printf("/*!40103 SET TIME_ZONE=@OLD_TIME_ZONE */;")
+	d.printf("")
+	d.printf("/*!40101 SET SQL_MODE=@OLD_SQL_MODE */;")
+	d.printf("/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */;")
+	d.printf("/*!40014 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS */;")
+	d.printf("/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */;")
+	d.printf("/*!40101 SET CHARACTER_SET_RESULTS=@OLD_CHARACTER_SET_RESULTS */;")
+	d.printf("/*!40101 SET COLLATION_CONNECTION=@OLD_COLLATION_CONNECTION */;")
+	d.printf("/*!40111 SET SQL_NOTES=@OLD_SQL_NOTES */;")
+	d.printf("")
+	d.printf("-- Dump completed")
+	return d.err
+}
+
+func (d *dumper) dumpDatabase(name string) error {
+	db, err := d.engine.Analyzer.Catalog.Database(d.ctx, name)
+	if err != nil {
+		return err
+	}
+	d.db = db.Name()
+	qdb := quoteIdentifier(d.db)
+
+	d.comment(fmt.Sprintf("Current Database: %s", qdb))
+	if !d.opts.NoCreateInfo {
+		rows, _, err := d.query(fmt.Sprintf("SHOW CREATE DATABASE %s", qdb))
+		if err != nil {
+			return err
+		}
+		create := strings.Replace(rows[0][1].(string), "CREATE DATABASE ", "CREATE DATABASE /*!32312 IF NOT EXISTS*/ ", 1)
+		d.printf("%s;", create)
+		d.printf("")
+	}
+	d.printf("USE %s;", qdb)
+
+	rows, _, err := d.query(fmt.Sprintf("SHOW FULL TABLES FROM %s", qdb))
+	if err != nil {
+		return err
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(string) < rows[j][0].(string)
+	})
+	var views []string
+	triggers, err := d.triggersByTable()
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		table := row[0].(string)
+		if row[1] == "VIEW" {
+			views = append(views, table)
+			if err = d.dumpStandInView(table); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = d.dumpTable(table); err != nil {
+			return err
+		}
+		if !d.opts.NoData {
+			if err = d.dumpRows(table); err != nil {
+				return err
+			}
+		}
+		for _, trigger := range triggers[table] {
+			if err = d.dumpTrigger(trigger); err != nil {
+				return err
+			}
+		}
+	}
+	if !d.opts.SkipEvents {
+		if err = d.dumpEvents(); err != nil {
+			return err
+		}
+	}
+	if !d.opts.SkipRoutines {
+		if err = d.dumpRoutines(db); err != nil {
+			return err
+		}
+	}
+	for _, view := range views {
+		if err = d.dumpView(view); err != nil {
+			return err
+		}
+	}
+	return d.err
+}
+
+func (d *dumper) dumpTable(table string) error {
+	if d.opts.NoCreateInfo {
+		return nil
+	}
+	rows, _, err := d.query(fmt.Sprintf("SHOW CREATE TABLE %s", d.qualified(table)))
+	if err != nil {
+		return err
+	}
+	d.comment(fmt.Sprintf("Table structure for table %s", quoteIdentifier(table)))
+	d.printf("DROP TABLE IF EXISTS %s;", quoteIdentifier(table))
+	d.printf("/*!40101 SET @saved_cs_client     = @@character_set_client */;")
+	d.printf("/*!50503 SET character_set_client = utf8mb4 */;")
+	d.printf("%s;", rows[0][1])
+	d.printf("/*!40101 SET character_set_client = @saved_cs_client */;")
+	return d.err
+}
+
+// dumpRows writes the INSERT statements for the rows of |table|. Generated columns are left out, and the columns
+// are then listed in the statements.
+func (d *dumper) dumpRows(table string) error {
+	tbl, _, err := d.engine.Analyzer.Catalog.Table(d.ctx, d.db, table)
+	if err != nil {
+		return err
+	}
+	var columns []string
+	hasGenerated := false
+	for _, col := range tbl.Schema(d.ctx) {
+		if col.Generated != nil {
+			hasGenerated = true
+			continue
+		}
+		columns = append(columns, quoteIdentifier(col.Name))
+	}
+	selectColumns := "*"
+	insertPrefix := fmt.Sprintf("INSERT INTO %s VALUES ", quoteIdentifier(table))
+	if hasGenerated {
+		selectColumns = strings.Join(columns, ",")
+		insertPrefix = fmt.Sprintf("INSERT INTO %s (%s) VALUES ", quoteIdentifier(table), strings.Join(columns, ", "))
+	}
+
+	sch, iter, _, err := d.engine.Query(d.ctx, fmt.Sprintf("SELECT %s FROM %s", selectColumns, d.qualified(table)))
+	if err != nil {
+		return err
+	}
+	d.comment(fmt.Sprintf("Dumping data for table %s", quoteIdentifier(table)))
+	d.printf("LOCK TABLES %s WRITE;", quoteIdentifier(table))
+	d.printf("/*!40000 ALTER TABLE %s DISABLE KEYS */;", quoteIdentifier(table))
+
+	var stmt strings.Builder
+	for {
+		row, err := iter.Next(d.ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			iter.Close(d.ctx)
+			return err
+		}
+		values, err := rowLiteral(d.ctx, sch, row)
+		if err != nil {
+			iter.Close(d.ctx)
+			return err
+		}
+		if stmt.Len() > 0 && stmt.Len()+len(values)+2 > d.opts.MaxInsertSize {
+			d.printf("%s;", stmt.String())
+			stmt.Reset()
+		}
+		if stmt.Len() == 0 {
+			stmt.WriteString(insertPrefix)
+		} else {
+			stmt.WriteByte(',')
+		}
+		stmt.WriteString(values)
+	}
+	if err = iter.Close(d.ctx); err != nil {
+		return err
+	}
+	if stmt.Len() > 0 {
+		d.printf("%s;", stmt.String())
+	}
+
+	d.printf("/*!40000 ALTER TABLE %s ENABLE KEYS */;", quoteIdentifier(table))
+	d.printf("UNLOCK TABLES;")
+	return d.err
+}
+
+// dumpStandInView writes a view named |view| with the columns of the view, which stands in for it until the view
+// itself is created, after every object it may refer to.
+func (d *dumper) dumpStandInView(view string) error {
+	if d.opts.NoCreateInfo {
+		return nil
+	}
+	_, sch, err := d.query(fmt.Sprintf("SELECT * FROM %s LIMIT 0", d.qualified(view)))
+	if err != nil {
+		return err
+	}
+	columns := make([]string, len(sch))
+	for i, col := range sch {
+		columns[i] = fmt.Sprintf("1 AS %s", quoteIdentifier(col.Name))
+	}
+	d.comment(fmt.Sprintf("Temporary view structure for view %s", quoteIdentifier(view)))
+	d.printf("DROP TABLE IF EXISTS %s;", quoteIdentifier(view))
+	d.printf("/*!50001 DROP VIEW IF EXISTS %s*/;", quoteIdentifier(view))
+	d.printf("/*!50001 CREATE VIEW %s AS SELECT %s*/;", quoteIdentifier(view), strings.Join(columns, ", "))
+	return d.err
+}
+
+func (d *dumper) dumpView(view string) error {
+	if d.opts.NoCreateInfo {
+		return nil
+	}
+	rows, _, err := d.query(fmt.Sprintf("SHOW CREATE VIEW %s", d.qualified(view)))
+	if err != nil {
+		return err
+	}
+	d.comment(fmt.Sprintf("Final view structure for view %s", quoteIdentifier(view)))
+	d.printf("/*!50001 DROP VIEW IF EXISTS %s*/;", quoteIdentifier(view))
+	d.printf("%s;", rows[0][1])
+	return d.err
+}
+
+// dumpedTrigger is a trigger to dump after the rows of its table.
+type dumpedTrigger struct {
+	name    string
+	sqlMode string
+}
+
+// triggersByTable returns the triggers of the database by the name of their table, in the order they're run.
+func (d *dumper) triggersByTable() (map[string][]dumpedTrigger, error) {
+	if d.opts.SkipTriggers {
+		return nil, nil
+	}
+	rows, _, err := d.query(fmt.Sprintf("SHOW TRIGGERS FROM %s", quoteIdentifier(d.db)))
+	if err != nil {
+		return nil, err
+	}
+	triggers := make(map[string][]dumpedTrigger)
+	for _, row := range rows {
+		table := row[2].(string)
+		triggers[table] = append(triggers[table], dumpedTrigger{name: row[0].(string), sqlMode: row[6].(string)})
+	}
+	return triggers, nil
+}
+
+func (d *dumper) dumpTrigger(trigger dumpedTrigger) error {
+	rows, _, err := d.query(fmt.Sprintf("SHOW CREATE TRIGGER %s", d.qualified(trigger.name)))
+	if err != nil {
+		return err
+	}
+	d.printf("/*!50003 DROP TRIGGER IF EXISTS %s */;", quoteIdentifier(trigger.name))
+	d.compound(trigger.sqlMode, rows[0][2].(string))
+	return d.err
+}
+
+func (d *dumper) dumpEvents() error {
+	events, err := d.queryStrings(fmt.Sprintf("SHOW EVENTS FROM %s", quoteIdentifier(d.db)), 1)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+	sort.Strings(events)
+	d.comment(fmt.Sprintf("Dumping events for database '%s'", d.db))
+	for _, event := range events {
+		rows, _, err := d.query(fmt.Sprintf("SHOW CREATE EVENT %s", d.qualified(event)))
+		if err != nil {
+			return err
+		}
+		d.printf("/*!50106 DROP EVENT IF EXISTS %s */;", quoteIdentifier(event))
+		d.compound(rows[0][1].(string), rows[0][3].(string))
+	}
+	return d.err
+}
+
+func (d *dumper) dumpRoutines(db sql.Database) error {
+	var functions []sql.StoredFunctionDetails
+	if sfd, ok := db.(sql.StoredFunctionDatabase); ok {
+		var err error
+		if functions, err = sfd.GetStoredFunctions(d.ctx); err != nil {
+			return err
+		}
+	}
+	var procedures []sql.StoredProcedureDetails
+	if spd, ok := db.(sql.StoredProcedureDatabase); ok {
+		var err error
+		if procedures, err = spd.GetStoredProcedures(d.ctx); err != nil {
+			return err
+		}
+	}
+	if len(functions) == 0 && len(procedures) == 0 {
+		return nil
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+	sort.Slice(procedures, func(i, j int) bool {
+		return procedures[i].Name < procedures[j].Name
+	})
+	d.comment(fmt.Sprintf("Dumping routines for database '%s'", db.Name()))
+	for _, function := range functions {
+		d.printf("/*!50003 DROP FUNCTION IF EXISTS %s */;", quoteIdentifier(function.Name))
+		d.compound(function.SqlMode, function.CreateStatement)
+	}
+	for _, procedure := range procedures {
+		d.printf("/*!50003 DROP PROCEDURE IF EXISTS %s */;", quoteIdentifier(procedure.Name))
+		d.compound(procedure.SqlMode, procedure.CreateStatement)
+	}
+	return d.err
+}
+
+// compound writes the statement |create| that creates a trigger, event or stored routine, whose body may contain
+// several statements, under the SQL mode |sqlMode| it was defined with.
+func (d *dumper) compound(sqlMode, create string) {
+	d.printf("/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;")
+	d.printf("/*!50003 SET sql_mode              = '%s' */ ;", sqlMode)
+	d.printf("DELIMITER ;;")
+	d.printf("%s ;;", create)
+	d.printf("DELIMITER ;")
+	d.printf("/*!50003 SET sql_mode              = @saved_sql_mode */ ;")
+}
+
+// qualified returns the quoted name of the object |name| of the database being dumped, qualified by the database.
+func (d *dumper) qualified(name string) string {
+	return quoteIdentifier(d.db) + "." + quoteIdentifier(name)
+}
+
+// rowLiteral returns the parenthesized list of SQL literals for |row|, whose values are of the types of |sch|.
+func rowLiteral(ctx *sql.Context, sch sql.Schema, row sql.Row) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('(')
+	for i, v := range row {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeLiteral(ctx, &buf, sch[i].Type, v); err != nil {
+			return "", err
+		}
+	}
+	buf.WriteByte(')')
+	return buf.String(), nil
+}
+
+// writeLiteral writes the SQL literal for the value |v| of type |typ| to |buf|. Binary strings and spatial values are
+// written in hexadecimal, as with the --hex-blob option of mysqldump.
+func writeLiteral(ctx *sql.Context, buf *bytes.Buffer, typ sql.Type, v interface{}) error {
+	if v == nil {
+		buf.WriteString("NULL")
+		return nil
+	}
+	val, err := typ.SQL(ctx, nil, v)
+	if err != nil {
+		return err
+	}
+	if (types.IsBinaryType(typ) && !types.IsJSON(typ)) || types.IsGeometry(typ) {
+		raw := val.Raw()
+		if len(raw) == 0 {
+			buf.WriteString("''")
+			return nil
+		}
+		buf.WriteString("0x")
+		buf.WriteString(hex.EncodeToString(raw))
+		return nil
+	}
+	val.EncodeSQL(buf)
+	return nil
+}
+
+// quoteIdentifier returns |name| quoted with backticks.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -16,11 +16,13 @@ package sqle
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	querypb "github.com/dolthub/vitess/go/vt/proto/query"
@@ -63,6 +65,13 @@ type Config struct {
 	// IncludeRootAccount adds the root account (with no password) to the list of accounts, and also enables
 	// authentication.
 	IncludeRootAccount bool
+	// QueryMemoryLimit is the number of bytes that all running queries may buffer at once. A query that would exceed
+	// it fails with ER_OUT_OF_RESOURCES. A value of 0 means there's no limit. The memory of a single query is limited
+	// by the query_memory_limit system variable.
+	QueryMemoryLimit int64
+	// SessionHandoffTimeout is how long the transaction of a session state exported by Engine.ExportSessionState is
+	// held for a restore before it's rolled back. DefaultSessionHandoffTimeout is used when it's zero.
+	SessionHandoffTimeout time.Duration
 }
 
 // TemporaryUser is a user that will be added to the engine. This is for temporary use while the remaining features
@@ -85,6 +94,8 @@ type Engine struct {
 	ReadOnly          atomic.Bool
 	IsServerLocked    bool
 	Version           sql.AnalyzerVersion
+	preparedPlans     *preparedPlanCache
+	handoffs          *sessionHandoffs
 }
 
 var _ sql.StatementRunner = (*Engine)(nil)
@@ -131,10 +142,26 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		mu:                &sync.Mutex{},
 		EventScheduler:    nil,
 		Parser:            sql.GetParser(a.Overrides),
+		preparedPlans:     newPreparedPlanCache(),
+		handoffs:          newSessionHandoffs(cfg.SessionHandoffTimeout),
 	}
 	ret.ReadOnly.Store(cfg.IsReadOnly)
+	a.Catalog.RegisterFunction(emptyCtx, sql.Function1{
+		Name: "dump_database",
+		Fn: function.NewDumpDatabase(func(ctx *sql.Context, w io.Writer, database string) error {
+			return ret.Dump(ctx, w, DumpOptions{}, database)
+		}),
+	})
+	a.Catalog.BackgroundThreads = ret.BackgroundThreads
+	ret.MemoryManager.SetQueryMemoryLimit(cfg.QueryMemoryLimit)
 	a.Runner = ret
 	a.ExecBuilder.Runner = ret
+	if a.Catalog.SchemaChanges != nil {
+		// plans prepared before a schema change may refer to objects that no longer exist, or have changed
+		a.Catalog.SchemaChanges.Subscribe(sql.SchemaChangeListenerFunc(func(*sql.Context, sql.SchemaChange) {
+			ret.preparedPlans.evictAll()
+		}))
+	}
 	return ret
 }
 
@@ -156,6 +183,27 @@ func (e *Engine) AnalyzeQuery(
 	return e.Analyzer.Analyze(ctx, parsed, nil, qFlags)
 }
 
+// DescribePlan returns a machine-readable description of the plans of |query|, before and after analysis, for external
+// tools that render and compare query plans. The query isn't executed.
+func (e *Engine) DescribePlan(ctx *sql.Context, query string) (*plan.PlanDescription, error) {
+	binder := planbuilder.New(ctx, e.Analyzer.Catalog, e.EventScheduler)
+	parsed, _, _, qFlags, err := binder.Parse(query, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	logical := plan.DescribePlanNode(ctx, parsed)
+	analyzed, err := e.Analyzer.Analyze(ctx, parsed, nil, qFlags)
+	if err != nil {
+		return nil, err
+	}
+	return &plan.PlanDescription{
+		Version:  plan.PlanDescriptionVersion,
+		Query:    query,
+		Logical:  logical,
+		Physical: plan.DescribePlanNode(ctx, analyzed),
+	}, nil
+}
+
 // PrepareQuery returns a partially analyzed query
 func (e *Engine) PrepareQuery(
 	ctx *sql.Context,
@@ -186,6 +234,7 @@ func (e *Engine) PrepareParsedQuery(
 	}
 
 	ctx.Session.PrepareQuery(statementKey, stmt)
+	e.preparedPlans.evict(ctx.Session.ID(), statementKey)
 
 	return node, nil
 }
@@ -210,6 +259,8 @@ func clearWarnings(ctx *sql.Context, node sql.Node) {
 	case plan.ShowWarnings:
 		// ShowWarnings should not clear the warnings, but should still reset the warning count.
 		ctx.ClearWarningCount()
+	case *plan.GetDiagnostics:
+		// GET DIAGNOSTICS reads the diagnostics area, so it leaves it unchanged.
 	default:
 		ctx.ClearWarnings()
 	}
@@ -329,6 +380,7 @@ func bindingsToExprs(ctx *sql.Context, bindings map[string]*querypb.BindVariable
 // If parsed is non-nil, it will be used instead of parsing the query from text.
 func (e *Engine) QueryWithBindings(ctx *sql.Context, query string, parsed sqlparser.Statement, bindings map[string]sqlparser.Expr, qFlags *sql.QueryFlags) (sql.Schema, sql.RowIter, *sql.QueryFlags, error) {
 	sql.IncrementStatusVariable(ctx, "Questions", 1)
+	sql.IncrementStatusVariable(ctx, "Queries", 1)
 
 	query = sql.RemoveSpaceAndDelimiter(query, ';')
 
@@ -349,41 +401,81 @@ func (e *Engine) QueryWithBindings(ctx *sql.Context, query string, parsed sqlpar
 		return nil, nil, nil, err
 	}
 
-	// planbuilding can produce warnings, so we need to preserve them
-	numPrevWarnings := len(ctx.Session.Warnings())
-	bound, qFlags, err := e.bindQuery(ctx, query, parsed, bindings, binder, qFlags)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	newWarnings := ctx.Session.Warnings()[numPrevWarnings:]
-	clearWarnings(ctx, bound)
-	// restore new warnings (backwards because they are in reverse order)
-	for i := len(newWarnings) - 1; i >= 0; i-- {
-		ctx.Session.Warn(newWarnings[i])
+	// A prepared statement may reuse the plan of its previous execution if nothing it was planned for has changed
+	fingerprint, cacheable := preparedPlanFingerprint(ctx, bindings, qFlags)
+	var cached *preparedPlan
+	if cacheable {
+		cached, _ = e.preparedPlans.get(ctx, query, fingerprint)
 	}
+	var bound, analyzed sql.Node
+	if cached != nil {
+		bound, analyzed = cached.bound, cached.analyzed
+		qFlags = &sql.QueryFlags{Flags: cached.flags.Flags.Copy()}
+		clearWarnings(ctx, bound)
+		if err = e.sandboxCheck(ctx, bound); err != nil {
+			return nil, nil, nil, err
+		}
+	} else {
+		// planbuilding can produce warnings, so we need to preserve them
+		numPrevWarnings := len(ctx.Session.Warnings())
+		bound, qFlags, err = e.bindQuery(ctx, query, parsed, bindings, binder, qFlags)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		newWarnings := ctx.Session.Warnings()[numPrevWarnings:]
+		clearWarnings(ctx, bound)
+		// restore new warnings (backwards because they are in reverse order)
+		for i := len(newWarnings) - 1; i >= 0; i-- {
+			ctx.Session.Warn(newWarnings[i])
+		}
 
-	analyzed, err := e.analyzeNode(ctx, query, bound, qFlags)
-	if err != nil {
-		return nil, nil, nil, err
-	}
+		if err = e.sandboxCheck(ctx, bound); err != nil {
+			return nil, nil, nil, err
+		}
 
-	if plan.NodeRepresentsSelect(ctx, analyzed) {
-		sql.IncrementStatusVariable(ctx, "Com_select", 1)
-	}
+		analyzed, err = e.analyzeNode(ctx, query, bound, qFlags)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 
-	if bindCtx := binder.BindCtx(); bindCtx != nil {
-		if unused := bindCtx.UnusedBindings(); len(unused) > 0 {
-			return nil, nil, nil, fmt.Errorf("invalid arguments. expected: %d, found: %d", len(bindCtx.Bindings)-len(unused), len(bindCtx.Bindings))
+		if bindCtx := binder.BindCtx(); bindCtx != nil {
+			if unused := bindCtx.UnusedBindings(); len(unused) > 0 {
+				return nil, nil, nil, fmt.Errorf("invalid arguments. expected: %d, found: %d", len(bindCtx.Bindings)-len(unused), len(bindCtx.Bindings))
+			}
+		}
+
+		if cacheable && isCacheablePreparedPlan(ctx, analyzed) {
+			p := &preparedPlan{fingerprint: fingerprint, tx: ctx.GetTransaction(), bound: bound, analyzed: analyzed}
+			if qFlags != nil {
+				p.flags = sql.QueryFlags{Flags: qFlags.Flags.Copy()}
+			}
+			e.preparedPlans.put(ctx, query, p)
+		} else {
+			e.preparedPlans.invalidate(ctx, analyzed)
 		}
 	}
 
+	incrementStatementStatusVariables(ctx, bound, analyzed)
+
 	err = e.readOnlyCheck(analyzed)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
+	invalidateSharedResults := e.sharedResultInvalidation(ctx, analyzed)
+	if invalidateSharedResults != nil {
+		invalidateSharedResults()
+	}
+
+	trackQueryMemory(ctx)
+	ctx, stopTimeout := withMaxExecutionTime(ctx, analyzed)
+	// LAST_INSERT_ID(expr) only changes the insert ID reported for the statement that calls it
+	ctx.GetLastQueryInfo().LastInsertIdSet.Store(false)
 	iter, err := e.Analyzer.ExecBuilder.Build(ctx, analyzed, nil)
 	if err != nil {
+		if stopTimeout != nil {
+			stopTimeout()
+		}
 		err2 := clearAutocommitTransaction(ctx)
 		if err2 != nil {
 			return nil, nil, nil, errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
@@ -394,12 +486,18 @@ func (e *Engine) QueryWithBindings(ctx *sql.Context, query string, parsed sqlpar
 	var schema sql.Schema
 	iter, schema, err = rowexec.FinalizeIters(ctx, analyzed, qFlags, iter)
 	if err != nil {
+		if stopTimeout != nil {
+			stopTimeout()
+		}
 		clearAutocommitErr := clearAutocommitTransaction(ctx)
 		if clearAutocommitErr != nil {
 			return nil, nil, nil, errors.Wrap(err, "unable to clear autocommit transaction: "+clearAutocommitErr.Error())
 		}
 		return nil, nil, nil, err
 	}
+	iter = rowexec.AddStatementTimeout(ctx, iter, stopTimeout)
+	iter = withSharedResultInvalidation(iter, invalidateSharedResults)
+	iter = e.withSchemaChangeNotification(ctx, analyzed, iter)
 
 	if schema == nil {
 		schema = analyzed.Schema(ctx)
@@ -427,9 +525,24 @@ func (e *Engine) PrepQueryPlanForExecution(ctx *sql.Context, _ string, plan sql.
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	err = e.sandboxCheck(ctx, plan)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	e.preparedPlans.invalidate(ctx, plan)
+	invalidateSharedResults := e.sharedResultInvalidation(ctx, plan)
+	if invalidateSharedResults != nil {
+		invalidateSharedResults()
+	}
 
+	trackQueryMemory(ctx)
+	ctx, stopTimeout := withMaxExecutionTime(ctx, plan)
+	ctx.GetLastQueryInfo().LastInsertIdSet.Store(false)
 	iter, err := e.Analyzer.ExecBuilder.Build(ctx, plan, nil)
 	if err != nil {
+		if stopTimeout != nil {
+			stopTimeout()
+		}
 		err2 := clearAutocommitTransaction(ctx)
 		if err2 != nil {
 			return nil, nil, nil, errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
@@ -440,12 +553,18 @@ func (e *Engine) PrepQueryPlanForExecution(ctx *sql.Context, _ string, plan sql.
 	var schema sql.Schema
 	iter, schema, err = rowexec.FinalizeIters(ctx, plan, qFlags, iter)
 	if err != nil {
+		if stopTimeout != nil {
+			stopTimeout()
+		}
 		clearAutocommitErr := clearAutocommitTransaction(ctx)
 		if clearAutocommitErr != nil {
 			return nil, nil, nil, errors.Wrap(err, "unable to clear autocommit transaction: "+clearAutocommitErr.Error())
 		}
 		return nil, nil, nil, err
 	}
+	iter = rowexec.AddStatementTimeout(ctx, iter, stopTimeout)
+	iter = withSharedResultInvalidation(iter, invalidateSharedResults)
+	iter = e.withSchemaChangeNotification(ctx, plan, iter)
 
 	if schema == nil {
 		schema = plan.Schema(ctx)
@@ -482,6 +601,14 @@ func (e *Engine) BoundQueryPlan(ctx *sql.Context, query string, parsed sqlparser
 		return nil, err
 	}
 
+	if err = e.sandboxCheck(ctx, bound); err != nil {
+		err2 := clearAutocommitTransaction(ctx)
+		if err2 != nil {
+			return nil, errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
+		}
+		return nil, err
+	}
+
 	analyzed, err := e.analyzeNode(ctx, query, bound, qFlags)
 	if err != nil {
 		err2 := clearAutocommitTransaction(ctx)
@@ -606,24 +733,33 @@ func (e *Engine) bindExecuteQueryNode(ctx *sql.Context, query string, eq *plan.E
 	// TODO: overwrite the current binding if bindings are not empty???
 	tempBindings := make(map[string]sql.Expression)
 	for i, name := range eq.BindVars {
+		var t sql.Type
+		var val interface{}
 		if strings.HasPrefix(name.String(), "@") {
-			t, val, err := ctx.GetUserVariable(ctx, strings.TrimPrefix(name.String(), "@"))
+			var err error
+			t, val, err = ctx.GetUserVariable(ctx, strings.TrimPrefix(name.String(), "@"))
 			if err != nil {
-				return nil, nil
+				return nil, err
 			}
-			if t == nil {
-				t = types.Null
+		} else {
+			// Local variables of a stored procedure are passed in as stored procedure params by the interpreter
+			param := ctx.Session.GetStoredProcParam(name.String())
+			if param == nil {
+				return nil, sql.ErrUndeclaredVariable.New(name.String())
 			}
-			if val != nil {
-				val, _, err = t.Promote().Convert(ctx, val)
-				if err != nil {
-					return nil, nil
-				}
+			t, val = param.Type, param.Value
+		}
+		if t == nil {
+			t = types.Null
+		}
+		if val != nil {
+			var err error
+			val, _, err = t.Promote().Convert(ctx, val)
+			if err != nil {
+				return nil, err
 			}
-			tempBindings[fmt.Sprintf("v%d", i+1)] = expression.NewLiteral(val, t)
-		} else {
-			tempBindings[fmt.Sprintf("v%d", i)] = name
 		}
+		tempBindings[fmt.Sprintf("v%d", i+1)] = expression.NewLiteral(val, t)
 	}
 
 	if len(tempBindings) == 0 {
@@ -675,6 +811,10 @@ func clearAutocommitTransaction(ctx *sql.Context) error {
 func (e *Engine) CloseSession(connID uint32) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.preparedPlans.evictSession(connID)
+	if e.Analyzer.ExecBuilder.SharedResults != nil {
+		e.Analyzer.ExecBuilder.SharedResults.ClearSession(connID)
+	}
 }
 
 func (e *Engine) beginTransaction(ctx *sql.Context) error {
@@ -703,18 +843,113 @@ func (e *Engine) Close() error {
 	for _, p := range e.ProcessList.Processes() {
 		e.ProcessList.Kill(p.Connection)
 	}
-	return e.BackgroundThreads.Shutdown()
+	// transactions handed off by sessions that were never restored would otherwise keep their locks
+	handoffErr := e.handoffs.discardAll()
+	if err := e.BackgroundThreads.Shutdown(); err != nil {
+		return err
+	}
+	return handoffErr
 }
 
 func (e *Engine) WithBackgroundThreads(b *sql.BackgroundThreads) *Engine {
 	e.BackgroundThreads = b
+	e.Analyzer.Catalog.BackgroundThreads = b
 	return e
 }
 
+// RegisterUserDefinedFunction registers a scalar or aggregate function implemented in Go, which can then be called by
+// its name like a built-in function and is listed by SHOW FUNCTION STATUS. Its name must not be taken by a built-in or
+// another user-defined function.
+func (e *Engine) RegisterUserDefinedFunction(ctx *sql.Context, fn sql.UserDefinedFunction) error {
+	return e.Analyzer.Catalog.RegisterUserDefinedFunction(ctx, fn)
+}
+
+// DropUserDefinedFunction removes a function registered with RegisterUserDefinedFunction.
+func (e *Engine) DropUserDefinedFunction(ctx *sql.Context, name string) error {
+	return e.Analyzer.Catalog.DropUserDefinedFunction(ctx, name)
+}
+
+// RegisterUserDefinedTableFunction registers a table function implemented in Go, which can then be called in the FROM
+// clause of queries run by this engine.
+func (e *Engine) RegisterUserDefinedTableFunction(ctx *sql.Context, fn sql.UserDefinedTableFunction) error {
+	return e.Analyzer.Catalog.RegisterUserDefinedTableFunction(ctx, fn)
+}
+
+// DropUserDefinedTableFunction removes a table function registered with RegisterUserDefinedTableFunction.
+func (e *Engine) DropUserDefinedTableFunction(ctx *sql.Context, name string) error {
+	return e.Analyzer.Catalog.DropUserDefinedTableFunction(ctx, name)
+}
+
+// RegisterTablePolicy registers a policy that filters the rows and masks the columns of a table for the users it
+// restricts. It applies to every query analyzed after it's registered.
+func (e *Engine) RegisterTablePolicy(ctx *sql.Context, policy sql.TablePolicy) error {
+	return e.Analyzer.Catalog.RegisterTablePolicy(ctx, policy)
+}
+
+// DropTablePolicy removes a policy registered with RegisterTablePolicy.
+func (e *Engine) DropTablePolicy(ctx *sql.Context, name string) error {
+	return e.Analyzer.Catalog.DropTablePolicy(ctx, name)
+}
+
 func (e *Engine) IsReadOnly() bool {
 	return e.ReadOnly.Load()
 }
 
+// trackQueryMemory starts accounting for the memory buffered by the query about to execute in |ctx|, which may buffer
+// up to the session's query_memory_limit. The memory is released once the query finishes.
+func trackQueryMemory(ctx *sql.Context) {
+	if ctx.IsInterpreted() || ctx.Memory == nil {
+		return
+	}
+	var limit int64
+	if val, err := ctx.GetSessionVariable(ctx, sql.QueryMemoryLimitSessionVar); err == nil {
+		limit, _ = val.(int64)
+	}
+	ctx.SetMemoryTracker(ctx.Memory.NewQueryTracker(limit))
+}
+
+// sandboxCheck returns an error if the session's user must set a new password before running |node|, as the password
+// of the user has expired. Until then, only statements that set the password of the user, and SET statements that
+// don't read tables, may run.
+func (e *Engine) sandboxCheck(ctx *sql.Context, node sql.Node) error {
+	mysqlDb := e.Analyzer.Catalog.MySQLDb
+	if !mysqlDb.Enabled() || !mysqlDb.SandboxMode(ctx) {
+		return nil
+	}
+	switch n := node.(type) {
+	case *plan.Set:
+		if !setReadsTables(ctx, n) {
+			return nil
+		}
+	case *plan.SetPassword:
+		if n.User == nil || mysqlDb.IsSessionUser(ctx, n.User.Name, n.User.Host) {
+			return nil
+		}
+	case *plan.AlterUser:
+		if n.User.Auth1 != nil && mysqlDb.IsSessionUser(ctx, n.User.UserName.Name, n.User.UserName.Host) {
+			return nil
+		}
+	}
+	return sql.ErrMustChangePassword.New()
+}
+
+// setReadsTables returns whether the values of |set| read tables, through subqueries or stored functions.
+func setReadsTables(ctx *sql.Context, set *plan.Set) bool {
+	for _, expr := range set.Exprs {
+		readsTables := transform.InspectExpr(ctx, expr, func(ctx *sql.Context, e sql.Expression) bool {
+			switch e.(type) {
+			case *plan.Subquery, *plan.StoredFunction:
+				return true
+			}
+			return false
+		})
+		if readsTables {
+			return true
+		}
+	}
+	return false
+}
+
 // readOnlyCheck checks to see if the query is valid with the modification setting of the engine.
 func (e *Engine) readOnlyCheck(node sql.Node) error {
 	// Note: We only compute plan.IsReadOnly if the server is in one of
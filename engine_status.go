@@ -0,0 +1,291 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// EngineStatus is a snapshot of the global status counters of an engine, for embedders that want to report on the
+// health of the engine without querying it. The same values are available from SHOW GLOBAL STATUS.
+type EngineStatus struct {
+	// Uptime is the time elapsed since the engine was created.
+	Uptime time.Duration
+	// Questions is the number of statements sent by clients.
+	Questions uint64
+	// Queries is the number of statements executed.
+	Queries uint64
+	// SlowQueries is the number of queries that took longer than long_query_time.
+	SlowQueries uint64
+	// ThreadsConnected is the number of open connections.
+	ThreadsConnected uint64
+	// ThreadsRunning is the number of queries currently running.
+	ThreadsRunning uint64
+	// Commands is the number of statements executed of each kind, keyed by the name of the corresponding Com_ status
+	// variable without its prefix, e.g. "select" or "create_table". Only commands that have been executed are present.
+	Commands map[string]uint64
+}
+
+// Status returns a snapshot of the engine's global status counters.
+func (e *Engine) Status() EngineStatus {
+	vars := sql.StatusVariables.NewGlobalMap()
+	counter := func(name string) uint64 {
+		if v, ok := vars[name]; ok {
+			if n, ok := v.Value().(uint64); ok {
+				return n
+			}
+		}
+		return 0
+	}
+
+	status := EngineStatus{
+		Questions:        counter("Questions"),
+		Queries:          counter("Queries"),
+		SlowQueries:      counter("Slow_queries"),
+		ThreadsConnected: counter("Threads_connected"),
+		ThreadsRunning:   counter("Threads_running"),
+		Commands:         make(map[string]uint64),
+	}
+	if uptime, ok := vars["Uptime"].(*sql.ElapsedStatusVarValue); ok {
+		status.Uptime = uptime.Elapsed()
+	}
+	for name := range vars {
+		if cmd, ok := strings.CutPrefix(name, "Com_"); ok {
+			if n := counter(name); n > 0 {
+				status.Commands[cmd] = n
+			}
+		}
+	}
+	return status
+}
+
+// incrementStatementStatusVariables increments the status variables that count statements of each kind for the
+// statement |bound|, as built by the planbuilder, and analyzed into |analyzed|. INSERT, UPDATE and DELETE are counted
+// by the planbuilder.
+func incrementStatementStatusVariables(ctx *sql.Context, bound, analyzed sql.Node) {
+	if plan.NodeRepresentsSelect(ctx, analyzed) {
+		sql.IncrementStatusVariable(ctx, "Com_select", 1)
+		for _, name := range selectStatusVariables(ctx, analyzed) {
+			sql.IncrementStatusVariable(ctx, name, 1)
+		}
+		return
+	}
+	if name := comStatusVariable(bound); name != "" {
+		sql.IncrementStatusVariable(ctx, name, 1)
+	}
+}
+
+// comStatusVariable returns the name of the Com_ status variable that counts statements like |n|, or the empty
+// string if there isn't one.
+func comStatusVariable(n sql.Node) string {
+	switch n := n.(type) {
+	case *plan.Block:
+		// ALTER TABLE statements with several clauses are built as a block of nodes
+		if children := n.Children(); len(children) > 0 {
+			return comStatusVariable(children[0])
+		}
+	case *plan.Filter:
+		// SHOW statements with a LIKE or WHERE clause
+		return comStatusVariable(n.Child)
+	case *plan.CreateTable:
+		return "Com_create_table"
+	case *plan.DropTable:
+		return "Com_drop_table"
+	case *plan.RenameTable:
+		return "Com_rename_table"
+	case *plan.AddColumn, *plan.DropColumn, *plan.RenameColumn, *plan.ModifyColumn, *plan.AlterPK,
+		*plan.AlterAutoIncrement, *plan.AlterDefaultSet, *plan.AlterDefaultDrop, *plan.CreateCheck, *plan.DropCheck,
+		*plan.DropConstraint, *plan.CreateForeignKey, *plan.DropForeignKey, *plan.AlterIndex:
+		return "Com_alter_table"
+	case *plan.CreateIndex:
+		return "Com_create_index"
+	case *plan.Truncate:
+		return "Com_truncate"
+	case *plan.CreateDB:
+		return "Com_create_db"
+	case *plan.DropDB:
+		return "Com_drop_db"
+	case *plan.AlterDB:
+		return "Com_alter_db"
+	case *plan.CreateView:
+		return "Com_create_view"
+	case *plan.DropView:
+		return "Com_drop_view"
+	case *plan.CreateTrigger:
+		return "Com_create_trigger"
+	case *plan.DropTrigger:
+		return "Com_drop_trigger"
+	case *plan.CreateProcedure:
+		return "Com_create_procedure"
+	case *plan.DropProcedure:
+		return "Com_drop_procedure"
+	case *plan.CreateEvent:
+		return "Com_create_event"
+	case *plan.AlterEvent:
+		return "Com_alter_event"
+	case *plan.DropEvent:
+		return "Com_drop_event"
+	case *plan.Call:
+		return "Com_call_procedure"
+	case *plan.StartTransaction:
+		return "Com_begin"
+	case *plan.Commit:
+		return "Com_commit"
+	case *plan.Rollback:
+		return "Com_rollback"
+	case *plan.CreateSavepoint:
+		return "Com_savepoint"
+	case *plan.RollbackSavepoint:
+		return "Com_rollback_to_savepoint"
+	case *plan.ReleaseSavepoint:
+		return "Com_release_savepoint"
+	case *plan.Set:
+		return "Com_set_option"
+	case *plan.Use:
+		return "Com_change_db"
+	case *plan.LockTables:
+		return "Com_lock_tables"
+	case *plan.UnlockTables:
+		return "Com_unlock_tables"
+	case *plan.Kill:
+		return "Com_kill"
+	case *plan.AnalyzeTable:
+		return "Com_analyze"
+	case *plan.FlushPrivileges:
+		return "Com_flush"
+	case *plan.Signal:
+		return "Com_signal"
+	case *plan.CreateUser:
+		return "Com_create_user"
+	case *plan.DropUser:
+		return "Com_drop_user"
+	case *plan.RenameUser:
+		return "Com_rename_user"
+	case *plan.CreateRole:
+		return "Com_create_role"
+	case *plan.DropRole:
+		return "Com_drop_role"
+	case *plan.Grant:
+		return "Com_grant"
+	case *plan.GrantRole:
+		return "Com_grant_roles"
+	case *plan.Revoke:
+		return "Com_revoke"
+	case *plan.RevokeRole:
+		return "Com_revoke_roles"
+	case *plan.SetRole:
+		return "Com_set_role"
+	case *plan.SetDefaultRole:
+		return "Com_alter_user_default_role"
+	case *plan.AlterUser, *plan.SetPassword:
+		return "Com_alter_user"
+	case *plan.PrepareQuery:
+		return "Com_prepare_sql"
+	case *plan.ExecuteQuery:
+		return "Com_execute_sql"
+	case *plan.DeallocateQuery:
+		return "Com_dealloc_sql"
+	case *plan.ShowTables:
+		return "Com_show_tables"
+	case *plan.ShowDatabases:
+		return "Com_show_databases"
+	case *plan.ShowVariables:
+		return "Com_show_variables"
+	case *plan.ShowStatus:
+		return "Com_show_status"
+	case *plan.ShowColumns:
+		return "Com_show_fields"
+	case *plan.ShowIndexes:
+		return "Com_show_keys"
+	case *plan.ShowCreateTable:
+		return "Com_show_create_table"
+	case *plan.ShowCreateDatabase:
+		return "Com_show_create_db"
+	case *plan.ShowCreateTrigger:
+		return "Com_show_create_trigger"
+	case *plan.ShowCreateProcedure:
+		return "Com_show_create_proc"
+	case *plan.ShowCreateFunction:
+		return "Com_show_create_func"
+	case *plan.ShowCreateEvent:
+		return "Com_show_create_event"
+	case *plan.ShowTriggers:
+		return "Com_show_triggers"
+	case *plan.ShowEvents:
+		return "Com_show_events"
+	case *plan.ShowTableStatus:
+		return "Com_show_table_status"
+	case *plan.ShowProcessList:
+		return "Com_show_processlist"
+	case plan.ShowWarnings:
+		return "Com_show_warnings"
+	case *plan.ShowGrants:
+		return "Com_show_grants"
+	case *plan.ShowPrivileges:
+		return "Com_show_privileges"
+	case *plan.ShowCharset:
+		return "Com_show_charsets"
+	case *plan.ShowReplicaStatus:
+		return "Com_show_replica_status"
+	case *plan.ShowBinlogs:
+		return "Com_show_binlogs"
+	}
+	return ""
+}
+
+// selectStatusVariables returns the names of the Select_ status variables that count the kinds of scans and joins
+// performed by the analyzed SELECT statement |n|. The first table read determines whether it is counted as a full
+// scan or a range scan, and any join that reads its right side without an index counts as a full join.
+func selectStatusVariables(ctx *sql.Context, n sql.Node) []string {
+	var names []string
+	var firstSeen, fullJoin, fullRangeJoin bool
+	transform.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.JoinNode:
+			switch {
+			case n.Op.IsRange():
+				fullRangeJoin = true
+			case !n.Op.IsLookup() && !n.Op.IsHash() && !n.Op.IsMerge():
+				fullJoin = true
+			}
+		case *plan.ResolvedTable:
+			if !firstSeen {
+				firstSeen = true
+				if !plan.IsDualTable(n.Table) {
+					names = append(names, "Select_scan")
+				}
+			}
+		case *plan.IndexedTableAccess:
+			if !firstSeen {
+				firstSeen = true
+				names = append(names, "Select_range")
+			}
+			return false
+		}
+		return true
+	})
+	if fullJoin {
+		names = append(names, "Select_full_join")
+	}
+	if fullRangeJoin {
+		names = append(names, "Select_full_range_join")
+	}
+	return names
+}
@@ -15,17 +15,27 @@
 package sqle
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/apd/v3"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/rowexec"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -244,3 +254,1519 @@ func TestTrackProcess(t *testing.T) {
 	require.Equal(sql.ProcessCommandSleep, processes[0].Command)
 	require.Error(ctx.Err())
 }
+
+func TestEngineStatus(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+
+	for _, q := range []string{
+		"CREATE TABLE t (a int primary key, b int)",
+		"INSERT INTO t VALUES (1, 1), (2, 2)",
+		"SELECT * FROM t",
+		"SELECT * FROM t WHERE a > 1",
+		"SELECT * FROM t t1, t t2",
+	} {
+		_, iter, _, err := e.Query(ctx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	status := e.Status()
+	require.Equal(uint64(5), status.Questions)
+	require.Equal(uint64(5), status.Queries)
+	require.Equal(map[string]uint64{"create_table": 1, "insert": 1, "select": 3}, status.Commands)
+	require.Greater(status.Uptime, time.Duration(0))
+	require.Less(status.Uptime, time.Minute)
+
+	for name, expected := range map[string]uint64{
+		"Select_scan":      2,
+		"Select_range":     1,
+		"Select_full_join": 1,
+	} {
+		_, val, ok := sql.StatusVariables.GetGlobal(name)
+		require.True(ok)
+		require.Equal(expected, val, name)
+	}
+	_, val, ok := sql.StatusVariables.GetGlobal("Uptime")
+	require.True(ok)
+	require.IsType(uint64(0), val)
+}
+
+func TestPreparedPlanReoptimization(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+
+	run := func(q string, bindings map[string]sqlparser.Expr) []sql.Row {
+		_, iter, _, err := e.QueryWithBindings(ctx, q, nil, bindings, nil)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+	bind := func(v string) map[string]sqlparser.Expr {
+		return map[string]sqlparser.Expr{"v1": sqlparser.NewIntVal([]byte(v))}
+	}
+	cachedPlan := func(q string) sql.Node {
+		e.preparedPlans.mu.Lock()
+		defer e.preparedPlans.mu.Unlock()
+		if p, ok := e.preparedPlans.plans[ctx.Session.ID()][q]; ok {
+			return p.analyzed
+		}
+		return nil
+	}
+
+	run("CREATE TABLE t (a int primary key, b int)", nil)
+	run("INSERT INTO t VALUES (1, 1), (2, 2), (3, 3)", nil)
+
+	const q = "SELECT b FROM t WHERE a = ?"
+
+	// every execution is re-optimized by default
+	run("BEGIN", nil)
+	require.Equal([]sql.Row{{int32(1)}}, run(q, bind("1")))
+	require.Nil(cachedPlan(q))
+	run("COMMIT", nil)
+
+	run("SET prepared_stmt_reoptimize = 'ON_FINGERPRINT_CHANGE'", nil)
+	run("BEGIN", nil)
+	require.Equal([]sql.Row{{int32(1)}}, run(q, bind("1")))
+	first := cachedPlan(q)
+	require.NotNil(first)
+
+	// the same bind values reuse the plan
+	require.Equal([]sql.Row{{int32(1)}}, run(q, bind("1")))
+	require.True(first == cachedPlan(q))
+
+	// different bind values re-optimize the statement
+	require.Equal([]sql.Row{{int32(2)}}, run(q, bind("2")))
+	second := cachedPlan(q)
+	require.NotNil(second)
+	require.False(first == second)
+
+	// a write discards the plans cached in the transaction
+	run("UPDATE t SET b = 20 WHERE a = 2", nil)
+	require.Nil(cachedPlan(q))
+	require.Equal([]sql.Row{{int32(20)}}, run(q, bind("2")))
+	second = cachedPlan(q)
+	run("COMMIT", nil)
+
+	// plans aren't reused across transactions
+	run("BEGIN", nil)
+	require.Equal([]sql.Row{{int32(20)}}, run(q, bind("2")))
+	require.False(second == cachedPlan(q))
+	run("COMMIT", nil)
+
+	e.CloseSession(ctx.Session.ID())
+	require.Nil(cachedPlan(q))
+}
+
+func TestSharedCteResults(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+	cache := e.Analyzer.ExecBuilder.SharedResults
+
+	run := func(q string) []sql.Row {
+		_, iter, _, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	run("CREATE TABLE t (a int primary key, b int)")
+	run("INSERT INTO t VALUES (1, 1), (2, 1), (3, 2)")
+
+	const with = "WITH totals AS (SELECT b, count(*) AS n FROM t GROUP BY b) "
+
+	// filters in the outer query are not applied to the shared result
+	require.Equal([]sql.Row{{int32(2), int64(1)}}, run(with+"SELECT /*+ CACHED(totals) */ b, n FROM totals WHERE b = 2"))
+	require.Equal(1, cache.Len(ctx.Session.ID()))
+	require.Equal([]sql.Row{{int32(1), int64(2)}, {int32(2), int64(1)}}, run(with+"SELECT /*+ CACHED(totals) */ b, n FROM totals ORDER BY b"))
+	require.Equal(1, cache.Len(ctx.Session.ID()))
+
+	// a write to a table the result was computed from invalidates it
+	run("INSERT INTO t VALUES (4, 2)")
+	require.Equal([]sql.Row{{int32(1), int64(2)}, {int32(2), int64(2)}}, run(with+"SELECT /*+ CACHED(totals) */ b, n FROM totals ORDER BY b"))
+
+	// DDL invalidates every result
+	run("CREATE TABLE u (a int primary key)")
+	require.Equal(0, cache.Len(ctx.Session.ID()))
+
+	// results that depend on variables are not shared
+	run("SET @min = 1")
+	require.Equal([]sql.Row{{int64(4)}}, run("WITH c AS (SELECT count(*) AS n FROM t WHERE a >= @min) SELECT /*+ CACHED(c) */ n FROM c"))
+	require.Equal(0, cache.Len(ctx.Session.ID()))
+	require.Len(ctx.Session.Warnings(), 1)
+
+	// nor are results that depend on session state, such as block_encryption_mode
+	run("WITH c AS (SELECT hex(aes_encrypt(b, 'key')) AS e FROM t WHERE a = 1) SELECT /*+ CACHED(c) */ e FROM c")
+	require.Equal(0, cache.Len(ctx.Session.ID()))
+	require.Len(ctx.Session.Warnings(), 1)
+}
+
+func TestMaxExecutionTime(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	pl := NewProcessList()
+	pl.AddConnection(sess.ID(), "localhost")
+	pl.ConnectionReady(sess)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithPid(1), sql.WithProcessList(pl))
+
+	run := func(q string) ([]sql.Row, error) {
+		ctx, err := pl.BeginQuery(ctx, q)
+		require.NoError(err)
+		defer pl.EndQuery(ctx)
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	_, err := run("SET max_execution_time = 10")
+	require.NoError(err)
+
+	// the state of a timed out query is shown in the process list until it ends
+	qctx, err := pl.BeginQuery(ctx, "SELECT SLEEP(10)")
+	require.NoError(err)
+	start := time.Now()
+	_, iter, _, err := e.Query(qctx, "SELECT SLEEP(10)")
+	require.NoError(err)
+	_, err = iter.Next(qctx)
+	require.True(sql.ErrQueryTimeout.Is(err), "unexpected error: %v", err)
+	require.Less(time.Since(start), 5*time.Second)
+	require.Equal(3024, sql.CastSQLError(err).Number())
+	require.Equal(rowexec.StatementTimeoutState, pl.Processes()[0].State)
+	require.NoError(iter.Close(qctx))
+	require.Equal("", pl.Processes()[0].State)
+
+	// statements that aren't SELECTs are not limited
+	_, err = run("CREATE TABLE t (a int primary key)")
+	require.NoError(err)
+	_, err = run("INSERT INTO t SELECT SLEEP(0.1)")
+	require.NoError(err)
+
+	// a limit of 0 disables the timeout
+	_, err = run("SET max_execution_time = 0")
+	require.NoError(err)
+	rows, err := run("SELECT SLEEP(0.1)")
+	require.NoError(err)
+	require.Equal([]sql.Row{{0}}, rows)
+}
+
+func TestQueryMemoryLimit(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithMemoryManager(e.MemoryManager))
+
+	run := func(q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	_, err := run("CREATE TABLE t (a int primary key, b varchar(1000))")
+	require.NoError(err)
+	_, err = run("INSERT INTO t SELECT x, repeat('a', 1000) FROM (WITH RECURSIVE r(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM r WHERE x < 100) SELECT x FROM r) s")
+	require.NoError(err)
+
+	// the sort buffers every row of the table
+	const sorted = "SELECT a FROM t ORDER BY b, a"
+	rows, err := run(sorted)
+	require.NoError(err)
+	require.Len(rows, 100)
+	require.Equal(int64(0), e.MemoryManager.QueryMemory().Used())
+
+	_, err = run("SET query_memory_limit = 10000")
+	require.NoError(err)
+	_, err = run(sorted)
+	require.True(sql.ErrOutOfResources.Is(err), "unexpected error: %v", err)
+	require.Equal(1041, sql.CastSQLError(err).Number())
+	require.Equal(int64(0), e.MemoryManager.QueryMemory().Used())
+
+	// queries that buffer little are unaffected
+	rows, err = run("SELECT a FROM t WHERE a = 1")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1)}}, rows)
+
+	// the server-wide limit applies to every query
+	_, err = run("SET query_memory_limit = 0")
+	require.NoError(err)
+	e.MemoryManager.SetQueryMemoryLimit(10000)
+	_, err = run(sorted)
+	require.True(sql.ErrOutOfResources.Is(err), "unexpected error: %v", err)
+	e.MemoryManager.SetQueryMemoryLimit(0)
+	rows, err = run(sorted)
+	require.NoError(err)
+	require.Len(rows, 100)
+}
+
+func TestBackgroundJobs(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	defer e.Close()
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+
+	err := e.BackgroundThreads.AddJob(sql.BackgroundJob{
+		Name: "fails",
+		Run: func(ctx context.Context) error {
+			return fmt.Errorf("something went wrong")
+		},
+	})
+	require.NoError(err)
+	err = e.BackgroundThreads.Add("waits", func(ctx context.Context) {
+		<-ctx.Done()
+	})
+	require.NoError(err)
+
+	require.Eventually(func() bool {
+		return e.BackgroundThreads.Jobs()[0].State == sql.BackgroundJobFailed
+	}, time.Second, time.Millisecond)
+
+	_, iter, _, err := e.Query(ctx, "SELECT name, state, finished IS NULL, restarts, last_error FROM information_schema.background_jobs ORDER BY name")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"fails", "failed", false, uint64(0), "something went wrong"},
+		{"waits", "running", true, uint64(0), nil},
+	}, rows)
+
+	require.NoError(e.Close())
+	require.Equal(sql.BackgroundJobStopped, e.BackgroundThreads.Jobs()[1].State)
+}
+
+func TestQueryColumns(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+
+	for _, q := range []string{
+		"CREATE TABLE t (a int primary key, b bigint unsigned, c double, d decimal(10,2), e varchar(10), f datetime, g json)",
+		"INSERT INTO t VALUES (1, 10, 1.5, 2.25, 'x', '2025-01-02 03:04:05', '{}'), (2, NULL, NULL, NULL, NULL, NULL, NULL), (3, 30, 3.5, 4.75, 'z', '2025-06-07 08:09:10', '[1]')",
+	} {
+		_, iter, _, err := e.Query(ctx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	sch, iter, err := e.QueryColumns(ctx, "SELECT a, b, c, d, e, f, g FROM t ORDER BY a", 2)
+	require.NoError(err)
+	require.Len(sch, 7)
+
+	batch, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(2, batch.Len)
+	require.Equal([]int64{1, 2}, batch.Columns[0].(*Vector[int64]).Values)
+	require.Equal([]uint64{10, 0}, batch.Columns[1].(*Vector[uint64]).Values)
+	require.Equal([]float64{1.5, 0}, batch.Columns[2].(*Vector[float64]).Values)
+	require.Equal("2.25", batch.Columns[3].(*Vector[*apd.Decimal]).Values[0].String())
+	require.Equal([]string{"x", ""}, batch.Columns[4].(*Vector[string]).Values)
+	require.Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), batch.Columns[5].(*Vector[time.Time]).Values[0])
+	require.IsType(&Vector[interface{}]{}, batch.Columns[6])
+	for _, col := range batch.Columns[1:] {
+		require.Equal(2, col.Len())
+		require.False(col.IsNull(0))
+		require.True(col.IsNull(1))
+		require.Nil(col.Value(1))
+	}
+	require.False(batch.Columns[0].IsNull(1))
+
+	batch, err = iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(1, batch.Len)
+	require.Equal([]int64{3}, batch.Columns[0].(*Vector[int64]).Values)
+	require.Equal(uint64(30), batch.Columns[1].Value(0))
+	require.False(batch.Columns[1].IsNull(0))
+
+	_, err = iter.Next(ctx)
+	require.Equal(io.EOF, err)
+	require.NoError(iter.Close(ctx))
+}
+
+func TestSessionState(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+
+	newContext := func() *sql.Context {
+		sess := memory.NewSession(sql.NewBaseSession(), provider)
+		return sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+	}
+	query := func(ctx *sql.Context, q string) []sql.Row {
+		_, iter, _, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	const check = "SELECT @i, @s, @b, @n, @@sql_select_limit, @@sql_mode, database()"
+	ctx := newContext()
+	for _, q := range []string{
+		"USE db",
+		"SET @i = 42, @s = 'hello', @b = x'00ff', @n = NULL",
+		"SET @@sql_select_limit = 7, @@sql_mode = 'ANSI_QUOTES'",
+		"START TRANSACTION",
+	} {
+		query(ctx, q)
+	}
+	expected := query(ctx, check)
+	tx := ctx.GetTransaction()
+	require.NotNil(tx)
+
+	data, err := e.ExportSessionState(ctx)
+	require.NoError(err)
+	require.Nil(ctx.GetTransaction())
+
+	restored := newContext()
+	require.NoError(e.RestoreSessionState(restored, data))
+	require.Same(tx, restored.GetTransaction())
+	require.True(restored.GetIgnoreAutoCommit())
+	require.Equal(expected, query(restored, check))
+
+	// the transaction is handed off only once
+	err = e.RestoreSessionState(newContext(), data)
+	require.True(sql.ErrSessionStateUnknownTransaction.Is(err))
+}
+
+func TestSessionStateHandoffRollback(t *testing.T) {
+	// setup exports the state of a session whose transaction locks a row of t, and returns the transaction
+	// token of the state along with a session that checks whether the row is still locked.
+	setup := func(t *testing.T, cfg *Config) (*Engine, string, func() error) {
+		db := memory.NewDatabase("db")
+		provider := memory.NewDBProvider(db)
+		e := New(analyzer.NewDefault(provider), cfg)
+		newContext := func() *sql.Context {
+			sess := memory.NewSession(sql.NewBaseSession(), provider)
+			sess.SetCurrentDatabase("db")
+			return sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+		}
+		query := func(ctx *sql.Context, q string) error {
+			_, iter, _, err := e.Query(ctx, q)
+			if err != nil {
+				return err
+			}
+			_, err = sql.RowIterToRows(ctx, iter)
+			return err
+		}
+
+		ctx := newContext()
+		for _, q := range []string{
+			"CREATE TABLE t (pk int primary key, v int)",
+			"INSERT INTO t VALUES (1, 1)",
+			"START TRANSACTION",
+			"SELECT * FROM t WHERE pk = 1 FOR UPDATE",
+		} {
+			require.NoError(t, query(ctx, q))
+		}
+		data, err := e.ExportSessionState(ctx)
+		require.NoError(t, err)
+		var state SessionState
+		require.NoError(t, json.Unmarshal(data, &state))
+		require.NotEmpty(t, state.Transaction)
+
+		other := newContext()
+		lockRow := func() error {
+			return query(other, "SELECT * FROM t WHERE pk = 1 FOR UPDATE NOWAIT")
+		}
+		// the held transaction keeps its lock
+		require.True(t, sql.ErrLockNowait.Is(lockRow()))
+		return e, state.Transaction, lockRow
+	}
+
+	t.Run("expired", func(t *testing.T) {
+		e, token, lockRow := setup(t, &Config{SessionHandoffTimeout: 50 * time.Millisecond})
+		require.Eventually(t, func() bool {
+			return lockRow() == nil
+		}, 5*time.Second, 10*time.Millisecond)
+		require.True(t, sql.ErrSessionStateUnknownTransaction.Is(e.DiscardSessionState(token)))
+	})
+
+	t.Run("discarded", func(t *testing.T) {
+		e, token, lockRow := setup(t, nil)
+		require.NoError(t, e.DiscardSessionState(token))
+		require.NoError(t, lockRow())
+		require.True(t, sql.ErrSessionStateUnknownTransaction.Is(e.DiscardSessionState(token)))
+	})
+
+	t.Run("engine closed", func(t *testing.T) {
+		e, token, lockRow := setup(t, nil)
+		require.NoError(t, e.Close())
+		require.NoError(t, lockRow())
+		require.True(t, sql.ErrSessionStateUnknownTransaction.Is(e.DiscardSessionState(token)))
+	})
+}
+
+func TestDescribePlan(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+
+	_, iter, _, err := e.Query(ctx, "CREATE TABLE t (a int primary key, b int)")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	query := "SELECT b FROM t WHERE a = 1 AND b IN (SELECT b FROM t)"
+	desc, err := e.DescribePlan(ctx, query)
+	require.NoError(err)
+	require.Equal(plan.PlanDescriptionVersion, desc.Version)
+	require.Equal(query, desc.Query)
+
+	var find func(d *plan.PlanNodeDescription, f func(*plan.PlanNodeDescription) bool) *plan.PlanNodeDescription
+	find = func(d *plan.PlanNodeDescription, f func(*plan.PlanNodeDescription) bool) *plan.PlanNodeDescription {
+		if f(d) {
+			return d
+		}
+		for _, c := range append(d.Children, d.Subqueries...) {
+			if found := find(c, f); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+
+	require.Equal("Project", desc.Logical.Operator)
+	require.Equal([]string{"b"}, desc.Logical.Columns)
+	filter := find(desc.Logical, func(d *plan.PlanNodeDescription) bool { return d.Operator == "Filter" })
+	require.NotNil(filter)
+	require.Len(filter.Subqueries, 1)
+
+	access := find(desc.Physical, func(d *plan.PlanNodeDescription) bool { return d.Operator == "IndexedTableAccess" })
+	require.NotNil(access)
+	require.Equal("t", access.Name)
+	require.Equal("db", access.Database)
+	require.NotEmpty(access.Index)
+
+	data, err := json.Marshal(desc)
+	require.NoError(err)
+	var decoded plan.PlanDescription
+	require.NoError(json.Unmarshal(data, &decoded))
+	require.Equal(desc, &decoded)
+}
+
+func TestLockingReads(t *testing.T) {
+	require := require.New(t)
+	variables.InitStatusVariables()
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	pl := NewProcessList()
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	pl.AddConnection(sess.ID(), "localhost")
+	pl.ConnectionReady(sess)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithPid(1), sql.WithProcessList(pl))
+
+	var locks *testRowLocks
+	query := func(q string) ([]sql.Row, error) {
+		if locks != nil {
+			// committing a memory session puts a memory.Table back in the database, replacing the locking table
+			tbl, ok, err := db.GetTableInsensitive(ctx, "t")
+			require.NoError(err)
+			require.True(ok)
+			if mt, ok := tbl.(*memory.Table); ok {
+				db.AddTable("t", &lockingTable{Table: mt, locks: locks})
+			}
+		}
+		qctx, err := pl.BeginQuery(ctx, q)
+		require.NoError(err)
+		defer pl.EndQuery(qctx)
+		_, iter, _, err := e.Query(qctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(qctx, iter)
+	}
+
+	for _, q := range []string{
+		"CREATE TABLE t (a int primary key, b int)",
+		"INSERT INTO t VALUES (1, 10), (2, 20), (3, 30)",
+	} {
+		_, err := query(q)
+		require.NoError(err)
+	}
+	locks = &testRowLocks{locked: map[int32]bool{2: true}}
+
+	rows, err := query("SELECT a FROM t FOR UPDATE SKIP LOCKED")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1)}, {int32(3)}}, rows)
+	require.Equal(sql.RowLock{Mode: sql.RowLockExclusive, Wait: sql.RowLockSkipLocked}, locks.lock)
+
+	_, err = query("SELECT a FROM t FOR UPDATE NOWAIT")
+	require.True(sql.ErrLockNowait.Is(err))
+
+	locks.wait = func() error {
+		require.Equal(sql.RowLockWaitState, pl.Processes()[0].State)
+		return nil
+	}
+	rows, err = query("SELECT x.a FROM t x LOCK IN SHARE MODE")
+	require.NoError(err)
+	require.Len(rows, 3)
+	require.Equal(sql.RowLock{Mode: sql.RowLockShared}, locks.lock)
+	require.Equal("", pl.Processes()[0].State)
+
+	rows, err = query("SELECT x.a FROM t x FOR SHARE")
+	require.NoError(err)
+	require.Len(rows, 3)
+	require.Equal(sql.RowLock{Mode: sql.RowLockShared}, locks.lock)
+
+	rows, err = query("SELECT a FROM t FOR SHARE SKIP LOCKED")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1)}, {int32(3)}}, rows)
+	require.Equal(sql.RowLock{Mode: sql.RowLockShared, Wait: sql.RowLockSkipLocked}, locks.lock)
+
+	_, err = query("SELECT a FROM t FOR SHARE NOWAIT")
+	require.True(sql.ErrLockNowait.Is(err))
+
+	rows, err = query("SELECT x.a, y.a FROM t x, t y FOR SHARE OF y SKIP LOCKED")
+	require.NoError(err)
+	require.Len(rows, 6)
+	require.Equal(sql.RowLock{Mode: sql.RowLockShared, Wait: sql.RowLockSkipLocked}, locks.lock)
+
+	// only the tables of a FOR UPDATE OF clause are locked
+	rows, err = query("SELECT x.a, y.a FROM t x, t y FOR UPDATE OF y SKIP LOCKED")
+	require.NoError(err)
+	require.Len(rows, 6)
+	for _, row := range rows {
+		require.NotEqual(int32(2), row[1])
+	}
+	_, err = query("SELECT a FROM t FOR UPDATE OF y")
+	require.True(sql.ErrUnresolvedTableLock.Is(err))
+}
+
+// testRowLocks are the rows of a lockingTable that are locked by another transaction.
+type testRowLocks struct {
+	locked map[int32]bool
+	// lock is the last lock taken by a read of the table
+	lock sql.RowLock
+	wait func() error
+}
+
+// lockingTable is a memory table that implements sql.LockableTable, whose rows in |locks| are locked by another
+// transaction. Its first column must be read.
+type lockingTable struct {
+	*memory.Table
+	locks *testRowLocks
+	lock  *sql.RowLock
+}
+
+var _ sql.LockableTable = (*lockingTable)(nil)
+
+// IgnoreSessionData keeps the database from replacing the table with the session's copy of its memory.Table, which
+// already holds the session's data.
+func (t *lockingTable) IgnoreSessionData() bool {
+	return true
+}
+
+func (t *lockingTable) WithRowLock(lock sql.RowLock) sql.Table {
+	return &lockingTable{Table: t.Table, locks: t.locks, lock: &lock}
+}
+
+func (t *lockingTable) WithProjections(ctx *sql.Context, cols []string) (sql.Table, error) {
+	nt, err := t.Table.WithProjections(ctx, cols)
+	if err != nil {
+		return nil, err
+	}
+	return &lockingTable{Table: nt.(*memory.Table), locks: t.locks, lock: t.lock}, nil
+}
+
+func (t *lockingTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Table.PartitionRows(ctx, partition)
+	if err != nil || t.lock == nil {
+		return iter, err
+	}
+	t.locks.lock = *t.lock
+	var rows []sql.Row
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if t.locks.locked[row[0].(int32)] {
+			switch t.lock.Wait {
+			case sql.RowLockNowait:
+				return nil, sql.ErrLockNowait.New()
+			case sql.RowLockSkipLocked:
+				continue
+			default:
+				if err = sql.WaitForRowLock(ctx, t.locks.wait); err != nil {
+					return nil, err
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	return sql.RowsToRowIter(rows...), iter.Close(ctx)
+}
+
+type concatAggregator struct {
+	vals []string
+}
+
+func (c *concatAggregator) Update(ctx *sql.Context, args []interface{}) error {
+	if args[0] != nil {
+		c.vals = append(c.vals, fmt.Sprint(args[0]))
+	}
+	return nil
+}
+
+func (c *concatAggregator) Result(ctx *sql.Context) (interface{}, error) {
+	if len(c.vals) == 0 {
+		return nil, nil
+	}
+	return strings.Join(c.vals, "|"), nil
+}
+
+func TestUserDefinedFunctions(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	require.NoError(e.RegisterUserDefinedFunction(ctx, sql.UserDefinedFunction{
+		Name:       "Add_Tax",
+		MinArgs:    1,
+		MaxArgs:    2,
+		ReturnType: types.Int64,
+		InferType: func(ctx *sql.Context, argTypes []sql.Type) sql.Type {
+			if types.IsFloat(argTypes[0]) {
+				return types.Float64
+			}
+			return nil
+		},
+		Volatility: sql.FunctionImmutable,
+		Comment:    "adds tax to a price",
+		Eval: func(ctx *sql.Context, args []interface{}) (interface{}, error) {
+			rate := 10.0
+			if len(args) == 2 {
+				if args[1] == nil {
+					return nil, nil
+				}
+				v, _, err := types.Float64.Convert(ctx, args[1])
+				if err != nil {
+					return nil, err
+				}
+				rate = v.(float64)
+			}
+			if args[0] == nil {
+				return nil, nil
+			}
+			v, _, err := types.Float64.Convert(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return v.(float64) * (1 + rate/100), nil
+		},
+	}))
+	require.NoError(e.RegisterUserDefinedFunction(ctx, sql.UserDefinedFunction{
+		Name:          "concat_all",
+		MinArgs:       1,
+		MaxArgs:       1,
+		ReturnType:    types.LongText,
+		NewAggregator: func(ctx *sql.Context) (sql.UserDefinedAggregator, error) { return &concatAggregator{}, nil },
+	}))
+
+	err := e.RegisterUserDefinedFunction(ctx, sql.UserDefinedFunction{Name: "ADD_TAX", ReturnType: types.Int64, Eval: func(ctx *sql.Context, args []interface{}) (interface{}, error) { return nil, nil }})
+	require.True(sql.ErrUserDefinedFunctionExists.Is(err))
+	err = e.RegisterUserDefinedFunction(ctx, sql.UserDefinedFunction{Name: "concat", ReturnType: types.Int64, Eval: func(ctx *sql.Context, args []interface{}) (interface{}, error) { return nil, nil }})
+	require.True(sql.ErrUserDefinedFunctionExists.Is(err))
+	err = e.RegisterUserDefinedFunction(ctx, sql.UserDefinedFunction{Name: "no_impl", ReturnType: types.Int64})
+	require.True(sql.ErrInvalidUserDefinedFunction.Is(err))
+
+	_, err = query("create table t (a int primary key, b varchar(10), c int)")
+	require.NoError(err)
+	_, err = query("insert into t values (1, 'x', 1), (2, 'y', 1), (3, 'z', 2)")
+	require.NoError(err)
+
+	rows, err := query("select add_tax(100), ADD_TAX(100, 50), add_tax(4e0, 50), add_tax(null)")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(110), int64(150), 6.0, nil}}, rows)
+
+	_, err = query("select add_tax(1, 2, 3)")
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+
+	rows, err = query("select c, concat_all(b) from t group by c order by c")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "x|y"}, {int32(2), "z"}}, rows)
+
+	rows, err = query("show function status like 'add%'")
+	require.NoError(err)
+	require.Len(rows, 1)
+	require.Equal([]interface{}{"mysql", "add_tax", "FUNCTION"}, []interface{}(rows[0][:3]))
+	require.Equal("INVOKER", rows[0][6])
+	require.Equal("adds tax to a price", rows[0][7])
+
+	rows, err = query("select routine_name, data_type, is_deterministic from information_schema.routines where routine_type = 'FUNCTION' order by routine_name")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"add_tax", "bigint", "YES"}, {"concat_all", "longtext", "NO"}}, rows)
+
+	require.NoError(e.DropUserDefinedFunction(ctx, "add_tax"))
+	require.True(sql.ErrUserDefinedFunctionNotDefined.Is(e.DropUserDefinedFunction(ctx, "add_tax")))
+	_, err = query("select add_tax(100)")
+	require.True(sql.ErrFunctionNotFound.Is(err))
+}
+
+func TestLoadableFunctions(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	sql.RegisterLoadableFunctionLibrary("test_udf.so", func(ctx *sql.Context, name string, aggregate bool) (sql.UserDefinedFunction, bool) {
+		switch {
+		case strings.EqualFold(name, "reverse_words") && !aggregate:
+			return sql.UserDefinedFunction{
+				MinArgs: 1,
+				MaxArgs: 1,
+				Eval: func(ctx *sql.Context, args []interface{}) (interface{}, error) {
+					if args[0] == nil {
+						return nil, nil
+					}
+					words := strings.Fields(fmt.Sprint(args[0]))
+					for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+						words[i], words[j] = words[j], words[i]
+					}
+					return strings.Join(words, " "), nil
+				},
+			}, true
+		case strings.EqualFold(name, "concat_all") && aggregate:
+			return sql.UserDefinedFunction{
+				MinArgs:       1,
+				MaxArgs:       1,
+				NewAggregator: func(ctx *sql.Context) (sql.UserDefinedAggregator, error) { return &concatAggregator{}, nil },
+			}, true
+		}
+		return sql.UserDefinedFunction{}, false
+	})
+	defer sql.RegisterLoadableFunctionLibrary("test_udf.so", nil)
+
+	_, err := query("create function reverse_words returns string soname 'test_udf.so'")
+	require.NoError(err)
+	_, err = query("CREATE AGGREGATE FUNCTION `concat_all` RETURNS STRING SONAME \"test_udf.so\";")
+	require.NoError(err)
+
+	rows, err := query("select reverse_words('a b c'), reverse_words(null)")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"c b a", nil}}, rows)
+	rows, err = query("select concat_all(x) from (select 'a' as x union all select 'b') t")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"a|b"}}, rows)
+
+	_, err = query("create function reverse_words returns string soname 'test_udf.so'")
+	require.True(sql.ErrUserDefinedFunctionExists.Is(err))
+	_, err = query("create function if not exists reverse_words returns string soname 'test_udf.so'")
+	require.NoError(err)
+	_, err = query("create function reverse_words2 returns string soname 'missing.so'")
+	require.True(sql.ErrCantOpenLibrary.Is(err))
+	_, err = query("create function unknown_fn returns integer soname 'test_udf.so'")
+	require.True(sql.ErrCantFindLibraryFunction.Is(err))
+	_, err = query("create function reverse_words2 returns string soname 'test_udf.so'")
+	require.True(sql.ErrCantFindLibraryFunction.Is(err))
+
+	_, err = query("drop function reverse_words")
+	require.NoError(err)
+	_, err = query("select reverse_words('a b')")
+	require.True(sql.ErrFunctionNotFound.Is(err))
+	_, err = query("drop function reverse_words")
+	require.True(sql.ErrStoredFunctionDoesNotExist.Is(err))
+	_, err = query("drop function if exists reverse_words")
+	require.NoError(err)
+}
+
+func TestSchemaChangeNotifications(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+
+	var changes []string
+	unsubscribe := e.SubscribeSchemaChanges(sql.SchemaChangeListenerFunc(func(ctx *sql.Context, change sql.SchemaChange) {
+		changes = append(changes, change.String())
+	}))
+	run := func(q string) error {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, iter)
+		return err
+	}
+	expect := func(q string, expected ...string) {
+		changes = nil
+		require.NoError(run(q))
+		require.Equal(expected, changes, q)
+	}
+
+	expect("CREATE TABLE t (a int primary key, b int)", "table db.t created")
+	expect("INSERT INTO t VALUES (1, 1)")
+	expect("SELECT * FROM t")
+	expect("ALTER TABLE t ADD COLUMN c int, ADD INDEX idx (b)", "table db.t altered")
+	expect("CREATE INDEX idx2 ON t (b, a)", "table db.t altered")
+	expect("RENAME TABLE t TO t2", "table db.t renamed to t2")
+	expect("CREATE VIEW v AS SELECT a FROM t2", "view db.v created")
+	expect("CREATE OR REPLACE VIEW v AS SELECT b FROM t2", "view db.v created")
+	expect("CREATE TRIGGER trig BEFORE INSERT ON t2 FOR EACH ROW SET new.b = 1", "trigger db.trig created")
+	expect("CREATE PROCEDURE p() CREATE TABLE u (a int)", "procedure db.p created")
+	expect("CALL p()", "table db.u created")
+	expect("CREATE SEQUENCE s", "sequence db.s created")
+	expect("DROP SEQUENCE s", "sequence db.s dropped")
+	expect("DROP TRIGGER trig", "trigger db.trig dropped")
+	expect("DROP VIEW v", "view db.v dropped")
+	expect("DROP TABLE t2, u", "table db.t2 dropped", "table db.u dropped")
+	expect("CREATE DATABASE db2", "database db2 created")
+	expect("DROP DATABASE db2", "database db2 dropped")
+
+	// failed statements publish nothing
+	changes = nil
+	require.Error(run("CREATE TABLE x (a int, a int)"))
+	require.Error(run("DROP TABLE missing"))
+	require.Empty(changes)
+
+	unsubscribe()
+	expect("CREATE TABLE t (a int primary key)")
+}
+
+func TestUserDefinedTableFunctions(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	provider := memory.NewDBProvider(db)
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	// generate_series(start, stop[, step]) returns the integers from start to stop
+	require.NoError(e.RegisterUserDefinedTableFunction(ctx, sql.UserDefinedTableFunction{
+		Name:    "Generate_Series",
+		MinArgs: 2,
+		MaxArgs: 3,
+		Schema: func(ctx *sql.Context, args []sql.Expression) (sql.Schema, error) {
+			return sql.Schema{{Name: "value", Type: types.Int64}}, nil
+		},
+		RowIter: func(ctx *sql.Context, args []interface{}) (sql.RowIter, error) {
+			vals := make([]int64, len(args))
+			for i, arg := range args {
+				if arg == nil {
+					return sql.RowsToRowIter(), nil
+				}
+				v, _, err := types.Int64.Convert(ctx, arg)
+				if err != nil {
+					return nil, err
+				}
+				vals[i] = v.(int64)
+			}
+			step := int64(1)
+			if len(vals) == 3 {
+				step = vals[2]
+			}
+			var rows []sql.Row
+			for v := vals[0]; v <= vals[1]; v += step {
+				rows = append(rows, sql.Row{v})
+			}
+			return sql.RowsToRowIter(rows...), nil
+		},
+	}))
+	// split(str, sep, column) splits a string into rows of a column with the name given
+	require.NoError(e.RegisterUserDefinedTableFunction(ctx, sql.UserDefinedTableFunction{
+		Name:    "split",
+		MinArgs: 3,
+		MaxArgs: 3,
+		Schema: func(ctx *sql.Context, args []sql.Expression) (sql.Schema, error) {
+			lit, ok := args[2].(*expression.Literal)
+			if !ok {
+				return nil, fmt.Errorf("split expects a literal column name")
+			}
+			return sql.Schema{
+				{Name: "ordinal", Type: types.Int64},
+				{Name: lit.Value().(string), Type: types.LongText, Nullable: true},
+			}, nil
+		},
+		RowIter: func(ctx *sql.Context, args []interface{}) (sql.RowIter, error) {
+			if args[0] == nil {
+				return sql.RowsToRowIter(), nil
+			}
+			var rows []sql.Row
+			for i, part := range strings.Split(args[0].(string), args[1].(string)) {
+				rows = append(rows, sql.Row{int64(i + 1), part})
+			}
+			return sql.RowsToRowIter(rows...), nil
+		},
+	}))
+
+	err := e.RegisterUserDefinedTableFunction(ctx, sql.UserDefinedTableFunction{Name: "SPLIT", Schema: func(ctx *sql.Context, args []sql.Expression) (sql.Schema, error) { return nil, nil }, RowIter: func(ctx *sql.Context, args []interface{}) (sql.RowIter, error) { return nil, nil }})
+	require.True(sql.ErrUserDefinedFunctionExists.Is(err))
+	err = e.RegisterUserDefinedTableFunction(ctx, sql.UserDefinedTableFunction{Name: "no_rows"})
+	require.True(sql.ErrInvalidUserDefinedFunction.Is(err))
+
+	_, err = query("create table t (id int primary key, tags varchar(50))")
+	require.NoError(err)
+	_, err = query("insert into t values (1, 'a,b'), (2, 'c'), (3, null)")
+	require.NoError(err)
+
+	rows, err := query("select * from generate_series(1, 3)")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}, {int64(2)}, {int64(3)}}, rows)
+
+	rows, err = query("select s.value * 2 from generate_series(0, 10, 5) as s where s.value > 0")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(10)}, {int64(20)}}, rows)
+
+	// arguments may refer to the tables before the function in the FROM clause
+	rows, err = query("select t.id, s.ordinal, s.tag from t, split(t.tags, ',', 'tag') s order by t.id, s.ordinal")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), int64(1), "a"}, {int32(1), int64(2), "b"}, {int32(2), int64(1), "c"}}, rows)
+
+	rows, err = query("select t.id, s.tag from t left join split(t.tags, ',', 'tag') s on true where s.ordinal is null or s.ordinal = 1 order by t.id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "a"}, {int32(2), "c"}, {int32(3), nil}}, rows)
+
+	rows, err = query("select a.value, b.value from generate_series(1, 3) a join generate_series(a.value, 3) b order by 1, 2")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1), int64(1)}, {int64(1), int64(2)}, {int64(1), int64(3)}, {int64(2), int64(2)}, {int64(2), int64(3)}, {int64(3), int64(3)}}, rows)
+
+	// or to the columns of an outer query
+	rows, err = query("select id, (select count(*) from split(tags, ',', 'x')) from t order by id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), int64(2)}, {int32(2), int64(1)}, {int32(3), int64(0)}}, rows)
+
+	_, err = query("select * from generate_series(1)")
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+	_, err = query("select * from split('a', ',', concat('x'))")
+	require.Error(err)
+
+	require.NoError(e.DropUserDefinedTableFunction(ctx, "generate_series"))
+	require.True(sql.ErrUserDefinedFunctionNotDefined.Is(e.DropUserDefinedTableFunction(ctx, "generate_series")))
+	_, err = query("select * from generate_series(1, 3)")
+	require.True(sql.ErrTableFunctionNotFound.Is(err))
+}
+
+func TestDumpAndRestore(t *testing.T) {
+	require := require.New(t)
+	newEngine := func(dbs ...sql.Database) (*Engine, func(string) ([]sql.Row, error), *sql.Context) {
+		provider := memory.NewDBProvider(dbs...)
+		e := NewDefault(provider)
+		sess := memory.NewSession(sql.NewBaseSession(), provider)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+		if len(dbs) > 0 {
+			sess.SetCurrentDatabase(dbs[0].Name())
+		}
+		return e, func(q string) ([]sql.Row, error) {
+			_, iter, _, err := e.Query(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			return sql.RowIterToRows(ctx, iter)
+		}, ctx
+	}
+
+	e, query, ctx := newEngine(memory.NewDatabase("db"))
+	for _, q := range []string{
+		"create table p (id int primary key, name varchar(20) default 'x', unique key uk (name))",
+		"create table c (id int primary key auto_increment, pid int, note text, b bit(3), bin varbinary(10), j json, " +
+			"g geometry, dt datetime(6), d decimal(5,2), e enum('x','y'), s set('a','b'), f float, tm time, " +
+			"gen int generated always as (id * 2) stored, constraint fk1 foreign key (pid) references p (id) on delete cascade, " +
+			"constraint chk check (d > 0), index idx_note (note(10)))",
+		`insert into p values (1, 'it''s'), (2, 'back\\slash'), (3, 'ünïcode')`,
+		`insert into c (pid, note, b, bin, j, g, dt, d, e, s, f, tm) values
+			(1, 'line\nnext; -- not a comment', b'101', 0x00ff27, '{"a": "it''s"}', point(1, 2), '2024-01-02 03:04:05.123456', 12.34, 'y', 'a,b', 1.5, '-12:00:01'),
+			(2, null, null, '', null, null, null, null, null, null, null, null)`,
+		"create view v1 as select id, name from p",
+		"create view v0 as select count(*) as n from v1",
+		"create trigger trg before insert on c for each row begin set new.note = concat(new.note, ';'); set new.d = 1; end",
+		"create procedure proc(in a int) begin select a; select a + 1; end",
+	} {
+		_, err := query(q)
+		require.NoError(err, q)
+	}
+
+	var dump bytes.Buffer
+	require.NoError(e.Dump(ctx, &dump, DumpOptions{MaxInsertSize: 60}, "db"))
+	script := dump.String()
+	require.Contains(script, "CREATE DATABASE /*!32312 IF NOT EXISTS*/ `db`")
+	require.Contains(script, "INSERT INTO `p` VALUES (1,'it\\'s'),(2,'back\\\\slash');\nINSERT INTO `p` VALUES (3,'ünïcode');")
+	require.Contains(script, "INSERT INTO `c` (`id`, `pid`, `note`, `b`, `bin`, `j`, `g`, `dt`, `d`, `e`, `s`, `f`, `tm`) VALUES")
+	require.Contains(script, "DELIMITER ;;\ncreate trigger trg")
+	require.Contains(script, "/*!50001 CREATE VIEW `v0` AS SELECT 1 AS `n`*/;")
+
+	// the dump restores to the same database in another engine
+	re, requery, rctx := newEngine()
+	require.NoError(re.Restore(rctx, strings.NewReader(script)))
+	for _, q := range []string{
+		"select * from p order by id",
+		"select id, pid, note, b, bin, j, st_astext(g), dt, d, e, s, f, tm, gen from c order by id",
+		"select * from v0",
+		"show create table c",
+		"show create procedure proc",
+	} {
+		expected, err := query(q)
+		require.NoError(err, q)
+		rows, err := requery("/* restored */ " + strings.Replace(q, " from ", " from db.", 1))
+		require.NoError(err, q)
+		require.Equal(expected, rows, q)
+	}
+	var redump bytes.Buffer
+	require.NoError(re.Dump(rctx, &redump, DumpOptions{MaxInsertSize: 60}, "db"))
+	require.Equal(script, redump.String())
+
+	// the trigger is restored after the rows it would have changed
+	rows, err := requery("select note from db.c where id = 1")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"line\nnext; -- not a comment"}}, rows)
+	rows, err = requery("show create trigger db.trg")
+	require.NoError(err)
+	require.Equal("create trigger trg before insert on c for each row begin set new.note = concat(new.note, ';'); set new.d = 1; end", rows[0][2])
+
+	// options leave out parts of the dump
+	dump.Reset()
+	require.NoError(e.Dump(ctx, &dump, DumpOptions{NoData: true, SkipTriggers: true, SkipRoutines: true}, "db"))
+	require.NotContains(dump.String(), "INSERT INTO")
+	require.NotContains(dump.String(), "TRIGGER")
+	require.NotContains(dump.String(), "PROCEDURE")
+	require.Contains(dump.String(), "CREATE TABLE `c`")
+	dump.Reset()
+	require.NoError(e.Dump(ctx, &dump, DumpOptions{NoCreateInfo: true}, "db"))
+	require.NotContains(dump.String(), "CREATE TABLE")
+	require.NotContains(dump.String(), "CREATE VIEW")
+	require.Contains(dump.String(), "INSERT INTO `p` VALUES")
+
+	// DUMP_DATABASE returns the same dump from SQL
+	rows, err = query("select dump_database('db')")
+	require.NoError(err)
+	dump.Reset()
+	require.NoError(e.Dump(ctx, &dump, DumpOptions{}, "db"))
+	require.Equal([]sql.Row{{dump.String()}}, rows)
+
+	// restoring stops at the first failing statement, and reports its line
+	err = re.Restore(rctx, strings.NewReader("use db;\n-- comment\ninsert into p values (10, 'a');\n\ninsert into missing values (1);\ninsert into p values (11, 'b');"))
+	require.ErrorContains(err, "line 5")
+	rows, err = requery("select id from db.p where id >= 10")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(10)}}, rows)
+}
+
+// mapFileSystem is a sql.FileSystem that keeps its files in memory.
+type mapFileSystem map[string]*bytes.Buffer
+
+func (m mapFileSystem) Open(ctx *sql.Context, name string) (io.ReadCloser, error) {
+	file, ok := m[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return io.NopCloser(bytes.NewReader(file.Bytes())), nil
+}
+
+func (m mapFileSystem) Create(ctx *sql.Context, name string) (io.WriteCloser, error) {
+	if _, ok := m[name]; ok {
+		return nil, sql.ErrFileExists.New(name)
+	}
+	m[name] = &bytes.Buffer{}
+	return nopWriteCloser{m[name]}, nil
+}
+
+func (m mapFileSystem) Resolve(ctx *sql.Context, name string) (string, error) {
+	return path.Clean("/" + name), nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+func TestFileSystem(t *testing.T) {
+	require := require.New(t)
+	require.NoError(sql.SystemVariables.AssignValues(map[string]interface{}{"secure_file_priv": "/secure"}))
+	defer sql.SystemVariables.AssignValues(map[string]interface{}{"secure_file_priv": ""})
+
+	files := mapFileSystem{"/secure/in.csv": bytes.NewBufferString("id,name\n1,\"a,b\"\n2,b\n")}
+	provider := memory.NewDBProvider(memory.NewDatabase("db"))
+	e := NewDefault(provider)
+	sess := memory.NewSession(sql.NewBaseSession(), provider)
+	sess.SetCurrentDatabase("db")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()),
+		sql.WithServices(sql.Services{FileSystem: files}))
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	_, err := query("create table t (id int primary key, name varchar(10))")
+	require.NoError(err)
+	_, err = query("load data infile '/secure/in.csv' into table t fields terminated by ',' enclosed by '\"' ignore 1 lines")
+	require.NoError(err)
+	rows, err := query("select * from t order by id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "a,b"}, {int32(2), "b"}}, rows)
+
+	_, err = query("select * from t order by id into outfile '/secure/out/t.txt'")
+	require.NoError(err)
+	require.Equal("1\ta,b\n2\tb\n", files["/secure/out/t.txt"].String())
+	_, err = query("select * from t order by id into outfile '/secure/out/t.txt'")
+	require.True(sql.ErrFileExists.Is(err), "%v", err)
+	_, err = query("select * from t into outfile '/secure/../t.txt'")
+	require.True(sql.ErrSecureFilePriv.Is(err), "%v", err)
+	_, err = query("load data infile '/other/in.csv' into table t")
+	require.True(sql.ErrLoadDataCannotOpen.Is(err), "%v", err)
+
+	rows, err = query("select load_file('/secure/out/t.txt'), load_file('/secure/missing'), load_file('/other/in.csv')")
+	require.NoError(err)
+	require.Equal([]sql.Row{{[]byte("1\ta,b\n2\tb\n"), nil, nil}}, rows)
+}
+
+func TestRoles(t *testing.T) {
+	require := require.New(t)
+	provider := memory.NewDBProvider(memory.NewDatabase("db"))
+	e := NewDefault(provider)
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	newContext := func(user string) *sql.Context {
+		sess := memory.NewSession(sql.NewBaseSessionWithClientServer("server", sql.Client{User: user, Address: "localhost"}, 1), provider)
+		sess.SetCurrentDatabase("db")
+		return sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+	}
+	query := func(ctx *sql.Context, q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	root := newContext("root")
+	for _, q := range []string{
+		"CREATE TABLE t (i INT PRIMARY KEY)",
+		"INSERT INTO t VALUES (1)",
+		"CREATE USER tester@localhost",
+		"CREATE ROLE reader, writer, nested",
+		"GRANT SELECT ON db.* TO reader",
+		"GRANT INSERT ON db.* TO nested",
+		"GRANT nested TO writer",
+		"GRANT reader, writer TO tester@localhost",
+		"SET DEFAULT ROLE reader TO tester@localhost",
+	} {
+		_, err := query(root, q)
+		require.NoError(err, q)
+	}
+
+	// only the default role is active when the session starts
+	ctx := newContext("tester")
+	rows, err := query(ctx, "SELECT CURRENT_ROLE()")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"`reader`@`%`"}}, rows)
+	_, err = query(ctx, "INSERT INTO t VALUES (2)")
+	require.Error(err)
+
+	// the privileges of nested come through writer
+	_, err = query(ctx, "SET ROLE writer")
+	require.NoError(err)
+	_, err = query(ctx, "INSERT INTO t VALUES (2)")
+	require.NoError(err)
+	_, err = query(ctx, "SELECT * FROM t")
+	require.Error(err)
+
+	_, err = query(ctx, "SET ROLE ALL EXCEPT writer")
+	require.NoError(err)
+	rows, err = query(ctx, "SELECT CURRENT_ROLE()")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"`reader`@`%`"}}, rows)
+
+	_, err = query(ctx, "SET ROLE ALL")
+	require.NoError(err)
+	rows, err = query(ctx, "SELECT CURRENT_ROLE()")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"`reader`@`%`,`writer`@`%`"}}, rows)
+
+	_, err = query(ctx, "SET ROLE NONE")
+	require.NoError(err)
+	_, err = query(ctx, "SELECT * FROM t")
+	require.Error(err)
+
+	_, err = query(ctx, "SET ROLE nested")
+	require.True(sql.ErrRoleNotGranted.Is(err))
+
+	_, err = query(ctx, "SET ROLE DEFAULT")
+	require.NoError(err)
+	rows, err = query(ctx, "SELECT * FROM t")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1)}, {int32(2)}}, rows)
+
+	// revoking a role takes it away from sessions that have it active
+	_, err = query(root, "REVOKE reader FROM tester@localhost")
+	require.NoError(err)
+	_, err = query(ctx, "SELECT * FROM t")
+	require.Error(err)
+}
+
+func TestTablePolicies(t *testing.T) {
+	require := require.New(t)
+	provider := memory.NewDBProvider(memory.NewDatabase("db"))
+	e := NewDefault(provider)
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	newContext := func(user string) *sql.Context {
+		sess := memory.NewSession(sql.NewBaseSessionWithClientServer("server", sql.Client{User: user, Address: "localhost"}, 1), provider)
+		sess.SetCurrentDatabase("db")
+		return sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(NewProcessList()))
+	}
+	query := func(ctx *sql.Context, q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	root := newContext("root")
+	for _, q := range []string{
+		"CREATE TABLE accounts (id INT PRIMARY KEY, tenant VARCHAR(10), ssn VARCHAR(11))",
+		"INSERT INTO accounts VALUES (1, 'alice', '123-45-6789'), (2, 'alice', '234-56-7890'), (3, 'bob', '345-67-8901')",
+		"CREATE VIEW tenant_accounts AS SELECT id, tenant FROM accounts",
+		"CREATE USER alice@localhost, bob@localhost",
+		"CREATE ROLE auditor",
+		"GRANT ALL ON db.* TO alice@localhost, bob@localhost",
+		"GRANT auditor TO bob@localhost",
+		"SET DEFAULT ROLE auditor TO bob@localhost",
+	} {
+		_, err := query(root, q)
+		require.NoError(err, q)
+	}
+
+	// each tenant only sees its own rows, and only auditors see social security numbers
+	require.NoError(e.RegisterTablePolicy(root, sql.TablePolicy{
+		Name:     "tenant_isolation",
+		Database: "db",
+		Table:    "accounts",
+		Apply: func(ctx *sql.Context) (*sql.TableRestriction, error) {
+			user := ctx.Session.Client().User
+			if user == "root" {
+				return nil, nil
+			}
+			return &sql.TableRestriction{RowFilter: fmt.Sprintf("tenant = '%s'", user)}, nil
+		},
+	}))
+	require.NoError(e.RegisterTablePolicy(root, sql.TablePolicy{
+		Name:  "ssn_mask",
+		Table: "ACCOUNTS",
+		Apply: func(ctx *sql.Context) (*sql.TableRestriction, error) {
+			if ctx.Session.Client().User == "root" {
+				return nil, nil
+			}
+			roles, _ := ctx.Session.GetActiveRoles()
+			for _, role := range roles {
+				if role.Name == "auditor" {
+					return nil, nil
+				}
+			}
+			return &sql.TableRestriction{ColumnMasks: map[string]string{"ssn": "concat('***-**-', right(ssn, 4))"}}, nil
+		},
+	}))
+	err := e.RegisterTablePolicy(root, sql.TablePolicy{Name: "SSN_MASK", Table: "accounts", Apply: func(ctx *sql.Context) (*sql.TableRestriction, error) { return nil, nil }})
+	require.True(sql.ErrTablePolicyExists.Is(err))
+	err = e.RegisterTablePolicy(root, sql.TablePolicy{Name: "no_apply", Table: "accounts"})
+	require.True(sql.ErrInvalidTablePolicy.Is(err))
+
+	rows, err := query(root, "SELECT id, ssn FROM accounts ORDER BY id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "123-45-6789"}, {int32(2), "234-56-7890"}, {int32(3), "345-67-8901"}}, rows)
+
+	alice := newContext("alice")
+	rows, err = query(alice, "SELECT * FROM accounts ORDER BY id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "alice", "***-**-6789"}, {int32(2), "alice", "***-**-7890"}}, rows)
+	rows, err = query(alice, "SELECT a.id, b.id FROM db.accounts a JOIN accounts b ON a.id + 1 = b.id WHERE a.ssn LIKE '%6789'")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), int32(2)}}, rows)
+	rows, err = query(alice, "SELECT ssn FROM accounts WHERE id = 3")
+	require.NoError(err)
+	require.Empty(rows)
+	rows, err = query(alice, "SELECT (SELECT count(*) FROM accounts), count(*) FROM tenant_accounts")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(2), int64(2)}}, rows)
+
+	bob := newContext("bob")
+	rows, err = query(bob, "SELECT id, ssn FROM accounts")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(3), "345-67-8901"}}, rows)
+
+	// the rows that a tenant may change are filtered too
+	_, err = query(alice, "UPDATE accounts SET ssn = '000-00-0000'")
+	require.NoError(err)
+	_, err = query(alice, "UPDATE accounts a LEFT JOIN accounts b ON a.id = b.id + 2 SET a.tenant = 'alice' WHERE b.id IS NULL")
+	require.NoError(err)
+	_, err = query(alice, "DELETE FROM accounts WHERE id > 1")
+	require.NoError(err)
+	rows, err = query(root, "SELECT * FROM accounts ORDER BY id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "alice", "000-00-0000"}, {int32(3), "bob", "345-67-8901"}}, rows)
+
+	// the masked columns of the tables that are changed can't be read, so they can't be copied into other columns, or
+	// be tested by the conditions of the statement to find their values
+	_, err = query(root, "ALTER TABLE accounts ADD COLUMN note VARCHAR(20)")
+	require.NoError(err)
+	for _, q := range []string{
+		"UPDATE accounts SET note = ssn",
+		"UPDATE accounts a SET a.note = concat('x', a.ssn)",
+		"UPDATE accounts SET note = 'match' WHERE ssn LIKE '000%'",
+		"UPDATE accounts SET note = 'first' ORDER BY ssn LIMIT 1",
+		"UPDATE accounts a JOIN tenant_accounts t ON a.ssn = '000-00-0000' AND a.id = t.id SET a.note = 'match'",
+		"DELETE FROM accounts WHERE ssn = '000-00-0000'",
+		"DELETE FROM accounts WHERE EXISTS (SELECT 1 FROM dual WHERE ssn LIKE '0%')",
+	} {
+		_, err = query(alice, q)
+		require.True(sql.ErrMaskedColumnRead.Is(err), q)
+	}
+	_, err = query(bob, "UPDATE accounts SET note = ssn")
+	require.NoError(err)
+	_, err = query(alice, "UPDATE accounts SET ssn = '000-00-0000', note = 'changed' WHERE id = 1")
+	require.NoError(err)
+	rows, err = query(root, "SELECT * FROM accounts ORDER BY id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "alice", "000-00-0000", "changed"}, {int32(3), "bob", "345-67-8901", "345-67-8901"}}, rows)
+
+	// REPLACE and ON DUPLICATE KEY UPDATE change the rows their new rows conflict with, which may be hidden, so they
+	// can't write a restricted table
+	for _, q := range []string{
+		"INSERT INTO accounts (id, tenant, ssn) VALUES (3, 'alice', '') ON DUPLICATE KEY UPDATE ssn = 'pwned'",
+		"INSERT INTO accounts (id, tenant) VALUES (4, 'alice') ON DUPLICATE KEY UPDATE note = ssn",
+		"REPLACE INTO accounts (id, tenant, ssn) VALUES (3, 'alice', '999-99-9999')",
+	} {
+		_, err = query(alice, q)
+		require.True(sql.ErrRestrictedTableConflict.Is(err), q)
+	}
+	_, err = query(bob, "REPLACE INTO accounts (id, tenant) VALUES (3, 'bob')")
+	require.True(sql.ErrRestrictedTableConflict.Is(err))
+	_, err = query(alice, "INSERT INTO accounts (id, tenant, ssn) VALUES (4, 'alice', '456-78-9012')")
+	require.NoError(err)
+	_, err = query(root, "INSERT INTO accounts (id, tenant, ssn) VALUES (4, 'alice', '') ON DUPLICATE KEY UPDATE note = 'root'")
+	require.NoError(err)
+	rows, err = query(root, "SELECT * FROM accounts WHERE id > 1 ORDER BY id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(3), "bob", "345-67-8901", "345-67-8901"}, {int32(4), "alice", "456-78-9012", "root"}}, rows)
+	_, err = query(root, "DELETE FROM accounts WHERE id = 4")
+	require.NoError(err)
+	_, err = query(root, "ALTER TABLE accounts DROP COLUMN note")
+	require.NoError(err)
+
+	// handlers read the rows of a table without its filters and masks, so they can't read a restricted table
+	for _, ctx := range []*sql.Context{alice, bob} {
+		_, err = query(ctx, "HANDLER accounts OPEN")
+		require.True(sql.ErrRestrictedTableHandler.Is(err))
+		_, err = query(ctx, "HANDLER accounts READ FIRST")
+		require.True(sql.ErrUnknownTableInHandler.Is(err))
+	}
+	_, err = query(root, "HANDLER accounts OPEN")
+	require.NoError(err)
+	rows, err = query(root, "HANDLER accounts READ FIRST")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "alice", "000-00-0000"}}, rows)
+	require.NoError(e.RegisterTablePolicy(root, sql.TablePolicy{
+		Name:  "hide_all",
+		Table: "accounts",
+		Apply: func(ctx *sql.Context) (*sql.TableRestriction, error) {
+			return &sql.TableRestriction{RowFilter: "false"}, nil
+		},
+	}))
+	_, err = query(root, "HANDLER accounts READ NEXT")
+	require.True(sql.ErrRestrictedTableHandler.Is(err))
+	require.NoError(e.DropTablePolicy(root, "hide_all"))
+	_, err = query(root, "HANDLER accounts CLOSE")
+	require.NoError(err)
+
+	// a policy that masks a column the table doesn't have can't be applied
+	require.NoError(e.RegisterTablePolicy(root, sql.TablePolicy{
+		Name:  "bad_mask",
+		Table: "accounts",
+		Apply: func(ctx *sql.Context) (*sql.TableRestriction, error) {
+			return &sql.TableRestriction{ColumnMasks: map[string]string{"email": "''"}}, nil
+		},
+	}))
+	_, err = query(alice, "SELECT * FROM accounts")
+	require.True(sql.ErrInvalidTablePolicy.Is(err))
+	require.NoError(e.DropTablePolicy(root, "bad_mask"))
+	require.True(sql.ErrTablePolicyNotFound.Is(e.DropTablePolicy(root, "bad_mask")))
+
+	require.NoError(e.DropTablePolicy(root, "tenant_isolation"))
+	rows, err = query(alice, "SELECT id, ssn FROM accounts ORDER BY id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(1), "***-**-0000"}, {int32(3), "***-**-8901"}}, rows)
+}
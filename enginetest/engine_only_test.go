@@ -487,7 +487,7 @@ func TestShowCharset(t *testing.T) {
 		var rows []sql.Row
 		iter := sql.NewCharacterSetsIterator()
 		for charset, ok := iter.Next(); ok; charset, ok = iter.Next() {
-			if charset.Encoder != nil {
+			if charset.ID.IsSupported() {
 				rows = append(rows, sql.Row{
 					charset.Name,
 					charset.Description,
@@ -547,6 +547,23 @@ func TestShowCharset(t *testing.T) {
 				return rows
 			},
 		},
+		{
+			Query: "SHOW CHARACTER SET WHERE Maxlen = 4",
+			RowGen: func(t *testing.T) []sql.Row {
+				return []sql.Row{
+					{"gb18030", "China National Standard GB18030", "gb18030_chinese_ci", uint64(4)},
+					{"utf16", "UTF-16 Unicode", "utf16_general_ci", uint64(4)},
+					{"utf32", "UTF-32 Unicode", "utf32_general_ci", uint64(4)},
+					{"utf8mb4", "UTF-8 Unicode", "utf8mb4_0900_ai_ci", uint64(4)},
+				}
+			},
+		},
+		{
+			Query: `SHOW CHARSET LIKE 'ucs2'`,
+			RowGen: func(t *testing.T) []sql.Row {
+				return nil
+			},
+		},
 		{
 			Query: `SHOW CHARSET WHERE Charset = 'foo'`,
 			RowGen: func(t *testing.T) []sql.Row {
@@ -653,7 +653,7 @@ func TestIndexQueryPlans(t *testing.T, harness Harness) {
 		RunQueryWithContext(t, e, harness, ctx, `CREATE INDEX idx1 ON otherdb.a (y);`)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW INDEXES FROM otherdb.a", []sql.Row{
-			{"a", 1, "idx1", 1, "y", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+			{"a", 1, "idx1", 1, "y", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 		}, nil, nil, nil)
 
 	})
@@ -870,6 +870,31 @@ func TestOrderByGroupBy(t *testing.T, harness Harness) {
 		AssertErr(t, e, harness, "select id, team from members group by team order by id", nil, analyzererrors.ErrValidationGroupBy)
 		AssertErr(t, e, harness, "select any_value(id), team from members group by team order by id", nil, analyzererrors.ErrValidationGroupByOrderBy)
 	})
+
+	t.Run("rollup row order", func(t *testing.T) {
+		e := mustNewEngine(t, harness)
+		defer e.Close()
+		ctx := NewContext(harness)
+
+		RunQueryWithContext(t, e, harness, ctx, "create table sales (id int primary key, region varchar(10), yr int, amount int);")
+		RunQueryWithContext(t, e, harness, ctx, "insert into sales values (1,'west',2021,5),(2,'east',2022,1),(3,'west',2020,2),(4,'east',2021,3),(5,'west',2021,4),(6,'east',null,7);")
+
+		// without an ORDER BY, each super-aggregate row follows the groups it sums up and the grand total comes last
+		_, rowIter, _, err := e.Query(ctx, "select region, yr, sum(amount) from sales group by region, yr with rollup")
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, rowIter)
+		require.NoError(t, err)
+		require.Equal(t, []sql.Row{
+			{"east", nil, float64(7)},
+			{"east", int32(2021), float64(3)},
+			{"east", int32(2022), float64(1)},
+			{"east", nil, float64(11)},
+			{"west", int32(2020), float64(2)},
+			{"west", int32(2021), float64(9)},
+			{"west", nil, float64(11)},
+			{nil, nil, float64(22)},
+		}, rows)
+	})
 }
 
 func TestReadOnly(t *testing.T, harness Harness, testStoredProcedures bool) {
@@ -1288,10 +1313,37 @@ func TestSelectIntoFile(t *testing.T, harness Harness) {
 			query: "select * from mytable into outfile '../outfile.txt';",
 			err:   sql.ErrSecureFilePriv,
 		},
+		{
+			file:    "outfile.txt",
+			query:   "select * from mytable into outfile 'outfile.txt' charset binary;",
+			expRows: []sql.Row{{types.NewOkResult(3)}},
+			exp: "" +
+				"1\tfirst row\n" +
+				"2\tsecond row\n" +
+				"3\tthird row\n",
+		},
+		{
+			file:    "outfile.txt",
+			query:   "select 'café', '€' into outfile 'outfile.txt' character set latin1;",
+			expRows: []sql.Row{{types.NewOkResult(1)}},
+			exp:     "caf\xe9\t\x80\n",
+		},
 		{
 			file:  "outfile.txt",
-			query: "select * from mytable into outfile 'outfile.txt' charset binary;",
-			err:   sql.ErrUnsupportedFeature,
+			query: "select * from mytable into outfile 'outfile.txt' charset nonsense;",
+			err:   sql.ErrCharSetUnknown,
+		},
+		{
+			file:    "outfile.txt",
+			query:   "select 'a,b', 'c\"d', 'e\\\\f', 'g\\nh', 'NULL', null, 1.5 into outfile 'outfile.txt' fields terminated by ',';",
+			expRows: []sql.Row{{types.NewOkResult(1)}},
+			exp:     "a\\,b,c\"d,e\\\\f,g\\\nh,NULL,\\N,1.5\n",
+		},
+		{
+			file:    "outfile.txt",
+			query:   "select 'a,b', 'c\"d', 'g\\nh', null, 1.5 into outfile 'outfile.txt' fields terminated by ',' optionally enclosed by '\"';",
+			expRows: []sql.Row{{types.NewOkResult(1)}},
+			exp:     "\"a,b\",\"c\\\"d\",\"g\nh\",\\N,1.5\n",
 		},
 	}
 
@@ -1340,6 +1392,43 @@ func TestSelectIntoFile(t *testing.T, harness Harness) {
 
 	AssertErrWithCtx(t, e, harness, ctx, "SELECT * FROM mytable INTO OUTFILE './exists.txt'", nil, sql.ErrFileExists)
 	AssertErrWithCtx(t, e, harness, ctx, "SELECT * FROM mytable LIMIT 1 INTO DUMPFILE './exists.txt'", nil, sql.ErrFileExists)
+
+	// files written with any options are read back by LOAD DATA with the same options
+	RunQueryWithContext(t, e, harness, ctx, "create table roundtrip (i int primary key, s varchar(40), d double)")
+	RunQueryWithContext(t, e, harness, ctx, "create table roundtrip_loaded (i int primary key, s varchar(40), d double)")
+	RunQueryWithContext(t, e, harness, ctx, `insert into roundtrip values
+		(1, 'plain', 1.5), (2, 'comma, and tab\t', null), (3, 'quote " and backslash \\', 0),
+		(4, 'new\nline', -2), (5, 'NULL', 3), (6, null, 4), (7, '', 5), (8, 'café', 6), (9, 'nul \0 byte', 7)`)
+	options := []string{
+		"",
+		"fields terminated by ','",
+		"fields terminated by ',' enclosed by '\"'",
+		"fields terminated by ',' optionally enclosed by '\"' lines terminated by '\r\n'",
+		"fields terminated by '|' escaped by '$' lines starting by '>>' terminated by ';'",
+		"fields terminated by ',' enclosed by '\"' escaped by ''",
+		"character set latin1 fields terminated by ','",
+	}
+	for _, opts := range options {
+		t.Run(opts, func(t *testing.T) {
+			os.Remove("roundtrip.txt")
+			defer os.Remove("roundtrip.txt")
+			RunQueryWithContext(t, e, harness, ctx, "delete from roundtrip_loaded")
+			RunQueryWithContext(t, e, harness, ctx, "select * from roundtrip order by i into outfile 'roundtrip.txt' "+opts)
+			RunQueryWithContext(t, e, harness, ctx, "load data infile 'roundtrip.txt' into table roundtrip_loaded "+opts)
+			TestQueryWithContext(t, ctx, e, harness, "select count(*) from roundtrip r join roundtrip_loaded l on r.i = l.i and r.s <=> l.s and r.d <=> l.d", []sql.Row{{9}}, nil, nil, nil)
+		})
+	}
+
+	t.Run("ignore lines", func(t *testing.T) {
+		os.Remove("roundtrip.txt")
+		defer os.Remove("roundtrip.txt")
+		RunQueryWithContext(t, e, harness, ctx, "delete from roundtrip_loaded")
+		RunQueryWithContext(t, e, harness, ctx, "select * from roundtrip order by i into outfile 'roundtrip.txt' fields enclosed by '\"'")
+		RunQueryWithContext(t, e, harness, ctx, "load data infile 'roundtrip.txt' into table roundtrip_loaded fields enclosed by '\"' ignore 4 lines")
+		// the line within the value of row 4 doesn't count
+		TestQueryWithContext(t, ctx, e, harness, "select min(i), count(*) from roundtrip_loaded", []sql.Row{{5, 5}}, nil, nil, nil)
+	})
+	AssertErrWithCtx(t, e, harness, ctx, "load data infile 'roundtrip.txt' into table roundtrip_loaded character set utf16", nil, sql.ErrLoadDataCharacterSet)
 }
 
 func TestReplaceInto(t *testing.T, harness Harness) {
@@ -2336,6 +2425,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 				{
 					"def",                   // trigger_catalog
@@ -2360,6 +2450,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 				{
 					"def",                   // trigger_catalog
@@ -2384,6 +2475,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 				{
 					"def",                   // trigger_catalog
@@ -2408,6 +2500,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 				{
 					"def",                                   // trigger_catalog
@@ -2432,6 +2525,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 				{
 					"def",                                   // trigger_catalog
@@ -2456,6 +2550,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 				{
 					"def",                                   // trigger_catalog
@@ -2480,6 +2575,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 				{
 					"def",                                   // trigger_catalog
@@ -2504,6 +2600,7 @@ func TestShowTriggers(t *testing.T, harness Harness) {
 					sql.Collation_Default.CharacterSet().String(), // character_set_client
 					sql.Collation_Default.String(),                // collation_connection
 					sql.Collation_Default.String(),                // database_collation
+					"YES",                                         // is_valid
 				},
 			},
 		},
@@ -2572,20 +2669,20 @@ func TestStoredProcedures(t *testing.T, harness Harness) {
 		TestQueryWithContext(t, ctx, e, harness, "CREATE PROCEDURE mydb.p2() SELECT 6", []sql.Row{{types.OkResult{}}}, nil, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW PROCEDURE STATUS", []sql.Row{
-			{"mydb", "p1", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p1", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p2", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p2", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p5", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p5", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 		}, nil, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "DROP PROCEDURE mydb.p1", []sql.Row{{types.OkResult{}}}, nil, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW PROCEDURE STATUS", []sql.Row{
-			{"mydb", "p2", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p2", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p5", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p5", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 		}, nil, nil, nil)
 	})
@@ -3090,7 +3187,7 @@ func TestAddColumn(t *testing.T, harness Harness) {
 		TestQueryWithContext(t, ctx, e, harness, "select database()", []sql.Row{{nil}}, nil, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "ALTER TABLE mydb.mytable ADD COLUMN s10 VARCHAR(26)", []sql.Row{{types.NewOkResult(0)}}, nil, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "SHOW FULL COLUMNS FROM mydb.mytable", []sql.Row{
-			{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "'yay'", "", "", "hello"},
+			{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "yay", "", "", "hello"},
 			{"s4", "varchar(1)", "utf8mb4_0900_bin", "NO", "", nil, "", "", ""},
 			{"i", "bigint", nil, "NO", "PRI", nil, "", "", ""},
 			{"s2", "text", "utf8mb4_0900_bin", "YES", "", nil, "", "", "hello"},
@@ -3385,6 +3482,16 @@ func TestFulltextIndexes(t *testing.T, harness Harness) {
 	for _, script := range queries.FulltextTests {
 		TestScript(t, harness, script)
 	}
+	t.Run("Minimum token size", func(t *testing.T) {
+		// innodb_ft_min_token_size is read only, so it's assigned as though it were set at startup
+		require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"innodb_ft_min_token_size": int64(2)}))
+		defer func() {
+			require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"innodb_ft_min_token_size": int64(3)}))
+		}()
+		for _, script := range queries.FulltextMinTokenSizeTests {
+			TestScript(t, harness, script)
+		}
+	})
 	t.Run("Type Hashing", func(t *testing.T) {
 		for _, script := range queries.TypeWireTests {
 			t.Run(script.Name, func(t *testing.T) {
@@ -4016,12 +4123,18 @@ func TestNamedWindows(t *testing.T, harness Harness) {
 	RunQueryWithContext(t, e, harness, ctx, "INSERT INTO b VALUES (1,1,10), (2,1,20), (3,1,30), (4,2,5), (5,2,15)")
 	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(amt) over w2 FROM b WINDOW w1 as (partition by grp), w2 as (w1 order by id) order by id`, []sql.Row{{float64(10)}, {float64(30)}, {float64(60)}, {float64(5)}, {float64(20)}}, nil, nil, nil)
 	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(amt) over (w1 order by id) FROM b WINDOW w1 as (partition by grp) order by id`, []sql.Row{{float64(10)}, {float64(30)}, {float64(60)}, {float64(5)}, {float64(20)}}, nil, nil, nil)
+	// windows may reference windows defined after them, and several window functions may share a window
+	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(amt) over w3, sum(amt) over w2, row_number() over w2 FROM b WINDOW w3 as (w2 rows 1 preceding), w2 as (w1 order by id desc), w1 as (partition by grp) order by id`, []sql.Row{{float64(30), float64(60), int64(3)}, {float64(50), float64(50), int64(2)}, {float64(30), float64(30), int64(1)}, {float64(20), float64(20), int64(2)}, {float64(15), float64(15), int64(1)}}, nil, nil, nil)
 
 	// errors
 	AssertErr(t, e, harness, "SELECT sum(y) over (w1 partition by x) FROM a WINDOW w1 as (partition by z) order by x", nil, sql.ErrInvalidWindowInheritance)
 	AssertErr(t, e, harness, "SELECT sum(y) over (w1 order by x) FROM a WINDOW w1 as (order by z) order by x", nil, sql.ErrInvalidWindowInheritance)
 	AssertErr(t, e, harness, "SELECT sum(y) over (w1 rows unbounded preceding) FROM a WINDOW w1 as (range unbounded preceding) order by x", nil, sql.ErrInvalidWindowInheritance)
 	AssertErr(t, e, harness, "SELECT sum(y) over (w3) FROM a WINDOW w1 as (w2), w2 as (w3), w3 as (w1) order by x", nil, sql.ErrCircularWindowInheritance)
+	AssertErr(t, e, harness, "SELECT sum(y) over w2 FROM a WINDOW w1 as (partition by z) order by x", nil, sql.ErrUnknownWindowName)
+	AssertErr(t, e, harness, "SELECT sum(y) over (w2 order by x) FROM a WINDOW w1 as (partition by z) order by x", nil, sql.ErrUnknownWindowName)
+	AssertErr(t, e, harness, "SELECT sum(y) over w1 FROM a WINDOW w1 as (w2 order by x) order by x", nil, sql.ErrUnknownWindowName)
+	AssertErr(t, e, harness, "SELECT sum(y) over w1 FROM a WINDOW w1 as (partition by z), w1 as (order by x) order by x", nil, sql.ErrDuplicateWindowName)
 
 	// TODO parser needs to differentiate between window replacement and copying -- window frames can't be copied
 	// AssertErr(t, e, harness, "SELECT sum(y) over w FROM a WINDOW (w) as (partition by z order by x rows unbounded preceding) order by x", sql.ErrInvalidWindowInheritance)
@@ -5294,6 +5407,27 @@ func TestAlterTable(t *testing.T, harness Harness) {
 	}
 }
 
+func TestPartitions(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.PartitionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestSequences(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.SequenceScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestStoredFunctions(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.StoredFunctionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
 func NewColumnDefaultValue(expr sql.Expression, outType sql.Type, representsLiteral, isParenthesized, mayReturnNil bool) *sql.ColumnDefaultValue {
 	cdv, err := sql.NewColumnDefaultValue(expr, outType, representsLiteral, isParenthesized, mayReturnNil)
 	if err != nil {
@@ -834,6 +834,18 @@ func TestAlterTable(t *testing.T) {
 	enginetest.TestAlterTable(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestPartitions(t *testing.T) {
+	enginetest.TestPartitions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestSequences(t *testing.T) {
+	enginetest.TestSequences(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestStoredFunctions(t *testing.T) {
+	enginetest.TestStoredFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestDateParse(t *testing.T) {
 	harness := enginetest.NewDefaultMemoryHarness()
 	if harness.IsUsingServer() {
@@ -969,7 +981,14 @@ func TestTypesOverWire(t *testing.T) {
 }
 
 func TestTransactions(t *testing.T) {
-	enginetest.TestTransactionScripts(t, enginetest.NewSkippingMemoryHarness())
+	for _, script := range queries.TransactionTests {
+		switch script.Name {
+		case "Test AUTO INCREMENT with no autocommit":
+			// memory tables allocate AUTO_INCREMENT values per transaction rather than globally
+			continue
+		}
+		enginetest.TestTransactionScript(t, enginetest.NewDefaultMemoryHarness(), script)
+	}
 }
 
 func mergableIndexDriver(dbs []sql.Database) sql.IndexDriver {
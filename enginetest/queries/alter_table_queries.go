@@ -72,6 +72,137 @@ var AlterTableScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "multi alter adding, dropping and indexing columns",
+		SetUpScript: []string{
+			"CREATE TABLE t(pk int primary key, a int, b int, c int)",
+			"INSERT INTO t VALUES (1, 10, 100, 1000), (2, 20, 200, 2000)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column d int default 7, drop column b, add index (c)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `a` int,\n" +
+					"  `c` int,\n" +
+					"  `d` int DEFAULT '7',\n" +
+					"  PRIMARY KEY (`pk`),\n" +
+					"  KEY `c` (`c`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 10, 1000, 7}, {2, 20, 2000, 7}},
+			},
+			{
+				Query:    "alter table t add column e int after pk, drop column a, modify column c bigint after e",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, nil, 1000, 7}, {2, nil, 2000, 7}},
+			},
+			{
+				Query:    "alter table t add column f int default 3, add constraint chk_f check (f > 0)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select pk, f from t order by pk",
+				Expected: []sql.Row{{1, 3}, {2, 3}},
+			},
+		},
+	},
+	{
+		Name: "multi alter is rolled back when a later clause fails",
+		SetUpScript: []string{
+			"CREATE TABLE t(pk int primary key, a int, b int)",
+			"INSERT INTO t VALUES (1, 1, 1), (2, 1, 2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter table t add column c int, drop column b, add unique index (a)",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, 1}, {2, 1, 2}},
+			},
+			{
+				Query: "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `a` int,\n" +
+					"  `b` int,\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:       "alter table t add column c int default -1, add constraint chk_c check (c > 0)",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, 1}, {2, 1, 2}},
+			},
+		},
+	},
+	{
+		Name: "alter table algorithm and lock clauses",
+		SetUpScript: []string{
+			"CREATE TABLE t(pk int primary key, a int, b varchar(10))",
+			"INSERT INTO t VALUES (1, 1, 'one')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column c int, algorithm=instant",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t rename column c to d, algorithm = instant",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t add index idx_a (a), algorithm=inplace, lock=none",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:          "alter table t add index idx_b (b), algorithm=instant",
+				ExpectedErrStr: "ALGORITHM=INSTANT is not supported for this operation. Try ALGORITHM=INPLACE.",
+			},
+			{
+				Query:          "alter table t modify column a bigint, algorithm=inplace",
+				ExpectedErrStr: "ALGORITHM=INPLACE is not supported for this operation. Try ALGORITHM=COPY.",
+			},
+			{
+				Query:          "alter table t modify column a bigint, lock=none",
+				ExpectedErrStr: "LOCK=NONE is not supported for this operation. Try LOCK=SHARED.",
+			},
+			{
+				Query:          "alter table t add column e int, algorithm=copy, lock=none",
+				ExpectedErrStr: "LOCK=NONE is not supported for this operation. Try LOCK=SHARED.",
+			},
+			{
+				Query:       "alter table t add column e int, algorithm=instant, lock=none",
+				ExpectedErr: sql.ErrAlterAlgorithmWrongUsage,
+			},
+			{
+				Query:    "alter table t modify column a bigint, algorithm=copy, lock=shared",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t drop column d, algorithm=default, lock=default",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 1, "one"}},
+			},
+		},
+	},
 	{
 		Name: "variety of alter column statements in a single statement",
 		SetUpScript: []string{
@@ -710,6 +841,81 @@ var AlterTableScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "ALTER TABLE CONVERT TO CHARACTER SET converts columns and their values",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk INT PRIMARY KEY, v1 VARCHAR(10), v2 TINYTEXT, v3 ENUM('a','b'), v4 VARBINARY(10), INDEX (v1)) CHARACTER SET latin1;",
+			"INSERT INTO test VALUES (1, 'héllo', 'wörld', 'a', 'bin');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "ALTER TABLE test CONVERT TO CHARACTER SET utf8mb4;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE test",
+				Expected: []sql.Row{{"test",
+					"CREATE TABLE `test` (\n" +
+						"  `pk` int NOT NULL,\n" +
+						"  `v1` varchar(10),\n" +
+						"  `v2` text,\n" +
+						"  `v3` enum('a','b'),\n" +
+						"  `v4` varbinary(10),\n" +
+						"  PRIMARY KEY (`pk`),\n" +
+						"  KEY `v1` (`v1`)\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci"}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (2, 'x', '😀', 'b', 'b');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT pk, v1, v2, v3 FROM test WHERE v1 = 'héllo';",
+				Expected: []sql.Row{{1, "héllo", "wörld", "a"}},
+			},
+			{
+				Query:       "ALTER TABLE test CONVERT TO CHARACTER SET latin1;",
+				ExpectedErr: types.ErrBadCharsetString,
+			},
+			{
+				Query:    "SELECT TABLE_COLLATION FROM information_schema.TABLES WHERE TABLE_NAME = 'test';",
+				Expected: []sql.Row{{"utf8mb4_0900_ai_ci"}},
+			},
+			{
+				Query:          "ALTER TABLE test CONVERT TO CHARACTER SET latin1, ALGORITHM=INSTANT;",
+				ExpectedErrStr: "ALGORITHM=INSTANT is not supported for this operation. Try ALGORITHM=COPY.",
+			},
+			{
+				Query:    "SET sql_mode = '';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE test CONVERT TO CHARACTER SET latin1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT pk, v1, v2, v4 FROM test ORDER BY pk;",
+				Expected: []sql.Row{{1, "héllo", "wörld", []byte("bin")}, {2, "x", "?", []byte("b")}},
+			},
+			{
+				Query:    "ALTER TABLE test DEFAULT CHARACTER SET utf8mb4;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE test",
+				Expected: []sql.Row{{"test",
+					"CREATE TABLE `test` (\n" +
+						"  `pk` int NOT NULL,\n" +
+						"  `v1` varchar(10) CHARACTER SET latin1 COLLATE latin1_swedish_ci,\n" +
+						"  `v2` text CHARACTER SET latin1 COLLATE latin1_swedish_ci,\n" +
+						"  `v3` enum('a','b') CHARACTER SET latin1 COLLATE latin1_swedish_ci,\n" +
+						"  `v4` varbinary(10),\n" +
+						"  PRIMARY KEY (`pk`),\n" +
+						"  KEY `v1` (`v1`)\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci"}},
+			},
+		},
+	},
 	{
 		Name: "ALTER TABLE ... ALTER ADD CHECK / DROP CHECK",
 		SetUpScript: []string{
@@ -980,7 +1186,7 @@ var AlterTableScripts = []ScriptTest{
 			},
 			{
 				Query:    "show indexes from t2;",
-				Expected: []sql.Row{{"t2", 1, "mySecondIndex", 1, "i", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil}},
+				Expected: []sql.Row{{"t2", 1, "mySecondIndex", 1, "i", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil}},
 			},
 			{
 				Query:    "alter table t3 rename index MYiNDEX3 to anotherIndex;",
@@ -988,7 +1194,7 @@ var AlterTableScripts = []ScriptTest{
 			},
 			{
 				Query:    "show indexes from t3;",
-				Expected: []sql.Row{{"t3", 1, "anotherIndex", 1, "i", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil}},
+				Expected: []sql.Row{{"t3", 1, "anotherIndex", 1, "i", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil}},
 			},
 		},
 	},
@@ -2043,7 +2249,7 @@ var AddColumnScripts = []ScriptTest{
 			{
 				Query: "SHOW FULL COLUMNS FROM mytable",
 				Expected: []sql.Row{
-					{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "'yay'", "", "", "hello"},
+					{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "yay", "", "", "hello"},
 					{"i", "bigint", nil, "NO", "PRI", nil, "", "", ""},
 					{"s2", "text", "utf8mb4_0900_bin", "YES", "", nil, "", "", "hello"},
 					{"s", "varchar(20)", "utf8mb4_0900_bin", "NO", "UNI", nil, "", "", "column s"},
@@ -2071,7 +2277,7 @@ var AddColumnScripts = []ScriptTest{
 			{
 				Query: "SHOW FULL COLUMNS FROM mytable",
 				Expected: []sql.Row{
-					{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "'yay'", "", "", "hello"},
+					{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "yay", "", "", "hello"},
 					{"s4", "varchar(1)", "utf8mb4_0900_bin", "NO", "", nil, "", "", ""},
 					{"i", "bigint", nil, "NO", "PRI", nil, "", "", ""},
 					{"s2", "text", "utf8mb4_0900_bin", "YES", "", nil, "", "", "hello"},
@@ -2100,7 +2306,7 @@ var AddColumnScripts = []ScriptTest{
 			{
 				Query: "SHOW FULL COLUMNS FROM mytable",
 				Expected: []sql.Row{
-					{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "'yay'", "", "", "hello"},
+					{"s3", "varchar(25)", "utf8mb4_0900_bin", "YES", "", "yay", "", "", "hello"},
 					{"s4", "varchar(1)", "utf8mb4_0900_bin", "NO", "", nil, "", "", ""},
 					{"i", "bigint", nil, "NO", "PRI", nil, "", "", ""},
 					{"s2", "text", "utf8mb4_0900_bin", "YES", "", nil, "", "", "hello"},
@@ -2225,8 +2431,8 @@ var RenameColumnScripts = []ScriptTest{
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "ALTER TABLE mytable RENAME COLUMN i2 TO i3",
-				ExpectedErr: sql.ErrCheckConstraintInvalidatedByColumnAlter,
+				Query:    "ALTER TABLE mytable RENAME COLUMN i2 TO i3",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
 				Query:    "ALTER TABLE mytable RENAME COLUMN s2 TO s3",
@@ -2236,7 +2442,92 @@ var RenameColumnScripts = []ScriptTest{
 				Query: `SELECT TC.CONSTRAINT_NAME, CC.CHECK_CLAUSE, TC.ENFORCED 
 FROM information_schema.TABLE_CONSTRAINTS TC, information_schema.CHECK_CONSTRAINTS CC 
 WHERE TABLE_SCHEMA = 'mydb' AND TABLE_NAME = 'mytable' AND TC.TABLE_SCHEMA = CC.CONSTRAINT_SCHEMA AND TC.CONSTRAINT_NAME = CC.CONSTRAINT_NAME AND TC.CONSTRAINT_TYPE = 'CHECK';`,
-				Expected: []sql.Row{{"test_check", "(`i2` < 12345)", "YES"}},
+				Expected: []sql.Row{{"test_check", "(`i3` < 12345)", "YES"}},
+			},
+			{
+				Query:       "INSERT INTO mytable VALUES (12345, 'row')",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+		},
+	},
+	{
+		Name: "rename column renames references in checks, views and triggers",
+		SetUpScript: []string{
+			"CREATE TABLE t (a INT PRIMARY KEY, b INT, c VARCHAR(10), CONSTRAINT c1 CHECK (b > 0), CONSTRAINT c2 CHECK (a < 100), CONSTRAINT c3 CHECK (b <> a))",
+			"INSERT INTO t VALUES (1, 2, 'x'), (2, 3, 'y')",
+			"CREATE TABLE u (a INT PRIMARY KEY, b INT)",
+			"INSERT INTO u VALUES (1, 7)",
+			"CREATE VIEW v AS SELECT a, b FROM t WHERE b > 1",
+			"CREATE VIEW v2 AS SELECT t.a, t.b AS tb, u.b FROM t JOIN u ON t.a = u.a",
+			"CREATE VIEW v3 (x, y) AS SELECT a, b FROM t",
+			"CREATE TRIGGER tr BEFORE INSERT ON t FOR EACH ROW SET new.b = new.b + 1",
+			"CREATE TRIGGER tr2 BEFORE INSERT ON t FOR EACH ROW FOLLOWS tr SET new.c = 'z'",
+			"CREATE TRIGGER tr3 AFTER INSERT ON u FOR EACH ROW UPDATE t SET b = b + 1 WHERE t.a = new.a",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "ALTER TABLE t CHANGE COLUMN b bb INT",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `bb` int,\n" +
+					"  `c` varchar(10),\n" +
+					"  PRIMARY KEY (`a`),\n" +
+					"  CONSTRAINT `c1` CHECK ((`bb` > 0)),\n" +
+					"  CONSTRAINT `c2` CHECK ((`a` < 100)),\n" +
+					"  CONSTRAINT `c3` CHECK ((NOT((`bb` = `a`))))\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SELECT * FROM v ORDER BY a",
+				Expected: []sql.Row{{1, 2}, {2, 3}},
+			},
+			{
+				Query:    "SELECT * FROM v2",
+				Expected: []sql.Row{{1, 2, 7}},
+			},
+			{
+				Query:    "SELECT * FROM v3 ORDER BY x",
+				Expected: []sql.Row{{1, 2}, {2, 3}},
+			},
+			{
+				Query:    "SHOW CREATE VIEW v",
+				Expected: []sql.Row{{"v", "CREATE VIEW `v` AS select a, bb as b from t where bb > 1", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (3, 5, 'q')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT INTO u VALUES (2, 0)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY a",
+				Expected: []sql.Row{{1, 2, "x"}, {2, 4, "y"}, {3, 6, "z"}},
+			},
+			{
+				Query:    "ALTER TABLE t RENAME COLUMN bb TO b2, RENAME COLUMN a TO a2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT * FROM v ORDER BY a",
+				Expected: []sql.Row{{1, 2}, {2, 4}, {3, 6}},
+			},
+			{
+				Query:    "SELECT * FROM v2 ORDER BY a",
+				Expected: []sql.Row{{1, 2, 7}, {2, 4, 0}},
+			},
+			{
+				Query:    "SELECT trigger_name, action_order FROM information_schema.triggers WHERE event_object_table = 't' ORDER BY 2",
+				Expected: []sql.Row{{"tr", 1}, {"tr2", 2}},
+			},
+			{
+				Query:       "INSERT INTO t VALUES (4, -1, 'w')",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
 			},
 		},
 	},
@@ -2369,6 +2660,57 @@ var ModifyColumnScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "moving columns keeps rows and indexes",
+		SetUpScript: []string{
+			"CREATE TABLE reorder (a INT, b VARCHAR(10), c INT, PRIMARY KEY (a, c), KEY bc (b, c))",
+			"INSERT INTO reorder VALUES (1, 'x', 10), (2, 'y', 20), (3, 'z', 30)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "ALTER TABLE reorder MODIFY c INT NOT NULL FIRST",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT * FROM reorder ORDER BY a",
+				Expected: []sql.Row{{10, 1, "x"}, {20, 2, "y"}, {30, 3, "z"}},
+			},
+			{
+				Query:    "SELECT a FROM reorder WHERE b = 'y' AND c = 20",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "ALTER TABLE reorder CHANGE a aa INT NOT NULL AFTER b",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE reorder",
+				Expected: []sql.Row{{"reorder", "CREATE TABLE `reorder` (\n" +
+					"  `c` int NOT NULL,\n" +
+					"  `b` varchar(10),\n" +
+					"  `aa` int NOT NULL,\n" +
+					"  PRIMARY KEY (`aa`,`c`),\n" +
+					"  KEY `bc` (`b`,`c`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SELECT * FROM reorder ORDER BY aa",
+				Expected: []sql.Row{{10, "x", 1}, {20, "y", 2}, {30, "z", 3}},
+			},
+			{
+				Query:    "INSERT INTO reorder VALUES (40, 'w', 4)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT aa, b FROM reorder WHERE c = 40 AND aa = 4",
+				Expected: []sql.Row{{4, "w"}},
+			},
+			{
+				Query:    "SELECT c FROM reorder WHERE b > 'x' ORDER BY b",
+				Expected: []sql.Row{{20}, {30}},
+			},
+		},
+	},
 	{
 		Name:        "error cases",
 		SetUpScript: []string{},
@@ -114,8 +114,8 @@ var CharsetCollationEngineTests = []ScriptTest{
 				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:       "ALTER TABLE test3 MODIFY COLUMN v1 VARCHAR(255) COLLATE utf8mb4_sr_latn_0900_as_cs;",
-				ExpectedErr: sql.ErrCollationNotYetImplementedTemp,
+				Query:    "ALTER TABLE test3 MODIFY COLUMN v1 VARCHAR(255) COLLATE utf8mb4_sr_latn_0900_as_cs;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 		},
 	},
@@ -1398,4 +1398,107 @@ T.TABLE_SCHEMA AS 'database', T.TABLE_CATALOG AS 'catalog',
 			},
 		},
 	},
+	{
+		Name: "utf8mb4_0900 collations sharing a tailoring",
+		SetUpScript: []string{
+			"CREATE TABLE nb (v VARCHAR(20) COLLATE utf8mb4_nb_0900_ai_ci);",
+			"INSERT INTO nb VALUES ('å'), ('Z'), ('ø'), ('a'), ('æ'), ('Ø');",
+			"CREATE TABLE bs (pk VARCHAR(20) COLLATE utf8mb4_bs_0900_ai_ci PRIMARY KEY);",
+			"INSERT INTO bs VALUES ('d'), ('č'), ('c');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT DISTINCT LOWER(v) FROM nb ORDER BY v;",
+				Expected: []sql.Row{{"a"}, {"z"}, {"æ"}, {"ø"}, {"å"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM nb GROUP BY v ORDER BY MIN(v);",
+				Expected: []sql.Row{{1}, {1}, {1}, {2}, {1}},
+			},
+			{
+				Query:    "SELECT COUNT(DISTINCT v) FROM nb;",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "SELECT * FROM bs ORDER BY pk;",
+				Expected: []sql.Row{{"c"}, {"č"}, {"d"}},
+			},
+			{
+				Query:    "SELECT * FROM bs WHERE pk = 'Č';",
+				Expected: []sql.Row{{"č"}},
+			},
+			{
+				Query:    "SELECT * FROM bs WHERE pk > 'C' ORDER BY pk;",
+				Expected: []sql.Row{{"č"}, {"d"}},
+			},
+			{
+				Query:    "SELECT COLLATION_NAME, PAD_ATTRIBUTE FROM information_schema.COLLATIONS WHERE COLLATION_NAME LIKE 'utf8mb4_sr_latn%' ORDER BY 1;",
+				Expected: []sql.Row{{"utf8mb4_sr_latn_0900_ai_ci", "NO PAD"}, {"utf8mb4_sr_latn_0900_as_cs", "NO PAD"}},
+			},
+		},
+	},
+	{
+		Name: "PAD SPACE collations ignore trailing spaces",
+		SetUpScript: []string{
+			"CREATE TABLE pad (v VARCHAR(20) COLLATE utf8mb4_general_ci);",
+			"INSERT INTO pad VALUES ('a'), ('A  '), ('a '), ('a\t');",
+			"CREATE TABLE nopad (v VARCHAR(20) COLLATE utf8mb4_0900_ai_ci);",
+			"INSERT INTO nopad VALUES ('a'), ('A  '), ('a '), ('a\t');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT COUNT(*) FROM pad WHERE v = 'a';",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT COUNT(DISTINCT v) FROM pad;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM pad GROUP BY v ORDER BY 1;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT HEX(v) FROM pad ORDER BY v, HEX(v) LIMIT 1;",
+				Expected: []sql.Row{{"6109"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM nopad WHERE v = 'a';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT COUNT(DISTINCT v) FROM nopad;",
+				Expected: []sql.Row{{4}},
+			},
+		},
+	},
+	{
+		Name: "legacy multi-byte character sets",
+		SetUpScript: []string{
+			"CREATE TABLE legacy (pk INT PRIMARY KEY, g VARCHAR(10) CHARACTER SET gbk, s VARCHAR(10) CHARACTER SET sjis);",
+			"INSERT INTO legacy VALUES (1, '中文', '日本'), (2, 'abc', 'abc'), (3, 'ABC', 'ABC');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT HEX(g), HEX(s), LENGTH(g), CHAR_LENGTH(g) FROM legacy WHERE pk = 1;",
+				Expected: []sql.Row{{"D6D0CEC4", "93FA967B", 4, 2}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM legacy WHERE g = 'abc';",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM legacy WHERE g COLLATE gbk_bin = 'abc';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM legacy ORDER BY g COLLATE gbk_bin;",
+				Expected: []sql.Row{{3}, {2}, {1}},
+			},
+			{
+				Query:    "SELECT HEX(CONVERT('中文' USING gb18030)), HEX(CONVERT('Ωμέγα' USING greek));",
+				Expected: []sql.Row{{"D6D0CEC4", "D9ECDDE3E1"}},
+			},
+		},
+	},
 }
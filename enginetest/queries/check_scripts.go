@@ -305,6 +305,42 @@ CREATE TABLE t4
 			},
 		},
 	},
+	{
+		Name: "adding check constraint validates existing rows unless it is not enforced",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk int PRIMARY KEY, a int, b int, c int AS (a + b) VIRTUAL)",
+			"INSERT INTO test (pk, a, b) VALUES (1, 1, 2), (2, 3, 4)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "ALTER TABLE test ADD CONSTRAINT sum_check CHECK (c < 5)",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:       "ALTER TABLE test ADD CONSTRAINT order_check CHECK (a > b)",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "ALTER TABLE test ADD CONSTRAINT sum_check CHECK (c < 5) NOT ENFORCED",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE test ADD CONSTRAINT order_check CHECK (a < b)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SELECT constraint_name, enforced FROM information_schema.table_constraints WHERE table_name = 'test' AND constraint_type = 'CHECK' ORDER BY constraint_name",
+				Expected: []sql.Row{
+					{"order_check", "YES"},
+					{"sum_check", "NO"},
+				},
+			},
+			{
+				Query:    "INSERT INTO test (pk, a, b) VALUES (3, 5, 6)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+		},
+	},
 	{
 		Name: "duplicate indexes still returns correct results",
 		SetUpScript: []string{
@@ -500,6 +536,39 @@ var ChecksOnInsertScripts = []ScriptTest{
 }
 
 var ChecksOnUpdateScriptTests = []ScriptTest{
+	{
+		Name: "updates of columns referenced by generated columns and multi-column checks",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (a int PRIMARY KEY, b int, c int, d int AS (b * 2) VIRTUAL, e int AS (c + 1) STORED, CONSTRAINT chk1 CHECK (d < 20), CONSTRAINT chk2 CHECK (b <= e))",
+			"INSERT INTO t1 (a, b, c) VALUES (1, 1, 5), (2, 2, 5)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "UPDATE t1 SET b = 10 WHERE a = 1",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:       "UPDATE t1 SET c = 0 WHERE a = 2",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "UPDATE t1 SET b = 6",
+				Expected: []sql.Row{{NewUpdateResult(2, 2)}},
+			},
+			{
+				Query:       "UPDATE t1 SET b = 7",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "UPDATE t1 SET c = 8, b = 9",
+				Expected: []sql.Row{{NewUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "SELECT * FROM t1 ORDER BY a",
+				Expected: []sql.Row{{1, 9, 8, 18, 9}, {2, 9, 8, 18, 9}},
+			},
+		},
+	},
 	{
 		Name: "Single table updates",
 		SetUpScript: []string{
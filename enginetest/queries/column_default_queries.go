@@ -489,7 +489,7 @@ var ColumnDefaultTests = []ScriptTest{
 			{
 				Query: "desc t33",
 				Expected: []sql.Row{
-					{"pk", "varchar(100)", "NO", "PRI", "(replace(uuid(), '-', ''))", "DEFAULT_GENERATED"},
+					{"pk", "varchar(100)", "NO", "PRI", "replace(uuid(), '-', '')", "DEFAULT_GENERATED"},
 					{"v1_new", "timestamp(6)", "YES", "", "CURRENT_TIMESTAMP(6)", "DEFAULT_GENERATED"},
 					{"v2", "varchar(100)", "YES", "", nil, ""},
 					{"v3", "datetime(6)", "YES", "", "CURRENT_TIMESTAMP(6)", "DEFAULT_GENERATED"},
@@ -882,7 +882,7 @@ var ColumnDefaultTests = []ScriptTest{
 			{
 				Query: "describe t;",
 				Expected: []sql.Row{
-					{"f", "float", "YES", "", "('1.23000')", "DEFAULT_GENERATED"},
+					{"f", "float", "YES", "", "'1.23000'", "DEFAULT_GENERATED"},
 				},
 			},
 			{
@@ -915,7 +915,7 @@ var ColumnDefaultTests = []ScriptTest{
 			{
 				Query: "describe t;",
 				Expected: []sql.Row{
-					{"i", "int", "YES", "", "(1)", "DEFAULT_GENERATED"},
+					{"i", "int", "YES", "", "1", "DEFAULT_GENERATED"},
 				},
 			},
 			{
@@ -191,62 +191,62 @@ var ConvertTests = []struct {
 	{Field: "f64", Op: "!=", Operand: "'string'", ExpCnt: 1},
 
 	{Field: "i8", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i8", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i8", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i8", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i8", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "i8", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "i8", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "i16", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i16", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i16", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i16", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i16", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "i16", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "i16", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "i32", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i32", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i32", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i32", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i32", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "i32", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "i32", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "i64", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i64", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "i64", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i64", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "i64", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "i64", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "i64", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "u8", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u8", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u8", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u8", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u8", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "u8", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "u8", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "u16", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u16", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u16", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u16", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u16", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "u16", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "u16", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "u32", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u32", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u32", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u32", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u32", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "u32", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "u32", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "u64", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u64", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "u64", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u64", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "u64", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "u64", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "u64", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "f32", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "f32", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "f32", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "f32", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "f32", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "f32", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "f32", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 
 	{Field: "f64", Op: "=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "f64", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
-	{Field: "f64", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "f64", Op: "<=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
+	{Field: "f64", Op: ">=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 0},
 	{Field: "f64", Op: "<>", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 	{Field: "f64", Op: "!=", Operand: "STR_TO_DATE('21,5,2013','%d,%m,%Y');", ExpCnt: 1},
 }
@@ -404,7 +404,7 @@ var CreateTableScriptTests = []ScriptTest{
 			{
 				Query: "SHOW KEYS FROM ost_user__cdata WHERE Key_name = 'PRIMARY'",
 				Expected: []sql.Row{
-					{"ost_user__cdata", 0, "PRIMARY", 1, "user_id", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"ost_user__cdata", 0, "PRIMARY", 1, "user_id", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 				},
 			},
 		},
@@ -440,7 +440,7 @@ var CreateTableScriptTests = []ScriptTest{
 			{
 				Query: "SHOW KEYS FROM t2 WHERE Key_name = 'PRIMARY'",
 				Expected: []sql.Row{
-					{"t2", 0, "PRIMARY", 1, "a", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t2", 0, "PRIMARY", 1, "a", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 				},
 			},
 			{
@@ -457,7 +457,7 @@ var CreateTableScriptTests = []ScriptTest{
 			{
 				Query: "SHOW KEYS FROM indexed WHERE Key_name = 'name'",
 				Expected: []sql.Row{
-					{"indexed", 1, "name", 1, "name", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"indexed", 1, "name", 1, "name", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 				},
 			},
 			{
@@ -488,7 +488,7 @@ var CreateTableScriptTests = []ScriptTest{
 			{
 				Query: "SHOW KEYS FROM uniq WHERE Key_name = 'a'",
 				Expected: []sql.Row{
-					{"uniq", 0, "a", 1, "a", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"uniq", 0, "a", 1, "a", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 				},
 			},
 			{
@@ -710,6 +710,58 @@ var CreateTableScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "time precision",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (pk int primary key, t time(0), t3 time(3), d2 datetime(2), ts timestamp(6) default current_timestamp(6))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table t1",
+				Expected: []sql.Row{{"t1",
+					"CREATE TABLE `t1` (\n" +
+						"  `pk` int NOT NULL,\n" +
+						"  `t` time,\n" +
+						"  `t3` time(3),\n" +
+						"  `d2` datetime(2),\n" +
+						"  `ts` timestamp(6) DEFAULT CURRENT_TIMESTAMP(6),\n" +
+						"  PRIMARY KEY (`pk`)\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select column_name, datetime_precision from information_schema.columns where table_name = 't1' and column_name <> 'pk' order by ordinal_position",
+				Expected: []sql.Row{{"t", 0}, {"t3", 3}, {"d2", 2}, {"ts", 6}},
+			},
+			{
+				Query:    "insert into t1 (pk, t, t3, d2) values (1, '11:12:13.5', '-11:12:13.4567', '2020-01-01 10:00:00.125')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select cast(t as char), cast(t3 as char), cast(d2 as char) from t1 where pk = 1",
+				Expected: []sql.Row{{"11:12:14", "-11:12:13.457", "2020-01-01 10:00:00.13"}},
+			},
+			{
+				Query:    "set sql_mode = concat(@@sql_mode, ',TIME_TRUNCATE_FRACTIONAL')",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t1 (pk, t, t3, d2) values (2, '11:12:13.5', '-11:12:13.4567', '2020-01-01 10:00:00.125')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select cast(t as char), cast(t3 as char), cast(d2 as char) from t1 where pk = 2",
+				Expected: []sql.Row{{"11:12:13", "-11:12:13.456", "2020-01-01 10:00:00.12"}},
+			},
+			{
+				Query:    "select length(cast(curtime() as char)), length(cast(curtime(2) as char)), length(cast(cast('11:12:13.4567' as time(3)) as char))",
+				Expected: []sql.Row{{8, 11, 12}},
+			},
+			{
+				Query:          "create table t4 (pk int primary key, t TIME(7))",
+				ExpectedErrStr: "TIME supports precision from 0 to 6",
+			},
+		},
+	},
 	{
 		Name: "Identifier lengths",
 		SetUpScript: []string{
@@ -172,13 +172,13 @@ var DeleteJoinTests = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "DELETE mytable, tabletest FROM mytable join tabletest where mytable.i=tabletest.i;",
-		ExpectedWriteResult: []sql.Row{{types.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{types.NewOkResult(6)}},
 		SelectQuery:         "SELECT (select count(*) FROM mytable), (SELECT count(*) from tabletest);",
 		ExpectedSelect:      []sql.Row{{0, 0}},
 	},
 	{
 		WriteQuery:          "DELETE MYTABLE, TABLETEST FROM mytable join tabletest where mytable.i=tabletest.i;",
-		ExpectedWriteResult: []sql.Row{{types.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{types.NewOkResult(6)}},
 		SelectQuery:         "SELECT (select count(*) FROM mytable), (SELECT count(*) from tabletest);",
 		ExpectedSelect:      []sql.Row{{0, 0}},
 	},
@@ -208,7 +208,7 @@ var DeleteJoinTests = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "DELETE FROM mytable, tabletest USING mytable inner join tabletest on mytable.i=tabletest.i;",
-		ExpectedWriteResult: []sql.Row{{types.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{types.NewOkResult(6)}},
 		SelectQuery:         "SELECT (select count(*) FROM mytable), (SELECT count(*) from tabletest);",
 		ExpectedSelect:      []sql.Row{{0, 0}},
 	},
@@ -220,13 +220,13 @@ var DeleteJoinTests = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "DELETE mytable, tabletest FROM mytable join tabletest where mytable.i=tabletest.i and mytable.i = 2;",
-		ExpectedWriteResult: []sql.Row{{types.NewOkResult(1)}},
+		ExpectedWriteResult: []sql.Row{{types.NewOkResult(2)}},
 		SelectQuery:         "SELECT (select count(*) FROM mytable), (SELECT count(*) from tabletest);",
 		ExpectedSelect:      []sql.Row{{2, 2}},
 	},
 	{
 		WriteQuery:          "DELETE tabletest, mytable FROM mytable join tabletest where mytable.i=tabletest.i and mytable.i = 2;",
-		ExpectedWriteResult: []sql.Row{{types.NewOkResult(1)}},
+		ExpectedWriteResult: []sql.Row{{types.NewOkResult(2)}},
 		SelectQuery:         "SELECT (select count(*) FROM mytable), (SELECT count(*) from tabletest);",
 		ExpectedSelect:      []sql.Row{{2, 2}},
 	},
@@ -244,7 +244,7 @@ var DeleteJoinTests = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "with t (n) as (select (1) from dual) delete mytable, tabletest from mytable join tabletest where mytable.i=tabletest.i and mytable.i in (select n from t)",
-		ExpectedWriteResult: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+		ExpectedWriteResult: []sql.Row{{types.OkResult{RowsAffected: 2}}},
 		SelectQuery:         "SELECT (select count(*) FROM mytable), (SELECT count(*) from tabletest);",
 		ExpectedSelect:      []sql.Row{{2, 2}},
 	},
@@ -258,7 +258,7 @@ var DeleteJoinTests = []WriteQueryTest{
 	{
 		// Multiple target tables, join with table function
 		WriteQuery:          "DELETE mytable, tabletest FROM mytable join tabletest on mytable.i=tabletest.i join JSON_TABLE('[{\"x\": 1},{\"x\": 2}]', '$[*]' COLUMNS (x INT PATH '$.x')) as jt on jt.x=mytable.i;",
-		ExpectedWriteResult: []sql.Row{{types.NewOkResult(2)}},
+		ExpectedWriteResult: []sql.Row{{types.NewOkResult(4)}},
 		SelectQuery:         "SELECT (select count(*) FROM mytable), (SELECT count(*) from tabletest);",
 		ExpectedSelect:      []sql.Row{{1, 1}},
 	},
@@ -48,6 +48,25 @@ var ForeignKeyTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "foreign key violations are reported in the format of MySQL",
+		SetUpScript: []string{
+			"CREATE TABLE fk_parent (id INT PRIMARY KEY);",
+			"CREATE TABLE fk_child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_pid FOREIGN KEY (pid) REFERENCES fk_parent (id) ON DELETE CASCADE);",
+			"INSERT INTO fk_parent VALUES (1);",
+			"INSERT INTO fk_child VALUES (1, 1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:          "INSERT INTO fk_child VALUES (2, 2);",
+				ExpectedErrStr: "Cannot add or update a child row: a foreign key constraint fails (`mydb`.`fk_child`, CONSTRAINT `fk_pid` FOREIGN KEY (`pid`) REFERENCES `fk_parent` (`id`) ON DELETE CASCADE)",
+			},
+			{
+				Query:          "UPDATE fk_parent SET id = 2;",
+				ExpectedErrStr: "Cannot delete or update a parent row: a foreign key constraint fails (`mydb`.`fk_child`, CONSTRAINT `fk_pid` FOREIGN KEY (`pid`) REFERENCES `fk_parent` (`id`) ON DELETE CASCADE)",
+			},
+		},
+	},
 	{
 		// See https://github.com/dolthub/dolt/issues/10970
 		Name: "Inline column REFERENCES creates an enforced foreign key",
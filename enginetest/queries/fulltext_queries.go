@@ -1232,4 +1232,143 @@ var FulltextTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Boolean mode",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(200) COLLATE utf8mb4_0900_ai_ci, FULLTEXT idx (v1));",
+			"INSERT INTO test VALUES (1, 'apple banana'), (2, 'Apple cherry'), (3, 'banana cherry'), (4, 'pineapple juice'), (5, 'apples and bananas'), (6, 'cherry banana apple');",
+			"CREATE TABLE keyless (v1 VARCHAR(200), FULLTEXT idx (v1));",
+			"INSERT INTO keyless VALUES ('apple banana'), ('banana cherry'), ('apples and bananas');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('apple banana' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}, {3}, {6}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+apple +banana' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}, {6}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+apple -cherry' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('-apple' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('app*' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}, {5}, {6}},
+			},
+			{
+				Query:    `SELECT pk FROM test WHERE MATCH(v1) AGAINST ('"banana apple"' IN BOOLEAN MODE) ORDER BY pk;`,
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+cherry +(apple juice)' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{2}, {6}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+banana ~cherry' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}, {3}, {6}},
+			},
+			{
+				Query:    "SELECT pk, MATCH(v1) AGAINST ('+apple' IN BOOLEAN MODE) > 0 FROM test ORDER BY pk;",
+				Expected: []sql.Row{{1, true}, {2, true}, {3, false}, {4, false}, {5, false}, {6, true}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+banana* -cherry' IN BOOLEAN MODE) AND pk > 1 ORDER BY pk;",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "SELECT v1 FROM keyless WHERE MATCH(v1) AGAINST ('+banana* -apple' IN BOOLEAN MODE) ORDER BY v1;",
+				Expected: []sql.Row{{"apples and bananas"}, {"banana cherry"}},
+			},
+		},
+	},
+	{
+		Name: "Stopwords",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(200), FULLTEXT idx (v1));",
+			"INSERT INTO test VALUES (1, 'the quick fox'), (2, 'fox about town'), (3, 'the end of the line');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('the') ORDER BY pk;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('the fox') ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+the' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+fox -about' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    `SELECT pk FROM test WHERE MATCH(v1) AGAINST ('"end of the line"' IN BOOLEAN MODE) ORDER BY pk;`,
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SET innodb_ft_enable_stopword = OFF;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('the') ORDER BY pk;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+the' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+fox -about' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    `SELECT pk FROM test WHERE MATCH(v1) AGAINST ('"end of the line"' IN BOOLEAN MODE) ORDER BY pk;`,
+				Expected: []sql.Row{{3}},
+			},
+		},
+	},
+}
+
+// FulltextMinTokenSizeTests are run with innodb_ft_min_token_size set to 2, so that two-letter words are indexed and
+// searched.
+var FulltextMinTokenSizeTests = []ScriptTest{
+	{
+		Name: "Minimum token size",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(200), FULLTEXT idx (v1));",
+			"INSERT INTO test VALUES (1, 'go to ox'), (2, 'ox cart'), (3, 'x y z');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('ox') ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('x y z') ORDER BY pk;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+go' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('+ox -go' IN BOOLEAN MODE) ORDER BY pk;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				// "to" is a stopword
+				Query:    "SELECT pk FROM test WHERE MATCH(v1) AGAINST ('to') ORDER BY pk;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 }
@@ -1975,9 +1975,10 @@ var FunctionQueryTests = []QueryTest{
 		},
 	},
 	{
-		Query: "select length(compress(repeat('a', 1000)))",
+		// the exact length depends on the zlib implementation, MySQL returns 21
+		Query: "select length(compress(repeat('a', 1000))) between 16 and 32",
 		Expected: []sql.Row{
-			{24}, // 21 in MySQL because of library implementation differences
+			{true},
 		},
 	},
 	{
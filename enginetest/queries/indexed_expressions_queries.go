@@ -660,7 +660,7 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 				Expected: []sql.Row{
 					{"pk", "int", "NO", "PRI", nil, ""},
 					{"c1", "int", "YES", "", nil, ""},
-					{"!hidden!idx1!0!0", "bigint", "YES", "UNI", nil, ""},
+					{"!hidden!idx1!0!0", "bigint", "YES", "UNI", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -702,7 +702,7 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 				Expected: []sql.Row{
 					{"pk", "int", "NO", "PRI", nil, ""},
 					{"c1", "int", "YES", "", nil, ""},
-					{"!hidden!idx1!0!0", "bigint", "YES", "MUL", nil, ""},
+					{"!hidden!idx1!0!0", "bigint", "YES", "MUL", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -766,7 +766,7 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 				Expected: []sql.Row{
 					{"pk", "int", "NO", "PRI", nil, ""},
 					{"c1", "int", "YES", "", nil, ""},
-					{"!hidden!idx1!0!0", "bigint", "YES", "UNI", nil, ""},
+					{"!hidden!idx1!0!0", "bigint", "YES", "UNI", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -848,7 +848,7 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 					{"pk", "int", "NO", "PRI", nil, ""},
 					{"c1", "int", "YES", "", nil, ""},
 					{"c2", "varchar(100)", "YES", "", nil, ""},
-					{"!hidden!idx1!0!0", "bigint", "YES", "UNI", nil, ""},
+					{"!hidden!idx1!0!0", "bigint", "YES", "UNI", nil, "VIRTUAL GENERATED"},
 				},
 			},
 		},
@@ -1124,8 +1124,42 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 		},
 	},
 	{
-		// TODO: Parser changes needed in order to support inline functional expression index
-		Skip: true,
+		Name: "functional index created on a table with a virtual generated column",
+		SetUpScript: []string{
+			"CREATE TABLE t2 (a INT PRIMARY KEY, b VARCHAR(20), c INT AS (a+1) VIRTUAL);",
+			"INSERT INTO t2 (a, b) VALUES (1, 'x'), (2, 'Y');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CREATE INDEX fi ON t2 ((LOWER(b)));",
+				Expected: []sql.Row{{gmstypes.NewOkResult(0)}},
+			},
+			{
+				Query:           "SELECT a, c FROM t2 WHERE LOWER(b) = 'y';",
+				Expected:        []sql.Row{{2, 3}},
+				ExpectedIndexes: []string{"fi"},
+			},
+			{
+				Query:    "INSERT INTO t2 (a, b) VALUES (3, 'X');",
+				Expected: []sql.Row{{gmstypes.NewOkResult(1)}},
+			},
+			{
+				Query:           "SELECT a, c FROM t2 WHERE LOWER(b) = 'x' ORDER BY a;",
+				Expected:        []sql.Row{{1, 2}, {3, 4}},
+				ExpectedIndexes: []string{"fi"},
+			},
+			{
+				Query:    "CREATE INDEX fc ON t2 ((c * 2));",
+				Expected: []sql.Row{{gmstypes.NewOkResult(0)}},
+			},
+			{
+				Query:           "SELECT a, b FROM t2 WHERE c * 2 = 6;",
+				Expected:        []sql.Row{{2, "Y"}},
+				ExpectedIndexes: []string{"fc"},
+			},
+		},
+	},
+	{
 		Name: "create functional index inline in table definition",
 		Assertions: []ScriptTestAssertion{
 			{
@@ -1143,10 +1177,54 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 				Expected: []sql.Row{{gmstypes.NewOkResult(2)}},
 			},
 			{
-				Query:           "SELECT pk FROM t WHERE c1 * 10 = 1000;",
+				Query:           "SELECT pk FROM t WHERE 10 * c1 = 1000;",
 				Expected:        []sql.Row{{1}},
 				ExpectedIndexes: []string{"idx1"},
 			},
+			{
+				Query: "SHOW CREATE TABLE t;",
+				Expected: []sql.Row{
+					{
+						"t",
+						"CREATE TABLE `t` (\n" +
+							"  `pk` int NOT NULL,\n" +
+							"  `c1` int DEFAULT NULL,\n" +
+							"  PRIMARY KEY (`pk`),\n" +
+							"  KEY `idx1` (((10 * `c1`)))\n" +
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				},
+			},
+		},
+	},
+	{
+		Name: "create functional index inline in table definition: unnamed indexes and primary keys",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CREATE TABLE t (pk int PRIMARY KEY, c1 int, c2 varchar(20), KEY ((c1 + 1)), UNIQUE KEY ((lower(c2))));",
+				Expected: []sql.Row{{gmstypes.NewOkResult(0)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (1, 10, 'ABC'), (2, 20, 'def');",
+				Expected: []sql.Row{{gmstypes.NewOkResult(2)}},
+			},
+			{
+				Query:           "SELECT pk FROM t WHERE c1 + 1 = 21;",
+				Expected:        []sql.Row{{2}},
+				ExpectedIndexes: []string{"functional_index"},
+			},
+			{
+				Query:           "SELECT pk FROM t WHERE lower(c2) = 'abc';",
+				Expected:        []sql.Row{{1}},
+				ExpectedIndexes: []string{"functional_index_2"},
+			},
+			{
+				Query:          "INSERT INTO t VALUES (3, 30, 'Abc');",
+				ExpectedErrStr: "duplicate unique key given: [abc]",
+			},
+			{
+				Query:       "CREATE TABLE t2 (c1 int, PRIMARY KEY ((c1 + 1)));",
+				ExpectedErr: sql.ErrFunctionalIndexPrimaryKey,
+			},
 		},
 	},
 	{
@@ -1410,8 +1488,8 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 					{"age", "int", "YES", "", nil, ""},
 					{"c1", "int", "YES", "", nil, ""},
 					{"c2", "int", "YES", "", nil, ""},
-					{"!hidden!idx1!0!0", "varchar(100)", "YES", "MUL", nil, ""},
-					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, ""},
+					{"!hidden!idx1!0!0", "varchar(100)", "YES", "MUL", nil, "VIRTUAL GENERATED"},
+					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -1445,8 +1523,8 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 					{"age", "int", "YES", "", nil, ""},
 					{"c1", "int", "YES", "", nil, ""},
 					{"c2", "int", "YES", "", nil, ""},
-					{"!hidden!idx1!0!0", "varchar(100)", "YES", "MUL", nil, ""},
-					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, ""},
+					{"!hidden!idx1!0!0", "varchar(100)", "YES", "MUL", nil, "VIRTUAL GENERATED"},
+					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -1476,8 +1554,8 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 					{"c1", "int", "YES", "", nil, ""},
 					{"c2", "int", "YES", "", nil, ""},
 					{"c3", "int", "YES", "", nil, ""},
-					{"!hidden!idx1!0!0", "bigint", "YES", "MUL", nil, ""},
-					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, ""},
+					{"!hidden!idx1!0!0", "bigint", "YES", "MUL", nil, "VIRTUAL GENERATED"},
+					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -1526,9 +1604,9 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 					{"c1", "int", "YES", "", nil, ""},
 					{"c2", "int", "YES", "", nil, ""},
 					{"c3", "int", "YES", "", nil, ""},
-					{"!hidden!idx2!0!0", "bigint", "YES", "MUL", nil, ""},
-					{"!hidden!idx1!0!0", "bigint", "YES", "MUL", nil, ""},
-					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, ""},
+					{"!hidden!idx2!0!0", "bigint", "YES", "MUL", nil, "VIRTUAL GENERATED"},
+					{"!hidden!idx1!0!0", "bigint", "YES", "MUL", nil, "VIRTUAL GENERATED"},
+					{"!hidden!idx1!2!0", "bigint", "YES", "", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -1543,7 +1621,7 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 					{"c1", "int", "YES", "", nil, ""},
 					{"c2", "int", "YES", "", nil, ""},
 					{"c3", "int", "YES", "", nil, ""},
-					{"!hidden!idx2!0!0", "bigint", "YES", "MUL", nil, ""},
+					{"!hidden!idx2!0!0", "bigint", "YES", "MUL", nil, "VIRTUAL GENERATED"},
 				},
 			},
 			{
@@ -1711,4 +1789,55 @@ var IndexedExpressionsScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "generated columns: filters on the generated expression use the column's index",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk INT PRIMARY KEY, c1 INT, v BIGINT AS (c1 * 10) VIRTUAL, s BIGINT AS (c1 + 1) STORED, d INT AS (c1 / 3), c INT AS (c1), INDEX idx_v (v), INDEX idx_s (s), INDEX idx_d (d), INDEX idx_c (c));",
+			"INSERT INTO test (pk, c1) VALUES (1, 1), (2, 2), (3, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "SELECT pk FROM test WHERE c1 * 10 = 20;",
+				Expected:        []sql.Row{{2}},
+				ExpectedIndexes: []string{"idx_v"},
+			},
+			{
+				Query:           "SELECT pk FROM test WHERE 20 < c1 * 10 ORDER BY pk;",
+				Expected:        []sql.Row{{3}},
+				ExpectedIndexes: []string{"idx_v"},
+			},
+			{
+				Query:           "SELECT pk FROM test WHERE c1 + 1 = 3;",
+				Expected:        []sql.Row{{2}},
+				ExpectedIndexes: []string{"idx_s"},
+			},
+			{
+				// the expression is a decimal, while the column rounds it to an integer
+				Query:           "SELECT pk FROM test WHERE c1 / 3 = 1;",
+				Expected:        []sql.Row{{3}},
+				ExpectedIndexes: []string{},
+			},
+			{
+				Query:           "SELECT pk FROM test WHERE c1 = 1;",
+				Expected:        []sql.Row{{1}},
+				ExpectedIndexes: []string{},
+			},
+		},
+	},
+	{
+		Name: "generated columns: joins on the generated expression use the column's index",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk INT PRIMARY KEY, c1 INT, v BIGINT AS (c1 * 10) VIRTUAL, UNIQUE INDEX idx_v (v));",
+			"CREATE TABLE t2 (t2pk INT PRIMARY KEY, t2c1 INT);",
+			"INSERT INTO test (pk, c1) VALUES (1, 1), (2, 2), (3, 3);",
+			"INSERT INTO t2 VALUES (1, 10), (2, 30);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "SELECT /*+ LOOKUP_JOIN(test, t2) */ pk FROM test JOIN t2 ON (c1*10) = t2c1 ORDER BY pk;",
+				Expected:        []sql.Row{{1}, {3}},
+				ExpectedIndexes: []string{"idx_v"},
+			},
+		},
+	},
 }
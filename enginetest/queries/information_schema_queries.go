@@ -99,6 +99,7 @@ var InfoSchemaQueries = []QueryTest{
 		Expected: []sql.Row{
 			{"administrable_role_authorizations"},
 			{"applicable_roles"},
+			{"background_jobs"},
 			{"character_sets"},
 			{"check_constraints"},
 			{"collations"},
@@ -364,35 +365,35 @@ var InfoSchemaQueries = []QueryTest{
 			&sql.Column{Name: "Expression", Type: types.LongText, Nullable: true},
 		},
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 2, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 1, "s", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 2, "i", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
 		Query: `SHOW INDEXES FROM othertable FROM foo`,
 		Expected: []sql.Row{
-			{"othertable", 0, "PRIMARY", 1, "text", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"othertable", 0, "PRIMARY", 1, "text", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
 		Query: `SHOW INDEXES FROM foo.othertable`,
 		Expected: []sql.Row{
-			{"othertable", 0, "PRIMARY", 1, "text", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"othertable", 0, "PRIMARY", 1, "text", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
 		Query: `SHOW KEYS FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 2, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 1, "s", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 2, "i", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
@@ -510,7 +511,20 @@ var InfoSchemaQueries = []QueryTest{
 	},
 	{
 		Query:    `SELECT count(*) FROM information_schema.COLLATIONS`,
-		Expected: []sql.Row{{286}},
+		Expected: []sql.Row{{241}},
+	},
+	{
+		Query:    `SELECT count(*) FROM information_schema.COLLATION_CHARACTER_SET_APPLICABILITY`,
+		Expected: []sql.Row{{241}},
+	},
+	{
+		Query:    `SELECT collation_name FROM information_schema.COLLATION_CHARACTER_SET_APPLICABILITY WHERE character_set_name = 'utf16' ORDER BY 1 LIMIT 3`,
+		Expected: []sql.Row{{"utf16_bin"}, {"utf16_croatian_ci"}, {"utf16_czech_ci"}},
+	},
+	{
+		// collations without a sort function, or of unsupported character sets, are not reported
+		Query:    `SELECT count(*) FROM information_schema.COLLATIONS WHERE character_set_name IN ('hp8', 'ucs2')`,
+		Expected: []sql.Row{{0}},
 	},
 	{
 		Query: `SELECT * FROM information_schema.COLLATIONS ORDER BY collation_name LIMIT 4`,
@@ -751,8 +765,49 @@ FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = 'mydb' AND INDEX_NAME='P
 		},
 	},
 	{
-		Query:    "select * from information_schema.character_sets;",
-		Expected: []sql.Row{{"utf8mb4", "utf8mb4_0900_ai_ci", "UTF-8 Unicode", uint32(4)}},
+		Query: "select * from information_schema.character_sets order by character_set_name;",
+		Expected: []sql.Row{
+			{"armscii8", "armscii8_general_ci", "ARMSCII-8 Armenian", uint32(1)},
+			{"ascii", "ascii_general_ci", "US ASCII", uint32(1)},
+			{"big5", "big5_chinese_ci", "Big5 Traditional Chinese", uint32(2)},
+			{"binary", "binary", "Binary pseudo charset", uint32(1)},
+			{"cp1250", "cp1250_general_ci", "Windows Central European", uint32(1)},
+			{"cp1251", "cp1251_general_ci", "Windows Cyrillic", uint32(1)},
+			{"cp1256", "cp1256_general_ci", "Windows Arabic", uint32(1)},
+			{"cp1257", "cp1257_general_ci", "Windows Baltic", uint32(1)},
+			{"cp850", "cp850_general_ci", "DOS West European", uint32(1)},
+			{"cp852", "cp852_general_ci", "DOS Central European", uint32(1)},
+			{"cp866", "cp866_general_ci", "DOS Russian", uint32(1)},
+			{"cp932", "cp932_japanese_ci", "SJIS for Windows Japanese", uint32(2)},
+			{"dec8", "dec8_swedish_ci", "DEC West European", uint32(1)},
+			{"eucjpms", "eucjpms_japanese_ci", "UJIS for Windows Japanese", uint32(3)},
+			{"euckr", "euckr_korean_ci", "EUC-KR Korean", uint32(2)},
+			{"gb18030", "gb18030_chinese_ci", "China National Standard GB18030", uint32(4)},
+			{"gb2312", "gb2312_chinese_ci", "GB2312 Simplified Chinese", uint32(2)},
+			{"gbk", "gbk_chinese_ci", "GBK Simplified Chinese", uint32(2)},
+			{"geostd8", "geostd8_general_ci", "GEOSTD8 Georgian", uint32(1)},
+			{"greek", "greek_general_ci", "ISO 8859-7 Greek", uint32(1)},
+			{"hebrew", "hebrew_general_ci", "ISO 8859-8 Hebrew", uint32(1)},
+			{"koi8r", "koi8r_general_ci", "KOI8-R Relcom Russian", uint32(1)},
+			{"koi8u", "koi8u_general_ci", "KOI8-U Ukrainian", uint32(1)},
+			{"latin1", "latin1_swedish_ci", "cp1252 West European", uint32(1)},
+			{"latin2", "latin2_general_ci", "ISO 8859-2 Central European", uint32(1)},
+			{"latin5", "latin5_turkish_ci", "ISO 8859-9 Turkish", uint32(1)},
+			{"latin7", "latin7_general_ci", "ISO 8859-13 Baltic", uint32(1)},
+			{"macroman", "macroman_general_ci", "Mac West European", uint32(1)},
+			{"sjis", "sjis_japanese_ci", "Shift-JIS Japanese", uint32(2)},
+			{"swe7", "swe7_swedish_ci", "7bit Swedish", uint32(1)},
+			{"tis620", "tis620_thai_ci", "TIS620 Thai", uint32(1)},
+			{"ujis", "ujis_japanese_ci", "EUC-JP Japanese", uint32(3)},
+			{"utf16", "utf16_general_ci", "UTF-16 Unicode", uint32(4)},
+			{"utf32", "utf32_general_ci", "UTF-32 Unicode", uint32(4)},
+			{"utf8mb3", "utf8mb3_general_ci", "UTF-8 Unicode", uint32(3)},
+			{"utf8mb4", "utf8mb4_0900_ai_ci", "UTF-8 Unicode", uint32(4)},
+		},
+	},
+	{
+		Query:    "select character_set_name, maxlen from information_schema.character_sets where maxlen > 3 order by 1;",
+		Expected: []sql.Row{{"gb18030", uint32(4)}, {"utf16", uint32(4)}, {"utf32", uint32(4)}, {"utf8mb4", uint32(4)}},
 	},
 	{
 		Query: `show columns from fk_tbl from mydb`,
@@ -1196,16 +1251,16 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 				Expected: []sql.Row{
 					{"p1", "def", "mydb", "p1", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "YES", "CONTAINS SQL", nil, "DEFINER", "NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",
-						"hi", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
+						"hi", "root@localhost", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 					{"p12", "def", "foo", "p12", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "YES", "CONTAINS SQL", nil, "DEFINER", "NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",
-						"hello", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
+						"hello", "root@localhost", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 					{"p2", "def", "mydb", "p2", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "NO", "CONTAINS SQL", nil, "INVOKER", "NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",
 						"", "user@%", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 					{"p21", "def", "mydb", "p21", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "NO", "CONTAINS SQL", nil, "DEFINER", "NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",
-						"", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
+						"", "root@localhost", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 				},
 			},
 		},
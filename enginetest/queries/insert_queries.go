@@ -492,14 +492,14 @@ var InsertQueries = []WriteQueryTest{
 		ExpectedWriteResult: []sql.Row{{types.NewOkResult(2)}},
 		SelectQuery:         "SELECT * FROM mytable WHERE i = 1",
 		ExpectedSelect:      []sql.Row{{int64(1), "hi"}},
-		Skip:                true, // https://github.com/dolthub/dolt/issues/7638
+		Dialect:             "mysql",
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) values (1, 'hi') AS dt ON DUPLICATE KEY UPDATE mytable.s=dt.s",
 		ExpectedWriteResult: []sql.Row{{types.NewOkResult(2)}},
 		SelectQuery:         "SELECT * FROM mytable WHERE i = 1",
-		ExpectedSelect:      []sql.Row{{int64(1), "hir"}},
-		Skip:                true, // https://github.com/dolthub/dolt/issues/7638
+		ExpectedSelect:      []sql.Row{{int64(1), "hi"}},
+		Dialect:             "mysql",
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (s,i) values ('dup',1) ON DUPLICATE KEY UPDATE s=CONCAT(VALUES(s), 'licate')",
@@ -1343,7 +1343,7 @@ var InsertScripts = []ScriptTest{
 			{
 				Query: "insert into auto_pk values (0), (1), (NULL), ()",
 				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 4}},
+					{types.OkResult{RowsAffected: 4, InsertID: 2}},
 				},
 			},
 			{
@@ -1848,6 +1848,48 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "insert on duplicate key with row alias and unique keys",
+		SetUpScript: []string{
+			`create table t (id int primary key, u int unique, c int, v varchar(10))`,
+			`insert into t values (1, 10, 0, 'a'), (2, 20, 0, 'b')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `insert into t values (3, 10, 5, 'x') as new on duplicate key update c = t.c + new.c, v = new.v`,
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    `insert into t values (4, 20, 0, 'b') as new(a, b, x, y) on duplicate key update c = x, v = y`,
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    `insert into t (id, u) values (5, 50) as new(i, j) on duplicate key update u = j`,
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    `insert into t (id, u, c) values (6, 10, 1), (7, 70, 1) on duplicate key update c = t.c + values(c)`,
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    `insert into t values (1, 99, 0, 'z') as new on duplicate key update u = new.u`,
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:       `insert into t values (2, 0, 0, '') as new on duplicate key update u = 99`,
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query: `select * from t order by id`,
+				Expected: []sql.Row{
+					{1, 99, 6, "x"},
+					{2, 20, 0, "b"},
+					{5, 50, nil, nil},
+					{7, 70, 1, nil},
+				},
+			},
+		},
+	},
 	{
 		Name: "Insert throws primary key violations",
 		SetUpScript: []string{
@@ -2914,9 +2956,10 @@ var InsertIgnoreScripts = []ScriptTest{
 				ExpectedErr: types.ErrDataTruncatedForColumnAtRow,
 			},
 			{
-				Query:    "insert ignore into test_table values (1, 'invalid'), (2, 'bye'), (3, null)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 3, InsertID: 1}}},
-				//ExpectedWarning: mysql.ERWarnDataTruncated, // TODO: incorrect code
+				Query:                 "insert ignore into test_table values (1, 'invalid'), (2, 'bye'), (3, null)",
+				Expected:              []sql.Row{{types.OkResult{RowsAffected: 3, InsertID: 1}}},
+				ExpectedWarningsCount: 1,
+				ExpectedWarning:       mysql.ERWarnDataTruncated,
 			},
 			{
 				Query:    "select * from test_table",
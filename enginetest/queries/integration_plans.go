@@ -27,73 +27,69 @@ WHERE
     id NOT IN (SELECT IXUXU FROM THNTS)
 ;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [yk2gw.id:0!null, yk2gw.FTQLQ:1!null]\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [YK2GW.id:0!null, YK2GW.FTQLQ:1!null, YK2GW.TUXML:2, YK2GW.PAEF5:3, YK2GW.RUCY4:4, YK2GW.TPNJ6:5!null, YK2GW.LBL53:6, YK2GW.NB3QS:7, YK2GW.EO7IV:8, YK2GW.MUHJF:9, YK2GW.FM34L:10, YK2GW.TY5RF:11, YK2GW.ZHTLH:12, YK2GW.NPB7W:13, YK2GW.SX3HH:14, YK2GW.ISBNF:15, YK2GW.YA7YB:16, YK2GW.C5YKB:17, YK2GW.QK7KT:18, YK2GW.FFGE6:19, YK2GW.FIIGJ:20, YK2GW.SH3NC:21, YK2GW.NTENA:22, YK2GW.M4AUB:23, YK2GW.X5AIR:24, YK2GW.SAB6M:25, YK2GW.G5QI5:26, YK2GW.ZVQVD:27, YK2GW.YKSSU:28, YK2GW.FHCYT:29]\n" +
-			"     └─ Filter\n" +
-			"         ├─ 1:31 IS NULL\n" +
-			"         └─ LeftOuterMergeJoin\n" +
-			"             ├─ cmp: Eq\n" +
-			"             │   ├─ yk2gw.id:0!null\n" +
-			"             │   └─ thnts.IXUXU:30\n" +
-			"             ├─ IndexedTableAccess(YK2GW)\n" +
-			"             │   ├─ index: [YK2GW.id]\n" +
-			"             │   ├─ static: [{[NULL, ∞)}]\n" +
-			"             │   ├─ colSet: (1-30)\n" +
-			"             │   ├─ tableId: 1\n" +
-			"             │   └─ Table\n" +
-			"             │       ├─ name: YK2GW\n" +
-			"             │       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			" ├─ columns: [YK2GW.id:0!null, YK2GW.FTQLQ:1!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ 1:3 IS NULL\n" +
+			"     └─ LeftOuterMergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ yk2gw.id:0!null\n" +
+			"         │   └─ thnts.IXUXU:2\n" +
+			"         ├─ IndexedTableAccess(YK2GW)\n" +
+			"         │   ├─ index: [YK2GW.id]\n" +
+			"         │   ├─ static: [{[NULL, ∞)}]\n" +
+			"         │   ├─ colSet: (1-30)\n" +
+			"         │   ├─ tableId: 1\n" +
+			"         │   └─ Table\n" +
+			"         │       ├─ name: YK2GW\n" +
+			"         │       └─ columns: [id ftqlq]\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [thnts.IXUXU:0, 1 (bigint)]\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [thnts.IXUXU:0, 1 (bigint)]\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [thnts.IXUXU:2]\n" +
-			"                     └─ IndexedTableAccess(THNTS)\n" +
-			"                         ├─ index: [THNTS.IXUXU]\n" +
-			"                         ├─ static: [{[NULL, ∞)}]\n" +
-			"                         ├─ colSet: (31-34)\n" +
-			"                         ├─ tableId: 2\n" +
-			"                         └─ Table\n" +
-			"                             ├─ name: THNTS\n" +
-			"                             └─ columns: [id nfryn ixuxu fhcyt]\n" +
+			"                 ├─ columns: [thnts.IXUXU:2]\n" +
+			"                 └─ IndexedTableAccess(THNTS)\n" +
+			"                     ├─ index: [THNTS.IXUXU]\n" +
+			"                     ├─ static: [{[NULL, ∞)}]\n" +
+			"                     ├─ colSet: (31-34)\n" +
+			"                     ├─ tableId: 2\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: THNTS\n" +
+			"                         └─ columns: [id nfryn ixuxu fhcyt]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [yk2gw.id, yk2gw.FTQLQ]\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [YK2GW.id, YK2GW.FTQLQ, YK2GW.TUXML, YK2GW.PAEF5, YK2GW.RUCY4, YK2GW.TPNJ6, YK2GW.LBL53, YK2GW.NB3QS, YK2GW.EO7IV, YK2GW.MUHJF, YK2GW.FM34L, YK2GW.TY5RF, YK2GW.ZHTLH, YK2GW.NPB7W, YK2GW.SX3HH, YK2GW.ISBNF, YK2GW.YA7YB, YK2GW.C5YKB, YK2GW.QK7KT, YK2GW.FFGE6, YK2GW.FIIGJ, YK2GW.SH3NC, YK2GW.NTENA, YK2GW.M4AUB, YK2GW.X5AIR, YK2GW.SAB6M, YK2GW.G5QI5, YK2GW.ZVQVD, YK2GW.YKSSU, YK2GW.FHCYT]\n" +
-			"     └─ Filter\n" +
-			"         ├─ 1 IS NULL\n" +
-			"         └─ LeftOuterMergeJoin (estimated cost=5077.210 rows=3122)\n" +
-			"             ├─ cmp: (yk2gw.id = thnts.IXUXU)\n" +
-			"             ├─ IndexedTableAccess(YK2GW)\n" +
-			"             │   ├─ index: [YK2GW.id]\n" +
-			"             │   └─ filters: [{[NULL, ∞)}]\n" +
+			" ├─ columns: [YK2GW.id, YK2GW.FTQLQ]\n" +
+			" └─ Filter\n" +
+			"     ├─ 1 IS NULL\n" +
+			"     └─ LeftOuterMergeJoin (estimated cost=5077.210 rows=3122)\n" +
+			"         ├─ cmp: (yk2gw.id = thnts.IXUXU)\n" +
+			"         ├─ IndexedTableAccess(YK2GW)\n" +
+			"         │   ├─ index: [YK2GW.id]\n" +
+			"         │   ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   └─ columns: [id ftqlq]\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [thnts.IXUXU, 1]\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [thnts.IXUXU, 1]\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [thnts.IXUXU]\n" +
-			"                     └─ IndexedTableAccess(THNTS)\n" +
-			"                         ├─ index: [THNTS.IXUXU]\n" +
-			"                         └─ filters: [{[NULL, ∞)}]\n" +
+			"                 ├─ columns: [thnts.IXUXU]\n" +
+			"                 └─ IndexedTableAccess(THNTS)\n" +
+			"                     ├─ index: [THNTS.IXUXU]\n" +
+			"                     └─ filters: [{[NULL, ∞)}]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [yk2gw.id, yk2gw.FTQLQ]\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [YK2GW.id, YK2GW.FTQLQ, YK2GW.TUXML, YK2GW.PAEF5, YK2GW.RUCY4, YK2GW.TPNJ6, YK2GW.LBL53, YK2GW.NB3QS, YK2GW.EO7IV, YK2GW.MUHJF, YK2GW.FM34L, YK2GW.TY5RF, YK2GW.ZHTLH, YK2GW.NPB7W, YK2GW.SX3HH, YK2GW.ISBNF, YK2GW.YA7YB, YK2GW.C5YKB, YK2GW.QK7KT, YK2GW.FFGE6, YK2GW.FIIGJ, YK2GW.SH3NC, YK2GW.NTENA, YK2GW.M4AUB, YK2GW.X5AIR, YK2GW.SAB6M, YK2GW.G5QI5, YK2GW.ZVQVD, YK2GW.YKSSU, YK2GW.FHCYT]\n" +
-			"     └─ Filter\n" +
-			"         ├─ 1 IS NULL\n" +
-			"         └─ LeftOuterMergeJoin (estimated cost=5077.210 rows=3122) (actual rows=0 loops=1)\n" +
-			"             ├─ cmp: (yk2gw.id = thnts.IXUXU)\n" +
-			"             ├─ IndexedTableAccess(YK2GW)\n" +
-			"             │   ├─ index: [YK2GW.id]\n" +
-			"             │   └─ filters: [{[NULL, ∞)}]\n" +
+			" ├─ columns: [YK2GW.id, YK2GW.FTQLQ]\n" +
+			" └─ Filter\n" +
+			"     ├─ 1 IS NULL\n" +
+			"     └─ LeftOuterMergeJoin (estimated cost=5077.210 rows=3122) (actual rows=0 loops=1)\n" +
+			"         ├─ cmp: (yk2gw.id = thnts.IXUXU)\n" +
+			"         ├─ IndexedTableAccess(YK2GW)\n" +
+			"         │   ├─ index: [YK2GW.id]\n" +
+			"         │   ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   └─ columns: [id ftqlq]\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [thnts.IXUXU, 1]\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [thnts.IXUXU, 1]\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [thnts.IXUXU]\n" +
-			"                     └─ IndexedTableAccess(THNTS)\n" +
-			"                         ├─ index: [THNTS.IXUXU]\n" +
-			"                         └─ filters: [{[NULL, ∞)}]\n" +
+			"                 ├─ columns: [thnts.IXUXU]\n" +
+			"                 └─ IndexedTableAccess(THNTS)\n" +
+			"                     ├─ index: [THNTS.IXUXU]\n" +
+			"                     └─ filters: [{[NULL, ∞)}]\n" +
 			"",
 	},
 	{
@@ -307,7 +303,8 @@ WHERE
 			"                                                 └─ TableAlias(nd)\n" +
 			"                                                     └─ IndexedTableAccess(E2I7U)\n" +
 			"                                                         ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                         └─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         ├─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [pbmrx.id as id, pbmrx.TW55N as TEYBZ, pbmrx.ZH72S as FB6N7]\n" +
@@ -377,7 +374,8 @@ WHERE
 			"                                                 └─ TableAlias(nd)\n" +
 			"                                                     └─ IndexedTableAccess(E2I7U)\n" +
 			"                                                         ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                         └─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         ├─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
@@ -480,15 +478,15 @@ WHERE
 			" │   │           │           │       ├─ columns: [nd.id:9!null]\n" +
 			" │   │           │           │       └─ Filter\n" +
 			" │   │           │           │           ├─ Eq\n" +
-			" │   │           │           │           │   ├─ nd.TW55N:12!null\n" +
+			" │   │           │           │           │   ├─ nd.TW55N:10!null\n" +
 			" │   │           │           │           │   └─ Subquery\n" +
 			" │   │           │           │           │       ├─ cacheable: false\n" +
 			" │   │           │           │           │       ├─ alias-string: select NHMXW.FZXV5 from WGSDC as NHMXW where NHMXW.id = ism.PRUV2\n" +
 			" │   │           │           │           │       └─ Project\n" +
-			" │   │           │           │           │           ├─ columns: [nhmxw.FZXV5:27]\n" +
+			" │   │           │           │           │           ├─ columns: [nhmxw.FZXV5:12]\n" +
 			" │   │           │           │           │           └─ Filter\n" +
 			" │   │           │           │           │               ├─ Eq\n" +
-			" │   │           │           │           │               │   ├─ nhmxw.id:26!null\n" +
+			" │   │           │           │           │               │   ├─ nhmxw.id:11!null\n" +
 			" │   │           │           │           │               │   └─ ism.PRUV2:6\n" +
 			" │   │           │           │           │               └─ TableAlias(nhmxw)\n" +
 			" │   │           │           │           │                   └─ IndexedTableAccess(WGSDC)\n" +
@@ -502,7 +500,7 @@ WHERE
 			" │   │           │           │           └─ TableAlias(nd)\n" +
 			" │   │           │           │               └─ Table\n" +
 			" │   │           │           │                   ├─ name: E2I7U\n" +
-			" │   │           │           │                   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			" │   │           │           │                   ├─ columns: [id tw55n]\n" +
 			" │   │           │           │                   ├─ colSet: (20-36)\n" +
 			" │   │           │           │                   └─ tableId: 3\n" +
 			" │   │           │           └─ ism.FV24E:1!null\n" +
@@ -518,15 +516,15 @@ WHERE
 			" │   │                       │       ├─ columns: [nd.id:9!null]\n" +
 			" │   │                       │       └─ Filter\n" +
 			" │   │                       │           ├─ Eq\n" +
-			" │   │                       │           │   ├─ nd.TW55N:12!null\n" +
+			" │   │                       │           │   ├─ nd.TW55N:10!null\n" +
 			" │   │                       │           │   └─ Subquery\n" +
 			" │   │                       │           │       ├─ cacheable: false\n" +
 			" │   │                       │           │       ├─ alias-string: select NHMXW.DQYGV from WGSDC as NHMXW where NHMXW.id = ism.PRUV2\n" +
 			" │   │                       │           │       └─ Project\n" +
-			" │   │                       │           │           ├─ columns: [nhmxw.DQYGV:27]\n" +
+			" │   │                       │           │           ├─ columns: [nhmxw.DQYGV:12]\n" +
 			" │   │                       │           │           └─ Filter\n" +
 			" │   │                       │           │               ├─ Eq\n" +
-			" │   │                       │           │               │   ├─ nhmxw.id:26!null\n" +
+			" │   │                       │           │               │   ├─ nhmxw.id:11!null\n" +
 			" │   │                       │           │               │   └─ ism.PRUV2:6\n" +
 			" │   │                       │           │               └─ TableAlias(nhmxw)\n" +
 			" │   │                       │           │                   └─ IndexedTableAccess(WGSDC)\n" +
@@ -540,7 +538,7 @@ WHERE
 			" │   │                       │           └─ TableAlias(nd)\n" +
 			" │   │                       │               └─ Table\n" +
 			" │   │                       │                   ├─ name: E2I7U\n" +
-			" │   │                       │                   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			" │   │                       │                   ├─ columns: [id tw55n]\n" +
 			" │   │                       │                   ├─ colSet: (47-63)\n" +
 			" │   │                       │                   └─ tableId: 5\n" +
 			" │   │                       └─ ism.UJ6XY:2!null\n" +
@@ -553,35 +551,35 @@ WHERE
 			" │               ├─ cacheable: true\n" +
 			" │               ├─ alias-string: select TIZHK.id as FWATE from WGSDC as NHMXW join WRZVO as TIZHK on TIZHK.TVNW2 = NHMXW.NOHHR and TIZHK.ZHITY = NHMXW.AVPYF and TIZHK.SYPKF = NHMXW.SYPKF and TIZHK.IDUT2 = NHMXW.IDUT2 where NHMXW.SWCQV = 0 and NHMXW.id not in (select PRUV2 from HDDVB where PRUV2 is not null)\n" +
 			" │               └─ Project\n" +
-			" │                   ├─ columns: [tizhk.id:19!null->FWATE:0]\n" +
+			" │                   ├─ columns: [tizhk.id:15!null->FWATE:0]\n" +
 			" │                   └─ Project\n" +
-			" │                       ├─ columns: [WGSDC.id:9!null, WGSDC.NOHHR:10!null, WGSDC.AVPYF:11!null, WGSDC.SYPKF:12!null, WGSDC.IDUT2:13!null, WGSDC.FZXV5:14, WGSDC.DQYGV:15, WGSDC.SWCQV:16!null, WGSDC.YKSSU:17, WGSDC.FHCYT:18, WRZVO.id:19!null, WRZVO.TVNW2:20, WRZVO.ZHITY:21, WRZVO.SYPKF:22, WRZVO.IDUT2:23, WRZVO.O6QJ3:24, WRZVO.NO2JA:25, WRZVO.YKSSU:26, WRZVO.FHCYT:27, WRZVO.QZ6VT:28]\n" +
+			" │                       ├─ columns: [WGSDC.id:9!null, WGSDC.NOHHR:10!null, WGSDC.AVPYF:11!null, WGSDC.SYPKF:12!null, WGSDC.IDUT2:13!null, WGSDC.SWCQV:14!null, WRZVO.id:15!null, WRZVO.TVNW2:16, WRZVO.ZHITY:17, WRZVO.SYPKF:18, WRZVO.IDUT2:19]\n" +
 			" │                       └─ Filter\n" +
-			" │                           ├─ 1:30 IS NULL\n" +
+			" │                           ├─ 1:21 IS NULL\n" +
 			" │                           └─ LeftOuterHashJoinExcludingNulls\n" +
 			" │                               ├─ Eq\n" +
 			" │                               │   ├─ nhmxw.id:9!null\n" +
-			" │                               │   └─ hddvb.PRUV2:29\n" +
+			" │                               │   └─ hddvb.PRUV2:20\n" +
 			" │                               ├─ LookupJoin\n" +
 			" │                               │   ├─ AND\n" +
 			" │                               │   │   ├─ AND\n" +
 			" │                               │   │   │   ├─ Eq\n" +
-			" │                               │   │   │   │   ├─ tizhk.TVNW2:20\n" +
+			" │                               │   │   │   │   ├─ tizhk.TVNW2:16\n" +
 			" │                               │   │   │   │   └─ nhmxw.NOHHR:10!null\n" +
 			" │                               │   │   │   └─ Eq\n" +
-			" │                               │   │   │       ├─ tizhk.ZHITY:21\n" +
+			" │                               │   │   │       ├─ tizhk.ZHITY:17\n" +
 			" │                               │   │   │       └─ nhmxw.AVPYF:11!null\n" +
 			" │                               │   │   └─ Eq\n" +
-			" │                               │   │       ├─ tizhk.IDUT2:23\n" +
+			" │                               │   │       ├─ tizhk.IDUT2:19\n" +
 			" │                               │   │       └─ nhmxw.IDUT2:13!null\n" +
 			" │                               │   ├─ Filter\n" +
 			" │                               │   │   ├─ Eq\n" +
-			" │                               │   │   │   ├─ nhmxw.SWCQV:16!null\n" +
+			" │                               │   │   │   ├─ nhmxw.SWCQV:14!null\n" +
 			" │                               │   │   │   └─ 0 (int)\n" +
 			" │                               │   │   └─ TableAlias(nhmxw)\n" +
 			" │                               │   │       └─ Table\n" +
 			" │                               │   │           ├─ name: WGSDC\n" +
-			" │                               │   │           ├─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			" │                               │   │           ├─ columns: [id nohhr avpyf sypkf idut2 swcqv]\n" +
 			" │                               │   │           ├─ colSet: (74-83)\n" +
 			" │                               │   │           └─ tableId: 7\n" +
 			" │                               │   └─ TableAlias(tizhk)\n" +
@@ -592,7 +590,7 @@ WHERE
 			" │                               │           ├─ tableId: 8\n" +
 			" │                               │           └─ Table\n" +
 			" │                               │               ├─ name: WRZVO\n" +
-			" │                               │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			" │                               │               └─ columns: [id tvnw2 zhity sypkf idut2]\n" +
 			" │                               └─ HashLookup\n" +
 			" │                                   ├─ left-key: TUPLE(nhmxw.id:9!null)\n" +
 			" │                                   ├─ right-key: TUPLE(hddvb.PRUV2:9)\n" +
@@ -620,7 +618,7 @@ WHERE
 			" │       └─ Project\n" +
 			" │           ├─ columns: [tizhk.id as FWATE]\n" +
 			" │           └─ Project\n" +
-			" │               ├─ columns: [WGSDC.id, WGSDC.NOHHR, WGSDC.AVPYF, WGSDC.SYPKF, WGSDC.IDUT2, WGSDC.FZXV5, WGSDC.DQYGV, WGSDC.SWCQV, WGSDC.YKSSU, WGSDC.FHCYT, WRZVO.id, WRZVO.TVNW2, WRZVO.ZHITY, WRZVO.SYPKF, WRZVO.IDUT2, WRZVO.O6QJ3, WRZVO.NO2JA, WRZVO.YKSSU, WRZVO.FHCYT, WRZVO.QZ6VT]\n" +
+			" │               ├─ columns: [WGSDC.id, WGSDC.NOHHR, WGSDC.AVPYF, WGSDC.SYPKF, WGSDC.IDUT2, WGSDC.SWCQV, WRZVO.id, WRZVO.TVNW2, WRZVO.ZHITY, WRZVO.SYPKF, WRZVO.IDUT2]\n" +
 			" │               └─ Filter\n" +
 			" │                   ├─ 1 IS NULL\n" +
 			" │                   └─ LeftOuterHashJoinExcludingNulls\n" +
@@ -631,10 +629,12 @@ WHERE
 			" │                       │   │   ├─ (nhmxw.SWCQV = 0)\n" +
 			" │                       │   │   └─ TableAlias(nhmxw)\n" +
 			" │                       │   │       └─ Table\n" +
-			" │                       │   │           └─ name: WGSDC\n" +
+			" │                       │   │           ├─ name: WGSDC\n" +
+			" │                       │   │           └─ columns: [id nohhr avpyf sypkf idut2 swcqv]\n" +
 			" │                       │   └─ TableAlias(tizhk)\n" +
 			" │                       │       └─ IndexedTableAccess(WRZVO)\n" +
 			" │                       │           ├─ index: [WRZVO.SYPKF]\n" +
+			" │                       │           ├─ columns: [id tvnw2 zhity sypkf idut2]\n" +
 			" │                       │           └─ keys: nhmxw.SYPKF\n" +
 			" │                       └─ HashLookup\n" +
 			" │                           ├─ left-key: (nhmxw.id)\n" +
@@ -648,7 +648,8 @@ WHERE
 			" │  ))\n" +
 			" └─ TableAlias(ism)\n" +
 			"     └─ Table\n" +
-			"         └─ name: HDDVB\n" +
+			"         ├─ name: HDDVB\n" +
+			"         └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
 			"",
 		ExpectedAnalysis: "Filter\n" +
 			" ├─ (((NOT(ism.PRUV2 IS NULL)) AND ((Subquery(select NHMXW.SWCQV from WGSDC as NHMXW where NHMXW.id = ism.PRUV2) = 1) OR (((NOT(ism.FV24E IS NULL)) AND (NOT((Subquery(select nd.id from E2I7U as nd where nd.TW55N = (select NHMXW.FZXV5 from WGSDC as NHMXW where NHMXW.id = ism.PRUV2)) = ism.FV24E)))) OR ((NOT(ism.UJ6XY IS NULL)) AND (NOT((Subquery(select nd.id from E2I7U as nd where nd.TW55N = (select NHMXW.DQYGV from WGSDC as NHMXW where NHMXW.id = ism.PRUV2)) = ism.UJ6XY))))))) OR ((NOT(ism.ETPQV IS NULL)) AND InSubquery\n" +
@@ -658,7 +659,7 @@ WHERE
 			" │       └─ Project\n" +
 			" │           ├─ columns: [tizhk.id as FWATE]\n" +
 			" │           └─ Project\n" +
-			" │               ├─ columns: [WGSDC.id, WGSDC.NOHHR, WGSDC.AVPYF, WGSDC.SYPKF, WGSDC.IDUT2, WGSDC.FZXV5, WGSDC.DQYGV, WGSDC.SWCQV, WGSDC.YKSSU, WGSDC.FHCYT, WRZVO.id, WRZVO.TVNW2, WRZVO.ZHITY, WRZVO.SYPKF, WRZVO.IDUT2, WRZVO.O6QJ3, WRZVO.NO2JA, WRZVO.YKSSU, WRZVO.FHCYT, WRZVO.QZ6VT]\n" +
+			" │               ├─ columns: [WGSDC.id, WGSDC.NOHHR, WGSDC.AVPYF, WGSDC.SYPKF, WGSDC.IDUT2, WGSDC.SWCQV, WRZVO.id, WRZVO.TVNW2, WRZVO.ZHITY, WRZVO.SYPKF, WRZVO.IDUT2]\n" +
 			" │               └─ Filter\n" +
 			" │                   ├─ 1 IS NULL\n" +
 			" │                   └─ LeftOuterHashJoinExcludingNulls\n" +
@@ -669,10 +670,12 @@ WHERE
 			" │                       │   │   ├─ (nhmxw.SWCQV = 0)\n" +
 			" │                       │   │   └─ TableAlias(nhmxw)\n" +
 			" │                       │   │       └─ Table\n" +
-			" │                       │   │           └─ name: WGSDC\n" +
+			" │                       │   │           ├─ name: WGSDC\n" +
+			" │                       │   │           └─ columns: [id nohhr avpyf sypkf idut2 swcqv]\n" +
 			" │                       │   └─ TableAlias(tizhk)\n" +
 			" │                       │       └─ IndexedTableAccess(WRZVO)\n" +
 			" │                       │           ├─ index: [WRZVO.SYPKF]\n" +
+			" │                       │           ├─ columns: [id tvnw2 zhity sypkf idut2]\n" +
 			" │                       │           └─ keys: nhmxw.SYPKF\n" +
 			" │                       └─ HashLookup\n" +
 			" │                           ├─ left-key: (nhmxw.id)\n" +
@@ -686,7 +689,8 @@ WHERE
 			" │  ))\n" +
 			" └─ TableAlias(ism)\n" +
 			"     └─ Table\n" +
-			"         └─ name: HDDVB\n" +
+			"         ├─ name: HDDVB\n" +
+			"         └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
 			"",
 	},
 	{
@@ -847,6 +851,7 @@ WHERE
 			"             │   └─ TableAlias(tizhk)\n" +
 			"             │       └─ IndexedTableAccess(WRZVO)\n" +
 			"             │           ├─ index: [WRZVO.id]\n" +
+			"             │           ├─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
 			"             │           └─ keys: tizhk_1.id\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (tizhk.id)\n" +
@@ -899,6 +904,7 @@ WHERE
 			"             │   └─ TableAlias(tizhk)\n" +
 			"             │       └─ IndexedTableAccess(WRZVO)\n" +
 			"             │           ├─ index: [WRZVO.id]\n" +
+			"             │           ├─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
 			"             │           └─ keys: tizhk_1.id\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (tizhk.id)\n" +
@@ -1069,6 +1075,7 @@ WHERE
 			"             │   └─ TableAlias(tizhk)\n" +
 			"             │       └─ IndexedTableAccess(WRZVO)\n" +
 			"             │           ├─ index: [WRZVO.id]\n" +
+			"             │           ├─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
 			"             │           └─ keys: tizhk_1.id\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (tizhk.id)\n" +
@@ -1121,6 +1128,7 @@ WHERE
 			"             │   └─ TableAlias(tizhk)\n" +
 			"             │       └─ IndexedTableAccess(WRZVO)\n" +
 			"             │           ├─ index: [WRZVO.id]\n" +
+			"             │           ├─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
 			"             │           └─ keys: tizhk_1.id\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (tizhk.id)\n" +
@@ -1344,7 +1352,8 @@ WHERE
 			"                                                 └─ TableAlias(nd)\n" +
 			"                                                     └─ IndexedTableAccess(E2I7U)\n" +
 			"                                                         ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                         └─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         ├─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [pbmrx.id as id, pbmrx.TW55N as TEYBZ, pbmrx.ZH72S as FB6N7]\n" +
@@ -1414,7 +1423,8 @@ WHERE
 			"                                                 └─ TableAlias(nd)\n" +
 			"                                                     └─ IndexedTableAccess(E2I7U)\n" +
 			"                                                         ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                         └─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         ├─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
@@ -1480,27 +1490,27 @@ WHERE
 	)
 	`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [ct.id:0!null->id:0, ci.FTQLQ:33!null->VCGT3:0, nd.TW55N:15!null->UWBAI:0, aac.BTXC5:30->TPXBU:0, ct.V5DPX:8!null->V5DPX:0, ct.S3Q3Y:9!null->S3Q3Y:0, ct.ZRV3B:10!null->ZRV3B:0]\n" +
+			" ├─ columns: [ct.id:0!null->id:0, ci.FTQLQ:14!null->VCGT3:0, nd.TW55N:10!null->UWBAI:0, aac.BTXC5:12->TPXBU:0, ct.V5DPX:6!null->V5DPX:0, ct.S3Q3Y:7!null->S3Q3Y:0, ct.ZRV3B:8!null->ZRV3B:0]\n" +
 			" └─ Filter\n" +
 			"     ├─ Or\n" +
 			"     │   ├─ AND\n" +
 			"     │   │   ├─ NOT\n" +
-			"     │   │   │   └─ ct.OCA7E:6 IS NULL\n" +
+			"     │   │   │   └─ ct.OCA7E:5 IS NULL\n" +
 			"     │   │   └─ Or\n" +
 			"     │   │       ├─ Eq\n" +
 			"     │   │       │   ├─ Subquery\n" +
 			"     │   │       │   │   ├─ cacheable: false\n" +
 			"     │   │       │   │   ├─ alias-string: select I7HCR.SWCQV from EPZU6 as I7HCR where I7HCR.id = ct.OCA7E\n" +
 			"     │   │       │   │   └─ Project\n" +
-			"     │   │       │   │       ├─ columns: [i7hcr.SWCQV:38!null]\n" +
+			"     │   │       │   │       ├─ columns: [i7hcr.SWCQV:16!null]\n" +
 			"     │   │       │   │       └─ Filter\n" +
 			"     │   │       │   │           ├─ Eq\n" +
-			"     │   │       │   │           │   ├─ i7hcr.id:37!null\n" +
-			"     │   │       │   │           │   └─ ct.OCA7E:6\n" +
+			"     │   │       │   │           │   ├─ i7hcr.id:15!null\n" +
+			"     │   │       │   │           │   └─ ct.OCA7E:5\n" +
 			"     │   │       │   │           └─ TableAlias(i7hcr)\n" +
 			"     │   │       │   │               └─ IndexedTableAccess(EPZU6)\n" +
 			"     │   │       │   │                   ├─ index: [EPZU6.id]\n" +
-			"     │   │       │   │                   ├─ keys: [ct.OCA7E:6]\n" +
+			"     │   │       │   │                   ├─ keys: [ct.OCA7E:5]\n" +
 			"     │   │       │   │                   ├─ colSet: (38-45)\n" +
 			"     │   │       │   │                   ├─ tableId: 5\n" +
 			"     │   │       │   │                   └─ Table\n" +
@@ -1513,23 +1523,23 @@ WHERE
 			"     │   │               │   ├─ cacheable: false\n" +
 			"     │   │               │   ├─ alias-string: select nd.id from E2I7U as nd where nd.TW55N = (select I7HCR.FVUCX from EPZU6 as I7HCR where I7HCR.id = ct.OCA7E)\n" +
 			"     │   │               │   └─ Project\n" +
-			"     │   │               │       ├─ columns: [nd.id:37!null]\n" +
+			"     │   │               │       ├─ columns: [nd.id:15!null]\n" +
 			"     │   │               │       └─ Filter\n" +
 			"     │   │               │           ├─ Eq\n" +
-			"     │   │               │           │   ├─ nd.TW55N:40!null\n" +
+			"     │   │               │           │   ├─ nd.TW55N:16!null\n" +
 			"     │   │               │           │   └─ Subquery\n" +
 			"     │   │               │           │       ├─ cacheable: false\n" +
 			"     │   │               │           │       ├─ alias-string: select I7HCR.FVUCX from EPZU6 as I7HCR where I7HCR.id = ct.OCA7E\n" +
 			"     │   │               │           │       └─ Project\n" +
-			"     │   │               │           │           ├─ columns: [i7hcr.FVUCX:55!null]\n" +
+			"     │   │               │           │           ├─ columns: [i7hcr.FVUCX:18!null]\n" +
 			"     │   │               │           │           └─ Filter\n" +
 			"     │   │               │           │               ├─ Eq\n" +
-			"     │   │               │           │               │   ├─ i7hcr.id:54!null\n" +
-			"     │   │               │           │               │   └─ ct.OCA7E:6\n" +
+			"     │   │               │           │               │   ├─ i7hcr.id:17!null\n" +
+			"     │   │               │           │               │   └─ ct.OCA7E:5\n" +
 			"     │   │               │           │               └─ TableAlias(i7hcr)\n" +
 			"     │   │               │           │                   └─ IndexedTableAccess(EPZU6)\n" +
 			"     │   │               │           │                       ├─ index: [EPZU6.id]\n" +
-			"     │   │               │           │                       ├─ keys: [ct.OCA7E:6]\n" +
+			"     │   │               │           │                       ├─ keys: [ct.OCA7E:5]\n" +
 			"     │   │               │           │                       ├─ colSet: (63-70)\n" +
 			"     │   │               │           │                       ├─ tableId: 7\n" +
 			"     │   │               │           │                       └─ Table\n" +
@@ -1538,60 +1548,60 @@ WHERE
 			"     │   │               │           └─ TableAlias(nd)\n" +
 			"     │   │               │               └─ Table\n" +
 			"     │   │               │                   ├─ name: E2I7U\n" +
-			"     │   │               │                   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │   │               │                   ├─ columns: [id tw55n]\n" +
 			"     │   │               │                   ├─ colSet: (46-62)\n" +
 			"     │   │               │                   └─ tableId: 6\n" +
 			"     │   │               └─ ct.LUEVY:2!null\n" +
 			"     │   └─ AND\n" +
 			"     │       ├─ NOT\n" +
-			"     │       │   └─ ct.NRURT:5 IS NULL\n" +
+			"     │       │   └─ ct.NRURT:4 IS NULL\n" +
 			"     │       └─ InSubquery\n" +
-			"     │           ├─ left: ct.NRURT:5\n" +
+			"     │           ├─ left: ct.NRURT:4\n" +
 			"     │           └─ right: Subquery\n" +
 			"     │               ├─ cacheable: true\n" +
 			"     │               ├─ alias-string: select uct.id as FDL23 from EPZU6 as I7HCR join OUBDL as uct on uct.FTQLQ = I7HCR.TOFPN and uct.ZH72S = I7HCR.SJYN2 and uct.LJLUM = I7HCR.BTXC5 where I7HCR.SWCQV = 0 and I7HCR.id not in (select OCA7E from FLQLP where OCA7E is not null)\n" +
 			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [uct.id:45!null->FDL23:0]\n" +
+			"     │                   ├─ columns: [uct.id:20!null->FDL23:0]\n" +
 			"     │                   └─ Project\n" +
-			"     │                       ├─ columns: [EPZU6.id:37!null, EPZU6.TOFPN:38!null, EPZU6.SJYN2:39!null, EPZU6.BTXC5:40!null, EPZU6.FVUCX:41!null, EPZU6.SWCQV:42!null, EPZU6.YKSSU:43, EPZU6.FHCYT:44, OUBDL.id:45!null, OUBDL.FTQLQ:46, OUBDL.ZH72S:47, OUBDL.SFJ6L:48, OUBDL.V5DPX:49, OUBDL.LJLUM:50, OUBDL.IDPK7:51, OUBDL.NO52D:52, OUBDL.ZRV3B:53, OUBDL.VYO5E:54, OUBDL.YKSSU:55, OUBDL.FHCYT:56, OUBDL.QZ6VT:57]\n" +
+			"     │                       ├─ columns: [EPZU6.id:15!null, EPZU6.TOFPN:16!null, EPZU6.SJYN2:17!null, EPZU6.BTXC5:18!null, EPZU6.SWCQV:19!null, OUBDL.id:20!null, OUBDL.FTQLQ:21, OUBDL.ZH72S:22, OUBDL.LJLUM:23]\n" +
 			"     │                       └─ Filter\n" +
-			"     │                           ├─ 1:59 IS NULL\n" +
+			"     │                           ├─ 1:25 IS NULL\n" +
 			"     │                           └─ LeftOuterHashJoinExcludingNulls\n" +
 			"     │                               ├─ Eq\n" +
-			"     │                               │   ├─ i7hcr.id:37!null\n" +
-			"     │                               │   └─ flqlp.OCA7E:58\n" +
+			"     │                               │   ├─ i7hcr.id:15!null\n" +
+			"     │                               │   └─ flqlp.OCA7E:24\n" +
 			"     │                               ├─ LookupJoin\n" +
 			"     │                               │   ├─ AND\n" +
 			"     │                               │   │   ├─ Eq\n" +
-			"     │                               │   │   │   ├─ uct.ZH72S:47\n" +
-			"     │                               │   │   │   └─ i7hcr.SJYN2:39!null\n" +
+			"     │                               │   │   │   ├─ uct.ZH72S:22\n" +
+			"     │                               │   │   │   └─ i7hcr.SJYN2:17!null\n" +
 			"     │                               │   │   └─ Eq\n" +
-			"     │                               │   │       ├─ uct.LJLUM:50\n" +
-			"     │                               │   │       └─ i7hcr.BTXC5:40!null\n" +
+			"     │                               │   │       ├─ uct.LJLUM:23\n" +
+			"     │                               │   │       └─ i7hcr.BTXC5:18!null\n" +
 			"     │                               │   ├─ Filter\n" +
 			"     │                               │   │   ├─ Eq\n" +
-			"     │                               │   │   │   ├─ i7hcr.SWCQV:42!null\n" +
+			"     │                               │   │   │   ├─ i7hcr.SWCQV:19!null\n" +
 			"     │                               │   │   │   └─ 0 (int)\n" +
 			"     │                               │   │   └─ TableAlias(i7hcr)\n" +
 			"     │                               │   │       └─ Table\n" +
 			"     │                               │   │           ├─ name: EPZU6\n" +
-			"     │                               │   │           ├─ columns: [id tofpn sjyn2 btxc5 fvucx swcqv ykssu fhcyt]\n" +
+			"     │                               │   │           ├─ columns: [id tofpn sjyn2 btxc5 swcqv]\n" +
 			"     │                               │   │           ├─ colSet: (71-78)\n" +
 			"     │                               │   │           └─ tableId: 8\n" +
 			"     │                               │   └─ TableAlias(uct)\n" +
 			"     │                               │       └─ IndexedTableAccess(OUBDL)\n" +
 			"     │                               │           ├─ index: [OUBDL.FTQLQ]\n" +
-			"     │                               │           ├─ keys: [i7hcr.TOFPN:38!null]\n" +
+			"     │                               │           ├─ keys: [i7hcr.TOFPN:16!null]\n" +
 			"     │                               │           ├─ colSet: (79-91)\n" +
 			"     │                               │           ├─ tableId: 9\n" +
 			"     │                               │           └─ Table\n" +
 			"     │                               │               ├─ name: OUBDL\n" +
-			"     │                               │               └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
+			"     │                               │               └─ columns: [id ftqlq zh72s ljlum]\n" +
 			"     │                               └─ HashLookup\n" +
-			"     │                                   ├─ left-key: TUPLE(i7hcr.id:37!null)\n" +
-			"     │                                   ├─ right-key: TUPLE(flqlp.OCA7E:37)\n" +
+			"     │                                   ├─ left-key: TUPLE(i7hcr.id:15!null)\n" +
+			"     │                                   ├─ right-key: TUPLE(flqlp.OCA7E:15)\n" +
 			"     │                                   └─ Project\n" +
-			"     │                                       ├─ columns: [flqlp.OCA7E:37, 1 (bigint)]\n" +
+			"     │                                       ├─ columns: [flqlp.OCA7E:15, 1 (bigint)]\n" +
 			"     │                                       └─ IndexedTableAccess(FLQLP)\n" +
 			"     │                                           ├─ index: [FLQLP.OCA7E]\n" +
 			"     │                                           ├─ static: [{(NULL, ∞)}]\n" +
@@ -1602,13 +1612,13 @@ WHERE
 			"     │                                               └─ columns: [oca7e]\n" +
 			"     └─ HashJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ ci.id:32!null\n" +
+			"         │   ├─ ci.id:13!null\n" +
 			"         │   └─ ct.FZ2R5:1!null\n" +
 			"         ├─ LookupJoin\n" +
 			"         │   ├─ MergeJoin\n" +
 			"         │   │   ├─ cmp: Eq\n" +
 			"         │   │   │   ├─ ct.LUEVY:2!null\n" +
-			"         │   │   │   └─ nd.id:12!null\n" +
+			"         │   │   │   └─ nd.id:9!null\n" +
 			"         │   │   ├─ TableAlias(ct)\n" +
 			"         │   │   │   └─ IndexedTableAccess(FLQLP)\n" +
 			"         │   │   │       ├─ index: [FLQLP.LUEVY]\n" +
@@ -1617,7 +1627,7 @@ WHERE
 			"         │   │   │       ├─ tableId: 1\n" +
 			"         │   │   │       └─ Table\n" +
 			"         │   │   │           ├─ name: FLQLP\n" +
-			"         │   │   │           └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         │   │   │           └─ columns: [id fz2r5 luevy m22qn nrurt oca7e v5dpx s3q3y zrv3b]\n" +
 			"         │   │   └─ TableAlias(nd)\n" +
 			"         │   │       └─ IndexedTableAccess(E2I7U)\n" +
 			"         │   │           ├─ index: [E2I7U.id]\n" +
@@ -1626,7 +1636,7 @@ WHERE
 			"         │   │           ├─ tableId: 3\n" +
 			"         │   │           └─ Table\n" +
 			"         │   │               ├─ name: E2I7U\n" +
-			"         │   │               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"         │   │               └─ columns: [id tw55n]\n" +
 			"         │   └─ TableAlias(aac)\n" +
 			"         │       └─ IndexedTableAccess(TPXBU)\n" +
 			"         │           ├─ index: [TPXBU.id]\n" +
@@ -1635,7 +1645,7 @@ WHERE
 			"         │           ├─ tableId: 4\n" +
 			"         │           └─ Table\n" +
 			"         │               ├─ name: TPXBU\n" +
-			"         │               └─ columns: [id btxc5 fhcyt]\n" +
+			"         │               └─ columns: [id btxc5]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: TUPLE(ct.FZ2R5:1!null)\n" +
 			"             ├─ right-key: TUPLE(ci.id:0!null)\n" +
@@ -1643,7 +1653,7 @@ WHERE
 			"                 └─ ProcessTable\n" +
 			"                     └─ Table\n" +
 			"                         ├─ name: JDLNA\n" +
-			"                         └─ columns: [id ftqlq fwwiq o3qxw fhcyt]\n" +
+			"                         └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [ct.id as id, ci.FTQLQ as VCGT3, nd.TW55N as UWBAI, aac.BTXC5 as TPXBU, ct.V5DPX as V5DPX, ct.S3Q3Y as S3Q3Y, ct.ZRV3B as ZRV3B]\n" +
@@ -1655,7 +1665,7 @@ WHERE
 			"     │       └─ Project\n" +
 			"     │           ├─ columns: [uct.id as FDL23]\n" +
 			"     │           └─ Project\n" +
-			"     │               ├─ columns: [EPZU6.id, EPZU6.TOFPN, EPZU6.SJYN2, EPZU6.BTXC5, EPZU6.FVUCX, EPZU6.SWCQV, EPZU6.YKSSU, EPZU6.FHCYT, OUBDL.id, OUBDL.FTQLQ, OUBDL.ZH72S, OUBDL.SFJ6L, OUBDL.V5DPX, OUBDL.LJLUM, OUBDL.IDPK7, OUBDL.NO52D, OUBDL.ZRV3B, OUBDL.VYO5E, OUBDL.YKSSU, OUBDL.FHCYT, OUBDL.QZ6VT]\n" +
+			"     │               ├─ columns: [EPZU6.id, EPZU6.TOFPN, EPZU6.SJYN2, EPZU6.BTXC5, EPZU6.SWCQV, OUBDL.id, OUBDL.FTQLQ, OUBDL.ZH72S, OUBDL.LJLUM]\n" +
 			"     │               └─ Filter\n" +
 			"     │                   ├─ 1 IS NULL\n" +
 			"     │                   └─ LeftOuterHashJoinExcludingNulls\n" +
@@ -1666,10 +1676,12 @@ WHERE
 			"     │                       │   │   ├─ (i7hcr.SWCQV = 0)\n" +
 			"     │                       │   │   └─ TableAlias(i7hcr)\n" +
 			"     │                       │   │       └─ Table\n" +
-			"     │                       │   │           └─ name: EPZU6\n" +
+			"     │                       │   │           ├─ name: EPZU6\n" +
+			"     │                       │   │           └─ columns: [id tofpn sjyn2 btxc5 swcqv]\n" +
 			"     │                       │   └─ TableAlias(uct)\n" +
 			"     │                       │       └─ IndexedTableAccess(OUBDL)\n" +
 			"     │                       │           ├─ index: [OUBDL.FTQLQ]\n" +
+			"     │                       │           ├─ columns: [id ftqlq zh72s ljlum]\n" +
 			"     │                       │           └─ keys: i7hcr.TOFPN\n" +
 			"     │                       └─ HashLookup\n" +
 			"     │                           ├─ left-key: (i7hcr.id)\n" +
@@ -1689,21 +1701,25 @@ WHERE
 			"         │   │   ├─ TableAlias(ct)\n" +
 			"         │   │   │   └─ IndexedTableAccess(FLQLP)\n" +
 			"         │   │   │       ├─ index: [FLQLP.LUEVY]\n" +
-			"         │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       └─ columns: [id fz2r5 luevy m22qn nrurt oca7e v5dpx s3q3y zrv3b]\n" +
 			"         │   │   └─ TableAlias(nd)\n" +
 			"         │   │       └─ IndexedTableAccess(E2I7U)\n" +
 			"         │   │           ├─ index: [E2I7U.id]\n" +
-			"         │   │           └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           └─ columns: [id tw55n]\n" +
 			"         │   └─ TableAlias(aac)\n" +
 			"         │       └─ IndexedTableAccess(TPXBU)\n" +
 			"         │           ├─ index: [TPXBU.id]\n" +
+			"         │           ├─ columns: [id btxc5]\n" +
 			"         │           └─ keys: ct.M22QN\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (ct.FZ2R5)\n" +
 			"             ├─ right-key: (ci.id)\n" +
 			"             └─ TableAlias(ci)\n" +
 			"                 └─ Table\n" +
-			"                     └─ name: JDLNA\n" +
+			"                     ├─ name: JDLNA\n" +
+			"                     └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [ct.id as id, ci.FTQLQ as VCGT3, nd.TW55N as UWBAI, aac.BTXC5 as TPXBU, ct.V5DPX as V5DPX, ct.S3Q3Y as S3Q3Y, ct.ZRV3B as ZRV3B]\n" +
@@ -1715,7 +1731,7 @@ WHERE
 			"     │       └─ Project\n" +
 			"     │           ├─ columns: [uct.id as FDL23]\n" +
 			"     │           └─ Project\n" +
-			"     │               ├─ columns: [EPZU6.id, EPZU6.TOFPN, EPZU6.SJYN2, EPZU6.BTXC5, EPZU6.FVUCX, EPZU6.SWCQV, EPZU6.YKSSU, EPZU6.FHCYT, OUBDL.id, OUBDL.FTQLQ, OUBDL.ZH72S, OUBDL.SFJ6L, OUBDL.V5DPX, OUBDL.LJLUM, OUBDL.IDPK7, OUBDL.NO52D, OUBDL.ZRV3B, OUBDL.VYO5E, OUBDL.YKSSU, OUBDL.FHCYT, OUBDL.QZ6VT]\n" +
+			"     │               ├─ columns: [EPZU6.id, EPZU6.TOFPN, EPZU6.SJYN2, EPZU6.BTXC5, EPZU6.SWCQV, OUBDL.id, OUBDL.FTQLQ, OUBDL.ZH72S, OUBDL.LJLUM]\n" +
 			"     │               └─ Filter\n" +
 			"     │                   ├─ 1 IS NULL\n" +
 			"     │                   └─ LeftOuterHashJoinExcludingNulls\n" +
@@ -1726,10 +1742,12 @@ WHERE
 			"     │                       │   │   ├─ (i7hcr.SWCQV = 0)\n" +
 			"     │                       │   │   └─ TableAlias(i7hcr)\n" +
 			"     │                       │   │       └─ Table\n" +
-			"     │                       │   │           └─ name: EPZU6\n" +
+			"     │                       │   │           ├─ name: EPZU6\n" +
+			"     │                       │   │           └─ columns: [id tofpn sjyn2 btxc5 swcqv]\n" +
 			"     │                       │   └─ TableAlias(uct)\n" +
 			"     │                       │       └─ IndexedTableAccess(OUBDL)\n" +
 			"     │                       │           ├─ index: [OUBDL.FTQLQ]\n" +
+			"     │                       │           ├─ columns: [id ftqlq zh72s ljlum]\n" +
 			"     │                       │           └─ keys: i7hcr.TOFPN\n" +
 			"     │                       └─ HashLookup\n" +
 			"     │                           ├─ left-key: (i7hcr.id)\n" +
@@ -1749,21 +1767,25 @@ WHERE
 			"         │   │   ├─ TableAlias(ct)\n" +
 			"         │   │   │   └─ IndexedTableAccess(FLQLP)\n" +
 			"         │   │   │       ├─ index: [FLQLP.LUEVY]\n" +
-			"         │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       └─ columns: [id fz2r5 luevy m22qn nrurt oca7e v5dpx s3q3y zrv3b]\n" +
 			"         │   │   └─ TableAlias(nd)\n" +
 			"         │   │       └─ IndexedTableAccess(E2I7U)\n" +
 			"         │   │           ├─ index: [E2I7U.id]\n" +
-			"         │   │           └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           └─ columns: [id tw55n]\n" +
 			"         │   └─ TableAlias(aac)\n" +
 			"         │       └─ IndexedTableAccess(TPXBU)\n" +
 			"         │           ├─ index: [TPXBU.id]\n" +
+			"         │           ├─ columns: [id btxc5]\n" +
 			"         │           └─ keys: ct.M22QN\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (ct.FZ2R5)\n" +
 			"             ├─ right-key: (ci.id)\n" +
 			"             └─ TableAlias(ci)\n" +
 			"                 └─ Table\n" +
-			"                     └─ name: JDLNA\n" +
+			"                     ├─ name: JDLNA\n" +
+			"                     └─ columns: [id ftqlq]\n" +
 			"",
 	},
 	{
@@ -2248,7 +2270,8 @@ WHERE
 			"         │   ├─ (hu5a5.SWCQV = 0)\n" +
 			"         │   └─ IndexedTableAccess(HU5A5)\n" +
 			"         │       ├─ index: [HU5A5.id]\n" +
-			"         │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │       └─ columns: [id tofpn i3vta sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e swcqv ykssu fhcyt]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [flqlp.XMM6Q, 1]\n" +
 			"             └─ Project\n" +
@@ -2269,7 +2292,8 @@ WHERE
 			"         │   ├─ (hu5a5.SWCQV = 0)\n" +
 			"         │   └─ IndexedTableAccess(HU5A5)\n" +
 			"         │       ├─ index: [HU5A5.id]\n" +
-			"         │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │       └─ columns: [id tofpn i3vta sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e swcqv ykssu fhcyt]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [flqlp.XMM6Q, 1]\n" +
 			"             └─ Project\n" +
@@ -2845,233 +2869,229 @@ WHERE
 	       S7BYT.SSHPJ NOT IN (SELECT SSHPJ FROM WE72E)
 	   )
 	`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [tdrvg.id:0!null, tdrvg.FGG57:1!null, tdrvg.SSHPJ:2!null, tdrvg.SFJ6L:3!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: tdrvg.id:0!null\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       ├─ alias-string: select (select id from TDRVG where SSHPJ = S7BYT.SSHPJ order by id asc limit 1) id from (select distinct S5KBM.SSHPJ as SSHPJ, S5KBM.SFJ6L as SFJ6L from TDRVG as S5KBM join E2I7U as nd on nd.FGG57 = S5KBM.FGG57) as S7BYT where S7BYT.SSHPJ not in (select SSHPJ from WE72E)\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [Subquery\n" +
-			"     │           │   ├─ cacheable: false\n" +
-			"     │           │   ├─ alias-string: select id from TDRVG where SSHPJ = S7BYT.SSHPJ order by id asc limit 1\n" +
-			"     │           │   └─ Limit(1)\n" +
-			"     │           │       └─ Project\n" +
-			"     │           │           ├─ columns: [tdrvg.id:8!null]\n" +
-			"     │           │           └─ TopN(Limit: [1 (bigint)]; tdrvg.id:8!null ASC nullsFirst)\n" +
-			"     │           │               └─ Filter\n" +
-			"     │           │                   ├─ Eq\n" +
-			"     │           │                   │   ├─ tdrvg.SSHPJ:9!null\n" +
-			"     │           │                   │   └─ s7byt.SSHPJ:5!null\n" +
-			"     │           │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │           │                       ├─ index: [TDRVG.SSHPJ]\n" +
-			"     │           │                       ├─ keys: [s7byt.SSHPJ:5!null]\n" +
-			"     │           │                       ├─ colSet: (36-40)\n" +
-			"     │           │                       ├─ tableId: 6\n" +
-			"     │           │                       └─ Table\n" +
-			"     │           │                           ├─ name: TDRVG\n" +
-			"     │           │                           └─ columns: [id sshpj]\n" +
-			"     │           │  ->id:0]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [s7byt.SSHPJ:5!null, s7byt.SFJ6L:6!null, Subquery\n" +
-			"     │               │   ├─ cacheable: false\n" +
-			"     │               │   ├─ alias-string: select id from TDRVG where SSHPJ = S7BYT.SSHPJ order by id asc limit 1\n" +
-			"     │               │   └─ Limit(1)\n" +
-			"     │               │       └─ Project\n" +
-			"     │               │           ├─ columns: [tdrvg.id:7!null]\n" +
-			"     │               │           └─ TopN(Limit: [1 (bigint)]; tdrvg.id:7!null ASC nullsFirst)\n" +
-			"     │               │               └─ Filter\n" +
-			"     │               │                   ├─ Eq\n" +
-			"     │               │                   │   ├─ tdrvg.SSHPJ:8!null\n" +
-			"     │               │                   │   └─ s7byt.SSHPJ:5!null\n" +
-			"     │               │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │               │                       ├─ index: [TDRVG.SSHPJ]\n" +
-			"     │               │                       ├─ keys: [s7byt.SSHPJ:5!null]\n" +
-			"     │               │                       ├─ colSet: (36-40)\n" +
-			"     │               │                       ├─ tableId: 6\n" +
-			"     │               │                       └─ Table\n" +
-			"     │               │                           ├─ name: TDRVG\n" +
-			"     │               │                           └─ columns: [id sshpj]\n" +
-			"     │               │  ->id:0]\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [s7byt.SSHPJ:5!null, s7byt.SFJ6L:6!null]\n" +
-			"     │                   └─ Filter\n" +
-			"     │                       ├─ we72e.SSHPJ:7!null IS NULL\n" +
-			"     │                       └─ LeftOuterLookupJoin\n" +
-			"     │                           ├─ SubqueryAlias\n" +
-			"     │                           │   ├─ name: s7byt\n" +
-			"     │                           │   ├─ outerVisibility: true\n" +
-			"     │                           │   ├─ isLateral: false\n" +
-			"     │                           │   ├─ cacheable: true\n" +
-			"     │                           │   ├─ colSet: (30,31)\n" +
-			"     │                           │   ├─ tableId: 4\n" +
-			"     │                           │   └─ Distinct\n" +
-			"     │                           │       └─ Project\n" +
-			"     │                           │           ├─ columns: [s5kbm.SSHPJ:7!null->SSHPJ:0, s5kbm.SFJ6L:8!null->SFJ6L:0]\n" +
-			"     │                           │           └─ LookupJoin\n" +
-			"     │                           │               ├─ TableAlias(nd)\n" +
-			"     │                           │               │   └─ Table\n" +
-			"     │                           │               │       ├─ name: E2I7U\n" +
-			"     │                           │               │       ├─ columns: [fgg57]\n" +
-			"     │                           │               │       ├─ colSet: (11-27)\n" +
-			"     │                           │               │       └─ tableId: 3\n" +
-			"     │                           │               └─ TableAlias(s5kbm)\n" +
-			"     │                           │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │                           │                       ├─ index: [TDRVG.FGG57]\n" +
-			"     │                           │                       ├─ keys: [nd.FGG57:5]\n" +
-			"     │                           │                       ├─ colSet: (6-10)\n" +
-			"     │                           │                       ├─ tableId: 2\n" +
-			"     │                           │                       └─ Table\n" +
-			"     │                           │                           ├─ name: TDRVG\n" +
-			"     │                           │                           └─ columns: [fgg57 sshpj sfj6l]\n" +
-			"     │                           └─ IndexedTableAccess(WE72E)\n" +
-			"     │                               ├─ index: [WE72E.SSHPJ]\n" +
-			"     │                               ├─ keys: [s7byt.SSHPJ:5!null]\n" +
-			"     │                               ├─ colSet: (32-35)\n" +
-			"     │                               ├─ tableId: 5\n" +
-			"     │                               └─ Table\n" +
-			"     │                                   ├─ name: WE72E\n" +
-			"     │                                   └─ columns: [sshpj]\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: TDRVG\n" +
-			"             └─ columns: [id fgg57 sshpj sfj6l zh72s]\n" +
-			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [tdrvg.id, tdrvg.FGG57, tdrvg.SSHPJ, tdrvg.SFJ6L]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: tdrvg.id\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [Subquery\n" +
-			"     │           │   ├─ cacheable: false\n" +
-			"     │           │   └─ Limit(1)\n" +
-			"     │           │       └─ Project\n" +
-			"     │           │           ├─ columns: [tdrvg.id]\n" +
-			"     │           │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
-			"     │           │               └─ Filter\n" +
-			"     │           │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
-			"     │           │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │           │                       ├─ index: [TDRVG.SSHPJ]\n" +
-			"     │           │                       ├─ columns: [id sshpj]\n" +
-			"     │           │                       └─ keys: s7byt.SSHPJ\n" +
-			"     │           │   as id]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L, Subquery\n" +
-			"     │               │   ├─ cacheable: false\n" +
-			"     │               │   └─ Limit(1)\n" +
-			"     │               │       └─ Project\n" +
-			"     │               │           ├─ columns: [tdrvg.id]\n" +
-			"     │               │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
-			"     │               │               └─ Filter\n" +
-			"     │               │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
-			"     │               │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │               │                       ├─ index: [TDRVG.SSHPJ]\n" +
-			"     │               │                       ├─ columns: [id sshpj]\n" +
-			"     │               │                       └─ keys: s7byt.SSHPJ\n" +
-			"     │               │   as id]\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L]\n" +
-			"     │                   └─ Filter\n" +
-			"     │                       ├─ we72e.SSHPJ IS NULL\n" +
-			"     │                       └─ LeftOuterLookupJoin (estimated cost=334.032 rows=100)\n" +
-			"     │                           ├─ SubqueryAlias\n" +
-			"     │                           │   ├─ name: s7byt\n" +
-			"     │                           │   ├─ outerVisibility: true\n" +
-			"     │                           │   ├─ isLateral: false\n" +
-			"     │                           │   ├─ cacheable: true\n" +
-			"     │                           │   ├─ colSet: (30,31)\n" +
-			"     │                           │   ├─ tableId: 4\n" +
-			"     │                           │   └─ Distinct\n" +
-			"     │                           │       └─ Project\n" +
-			"     │                           │           ├─ columns: [s5kbm.SSHPJ as SSHPJ, s5kbm.SFJ6L as SFJ6L]\n" +
-			"     │                           │           └─ LookupJoin (estimated cost=12885.375 rows=3842)\n" +
-			"     │                           │               ├─ TableAlias(nd)\n" +
-			"     │                           │               │   └─ Table\n" +
-			"     │                           │               │       ├─ name: E2I7U\n" +
-			"     │                           │               │       └─ columns: [fgg57]\n" +
-			"     │                           │               └─ TableAlias(s5kbm)\n" +
-			"     │                           │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │                           │                       ├─ index: [TDRVG.FGG57]\n" +
-			"     │                           │                       ├─ columns: [fgg57 sshpj sfj6l]\n" +
-			"     │                           │                       └─ keys: nd.FGG57\n" +
-			"     │                           └─ IndexedTableAccess(WE72E)\n" +
-			"     │                               ├─ index: [WE72E.SSHPJ]\n" +
-			"     │                               ├─ columns: [sshpj]\n" +
-			"     │                               └─ keys: s7byt.SSHPJ\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: tdrvg.id:0!null\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       ├─ alias-string: select (select id from TDRVG where SSHPJ = S7BYT.SSHPJ order by id asc limit 1) id from (select distinct S5KBM.SSHPJ as SSHPJ, S5KBM.SFJ6L as SFJ6L from TDRVG as S5KBM join E2I7U as nd on nd.FGG57 = S5KBM.FGG57) as S7BYT where S7BYT.SSHPJ not in (select SSHPJ from WE72E)\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [Subquery\n" +
+			" │           │   ├─ cacheable: false\n" +
+			" │           │   ├─ alias-string: select id from TDRVG where SSHPJ = S7BYT.SSHPJ order by id asc limit 1\n" +
+			" │           │   └─ Limit(1)\n" +
+			" │           │       └─ Project\n" +
+			" │           │           ├─ columns: [tdrvg.id:7!null]\n" +
+			" │           │           └─ TopN(Limit: [1 (bigint)]; tdrvg.id:7!null ASC nullsFirst)\n" +
+			" │           │               └─ Filter\n" +
+			" │           │                   ├─ Eq\n" +
+			" │           │                   │   ├─ tdrvg.SSHPJ:8!null\n" +
+			" │           │                   │   └─ s7byt.SSHPJ:4!null\n" +
+			" │           │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │           │                       ├─ index: [TDRVG.SSHPJ]\n" +
+			" │           │                       ├─ keys: [s7byt.SSHPJ:4!null]\n" +
+			" │           │                       ├─ colSet: (36-40)\n" +
+			" │           │                       ├─ tableId: 6\n" +
+			" │           │                       └─ Table\n" +
+			" │           │                           ├─ name: TDRVG\n" +
+			" │           │                           └─ columns: [id sshpj]\n" +
+			" │           │  ->id:0]\n" +
+			" │           └─ Project\n" +
+			" │               ├─ columns: [s7byt.SSHPJ:4!null, s7byt.SFJ6L:5!null, Subquery\n" +
+			" │               │   ├─ cacheable: false\n" +
+			" │               │   ├─ alias-string: select id from TDRVG where SSHPJ = S7BYT.SSHPJ order by id asc limit 1\n" +
+			" │               │   └─ Limit(1)\n" +
+			" │               │       └─ Project\n" +
+			" │               │           ├─ columns: [tdrvg.id:6!null]\n" +
+			" │               │           └─ TopN(Limit: [1 (bigint)]; tdrvg.id:6!null ASC nullsFirst)\n" +
+			" │               │               └─ Filter\n" +
+			" │               │                   ├─ Eq\n" +
+			" │               │                   │   ├─ tdrvg.SSHPJ:7!null\n" +
+			" │               │                   │   └─ s7byt.SSHPJ:4!null\n" +
+			" │               │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │               │                       ├─ index: [TDRVG.SSHPJ]\n" +
+			" │               │                       ├─ keys: [s7byt.SSHPJ:4!null]\n" +
+			" │               │                       ├─ colSet: (36-40)\n" +
+			" │               │                       ├─ tableId: 6\n" +
+			" │               │                       └─ Table\n" +
+			" │               │                           ├─ name: TDRVG\n" +
+			" │               │                           └─ columns: [id sshpj]\n" +
+			" │               │  ->id:0]\n" +
+			" │               └─ Project\n" +
+			" │                   ├─ columns: [s7byt.SSHPJ:4!null, s7byt.SFJ6L:5!null]\n" +
+			" │                   └─ Filter\n" +
+			" │                       ├─ we72e.SSHPJ:6!null IS NULL\n" +
+			" │                       └─ LeftOuterLookupJoin\n" +
+			" │                           ├─ SubqueryAlias\n" +
+			" │                           │   ├─ name: s7byt\n" +
+			" │                           │   ├─ outerVisibility: true\n" +
+			" │                           │   ├─ isLateral: false\n" +
+			" │                           │   ├─ cacheable: true\n" +
+			" │                           │   ├─ colSet: (30,31)\n" +
+			" │                           │   ├─ tableId: 4\n" +
+			" │                           │   └─ Distinct\n" +
+			" │                           │       └─ Project\n" +
+			" │                           │           ├─ columns: [s5kbm.SSHPJ:6!null->SSHPJ:0, s5kbm.SFJ6L:7!null->SFJ6L:0]\n" +
+			" │                           │           └─ LookupJoin\n" +
+			" │                           │               ├─ TableAlias(nd)\n" +
+			" │                           │               │   └─ Table\n" +
+			" │                           │               │       ├─ name: E2I7U\n" +
+			" │                           │               │       ├─ columns: [fgg57]\n" +
+			" │                           │               │       ├─ colSet: (11-27)\n" +
+			" │                           │               │       └─ tableId: 3\n" +
+			" │                           │               └─ TableAlias(s5kbm)\n" +
+			" │                           │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │                           │                       ├─ index: [TDRVG.FGG57]\n" +
+			" │                           │                       ├─ keys: [nd.FGG57:4]\n" +
+			" │                           │                       ├─ colSet: (6-10)\n" +
+			" │                           │                       ├─ tableId: 2\n" +
+			" │                           │                       └─ Table\n" +
+			" │                           │                           ├─ name: TDRVG\n" +
+			" │                           │                           └─ columns: [fgg57 sshpj sfj6l]\n" +
+			" │                           └─ IndexedTableAccess(WE72E)\n" +
+			" │                               ├─ index: [WE72E.SSHPJ]\n" +
+			" │                               ├─ keys: [s7byt.SSHPJ:4!null]\n" +
+			" │                               ├─ colSet: (32-35)\n" +
+			" │                               ├─ tableId: 5\n" +
+			" │                               └─ Table\n" +
+			" │                                   ├─ name: WE72E\n" +
+			" │                                   └─ columns: [sshpj]\n" +
+			" └─ ProcessTable\n" +
 			"     └─ Table\n" +
-			"         └─ name: TDRVG\n" +
+			"         ├─ name: TDRVG\n" +
+			"         └─ columns: [id fgg57 sshpj sfj6l]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [tdrvg.id, tdrvg.FGG57, tdrvg.SSHPJ, tdrvg.SFJ6L]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: tdrvg.id\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [Subquery\n" +
-			"     │           │   ├─ cacheable: false\n" +
-			"     │           │   └─ Limit(1)\n" +
-			"     │           │       └─ Project\n" +
-			"     │           │           ├─ columns: [tdrvg.id]\n" +
-			"     │           │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
-			"     │           │               └─ Filter\n" +
-			"     │           │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
-			"     │           │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │           │                       ├─ index: [TDRVG.SSHPJ]\n" +
-			"     │           │                       ├─ columns: [id sshpj]\n" +
-			"     │           │                       └─ keys: s7byt.SSHPJ\n" +
-			"     │           │   as id]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L, Subquery\n" +
-			"     │               │   ├─ cacheable: false\n" +
-			"     │               │   └─ Limit(1)\n" +
-			"     │               │       └─ Project\n" +
-			"     │               │           ├─ columns: [tdrvg.id]\n" +
-			"     │               │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
-			"     │               │               └─ Filter\n" +
-			"     │               │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
-			"     │               │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │               │                       ├─ index: [TDRVG.SSHPJ]\n" +
-			"     │               │                       ├─ columns: [id sshpj]\n" +
-			"     │               │                       └─ keys: s7byt.SSHPJ\n" +
-			"     │               │   as id]\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L]\n" +
-			"     │                   └─ Filter\n" +
-			"     │                       ├─ we72e.SSHPJ IS NULL\n" +
-			"     │                       └─ LeftOuterLookupJoin (estimated cost=334.032 rows=100)\n" +
-			"     │                           ├─ SubqueryAlias\n" +
-			"     │                           │   ├─ name: s7byt\n" +
-			"     │                           │   ├─ outerVisibility: true\n" +
-			"     │                           │   ├─ isLateral: false\n" +
-			"     │                           │   ├─ cacheable: true\n" +
-			"     │                           │   ├─ colSet: (30,31)\n" +
-			"     │                           │   ├─ tableId: 4\n" +
-			"     │                           │   └─ Distinct\n" +
-			"     │                           │       └─ Project\n" +
-			"     │                           │           ├─ columns: [s5kbm.SSHPJ as SSHPJ, s5kbm.SFJ6L as SFJ6L]\n" +
-			"     │                           │           └─ LookupJoin (estimated cost=12885.375 rows=3842)\n" +
-			"     │                           │               ├─ TableAlias(nd)\n" +
-			"     │                           │               │   └─ Table\n" +
-			"     │                           │               │       ├─ name: E2I7U\n" +
-			"     │                           │               │       └─ columns: [fgg57]\n" +
-			"     │                           │               └─ TableAlias(s5kbm)\n" +
-			"     │                           │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │                           │                       ├─ index: [TDRVG.FGG57]\n" +
-			"     │                           │                       ├─ columns: [fgg57 sshpj sfj6l]\n" +
-			"     │                           │                       └─ keys: nd.FGG57\n" +
-			"     │                           └─ IndexedTableAccess(WE72E)\n" +
-			"     │                               ├─ index: [WE72E.SSHPJ]\n" +
-			"     │                               ├─ columns: [sshpj]\n" +
-			"     │                               └─ keys: s7byt.SSHPJ\n" +
-			"     └─ Table\n" +
-			"         └─ name: TDRVG\n" +
+		ExpectedEstimates: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: tdrvg.id\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [Subquery\n" +
+			" │           │   ├─ cacheable: false\n" +
+			" │           │   └─ Limit(1)\n" +
+			" │           │       └─ Project\n" +
+			" │           │           ├─ columns: [tdrvg.id]\n" +
+			" │           │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
+			" │           │               └─ Filter\n" +
+			" │           │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
+			" │           │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │           │                       ├─ index: [TDRVG.SSHPJ]\n" +
+			" │           │                       ├─ columns: [id sshpj]\n" +
+			" │           │                       └─ keys: s7byt.SSHPJ\n" +
+			" │           │   as id]\n" +
+			" │           └─ Project\n" +
+			" │               ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L, Subquery\n" +
+			" │               │   ├─ cacheable: false\n" +
+			" │               │   └─ Limit(1)\n" +
+			" │               │       └─ Project\n" +
+			" │               │           ├─ columns: [tdrvg.id]\n" +
+			" │               │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
+			" │               │               └─ Filter\n" +
+			" │               │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
+			" │               │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │               │                       ├─ index: [TDRVG.SSHPJ]\n" +
+			" │               │                       ├─ columns: [id sshpj]\n" +
+			" │               │                       └─ keys: s7byt.SSHPJ\n" +
+			" │               │   as id]\n" +
+			" │               └─ Project\n" +
+			" │                   ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L]\n" +
+			" │                   └─ Filter\n" +
+			" │                       ├─ we72e.SSHPJ IS NULL\n" +
+			" │                       └─ LeftOuterLookupJoin (estimated cost=334.032 rows=100)\n" +
+			" │                           ├─ SubqueryAlias\n" +
+			" │                           │   ├─ name: s7byt\n" +
+			" │                           │   ├─ outerVisibility: true\n" +
+			" │                           │   ├─ isLateral: false\n" +
+			" │                           │   ├─ cacheable: true\n" +
+			" │                           │   ├─ colSet: (30,31)\n" +
+			" │                           │   ├─ tableId: 4\n" +
+			" │                           │   └─ Distinct\n" +
+			" │                           │       └─ Project\n" +
+			" │                           │           ├─ columns: [s5kbm.SSHPJ as SSHPJ, s5kbm.SFJ6L as SFJ6L]\n" +
+			" │                           │           └─ LookupJoin (estimated cost=12885.375 rows=3842)\n" +
+			" │                           │               ├─ TableAlias(nd)\n" +
+			" │                           │               │   └─ Table\n" +
+			" │                           │               │       ├─ name: E2I7U\n" +
+			" │                           │               │       └─ columns: [fgg57]\n" +
+			" │                           │               └─ TableAlias(s5kbm)\n" +
+			" │                           │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │                           │                       ├─ index: [TDRVG.FGG57]\n" +
+			" │                           │                       ├─ columns: [fgg57 sshpj sfj6l]\n" +
+			" │                           │                       └─ keys: nd.FGG57\n" +
+			" │                           └─ IndexedTableAccess(WE72E)\n" +
+			" │                               ├─ index: [WE72E.SSHPJ]\n" +
+			" │                               ├─ columns: [sshpj]\n" +
+			" │                               └─ keys: s7byt.SSHPJ\n" +
+			" └─ Table\n" +
+			"     ├─ name: TDRVG\n" +
+			"     └─ columns: [id fgg57 sshpj sfj6l]\n" +
+			"",
+		ExpectedAnalysis: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: tdrvg.id\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [Subquery\n" +
+			" │           │   ├─ cacheable: false\n" +
+			" │           │   └─ Limit(1)\n" +
+			" │           │       └─ Project\n" +
+			" │           │           ├─ columns: [tdrvg.id]\n" +
+			" │           │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
+			" │           │               └─ Filter\n" +
+			" │           │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
+			" │           │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │           │                       ├─ index: [TDRVG.SSHPJ]\n" +
+			" │           │                       ├─ columns: [id sshpj]\n" +
+			" │           │                       └─ keys: s7byt.SSHPJ\n" +
+			" │           │   as id]\n" +
+			" │           └─ Project\n" +
+			" │               ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L, Subquery\n" +
+			" │               │   ├─ cacheable: false\n" +
+			" │               │   └─ Limit(1)\n" +
+			" │               │       └─ Project\n" +
+			" │               │           ├─ columns: [tdrvg.id]\n" +
+			" │               │           └─ TopN(Limit: [1]; tdrvg.id ASC)\n" +
+			" │               │               └─ Filter\n" +
+			" │               │                   ├─ (tdrvg.SSHPJ = s7byt.SSHPJ)\n" +
+			" │               │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │               │                       ├─ index: [TDRVG.SSHPJ]\n" +
+			" │               │                       ├─ columns: [id sshpj]\n" +
+			" │               │                       └─ keys: s7byt.SSHPJ\n" +
+			" │               │   as id]\n" +
+			" │               └─ Project\n" +
+			" │                   ├─ columns: [s7byt.SSHPJ, s7byt.SFJ6L]\n" +
+			" │                   └─ Filter\n" +
+			" │                       ├─ we72e.SSHPJ IS NULL\n" +
+			" │                       └─ LeftOuterLookupJoin (estimated cost=334.032 rows=100)\n" +
+			" │                           ├─ SubqueryAlias\n" +
+			" │                           │   ├─ name: s7byt\n" +
+			" │                           │   ├─ outerVisibility: true\n" +
+			" │                           │   ├─ isLateral: false\n" +
+			" │                           │   ├─ cacheable: true\n" +
+			" │                           │   ├─ colSet: (30,31)\n" +
+			" │                           │   ├─ tableId: 4\n" +
+			" │                           │   └─ Distinct\n" +
+			" │                           │       └─ Project\n" +
+			" │                           │           ├─ columns: [s5kbm.SSHPJ as SSHPJ, s5kbm.SFJ6L as SFJ6L]\n" +
+			" │                           │           └─ LookupJoin (estimated cost=12885.375 rows=3842)\n" +
+			" │                           │               ├─ TableAlias(nd)\n" +
+			" │                           │               │   └─ Table\n" +
+			" │                           │               │       ├─ name: E2I7U\n" +
+			" │                           │               │       └─ columns: [fgg57]\n" +
+			" │                           │               └─ TableAlias(s5kbm)\n" +
+			" │                           │                   └─ IndexedTableAccess(TDRVG)\n" +
+			" │                           │                       ├─ index: [TDRVG.FGG57]\n" +
+			" │                           │                       ├─ columns: [fgg57 sshpj sfj6l]\n" +
+			" │                           │                       └─ keys: nd.FGG57\n" +
+			" │                           └─ IndexedTableAccess(WE72E)\n" +
+			" │                               ├─ index: [WE72E.SSHPJ]\n" +
+			" │                               ├─ columns: [sshpj]\n" +
+			" │                               └─ keys: s7byt.SSHPJ\n" +
+			" └─ Table\n" +
+			"     ├─ name: TDRVG\n" +
+			"     └─ columns: [id fgg57 sshpj sfj6l]\n" +
 			"",
 	},
 	{
@@ -3285,7 +3305,8 @@ WHERE
 			"                                                 └─ TableAlias(nd)\n" +
 			"                                                     └─ IndexedTableAccess(E2I7U)\n" +
 			"                                                         ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                         └─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         ├─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [pbmrx.id as id, pbmrx.TW55N as UYOGN, pbmrx.ZH72S as H4JEA]\n" +
@@ -3355,7 +3376,8 @@ WHERE
 			"                                                 └─ TableAlias(nd)\n" +
 			"                                                     └─ IndexedTableAccess(E2I7U)\n" +
 			"                                                         ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                         └─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         ├─ filters: [{(NULL, ∞)}]\n" +
+			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
@@ -3382,11 +3404,11 @@ WHERE
 			"     ├─ columns: [ufc.id:0!null, ufc.T4IBQ:1, ufc.ZH72S:2, ufc.AMYXQ:3, ufc.KTNZ2:4, ufc.HIID2:5, ufc.DN3OQ:6, ufc.VVKNB:7, ufc.SH7TP:8, ufc.SRZZO:9, ufc.QZ6VT:10]\n" +
 			"     └─ HashJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ nd.ZH72S:48\n" +
+			"         │   ├─ nd.ZH72S:12\n" +
 			"         │   └─ ufc.ZH72S:2\n" +
 			"         ├─ HashJoin\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ cla.FTQLQ:12!null\n" +
+			"         │   │   ├─ cla.FTQLQ:11!null\n" +
 			"         │   │   └─ ufc.T4IBQ:1\n" +
 			"         │   ├─ Project\n" +
 			"         │   │   ├─ columns: [SISUT.id:0!null, SISUT.T4IBQ:1, SISUT.ZH72S:2, SISUT.AMYXQ:3, SISUT.KTNZ2:4, SISUT.HIID2:5, SISUT.DN3OQ:6, SISUT.VVKNB:7, SISUT.SH7TP:8, SISUT.SRZZO:9, SISUT.QZ6VT:10]\n" +
@@ -3419,15 +3441,15 @@ WHERE
 			"         │   │                           └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ left-key: TUPLE(ufc.T4IBQ:1)\n" +
-			"         │       ├─ right-key: TUPLE(cla.FTQLQ:1!null)\n" +
+			"         │       ├─ right-key: TUPLE(cla.FTQLQ:0!null)\n" +
 			"         │       └─ TableAlias(cla)\n" +
 			"         │           └─ ProcessTable\n" +
 			"         │               └─ Table\n" +
 			"         │                   ├─ name: YK2GW\n" +
-			"         │                   └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			"         │                   └─ columns: [ftqlq]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: TUPLE(ufc.ZH72S:2)\n" +
-			"             ├─ right-key: TUPLE(nd.ZH72S:7)\n" +
+			"             ├─ right-key: TUPLE(nd.ZH72S:0)\n" +
 			"             └─ TableAlias(nd)\n" +
 			"                 └─ IndexedTableAccess(E2I7U)\n" +
 			"                     ├─ index: [E2I7U.ZH72S]\n" +
@@ -3436,7 +3458,7 @@ WHERE
 			"                     ├─ tableId: 2\n" +
 			"                     └─ Table\n" +
 			"                         ├─ name: E2I7U\n" +
-			"                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                         └─ columns: [zh72s]\n" +
 			"",
 		ExpectedEstimates: "Distinct\n" +
 			" └─ Project\n" +
@@ -3454,7 +3476,8 @@ WHERE
 			"         │   │           ├─ TableAlias(ufc)\n" +
 			"         │   │           │   └─ IndexedTableAccess(SISUT)\n" +
 			"         │   │           │       ├─ index: [SISUT.id]\n" +
-			"         │   │           │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
 			"         │   │           └─ Project\n" +
 			"         │   │               ├─ columns: [amyxq.KKGN5, 1]\n" +
 			"         │   │               └─ Project\n" +
@@ -3467,14 +3490,16 @@ WHERE
 			"         │       ├─ right-key: (cla.FTQLQ)\n" +
 			"         │       └─ TableAlias(cla)\n" +
 			"         │           └─ Table\n" +
-			"         │               └─ name: YK2GW\n" +
+			"         │               ├─ name: YK2GW\n" +
+			"         │               └─ columns: [ftqlq]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (ufc.ZH72S)\n" +
 			"             ├─ right-key: (nd.ZH72S)\n" +
 			"             └─ TableAlias(nd)\n" +
 			"                 └─ IndexedTableAccess(E2I7U)\n" +
 			"                     ├─ index: [E2I7U.ZH72S]\n" +
-			"                     └─ filters: [{(NULL, ∞)}]\n" +
+			"                     ├─ filters: [{(NULL, ∞)}]\n" +
+			"                     └─ columns: [zh72s]\n" +
 			"",
 		ExpectedAnalysis: "Distinct\n" +
 			" └─ Project\n" +
@@ -3492,7 +3517,8 @@ WHERE
 			"         │   │           ├─ TableAlias(ufc)\n" +
 			"         │   │           │   └─ IndexedTableAccess(SISUT)\n" +
 			"         │   │           │       ├─ index: [SISUT.id]\n" +
-			"         │   │           │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
 			"         │   │           └─ Project\n" +
 			"         │   │               ├─ columns: [amyxq.KKGN5, 1]\n" +
 			"         │   │               └─ Project\n" +
@@ -3505,14 +3531,16 @@ WHERE
 			"         │       ├─ right-key: (cla.FTQLQ)\n" +
 			"         │       └─ TableAlias(cla)\n" +
 			"         │           └─ Table\n" +
-			"         │               └─ name: YK2GW\n" +
+			"         │               ├─ name: YK2GW\n" +
+			"         │               └─ columns: [ftqlq]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (ufc.ZH72S)\n" +
 			"             ├─ right-key: (nd.ZH72S)\n" +
 			"             └─ TableAlias(nd)\n" +
 			"                 └─ IndexedTableAccess(E2I7U)\n" +
 			"                     ├─ index: [E2I7U.ZH72S]\n" +
-			"                     └─ filters: [{(NULL, ∞)}]\n" +
+			"                     ├─ filters: [{(NULL, ∞)}]\n" +
+			"                     └─ columns: [zh72s]\n" +
 			"",
 	},
 	{
@@ -3539,11 +3567,11 @@ WHERE
 			"     ├─ columns: [ufc.id:0!null, ufc.T4IBQ:1, ufc.ZH72S:2, ufc.AMYXQ:3, ufc.KTNZ2:4, ufc.HIID2:5, ufc.DN3OQ:6, ufc.VVKNB:7, ufc.SH7TP:8, ufc.SRZZO:9, ufc.QZ6VT:10]\n" +
 			"     └─ HashJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ nd.ZH72S:48\n" +
+			"         │   ├─ nd.ZH72S:12\n" +
 			"         │   └─ ufc.ZH72S:2\n" +
 			"         ├─ HashJoin\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ cla.FTQLQ:12!null\n" +
+			"         │   │   ├─ cla.FTQLQ:11!null\n" +
 			"         │   │   └─ ufc.T4IBQ:1\n" +
 			"         │   ├─ Project\n" +
 			"         │   │   ├─ columns: [SISUT.id:0!null, SISUT.T4IBQ:1, SISUT.ZH72S:2, SISUT.AMYXQ:3, SISUT.KTNZ2:4, SISUT.HIID2:5, SISUT.DN3OQ:6, SISUT.VVKNB:7, SISUT.SH7TP:8, SISUT.SRZZO:9, SISUT.QZ6VT:10]\n" +
@@ -3576,15 +3604,15 @@ WHERE
 			"         │   │                           └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ left-key: TUPLE(ufc.T4IBQ:1)\n" +
-			"         │       ├─ right-key: TUPLE(cla.FTQLQ:1!null)\n" +
+			"         │       ├─ right-key: TUPLE(cla.FTQLQ:0!null)\n" +
 			"         │       └─ TableAlias(cla)\n" +
 			"         │           └─ ProcessTable\n" +
 			"         │               └─ Table\n" +
 			"         │                   ├─ name: YK2GW\n" +
-			"         │                   └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			"         │                   └─ columns: [ftqlq]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: TUPLE(ufc.ZH72S:2)\n" +
-			"             ├─ right-key: TUPLE(nd.ZH72S:7)\n" +
+			"             ├─ right-key: TUPLE(nd.ZH72S:0)\n" +
 			"             └─ TableAlias(nd)\n" +
 			"                 └─ IndexedTableAccess(E2I7U)\n" +
 			"                     ├─ index: [E2I7U.ZH72S]\n" +
@@ -3593,7 +3621,7 @@ WHERE
 			"                     ├─ tableId: 2\n" +
 			"                     └─ Table\n" +
 			"                         ├─ name: E2I7U\n" +
-			"                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                         └─ columns: [zh72s]\n" +
 			"",
 		ExpectedEstimates: "Distinct\n" +
 			" └─ Project\n" +
@@ -3611,7 +3639,8 @@ WHERE
 			"         │   │           ├─ TableAlias(ufc)\n" +
 			"         │   │           │   └─ IndexedTableAccess(SISUT)\n" +
 			"         │   │           │       ├─ index: [SISUT.id]\n" +
-			"         │   │           │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
 			"         │   │           └─ Project\n" +
 			"         │   │               ├─ columns: [amyxq.KKGN5, 1]\n" +
 			"         │   │               └─ Project\n" +
@@ -3624,14 +3653,16 @@ WHERE
 			"         │       ├─ right-key: (cla.FTQLQ)\n" +
 			"         │       └─ TableAlias(cla)\n" +
 			"         │           └─ Table\n" +
-			"         │               └─ name: YK2GW\n" +
+			"         │               ├─ name: YK2GW\n" +
+			"         │               └─ columns: [ftqlq]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (ufc.ZH72S)\n" +
 			"             ├─ right-key: (nd.ZH72S)\n" +
 			"             └─ TableAlias(nd)\n" +
 			"                 └─ IndexedTableAccess(E2I7U)\n" +
 			"                     ├─ index: [E2I7U.ZH72S]\n" +
-			"                     └─ filters: [{(NULL, ∞)}]\n" +
+			"                     ├─ filters: [{(NULL, ∞)}]\n" +
+			"                     └─ columns: [zh72s]\n" +
 			"",
 		ExpectedAnalysis: "Distinct\n" +
 			" └─ Project\n" +
@@ -3649,7 +3680,8 @@ WHERE
 			"         │   │           ├─ TableAlias(ufc)\n" +
 			"         │   │           │   └─ IndexedTableAccess(SISUT)\n" +
 			"         │   │           │       ├─ index: [SISUT.id]\n" +
-			"         │   │           │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           │       └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
 			"         │   │           └─ Project\n" +
 			"         │   │               ├─ columns: [amyxq.KKGN5, 1]\n" +
 			"         │   │               └─ Project\n" +
@@ -3662,14 +3694,16 @@ WHERE
 			"         │       ├─ right-key: (cla.FTQLQ)\n" +
 			"         │       └─ TableAlias(cla)\n" +
 			"         │           └─ Table\n" +
-			"         │               └─ name: YK2GW\n" +
+			"         │               ├─ name: YK2GW\n" +
+			"         │               └─ columns: [ftqlq]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (ufc.ZH72S)\n" +
 			"             ├─ right-key: (nd.ZH72S)\n" +
 			"             └─ TableAlias(nd)\n" +
 			"                 └─ IndexedTableAccess(E2I7U)\n" +
 			"                     ├─ index: [E2I7U.ZH72S]\n" +
-			"                     └─ filters: [{(NULL, ∞)}]\n" +
+			"                     ├─ filters: [{(NULL, ∞)}]\n" +
+			"                     └─ columns: [zh72s]\n" +
 			"",
 	},
 	{
@@ -3688,9 +3722,9 @@ WHERE
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [ums.id:0!null, ums.T4IBQ:1, ums.ner:2, ums.ber:3, ums.hr:4, ums.mmr:5, ums.QZ6VT:6]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [FG26Y.id:0!null, FG26Y.T4IBQ:1, FG26Y.ner:2, FG26Y.ber:3, FG26Y.hr:4, FG26Y.mmr:5, FG26Y.QZ6VT:6, YK2GW.id:7!null, YK2GW.FTQLQ:8!null, YK2GW.TUXML:9, YK2GW.PAEF5:10, YK2GW.RUCY4:11, YK2GW.TPNJ6:12!null, YK2GW.LBL53:13, YK2GW.NB3QS:14, YK2GW.EO7IV:15, YK2GW.MUHJF:16, YK2GW.FM34L:17, YK2GW.TY5RF:18, YK2GW.ZHTLH:19, YK2GW.NPB7W:20, YK2GW.SX3HH:21, YK2GW.ISBNF:22, YK2GW.YA7YB:23, YK2GW.C5YKB:24, YK2GW.QK7KT:25, YK2GW.FFGE6:26, YK2GW.FIIGJ:27, YK2GW.SH3NC:28, YK2GW.NTENA:29, YK2GW.M4AUB:30, YK2GW.X5AIR:31, YK2GW.SAB6M:32, YK2GW.G5QI5:33, YK2GW.ZVQVD:34, YK2GW.YKSSU:35, YK2GW.FHCYT:36]\n" +
+			"     ├─ columns: [FG26Y.id:0!null, FG26Y.T4IBQ:1, FG26Y.ner:2, FG26Y.ber:3, FG26Y.hr:4, FG26Y.mmr:5, FG26Y.QZ6VT:6, YK2GW.FTQLQ:7!null]\n" +
 			"     └─ Filter\n" +
-			"         ├─ 1:38 IS NULL\n" +
+			"         ├─ 1:9 IS NULL\n" +
 			"         └─ LeftOuterLookupJoin\n" +
 			"             ├─ LookupJoin\n" +
 			"             │   ├─ TableAlias(ums)\n" +
@@ -3706,7 +3740,7 @@ WHERE
 			"             │           ├─ tableId: 2\n" +
 			"             │           └─ Table\n" +
 			"             │               ├─ name: YK2GW\n" +
-			"             │               └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			"             │               └─ columns: [ftqlq]\n" +
 			"             └─ Project\n" +
 			"                 ├─ columns: [szqwj.JOGI6:0, 1 (bigint)]\n" +
 			"                 └─ Project\n" +
@@ -3723,17 +3757,19 @@ WHERE
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [ums.id, ums.T4IBQ, ums.ner, ums.ber, ums.hr, ums.mmr, ums.QZ6VT]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [FG26Y.id, FG26Y.T4IBQ, FG26Y.ner, FG26Y.ber, FG26Y.hr, FG26Y.mmr, FG26Y.QZ6VT, YK2GW.id, YK2GW.FTQLQ, YK2GW.TUXML, YK2GW.PAEF5, YK2GW.RUCY4, YK2GW.TPNJ6, YK2GW.LBL53, YK2GW.NB3QS, YK2GW.EO7IV, YK2GW.MUHJF, YK2GW.FM34L, YK2GW.TY5RF, YK2GW.ZHTLH, YK2GW.NPB7W, YK2GW.SX3HH, YK2GW.ISBNF, YK2GW.YA7YB, YK2GW.C5YKB, YK2GW.QK7KT, YK2GW.FFGE6, YK2GW.FIIGJ, YK2GW.SH3NC, YK2GW.NTENA, YK2GW.M4AUB, YK2GW.X5AIR, YK2GW.SAB6M, YK2GW.G5QI5, YK2GW.ZVQVD, YK2GW.YKSSU, YK2GW.FHCYT]\n" +
+			"     ├─ columns: [FG26Y.id, FG26Y.T4IBQ, FG26Y.ner, FG26Y.ber, FG26Y.hr, FG26Y.mmr, FG26Y.QZ6VT, YK2GW.FTQLQ]\n" +
 			"     └─ Filter\n" +
 			"         ├─ 1 IS NULL\n" +
 			"         └─ LeftOuterLookupJoin (estimated cost=3940.431 rows=1251)\n" +
 			"             ├─ LookupJoin (estimated cost=3342.455 rows=1001)\n" +
 			"             │   ├─ TableAlias(ums)\n" +
 			"             │   │   └─ Table\n" +
-			"             │   │       └─ name: FG26Y\n" +
+			"             │   │       ├─ name: FG26Y\n" +
+			"             │   │       └─ columns: [id t4ibq ner ber hr mmr qz6vt]\n" +
 			"             │   └─ TableAlias(cla)\n" +
 			"             │       └─ IndexedTableAccess(YK2GW)\n" +
 			"             │           ├─ index: [YK2GW.FTQLQ]\n" +
+			"             │           ├─ columns: [ftqlq]\n" +
 			"             │           └─ keys: ums.T4IBQ\n" +
 			"             └─ Project\n" +
 			"                 ├─ columns: [szqwj.JOGI6, 1]\n" +
@@ -3746,17 +3782,19 @@ WHERE
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [ums.id, ums.T4IBQ, ums.ner, ums.ber, ums.hr, ums.mmr, ums.QZ6VT]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [FG26Y.id, FG26Y.T4IBQ, FG26Y.ner, FG26Y.ber, FG26Y.hr, FG26Y.mmr, FG26Y.QZ6VT, YK2GW.id, YK2GW.FTQLQ, YK2GW.TUXML, YK2GW.PAEF5, YK2GW.RUCY4, YK2GW.TPNJ6, YK2GW.LBL53, YK2GW.NB3QS, YK2GW.EO7IV, YK2GW.MUHJF, YK2GW.FM34L, YK2GW.TY5RF, YK2GW.ZHTLH, YK2GW.NPB7W, YK2GW.SX3HH, YK2GW.ISBNF, YK2GW.YA7YB, YK2GW.C5YKB, YK2GW.QK7KT, YK2GW.FFGE6, YK2GW.FIIGJ, YK2GW.SH3NC, YK2GW.NTENA, YK2GW.M4AUB, YK2GW.X5AIR, YK2GW.SAB6M, YK2GW.G5QI5, YK2GW.ZVQVD, YK2GW.YKSSU, YK2GW.FHCYT]\n" +
+			"     ├─ columns: [FG26Y.id, FG26Y.T4IBQ, FG26Y.ner, FG26Y.ber, FG26Y.hr, FG26Y.mmr, FG26Y.QZ6VT, YK2GW.FTQLQ]\n" +
 			"     └─ Filter\n" +
 			"         ├─ 1 IS NULL\n" +
 			"         └─ LeftOuterLookupJoin (estimated cost=3940.431 rows=1251) (actual rows=0 loops=1)\n" +
 			"             ├─ LookupJoin (estimated cost=3342.455 rows=1001) (actual rows=0 loops=1)\n" +
 			"             │   ├─ TableAlias(ums)\n" +
 			"             │   │   └─ Table\n" +
-			"             │   │       └─ name: FG26Y\n" +
+			"             │   │       ├─ name: FG26Y\n" +
+			"             │   │       └─ columns: [id t4ibq ner ber hr mmr qz6vt]\n" +
 			"             │   └─ TableAlias(cla)\n" +
 			"             │       └─ IndexedTableAccess(YK2GW)\n" +
 			"             │           ├─ index: [YK2GW.FTQLQ]\n" +
+			"             │           ├─ columns: [ftqlq]\n" +
 			"             │           └─ keys: ums.T4IBQ\n" +
 			"             └─ Project\n" +
 			"                 ├─ columns: [szqwj.JOGI6, 1]\n" +
@@ -3832,27 +3870,27 @@ WHERE
 	)
 	`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [mf.id:0!null->id:0, cla.FTQLQ:42!null->T4IBQ:0, nd.TW55N:23!null->UWBAI:0, aac.BTXC5:18->TPXBU:0, mf.FSDY2:10!null->FSDY2:0]\n" +
+			" ├─ columns: [mf.id:0!null->id:0, cla.FTQLQ:14!null->T4IBQ:0, nd.TW55N:10!null->UWBAI:0, aac.BTXC5:8->TPXBU:0, mf.FSDY2:4!null->FSDY2:0]\n" +
 			" └─ Filter\n" +
 			"     ├─ Or\n" +
 			"     │   ├─ AND\n" +
 			"     │   │   ├─ NOT\n" +
-			"     │   │   │   └─ mf.QQV4M:15 IS NULL\n" +
+			"     │   │   │   └─ mf.QQV4M:6 IS NULL\n" +
 			"     │   │   └─ Or\n" +
 			"     │   │       ├─ Eq\n" +
 			"     │   │       │   ├─ Subquery\n" +
 			"     │   │       │   │   ├─ cacheable: false\n" +
 			"     │   │       │   │   ├─ alias-string: select TJ5D2.SWCQV from SZW6V as TJ5D2 where TJ5D2.id = mf.QQV4M\n" +
 			"     │   │       │   │   └─ Project\n" +
-			"     │   │       │   │       ├─ columns: [tj5d2.SWCQV:72!null]\n" +
+			"     │   │       │   │       ├─ columns: [tj5d2.SWCQV:16!null]\n" +
 			"     │   │       │   │       └─ Filter\n" +
 			"     │   │       │   │           ├─ Eq\n" +
-			"     │   │       │   │           │   ├─ tj5d2.id:71!null\n" +
-			"     │   │       │   │           │   └─ mf.QQV4M:15\n" +
+			"     │   │       │   │           │   ├─ tj5d2.id:15!null\n" +
+			"     │   │       │   │           │   └─ mf.QQV4M:6\n" +
 			"     │   │       │   │           └─ TableAlias(tj5d2)\n" +
 			"     │   │       │   │               └─ IndexedTableAccess(SZW6V)\n" +
 			"     │   │       │   │                   ├─ index: [SZW6V.id]\n" +
-			"     │   │       │   │                   ├─ keys: [mf.QQV4M:15]\n" +
+			"     │   │       │   │                   ├─ keys: [mf.QQV4M:6]\n" +
 			"     │   │       │   │                   ├─ colSet: (72-79)\n" +
 			"     │   │       │   │                   ├─ tableId: 6\n" +
 			"     │   │       │   │                   └─ Table\n" +
@@ -3865,23 +3903,23 @@ WHERE
 			"     │   │               │   ├─ cacheable: false\n" +
 			"     │   │               │   ├─ alias-string: select nd.id from E2I7U as nd where nd.TW55N = (select TJ5D2.H4DMT from SZW6V as TJ5D2 where TJ5D2.id = mf.QQV4M)\n" +
 			"     │   │               │   └─ Project\n" +
-			"     │   │               │       ├─ columns: [nd.id:71!null]\n" +
+			"     │   │               │       ├─ columns: [nd.id:15!null]\n" +
 			"     │   │               │       └─ Filter\n" +
 			"     │   │               │           ├─ Eq\n" +
-			"     │   │               │           │   ├─ nd.TW55N:74!null\n" +
+			"     │   │               │           │   ├─ nd.TW55N:16!null\n" +
 			"     │   │               │           │   └─ Subquery\n" +
 			"     │   │               │           │       ├─ cacheable: false\n" +
 			"     │   │               │           │       ├─ alias-string: select TJ5D2.H4DMT from SZW6V as TJ5D2 where TJ5D2.id = mf.QQV4M\n" +
 			"     │   │               │           │       └─ Project\n" +
-			"     │   │               │           │           ├─ columns: [tj5d2.H4DMT:89!null]\n" +
+			"     │   │               │           │           ├─ columns: [tj5d2.H4DMT:18!null]\n" +
 			"     │   │               │           │           └─ Filter\n" +
 			"     │   │               │           │               ├─ Eq\n" +
-			"     │   │               │           │               │   ├─ tj5d2.id:88!null\n" +
-			"     │   │               │           │               │   └─ mf.QQV4M:15\n" +
+			"     │   │               │           │               │   ├─ tj5d2.id:17!null\n" +
+			"     │   │               │           │               │   └─ mf.QQV4M:6\n" +
 			"     │   │               │           │               └─ TableAlias(tj5d2)\n" +
 			"     │   │               │           │                   └─ IndexedTableAccess(SZW6V)\n" +
 			"     │   │               │           │                       ├─ index: [SZW6V.id]\n" +
-			"     │   │               │           │                       ├─ keys: [mf.QQV4M:15]\n" +
+			"     │   │               │           │                       ├─ keys: [mf.QQV4M:6]\n" +
 			"     │   │               │           │                       ├─ colSet: (97-104)\n" +
 			"     │   │               │           │                       ├─ tableId: 8\n" +
 			"     │   │               │           │                       └─ Table\n" +
@@ -3890,51 +3928,51 @@ WHERE
 			"     │   │               │           └─ TableAlias(nd)\n" +
 			"     │   │               │               └─ Table\n" +
 			"     │   │               │                   ├─ name: E2I7U\n" +
-			"     │   │               │                   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │   │               │                   ├─ columns: [id tw55n]\n" +
 			"     │   │               │                   ├─ colSet: (80-96)\n" +
 			"     │   │               │                   └─ tableId: 7\n" +
 			"     │   │               └─ mf.LUEVY:2!null\n" +
 			"     │   └─ AND\n" +
 			"     │       ├─ NOT\n" +
-			"     │       │   └─ mf.TEUJA:14 IS NULL\n" +
+			"     │       │   └─ mf.TEUJA:5 IS NULL\n" +
 			"     │       └─ InSubquery\n" +
-			"     │           ├─ left: mf.TEUJA:14\n" +
+			"     │           ├─ left: mf.TEUJA:5\n" +
 			"     │           └─ right: Subquery\n" +
 			"     │               ├─ cacheable: true\n" +
 			"     │               ├─ alias-string: select umf.id as ORB3K from SZW6V as TJ5D2 join NZKPM as umf on umf.T4IBQ = TJ5D2.T4IBQ and umf.FGG57 = TJ5D2.V7UFH and umf.SYPKF = TJ5D2.SYPKF where TJ5D2.SWCQV = 0 and TJ5D2.id not in (select QQV4M from HGMQ6 where QQV4M is not null)\n" +
 			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [umf.id:79!null->ORB3K:0]\n" +
+			"     │                   ├─ columns: [umf.id:20!null->ORB3K:0]\n" +
 			"     │                   └─ LookupJoin\n" +
 			"     │                       ├─ AND\n" +
 			"     │                       │   ├─ Eq\n" +
-			"     │                       │   │   ├─ umf.FGG57:81\n" +
-			"     │                       │   │   └─ tj5d2.V7UFH:73!null\n" +
+			"     │                       │   │   ├─ umf.FGG57:22\n" +
+			"     │                       │   │   └─ tj5d2.V7UFH:17!null\n" +
 			"     │                       │   └─ Eq\n" +
-			"     │                       │       ├─ umf.SYPKF:87\n" +
-			"     │                       │       └─ tj5d2.SYPKF:74!null\n" +
+			"     │                       │       ├─ umf.SYPKF:23\n" +
+			"     │                       │       └─ tj5d2.SYPKF:18!null\n" +
 			"     │                       ├─ Project\n" +
-			"     │                       │   ├─ columns: [SZW6V.id:71!null, SZW6V.T4IBQ:72!null, SZW6V.V7UFH:73!null, SZW6V.SYPKF:74!null, SZW6V.H4DMT:75!null, SZW6V.SWCQV:76!null, SZW6V.YKSSU:77, SZW6V.FHCYT:78]\n" +
+			"     │                       │   ├─ columns: [SZW6V.id:15!null, SZW6V.T4IBQ:16!null, SZW6V.V7UFH:17!null, SZW6V.SYPKF:18!null, SZW6V.SWCQV:19!null]\n" +
 			"     │                       │   └─ Filter\n" +
-			"     │                       │       ├─ 1:80 IS NULL\n" +
+			"     │                       │       ├─ 1:21 IS NULL\n" +
 			"     │                       │       └─ LeftOuterLookupJoin\n" +
 			"     │                       │           ├─ Filter\n" +
 			"     │                       │           │   ├─ Eq\n" +
-			"     │                       │           │   │   ├─ tj5d2.SWCQV:76!null\n" +
+			"     │                       │           │   │   ├─ tj5d2.SWCQV:19!null\n" +
 			"     │                       │           │   │   └─ 0 (int)\n" +
 			"     │                       │           │   └─ TableAlias(tj5d2)\n" +
 			"     │                       │           │       └─ Table\n" +
 			"     │                       │           │           ├─ name: SZW6V\n" +
-			"     │                       │           │           ├─ columns: [id t4ibq v7ufh sypkf h4dmt swcqv ykssu fhcyt]\n" +
+			"     │                       │           │           ├─ columns: [id t4ibq v7ufh sypkf swcqv]\n" +
 			"     │                       │           │           ├─ colSet: (105-112)\n" +
 			"     │                       │           │           └─ tableId: 9\n" +
 			"     │                       │           └─ Project\n" +
-			"     │                       │               ├─ columns: [hgmq6.QQV4M:71, 1 (bigint)]\n" +
+			"     │                       │               ├─ columns: [hgmq6.QQV4M:15, 1 (bigint)]\n" +
 			"     │                       │               └─ Filter\n" +
 			"     │                       │                   ├─ NOT\n" +
-			"     │                       │                   │   └─ hgmq6.QQV4M:71 IS NULL\n" +
+			"     │                       │                   │   └─ hgmq6.QQV4M:15 IS NULL\n" +
 			"     │                       │                   └─ IndexedTableAccess(HGMQ6)\n" +
 			"     │                       │                       ├─ index: [HGMQ6.QQV4M]\n" +
-			"     │                       │                       ├─ keys: [tj5d2.id:71!null]\n" +
+			"     │                       │                       ├─ keys: [tj5d2.id:15!null]\n" +
 			"     │                       │                       ├─ colSet: (138-154)\n" +
 			"     │                       │                       ├─ tableId: 11\n" +
 			"     │                       │                       └─ Table\n" +
@@ -3943,24 +3981,24 @@ WHERE
 			"     │                       └─ TableAlias(umf)\n" +
 			"     │                           └─ IndexedTableAccess(NZKPM)\n" +
 			"     │                               ├─ index: [NZKPM.T4IBQ]\n" +
-			"     │                               ├─ keys: [tj5d2.T4IBQ:72!null]\n" +
+			"     │                               ├─ keys: [tj5d2.T4IBQ:16!null]\n" +
 			"     │                               ├─ colSet: (113-137)\n" +
 			"     │                               ├─ tableId: 10\n" +
 			"     │                               └─ Table\n" +
 			"     │                                   ├─ name: NZKPM\n" +
-			"     │                                   └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
+			"     │                                   └─ columns: [id t4ibq fgg57 sypkf]\n" +
 			"     └─ HashJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ bs.id:37!null\n" +
+			"         │   ├─ bs.id:11!null\n" +
 			"         │   └─ mf.GXLUB:1!null\n" +
 			"         ├─ HashJoin\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ nd.id:20!null\n" +
+			"         │   │   ├─ nd.id:9!null\n" +
 			"         │   │   └─ mf.LUEVY:2!null\n" +
 			"         │   ├─ MergeJoin\n" +
 			"         │   │   ├─ cmp: Eq\n" +
 			"         │   │   │   ├─ mf.M22QN:3!null\n" +
-			"         │   │   │   └─ aac.id:17!null\n" +
+			"         │   │   │   └─ aac.id:7!null\n" +
 			"         │   │   ├─ TableAlias(mf)\n" +
 			"         │   │   │   └─ IndexedTableAccess(HGMQ6)\n" +
 			"         │   │   │       ├─ index: [HGMQ6.M22QN]\n" +
@@ -3969,7 +4007,7 @@ WHERE
 			"         │   │   │       ├─ tableId: 1\n" +
 			"         │   │   │       └─ Table\n" +
 			"         │   │   │           ├─ name: HGMQ6\n" +
-			"         │   │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			"         │   │   │           └─ columns: [id gxlub luevy m22qn fsdy2 teuja qqv4m]\n" +
 			"         │   │   └─ TableAlias(aac)\n" +
 			"         │   │       └─ IndexedTableAccess(TPXBU)\n" +
 			"         │   │           ├─ index: [TPXBU.id]\n" +
@@ -3978,7 +4016,7 @@ WHERE
 			"         │   │           ├─ tableId: 5\n" +
 			"         │   │           └─ Table\n" +
 			"         │   │               ├─ name: TPXBU\n" +
-			"         │   │               └─ columns: [id btxc5 fhcyt]\n" +
+			"         │   │               └─ columns: [id btxc5]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ left-key: TUPLE(mf.LUEVY:2!null)\n" +
 			"         │       ├─ right-key: TUPLE(nd.id:0!null)\n" +
@@ -3986,14 +4024,14 @@ WHERE
 			"         │           └─ ProcessTable\n" +
 			"         │               └─ Table\n" +
 			"         │                   ├─ name: E2I7U\n" +
-			"         │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"         │                   └─ columns: [id tw55n]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: TUPLE(mf.GXLUB:1!null)\n" +
 			"             ├─ right-key: TUPLE(bs.id:0!null)\n" +
 			"             └─ MergeJoin\n" +
 			"                 ├─ cmp: Eq\n" +
-			"                 │   ├─ bs.IXUXU:39\n" +
-			"                 │   └─ cla.id:41!null\n" +
+			"                 │   ├─ bs.IXUXU:12\n" +
+			"                 │   └─ cla.id:13!null\n" +
 			"                 ├─ TableAlias(bs)\n" +
 			"                 │   └─ IndexedTableAccess(THNTS)\n" +
 			"                 │       ├─ index: [THNTS.IXUXU]\n" +
@@ -4002,7 +4040,7 @@ WHERE
 			"                 │       ├─ tableId: 2\n" +
 			"                 │       └─ Table\n" +
 			"                 │           ├─ name: THNTS\n" +
-			"                 │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
+			"                 │           └─ columns: [id ixuxu]\n" +
 			"                 └─ TableAlias(cla)\n" +
 			"                     └─ IndexedTableAccess(YK2GW)\n" +
 			"                         ├─ index: [YK2GW.id]\n" +
@@ -4011,7 +4049,7 @@ WHERE
 			"                         ├─ tableId: 3\n" +
 			"                         └─ Table\n" +
 			"                             ├─ name: YK2GW\n" +
-			"                             └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			"                             └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [mf.id as id, cla.FTQLQ as T4IBQ, nd.TW55N as UWBAI, aac.BTXC5 as TPXBU, mf.FSDY2 as FSDY2]\n" +
@@ -4025,7 +4063,7 @@ WHERE
 			"     │           └─ LookupJoin\n" +
 			"     │               ├─ ((umf.FGG57 = tj5d2.V7UFH) AND (umf.SYPKF = tj5d2.SYPKF))\n" +
 			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [SZW6V.id, SZW6V.T4IBQ, SZW6V.V7UFH, SZW6V.SYPKF, SZW6V.H4DMT, SZW6V.SWCQV, SZW6V.YKSSU, SZW6V.FHCYT]\n" +
+			"     │               │   ├─ columns: [SZW6V.id, SZW6V.T4IBQ, SZW6V.V7UFH, SZW6V.SYPKF, SZW6V.SWCQV]\n" +
 			"     │               │   └─ Filter\n" +
 			"     │               │       ├─ 1 IS NULL\n" +
 			"     │               │       └─ LeftOuterLookupJoin\n" +
@@ -4033,7 +4071,8 @@ WHERE
 			"     │               │           │   ├─ (tj5d2.SWCQV = 0)\n" +
 			"     │               │           │   └─ TableAlias(tj5d2)\n" +
 			"     │               │           │       └─ Table\n" +
-			"     │               │           │           └─ name: SZW6V\n" +
+			"     │               │           │           ├─ name: SZW6V\n" +
+			"     │               │           │           └─ columns: [id t4ibq v7ufh sypkf swcqv]\n" +
 			"     │               │           └─ Project\n" +
 			"     │               │               ├─ columns: [hgmq6.QQV4M, 1]\n" +
 			"     │               │               └─ Filter\n" +
@@ -4045,6 +4084,7 @@ WHERE
 			"     │               └─ TableAlias(umf)\n" +
 			"     │                   └─ IndexedTableAccess(NZKPM)\n" +
 			"     │                       ├─ index: [NZKPM.T4IBQ]\n" +
+			"     │                       ├─ columns: [id t4ibq fgg57 sypkf]\n" +
 			"     │                       └─ keys: tj5d2.T4IBQ\n" +
 			"     │  ))\n" +
 			"     └─ HashJoin (estimated cost=529702.380 rows=511969)\n" +
@@ -4056,17 +4096,20 @@ WHERE
 			"         │   │   ├─ TableAlias(mf)\n" +
 			"         │   │   │   └─ IndexedTableAccess(HGMQ6)\n" +
 			"         │   │   │       ├─ index: [HGMQ6.M22QN]\n" +
-			"         │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       └─ columns: [id gxlub luevy m22qn fsdy2 teuja qqv4m]\n" +
 			"         │   │   └─ TableAlias(aac)\n" +
 			"         │   │       └─ IndexedTableAccess(TPXBU)\n" +
 			"         │   │           ├─ index: [TPXBU.id]\n" +
-			"         │   │           └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           └─ columns: [id btxc5]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ left-key: (mf.LUEVY)\n" +
 			"         │       ├─ right-key: (nd.id)\n" +
 			"         │       └─ TableAlias(nd)\n" +
 			"         │           └─ Table\n" +
-			"         │               └─ name: E2I7U\n" +
+			"         │               ├─ name: E2I7U\n" +
+			"         │               └─ columns: [id tw55n]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (mf.GXLUB)\n" +
 			"             ├─ right-key: (bs.id)\n" +
@@ -4075,11 +4118,13 @@ WHERE
 			"                 ├─ TableAlias(bs)\n" +
 			"                 │   └─ IndexedTableAccess(THNTS)\n" +
 			"                 │       ├─ index: [THNTS.IXUXU]\n" +
-			"                 │       └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       └─ columns: [id ixuxu]\n" +
 			"                 └─ TableAlias(cla)\n" +
 			"                     └─ IndexedTableAccess(YK2GW)\n" +
 			"                         ├─ index: [YK2GW.id]\n" +
-			"                         └─ filters: [{[NULL, ∞)}]\n" +
+			"                         ├─ filters: [{[NULL, ∞)}]\n" +
+			"                         └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [mf.id as id, cla.FTQLQ as T4IBQ, nd.TW55N as UWBAI, aac.BTXC5 as TPXBU, mf.FSDY2 as FSDY2]\n" +
@@ -4093,7 +4138,7 @@ WHERE
 			"     │           └─ LookupJoin\n" +
 			"     │               ├─ ((umf.FGG57 = tj5d2.V7UFH) AND (umf.SYPKF = tj5d2.SYPKF))\n" +
 			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [SZW6V.id, SZW6V.T4IBQ, SZW6V.V7UFH, SZW6V.SYPKF, SZW6V.H4DMT, SZW6V.SWCQV, SZW6V.YKSSU, SZW6V.FHCYT]\n" +
+			"     │               │   ├─ columns: [SZW6V.id, SZW6V.T4IBQ, SZW6V.V7UFH, SZW6V.SYPKF, SZW6V.SWCQV]\n" +
 			"     │               │   └─ Filter\n" +
 			"     │               │       ├─ 1 IS NULL\n" +
 			"     │               │       └─ LeftOuterLookupJoin\n" +
@@ -4101,7 +4146,8 @@ WHERE
 			"     │               │           │   ├─ (tj5d2.SWCQV = 0)\n" +
 			"     │               │           │   └─ TableAlias(tj5d2)\n" +
 			"     │               │           │       └─ Table\n" +
-			"     │               │           │           └─ name: SZW6V\n" +
+			"     │               │           │           ├─ name: SZW6V\n" +
+			"     │               │           │           └─ columns: [id t4ibq v7ufh sypkf swcqv]\n" +
 			"     │               │           └─ Project\n" +
 			"     │               │               ├─ columns: [hgmq6.QQV4M, 1]\n" +
 			"     │               │               └─ Filter\n" +
@@ -4113,6 +4159,7 @@ WHERE
 			"     │               └─ TableAlias(umf)\n" +
 			"     │                   └─ IndexedTableAccess(NZKPM)\n" +
 			"     │                       ├─ index: [NZKPM.T4IBQ]\n" +
+			"     │                       ├─ columns: [id t4ibq fgg57 sypkf]\n" +
 			"     │                       └─ keys: tj5d2.T4IBQ\n" +
 			"     │  ))\n" +
 			"     └─ HashJoin (estimated cost=529702.380 rows=511969) (actual rows=0 loops=1)\n" +
@@ -4124,17 +4171,20 @@ WHERE
 			"         │   │   ├─ TableAlias(mf)\n" +
 			"         │   │   │   └─ IndexedTableAccess(HGMQ6)\n" +
 			"         │   │   │       ├─ index: [HGMQ6.M22QN]\n" +
-			"         │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       └─ columns: [id gxlub luevy m22qn fsdy2 teuja qqv4m]\n" +
 			"         │   │   └─ TableAlias(aac)\n" +
 			"         │   │       └─ IndexedTableAccess(TPXBU)\n" +
 			"         │   │           ├─ index: [TPXBU.id]\n" +
-			"         │   │           └─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │           └─ columns: [id btxc5]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ left-key: (mf.LUEVY)\n" +
 			"         │       ├─ right-key: (nd.id)\n" +
 			"         │       └─ TableAlias(nd)\n" +
 			"         │           └─ Table\n" +
-			"         │               └─ name: E2I7U\n" +
+			"         │               ├─ name: E2I7U\n" +
+			"         │               └─ columns: [id tw55n]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (mf.GXLUB)\n" +
 			"             ├─ right-key: (bs.id)\n" +
@@ -4143,11 +4193,13 @@ WHERE
 			"                 ├─ TableAlias(bs)\n" +
 			"                 │   └─ IndexedTableAccess(THNTS)\n" +
 			"                 │       ├─ index: [THNTS.IXUXU]\n" +
-			"                 │       └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       └─ columns: [id ixuxu]\n" +
 			"                 └─ TableAlias(cla)\n" +
 			"                     └─ IndexedTableAccess(YK2GW)\n" +
 			"                         ├─ index: [YK2GW.id]\n" +
-			"                         └─ filters: [{[NULL, ∞)}]\n" +
+			"                         ├─ filters: [{[NULL, ∞)}]\n" +
+			"                         └─ columns: [id ftqlq]\n" +
 			"",
 	},
 	{
@@ -4175,11 +4227,11 @@ WHERE
 			" ├─ columns: [umf.id:0!null, umf.T4IBQ:1, umf.FGG57:2, umf.SSHPJ:3, umf.NLA6O:4, umf.SFJ6L:5, umf.TJPT7:6, umf.ARN5P:7, umf.SYPKF:8, umf.IVFMK:9, umf.IDE43:10, umf.AZ6SP:11, umf.FSDY2:12, umf.XOSD4:13, umf.HMW4H:14, umf.S76OM:15, umf.vaf:16, umf.ZROH6:17, umf.QCGTS:18, umf.LNFM6:19, umf.TVAWL:20, umf.HDLCL:21, umf.BHHW6:22, umf.FHCYT:23, umf.QZ6VT:24]\n" +
 			" └─ HashJoin\n" +
 			"     ├─ Eq\n" +
-			"     │   ├─ nd.FGG57:61\n" +
+			"     │   ├─ nd.FGG57:26\n" +
 			"     │   └─ umf.FGG57:2\n" +
 			"     ├─ HashJoin\n" +
 			"     │   ├─ Eq\n" +
-			"     │   │   ├─ cla.FTQLQ:26!null\n" +
+			"     │   │   ├─ cla.FTQLQ:25!null\n" +
 			"     │   │   └─ umf.T4IBQ:1\n" +
 			"     │   ├─ Project\n" +
 			"     │   │   ├─ columns: [NZKPM.id:0!null, NZKPM.T4IBQ:1, NZKPM.FGG57:2, NZKPM.SSHPJ:3, NZKPM.NLA6O:4, NZKPM.SFJ6L:5, NZKPM.TJPT7:6, NZKPM.ARN5P:7, NZKPM.SYPKF:8, NZKPM.IVFMK:9, NZKPM.IDE43:10, NZKPM.AZ6SP:11, NZKPM.FSDY2:12, NZKPM.XOSD4:13, NZKPM.HMW4H:14, NZKPM.S76OM:15, NZKPM.vaf:16, NZKPM.ZROH6:17, NZKPM.QCGTS:18, NZKPM.LNFM6:19, NZKPM.TVAWL:20, NZKPM.HDLCL:21, NZKPM.BHHW6:22, NZKPM.FHCYT:23, NZKPM.QZ6VT:24]\n" +
@@ -4217,15 +4269,15 @@ WHERE
 			"     │   │                           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			"     │   └─ HashLookup\n" +
 			"     │       ├─ left-key: TUPLE(umf.T4IBQ:1)\n" +
-			"     │       ├─ right-key: TUPLE(cla.FTQLQ:1!null)\n" +
+			"     │       ├─ right-key: TUPLE(cla.FTQLQ:0!null)\n" +
 			"     │       └─ TableAlias(cla)\n" +
 			"     │           └─ ProcessTable\n" +
 			"     │               └─ Table\n" +
 			"     │                   ├─ name: YK2GW\n" +
-			"     │                   └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			"     │                   └─ columns: [ftqlq]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ left-key: TUPLE(umf.FGG57:2)\n" +
-			"         ├─ right-key: TUPLE(nd.FGG57:6)\n" +
+			"         ├─ right-key: TUPLE(nd.FGG57:0)\n" +
 			"         └─ TableAlias(nd)\n" +
 			"             └─ IndexedTableAccess(E2I7U)\n" +
 			"                 ├─ index: [E2I7U.FGG57]\n" +
@@ -4234,7 +4286,7 @@ WHERE
 			"                 ├─ tableId: 2\n" +
 			"                 └─ Table\n" +
 			"                     ├─ name: E2I7U\n" +
-			"                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                     └─ columns: [fgg57]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [umf.id, umf.T4IBQ, umf.FGG57, umf.SSHPJ, umf.NLA6O, umf.SFJ6L, umf.TJPT7, umf.ARN5P, umf.SYPKF, umf.IVFMK, umf.IDE43, umf.AZ6SP, umf.FSDY2, umf.XOSD4, umf.HMW4H, umf.S76OM, umf.vaf, umf.ZROH6, umf.QCGTS, umf.LNFM6, umf.TVAWL, umf.HDLCL, umf.BHHW6, umf.FHCYT, umf.QZ6VT]\n" +
@@ -4253,7 +4305,8 @@ WHERE
 			"     │   │           │   └─ TableAlias(umf)\n" +
 			"     │   │           │       └─ IndexedTableAccess(NZKPM)\n" +
 			"     │   │           │           ├─ index: [NZKPM.id]\n" +
-			"     │   │           │           └─ filters: [{[NULL, ∞)}]\n" +
+			"     │   │           │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │   │           │           └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
 			"     │   │           └─ Project\n" +
 			"     │   │               ├─ columns: [hgmq6.TEUJA, 1]\n" +
 			"     │   │               └─ Project\n" +
@@ -4266,14 +4319,16 @@ WHERE
 			"     │       ├─ right-key: (cla.FTQLQ)\n" +
 			"     │       └─ TableAlias(cla)\n" +
 			"     │           └─ Table\n" +
-			"     │               └─ name: YK2GW\n" +
+			"     │               ├─ name: YK2GW\n" +
+			"     │               └─ columns: [ftqlq]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ left-key: (umf.FGG57)\n" +
 			"         ├─ right-key: (nd.FGG57)\n" +
 			"         └─ TableAlias(nd)\n" +
 			"             └─ IndexedTableAccess(E2I7U)\n" +
 			"                 ├─ index: [E2I7U.FGG57]\n" +
-			"                 └─ filters: [{(NULL, ∞)}]\n" +
+			"                 ├─ filters: [{(NULL, ∞)}]\n" +
+			"                 └─ columns: [fgg57]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [umf.id, umf.T4IBQ, umf.FGG57, umf.SSHPJ, umf.NLA6O, umf.SFJ6L, umf.TJPT7, umf.ARN5P, umf.SYPKF, umf.IVFMK, umf.IDE43, umf.AZ6SP, umf.FSDY2, umf.XOSD4, umf.HMW4H, umf.S76OM, umf.vaf, umf.ZROH6, umf.QCGTS, umf.LNFM6, umf.TVAWL, umf.HDLCL, umf.BHHW6, umf.FHCYT, umf.QZ6VT]\n" +
@@ -4292,7 +4347,8 @@ WHERE
 			"     │   │           │   └─ TableAlias(umf)\n" +
 			"     │   │           │       └─ IndexedTableAccess(NZKPM)\n" +
 			"     │   │           │           ├─ index: [NZKPM.id]\n" +
-			"     │   │           │           └─ filters: [{[NULL, ∞)}]\n" +
+			"     │   │           │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │   │           │           └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
 			"     │   │           └─ Project\n" +
 			"     │   │               ├─ columns: [hgmq6.TEUJA, 1]\n" +
 			"     │   │               └─ Project\n" +
@@ -4305,14 +4361,16 @@ WHERE
 			"     │       ├─ right-key: (cla.FTQLQ)\n" +
 			"     │       └─ TableAlias(cla)\n" +
 			"     │           └─ Table\n" +
-			"     │               └─ name: YK2GW\n" +
+			"     │               ├─ name: YK2GW\n" +
+			"     │               └─ columns: [ftqlq]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ left-key: (umf.FGG57)\n" +
 			"         ├─ right-key: (nd.FGG57)\n" +
 			"         └─ TableAlias(nd)\n" +
 			"             └─ IndexedTableAccess(E2I7U)\n" +
 			"                 ├─ index: [E2I7U.FGG57]\n" +
-			"                 └─ filters: [{(NULL, ∞)}]\n" +
+			"                 ├─ filters: [{(NULL, ∞)}]\n" +
+			"                 └─ columns: [fgg57]\n" +
 			"",
 	},
 	{
@@ -4690,26 +4748,26 @@ WHERE
 			"             │               │   ├─ colSet: (192-197)\n" +
 			"             │               │   ├─ tableId: 19\n" +
 			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id:0!null->BDNYB:0, ci.FTQLQ:23!null->TOFPN:0, ct.M22QN:13!null->M22QN:0, cec.ADURZ:26!null->ADURZ:0, cec.NO52D:25!null->NO52D:0, ct.S3Q3Y:19!null->IDPK7:0]\n" +
+			"             │               │       ├─ columns: [sn.id:0!null->BDNYB:0, ci.FTQLQ:9!null->TOFPN:0, ct.M22QN:4!null->M22QN:0, cec.ADURZ:12!null->ADURZ:0, cec.NO52D:11!null->NO52D:0, ct.S3Q3Y:6!null->IDPK7:0]\n" +
 			"             │               │       └─ HashJoin\n" +
 			"             │               │           ├─ Eq\n" +
-			"             │               │           │   ├─ cec.id:24!null\n" +
-			"             │               │           │   └─ ct.OVE3E:14!null\n" +
+			"             │               │           │   ├─ cec.id:10!null\n" +
+			"             │               │           │   └─ ct.OVE3E:5!null\n" +
 			"             │               │           ├─ HashJoin\n" +
 			"             │               │           │   ├─ Eq\n" +
-			"             │               │           │   │   ├─ ci.id:22!null\n" +
-			"             │               │           │   │   └─ ct.FZ2R5:11!null\n" +
+			"             │               │           │   │   ├─ ci.id:8!null\n" +
+			"             │               │           │   │   └─ ct.FZ2R5:2!null\n" +
 			"             │               │           │   ├─ MergeJoin\n" +
 			"             │               │           │   │   ├─ cmp: Eq\n" +
 			"             │               │           │   │   │   ├─ sn.BRQP2:1!null\n" +
-			"             │               │           │   │   │   └─ ct.LUEVY:12!null\n" +
+			"             │               │           │   │   │   └─ ct.LUEVY:3!null\n" +
 			"             │               │           │   │   ├─ sel: Eq\n" +
-			"             │               │           │   │   │   ├─ ct.M22QN:13!null\n" +
+			"             │               │           │   │   │   ├─ ct.M22QN:4!null\n" +
 			"             │               │           │   │   │   └─ Subquery\n" +
 			"             │               │           │   │   │       ├─ cacheable: true\n" +
 			"             │               │           │   │   │       ├─ alias-string: select aac.id from TPXBU as aac where BTXC5 = 'WT'\n" +
 			"             │               │           │   │   │       └─ Project\n" +
-			"             │               │           │   │   │           ├─ columns: [aac.id:22!null]\n" +
+			"             │               │           │   │   │           ├─ columns: [aac.id:8!null]\n" +
 			"             │               │           │   │   │           └─ TableAlias(aac)\n" +
 			"             │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
 			"             │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
@@ -4727,10 +4785,10 @@ WHERE
 			"             │               │           │   │   │       ├─ tableId: 14\n" +
 			"             │               │           │   │   │       └─ Table\n" +
 			"             │               │           │   │   │           ├─ name: NOXN3\n" +
-			"             │               │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"             │               │           │   │   │           └─ columns: [id brqp2]\n" +
 			"             │               │           │   │   └─ Filter\n" +
 			"             │               │           │   │       ├─ Eq\n" +
-			"             │               │           │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"             │               │           │   │       │   ├─ ct.ZRV3B:5!null\n" +
 			"             │               │           │   │       │   └─ = (longtext)\n" +
 			"             │               │           │   │       └─ TableAlias(ct)\n" +
 			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
@@ -4740,9 +4798,9 @@ WHERE
 			"             │               │           │   │               ├─ tableId: 15\n" +
 			"             │               │           │   │               └─ Table\n" +
 			"             │               │           │   │                   ├─ name: FLQLP\n" +
-			"             │               │           │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"             │               │           │   │                   └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:11!null)\n" +
+			"             │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:2!null)\n" +
 			"             │               │           │       ├─ right-key: TUPLE(ci.id:0!null)\n" +
 			"             │               │           │       └─ TableAlias(ci)\n" +
 			"             │               │           │           └─ IndexedTableAccess(JDLNA)\n" +
@@ -4754,7 +4812,7 @@ WHERE
 			"             │               │           │                   ├─ name: JDLNA\n" +
 			"             │               │           │                   └─ columns: [id ftqlq]\n" +
 			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: TUPLE(ct.OVE3E:14!null)\n" +
+			"             │               │               ├─ left-key: TUPLE(ct.OVE3E:5!null)\n" +
 			"             │               │               ├─ right-key: TUPLE(cec.id:0!null)\n" +
 			"             │               │               └─ TableAlias(cec)\n" +
 			"             │               │                   └─ Table\n" +
@@ -4890,20 +4948,24 @@ WHERE
 			" │               │   │   │   └─ TableAlias(sn)\n" +
 			" │               │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
 			" │               │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │               │   │   │           ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │               │   │   │           └─ keys: sl3s5.BDNYB\n" +
 			" │               │   │   └─ TableAlias(mf)\n" +
 			" │               │   │       └─ IndexedTableAccess(HGMQ6)\n" +
 			" │               │   │           ├─ index: [HGMQ6.M22QN]\n" +
+			" │               │   │           ├─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │               │   │           └─ keys: sl3s5.M22QN\n" +
 			" │               │   └─ TableAlias(bs)\n" +
 			" │               │       └─ IndexedTableAccess(THNTS)\n" +
 			" │               │           ├─ index: [THNTS.id]\n" +
+			" │               │           ├─ columns: [id nfryn ixuxu fhcyt]\n" +
 			" │               │           └─ keys: mf.GXLUB\n" +
 			" │               └─ Filter\n" +
 			" │                   ├─ (cla.FTQLQ HASH IN ('SQ1'))\n" +
 			" │                   └─ TableAlias(cla)\n" +
 			" │                       └─ IndexedTableAccess(YK2GW)\n" +
 			" │                           ├─ index: [YK2GW.id]\n" +
+			" │                           ├─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
@@ -4963,13 +5025,15 @@ WHERE
 			"             │               │           │   │   ├─ TableAlias(sn)\n" +
 			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       └─ columns: [id brqp2]\n" +
 			"             │               │           │   │   └─ Filter\n" +
 			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
 			"             │               │           │   │       └─ TableAlias(ct)\n" +
 			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
 			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			"             │               │           │   └─ HashLookup\n" +
 			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
 			"             │               │           │       ├─ right-key: (ci.id)\n" +
@@ -4988,6 +5052,7 @@ WHERE
 			"             │               └─ TableAlias(sn)\n" +
 			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │                       ├─ index: [NOXN3.id]\n" +
+			"             │                       ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │                       └─ keys: sl3s5.BDNYB\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: ()\n" +
@@ -5106,20 +5171,24 @@ WHERE
 			" │               │   │   │   └─ TableAlias(sn)\n" +
 			" │               │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
 			" │               │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │               │   │   │           ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │               │   │   │           └─ keys: sl3s5.BDNYB\n" +
 			" │               │   │   └─ TableAlias(mf)\n" +
 			" │               │   │       └─ IndexedTableAccess(HGMQ6)\n" +
 			" │               │   │           ├─ index: [HGMQ6.M22QN]\n" +
+			" │               │   │           ├─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │               │   │           └─ keys: sl3s5.M22QN\n" +
 			" │               │   └─ TableAlias(bs)\n" +
 			" │               │       └─ IndexedTableAccess(THNTS)\n" +
 			" │               │           ├─ index: [THNTS.id]\n" +
+			" │               │           ├─ columns: [id nfryn ixuxu fhcyt]\n" +
 			" │               │           └─ keys: mf.GXLUB\n" +
 			" │               └─ Filter\n" +
 			" │                   ├─ (cla.FTQLQ HASH IN ('SQ1'))\n" +
 			" │                   └─ TableAlias(cla)\n" +
 			" │                       └─ IndexedTableAccess(YK2GW)\n" +
 			" │                           ├─ index: [YK2GW.id]\n" +
+			" │                           ├─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
@@ -5179,13 +5248,15 @@ WHERE
 			"             │               │           │   │   ├─ TableAlias(sn)\n" +
 			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       └─ columns: [id brqp2]\n" +
 			"             │               │           │   │   └─ Filter\n" +
 			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
 			"             │               │           │   │       └─ TableAlias(ct)\n" +
 			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
 			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			"             │               │           │   └─ HashLookup\n" +
 			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
 			"             │               │           │       ├─ right-key: (ci.id)\n" +
@@ -5204,6 +5275,7 @@ WHERE
 			"             │               └─ TableAlias(sn)\n" +
 			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │                       ├─ index: [NOXN3.id]\n" +
+			"             │                       ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │                       └─ keys: sl3s5.BDNYB\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: ()\n" +
@@ -5559,26 +5631,26 @@ WHERE
 			"             │               │   ├─ colSet: (192-197)\n" +
 			"             │               │   ├─ tableId: 19\n" +
 			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id:0!null->BDNYB:0, ci.FTQLQ:23!null->TOFPN:0, ct.M22QN:13!null->M22QN:0, cec.ADURZ:26!null->ADURZ:0, cec.NO52D:25!null->NO52D:0, ct.S3Q3Y:19!null->IDPK7:0]\n" +
+			"             │               │       ├─ columns: [sn.id:0!null->BDNYB:0, ci.FTQLQ:9!null->TOFPN:0, ct.M22QN:4!null->M22QN:0, cec.ADURZ:12!null->ADURZ:0, cec.NO52D:11!null->NO52D:0, ct.S3Q3Y:6!null->IDPK7:0]\n" +
 			"             │               │       └─ HashJoin\n" +
 			"             │               │           ├─ Eq\n" +
-			"             │               │           │   ├─ cec.id:24!null\n" +
-			"             │               │           │   └─ ct.OVE3E:14!null\n" +
+			"             │               │           │   ├─ cec.id:10!null\n" +
+			"             │               │           │   └─ ct.OVE3E:5!null\n" +
 			"             │               │           ├─ HashJoin\n" +
 			"             │               │           │   ├─ Eq\n" +
-			"             │               │           │   │   ├─ ci.id:22!null\n" +
-			"             │               │           │   │   └─ ct.FZ2R5:11!null\n" +
+			"             │               │           │   │   ├─ ci.id:8!null\n" +
+			"             │               │           │   │   └─ ct.FZ2R5:2!null\n" +
 			"             │               │           │   ├─ MergeJoin\n" +
 			"             │               │           │   │   ├─ cmp: Eq\n" +
 			"             │               │           │   │   │   ├─ sn.BRQP2:1!null\n" +
-			"             │               │           │   │   │   └─ ct.LUEVY:12!null\n" +
+			"             │               │           │   │   │   └─ ct.LUEVY:3!null\n" +
 			"             │               │           │   │   ├─ sel: Eq\n" +
-			"             │               │           │   │   │   ├─ ct.M22QN:13!null\n" +
+			"             │               │           │   │   │   ├─ ct.M22QN:4!null\n" +
 			"             │               │           │   │   │   └─ Subquery\n" +
 			"             │               │           │   │   │       ├─ cacheable: true\n" +
 			"             │               │           │   │   │       ├─ alias-string: select aac.id from TPXBU as aac where BTXC5 = 'WT'\n" +
 			"             │               │           │   │   │       └─ Project\n" +
-			"             │               │           │   │   │           ├─ columns: [aac.id:22!null]\n" +
+			"             │               │           │   │   │           ├─ columns: [aac.id:8!null]\n" +
 			"             │               │           │   │   │           └─ TableAlias(aac)\n" +
 			"             │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
 			"             │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
@@ -5596,10 +5668,10 @@ WHERE
 			"             │               │           │   │   │       ├─ tableId: 14\n" +
 			"             │               │           │   │   │       └─ Table\n" +
 			"             │               │           │   │   │           ├─ name: NOXN3\n" +
-			"             │               │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"             │               │           │   │   │           └─ columns: [id brqp2]\n" +
 			"             │               │           │   │   └─ Filter\n" +
 			"             │               │           │   │       ├─ Eq\n" +
-			"             │               │           │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"             │               │           │   │       │   ├─ ct.ZRV3B:5!null\n" +
 			"             │               │           │   │       │   └─ = (longtext)\n" +
 			"             │               │           │   │       └─ TableAlias(ct)\n" +
 			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
@@ -5609,9 +5681,9 @@ WHERE
 			"             │               │           │   │               ├─ tableId: 15\n" +
 			"             │               │           │   │               └─ Table\n" +
 			"             │               │           │   │                   ├─ name: FLQLP\n" +
-			"             │               │           │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"             │               │           │   │                   └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:11!null)\n" +
+			"             │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:2!null)\n" +
 			"             │               │           │       ├─ right-key: TUPLE(ci.id:0!null)\n" +
 			"             │               │           │       └─ TableAlias(ci)\n" +
 			"             │               │           │           └─ IndexedTableAccess(JDLNA)\n" +
@@ -5623,7 +5695,7 @@ WHERE
 			"             │               │           │                   ├─ name: JDLNA\n" +
 			"             │               │           │                   └─ columns: [id ftqlq]\n" +
 			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: TUPLE(ct.OVE3E:14!null)\n" +
+			"             │               │               ├─ left-key: TUPLE(ct.OVE3E:5!null)\n" +
 			"             │               │               ├─ right-key: TUPLE(cec.id:0!null)\n" +
 			"             │               │               └─ TableAlias(cec)\n" +
 			"             │               │                   └─ Table\n" +
@@ -5754,20 +5826,24 @@ WHERE
 			" │               │   │   │   └─ TableAlias(sn)\n" +
 			" │               │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
 			" │               │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │               │   │   │           ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │               │   │   │           └─ keys: sl3s5.BDNYB\n" +
 			" │               │   │   └─ TableAlias(mf)\n" +
 			" │               │   │       └─ IndexedTableAccess(HGMQ6)\n" +
 			" │               │   │           ├─ index: [HGMQ6.M22QN]\n" +
+			" │               │   │           ├─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │               │   │           └─ keys: sl3s5.M22QN\n" +
 			" │               │   └─ TableAlias(bs)\n" +
 			" │               │       └─ IndexedTableAccess(THNTS)\n" +
 			" │               │           ├─ index: [THNTS.id]\n" +
+			" │               │           ├─ columns: [id nfryn ixuxu fhcyt]\n" +
 			" │               │           └─ keys: mf.GXLUB\n" +
 			" │               └─ Filter\n" +
 			" │                   ├─ (cla.FTQLQ HASH IN ('SQ1'))\n" +
 			" │                   └─ TableAlias(cla)\n" +
 			" │                       └─ IndexedTableAccess(YK2GW)\n" +
 			" │                           ├─ index: [YK2GW.id]\n" +
+			" │                           ├─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
@@ -5827,13 +5903,15 @@ WHERE
 			"             │               │           │   │   ├─ TableAlias(sn)\n" +
 			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       └─ columns: [id brqp2]\n" +
 			"             │               │           │   │   └─ Filter\n" +
 			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
 			"             │               │           │   │       └─ TableAlias(ct)\n" +
 			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
 			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			"             │               │           │   └─ HashLookup\n" +
 			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
 			"             │               │           │       ├─ right-key: (ci.id)\n" +
@@ -5852,6 +5930,7 @@ WHERE
 			"             │               └─ TableAlias(sn)\n" +
 			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │                       ├─ index: [NOXN3.id]\n" +
+			"             │                       ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │                       └─ keys: sl3s5.BDNYB\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: ()\n" +
@@ -5965,20 +6044,24 @@ WHERE
 			" │               │   │   │   └─ TableAlias(sn)\n" +
 			" │               │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
 			" │               │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │               │   │   │           ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │               │   │   │           └─ keys: sl3s5.BDNYB\n" +
 			" │               │   │   └─ TableAlias(mf)\n" +
 			" │               │   │       └─ IndexedTableAccess(HGMQ6)\n" +
 			" │               │   │           ├─ index: [HGMQ6.M22QN]\n" +
+			" │               │   │           ├─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │               │   │           └─ keys: sl3s5.M22QN\n" +
 			" │               │   └─ TableAlias(bs)\n" +
 			" │               │       └─ IndexedTableAccess(THNTS)\n" +
 			" │               │           ├─ index: [THNTS.id]\n" +
+			" │               │           ├─ columns: [id nfryn ixuxu fhcyt]\n" +
 			" │               │           └─ keys: mf.GXLUB\n" +
 			" │               └─ Filter\n" +
 			" │                   ├─ (cla.FTQLQ HASH IN ('SQ1'))\n" +
 			" │                   └─ TableAlias(cla)\n" +
 			" │                       └─ IndexedTableAccess(YK2GW)\n" +
 			" │                           ├─ index: [YK2GW.id]\n" +
+			" │                           ├─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
@@ -6038,13 +6121,15 @@ WHERE
 			"             │               │           │   │   ├─ TableAlias(sn)\n" +
 			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │   │       └─ columns: [id brqp2]\n" +
 			"             │               │           │   │   └─ Filter\n" +
 			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
 			"             │               │           │   │       └─ TableAlias(ct)\n" +
 			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
 			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │           │   │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			"             │               │           │   └─ HashLookup\n" +
 			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
 			"             │               │           │       ├─ right-key: (ci.id)\n" +
@@ -6063,6 +6148,7 @@ WHERE
 			"             │               └─ TableAlias(sn)\n" +
 			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
 			"             │                       ├─ index: [NOXN3.id]\n" +
+			"             │                       ├─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │                       └─ keys: sl3s5.BDNYB\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: ()\n" +
@@ -6846,7 +6932,8 @@ WHERE
 			"         │           └─ CrossHashJoin (estimated cost=4181.660 rows=124)\n" +
 			"         │               ├─ TableAlias(nd)\n" +
 			"         │               │   └─ Table\n" +
-			"         │               │       └─ name: E2I7U\n" +
+			"         │               │       ├─ name: E2I7U\n" +
+			"         │               │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"         │               └─ HashLookup\n" +
 			"         │                   ├─ left-key: ()\n" +
 			"         │                   ├─ right-key: ()\n" +
@@ -6918,7 +7005,8 @@ WHERE
 			"         │           └─ CrossHashJoin (estimated cost=4181.660 rows=124) (actual rows=0 loops=1)\n" +
 			"         │               ├─ TableAlias(nd)\n" +
 			"         │               │   └─ Table\n" +
-			"         │               │       └─ name: E2I7U\n" +
+			"         │               │       ├─ name: E2I7U\n" +
+			"         │               │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"         │               └─ HashLookup\n" +
 			"         │                   ├─ left-key: ()\n" +
 			"         │                   ├─ right-key: ()\n" +
@@ -8825,113 +8913,78 @@ WHERE
 	   ON XJ2RD.WNUNU = TUSAY.XLFIA
 	ORDER BY Y46B2 ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [tusay.Y3IOU:3!null->RWGEU:0]\n" +
-			" └─ Sort(xj2rd.Y46B2:0!null ASC nullsFirst)\n" +
-			"     └─ HashJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ xj2rd.WNUNU:1!null\n" +
-			"         │   └─ tusay.XLFIA:4!null\n" +
+			" ├─ columns: [tusay.Y3IOU:0!null->RWGEU:0]\n" +
+			" └─ Sort(qywqd.id:2!null ASC nullsFirst)\n" +
+			"     └─ LookupJoin\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: xj2rd\n" +
+			"         │   ├─ name: tusay\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ isLateral: false\n" +
 			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (10-12)\n" +
-			"         │   ├─ tableId: 2\n" +
+			"         │   ├─ colSet: (26,27)\n" +
+			"         │   ├─ tableId: 4\n" +
 			"         │   └─ Project\n" +
-			"         │       ├─ columns: [qywqd.id:0!null->Y46B2:0, qywqd.WNUNU:1!null->WNUNU:0, qywqd.HVHRZ:2!null->HVHRZ:0]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: QYWQD\n" +
-			"         │           ├─ columns: [id wnunu hvhrz]\n" +
-			"         │           ├─ colSet: (1-6)\n" +
-			"         │           └─ tableId: 1\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(xj2rd.WNUNU:1!null)\n" +
-			"             ├─ right-key: TUPLE(tusay.XLFIA:1!null)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: tusay\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     ├─ colSet: (26,27)\n" +
-			"                     ├─ tableId: 4\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [row_number() over ( order by noxn3.id asc):0!null->Y3IOU:0, noxn3.id:1!null->XLFIA:0]\n" +
-			"                         └─ Window\n" +
-			"                             ├─ row_number() over ( order by noxn3.id ASC)\n" +
-			"                             ├─ noxn3.id:0!null\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: NOXN3\n" +
-			"                                 ├─ columns: [id]\n" +
-			"                                 ├─ colSet: (13-22)\n" +
-			"                                 └─ tableId: 3\n" +
+			"         │       ├─ columns: [row_number() over ( order by noxn3.id asc):0!null->Y3IOU:0, noxn3.id:1!null->XLFIA:0]\n" +
+			"         │       └─ Window\n" +
+			"         │           ├─ row_number() over ( order by noxn3.id ASC)\n" +
+			"         │           ├─ noxn3.id:0!null\n" +
+			"         │           └─ Table\n" +
+			"         │               ├─ name: NOXN3\n" +
+			"         │               ├─ columns: [id]\n" +
+			"         │               ├─ colSet: (13-22)\n" +
+			"         │               └─ tableId: 3\n" +
+			"         └─ IndexedTableAccess(QYWQD)\n" +
+			"             ├─ index: [QYWQD.WNUNU]\n" +
+			"             ├─ keys: [tusay.XLFIA:1!null]\n" +
+			"             ├─ colSet: (1-6)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: QYWQD\n" +
+			"                 └─ columns: [id wnunu]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [tusay.Y3IOU as RWGEU]\n" +
-			" └─ Sort(xj2rd.Y46B2 ASC)\n" +
-			"     └─ HashJoin (estimated cost=402.250 rows=125)\n" +
-			"         ├─ (xj2rd.WNUNU = tusay.XLFIA)\n" +
+			" └─ Sort(qywqd.id ASC)\n" +
+			"     └─ LookupJoin (estimated cost=411.483 rows=124)\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: xj2rd\n" +
+			"         │   ├─ name: tusay\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ isLateral: false\n" +
 			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (10-12)\n" +
-			"         │   ├─ tableId: 2\n" +
+			"         │   ├─ colSet: (26,27)\n" +
+			"         │   ├─ tableId: 4\n" +
 			"         │   └─ Project\n" +
-			"         │       ├─ columns: [qywqd.id as Y46B2, qywqd.WNUNU as WNUNU, qywqd.HVHRZ as HVHRZ]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: QYWQD\n" +
-			"         │           └─ columns: [id wnunu hvhrz]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (xj2rd.WNUNU)\n" +
-			"             ├─ right-key: (tusay.XLFIA)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: tusay\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
-			"                         └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: NOXN3\n" +
-			"                                 └─ columns: [id]\n" +
+			"         │       ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
+			"         │       └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
+			"         │           └─ Table\n" +
+			"         │               ├─ name: NOXN3\n" +
+			"         │               └─ columns: [id]\n" +
+			"         └─ IndexedTableAccess(QYWQD)\n" +
+			"             ├─ index: [QYWQD.WNUNU]\n" +
+			"             ├─ columns: [id wnunu]\n" +
+			"             └─ keys: tusay.XLFIA\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [tusay.Y3IOU as RWGEU]\n" +
-			" └─ Sort(xj2rd.Y46B2 ASC)\n" +
-			"     └─ HashJoin (estimated cost=402.250 rows=125) (actual rows=0 loops=1)\n" +
-			"         ├─ (xj2rd.WNUNU = tusay.XLFIA)\n" +
+			" └─ Sort(qywqd.id ASC)\n" +
+			"     └─ LookupJoin (estimated cost=411.483 rows=124) (actual rows=0 loops=1)\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: xj2rd\n" +
+			"         │   ├─ name: tusay\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ isLateral: false\n" +
 			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (10-12)\n" +
-			"         │   ├─ tableId: 2\n" +
+			"         │   ├─ colSet: (26,27)\n" +
+			"         │   ├─ tableId: 4\n" +
 			"         │   └─ Project\n" +
-			"         │       ├─ columns: [qywqd.id as Y46B2, qywqd.WNUNU as WNUNU, qywqd.HVHRZ as HVHRZ]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: QYWQD\n" +
-			"         │           └─ columns: [id wnunu hvhrz]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (xj2rd.WNUNU)\n" +
-			"             ├─ right-key: (tusay.XLFIA)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: tusay\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
-			"                         └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: NOXN3\n" +
-			"                                 └─ columns: [id]\n" +
+			"         │       ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
+			"         │       └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
+			"         │           └─ Table\n" +
+			"         │               ├─ name: NOXN3\n" +
+			"         │               └─ columns: [id]\n" +
+			"         └─ IndexedTableAccess(QYWQD)\n" +
+			"             ├─ index: [QYWQD.WNUNU]\n" +
+			"             ├─ columns: [id wnunu]\n" +
+			"             └─ keys: tusay.XLFIA\n" +
 			"",
 	},
 	{
@@ -9003,28 +9056,19 @@ WHERE
 			"     ├─ colSet: (33,34)\n" +
 			"     ├─ tableId: 5\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [nd.T722E:0!null, fc.Z35GY:2!null]\n" +
-			"         └─ Sort(nd.T722E:0!null ASC nullsFirst)\n" +
+			"         ├─ columns: [e2i7u.id:0!null->T722E:0, fc.Z35GY:2!null]\n" +
+			"         └─ Sort(e2i7u.id:0!null ASC nullsFirst)\n" +
 			"             └─ LeftOuterHashJoin\n" +
 			"                 ├─ Eq\n" +
-			"                 │   ├─ nd.T722E:0!null\n" +
+			"                 │   ├─ e2i7u.id:0!null\n" +
 			"                 │   └─ fc.ZPAIK:1!null\n" +
-			"                 ├─ SubqueryAlias\n" +
-			"                 │   ├─ name: nd\n" +
-			"                 │   ├─ outerVisibility: false\n" +
-			"                 │   ├─ isLateral: false\n" +
-			"                 │   ├─ cacheable: true\n" +
-			"                 │   ├─ colSet: (19)\n" +
-			"                 │   ├─ tableId: 2\n" +
-			"                 │   └─ Project\n" +
-			"                 │       ├─ columns: [e2i7u.id:0!null->T722E:0]\n" +
-			"                 │       └─ Table\n" +
-			"                 │           ├─ name: E2I7U\n" +
-			"                 │           ├─ columns: [id]\n" +
-			"                 │           ├─ colSet: (1-17)\n" +
-			"                 │           └─ tableId: 1\n" +
+			"                 ├─ Table\n" +
+			"                 │   ├─ name: E2I7U\n" +
+			"                 │   ├─ columns: [id]\n" +
+			"                 │   ├─ colSet: (1-17)\n" +
+			"                 │   └─ tableId: 1\n" +
 			"                 └─ HashLookup\n" +
-			"                     ├─ left-key: TUPLE(nd.T722E:0!null)\n" +
+			"                     ├─ left-key: TUPLE(e2i7u.id:0!null)\n" +
 			"                     ├─ right-key: TUPLE(fc.ZPAIK:0!null)\n" +
 			"                     └─ CachedResults\n" +
 			"                         └─ SubqueryAlias\n" +
@@ -9055,24 +9099,15 @@ WHERE
 			"     ├─ colSet: (33,34)\n" +
 			"     ├─ tableId: 5\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [nd.T722E, fc.Z35GY]\n" +
-			"         └─ Sort(nd.T722E ASC)\n" +
-			"             └─ LeftOuterHashJoin (estimated cost=402.250 rows=125)\n" +
-			"                 ├─ (nd.T722E = fc.ZPAIK)\n" +
-			"                 ├─ SubqueryAlias\n" +
-			"                 │   ├─ name: nd\n" +
-			"                 │   ├─ outerVisibility: false\n" +
-			"                 │   ├─ isLateral: false\n" +
-			"                 │   ├─ cacheable: true\n" +
-			"                 │   ├─ colSet: (19)\n" +
-			"                 │   ├─ tableId: 2\n" +
-			"                 │   └─ Project\n" +
-			"                 │       ├─ columns: [e2i7u.id as T722E]\n" +
-			"                 │       └─ Table\n" +
-			"                 │           ├─ name: E2I7U\n" +
-			"                 │           └─ columns: [id]\n" +
+			"         ├─ columns: [e2i7u.id as T722E, fc.Z35GY]\n" +
+			"         └─ Sort(e2i7u.id ASC)\n" +
+			"             └─ LeftOuterHashJoin (estimated cost=4218.840 rows=3842)\n" +
+			"                 ├─ (e2i7u.id = fc.ZPAIK)\n" +
+			"                 ├─ Table\n" +
+			"                 │   ├─ name: E2I7U\n" +
+			"                 │   └─ columns: [id]\n" +
 			"                 └─ HashLookup\n" +
-			"                     ├─ left-key: (nd.T722E)\n" +
+			"                     ├─ left-key: (e2i7u.id)\n" +
 			"                     ├─ right-key: (fc.ZPAIK)\n" +
 			"                     └─ CachedResults\n" +
 			"                         └─ SubqueryAlias\n" +
@@ -9099,24 +9134,15 @@ WHERE
 			"     ├─ colSet: (33,34)\n" +
 			"     ├─ tableId: 5\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [nd.T722E, fc.Z35GY]\n" +
-			"         └─ Sort(nd.T722E ASC)\n" +
-			"             └─ LeftOuterHashJoin (estimated cost=402.250 rows=125) (actual rows=0 loops=1)\n" +
-			"                 ├─ (nd.T722E = fc.ZPAIK)\n" +
-			"                 ├─ SubqueryAlias\n" +
-			"                 │   ├─ name: nd\n" +
-			"                 │   ├─ outerVisibility: false\n" +
-			"                 │   ├─ isLateral: false\n" +
-			"                 │   ├─ cacheable: true\n" +
-			"                 │   ├─ colSet: (19)\n" +
-			"                 │   ├─ tableId: 2\n" +
-			"                 │   └─ Project\n" +
-			"                 │       ├─ columns: [e2i7u.id as T722E]\n" +
-			"                 │       └─ Table\n" +
-			"                 │           ├─ name: E2I7U\n" +
-			"                 │           └─ columns: [id]\n" +
+			"         ├─ columns: [e2i7u.id as T722E, fc.Z35GY]\n" +
+			"         └─ Sort(e2i7u.id ASC)\n" +
+			"             └─ LeftOuterHashJoin (estimated cost=4218.840 rows=3842) (actual rows=0 loops=1)\n" +
+			"                 ├─ (e2i7u.id = fc.ZPAIK)\n" +
+			"                 ├─ Table\n" +
+			"                 │   ├─ name: E2I7U\n" +
+			"                 │   └─ columns: [id]\n" +
 			"                 └─ HashLookup\n" +
-			"                     ├─ left-key: (nd.T722E)\n" +
+			"                     ├─ left-key: (e2i7u.id)\n" +
 			"                     ├─ right-key: (fc.ZPAIK)\n" +
 			"                     └─ CachedResults\n" +
 			"                         └─ SubqueryAlias\n" +
@@ -9161,25 +9187,23 @@ WHERE
 			" │       ├─ cacheable: true\n" +
 			" │       ├─ alias-string: select id from E2I7U where not id in (select LUEVY from AMYXQ)\n" +
 			" │       └─ Project\n" +
-			" │           ├─ columns: [e2i7u.id:18!null]\n" +
-			" │           └─ Project\n" +
-			" │               ├─ columns: [E2I7U.id:18!null, E2I7U.DKCAJ:19!null, E2I7U.KNG7T:20, E2I7U.TW55N:21!null, E2I7U.QRQXW:22!null, E2I7U.ECXAJ:23!null, E2I7U.FGG57:24, E2I7U.ZH72S:25, E2I7U.FSK67:26!null, E2I7U.XQDYT:27!null, E2I7U.TCE7A:28, E2I7U.IWV2H:29, E2I7U.HPCMS:30!null, E2I7U.N5CC2:31, E2I7U.FHCYT:32, E2I7U.ETAQ7:33, E2I7U.A75X7:34]\n" +
-			" │               └─ Filter\n" +
-			" │                   ├─ amyxq.LUEVY:35!null IS NULL\n" +
-			" │                   └─ LeftOuterLookupJoin\n" +
-			" │                       ├─ Table\n" +
-			" │                       │   ├─ name: E2I7U\n" +
-			" │                       │   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			" │                       │   ├─ colSet: (18-34)\n" +
-			" │                       │   └─ tableId: 2\n" +
-			" │                       └─ IndexedTableAccess(AMYXQ)\n" +
-			" │                           ├─ index: [AMYXQ.LUEVY]\n" +
-			" │                           ├─ keys: [e2i7u.id:18!null]\n" +
-			" │                           ├─ colSet: (35-42)\n" +
-			" │                           ├─ tableId: 3\n" +
-			" │                           └─ Table\n" +
-			" │                               ├─ name: AMYXQ\n" +
-			" │                               └─ columns: [luevy]\n" +
+			" │           ├─ columns: [E2I7U.id:18!null]\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ amyxq.LUEVY:19!null IS NULL\n" +
+			" │               └─ LeftOuterLookupJoin\n" +
+			" │                   ├─ Table\n" +
+			" │                   │   ├─ name: E2I7U\n" +
+			" │                   │   ├─ columns: [id]\n" +
+			" │                   │   ├─ colSet: (18-34)\n" +
+			" │                   │   └─ tableId: 2\n" +
+			" │                   └─ IndexedTableAccess(AMYXQ)\n" +
+			" │                       ├─ index: [AMYXQ.LUEVY]\n" +
+			" │                       ├─ keys: [e2i7u.id:18!null]\n" +
+			" │                       ├─ colSet: (35-42)\n" +
+			" │                       ├─ tableId: 3\n" +
+			" │                       └─ Table\n" +
+			" │                           ├─ name: AMYXQ\n" +
+			" │                           └─ columns: [luevy]\n" +
 			" │   THEN 1 (tinyint) WHEN Eq\n" +
 			" │   ├─ e2i7u.FSK67:8!null\n" +
 			" │   └─ z (longtext)\n" +
@@ -9194,25 +9218,23 @@ WHERE
 			"     │       ├─ cacheable: true\n" +
 			"     │       ├─ alias-string: select id from E2I7U where not id in (select LUEVY from AMYXQ)\n" +
 			"     │       └─ Project\n" +
-			"     │           ├─ columns: [e2i7u.id:17!null]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [E2I7U.id:17!null, E2I7U.DKCAJ:18!null, E2I7U.KNG7T:19, E2I7U.TW55N:20!null, E2I7U.QRQXW:21!null, E2I7U.ECXAJ:22!null, E2I7U.FGG57:23, E2I7U.ZH72S:24, E2I7U.FSK67:25!null, E2I7U.XQDYT:26!null, E2I7U.TCE7A:27, E2I7U.IWV2H:28, E2I7U.HPCMS:29!null, E2I7U.N5CC2:30, E2I7U.FHCYT:31, E2I7U.ETAQ7:32, E2I7U.A75X7:33]\n" +
-			"     │               └─ Filter\n" +
-			"     │                   ├─ amyxq.LUEVY:34!null IS NULL\n" +
-			"     │                   └─ LeftOuterLookupJoin\n" +
-			"     │                       ├─ Table\n" +
-			"     │                       │   ├─ name: E2I7U\n" +
-			"     │                       │   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     │                       │   ├─ colSet: (18-34)\n" +
-			"     │                       │   └─ tableId: 2\n" +
-			"     │                       └─ IndexedTableAccess(AMYXQ)\n" +
-			"     │                           ├─ index: [AMYXQ.LUEVY]\n" +
-			"     │                           ├─ keys: [e2i7u.id:17!null]\n" +
-			"     │                           ├─ colSet: (35-42)\n" +
-			"     │                           ├─ tableId: 3\n" +
-			"     │                           └─ Table\n" +
-			"     │                               ├─ name: AMYXQ\n" +
-			"     │                               └─ columns: [luevy]\n" +
+			"     │           ├─ columns: [E2I7U.id:17!null]\n" +
+			"     │           └─ Filter\n" +
+			"     │               ├─ amyxq.LUEVY:18!null IS NULL\n" +
+			"     │               └─ LeftOuterLookupJoin\n" +
+			"     │                   ├─ Table\n" +
+			"     │                   │   ├─ name: E2I7U\n" +
+			"     │                   │   ├─ columns: [id]\n" +
+			"     │                   │   ├─ colSet: (18-34)\n" +
+			"     │                   │   └─ tableId: 2\n" +
+			"     │                   └─ IndexedTableAccess(AMYXQ)\n" +
+			"     │                       ├─ index: [AMYXQ.LUEVY]\n" +
+			"     │                       ├─ keys: [e2i7u.id:17!null]\n" +
+			"     │                       ├─ colSet: (35-42)\n" +
+			"     │                       ├─ tableId: 3\n" +
+			"     │                       └─ Table\n" +
+			"     │                           ├─ name: AMYXQ\n" +
+			"     │                           └─ columns: [luevy]\n" +
 			"     │   THEN 1 (tinyint) WHEN Eq\n" +
 			"     │   ├─ e2i7u.FSK67:8!null\n" +
 			"     │   └─ z (longtext)\n" +
@@ -9235,18 +9257,17 @@ WHERE
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: true\n" +
 			" │       └─ Project\n" +
-			" │           ├─ columns: [e2i7u.id]\n" +
-			" │           └─ Project\n" +
-			" │               ├─ columns: [E2I7U.id, E2I7U.DKCAJ, E2I7U.KNG7T, E2I7U.TW55N, E2I7U.QRQXW, E2I7U.ECXAJ, E2I7U.FGG57, E2I7U.ZH72S, E2I7U.FSK67, E2I7U.XQDYT, E2I7U.TCE7A, E2I7U.IWV2H, E2I7U.HPCMS, E2I7U.N5CC2, E2I7U.FHCYT, E2I7U.ETAQ7, E2I7U.A75X7]\n" +
-			" │               └─ Filter\n" +
-			" │                   ├─ amyxq.LUEVY IS NULL\n" +
-			" │                   └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
-			" │                       ├─ Table\n" +
-			" │                       │   └─ name: E2I7U\n" +
-			" │                       └─ IndexedTableAccess(AMYXQ)\n" +
-			" │                           ├─ index: [AMYXQ.LUEVY]\n" +
-			" │                           ├─ columns: [luevy]\n" +
-			" │                           └─ keys: e2i7u.id\n" +
+			" │           ├─ columns: [E2I7U.id]\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ amyxq.LUEVY IS NULL\n" +
+			" │               └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
+			" │                   ├─ Table\n" +
+			" │                   │   ├─ name: E2I7U\n" +
+			" │                   │   └─ columns: [id]\n" +
+			" │                   └─ IndexedTableAccess(AMYXQ)\n" +
+			" │                       ├─ index: [AMYXQ.LUEVY]\n" +
+			" │                       ├─ columns: [luevy]\n" +
+			" │                       └─ keys: e2i7u.id\n" +
 			" │   THEN 1 WHEN (e2i7u.FSK67 = 'z') THEN 2 WHEN (e2i7u.FSK67 = 'CRZ2X') THEN 0 ELSE 3 END as SZ6KK]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [e2i7u.id, e2i7u.DKCAJ, e2i7u.KNG7T, e2i7u.TW55N, e2i7u.QRQXW, e2i7u.ECXAJ, e2i7u.FGG57, e2i7u.ZH72S, e2i7u.FSK67, e2i7u.XQDYT, e2i7u.TCE7A, e2i7u.IWV2H, e2i7u.HPCMS, e2i7u.N5CC2, e2i7u.FHCYT, e2i7u.ETAQ7, e2i7u.A75X7, CASE  WHEN e2i7u.FGG57 IS NULL THEN 0 WHEN InSubquery\n" +
@@ -9254,22 +9275,22 @@ WHERE
 			"     │   └─ right: Subquery\n" +
 			"     │       ├─ cacheable: true\n" +
 			"     │       └─ Project\n" +
-			"     │           ├─ columns: [e2i7u.id]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [E2I7U.id, E2I7U.DKCAJ, E2I7U.KNG7T, E2I7U.TW55N, E2I7U.QRQXW, E2I7U.ECXAJ, E2I7U.FGG57, E2I7U.ZH72S, E2I7U.FSK67, E2I7U.XQDYT, E2I7U.TCE7A, E2I7U.IWV2H, E2I7U.HPCMS, E2I7U.N5CC2, E2I7U.FHCYT, E2I7U.ETAQ7, E2I7U.A75X7]\n" +
-			"     │               └─ Filter\n" +
-			"     │                   ├─ amyxq.LUEVY IS NULL\n" +
-			"     │                   └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
-			"     │                       ├─ Table\n" +
-			"     │                       │   └─ name: E2I7U\n" +
-			"     │                       └─ IndexedTableAccess(AMYXQ)\n" +
-			"     │                           ├─ index: [AMYXQ.LUEVY]\n" +
-			"     │                           ├─ columns: [luevy]\n" +
-			"     │                           └─ keys: e2i7u.id\n" +
+			"     │           ├─ columns: [E2I7U.id]\n" +
+			"     │           └─ Filter\n" +
+			"     │               ├─ amyxq.LUEVY IS NULL\n" +
+			"     │               └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
+			"     │                   ├─ Table\n" +
+			"     │                   │   ├─ name: E2I7U\n" +
+			"     │                   │   └─ columns: [id]\n" +
+			"     │                   └─ IndexedTableAccess(AMYXQ)\n" +
+			"     │                       ├─ index: [AMYXQ.LUEVY]\n" +
+			"     │                       ├─ columns: [luevy]\n" +
+			"     │                       └─ keys: e2i7u.id\n" +
 			"     │   THEN 1 WHEN (e2i7u.FSK67 = 'z') THEN 2 WHEN (e2i7u.FSK67 = 'CRZ2X') THEN 0 ELSE 3 END as SZ6KK]\n" +
 			"     └─ IndexedTableAccess(E2I7U)\n" +
 			"         ├─ index: [E2I7U.id]\n" +
-			"         └─ filters: [{[NULL, ∞)}]\n" +
+			"         ├─ filters: [{[NULL, ∞)}]\n" +
+			"         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [CASE  WHEN e2i7u.FGG57 IS NULL THEN 0 WHEN InSubquery\n" +
@@ -9277,18 +9298,17 @@ WHERE
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: true\n" +
 			" │       └─ Project\n" +
-			" │           ├─ columns: [e2i7u.id]\n" +
-			" │           └─ Project\n" +
-			" │               ├─ columns: [E2I7U.id, E2I7U.DKCAJ, E2I7U.KNG7T, E2I7U.TW55N, E2I7U.QRQXW, E2I7U.ECXAJ, E2I7U.FGG57, E2I7U.ZH72S, E2I7U.FSK67, E2I7U.XQDYT, E2I7U.TCE7A, E2I7U.IWV2H, E2I7U.HPCMS, E2I7U.N5CC2, E2I7U.FHCYT, E2I7U.ETAQ7, E2I7U.A75X7]\n" +
-			" │               └─ Filter\n" +
-			" │                   ├─ amyxq.LUEVY IS NULL\n" +
-			" │                   └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
-			" │                       ├─ Table\n" +
-			" │                       │   └─ name: E2I7U\n" +
-			" │                       └─ IndexedTableAccess(AMYXQ)\n" +
-			" │                           ├─ index: [AMYXQ.LUEVY]\n" +
-			" │                           ├─ columns: [luevy]\n" +
-			" │                           └─ keys: e2i7u.id\n" +
+			" │           ├─ columns: [E2I7U.id]\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ amyxq.LUEVY IS NULL\n" +
+			" │               └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
+			" │                   ├─ Table\n" +
+			" │                   │   ├─ name: E2I7U\n" +
+			" │                   │   └─ columns: [id]\n" +
+			" │                   └─ IndexedTableAccess(AMYXQ)\n" +
+			" │                       ├─ index: [AMYXQ.LUEVY]\n" +
+			" │                       ├─ columns: [luevy]\n" +
+			" │                       └─ keys: e2i7u.id\n" +
 			" │   THEN 1 WHEN (e2i7u.FSK67 = 'z') THEN 2 WHEN (e2i7u.FSK67 = 'CRZ2X') THEN 0 ELSE 3 END as SZ6KK]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [e2i7u.id, e2i7u.DKCAJ, e2i7u.KNG7T, e2i7u.TW55N, e2i7u.QRQXW, e2i7u.ECXAJ, e2i7u.FGG57, e2i7u.ZH72S, e2i7u.FSK67, e2i7u.XQDYT, e2i7u.TCE7A, e2i7u.IWV2H, e2i7u.HPCMS, e2i7u.N5CC2, e2i7u.FHCYT, e2i7u.ETAQ7, e2i7u.A75X7, CASE  WHEN e2i7u.FGG57 IS NULL THEN 0 WHEN InSubquery\n" +
@@ -9296,22 +9316,22 @@ WHERE
 			"     │   └─ right: Subquery\n" +
 			"     │       ├─ cacheable: true\n" +
 			"     │       └─ Project\n" +
-			"     │           ├─ columns: [e2i7u.id]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [E2I7U.id, E2I7U.DKCAJ, E2I7U.KNG7T, E2I7U.TW55N, E2I7U.QRQXW, E2I7U.ECXAJ, E2I7U.FGG57, E2I7U.ZH72S, E2I7U.FSK67, E2I7U.XQDYT, E2I7U.TCE7A, E2I7U.IWV2H, E2I7U.HPCMS, E2I7U.N5CC2, E2I7U.FHCYT, E2I7U.ETAQ7, E2I7U.A75X7]\n" +
-			"     │               └─ Filter\n" +
-			"     │                   ├─ amyxq.LUEVY IS NULL\n" +
-			"     │                   └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
-			"     │                       ├─ Table\n" +
-			"     │                       │   └─ name: E2I7U\n" +
-			"     │                       └─ IndexedTableAccess(AMYXQ)\n" +
-			"     │                           ├─ index: [AMYXQ.LUEVY]\n" +
-			"     │                           ├─ columns: [luevy]\n" +
-			"     │                           └─ keys: e2i7u.id\n" +
+			"     │           ├─ columns: [E2I7U.id]\n" +
+			"     │           └─ Filter\n" +
+			"     │               ├─ amyxq.LUEVY IS NULL\n" +
+			"     │               └─ LeftOuterLookupJoin (estimated cost=15202.776 rows=4802)\n" +
+			"     │                   ├─ Table\n" +
+			"     │                   │   ├─ name: E2I7U\n" +
+			"     │                   │   └─ columns: [id]\n" +
+			"     │                   └─ IndexedTableAccess(AMYXQ)\n" +
+			"     │                       ├─ index: [AMYXQ.LUEVY]\n" +
+			"     │                       ├─ columns: [luevy]\n" +
+			"     │                       └─ keys: e2i7u.id\n" +
 			"     │   THEN 1 WHEN (e2i7u.FSK67 = 'z') THEN 2 WHEN (e2i7u.FSK67 = 'CRZ2X') THEN 0 ELSE 3 END as SZ6KK]\n" +
 			"     └─ IndexedTableAccess(E2I7U)\n" +
 			"         ├─ index: [E2I7U.id]\n" +
-			"         └─ filters: [{[NULL, ∞)}]\n" +
+			"         ├─ filters: [{[NULL, ∞)}]\n" +
+			"         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
@@ -11242,7 +11262,8 @@ WHERE
 			"         ├─ (aac.id = mjr3d.M22QN)\n" +
 			"         ├─ TableAlias(aac)\n" +
 			"         │   └─ Table\n" +
-			"         │       └─ name: TPXBU\n" +
+			"         │       ├─ name: TPXBU\n" +
+			"         │       └─ columns: [id btxc5 fhcyt]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (aac.id)\n" +
 			"             ├─ right-key: (mjr3d.M22QN)\n" +
@@ -11476,7 +11497,8 @@ WHERE
 			"                 │   │                                               └─ keys: ism.FV24E\n" +
 			"                 │   └─ TableAlias(sn)\n" +
 			"                 │       └─ Table\n" +
-			"                 │           └─ name: NOXN3\n" +
+			"                 │           ├─ name: NOXN3\n" +
+			"                 │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"                 └─ HashLookup\n" +
 			"                     ├─ left-key: (sn.BRQP2, mjr3d.M22QN)\n" +
 			"                     ├─ right-key: (mf.LUEVY, mf.M22QN)\n" +
@@ -11600,7 +11622,8 @@ WHERE
 			"         ├─ (aac.id = mjr3d.M22QN)\n" +
 			"         ├─ TableAlias(aac)\n" +
 			"         │   └─ Table\n" +
-			"         │       └─ name: TPXBU\n" +
+			"         │       ├─ name: TPXBU\n" +
+			"         │       └─ columns: [id btxc5 fhcyt]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: (aac.id)\n" +
 			"             ├─ right-key: (mjr3d.M22QN)\n" +
@@ -11834,7 +11857,8 @@ WHERE
 			"                 │   │                                               └─ keys: ism.FV24E\n" +
 			"                 │   └─ TableAlias(sn)\n" +
 			"                 │       └─ Table\n" +
-			"                 │           └─ name: NOXN3\n" +
+			"                 │           ├─ name: NOXN3\n" +
+			"                 │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"                 └─ HashLookup\n" +
 			"                     ├─ left-key: (sn.BRQP2, mjr3d.M22QN)\n" +
 			"                     ├─ right-key: (mf.LUEVY, mf.M22QN)\n" +
@@ -13114,10 +13138,12 @@ WHERE
 			"             │           │                           │   └─ TableAlias(aac)\n" +
 			"             │           │                           │       └─ IndexedTableAccess(TPXBU)\n" +
 			"             │           │                           │           ├─ index: [TPXBU.id]\n" +
+			"             │           │                           │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"             │           │                           │           └─ keys: mjr3d.M22QN\n" +
 			"             │           │                           └─ TableAlias(sn)\n" +
 			"             │           │                               └─ Table\n" +
-			"             │           │                                   └─ name: NOXN3\n" +
+			"             │           │                                   ├─ name: NOXN3\n" +
+			"             │           │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │           └─ HashLookup\n" +
 			"             │               ├─ left-key: ()\n" +
 			"             │               ├─ right-key: ()\n" +
@@ -13420,10 +13446,12 @@ WHERE
 			"                                                                 │   └─ TableAlias(aac)\n" +
 			"                                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
 			"                                                                 │           ├─ index: [TPXBU.id]\n" +
+			"                                                                 │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"                                                                 │           └─ keys: mjr3d.M22QN\n" +
 			"                                                                 └─ TableAlias(sn)\n" +
 			"                                                                     └─ Table\n" +
-			"                                                                         └─ name: NOXN3\n" +
+			"                                                                         ├─ name: NOXN3\n" +
+			"                                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [fs.T4IBQ as T4IBQ, fs.M6T2N as M6T2N, fs.TUV25 as TUV25, fs.BTXC5 as YEBDJ]\n" +
@@ -13645,10 +13673,12 @@ WHERE
 			"             │           │                           │   └─ TableAlias(aac)\n" +
 			"             │           │                           │       └─ IndexedTableAccess(TPXBU)\n" +
 			"             │           │                           │           ├─ index: [TPXBU.id]\n" +
+			"             │           │                           │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"             │           │                           │           └─ keys: mjr3d.M22QN\n" +
 			"             │           │                           └─ TableAlias(sn)\n" +
 			"             │           │                               └─ Table\n" +
-			"             │           │                                   └─ name: NOXN3\n" +
+			"             │           │                                   ├─ name: NOXN3\n" +
+			"             │           │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │           └─ HashLookup\n" +
 			"             │               ├─ left-key: ()\n" +
 			"             │               ├─ right-key: ()\n" +
@@ -13951,10 +13981,12 @@ WHERE
 			"                                                                 │   └─ TableAlias(aac)\n" +
 			"                                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
 			"                                                                 │           ├─ index: [TPXBU.id]\n" +
+			"                                                                 │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"                                                                 │           └─ keys: mjr3d.M22QN\n" +
 			"                                                                 └─ TableAlias(sn)\n" +
 			"                                                                     └─ Table\n" +
-			"                                                                         └─ name: NOXN3\n" +
+			"                                                                         ├─ name: NOXN3\n" +
+			"                                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 	},
 	{
@@ -15189,10 +15221,12 @@ WHERE
 			"             │           │                           │   └─ TableAlias(aac)\n" +
 			"             │           │                           │       └─ IndexedTableAccess(TPXBU)\n" +
 			"             │           │                           │           ├─ index: [TPXBU.id]\n" +
+			"             │           │                           │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"             │           │                           │           └─ keys: mjr3d.M22QN\n" +
 			"             │           │                           └─ TableAlias(sn)\n" +
 			"             │           │                               └─ Table\n" +
-			"             │           │                                   └─ name: NOXN3\n" +
+			"             │           │                                   ├─ name: NOXN3\n" +
+			"             │           │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │           └─ HashLookup\n" +
 			"             │               ├─ left-key: ()\n" +
 			"             │               ├─ right-key: ()\n" +
@@ -15491,10 +15525,12 @@ WHERE
 			"                                                                 │   └─ TableAlias(aac)\n" +
 			"                                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
 			"                                                                 │           ├─ index: [TPXBU.id]\n" +
+			"                                                                 │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"                                                                 │           └─ keys: mjr3d.M22QN\n" +
 			"                                                                 └─ TableAlias(sn)\n" +
 			"                                                                     └─ Table\n" +
-			"                                                                         └─ name: NOXN3\n" +
+			"                                                                         ├─ name: NOXN3\n" +
+			"                                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [fs.T4IBQ as T4IBQ, fs.M6T2N as M6T2N, fs.TUV25 as TUV25, fs.BTXC5 as YEBDJ]\n" +
@@ -15716,10 +15752,12 @@ WHERE
 			"             │           │                           │   └─ TableAlias(aac)\n" +
 			"             │           │                           │       └─ IndexedTableAccess(TPXBU)\n" +
 			"             │           │                           │           ├─ index: [TPXBU.id]\n" +
+			"             │           │                           │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"             │           │                           │           └─ keys: mjr3d.M22QN\n" +
 			"             │           │                           └─ TableAlias(sn)\n" +
 			"             │           │                               └─ Table\n" +
-			"             │           │                                   └─ name: NOXN3\n" +
+			"             │           │                                   ├─ name: NOXN3\n" +
+			"             │           │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"             │           └─ HashLookup\n" +
 			"             │               ├─ left-key: ()\n" +
 			"             │               ├─ right-key: ()\n" +
@@ -16018,10 +16056,12 @@ WHERE
 			"                                                                 │   └─ TableAlias(aac)\n" +
 			"                                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
 			"                                                                 │           ├─ index: [TPXBU.id]\n" +
+			"                                                                 │           ├─ columns: [id btxc5 fhcyt]\n" +
 			"                                                                 │           └─ keys: mjr3d.M22QN\n" +
 			"                                                                 └─ TableAlias(sn)\n" +
 			"                                                                     └─ Table\n" +
-			"                                                                         └─ name: NOXN3\n" +
+			"                                                                         ├─ name: NOXN3\n" +
+			"                                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 	},
 	{
@@ -16165,113 +16205,78 @@ INNER JOIN
    ON XJ2RD.HHVLX = TUSAY.XLFIA
 ORDER BY Y46B2 ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [tusay.Y3IOU:3!null->Q7H3X:0]\n" +
-			" └─ Sort(xj2rd.Y46B2:0!null ASC nullsFirst)\n" +
-			"     └─ HashJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ xj2rd.HHVLX:1!null\n" +
-			"         │   └─ tusay.XLFIA:4!null\n" +
+			" ├─ columns: [tusay.Y3IOU:0!null->Q7H3X:0]\n" +
+			" └─ Sort(qywqd.id:2!null ASC nullsFirst)\n" +
+			"     └─ LookupJoin\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: xj2rd\n" +
+			"         │   ├─ name: tusay\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ isLateral: false\n" +
 			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (10-12)\n" +
-			"         │   ├─ tableId: 2\n" +
+			"         │   ├─ colSet: (26,27)\n" +
+			"         │   ├─ tableId: 4\n" +
 			"         │   └─ Project\n" +
-			"         │       ├─ columns: [qywqd.id:0!null->Y46B2:0, qywqd.HHVLX:1!null->HHVLX:0, qywqd.HVHRZ:2!null->HVHRZ:0]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: QYWQD\n" +
-			"         │           ├─ columns: [id hhvlx hvhrz]\n" +
-			"         │           ├─ colSet: (1-6)\n" +
-			"         │           └─ tableId: 1\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(xj2rd.HHVLX:1!null)\n" +
-			"             ├─ right-key: TUPLE(tusay.XLFIA:1!null)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: tusay\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     ├─ colSet: (26,27)\n" +
-			"                     ├─ tableId: 4\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [row_number() over ( order by noxn3.id asc):0!null->Y3IOU:0, noxn3.id:1!null->XLFIA:0]\n" +
-			"                         └─ Window\n" +
-			"                             ├─ row_number() over ( order by noxn3.id ASC)\n" +
-			"                             ├─ noxn3.id:0!null\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: NOXN3\n" +
-			"                                 ├─ columns: [id]\n" +
-			"                                 ├─ colSet: (13-22)\n" +
-			"                                 └─ tableId: 3\n" +
+			"         │       ├─ columns: [row_number() over ( order by noxn3.id asc):0!null->Y3IOU:0, noxn3.id:1!null->XLFIA:0]\n" +
+			"         │       └─ Window\n" +
+			"         │           ├─ row_number() over ( order by noxn3.id ASC)\n" +
+			"         │           ├─ noxn3.id:0!null\n" +
+			"         │           └─ Table\n" +
+			"         │               ├─ name: NOXN3\n" +
+			"         │               ├─ columns: [id]\n" +
+			"         │               ├─ colSet: (13-22)\n" +
+			"         │               └─ tableId: 3\n" +
+			"         └─ IndexedTableAccess(QYWQD)\n" +
+			"             ├─ index: [QYWQD.HHVLX]\n" +
+			"             ├─ keys: [tusay.XLFIA:1!null]\n" +
+			"             ├─ colSet: (1-6)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: QYWQD\n" +
+			"                 └─ columns: [id hhvlx]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [tusay.Y3IOU as Q7H3X]\n" +
-			" └─ Sort(xj2rd.Y46B2 ASC)\n" +
-			"     └─ HashJoin (estimated cost=402.250 rows=125)\n" +
-			"         ├─ (xj2rd.HHVLX = tusay.XLFIA)\n" +
+			" └─ Sort(qywqd.id ASC)\n" +
+			"     └─ LookupJoin (estimated cost=411.483 rows=124)\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: xj2rd\n" +
+			"         │   ├─ name: tusay\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ isLateral: false\n" +
 			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (10-12)\n" +
-			"         │   ├─ tableId: 2\n" +
+			"         │   ├─ colSet: (26,27)\n" +
+			"         │   ├─ tableId: 4\n" +
 			"         │   └─ Project\n" +
-			"         │       ├─ columns: [qywqd.id as Y46B2, qywqd.HHVLX as HHVLX, qywqd.HVHRZ as HVHRZ]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: QYWQD\n" +
-			"         │           └─ columns: [id hhvlx hvhrz]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (xj2rd.HHVLX)\n" +
-			"             ├─ right-key: (tusay.XLFIA)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: tusay\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
-			"                         └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: NOXN3\n" +
-			"                                 └─ columns: [id]\n" +
+			"         │       ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
+			"         │       └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
+			"         │           └─ Table\n" +
+			"         │               ├─ name: NOXN3\n" +
+			"         │               └─ columns: [id]\n" +
+			"         └─ IndexedTableAccess(QYWQD)\n" +
+			"             ├─ index: [QYWQD.HHVLX]\n" +
+			"             ├─ columns: [id hhvlx]\n" +
+			"             └─ keys: tusay.XLFIA\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [tusay.Y3IOU as Q7H3X]\n" +
-			" └─ Sort(xj2rd.Y46B2 ASC)\n" +
-			"     └─ HashJoin (estimated cost=402.250 rows=125) (actual rows=0 loops=1)\n" +
-			"         ├─ (xj2rd.HHVLX = tusay.XLFIA)\n" +
+			" └─ Sort(qywqd.id ASC)\n" +
+			"     └─ LookupJoin (estimated cost=411.483 rows=124) (actual rows=0 loops=1)\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: xj2rd\n" +
+			"         │   ├─ name: tusay\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ isLateral: false\n" +
 			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (10-12)\n" +
-			"         │   ├─ tableId: 2\n" +
+			"         │   ├─ colSet: (26,27)\n" +
+			"         │   ├─ tableId: 4\n" +
 			"         │   └─ Project\n" +
-			"         │       ├─ columns: [qywqd.id as Y46B2, qywqd.HHVLX as HHVLX, qywqd.HVHRZ as HVHRZ]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: QYWQD\n" +
-			"         │           └─ columns: [id hhvlx hvhrz]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (xj2rd.HHVLX)\n" +
-			"             ├─ right-key: (tusay.XLFIA)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: tusay\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
-			"                         └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: NOXN3\n" +
-			"                                 └─ columns: [id]\n" +
+			"         │       ├─ columns: [row_number() over ( order by noxn3.id asc) as Y3IOU, noxn3.id as XLFIA]\n" +
+			"         │       └─ Window(row_number() over ( order by noxn3.id ASC), noxn3.id)\n" +
+			"         │           └─ Table\n" +
+			"         │               ├─ name: NOXN3\n" +
+			"         │               └─ columns: [id]\n" +
+			"         └─ IndexedTableAccess(QYWQD)\n" +
+			"             ├─ index: [QYWQD.HHVLX]\n" +
+			"             ├─ columns: [id hhvlx]\n" +
+			"             └─ keys: tusay.XLFIA\n" +
 			"",
 	},
 	{
@@ -16343,46 +16348,30 @@ ORDER BY sn.XLFIA ASC`,
 			"                     ├─ colSet: (42,43)\n" +
 			"                     ├─ tableId: 7\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [nd.LUEVY:0!null, CASE  WHEN Eq\n" +
-			"                         │   ├─ nma.DZLIM:3!null\n" +
+			"                         ├─ columns: [e2i7u.id:0!null->LUEVY:0, CASE  WHEN Eq\n" +
+			"                         │   ├─ tnmxi.DZLIM:3!null\n" +
 			"                         │   └─ Q5I4E (longtext)\n" +
 			"                         │   THEN 1 (tinyint) ELSE 0 (tinyint) END->R2SR7:0]\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ Eq\n" +
-			"                             │   ├─ nd.HPCMS:1!null\n" +
-			"                             │   └─ nma.MLECF:2!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: nd\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ isLateral: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   ├─ colSet: (33,34)\n" +
-			"                             │   ├─ tableId: 4\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [e2i7u.id:0!null->LUEVY:0, e2i7u.HPCMS:1!null->HPCMS:0]\n" +
-			"                             │       └─ Table\n" +
-			"                             │           ├─ name: E2I7U\n" +
-			"                             │           ├─ columns: [id hpcms]\n" +
-			"                             │           ├─ colSet: (14-30)\n" +
-			"                             │           └─ tableId: 3\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ left-key: TUPLE(nd.HPCMS:1!null)\n" +
-			"                                 ├─ right-key: TUPLE(nma.MLECF:0!null)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: nma\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ isLateral: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         ├─ colSet: (39,40)\n" +
-			"                                         ├─ tableId: 6\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [tnmxi.id:0!null->MLECF:0, tnmxi.DZLIM:1!null]\n" +
-			"                                             └─ Table\n" +
-			"                                                 ├─ name: TNMXI\n" +
-			"                                                 ├─ columns: [id dzlim]\n" +
-			"                                                 ├─ colSet: (35-37)\n" +
-			"                                                 └─ tableId: 5\n" +
+			"                         └─ LeftOuterMergeJoin\n" +
+			"                             ├─ cmp: Eq\n" +
+			"                             │   ├─ e2i7u.HPCMS:1!null\n" +
+			"                             │   └─ tnmxi.id:2!null\n" +
+			"                             ├─ IndexedTableAccess(E2I7U)\n" +
+			"                             │   ├─ index: [E2I7U.HPCMS]\n" +
+			"                             │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                             │   ├─ colSet: (14-30)\n" +
+			"                             │   ├─ tableId: 3\n" +
+			"                             │   └─ Table\n" +
+			"                             │       ├─ name: E2I7U\n" +
+			"                             │       └─ columns: [id hpcms]\n" +
+			"                             └─ IndexedTableAccess(TNMXI)\n" +
+			"                                 ├─ index: [TNMXI.id]\n" +
+			"                                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                                 ├─ colSet: (35-37)\n" +
+			"                                 ├─ tableId: 5\n" +
+			"                                 └─ Table\n" +
+			"                                     ├─ name: TNMXI\n" +
+			"                                     └─ columns: [id dzlim]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [i2gj5.R2SR7]\n" +
@@ -16412,35 +16401,17 @@ ORDER BY sn.XLFIA ASC`,
 			"                     ├─ isLateral: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [nd.LUEVY, CASE  WHEN (nma.DZLIM = 'Q5I4E') THEN 1 ELSE 0 END as R2SR7]\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ (nd.HPCMS = nma.MLECF)\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: nd\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ isLateral: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   ├─ colSet: (33,34)\n" +
-			"                             │   ├─ tableId: 4\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [e2i7u.id as LUEVY, e2i7u.HPCMS as HPCMS]\n" +
-			"                             │       └─ Table\n" +
-			"                             │           ├─ name: E2I7U\n" +
-			"                             │           └─ columns: [id hpcms]\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ left-key: (nd.HPCMS)\n" +
-			"                                 ├─ right-key: (nma.MLECF)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: nma\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ isLateral: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [tnmxi.id as MLECF, tnmxi.DZLIM]\n" +
-			"                                             └─ Table\n" +
-			"                                                 ├─ name: TNMXI\n" +
-			"                                                 └─ columns: [id dzlim]\n" +
+			"                         ├─ columns: [e2i7u.id as LUEVY, CASE  WHEN (tnmxi.DZLIM = 'Q5I4E') THEN 1 ELSE 0 END as R2SR7]\n" +
+			"                         └─ LeftOuterMergeJoin\n" +
+			"                             ├─ cmp: (e2i7u.HPCMS = tnmxi.id)\n" +
+			"                             ├─ IndexedTableAccess(E2I7U)\n" +
+			"                             │   ├─ index: [E2I7U.HPCMS]\n" +
+			"                             │   ├─ filters: [{[NULL, ∞)}]\n" +
+			"                             │   └─ columns: [id hpcms]\n" +
+			"                             └─ IndexedTableAccess(TNMXI)\n" +
+			"                                 ├─ index: [TNMXI.id]\n" +
+			"                                 ├─ filters: [{[NULL, ∞)}]\n" +
+			"                                 └─ columns: [id dzlim]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [i2gj5.R2SR7]\n" +
@@ -16470,35 +16441,17 @@ ORDER BY sn.XLFIA ASC`,
 			"                     ├─ isLateral: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [nd.LUEVY, CASE  WHEN (nma.DZLIM = 'Q5I4E') THEN 1 ELSE 0 END as R2SR7]\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ (nd.HPCMS = nma.MLECF)\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: nd\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ isLateral: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   ├─ colSet: (33,34)\n" +
-			"                             │   ├─ tableId: 4\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [e2i7u.id as LUEVY, e2i7u.HPCMS as HPCMS]\n" +
-			"                             │       └─ Table\n" +
-			"                             │           ├─ name: E2I7U\n" +
-			"                             │           └─ columns: [id hpcms]\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ left-key: (nd.HPCMS)\n" +
-			"                                 ├─ right-key: (nma.MLECF)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: nma\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ isLateral: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [tnmxi.id as MLECF, tnmxi.DZLIM]\n" +
-			"                                             └─ Table\n" +
-			"                                                 ├─ name: TNMXI\n" +
-			"                                                 └─ columns: [id dzlim]\n" +
+			"                         ├─ columns: [e2i7u.id as LUEVY, CASE  WHEN (tnmxi.DZLIM = 'Q5I4E') THEN 1 ELSE 0 END as R2SR7]\n" +
+			"                         └─ LeftOuterMergeJoin\n" +
+			"                             ├─ cmp: (e2i7u.HPCMS = tnmxi.id)\n" +
+			"                             ├─ IndexedTableAccess(E2I7U)\n" +
+			"                             │   ├─ index: [E2I7U.HPCMS]\n" +
+			"                             │   ├─ filters: [{[NULL, ∞)}]\n" +
+			"                             │   └─ columns: [id hpcms]\n" +
+			"                             └─ IndexedTableAccess(TNMXI)\n" +
+			"                                 ├─ index: [TNMXI.id]\n" +
+			"                                 ├─ filters: [{[NULL, ∞)}]\n" +
+			"                                 └─ columns: [id dzlim]\n" +
 			"",
 	},
 	{
@@ -16521,27 +16474,17 @@ LEFT JOIN
 ORDER BY GRRB6.XLFIA ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [qi2ie.DICQO:2!null->DICQO:0]\n" +
-			" └─ Sort(grrb6.XLFIA:0!null ASC nullsFirst)\n" +
+			" └─ Sort(noxn3.id:0!null ASC nullsFirst)\n" +
 			"     └─ LeftOuterHashJoin\n" +
 			"         ├─ Eq\n" +
 			"         │   ├─ qi2ie.VIBZI:3!null\n" +
-			"         │   └─ grrb6.AHMDT:1!null\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: grrb6\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ isLateral: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (13,14)\n" +
-			"         │   ├─ tableId: 2\n" +
-			"         │   └─ Project\n" +
-			"         │       ├─ columns: [noxn3.id:0!null->XLFIA:0, noxn3.BRQP2:1!null->AHMDT:0]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: NOXN3\n" +
-			"         │           ├─ columns: [id brqp2]\n" +
-			"         │           ├─ colSet: (1-10)\n" +
-			"         │           └─ tableId: 1\n" +
+			"         │   └─ noxn3.BRQP2:1!null\n" +
+			"         ├─ ProcessTable\n" +
+			"         │   └─ Table\n" +
+			"         │       ├─ name: NOXN3\n" +
+			"         │       └─ columns: [id brqp2]\n" +
 			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(grrb6.AHMDT:1!null)\n" +
+			"             ├─ left-key: TUPLE(noxn3.BRQP2:1!null)\n" +
 			"             ├─ right-key: TUPLE(qi2ie.VIBZI:1!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
@@ -16564,23 +16507,14 @@ ORDER BY GRRB6.XLFIA ASC`,
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [qi2ie.DICQO as DICQO]\n" +
-			" └─ Sort(grrb6.XLFIA ASC)\n" +
-			"     └─ LeftOuterHashJoin (estimated cost=402.250 rows=125)\n" +
-			"         ├─ (qi2ie.VIBZI = grrb6.AHMDT)\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: grrb6\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ isLateral: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (13,14)\n" +
-			"         │   ├─ tableId: 2\n" +
-			"         │   └─ Project\n" +
-			"         │       ├─ columns: [noxn3.id as XLFIA, noxn3.BRQP2 as AHMDT]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: NOXN3\n" +
-			"         │           └─ columns: [id brqp2]\n" +
+			" └─ Sort(noxn3.id ASC)\n" +
+			"     └─ LeftOuterHashJoin (estimated cost=12349.260 rows=11813)\n" +
+			"         ├─ (qi2ie.VIBZI = noxn3.BRQP2)\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: NOXN3\n" +
+			"         │   └─ columns: [id brqp2]\n" +
 			"         └─ HashLookup\n" +
-			"             ├─ left-key: (grrb6.AHMDT)\n" +
+			"             ├─ left-key: (noxn3.BRQP2)\n" +
 			"             ├─ right-key: (qi2ie.VIBZI)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
@@ -16597,23 +16531,14 @@ ORDER BY GRRB6.XLFIA ASC`,
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [qi2ie.DICQO as DICQO]\n" +
-			" └─ Sort(grrb6.XLFIA ASC)\n" +
-			"     └─ LeftOuterHashJoin (estimated cost=402.250 rows=125) (actual rows=0 loops=1)\n" +
-			"         ├─ (qi2ie.VIBZI = grrb6.AHMDT)\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: grrb6\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ isLateral: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   ├─ colSet: (13,14)\n" +
-			"         │   ├─ tableId: 2\n" +
-			"         │   └─ Project\n" +
-			"         │       ├─ columns: [noxn3.id as XLFIA, noxn3.BRQP2 as AHMDT]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: NOXN3\n" +
-			"         │           └─ columns: [id brqp2]\n" +
+			" └─ Sort(noxn3.id ASC)\n" +
+			"     └─ LeftOuterHashJoin (estimated cost=12349.260 rows=11813) (actual rows=0 loops=1)\n" +
+			"         ├─ (qi2ie.VIBZI = noxn3.BRQP2)\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: NOXN3\n" +
+			"         │   └─ columns: [id brqp2]\n" +
 			"         └─ HashLookup\n" +
-			"             ├─ left-key: (grrb6.AHMDT)\n" +
+			"             ├─ left-key: (noxn3.BRQP2)\n" +
 			"             ├─ right-key: (qi2ie.VIBZI)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
@@ -16649,7 +16574,7 @@ ORDER BY cla.FTQLQ ASC`,
 			"     └─ Project\n" +
 			"         ├─ columns: [cla.FTQLQ:1!null]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [cla.id:1!null, cla.FTQLQ:2!null, cla.TUXML:3, cla.PAEF5:4, cla.RUCY4:5, cla.TPNJ6:6!null, cla.LBL53:7, cla.NB3QS:8, cla.EO7IV:9, cla.MUHJF:10, cla.FM34L:11, cla.TY5RF:12, cla.ZHTLH:13, cla.NPB7W:14, cla.SX3HH:15, cla.ISBNF:16, cla.YA7YB:17, cla.C5YKB:18, cla.QK7KT:19, cla.FFGE6:20, cla.FIIGJ:21, cla.SH3NC:22, cla.NTENA:23, cla.M4AUB:24, cla.X5AIR:25, cla.SAB6M:26, cla.G5QI5:27, cla.ZVQVD:28, cla.YKSSU:29, cla.FHCYT:30]\n" +
+			"             ├─ columns: [YK2GW.id:1!null, YK2GW.FTQLQ:2!null]\n" +
 			"             └─ HashJoin\n" +
 			"                 ├─ Eq\n" +
 			"                 │   ├─ cla.id:1!null\n" +
@@ -16691,14 +16616,14 @@ ORDER BY cla.FTQLQ ASC`,
 			"                         └─ ProcessTable\n" +
 			"                             └─ Table\n" +
 			"                                 ├─ name: YK2GW\n" +
-			"                                 └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			"                                 └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedEstimates: "Sort(cla.FTQLQ ASC)\n" +
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [cla.FTQLQ]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [cla.id, cla.FTQLQ, cla.TUXML, cla.PAEF5, cla.RUCY4, cla.TPNJ6, cla.LBL53, cla.NB3QS, cla.EO7IV, cla.MUHJF, cla.FM34L, cla.TY5RF, cla.ZHTLH, cla.NPB7W, cla.SX3HH, cla.ISBNF, cla.YA7YB, cla.C5YKB, cla.QK7KT, cla.FFGE6, cla.FIIGJ, cla.SH3NC, cla.NTENA, cla.M4AUB, cla.X5AIR, cla.SAB6M, cla.G5QI5, cla.ZVQVD, cla.YKSSU, cla.FHCYT]\n" +
+			"             ├─ columns: [YK2GW.id, YK2GW.FTQLQ]\n" +
 			"             └─ HashJoin (estimated cost=660254.220 rows=639961)\n" +
 			"                 ├─ (cla.id = bs.IXUXU)\n" +
 			"                 ├─ Distinct\n" +
@@ -16724,14 +16649,15 @@ ORDER BY cla.FTQLQ ASC`,
 			"                     ├─ right-key: (cla.id)\n" +
 			"                     └─ TableAlias(cla)\n" +
 			"                         └─ Table\n" +
-			"                             └─ name: YK2GW\n" +
+			"                             ├─ name: YK2GW\n" +
+			"                             └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedAnalysis: "Sort(cla.FTQLQ ASC)\n" +
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [cla.FTQLQ]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [cla.id, cla.FTQLQ, cla.TUXML, cla.PAEF5, cla.RUCY4, cla.TPNJ6, cla.LBL53, cla.NB3QS, cla.EO7IV, cla.MUHJF, cla.FM34L, cla.TY5RF, cla.ZHTLH, cla.NPB7W, cla.SX3HH, cla.ISBNF, cla.YA7YB, cla.C5YKB, cla.QK7KT, cla.FFGE6, cla.FIIGJ, cla.SH3NC, cla.NTENA, cla.M4AUB, cla.X5AIR, cla.SAB6M, cla.G5QI5, cla.ZVQVD, cla.YKSSU, cla.FHCYT]\n" +
+			"             ├─ columns: [YK2GW.id, YK2GW.FTQLQ]\n" +
 			"             └─ HashJoin (estimated cost=660254.220 rows=639961) (actual rows=0 loops=1)\n" +
 			"                 ├─ (cla.id = bs.IXUXU)\n" +
 			"                 ├─ Distinct\n" +
@@ -16757,7 +16683,8 @@ ORDER BY cla.FTQLQ ASC`,
 			"                     ├─ right-key: (cla.id)\n" +
 			"                     └─ TableAlias(cla)\n" +
 			"                         └─ Table\n" +
-			"                             └─ name: YK2GW\n" +
+			"                             ├─ name: YK2GW\n" +
+			"                             └─ columns: [id ftqlq]\n" +
 			"",
 	},
 	{
@@ -16876,7 +16803,7 @@ ORDER BY cla.FTQLQ ASC`,
 			"     └─ Project\n" +
 			"         ├─ columns: [cla.FTQLQ:1!null]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [cla.id:1!null, cla.FTQLQ:2!null, cla.TUXML:3, cla.PAEF5:4, cla.RUCY4:5, cla.TPNJ6:6!null, cla.LBL53:7, cla.NB3QS:8, cla.EO7IV:9, cla.MUHJF:10, cla.FM34L:11, cla.TY5RF:12, cla.ZHTLH:13, cla.NPB7W:14, cla.SX3HH:15, cla.ISBNF:16, cla.YA7YB:17, cla.C5YKB:18, cla.QK7KT:19, cla.FFGE6:20, cla.FIIGJ:21, cla.SH3NC:22, cla.NTENA:23, cla.M4AUB:24, cla.X5AIR:25, cla.SAB6M:26, cla.G5QI5:27, cla.ZVQVD:28, cla.YKSSU:29, cla.FHCYT:30]\n" +
+			"             ├─ columns: [YK2GW.id:1!null, YK2GW.FTQLQ:2!null]\n" +
 			"             └─ HashJoin\n" +
 			"                 ├─ Eq\n" +
 			"                 │   ├─ cla.id:1!null\n" +
@@ -16907,14 +16834,14 @@ ORDER BY cla.FTQLQ ASC`,
 			"                         └─ ProcessTable\n" +
 			"                             └─ Table\n" +
 			"                                 ├─ name: YK2GW\n" +
-			"                                 └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			"                                 └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedEstimates: "Sort(cla.FTQLQ ASC)\n" +
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [cla.FTQLQ]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [cla.id, cla.FTQLQ, cla.TUXML, cla.PAEF5, cla.RUCY4, cla.TPNJ6, cla.LBL53, cla.NB3QS, cla.EO7IV, cla.MUHJF, cla.FM34L, cla.TY5RF, cla.ZHTLH, cla.NPB7W, cla.SX3HH, cla.ISBNF, cla.YA7YB, cla.C5YKB, cla.QK7KT, cla.FFGE6, cla.FIIGJ, cla.SH3NC, cla.NTENA, cla.M4AUB, cla.X5AIR, cla.SAB6M, cla.G5QI5, cla.ZVQVD, cla.YKSSU, cla.FHCYT]\n" +
+			"             ├─ columns: [YK2GW.id, YK2GW.FTQLQ]\n" +
 			"             └─ HashJoin (estimated cost=4889905.560 rows=4786678)\n" +
 			"                 ├─ (cla.id = bs.IXUXU)\n" +
 			"                 ├─ Distinct\n" +
@@ -16934,14 +16861,15 @@ ORDER BY cla.FTQLQ ASC`,
 			"                     ├─ right-key: (cla.id)\n" +
 			"                     └─ TableAlias(cla)\n" +
 			"                         └─ Table\n" +
-			"                             └─ name: YK2GW\n" +
+			"                             ├─ name: YK2GW\n" +
+			"                             └─ columns: [id ftqlq]\n" +
 			"",
 		ExpectedAnalysis: "Sort(cla.FTQLQ ASC)\n" +
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [cla.FTQLQ]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [cla.id, cla.FTQLQ, cla.TUXML, cla.PAEF5, cla.RUCY4, cla.TPNJ6, cla.LBL53, cla.NB3QS, cla.EO7IV, cla.MUHJF, cla.FM34L, cla.TY5RF, cla.ZHTLH, cla.NPB7W, cla.SX3HH, cla.ISBNF, cla.YA7YB, cla.C5YKB, cla.QK7KT, cla.FFGE6, cla.FIIGJ, cla.SH3NC, cla.NTENA, cla.M4AUB, cla.X5AIR, cla.SAB6M, cla.G5QI5, cla.ZVQVD, cla.YKSSU, cla.FHCYT]\n" +
+			"             ├─ columns: [YK2GW.id, YK2GW.FTQLQ]\n" +
 			"             └─ HashJoin (estimated cost=4889905.560 rows=4786678) (actual rows=0 loops=1)\n" +
 			"                 ├─ (cla.id = bs.IXUXU)\n" +
 			"                 ├─ Distinct\n" +
@@ -16961,7 +16889,8 @@ ORDER BY cla.FTQLQ ASC`,
 			"                     ├─ right-key: (cla.id)\n" +
 			"                     └─ TableAlias(cla)\n" +
 			"                         └─ Table\n" +
-			"                             └─ name: YK2GW\n" +
+			"                             ├─ name: YK2GW\n" +
+			"                             └─ columns: [id ftqlq]\n" +
 			"",
 	},
 	{
@@ -17246,11 +17175,13 @@ ORDER BY LUEVY`,
 			"             │               ├─ TableAlias(nd)\n" +
 			"             │               │   └─ IndexedTableAccess(E2I7U)\n" +
 			"             │               │       ├─ index: [E2I7U.HPCMS]\n" +
-			"             │               │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"             │               └─ TableAlias(nma)\n" +
 			"             │                   └─ IndexedTableAccess(TNMXI)\n" +
 			"             │                       ├─ index: [TNMXI.id]\n" +
-			"             │                       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │                       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │                       └─ columns: [id dzlim f3yue]\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (ypgda.I3L5A)\n" +
 			"                 ├─ right-key: (ybbg5.id)\n" +
@@ -17304,11 +17235,13 @@ ORDER BY LUEVY`,
 			"             │               ├─ TableAlias(nd)\n" +
 			"             │               │   └─ IndexedTableAccess(E2I7U)\n" +
 			"             │               │       ├─ index: [E2I7U.HPCMS]\n" +
-			"             │               │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │               │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"             │               └─ TableAlias(nma)\n" +
 			"             │                   └─ IndexedTableAccess(TNMXI)\n" +
 			"             │                       ├─ index: [TNMXI.id]\n" +
-			"             │                       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │                       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │                       └─ columns: [id dzlim f3yue]\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (ypgda.I3L5A)\n" +
 			"                 ├─ right-key: (ybbg5.id)\n" +
@@ -19768,13 +19701,13 @@ WHERE
 			"     │           ├─ tableId: 4\n" +
 			"     │           └─ Distinct\n" +
 			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [umf.SYPKF:8->BTXC5:0]\n" +
+			"     │                   ├─ columns: [umf.SYPKF:1->BTXC5:0]\n" +
 			"     │                   └─ Filter\n" +
 			"     │                       ├─ AND\n" +
 			"     │                       │   ├─ AND\n" +
 			"     │                       │   │   ├─ NOT\n" +
 			"     │                       │   │   │   └─ InSubquery\n" +
-			"     │                       │   │   │       ├─ left: umf.SYPKF:8\n" +
+			"     │                       │   │   │       ├─ left: umf.SYPKF:1\n" +
 			"     │                       │   │   │       └─ right: Subquery\n" +
 			"     │                       │   │   │           ├─ cacheable: true\n" +
 			"     │                       │   │   │           ├─ alias-string: select BTXC5 from TPXBU where BTXC5 is not null\n" +
@@ -19787,10 +19720,10 @@ WHERE
 			"     │                       │   │   │                   ├─ name: TPXBU\n" +
 			"     │                       │   │   │                   └─ columns: [btxc5]\n" +
 			"     │                       │   │   └─ NOT\n" +
-			"     │                       │   │       └─ umf.SYPKF:8 IS NULL\n" +
+			"     │                       │   │       └─ umf.SYPKF:1 IS NULL\n" +
 			"     │                       │   └─ NOT\n" +
 			"     │                       │       └─ Eq\n" +
-			"     │                       │           ├─ umf.SYPKF:8\n" +
+			"     │                       │           ├─ umf.SYPKF:1\n" +
 			"     │                       │           └─ N/A (longtext)\n" +
 			"     │                       └─ TableAlias(umf)\n" +
 			"     │                           └─ IndexedTableAccess(NZKPM)\n" +
@@ -19800,7 +19733,7 @@ WHERE
 			"     │                               ├─ tableId: 2\n" +
 			"     │                               └─ Table\n" +
 			"     │                                   ├─ name: NZKPM\n" +
-			"     │                                   └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
+			"     │                                   └─ columns: [id sypkf]\n" +
 			"     └─ BEGIN .. END\n" +
 			"         └─ IF BLOCK\n" +
 			"             └─ IF(InSubquery\n" +
@@ -19998,14 +19931,11 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     │       │           │   ├─ aac.BTXC5:85\n" +
 			"     │       │           │   └─ umf.SYPKF:8\n" +
 			"     │       │           └─ TableAlias(aac)\n" +
-			"     │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"     │       │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"     │       │                   ├─ keys: [umf.SYPKF:8]\n" +
+			"     │       │               └─ Table\n" +
+			"     │       │                   ├─ name: TPXBU\n" +
+			"     │       │                   ├─ columns: [id btxc5]\n" +
 			"     │       │                   ├─ colSet: (197-199)\n" +
-			"     │       │                   ├─ tableId: 12\n" +
-			"     │       │                   └─ Table\n" +
-			"     │       │                       ├─ name: TPXBU\n" +
-			"     │       │                       └─ columns: [id btxc5]\n" +
+			"     │       │                   └─ tableId: 12\n" +
 			"     │       │   END->M22QN:0, umf.TJPT7:6->TJPT7:0, umf.ARN5P:7->ARN5P:0, umf.XOSD4:13->XOSD4:0, umf.IDE43:10->IDE43:0, CASE  WHEN NOT\n" +
 			"     │       │   └─ Eq\n" +
 			"     │       │       ├─ umf.HMW4H:14\n" +
@@ -20032,7 +19962,7 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     │       │       └─  (longtext)\n" +
 			"     │       │   THEN (umf.QCGTS:18 + 0.0 (decimal(2,1))) ELSE NULL (null) END->QCGTS:0, umf.id:0!null->TEUJA:0, tj5d2.id:25!null->QQV4M:0, umf.FHCYT:23->FHCYT:0]\n" +
 			"     │       └─ Project\n" +
-			"     │           ├─ columns: [umf.id:0!null, umf.T4IBQ:1, umf.FGG57:2, umf.SSHPJ:3, umf.NLA6O:4, umf.SFJ6L:5, umf.TJPT7:6, umf.ARN5P:7, umf.SYPKF:8, umf.IVFMK:9, umf.IDE43:10, umf.AZ6SP:11, umf.FSDY2:12, umf.XOSD4:13, umf.HMW4H:14, umf.S76OM:15, umf.vaf:16, umf.ZROH6:17, umf.QCGTS:18, umf.LNFM6:19, umf.TVAWL:20, umf.HDLCL:21, umf.BHHW6:22, umf.FHCYT:23, umf.QZ6VT:24, tj5d2.id:25!null, tj5d2.T4IBQ:26!null, tj5d2.V7UFH:27!null, tj5d2.SYPKF:28!null, tj5d2.H4DMT:29!null, tj5d2.SWCQV:30!null, tj5d2.YKSSU:31, tj5d2.FHCYT:32, cla.id:33!null, cla.FTQLQ:34!null, cla.TUXML:35, cla.PAEF5:36, cla.RUCY4:37, cla.TPNJ6:38!null, cla.LBL53:39, cla.NB3QS:40, cla.EO7IV:41, cla.MUHJF:42, cla.FM34L:43, cla.TY5RF:44, cla.ZHTLH:45, cla.NPB7W:46, cla.SX3HH:47, cla.ISBNF:48, cla.YA7YB:49, cla.C5YKB:50, cla.QK7KT:51, cla.FFGE6:52, cla.FIIGJ:53, cla.SH3NC:54, cla.NTENA:55, cla.M4AUB:56, cla.X5AIR:57, cla.SAB6M:58, cla.G5QI5:59, cla.ZVQVD:60, cla.YKSSU:61, cla.FHCYT:62, bs.id:63!null, bs.NFRYN:64!null, bs.IXUXU:65, bs.FHCYT:66, umf.id:0!null->id:0, bs.id:63!null->GXLUB:0, CASE  WHEN NOT\n" +
+			"     │           ├─ columns: [nzkpm.id:0!null->id:0, nzkpm.T4IBQ:1->T4IBQ:0, nzkpm.FGG57:2->FGG57:0, nzkpm.SSHPJ:3->SSHPJ:0, nzkpm.NLA6O:4->NLA6O:0, nzkpm.SFJ6L:5->SFJ6L:0, nzkpm.TJPT7:6->TJPT7:0, nzkpm.ARN5P:7->ARN5P:0, nzkpm.SYPKF:8->SYPKF:0, nzkpm.IVFMK:9->IVFMK:0, nzkpm.IDE43:10->IDE43:0, nzkpm.AZ6SP:11->AZ6SP:0, nzkpm.FSDY2:12->FSDY2:0, nzkpm.XOSD4:13->XOSD4:0, nzkpm.HMW4H:14->HMW4H:0, nzkpm.S76OM:15->S76OM:0, nzkpm.vaf:16->vaf:0, nzkpm.ZROH6:17->ZROH6:0, nzkpm.QCGTS:18->QCGTS:0, nzkpm.LNFM6:19->LNFM6:0, nzkpm.TVAWL:20->TVAWL:0, nzkpm.HDLCL:21->HDLCL:0, nzkpm.BHHW6:22->BHHW6:0, nzkpm.FHCYT:23->FHCYT:0, nzkpm.QZ6VT:24->QZ6VT:0, tj5d2.id:25!null, tj5d2.T4IBQ:26!null, tj5d2.V7UFH:27!null, tj5d2.SYPKF:28!null, tj5d2.H4DMT:29!null, tj5d2.SWCQV:30!null, tj5d2.YKSSU:31, tj5d2.FHCYT:32, cla.id:33!null, cla.FTQLQ:34!null, cla.TUXML:35, cla.PAEF5:36, cla.RUCY4:37, cla.TPNJ6:38!null, cla.LBL53:39, cla.NB3QS:40, cla.EO7IV:41, cla.MUHJF:42, cla.FM34L:43, cla.TY5RF:44, cla.ZHTLH:45, cla.NPB7W:46, cla.SX3HH:47, cla.ISBNF:48, cla.YA7YB:49, cla.C5YKB:50, cla.QK7KT:51, cla.FFGE6:52, cla.FIIGJ:53, cla.SH3NC:54, cla.NTENA:55, cla.M4AUB:56, cla.X5AIR:57, cla.SAB6M:58, cla.G5QI5:59, cla.ZVQVD:60, cla.YKSSU:61, cla.FHCYT:62, bs.id:63!null, bs.NFRYN:64!null, bs.IXUXU:65, bs.FHCYT:66, nzkpm.id:0!null->id:0, bs.id:63!null->GXLUB:0, CASE  WHEN NOT\n" +
 			"     │           │   └─ tj5d2.id:25!null IS NULL\n" +
 			"     │           │   THEN Subquery\n" +
 			"     │           │   ├─ cacheable: false\n" +
@@ -20060,7 +19990,7 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     │           │       └─ Filter\n" +
 			"     │           │           ├─ Eq\n" +
 			"     │           │           │   ├─ nd_for_id.FGG57:68\n" +
-			"     │           │           │   └─ umf.FGG57:2\n" +
+			"     │           │           │   └─ nzkpm.FGG57:2\n" +
 			"     │           │           └─ TableAlias(nd_for_id)\n" +
 			"     │           │               └─ IndexedTableAccess(E2I7U)\n" +
 			"     │           │                   ├─ index: [E2I7U.FGG57]\n" +
@@ -20071,7 +20001,7 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     │           │                       ├─ name: E2I7U\n" +
 			"     │           │                       └─ columns: [id fgg57]\n" +
 			"     │           │   END->LUEVY:0, CASE  WHEN Eq\n" +
-			"     │           │   ├─ umf.SYPKF:8\n" +
+			"     │           │   ├─ nzkpm.SYPKF:8\n" +
 			"     │           │   └─ N/A (longtext)\n" +
 			"     │           │   THEN Subquery\n" +
 			"     │           │   ├─ cacheable: true\n" +
@@ -20094,41 +20024,38 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     │           │       └─ Filter\n" +
 			"     │           │           ├─ Eq\n" +
 			"     │           │           │   ├─ aac.BTXC5:68\n" +
-			"     │           │           │   └─ umf.SYPKF:8\n" +
+			"     │           │           │   └─ nzkpm.SYPKF:8\n" +
 			"     │           │           └─ TableAlias(aac)\n" +
-			"     │           │               └─ IndexedTableAccess(TPXBU)\n" +
-			"     │           │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"     │           │                   ├─ keys: [umf.SYPKF:8]\n" +
+			"     │           │               └─ Table\n" +
+			"     │           │                   ├─ name: TPXBU\n" +
+			"     │           │                   ├─ columns: [id btxc5]\n" +
 			"     │           │                   ├─ colSet: (197-199)\n" +
-			"     │           │                   ├─ tableId: 12\n" +
-			"     │           │                   └─ Table\n" +
-			"     │           │                       ├─ name: TPXBU\n" +
-			"     │           │                       └─ columns: [id btxc5]\n" +
-			"     │           │   END->M22QN:0, umf.TJPT7:6->TJPT7:0, umf.ARN5P:7->ARN5P:0, umf.XOSD4:13->XOSD4:0, umf.IDE43:10->IDE43:0, CASE  WHEN NOT\n" +
+			"     │           │                   └─ tableId: 12\n" +
+			"     │           │   END->M22QN:0, nzkpm.TJPT7:6->TJPT7:0, nzkpm.ARN5P:7->ARN5P:0, nzkpm.XOSD4:13->XOSD4:0, nzkpm.IDE43:10->IDE43:0, CASE  WHEN NOT\n" +
 			"     │           │   └─ Eq\n" +
-			"     │           │       ├─ umf.HMW4H:14\n" +
+			"     │           │       ├─ nzkpm.HMW4H:14\n" +
 			"     │           │       └─ N/A (longtext)\n" +
-			"     │           │   THEN umf.HMW4H:14 ELSE NULL (null) END->HMW4H:0, CASE  WHEN NOT\n" +
+			"     │           │   THEN nzkpm.HMW4H:14 ELSE NULL (null) END->HMW4H:0, CASE  WHEN NOT\n" +
 			"     │           │   └─ Eq\n" +
-			"     │           │       ├─ umf.S76OM:15\n" +
+			"     │           │       ├─ nzkpm.S76OM:15\n" +
 			"     │           │       └─ N/A (longtext)\n" +
-			"     │           │   THEN (umf.S76OM:15 + 0 (tinyint)) ELSE NULL (null) END->ZBT6R:0, CASE  WHEN NOT\n" +
+			"     │           │   THEN (nzkpm.S76OM:15 + 0 (tinyint)) ELSE NULL (null) END->ZBT6R:0, CASE  WHEN NOT\n" +
 			"     │           │   └─ Eq\n" +
-			"     │           │       ├─ umf.FSDY2:12\n" +
+			"     │           │       ├─ nzkpm.FSDY2:12\n" +
 			"     │           │       └─ N/A (longtext)\n" +
-			"     │           │   THEN umf.FSDY2:12 ELSE VUS (longtext) END->FSDY2:0, CASE  WHEN NOT\n" +
+			"     │           │   THEN nzkpm.FSDY2:12 ELSE VUS (longtext) END->FSDY2:0, CASE  WHEN NOT\n" +
 			"     │           │   └─ Eq\n" +
-			"     │           │       ├─ umf.vaf:16\n" +
+			"     │           │       ├─ nzkpm.vaf:16\n" +
 			"     │           │       └─  (longtext)\n" +
-			"     │           │   THEN (umf.vaf:16 + 0.0 (decimal(2,1))) ELSE NULL (null) END->LT7K6:0, CASE  WHEN NOT\n" +
+			"     │           │   THEN (nzkpm.vaf:16 + 0.0 (decimal(2,1))) ELSE NULL (null) END->LT7K6:0, CASE  WHEN NOT\n" +
 			"     │           │   └─ Eq\n" +
-			"     │           │       ├─ umf.ZROH6:17\n" +
+			"     │           │       ├─ nzkpm.ZROH6:17\n" +
 			"     │           │       └─  (longtext)\n" +
-			"     │           │   THEN (umf.ZROH6:17 + 0.0 (decimal(2,1))) ELSE NULL (null) END->SPPYD:0, CASE  WHEN NOT\n" +
+			"     │           │   THEN (nzkpm.ZROH6:17 + 0.0 (decimal(2,1))) ELSE NULL (null) END->SPPYD:0, CASE  WHEN NOT\n" +
 			"     │           │   └─ Eq\n" +
-			"     │           │       ├─ umf.QCGTS:18\n" +
+			"     │           │       ├─ nzkpm.QCGTS:18\n" +
 			"     │           │       └─  (longtext)\n" +
-			"     │           │   THEN (umf.QCGTS:18 + 0.0 (decimal(2,1))) ELSE NULL (null) END->QCGTS:0, umf.id:0!null->TEUJA:0, tj5d2.id:25!null->QQV4M:0, umf.FHCYT:23->FHCYT:0]\n" +
+			"     │           │   THEN (nzkpm.QCGTS:18 + 0.0 (decimal(2,1))) ELSE NULL (null) END->QCGTS:0, nzkpm.id:0!null->TEUJA:0, tj5d2.id:25!null->QQV4M:0, nzkpm.FHCYT:23->FHCYT:0]\n" +
 			"     │           └─ LookupJoin\n" +
 			"     │               ├─ LookupJoin\n" +
 			"     │               │   ├─ LeftOuterJoin\n" +
@@ -20140,58 +20067,51 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     │               │   │   │   │   │   │   └─ 0 (int)\n" +
 			"     │               │   │   │   │   │   └─ Eq\n" +
 			"     │               │   │   │   │   │       ├─ tj5d2.T4IBQ:26!null\n" +
-			"     │               │   │   │   │   │       └─ umf.T4IBQ:1\n" +
+			"     │               │   │   │   │   │       └─ nzkpm.T4IBQ:1\n" +
 			"     │               │   │   │   │   └─ Eq\n" +
 			"     │               │   │   │   │       ├─ tj5d2.V7UFH:27!null\n" +
-			"     │               │   │   │   │       └─ umf.FGG57:2\n" +
+			"     │               │   │   │   │       └─ nzkpm.FGG57:2\n" +
 			"     │               │   │   │   └─ Eq\n" +
 			"     │               │   │   │       ├─ tj5d2.SYPKF:28!null\n" +
-			"     │               │   │   │       └─ umf.SYPKF:8\n" +
-			"     │               │   │   ├─ SubqueryAlias\n" +
-			"     │               │   │   │   ├─ name: umf\n" +
-			"     │               │   │   │   ├─ outerVisibility: false\n" +
-			"     │               │   │   │   ├─ isLateral: false\n" +
-			"     │               │   │   │   ├─ cacheable: true\n" +
-			"     │               │   │   │   ├─ colSet: (90-114)\n" +
-			"     │               │   │   │   ├─ tableId: 5\n" +
-			"     │               │   │   │   └─ Filter\n" +
-			"     │               │   │   │       ├─ AND\n" +
-			"     │               │   │   │       │   ├─ AND\n" +
-			"     │               │   │   │       │   │   ├─ InSubquery\n" +
-			"     │               │   │   │       │   │   │   ├─ left: nzkpm.T4IBQ:1\n" +
-			"     │               │   │   │       │   │   │   └─ right: Subquery\n" +
-			"     │               │   │   │       │   │   │       ├─ cacheable: true\n" +
-			"     │               │   │   │       │   │   │       ├─ alias-string: select FTQLQ from YK2GW\n" +
-			"     │               │   │   │       │   │   │       └─ Table\n" +
-			"     │               │   │   │       │   │   │           ├─ name: YK2GW\n" +
-			"     │               │   │   │       │   │   │           ├─ columns: [ftqlq]\n" +
-			"     │               │   │   │       │   │   │           ├─ colSet: (43-72)\n" +
-			"     │               │   │   │       │   │   │           └─ tableId: 3\n" +
-			"     │               │   │   │       │   │   └─ InSubquery\n" +
-			"     │               │   │   │       │   │       ├─ left: nzkpm.FGG57:2\n" +
-			"     │               │   │   │       │   │       └─ right: Subquery\n" +
-			"     │               │   │   │       │   │           ├─ cacheable: true\n" +
-			"     │               │   │   │       │   │           ├─ alias-string: select FGG57 from E2I7U where FGG57 is not null\n" +
-			"     │               │   │   │       │   │           └─ IndexedTableAccess(E2I7U)\n" +
-			"     │               │   │   │       │   │               ├─ index: [E2I7U.FGG57]\n" +
-			"     │               │   │   │       │   │               ├─ static: [{(NULL, ∞)}]\n" +
-			"     │               │   │   │       │   │               ├─ colSet: (73-89)\n" +
-			"     │               │   │   │       │   │               ├─ tableId: 4\n" +
-			"     │               │   │   │       │   │               └─ Table\n" +
-			"     │               │   │   │       │   │                   ├─ name: E2I7U\n" +
-			"     │               │   │   │       │   │                   └─ columns: [fgg57]\n" +
-			"     │               │   │   │       │   └─ NOT\n" +
-			"     │               │   │   │       │       └─ Eq\n" +
-			"     │               │   │   │       │           ├─ nzkpm.ARN5P:7\n" +
-			"     │               │   │   │       │           └─ N/A (longtext)\n" +
-			"     │               │   │   │       └─ IndexedTableAccess(NZKPM)\n" +
-			"     │               │   │   │           ├─ index: [NZKPM.id]\n" +
-			"     │               │   │   │           ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
-			"     │               │   │   │           ├─ colSet: (18-42)\n" +
-			"     │               │   │   │           ├─ tableId: 2\n" +
-			"     │               │   │   │           └─ Table\n" +
-			"     │               │   │   │               ├─ name: NZKPM\n" +
-			"     │               │   │   │               └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
+			"     │               │   │   │       └─ nzkpm.SYPKF:8\n" +
+			"     │               │   │   ├─ Filter\n" +
+			"     │               │   │   │   ├─ AND\n" +
+			"     │               │   │   │   │   ├─ AND\n" +
+			"     │               │   │   │   │   │   ├─ InSubquery\n" +
+			"     │               │   │   │   │   │   │   ├─ left: nzkpm.T4IBQ:1\n" +
+			"     │               │   │   │   │   │   │   └─ right: Subquery\n" +
+			"     │               │   │   │   │   │   │       ├─ cacheable: true\n" +
+			"     │               │   │   │   │   │   │       ├─ alias-string: select FTQLQ from YK2GW\n" +
+			"     │               │   │   │   │   │   │       └─ Table\n" +
+			"     │               │   │   │   │   │   │           ├─ name: YK2GW\n" +
+			"     │               │   │   │   │   │   │           ├─ columns: [ftqlq]\n" +
+			"     │               │   │   │   │   │   │           ├─ colSet: (43-72)\n" +
+			"     │               │   │   │   │   │   │           └─ tableId: 3\n" +
+			"     │               │   │   │   │   │   └─ InSubquery\n" +
+			"     │               │   │   │   │   │       ├─ left: nzkpm.FGG57:2\n" +
+			"     │               │   │   │   │   │       └─ right: Subquery\n" +
+			"     │               │   │   │   │   │           ├─ cacheable: true\n" +
+			"     │               │   │   │   │   │           ├─ alias-string: select FGG57 from E2I7U where FGG57 is not null\n" +
+			"     │               │   │   │   │   │           └─ IndexedTableAccess(E2I7U)\n" +
+			"     │               │   │   │   │   │               ├─ index: [E2I7U.FGG57]\n" +
+			"     │               │   │   │   │   │               ├─ static: [{(NULL, ∞)}]\n" +
+			"     │               │   │   │   │   │               ├─ colSet: (73-89)\n" +
+			"     │               │   │   │   │   │               ├─ tableId: 4\n" +
+			"     │               │   │   │   │   │               └─ Table\n" +
+			"     │               │   │   │   │   │                   ├─ name: E2I7U\n" +
+			"     │               │   │   │   │   │                   └─ columns: [fgg57]\n" +
+			"     │               │   │   │   │   └─ NOT\n" +
+			"     │               │   │   │   │       └─ Eq\n" +
+			"     │               │   │   │   │           ├─ nzkpm.ARN5P:7\n" +
+			"     │               │   │   │   │           └─ N/A (longtext)\n" +
+			"     │               │   │   │   └─ IndexedTableAccess(NZKPM)\n" +
+			"     │               │   │   │       ├─ index: [NZKPM.id]\n" +
+			"     │               │   │   │       ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
+			"     │               │   │   │       ├─ colSet: (18-42)\n" +
+			"     │               │   │   │       ├─ tableId: 2\n" +
+			"     │               │   │   │       └─ Table\n" +
+			"     │               │   │   │           ├─ name: NZKPM\n" +
+			"     │               │   │   │           └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
 			"     │               │   │   └─ TableAlias(tj5d2)\n" +
 			"     │               │   │       └─ ProcessTable\n" +
 			"     │               │   │           └─ Table\n" +
@@ -20200,7 +20120,7 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     │               │   └─ TableAlias(cla)\n" +
 			"     │               │       └─ IndexedTableAccess(YK2GW)\n" +
 			"     │               │           ├─ index: [YK2GW.FTQLQ]\n" +
-			"     │               │           ├─ keys: [umf.T4IBQ:1]\n" +
+			"     │               │           ├─ keys: [nzkpm.T4IBQ:1]\n" +
 			"     │               │           ├─ colSet: (123-152)\n" +
 			"     │               │           ├─ tableId: 7\n" +
 			"     │               │           └─ Table\n" +
@@ -21379,10 +21299,10 @@ WHERE
 			"     │               │           │           │   │           │   ├─ alias-string: select nd.DKCAJ from E2I7U as nd where nd.ZH72S = uct.ZH72S limit 1\n" +
 			"     │               │           │           │   │           │   └─ Limit(1)\n" +
 			"     │               │           │           │   │           │       └─ Project\n" +
-			"     │               │           │           │   │           │           ├─ columns: [nd.DKCAJ:40!null]\n" +
+			"     │               │           │           │   │           │           ├─ columns: [nd.DKCAJ:38!null]\n" +
 			"     │               │           │           │   │           │           └─ Filter\n" +
 			"     │               │           │           │   │           │               ├─ Eq\n" +
-			"     │               │           │           │   │           │               │   ├─ nd.ZH72S:41\n" +
+			"     │               │           │           │   │           │               │   ├─ nd.ZH72S:39\n" +
 			"     │               │           │           │   │           │               │   └─ uct.ZH72S:2\n" +
 			"     │               │           │           │   │           │               └─ TableAlias(nd)\n" +
 			"     │               │           │           │   │           │                   └─ IndexedTableAccess(E2I7U)\n" +
@@ -21397,10 +21317,10 @@ WHERE
 			"     │               │           │           │   │           │   ├─ cacheable: false\n" +
 			"     │               │           │           │   │           │   ├─ alias-string: select nd.DKCAJ from E2I7U as nd where nd.TW55N = I7HCR.FVUCX\n" +
 			"     │               │           │           │   │           │   └─ Project\n" +
-			"     │               │           │           │   │           │       ├─ columns: [nd.DKCAJ:40!null]\n" +
+			"     │               │           │           │   │           │       ├─ columns: [nd.DKCAJ:38!null]\n" +
 			"     │               │           │           │   │           │       └─ Filter\n" +
 			"     │               │           │           │   │           │           ├─ Eq\n" +
-			"     │               │           │           │   │           │           │   ├─ nd.TW55N:41!null\n" +
+			"     │               │           │           │   │           │           │   ├─ nd.TW55N:39!null\n" +
 			"     │               │           │           │   │           │           │   └─ i7hcr.FVUCX:17!null\n" +
 			"     │               │           │           │   │           │           └─ TableAlias(nd)\n" +
 			"     │               │           │           │   │           │               └─ IndexedTableAccess(E2I7U)\n" +
@@ -21418,7 +21338,7 @@ WHERE
 			"     │               │           │           │   │           │                              (SELECT nd.DKCAJ FROM E2I7U nd WHERE nd.TW55N = I7HCR.FVUCX)\n" +
 			"     │               │           │           │   │           │                      END:0]\n" +
 			"     │               │           │           │   │           └─ Project\n" +
-			"     │               │           │           │   │               ├─ columns: [dual.:39!null]\n" +
+			"     │               │           │           │   │               ├─ columns: [dual.:37!null]\n" +
 			"     │               │           │           │   │               └─ Table\n" +
 			"     │               │           │           │   │                   ├─ name: \n" +
 			"     │               │           │           │   │                   ├─ columns: []\n" +
@@ -21450,7 +21370,7 @@ WHERE
 			"     │               │           │               ├─ tableId: 5\n" +
 			"     │               │           │               └─ Table\n" +
 			"     │               │           │                   ├─ name: SFEGG\n" +
-			"     │               │           │                   └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
+			"     │               │           │                   └─ columns: [id no52d vyo5e dkcaj]\n" +
 			"     │               │           │  ->OVE3E:0, uct.id:0!null->NRURT:0, i7hcr.id:13!null->OCA7E:0, NULL (null)->XMM6Q:83, uct.V5DPX:4->V5DPX:0, (uct.IDPK7:6 + 0.0 (decimal(2,1)))->S3Q3Y:0, uct.ZRV3B:8->ZRV3B:0, CASE  WHEN NOT\n" +
 			"     │               │           │   └─ Eq\n" +
 			"     │               │           │       ├─ uct.FHCYT:11\n" +
@@ -21492,10 +21412,10 @@ WHERE
 			"     │               │               │           │   │           │   ├─ alias-string: select nd.DKCAJ from E2I7U as nd where nd.ZH72S = uct.ZH72S limit 1\n" +
 			"     │               │               │           │   │           │   └─ Limit(1)\n" +
 			"     │               │               │           │   │           │       └─ Project\n" +
-			"     │               │               │           │   │           │           ├─ columns: [nd.DKCAJ:28!null]\n" +
+			"     │               │               │           │   │           │           ├─ columns: [nd.DKCAJ:26!null]\n" +
 			"     │               │               │           │   │           │           └─ Filter\n" +
 			"     │               │               │           │   │           │               ├─ Eq\n" +
-			"     │               │               │           │   │           │               │   ├─ nd.ZH72S:29\n" +
+			"     │               │               │           │   │           │               │   ├─ nd.ZH72S:27\n" +
 			"     │               │               │           │   │           │               │   └─ uct.ZH72S:2\n" +
 			"     │               │               │           │   │           │               └─ TableAlias(nd)\n" +
 			"     │               │               │           │   │           │                   └─ IndexedTableAccess(E2I7U)\n" +
@@ -21510,10 +21430,10 @@ WHERE
 			"     │               │               │           │   │           │   ├─ cacheable: false\n" +
 			"     │               │               │           │   │           │   ├─ alias-string: select nd.DKCAJ from E2I7U as nd where nd.TW55N = I7HCR.FVUCX\n" +
 			"     │               │               │           │   │           │   └─ Project\n" +
-			"     │               │               │           │   │           │       ├─ columns: [nd.DKCAJ:28!null]\n" +
+			"     │               │               │           │   │           │       ├─ columns: [nd.DKCAJ:26!null]\n" +
 			"     │               │               │           │   │           │       └─ Filter\n" +
 			"     │               │               │           │   │           │           ├─ Eq\n" +
-			"     │               │               │           │   │           │           │   ├─ nd.TW55N:29!null\n" +
+			"     │               │               │           │   │           │           │   ├─ nd.TW55N:27!null\n" +
 			"     │               │               │           │   │           │           │   └─ i7hcr.FVUCX:17!null\n" +
 			"     │               │               │           │   │           │           └─ TableAlias(nd)\n" +
 			"     │               │               │           │   │           │               └─ IndexedTableAccess(E2I7U)\n" +
@@ -21531,7 +21451,7 @@ WHERE
 			"     │               │               │           │   │           │                              (SELECT nd.DKCAJ FROM E2I7U nd WHERE nd.TW55N = I7HCR.FVUCX)\n" +
 			"     │               │               │           │   │           │                      END:0]\n" +
 			"     │               │               │           │   │           └─ Project\n" +
-			"     │               │               │           │   │               ├─ columns: [dual.:27!null]\n" +
+			"     │               │               │           │   │               ├─ columns: [dual.:25!null]\n" +
 			"     │               │               │           │   │               └─ Table\n" +
 			"     │               │               │           │   │                   ├─ name: \n" +
 			"     │               │               │           │   │                   ├─ columns: []\n" +
@@ -21563,7 +21483,7 @@ WHERE
 			"     │               │               │               ├─ tableId: 5\n" +
 			"     │               │               │               └─ Table\n" +
 			"     │               │               │                   ├─ name: SFEGG\n" +
-			"     │               │               │                   └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
+			"     │               │               │                   └─ columns: [id no52d vyo5e dkcaj]\n" +
 			"     │               │               │  ->OVE3E:0, uct.id:0!null->NRURT:0, i7hcr.id:13!null->OCA7E:0, NULL (null)->XMM6Q:83, uct.V5DPX:4->V5DPX:0, (uct.IDPK7:6 + 0.0 (decimal(2,1)))->S3Q3Y:0, uct.ZRV3B:8->ZRV3B:0, CASE  WHEN NOT\n" +
 			"     │               │               │   └─ Eq\n" +
 			"     │               │               │       ├─ uct.FHCYT:11\n" +
@@ -21997,10 +21917,10 @@ WHERE
 			"     │       │           │   │       ├─ cacheable: false\n" +
 			"     │       │           │   │       ├─ alias-string: select nd.DKCAJ from E2I7U as nd where nd.TW55N = TVTJS.I3VTA\n" +
 			"     │       │           │   │       └─ Project\n" +
-			"     │       │           │   │           ├─ columns: [nd.DKCAJ:31!null]\n" +
+			"     │       │           │   │           ├─ columns: [nd.DKCAJ:29!null]\n" +
 			"     │       │           │   │           └─ Filter\n" +
 			"     │       │           │   │               ├─ Eq\n" +
-			"     │       │           │   │               │   ├─ nd.TW55N:32!null\n" +
+			"     │       │           │   │               │   ├─ nd.TW55N:30!null\n" +
 			"     │       │           │   │               │   └─ tvtjs.I3VTA:2!null\n" +
 			"     │       │           │   │               └─ TableAlias(nd)\n" +
 			"     │       │           │   │                   └─ IndexedTableAccess(E2I7U)\n" +
@@ -22037,7 +21957,7 @@ WHERE
 			"     │       │               ├─ tableId: 6\n" +
 			"     │       │               └─ Table\n" +
 			"     │       │                   ├─ name: SFEGG\n" +
-			"     │       │                   └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
+			"     │       │                   └─ columns: [id no52d vyo5e dkcaj]\n" +
 			"     │       │  ->OVE3E:0, NULL (null)->NRURT:79, NULL (null)->OCA7E:80, tvtjs.id:0!null->XMM6Q:0, tvtjs.V5DPX:4!null->V5DPX:0, (tvtjs.IDPK7:6!null + 0.0 (decimal(2,1)))->S3Q3Y:0, tvtjs.ZRV3B:8!null->ZRV3B:0, tvtjs.FHCYT:12->FHCYT:0]\n" +
 			"     │       └─ Project\n" +
 			"     │           ├─ columns: [tvtjs.id:0!null, tvtjs.TOFPN:1!null, tvtjs.I3VTA:2!null, tvtjs.SFJ6L:3, tvtjs.V5DPX:4!null, tvtjs.LJLUM:5!null, tvtjs.IDPK7:6!null, tvtjs.NO52D:7!null, tvtjs.ZRV3B:8!null, tvtjs.VYO5E:9, tvtjs.SWCQV:10!null, tvtjs.YKSSU:11, tvtjs.FHCYT:12, lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0')->id:26, Subquery\n" +
@@ -22104,10 +22024,10 @@ WHERE
 			"     │           │           │   │       ├─ cacheable: false\n" +
 			"     │           │           │   │       ├─ alias-string: select nd.DKCAJ from E2I7U as nd where nd.TW55N = TVTJS.I3VTA\n" +
 			"     │           │           │   │       └─ Project\n" +
-			"     │           │           │   │           ├─ columns: [nd.DKCAJ:19!null]\n" +
+			"     │           │           │   │           ├─ columns: [nd.DKCAJ:17!null]\n" +
 			"     │           │           │   │           └─ Filter\n" +
 			"     │           │           │   │               ├─ Eq\n" +
-			"     │           │           │   │               │   ├─ nd.TW55N:20!null\n" +
+			"     │           │           │   │               │   ├─ nd.TW55N:18!null\n" +
 			"     │           │           │   │               │   └─ tvtjs.I3VTA:2!null\n" +
 			"     │           │           │   │               └─ TableAlias(nd)\n" +
 			"     │           │           │   │                   └─ IndexedTableAccess(E2I7U)\n" +
@@ -22144,7 +22064,7 @@ WHERE
 			"     │           │               ├─ tableId: 6\n" +
 			"     │           │               └─ Table\n" +
 			"     │           │                   ├─ name: SFEGG\n" +
-			"     │           │                   └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
+			"     │           │                   └─ columns: [id no52d vyo5e dkcaj]\n" +
 			"     │           │  ->OVE3E:0, NULL (null)->NRURT:79, NULL (null)->OCA7E:80, tvtjs.id:0!null->XMM6Q:0, tvtjs.V5DPX:4!null->V5DPX:0, (tvtjs.IDPK7:6!null + 0.0 (decimal(2,1)))->S3Q3Y:0, tvtjs.ZRV3B:8!null->ZRV3B:0, tvtjs.FHCYT:12->FHCYT:0]\n" +
 			"     │           └─ TableAlias(tvtjs)\n" +
 			"     │               └─ IndexedTableAccess(HU5A5)\n" +
@@ -1751,4 +1751,31 @@ from three_pk outer_table join lateral (
 			},
 		},
 	},
+	{
+		Name: "lateral joins with USING and NATURAL",
+		SetUpScript: []string{
+			"create table t (i int primary key, n int)",
+			"insert into t values (1, 10), (2, 20), (3, 30)",
+			"create table docs (id int primary key, j json)",
+			`insert into docs values (1, '[{"id": 1, "v": "a"}, {"id": 2, "v": "b"}]'), (2, '[{"id": 2, "v": "c"}]'), (3, '[]')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select i, k from t join lateral (select i2.i, t.n + 1 as k from t i2 where i2.i <= t.i) s using (i) order by i",
+				Expected: []sql.Row{{1, 11}, {2, 21}, {3, 31}},
+			},
+			{
+				Query:    "select t.i, s.k from t left join lateral (select i2.i, t.n as k from t i2 where i2.i = t.i and t.n > 10) s using (i) order by t.i",
+				Expected: []sql.Row{{1, nil}, {2, 20}, {3, 30}},
+			},
+			{
+				Query:    "select id, v from docs natural join json_table(docs.j, '$[*]' columns (id int path '$.id', v varchar(10) path '$.v')) as jt order by id",
+				Expected: []sql.Row{{1, "a"}, {2, "c"}},
+			},
+			{
+				Query:    "select docs.id, jt.v from docs left join json_table(docs.j, '$[*]' columns (id int path '$.id', v varchar(10) path '$.v')) as jt using (id) order by docs.id",
+				Expected: []sql.Row{{1, "a"}, {2, "c"}, {3, nil}},
+			},
+		},
+	},
 }
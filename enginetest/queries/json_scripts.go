@@ -980,6 +980,171 @@ var JsonScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "json path wildcards, ranges and the ** ellipsis",
+		SetUpScript: []string{
+			`create table t (pk int primary key, j json);`,
+			`insert into t values (1, '{"a": [1, 2, 3, 4], "b": {"k": "x", "c": {"k": "y"}}, "d e": "x"}');`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `select json_extract(j, '$.a[last]'), json_extract(j, '$.a[last-1]'), json_extract(j, '$.a[1 to 2]') from t`,
+				Expected: []sql.Row{{types.MustJSON("4"), types.MustJSON("3"), types.MustJSON("[2, 3]")}},
+			},
+			{
+				Query:    `select json_extract(j, '$**.k'), json_extract(j, '$."d e"'), json_extract(j, '$.b.*') from t`,
+				Expected: []sql.Row{{types.MustJSON(`["x", "y"]`), types.MustJSON(`"x"`), types.MustJSON(`[{"k": "y"}, "x"]`)}},
+			},
+			{
+				Query:    `select json_extract(j, '$.a[0]', '$.a[9]', '$.a[2 to 3]') from t`,
+				Expected: []sql.Row{{types.MustJSON("[1, 3, 4]")}},
+			},
+			{
+				Query:    `select json_extract(j, '$.a[9]', '$.x') from t`,
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    `select json_search(j, 'all', 'x'), json_search(j, 'all', 'y', null, '$**.c'), json_search(j, 'one', 'x') from t`,
+				Expected: []sql.Row{{types.MustJSON(`["$.b.k", "$.\"d e\""]`), types.MustJSON(`"$.b.c.k"`), types.MustJSON(`"$.b.k"`)}},
+			},
+			{
+				Query:    `select json_contains_path(j, 'one', '$**.c'), json_contains_path(j, 'all', '$.a[last]', '$.b.*') from t`,
+				Expected: []sql.Row{{true, true}},
+			},
+			{
+				Query:          `select json_length(j, '$.a[*]') from t`,
+				ExpectedErrStr: "In this situation, path expressions may not contain the * and ** tokens or an array range.",
+			},
+			{
+				Query:          `select json_keys(j, '$**.b') from t`,
+				ExpectedErrStr: "In this situation, path expressions may not contain the * and ** tokens or an array range.",
+			},
+			{
+				Query:          `select json_extract(j, '$.[0]') from t`,
+				ExpectedErrStr: "failed to extract from expression 't.j'; Invalid JSON path expression. Expected field name after '.' at character 2 of $.[0]",
+			},
+			{
+				Query:          `select json_extract(j, '$**') from t`,
+				ExpectedErrStr: "failed to extract from expression 't.j'; Invalid JSON path expression. Expected '.' or '[' after '**' at character 3 of $**",
+			},
+		},
+	},
+	{
+		Name: "MEMBER OF",
+		SetUpScript: []string{
+			`create table t (pk int primary key, j json);`,
+			`insert into t values (1, '[1, "ab", [3, 4], {"a": 5}]'), (2, '["b"]'), (3, '2'), (4, null);`,
+			`create table m (member int primary key);`,
+			`insert into m values (1), (2);`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `select 17 member of ('[23, "abc", 17, "ab", 10]'), 'ab' member of ('[23, "abc", 17, "ab", 10]')`,
+				Expected: []sql.Row{{true, true}},
+			},
+			{
+				Query:    `select '[3, 4]' member of ('[[3, 4]]'), cast('[3, 4]' as json) member of ('[[3, 4]]'), 2 member of ('2')`,
+				Expected: []sql.Row{{false, true, true}},
+			},
+			{
+				Query:    `select pk from t where 'ab' member of (j) or 2 MEMBER OF (j) order by pk`,
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    `select pk, cast('{"a": 5}' as json) member of (j) from t order by pk`,
+				Expected: []sql.Row{{1, true}, {2, false}, {3, false}, {4, nil}},
+			},
+			{
+				Query:    `select not 1 member of ('[2]'), null member of ('[null]')`,
+				Expected: []sql.Row{{true, nil}},
+			},
+			{
+				Query:    `select 3 member of('[1,2]') = 0, 1 + 1 member of ('[2]'), 0 = 3 member of ('[3]')`,
+				Expected: []sql.Row{{true, true, false}},
+			},
+			{
+				Query:    `select member from m where member member of ('[1]')`,
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "select 1 = `member of`('[1]')",
+				ExpectedErr: sql.ErrFunctionNotFound,
+			},
+			{
+				Query:    `create view v as select pk, 1 member of (j) as m from t`,
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    `select pk from v where m order by pk`,
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "partial updates of JSON documents",
+		SetUpScript: []string{
+			`create table t (pk int primary key, j json, k json);`,
+			`insert into t values (1, '{"a": 1, "b": {"c": [1, 2, 3]}, "d": "x"}', '[1, 2]'), (2, '[1, {"a": 2}]', null), (3, '5', '{}');`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: `update t set j = json_set(j, '$.a', 10, '$.b.c[1]', 20, '$.b.c[5]', 30, '$.e', 'new', '$.b.c[last]', 40)`,
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 3, Updated: 1}}},
+				},
+			},
+			{
+				Query: `select pk, j, k from t order by pk`,
+				Expected: []sql.Row{
+					{1, types.MustJSON(`{"a": 10, "b": {"c": [1, 20, 3, 40]}, "d": "x", "e": "new"}`), types.MustJSON(`[1, 2]`)},
+					{2, types.MustJSON(`[1, {"a": 2}]`), nil},
+					{3, types.MustJSON(`5`), types.MustJSON(`{}`)},
+				},
+			},
+			{
+				Query: `update t set j = json_remove(j, '$.d', '$.b.c[0]', '$[0]'), k = json_replace(k, '$[0]', 'r', '$.z', 1)`,
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 3, Info: plan.UpdateInfo{Matched: 3, Updated: 3}}},
+				},
+			},
+			{
+				Query: `select pk, j, k from t order by pk`,
+				Expected: []sql.Row{
+					{1, types.MustJSON(`{"a": 10, "b": {"c": [20, 3, 40]}, "e": "new"}`), types.MustJSON(`["r", 2]`)},
+					{2, types.MustJSON(`[{"a": 2}]`), nil},
+					{3, types.MustJSON(`5`), types.MustJSON(`"r"`)},
+				},
+			},
+			{
+				Query: `update t set j = json_set(j, '$.b', json_object('x', 1), '$.b.y', 2), k = json_set(json_set(k, '$[0]', 's'), '$[1]', 3) where pk = 1`,
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}},
+				},
+			},
+			{
+				Query: `select pk, j, k from t where pk = 1`,
+				Expected: []sql.Row{
+					{1, types.MustJSON(`{"a": 10, "b": {"x": 1, "y": 2}, "e": "new"}`), types.MustJSON(`["s", 3]`)},
+				},
+			},
+			{
+				Query: `update t set j = json_set(j, '$.a[1]', 1), k = json_set(j, '$.f', 2) where pk = 1`,
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}},
+				},
+			},
+			{
+				Query: `select pk, j, k from t where pk = 1`,
+				Expected: []sql.Row{
+					{1, types.MustJSON(`{"a": [10, 1], "b": {"x": 1, "y": 2}, "e": "new"}`), types.MustJSON(`{"a": [10, 1], "b": {"x": 1, "y": 2}, "e": "new", "f": 2}`)},
+				},
+			},
+			{
+				Query:          `update t set j = json_remove(j, '$.a', '$')`,
+				ExpectedErrStr: "The path expression '$' is not allowed in this context.",
+			},
+		},
+	},
 	{
 		Name: "json_contains_path returns true if the path exists",
 		SetUpScript: []string{
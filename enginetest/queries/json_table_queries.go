@@ -614,6 +614,12 @@ var JSONTableScriptTests = []ScriptTest{
 				Query:          "SELECT * FROM JSON_TABLE('{\"c1\":\"abc\"}', '$' COLUMNS(c1 INT PATH '$.c1' ERROR ON ERROR)) as jt;",
 				ExpectedErrStr: "Invalid JSON text in argument 1 to function JSON_TABLE: \"Invalid value.\"",
 			},
+			{
+				Query: "SELECT * FROM JSON_TABLE('[ {\"c1\": null} ]', '$[*]' COLUMNS( c1 INT PATH '$.c1' ERROR ON ERROR )) as jt;",
+				Expected: []sql.Row{
+					{nil},
+				},
+			},
 		},
 	},
 	{
@@ -652,10 +658,9 @@ var JSONTableScriptTests = []ScriptTest{
 					{2, 222, 222},
 				},
 			},
-			// TODO: the path '$.b' and `$.b[*]` are equivalent, but somehow MySQL can differentiate this from the above test
+			// a path without a wildcard matches an array as a whole
 			{
 				Query: "SELECT * FROM  JSON_TABLE('[{\"a\": 1, \"b\": [11,111]}, {\"a\": 2, \"b\": [22,222]}]', '$[*]' COLUMNS( a INT PATH '$.a', NESTED PATH '$.b' COLUMNS (b1 INT PATH '$[0]', b2 INT PATH '$[1]'))) AS jt;",
-				Skip:  true,
 				Expected: []sql.Row{
 					{1, 11, 111},
 					{2, 22, 222},
@@ -785,6 +790,72 @@ JSON_TABLE(
 			},
 		},
 	},
+	{
+		Name:        "test NESTED PATH without matches",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM JSON_TABLE('[ {\"a\": 1, \"b\": [11,111]}, {\"a\": 2, \"b\": []}, {\"a\":3}]', '$[*]' COLUMNS(a INT PATH '$.a', NESTED PATH '$.b[*]' COLUMNS (b INT PATH '$'))) AS jt;",
+				Expected: []sql.Row{
+					{1, 11},
+					{1, 111},
+					{2, nil},
+					{3, nil},
+				},
+			},
+			{
+				Query: "SELECT * FROM JSON_TABLE('[ {\"a\": 1, \"b\": [11,111]}, {\"a\": 2, \"b\": [22,222]}, {\"a\":3}]', '$[*]' COLUMNS(a INT PATH '$.a', NESTED PATH '$.b[*]' COLUMNS (b INT PATH '$'))) AS jt WHERE b IS NOT NULL;",
+				Expected: []sql.Row{
+					{1, 11},
+					{1, 111},
+					{2, 22},
+					{2, 222},
+				},
+			},
+			{
+				Query: "SELECT * FROM JSON_TABLE('[ {\"a\": 1, \"b\": [11]}, {\"a\": 2, \"c\": [22, 222]}]', '$[*]' COLUMNS(a INT PATH '$.a', NESTED PATH '$.b[*]' COLUMNS (b INT PATH '$'), NESTED PATH '$.c[*]' COLUMNS (c INT PATH '$'))) AS jt;",
+				Expected: []sql.Row{
+					{1, 11, nil},
+					{2, nil, 22},
+					{2, nil, 222},
+				},
+			},
+		},
+	},
+	{
+		Name:        "test FOR ORDINALITY and EXISTS in NESTED PATH",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM JSON_TABLE('[{\"id\": 1, \"tags\": [{\"n\": \"x\"}, {\"n\": \"y\", \"hot\": true}]}, {\"id\": 2, \"tags\": [{\"n\": \"z\"}]}]', '$[*]' COLUMNS(row_num FOR ORDINALITY, id INT PATH '$.id', NESTED PATH '$.tags[*]' COLUMNS (tag_num FOR ORDINALITY, n VARCHAR(10) PATH '$.n', hot INT EXISTS PATH '$.hot'))) AS jt;",
+				Expected: []sql.Row{
+					{1, 1, 1, "x", 0},
+					{1, 1, 2, "y", 1},
+					{2, 2, 1, "z", 0},
+				},
+			},
+		},
+	},
+	{
+		Name:        "test root path without wildcard",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM JSON_TABLE('[1, 2, 3]', '$' COLUMNS(f INT PATH '$[0]', doc JSON PATH '$')) AS jt;",
+				Expected: []sql.Row{
+					{1, types.MustJSON("[1, 2, 3]")},
+				},
+			},
+			{
+				Query: "SELECT * FROM JSON_TABLE('{\"a\": [1, 2, 3]}', '$.a[*]' COLUMNS(x INT PATH '$')) AS jt;",
+				Expected: []sql.Row{
+					{1},
+					{2},
+					{3},
+				},
+			},
+		},
+	},
 }
 
 var BrokenJSONTableScriptTests = []ScriptTest{
@@ -811,12 +882,6 @@ var BrokenJSONTableScriptTests = []ScriptTest{
 		Name:        "json_table out of cte",
 		SetUpScript: []string{},
 		Assertions: []ScriptTestAssertion{
-			{
-				Query: "SELECT * FROM JSON_TABLE('[ {\"c1\": null} ]', '$[*]' COLUMNS( c1 INT PATH '$.c1' ERROR ON ERROR )) as jt;",
-				Expected: []sql.Row{
-					{nil},
-				},
-			},
 			{
 				Query: "SELECT * FROM JSON_TABLE('[{\"a\":\"3\"},{\"a\":2},{\"b\":1},{\"a\":0},{\"a\":[1,2]}]', \"$[*]\" COLUMNS(rowid FOR ORDINALITY, ac VARCHAR(100) PATH \"$.a\" DEFAULT '111' ON EMPTY DEFAULT '999' ON ERROR, aj JSON PATH \"$.a\" DEFAULT '{\"x\": 333}' ON EMPTY, bx INT EXISTS PATH \"$.b\")) AS tt;",
 				Expected: []sql.Row{
@@ -827,15 +892,6 @@ var BrokenJSONTableScriptTests = []ScriptTest{
 					{5, 999, types.MustJSON("[1, 2]"), 0},
 				},
 			},
-			{
-				Query: "SELECT * FROM JSON_TABLE('[ {\"a\": 1, \"b\": [11,111]}, {\"a\": 2, \"b\": [22,222]}, {\"a\":3}]', '$[*]' COLUMNS(a INT PATH '$.a', NESTED PATH '$.b[*]' COLUMNS (b INT PATH '$'))) AS jt WHERE b IS NOT NULL;",
-				Expected: []sql.Row{
-					{1, 11},
-					{1, 111},
-					{2, 22},
-					{2, 222},
-				},
-			},
 		},
 	},
 }
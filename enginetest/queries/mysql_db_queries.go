@@ -38,6 +38,31 @@ var MySqlDbTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "test mysql database time_zone tables",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show create table mysql.time_zone;",
+				Expected: []sql.Row{{"time_zone", "CREATE TABLE `time_zone` (\n  `Time_zone_id` int unsigned NOT NULL AUTO_INCREMENT,\n  `Use_leap_seconds` enum('Y','N') COLLATE utf8mb3_general_ci NOT NULL,\n  PRIMARY KEY (`Time_zone_id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_bin"}},
+			},
+			{
+				Query:    "show create table mysql.time_zone_name;",
+				Expected: []sql.Row{{"time_zone_name", "CREATE TABLE `time_zone_name` (\n  `Name` char(64) COLLATE utf8mb3_general_ci NOT NULL,\n  `Time_zone_id` int unsigned NOT NULL,\n  PRIMARY KEY (`Name`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_bin"}},
+			},
+			{
+				Query:    "show create table mysql.time_zone_transition;",
+				Expected: []sql.Row{{"time_zone_transition", "CREATE TABLE `time_zone_transition` (\n  `Time_zone_id` int unsigned NOT NULL,\n  `Transition_time` bigint NOT NULL,\n  `Transition_type_id` int unsigned NOT NULL,\n  PRIMARY KEY (`Time_zone_id`,`Transition_time`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_bin"}},
+			},
+			{
+				Query:    "show create table mysql.time_zone_transition_type;",
+				Expected: []sql.Row{{"time_zone_transition_type", "CREATE TABLE `time_zone_transition_type` (\n  `Time_zone_id` int unsigned NOT NULL,\n  `Transition_type_id` int unsigned NOT NULL,\n  `Offset` int NOT NULL,\n  `Is_DST` tinyint unsigned NOT NULL,\n  `Abbreviation` char(8) COLLATE utf8mb3_general_ci NOT NULL,\n  PRIMARY KEY (`Time_zone_id`,`Transition_type_id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_bin"}},
+			},
+			{
+				Query:    "show create table mysql.time_zone_leap_second;",
+				Expected: []sql.Row{{"time_zone_leap_second", "CREATE TABLE `time_zone_leap_second` (\n  `Transition_time` bigint NOT NULL,\n  `Correction` int NOT NULL,\n  PRIMARY KEY (`Transition_time`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_bin"}},
+			},
+		},
+	},
 	{
 		Name: "test mysql database",
 		Assertions: []ScriptTestAssertion{
@@ -0,0 +1,502 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var PartitionScripts = []ScriptTest{
+	{
+		Name: "range partitioning",
+		SetUpScript: []string{
+			"create table t (a int primary key, b varchar(10)) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than maxvalue)",
+			"insert into t values (1, 'one'), (15, 'fifteen'), (100, 'hundred'), (-5, 'negative')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `b` varchar(10),\n" +
+					"  PRIMARY KEY (`a`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin\n" +
+					"/*!50100 PARTITION BY RANGE (`a`)\n" +
+					"(PARTITION p0 VALUES LESS THAN (10) ENGINE = InnoDB,\n" +
+					" PARTITION p1 VALUES LESS THAN (20) ENGINE = InnoDB,\n" +
+					" PARTITION p2 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */"}},
+			},
+			{
+				Query:    "select * from t partition (p0) order by a",
+				Expected: []sql.Row{{-5, "negative"}, {1, "one"}},
+			},
+			{
+				Query:    "select * from t partition (P1, p2) order by a",
+				Expected: []sql.Row{{15, "fifteen"}, {100, "hundred"}},
+			},
+			{
+				Query:    "select a from t partition (p2) x where x.a > 10",
+				Expected: []sql.Row{{100}},
+			},
+			{
+				Query:    "select count(*) from t partition (p0)",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select a from t where a between 12 and 200 order by a",
+				Expected: []sql.Row{{15}, {100}},
+			},
+			{
+				Query:    "select a from t where a = 15",
+				Expected: []sql.Row{{15}},
+			},
+			{
+				Query:       "select * from t partition (p9)",
+				ExpectedErr: sql.ErrUnknownPartition,
+			},
+			{
+				Query:    "update t partition (p0) set b = 'small'",
+				Expected: []sql.Row{{NewUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "delete from t partition (p2) where a > 0",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by a",
+				Expected: []sql.Row{{-5, "small"}, {1, "small"}, {15, "fifteen"}},
+			},
+			{
+				// rows whose partition column changes move to their new partition
+				Query:    "update t set a = 5 where a = 15",
+				Expected: []sql.Row{{NewUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select a from t partition (p0) order by a",
+				Expected: []sql.Row{{-5}, {1}, {5}},
+			},
+			{
+				Query:    "select partition_name, partition_ordinal_position, partition_method, partition_expression, partition_description, table_rows from information_schema.partitions where table_name = 't'",
+				Expected: []sql.Row{{"p0", uint32(1), "RANGE", "`a`", "10", uint64(3)}, {"p1", uint32(2), "RANGE", "`a`", "20", uint64(0)}, {"p2", uint32(3), "RANGE", "`a`", "MAXVALUE", uint64(0)}},
+			},
+			{
+				Query:    "select create_options from information_schema.tables where table_name = 't'",
+				Expected: []sql.Row{{"partitioned"}},
+			},
+		},
+	},
+	{
+		Name: "range partitioning without MAXVALUE",
+		SetUpScript: []string{
+			"create table t (a int, b int) partition by range (a) (partition p0 values less than (0), partition p1 values less than (10))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t values (-1, 1), (9, 2), (null, 3)",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:       "insert into t values (10, 4)",
+				ExpectedErr: sql.ErrNoPartitionForValue,
+			},
+			{
+				Query:       "update t set a = 20 where b = 2",
+				ExpectedErr: sql.ErrNoPartitionForValue,
+			},
+			{
+				// NULL values are in the first partition of a RANGE partitioned table
+				Query:    "select a, b from t partition (p0) order by b",
+				Expected: []sql.Row{{-1, 1}, {nil, 3}},
+			},
+			{
+				Query:    "alter table t add partition (partition p2 values less than (100))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t values (10, 4)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select a, b from t partition (p2)",
+				Expected: []sql.Row{{10, 4}},
+			},
+			{
+				Query:       "alter table t add partition (partition p3 values less than (50))",
+				ExpectedErr: sql.ErrPartitionRangeNotIncreasing,
+			},
+			{
+				Query:       "alter table t add partition (partition p1 values less than (200))",
+				ExpectedErr: sql.ErrDuplicatePartitionName,
+			},
+			{
+				Query:    "alter table t drop partition p0, p2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select a, b from t order by b",
+				Expected: []sql.Row{{9, 2}},
+			},
+			{
+				Query:       "alter table t drop partition p1",
+				ExpectedErr: sql.ErrDropLastPartition,
+			},
+			{
+				Query:       "alter table t drop partition p5",
+				ExpectedErr: sql.ErrPartitionNonExistent,
+			},
+		},
+	},
+	{
+		Name: "list partitioning",
+		SetUpScript: []string{
+			"create table t (id int primary key, region int) partition by list (id) (partition odd values in (1, 3, 5), partition even values in (2, 4, 6, null))",
+			"insert into t values (1, 10), (2, 20), (3, 30), (6, 60)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select id from t partition (odd) order by id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select id from t partition (even) order by id",
+				Expected: []sql.Row{{2}, {6}},
+			},
+			{
+				Query:       "insert into t values (7, 70)",
+				ExpectedErr: sql.ErrNoPartitionForValue,
+			},
+			{
+				Query:    "select partition_name, partition_method, partition_description from information_schema.partitions where table_name = 't'",
+				Expected: []sql.Row{{"odd", "LIST", "1,3,5"}, {"even", "LIST", "2,4,6,NULL"}},
+			},
+			{
+				Query:    "alter table t truncate partition odd",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select id from t order by id",
+				Expected: []sql.Row{{2}, {6}},
+			},
+			{
+				Query:    "alter table t truncate partition all",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select count(*) from t",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "create table t2 (a int) partition by list (a) (partition p0 values in (1, 2), partition p1 values in (2, 3))",
+				ExpectedErr: sql.ErrPartitionListDuplicateValue,
+			},
+			{
+				Query:       "create table t2 (a int) partition by list (a) (partition p0 values less than (1))",
+				ExpectedErr: sql.ErrPartitionWrongValues,
+			},
+		},
+	},
+	{
+		Name: "range columns and list columns partitioning",
+		SetUpScript: []string{
+			"create table t (name varchar(20), d date) partition by range columns (name) (partition am values less than ('n'), partition nz values less than (maxvalue))",
+			"create table t2 (name varchar(20)) partition by list columns (name) (partition a values in ('x', 'y'), partition b values in ('z'))",
+			"insert into t values ('alice', '2020-01-01'), ('zed', '2021-01-01'), ('Nora', '2022-01-01')",
+			"insert into t2 values ('x'), ('z')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select name from t partition (am) order by name",
+				Expected: []sql.Row{{"Nora"}, {"alice"}},
+			},
+			{
+				Query:    "select name from t partition (nz) order by name",
+				Expected: []sql.Row{{"zed"}},
+			},
+			{
+				Query:    "select name from t2 partition (b)",
+				Expected: []sql.Row{{"z"}},
+			},
+			{
+				Query: "show create table t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n" +
+					"  `name` varchar(20)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin\n" +
+					"/*!50500 PARTITION BY LIST  COLUMNS(name)\n" +
+					"(PARTITION a VALUES IN ('x','y') ENGINE = InnoDB,\n" +
+					" PARTITION b VALUES IN ('z') ENGINE = InnoDB) */"}},
+			},
+			{
+				Query:    "select partition_name, partition_method, partition_description from information_schema.partitions where table_name = 't'",
+				Expected: []sql.Row{{"am", "RANGE COLUMNS", "'n'"}, {"nz", "RANGE COLUMNS", "MAXVALUE"}},
+			},
+			{
+				Query:       "create table t3 (name varchar(20)) partition by range (name) (partition p0 values less than (10))",
+				ExpectedErr: sql.ErrPartitionFieldType,
+			},
+		},
+	},
+	{
+		Name: "hash and key partitioning",
+		SetUpScript: []string{
+			"create table h (a int primary key, b int) partition by hash (a) partitions 4",
+			"create table lh (a int primary key) partition by linear hash (a) partitions 3",
+			"create table k (a varchar(10) primary key) partition by key () partitions 2",
+			"insert into h values (0, 0), (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (-6, 6)",
+			"insert into lh values (0), (1), (2), (3), (4), (5), (6), (7)",
+			"insert into k values ('a'), ('b'), ('c'), ('d')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a from h partition (p0) order by a",
+				Expected: []sql.Row{{0}, {4}},
+			},
+			{
+				Query:    "select a from h partition (p2) order by a",
+				Expected: []sql.Row{{-6}, {2}},
+			},
+			{
+				// LINEAR HASH with 3 partitions uses the mask 3, then 1 for values beyond the last partition
+				Query:    "select a from lh partition (p1) order by a",
+				Expected: []sql.Row{{1}, {3}, {5}, {7}},
+			},
+			{
+				Query:    "select a from lh partition (p2) order by a",
+				Expected: []sql.Row{{2}, {6}},
+			},
+			{
+				Query:    "select count(*) from k partition (p0, p1)",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query: "show create table h",
+				Expected: []sql.Row{{"h", "CREATE TABLE `h` (\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `b` int,\n" +
+					"  PRIMARY KEY (`a`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin\n" +
+					"/*!50100 PARTITION BY HASH (`a`)\n" +
+					"PARTITIONS 4 */"}},
+			},
+			{
+				Query:    "select partition_name, partition_method, partition_description from information_schema.partitions where table_name = 'lh'",
+				Expected: []sql.Row{{"p0", "LINEAR HASH", nil}, {"p1", "LINEAR HASH", nil}, {"p2", "LINEAR HASH", nil}},
+			},
+			{
+				Query:    "alter table h coalesce partition 2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select a from h partition (p1) order by a",
+				Expected: []sql.Row{{1}, {3}, {5}},
+			},
+			{
+				Query:    "select count(*) from h",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "alter table h add partition partitions 1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select a from h partition (p0) order by a",
+				Expected: []sql.Row{{-6}, {0}, {3}},
+			},
+			{
+				Query:       "alter table h drop partition p0",
+				ExpectedErr: sql.ErrPartitionOnlyOnRangeList,
+			},
+			{
+				Query:       "alter table h coalesce partition 3",
+				ExpectedErr: sql.ErrDropLastPartition,
+			},
+			{
+				Query:       "alter table h add partition (partition p3 values less than (10))",
+				ExpectedErr: sql.ErrPartitionWrongValues,
+			},
+		},
+	},
+	{
+		Name: "partitioning and unique keys",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create table t (a int primary key, b int) partition by hash (b) partitions 2",
+				ExpectedErr: sql.ErrUniqueKeyNeedsPartitionColumns,
+			},
+			{
+				Query:       "create table t (a int, b int, unique key (a)) partition by hash (b) partitions 2",
+				ExpectedErr: sql.ErrUniqueKeyNeedsPartitionColumns,
+			},
+			{
+				Query:    "create table t (a int, b int, primary key (a, b)) partition by hash (b) partitions 2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "create table t2 (a int) partition by hash (c) partitions 2",
+				ExpectedErr: sql.ErrPartitionFieldNotFound,
+			},
+			{
+				Query:       "create table t2 (a int) partition by range (a) (partition p0 values less than maxvalue, partition p1 values less than (1))",
+				ExpectedErr: sql.ErrPartitionMaxValue,
+			},
+			{
+				Query:       "create table t2 (a int) partition by range (a) (partition p0 values less than (5), partition p1 values less than (1))",
+				ExpectedErr: sql.ErrPartitionRangeNotIncreasing,
+			},
+			{
+				Query:       "create table t2 (a int) partition by range (a)",
+				ExpectedErr: sql.ErrPartitionsMustBeDefined,
+			},
+		},
+	},
+	{
+		Name: "partitioning existing tables",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int, key (b))",
+			"insert into t values (1, 10), (2, 20), (3, 30), (4, 40)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "select * from t partition (p0)",
+				ExpectedErr: sql.ErrPartitionClauseOnNonPartitioned,
+			},
+			{
+				Query:       "alter table t add partition (partition p1 values less than (10))",
+				ExpectedErr: sql.ErrPartitionMgmtOnNonPartitioned,
+			},
+			{
+				Query:    "alter table t partition by range (a) (partition p0 values less than (3), partition p1 values less than (10))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select a, b from t partition (p1) order by a",
+				Expected: []sql.Row{{3, 30}, {4, 40}},
+			},
+			{
+				// secondary indexes locate the moved rows
+				Query:    "select a from t partition (p1) where b = 30",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select a from t where b >= 20 order by b",
+				Expected: []sql.Row{{2}, {3}, {4}},
+			},
+			{
+				Query:       "alter table t drop column a",
+				ExpectedErr: sql.ErrPartitionFieldNotFound,
+			},
+			{
+				Query:    "alter table t remove partitioning",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select count(*) from information_schema.partitions where table_name = 't'",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select a, b from t where b = 40",
+				Expected: []sql.Row{{4, 40}},
+			},
+			{
+				Query:       "alter table t truncate partition p0",
+				ExpectedErr: sql.ErrPartitionMgmtOnNonPartitioned,
+			},
+		},
+	},
+	{
+		Name: "renaming and changing the partition column",
+		SetUpScript: []string{
+			"create table t (a int, b int) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20))",
+			"insert into t values (1, 1), (11, 2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t rename column a to c",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select partition_expression from information_schema.partitions where table_name = 't' and partition_name = 'p0'",
+				Expected: []sql.Row{{"`c`"}},
+			},
+			{
+				Query:    "insert into t values (12, 3)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select c from t partition (p1) order by c",
+				Expected: []sql.Row{{11}, {12}},
+			},
+			{
+				Query:    "alter table t modify column c bigint first",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t change column c d bigint after b",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select b, d from t partition (p0)",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:       "alter table t drop column d",
+				ExpectedErr: sql.ErrPartitionFieldNotFound,
+			},
+		},
+	},
+	{
+		Name: "partition clauses with options and comments",
+		SetUpScript: []string{
+			"create /* partitioned */ table t (a int primary key) partition by range (a) (partition p0 values less than (10) engine = InnoDB comment 'low', partition /* last */ p1 values less than (20) storage engine InnoDB)",
+			"create table h (a int primary key) partition by hash (a) (partition one, partition two)",
+			"insert into t values (1), (11)",
+			"insert into h values (1), (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a from t partition (p1)",
+				Expected: []sql.Row{{11}},
+			},
+			{
+				Query:    "alter table t add partition (partition p2 values less than (30), partition p3 values less than maxvalue /* rest */)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select partition_name from information_schema.partitions where table_name = 't' order by partition_ordinal_position",
+				Expected: []sql.Row{{"p0"}, {"p1"}, {"p2"}, {"p3"}},
+			},
+			{
+				Query:    "select a from h partition (two)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "alter table /* t */ t truncate partition p0, p1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select count(*) from t",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "create table s (a int) partition by range (a) subpartition by hash (a) subpartitions 2 (partition p0 values less than (10))",
+				ExpectedErr: sql.ErrUnsupportedFeature,
+			},
+			{
+				Query:       "create table e (a int, b int) partition by hash (a + b) partitions 2",
+				ExpectedErr: sql.ErrUnsupportedFeature,
+			},
+		},
+	},
+}
@@ -437,6 +437,7 @@ var UserPrivTests = []UserPrivilegeTest{
 			"CREATE USER tester@localhost;",
 			"CREATE ROLE test_role;",
 			"GRANT SELECT ON mydb.* TO test_role;",
+			"SET @@GLOBAL.activate_all_roles_on_login = true;",
 		},
 		Assertions: []UserPrivilegeTestAssertion{
 			{
@@ -858,6 +859,21 @@ var UserPrivTests = []UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "User creation and alteration with account limits",
+		SetUpScript: []string{
+			"CREATE USER testuser1@`127.0.0.1` WITH MAX_QUERIES_PER_HOUR 100 MAX_UPDATES_PER_HOUR 10;",
+			"CREATE USER testuser2@`127.0.0.1`;",
+			"ALTER USER testuser2@`127.0.0.1` WITH MAX_UPDATES_PER_HOUR 5;",
+			"ALTER USER testuser1@`127.0.0.1` WITH MAX_QUERIES_PER_HOUR 0;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				Query:    "select user, max_questions, max_updates from mysql.user where user like 'testuser%' order by user;",
+				Expected: []sql.Row{{"testuser1", uint32(0), uint32(10)}, {"testuser2", uint32(0), uint32(5)}},
+			},
+		},
+	},
 	{
 		Name: "Dynamic privilege support",
 		SetUpScript: []string{
@@ -2591,238 +2607,1032 @@ FROM ((SELECT 1 as found FROM information_schema.tables WHERE table_schema = 'te
 			},
 		},
 	},
-}
-
-// NoopPlaintextPlugin is used to authenticate plaintext user plugins
-type NoopPlaintextPlugin struct{}
-
-var _ mysql_db.PlaintextAuthPlugin = &NoopPlaintextPlugin{}
-
-func (p *NoopPlaintextPlugin) Authenticate(db *mysql_db.MySQLDb, user string, userEntry *mysql_db.User, pass string) (bool, error) {
-	return pass == "right-password", nil
-}
-
-// ServerAuthTests test the server authentication system. These tests always have the root account available, and the
-// root account is used with any queries in the SetUpScript, along as being set to the context passed to SetUpFunc.
-var ServerAuthTests = []ServerAuthenticationTest{
 	{
-		Name: "ALTER USER can change passwords",
-		Assertions: []ServerAuthenticationTestAssertion{
-			// Create test users, privileges, etc
+		Name: "Default roles are active at login",
+		SetUpScript: []string{
+			"SET @@GLOBAL.activate_all_roles_on_login = false;",
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.test VALUES (1);",
+			"CREATE ROLE reader, writer;",
+			"GRANT SELECT ON mydb.* TO reader;",
+			"GRANT INSERT ON mydb.* TO writer;",
+			"CREATE USER tester@localhost DEFAULT ROLE reader;",
+			"GRANT reader, writer TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
-				ExpectedErr: false,
-			}, {
-				// Create a user with CREATE USER privileges
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE USER `createUserUser`@`localhost` IDENTIFIED BY '';",
-				ExpectedErr: false,
-			}, {
-				Username:    "root",
-				Password:    "",
-				Query:       "GRANT CREATE USER ON *.* TO `createUserUser`@`localhost`;",
-				ExpectedErr: false,
-			}, {
-				// Create a user with UPDATE privileges on the mysql database
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE USER `updateUser`@`localhost` IDENTIFIED BY '';",
-				ExpectedErr: false,
-			}, {
-				Username:    "root",
-				Password:    "",
-				Query:       "GRANT UPDATE ON mysql.* TO `updateUser`@`localhost`;",
-				ExpectedErr: false,
-			}, {
-				// Create a regular user named user1 with SELECT privileges
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE USER `user1`@`localhost` IDENTIFIED BY '';",
-				ExpectedErr: false,
-			}, {
-				Username:    "root",
-				Password:    "",
-				Query:       "GRANT SELECT ON *.* TO `user1`@`localhost`;",
-				ExpectedErr: false,
-			}, {
-				// Create a regular user named user2 with SELECT privileges
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE USER `user2`@`localhost` IDENTIFIED BY '';",
-				ExpectedErr: false,
-			}, {
-				Username:    "root",
-				Password:    "",
-				Query:       "GRANT SELECT ON *.* TO `user2`@`localhost`;",
-				ExpectedErr: false,
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT CURRENT_ROLE();",
+				Expected: []sql.Row{{"`reader`@`%`"}},
 			},
-
-			// When IF EXISTS is specified, an error isn't returned if the user doesn't exist
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "ALTER USER IF EXISTS nobody@localhost IDENTIFIED BY 'password';",
-				ExpectedErr: false,
-			}, {
-				Username:       "root",
-				Password:       "",
-				Query:          "ALTER USER nobody@localhost IDENTIFIED BY 'password';",
-				ExpectedErr:    true,
-				ExpectedErrStr: "Error 1105 (HY000): Operation ALTER USER failed for 'nobody'@'localhost'",
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.test;",
+				Expected: []sql.Row{{1}},
 			},
-
-			// RANDOM PASSWORD is not supported yet, so an error should be returned
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "ALTER USER user2@localhost IDENTIFIED BY RANDOM PASSWORD;",
-				ExpectedErr: true,
-				ExpectedErrStr: "Error 1105 (HY000): random password generation is not currently supported; " +
-					"you can request support at https://github.com/dolthub/dolt/issues/new",
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.test VALUES (2);",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
 			},
-
-			// root super user can change other account passwords
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "ALTER USER `user1`@`localhost` IDENTIFIED BY 'password1';",
-				ExpectedErr: false,
-			}, {
-				Username:    "user1",
-				Password:    "",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: true,
-			}, {
-				Username:    "user1",
-				Password:    "password1",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: false,
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SET DEFAULT ROLE ALL TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
-
-			// Accounts with the CREATE USER privilege can change other account passwords
 			{
-				Username:    "createUserUser",
-				Password:    "",
-				Query:       "ALTER USER `user1`@`localhost` IDENTIFIED BY 'password2';",
-				ExpectedErr: false,
-			}, {
-				Username:    "user1",
-				Password:    "",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: true,
-			}, {
-				Username:    "user1",
-				Password:    "password2",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: false,
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT CURRENT_ROLE();",
+				Expected: []sql.Row{{"`reader`@`%`,`writer`@`%`"}},
 			},
-
-			// Accounts with the UPDATE privilege on the mysql db can change other account passwords
 			{
-				Username:    "updateUser",
-				Password:    "",
-				Query:       "ALTER USER `user2`@`localhost` IDENTIFIED BY 'password3';",
-				ExpectedErr: false,
-			}, {
-				Username:    "user2",
-				Password:    "",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: true,
-			}, {
-				Username:    "user2",
-				Password:    "password3",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: false,
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mysql.default_roles ORDER BY DEFAULT_ROLE_USER;",
+				Expected: []sql.Row{{"localhost", "tester", "%", "reader"}, {"localhost", "tester", "%", "writer"}},
 			},
-
-			// Accounts can change their own password
 			{
-				Username:    "user1",
-				Password:    "password2",
-				Query:       "ALTER USER `user1`@`localhost` IDENTIFIED BY 'password4';",
-				ExpectedErr: false,
-			}, {
-				Username:    "user1",
-				Password:    "",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: true,
-			}, {
-				Username:    "user1",
-				Password:    "password4",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: false,
+				User:     "root",
+				Host:     "localhost",
+				Query:    "ALTER USER tester@localhost DEFAULT ROLE NONE;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
-
-			// Accounts CANNOT change another account's password (without the CREATE USER or UPDATE privilege)
 			{
-				Username:    "user1",
-				Password:    "password2",
-				Query:       "ALTER USER `user2`@`localhost` IDENTIFIED BY 'password5';",
-				ExpectedErr: true,
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT CURRENT_ROLE();",
+				Expected: []sql.Row{{"NONE"}},
 			},
-		},
-	},
-	{
-		Name: "DROP USER reports correct string for missing address",
-		Assertions: []ServerAuthenticationTestAssertion{
 			{
-				Username:       "root",
-				Password:       "",
-				Query:          "DROP USER xyz;",
-				ExpectedErrStr: "Error 1105 (HY000): Operation DROP USER failed for 'xyz'@'%'",
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
 			},
-		},
-	},
-	{
-		Name: "CREATE USER with a random password is not supported",
-		Assertions: []ServerAuthenticationTestAssertion{
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE USER foo1@localhost IDENTIFIED BY RANDOM PASSWORD;",
-				ExpectedErr: true,
-				ExpectedErrStr: "Error 1105 (HY000): random password generation is not currently supported; " +
-					"you can request support at https://github.com/dolthub/dolt/issues/new",
+				User:        "root",
+				Host:        "localhost",
+				Query:       "SET DEFAULT ROLE reader, other TO tester@localhost;",
+				ExpectedErr: sql.ErrRoleNotGranted,
 			},
-		},
-	},
-	{
-		Name: "CREATE USER with an empty password",
-		Assertions: []ServerAuthenticationTestAssertion{
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
-				ExpectedErr: false,
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER USER nobody DEFAULT ROLE ALL;",
+				ExpectedErr: sql.ErrUserAlterFailure,
 			},
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "CREATE USER rand_user@localhost IDENTIFIED BY '';",
-				ExpectedErr: false,
+				User:     "root",
+				Host:     "localhost",
+				Query:    "ALTER USER IF EXISTS nobody DEFAULT ROLE ALL;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Username:    "root",
-				Password:    "",
-				Query:       "GRANT ALL ON *.* TO rand_user@localhost;",
-				ExpectedErr: false,
+				User:        "root",
+				Host:        "localhost",
+				Query:       "CREATE USER other DEFAULT ROLE missing;",
+				ExpectedErr: sql.ErrGrantRevokeRoleDoesNotExist,
 			},
 			{
-				Username:    "rand_user",
-				Password:    "",
-				Query:       "SELECT * FROM mydb.test;",
-				ExpectedErr: false,
+				User:     "root",
+				Host:     "localhost",
+				Query:    "DROP ROLE reader;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT COUNT(*) FROM mysql.default_roles;",
+				Expected: []sql.Row{{0}},
 			},
 		},
 	},
 	{
-		Name: "Basic root authentication",
-		Assertions: []ServerAuthenticationTestAssertion{
-			{
-				Username:    "root",
+		Name: "Role graphs",
+		SetUpScript: []string{
+			"SET @@GLOBAL.activate_all_roles_on_login = true;",
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.test VALUES (1);",
+			"CREATE ROLE r1, r2, r3;",
+			"GRANT SELECT ON mydb.* TO r3;",
+			"GRANT r3 TO r2;",
+			"GRANT r2 TO r1 WITH ADMIN OPTION;",
+			"CREATE USER tester@localhost;",
+			"GRANT r1 TO tester@localhost WITH ADMIN OPTION;",
+			"SET DEFAULT ROLE r1 TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.test;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "GRANT r1 TO r3;",
+				ExpectedErr: sql.ErrRoleGrantedToItself,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "GRANT r1 TO r1;",
+				ExpectedErr: sql.ErrRoleGrantedToItself,
+			},
+			{
+				User:  "tester",
+				Host:  "localhost",
+				Query: "SELECT * FROM information_schema.applicable_roles;",
+				Expected: []sql.Row{
+					{"tester", "localhost", "r1", "%", "r2", "%", "YES", "NO", "NO"},
+					{"tester", "localhost", "r2", "%", "r3", "%", "NO", "NO", "NO"},
+					{"tester", "localhost", "tester", "localhost", "r1", "%", "YES", "YES", "NO"},
+				},
+			},
+			{
+				User:  "tester",
+				Host:  "localhost",
+				Query: "SELECT GRANTEE, ROLE_NAME FROM information_schema.administrable_role_authorizations;",
+				Expected: []sql.Row{
+					{"r1", "r2"},
+					{"tester", "r1"},
+				},
+			},
+			{
+				User:  "tester",
+				Host:  "localhost",
+				Query: "SELECT * FROM information_schema.enabled_roles ORDER BY ROLE_NAME;",
+				Expected: []sql.Row{
+					{"r1", "%", "YES", "NO"},
+					{"r2", "%", "NO", "NO"},
+					{"r3", "%", "NO", "NO"},
+				},
+			},
+		},
+	},
+	{
+		Name: "Mandatory roles",
+		SetUpScript: []string{
+			"SET @@GLOBAL.activate_all_roles_on_login = true;",
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.test VALUES (1);",
+			"CREATE ROLE reader;",
+			"GRANT SELECT ON mydb.* TO reader;",
+			"CREATE USER tester@localhost;",
+			"SET @@GLOBAL.mandatory_roles = 'reader,`missing`@`%`';",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT CURRENT_ROLE();",
+				Expected: []sql.Row{{"`reader`@`%`"}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.test;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT ROLE_NAME, IS_MANDATORY FROM information_schema.applicable_roles;",
+				Expected: []sql.Row{{"reader", "YES"}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "DROP ROLE reader;",
+				ExpectedErr: sql.ErrMandatoryRole,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT reader TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "REVOKE reader FROM tester@localhost;",
+				ExpectedErr: sql.ErrMandatoryRole,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.mandatory_roles = '';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "DROP ROLE reader;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "Password expiration and reuse policies",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.secret (v VARCHAR(20));",
+			"INSERT INTO mydb.secret VALUES ('topsecret');",
+			"CREATE USER tester@localhost IDENTIFIED BY 'pass1' PASSWORD HISTORY 2;",
+			"GRANT SELECT ON mydb.secret TO tester@localhost;",
+			"ALTER USER tester@localhost PASSWORD EXPIRE;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT 1;",
+				ExpectedErr: sql.ErrMustChangePassword,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SET @@SESSION.autocommit = 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SET @@SESSION.sql_mode = (SELECT v FROM mydb.secret);",
+				ExpectedErr: sql.ErrMustChangePassword,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SET @v = 1, @w = (SELECT count(*) FROM mydb.secret);",
+				ExpectedErr: sql.ErrMustChangePassword,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SET PASSWORD = 'pass1';",
+				ExpectedErr: sql.ErrPasswordHistory,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SET PASSWORD = 'pass2';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT 1;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SET PASSWORD = 'pass1';",
+				ExpectedErr: sql.ErrPasswordHistory,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "ALTER USER tester@localhost IDENTIFIED BY 'pass3' PASSWORD HISTORY 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET PASSWORD FOR tester@localhost = 'pass1';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "ALTER USER tester@localhost PASSWORD EXPIRE INTERVAL 30 DAY PASSWORD REUSE INTERVAL 10 DAY ACCOUNT LOCK;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT password_expired, password_lifetime, password_reuse_history, password_reuse_time, account_locked FROM mysql.user WHERE User = 'tester';",
+				Expected: []sql.Row{{"N", uint16(30), uint16(1), uint16(10), "Y"}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER USER tester@localhost IDENTIFIED BY 'pass1';",
+				ExpectedErr: sql.ErrPasswordHistory,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "ALTER USER tester@localhost PASSWORD EXPIRE DEFAULT PASSWORD HISTORY DEFAULT PASSWORD REUSE INTERVAL DEFAULT ACCOUNT UNLOCK;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT password_lifetime, password_reuse_history, password_reuse_time, account_locked FROM mysql.user WHERE User = 'tester';",
+				Expected: []sql.Row{{nil, nil, nil, "N"}},
+			},
+		},
+	},
+	{
+		Name: "Password options of CREATE USER and ALTER USER",
+		SetUpScript: []string{
+			"CREATE USER tester@localhost IDENTIFIED BY 'pass1' PASSWORD EXPIRE FAILED_LOGIN_ATTEMPTS 3;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT 1;",
+				ExpectedErr: sql.ErrMustChangePassword,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT password_expired FROM mysql.user WHERE User = 'tester';",
+				Expected: []sql.Row{{"Y"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET /* comment */ PASSWORD FOR tester@localhost = 'pass2';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT 1;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "ALTER USER tester@localhost PASSWORD EXPIRE NEVER PASSWORD_LOCK_TIME UNBOUNDED;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT password_expired, password_lifetime FROM mysql.user WHERE User = 'tester';",
+				Expected: []sql.Row{{"N", uint16(0)}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER USER tester@localhost PASSWORD EXPIRE INTERVAL 5;",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "SET PASSWORD FOR tester@localhost = 5;",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+		},
+	},
+	{
+		Name: "Views and procedures run with the privileges of their definer",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.test VALUES (1), (2);",
+			"CREATE USER tester@localhost;",
+			"CREATE USER owner@localhost;",
+			"GRANT SELECT ON mydb.test TO owner@localhost;",
+			"CREATE DEFINER=`owner`@`localhost` VIEW mydb.definer_view AS SELECT * FROM mydb.test;",
+			"CREATE DEFINER=`owner`@`localhost` SQL SECURITY INVOKER VIEW mydb.invoker_view AS SELECT * FROM mydb.test;",
+			"CREATE DEFINER=`owner`@`localhost` PROCEDURE mydb.definer_proc() SELECT COUNT(*) FROM mydb.test;",
+			"CREATE DEFINER=`owner`@`localhost` PROCEDURE mydb.invoker_proc() SQL SECURITY INVOKER SELECT COUNT(*) FROM mydb.test;",
+			"GRANT SELECT ON mydb.definer_view TO tester@localhost;",
+			"GRANT SELECT ON mydb.invoker_view TO tester@localhost;",
+			"GRANT EXECUTE ON mydb.* TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.definer_view ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.invoker_view;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CALL mydb.definer_proc();",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CALL mydb.invoker_proc();",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "DROP USER owner@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.definer_view;",
+				ExpectedErr: sql.ErrNoSuchDefiner,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CALL mydb.definer_proc();",
+				ExpectedErr: sql.ErrNoSuchDefiner,
+			},
+		},
+	},
+	{
+		Name: "Setting a definer other than the current user requires SET_USER_ID",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"CREATE USER tester@localhost;",
+			"CREATE USER other@localhost;",
+			"GRANT CREATE VIEW, SELECT, CREATE ROUTINE ON mydb.* TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER=`other`@`localhost` VIEW mydb.v1 AS SELECT * FROM mydb.test;",
+				ExpectedErr: sql.ErrSetDefinerDenied,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER=`other`@`localhost` PROCEDURE mydb.p1() SELECT 1;",
+				ExpectedErr: sql.ErrSetDefinerDenied,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER=`tester`@`localhost` VIEW mydb.v1 AS SELECT * FROM mydb.test;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT SET_USER_ID ON *.* TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER=`other`@`localhost` PROCEDURE mydb.p1() SELECT 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "SHOW statements check the privileges of the objects they show",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"CREATE TABLE mydb.test2 (pk BIGINT PRIMARY KEY);",
+			"CREATE TRIGGER trig1 BEFORE INSERT ON mydb.test FOR EACH ROW SET new.pk = new.pk + 1;",
+			"CREATE TRIGGER trig2 BEFORE INSERT ON mydb.test2 FOR EACH ROW SET new.pk = new.pk + 1;",
+			"CREATE VIEW mydb.v1 AS SELECT * FROM mydb.test;",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT ON mydb.* TO tester@localhost;",
+			"GRANT TRIGGER ON mydb.test TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SHOW TRIGGERS FROM mydb LIKE 'trig2';",
+				Expected: []sql.Row{},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SHOW CREATE TRIGGER mydb.trig2;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SHOW CREATE VIEW mydb.v1;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SHOW EVENTS FROM mydb;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT SHOW VIEW, EVENT ON mydb.* TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SHOW CREATE VIEW mydb.v1;",
+				Expected: []sql.Row{{"v1", "CREATE VIEW `v1` AS SELECT * FROM mydb.test", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SHOW EVENTS FROM mydb;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "Partial revokes",
+		SetUpScript: []string{
+			"SET @@GLOBAL.partial_revokes = ON;",
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.test VALUES (1);",
+			"CREATE DATABASE otherdb;",
+			"CREATE TABLE otherdb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO otherdb.test VALUES (2);",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT, INSERT ON *.* TO tester@localhost;",
+			"REVOKE SELECT ON mydb.* FROM tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM otherdb.test;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.test VALUES (3);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT SELECT, INSERT ON *.* TO `tester`@`localhost`"},
+					{"REVOKE SELECT ON `mydb`.* FROM `tester`@`localhost`"},
+				},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT SELECT ON mydb.* TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.test;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{{"GRANT SELECT, INSERT ON *.* TO `tester`@`localhost`"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE ALL ON otherdb.* FROM tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT SELECT, INSERT ON *.* TO `tester`@`localhost`"},
+					{"REVOKE SELECT, INSERT ON `otherdb`.* FROM `tester`@`localhost`"},
+				},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM otherdb.test;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE INSERT ON *.* FROM tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT SELECT ON *.* TO `tester`@`localhost`"},
+					{"REVOKE SELECT ON `otherdb`.* FROM `tester`@`localhost`"},
+				},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.partial_revokes = OFF;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "SHOW GRANTS USING roles",
+		SetUpScript: []string{
+			"CREATE ROLE r1, r2;",
+			"GRANT SELECT ON mydb.* TO r1;",
+			"GRANT INSERT ON *.* TO r2;",
+			"CREATE USER tester@localhost;",
+			"GRANT r1 TO tester@localhost;",
+			"GRANT USAGE ON mydb.* TO tester@localhost WITH GRANT OPTION;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `tester`@`localhost`"},
+					{"GRANT USAGE ON `mydb`.* TO `tester`@`localhost` WITH GRANT OPTION"},
+					{"GRANT `r1`@`%` TO `tester`@`localhost`"},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost USING r1;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `tester`@`localhost`"},
+					{"GRANT SELECT ON `mydb`.* TO `tester`@`localhost` WITH GRANT OPTION"},
+					{"GRANT `r1`@`%` TO `tester`@`localhost`"},
+				},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "SHOW GRANTS FOR tester@localhost USING r1, r2;",
+				ExpectedErr: sql.ErrRoleNotGranted,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT r2 TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost USING r1, r2;",
+				Expected: []sql.Row{
+					{"GRANT INSERT ON *.* TO `tester`@`localhost`"},
+					{"GRANT SELECT ON `mydb`.* TO `tester`@`localhost` WITH GRANT OPTION"},
+					{"GRANT `r1`@`%`, `r2`@`%` TO `tester`@`localhost`"},
+				},
+			},
+		},
+	},
+	{
+		Name: "Proxy grants",
+		SetUpScript: []string{
+			"CREATE USER tester@localhost;",
+			"CREATE USER target@localhost;",
+			"CREATE USER other@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "GRANT PROXY ON target@localhost TO other@localhost;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT PROXY ON target@localhost TO tester@localhost WITH GRANT OPTION;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `tester`@`localhost`"},
+					{"GRANT PROXY ON `target`@`localhost` TO `tester`@`localhost` WITH GRANT OPTION"},
+				},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT Host, User, Proxied_host, Proxied_user, With_grant FROM mysql.proxies_priv;",
+				Expected: []sql.Row{{"localhost", "tester", "localhost", "target", int8(1)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "GRANT PROXY ON target@localhost TO other@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR other@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `other`@`localhost`"},
+					{"GRANT PROXY ON `target`@`localhost` TO `other`@`localhost`"},
+				},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE PROXY ON target@localhost FROM tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{{"GRANT USAGE ON *.* TO `tester`@`localhost`"}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "REVOKE PROXY ON target@localhost FROM tester@localhost;",
+				ExpectedErr: sql.ErrRevokeUserDoesNotExist,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "DROP USER other@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT COUNT(*) FROM mysql.proxies_priv;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+}
+
+// NoopPlaintextPlugin is used to authenticate plaintext user plugins
+type NoopPlaintextPlugin struct{}
+
+var _ mysql_db.PlaintextAuthPlugin = &NoopPlaintextPlugin{}
+
+func (p *NoopPlaintextPlugin) Authenticate(db *mysql_db.MySQLDb, user string, userEntry *mysql_db.User, pass string) (bool, error) {
+	return pass == "right-password", nil
+}
+
+// ServerAuthTests test the server authentication system. These tests always have the root account available, and the
+// root account is used with any queries in the SetUpScript, along as being set to the context passed to SetUpFunc.
+var ServerAuthTests = []ServerAuthenticationTest{
+	{
+		Name: "ALTER USER can change passwords",
+		Assertions: []ServerAuthenticationTestAssertion{
+			// Create test users, privileges, etc
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+				ExpectedErr: false,
+			}, {
+				// Create a user with CREATE USER privileges
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE USER `createUserUser`@`localhost` IDENTIFIED BY '';",
+				ExpectedErr: false,
+			}, {
+				Username:    "root",
+				Password:    "",
+				Query:       "GRANT CREATE USER ON *.* TO `createUserUser`@`localhost`;",
+				ExpectedErr: false,
+			}, {
+				// Create a user with UPDATE privileges on the mysql database
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE USER `updateUser`@`localhost` IDENTIFIED BY '';",
+				ExpectedErr: false,
+			}, {
+				Username:    "root",
+				Password:    "",
+				Query:       "GRANT UPDATE ON mysql.* TO `updateUser`@`localhost`;",
+				ExpectedErr: false,
+			}, {
+				// Create a regular user named user1 with SELECT privileges
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE USER `user1`@`localhost` IDENTIFIED BY '';",
+				ExpectedErr: false,
+			}, {
+				Username:    "root",
+				Password:    "",
+				Query:       "GRANT SELECT ON *.* TO `user1`@`localhost`;",
+				ExpectedErr: false,
+			}, {
+				// Create a regular user named user2 with SELECT privileges
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE USER `user2`@`localhost` IDENTIFIED BY '';",
+				ExpectedErr: false,
+			}, {
+				Username:    "root",
+				Password:    "",
+				Query:       "GRANT SELECT ON *.* TO `user2`@`localhost`;",
+				ExpectedErr: false,
+			},
+
+			// When IF EXISTS is specified, an error isn't returned if the user doesn't exist
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "ALTER USER IF EXISTS nobody@localhost IDENTIFIED BY 'password';",
+				ExpectedErr: false,
+			}, {
+				Username:       "root",
+				Password:       "",
+				Query:          "ALTER USER nobody@localhost IDENTIFIED BY 'password';",
+				ExpectedErr:    true,
+				ExpectedErrStr: "Error 1105 (HY000): Operation ALTER USER failed for 'nobody'@'localhost'",
+			},
+
+			// RANDOM PASSWORD is not supported yet, so an error should be returned
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "ALTER USER user2@localhost IDENTIFIED BY RANDOM PASSWORD;",
+				ExpectedErr: true,
+				ExpectedErrStr: "Error 1105 (HY000): random password generation is not currently supported; " +
+					"you can request support at https://github.com/dolthub/dolt/issues/new",
+			},
+
+			// root super user can change other account passwords
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "ALTER USER `user1`@`localhost` IDENTIFIED BY 'password1';",
+				ExpectedErr: false,
+			}, {
+				Username:    "user1",
+				Password:    "",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: true,
+			}, {
+				Username:    "user1",
+				Password:    "password1",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: false,
+			},
+
+			// Accounts with the CREATE USER privilege can change other account passwords
+			{
+				Username:    "createUserUser",
+				Password:    "",
+				Query:       "ALTER USER `user1`@`localhost` IDENTIFIED BY 'password2';",
+				ExpectedErr: false,
+			}, {
+				Username:    "user1",
+				Password:    "",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: true,
+			}, {
+				Username:    "user1",
+				Password:    "password2",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: false,
+			},
+
+			// Accounts with the UPDATE privilege on the mysql db can change other account passwords
+			{
+				Username:    "updateUser",
+				Password:    "",
+				Query:       "ALTER USER `user2`@`localhost` IDENTIFIED BY 'password3';",
+				ExpectedErr: false,
+			}, {
+				Username:    "user2",
+				Password:    "",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: true,
+			}, {
+				Username:    "user2",
+				Password:    "password3",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: false,
+			},
+
+			// Accounts can change their own password
+			{
+				Username:    "user1",
+				Password:    "password2",
+				Query:       "ALTER USER `user1`@`localhost` IDENTIFIED BY 'password4';",
+				ExpectedErr: false,
+			}, {
+				Username:    "user1",
+				Password:    "",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: true,
+			}, {
+				Username:    "user1",
+				Password:    "password4",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: false,
+			},
+
+			// Accounts CANNOT change another account's password (without the CREATE USER or UPDATE privilege)
+			{
+				Username:    "user1",
+				Password:    "password2",
+				Query:       "ALTER USER `user2`@`localhost` IDENTIFIED BY 'password5';",
+				ExpectedErr: true,
+			},
+		},
+	},
+	{
+		Name: "DROP USER reports correct string for missing address",
+		Assertions: []ServerAuthenticationTestAssertion{
+			{
+				Username:       "root",
+				Password:       "",
+				Query:          "DROP USER xyz;",
+				ExpectedErrStr: "Error 1105 (HY000): Operation DROP USER failed for 'xyz'@'%'",
+			},
+		},
+	},
+	{
+		Name: "CREATE USER with a random password is not supported",
+		Assertions: []ServerAuthenticationTestAssertion{
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE USER foo1@localhost IDENTIFIED BY RANDOM PASSWORD;",
+				ExpectedErr: true,
+				ExpectedErrStr: "Error 1105 (HY000): random password generation is not currently supported; " +
+					"you can request support at https://github.com/dolthub/dolt/issues/new",
+			},
+		},
+	},
+	{
+		Name: "CREATE USER with an empty password",
+		Assertions: []ServerAuthenticationTestAssertion{
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+				ExpectedErr: false,
+			},
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "CREATE USER rand_user@localhost IDENTIFIED BY '';",
+				ExpectedErr: false,
+			},
+			{
+				Username:    "root",
+				Password:    "",
+				Query:       "GRANT ALL ON *.* TO rand_user@localhost;",
+				ExpectedErr: false,
+			},
+			{
+				Username:    "rand_user",
+				Password:    "",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: false,
+			},
+		},
+	},
+	{
+		Name: "Basic root authentication",
+		Assertions: []ServerAuthenticationTestAssertion{
+			{
+				Username:    "root",
 				Password:    "",
 				Query:       "SELECT * FROM mysql.user;",
 				ExpectedErr: false,
@@ -2868,6 +3678,80 @@ var ServerAuthTests = []ServerAuthenticationTest{
 			},
 		},
 	},
+	{
+		Name: "Failed logins temporarily lock accounts",
+		SetUpScript: []string{
+			"CREATE USER locked@localhost IDENTIFIED BY 'right' FAILED_LOGIN_ATTEMPTS 2 PASSWORD_LOCK_TIME 3;",
+			"CREATE USER unbounded@localhost IDENTIFIED BY 'right' FAILED_LOGIN_ATTEMPTS 1 PASSWORD_LOCK_TIME UNBOUNDED;",
+			"CREATE USER altered@localhost IDENTIFIED BY 'right';",
+			"ALTER USER altered@localhost FAILED_LOGIN_ATTEMPTS 1 PASSWORD_LOCK_TIME 2;",
+		},
+		Assertions: []ServerAuthenticationTestAssertion{
+			{
+				Username:       "locked",
+				Password:       "wrong",
+				Query:          "SELECT 1;",
+				ExpectedErrStr: "Error 1045 (28000): Access denied for user 'locked'",
+			},
+			{
+				Username:    "locked",
+				Password:    "right",
+				Query:       "SELECT 1;",
+				ExpectedErr: false,
+			},
+			{
+				Username:       "locked",
+				Password:       "wrong",
+				Query:          "SELECT 1;",
+				ExpectedErrStr: "Error 1045 (28000): Access denied for user 'locked'",
+			},
+			{
+				Username:       "locked",
+				Password:       "wrong",
+				Query:          "SELECT 1;",
+				ExpectedErrStr: "Error 3955 (HY000): Access denied for user 'locked'@'localhost'. Account is blocked for 3 day(s) (3 day(s) remaining) due to 2 consecutive failed logins.",
+			},
+			{
+				Username:       "locked",
+				Password:       "right",
+				Query:          "SELECT 1;",
+				ExpectedErrStr: "Error 3955 (HY000): Access denied for user 'locked'@'localhost'. Account is blocked for 3 day(s) (3 day(s) remaining) due to 2 consecutive failed logins.",
+			},
+			{
+				Username:       "unbounded",
+				Password:       "wrong",
+				Query:          "SELECT 1;",
+				ExpectedErrStr: "Error 3955 (HY000): Access denied for user 'unbounded'@'localhost'. Account is blocked for unlimited day(s) (unlimited day(s) remaining) due to 1 consecutive failed logins.",
+			},
+			{
+				Username: "root",
+				Password: "",
+				Query:    "ALTER USER locked@localhost ACCOUNT UNLOCK;",
+			},
+			{
+				Username: "locked",
+				Password: "right",
+				Query:    "SELECT 1;",
+			},
+			{
+				Username:       "altered",
+				Password:       "wrong",
+				Query:          "SELECT 1;",
+				ExpectedErrStr: "Error 3955 (HY000): Access denied for user 'altered'@'localhost'. Account is blocked for 2 day(s) (2 day(s) remaining) due to 1 consecutive failed logins.",
+			},
+			{
+				Username: "root",
+				Password: "",
+				Query:    "ALTER USER altered@localhost ACCOUNT UNLOCK;",
+			},
+			{
+				// ALTER USER keeps the password when it isn't given a new one
+				Username: "altered",
+				Password: "right",
+				Query:    "SELECT 1;",
+			},
+		},
+	},
 	{
 		Name: "Create User explicitly with mysql_native_password plugin",
 		SetUpScript: []string{
@@ -1033,8 +1033,11 @@ END;`,
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:          "CALL p1(0)",
-				ExpectedErrStr: "warnings not yet implemented",
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "CALL p1(0)",
+				Expected:                      []sql.Row{{types.NewOkResult(0)}},
+				ExpectedWarning:               1642,
+				ExpectedWarningsCount:         1,
 			},
 			{
 				Query:          "CALL p1(1)",
@@ -1280,9 +1283,8 @@ END;`,
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				// TODO: MySQL returns: ERROR: 1329: No data - zero rows fetched, selected, or processed
 				Query:          "CALL eof();",
-				ExpectedErrStr: "exhausted fetch iterator",
+				ExpectedErrStr: "No data - zero rows fetched, selected, or processed (errno 1329) (sqlstate 02000)",
 			},
 			{
 				Query:    "CALL duplicate_key();",
@@ -1590,6 +1592,74 @@ END;`,
 			},
 		},
 	},
+	{
+		Name: "ITERATE in REPEAT, LEAVE from a block, and FETCH into parameters",
+		SetUpScript: []string{
+			`CREATE TABLE t1 (pk BIGINT PRIMARY KEY);`,
+			`INSERT INTO t1 VALUES (1), (2), (3), (4), (5), (6), (7), (8), (9)`,
+			`CREATE PROCEDURE p1()
+BEGIN
+	DECLARE i INT DEFAULT 0;
+	DECLARE res VARCHAR(100) DEFAULT '';
+	tloop: REPEAT
+		SET i = i + 1;
+		IF i < 3 THEN
+			ITERATE tloop;
+		END IF;
+		SET res = CONCAT(res, i, ';');
+	UNTIL i >= 1
+	END REPEAT;
+	SELECT res;
+END;`,
+			`CREATE PROCEDURE p2()
+BEGIN
+	DECLARE a INT DEFAULT 1;
+	tblock: BEGIN
+		DECLARE a INT DEFAULT 2;
+		IF a = 2 THEN
+			LEAVE tblock;
+		END IF;
+		SET a = 3;
+	END;
+	SELECT a;
+END;`,
+			`CREATE PROCEDURE p3(OUT total INT, OUT last_pk INT)
+BEGIN
+	DECLARE done BOOL DEFAULT FALSE;
+	DECLARE cur1 CURSOR FOR SELECT pk FROM t1 ORDER BY pk;
+	DECLARE CONTINUE HANDLER FOR NOT FOUND SET done = TRUE;
+	SET total = 0;
+	OPEN cur1;
+	read_loop: LOOP
+		FETCH cur1 INTO last_pk;
+		IF done THEN
+			LEAVE read_loop;
+		END IF;
+		SET total = total + last_pk;
+	END LOOP;
+	CLOSE cur1;
+END;`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CALL p1();",
+				Expected: []sql.Row{{"3;"}},
+			},
+			{
+				Query:    "CALL p2();",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:                         "CALL p3(@total, @last);",
+				SkipResultCheckOnServerEngine: true, // call depends on stored procedure stmt for whether to use 'query' or 'exec' from go sql driver.
+				Expected:                      []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT @total, @last;",
+				Expected: []sql.Row{{45, 9}},
+			},
+		},
+	},
 	{
 		Name: "Handle setting an uninitialized user variable",
 		SetUpScript: []string{
@@ -1784,6 +1854,186 @@ END`,
 			},
 		},
 	},
+	{
+		Name: "DECLARE HANDLER for MySQL error codes, SQLSTATEs and named conditions",
+		SetUpScript: []string{
+			`CREATE TABLE t (pk INT PRIMARY KEY);`,
+			`CREATE PROCEDURE p1(x INT)
+BEGIN
+	DECLARE dup_key CONDITION FOR 1062;
+	DECLARE custom CONDITION FOR SQLSTATE '45001';
+	DECLARE res VARCHAR(100) DEFAULT '';
+	DECLARE CONTINUE HANDLER FOR dup_key SET res = CONCAT(res, 'dup;');
+	DECLARE CONTINUE HANDLER FOR SQLSTATE '45000' SET res = CONCAT(res, '45000;');
+	DECLARE CONTINUE HANDLER FOR custom, SQLSTATE '45002' SET res = CONCAT(res, 'custom;');
+	DECLARE CONTINUE HANDLER FOR SQLEXCEPTION SET res = CONCAT(res, 'exception;');
+	DECLARE CONTINUE HANDLER FOR SQLWARNING SET res = CONCAT(res, 'warning;');
+	INSERT INTO t VALUES (x);
+	INSERT INTO t VALUES (x);
+	SIGNAL SQLSTATE '45000';
+	SIGNAL SQLSTATE '45001';
+	SIGNAL SQLSTATE '45002';
+	SIGNAL SQLSTATE '45003';
+	SIGNAL SQLSTATE '01000';
+	SELECT res;
+END`,
+			`CREATE PROCEDURE p2(msg VARCHAR(100))
+BEGIN
+	DECLARE EXIT HANDLER FOR SQLEXCEPTION
+	BEGIN
+		RESIGNAL SET MESSAGE_TEXT = msg, MYSQL_ERRNO = 5000;
+	END;
+	INSERT INTO t VALUES (1);
+END`,
+			`CREATE PROCEDURE p3()
+BEGIN
+	DECLARE res VARCHAR(100) DEFAULT '';
+	DECLARE CONTINUE HANDLER FOR SQLSTATE '45001' SET res = CONCAT(res, 'outer;');
+	BEGIN
+		DECLARE EXIT HANDLER FOR SQLSTATE '45000'
+		BEGIN
+			SET res = CONCAT(res, 'inner;');
+			RESIGNAL SQLSTATE '45001';
+		END;
+		SIGNAL SQLSTATE '45000';
+		SET res = CONCAT(res, 'unreachable;');
+	END;
+	SELECT res;
+END`,
+			`CREATE PROCEDURE p4()
+BEGIN
+	DECLARE EXIT HANDLER FOR SQLEXCEPTION RESIGNAL;
+	SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'original', MYSQL_ERRNO = 5001;
+END`,
+			`CREATE PROCEDURE p5()
+BEGIN
+	RESIGNAL;
+END`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CALL p1(1);",
+				Expected: []sql.Row{{"dup;45000;custom;custom;exception;warning;"}},
+			},
+			{
+				Query:          "CALL p2('insert failed');",
+				ExpectedErrStr: "insert failed (errno 5000) (sqlstate 23000)",
+			},
+			{
+				Query:    "CALL p3();",
+				Expected: []sql.Row{{"inner;outer;"}},
+			},
+			{
+				Query:          "CALL p4();",
+				ExpectedErrStr: "original (errno 5001) (sqlstate 45000)",
+			},
+			{
+				Query:       "CALL p5();",
+				ExpectedErr: sql.ErrResignalWithoutHandler,
+			},
+		},
+	},
+	{
+		Name: "GET DIAGNOSTICS",
+		SetUpScript: []string{
+			`CREATE TABLE t (pk INT PRIMARY KEY);`,
+			`INSERT INTO t VALUES (1);`,
+			`CREATE PROCEDURE p1()
+BEGIN
+	DECLARE n INT;
+	DECLARE state CHAR(5);
+	DECLARE errno INT;
+	DECLARE CONTINUE HANDLER FOR SQLEXCEPTION
+	BEGIN
+		GET DIAGNOSTICS n = NUMBER;
+		GET CURRENT DIAGNOSTICS CONDITION n state = RETURNED_SQLSTATE, errno = MYSQL_ERRNO;
+	END;
+	INSERT INTO t VALUES (1);
+	SELECT n, state, errno;
+END`,
+			`CREATE PROCEDURE p2()
+BEGIN
+	DECLARE ignored INT;
+	DECLARE EXIT HANDLER FOR SQLSTATE '45000'
+	BEGIN
+		SELECT 1 INTO ignored;
+		GET DIAGNOSTICS @current = NUMBER;
+		GET STACKED DIAGNOSTICS CONDITION 1 @state = RETURNED_SQLSTATE, @msg = MESSAGE_TEXT, @errno = MYSQL_ERRNO,
+			@class = CLASS_ORIGIN, @subclass = SUBCLASS_ORIGIN, @tbl = TABLE_NAME;
+	END;
+	SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'custom', MYSQL_ERRNO = 5000;
+END`,
+			`CREATE PROCEDURE p3()
+BEGIN
+	DECLARE CONTINUE HANDLER FOR SQLSTATE 'HY000'
+		GET DIAGNOSTICS CONDITION 2 @msg = MESSAGE_TEXT;
+	SIGNAL SQLSTATE 'HY000';
+END`,
+			`CREATE PROCEDURE p4()
+BEGIN
+	GET STACKED DIAGNOSTICS @n = NUMBER;
+END`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CALL p1();",
+				Expected: []sql.Row{{1, "23000", 1062}},
+			},
+			{
+				Query:    "CALL p2();",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT @current, @state, @msg, @errno, @class, @subclass, @tbl;",
+				Expected: []sql.Row{{0, "45000", "custom", 5000, "ISO 9075", "ISO 9075", ""}},
+			},
+			{
+				Query:       "CALL p3();",
+				ExpectedErr: sql.ErrInvalidConditionNumber,
+			},
+			{
+				Query:       "CALL p4();",
+				ExpectedErr: sql.ErrGetStackedDiagnosticsWithoutHandler,
+			},
+			{
+				Query:    "DROP TABLE IF EXISTS no_such_table;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "GET DIAGNOSTICS @n = NUMBER;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "GET DIAGNOSTICS CONDITION @n @state = RETURNED_SQLSTATE, @errno = MYSQL_ERRNO, @msg = MESSAGE_TEXT;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT @n, @state, @errno, @msg;",
+				Expected: []sql.Row{{1, "HY000", 1051, "Unknown table 'no_such_table'"}},
+			},
+			{
+				Query:       "GET DIAGNOSTICS CONDITION 1 @msg = MESSAGE_TEXT;",
+				ExpectedErr: sql.ErrInvalidConditionNumber,
+			},
+			{
+				Query:       "GET STACKED DIAGNOSTICS @n = NUMBER;",
+				ExpectedErr: sql.ErrGetStackedDiagnosticsWithoutHandler,
+			},
+			{
+				Query:       "GET DIAGNOSTICS n = NUMBER;",
+				ExpectedErr: sql.ErrUndeclaredVariable,
+			},
+		},
+	},
+	{
+		Name: "DECLARE HANDLER for a condition that does not exist",
+		Query: `CREATE PROCEDURE p1()
+BEGIN
+	DECLARE CONTINUE HANDLER FOR no_such_condition BEGIN END;
+	SELECT 1;
+END`,
+		ExpectedErr: sql.ErrDeclareConditionNotFound,
+	},
 	{
 		Name:        "Duplicate parameter names",
 		Query:       "CREATE PROCEDURE p1(abc DATETIME, abc DOUBLE) SELECT abc",
@@ -1872,14 +2122,14 @@ BEGIN
 END;`,
 		ExpectedErr: sql.ErrDeclareConditionDuplicate,
 	},
-	{ //TODO: change this test when we implement DECLARE CONDITION for MySQL error codes
+	{
 		Name: "SIGNAL references condition name for MySQL error code",
 		Query: `CREATE PROCEDURE p1(x INT)
 BEGIN
 	DECLARE mysql_err_code CONDITION FOR 1000;
 	SIGNAL mysql_err_code;
 END;`,
-		ExpectedErr: sql.ErrUnsupportedSyntax,
+		ExpectedErr: sql.ErrSignalOnlySqlState,
 	},
 	{
 		Name: "SIGNAL non-existent condition name",
@@ -2309,6 +2559,94 @@ end;
 			},
 		},
 	},
+	{
+		Name: "prepared statements with arguments inside of stored procedures",
+		SetUpScript: []string{
+			"create table t (i int primary key, j int);",
+			`
+create procedure insert_user_vars(x int)
+begin
+  set @a = x;
+  set @b = x * 10;
+  prepare stmt from 'insert into t values (?, ?)';
+  execute stmt using @a, @b;
+  deallocate prepare stmt;
+end;
+`,
+			`
+create procedure insert_local_vars()
+begin
+  declare n int default 100;
+  prepare stmt from 'insert into t values (?, ?)';
+  while n < 103 do
+    set n = n + 1;
+    execute stmt using n, n;
+  end while;
+end;
+`,
+			`
+create procedure select_dynamic(tbl varchar(20))
+begin
+  set @query = concat('select i, j from ', tbl, ' where i < ? order by i');
+  set @max = 100;
+  prepare stmt from @query;
+  execute stmt using @max;
+end;
+`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "call insert_user_vars(1);",
+				Expected: []sql.Row{
+					{types.NewOkResult(0)},
+				},
+			},
+			{
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "call insert_user_vars(2);",
+				Expected: []sql.Row{
+					{types.NewOkResult(0)},
+				},
+			},
+			{
+				Query:       "execute stmt using @a, @b;",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+			{
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "call insert_local_vars();",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				Query: "select * from t order by i;",
+				Expected: []sql.Row{
+					{1, 10},
+					{2, 20},
+					{101, 101},
+					{102, 102},
+					{103, 103},
+				},
+			},
+			{
+				Query: "call select_dynamic('t');",
+				Expected: []sql.Row{
+					{1, 10},
+					{2, 20},
+				},
+			},
+			{
+				// statements prepared inside of a procedure outlive it
+				Query: "execute stmt using @max;",
+				Expected: []sql.Row{
+					{1, 10},
+					{2, 20},
+				},
+			},
+		},
+	},
 	{
 		Name: "stored procedure with exists subquery",
 		SetUpScript: []string{
@@ -2795,7 +3133,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2821,7 +3159,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2852,7 +3190,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2874,7 +3212,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2900,7 +3238,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2918,7 +3256,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2931,7 +3269,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2967,7 +3305,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2993,7 +3331,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -5078,7 +5078,6 @@ SELECT * FROM cte WHERE  d = 2;`,
 			{"gtid_mode", "OFF"},
 			{"innodb_autoinc_lock_mode", int64(2)},
 			{"offline_mode", "OFF"},
-			{"pseudo_slave_mode", "OFF"},
 			{"rbr_exec_mode", "STRICT"},
 			{"sql_mode", "NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES"},
 			{"ssl_fips_mode", "OFF"},
@@ -5478,6 +5477,14 @@ SELECT * FROM cte WHERE  d = 2;`,
 		Query:    `SHOW COLLATION WHERE charset = 'foo'`,
 		Expected: nil,
 	},
+	{
+		Query:    `SHOW COLLATION WHERE charset = 'big5'`,
+		Expected: nil,
+	},
+	{
+		Query:    "SHOW COLLATION WHERE Charset = 'latin1' AND `Default` = 'Yes'",
+		Expected: []sql.Row{{"latin1_swedish_ci", "latin1", uint64(8), "Yes", "Yes", uint32(1), "PAD SPACE"}},
+	},
 	{
 		Query: "SHOW COLLATION WHERE `Default` = 'Yes' AND `Collation` LIKE 'utf8mb4%'",
 		Expected: []sql.Row{
@@ -5635,7 +5642,7 @@ SELECT * FROM cte WHERE  d = 2;`,
 		Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}},
 	},
 	{
-		Query:    `SELECT JSON_EXTRACT('[1, 2, 3]', '$.[0]')`,
+		Query:    `SELECT JSON_EXTRACT('[1, 2, 3]', '$[0]')`,
 		Expected: []sql.Row{{types.MustJSON(`1`)}},
 	},
 	// TODO(andy)
@@ -6868,11 +6875,11 @@ SELECT * FROM cte WHERE  d = 2;`,
 	{
 		Query: "SELECT pk, row_number() over (partition by v2 order by pk ), max(v3) over (partition by v2 order by pk) FROM one_pk_three_idx ORDER BY pk",
 		Expected: []sql.Row{
-			{0, 1, 3},
-			{1, 2, 3},
+			{0, 1, 0},
+			{1, 2, 1},
 			{2, 1, 0},
 			{3, 1, 2},
-			{4, 3, 3},
+			{4, 3, 1},
 			{5, 4, 3},
 			{6, 1, 0},
 			{7, 1, 4},
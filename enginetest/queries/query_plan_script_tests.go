@@ -1512,4 +1512,181 @@ WHERE sub.region = 'West';`,
 			},
 		},
 	},
+	{
+		Name: "derived tables and views are merged into the outer query",
+		SetUpScript: []string{
+			`create table t (a int primary key, b int, c varchar(20), index (b));`,
+			`insert into t values (1, 10, 'one'), (2, 20, 'two'), (3, 30, 'three'), (4, 20, 'four');`,
+			`create view v as select a, b as x, c from t where a > 1;`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: `select sq.y from (select b as y, c from t) sq where sq.y = 20 order by sq.c;`,
+				Expected: []sql.Row{
+					sql.Row{20},
+					sql.Row{20},
+				},
+				ExpectedPlan: "Project\n" +
+					" ├─ columns: [t.b:0->y:0]\n" +
+					" └─ Sort(t.c:1 ASC nullsFirst)\n" +
+					"     └─ IndexedTableAccess(t)\n" +
+					"         ├─ index: [t.b]\n" +
+					"         ├─ static: [{[20, 20]}]\n" +
+					"         ├─ colSet: (1-3)\n" +
+					"         ├─ tableId: 1\n" +
+					"         └─ Table\n" +
+					"             ├─ name: t\n" +
+					"             └─ columns: [b c]\n" +
+					"",
+			},
+			{
+				Query: `select v.a, v.c from v where v.x = 20 order by v.a;`,
+				Expected: []sql.Row{
+					sql.Row{2, "two"},
+					sql.Row{4, "four"},
+				},
+				ExpectedPlan: "Project\n" +
+					" ├─ columns: [t.a:0!null->a:0, t.c:2->c:0]\n" +
+					" └─ Sort(t.a:0!null ASC nullsFirst)\n" +
+					"     └─ Filter\n" +
+					"         ├─ GreaterThan\n" +
+					"         │   ├─ t.a:0!null\n" +
+					"         │   └─ 1 (int)\n" +
+					"         └─ IndexedTableAccess(t)\n" +
+					"             ├─ index: [t.b]\n" +
+					"             ├─ static: [{[20, 20]}]\n" +
+					"             ├─ colSet: (1-3)\n" +
+					"             ├─ tableId: 1\n" +
+					"             └─ Table\n" +
+					"                 ├─ name: t\n" +
+					"                 └─ columns: [a b c]\n" +
+					"",
+			},
+			{
+				Query: `select t1.a, v.a from t t1 join v on t1.b = v.x order by t1.a, v.a;`,
+				Expected: []sql.Row{
+					sql.Row{2, 2},
+					sql.Row{2, 4},
+					sql.Row{3, 3},
+					sql.Row{4, 2},
+					sql.Row{4, 4},
+				},
+				ExpectedPlan: "Project\n" +
+					" ├─ columns: [t1.a:0!null, t.a:2!null->a:0]\n" +
+					" └─ Sort(t1.a:0!null ASC nullsFirst, t.a:2!null ASC nullsFirst)\n" +
+					"     └─ MergeJoin\n" +
+					"         ├─ cmp: Eq\n" +
+					"         │   ├─ t1.b:1\n" +
+					"         │   └─ t.b:3\n" +
+					"         ├─ TableAlias(t1)\n" +
+					"         │   └─ IndexedTableAccess(t)\n" +
+					"         │       ├─ index: [t.b]\n" +
+					"         │       ├─ static: [{[NULL, ∞)}]\n" +
+					"         │       ├─ colSet: (1-3)\n" +
+					"         │       ├─ tableId: 1\n" +
+					"         │       └─ Table\n" +
+					"         │           ├─ name: t\n" +
+					"         │           └─ columns: [a b]\n" +
+					"         └─ Filter\n" +
+					"             ├─ GreaterThan\n" +
+					"             │   ├─ t.a:0!null\n" +
+					"             │   └─ 1 (int)\n" +
+					"             └─ IndexedTableAccess(t)\n" +
+					"                 ├─ index: [t.b]\n" +
+					"                 ├─ static: [{[NULL, ∞)}]\n" +
+					"                 ├─ colSet: (4-6)\n" +
+					"                 ├─ tableId: 2\n" +
+					"                 └─ Table\n" +
+					"                     ├─ name: t\n" +
+					"                     └─ columns: [a b]\n" +
+					"",
+			},
+			{
+				Query: `select t.a, v.a from t join v on t.a = v.a where v.x = 20 order by t.a;`,
+				Expected: []sql.Row{
+					sql.Row{2, 2},
+					sql.Row{4, 4},
+				},
+				ExpectedPlan: "Project\n" +
+					" ├─ columns: [t.a:3!null, v.a:0!null]\n" +
+					" └─ Sort(t.a:3!null ASC nullsFirst)\n" +
+					"     └─ HashJoin\n" +
+					"         ├─ Eq\n" +
+					"         │   ├─ t.a:3!null\n" +
+					"         │   └─ v.a:0!null\n" +
+					"         ├─ SubqueryAlias\n" +
+					"         │   ├─ name: v\n" +
+					"         │   ├─ outerVisibility: false\n" +
+					"         │   ├─ isLateral: false\n" +
+					"         │   ├─ cacheable: true\n" +
+					"         │   ├─ colSet: (8-10)\n" +
+					"         │   ├─ tableId: 3\n" +
+					"         │   └─ Project\n" +
+					"         │       ├─ columns: [t.a:0!null, t.b:1->x:0, t.c:2]\n" +
+					"         │       └─ Filter\n" +
+					"         │           ├─ GreaterThan\n" +
+					"         │           │   ├─ t.a:0!null\n" +
+					"         │           │   └─ 1 (int)\n" +
+					"         │           └─ IndexedTableAccess(t)\n" +
+					"         │               ├─ index: [t.b]\n" +
+					"         │               ├─ static: [{[20, 20]}]\n" +
+					"         │               ├─ colSet: (4-6)\n" +
+					"         │               ├─ tableId: 2\n" +
+					"         │               └─ Table\n" +
+					"         │                   ├─ name: t\n" +
+					"         │                   └─ columns: [a b c]\n" +
+					"         └─ HashLookup\n" +
+					"             ├─ left-key: TUPLE(v.a:0!null)\n" +
+					"             ├─ right-key: TUPLE(t.a:0!null)\n" +
+					"             └─ ProcessTable\n" +
+					"                 └─ Table\n" +
+					"                     ├─ name: t\n" +
+					"                     └─ columns: [a]\n" +
+					"",
+			},
+		},
+	},
+	{
+		Name: "derived tables and views are not merged with derived_merge=off",
+		SetUpScript: []string{
+			`create table t (a int primary key, b int, c varchar(20), index (b));`,
+			`insert into t values (1, 10, 'one'), (2, 20, 'two'), (3, 30, 'three'), (4, 20, 'four');`,
+			`create view v as select a, b as x, c from t where a > 1;`,
+			`set optimizer_switch = 'derived_merge=off';`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: `select v.a, v.c from v where v.x = 20 order by v.a;`,
+				Expected: []sql.Row{
+					sql.Row{2, "two"},
+					sql.Row{4, "four"},
+				},
+				ExpectedPlan: "Project\n" +
+					" ├─ columns: [v.a:0!null, v.c:2]\n" +
+					" └─ Sort(v.a:0!null ASC nullsFirst)\n" +
+					"     └─ SubqueryAlias\n" +
+					"         ├─ name: v\n" +
+					"         ├─ outerVisibility: false\n" +
+					"         ├─ isLateral: false\n" +
+					"         ├─ cacheable: true\n" +
+					"         ├─ colSet: (5-7)\n" +
+					"         ├─ tableId: 2\n" +
+					"         └─ Project\n" +
+					"             ├─ columns: [t.a:0!null, t.b:1->x:0, t.c:2]\n" +
+					"             └─ Filter\n" +
+					"                 ├─ GreaterThan\n" +
+					"                 │   ├─ t.a:0!null\n" +
+					"                 │   └─ 1 (int)\n" +
+					"                 └─ IndexedTableAccess(t)\n" +
+					"                     ├─ index: [t.b]\n" +
+					"                     ├─ static: [{[20, 20]}]\n" +
+					"                     ├─ colSet: (1-3)\n" +
+					"                     ├─ tableId: 1\n" +
+					"                     └─ Table\n" +
+					"                         ├─ name: t\n" +
+					"                         └─ columns: [a b c]\n" +
+					"",
+			},
+		},
+	},
 }
@@ -104,7 +104,8 @@ offset 1;`,
 			" │   as xy]\n" +
 			" └─ TableAlias(cte)\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [cte.x, cte.y, Subquery\n" +
@@ -121,7 +122,8 @@ offset 1;`,
 			" │   as xy]\n" +
 			" └─ TableAlias(cte)\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 	},
 	{
@@ -213,9 +215,11 @@ offset 1;`,
 			"     │                               ├─ EXISTS Subquery(select t3.y from xy as t3 where t3.y = xy.x)\n" +
 			"     │                               └─ TableAlias(t2)\n" +
 			"     │                                   └─ Table\n" +
-			"     │                                       └─ name: xy\n" +
+			"     │                                       ├─ name: xy\n" +
+			"     │                                       └─ columns: [x y]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [xy.x]\n" +
@@ -246,9 +250,11 @@ offset 1;`,
 			"     │                               ├─ EXISTS Subquery(select t3.y from xy as t3 where t3.y = xy.x)\n" +
 			"     │                               └─ TableAlias(t2)\n" +
 			"     │                                   └─ Table\n" +
-			"     │                                       └─ name: xy\n" +
+			"     │                                       ├─ name: xy\n" +
+			"     │                                       └─ columns: [x y]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 	},
 	{
@@ -359,6 +365,7 @@ offset 1;`,
 			"     │                       └─ keys: xy_1.x\n" +
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.y]\n" +
+			"         ├─ columns: [x y]\n" +
 			"         └─ keys: xy_1.x\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -380,6 +387,7 @@ offset 1;`,
 			"     │                       └─ keys: xy_1.x\n" +
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.y]\n" +
+			"         ├─ columns: [x y]\n" +
 			"         └─ keys: xy_1.x\n" +
 			"",
 	},
@@ -446,30 +454,28 @@ From xy;`,
 			" │       ├─ alias-string: select x from xy where not x in (select u from uv)\n" +
 			" │       └─ Project\n" +
 			" │           ├─ columns: [xy.x:3!null]\n" +
-			" │           └─ Project\n" +
-			" │               ├─ columns: [xy.x:3!null, xy.y:4]\n" +
-			" │               └─ Filter\n" +
-			" │                   ├─ uv.u:5!null IS NULL\n" +
-			" │                   └─ LeftOuterMergeJoin\n" +
-			" │                       ├─ cmp: Eq\n" +
-			" │                       │   ├─ xy.x:3!null\n" +
-			" │                       │   └─ uv.u:5!null\n" +
-			" │                       ├─ IndexedTableAccess(xy)\n" +
-			" │                       │   ├─ index: [xy.x]\n" +
-			" │                       │   ├─ static: [{[NULL, ∞)}]\n" +
-			" │                       │   ├─ colSet: (3,4)\n" +
-			" │                       │   ├─ tableId: 2\n" +
-			" │                       │   └─ Table\n" +
-			" │                       │       ├─ name: xy\n" +
-			" │                       │       └─ columns: [x y]\n" +
-			" │                       └─ IndexedTableAccess(uv)\n" +
-			" │                           ├─ index: [uv.u]\n" +
-			" │                           ├─ static: [{[NULL, ∞)}]\n" +
-			" │                           ├─ colSet: (5,6)\n" +
-			" │                           ├─ tableId: 3\n" +
-			" │                           └─ Table\n" +
-			" │                               ├─ name: uv\n" +
-			" │                               └─ columns: [u]\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ uv.u:4!null IS NULL\n" +
+			" │               └─ LeftOuterMergeJoin\n" +
+			" │                   ├─ cmp: Eq\n" +
+			" │                   │   ├─ xy.x:3!null\n" +
+			" │                   │   └─ uv.u:4!null\n" +
+			" │                   ├─ IndexedTableAccess(xy)\n" +
+			" │                   │   ├─ index: [xy.x]\n" +
+			" │                   │   ├─ static: [{[NULL, ∞)}]\n" +
+			" │                   │   ├─ colSet: (3,4)\n" +
+			" │                   │   ├─ tableId: 2\n" +
+			" │                   │   └─ Table\n" +
+			" │                   │       ├─ name: xy\n" +
+			" │                   │       └─ columns: [x]\n" +
+			" │                   └─ IndexedTableAccess(uv)\n" +
+			" │                       ├─ index: [uv.u]\n" +
+			" │                       ├─ static: [{[NULL, ∞)}]\n" +
+			" │                       ├─ colSet: (5,6)\n" +
+			" │                       ├─ tableId: 3\n" +
+			" │                       └─ Table\n" +
+			" │                           ├─ name: uv\n" +
+			" │                           └─ columns: [u]\n" +
 			" │   THEN 1 (tinyint) ELSE 2 (tinyint) END->s:0]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [xy.x:0!null, xy.y:1, CASE  WHEN xy.x:0!null IS NULL THEN 0 (tinyint) WHEN InSubquery\n" +
@@ -479,30 +485,28 @@ From xy;`,
 			"     │       ├─ alias-string: select x from xy where not x in (select u from uv)\n" +
 			"     │       └─ Project\n" +
 			"     │           ├─ columns: [xy.x:2!null]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [xy.x:2!null, xy.y:3]\n" +
-			"     │               └─ Filter\n" +
-			"     │                   ├─ uv.u:4!null IS NULL\n" +
-			"     │                   └─ LeftOuterMergeJoin\n" +
-			"     │                       ├─ cmp: Eq\n" +
-			"     │                       │   ├─ xy.x:2!null\n" +
-			"     │                       │   └─ uv.u:4!null\n" +
-			"     │                       ├─ IndexedTableAccess(xy)\n" +
-			"     │                       │   ├─ index: [xy.x]\n" +
-			"     │                       │   ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                       │   ├─ colSet: (3,4)\n" +
-			"     │                       │   ├─ tableId: 2\n" +
-			"     │                       │   └─ Table\n" +
-			"     │                       │       ├─ name: xy\n" +
-			"     │                       │       └─ columns: [x y]\n" +
-			"     │                       └─ IndexedTableAccess(uv)\n" +
-			"     │                           ├─ index: [uv.u]\n" +
-			"     │                           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                           ├─ colSet: (5,6)\n" +
-			"     │                           ├─ tableId: 3\n" +
-			"     │                           └─ Table\n" +
-			"     │                               ├─ name: uv\n" +
-			"     │                               └─ columns: [u]\n" +
+			"     │           └─ Filter\n" +
+			"     │               ├─ uv.u:3!null IS NULL\n" +
+			"     │               └─ LeftOuterMergeJoin\n" +
+			"     │                   ├─ cmp: Eq\n" +
+			"     │                   │   ├─ xy.x:2!null\n" +
+			"     │                   │   └─ uv.u:3!null\n" +
+			"     │                   ├─ IndexedTableAccess(xy)\n" +
+			"     │                   │   ├─ index: [xy.x]\n" +
+			"     │                   │   ├─ static: [{[NULL, ∞)}]\n" +
+			"     │                   │   ├─ colSet: (3,4)\n" +
+			"     │                   │   ├─ tableId: 2\n" +
+			"     │                   │   └─ Table\n" +
+			"     │                   │       ├─ name: xy\n" +
+			"     │                   │       └─ columns: [x]\n" +
+			"     │                   └─ IndexedTableAccess(uv)\n" +
+			"     │                       ├─ index: [uv.u]\n" +
+			"     │                       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │                       ├─ colSet: (5,6)\n" +
+			"     │                       ├─ tableId: 3\n" +
+			"     │                       └─ Table\n" +
+			"     │                           ├─ name: uv\n" +
+			"     │                           └─ columns: [u]\n" +
 			"     │   THEN 1 (tinyint) ELSE 2 (tinyint) END->s:0]\n" +
 			"     └─ ProcessTable\n" +
 			"         └─ Table\n" +
@@ -516,19 +520,18 @@ From xy;`,
 			" │       ├─ cacheable: true\n" +
 			" │       └─ Project\n" +
 			" │           ├─ columns: [xy.x]\n" +
-			" │           └─ Project\n" +
-			" │               ├─ columns: [xy.x, xy.y]\n" +
-			" │               └─ Filter\n" +
-			" │                   ├─ uv.u IS NULL\n" +
-			" │                   └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
-			" │                       ├─ cmp: (xy.x = uv.u)\n" +
-			" │                       ├─ IndexedTableAccess(xy)\n" +
-			" │                       │   ├─ index: [xy.x]\n" +
-			" │                       │   └─ filters: [{[NULL, ∞)}]\n" +
-			" │                       └─ IndexedTableAccess(uv)\n" +
-			" │                           ├─ index: [uv.u]\n" +
-			" │                           ├─ filters: [{[NULL, ∞)}]\n" +
-			" │                           └─ columns: [u]\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ uv.u IS NULL\n" +
+			" │               └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
+			" │                   ├─ cmp: (xy.x = uv.u)\n" +
+			" │                   ├─ IndexedTableAccess(xy)\n" +
+			" │                   │   ├─ index: [xy.x]\n" +
+			" │                   │   ├─ filters: [{[NULL, ∞)}]\n" +
+			" │                   │   └─ columns: [x]\n" +
+			" │                   └─ IndexedTableAccess(uv)\n" +
+			" │                       ├─ index: [uv.u]\n" +
+			" │                       ├─ filters: [{[NULL, ∞)}]\n" +
+			" │                       └─ columns: [u]\n" +
 			" │   THEN 1 ELSE 2 END as s]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [xy.x, xy.y, CASE  WHEN xy.x IS NULL THEN 0 WHEN InSubquery\n" +
@@ -537,22 +540,22 @@ From xy;`,
 			"     │       ├─ cacheable: true\n" +
 			"     │       └─ Project\n" +
 			"     │           ├─ columns: [xy.x]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [xy.x, xy.y]\n" +
-			"     │               └─ Filter\n" +
-			"     │                   ├─ uv.u IS NULL\n" +
-			"     │                   └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
-			"     │                       ├─ cmp: (xy.x = uv.u)\n" +
-			"     │                       ├─ IndexedTableAccess(xy)\n" +
-			"     │                       │   ├─ index: [xy.x]\n" +
-			"     │                       │   └─ filters: [{[NULL, ∞)}]\n" +
-			"     │                       └─ IndexedTableAccess(uv)\n" +
-			"     │                           ├─ index: [uv.u]\n" +
-			"     │                           ├─ filters: [{[NULL, ∞)}]\n" +
-			"     │                           └─ columns: [u]\n" +
+			"     │           └─ Filter\n" +
+			"     │               ├─ uv.u IS NULL\n" +
+			"     │               └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
+			"     │                   ├─ cmp: (xy.x = uv.u)\n" +
+			"     │                   ├─ IndexedTableAccess(xy)\n" +
+			"     │                   │   ├─ index: [xy.x]\n" +
+			"     │                   │   ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │                   │   └─ columns: [x]\n" +
+			"     │                   └─ IndexedTableAccess(uv)\n" +
+			"     │                       ├─ index: [uv.u]\n" +
+			"     │                       ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │                       └─ columns: [u]\n" +
 			"     │   THEN 1 ELSE 2 END as s]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [CASE  WHEN xy.x IS NULL THEN 0 WHEN InSubquery\n" +
@@ -561,19 +564,18 @@ From xy;`,
 			" │       ├─ cacheable: true\n" +
 			" │       └─ Project\n" +
 			" │           ├─ columns: [xy.x]\n" +
-			" │           └─ Project\n" +
-			" │               ├─ columns: [xy.x, xy.y]\n" +
-			" │               └─ Filter\n" +
-			" │                   ├─ uv.u IS NULL\n" +
-			" │                   └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
-			" │                       ├─ cmp: (xy.x = uv.u)\n" +
-			" │                       ├─ IndexedTableAccess(xy)\n" +
-			" │                       │   ├─ index: [xy.x]\n" +
-			" │                       │   └─ filters: [{[NULL, ∞)}]\n" +
-			" │                       └─ IndexedTableAccess(uv)\n" +
-			" │                           ├─ index: [uv.u]\n" +
-			" │                           ├─ filters: [{[NULL, ∞)}]\n" +
-			" │                           └─ columns: [u]\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ uv.u IS NULL\n" +
+			" │               └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
+			" │                   ├─ cmp: (xy.x = uv.u)\n" +
+			" │                   ├─ IndexedTableAccess(xy)\n" +
+			" │                   │   ├─ index: [xy.x]\n" +
+			" │                   │   ├─ filters: [{[NULL, ∞)}]\n" +
+			" │                   │   └─ columns: [x]\n" +
+			" │                   └─ IndexedTableAccess(uv)\n" +
+			" │                       ├─ index: [uv.u]\n" +
+			" │                       ├─ filters: [{[NULL, ∞)}]\n" +
+			" │                       └─ columns: [u]\n" +
 			" │   THEN 1 ELSE 2 END as s]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [xy.x, xy.y, CASE  WHEN xy.x IS NULL THEN 0 WHEN InSubquery\n" +
@@ -582,22 +584,22 @@ From xy;`,
 			"     │       ├─ cacheable: true\n" +
 			"     │       └─ Project\n" +
 			"     │           ├─ columns: [xy.x]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [xy.x, xy.y]\n" +
-			"     │               └─ Filter\n" +
-			"     │                   ├─ uv.u IS NULL\n" +
-			"     │                   └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
-			"     │                       ├─ cmp: (xy.x = uv.u)\n" +
-			"     │                       ├─ IndexedTableAccess(xy)\n" +
-			"     │                       │   ├─ index: [xy.x]\n" +
-			"     │                       │   └─ filters: [{[NULL, ∞)}]\n" +
-			"     │                       └─ IndexedTableAccess(uv)\n" +
-			"     │                           ├─ index: [uv.u]\n" +
-			"     │                           ├─ filters: [{[NULL, ∞)}]\n" +
-			"     │                           └─ columns: [u]\n" +
+			"     │           └─ Filter\n" +
+			"     │               ├─ uv.u IS NULL\n" +
+			"     │               └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
+			"     │                   ├─ cmp: (xy.x = uv.u)\n" +
+			"     │                   ├─ IndexedTableAccess(xy)\n" +
+			"     │                   │   ├─ index: [xy.x]\n" +
+			"     │                   │   ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │                   │   └─ columns: [x]\n" +
+			"     │                   └─ IndexedTableAccess(uv)\n" +
+			"     │                       ├─ index: [uv.u]\n" +
+			"     │                       ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │                       └─ columns: [u]\n" +
 			"     │   THEN 1 ELSE 2 END as s]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 	},
 	{
@@ -638,7 +640,7 @@ From xy;`,
 			"     ├─ select: COUNT(1 (bigint))\n" +
 			"     ├─ group: \n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [xy.x:1!null, xy.y:2]\n" +
+			"         ├─ columns: [xy.y:1]\n" +
 			"         └─ LookupJoin\n" +
 			"             ├─ Distinct\n" +
 			"             │   └─ Project\n" +
@@ -654,7 +656,7 @@ From xy;`,
 			"                 ├─ tableId: 1\n" +
 			"                 └─ Table\n" +
 			"                     ├─ name: xy\n" +
-			"                     └─ columns: [x y]\n" +
+			"                     └─ columns: [y]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [count(1) as count(*)]\n" +
@@ -662,7 +664,7 @@ From xy;`,
 			"     ├─ select: COUNT(1)\n" +
 			"     ├─ group: \n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [xy.x, xy.y]\n" +
+			"         ├─ columns: [xy.y]\n" +
 			"         └─ LookupJoin (estimated cost=13.338 rows=4)\n" +
 			"             ├─ Distinct\n" +
 			"             │   └─ Project\n" +
@@ -671,6 +673,7 @@ From xy;`,
 			"             │           └─ name: uv\n" +
 			"             └─ IndexedTableAccess(xy)\n" +
 			"                 ├─ index: [xy.y]\n" +
+			"                 ├─ columns: [y]\n" +
 			"                 └─ keys: uv.v\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -679,7 +682,7 @@ From xy;`,
 			"     ├─ select: COUNT(1)\n" +
 			"     ├─ group: \n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [xy.x, xy.y]\n" +
+			"         ├─ columns: [xy.y]\n" +
 			"         └─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=2 loops=1)\n" +
 			"             ├─ Distinct\n" +
 			"             │   └─ Project\n" +
@@ -688,6 +691,7 @@ From xy;`,
 			"             │           └─ name: uv\n" +
 			"             └─ IndexedTableAccess(xy)\n" +
 			"                 ├─ index: [xy.y]\n" +
+			"                 ├─ columns: [y]\n" +
 			"                 └─ keys: uv.v\n" +
 			"",
 	},
@@ -700,7 +704,7 @@ From xy;`,
 			"     ├─ group: \n" +
 			"     └─ LookupJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ xy.y:2\n" +
+			"         │   ├─ xy.y:1\n" +
 			"         │   └─ uv.u:0!null\n" +
 			"         ├─ OrderedDistinct\n" +
 			"         │   └─ Project\n" +
@@ -716,7 +720,7 @@ From xy;`,
 			"             ├─ tableId: 1\n" +
 			"             └─ Table\n" +
 			"                 ├─ name: xy\n" +
-			"                 └─ columns: [x y]\n" +
+			"                 └─ columns: [y]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [count(1) as count(*)]\n" +
@@ -732,6 +736,7 @@ From xy;`,
 			"         │           └─ name: uv\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.y]\n" +
+			"             ├─ columns: [y]\n" +
 			"             └─ keys: uv.u\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -748,6 +753,7 @@ From xy;`,
 			"         │           └─ name: uv\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.y]\n" +
+			"             ├─ columns: [y]\n" +
 			"             └─ keys: uv.u\n" +
 			"",
 	},
@@ -1662,7 +1668,8 @@ where
 			"                 │           │               ├─ index: [parts.part,parts.sub_part]\n" +
 			"                 │           │               └─ filters: [{[pie, pie], [crust, crust]}]\n" +
 			"                 │           └─ Table\n" +
-			"                 │               └─ name: parts\n" +
+			"                 │               ├─ name: parts\n" +
+			"                 │               └─ columns: [part sub_part quantity]\n" +
 			"                 └─ Project\n" +
 			"                     ├─ columns: [p.sub_part, p.part, p.quantity]\n" +
 			"                     └─ LookupJoin\n" +
@@ -1702,7 +1709,8 @@ where
 			"                 │           │               ├─ index: [parts.part,parts.sub_part]\n" +
 			"                 │           │               └─ filters: [{[pie, pie], [crust, crust]}]\n" +
 			"                 │           └─ Table\n" +
-			"                 │               └─ name: parts\n" +
+			"                 │               ├─ name: parts\n" +
+			"                 │               └─ columns: [part sub_part quantity]\n" +
 			"                 └─ Project\n" +
 			"                     ├─ columns: [p.sub_part, p.part, p.quantity]\n" +
 			"                     └─ LookupJoin\n" +
@@ -1814,49 +1822,47 @@ Select * from (
 			"         │   ├─ type: signed\n" +
 			"         │   └─ xy.x:0!null\n" +
 			"         │  ->x:0]\n" +
-			"         └─ Project\n" +
-			"             ├─ columns: [xy.x:0!null]\n" +
-			"             └─ SemiJoin\n" +
-			"                 ├─ Eq\n" +
-			"                 │   ├─ xy.x:0!null\n" +
-			"                 │   └─ cte.s:2!null\n" +
-			"                 ├─ IndexedTableAccess(xy)\n" +
-			"                 │   ├─ index: [xy.x]\n" +
-			"                 │   ├─ static: [{[1, 1]}]\n" +
-			"                 │   ├─ colSet: (7,8)\n" +
-			"                 │   ├─ tableId: 5\n" +
-			"                 │   └─ Table\n" +
-			"                 │       ├─ name: xy\n" +
-			"                 │       └─ columns: [x y]\n" +
-			"                 └─ CachedResults\n" +
-			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: cte\n" +
-			"                         ├─ outerVisibility: false\n" +
-			"                         ├─ isLateral: false\n" +
-			"                         ├─ cacheable: true\n" +
-			"                         ├─ colSet: (9)\n" +
-			"                         ├─ tableId: 4\n" +
-			"                         └─ RecursiveCTE\n" +
-			"                             └─ Union distinct\n" +
-			"                                 ├─ Project\n" +
-			"                                 │   ├─ columns: [1 (tinyint)]\n" +
-			"                                 │   └─ Table\n" +
-			"                                 │       ├─ name: \n" +
-			"                                 │       ├─ columns: []\n" +
-			"                                 │       ├─ colSet: ()\n" +
-			"                                 │       └─ tableId: 0\n" +
-			"                                 └─ Project\n" +
-			"                                     ├─ columns: [xy.x:1!null]\n" +
-			"                                     └─ LookupJoin\n" +
-			"                                         ├─ RecursiveTable(cte)\n" +
-			"                                         └─ IndexedTableAccess(xy)\n" +
-			"                                             ├─ index: [xy.x]\n" +
-			"                                             ├─ keys: [cte.s:0!null]\n" +
-			"                                             ├─ colSet: (3,4)\n" +
-			"                                             ├─ tableId: 3\n" +
-			"                                             └─ Table\n" +
-			"                                                 ├─ name: xy\n" +
-			"                                                 └─ columns: [x]\n" +
+			"         └─ SemiJoin\n" +
+			"             ├─ Eq\n" +
+			"             │   ├─ xy.x:0!null\n" +
+			"             │   └─ cte.s:1!null\n" +
+			"             ├─ IndexedTableAccess(xy)\n" +
+			"             │   ├─ index: [xy.x]\n" +
+			"             │   ├─ static: [{[1, 1]}]\n" +
+			"             │   ├─ colSet: (7,8)\n" +
+			"             │   ├─ tableId: 5\n" +
+			"             │   └─ Table\n" +
+			"             │       ├─ name: xy\n" +
+			"             │       └─ columns: [x]\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: cte\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ isLateral: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     ├─ colSet: (9)\n" +
+			"                     ├─ tableId: 4\n" +
+			"                     └─ RecursiveCTE\n" +
+			"                         └─ Union distinct\n" +
+			"                             ├─ Project\n" +
+			"                             │   ├─ columns: [1 (tinyint)]\n" +
+			"                             │   └─ Table\n" +
+			"                             │       ├─ name: \n" +
+			"                             │       ├─ columns: []\n" +
+			"                             │       ├─ colSet: ()\n" +
+			"                             │       └─ tableId: 0\n" +
+			"                             └─ Project\n" +
+			"                                 ├─ columns: [xy.x:1!null]\n" +
+			"                                 └─ LookupJoin\n" +
+			"                                     ├─ RecursiveTable(cte)\n" +
+			"                                     └─ IndexedTableAccess(xy)\n" +
+			"                                         ├─ index: [xy.x]\n" +
+			"                                         ├─ keys: [cte.s:0!null]\n" +
+			"                                         ├─ colSet: (3,4)\n" +
+			"                                         ├─ tableId: 3\n" +
+			"                                         └─ Table\n" +
+			"                                             ├─ name: xy\n" +
+			"                                             └─ columns: [x]\n" +
 			"",
 		ExpectedEstimates: "SubqueryAlias\n" +
 			" ├─ name: dt\n" +
@@ -1891,33 +1897,32 @@ Select * from (
 			"     │                           └─ keys: cte.s\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [convert(xy.x, signed) as x]\n" +
-			"         └─ Project\n" +
-			"             ├─ columns: [xy.x]\n" +
-			"             └─ SemiJoin\n" +
-			"                 ├─ (xy.x = cte.s)\n" +
-			"                 ├─ IndexedTableAccess(xy)\n" +
-			"                 │   ├─ index: [xy.x]\n" +
-			"                 │   └─ filters: [{[1, 1]}]\n" +
-			"                 └─ CachedResults\n" +
-			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: cte\n" +
-			"                         ├─ outerVisibility: false\n" +
-			"                         ├─ isLateral: false\n" +
-			"                         ├─ cacheable: true\n" +
-			"                         └─ RecursiveCTE\n" +
-			"                             └─ Union distinct\n" +
-			"                                 ├─ Project\n" +
-			"                                 │   ├─ columns: [1]\n" +
-			"                                 │   └─ Table\n" +
-			"                                 │       └─ name: \n" +
-			"                                 └─ Project\n" +
-			"                                     ├─ columns: [xy.x]\n" +
-			"                                     └─ LookupJoin\n" +
-			"                                         ├─ RecursiveTable(cte)\n" +
-			"                                         └─ IndexedTableAccess(xy)\n" +
-			"                                             ├─ index: [xy.x]\n" +
-			"                                             ├─ columns: [x]\n" +
-			"                                             └─ keys: cte.s\n" +
+			"         └─ SemiJoin\n" +
+			"             ├─ (xy.x = cte.s)\n" +
+			"             ├─ IndexedTableAccess(xy)\n" +
+			"             │   ├─ index: [xy.x]\n" +
+			"             │   ├─ filters: [{[1, 1]}]\n" +
+			"             │   └─ columns: [x]\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: cte\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ isLateral: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ RecursiveCTE\n" +
+			"                         └─ Union distinct\n" +
+			"                             ├─ Project\n" +
+			"                             │   ├─ columns: [1]\n" +
+			"                             │   └─ Table\n" +
+			"                             │       └─ name: \n" +
+			"                             └─ Project\n" +
+			"                                 ├─ columns: [xy.x]\n" +
+			"                                 └─ LookupJoin\n" +
+			"                                     ├─ RecursiveTable(cte)\n" +
+			"                                     └─ IndexedTableAccess(xy)\n" +
+			"                                         ├─ index: [xy.x]\n" +
+			"                                         ├─ columns: [x]\n" +
+			"                                         └─ keys: cte.s\n" +
 			"",
 		ExpectedAnalysis: "SubqueryAlias\n" +
 			" ├─ name: dt\n" +
@@ -1952,33 +1957,32 @@ Select * from (
 			"     │                           └─ keys: cte.s\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [convert(xy.x, signed) as x]\n" +
-			"         └─ Project\n" +
-			"             ├─ columns: [xy.x]\n" +
-			"             └─ SemiJoin\n" +
-			"                 ├─ (xy.x = cte.s)\n" +
-			"                 ├─ IndexedTableAccess(xy)\n" +
-			"                 │   ├─ index: [xy.x]\n" +
-			"                 │   └─ filters: [{[1, 1]}]\n" +
-			"                 └─ CachedResults\n" +
-			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: cte\n" +
-			"                         ├─ outerVisibility: false\n" +
-			"                         ├─ isLateral: false\n" +
-			"                         ├─ cacheable: true\n" +
-			"                         └─ RecursiveCTE\n" +
-			"                             └─ Union distinct\n" +
-			"                                 ├─ Project\n" +
-			"                                 │   ├─ columns: [1]\n" +
-			"                                 │   └─ Table\n" +
-			"                                 │       └─ name: \n" +
-			"                                 └─ Project\n" +
-			"                                     ├─ columns: [xy.x]\n" +
-			"                                     └─ LookupJoin\n" +
-			"                                         ├─ RecursiveTable(cte)\n" +
-			"                                         └─ IndexedTableAccess(xy)\n" +
-			"                                             ├─ index: [xy.x]\n" +
-			"                                             ├─ columns: [x]\n" +
-			"                                             └─ keys: cte.s\n" +
+			"         └─ SemiJoin\n" +
+			"             ├─ (xy.x = cte.s)\n" +
+			"             ├─ IndexedTableAccess(xy)\n" +
+			"             │   ├─ index: [xy.x]\n" +
+			"             │   ├─ filters: [{[1, 1]}]\n" +
+			"             │   └─ columns: [x]\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: cte\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ isLateral: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ RecursiveCTE\n" +
+			"                         └─ Union distinct\n" +
+			"                             ├─ Project\n" +
+			"                             │   ├─ columns: [1]\n" +
+			"                             │   └─ Table\n" +
+			"                             │       └─ name: \n" +
+			"                             └─ Project\n" +
+			"                                 ├─ columns: [xy.x]\n" +
+			"                                 └─ LookupJoin\n" +
+			"                                     ├─ RecursiveTable(cte)\n" +
+			"                                     └─ IndexedTableAccess(xy)\n" +
+			"                                         ├─ index: [xy.x]\n" +
+			"                                         ├─ columns: [x]\n" +
+			"                                         └─ keys: cte.s\n" +
 			"",
 	},
 	{
@@ -2074,7 +2078,7 @@ Select * from (
 			"                     ├─ tableId: 5\n" +
 			"                     └─ Table\n" +
 			"                         ├─ name: xy\n" +
-			"                         └─ columns: [x y]\n" +
+			"                         └─ columns: [x]\n" +
 			"",
 		ExpectedEstimates: "SubqueryAlias\n" +
 			" ├─ name: dt\n" +
@@ -2137,6 +2141,7 @@ Select * from (
 			"                 │                           └─ keys: cte.s\n" +
 			"                 └─ IndexedTableAccess(xy)\n" +
 			"                     ├─ index: [xy.x]\n" +
+			"                     ├─ columns: [x]\n" +
 			"                     └─ keys: cte.s\n" +
 			"",
 		ExpectedAnalysis: "SubqueryAlias\n" +
@@ -2200,6 +2205,7 @@ Select * from (
 			"                 │                           └─ keys: cte.s\n" +
 			"                 └─ IndexedTableAccess(xy)\n" +
 			"                     ├─ index: [xy.x]\n" +
+			"                     ├─ columns: [x]\n" +
 			"                     └─ keys: cte.s\n" +
 			"",
 	},
@@ -2223,7 +2229,8 @@ Select * from (
 			"",
 		ExpectedEstimates: "SemiLookupJoin (estimated cost=3334.539 rows=1000)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: xy\n" +
+			" │   ├─ name: xy\n" +
+			" │   └─ columns: [x y]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [ab.a]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
@@ -2232,7 +2239,8 @@ Select * from (
 			"",
 		ExpectedAnalysis: "SemiLookupJoin (estimated cost=3334.539 rows=1000) (actual rows=4 loops=1)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: xy\n" +
+			" │   ├─ name: xy\n" +
+			" │   └─ columns: [x y]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [ab.a]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
@@ -2299,6 +2307,7 @@ Select * from (
 			"     │               └─ columns: [u]\n" +
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
+			"         ├─ columns: [x y]\n" +
 			"         └─ keys: ab.a\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -2320,6 +2329,7 @@ Select * from (
 			"     │               └─ columns: [u]\n" +
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
+			"         ├─ columns: [x y]\n" +
 			"         └─ keys: ab.a\n" +
 			"",
 	},
@@ -2351,16 +2361,20 @@ Select * from (
 		ExpectedEstimates: "AntiJoinIncludingNulls (estimated cost=2024.000 rows=5)\n" +
 			" ├─ ((NOT(EXISTS Subquery(select * from xy where not (u = 1)))) OR (uv.u = 1))\n" +
 			" ├─ Table\n" +
-			" │   └─ name: uv\n" +
+			" │   ├─ name: uv\n" +
+			" │   └─ columns: [u v]\n" +
 			" └─ Table\n" +
-			"     └─ name: xy\n" +
+			"     ├─ name: xy\n" +
+			"     └─ columns: [x y]\n" +
 			"",
 		ExpectedAnalysis: "AntiJoinIncludingNulls (estimated cost=2024.000 rows=5) (actual rows=3 loops=1)\n" +
 			" ├─ ((NOT(EXISTS Subquery(select * from xy where not (u = 1)))) OR (uv.u = 1))\n" +
 			" ├─ Table\n" +
-			" │   └─ name: uv\n" +
+			" │   ├─ name: uv\n" +
+			" │   └─ columns: [u v]\n" +
 			" └─ Table\n" +
-			"     └─ name: xy\n" +
+			"     ├─ name: xy\n" +
+			"     └─ columns: [x y]\n" +
 			"",
 	},
 	{
@@ -2368,89 +2382,82 @@ Select * from (
 	select (select u from uv where u = sq.p)
    from (select p from pq) sq);
 `,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [xy.x:0!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: xy.x:0!null\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       ├─ alias-string: select (select u from uv where u = sq.p) from (select p from pq) as sq\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [Subquery\n" +
-			"     │           │   ├─ cacheable: false\n" +
-			"     │           │   ├─ alias-string: select u from uv where u = sq.p\n" +
-			"     │           │   └─ Filter\n" +
-			"     │           │       ├─ Eq\n" +
-			"     │           │       │   ├─ uv.u:3!null\n" +
-			"     │           │       │   └─ sq.p:2!null\n" +
-			"     │           │       └─ IndexedTableAccess(uv)\n" +
-			"     │           │           ├─ index: [uv.u]\n" +
-			"     │           │           ├─ keys: [sq.p:2!null]\n" +
-			"     │           │           ├─ colSet: (6,7)\n" +
-			"     │           │           ├─ tableId: 4\n" +
-			"     │           │           └─ Table\n" +
-			"     │           │               ├─ name: uv\n" +
-			"     │           │               └─ columns: [u]\n" +
-			"     │           │  ->(select u from uv where u = sq.p):0]\n" +
-			"     │           └─ TableAlias(sq)\n" +
-			"     │               └─ Table\n" +
-			"     │                   ├─ name: pq\n" +
-			"     │                   ├─ columns: [p]\n" +
-			"     │                   ├─ colSet: (3,4)\n" +
-			"     │                   └─ tableId: 2\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: xy\n" +
-			"             └─ columns: [x y]\n" +
-			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [xy.x]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: xy.x\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [Subquery\n" +
-			"     │           │   ├─ cacheable: false\n" +
-			"     │           │   └─ Filter\n" +
-			"     │           │       ├─ (uv.u = sq.p)\n" +
-			"     │           │       └─ IndexedTableAccess(uv)\n" +
-			"     │           │           ├─ index: [uv.u]\n" +
-			"     │           │           ├─ columns: [u]\n" +
-			"     │           │           └─ keys: sq.p\n" +
-			"     │           │   as (select u from uv where u = sq.p)]\n" +
-			"     │           └─ TableAlias(sq)\n" +
-			"     │               └─ Table\n" +
-			"     │                   ├─ name: pq\n" +
-			"     │                   └─ columns: [p]\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: xy.x:0!null\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       ├─ alias-string: select (select u from uv where u = sq.p) from (select p from pq) as sq\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [Subquery\n" +
+			" │           │   ├─ cacheable: false\n" +
+			" │           │   ├─ alias-string: select u from uv where u = sq.p\n" +
+			" │           │   └─ Filter\n" +
+			" │           │       ├─ Eq\n" +
+			" │           │       │   ├─ uv.u:2!null\n" +
+			" │           │       │   └─ pq.p:1!null\n" +
+			" │           │       └─ IndexedTableAccess(uv)\n" +
+			" │           │           ├─ index: [uv.u]\n" +
+			" │           │           ├─ keys: [pq.p:1!null]\n" +
+			" │           │           ├─ colSet: (6,7)\n" +
+			" │           │           ├─ tableId: 4\n" +
+			" │           │           └─ Table\n" +
+			" │           │               ├─ name: uv\n" +
+			" │           │               └─ columns: [u]\n" +
+			" │           │  ->(select u from uv where u = sq.p):0]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: pq\n" +
+			" │               ├─ columns: [p]\n" +
+			" │               ├─ colSet: (3,4)\n" +
+			" │               └─ tableId: 2\n" +
+			" └─ ProcessTable\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [xy.x]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: xy.x\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [Subquery\n" +
-			"     │           │   ├─ cacheable: false\n" +
-			"     │           │   └─ Filter\n" +
-			"     │           │       ├─ (uv.u = sq.p)\n" +
-			"     │           │       └─ IndexedTableAccess(uv)\n" +
-			"     │           │           ├─ index: [uv.u]\n" +
-			"     │           │           ├─ columns: [u]\n" +
-			"     │           │           └─ keys: sq.p\n" +
-			"     │           │   as (select u from uv where u = sq.p)]\n" +
-			"     │           └─ TableAlias(sq)\n" +
-			"     │               └─ Table\n" +
-			"     │                   ├─ name: pq\n" +
-			"     │                   └─ columns: [p]\n" +
-			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+		ExpectedEstimates: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: xy.x\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [Subquery\n" +
+			" │           │   ├─ cacheable: false\n" +
+			" │           │   └─ Filter\n" +
+			" │           │       ├─ (uv.u = pq.p)\n" +
+			" │           │       └─ IndexedTableAccess(uv)\n" +
+			" │           │           ├─ index: [uv.u]\n" +
+			" │           │           ├─ columns: [u]\n" +
+			" │           │           └─ keys: pq.p\n" +
+			" │           │   as (select u from uv where u = sq.p)]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: pq\n" +
+			" │               └─ columns: [p]\n" +
+			" └─ Table\n" +
+			"     ├─ name: xy\n" +
+			"     └─ columns: [x]\n" +
+			"",
+		ExpectedAnalysis: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: xy.x\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [Subquery\n" +
+			" │           │   ├─ cacheable: false\n" +
+			" │           │   └─ Filter\n" +
+			" │           │       ├─ (uv.u = pq.p)\n" +
+			" │           │       └─ IndexedTableAccess(uv)\n" +
+			" │           │           ├─ index: [uv.u]\n" +
+			" │           │           ├─ columns: [u]\n" +
+			" │           │           └─ keys: pq.p\n" +
+			" │           │   as (select u from uv where u = sq.p)]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: pq\n" +
+			" │               └─ columns: [p]\n" +
+			" └─ Table\n" +
+			"     ├─ name: xy\n" +
+			"     └─ columns: [x]\n" +
 			"",
 	},
 	{
@@ -2493,6 +2500,7 @@ Select * from (
 			"     │           └─ filters: [{[second, second]}]\n" +
 			"     └─ IndexedTableAccess(mytable)\n" +
 			"         ├─ index: [mytable.i]\n" +
+			"         ├─ columns: [i s]\n" +
 			"         └─ keys: othertable.i2\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -2507,6 +2515,7 @@ Select * from (
 			"     │           └─ filters: [{[second, second]}]\n" +
 			"     └─ IndexedTableAccess(mytable)\n" +
 			"         ├─ index: [mytable.i]\n" +
+			"         ├─ columns: [i s]\n" +
 			"         └─ keys: othertable.i2\n" +
 			"",
 	},
@@ -2536,7 +2545,8 @@ Select * from (
 			"     └─ SemiJoin (estimated cost=7.545 rows=3)\n" +
 			"         ├─ (mytable.i = othertable.i2)\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: mytable\n" +
+			"         │   ├─ name: mytable\n" +
+			"         │   └─ columns: [i s]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [othertable.i2]\n" +
 			"             └─ Table\n" +
@@ -2548,7 +2558,8 @@ Select * from (
 			"     └─ SemiJoin (estimated cost=7.545 rows=3) (actual rows=3 loops=1)\n" +
 			"         ├─ (mytable.i = othertable.i2)\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: mytable\n" +
+			"         │   ├─ name: mytable\n" +
+			"         │   └─ columns: [i s]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [othertable.i2]\n" +
 			"             └─ Table\n" +
@@ -2939,31 +2950,29 @@ Select * from (
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
 			" │       ├─ alias-string: select x from xy where x in (select u from uv where u = a)\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.x:2!null]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.x:2!null\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       ├─ alias-string: select u from uv where u = a\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ Eq\n" +
-			" │               │           │   ├─ uv.u:4!null\n" +
-			" │               │           │   └─ ab.a:0!null\n" +
-			" │               │           └─ IndexedTableAccess(uv)\n" +
-			" │               │               ├─ index: [uv.u]\n" +
-			" │               │               ├─ keys: [ab.a:0!null]\n" +
-			" │               │               ├─ colSet: (5,6)\n" +
-			" │               │               ├─ tableId: 3\n" +
-			" │               │               └─ Table\n" +
-			" │               │                   ├─ name: uv\n" +
-			" │               │                   └─ columns: [u]\n" +
-			" │               └─ Table\n" +
-			" │                   ├─ name: xy\n" +
-			" │                   ├─ columns: [x y]\n" +
-			" │                   ├─ colSet: (3,4)\n" +
-			" │                   └─ tableId: 2\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.x:2!null\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       ├─ alias-string: select u from uv where u = a\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ Eq\n" +
+			" │           │           │   ├─ uv.u:3!null\n" +
+			" │           │           │   └─ ab.a:0!null\n" +
+			" │           │           └─ IndexedTableAccess(uv)\n" +
+			" │           │               ├─ index: [uv.u]\n" +
+			" │           │               ├─ keys: [ab.a:0!null]\n" +
+			" │           │               ├─ colSet: (5,6)\n" +
+			" │           │               ├─ tableId: 3\n" +
+			" │           │               └─ Table\n" +
+			" │           │                   ├─ name: uv\n" +
+			" │           │                   └─ columns: [u]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               ├─ columns: [x]\n" +
+			" │               ├─ colSet: (3,4)\n" +
+			" │               └─ tableId: 2\n" +
 			" └─ ProcessTable\n" +
 			"     └─ Table\n" +
 			"         ├─ name: ab\n" +
@@ -2974,46 +2983,46 @@ Select * from (
 			" │   ├─ left: ab.a\n" +
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.x]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.x\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ (uv.u = ab.a)\n" +
-			" │               │           └─ IndexedTableAccess(uv)\n" +
-			" │               │               ├─ index: [uv.u]\n" +
-			" │               │               ├─ columns: [u]\n" +
-			" │               │               └─ keys: ab.a\n" +
-			" │               └─ Table\n" +
-			" │                   └─ name: xy\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.x\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ (uv.u = ab.a)\n" +
+			" │           │           └─ IndexedTableAccess(uv)\n" +
+			" │           │               ├─ index: [uv.u]\n" +
+			" │           │               ├─ columns: [u]\n" +
+			" │           │               └─ keys: ab.a\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               └─ columns: [x]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 		ExpectedAnalysis: "Filter\n" +
 			" ├─ InSubquery\n" +
 			" │   ├─ left: ab.a\n" +
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.x]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.x\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ (uv.u = ab.a)\n" +
-			" │               │           └─ IndexedTableAccess(uv)\n" +
-			" │               │               ├─ index: [uv.u]\n" +
-			" │               │               ├─ columns: [u]\n" +
-			" │               │               └─ keys: ab.a\n" +
-			" │               └─ Table\n" +
-			" │                   └─ name: xy\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.x\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ (uv.u = ab.a)\n" +
+			" │           │           └─ IndexedTableAccess(uv)\n" +
+			" │           │               ├─ index: [uv.u]\n" +
+			" │           │               ├─ columns: [u]\n" +
+			" │           │               └─ keys: ab.a\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               └─ columns: [x]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 	},
 	{
@@ -3024,28 +3033,26 @@ Select * from (
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
 			" │       ├─ alias-string: select y from xy where y in (select v from uv where v = a)\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.y:3]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.y:3\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       ├─ alias-string: select v from uv where v = a\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ Eq\n" +
-			" │               │           │   ├─ uv.v:4\n" +
-			" │               │           │   └─ ab.a:0!null\n" +
-			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
-			" │               │               ├─ columns: [v]\n" +
-			" │               │               ├─ colSet: (5,6)\n" +
-			" │               │               └─ tableId: 3\n" +
-			" │               └─ Table\n" +
-			" │                   ├─ name: xy\n" +
-			" │                   ├─ columns: [x y]\n" +
-			" │                   ├─ colSet: (3,4)\n" +
-			" │                   └─ tableId: 2\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.y:2\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       ├─ alias-string: select v from uv where v = a\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ Eq\n" +
+			" │           │           │   ├─ uv.v:3\n" +
+			" │           │           │   └─ ab.a:0!null\n" +
+			" │           │           └─ Table\n" +
+			" │           │               ├─ name: uv\n" +
+			" │           │               ├─ columns: [v]\n" +
+			" │           │               ├─ colSet: (5,6)\n" +
+			" │           │               └─ tableId: 3\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               ├─ columns: [y]\n" +
+			" │               ├─ colSet: (3,4)\n" +
+			" │               └─ tableId: 2\n" +
 			" └─ ProcessTable\n" +
 			"     └─ Table\n" +
 			"         ├─ name: ab\n" +
@@ -3056,44 +3063,44 @@ Select * from (
 			" │   ├─ left: ab.a\n" +
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.y]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.y\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ (uv.v = ab.a)\n" +
-			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
-			" │               │               └─ columns: [v]\n" +
-			" │               └─ Table\n" +
-			" │                   └─ name: xy\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.y\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ (uv.v = ab.a)\n" +
+			" │           │           └─ Table\n" +
+			" │           │               ├─ name: uv\n" +
+			" │           │               └─ columns: [v]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               └─ columns: [y]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 		ExpectedAnalysis: "Filter\n" +
 			" ├─ InSubquery\n" +
 			" │   ├─ left: ab.a\n" +
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.y]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.y\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ (uv.v = ab.a)\n" +
-			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
-			" │               │               └─ columns: [v]\n" +
-			" │               └─ Table\n" +
-			" │                   └─ name: xy\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.y\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ (uv.v = ab.a)\n" +
+			" │           │           └─ Table\n" +
+			" │           │               ├─ name: uv\n" +
+			" │           │               └─ columns: [v]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               └─ columns: [y]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 	},
 	{
@@ -3104,28 +3111,26 @@ Select * from (
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
 			" │       ├─ alias-string: select y from xy where y in (select v from uv where v = b)\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.y:3]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.y:3\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       ├─ alias-string: select v from uv where v = b\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ Eq\n" +
-			" │               │           │   ├─ uv.v:4\n" +
-			" │               │           │   └─ ab.b:1\n" +
-			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
-			" │               │               ├─ columns: [v]\n" +
-			" │               │               ├─ colSet: (5,6)\n" +
-			" │               │               └─ tableId: 3\n" +
-			" │               └─ Table\n" +
-			" │                   ├─ name: xy\n" +
-			" │                   ├─ columns: [x y]\n" +
-			" │                   ├─ colSet: (3,4)\n" +
-			" │                   └─ tableId: 2\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.y:2\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       ├─ alias-string: select v from uv where v = b\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ Eq\n" +
+			" │           │           │   ├─ uv.v:3\n" +
+			" │           │           │   └─ ab.b:1\n" +
+			" │           │           └─ Table\n" +
+			" │           │               ├─ name: uv\n" +
+			" │           │               ├─ columns: [v]\n" +
+			" │           │               ├─ colSet: (5,6)\n" +
+			" │           │               └─ tableId: 3\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               ├─ columns: [y]\n" +
+			" │               ├─ colSet: (3,4)\n" +
+			" │               └─ tableId: 2\n" +
 			" └─ ProcessTable\n" +
 			"     └─ Table\n" +
 			"         ├─ name: ab\n" +
@@ -3136,84 +3141,82 @@ Select * from (
 			" │   ├─ left: ab.b\n" +
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.y]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.y\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ (uv.v = ab.b)\n" +
-			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
-			" │               │               └─ columns: [v]\n" +
-			" │               └─ Table\n" +
-			" │                   └─ name: xy\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.y\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ (uv.v = ab.b)\n" +
+			" │           │           └─ Table\n" +
+			" │           │               ├─ name: uv\n" +
+			" │           │               └─ columns: [v]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               └─ columns: [y]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 		ExpectedAnalysis: "Filter\n" +
 			" ├─ InSubquery\n" +
 			" │   ├─ left: ab.b\n" +
 			" │   └─ right: Subquery\n" +
 			" │       ├─ cacheable: false\n" +
-			" │       └─ Project\n" +
-			" │           ├─ columns: [xy.y]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ InSubquery\n" +
-			" │               │   ├─ left: xy.y\n" +
-			" │               │   └─ right: Subquery\n" +
-			" │               │       ├─ cacheable: false\n" +
-			" │               │       └─ Filter\n" +
-			" │               │           ├─ (uv.v = ab.b)\n" +
-			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
-			" │               │               └─ columns: [v]\n" +
-			" │               └─ Table\n" +
-			" │                   └─ name: xy\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ InSubquery\n" +
+			" │           │   ├─ left: xy.y\n" +
+			" │           │   └─ right: Subquery\n" +
+			" │           │       ├─ cacheable: false\n" +
+			" │           │       └─ Filter\n" +
+			" │           │           ├─ (uv.v = ab.b)\n" +
+			" │           │           └─ Table\n" +
+			" │           │               ├─ name: uv\n" +
+			" │           │               └─ columns: [v]\n" +
+			" │           └─ Table\n" +
+			" │               ├─ name: xy\n" +
+			" │               └─ columns: [y]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 	},
 	{
 		Query: `select ab.* from ab join pq on a = p where b = (select y from xy where y in (select v from uv where v = b)) order by a;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [ab.a:2!null, ab.b:3]\n" +
-			" └─ Sort(ab.a:2!null ASC nullsFirst)\n" +
+			" ├─ columns: [ab.a:1!null, ab.b:2]\n" +
+			" └─ Sort(ab.a:1!null ASC nullsFirst)\n" +
 			"     └─ Filter\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ ab.b:3\n" +
+			"         │   ├─ ab.b:2\n" +
 			"         │   └─ Subquery\n" +
 			"         │       ├─ cacheable: false\n" +
 			"         │       ├─ alias-string: select y from xy where y in (select v from uv where v = b)\n" +
-			"         │       └─ Project\n" +
-			"         │           ├─ columns: [xy.y:5]\n" +
-			"         │           └─ Filter\n" +
-			"         │               ├─ InSubquery\n" +
-			"         │               │   ├─ left: xy.y:5\n" +
-			"         │               │   └─ right: Subquery\n" +
-			"         │               │       ├─ cacheable: false\n" +
-			"         │               │       ├─ alias-string: select v from uv where v = b\n" +
-			"         │               │       └─ Filter\n" +
-			"         │               │           ├─ Eq\n" +
-			"         │               │           │   ├─ uv.v:6\n" +
-			"         │               │           │   └─ ab.b:3\n" +
-			"         │               │           └─ Table\n" +
-			"         │               │               ├─ name: uv\n" +
-			"         │               │               ├─ columns: [v]\n" +
-			"         │               │               ├─ colSet: (7,8)\n" +
-			"         │               │               └─ tableId: 4\n" +
-			"         │               └─ Table\n" +
-			"         │                   ├─ name: xy\n" +
-			"         │                   ├─ columns: [x y]\n" +
-			"         │                   ├─ colSet: (5,6)\n" +
-			"         │                   └─ tableId: 3\n" +
+			"         │       └─ Filter\n" +
+			"         │           ├─ InSubquery\n" +
+			"         │           │   ├─ left: xy.y:3\n" +
+			"         │           │   └─ right: Subquery\n" +
+			"         │           │       ├─ cacheable: false\n" +
+			"         │           │       ├─ alias-string: select v from uv where v = b\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ Eq\n" +
+			"         │           │           │   ├─ uv.v:4\n" +
+			"         │           │           │   └─ ab.b:2\n" +
+			"         │           │           └─ Table\n" +
+			"         │           │               ├─ name: uv\n" +
+			"         │           │               ├─ columns: [v]\n" +
+			"         │           │               ├─ colSet: (7,8)\n" +
+			"         │           │               └─ tableId: 4\n" +
+			"         │           └─ Table\n" +
+			"         │               ├─ name: xy\n" +
+			"         │               ├─ columns: [y]\n" +
+			"         │               ├─ colSet: (5,6)\n" +
+			"         │               └─ tableId: 3\n" +
 			"         └─ LookupJoin\n" +
 			"             ├─ ProcessTable\n" +
 			"             │   └─ Table\n" +
 			"             │       ├─ name: pq\n" +
-			"             │       └─ columns: [p q]\n" +
+			"             │       └─ columns: [p]\n" +
 			"             └─ IndexedTableAccess(ab)\n" +
 			"                 ├─ index: [ab.a]\n" +
 			"                 ├─ keys: [pq.p:0!null]\n" +
@@ -3230,9 +3233,11 @@ Select * from (
 			"         ├─ (ab.b = Subquery(select y from xy where y in (select v from uv where v = b)))\n" +
 			"         └─ LookupJoin (estimated cost=13.338 rows=4)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: pq\n" +
+			"             │   ├─ name: pq\n" +
+			"             │   └─ columns: [p]\n" +
 			"             └─ IndexedTableAccess(ab)\n" +
 			"                 ├─ index: [ab.a]\n" +
+			"                 ├─ columns: [a b]\n" +
 			"                 └─ keys: pq.p\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -3242,9 +3247,11 @@ Select * from (
 			"         ├─ (ab.b = Subquery(select y from xy where y in (select v from uv where v = b)))\n" +
 			"         └─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: pq\n" +
+			"             │   ├─ name: pq\n" +
+			"             │   └─ columns: [p]\n" +
 			"             └─ IndexedTableAccess(ab)\n" +
 			"                 ├─ index: [ab.a]\n" +
+			"                 ├─ columns: [a b]\n" +
 			"                 └─ keys: pq.p\n" +
 			"",
 	},
@@ -3338,9 +3345,11 @@ Select * from (
 			"     └─ Sort(xy.y ASC)\n" +
 			"         └─ LookupJoin (estimated cost=13.338 rows=4)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: uv\n" +
+			"             │   ├─ name: uv\n" +
+			"             │   └─ columns: [u v]\n" +
 			"             └─ IndexedTableAccess(xy)\n" +
 			"                 ├─ index: [xy.x]\n" +
+			"                 ├─ columns: [x y]\n" +
 			"                 └─ keys: uv.v\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -3370,9 +3379,11 @@ Select * from (
 			"     └─ Sort(xy.y ASC)\n" +
 			"         └─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: uv\n" +
+			"             │   ├─ name: uv\n" +
+			"             │   └─ columns: [u v]\n" +
 			"             └─ IndexedTableAccess(xy)\n" +
 			"                 ├─ index: [xy.x]\n" +
+			"                 ├─ columns: [x y]\n" +
 			"                 └─ keys: uv.v\n" +
 			"",
 	},
@@ -3463,9 +3474,11 @@ Select * from (
 			"             │   as is_one]\n" +
 			"             └─ LookupJoin (estimated cost=13.338 rows=4)\n" +
 			"                 ├─ Table\n" +
-			"                 │   └─ name: uv\n" +
+			"                 │   ├─ name: uv\n" +
+			"                 │   └─ columns: [u v]\n" +
 			"                 └─ IndexedTableAccess(xy)\n" +
 			"                     ├─ index: [xy.x]\n" +
+			"                     ├─ columns: [x y]\n" +
 			"                     └─ keys: uv.v\n" +
 			"",
 		ExpectedAnalysis: "Sort(sq.y ASC)\n" +
@@ -3498,9 +3511,11 @@ Select * from (
 			"             │   as is_one]\n" +
 			"             └─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
 			"                 ├─ Table\n" +
-			"                 │   └─ name: uv\n" +
+			"                 │   ├─ name: uv\n" +
+			"                 │   └─ columns: [u v]\n" +
 			"                 └─ IndexedTableAccess(xy)\n" +
 			"                     ├─ index: [xy.x]\n" +
+			"                     ├─ columns: [x y]\n" +
 			"                     └─ keys: uv.v\n" +
 			"",
 	},
@@ -3574,9 +3589,11 @@ Select * from (
 			"     │   as is_one]\n" +
 			"     └─ LookupJoin (estimated cost=13.338 rows=4)\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: uv\n" +
+			"         │   ├─ name: uv\n" +
+			"         │   └─ columns: [u v]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
+			"             ├─ columns: [x y]\n" +
 			"             └─ keys: uv.v\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -3601,70 +3618,46 @@ Select * from (
 			"     │   as is_one]\n" +
 			"     └─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: uv\n" +
+			"         │   ├─ name: uv\n" +
+			"         │   └─ columns: [u v]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
+			"             ├─ columns: [x y]\n" +
 			"             └─ keys: uv.v\n" +
 			"",
 	},
 	{
 		Query: `SELECT a FROM (select i,s FROM mytable) mt (a,b) order by 1;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [mt.a:0!null]\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: mt\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ isLateral: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     ├─ colSet: (3,4)\n" +
-			"     ├─ tableId: 2\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
-			"         ├─ static: [{[NULL, ∞)}]\n" +
-			"         ├─ colSet: (1,2)\n" +
-			"         ├─ tableId: 1\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i s]\n" +
+			" ├─ columns: [mytable.i:0!null->a:0]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     ├─ colSet: (1,2)\n" +
+			"     ├─ tableId: 1\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [mt.a]\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: mt\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ isLateral: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     ├─ colSet: (3,4)\n" +
-			"     ├─ tableId: 2\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
-			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [i s]\n" +
+			" ├─ columns: [mytable.i as a]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ filters: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [mt.a]\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: mt\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ isLateral: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     ├─ colSet: (3,4)\n" +
-			"     ├─ tableId: 2\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
-			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [i s]\n" +
+			" ├─ columns: [mytable.i as a]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ filters: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM (select t.i,t.s FROM mytable t) mt order by i;`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: mt\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ isLateral: false\n" +
-			" ├─ cacheable: true\n" +
-			" ├─ colSet: (3,4)\n" +
-			" ├─ tableId: 2\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [t.i:0!null->i:0, t.s:1!null->s:0]\n" +
 			" └─ TableAlias(t)\n" +
 			"     └─ IndexedTableAccess(mytable)\n" +
 			"         ├─ index: [mytable.i]\n" +
@@ -3675,26 +3668,16 @@ Select * from (
 			"             ├─ name: mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
-		ExpectedEstimates: "SubqueryAlias\n" +
-			" ├─ name: mt\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ isLateral: false\n" +
-			" ├─ cacheable: true\n" +
-			" ├─ colSet: (3,4)\n" +
-			" ├─ tableId: 2\n" +
+		ExpectedEstimates: "Project\n" +
+			" ├─ columns: [t.i as i, t.s as s]\n" +
 			" └─ TableAlias(t)\n" +
 			"     └─ IndexedTableAccess(mytable)\n" +
 			"         ├─ index: [mytable.i]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
-		ExpectedAnalysis: "SubqueryAlias\n" +
-			" ├─ name: mt\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ isLateral: false\n" +
-			" ├─ cacheable: true\n" +
-			" ├─ colSet: (3,4)\n" +
-			" ├─ tableId: 2\n" +
+		ExpectedAnalysis: "Project\n" +
+			" ├─ columns: [t.i as i, t.s as s]\n" +
 			" └─ TableAlias(t)\n" +
 			"     └─ IndexedTableAccess(mytable)\n" +
 			"         ├─ index: [mytable.i]\n" +
@@ -3873,7 +3856,8 @@ Select * from (
 			"         └─ LeftOuterHashJoinExcludingNulls (estimated cost=1320.000 rows=1000)\n" +
 			"             ├─ (xy.x = cte1.u)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: xy\n" +
+			"             │   ├─ name: xy\n" +
+			"             │   └─ columns: [x y]\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (xy.x)\n" +
 			"                 ├─ right-key: (cte1.u)\n" +
@@ -3921,7 +3905,8 @@ Select * from (
 			"         └─ LeftOuterHashJoinExcludingNulls (estimated cost=1320.000 rows=1000) (actual rows=12 loops=1)\n" +
 			"             ├─ (xy.x = cte1.u)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: xy\n" +
+			"             │   ├─ name: xy\n" +
+			"             │   └─ columns: [x y]\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ left-key: (xy.x)\n" +
 			"                 ├─ right-key: (cte1.u)\n" +
@@ -4434,7 +4419,7 @@ Select * from (
 			" │                   ├─ tableId: 2\n" +
 			" │                   └─ Table\n" +
 			" │                       ├─ name: ab\n" +
-			" │                       └─ columns: [a b]\n" +
+			" │                       └─ columns: [a]\n" +
 			" │  ->s:0]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [xy.x:0!null, xy.y:1, InSubquery\n" +
@@ -4463,7 +4448,7 @@ Select * from (
 			"     │                   ├─ tableId: 2\n" +
 			"     │                   └─ Table\n" +
 			"     │                       ├─ name: ab\n" +
-			"     │                       └─ columns: [a b]\n" +
+			"     │                       └─ columns: [a]\n" +
 			"     │  ->s:0]\n" +
 			"     └─ ProcessTable\n" +
 			"         └─ Table\n" +
@@ -4487,6 +4472,7 @@ Select * from (
 			" │               │           └─ columns: [u v]\n" +
 			" │               └─ IndexedTableAccess(ab)\n" +
 			" │                   ├─ index: [ab.a]\n" +
+			" │                   ├─ columns: [a]\n" +
 			" │                   └─ keys: uv.u\n" +
 			" │   as s]\n" +
 			" └─ Project\n" +
@@ -4506,10 +4492,12 @@ Select * from (
 			"     │               │           └─ columns: [u v]\n" +
 			"     │               └─ IndexedTableAccess(ab)\n" +
 			"     │                   ├─ index: [ab.a]\n" +
+			"     │                   ├─ columns: [a]\n" +
 			"     │                   └─ keys: uv.u\n" +
 			"     │   as s]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [xy.x, InSubquery\n" +
@@ -4528,6 +4516,7 @@ Select * from (
 			" │               │           └─ columns: [u v]\n" +
 			" │               └─ IndexedTableAccess(ab)\n" +
 			" │                   ├─ index: [ab.a]\n" +
+			" │                   ├─ columns: [a]\n" +
 			" │                   └─ keys: uv.u\n" +
 			" │   as s]\n" +
 			" └─ Project\n" +
@@ -4547,10 +4536,12 @@ Select * from (
 			"     │               │           └─ columns: [u v]\n" +
 			"     │               └─ IndexedTableAccess(ab)\n" +
 			"     │                   ├─ index: [ab.a]\n" +
+			"     │                   ├─ columns: [a]\n" +
 			"     │                   └─ keys: uv.u\n" +
 			"     │   as s]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 	},
 	{
@@ -4619,7 +4610,8 @@ Select * from (
 			"     │       └─ columns: [u v]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
-			"         └─ filters: [{[1, 1]}]\n" +
+			"         ├─ filters: [{[1, 1]}]\n" +
+			"         └─ columns: [a b]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [ab.a, ab.b]\n" +
@@ -4630,7 +4622,8 @@ Select * from (
 			"     │       └─ columns: [u v]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
-			"         └─ filters: [{[1, 1]}]\n" +
+			"         ├─ filters: [{[1, 1]}]\n" +
+			"         └─ columns: [a b]\n" +
 			"",
 	},
 	{
@@ -4663,7 +4656,8 @@ Select * from (
 			"     │           ├─ filters: [{[1, 1]}]\n" +
 			"     │           └─ columns: [a b]\n" +
 			"     └─ Table\n" +
-			"         └─ name: ab\n" +
+			"         ├─ name: ab\n" +
+			"         └─ columns: [a b]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [ab.a, ab.b]\n" +
@@ -4675,7 +4669,8 @@ Select * from (
 			"     │           ├─ filters: [{[1, 1]}]\n" +
 			"     │           └─ columns: [a b]\n" +
 			"     └─ Table\n" +
-			"         └─ name: ab\n" +
+			"         ├─ name: ab\n" +
+			"         └─ columns: [a b]\n" +
 			"",
 	},
 	{
@@ -4794,7 +4789,8 @@ Select * from (
 			" ├─ ((ab.a = 1) OR (s.a = 1))\n" +
 			" ├─ TableAlias(s)\n" +
 			" │   └─ Table\n" +
-			" │       └─ name: ab\n" +
+			" │       ├─ name: ab\n" +
+			" │       └─ columns: [a b]\n" +
 			" └─ Table\n" +
 			"     ├─ name: ab\n" +
 			"     └─ columns: [a b]\n" +
@@ -4803,7 +4799,8 @@ Select * from (
 			" ├─ ((ab.a = 1) OR (s.a = 1))\n" +
 			" ├─ TableAlias(s)\n" +
 			" │   └─ Table\n" +
-			" │       └─ name: ab\n" +
+			" │       ├─ name: ab\n" +
+			" │       └─ columns: [a b]\n" +
 			" └─ Table\n" +
 			"     ├─ name: ab\n" +
 			"     └─ columns: [a b]\n" +
@@ -4827,7 +4824,8 @@ Select * from (
 			"",
 		ExpectedEstimates: "SemiLookupJoin (estimated cost=13.338 rows=4)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: uv\n" +
+			" │   ├─ name: uv\n" +
+			" │   └─ columns: [u v]\n" +
 			" └─ IndexedTableAccess(ab)\n" +
 			"     ├─ index: [ab.a]\n" +
 			"     ├─ columns: [a]\n" +
@@ -4835,7 +4833,8 @@ Select * from (
 			"",
 		ExpectedAnalysis: "SemiLookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: uv\n" +
+			" │   ├─ name: uv\n" +
+			" │   └─ columns: [u v]\n" +
 			" └─ IndexedTableAccess(ab)\n" +
 			"     ├─ index: [ab.a]\n" +
 			"     ├─ columns: [a]\n" +
@@ -4853,7 +4852,7 @@ Select * from (
 			"         ├─ ProcessTable\n" +
 			"         │   └─ Table\n" +
 			"         │       ├─ name: ab\n" +
-			"         │       └─ columns: [a b]\n" +
+			"         │       └─ columns: [a]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
 			"             ├─ keys: [ab.a:0!null]\n" +
@@ -4870,7 +4869,8 @@ Select * from (
 			"     ├─ group: ab.a\n" +
 			"     └─ SemiLookupJoin (estimated cost=3334.539 rows=1000)\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: ab\n" +
+			"         │   ├─ name: ab\n" +
+			"         │   └─ columns: [a]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
 			"             ├─ columns: [x y]\n" +
@@ -4883,7 +4883,8 @@ Select * from (
 			"     ├─ group: ab.a\n" +
 			"     └─ SemiLookupJoin (estimated cost=3334.539 rows=1000) (actual rows=4 loops=1)\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: ab\n" +
+			"         │   ├─ name: ab\n" +
+			"         │   └─ columns: [a]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
 			"             ├─ columns: [x y]\n" +
@@ -5045,107 +5046,99 @@ Select * from (
 	},
 	{
 		Query: `SELECT pk FROM one_pk WHERE (pk, 123) IN (SELECT count(*) AS u, 123 AS v FROM emptytable);`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [one_pk.pk:0!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: TUPLE(one_pk.pk:0!null, 123 (tinyint))\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       ├─ alias-string: select count(*) u, 123 v from emptytable\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [count(1):6!null->u:0, 123 (tinyint)->v:11]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [emptytable.COUNT(1):6!null->COUNT(1):0]\n" +
-			"     │               └─ table_count(emptytable) as COUNT(1)\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: one_pk\n" +
-			"             └─ columns: [pk c1 c2 c3 c4 c5]\n" +
-			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [one_pk.pk]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: (one_pk.pk, 123)\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [count(1) as u, 123 as v]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [emptytable.COUNT(1) as COUNT(1)]\n" +
-			"     │               └─ table_count(emptytable) as COUNT(1)\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: TUPLE(one_pk.pk:0!null, 123 (tinyint))\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       ├─ alias-string: select count(*) u, 123 v from emptytable\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [count(1):1!null->u:0, 123 (tinyint)->v:11]\n" +
+			" │           └─ Project\n" +
+			" │               ├─ columns: [emptytable.COUNT(1):1!null->COUNT(1):0]\n" +
+			" │               └─ table_count(emptytable) as COUNT(1)\n" +
+			" └─ ProcessTable\n" +
 			"     └─ Table\n" +
-			"         └─ name: one_pk\n" +
+			"         ├─ name: one_pk\n" +
+			"         └─ columns: [pk]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [one_pk.pk]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: (one_pk.pk, 123)\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [count(1) as u, 123 as v]\n" +
-			"     │           └─ Project\n" +
-			"     │               ├─ columns: [emptytable.COUNT(1) as COUNT(1)]\n" +
-			"     │               └─ table_count(emptytable) as COUNT(1)\n" +
-			"     └─ Table\n" +
-			"         └─ name: one_pk\n" +
+		ExpectedEstimates: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: (one_pk.pk, 123)\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [count(1) as u, 123 as v]\n" +
+			" │           └─ Project\n" +
+			" │               ├─ columns: [emptytable.COUNT(1) as COUNT(1)]\n" +
+			" │               └─ table_count(emptytable) as COUNT(1)\n" +
+			" └─ Table\n" +
+			"     ├─ name: one_pk\n" +
+			"     └─ columns: [pk]\n" +
+			"",
+		ExpectedAnalysis: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: (one_pk.pk, 123)\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [count(1) as u, 123 as v]\n" +
+			" │           └─ Project\n" +
+			" │               ├─ columns: [emptytable.COUNT(1) as COUNT(1)]\n" +
+			" │               └─ table_count(emptytable) as COUNT(1)\n" +
+			" └─ Table\n" +
+			"     ├─ name: one_pk\n" +
+			"     └─ columns: [pk]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk FROM one_pk WHERE (pk, 123) IN (SELECT count(*) AS u, 123 AS v FROM mytable WHERE false);`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [one_pk.pk:0!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: TUPLE(one_pk.pk:0!null, 123 (tinyint))\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       ├─ alias-string: select count(*) u, 123 v from mytable where false\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [count(1):6!null->u:0, 123 (tinyint)->v:11]\n" +
-			"     │           └─ GroupBy\n" +
-			"     │               ├─ select: COUNT(1 (bigint))\n" +
-			"     │               ├─ group: \n" +
-			"     │               └─ EmptyTable\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: one_pk\n" +
-			"             └─ columns: [pk c1 c2 c3 c4 c5]\n" +
-			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [one_pk.pk]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: (one_pk.pk, 123)\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [count(1) as u, 123 as v]\n" +
-			"     │           └─ GroupBy\n" +
-			"     │               ├─ select: COUNT(1)\n" +
-			"     │               ├─ group: \n" +
-			"     │               └─ EmptyTable\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: TUPLE(one_pk.pk:0!null, 123 (tinyint))\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       ├─ alias-string: select count(*) u, 123 v from mytable where false\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [count(1):1!null->u:0, 123 (tinyint)->v:11]\n" +
+			" │           └─ GroupBy\n" +
+			" │               ├─ select: COUNT(1 (bigint))\n" +
+			" │               ├─ group: \n" +
+			" │               └─ EmptyTable\n" +
+			" └─ ProcessTable\n" +
 			"     └─ Table\n" +
-			"         └─ name: one_pk\n" +
+			"         ├─ name: one_pk\n" +
+			"         └─ columns: [pk]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [one_pk.pk]\n" +
-			" └─ Filter\n" +
-			"     ├─ InSubquery\n" +
-			"     │   ├─ left: (one_pk.pk, 123)\n" +
-			"     │   └─ right: Subquery\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ Project\n" +
-			"     │           ├─ columns: [count(1) as u, 123 as v]\n" +
-			"     │           └─ GroupBy\n" +
-			"     │               ├─ select: COUNT(1)\n" +
-			"     │               ├─ group: \n" +
-			"     │               └─ EmptyTable\n" +
-			"     └─ Table\n" +
-			"         └─ name: one_pk\n" +
+		ExpectedEstimates: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: (one_pk.pk, 123)\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [count(1) as u, 123 as v]\n" +
+			" │           └─ GroupBy\n" +
+			" │               ├─ select: COUNT(1)\n" +
+			" │               ├─ group: \n" +
+			" │               └─ EmptyTable\n" +
+			" └─ Table\n" +
+			"     ├─ name: one_pk\n" +
+			"     └─ columns: [pk]\n" +
+			"",
+		ExpectedAnalysis: "Filter\n" +
+			" ├─ InSubquery\n" +
+			" │   ├─ left: (one_pk.pk, 123)\n" +
+			" │   └─ right: Subquery\n" +
+			" │       ├─ cacheable: true\n" +
+			" │       └─ Project\n" +
+			" │           ├─ columns: [count(1) as u, 123 as v]\n" +
+			" │           └─ GroupBy\n" +
+			" │               ├─ select: COUNT(1)\n" +
+			" │               ├─ group: \n" +
+			" │               └─ EmptyTable\n" +
+			" └─ Table\n" +
+			"     ├─ name: one_pk\n" +
+			"     └─ columns: [pk]\n" +
 			"",
 	},
 	{
@@ -5172,7 +5165,7 @@ Select * from (
 			"         └─ ProcessTable\n" +
 			"             └─ Table\n" +
 			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [i s]\n" +
+			"                 └─ columns: [i]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [mytable.i]\n" +
@@ -5192,7 +5185,8 @@ Select * from (
 			"         ├─ left-key: ()\n" +
 			"         ├─ right-key: ()\n" +
 			"         └─ Table\n" +
-			"             └─ name: mytable\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [mytable.i]\n" +
@@ -5212,7 +5206,8 @@ Select * from (
 			"         ├─ left-key: ()\n" +
 			"         ├─ right-key: ()\n" +
 			"         └─ Table\n" +
-			"             └─ name: mytable\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -5278,43 +5273,37 @@ Select * from (
 	},
 	{
 		Query: `with cte(a,b) as (select * from ab) select * from xy where exists (select * from cte where a = x)`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ EXISTS Subquery\n" +
-			" │   ├─ cacheable: false\n" +
-			" │   ├─ alias-string: select * from cte where a = x\n" +
-			" │   └─ SubqueryAlias\n" +
-			" │       ├─ name: cte\n" +
-			" │       ├─ outerVisibility: true\n" +
-			" │       ├─ isLateral: true\n" +
-			" │       ├─ cacheable: false\n" +
-			" │       ├─ colSet: (7,8)\n" +
-			" │       ├─ tableId: 3\n" +
-			" │       └─ Filter\n" +
-			" │           ├─ Eq\n" +
-			" │           │   ├─ ab.a:2!null\n" +
-			" │           │   └─ xy.x:0!null\n" +
-			" │           └─ IndexedTableAccess(ab)\n" +
-			" │               ├─ index: [ab.a]\n" +
-			" │               ├─ keys: [xy.x:0!null]\n" +
-			" │               ├─ colSet: (1,2)\n" +
-			" │               ├─ tableId: 1\n" +
-			" │               └─ Table\n" +
-			" │                   ├─ name: ab\n" +
-			" │                   └─ columns: [a b]\n" +
-			" └─ ProcessTable\n" +
+		ExpectedPlan: "SemiLookupJoin\n" +
+			" ├─ ProcessTable\n" +
+			" │   └─ Table\n" +
+			" │       ├─ name: xy\n" +
+			" │       └─ columns: [x y]\n" +
+			" └─ IndexedTableAccess(ab)\n" +
+			"     ├─ index: [ab.a]\n" +
+			"     ├─ keys: [xy.x:0!null]\n" +
+			"     ├─ colSet: (1,2)\n" +
+			"     ├─ tableId: 1\n" +
 			"     └─ Table\n" +
-			"         ├─ name: xy\n" +
-			"         └─ columns: [x y]\n" +
-			"",
-		ExpectedEstimates: "Filter\n" +
-			" ├─ EXISTS Subquery(select * from cte where a = x)\n" +
-			" └─ Table\n" +
-			"     └─ name: xy\n" +
+			"         ├─ name: ab\n" +
+			"         └─ columns: [a b]\n" +
 			"",
-		ExpectedAnalysis: "Filter\n" +
-			" ├─ EXISTS Subquery(select * from cte where a = x)\n" +
-			" └─ Table\n" +
-			"     └─ name: xy\n" +
+		ExpectedEstimates: "SemiLookupJoin (estimated cost=3334.539 rows=1000)\n" +
+			" ├─ Table\n" +
+			" │   ├─ name: xy\n" +
+			" │   └─ columns: [x y]\n" +
+			" └─ IndexedTableAccess(ab)\n" +
+			"     ├─ index: [ab.a]\n" +
+			"     ├─ columns: [a b]\n" +
+			"     └─ keys: xy.x\n" +
+			"",
+		ExpectedAnalysis: "SemiLookupJoin (estimated cost=3334.539 rows=1000) (actual rows=4 loops=1)\n" +
+			" ├─ Table\n" +
+			" │   ├─ name: xy\n" +
+			" │   └─ columns: [x y]\n" +
+			" └─ IndexedTableAccess(ab)\n" +
+			"     ├─ index: [ab.a]\n" +
+			"     ├─ columns: [a b]\n" +
+			"     └─ keys: xy.x\n" +
 			"",
 	},
 	{
@@ -5337,7 +5326,8 @@ Select * from (
 		ExpectedEstimates: "Sort(xy.x ASC)\n" +
 			" └─ SemiLookupJoin (estimated cost=3334.539 rows=1000)\n" +
 			"     ├─ Table\n" +
-			"     │   └─ name: xy\n" +
+			"     │   ├─ name: xy\n" +
+			"     │   └─ columns: [x y]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
 			"         ├─ columns: [a b]\n" +
@@ -5346,7 +5336,8 @@ Select * from (
 		ExpectedAnalysis: "Sort(xy.x ASC)\n" +
 			" └─ SemiLookupJoin (estimated cost=3334.539 rows=1000) (actual rows=4 loops=1)\n" +
 			"     ├─ Table\n" +
-			"     │   └─ name: xy\n" +
+			"     │   ├─ name: xy\n" +
+			"     │   └─ columns: [x y]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
 			"         ├─ columns: [a b]\n" +
@@ -5373,7 +5364,8 @@ Select * from (
 		ExpectedEstimates: "TopN(Limit: [5]; xy.x ASC)\n" +
 			" └─ SemiLookupJoin\n" +
 			"     ├─ Table\n" +
-			"     │   └─ name: xy\n" +
+			"     │   ├─ name: xy\n" +
+			"     │   └─ columns: [x y]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
 			"         ├─ columns: [a b]\n" +
@@ -5382,7 +5374,8 @@ Select * from (
 		ExpectedAnalysis: "TopN(Limit: [5]; xy.x ASC)\n" +
 			" └─ SemiLookupJoin\n" +
 			"     ├─ Table\n" +
-			"     │   └─ name: xy\n" +
+			"     │   ├─ name: xy\n" +
+			"     │   └─ columns: [x y]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
 			"         ├─ columns: [a b]\n" +
@@ -5438,11 +5431,17 @@ inner join xy on a = x;`,
 			" │               └─ Distinct\n" +
 			" │                   └─ Project\n" +
 			" │                       ├─ columns: [pq.p:0!null]\n" +
-			" │                       └─ Table\n" +
-			" │                           ├─ name: pq\n" +
-			" │                           ├─ columns: [p q]\n" +
-			" │                           ├─ colSet: (5,6)\n" +
-			" │                           └─ tableId: 3\n" +
+			" │                       └─ LooseIndexScan\n" +
+			" │                           ├─ Using index for group-by\n" +
+			" │                           ├─ prefix: [pq.p]\n" +
+			" │                           └─ IndexedTableAccess(pq)\n" +
+			" │                               ├─ index: [pq.p]\n" +
+			" │                               ├─ static: [{[NULL, ∞)}]\n" +
+			" │                               ├─ colSet: (5,6)\n" +
+			" │                               ├─ tableId: 3\n" +
+			" │                               └─ Table\n" +
+			" │                                   ├─ name: pq\n" +
+			" │                                   └─ columns: [p q]\n" +
 			" └─ IndexedTableAccess(xy)\n" +
 			"     ├─ index: [xy.x]\n" +
 			"     ├─ keys: [alias2.a:0!null]\n" +
@@ -5468,19 +5467,25 @@ inner join xy on a = x;`,
 			" │           │   ├─ cmp: (ab.a = uv.u)\n" +
 			" │           │   ├─ IndexedTableAccess(ab)\n" +
 			" │           │   │   ├─ index: [ab.a]\n" +
-			" │           │   │   └─ filters: [{[NULL, ∞)}]\n" +
+			" │           │   │   ├─ filters: [{[NULL, ∞)}]\n" +
+			" │           │   │   └─ columns: [a b]\n" +
 			" │           │   └─ IndexedTableAccess(uv)\n" +
 			" │           │       ├─ index: [uv.u]\n" +
-			" │           │       └─ filters: [{[NULL, ∞)}]\n" +
+			" │           │       ├─ filters: [{[NULL, ∞)}]\n" +
+			" │           │       └─ columns: [u v]\n" +
 			" │           └─ HashLookup\n" +
 			" │               ├─ left-key: (uv.u)\n" +
 			" │               ├─ right-key: (pq.p)\n" +
 			" │               └─ Distinct\n" +
 			" │                   └─ Project\n" +
 			" │                       ├─ columns: [pq.p]\n" +
-			" │                       └─ Table\n" +
-			" │                           ├─ name: pq\n" +
-			" │                           └─ columns: [p q]\n" +
+			" │                       └─ LooseIndexScan\n" +
+			" │                           ├─ Using index for group-by\n" +
+			" │                           ├─ prefix: [pq.p]\n" +
+			" │                           └─ IndexedTableAccess(pq)\n" +
+			" │                               ├─ index: [pq.p]\n" +
+			" │                               ├─ filters: [{[NULL, ∞)}]\n" +
+			" │                               └─ columns: [p q]\n" +
 			" └─ IndexedTableAccess(xy)\n" +
 			"     ├─ index: [xy.x]\n" +
 			"     ├─ columns: [x y]\n" +
@@ -5502,19 +5507,25 @@ inner join xy on a = x;`,
 			" │           │   ├─ cmp: (ab.a = uv.u)\n" +
 			" │           │   ├─ IndexedTableAccess(ab)\n" +
 			" │           │   │   ├─ index: [ab.a]\n" +
-			" │           │   │   └─ filters: [{[NULL, ∞)}]\n" +
+			" │           │   │   ├─ filters: [{[NULL, ∞)}]\n" +
+			" │           │   │   └─ columns: [a b]\n" +
 			" │           │   └─ IndexedTableAccess(uv)\n" +
 			" │           │       ├─ index: [uv.u]\n" +
-			" │           │       └─ filters: [{[NULL, ∞)}]\n" +
+			" │           │       ├─ filters: [{[NULL, ∞)}]\n" +
+			" │           │       └─ columns: [u v]\n" +
 			" │           └─ HashLookup\n" +
 			" │               ├─ left-key: (uv.u)\n" +
 			" │               ├─ right-key: (pq.p)\n" +
 			" │               └─ Distinct\n" +
 			" │                   └─ Project\n" +
 			" │                       ├─ columns: [pq.p]\n" +
-			" │                       └─ Table\n" +
-			" │                           ├─ name: pq\n" +
-			" │                           └─ columns: [p q]\n" +
+			" │                       └─ LooseIndexScan\n" +
+			" │                           ├─ Using index for group-by\n" +
+			" │                           ├─ prefix: [pq.p]\n" +
+			" │                           └─ IndexedTableAccess(pq)\n" +
+			" │                               ├─ index: [pq.p]\n" +
+			" │                               ├─ filters: [{[NULL, ∞)}]\n" +
+			" │                               └─ columns: [p q]\n" +
 			" └─ IndexedTableAccess(xy)\n" +
 			"     ├─ index: [xy.x]\n" +
 			"     ├─ columns: [x y]\n" +
@@ -5587,6 +5598,7 @@ where exists
 			"     │               └─ columns: [p q]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
+			"         ├─ columns: [a b]\n" +
 			"         └─ keys: uv.u\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -5608,6 +5620,7 @@ where exists
 			"     │               └─ columns: [p q]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
+			"         ├─ columns: [a b]\n" +
 			"         └─ keys: uv.u\n" +
 			"",
 	},
@@ -5621,126 +5634,74 @@ select * from
 where exists (select * from pq where a = p)
 `,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [alias1.a:0!null, alias1.b:1]\n" +
-			" └─ HashJoin\n" +
+			" ├─ columns: [ab.a:1!null->a:0, ab.b:2->b:0]\n" +
+			" └─ AntiJoinIncludingNulls\n" +
 			"     ├─ Eq\n" +
-			"     │   ├─ alias1.a:0!null\n" +
-			"     │   └─ pq.p:2!null\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: alias1\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ isLateral: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   ├─ colSet: (5,6)\n" +
-			"     │   ├─ tableId: 3\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [ab.a:0!null, ab.b:1]\n" +
-			"     │       └─ Filter\n" +
-			"     │           ├─ uv.u:2!null IS NULL\n" +
-			"     │           └─ LeftOuterMergeJoin\n" +
-			"     │               ├─ cmp: Eq\n" +
-			"     │               │   ├─ ab.a:0!null\n" +
-			"     │               │   └─ uv.u:2!null\n" +
-			"     │               ├─ IndexedTableAccess(ab)\n" +
-			"     │               │   ├─ index: [ab.a]\n" +
-			"     │               │   ├─ static: [{[NULL, ∞)}]\n" +
-			"     │               │   ├─ colSet: (1,2)\n" +
-			"     │               │   ├─ tableId: 1\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       ├─ name: ab\n" +
-			"     │               │       └─ columns: [a b]\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [uv.u:0!null]\n" +
-			"     │                   └─ IndexedTableAccess(uv)\n" +
-			"     │                       ├─ index: [uv.u]\n" +
-			"     │                       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                       ├─ colSet: (3,4)\n" +
-			"     │                       ├─ tableId: 2\n" +
-			"     │                       └─ Table\n" +
-			"     │                           ├─ name: uv\n" +
-			"     │                           └─ columns: [u v]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(alias1.a:0!null)\n" +
-			"         ├─ right-key: TUPLE(pq.p:0!null)\n" +
-			"         └─ Distinct\n" +
-			"             └─ Project\n" +
-			"                 ├─ columns: [pq.p:0!null]\n" +
-			"                 └─ ProcessTable\n" +
-			"                     └─ Table\n" +
-			"                         ├─ name: pq\n" +
-			"                         └─ columns: [p q]\n" +
+			"     │   ├─ ab.a:1!null\n" +
+			"     │   └─ uv.u:3!null\n" +
+			"     ├─ LookupJoin\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ ab.a:1!null\n" +
+			"     │   │   └─ pq.p:0!null\n" +
+			"     │   ├─ OrderedDistinct\n" +
+			"     │   │   └─ Project\n" +
+			"     │   │       ├─ columns: [pq.p:0!null]\n" +
+			"     │   │       └─ ProcessTable\n" +
+			"     │   │           └─ Table\n" +
+			"     │   │               ├─ name: pq\n" +
+			"     │   │               └─ columns: [p q]\n" +
+			"     │   └─ IndexedTableAccess(ab)\n" +
+			"     │       ├─ index: [ab.a]\n" +
+			"     │       ├─ keys: [pq.p:0!null]\n" +
+			"     │       ├─ colSet: (1,2)\n" +
+			"     │       ├─ tableId: 1\n" +
+			"     │       └─ Table\n" +
+			"     │           ├─ name: ab\n" +
+			"     │           └─ columns: [a b]\n" +
+			"     └─ ProcessTable\n" +
+			"         └─ Table\n" +
+			"             ├─ name: uv\n" +
+			"             └─ columns: [u v]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [alias1.a, alias1.b]\n" +
-			" └─ HashJoin (estimated cost=114.000 rows=100)\n" +
-			"     ├─ (alias1.a = pq.p)\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: alias1\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ isLateral: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   ├─ colSet: (5,6)\n" +
-			"     │   ├─ tableId: 3\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [ab.a, ab.b]\n" +
-			"     │       └─ Filter\n" +
-			"     │           ├─ uv.u IS NULL\n" +
-			"     │           └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000)\n" +
-			"     │               ├─ cmp: (ab.a = uv.u)\n" +
-			"     │               ├─ IndexedTableAccess(ab)\n" +
-			"     │               │   ├─ index: [ab.a]\n" +
-			"     │               │   └─ filters: [{[NULL, ∞)}]\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [uv.u]\n" +
-			"     │                   └─ IndexedTableAccess(uv)\n" +
-			"     │                       ├─ index: [uv.u]\n" +
-			"     │                       ├─ filters: [{[NULL, ∞)}]\n" +
-			"     │                       └─ columns: [u v]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: (alias1.a)\n" +
-			"         ├─ right-key: (pq.p)\n" +
-			"         └─ Distinct\n" +
-			"             └─ Project\n" +
-			"                 ├─ columns: [pq.p]\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: pq\n" +
-			"                     └─ columns: [p q]\n" +
+			" ├─ columns: [ab.a as a, ab.b as b]\n" +
+			" └─ AntiJoinIncludingNulls (estimated cost=12.080 rows=850)\n" +
+			"     ├─ (ab.a = uv.u)\n" +
+			"     ├─ LookupJoin (estimated cost=13.338 rows=4)\n" +
+			"     │   ├─ (ab.a = pq.p)\n" +
+			"     │   ├─ OrderedDistinct\n" +
+			"     │   │   └─ Project\n" +
+			"     │   │       ├─ columns: [pq.p]\n" +
+			"     │   │       └─ Table\n" +
+			"     │   │           ├─ name: pq\n" +
+			"     │   │           └─ columns: [p q]\n" +
+			"     │   └─ IndexedTableAccess(ab)\n" +
+			"     │       ├─ index: [ab.a]\n" +
+			"     │       ├─ columns: [a b]\n" +
+			"     │       └─ keys: pq.p\n" +
+			"     └─ Table\n" +
+			"         ├─ name: uv\n" +
+			"         └─ columns: [u v]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [alias1.a, alias1.b]\n" +
-			" └─ HashJoin (estimated cost=114.000 rows=100) (actual rows=0 loops=1)\n" +
-			"     ├─ (alias1.a = pq.p)\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: alias1\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ isLateral: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   ├─ colSet: (5,6)\n" +
-			"     │   ├─ tableId: 3\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [ab.a, ab.b]\n" +
-			"     │       └─ Filter\n" +
-			"     │           ├─ uv.u IS NULL\n" +
-			"     │           └─ LeftOuterMergeJoin (estimated cost=1024.040 rows=1000) (actual rows=4 loops=1)\n" +
-			"     │               ├─ cmp: (ab.a = uv.u)\n" +
-			"     │               ├─ IndexedTableAccess(ab)\n" +
-			"     │               │   ├─ index: [ab.a]\n" +
-			"     │               │   └─ filters: [{[NULL, ∞)}]\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [uv.u]\n" +
-			"     │                   └─ IndexedTableAccess(uv)\n" +
-			"     │                       ├─ index: [uv.u]\n" +
-			"     │                       ├─ filters: [{[NULL, ∞)}]\n" +
-			"     │                       └─ columns: [u v]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: (alias1.a)\n" +
-			"         ├─ right-key: (pq.p)\n" +
-			"         └─ Distinct\n" +
-			"             └─ Project\n" +
-			"                 ├─ columns: [pq.p]\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: pq\n" +
-			"                     └─ columns: [p q]\n" +
+			" ├─ columns: [ab.a as a, ab.b as b]\n" +
+			" └─ AntiJoinIncludingNulls (estimated cost=12.080 rows=850) (actual rows=0 loops=1)\n" +
+			"     ├─ (ab.a = uv.u)\n" +
+			"     ├─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
+			"     │   ├─ (ab.a = pq.p)\n" +
+			"     │   ├─ OrderedDistinct\n" +
+			"     │   │   └─ Project\n" +
+			"     │   │       ├─ columns: [pq.p]\n" +
+			"     │   │       └─ Table\n" +
+			"     │   │           ├─ name: pq\n" +
+			"     │   │           └─ columns: [p q]\n" +
+			"     │   └─ IndexedTableAccess(ab)\n" +
+			"     │       ├─ index: [ab.a]\n" +
+			"     │       ├─ columns: [a b]\n" +
+			"     │       └─ keys: pq.p\n" +
+			"     └─ Table\n" +
+			"         ├─ name: uv\n" +
+			"         └─ columns: [u v]\n" +
 			"",
 	},
 	{
@@ -5927,62 +5888,47 @@ inner join pq on true
 	where exists (select * from uv where a = u)
 	`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [alias1.a:0!null, alias1.b:1, pq.p:2!null, pq.q:3]\n" +
+			" ├─ columns: [ab.a:0!null->a:0, ab.b:1->b:0, pq.p:2!null, pq.q:3]\n" +
 			" └─ LeftOuterHashJoin\n" +
 			"     ├─ Eq\n" +
-			"     │   ├─ alias1.a:0!null\n" +
+			"     │   ├─ ab.a:0!null\n" +
 			"     │   └─ pq.p:2!null\n" +
 			"     ├─ Project\n" +
-			"     │   ├─ columns: [alias1.a:0!null, alias1.b:1]\n" +
-			"     │   └─ HashJoin\n" +
-			"     │       ├─ Eq\n" +
-			"     │       │   ├─ alias1.a:0!null\n" +
-			"     │       │   └─ uv.u:2!null\n" +
-			"     │       ├─ SubqueryAlias\n" +
-			"     │       │   ├─ name: alias1\n" +
-			"     │       │   ├─ outerVisibility: false\n" +
-			"     │       │   ├─ isLateral: false\n" +
-			"     │       │   ├─ cacheable: true\n" +
-			"     │       │   ├─ colSet: (5,6)\n" +
-			"     │       │   ├─ tableId: 3\n" +
-			"     │       │   └─ Project\n" +
-			"     │       │       ├─ columns: [ab.a:0!null, ab.b:1]\n" +
-			"     │       │       └─ Filter\n" +
-			"     │       │           ├─ xy.x:2!null IS NULL\n" +
-			"     │       │           └─ LeftOuterMergeJoin\n" +
-			"     │       │               ├─ cmp: Eq\n" +
-			"     │       │               │   ├─ ab.a:0!null\n" +
-			"     │       │               │   └─ xy.x:2!null\n" +
-			"     │       │               ├─ IndexedTableAccess(ab)\n" +
-			"     │       │               │   ├─ index: [ab.a]\n" +
-			"     │       │               │   ├─ static: [{[NULL, ∞)}]\n" +
-			"     │       │               │   ├─ colSet: (1,2)\n" +
-			"     │       │               │   ├─ tableId: 1\n" +
-			"     │       │               │   └─ Table\n" +
-			"     │       │               │       ├─ name: ab\n" +
-			"     │       │               │       └─ columns: [a b]\n" +
-			"     │       │               └─ Project\n" +
-			"     │       │                   ├─ columns: [xy.x:0!null]\n" +
-			"     │       │                   └─ IndexedTableAccess(xy)\n" +
-			"     │       │                       ├─ index: [xy.x]\n" +
-			"     │       │                       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │       │                       ├─ colSet: (3,4)\n" +
-			"     │       │                       ├─ tableId: 2\n" +
-			"     │       │                       └─ Table\n" +
-			"     │       │                           ├─ name: xy\n" +
-			"     │       │                           └─ columns: [x y]\n" +
-			"     │       └─ HashLookup\n" +
-			"     │           ├─ left-key: TUPLE(alias1.a:0!null)\n" +
-			"     │           ├─ right-key: TUPLE(uv.u:0!null)\n" +
-			"     │           └─ Distinct\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [uv.u:0!null]\n" +
-			"     │                   └─ ProcessTable\n" +
-			"     │                       └─ Table\n" +
-			"     │                           ├─ name: uv\n" +
-			"     │                           └─ columns: [u v]\n" +
+			"     │   ├─ columns: [ab.a:1!null, ab.b:2]\n" +
+			"     │   └─ Filter\n" +
+			"     │       ├─ xy.x:3!null IS NULL\n" +
+			"     │       └─ LeftOuterLookupJoin\n" +
+			"     │           ├─ LookupJoin\n" +
+			"     │           │   ├─ Eq\n" +
+			"     │           │   │   ├─ ab.a:1!null\n" +
+			"     │           │   │   └─ uv.u:0!null\n" +
+			"     │           │   ├─ OrderedDistinct\n" +
+			"     │           │   │   └─ Project\n" +
+			"     │           │   │       ├─ columns: [uv.u:0!null]\n" +
+			"     │           │   │       └─ ProcessTable\n" +
+			"     │           │   │           └─ Table\n" +
+			"     │           │   │               ├─ name: uv\n" +
+			"     │           │   │               └─ columns: [u v]\n" +
+			"     │           │   └─ IndexedTableAccess(ab)\n" +
+			"     │           │       ├─ index: [ab.a]\n" +
+			"     │           │       ├─ keys: [uv.u:0!null]\n" +
+			"     │           │       ├─ colSet: (1,2)\n" +
+			"     │           │       ├─ tableId: 1\n" +
+			"     │           │       └─ Table\n" +
+			"     │           │           ├─ name: ab\n" +
+			"     │           │           └─ columns: [a b]\n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x:0!null]\n" +
+			"     │               └─ IndexedTableAccess(xy)\n" +
+			"     │                   ├─ index: [xy.x]\n" +
+			"     │                   ├─ keys: [ab.a:1!null]\n" +
+			"     │                   ├─ colSet: (3,4)\n" +
+			"     │                   ├─ tableId: 2\n" +
+			"     │                   └─ Table\n" +
+			"     │                       ├─ name: xy\n" +
+			"     │                       └─ columns: [x y]\n" +
 			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(alias1.a:0!null)\n" +
+			"         ├─ left-key: TUPLE(ab.a:0!null)\n" +
 			"         ├─ right-key: TUPLE(pq.p:0!null)\n" +
 			"         └─ ProcessTable\n" +
 			"             └─ Table\n" +
@@ -5990,139 +5936,113 @@ inner join pq on true
 			"                 └─ columns: [p q]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [alias1.a, alias1.b, pq.p, pq.q]\n" +
-			" └─ LeftOuterHashJoin (estimated cost=114.000 rows=100)\n" +
-			"     ├─ (alias1.a = pq.p)\n" +
+			" ├─ columns: [ab.a as a, ab.b as b, pq.p, pq.q]\n" +
+			" └─ LeftOuterHashJoin (estimated cost=879.000 rows=850)\n" +
+			"     ├─ (ab.a = pq.p)\n" +
 			"     ├─ Project\n" +
-			"     │   ├─ columns: [alias1.a, alias1.b]\n" +
-			"     │   └─ HashJoin (estimated cost=114.000 rows=100)\n" +
-			"     │       ├─ (alias1.a = uv.u)\n" +
-			"     │       ├─ SubqueryAlias\n" +
-			"     │       │   ├─ name: alias1\n" +
-			"     │       │   ├─ outerVisibility: false\n" +
-			"     │       │   ├─ isLateral: false\n" +
-			"     │       │   ├─ cacheable: true\n" +
-			"     │       │   ├─ colSet: (5,6)\n" +
-			"     │       │   ├─ tableId: 3\n" +
-			"     │       │   └─ Project\n" +
-			"     │       │       ├─ columns: [ab.a, ab.b]\n" +
-			"     │       │       └─ Filter\n" +
-			"     │       │           ├─ xy.x IS NULL\n" +
-			"     │       │           └─ LeftOuterMergeJoin (estimated cost=2030.000 rows=1000)\n" +
-			"     │       │               ├─ cmp: (ab.a = xy.x)\n" +
-			"     │       │               ├─ IndexedTableAccess(ab)\n" +
-			"     │       │               │   ├─ index: [ab.a]\n" +
-			"     │       │               │   └─ filters: [{[NULL, ∞)}]\n" +
-			"     │       │               └─ Project\n" +
-			"     │       │                   ├─ columns: [xy.x]\n" +
-			"     │       │                   └─ IndexedTableAccess(xy)\n" +
-			"     │       │                       ├─ index: [xy.x]\n" +
-			"     │       │                       ├─ filters: [{[NULL, ∞)}]\n" +
-			"     │       │                       └─ columns: [x y]\n" +
-			"     │       └─ HashLookup\n" +
-			"     │           ├─ left-key: (alias1.a)\n" +
-			"     │           ├─ right-key: (uv.u)\n" +
-			"     │           └─ Distinct\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [uv.u]\n" +
-			"     │                   └─ Table\n" +
-			"     │                       ├─ name: uv\n" +
-			"     │                       └─ columns: [u v]\n" +
+			"     │   ├─ columns: [ab.a, ab.b]\n" +
+			"     │   └─ Filter\n" +
+			"     │       ├─ xy.x IS NULL\n" +
+			"     │       └─ LeftOuterLookupJoin (estimated cost=13.338 rows=4)\n" +
+			"     │           ├─ LookupJoin (estimated cost=13.338 rows=4)\n" +
+			"     │           │   ├─ (ab.a = uv.u)\n" +
+			"     │           │   ├─ OrderedDistinct\n" +
+			"     │           │   │   └─ Project\n" +
+			"     │           │   │       ├─ columns: [uv.u]\n" +
+			"     │           │   │       └─ Table\n" +
+			"     │           │   │           ├─ name: uv\n" +
+			"     │           │   │           └─ columns: [u v]\n" +
+			"     │           │   └─ IndexedTableAccess(ab)\n" +
+			"     │           │       ├─ index: [ab.a]\n" +
+			"     │           │       ├─ columns: [a b]\n" +
+			"     │           │       └─ keys: uv.u\n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x]\n" +
+			"     │               └─ IndexedTableAccess(xy)\n" +
+			"     │                   ├─ index: [xy.x]\n" +
+			"     │                   ├─ columns: [x y]\n" +
+			"     │                   └─ keys: ab.a\n" +
 			"     └─ HashLookup\n" +
-			"         ├─ left-key: (alias1.a)\n" +
+			"         ├─ left-key: (ab.a)\n" +
 			"         ├─ right-key: (pq.p)\n" +
 			"         └─ Table\n" +
-			"             └─ name: pq\n" +
+			"             ├─ name: pq\n" +
+			"             └─ columns: [p q]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [alias1.a, alias1.b, pq.p, pq.q]\n" +
-			" └─ LeftOuterHashJoin (estimated cost=114.000 rows=100) (actual rows=0 loops=1)\n" +
-			"     ├─ (alias1.a = pq.p)\n" +
+			" ├─ columns: [ab.a as a, ab.b as b, pq.p, pq.q]\n" +
+			" └─ LeftOuterHashJoin (estimated cost=879.000 rows=850) (actual rows=0 loops=1)\n" +
+			"     ├─ (ab.a = pq.p)\n" +
 			"     ├─ Project\n" +
-			"     │   ├─ columns: [alias1.a, alias1.b]\n" +
-			"     │   └─ HashJoin (estimated cost=114.000 rows=100) (actual rows=0 loops=1)\n" +
-			"     │       ├─ (alias1.a = uv.u)\n" +
-			"     │       ├─ SubqueryAlias\n" +
-			"     │       │   ├─ name: alias1\n" +
-			"     │       │   ├─ outerVisibility: false\n" +
-			"     │       │   ├─ isLateral: false\n" +
-			"     │       │   ├─ cacheable: true\n" +
-			"     │       │   ├─ colSet: (5,6)\n" +
-			"     │       │   ├─ tableId: 3\n" +
-			"     │       │   └─ Project\n" +
-			"     │       │       ├─ columns: [ab.a, ab.b]\n" +
-			"     │       │       └─ Filter\n" +
-			"     │       │           ├─ xy.x IS NULL\n" +
-			"     │       │           └─ LeftOuterMergeJoin (estimated cost=2030.000 rows=1000) (actual rows=4 loops=1)\n" +
-			"     │       │               ├─ cmp: (ab.a = xy.x)\n" +
-			"     │       │               ├─ IndexedTableAccess(ab)\n" +
-			"     │       │               │   ├─ index: [ab.a]\n" +
-			"     │       │               │   └─ filters: [{[NULL, ∞)}]\n" +
-			"     │       │               └─ Project\n" +
-			"     │       │                   ├─ columns: [xy.x]\n" +
-			"     │       │                   └─ IndexedTableAccess(xy)\n" +
-			"     │       │                       ├─ index: [xy.x]\n" +
-			"     │       │                       ├─ filters: [{[NULL, ∞)}]\n" +
-			"     │       │                       └─ columns: [x y]\n" +
-			"     │       └─ HashLookup\n" +
-			"     │           ├─ left-key: (alias1.a)\n" +
-			"     │           ├─ right-key: (uv.u)\n" +
-			"     │           └─ Distinct\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [uv.u]\n" +
-			"     │                   └─ Table\n" +
-			"     │                       ├─ name: uv\n" +
-			"     │                       └─ columns: [u v]\n" +
+			"     │   ├─ columns: [ab.a, ab.b]\n" +
+			"     │   └─ Filter\n" +
+			"     │       ├─ xy.x IS NULL\n" +
+			"     │       └─ LeftOuterLookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
+			"     │           ├─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
+			"     │           │   ├─ (ab.a = uv.u)\n" +
+			"     │           │   ├─ OrderedDistinct\n" +
+			"     │           │   │   └─ Project\n" +
+			"     │           │   │       ├─ columns: [uv.u]\n" +
+			"     │           │   │       └─ Table\n" +
+			"     │           │   │           ├─ name: uv\n" +
+			"     │           │   │           └─ columns: [u v]\n" +
+			"     │           │   └─ IndexedTableAccess(ab)\n" +
+			"     │           │       ├─ index: [ab.a]\n" +
+			"     │           │       ├─ columns: [a b]\n" +
+			"     │           │       └─ keys: uv.u\n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x]\n" +
+			"     │               └─ IndexedTableAccess(xy)\n" +
+			"     │                   ├─ index: [xy.x]\n" +
+			"     │                   ├─ columns: [x y]\n" +
+			"     │                   └─ keys: ab.a\n" +
 			"     └─ HashLookup\n" +
-			"         ├─ left-key: (alias1.a)\n" +
+			"         ├─ left-key: (ab.a)\n" +
 			"         ├─ right-key: (pq.p)\n" +
 			"         └─ Table\n" +
-			"             └─ name: pq\n" +
+			"             ├─ name: pq\n" +
+			"             └─ columns: [p q]\n" +
 			"",
 	},
 	{
 		Query: `select i from mytable a where exists (select 1 from mytable b where a.i = b.i)`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:0!null]\n" +
-			" └─ SemiJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ a.i:0!null\n" +
-			"     │   └─ b.i:2!null\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ ProcessTable\n" +
-			"     │       └─ Table\n" +
-			"     │           ├─ name: mytable\n" +
-			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             ├─ columns: [i]\n" +
-			"             ├─ colSet: (3,4)\n" +
-			"             └─ tableId: 2\n" +
+		ExpectedPlan: "SemiJoin\n" +
+			" ├─ Eq\n" +
+			" │   ├─ a.i:0!null\n" +
+			" │   └─ b.i:1!null\n" +
+			" ├─ TableAlias(a)\n" +
+			" │   └─ ProcessTable\n" +
+			" │       └─ Table\n" +
+			" │           ├─ name: mytable\n" +
+			" │           └─ columns: [i]\n" +
+			" └─ TableAlias(b)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         ├─ columns: [i]\n" +
+			"         ├─ colSet: (3,4)\n" +
+			"         └─ tableId: 2\n" +
 			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [a.i]\n" +
-			" └─ SemiJoin (estimated cost=7.545 rows=3)\n" +
-			"     ├─ (a.i = b.i)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ Table\n" +
-			"     │       └─ name: mytable\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+		ExpectedEstimates: "SemiJoin (estimated cost=7.545 rows=3)\n" +
+			" ├─ (a.i = b.i)\n" +
+			" ├─ TableAlias(a)\n" +
+			" │   └─ Table\n" +
+			" │       ├─ name: mytable\n" +
+			" │       └─ columns: [i]\n" +
+			" └─ TableAlias(b)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [a.i]\n" +
-			" └─ SemiJoin (estimated cost=7.545 rows=3) (actual rows=3 loops=1)\n" +
-			"     ├─ (a.i = b.i)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ Table\n" +
-			"     │       └─ name: mytable\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+		ExpectedAnalysis: "SemiJoin (estimated cost=7.545 rows=3) (actual rows=3 loops=1)\n" +
+			" ├─ (a.i = b.i)\n" +
+			" ├─ TableAlias(a)\n" +
+			" │   └─ Table\n" +
+			" │       ├─ name: mytable\n" +
+			" │       └─ columns: [i]\n" +
+			" └─ TableAlias(b)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -6130,13 +6050,13 @@ inner join pq on true
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.i:0!null]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [mytable.i:0!null, mytable.s:1!null]\n" +
+			"     ├─ columns: [mytable.i:0!null]\n" +
 			"     └─ Filter\n" +
-			"         ├─ b.i:2!null IS NULL\n" +
+			"         ├─ b.i:1!null IS NULL\n" +
 			"         └─ LeftOuterMergeJoin\n" +
 			"             ├─ cmp: Eq\n" +
 			"             │   ├─ a.i:0!null\n" +
-			"             │   └─ b.i:2!null\n" +
+			"             │   └─ b.i:1!null\n" +
 			"             ├─ TableAlias(a)\n" +
 			"             │   └─ IndexedTableAccess(mytable)\n" +
 			"             │       ├─ index: [mytable.i,mytable.s]\n" +
@@ -6145,7 +6065,7 @@ inner join pq on true
 			"             │       ├─ tableId: 1\n" +
 			"             │       └─ Table\n" +
 			"             │           ├─ name: mytable\n" +
-			"             │           └─ columns: [i s]\n" +
+			"             │           └─ columns: [i]\n" +
 			"             └─ TableAlias(b)\n" +
 			"                 └─ IndexedTableAccess(mytable)\n" +
 			"                     ├─ index: [mytable.i]\n" +
@@ -6159,7 +6079,7 @@ inner join pq on true
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [mytable.i, mytable.s]\n" +
+			"     ├─ columns: [mytable.i]\n" +
 			"     └─ Filter\n" +
 			"         ├─ b.i IS NULL\n" +
 			"         └─ LeftOuterMergeJoin (estimated cost=6.090 rows=3)\n" +
@@ -6167,7 +6087,8 @@ inner join pq on true
 			"             ├─ TableAlias(a)\n" +
 			"             │   └─ IndexedTableAccess(mytable)\n" +
 			"             │       ├─ index: [mytable.i,mytable.s]\n" +
-			"             │       └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │       └─ columns: [i]\n" +
 			"             └─ TableAlias(b)\n" +
 			"                 └─ IndexedTableAccess(mytable)\n" +
 			"                     ├─ index: [mytable.i]\n" +
@@ -6177,7 +6098,7 @@ inner join pq on true
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [mytable.i, mytable.s]\n" +
+			"     ├─ columns: [mytable.i]\n" +
 			"     └─ Filter\n" +
 			"         ├─ b.i IS NULL\n" +
 			"         └─ LeftOuterMergeJoin (estimated cost=6.090 rows=3) (actual rows=3 loops=1)\n" +
@@ -6185,7 +6106,8 @@ inner join pq on true
 			"             ├─ TableAlias(a)\n" +
 			"             │   └─ IndexedTableAccess(mytable)\n" +
 			"             │       ├─ index: [mytable.i,mytable.s]\n" +
-			"             │       └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │       └─ columns: [i]\n" +
 			"             └─ TableAlias(b)\n" +
 			"                 └─ IndexedTableAccess(mytable)\n" +
 			"                     ├─ index: [mytable.i]\n" +
@@ -8044,7 +7966,7 @@ inner join pq on true
 	{
 		Query: `SELECT mytable.i, selfjoin.i FROM mytable INNER JOIN mytable selfjoin ON mytable.i = selfjoin.i WHERE selfjoin.i IN (SELECT 1 FROM DUAL)`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [mytable.i:2!null, selfjoin.i:0!null]\n" +
+			" ├─ columns: [mytable.i:1!null, selfjoin.i:0!null]\n" +
 			" └─ SemiJoin\n" +
 			"     ├─ LookupJoin\n" +
 			"     │   ├─ TableAlias(selfjoin)\n" +
@@ -8055,7 +7977,7 @@ inner join pq on true
 			"     │   │       ├─ tableId: 2\n" +
 			"     │   │       └─ Table\n" +
 			"     │   │           ├─ name: mytable\n" +
-			"     │   │           └─ columns: [i s]\n" +
+			"     │   │           └─ columns: [i]\n" +
 			"     │   └─ IndexedTableAccess(mytable)\n" +
 			"     │       ├─ index: [mytable.i]\n" +
 			"     │       ├─ keys: [selfjoin.i:0!null]\n" +
@@ -8063,7 +7985,7 @@ inner join pq on true
 			"     │       ├─ tableId: 1\n" +
 			"     │       └─ Table\n" +
 			"     │           ├─ name: mytable\n" +
-			"     │           └─ columns: [i s]\n" +
+			"     │           └─ columns: [i]\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [1 (tinyint)]\n" +
 			"         └─ ProcessTable\n" +
@@ -8078,9 +8000,11 @@ inner join pq on true
 			"     │   ├─ TableAlias(selfjoin)\n" +
 			"     │   │   └─ IndexedTableAccess(mytable)\n" +
 			"     │   │       ├─ index: [mytable.i]\n" +
-			"     │   │       └─ filters: [{[1, 1]}]\n" +
+			"     │   │       ├─ filters: [{[1, 1]}]\n" +
+			"     │   │       └─ columns: [i]\n" +
 			"     │   └─ IndexedTableAccess(mytable)\n" +
 			"     │       ├─ index: [mytable.i]\n" +
+			"     │       ├─ columns: [i]\n" +
 			"     │       └─ keys: selfjoin.i\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [1]\n" +
@@ -8094,9 +8018,11 @@ inner join pq on true
 			"     │   ├─ TableAlias(selfjoin)\n" +
 			"     │   │   └─ IndexedTableAccess(mytable)\n" +
 			"     │   │       ├─ index: [mytable.i]\n" +
-			"     │   │       └─ filters: [{[1, 1]}]\n" +
+			"     │   │       ├─ filters: [{[1, 1]}]\n" +
+			"     │   │       └─ columns: [i]\n" +
 			"     │   └─ IndexedTableAccess(mytable)\n" +
 			"     │       ├─ index: [mytable.i]\n" +
+			"     │       ├─ columns: [i]\n" +
 			"     │       └─ keys: selfjoin.i\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [1]\n" +
@@ -11160,7 +11086,8 @@ inner join pq on true
 		ExpectedEstimates: "SemiJoin (estimated cost=7.545 rows=3)\n" +
 			" ├─ (mytable.i = othertable.i2)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: mytable\n" +
+			" │   ├─ name: mytable\n" +
+			" │   └─ columns: [i s]\n" +
 			" └─ Limit(1)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [othertable.i2]\n" +
@@ -11170,7 +11097,8 @@ inner join pq on true
 		ExpectedAnalysis: "SemiJoin (estimated cost=7.545 rows=3) (actual rows=1 loops=1)\n" +
 			" ├─ (mytable.i = othertable.i2)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: mytable\n" +
+			" │   ├─ name: mytable\n" +
+			" │   └─ columns: [i s]\n" +
 			" └─ Limit(1)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [othertable.i2]\n" +
@@ -11198,7 +11126,8 @@ inner join pq on true
 		ExpectedEstimates: "SemiJoin (estimated cost=7.545 rows=3)\n" +
 			" ├─ (mytable.i = othertable.i2)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: mytable\n" +
+			" │   ├─ name: mytable\n" +
+			" │   └─ columns: [i s]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [othertable.i2]\n" +
 			"     └─ Table\n" +
@@ -11207,7 +11136,8 @@ inner join pq on true
 		ExpectedAnalysis: "SemiJoin (estimated cost=7.545 rows=3) (actual rows=3 loops=1)\n" +
 			" ├─ (mytable.i = othertable.i2)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: mytable\n" +
+			" │   ├─ name: mytable\n" +
+			" │   └─ columns: [i s]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [othertable.i2]\n" +
 			"     └─ Table\n" +
@@ -11251,7 +11181,8 @@ inner join pq on true
 			" │               ├─ columns: [i2]\n" +
 			" │               └─ keys: mytable.i\n" +
 			" └─ Table\n" +
-			"     └─ name: mytable\n" +
+			"     ├─ name: mytable\n" +
+			"     └─ columns: [i s]\n" +
 			"",
 		ExpectedAnalysis: "Filter\n" +
 			" ├─ InSubquery\n" +
@@ -11265,7 +11196,8 @@ inner join pq on true
 			" │               ├─ columns: [i2]\n" +
 			" │               └─ keys: mytable.i\n" +
 			" └─ Table\n" +
-			"     └─ name: mytable\n" +
+			"     ├─ name: mytable\n" +
+			"     └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -11958,13 +11890,8 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM othertable WHERE i2 = 1) othertable_alias WHERE othertable_alias.i2 = 1`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ isLateral: false\n" +
-			" ├─ cacheable: true\n" +
-			" ├─ colSet: (3,4)\n" +
-			" ├─ tableId: 2\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [othertable.s2:0!null->s2:0, othertable.i2:1!null->i2:0]\n" +
 			" └─ IndexedTableAccess(othertable)\n" +
 			"     ├─ index: [othertable.i2]\n" +
 			"     ├─ static: [{[1, 1]}]\n" +
@@ -11974,25 +11901,15 @@ inner join pq on true
 			"         ├─ name: othertable\n" +
 			"         └─ columns: [s2 i2]\n" +
 			"",
-		ExpectedEstimates: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ isLateral: false\n" +
-			" ├─ cacheable: true\n" +
-			" ├─ colSet: (3,4)\n" +
-			" ├─ tableId: 2\n" +
+		ExpectedEstimates: "Project\n" +
+			" ├─ columns: [othertable.s2 as s2, othertable.i2 as i2]\n" +
 			" └─ IndexedTableAccess(othertable)\n" +
 			"     ├─ index: [othertable.i2]\n" +
 			"     ├─ filters: [{[1, 1]}]\n" +
 			"     └─ columns: [s2 i2]\n" +
 			"",
-		ExpectedAnalysis: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ isLateral: false\n" +
-			" ├─ cacheable: true\n" +
-			" ├─ colSet: (3,4)\n" +
-			" ├─ tableId: 2\n" +
+		ExpectedAnalysis: "Project\n" +
+			" ├─ columns: [othertable.s2 as s2, othertable.i2 as i2]\n" +
 			" └─ IndexedTableAccess(othertable)\n" +
 			"     ├─ index: [othertable.i2]\n" +
 			"     ├─ filters: [{[1, 1]}]\n" +
@@ -16056,140 +15973,132 @@ inner join pq on true
 		Query: `SELECT i FROM mytable mt
 		WHERE (SELECT i FROM mytable where i = mt.i and i > 2) IS NOT NULL
 		AND (SELECT i2 FROM othertable where i2 = i) IS NOT NULL`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [mt.i:0!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ AND\n" +
-			"     │   ├─ NOT\n" +
-			"     │   │   └─ Subquery\n" +
-			"     │   │       ├─ cacheable: false\n" +
-			"     │   │       ├─ alias-string: select i from mytable where i = mt.i and i > 2\n" +
-			"     │   │       └─ Filter\n" +
-			"     │   │           ├─ Eq\n" +
-			"     │   │           │   ├─ mytable.i:2!null\n" +
-			"     │   │           │   └─ mt.i:0!null\n" +
-			"     │   │           └─ IndexedTableAccess(mytable)\n" +
-			"     │   │               ├─ index: [mytable.i]\n" +
-			"     │   │               ├─ static: [{(2, ∞)}]\n" +
-			"     │   │               ├─ colSet: (3,4)\n" +
-			"     │   │               ├─ tableId: 2\n" +
-			"     │   │               └─ Table\n" +
-			"     │   │                   ├─ name: mytable\n" +
-			"     │   │                   └─ columns: [i]\n" +
-			"     │   │       IS NULL\n" +
-			"     │   └─ NOT\n" +
-			"     │       └─ Subquery\n" +
-			"     │           ├─ cacheable: false\n" +
-			"     │           ├─ alias-string: select i2 from othertable where i2 = i\n" +
-			"     │           └─ Filter\n" +
-			"     │               ├─ Eq\n" +
-			"     │               │   ├─ othertable.i2:2!null\n" +
-			"     │               │   └─ mt.i:0!null\n" +
-			"     │               └─ IndexedTableAccess(othertable)\n" +
-			"     │                   ├─ index: [othertable.i2]\n" +
-			"     │                   ├─ keys: [mt.i:0!null]\n" +
-			"     │                   ├─ colSet: (5,6)\n" +
-			"     │                   ├─ tableId: 3\n" +
-			"     │                   └─ Table\n" +
-			"     │                       ├─ name: othertable\n" +
-			"     │                       └─ columns: [i2]\n" +
-			"     │           IS NULL\n" +
-			"     └─ TableAlias(mt)\n" +
-			"         └─ ProcessTable\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [i s]\n" +
-			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [mt.i]\n" +
-			" └─ Filter\n" +
-			"     ├─ ((NOT(Subquery(select i from mytable where i = mt.i and i > 2) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i) IS NULL)))\n" +
-			"     └─ TableAlias(mt)\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ AND\n" +
+			" │   ├─ NOT\n" +
+			" │   │   └─ Subquery\n" +
+			" │   │       ├─ cacheable: false\n" +
+			" │   │       ├─ alias-string: select i from mytable where i = mt.i and i > 2\n" +
+			" │   │       └─ Filter\n" +
+			" │   │           ├─ Eq\n" +
+			" │   │           │   ├─ mytable.i:1!null\n" +
+			" │   │           │   └─ mt.i:0!null\n" +
+			" │   │           └─ IndexedTableAccess(mytable)\n" +
+			" │   │               ├─ index: [mytable.i]\n" +
+			" │   │               ├─ static: [{(2, ∞)}]\n" +
+			" │   │               ├─ colSet: (3,4)\n" +
+			" │   │               ├─ tableId: 2\n" +
+			" │   │               └─ Table\n" +
+			" │   │                   ├─ name: mytable\n" +
+			" │   │                   └─ columns: [i]\n" +
+			" │   │       IS NULL\n" +
+			" │   └─ NOT\n" +
+			" │       └─ Subquery\n" +
+			" │           ├─ cacheable: false\n" +
+			" │           ├─ alias-string: select i2 from othertable where i2 = i\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ Eq\n" +
+			" │               │   ├─ othertable.i2:1!null\n" +
+			" │               │   └─ mt.i:0!null\n" +
+			" │               └─ IndexedTableAccess(othertable)\n" +
+			" │                   ├─ index: [othertable.i2]\n" +
+			" │                   ├─ keys: [mt.i:0!null]\n" +
+			" │                   ├─ colSet: (5,6)\n" +
+			" │                   ├─ tableId: 3\n" +
+			" │                   └─ Table\n" +
+			" │                       ├─ name: othertable\n" +
+			" │                       └─ columns: [i2]\n" +
+			" │           IS NULL\n" +
+			" └─ TableAlias(mt)\n" +
+			"     └─ ProcessTable\n" +
 			"         └─ Table\n" +
-			"             └─ name: mytable\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [mt.i]\n" +
-			" └─ Filter\n" +
-			"     ├─ ((NOT(Subquery(select i from mytable where i = mt.i and i > 2) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i) IS NULL)))\n" +
-			"     └─ TableAlias(mt)\n" +
-			"         └─ Table\n" +
-			"             └─ name: mytable\n" +
+		ExpectedEstimates: "Filter\n" +
+			" ├─ ((NOT(Subquery(select i from mytable where i = mt.i and i > 2) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i) IS NULL)))\n" +
+			" └─ TableAlias(mt)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i]\n" +
+			"",
+		ExpectedAnalysis: "Filter\n" +
+			" ├─ ((NOT(Subquery(select i from mytable where i = mt.i and i > 2) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i) IS NULL)))\n" +
+			" └─ TableAlias(mt)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT i FROM mytable mt
 		WHERE (SELECT i FROM mytable where i = mt.i) IS NOT NULL
 		AND (SELECT i2 FROM othertable where i2 = i and i > 2) IS NOT NULL`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [mt.i:0!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ AND\n" +
-			"     │   ├─ NOT\n" +
-			"     │   │   └─ Subquery\n" +
-			"     │   │       ├─ cacheable: false\n" +
-			"     │   │       ├─ alias-string: select i from mytable where i = mt.i\n" +
-			"     │   │       └─ Filter\n" +
-			"     │   │           ├─ Eq\n" +
-			"     │   │           │   ├─ mytable.i:2!null\n" +
-			"     │   │           │   └─ mt.i:0!null\n" +
-			"     │   │           └─ IndexedTableAccess(mytable)\n" +
-			"     │   │               ├─ index: [mytable.i]\n" +
-			"     │   │               ├─ keys: [mt.i:0!null]\n" +
-			"     │   │               ├─ colSet: (3,4)\n" +
-			"     │   │               ├─ tableId: 2\n" +
-			"     │   │               └─ Table\n" +
-			"     │   │                   ├─ name: mytable\n" +
-			"     │   │                   └─ columns: [i]\n" +
-			"     │   │       IS NULL\n" +
-			"     │   └─ NOT\n" +
-			"     │       └─ Subquery\n" +
-			"     │           ├─ cacheable: false\n" +
-			"     │           ├─ alias-string: select i2 from othertable where i2 = i and i > 2\n" +
-			"     │           └─ Filter\n" +
-			"     │               ├─ AND\n" +
-			"     │               │   ├─ GreaterThan\n" +
-			"     │               │   │   ├─ mt.i:0!null\n" +
-			"     │               │   │   └─ 2 (bigint)\n" +
-			"     │               │   └─ Eq\n" +
-			"     │               │       ├─ othertable.i2:2!null\n" +
-			"     │               │       └─ mt.i:0!null\n" +
-			"     │               └─ IndexedTableAccess(othertable)\n" +
-			"     │                   ├─ index: [othertable.i2]\n" +
-			"     │                   ├─ keys: [mt.i:0!null]\n" +
-			"     │                   ├─ colSet: (5,6)\n" +
-			"     │                   ├─ tableId: 3\n" +
-			"     │                   └─ Table\n" +
-			"     │                       ├─ name: othertable\n" +
-			"     │                       └─ columns: [i2]\n" +
-			"     │           IS NULL\n" +
-			"     └─ TableAlias(mt)\n" +
-			"         └─ ProcessTable\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [i s]\n" +
-			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [mt.i]\n" +
-			" └─ Filter\n" +
-			"     ├─ ((NOT(Subquery(select i from mytable where i = mt.i) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i and i > 2) IS NULL)))\n" +
-			"     └─ TableAlias(mt)\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ AND\n" +
+			" │   ├─ NOT\n" +
+			" │   │   └─ Subquery\n" +
+			" │   │       ├─ cacheable: false\n" +
+			" │   │       ├─ alias-string: select i from mytable where i = mt.i\n" +
+			" │   │       └─ Filter\n" +
+			" │   │           ├─ Eq\n" +
+			" │   │           │   ├─ mytable.i:1!null\n" +
+			" │   │           │   └─ mt.i:0!null\n" +
+			" │   │           └─ IndexedTableAccess(mytable)\n" +
+			" │   │               ├─ index: [mytable.i]\n" +
+			" │   │               ├─ keys: [mt.i:0!null]\n" +
+			" │   │               ├─ colSet: (3,4)\n" +
+			" │   │               ├─ tableId: 2\n" +
+			" │   │               └─ Table\n" +
+			" │   │                   ├─ name: mytable\n" +
+			" │   │                   └─ columns: [i]\n" +
+			" │   │       IS NULL\n" +
+			" │   └─ NOT\n" +
+			" │       └─ Subquery\n" +
+			" │           ├─ cacheable: false\n" +
+			" │           ├─ alias-string: select i2 from othertable where i2 = i and i > 2\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ AND\n" +
+			" │               │   ├─ GreaterThan\n" +
+			" │               │   │   ├─ mt.i:0!null\n" +
+			" │               │   │   └─ 2 (bigint)\n" +
+			" │               │   └─ Eq\n" +
+			" │               │       ├─ othertable.i2:1!null\n" +
+			" │               │       └─ mt.i:0!null\n" +
+			" │               └─ IndexedTableAccess(othertable)\n" +
+			" │                   ├─ index: [othertable.i2]\n" +
+			" │                   ├─ keys: [mt.i:0!null]\n" +
+			" │                   ├─ colSet: (5,6)\n" +
+			" │                   ├─ tableId: 3\n" +
+			" │                   └─ Table\n" +
+			" │                       ├─ name: othertable\n" +
+			" │                       └─ columns: [i2]\n" +
+			" │           IS NULL\n" +
+			" └─ TableAlias(mt)\n" +
+			"     └─ ProcessTable\n" +
 			"         └─ Table\n" +
-			"             └─ name: mytable\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [mt.i]\n" +
-			" └─ Filter\n" +
-			"     ├─ ((NOT(Subquery(select i from mytable where i = mt.i) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i and i > 2) IS NULL)))\n" +
-			"     └─ TableAlias(mt)\n" +
-			"         └─ Table\n" +
-			"             └─ name: mytable\n" +
+		ExpectedEstimates: "Filter\n" +
+			" ├─ ((NOT(Subquery(select i from mytable where i = mt.i) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i and i > 2) IS NULL)))\n" +
+			" └─ TableAlias(mt)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i]\n" +
+			"",
+		ExpectedAnalysis: "Filter\n" +
+			" ├─ ((NOT(Subquery(select i from mytable where i = mt.i) IS NULL)) AND (NOT(Subquery(select i2 from othertable where i2 = i and i > 2) IS NULL)))\n" +
+			" └─ TableAlias(mt)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk2, (SELECT pk from one_pk where pk = 1 limit 1) FROM one_pk t1, two_pk t2 WHERE pk=1 AND pk2=1 ORDER BY 1,2`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [t1.pk:7!null, t2.pk2:1!null, Subquery\n" +
+			" ├─ columns: [t1.pk:1!null, t2.pk2:0!null, Subquery\n" +
 			" │   ├─ cacheable: true\n" +
 			" │   ├─ alias-string: select pk from one_pk where pk = 1 limit 1\n" +
 			" │   └─ Limit(1)\n" +
@@ -16202,17 +16111,17 @@ inner join pq on true
 			" │               ├─ name: one_pk\n" +
 			" │               └─ columns: [pk]\n" +
 			" │  ->(SELECT pk from one_pk where pk = 1 limit 1):0]\n" +
-			" └─ Sort(t1.pk:7!null ASC nullsFirst, t2.pk2:1!null ASC nullsFirst)\n" +
+			" └─ Sort(t1.pk:1!null ASC nullsFirst, t2.pk2:0!null ASC nullsFirst)\n" +
 			"     └─ CrossJoin\n" +
 			"         ├─ Filter\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ t2.pk2:1!null\n" +
+			"         │   │   ├─ t2.pk2:0!null\n" +
 			"         │   │   └─ 1 (tinyint)\n" +
 			"         │   └─ TableAlias(t2)\n" +
 			"         │       └─ ProcessTable\n" +
 			"         │           └─ Table\n" +
 			"         │               ├─ name: two_pk\n" +
-			"         │               └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
+			"         │               └─ columns: [pk2]\n" +
 			"         └─ TableAlias(t1)\n" +
 			"             └─ IndexedTableAccess(one_pk)\n" +
 			"                 ├─ index: [one_pk.pk]\n" +
@@ -16221,7 +16130,7 @@ inner join pq on true
 			"                 ├─ tableId: 1\n" +
 			"                 └─ Table\n" +
 			"                     ├─ name: one_pk\n" +
-			"                     └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"                     └─ columns: [pk]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [t1.pk, t2.pk2, Subquery\n" +
@@ -16238,11 +16147,13 @@ inner join pq on true
 			"         │   ├─ (t2.pk2 = 1)\n" +
 			"         │   └─ TableAlias(t2)\n" +
 			"         │       └─ Table\n" +
-			"         │           └─ name: two_pk\n" +
+			"         │           ├─ name: two_pk\n" +
+			"         │           └─ columns: [pk2]\n" +
 			"         └─ TableAlias(t1)\n" +
 			"             └─ IndexedTableAccess(one_pk)\n" +
 			"                 ├─ index: [one_pk.pk]\n" +
-			"                 └─ filters: [{[1, 1]}]\n" +
+			"                 ├─ filters: [{[1, 1]}]\n" +
+			"                 └─ columns: [pk]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [t1.pk, t2.pk2, Subquery\n" +
@@ -16259,11 +16170,13 @@ inner join pq on true
 			"         │   ├─ (t2.pk2 = 1)\n" +
 			"         │   └─ TableAlias(t2)\n" +
 			"         │       └─ Table\n" +
-			"         │           └─ name: two_pk\n" +
+			"         │           ├─ name: two_pk\n" +
+			"         │           └─ columns: [pk2]\n" +
 			"         └─ TableAlias(t1)\n" +
 			"             └─ IndexedTableAccess(one_pk)\n" +
 			"                 ├─ index: [one_pk.pk]\n" +
-			"                 └─ filters: [{[1, 1]}]\n" +
+			"                 ├─ filters: [{[1, 1]}]\n" +
+			"                 └─ columns: [pk]\n" +
 			"",
 	},
 	{
@@ -21414,59 +21327,41 @@ With c as (
 			" ├─ tableId: 8\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [a.s:3!null]\n" +
-			"     └─ HashJoin\n" +
+			"     └─ LookupJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ b.I:0!null\n" +
-			"         │   └─ e.i:4!null\n" +
-			"         ├─ HashJoin\n" +
+			"         │   ├─ a.i:2!null\n" +
+			"         │   └─ t1.i:0!null\n" +
+			"         ├─ InnerJoin\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ a.i:2!null\n" +
-			"         │   │   └─ b.i:0!null\n" +
-			"         │   ├─ SubqueryAlias\n" +
-			"         │   │   ├─ name: b\n" +
-			"         │   │   ├─ outerVisibility: false\n" +
-			"         │   │   ├─ isLateral: false\n" +
-			"         │   │   ├─ cacheable: true\n" +
-			"         │   │   ├─ colSet: (5,6)\n" +
-			"         │   │   ├─ tableId: 3\n" +
-			"         │   │   └─ TableAlias(t2)\n" +
-			"         │   │       └─ IndexedTableAccess(mytable)\n" +
-			"         │   │           ├─ index: [mytable.i]\n" +
-			"         │   │           ├─ static: [{[1, 1]}, {[2, 2]}]\n" +
-			"         │   │           ├─ colSet: (3,4)\n" +
-			"         │   │           ├─ tableId: 2\n" +
-			"         │   │           └─ Table\n" +
-			"         │   │               ├─ name: mytable\n" +
-			"         │   │               └─ columns: [i s]\n" +
-			"         │   └─ HashLookup\n" +
-			"         │       ├─ left-key: TUPLE(b.i:0!null)\n" +
-			"         │       ├─ right-key: TUPLE(a.i:0!null)\n" +
-			"         │       └─ TableAlias(a)\n" +
+			"         │   │   ├─ t2.i:1!null\n" +
+			"         │   │   └─ t1.i:0!null\n" +
+			"         │   ├─ TableAlias(t1)\n" +
+			"         │   │   └─ IndexedTableAccess(mytable)\n" +
+			"         │   │       ├─ index: [mytable.i]\n" +
+			"         │   │       ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
+			"         │   │       ├─ colSet: (7,8)\n" +
+			"         │   │       ├─ tableId: 4\n" +
+			"         │   │       └─ Table\n" +
+			"         │   │           ├─ name: mytable\n" +
+			"         │   │           └─ columns: [i]\n" +
+			"         │   └─ TableAlias(t2)\n" +
+			"         │       └─ IndexedTableAccess(mytable)\n" +
+			"         │           ├─ index: [mytable.i]\n" +
+			"         │           ├─ static: [{[1, 1]}, {[2, 2]}]\n" +
+			"         │           ├─ colSet: (3,4)\n" +
+			"         │           ├─ tableId: 2\n" +
 			"         │           └─ Table\n" +
 			"         │               ├─ name: mytable\n" +
-			"         │               ├─ columns: [i s]\n" +
-			"         │               ├─ colSet: (1,2)\n" +
-			"         │               └─ tableId: 1\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(b.I:0!null)\n" +
-			"             ├─ right-key: TUPLE(e.i:0!null)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: e\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     ├─ colSet: (9,10)\n" +
-			"                     ├─ tableId: 5\n" +
-			"                     └─ TableAlias(t1)\n" +
-			"                         └─ IndexedTableAccess(mytable)\n" +
-			"                             ├─ index: [mytable.i]\n" +
-			"                             ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
-			"                             ├─ colSet: (7,8)\n" +
-			"                             ├─ tableId: 4\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: mytable\n" +
-			"                                 └─ columns: [i s]\n" +
+			"         │               └─ columns: [i]\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ keys: [t2.i:1!null]\n" +
+			"                 ├─ colSet: (1,2)\n" +
+			"                 ├─ tableId: 1\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: mytable\n" +
+			"                     └─ columns: [i s]\n" +
 			"",
 		ExpectedEstimates: "SubqueryAlias\n" +
 			" ├─ name: c\n" +
@@ -21477,43 +21372,25 @@ With c as (
 			" ├─ tableId: 8\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [a.s]\n" +
-			"     └─ HashJoin (estimated cost=402.250 rows=125)\n" +
-			"         ├─ (b.I = e.i)\n" +
-			"         ├─ HashJoin (estimated cost=111.000 rows=100)\n" +
-			"         │   ├─ (a.i = b.i)\n" +
-			"         │   ├─ SubqueryAlias\n" +
-			"         │   │   ├─ name: b\n" +
-			"         │   │   ├─ outerVisibility: false\n" +
-			"         │   │   ├─ isLateral: false\n" +
-			"         │   │   ├─ cacheable: true\n" +
-			"         │   │   ├─ colSet: (5,6)\n" +
-			"         │   │   ├─ tableId: 3\n" +
-			"         │   │   └─ TableAlias(t2)\n" +
-			"         │   │       └─ IndexedTableAccess(mytable)\n" +
-			"         │   │           ├─ index: [mytable.i]\n" +
-			"         │   │           ├─ filters: [{[1, 1]}, {[2, 2]}]\n" +
-			"         │   │           └─ columns: [i s]\n" +
-			"         │   └─ HashLookup\n" +
-			"         │       ├─ left-key: (b.i)\n" +
-			"         │       ├─ right-key: (a.i)\n" +
-			"         │       └─ TableAlias(a)\n" +
-			"         │           └─ Table\n" +
-			"         │               ├─ name: mytable\n" +
-			"         │               └─ columns: [i s]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (b.I)\n" +
-			"             ├─ right-key: (e.i)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: e\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ TableAlias(t1)\n" +
-			"                         └─ IndexedTableAccess(mytable)\n" +
-			"                             ├─ index: [mytable.i]\n" +
-			"                             ├─ filters: [{[2, 2]}, {[3, 3]}]\n" +
-			"                             └─ columns: [i s]\n" +
+			"     └─ LookupJoin (estimated cost=3.305 rows=1)\n" +
+			"         ├─ (a.i = t1.i)\n" +
+			"         ├─ InnerJoin (estimated cost=2.010 rows=1)\n" +
+			"         │   ├─ (t2.i = t1.i)\n" +
+			"         │   ├─ TableAlias(t1)\n" +
+			"         │   │   └─ IndexedTableAccess(mytable)\n" +
+			"         │   │       ├─ index: [mytable.i]\n" +
+			"         │   │       ├─ filters: [{[2, 2]}, {[3, 3]}]\n" +
+			"         │   │       └─ columns: [i]\n" +
+			"         │   └─ TableAlias(t2)\n" +
+			"         │       └─ IndexedTableAccess(mytable)\n" +
+			"         │           ├─ index: [mytable.i]\n" +
+			"         │           ├─ filters: [{[1, 1]}, {[2, 2]}]\n" +
+			"         │           └─ columns: [i]\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ columns: [i s]\n" +
+			"                 └─ keys: t2.i\n" +
 			"",
 		ExpectedAnalysis: "SubqueryAlias\n" +
 			" ├─ name: c\n" +
@@ -21524,43 +21401,25 @@ With c as (
 			" ├─ tableId: 8\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [a.s]\n" +
-			"     └─ HashJoin (estimated cost=402.250 rows=125) (actual rows=1 loops=1)\n" +
-			"         ├─ (b.I = e.i)\n" +
-			"         ├─ HashJoin (estimated cost=111.000 rows=100) (actual rows=2 loops=1)\n" +
-			"         │   ├─ (a.i = b.i)\n" +
-			"         │   ├─ SubqueryAlias\n" +
-			"         │   │   ├─ name: b\n" +
-			"         │   │   ├─ outerVisibility: false\n" +
-			"         │   │   ├─ isLateral: false\n" +
-			"         │   │   ├─ cacheable: true\n" +
-			"         │   │   ├─ colSet: (5,6)\n" +
-			"         │   │   ├─ tableId: 3\n" +
-			"         │   │   └─ TableAlias(t2)\n" +
-			"         │   │       └─ IndexedTableAccess(mytable)\n" +
-			"         │   │           ├─ index: [mytable.i]\n" +
-			"         │   │           ├─ filters: [{[1, 1]}, {[2, 2]}]\n" +
-			"         │   │           └─ columns: [i s]\n" +
-			"         │   └─ HashLookup\n" +
-			"         │       ├─ left-key: (b.i)\n" +
-			"         │       ├─ right-key: (a.i)\n" +
-			"         │       └─ TableAlias(a)\n" +
-			"         │           └─ Table\n" +
-			"         │               ├─ name: mytable\n" +
-			"         │               └─ columns: [i s]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (b.I)\n" +
-			"             ├─ right-key: (e.i)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: e\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ TableAlias(t1)\n" +
-			"                         └─ IndexedTableAccess(mytable)\n" +
-			"                             ├─ index: [mytable.i]\n" +
-			"                             ├─ filters: [{[2, 2]}, {[3, 3]}]\n" +
-			"                             └─ columns: [i s]\n" +
+			"     └─ LookupJoin (estimated cost=3.305 rows=1) (actual rows=1 loops=1)\n" +
+			"         ├─ (a.i = t1.i)\n" +
+			"         ├─ InnerJoin (estimated cost=2.010 rows=1) (actual rows=1 loops=1)\n" +
+			"         │   ├─ (t2.i = t1.i)\n" +
+			"         │   ├─ TableAlias(t1)\n" +
+			"         │   │   └─ IndexedTableAccess(mytable)\n" +
+			"         │   │       ├─ index: [mytable.i]\n" +
+			"         │   │       ├─ filters: [{[2, 2]}, {[3, 3]}]\n" +
+			"         │   │       └─ columns: [i]\n" +
+			"         │   └─ TableAlias(t2)\n" +
+			"         │       └─ IndexedTableAccess(mytable)\n" +
+			"         │           ├─ index: [mytable.i]\n" +
+			"         │           ├─ filters: [{[1, 1]}, {[2, 2]}]\n" +
+			"         │           └─ columns: [i]\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ columns: [i s]\n" +
+			"                 └─ keys: t2.i\n" +
 			"",
 	},
 	{
@@ -21869,13 +21728,8 @@ With c as (
 			"     ├─ cacheable: true\n" +
 			"     ├─ colSet: (4)\n" +
 			"     ├─ tableId: 3\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: sq1\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ isLateral: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         ├─ colSet: (3)\n" +
-			"         ├─ tableId: 2\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [mytable.i:0!null->i:0]\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
 			"             ├─ index: [mytable.i]\n" +
 			"             ├─ static: [{(1, ∞)}]\n" +
@@ -21891,11 +21745,8 @@ With c as (
 			"     ├─ outerVisibility: false\n" +
 			"     ├─ isLateral: false\n" +
 			"     ├─ cacheable: true\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: sq1\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ isLateral: false\n" +
-			"         ├─ cacheable: true\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [mytable.i as i]\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
 			"             ├─ index: [mytable.i]\n" +
 			"             ├─ filters: [{(1, ∞)}]\n" +
@@ -21907,11 +21758,8 @@ With c as (
 			"     ├─ outerVisibility: false\n" +
 			"     ├─ isLateral: false\n" +
 			"     ├─ cacheable: true\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: sq1\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ isLateral: false\n" +
-			"         ├─ cacheable: true\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [mytable.i as i]\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
 			"             ├─ index: [mytable.i]\n" +
 			"             ├─ filters: [{(1, ∞)}]\n" +
@@ -22279,10 +22127,10 @@ WHERE keyless.c0 IN (
 			"         │       ├─ cacheable: false\n" +
 			"         │       ├─ alias-string: with recursive cte (depth, i, j) as (select 0, T1.c0, T1.c1 from keyless as T1 where T1.c0 = 0 union all select cte.depth + 1, cte.i, T2.c1 + 1 from cte, keyless as T2 where cte.depth = T2.c0) select U0.c0 from keyless as U0, cte where cte.j = keyless.c0\n" +
 			"         │       └─ Project\n" +
-			"         │           ├─ columns: [u0.c0:5]\n" +
+			"         │           ├─ columns: [u0.c0:4]\n" +
 			"         │           └─ Filter\n" +
 			"         │               ├─ Eq\n" +
-			"         │               │   ├─ cte.j:4\n" +
+			"         │               │   ├─ cte.j:3\n" +
 			"         │               │   └─ keyless.c0:0\n" +
 			"         │               └─ CrossHashJoin\n" +
 			"         │                   ├─ SubqueryAlias\n" +
@@ -22295,10 +22143,10 @@ WHERE keyless.c0 IN (
 			"         │                   │   └─ RecursiveCTE\n" +
 			"         │                   │       └─ Union all\n" +
 			"         │                   │           ├─ Project\n" +
-			"         │                   │           │   ├─ columns: [0 (tinyint), t1.c0:2, t1.c1:3]\n" +
+			"         │                   │           │   ├─ columns: [0 (tinyint), t1.c0:1, t1.c1:2]\n" +
 			"         │                   │           │   └─ Filter\n" +
 			"         │                   │           │       ├─ Eq\n" +
-			"         │                   │           │       │   ├─ t1.c0:2\n" +
+			"         │                   │           │       │   ├─ t1.c0:1\n" +
 			"         │                   │           │       │   └─ 0 (bigint)\n" +
 			"         │                   │           │       └─ TableAlias(t1)\n" +
 			"         │                   │           │           └─ Table\n" +
@@ -22307,11 +22155,11 @@ WHERE keyless.c0 IN (
 			"         │                   │           │               ├─ colSet: (3,4)\n" +
 			"         │                   │           │               └─ tableId: 2\n" +
 			"         │                   │           └─ Project\n" +
-			"         │                   │               ├─ columns: [(cte.depth:4!null + 1 (tinyint))->cte.depth + 1:0, cte.i:5, (t2.c1:3 + 1 (tinyint))->T2.c1 + 1:0]\n" +
+			"         │                   │               ├─ columns: [(cte.depth:3!null + 1 (tinyint))->cte.depth + 1:0, cte.i:4, (t2.c1:2 + 1 (tinyint))->T2.c1 + 1:0]\n" +
 			"         │                   │               └─ InnerJoin\n" +
 			"         │                   │                   ├─ Eq\n" +
-			"         │                   │                   │   ├─ cte.depth:4!null\n" +
-			"         │                   │                   │   └─ t2.c0:2\n" +
+			"         │                   │                   │   ├─ cte.depth:3!null\n" +
+			"         │                   │                   │   └─ t2.c0:1\n" +
 			"         │                   │                   ├─ TableAlias(t2)\n" +
 			"         │                   │                   │   └─ Table\n" +
 			"         │                   │                   │       ├─ name: keyless\n" +
@@ -22331,7 +22179,7 @@ WHERE keyless.c0 IN (
 			"         └─ ProcessTable\n" +
 			"             └─ Table\n" +
 			"                 ├─ name: keyless\n" +
-			"                 └─ columns: [c0 c1]\n" +
+			"                 └─ columns: [c0]\n" +
 			"",
 		ExpectedEstimates: "skip",
 		ExpectedAnalysis:  "skip",
@@ -22369,10 +22217,10 @@ WHERE keyless.c0 IN (
 			"         │       ├─ cacheable: false\n" +
 			"         │       ├─ alias-string: with recursive cte (depth, i, j) as (select 0, T1.c0, T1.c1 from keyless as T1 where T1.c0 = 0 union all select cte.depth + 1, cte.i, T2.c1 + 1 from cte, keyless as T2 where cte.depth = T2.c0) select U0.c0 from cte, keyless as U0 where cte.j = keyless.c0\n" +
 			"         │       └─ Project\n" +
-			"         │           ├─ columns: [u0.c0:5]\n" +
+			"         │           ├─ columns: [u0.c0:4]\n" +
 			"         │           └─ Filter\n" +
 			"         │               ├─ Eq\n" +
-			"         │               │   ├─ cte.j:4\n" +
+			"         │               │   ├─ cte.j:3\n" +
 			"         │               │   └─ keyless.c0:0\n" +
 			"         │               └─ CrossHashJoin\n" +
 			"         │                   ├─ SubqueryAlias\n" +
@@ -22385,10 +22233,10 @@ WHERE keyless.c0 IN (
 			"         │                   │   └─ RecursiveCTE\n" +
 			"         │                   │       └─ Union all\n" +
 			"         │                   │           ├─ Project\n" +
-			"         │                   │           │   ├─ columns: [0 (tinyint), t1.c0:2, t1.c1:3]\n" +
+			"         │                   │           │   ├─ columns: [0 (tinyint), t1.c0:1, t1.c1:2]\n" +
 			"         │                   │           │   └─ Filter\n" +
 			"         │                   │           │       ├─ Eq\n" +
-			"         │                   │           │       │   ├─ t1.c0:2\n" +
+			"         │                   │           │       │   ├─ t1.c0:1\n" +
 			"         │                   │           │       │   └─ 0 (bigint)\n" +
 			"         │                   │           │       └─ TableAlias(t1)\n" +
 			"         │                   │           │           └─ Table\n" +
@@ -22397,11 +22245,11 @@ WHERE keyless.c0 IN (
 			"         │                   │           │               ├─ colSet: (3,4)\n" +
 			"         │                   │           │               └─ tableId: 2\n" +
 			"         │                   │           └─ Project\n" +
-			"         │                   │               ├─ columns: [(cte.depth:4!null + 1 (tinyint))->cte.depth + 1:0, cte.i:5, (t2.c1:3 + 1 (tinyint))->T2.c1 + 1:0]\n" +
+			"         │                   │               ├─ columns: [(cte.depth:3!null + 1 (tinyint))->cte.depth + 1:0, cte.i:4, (t2.c1:2 + 1 (tinyint))->T2.c1 + 1:0]\n" +
 			"         │                   │               └─ InnerJoin\n" +
 			"         │                   │                   ├─ Eq\n" +
-			"         │                   │                   │   ├─ cte.depth:4!null\n" +
-			"         │                   │                   │   └─ t2.c0:2\n" +
+			"         │                   │                   │   ├─ cte.depth:3!null\n" +
+			"         │                   │                   │   └─ t2.c0:1\n" +
 			"         │                   │                   ├─ TableAlias(t2)\n" +
 			"         │                   │                   │   └─ Table\n" +
 			"         │                   │                   │       ├─ name: keyless\n" +
@@ -22421,7 +22269,7 @@ WHERE keyless.c0 IN (
 			"         └─ ProcessTable\n" +
 			"             └─ Table\n" +
 			"                 ├─ name: keyless\n" +
-			"                 └─ columns: [c0 c1]\n" +
+			"                 └─ columns: [c0]\n" +
 			"",
 		ExpectedEstimates: "skip",
 		ExpectedAnalysis:  "skip",
@@ -22560,26 +22408,41 @@ WHERE keyless.c0 IN (
 			" └─ GroupBy\n" +
 			"     ├─ select: two_pk.pk1:0!null, two_pk.pk2:1!null\n" +
 			"     ├─ group: two_pk.pk1:0!null, two_pk.pk2:1!null\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1-7)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: two_pk\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedEstimates: "Sort(two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ GroupBy\n" +
 			"     ├─ select: two_pk.pk1, two_pk.pk2\n" +
 			"     ├─ group: two_pk.pk1, two_pk.pk2\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedAnalysis: "Sort(two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ GroupBy\n" +
 			"     ├─ select: two_pk.pk1, two_pk.pk2\n" +
 			"     ├─ group: two_pk.pk1, two_pk.pk2\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
@@ -22588,26 +22451,41 @@ WHERE keyless.c0 IN (
 			" └─ GroupBy\n" +
 			"     ├─ select: two_pk.pk1:0!null, two_pk.pk2:1!null\n" +
 			"     ├─ group: two_pk.pk1:0!null, two_pk.pk2:1!null\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1-7)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: two_pk\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedEstimates: "Sort(two_pk.pk1 DESC, two_pk.pk2 DESC)\n" +
 			" └─ GroupBy\n" +
 			"     ├─ select: two_pk.pk1, two_pk.pk2\n" +
 			"     ├─ group: two_pk.pk1, two_pk.pk2\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedAnalysis: "Sort(two_pk.pk1 DESC, two_pk.pk2 DESC)\n" +
 			" └─ GroupBy\n" +
 			"     ├─ select: two_pk.pk1, two_pk.pk2\n" +
 			"     ├─ group: two_pk.pk1, two_pk.pk2\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
@@ -23665,55 +23543,36 @@ WHERE keyless.c0 IN (
 	},
 	{
 		Query: `with cte(i) as (select x from xy) select max(i) from cte`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [max(cte.i):0!null->max(i):0]\n" +
-			" └─ GroupBy\n" +
-			"     ├─ select: MAX(cte.i:0!null)\n" +
-			"     ├─ group: \n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: cte\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ isLateral: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         ├─ colSet: (4)\n" +
-			"         ├─ tableId: 3\n" +
-			"         └─ Table\n" +
-			"             ├─ name: xy\n" +
-			"             ├─ columns: [x]\n" +
-			"             ├─ colSet: (1,2)\n" +
-			"             └─ tableId: 1\n" +
-			"",
-		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [max(cte.i) as max(i)]\n" +
-			" └─ GroupBy\n" +
-			"     ├─ select: MAX(cte.i)\n" +
-			"     ├─ group: \n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: cte\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ isLateral: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         ├─ colSet: (4)\n" +
-			"         ├─ tableId: 3\n" +
+		ExpectedPlan: "Limit(1)\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [xy.x:0!null->max(cte.i):0->max(i):0]\n" +
+			"     └─ IndexedTableAccess(xy)\n" +
+			"         ├─ index: [xy.x]\n" +
+			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         ├─ reverse: true\n" +
+			"         ├─ colSet: (1,2)\n" +
+			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
 			"             ├─ name: xy\n" +
 			"             └─ columns: [x]\n" +
 			"",
-		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [max(cte.i) as max(i)]\n" +
-			" └─ GroupBy\n" +
-			"     ├─ select: MAX(cte.i)\n" +
-			"     ├─ group: \n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: cte\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ isLateral: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         ├─ colSet: (4)\n" +
-			"         ├─ tableId: 3\n" +
-			"         └─ Table\n" +
-			"             ├─ name: xy\n" +
-			"             └─ columns: [x]\n" +
+		ExpectedEstimates: "Limit(1)\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [xy.x as max(cte.i) as max(i)]\n" +
+			"     └─ IndexedTableAccess(xy)\n" +
+			"         ├─ index: [xy.x]\n" +
+			"         ├─ filters: [{[NULL, ∞)}]\n" +
+			"         ├─ columns: [x]\n" +
+			"         └─ reverse: true\n" +
+			"",
+		ExpectedAnalysis: "Limit(1)\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [xy.x as max(cte.i) as max(i)]\n" +
+			"     └─ IndexedTableAccess(xy)\n" +
+			"         ├─ index: [xy.x]\n" +
+			"         ├─ filters: [{[NULL, ∞)}]\n" +
+			"         ├─ columns: [x]\n" +
+			"         └─ reverse: true\n" +
 			"",
 	},
 	{
@@ -23952,26 +23811,19 @@ order by i;`,
 			"                         │   ├─ colSet: (3,4)\n" +
 			"                         │   └─ tableId: 2\n" +
 			"                         └─ Project\n" +
-			"                             ├─ columns: [sqa1.x:2!null]\n" +
+			"                             ├─ columns: [xy.x:2!null->x:0]\n" +
 			"                             └─ InnerJoin\n" +
 			"                                 ├─ Eq\n" +
-			"                                 │   ├─ sqa1.x:2!null\n" +
-			"                                 │   └─ cte.a:4\n" +
-			"                                 ├─ SubqueryAlias\n" +
-			"                                 │   ├─ name: sqa1\n" +
-			"                                 │   ├─ outerVisibility: false\n" +
-			"                                 │   ├─ isLateral: true\n" +
-			"                                 │   ├─ cacheable: true\n" +
-			"                                 │   ├─ colSet: (9,10)\n" +
-			"                                 │   ├─ tableId: 6\n" +
-			"                                 │   └─ IndexedTableAccess(xy)\n" +
-			"                                 │       ├─ index: [xy.x]\n" +
-			"                                 │       ├─ static: [{[1, 1]}]\n" +
-			"                                 │       ├─ colSet: (7,8)\n" +
-			"                                 │       ├─ tableId: 5\n" +
-			"                                 │       └─ Table\n" +
-			"                                 │           ├─ name: xy\n" +
-			"                                 │           └─ columns: [x y]\n" +
+			"                                 │   ├─ xy.x:2!null\n" +
+			"                                 │   └─ cte.a:3\n" +
+			"                                 ├─ IndexedTableAccess(xy)\n" +
+			"                                 │   ├─ index: [xy.x]\n" +
+			"                                 │   ├─ static: [{[1, 1]}]\n" +
+			"                                 │   ├─ colSet: (7,8)\n" +
+			"                                 │   ├─ tableId: 5\n" +
+			"                                 │   └─ Table\n" +
+			"                                 │       ├─ name: xy\n" +
+			"                                 │       └─ columns: [x]\n" +
 			"                                 └─ RecursiveTable(cte)\n" +
 			"",
 		ExpectedEstimates: "Sort(mytable.i ASC)\n" +
@@ -23993,20 +23845,13 @@ order by i;`,
 			"                         │   ├─ name: xy\n" +
 			"                         │   └─ columns: [y]\n" +
 			"                         └─ Project\n" +
-			"                             ├─ columns: [sqa1.x]\n" +
+			"                             ├─ columns: [xy.x as x]\n" +
 			"                             └─ InnerJoin\n" +
-			"                                 ├─ (sqa1.x = cte.a)\n" +
-			"                                 ├─ SubqueryAlias\n" +
-			"                                 │   ├─ name: sqa1\n" +
-			"                                 │   ├─ outerVisibility: false\n" +
-			"                                 │   ├─ isLateral: true\n" +
-			"                                 │   ├─ cacheable: true\n" +
-			"                                 │   ├─ colSet: (9,10)\n" +
-			"                                 │   ├─ tableId: 6\n" +
-			"                                 │   └─ IndexedTableAccess(xy)\n" +
-			"                                 │       ├─ index: [xy.x]\n" +
-			"                                 │       ├─ filters: [{[1, 1]}]\n" +
-			"                                 │       └─ columns: [x y]\n" +
+			"                                 ├─ (xy.x = cte.a)\n" +
+			"                                 ├─ IndexedTableAccess(xy)\n" +
+			"                                 │   ├─ index: [xy.x]\n" +
+			"                                 │   ├─ filters: [{[1, 1]}]\n" +
+			"                                 │   └─ columns: [x]\n" +
 			"                                 └─ RecursiveTable(cte)\n" +
 			"",
 		ExpectedAnalysis: "Sort(mytable.i ASC)\n" +
@@ -24028,20 +23873,13 @@ order by i;`,
 			"                         │   ├─ name: xy\n" +
 			"                         │   └─ columns: [y]\n" +
 			"                         └─ Project\n" +
-			"                             ├─ columns: [sqa1.x]\n" +
+			"                             ├─ columns: [xy.x as x]\n" +
 			"                             └─ InnerJoin\n" +
-			"                                 ├─ (sqa1.x = cte.a)\n" +
-			"                                 ├─ SubqueryAlias\n" +
-			"                                 │   ├─ name: sqa1\n" +
-			"                                 │   ├─ outerVisibility: false\n" +
-			"                                 │   ├─ isLateral: true\n" +
-			"                                 │   ├─ cacheable: true\n" +
-			"                                 │   ├─ colSet: (9,10)\n" +
-			"                                 │   ├─ tableId: 6\n" +
-			"                                 │   └─ IndexedTableAccess(xy)\n" +
-			"                                 │       ├─ index: [xy.x]\n" +
-			"                                 │       ├─ filters: [{[1, 1]}]\n" +
-			"                                 │       └─ columns: [x y]\n" +
+			"                                 ├─ (xy.x = cte.a)\n" +
+			"                                 ├─ IndexedTableAccess(xy)\n" +
+			"                                 │   ├─ index: [xy.x]\n" +
+			"                                 │   ├─ filters: [{[1, 1]}]\n" +
+			"                                 │   └─ columns: [x]\n" +
 			"                                 └─ RecursiveTable(cte)\n" +
 			"",
 	},
@@ -24096,9 +23934,11 @@ order by xy.x, xy.y, uv.u, uv.v;`,
 			"     └─ LookupJoin (estimated cost=13.338 rows=4)\n" +
 			"         ├─ (uv.v = Subquery(select max(v) from uv where xy.x = uv.u))\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: uv\n" +
+			"         │   ├─ name: uv\n" +
+			"         │   └─ columns: [u v]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
+			"             ├─ columns: [x y]\n" +
 			"             └─ keys: uv.u\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -24107,9 +23947,11 @@ order by xy.x, xy.y, uv.u, uv.v;`,
 			"     └─ LookupJoin (estimated cost=13.338 rows=4) (actual rows=4 loops=1)\n" +
 			"         ├─ (uv.v = Subquery(select max(v) from uv where xy.x = uv.u))\n" +
 			"         ├─ Table\n" +
-			"         │   └─ name: uv\n" +
+			"         │   ├─ name: uv\n" +
+			"         │   └─ columns: [u v]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
+			"             ├─ columns: [x y]\n" +
 			"             └─ keys: uv.u\n" +
 			"",
 	},
@@ -24165,17 +24007,21 @@ where exists (
 			" ├─ (ab.b = Subquery(select max(v) from uv where uv.v = ab2.a and uv.v = ab.a))\n" +
 			" ├─ TableAlias(ab2)\n" +
 			" │   └─ Table\n" +
-			" │       └─ name: ab\n" +
+			" │       ├─ name: ab\n" +
+			" │       └─ columns: [a b]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 		ExpectedAnalysis: "SemiJoin (estimated cost=506000.000 rows=1250) (actual rows=1 loops=1)\n" +
 			" ├─ (ab.b = Subquery(select max(v) from uv where uv.v = ab2.a and uv.v = ab.a))\n" +
 			" ├─ TableAlias(ab2)\n" +
 			" │   └─ Table\n" +
-			" │       └─ name: ab\n" +
+			" │       ├─ name: ab\n" +
+			" │       └─ columns: [a b]\n" +
 			" └─ Table\n" +
-			"     └─ name: ab\n" +
+			"     ├─ name: ab\n" +
+			"     └─ columns: [a b]\n" +
 			"",
 	},
 	{
@@ -24234,18 +24080,22 @@ order by x, y;
 			"     ├─ (xy.y = Subquery(select max(v) from uv where uv.v = xy2.x and uv.v = xy.x))\n" +
 			"     ├─ TableAlias(xy2)\n" +
 			"     │   └─ Table\n" +
-			"     │       └─ name: xy\n" +
+			"     │       ├─ name: xy\n" +
+			"     │       └─ columns: [x y]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 		ExpectedAnalysis: "Sort(xy2.x ASC, xy2.y ASC)\n" +
 			" └─ SemiJoin (estimated cost=506000.000 rows=1250) (actual rows=0 loops=1)\n" +
 			"     ├─ (xy.y = Subquery(select max(v) from uv where uv.v = xy2.x and uv.v = xy.x))\n" +
 			"     ├─ TableAlias(xy2)\n" +
 			"     │   └─ Table\n" +
-			"     │       └─ name: xy\n" +
+			"     │       ├─ name: xy\n" +
+			"     │       └─ columns: [x y]\n" +
 			"     └─ Table\n" +
-			"         └─ name: xy\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 	},
 	{
@@ -24537,74 +24387,61 @@ order by x, y;
 			" │       ├─ name: mytable\n" +
 			" │       └─ columns: [i s]\n" +
 			" └─ Limit(1)\n" +
-			"     └─ LeftOuterHashJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ l.s:2!null\n" +
+			"     └─ LeftOuterMergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ mytable_1.s:2!null\n" +
 			"         │   └─ othertable.s2:3!null\n" +
-			"         ├─ CachedResults\n" +
-			"         │   └─ SubqueryAlias\n" +
-			"         │       ├─ name: l\n" +
-			"         │       ├─ outerVisibility: true\n" +
-			"         │       ├─ isLateral: false\n" +
-			"         │       ├─ cacheable: true\n" +
-			"         │       ├─ colSet: (5)\n" +
-			"         │       ├─ tableId: 3\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: mytable\n" +
-			"         │           ├─ columns: [s]\n" +
-			"         │           ├─ colSet: (3,4)\n" +
-			"         │           └─ tableId: 2\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(l.s:2!null)\n" +
-			"             ├─ right-key: TUPLE(othertable.s2:0!null)\n" +
-			"             └─ ProcessTable\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
-			"                     └─ columns: [s2]\n" +
-			"",
-		ExpectedEstimates: "AntiJoinIncludingNulls (estimated cost=154.500 rows=2)\n" +
-			" ├─ Table\n" +
-			" │   └─ name: mytable\n" +
-			" └─ Limit(1)\n" +
-			"     └─ LeftOuterHashJoin\n" +
-			"         ├─ (l.s = othertable.s2)\n" +
-			"         ├─ CachedResults\n" +
-			"         │   └─ SubqueryAlias\n" +
-			"         │       ├─ name: l\n" +
-			"         │       ├─ outerVisibility: true\n" +
-			"         │       ├─ isLateral: false\n" +
-			"         │       ├─ cacheable: true\n" +
+			"         ├─ TableAlias(mytable_1)\n" +
+			"         │   └─ IndexedTableAccess(mytable)\n" +
+			"         │       ├─ index: [mytable.s]\n" +
+			"         │       ├─ static: [{[NULL, ∞)}]\n" +
+			"         │       ├─ colSet: (3,4)\n" +
+			"         │       ├─ tableId: 2\n" +
 			"         │       └─ Table\n" +
 			"         │           ├─ name: mytable\n" +
 			"         │           └─ columns: [s]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (l.s)\n" +
-			"             ├─ right-key: (othertable.s2)\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.s2,othertable.i2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (6,7)\n" +
+			"             ├─ tableId: 4\n" +
 			"             └─ Table\n" +
 			"                 ├─ name: othertable\n" +
 			"                 └─ columns: [s2]\n" +
 			"",
-		ExpectedAnalysis: "AntiJoinIncludingNulls (estimated cost=154.500 rows=2) (actual rows=0 loops=1)\n" +
+		ExpectedEstimates: "AntiJoinIncludingNulls (estimated cost=7.545 rows=2)\n" +
 			" ├─ Table\n" +
-			" │   └─ name: mytable\n" +
+			" │   ├─ name: mytable\n" +
+			" │   └─ columns: [i s]\n" +
 			" └─ Limit(1)\n" +
-			"     └─ LeftOuterHashJoin\n" +
-			"         ├─ (l.s = othertable.s2)\n" +
-			"         ├─ CachedResults\n" +
-			"         │   └─ SubqueryAlias\n" +
-			"         │       ├─ name: l\n" +
-			"         │       ├─ outerVisibility: true\n" +
-			"         │       ├─ isLateral: false\n" +
-			"         │       ├─ cacheable: true\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: mytable\n" +
-			"         │           └─ columns: [s]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: (l.s)\n" +
-			"             ├─ right-key: (othertable.s2)\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: othertable\n" +
-			"                 └─ columns: [s2]\n" +
+			"     └─ LeftOuterMergeJoin\n" +
+			"         ├─ cmp: (mytable_1.s = othertable.s2)\n" +
+			"         ├─ TableAlias(mytable_1)\n" +
+			"         │   └─ IndexedTableAccess(mytable)\n" +
+			"         │       ├─ index: [mytable.s]\n" +
+			"         │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │       └─ columns: [s]\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.s2,othertable.i2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [s2]\n" +
+			"",
+		ExpectedAnalysis: "AntiJoinIncludingNulls (estimated cost=7.545 rows=2) (actual rows=0 loops=1)\n" +
+			" ├─ Table\n" +
+			" │   ├─ name: mytable\n" +
+			" │   └─ columns: [i s]\n" +
+			" └─ Limit(1)\n" +
+			"     └─ LeftOuterMergeJoin\n" +
+			"         ├─ cmp: (mytable_1.s = othertable.s2)\n" +
+			"         ├─ TableAlias(mytable_1)\n" +
+			"         │   └─ IndexedTableAccess(mytable)\n" +
+			"         │       ├─ index: [mytable.s]\n" +
+			"         │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │       └─ columns: [s]\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.s2,othertable.i2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [s2]\n" +
 			"",
 	},
 	{
@@ -24657,27 +24494,39 @@ order by x, y;
 	},
 	{
 		Query: `select distinct pk1 from two_pk order by pk1`,
-		ExpectedPlan: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     ├─ colSet: (1-7)\n" +
-			"     ├─ tableId: 1\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1]\n" +
+		ExpectedPlan: "Sort(two_pk.pk1:0!null ASC nullsFirst)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1-7)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: two_pk\n" +
+			"                 └─ columns: [pk1]\n" +
 			"",
-		ExpectedEstimates: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk1]\n" +
+		ExpectedEstimates: "Sort(two_pk.pk1 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1]\n" +
 			"",
-		ExpectedAnalysis: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk1]\n" +
+		ExpectedAnalysis: "Sort(two_pk.pk1 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1]\n" +
 			"",
 	},
 	{
@@ -24763,127 +24612,193 @@ order by x, y;
 	},
 	{
 		Query: `select distinct pk1, pk2 from two_pk order by pk1`,
-		ExpectedPlan: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     ├─ colSet: (1-7)\n" +
-			"     ├─ tableId: 1\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+		ExpectedPlan: "Sort(two_pk.pk1:0!null ASC nullsFirst)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1-7)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: two_pk\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
-		ExpectedEstimates: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk1 pk2]\n" +
+		ExpectedEstimates: "Sort(two_pk.pk1 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
-		ExpectedAnalysis: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk1 pk2]\n" +
+		ExpectedAnalysis: "Sort(two_pk.pk1 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
 		Query: `select distinct pk1, pk2 from two_pk order by pk2`,
 		ExpectedPlan: "Sort(two_pk.pk2:1!null ASC nullsFirst)\n" +
 			" └─ Distinct\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1-7)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: two_pk\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedEstimates: "Sort(two_pk.pk2 ASC)\n" +
 			" └─ Distinct\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedAnalysis: "Sort(two_pk.pk2 ASC)\n" +
 			" └─ Distinct\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
 		Query: `select distinct pk1, pk2 from two_pk order by pk1, pk2`,
-		ExpectedPlan: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     ├─ colSet: (1-7)\n" +
-			"     ├─ tableId: 1\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+		ExpectedPlan: "Sort(two_pk.pk1:0!null ASC nullsFirst, two_pk.pk2:1!null ASC nullsFirst)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1-7)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: two_pk\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
-		ExpectedEstimates: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk1 pk2]\n" +
+		ExpectedEstimates: "Sort(two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
-		ExpectedAnalysis: "Distinct\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk1 pk2]\n" +
+		ExpectedAnalysis: "Sort(two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
 		Query: `select distinct pk1, pk2 from two_pk order by pk2, pk1`,
 		ExpectedPlan: "Sort(two_pk.pk2:1!null ASC nullsFirst, two_pk.pk1:0!null ASC nullsFirst)\n" +
 			" └─ Distinct\n" +
-			"     └─ ProcessTable\n" +
-			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1-7)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: two_pk\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedEstimates: "Sort(two_pk.pk2 ASC, two_pk.pk1 ASC)\n" +
 			" └─ Distinct\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedAnalysis: "Sort(two_pk.pk2 ASC, two_pk.pk1 ASC)\n" +
 			" └─ Distinct\n" +
-			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+			"     └─ LooseIndexScan\n" +
+			"         ├─ Using index for group-by\n" +
+			"         ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk)\n" +
+			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
 		Query: `select distinct pk2, pk1 from two_pk order by pk1, pk2`,
-		ExpectedPlan: "Distinct\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [two_pk.pk2:1!null, two_pk.pk1:0!null]\n" +
-			"     └─ IndexedTableAccess(two_pk)\n" +
-			"         ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"         ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         ├─ colSet: (1-7)\n" +
-			"         ├─ tableId: 1\n" +
-			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+		ExpectedPlan: "Sort(two_pk.pk1:1!null ASC nullsFirst, two_pk.pk2:0!null ASC nullsFirst)\n" +
+			" └─ Distinct\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [two_pk.pk2:1!null, two_pk.pk1:0!null]\n" +
+			"         └─ LooseIndexScan\n" +
+			"             ├─ Using index for group-by\n" +
+			"             ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk)\n" +
+			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                 ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 ├─ colSet: (1-7)\n" +
+			"                 ├─ tableId: 1\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: two_pk\n" +
+			"                     └─ columns: [pk1 pk2]\n" +
 			"",
-		ExpectedEstimates: "Distinct\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [two_pk.pk2, two_pk.pk1]\n" +
-			"     └─ IndexedTableAccess(two_pk)\n" +
-			"         ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"         ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+		ExpectedEstimates: "Sort(two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [two_pk.pk2, two_pk.pk1]\n" +
+			"         └─ LooseIndexScan\n" +
+			"             ├─ Using index for group-by\n" +
+			"             ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk)\n" +
+			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                 ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
-		ExpectedAnalysis: "Distinct\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [two_pk.pk2, two_pk.pk1]\n" +
-			"     └─ IndexedTableAccess(two_pk)\n" +
-			"         ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"         ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         └─ columns: [pk1 pk2]\n" +
+		ExpectedAnalysis: "Sort(two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
+			" └─ Distinct\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [two_pk.pk2, two_pk.pk1]\n" +
+			"         └─ LooseIndexScan\n" +
+			"             ├─ Using index for group-by\n" +
+			"             ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk)\n" +
+			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                 ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
@@ -24892,26 +24807,41 @@ order by x, y;
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [two_pk.pk2:1!null, two_pk.pk1:0!null]\n" +
-			"         └─ ProcessTable\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: two_pk\n" +
-			"                 └─ columns: [pk1 pk2]\n" +
+			"         └─ LooseIndexScan\n" +
+			"             ├─ Using index for group-by\n" +
+			"             ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk)\n" +
+			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                 ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 ├─ colSet: (1-7)\n" +
+			"                 ├─ tableId: 1\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: two_pk\n" +
+			"                     └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedEstimates: "Sort(two_pk.pk2 ASC, two_pk.pk1 ASC)\n" +
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [two_pk.pk2, two_pk.pk1]\n" +
-			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+			"         └─ LooseIndexScan\n" +
+			"             ├─ Using index for group-by\n" +
+			"             ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk)\n" +
+			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                 ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 		ExpectedAnalysis: "Sort(two_pk.pk2 ASC, two_pk.pk1 ASC)\n" +
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [two_pk.pk2, two_pk.pk1]\n" +
-			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+			"         └─ LooseIndexScan\n" +
+			"             ├─ Using index for group-by\n" +
+			"             ├─ prefix: [two_pk.pk1, two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk)\n" +
+			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                 ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
@@ -25173,7 +25103,8 @@ order by x, y;
 			" └─ SemiJoin (estimated cost=40.320 rows=10)\n" +
 			"     ├─ (one_pk_three_idx.v1 = pk_table.v2)\n" +
 			"     ├─ Table\n" +
-			"     │   └─ name: one_pk_three_idx\n" +
+			"     │   ├─ name: one_pk_three_idx\n" +
+			"     │   └─ columns: [pk v1 v2 v3]\n" +
 			"     └─ TableAlias(pk_table)\n" +
 			"         └─ Table\n" +
 			"             ├─ name: one_pk_three_idx\n" +
@@ -25184,7 +25115,8 @@ order by x, y;
 			" └─ SemiJoin (estimated cost=40.320 rows=10) (actual rows=8 loops=1)\n" +
 			"     ├─ (one_pk_three_idx.v1 = pk_table.v2)\n" +
 			"     ├─ Table\n" +
-			"     │   └─ name: one_pk_three_idx\n" +
+			"     │   ├─ name: one_pk_three_idx\n" +
+			"     │   └─ columns: [pk v1 v2 v3]\n" +
 			"     └─ TableAlias(pk_table)\n" +
 			"         └─ Table\n" +
 			"             ├─ name: one_pk_three_idx\n" +
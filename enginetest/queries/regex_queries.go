@@ -2160,6 +2160,58 @@ var RegexTests = []RegexTest{
 		Query:    "SELECT REGEXP_SUBSTR('abc def ghi', '[j-z]+');",
 		Expected: []sql.Row{{nil}},
 	},
+	{
+		Query:    "SELECT REGEXP_INSTR('ñandú ñu', 'ñ', 2);",
+		Expected: []sql.Row{{7}},
+	},
+	{
+		Query:    "SELECT REGEXP_INSTR('😀a😀b', 'b', 1, 1, 1);",
+		Expected: []sql.Row{{5}},
+	},
+	{
+		Query:    "SELECT REGEXP_SUBSTR('😀abc 😀def', '[a-z]+', 3);",
+		Expected: []sql.Row{{"bc"}},
+	},
+	{
+		Query:    "SELECT REGEXP_REPLACE('😀abc 😀abc', 'abc', 'x', 3);",
+		Expected: []sql.Row{{"😀abc 😀x"}},
+	},
+	{
+		Query:    "SELECT REGEXP_REPLACE('abc', 'c', 'x', 4);",
+		Expected: []sql.Row{{"abc"}},
+	},
+	{
+		Query:       "SELECT REGEXP_REPLACE('abc', 'c', 'x', 5);",
+		ExpectedErr: sql.ErrRegexpIndexOutOfBounds,
+	},
+	{
+		Query:       "SELECT REGEXP_SUBSTR('abc', 'c', 5);",
+		ExpectedErr: sql.ErrRegexpIndexOutOfBounds,
+	},
+	{
+		Query:       "SELECT REGEXP_INSTR('abc', 'c', 0);",
+		ExpectedErr: sql.ErrInvalidArgumentDetails,
+	},
+	{
+		Query:       "SELECT REGEXP_INSTR('abc', 'c', 1, 1, 2);",
+		ExpectedErr: sql.ErrInvalidArgumentDetails,
+	},
+	{
+		Query:    "SELECT REGEXP_LIKE('ABC' COLLATE utf8mb4_0900_ai_ci, 'abc', 'c');",
+		Expected: []sql.Row{{0}},
+	},
+	{
+		Query:    "SELECT REGEXP_LIKE('ABC' COLLATE utf8mb4_0900_bin, 'abc', 'i');",
+		Expected: []sql.Row{{1}},
+	},
+	{
+		Query:    "SELECT REGEXP_LIKE('ABC' COLLATE utf8mb4_0900_ai_ci, 'abc');",
+		Expected: []sql.Row{{1}},
+	},
+	{
+		Query:    "SELECT REGEXP_SUBSTR('ABC abc', 'abc', 1, 1, 'c');",
+		Expected: []sql.Row{{"abc"}},
+	},
 }
 
 // RegexScriptTests holds ScriptTests for the regexp functions.
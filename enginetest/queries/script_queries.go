@@ -17,6 +17,7 @@ package queries
 import (
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/dolthub/vitess/go/mysql"
@@ -122,6 +123,138 @@ type ScriptTestAssertion struct {
 // Unlike other engine tests, ScriptTests must be self-contained. No other tables are created outside the definition of
 // the tests.
 var ScriptTests = []ScriptTest{
+	{
+		Name: "UUID columns",
+		SetUpScript: []string{
+			"CREATE TABLE uuids (id UUID PRIMARY KEY, `name` varchar(20), parent uuid NULL, KEY (parent))",
+			"INSERT INTO uuids VALUES ('c27a0000-0000-11ee-8000-000000000001', 'b', NULL), ('{3C27A000-0000-11EF-8000-000000000001}', 'c', 'c27a0000-0000-11ee-8000-000000000001'), ('0c27a0000000401e8000000000000001', 'a', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// version 1 UUIDs are ordered by time, and others by their bytes
+				Query: "SELECT concat(id, ''), name FROM uuids ORDER BY id",
+				Expected: []sql.Row{
+					{"0c27a000-0000-401e-8000-000000000001", "a"},
+					{"c27a0000-0000-11ee-8000-000000000001", "b"},
+					{"3c27a000-0000-11ef-8000-000000000001", "c"},
+				},
+			},
+			{
+				Query:    "SELECT name FROM uuids WHERE id = 'C27A0000-0000-11EE-8000-000000000001'",
+				Expected: []sql.Row{{"b"}},
+			},
+			{
+				Query:    "SELECT name FROM uuids WHERE id = 'not a uuid'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT name FROM uuids WHERE id > '0c27a000-0000-401e-8000-000000000001' ORDER BY id",
+				Expected: []sql.Row{{"b"}, {"c"}},
+			},
+			{
+				Query:    "SELECT a.name FROM uuids a JOIN uuids b ON a.id = b.parent",
+				Expected: []sql.Row{{"b"}},
+			},
+			{
+				Query:    "SELECT hex(uuid_to_bin(id)), hex(uuid_to_bin(id, 1)), bin_to_uuid(uuid_to_bin(id, 1), 1) = id, is_uuid(id), concat(id, '') FROM uuids WHERE name = 'b'",
+				Expected: []sql.Row{{"C27A0000000011EE8000000000000001", "11EE0000C27A00008000000000000001", true, true, "c27a0000-0000-11ee-8000-000000000001"}},
+			},
+			{
+				Query:       "INSERT INTO uuids VALUES ('not a uuid', 'd', NULL)",
+				ExpectedErr: types.ErrConvertingToUuid,
+			},
+			{
+				Query:    "INSERT INTO uuids VALUES (uuid_to_bin('0c27a000-0000-401e-8000-000000000002'), 'd', NULL)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT name FROM uuids WHERE id = '0c27a000-0000-401e-8000-000000000002'",
+				Expected: []sql.Row{{"d"}},
+			},
+			{
+				Query: "SHOW CREATE TABLE uuids",
+				Expected: []sql.Row{{"uuids", "CREATE TABLE `uuids` (\n" +
+					"  `id` uuid NOT NULL,\n" +
+					"  `name` varchar(20),\n" +
+					"  `parent` uuid,\n" +
+					"  PRIMARY KEY (`id`),\n" +
+					"  KEY `parent` (`parent`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "ALTER TABLE uuids ADD COLUMN other uuid DEFAULT ('c27a0000-0000-11ee-8000-000000000001'), MODIFY COLUMN parent uuid DEFAULT ('c27a0000-0000-11ee-8000-000000000002')",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "INSERT INTO uuids (id, name) VALUES ('0c27a000-0000-401e-8000-000000000003', 'e')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT name, concat(other, ''), concat(parent, '') FROM uuids WHERE name IN ('a', 'e') ORDER BY name",
+				Expected: []sql.Row{{"a", "c27a0000-0000-11ee-8000-000000000001", nil}, {"e", "c27a0000-0000-11ee-8000-000000000001", "c27a0000-0000-11ee-8000-000000000002"}},
+			},
+			{
+				Query:    "CREATE /* uuids */ TABLE commented_uuids (id /* key */ UUID /* type */ PRIMARY KEY)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT column_type FROM information_schema.columns WHERE table_name = 'commented_uuids'",
+				Expected: []sql.Row{{"uuid"}},
+			},
+			{
+				Query:       "CREATE TABLE bad_uuids (id uuid(16))",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:    "SET uuid_binary_compatibility = 1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "CREATE TABLE binary_uuids (id uuid PRIMARY KEY)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT column_type FROM information_schema.columns WHERE table_name = 'binary_uuids'",
+				Expected: []sql.Row{{"binary(16)"}},
+			},
+		},
+	},
+	{
+		Name: "filters and projections evaluated a batch of rows at a time",
+		SetUpScript: []string{
+			"CREATE TABLE vt (pk INT PRIMARY KEY, a INT, b INT, s VARCHAR(20), f DOUBLE)",
+			"INSERT INTO vt VALUES (1, 1, 2, 'Abc', NULL), (2, -3, NULL, 'dEf', 1.5), (3, NULL, 4, NULL, -2), (4, 0, 0, '', NULL), (5, 5, -5, 'ghi', 0)",
+			"SET vectorized_execution = 1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT pk, a + b, a * b - 1, abs(a), lower(s), upper(s), length(s), coalesce(f, a, 7) FROM vt ORDER BY pk",
+				Expected: []sql.Row{
+					{1, 3, 1, 1, "abc", "ABC", 3, 1.0},
+					{2, nil, nil, 3, "def", "DEF", 3, 1.5},
+					{3, nil, nil, nil, nil, nil, nil, -2.0},
+					{4, 0, -1, 0, "", "", 0, 0.0},
+					{5, 0, -26, 5, "ghi", "GHI", 3, 0.0},
+				},
+			},
+			{
+				Query:    "SELECT pk FROM vt WHERE a < b OR f IS NULL ORDER BY pk",
+				Expected: []sql.Row{{1}, {4}},
+			},
+			{
+				Query:    "SELECT pk, a = b FROM vt WHERE NOT (a >= 0 AND b <= 0) ORDER BY pk",
+				Expected: []sql.Row{{1, false}, {2, nil}, {3, nil}},
+			},
+			{
+				Query:    "SELECT pk FROM vt WHERE s ORDER BY pk",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM vt WHERE a ORDER BY pk",
+				Expected: []sql.Row{{1}, {2}, {5}},
+			},
+		},
+	},
 	{
 		// https://github.com/dolthub/dolt/issues/10113
 		Name: "DELETE with NOT EXISTS subquery",
@@ -784,13 +917,12 @@ FROM task_instance INNER JOIN job ON job.id = task_instance.queued_by_job_id INN
 				Expected: []sql.Row{{true}},
 			},
 			{
-				// TODO: 123.456 is converted to a DECIMAL by Builder.ConvertVal, when it should be a DOUBLE
 				SkipResultCheckOnServerEngine:   true, // TODO: warnings do not make it to server engine
 				Query:                           "SELECT '123.456ABC' = 123.456;",
 				Expected:                        []sql.Row{{true}},
 				ExpectedWarningsCount:           1,
 				ExpectedWarning:                 mysql.ERTruncatedWrongValue,
-				ExpectedWarningMessageSubstring: "Truncated incorrect decimal(65,30) value: 123.456ABC",
+				ExpectedWarningMessageSubstring: "Truncated incorrect double value: 123.456ABC",
 			},
 			{
 				Query:    "SELECT '123.456e2' = 12345.6;",
@@ -926,7 +1058,7 @@ FROM task_instance INNER JOIN job ON job.id = task_instance.queued_by_job_id INN
 				Expected:                        []sql.Row{{true}},
 				ExpectedWarningsCount:           1,
 				ExpectedWarning:                 mysql.ERTruncatedWrongValue,
-				ExpectedWarningMessageSubstring: "Truncated incorrect decimal(65,30) value: 123.456ABC",
+				ExpectedWarningMessageSubstring: "Truncated incorrect double value: 123.456ABC",
 			},
 			{
 				Query:    "SELECT '123.456e2' in (12345.6);",
@@ -1887,6 +2019,40 @@ FROM task_instance INNER JOIN job ON job.id = task_instance.queued_by_job_id INN
 			},
 		},
 	},
+	{
+		Name:    "bit values in numeric and string contexts",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (pk int primary key, b bit(8), b64 bit(64));",
+			"insert into t values (1, b'1000001', 18446744073709551615), (2, 240, 1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select b + 0, b * 2, cast(b as signed), b = 65 from t order by pk;",
+				Expected: []sql.Row{{65, 130, 65, true}, {240, 480, 240, false}},
+			},
+			{
+				Query:    "select bit_or(b), bit_and(b), bit_xor(b), sum(b) from t;",
+				Expected: []sql.Row{{uint64(241), uint64(64), uint64(177), float64(305)}},
+			},
+			{
+				Query:    "select b64 & 18446744073709551614, b64 | 0, ~b64, b64 >> 60 from t where pk = 1;",
+				Expected: []sql.Row{{uint64(18446744073709551614), uint64(18446744073709551615), uint64(0), uint64(15)}},
+			},
+			{
+				Query:    "select ~0, ~1, ~null, ~b'1';",
+				Expected: []sql.Row{{uint64(18446744073709551615), uint64(18446744073709551614), nil, uint64(18446744073709551614)}},
+			},
+			{
+				Query:    "select cast(b as char), concat('x', b), length(b), hex(cast(b64 as binary)) from t where pk = 1;",
+				Expected: []sql.Row{{"A", "xA", 1, "FFFFFFFFFFFFFFFF"}},
+			},
+			{
+				Query:    "select cast(b'1000001' as char), concat(b'1000001'), b'1000001' + 0;",
+				Expected: []sql.Row{{"A", "A", 65}},
+			},
+		},
+	},
 	{
 		Name: "outer join finish unmatched right side",
 		SetUpScript: []string{
@@ -1996,6 +2162,7 @@ CREATE TABLE sourceTable_test (
 		Name: "histogram bucket merging error for implementor buckets",
 		SetUpScript: []string{
 			"CREATE TABLE xy (x int primary key, y varchar(10), key(y));",
+			"SET @@cte_max_recursion_depth = 5000;",
 			"insert into xy select x, 'x' from (with recursive inputs(x) as (select 1 union select x+1 from inputs where x < 5000) select * from inputs) dt",
 			"analyze table xy",
 		},
@@ -3815,7 +3982,7 @@ CREATE TABLE tab3 (
 
 			{
 				Query:    "insert into t(pk) values (10), (default);",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 10}}},
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 11}}},
 			},
 			{
 				Query: "select last_insert_id()",
@@ -3839,7 +4006,7 @@ CREATE TABLE tab3 (
 
 			{
 				Query:    "insert into t(pk) values (20), (default), (default);",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 3, InsertID: 20}}},
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 3, InsertID: 21}}},
 			},
 			{
 				Query: "select last_insert_id()",
@@ -3983,6 +4150,111 @@ CREATE TABLE tab3 (
 			},
 		},
 	},
+	{
+		Name:    "auto_increment_increment and auto_increment_offset",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (pk int primary key auto_increment, v int)",
+			"set auto_increment_increment = 10",
+			"set auto_increment_offset = 5",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t(v) values (1), (2), (3)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 3, InsertID: 5}}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{uint64(5)}},
+			},
+			{
+				Query:    "insert into t values (27, 4)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 27}}},
+			},
+			{
+				Query:    "insert into t(v) values (5)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 35}}},
+			},
+			{
+				// an offset greater than the increment is ignored
+				Query:    "set auto_increment_offset = 20",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t(v) values (6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 41}}},
+			},
+			{
+				Query:    "set auto_increment_increment = 1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t(pk, v) values (null, 7), (0, 8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 42}}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{5, 1}, {15, 2}, {25, 3}, {27, 4}, {35, 5}, {41, 6}, {42, 7}, {43, 8}},
+			},
+		},
+	},
+	{
+		Name:    "last_insert_id(expr) sets the insert id of the statement",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table seq (id int)",
+			"insert into seq values (0)",
+			"create table t (pk int primary key auto_increment, v int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "update seq set id = last_insert_id(id + 1)",
+				Expected: []sql.Row{{types.OkResult{
+					RowsAffected: 1,
+					InsertID:     1,
+					Info: plan.UpdateInfo{
+						Matched: 1,
+						Updated: 1,
+					},
+				}}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{uint64(1)}},
+			},
+			{
+				Query:    "select last_insert_id(42)",
+				Expected: []sql.Row{{uint64(42)}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{uint64(42)}},
+			},
+			{
+				Query: "update seq set id = id + 1",
+				Expected: []sql.Row{{types.OkResult{
+					RowsAffected: 1,
+					Info: plan.UpdateInfo{
+						Matched: 1,
+						Updated: 1,
+					},
+				}}},
+			},
+			{
+				Query:    "insert into seq values (last_insert_id(100))",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 100}}},
+			},
+			{
+				// a generated value takes precedence over the argument of last_insert_id(expr)
+				Query:    "insert into t(v) values (last_insert_id(200))",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 1}}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{uint64(1)}},
+			},
+		},
+	},
 	{
 		Name:    "row_count() behavior",
 		Dialect: "mysql",
@@ -4376,6 +4648,36 @@ CREATE TABLE tab3 (
 				Query:    "SELECT group_concat(attribute order by attribute separator '') FROM t WHERE o_id=2 ORDER BY attribute",
 				Expected: []sql.Row{{"colorfabric"}},
 			},
+			{
+				Query:    "SELECT group_concat(o_id, ':', `value` order by o_id desc, `value`) FROM t",
+				Expected: []sql.Row{{"3:green,3:square,2:red,2:silk"}},
+			},
+			{
+				Query:    "SELECT group_concat(DISTINCT o_id, `attribute` order by `attribute` desc, o_id SEPARATOR ' ') FROM t",
+				Expected: []sql.Row{{"3shape 2fabric 2color 3color"}},
+			},
+			{
+				Query:    "SELECT group_concat(pk, 'x') FROM x",
+				Expected: []sql.Row{{"1x,2x,3x,4x"}},
+			},
+			{
+				Query:    "SET group_concat_max_len = 10",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:                           "SELECT group_concat(`value` order by `value`) FROM t",
+				Expected:                        []sql.Row{{"green,red,"}},
+				ExpectedWarningsCount:           1,
+				ExpectedWarning:                 1260,
+				ExpectedWarningMessageSubstring: "Row 1 was cut by GROUP_CONCAT()",
+			},
+			{
+				Query:                           "SELECT group_concat(`attribute`) FROM t group by o_id order by o_id",
+				Expected:                        []sql.Row{{"color,fabr"}, {"color,shap"}},
+				ExpectedWarningsCount:           2,
+				ExpectedWarning:                 1260,
+				ExpectedWarningMessageSubstring: "was cut by GROUP_CONCAT()",
+			},
 		},
 	},
 	{
@@ -5747,7 +6049,7 @@ CREATE TABLE tab3 (
 				Query: "DESCRIBE t",
 				Expected: []sql.Row{
 					{"pk", "int", "NO", "PRI", nil, ""},
-					{"val", "int", "YES", "", "((`pk` * 2))", "DEFAULT_GENERATED"},
+					{"val", "int", "YES", "", "(`pk` * 2)", "DEFAULT_GENERATED"},
 				},
 			},
 		},
@@ -6659,8 +6961,8 @@ CREATE TABLE tab3 (
 			{
 				Query: "show index from TABLE_one;",
 				Expected: []sql.Row{
-					{"table_One", 0, "PRIMARY", 1, "Id", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-					{"table_One", 1, "idx_one", 1, "Val1", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"table_One", 0, "PRIMARY", 1, "Id", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"table_One", 1, "idx_one", 1, "Val1", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 				},
 			},
 			{
@@ -6680,9 +6982,9 @@ CREATE TABLE tab3 (
 			{
 				Query: "show index from tABLEtwo;",
 				Expected: []sql.Row{
-					{"TableTwo", 0, "PRIMARY", 1, "iD", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-					{"TableTwo", 1, "idx_one", 1, "VAL2", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
-					{"TableTwo", 1, "idx_one", 2, "vAL3", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"TableTwo", 0, "PRIMARY", 1, "iD", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"TableTwo", 1, "idx_one", 1, "VAL2", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"TableTwo", 1, "idx_one", 2, "vAL3", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 				},
 			},
 			{
@@ -6854,8 +7156,13 @@ CREATE TABLE tab3 (
 				ExpectedErr: sql.ErrInvalidRefInView,
 			},
 			{
-				Query:       "SELECT * FROM f;",
-				ExpectedErr: sql.ErrInvalidRefInView,
+				// the view is changed to refer to the renamed column
+				Query:    "SELECT * FROM f;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW CREATE VIEW f;",
+				Expected: []sql.Row{{"f", "CREATE VIEW `f` AS select newcol as npk from a", "utf8mb4", "utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9709,92 +10016,415 @@ where
 		},
 	},
 	{
-		Name:    "negative int limits",
+		Name:    "binary strings pad and truncate by bytes",
 		Dialect: "mysql",
 		SetUpScript: []string{
-			"CREATE TABLE t(i8 tinyint, i16 smallint, i24 mediumint, i32 int, i64 bigint);",
-			"INSERT INTO t VALUES(-128, -32768, -8388608, -2147483648, -9223372036854775808);",
+			"create table bt (pk int primary key, b binary(4), vb varbinary(4));",
+			"insert into bt values (1, 'ab', 'ab');",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				SkipResultCheckOnServerEngine: true,
-				Query:                         "SELECT -i8, -i16, -i24, -i32 from t;",
-				Expected: []sql.Row{
-					{128, 32768, 8388608, 2147483648},
-				},
+				Query:    "select hex(b), hex(vb), length(b), length(vb) from bt;",
+				Expected: []sql.Row{{"61620000", "6162", 4, 2}},
 			},
 			{
-				Query:          "SELECT -i64 from t;",
-				ExpectedErrStr: "BIGINT out of range for -9223372036854775808",
+				Query:    "select b = 'ab', vb = 'ab', b = x'61620000' from bt;",
+				Expected: []sql.Row{{false, true, true}},
 			},
-		},
-	},
-	{
-		Name:    "negative int limits",
-		Dialect: "mysql",
-		SetUpScript: []string{
-			"CREATE TABLE t(i8 tinyint, i16 smallint, i24 mediumint, i32 int, i64 bigint);",
-			"INSERT INTO t VALUES(-128, -32768, -8388608, -2147483648, -9223372036854775808);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				SkipResultCheckOnServerEngine: true,
-				Query:                         "SELECT -i8, -i16, -i24, -i32 from t;",
-				Expected: []sql.Row{
-					{128, 32768, 8388608, 2147483648},
-				},
+				Query:                 "insert ignore into bt values (2, 'abcdef', x'0102030405');",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarningsCount: 2,
 			},
 			{
-				Query:          "SELECT -i64 from t;",
-				ExpectedErrStr: "BIGINT out of range for -9223372036854775808",
+				Query:    "select pk, hex(b), hex(vb) from bt order by pk;",
+				Expected: []sql.Row{{1, "61620000", "6162"}, {2, "61626364", "01020304"}},
+			},
+			{
+				Query:    "select pk from bt order by b desc;",
+				Expected: []sql.Row{{2}, {1}},
+			},
+			{
+				Query:    "select hex(cast(x'e9' as char character set latin1)), charset(cast(x'61' as char character set latin1));",
+				Expected: []sql.Row{{"E9", "latin1"}},
+			},
+			{
+				Query:                 "select cast(x'e9' as char);",
+				Expected:              []sql.Row{{nil}},
+				ExpectedWarning:       mysql.ERInvalidCharacterString,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:                 "select cast('éab' as char(2));",
+				Expected:              []sql.Row{{"éa"}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "select cast('abc' as binary(2)), hex(unhex('00ff'));",
+				Expected: []sql.Row{{[]byte("ab"), "00FF"}},
 			},
 		},
 	},
 	{
-		Name:    "std, stdev, stddev_pop, variance, var_pop, var_samp tests",
+		Name:    "zero and invalid dates follow sql_mode",
 		Dialect: "mysql",
 		SetUpScript: []string{
-			"create table t (i int);",
-			"create table tt (i int, j int);",
-			"insert into tt values (0, 1), (0, 2), (0, 3);",
-			"insert into tt values (1, 123), (1, 456), (1, 789);",
+			"create table t (pk int primary key, d date, dt datetime);",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "select std(i), stddev(i), stddev_pop(i), stddev_samp(i) from t;",
-				Expected: []sql.Row{
-					{nil, nil, nil, nil},
-				},
+				Query:    "insert into t values (1, '0000-00-00', '0000-00-00 00:00:00');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "select variance(i), var_pop(i), var_samp(i) from t;",
-				Expected: []sql.Row{
-					{nil, nil, nil},
-				},
+				Query:       "insert into t values (2, '2020-02-30', null);",
+				ExpectedErr: types.ErrConvertingToTime,
 			},
 			{
-				Query: "insert into t values (1);",
-				Expected: []sql.Row{
-					{types.NewOkResult(1)},
-				},
+				Query:    "insert into t values (2, '2020-00-15', '2020-00-15 10:30:00');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "select std(i), stddev(i), stddev_pop(i), stddev_samp(i) from t;",
-				Expected: []sql.Row{
-					{0.0, 0.0, 0.0, nil},
-				},
+				Query:    "set sql_mode = 'STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ZERO_IN_DATE';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query: "select variance(i), var_pop(i), var_samp(i) from t;",
-				Expected: []sql.Row{
-					{0.0, 0.0, nil},
-				},
+				Query:       "insert into t values (3, '0000-00-00', null);",
+				ExpectedErr: sql.ErrIncorrectDateValueForColumn,
 			},
 			{
-				Query: "insert into t values (2);",
-				Expected: []sql.Row{
-					{types.NewOkResult(1)},
-				},
+				Query:       "insert into t values (3, '2020-00-15', null);",
+				ExpectedErr: types.ErrConvertingToTime,
+			},
+			{
+				Query:       "update t set d = '0000-00-00' where pk = 1;",
+				ExpectedErr: sql.ErrIncorrectDateValueForColumn,
+			},
+			{
+				Query:                 "insert ignore into t values (3, '0000-00-00', null);",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:                 "select cast('0000-00-00' as date);",
+				Expected:              []sql.Row{{nil}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "set sql_mode = 'NO_ZERO_DATE';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:                 "insert into t values (4, '0000-00-00', null);",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:                 "insert into t values (5, '2020-02-30', 'garbage');",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:       mysql.ERWarnDataTruncated,
+				ExpectedWarningsCount: 2,
+			},
+			{
+				Query:    "set sql_mode = 'STRICT_TRANS_TABLES,ALLOW_INVALID_DATES';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t values (6, '2020-02-30', null);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "insert into t values (7, '2020-02-32', null);",
+				ExpectedErr: types.ErrConvertingToTime,
+			},
+			{
+				Query: "select pk, d, dt from t order by pk;",
+				Expected: []sql.Row{
+					{1, types.ZeroTime, types.ZeroTime},
+					{2, types.DateParts{Year: 2020, Month: 0, Day: 15}, types.DateParts{Year: 2020, Month: 0, Day: 15, Hour: 10, Minute: 30, HasTime: true}},
+					{3, types.ZeroTime, nil},
+					{4, types.ZeroTime, nil},
+					{5, types.ZeroTime, types.ZeroTime},
+					{6, types.DateParts{Year: 2020, Month: 2, Day: 30}, nil},
+				},
+			},
+			{
+				Query:    "select pk, cast(d as char), cast(dt as char) from t where pk in (2, 6) order by pk;",
+				Expected: []sql.Row{{2, "2020-00-15", "2020-00-15 10:30:00"}, {6, "2020-02-30", nil}},
+			},
+			{
+				Query:    "select pk from t where d = '2020-02-30' or dt = '2020-00-15 10:30:00' order by pk;",
+				Expected: []sql.Row{{2}, {6}},
+			},
+			{
+				Query:                 "select cast('2020-02-30' as date), cast('2020-02-29' as date);",
+				Expected:              []sql.Row{{nil, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount: 1,
+			},
+		},
+	},
+	{
+		Name:    "dates stored as their parts work with date functions, arithmetic, JSON and indexes",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table d (pk int primary key, d date, dt datetime);",
+			"set sql_mode = 'ALLOW_INVALID_DATES';",
+			"insert into d values (1, '2020-02-31', '2020-02-31 10:11:12'), (2, '2020-00-15', '2020-00-15 01:02:03'), (3, '2020-02-20', '2020-02-20 00:00:00');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select pk, year(d), month(d), day(d), quarter(d), hour(dt), minute(dt), second(dt) from d where pk < 3 order by pk;",
+				Expected: []sql.Row{{1, 2020, 2, 31, 1, 10, 11, 12}, {2, 2020, 0, 15, 0, 1, 2, 3}},
+			},
+			{
+				// a day past the end of its month is read as a day of the next month
+				Query:    "select weekday(d), dayofyear(d) from d where pk = 1;",
+				Expected: []sql.Row{{0, 62}},
+			},
+			{
+				Query:                 "select weekday(d) from d where pk = 2;",
+				Expected:              []sql.Row{{nil}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query: "select date_add(d, interval 1 day), date_add(dt, interval 1 day), date_sub(d, interval 1 month), date_add(d, interval 1 year) from d where pk = 1;",
+				Expected: []sql.Row{{
+					time.Date(2020, 3, 3, 0, 0, 0, 0, time.UTC),
+					time.Date(2020, 3, 3, 10, 11, 12, 0, time.UTC),
+					time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC),
+					time.Date(2021, 2, 28, 0, 0, 0, 0, time.UTC),
+				}},
+			},
+			{
+				Query:                 "select date_add(d, interval 1 day) from d where pk = 2;",
+				Expected:              []sql.Row{{nil}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "select pk, d + 0, dt + 0 from d where pk < 3 order by pk;",
+				Expected: []sql.Row{{1, 20200231, 20200231101112}, {2, 20200015, 20200015010203}},
+			},
+			{
+				Query:    "select pk, cast(json_object('d', d, 'dt', dt) as char) from d where pk < 3 order by pk;",
+				Expected: []sql.Row{{1, `{"d": "2020-02-31", "dt": "2020-02-31 10:11:12.000000"}`}, {2, `{"d": "2020-00-15", "dt": "2020-00-15 01:02:03.000000"}`}},
+			},
+			{
+				Query:    "create index i on d(d);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select pk from d where d between '2020-02-20' and '2020-02-31' order by pk;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select pk from d where d = '2020-02-31' or d = '2020-00-15' order by pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "select pk from d where d < '2020-02-01';",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name:    "mixed type comparisons follow MySQL conversion rules",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (pk int primary key, i int, u bigint unsigned, v varchar(10), vb varbinary(10), d date, dt datetime);",
+			"insert into t values (1, 65, 18446744073709551615, 'A', 'A', '2020-01-01', '2020-01-01 12:34:56');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select d = 20200101, d = 200101, dt = 20200101123456, dt = 20200101, d > 2 from t;",
+				Expected: []sql.Row{{true, true, true, false, true}},
+			},
+			{
+				Query:    "select date('2020-01-01') = 20200101, now() > 0;",
+				Expected: []sql.Row{{true, true}},
+			},
+			{
+				Query:    "select u > -1, u = 18446744073709551614, cast(9223372036854775807 as unsigned) = 9223372036854775806 from t;",
+				Expected: []sql.Row{{true, false, false}},
+			},
+			{
+				Query:    "select 0x41 = 65, x'41' = 65, b'1000001' = 'A', 0x41 = 'A';",
+				Expected: []sql.Row{{true, true, true, true}},
+			},
+			{
+				Query:    "select i = 0x41, v = 0x41, vb = 0x41, vb = 65 from t;",
+				Expected: []sql.Row{{true, true, true, false}},
+			},
+			{
+				Query:    "select pk from t where i = '65abc' and v = 0;",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name:    "out of range integers follow sql_mode",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (pk int primary key, u bigint unsigned, i tinyint);",
+			"insert into t values (1, 5, 1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "insert into t values (2, -1, 1);",
+				ExpectedErr: sql.ErrValueOutOfRange,
+			},
+			{
+				Query:       "update t set i = 1000 where pk = 1;",
+				ExpectedErr: sql.ErrValueOutOfRange,
+			},
+			{
+				Query:                           "insert ignore into t values (2, -1, 1);",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarningsCount:           1,
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningMessageSubstring: "Out of range value for column 'u' at row 1",
+			},
+			{
+				Query:    "set sql_mode = '';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:                           "insert into t values (3, 18446744073709551616, -1000);",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarningsCount:           2,
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningMessageSubstring: "Out of range value for column",
+			},
+			{
+				Query:                           "update t set i = 1000 where pk = 1;",
+				Expected:                        []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+				ExpectedWarningsCount:           1,
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningMessageSubstring: "Out of range value for column 'i' at row 1",
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, uint64(5), 127}, {2, uint64(0), 1}, {3, uint64(18446744073709551615), -128}},
+			},
+			{
+				Query:    "select u + -3, u - 5, u * 2 from t where pk = 1;",
+				Expected: []sql.Row{{uint64(2), uint64(0), uint64(10)}},
+			},
+			{
+				Query:          "select u - 6 from t where pk = 1;",
+				ExpectedErrStr: "BIGINT UNSIGNED value is out of range in '(t.u - 6)'",
+			},
+			{
+				Query:       "select u + 1 from t where pk = 3;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:       "select 9223372036854775807 + 1;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:    "set sql_mode = 'NO_UNSIGNED_SUBTRACTION';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select u - 6 from t where pk = 1;",
+				Expected: []sql.Row{{-1}},
+			},
+		},
+	},
+	{
+		Name:    "negative int limits",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"CREATE TABLE t(i8 tinyint, i16 smallint, i24 mediumint, i32 int, i64 bigint);",
+			"INSERT INTO t VALUES(-128, -32768, -8388608, -2147483648, -9223372036854775808);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "SELECT -i8, -i16, -i24, -i32 from t;",
+				Expected: []sql.Row{
+					{128, 32768, 8388608, 2147483648},
+				},
+			},
+			{
+				Query:          "SELECT -i64 from t;",
+				ExpectedErrStr: "BIGINT out of range for -9223372036854775808",
+			},
+		},
+	},
+	{
+		Name:    "negative int limits",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"CREATE TABLE t(i8 tinyint, i16 smallint, i24 mediumint, i32 int, i64 bigint);",
+			"INSERT INTO t VALUES(-128, -32768, -8388608, -2147483648, -9223372036854775808);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "SELECT -i8, -i16, -i24, -i32 from t;",
+				Expected: []sql.Row{
+					{128, 32768, 8388608, 2147483648},
+				},
+			},
+			{
+				Query:          "SELECT -i64 from t;",
+				ExpectedErrStr: "BIGINT out of range for -9223372036854775808",
+			},
+		},
+	},
+	{
+		Name:    "std, stdev, stddev_pop, variance, var_pop, var_samp tests",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (i int);",
+			"create table tt (i int, j int);",
+			"insert into tt values (0, 1), (0, 2), (0, 3);",
+			"insert into tt values (1, 123), (1, 456), (1, 789);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select std(i), stddev(i), stddev_pop(i), stddev_samp(i) from t;",
+				Expected: []sql.Row{
+					{nil, nil, nil, nil},
+				},
+			},
+			{
+				Query: "select variance(i), var_pop(i), var_samp(i) from t;",
+				Expected: []sql.Row{
+					{nil, nil, nil},
+				},
+			},
+			{
+				Query: "insert into t values (1);",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				Query: "select std(i), stddev(i), stddev_pop(i), stddev_samp(i) from t;",
+				Expected: []sql.Row{
+					{0.0, 0.0, 0.0, nil},
+				},
+			},
+			{
+				Query: "select variance(i), var_pop(i), var_samp(i) from t;",
+				Expected: []sql.Row{
+					{0.0, 0.0, nil},
+				},
+			},
+			{
+				Query: "insert into t values (2);",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
 			},
 			{
 				Query: "select std(i), stddev(i), stddev_pop(i), stddev_samp(i) from t;",
@@ -9971,34 +10601,128 @@ where
 		},
 	},
 	{
-		Name:    "ntile tests",
+		Name:    "aggregate functions over window frames",
 		Dialect: "mysql",
 		SetUpScript: []string{
-			"create table t (i int primary key, j int);",
-			"insert into t values (1, 1), (2, 1), (3, 1), (4, 1), (5, 1), (6, 2), (7, 2), (8, 2), (9, 2), (10, 2);",
+			"create table t (a int, b int, c int, j json);",
+			`insert into t values (1, 1, 12, '1'), (1, 2, 10, '"x"'), (1, 3, 7, '[1]'), (2, 1, 3, '2'), (2, 2, 5, null);`,
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "select i, ntile(null) over() from t;",
-				ExpectedErr: sql.ErrInvalidArgument,
+				Query: "select a, b, bit_and(c) over w, bit_or(c) over w, bit_xor(c) over w from t window w as (partition by a order by b) order by a, b;",
+				Expected: []sql.Row{
+					{1, 1, uint64(12), uint64(12), uint64(12)},
+					{1, 2, uint64(8), uint64(14), uint64(6)},
+					{1, 3, uint64(0), uint64(15), uint64(1)},
+					{2, 1, uint64(3), uint64(3), uint64(3)},
+					{2, 2, uint64(1), uint64(7), uint64(6)},
+				},
 			},
 			{
-				Query:       "select i, ntile(0) over() from t;",
-				ExpectedErr: sql.ErrInvalidArgument,
+				Query: "select a, b, bit_or(c) over (partition by a) from t order by a, b;",
+				Expected: []sql.Row{
+					{1, 1, uint64(15)},
+					{1, 2, uint64(15)},
+					{1, 3, uint64(15)},
+					{2, 1, uint64(7)},
+					{2, 2, uint64(7)},
+				},
 			},
 			{
-				Query:       "select i, ntile(-1) over() from t;",
-				ExpectedErr: sql.ErrInvalidArgument,
+				Query: "select a, b, max(c) over (partition by a order by b desc) from t order by a, b;",
+				Expected: []sql.Row{
+					{1, 1, 12},
+					{1, 2, 10},
+					{1, 3, 7},
+					{2, 1, 5},
+					{2, 2, 5},
+				},
 			},
 			{
-				Query: "select i, ntile(100) over() from t;",
+				Query: "select a, b, stddev_pop(c) over w, var_pop(c) over w from t window w as (partition by a order by b rows between 1 preceding and current row) order by a, b;",
 				Expected: []sql.Row{
-					{1, uint64(1)},
-					{2, uint64(2)},
-					{3, uint64(3)},
-					{4, uint64(4)},
-					{5, uint64(5)},
-					{6, uint64(6)},
+					{1, 1, 0.0, 0.0},
+					{1, 2, 1.0, 1.0},
+					{1, 3, 1.5, 2.25},
+					{2, 1, 0.0, 0.0},
+					{2, 2, 1.0, 1.0},
+				},
+			},
+			{
+				Query: "select a, b, json_arrayagg(j) over (order by a, b rows between 1 preceding and 1 following) from t order by a, b;",
+				Expected: []sql.Row{
+					{1, 1, types.MustJSON(`[1, "x"]`)},
+					{1, 2, types.MustJSON(`[1, "x", [1]]`)},
+					{1, 3, types.MustJSON(`["x", [1], 2]`)},
+					{2, 1, types.MustJSON(`[[1], 2, null]`)},
+					{2, 2, types.MustJSON(`[2, null]`)},
+				},
+			},
+			{
+				Query: "select a, b, json_arrayagg(c) over (order by a, b rows between 1 following and 1 following) from t order by a, b;",
+				Expected: []sql.Row{
+					{1, 1, types.MustJSON(`[10]`)},
+					{1, 2, types.MustJSON(`[7]`)},
+					{1, 3, types.MustJSON(`[3]`)},
+					{2, 1, types.MustJSON(`[5]`)},
+					{2, 2, nil},
+				},
+			},
+			{
+				Query: "select a, b, json_objectagg(b, c) over (partition by a) from t order by a, b;",
+				Expected: []sql.Row{
+					{1, 1, types.MustJSON(`{"1": 12, "2": 10, "3": 7}`)},
+					{1, 2, types.MustJSON(`{"1": 12, "2": 10, "3": 7}`)},
+					{1, 3, types.MustJSON(`{"1": 12, "2": 10, "3": 7}`)},
+					{2, 1, types.MustJSON(`{"1": 3, "2": 5}`)},
+					{2, 2, types.MustJSON(`{"1": 3, "2": 5}`)},
+				},
+			},
+			{
+				Query: "select a, b, json_objectagg(b, c) over (partition by a order by b rows between current row and 1 following) from t order by a, b;",
+				Expected: []sql.Row{
+					{1, 1, types.MustJSON(`{"1": 12, "2": 10}`)},
+					{1, 2, types.MustJSON(`{"2": 10, "3": 7}`)},
+					{1, 3, types.MustJSON(`{"3": 7}`)},
+					{2, 1, types.MustJSON(`{"1": 3, "2": 5}`)},
+					{2, 2, types.MustJSON(`{"2": 5}`)},
+				},
+			},
+			{
+				Query:       "select a, json_objectagg(j, c) over (partition by a order by b rows between current row and current row) from t;",
+				ExpectedErr: sql.ErrJSONObjectAggNullKey,
+			},
+		},
+	},
+	{
+		Name:    "ntile tests",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (i int primary key, j int);",
+			"insert into t values (1, 1), (2, 1), (3, 1), (4, 1), (5, 1), (6, 2), (7, 2), (8, 2), (9, 2), (10, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "select i, ntile(null) over() from t;",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+			{
+				Query:       "select i, ntile(0) over() from t;",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+			{
+				Query:       "select i, ntile(-1) over() from t;",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+			{
+				Query: "select i, ntile(100) over() from t;",
+				Expected: []sql.Row{
+					{1, uint64(1)},
+					{2, uint64(2)},
+					{3, uint64(3)},
+					{4, uint64(4)},
+					{5, uint64(5)},
+					{6, uint64(6)},
 					{7, uint64(7)},
 					{8, uint64(8)},
 					{9, uint64(9)},
@@ -10544,7 +11268,7 @@ where
 				Query: "DESCRIBE enumtest1;",
 				Expected: []sql.Row{
 					{"pk", "int", "NO", "PRI", nil, ""},
-					{"e", "enum('abc','XYZ') COLLATE utf8mb4_0900_ai_ci", "YES", "", nil, ""}},
+					{"e", "enum('abc','XYZ')", "YES", "", nil, ""}},
 			},
 			{
 				Query:    "select data_type, column_type from information_schema.columns where table_name='enumtest1' and column_name='e';",
@@ -10928,6 +11652,108 @@ where
 			},
 		},
 	},
+	{
+		Name:    "enums and sets with invalid values in non-strict mode",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"SET sql_mode = '';",
+			"create table t (i int primary key, e enum('a', 'b', 'c'), s set('x', 'y', 'z'));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                 "insert into t values (1, 'nope', 'q');",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarningsCount: 2,
+				ExpectedWarning:       mysql.ERWarnDataTruncated,
+			},
+			{
+				Query:                 "insert into t values (2, 9, 15);",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarningsCount: 2,
+				ExpectedWarning:       mysql.ERWarnDataTruncated,
+			},
+			{
+				Query:    "select i, e, e + 0, s, s + 0 from t order by i;",
+				Expected: []sql.Row{{1, "", float64(0), "", float64(0)}, {2, "", float64(0), "", float64(0)}},
+			},
+			{
+				Query:            "SET sql_mode = 'STRICT_ALL_TABLES';",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:          "insert into t values (3, 'nope', 'x');",
+				ExpectedErrStr: "Data truncated for column 'e' at row 1",
+			},
+		},
+	},
+	{
+		Name:    "enum and set members are ordered and compared by their index",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (i int primary key, e enum('c', 'a', 'b'), s set('x', 'y', 'z'));",
+			"insert into t values (1, 'a', 'x,z'), (2, 'b', 'y'), (3, 'c', 'x'), (4, 2, '3'), (5, '3', 6);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select i, e, s from t order by e, i;",
+				Expected: []sql.Row{{3, "c", "x"}, {1, "a", "x,z"}, {4, "a", "x,y"}, {2, "b", "y"}, {5, "b", "y,z"}},
+			},
+			{
+				Query:    "select i from t where e < 'b' order by i;",
+				Expected: []sql.Row{{1}, {3}, {4}},
+			},
+			{
+				Query:    "select i from t where e = 2 order by i;",
+				Expected: []sql.Row{{1}, {4}},
+			},
+			{
+				Query:    "select i from t order by s, i;",
+				Expected: []sql.Row{{3}, {2}, {4}, {1}, {5}},
+			},
+			{
+				Query:    "select i, find_in_set('y', s), s & 2, s | 1 from t order by i;",
+				Expected: []sql.Row{{1, 0, uint64(0), uint64(5)}, {2, 1, uint64(2), uint64(3)}, {3, 0, uint64(0), uint64(1)}, {4, 2, uint64(2), uint64(3)}, {5, 1, uint64(2), uint64(7)}},
+			},
+			{
+				Query:    "alter table t modify column e enum('a', 'b', 'c');",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select i, e, e + 0 from t order by e, i;",
+				Expected: []sql.Row{{1, "a", float64(1)}, {4, "a", float64(1)}, {2, "b", float64(2)}, {5, "b", float64(2)}, {3, "c", float64(3)}},
+			},
+			{
+				Query:          "alter table t modify column e enum('a', 'c');",
+				ExpectedErrStr: "Data truncated for column 'e'",
+			},
+		},
+	},
+	{
+		Name:    "enum and set member limits",
+		Dialect: "mysql",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create table t (s set(" + strings.Repeat("'a', ", 64) + "'b'));",
+				ExpectedErr: sql.ErrTooBigSet,
+			},
+			{
+				Query:          "create table t (i int primary key, s set('a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', 'a1', 'b1', 'c1', 'd1', 'e1', 'f1', 'g1', 'h1', 'i1', 'j1', 'k1', 'l1', 'm1', 'n1', 'o1', 'p1', 'q1', 'r1', 's1', 't1', 'u1', 'v1', 'w1', 'x1', 'y1', 'z1', 'a2', 'b2', 'c2', 'd2', 'e2', 'f2', 'g2', 'h2', 'i2', 'j2', 'k2', 'l2', 'm2'));",
+				ExpectedErrStr: "Too many strings for column s and SET",
+			},
+			{
+				Query:       "create table t (e enum('" + strings.Repeat("a", 256) + "'));",
+				ExpectedErr: sql.ErrTooLongSetEnumValue,
+			},
+			{
+				Query:    "create table t (i int primary key, e enum('" + strings.Repeat("a", 255) + "'));",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:          "alter table t add column s set('a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', 'a1', 'b1', 'c1', 'd1', 'e1', 'f1', 'g1', 'h1', 'i1', 'j1', 'k1', 'l1', 'm1', 'n1', 'o1', 'p1', 'q1', 'r1', 's1', 't1', 'u1', 'v1', 'w1', 'x1', 'y1', 'z1', 'a2', 'b2', 'c2', 'd2', 'e2', 'f2', 'g2', 'h2', 'i2', 'j2', 'k2', 'l2', 'm2');",
+				ExpectedErrStr: "Too many strings for column s and SET",
+			},
+		},
+	},
 	{
 		Name:    "enum import error message validation",
 		Dialect: "mysql",
@@ -14605,6 +15431,128 @@ select * from t1 except (
 			},
 		},
 	},
+	{
+		Name: "loose index scan for group by and distinct",
+		SetUpScript: []string{
+			"create table t (id int primary key, a int, b int, c int, index ab (a, b))",
+			"insert into t values (1, 1, null, 1), (2, 1, 5, 2), (3, 1, 3, 3), (4, 2, 7, 4), (5, null, 2, 5), (6, null, null, 6), (7, 3, null, 7), (8, 2, 1, 8)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select distinct a from t order by a",
+				Expected: []sql.Row{{nil}, {1}, {2}, {3}},
+			},
+			{
+				Query:    "select a, min(b) from t group by a order by a",
+				Expected: []sql.Row{{nil, 2}, {1, 3}, {2, 1}, {3, nil}},
+			},
+			{
+				Query:    "select b, a, max(a) from t group by a, b order by a, b",
+				Expected: []sql.Row{{nil, nil, nil}, {2, nil, nil}, {nil, 1, 1}, {3, 1, 1}, {5, 1, 1}, {1, 2, 2}, {7, 2, 2}, {nil, 3, 3}},
+			},
+			{
+				Query:    "select a, (select count(*) from t t2 where t2.a = t.a) from t where id in (select min(id) from t group by a) order by a",
+				Expected: []sql.Row{{nil, 0}, {1, 3}, {2, 2}, {3, 1}},
+			},
+			{
+				Query: "explain plan select a, min(b) from t group by a",
+				Expected: []sql.Row{
+					{"Project"},
+					{" ├─ columns: [t.a, min(t.b)]"},
+					{" └─ GroupBy"},
+					{"     ├─ select: MIN(t.b), t.a"},
+					{"     ├─ group: t.a"},
+					{"     └─ LooseIndexScan"},
+					{"         ├─ Using index for group-by"},
+					{"         ├─ prefix: [t.a]"},
+					{"         ├─ min: t.b"},
+					{"         └─ IndexedTableAccess(t)"},
+					{"             ├─ index: [t.a,t.b]"},
+					{"             ├─ filters: [{[NULL, ∞), [NULL, ∞)}]"},
+					{"             └─ columns: [a b]"},
+				},
+			},
+			{
+				// MAX of the column after the prefix would need the last row of each group
+				Query: "explain plan select a, max(b) from t group by a",
+				Expected: []sql.Row{
+					{"Project"},
+					{" ├─ columns: [t.a, max(t.b)]"},
+					{" └─ GroupBy"},
+					{"     ├─ select: MAX(t.b), t.a"},
+					{"     ├─ group: t.a"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [a b]"},
+				},
+			},
+			{
+				Query:    "select a, max(b) from t group by a order by a",
+				Expected: []sql.Row{{nil, 2}, {1, 5}, {2, 7}, {3, nil}},
+			},
+			{
+				Query:    "set optimizer_switch = 'skip_scan=off'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "explain plan select distinct a from t",
+				Expected: []sql.Row{
+					{"Distinct"},
+					{" └─ Table"},
+					{"     ├─ name: t"},
+					{"     └─ columns: [a]"},
+				},
+			},
+			{
+				Query:    "select a, min(b) from t group by a order by a",
+				Expected: []sql.Row{{nil, 2}, {1, 3}, {2, 1}, {3, nil}},
+			},
+		},
+	},
+	{
+		Name: "explain estimates reports pruned columns",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int, c varchar(10))",
+			"insert into t values (1, 2, 'x')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "explain format=estimates select a from t",
+				Expected: []sql.Row{
+					{"Table"},
+					{" ├─ name: t"},
+					{" └─ columns: [a]"},
+					{"pruned columns: 2 of 3"},
+				},
+			},
+			{
+				Query: "explain format=estimates select a from t where b in (select a from t)",
+				Expected: []sql.Row{
+					{"Project"},
+					{" ├─ columns: [t.a]"},
+					{" └─ SemiJoin (estimated cost=1.505 rows=1)"},
+					{"     ├─ (t.b = t_1.a)"},
+					{"     ├─ Table"},
+					{"     │   ├─ name: t"},
+					{"     │   └─ columns: [a b]"},
+					{"     └─ Project"},
+					{"         ├─ columns: [t_1.a]"},
+					{"         └─ TableAlias(t_1)"},
+					{"             └─ Table"},
+					{"                 └─ name: t"},
+					{"pruned columns: 1 of 6"},
+				},
+			},
+			{
+				Query: "explain format=tree select a from t",
+				Expected: []sql.Row{
+					{"Table"},
+					{" ├─ name: t"},
+					{" └─ columns: [a]"},
+				},
+			},
+		},
+	},
 	{
 		// https://github.com/dolthub/dolt/issues/10064
 		Name: "Hoist out of scope filters for left and right sides of union",
@@ -14870,156 +15818,922 @@ select * from t1 except (
 					) as blocked WHERE blocked.issue_id = i.id);`,
 				Expected: []sql.Row{{1}, {4}, {5}, {6}},
 			},
-		},
-	},
-	{
-		Name: "Greatest and least with decimal arguments",
-		// TODO: This should work in Doltgres https://github.com/dolthub/doltgresql/issues/2378
-		Dialect: "mysql",
-		SetUpScript: []string{
-			"create table t(a decimal(6, 2), b decimal(8, 5), c decimal(5, 1));",
-			"insert into t values (2.75, 8.8, 3.1);",
-		},
-		Assertions: []ScriptTestAssertion{
+		},
+	},
+	{
+		Name: "Greatest and least with decimal arguments",
+		// TODO: This should work in Doltgres https://github.com/dolthub/doltgresql/issues/2378
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t(a decimal(6, 2), b decimal(8, 5), c decimal(5, 1));",
+			"insert into t values (2.75, 8.8, 3.1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// https://github.com/dolthub/dolt/issues/10562
+				Query: "select greatest(a, b, c), least(a, b, c) from t;",
+				// TODO: greatest and least currently return a float64 for decimals. MySQL returns a decimal with the
+				//  highest precision https://github.com/dolthub/dolt/issues/10567
+				Expected: []sql.Row{{8.8, 2.75}},
+			},
+		},
+	},
+	{
+		// https://github.com/dolthub/dolt/issues/10600
+		Name: "self-referential NOT IN subquery",
+		SetUpScript: []string{
+			"CREATE TABLE bug_repro (id VARCHAR(32) PRIMARY KEY, status VARCHAR(16), name VARCHAR(32));",
+			"INSERT INTO bug_repro VALUES ('a', 'open', 'x'), ('b', 'open', 'y'), ('c', 'open', 'z');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM bug_repro WHERE id NOT IN (SELECT id FROM bug_repro WHERE status='open' LIMIT 1);",
+				Expected: []sql.Row{
+					{"b", "open", "y"},
+					{"c", "open", "z"},
+				},
+			},
+			{
+				Query:    "UPDATE bug_repro SET status='closed' WHERE id NOT IN (SELECT id FROM bug_repro WHERE status='open' LIMIT 1);",
+				Expected: []sql.Row{{NewUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "INSERT INTO bug_repro VALUES ('d', 'open', 'zz')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "DELETE FROM bug_repro WHERE status='open' AND id NOT IN (SELECT id FROM bug_repro WHERE status='open' LIMIT 1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query: "select * from bug_repro",
+				Expected: []sql.Row{
+					{"a", "open", "x"},
+					{"b", "closed", "y"},
+					{"c", "closed", "z"},
+				},
+			},
+			{
+				Query:    "UPDATE bug_repro SET status='delete this' WHERE id NOT IN (SELECT id FROM bug_repro WHERE status='keep this');",
+				Expected: []sql.Row{{NewUpdateResult(3, 3)}},
+			},
+			{
+				Query: "select * from bug_repro",
+				Expected: []sql.Row{
+					{"a", "delete this", "x"},
+					{"b", "delete this", "y"},
+					{"c", "delete this", "z"},
+				},
+			},
+		},
+	},
+	{
+		// See https://github.com/dolthub/dolt/issues/10924
+		Name:    "INSERT IGNORE truncates invalid UTF-8 at first bad byte",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(255));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// 0x85 is invalid UTF-8; the row is inserted with the value truncated to the valid prefix.
+				Query:                 "INSERT IGNORE INTO t VALUES (1, UNHEX('5353442031544220322E3585204E564D65'));",
+				Expected:              []sql.Row{{types.OkResult{RowsAffected: 1}}},
+				ExpectedWarning:       mysql.ERTruncatedWrongValueForField,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "SELECT name, HEX(name) FROM t WHERE id = 1;",
+				Expected: []sql.Row{{"SSD 1TB 2.5", "5353442031544220322E35"}},
+			},
+		},
+	},
+	{
+		// See https://github.com/dolthub/dolt/issues/10924
+		Name:    "LIKE with invalid UTF-8 pattern issues warning and returns no match",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(255));",
+			"INSERT INTO t VALUES (1, 'hello');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// 0x85 is invalid UTF-8 in the pattern literal; LIKE issues warning 1300 and returns no match.
+				Query:                           "SELECT id FROM t WHERE name LIKE '%" + "\x85" + "%';",
+				Expected:                        []sql.Row{},
+				ExpectedWarning:                 mysql.ERInvalidCharacterString,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "invalid string for character set",
+			},
+		},
+	},
+	{
+		// https://github.com/dolthub/dolt/issues/11300
+		Name: "TopN rows (Sort with LIMIT) where sort condition is a subquery",
+		SetUpScript: []string{
+			"CREATE TABLE foo (id VARCHAR(36) PRIMARY KEY);",
+			"CREATE TABLE bar (id VARCHAR(36) PRIMARY KEY, foo_id VARCHAR(36) NOT NULL, approved_on DATETIME(3), FOREIGN KEY (foo_id) REFERENCES foo(id) ON DELETE CASCADE ON UPDATE CASCADE);",
+			"INSERT INTO foo VALUES('foo-1'), ('foo-2'), ('foo-3'), ('foo-4'), ('foo-5'), ('foo-6'), ('foo-7'), ('foo-8');",
+			`INSERT INTO bar VALUES
+('bar-1', 'foo-1', '2026-07-14 07:18:04.000'),
+('bar-2', 'foo-2', '2026-07-14 07:18:03.000'),
+('bar-3', 'foo-3', '2026-07-14 07:18:05.000'),
+('bar-4', 'foo-4', '2026-07-14 07:18:01.000'),
+('bar-5', 'foo-5', '2026-07-14 07:18:08.000'),
+('bar-6', 'foo-6', '2026-07-14 07:18:02.000'),
+('bar-7', 'foo-7', '2026-07-14 07:18:07.000'),
+('bar-8', 'foo-8', '2026-07-14 07:18:00.000');`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) ASC LIMIT 1",
+				Expected: []sql.Row{{"foo-8"}},
+			},
+			{
+				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) DESC LIMIT 1",
+				Expected: []sql.Row{{"foo-5"}},
+			},
+			{
+				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) ASC LIMIT 2",
+				Expected: []sql.Row{{"foo-8"}, {"foo-4"}},
+			},
+			{
+				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) DESC LIMIT 2",
+				Expected: []sql.Row{{"foo-5"}, {"foo-7"}},
+			},
+			{
+				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) ASC LIMIT 4",
+				Expected: []sql.Row{{"foo-8"}, {"foo-4"}, {"foo-6"}, {"foo-2"}},
+			},
+			{
+				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) DESC LIMIT 4",
+				Expected: []sql.Row{{"foo-5"}, {"foo-7"}, {"foo-3"}, {"foo-1"}},
+			},
+		},
+	},
+	{
+		Name: "correlated subqueries over repeated outer values",
+		SetUpScript: []string{
+			"CREATE TABLE o (id int primary key, k varchar(10));",
+			"CREATE TABLE o2 (id int primary key, k varchar(10) collate utf8mb4_0900_ai_ci);",
+			"CREATE TABLE i (id int primary key, k varchar(10), v int);",
+			"INSERT INTO o VALUES (1, 'a'), (2, 'b'), (3, 'a'), (4, 'A'), (5, NULL), (6, 'b');",
+			"INSERT INTO o2 VALUES (1, 'a'), (2, 'A'), (3, 'a'), (4, NULL);",
+			"INSERT INTO i VALUES (1, 'a', 10), (2, 'a', 20), (3, 'b', 30);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT o.id, (SELECT i.v FROM i WHERE i.k = o.k ORDER BY i.v DESC LIMIT 1) FROM o ORDER BY o.id",
+				Expected: []sql.Row{{1, 20}, {2, 30}, {3, 20}, {4, nil}, {5, nil}, {6, 30}},
+			},
+			{
+				// values that are equal in the collation of the outer column may still produce different results
+				Query:    "SELECT o2.id, (SELECT concat(o2.k, i.v) FROM i WHERE i.id = 1) FROM o2 ORDER BY o2.id",
+				Expected: []sql.Row{{1, "a10"}, {2, "A10"}, {3, "a10"}, {4, nil}},
+			},
+			{
+				Query:    "SELECT o.id FROM o WHERE EXISTS (SELECT 1 FROM i WHERE i.k = o.k AND i.v > o.id * 5 LIMIT 1) ORDER BY o.id",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "SELECT o.id, o.k IN (SELECT i.k FROM i WHERE i.v > o.id * 5) FROM o ORDER BY o.id",
+				Expected: []sql.Row{{1, true}, {2, true}, {3, true}, {4, false}, {5, nil}, {6, false}},
+			},
+		},
+	},
+	{
+		Name: "recursive cte depth limit and limit clause",
+		SetUpScript: []string{
+			"SET @@cte_max_recursion_depth = 10;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t WHERE n < 10) SELECT count(*) FROM t",
+				Expected: []sql.Row{{10}},
+			},
+			{
+				Query:          "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t WHERE n < 100) SELECT count(*) FROM t",
+				ExpectedErrStr: "Recursive query aborted after 11 iterations. Try increasing @@cte_max_recursion_depth to a larger value.",
+			},
+			{
+				// a cycle never runs out of rows, so it's stopped by the depth limit
+				Query:       "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n FROM t) SELECT count(*) FROM t",
+				ExpectedErr: sql.ErrCteRecursionLimitExceeded,
+			},
+			{
+				// a limit stops the recursion once it has produced enough rows
+				Query:    "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t LIMIT 5) SELECT count(*), count(distinct n) FROM t",
+				Expected: []sql.Row{{5, 5}},
+			},
+			{
+				Query:    "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n FROM t LIMIT 5) SELECT count(*) FROM t",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t LIMIT 3 OFFSET 2) SELECT count(*) FROM t WHERE n BETWEEN 3 AND 5",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SET @@cte_max_recursion_depth = 100;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t WHERE n < 100) SELECT count(*) FROM t",
+				Expected: []sql.Row{{100}},
+			},
+		},
+	},
+	{
+		Name: "group by rollup and the grouping function",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, a varchar(10), b int);",
+			"INSERT INTO t VALUES (1, 'x', 1), (2, 'x', 2), (3, 'y', 1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT a, b, count(*), grouping(a), grouping(b), grouping(a, b) FROM t GROUP BY rollup(a, b)",
+				Expected: []sql.Row{
+					{"x", 1, 1, 0, 0, 0},
+					{"x", 2, 1, 0, 0, 0},
+					{"y", 1, 1, 0, 0, 0},
+					{"x", nil, 2, 0, 1, 1},
+					{"y", nil, 1, 0, 1, 1},
+					{nil, nil, 3, 1, 1, 3},
+				},
+			},
+			{
+				Query: "SELECT a, b, count(*) FROM t GROUP BY a, rollup(b)",
+				Expected: []sql.Row{
+					{"x", 1, 1},
+					{"x", 2, 1},
+					{"y", 1, 1},
+					{"x", nil, 2},
+					{"y", nil, 1},
+				},
+			},
+			{
+				Query:    "SELECT concat(a, '!'), count(*) FROM t GROUP BY rollup(a)",
+				Expected: []sql.Row{{"x!", 2}, {"y!", 1}, {nil, 3}},
+			},
+			{
+				Query:    "SELECT a, count(*) FROM t GROUP BY rollup(a) HAVING grouping(a) = 1",
+				Expected: []sql.Row{{nil, 3}},
+			},
+			{
+				Query:    "SELECT a, sum(b) FROM t GROUP BY rollup(a) ORDER BY grouping(a), a",
+				Expected: []sql.Row{{"x", float64(3)}, {"y", float64(1)}, {nil, float64(4)}},
+			},
+			{
+				Query:    "SELECT a, grouping(a) FROM t GROUP BY a",
+				Expected: []sql.Row{{"x", 0}, {"y", 0}},
+			},
+			{
+				Query:       "SELECT grouping(b) FROM t GROUP BY rollup(a)",
+				ExpectedErr: sql.ErrFieldInGroupingNotGroupBy,
+			},
+			{
+				Query: "SELECT a, b, count(*), grouping(a), grouping(b) FROM t GROUP BY a, b WITH ROLLUP",
+				Expected: []sql.Row{
+					{"x", 1, 1, 0, 0},
+					{"x", 2, 1, 0, 0},
+					{"y", 1, 1, 0, 0},
+					{"x", nil, 2, 0, 1},
+					{"y", nil, 1, 0, 1},
+					{nil, nil, 3, 1, 1},
+				},
+			},
+			{
+				Query:    "SELECT a, count(*) FROM t GROUP BY a WITH ROLLUP HAVING grouping(a) = 1",
+				Expected: []sql.Row{{nil, 3}},
+			},
+			{
+				Query: "SELECT a, b, count(*), grouping(a, b) FROM t GROUP BY cube(a, b)",
+				Expected: []sql.Row{
+					{"x", 1, 1, 0},
+					{"x", 2, 1, 0},
+					{"y", 1, 1, 0},
+					{"x", nil, 2, 1},
+					{"y", nil, 1, 1},
+					{nil, 1, 2, 2},
+					{nil, 2, 1, 2},
+					{nil, nil, 3, 3},
+				},
+			},
+			{
+				Query: "SELECT a, b, count(*) FROM t GROUP BY GROUPING SETS ((a), (b), ())",
+				Expected: []sql.Row{
+					{"x", nil, 2},
+					{"y", nil, 1},
+					{nil, 1, 2},
+					{nil, 2, 1},
+					{nil, nil, 3},
+				},
+			},
+			{
+				Query: "SELECT a, b, count(*) FROM t GROUP BY GROUPING SETS ((a, b), a)",
+				Expected: []sql.Row{
+					{"x", 1, 1},
+					{"x", 2, 1},
+					{"y", 1, 1},
+					{"x", nil, 2},
+					{"y", nil, 1},
+				},
+			},
+		},
+	},
+	{
+		Name: "triggers and procedures that refer to dropped tables and columns",
+		SetUpScript: []string{
+			"create table t (id int primary key, a int, b int);",
+			"create table log (id int primary key, a int);",
+			"create table other (x int primary key);",
+			"create trigger trg after insert on t for each row insert into log (id, a) values (new.id, new.a);",
+			"create procedure p() select a, b from t;",
+			"create procedure p2() select x from other;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select trigger_name, is_valid from information_schema.triggers where trigger_schema = 'mydb'",
+				Expected: []sql.Row{{"trg", "YES"}},
+			},
+			{
+				Query:    "select routine_name, is_valid from information_schema.routines where routine_schema = 'mydb' order by routine_name",
+				Expected: []sql.Row{{"p", "YES"}, {"p2", "YES"}},
+			},
+			{
+				Query:                           "alter table t drop column b",
+				SkipResultsCheck:                true,
+				ExpectedWarning:                 mysql.ERUnknownError,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: `procedure "p" refers to column "t.b", which no longer exists`,
+			},
+			{
+				Query:    "select routine_name, is_valid from information_schema.routines where routine_schema = 'mydb' order by routine_name",
+				Expected: []sql.Row{{"p", "NO"}, {"p2", "YES"}},
+			},
+			{
+				// the trigger is changed to refer to the renamed column
+				Query:    "alter table log rename column a to c",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select trigger_name, is_valid from information_schema.triggers where trigger_schema = 'mydb'",
+				Expected: []sql.Row{{"trg", "YES"}},
+			},
+			{
+				Query:                           "alter table log drop column c",
+				SkipResultsCheck:                true,
+				ExpectedWarning:                 mysql.ERUnknownError,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: `trigger "trg" refers to column "log.c", which no longer exists`,
+			},
+			{
+				Query:    "select trigger_name, is_valid from information_schema.triggers where trigger_schema = 'mydb'",
+				Expected: []sql.Row{{"trg", "NO"}},
+			},
+			{
+				Query:    "alter table log add column c int",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select trigger_name, is_valid from information_schema.triggers where trigger_schema = 'mydb'",
+				Expected: []sql.Row{{"trg", "YES"}},
+			},
+			{
+				Query:                           "drop table other",
+				SkipResultsCheck:                true,
+				ExpectedWarning:                 mysql.ERUnknownError,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: `procedure "p2" refers to table "other", which no longer exists`,
+			},
+			{
+				Query:    "select routine_name, is_valid from information_schema.routines where routine_schema = 'mydb' order by routine_name",
+				Expected: []sql.Row{{"p", "NO"}, {"p2", "NO"}},
+			},
+		},
+	},
+	{
+		Name: "VALUES statements as tables",
+		SetUpScript: []string{
+			"create table t (i int primary key, s varchar(10))",
+			"insert into t values (1, 'x'), (2, 'y')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "values row(1, 10), row(2, 20)",
+				Expected:        []sql.Row{{1, 10}, {2, 20}},
+				ExpectedColumns: sql.Schema{{Name: "column_0", Type: types.Int8}, {Name: "column_1", Type: types.Int8}},
+			},
+			{
+				Query:    "with c as (values row(1, 'a'), row(2, 'b')) select column_1 from c order by column_0 desc",
+				Expected: []sql.Row{{"b"}, {"a"}},
+			},
+			{
+				Query:    "with c (x, y) as (values row(1, 'a'), row(2, 'b')) select y from c where x = 2",
+				Expected: []sql.Row{{"b"}},
+			},
+			{
+				Query:    "select t.s, v.column_1 from t join (values row(1, 'a'), row(2, 'b')) v on t.i = v.column_0 order by t.i",
+				Expected: []sql.Row{{"x", "a"}, {"y", "b"}},
+			},
+			{
+				Query:    "insert into t select * from (values row(3, 'c'), row(4, 'd')) v",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t values row(5, 'e')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by i",
+				Expected: []sql.Row{{1, "x"}, {2, "y"}, {3, "c"}, {4, "d"}, {5, "e"}},
+			},
+		},
+	},
+	{
+		Name:    "REPLACE and INSERT IGNORE with several unique keys",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (id int primary key, u int unique, v varchar(3))",
+			"insert into t values (1, 10, 'a'), (2, 20, 'b')",
+			"create table child (id int primary key, t_id int, foreign key (t_id) references t(id))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// deletes the row with the same id and the row with the same u
+				Query:    "replace into t values (1, 20, 'c')",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 20, "c"}},
+			},
+			{
+				Query:    "replace into t values (3, 30, 'd')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "replace into t values (3, 30, 'd')",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:                 "insert ignore into t values (4, 20, 'e'), (5, 50, 'f')",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarningsCount: 1,
+				ExpectedWarning:       mysql.ERDupEntry,
+			},
+			{
+				Query:    "show warnings",
+				Expected: []sql.Row{{"Warning", 1062, "Duplicate entry '20' for key 't.u'"}},
+			},
+			{
+				Query:                 "insert ignore into child values (1, 99)",
+				Expected:              []sql.Row{{types.NewOkResult(0)}},
+				ExpectedWarningsCount: 1,
+				ExpectedWarning:       mysql.ErNoReferencedRow2,
+			},
+			{
+				Query:    "insert ignore into t values (6, 60, 'ghij')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{1, 20, "c"}, {3, 30, "d"}, {5, 50, "f"}, {6, 60, "ghi"}},
+			},
+		},
+	},
+	{
+		Name:    "HANDLER statements",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t (id int primary key, k int, v varchar(10), index k_idx (k, v))",
+			"insert into t values (1, 30, 'a'), (2, 10, 'b'), (3, 20, 'c'), (4, 20, 'd'), (5, 40, 'e')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "handler t read first",
+				ExpectedErr: sql.ErrUnknownTableInHandler,
+			},
+			{
+				Query:    "handler t open",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "handler t open",
+				ExpectedErr: sql.ErrDuplicateAliasOrTable,
+			},
+			{
+				Query:    "handler t read `PRIMARY` first",
+				Expected: []sql.Row{{1, 30, "a"}},
+			},
+			{
+				Query:    "handler t read `PRIMARY` next",
+				Expected: []sql.Row{{2, 10, "b"}},
+			},
+			{
+				Query:    "handler t read `PRIMARY` next limit 2",
+				Expected: []sql.Row{{3, 20, "c"}, {4, 20, "d"}},
+			},
+			{
+				Query:    "handler t read `PRIMARY` prev",
+				Expected: []sql.Row{{3, 20, "c"}},
+			},
+			{
+				Query:    "handler t read `PRIMARY` last",
+				Expected: []sql.Row{{5, 40, "e"}},
+			},
+			{
+				Query:    "handler t read `PRIMARY` next",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "handler t read k_idx first limit 3",
+				Expected: []sql.Row{{2, 10, "b"}, {3, 20, "c"}, {4, 20, "d"}},
+			},
+			{
+				Query:    "handler t read k_idx = (20) limit 5",
+				Expected: []sql.Row{{3, 20, "c"}, {4, 20, "d"}},
+			},
+			{
+				Query:    "handler t read k_idx next",
+				Expected: []sql.Row{{1, 30, "a"}},
+			},
+			{
+				Query:    "handler t read k_idx = (20, 'd')",
+				Expected: []sql.Row{{4, 20, "d"}},
+			},
+			{
+				Query:    "handler t read k_idx >= (25) limit 5",
+				Expected: []sql.Row{{1, 30, "a"}, {5, 40, "e"}},
+			},
+			{
+				Query:    "handler t read k_idx > (20, 'c')",
+				Expected: []sql.Row{{4, 20, "d"}},
+			},
+			{
+				Query:    "handler t read k_idx <= (20) limit 5",
+				Expected: []sql.Row{{4, 20, "d"}, {3, 20, "c"}, {2, 10, "b"}},
+			},
+			{
+				Query:    "handler t read k_idx < (20)",
+				Expected: []sql.Row{{2, 10, "b"}},
+			},
+			{
+				Query:    "handler t read k_idx first where v > 'b' limit 2",
+				Expected: []sql.Row{{3, 20, "c"}, {4, 20, "d"}},
+			},
+			{
+				Query:    "handler t read k_idx next where id = 5",
+				Expected: []sql.Row{{5, 40, "e"}},
+			},
+			{
+				Query:    "handler t read first limit 1, 2",
+				Expected: []sql.Row{{2, 10, "b"}, {3, 20, "c"}},
+			},
+			{
+				Query:    "handler t read next",
+				Expected: []sql.Row{{4, 20, "d"}},
+			},
+			{
+				Query:       "handler t read missing_idx first",
+				ExpectedErr: sql.ErrKeyDoesNotExist,
+			},
+			{
+				Query:       "handler t read k_idx = (20, 'c', 1)",
+				ExpectedErr: sql.ErrTooManyKeyParts,
+			},
+			{
+				Query:    "handler t close",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "handler t read next",
+				ExpectedErr: sql.ErrUnknownTableInHandler,
+			},
+			{
+				Query:       "handler t close",
+				ExpectedErr: sql.ErrUnknownTableInHandler,
+			},
+		},
+	},
+	{
+		Name:    "HANDLER with an alias",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create database db2",
+			"create table db2.t (id int primary key)",
+			"insert into db2.t values (1), (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "handler db2.missing open",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "handler db2.t open as h",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "handler t read first",
+				ExpectedErr: sql.ErrUnknownTableInHandler,
+			},
+			{
+				Query:    "handler h read first",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				// rows added after the handler was positioned are seen once it's positioned again
+				Query:    "insert into db2.t values (0)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "handler h read `PRIMARY` first",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "handler h close",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* c */ handler db2.t /* inner */ open h",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* c */ handler h read /* inner */ `PRIMARY` = (1)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "handler h read `PRIMARY` <> (1)",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:    "/* c */ handler h close",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "temporary tables shadow permanent tables",
+		SetUpScript: []string{
+			"create table t (i int primary key, s varchar(10))",
+			"insert into t values (1, 'perm')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "create temporary table t (i int primary key, v int)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t values (1, 10), (2, 20)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t order by i",
+				Expected: []sql.Row{{1, 10}, {2, 20}},
+			},
+			{
+				Query:       "create temporary table t (i int)",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+			{
+				// a failed statement leaves the temporary table as it was
+				Query:       "insert into t values (3, 30), (1, 40)",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:    "select * from t order by i",
+				Expected: []sql.Row{{1, 10}, {2, 20}},
+			},
+			{
+				Query:    "alter table t add column w int default 5",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t modify column v bigint",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "create index idx on t (v)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select i, v, w from t where v = 20",
+				Expected: []sql.Row{{2, int64(20), 5}},
+			},
+			{
+				Query: "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TEMPORARY TABLE `t` (\n" +
+					"  `i` int NOT NULL,\n" +
+					"  `v` bigint,\n" +
+					"  `w` int DEFAULT '5',\n" +
+					"  PRIMARY KEY (`i`),\n" +
+					"  KEY `idx` (`v`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "create temporary table t2 select i, v from t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t2 order by i",
+				Expected: []sql.Row{{1, int64(10)}, {2, int64(20)}},
+			},
+			{
+				Query:    "create temporary table t3 (i int primary key, s text)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "create fulltext index ft on t3 (s)",
+				ExpectedErr: sql.ErrTemporaryTablesFullTextSupport,
+			},
+			{
+				Query:    "drop temporary table t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, "perm"}},
+			},
+			{
+				Query:       "drop temporary table t",
+				ExpectedErr: sql.ErrUnknownTable,
+			},
+			{
+				Query:    "drop table t2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
 			{
-				// https://github.com/dolthub/dolt/issues/10562
-				Query: "select greatest(a, b, c), least(a, b, c) from t;",
-				// TODO: greatest and least currently return a float64 for decimals. MySQL returns a decimal with the
-				//  highest precision https://github.com/dolthub/dolt/issues/10567
-				Expected: []sql.Row{{8.8, 2.75}},
+				Query:       "select * from t2",
+				ExpectedErr: sql.ErrTableNotFound,
 			},
 		},
 	},
 	{
-		// https://github.com/dolthub/dolt/issues/10600
-		Name: "self-referential NOT IN subquery",
+		Name: "AES_ENCRYPT and AES_DECRYPT follow block_encryption_mode",
 		SetUpScript: []string{
-			"CREATE TABLE bug_repro (id VARCHAR(32) PRIMARY KEY, status VARCHAR(16), name VARCHAR(32));",
-			"INSERT INTO bug_repro VALUES ('a', 'open', 'x'), ('b', 'open', 'y'), ('c', 'open', 'z');",
+			"create table secrets (id int primary key, v varbinary(100))",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "SELECT * FROM bug_repro WHERE id NOT IN (SELECT id FROM bug_repro WHERE status='open' LIMIT 1);",
-				Expected: []sql.Row{
-					{"b", "open", "y"},
-					{"c", "open", "z"},
-				},
+				Query:    "select hex(aes_encrypt('text', 'password'))",
+				Expected: []sql.Row{{"F6BD0FA8DCB7F8CD4A2FAABC54668044"}},
 			},
 			{
-				Query:    "UPDATE bug_repro SET status='closed' WHERE id NOT IN (SELECT id FROM bug_repro WHERE status='open' LIMIT 1);",
-				Expected: []sql.Row{{NewUpdateResult(2, 2)}},
+				Query:    "select aes_decrypt(aes_encrypt('text', 'password'), 'password')",
+				Expected: []sql.Row{{[]byte("text")}},
 			},
 			{
-				Query:    "INSERT INTO bug_repro VALUES ('d', 'open', 'zz')",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "select aes_encrypt(null, 'password'), aes_decrypt('not encrypted', 'password')",
+				Expected: []sql.Row{{nil, nil}},
 			},
 			{
-				Query:    "DELETE FROM bug_repro WHERE status='open' AND id NOT IN (SELECT id FROM bug_repro WHERE status='open' LIMIT 1);",
+				Query:       "set block_encryption_mode = 'des-ecb'",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
+			},
+			{
+				Query:    "set block_encryption_mode = 'AES-256-CBC'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select @@block_encryption_mode",
+				Expected: []sql.Row{{"aes-256-cbc"}},
+			},
+			{
+				Query:    "select hex(aes_encrypt('text', 'My secret passphrase', '1234567890123456'))",
+				Expected: []sql.Row{{"6CADB5DCD3FBFA6F5A0F315ACC8F9053"}},
+			},
+			{
+				Query:          "select aes_encrypt('text', 'My secret passphrase')",
+				ExpectedErrStr: "Incorrect parameter count in the call to native function 'aes_encrypt'",
+			},
+			{
+				Query:          "select aes_encrypt('text', 'My secret passphrase', 'short')",
+				ExpectedErrStr: "The initialization vector supplied to aes_encrypt is too short. Must be at least 16 bytes long",
+			},
+			{
+				Query:    "insert into secrets values (1, aes_encrypt('hello', 'key', 'initialization vector', 'pbkdf2_hmac', 'salt', 2000))",
 				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "select * from bug_repro",
-				Expected: []sql.Row{
-					{"a", "open", "x"},
-					{"b", "closed", "y"},
-					{"c", "closed", "z"},
-				},
+				Query:    "select cast(aes_decrypt(v, 'key', 'initialization vector', 'pbkdf2_hmac', 'salt', 2000) as char) from secrets",
+				Expected: []sql.Row{{"hello"}},
 			},
 			{
-				Query:    "UPDATE bug_repro SET status='delete this' WHERE id NOT IN (SELECT id FROM bug_repro WHERE status='keep this');",
-				Expected: []sql.Row{{NewUpdateResult(3, 3)}},
+				Query:    "set block_encryption_mode = 'aes-128-gcm'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query: "select * from bug_repro",
-				Expected: []sql.Row{
-					{"a", "delete this", "x"},
-					{"b", "delete this", "y"},
-					{"c", "delete this", "z"},
-				},
+				Query:    "select length(aes_encrypt('hello', 'key', '123456789012'))",
+				Expected: []sql.Row{{21}},
 			},
-		},
-	},
-	{
-		// See https://github.com/dolthub/dolt/issues/10924
-		Name:    "INSERT IGNORE truncates invalid UTF-8 at first bad byte",
-		Dialect: "mysql",
-		SetUpScript: []string{
-			"CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(255));",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				// 0x85 is invalid UTF-8; the row is inserted with the value truncated to the valid prefix.
-				Query:                 "INSERT IGNORE INTO t VALUES (1, UNHEX('5353442031544220322E3585204E564D65'));",
-				Expected:              []sql.Row{{types.OkResult{RowsAffected: 1}}},
-				ExpectedWarning:       mysql.ERTruncatedWrongValueForField,
-				ExpectedWarningsCount: 1,
+				Query:    "select cast(aes_decrypt(aes_encrypt('hello', 'key', '123456789012'), 'key', '123456789012') as char)",
+				Expected: []sql.Row{{"hello"}},
 			},
 			{
-				Query:    "SELECT name, HEX(name) FROM t WHERE id = 1;",
-				Expected: []sql.Row{{"SSD 1TB 2.5", "5353442031544220322E35"}},
+				// the authentication tag does not match
+				Query:    "select aes_decrypt(aes_encrypt('hello', 'key', '123456789012'), 'other key', '123456789012')",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:       "alter table secrets add constraint chk check (v <> aes_encrypt('x', 'key', '123456789012'))",
+				ExpectedErr: sql.ErrInvalidConstraintFunctionNotSupported,
 			},
 		},
 	},
 	{
-		// See https://github.com/dolthub/dolt/issues/10924
-		Name:    "LIKE with invalid UTF-8 pattern issues warning and returns no match",
-		Dialect: "mysql",
-		SetUpScript: []string{
-			"CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(255));",
-			"INSERT INTO t VALUES (1, 'hello');",
-		},
+		Name: "lc_time_names localizes month and day names",
 		Assertions: []ScriptTestAssertion{
 			{
-				// 0x85 is invalid UTF-8 in the pattern literal; LIKE issues warning 1300 and returns no match.
-				Query:                           "SELECT id FROM t WHERE name LIKE '%" + "\x85" + "%';",
-				Expected:                        []sql.Row{},
-				ExpectedWarning:                 mysql.ERInvalidCharacterString,
-				ExpectedWarningsCount:           1,
-				ExpectedWarningMessageSubstring: "invalid string for character set",
+				Query:    "select @@lc_time_names",
+				Expected: []sql.Row{{"en_US"}},
+			},
+			{
+				Query:    "select date_format('2024-03-04', '%W %e %M %Y'), monthname('2024-03-04'), dayname('2024-03-04')",
+				Expected: []sql.Row{{"Monday 4 March 2024", "March", "Monday"}},
+			},
+			{
+				Query:    "set lc_time_names = 'de_de'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select @@lc_time_names",
+				Expected: []sql.Row{{"de_DE"}},
+			},
+			{
+				Query:    "select date_format('2024-03-04', '%W, %e. %M %Y (%a %b)'), monthname('2024-03-04'), dayname('2024-03-04')",
+				Expected: []sql.Row{{"Montag, 4. März 2024 (Mo Mär)", "März", "Montag"}},
+			},
+			{
+				Query:    "select from_unixtime(0, '%M')",
+				Expected: []sql.Row{{"Januar"}},
+			},
+			{
+				// numeric specifiers and AM/PM are not localized
+				Query:    "select date_format('2024-03-04 15:00:00', '%D %p')",
+				Expected: []sql.Row{{"4th PM"}},
+			},
+			{
+				Query:    "set lc_time_names = 'es_ES'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select date_format('2024-08-10', '%W %e de %M')",
+				Expected: []sql.Row{{"sábado 10 de agosto"}},
+			},
+			{
+				Query:       "set lc_time_names = 'xx_XX'",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
 			},
 		},
 	},
 	{
-		// https://github.com/dolthub/dolt/issues/11300
-		Name: "TopN rows (Sort with LIMIT) where sort condition is a subquery",
-		SetUpScript: []string{
-			"CREATE TABLE foo (id VARCHAR(36) PRIMARY KEY);",
-			"CREATE TABLE bar (id VARCHAR(36) PRIMARY KEY, foo_id VARCHAR(36) NOT NULL, approved_on DATETIME(3), FOREIGN KEY (foo_id) REFERENCES foo(id) ON DELETE CASCADE ON UPDATE CASCADE);",
-			"INSERT INTO foo VALUES('foo-1'), ('foo-2'), ('foo-3'), ('foo-4'), ('foo-5'), ('foo-6'), ('foo-7'), ('foo-8');",
-			`INSERT INTO bar VALUES
-('bar-1', 'foo-1', '2026-07-14 07:18:04.000'),
-('bar-2', 'foo-2', '2026-07-14 07:18:03.000'),
-('bar-3', 'foo-3', '2026-07-14 07:18:05.000'),
-('bar-4', 'foo-4', '2026-07-14 07:18:01.000'),
-('bar-5', 'foo-5', '2026-07-14 07:18:08.000'),
-('bar-6', 'foo-6', '2026-07-14 07:18:02.000'),
-('bar-7', 'foo-7', '2026-07-14 07:18:07.000'),
-('bar-8', 'foo-8', '2026-07-14 07:18:00.000');`,
-		},
+		Name: "named time zones",
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) ASC LIMIT 1",
-				Expected: []sql.Row{{"foo-8"}},
+				Query:    "select convert_tz('2024-01-15 12:00:00', 'US/Eastern', 'UTC')",
+				Expected: []sql.Row{{time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)}},
 			},
 			{
-				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) DESC LIMIT 1",
-				Expected: []sql.Row{{"foo-5"}},
+				// daylight saving time
+				Query:    "select convert_tz('2024-07-15 12:00:00', 'US/Eastern', 'UTC')",
+				Expected: []sql.Row{{time.Date(2024, 7, 15, 16, 0, 0, 0, time.UTC)}},
 			},
 			{
-				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) ASC LIMIT 2",
-				Expected: []sql.Row{{"foo-8"}, {"foo-4"}},
+				Query:    "select convert_tz('2024-07-15 12:00:00', 'europe/berlin', '+00:00'), convert_tz('2024-07-15 12:00:00', 'Not/AZone', 'UTC')",
+				Expected: []sql.Row{{time.Date(2024, 7, 15, 10, 0, 0, 0, time.UTC), nil}},
 			},
 			{
-				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) DESC LIMIT 2",
-				Expected: []sql.Row{{"foo-5"}, {"foo-7"}},
+				Query:    "set time_zone = 'Europe/Berlin'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) ASC LIMIT 4",
-				Expected: []sql.Row{{"foo-8"}, {"foo-4"}, {"foo-6"}, {"foo-2"}},
+				Query:    "select @@time_zone",
+				Expected: []sql.Row{{"Europe/Berlin"}},
 			},
 			{
-				Query:    "SELECT * FROM foo ORDER BY (SELECT MAX(r.approved_on) FROM bar r WHERE r.foo_id = foo.id) DESC LIMIT 4",
-				Expected: []sql.Row{{"foo-5"}, {"foo-7"}, {"foo-3"}, {"foo-1"}},
+				Query:       "set time_zone = 'Not/AZone'",
+				ExpectedErr: sql.ErrInvalidTimeZone,
+			},
+			{
+				Query:    "select count(*) > 500 from mysql.time_zone_name",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "select t.Use_leap_seconds, count(*) > 0 from mysql.time_zone_name n join mysql.time_zone t on n.Time_zone_id = t.Time_zone_id where n.Name = 'Europe/Berlin' group by t.Use_leap_seconds",
+				Expected: []sql.Row{{"N", true}},
+			},
+			{
+				Query: `select tt.Offset, tt.Is_DST, tt.Abbreviation
+from mysql.time_zone_name n
+join mysql.time_zone_transition t on n.Time_zone_id = t.Time_zone_id
+join mysql.time_zone_transition_type tt on t.Time_zone_id = tt.Time_zone_id and t.Transition_type_id = tt.Transition_type_id
+where n.Name = 'Europe/Berlin' and t.Transition_time = 1711846800`,
+				Expected: []sql.Row{{int32(7200), uint8(1), "CEST"}},
+			},
+			{
+				Query:    "select count(*) from mysql.time_zone_leap_second",
+				Expected: []sql.Row{{0}},
 			},
 		},
 	},
@@ -15045,7 +16759,7 @@ var SpatialScriptTests = []ScriptTest{
 				Query: "describe test",
 				Expected: []sql.Row{
 					{"i", "int", "NO", "PRI", nil, ""},
-					{"p", "point", "YES", "", "(point(123.456,7.89))", "DEFAULT_GENERATED"},
+					{"p", "point", "YES", "", "point(123.456,7.89)", "DEFAULT_GENERATED"},
 				},
 			},
 		},
@@ -15069,7 +16783,7 @@ var SpatialScriptTests = []ScriptTest{
 				Query: "describe test",
 				Expected: []sql.Row{
 					{"i", "int", "NO", "PRI", nil, ""},
-					{"l", "linestring", "YES", "", "(linestring(point(1,2),point(3,4)))", "DEFAULT_GENERATED"},
+					{"l", "linestring", "YES", "", "linestring(point(1,2),point(3,4))", "DEFAULT_GENERATED"},
 				},
 			},
 		},
@@ -15093,7 +16807,7 @@ var SpatialScriptTests = []ScriptTest{
 				Query: "describe test",
 				Expected: []sql.Row{
 					{"i", "int", "NO", "PRI", nil, ""},
-					{"p", "polygon", "YES", "", "(polygon(linestring(point(0,0),point(1,1),point(2,2),point(0,0))))", "DEFAULT_GENERATED"},
+					{"p", "polygon", "YES", "", "polygon(linestring(point(0,0),point(1,1),point(2,2),point(0,0)))", "DEFAULT_GENERATED"},
 				},
 			},
 		},
@@ -15117,7 +16831,7 @@ var SpatialScriptTests = []ScriptTest{
 				Query: "describe test",
 				Expected: []sql.Row{
 					{"i", "int", "NO", "PRI", nil, ""},
-					{"g", "geometry", "YES", "", "(point(123.456,7.89))", "DEFAULT_GENERATED"},
+					{"g", "geometry", "YES", "", "point(123.456,7.89)", "DEFAULT_GENERATED"},
 				},
 			},
 		},
@@ -15141,7 +16855,7 @@ var SpatialScriptTests = []ScriptTest{
 				Query: "describe test",
 				Expected: []sql.Row{
 					{"i", "int", "NO", "PRI", nil, ""},
-					{"g", "geometry", "YES", "", "(linestring(point(1,2),point(3,4)))", "DEFAULT_GENERATED"},
+					{"g", "geometry", "YES", "", "linestring(point(1,2),point(3,4))", "DEFAULT_GENERATED"},
 				},
 			},
 		},
@@ -15165,7 +16879,7 @@ var SpatialScriptTests = []ScriptTest{
 				Query: "describe test",
 				Expected: []sql.Row{
 					{"i", "int", "NO", "PRI", nil, ""},
-					{"g", "geometry", "YES", "", "(polygon(linestring(point(0,0),point(1,1),point(2,2),point(0,0))))", "DEFAULT_GENERATED"}},
+					{"g", "geometry", "YES", "", "polygon(linestring(point(0,0),point(1,1),point(2,2),point(0,0)))", "DEFAULT_GENERATED"}},
 			},
 		},
 	},
@@ -15935,6 +17649,150 @@ var SpatialScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name:        "ST_Distance_Sphere",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT ROUND(ST_DISTANCE_SPHERE(POINT(0, 0), POINT(1, 0)), 1)",
+				Expected: []sql.Row{{111194.7}},
+			},
+			{
+				Query:    "SELECT ROUND(ST_DISTANCE_SPHERE(ST_GEOMFROMTEXT('POINT(0 0)', 4326), ST_GEOMFROMTEXT('POINT(90 0)', 4326), 1), 6)",
+				Expected: []sql.Row{{1.570796}},
+			},
+			{
+				Query:    "SELECT ROUND(ST_DISTANCE_SPHERE(POINT(0, 0), ST_GEOMFROMTEXT('MULTIPOINT(50 50,0 1)')), 1)",
+				Expected: []sql.Row{{111194.7}},
+			},
+			{
+				Query:    "SELECT ST_DISTANCE_SPHERE(POINT(0, 0), NULL)",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:          "SELECT ST_DISTANCE_SPHERE(POINT(0, 0), POINT(0, 0), -1)",
+				ExpectedErrStr: "Invalid radius provided to function st_distance_sphere: Radius must be greater than zero.",
+			},
+			{
+				Query:       "SELECT ST_DISTANCE_SPHERE(POINT(0, 0), LINESTRING(POINT(0, 0), POINT(1, 1)))",
+				ExpectedErr: sql.ErrUnsupportedGISTypeForSpatialFunc,
+			},
+		},
+	},
+	{
+		Name:        "ST_Transform",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT ST_SRID(ST_TRANSFORM(ST_GEOMFROMTEXT('POINT(45 10)', 4326), 3857))",
+				Expected: []sql.Row{{uint32(3857)}},
+			},
+			{
+				Query:    "SELECT ROUND(ST_X(ST_TRANSFORM(ST_GEOMFROMTEXT('POINT(45 10)', 4326), 3857)), 2), ROUND(ST_Y(ST_TRANSFORM(ST_GEOMFROMTEXT('POINT(45 10)', 4326), 3857)), 2)",
+				Expected: []sql.Row{{1113194.91, 5621521.49}},
+			},
+			{
+				Query:    "SELECT ROUND(ST_X(p), 6), ROUND(ST_Y(p), 6) FROM (SELECT ST_TRANSFORM(ST_TRANSFORM(ST_GEOMFROMTEXT('POINT(45 10)', 4326), 32632), 4326) AS p) t",
+				Expected: []sql.Row{{10.0, 45.0}},
+			},
+			{
+				Query:    "SELECT ST_TRANSFORM(NULL, 4326)",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:          "SELECT ST_TRANSFORM(POINT(1, 2), 4326)",
+				ExpectedErrStr: "Transformation from SRID 0 is not supported.",
+			},
+			{
+				Query:       "SELECT ST_TRANSFORM(ST_GEOMFROMTEXT('POINT(45 10)', 4326), 1234567)",
+				ExpectedErr: sql.ErrNoSRID,
+			},
+		},
+	},
+	{
+		Name:        "ST_Buffer and ST_Buffer_Strategy",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT ST_ASTEXT(ST_BUFFER(POINT(0, 0), 1, ST_BUFFER_STRATEGY('point_square')))",
+				Expected: []sql.Row{{"POLYGON((-1 -1,1 -1,1 1,-1 1,-1 -1))"}},
+			},
+			{
+				Query:    "SELECT ST_ASTEXT(ST_BUFFER(POINT(1, 1), 2, ST_BUFFER_STRATEGY('point_circle', 4)))",
+				Expected: []sql.Row{{"POLYGON((3 1,1 3,-1 1,1 -1,3 1))"}},
+			},
+			{
+				Query:    "SELECT ST_NUMPOINTS(ST_EXTERIORRING(ST_BUFFER(POINT(0, 0), 1)))",
+				Expected: []sql.Row{{33}},
+			},
+			{
+				Query:    "SELECT ST_ASTEXT(ST_BUFFER(LINESTRING(POINT(0, 0), POINT(4, 0)), 1, ST_BUFFER_STRATEGY('end_flat')))",
+				Expected: []sql.Row{{"POLYGON((0 -1,4 -1,4 1,0 1,0 -1))"}},
+			},
+			{
+				Query:    "SELECT ST_ASTEXT(ST_BUFFER(ST_GEOMFROMTEXT('POLYGON((0 0,2 0,2 2,0 2,0 0))'), 1, ST_BUFFER_STRATEGY('join_miter', 5)))",
+				Expected: []sql.Row{{"POLYGON((-1 -1,3 -1,3 3,-1 3,-1 -1))"}},
+			},
+			{
+				Query:    "SELECT ST_ASTEXT(ST_BUFFER(ST_GEOMFROMTEXT('POLYGON((0 0,2 0,2 2,0 2,0 0))'), -0.5))",
+				Expected: []sql.Row{{"POLYGON((0.5 0.5,1.5 0.5,1.5 1.5,0.5 1.5,0.5 0.5))"}},
+			},
+			{
+				Query:    "SELECT ST_ASTEXT(ST_BUFFER(POINT(0, 0), -1))",
+				Expected: []sql.Row{{"GEOMETRYCOLLECTION EMPTY"}},
+			},
+			{
+				Query:          "SELECT ST_BUFFER(POINT(0, 0), 1, ST_BUFFER_STRATEGY('point_square'), ST_BUFFER_STRATEGY('point_circle', 8))",
+				ExpectedErrStr: "Incorrect arguments to st_buffer",
+			},
+			{
+				Query:          "SELECT ST_BUFFER_STRATEGY('end_flat', 4)",
+				ExpectedErrStr: "Incorrect arguments to st_buffer_strategy",
+			},
+		},
+	},
+	{
+		Name:        "GeoJSON round trips keep SRID and flags",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT ST_ASGEOJSON(ST_GEOMFROMTEXT('POINT(2 1)', 4326), 2, 3)",
+				Expected: []sql.Row{{types.JSONDocument{Val: map[string]interface{}{
+					"type":        "Point",
+					"coordinates": [2]float64{1, 2},
+					"bbox":        [4]float64{1, 2, 1, 2},
+					"crs":         map[string]interface{}{"type": "name", "properties": map[string]interface{}{"name": "EPSG:4326"}},
+				}}}},
+			},
+			{
+				Query: "SELECT ST_ASGEOJSON(ST_GEOMFROMTEXT('POINT(2 1)', 4326), 2, 6)",
+				Expected: []sql.Row{{types.JSONDocument{Val: map[string]interface{}{
+					"type":        "Point",
+					"coordinates": [2]float64{1, 2},
+					"crs":         map[string]interface{}{"type": "name", "properties": map[string]interface{}{"name": "urn:ogc:def:crs:EPSG::4326"}},
+				}}}},
+			},
+			{
+				Query: "SELECT ST_ASGEOJSON(ST_GEOMFROMTEXT('GEOMETRYCOLLECTION(POINT(1.2345 2.3456))'), 2)",
+				Expected: []sql.Row{{types.JSONDocument{Val: map[string]interface{}{
+					"type":       "GeometryCollection",
+					"geometries": []interface{}{map[string]interface{}{"type": "Point", "coordinates": [2]float64{1.23, 2.35}}},
+				}}}},
+			},
+			{
+				Query:    "SELECT ST_SRID(ST_GEOMFROMGEOJSON(ST_ASGEOJSON(ST_TRANSFORM(ST_GEOMFROMTEXT('POINT(45 10)', 4326), 3857), 9, 4)))",
+				Expected: []sql.Row{{uint32(3857)}},
+			},
+			{
+				Query:    "SELECT ST_SRID(ST_GEOMFROMGEOJSON('{\"type\": \"Point\", \"coordinates\": [1, 2], \"crs\": {\"type\": \"name\", \"properties\": {\"name\": \"EPSG:3857\"}}}', 1, 0))",
+				Expected: []sql.Row{{uint32(0)}},
+			},
+			{
+				Query:    "SELECT ST_ASTEXT(ST_GEOMFROMGEOJSON(ST_ASGEOJSON(ST_GEOMFROMTEXT('POLYGON((0 0,1 0,1 1,0 0))', 4326))))",
+				Expected: []sql.Row{{"POLYGON((0 0,1 0,1 1,0 0))"}},
+			},
+		},
+	},
 }
 
 var SpatialIndexScriptTests = []ScriptTest{
@@ -16101,6 +17959,38 @@ var SpatialIndexScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "srid of column with spatial index cannot change",
+		SetUpScript: []string{
+			"create table geom_tbl(g geometry not null srid 0, h geometry not null srid 0, spatial index (g))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter table geom_tbl modify column g geometry not null srid 4326",
+				ExpectedErr: sql.ErrSpatialIdxSRIDChange,
+			},
+			{
+				Query:       "alter table geom_tbl modify column g geometry not null",
+				ExpectedErr: sql.ErrSpatialIdxSRIDChange,
+			},
+			{
+				Query:       "alter table geom_tbl change column g g2 point not null srid 4326",
+				ExpectedErr: sql.ErrSpatialIdxSRIDChange,
+			},
+			{
+				Query: "alter table geom_tbl modify column g point not null srid 0",
+				Expected: []sql.Row{
+					{types.NewOkResult(0)},
+				},
+			},
+			{
+				Query: "alter table geom_tbl modify column h geometry not null srid 4326",
+				Expected: []sql.Row{
+					{types.NewOkResult(0)},
+				},
+			},
+		},
+	},
 }
 
 var PreparedScriptTests = []ScriptTest{
@@ -0,0 +1,247 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var SequenceScripts = []ScriptTest{
+	{
+		Name: "create sequence and generate values",
+		SetUpScript: []string{
+			"create sequence s",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select lastval(s)",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    "select nextval(s), nextval(s)",
+				Expected: []sql.Row{{int64(1), int64(2)}},
+			},
+			{
+				Query:    "select next value for s",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query:    "select next value for mydb.s + 10, previous value for s",
+				Expected: []sql.Row{{int64(14), int64(4)}},
+			},
+			{
+				Query:    "select lastval(s), lastval('mydb.s'), nextval('s')",
+				Expected: []sql.Row{{int64(4), int64(4), int64(5)}},
+			},
+			{
+				Query: "show create sequence s",
+				Expected: []sql.Row{{"s", "CREATE SEQUENCE `s` start with 1 minvalue 1 maxvalue 9223372036854775806 " +
+					"increment by 1 cache 1000 nocycle"}},
+			},
+			{
+				Query:    "select /* c */ next /* v */ value for s, previous /* p */ value for mydb.s",
+				Expected: []sql.Row{{int64(6), int64(6)}},
+			},
+		},
+	},
+	{
+		Name: "sequence options",
+		SetUpScript: []string{
+			"create sequence down start with 10 increment by -3 minvalue 4 maxvalue 10 cycle",
+			"create sequence short maxvalue 2 nocache nocycle",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select nextval(down), nextval(down), nextval(down), nextval(down)",
+				Expected: []sql.Row{{int64(10), int64(7), int64(4), int64(10)}},
+			},
+			{
+				Query:    "select nextval(short), nextval(short)",
+				Expected: []sql.Row{{int64(1), int64(2)}},
+			},
+			{
+				Query:       "select nextval(short)",
+				ExpectedErr: sql.ErrSequenceRunOut,
+			},
+			{
+				Query:    "alter sequence short restart",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select nextval(short)",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:    "alter sequence short maxvalue 100 increment by 10 restart with 50",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select nextval(short), nextval(short)",
+				Expected: []sql.Row{{int64(50), int64(60)}},
+			},
+			{
+				Query: "show create sequence short",
+				Expected: []sql.Row{{"short", "CREATE SEQUENCE `short` start with 1 minvalue 1 maxvalue 100 " +
+					"increment by 10 cache 0 nocycle"}},
+			},
+			{
+				Query:       "create sequence bad start with 0",
+				ExpectedErr: sql.ErrSequenceInvalidData,
+			},
+			{
+				Query:       "create sequence bad minvalue 10 maxvalue 5",
+				ExpectedErr: sql.ErrSequenceInvalidData,
+			},
+			{
+				Query:       "create sequence bad increment by 1 bogus 3",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:       "create sequence bad restart with 3",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:    "/* a */ create /* b */ sequence /* c */ commented start /* d */ with 5 increment /* e */ by 2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select nextval(commented), nextval(commented)",
+				Expected: []sql.Row{{int64(5), int64(7)}},
+			},
+		},
+	},
+	{
+		Name: "setval",
+		SetUpScript: []string{
+			"create sequence s",
+			"create sequence d increment by -1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select setval(s, 100)",
+				Expected: []sql.Row{{int64(100)}},
+			},
+			{
+				Query:    "select nextval(s)",
+				Expected: []sql.Row{{int64(101)}},
+			},
+			{
+				// setval doesn't move a sequence backward
+				Query:    "select setval(s, 50)",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    "select setval(s, 200, 0), nextval(s)",
+				Expected: []sql.Row{{int64(200), int64(200)}},
+			},
+			{
+				Query:    "select nextval(d), setval(d, -10), nextval(d)",
+				Expected: []sql.Row{{int64(-1), int64(-10), int64(-11)}},
+			},
+			{
+				Query:    "select setval(d, 0)",
+				Expected: []sql.Row{{nil}},
+			},
+		},
+	},
+	{
+		Name: "sequences in DML",
+		SetUpScript: []string{
+			"create sequence ids start with 100",
+			"create table t (id int primary key default (nextval(ids)), v int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t (v) values (1), (2)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t values (next value for ids, 3), (NEXT VALUE FOR `ids`, 4)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "update t set v = nextval(ids) where id = 100",
+				Expected: []sql.Row{{NewUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{100, 104}, {101, 2}, {102, 3}, {103, 4}},
+			},
+		},
+	},
+	{
+		Name: "create, replace and drop sequences",
+		SetUpScript: []string{
+			"create sequence s",
+			"create table t (i int)",
+			"select nextval(s)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create sequence s",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+			{
+				Query:       "create sequence t",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+			{
+				Query:    "create sequence if not exists s",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "create or replace sequence s start with 5",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select nextval(s)",
+				Expected: []sql.Row{{int64(5)}},
+			},
+			{
+				Query:    "create sequence s2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "drop sequence s, s2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select nextval(s)",
+				ExpectedErr: sql.ErrUnknownSequence,
+			},
+			{
+				Query:       "drop sequence s",
+				ExpectedErr: sql.ErrUnknownSequence,
+			},
+			{
+				Query:    "drop sequence if exists s",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "alter sequence s increment by 2",
+				ExpectedErr: sql.ErrUnknownSequence,
+			},
+			{
+				Query:    "alter sequence if exists s increment by 2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "show create sequence s",
+				ExpectedErr: sql.ErrUnknownSequence,
+			},
+		},
+	},
+}
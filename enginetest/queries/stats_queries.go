@@ -105,6 +105,45 @@ var StatisticsQueries = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "show index reports cardinality from statistics",
+		SetUpScript: []string{
+			"CREATE TABLE t (i bigint primary key, j bigint, key(j) comment 'j index')",
+			"INSERT INTO t VALUES (1, 4), (2, 4), (3, 6)",
+			"CREATE TABLE u (i bigint primary key, s varchar(20), key(s(4)))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW INDEX FROM t",
+				Expected: []sql.Row{
+					{"t", 0, "PRIMARY", 1, "i", "A", int64(0), nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t", 1, "j", 1, "j", "A", int64(0), nil, nil, "YES", "BTREE", "", "j index", "YES", nil},
+				},
+			},
+			{
+				Query:    "ANALYZE TABLE t",
+				Expected: []sql.Row{{"t", "analyze", "status", "OK"}},
+			},
+			{
+				Query: "SHOW INDEX FROM t",
+				Expected: []sql.Row{
+					{"t", 0, "PRIMARY", 1, "i", "A", int64(3), nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t", 1, "j", 1, "j", "A", int64(3), nil, nil, "YES", "BTREE", "", "j index", "YES", nil},
+				},
+			},
+			{
+				Query:    "SELECT index_name, cardinality, index_comment FROM information_schema.statistics WHERE table_name = 't' ORDER BY index_name",
+				Expected: []sql.Row{{"j", int64(3), "j index"}, {"PRIMARY", int64(3), ""}},
+			},
+			{
+				Query: "SHOW INDEX FROM u",
+				Expected: []sql.Row{
+					{"u", 0, "PRIMARY", 1, "i", "A", int64(0), nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"u", 1, "s", 1, "s", "A", int64(0), int64(4), nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+		},
+	},
 	{
 		Name: "analyze float columns",
 		SetUpScript: []string{
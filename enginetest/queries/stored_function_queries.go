@@ -0,0 +1,220 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var StoredFunctionScripts = []ScriptTest{
+	{
+		Name: "create and call a stored function",
+		SetUpScript: []string{
+			"create function add_len(a int, b varchar(10)) returns int deterministic return a + length(b)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select add_len(1, 'abc')",
+				Expected: []sql.Row{{int32(4)}},
+			},
+			{
+				Query:    "select mydb.add_len(2, 'a'), ADD_LEN(3, '')",
+				Expected: []sql.Row{{int32(3), int32(3)}},
+			},
+			{
+				Query:       "select add_len(1)",
+				ExpectedErr: sql.ErrStoredFunctionArgumentCount,
+			},
+			{
+				Query:       "create function add_len(a int) returns int return a",
+				ExpectedErr: sql.ErrStoredFunctionAlreadyExists,
+			},
+			{
+				Query:    "create function if not exists add_len(a int) returns int return a",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select add_len(1, 'abc')",
+				Expected: []sql.Row{{int32(4)}},
+			},
+			{
+				Query:    "drop function add_len",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select add_len(1, 'abc')",
+				ExpectedErr: sql.ErrFunctionNotFound,
+			},
+			{
+				Query:       "drop function add_len",
+				ExpectedErr: sql.ErrStoredFunctionDoesNotExist,
+			},
+			{
+				Query:    "drop function if exists add_len",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "stored function with a compound body",
+		SetUpScript: []string{
+			"create table t (i int primary key, v varchar(20))",
+			"insert into t values (1, 'one'), (2, 'two')",
+			`create function lookup(x int) returns varchar(20) reads sql data
+begin
+	declare res varchar(20);
+	select v into res from t where i = x;
+	if res is null then
+		return 'none';
+	end if;
+	return upper(res);
+end`,
+			`create function fact(n int) returns bigint deterministic
+begin
+	declare r bigint default 1;
+	while n > 1 do
+		set r = r * n;
+		set n = n - 1;
+	end while;
+	return r;
+end`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select lookup(1), lookup(3)",
+				Expected: []sql.Row{{"ONE", "none"}},
+			},
+			{
+				Query:    "select i, lookup(i) from t order by i",
+				Expected: []sql.Row{{1, "ONE"}, {2, "TWO"}},
+			},
+			{
+				Query:    "select fact(5), fact(fact(3))",
+				Expected: []sql.Row{{int64(120), int64(720)}},
+			},
+		},
+	},
+	{
+		Name: "stored functions in information_schema",
+		SetUpScript: []string{
+			"create function f_det(a int, b varchar(10)) returns varchar(20) deterministic no sql comment 'det' return concat(a, b)",
+			"create function f_nondet() returns int reads sql data return 1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select routine_name, routine_type, data_type, is_deterministic, sql_data_access, routine_comment, routine_definition from information_schema.routines where routine_schema = 'mydb' order by routine_name",
+				Expected: []sql.Row{
+					{"f_det", "FUNCTION", "varchar", "YES", "NO SQL", "det", "return concat(a, b)"},
+					{"f_nondet", "FUNCTION", "int", "NO", "READS SQL DATA", "", "return 1"},
+				},
+			},
+			{
+				Query: "select specific_name, ordinal_position, parameter_mode, parameter_name, data_type from information_schema.parameters where specific_schema = 'mydb' order by specific_name, ordinal_position",
+				Expected: []sql.Row{
+					{"f_det", uint64(0), nil, nil, "varchar"},
+					{"f_det", uint64(1), "IN", "a", "int"},
+					{"f_det", uint64(2), "IN", "b", "varchar"},
+					{"f_nondet", uint64(0), nil, nil, "int"},
+				},
+			},
+			{
+				Query:            "show function status where Db = 'mydb'",
+				SkipResultsCheck: true, // the created and modified times vary
+			},
+			{
+				Query: "show create function f_nondet",
+				Expected: []sql.Row{{
+					"f_nondet",
+					"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",
+					"create function f_nondet() returns int reads sql data return 1",
+					"utf8mb4",
+					"utf8mb4_0900_bin",
+					"utf8mb4_0900_bin",
+				}},
+			},
+			{
+				Query:       "show create function mydb.nope",
+				ExpectedErr: sql.ErrStoredFunctionDoesNotExist,
+			},
+		},
+	},
+	{
+		Name: "stored functions can't call themselves",
+		SetUpScript: []string{
+			"create function rec(n int) returns int return if(n <= 0, 0, rec(n - 1) + 1)",
+			"create function ping(n int) returns int return if(n <= 0, 0, pong(n - 1))",
+			"create function pong(n int) returns int return if(n <= 0, 0, ping(n - 1))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "select rec(3)",
+				ExpectedErr: sql.ErrStoredFunctionRecursive,
+			},
+			{
+				Query:       "select ping(3)",
+				ExpectedErr: sql.ErrStoredFunctionRecursive,
+			},
+			{
+				Query:    "select rec(0), ping(1)",
+				Expected: []sql.Row{{int32(0), int32(0)}},
+			},
+		},
+	},
+	{
+		Name: "invalid stored functions",
+		SetUpScript: []string{
+			"create function maybe(x int) returns int begin if x > 0 then return 1; end if; end",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create function no_return() returns int begin declare x int; set x = 1; end",
+				ExpectedErr: sql.ErrStoredFunctionNoReturn,
+			},
+			{
+				Query:       "create function rows_out() returns int begin select 1; return 1; end",
+				ExpectedErr: sql.ErrStoredFunctionResultSet,
+			},
+			{
+				Query:       "create function dup(a int, A int) returns int return a",
+				ExpectedErr: sql.ErrDeclareVariableDuplicate,
+			},
+			{
+				Query:       "create procedure p() begin return 1; end",
+				ExpectedErr: sql.ErrReturnOutsideFunction,
+			},
+			{
+				Query:    "select maybe(1)",
+				Expected: []sql.Row{{int32(1)}},
+			},
+			{
+				Query:       "select maybe(0)",
+				ExpectedErr: sql.ErrStoredFunctionEndedWithoutReturn,
+			},
+		},
+	},
+	{
+		Name: "built-in functions take precedence over unqualified stored functions",
+		SetUpScript: []string{
+			"create function abs(x int) returns int return 42",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select abs(-1), mydb.abs(-1)",
+				Expected: []sql.Row{{1, int32(42)}},
+			},
+		},
+	},
+}
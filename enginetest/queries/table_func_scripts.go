@@ -158,6 +158,26 @@ var TableFunctionScriptTests = []ScriptTest{
 		Query:    "select * from sequence_table('x', 3) l where exists (select * from sequence_table('y', l.x))",
 		Expected: []sql.Row{{1}, {2}},
 	},
+	{
+		Query:    "select * from sequence_table('x', 3) l, sequence_table('y', l.x) r",
+		Expected: []sql.Row{{1, 0}, {2, 0}, {2, 1}},
+	},
+	{
+		Query:    "select * from sequence_table('x', 3) l join sequence_table('y', l.x + 1) r on r.y > 0",
+		Expected: []sql.Row{{1, 1}, {2, 1}, {2, 2}},
+	},
+	{
+		Query:    "select * from sequence_table('x', 3) l left join sequence_table('y', l.x) r on true",
+		Expected: []sql.Row{{0, nil}, {1, 0}, {2, 0}, {2, 1}},
+	},
+	{
+		Query:    "select * from sequence_table('x', 3) l left join lateral (select * from sequence_table('y', l.x)) r on true",
+		Expected: []sql.Row{{0, nil}, {1, 0}, {2, 0}, {2, 1}},
+	},
+	{
+		Query:    "select t.x, r.y from xy t, sequence_table('y', t.y) r where r.y >= 2",
+		Expected: []sql.Row{{2, 2}},
+	},
 	{
 		Query:       "select not_seq.x from sequence_table('x', 5) as seq",
 		ExpectedErr: sql.ErrTableNotFound,
@@ -771,9 +771,9 @@ WHERE
 			"     │       ├─ cacheable: true\n" +
 			"     │       ├─ alias-string: select MAX(o_id) from orders2 where o_w_id = 1 and o_d_id = 3 and o_c_id = 20001\n" +
 			"     │       └─ Project\n" +
-			"     │           ├─ columns: [max(orders2.o_id):8!null->MAX(o_id):0]\n" +
+			"     │           ├─ columns: [max(orders2.o_id):6!null->MAX(o_id):0]\n" +
 			"     │           └─ GroupBy\n" +
-			"     │               ├─ select: MAX(orders2.o_id:8!null)\n" +
+			"     │               ├─ select: MAX(orders2.o_id:6!null)\n" +
 			"     │               ├─ group: \n" +
 			"     │               └─ IndexedTableAccess(orders2)\n" +
 			"     │                   ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_c_id,orders2.o_id]\n" +
@@ -790,7 +790,7 @@ WHERE
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
 			"             ├─ name: orders2\n" +
-			"             └─ columns: [o_id o_d_id o_w_id o_c_id o_entry_d o_carrier_id o_ol_cnt o_all_local]\n" +
+			"             └─ columns: [o_id o_d_id o_w_id o_c_id o_entry_d o_carrier_id]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [orders2.o_id, orders2.o_entry_d, coalesce(orders2.o_carrier_id,0) as COALESCE(o_carrier_id,0)]\n" +
@@ -798,7 +798,8 @@ WHERE
 			"     ├─ (orders2.o_id = Subquery(select MAX(o_id) from orders2 where o_w_id = 1 and o_d_id = 3 and o_c_id = 20001))\n" +
 			"     └─ IndexedTableAccess(orders2)\n" +
 			"         ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_c_id,orders2.o_id]\n" +
-			"         └─ filters: [{[1, 1], [3, 3], [20001, 20001], [NULL, ∞)}]\n" +
+			"         ├─ filters: [{[1, 1], [3, 3], [20001, 20001], [NULL, ∞)}]\n" +
+			"         └─ columns: [o_id o_d_id o_w_id o_c_id o_entry_d o_carrier_id]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [orders2.o_id, orders2.o_entry_d, coalesce(orders2.o_carrier_id,0) as COALESCE(o_carrier_id,0)]\n" +
@@ -806,7 +807,8 @@ WHERE
 			"     ├─ (orders2.o_id = Subquery(select MAX(o_id) from orders2 where o_w_id = 1 and o_d_id = 3 and o_c_id = 20001))\n" +
 			"     └─ IndexedTableAccess(orders2)\n" +
 			"         ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_c_id,orders2.o_id]\n" +
-			"         └─ filters: [{[1, 1], [3, 3], [20001, 20001], [NULL, ∞)}]\n" +
+			"         ├─ filters: [{[1, 1], [3, 3], [20001, 20001], [NULL, ∞)}]\n" +
+			"         └─ columns: [o_id o_d_id o_w_id o_c_id o_entry_d o_carrier_id]\n" +
 			"",
 	},
 	{
@@ -855,7 +857,7 @@ from
 			"         │                       ├─ tableId: 2\n" +
 			"         │                       └─ Table\n" +
 			"         │                           ├─ name: orders2\n" +
-			"         │                           └─ columns: [o_id o_d_id o_w_id o_c_id o_entry_d o_carrier_id o_ol_cnt o_all_local]\n" +
+			"         │                           └─ columns: [o_id o_d_id o_w_id o_c_id]\n" +
 			"         └─ TableAlias(o)\n" +
 			"             └─ IndexedTableAccess(orders2)\n" +
 			"                 ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_c_id,orders2.o_id]\n" +
@@ -886,7 +888,8 @@ from
 			"         │                   ├─ group: orders2.o_c_id, orders2.o_d_id, orders2.o_w_id\n" +
 			"         │                   └─ IndexedTableAccess(orders2)\n" +
 			"         │                       ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_id]\n" +
-			"         │                       └─ filters: [{[1, 1], [NULL, ∞), (2100, 11153)}]\n" +
+			"         │                       ├─ filters: [{[1, 1], [NULL, ∞), (2100, 11153)}]\n" +
+			"         │                       └─ columns: [o_id o_d_id o_w_id o_c_id]\n" +
 			"         └─ TableAlias(o)\n" +
 			"             └─ IndexedTableAccess(orders2)\n" +
 			"                 ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_c_id,orders2.o_id]\n" +
@@ -913,7 +916,8 @@ from
 			"         │                   ├─ group: orders2.o_c_id, orders2.o_d_id, orders2.o_w_id\n" +
 			"         │                   └─ IndexedTableAccess(orders2)\n" +
 			"         │                       ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_id]\n" +
-			"         │                       └─ filters: [{[1, 1], [NULL, ∞), (2100, 11153)}]\n" +
+			"         │                       ├─ filters: [{[1, 1], [NULL, ∞), (2100, 11153)}]\n" +
+			"         │                       └─ columns: [o_id o_d_id o_w_id o_c_id]\n" +
 			"         └─ TableAlias(o)\n" +
 			"             └─ IndexedTableAccess(orders2)\n" +
 			"                 ├─ index: [orders2.o_w_id,orders2.o_d_id,orders2.o_c_id,orders2.o_id]\n" +
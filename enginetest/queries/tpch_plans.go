@@ -128,18 +128,18 @@ order by
 	s_name,
 	p_partkey;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [supplier.s_acctbal:19!null, supplier.s_name:15!null, nation.n_name:22!null, part.p_partkey:0!null, part.p_mfgr:2!null, supplier.s_address:16!null, supplier.s_phone:18!null, supplier.s_comment:20!null]\n" +
-			" └─ Sort(supplier.s_acctbal:19!null DESC nullsFirst, nation.n_name:22!null ASC nullsFirst, supplier.s_name:15!null ASC nullsFirst, part.p_partkey:0!null ASC nullsFirst)\n" +
+			" ├─ columns: [supplier.s_acctbal:12!null, supplier.s_name:8!null, nation.n_name:15!null, part.p_partkey:0!null, part.p_mfgr:1!null, supplier.s_address:9!null, supplier.s_phone:11!null, supplier.s_comment:13!null]\n" +
+			" └─ Sort(supplier.s_acctbal:12!null DESC nullsFirst, nation.n_name:15!null ASC nullsFirst, supplier.s_name:8!null ASC nullsFirst, part.p_partkey:0!null ASC nullsFirst)\n" +
 			"     └─ Filter\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ partsupp.ps_supplycost:12!null\n" +
+			"         │   ├─ partsupp.ps_supplycost:6!null\n" +
 			"         │   └─ Subquery\n" +
 			"         │       ├─ cacheable: false\n" +
 			"         │       ├─ alias-string: select min(ps_supplycost) from partsupp, supplier, nation, region where p_partkey = ps_partkey and s_suppkey = ps_suppkey and s_nationkey = n_nationkey and n_regionkey = r_regionkey and r_name = 'EUROPE'\n" +
 			"         │       └─ Project\n" +
-			"         │           ├─ columns: [min(partsupp.ps_supplycost):28!null->min(ps_supplycost):0]\n" +
+			"         │           ├─ columns: [min(partsupp.ps_supplycost):19!null->min(ps_supplycost):0]\n" +
 			"         │           └─ GroupBy\n" +
-			"         │               ├─ select: MIN(partsupp.ps_supplycost:30!null)\n" +
+			"         │               ├─ select: MIN(partsupp.ps_supplycost:21!null)\n" +
 			"         │               ├─ group: \n" +
 			"         │               └─ LookupJoin\n" +
 			"         │                   ├─ LookupJoin\n" +
@@ -147,7 +147,7 @@ order by
 			"         │                   │   │   ├─ Filter\n" +
 			"         │                   │   │   │   ├─ Eq\n" +
 			"         │                   │   │   │   │   ├─ part.p_partkey:0!null\n" +
-			"         │                   │   │   │   │   └─ partsupp.ps_partkey:28!null\n" +
+			"         │                   │   │   │   │   └─ partsupp.ps_partkey:19!null\n" +
 			"         │                   │   │   │   └─ Table\n" +
 			"         │                   │   │   │       ├─ name: partsupp\n" +
 			"         │                   │   │   │       ├─ columns: [ps_partkey ps_suppkey ps_supplycost]\n" +
@@ -155,7 +155,7 @@ order by
 			"         │                   │   │   │       └─ tableId: 6\n" +
 			"         │                   │   │   └─ IndexedTableAccess(supplier)\n" +
 			"         │                   │   │       ├─ index: [supplier.S_SUPPKEY]\n" +
-			"         │                   │   │       ├─ keys: [partsupp.ps_suppkey:29!null]\n" +
+			"         │                   │   │       ├─ keys: [partsupp.ps_suppkey:20!null]\n" +
 			"         │                   │   │       ├─ colSet: (34-40)\n" +
 			"         │                   │   │       ├─ tableId: 7\n" +
 			"         │                   │   │       └─ Table\n" +
@@ -163,7 +163,7 @@ order by
 			"         │                   │   │           └─ columns: [s_suppkey s_nationkey]\n" +
 			"         │                   │   └─ IndexedTableAccess(nation)\n" +
 			"         │                   │       ├─ index: [nation.N_NATIONKEY]\n" +
-			"         │                   │       ├─ keys: [supplier.s_nationkey:32!null]\n" +
+			"         │                   │       ├─ keys: [supplier.s_nationkey:23!null]\n" +
 			"         │                   │       ├─ colSet: (41-44)\n" +
 			"         │                   │       ├─ tableId: 8\n" +
 			"         │                   │       └─ Table\n" +
@@ -171,11 +171,11 @@ order by
 			"         │                   │           └─ columns: [n_nationkey n_regionkey]\n" +
 			"         │                   └─ Filter\n" +
 			"         │                       ├─ Eq\n" +
-			"         │                       │   ├─ region.r_name:29!null\n" +
+			"         │                       │   ├─ region.r_name:20!null\n" +
 			"         │                       │   └─ EUROPE (longtext)\n" +
 			"         │                       └─ IndexedTableAccess(region)\n" +
 			"         │                           ├─ index: [region.R_REGIONKEY]\n" +
-			"         │                           ├─ keys: [nation.n_regionkey:34!null]\n" +
+			"         │                           ├─ keys: [nation.n_regionkey:25!null]\n" +
 			"         │                           ├─ colSet: (45-47)\n" +
 			"         │                           ├─ tableId: 9\n" +
 			"         │                           └─ Table\n" +
@@ -187,11 +187,11 @@ order by
 			"             │   │   ├─ MergeJoin\n" +
 			"             │   │   │   ├─ cmp: Eq\n" +
 			"             │   │   │   │   ├─ part.p_partkey:0!null\n" +
-			"             │   │   │   │   └─ partsupp.ps_partkey:9!null\n" +
+			"             │   │   │   │   └─ partsupp.ps_partkey:4!null\n" +
 			"             │   │   │   ├─ Filter\n" +
 			"             │   │   │   │   ├─ AND\n" +
 			"             │   │   │   │   │   ├─ Eq\n" +
-			"             │   │   │   │   │   │   ├─ part.p_size:5!null\n" +
+			"             │   │   │   │   │   │   ├─ part.p_size:3!null\n" +
 			"             │   │   │   │   │   │   └─ 15 (int)\n" +
 			"             │   │   │   │   │   └─ part.p_type LIKE '%BRASS'\n" +
 			"             │   │   │   │   └─ IndexedTableAccess(part)\n" +
@@ -201,7 +201,7 @@ order by
 			"             │   │   │   │       ├─ tableId: 1\n" +
 			"             │   │   │   │       └─ Table\n" +
 			"             │   │   │   │           ├─ name: part\n" +
-			"             │   │   │   │           └─ columns: [p_partkey p_name p_mfgr p_brand p_type p_size p_container p_retailprice p_comment]\n" +
+			"             │   │   │   │           └─ columns: [p_partkey p_mfgr p_type p_size]\n" +
 			"             │   │   │   └─ IndexedTableAccess(partsupp)\n" +
 			"             │   │   │       ├─ index: [partsupp.PS_PARTKEY,partsupp.PS_SUPPKEY]\n" +
 			"             │   │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
@@ -209,10 +209,10 @@ order by
 			"             │   │   │       ├─ tableId: 3\n" +
 			"             │   │   │       └─ Table\n" +
 			"             │   │   │           ├─ name: partsupp\n" +
-			"             │   │   │           └─ columns: [ps_partkey ps_suppkey ps_availqty ps_supplycost ps_comment]\n" +
+			"             │   │   │           └─ columns: [ps_partkey ps_suppkey ps_supplycost]\n" +
 			"             │   │   └─ IndexedTableAccess(supplier)\n" +
 			"             │   │       ├─ index: [supplier.S_SUPPKEY]\n" +
-			"             │   │       ├─ keys: [partsupp.ps_suppkey:10!null]\n" +
+			"             │   │       ├─ keys: [partsupp.ps_suppkey:5!null]\n" +
 			"             │   │       ├─ colSet: (10-16)\n" +
 			"             │   │       ├─ tableId: 2\n" +
 			"             │   │       └─ Table\n" +
@@ -220,24 +220,24 @@ order by
 			"             │   │           └─ columns: [s_suppkey s_name s_address s_nationkey s_phone s_acctbal s_comment]\n" +
 			"             │   └─ IndexedTableAccess(nation)\n" +
 			"             │       ├─ index: [nation.N_NATIONKEY]\n" +
-			"             │       ├─ keys: [supplier.s_nationkey:17!null]\n" +
+			"             │       ├─ keys: [supplier.s_nationkey:10!null]\n" +
 			"             │       ├─ colSet: (22-25)\n" +
 			"             │       ├─ tableId: 4\n" +
 			"             │       └─ Table\n" +
 			"             │           ├─ name: nation\n" +
-			"             │           └─ columns: [n_nationkey n_name n_regionkey n_comment]\n" +
+			"             │           └─ columns: [n_nationkey n_name n_regionkey]\n" +
 			"             └─ Filter\n" +
 			"                 ├─ Eq\n" +
 			"                 │   ├─ region.r_name:1!null\n" +
 			"                 │   └─ EUROPE (longtext)\n" +
 			"                 └─ IndexedTableAccess(region)\n" +
 			"                     ├─ index: [region.R_REGIONKEY]\n" +
-			"                     ├─ keys: [nation.n_regionkey:23!null]\n" +
+			"                     ├─ keys: [nation.n_regionkey:16!null]\n" +
 			"                     ├─ colSet: (26-28)\n" +
 			"                     ├─ tableId: 5\n" +
 			"                     └─ Table\n" +
 			"                         ├─ name: region\n" +
-			"                         └─ columns: [r_regionkey r_name r_comment]\n" +
+			"                         └─ columns: [r_regionkey r_name]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [supplier.s_acctbal, supplier.s_name, nation.n_name, part.p_partkey, part.p_mfgr, supplier.s_address, supplier.s_phone, supplier.s_comment]\n" +
@@ -253,20 +253,25 @@ order by
 			"             │   │   │   │   ├─ ((part.p_size = 15) AND part.p_type LIKE '%BRASS')\n" +
 			"             │   │   │   │   └─ IndexedTableAccess(part)\n" +
 			"             │   │   │   │       ├─ index: [part.P_PARTKEY]\n" +
-			"             │   │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │   │   │   │       └─ columns: [p_partkey p_mfgr p_type p_size]\n" +
 			"             │   │   │   └─ IndexedTableAccess(partsupp)\n" +
 			"             │   │   │       ├─ index: [partsupp.PS_PARTKEY,partsupp.PS_SUPPKEY]\n" +
-			"             │   │   │       └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │   │   │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │   │   │       └─ columns: [ps_partkey ps_suppkey ps_supplycost]\n" +
 			"             │   │   └─ IndexedTableAccess(supplier)\n" +
 			"             │   │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"             │   │       ├─ columns: [s_suppkey s_name s_address s_nationkey s_phone s_acctbal s_comment]\n" +
 			"             │   │       └─ keys: partsupp.ps_suppkey\n" +
 			"             │   └─ IndexedTableAccess(nation)\n" +
 			"             │       ├─ index: [nation.N_NATIONKEY]\n" +
+			"             │       ├─ columns: [n_nationkey n_name n_regionkey]\n" +
 			"             │       └─ keys: supplier.s_nationkey\n" +
 			"             └─ Filter\n" +
 			"                 ├─ (region.r_name = 'EUROPE')\n" +
 			"                 └─ IndexedTableAccess(region)\n" +
 			"                     ├─ index: [region.R_REGIONKEY]\n" +
+			"                     ├─ columns: [r_regionkey r_name]\n" +
 			"                     └─ keys: nation.n_regionkey\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -283,20 +288,25 @@ order by
 			"             │   │   │   │   ├─ ((part.p_size = 15) AND part.p_type LIKE '%BRASS')\n" +
 			"             │   │   │   │   └─ IndexedTableAccess(part)\n" +
 			"             │   │   │   │       ├─ index: [part.P_PARTKEY]\n" +
-			"             │   │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"             │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"             │   │   │   │       └─ columns: [p_partkey p_mfgr p_type p_size]\n" +
 			"             │   │   │   └─ IndexedTableAccess(partsupp)\n" +
 			"             │   │   │       ├─ index: [partsupp.PS_PARTKEY,partsupp.PS_SUPPKEY]\n" +
-			"             │   │   │       └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │   │   │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             │   │   │       └─ columns: [ps_partkey ps_suppkey ps_supplycost]\n" +
 			"             │   │   └─ IndexedTableAccess(supplier)\n" +
 			"             │   │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"             │   │       ├─ columns: [s_suppkey s_name s_address s_nationkey s_phone s_acctbal s_comment]\n" +
 			"             │   │       └─ keys: partsupp.ps_suppkey\n" +
 			"             │   └─ IndexedTableAccess(nation)\n" +
 			"             │       ├─ index: [nation.N_NATIONKEY]\n" +
+			"             │       ├─ columns: [n_nationkey n_name n_regionkey]\n" +
 			"             │       └─ keys: supplier.s_nationkey\n" +
 			"             └─ Filter\n" +
 			"                 ├─ (region.r_name = 'EUROPE')\n" +
 			"                 └─ IndexedTableAccess(region)\n" +
 			"                     ├─ index: [region.R_REGIONKEY]\n" +
+			"                     ├─ columns: [r_regionkey r_name]\n" +
 			"                     └─ keys: nation.n_regionkey\n" +
 			"",
 	},
@@ -464,21 +474,21 @@ order by
 			" ├─ columns: [orders.o_orderpriority:1!null, count(1):0!null->order_count:0]\n" +
 			" └─ Sort(orders.o_orderpriority:1!null ASC nullsFirst)\n" +
 			"     └─ GroupBy\n" +
-			"         ├─ select: COUNT(1 (bigint)), orders.o_orderpriority:5!null\n" +
-			"         ├─ group: orders.o_orderpriority:5!null\n" +
+			"         ├─ select: COUNT(1 (bigint)), orders.o_orderpriority:2!null\n" +
+			"         ├─ group: orders.o_orderpriority:2!null\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [orders.O_ORDERKEY:0!null, orders.O_CUSTKEY:1!null, orders.O_ORDERSTATUS:2!null, orders.O_TOTALPRICE:3!null, orders.O_ORDERDATE:4!null, orders.O_ORDERPRIORITY:5!null, orders.O_CLERK:6!null, orders.O_SHIPPRIORITY:7!null, orders.O_COMMENT:8!null]\n" +
+			"             ├─ columns: [orders.O_ORDERKEY:0!null, orders.O_ORDERDATE:1!null, orders.O_ORDERPRIORITY:2!null]\n" +
 			"             └─ MergeJoin\n" +
 			"                 ├─ cmp: Eq\n" +
 			"                 │   ├─ orders.o_orderkey:0!null\n" +
-			"                 │   └─ lineitem.l_orderkey:9!null\n" +
+			"                 │   └─ lineitem.l_orderkey:3!null\n" +
 			"                 ├─ Filter\n" +
 			"                 │   ├─ AND\n" +
 			"                 │   │   ├─ GreaterThanOrEqual\n" +
-			"                 │   │   │   ├─ orders.o_orderdate:4!null\n" +
+			"                 │   │   │   ├─ orders.o_orderdate:1!null\n" +
 			"                 │   │   │   └─ 1993-07-01 (longtext)\n" +
 			"                 │   │   └─ LessThan\n" +
-			"                 │   │       ├─ orders.o_orderdate:4!null\n" +
+			"                 │   │       ├─ orders.o_orderdate:1!null\n" +
 			"                 │   │       └─ 1993-10-01 00:00:00 +0000 UTC (datetime)\n" +
 			"                 │   └─ IndexedTableAccess(orders)\n" +
 			"                 │       ├─ index: [orders.O_ORDERKEY]\n" +
@@ -487,7 +497,7 @@ order by
 			"                 │       ├─ tableId: 1\n" +
 			"                 │       └─ Table\n" +
 			"                 │           ├─ name: orders\n" +
-			"                 │           └─ columns: [o_orderkey o_custkey o_orderstatus o_totalprice o_orderdate o_orderpriority o_clerk o_shippriority o_comment]\n" +
+			"                 │           └─ columns: [o_orderkey o_orderdate o_orderpriority]\n" +
 			"                 └─ Distinct\n" +
 			"                     └─ Project\n" +
 			"                         ├─ columns: [lineitem.l_orderkey:0!null]\n" +
@@ -511,14 +521,15 @@ order by
 			"         ├─ select: COUNT(1), orders.o_orderpriority\n" +
 			"         ├─ group: orders.o_orderpriority\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [orders.O_ORDERKEY, orders.O_CUSTKEY, orders.O_ORDERSTATUS, orders.O_TOTALPRICE, orders.O_ORDERDATE, orders.O_ORDERPRIORITY, orders.O_CLERK, orders.O_SHIPPRIORITY, orders.O_COMMENT]\n" +
+			"             ├─ columns: [orders.O_ORDERKEY, orders.O_ORDERDATE, orders.O_ORDERPRIORITY]\n" +
 			"             └─ MergeJoin (estimated cost=1725.500 rows=850)\n" +
 			"                 ├─ cmp: (orders.o_orderkey = lineitem.l_orderkey)\n" +
 			"                 ├─ Filter\n" +
 			"                 │   ├─ ((orders.o_orderdate >= '1993-07-01') AND (orders.o_orderdate < 1993-10-01 00:00:00 +0000 UTC))\n" +
 			"                 │   └─ IndexedTableAccess(orders)\n" +
 			"                 │       ├─ index: [orders.O_ORDERKEY]\n" +
-			"                 │       └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       └─ columns: [o_orderkey o_orderdate o_orderpriority]\n" +
 			"                 └─ Distinct\n" +
 			"                     └─ Project\n" +
 			"                         ├─ columns: [lineitem.l_orderkey]\n" +
@@ -536,14 +547,15 @@ order by
 			"         ├─ select: COUNT(1), orders.o_orderpriority\n" +
 			"         ├─ group: orders.o_orderpriority\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [orders.O_ORDERKEY, orders.O_CUSTKEY, orders.O_ORDERSTATUS, orders.O_TOTALPRICE, orders.O_ORDERDATE, orders.O_ORDERPRIORITY, orders.O_CLERK, orders.O_SHIPPRIORITY, orders.O_COMMENT]\n" +
+			"             ├─ columns: [orders.O_ORDERKEY, orders.O_ORDERDATE, orders.O_ORDERPRIORITY]\n" +
 			"             └─ MergeJoin (estimated cost=1725.500 rows=850) (actual rows=0 loops=1)\n" +
 			"                 ├─ cmp: (orders.o_orderkey = lineitem.l_orderkey)\n" +
 			"                 ├─ Filter\n" +
 			"                 │   ├─ ((orders.o_orderdate >= '1993-07-01') AND (orders.o_orderdate < 1993-10-01 00:00:00 +0000 UTC))\n" +
 			"                 │   └─ IndexedTableAccess(orders)\n" +
 			"                 │       ├─ index: [orders.O_ORDERKEY]\n" +
-			"                 │       └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"                 │       └─ columns: [o_orderkey o_orderdate o_orderpriority]\n" +
 			"                 └─ Distinct\n" +
 			"                     └─ Project\n" +
 			"                         ├─ columns: [lineitem.l_orderkey]\n" +
@@ -1799,7 +1811,7 @@ order by
 			"                     │   ├─ ProcessTable\n" +
 			"                     │   │   └─ Table\n" +
 			"                     │   │       ├─ name: partsupp\n" +
-			"                     │   │       └─ columns: [ps_partkey ps_suppkey ps_availqty ps_supplycost ps_comment]\n" +
+			"                     │   │       └─ columns: [ps_partkey ps_suppkey ps_availqty ps_supplycost]\n" +
 			"                     │   └─ IndexedTableAccess(supplier)\n" +
 			"                     │       ├─ index: [supplier.S_SUPPKEY]\n" +
 			"                     │       ├─ keys: [partsupp.ps_suppkey:1!null]\n" +
@@ -1807,19 +1819,19 @@ order by
 			"                     │       ├─ tableId: 2\n" +
 			"                     │       └─ Table\n" +
 			"                     │           ├─ name: supplier\n" +
-			"                     │           └─ columns: [s_suppkey s_name s_address s_nationkey s_phone s_acctbal s_comment]\n" +
+			"                     │           └─ columns: [s_suppkey s_nationkey]\n" +
 			"                     └─ Filter\n" +
 			"                         ├─ Eq\n" +
 			"                         │   ├─ nation.n_name:1!null\n" +
 			"                         │   └─ GERMANY (longtext)\n" +
 			"                         └─ IndexedTableAccess(nation)\n" +
 			"                             ├─ index: [nation.N_NATIONKEY]\n" +
-			"                             ├─ keys: [supplier.s_nationkey:8!null]\n" +
+			"                             ├─ keys: [supplier.s_nationkey:5!null]\n" +
 			"                             ├─ colSet: (13-16)\n" +
 			"                             ├─ tableId: 3\n" +
 			"                             └─ Table\n" +
 			"                                 ├─ name: nation\n" +
-			"                                 └─ columns: [n_nationkey n_name n_regionkey n_comment]\n" +
+			"                                 └─ columns: [n_nationkey n_name]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [partsupp.ps_partkey, sum((partsupp.ps_supplycost * partsupp.ps_availqty)) as value]\n" +
@@ -1833,14 +1845,17 @@ order by
 			"                 └─ LookupJoin\n" +
 			"                     ├─ LookupJoin\n" +
 			"                     │   ├─ Table\n" +
-			"                     │   │   └─ name: partsupp\n" +
+			"                     │   │   ├─ name: partsupp\n" +
+			"                     │   │   └─ columns: [ps_partkey ps_suppkey ps_availqty ps_supplycost]\n" +
 			"                     │   └─ IndexedTableAccess(supplier)\n" +
 			"                     │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"                     │       ├─ columns: [s_suppkey s_nationkey]\n" +
 			"                     │       └─ keys: partsupp.ps_suppkey\n" +
 			"                     └─ Filter\n" +
 			"                         ├─ (nation.n_name = 'GERMANY')\n" +
 			"                         └─ IndexedTableAccess(nation)\n" +
 			"                             ├─ index: [nation.N_NATIONKEY]\n" +
+			"                             ├─ columns: [n_nationkey n_name]\n" +
 			"                             └─ keys: supplier.s_nationkey\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -1855,14 +1870,17 @@ order by
 			"                 └─ LookupJoin\n" +
 			"                     ├─ LookupJoin\n" +
 			"                     │   ├─ Table\n" +
-			"                     │   │   └─ name: partsupp\n" +
+			"                     │   │   ├─ name: partsupp\n" +
+			"                     │   │   └─ columns: [ps_partkey ps_suppkey ps_availqty ps_supplycost]\n" +
 			"                     │   └─ IndexedTableAccess(supplier)\n" +
 			"                     │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"                     │       ├─ columns: [s_suppkey s_nationkey]\n" +
 			"                     │       └─ keys: partsupp.ps_suppkey\n" +
 			"                     └─ Filter\n" +
 			"                         ├─ (nation.n_name = 'GERMANY')\n" +
 			"                         └─ IndexedTableAccess(nation)\n" +
 			"                             ├─ index: [nation.N_NATIONKEY]\n" +
+			"                             ├─ columns: [n_nationkey n_name]\n" +
 			"                             └─ keys: supplier.s_nationkey\n" +
 			"",
 	},
@@ -2229,7 +2247,7 @@ where
 order by
 	s_suppkey;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [supplier.s_suppkey:2!null, supplier.s_name:3!null, supplier.s_address:4!null, supplier.s_phone:6!null, revenue0.total_revenue:1!null]\n" +
+			" ├─ columns: [supplier.s_suppkey:2!null, supplier.s_name:3!null, supplier.s_address:4!null, supplier.s_phone:5!null, revenue0.total_revenue:1!null]\n" +
 			" └─ Sort(supplier.s_suppkey:2!null ASC nullsFirst)\n" +
 			"     └─ Filter\n" +
 			"         ├─ Eq\n" +
@@ -2238,9 +2256,9 @@ order by
 			"         │       ├─ cacheable: true\n" +
 			"         │       ├─ alias-string: select max(total_revenue) from revenue0\n" +
 			"         │       └─ Project\n" +
-			"         │           ├─ columns: [max(revenue0.total_revenue):9!null->max(total_revenue):0]\n" +
+			"         │           ├─ columns: [max(revenue0.total_revenue):6!null->max(total_revenue):0]\n" +
 			"         │           └─ GroupBy\n" +
-			"         │               ├─ select: MAX(revenue0.total_revenue:10!null)\n" +
+			"         │               ├─ select: MAX(revenue0.total_revenue:7!null)\n" +
 			"         │               ├─ group: \n" +
 			"         │               └─ SubqueryAlias\n" +
 			"         │                   ├─ name: revenue0\n" +
@@ -2250,17 +2268,17 @@ order by
 			"         │                   ├─ colSet: (29,30)\n" +
 			"         │                   ├─ tableId: 4\n" +
 			"         │                   └─ Project\n" +
-			"         │                       ├─ columns: [lineitem.l_suppkey:10!null, sum((lineitem.l_extendedprice * (1 - lineitem.l_discount))):9!null->sum(l_extendedprice * (1 - l_discount)):0]\n" +
+			"         │                       ├─ columns: [lineitem.l_suppkey:7!null, sum((lineitem.l_extendedprice * (1 - lineitem.l_discount))):6!null->sum(l_extendedprice * (1 - l_discount)):0]\n" +
 			"         │                       └─ GroupBy\n" +
-			"         │                           ├─ select: SUM((lineitem.l_extendedprice:10!null * (1 (tinyint) - lineitem.l_discount:11!null))), lineitem.l_suppkey:9!null\n" +
-			"         │                           ├─ group: lineitem.l_suppkey:9!null\n" +
+			"         │                           ├─ select: SUM((lineitem.l_extendedprice:7!null * (1 (tinyint) - lineitem.l_discount:8!null))), lineitem.l_suppkey:6!null\n" +
+			"         │                           ├─ group: lineitem.l_suppkey:6!null\n" +
 			"         │                           └─ Filter\n" +
 			"         │                               ├─ AND\n" +
 			"         │                               │   ├─ GreaterThanOrEqual\n" +
-			"         │                               │   │   ├─ lineitem.l_shipdate:12!null\n" +
+			"         │                               │   │   ├─ lineitem.l_shipdate:9!null\n" +
 			"         │                               │   │   └─ 1996-01-01 (longtext)\n" +
 			"         │                               │   └─ LessThan\n" +
-			"         │                               │       ├─ lineitem.l_shipdate:12!null\n" +
+			"         │                               │       ├─ lineitem.l_shipdate:9!null\n" +
 			"         │                               │       └─ 1996-04-01 00:00:00 +0000 UTC (datetime)\n" +
 			"         │                               └─ Table\n" +
 			"         │                                   ├─ name: lineitem\n" +
@@ -2300,7 +2318,7 @@ order by
 			"                 ├─ tableId: 4\n" +
 			"                 └─ Table\n" +
 			"                     ├─ name: supplier\n" +
-			"                     └─ columns: [s_suppkey s_name s_address s_nationkey s_phone s_acctbal s_comment]\n" +
+			"                     └─ columns: [s_suppkey s_name s_address s_phone]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [supplier.s_suppkey, supplier.s_name, supplier.s_address, supplier.s_phone, revenue0.total_revenue]\n" +
@@ -2327,6 +2345,7 @@ order by
 			"             │                   └─ columns: [l_suppkey l_extendedprice l_discount l_shipdate]\n" +
 			"             └─ IndexedTableAccess(supplier)\n" +
 			"                 ├─ index: [supplier.S_SUPPKEY]\n" +
+			"                 ├─ columns: [s_suppkey s_name s_address s_phone]\n" +
 			"                 └─ keys: revenue0.supplier_no\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -2354,6 +2373,7 @@ order by
 			"             │                   └─ columns: [l_suppkey l_extendedprice l_discount l_shipdate]\n" +
 			"             └─ IndexedTableAccess(supplier)\n" +
 			"                 ├─ index: [supplier.S_SUPPKEY]\n" +
+			"                 ├─ columns: [s_suppkey s_name s_address s_phone]\n" +
 			"                 └─ keys: revenue0.supplier_no\n" +
 			"",
 	},
@@ -2396,17 +2416,17 @@ order by
 			"     └─ Project\n" +
 			"         ├─ columns: [countdistinct([partsupp.ps_suppkey]):0!null, part.p_brand:1!null, part.p_type:2!null, part.p_size:3!null, countdistinct([partsupp.ps_suppkey]):0!null->supplier_cnt:0]\n" +
 			"         └─ GroupBy\n" +
-			"             ├─ select: COUNTDISTINCT([partsupp.ps_suppkey]), part.p_brand:8!null, part.p_type:9!null, part.p_size:10!null\n" +
-			"             ├─ group: part.p_brand:8!null, part.p_type:9!null, part.p_size:10!null\n" +
+			"             ├─ select: COUNTDISTINCT([partsupp.ps_suppkey]), part.p_brand:3!null, part.p_type:4!null, part.p_size:5!null\n" +
+			"             ├─ group: part.p_brand:3!null, part.p_type:4!null, part.p_size:5!null\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [partsupp.PS_PARTKEY:0!null, partsupp.PS_SUPPKEY:1!null, partsupp.PS_AVAILQTY:2!null, partsupp.PS_SUPPLYCOST:3!null, partsupp.PS_COMMENT:4!null, part.P_PARTKEY:5!null, part.P_NAME:6!null, part.P_MFGR:7!null, part.P_BRAND:8!null, part.P_TYPE:9!null, part.P_SIZE:10!null, part.P_CONTAINER:11!null, part.P_RETAILPRICE:12!null, part.P_COMMENT:13!null]\n" +
+			"                 ├─ columns: [partsupp.PS_PARTKEY:0!null, partsupp.PS_SUPPKEY:1!null, part.P_PARTKEY:2!null, part.P_BRAND:3!null, part.P_TYPE:4!null, part.P_SIZE:5!null]\n" +
 			"                 └─ Filter\n" +
-			"                     ├─ supplier.s_suppkey:14!null IS NULL\n" +
+			"                     ├─ supplier.s_suppkey:6!null IS NULL\n" +
 			"                     └─ LeftOuterLookupJoin\n" +
 			"                         ├─ MergeJoin\n" +
 			"                         │   ├─ cmp: Eq\n" +
 			"                         │   │   ├─ partsupp.ps_partkey:0!null\n" +
-			"                         │   │   └─ part.p_partkey:5!null\n" +
+			"                         │   │   └─ part.p_partkey:2!null\n" +
 			"                         │   ├─ IndexedTableAccess(partsupp)\n" +
 			"                         │   │   ├─ index: [partsupp.PS_PARTKEY,partsupp.PS_SUPPKEY]\n" +
 			"                         │   │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
@@ -2414,23 +2434,23 @@ order by
 			"                         │   │   ├─ tableId: 1\n" +
 			"                         │   │   └─ Table\n" +
 			"                         │   │       ├─ name: partsupp\n" +
-			"                         │   │       └─ columns: [ps_partkey ps_suppkey ps_availqty ps_supplycost ps_comment]\n" +
+			"                         │   │       └─ columns: [ps_partkey ps_suppkey]\n" +
 			"                         │   └─ Filter\n" +
 			"                         │       ├─ AND\n" +
 			"                         │       │   ├─ AND\n" +
 			"                         │       │   │   ├─ NOT\n" +
 			"                         │       │   │   │   └─ Eq\n" +
-			"                         │       │   │   │       ├─ part.p_brand:3!null\n" +
+			"                         │       │   │   │       ├─ part.p_brand:1!null\n" +
 			"                         │       │   │   │       └─ Brand#45 (longtext)\n" +
 			"                         │       │   │   └─ Or\n" +
 			"                         │       │   │       ├─ LessThan\n" +
-			"                         │       │   │       │   ├─ part.p_type:4!null\n" +
+			"                         │       │   │       │   ├─ part.p_type:2!null\n" +
 			"                         │       │   │       │   └─ MEDIUM POLISHED (longtext)\n" +
 			"                         │       │   │       └─ GreaterThanOrEqual\n" +
-			"                         │       │   │           ├─ part.p_type:4!null\n" +
+			"                         │       │   │           ├─ part.p_type:2!null\n" +
 			"                         │       │   │           └─ MEDIUM POLISHEE (longtext)\n" +
 			"                         │       │   └─ HashIn\n" +
-			"                         │       │       ├─ part.p_size:5!null\n" +
+			"                         │       │       ├─ part.p_size:3!null\n" +
 			"                         │       │       └─ TUPLE(49 (tinyint), 14 (tinyint), 23 (tinyint), 45 (tinyint), 19 (tinyint), 3 (tinyint), 36 (tinyint), 9 (tinyint))\n" +
 			"                         │       └─ IndexedTableAccess(part)\n" +
 			"                         │           ├─ index: [part.P_PARTKEY]\n" +
@@ -2439,7 +2459,7 @@ order by
 			"                         │           ├─ tableId: 2\n" +
 			"                         │           └─ Table\n" +
 			"                         │               ├─ name: part\n" +
-			"                         │               └─ columns: [p_partkey p_name p_mfgr p_brand p_type p_size p_container p_retailprice p_comment]\n" +
+			"                         │               └─ columns: [p_partkey p_brand p_type p_size]\n" +
 			"                         └─ Project\n" +
 			"                             ├─ columns: [supplier.s_suppkey:0!null]\n" +
 			"                             └─ Filter\n" +
@@ -2462,7 +2482,7 @@ order by
 			"             ├─ select: COUNTDISTINCT([partsupp.ps_suppkey]), part.p_brand, part.p_type, part.p_size\n" +
 			"             ├─ group: part.p_brand, part.p_type, part.p_size\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [partsupp.PS_PARTKEY, partsupp.PS_SUPPKEY, partsupp.PS_AVAILQTY, partsupp.PS_SUPPLYCOST, partsupp.PS_COMMENT, part.P_PARTKEY, part.P_NAME, part.P_MFGR, part.P_BRAND, part.P_TYPE, part.P_SIZE, part.P_CONTAINER, part.P_RETAILPRICE, part.P_COMMENT]\n" +
+			"                 ├─ columns: [partsupp.PS_PARTKEY, partsupp.PS_SUPPKEY, part.P_PARTKEY, part.P_BRAND, part.P_TYPE, part.P_SIZE]\n" +
 			"                 └─ Filter\n" +
 			"                     ├─ supplier.s_suppkey IS NULL\n" +
 			"                     └─ LeftOuterLookupJoin (estimated cost=3333.726 rows=1000)\n" +
@@ -2470,12 +2490,14 @@ order by
 			"                         │   ├─ cmp: (partsupp.ps_partkey = part.p_partkey)\n" +
 			"                         │   ├─ IndexedTableAccess(partsupp)\n" +
 			"                         │   │   ├─ index: [partsupp.PS_PARTKEY,partsupp.PS_SUPPKEY]\n" +
-			"                         │   │   └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                         │   │   ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                         │   │   └─ columns: [ps_partkey ps_suppkey]\n" +
 			"                         │   └─ Filter\n" +
 			"                         │       ├─ (((NOT((part.p_brand = 'Brand#45'))) AND ((part.p_type < 'MEDIUM POLISHED') OR (part.p_type >= 'MEDIUM POLISHEE'))) AND (part.p_size HASH IN (49, 14, 23, 45, 19, 3, 36, 9)))\n" +
 			"                         │       └─ IndexedTableAccess(part)\n" +
 			"                         │           ├─ index: [part.P_PARTKEY]\n" +
-			"                         │           └─ filters: [{[NULL, ∞)}]\n" +
+			"                         │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"                         │           └─ columns: [p_partkey p_brand p_type p_size]\n" +
 			"                         └─ Project\n" +
 			"                             ├─ columns: [supplier.s_suppkey]\n" +
 			"                             └─ Filter\n" +
@@ -2493,7 +2515,7 @@ order by
 			"             ├─ select: COUNTDISTINCT([partsupp.ps_suppkey]), part.p_brand, part.p_type, part.p_size\n" +
 			"             ├─ group: part.p_brand, part.p_type, part.p_size\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [partsupp.PS_PARTKEY, partsupp.PS_SUPPKEY, partsupp.PS_AVAILQTY, partsupp.PS_SUPPLYCOST, partsupp.PS_COMMENT, part.P_PARTKEY, part.P_NAME, part.P_MFGR, part.P_BRAND, part.P_TYPE, part.P_SIZE, part.P_CONTAINER, part.P_RETAILPRICE, part.P_COMMENT]\n" +
+			"                 ├─ columns: [partsupp.PS_PARTKEY, partsupp.PS_SUPPKEY, part.P_PARTKEY, part.P_BRAND, part.P_TYPE, part.P_SIZE]\n" +
 			"                 └─ Filter\n" +
 			"                     ├─ supplier.s_suppkey IS NULL\n" +
 			"                     └─ LeftOuterLookupJoin (estimated cost=3333.726 rows=1000) (actual rows=0 loops=1)\n" +
@@ -2501,12 +2523,14 @@ order by
 			"                         │   ├─ cmp: (partsupp.ps_partkey = part.p_partkey)\n" +
 			"                         │   ├─ IndexedTableAccess(partsupp)\n" +
 			"                         │   │   ├─ index: [partsupp.PS_PARTKEY,partsupp.PS_SUPPKEY]\n" +
-			"                         │   │   └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                         │   │   ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                         │   │   └─ columns: [ps_partkey ps_suppkey]\n" +
 			"                         │   └─ Filter\n" +
 			"                         │       ├─ (((NOT((part.p_brand = 'Brand#45'))) AND ((part.p_type < 'MEDIUM POLISHED') OR (part.p_type >= 'MEDIUM POLISHEE'))) AND (part.p_size HASH IN (49, 14, 23, 45, 19, 3, 36, 9)))\n" +
 			"                         │       └─ IndexedTableAccess(part)\n" +
 			"                         │           ├─ index: [part.P_PARTKEY]\n" +
-			"                         │           └─ filters: [{[NULL, ∞)}]\n" +
+			"                         │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"                         │           └─ columns: [p_partkey p_brand p_type p_size]\n" +
 			"                         └─ Project\n" +
 			"                             ├─ columns: [supplier.s_suppkey]\n" +
 			"                             └─ Filter\n" +
@@ -2539,23 +2563,23 @@ where
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [(sum(lineitem.l_extendedprice):0!null / 7.0 (decimal(2,1)))->avg_yearly:0]\n" +
 			" └─ GroupBy\n" +
-			"     ├─ select: SUM(lineitem.l_extendedprice:5!null)\n" +
+			"     ├─ select: SUM(lineitem.l_extendedprice:2!null)\n" +
 			"     ├─ group: \n" +
 			"     └─ Filter\n" +
 			"         ├─ LessThan\n" +
-			"         │   ├─ lineitem.l_quantity:4!null\n" +
+			"         │   ├─ lineitem.l_quantity:1!null\n" +
 			"         │   └─ Subquery\n" +
 			"         │       ├─ cacheable: false\n" +
 			"         │       ├─ alias-string: select 0.2 * avg(l_quantity) from lineitem where l_partkey = p_partkey\n" +
 			"         │       └─ Project\n" +
-			"         │           ├─ columns: [(0.2 (decimal(2,1)) * avg(lineitem.l_quantity):25)->0.2 * avg(l_quantity):0]\n" +
+			"         │           ├─ columns: [(0.2 (decimal(2,1)) * avg(lineitem.l_quantity):6)->0.2 * avg(l_quantity):0]\n" +
 			"         │           └─ GroupBy\n" +
-			"         │               ├─ select: AVG(lineitem.l_quantity:26!null)\n" +
+			"         │               ├─ select: AVG(lineitem.l_quantity:7!null)\n" +
 			"         │               ├─ group: \n" +
 			"         │               └─ Filter\n" +
 			"         │                   ├─ Eq\n" +
-			"         │                   │   ├─ lineitem.l_partkey:25!null\n" +
-			"         │                   │   └─ part.p_partkey:16!null\n" +
+			"         │                   │   ├─ lineitem.l_partkey:6!null\n" +
+			"         │                   │   └─ part.p_partkey:3!null\n" +
 			"         │                   └─ Table\n" +
 			"         │                       ├─ name: lineitem\n" +
 			"         │                       ├─ columns: [l_partkey l_quantity]\n" +
@@ -2565,23 +2589,23 @@ where
 			"             ├─ ProcessTable\n" +
 			"             │   └─ Table\n" +
 			"             │       ├─ name: lineitem\n" +
-			"             │       └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
+			"             │       └─ columns: [l_partkey l_quantity l_extendedprice]\n" +
 			"             └─ Filter\n" +
 			"                 ├─ AND\n" +
 			"                 │   ├─ Eq\n" +
-			"                 │   │   ├─ part.p_brand:3!null\n" +
+			"                 │   │   ├─ part.p_brand:1!null\n" +
 			"                 │   │   └─ Brand#23 (longtext)\n" +
 			"                 │   └─ Eq\n" +
-			"                 │       ├─ part.p_container:6!null\n" +
+			"                 │       ├─ part.p_container:2!null\n" +
 			"                 │       └─ MED BOX (longtext)\n" +
 			"                 └─ IndexedTableAccess(part)\n" +
 			"                     ├─ index: [part.P_PARTKEY]\n" +
-			"                     ├─ keys: [lineitem.l_partkey:1!null]\n" +
+			"                     ├─ keys: [lineitem.l_partkey:0!null]\n" +
 			"                     ├─ colSet: (17-25)\n" +
 			"                     ├─ tableId: 2\n" +
 			"                     └─ Table\n" +
 			"                         ├─ name: part\n" +
-			"                         └─ columns: [p_partkey p_name p_mfgr p_brand p_type p_size p_container p_retailprice p_comment]\n" +
+			"                         └─ columns: [p_partkey p_brand p_container]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [(sum(lineitem.l_extendedprice) / 7.0) as avg_yearly]\n" +
@@ -2592,11 +2616,13 @@ where
 			"         ├─ (lineitem.l_quantity < Subquery(select 0.2 * avg(l_quantity) from lineitem where l_partkey = p_partkey))\n" +
 			"         └─ LookupJoin (estimated cost=3333.726 rows=1000)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: lineitem\n" +
+			"             │   ├─ name: lineitem\n" +
+			"             │   └─ columns: [l_partkey l_quantity l_extendedprice]\n" +
 			"             └─ Filter\n" +
 			"                 ├─ ((part.p_brand = 'Brand#23') AND (part.p_container = 'MED BOX'))\n" +
 			"                 └─ IndexedTableAccess(part)\n" +
 			"                     ├─ index: [part.P_PARTKEY]\n" +
+			"                     ├─ columns: [p_partkey p_brand p_container]\n" +
 			"                     └─ keys: lineitem.l_partkey\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -2608,11 +2634,13 @@ where
 			"         ├─ (lineitem.l_quantity < Subquery(select 0.2 * avg(l_quantity) from lineitem where l_partkey = p_partkey))\n" +
 			"         └─ LookupJoin (estimated cost=3333.726 rows=1000) (actual rows=0 loops=1)\n" +
 			"             ├─ Table\n" +
-			"             │   └─ name: lineitem\n" +
+			"             │   ├─ name: lineitem\n" +
+			"             │   └─ columns: [l_partkey l_quantity l_extendedprice]\n" +
 			"             └─ Filter\n" +
 			"                 ├─ ((part.p_brand = 'Brand#23') AND (part.p_container = 'MED BOX'))\n" +
 			"                 └─ IndexedTableAccess(part)\n" +
 			"                     ├─ index: [part.P_PARTKEY]\n" +
+			"                     ├─ columns: [p_partkey p_brand p_container]\n" +
 			"                     └─ keys: lineitem.l_partkey\n" +
 			"",
 	},
@@ -2655,32 +2683,32 @@ order by
 			" ├─ columns: [customer.c_name:1!null, customer.c_custkey:2!null, orders.o_orderkey:3!null, orders.o_orderdate:4!null, orders.o_totalprice:5!null, sum(lineitem.l_quantity):0!null->sum(l_quantity):0]\n" +
 			" └─ Sort(orders.o_totalprice:5!null DESC nullsFirst, orders.o_orderdate:4!null ASC nullsFirst)\n" +
 			"     └─ GroupBy\n" +
-			"         ├─ select: SUM(lineitem.l_quantity:21!null), customer.c_name:1!null, customer.c_custkey:0!null, orders.o_orderkey:8!null, orders.o_orderdate:12!null, orders.o_totalprice:11!null\n" +
-			"         ├─ group: customer.c_name:1!null, customer.c_custkey:0!null, orders.o_orderkey:8!null, orders.o_orderdate:12!null, orders.o_totalprice:11!null\n" +
+			"         ├─ select: SUM(lineitem.l_quantity:7!null), customer.c_name:1!null, customer.c_custkey:0!null, orders.o_orderkey:2!null, orders.o_orderdate:5!null, orders.o_totalprice:4!null\n" +
+			"         ├─ group: customer.c_name:1!null, customer.c_custkey:0!null, orders.o_orderkey:2!null, orders.o_orderdate:5!null, orders.o_totalprice:4!null\n" +
 			"         └─ SemiJoin\n" +
 			"             ├─ Eq\n" +
-			"             │   ├─ orders.o_orderkey:8!null\n" +
-			"             │   └─ lineitem_1.l_orderkey:33!null\n" +
+			"             │   ├─ orders.o_orderkey:2!null\n" +
+			"             │   └─ lineitem_1.l_orderkey:8!null\n" +
 			"             ├─ InnerJoin\n" +
 			"             │   ├─ Eq\n" +
-			"             │   │   ├─ orders.o_orderkey:8!null\n" +
-			"             │   │   └─ lineitem.l_orderkey:17!null\n" +
+			"             │   │   ├─ orders.o_orderkey:2!null\n" +
+			"             │   │   └─ lineitem.l_orderkey:6!null\n" +
 			"             │   ├─ InnerJoin\n" +
 			"             │   │   ├─ Eq\n" +
 			"             │   │   │   ├─ customer.c_custkey:0!null\n" +
-			"             │   │   │   └─ orders.o_custkey:9!null\n" +
+			"             │   │   │   └─ orders.o_custkey:3!null\n" +
 			"             │   │   ├─ ProcessTable\n" +
 			"             │   │   │   └─ Table\n" +
 			"             │   │   │       ├─ name: customer\n" +
-			"             │   │   │       └─ columns: [c_custkey c_name c_address c_nationkey c_phone c_acctbal c_mktsegment c_comment]\n" +
+			"             │   │   │       └─ columns: [c_custkey c_name]\n" +
 			"             │   │   └─ ProcessTable\n" +
 			"             │   │       └─ Table\n" +
 			"             │   │           ├─ name: orders\n" +
-			"             │   │           └─ columns: [o_orderkey o_custkey o_orderstatus o_totalprice o_orderdate o_orderpriority o_clerk o_shippriority o_comment]\n" +
+			"             │   │           └─ columns: [o_orderkey o_custkey o_totalprice o_orderdate]\n" +
 			"             │   └─ ProcessTable\n" +
 			"             │       └─ Table\n" +
 			"             │           ├─ name: lineitem\n" +
-			"             │           └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
+			"             │           └─ columns: [l_orderkey l_quantity]\n" +
 			"             └─ Project\n" +
 			"                 ├─ columns: [lineitem_1.l_orderkey:1!null]\n" +
 			"                 └─ Having\n" +
@@ -2710,11 +2738,14 @@ order by
 			"             │   ├─ InnerJoin (No stats)\n" +
 			"             │   │   ├─ (customer.c_custkey = orders.o_custkey)\n" +
 			"             │   │   ├─ Table\n" +
-			"             │   │   │   └─ name: customer\n" +
+			"             │   │   │   ├─ name: customer\n" +
+			"             │   │   │   └─ columns: [c_custkey c_name]\n" +
 			"             │   │   └─ Table\n" +
-			"             │   │       └─ name: orders\n" +
+			"             │   │       ├─ name: orders\n" +
+			"             │   │       └─ columns: [o_orderkey o_custkey o_totalprice o_orderdate]\n" +
 			"             │   └─ Table\n" +
-			"             │       └─ name: lineitem\n" +
+			"             │       ├─ name: lineitem\n" +
+			"             │       └─ columns: [l_orderkey l_quantity]\n" +
 			"             └─ Project\n" +
 			"                 ├─ columns: [lineitem_1.l_orderkey]\n" +
 			"                 └─ Having((sum(lineitem.l_quantity) > 300))\n" +
@@ -2738,11 +2769,14 @@ order by
 			"             │   ├─ InnerJoin (No stats)\n" +
 			"             │   │   ├─ (customer.c_custkey = orders.o_custkey)\n" +
 			"             │   │   ├─ Table\n" +
-			"             │   │   │   └─ name: customer\n" +
+			"             │   │   │   ├─ name: customer\n" +
+			"             │   │   │   └─ columns: [c_custkey c_name]\n" +
 			"             │   │   └─ Table\n" +
-			"             │   │       └─ name: orders\n" +
+			"             │   │       ├─ name: orders\n" +
+			"             │   │       └─ columns: [o_orderkey o_custkey o_totalprice o_orderdate]\n" +
 			"             │   └─ Table\n" +
-			"             │       └─ name: lineitem\n" +
+			"             │       ├─ name: lineitem\n" +
+			"             │       └─ columns: [l_orderkey l_quantity]\n" +
 			"             └─ Project\n" +
 			"                 ├─ columns: [lineitem_1.l_orderkey]\n" +
 			"                 └─ Having((sum(lineitem.l_quantity) > 300))\n" +
@@ -3110,7 +3144,7 @@ order by
 			"         │       ├─ tableId: 1\n" +
 			"         │       └─ Table\n" +
 			"         │           ├─ name: supplier\n" +
-			"         │           └─ columns: [s_suppkey s_name s_address s_nationkey s_phone s_acctbal s_comment]\n" +
+			"         │           └─ columns: [s_suppkey s_name s_address s_nationkey]\n" +
 			"         └─ Filter\n" +
 			"             ├─ Eq\n" +
 			"             │   ├─ nation.n_name:1!null\n" +
@@ -3122,7 +3156,7 @@ order by
 			"                 ├─ tableId: 2\n" +
 			"                 └─ Table\n" +
 			"                     ├─ name: nation\n" +
-			"                     └─ columns: [n_nationkey n_name n_regionkey n_comment]\n" +
+			"                     └─ columns: [n_nationkey n_name]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [supplier.s_name, supplier.s_address]\n" +
@@ -3152,11 +3186,13 @@ order by
 			"         │   │                                   └─ filters: [{[NULL, ∞)}]\n" +
 			"         │   └─ IndexedTableAccess(supplier)\n" +
 			"         │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"         │       ├─ columns: [s_suppkey s_name s_address s_nationkey]\n" +
 			"         │       └─ keys: partsupp.ps_suppkey\n" +
 			"         └─ Filter\n" +
 			"             ├─ (nation.n_name = 'CANADA')\n" +
 			"             └─ IndexedTableAccess(nation)\n" +
 			"                 ├─ index: [nation.N_NATIONKEY]\n" +
+			"                 ├─ columns: [n_nationkey n_name]\n" +
 			"                 └─ keys: supplier.s_nationkey\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
@@ -3187,11 +3223,13 @@ order by
 			"         │   │                                   └─ filters: [{[NULL, ∞)}]\n" +
 			"         │   └─ IndexedTableAccess(supplier)\n" +
 			"         │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"         │       ├─ columns: [s_suppkey s_name s_address s_nationkey]\n" +
 			"         │       └─ keys: partsupp.ps_suppkey\n" +
 			"         └─ Filter\n" +
 			"             ├─ (nation.n_name = 'CANADA')\n" +
 			"             └─ IndexedTableAccess(nation)\n" +
 			"                 ├─ index: [nation.N_NATIONKEY]\n" +
+			"                 ├─ columns: [n_nationkey n_name]\n" +
 			"                 └─ keys: supplier.s_nationkey\n" +
 			"",
 	},
@@ -3243,32 +3281,32 @@ order by
 			"     └─ Project\n" +
 			"         ├─ columns: [count(1):0!null, supplier.s_name:1!null, count(1):0!null->numwait:0]\n" +
 			"         └─ GroupBy\n" +
-			"             ├─ select: COUNT(1 (bigint)), supplier.s_name:26!null\n" +
-			"             ├─ group: supplier.s_name:26!null\n" +
+			"             ├─ select: COUNT(1 (bigint)), supplier.s_name:7!null\n" +
+			"             ├─ group: supplier.s_name:7!null\n" +
 			"             └─ SemiLookupJoin\n" +
 			"                 ├─ NOT\n" +
 			"                 │   └─ Eq\n" +
-			"                 │       ├─ l2.l_suppkey:38!null\n" +
-			"                 │       └─ l1.l_suppkey:2!null\n" +
+			"                 │       ├─ l2.l_suppkey:13!null\n" +
+			"                 │       └─ l1.l_suppkey:1!null\n" +
 			"                 ├─ AntiJoinIncludingNulls\n" +
 			"                 │   ├─ AND\n" +
 			"                 │   │   ├─ Eq\n" +
-			"                 │   │   │   ├─ l3.l_orderkey:36!null\n" +
+			"                 │   │   │   ├─ l3.l_orderkey:11!null\n" +
 			"                 │   │   │   └─ l1.l_orderkey:0!null\n" +
 			"                 │   │   └─ NOT\n" +
 			"                 │   │       └─ Eq\n" +
-			"                 │   │           ├─ l3.l_suppkey:38!null\n" +
-			"                 │   │           └─ l1.l_suppkey:2!null\n" +
+			"                 │   │           ├─ l3.l_suppkey:13!null\n" +
+			"                 │   │           └─ l1.l_suppkey:1!null\n" +
 			"                 │   ├─ LookupJoin\n" +
 			"                 │   │   ├─ LookupJoin\n" +
 			"                 │   │   │   ├─ MergeJoin\n" +
 			"                 │   │   │   │   ├─ cmp: Eq\n" +
 			"                 │   │   │   │   │   ├─ l1.l_orderkey:0!null\n" +
-			"                 │   │   │   │   │   └─ orders.o_orderkey:16!null\n" +
+			"                 │   │   │   │   │   └─ orders.o_orderkey:4!null\n" +
 			"                 │   │   │   │   ├─ Filter\n" +
 			"                 │   │   │   │   │   ├─ GreaterThan\n" +
-			"                 │   │   │   │   │   │   ├─ l1.l_receiptdate:12!null\n" +
-			"                 │   │   │   │   │   │   └─ l1.l_commitdate:11!null\n" +
+			"                 │   │   │   │   │   │   ├─ l1.l_receiptdate:3!null\n" +
+			"                 │   │   │   │   │   │   └─ l1.l_commitdate:2!null\n" +
 			"                 │   │   │   │   │   └─ TableAlias(l1)\n" +
 			"                 │   │   │   │   │       └─ IndexedTableAccess(lineitem)\n" +
 			"                 │   │   │   │   │           ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
@@ -3277,10 +3315,10 @@ order by
 			"                 │   │   │   │   │           ├─ tableId: 2\n" +
 			"                 │   │   │   │   │           └─ Table\n" +
 			"                 │   │   │   │   │               ├─ name: lineitem\n" +
-			"                 │   │   │   │   │               └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
+			"                 │   │   │   │   │               └─ columns: [l_orderkey l_suppkey l_commitdate l_receiptdate]\n" +
 			"                 │   │   │   │   └─ Filter\n" +
 			"                 │   │   │   │       ├─ Eq\n" +
-			"                 │   │   │   │       │   ├─ orders.o_orderstatus:2!null\n" +
+			"                 │   │   │   │       │   ├─ orders.o_orderstatus:1!null\n" +
 			"                 │   │   │   │       │   └─ F (longtext)\n" +
 			"                 │   │   │   │       └─ IndexedTableAccess(orders)\n" +
 			"                 │   │   │   │           ├─ index: [orders.O_ORDERKEY]\n" +
@@ -3289,27 +3327,27 @@ order by
 			"                 │   │   │   │           ├─ tableId: 3\n" +
 			"                 │   │   │   │           └─ Table\n" +
 			"                 │   │   │   │               ├─ name: orders\n" +
-			"                 │   │   │   │               └─ columns: [o_orderkey o_custkey o_orderstatus o_totalprice o_orderdate o_orderpriority o_clerk o_shippriority o_comment]\n" +
+			"                 │   │   │   │               └─ columns: [o_orderkey o_orderstatus]\n" +
 			"                 │   │   │   └─ IndexedTableAccess(supplier)\n" +
 			"                 │   │   │       ├─ index: [supplier.S_SUPPKEY]\n" +
-			"                 │   │   │       ├─ keys: [l1.l_suppkey:2!null]\n" +
+			"                 │   │   │       ├─ keys: [l1.l_suppkey:1!null]\n" +
 			"                 │   │   │       ├─ colSet: (1-7)\n" +
 			"                 │   │   │       ├─ tableId: 1\n" +
 			"                 │   │   │       └─ Table\n" +
 			"                 │   │   │           ├─ name: supplier\n" +
-			"                 │   │   │           └─ columns: [s_suppkey s_name s_address s_nationkey s_phone s_acctbal s_comment]\n" +
+			"                 │   │   │           └─ columns: [s_suppkey s_name s_nationkey]\n" +
 			"                 │   │   └─ Filter\n" +
 			"                 │   │       ├─ Eq\n" +
 			"                 │   │       │   ├─ nation.n_name:1!null\n" +
 			"                 │   │       │   └─ SAUDI ARABIA (longtext)\n" +
 			"                 │   │       └─ IndexedTableAccess(nation)\n" +
 			"                 │   │           ├─ index: [nation.N_NATIONKEY]\n" +
-			"                 │   │           ├─ keys: [supplier.s_nationkey:28!null]\n" +
+			"                 │   │           ├─ keys: [supplier.s_nationkey:8!null]\n" +
 			"                 │   │           ├─ colSet: (33-36)\n" +
 			"                 │   │           ├─ tableId: 4\n" +
 			"                 │   │           └─ Table\n" +
 			"                 │   │               ├─ name: nation\n" +
-			"                 │   │               └─ columns: [n_nationkey n_name n_regionkey n_comment]\n" +
+			"                 │   │               └─ columns: [n_nationkey n_name]\n" +
 			"                 │   └─ Filter\n" +
 			"                 │       ├─ GreaterThan\n" +
 			"                 │       │   ├─ l3.l_receiptdate:12!null\n" +
@@ -3350,19 +3388,23 @@ order by
 			"                 │   │   │   │   │   └─ TableAlias(l1)\n" +
 			"                 │   │   │   │   │       └─ IndexedTableAccess(lineitem)\n" +
 			"                 │   │   │   │   │           ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
-			"                 │   │   │   │   │           └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 │   │   │   │   │           ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 │   │   │   │   │           └─ columns: [l_orderkey l_suppkey l_commitdate l_receiptdate]\n" +
 			"                 │   │   │   │   └─ Filter\n" +
 			"                 │   │   │   │       ├─ (orders.o_orderstatus = 'F')\n" +
 			"                 │   │   │   │       └─ IndexedTableAccess(orders)\n" +
 			"                 │   │   │   │           ├─ index: [orders.O_ORDERKEY]\n" +
-			"                 │   │   │   │           └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"                 │   │   │   │           └─ columns: [o_orderkey o_orderstatus]\n" +
 			"                 │   │   │   └─ IndexedTableAccess(supplier)\n" +
 			"                 │   │   │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"                 │   │   │       ├─ columns: [s_suppkey s_name s_nationkey]\n" +
 			"                 │   │   │       └─ keys: l1.l_suppkey\n" +
 			"                 │   │   └─ Filter\n" +
 			"                 │   │       ├─ (nation.n_name = 'SAUDI ARABIA')\n" +
 			"                 │   │       └─ IndexedTableAccess(nation)\n" +
 			"                 │   │           ├─ index: [nation.N_NATIONKEY]\n" +
+			"                 │   │           ├─ columns: [n_nationkey n_name]\n" +
 			"                 │   │           └─ keys: supplier.s_nationkey\n" +
 			"                 │   └─ Filter\n" +
 			"                 │       ├─ (l3.l_receiptdate > l3.l_commitdate)\n" +
@@ -3397,19 +3439,23 @@ order by
 			"                 │   │   │   │   │   └─ TableAlias(l1)\n" +
 			"                 │   │   │   │   │       └─ IndexedTableAccess(lineitem)\n" +
 			"                 │   │   │   │   │           ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
-			"                 │   │   │   │   │           └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 │   │   │   │   │           ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 │   │   │   │   │           └─ columns: [l_orderkey l_suppkey l_commitdate l_receiptdate]\n" +
 			"                 │   │   │   │   └─ Filter\n" +
 			"                 │   │   │   │       ├─ (orders.o_orderstatus = 'F')\n" +
 			"                 │   │   │   │       └─ IndexedTableAccess(orders)\n" +
 			"                 │   │   │   │           ├─ index: [orders.O_ORDERKEY]\n" +
-			"                 │   │   │   │           └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"                 │   │   │   │           └─ columns: [o_orderkey o_orderstatus]\n" +
 			"                 │   │   │   └─ IndexedTableAccess(supplier)\n" +
 			"                 │   │   │       ├─ index: [supplier.S_SUPPKEY]\n" +
+			"                 │   │   │       ├─ columns: [s_suppkey s_name s_nationkey]\n" +
 			"                 │   │   │       └─ keys: l1.l_suppkey\n" +
 			"                 │   │   └─ Filter\n" +
 			"                 │   │       ├─ (nation.n_name = 'SAUDI ARABIA')\n" +
 			"                 │   │       └─ IndexedTableAccess(nation)\n" +
 			"                 │   │           ├─ index: [nation.N_NATIONKEY]\n" +
+			"                 │   │           ├─ columns: [n_nationkey n_name]\n" +
 			"                 │   │           └─ keys: supplier.s_nationkey\n" +
 			"                 │   └─ Filter\n" +
 			"                 │       ├─ (l3.l_receiptdate > l3.l_commitdate)\n" +
@@ -3478,22 +3524,22 @@ order by
 			"             ├─ colSet: (28,29)\n" +
 			"             ├─ tableId: 4\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [SUBSTRING(customer.c_phone, 1, 2)->cntrycode:0, customer.c_acctbal:5!null]\n" +
+			"                 ├─ columns: [SUBSTRING(customer.c_phone, 1, 2)->cntrycode:0, customer.c_acctbal:2!null]\n" +
 			"                 └─ Filter\n" +
 			"                     ├─ GreaterThan\n" +
-			"                     │   ├─ customer.c_acctbal:5!null\n" +
+			"                     │   ├─ customer.c_acctbal:2!null\n" +
 			"                     │   └─ Subquery\n" +
 			"                     │       ├─ cacheable: true\n" +
 			"                     │       ├─ alias-string: select avg(c_acctbal) from customer where c_acctbal > 0.00 and substr(c_phone, 1, 2) in ('13', '31', '23', '29', '30', '18', '17')\n" +
 			"                     │       └─ Project\n" +
-			"                     │           ├─ columns: [avg(customer.c_acctbal):8->avg(c_acctbal):0]\n" +
+			"                     │           ├─ columns: [avg(customer.c_acctbal):3->avg(c_acctbal):0]\n" +
 			"                     │           └─ GroupBy\n" +
-			"                     │               ├─ select: AVG(customer.c_acctbal:9!null)\n" +
+			"                     │               ├─ select: AVG(customer.c_acctbal:4!null)\n" +
 			"                     │               ├─ group: \n" +
 			"                     │               └─ Filter\n" +
 			"                     │                   ├─ AND\n" +
 			"                     │                   │   ├─ GreaterThan\n" +
-			"                     │                   │   │   ├─ customer.c_acctbal:9!null\n" +
+			"                     │                   │   │   ├─ customer.c_acctbal:4!null\n" +
 			"                     │                   │   │   └─ 0.00 (decimal(15,2))\n" +
 			"                     │                   │   └─ HashIn\n" +
 			"                     │                   │       ├─ SUBSTRING(customer.c_phone, 1, 2)\n" +
@@ -3504,12 +3550,12 @@ order by
 			"                     │                       ├─ colSet: (9-16)\n" +
 			"                     │                       └─ tableId: 2\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [customer.C_CUSTKEY:0!null, customer.C_NAME:1!null, customer.C_ADDRESS:2!null, customer.C_NATIONKEY:3!null, customer.C_PHONE:4!null, customer.C_ACCTBAL:5!null, customer.C_MKTSEGMENT:6!null, customer.C_COMMENT:7!null]\n" +
+			"                         ├─ columns: [customer.C_CUSTKEY:0!null, customer.C_PHONE:1!null, customer.C_ACCTBAL:2!null]\n" +
 			"                         └─ Filter\n" +
-			"                             ├─ orders.o_custkey:8!null IS NULL\n" +
+			"                             ├─ orders.o_custkey:3!null IS NULL\n" +
 			"                             └─ LeftOuterHashJoin\n" +
 			"                                 ├─ Eq\n" +
-			"                                 │   ├─ orders.o_custkey:8!null\n" +
+			"                                 │   ├─ orders.o_custkey:3!null\n" +
 			"                                 │   └─ customer.c_custkey:0!null\n" +
 			"                                 ├─ Filter\n" +
 			"                                 │   ├─ HashIn\n" +
@@ -3517,7 +3563,7 @@ order by
 			"                                 │   │   └─ TUPLE(13 (longtext), 31 (longtext), 23 (longtext), 29 (longtext), 30 (longtext), 18 (longtext), 17 (longtext))\n" +
 			"                                 │   └─ Table\n" +
 			"                                 │       ├─ name: customer\n" +
-			"                                 │       ├─ columns: [c_custkey c_name c_address c_nationkey c_phone c_acctbal c_mktsegment c_comment]\n" +
+			"                                 │       ├─ columns: [c_custkey c_phone c_acctbal]\n" +
 			"                                 │       ├─ colSet: (1-8)\n" +
 			"                                 │       └─ tableId: 1\n" +
 			"                                 └─ HashLookup\n" +
@@ -3549,7 +3595,7 @@ order by
 			"                 └─ Filter\n" +
 			"                     ├─ (customer.c_acctbal > Subquery(select avg(c_acctbal) from customer where c_acctbal > 0.00 and substr(c_phone, 1, 2) in ('13', '31', '23', '29', '30', '18', '17')))\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [customer.C_CUSTKEY, customer.C_NAME, customer.C_ADDRESS, customer.C_NATIONKEY, customer.C_PHONE, customer.C_ACCTBAL, customer.C_MKTSEGMENT, customer.C_COMMENT]\n" +
+			"                         ├─ columns: [customer.C_CUSTKEY, customer.C_PHONE, customer.C_ACCTBAL]\n" +
 			"                         └─ Filter\n" +
 			"                             ├─ orders.o_custkey IS NULL\n" +
 			"                             └─ LeftOuterHashJoin (estimated cost=3869.120 rows=1062)\n" +
@@ -3557,7 +3603,8 @@ order by
 			"                                 ├─ Filter\n" +
 			"                                 │   ├─ (SUBSTRING(customer.c_phone, 1, 2) HASH IN ('13', '31', '23', '29', '30', '18', '17'))\n" +
 			"                                 │   └─ Table\n" +
-			"                                 │       └─ name: customer\n" +
+			"                                 │       ├─ name: customer\n" +
+			"                                 │       └─ columns: [c_custkey c_phone c_acctbal]\n" +
 			"                                 └─ HashLookup\n" +
 			"                                     ├─ left-key: (customer.c_custkey)\n" +
 			"                                     ├─ right-key: (orders.o_custkey)\n" +
@@ -3585,7 +3632,7 @@ order by
 			"                 └─ Filter\n" +
 			"                     ├─ (customer.c_acctbal > Subquery(select avg(c_acctbal) from customer where c_acctbal > 0.00 and substr(c_phone, 1, 2) in ('13', '31', '23', '29', '30', '18', '17')))\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [customer.C_CUSTKEY, customer.C_NAME, customer.C_ADDRESS, customer.C_NATIONKEY, customer.C_PHONE, customer.C_ACCTBAL, customer.C_MKTSEGMENT, customer.C_COMMENT]\n" +
+			"                         ├─ columns: [customer.C_CUSTKEY, customer.C_PHONE, customer.C_ACCTBAL]\n" +
 			"                         └─ Filter\n" +
 			"                             ├─ orders.o_custkey IS NULL\n" +
 			"                             └─ LeftOuterHashJoin (estimated cost=3869.120 rows=1062) (actual rows=0 loops=1)\n" +
@@ -3593,7 +3640,8 @@ order by
 			"                                 ├─ Filter\n" +
 			"                                 │   ├─ (SUBSTRING(customer.c_phone, 1, 2) HASH IN ('13', '31', '23', '29', '30', '18', '17'))\n" +
 			"                                 │   └─ Table\n" +
-			"                                 │       └─ name: customer\n" +
+			"                                 │       ├─ name: customer\n" +
+			"                                 │       └─ columns: [c_custkey c_phone c_acctbal]\n" +
 			"                                 └─ HashLookup\n" +
 			"                                     ├─ left-key: (customer.c_custkey)\n" +
 			"                                     ├─ right-key: (orders.o_custkey)\n" +
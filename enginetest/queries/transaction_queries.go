@@ -1220,16 +1220,12 @@ var TransactionTests = []TransactionTest{
 				Expected: []sql.Row{},
 			},
 			{
-				// TODO: turns out we can't alter temporary tables; unskip tests when that is fixed
 				// Oddly, this does implicitly commit the transaction
-				Skip:     true,
 				Query:    "/* client a */ alter table tmp add column j int;",
 				Expected: []sql.Row{{types.OkResult{}}},
 			},
 			{
-				// TODO: turns out we can't alter temporary tables; unskip tests when that is fixed
 				Query: "/* client b */ select * from t;",
-				Skip:  true,
 				Expected: []sql.Row{
 					{1},
 					{2},
@@ -1238,6 +1234,51 @@ var TransactionTests = []TransactionTest{
 			},
 		},
 	},
+	{
+		Name: "temporary tables are private to the session that creates them",
+		SetUpScript: []string{
+			"create table t (pk int primary key)",
+			"insert into t values (1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "/* client a */ create temporary table t (pk int primary key, v int);",
+				Expected: []sql.Row{{types.OkResult{}}},
+			},
+			{
+				Query:    "/* client a */ insert into t values (2, 2);",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "/* client a */ select * from t;",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query:    "/* client b */ select * from t;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "/* client b */ create temporary table t (pk int primary key, v int);",
+				Expected: []sql.Row{{types.OkResult{}}},
+			},
+			{
+				Query:    "/* client b */ select * from t;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client b */ drop temporary table t;",
+				Expected: []sql.Row{{types.OkResult{}}},
+			},
+			{
+				Query:    "/* client b */ select * from t;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "/* client a */ select * from t;",
+				Expected: []sql.Row{{2, 2}},
+			},
+		},
+	},
 	{
 		Name: "alter table queries are implicitly committed",
 		Assertions: []ScriptTestAssertion{
@@ -2164,7 +2164,7 @@ end;`,
 				Expected: []sql.Row{
 					{
 						"a1", // Trigger
-						"",   // sql_mode
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",           // sql_mode
 						"create trigger a1 before insert on a for each row set new.x = new.x + 1", // SQL Original Statement
 						sql.Collation_Default.CharacterSet().String(),                             // character_set_client
 						sql.Collation_Default.String(),                                            // collation_connection
@@ -2178,7 +2178,7 @@ end;`,
 				Expected: []sql.Row{
 					{
 						"b1", // Trigger
-						"",   // sql_mode
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",           // sql_mode
 						"create trigger b1 before insert on b for each row set new.y = new.y + 2", // SQL Original Statement
 						sql.Collation_Default.CharacterSet().String(),                             // character_set_client
 						sql.Collation_Default.String(),                                            // collation_connection
@@ -2215,8 +2215,8 @@ end;`,
 						"set new.x = new.x + 1", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2228,8 +2228,8 @@ end;`,
 						"set new.x = new.x + 2", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2241,8 +2241,8 @@ end;`,
 						"insert into abb values (new.y)", // Statement
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
-						"",                               // sql_mode
-						"",                               // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2254,8 +2254,8 @@ end;`,
 						"set new.y = old.y + 2", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2272,8 +2272,8 @@ end;`,
 						"set new.x = new.x + 1", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2285,8 +2285,8 @@ end;`,
 						"set new.x = new.x + 2", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2298,8 +2298,8 @@ end;`,
 						"insert into abb values (new.y)", // Statement
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
-						"",                               // sql_mode
-						"",                               // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2311,8 +2311,8 @@ end;`,
 						"set new.y = old.y + 2", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2329,8 +2329,8 @@ end;`,
 						"insert into abb values (new.y)", // Statement
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
-						"",                               // sql_mode
-						"",                               // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2342,8 +2342,8 @@ end;`,
 						"set new.y = old.y + 2", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2360,8 +2360,8 @@ end;`,
 						"set new.x = new.x + 1", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2373,8 +2373,8 @@ end;`,
 						"set new.x = new.x + 2", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2386,8 +2386,8 @@ end;`,
 						"insert into abb values (new.y)", // Statement
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
-						"",                               // sql_mode
-						"",                               // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2404,8 +2404,8 @@ end;`,
 						"insert into abb values (new.y)", // Statement
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
-						"",                               // sql_mode
-						"",                               // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2422,8 +2422,8 @@ end;`,
 						"set new.x = new.x + 1", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2435,8 +2435,8 @@ end;`,
 						"set new.x = new.x + 2", // Statement
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
-						"",                      // sql_mode
-						"",                      // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -2445,6 +2445,66 @@ end;`,
 			},
 		},
 	},
+	{
+		Name: "show triggers definer, sql_mode and database",
+		SetUpScript: []string{
+			"create table t (x int primary key)",
+			"set sql_mode = 'ANSI_QUOTES'",
+			"create definer = `dolt`@`localhost` trigger trg1 before insert on t for each row set new.x = new.x + 1",
+			"set sql_mode = 'NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES'",
+			"create database otherdb",
+			"use otherdb",
+			"create table u (y int primary key)",
+			"create trigger trg2 after delete on u for each row set @count = @count + 1",
+			"use mydb",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show triggers",
+				Expected: []sql.Row{
+					{
+						"trg1",                  // Trigger
+						"INSERT",                // Event
+						"t",                     // Table
+						"set new.x = new.x + 1", // Statement
+						"BEFORE",                // Timing
+						time.Unix(0, 0).UTC(),   // Created
+						"ANSI_QUOTES",           // sql_mode
+						"dolt@localhost",        // Definer
+						sql.Collation_Default.CharacterSet().String(), // character_set_client
+						sql.Collation_Default.String(),                // collation_connection
+						sql.Collation_Default.String(),                // Database Collation
+					},
+				},
+			},
+			{
+				Query: "show triggers from otherdb like 'u'",
+				Expected: []sql.Row{
+					{
+						"trg2",                    // Trigger
+						"DELETE",                  // Event
+						"u",                       // Table
+						"set @count = @count + 1", // Statement
+						"AFTER",                   // Timing
+						time.Unix(0, 0).UTC(),     // Created
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
+						sql.Collation_Default.CharacterSet().String(), // character_set_client
+						sql.Collation_Default.String(),                // collation_connection
+						sql.Collation_Default.String(),                // Database Collation
+					},
+				},
+			},
+			{
+				Query:    "show triggers from otherdb like 't'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show triggers from otherdb where Definer = 'dolt@localhost'",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 	// DROP TRIGGER
 	{
 		Name: "drop trigger",
@@ -2499,6 +2559,56 @@ end;`,
 			},
 		},
 	},
+	{
+		Name: "trigger action order with follows / precedes",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"create trigger a1 before insert on a for each row set new.x = new.x + 1",
+			"create trigger a2 before insert on a for each row precedes a1 set new.x = new.x * 2",
+			"create trigger a3 before insert on a for each row follows a2 set new.x = new.x * 3",
+			"create trigger a4 after insert on a for each row set @a = 1",
+			"create trigger b1 before insert on b for each row set new.y = new.y + 1",
+			"create trigger b2 before insert on b for each row follows b1 set new.y = new.y * 2",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select trigger_name, event_object_table, action_timing, action_order from information_schema.triggers order by 2, 3, 4",
+				Expected: []sql.Row{
+					{"a2", "a", "BEFORE", int64(1)},
+					{"a3", "a", "BEFORE", int64(2)},
+					{"a1", "a", "BEFORE", int64(3)},
+					{"a4", "a", "AFTER", int64(1)},
+					{"b1", "b", "BEFORE", int64(1)},
+					{"b2", "b", "BEFORE", int64(2)},
+				},
+			},
+			{
+				Query:    "insert into a values (1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select x from a",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:       "create trigger a5 before insert on a for each row follows missing set new.x = new.x",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+			{
+				Query:       "create trigger a5 before insert on a for each row follows a4 set new.x = new.x",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+			{
+				Query:       "create trigger a5 before insert on a for each row follows b1 set new.x = new.x",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+			{
+				Query:       "create trigger a5 before update on a for each row precedes a1 set new.x = new.x",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+		},
+	},
 	// DROP TABLE referenced in triggers
 	{
 		Name: "drop table referenced in triggers",
@@ -3805,7 +3915,7 @@ end;
 				Expected: []sql.Row{
 					{
 						"trig",
-						"",
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",
 						"create trigger trig before insert on t for each row begin replace into t select 1; end",
 						sql.Collation_Default.CharacterSet().String(),
 						sql.Collation_Default.String(),
@@ -3940,8 +4050,8 @@ end;
 						"insert into C (col0) select col0 from B where B.col0 = new.col0", // Statement
 						"AFTER",               // Timing
 						time.Unix(0, 0).UTC(), // Created
-						"",                    // sql_mode
-						"",                    // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -3965,8 +4075,8 @@ end;
 						"insert into C (col0) select col0 from B where B.col0 = new.col0", // Statement
 						"AFTER",               // Timing
 						time.Unix(0, 0).UTC(), // Created
-						"",                    // sql_mode
-						"",                    // Definer
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", // sql_mode
+						"root@localhost", // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -3999,7 +4109,7 @@ end;
 				Expected: []sql.Row{
 					{
 						"test_trigger",
-						"",
+						"NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES",
 						"create trigger test_trigger after update on A for each row insert into C (col0) select col0 from B where B.col0 = new.col0",
 						sql.Collation_Default.CharacterSet().String(),
 						sql.Collation_Default.String(),
@@ -710,6 +710,52 @@ t1.oid = t2.pid;`,
 			},
 		},
 	},
+	{
+		Name:    "UPDATE and DELETE join – rows joined with several rows",
+		Dialect: "mysql",
+		SetUpScript: []string{
+			"create table t1 (id int primary key, v int)",
+			"insert into t1 values (1, 0), (2, 0), (3, 0)",
+			"create table t2 (id int primary key, t1_id int, w int)",
+			"insert into t2 values (10, 1, 0), (11, 1, 0), (12, 2, 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "update t1, t2 set t1.v = t1.v + 1, t2.w = 5 where t1.id = t2.t1_id",
+				Expected: []sql.Row{{types.OkResult{
+					RowsAffected: 5,
+					Info:         plan.UpdateInfo{Matched: 5, Updated: 5},
+				}}},
+			},
+			{
+				Query:    "select * from t1 order by id",
+				Expected: []sql.Row{{1, 1}, {2, 1}, {3, 0}},
+			},
+			{
+				Query: "update t1 join t2 on t1.id = t2.t1_id set t1.v = 1",
+				Expected: []sql.Row{{types.OkResult{
+					RowsAffected: 0,
+					Info:         plan.UpdateInfo{Matched: 2, Updated: 0},
+				}}},
+			},
+			{
+				Query:    "delete t1 from t1 join t2 on t1.id = t2.t1_id where t2.id < 12",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "delete t1, t2 from t1 join t2 on t1.id = t2.t1_id",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t1",
+				Expected: []sql.Row{{3, 0}},
+			},
+			{
+				Query:    "select * from t2 order by id",
+				Expected: []sql.Row{{10, 1, 5}, {11, 1, 5}},
+			},
+		},
+	},
 }
 
 var SpatialUpdateTests = []WriteQueryTest{
@@ -322,6 +322,22 @@ var VariableQueries = []ScriptTest{
 			{"ALLOW_INVALID_DATES"},
 		},
 	},
+	{
+		Name: "partial optimizer_switch assignments keep the full flag list",
+		SetUpScript: []string{
+			`set optimizer_switch = 'derived_merge=off'`,
+			`set optimizer_switch = 'skip_scan=off,hash_join=default'`,
+		},
+		Query: "SELECT @@optimizer_switch",
+		Expected: []sql.Row{
+			{"index_merge=on,index_merge_union=on,index_merge_sort_union=on,index_merge_intersection=on," +
+				"engine_condition_pushdown=on,index_condition_pushdown=on,mrr=on,mrr_cost_based=on," +
+				"block_nested_loop=on,batched_key_access=off,materialization=on,semijoin=on,loosescan=on," +
+				"firstmatch=on,duplicateweedout=on,subquery_materialization_cost_based=on,use_index_extensions=on," +
+				"condition_fanout_filter=on,derived_merge=off,use_invisible_indexes=off,skip_scan=off,hash_join=on," +
+				"subquery_to_derived=off,prefer_ordering_index=on,hypergraph_optimizer=off,derived_condition_pushdown=on"},
+		},
+	},
 	{
 		Name: "set system variable to no_auto_create_user, which has been deprecated",
 		SetUpScript: []string{
@@ -352,6 +368,53 @@ var VariableQueries = []ScriptTest{
 			{"NO_AUTO_VALUE_ON_ZERO,NO_ENGINE_SUBSTITUTION,STRICT_TRANS_TABLES"},
 		},
 	},
+	{
+		Name: "show variables scope",
+		SetUpScript: []string{
+			"set @@session.sql_select_limit = 10",
+			"set @@global.max_connections = 200",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW SESSION VARIABLES LIKE 'sql_select_limit'",
+				Expected: []sql.Row{{"sql_select_limit", 10}},
+			},
+			{
+				Query:    "SHOW GLOBAL VARIABLES LIKE 'sql_select_limit'",
+				Expected: []sql.Row{{"sql_select_limit", 2147483647}},
+			},
+			{
+				// global-only variables show their global value in the session
+				Query:    "SHOW VARIABLES LIKE 'max_connections'",
+				Expected: []sql.Row{{"max_connections", int64(200)}},
+			},
+			{
+				// session-only variables are not global
+				Query:    "SHOW GLOBAL VARIABLES LIKE 'pseudo_slave_mode'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW SESSION VARIABLES LIKE 'pseudo_slave_mode'",
+				Expected: []sql.Row{{"pseudo_slave_mode", "OFF"}},
+			},
+			{
+				Query:    "SHOW VARIABLES WHERE Variable_name LIKE 'sql_select%' AND Value = 10",
+				Expected: []sql.Row{{"sql_select_limit", 10}},
+			},
+			{
+				Query:    "SHOW VARIABLES WHERE Value = 'utf8mb4_0900_bin' AND Variable_name LIKE 'collation_%'",
+				Expected: []sql.Row{{"collation_connection", "utf8mb4_0900_bin"}, {"collation_database", "utf8mb4_0900_bin"}, {"collation_server", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('lower_case_table_names', 'character_set_server')",
+				Expected: []sql.Row{{"character_set_server", "utf8mb4"}, {"lower_case_table_names", int64(0)}},
+			},
+			{
+				Query:    "set @@global.max_connections = 151",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
 	{
 		Name: "show variables renders enums after set",
 		SetUpScript: []string{
@@ -654,6 +717,14 @@ var VariableErrorTests = []QueryErrorTest{
 		Query:       "set @myvar = bareword",
 		ExpectedErr: sql.ErrColumnNotFound,
 	},
+	{
+		Query:       "set optimizer_switch = 'fake_flag=on'",
+		ExpectedErr: sql.ErrInvalidSystemVariableValue,
+	},
+	{
+		Query:       "set optimizer_switch = 'derived_merge=maybe'",
+		ExpectedErr: sql.ErrInvalidSystemVariableValue,
+	},
 	{
 		Query:       "set @@sql_mode = true",
 		ExpectedErr: sql.ErrInvalidSystemVariableValue,
@@ -212,6 +212,66 @@ var VectorIndexQueries = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "vector indexes using other distance functions",
+		SetUpScript: []string{
+			`create table vectors (id int primary key, v vector(2) not null, vector index cos_idx (v) engine_attribute '{"distance": "cosine"}');`,
+			`insert into vectors values
+                        (1, STRING_TO_VECTOR('[4.0,3.0]')),
+                        (2, STRING_TO_VECTOR('[1.0,0.0]')),
+                        (3, STRING_TO_VECTOR('[-1.0,1.0]')),
+                        (4, STRING_TO_VECTOR('[0.0,-2.0]')),
+                        (5, STRING_TO_VECTOR('[2.0,2.0]'));`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table vectors",
+				Expected: []sql.Row{
+					{"vectors", "CREATE TABLE `vectors` (\n  `id` int NOT NULL,\n  `v` VECTOR(2) NOT NULL,\n  PRIMARY KEY (`id`),\n  VECTOR KEY `cos_idx` (`v`) ENGINE_ATTRIBUTE '{\"distance\": \"cosine\"}'\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				},
+			},
+			{
+				Query:           "select id from vectors order by VEC_DISTANCE_COSINE('[1.0,1.0]', v) limit 3",
+				Expected:        []sql.Row{{5}, {1}, {2}},
+				ExpectedIndexes: []string{"cos_idx"},
+			},
+			{
+				// DISTANCE with a constant metric can use the index as well.
+				Query:           "select id from vectors order by DISTANCE('[1.0,1.0]', v, 'cosine') limit 3",
+				Expected:        []sql.Row{{5}, {1}, {2}},
+				ExpectedIndexes: []string{"cos_idx"},
+			},
+			{
+				// The index can't order rows by another distance function.
+				Query:           "select id from vectors order by VEC_DISTANCE('[1.0,1.0]', v) limit 3",
+				Expected:        []sql.Row{{2}, {5}, {3}},
+				ExpectedIndexes: []string{},
+			},
+			{
+				// The index only returns the nearest rows first.
+				Query:           "select id from vectors order by VEC_DISTANCE_COSINE('[1.0,1.0]', v) desc limit 2",
+				Expected:        []sql.Row{{4}, {3}},
+				ExpectedIndexes: []string{},
+			},
+			{
+				Query:    `create vector index dot_idx on vectors(v) engine_attribute '{"distance": "DOT"}'`,
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:           "select id, VEC_DISTANCE_DOT('[1.0,1.0]', v) from vectors order by VEC_DISTANCE_DOT('[1.0,1.0]', v) limit 2",
+				Expected:        []sql.Row{{1, -7.0}, {5, -4.0}},
+				ExpectedIndexes: []string{"dot_idx"},
+			},
+			{
+				Query:    "select DISTANCE(STRING_TO_VECTOR('[1.0,1.0]'), STRING_TO_VECTOR('[2.0,3.0]'), 'DOT')",
+				Expected: []sql.Row{{-5.0}},
+			},
+			{
+				Query:          `create vector index bad_idx on vectors(v) engine_attribute '{"distance": "manhattan"}'`,
+				ExpectedErrStr: `vector index distance must be "EUCLIDEAN", "L2_SQUARED", "COSINE", or "DOT", got manhattan`,
+			},
+		},
+	},
 	{
 		Name: "vector index errors",
 		SetUpScript: []string{
@@ -547,7 +547,7 @@ CREATE TABLE tab1 (
 					{"i", "int", nil, "NO", "", nil, "", "", ""},
 					{"ii", "int", nil, "NO", "", nil, "", "", ""},
 					{"j", "int", nil, "YES", "", "100", "", "", ""},
-					{"jj", "int", nil, "YES", "", "(power(11, 2))", "DEFAULT_GENERATED", "", ""},
+					{"jj", "int", nil, "YES", "", "power(11, 2)", "DEFAULT_GENERATED", "", ""},
 					{"i + ii + j + jj", "bigint", nil, "YES", "", nil, "", "", ""},
 				},
 			},
@@ -557,7 +557,7 @@ CREATE TABLE tab1 (
 					{"i", "int", "NO", "", nil, ""},
 					{"ii", "int", "NO", "", nil, ""},
 					{"j", "int", "YES", "", "100", ""},
-					{"jj", "int", "YES", "", "(power(11, 2))", "DEFAULT_GENERATED"},
+					{"jj", "int", "YES", "", "power(11, 2)", "DEFAULT_GENERATED"},
 					{"i + ii + j + jj", "bigint", "YES", "", nil, ""},
 				},
 			},
@@ -567,7 +567,7 @@ CREATE TABLE tab1 (
 					{"i", "int", "NO", "", nil, ""},
 					{"ii", "int", "NO", "", nil, ""},
 					{"j", "int", "YES", "", "100", ""},
-					{"jj", "int", "YES", "", "(power(11, 2))", "DEFAULT_GENERATED"},
+					{"jj", "int", "YES", "", "power(11, 2)", "DEFAULT_GENERATED"},
 					{"i + ii + j + jj", "bigint", "YES", "", nil, ""},
 				},
 			},
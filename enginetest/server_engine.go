@@ -406,7 +406,7 @@ func convertValue(ctx *sql.Context, sch sql.Schema, row sql.Row) sql.Row {
 			}
 		case query.Type_TIME:
 			if row[i] != nil {
-				r, _, err := types.TimespanType_{}.Convert(ctx, string(row[i].([]byte)))
+				r, _, err := types.Time.Convert(ctx, string(row[i].([]byte)))
 				if err != nil {
 					//t.Skip(fmt.Sprintf("received error converting returned timespan result"))
 				} else {
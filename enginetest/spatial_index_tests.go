@@ -349,6 +349,92 @@ var SpatialIndexTests = []SpatialIndexPlanTest{
 			},
 		},
 	},
+	{
+		name: "filter geom table with st_contains and mbr functions",
+		setup: []string{
+			"create table geom_tbl(i int primary key, g geometry not null srid 0, spatial index (g))",
+			"insert into geom_tbl values (0, point(0,0)), (1, point(2,2)), (2, st_geomfromtext('polygon((0 0,4 0,4 4,0 4,0 0))')), (3, st_geomfromtext('linestring(5 5,6 6)'))",
+		},
+		tests: []SpatialIndexPlanTestAssertion{
+			{
+				q: "select i from geom_tbl where st_contains(g, point(2,2)) order by i",
+				exp: []sql.Row{
+					{1},
+					{2},
+				},
+			},
+			{
+				q: "select i from geom_tbl where mbrcontains(g, point(2,2)) order by i",
+				exp: []sql.Row{
+					{1},
+					{2},
+				},
+			},
+			{
+				q: "select i from geom_tbl where mbrcontains(st_geomfromtext('polygon((-1 -1,3 -1,3 3,-1 3,-1 -1))'), g) order by i",
+				exp: []sql.Row{
+					{0},
+					{1},
+				},
+			},
+			{
+				q: "select i from geom_tbl where mbrwithin(g, st_geomfromtext('polygon((-1 -1,3 -1,3 3,-1 3,-1 -1))')) order by i",
+				exp: []sql.Row{
+					{0},
+					{1},
+				},
+			},
+			{
+				q: "select i from geom_tbl where mbrintersects(g, st_geomfromtext('linestring(4 4,5 5)')) order by i",
+				exp: []sql.Row{
+					{2},
+					{3},
+				},
+			},
+			{
+				q: "select i from geom_tbl where mbrcovers(g, point(4,4)) order by i",
+				exp: []sql.Row{
+					{2},
+				},
+			},
+			{
+				q:   "select i from geom_tbl where mbrcontains(g, point(4,4)) order by i",
+				exp: []sql.Row{},
+			},
+			{
+				noIdx: true,
+				q:     "select i from geom_tbl where mbrdisjoint(g, point(2,2))",
+				exp: []sql.Row{
+					{0},
+					{3},
+				},
+			},
+		},
+	},
+	{
+		name: "spatial index is only used for columns and geometries of the same srid",
+		setup: []string{
+			"create table no_srid(g geometry not null, spatial index (g))",
+			"insert into no_srid values (point(0,0)), (point(1,1))",
+			"create table geo(g geometry not null srid 4326, spatial index (g))",
+			"insert into geo values (st_srid(point(0,0), 4326)), (st_srid(point(1,1), 4326))",
+		},
+		tests: []SpatialIndexPlanTestAssertion{
+			{
+				noIdx: true,
+				q:     "select st_aswkt(g) from no_srid where st_intersects(g, point(0,0))",
+				exp: []sql.Row{
+					{"POINT(0 0)"},
+				},
+			},
+			{
+				q: "select st_aswkt(g) from geo where st_intersects(g, st_srid(point(1,1), 4326))",
+				exp: []sql.Row{
+					{"POINT(1 1)"},
+				},
+			},
+		},
+	},
 }
 
 func TestSpatialIndexPlans(t *testing.T, harness Harness) {
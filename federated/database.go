@@ -0,0 +1,89 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Database is a database whose tables are the tables of a database on a remote server. The tables and their schemas
+// are read from the remote server each time they're resolved, so that they follow changes made to it.
+type Database struct {
+	server     *Server
+	name       string
+	remoteName string
+}
+
+var _ sql.Database = (*Database)(nil)
+
+// Name implements the sql.Database interface.
+func (d *Database) Name() string {
+	return d.name
+}
+
+// RemoteName returns the name of the database on the remote server.
+func (d *Database) RemoteName() string {
+	return d.remoteName
+}
+
+// GetTableInsensitive implements the sql.Database interface.
+func (d *Database) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	var remoteTable string
+	rows, err := d.server.db.QueryContext(ctx,
+		"SELECT table_name FROM information_schema.tables WHERE table_schema = ? AND LOWER(table_name) = LOWER(?)",
+		d.remoteName, tblName,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, false, rows.Err()
+	}
+	if err = rows.Scan(&remoteTable); err != nil {
+		return nil, false, err
+	}
+	if err = rows.Close(); err != nil {
+		return nil, false, err
+	}
+
+	table, err := d.server.Table(ctx, d.name, remoteTable, d.remoteName, remoteTable)
+	if err != nil {
+		return nil, false, err
+	}
+	return table, true, nil
+}
+
+// GetTableNames implements the sql.Database interface.
+func (d *Database) GetTableNames(ctx *sql.Context) ([]string, error) {
+	rows, err := d.server.db.QueryContext(ctx,
+		"SELECT table_name FROM information_schema.tables WHERE table_schema = ? ORDER BY table_name",
+		d.remoteName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
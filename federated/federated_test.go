@@ -0,0 +1,233 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/federated"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// startRemote starts a server over a memory database named "remote", set up with |setup|, and returns its address.
+func startRemote(t *testing.T, setup ...string) string {
+	pro := memory.NewDBProvider(memory.NewDatabase("remote"))
+	engine := sqle.NewDefault(pro)
+	ctx := newContext(pro, "remote")
+	for _, q := range setup {
+		_, iter, _, err := engine.Query(ctx, q)
+		require.NoError(t, err, q)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err, q)
+	}
+
+	port, err := sql.GetEmptyPort()
+	require.NoError(t, err)
+	address := fmt.Sprintf("localhost:%d", port)
+	sessBuilder := func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+		return memory.NewSession(sql.NewBaseSession(), pro), nil
+	}
+	s, err := server.NewServer(server.Config{Protocol: "tcp", Address: address}, engine, sql.NewContext, sessBuilder, nil)
+	require.NoError(t, err)
+	go s.Start()
+	t.Cleanup(func() { _ = s.Close() })
+	return address
+}
+
+func newContext(pro sql.DatabaseProvider, db string) *sql.Context {
+	sess := memory.NewSession(sql.NewBaseSession(), pro)
+	sess.SetCurrentDatabase(db)
+	return sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithProcessList(sqle.NewProcessList()))
+}
+
+func TestFederatedTables(t *testing.T) {
+	require := require.New(t)
+	address := startRemote(t,
+		"create table people (id int primary key, name varchar(20) not null, score decimal(5,2), born date, tags set('a','b'), data json)",
+		`insert into people values
+			(1, 'ann', 10.50, '1990-01-02', 'a', '{"k": 1}'),
+			(2, 'bob', null, '1985-06-07', 'a,b', null),
+			(3, 'cid', 7.25, null, '', '[1, 2]'),
+			(4, 'dee', 99.99, '2001-12-31', 'b', '"x"')`,
+		"create table pets (id int primary key, owner int, kind varchar(10))",
+		"insert into pets values (1, 1, 'cat'), (2, 1, 'dog'), (3, 3, 'fish')",
+	)
+
+	remote, err := federated.NewServer(fmt.Sprintf("root:@tcp(%s)/", address), federated.Options{MaxOpenConns: 4})
+	require.NoError(err)
+	defer remote.Close()
+
+	local := memory.NewDatabase("mydb")
+	pro := memory.NewDBProvider(local, remote.Database("fed", "remote"))
+	e := sqle.NewDefault(pro)
+	ctx := newContext(pro, "mydb")
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, _, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	// remoteQuery returns the query the federated table in the plan of |q| sends to the remote server
+	remoteQuery := func(q string) string {
+		n, err := e.AnalyzeQuery(ctx, q)
+		require.NoError(err)
+		var ret string
+		transform.Inspect(n, func(n sql.Node) bool {
+			if rt, ok := n.(*plan.ResolvedTable); ok {
+				if ft, ok := rt.UnderlyingTable().(*federated.Table); ok {
+					ret = ft.RemoteQuery()
+				}
+			}
+			return ret == ""
+		})
+		return ret
+	}
+
+	for _, q := range []string{
+		"create table local_scores (id int primary key, bonus int)",
+		"insert into local_scores values (1, 5), (3, 1), (4, 0)",
+	} {
+		_, err := query(q)
+		require.NoError(err, q)
+	}
+
+	rows, err := query("show tables from fed")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"people"}, {"pets"}}, rows)
+
+	rows, err = query("select * from fed.people order by id")
+	require.NoError(err)
+	require.Len(rows, 4)
+	require.Equal("10.50", fmt.Sprint(rows[0][2]))
+	rows[0][2] = nil
+	require.Equal(sql.Row{int32(1), "ann", nil, time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC), uint64(1), types.MustJSON(`{"k": 1}`)}, rows[0])
+	require.Equal(sql.Row{int32(2), "bob", nil, time.Date(1985, 6, 7, 0, 0, 0, 0, time.UTC), uint64(3), nil}, rows[1])
+
+	// filters and projections are sent to the remote server
+	q := "select name from fed.people where id > 1 and name <> 'cid' order by id"
+	rows, err = query(q)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"bob"}, {"dee"}}, rows)
+	require.Equal("SELECT `id`, `name` FROM `remote`.`people` WHERE (`id` > 1) AND (NOT (`name` = 'cid'))", remoteQuery(q))
+
+	q = "select p.id from fed.people p where p.score between 5 and 20 or p.born is null"
+	rows, err = query(q)
+	require.NoError(err)
+	require.ElementsMatch([]sql.Row{{int32(1)}, {int32(3)}}, rows)
+	require.Contains(remoteQuery(q), "WHERE (((`score` >= 5) AND (`score` <= 20)) OR (`born` IS NULL))")
+
+	q = "select id from fed.people where name in ('ann', 'dee') and name like '%e'"
+	rows, err = query(q)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(4)}}, rows)
+	require.Contains(remoteQuery(q), "WHERE (`name` IN ('ann', 'dee')) AND (`name` LIKE '%e')")
+
+	// filters the remote server can't evaluate are applied by the engine
+	q = "select id from fed.people where id >= 2 and length(name) = 3 and upper(name) = 'DEE'"
+	rows, err = query(q)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(4)}}, rows)
+	require.Contains(remoteQuery(q), "WHERE (`id` >= 2)")
+
+	// limits and offsets are sent to the remote server when nothing else changes the rows they apply to
+	q = "select id, name from fed.people where id < 4 limit 2"
+	rows, err = query(q)
+	require.NoError(err)
+	require.Len(rows, 2)
+	require.Contains(remoteQuery(q), "WHERE (`id` < 4) LIMIT 2")
+
+	q = "select id from fed.people limit 1 offset 2"
+	rows, err = query(q)
+	require.NoError(err)
+	require.Len(rows, 1)
+	require.Contains(remoteQuery(q), "LIMIT 3")
+
+	q = "select id from fed.people order by name desc limit 1"
+	rows, err = query(q)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(4)}}, rows)
+	require.NotContains(remoteQuery(q), "LIMIT")
+
+	q = "select id from fed.people where length(name) = 3 limit 1"
+	require.NotContains(remoteQuery(q), "LIMIT")
+
+	// federated tables join with local tables and with each other
+	rows, err = query(`select p.name, s.bonus from fed.people p join local_scores s on p.id = s.id where s.bonus > 0 order by p.id`)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"ann", int32(5)}, {"cid", int32(1)}}, rows)
+
+	rows, err = query(`select p.name, count(x.id) from fed.people p left join fed.pets x on x.owner = p.id group by p.name order by p.name`)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"ann", int64(2)}, {"bob", int64(0)}, {"cid", int64(1)}, {"dee", int64(0)}}, rows)
+
+	rows, err = query("select count(*) from fed.pets where kind <> 'fish'")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(2)}}, rows)
+
+	// tables are resolved against the remote server each time they're used
+	_, err = query("select * from fed.missing")
+	require.Error(err)
+	require.True(sql.ErrTableNotFound.Is(err), err.Error())
+}
+
+func TestFederatedTable(t *testing.T) {
+	require := require.New(t)
+	address := startRemote(t,
+		"create table t (pk int primary key, v varchar(10) collate utf8mb4_bin, b bit(4), bin varbinary(4))",
+		"insert into t values (1, 'A', b'0101', 0x0102), (2, 'a', b'1111', null)",
+	)
+
+	remote, err := federated.NewServer(fmt.Sprintf("root:@tcp(%s)/", address), federated.Options{PrefetchRows: 16})
+	require.NoError(err)
+	defer remote.Close()
+
+	pro := memory.NewDBProvider(memory.NewDatabase("mydb"))
+	ctx := newContext(pro, "mydb")
+
+	_, err = remote.Table(ctx, "mydb", "t", "remote", "nope")
+	require.True(federated.ErrRemoteTableNotFound.Is(err))
+
+	table, err := remote.Table(ctx, "mydb", "remote_t", "remote", "t")
+	require.NoError(err)
+	require.Equal(16, table.PrefetchRows())
+	require.Equal([]int{0}, table.PrimaryKeySchema(ctx).PkOrdinals)
+	require.Equal(sql.Collation_utf8mb4_bin, table.Schema(ctx)[1].Type.(sql.StringType).Collation())
+
+	rows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, mustPartitions(t, ctx, table)))
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{int32(1), "A", uint64(5), []byte{1, 2}},
+		{int32(2), "a", uint64(15), nil},
+	}, rows)
+}
+
+func mustPartitions(t *testing.T, ctx *sql.Context, table sql.Table) sql.PartitionIter {
+	iter, err := table.Partitions(ctx)
+	require.NoError(t, err)
+	return iter
+}
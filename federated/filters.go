@@ -0,0 +1,145 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// filterSQL returns the SQL condition for the remote server equivalent to |filter|, a filter on a table with |schema|,
+// and whether there is one. Only comparisons, IN, BETWEEN, LIKE and IS NULL between the table's columns and literals,
+// combined with AND, OR and NOT, are sent to the remote server.
+func filterSQL(ctx *sql.Context, filter sql.Expression, schema sql.Schema) (string, bool) {
+	switch e := filter.(type) {
+	case *expression.And:
+		return binarySQL(ctx, e.LeftChild, "AND", e.RightChild, schema, filterSQL)
+	case *expression.Or:
+		return binarySQL(ctx, e.LeftChild, "OR", e.RightChild, schema, filterSQL)
+	case *expression.Not:
+		child, ok := filterSQL(ctx, e.Child, schema)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(NOT %s)", child), true
+	case *expression.Equals:
+		return binarySQL(ctx, e.Left(), "=", e.Right(), schema, operandSQL)
+	case *expression.NullSafeEquals:
+		return binarySQL(ctx, e.Left(), "<=>", e.Right(), schema, operandSQL)
+	case *expression.GreaterThan:
+		return binarySQL(ctx, e.Left(), ">", e.Right(), schema, operandSQL)
+	case *expression.GreaterThanOrEqual:
+		return binarySQL(ctx, e.Left(), ">=", e.Right(), schema, operandSQL)
+	case *expression.LessThan:
+		return binarySQL(ctx, e.Left(), "<", e.Right(), schema, operandSQL)
+	case *expression.LessThanOrEqual:
+		return binarySQL(ctx, e.Left(), "<=", e.Right(), schema, operandSQL)
+	case *expression.Like:
+		if e.Escape != nil {
+			return "", false
+		}
+		return binarySQL(ctx, e.Left(), "LIKE", e.Right(), schema, operandSQL)
+	case *expression.IsNull:
+		child, ok := operandSQL(ctx, e.Child, schema)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(%s IS NULL)", child), true
+	case *expression.Between:
+		val, ok := operandSQL(ctx, e.Val, schema)
+		if !ok {
+			return "", false
+		}
+		lower, ok := operandSQL(ctx, e.Lower, schema)
+		if !ok {
+			return "", false
+		}
+		upper, ok := operandSQL(ctx, e.Upper, schema)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(%s BETWEEN %s AND %s)", val, lower, upper), true
+	case *expression.InTuple:
+		left, ok := operandSQL(ctx, e.Left(), schema)
+		if !ok {
+			return "", false
+		}
+		tuple, ok := e.Right().(expression.Tuple)
+		if !ok {
+			return "", false
+		}
+		values := make([]string, len(tuple))
+		for i, v := range tuple {
+			if values[i], ok = operandSQL(ctx, v, schema); !ok {
+				return "", false
+			}
+		}
+		return fmt.Sprintf("(%s IN (%s))", left, strings.Join(values, ", ")), true
+	default:
+		return "", false
+	}
+}
+
+// binarySQL returns the SQL for the binary operator |op| applied to |left| and |right|, each written with |toSQL|.
+func binarySQL(
+	ctx *sql.Context,
+	left sql.Expression,
+	op string,
+	right sql.Expression,
+	schema sql.Schema,
+	toSQL func(*sql.Context, sql.Expression, sql.Schema) (string, bool),
+) (string, bool) {
+	l, ok := toSQL(ctx, left, schema)
+	if !ok {
+		return "", false
+	}
+	r, ok := toSQL(ctx, right, schema)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("(%s %s %s)", l, op, r), true
+}
+
+// operandSQL returns the SQL for |e|, an operand of a filter, if it's a column of the table or a literal of a type
+// that's compared the same way by the remote server.
+func operandSQL(ctx *sql.Context, e sql.Expression, schema sql.Schema) (string, bool) {
+	switch e := e.(type) {
+	case *expression.GetField:
+		idx := schema.IndexOfColName(e.Name())
+		if idx < 0 {
+			return "", false
+		}
+		return quoteIdentifier(schema[idx].Name), true
+	case *expression.Literal:
+		typ := e.Type(ctx)
+		if e.Value() == nil || !(types.IsTextOnly(typ) || types.IsTime(typ) || (types.IsNumber(typ) && !types.IsBit(typ))) {
+			return "", false
+		}
+		v, err := typ.SQL(ctx, nil, e.Value())
+		if err != nil {
+			return "", false
+		}
+		var buf bytes.Buffer
+		v.EncodeSQL(&buf)
+		return buf.String(), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package federated exposes the tables of a remote MySQL server as tables of the engine, in the manner of MySQL's
+// FEDERATED storage engine. Filters, projections and limits on scans of a federated table are sent to the remote
+// server as part of the query that reads its rows, so that only the rows and columns a query needs are transferred.
+// Federated tables are read-only.
+package federated
+
+import (
+	gosql "database/sql"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+)
+
+// ErrRemoteTableNotFound is returned when a table doesn't exist on the remote server.
+var ErrRemoteTableNotFound = errors.NewKind("table %s.%s not found on the remote server")
+
+// ErrUnsupportedRemoteType is returned when a column of a remote table has a type the engine can't represent.
+var ErrUnsupportedRemoteType = errors.NewKind("column %s of remote table %s.%s has unsupported type %s: %s")
+
+// Options configures the connection pool of a Server and the scans of its tables.
+type Options struct {
+	// MaxOpenConns is the maximum number of open connections to the remote server. Zero means no limit.
+	MaxOpenConns int
+	// MaxIdleConns is the maximum number of idle connections kept in the pool. Zero keeps the database/sql default.
+	MaxIdleConns int
+	// ConnMaxLifetime is the maximum time a connection may be reused for. Zero means no limit.
+	ConnMaxLifetime time.Duration
+	// PrefetchRows is the number of rows read ahead of the query scanning a federated table, to overlap the latency
+	// of the remote server with the processing of the rows. Zero disables reading ahead.
+	PrefetchRows int
+}
+
+// Server is a pool of connections to a remote MySQL server, whose tables it exposes as federated tables.
+type Server struct {
+	db           *gosql.DB
+	prefetchRows int
+}
+
+// NewServer returns a Server for the remote server given by |dsn|, in the format of the go-sql-driver/mysql driver.
+// Connections are opened as they're needed.
+func NewServer(dsn string, opts Options) (*Server, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	// values are converted from their text representation by the types of the columns they belong to
+	cfg.ParseTime = false
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewServerWithDB(gosql.OpenDB(connector), opts), nil
+}
+
+// NewServerWithDB returns a Server that connects to the remote server through |db|, whose pool settings are replaced
+// by those of |opts|. The connections of |db| must not parse DATE and DATETIME values into time.Time values.
+func NewServerWithDB(db *gosql.DB, opts Options) *Server {
+	db.SetMaxOpenConns(opts.MaxOpenConns)
+	if opts.MaxIdleConns != 0 {
+		db.SetMaxIdleConns(opts.MaxIdleConns)
+	}
+	db.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	return &Server{db: db, prefetchRows: opts.PrefetchRows}
+}
+
+// Close closes the connections to the remote server. Tables of the server can't be read once it's closed.
+func (s *Server) Close() error {
+	return s.db.Close()
+}
+
+// Database returns a database named |name| whose tables are those of the database |remoteName| on the remote server.
+func (s *Server) Database(name, remoteName string) *Database {
+	return &Database{server: s, name: name, remoteName: remoteName}
+}
+
+// Table returns a table named |name|, in the local database |dbName|, whose rows are those of the table |remoteTable|
+// of the database |remoteDb| on the remote server. Its schema is read from the remote server when it's created.
+func (s *Server) Table(ctx *sql.Context, dbName, name, remoteDb, remoteTable string) (*Table, error) {
+	var collation string
+	err := s.db.QueryRowContext(ctx,
+		"SELECT table_name, IFNULL(table_collation, '') FROM information_schema.tables WHERE table_schema = ? AND table_name = ?",
+		remoteDb, remoteTable,
+	).Scan(&remoteTable, &collation)
+	if err == gosql.ErrNoRows {
+		return nil, ErrRemoteTableNotFound.New(remoteDb, remoteTable)
+	} else if err != nil {
+		return nil, err
+	}
+
+	schema, err := s.remoteSchema(ctx, dbName, name, remoteDb, remoteTable)
+	if err != nil {
+		return nil, err
+	}
+	tableCollation := sql.Collation_Default
+	if collation != "" {
+		if tableCollation, err = sql.ParseCollation("", collation, false); err != nil {
+			return nil, err
+		}
+	}
+	return &Table{
+		server:      s,
+		name:        name,
+		remoteDb:    remoteDb,
+		remoteTable: remoteTable,
+		schema:      schema,
+		collation:   tableCollation,
+	}, nil
+}
+
+// remoteSchema returns the schema of |remoteTable| on the remote server, for a table named |name| in |dbName|.
+func (s *Server) remoteSchema(ctx *sql.Context, dbName, name, remoteDb, remoteTable string) (sql.PrimaryKeySchema, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT column_name, column_type, IFNULL(collation_name, ''), is_nullable, column_key, extra, column_comment
+		FROM information_schema.columns WHERE table_schema = ? AND table_name = ? ORDER BY ordinal_position`,
+		remoteDb, remoteTable,
+	)
+	if err != nil {
+		return sql.PrimaryKeySchema{}, err
+	}
+	defer rows.Close()
+
+	var schema sql.Schema
+	for rows.Next() {
+		var colName, colType, collation, nullable, key, extra, comment string
+		if err = rows.Scan(&colName, &colType, &collation, &nullable, &key, &extra, &comment); err != nil {
+			return sql.PrimaryKeySchema{}, err
+		}
+		typeString := colType
+		if collation != "" {
+			typeString += " COLLATE " + collation
+		}
+		typ, err := planbuilder.ParseColumnTypeString(ctx, typeString)
+		if err != nil {
+			return sql.PrimaryKeySchema{}, ErrUnsupportedRemoteType.New(colName, remoteDb, remoteTable, colType, err)
+		}
+		schema = append(schema, &sql.Column{
+			Name:           colName,
+			Type:           typ,
+			Nullable:       strings.EqualFold(nullable, "YES"),
+			PrimaryKey:     strings.EqualFold(key, "PRI"),
+			AutoIncrement:  strings.Contains(strings.ToLower(extra), "auto_increment"),
+			Comment:        comment,
+			Source:         name,
+			DatabaseSource: dbName,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return sql.PrimaryKeySchema{}, err
+	}
+	if len(schema) == 0 {
+		return sql.PrimaryKeySchema{}, ErrRemoteTableNotFound.New(remoteDb, remoteTable)
+	}
+	return sql.NewPrimaryKeySchema(schema), nil
+}
+
+// quoteIdentifier returns |name| quoted as a MySQL identifier.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	gosql "database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Table is a table whose rows are read from a table on a remote server. The filters, projections and limits the
+// analyzer pushes into it are applied by the remote server.
+type Table struct {
+	server      *Server
+	name        string
+	remoteDb    string
+	remoteTable string
+	schema      sql.PrimaryKeySchema
+	collation   sql.CollationID
+
+	projection []string
+	projected  sql.Schema
+	filters    []sql.Expression
+	// filterSQL holds the conditions of |filters|, in the SQL of the remote server
+	filterSQL []string
+	limit     uint64
+	hasLimit  bool
+}
+
+var _ sql.Table = (*Table)(nil)
+var _ sql.FilterPushdownTable = (*Table)(nil)
+var _ sql.ProjectedTable = (*Table)(nil)
+var _ sql.LimitedTable = (*Table)(nil)
+var _ sql.PrefetchTable = (*Table)(nil)
+var _ sql.PrimaryKeyTable = (*Table)(nil)
+
+// Name implements the sql.Table interface.
+func (t *Table) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *Table) String() string {
+	return t.name
+}
+
+// RemoteName returns the name of the database and table on the remote server this table reads from.
+func (t *Table) RemoteName() (database, table string) {
+	return t.remoteDb, t.remoteTable
+}
+
+// Schema implements the sql.Table interface.
+func (t *Table) Schema(ctx *sql.Context) sql.Schema {
+	if t.projected != nil {
+		return t.projected
+	}
+	return t.schema.Schema
+}
+
+// PrimaryKeySchema implements the sql.PrimaryKeyTable interface.
+func (t *Table) PrimaryKeySchema(ctx *sql.Context) sql.PrimaryKeySchema {
+	return t.schema
+}
+
+// Collation implements the sql.Table interface.
+func (t *Table) Collation() sql.CollationID {
+	return t.collation
+}
+
+// PrefetchRows implements the sql.PrefetchTable interface.
+func (t *Table) PrefetchRows() int {
+	return t.server.prefetchRows
+}
+
+// Filters implements the sql.FilteredTable interface.
+func (t *Table) Filters() []sql.Expression {
+	return t.filters
+}
+
+// PushdownFilters implements the sql.FilterPushdownTable interface.
+func (t *Table) PushdownFilters() bool {
+	return true
+}
+
+// HandledFilters implements the sql.FilteredTable interface. The filters handled are those that can be written in SQL
+// for the remote server to evaluate.
+func (t *Table) HandledFilters(ctx *sql.Context, filters []sql.Expression) []sql.Expression {
+	var handled []sql.Expression
+	for _, f := range filters {
+		if _, ok := filterSQL(ctx, f, t.schema.Schema); ok {
+			handled = append(handled, f)
+		}
+	}
+	return handled
+}
+
+// WithFilters implements the sql.FilteredTable interface. Filters the table can't handle are ignored.
+func (t *Table) WithFilters(ctx *sql.Context, filters []sql.Expression) sql.Table {
+	nt := *t
+	nt.filters = nil
+	nt.filterSQL = nil
+	for _, f := range filters {
+		if cond, ok := filterSQL(ctx, f, t.schema.Schema); ok {
+			nt.filters = append(nt.filters, f)
+			nt.filterSQL = append(nt.filterSQL, cond)
+		}
+	}
+	return &nt
+}
+
+// Projections implements the sql.ProjectedTable interface.
+func (t *Table) Projections() []string {
+	return t.projection
+}
+
+// WithProjections implements the sql.ProjectedTable interface.
+func (t *Table) WithProjections(ctx *sql.Context, colNames []string) (sql.Table, error) {
+	projected := make(sql.Schema, len(colNames))
+	for i, name := range colNames {
+		idx := t.schema.IndexOfColName(name)
+		if idx < 0 {
+			return nil, sql.ErrTableColumnNotFound.New(t.name, name)
+		}
+		projected[i] = t.schema.Schema[idx]
+	}
+	nt := *t
+	nt.projection = colNames
+	nt.projected = projected
+	return &nt, nil
+}
+
+// Limit implements the sql.LimitedTable interface.
+func (t *Table) Limit() (uint64, bool) {
+	return t.limit, t.hasLimit
+}
+
+// WithLimit implements the sql.LimitedTable interface.
+func (t *Table) WithLimit(ctx *sql.Context, limit uint64) sql.Table {
+	nt := *t
+	nt.limit = limit
+	nt.hasLimit = true
+	return &nt
+}
+
+// Partitions implements the sql.Table interface. A federated table has a single partition, read by a single query.
+func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(partition{}), nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *Table) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	rows, err := t.server.db.QueryContext(ctx, t.RemoteQuery())
+	if err != nil {
+		return nil, err
+	}
+	return &rowIter{rows: rows, schema: t.Schema(ctx)}, nil
+}
+
+// RemoteQuery returns the query the table sends to the remote server to read its rows.
+func (t *Table) RemoteQuery() string {
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	schema := t.schema.Schema
+	if t.projected != nil {
+		schema = t.projected
+	}
+	if len(schema) == 0 {
+		// the rows are only counted
+		sb.WriteString("1")
+	}
+	for i, col := range schema {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(quoteIdentifier(col.Name))
+	}
+	fmt.Fprintf(&sb, " FROM %s.%s", quoteIdentifier(t.remoteDb), quoteIdentifier(t.remoteTable))
+	if len(t.filterSQL) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(t.filterSQL, " AND "))
+	}
+	if t.hasLimit {
+		fmt.Fprintf(&sb, " LIMIT %d", t.limit)
+	}
+	return sb.String()
+}
+
+type partition struct{}
+
+func (partition) Key() []byte {
+	return []byte("federated")
+}
+
+// rowIter converts the rows of a query of the remote server to rows of the schema of the table that ran it.
+type rowIter struct {
+	rows   *gosql.Rows
+	schema sql.Schema
+	values []interface{}
+}
+
+func (i *rowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !i.rows.Next() {
+		if err := i.rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	if len(i.schema) == 0 {
+		return sql.Row{}, nil
+	}
+
+	if i.values == nil {
+		i.values = make([]interface{}, len(i.schema))
+	}
+	dest := make([]interface{}, len(i.schema))
+	for j := range dest {
+		dest[j] = &i.values[j]
+	}
+	if err := i.rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	row := make(sql.Row, len(i.schema))
+	for j, col := range i.schema {
+		v, err := convertValue(ctx, col.Type, i.values[j])
+		if err != nil {
+			return nil, err
+		}
+		row[j] = v
+	}
+	return row, nil
+}
+
+func (i *rowIter) Close(*sql.Context) error {
+	return i.rows.Close()
+}
+
+// convertValue converts |v|, a value read from the remote server, to a value of |typ|. Values are read in their text
+// representation, other than those of binary and BIT columns.
+func convertValue(ctx *sql.Context, typ sql.Type, v interface{}) (interface{}, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		if v == nil {
+			return nil, nil
+		}
+		ret, _, err := typ.Convert(ctx, v)
+		return ret, err
+	}
+	if types.IsJSON(typ) || !(types.IsBinaryType(typ) || types.IsBit(typ)) {
+		v = string(b)
+	}
+	ret, _, err := typ.Convert(ctx, v)
+	return ret, err
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"unicode/utf16"
 
 	"gopkg.in/src-d/go-errors.v1"
 )
@@ -51,6 +52,7 @@ type privateRegex struct {
 
 	done  bool
 	start int
+	offs  int
 	locs  [][]int
 }
 
@@ -107,13 +109,35 @@ func (pr *privateRegex) do(start int) error {
 		if !pr.sset {
 			return ErrMatchNotYetSet.New()
 		}
-		pr.locs = pr.re.FindAllStringIndex(pr.str[start-1:], -1)
+		pr.offs = unitsToBytes(pr.str, start-1)
+		pr.locs = pr.re.FindAllStringIndex(pr.str[pr.offs:], -1)
 		pr.start = start
 		pr.done = true
 	}
 	return nil
 }
 
+// unitsToBytes returns the byte offset in |str| of the offset |units| counted in UTF-16 code units, which is how ICU
+// counts positions.
+func unitsToBytes(str string, units int) int {
+	for i, c := range str {
+		if units <= 0 {
+			return i
+		}
+		units -= utf16.RuneLen(c)
+	}
+	return len(str)
+}
+
+// bytesToUnits returns the offset in UTF-16 code units of the byte offset |bytes| in |str|.
+func bytesToUnits(str string, bytes int) int {
+	units := 0
+	for _, c := range str[:bytes] {
+		units += utf16.RuneLen(c)
+	}
+	return units
+}
+
 func (pr *privateRegex) location(occurrence int) []int {
 	occurrence--
 	if occurrence < 0 {
@@ -138,7 +162,7 @@ func (pr *privateRegex) IndexOf(ctx context.Context, start int, occurrence int,
 	if endIndex {
 		pos = loc[1]
 	}
-	return pos + pr.start, nil
+	return bytesToUnits(pr.str, pos+pr.offs) + 1, nil
 }
 
 func (pr *privateRegex) Matches(ctx context.Context, start int, occurrence int) (bool, error) {
@@ -165,7 +189,7 @@ func (pr *privateRegex) Replace(ctx context.Context, replacement string, start i
 			locs = [][]int{loc}
 		}
 	}
-	offs := pr.start - 1
+	offs := pr.offs
 	pos := offs
 	ret := []byte(pr.str[:pos])
 	for _, loc := range locs {
@@ -174,12 +198,6 @@ func (pr *privateRegex) Replace(ctx context.Context, replacement string, start i
 	}
 	ret = fmt.Append(ret, pr.str[pos:])
 	return string(ret), nil
-
-	loc := pr.location(occurrence)
-	if loc == nil {
-		return pr.str, nil
-	}
-	return pr.str[:loc[0]+pr.start-1] + replacement + pr.str[loc[1]+pr.start-1:], nil
 }
 
 func (pr *privateRegex) Substring(ctx context.Context, start int, occurrence int) (string, bool, error) {
@@ -191,7 +209,7 @@ func (pr *privateRegex) Substring(ctx context.Context, start int, occurrence int
 	if loc == nil {
 		return "", false, nil
 	}
-	return pr.str[loc[0]+pr.start-1 : loc[1]+pr.start-1], true, nil
+	return pr.str[loc[0]+pr.offs : loc[1]+pr.offs], true, nil
 }
 
 func (pr *privateRegex) Close() (err error) {
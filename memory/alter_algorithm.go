@@ -0,0 +1,65 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AlterAlgorithm implements sql.AlterAlgorithmTable. The algorithms and locks reported follow those of InnoDB, so that
+// tests of tools relying on ALGORITHM and LOCK clauses behave as they do against MySQL.
+func (t *Table) AlterAlgorithm(ctx *sql.Context, op sql.AlterOperation) (sql.AlterAlgorithm, sql.AlterLock) {
+	switch op.Kind {
+	case sql.AlterOperationAddColumn:
+		if op.NewColumn != nil && op.NewColumn.AutoIncrement {
+			return sql.AlterAlgorithmInplace, sql.AlterLockShared
+		}
+		return sql.AlterAlgorithmInstant, sql.AlterLockNone
+	case sql.AlterOperationDropColumn, sql.AlterOperationRenameColumn, sql.AlterOperationSetColumnDefault,
+		sql.AlterOperationDropCheck, sql.AlterOperationCollation, sql.AlterOperationComment, sql.AlterOperationRenameTable:
+		return sql.AlterAlgorithmInstant, sql.AlterLockNone
+	case sql.AlterOperationModifyColumn:
+		if op.OldColumn == nil || op.NewColumn == nil || !op.OldColumn.Type.Equals(op.NewColumn.Type) ||
+			op.OldColumn.AutoIncrement != op.NewColumn.AutoIncrement {
+			return sql.AlterAlgorithmCopy, sql.AlterLockShared
+		}
+		if op.Order != nil || op.OldColumn.Nullable != op.NewColumn.Nullable {
+			// Moving a column or changing its nullability rebuilds the table
+			return sql.AlterAlgorithmInplace, sql.AlterLockNone
+		}
+		return sql.AlterAlgorithmInstant, sql.AlterLockNone
+	case sql.AlterOperationAddIndex:
+		if op.IndexConstraint == sql.IndexConstraint_Fulltext || op.IndexConstraint == sql.IndexConstraint_Spatial {
+			return sql.AlterAlgorithmInplace, sql.AlterLockShared
+		}
+		return sql.AlterAlgorithmInplace, sql.AlterLockNone
+	case sql.AlterOperationDropIndex, sql.AlterOperationRenameIndex, sql.AlterOperationAddPrimaryKey,
+		sql.AlterOperationDropForeignKey, sql.AlterOperationAutoIncrement:
+		return sql.AlterAlgorithmInplace, sql.AlterLockNone
+	case sql.AlterOperationAddForeignKey:
+		// Foreign keys can only be added in place when the existing rows aren't checked
+		if op.Validates {
+			return sql.AlterAlgorithmCopy, sql.AlterLockShared
+		}
+		return sql.AlterAlgorithmInplace, sql.AlterLockNone
+	case sql.AlterOperationAddCheck:
+		if op.Validates {
+			return sql.AlterAlgorithmCopy, sql.AlterLockShared
+		}
+		return sql.AlterAlgorithmInstant, sql.AlterLockNone
+	default:
+		return sql.AlterAlgorithmCopy, sql.AlterLockShared
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Partitions of a table with compression enabled are kept compressed, and only decompressed when they're read. Every
+// statement that changes the table decompresses its partitions before applying its changes and compresses them again
+// afterward, so compression suits large tables that are loaded once and then mostly read, like test fixtures of tens
+// of millions of rows.
+
+// PartitionCodec compresses the serialized rows of a partition of a Table.
+type PartitionCodec interface {
+	// Name returns the name of the compression algorithm, as given in the COMPRESSION table option.
+	Name() string
+	// Compress returns the compressed form of |src|.
+	Compress(src []byte) []byte
+	// Decompress returns the |size| bytes |src| was compressed from.
+	Decompress(src []byte, size int) ([]byte, error)
+}
+
+// ErrPartitionChecksum is returned when a compressed partition doesn't decompress to the rows it was compressed from.
+var ErrPartitionChecksum = errors.NewKind("checksum mismatch in partition %s of table %s")
+
+// ErrUnknownCompression is returned for a COMPRESSION table option naming an unsupported algorithm.
+var ErrUnknownCompression = errors.NewKind("unknown compression algorithm '%s'")
+
+// codecs are the PartitionCodecs available, keyed by lowercase name.
+var codecs = map[string]PartitionCodec{
+	"lz4":    LZ4,
+	"snappy": Snappy,
+}
+
+// codecByName returns the PartitionCodec with the name given, or nil for "none" and the empty string.
+func codecByName(name string) (PartitionCodec, error) {
+	name = strings.ToLower(name)
+	if name == "" || name == "none" {
+		return nil, nil
+	}
+	codec, ok := codecs[name]
+	if !ok {
+		return nil, ErrUnknownCompression.New(name)
+	}
+	return codec, nil
+}
+
+// compressedPartition is a partition of rows serialized with encodeValues and compressed with a PartitionCodec.
+// Compressed partitions are immutable, and shared by every version of the table data containing them.
+type compressedPartition struct {
+	codec PartitionCodec
+	// types are the storage types of the rows, as of when they were compressed
+	types []sql.Type
+	data  []byte
+	// size is the length of the serialized rows before compression
+	size int
+	rows int
+	// checksum is the hash of the serialized rows
+	checksum uint64
+}
+
+// compressPartition serializes and compresses |rows|, which have the storage types |typs|.
+func compressPartition(ctx *sql.Context, codec PartitionCodec, typs []sql.Type, rows []sql.Row) (*compressedPartition, error) {
+	var buf []byte
+	for _, row := range rows {
+		encoded, err := encodeValues(ctx, typs, row)
+		if err != nil {
+			return nil, err
+		}
+		for _, val := range encoded {
+			// NULL values are written as a zero length, and other values as their length plus one
+			if val == nil {
+				buf = binary.AppendUvarint(buf, 0)
+				continue
+			}
+			buf = binary.AppendUvarint(buf, uint64(len(*val))+1)
+			buf = append(buf, *val...)
+		}
+	}
+	return &compressedPartition{
+		codec:    codec,
+		types:    typs,
+		data:     codec.Compress(buf),
+		size:     len(buf),
+		rows:     len(rows),
+		checksum: xxhash.Sum64(buf),
+	}, nil
+}
+
+// decompress returns the rows of the partition with the key given, in table |tableName|.
+func (p *compressedPartition) decompress(ctx *sql.Context, tableName, key string) ([]sql.Row, error) {
+	buf, err := p.codec.Decompress(p.data, p.size)
+	if err != nil || xxhash.Sum64(buf) != p.checksum {
+		return nil, ErrPartitionChecksum.New(key, tableName)
+	}
+
+	rows := make([]sql.Row, p.rows)
+	encoded := make([]*[]byte, len(p.types))
+	for i := range rows {
+		for j := range encoded {
+			n, read := binary.Uvarint(buf)
+			if read <= 0 || n > uint64(len(buf)-read)+1 {
+				return nil, ErrPartitionChecksum.New(key, tableName)
+			}
+			buf = buf[read:]
+			encoded[j] = nil
+			if n > 0 {
+				val := buf[:n-1]
+				encoded[j] = &val
+				buf = buf[n-1:]
+			}
+		}
+		rows[i], err = decodeValues(ctx, p.types, encoded)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// storageTypes returns the types of the values stored in partition rows, which don't include virtual columns.
+func (td *TableData) storageTypes() []sql.Type {
+	var typs []sql.Type
+	for _, col := range td.schema.Schema {
+		if !col.Virtual {
+			typs = append(typs, col.Type)
+		}
+	}
+	return typs
+}
+
+// partitionRows returns the rows of the partition with the key given, decompressing it if it's compressed, and
+// whether the partition exists. The rows of compressed partitions are decompressed anew on every call.
+func (td *TableData) partitionRows(ctx *sql.Context, key string) ([]sql.Row, bool, error) {
+	if rows, ok := td.partitions[key]; ok {
+		return rows, true, nil
+	}
+	p, ok := td.compressed[key]
+	if !ok {
+		return nil, false, nil
+	}
+	rows, err := p.decompress(ctx, td.tableName, key)
+	if err != nil {
+		return nil, false, err
+	}
+	return rows, true, nil
+}
+
+// partitionLen returns the number of rows in the partition with the key given.
+func (td *TableData) partitionLen(key string) int {
+	if p, ok := td.compressed[key]; ok {
+		return p.rows
+	}
+	return len(td.partitions[key])
+}
+
+// allPartitions returns the rows of every partition, keyed by partition key, decompressing the partitions that are
+// compressed without changing the table data.
+func (td *TableData) allPartitions(ctx *sql.Context) (map[string][]sql.Row, error) {
+	if len(td.compressed) == 0 {
+		return td.partitions, nil
+	}
+	parts := make(map[string][]sql.Row, len(td.partitions)+len(td.compressed))
+	for k, rows := range td.partitions {
+		parts[k] = rows
+	}
+	for k := range td.compressed {
+		rows, _, err := td.partitionRows(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+		parts[k] = rows
+	}
+	return parts, nil
+}
+
+// decompressPartitions decompresses every compressed partition in place, so that its rows can be changed.
+func (td *TableData) decompressPartitions(ctx *sql.Context) error {
+	for k, p := range td.compressed {
+		rows, err := p.decompress(ctx, td.tableName, k)
+		if err != nil {
+			return err
+		}
+		td.partitions[k] = rows
+		delete(td.compressed, k)
+	}
+	return nil
+}
+
+// compressPartitions compresses every non-empty partition that isn't already compressed, if the table has
+// compression enabled.
+func (td *TableData) compressPartitions(ctx *sql.Context) error {
+	if td.codec == nil {
+		return nil
+	}
+	if td.compressed == nil {
+		td.compressed = make(map[string]*compressedPartition)
+	}
+	typs := td.storageTypes()
+	for k, rows := range td.partitions {
+		if len(rows) == 0 {
+			continue
+		}
+		p, err := compressPartition(ctx, td.codec, typs, rows)
+		if err != nil {
+			return err
+		}
+		td.compressed[k] = p
+		delete(td.partitions, k)
+	}
+	return nil
+}
+
+// setCompression sets the codec the partitions of the table are compressed with, or disables compression for a nil
+// codec, and recompresses the partitions accordingly.
+func (td *TableData) setCompression(ctx *sql.Context, codec PartitionCodec) error {
+	if err := td.decompressPartitions(ctx); err != nil {
+		return err
+	}
+	td.codec = codec
+	return td.compressPartitions(ctx)
+}
+
+// compressionStats returns statistics about the compressed partitions of the table.
+func (td *TableData) compressionStats() sql.CompressionStats {
+	stats := sql.CompressionStats{
+		Partitions:           len(td.partitionKeys),
+		CompressedPartitions: len(td.compressed),
+	}
+	for _, p := range td.compressed {
+		stats.UncompressedLength += uint64(p.size)
+		stats.CompressedLength += uint64(len(p.data))
+		stats.Checksum ^= p.checksum
+	}
+	return stats
+}
+
+// Compression implements sql.CompressedTable
+func (t *Table) Compression() string {
+	if t.data.codec == nil {
+		return ""
+	}
+	return t.data.codec.Name()
+}
+
+// CompressionStats implements sql.CompressedTable
+func (t *Table) CompressionStats(ctx *sql.Context) (sql.CompressionStats, error) {
+	return t.sessionTableData(ctx).compressionStats(), nil
+}
+
+// ModifyCompression implements sql.CompressionAlterableTable
+func (t *Table) ModifyCompression(ctx *sql.Context, algorithm string) error {
+	codec, err := codecByName(algorithm)
+	if err != nil {
+		return err
+	}
+	data := t.sessionTableData(ctx)
+	if err = data.setCompression(ctx, codec); err != nil {
+		return fmt.Errorf("compressing table %s: %w", t.name, err)
+	}
+	return nil
+}
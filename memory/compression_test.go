@@ -0,0 +1,123 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPartitionCodecs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := make([]byte, 100000)
+	rnd.Read(random)
+	inputs := [][]byte{
+		nil,
+		[]byte("a"),
+		[]byte("abcdefghijkl"),
+		bytes.Repeat([]byte("a"), 100000),
+		[]byte(strings.Repeat("row,1,hello world;", 5000)),
+		random,
+	}
+
+	for _, codec := range []memory.PartitionCodec{memory.LZ4, memory.Snappy} {
+		t.Run(codec.Name(), func(t *testing.T) {
+			for _, input := range inputs {
+				compressed := codec.Compress(input)
+				decompressed, err := codec.Decompress(compressed, len(input))
+				require.NoError(t, err)
+				require.Equal(t, len(input), len(decompressed))
+				require.True(t, bytes.Equal(input, decompressed))
+			}
+
+			compressed := codec.Compress(bytes.Repeat([]byte("a"), 1000))
+			require.Less(t, len(compressed), 100)
+			_, err := codec.Decompress(compressed, 999)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCompressedTable(t *testing.T) {
+	for _, compression := range []string{"lz4", "snappy"} {
+		t.Run(compression, func(t *testing.T) {
+			db := memory.NewDatabase("mydb")
+			pro := memory.NewDBProvider(db)
+			ctx := newContext(pro)
+			ctx.SetCurrentDatabase("mydb")
+			e := sqle.NewDefault(pro)
+
+			runQuery(t, e, ctx, fmt.Sprintf("create table t (id int primary key, name varchar(20), score decimal(10,2), key (name)) compression='%s'", compression))
+			var values []string
+			for i := 0; i < 200; i++ {
+				values = append(values, fmt.Sprintf("(%d, 'name %d', %d.25)", i, i%10, i))
+			}
+			runQuery(t, e, ctx, "insert into t values "+strings.Join(values, ", "))
+
+			status := runQuery(t, e, ctx, "show table status like 't'")
+			require.Len(t, status, 1)
+			require.Equal(t, "Compressed", status[0][3])
+			require.Equal(t, uint64(200), status[0][4])
+			require.NotNil(t, status[0][15])
+			require.Contains(t, status[0][16], fmt.Sprintf("COMPRESSION=\"%s\"", compression))
+
+			require.Equal(t, []sql.Row{{int64(200), "19950.00"}}, runQuery(t, e, ctx, "select count(*), cast(sum(score) as char) from t"))
+			require.Equal(t, []sql.Row{{int32(42), "name 2"}}, runQuery(t, e, ctx, "select id, name from t where id = 42"))
+			require.Equal(t, []sql.Row{{int64(20)}}, runQuery(t, e, ctx, "select count(*) from t where name = 'name 3'"))
+
+			runQuery(t, e, ctx, "update t set name = 'updated' where id < 10")
+			runQuery(t, e, ctx, "delete from t where id >= 100")
+			require.Equal(t, []sql.Row{{int64(10)}}, runQuery(t, e, ctx, "select count(*) from t where name = 'updated'"))
+			require.Equal(t, []sql.Row{{int64(100)}}, runQuery(t, e, ctx, "select count(*) from t"))
+
+			runQuery(t, e, ctx, "alter table t add column extra int default 7")
+			require.Equal(t, []sql.Row{{int32(7)}}, runQuery(t, e, ctx, "select extra from t where id = 5"))
+
+			createTable := runQuery(t, e, ctx, "show create table t")
+			require.Contains(t, createTable[0][1], fmt.Sprintf("COMPRESSION='%s'", compression))
+
+			table, ok, err := db.GetTableInsensitive(ctx, "t")
+			require.NoError(t, err)
+			require.True(t, ok)
+			stats, err := table.(sql.CompressedTable).CompressionStats(ctx)
+			require.NoError(t, err)
+			require.Equal(t, stats.Partitions, stats.CompressedPartitions)
+			require.Less(t, stats.CompressedLength, stats.UncompressedLength)
+		})
+	}
+
+	t.Run("unknown algorithm", func(t *testing.T) {
+		db := memory.NewDatabase("mydb")
+		pro := memory.NewDBProvider(db)
+		ctx := newContext(pro)
+		ctx.SetCurrentDatabase("mydb")
+		e := sqle.NewDefault(pro)
+
+		_, iter, _, err := e.Query(ctx, "create table t (i int) compression='zstd'")
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+		}
+		require.True(t, memory.ErrUnknownCompression.Is(err))
+	})
+}
@@ -47,7 +47,9 @@ var _ sql.TableDropper = (*Database)(nil)
 var _ sql.TableRenamer = (*Database)(nil)
 var _ sql.TriggerDatabase = (*Database)(nil)
 var _ sql.StoredProcedureDatabase = (*Database)(nil)
+var _ sql.StoredFunctionDatabase = (*Database)(nil)
 var _ sql.EventDatabase = (*Database)(nil)
+var _ sql.SequenceDatabase = (*Database)(nil)
 var _ sql.ViewDatabase = (*Database)(nil)
 var _ sql.CollatedDatabase = (*Database)(nil)
 var _ fulltext.Database = (*Database)(nil)
@@ -62,28 +64,37 @@ type BaseDatabase struct {
 	name             string
 	triggers         []sql.TriggerDefinition
 	storedProcedures []sql.StoredProcedureDetails
+	storedFunctions  []sql.StoredFunctionDetails
 	events           []sql.EventDefinition
+	sequences        []sql.SequenceDefinition
+	sequencesMu      *sync.Mutex
 	collation        sql.CollationID
+	persister        *persister
 }
 
 var _ MemoryDatabase = (*Database)(nil)
 var _ MemoryDatabase = (*BaseDatabase)(nil)
 
 // NewDatabase creates a new database with the given name.
-func NewDatabase(name string) *Database {
-	return &Database{
+func NewDatabase(name string, opts ...DatabaseOption) *Database {
+	db := &Database{
 		BaseDatabase: NewViewlessDatabase(name),
 		views:        make(map[string]sql.ViewDefinition),
 	}
+	for _, opt := range opts {
+		opt(db)
+	}
+	return db
 }
 
 // NewViewlessDatabase creates a new database that doesn't persist views. Used only for testing. Use NewDatabase.
 func NewViewlessDatabase(name string) *BaseDatabase {
 	return &BaseDatabase{
-		name:     name,
-		tables:   map[string]MemTable{},
-		fkColl:   newForeignKeyCollection(),
-		tablesMu: &sync.RWMutex{},
+		name:        name,
+		tables:      map[string]MemTable{},
+		fkColl:      newForeignKeyCollection(),
+		tablesMu:    &sync.RWMutex{},
+		sequencesMu: &sync.Mutex{},
 	}
 }
 
@@ -126,7 +137,7 @@ func (d *BaseDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sq
 	underlying := memTable.UnderlyingTable()
 	// look in the session for table data. If it's not there, then cache it in the session and return it
 	sess := SessionFromContext(ctx)
-	underlying = underlying.copy()
+	underlying = sess.snapshotTable(d, underlying).copy()
 	underlying.data = sess.tableData(underlying)
 
 	return underlying, ok, nil
@@ -148,6 +159,19 @@ func (d *BaseDatabase) putTable(t *Table) {
 	panic(fmt.Sprintf("table %s not found", t.name))
 }
 
+// committedTableData returns the committed data of every table in the database, keyed by lowercase table name.
+func (d *BaseDatabase) committedTableData() map[string]*TableData {
+	d.tablesMu.RLock()
+	defer d.tablesMu.RUnlock()
+	data := make(map[string]*TableData, len(d.tables))
+	for name, table := range d.tables {
+		if t, ok := table.(*Table); ok {
+			data[strings.ToLower(name)] = t.data
+		}
+	}
+	return data
+}
+
 func (d *BaseDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
 	d.tablesMu.RLock()
 	defer d.tablesMu.RUnlock()
@@ -319,7 +343,7 @@ func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
 	SessionFromContext(ctx).dropTable(t.(*Table).data)
 
 	d.DeleteTable(name)
-	return nil
+	return d.persist(ctx)
 }
 
 func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) error {
@@ -364,7 +388,7 @@ func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) er
 	d.DeleteTable(oldName)
 	sess.putTable(memTbl.data)
 
-	return nil
+	return d.persist(ctx, newName)
 }
 
 func (d *BaseDatabase) GetTriggers(_ *sql.Context) ([]sql.TriggerDefinition, error) {
@@ -373,12 +397,12 @@ func (d *BaseDatabase) GetTriggers(_ *sql.Context) ([]sql.TriggerDefinition, err
 	return triggers, nil
 }
 
-func (d *BaseDatabase) CreateTrigger(_ *sql.Context, definition sql.TriggerDefinition) error {
+func (d *BaseDatabase) CreateTrigger(ctx *sql.Context, definition sql.TriggerDefinition) error {
 	d.triggers = append(d.triggers, definition)
-	return nil
+	return d.persist(ctx)
 }
 
-func (d *BaseDatabase) DropTrigger(_ *sql.Context, name string) error {
+func (d *BaseDatabase) DropTrigger(ctx *sql.Context, name string) error {
 	found := false
 	for i, trigger := range d.triggers {
 		if trigger.Name == name {
@@ -390,7 +414,7 @@ func (d *BaseDatabase) DropTrigger(_ *sql.Context, name string) error {
 	if !found {
 		return sql.ErrTriggerDoesNotExist.New(name)
 	}
-	return nil
+	return d.persist(ctx)
 }
 
 // GetStoredProcedure implements sql.StoredProcedureDatabase
@@ -420,7 +444,7 @@ func (d *BaseDatabase) SaveStoredProcedure(ctx *sql.Context, spd sql.StoredProce
 		}
 	}
 	d.storedProcedures = append(d.storedProcedures, spd)
-	return nil
+	return d.persist(ctx)
 }
 
 // DropStoredProcedure implements sql.StoredProcedureDatabase
@@ -437,7 +461,54 @@ func (d *BaseDatabase) DropStoredProcedure(ctx *sql.Context, name string) error
 	if !found {
 		return sql.ErrStoredProcedureDoesNotExist.New(name)
 	}
-	return nil
+	return d.persist(ctx)
+}
+
+// GetStoredFunction implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) GetStoredFunction(ctx *sql.Context, name string) (sql.StoredFunctionDetails, bool, error) {
+	name = strings.ToLower(name)
+	for _, sfd := range d.storedFunctions {
+		if name == strings.ToLower(sfd.Name) {
+			return sfd, true, nil
+		}
+	}
+	return sql.StoredFunctionDetails{}, false, nil
+}
+
+// GetStoredFunctions implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) GetStoredFunctions(ctx *sql.Context) ([]sql.StoredFunctionDetails, error) {
+	var sfds []sql.StoredFunctionDetails
+	sfds = append(sfds, d.storedFunctions...)
+	return sfds, nil
+}
+
+// SaveStoredFunction implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) SaveStoredFunction(ctx *sql.Context, sfd sql.StoredFunctionDetails) error {
+	loweredName := strings.ToLower(sfd.Name)
+	for _, existingSfd := range d.storedFunctions {
+		if strings.ToLower(existingSfd.Name) == loweredName {
+			return sql.ErrStoredFunctionAlreadyExists.New(sfd.Name)
+		}
+	}
+	d.storedFunctions = append(d.storedFunctions, sfd)
+	return d.persist(ctx)
+}
+
+// DropStoredFunction implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) DropStoredFunction(ctx *sql.Context, name string) error {
+	loweredName := strings.ToLower(name)
+	found := false
+	for i, sfd := range d.storedFunctions {
+		if strings.ToLower(sfd.Name) == loweredName {
+			d.storedFunctions = append(d.storedFunctions[:i], d.storedFunctions[i+1:]...)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return sql.ErrStoredFunctionDoesNotExist.New(d.name + "." + name)
+	}
+	return d.persist(ctx)
 }
 
 // GetEvent implements sql.EventDatabase
@@ -460,7 +531,7 @@ func (d *BaseDatabase) GetEvents(ctx *sql.Context) ([]sql.EventDefinition, inter
 }
 
 // SaveEvent implements sql.EventDatabase
-func (d *BaseDatabase) SaveEvent(_ *sql.Context, event sql.EventDefinition) (bool, error) {
+func (d *BaseDatabase) SaveEvent(ctx *sql.Context, event sql.EventDefinition) (bool, error) {
 	loweredName := strings.ToLower(event.Name)
 	for _, existingEvent := range d.events {
 		if strings.ToLower(existingEvent.Name) == loweredName {
@@ -468,7 +539,7 @@ func (d *BaseDatabase) SaveEvent(_ *sql.Context, event sql.EventDefinition) (boo
 		}
 	}
 	d.events = append(d.events, event)
-	return event.Status == sql.EventStatus_Enable.String(), nil
+	return event.Status == sql.EventStatus_Enable.String(), d.persist(ctx)
 }
 
 // DropEvent implements sql.EventDatabase
@@ -485,11 +556,11 @@ func (d *BaseDatabase) DropEvent(ctx *sql.Context, name string) error {
 	if !found {
 		return sql.ErrEventDoesNotExist.New(name)
 	}
-	return nil
+	return d.persist(ctx)
 }
 
 // UpdateEvent implements sql.EventDatabase
-func (d *BaseDatabase) UpdateEvent(_ *sql.Context, originalName string, event sql.EventDefinition) (bool, error) {
+func (d *BaseDatabase) UpdateEvent(ctx *sql.Context, originalName string, event sql.EventDefinition) (bool, error) {
 	loweredOriginalName := strings.ToLower(originalName)
 	loweredNewName := strings.ToLower(event.Name)
 	found := false
@@ -505,7 +576,7 @@ func (d *BaseDatabase) UpdateEvent(_ *sql.Context, originalName string, event sq
 	if !found {
 		return false, sql.ErrEventDoesNotExist.New(event.Name)
 	}
-	return event.Status == sql.EventStatus_Enable.String(), nil
+	return event.Status == sql.EventStatus_Enable.String(), d.persist(ctx)
 }
 
 // UpdateLastExecuted implements sql.EventDatabase
@@ -539,7 +610,7 @@ func (d *BaseDatabase) GetCollation(ctx *sql.Context) sql.CollationID {
 // SetCollation implements sql.CollatedDatabase.
 func (d *BaseDatabase) SetCollation(ctx *sql.Context, collation sql.CollationID) error {
 	d.collation = collation
-	return nil
+	return d.persist(ctx)
 }
 
 func (d *Database) Database() *BaseDatabase {
@@ -561,7 +632,7 @@ func (d *Database) CreateView(ctx *sql.Context, name string, selectStatement, cr
 		CreateViewStatement: createViewStmt,
 		SqlMode:             sqlMode.String(),
 	}
-	return nil
+	return d.persist(ctx)
 }
 
 // DropView implements the interface sql.ViewDatabase.
@@ -572,7 +643,7 @@ func (d *Database) DropView(ctx *sql.Context, name string) error {
 	}
 
 	delete(d.views, name)
-	return nil
+	return d.persist(ctx)
 }
 
 // AllViews implements the interface sql.ViewDatabase.
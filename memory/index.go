@@ -60,6 +60,7 @@ var _ sql.FilteredIndex = (*Index)(nil)
 var _ sql.OrderedIndex = (*Index)(nil)
 var _ sql.ExtendedIndex = (*Index)(nil)
 var _ fulltext.Index = (*Index)(nil)
+var _ vector.Index = (*Index)(nil)
 
 func (idx *Index) Database() string                                { return idx.DB }
 func (idx *Index) Driver() string                                  { return idx.DriverName }
@@ -93,14 +94,21 @@ func (idx *Index) ExtendedExpressions(ctx *sql.Context) []string {
 
 // ExtendedExprs returns the same information as ExtendedExpressions, but in sql.Expression form.
 func (idx *Index) ExtendedExprs() []sql.Expression {
+	return idx.extendedExprs(idx.Tbl.data.schema)
+}
+
+// extendedExprs returns the expressions of the index followed by the primary key columns of |sch| it doesn't include.
+// The table of the index may hold older table data than what's being edited, whose primary key may be in a different
+// position, so the schema of the table data edited is given.
+func (idx *Index) extendedExprs(sch sql.PrimaryKeySchema) []sql.Expression {
 	var exprs []sql.Expression
 	foundCols := make(map[string]struct{})
 	for _, e := range idx.Exprs {
 		foundCols[strings.ToLower(e.(*expression.GetField).Name())] = struct{}{}
 		exprs = append(exprs, e)
 	}
-	for _, ord := range idx.Tbl.data.schema.PkOrdinals {
-		col := idx.Tbl.data.schema.Schema[ord]
+	for _, ord := range sch.PkOrdinals {
+		col := sch.Schema[ord]
 		if _, ok := foundCols[strings.ToLower(col.Name)]; !ok {
 			exprs = append(exprs, expression.NewGetFieldWithTable(ord, 0, col.Type, idx.DB, idx.Tbl.name, col.Name, col.Nullable))
 		}
@@ -128,6 +136,11 @@ func (idx *Index) IsVector() bool {
 	return idx.SupportedVectorFunction != nil
 }
 
+// VectorDistanceType implements vector.Index
+func (idx *Index) VectorDistanceType() vector.DistanceType {
+	return idx.SupportedVectorFunction
+}
+
 func (idx *Index) CanSupportOrderBy(expr sql.Expression) bool {
 	if idx.SupportedVectorFunction == nil {
 		return false
@@ -151,12 +164,12 @@ func (idx *Index) IndexType() string {
 	return "BTREE" // fake but so are you
 }
 
-func (idx *Index) rowToIndexStorage(row sql.Row, partitionName string, rowIdx int) (sql.Row, error) {
+func (idx *Index) rowToIndexStorage(td *TableData, row sql.Row, partitionName string, rowIdx int) (sql.Row, error) {
 	if idx.Name == "PRIMARY" {
 		return row, nil
 	}
 
-	exprs := idx.ExtendedExprs()
+	exprs := idx.extendedExprs(td.schema)
 	newRow := make(sql.Row, len(exprs)+1)
 	for i, expr := range exprs {
 		var err error
@@ -0,0 +1,314 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Secondary index storage is a slice of index rows kept in key order, the same order a B-tree index would iterate its
+// leaves in. Each index row holds the values of the index's extended expressions (the indexed columns followed by any
+// primary key columns not already indexed) and a trailing primaryRowLocation. Rows are ordered by every key column,
+// so rows with equal indexed values are ordered by primary key, and NULL sorts before all other values. Lookups
+// binary search the storage for the start and end of each range rather than scanning every row.
+
+// indexSpan is the half-open interval [start, end) of positions in an index's storage.
+type indexSpan struct {
+	start, end int
+}
+
+// indexStorageColumns returns the key columns of the storage rows of the index given.
+func (td *TableData) indexStorageColumns(idx *Index) []*sql.Column {
+	var cols []*sql.Column
+	foundCols := make(map[string]struct{})
+	for _, expr := range idx.Exprs {
+		name := expr.(*expression.GetField).Name()
+		foundCols[strings.ToLower(name)] = struct{}{}
+		cols = append(cols, td.schema.Schema[td.schema.Schema.IndexOfColName(name)])
+	}
+	for _, ord := range td.schema.PkOrdinals {
+		col := td.schema.Schema[ord]
+		if _, ok := foundCols[strings.ToLower(col.Name)]; !ok {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+// indexStorageTypes returns the types of the key columns of the storage rows of the index given.
+func (td *TableData) indexStorageTypes(idx *Index) []sql.Type {
+	cols := td.indexStorageColumns(idx)
+	typs := make([]sql.Type, len(cols))
+	for i, col := range cols {
+		typs[i] = col.Type
+	}
+	return typs
+}
+
+// convertIndexStorageColumn converts the values of the column named stored in secondary indexes from |fromType| to the
+// column's current type, then restores the key order of the indexes.
+func (td *TableData) convertIndexStorageColumn(ctx *sql.Context, colName string, fromType sql.Type) error {
+	for idxName, storage := range td.secondaryIndexStorage {
+		idx := td.indexes[strings.ToLower(string(idxName))].(*Index)
+		for pos, col := range td.indexStorageColumns(idx) {
+			if !strings.EqualFold(col.Name, colName) {
+				continue
+			}
+			for i, idxRow := range storage {
+				newVal, _, err := types.ConvertColumnValue(ctx, idxRow[pos], fromType, col.Type)
+				if err != nil {
+					return err
+				}
+				// Index rows are shared with other copies of the table data, so they're replaced rather than modified
+				newRow := idxRow.Copy()
+				newRow[pos] = newVal
+				storage[i] = newRow
+			}
+		}
+	}
+	td.sortSecondaryIndexes(ctx)
+	return nil
+}
+
+// compareIndexStorageRows compares the key columns of the index storage rows given.
+func compareIndexStorageRows(ctx *sql.Context, typs []sql.Type, left, right sql.Row) (int, error) {
+	for i, typ := range typs {
+		if i >= len(left)-1 || i >= len(right)-1 {
+			break
+		}
+		cmp, err := compareIndexValues(ctx, typ, left[i], right[i])
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+// compareIndexValues compares two values of an index column. NULL sorts before all other values.
+func compareIndexValues(ctx *sql.Context, typ sql.Type, left, right interface{}) (int, error) {
+	if left == nil {
+		if right == nil {
+			return 0, nil
+		}
+		return -1, nil
+	} else if right == nil {
+		return 1, nil
+	}
+	return typ.Compare(ctx, left, right)
+}
+
+// insertIndexStorageRow inserts the index row given into |storage| after any rows with equal keys, keeping the
+// storage in key order.
+func insertIndexStorageRow(ctx *sql.Context, typs []sql.Type, storage []sql.Row, idxRow sql.Row) ([]sql.Row, error) {
+	var err error
+	pos := sort.Search(len(storage), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var cmp int
+		cmp, err = compareIndexStorageRows(ctx, typs, storage[i], idxRow)
+		return cmp > 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storage = append(storage, nil)
+	copy(storage[pos+1:], storage[pos:])
+	storage[pos] = idxRow
+	return storage, nil
+}
+
+// indexStorageSpans returns the spans of |storage| that may contain rows in the ranges given, in key order and without
+// overlaps. Rows in a span still need to be filtered by the ranges: a range over more than one column is a single
+// contiguous span of the storage only up to its first column that isn't bound to a single value.
+func indexStorageSpans(ctx *sql.Context, idx *Index, typs []sql.Type, storage []sql.Row, ranges sql.MySQLRangeCollection) ([]indexSpan, error) {
+	if !idx.canSeek(typs) {
+		return []indexSpan{{0, len(storage)}}, nil
+	}
+
+	var spans []indexSpan
+	for _, rang := range ranges {
+		span, err := indexStorageSpan(ctx, typs, storage, rang)
+		if err != nil {
+			return nil, err
+		}
+		if span.start < span.end {
+			spans = append(spans, span)
+		}
+	}
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+	merged := spans[:0]
+	for _, span := range spans {
+		if len(merged) > 0 && span.start <= merged[len(merged)-1].end {
+			if span.end > merged[len(merged)-1].end {
+				merged[len(merged)-1].end = span.end
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged, nil
+}
+
+// canSeek returns whether lookups on this index can seek directly to the rows in range. Prefix indexes store full
+// values and are compared to truncated keys, and extended types compare keys with their own conversion rules, so both
+// are scanned in full instead.
+func (idx *Index) canSeek(typs []sql.Type) bool {
+	for _, prefixLen := range idx.PrefixLens {
+		if prefixLen > 0 {
+			return false
+		}
+	}
+	for _, typ := range typs {
+		if _, ok := typ.(sql.ExtendedType); ok {
+			return false
+		}
+	}
+	return true
+}
+
+func indexStorageSpan(ctx *sql.Context, typs []sql.Type, storage []sql.Row, rang sql.MySQLRange) (indexSpan, error) {
+	if len(rang) > len(typs) {
+		return indexSpan{0, len(storage)}, nil
+	}
+
+	// Only the leading columns bound to a single value, plus the column after them, narrow the span
+	var err error
+	var prefixLen int
+	for prefixLen < len(rang) {
+		var isPoint bool
+		isPoint, err = isPointRange(ctx, typs[prefixLen], rang[prefixLen])
+		if err != nil {
+			return indexSpan{}, err
+		}
+		prefixLen++
+		if !isPoint {
+			break
+		}
+	}
+	rang = rang[:prefixLen]
+
+	start := sort.Search(len(storage), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var before bool
+		before, err = isBeforeRange(ctx, typs, storage[i], rang)
+		return !before
+	})
+	end := sort.Search(len(storage), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var after bool
+		after, err = isAfterRange(ctx, typs, storage[i], rang)
+		return after
+	})
+	if err != nil {
+		return indexSpan{}, err
+	}
+	return indexSpan{start, end}, nil
+}
+
+// isPointRange returns whether the range column expression given matches a single value, or only NULL.
+func isPointRange(ctx *sql.Context, typ sql.Type, rce sql.MySQLRangeColumnExpr) (bool, error) {
+	switch rce.Type() {
+	case sql.RangeType_EqualNull:
+		return true, nil
+	case sql.RangeType_ClosedClosed:
+		cmp, err := typ.Compare(ctx, sql.GetMySQLRangeCutKey(rce.LowerBound), sql.GetMySQLRangeCutKey(rce.UpperBound))
+		return cmp == 0, err
+	default:
+		return false, nil
+	}
+}
+
+// isBeforeRange returns whether the index row given sorts before every row in the range given, whose columns other
+// than the last are all bound to a single value.
+func isBeforeRange(ctx *sql.Context, typs []sql.Type, idxRow sql.Row, rang sql.MySQLRange) (bool, error) {
+	for i, rce := range rang {
+		cmp, err := compareIndexValueToCut(ctx, typs[i], idxRow[i], rce.LowerBound)
+		if err != nil || cmp < 0 {
+			return true, err
+		}
+		if i < len(rang)-1 {
+			cmp, err = compareIndexValueToCut(ctx, typs[i], idxRow[i], rce.UpperBound)
+			if err != nil || cmp > 0 {
+				return false, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// isAfterRange returns whether the index row given sorts after every row in the range given, whose columns other
+// than the last are all bound to a single value.
+func isAfterRange(ctx *sql.Context, typs []sql.Type, idxRow sql.Row, rang sql.MySQLRange) (bool, error) {
+	for i, rce := range rang {
+		cmp, err := compareIndexValueToCut(ctx, typs[i], idxRow[i], rce.UpperBound)
+		if err != nil || cmp > 0 {
+			return true, err
+		}
+		if i < len(rang)-1 {
+			cmp, err = compareIndexValueToCut(ctx, typs[i], idxRow[i], rce.LowerBound)
+			if err != nil || cmp < 0 {
+				return false, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// compareIndexValueToCut returns -1 if the index value given sorts before the cut given, and 1 if it sorts after it.
+// Cuts lie between values, so they never compare equal to one.
+func compareIndexValueToCut(ctx *sql.Context, typ sql.Type, val interface{}, cut sql.MySQLRangeCut) (int, error) {
+	switch cut := cut.(type) {
+	case sql.BelowNull:
+		return 1, nil
+	case sql.AboveNull:
+		if val == nil {
+			return -1, nil
+		}
+		return 1, nil
+	case sql.AboveAll:
+		return -1, nil
+	case sql.Below:
+		cmp, err := compareIndexValues(ctx, typ, val, cut.Key)
+		if err != nil || cmp < 0 {
+			return -1, err
+		}
+		return 1, nil
+	case sql.Above:
+		cmp, err := compareIndexValues(ctx, typ, val, cut.Key)
+		if err != nil || cmp <= 0 {
+			return -1, err
+		}
+		return 1, nil
+	default:
+		return -1, nil
+	}
+}
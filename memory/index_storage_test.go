@@ -0,0 +1,156 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestIndexStorageSpans(t *testing.T) {
+	db := NewDatabase("db")
+	pro := NewDBProvider(db)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(NewSession(sql.NewBaseSession(), pro)))
+
+	table := NewTable(ctx, db.BaseDatabase, "test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "test", PrimaryKey: true},
+		{Name: "a", Type: types.Int64, Source: "test", Nullable: true},
+		{Name: "b", Type: types.Int64, Source: "test", Nullable: true},
+	}), nil)
+	require.NoError(t, table.CreateIndex(ctx, sql.IndexDef{
+		Name:    "idx_a_b",
+		Columns: []sql.IndexColumn{{Name: "a"}, {Name: "b"}},
+	}))
+
+	// a takes the values NULL, 0, 1, 2, 3 and b the values NULL, 0, 1, inserted out of order
+	pk := int64(0)
+	for _, a := range []interface{}{int64(3), nil, int64(1), int64(0), int64(2)} {
+		for _, b := range []interface{}{int64(1), nil, int64(0)} {
+			pk++
+			require.NoError(t, table.Insert(ctx, sql.Row{pk, a, b}))
+		}
+	}
+
+	data := table.data
+	idx := data.indexes["idx_a_b"].(*Index)
+	storage := data.secondaryIndexStorage["idx_a_b"]
+	typs := data.indexStorageTypes(idx)
+	require.Len(t, storage, 15)
+
+	// storage is ordered by a, then b, then pk, with NULL first
+	for i := 1; i < len(storage); i++ {
+		cmp, err := compareIndexStorageRows(ctx, typs, storage[i-1], storage[i])
+		require.NoError(t, err)
+		require.Equal(t, -1, cmp)
+	}
+	require.Nil(t, storage[0][0])
+	require.Nil(t, storage[0][1])
+
+	tests := []struct {
+		name     string
+		ranges   sql.MySQLRangeCollection
+		expected []indexSpan
+	}{
+		{
+			name:     "point on leading column",
+			ranges:   sql.MySQLRangeCollection{{sql.ClosedRangeColumnExpr(int64(1), int64(1), types.Int64)}},
+			expected: []indexSpan{{6, 9}},
+		},
+		{
+			name: "point on both columns",
+			ranges: sql.MySQLRangeCollection{{
+				sql.ClosedRangeColumnExpr(int64(1), int64(1), types.Int64),
+				sql.ClosedRangeColumnExpr(int64(0), int64(0), types.Int64),
+			}},
+			expected: []indexSpan{{7, 8}},
+		},
+		{
+			name: "point then range",
+			ranges: sql.MySQLRangeCollection{{
+				sql.ClosedRangeColumnExpr(int64(2), int64(2), types.Int64),
+				sql.GreaterThanRangeColumnExpr(int64(0), types.Int64),
+			}},
+			expected: []indexSpan{{11, 12}},
+		},
+		{
+			name: "range on leading column doesn't narrow by the second",
+			ranges: sql.MySQLRangeCollection{{
+				sql.OpenRangeColumnExpr(int64(0), int64(3), types.Int64),
+				sql.ClosedRangeColumnExpr(int64(0), int64(0), types.Int64),
+			}},
+			expected: []indexSpan{{6, 12}},
+		},
+		{
+			name:     "null",
+			ranges:   sql.MySQLRangeCollection{{sql.NullRangeColumnExpr(types.Int64)}},
+			expected: []indexSpan{{0, 3}},
+		},
+		{
+			name:     "not null",
+			ranges:   sql.MySQLRangeCollection{{sql.NotNullRangeColumnExpr(types.Int64)}},
+			expected: []indexSpan{{3, 15}},
+		},
+		{
+			name:     "less than excludes nulls",
+			ranges:   sql.MySQLRangeCollection{{sql.LessThanRangeColumnExpr(int64(1), types.Int64)}},
+			expected: []indexSpan{{3, 6}},
+		},
+		{
+			name: "disjoint and adjacent ranges",
+			ranges: sql.MySQLRangeCollection{
+				{sql.ClosedRangeColumnExpr(int64(3), int64(3), types.Int64)},
+				{sql.ClosedRangeColumnExpr(int64(0), int64(0), types.Int64)},
+				{sql.ClosedRangeColumnExpr(int64(1), int64(1), types.Int64)},
+			},
+			expected: []indexSpan{{3, 9}, {12, 15}},
+		},
+		{
+			name:     "empty",
+			ranges:   sql.MySQLRangeCollection{{sql.GreaterThanRangeColumnExpr(int64(3), types.Int64)}},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans, err := indexStorageSpans(ctx, idx, typs, storage, tt.ranges)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, spans)
+		})
+	}
+
+	t.Run("reverse iteration", func(t *testing.T) {
+		lookup := sql.IndexLookup{Index: idx, IsReverse: true}
+		iter := newIndexScanRowIter(idx, lookup, expression.NewLiteral(true, types.Boolean), data, storage, []indexSpan{{3, 5}, {12, 15}}, nil, 3, nil)
+		var pks []int64
+		for {
+			row, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			pks = append(pks, row[0].(int64))
+		}
+		// a = 3 with b = 1, 0, NULL, then a = 0 with b = 0, NULL
+		require.Equal(t, []int64{1, 3, 2, 12, 11}, pks)
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// LZ4 compresses partitions in the LZ4 block format. It favors speed over compression ratio, like the reference
+// implementation's default mode.
+var LZ4 PartitionCodec = lz4Codec{}
+
+const (
+	lz4MinMatch  = 4
+	lz4HashLog   = 14
+	lz4MaxOffset = 65535
+	// the last match must start at least 12 bytes before the end of the block, and the last 5 bytes are literals
+	lz4MatchStartLimit = 12
+	lz4LastLiterals    = 5
+)
+
+var errLZ4Corrupt = errors.New("corrupt LZ4 block")
+
+type lz4Codec struct{}
+
+func (lz4Codec) Name() string {
+	return "lz4"
+}
+
+// Compress implements PartitionCodec, with a greedy search for matches of the last position each 4 byte sequence was
+// seen at.
+func (lz4Codec) Compress(src []byte) []byte {
+	dst := make([]byte, 0, len(src)/2+16)
+	anchor := 0
+	if len(src) > lz4MatchStartLimit {
+		table := make([]int32, 1<<lz4HashLog)
+		limit := len(src) - lz4MatchStartLimit
+		for i := 0; i < limit; {
+			seq := binary.LittleEndian.Uint32(src[i:])
+			h := (seq * 2654435761) >> (32 - lz4HashLog)
+			ref := int(table[h]) - 1
+			table[h] = int32(i + 1)
+			if ref < 0 || i-ref > lz4MaxOffset || binary.LittleEndian.Uint32(src[ref:]) != seq {
+				i++
+				continue
+			}
+
+			matchLen := lz4MinMatch
+			for i+matchLen < len(src)-lz4LastLiterals && src[ref+matchLen] == src[i+matchLen] {
+				matchLen++
+			}
+			dst = lz4AppendSequence(dst, src[anchor:i], i-ref, matchLen)
+			i += matchLen
+			anchor = i
+		}
+	}
+	return lz4AppendSequence(dst, src[anchor:], 0, 0)
+}
+
+// lz4AppendSequence appends a sequence of |literals| followed by a match of |matchLen| bytes at |offset| to |dst|. The
+// last sequence of a block has no match, and a |matchLen| of 0.
+func lz4AppendSequence(dst []byte, literals []byte, offset int, matchLen int) []byte {
+	litLen := len(literals)
+	token := byte(min(litLen, 15)) << 4
+	if matchLen > 0 {
+		token |= byte(min(matchLen-lz4MinMatch, 15))
+	}
+	dst = append(dst, token)
+	if litLen >= 15 {
+		dst = lz4AppendLength(dst, litLen-15)
+	}
+	dst = append(dst, literals...)
+	if matchLen == 0 {
+		return dst
+	}
+	dst = append(dst, byte(offset), byte(offset>>8))
+	if matchLen-lz4MinMatch >= 15 {
+		dst = lz4AppendLength(dst, matchLen-lz4MinMatch-15)
+	}
+	return dst
+}
+
+func lz4AppendLength(dst []byte, n int) []byte {
+	for ; n >= 255; n -= 255 {
+		dst = append(dst, 255)
+	}
+	return append(dst, byte(n))
+}
+
+// Decompress implements PartitionCodec.
+func (lz4Codec) Decompress(src []byte, size int) ([]byte, error) {
+	dst := make([]byte, 0, size)
+	for i := 0; i < len(src); {
+		token := src[i]
+		i++
+
+		litLen := int(token >> 4)
+		if litLen == 15 {
+			var err error
+			if litLen, i, err = lz4ReadLength(src, i, litLen); err != nil {
+				return nil, err
+			}
+		}
+		if litLen > len(src)-i || len(dst)+litLen > size {
+			return nil, errLZ4Corrupt
+		}
+		dst = append(dst, src[i:i+litLen]...)
+		i += litLen
+		if i == len(src) {
+			// the last sequence has no match
+			break
+		}
+
+		if i+2 > len(src) {
+			return nil, errLZ4Corrupt
+		}
+		offset := int(src[i]) | int(src[i+1])<<8
+		i += 2
+		if offset == 0 || offset > len(dst) {
+			return nil, errLZ4Corrupt
+		}
+		matchLen := int(token & 15)
+		if matchLen == 15 {
+			var err error
+			if matchLen, i, err = lz4ReadLength(src, i, matchLen); err != nil {
+				return nil, err
+			}
+		}
+		matchLen += lz4MinMatch
+		if len(dst)+matchLen > size {
+			return nil, errLZ4Corrupt
+		}
+		// matches may overlap the bytes they produce, so they're copied a byte at a time
+		start := len(dst) - offset
+		for j := 0; j < matchLen; j++ {
+			dst = append(dst, dst[start+j])
+		}
+	}
+	if len(dst) != size {
+		return nil, errLZ4Corrupt
+	}
+	return dst, nil
+}
+
+// lz4ReadLength reads the bytes extending a length of |n| starting at |src[i]|, and returns the length and the
+// position after them.
+func lz4ReadLength(src []byte, i int, n int) (int, int, error) {
+	for {
+		if i >= len(src) {
+			return 0, 0, errLZ4Corrupt
+		}
+		b := src[i]
+		i++
+		n += int(b)
+		if b != 255 {
+			return n, i, nil
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var _ sql.PartitionAlterableTable = (*Table)(nil)
+
+// PartitionScheme implements sql.PartitionedTable
+func (t *Table) PartitionScheme(ctx *sql.Context) sql.PartitionScheme {
+	return t.sessionTableData(ctx).partitionScheme
+}
+
+// WithPartitionNames implements sql.PartitionedTable
+func (t *Table) WithPartitionNames(ctx *sql.Context, names []string) (sql.Table, error) {
+	nt := *t
+	nt.partitionNames = names
+	if nt.partitionNames == nil {
+		nt.partitionNames = []string{}
+	}
+	return &nt, nil
+}
+
+// PartitionNames implements sql.PartitionedTable
+func (t *Table) PartitionNames() []string {
+	return t.partitionNames
+}
+
+// SetPartitionScheme implements sql.PartitionAlterableTable
+func (t *Table) SetPartitionScheme(ctx *sql.Context, scheme sql.PartitionScheme) error {
+	return t.sessionTableData(ctx).repartition(ctx, scheme, nil)
+}
+
+// AddPartitions implements sql.PartitionAlterableTable
+func (t *Table) AddPartitions(ctx *sql.Context, defs []sql.PartitionDefinition) error {
+	data := t.sessionTableData(ctx)
+	scheme := data.partitionScheme
+	scheme.Partitions = append(slices.Clip(scheme.Partitions), defs...)
+	return data.repartition(ctx, scheme, nil)
+}
+
+// DropPartitions implements sql.PartitionAlterableTable
+func (t *Table) DropPartitions(ctx *sql.Context, names []string) error {
+	data := t.sessionTableData(ctx)
+	scheme := data.partitionScheme
+	scheme.Partitions = slices.DeleteFunc(slices.Clone(scheme.Partitions), func(def sql.PartitionDefinition) bool {
+		return containsPartition(names, def.Name)
+	})
+	return data.repartition(ctx, scheme, names)
+}
+
+// TruncatePartitions implements sql.PartitionAlterableTable
+func (t *Table) TruncatePartitions(ctx *sql.Context, names []string) error {
+	data := t.sessionTableData(ctx)
+	return data.repartition(ctx, data.partitionScheme, names)
+}
+
+// containsPartition returns whether |names| includes the partition named, which are compared case-insensitively.
+func containsPartition(names []string, name string) bool {
+	return slices.ContainsFunc(names, func(n string) bool {
+		return strings.EqualFold(n, name)
+	})
+}
+
+// repartition partitions the table with |scheme|, moving every row into the partition the scheme assigns it and
+// deleting the rows of the partitions named in |deleted|. Index storage is updated to the new locations of the rows.
+// A table without a partition scheme keeps its rows in a single partition.
+func (td *TableData) repartition(ctx *sql.Context, scheme sql.PartitionScheme, deleted []string) error {
+	if err := td.decompressPartitions(ctx); err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	if scheme.IsPartitioned() {
+		for _, def := range scheme.Partitions {
+			keys = append(keys, []byte(def.Name))
+		}
+	} else {
+		keys = [][]byte{[]byte("0")}
+	}
+
+	// Rows are stored without their virtual columns, so the partition column is found in the physical schema
+	colIdx := td.schema.PhysicalSchema().IndexOfColName(scheme.Column)
+	var colType sql.Type
+	if colIdx >= 0 {
+		colType = td.schema.PhysicalSchema()[colIdx].Type
+	}
+
+	partitions := make(map[string][]sql.Row, len(keys))
+	for _, key := range keys {
+		partitions[string(key)] = []sql.Row{}
+	}
+	moved := make(map[primaryRowLocation]primaryRowLocation)
+	for _, key := range td.partitionKeys {
+		if containsPartition(deleted, string(key)) {
+			continue
+		}
+		for i, row := range td.partitions[string(key)] {
+			p := 0
+			if scheme.IsPartitioned() {
+				var err error
+				if p, err = scheme.PartitionFor(ctx, colType, row[colIdx]); err != nil {
+					return err
+				}
+			}
+			newKey := string(keys[p])
+			partitions[newKey] = append(partitions[newKey], row)
+			moved[primaryRowLocation{partition: string(key), idx: i}] = primaryRowLocation{partition: newKey, idx: len(partitions[newKey]) - 1}
+		}
+	}
+
+	for name, storage := range td.secondaryIndexStorage {
+		newStorage := make([]sql.Row, 0, len(storage))
+		for _, idxRow := range storage {
+			loc, ok := moved[idxRow[len(idxRow)-1].(primaryRowLocation)]
+			if !ok {
+				continue
+			}
+			newStorage = append(newStorage, withRowLocation(idxRow, loc))
+		}
+		td.secondaryIndexStorage[name] = newStorage
+	}
+
+	td.partitionScheme = scheme
+	td.partitionKeys = keys
+	td.partitions = partitions
+	td.sortRows(ctx)
+	return td.compressPartitions(ctx)
+}
@@ -0,0 +1,918 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// DefaultSnapshotInterval is the number of log records written between snapshots when a PersistenceConfig doesn't
+	// specify one.
+	DefaultSnapshotInterval = 100
+
+	snapshotFileName = "snapshot.json"
+	logFileName      = "wal.log"
+)
+
+// PersistenceConfig configures a Database to persist its contents to a directory, so that they survive restarts. The
+// directory holds a snapshot of the database and a write-ahead log of the changes committed since that snapshot.
+// Every commit logs the full contents of each table it changed, which makes persistence suitable for small databases
+// such as test fixtures and lightweight embedded use, not for large data sets.
+type PersistenceConfig struct {
+	// Dir is the directory holding the snapshot and the log. It's created if it doesn't exist.
+	Dir string
+	// SnapshotInterval is the number of log records after which the log is compacted into a new snapshot. Defaults to
+	// DefaultSnapshotInterval.
+	SnapshotInterval int
+	// SyncWrites makes every commit wait for the log to be flushed to stable storage.
+	SyncWrites bool
+}
+
+// DatabaseOption configures optional behavior of a Database created with NewDatabase.
+type DatabaseOption func(*Database)
+
+// WithPersistence returns a DatabaseOption that persists the database as described by |config|. The persisted contents
+// are only loaded, and changes only logged, once Restore has been called on the database.
+func WithPersistence(config PersistenceConfig) DatabaseOption {
+	return func(d *Database) {
+		if config.SnapshotInterval <= 0 {
+			config.SnapshotInterval = DefaultSnapshotInterval
+		}
+		d.persister = &persister{
+			config: config,
+			db:     d,
+			hashes: make(map[string]uint64),
+		}
+	}
+}
+
+// Restore loads the persisted contents of the database, replacing any tables and definitions with the same names,
+// and starts logging changes. It's a no-op for databases created without WithPersistence.
+func (d *Database) Restore(ctx *sql.Context) error {
+	if d.persister == nil {
+		return nil
+	}
+	return d.persister.restore(ctx)
+}
+
+// Close flushes and closes the write-ahead log of a persisted database. Changes made after Close are not persisted.
+func (d *Database) Close() error {
+	if d.persister == nil {
+		return nil
+	}
+	return d.persister.close()
+}
+
+// persist logs the current contents of the tables named, if they changed since they were last logged, along with
+// any tables dropped and any change to the definitions of the database. It's a no-op if the database isn't persisted.
+func (d *BaseDatabase) persist(ctx *sql.Context, tableNames ...string) error {
+	if d.persister == nil {
+		return nil
+	}
+	return d.persister.persist(ctx, tableNames)
+}
+
+// persister writes the contents of a Database to a snapshot file and a write-ahead log.
+type persister struct {
+	config PersistenceConfig
+	db     *Database
+	mu     sync.Mutex
+	log    *os.File
+	// records is the number of records written to the log since the last snapshot
+	records int
+	// hashes holds the hash of the last logged image of each table, keyed by lowercase table name
+	hashes map[string]uint64
+	// dbHash is the hash of the last logged databaseImage
+	dbHash uint64
+}
+
+// logRecord is a single entry of the write-ahead log. Each entry replaces some part of the database as a whole, so
+// replaying an entry more than once has no further effect.
+type logRecord struct {
+	Table     *tableImage    `json:"table,omitempty"`
+	DropTable string         `json:"drop_table,omitempty"`
+	Database  *databaseImage `json:"database,omitempty"`
+}
+
+// snapshot is the contents of the snapshot file.
+type snapshot struct {
+	Database *databaseImage `json:"database"`
+	Tables   []*tableImage  `json:"tables"`
+}
+
+// databaseImage holds the definitions of a database that don't belong to a single table.
+type databaseImage struct {
+	Collation        sql.CollationID              `json:"collation"`
+	Views            []sql.ViewDefinition         `json:"views"`
+	Triggers         []sql.TriggerDefinition      `json:"triggers"`
+	StoredProcedures []sql.StoredProcedureDetails `json:"stored_procedures"`
+	StoredFunctions  []sql.StoredFunctionDetails  `json:"stored_functions"`
+	Events           []sql.EventDefinition        `json:"events"`
+	Sequences        []sql.SequenceDefinition     `json:"sequences"`
+	ForeignKeys      []sql.ForeignKeyConstraint   `json:"foreign_keys"`
+}
+
+// tableImage holds the schema and rows of a table. Values are stored in their MySQL wire representation, which is
+// converted back to the column type when the image is loaded.
+type tableImage struct {
+	Name                    string                  `json:"name"`
+	Comment                 string                  `json:"comment"`
+	Collation               sql.CollationID         `json:"collation"`
+	Columns                 []columnImage           `json:"columns"`
+	PkOrdinals              []int                   `json:"pk_ordinals"`
+	Checks                  []sql.CheckDefinition   `json:"checks"`
+	Indexes                 []indexImage            `json:"indexes"`
+	AutoIncrement           uint64                  `json:"auto_increment"`
+	PartitionKeys           []string                `json:"partition_keys"`
+	Partitions              map[string][][]*[]byte  `json:"partitions"`
+	FullTextConfigTableName string                  `json:"full_text_config_table_name,omitempty"`
+	IndexStorage            map[string][]indexEntry `json:"index_storage,omitempty"`
+	Compression             string                  `json:"compression,omitempty"`
+	PartitionScheme         *partitionSchemeImage   `json:"partition_scheme,omitempty"`
+}
+
+// partitionSchemeImage is the sql.PartitionScheme of a partitioned table, whose bounds and values are encoded as
+// values of the partition column.
+type partitionSchemeImage struct {
+	Method     sql.PartitionMethod `json:"method"`
+	Column     string              `json:"column"`
+	Linear     bool                `json:"linear,omitempty"`
+	Columns    bool                `json:"columns,omitempty"`
+	Partitions []partitionImage    `json:"partitions"`
+}
+
+type partitionImage struct {
+	Name string `json:"name"`
+	// LessThan is empty for the MAXVALUE bound, and for partitions of tables not partitioned by RANGE
+	LessThan []*[]byte `json:"less_than,omitempty"`
+	Values   []*[]byte `json:"values,omitempty"`
+}
+
+type columnImage struct {
+	Name          string          `json:"name"`
+	Type          string          `json:"type"`
+	Collation     sql.CollationID `json:"collation,omitempty"`
+	Default       *string         `json:"default,omitempty"`
+	Generated     *string         `json:"generated,omitempty"`
+	OnUpdate      *string         `json:"on_update,omitempty"`
+	Comment       string          `json:"comment,omitempty"`
+	Extra         string          `json:"extra,omitempty"`
+	Nullable      bool            `json:"nullable"`
+	PrimaryKey    bool            `json:"primary_key"`
+	AutoIncrement bool            `json:"auto_increment"`
+	Virtual       bool            `json:"virtual"`
+}
+
+type indexImage struct {
+	Name       string       `json:"name"`
+	Columns    []string     `json:"columns"`
+	PrefixLens []uint16     `json:"prefix_lens,omitempty"`
+	Comment    string       `json:"comment,omitempty"`
+	Unique     bool         `json:"unique"`
+	Spatial    bool         `json:"spatial"`
+	Fulltext   bool         `json:"fulltext"`
+	Vector     bool         `json:"vector"`
+	FullText   fulltextInfo `json:"full_text"`
+}
+
+// indexEntry is a row of secondary index storage, which locates a row in the primary storage of its table.
+type indexEntry struct {
+	Values    []*[]byte `json:"values"`
+	Partition string    `json:"partition"`
+	RowIdx    int       `json:"row_idx"`
+}
+
+func (p *persister) restore(ctx *sql.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := os.MkdirAll(p.config.Dir, 0755); err != nil {
+		return err
+	}
+
+	snapshotBytes, err := os.ReadFile(filepath.Join(p.config.Dir, snapshotFileName))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err == nil {
+		var snap snapshot
+		if err = json.Unmarshal(snapshotBytes, &snap); err != nil {
+			return fmt.Errorf("unable to read snapshot of database %s: %w", p.db.name, err)
+		}
+		if snap.Database != nil {
+			p.applyDatabase(snap.Database)
+		}
+		for _, image := range snap.Tables {
+			if err = p.applyTable(ctx, image); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = p.replayLog(ctx); err != nil {
+		return err
+	}
+
+	// Compact the replayed log into a new snapshot, which also records the hashes that later changes are compared to
+	return p.writeSnapshot(ctx)
+}
+
+// replayLog applies every complete record of the write-ahead log. A trailing record that wasn't completely written,
+// because of a crash during a commit, is ignored.
+func (p *persister) replayLog(ctx *sql.Context) error {
+	f, err := os.Open(filepath.Join(p.config.Dir, logFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		var record logRecord
+		if err = json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("unable to read log of database %s: %w", p.db.name, err)
+		}
+		switch {
+		case record.Table != nil:
+			err = p.applyTable(ctx, record.Table)
+		case record.DropTable != "":
+			p.db.DeleteTable(record.DropTable)
+		case record.Database != nil:
+			p.applyDatabase(record.Database)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (p *persister) persist(ctx *sql.Context, tableNames []string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.log == nil {
+		return nil
+	}
+
+	tables := p.db.Tables()
+	for _, name := range tableNames {
+		table, ok := sql.GetTableInsensitive(name, tables)
+		if !ok {
+			continue
+		}
+		memTable, ok := table.(*Table)
+		if !ok {
+			continue
+		}
+		image, err := newTableImage(ctx, memTable.data)
+		if err != nil {
+			return err
+		}
+		if err = p.logIfChanged(&logRecord{Table: image}, strings.ToLower(image.Name)); err != nil {
+			return err
+		}
+	}
+
+	var dropped []string
+	for name := range p.hashes {
+		if _, ok := sql.GetTableInsensitive(name, tables); !ok {
+			dropped = append(dropped, name)
+		}
+	}
+	sort.Strings(dropped)
+	for _, name := range dropped {
+		if err := p.writeRecord(&logRecord{DropTable: name}); err != nil {
+			return err
+		}
+		delete(p.hashes, name)
+	}
+
+	if err := p.logIfChanged(&logRecord{Database: p.databaseImage()}, ""); err != nil {
+		return err
+	}
+
+	if p.config.SyncWrites {
+		if err := p.log.Sync(); err != nil {
+			return err
+		}
+	}
+
+	if p.records >= p.config.SnapshotInterval {
+		return p.writeSnapshot(ctx)
+	}
+	return nil
+}
+
+// logIfChanged writes the record given to the log if its contents differ from the last record written for the same
+// table, or for the database definitions when |tableName| is empty.
+func (p *persister) logIfChanged(record *logRecord, tableName string) error {
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	hash := xxhash.Sum64(encoded)
+	if tableName == "" {
+		if hash == p.dbHash {
+			return nil
+		}
+		p.dbHash = hash
+	} else {
+		if lastHash, ok := p.hashes[tableName]; ok && hash == lastHash {
+			return nil
+		}
+		p.hashes[tableName] = hash
+	}
+
+	return p.writeEncodedRecord(encoded)
+}
+
+func (p *persister) writeRecord(record *logRecord) error {
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return p.writeEncodedRecord(encoded)
+}
+
+func (p *persister) writeEncodedRecord(encoded []byte) error {
+	if _, err := p.log.Write(append(encoded, '\n')); err != nil {
+		return err
+	}
+	p.records++
+	return nil
+}
+
+// writeSnapshot replaces the snapshot with the current contents of the database and starts a new, empty log.
+func (p *persister) writeSnapshot(ctx *sql.Context) error {
+	snap := snapshot{Database: p.databaseImage()}
+	hashes := make(map[string]uint64)
+
+	tables := p.db.Tables()
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		memTable, ok := tables[name].(*Table)
+		if !ok {
+			continue
+		}
+		image, err := newTableImage(ctx, memTable.data)
+		if err != nil {
+			return err
+		}
+		encoded, err := json.Marshal(&logRecord{Table: image})
+		if err != nil {
+			return err
+		}
+		hashes[strings.ToLower(name)] = xxhash.Sum64(encoded)
+		snap.Tables = append(snap.Tables, image)
+	}
+
+	encodedDb, err := json.Marshal(&logRecord{Database: snap.Database})
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(&snap)
+	if err != nil {
+		return err
+	}
+
+	// Write the snapshot to a temporary file first, so that a crash never leaves a partially written snapshot behind
+	tmpPath := filepath.Join(p.config.Dir, snapshotFileName+".tmp")
+	if err = writeFileSync(tmpPath, encoded); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpPath, filepath.Join(p.config.Dir, snapshotFileName)); err != nil {
+		return err
+	}
+
+	if p.log != nil {
+		if err = p.log.Close(); err != nil {
+			return err
+		}
+	}
+	p.log, err = os.OpenFile(filepath.Join(p.config.Dir, logFileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		p.log = nil
+		return err
+	}
+
+	p.records = 0
+	p.hashes = hashes
+	p.dbHash = xxhash.Sum64(encodedDb)
+	return nil
+}
+
+func (p *persister) close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.log == nil {
+		return nil
+	}
+	err := p.log.Close()
+	p.log = nil
+	return err
+}
+
+func writeFileSync(path string, contents []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(contents); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (p *persister) databaseImage() *databaseImage {
+	d := p.db
+	image := &databaseImage{
+		Collation:        d.collation,
+		Triggers:         d.triggers,
+		StoredProcedures: d.storedProcedures,
+		StoredFunctions:  d.storedFunctions,
+		Events:           d.events,
+		Sequences:        d.sequenceDefinitions(),
+		ForeignKeys:      d.fkColl.Keys(),
+	}
+	for _, view := range d.views {
+		image.Views = append(image.Views, view)
+	}
+	sort.Slice(image.Views, func(i, j int) bool {
+		return image.Views[i].Name < image.Views[j].Name
+	})
+	return image
+}
+
+func (p *persister) applyDatabase(image *databaseImage) {
+	d := p.db
+	d.collation = image.Collation
+	d.triggers = image.Triggers
+	d.storedProcedures = image.StoredProcedures
+	d.storedFunctions = image.StoredFunctions
+	d.events = image.Events
+	d.sequences = image.Sequences
+	d.fkColl.fks = image.ForeignKeys
+	d.views = make(map[string]sql.ViewDefinition)
+	for _, view := range image.Views {
+		d.views[strings.ToLower(view.Name)] = view
+	}
+}
+
+func newTableImage(ctx *sql.Context, data *TableData) (*tableImage, error) {
+	image := &tableImage{
+		Name:                    data.tableName,
+		Comment:                 data.comment,
+		Collation:               data.collation,
+		PkOrdinals:              data.schema.PkOrdinals,
+		Checks:                  data.checks,
+		AutoIncrement:           data.autoIncVal,
+		Partitions:              make(map[string][][]*[]byte, len(data.partitionKeys)),
+		FullTextConfigTableName: data.fullTextConfigTableName,
+	}
+	if data.codec != nil {
+		image.Compression = data.codec.Name()
+	}
+
+	var storageTypes []sql.Type
+	for _, col := range data.schema.Schema {
+		colImage := columnImage{
+			Name:          col.Name,
+			Type:          col.Type.String(),
+			Comment:       col.Comment,
+			Extra:         col.Extra,
+			Nullable:      col.Nullable,
+			PrimaryKey:    col.PrimaryKey,
+			AutoIncrement: col.AutoIncrement,
+			Virtual:       col.Virtual,
+			Default:       columnDefaultString(col.Default),
+			Generated:     columnDefaultString(col.Generated),
+			OnUpdate:      columnDefaultString(col.OnUpdate),
+		}
+		if collatedType, ok := col.Type.(sql.TypeWithCollation); ok {
+			colImage.Collation = collatedType.Collation()
+		}
+		image.Columns = append(image.Columns, colImage)
+		if !col.Virtual {
+			storageTypes = append(storageTypes, col.Type)
+		}
+	}
+
+	for _, key := range data.partitionKeys {
+		image.PartitionKeys = append(image.PartitionKeys, string(key))
+	}
+
+	if data.partitionScheme.IsPartitioned() {
+		var err error
+		if image.PartitionScheme, err = newPartitionSchemeImage(ctx, data); err != nil {
+			return nil, fmt.Errorf("unable to persist partitions of table %s: %w", data.tableName, err)
+		}
+	}
+	partitions, err := data.allPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for key, rows := range partitions {
+		encodedRows := make([][]*[]byte, len(rows))
+		for i, row := range rows {
+			var err error
+			encodedRows[i], err = encodeValues(ctx, storageTypes, row)
+			if err != nil {
+				return nil, err
+			}
+		}
+		image.Partitions[key] = encodedRows
+	}
+
+	idxNames := make([]string, 0, len(data.indexes))
+	for name := range data.indexes {
+		idxNames = append(idxNames, name)
+	}
+	sort.Strings(idxNames)
+
+	for _, name := range idxNames {
+		idx, ok := data.indexes[name].(*Index)
+		if !ok {
+			continue
+		}
+		idxImage := indexImage{
+			Name:       idx.Name,
+			PrefixLens: idx.PrefixLens,
+			Comment:    idx.CommentStr,
+			Unique:     idx.Unique,
+			Spatial:    idx.Spatial,
+			Fulltext:   idx.Fulltext,
+			Vector:     idx.SupportedVectorFunction != nil,
+			FullText:   idx.fulltextInfo,
+		}
+		for _, expr := range idx.Exprs {
+			gf, ok := expr.(*expression.GetField)
+			if !ok {
+				return nil, fmt.Errorf("unable to persist index %s on table %s: unsupported expression %s", idx.Name, data.tableName, expr)
+			}
+			idxImage.Columns = append(idxImage.Columns, gf.Name())
+		}
+		image.Indexes = append(image.Indexes, idxImage)
+
+		storage := data.secondaryIndexStorage[indexName(idx.ID())]
+		if len(storage) == 0 {
+			continue
+		}
+		exprs := idx.ExtendedExprs()
+		idxTypes := make([]sql.Type, len(exprs))
+		for i, expr := range exprs {
+			idxTypes[i] = expr.Type(ctx)
+		}
+		entries := make([]indexEntry, len(storage))
+		for i, idxRow := range storage {
+			values, err := encodeValues(ctx, idxTypes, idxRow[:len(idxRow)-1])
+			if err != nil {
+				return nil, err
+			}
+			loc := idxRow[len(idxRow)-1].(primaryRowLocation)
+			entries[i] = indexEntry{Values: values, Partition: loc.partition, RowIdx: loc.idx}
+		}
+		if image.IndexStorage == nil {
+			image.IndexStorage = make(map[string][]indexEntry)
+		}
+		image.IndexStorage[idx.ID()] = entries
+	}
+
+	return image, nil
+}
+
+func (p *persister) applyTable(ctx *sql.Context, image *tableImage) error {
+	d := p.db
+	sch := make(sql.Schema, len(image.Columns))
+	var storageTypes []sql.Type
+	for i, colImage := range image.Columns {
+		typ, err := parseColumnType(colImage.Type, colImage.Collation)
+		if err != nil {
+			return fmt.Errorf("unable to restore column %s of table %s: %w", colImage.Name, image.Name, err)
+		}
+		sch[i] = &sql.Column{
+			Name:           colImage.Name,
+			Type:           typ,
+			Source:         image.Name,
+			DatabaseSource: d.name,
+			Comment:        colImage.Comment,
+			Extra:          colImage.Extra,
+			Nullable:       colImage.Nullable,
+			PrimaryKey:     colImage.PrimaryKey,
+			AutoIncrement:  colImage.AutoIncrement,
+			Virtual:        colImage.Virtual,
+			Default:        columnDefaultValue(colImage.Default),
+			Generated:      columnDefaultValue(colImage.Generated),
+			OnUpdate:       columnDefaultValue(colImage.OnUpdate),
+		}
+		if !colImage.Virtual {
+			storageTypes = append(storageTypes, typ)
+		}
+	}
+
+	table := NewPartitionedTableWithCollation(ctx, d.BaseDatabase, image.Name, sql.NewPrimaryKeySchema(sch, image.PkOrdinals...), d.fkColl, len(image.PartitionKeys), image.Collation, image.Comment)
+	data := table.data
+	data.checks = image.Checks
+	data.autoIncVal = image.AutoIncrement
+	data.fullTextConfigTableName = image.FullTextConfigTableName
+
+	data.partitionKeys = make([][]byte, len(image.PartitionKeys))
+	data.partitions = make(map[string][]sql.Row, len(image.PartitionKeys))
+	for i, key := range image.PartitionKeys {
+		data.partitionKeys[i] = []byte(key)
+		rows := make([]sql.Row, len(image.Partitions[key]))
+		for j, encodedRow := range image.Partitions[key] {
+			var err error
+			rows[j], err = decodeValues(ctx, storageTypes, encodedRow)
+			if err != nil {
+				return fmt.Errorf("unable to restore rows of table %s: %w", image.Name, err)
+			}
+		}
+		data.partitions[key] = rows
+	}
+	codec, err := codecByName(image.Compression)
+	if err != nil {
+		return fmt.Errorf("unable to restore table %s: %w", image.Name, err)
+	}
+	if err = data.setCompression(ctx, codec); err != nil {
+		return fmt.Errorf("unable to restore rows of table %s: %w", image.Name, err)
+	}
+	if image.PartitionScheme != nil {
+		if data.partitionScheme, err = image.PartitionScheme.scheme(ctx, sch); err != nil {
+			return fmt.Errorf("unable to restore partitions of table %s: %w", image.Name, err)
+		}
+	}
+
+	data.indexes = make(map[string]sql.Index, len(image.Indexes))
+	for _, idxImage := range image.Indexes {
+		columns := make([]sql.IndexColumn, len(idxImage.Columns))
+		for i, colName := range idxImage.Columns {
+			columns[i] = sql.IndexColumn{Name: colName}
+			if i < len(idxImage.PrefixLens) {
+				columns[i].Length = int64(idxImage.PrefixLens[i])
+			}
+		}
+		constraint := sql.IndexConstraint_None
+		switch {
+		case idxImage.Unique:
+			constraint = sql.IndexConstraint_Unique
+		case idxImage.Spatial:
+			constraint = sql.IndexConstraint_Spatial
+		case idxImage.Fulltext:
+			constraint = sql.IndexConstraint_Fulltext
+		case idxImage.Vector:
+			constraint = sql.IndexConstraint_Vector
+		}
+
+		index, err := table.createIndex(ctx, data, idxImage.Name, columns, constraint, idxImage.Comment)
+		if err != nil {
+			return fmt.Errorf("unable to restore index %s of table %s: %w", idxImage.Name, image.Name, err)
+		}
+		memIdx := index.(*Index)
+		memIdx.fulltextInfo = idxImage.FullText
+		data.indexes[strings.ToLower(memIdx.ID())] = memIdx
+
+		entries := image.IndexStorage[memIdx.ID()]
+		if len(entries) == 0 {
+			continue
+		}
+		exprs := memIdx.ExtendedExprs()
+		idxTypes := make([]sql.Type, len(exprs))
+		for i, expr := range exprs {
+			idxTypes[i] = expr.Type(ctx)
+		}
+		storage := make([]sql.Row, len(entries))
+		for i, entry := range entries {
+			values, err := decodeValues(ctx, idxTypes, entry.Values)
+			if err != nil {
+				return fmt.Errorf("unable to restore index %s of table %s: %w", idxImage.Name, image.Name, err)
+			}
+			storage[i] = append(values, primaryRowLocation{partition: entry.Partition, idx: entry.RowIdx})
+		}
+		data.secondaryIndexStorage[indexName(memIdx.ID())] = storage
+	}
+
+	if existing, ok := sql.GetTableInsensitive(image.Name, d.Tables()); ok {
+		d.DeleteTable(existing.Name())
+	}
+	d.AddTable(image.Name, table)
+	return nil
+}
+
+// newPartitionSchemeImage returns the image of the partition scheme of the table given.
+func newPartitionSchemeImage(ctx *sql.Context, data *TableData) (*partitionSchemeImage, error) {
+	scheme := data.partitionScheme
+	typ := partitionValueType(scheme.Columns, data.schema.Schema[data.schema.Schema.IndexOfColName(scheme.Column)].Type)
+	image := &partitionSchemeImage{
+		Method:  scheme.Method,
+		Column:  scheme.Column,
+		Linear:  scheme.Linear,
+		Columns: scheme.Columns,
+	}
+	for _, def := range scheme.Partitions {
+		partImage := partitionImage{Name: def.Name}
+		var err error
+		if def.LessThan != nil {
+			if partImage.LessThan, err = encodeValues(ctx, []sql.Type{typ}, sql.Row{def.LessThan}); err != nil {
+				return nil, err
+			}
+		}
+		if len(def.Values) > 0 {
+			typs := make([]sql.Type, len(def.Values))
+			for i := range typs {
+				typs[i] = typ
+			}
+			if partImage.Values, err = encodeValues(ctx, typs, def.Values); err != nil {
+				return nil, err
+			}
+		}
+		image.Partitions = append(image.Partitions, partImage)
+	}
+	return image, nil
+}
+
+// scheme returns the partition scheme described by the image, for a table with the schema given.
+func (image *partitionSchemeImage) scheme(ctx *sql.Context, sch sql.Schema) (sql.PartitionScheme, error) {
+	idx := sch.IndexOfColName(image.Column)
+	if idx < 0 {
+		return sql.PartitionScheme{}, sql.ErrPartitionFieldNotFound.New()
+	}
+	typ := partitionValueType(image.Columns, sch[idx].Type)
+	scheme := sql.PartitionScheme{
+		Method:  image.Method,
+		Column:  image.Column,
+		Linear:  image.Linear,
+		Columns: image.Columns,
+	}
+	for _, partImage := range image.Partitions {
+		def := sql.PartitionDefinition{Name: partImage.Name}
+		if len(partImage.LessThan) > 0 {
+			bound, err := decodeValues(ctx, []sql.Type{typ}, partImage.LessThan)
+			if err != nil {
+				return sql.PartitionScheme{}, err
+			}
+			def.LessThan = bound[0]
+		}
+		if len(partImage.Values) > 0 {
+			typs := make([]sql.Type, len(partImage.Values))
+			for i := range typs {
+				typs[i] = typ
+			}
+			values, err := decodeValues(ctx, typs, partImage.Values)
+			if err != nil {
+				return sql.PartitionScheme{}, err
+			}
+			def.Values = values
+		}
+		scheme.Partitions = append(scheme.Partitions, def)
+	}
+	return scheme, nil
+}
+
+// partitionValueType returns the type of the bounds and values of partitions of a column of type |typ|, which are
+// integers unless the table is partitioned by RANGE COLUMNS or LIST COLUMNS.
+func partitionValueType(columns bool, typ sql.Type) sql.Type {
+	if columns {
+		return typ
+	}
+	if types.IsUnsigned(typ) {
+		return types.Uint64
+	}
+	return types.Int64
+}
+
+// encodeValues returns the wire representation of each of the values given, with nil for NULL values.
+func encodeValues(ctx *sql.Context, typs []sql.Type, values sql.Row) ([]*[]byte, error) {
+	encoded := make([]*[]byte, len(values))
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+		val, err := typs[i].SQL(ctx, nil, v)
+		if err != nil {
+			return nil, err
+		}
+		raw := bytes.Clone(val.Raw())
+		encoded[i] = &raw
+	}
+	return encoded, nil
+}
+
+// decodeValues converts values produced by encodeValues back to the types given.
+func decodeValues(ctx *sql.Context, typs []sql.Type, encoded []*[]byte) (sql.Row, error) {
+	if len(encoded) != len(typs) {
+		return nil, fmt.Errorf("expected %d values but found %d", len(typs), len(encoded))
+	}
+	row := make(sql.Row, len(encoded))
+	for i, raw := range encoded {
+		if raw == nil {
+			continue
+		}
+		var err error
+		row[i], _, err = typs[i].Convert(ctx, string(*raw))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// parseColumnType returns the type described by the type string given, as returned by sql.Type.String.
+func parseColumnType(typeString string, collation sql.CollationID) (sql.Type, error) {
+	parsed, err := ast.Parse(fmt.Sprintf("create table t(a %s)", typeString))
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := parsed.(*ast.DDL)
+	if !ok || ddl.TableSpec == nil || len(ddl.TableSpec.Columns) != 1 {
+		return nil, fmt.Errorf("unable to parse column type %s", typeString)
+	}
+
+	parsedTyp := ddl.TableSpec.Columns[0].Type
+	typ, err := types.ColumnTypeToType(&parsedTyp)
+	if err != nil {
+		return nil, err
+	}
+	if parsedTyp.SRID != nil {
+		srid, err := strconv.ParseUint(string(parsedTyp.SRID.Val), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		if spatialType, ok := typ.(sql.SpatialColumnType); ok {
+			typ = spatialType.SetSRID(uint32(srid))
+		}
+	}
+
+	// Type strings omit the default collation, so it's restored separately
+	if collatedType, ok := typ.(sql.TypeWithCollation); ok && collation != sql.Collation_Unspecified {
+		return collatedType.WithNewCollation(collation)
+	}
+	return typ, nil
+}
+
+func columnDefaultString(def *sql.ColumnDefaultValue) *string {
+	if def == nil {
+		return nil
+	}
+	str := def.String()
+	return &str
+}
+
+func columnDefaultValue(str *string) *sql.ColumnDefaultValue {
+	if str == nil {
+		return nil
+	}
+	return sql.NewUnresolvedColumnDefaultValue(*str)
+}
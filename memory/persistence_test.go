@@ -0,0 +1,165 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// persistedEngine returns an engine over a single persisted database named mydb, restored from |dir|.
+func persistedEngine(t *testing.T, dir string, snapshotInterval int) (*sqle.Engine, *sql.Context, *memory.Database) {
+	db := memory.NewDatabase("mydb", memory.WithPersistence(memory.PersistenceConfig{
+		Dir:              dir,
+		SnapshotInterval: snapshotInterval,
+	}))
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+	ctx.SetCurrentDatabase("mydb")
+	require.NoError(t, db.Restore(ctx))
+	return sqle.NewDefault(pro), ctx, db
+}
+
+func runQuery(t *testing.T, e *sqle.Engine, ctx *sql.Context, query string) []sql.Row {
+	_, iter, _, err := e.Query(ctx, query)
+	require.NoError(t, err, query)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(t, err, query)
+	return rows
+}
+
+func TestPersistence(t *testing.T) {
+	for _, interval := range []int{1, 3, 1000} {
+		dir := t.TempDir()
+
+		e, ctx, db := persistedEngine(t, dir, interval)
+		for _, query := range []string{
+			"create table t (id int primary key auto_increment, name varchar(20) collate utf8mb4_bin default 'x', score decimal(10,2), created datetime(6), data json, unique key (name))",
+			"insert into t (name, score, created, data) values ('a', 1.5, '2020-01-02 03:04:05.123456', '{\"k\": [1, 2]}'), ('b', null, null, null)",
+			"insert into t (name, score) values ('c', 3)",
+			"update t set score = score + 1 where name = 'a'",
+			"delete from t where name = 'c'",
+			"create table dropped (i int)",
+			"insert into dropped values (1)",
+			"drop table dropped",
+			"create table old_name (e enum('x', 'y'), s set('p', 'q'), b bit(4), y year, g point srid 4326)",
+			"insert into old_name values ('y', 'p,q', b'101', 2021, st_srid(point(1, 2), 4326))",
+			"rename table old_name to renamed",
+			"create view v as select name from t",
+			"create trigger trg before insert on t for each row set new.score = 10",
+			"create procedure p() select 1",
+		} {
+			runQuery(t, e, ctx, query)
+		}
+		require.NoError(t, db.Close())
+
+		e, ctx, db = persistedEngine(t, dir, interval)
+		require.Equal(t,
+			[]sql.Row{{int32(1), "a", "2.50", "{\"k\": [1, 2]}"}, {int32(2), "b", nil, nil}},
+			runQuery(t, e, ctx, "select id, name, cast(score as char), cast(data as char) from t order by id"))
+		require.Equal(t, []sql.Row{{"a"}, {"b"}}, runQuery(t, e, ctx, "select * from v order by name"))
+		require.Equal(t, []sql.Row{{uint16(2), uint64(3), int64(5), int16(2021), uint32(4326)}},
+			runQuery(t, e, ctx, "select e, s, b + 0, y, st_srid(g) from renamed"))
+		require.Equal(t, []sql.Row{{int8(1)}}, runQuery(t, e, ctx, "call p()"))
+
+		// unique index, auto increment and trigger are all restored
+		_, iter, _, err := e.Query(ctx, "insert into t (name) values ('a')")
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+		}
+		require.Error(t, err)
+		runQuery(t, e, ctx, "insert into t (name) values ('d')")
+		require.Equal(t, []sql.Row{{int32(4), "10.00"}}, runQuery(t, e, ctx, "select id, cast(score as char) from t where name = 'd'"))
+
+		tables, err := db.GetTableNames(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"t", "renamed"}, tables)
+		require.NoError(t, db.Close())
+
+		// A record torn by a crash during a commit is ignored
+		f, err := os.OpenFile(filepath.Join(dir, "wal.log"), os.O_APPEND|os.O_WRONLY, 0644)
+		require.NoError(t, err)
+		_, err = f.WriteString("{\"table\": {\"na")
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		e, ctx, db = persistedEngine(t, dir, interval)
+		require.Equal(t, []sql.Row{{int64(3)}}, runQuery(t, e, ctx, "select count(*) from t"))
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestPersistenceColumnTypes(t *testing.T) {
+	dir := t.TempDir()
+	e, ctx, db := persistedEngine(t, dir, 10)
+	runQuery(t, e, ctx, "create table types (a tinyint unsigned, b text collate latin1_swedish_ci, c varbinary(10), d double, e time)")
+	require.NoError(t, db.Close())
+
+	_, ctx, db = persistedEngine(t, dir, 10)
+	table, ok, err := db.GetTableInsensitive(ctx, "types")
+	require.NoError(t, err)
+	require.True(t, ok)
+	sch := table.Schema(ctx)
+	require.Equal(t, types.Uint8, sch[0].Type)
+	require.Equal(t, sql.Collation_latin1_swedish_ci, sch[1].Type.(sql.TypeWithCollation).Collation())
+	require.Equal(t, "varbinary(10)", sch[2].Type.String())
+	require.Equal(t, types.Float64, sch[3].Type)
+	require.Equal(t, types.Time, sch[4].Type)
+	require.NoError(t, db.Close())
+}
+
+func TestPersistencePartitions(t *testing.T) {
+	dir := t.TempDir()
+	e, ctx, db := persistedEngine(t, dir, 10)
+	runQuery(t, e, ctx, "create table r (a int primary key, b int) partition by range (a) (partition p0 values less than (10), partition p1 values less than maxvalue)")
+	runQuery(t, e, ctx, "create table l (name varchar(10)) partition by list columns (name) (partition x values in ('a', 'b'), partition y values in ('c', null))")
+	runQuery(t, e, ctx, "insert into r values (1, 1), (20, 2)")
+	runQuery(t, e, ctx, "insert into l values ('a'), ('c'), (null)")
+	require.NoError(t, db.Close())
+
+	e, ctx, db = persistedEngine(t, dir, 10)
+	require.Equal(t, []sql.Row{{int32(20)}}, runQuery(t, e, ctx, "select a from r partition (p1)"))
+	require.Equal(t, []sql.Row{{nil}, {"c"}}, runQuery(t, e, ctx, "select name from l partition (y) order by name"))
+	runQuery(t, e, ctx, "insert into r values (5, 3)")
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(5)}}, runQuery(t, e, ctx, "select a from r partition (p0) order by a"))
+	_, iter, _, err := e.Query(ctx, "insert into l values ('d')")
+	if err == nil {
+		_, err = sql.RowIterToRows(ctx, iter)
+	}
+	require.ErrorContains(t, err, "no partition for value d")
+	require.NoError(t, db.Close())
+}
+
+func TestPersistenceSequences(t *testing.T) {
+	dir := t.TempDir()
+	e, ctx, db := persistedEngine(t, dir, 10)
+	runQuery(t, e, ctx, "create sequence s start with 10 increment by 5 cycle")
+	require.Equal(t, []sql.Row{{int64(10), int64(15)}}, runQuery(t, e, ctx, "select nextval(s), nextval(s)"))
+	require.NoError(t, db.Close())
+
+	e, ctx, db = persistedEngine(t, dir, 10)
+	require.Equal(t, []sql.Row{{int64(20)}}, runQuery(t, e, ctx, "select next value for s"))
+	require.Equal(t, []sql.Row{{"s", "CREATE SEQUENCE `s` start with 10 minvalue 1 maxvalue 9223372036854775806 increment by 5 cache 1000 cycle"}},
+		runQuery(t, e, ctx, "show create sequence s"))
+	require.NoError(t, db.Close())
+}
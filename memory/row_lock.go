@@ -0,0 +1,352 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/hash"
+)
+
+// Rows are locked by locking reads, such as SELECT ... FOR UPDATE, and by the reads of UPDATE and DELETE statements,
+// as they are in InnoDB. Locks are held by the transaction of a session until it commits or rolls back. A transaction
+// that needs a row locked by another one in a conflicting mode waits for it to be released, for at most
+// innodb_lock_wait_timeout seconds. Waiting transactions form a wait-for graph, and a transaction whose wait would
+// close a cycle in it is chosen as the deadlock victim: it's rolled back and fails with sql.ErrLockDeadlock.
+
+// defaultLockWaitTimeout is the lock wait timeout used when innodb_lock_wait_timeout can't be read.
+const defaultLockWaitTimeout = 50 * time.Second
+
+// rowLockKey identifies a row of a table. Rows of tables with a primary key are identified by their key, and rows of
+// keyless tables by all their stored values.
+type rowLockKey struct {
+	db    *BaseDatabase
+	table string
+	row   uint64
+}
+
+// rowLockState holds the locks on a row.
+type rowLockState struct {
+	holders map[*Session]sql.RowLockMode
+	// released is closed when a lock on the row is released
+	released chan struct{}
+}
+
+// blockers returns the sessions holding locks on the row that conflict with a lock in |mode| for |sess|.
+func (s *rowLockState) blockers(sess *Session, mode sql.RowLockMode) []*Session {
+	var blockers []*Session
+	for holder, holderMode := range s.holders {
+		if holder != sess && (mode == sql.RowLockExclusive || holderMode == sql.RowLockExclusive) {
+			blockers = append(blockers, holder)
+		}
+	}
+	return blockers
+}
+
+// rowLockManager holds the row locks of every transaction.
+type rowLockManager struct {
+	mu    sync.Mutex
+	locks map[rowLockKey]*rowLockState
+	held  map[*Session][]rowLockKey
+	// waitsFor holds, for each session waiting for a row lock, the sessions holding the locks it waits for
+	waitsFor map[*Session][]*Session
+}
+
+var rowLocks = &rowLockManager{
+	locks:    make(map[rowLockKey]*rowLockState),
+	held:     make(map[*Session][]rowLockKey),
+	waitsFor: make(map[*Session][]*Session),
+}
+
+// lock locks the row identified by |key| for the transaction of |sess|, waiting for conflicting locks held by other
+// transactions to be released as |lock.Wait| directs. Returns whether the lock was acquired, which is false only for
+// rows skipped by SKIP LOCKED, and whether it had to wait for it.
+func (m *rowLockManager) lock(ctx *sql.Context, sess *Session, key rowLockKey, lock sql.RowLock) (acquired bool, waited bool, err error) {
+	var timeout <-chan time.Time
+	for {
+		m.mu.Lock()
+		state, ok := m.locks[key]
+		if !ok {
+			state = &rowLockState{
+				holders:  make(map[*Session]sql.RowLockMode),
+				released: make(chan struct{}),
+			}
+			m.locks[key] = state
+		}
+
+		blockers := state.blockers(sess, lock.Mode)
+		if len(blockers) == 0 {
+			mode, ok := state.holders[sess]
+			if !ok {
+				m.held[sess] = append(m.held[sess], key)
+			}
+			if mode < lock.Mode {
+				state.holders[sess] = lock.Mode
+			}
+			delete(m.waitsFor, sess)
+			m.mu.Unlock()
+			return true, waited, nil
+		}
+
+		switch lock.Wait {
+		case sql.RowLockNowait:
+			m.mu.Unlock()
+			return false, waited, sql.ErrLockNowait.New()
+		case sql.RowLockSkipLocked:
+			m.mu.Unlock()
+			return false, waited, nil
+		}
+
+		m.waitsFor[sess] = blockers
+		if m.waitsOn(blockers, sess) {
+			delete(m.waitsFor, sess)
+			m.mu.Unlock()
+			return false, waited, sql.ErrLockDeadlock.New("deadlock found when trying to get lock")
+		}
+		released := state.released
+		m.mu.Unlock()
+
+		if timeout == nil {
+			timeout = time.After(lockWaitTimeout(ctx))
+		}
+		waited = true
+		err = sql.WaitForRowLock(ctx, func() error {
+			select {
+			case <-released:
+				return nil
+			case <-timeout:
+				return sql.ErrLockWaitTimeout.New()
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			m.mu.Lock()
+			delete(m.waitsFor, sess)
+			m.mu.Unlock()
+			return false, waited, err
+		}
+	}
+}
+
+// waitsOn returns whether any of |sessions| is |target| or waits, directly or through other sessions, for a lock held
+// by |target|. Must be called with |m.mu| held.
+func (m *rowLockManager) waitsOn(sessions []*Session, target *Session) bool {
+	visited := make(map[*Session]struct{})
+	for len(sessions) > 0 {
+		sess := sessions[len(sessions)-1]
+		sessions = sessions[:len(sessions)-1]
+		if sess == target {
+			return true
+		}
+		if _, ok := visited[sess]; ok {
+			continue
+		}
+		visited[sess] = struct{}{}
+		sessions = append(sessions, m.waitsFor[sess]...)
+	}
+	return false
+}
+
+// release releases every row lock held by the transaction of |sess|.
+func (m *rowLockManager) release(sess *Session) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range m.held[sess] {
+		state := m.locks[key]
+		delete(state.holders, sess)
+		close(state.released)
+		if len(state.holders) == 0 {
+			delete(m.locks, key)
+		} else {
+			state.released = make(chan struct{})
+		}
+	}
+	delete(m.held, sess)
+	delete(m.waitsFor, sess)
+}
+
+// lockWaitTimeout returns the value of innodb_lock_wait_timeout for the session of |ctx|.
+func lockWaitTimeout(ctx *sql.Context) time.Duration {
+	val, err := ctx.GetSessionVariable(ctx, "innodb_lock_wait_timeout")
+	if err != nil {
+		return defaultLockWaitTimeout
+	}
+	seconds, ok := val.(int64)
+	if !ok {
+		return defaultLockWaitTimeout
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// rowLockKey returns the key of the lock on |row|, a storage row of this table.
+func (td *TableData) rowLockKey(ctx *sql.Context, db *BaseDatabase, row sql.Row) (rowLockKey, error) {
+	var rowHash uint64
+	var err error
+	if len(td.schema.PkOrdinals) > 0 {
+		rowHash, err = td.primaryKeyHash(ctx, row)
+	} else {
+		rowHash, err = hash.HashOf(ctx, td.schema.PhysicalSchema(), row)
+	}
+	if err != nil {
+		return rowLockKey{}, err
+	}
+	return rowLockKey{db: db, table: key(td).table, row: rowHash}, nil
+}
+
+// latestRow returns the version of |row|, a storage row read from an earlier version of this table, in this table, or
+// nil if the row was deleted.
+func (td *TableData) latestRow(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+	var keyHash uint64
+	if len(td.schema.PkOrdinals) > 0 {
+		var err error
+		keyHash, err = td.primaryKeyHash(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	partitions, err := td.allPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, partition := range partitions {
+		for _, r := range partition {
+			if rowIdentity(r) == rowIdentity(row) {
+				return r, nil
+			}
+			// rows of keyless tables are never updated in place, only deleted
+			if len(td.schema.PkOrdinals) == 0 {
+				continue
+			}
+			h, err := td.primaryKeyHash(ctx, r)
+			if err != nil {
+				return nil, err
+			}
+			if h == keyHash {
+				return r, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// rowLocker locks the rows read by a locking read of a table.
+type rowLocker struct {
+	table *Table
+	data  *TableData
+	lock  sql.RowLock
+	// refreshed is set once the session data of the table has been brought up to date during the read, after which
+	// the rows the read returns must be read again from the session data
+	refreshed bool
+}
+
+// newRowLocker returns a rowLocker for a read of the session data |data| of |t|, or nil if the read doesn't lock rows.
+func newRowLocker(ctx *sql.Context, t *Table, data *TableData) (*rowLocker, error) {
+	if t.rowLock.Mode == 0 || t.ignoreSessionData || t.db == nil {
+		return nil, nil
+	}
+	if SessionFromContext(ctx).snapshots == nil {
+		// not in a transaction
+		return nil, nil
+	}
+	return &rowLocker{table: t, data: data, lock: t.rowLock}, nil
+}
+
+// lockRow locks |row|, a storage row of the table, and returns the latest version of the row, which is nil if the row
+// was deleted by the transaction holding the lock, or if it's skipped because it's locked. Like in InnoDB, locking
+// reads see the latest committed version of the rows they lock rather than the transaction's snapshot: when another
+// transaction committed a change to the row, the session data of the table is brought up to date.
+func (l *rowLocker) lockRow(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+	sess := SessionFromContext(ctx)
+	lockKey, err := l.data.rowLockKey(ctx, l.table.db, row)
+	if err != nil {
+		return nil, err
+	}
+	acquired, _, err := rowLocks.lock(ctx, sess, lockKey, l.lock)
+	if err != nil {
+		if sql.ErrLockDeadlock.Is(err) {
+			sess.abortTransaction()
+		}
+		return nil, err
+	}
+	if !acquired {
+		return nil, nil
+	}
+
+	changed, err := l.committedRowChanged(ctx, sess, row)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		refreshed, err := sess.refreshTable(ctx, l.table.db, l.data)
+		if err != nil {
+			return nil, err
+		}
+		l.refreshed = l.refreshed || refreshed
+	}
+	if !l.refreshed {
+		return row, nil
+	}
+	return l.data.latestRow(ctx, row)
+}
+
+// committedRowChanged returns whether another transaction committed a change to |row| since the session data of the
+// table was read.
+func (l *rowLocker) committedRowChanged(ctx *sql.Context, sess *Session, row sql.Row) (bool, error) {
+	k := key(l.data)
+	readVersion, ok := sess.readVersions[k]
+	if !ok {
+		return false, nil
+	}
+	current, ok := l.table.db.committedTableData()[k.table]
+	if !ok || current == readVersion {
+		return false, nil
+	}
+
+	readRow, err := readVersion.latestRow(ctx, row)
+	if err != nil {
+		return false, err
+	}
+	currentRow, err := current.latestRow(ctx, row)
+	if err != nil {
+		return false, err
+	}
+	return (readRow == nil) != (currentRow == nil) || rowIdentity(readRow) != rowIdentity(currentRow), nil
+}
+
+// lockRowForWrite locks |row|, a full row of the table |td| about to be updated or deleted by the transaction of the
+// session of |ctx|.
+func lockRowForWrite(ctx *sql.Context, t *Table, td *TableData, row sql.Row) error {
+	if t.ignoreSessionData || t.db == nil {
+		return nil
+	}
+	sess := SessionFromContext(ctx)
+	if sess.snapshots == nil {
+		// not in a transaction
+		return nil
+	}
+	lockKey, err := td.rowLockKey(ctx, t.db, td.toStorageRow(row))
+	if err != nil {
+		return err
+	}
+	_, _, err = rowLocks.lock(ctx, sess, lockKey, sql.RowLock{Mode: sql.RowLockExclusive})
+	if sql.ErrLockDeadlock.Is(err) {
+		sess.abortTransaction()
+	}
+	return err
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// lockWaitState is a process list for a single session that records whether its query is waiting for a row lock.
+type lockWaitState struct {
+	sql.EmptyProcessList
+	waiting atomic.Bool
+}
+
+func (p *lockWaitState) UpdateQueryState(pid uint64, state string) {
+	p.waiting.Store(state == sql.RowLockWaitState)
+}
+
+// startQuery runs |query| in the background, and returns a channel receiving its error once it completes.
+func startQuery(e *sqle.Engine, ctx *sql.Context, query string) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- queryErr(e, ctx, query)
+	}()
+	return done
+}
+
+// waitForRowLock waits until the session of |state| is waiting for a row lock.
+func waitForRowLock(t *testing.T, state *lockWaitState, done <-chan error) {
+	require.Eventually(t, func() bool {
+		select {
+		case err := <-done:
+			require.FailNow(t, "query completed without waiting", "%v", err)
+		default:
+		}
+		return state.waiting.Load()
+	}, 5*time.Second, time.Millisecond)
+}
+
+func lockingEngine(t *testing.T) (*sqle.Engine, *sql.Context, *sql.Context, *lockWaitState, *lockWaitState) {
+	e, a, b := transactionEngine(t)
+	aState, bState := &lockWaitState{}, &lockWaitState{}
+	a.ProcessList = aState
+	b.ProcessList = bState
+	return e, a, b, aState, bState
+}
+
+func TestRowLockWaits(t *testing.T) {
+	e, a, b, _, bState := lockingEngine(t)
+
+	runQuery(t, e, a, "start transaction")
+	runQuery(t, e, b, "start transaction")
+	runQuery(t, e, a, "update t set v = v + 10 where pk = 1")
+
+	// b waits for a to commit, then updates the row a committed
+	done := startQuery(e, b, "update t set v = v + 100 where pk = 1")
+	waitForRowLock(t, bState, done)
+	runQuery(t, e, a, "commit")
+	require.NoError(t, <-done)
+	require.Equal(t, []sql.Row{{int32(111)}}, runQuery(t, e, b, "select v from t where pk = 1"))
+	runQuery(t, e, b, "commit")
+
+	require.Equal(t,
+		[]sql.Row{{int32(1), int32(111)}, {int32(2), int32(2)}, {int32(3), int32(3)}},
+		runQuery(t, e, a, "select * from t order by pk"))
+
+	// locking reads see the latest committed version of the rows they lock
+	runQuery(t, e, a, "start transaction")
+	runQuery(t, e, b, "start transaction")
+	require.Equal(t, []sql.Row{{int32(2)}}, runQuery(t, e, b, "select v from t where pk = 2"))
+	runQuery(t, e, a, "delete from t where pk = 2")
+	done = startQuery(e, b, "select v from t where pk = 2 lock in share mode")
+	waitForRowLock(t, bState, done)
+	runQuery(t, e, a, "commit")
+	require.NoError(t, <-done)
+	require.Empty(t, runQuery(t, e, b, "select v from t where pk = 2 for update"))
+	runQuery(t, e, b, "commit")
+}
+
+func TestRowLockNowaitAndSkipLocked(t *testing.T) {
+	e, a, b, _, _ := lockingEngine(t)
+
+	runQuery(t, e, a, "start transaction")
+	runQuery(t, e, b, "start transaction")
+	runQuery(t, e, a, "select * from t where pk = 2 lock in share mode")
+
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}}, runQuery(t, e, b, "select pk from t for update skip locked"))
+	require.True(t, sql.ErrLockNowait.Is(queryErr(e, b, "select pk from t where pk = 2 for update nowait")))
+	// shared locks don't conflict
+	require.Equal(t, []sql.Row{{int32(2)}}, runQuery(t, e, b, "select pk from t where pk = 2 lock in share mode"))
+
+	runQuery(t, e, a, "rollback")
+	require.Equal(t, []sql.Row{{int32(2)}}, runQuery(t, e, b, "select pk from t where pk = 2 for update nowait"))
+	runQuery(t, e, b, "commit")
+}
+
+func TestRowLockWaitTimeout(t *testing.T) {
+	e, a, b, _, _ := lockingEngine(t)
+
+	runQuery(t, e, b, "set innodb_lock_wait_timeout = 1")
+	runQuery(t, e, a, "start transaction")
+	runQuery(t, e, b, "start transaction")
+	runQuery(t, e, a, "delete from t where pk = 3")
+
+	err := queryErr(e, b, "update t set v = 30 where pk = 3")
+	require.Error(t, err)
+	require.True(t, sql.ErrLockWaitTimeout.Is(err), err.Error())
+
+	// the transaction is still open, and rows not locked by a can be changed
+	runQuery(t, e, b, "update t set v = 10 where pk = 1")
+	runQuery(t, e, a, "commit")
+	runQuery(t, e, b, "commit")
+	require.Equal(t, []sql.Row{{int32(1), int32(10)}, {int32(2), int32(2)}}, runQuery(t, e, a, "select * from t order by pk"))
+}
+
+func TestRowLockDeadlock(t *testing.T) {
+	e, a, b, aState, _ := lockingEngine(t)
+
+	runQuery(t, e, a, "start transaction")
+	runQuery(t, e, b, "start transaction")
+	runQuery(t, e, a, "update t set v = 11 where pk = 1")
+	runQuery(t, e, b, "update t set v = 22 where pk = 2")
+	runQuery(t, e, b, "insert into keyless values (2)")
+
+	done := startQuery(e, a, "update t set v = 12 where pk = 2")
+	waitForRowLock(t, aState, done)
+
+	// b would wait for a, which waits for b: b is rolled back
+	err := queryErr(e, b, "update t set v = 21 where pk = 1")
+	require.Error(t, err)
+	require.True(t, sql.ErrLockDeadlock.Is(err), err.Error())
+
+	require.NoError(t, <-done)
+	runQuery(t, e, a, "commit")
+	runQuery(t, e, b, "commit")
+
+	require.Equal(t,
+		[]sql.Row{{int32(1), int32(11)}, {int32(2), int32(12)}, {int32(3), int32(3)}},
+		runQuery(t, e, a, "select * from t order by pk"))
+	require.Empty(t, runQuery(t, e, a, "select * from keyless"))
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Sequences are replaced rather than changed in place, so that a copy of |d.sequences| taken under |d.sequencesMu| can
+// be persisted without holding the lock.
+
+// GetSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) GetSequence(ctx *sql.Context, name string) (sql.SequenceDefinition, bool, error) {
+	d.sequencesMu.Lock()
+	defer d.sequencesMu.Unlock()
+	if i := d.sequenceIndex(name); i >= 0 {
+		return d.sequences[i], true, nil
+	}
+	return sql.SequenceDefinition{}, false, nil
+}
+
+// GetSequences implements sql.SequenceDatabase
+func (d *BaseDatabase) GetSequences(ctx *sql.Context) ([]sql.SequenceDefinition, error) {
+	return d.sequenceDefinitions(), nil
+}
+
+// CreateSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) CreateSequence(ctx *sql.Context, seq sql.SequenceDefinition) error {
+	d.sequencesMu.Lock()
+	if d.sequenceIndex(seq.Name) >= 0 {
+		d.sequencesMu.Unlock()
+		return sql.ErrTableAlreadyExists.New(seq.Name)
+	}
+	d.sequences = append(slices.Clip(d.sequences), seq)
+	d.sequencesMu.Unlock()
+	return d.persist(ctx)
+}
+
+// UpdateSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) UpdateSequence(ctx *sql.Context, seq sql.SequenceDefinition) error {
+	d.sequencesMu.Lock()
+	i := d.sequenceIndex(seq.Name)
+	if i < 0 {
+		d.sequencesMu.Unlock()
+		return sql.ErrUnknownSequence.New(seq.Name)
+	}
+	d.sequences = slices.Clone(d.sequences)
+	d.sequences[i] = seq
+	d.sequencesMu.Unlock()
+	return d.persist(ctx)
+}
+
+// DropSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) DropSequence(ctx *sql.Context, name string) error {
+	d.sequencesMu.Lock()
+	i := d.sequenceIndex(name)
+	if i < 0 {
+		d.sequencesMu.Unlock()
+		return sql.ErrUnknownSequence.New(name)
+	}
+	d.sequences = slices.Delete(slices.Clone(d.sequences), i, i+1)
+	d.sequencesMu.Unlock()
+	return d.persist(ctx)
+}
+
+// NextSequenceValue implements sql.SequenceDatabase
+func (d *BaseDatabase) NextSequenceValue(ctx *sql.Context, name string) (int64, error) {
+	d.sequencesMu.Lock()
+	i := d.sequenceIndex(name)
+	if i < 0 {
+		d.sequencesMu.Unlock()
+		return 0, sql.ErrUnknownSequence.New(name)
+	}
+	value, seq, ok := d.sequences[i].Advance()
+	if !ok {
+		d.sequencesMu.Unlock()
+		return 0, sql.ErrSequenceRunOut.New(d.name, d.sequences[i].Name)
+	}
+	d.sequences = slices.Clone(d.sequences)
+	d.sequences[i] = seq
+	d.sequencesMu.Unlock()
+	return value, d.persist(ctx)
+}
+
+// sequenceDefinitions returns the sequences of the database.
+func (d *BaseDatabase) sequenceDefinitions() []sql.SequenceDefinition {
+	d.sequencesMu.Lock()
+	defer d.sequencesMu.Unlock()
+	return slices.Clone(d.sequences)
+}
+
+// sequenceIndex returns the position in |d.sequences| of the sequence named, or -1 if there's none. It must be called
+// with |d.sequencesMu| held.
+func (d *BaseDatabase) sequenceIndex(name string) int {
+	return slices.IndexFunc(d.sequences, func(seq sql.SequenceDefinition) bool {
+		return strings.EqualFold(seq.Name, name)
+	})
+}
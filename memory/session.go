@@ -32,12 +32,22 @@ type Session struct {
 	editAccumulators map[tableKey]tableEditAccumulator
 	persistedGlobals GlobalsMap
 	validateCallback func()
+	// snapshots holds, for each database read by the current transaction, the committed data of its tables as of the
+	// transaction's first read of the database, keyed by lowercase database and table name. Nil outside transactions.
+	snapshots map[string]map[string]*TableData
+	// readVersions holds the committed data that the session data of each table was copied from, which is compared to
+	// the committed data at commit time to detect conflicting writes
+	readVersions map[tableKey]*TableData
+	savepoints   []savepoint
+	// generation is incremented every time the transaction state is cleared
+	generation uint64
 }
 
 var _ sql.Session = (*Session)(nil)
 var _ sql.TransactionSession = (*Session)(nil)
 var _ sql.Transaction = (*Transaction)(nil)
 var _ sql.PersistableSession = (*Session)(nil)
+var _ sql.LifecycleAwareSession = (*Session)(nil)
 
 // NewSession returns the new session for this object
 func NewSession(baseSession *sql.BaseSession, provider sql.DatabaseProvider) *Session {
@@ -134,60 +144,271 @@ func (s *Session) putTable(d *TableData) {
 // dropTable clears the table data for the session
 func (s *Session) dropTable(d *TableData) {
 	delete(s.tables, key(d))
+	// a table created with the same name is a new table, not a version of this one
+	delete(s.readVersions, key(d))
 }
 
-// StartTransaction clears session state and returns a new transaction object.
-// Because we don't support concurrency, we store table data changes in the session, rather than the transaction itself.
+// snapshotTable returns the table given with the data it had in the current transaction's snapshot of its database,
+// recording that version of the table as the one the session's changes to it are based on. The snapshot of a database
+// is taken the first time the transaction reads any of its tables, so that every read sees the same committed state.
+func (s *Session) snapshotTable(d *BaseDatabase, t *Table) *Table {
+	k := key(t.data)
+	if s.snapshots == nil {
+		return t
+	}
+	if _, ok := s.tables[k]; ok {
+		return t
+	}
+
+	dbSnapshot, ok := s.snapshots[k.db]
+	if !ok {
+		dbSnapshot = d.committedTableData()
+		s.snapshots[k.db] = dbSnapshot
+	}
+
+	committed, ok := dbSnapshot[k.table]
+	if !ok {
+		// the table was created after the snapshot was taken
+		committed = t.data
+	}
+	s.readVersions[k] = committed
+
+	if committed == t.data {
+		return t
+	}
+	nt := *t
+	nt.data = committed
+	return &nt
+}
+
+// StartTransaction clears session state and returns a new transaction object. Table data changes are stored in the
+// session, and written to the database on commit.
 func (s *Session) StartTransaction(ctx *sql.Context, tCharacteristic sql.TransactionCharacteristic) (sql.Transaction, error) {
+	s.clearTransactionState()
+	s.snapshots = make(map[string]map[string]*TableData)
+	s.readVersions = make(map[tableKey]*TableData)
+	return &Transaction{tCharacteristic == sql.ReadOnly}, nil
+}
+
+func (s *Session) clearTransactionState() {
+	rowLocks.release(s)
 	s.tables = make(map[tableKey]*TableData)
 	s.editAccumulators = make(map[tableKey]tableEditAccumulator)
-	return &Transaction{tCharacteristic == sql.ReadOnly}, nil
+	s.snapshots = nil
+	s.readVersions = nil
+	s.savepoints = nil
+	s.generation++
 }
 
+// abortTransaction rolls back the current transaction, as when it's chosen as a deadlock victim, leaving a new one
+// open in its place.
+func (s *Session) abortTransaction() {
+	s.clearTransactionState()
+	s.snapshots = make(map[string]map[string]*TableData)
+	s.readVersions = make(map[tableKey]*TableData)
+}
+
+// refreshTable brings |working|, the session data of a table in |db|, up to date with the latest committed data of the
+// table, by applying the changes the transaction made to it on top of the changes other transactions committed since
+// it was read. The data is updated in place, so that the edit accumulators and table editors of the table see it.
+// Returns whether the data changed. A conflict between the changes rolls back the transaction.
+func (s *Session) refreshTable(ctx *sql.Context, db *BaseDatabase, working *TableData) (bool, error) {
+	k := key(working)
+	readVersion, ok := s.readVersions[k]
+	if !ok {
+		return false, nil
+	}
+	current, ok := db.committedTableData()[k.table]
+	if !ok || current == readVersion {
+		return false, nil
+	}
+
+	refreshed, err := rebaseTableData(ctx, db, readVersion, current, working)
+	if err != nil {
+		if sql.ErrLockDeadlock.Is(err) {
+			s.abortTransaction()
+		}
+		return false, err
+	}
+	*working = *refreshed
+	s.readVersions[k] = current
+	return true, nil
+}
+
+// restoreTable replaces the session data of a table with |data|, a copy of it taken when the session data was based on
+// |readVersion|, when a statement that changed it fails. If the session data was refreshed since, |data| is brought up
+// to date first. Nothing is restored if the transaction was rolled back since the copy was taken in |generation|.
+func (s *Session) restoreTable(ctx *sql.Context, db *BaseDatabase, data, readVersion *TableData, generation uint64) error {
+	if generation != s.generation {
+		return nil
+	}
+	k := key(data)
+	if current, ok := s.readVersions[k]; ok && readVersion != nil && current != readVersion {
+		var err error
+		data, err = rebaseTableData(ctx, db, readVersion, current, data)
+		if err != nil {
+			return err
+		}
+	}
+	s.putTable(data)
+	return nil
+}
+
+// CommitTransaction writes the tables changed by the transaction to their databases. Tables that other transactions
+// committed changes to since this transaction read them are merged row by row, and the commit fails with
+// sql.ErrLockDeadlock if both transactions changed the same row or the definition of the same table.
 func (s *Session) CommitTransaction(ctx *sql.Context, tx sql.Transaction) error {
-	for key := range s.tables {
+	commitMu.Lock()
+	defer commitMu.Unlock()
+
+	var commits []tableCommit
+	for key, data := range s.tables {
 		if key.db == "" && key.table == "" {
 			// dual table
 			continue
 		}
-		db, err := s.dbProvider.Database(ctx, key.db)
+		baseDb, err := s.baseDatabase(ctx, key.db)
+		if err != nil {
+			return err
+		}
+
+		committed, err := prepareTableCommit(ctx, baseDb, s.readVersions[key], data)
 		if err != nil {
+			if sql.ErrLockDeadlock.Is(err) {
+				s.clearTransactionState()
+			}
 			return err
 		}
+		if committed != nil {
+			commits = append(commits, tableCommit{db: baseDb, key: key, data: committed})
+		}
+	}
 
-		var baseDb *BaseDatabase
-		switch db := db.(type) {
-		case *BaseDatabase:
-			baseDb = db
-		case *Database:
-			baseDb = db.BaseDatabase
-		case *HistoryDatabase:
-			baseDb = db.BaseDatabase
-		default:
-			return fmt.Errorf("unknown database type %T", db)
+	installed := make(map[tableKey]*TableData, len(commits))
+	for _, commit := range commits {
+		table := commit.data.Table(commit.db)
+		commit.db.putTable(table)
+		installed[commit.key] = table.data
+		if err := commit.db.persist(ctx, commit.key.table); err != nil {
+			return err
 		}
-		baseDb.putTable(s.tables[key].Table(baseDb))
 	}
+	rowLocks.release(s)
 
+	// Statements run after the commit without starting a new transaction, such as those in stored procedures, see the
+	// committed data. They edit copies of it, as committed data is never modified in place.
+	s.tables = make(map[tableKey]*TableData, len(installed))
+	for k, td := range installed {
+		s.tables[k] = td.copy()
+	}
+	s.editAccumulators = make(map[tableKey]tableEditAccumulator)
+	s.savepoints = nil
+	if s.snapshots != nil {
+		s.snapshots = make(map[string]map[string]*TableData)
+		s.readVersions = installed
+	}
 	return nil
 }
 
+// baseDatabase returns the storage of the database named.
+func (s *Session) baseDatabase(ctx *sql.Context, name string) (*BaseDatabase, error) {
+	db, err := s.dbProvider.Database(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch db := db.(type) {
+	case *BaseDatabase:
+		return db, nil
+	case *Database:
+		return db.BaseDatabase, nil
+	case *HistoryDatabase:
+		return db.BaseDatabase, nil
+	default:
+		return nil, fmt.Errorf("unknown database type %T", db)
+	}
+}
+
 func (s *Session) Rollback(ctx *sql.Context, transaction sql.Transaction) error {
-	s.tables = make(map[tableKey]*TableData)
-	s.editAccumulators = make(map[tableKey]tableEditAccumulator)
+	s.clearTransactionState()
 	return nil
 }
 
+// savepoint is a named copy of the session's table data within a transaction, along with the committed data each
+// table's data was based on.
+type savepoint struct {
+	name         string
+	tables       map[tableKey]*TableData
+	readVersions map[tableKey]*TableData
+}
+
+func copySessionTables(tables map[tableKey]*TableData) map[tableKey]*TableData {
+	copied := make(map[tableKey]*TableData, len(tables))
+	for k, td := range tables {
+		copied[k] = td.copy()
+	}
+	return copied
+}
+
+func (s *Session) savepointIndex(name string) int {
+	for i, sp := range s.savepoints {
+		if strings.EqualFold(sp.name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// CreateSavepoint implements sql.TransactionSession
 func (s *Session) CreateSavepoint(ctx *sql.Context, transaction sql.Transaction, name string) error {
-	return fmt.Errorf("savepoints are not supported in memory sessions")
+	if i := s.savepointIndex(name); i >= 0 {
+		s.savepoints = append(s.savepoints[:i], s.savepoints[i+1:]...)
+	}
+	readVersions := make(map[tableKey]*TableData, len(s.readVersions))
+	for k, td := range s.readVersions {
+		readVersions[k] = td
+	}
+	s.savepoints = append(s.savepoints, savepoint{name: name, tables: copySessionTables(s.tables), readVersions: readVersions})
+	return nil
 }
 
+// RollbackToSavepoint implements sql.TransactionSession
 func (s *Session) RollbackToSavepoint(ctx *sql.Context, transaction sql.Transaction, name string) error {
-	return fmt.Errorf("savepoints are not supported in memory sessions")
+	i := s.savepointIndex(name)
+	if i < 0 {
+		return sql.ErrSavepointDoesNotExist.New(name)
+	}
+	// The savepoint keeps its own copy of the table data so that it can be rolled back to again
+	tables := copySessionTables(s.savepoints[i].tables)
+	// Tables refreshed by locking reads since the savepoint was created keep the changes committed by other
+	// transactions
+	for k, td := range tables {
+		readVersion, current := s.savepoints[i].readVersions[k], s.readVersions[k]
+		if readVersion == nil || current == nil || readVersion == current {
+			continue
+		}
+		db, err := s.baseDatabase(ctx, k.db)
+		if err != nil {
+			return err
+		}
+		if tables[k], err = rebaseTableData(ctx, db, readVersion, current, td); err != nil {
+			return err
+		}
+	}
+	s.tables = tables
+	s.editAccumulators = make(map[tableKey]tableEditAccumulator)
+	s.savepoints = s.savepoints[:i+1]
+	return nil
 }
 
+// ReleaseSavepoint implements sql.TransactionSession
 func (s *Session) ReleaseSavepoint(ctx *sql.Context, transaction sql.Transaction, name string) error {
-	return fmt.Errorf("savepoints are not supported in memory sessions")
+	i := s.savepointIndex(name)
+	if i < 0 {
+		return sql.ErrSavepointDoesNotExist.New(name)
+	}
+	s.savepoints = s.savepoints[:i]
+	return nil
 }
 
 // PersistGlobal implements sql.PersistableSession
@@ -234,6 +455,20 @@ func (s *Session) GetPersistedValue(k string) (interface{}, error) {
 	return s.persistedGlobals[k], nil
 }
 
+// CommandBegin implements sql.LifecycleAwareSession
+func (s *Session) CommandBegin() error {
+	return nil
+}
+
+// CommandEnd implements sql.LifecycleAwareSession
+func (s *Session) CommandEnd() {}
+
+// SessionEnd implements sql.LifecycleAwareSession. The row locks of a transaction left open by a closed connection are
+// released, as it can never be committed.
+func (s *Session) SessionEnd() {
+	rowLocks.release(s)
+}
+
 // ValidateSession counts the number of times this method is called.
 func (s *Session) ValidateSession(ctx *sql.Context) error {
 	if s.validateCallback != nil {
@@ -0,0 +1,182 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Snappy compresses partitions in the snappy block format.
+var Snappy PartitionCodec = snappyCodec{}
+
+const (
+	snappyHashLog   = 14
+	snappyMaxOffset = 65535
+	// copies with a two byte offset hold up to 64 bytes
+	snappyMaxCopyLen = 64
+
+	snappyTagLiteral = 0x00
+	snappyTagCopy1   = 0x01
+	snappyTagCopy2   = 0x02
+	snappyTagCopy4   = 0x03
+)
+
+var errSnappyCorrupt = errors.New("corrupt snappy block")
+
+type snappyCodec struct{}
+
+func (snappyCodec) Name() string {
+	return "snappy"
+}
+
+// Compress implements PartitionCodec, with a greedy search for matches of the last position each 4 byte sequence was
+// seen at.
+func (snappyCodec) Compress(src []byte) []byte {
+	dst := binary.AppendUvarint(make([]byte, 0, len(src)/2+16), uint64(len(src)))
+	anchor := 0
+	if len(src) >= 4 {
+		table := make([]int32, 1<<snappyHashLog)
+		limit := len(src) - 4
+		for i := 0; i <= limit; {
+			seq := binary.LittleEndian.Uint32(src[i:])
+			h := (seq * 0x1e35a7bd) >> (32 - snappyHashLog)
+			ref := int(table[h]) - 1
+			table[h] = int32(i + 1)
+			if ref < 0 || i-ref > snappyMaxOffset || binary.LittleEndian.Uint32(src[ref:]) != seq {
+				i++
+				continue
+			}
+
+			matchLen := 4
+			for i+matchLen < len(src) && src[ref+matchLen] == src[i+matchLen] {
+				matchLen++
+			}
+			dst = snappyAppendLiteral(dst, src[anchor:i])
+			dst = snappyAppendCopy(dst, i-ref, matchLen)
+			i += matchLen
+			anchor = i
+		}
+	}
+	return snappyAppendLiteral(dst, src[anchor:])
+}
+
+// snappyAppendLiteral appends a literal element holding |lit| to |dst|.
+func snappyAppendLiteral(dst []byte, lit []byte) []byte {
+	if len(lit) == 0 {
+		return dst
+	}
+	n := len(lit) - 1
+	switch {
+	case n < 60:
+		dst = append(dst, byte(n)<<2|snappyTagLiteral)
+	case n < 1<<8:
+		dst = append(dst, 60<<2|snappyTagLiteral, byte(n))
+	case n < 1<<16:
+		dst = append(dst, 61<<2|snappyTagLiteral, byte(n), byte(n>>8))
+	case n < 1<<24:
+		dst = append(dst, 62<<2|snappyTagLiteral, byte(n), byte(n>>8), byte(n>>16))
+	default:
+		dst = append(dst, 63<<2|snappyTagLiteral, byte(n), byte(n>>8), byte(n>>16), byte(n>>24))
+	}
+	return append(dst, lit...)
+}
+
+// snappyAppendCopy appends copy elements for a match of |length| bytes at |offset| to |dst|. Matches longer than a
+// single element can hold are split, leaving at least 4 bytes for the last element.
+func snappyAppendCopy(dst []byte, offset int, length int) []byte {
+	for length > 0 {
+		n := length
+		if n > snappyMaxCopyLen {
+			n = snappyMaxCopyLen
+			if length-n < 4 {
+				n = length - 4
+			}
+		}
+		dst = append(dst, byte(n-1)<<2|snappyTagCopy2, byte(offset), byte(offset>>8))
+		length -= n
+	}
+	return dst
+}
+
+// Decompress implements PartitionCodec.
+func (snappyCodec) Decompress(src []byte, size int) ([]byte, error) {
+	decodedLen, i := binary.Uvarint(src)
+	if i <= 0 || decodedLen != uint64(size) {
+		return nil, errSnappyCorrupt
+	}
+	dst := make([]byte, 0, size)
+	for i < len(src) {
+		tag := src[i]
+		i++
+
+		var offset, length int
+		switch tag & 0x03 {
+		case snappyTagLiteral:
+			length = int(tag >> 2)
+			if length >= 60 {
+				numBytes := length - 59
+				if i+numBytes > len(src) {
+					return nil, errSnappyCorrupt
+				}
+				length = 0
+				for j := numBytes - 1; j >= 0; j-- {
+					length = length<<8 | int(src[i+j])
+				}
+				i += numBytes
+			}
+			length++
+			if length > len(src)-i || len(dst)+length > size {
+				return nil, errSnappyCorrupt
+			}
+			dst = append(dst, src[i:i+length]...)
+			i += length
+			continue
+		case snappyTagCopy1:
+			if i+1 > len(src) {
+				return nil, errSnappyCorrupt
+			}
+			length = 4 + int(tag>>2&0x07)
+			offset = int(tag>>5)<<8 | int(src[i])
+			i++
+		case snappyTagCopy2:
+			if i+2 > len(src) {
+				return nil, errSnappyCorrupt
+			}
+			length = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint16(src[i:]))
+			i += 2
+		case snappyTagCopy4:
+			if i+4 > len(src) {
+				return nil, errSnappyCorrupt
+			}
+			length = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint32(src[i:]))
+			i += 4
+		}
+		if offset == 0 || offset > len(dst) || len(dst)+length > size {
+			return nil, errSnappyCorrupt
+		}
+		// copies may overlap the bytes they produce, so they're copied a byte at a time
+		start := len(dst) - offset
+		for j := 0; j < length; j++ {
+			dst = append(dst, dst[start+j])
+		}
+	}
+	if len(dst) != size {
+		return nil, errSnappyCorrupt
+	}
+	return dst, nil
+}
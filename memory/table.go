@@ -16,15 +16,18 @@ package memory
 
 import (
 	"bytes"
+	"context"
 	"encoding/gob"
 	"fmt"
 	"io"
 	"math"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -55,6 +58,11 @@ type Table struct {
 	filters           []sql.Expression
 	ignoreSessionData bool
 	pkIndexesEnabled  bool
+	temporary         bool
+	// partitionNames are the partitions the table is restricted to by WithPartitionNames, or nil for all of them
+	partitionNames []string
+	// rowLock is the lock taken on the rows read from the table, if any
+	rowLock sql.RowLock
 }
 
 var _ sql.Table = (*Table)(nil)
@@ -74,15 +82,22 @@ var _ sql.CheckAlterableTable = (*Table)(nil)
 var _ sql.RewritableTable = (*Table)(nil)
 var _ sql.CheckTable = (*Table)(nil)
 var _ sql.AutoIncrementTable = (*Table)(nil)
+var _ sql.AutoIncrementRangeTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
 var _ fulltext.IndexAlterableTable = (*Table)(nil)
+var _ vector.IndexAlterableTable = (*Table)(nil)
 var _ sql.IndexBuildingTable = (*Table)(nil)
 var _ sql.Databaseable = (*Table)(nil)
 var _ sql.TargetRowSizeAlterableTable = (*Table)(nil)
 var _ sql.TargetRowSizeTable = (*Table)(nil)
+var _ sql.LockableTable = (*Table)(nil)
+var _ sql.CompressionAlterableTable = (*Table)(nil)
+var _ sql.CompressedTable = (*Table)(nil)
+var _ sql.TemporaryTable = (*Table)(nil)
+var _ sql.AlterAlgorithmTable = (*Table)(nil)
 
 // NewTable creates a new Table with the given name and schema. Assigns the default collation, therefore if a different
 // collation is desired, please use NewTableWithCollation.
@@ -107,6 +122,17 @@ func NewLocalTable(ctx *sql.Context, db MemoryDatabase, name string, schema sql.
 	return tbl
 }
 
+// NewTemporaryTable returns a table created with CREATE TEMPORARY TABLE in the database named |dbName|, which may be a
+// database of any provider. Temporary tables are only visible to the session that creates them, so their data is kept
+// in the table rather than in the session, and changes to it aren't part of the session's transaction.
+func NewTemporaryTable(ctx *sql.Context, dbName, name string, schema sql.PrimaryKeySchema, collation sql.CollationID, comment string) *Table {
+	db := NewViewlessDatabase(dbName)
+	tbl := NewPartitionedTableWithCollation(ctx, db, name, schema, db.fkColl, 0, collation, comment)
+	tbl.ignoreSessionData = true
+	tbl.temporary = true
+	return tbl
+}
+
 // NewTableWithCollation creates a new Table with the given name, schema, and collation.
 func NewTableWithCollation(ctx *sql.Context, db *BaseDatabase, name string, schema sql.PrimaryKeySchema, fkColl *ForeignKeyCollection, collation sql.CollationID) *Table {
 	return NewPartitionedTableWithCollation(ctx, db, name, schema, fkColl, 0, collation, "")
@@ -261,6 +287,11 @@ func (t *Table) IgnoreSessionData() bool {
 	return t.ignoreSessionData
 }
 
+// IsTemporary implements the sql.TemporaryTable interface.
+func (t *Table) IsTemporary() bool {
+	return t.temporary
+}
+
 func (t *Table) UnderlyingTable() *Table {
 	return t
 }
@@ -276,11 +307,17 @@ func (t *Table) GetPartition(key string) []sql.Row {
 
 // Partitions implements the sql.Table interface.
 func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	data := t.sessionTableData(ctx)
 
 	var keys [][]byte
 	for _, k := range data.partitionKeys {
-		if rows, ok := data.partitions[string(k)]; ok && len(rows) > 0 {
+		if t.partitionNames != nil && !slices.Contains(t.partitionNames, string(k)) {
+			continue
+		}
+		if data.partitionLen(string(k)) > 0 {
 			keys = append(keys, k)
 		}
 	}
@@ -421,7 +458,7 @@ type spatialRangePartition struct {
 func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
 	data := t.sessionTableData(ctx)
 
-	return int64(len(data.partitions)), nil
+	return int64(len(data.partitions) + len(data.compressed)), nil
 }
 
 type indexScanRowIter struct {
@@ -429,86 +466,151 @@ type indexScanRowIter struct {
 	ranges    sql.Expression
 	lookup    sql.IndexLookup
 	indexRows []sql.Row
+	spans     []indexSpan
 
-	incrementFunc func()
-	primaryRows   map[string][]sql.Row
+	primaryRows map[string][]sql.Row
+	// data is the table data the primary rows belong to, for decompressing the partitions of compressed tables
+	data         *TableData
+	decompressed map[string][]sql.Row
 
 	columns     []int
 	virtualCols []int
+	span        int
 	i           int
 	numColumns  int
+	canceled    cancelChecker
+	// locker locks the rows returned for locking reads
+	locker *rowLocker
+	// partitions are the partitions of a table restricted by WithPartitionNames, whose rows are the only ones returned
+	partitions []string
 }
 
 func newIndexScanRowIter(
 	index *Index,
 	lookup sql.IndexLookup,
 	ranges sql.Expression,
-	primaryRows map[string][]sql.Row,
+	data *TableData,
 	indexRows []sql.Row,
+	spans []indexSpan,
 	columns []int,
 	numColumns int,
 	virtualCols []int,
 ) *indexScanRowIter {
-
-	i := 0
-	if lookup.IsReverse {
-		i = len(indexRows) - 1
-	}
-
 	iter := &indexScanRowIter{
-		i:           i,
 		index:       index,
 		lookup:      lookup,
 		ranges:      ranges,
-		primaryRows: primaryRows,
+		primaryRows: data.partitions,
+		data:        data,
 		indexRows:   indexRows,
+		spans:       spans,
 		columns:     columns,
 		numColumns:  numColumns,
 		virtualCols: virtualCols,
 	}
 
 	if lookup.IsReverse {
-		iter.incrementFunc = func() {
-			iter.i--
-		}
-	} else {
-		iter.incrementFunc = func() {
-			iter.i++
+		iter.span = len(spans) - 1
+		if iter.span >= 0 {
+			iter.i = spans[iter.span].end - 1
 		}
+	} else if len(spans) > 0 {
+		iter.i = spans[0].start
 	}
 
 	return iter
 }
 
-func (i *indexScanRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	if i.i >= len(i.indexRows) || i.i < 0 {
-		return nil, io.EOF
+// increment moves the iterator to the next index row in its spans, in descending order for reverse lookups.
+func (i *indexScanRowIter) increment() {
+	if i.lookup.IsReverse {
+		i.i--
+		if i.i < i.spans[i.span].start {
+			i.span--
+			if i.span >= 0 {
+				i.i = i.spans[i.span].end - 1
+			}
+		}
+	} else {
+		i.i++
+		if i.i >= i.spans[i.span].end {
+			i.span++
+			if i.span < len(i.spans) {
+				i.i = i.spans[i.span].start
+			}
+		}
 	}
+}
 
+// partitionRows returns the rows of the partition with the key given, decompressing compressed partitions the first
+// time they're read.
+func (i *indexScanRowIter) partitionRows(ctx *sql.Context, key string) ([]sql.Row, error) {
+	if rows, ok := i.primaryRows[key]; ok {
+		return rows, nil
+	}
+	if rows, ok := i.decompressed[key]; ok {
+		return rows, nil
+	}
+	rows, _, err := i.data.partitionRows(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if i.decompressed == nil {
+		i.decompressed = make(map[string][]sql.Row)
+	}
+	i.decompressed[key] = rows
+	return rows, nil
+}
+
+func (i *indexScanRowIter) done() bool {
+	return i.span < 0 || i.span >= len(i.spans)
+}
+
+func (i *indexScanRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	var row sql.Row
-	for ; i.i < len(i.indexRows) && i.i >= 0; i.incrementFunc() {
+	for ; !i.done(); i.increment() {
+		// many index rows may be skipped before one matches, so this is checked for every index row read
+		if err := i.canceled.check(ctx); err != nil {
+			return nil, err
+		}
 		idxRow := i.indexRows[i.i]
 		rowLoc := idxRow[len(idxRow)-1].(primaryRowLocation)
+		if i.partitions != nil && !slices.Contains(i.partitions, rowLoc.partition) {
+			continue
+		}
 		// this is a bit of a hack: during self-referential foreign key delete cascades, the index storage rows don't get
 		// updated at the same time the primary table storage does, since we update the slices directly in the case of
 		// the primary index but update the map entries for the secondary index storage.
-		// TODO: revisit this once we have b-tree storage in place
-		if len(i.primaryRows[rowLoc.partition]) <= rowLoc.idx {
+		primaryRows, err := i.partitionRows(ctx, rowLoc.partition)
+		if err != nil {
+			return nil, err
+		}
+		if len(primaryRows) <= rowLoc.idx {
 			continue
 		}
 
-		candidate := i.primaryRows[rowLoc.partition][rowLoc.idx]
+		candidate := primaryRows[rowLoc.idx]
 
 		matches, err := indexRowMatches(i.ranges, idxRow[:len(idxRow)-1])
 		if err != nil {
 			return nil, err
 		}
 
-		if matches {
-			row = candidate
-			i.incrementFunc()
-			break
+		if !matches {
+			continue
 		}
+		if i.locker != nil {
+			candidate, err = i.locker.lockRow(ctx, candidate)
+			if err != nil {
+				return nil, err
+			}
+			if candidate == nil {
+				continue
+			}
+		}
+		row = candidate
+		i.increment()
+		break
 	}
 
 	if row == nil {
@@ -535,7 +637,14 @@ func (i *indexScanRowIter) Close(context *sql.Context) error {
 
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	data := t.sessionTableData(ctx)
+	locker, err := newRowLocker(ctx, t, data)
+	if err != nil {
+		return nil, err
+	}
 
 	if isp, ok := partition.(indexScanPartition); ok {
 		numColumns := len(data.schema.Schema)
@@ -543,16 +652,30 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 			numColumns = len(t.columns)
 		}
 
-		return newIndexScanRowIter(
+		indexRows := data.secondaryIndexStorage[indexName(isp.index.Name)]
+		lookupRanges, ok := isp.lookup.Ranges.(sql.MySQLRangeCollection)
+		if !ok {
+			return nil, fmt.Errorf("expected MySQL ranges in memory indexed table")
+		}
+		spans, err := indexStorageSpans(ctx, isp.index, data.indexStorageTypes(isp.index), indexRows, lookupRanges)
+		if err != nil {
+			return nil, err
+		}
+
+		iter := newIndexScanRowIter(
 			isp.index,
 			isp.lookup,
 			isp.ranges,
-			data.partitions,
-			data.secondaryIndexStorage[indexName(isp.index.Name)],
+			data,
+			indexRows,
+			spans,
 			t.columns,
 			numColumns,
 			data.virtualColIndexes(),
-		), nil
+		)
+		iter.locker = locker
+		iter.partitions = t.partitionNames
+		return iter, nil
 	}
 
 	filters := t.filters
@@ -563,7 +686,10 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 
 	if vectorPartition, ok := partition.(*vectorPartitionIter); ok {
 		// Assume only one partition for now
-		rows := data.partitions[string(data.partitionKeys[0])]
+		rows, _, err := data.partitionRows(ctx, string(data.partitionKeys[0]))
+		if err != nil {
+			return nil, err
+		}
 
 		sc := sql.SortConditions{
 			{Expr: vectorPartition.OrderBy, Order: sql.Ascending},
@@ -580,7 +706,10 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 		return iters.NewSortIter(sc, sql.RowsToRowIter(rows...)), nil
 	}
 
-	rows, ok := data.partitions[string(partition.Key())]
+	rows, ok, err := data.partitionRows(ctx, string(partition.Key()))
+	if err != nil {
+		return nil, err
+	}
 	if !ok {
 		return nil, sql.ErrPartitionNotFound.New(partition.Key())
 	}
@@ -613,9 +742,18 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 		numColumns:  numColumns,
 		virtualCols: data.virtualColIndexes(),
 		filters:     filters,
+		sch:         t.Schema(ctx),
+		locker:      locker,
 	}, nil
 }
 
+// WithRowLock implements sql.LockableTable
+func (t *Table) WithRowLock(lock sql.RowLock) sql.Table {
+	nt := *t
+	nt.rowLock = lock
+	return &nt
+}
+
 func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
 	data := t.sessionTableData(ctx)
 
@@ -657,6 +795,13 @@ func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
 
 func (t *Table) RowCount(ctx *sql.Context) (uint64, bool, error) {
 	data := t.sessionTableData(ctx)
+	if t.partitionNames != nil {
+		var count uint64
+		for _, name := range t.partitionNames {
+			count += uint64(data.partitionLen(name))
+		}
+		return count, true, nil
+	}
 	rows, err := data.numRows(ctx)
 	return rows, true, err
 }
@@ -676,7 +821,10 @@ type partitionIter struct {
 	pos  int
 }
 
-func (p *partitionIter) Next(*sql.Context) (sql.Partition, error) {
+func (p *partitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	if p.pos >= len(p.keys) {
 		return nil, io.EOF
 	}
@@ -688,6 +836,25 @@ func (p *partitionIter) Next(*sql.Context) (sql.Partition, error) {
 
 func (p *partitionIter) Close(*sql.Context) error { return nil }
 
+// cancelCheckInterval is the number of rows a scan reads between checks of whether its query was canceled. Checking
+// the context for every row would add to the cost of reading every row of a table.
+const cancelCheckInterval = 1024
+
+// cancelChecker checks whether the query of a scan was canceled once every cancelCheckInterval rows.
+type cancelChecker struct {
+	rows int
+}
+
+// check returns the error of |ctx| if it's time to check it, and the query was canceled.
+func (c *cancelChecker) check(ctx *sql.Context) error {
+	c.rows++
+	if c.rows < cancelCheckInterval {
+		return nil
+	}
+	c.rows = 0
+	return ctx.Err()
+}
+
 type tableIter struct {
 	indexValues sql.IndexValueIter
 	rows        []sql.Row
@@ -696,30 +863,105 @@ type tableIter struct {
 	virtualCols []int
 	numColumns  int
 	pos         int
+	// sch and arena are used to return the rows as sql.ValueRows
+	sch   sql.Schema
+	arena sql.ValueArena
+	// locker locks the rows returned for locking reads
+	locker   *rowLocker
+	canceled cancelChecker
 }
 
 var _ sql.RowIter = (*tableIter)(nil)
+var _ sql.ValueRowIter = (*tableIter)(nil)
 
 func (i *tableIter) Next(ctx *sql.Context) (sql.Row, error) {
-	row, err := i.getRow(ctx)
+	// rows that don't match the filters are skipped by calling Next again, so this is checked for every row read
+	if err := i.canceled.check(ctx); err != nil {
+		return nil, err
+	}
+	storageRow, err := i.getRow(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	row = normalizeRowForRead(row, i.numColumns, i.virtualCols)
+	row := normalizeRowForRead(storageRow, i.numColumns, i.virtualCols)
+	matches, err := i.matchesFilters(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if !matches {
+		return i.Next(ctx)
+	}
+
+	if i.locker != nil {
+		// the latest version of the row may no longer match
+		storageRow, err = i.locker.lockRow(ctx, storageRow)
+		if err != nil {
+			return nil, err
+		}
+		if storageRow == nil {
+			return i.Next(ctx)
+		}
+		row = normalizeRowForRead(storageRow, i.numColumns, i.virtualCols)
+		matches, err = i.matchesFilters(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			return i.Next(ctx)
+		}
+	}
+
+	return projectRow(i.columns, row), nil
+}
 
+// matchesFilters returns whether |row| matches the filters of the iterator.
+func (i *tableIter) matchesFilters(ctx *sql.Context, row sql.Row) (bool, error) {
 	for _, f := range i.filters {
 		result, err := f.Eval(ctx, row)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 		result, _ = sql.ConvertToBool(ctx, result)
 		if result != true {
-			return i.Next(ctx)
+			return false, nil
 		}
 	}
+	return true, nil
+}
 
-	return projectRow(i.columns, row), nil
+// NextValueRow implements the sql.ValueRowIter interface.
+func (i *tableIter) NextValueRow(ctx *sql.Context) (sql.ValueRow, error) {
+	row, err := i.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	valueRow := i.arena.NewValueRow(len(row))
+	for j, v := range row {
+		valueRow[j], err = i.arena.EncodeValue(i.sch[j].Type.Type(), v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return valueRow, nil
+}
+
+// IsValueRowIter implements the sql.ValueRowIter interface. Rows are returned as sql.ValueRows when every column is a
+// number or a string, as the values of other types are not encoded.
+func (i *tableIter) IsValueRowIter(ctx *sql.Context) bool {
+	if i.sch == nil || len(i.sch) != i.numColumns {
+		return false
+	}
+	for _, col := range i.sch {
+		if types.IsInteger(col.Type) || types.IsFloat(col.Type) {
+			continue
+		}
+		// BINARY values are padded when they're returned, which is not done for sql.Values
+		if st, ok := col.Type.(types.StringType); !ok || st.Type() == sqltypes.Binary {
+			return false
+		}
+	}
+	return true
 }
 
 func projectRow(columns []int, row sql.Row) sql.Row {
@@ -812,11 +1054,15 @@ type spatialTableIter struct {
 	pos                    int
 	ord                    int
 	minX, minY, maxX, maxY float64
+	canceled               cancelChecker
 }
 
 var _ sql.RowIter = (*spatialTableIter)(nil)
 
 func (i *spatialTableIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if err := i.canceled.check(ctx); err != nil {
+		return nil, err
+	}
 	row, err := i.getRow(ctx)
 	if err != nil {
 		return nil, err
@@ -931,8 +1177,8 @@ func (t *Table) getTableEditor(ctx *sql.Context) sql.TableEditor {
 	return editor
 }
 
-func (t *Table) getRewriteTableEditor(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema) sql.TableEditor {
-	editor, err := t.tableEditorForRewrite(ctx, oldSchema, newSchema)
+func (t *Table) getRewriteTableEditor(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema, scheme sql.PartitionScheme) sql.TableEditor {
+	editor, err := t.tableEditorForRewrite(ctx, oldSchema, newSchema, scheme)
 	if err != nil {
 		panic(err)
 	}
@@ -1012,12 +1258,19 @@ func (t *Table) newTableEditor(ctx *sql.Context) (sql.TableEditor, error) {
 		ea = sess.editAccumulator(t)
 		data = sess.tableData(t)
 	}
+	// rows are edited in place, so compressed partitions are decompressed for the length of the statement
+	if err := ea.TableData().decompressPartitions(ctx); err != nil {
+		return nil, err
+	}
+	if err := data.decompressPartitions(ctx); err != nil {
+		return nil, err
+	}
 
 	tableUnderEdit := t.copy()
 	tableUnderEdit.data = data
 
 	uniqIdxCols, prefixLengths, uniqIdxNames := data.indexColsForTableEditor()
-	var editor sql.TableEditor = &tableEditor{
+	editor := &tableEditor{
 		editedTable:    tableUnderEdit,
 		initialTable:   t.copy(),
 		ea:             ea,
@@ -1025,22 +1278,24 @@ func (t *Table) newTableEditor(ctx *sql.Context) (sql.TableEditor, error) {
 		prefixLengths:  prefixLengths,
 		uniqueIdxNames: uniqIdxNames,
 	}
+	editor.recordReadVersion(ctx)
 	return editor, nil
 }
 
-func (t *Table) tableEditorForRewrite(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema) (sql.TableEditor, error) {
+func (t *Table) tableEditorForRewrite(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema, scheme sql.PartitionScheme) (sql.TableEditor, error) {
 	// Make a copy of the table under edit with the new schema and no data
 	tableUnderEdit := t.copy()
 	// Use session indexes so that indexes created in this session are preserved during rewrite
 	if !t.ignoreSessionData {
 		tableUnderEdit.data.indexes = t.sessionTableData(ctx).indexes
 	}
+	tableUnderEdit.data.partitionScheme = scheme
 	tableData := tableUnderEdit.data.truncate(ctx, normalizeSchemaForRewrite(newSchema))
 	tableUnderEdit.data = tableData
 
 	// TODO: |editedTableAnd| and |ea| should have the same tableData reference
 	uniqIdxCols, prefixLengths, uniqIdxNames := tableData.indexColsForTableEditor()
-	var editor sql.TableEditor = &tableEditor{
+	editor := &tableEditor{
 		editedTable:    tableUnderEdit,
 		initialTable:   t.copy(),
 		ea:             newTableEditAccumulator(tableData),
@@ -1048,6 +1303,9 @@ func (t *Table) tableEditorForRewrite(ctx *sql.Context, oldSchema, newSchema sql
 		prefixLengths:  prefixLengths,
 		uniqueIdxNames: uniqIdxNames,
 	}
+	if t.ignoreSessionData {
+		editor.rewrittenData = t.data
+	}
 	return editor, nil
 }
 
@@ -1140,8 +1398,8 @@ func (t *Table) Truncate(ctx *sql.Context) (int, error) {
 	data := t.sessionTableData(ctx)
 
 	count := 0
-	for key := range data.partitions {
-		count += len(data.partitions[key])
+	for _, key := range data.partitionKeys {
+		count += data.partitionLen(string(key))
 	}
 
 	data.truncate(ctx, data.schema)
@@ -1206,9 +1464,20 @@ func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{
 	return data.autoIncVal, nil
 }
 
+// ReserveAutoIncrementRange implements sql.AutoIncrementRangeTable
+func (t *Table) ReserveAutoIncrementRange(ctx *sql.Context, count, increment, offset uint64) (uint64, error) {
+	data := t.sessionTableData(ctx)
+
+	first := sql.NextAutoIncrementInSeries(data.autoIncVal, increment, offset)
+	data.autoIncVal = first + (count-1)*increment
+	if autoCol := t.getAutoIncrementColumn(ctx); autoCol != nil {
+		updateAutoIncrementSafe(ctx, autoCol, &data.autoIncVal)
+	}
+	return first, nil
+}
+
 func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
 
 	newColIdx, data, err := addColumnToSchema(ctx, data, column, order)
 	if err != nil {
@@ -1219,13 +1488,20 @@ func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.Colum
 	if err != nil {
 		return err
 	}
+	if err = data.compressPartitions(ctx); err != nil {
+		return err
+	}
 
-	sess.putTable(data)
+	t.putSessionTableData(ctx, data)
 	return nil
 }
 
 // addColumnToSchema adds the given column to the schema and returns the new index
 func addColumnToSchema(ctx *sql.Context, data *TableData, newCol *sql.Column, order *sql.ColumnOrder) (int, *TableData, error) {
+	if err := data.decompressPartitions(ctx); err != nil {
+		return 0, nil, err
+	}
+
 	// TODO: might have wrong case
 	newCol.Source = data.tableName
 	newSch := make(sql.Schema, len(data.schema.Schema)+1)
@@ -1358,8 +1634,13 @@ func maxRowStorageSize(schema sql.Schema) int64 {
 }
 
 func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
+	if data.partitionScheme.IsPartitioned() && strings.EqualFold(data.partitionScheme.Column, columnName) {
+		return sql.ErrPartitionFieldNotFound.New()
+	}
+	if err := data.decompressPartitions(ctx); err != nil {
+		return err
+	}
 
 	droppedCol, data := dropColumnFromSchema(ctx, data, columnName)
 	for k, p := range data.partitions {
@@ -1372,8 +1653,11 @@ func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
 		}
 		data.partitions[k] = newP
 	}
+	if err := data.compressPartitions(ctx); err != nil {
+		return err
+	}
 
-	sess.putTable(data)
+	t.putSessionTableData(ctx, data)
 
 	return nil
 }
@@ -1406,8 +1690,10 @@ func dropColumnFromSchema(ctx *sql.Context, data *TableData, columnName string)
 }
 
 func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Column, order *sql.ColumnOrder) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
+	if err := data.decompressPartitions(ctx); err != nil {
+		return err
+	}
 
 	oldIdx := -1
 	newIdx := 0
@@ -1448,14 +1734,20 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 
 	// Virtual columns are not stored in partition rows, so skip the row rewrite for them
 	if !data.schema.Schema[oldIdx].Virtual {
-		for k, p := range data.partitions {
+		colCtx := ctx.WithContext(context.WithValue(ctx.Context, types.ColumnNameKey, columnName))
+		rowNumber := int64(0)
+		for _, key := range data.partitionKeys {
+			k := string(key)
+			p := data.partitions[k]
 			newP := make([]sql.Row, len(p))
 			for i, row := range p {
+				rowNumber++
 				var oldRowWithoutVal sql.Row
 				oldRowWithoutVal = append(oldRowWithoutVal, row[:oldIdx]...)
 				oldRowWithoutVal = append(oldRowWithoutVal, row[oldIdx+1:]...)
 				oldType := data.schema.Schema[oldIdx].Type
-				newVal, inRange, err := types.TypeAwareConversion(ctx, row[oldIdx], oldType, column.Type)
+				rowCtx := colCtx.WithContext(context.WithValue(colCtx.Context, types.RowNumberKey, rowNumber))
+				newVal, inRange, err := types.ConvertColumnValue(rowCtx, row[oldIdx], oldType, column.Type)
 				if err != nil {
 					if sql.ErrNotMatchingSRID.Is(err) {
 						err = sql.ErrNotMatchingSRIDWithColName.New(columnName, err)
@@ -1491,8 +1783,12 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 	newPkOrds := make([]int, len(data.schema.PkOrdinals))
 	for ord, col := range data.schema.Schema {
 		if col.PrimaryKey {
-			i := pkNameToOrdIdx[col.Name]
-			newPkOrds[i] = ord
+			name := col.Name
+			if name == column.Name {
+				// the modified column may have been renamed
+				name = columnName
+			}
+			newPkOrds[pkNameToOrdIdx[name]] = ord
 		}
 	}
 
@@ -1509,7 +1805,22 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		}
 	}
 
-	sess.putTable(data)
+	if err = data.convertIndexStorageColumn(ctx, column.Name, oldSch.Schema[oldIdx].Type); err != nil {
+		return err
+	}
+	if data.partitionScheme.IsPartitioned() && strings.EqualFold(data.partitionScheme.Column, columnName) {
+		// the partitions of rows are determined again by the converted values of the partition column
+		scheme := data.partitionScheme
+		scheme.Column = column.Name
+		if err = data.repartition(ctx, scheme, nil); err != nil {
+			return err
+		}
+	}
+	if err = data.compressPartitions(ctx); err != nil {
+		return err
+	}
+
+	t.putSessionTableData(ctx, data)
 
 	return nil
 }
@@ -1640,6 +1951,9 @@ type IndexedTable struct {
 var _ sql.StatisticsTable = (*IndexedTable)(nil)
 
 func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup) (sql.PartitionIter, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	memIdx := lookup.Index.(*Index)
 
 	if lookup.VectorOrderAndLimit.OrderBy != nil {
@@ -1894,8 +2208,7 @@ func (t *Table) GetReferencedForeignKeys(ctx *sql.Context) ([]sql.ForeignKeyCons
 
 // AddForeignKey implements sql.ForeignKeyTable. Foreign partitionKeys are not enforced on update / delete.
 func (t *Table) AddForeignKey(ctx *sql.Context, fk sql.ForeignKeyConstraint) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
 
 	lowerName := strings.ToLower(fk.Name)
 	for _, key := range data.fkColl.Keys() {
@@ -1910,8 +2223,7 @@ func (t *Table) AddForeignKey(ctx *sql.Context, fk sql.ForeignKeyConstraint) err
 
 // DropForeignKey implements sql.ForeignKeyTable.
 func (t *Table) DropForeignKey(ctx *sql.Context, fkName string, tableName string, schemaName string) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
 
 	if data.fkColl.DropFK(fkName) {
 		return nil
@@ -1922,8 +2234,7 @@ func (t *Table) DropForeignKey(ctx *sql.Context, fkName string, tableName string
 
 // UpdateForeignKey implements sql.ForeignKeyTable.
 func (t *Table) UpdateForeignKey(ctx *sql.Context, fkName string, fk sql.ForeignKeyConstraint) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
 
 	data.fkColl.DropFK(fkName)
 	lowerName := strings.ToLower(fk.Name)
@@ -1971,6 +2282,18 @@ func (t *Table) sessionTableData(ctx *sql.Context) *TableData {
 	return sess.tableData(t)
 }
 
+// putSessionTableData stores |data| as the session's data for this table. Tables that ignore session data have their
+// data replaced in place instead, so that every copy of the table sees the change.
+func (t *Table) putSessionTableData(ctx *sql.Context, data *TableData) {
+	if t.ignoreSessionData {
+		if data != t.data {
+			*t.data = *data
+		}
+		return
+	}
+	SessionFromContext(ctx).putTable(data)
+}
+
 // CreateCheck implements sql.CheckAlterableTable
 func (t *Table) CreateCheck(ctx *sql.Context, check *sql.CheckDefinition) error {
 	data := t.sessionTableData(ctx)
@@ -2068,8 +2391,7 @@ func (t *Table) createIndex(ctx *sql.Context, data *TableData, name string, colu
 
 // CreateIndex implements sql.IndexAlterableTable
 func (t *Table) CreateIndex(ctx *sql.Context, idx sql.IndexDef) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
 
 	if data.indexes == nil {
 		data.indexes = make(map[string]sql.Index)
@@ -2082,7 +2404,7 @@ func (t *Table) CreateIndex(ctx *sql.Context, idx sql.IndexDef) error {
 
 	// Store the computed index name in the case of an empty index name being passed in
 	data.indexes[strings.ToLower(index.ID())] = index
-	sess.putTable(data)
+	t.putSessionTableData(ctx, data)
 
 	return nil
 }
@@ -2126,8 +2448,7 @@ func (t *Table) RenameIndex(ctx *sql.Context, oldName string, newName string) er
 
 // CreateFulltextIndex implements fulltext.IndexAlterableTable
 func (t *Table) CreateFulltextIndex(ctx *sql.Context, indexDef sql.IndexDef, keyCols fulltext.KeyColumns, tableNames fulltext.IndexTableNames) error {
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
 
 	if len(data.fullTextConfigTableName) > 0 {
 		if data.fullTextConfigTableName != tableNames.Config {
@@ -2155,18 +2476,18 @@ func (t *Table) CreateFulltextIndex(ctx *sql.Context, indexDef sql.IndexDef, key
 
 	// TODO: We should store the computed index name in the case of an empty index name being passed in
 	data.indexes[strings.ToLower(index.ID())] = index
-	sess.putTable(data)
+	t.putSessionTableData(ctx, data)
 
 	return nil
 }
 
+// CreateVectorIndex implements vector.IndexAlterableTable
 func (t *Table) CreateVectorIndex(ctx *sql.Context, idx sql.IndexDef, distanceType vector.DistanceType) error {
 	if len(idx.Columns) > 1 {
 		return fmt.Errorf("vector indexes must have exactly one column")
 	}
 
-	sess := SessionFromContext(ctx)
-	data := sess.tableData(t)
+	data := t.sessionTableData(ctx)
 
 	if data.indexes == nil {
 		data.indexes = make(map[string]sql.Index)
@@ -2180,7 +2501,7 @@ func (t *Table) CreateVectorIndex(ctx *sql.Context, idx sql.IndexDef, distanceTy
 
 	// Store the computed index name in the case of an empty index name being passed in
 	data.indexes[strings.ToLower(index.ID())] = index
-	sess.putTable(data)
+	t.putSessionTableData(ctx, data)
 
 	return nil
 }
@@ -2300,18 +2621,18 @@ func (ps partitionssort) Swap(i, j int) {
 	// that turns the sort operation into worse than cubic. Doing better requires doing something more intelligent than
 	// sorted slices for rows and indexes, some sort of sorted collection.
 	for _, indexRows := range ps.indexes {
-		for _, idxRow := range indexRows {
+		for k, idxRow := range indexRows {
 			rowLoc := idxRow[len(idxRow)-1].(primaryRowLocation)
 			if rowLoc.partition == lidx.partitionName && rowLoc.idx == lidx.rowIdx {
-				idxRow[len(idxRow)-1] = primaryRowLocation{
+				indexRows[k] = withRowLocation(idxRow, primaryRowLocation{
 					partition: ridx.partitionName,
 					idx:       ridx.rowIdx,
-				}
+				})
 			} else if rowLoc.partition == ridx.partitionName && rowLoc.idx == ridx.rowIdx {
-				idxRow[len(idxRow)-1] = primaryRowLocation{
+				indexRows[k] = withRowLocation(idxRow, primaryRowLocation{
 					partition: lidx.partitionName,
 					idx:       lidx.rowIdx,
-				}
+				})
 			}
 		}
 	}
@@ -2430,7 +2751,16 @@ func isColumnDrop(oldSchema sql.PrimaryKeySchema, newSchema sql.PrimaryKeySchema
 	return len(oldSchema.Schema) > len(newSchema.Schema)
 }
 
-func (t *Table) RewriteInserter(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema, _, _ *sql.Column, idxCols []sql.IndexColumn) (sql.RowInserter, error) {
+func (t *Table) RewriteInserter(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema, oldColumn, newColumn *sql.Column, idxCols []sql.IndexColumn) (sql.RowInserter, error) {
+	// the partition column may be renamed, but not dropped
+	scheme := t.sessionTableData(ctx).partitionScheme
+	if scheme.IsPartitioned() && newSchema.Schema.IndexOfColName(scheme.Column) < 0 {
+		if oldColumn == nil || newColumn == nil || !strings.EqualFold(oldColumn.Name, scheme.Column) {
+			return nil, sql.ErrPartitionFieldNotFound.New()
+		}
+		scheme.Column = newColumn.Name
+	}
+
 	// TODO: this is insufficient: we need prevent dropping any index that is used by a primary key (or the engine does)
 	if isPrimaryKeyDrop(oldSchema, newSchema) {
 		err := sql.ValidatePrimaryKeyDrop(ctx, t, oldSchema)
@@ -2446,7 +2776,7 @@ func (t *Table) RewriteInserter(ctx *sql.Context, oldSchema, newSchema sql.Prima
 		}
 	}
 
-	return t.getRewriteTableEditor(ctx, oldSchema, newSchema), nil
+	return t.getRewriteTableEditor(ctx, oldSchema, newSchema, scheme), nil
 }
 
 func validatePrimaryKeyChange(ctx *sql.Context, oldSchema sql.PrimaryKeySchema, newSchema sql.PrimaryKeySchema, idxCols []sql.IndexColumn) error {
@@ -2549,7 +2879,7 @@ func (t *Table) BuildIndex(ctx *sql.Context, indexDef sql.IndexDef) (sql.RowInse
 	}
 
 	// For now we're just rewriting the entire table, but we could also just rewrite the index with a little work
-	return t.getRewriteTableEditor(ctx, data.schema, data.schema), nil
+	return t.getRewriteTableEditor(ctx, data.schema, data.schema, data.partitionScheme), nil
 }
 
 // TableRevision is a container for memory tables to run basic smoke tests for versioned queries. It overrides only
@@ -2585,6 +2915,9 @@ func (t *TableRevision) AddColumn(ctx *sql.Context, column *sql.Column, order *s
 	if err != nil {
 		return err
 	}
+	if err = data.compressPartitions(ctx); err != nil {
+		return err
+	}
 
 	t.data = data
 	return nil
@@ -48,6 +48,12 @@ type TableData struct {
 	autoIncVal              uint64
 	targetRowSize           uint64
 	collation               sql.CollationID
+	// compressed holds the partitions that are compressed with |codec|, which aren't in |partitions|
+	compressed map[string]*compressedPartition
+	codec      PartitionCodec
+	// partitionScheme is the partitioning of the table declared by PARTITION BY, whose partition names are the
+	// |partitionKeys| of a partitioned table. It's never changed in place, only replaced.
+	partitionScheme sql.PartitionScheme
 }
 
 type indexName string
@@ -80,6 +86,14 @@ func (td TableData) copy() *TableData {
 		parts[k] = data
 	}
 
+	if td.compressed != nil {
+		compressed := make(map[string]*compressedPartition, len(td.compressed))
+		for k, v := range td.compressed {
+			compressed[k] = v
+		}
+		td.compressed = compressed
+	}
+
 	keys := make([][]byte, len(td.partitionKeys))
 	for i := range td.partitionKeys {
 		keys[i] = make([]byte, len(td.partitionKeys[i]))
@@ -94,6 +108,14 @@ func (td TableData) copy() *TableData {
 	}
 	td.secondaryIndexStorage = idxStorage
 
+	if td.indexes != nil {
+		indexes := make(map[string]sql.Index, len(td.indexes))
+		for k, v := range td.indexes {
+			indexes[k] = v
+		}
+		td.indexes = indexes
+	}
+
 	td.partitionKeys, td.partitions = keys, parts
 
 	if td.checks != nil {
@@ -105,9 +127,14 @@ func (td TableData) copy() *TableData {
 	return &td
 }
 
-// partition returns the partition for the row given. Uses the primary key columns if they exist, or all columns
-// otherwise
+// partition returns the partition for the row given. Uses the partition scheme of a partitioned table, and the
+// primary key columns if they exist, or all columns otherwise, for other tables.
 func (td TableData) partition(ctx *sql.Context, row sql.Row) (int, error) {
+	if td.partitionScheme.IsPartitioned() {
+		idx := td.schema.Schema.IndexOfColName(td.partitionScheme.Column)
+		return td.partitionScheme.PartitionFor(ctx, td.schema.Schema[idx].Type, row[idx])
+	}
+
 	var keyColumns []int
 	if len(td.schema.PkOrdinals) > 0 {
 		keyColumns = td.schema.PkOrdinals
@@ -152,14 +179,22 @@ func (td *TableData) truncate(ctx *sql.Context, schema sql.PrimaryKeySchema) *Ta
 	var partitions = map[string][]sql.Row{}
 	numParts := len(td.partitionKeys)
 
-	for i := 0; i < numParts; i++ {
-		key := strconv.Itoa(i)
-		keys = append(keys, []byte(key))
-		partitions[key] = []sql.Row{}
+	if td.partitionScheme.IsPartitioned() {
+		for _, def := range td.partitionScheme.Partitions {
+			keys = append(keys, []byte(def.Name))
+			partitions[def.Name] = []sql.Row{}
+		}
+	} else {
+		for i := 0; i < numParts; i++ {
+			key := strconv.Itoa(i)
+			keys = append(keys, []byte(key))
+			partitions[key] = []sql.Row{}
+		}
 	}
 
 	td.partitionKeys = keys
 	td.partitions = partitions
+	td.compressed = nil
 	td.schema = schema
 
 	td.indexes = rewriteIndexes(ctx, td.indexes, schema)
@@ -261,6 +296,9 @@ func (td *TableData) numRows(ctx *sql.Context) (uint64, error) {
 	for _, rows := range td.partitions {
 		count += uint64(len(rows))
 	}
+	for _, p := range td.compressed {
+		count += uint64(p.rows)
+	}
 
 	return count, nil
 }
@@ -278,11 +316,15 @@ func (td *TableData) errIfDuplicateEntryExist(ctx *sql.Context, cols []string, i
 		}
 	}
 
+	if err != nil {
+		return err
+	}
+	partitions, err := td.allPartitions(ctx)
 	if err != nil {
 		return err
 	}
 	unique := make(map[uint64]struct{})
-	for _, partition := range td.partitions {
+	for _, partition := range partitions {
 		for _, row := range partition {
 			idxPrefixKey := projectOnRow(columnMapping, row)
 			if hasNulls(idxPrefixKey) {
@@ -293,7 +335,7 @@ func (td *TableData) errIfDuplicateEntryExist(ctx *sql.Context, cols []string, i
 				return err
 			}
 			if _, ok := unique[h]; ok {
-				return sql.NewUniqueKeyErr(formatRow(row, columnMapping), false, nil)
+				return sql.NewDuplicateKeyErr(td.tableName, idxName, projectOnRow(columnMapping, row), false, nil)
 			}
 			unique[h] = struct{}{}
 		}
@@ -372,7 +414,7 @@ func (td *TableData) indexColsForTableEditor() ([][]int, [][]uint16, []string) {
 		}
 		uniqIdxCols = append(uniqIdxCols, colIdxs)
 		prefixLengths = append(prefixLengths, idx.PrefixLengths())
-		idxNames = append(idxNames, strings.ToLower(idx.ID()))
+		idxNames = append(idxNames, idx.ID())
 	}
 	return uniqIdxCols, prefixLengths, idxNames
 }
@@ -393,52 +435,37 @@ func (td *TableData) sortRows(ctx *sql.Context) {
 		for i := 0; i < len(p); i++ {
 			flattenedRows = append(flattenedRows, partitionRow{string(k), i})
 		}
+		if td.partitionScheme.IsPartitioned() {
+			// the rows of a partitioned table are sorted within the partition the scheme assigns them
+			td.sortPartitionRows(ctx, pk, flattenedRows)
+			flattenedRows = nil
+		}
 	}
+	td.sortPartitionRows(ctx, pk, flattenedRows)
+}
 
+func (td *TableData) sortPartitionRows(ctx *sql.Context, pk []pkfield, rows []partitionRow) {
 	sort.Sort(partitionssort{
 		pk:      pk,
 		ps:      td.partitions,
-		allRows: flattenedRows,
+		allRows: rows,
 		indexes: td.secondaryIndexStorage,
 		ctx:     ctx,
 	})
-
-	td.sortSecondaryIndexes(ctx)
 }
 
+// sortSecondaryIndexes restores the key order of every secondary index's storage, for changes that modify the stored
+// values in place rather than through inserts and deletes.
 func (td *TableData) sortSecondaryIndexes(ctx *sql.Context) {
 	for idxName, idxStorage := range td.secondaryIndexStorage {
 		idx := td.indexes[strings.ToLower(string(idxName))].(*Index)
-		fieldIndexes := idx.columnIndexes(td.schema.Schema)
-		types := make([]sql.Type, len(fieldIndexes))
-		for i, idx := range fieldIndexes {
-			types[i] = td.schema.Schema[idx].Type
-		}
+		typs := td.indexStorageTypes(idx)
 		sort.SliceStable(idxStorage, func(i, j int) bool {
-			for t, typ := range types {
-				left := idxStorage[i][t]
-				right := idxStorage[j][t]
-
-				// Compare doesn't handle nil values, so we need to handle that case. Nils sort before other values
-				if left == nil {
-					if right == nil {
-						continue
-					} else {
-						return true
-					}
-				} else if right == nil {
-					return false
-				}
-
-				compare, err := typ.Compare(ctx, left, right)
-				if err != nil {
-					panic(err)
-				}
-				if compare != 0 {
-					return compare < 0
-				}
+			cmp, err := compareIndexStorageRows(ctx, typs, idxStorage[i], idxStorage[j])
+			if err != nil {
+				panic(err)
 			}
-			return false
+			return cmp < 0
 		})
 	}
 }
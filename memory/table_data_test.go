@@ -37,18 +37,17 @@ func TestTableDataSecondaryIndexDeterminism(t *testing.T) {
 	var expectedPkOrder0 []int64
 	var expectedPkOrder1 []int64
 
-	// Insert 100 rows with ties on (c0, c1) but different pk.
+	// Insert 100 rows with ties on (c0, c1) but different pk, in descending pk order.
 	// We interleave (0,0) and (1,1) so that the sorting algorithm has to move elements.
-	// Unstable sort (like pdqsort in sort.Slice) will scramble the relative order
-	// of tied elements during partitioning.
-	// Stable sort will preserve the insertion order perfectly.
+	// Index rows with tied values are ordered by the primary key that follows the indexed
+	// columns, regardless of insertion order.
 	for i := 0; i < 50; i++ {
 		pk0 := int64(1000 - i)
-		expectedPkOrder0 = append(expectedPkOrder0, pk0)
+		expectedPkOrder0 = append([]int64{pk0}, expectedPkOrder0...)
 		require.NoError(table.Insert(ctx, sql.Row{int64(0), int64(0), pk0}))
 
 		pk1 := int64(2000 - i)
-		expectedPkOrder1 = append(expectedPkOrder1, pk1)
+		expectedPkOrder1 = append([]int64{pk1}, expectedPkOrder1...)
 		require.NoError(table.Insert(ctx, sql.Row{int64(1), int64(1), pk1}))
 	}
 
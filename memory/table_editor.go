@@ -37,6 +37,13 @@ type tableEditor struct {
 	prefixLengths  [][]uint16
 	uniqueIdxNames []string
 	discardChanges bool
+	// readVersion is the committed data the session data of the table was based on when |initialTable| was copied,
+	// in the transaction of session generation |generation|
+	readVersion *TableData
+	generation  uint64
+	// rewrittenData is the data of the table being rewritten, for rewrites of tables that ignore session data. The
+	// rewritten data replaces it when the editor is closed.
+	rewrittenData *TableData
 }
 
 var _ sql.Table = (*tableEditor)(nil)
@@ -89,8 +96,7 @@ func (t *tableEditor) Close(ctx *sql.Context) error {
 		sess = SessionFromContext(ctx)
 
 		if t.discardChanges {
-			sess.putTable(t.initialTable.data)
-			return nil
+			return sess.restoreTable(ctx, t.editedTable.db, t.initialTable.data, t.readVersion, t.generation)
 		}
 	} else {
 		if t.discardChanges {
@@ -109,6 +115,8 @@ func (t *tableEditor) Close(ctx *sql.Context) error {
 
 	if !t.editedTable.IgnoreSessionData() {
 		sess.putTable(t.editedTable.data)
+	} else if t.rewrittenData != nil {
+		*t.rewrittenData = *t.editedTable.data
 	}
 
 	return nil
@@ -116,11 +124,26 @@ func (t *tableEditor) Close(ctx *sql.Context) error {
 
 func (t *tableEditor) StatementBegin(ctx *sql.Context) {
 	t.initialTable = t.editedTable.copy()
+	t.recordReadVersion(ctx)
+}
+
+// recordReadVersion records the committed data the session data of the table is currently based on.
+func (t *tableEditor) recordReadVersion(ctx *sql.Context) {
+	if t.editedTable.IgnoreSessionData() {
+		return
+	}
+	sess := SessionFromContext(ctx)
+	t.readVersion = sess.readVersions[key(t.editedTable.data)]
+	t.generation = sess.generation
 }
 
 func (t *tableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
 	t.ea.Clear()
 	if _, ignore := errorEncountered.(sql.IgnorableError); !ignore {
+		if t.editedTable.IgnoreSessionData() && t.rewrittenData == nil {
+			// the edits were applied to the table's own data, which other copies of the table share
+			*t.ea.TableData() = *t.initialTable.data.copy()
+		}
 		t.editedTable.replaceData(t.initialTable.data)
 		t.discardChanges = true
 	}
@@ -147,6 +170,10 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	if err := checkRow(ctx, t.editedTable.data.schema.Schema, row); err != nil {
 		return err
 	}
+	// rows that belong to no partition are rejected before any edit is accumulated
+	if _, err := t.ea.TableData().partition(ctx, row); err != nil {
+		return err
+	}
 
 	partitionRow, added, err := t.ea.Get(row)
 	if err != nil {
@@ -155,7 +182,7 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 
 	if added {
 		pkColIdxes := t.pkColumnIndexes()
-		return sql.NewUniqueKeyErr(formatRow(row, pkColIdxes), true, partitionRow)
+		return sql.NewDuplicateKeyErr(t.editedTable.Name(), "PRIMARY", projectOnRow(pkColIdxes, row), true, partitionRow)
 	}
 
 	if err := t.checkUniqueConstraints(ctx, row); err != nil {
@@ -196,6 +223,10 @@ func (t *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
 		return err
 	}
 
+	if err := lockRowForWrite(ctx, t.editedTable, t.editedTable.data, row); err != nil {
+		return err
+	}
+
 	err := t.ea.Delete(ctx, row)
 	if err != nil {
 		return err
@@ -212,6 +243,12 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	if err := checkRow(ctx, t.editedTable.Schema(ctx), newRow); err != nil {
 		return err
 	}
+	if _, err := t.ea.TableData().partition(ctx, newRow); err != nil {
+		return err
+	}
+	if err := lockRowForWrite(ctx, t.editedTable, t.editedTable.data, oldRow); err != nil {
+		return err
+	}
 
 	err := t.ea.Delete(ctx, oldRow)
 	if err != nil {
@@ -226,11 +263,7 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 
 		if added {
 			pkColIdxes := t.pkColumnIndexes()
-			vals := make([]interface{}, len(pkColIdxes))
-			for i := range pkColIdxes {
-				vals[i] = newRow[pkColIdxes[i]]
-			}
-			return sql.NewUniqueKeyErr(fmt.Sprint(vals), true, partitionRow)
+			return sql.NewDuplicateKeyErr(t.editedTable.Name(), "PRIMARY", projectOnRow(pkColIdxes, newRow), true, partitionRow)
 		}
 	}
 
@@ -247,6 +280,24 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	return nil
 }
 
+// UpdateJSON implements the interface sql.JSONUpdater. The JSON documents that are written are built by applying the
+// diffs to the documents in the old row, so that tests catch any diffs that don't match the new row.
+func (t *tableEditor) UpdateJSON(ctx *sql.Context, oldRow sql.Row, newRow sql.Row, diffs map[int][]sql.JSONDiff) error {
+	newRow = newRow.Copy()
+	for i, colDiffs := range diffs {
+		doc, ok := oldRow[i].(sql.JSONWrapper)
+		if !ok {
+			return fmt.Errorf("cannot apply JSON diffs to column %s of type %T", t.editedTable.Schema(ctx)[i].Name, oldRow[i])
+		}
+		updated, err := types.ApplyJSONDiffs(ctx, doc, colDiffs)
+		if err != nil {
+			return err
+		}
+		newRow[i] = updated
+	}
+	return t.Update(ctx, oldRow, newRow)
+}
+
 // SetAutoIncrementValue sets a new AUTO_INCREMENT value
 func (t *tableEditor) SetAutoIncrementValue(ctx *sql.Context, val uint64) error {
 	t.editedTable.data.autoIncVal = val
@@ -289,7 +340,7 @@ func (t *tableEditor) checkUniqueConstraints(ctx *sql.Context, row sql.Row) erro
 			return err
 		}
 		if found {
-			return sql.NewUniqueKeyErr(formatRow(row, cols), false, existing)
+			return sql.NewDuplicateKeyErr(t.editedTable.Name(), t.uniqueIdxNames[i], projectOnRow(cols, row), false, existing)
 		}
 	}
 	return nil
@@ -546,6 +597,9 @@ func (pke *pkTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefixLe
 
 // ApplyEdits implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) ApplyEdits(ctx *sql.Context, table *Table) error {
+	if err := pke.tableData.decompressPartitions(ctx); err != nil {
+		return err
+	}
 
 	if err := pke.deletes.Foreach(func(key string, val sql.Row) error {
 		return pke.deleteHelper(ctx, pke.tableData, val)
@@ -564,7 +618,7 @@ func (pke *pkTableEditAccumulator) ApplyEdits(ctx *sql.Context, table *Table) er
 	pke.tableData.sortRows(ctx)
 	table.replaceData(pke.tableData)
 
-	return nil
+	return table.data.compressPartitions(ctx)
 }
 
 // Clear implements the tableEditAccumulator interface.
@@ -650,13 +704,21 @@ func deleteRowFromIndexes(table *TableData, partKey string, rowIdx int) {
 				idxStorage = append(idxStorage[:i], idxStorage[i+1:]...)
 			} else if rowLoc.partition == partKey && rowLoc.idx > rowIdx {
 				// For rows after the one we deleted, offset the row index by -1
-				idxRow[len(idxRow)-1] = primaryRowLocation{rowLoc.partition, rowLoc.idx - 1}
+				idxStorage[i] = withRowLocation(idxRow, primaryRowLocation{rowLoc.partition, rowLoc.idx - 1})
 			}
 		}
 		table.secondaryIndexStorage[indexName(memIdx.ID())] = idxStorage
 	}
 }
 
+// withRowLocation returns a copy of the index row given pointing to a new location. Index rows are shared with
+// snapshots of the table data read by other transactions, so they're never modified in place.
+func withRowLocation(idxRow sql.Row, loc primaryRowLocation) sql.Row {
+	newRow := idxRow.Copy()
+	newRow[len(newRow)-1] = loc
+	return newRow
+}
+
 // insertHelper inserts the given row into the given tableData.
 func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *TableData, row sql.Row) error {
 	partIdx, err := table.partition(ctx, row)
@@ -696,7 +758,7 @@ func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *TableDa
 		rowIdx = len(table.partitions[key]) - 1
 	}
 
-	err = addRowToIndexes(table, row, partKey, rowIdx)
+	err = addRowToIndexes(ctx, table, row, partKey, rowIdx)
 	if err != nil {
 		return err
 	}
@@ -704,15 +766,19 @@ func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *TableDa
 	return nil
 }
 
-// addRowToIndexes adds the given row to all indexes
-func addRowToIndexes(table *TableData, row sql.Row, partKey string, rowIdx int) error {
+// addRowToIndexes adds the given row to all indexes, keeping each index in key order
+func addRowToIndexes(ctx *sql.Context, table *TableData, row sql.Row, partKey string, rowIdx int) error {
 	for _, idx := range table.indexes {
 		memIdx := idx.(*Index)
-		idxRow, err := memIdx.rowToIndexStorage(row, partKey, rowIdx)
+		idxRow, err := memIdx.rowToIndexStorage(table, row, partKey, rowIdx)
 		if err != nil {
 			return err
 		}
-		table.secondaryIndexStorage[indexName(memIdx.ID())] = append(table.secondaryIndexStorage[indexName(memIdx.ID())], idxRow)
+		storage, err := insertIndexStorageRow(ctx, table.indexStorageTypes(memIdx), table.secondaryIndexStorage[indexName(memIdx.ID())], idxRow)
+		if err != nil {
+			return err
+		}
+		table.secondaryIndexStorage[indexName(memIdx.ID())] = storage
 	}
 	return nil
 }
@@ -807,6 +873,10 @@ func (k *keylessTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefi
 
 // ApplyEdits implements the tableEditAccumulator interface.
 func (k *keylessTableEditAccumulator) ApplyEdits(ctx *sql.Context, table *Table) error {
+	if err := k.tableData.decompressPartitions(ctx); err != nil {
+		return err
+	}
+
 	for _, val := range k.deletes {
 		err := k.deleteHelper(ctx, k.tableData, val)
 		if err != nil {
@@ -821,11 +891,8 @@ func (k *keylessTableEditAccumulator) ApplyEdits(ctx *sql.Context, table *Table)
 		}
 	}
 
-	// The primary index is unsorted, but we still need to sort the secondary indexes
-	k.tableData.sortSecondaryIndexes(ctx)
-
 	table.replaceData(k.tableData)
-	return nil
+	return table.data.compressPartitions(ctx)
 }
 
 // Clear implements the tableEditAccumulator interface.
@@ -882,7 +949,7 @@ func (k *keylessTableEditAccumulator) insertHelper(ctx *sql.Context, table *Tabl
 	storageRow := k.tableData.toStorageRow(row)
 	table.partitions[key] = append(table.partitions[key], storageRow)
 
-	err = addRowToIndexes(table, row, key, len(table.partitions[key])-1)
+	err = addRowToIndexes(ctx, table, row, key, len(table.partitions[key])-1)
 	if err != nil {
 		return err
 	}
@@ -890,20 +957,6 @@ func (k *keylessTableEditAccumulator) insertHelper(ctx *sql.Context, table *Tabl
 	return nil
 }
 
-func formatRow(r sql.Row, idxs []int) string {
-	b := &strings.Builder{}
-	b.WriteString("[")
-	var seenOne bool
-	for _, idx := range idxs {
-		if seenOne {
-			_, _ = fmt.Fprintf(b, ",")
-		}
-		_, _ = fmt.Fprintf(b, "%v", r[idx])
-	}
-	b.WriteString("]")
-	return b.String()
-}
-
 func checkRow(ctx *sql.Context, schema sql.Schema, row sql.Row) error {
 	for i, value := range row {
 		c := schema[i]
@@ -928,6 +981,10 @@ func verifyRowTypes(row sql.Row, schema sql.Schema) error {
 				valType = reflect.TypeOf(rowVal)
 			}
 			expectedType := col.Type.ValueType()
+			if _, isDateParts := rowVal.(types.DateParts); isDateParts && (types.IsDateType(col.Type) || types.IsDatetimeType(col.Type)) {
+				// dates the sql_mode allows but a time.Time can't hold are stored as their parts
+				continue
+			}
 			if valType != expectedType && rowVal != nil && !valType.AssignableTo(expectedType) {
 				return fmt.Errorf("Actual Value Type: %s, Expected Value Type: %s", valType.String(), expectedType.String())
 			}
@@ -347,6 +347,73 @@ func TestTable(t *testing.T) {
 	}
 }
 
+func TestTableCanceledContext(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	test := tests[0]
+	table := memory.NewPartitionedTable(ctx, db.BaseDatabase, test.name, test.schema, nil, test.numPartitions)
+	for _, row := range test.rows {
+		require.NoError(table.Insert(ctx, row))
+	}
+
+	pIter, err := table.Partitions(ctx)
+	require.NoError(err)
+	p, err := pIter.Next(ctx)
+	require.NoError(err)
+	iter, err := table.PartitionRows(ctx, p)
+	require.NoError(err)
+	_, err = iter.Next(ctx)
+	require.NoError(err)
+
+	canceledCtx, cancel := ctx.NewSubContext()
+	cancel()
+
+	_, err = pIter.Next(canceledCtx)
+	require.ErrorIs(err, context.Canceled)
+	_, err = table.PartitionRows(canceledCtx, p)
+	require.ErrorIs(err, context.Canceled)
+	_, err = table.Partitions(canceledCtx)
+	require.ErrorIs(err, context.Canceled)
+}
+
+func TestTableScanCanceledContext(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("db")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	sch := sql.NewPrimaryKeySchema(sql.Schema{{Name: "pk", Type: types.Int64, Source: "test", PrimaryKey: true}})
+	table := memory.NewPartitionedTable(ctx, db.BaseDatabase, "test", sch, nil, 1)
+	const numRows = 5000
+	for i := 0; i < numRows; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(int64(i))))
+	}
+
+	pIter, err := table.Partitions(ctx)
+	require.NoError(err)
+	p, err := pIter.Next(ctx)
+	require.NoError(err)
+	iter, err := table.PartitionRows(ctx, p)
+	require.NoError(err)
+	_, err = iter.Next(ctx)
+	require.NoError(err)
+
+	// cancellation isn't checked for every row, but a scan must stop long before reading the rest of the table
+	canceledCtx, cancel := ctx.NewSubContext()
+	cancel()
+	read := 0
+	for ; read < numRows; read++ {
+		if _, err = iter.Next(canceledCtx); err != nil {
+			break
+		}
+	}
+	require.ErrorIs(err, context.Canceled)
+	require.Less(read, numRows/2)
+}
+
 func TestFiltered(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
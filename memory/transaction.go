@@ -0,0 +1,368 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/hash"
+)
+
+// Committed table data is never modified in place: each commit installs a new version of the data of every table it
+// changed, and transactions read the versions that were committed when they took their snapshot. Rows are immutable
+// and shared between versions, so the rows a transaction changed are the ones whose identity differs between the
+// version it read and the version it wrote. At commit, the changes of a transaction are checked against the changes
+// other transactions committed to the same tables in the meantime, and merged into them when they don't conflict.
+
+// commitMu serializes commits, so that checking for conflicts and installing the committed data is atomic.
+var commitMu sync.Mutex
+
+// tableCommit is the new committed data of a table in a transaction being committed.
+type tableCommit struct {
+	db   *BaseDatabase
+	key  tableKey
+	data *TableData
+}
+
+// tableChanges are the differences between two versions of a table's data.
+type tableChanges struct {
+	definition    bool
+	autoIncrement bool
+	deleted       []sql.Row
+	inserted      []sql.Row
+}
+
+func (c tableChanges) isEmpty() bool {
+	return !c.definition && !c.autoIncrement && len(c.deleted) == 0 && len(c.inserted) == 0
+}
+
+// rowIdentity identifies a row by its backing array, which is shared by every version of the table containing it.
+// Empty rows, stored for tables with only virtual columns, can't be told apart and share an identity.
+func rowIdentity(row sql.Row) *interface{} {
+	if len(row) == 0 {
+		return nil
+	}
+	return &row[0]
+}
+
+// prepareTableCommit returns the data to commit for a table the transaction read at |readVersion| and changed to
+// |working|, or nil if there's nothing to commit. A nil |readVersion| means the table was created by the transaction.
+func prepareTableCommit(ctx *sql.Context, db *BaseDatabase, readVersion, working *TableData) (*TableData, error) {
+	if readVersion == nil {
+		return working, nil
+	}
+
+	current, ok := db.committedTableData()[strings.ToLower(working.tableName)]
+	if ok && current == readVersion {
+		return working, nil
+	}
+
+	// Another transaction committed changes to the table since it was read. Tables this transaction only read are
+	// left alone rather than overwriting those changes.
+	ours, err := diffTableData(ctx, readVersion, working)
+	if err != nil {
+		return nil, err
+	}
+	if ours.isEmpty() {
+		return nil, nil
+	}
+	if !ok {
+		return nil, errWriteConflict(working.tableName, "the table was dropped")
+	}
+
+	return mergeTableData(ctx, db, readVersion, current, working, ours)
+}
+
+// rebaseTableData returns the changes a transaction made to a table it read at |from|, which it changed to |working|,
+// applied on top of |to|, a later committed version of the table.
+func rebaseTableData(ctx *sql.Context, db *BaseDatabase, from, to, working *TableData) (*TableData, error) {
+	ours, err := diffTableData(ctx, from, working)
+	if err != nil {
+		return nil, err
+	}
+	if ours.isEmpty() {
+		return to.copy(), nil
+	}
+	return mergeTableData(ctx, db, from, to, working, ours)
+}
+
+// mergeTableData applies the changes a transaction made to a table on top of the changes other transactions committed
+// since it read the table.
+func mergeTableData(ctx *sql.Context, db *BaseDatabase, readVersion, current, working *TableData, ours tableChanges) (*TableData, error) {
+	theirs, err := diffTableData(ctx, readVersion, current)
+	if err != nil {
+		return nil, err
+	}
+	if ours.definition || theirs.definition {
+		return nil, errWriteConflict(working.tableName, "the table definition was changed")
+	}
+	if len(working.virtualColIndexes()) > 0 && (len(ours.deleted) > 0 || len(ours.inserted) > 0) {
+		return nil, errWriteConflict(working.tableName, "rows of tables with virtual columns can't be merged")
+	}
+
+	conflict, err := changesOverlap(ctx, readVersion, ours, theirs)
+	if err != nil {
+		return nil, err
+	}
+	if conflict {
+		return nil, errWriteConflict(working.tableName, "a row was changed by another transaction")
+	}
+
+	merged := current.copy()
+	ea := newTableEditAccumulator(merged)
+	for _, row := range ours.deleted {
+		if err = ea.Delete(ctx, row); err != nil {
+			return nil, err
+		}
+	}
+	for _, row := range ours.inserted {
+		if err = ea.Insert(ctx, row); err != nil {
+			return nil, err
+		}
+	}
+	table := merged.Table(db)
+	if err = ea.ApplyEdits(ctx, table); err != nil {
+		return nil, err
+	}
+	merged = table.data
+
+	if working.autoIncVal > merged.autoIncVal {
+		merged.autoIncVal = working.autoIncVal
+	}
+
+	// Rows inserted by different transactions may have the same unique key
+	for _, idx := range merged.indexes {
+		memIdx := idx.(*Index)
+		if !memIdx.IsUnique() {
+			continue
+		}
+		var colNames []string
+		for _, col := range merged.indexStorageColumns(memIdx)[:len(memIdx.Exprs)] {
+			colNames = append(colNames, col.Name)
+		}
+		if err = merged.errIfDuplicateEntryExist(ctx, colNames, memIdx.ID()); err != nil {
+			return nil, errWriteConflict(working.tableName, "a row with the same unique key was inserted by another transaction")
+		}
+	}
+
+	return merged, nil
+}
+
+// changesOverlap returns whether two sets of changes to the same version of a table changed the same row. Rows of
+// tables with a primary key are identified by their key, and rows of keyless tables by their identity, so that
+// concurrent inserts into a keyless table never conflict.
+func changesOverlap(ctx *sql.Context, td *TableData, ours, theirs tableChanges) (bool, error) {
+	if len(td.schema.PkOrdinals) == 0 {
+		deleted := make(map[*interface{}]struct{}, len(theirs.deleted))
+		for _, row := range theirs.deleted {
+			deleted[rowIdentity(row)] = struct{}{}
+		}
+		for _, row := range ours.deleted {
+			if _, ok := deleted[rowIdentity(row)]; ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	changedKeys := make(map[uint64]struct{})
+	for _, rows := range [][]sql.Row{theirs.deleted, theirs.inserted} {
+		for _, row := range rows {
+			key, err := td.primaryKeyHash(ctx, row)
+			if err != nil {
+				return false, err
+			}
+			changedKeys[key] = struct{}{}
+		}
+	}
+	for _, rows := range [][]sql.Row{ours.deleted, ours.inserted} {
+		for _, row := range rows {
+			key, err := td.primaryKeyHash(ctx, row)
+			if err != nil {
+				return false, err
+			}
+			if _, ok := changedKeys[key]; ok {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// primaryKeyHash returns a hash of the primary key of the storage row given.
+func (td *TableData) primaryKeyHash(ctx *sql.Context, row sql.Row) (uint64, error) {
+	physical := td.schema.PhysicalSchema()
+	keySch := make(sql.Schema, len(td.schema.PkOrdinals))
+	key := make(sql.Row, len(td.schema.PkOrdinals))
+	for i, ord := range td.schema.PkOrdinals {
+		col := td.schema.Schema[ord]
+		keySch[i] = col
+		key[i] = row[physical.IndexOfColName(col.Name)]
+	}
+	return hash.HashOf(ctx, keySch, key)
+}
+
+// diffTableData returns the changes between two versions of a table's data.
+func diffTableData(ctx *sql.Context, from, to *TableData) (tableChanges, error) {
+	changes := tableChanges{
+		definition:    tableDefinitionChanged(from, to),
+		autoIncrement: from.autoIncVal != to.autoIncVal,
+	}
+	if changes.definition {
+		return changes, nil
+	}
+
+	fromRows, toRows, err := changedPartitionRows(ctx, from, to)
+	if err != nil {
+		return tableChanges{}, err
+	}
+
+	// Counts of the rows of |from| with each identity not yet matched to a row of |to|
+	unmatched := make(map[*interface{}]int)
+	for _, row := range fromRows {
+		unmatched[rowIdentity(row)]++
+	}
+	for _, row := range toRows {
+		id := rowIdentity(row)
+		if unmatched[id] > 0 {
+			unmatched[id]--
+		} else {
+			changes.inserted = append(changes.inserted, row)
+		}
+	}
+	for _, row := range fromRows {
+		id := rowIdentity(row)
+		if unmatched[id] > 0 {
+			unmatched[id]--
+			changes.deleted = append(changes.deleted, row)
+		}
+	}
+
+	if len(from.compressed) == 0 && len(to.compressed) == 0 {
+		return changes, nil
+	}
+	// Decompressing a partition gives its rows new identities, so rows that didn't match by identity are matched by
+	// value instead
+	return matchChangesByValue(ctx, to.schema.PhysicalSchema(), changes)
+}
+
+// changedPartitionRows returns the rows of the partitions of two versions of a table's data that may differ, in
+// partition order. Partitions that are compressed identically in both versions are left out.
+func changedPartitionRows(ctx *sql.Context, from, to *TableData) ([]sql.Row, []sql.Row, error) {
+	fromRows, err := partitionRowsNotSharedWith(ctx, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	toRows, err := partitionRowsNotSharedWith(ctx, to, from)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fromRows, toRows, nil
+}
+
+// partitionRowsNotSharedWith returns the rows of the partitions of |td| that aren't compressed identically in |other|.
+func partitionRowsNotSharedWith(ctx *sql.Context, td, other *TableData) ([]sql.Row, error) {
+	var rows []sql.Row
+	for _, partKey := range td.partitionKeys {
+		key := string(partKey)
+		if p, ok := td.compressed[key]; ok && other.compressed[key] == p {
+			continue
+		}
+		partition, _, err := td.partitionRows(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, partition...)
+	}
+	return rows, nil
+}
+
+// matchChangesByValue removes the rows that were both deleted and inserted from the changes given, matching rows by
+// their values.
+func matchChangesByValue(ctx *sql.Context, sch sql.Schema, changes tableChanges) (tableChanges, error) {
+	deleted := make(map[uint64]int, len(changes.deleted))
+	for _, row := range changes.deleted {
+		h, err := hash.HashOf(ctx, sch, row)
+		if err != nil {
+			return tableChanges{}, err
+		}
+		deleted[h]++
+	}
+
+	inserted := make(map[uint64]int)
+	var newInserted []sql.Row
+	for _, row := range changes.inserted {
+		h, err := hash.HashOf(ctx, sch, row)
+		if err != nil {
+			return tableChanges{}, err
+		}
+		if deleted[h] > 0 {
+			deleted[h]--
+			inserted[h]++
+		} else {
+			newInserted = append(newInserted, row)
+		}
+	}
+
+	var newDeleted []sql.Row
+	for _, row := range changes.deleted {
+		h, err := hash.HashOf(ctx, sch, row)
+		if err != nil {
+			return tableChanges{}, err
+		}
+		if inserted[h] > 0 {
+			inserted[h]--
+		} else {
+			newDeleted = append(newDeleted, row)
+		}
+	}
+
+	changes.inserted, changes.deleted = newInserted, newDeleted
+	return changes, nil
+}
+
+// tableDefinitionChanged returns whether the schema, indexes or other definitions of a table differ between two
+// versions of its data.
+func tableDefinitionChanged(from, to *TableData) bool {
+	if from.tableName != to.tableName ||
+		from.comment != to.comment ||
+		from.collation != to.collation ||
+		from.fullTextConfigTableName != to.fullTextConfigTableName ||
+		from.codec != to.codec ||
+		!reflect.DeepEqual(from.schema.Schema, to.schema.Schema) ||
+		!slices.Equal(from.schema.PkOrdinals, to.schema.PkOrdinals) ||
+		!reflect.DeepEqual(from.checks, to.checks) ||
+		!reflect.DeepEqual(from.partitionScheme, to.partitionScheme) ||
+		len(from.indexes) != len(to.indexes) {
+		return true
+	}
+
+	for name, idx := range from.indexes {
+		other, ok := to.indexes[name]
+		if !ok || idx.IsUnique() != other.IsUnique() || !slices.Equal(idx.Expressions(), other.Expressions()) {
+			return true
+		}
+	}
+	return false
+}
+
+func errWriteConflict(tableName, reason string) error {
+	return sql.ErrLockDeadlock.New(fmt.Sprintf("write conflict on table %s: %s", tableName, reason))
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// transactionEngine returns an engine over a database named mydb holding the table t, and two sessions on it.
+func transactionEngine(t *testing.T) (*sqle.Engine, *sql.Context, *sql.Context) {
+	pro := memory.NewDBProvider(memory.NewDatabase("mydb"))
+	e := sqle.NewDefault(pro)
+	a, b := newContext(pro), newContext(pro)
+	a.SetCurrentDatabase("mydb")
+	b.SetCurrentDatabase("mydb")
+	runQuery(t, e, a, "create table t (pk int primary key, v int, unique key (v))")
+	runQuery(t, e, a, "create table keyless (v int)")
+	runQuery(t, e, a, "insert into t values (1, 1), (2, 2), (3, 3)")
+	return e, a, b
+}
+
+func queryErr(e *sqle.Engine, ctx *sql.Context, query string) error {
+	_, iter, _, err := e.Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	_, err = sql.RowIterToRows(ctx, iter)
+	return err
+}
+
+func TestTransactionSnapshotReads(t *testing.T) {
+	e, a, b := transactionEngine(t)
+
+	runQuery(t, e, a, "start transaction")
+	require.Equal(t, []sql.Row{{int64(3)}}, runQuery(t, e, a, "select count(*) from t"))
+
+	runQuery(t, e, b, "insert into t values (4, 4)")
+	runQuery(t, e, b, "update t set v = 10 where pk = 1")
+
+	// a keeps reading the state committed when its transaction started, and sees its own changes
+	require.Equal(t, []sql.Row{{int64(3)}}, runQuery(t, e, a, "select count(*) from t"))
+	require.Equal(t, []sql.Row{{int32(1)}}, runQuery(t, e, a, "select v from t where pk = 1"))
+	runQuery(t, e, a, "delete from t where pk = 3")
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(2)}}, runQuery(t, e, a, "select pk from t order by pk"))
+	runQuery(t, e, a, "commit")
+
+	// the changes of both transactions were committed
+	require.Equal(t,
+		[]sql.Row{{int32(1), int32(10)}, {int32(2), int32(2)}, {int32(4), int32(4)}},
+		runQuery(t, e, a, "select * from t order by pk"))
+}
+
+func TestTransactionMerge(t *testing.T) {
+	e, a, b := transactionEngine(t)
+
+	runQuery(t, e, a, "start transaction")
+	runQuery(t, e, b, "start transaction")
+	runQuery(t, e, a, "update t set v = 11 where pk = 1")
+	runQuery(t, e, a, "insert into keyless values (1)")
+	runQuery(t, e, b, "update t set v = 12 where pk = 2")
+	runQuery(t, e, b, "insert into t values (5, 5)")
+	runQuery(t, e, b, "insert into keyless values (2)")
+	runQuery(t, e, a, "commit")
+	runQuery(t, e, b, "commit")
+
+	require.Equal(t,
+		[]sql.Row{{int32(1), int32(11)}, {int32(2), int32(12)}, {int32(3), int32(3)}, {int32(5), int32(5)}},
+		runQuery(t, e, a, "select * from t order by pk"))
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(2)}}, runQuery(t, e, a, "select * from keyless order by v"))
+	// the secondary index of the merged table is usable
+	require.Equal(t, []sql.Row{{int32(5)}}, runQuery(t, e, a, "select pk from t where v = 5"))
+}
+
+func TestTransactionWriteConflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		aQuery  string
+		bQuery  string
+		results []sql.Row
+	}{
+		{
+			name:    "same row updated without a locking read",
+			aQuery:  "update t set v = 11 where pk = 1",
+			bQuery:  "update t, (select 1) s set t.v = 12 where t.pk = 1",
+			results: []sql.Row{{int32(1), int32(11)}, {int32(2), int32(2)}, {int32(3), int32(3)}},
+		},
+		{
+			name:    "same key inserted",
+			aQuery:  "insert into t values (4, 4)",
+			bQuery:  "insert into t values (4, 5)",
+			results: []sql.Row{{int32(1), int32(1)}, {int32(2), int32(2)}, {int32(3), int32(3)}, {int32(4), int32(4)}},
+		},
+		{
+			name:    "same unique key inserted",
+			aQuery:  "insert into t values (4, 4)",
+			bQuery:  "insert into t values (5, 4)",
+			results: []sql.Row{{int32(1), int32(1)}, {int32(2), int32(2)}, {int32(3), int32(3)}, {int32(4), int32(4)}},
+		},
+		{
+			name:    "table altered",
+			aQuery:  "alter table t add column w int",
+			bQuery:  "insert into t values (4, 4)",
+			results: []sql.Row{{int32(1), int32(1), nil}, {int32(2), int32(2), nil}, {int32(3), int32(3), nil}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, a, b := transactionEngine(t)
+			runQuery(t, e, a, "start transaction")
+			runQuery(t, e, b, "start transaction")
+			runQuery(t, e, b, "select * from t")
+			runQuery(t, e, a, tt.aQuery)
+			runQuery(t, e, a, "commit")
+			runQuery(t, e, b, tt.bQuery)
+
+			err := queryErr(e, b, "commit")
+			require.Error(t, err)
+			require.True(t, sql.ErrLockDeadlock.Is(err), err.Error())
+
+			// the failed transaction was rolled back
+			require.Equal(t, tt.results, runQuery(t, e, b, "select * from t order by pk"))
+		})
+	}
+}
+
+func TestTransactionSavepoints(t *testing.T) {
+	e, a, _ := transactionEngine(t)
+
+	runQuery(t, e, a, "start transaction")
+	runQuery(t, e, a, "insert into t values (4, 4)")
+	runQuery(t, e, a, "savepoint sp1")
+	runQuery(t, e, a, "insert into t values (5, 5)")
+	runQuery(t, e, a, "savepoint sp2")
+	runQuery(t, e, a, "delete from t")
+	require.Equal(t, []sql.Row{{int64(0)}}, runQuery(t, e, a, "select count(*) from t"))
+
+	runQuery(t, e, a, "rollback to savepoint sp2")
+	require.Equal(t, []sql.Row{{int64(5)}}, runQuery(t, e, a, "select count(*) from t"))
+	runQuery(t, e, a, "delete from t where pk = 1")
+
+	// rolling back to a savepoint discards the savepoints created after it
+	runQuery(t, e, a, "rollback to savepoint sp1")
+	require.Equal(t, []sql.Row{{int64(4)}}, runQuery(t, e, a, "select count(*) from t"))
+	require.True(t, sql.ErrSavepointDoesNotExist.Is(queryErr(e, a, "rollback to savepoint sp2")))
+
+	runQuery(t, e, a, "release savepoint sp1")
+	require.True(t, sql.ErrSavepointDoesNotExist.Is(queryErr(e, a, "rollback to savepoint sp1")))
+	runQuery(t, e, a, "commit")
+
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(2)}, {int32(3)}, {int32(4)}}, runQuery(t, e, a, "select pk from t order by pk"))
+}
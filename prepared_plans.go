@@ -0,0 +1,175 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// preparedPlanSessionVars are the session variables whose values affect how a statement is planned, and so are part
+// of the fingerprint of a prepared plan.
+var preparedPlanSessionVars = []string{
+	sql.SqlModeSessionVar,
+	sql.OptimizerSwitchSessionVar,
+	sql.DisableMergeJoin,
+	"inmemory_joins",
+	"sql_select_limit",
+	"time_zone",
+}
+
+// preparedPlan is the analyzed plan of one execution of a prepared statement.
+type preparedPlan struct {
+	// fingerprint identifies the bind values and session state the plan was built for.
+	fingerprint string
+	// tx is the transaction the plan was built in. Tables resolved in one transaction may not be read in another.
+	tx       sql.Transaction
+	bound    sql.Node
+	analyzed sql.Node
+	flags    sql.QueryFlags
+}
+
+// preparedPlanCache holds the most recent plan of each prepared statement of each session, for sessions that re-optimize
+// prepared statements only when their fingerprint changes.
+type preparedPlanCache struct {
+	mu    sync.Mutex
+	plans map[uint32]map[string]*preparedPlan
+}
+
+func newPreparedPlanCache() *preparedPlanCache {
+	return &preparedPlanCache{plans: make(map[uint32]map[string]*preparedPlan)}
+}
+
+// get returns the cached plan for |query| in the session of |ctx| if it was built for |fingerprint| in the session's
+// current transaction.
+func (c *preparedPlanCache) get(ctx *sql.Context, query, fingerprint string) (*preparedPlan, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.plans[ctx.Session.ID()][query]
+	if !ok || p.fingerprint != fingerprint || p.tx != ctx.GetTransaction() {
+		return nil, false
+	}
+	return p, true
+}
+
+// put caches |p| as the plan for |query| in the session of |ctx|, replacing any previous plan.
+func (c *preparedPlanCache) put(ctx *sql.Context, query string, p *preparedPlan) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	plans, ok := c.plans[ctx.Session.ID()]
+	if !ok {
+		plans = make(map[string]*preparedPlan)
+		c.plans[ctx.Session.ID()] = plans
+	}
+	plans[query] = p
+}
+
+// evict removes the cached plan for |query| in the session with id |connID|.
+func (c *preparedPlanCache) evict(connID uint32, query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.plans[connID], query)
+}
+
+// evictSession removes every cached plan of the session with id |connID|.
+func (c *preparedPlanCache) evictSession(connID uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.plans, connID)
+}
+
+// evictAll removes every cached plan of every session.
+func (c *preparedPlanCache) evictAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.plans = make(map[uint32]map[string]*preparedPlan)
+}
+
+// invalidate removes every cached plan of the session of |ctx| if |n| may write, since the plans cached earlier in its
+// transaction may not see the effects of the write.
+func (c *preparedPlanCache) invalidate(ctx *sql.Context, n sql.Node) {
+	if !plan.IsReadOnly(n) {
+		c.evictSession(ctx.Session.ID())
+	}
+}
+
+// reoptimizeOnFingerprintChange returns whether the session of |ctx| reuses the plans of its prepared statements until
+// their fingerprint changes, rather than re-optimizing them on every execution.
+func reoptimizeOnFingerprintChange(ctx *sql.Context) bool {
+	val, err := ctx.GetSessionVariable(ctx, sql.PreparedStmtReoptimizeSessionVar)
+	if err != nil {
+		return false
+	}
+	policy, ok := val.(string)
+	return ok && strings.EqualFold(policy, sql.PreparedStmtReoptimizeOnFingerprintChange)
+}
+
+// preparedPlanFingerprint returns the fingerprint of an execution of a prepared statement with |bindings|, which
+// determines whether a plan cached by an earlier execution can be reused. It returns false if the execution can't use
+// the plan cache, either because the session re-optimizes every execution or because there is no transaction whose
+// identity the plan can be tied to.
+func preparedPlanFingerprint(ctx *sql.Context, bindings map[string]sqlparser.Expr, qFlags *sql.QueryFlags) (string, bool) {
+	if len(bindings) == 0 || !reoptimizeOnFingerprintChange(ctx) {
+		return "", false
+	}
+	tx := ctx.GetTransaction()
+	if tx == nil || !reflect.TypeOf(tx).Comparable() {
+		return "", false
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "db=%s;", ctx.GetCurrentDatabase())
+	if qFlags != nil {
+		fmt.Fprintf(&sb, "flags=%s;", qFlags.Flags.String())
+	}
+	for _, name := range preparedPlanSessionVars {
+		val, err := ctx.GetSessionVariable(ctx, name)
+		if err != nil {
+			return "", false
+		}
+		fmt.Fprintf(&sb, "@@%s=%v;", name, val)
+	}
+
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// the type distinguishes values with the same text, such as the string '1' and the integer 1
+		expr := bindings[name]
+		if v, ok := expr.(*sqlparser.SQLVal); ok {
+			fmt.Fprintf(&sb, ":%s=%d:%s;", name, v.Type, sqlparser.String(v))
+		} else {
+			fmt.Fprintf(&sb, ":%s=%T:%s;", name, expr, sqlparser.String(expr))
+		}
+	}
+	return sb.String(), true
+}
+
+// isCacheablePreparedPlan returns whether |analyzed| can be executed again in the same transaction. Only plans of
+// read-only SELECT statements are reused, since a statement with side effects may change what a later execution must
+// read.
+func isCacheablePreparedPlan(ctx *sql.Context, analyzed sql.Node) bool {
+	return plan.IsReadOnly(analyzed) && plan.NodeRepresentsSelect(ctx, analyzed)
+}
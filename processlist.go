@@ -135,6 +135,7 @@ func (pl *ProcessList) BeginQuery(
 
 	p.Command = sql.ProcessCommandQuery
 	p.Query = query
+	p.State = ""
 	p.QueryPid = pid
 	p.StartedAt = time.Now()
 	p.Kill = cancel
@@ -166,6 +167,7 @@ func (pl *ProcessList) EndQuery(ctx *sql.Context) {
 		sql.StatusVariables.IncrementGlobal("Threads_running", -1)
 		p.Command = sql.ProcessCommandSleep
 		p.Query = ""
+		p.State = ""
 		p.StartedAt = time.Now()
 		p.Kill()
 		p.Kill = nil
@@ -360,6 +362,22 @@ func (pl *ProcessList) RemovePartitionProgress(pid uint64, tableName, partitionN
 	delete(tablePg.PartitionsProgress, partitionName)
 }
 
+// UpdateQueryState sets the state shown for the query of the process with the given pid.
+func (pl *ProcessList) UpdateQueryState(pid uint64, state string) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	id, ok := pl.byQueryPid[pid]
+	if !ok {
+		return
+	}
+	p, ok := pl.procs[id]
+	if !ok {
+		return
+	}
+	p.State = state
+}
+
 // Kill terminates all queries for a given connection id.
 func (pl *ProcessList) Kill(connID uint32) {
 	pl.mu.Lock()
@@ -0,0 +1,241 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Restore runs the SQL statements read from |r| in the session of |ctx|, as the mysql client does when a script is
+// piped into it, and stops at the first statement that fails. Statements are read and run one at a time, so scripts of
+// any size may be restored, such as those written by Engine.Dump or by mysqldump. Comments are skipped, except for
+// MySQL's executable /*! ... */ comments, and the DELIMITER command changes the delimiter of the statements that
+// follow it.
+func (e *Engine) Restore(ctx *sql.Context, r io.Reader) error {
+	s := newStatementScanner(r)
+	for {
+		stmt, line, err := s.next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "reading statement at line %d", line)
+		}
+		_, iter, _, err := e.Query(ctx, stmt)
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "restoring statement at line %d", line)
+		}
+	}
+}
+
+// statementScanner splits a SQL script into statements.
+type statementScanner struct {
+	r         *bufio.Reader
+	delimiter string
+	line      int
+}
+
+func newStatementScanner(r io.Reader) *statementScanner {
+	return &statementScanner{r: bufio.NewReader(r), delimiter: ";", line: 1}
+}
+
+// next returns the next statement of the script, without its delimiter, and the line it starts on. It returns io.EOF
+// once there are no statements left.
+func (s *statementScanner) next() (string, int, error) {
+	var stmt strings.Builder
+	start := s.line
+	for {
+		c, err := s.read()
+		if err == io.EOF {
+			if text := strings.TrimSpace(stmt.String()); text != "" {
+				return text, start, nil
+			}
+			return "", start, io.EOF
+		} else if err != nil {
+			return "", start, err
+		}
+
+		if strings.TrimSpace(stmt.String()) == "" {
+			if unicode.IsSpace(c) {
+				stmt.Reset()
+				start = s.line
+				continue
+			}
+			// the DELIMITER command is only recognized at the start of a statement, and takes the rest of its line
+			if c == 'd' || c == 'D' {
+				if ok, err := s.delimiterCommand(c); err != nil {
+					return "", start, err
+				} else if ok {
+					start = s.line
+					continue
+				}
+			}
+		}
+
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			stmt.WriteRune(c)
+			if err = s.quoted(&stmt, c); err != nil {
+				return "", start, err
+			}
+			continue
+		case c == '#':
+			if err = s.skipLine(); err != nil {
+				return "", start, err
+			}
+			stmt.WriteByte(' ')
+			continue
+		case c == '-' && s.peekIs("-"):
+			// "--" only starts a comment when it's followed by whitespace
+			if next, err := s.r.Peek(2); err == io.EOF || (err == nil && unicode.IsSpace(rune(next[1]))) {
+				if err = s.skipLine(); err != nil {
+					return "", start, err
+				}
+				stmt.WriteByte(' ')
+				continue
+			}
+		case c == '/' && s.peekIs("*"):
+			if err = s.blockComment(&stmt); err != nil {
+				return "", start, err
+			}
+			continue
+		}
+
+		stmt.WriteRune(c)
+		if text := stmt.String(); strings.HasSuffix(text, s.delimiter) {
+			text = strings.TrimSpace(text[:len(text)-len(s.delimiter)])
+			if text == "" {
+				stmt.Reset()
+				start = s.line
+				continue
+			}
+			return text, start, nil
+		}
+	}
+}
+
+// read returns the next character of the script.
+func (s *statementScanner) read() (rune, error) {
+	c, _, err := s.r.ReadRune()
+	if c == '\n' {
+		s.line++
+	}
+	return c, err
+}
+
+// peekIs returns whether the script continues with |prefix|.
+func (s *statementScanner) peekIs(prefix string) bool {
+	next, err := s.r.Peek(len(prefix))
+	return err == nil && string(next) == prefix
+}
+
+// quoted writes the rest of the string or identifier quoted with |quote| to |stmt|.
+func (s *statementScanner) quoted(stmt *strings.Builder, quote rune) error {
+	for {
+		c, err := s.read()
+		if err != nil {
+			return err
+		}
+		stmt.WriteRune(c)
+		if c == '\\' && quote != '`' {
+			c, err = s.read()
+			if err != nil {
+				return err
+			}
+			stmt.WriteRune(c)
+		} else if c == quote {
+			// a doubled quote is part of the string
+			if !s.peekIs(string(quote)) {
+				return nil
+			}
+			c, _ = s.read()
+			stmt.WriteRune(c)
+		}
+	}
+}
+
+// skipLine skips the rest of the current line.
+func (s *statementScanner) skipLine() error {
+	for {
+		c, err := s.read()
+		if err == io.EOF || c == '\n' {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// blockComment reads the rest of a comment started by "/". Executable comments, which begin with "/*!" or "/*+",
+// are written to |stmt|, and other comments are replaced with a space.
+func (s *statementScanner) blockComment(stmt *strings.Builder) error {
+	var comment strings.Builder
+	comment.WriteByte('/')
+	for {
+		c, err := s.read()
+		if err != nil {
+			return err
+		}
+		comment.WriteRune(c)
+		if text := comment.String(); len(text) > 3 && strings.HasSuffix(text, "*/") {
+			if strings.HasPrefix(text, "/*!") || strings.HasPrefix(text, "/*+") {
+				stmt.WriteString(text)
+			} else {
+				stmt.WriteByte(' ')
+			}
+			return nil
+		}
+	}
+}
+
+// delimiterCommand reads a DELIMITER command that starts with |first|, and changes the delimiter to the one it
+// gives. If the line isn't a DELIMITER command, nothing is read and it returns false.
+func (s *statementScanner) delimiterCommand(first rune) (bool, error) {
+	const command = "delimiter"
+	next, err := s.r.Peek(len(command))
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	if len(next) < len(command) || !strings.EqualFold(string(first)+string(next[:len(command)-1]), command) ||
+		!unicode.IsSpace(rune(next[len(command)-1])) {
+		return false, nil
+	}
+
+	var line strings.Builder
+	for {
+		c, err := s.read()
+		if err == io.EOF || c == '\n' {
+			break
+		} else if err != nil {
+			return false, err
+		}
+		line.WriteRune(c)
+	}
+	fields := strings.Fields(line.String())
+	if len(fields) < 2 {
+		return false, errors.New("DELIMITER must be followed by a delimiter")
+	}
+	s.delimiter = fields[1]
+	return true, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// SubscribeSchemaChanges registers |listener| to be notified of the changes DDL statements run by this engine make to
+// databases, tables, views, triggers, stored procedures, events and sequences, and returns a function that
+// unsubscribes it. Statements run by stored procedures, triggers and events are included.
+func (e *Engine) SubscribeSchemaChanges(listener sql.SchemaChangeListener) (unsubscribe func()) {
+	return e.Analyzer.Catalog.SchemaChanges.Subscribe(listener)
+}
+
+// withSchemaChangeNotification returns |iter|, wrapped so that the schema changes made by executing |n| are published
+// when it's closed, if it completed without error. |iter| is returned unchanged if |n| makes no schema changes.
+func (e *Engine) withSchemaChangeNotification(ctx *sql.Context, n sql.Node, iter sql.RowIter) sql.RowIter {
+	notifier := e.Analyzer.Catalog.SchemaChanges
+	if notifier == nil || plan.IsReadOnly(n) {
+		return iter
+	}
+	changes := plan.SchemaChanges(ctx, n)
+	if len(changes) == 0 {
+		return iter
+	}
+	return &schemaChangeNotifyingIter{RowIter: iter, notifier: notifier, changes: changes}
+}
+
+// schemaChangeNotifyingIter publishes the schema changes of its statement after its child has been closed, once any
+// transaction the statement committed has ended.
+type schemaChangeNotifyingIter struct {
+	sql.RowIter
+	notifier *sql.SchemaChangeNotifier
+	changes  []sql.SchemaChange
+	failed   bool
+}
+
+func (i *schemaChangeNotifyingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	if err != nil && err != io.EOF {
+		i.failed = true
+	}
+	return row, err
+}
+
+func (i *schemaChangeNotifyingIter) Close(ctx *sql.Context) error {
+	if err := i.RowIter.Close(ctx); err != nil {
+		return err
+	}
+	if !i.failed {
+		i.notifier.Publish(ctx, i.changes...)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// AuthenticationPlugin authenticates accounts against an external service, such as LDAP, OIDC or PAM, in place of the
+// passwords stored in mysql.user. An account is authenticated by the plugin when its plugin in mysql.user is the name
+// of the plugin, as set by CREATE USER ... IDENTIFIED WITH, so accounts that use different plugins can live side by
+// side. The account must exist, so its privileges are still those granted to it.
+type AuthenticationPlugin interface {
+	// Name returns the name of the plugin, which accounts give as their plugin, such as "authentication_ldap_simple".
+	Name() string
+	// ClientPlugin returns the name of the client-side plugin that the client authenticates with. With
+	// mysql_clear_password, the client sends its password in cleartext, which is only allowed over TLS or a unix
+	// socket, unless the server allows cleartext passwords without TLS. Any other client plugin is a
+	// challenge-response exchange, in which the client answers the challenge that the server sends.
+	ClientPlugin() string
+	// Challenge returns the challenge that is sent to a client, which is called for each connection. Plugins that
+	// authenticate with cleartext passwords may return nil.
+	Challenge() ([]byte, error)
+	// Authenticate returns whether |req| authenticates the account. An error denies access, and its message is
+	// returned to the client.
+	Authenticate(ctx context.Context, req AuthenticationRequest) (bool, error)
+}
+
+// AuthenticationRequest is the request of a client to log in as an account that is authenticated by an
+// AuthenticationPlugin.
+type AuthenticationRequest struct {
+	// User is the name of the user that is logging in.
+	User string
+	// Host is the host that the client connects from.
+	Host string
+	// AccountHost is the host of the account that the client logs in as, which may be a pattern such as %.
+	AccountHost string
+	// AuthString is the authentication string of the account, as given by the AS clause of CREATE USER ... IDENTIFIED
+	// WITH, such as the distinguished name of the user in an LDAP directory.
+	AuthString string
+	// Password is the password that the client sent, when the client plugin is mysql_clear_password.
+	Password string
+	// Challenge is the challenge that was sent to the client, which is the one returned by Challenge, or the one of
+	// the initial handshake when the client answered it with the plugin's client plugin right away. It's empty when
+	// the client plugin is mysql_clear_password.
+	Challenge []byte
+	// Response is the response of the client to the challenge.
+	Response []byte
+	// TLS is true if the connection uses TLS.
+	TLS bool
+	// ClientCertificates are the certificates that the client presented over TLS.
+	ClientCertificates []*x509.Certificate
+}
+
+// pluginAuthMethod is the mysql.AuthMethod of an AuthenticationPlugin, which is negotiated for the accounts that use
+// the plugin.
+type pluginAuthMethod struct {
+	db     *mysql_db.MySQLDb
+	plugin AuthenticationPlugin
+	// timeout is how long the plugin may take to authenticate a client. When zero, the connect_timeout system
+	// variable is used, as it is for the rest of the handshake.
+	timeout time.Duration
+}
+
+var _ mysql.AuthMethod = (*pluginAuthMethod)(nil)
+
+// addAuthenticationPlugins adds the auth methods of |plugins| to |db|, which give each plugin |timeout| to
+// authenticate a client.
+func addAuthenticationPlugins(db *mysql_db.MySQLDb, plugins []AuthenticationPlugin, timeout time.Duration) {
+	for _, plugin := range plugins {
+		db.AddAuthMethod(plugin.Name(), &pluginAuthMethod{db: db, plugin: plugin, timeout: timeout})
+	}
+}
+
+// Name implements the mysql.AuthMethod interface.
+func (m *pluginAuthMethod) Name() mysql.AuthMethodDescription {
+	return mysql.AuthMethodDescription(m.plugin.ClientPlugin())
+}
+
+// HandleUser implements the mysql.AuthMethod interface.
+func (m *pluginAuthMethod) HandleUser(conn *mysql.Conn, user string) bool {
+	if !m.db.Enabled() {
+		return false
+	}
+	account, _, err := m.db.ConnectionAccount(conn, user)
+	return err == nil && account != nil && account.Plugin == m.plugin.Name()
+}
+
+// AllowClearTextWithoutTLS implements the mysql.AuthMethod interface.
+func (m *pluginAuthMethod) AllowClearTextWithoutTLS() bool {
+	return m.Name() != mysql.MysqlClearPassword
+}
+
+// AuthPluginData implements the mysql.AuthMethod interface.
+func (m *pluginAuthMethod) AuthPluginData() ([]byte, error) {
+	return m.plugin.Challenge()
+}
+
+// HandleAuthPluginData implements the mysql.AuthMethod interface.
+func (m *pluginAuthMethod) HandleAuthPluginData(conn *mysql.Conn, user string, serverAuthPluginData []byte, clientAuthPluginData []byte, _ net.Addr) (mysql.Getter, error) {
+	account, host, err := m.db.ConnectionAccount(conn, user)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil || account.Locked || account.Plugin != m.plugin.Name() {
+		return nil, accessDenied(user)
+	}
+	if err = mysql_db.ValidateConnectionSecurity(account, conn); err != nil {
+		return nil, err
+	}
+
+	req := AuthenticationRequest{
+		User:               user,
+		Host:               host,
+		AccountHost:        account.Host,
+		AuthString:         account.AuthString,
+		TLS:                conn.TLSEnabled(),
+		ClientCertificates: conn.GetTLSClientCerts(),
+	}
+	if m.Name() == mysql.MysqlClearPassword {
+		// the password is terminated by a NUL byte
+		password := clientAuthPluginData
+		if n := len(password); n > 0 && password[n-1] == 0 {
+			password = password[:n-1]
+		}
+		req.Password = string(password)
+	} else {
+		req.Challenge, req.Response = serverAuthPluginData, clientAuthPluginData
+	}
+
+	ok, err := m.authenticate(conn, req)
+	if err == context.Canceled {
+		// the client went away, which isn't a failed login
+		return nil, accessDenied(user)
+	}
+	if err != nil {
+		// a plugin that fails to authenticate the user counts as a failed login
+		if err := m.db.RecordLogin(account, false); err != nil {
+			return nil, err
+		}
+		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError,
+			"Access denied for user '%v': %v", user, err)
+	}
+	if err = m.db.RecordLogin(account, ok); err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, accessDenied(user)
+	}
+	return sql.MysqlConnectionUser{User: account.User, Host: account.Host}, nil
+}
+
+// authenticate calls the plugin to authenticate |req|, with a context that is cancelled when the client closes
+// |conn| and that times out after the plugin's timeout. A plugin that doesn't return by then is abandoned, so it can't
+// hold the handshake open, and authenticate returns context.Canceled or an error for the timeout.
+func (m *pluginAuthMethod) authenticate(conn *mysql.Conn, req AuthenticationRequest) (bool, error) {
+	timeout := m.timeout
+	if timeout == 0 {
+		timeout = connectTimeout()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// The client waits for the result of the handshake without writing, so any activity on the connection means
+	// that it was closed.
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		if err := conn.WaitForClientActivity(watchCtx); err != nil {
+			cancel()
+		}
+	}()
+	defer func() {
+		stopWatch()
+		<-watchDone
+	}()
+
+	type result struct {
+		ok  bool
+		err error
+	}
+	results := make(chan result, 1)
+	go func() {
+		ok, err := m.plugin.Authenticate(ctx, req)
+		results <- result{ok: ok, err: err}
+	}()
+
+	select {
+	case res := <-results:
+		return res.ok, res.err
+	case <-ctx.Done():
+		if ctx.Err() == context.DeadlineExceeded {
+			return false, fmt.Errorf("authentication plugin %s timed out after %v", m.plugin.Name(), timeout)
+		}
+		return false, context.Canceled
+	}
+}
+
+// connectTimeout returns the value of the connect_timeout system variable.
+func connectTimeout() time.Duration {
+	timeout := 10 * time.Second
+	if _, val, ok := sql.SystemVariables.GetGlobal("connect_timeout"); ok {
+		if seconds, ok := val.(int64); ok && seconds > 0 {
+			timeout = time.Duration(seconds) * time.Second
+		}
+	}
+	return timeout
+}
+
+// accessDenied returns the error of a client that failed to authenticate as |user|.
+func accessDenied(user string) error {
+	return mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+}
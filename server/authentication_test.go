@@ -0,0 +1,336 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	vsql "github.com/dolthub/vitess/go/mysql"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	gsql "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// directoryPlugin authenticates accounts against the passwords of a directory, with cleartext passwords.
+type directoryPlugin struct {
+	passwords map[string]string
+	requests  []server.AuthenticationRequest
+}
+
+func (p *directoryPlugin) Name() string         { return "authentication_test_directory" }
+func (p *directoryPlugin) ClientPlugin() string { return string(vsql.MysqlClearPassword) }
+func (p *directoryPlugin) Challenge() ([]byte, error) {
+	return nil, nil
+}
+
+func (p *directoryPlugin) Authenticate(ctx context.Context, req server.AuthenticationRequest) (bool, error) {
+	p.requests = append(p.requests, req)
+	password, ok := p.passwords[req.AuthString]
+	return ok && password == req.Password, nil
+}
+
+// scramblePlugin authenticates accounts against the passwords of a directory, with the challenge-response exchange of
+// mysql_native_password, so that passwords are never sent.
+type scramblePlugin struct {
+	passwords map[string]string
+}
+
+func (p *scramblePlugin) Name() string         { return "authentication_test_scramble" }
+func (p *scramblePlugin) ClientPlugin() string { return string(vsql.MysqlNativePassword) }
+func (p *scramblePlugin) Challenge() ([]byte, error) {
+	salt, err := vsql.NewSalt()
+	return append(salt, 0), err
+}
+
+func (p *scramblePlugin) Authenticate(ctx context.Context, req server.AuthenticationRequest) (bool, error) {
+	password, ok := p.passwords[req.User]
+	if !ok {
+		return false, fmt.Errorf("no such user in directory")
+	}
+	salt := bytes.TrimSuffix(req.Challenge, []byte{0})
+	return bytes.Equal(vsql.ScrambleMysqlNativePassword(salt, []byte(password)), req.Response), nil
+}
+
+// unavailablePlugin fails to authenticate anyone, as a plugin whose directory can't be reached would.
+type unavailablePlugin struct{}
+
+func (p *unavailablePlugin) Name() string         { return "authentication_test_unavailable" }
+func (p *unavailablePlugin) ClientPlugin() string { return string(vsql.MysqlClearPassword) }
+func (p *unavailablePlugin) Challenge() ([]byte, error) {
+	return nil, nil
+}
+
+func (p *unavailablePlugin) Authenticate(ctx context.Context, req server.AuthenticationRequest) (bool, error) {
+	return false, fmt.Errorf("directory unavailable")
+}
+
+func TestAuthenticationPlugins(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	pro := memory.NewDBProvider(memory.NewDatabase("mydb"))
+	engine := sqle.NewDefault(pro)
+	engine.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	engine.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	directory := &directoryPlugin{passwords: map[string]string{"cn=alice,dc=example": "alice-secret"}}
+	scramble := &scramblePlugin{passwords: map[string]string{"bob": "bob-secret"}}
+	cfg := server.Config{
+		Listener:                 listener,
+		AllowClearTextWithoutTLS: true,
+		AuthenticationPlugins:    []server.AuthenticationPlugin{directory, scramble, &unavailablePlugin{}},
+	}
+	s, err := server.NewServer(cfg, engine, gsql.NewContext, memory.NewSessionBuilder(pro), nil)
+	require.NoError(t, err)
+	go func() {
+		_ = s.Start()
+	}()
+	t.Cleanup(func() { s.Close() })
+
+	ctx := gsql.NewContext(context.Background(), gsql.WithSession(
+		memory.NewSession(gsql.NewBaseSessionWithClientServer("", gsql.Client{User: "root", Address: "localhost"}, 1), pro)))
+	for _, q := range []string{
+		"CREATE USER alice IDENTIFIED WITH authentication_test_directory AS 'cn=alice,dc=example'",
+		"CREATE USER bob IDENTIFIED WITH authentication_test_scramble",
+		"CREATE USER carol IDENTIFIED BY 'carol-secret'",
+		"CREATE USER dave IDENTIFIED WITH authentication_test_unavailable FAILED_LOGIN_ATTEMPTS 2 PASSWORD_LOCK_TIME 1",
+		"GRANT SELECT ON *.* TO alice, bob, carol, dave",
+	} {
+		_, iter, _, err := engine.Query(ctx, q)
+		require.NoError(t, err, q)
+		_, err = gsql.RowIterToRows(ctx, iter)
+		require.NoError(t, err, q)
+	}
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	currentUser := func(user, password string) (string, error) {
+		db, err := sql.Open("mysql", fmt.Sprintf("%s:%s@tcp(127.0.0.1:%d)/mydb?allowCleartextPasswords=true", user, password, port))
+		require.NoError(t, err)
+		defer db.Close()
+		var current string
+		err = db.QueryRow("SELECT CURRENT_USER()").Scan(&current)
+		return current, err
+	}
+
+	current, err := currentUser("alice", "alice-secret")
+	require.NoError(t, err)
+	require.Equal(t, "alice@%", current)
+	require.NotEmpty(t, directory.requests)
+	require.Equal(t, "alice", directory.requests[0].User)
+	require.Equal(t, "127.0.0.1", directory.requests[0].Host)
+	require.Equal(t, "%", directory.requests[0].AccountHost)
+
+	_, err = currentUser("alice", "wrong")
+	require.ErrorContains(t, err, "Access denied for user 'alice'")
+
+	current, err = currentUser("bob", "bob-secret")
+	require.NoError(t, err)
+	require.Equal(t, "bob@%", current)
+
+	_, err = currentUser("bob", "wrong")
+	require.ErrorContains(t, err, "Access denied for user 'bob'")
+
+	// accounts that don't use a plugin still authenticate with their own password
+	current, err = currentUser("carol", "carol-secret")
+	require.NoError(t, err)
+	require.Equal(t, "carol@%", current)
+
+	_, err = currentUser("carol", "alice-secret")
+	require.ErrorContains(t, err, "Access denied for user 'carol'")
+
+	// a plugin that fails counts as a failed login, so repeated failures lock the account
+	_, err = currentUser("dave", "dave-secret")
+	require.ErrorContains(t, err, "directory unavailable")
+	_, err = currentUser("dave", "dave-secret")
+	require.ErrorContains(t, err, "Access denied for user 'dave'")
+	locks := engine.Analyzer.Catalog.MySQLDb.AccountLocks()
+	require.Len(t, locks, 1)
+	require.Equal(t, "dave", locks[0].User)
+	require.True(t, locks[0].Locked)
+
+	// a locked account can't log in, even if the plugin would accept it
+	_, iter, _, err := engine.Query(ctx, "UPDATE mysql.user SET account_locked = 'Y' WHERE User = 'alice'")
+	require.NoError(t, err)
+	_, err = gsql.RowIterToRows(ctx, iter)
+	require.NoError(t, err)
+	_, err = currentUser("alice", "alice-secret")
+	require.ErrorContains(t, err, "Access denied for user 'alice'")
+}
+
+// hangingPlugin never answers, as a plugin whose directory stopped responding wouldn't. It reports the error of the
+// context that it was called with once that context is done, unless it ignores its context.
+type hangingPlugin struct {
+	ignoreContext bool
+	release       chan struct{}
+	done          chan error
+}
+
+func (p *hangingPlugin) Name() string         { return "authentication_test_hanging" }
+func (p *hangingPlugin) ClientPlugin() string { return string(vsql.MysqlClearPassword) }
+func (p *hangingPlugin) Challenge() ([]byte, error) {
+	return nil, nil
+}
+
+func (p *hangingPlugin) Authenticate(ctx context.Context, req server.AuthenticationRequest) (bool, error) {
+	if p.ignoreContext {
+		<-p.release
+		return true, nil
+	}
+	<-ctx.Done()
+	p.done <- ctx.Err()
+	return false, ctx.Err()
+}
+
+func TestAuthenticationPluginTimeout(t *testing.T) {
+	startServer := func(plugin server.AuthenticationPlugin, timeout time.Duration) int {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		pro := memory.NewDBProvider(memory.NewDatabase("mydb"))
+		engine := sqle.NewDefault(pro)
+		engine.Analyzer.Catalog.MySQLDb.AddRootAccount()
+		engine.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+		cfg := server.Config{
+			Listener:                    listener,
+			AllowClearTextWithoutTLS:    true,
+			AuthenticationPlugins:       []server.AuthenticationPlugin{plugin},
+			AuthenticationPluginTimeout: timeout,
+		}
+		s, err := server.NewServer(cfg, engine, gsql.NewContext, memory.NewSessionBuilder(pro), nil)
+		require.NoError(t, err)
+		go func() {
+			_ = s.Start()
+		}()
+		t.Cleanup(func() { s.Close() })
+
+		ctx := gsql.NewContext(context.Background(), gsql.WithSession(
+			memory.NewSession(gsql.NewBaseSessionWithClientServer("", gsql.Client{User: "root", Address: "localhost"}, 1), pro)))
+		_, iter, _, err := engine.Query(ctx, "CREATE USER erin IDENTIFIED WITH authentication_test_hanging")
+		require.NoError(t, err)
+		_, err = gsql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		return listener.Addr().(*net.TCPAddr).Port
+	}
+	login := func(port int, params string) error {
+		db, err := sql.Open("mysql", fmt.Sprintf("erin:secret@tcp(127.0.0.1:%d)/mydb?allowCleartextPasswords=true%s", port, params))
+		require.NoError(t, err)
+		defer db.Close()
+		return db.Ping()
+	}
+
+	t.Run("a plugin that times out denies the login", func(t *testing.T) {
+		plugin := &hangingPlugin{done: make(chan error, 1)}
+		port := startServer(plugin, 100*time.Millisecond)
+		err := login(port, "")
+		require.ErrorContains(t, err, "authentication plugin authentication_test_hanging timed out")
+		require.ErrorIs(t, <-plugin.done, context.DeadlineExceeded)
+	})
+
+	t.Run("a plugin that ignores its context doesn't hold the handshake open", func(t *testing.T) {
+		plugin := &hangingPlugin{ignoreContext: true, release: make(chan struct{})}
+		defer close(plugin.release)
+		port := startServer(plugin, 100*time.Millisecond)
+		err := login(port, "")
+		require.ErrorContains(t, err, "timed out")
+	})
+
+	t.Run("closing the connection cancels the plugin", func(t *testing.T) {
+		plugin := &hangingPlugin{done: make(chan error, 1)}
+		port := startServer(plugin, time.Minute)
+		// the client gives up on the handshake and closes its connection
+		err := login(port, "&readTimeout=100ms")
+		require.Error(t, err)
+		select {
+		case err = <-plugin.done:
+			require.ErrorIs(t, err, context.Canceled)
+		case <-time.After(10 * time.Second):
+			t.Fatal("the plugin's context wasn't cancelled when the client closed its connection")
+		}
+	})
+}
+
+func TestAuthenticationPluginsOfSeveralServers(t *testing.T) {
+	pro := memory.NewDBProvider(memory.NewDatabase("mydb"))
+	engine := sqle.NewDefault(pro)
+	engine.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	engine.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+	methods := len(engine.Analyzer.Catalog.MySQLDb.AuthMethods())
+
+	startServer := func(plugins []server.AuthenticationPlugin, timeout time.Duration) int {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cfg := server.Config{
+			Listener:                    listener,
+			AllowClearTextWithoutTLS:    true,
+			AuthenticationPlugins:       plugins,
+			AuthenticationPluginTimeout: timeout,
+		}
+		s, err := server.NewServer(cfg, engine, gsql.NewContext, memory.NewSessionBuilder(pro), nil)
+		require.NoError(t, err)
+		go func() {
+			_ = s.Start()
+		}()
+		t.Cleanup(func() { s.Close() })
+		return listener.Addr().(*net.TCPAddr).Port
+	}
+	login := func(port int, user, password string) error {
+		db, err := sql.Open("mysql", fmt.Sprintf("%s:%s@tcp(127.0.0.1:%d)/mydb?allowCleartextPasswords=true", user, password, port))
+		require.NoError(t, err)
+		defer db.Close()
+		return db.Ping()
+	}
+
+	// the second server has its own instance of the directory plugin, and another timeout for the hanging plugin
+	first := &directoryPlugin{passwords: map[string]string{"cn=alice,dc=example": "first-secret"}}
+	second := &directoryPlugin{passwords: map[string]string{"cn=alice,dc=example": "second-secret"}}
+	hanging := &hangingPlugin{ignoreContext: true, release: make(chan struct{})}
+	defer close(hanging.release)
+	firstPort := startServer([]server.AuthenticationPlugin{first, hanging}, 100*time.Millisecond)
+	secondPort := startServer([]server.AuthenticationPlugin{second, hanging, &scramblePlugin{}}, 200*time.Millisecond)
+
+	// each plugin has one auth method, which is the one that was added last
+	require.Len(t, engine.Analyzer.Catalog.MySQLDb.AuthMethods(), methods+3)
+
+	ctx := gsql.NewContext(context.Background(), gsql.WithSession(
+		memory.NewSession(gsql.NewBaseSessionWithClientServer("", gsql.Client{User: "root", Address: "localhost"}, 1), pro)))
+	for _, q := range []string{
+		"CREATE USER alice IDENTIFIED WITH authentication_test_directory AS 'cn=alice,dc=example'",
+		"CREATE USER erin IDENTIFIED WITH authentication_test_hanging",
+		"CREATE USER bob IDENTIFIED WITH authentication_test_scramble",
+		"GRANT SELECT ON *.* TO alice, erin",
+	} {
+		_, iter, _, err := engine.Query(ctx, q)
+		require.NoError(t, err, q)
+		_, err = gsql.RowIterToRows(ctx, iter)
+		require.NoError(t, err, q)
+	}
+
+	for _, port := range []int{firstPort, secondPort} {
+		require.NoError(t, login(port, "alice", "second-secret"))
+		require.ErrorContains(t, login(port, "alice", "first-secret"), "Access denied for user 'alice'")
+		require.ErrorContains(t, login(port, "erin", "secret"), "timed out after 200ms")
+	}
+	require.Empty(t, first.requests)
+	require.Len(t, second.requests, 4)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
+)
+
+// Statements are sent in the session's character_set_client and converted to the internal UTF-8 encoding before they
+// are parsed. Results are converted to character_set_results when they're written, by each type's SQLValue. Parameters
+// of prepared statements are not converted, as the protocol sends strings and binary strings with the same type.
+
+// clientCharsetIsUtf8 returns whether statements sent in |charset| can be used without being converted.
+func clientCharsetIsUtf8(charset sql.CharacterSetID) bool {
+	switch charset {
+	case sql.CharacterSet_Unspecified, sql.CharacterSet_utf8mb4, sql.CharacterSet_utf8mb3, sql.CharacterSet_ascii,
+		sql.CharacterSet_binary:
+		return true
+	}
+	return charset.Encoder() == nil
+}
+
+// decodeFromClient converts |query| from the client's character set |charset| to UTF-8. |charset| must not be UTF-8.
+func decodeFromClient(charset sql.CharacterSetID, query string) (string, error) {
+	decoded, ok := charset.Encoder().Decode(encodings.StringToBytes(query))
+	if !ok {
+		snippet := query
+		if len(snippet) > 50 {
+			snippet = snippet[:50]
+		}
+		return "", sql.ErrCharSetInvalidString.New(charset.Name(), strings.ToValidUTF8(snippet, string(utf8.RuneError)))
+	}
+	return string(decoded), nil
+}
+
+// encodeForClient converts |str| from UTF-8 back to the client's character set |charset|. It's used for the remainder
+// of a multi-statement query, which is decoded again when it's executed.
+func encodeForClient(charset sql.CharacterSetID, str string) string {
+	if str == "" || clientCharsetIsUtf8(charset) {
+		return str
+	}
+	return string(charset.Encoder().EncodeReplaceUnknown(encodings.StringToBytes(str)))
+}
@@ -62,6 +62,12 @@ type SessionManager struct {
 	// connection lifecycle (AddConn/RemoveConn) and may be nil (e.g. a
 	// SessionManager constructed without a server, or the watcher disabled).
 	connWatcher *connWatcher
+	// fileSystem is given to the contexts of queries as the file system of the server. When nil, the file system of
+	// the host is used.
+	fileSystem sql.FileSystem
+	// sessionVars are the values of system variables that are specific to the server, such as its version, which
+	// each new session is initialized with.
+	sessionVars map[string]interface{}
 	addr        string
 	// Implements WaitForClosedConnections(), which is only used
 	// at server shutdown to allow the integrator to ensure that
@@ -151,6 +157,14 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 	}
 
 	session.SetConnectionId(conn.ConnectionID)
+	if len(s.sessionVars) > 0 {
+		sqlCtx := s.ctxFactory(ctx, sql.WithSession(session))
+		for name, value := range s.sessionVars {
+			if err = session.InitSessionVariable(sqlCtx, name, value); err != nil {
+				return err
+			}
+		}
+	}
 
 	ci := s.sessions[conn.ConnectionID]
 	if ci == nil {
@@ -342,6 +356,7 @@ func (s *SessionManager) newContextAndWatch(ctx context.Context, conn *mysql.Con
 		sql.WithServices(sql.Services{
 			KillConnection: s.KillConnection,
 			LoadInfile:     conn.LoadInfile,
+			FileSystem:     s.fileSystem,
 		}),
 	)
 
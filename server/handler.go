@@ -71,8 +71,10 @@ type Handler struct {
 	sm                *SessionManager
 	readTimeout       time.Duration
 	maxLoggedQueryLen int
+	disabledCaps      uint32
 	disableMultiStmts bool
 	encodeLoggedQuery bool
+	quotas            quotaTracker
 }
 
 var _ mysql.Handler = (*Handler)(nil)
@@ -103,6 +105,9 @@ func (h *Handler) ConnectionAborted(_ *mysql.Conn, _ string) error {
 // the connection in the session manager and updating processlist with
 // the authenticated user and remote address.
 func (h *Handler) ConnectionAuthenticated(c *mysql.Conn) error {
+	// The MySQL listener never advertises disabled capabilities, but a listener
+	// from a custom ProtocolListenerFactory may still have negotiated them.
+	c.Capabilities &^= h.disabledCaps
 	err := h.sm.ConnReady(context.Background(), c)
 	if err != nil {
 		logrus.Errorf("unable to register new authenticated connection: %s", err.Error())
@@ -132,6 +137,12 @@ func (h *Handler) ComPrepare(ctx context.Context, c *mysql.Conn, query string, p
 	if err != nil {
 		return nil, err
 	}
+	if clientCharset := sqlCtx.GetCharacterSetClient(); !clientCharsetIsUtf8(clientCharset) {
+		if query, err = decodeFromClient(clientCharset, query); err != nil {
+			return nil, err
+		}
+		sqlCtx = sqlCtx.WithQuery(query)
+	}
 	sqlCtx, err = sqlCtx.ProcessList.BeginOperation(sqlCtx)
 	if err != nil {
 		return nil, err
@@ -429,6 +440,13 @@ func (h *Handler) doQuery(
 	if err != nil {
 		return "", err
 	}
+	clientCharset := sqlCtx.GetCharacterSetClient()
+	if !clientCharsetIsUtf8(clientCharset) {
+		if query, err = decodeFromClient(clientCharset, query); err != nil {
+			return "", err
+		}
+		sqlCtx = sqlCtx.WithQuery(query)
+	}
 
 	// Notify the disconnect watcher that a query is in flight. If it runs long
 	// enough, the background sweeper starts an outstanding-read watch on the
@@ -462,6 +480,7 @@ func (h *Handler) doQuery(
 			if prequery != "" {
 				query = prequery
 			}
+			remainder = encodeForClient(clientCharset, remainder)
 		}
 	}
 
@@ -492,6 +511,10 @@ func (h *Handler) doQuery(
 
 	var schema sql.Schema
 	var rowIter sql.RowIter
+	if err = h.checkQuotas(sqlCtx, query, parsed, analyzedPlan); err != nil {
+		return remainder, err
+	}
+
 	qFlags.Set(sql.QFlagDeferProjections)
 	schema, rowIter, qFlags, err = queryExec(sqlCtx, query, parsed, analyzedPlan, bindings, qFlags)
 	if err != nil {
@@ -585,6 +608,28 @@ func resultForEmptyIter(ctx *sql.Context, iter sql.RowIter, resultFields []*quer
 	return &sqltypes.Result{Fields: resultFields}, nil
 }
 
+// spooledValueOverhead is the approximate size of a sqltypes.Value, not counting its data.
+const spooledValueOverhead = 32
+
+// spooledRowSize returns the approximate number of bytes held by |row| while it waits to be sent to the client.
+func spooledRowSize(row []sqltypes.Value) int64 {
+	size := int64(0)
+	for _, v := range row {
+		size += spooledValueOverhead + int64(v.Len())
+	}
+	return size
+}
+
+// spooledResultSize returns the approximate number of bytes held by the rows of |r| while they wait to be sent to the
+// client.
+func spooledResultSize(r *sqltypes.Result) int64 {
+	size := int64(0)
+	for _, row := range r.Rows {
+		size += spooledRowSize(row)
+	}
+	return size
+}
+
 // GetDeferredProjections looks for a top-level deferred projection, retrieves its projections, and removes it from the
 // iterator tree.
 func GetDeferredProjections(iter sql.RowIter) (sql.RowIter, []sql.Expression) {
@@ -601,6 +646,10 @@ func GetDeferredProjections(iter sql.RowIter) (sql.RowIter, []sql.Expression) {
 		if newChild, projs := GetDeferredProjections(i.GetIter()); projs != nil {
 			return i.WithChildIter(newChild), projs
 		}
+	case *rowexec.StatementTimeoutIter:
+		if newChild, projs := GetDeferredProjections(i.GetIter()); projs != nil {
+			return i.WithChildIter(newChild), projs
+		}
 	case *iters.LimitIter:
 		if newChild, projs := GetDeferredProjections(i.ChildIter); projs != nil {
 			i.ChildIter = newChild
@@ -745,6 +794,9 @@ func (h *Handler) resultForDefaultIter(ctx *sql.Context, c *mysql.Conn, schema s
 					return sqlErr
 				}
 
+				if err := ctx.MemoryTracker().Grow(spooledRowSize(outRow)); err != nil {
+					return err
+				}
 				ctx.GetLogger().Tracef("spooling result row %s", outRow)
 				res.Rows = append(res.Rows, outRow)
 				res.RowsAffected++
@@ -777,6 +829,8 @@ func (h *Handler) resultForDefaultIter(ctx *sql.Context, c *mysql.Conn, schema s
 				}
 				processedAtLeastOneBatch = true
 				err = callback(r, more)
+				// the rows of the batch have been sent, and are no longer held by the query
+				ctx.MemoryTracker().Shrink(spooledResultSize(r))
 				if err != nil {
 					return err
 				}
@@ -894,6 +948,9 @@ func (h *Handler) resultForValueRowIter(ctx *sql.Context, c *mysql.Conn, schema
 					return sqlErr
 				}
 
+				if err := ctx.MemoryTracker().Grow(spooledRowSize(outRow)); err != nil {
+					return err
+				}
 				ctx.GetLogger().Tracef("spooling result row %s", outRow)
 				res.Rows[res.RowsAffected] = outRow
 				res.RowsAffected++
@@ -926,6 +983,8 @@ func (h *Handler) resultForValueRowIter(ctx *sql.Context, c *mysql.Conn, schema
 				}
 				processedAtLeastOneBatch = true
 				err = callback(r, more)
+				// the rows of the batch have been sent, and are no longer held by the query
+				ctx.MemoryTracker().Shrink(spooledResultSize(r))
 				if err != nil {
 					return err
 				}
@@ -1195,33 +1254,6 @@ func schemaToFields(ctx *sql.Context, s sql.Schema) []*querypb.Field {
 	charSetResults := ctx.GetCharacterSetResults()
 	fields := make([]*querypb.Field, len(s))
 	for i, c := range s {
-		charset := uint32(sql.Collation_Default.CharacterSet())
-		if collatedType, ok := c.Type.(sql.TypeWithCollation); ok {
-			charset = uint32(collatedType.Collation().CharacterSet())
-		}
-
-		// Binary types always use a binary collation, but non-binary types must
-		// respect character_set_results if it is set.
-		if types.IsBinaryType(c.Type) {
-			charset = uint32(sql.Collation_binary)
-		} else if charSetResults != sql.CharacterSet_Unspecified {
-			charset = uint32(charSetResults)
-		}
-
-		var flags querypb.MySqlFlag
-		if !c.Nullable {
-			flags = flags | querypb.MySqlFlag_NOT_NULL_FLAG
-		}
-		if c.AutoIncrement {
-			flags = flags | querypb.MySqlFlag_AUTO_INCREMENT_FLAG
-		}
-		if c.PrimaryKey {
-			flags = flags | querypb.MySqlFlag_PRI_KEY_FLAG
-		}
-		if types.IsUnsigned(c.Type) {
-			flags = flags | querypb.MySqlFlag_UNSIGNED_FLAG
-		}
-
 		fields[i] = &querypb.Field{
 			Name:         c.Name,
 			OrgName:      c.Name,
@@ -1229,9 +1261,9 @@ func schemaToFields(ctx *sql.Context, s sql.Schema) []*querypb.Field {
 			OrgTable:     c.Source,
 			Database:     c.DatabaseSource,
 			Type:         c.Type.Type(),
-			Charset:      charset,
+			Charset:      columnCharset(c.Type, charSetResults),
 			ColumnLength: c.Type.MaxTextResponseByteLength(ctx),
-			Flags:        uint32(flags),
+			Flags:        uint32(columnFlags(c)),
 		}
 
 		if types.IsDecimal(c.Type) {
@@ -1240,12 +1272,79 @@ func schemaToFields(ctx *sql.Context, s sql.Schema) []*querypb.Field {
 		} else if types.IsDatetimeType(c.Type) {
 			dtType := c.Type.(sql.DatetimeType)
 			fields[i].Decimals = uint32(dtType.Precision())
+		} else if timeType, ok := c.Type.(types.TimeType); ok {
+			fields[i].Decimals = uint32(timeType.Precision())
+		} else if types.IsFloat(c.Type) {
+			// Floating point columns don't have a fixed scale, which MySQL reports as NOT_FIXED_DEC
+			fields[i].Decimals = notFixedDecimals
 		}
 	}
 
 	return fields
 }
 
+// notFixedDecimals is the decimals value MySQL reports for columns without a fixed number of decimal places.
+const notFixedDecimals = 31
+
+// columnCharset returns the collation id sent in the column metadata for a column of type |typ|. Only character
+// string types have a character set; every other type, including binary strings, is reported as binary. Character
+// strings are converted to character_set_results when it is set, so they report its default collation instead of
+// their own.
+func columnCharset(typ sql.Type, charSetResults sql.CharacterSetID) uint32 {
+	collatedType, ok := typ.(sql.TypeWithCollation)
+	if !ok || types.IsBinaryType(typ) || types.IsBit(typ) {
+		return uint32(sql.Collation_binary)
+	}
+	collation := collatedType.Collation()
+	if collation.CharacterSet() == sql.CharacterSet_binary {
+		return uint32(sql.Collation_binary)
+	}
+	if charSetResults != sql.CharacterSet_Unspecified {
+		return uint32(charSetResults.DefaultCollation())
+	}
+	return uint32(collation)
+}
+
+// columnFlags returns the column definition flags MySQL sends for the column |c|.
+func columnFlags(c *sql.Column) querypb.MySqlFlag {
+	var flags querypb.MySqlFlag
+	if !c.Nullable {
+		flags |= querypb.MySqlFlag_NOT_NULL_FLAG
+	}
+	if c.AutoIncrement {
+		flags |= querypb.MySqlFlag_AUTO_INCREMENT_FLAG
+	}
+	if c.PrimaryKey {
+		flags |= querypb.MySqlFlag_PRI_KEY_FLAG | querypb.MySqlFlag_PART_KEY_FLAG
+	}
+
+	switch {
+	case types.IsUnsigned(c.Type) || types.IsBit(c.Type):
+		flags |= querypb.MySqlFlag_UNSIGNED_FLAG
+	case types.IsYear(c.Type):
+		flags |= querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_ZEROFILL_FLAG
+	case types.IsEnum(c.Type):
+		flags |= querypb.MySqlFlag_ENUM_FLAG
+	case types.IsSet(c.Type):
+		flags |= querypb.MySqlFlag_SET_FLAG
+	}
+
+	if types.IsTextBlob(c.Type) || types.IsJSON(c.Type) || types.IsGeometry(c.Type) {
+		flags |= querypb.MySqlFlag_BLOB_FLAG
+	}
+	if types.IsBinaryType(c.Type) || types.IsTime(c.Type) || types.IsTimespan(c.Type) {
+		flags |= querypb.MySqlFlag_BINARY_FLAG
+	}
+	if types.IsTimestampType(c.Type) {
+		flags |= querypb.MySqlFlag_TIMESTAMP_FLAG
+		if c.OnUpdate != nil {
+			flags |= querypb.MySqlFlag_ON_UPDATE_NOW_FLAG
+		}
+	}
+
+	return flags
+}
+
 func (h *Handler) observeQuery(ctx *sql.Context, query string) func(err error) {
 	span, ctx := ctx.Span("query", otel.WithAttributes(attribute.String("query", query)))
 
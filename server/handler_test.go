@@ -360,6 +360,117 @@ func TestHandlerComResetConnection(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestHandlerClientCharset(t *testing.T) {
+	e, pro := setupMemDB(require.New(t))
+	dummyConn := newConn(1)
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			sql.NewContext,
+			testSessionBuilder(pro),
+			sql.NoopTracer,
+			pro.Database,
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+	}
+	handler.NewConnection(dummyConn)
+	handler.ComInitDB(dummyConn, "test")
+	query := func(q string) [][]sqltypes.Value {
+		var rows [][]sqltypes.Value
+		err := handler.ComQuery(context.Background(), dummyConn, q, func(res *sqltypes.Result, more bool) error {
+			rows = append(rows, res.Rows...)
+			return nil
+		})
+		require.NoError(t, err)
+		return rows
+	}
+
+	// 中文 in GBK
+	gbk := "\xd6\xd0\xce\xc4"
+	query("CREATE TABLE words (pk INT PRIMARY KEY, word VARCHAR(20))")
+	query("SET NAMES gbk")
+	rows := query("SELECT '" + gbk + "'")
+	require.Equal(t, gbk, rows[0][0].ToString())
+	query("INSERT INTO words VALUES (1, '" + gbk + "')")
+	rows = query("SELECT pk, LENGTH(word), CHAR_LENGTH(word) FROM words WHERE word = '" + gbk + "'")
+	require.Equal(t, "1", rows[0][0].ToString())
+	require.Equal(t, "6", rows[0][1].ToString())
+	require.Equal(t, "2", rows[0][2].ToString())
+
+	remainder, err := handler.ComMultiQuery(context.Background(), dummyConn, "SELECT 1; SELECT '"+gbk+"'", dummyCb)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT '"+gbk+"'", strings.TrimSpace(remainder))
+
+	// 0x81 is not a valid lead byte on its own
+	err = handler.ComQuery(context.Background(), dummyConn, "SELECT '\x81'", dummyCb)
+	require.ErrorContains(t, err, "invalid string for character set `gbk`")
+
+	query("SET NAMES latin1")
+	rows = query("SELECT word FROM words")
+	require.Equal(t, "??", rows[0][0].ToString())
+	query("SET NAMES utf8mb4")
+	rows = query("SELECT word FROM words")
+	require.Equal(t, "中文", rows[0][0].ToString())
+}
+
+func TestHandlerValueRows(t *testing.T) {
+	e, pro := setupMemDB(require.New(t))
+	dummyConn := newConn(1)
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			sql.NewContext,
+			testSessionBuilder(pro),
+			sql.NoopTracer,
+			pro.Database,
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+	}
+	handler.NewConnection(dummyConn)
+	handler.ComInitDB(dummyConn, "test")
+	query := func(q string) []string {
+		var rows []string
+		err := handler.ComQuery(context.Background(), dummyConn, q, func(res *sqltypes.Result, more bool) error {
+			for _, row := range res.Rows {
+				rows = append(rows, fmt.Sprint(row))
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return rows
+	}
+
+	query("CREATE TABLE nums (pk INT PRIMARY KEY, ti TINYINT, mi MEDIUMINT, u BIGINT UNSIGNED, f FLOAT, d DOUBLE, s VARCHAR(10), b VARBINARY(10))")
+	query(`INSERT INTO nums VALUES
+		(1, -1, -70000, 18446744073709551615, 1.5, 1e20, 'a', 'x'),
+		(2, 0, 0, 0, -0.25, 0.1, '', ''),
+		(3, NULL, NULL, NULL, NULL, NULL, NULL, NULL),
+		(4, 4, 8388607, 4, 4, 4.5, 'four', 'y')`)
+
+	// Results of the plans that use sql.ValueRows must match those of the same queries sorted with the row path, which
+	// sorts on an expression so that the primary key order isn't used
+	for _, q := range []struct {
+		query  string
+		sorted string
+	}{
+		{"SELECT * FROM nums", "SELECT * FROM nums ORDER BY pk + 0"},
+		{"SELECT pk, ti AS t, s FROM nums WHERE ti >= 0", "SELECT pk, ti AS t, s FROM nums WHERE ti >= 0 ORDER BY pk + 0"},
+		{"SELECT pk, mi, 1 FROM nums WHERE mi < 1", "SELECT pk, mi, 1 FROM nums WHERE mi < 1 ORDER BY pk + 0"},
+		{"SELECT pk FROM nums WHERE ti", "SELECT pk FROM nums WHERE ti ORDER BY pk + 0"},
+		{"SELECT f, d, u FROM nums LIMIT 2 OFFSET 1", "SELECT f, d, u FROM nums ORDER BY pk + 0 LIMIT 2 OFFSET 1"},
+	} {
+		t.Run(q.query, func(t *testing.T) {
+			expected := query(q.sorted)
+			require.NotEmpty(t, expected)
+			require.Equal(t, expected, query(q.query))
+		})
+	}
+}
+
 func TestHandlerComPrepare(t *testing.T) {
 	e, pro := setupMemDB(require.New(t))
 	dummyConn := newConn(1)
@@ -406,7 +517,7 @@ func TestHandlerComPrepare(t *testing.T) {
 			name:      "select statement returns non-nil schema",
 			statement: "select c1 from test where c1 > ?",
 			expected: []*query.Field{
-				{Name: "c1", OrgName: "c1", Table: "test", OrgTable: "test", Database: "test", Type: query.Type_INT32, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+				{Name: "c1", OrgName: "c1", Table: "test", OrgTable: "test", Database: "test", Type: query.Type_INT32, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 		},
 		{
@@ -475,7 +586,7 @@ func TestHandlerComPrepareExecute(t *testing.T) {
 			},
 			schema: []*query.Field{
 				{Name: "c1", OrgName: "c1", Table: "test", OrgTable: "test", Database: "test", Type: query.Type_INT32,
-					Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+					Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{0}, {1}, {2}, {3}, {4},
@@ -555,7 +666,7 @@ func TestHandlerComPrepareExecuteWithPreparedDisabled(t *testing.T) {
 			},
 			schema: []*query.Field{
 				{Name: "c1", OrgName: "c1", Table: "test", OrgTable: "test", Database: "test", Type: query.Type_INT32,
-					Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+					Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{0}, {1}, {2}, {3}, {4},
@@ -573,7 +684,7 @@ func TestHandlerComPrepareExecuteWithPreparedDisabled(t *testing.T) {
 			},
 			schema: []*query.Field{
 				{Name: "a", OrgName: "a", Table: "", OrgTable: "", Database: "", Type: query.Type_INT16,
-					Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 6, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+					Charset: mysql.CharacterSetBinary, ColumnLength: 6, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{1000},
@@ -591,7 +702,7 @@ func TestHandlerComPrepareExecuteWithPreparedDisabled(t *testing.T) {
 			},
 			schema: []*query.Field{
 				{Name: "a", OrgName: "a", Table: "", OrgTable: "", Database: "", Type: query.Type_INT16,
-					Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 6, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+					Charset: mysql.CharacterSetBinary, ColumnLength: 6, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{-129},
@@ -971,57 +1082,57 @@ func TestSchemaToFields(t *testing.T) {
 
 	expected := []*query.Field{
 		// Blob, Text, and JSON Types
-		{Name: "tinyblob", OrgName: "tinyblob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 255, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "blob", OrgName: "blob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 65_535, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "mediumblob", OrgName: "mediumblob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 16_777_215, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "longblob", OrgName: "longblob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "tinytext", OrgName: "tinytext", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 1020, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "text", OrgName: "text", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 262_140, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "mediumtext", OrgName: "mediumtext", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 67_108_860, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "longtext", OrgName: "longtext", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "json", OrgName: "json", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_JSON, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "tinyblob", OrgName: "tinyblob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 255, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "blob", OrgName: "blob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 65_535, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "mediumblob", OrgName: "mediumblob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 16_777_215, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "longblob", OrgName: "longblob", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "tinytext", OrgName: "tinytext", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 1020, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG)},
+		{Name: "text", OrgName: "text", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 262_140, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG)},
+		{Name: "mediumtext", OrgName: "mediumtext", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 67_108_860, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG)},
+		{Name: "longtext", OrgName: "longtext", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TEXT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG)},
+		{Name: "json", OrgName: "json", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_JSON, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
 
 		// Geometry Types
-		{Name: "geometry", OrgName: "geometry", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "point", OrgName: "point", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "polygon", OrgName: "polygon", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "linestring", OrgName: "linestring", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "geometry", OrgName: "geometry", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "point", OrgName: "point", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "polygon", OrgName: "polygon", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "linestring", OrgName: "linestring", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
 
 		// Integer Types
-		{Name: "uint8", OrgName: "uint8", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT8, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 3, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
-		{Name: "int8", OrgName: "int8", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT8, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 4, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "uint16", OrgName: "uint16", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT16, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 5, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
-		{Name: "int16", OrgName: "int16", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT16, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 6, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "uint24", OrgName: "uint24", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT24, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 8, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
-		{Name: "int24", OrgName: "int24", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT24, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 9, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "uint32", OrgName: "uint32", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT32, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 10, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
-		{Name: "int32", OrgName: "int32", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT32, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "uint64", OrgName: "uint64", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT64, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
-		{Name: "int64", OrgName: "int64", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT64, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "uint8", OrgName: "uint8", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT8, Charset: mysql.CharacterSetBinary, ColumnLength: 3, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int8", OrgName: "int8", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT8, Charset: mysql.CharacterSetBinary, ColumnLength: 4, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "uint16", OrgName: "uint16", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT16, Charset: mysql.CharacterSetBinary, ColumnLength: 5, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int16", OrgName: "int16", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT16, Charset: mysql.CharacterSetBinary, ColumnLength: 6, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "uint24", OrgName: "uint24", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT24, Charset: mysql.CharacterSetBinary, ColumnLength: 8, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int24", OrgName: "int24", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT24, Charset: mysql.CharacterSetBinary, ColumnLength: 9, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "uint32", OrgName: "uint32", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT32, Charset: mysql.CharacterSetBinary, ColumnLength: 10, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int32", OrgName: "int32", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT32, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "uint64", OrgName: "uint64", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_UINT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int64", OrgName: "int64", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_INT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 
 		// Floating Point and Decimal Types
-		{Name: "float32", OrgName: "float32", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_FLOAT32, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 12, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "float64", OrgName: "float64", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_FLOAT64, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 22, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "decimal10_0", OrgName: "decimal10_0", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DECIMAL, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 11, Decimals: 0, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "decimal60_30", OrgName: "decimal60_30", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DECIMAL, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 62, Decimals: 30, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "float32", OrgName: "float32", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_FLOAT32, Charset: mysql.CharacterSetBinary, ColumnLength: 12, Decimals: 31, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "float64", OrgName: "float64", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_FLOAT64, Charset: mysql.CharacterSetBinary, ColumnLength: 22, Decimals: 31, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "decimal10_0", OrgName: "decimal10_0", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Decimals: 0, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "decimal60_30", OrgName: "decimal60_30", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetBinary, ColumnLength: 62, Decimals: 30, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 
 		// Char, Binary, and Bit Types
 		{Name: "varchar50", OrgName: "varchar50", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_VARCHAR, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 50 * 4, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "varbinary12345", OrgName: "varbinary12345", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_VARBINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 12345, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "binary123", OrgName: "binary123", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 123, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "varbinary12345", OrgName: "varbinary12345", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_VARBINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 12345, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "binary123", OrgName: "binary123", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 123, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BINARY_FLAG)},
 		{Name: "char123", OrgName: "char123", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_CHAR, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 123 * 4, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "bit12", OrgName: "bit12", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BIT, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 12, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "bit12", OrgName: "bit12", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_BIT, Charset: mysql.CharacterSetBinary, ColumnLength: 12, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
 
 		// Dates
-		{Name: "datetime", OrgName: "datetime", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DATETIME, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 26, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "timestamp", OrgName: "timestamp", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TIMESTAMP, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 26, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "date", OrgName: "date", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DATE, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 10, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "time", OrgName: "time", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TIME, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 17, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "year", OrgName: "year", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_YEAR, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 4, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "datetime", OrgName: "datetime", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DATETIME, Charset: mysql.CharacterSetBinary, ColumnLength: 19, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "timestamp", OrgName: "timestamp", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TIMESTAMP, Charset: mysql.CharacterSetBinary, ColumnLength: 19, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BINARY_FLAG | query.MySqlFlag_TIMESTAMP_FLAG)},
+		{Name: "date", OrgName: "date", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_DATE, Charset: mysql.CharacterSetBinary, ColumnLength: 10, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "time", OrgName: "time", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_TIME, Charset: mysql.CharacterSetBinary, ColumnLength: 17, Decimals: 6, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "year", OrgName: "year", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_YEAR, Charset: mysql.CharacterSetBinary, ColumnLength: 4, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_UNSIGNED_FLAG | query.MySqlFlag_ZEROFILL_FLAG)},
 
 		// Set and Enum Types
-		{Name: "set", OrgName: "set", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_SET, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 72, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
-		{Name: "enum", OrgName: "enum", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_ENUM, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "set", OrgName: "set", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_SET, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 72, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_SET_FLAG)},
+		{Name: "enum", OrgName: "enum", Table: "table1", OrgTable: "table1", Database: "db1", Type: query.Type_ENUM, Charset: uint32(sql.CharacterSet_utf8mb4), ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_ENUM_FLAG)},
 	}
 
 	require.Equal(len(schema), len(expected))
@@ -1057,6 +1168,36 @@ func TestSchemaToFields(t *testing.T) {
 	}
 }
 
+// TestSchemaToFieldsMetadata tests the collation and flags reported for columns whose metadata depends on the
+// session and on the column definition rather than only on the column type.
+func TestSchemaToFieldsMetadata(t *testing.T) {
+	session := sql.NewBaseSession()
+	ctx := sql.NewContext(
+		context.Background(),
+		sql.WithSession(session),
+	)
+
+	schema := sql.Schema{
+		{Name: "pk", Source: "t", Type: types.Int64, PrimaryKey: true, AutoIncrement: true},
+		{Name: "vc", Source: "t", Type: types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb4_bin), Nullable: true},
+		{Name: "ts", Source: "t", Type: types.Timestamp, Nullable: true, OnUpdate: &sql.ColumnDefaultValue{}},
+	}
+
+	require.NoError(t, session.SetSessionVariable(ctx, "character_set_results", nil))
+	fields := schemaToFields(ctx, schema)
+	require.Equal(t, uint32(mysql.CharacterSetBinary), fields[0].Charset)
+	require.Equal(t, uint32(query.MySqlFlag_NOT_NULL_FLAG|query.MySqlFlag_PRI_KEY_FLAG|query.MySqlFlag_PART_KEY_FLAG|query.MySqlFlag_AUTO_INCREMENT_FLAG), fields[0].Flags)
+	require.Equal(t, uint32(sql.Collation_utf8mb4_bin), fields[1].Charset)
+	require.Equal(t, uint32(0), fields[1].Flags)
+	require.Equal(t, uint32(query.MySqlFlag_BINARY_FLAG|query.MySqlFlag_TIMESTAMP_FLAG|query.MySqlFlag_ON_UPDATE_NOW_FLAG), fields[2].Flags)
+
+	// Character strings are converted to character_set_results, so they report its default collation
+	require.NoError(t, session.SetSessionVariable(ctx, "character_set_results", "latin1"))
+	fields = schemaToFields(ctx, schema)
+	require.Equal(t, uint32(mysql.CharacterSetBinary), fields[0].Charset)
+	require.Equal(t, uint32(sql.CharacterSet_latin1.DefaultCollation()), fields[1].Charset)
+}
+
 // TestHandlerMaxTextResponseBytes tests that the handler calculates the correct max text response byte
 // metadata for TEXT types, including honoring the character_set_results session variable. This is tested
 // here, instead of in string type unit tests, because of the dependency on system variables being loaded.
@@ -1282,6 +1423,40 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 	}
 }
 
+func TestHandlerDisabledCapabilities(t *testing.T) {
+	e, pro := setupMemDB(require.New(t))
+	dbFunc := pro.Database
+	dummyConn := newConn(1)
+	dummyConn.Capabilities = mysql.CapabilityClientFoundRows | mysql.CapabilityClientDeprecateEOF
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			sql.NewContext,
+			testSessionBuilder(pro),
+			sql.NoopTracer,
+			dbFunc,
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		disabledCaps: mysql.CapabilityClientFoundRows,
+	}
+
+	handler.NewConnection(dummyConn)
+	require.NoError(t, handler.ConnectionAuthenticated(dummyConn))
+	require.Equal(t, uint32(mysql.CapabilityClientDeprecateEOF), dummyConn.Capabilities)
+
+	handler.ComInitDB(dummyConn, "test")
+	var rowsAffected uint64
+	err := handler.ComQuery(context.Background(), dummyConn, "UPDATE test set c1 = c1 where c1 < 10", func(res *sqltypes.Result, more bool) error {
+		rowsAffected = res.RowsAffected
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), rowsAffected)
+}
+
 func setupMemDB(require *require.Assertions) (*sqle.Engine, *memory.DbProvider) {
 	db := memory.NewDatabase("test")
 	pro := memory.NewDBProvider(db)
@@ -0,0 +1,167 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// quotaWindow is the period over which the statements of an account are counted against its limits.
+const quotaWindow = time.Hour
+
+// DigestQuotas limits how often each account may execute statements with a particular digest, such as the shape of a
+// query that's too expensive to let a single client run at will on a shared deployment. Statements have the same
+// digest if their text is the same other than the values of their literals.
+type DigestQuotas interface {
+	// MaxExecutionsPerHour returns the number of times per hour that the account |user|@|host| may execute statements
+	// with the digest |digest|, or 0 if they aren't limited. |normalized| is the text of the statement with its
+	// literals replaced by placeholders.
+	MaxExecutionsPerHour(ctx *sql.Context, user, host, digest, normalized string) uint64
+}
+
+// quotaTracker counts the statements that each account executes in the current window, to enforce the
+// MAX_QUERIES_PER_HOUR and MAX_UPDATES_PER_HOUR limits of the account and the limits of |digests|. Only accounts with
+// limits are tracked. The zero value is ready to use.
+type quotaTracker struct {
+	mu sync.Mutex
+	// usage is the usage of each account, keyed by user@host
+	usage   map[string]*accountUsage
+	digests DigestQuotas
+}
+
+// accountUsage is the number of statements that an account has executed since |start|.
+type accountUsage struct {
+	start     time.Time
+	questions uint64
+	updates   uint64
+	digests   map[string]uint64
+}
+
+// statementQuota is a statement about to be executed, along with the limits of the account executing it.
+type statementQuota struct {
+	user         string
+	host         string
+	maxQuestions uint64
+	maxUpdates   uint64
+	changesData  bool
+	digest       string
+	maxDigest    uint64
+}
+
+// use counts the statement |q| against the limits of its account, and returns ErrUserLimitReached if the account has
+// already used up one of them in the current window. As in MySQL, the value reported in the error is the limit itself.
+func (t *quotaTracker) use(now time.Time, q statementQuota) error {
+	if q.maxQuestions == 0 && q.maxUpdates == 0 && q.maxDigest == 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.usage == nil {
+		t.usage = make(map[string]*accountUsage)
+	}
+	key := q.user + "@" + q.host
+	u, ok := t.usage[key]
+	if !ok || now.Sub(u.start) >= quotaWindow {
+		u = &accountUsage{start: now}
+		t.usage[key] = u
+	}
+
+	if q.maxQuestions > 0 {
+		if u.questions >= q.maxQuestions {
+			return sql.ErrUserLimitReached.New(q.user, "max_questions", q.maxQuestions)
+		}
+		u.questions++
+	}
+	if q.changesData && q.maxUpdates > 0 {
+		if u.updates >= q.maxUpdates {
+			return sql.ErrUserLimitReached.New(q.user, "max_updates", q.maxUpdates)
+		}
+		u.updates++
+	}
+	if q.maxDigest > 0 {
+		if u.digests == nil {
+			u.digests = make(map[string]uint64)
+		}
+		if u.digests[q.digest] >= q.maxDigest {
+			return sql.ErrUserLimitReached.New(q.user, "max_executions_per_digest", q.maxDigest)
+		}
+		u.digests[q.digest]++
+	}
+	return nil
+}
+
+// checkQuotas counts the statement |query| about to be executed in |ctx| against the limits of the session's account,
+// and returns ErrUserLimitReached if the account has exceeded one of them. |parsed| and |analyzed| are the statement's
+// parsed and analyzed forms, either of which may be nil.
+func (h *Handler) checkQuotas(ctx *sql.Context, query string, parsed sqlparser.Statement, analyzed sql.Node) error {
+	client := ctx.Session.Client()
+	q := statementQuota{user: client.User, host: client.Address}
+	if mysqlDb := h.e.Analyzer.Catalog.MySQLDb; mysqlDb != nil && mysqlDb.Enabled() {
+		rd := mysqlDb.Reader()
+		user := mysqlDb.GetUser(rd, client.User, client.Address, false)
+		rd.Close()
+		if user != nil {
+			q.user, q.host = user.User, user.Host
+			q.maxQuestions, q.maxUpdates = user.MaxQuestions, user.MaxUpdates
+		}
+	}
+
+	if h.quotas.digests != nil {
+		normalized, err := sqlparser.RedactSQLQuery(query)
+		if err != nil {
+			normalized = query
+		}
+		sum := sha256.Sum256([]byte(normalized))
+		q.digest = hex.EncodeToString(sum[:])
+		q.maxDigest = h.quotas.digests.MaxExecutionsPerHour(ctx, q.user, q.host, q.digest, normalized)
+	}
+
+	if q.maxUpdates > 0 {
+		if analyzed != nil {
+			q.changesData = !analyzed.IsReadOnly()
+		} else {
+			if parsed == nil {
+				// a statement that fails to parse won't change any data
+				parsed, _, _, _ = h.e.Parser.Parse(ctx, query, false)
+			}
+			q.changesData = parsed != nil && changesData(parsed)
+		}
+	}
+
+	return h.quotas.use(time.Now(), q)
+}
+
+// changesData returns whether |stmt| is one that counts against the MAX_UPDATES_PER_HOUR limit of an account: a
+// statement that modifies rows, the schema, or accounts and their privileges.
+func changesData(stmt sqlparser.Statement) bool {
+	switch stmt.(type) {
+	case *sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete, *sqlparser.Load,
+		*sqlparser.DDL, *sqlparser.DBDDL, *sqlparser.AlterTable, *sqlparser.CreateSpatialRefSys,
+		*sqlparser.CreateUser, *sqlparser.RenameUser, *sqlparser.DropUser, *sqlparser.CreateRole, *sqlparser.DropRole,
+		*sqlparser.GrantPrivilege, *sqlparser.GrantRole, *sqlparser.GrantProxy,
+		*sqlparser.RevokePrivilege, *sqlparser.RevokeRole, *sqlparser.RevokeProxy:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestQuotaTracker(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("max questions", func(t *testing.T) {
+		var tracker quotaTracker
+		q := statementQuota{user: "alice", host: "%", maxQuestions: 2}
+		require.NoError(t, tracker.use(start, q))
+		require.NoError(t, tracker.use(start, q))
+		err := tracker.use(start, q)
+		require.True(t, sql.ErrUserLimitReached.Is(err))
+		require.Equal(t, "User 'alice' has exceeded the 'max_questions' resource (current value: 2)", err.Error())
+		require.Equal(t, mysql.ERUserLimitReached, sql.CastSQLError(err).Num)
+
+		// other accounts have their own limits
+		require.NoError(t, tracker.use(start, statementQuota{user: "bob", host: "%", maxQuestions: 2}))
+		// the count starts over in the next window
+		require.NoError(t, tracker.use(start.Add(quotaWindow), q))
+	})
+
+	t.Run("max updates", func(t *testing.T) {
+		var tracker quotaTracker
+		read := statementQuota{user: "alice", host: "%", maxUpdates: 1}
+		write := read
+		write.changesData = true
+		require.NoError(t, tracker.use(start, write))
+		require.NoError(t, tracker.use(start, read))
+		err := tracker.use(start, write)
+		require.True(t, sql.ErrUserLimitReached.Is(err))
+		require.Contains(t, err.Error(), "'max_updates'")
+		require.NoError(t, tracker.use(start, read))
+	})
+
+	t.Run("max executions per digest", func(t *testing.T) {
+		var tracker quotaTracker
+		q1 := statementQuota{user: "alice", host: "%", digest: "d1", maxDigest: 1}
+		q2 := statementQuota{user: "alice", host: "%", digest: "d2", maxDigest: 1}
+		require.NoError(t, tracker.use(start, q1))
+		require.NoError(t, tracker.use(start, q2))
+		err := tracker.use(start, q1)
+		require.True(t, sql.ErrUserLimitReached.Is(err))
+		require.Contains(t, err.Error(), "'max_executions_per_digest'")
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		var tracker quotaTracker
+		q := statementQuota{user: "alice", host: "%", changesData: true}
+		for i := 0; i < 10; i++ {
+			require.NoError(t, tracker.use(start, q))
+		}
+		require.Empty(t, tracker.usage)
+	})
+}
+
+func TestChangesData(t *testing.T) {
+	tests := []struct {
+		query       string
+		changesData bool
+	}{
+		{"select * from t", false},
+		{"show tables", false},
+		{"set @a = 1", false},
+		{"insert into t values (1)", true},
+		{"update t set a = 1", true},
+		{"delete from t", true},
+		{"create table t2 (a int primary key)", true},
+		{"alter table t add column b int", true},
+		{"drop database db", true},
+		{"create user bob", true},
+		{"grant select on *.* to bob", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.changesData, changesData(stmt))
+		})
+	}
+}
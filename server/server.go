@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/trace"
+	goerrors "gopkg.in/src-d/go-errors.v1"
 
 	sqle "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -48,11 +49,21 @@ func MySQLProtocolListenerFactory(cfg Config, listenerCfg mysql.ListenerConfig,
 	if cfg.Version != "" {
 		vtListener.ServerVersion = cfg.Version
 	}
+	vtListener.DisabledCapabilities = cfg.DisabledCapabilities
 	vtListener.TLSConfig = cfg.TLSConfig
 	vtListener.RequireSecureTransport = cfg.RequireSecureTransport
 	return vtListener, nil
 }
 
+// DisableableCapabilities are the capability flags which may be set in Config.DisabledCapabilities. These are left out
+// of the capabilities advertised in the initial handshake, and only affect how the server interprets statements, so
+// listeners from a custom ProtocolListenerFactory may also drop them after the handshake.
+const DisableableCapabilities = mysql.CapabilityClientFoundRows | mysql.CapabilityClientMultiStatements
+
+// ErrUnsupportedDisabledCapability is returned when Config.DisabledCapabilities contains flags outside of
+// DisableableCapabilities.
+var ErrUnsupportedDisabledCapability = goerrors.NewKind("capability flags 0x%x cannot be disabled")
+
 type ServerEventListener interface {
 	ClientConnected()
 	ClientDisconnected()
@@ -90,16 +101,24 @@ func NewServerWithHandler(
 		tracer = sql.NoopTracer
 	}
 
+	if unsupported := cfg.DisabledCapabilities &^ DisableableCapabilities; unsupported != 0 {
+		return nil, ErrUnsupportedDisabledCapability.New(unsupported)
+	}
+
 	sm := NewSessionManager(ctxFactory, sb, tracer, e.Analyzer.Catalog.Database, e.MemoryManager, e.ProcessList, cfg.Address)
 	sm.connWatcher = newConnWatcher(connWatchStartDelay, connWatchTick, cfg.DisableConnectionWatcher)
+	sm.fileSystem = cfg.FileSystem
+	sm.sessionVars = serverSessionVariables(cfg)
 	h := &Handler{
 		e:                 e,
 		sm:                sm,
 		readTimeout:       cfg.ConnReadTimeout,
 		disableMultiStmts: cfg.DisableClientMultiStatements,
+		disabledCaps:      cfg.DisabledCapabilities,
 		maxLoggedQueryLen: cfg.MaxLoggedQueryLen,
 		encodeLoggedQuery: cfg.EncodeLoggedQuery,
 		sel:               listener,
+		quotas:            quotaTracker{digests: cfg.DigestQuotas},
 
 		queryCounter:      cfg.QueryCounter,
 		queryErrorCounter: cfg.QueryErrorCounter,
@@ -136,6 +155,20 @@ func getPort(cfg mysql.ListenerConfig) (int64, error) {
 	return portInt, nil
 }
 
+// serverSessionVariables returns the system variables whose values are specific to the server configured by |cfg|.
+// These are given to the sessions of the server rather than assigned globally, so that several servers in the same
+// process may report different values.
+func serverSessionVariables(cfg Config) map[string]interface{} {
+	sysVars := make(map[string]interface{})
+	if cfg.Version != "" {
+		sysVars["version"] = cfg.Version
+	}
+	if cfg.VersionComment != "" {
+		sysVars["version_comment"] = cfg.VersionComment
+	}
+	return sysVars
+}
+
 func updateSystemVariables(cfg mysql.ListenerConfig) error {
 	sysVars := make(map[string]interface{})
 
@@ -182,6 +215,8 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 		}
 	}
 
+	addAuthenticationPlugins(e.Analyzer.Catalog.MySQLDb, cfg.AuthenticationPlugins, cfg.AuthenticationPluginTimeout)
+
 	listenerCfg := mysql.ListenerConfig{
 		Listener:                 l,
 		AuthServer:               e.Analyzer.Catalog.MySQLDb,
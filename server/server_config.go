@@ -62,8 +62,18 @@ type Config struct {
 	Address string
 	// Socket is a path to unix socket file
 	Socket string
-	// Version string to advertise in running server
+	// Version string to advertise in running server. When set, it is also
+	// reported by the version system variable and the VERSION() function in
+	// the sessions of this server.
 	Version string
+	// VersionComment is reported by the version_comment system variable in
+	// the sessions of this server. If empty, the default is left in place.
+	VersionComment string
+	// DisabledCapabilities is a set of mysql.Capability* flags that the server
+	// will neither advertise in its handshake nor honor for connections on this
+	// listener, even if the client requests them. Only flags which do not change
+	// the wire protocol framing may be disabled; see DisableableCapabilities.
+	DisabledCapabilities uint32
 
 	// Options gets a chance to visit and mutate the GMS *Engine,
 	// *server.SessionManager and the mysql.Handler as the server
@@ -109,6 +119,18 @@ type Config struct {
 	// true to let such queries run to completion regardless of the client's
 	// state.
 	DisableConnectionWatcher bool
+	// DigestQuotas, if set, limits how often each account may execute statements with the same digest, in addition to
+	// the MAX_QUERIES_PER_HOUR and MAX_UPDATES_PER_HOUR limits of the account.
+	DigestQuotas DigestQuotas
+	// FileSystem, if set, is the file system that LOAD DATA INFILE, SELECT ... INTO OUTFILE and LOAD_FILE() read and
+	// write, in place of the file system of the host.
+	FileSystem sql.FileSystem
+	// AuthenticationPlugins authenticate the accounts that name them as their plugin against external services, in
+	// place of the passwords stored in mysql.user.
+	AuthenticationPlugins []AuthenticationPlugin
+	// AuthenticationPluginTimeout is how long an authentication plugin may take to authenticate a client before the
+	// login is denied. When zero, the connect_timeout system variable is used.
+	AuthenticationPluginTimeout time.Duration
 }
 
 func (c Config) NewConfig() (Config, error) {
@@ -1,9 +1,12 @@
 package server_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/binary"
 	"fmt"
+	"io"
 	"net"
 	"testing"
 	"time"
@@ -217,3 +220,68 @@ func TestConnectionWatcherDisabled(t *testing.T) {
 		return runningQueries(engine) == 0
 	}, 2*time.Second, 50*time.Millisecond, "query was cancelled despite watcher being disabled")
 }
+
+// TestServersReportTheirOwnVersions verifies that the version settings of a server only apply to its own sessions, so
+// that several servers in one process may masquerade as different versions.
+func TestServersReportTheirOwnVersions(t *testing.T) {
+	_, host, port1 := startWatcherTestServer(t, server.Config{Version: "5.7.99", VersionComment: "first"})
+	_, _, port2 := startWatcherTestServer(t, server.Config{Version: "8.4.99", VersionComment: "second"})
+
+	for _, test := range []struct {
+		port    int
+		version string
+		comment string
+	}{
+		{port1, "5.7.99", "first"},
+		{port2, "8.4.99", "second"},
+	} {
+		conn, err := vsql.Connect(context.Background(), &vsql.ConnParams{
+			Host:   host,
+			Port:   test.port,
+			Uname:  "root",
+			DbName: "mydb",
+		})
+		require.NoError(t, err)
+		require.Equal(t, test.version, conn.ServerVersion)
+		res, err := conn.ExecuteFetch("SELECT @@version, @@version_comment, VERSION()", 1, false)
+		require.NoError(t, err)
+		require.Equal(t, test.version, res.Rows[0][0].ToString())
+		require.Equal(t, test.comment, res.Rows[0][1].ToString())
+		require.Equal(t, test.version, res.Rows[0][2].ToString())
+		conn.Close()
+	}
+}
+
+// TestDisabledCapabilitiesAreNotAdvertised verifies that disabled capabilities are left out of the initial handshake,
+// so that clients don't negotiate them in the first place.
+func TestDisabledCapabilitiesAreNotAdvertised(t *testing.T) {
+	handshakeCapabilities := func(port int) uint32 {
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+		require.NoError(t, err)
+		defer conn.Close()
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+
+		header := make([]byte, 4)
+		_, err = io.ReadFull(conn, header)
+		require.NoError(t, err)
+		packet := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+		_, err = io.ReadFull(conn, packet)
+		require.NoError(t, err)
+
+		// protocol version, null terminated server version, connection id, first part of the salt and a filler byte
+		pos := 1 + bytes.IndexByte(packet[1:], 0) + 1 + 4 + 8 + 1
+		lower := binary.LittleEndian.Uint16(packet[pos:])
+		// lower capabilities, character set and status flags
+		pos += 2 + 1 + 2
+		upper := binary.LittleEndian.Uint16(packet[pos:])
+		return uint32(upper)<<16 | uint32(lower)
+	}
+
+	_, _, port := startWatcherTestServer(t, server.Config{})
+	require.NotZero(t, handshakeCapabilities(port)&vsql.CapabilityClientFoundRows)
+	require.NotZero(t, handshakeCapabilities(port)&vsql.CapabilityClientMultiStatements)
+
+	_, _, port = startWatcherTestServer(t, server.Config{DisabledCapabilities: vsql.CapabilityClientFoundRows})
+	require.Zero(t, handshakeCapabilities(port)&vsql.CapabilityClientFoundRows)
+	require.NotZero(t, handshakeCapabilities(port)&vsql.CapabilityClientMultiStatements)
+}
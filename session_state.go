@@ -0,0 +1,369 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// SessionState is the minimal state of a session needed to continue it on another connection, as exported by
+// ExportSessionState and restored by RestoreSessionState. Prepared statements, temporary tables, table locks and named
+// locks are not part of it.
+type SessionState struct {
+	// User and Host identify the account of the session.
+	User string `json:"user"`
+	Host string `json:"host"`
+	// Database is the current database of the session.
+	Database string `json:"database,omitempty"`
+	// SystemVariables are the session values of the system variables that differ from their global values.
+	SystemVariables map[string]interface{} `json:"system_variables,omitempty"`
+	// UserVariables are the user variables of the session.
+	UserVariables map[string]UserVariableState `json:"user_variables,omitempty"`
+	// Transaction is the token of the open transaction of the session, if any. The engine holds the transaction until
+	// the state is restored.
+	Transaction string `json:"transaction,omitempty"`
+	// TransactionDatabase is the database the open transaction was started in.
+	TransactionDatabase string `json:"transaction_database,omitempty"`
+	// ExplicitTransaction is whether the open transaction was started with START TRANSACTION or BEGIN, rather than
+	// being implicit in a session with autocommit disabled.
+	ExplicitTransaction bool `json:"explicit_transaction,omitempty"`
+}
+
+// UserVariableState is the type and value of a user variable of a SessionState.
+type UserVariableState struct {
+	// Type is the SQL type of the variable, as written in a column definition.
+	Type string `json:"type,omitempty"`
+	// Value is the value of the variable, as sent to clients in a result set.
+	Value []byte `json:"value,omitempty"`
+	Null  bool   `json:"null,omitempty"`
+}
+
+// ExportSessionState serializes the state of the session of |ctx| so that it can be restored on another connection
+// with RestoreSessionState, for proxies that move clients between connections and servers that hand their connections
+// off during a restart. If the session has an open transaction, it's detached from the session and held by the engine
+// until the state is restored, so the session must not be used afterward, and the state must be restored by the same
+// engine within Config.SessionHandoffTimeout, after which the transaction is rolled back. Integrators whose
+// transactions keep state in the session that started them should commit before exporting.
+//
+// The exported state isn't authenticated: the account in it becomes the account of the session it's restored in, so it
+// must only be restored from a trusted source.
+func (e *Engine) ExportSessionState(ctx *sql.Context) ([]byte, error) {
+	sess := ctx.Session
+	client := sess.Client()
+	state := SessionState{
+		User:     client.User,
+		Host:     client.Address,
+		Database: sess.GetCurrentDatabase(),
+	}
+
+	for name, val := range sess.GetAllSessionVariables() {
+		sysVar, global, ok := sql.SystemVariables.GetGlobal(name)
+		if !ok || sysVar.IsReadOnly() || sysVar.IsGlobalOnly() {
+			continue
+		}
+		if setType, ok := sysVar.GetType().(sql.SetType); ok {
+			if bits, ok := global.(uint64); ok {
+				global, _ = setType.BitsToString(bits)
+			}
+		}
+		if fmt.Sprint(val) == fmt.Sprint(global) {
+			continue
+		}
+		if state.SystemVariables == nil {
+			state.SystemVariables = make(map[string]interface{})
+		}
+		state.SystemVariables[name] = val
+	}
+
+	if iter, ok := sess.(sql.UserVariableIterator); ok {
+		err := iter.IterUserVariables(ctx, func(name string, typ sql.Type, val interface{}) error {
+			if state.UserVariables == nil {
+				state.UserVariables = make(map[string]UserVariableState)
+			}
+			if val == nil || typ == nil {
+				state.UserVariables[name] = UserVariableState{Null: true}
+				return nil
+			}
+			sqlVal, err := typ.SQL(ctx, nil, val)
+			if err != nil {
+				return err
+			}
+			state.UserVariables[name] = UserVariableState{Type: typ.String(), Value: sqlVal.ToBytes()}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx := sess.GetTransaction()
+	if tx != nil {
+		token, err := e.handoffs.hold(sess, tx)
+		if err != nil {
+			return nil, err
+		}
+		state.Transaction = token
+		if tdb, ok := sess.(transactionDatabaseSession); ok {
+			state.TransactionDatabase = tdb.GetTransactionDatabase()
+		}
+		state.ExplicitTransaction = sess.GetIgnoreAutoCommit()
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		if tx != nil {
+			e.handoffs.take(state.Transaction)
+		}
+		return nil, err
+	}
+	if tx != nil {
+		sess.SetTransaction(nil)
+		sess.SetIgnoreAutoCommit(false)
+	}
+	return data, nil
+}
+
+// RestoreSessionState restores |data|, the state of a session exported by ExportSessionState, into the session of
+// |ctx|, which should be a new session that hasn't run any statements.
+func (e *Engine) RestoreSessionState(ctx *sql.Context, data []byte) error {
+	var state SessionState
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&state); err != nil {
+		return err
+	}
+	sess := ctx.Session
+
+	if mysqlDb := e.Analyzer.Catalog.MySQLDb; mysqlDb.Enabled() {
+		rd := mysqlDb.Reader()
+		user := mysqlDb.GetUser(rd, state.User, state.Host, false)
+		rd.Close()
+		if user == nil {
+			return sql.ErrSessionStateUnknownUser.New(state.User, state.Host)
+		}
+	}
+	client := sess.Client()
+	client.User = state.User
+	client.Address = state.Host
+	sess.SetClient(client)
+	// the cached privileges were those of the previous account
+	sess.SetPrivilegeSet(nil, 0)
+
+	for name, val := range state.SystemVariables {
+		if num, ok := val.(json.Number); ok {
+			val = numberValue(num)
+		}
+		if err := sess.SetSessionVariable(ctx, name, val); err != nil {
+			return err
+		}
+	}
+
+	for name, uv := range state.UserVariables {
+		if uv.Null {
+			if err := sess.SetUserVariable(ctx, name, nil, types.Null); err != nil {
+				return err
+			}
+			continue
+		}
+		typ, err := planbuilder.ParseColumnTypeString(ctx, uv.Type)
+		if err != nil {
+			return err
+		}
+		var raw interface{} = string(uv.Value)
+		if types.IsBinaryType(typ) {
+			raw = uv.Value
+		}
+		val, _, err := typ.Convert(ctx, raw)
+		if err != nil {
+			return err
+		}
+		if err = sess.SetUserVariable(ctx, name, val, typ); err != nil {
+			return err
+		}
+	}
+
+	if state.Database != "" {
+		db, err := e.Analyzer.Catalog.Database(ctx, state.Database)
+		if err != nil {
+			return err
+		}
+		sess.SetCurrentDatabase(state.Database)
+		if pdb, ok := db.(mysql_db.PrivilegedDatabase); ok {
+			db = pdb.Unwrap()
+		}
+		if err = sess.UseDatabase(ctx, db); err != nil {
+			return err
+		}
+	}
+
+	if state.Transaction != "" {
+		tx, ok := e.handoffs.take(state.Transaction)
+		if !ok {
+			return sql.ErrSessionStateUnknownTransaction.New(state.Transaction)
+		}
+		sess.SetTransaction(tx)
+		if tdb, ok := sess.(transactionDatabaseSession); ok {
+			tdb.SetTransactionDatabase(state.TransactionDatabase)
+		}
+		sess.SetIgnoreAutoCommit(state.ExplicitTransaction)
+	}
+	return nil
+}
+
+// DiscardSessionState rolls back the open transaction of a session state exported by ExportSessionState that won't be
+// restored, given the Transaction token of the state. The engine rolls back transactions that aren't restored within
+// Config.SessionHandoffTimeout, or that are still held when it closes, on its own.
+func (e *Engine) DiscardSessionState(token string) error {
+	return e.handoffs.discard(token)
+}
+
+// transactionDatabaseSession is implemented by sessions that record the database their transaction was started in,
+// such as BaseSession.
+type transactionDatabaseSession interface {
+	GetTransactionDatabase() string
+	SetTransactionDatabase(dbName string)
+}
+
+// numberValue returns the integer or float value of |num|, a number decoded from JSON.
+func numberValue(num json.Number) interface{} {
+	if i, err := num.Int64(); err == nil {
+		return i
+	}
+	if u, err := strconv.ParseUint(num.String(), 10, 64); err == nil {
+		return u
+	}
+	if f, err := num.Float64(); err == nil {
+		return f
+	}
+	return num.String()
+}
+
+// DefaultSessionHandoffTimeout is how long the engine holds the transaction of an exported session state, when
+// Config.SessionHandoffTimeout isn't set.
+const DefaultSessionHandoffTimeout = time.Minute
+
+// sessionHandoffs holds the transactions of exported session states until they're restored. A transaction that isn't
+// restored within the timeout, is discarded, or is still held when the engine closes is rolled back, so that it
+// doesn't keep holding its locks.
+type sessionHandoffs struct {
+	mu      sync.Mutex
+	held    map[string]*heldTransaction
+	timeout time.Duration
+}
+
+// heldTransaction is a transaction held by sessionHandoffs, along with the session that exported it, which rolls it
+// back.
+type heldTransaction struct {
+	tx    sql.Transaction
+	sess  sql.Session
+	timer *time.Timer
+}
+
+func newSessionHandoffs(timeout time.Duration) *sessionHandoffs {
+	if timeout <= 0 {
+		timeout = DefaultSessionHandoffTimeout
+	}
+	return &sessionHandoffs{held: make(map[string]*heldTransaction), timeout: timeout}
+}
+
+// hold holds |tx| of |sess| and returns the token that takes it back.
+func (h *sessionHandoffs) hold(sess sql.Session, tx sql.Transaction) (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b[:])
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	held := &heldTransaction{tx: tx, sess: sess}
+	held.timer = time.AfterFunc(h.timeout, func() {
+		if err := h.discard(token); err != nil && !sql.ErrSessionStateUnknownTransaction.Is(err) {
+			logrus.WithError(err).Warn("failed to roll back an expired session handoff")
+		}
+	})
+	h.held[token] = held
+	return token, nil
+}
+
+// take returns the transaction held for |token|, which is no longer held afterward.
+func (h *sessionHandoffs) take(token string) (sql.Transaction, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	held, ok := h.held[token]
+	if !ok {
+		return nil, false
+	}
+	held.timer.Stop()
+	delete(h.held, token)
+	return held.tx, true
+}
+
+// discard rolls back the transaction held for |token|.
+func (h *sessionHandoffs) discard(token string) error {
+	h.mu.Lock()
+	held, ok := h.held[token]
+	if ok {
+		held.timer.Stop()
+		delete(h.held, token)
+	}
+	h.mu.Unlock()
+	if !ok {
+		return sql.ErrSessionStateUnknownTransaction.New(token)
+	}
+	return held.rollback()
+}
+
+// discardAll rolls back every held transaction.
+func (h *sessionHandoffs) discardAll() error {
+	h.mu.Lock()
+	held := h.held
+	h.held = make(map[string]*heldTransaction)
+	h.mu.Unlock()
+
+	var err error
+	for _, ht := range held {
+		ht.timer.Stop()
+		if rbErr := ht.rollback(); rbErr != nil && err == nil {
+			err = rbErr
+		}
+	}
+	return err
+}
+
+// rollback rolls back the held transaction in the session that exported it.
+func (ht *heldTransaction) rollback() error {
+	ts, ok := ht.sess.(sql.TransactionSession)
+	if !ok {
+		return nil
+	}
+	ctx := sql.NewContext(context.Background(), sql.WithSession(ht.sess))
+	return ts.Rollback(ctx, ht.tx)
+}
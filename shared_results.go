@@ -0,0 +1,92 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// sharedResultInvalidation returns a function that discards the shared common table expression results that executing
+// |n| may change, or nil if |n| changes no data. The function is called once before the statement executes and again
+// when it finishes, so that a result computed while the statement was running isn't kept.
+func (e *Engine) sharedResultInvalidation(ctx *sql.Context, n sql.Node) func() {
+	cache := e.Analyzer.ExecBuilder.SharedResults
+	if cache == nil {
+		return nil
+	}
+
+	var dml, all bool
+	type tableName struct{ db, table string }
+	tables := make(map[string]tableName)
+	transform.InspectWithOpaque(ctx, n, func(ctx *sql.Context, n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.InsertInto, *plan.Update, *plan.DeleteFrom:
+			dml = true
+		case *plan.Commit, *plan.Rollback, *plan.RollbackSavepoint, *plan.StartTransaction:
+			// ending a transaction publishes or discards all of its writes
+			all = true
+		case *plan.Call, *plan.ForeignKeyHandler:
+			// stored procedures and foreign key cascades may write to tables that don't appear in the plan
+			all = true
+		case *plan.ResolvedTable:
+			db := ""
+			if n.SqlDatabase != nil {
+				db = n.SqlDatabase.Name()
+			}
+			tables[sql.SharedResultTableKey(db, n.Name())] = tableName{db, n.Name()}
+		}
+		return !all
+	})
+
+	if !all && !dml {
+		if plan.IsReadOnly(n) {
+			return nil
+		}
+		// DDL and other statements may change any table, or its schema
+		all = true
+	}
+
+	return func() {
+		if all {
+			cache.InvalidateAll()
+			return
+		}
+		for _, t := range tables {
+			cache.InvalidateTable(t.db, t.table)
+		}
+	}
+}
+
+// sharedResultInvalidatingIter calls its invalidate function after its child has been closed, once any transaction the
+// statement committed has ended.
+type sharedResultInvalidatingIter struct {
+	sql.RowIter
+	invalidate func()
+}
+
+// withSharedResultInvalidation returns |iter|, wrapped so that |invalidate| is called when it's closed, if it's not nil.
+func withSharedResultInvalidation(iter sql.RowIter, invalidate func()) sql.RowIter {
+	if invalidate == nil {
+		return iter
+	}
+	return &sharedResultInvalidatingIter{RowIter: iter, invalidate: invalidate}
+}
+
+func (i *sharedResultInvalidatingIter) Close(ctx *sql.Context) error {
+	defer i.invalidate()
+	return i.RowIter.Close(ctx)
+}
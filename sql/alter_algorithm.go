@@ -0,0 +1,145 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// AlterAlgorithm is the algorithm used to apply an ALTER TABLE statement, as named by its ALGORITHM clause. The
+// algorithms are ordered from the fastest to the slowest, so a table that can apply an operation with one algorithm
+// can also apply it with any of the algorithms after it.
+type AlterAlgorithm byte
+
+const (
+	// AlterAlgorithmDefault lets the table use the fastest algorithm it supports.
+	AlterAlgorithmDefault AlterAlgorithm = iota
+	// AlterAlgorithmInstant only changes the metadata of the table.
+	AlterAlgorithmInstant
+	// AlterAlgorithmInplace changes the table without copying it, although it may rebuild it.
+	AlterAlgorithmInplace
+	// AlterAlgorithmCopy copies the rows of the table into a table with the new schema.
+	AlterAlgorithmCopy
+)
+
+// String returns the name of the algorithm as used by the ALGORITHM clause.
+func (a AlterAlgorithm) String() string {
+	switch a {
+	case AlterAlgorithmInstant:
+		return "INSTANT"
+	case AlterAlgorithmInplace:
+		return "INPLACE"
+	case AlterAlgorithmCopy:
+		return "COPY"
+	default:
+		return "DEFAULT"
+	}
+}
+
+// AlterLock is the level of concurrent access to a table allowed while an ALTER TABLE statement is applied, as named by
+// its LOCK clause. The levels are ordered from the least to the most restrictive.
+type AlterLock byte
+
+const (
+	// AlterLockDefault lets the table use the least restrictive lock it supports.
+	AlterLockDefault AlterLock = iota
+	// AlterLockNone allows concurrent reads and writes.
+	AlterLockNone
+	// AlterLockShared allows concurrent reads, but not writes.
+	AlterLockShared
+	// AlterLockExclusive allows neither concurrent reads nor writes.
+	AlterLockExclusive
+)
+
+// String returns the name of the lock level as used by the LOCK clause.
+func (l AlterLock) String() string {
+	switch l {
+	case AlterLockNone:
+		return "NONE"
+	case AlterLockShared:
+		return "SHARED"
+	case AlterLockExclusive:
+		return "EXCLUSIVE"
+	default:
+		return "DEFAULT"
+	}
+}
+
+// AlterOperationKind identifies the kind of change made by a clause of an ALTER TABLE statement.
+type AlterOperationKind byte
+
+const (
+	AlterOperationOther AlterOperationKind = iota
+	AlterOperationAddColumn
+	AlterOperationDropColumn
+	AlterOperationRenameColumn
+	AlterOperationModifyColumn
+	AlterOperationSetColumnDefault
+	AlterOperationAddIndex
+	AlterOperationDropIndex
+	AlterOperationRenameIndex
+	AlterOperationAddPrimaryKey
+	AlterOperationDropPrimaryKey
+	AlterOperationAddForeignKey
+	AlterOperationDropForeignKey
+	AlterOperationAddCheck
+	AlterOperationDropCheck
+	AlterOperationAutoIncrement
+	AlterOperationCollation
+	AlterOperationComment
+	AlterOperationRenameTable
+)
+
+// AlterOperation describes a clause of an ALTER TABLE statement to an AlterAlgorithmTable.
+type AlterOperation struct {
+	// Kind is the kind of change made by the clause.
+	Kind AlterOperationKind
+	// OldColumn is the column dropped, renamed or modified by the clause, if any.
+	OldColumn *Column
+	// NewColumn is the column added by the clause, or the new definition of the column it renames or modifies.
+	NewColumn *Column
+	// Order is the position given to the column added or modified by the clause, if any.
+	Order *ColumnOrder
+	// IndexConstraint is the constraint of the index added by the clause.
+	IndexConstraint IndexConstraint
+	// Validates is true if the clause must check the existing rows of the table, as for an enforced CHECK constraint
+	// or a foreign key added while foreign_key_checks is enabled.
+	Validates bool
+}
+
+// AlterAlgorithmTable is a table that reports how it applies the clauses of ALTER TABLE statements, so that the engine
+// can honor their ALGORITHM and LOCK clauses. Statements that ask for a faster algorithm or a less restrictive lock
+// than the table needs fail, as they do in MySQL. Tables that don't implement this interface accept any ALGORITHM and
+// LOCK clause.
+type AlterAlgorithmTable interface {
+	Table
+	// AlterAlgorithm returns the fastest algorithm the table can apply |op| with, and the least restrictive lock it
+	// needs to apply it with that algorithm. The COPY algorithm always needs at least a SHARED lock.
+	AlterAlgorithm(ctx *Context, op AlterOperation) (AlterAlgorithm, AlterLock)
+}
+
+// ValidateAlterAlgorithm returns an error if an ALTER TABLE statement that asks for |algorithm| and |lock| can't be
+// applied by a table that needs |required| and |requiredLock|.
+func ValidateAlterAlgorithm(algorithm AlterAlgorithm, lock AlterLock, required AlterAlgorithm, requiredLock AlterLock) error {
+	if algorithm == AlterAlgorithmInstant && lock != AlterLockDefault {
+		return ErrAlterAlgorithmWrongUsage.New(algorithm, lock)
+	}
+	if algorithm != AlterAlgorithmDefault && algorithm < required {
+		return ErrAlterOperationNotSupported.New("ALGORITHM="+algorithm.String(), "ALGORITHM="+required.String())
+	}
+	if algorithm == AlterAlgorithmCopy && requiredLock < AlterLockShared {
+		requiredLock = AlterLockShared
+	}
+	if lock != AlterLockDefault && lock < requiredLock {
+		return ErrAlterOperationNotSupported.New("LOCK="+lock.String(), "LOCK="+requiredLock.String())
+	}
+	return nil
+}
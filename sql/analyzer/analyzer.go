@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"runtime/trace"
 	"strings"
+	"sync"
 
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/sirupsen/logrus"
@@ -49,6 +50,9 @@ var ErrInAnalysis = errors.NewKind("error in analysis: %s")
 // ErrInvalidNodeType is thrown when the analyzer can't handle a particular kind of node type
 var ErrInvalidNodeType = errors.NewKind("%s: invalid node of type: %T")
 
+// ErrRuleNotFound is returned when a rule is added relative to a rule the analyzer doesn't have
+var ErrRuleNotFound = errors.NewKind("analyzer rule not found: %s")
+
 const disablePrepareStmtKey = "DISABLE_PREPARED_STATEMENTS"
 
 var PreparedStmtDisabled bool
@@ -75,8 +79,14 @@ type Builder struct {
 	onceAfterRules      []Rule
 	validationRules     []Rule
 	afterAllRules       []Rule
-	debug               bool
-	overrides           sql.EngineOverrides
+	// afterCounts tracks how many rules have been added after each built-in rule, so that rules added after the same
+	// rule run in the order they were added.
+	afterCounts map[RuleId]int
+	customRules bool
+	err         error
+	debug       bool
+	planDump    io.Writer
+	overrides   sql.EngineOverrides
 }
 
 // NewBuilder creates a new Builder from a specific catalog.
@@ -102,9 +112,19 @@ func (ab *Builder) WithDebug() *Builder {
 	return ab
 }
 
+// WithPlanDump makes the Analyzer write the plan to |w| after every rule it applies, labeled with the batch and rule
+// that produced it. Rules that leave the plan unchanged are listed without the plan. Output from concurrent analyses
+// is written a rule at a time, but may interleave.
+func (ab *Builder) WithPlanDump(w io.Writer) *Builder {
+	ab.planDump = w
+
+	return ab
+}
+
 // AddPreAnalyzeRule adds a new rule to the analyze before the standard analyzer rules.
 func (ab *Builder) AddPreAnalyzeRule(id RuleId, fn RuleFunc) *Builder {
 	ab.preAnalyzeRules = append(ab.preAnalyzeRules, Rule{Id: id, Apply: fn})
+	ab.customRules = true
 
 	return ab
 }
@@ -112,6 +132,7 @@ func (ab *Builder) AddPreAnalyzeRule(id RuleId, fn RuleFunc) *Builder {
 // AddPostAnalyzeRule adds a new rule to the analyzer after standard analyzer rules.
 func (ab *Builder) AddPostAnalyzeRule(id RuleId, fn RuleFunc) *Builder {
 	ab.postAnalyzeRules = append(ab.postAnalyzeRules, Rule{Id: id, Apply: fn})
+	ab.customRules = true
 
 	return ab
 }
@@ -119,6 +140,7 @@ func (ab *Builder) AddPostAnalyzeRule(id RuleId, fn RuleFunc) *Builder {
 // AddPreValidationRule adds a new rule to the analyzer before standard validation rules.
 func (ab *Builder) AddPreValidationRule(id RuleId, fn RuleFunc) *Builder {
 	ab.preValidationRules = append(ab.preValidationRules, Rule{Id: id, Apply: fn})
+	ab.customRules = true
 
 	return ab
 }
@@ -126,10 +148,67 @@ func (ab *Builder) AddPreValidationRule(id RuleId, fn RuleFunc) *Builder {
 // AddPostValidationRule adds a new rule to the analyzer after standard validation rules.
 func (ab *Builder) AddPostValidationRule(id RuleId, fn RuleFunc) *Builder {
 	ab.postValidationRules = append(ab.postValidationRules, Rule{Id: id, Apply: fn})
+	ab.customRules = true
+
+	return ab
+}
+
+// AddRuleBefore adds a new rule to the analyzer that runs immediately before the built-in rule |before|, in the same
+// batch. Rules added before the same rule run in the order they were added. Built-in rules may be found by name with
+// RuleIdByName. If |before| is not one of the analyzer's rules, the new rule is not added and Err reports why.
+func (ab *Builder) AddRuleBefore(before RuleId, id RuleId, fn RuleFunc) *Builder {
+	return ab.addRuleAt(before, 0, Rule{Id: id, Apply: fn})
+}
+
+// AddRuleAfter adds a new rule to the analyzer that runs immediately after the built-in rule |after|, in the same
+// batch. Rules added after the same rule run in the order they were added. Built-in rules may be found by name with
+// RuleIdByName. If |after| is not one of the analyzer's rules, the new rule is not added and Err reports why.
+func (ab *Builder) AddRuleAfter(after RuleId, id RuleId, fn RuleFunc) *Builder {
+	if ab.afterCounts == nil {
+		ab.afterCounts = make(map[RuleId]int)
+	}
+	ab.afterCounts[after]++
+	return ab.addRuleAt(after, ab.afterCounts[after], Rule{Id: id, Apply: fn})
+}
 
+// addRuleAt inserts |rule| into the batch containing |anchor|, |offset| places after it.
+func (ab *Builder) addRuleAt(anchor RuleId, offset int, rule Rule) *Builder {
+	for _, rules := range []*[]Rule{&ab.onceBeforeRules, &ab.defaultRules, &ab.onceAfterRules, &ab.validationRules, &ab.afterAllRules} {
+		for i, r := range *rules {
+			if r.Id != anchor {
+				continue
+			}
+			// the rule lists may be shared with the package defaults, so never insert in place
+			newRules := make([]Rule, 0, len(*rules)+1)
+			newRules = append(newRules, (*rules)[:i+offset]...)
+			newRules = append(newRules, rule)
+			newRules = append(newRules, (*rules)[i+offset:]...)
+			*rules = newRules
+			ab.customRules = true
+			return ab
+		}
+	}
+	if ab.err == nil {
+		ab.err = ErrRuleNotFound.New(anchor)
+	}
 	return ab
 }
 
+// Err returns the first error encountered while adding rules to the builder, if any.
+func (ab *Builder) Err() error {
+	return ab.err
+}
+
+// RuleIdByName returns the id of the built-in analyzer rule with the given name, as reported by RuleId.String.
+func RuleIdByName(name string) (RuleId, bool) {
+	for id := RuleId(0); id <= engineOverridesId; id++ {
+		if strings.EqualFold(id.String(), name) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
 // AddOverrides adds the given overrides to the builder.
 func (ab *Builder) AddOverrides(overrides sql.EngineOverrides) *Builder {
 	ab.overrides = overrides
@@ -271,6 +350,10 @@ func (ab *Builder) Build() *Analyzer {
 			Rules:      ab.afterAllRules,
 		},
 	}
+	var planDump *planDumper
+	if ab.planDump != nil {
+		planDump = &planDumper{w: ab.planDump}
+	}
 	return &Analyzer{
 		Debug:           debug || ab.debug,
 		Verbose:         verbose,
@@ -283,6 +366,8 @@ func (ab *Builder) Build() *Analyzer {
 		ExecBuilder:     rowexec.NewBuilder(nil, ab.overrides),
 		Parser:          sql.GetParser(ab.overrides),
 		SchemaFormatter: sql.GetSchemaFormatter(ab.overrides),
+		customRules:     ab.customRules,
+		planDump:        planDump,
 	}
 }
 
@@ -313,6 +398,30 @@ type Analyzer struct {
 	Trace bool
 	// Whether to output the query plan at each step of the analyzer
 	Verbose bool
+	// Whether rules were added to the default rule set. If so, the abbreviated rule sets used for simple statements
+	// are skipped, since they would not include the added rules.
+	customRules bool
+	// If set, the plan is written out after every rule. See Builder.WithPlanDump.
+	planDump *planDumper
+}
+
+// planDumper writes the plan after each analyzer rule to an io.Writer.
+type planDumper struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// dump writes |n|, as produced by |rule| in |batch|, to the dumper's writer.
+func (d *planDumper) dump(ctx *sql.Context, batch string, rule RuleId, n sql.Node, same transform.TreeIdentity) {
+	var out string
+	if same || n == nil {
+		out = fmt.Sprintf("%s/%s: unchanged\n", batch, rule)
+	} else {
+		out = fmt.Sprintf("%s/%s:\n%s\n", batch, rule, sql.DebugString(ctx, n))
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = io.WriteString(d.w, out)
 }
 
 // NewDefault creates a default Analyzer instance with all default Rules and configuration.
@@ -534,7 +643,9 @@ func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *plan
 	a.LogNode(ctx, n)
 
 	batches := a.Batches
-	if b, ok := getBatchesForNode(scope, n, qFlags); ok {
+	// the abbreviated batches for simple statements don't include custom rules, so only statements that skip analysis
+	// entirely may use them when custom rules are present
+	if b, ok := getBatchesForNode(scope, n, qFlags); ok && (!a.customRules || b == nil) {
 		batches = b
 	}
 
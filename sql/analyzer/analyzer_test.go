@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -24,6 +25,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -107,6 +109,91 @@ func TestRemoveAfterAllRule(t *testing.T) {
 	require.Equal(countRules(a.Batches), defRulesCount-1)
 }
 
+func TestAddRuleBeforeAndAfter(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		before1 RuleId = -1 - iota
+		before2
+		after1
+		after2
+	)
+	defRulesCount := countRules(NewDefault(nil).Batches)
+
+	b := NewBuilder(nil).
+		AddRuleBefore(pushFiltersId, before1, pushFilters).
+		AddRuleAfter(pushFiltersId, after1, pushFilters).
+		AddRuleBefore(pushFiltersId, before2, pushFilters).
+		AddRuleAfter(pushFiltersId, after2, pushFilters)
+	require.NoError(b.Err())
+	a := b.Build()
+	require.Equal(defRulesCount+4, countRules(a.Batches))
+
+	var ids []RuleId
+	for _, batch := range a.Batches {
+		for i, r := range batch.Rules {
+			if r.Id == pushFiltersId {
+				for _, r := range batch.Rules[i-2 : i+3] {
+					ids = append(ids, r.Id)
+				}
+			}
+		}
+	}
+	require.Equal([]RuleId{before1, before2, pushFiltersId, after1, after2}, ids)
+
+	// the package defaults are left alone
+	require.Equal(defRulesCount, countRules(NewDefault(nil).Batches))
+}
+
+func TestAddRuleAfterUnknownRule(t *testing.T) {
+	require := require.New(t)
+
+	defRulesCount := countRules(NewDefault(nil).Batches)
+
+	b := NewBuilder(nil).AddRuleAfter(RuleId(-100), -1, pushFilters)
+	require.True(ErrRuleNotFound.Is(b.Err()))
+	require.Equal(defRulesCount, countRules(b.Build().Batches))
+}
+
+func TestRuleIdByName(t *testing.T) {
+	require := require.New(t)
+
+	id, ok := RuleIdByName("pushFilters")
+	require.True(ok)
+	require.Equal(pushFiltersId, id)
+
+	id, ok = RuleIdByName("trackProcess")
+	require.True(ok)
+	require.Equal(TrackProcessId, id)
+
+	_, ok = RuleIdByName("notARule")
+	require.False(ok)
+}
+
+func TestPlanDump(t *testing.T) {
+	require := require.New(t)
+
+	var out strings.Builder
+	a := NewBuilder(nil).WithPlanDump(&out).Build()
+	ctx := sql.NewEmptyContext()
+	batch := &Batch{
+		Desc:       "test",
+		Iterations: 1,
+		Rules: []Rule{
+			{Id: -1, Apply: func(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+				return n, transform.SameTree, nil
+			}},
+			{Id: -2, Apply: func(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+				return plan.NewProject(ctx, []sql.Expression{expression.NewLiteral(1, types.Int64)}, n), transform.NewTree, nil
+			}},
+		},
+	}
+	_, _, err := batch.Eval(ctx, a, plan.NewResolvedDualTable(), nil, DefaultRuleSelector, nil)
+	require.NoError(err)
+	require.Contains(out.String(), "test/RuleId(-1): unchanged\n")
+	require.Contains(out.String(), "test/RuleId(-2):\nProject")
+}
+
 func countRules(batches []*Batch) int {
 	var count int
 	for _, b := range batches {
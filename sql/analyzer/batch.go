@@ -84,6 +84,9 @@ func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.
 		a.PushDebugContext(rule.Id.String())
 		next, same, err = rule.Apply(ctx, a, prev, scope, sel, qFlags)
 		allSame = same && allSame
+		if a.planDump != nil {
+			a.planDump.dump(ctx, b.Desc, rule.Id, next, same)
+		}
 		if next != nil && !same {
 			a.LogNode(ctx, next)
 			// We should only do this if the result has changed, but some rules currently misbehave and falsely report nothing
@@ -16,14 +16,17 @@ package analyzer
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/internal/similartext"
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	dtablefunctions "github.com/dolthub/go-mysql-server/sql/expression/tablefunction"
 	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 )
@@ -43,10 +46,26 @@ type Catalog struct {
 	// replication messages (e.g. "show replicas") and commands (e.g. COM_REGISTER_REPLICA).
 	BinlogPrimaryController binlogreplication.BinlogPrimaryController
 
+	// BackgroundThreads holds the background jobs of the engine this catalog belongs to, if any.
+	BackgroundThreads *sql.BackgroundThreads
+
+	// SchemaChanges notifies its listeners of the changes DDL statements make to the objects in the catalog.
+	SchemaChanges *sql.SchemaChangeNotifier
+
 	MySQLDb          *mysql_db.MySQLDb
 	builtInFunctions function.Registry
 	overrides        sql.EngineOverrides
 
+	// userFunctions holds the functions registered with RegisterUserDefinedFunction by lowercase name
+	userFunctions map[string]sql.UserDefinedFunction
+	// userTableFunctions holds the table functions registered with RegisterUserDefinedTableFunction by lowercase name
+	userTableFunctions map[string]sql.UserDefinedTableFunction
+	userFunctionsMu    sync.RWMutex
+
+	// tablePolicies holds the policies registered with RegisterTablePolicy, in the order they were registered
+	tablePolicies   []sql.TablePolicy
+	tablePoliciesMu sync.RWMutex
+
 	locks sessionLocks
 	mu    sync.RWMutex
 }
@@ -55,6 +74,10 @@ var _ sql.Catalog = (*Catalog)(nil)
 var _ binlogreplication.BinlogConsumerProvider = (*Catalog)(nil)
 var _ binlogreplication.BinlogReplicaProvider = (*Catalog)(nil)
 var _ binlogreplication.BinlogPrimaryProvider = (*Catalog)(nil)
+var _ sql.BackgroundJobProvider = (*Catalog)(nil)
+var _ sql.UserDefinedFunctionProvider = (*Catalog)(nil)
+var _ sql.UserDefinedTableFunctionProvider = (*Catalog)(nil)
+var _ sql.TablePolicyProvider = (*Catalog)(nil)
 
 type tableLocks map[string]struct{}
 
@@ -72,6 +95,7 @@ func NewCatalog(provider sql.DatabaseProvider, overrides sql.EngineOverrides) *C
 		overrides:        overrides,
 		StatsProvider:    memory.NewStatsProv(),
 		locks:            make(sessionLocks),
+		SchemaChanges:    sql.NewSchemaChangeNotifier(),
 	}
 	c.AuthHandler = sql.GetAuthorizationHandlerFactory().CreateHandler(c)
 	return c
@@ -101,6 +125,14 @@ func (c *Catalog) GetBinlogPrimaryController() binlogreplication.BinlogPrimaryCo
 	return c.BinlogPrimaryController
 }
 
+// BackgroundJobs implements sql.BackgroundJobProvider
+func (c *Catalog) BackgroundJobs() []sql.BackgroundJobStatus {
+	if c.BackgroundThreads == nil {
+		return nil
+	}
+	return c.BackgroundThreads.Jobs()
+}
+
 func (c *Catalog) WithTableFunctions(fns ...sql.TableFunction) (sql.TableFunctionProvider, error) {
 	if tfp, ok := c.DbProvider.(sql.TableFunctionProvider); !ok {
 		return nil, fmt.Errorf("catalog does not implement sql.TableFunctionProvider")
@@ -294,7 +326,7 @@ func (c *Catalog) DatabaseTable(ctx *sql.Context, db sql.Database, tableName str
 		return c.Table(ctx, db.Name(), tableName)
 	}
 
-	tbl, ok, err := db.GetTableInsensitive(ctx, tableName)
+	tbl, ok, err := sql.GetSessionTableInsensitive(ctx, db, tableName)
 	if err != nil {
 		return nil, nil, err
 	} else if !ok {
@@ -370,10 +402,71 @@ func (c *Catalog) Function(ctx *sql.Context, schema, name string) (sql.Function,
 			return f, true
 		}
 	}
+	if fn, ok := c.UserDefinedFunction(ctx, name); ok {
+		return function.NewUserDefinedFunction(fn), true
+	}
 
 	return c.builtInFunctions.Function(ctx, schema, name)
 }
 
+// RegisterUserDefinedFunction implements sql.UserDefinedFunctionProvider
+func (c *Catalog) RegisterUserDefinedFunction(ctx *sql.Context, fn sql.UserDefinedFunction) error {
+	if err := fn.Validate(); err != nil {
+		return err
+	}
+	name := strings.ToLower(fn.Name)
+	if _, ok := c.builtInFunctions.Function(ctx, "", name); ok {
+		return sql.ErrUserDefinedFunctionExists.New(fn.Name)
+	}
+
+	c.userFunctionsMu.Lock()
+	defer c.userFunctionsMu.Unlock()
+	if _, ok := c.userFunctions[name]; ok {
+		return sql.ErrUserDefinedFunctionExists.New(fn.Name)
+	}
+	if c.userFunctions == nil {
+		c.userFunctions = make(map[string]sql.UserDefinedFunction)
+	}
+	if fn.CreatedAt.IsZero() {
+		fn.CreatedAt = time.Now()
+	}
+	c.userFunctions[name] = fn
+	return nil
+}
+
+// DropUserDefinedFunction implements sql.UserDefinedFunctionProvider
+func (c *Catalog) DropUserDefinedFunction(ctx *sql.Context, name string) error {
+	c.userFunctionsMu.Lock()
+	defer c.userFunctionsMu.Unlock()
+	if _, ok := c.userFunctions[strings.ToLower(name)]; !ok {
+		return sql.ErrUserDefinedFunctionNotDefined.New(name)
+	}
+	delete(c.userFunctions, strings.ToLower(name))
+	return nil
+}
+
+// UserDefinedFunction implements sql.UserDefinedFunctionProvider
+func (c *Catalog) UserDefinedFunction(ctx *sql.Context, name string) (sql.UserDefinedFunction, bool) {
+	c.userFunctionsMu.RLock()
+	defer c.userFunctionsMu.RUnlock()
+	fn, ok := c.userFunctions[strings.ToLower(name)]
+	return fn, ok
+}
+
+// UserDefinedFunctions implements sql.UserDefinedFunctionProvider
+func (c *Catalog) UserDefinedFunctions(ctx *sql.Context) []sql.UserDefinedFunction {
+	c.userFunctionsMu.RLock()
+	defer c.userFunctionsMu.RUnlock()
+	fns := make([]sql.UserDefinedFunction, 0, len(c.userFunctions))
+	for _, fn := range c.userFunctions {
+		fns = append(fns, fn)
+	}
+	sort.Slice(fns, func(i, j int) bool {
+		return strings.ToLower(fns[i].Name) < strings.ToLower(fns[j].Name)
+	})
+	return fns
+}
+
 // ExternalStoredProcedure implements sql.ExternalStoredProcedureProvider
 func (c *Catalog) ExternalStoredProcedure(ctx *sql.Context, name string, numOfParams int) (*sql.ExternalStoredProcedureDetails, error) {
 	if espp, ok := c.DbProvider.(sql.ExternalStoredProcedureProvider); ok {
@@ -410,9 +503,100 @@ func (c *Catalog) TableFunction(ctx *sql.Context, name string) (sql.TableFunctio
 			return tf, true
 		}
 	}
+	if fn, ok := c.UserDefinedTableFunction(ctx, name); ok {
+		return dtablefunctions.NewUserDefinedTableFunction(fn), true
+	}
 	return nil, false
 }
 
+// RegisterUserDefinedTableFunction implements sql.UserDefinedTableFunctionProvider
+func (c *Catalog) RegisterUserDefinedTableFunction(ctx *sql.Context, fn sql.UserDefinedTableFunction) error {
+	if err := fn.Validate(); err != nil {
+		return err
+	}
+	name := strings.ToLower(fn.Name)
+	if fp, ok := c.DbProvider.(sql.TableFunctionProvider); ok {
+		if tf, found := fp.TableFunction(ctx, name); found && tf != nil {
+			return sql.ErrUserDefinedFunctionExists.New(fn.Name)
+		}
+	}
+
+	c.userFunctionsMu.Lock()
+	defer c.userFunctionsMu.Unlock()
+	if _, ok := c.userTableFunctions[name]; ok {
+		return sql.ErrUserDefinedFunctionExists.New(fn.Name)
+	}
+	if c.userTableFunctions == nil {
+		c.userTableFunctions = make(map[string]sql.UserDefinedTableFunction)
+	}
+	if fn.CreatedAt.IsZero() {
+		fn.CreatedAt = time.Now()
+	}
+	c.userTableFunctions[name] = fn
+	return nil
+}
+
+// DropUserDefinedTableFunction implements sql.UserDefinedTableFunctionProvider
+func (c *Catalog) DropUserDefinedTableFunction(ctx *sql.Context, name string) error {
+	c.userFunctionsMu.Lock()
+	defer c.userFunctionsMu.Unlock()
+	if _, ok := c.userTableFunctions[strings.ToLower(name)]; !ok {
+		return sql.ErrUserDefinedFunctionNotDefined.New(name)
+	}
+	delete(c.userTableFunctions, strings.ToLower(name))
+	return nil
+}
+
+// UserDefinedTableFunction implements sql.UserDefinedTableFunctionProvider
+func (c *Catalog) UserDefinedTableFunction(ctx *sql.Context, name string) (sql.UserDefinedTableFunction, bool) {
+	c.userFunctionsMu.RLock()
+	defer c.userFunctionsMu.RUnlock()
+	fn, ok := c.userTableFunctions[strings.ToLower(name)]
+	return fn, ok
+}
+
+// RegisterTablePolicy implements sql.TablePolicyProvider
+func (c *Catalog) RegisterTablePolicy(ctx *sql.Context, policy sql.TablePolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	c.tablePoliciesMu.Lock()
+	defer c.tablePoliciesMu.Unlock()
+	for _, p := range c.tablePolicies {
+		if strings.EqualFold(p.Name, policy.Name) {
+			return sql.ErrTablePolicyExists.New(policy.Name)
+		}
+	}
+	c.tablePolicies = append(c.tablePolicies, policy)
+	return nil
+}
+
+// DropTablePolicy implements sql.TablePolicyProvider
+func (c *Catalog) DropTablePolicy(ctx *sql.Context, name string) error {
+	c.tablePoliciesMu.Lock()
+	defer c.tablePoliciesMu.Unlock()
+	for i, p := range c.tablePolicies {
+		if strings.EqualFold(p.Name, name) {
+			c.tablePolicies = append(c.tablePolicies[:i:i], c.tablePolicies[i+1:]...)
+			return nil
+		}
+	}
+	return sql.ErrTablePolicyNotFound.New(name)
+}
+
+// TablePolicies implements sql.TablePolicyProvider
+func (c *Catalog) TablePolicies(ctx *sql.Context, db, table string) []sql.TablePolicy {
+	c.tablePoliciesMu.RLock()
+	defer c.tablePoliciesMu.RUnlock()
+	var policies []sql.TablePolicy
+	for _, p := range c.tablePolicies {
+		if p.AppliesTo(db, table) {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
 // Overrides implements the sql.Catalog interface
 func (c *Catalog) Overrides() sql.EngineOverrides {
 	return c.overrides
@@ -103,6 +103,10 @@ func checkExpressionValid(ctx *sql.Context, e sql.Expression) error {
 			if ndf, ok := e.(sql.NonDeterministicExpression); ok && ndf.IsNonDeterministic() {
 				err = sql.ErrInvalidConstraintFunctionNotSupported.New(e.String())
 			}
+			// a constraint that depends on session state could hold for one session and not another
+			if sdf, ok := e.(sql.SessionDependentExpression); ok && sdf.IsSessionDependent() {
+				err = sql.ErrInvalidConstraintFunctionNotSupported.New(e.String())
+			}
 			return false
 		case *plan.Subquery:
 			err = sql.ErrInvalidConstraintSubqueryNotSupported.New(e.String())
@@ -294,7 +294,7 @@ func getCostedIndexScan(
 		b.leftover = append(b.leftover, leftover)
 	}
 	ranges, err := b.buildRangeCollection(root)
-	if sql.ErrInvalidValueType.Is(err) {
+	if sql.ErrInvalidValueType.Is(err) || types.ErrConvertingToUuid.Is(err) {
 		return nil, nil, nil, nil
 	}
 	if err != nil {
@@ -1573,7 +1573,21 @@ func (c *indexCoster) costIndexScanLeaf(ctx *sql.Context, filter *iScanLeaf, s s
 }
 
 func (c *indexCoster) costSpatial(filter *iScanLeaf, s sql.Statistic, ordinal int) (sql.Statistic, bool, error) {
-	return s, s.IndexClass() == sql.IndexClassSpatial && ordinal == 0 && filter.litValue != nil, nil
+	if s.IndexClass() != sql.IndexClassSpatial || ordinal != 0 || filter.litValue == nil {
+		return s, false, nil
+	}
+	// Like MySQL, a spatial index is only used for a column with an SRID attribute, and only to find geometries in the
+	// same spatial reference system as that column.
+	spatialCol, ok := filter.typ.(sql.SpatialColumnType)
+	if !ok {
+		return s, false, nil
+	}
+	srid, hasSRID := spatialCol.GetSpatialTypeSRID()
+	g, ok := filter.litValue.(types.GeometryValue)
+	if !hasSRID || !ok || g.GetSRID() != srid {
+		return s, false, nil
+	}
+	return s, true, nil
 }
 
 func (c *indexCoster) costFulltext(filter *iScanLeaf, s sql.Statistic, ordinal int) (sql.Statistic, bool, error) {
@@ -1947,11 +1961,18 @@ func IndexLeafChildren(e sql.Expression) (sql.IndexScanOp, sql.Expression, sql.E
 		default:
 			return 0, nil, nil, false
 		}
-	case *spatial.Intersects, *spatial.Within, *spatial.STEquals:
+	case *spatial.Intersects, *spatial.Within, *spatial.Contains, *spatial.STEquals:
 		op = sql.IndexScanOpSpatialEq
 		children := e.Children()
 		left = children[0]
 		right = children[1]
+	case *spatial.MBRPredicate:
+		if !e.CanUseSpatialIndex() {
+			return 0, nil, nil, false
+		}
+		op = sql.IndexScanOpSpatialEq
+		left = e.LeftChild
+		right = e.RightChild
 	case *expression.MatchAgainst:
 		op = sql.IndexScanOpFulltextEq
 	case sql.IndexComparisonExpression:
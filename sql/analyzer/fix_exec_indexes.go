@@ -476,12 +476,16 @@ func (s *idxScope) visitSelf(ctx *sql.Context, n sql.Node) error {
 		destSch := n.Destination.Schema(ctx)
 		srcSch := n.Source.Schema(ctx)
 		rightSchema := make(sql.Schema, len(destSch)*2)
-		if values, isValues := n.Source.(*plan.Values); isValues {
+		if values, isValues := insertValuesSource(n.Source); isValues {
 			for oldRowIdx, c := range destSch {
 				newC := c.Copy()
 				newC.Source = values.AliasName
-				if values.ColumnNames != nil {
-					newC.Name = values.ColumnNames[newC.Name]
+				if newC.Source == "" {
+					newC.Source = planbuilder.OnDupValuesPrefix
+				}
+				// columns that weren't given an alias can't be referenced through the row alias
+				if alias, ok := values.ColumnNames[strings.ToLower(newC.Name)]; ok {
+					newC.Name = alias
 				}
 
 				newRowIdx := len(destSch) + oldRowIdx
@@ -795,3 +799,13 @@ func fixExprToScope(ctx *sql.Context, e sql.Expression, scopes ...*idxScope) sql
 	})
 	return ret
 }
+
+// insertValuesSource returns the VALUES rows of an insert with the source |n|, which is wrapped in a projection onto the
+// destination schema by resolveInsertRows.
+func insertValuesSource(n sql.Node) (*plan.Values, bool) {
+	if p, ok := n.(*plan.Project); ok {
+		n = p.Child
+	}
+	values, ok := n.(*plan.Values)
+	return values, ok
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/memo"
 	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // indexedExprEntry holds a hidden system column's generated expression and the column name
@@ -73,12 +74,12 @@ func buildIndexedExprToColumnNameMap(ctx *sql.Context, cat sql.Catalog, indexes
 			columnIdx := sch.IndexOfColName(unqualifiedColName)
 			if columnIdx < 0 {
 				// The column may not appear in a projected schema (e.g. inside a subquery
-				// that only selects a subset of columns). Non-hidden columns can be safely
-				// skipped here — we only care about HiddenSystem columns.
+				// that only selects a subset of columns). Non-generated columns can be safely
+				// skipped here — we only care about generated columns.
 				continue
 			}
-			if sch[columnIdx].HiddenSystem && sch[columnIdx].Generated != nil {
-				indexedExprs = append(indexedExprs, newIndexedExprEntry(sch[columnIdx].Generated.Expr, unqualifiedColName))
+			if expr, ok := indexableGeneratedExpr(ctx, sch[columnIdx]); ok {
+				indexedExprs = append(indexedExprs, newIndexedExprEntry(expr, unqualifiedColName))
 			}
 		}
 	}
@@ -97,8 +98,8 @@ func buildColumnIdToIndexedExprMap(ctx *sql.Context, cat sql.Catalog, tableNode
 	result := make(map[sql.ColumnId]indexedExprEntry)
 	sch := resolveTableSchema(ctx, cat, tableNode)
 
-	// If the table doesn't have any virtual columns, exit out early without iterating over indexes
-	if sch.HasVirtualColumns() == false {
+	// If the table doesn't have any generated columns, exit out early without iterating over indexes
+	if !hasGeneratedColumns(sch) {
 		return result
 	}
 
@@ -113,15 +114,62 @@ func buildColumnIdToIndexedExprMap(ctx *sql.Context, cat sql.Catalog, tableNode
 			if schIdx < 0 {
 				continue
 			}
-			col := sch[schIdx]
-			if col.HiddenSystem && col.Generated != nil {
-				result[cols[i]] = newIndexedExprEntry(col.Generated.Expr, unqualifiedColName)
+			if expr, ok := indexableGeneratedExpr(ctx, sch[schIdx]); ok {
+				result[cols[i]] = newIndexedExprEntry(expr, unqualifiedColName)
 			}
 		}
 	}
 	return result
 }
 
+// indexableGeneratedExpr returns the generated expression of |col|, if filters on that expression may be served by an
+// index on |col|. This is always the case for the hidden system columns of functional indexes. For generated columns
+// declared by users, as in MySQL, the expression must use some other column and must not be just a column reference,
+// and the column's type must match the type of the expression, so that comparing the expression and comparing the
+// column always have the same result. Integer types of different sizes are considered to match.
+func indexableGeneratedExpr(ctx *sql.Context, col *sql.Column) (sql.Expression, bool) {
+	if col.Generated == nil || col.Generated.Expr == nil {
+		return nil, false
+	}
+	expr := col.Generated.Expr
+	if _, ok := expr.(*sql.UnresolvedColumnDefault); ok {
+		return nil, false
+	}
+	if col.HiddenSystem {
+		return expr, true
+	}
+
+	if _, ok := expr.(*expression.GetField); ok {
+		return nil, false
+	}
+	hasColumn := false
+	sql.Inspect(ctx, expr, func(ctx *sql.Context, e sql.Expression) bool {
+		if _, ok := e.(*expression.GetField); ok {
+			hasColumn = true
+		}
+		return !hasColumn
+	})
+	if !hasColumn {
+		return nil, false
+	}
+
+	exprType := expr.Type(ctx)
+	if col.Type.Equals(exprType) || (types.IsInteger(col.Type) && types.IsInteger(exprType)) {
+		return expr, true
+	}
+	return nil, false
+}
+
+// hasGeneratedColumns returns whether any column of |sch| is generated.
+func hasGeneratedColumns(sch sql.Schema) bool {
+	for _, col := range sch {
+		if col.Generated != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // expressionsEquivalent reports whether two sql.Expression trees are structurally equal
 // for the purpose of functional index matching. Table qualifiers and quoteName flags
 // on GetField nodes are ignored so that expressions using different table aliases match.
@@ -213,17 +261,17 @@ func operatorOf(e sql.Expression) (string, bool) {
 }
 
 // resolveTableSchema returns tableNode's schema with any UnresolvedColumnDefault expressions
-// in hidden system columns resolved to real expression trees. If no unresolved expressions are
+// in generated columns resolved to real expression trees. If no unresolved expressions are
 // present, the original schema slice is returned unchanged (no allocation).
 //
 // In Dolt, schemas are loaded from storage before a SQL context is available, so generated
 // expressions are stored as UnresolvedColumnDefault placeholders. This function resolves them
-// lazily: it only invokes planbuilder.ResolveSchemaDefaults when at least one hidden column
+// lazily: it only invokes planbuilder.ResolveSchemaDefaults when at least one generated column
 // still carries an unresolved placeholder.
 func resolveTableSchema(ctx *sql.Context, cat sql.Catalog, tableNode sql.TableNode) sql.Schema {
 	sch := tableNode.Schema(ctx)
 	for _, col := range sch {
-		if col.HiddenSystem && col.Generated != nil {
+		if col.Generated != nil {
 			if _, ok := col.Generated.Expr.(*sql.UnresolvedColumnDefault); ok {
 				var dbName string
 				if dbTab, ok := tableNode.UnderlyingTable().(sql.Databaseable); ok {
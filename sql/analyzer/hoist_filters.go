@@ -62,7 +62,12 @@ func recurseSubqueryForOuterFilters(ctx *sql.Context, n sql.Node, a *Analyzer, c
 			if same {
 				return n, transform.SameTree, nil
 			}
-			if len(hoisted) > 0 {
+			if len(hoisted) > 0 && sq.IsLateral {
+				// a lateral subquery is the right side of a join, and filters on its outer
+				// columns can't move above the join without changing its result
+				newQ = plan.NewFilter(ctx, expression.JoinAnd(hoisted...), newQ)
+				subCorr = subCorr.Union(sq.Correlated)
+			} else if len(hoisted) > 0 {
 				hoistFilters = append(hoistFilters, hoisted...)
 			}
 			newCorr = newCorr.Union(subCorr)
@@ -568,6 +568,12 @@ func convertSemiToInnerJoin(ctx *sql.Context, m *memo.Memo) error {
 			var table sql.Table
 			if tw, ok := srcNode.(sql.TableNode); ok {
 				table = tw.UnderlyingTable()
+			} else if ta, ok := srcNode.(*plan.TableAlias); ok {
+				// column ids are ordinals of the full table schema, even when
+				// the aliased table has been pruned to a projection
+				if tw, ok := ta.Child.(sql.TableNode); ok {
+					table = tw.UnderlyingTable()
+				}
 			}
 			if pkt, ok := table.(sql.PrimaryKeyTable); ok {
 				sch = pkt.PrimaryKeySchema(ctx).Schema
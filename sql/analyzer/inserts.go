@@ -181,36 +181,31 @@ func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, s
 			if err != nil {
 				return nil, -1, sets.FastIntSet{}, err
 			}
-			projExprs[i] = ai
 
+			values, isValues := insertSource.(*plan.Values)
 			if colIdx == -1 {
 				// Auto increment column was not specified explicitly, so we should increment last_insert_id immediately
 				firstGeneratedAutoIncRowIdx = 0
-			} else {
+				if isValues {
+					ai = ai.WithReserveCount(uint64(len(values.ExpressionTuples)))
+				}
+			} else if isValues {
 				// Additionally, the first NULL, DEFAULT, or empty value is what the last_insert_id should be set to.
-				switch src := insertSource.(type) {
-				case *plan.Values:
-					for ii, tup := range src.ExpressionTuples {
-						expr := tup[colIdx]
-						if unwrap, ok := expr.(*expression.Wrapper); ok {
-							expr = unwrap.Unwrap()
-						}
-						if _, isDef := expr.(*sql.ColumnDefaultValue); isDef {
+				generatedRows := 0
+				for ii, tup := range values.ExpressionTuples {
+					if generatesAutoIncrementValue(ctx, tup[colIdx]) {
+						if generatedRows == 0 {
 							firstGeneratedAutoIncRowIdx = ii
-							break
-						}
-						if lit, isLit := expr.(*expression.Literal); isLit {
-							// If a literal NULL or if 0 is specified and the NO_AUTO_VALUE_ON_ZERO SQL mode is
-							// not active, then MySQL will fill in an auto_increment value.
-							if types.Null.Equals(lit.Type(ctx)) ||
-								(!sql.LoadSqlMode(ctx).ModeEnabled(sql.NoAutoValueOnZero) && isZero(ctx, lit)) {
-								firstGeneratedAutoIncRowIdx = ii
-								break
-							}
 						}
+						generatedRows++
 					}
 				}
+				// The values for a statement that generates all of its values are reserved at once
+				if generatedRows == len(values.ExpressionTuples) {
+					ai = ai.WithReserveCount(uint64(generatedRows))
+				}
 			}
+			projExprs[i] = ai
 		}
 	}
 
@@ -241,6 +236,24 @@ func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, s
 	return plan.NewProject(ctx, projExprs, insertSource), firstGeneratedAutoIncRowIdx, deferredDefaults, nil
 }
 
+// generatesAutoIncrementValue returns whether |expr|, given for an auto_increment column, is replaced by the next
+// value of the column's sequence.
+func generatesAutoIncrementValue(ctx *sql.Context, expr sql.Expression) bool {
+	if unwrap, ok := expr.(*expression.Wrapper); ok {
+		expr = unwrap.Unwrap()
+	}
+	if _, isDef := expr.(*sql.ColumnDefaultValue); isDef {
+		return true
+	}
+	if lit, isLit := expr.(*expression.Literal); isLit {
+		// If a literal NULL or if 0 is specified and the NO_AUTO_VALUE_ON_ZERO SQL mode is
+		// not active, then MySQL will fill in an auto_increment value.
+		return types.Null.Equals(lit.Type(ctx)) ||
+			(!sql.LoadSqlMode(ctx).ModeEnabled(sql.NoAutoValueOnZero) && isZero(ctx, lit))
+	}
+	return false
+}
+
 // isZero returns true if the specified literal value |lit| has a value equal to 0.
 func isZero(ctx *sql.Context, lit *expression.Literal) bool {
 	if !types.IsNumber(lit.Type(ctx)) {
@@ -332,14 +345,22 @@ func wrapAutoUuidInValuesTuples(ctx *sql.Context, autoUuidCol *sql.Column, inser
 
 // isAutoUuidColumn returns true if the specified |col| meets the requirements of an auto generated UUID column. To
 // be an auto UUID column, the column must be part of the primary key (it may be a composite primary key), and the
-// type must be either varchar(36), char(36), varbinary(16), or binary(16). It must have a default value set to
-// populate a UUID, either through the UUID() function (for char and varchar columns) or the UUID_TO_BIN(UUID())
+// type must be either uuid, varchar(36), char(36), varbinary(16), or binary(16). It must have a default value set to
+// populate a UUID, either through the UUID() function (for uuid, char and varchar columns) or the UUID_TO_BIN(UUID())
 // function (for binary and varbinary columns).
 func isAutoUuidColumn(ctx *sql.Context, col *sql.Column) bool {
 	if col.PrimaryKey == false {
 		return false
 	}
 
+	if types.IsUuid(col.Type) {
+		if col.Default == nil {
+			return false
+		}
+		_, ok := col.Default.Expr.(*function.UUIDFunc)
+		return ok
+	}
+
 	switch col.Type.Type() {
 	case sqltypes.Char, sqltypes.VarChar:
 		stringType := col.Type.(sql.StringType)
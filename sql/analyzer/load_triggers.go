@@ -37,10 +37,19 @@ func loadTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
-			if len(loadedTriggers) != 0 {
-				newShowTriggers.Triggers = loadedTriggers
-			} else {
-				newShowTriggers.Triggers = make([]*plan.CreateTrigger, 0)
+			newShowTriggers.Triggers = make([]*plan.CreateTrigger, 0, len(loadedTriggers))
+			for _, trigger := range loadedTriggers {
+				// Only the triggers of tables that the user has the TRIGGER privilege on are shown
+				if a.Catalog.MySQLDb.Enabled() {
+					subject := sql.PrivilegeCheckSubject{
+						Database: newShowTriggers.Database().Name(),
+						Table:    getTableName(ctx, trigger.Table),
+					}
+					if !a.Catalog.MySQLDb.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Trigger)) {
+						continue
+					}
+				}
+				newShowTriggers.Triggers = append(newShowTriggers.Triggers, trigger)
 			}
 			return &newShowTriggers, transform.NewTree, nil
 		case *plan.DropTrigger:
@@ -102,11 +111,19 @@ func loadTriggersFromDb(ctx *sql.Context, a *Analyzer, db sql.Database, ignorePa
 		if err != nil {
 			return nil, err
 		}
+		// Trigger statements name their table without a database, so they're resolved against the trigger's database
+		if len(triggers) > 0 && !strings.EqualFold(ctx.GetCurrentDatabase(), db.Name()) {
+			originalDatabase := ctx.GetCurrentDatabase()
+			ctx.SetCurrentDatabase(db.Name())
+			defer ctx.SetCurrentDatabase(originalDatabase)
+		}
 		for _, trigger := range triggers {
 			var parsedTrigger sql.Node
 			sqlMode := sql.NewSqlModeFromString(trigger.SqlMode)
-			// TODO: should perhaps add the auth query handler to the analyzer? does this even use auth?
+			// Triggers are loaded for the statement being analyzed, which is authorized on its own, so loading them
+			// doesn't take the privileges of their CREATE TRIGGER statements
 			builder := planbuilder.New(ctx, a.Catalog, nil)
+			builder.DisableAuth()
 			builder.SetParserOptions(sqlMode.ParserOptions())
 			builder.TriggerCtx().LoadOnly = true
 			parsedTrigger, _, _, _, err = builder.Parse(trigger.CreateStatement, nil, false)
@@ -128,6 +145,10 @@ func loadTriggersFromDb(ctx *sql.Context, a *Analyzer, db sql.Database, ignorePa
 				return nil, sql.ErrTriggerCreateStatementInvalid.New(trigger.CreateStatement)
 			}
 			triggerPlan.CreatedAt = trigger.CreatedAt // use the stored created time
+			triggerPlan.SqlMode = sqlMode.String()
+			if trigger.Definer != "" {
+				triggerPlan.Definer = trigger.Definer
+			}
 			loadedTriggers = append(loadedTriggers, triggerPlan)
 		}
 	}
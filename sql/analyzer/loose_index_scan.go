@@ -0,0 +1,234 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// replaceLooseIndexScan replaces the table scan beneath a GROUP BY or DISTINCT with a *plan.LooseIndexScan when the
+// grouping columns are a prefix of an ordered index. The scan reads one row per group instead of the entire index.
+// The GROUP BY or DISTINCT node is kept, so it only has to be correct for every aggregate to see one row per group:
+//
+//	SELECT DISTINCT a FROM t
+//	SELECT a, MIN(b) FROM t GROUP BY a
+//
+// with an index on (a, b).
+func replaceLooseIndexScan(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	if !sql.LoadOptimizerSwitch(ctx).SkipScan() {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(ctx, n, func(ctx *sql.Context, n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.GroupBy:
+			rt, ok := n.Child.(*plan.ResolvedTable)
+			if !ok || len(n.GroupByExprs) == 0 {
+				return n, transform.SameTree, nil
+			}
+			groupCols, ok := looseScanColumns(rt, n.GroupByExprs)
+			if !ok {
+				return n, transform.SameTree, nil
+			}
+
+			// every selected expression must have the same value for any
+			// row of its group, except for MIN of the column after the prefix
+			var minCol string
+			for _, e := range n.SelectDeps {
+				switch e := e.(type) {
+				case *expression.GetField:
+					if _, ok := groupCols[strings.ToLower(e.Name())]; !ok {
+						return n, transform.SameTree, nil
+					}
+				case *aggregation.Min, *aggregation.Max:
+					gf, ok := e.Children()[0].(*expression.GetField)
+					if !ok || !strings.EqualFold(gf.Table(), rt.Name()) {
+						return n, transform.SameTree, nil
+					}
+					name := strings.ToLower(gf.Name())
+					if _, ok := groupCols[name]; ok {
+						continue
+					}
+					if _, ok := e.(*aggregation.Min); !ok || (minCol != "" && minCol != name) {
+						return n, transform.SameTree, nil
+					}
+					minCol = name
+				default:
+					return n, transform.SameTree, nil
+				}
+			}
+
+			scan, err := newLooseIndexScan(ctx, rt, groupCols, minCol)
+			if err != nil || scan == nil {
+				return n, transform.SameTree, err
+			}
+			ret, err := n.WithChildren(ctx, scan)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return ret, transform.NewTree, nil
+		case *plan.Distinct:
+			if len(n.DistinctOn()) > 0 {
+				return n, transform.SameTree, nil
+			}
+			var rt *plan.ResolvedTable
+			var groupCols map[string]struct{}
+			switch c := n.Child.(type) {
+			case *plan.ResolvedTable:
+				rt = c
+				groupCols = make(map[string]struct{})
+				for _, col := range c.Schema(ctx) {
+					groupCols[strings.ToLower(col.Name)] = struct{}{}
+				}
+			case *plan.Project:
+				var ok bool
+				if rt, ok = c.Child.(*plan.ResolvedTable); !ok {
+					return n, transform.SameTree, nil
+				}
+				if groupCols, ok = looseScanColumns(rt, c.Projections); !ok {
+					return n, transform.SameTree, nil
+				}
+			default:
+				return n, transform.SameTree, nil
+			}
+			if len(groupCols) == 0 {
+				return n, transform.SameTree, nil
+			}
+
+			scan, err := newLooseIndexScan(ctx, rt, groupCols, "")
+			if err != nil || scan == nil {
+				return n, transform.SameTree, err
+			}
+			newChild := sql.Node(scan)
+			if proj, ok := n.Child.(*plan.Project); ok {
+				if newChild, err = proj.WithChildren(ctx, scan); err != nil {
+					return nil, transform.SameTree, err
+				}
+			}
+			ret, err := n.WithChildren(ctx, newChild)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return ret, transform.NewTree, nil
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+}
+
+// looseScanColumns returns the lower case names of the columns of |rt| referenced by |exprs|, returning false if any
+// expression is not a column of |rt|.
+func looseScanColumns(rt *plan.ResolvedTable, exprs []sql.Expression) (map[string]struct{}, bool) {
+	cols := make(map[string]struct{}, len(exprs))
+	for _, e := range exprs {
+		gf, ok := e.(*expression.GetField)
+		if !ok || !strings.EqualFold(gf.Table(), rt.Name()) {
+			return nil, false
+		}
+		cols[strings.ToLower(gf.Name())] = struct{}{}
+	}
+	return cols, true
+}
+
+// newLooseIndexScan returns a *plan.LooseIndexScan over an index of |rt| whose leading columns are exactly
+// |groupCols|, followed by |minCol| if it is not empty. It returns nil if no index qualifies.
+func newLooseIndexScan(ctx *sql.Context, rt *plan.ResolvedTable, groupCols map[string]struct{}, minCol string) (*plan.LooseIndexScan, error) {
+	table := rt.UnderlyingTable()
+	idxTbl, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return nil, nil
+	}
+	if indexSearchable, ok := table.(sql.IndexSearchableTable); ok && indexSearchable.SkipIndexCosting() {
+		return nil, nil
+	}
+	if _, ok := plan.FindVirtualColumnTable(rt.Table); ok {
+		return nil, nil
+	}
+
+	idxs, err := idxTbl.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyLen := len(groupCols)
+	if minCol != "" {
+		keyLen++
+	}
+
+	var idx sql.Index
+	for _, candidate := range idxs {
+		if candidate.IsSpatial() || candidate.IsFullText() || candidate.IsVector() {
+			continue
+		}
+		if oi, ok := candidate.(sql.OrderedIndex); !ok || oi.Order(ctx) == sql.IndexOrderNone {
+			continue
+		}
+		if looseScanIndexMatches(candidate, groupCols, minCol, keyLen) {
+			idx = candidate
+			break
+		}
+	}
+	if idx == nil {
+		return nil, nil
+	}
+
+	lookup, err := sql.NewMySQLIndexBuilder(ctx, idx).Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !idx.CanSupport(ctx, lookup.Ranges.(sql.MySQLRangeCollection).ToRanges()...) {
+		return nil, nil
+	}
+	ita, err := plan.NewStaticIndexedAccessForTableNode(ctx, rt, lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	sch := ita.Schema(ctx)
+	keyOrdinals := make([]int, keyLen)
+	for i, expr := range idx.Expressions()[:keyLen] {
+		keyOrdinals[i] = sch.IndexOfColName(unqualify(expr))
+		if keyOrdinals[i] < 0 {
+			return nil, nil
+		}
+	}
+	return plan.NewLooseIndexScan(ita, len(groupCols), keyOrdinals, minCol != ""), nil
+}
+
+// looseScanIndexMatches returns whether the first columns of |idx| are the columns of |groupCols| in any order,
+// followed by |minCol| when it is set. Prefix indexes cannot be used, since they do not store whole values.
+func looseScanIndexMatches(idx sql.Index, groupCols map[string]struct{}, minCol string, keyLen int) bool {
+	exprs := idx.Expressions()
+	if len(exprs) < keyLen {
+		return false
+	}
+	for i, l := range idx.PrefixLengths() {
+		if i < keyLen && l > 0 {
+			return false
+		}
+	}
+	for _, expr := range exprs[:len(groupCols)] {
+		if _, ok := groupCols[strings.ToLower(unqualify(expr))]; !ok {
+			return false
+		}
+	}
+	return minCol == "" || strings.EqualFold(unqualify(exprs[len(groupCols)]), minCol)
+}
@@ -0,0 +1,330 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// mergeDerivedTables merges simple derived tables and views into the outer
+// query block, which is MySQL's derived_merge optimization:
+//
+//	SELECT sq.x FROM (SELECT a AS x FROM t WHERE b > 0) sq WHERE sq.x = 1
+//
+// becomes
+//
+//	SELECT t.a AS x FROM t WHERE b > 0 AND t.a = 1
+//
+// A derived table is mergeable when it projects plain column references over
+// a single (optionally filtered) table and is not correlated, lateral, or
+// volatile. The SubqueryAlias is replaced by its filtered table, and
+// references to the alias's columns are rewritten to reference the base
+// table's columns, so the outer query can use the base table's indexes for
+// filters and joins. Projections that expose a merged column keep the
+// original column id through an alias, so the outer schema is unchanged.
+//
+// The optimization is disabled with SET optimizer_switch = 'derived_merge=off'.
+func mergeDerivedTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	if !qFlags.SubqueryIsSet() && !hasSubqueryAlias(ctx, n) {
+		return n, transform.SameTree, nil
+	}
+
+	switch n.(type) {
+	case *plan.ShowCreateTable, *plan.ShowColumns, *plan.CreateView:
+		return n, transform.SameTree, nil
+	}
+
+	// DML targets must remain addressable by their original names
+	if !n.IsReadOnly() {
+		return n, transform.SameTree, nil
+	}
+
+	if !sql.LoadOptimizerSwitch(ctx).DerivedMerge() {
+		return n, transform.SameTree, nil
+	}
+
+	m := &derivedTableMerger{
+		replacements: make(map[sql.ColumnId]*expression.GetField),
+		tableNames:   make(map[string]int),
+	}
+	m.countTableNames(ctx, n)
+	ret, raw, same, err := m.mergeNode(ctx, n, sql.ColSet{})
+	if err != nil {
+		return n, transform.SameTree, err
+	}
+	if m.abort || !raw.Empty() {
+		// A merged column escaped without a projection to rename it, which
+		// would change the schema of the result.
+		return n, transform.SameTree, nil
+	}
+	return ret, same, nil
+}
+
+// derivedTableMerger tracks the columns of merged derived tables and the base
+// table expressions that replace them.
+type derivedTableMerger struct {
+	replacements map[sql.ColumnId]*expression.GetField
+	// tableNames counts the tables and aliases in the query by name
+	tableNames map[string]int
+	abort      bool
+}
+
+// countTableNames counts the names of the tables, table aliases and derived
+// tables in |n|, including those in subquery expressions.
+func (m *derivedTableMerger) countTableNames(ctx *sql.Context, n sql.Node) {
+	transform.InspectWithOpaque(ctx, n, func(ctx *sql.Context, n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias:
+			m.tableNames[strings.ToLower(n.(sql.Nameable).Name())]++
+		}
+		if ne, ok := n.(sql.Expressioner); ok {
+			for _, e := range ne.Expressions() {
+				transform.InspectExpr(ctx, e, func(ctx *sql.Context, e sql.Expression) bool {
+					if sq, ok := e.(*plan.Subquery); ok {
+						m.countTableNames(ctx, sq.Query)
+					}
+					return false
+				})
+			}
+		}
+		_, isAlias := n.(*plan.TableAlias)
+		return !isAlias
+	})
+}
+
+// mergeNode merges the mergeable derived tables in |n| and rewrites the
+// references to their columns. |outer| contains the merged columns visible
+// from outer scopes. The returned ColSet contains the merged columns that |n|
+// passes through to its parent without renaming them.
+func (m *derivedTableMerger) mergeNode(ctx *sql.Context, n sql.Node, outer sql.ColSet) (sql.Node, sql.ColSet, transform.TreeIdentity, error) {
+	if m.abort {
+		return n, sql.ColSet{}, transform.SameTree, nil
+	}
+
+	var childRaw sql.ColSet
+	visible := outer
+	children := n.Children()
+	newChildren := make([]sql.Node, len(children))
+	same := transform.SameTree
+	for i, child := range children {
+		newChild, raw, childSame, err := m.mergeNode(ctx, child, visible)
+		if err != nil {
+			return n, sql.ColSet{}, transform.SameTree, err
+		}
+		newChildren[i] = newChild
+		same = same && childSame
+		childRaw = childRaw.Union(raw)
+		// preceding join children are visible to lateral derived tables
+		visible = visible.Union(raw)
+	}
+
+	ret := n
+	if !same {
+		var err error
+		ret, err = n.WithChildren(ctx, newChildren...)
+		if err != nil {
+			return n, sql.ColSet{}, transform.SameTree, err
+		}
+	}
+
+	switch nn := ret.(type) {
+	case *plan.SubqueryAlias:
+		if !childRaw.Empty() {
+			m.abort = true
+			return n, sql.ColSet{}, transform.SameTree, nil
+		}
+		if !nn.Correlated.Empty() {
+			correlated := m.replaceCols(nn.Correlated, outer)
+			if !correlated.Equals(nn.Correlated) {
+				nn = nn.WithCorrelated(correlated)
+				ret, same = nn, transform.NewTree
+			}
+		}
+		if merged, cols, ok := m.tryMerge(ctx, nn); ok {
+			return merged, cols, transform.NewTree, nil
+		}
+		return ret, sql.ColSet{}, same, nil
+	case *plan.SetOp, *plan.RecursiveCte:
+		if !childRaw.Empty() {
+			m.abort = true
+		}
+		return ret, sql.ColSet{}, same, nil
+	}
+
+	if len(m.replacements) == 0 {
+		return ret, childRaw, same, nil
+	}
+
+	ret, exprsSame, err := m.replaceExprs(ctx, ret, childRaw, outer.Union(childRaw))
+	if err != nil {
+		return n, sql.ColSet{}, transform.SameTree, err
+	}
+	if _, ok := ret.(sql.Projector); ok {
+		return ret, sql.ColSet{}, same && exprsSame, nil
+	}
+	return ret, childRaw, same && exprsSame, nil
+}
+
+// tryMerge returns the filtered table that replaces |sqa| if it can be merged
+// into the outer query block, along with the columns of |sqa| that are now
+// provided by that table.
+func (m *derivedTableMerger) tryMerge(ctx *sql.Context, sqa *plan.SubqueryAlias) (sql.Node, sql.ColSet, bool) {
+	if sqa.IsLateral || sqa.Volatile || !sqa.Correlated.Empty() {
+		return nil, sql.ColSet{}, false
+	}
+	proj, ok := sqa.Child.(*plan.Project)
+	if !ok || len(proj.Projections) != sqa.Columns().Len() {
+		return nil, sql.ColSet{}, false
+	}
+
+	var source sql.Node = proj.Child
+	for {
+		f, ok := source.(*plan.Filter)
+		if !ok {
+			break
+		}
+		source = f.Child
+	}
+	switch t := source.(type) {
+	case *plan.ResolvedTable:
+		if plan.IsDualTable(t.Table) {
+			return nil, sql.ColSet{}, false
+		}
+	case *plan.TableAlias:
+		if _, ok := t.Child.(*plan.ResolvedTable); !ok {
+			return nil, sql.ColSet{}, false
+		}
+	default:
+		return nil, sql.ColSet{}, false
+	}
+	// the merged table must not be confused with another table of the same name
+	if m.tableNames[strings.ToLower(source.(sql.Nameable).Name())] > 1 {
+		return nil, sql.ColSet{}, false
+	}
+
+	replacements := make([]*expression.GetField, len(proj.Projections))
+	for i, p := range proj.Projections {
+		if a, ok := p.(*expression.Alias); ok {
+			p = a.Child
+		}
+		gf, ok := p.(*expression.GetField)
+		if !ok {
+			return nil, sql.ColSet{}, false
+		}
+		replacements[i] = gf
+	}
+
+	i := 0
+	for col, ok := sqa.Columns().Next(1); ok; col, ok = sqa.Columns().Next(col + 1) {
+		m.replacements[col] = replacements[i]
+		i++
+	}
+	return proj.Child, sqa.Columns(), true
+}
+
+// replaceExprs rewrites references to merged columns in the expressions of
+// |n|. Top-level projections of merged columns passed through by |n|'s
+// children are aliased so that they retain their column id and name.
+func (m *derivedTableMerger) replaceExprs(ctx *sql.Context, n sql.Node, childRaw, visible sql.ColSet) (sql.Node, transform.TreeIdentity, error) {
+	ne, ok := n.(sql.Expressioner)
+	if !ok {
+		return n, transform.SameTree, nil
+	}
+	var projected int
+	if p, ok := n.(sql.Projector); ok {
+		projected = len(p.ProjectedExprs())
+	}
+
+	exprs := ne.Expressions()
+	newExprs := make([]sql.Expression, len(exprs))
+	same := transform.SameTree
+	for i, e := range exprs {
+		if gf, ok := e.(*expression.GetField); ok && i < projected && childRaw.Contains(gf.Id()) {
+			if r, ok := m.replacements[gf.Id()]; ok {
+				newExprs[i] = expression.NewAlias(ctx, gf.Name(), r).WithId(gf.Id()).(*expression.Alias)
+				same = transform.NewTree
+				continue
+			}
+		}
+		newExpr, exprSame, err := m.replaceExpr(ctx, e, visible)
+		if err != nil {
+			return n, transform.SameTree, err
+		}
+		newExprs[i] = newExpr
+		same = same && exprSame
+	}
+	if same {
+		return n, transform.SameTree, nil
+	}
+	ret, err := ne.WithExpressions(ctx, newExprs...)
+	if err != nil {
+		return n, transform.SameTree, err
+	}
+	return ret, transform.NewTree, nil
+}
+
+// replaceExpr replaces references to the merged columns in |visible| within
+// |e|, including outer references from subquery expressions.
+func (m *derivedTableMerger) replaceExpr(ctx *sql.Context, e sql.Expression, visible sql.ColSet) (sql.Expression, transform.TreeIdentity, error) {
+	return transform.Expr(ctx, e, func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		switch e := e.(type) {
+		case *expression.GetField:
+			if !visible.Contains(e.Id()) {
+				return e, transform.SameTree, nil
+			}
+			if r, ok := m.replacements[e.Id()]; ok {
+				return r, transform.NewTree, nil
+			}
+		case *plan.Subquery:
+			newQuery, raw, same, err := m.mergeNode(ctx, e.Query, visible)
+			if err != nil {
+				return e, transform.SameTree, err
+			}
+			if !raw.Empty() {
+				m.abort = true
+			}
+			ret := e
+			if !same {
+				ret = ret.WithQuery(newQuery)
+			}
+			if correlated := m.replaceCols(ret.Correlated(), visible); !correlated.Equals(ret.Correlated()) {
+				ret = ret.WithCorrelated(correlated)
+				same = transform.NewTree
+			}
+			return ret, same, nil
+		}
+		return e, transform.SameTree, nil
+	})
+}
+
+// replaceCols returns |cols| with the merged columns in |visible| replaced by
+// the base table columns that replace them.
+func (m *derivedTableMerger) replaceCols(cols, visible sql.ColSet) sql.ColSet {
+	var ret sql.ColSet
+	cols.ForEach(func(col sql.ColumnId) {
+		r, ok := m.replacements[col]
+		if !ok || !visible.Contains(col) {
+			ret.Add(col)
+			return
+		}
+		ret.Add(r.Id())
+	})
+	return ret
+}
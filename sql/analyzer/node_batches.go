@@ -20,6 +20,10 @@ func isSimpleSelect(proj *plan.Project) bool {
 // getBatchesForNode returns a partial analyzer ruleset for simple node
 // types that require little prior validation before execution.
 func getBatchesForNode(scope *plan.Scope, node sql.Node, qFlags *sql.QueryFlags) ([]*Batch, bool) {
+	// calls to stored functions are handed the engine by the interpreter rule, which the partial rulesets don't include
+	if qFlags.IsSet(sql.QFlagStoredFunction) {
+		return nil, false
+	}
 	switch n := node.(type) {
 	case *plan.Commit:
 		return nil, true
@@ -201,6 +205,10 @@ func getBatchesForNode(scope *plan.Scope, node sql.Node, qFlags *sql.QueryFlags)
 							Id:    pushFiltersId,
 							Apply: pushFilters,
 						},
+						{
+							Id:    prunePartitionsId,
+							Apply: prunePartitions,
+						},
 						{
 							Id:    optimizeJoinsId,
 							Apply: optimizeJoins,
@@ -155,7 +155,7 @@ func validateTruncate(ctx *sql.Context, db sql.Database, tbl sql.Node) (bool, er
 		if strings.ToLower(tableNameToCheck) == tableName {
 			continue
 		}
-		tableToCheck, ok, err := db.GetTableInsensitive(ctx, tableNameToCheck)
+		tableToCheck, ok, err := sql.GetSessionTableInsensitive(ctx, db, tableNameToCheck)
 		if err != nil {
 			return true, err // should not error under normal circumstances
 		}
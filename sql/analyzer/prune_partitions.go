@@ -0,0 +1,342 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// prunePartitions restricts each sql.PartitionedTable to the partitions that the filters pushed down directly above it
+// don't rule out, so that scans of the table skip the partitions that can't hold any matching rows. It runs after
+// pushFilters, and only considers filters on the table's partitioning column that compare it to literals.
+func prunePartitions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(ctx, n, func(ctx *sql.Context, n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		f, ok := n.(*plan.Filter)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+
+		var rt *plan.ResolvedTable
+		var alias *plan.TableAlias
+		switch c := f.Child.(type) {
+		case *plan.ResolvedTable:
+			rt = c
+		case *plan.TableAlias:
+			alias = c
+			if rt, ok = c.Child.(*plan.ResolvedTable); !ok {
+				return n, transform.SameTree, nil
+			}
+		default:
+			return n, transform.SameTree, nil
+		}
+		pt, ok := rt.UnderlyingTable().(sql.PartitionedTable)
+		if !ok || pt.PartitionNames() != nil {
+			return n, transform.SameTree, nil
+		}
+
+		scheme := pt.PartitionScheme(ctx)
+		p := partitionPruner{scheme: scheme, tableIds: []sql.TableId{rt.Id()}}
+		if alias != nil {
+			p.tableIds = append(p.tableIds, alias.Id())
+		}
+		surviving := p.allPartitions()
+		for _, e := range expression.SplitConjunction(ctx, f.Expression) {
+			if keep, ok := p.partitionsFor(ctx, e); ok {
+				surviving = intersectPartitions(surviving, keep)
+			}
+		}
+		if len(surviving) == len(scheme.Partitions) {
+			return n, transform.SameTree, nil
+		}
+
+		names := make([]string, 0, len(surviving))
+		for i, def := range scheme.Partitions {
+			if surviving[i] {
+				names = append(names, def.Name)
+			}
+		}
+		newTable, err := pt.WithPartitionNames(ctx, names)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		var child sql.Node
+		child, err = rt.WithTable(ctx, newTable)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if alias != nil {
+			child, err = alias.WithChildren(ctx, child)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		a.Log("pruned partitions of table %q to %v", rt.Name(), names)
+		ret, err := f.WithChildren(ctx, child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return ret, transform.NewTree, nil
+	})
+}
+
+// partitionSet marks the partitions of a sql.PartitionScheme, by position, that may hold matching rows. A partition
+// is included if its position is a key of the set.
+type partitionSet map[int]bool
+
+func intersectPartitions(a, b partitionSet) partitionSet {
+	ret := make(partitionSet)
+	for i := range a {
+		if b[i] {
+			ret[i] = true
+		}
+	}
+	return ret
+}
+
+func unionPartitions(a, b partitionSet) partitionSet {
+	ret := make(partitionSet)
+	for i := range a {
+		ret[i] = true
+	}
+	for i := range b {
+		ret[i] = true
+	}
+	return ret
+}
+
+// partitionPruner determines the partitions of a table that may hold the rows that satisfy a filter.
+type partitionPruner struct {
+	scheme sql.PartitionScheme
+	// tableIds are the ids that columns of the table may be referenced by
+	tableIds []sql.TableId
+}
+
+func (p partitionPruner) allPartitions() partitionSet {
+	ret := make(partitionSet)
+	for i := range p.scheme.Partitions {
+		ret[i] = true
+	}
+	return ret
+}
+
+// isPartitionColumn returns whether |e| is a reference to the partitioning column of the table.
+func (p partitionPruner) isPartitionColumn(e sql.Expression) (*expression.GetField, bool) {
+	gf, ok := e.(*expression.GetField)
+	if !ok || !strings.EqualFold(gf.Name(), p.scheme.Column) {
+		return nil, false
+	}
+	for _, id := range p.tableIds {
+		if gf.TableId() == id {
+			return gf, true
+		}
+	}
+	return nil, false
+}
+
+// partitionsFor returns the partitions that may hold rows for which |e| is true, or false if |e| can't be used to
+// prune partitions.
+func (p partitionPruner) partitionsFor(ctx *sql.Context, e sql.Expression) (partitionSet, bool) {
+	switch e := e.(type) {
+	case *expression.And:
+		l, lok := p.partitionsFor(ctx, e.LeftChild)
+		r, rok := p.partitionsFor(ctx, e.RightChild)
+		switch {
+		case lok && rok:
+			return intersectPartitions(l, r), true
+		case lok:
+			return l, true
+		case rok:
+			return r, true
+		}
+	case *expression.Or:
+		l, lok := p.partitionsFor(ctx, e.LeftChild)
+		r, rok := p.partitionsFor(ctx, e.RightChild)
+		if lok && rok {
+			return unionPartitions(l, r), true
+		}
+	case *expression.IsNull:
+		if _, ok := p.isPartitionColumn(e.Child); ok {
+			return p.matching(ctx, nil, nil, true)
+		}
+	case *expression.InTuple:
+		gf, ok := p.isPartitionColumn(e.Left())
+		if !ok {
+			return nil, false
+		}
+		tup, ok := e.Right().(expression.Tuple)
+		if !ok {
+			return nil, false
+		}
+		ret := make(partitionSet)
+		for _, el := range tup {
+			lit, ok := el.(*expression.Literal)
+			if !ok {
+				return nil, false
+			}
+			keep, ok := p.comparedTo(ctx, gf, lit, func(cmp int) bool { return cmp == 0 })
+			if !ok {
+				return nil, false
+			}
+			ret = unionPartitions(ret, keep)
+		}
+		return ret, true
+	case expression.Comparer:
+		var match func(int) bool
+		switch e.(type) {
+		case *expression.Equals, *expression.NullSafeEquals:
+			match = func(cmp int) bool { return cmp == 0 }
+		case *expression.LessThan:
+			match = func(cmp int) bool { return cmp < 0 }
+		case *expression.LessThanOrEqual:
+			match = func(cmp int) bool { return cmp <= 0 }
+		case *expression.GreaterThan:
+			match = func(cmp int) bool { return cmp > 0 }
+		case *expression.GreaterThanOrEqual:
+			match = func(cmp int) bool { return cmp >= 0 }
+		default:
+			return nil, false
+		}
+		if gf, ok := p.isPartitionColumn(e.Left()); ok {
+			if lit, ok := e.Right().(*expression.Literal); ok {
+				return p.comparedTo(ctx, gf, lit, match)
+			}
+		}
+		// with the column on the right hand side, the comparison is reversed
+		if gf, ok := p.isPartitionColumn(e.Right()); ok {
+			if lit, ok := e.Left().(*expression.Literal); ok {
+				return p.comparedTo(ctx, gf, lit, func(cmp int) bool { return match(-cmp) })
+			}
+		}
+	}
+	return nil, false
+}
+
+// comparedTo returns the partitions that may hold values of the column |gf| whose comparison to |lit| satisfies
+// |match|. NULL values never match a comparison to a literal, except for NULL-safe equality with NULL.
+func (p partitionPruner) comparedTo(ctx *sql.Context, gf *expression.GetField, lit *expression.Literal, match func(int) bool) (partitionSet, bool) {
+	val := lit.Value()
+	if val == nil {
+		// only NULL-safe equality can match NULL, in which case it's the same as IS NULL
+		if match(0) && !match(-1) && !match(1) {
+			return p.matching(ctx, gf.Type(ctx), nil, true)
+		}
+		return nil, false
+	}
+	return p.matching(ctx, gf.Type(ctx), match, false, val)
+}
+
+// matching returns the partitions that may hold a value |v| such that |match| is true for the comparison of |v| to
+// |val|, or that may hold NULL values if |null| is true.
+func (p partitionPruner) matching(ctx *sql.Context, typ sql.Type, match func(int) bool, null bool, val ...interface{}) (partitionSet, bool) {
+	ret := make(partitionSet)
+	switch p.scheme.Method {
+	case sql.PartitionMethodRange:
+		if null {
+			if len(p.scheme.Partitions) > 0 {
+				ret[0] = true
+			}
+			return ret, true
+		}
+		var lower interface{}
+		for i, def := range p.scheme.Partitions {
+			// the partition holds the values in [lower, def.LessThan)
+			keep, ok := rangeMayMatch(ctx, typ, lower, def.LessThan, i == 0, val[0], match)
+			if !ok {
+				return nil, false
+			}
+			if keep {
+				ret[i] = true
+			}
+			lower = def.LessThan
+		}
+		return ret, true
+	case sql.PartitionMethodList:
+		for i, def := range p.scheme.Partitions {
+			for _, v := range def.Values {
+				if v == nil {
+					if null {
+						ret[i] = true
+					}
+					continue
+				}
+				if null {
+					continue
+				}
+				cmp, err := typ.Compare(ctx, v, val[0])
+				if err != nil {
+					return nil, false
+				}
+				if match(cmp) {
+					ret[i] = true
+				}
+			}
+		}
+		return ret, true
+	case sql.PartitionMethodHash, sql.PartitionMethodKey:
+		n := len(p.scheme.Partitions)
+		if n == 0 {
+			return ret, true
+		}
+		if null {
+			ret[0] = true
+			return ret, true
+		}
+		// only equality identifies the partition of a value
+		if !match(0) || match(-1) || match(1) {
+			return nil, false
+		}
+		v, _, err := typ.Convert(ctx, val[0])
+		if err != nil || v == nil {
+			return nil, false
+		}
+		i, err := p.scheme.PartitionFor(ctx, typ, v)
+		if err != nil {
+			return nil, false
+		}
+		ret[i] = true
+		return ret, true
+	}
+	return nil, false
+}
+
+// rangeMayMatch returns whether the range of values [lower, upper) may hold a value |v| for which |match| is true for
+// the comparison of |v| to |val|. A nil |upper| is unbounded, as is a nil |lower| if |first| is true.
+func rangeMayMatch(ctx *sql.Context, typ sql.Type, lower, upper interface{}, first bool, val interface{}, match func(int) bool) (bool, bool) {
+	// values below |val| are in the range if its lower bound is below |val|, and |val| itself if it's not above it
+	below, atLeastLower := first, first
+	if !first {
+		cmp, err := typ.Compare(ctx, lower, val)
+		if err != nil {
+			return false, false
+		}
+		below, atLeastLower = cmp < 0, cmp <= 0
+	}
+	// values above |val|, and |val| itself, are in the range if its upper bound is above |val|
+	above := upper == nil
+	if !above {
+		cmp, err := typ.Compare(ctx, upper, val)
+		if err != nil {
+			return false, false
+		}
+		above = cmp > 0
+	}
+	within := atLeastLower && above
+	return (below && match(-1)) || (within && match(0)) || (above && match(1)), true
+}
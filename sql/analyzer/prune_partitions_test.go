@@ -0,0 +1,210 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestPrunePartitions(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	table := memory.NewTable(ctx, db, "t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t", Nullable: true},
+		{Name: "j", Type: types.Int64, Source: "t", Nullable: true},
+	}), nil)
+	i := expression.NewGetFieldWithTable(0, 1, types.Int64, "mydb", "t", "i", true)
+	j := expression.NewGetFieldWithTable(1, 1, types.Int64, "mydb", "t", "j", true)
+	lit := func(v int64) sql.Expression {
+		return expression.NewLiteral(v, types.Int64)
+	}
+
+	rangeScheme := sql.PartitionScheme{
+		Method: sql.PartitionMethodRange,
+		Column: "i",
+		Partitions: []sql.PartitionDefinition{
+			{Name: "p0", LessThan: int64(10)},
+			{Name: "p1", LessThan: int64(20)},
+			{Name: "p2", LessThan: int64(30)},
+			{Name: "pmax"},
+		},
+	}
+	listScheme := sql.PartitionScheme{
+		Method: sql.PartitionMethodList,
+		Column: "i",
+		Partitions: []sql.PartitionDefinition{
+			{Name: "odd", Values: []interface{}{int64(1), int64(3), int64(5)}},
+			{Name: "even", Values: []interface{}{int64(2), int64(4), int64(6)}},
+			{Name: "none", Values: []interface{}{nil}},
+		},
+	}
+	hashScheme := sql.PartitionScheme{
+		Method:     sql.PartitionMethodHash,
+		Column:     "i",
+		Partitions: []sql.PartitionDefinition{{Name: "h0"}, {Name: "h1"}, {Name: "h2"}},
+	}
+
+	tests := []struct {
+		name       string
+		scheme     sql.PartitionScheme
+		filter     sql.Expression
+		partitions []string
+	}{
+		{
+			name:       "range equality",
+			scheme:     rangeScheme,
+			filter:     expression.NewEquals(i, lit(15)),
+			partitions: []string{"p1"},
+		},
+		{
+			name:       "range equality to a bound",
+			scheme:     rangeScheme,
+			filter:     expression.NewEquals(i, lit(10)),
+			partitions: []string{"p1"},
+		},
+		{
+			name:       "range less than",
+			scheme:     rangeScheme,
+			filter:     expression.NewLessThan(i, lit(10)),
+			partitions: []string{"p0"},
+		},
+		{
+			name:       "range greater than or equal",
+			scheme:     rangeScheme,
+			filter:     expression.NewGreaterThanOrEqual(i, lit(20)),
+			partitions: []string{"p2", "pmax"},
+		},
+		{
+			name:       "range with column on the right",
+			scheme:     rangeScheme,
+			filter:     expression.NewGreaterThan(lit(10), i),
+			partitions: []string{"p0"},
+		},
+		{
+			name:       "range between",
+			scheme:     rangeScheme,
+			filter:     expression.NewAnd(expression.NewGreaterThan(i, lit(12)), expression.NewLessThan(i, lit(25))),
+			partitions: []string{"p1", "p2"},
+		},
+		{
+			name:       "range is null",
+			scheme:     rangeScheme,
+			filter:     expression.NewIsNull(i),
+			partitions: []string{"p0"},
+		},
+		{
+			name:       "range no match",
+			scheme:     rangeScheme,
+			filter:     expression.NewAnd(expression.NewLessThan(i, lit(5)), expression.NewGreaterThan(i, lit(40))),
+			partitions: []string{},
+		},
+		{
+			name:       "list in",
+			scheme:     listScheme,
+			filter:     expression.NewInTuple(i, expression.NewTuple(lit(1), lit(3))),
+			partitions: []string{"odd"},
+		},
+		{
+			name:       "list or",
+			scheme:     listScheme,
+			filter:     expression.NewOr(expression.NewEquals(i, lit(4)), expression.NewIsNull(i)),
+			partitions: []string{"even", "none"},
+		},
+		{
+			name:       "list or with another column",
+			scheme:     listScheme,
+			filter:     expression.NewOr(expression.NewEquals(i, lit(4)), expression.NewEquals(j, lit(1))),
+			partitions: nil,
+		},
+		{
+			name:       "list conjunction with another column",
+			scheme:     listScheme,
+			filter:     expression.NewAnd(expression.NewEquals(i, lit(4)), expression.NewEquals(j, lit(1))),
+			partitions: []string{"even"},
+		},
+		{
+			name:       "hash equality",
+			scheme:     hashScheme,
+			filter:     expression.NewEquals(i, lit(-7)),
+			partitions: []string{"h1"},
+		},
+		{
+			name:       "hash in",
+			scheme:     hashScheme,
+			filter:     expression.NewInTuple(i, expression.NewTuple(lit(3), lit(5))),
+			partitions: []string{"h0", "h2"},
+		},
+		{
+			name:       "hash range",
+			scheme:     hashScheme,
+			filter:     expression.NewLessThan(i, lit(5)),
+			partitions: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := &partitionedTable{Table: table, scheme: tt.scheme}
+			n := plan.NewFilter(ctx, tt.filter, plan.NewResolvedTable(pt, db, nil).WithId(1).WithColumns(sql.NewColSet(1, 2)))
+
+			res, same, err := prunePartitions(ctx, NewDefault(pro), n, nil, DefaultRuleSelector, nil)
+			require.NoError(t, err)
+
+			rt := res.(*plan.Filter).Child.(*plan.ResolvedTable)
+			pruned := rt.UnderlyingTable().(*partitionedTable)
+			if tt.partitions == nil {
+				require.True(t, bool(same))
+				require.Nil(t, pruned.PartitionNames())
+				return
+			}
+			require.False(t, bool(same))
+			require.Equal(t, tt.partitions, pruned.PartitionNames())
+			require.Contains(t, rt.String(), "partitions: [")
+		})
+	}
+}
+
+// partitionedTable is a memory table that pretends to be divided into partitions according to a sql.PartitionScheme.
+type partitionedTable struct {
+	*memory.Table
+	scheme sql.PartitionScheme
+	names  []string
+}
+
+var _ sql.PartitionedTable = (*partitionedTable)(nil)
+
+func (t *partitionedTable) PartitionScheme(*sql.Context) sql.PartitionScheme {
+	return t.scheme
+}
+
+func (t *partitionedTable) WithPartitionNames(_ *sql.Context, names []string) (sql.Table, error) {
+	nt := *t
+	nt.names = names
+	return &nt, nil
+}
+
+func (t *partitionedTable) PartitionNames() []string {
+	return t.names
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // pushFilters moves filter nodes down to their appropriate relations.
@@ -111,13 +112,21 @@ func pushdownFiltersAboveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 		return plan.NewJoin(ctx, leftChild, rightChild, joinOp, joinCondition).WithComment(node.Comment()), transform.NewTree, nil
 	case *plan.TableAlias, *plan.ResolvedTable, *plan.ValueDerivedTable:
 		return filteredTableNode(ctx, a, node.(plan.TableIdNode), parentFilters)
-	case *plan.Limit, *plan.Window:
-		// Parent filters shouldn't be pushed through Limit or Window nodes but child filters should still get pushed
-		// down to the table level.
+	case *plan.Limit, *plan.Window, *plan.SharedResult:
+		// Parent filters shouldn't be pushed through Limit or Window nodes, or change the rows of a SharedResult, but
+		// child filters should still get pushed down to the table level.
 		newChild, same, err := pushdownFiltersAboveTables(ctx, a, node.Children()[0], scope, newEmptyFilterSet(parentFilters.projectionExpressions))
 		if err != nil {
 			return node, transform.SameTree, err
 		}
+		if limit, ok := node.(*plan.Limit); ok {
+			var limitSame transform.TreeIdentity
+			newChild, limitSame, err = pushLimitIntoTable(ctx, limit, newChild)
+			if err != nil {
+				return node, transform.SameTree, err
+			}
+			same = same && limitSame
+		}
 		if !same {
 			newNode, err := node.WithChildren(ctx, newChild)
 			return newNode, transform.NewTree, err
@@ -195,8 +204,9 @@ func filterPushdownSelector(ctx *sql.Context, c transform.Context) bool {
 	}
 
 	switch n := c.Node.(type) {
-	case *plan.Limit, *plan.Window:
-		// Limit and Window operate across the rows they see and cannot have filters pushed below them.
+	case *plan.Limit, *plan.Window, *plan.SharedResult:
+		// Limit and Window operate across the rows they see and cannot have filters pushed below them. The rows of a
+		// SharedResult are reused by other statements, so they must not depend on this one.
 		return false
 	case *plan.JoinNode:
 		// Filters cannot be pushed down into FullOuter joins because it is not null-safe and must be evaluated
@@ -224,6 +234,9 @@ func transformPushdownSubqueryAliasFilters(ctx *sql.Context, a *Analyzer, n sql.
 				if _, ok := node.Child.(*plan.RecursiveCte); ok {
 					return node, transform.SameTree, nil
 				}
+				if _, ok := node.Child.(*plan.SharedResult); ok {
+					return node, transform.SameTree, nil
+				}
 				return pushdownFiltersUnderSubqueryAlias(ctx, a, node, filters)
 			default:
 				return node, transform.SameTree, nil
@@ -274,6 +287,14 @@ func filteredTableNode(
 				return e, transform.SameTree, nil
 			})
 		}
+		var err error
+		tableNode, tableFilters, err = pushFiltersIntoTable(ctx, tableNode, tableFilters)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if len(tableFilters) == 0 {
+			return tableNode, transform.NewTree, nil
+		}
 		pushedDownFilterExpression = expression.JoinAnd(tableFilters...)
 
 		a.Log(
@@ -297,6 +318,114 @@ func filteredTableNode(
 	}
 }
 
+// pushFiltersIntoTable pushes those of |filters| that refer only to the columns of |tableNode| into its table, if
+// it's a sql.FilterPushdownTable that handles them. Returns the node for the table and the filters it didn't handle.
+func pushFiltersIntoTable(ctx *sql.Context, tableNode plan.TableIdNode, filters []sql.Expression) (plan.TableIdNode, []sql.Expression, error) {
+	alias, isAlias := tableNode.(*plan.TableAlias)
+	var rt *plan.ResolvedTable
+	if isAlias {
+		rt, _ = alias.Child.(*plan.ResolvedTable)
+	} else {
+		rt, _ = tableNode.(*plan.ResolvedTable)
+	}
+	if rt == nil {
+		return tableNode, filters, nil
+	}
+	ft, ok := rt.UnderlyingTable().(sql.FilterPushdownTable)
+	if !ok || !ft.PushdownFilters() {
+		return tableNode, filters, nil
+	}
+
+	var candidates []sql.Expression
+	for _, filter := range filters {
+		onlyTable := true
+		sql.Inspect(ctx, filter, func(ctx *sql.Context, e sql.Expression) bool {
+			if gf, ok := e.(*expression.GetField); ok && gf.TableId() != tableNode.Id() {
+				onlyTable = false
+			}
+			return onlyTable
+		})
+		if onlyTable {
+			candidates = append(candidates, filter)
+		}
+	}
+	handled := ft.HandledFilters(ctx, candidates)
+	if len(handled) == 0 {
+		return tableNode, filters, nil
+	}
+
+	pushed := append(append([]sql.Expression{}, ft.Filters()...), handled...)
+	newTable, err := rt.WithTable(ctx, ft.WithFilters(ctx, pushed))
+	if err != nil {
+		return nil, nil, err
+	}
+	var ret sql.Node = newTable
+	if isAlias {
+		if ret, err = alias.WithChildren(ctx, newTable); err != nil {
+			return nil, nil, err
+		}
+	}
+	return ret.(plan.TableIdNode), subtractExprSet(filters, handled), nil
+}
+
+// pushLimitIntoTable pushes the limit of |limit| into the table scanned by |child|, its child, if it's a
+// sql.LimitedTable and the rows of the scan reach the limit unchanged but for projections and an offset.
+func pushLimitIntoTable(ctx *sql.Context, limit *plan.Limit, child sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	if limit.CalcFoundRows {
+		return child, transform.SameTree, nil
+	}
+	rowCount, ok := literalRowCount(ctx, limit.Limit)
+	if !ok {
+		return child, transform.SameTree, nil
+	}
+
+	var push func(n sql.Node, rowCount uint64) (sql.Node, transform.TreeIdentity, error)
+	push = func(n sql.Node, rowCount uint64) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.Project, *plan.TableAlias, *plan.Offset:
+			if offset, ok := n.(*plan.Offset); ok {
+				skipped, ok := literalRowCount(ctx, offset.Offset)
+				if !ok {
+					return n, transform.SameTree, nil
+				}
+				rowCount += skipped
+			}
+			newChild, same, err := push(n.Children()[0], rowCount)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			ret, err := n.WithChildren(ctx, newChild)
+			return ret, transform.NewTree, err
+		case *plan.ResolvedTable:
+			lt, ok := n.UnderlyingTable().(sql.LimitedTable)
+			if !ok {
+				return n, transform.SameTree, nil
+			}
+			if current, ok := lt.Limit(); ok && current <= rowCount {
+				return n, transform.SameTree, nil
+			}
+			ret, err := n.WithTable(ctx, lt.WithLimit(ctx, rowCount))
+			return ret, transform.NewTree, err
+		default:
+			return n, transform.SameTree, nil
+		}
+	}
+	return push(child, rowCount)
+}
+
+// literalRowCount returns the row count given by |e|, and whether it's a literal non-negative integer.
+func literalRowCount(ctx *sql.Context, e sql.Expression) (uint64, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok || !types.IsInteger(lit.Type(ctx)) {
+		return 0, false
+	}
+	v, _, err := types.Int64.Convert(ctx, lit.Value())
+	if err != nil || v == nil || v.(int64) < 0 {
+		return 0, false
+	}
+	return uint64(v.(int64)), true
+}
+
 // pushdownFiltersUnderSubqueryAlias takes |filters| applying to the subquery
 // alias a moves them under the subquery alias. Because the subquery alias is
 // Opaque, it behaves a little bit like a FilteredTable, and pushing the
@@ -64,6 +64,10 @@ func replaceIdxOrderByDistanceHelper(ctx *sql.Context, scope *plan.Scope, node s
 		if !isDistance {
 			return n, transform.SameTree, nil
 		}
+		// Vector indexes return the nearest rows first
+		if sortNode.GetSortConditions()[0].Order != sql.Ascending {
+			return n, transform.SameTree, nil
+		}
 
 		// We currently require that the query vector to the distance function is a constant value that does not
 		// depend on the row. Right now that can be a Literal or a UserVar.
@@ -411,10 +411,17 @@ func replaceAgg(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.Scope,
 
 		// replace all aggs in proj.Projections with GetField
 		name := gb.SelectDeps[0].String()
+		var aggId sql.ColumnId
+		if idExpr, ok := gb.SelectDeps[0].(sql.IdExpression); ok {
+			aggId = idExpr.Id()
+		}
 		newProjs, _, err := transform.Exprs(ctx, proj.Projections, func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 			if strings.EqualFold(e.String(), name) {
 				return sortBy.Expr, transform.NewTree, nil
 			}
+			if gf, ok := e.(*expression.GetField); ok && aggId > 0 && gf.Id() == aggId {
+				return expression.NewAlias(ctx, gf.Name(), sortBy.Expr).WithId(aggId), transform.NewTree, nil
+			}
 			return e, transform.SameTree, nil
 		})
 		if err != nil {
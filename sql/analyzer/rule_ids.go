@@ -32,6 +32,7 @@ const (
 	flattenTableAliasesId           // flattenTableAliases
 	pushdownSubqueryAliasFiltersId  // pushdownSubqueryAliasFilters
 	replaceSubqueriesId             // replaceSubqueries
+	mergeDerivedTablesId            // mergeDerivedTables
 	validateCheckConstraintId       // validateCheckConstraints
 	replaceCountStarId              // replaceCountStar
 	replaceCrossJoinsId             // replaceCrossJoins
@@ -51,6 +52,7 @@ const (
 	stripTableNameInDefaultsId   // stripTableNamesFromColumnDefaults
 	optimizeJoinsId              // optimizeJoins
 	pushFiltersId                // pushFilters
+	prunePartitionsId            // prunePartitions
 	applyIndexesFromOuterScopeId // applyIndexesFromOuterScope
 	pruneTablesId                // pruneTables
 	assignExecIndexesId          // assignExecIndexes
@@ -58,10 +60,12 @@ const (
 	eraseProjectionId            // eraseProjection
 	flattenDistinctId            // flattenDistinct
 	replaceAggId                 // replaceAgg
+	replaceLooseIndexScanId      // replaceLooseIndexScan
 	replaceIdxSortId             // replaceIdxSort
 	insertTopNId                 // insertTopNNodes
 	replaceIdxOrderByDistanceId  // replaceIdxOrderByDistance
 	applyHashInId                // applyHashIn
+	applyRuntimeFiltersId        // applyRuntimeFilters
 	resolveInsertRowsId          // resolveInsertRows
 	applyTriggersId              // applyTriggers
 	applyProceduresId            // applyProcedures
@@ -31,60 +31,64 @@ func _() {
 	_ = x[flattenTableAliasesId-20]
 	_ = x[pushdownSubqueryAliasFiltersId-21]
 	_ = x[replaceSubqueriesId-22]
-	_ = x[validateCheckConstraintId-23]
-	_ = x[replaceCountStarId-24]
-	_ = x[replaceCrossJoinsId-25]
-	_ = x[simplifyFiltersId-26]
-	_ = x[pushNotFiltersId-27]
-	_ = x[validateNoHiddenSystemColumnsId-28]
-	_ = x[hoistOutOfScopeFiltersId-29]
-	_ = x[unnestInSubqueriesId-30]
-	_ = x[unnestExistsSubqueriesId-31]
-	_ = x[finalizeSubqueriesId-32]
-	_ = x[finalizeUnionsId-33]
-	_ = x[loadTriggersId-34]
-	_ = x[processTruncateId-35]
-	_ = x[ResolveAlterColumnId-36]
-	_ = x[stripTableNameInDefaultsId-37]
-	_ = x[optimizeJoinsId-38]
-	_ = x[pushFiltersId-39]
-	_ = x[applyIndexesFromOuterScopeId-40]
-	_ = x[pruneTablesId-41]
-	_ = x[assignExecIndexesId-42]
-	_ = x[inlineSubqueryAliasRefsId-43]
-	_ = x[eraseProjectionId-44]
-	_ = x[flattenDistinctId-45]
-	_ = x[replaceAggId-46]
-	_ = x[replaceIdxSortId-47]
-	_ = x[insertTopNId-48]
-	_ = x[replaceIdxOrderByDistanceId-49]
-	_ = x[applyHashInId-50]
-	_ = x[resolveInsertRowsId-51]
-	_ = x[applyTriggersId-52]
-	_ = x[applyProceduresId-53]
-	_ = x[assignRoutinesId-54]
-	_ = x[modifyUpdateExprsForJoinId-55]
-	_ = x[applyForeignKeysId-56]
-	_ = x[interpreterId-57]
-	_ = x[validateResolvedId-58]
-	_ = x[validateOrderById-59]
-	_ = x[validateSchemaSourceId-60]
-	_ = x[validateIndexCreationId-61]
-	_ = x[ValidateOperandsId-62]
-	_ = x[validateIntervalUsageId-63]
-	_ = x[validateSubqueryColumnsId-64]
-	_ = x[validateUnionSchemasMatchId-65]
-	_ = x[validateAggregationsId-66]
-	_ = x[validateDeleteFromId-67]
-	_ = x[cacheSubqueryAliasesInJoinsId-68]
-	_ = x[QuoteDefaultColumnValueNamesId-69]
-	_ = x[TrackProcessId-70]
-	_ = x[engineOverridesId-71]
+	_ = x[mergeDerivedTablesId-23]
+	_ = x[validateCheckConstraintId-24]
+	_ = x[replaceCountStarId-25]
+	_ = x[replaceCrossJoinsId-26]
+	_ = x[simplifyFiltersId-27]
+	_ = x[pushNotFiltersId-28]
+	_ = x[validateNoHiddenSystemColumnsId-29]
+	_ = x[hoistOutOfScopeFiltersId-30]
+	_ = x[unnestInSubqueriesId-31]
+	_ = x[unnestExistsSubqueriesId-32]
+	_ = x[finalizeSubqueriesId-33]
+	_ = x[finalizeUnionsId-34]
+	_ = x[loadTriggersId-35]
+	_ = x[processTruncateId-36]
+	_ = x[ResolveAlterColumnId-37]
+	_ = x[stripTableNameInDefaultsId-38]
+	_ = x[optimizeJoinsId-39]
+	_ = x[pushFiltersId-40]
+	_ = x[prunePartitionsId-41]
+	_ = x[applyIndexesFromOuterScopeId-42]
+	_ = x[pruneTablesId-43]
+	_ = x[assignExecIndexesId-44]
+	_ = x[inlineSubqueryAliasRefsId-45]
+	_ = x[eraseProjectionId-46]
+	_ = x[flattenDistinctId-47]
+	_ = x[replaceAggId-48]
+	_ = x[replaceLooseIndexScanId-49]
+	_ = x[replaceIdxSortId-50]
+	_ = x[insertTopNId-51]
+	_ = x[replaceIdxOrderByDistanceId-52]
+	_ = x[applyHashInId-53]
+	_ = x[applyRuntimeFiltersId-54]
+	_ = x[resolveInsertRowsId-55]
+	_ = x[applyTriggersId-56]
+	_ = x[applyProceduresId-57]
+	_ = x[assignRoutinesId-58]
+	_ = x[modifyUpdateExprsForJoinId-59]
+	_ = x[applyForeignKeysId-60]
+	_ = x[interpreterId-61]
+	_ = x[validateResolvedId-62]
+	_ = x[validateOrderById-63]
+	_ = x[validateSchemaSourceId-64]
+	_ = x[validateIndexCreationId-65]
+	_ = x[ValidateOperandsId-66]
+	_ = x[validateIntervalUsageId-67]
+	_ = x[validateSubqueryColumnsId-68]
+	_ = x[validateUnionSchemasMatchId-69]
+	_ = x[validateAggregationsId-70]
+	_ = x[validateDeleteFromId-71]
+	_ = x[cacheSubqueryAliasesInJoinsId-72]
+	_ = x[QuoteDefaultColumnValueNamesId-73]
+	_ = x[TrackProcessId-74]
+	_ = x[engineOverridesId-75]
 }
 
-const _RuleId_name = "applyDefaultSelectLimitvalidateOffsetAndLimitvalidateStarExpressionsvalidateCreateTablevalidateAlterTablevalidateExprSemloadStoredProceduresvalidateDropTablesresolveDropConstraintvalidateDropConstraintresolveCreateSelectresolveSubqueriesresolveUnionsvalidateColumnDefaultsvalidateCreateTriggervalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateDatabaseSetvalidatePrivilegesvalidateGroupByflattenTableAliasespushdownSubqueryAliasFiltersreplaceSubqueriesvalidateCheckConstraintsreplaceCountStarreplaceCrossJoinssimplifyFilterspushNotFiltersvalidateNoHiddenSystemColumnshoistOutOfScopeFiltersunnestInSubqueriesunnestExistsSubqueriesfinalizeSubqueriesfinalizeUnionsloadTriggersprocessTruncateResolveAlterColumnstripTableNamesFromColumnDefaultsoptimizeJoinspushFiltersapplyIndexesFromOuterScopepruneTablesassignExecIndexesinlineSubqueryAliasRefseraseProjectionflattenDistinctreplaceAggreplaceIdxSortinsertTopNNodesreplaceIdxOrderByDistanceapplyHashInresolveInsertRowsapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyForeignKeysinterpretervalidateResolvedvalidateOrderByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateIntervalUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryAliasesInJoinsquoteDefaultColumnValueNamestrackProcessengineOverrides"
+const _RuleId_name = "applyDefaultSelectLimitvalidateOffsetAndLimitvalidateStarExpressionsvalidateCreateTablevalidateAlterTablevalidateExprSemloadStoredProceduresvalidateDropTablesresolveDropConstraintvalidateDropConstraintresolveCreateSelectresolveSubqueriesresolveUnionsvalidateColumnDefaultsvalidateCreateTriggervalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateDatabaseSetvalidatePrivilegesvalidateGroupByflattenTableAliasespushdownSubqueryAliasFiltersreplaceSubqueriesmergeDerivedTablesvalidateCheckConstraintsreplaceCountStarreplaceCrossJoinssimplifyFilterspushNotFiltersvalidateNoHiddenSystemColumnshoistOutOfScopeFiltersunnestInSubqueriesunnestExistsSubqueriesfinalizeSubqueriesfinalizeUnionsloadTriggersprocessTruncateResolveAlterColumnstripTableNamesFromColumnDefaultsoptimizeJoinspushFiltersprunePartitionsapplyIndexesFromOuterScopepruneTablesassignExecIndexesinlineSubqueryAliasRefseraseProjectionflattenDistinctreplaceAggreplaceLooseIndexScanreplaceIdxSortinsertTopNNodesreplaceIdxOrderByDistanceapplyHashInapplyRuntimeFiltersresolveInsertRowsapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyForeignKeysinterpretervalidateResolvedvalidateOrderByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateIntervalUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryAliasesInJoinsquoteDefaultColumnValueNamestrackProcessengineOverrides"
 
-var _RuleId_index = [...]uint16{0, 23, 45, 68, 87, 105, 120, 140, 158, 179, 201, 220, 237, 250, 272, 293, 317, 344, 363, 381, 396, 415, 443, 460, 484, 500, 517, 532, 546, 575, 597, 615, 637, 655, 669, 681, 696, 714, 747, 760, 771, 797, 808, 825, 848, 863, 878, 888, 902, 917, 942, 953, 970, 983, 998, 1012, 1036, 1052, 1063, 1079, 1094, 1114, 1135, 1151, 1172, 1195, 1220, 1240, 1258, 1285, 1313, 1325, 1340}
+var _RuleId_index = [...]uint16{0, 23, 45, 68, 87, 105, 120, 140, 158, 179, 201, 220, 237, 250, 272, 293, 317, 344, 363, 381, 396, 415, 443, 460, 478, 502, 518, 535, 550, 564, 593, 615, 633, 655, 673, 687, 699, 714, 732, 765, 778, 789, 804, 830, 841, 858, 881, 896, 911, 921, 942, 956, 971, 996, 1007, 1026, 1043, 1056, 1071, 1085, 1109, 1125, 1136, 1152, 1167, 1187, 1208, 1224, 1245, 1268, 1293, 1313, 1331, 1358, 1386, 1398, 1413}
 
 func (i RuleId) String() string {
 	if i < 0 || i >= RuleId(len(_RuleId_index)-1) {
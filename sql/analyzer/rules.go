@@ -67,6 +67,7 @@ var DefaultRules = []Rule{
 	{Id: hoistOutOfScopeFiltersId, Apply: hoistOutOfScopeFilters},
 	{Id: validateStarExpressionsId, Apply: validateStarExpressions}, //TODO
 	{Id: replaceSubqueriesId, Apply: replaceSubqueries},
+	{Id: mergeDerivedTablesId, Apply: mergeDerivedTables},
 	{Id: pushdownSubqueryAliasFiltersId, Apply: pushdownSubqueryAliasFilters},
 	{Id: pruneTablesId, Apply: pruneTables},
 	{Id: validateCheckConstraintId, Apply: validateCheckConstraints},
@@ -82,16 +83,19 @@ var OnceAfterDefault = []Rule{
 	{Id: processTruncateId, Apply: processTruncate},
 	{Id: stripTableNameInDefaultsId, Apply: stripTableNamesFromColumnDefaults},
 	{Id: pushFiltersId, Apply: pushFilters},
+	{Id: prunePartitionsId, Apply: prunePartitions},
 	{Id: optimizeJoinsId, Apply: optimizeJoins},
 	{Id: finalizeSubqueriesId, Apply: finalizeSubqueries},
 	{Id: applyIndexesFromOuterScopeId, Apply: applyIndexesFromOuterScope},
 	{Id: replaceAggId, Apply: replaceAgg},
+	{Id: replaceLooseIndexScanId, Apply: replaceLooseIndexScan},
 	{Id: replaceIdxSortId, Apply: replaceIdxSort},
 	{Id: eraseProjectionId, Apply: eraseProjection},
 	{Id: flattenDistinctId, Apply: flattenDistinct},
 	{Id: insertTopNId, Apply: insertTopNNodes},
 	{Id: replaceIdxOrderByDistanceId, Apply: replaceIdxOrderByDistance},
 	{Id: applyHashInId, Apply: applyHashIn},
+	{Id: applyRuntimeFiltersId, Apply: applyRuntimeFilters},
 	{Id: assignRoutinesId, Apply: assignRoutines},
 	{Id: modifyUpdateExprsForJoinId, Apply: modifyUpdateExprsForJoin},
 	{Id: applyForeignKeysId, Apply: applyForeignKeys},
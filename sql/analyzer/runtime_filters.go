@@ -0,0 +1,153 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// applyRuntimeFilters pushes a *plan.RuntimeFilter into the probe side table of inner and semi hash joins when that
+// table is a sql.FilteredTable that accepts it. Once the build side of the join has been materialized, the filter
+// is populated with a bloom filter of its keys, and the table can discard rows that have no possible match before they
+// reach the join. The filter is only an optimization: the join condition is still evaluated on every row that passes.
+func applyRuntimeFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	if !sql.LoadOptimizerSwitch(ctx).EngineConditionPushdown() {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(ctx, n, func(ctx *sql.Context, n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		j, ok := n.(*plan.JoinNode)
+		if !ok || j.IsReversed {
+			return n, transform.SameTree, nil
+		}
+		// outer and anti joins return probe rows without a match, so they can't be filtered out
+		if j.Op != plan.JoinTypeHash && j.Op != plan.JoinTypeSemiHash {
+			return n, transform.SameTree, nil
+		}
+		hl, ok := j.Right().(*plan.HashLookup)
+		if !ok || hl.RuntimeFilter != nil {
+			return n, transform.SameTree, nil
+		}
+
+		newHl := *hl
+		left, ok, err := pushRuntimeFilter(ctx, j.Left(), "", &newHl)
+		if err != nil || !ok {
+			return n, transform.SameTree, err
+		}
+		ret, err := j.WithChildren(ctx, left, &newHl)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return ret, transform.NewTree, nil
+	})
+}
+
+// pushRuntimeFilter pushes a runtime filter for the probe key of |hl| into the table beneath |n|, which must be a
+// single table optionally wrapped in filters and an alias. On success, |hl| is updated to reference the new filter.
+// |alias| is the name the table's columns are referred to by in the probe key.
+func pushRuntimeFilter(ctx *sql.Context, n sql.Node, alias string, hl *plan.HashLookup) (sql.Node, bool, error) {
+	switch n := n.(type) {
+	case *plan.Filter:
+		child, ok, err := pushRuntimeFilter(ctx, n.Child, alias, hl)
+		if err != nil || !ok {
+			return n, false, err
+		}
+		ret, err := n.WithChildren(ctx, child)
+		return ret, err == nil, err
+	case *plan.TableAlias:
+		child, ok, err := pushRuntimeFilter(ctx, n.Child, n.Name(), hl)
+		if err != nil || !ok {
+			return n, false, err
+		}
+		ret, err := n.WithChildren(ctx, child)
+		return ret, err == nil, err
+	case *plan.ResolvedTable:
+		ft, ok := n.UnderlyingTable().(sql.FilteredTable)
+		if !ok {
+			return n, false, nil
+		}
+		if alias == "" {
+			alias = n.Name()
+		}
+		key, ok := runtimeFilterKey(ctx, hl.LeftProbeKey, n.Schema(ctx), runtimeFilterSchema(ctx, ft), alias, ft.Name())
+		if !ok {
+			return n, false, nil
+		}
+		rf := plan.NewRuntimeFilter(key, hl)
+		if len(ft.HandledFilters(ctx, []sql.Expression{rf})) != 1 {
+			return n, false, nil
+		}
+		filters := append(append([]sql.Expression{}, ft.Filters()...), rf)
+		ret, err := n.WithTable(ctx, ft.WithFilters(ctx, filters))
+		if err != nil {
+			return n, false, err
+		}
+		hl.RuntimeFilter = rf
+		return ret, true, nil
+	default:
+		return n, false, nil
+	}
+}
+
+// runtimeFilterSchema returns the schema that filters pushed into |ft| are evaluated against, which is the table's
+// full schema rather than any projection of it.
+func runtimeFilterSchema(ctx *sql.Context, ft sql.FilteredTable) sql.Schema {
+	if pkt, ok := ft.(sql.PrimaryKeyTable); ok {
+		return pkt.PrimaryKeySchema(ctx).Schema
+	}
+	return ft.Schema(ctx)
+}
+
+// runtimeFilterKey rewrites |probeKey| so that its fields index into |tableSch|. Every field must be a column of the
+// table, named by |alias| and found in the node schema |nodeSch| at a consistent offset from its execution index.
+// Virtual columns and expressions that must not be evaluated more than once disqualify the key.
+func runtimeFilterKey(ctx *sql.Context, probeKey sql.Expression, nodeSch, tableSch sql.Schema, alias, tableName string) (sql.Expression, bool) {
+	offset := -1
+	valid := true
+	key, _, err := transform.Expr(ctx, probeKey, func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		switch e := e.(type) {
+		case *expression.GetField:
+			if !strings.EqualFold(e.Table(), alias) {
+				valid = false
+				return e, transform.SameTree, nil
+			}
+			nodeIdx := nodeSch.IndexOfColName(e.Name())
+			tableIdx := tableSch.IndexOfColName(e.Name())
+			// virtual columns are not yet computed when the table evaluates its filters
+			if nodeIdx < 0 || tableIdx < 0 || tableSch[tableIdx].Virtual || (offset >= 0 && e.Index()-nodeIdx != offset) {
+				valid = false
+				return e, transform.SameTree, nil
+			}
+			offset = e.Index() - nodeIdx
+			return e.WithTable(tableName).WithIndex(tableIdx), transform.NewTree, nil
+		case *plan.Subquery:
+			valid = false
+		case sql.NonDeterministicExpression:
+			if e.IsNonDeterministic() {
+				valid = false
+			}
+		}
+		return e, transform.SameTree, nil
+	})
+	if err != nil || !valid || offset < 0 {
+		return nil, false
+	}
+	return key, true
+}
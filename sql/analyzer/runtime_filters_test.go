@@ -0,0 +1,106 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestApplyRuntimeFilters(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	xy := memory.NewFilteredTable(ctx, db, "xy", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "x", Type: types.Int64, Source: "xy"},
+		{Name: "y", Type: types.Int64, Source: "xy"},
+	}, 0), nil)
+	ab := memory.NewTable(ctx, db, "ab", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64, Source: "ab"},
+		{Name: "b", Type: types.Int64, Source: "ab"},
+	}, 0), nil)
+	db.AddTable("xy", xy)
+	db.AddTable("ab", ab)
+
+	newJoin := func(op plan.JoinType) (*plan.JoinNode, *plan.HashLookup) {
+		leftKey := expression.NewGetFieldWithTable(1, 1, types.Int64, "mydb", "xy", "y", false)
+		rightKey := expression.NewGetFieldWithTable(2, 2, types.Int64, "mydb", "ab", "a", false)
+		hl := plan.NewHashLookup(ctx, plan.NewCachedResults(plan.NewResolvedTable(ab, db, nil)), rightKey, leftKey, op)
+		return plan.NewJoin(ctx, plan.NewResolvedTable(xy, db, nil), hl, op, expression.NewEquals(leftKey, rightKey)), hl
+	}
+
+	t.Run("inner hash join", func(t *testing.T) {
+		j, _ := newJoin(plan.JoinTypeHash)
+		res, _, err := applyRuntimeFilters(ctx, NewDefault(pro), j, nil, DefaultRuleSelector, nil)
+		require.NoError(t, err)
+
+		j = res.(*plan.JoinNode)
+		hl := j.Right().(*plan.HashLookup)
+		require.NotNil(t, hl.RuntimeFilter)
+		filters := j.Left().(*plan.ResolvedTable).UnderlyingTable().(sql.FilteredTable).Filters()
+		require.Len(t, filters, 1)
+		rf := filters[0].(*plan.RuntimeFilter)
+		require.Equal(t, "RUNTIME_FILTER(xy.y)", rf.String())
+		require.Equal(t, 1, rf.Key.(*expression.GetField).Index())
+
+		// every row passes until the filter is built
+		pass, err := rf.Eval(ctx, sql.Row{int64(1), int64(100)})
+		require.NoError(t, err)
+		require.Equal(t, true, pass)
+
+		lookup := make(map[interface{}][]sql.Row)
+		for _, r := range []sql.Row{{int64(0), int64(0)}, {int64(0), int64(0)}, {int64(5), int64(0)}} {
+			key, _, err := hl.GetHashKey(ctx, hl.RightEntryKey, append(sql.Row{int64(0), int64(0)}, r...))
+			require.NoError(t, err)
+			lookup[key] = append(lookup[key], r)
+		}
+		hl.RuntimeFilter.Build(lookup)
+		require.True(t, rf.Built())
+
+		pass, err = rf.Eval(ctx, sql.Row{int64(1), int64(5)})
+		require.NoError(t, err)
+		require.Equal(t, true, pass)
+		pass, err = rf.Eval(ctx, sql.Row{int64(1), int64(100)})
+		require.NoError(t, err)
+		require.Equal(t, false, pass)
+
+		hl.Dispose(ctx)
+		require.False(t, rf.Built())
+	})
+
+	t.Run("left outer hash join is not filtered", func(t *testing.T) {
+		j, _ := newJoin(plan.JoinTypeLeftOuterHash)
+		res, _, err := applyRuntimeFilters(ctx, NewDefault(pro), j, nil, DefaultRuleSelector, nil)
+		require.NoError(t, err)
+		require.Nil(t, res.(*plan.JoinNode).Right().(*plan.HashLookup).RuntimeFilter)
+	})
+
+	t.Run("disabled by optimizer switch", func(t *testing.T) {
+		require.NoError(t, ctx.SetSessionVariable(ctx, sql.OptimizerSwitchSessionVar, "engine_condition_pushdown=off"))
+		defer ctx.SetSessionVariable(ctx, sql.OptimizerSwitchSessionVar, sql.DefaultOptimizerSwitch)
+		j, _ := newJoin(plan.JoinTypeHash)
+		res, _, err := applyRuntimeFilters(ctx, NewDefault(pro), j, nil, DefaultRuleSelector, nil)
+		require.NoError(t, err)
+		require.Nil(t, res.(*plan.JoinNode).Right().(*plan.HashLookup).RuntimeFilter)
+	})
+}
@@ -89,7 +89,7 @@ func pruneTables(ctx *sql.Context, a *Analyzer, n sql.Node, s *plan.Scope, sel R
 		case *plan.ResolvedTable:
 			return pruneTableCols(ctx, n, parentCols, parentStars, unqualifiedStar)
 		case *plan.JoinNode:
-			if n.JoinType().IsPhysical() || n.JoinType().IsUsing() || n.JoinType().IsSemi() {
+			if n.JoinType().IsPhysical() || n.JoinType().IsUsing() {
 				return n, transform.SameTree, nil
 			}
 			// we cannot push projections past lateral joins as columns not in the projection,
@@ -103,18 +103,32 @@ func pruneTables(ctx *sql.Context, a *Analyzer, n sql.Node, s *plan.Scope, sel R
 				push(outerCols, outerStars, outerUnq)
 				push(aliasCols, aliasStars, false)
 			}
+			// the arguments of a table function may refer to the columns of the left subtree
+			if ta, ok := n.Right().(*plan.TableAlias); ok {
+				if tf, ok := ta.Child.(sql.TableFunction); ok {
+					push(gatherOuterCols(ctx, tf))
+				}
+			}
+		case *plan.SetOp:
+			// each side of a set operation must project every column it returns,
+			// otherwise a side's table would be pruned of positional columns
+			if !isProjectedSetOpBranch(n.Left()) || !isProjectedSetOpBranch(n.Right()) {
+				return n, transform.SameTree, nil
+			}
 		case *plan.Filter, *plan.Distinct, *plan.GroupBy, *plan.Project, *plan.TableAlias,
 			*plan.Window, *plan.Sort, *plan.Limit, *plan.RecursiveCte,
-			*plan.RecursiveTable, *plan.TopN, *plan.Offset:
+			*plan.RecursiveTable, *plan.TopN, *plan.Offset, *plan.Having:
 		default:
 			return n, transform.SameTree, nil
 		}
-		if sq := findSubqueryExpr(ctx, n); sq != nil {
-			return n, transform.SameTree, nil
-		}
 
 		beforeUnq := unqualifiedStar
 
+		// subquery expressions can reference any column of this node's
+		// children, so their column references are dependencies as well
+		subqueryCols, subqueryStars, subqueryUnq := gatherSubqueryCols(ctx, n)
+		push(subqueryCols, subqueryStars, subqueryUnq)
+
 		//todo(max): outer and alias cols can have duplicates, as long as the pop
 		// is equal and opposite we are usually fine. In the cases we aren't, we
 		// already do not handle nested aliasing well.
@@ -141,6 +155,7 @@ func pruneTables(ctx *sql.Context, a *Analyzer, n sql.Node, s *plan.Scope, sel R
 
 		pop(outerCols, outerStars, beforeUnq)
 		pop(aliasCols, aliasStars, beforeUnq)
+		pop(subqueryCols, subqueryStars, beforeUnq)
 
 		if len(newChildren) == 0 {
 			return n, transform.SameTree, nil
@@ -152,27 +167,54 @@ func pruneTables(ctx *sql.Context, a *Analyzer, n sql.Node, s *plan.Scope, sel R
 	return pruneWalk(ctx, n)
 }
 
-// findSubqueryExpr searches for a *plan.Subquery in a single node,
-// returning the subquery or nil
-func findSubqueryExpr(ctx *sql.Context, n sql.Node) *plan.Subquery {
-	var sq *plan.Subquery
+// gatherSubqueryCols searches the subquery expressions of a single
+// node for column references and stars, including references made
+// by nested subqueries and derived tables.
+func gatherSubqueryCols(ctx *sql.Context, n sql.Node) ([]tableCol, []string, bool) {
 	ne, ok := n.(sql.Expressioner)
 	if !ok {
-		return nil
+		return nil, nil, false
 	}
+
+	var cols []tableCol
+	var nodeStars []string
+	var nodeUnqualifiedStar bool
 	for _, e := range ne.Expressions() {
-		found := transform.InspectExpr(ctx, e, func(ctx *sql.Context, e sql.Expression) bool {
-			if e, ok := e.(*plan.Subquery); ok {
-				sq = e
-				return true
+		transform.InspectExpr(ctx, e, func(ctx *sql.Context, e sql.Expression) bool {
+			sq, ok := e.(*plan.Subquery)
+			if !ok {
+				return false
 			}
+			transform.InspectWithOpaque(ctx, sq.Query, func(ctx *sql.Context, n sql.Node) bool {
+				nodeCols, stars, unq := gatherOuterCols(ctx, n)
+				cols = append(cols, nodeCols...)
+				nodeStars = append(nodeStars, stars...)
+				nodeUnqualifiedStar = nodeUnqualifiedStar || unq
+				subCols, subStars, subUnq := gatherSubqueryCols(ctx, n)
+				cols = append(cols, subCols...)
+				nodeStars = append(nodeStars, subStars...)
+				nodeUnqualifiedStar = nodeUnqualifiedStar || subUnq
+				return true
+			})
 			return false
 		})
-		if found {
-			return sq
-		}
 	}
-	return nil
+	return cols, nodeStars, nodeUnqualifiedStar
+}
+
+// isProjectedSetOpBranch returns whether |n|, a child of a set
+// operation, projects its columns from the tables beneath it.
+func isProjectedSetOpBranch(n sql.Node) bool {
+	switch n := n.(type) {
+	case *plan.Project, *plan.GroupBy, *plan.Window:
+		return true
+	case *plan.SetOp:
+		return isProjectedSetOpBranch(n.Left()) && isProjectedSetOpBranch(n.Right())
+	case *plan.Distinct, *plan.Sort, *plan.Limit, *plan.Offset, *plan.TopN, *plan.Having, *plan.Filter:
+		return isProjectedSetOpBranch(n.Children()[0])
+	default:
+		return false
+	}
 }
 
 // hasMatchAgainstExpr searches for an *expression.MatchAgainst within the node's expressions
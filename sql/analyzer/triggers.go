@@ -96,6 +96,12 @@ func validateCreateTrigger(ctx *sql.Context, a *Analyzer, node sql.Node, scope *
 		return nil, transform.SameTree, err
 	}
 
+	if ct.TriggerOrder != nil {
+		if err = validateTriggerOrder(ctx, a, ct); err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+
 	trigTable := getResolvedTable(ctx, ct.Table)
 	sch := trigTable.Schema(ctx)
 	colsList := make(map[string]struct{})
@@ -122,6 +128,28 @@ func validateCreateTrigger(ctx *sql.Context, a *Analyzer, node sql.Node, scope *
 	return node, transform.NewTree, nil
 }
 
+// validateTriggerOrder returns an error if the trigger that |ct| FOLLOWS or PRECEDES doesn't exist on the same table
+// with the same timing and event.
+func validateTriggerOrder(ctx *sql.Context, a *Analyzer, ct *plan.CreateTrigger) error {
+	triggers, err := loadTriggersFromDb(ctx, a, ct.Database(), true)
+	if err != nil {
+		return err
+	}
+	tableName := getResolvedTable(ctx, ct.Table).Name()
+	for _, trigger := range triggers {
+		if !strings.EqualFold(trigger.TriggerName, ct.TriggerOrder.OtherTriggerName) {
+			continue
+		}
+		nameable, ok := trigger.Table.(sql.Nameable)
+		if ok && strings.EqualFold(nameable.Name(), tableName) &&
+			strings.EqualFold(trigger.TriggerTime, ct.TriggerTime) &&
+			strings.EqualFold(trigger.TriggerEvent, ct.TriggerEvent) {
+			return nil
+		}
+	}
+	return sql.ErrReferencedTriggerDoesNotExist.New(ct.TriggerOrder.OtherTriggerName)
+}
+
 func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
 	if !qFlags.DmlIsSet() {
 		return n, transform.SameTree, nil
@@ -242,7 +270,7 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 				b.TriggerCtx().Call = true
 				// TODO: We only need to parse the body here since the other info from the create trigger statement have
 				// already been parsed.
-				parsedTrigger, _, _, _, err = b.Parse(trigger.CreateStatement, nil, false)
+				parsedTrigger, _, _, _, err = bindTriggerAsDefiner(b, trigger)
 				b.Reset()
 				if err != nil {
 					return nil, transform.SameTree, err
@@ -578,3 +606,19 @@ func orderTriggersAndReverseAfter(triggers []*plan.CreateTrigger) []*plan.Create
 func triggerEventsMatch(event plan.TriggerEvent, event2 string) bool {
 	return strings.ToLower((string)(event)) == strings.ToLower(event2)
 }
+
+// bindTriggerAsDefiner binds the statement that created |trigger| with the privileges of its definer, which the
+// trigger's body runs with. Triggers stored without a definer are bound without authorization.
+func bindTriggerAsDefiner(b *planbuilder.Builder, trigger sql.TriggerDefinition) (sql.Node, string, string, *sql.QueryFlags, error) {
+	if trigger.Definer == "" {
+		return b.Parse(trigger.CreateStatement, nil, false)
+	}
+	b.EnableAuth()
+	defer b.DisableAuth()
+	restorePrivileges, err := b.ImpersonateDefiner(trigger.Definer)
+	if err != nil {
+		return nil, "", "", nil, err
+	}
+	defer restorePrivileges()
+	return b.Parse(trigger.CreateStatement, nil, false)
+}
@@ -372,7 +372,7 @@ func UpdateKeyedColumns(keyedColumns map[string]bool, n *plan.AlterIndex) map[st
 }
 
 // ValidateRenameColumn checks that a DDL RenameColumn node can be safely executed (e.g. no collision with other
-// column names, doesn't invalidate any table check constraints).
+// column names). The check constraints that refer to the column are renamed along with it.
 //
 // Note that schema is passed in twice, because one version is the initial version before the alter column expressions
 // are applied, and the second version is the current schema that is being modified as multiple nodes are processed.
@@ -397,12 +397,7 @@ func ValidateRenameColumn(ctx *sql.Context, initialSch, sch sql.Schema, rc *plan
 		return nil, sql.ErrTableColumnNotFound.New(nameable.Name(), rc.ColumnName)
 	}
 
-	err = ValidateColumnNotUsedInCheckConstraint(ctx, rc.ColumnName, rc.Checks())
-	if err != nil {
-		return nil, err
-	}
-
-	return renameInSchema(sch, rc.ColumnName, rc.NewColumnName, nameable.Name()), nil
+	return renameInSchema(ctx, sch, rc.ColumnName, rc.NewColumnName, nameable.Name())
 }
 
 // ValidateAddColumn validates that the column specified in |ac| can be added to the specified
@@ -423,24 +418,22 @@ func ValidateAddColumn(ctx *sql.Context, schema sql.Schema, ac *plan.AddColumn)
 	}
 
 	// Make sure columns named in After clause exist
-	idx := -1
+	idx := len(schema)
 	if ac.Order(ctx) != nil && ac.Order(ctx).AfterColumn != "" {
 		afterColumn := ac.Order(ctx).AfterColumn
 		idx = schema.IndexOf(afterColumn, nameable.Name())
 		if idx < 0 {
 			return nil, sql.ErrTableColumnNotFound.New(nameable.Name(), afterColumn)
 		}
+		idx++
+	} else if ac.Order(ctx) != nil && ac.Order(ctx).First {
+		idx = 0
 	}
 
 	newSch := make(sql.Schema, 0, len(schema)+1)
-	if idx >= 0 {
-		newSch = append(newSch, schema[:idx+1]...)
-		newSch = append(newSch, ac.Column().Copy())
-		newSch = append(newSch, schema[idx+1:]...)
-	} else { // new column at end
-		newSch = append(newSch, schema...)
-		newSch = append(newSch, ac.Column().Copy())
-	}
+	newSch = append(newSch, schema[:idx]...)
+	newSch = append(newSch, ac.Column().Copy())
+	newSch = append(newSch, schema[idx:]...)
 
 	return newSch, nil
 }
@@ -475,10 +468,16 @@ func ValidateModifyColumn(ctx *sql.Context, initialSch sql.Schema, schema sql.Sc
 		return nil, err
 	}
 
-	newSch := replaceInSchema(schema, newCol, tableName)
+	newSch, err := replaceInSchema(ctx, schema, oldColName, newCol, mc.Order(ctx), tableName)
+	if err != nil {
+		return nil, err
+	}
 	if err := validateAutoIncrementModify(newSch, keyedColumns); err != nil {
 		return nil, err
 	}
+	if err := validateSpatialIndexSRIDModify(ctx, table, initialSch, oldColName, newCol); err != nil {
+		return nil, err
+	}
 
 	// TODO: When a column is being modified, we should ideally check that any existing table check constraints
 	//       are still valid (e.g. if the column type changed) and throw an error if they are invalidated.
@@ -532,6 +531,45 @@ func ValidateModifyColumn(ctx *sql.Context, initialSch sql.Schema, schema sql.Sc
 	return newSch, nil
 }
 
+// validateSpatialIndexSRIDModify returns an error if the modification of the column |oldColName| into |newCol| changes
+// the SRID attribute of a column with a spatial index, since the index only holds geometries of the column's SRID.
+func validateSpatialIndexSRIDModify(ctx *sql.Context, table sql.Node, initialSch sql.Schema, oldColName string, newCol *sql.Column) error {
+	idx := initialSch.IndexOfColName(oldColName)
+	if idx < 0 {
+		return nil
+	}
+	oldSpatial, ok := initialSch[idx].Type.(sql.SpatialColumnType)
+	if !ok {
+		return nil
+	}
+	oldSRID, oldHasSRID := oldSpatial.GetSpatialTypeSRID()
+	var newSRID uint32
+	var newHasSRID bool
+	if newSpatial, ok := newCol.Type.(sql.SpatialColumnType); ok {
+		newSRID, newHasSRID = newSpatial.GetSpatialTypeSRID()
+	}
+	if oldSRID == newSRID && oldHasSRID == newHasSRID {
+		return nil
+	}
+
+	ia, err := newIndexAnalyzerForNode(ctx, table)
+	if err != nil {
+		return err
+	}
+	tbl := getTable(ctx, table)
+	for _, index := range ia.IndexesByTable(ctx, ctx.GetCurrentDatabase(), getTableName(ctx, table)) {
+		if !index.IsSpatial() {
+			continue
+		}
+		for _, expr := range index.Expressions() {
+			if col := plan.GetColumnFromIndexExpr(ctx, expr, tbl); col != nil && strings.EqualFold(col.Name, oldColName) {
+				return sql.ErrSpatialIdxSRIDChange.New(initialSch[idx].Name)
+			}
+		}
+	}
+	return nil
+}
+
 func ValidateIdentifier(name string) error {
 	if len(name) > sql.MaxIdentifierLength {
 		return sql.ErrInvalidIdentifier.New(name)
@@ -754,9 +792,13 @@ func validatePrefixLength(ctx *sql.Context, colName string, colLen int64, colTyp
 	return nil
 }
 
-func replaceInSchema(sch sql.Schema, col *sql.Column, tableName string) sql.Schema {
-	idx := sch.IndexOf(col.Name, tableName)
-	schCopy := make(sql.Schema, len(sch))
+// replaceInSchema returns a copy of |sch| with the column named |oldColName| replaced by |col|, and moved to the
+// position given by |order| if it isn't nil. References to the column in the default and generated expressions of the
+// schema are updated if |col| renames it.
+func replaceInSchema(ctx *sql.Context, sch sql.Schema, oldColName string, col *sql.Column, order *sql.ColumnOrder, tableName string) (sql.Schema, error) {
+	idx := sch.IndexOf(oldColName, tableName)
+	schCopy := make(sql.Schema, 0, len(sch))
+	var replaced *sql.Column
 	for i := range sch {
 		if i == idx {
 			cc := *col
@@ -766,18 +808,36 @@ func replaceInSchema(sch sql.Schema, col *sql.Column, tableName string) sql.Sche
 			if cc.PrimaryKey {
 				cc.Nullable = false
 			}
-
-			schCopy[i] = &cc
-
+			replaced = &cc
 		} else {
 			cc := *sch[i]
-			schCopy[i] = &cc
+			schCopy = append(schCopy, &cc)
 		}
 	}
-	return schCopy
+	if replaced == nil {
+		return schCopy, nil
+	}
+
+	if order != nil && order.First {
+		idx = 0
+	} else if order != nil && order.AfterColumn != "" {
+		if afterIdx := schCopy.IndexOf(order.AfterColumn, tableName); afterIdx >= 0 {
+			idx = afterIdx + 1
+		}
+	}
+	schCopy = append(schCopy[:idx], append(sql.Schema{replaced}, schCopy[idx:]...)...)
+
+	if !strings.EqualFold(oldColName, col.Name) {
+		if err := renameColumnReferences(ctx, schCopy, oldColName, col.Name); err != nil {
+			return nil, err
+		}
+	}
+	return schCopy, nil
 }
 
-func renameInSchema(sch sql.Schema, oldColName, newColName, tableName string) sql.Schema {
+// renameInSchema returns a copy of |sch| with the column named |oldColName| renamed to |newColName|, along with the
+// references to it in the default and generated expressions of the schema.
+func renameInSchema(ctx *sql.Context, sch sql.Schema, oldColName, newColName, tableName string) (sql.Schema, error) {
 	idx := sch.IndexOf(oldColName, tableName)
 	schCopy := make(sql.Schema, len(sch))
 	for i := range sch {
@@ -790,7 +850,51 @@ func renameInSchema(sch sql.Schema, oldColName, newColName, tableName string) sq
 			schCopy[i] = &cc
 		}
 	}
-	return schCopy
+	if err := renameColumnReferences(ctx, schCopy, oldColName, newColName); err != nil {
+		return nil, err
+	}
+	return schCopy, nil
+}
+
+// renameColumnReferences renames the references to the column |oldColName| in the default and generated expressions
+// of the columns of |sch|, so that later clauses of the same ALTER TABLE see the schema as it will be after the rename.
+// The columns of |sch| must be copies, since they're modified in place.
+func renameColumnReferences(ctx *sql.Context, sch sql.Schema, oldColName, newColName string) error {
+	renameGetField := func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if gf, ok := e.(*expression.GetField); ok && strings.EqualFold(gf.Name(), oldColName) {
+			return gf.WithName(newColName), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	}
+	for _, col := range sch {
+		if col.Default != nil {
+			expr, same, err := transform.Expr(ctx, col.Default.Expr, renameGetField)
+			if err != nil {
+				return err
+			}
+			if !same {
+				def, err := col.Default.WithChildren(ctx, expr)
+				if err != nil {
+					return err
+				}
+				col.Default = def.(*sql.ColumnDefaultValue)
+			}
+		}
+		if col.Generated != nil {
+			expr, same, err := transform.Expr(ctx, col.Generated.Expr, renameGetField)
+			if err != nil {
+				return err
+			}
+			if !same {
+				gen, err := col.Generated.WithChildren(ctx, expr)
+				if err != nil {
+					return err
+				}
+				col.Generated = gen.(*sql.ColumnDefaultValue)
+			}
+		}
+	}
+	return nil
 }
 
 func removeInSchema(sch sql.Schema, colName, tableName string) sql.Schema {
@@ -34,7 +34,8 @@ func validateNoHiddenSystemColumns(ctx *sql.Context, _ *Analyzer, n sql.Node, _
 		switch nn := n.(type) {
 		case *plan.CreateTable:
 			for _, col := range nn.TargetSchema() {
-				if sql.IsHiddenSystemColumn(col.Name) {
+				// functional indexes defined in the table add their own hidden system columns
+				if sql.IsHiddenSystemColumn(col.Name) && !col.HiddenSystem {
 					err = fmt.Errorf("invalid column name: %s", col.Name)
 				}
 			}
@@ -882,7 +882,7 @@ func validateReadOnlyTransaction(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 	isTempTable := func(table sql.Table) bool {
 		tt, isTempTable := table.(sql.TemporaryTable)
 		if !isTempTable {
-			valid = false
+			return false
 		}
 
 		return tt.IsTemporary()
@@ -17,11 +17,74 @@ package sql
 import (
 	"context"
 	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
 	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
 )
 
 var ErrCannotAddToClosedBackgroundThreads = errors.New("cannot add to a close background threads instance")
 
+// defaultBackgroundJobRestartDelay is how long a supervised job that failed waits before it's restarted, if its
+// BackgroundJob doesn't specify a delay.
+const defaultBackgroundJobRestartDelay = time.Second
+
+// BackgroundJobState is the state of a background job.
+type BackgroundJobState string
+
+const (
+	// BackgroundJobRunning is the state of a job whose goroutine is running.
+	BackgroundJobRunning BackgroundJobState = "running"
+	// BackgroundJobRestarting is the state of a job that failed and is waiting to be restarted.
+	BackgroundJobRestarting BackgroundJobState = "restarting"
+	// BackgroundJobFinished is the state of a job that returned without an error.
+	BackgroundJobFinished BackgroundJobState = "finished"
+	// BackgroundJobFailed is the state of a job that returned an error or panicked, and won't be restarted.
+	BackgroundJobFailed BackgroundJobState = "failed"
+	// BackgroundJobStopped is the state of a job that returned after its context was cancelled.
+	BackgroundJobStopped BackgroundJobState = "stopped"
+)
+
+// BackgroundJob is a long-running task that BackgroundThreads runs in a goroutine of its own and supervises. A job
+// that panics is recovered, and the panic is treated as the job failing with an error.
+type BackgroundJob struct {
+	// Name identifies the job in its status. Adding a job with the name of another replaces its status, but doesn't
+	// stop the other job.
+	Name string
+	// Run does the work of the job. It must return when |ctx| is cancelled, otherwise Shutdown will hang.
+	Run func(ctx context.Context) error
+	// RestartOnFailure restarts the job after RestartDelay whenever Run returns an error or panics, until it has been
+	// restarted MaxRestarts times.
+	RestartOnFailure bool
+	// RestartDelay is how long to wait before restarting a failed job. It defaults to one second.
+	RestartDelay time.Duration
+	// MaxRestarts is the number of times a failed job is restarted, or 0 to restart it indefinitely.
+	MaxRestarts int
+}
+
+// BackgroundJobStatus describes the state of a background job, as reported by BackgroundThreads.Jobs.
+type BackgroundJobStatus struct {
+	Name  string
+	State BackgroundJobState
+	// Started is when the job's current or last run started.
+	Started time.Time
+	// Finished is when the job's last run returned, or zero if it's running.
+	Finished time.Time
+	// Restarts is the number of times the job has been restarted after failing.
+	Restarts int
+	// LastError is the error returned by, or the panic of, the job's last failed run.
+	LastError error
+}
+
+// BackgroundJobProvider is a Catalog that can report the background jobs of the engine it belongs to.
+type BackgroundJobProvider interface {
+	// BackgroundJobs returns the status of every background job, ordered by name.
+	BackgroundJobs() []BackgroundJobStatus
+}
+
 type BackgroundThreads struct {
 	wg           *sync.WaitGroup
 	mu           *sync.Mutex
@@ -29,6 +92,7 @@ type BackgroundThreads struct {
 	parentCancel context.CancelFunc
 	nameToCancel map[string]context.CancelFunc
 	nameToCtx    map[string]context.Context
+	nameToStatus map[string]*BackgroundJobStatus
 }
 
 func NewBackgroundThreads() *BackgroundThreads {
@@ -40,13 +104,27 @@ func NewBackgroundThreads() *BackgroundThreads {
 		mu:           &sync.Mutex{},
 		nameToCancel: make(map[string]context.CancelFunc),
 		nameToCtx:    make(map[string]context.Context),
+		nameToStatus: make(map[string]*BackgroundJobStatus),
 	}
 }
 
 // Add starts a background goroutine wrapped by a top-level sync.WaitGroup.
 // [f] must return when its [ctx] argument is cancelled, otherwise
-// Shutdown will hang.
+// Shutdown will hang. A panic in [f] is recovered and reported in the
+// thread's status.
 func (bt *BackgroundThreads) Add(name string, f func(ctx context.Context)) error {
+	return bt.AddJob(BackgroundJob{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			f(ctx)
+			return nil
+		},
+	})
+}
+
+// AddJob starts |job| in a background goroutine wrapped by a top-level sync.WaitGroup, and supervises it until it
+// finishes or Shutdown is called.
+func (bt *BackgroundThreads) AddJob(job BackgroundJob) error {
 	select {
 	case <-bt.parentCtx.Done():
 		return ErrCannotAddToClosedBackgroundThreads
@@ -61,14 +139,94 @@ func (bt *BackgroundThreads) Add(name string, f func(ctx context.Context)) error
 	// XXX: It seems like duplicate names should either be an
 	// error or a replace (with cancelation and maybe
 	// block-on-exit behavior.
-	bt.nameToCancel[name] = threadCancel
-	bt.nameToCtx[name] = threadCtx
+	bt.nameToCancel[job.Name] = threadCancel
+	bt.nameToCtx[job.Name] = threadCtx
+	status := &BackgroundJobStatus{Name: job.Name, State: BackgroundJobRunning, Started: time.Now()}
+	bt.nameToStatus[job.Name] = status
 	bt.wg.Go(func() {
-		f(threadCtx)
+		defer threadCancel()
+		bt.supervise(threadCtx, job, status)
 	})
 	return nil
 }
 
+// supervise runs |job| until it finishes, restarting it as its BackgroundJob specifies, and keeps |status| up to date.
+func (bt *BackgroundThreads) supervise(ctx context.Context, job BackgroundJob, status *BackgroundJobStatus) {
+	delay := job.RestartDelay
+	if delay <= 0 {
+		delay = defaultBackgroundJobRestartDelay
+	}
+	for {
+		panicked, err := runBackgroundJob(ctx, job)
+
+		bt.mu.Lock()
+		status.Finished = time.Now()
+		switch {
+		case ctx.Err() != nil:
+			status.State = BackgroundJobStopped
+		case err == nil:
+			status.State = BackgroundJobFinished
+		default:
+			status.LastError = err
+			status.State = BackgroundJobFailed
+			if job.RestartOnFailure && (job.MaxRestarts == 0 || status.Restarts < job.MaxRestarts) {
+				status.State = BackgroundJobRestarting
+			}
+		}
+		state := status.State
+		bt.mu.Unlock()
+
+		if err != nil && !panicked && ctx.Err() == nil {
+			logrus.WithField("job", job.Name).Errorf("background job failed: %v", err)
+		}
+		if state != BackgroundJobRestarting {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			bt.mu.Lock()
+			status.State = BackgroundJobStopped
+			bt.mu.Unlock()
+			return
+		case <-time.After(delay):
+		}
+
+		bt.mu.Lock()
+		status.Restarts++
+		status.State = BackgroundJobRunning
+		status.Started = time.Now()
+		status.Finished = time.Time{}
+		bt.mu.Unlock()
+	}
+}
+
+// runBackgroundJob runs |job| once and returns its error. If |job| panics, it returns true and an error describing the
+// panic.
+func runBackgroundJob(ctx context.Context, job BackgroundJob) (panicked bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked, err = true, fmt.Errorf("panic: %v", r)
+			logrus.WithField("job", job.Name).Errorf("background job panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return false, job.Run(ctx)
+}
+
+// Jobs returns the status of every job that has been added, ordered by name.
+func (bt *BackgroundThreads) Jobs() []BackgroundJobStatus {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	ret := make([]BackgroundJobStatus, 0, len(bt.nameToStatus))
+	for _, status := range bt.nameToStatus {
+		ret = append(ret, *status)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
 // Shutdown cancels the parent context for every async thread
 // and waits for each goroutine to drain and return before exiting.
 func (bt *BackgroundThreads) Shutdown() error {
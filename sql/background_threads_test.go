@@ -17,9 +17,11 @@ package sql_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -98,4 +100,74 @@ func TestBackgroundThreads(t *testing.T) {
 		sort.Ints(b)
 		assert.Equal(t, []int{}, b)
 	})
+
+	t.Run("panics are recovered", func(t *testing.T) {
+		bThreads = sql.NewBackgroundThreads()
+		defer bThreads.Shutdown()
+
+		done := make(chan struct{})
+		err = bThreads.Add("panics", func(ctx context.Context) {
+			defer close(done)
+			panic("oops")
+		})
+		assert.NoError(t, err)
+		<-done
+
+		assert.Eventually(t, func() bool {
+			jobs := bThreads.Jobs()
+			return len(jobs) == 1 && jobs[0].State == sql.BackgroundJobFailed
+		}, time.Second, time.Millisecond)
+		assert.EqualError(t, bThreads.Jobs()[0].LastError, "panic: oops")
+	})
+
+	t.Run("failed jobs are restarted", func(t *testing.T) {
+		bThreads = sql.NewBackgroundThreads()
+		defer bThreads.Shutdown()
+
+		var runs int
+		err = bThreads.AddJob(sql.BackgroundJob{
+			Name: "fails",
+			Run: func(ctx context.Context) error {
+				mu.Lock()
+				defer mu.Unlock()
+				runs++
+				return fmt.Errorf("run %d failed", runs)
+			},
+			RestartOnFailure: true,
+			RestartDelay:     time.Millisecond,
+			MaxRestarts:      2,
+		})
+		assert.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			jobs := bThreads.Jobs()
+			return len(jobs) == 1 && jobs[0].State == sql.BackgroundJobFailed
+		}, time.Second, time.Millisecond)
+		job := bThreads.Jobs()[0]
+		assert.Equal(t, 2, job.Restarts)
+		assert.EqualError(t, job.LastError, "run 3 failed")
+		assert.False(t, job.Finished.IsZero())
+	})
+
+	t.Run("jobs are stopped by shutdown", func(t *testing.T) {
+		bThreads = sql.NewBackgroundThreads()
+		defer bThreads.Shutdown()
+
+		err = bThreads.AddJob(sql.BackgroundJob{
+			Name: "waits",
+			Run: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			RestartOnFailure: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, sql.BackgroundJobRunning, bThreads.Jobs()[0].State)
+
+		err = bThreads.Shutdown()
+		assert.NoError(t, err)
+		job := bThreads.Jobs()[0]
+		assert.Equal(t, sql.BackgroundJobStopped, job.State)
+		assert.Equal(t, 0, job.Restarts)
+	})
 }
@@ -17,6 +17,7 @@ package sql
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync/atomic"
 
@@ -30,10 +31,14 @@ import (
 type BaseSession struct {
 	tx               Transaction
 	privilegeSet     PrivilegeSet
+	activeRoles      []RoleName
 	userVars         SessionUserVariables
 	logger           *logrus.Entry
 	locks            map[string]bool
 	storedProcParams map[string]*StoredProcParam
+	tableHandlers    map[string]*TableHandler
+	temporaryTables  map[string]map[string]Table
+	sequenceValues   map[string]int64
 	systemVars       map[string]SystemVarValue
 	statusVars       map[string]StatusVarValue
 	preparedQueries  map[string]sqlparser.Statement
@@ -53,6 +58,9 @@ type BaseSession struct {
 	charset          CharacterSetID
 	warningLock      bool
 	ignoreAutocommit bool
+	activeRolesSet   bool
+	sandboxed        bool
+	sandboxedSet     bool
 }
 
 func (s *BaseSession) GetLogger() *logrus.Entry {
@@ -81,6 +89,10 @@ func (s *BaseSession) GetIgnoreAutoCommit() bool {
 }
 
 var _ Session = (*BaseSession)(nil)
+var _ UserVariableIterator = (*BaseSession)(nil)
+var _ TableHandlerSession = (*BaseSession)(nil)
+var _ TemporaryTableSession = (*BaseSession)(nil)
+var _ SequenceSession = (*BaseSession)(nil)
 
 func (s *BaseSession) SetTransactionDatabase(dbName string) {
 	s.transactionDb = dbName
@@ -209,6 +221,15 @@ func (s *BaseSession) SetUserVariable(ctx *Context, varName string, value interf
 	return s.userVars.SetUserVariable(ctx, varName, value, typ)
 }
 
+// IterUserVariables implements the UserVariableIterator interface. Sessions whose user variables don't implement it
+// have no user variables to iterate.
+func (s *BaseSession) IterUserVariables(ctx *Context, cb func(name string, typ Type, val interface{}) error) error {
+	if iter, ok := s.userVars.(UserVariableIterator); ok {
+		return iter.IterUserVariables(ctx, cb)
+	}
+	return nil
+}
+
 // GetSessionVariable implements the Session interface.
 func (s *BaseSession) GetSessionVariable(ctx *Context, sysVarName string) (interface{}, error) {
 	sysVarName = strings.ToLower(sysVarName)
@@ -347,6 +368,20 @@ func (s *BaseSession) GetCharacterSetResults() CharacterSetID {
 	return s.charset
 }
 
+// GetCharacterSetClient returns the character set that this session's statements are sent in (defined by the system
+// variable `character_set_client`).
+func (s *BaseSession) GetCharacterSetClient() CharacterSetID {
+	sysVar, _ := s.systemVars[characterSetClientSysVarName]
+	if sysVar.Val == nil {
+		return CharacterSet_Unspecified
+	}
+	charSet, err := ParseCharacterSet(sysVar.Val.(string))
+	if err != nil {
+		return CharacterSet_Unspecified
+	}
+	return charSet
+}
+
 // GetCollation returns the collation for this session (defined by the system variable `collation_connection`).
 func (s *BaseSession) GetCollation() CollationID {
 	sysVar, ok := s.systemVars[collationConnectionSysVarName]
@@ -521,6 +556,24 @@ func (s *BaseSession) SetPrivilegeSet(newPs PrivilegeSet, counter uint64) {
 	s.privilegeSet = newPs
 }
 
+func (s *BaseSession) GetActiveRoles() ([]RoleName, bool) {
+	return s.activeRoles, s.activeRolesSet
+}
+
+func (s *BaseSession) SetActiveRoles(roles []RoleName, ok bool) {
+	s.activeRoles = roles
+	s.activeRolesSet = ok
+}
+
+func (s *BaseSession) GetSandboxMode() (bool, bool) {
+	return s.sandboxed, s.sandboxedSet
+}
+
+func (s *BaseSession) SetSandboxMode(sandboxed bool, ok bool) {
+	s.sandboxed = sandboxed
+	s.sandboxedSet = ok
+}
+
 func (s *BaseSession) PrepareQuery(query string, stmt sqlparser.Statement) {
 	s.preparedQueries[query] = stmt
 }
@@ -534,6 +587,93 @@ func (s *BaseSession) GetPreparedQuery(query string) (sqlparser.Statement, bool)
 	return stmt, ok
 }
 
+// OpenTableHandler implements the TableHandlerSession interface.
+func (s *BaseSession) OpenTableHandler(name string, handler *TableHandler) bool {
+	name = strings.ToLower(name)
+	if _, ok := s.tableHandlers[name]; ok {
+		return false
+	}
+	if s.tableHandlers == nil {
+		s.tableHandlers = make(map[string]*TableHandler)
+	}
+	s.tableHandlers[name] = handler
+	return true
+}
+
+// GetTableHandler implements the TableHandlerSession interface.
+func (s *BaseSession) GetTableHandler(name string) (*TableHandler, bool) {
+	handler, ok := s.tableHandlers[strings.ToLower(name)]
+	return handler, ok
+}
+
+// CloseTableHandler implements the TableHandlerSession interface.
+func (s *BaseSession) CloseTableHandler(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := s.tableHandlers[name]; !ok {
+		return false
+	}
+	delete(s.tableHandlers, name)
+	return true
+}
+
+// AddTemporaryTable implements the TemporaryTableSession interface.
+func (s *BaseSession) AddTemporaryTable(db string, table Table) bool {
+	db, name := strings.ToLower(db), strings.ToLower(table.Name())
+	if _, ok := s.temporaryTables[db][name]; ok {
+		return false
+	}
+	if s.temporaryTables == nil {
+		s.temporaryTables = make(map[string]map[string]Table)
+	}
+	if s.temporaryTables[db] == nil {
+		s.temporaryTables[db] = make(map[string]Table)
+	}
+	s.temporaryTables[db][name] = table
+	return true
+}
+
+// GetTemporaryTable implements the TemporaryTableSession interface.
+func (s *BaseSession) GetTemporaryTable(db, name string) (Table, bool) {
+	table, ok := s.temporaryTables[strings.ToLower(db)][strings.ToLower(name)]
+	return table, ok
+}
+
+// DropTemporaryTable implements the TemporaryTableSession interface.
+func (s *BaseSession) DropTemporaryTable(db, name string) bool {
+	db, name = strings.ToLower(db), strings.ToLower(name)
+	if _, ok := s.temporaryTables[db][name]; !ok {
+		return false
+	}
+	delete(s.temporaryTables[db], name)
+	return true
+}
+
+// GetTemporaryTables implements the TemporaryTableSession interface.
+func (s *BaseSession) GetTemporaryTables(db string) []Table {
+	tables := make([]Table, 0, len(s.temporaryTables[strings.ToLower(db)]))
+	for _, table := range s.temporaryTables[strings.ToLower(db)] {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return strings.ToLower(tables[i].Name()) < strings.ToLower(tables[j].Name())
+	})
+	return tables
+}
+
+// SetLastSequenceValue implements the SequenceSession interface.
+func (s *BaseSession) SetLastSequenceValue(db, name string, value int64) {
+	if s.sequenceValues == nil {
+		s.sequenceValues = make(map[string]int64)
+	}
+	s.sequenceValues[strings.ToLower(db)+"."+strings.ToLower(name)] = value
+}
+
+// LastSequenceValue implements the SequenceSession interface.
+func (s *BaseSession) LastSequenceValue(db, name string) (int64, bool) {
+	value, ok := s.sequenceValues[strings.ToLower(db)+"."+strings.ToLower(name)]
+	return value, ok
+}
+
 func (s *BaseSession) CacheQuery(query string, stmt sqlparser.Statement) {
 	s.cachedQueries[query] = stmt
 }
@@ -558,7 +698,6 @@ func BaseSessionFromConnection(ctx context.Context, c *mysql.Conn, addr string)
 
 // NewBaseSessionWithClientServer creates a new session with data.
 func NewBaseSessionWithClientServer(server string, client Client, id uint32) *BaseSession {
-	// TODO: if system variable "activate_all_roles_on_login" if set, activate all roles
 	var systemVars map[string]SystemVarValue
 	if SystemVariables != nil {
 		systemVars = SystemVariables.NewSessionMap()
@@ -579,6 +718,7 @@ func NewBaseSessionWithClientServer(server string, client Client, id uint32) *Ba
 		statusVars:       statusVars,
 		userVars:         NewUserVars(),
 		storedProcParams: make(map[string]*StoredProcParam),
+		tableHandlers:    make(map[string]*TableHandler),
 		preparedQueries:  make(map[string]sqlparser.Statement),
 		cachedQueries:    make(map[string]sqlparser.Statement),
 		idxReg:           NewIndexRegistry(),
@@ -591,7 +731,6 @@ func NewBaseSessionWithClientServer(server string, client Client, id uint32) *Ba
 
 // NewBaseSession creates a new empty session.
 func NewBaseSession() *BaseSession {
-	// TODO: if system variable "activate_all_roles_on_login" if set, activate all roles
 	var systemVars map[string]SystemVarValue
 	if SystemVariables != nil {
 		systemVars = SystemVariables.NewSessionMap()
@@ -610,6 +749,7 @@ func NewBaseSession() *BaseSession {
 		statusVars:       statusVars,
 		userVars:         NewUserVars(),
 		storedProcParams: make(map[string]*StoredProcParam),
+		tableHandlers:    make(map[string]*TableHandler),
 		preparedQueries:  make(map[string]sqlparser.Statement),
 		cachedQueries:    make(map[string]sqlparser.Statement),
 		idxReg:           NewIndexRegistry(),
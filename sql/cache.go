@@ -73,18 +73,24 @@ func (l *lruCache) Dispose(ctx *Context) {
 type rowsCache struct {
 	memory    Freeable
 	reporter  Reporter
+	tracker   *MemoryTracker
 	rows      []Row
 	valueRows []ValueRow
+	// charged is the number of bytes charged to tracker for the cached rows
+	charged int64
 }
 
-func newRowsCache(memory Freeable, r Reporter) *rowsCache {
-	return &rowsCache{memory: memory, reporter: r}
+func newRowsCache(memory Freeable, r Reporter, tracker *MemoryTracker) *rowsCache {
+	return &rowsCache{memory: memory, reporter: r, tracker: tracker}
 }
 
 func (c *rowsCache) Add(row Row) error {
 	if !releaseMemoryIfNeeded(c.reporter, c.memory.Free) {
 		return ErrNoMemoryAvailable.New()
 	}
+	if err := c.charge(EstimateRowSize(row)); err != nil {
+		return err
+	}
 
 	c.rows = append(c.rows, row)
 	return nil
@@ -96,6 +102,9 @@ func (c *rowsCache) AddValueRow(row ValueRow) error {
 	if !releaseMemoryIfNeeded(c.reporter, c.memory.Free) {
 		return ErrNoMemoryAvailable.New()
 	}
+	if err := c.charge(EstimateValueRowSize(row)); err != nil {
+		return err
+	}
 
 	c.valueRows = append(c.valueRows, row)
 	return nil
@@ -105,9 +114,20 @@ func (c *rowsCache) GetValueRow() []ValueRow {
 	return c.valueRows
 }
 
+func (c *rowsCache) charge(bytes int64) error {
+	if err := c.tracker.Grow(bytes); err != nil {
+		return err
+	}
+	c.charged += bytes
+	return nil
+}
+
 func (c *rowsCache) Dispose(ctx *Context) {
 	c.memory = nil
 	c.rows = nil
+	c.valueRows = nil
+	c.tracker.Shrink(c.charged)
+	c.charged = 0
 }
 
 // mapCache is a simple in-memory implementation of a cache
@@ -141,21 +161,32 @@ func NewMapCache() mapCache {
 type historyCache struct {
 	memory   Freeable
 	reporter Reporter
+	tracker  *MemoryTracker
 	cache    map[uint64]interface{}
+	// charged is the number of bytes charged to tracker for the cached values
+	charged int64
 }
 
 func (h *historyCache) Size() int {
 	return len(h.cache)
 }
 
-func newHistoryCache(memory Freeable, r Reporter) *historyCache {
-	return &historyCache{memory, r, make(map[uint64]interface{})}
+func newHistoryCache(memory Freeable, r Reporter, tracker *MemoryTracker) *historyCache {
+	return &historyCache{memory: memory, reporter: r, tracker: tracker, cache: make(map[uint64]interface{})}
 }
 
 func (h *historyCache) Put(k uint64, v interface{}) error {
 	if !releaseMemoryIfNeeded(h.reporter, h.memory.Free) {
 		return ErrNoMemoryAvailable.New()
 	}
+	if _, ok := h.cache[k]; !ok {
+		// the key and the map entry holding it
+		size := EstimateValueSize(v) + 16
+		if err := h.tracker.Grow(size); err != nil {
+			return err
+		}
+		h.charged += size
+	}
 	h.cache[k] = v
 	return nil
 }
@@ -171,6 +202,8 @@ func (h *historyCache) Get(k uint64) (interface{}, error) {
 func (h *historyCache) Dispose(ctx *Context) {
 	h.memory = nil
 	h.cache = nil
+	h.tracker.Shrink(h.charged)
+	h.charged = 0
 }
 
 // releasesMemoryIfNeeded releases memory if needed using the following steps
@@ -86,7 +86,7 @@ func TestHistoryCache(t *testing.T) {
 	t.Run("basic methods", func(t *testing.T) {
 		require := require.New(t)
 
-		cache := newHistoryCache(mockMemory{}, fixedReporter(5, 50))
+		cache := newHistoryCache(mockMemory{}, fixedReporter(5, 50), nil)
 
 		require.NoError(cache.Put(1, "foo"))
 		v, err := cache.Get(1)
@@ -105,7 +105,7 @@ func TestHistoryCache(t *testing.T) {
 
 	t.Run("no memory available", func(t *testing.T) {
 		require := require.New(t)
-		cache := newHistoryCache(mockMemory{}, fixedReporter(51, 50))
+		cache := newHistoryCache(mockMemory{}, fixedReporter(51, 50), nil)
 
 		err := cache.Put(1, "foo")
 		require.Error(err)
@@ -125,6 +125,7 @@ func TestHistoryCache(t *testing.T) {
 				}
 				return 51
 			}, 50},
+			nil,
 		)
 		require.NoError(cache.Put(1, "foo"))
 		v, err := cache.Get(1)
@@ -138,7 +139,7 @@ func TestRowsCache(t *testing.T) {
 	t.Run("basic methods", func(t *testing.T) {
 		require := require.New(t)
 
-		cache := newRowsCache(mockMemory{}, fixedReporter(5, 50))
+		cache := newRowsCache(mockMemory{}, fixedReporter(5, 50), nil)
 
 		require.NoError(cache.Add(Row{1}))
 		require.Len(cache.Get(), 1)
@@ -151,7 +152,7 @@ func TestRowsCache(t *testing.T) {
 
 	t.Run("no memory available", func(t *testing.T) {
 		require := require.New(t)
-		cache := newRowsCache(mockMemory{}, fixedReporter(51, 50))
+		cache := newRowsCache(mockMemory{}, fixedReporter(51, 50), nil)
 
 		err := cache.Add(Row{1, "foo"})
 		require.Error(err)
@@ -171,6 +172,7 @@ func TestRowsCache(t *testing.T) {
 				}
 				return 51
 			}, 50},
+			nil,
 		)
 		require.NoError(cache.Add(Row{1, "foo"}))
 		require.Len(cache.Get(), 1)
@@ -119,6 +119,34 @@ func (f *ForeignKeyConstraint) DebugString(ctx *Context) string {
 	)
 }
 
+// Description returns the description of the foreign key that MySQL includes in the errors for its violations, such as
+// "`db`.`child`, CONSTRAINT `fk` FOREIGN KEY (`pid`) REFERENCES `parent` (`id`) ON DELETE CASCADE".
+func (f *ForeignKeyConstraint) Description() string {
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "`%s`.`%s`, CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES ", f.Database, f.Table, f.Name, quoteIdentifiers(f.Columns))
+	if !strings.EqualFold(f.Database, f.ParentDatabase) {
+		fmt.Fprintf(&sb, "`%s`.", f.ParentDatabase)
+	}
+	fmt.Fprintf(&sb, "`%s` (%s)", f.ParentTable, quoteIdentifiers(f.ParentColumns))
+	// like MySQL, RESTRICT is left out since it's the default
+	if f.OnDelete != ForeignKeyReferentialAction_DefaultAction && f.OnDelete != ForeignKeyReferentialAction_Restrict && f.OnDelete != "" {
+		fmt.Fprintf(&sb, " ON DELETE %s", f.OnDelete)
+	}
+	if f.OnUpdate != ForeignKeyReferentialAction_DefaultAction && f.OnUpdate != ForeignKeyReferentialAction_Restrict && f.OnUpdate != "" {
+		fmt.Fprintf(&sb, " ON UPDATE %s", f.OnUpdate)
+	}
+	return sb.String()
+}
+
+// quoteIdentifiers returns |names| quoted with backticks and separated by commas.
+func quoteIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
 func (f *ForeignKeyConstraint) AllowStoredGeneratedColumnReference() bool {
 	return f.OnDelete.allowStoredGeneratedColumnReference() && f.OnUpdate.allowStoredGeneratedColumnReference()
 }
@@ -81,6 +81,15 @@ type NonDeterministicExpression interface {
 	IsNonDeterministic() bool
 }
 
+// SessionDependentExpression allows a way for expressions to declare that their result depends on the state of the
+// session, such as a system variable they read implicitly, in addition to their children. Such an expression returns
+// the same result for the duration of a statement, but its result must not be shared across statements or sessions.
+type SessionDependentExpression interface {
+	Expression
+	// IsSessionDependent returns whether this expression's result depends on the state of the session.
+	IsSessionDependent() bool
+}
+
 // IsNullExpression indicates that this expression tests for IS NULL.
 type IsNullExpression interface {
 	Expression
@@ -485,6 +494,16 @@ type ValueExpression interface {
 	IsValueExpression(ctx *Context) bool
 }
 
+// BatchExpression is an Expression that evaluates every row of a RowBatch at once, rather than one row at a time. Its
+// children are evaluated with EvalBatch, so they need not implement this interface themselves.
+type BatchExpression interface {
+	Expression
+	// EvalBatch evaluates the selected rows of the batch, returning a vector with a value for every row of the batch.
+	// The values of rows that aren't selected are unspecified. The vector may be shared with the batch or with other
+	// expressions, so it must not be modified.
+	EvalBatch(ctx *Context, batch *RowBatch) (ColumnVector, error)
+}
+
 var SystemVariables SystemVariableRegistry
 
 // SystemVariableRegistry is a registry of system variables. Each session gets its own copy of all values via the
@@ -958,6 +977,57 @@ func (s *ImmutableStatusVarValue) Copy() StatusVarValue {
 	return &ret
 }
 
+// ElapsedStatusVarValue is a StatusVariable whose value is the number of whole seconds elapsed since a point in time,
+// such as Uptime. The value is computed when it is read, and the full precision duration is available from Elapsed.
+type ElapsedStatusVarValue struct {
+	Var   StatusVariable
+	Since *atomic.Int64
+}
+
+// NewElapsedStatusVarValue returns a new ElapsedStatusVarValue that counts from the current time.
+func NewElapsedStatusVarValue(v StatusVariable) *ElapsedStatusVarValue {
+	s := &ElapsedStatusVarValue{
+		Var:   v,
+		Since: &atomic.Int64{},
+	}
+	s.Since.Store(time.Now().UnixNano())
+	return s
+}
+
+func (s *ElapsedStatusVarValue) Increment(uint64) error {
+	return fmt.Errorf("status variable %s cannot be incremented", s.Variable().GetName())
+}
+
+// Set sets the number of seconds elapsed, which continues to count up from the given value.
+func (s *ElapsedStatusVarValue) Set(v interface{}) error {
+	typedVal, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("expected uint64")
+	}
+	s.Since.Store(time.Now().Add(-time.Duration(typedVal) * time.Second).UnixNano())
+	return nil
+}
+
+func (s *ElapsedStatusVarValue) Variable() StatusVariable {
+	return s.Var
+}
+
+func (s *ElapsedStatusVarValue) Value() interface{} {
+	return uint64(s.Elapsed() / time.Second)
+}
+
+// Elapsed returns the time elapsed since the value started counting.
+func (s *ElapsedStatusVarValue) Elapsed() time.Duration {
+	return time.Duration(time.Now().UnixNano() - s.Since.Load())
+}
+
+func (s *ElapsedStatusVarValue) Copy() StatusVarValue {
+	ret := *s
+	ret.Since = &atomic.Int64{}
+	ret.Since.Store(s.Since.Load())
+	return &ret
+}
+
 // IncrementStatusVariable increments the value of the status variable by integer val.
 // |name| is case-sensitive.
 func IncrementStatusVariable(ctx *Context, name string, val int) {
@@ -254,6 +254,9 @@ type TriggerDefinition struct {
 	// SqlMode holds the SQL_MODE that was in use when this trigger was originally defined. It contains information
 	// needed for how to parse the trigger's SQL, such as whether ANSI_QUOTES mode is enabled.
 	SqlMode string
+	// Definer is the account that defined this trigger, as 'user'@'host'. Triggers stored before this field was added
+	// have an empty definer, in which case the DEFINER clause of CreateStatement, if any, is used.
+	Definer string
 	// SchemaName is the name of the schema of the trigger, for databases that support schemas.
 	SchemaName string
 }
@@ -289,6 +292,21 @@ type StoredProcedureDatabase interface {
 	DropStoredProcedure(ctx *Context, name string) error
 }
 
+// StoredFunctionDatabase is a database that supports the creation and execution of stored functions written in SQL.
+// The engine handles parsing and execution, so integrators only need to store and retrieve StoredFunctionDetails.
+type StoredFunctionDatabase interface {
+	Database
+	// GetStoredFunction returns the desired StoredFunctionDetails from the database.
+	GetStoredFunction(ctx *Context, name string) (StoredFunctionDetails, bool, error)
+	// GetStoredFunctions returns all StoredFunctionDetails for the database.
+	GetStoredFunctions(ctx *Context) ([]StoredFunctionDetails, error)
+	// SaveStoredFunction stores the given StoredFunctionDetails to the database. The integrator should verify that the
+	// name of the new stored function is unique amongst existing stored functions.
+	SaveStoredFunction(ctx *Context, sfd StoredFunctionDetails) error
+	// DropStoredFunction removes the StoredFunctionDetails with the matching name from the database.
+	DropStoredFunction(ctx *Context, name string) error
+}
+
 // EventDatabase is a database that supports the creation and execution of events. The engine will
 // handle execution logic for events. Integrators only need to store and retrieve EventDefinition.
 type EventDatabase interface {
@@ -66,6 +66,16 @@ func (e *DescribeStats) getDescribeStats() *DescribeStats {
 	return e
 }
 
+// GetDescribeStats returns the stats of |n|, and whether it has any. Only nodes that implement WithDescribeStats have
+// stats, and only once the plan they're in has been costed.
+func GetDescribeStats(n interface{}) (DescribeStats, bool) {
+	if d, ok := n.(WithDescribeStats); ok {
+		stats := d.getDescribeStats()
+		return *stats, stats.HasStats
+	}
+	return DescribeStats{}, false
+}
+
 type CountingRowIter struct {
 	RowIter
 	Stats *DescribeStats
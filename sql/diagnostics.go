@@ -0,0 +1,86 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "fmt"
+
+// DiagnosticsArea returns the conditions in the diagnostics area of the session, which are the warnings raised by the
+// most recent statement, in the order they were raised.
+func DiagnosticsArea(ctx *Context) []*Warning {
+	warnings := ctx.Session.Warnings()
+	area := make([]*Warning, len(warnings))
+	for i, w := range warnings {
+		area[len(warnings)-i-1] = w
+	}
+	return area
+}
+
+// StatementInformation returns the statement information item |name| of the diagnostics area |area|, as read by GET
+// DIAGNOSTICS. The item is one of "number" and "row_count".
+func StatementInformation(ctx *Context, area []*Warning, name string) (any, error) {
+	switch name {
+	case "number":
+		return int64(len(area)), nil
+	case "row_count":
+		return ctx.GetLastQueryInfo().RowCount.Load(), nil
+	default:
+		return nil, fmt.Errorf("unknown statement information item: %s", name)
+	}
+}
+
+// DiagnosticsCondition returns the condition numbered |n| in the diagnostics area |area|. Conditions are numbered
+// from 1, in the order they were raised.
+func DiagnosticsCondition(area []*Warning, n int64) (*Warning, error) {
+	if n < 1 || n > int64(len(area)) {
+		return nil, ErrInvalidConditionNumber.New()
+	}
+	return area[n-1], nil
+}
+
+// ConditionInformation returns the condition information item |name| of |cond|, as read by GET DIAGNOSTICS. The items
+// that a condition doesn't record, such as the names of the table and column it is about, are empty strings.
+func ConditionInformation(cond *Warning, name string) any {
+	state := cond.State
+	if state == "" {
+		state = "HY000"
+	}
+	switch name {
+	case "returned_sqlstate":
+		return state
+	case "message_text":
+		return cond.Message
+	case "mysql_errno":
+		return int64(cond.Code)
+	case "class_origin":
+		if isStandardSQLStateClass(state) {
+			return "ISO 9075"
+		}
+		return "MySQL"
+	case "subclass_origin":
+		if isStandardSQLStateClass(state) && state[2:] == "000" {
+			return "ISO 9075"
+		}
+		return "MySQL"
+	default:
+		return ""
+	}
+}
+
+// isStandardSQLStateClass returns whether the class of |state|, its first two characters, is defined by the SQL
+// standard, rather than by MySQL.
+func isStandardSQLStateClass(state string) bool {
+	c := state[0]
+	return (c >= '0' && c <= '4') || (c >= 'A' && c <= 'H')
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encodings
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// These character sets are transcoded using the encodings of golang.org/x/text. Their default and binary collations
+// order characters by their encoded bytes, see BinRuneWeight and CaseInsensitiveRuneWeight.
+var (
+	Big5     = &TextEncoding{encoding: traditionalchinese.Big5}
+	Cp1250   = &TextEncoding{encoding: charmap.Windows1250}
+	Cp1251   = &TextEncoding{encoding: charmap.Windows1251}
+	Cp850    = &TextEncoding{encoding: charmap.CodePage850}
+	Cp852    = &TextEncoding{encoding: charmap.CodePage852}
+	Cp866    = &TextEncoding{encoding: charmap.CodePage866}
+	Cp932    = &TextEncoding{encoding: japanese.ShiftJIS}
+	Eucjpms  = &TextEncoding{encoding: japanese.EUCJP}
+	Euckr    = &TextEncoding{encoding: korean.EUCKR}
+	Gb18030  = &TextEncoding{encoding: simplifiedchinese.GB18030}
+	Gb2312   = &TextEncoding{encoding: simplifiedchinese.GBK} // GBK is a superset of GB2312
+	Gbk      = &TextEncoding{encoding: simplifiedchinese.GBK}
+	Greek    = &TextEncoding{encoding: charmap.ISO8859_7}
+	Hebrew   = &TextEncoding{encoding: charmap.ISO8859_8}
+	Koi8r    = &TextEncoding{encoding: charmap.KOI8R}
+	Koi8u    = &TextEncoding{encoding: charmap.KOI8U}
+	Latin2   = &TextEncoding{encoding: charmap.ISO8859_2}
+	Latin5   = &TextEncoding{encoding: charmap.ISO8859_9}
+	Macroman = &TextEncoding{encoding: charmap.Macintosh}
+	Sjis     = &TextEncoding{encoding: japanese.ShiftJIS}
+	Tis620   = &TextEncoding{encoding: charmap.Windows874}
+	Ujis     = &TextEncoding{encoding: japanese.EUCJP}
+)
+
+// TextEncoding is an Encoder for an encoding from golang.org/x/text.
+type TextEncoding struct {
+	encoding encoding.Encoding
+	weights  sync.Map // rune -> int32
+}
+
+var _ Encoder = (*TextEncoding)(nil)
+
+// Decode implements the Encoder interface. Decoding fails if the string contains a sequence that isn't valid in the
+// encoding.
+func (te *TextEncoding) Decode(str []byte) ([]byte, bool) {
+	decoded, err := te.encoding.NewDecoder().Bytes(str)
+	if err != nil || bytes.ContainsRune(decoded, utf8.RuneError) {
+		return nil, false
+	}
+	return decoded, true
+}
+
+// Encode implements the Encoder interface. Encoding fails if the string contains a character that the encoding can't
+// represent.
+func (te *TextEncoding) Encode(str []byte) ([]byte, bool) {
+	encoded, err := te.encoding.NewEncoder().Bytes(str)
+	if err != nil {
+		return nil, false
+	}
+	return encoded, true
+}
+
+// EncodeReplaceUnknown implements the Encoder interface.
+func (te *TextEncoding) EncodeReplaceUnknown(str []byte) []byte {
+	if encoded, ok := te.Encode(str); ok {
+		return encoded
+	}
+	encoder := te.encoding.NewEncoder()
+	encodedStr := make([]byte, 0, len(str))
+	for len(str) > 0 {
+		_, n := utf8.DecodeRune(str)
+		encodedRune, err := encoder.Bytes(str[:n])
+		if err != nil {
+			encodedRune = []byte{'?'}
+		}
+		encodedStr = append(encodedStr, encodedRune...)
+		str = str[n:]
+	}
+	return encodedStr
+}
+
+// DecodeRune implements the Encoder interface.
+func (te *TextEncoding) DecodeRune(r []byte) ([]byte, bool) {
+	return te.Decode(r)
+}
+
+// EncodeRune implements the Encoder interface.
+func (te *TextEncoding) EncodeRune(r []byte) ([]byte, bool) {
+	return te.Encode(r)
+}
+
+// Uppercase implements the Encoder interface.
+func (te *TextEncoding) Uppercase(str string) string {
+	return strings.ToUpper(str)
+}
+
+// Lowercase implements the Encoder interface.
+func (te *TextEncoding) Lowercase(str string) string {
+	return strings.ToLower(str)
+}
+
+// UppercaseRune implements the Encoder interface.
+func (te *TextEncoding) UppercaseRune(r rune) rune {
+	return unicode.ToUpper(r)
+}
+
+// LowercaseRune implements the Encoder interface.
+func (te *TextEncoding) LowercaseRune(r rune) rune {
+	return unicode.ToLower(r)
+}
+
+// NextRune implements the Encoder interface.
+func (te *TextEncoding) NextRune(str string) (rune, int) {
+	return utf8.DecodeRuneInString(str)
+}
+
+// IsReturnSafe implements the Encoder interface. All returns from TextEncoding are safe to edit as they create a new
+// byte slice.
+func (te *TextEncoding) IsReturnSafe() bool {
+	return true
+}
+
+// BinRuneWeight returns the weight of a rune in the binary collation of the encoding, which orders runes by their
+// encoded bytes. Runes that the encoding can't represent sort after all others.
+func (te *TextEncoding) BinRuneWeight(r rune) int32 {
+	if r >= 0 && r <= 127 {
+		// every encoding here leaves ASCII unchanged
+		return int32(uint32(r)<<24 ^ 0x80000000)
+	}
+	if weight, ok := te.weights.Load(r); ok {
+		return weight.(int32)
+	}
+	weight := int32(2147483647)
+	if encoded, err := te.encoding.NewEncoder().Bytes([]byte(string(r))); err == nil && len(encoded) <= 4 {
+		// The encoded bytes are left-aligned so that shorter sequences sort before longer sequences that they prefix,
+		// and the sign bit is flipped so that the order of the unsigned value is kept.
+		var v uint32
+		for i, b := range encoded {
+			v |= uint32(b) << (24 - 8*i)
+		}
+		weight = int32(v ^ 0x80000000)
+	}
+	te.weights.Store(r, weight)
+	return weight
+}
+
+// CaseInsensitiveRuneWeight returns the weight of a rune in the default collation of the encoding, which orders runes
+// by the encoded bytes of their uppercase form.
+func (te *TextEncoding) CaseInsensitiveRuneWeight(r rune) int32 {
+	return te.BinRuneWeight(unicode.ToUpper(r))
+}
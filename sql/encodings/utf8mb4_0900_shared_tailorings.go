@@ -0,0 +1,104 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encodings
+
+// The collations in this file were added in MySQL 8.0.30 for languages whose CLDR tailoring is the same as that of a
+// collation which already existed, so they share that collation's weights rather than embedding a copy of them.
+// Norwegian follows Danish, Bosnian and Serbian (Latin) follow Croatian, Galician follows Spanish, and Bulgarian and
+// Mongolian (Cyrillic) follow Russian in placing Cyrillic before other scripts.
+
+// Utf8mb4_nb_0900_ai_ci_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_nb_0900_ai_ci` collation.
+func Utf8mb4_nb_0900_ai_ci_RuneWeight(r rune) int32 {
+	return Utf8mb4_da_0900_ai_ci_RuneWeight(r)
+}
+
+// Utf8mb4_nb_0900_as_cs_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_nb_0900_as_cs` collation.
+func Utf8mb4_nb_0900_as_cs_RuneWeight(r rune) int32 {
+	return Utf8mb4_da_0900_as_cs_RuneWeight(r)
+}
+
+// Utf8mb4_nn_0900_ai_ci_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_nn_0900_ai_ci` collation.
+func Utf8mb4_nn_0900_ai_ci_RuneWeight(r rune) int32 {
+	return Utf8mb4_da_0900_ai_ci_RuneWeight(r)
+}
+
+// Utf8mb4_nn_0900_as_cs_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_nn_0900_as_cs` collation.
+func Utf8mb4_nn_0900_as_cs_RuneWeight(r rune) int32 {
+	return Utf8mb4_da_0900_as_cs_RuneWeight(r)
+}
+
+// Utf8mb4_sr_latn_0900_ai_ci_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_sr_latn_0900_ai_ci` collation.
+func Utf8mb4_sr_latn_0900_ai_ci_RuneWeight(r rune) int32 {
+	return Utf8mb4_hr_0900_ai_ci_RuneWeight(r)
+}
+
+// Utf8mb4_sr_latn_0900_as_cs_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_sr_latn_0900_as_cs` collation.
+func Utf8mb4_sr_latn_0900_as_cs_RuneWeight(r rune) int32 {
+	return Utf8mb4_hr_0900_as_cs_RuneWeight(r)
+}
+
+// Utf8mb4_bs_0900_ai_ci_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_bs_0900_ai_ci` collation.
+func Utf8mb4_bs_0900_ai_ci_RuneWeight(r rune) int32 {
+	return Utf8mb4_hr_0900_ai_ci_RuneWeight(r)
+}
+
+// Utf8mb4_bs_0900_as_cs_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_bs_0900_as_cs` collation.
+func Utf8mb4_bs_0900_as_cs_RuneWeight(r rune) int32 {
+	return Utf8mb4_hr_0900_as_cs_RuneWeight(r)
+}
+
+// Utf8mb4_bg_0900_ai_ci_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_bg_0900_ai_ci` collation.
+func Utf8mb4_bg_0900_ai_ci_RuneWeight(r rune) int32 {
+	return Utf8mb4_ru_0900_ai_ci_RuneWeight(r)
+}
+
+// Utf8mb4_bg_0900_as_cs_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_bg_0900_as_cs` collation.
+func Utf8mb4_bg_0900_as_cs_RuneWeight(r rune) int32 {
+	return Utf8mb4_ru_0900_as_cs_RuneWeight(r)
+}
+
+// Utf8mb4_gl_0900_ai_ci_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_gl_0900_ai_ci` collation.
+func Utf8mb4_gl_0900_ai_ci_RuneWeight(r rune) int32 {
+	return Utf8mb4_es_0900_ai_ci_RuneWeight(r)
+}
+
+// Utf8mb4_gl_0900_as_cs_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_gl_0900_as_cs` collation.
+func Utf8mb4_gl_0900_as_cs_RuneWeight(r rune) int32 {
+	return Utf8mb4_es_0900_as_cs_RuneWeight(r)
+}
+
+// Utf8mb4_mn_cyrl_0900_ai_ci_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_mn_cyrl_0900_ai_ci` collation.
+func Utf8mb4_mn_cyrl_0900_ai_ci_RuneWeight(r rune) int32 {
+	return Utf8mb4_ru_0900_ai_ci_RuneWeight(r)
+}
+
+// Utf8mb4_mn_cyrl_0900_as_cs_RuneWeight returns the weight of a given rune based on its relational sort order from
+// the `utf8mb4_mn_cyrl_0900_as_cs` collation.
+func Utf8mb4_mn_cyrl_0900_as_cs_RuneWeight(r rune) int32 {
+	return Utf8mb4_ru_0900_as_cs_RuneWeight(r)
+}
@@ -17,6 +17,7 @@ package sql
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
@@ -180,6 +181,14 @@ var (
 	// ErrTriggerCannotBeDropped is returned when dropping a trigger would cause another trigger to reference a non-existent trigger.
 	ErrTriggerCannotBeDropped = errors.NewKind(`trigger "%s" cannot be dropped as it is referenced by trigger "%s"`)
 
+	// ErrReferencedTriggerDoesNotExist is returned when a trigger FOLLOWS or PRECEDES a trigger that doesn't exist, or
+	// that isn't on the same table with the same timing and event.
+	ErrReferencedTriggerDoesNotExist = errors.NewKind("Referenced trigger '%s' for the given action time and event type does not exist.")
+
+	// ErrRoutineDependencyDropped is the warning given when a DDL statement drops or renames a table or column that the
+	// body of a trigger, stored procedure or stored function refers to.
+	ErrRoutineDependencyDropped = errors.NewKind(`%s "%s" refers to %s, which no longer exists, and will fail when it is executed`)
+
 	// ErrStoredProceduresNotSupported is returned when attempting to create a stored procedure on a database that doesn't support them.
 	ErrStoredProceduresNotSupported = errors.NewKind(`database "%s" doesn't support stored procedures`)
 
@@ -340,6 +349,9 @@ var (
 	// ErrLoadDataCannotOpen is returned when a LOAD DATA operation is unable to open the file specified.
 	ErrLoadDataCannotOpen = errors.NewKind("LOAD DATA is unable to open file: %s")
 
+	// ErrLoadDataCharacterSet is returned when LOAD DATA is given a character set that files can't be loaded from.
+	ErrLoadDataCharacterSet = errors.NewKind("LOAD DATA cannot load files in the %s character set")
+
 	// ErrLoadDataCharacterLength is returned when a symbol is of the wrong character length for a LOAD DATA operation.
 	ErrLoadDataCharacterLength = errors.NewKind("%s must be 1 character long")
 
@@ -397,6 +409,16 @@ var (
 	// ErrSignalOnlySqlState is returned when SIGNAL/RESIGNAL references a DECLARE CONDITION for a MySQL error code.
 	ErrSignalOnlySqlState = errors.NewKind("SIGNAL/RESIGNAL can only use a condition defined with SQLSTATE")
 
+	// ErrResignalWithoutHandler is returned when RESIGNAL is executed outside of a DECLARE ... HANDLER statement.
+	ErrResignalWithoutHandler = newMySQLKind("RESIGNAL when handler not active", 1645, "0K000")
+
+	// ErrGetStackedDiagnosticsWithoutHandler is returned when GET STACKED DIAGNOSTICS is executed outside of a DECLARE
+	// ... HANDLER statement.
+	ErrGetStackedDiagnosticsWithoutHandler = newMySQLKind("GET STACKED DIAGNOSTICS when handler not active", 1887, "0Z002")
+
+	// ErrInvalidConditionNumber is returned when GET DIAGNOSTICS reads a condition that isn't in the diagnostics area.
+	ErrInvalidConditionNumber = newMySQLKind("Invalid condition number", 1758, "35000")
+
 	// ErrExpectedSingleRow is returned when a subquery executed in normal queries or aggregation function returns
 	// more than 1 row without an attached IN clause.
 	ErrExpectedSingleRow = errors.NewKind("the subquery returned more than 1 row")
@@ -425,14 +447,27 @@ var (
 	// ErrPartitionNotFound is thrown when a partition key on a table is not found
 	ErrPartitionNotFound = errors.NewKind("partition not found %q")
 
+	// ErrStorageCallTimeout is returned when a call into a storage implementation made with CallWithTimeout doesn't
+	// finish within its timeout.
+	ErrStorageCallTimeout = errors.NewKind("storage call exceeded its timeout of %s")
+
+	// ErrSessionStateUnknownUser is returned when restoring a session state whose account doesn't exist.
+	ErrSessionStateUnknownUser = errors.NewKind("cannot restore session state: account '%s'@'%s' does not exist")
+
+	// ErrSessionStateUnknownTransaction is returned when restoring a session state whose transaction isn't held by the
+	// engine, because it was already restored or was handed off by another engine.
+	ErrSessionStateUnknownTransaction = errors.NewKind("cannot restore session state: transaction %s is not held by this engine")
+
 	// ErrInsertIntoNonNullableProvidedNull is called when a null value is inserted into a non-nullable column
 	ErrInsertIntoNonNullableProvidedNull = errors.NewKind("column name '%v' is non-nullable but attempted to set a value of null")
 
 	// ErrForeignKeyChildViolation is called when a rows is added but there is no parent row, and a foreign key constraint fails. Add the parent row first.
-	ErrForeignKeyChildViolation = errors.NewKind("cannot add or update a child row - Foreign key violation on fk: `%s`, table: `%s`, referenced table: `%s`, key: `%s`")
+	// The argument is the constraint's ForeignKeyConstraint.Description.
+	ErrForeignKeyChildViolation = errors.NewKind("Cannot add or update a child row: a foreign key constraint fails (%s)")
 
 	// ErrForeignKeyParentViolation is called when a parent row that is deleted has children, and a foreign key constraint fails. Delete the children first.
-	ErrForeignKeyParentViolation = errors.NewKind("cannot delete or update a parent row - Foreign key violation on fk: `%s`, table: `%s`, referenced table: `%s`, key: `%s`")
+	// The argument is the constraint's ForeignKeyConstraint.Description.
+	ErrForeignKeyParentViolation = errors.NewKind("Cannot delete or update a parent row: a foreign key constraint fails (%s)")
 
 	// ErrForeignKeyColumnCountMismatch is called when the declared column and referenced column counts do not match.
 	ErrForeignKeyColumnCountMismatch = errors.NewKind("the foreign key must reference an equivalent number of columns")
@@ -468,6 +503,9 @@ var (
 	// ErrTemporaryTablesForeignKeySupport is returned when a user tries to create a temporary table with a foreign key
 	ErrTemporaryTablesForeignKeySupport = errors.NewKind("temporary tables do not support foreign keys")
 
+	// ErrTemporaryTablesFullTextSupport is returned when a user tries to create a Full-Text index on a temporary table
+	ErrTemporaryTablesFullTextSupport = errors.NewKind("cannot create FULLTEXT index on temporary table")
+
 	// ErrForeignKeyNotFound is returned when a foreign key was not found.
 	ErrForeignKeyNotFound = errors.NewKind("foreign key `%s` was not found on the table `%s`")
 
@@ -492,7 +530,8 @@ var (
 	ErrForeignKeyTypeChange = errors.NewKind("unable to change type of column `%s` as it is used by foreign keys")
 
 	// ErrForeignKeyDepthLimit is returned when the CASCADE depth limit has been reached.
-	ErrForeignKeyDepthLimit = errors.NewKind("Foreign key cascade delete/update exceeds max depth of 15.")
+	// This matches MySQL ERROR 3008 (HY000).
+	ErrForeignKeyDepthLimit = newMySQLKind("Foreign key cascade delete/update exceeds max depth of 15.", 3008, "HY000")
 
 	// ErrDuplicateKey is returned when a duplicate key is defined on a table.
 	ErrDuplicateKey = errors.NewKind("Duplicate key name '%s'")
@@ -578,6 +617,10 @@ var (
 	ErrNonAggregatedColumnWithoutGroupBy = errors.NewKind("in aggregated query without GROUP BY, expression #%d of SELECT list contains nonaggregated column '%s'; " +
 		"this is incompatible with sql_mode=only_full_group_by")
 
+	// ErrFieldInGroupingNotGroupBy is returned when an argument of the GROUPING function is not one of the GROUP BY
+	// expressions of its query.
+	ErrFieldInGroupingNotGroupBy = newMySQLKind("Argument #%d of GROUPING function is not in GROUP BY", 3580, "HY000")
+
 	// ErrInvalidArgumentNumber is returned when the number of arguments to call a
 	// function is different from the function arity.
 	ErrInvalidArgumentNumber = errors.NewKind("function '%s' expected %v arguments, %v received")
@@ -604,6 +647,15 @@ var (
 	// ErrPidAlreadyUsed is returned when the pid is already registered.
 	ErrPidAlreadyUsed = errors.NewKind("pid %d is already in use")
 
+	// ErrOutOfResources is returned when a query exceeds its own memory limit, or the memory limit of the server.
+	ErrOutOfResources = newMySQLKind("Out of memory; %s memory limit of %d bytes exceeded", mysql.EROutOfResources, "HY000")
+
+	// ErrUserLimitReached is returned when an account has used up one of its resource limits for the current hour.
+	ErrUserLimitReached = newMySQLKind("User '%s' has exceeded the '%s' resource (current value: %d)", mysql.ERUserLimitReached, "42000")
+
+	// ErrQueryTimeout is returned when a statement runs for longer than its max_execution_time.
+	ErrQueryTimeout = newMySQLKind("Query execution was interrupted, maximum statement execution time exceeded", mysql.ERQueryTimeout, "HY000")
+
 	// ErrInvalidOperandColumns is returned when the columns in the left
 	// operand and the elements of the right operand don't match. Also
 	// returned for invalid number of columns in projections, filters,
@@ -617,6 +669,99 @@ var (
 	// are automatically rolled back. Clients receiving this error must retry the transaction.
 	ErrLockDeadlock = errors.NewKind("serialization failure: %s, try restarting transaction.")
 
+	// ErrLockNowait is returned by a locking read with NOWAIT that reads a row locked by another transaction.
+	ErrLockNowait = newMySQLKind("Statement aborted because lock(s) could not be acquired immediately and NOWAIT is set.", 3572, "HY000")
+
+	// ErrLockWaitTimeout is returned by a statement that waited longer than innodb_lock_wait_timeout for a row lock
+	// held by another transaction.
+	ErrLockWaitTimeout = newMySQLKind("Lock wait timeout exceeded; try restarting transaction", 1205, "HY000")
+
+	// ErrAlterOperationNotSupported is returned by an ALTER TABLE statement whose ALGORITHM or LOCK clause asks for
+	// a faster algorithm or a less restrictive lock than the table can apply the statement with.
+	ErrAlterOperationNotSupported = newMySQLKind("%s is not supported for this operation. Try %s.", 1845, "0A000")
+
+	// ErrAlterAlgorithmWrongUsage is returned by an ALTER TABLE statement that combines ALGORITHM=INSTANT with a
+	// LOCK clause.
+	ErrAlterAlgorithmWrongUsage = newMySQLKind("Incorrect usage of ALGORITHM=%s and LOCK=%s", 1221, "HY000")
+
+	// ErrNoPartitionForValue is returned when a row written to a partitioned table doesn't belong to any of its
+	// partitions.
+	ErrNoPartitionForValue = newMySQLKind("Table has no partition for value %v", 1526, "HY000")
+
+	// ErrPartitionsMustBeDefined is returned when a table partitioned by RANGE or LIST doesn't define its partitions.
+	ErrPartitionsMustBeDefined = newMySQLKind("For %s partitions each partition must be defined", 1492, "HY000")
+
+	// ErrPartitionRangeNotIncreasing is returned when the bounds of the partitions of a table partitioned by RANGE
+	// aren't in increasing order.
+	ErrPartitionRangeNotIncreasing = newMySQLKind("VALUES LESS THAN value must be strictly increasing for each partition", 1493, "HY000")
+
+	// ErrPartitionListDuplicateValue is returned when a value is given to more than one partition of a table
+	// partitioned by LIST.
+	ErrPartitionListDuplicateValue = newMySQLKind("Multiple definition of same constant in list partitioning", 1495, "HY000")
+
+	// ErrPartitionWrongValues is returned when the definition of a partition uses VALUES of the wrong kind for the
+	// partitioning method of its table.
+	ErrPartitionWrongValues = newMySQLKind("Only %s PARTITIONING can use VALUES %s in partition definition", 1480, "HY000")
+
+	// ErrDuplicatePartitionName is returned when two partitions of a table have the same name.
+	ErrDuplicatePartitionName = newMySQLKind("Duplicate partition name %s", 1517, "HY000")
+
+	// ErrUniqueKeyNeedsPartitionColumns is returned when a unique key of a partitioned table doesn't include the
+	// partitioning column.
+	ErrUniqueKeyNeedsPartitionColumns = newMySQLKind("A %s must include all columns in the table's partitioning function", 1503, "HY000")
+
+	// ErrPartitionFieldNotFound is returned when a table is partitioned by a column it doesn't have.
+	ErrPartitionFieldNotFound = newMySQLKind("Field in list of fields for partition function not found in table", 1488, "HY000")
+
+	// ErrPartitionMgmtOnNonPartitioned is returned by ALTER TABLE statements that manage the partitions of a table that
+	// isn't partitioned.
+	ErrPartitionMgmtOnNonPartitioned = newMySQLKind("Partition management on a not partitioned table is not possible", 1505, "HY000")
+
+	// ErrPartitionOnlyOnRangeList is returned by ALTER TABLE statements that drop the partitions of a table that isn't
+	// partitioned by RANGE or LIST.
+	ErrPartitionOnlyOnRangeList = newMySQLKind("%s PARTITION can only be used on RANGE/LIST partitions", 1512, "HY000")
+
+	// ErrPartitionNonExistent is returned by ALTER TABLE statements that name a partition the table doesn't have.
+	ErrPartitionNonExistent = newMySQLKind("Error in list of partitions to %s", 1507, "HY000")
+
+	// ErrDropLastPartition is returned by ALTER TABLE statements that drop every partition of a table.
+	ErrDropLastPartition = newMySQLKind("Cannot remove all partitions, use DROP TABLE instead", 1508, "HY000")
+
+	// ErrUnknownPartition is returned when a query selects a partition that its table doesn't have.
+	ErrUnknownPartition = newMySQLKind("Unknown partition '%s' in table '%s'", 1735, "HY000")
+
+	// ErrPartitionClauseOnNonPartitioned is returned when a query selects partitions of a table that isn't
+	// partitioned.
+	ErrPartitionClauseOnNonPartitioned = newMySQLKind("PARTITION () clause on non partitioned table", 1747, "HY000")
+
+	// ErrPartitionRequiresValues is returned when a partition of a table partitioned by RANGE or LIST doesn't define
+	// the values it holds.
+	ErrPartitionRequiresValues = newMySQLKind("Syntax error: %s PARTITIONING requires definition of VALUES %s for each partition", 1479, "HY000")
+
+	// ErrPartitionMaxValue is returned when a partition other than the last one of a table partitioned by RANGE is
+	// bounded by MAXVALUE.
+	ErrPartitionMaxValue = newMySQLKind("MAXVALUE can only be used in last partition definition", 1481, "HY000")
+
+	// ErrPartitionValueNotInt is returned when a partition of a table partitioned by RANGE or LIST is defined by a
+	// value that isn't an integer.
+	ErrPartitionValueNotInt = newMySQLKind("VALUES value for partition '%s' must have type INT", 1697, "HY000")
+
+	// ErrPartitionColumnValueType is returned when a partition of a table partitioned by RANGE COLUMNS or LIST COLUMNS
+	// is defined by a value that doesn't fit the type of the partitioning column.
+	ErrPartitionColumnValueType = newMySQLKind("Partition column values of incorrect type", 1654, "HY000")
+
+	// ErrPartitionFieldType is returned when a table is partitioned by a column of a type its partitioning method
+	// doesn't support.
+	ErrPartitionFieldType = newMySQLKind("Field '%s' is of a not allowed type for this type of partitioning", 1659, "HY000")
+
+	// ErrPartitionWrongCount is returned when the number of partitions of a table doesn't match the partitions it
+	// defines.
+	ErrPartitionWrongCount = newMySQLKind("Wrong number of partitions defined, mismatch with previous setting", 1484, "HY000")
+
+	// ErrPartitionCoalesceOnlyOnHashKey is returned by ALTER TABLE statements that coalesce the partitions of a table
+	// that isn't partitioned by HASH or KEY.
+	ErrPartitionCoalesceOnlyOnHashKey = newMySQLKind("COALESCE PARTITION can only be used on HASH/KEY partitions", 1509, "HY000")
+
 	// ErrViewCreateStatementInvalid is returned when a ViewDatabase returns a CREATE VIEW statement that is invalid
 	ErrViewCreateStatementInvalid = errors.NewKind(`Invalid CREATE VIEW statement: %s`)
 
@@ -662,6 +807,9 @@ var (
 	// ErrBadSpatialIdxCol is thrown when attempting to define a SPATIAL index over a non-geometry column
 	ErrBadSpatialIdxCol = errors.NewKind("a SPATIAL index may only contain a geometrical type column")
 
+	// ErrSpatialIdxSRIDChange is thrown when altering the SRID attribute of a column with a SPATIAL index
+	ErrSpatialIdxSRIDChange = newMySQLKind("The SRID specification on the column '%s' cannot be changed because there is a spatial index on the column.", 3643, "HY000")
+
 	// ErrNoSRID is thrown when attempting to create a Geometry with a non-existent SRID
 	ErrNoSRID = errors.NewKind("There's no spatial reference with SRID %d")
 
@@ -743,14 +891,66 @@ var (
 	// ErrShowGrantsUserDoesNotExist is returned when a user does not exist when attempting to show their grants.
 	ErrShowGrantsUserDoesNotExist = errors.NewKind("There is no such grant defined for user '%s' on host '%s'")
 
+	// ErrRoleNotGranted is returned when a role that isn't granted to a user is activated, or made a default role.
+	ErrRoleNotGranted = newMySQLKind("%s is not granted to %s", 3530, "HY000")
+
+	// ErrRoleGrantedToItself is returned when granting a role would create a cycle in the role graph.
+	ErrRoleGrantedToItself = errors.NewKind("User account %s is directly or indirectly granted to the role %s. The GRANT would create a loop in the role graph.")
+
+	// ErrMandatoryRole is returned when revoking or dropping a role that the mandatory_roles system variable names.
+	ErrMandatoryRole = errors.NewKind("The role %s is a mandatory role and can't be revoked or dropped. The restriction can be lifted by excluding the role identifier from the global variable mandatory_roles.")
+
+	// ErrMustChangePassword is returned when a session in sandbox mode, whose user's password has expired, runs a
+	// statement other than one that sets a new password.
+	ErrMustChangePassword = newMySQLKind("You must reset your password using ALTER USER statement before executing this statement.", 1820, "HY000")
+
+	// ErrPasswordHistory is returned when a new password is one that the password reuse policies of the account don't
+	// allow.
+	ErrPasswordHistory = newMySQLKind("Cannot use these credentials for '%s@%s' because they contradict the password history policy", 3638, "HY000")
+
+	// ErrSetPasswordAuthPlugin is returned by SET PASSWORD for an account whose authentication plugin doesn't store a
+	// password.
+	ErrSetPasswordAuthPlugin = newMySQLKind("SET PASSWORD has no significance for user '%s'@'%s' as the authentication method used doesn't store authentication data in the MySQL server. Please consider using ALTER USER instead if you want to change authentication parameters.", 1699, "HY000")
+
+	// ErrNoSuchDefiner is returned when a view, routine or trigger that runs with the privileges of its definer is used,
+	// but the definer's account doesn't exist.
+	ErrNoSuchDefiner = newMySQLKind("The user specified as a definer ('%s'@'%s') does not exist", 1449, "HY000")
+
+	// ErrSetDefinerDenied is returned when a user that lacks the SUPER and SET_USER_ID privileges names a definer other
+	// than themselves.
+	ErrSetDefinerDenied = newMySQLKind("Access denied; you need (at least one of) the SUPER or SET_USER_ID privilege(s) for this operation", 1227, "42000")
+
+	// ErrInvalidTablePolicy is returned when registering a table policy with an invalid definition, or when the
+	// restriction a policy returns can't be applied.
+	ErrInvalidTablePolicy = errors.NewKind("invalid table policy '%s': %s")
+
+	// ErrTablePolicyExists is returned when registering a table policy whose name is already taken.
+	ErrTablePolicyExists = errors.NewKind("table policy '%s' already exists")
+
+	// ErrTablePolicyNotFound is returned when dropping a table policy that isn't registered.
+	ErrTablePolicyNotFound = errors.NewKind("table policy '%s' does not exist")
+
+	// ErrMaskedColumnRead is returned when an UPDATE or DELETE reads a column that a table policy masks from a table the
+	// statement changes, whose rows are read as they are.
+	ErrMaskedColumnRead = errors.NewKind("column '%s' is masked by table policy '%s' and can't be read by a statement that changes table '%s'")
+
+	// ErrRestrictedTableConflict is returned when a REPLACE or an INSERT ... ON DUPLICATE KEY UPDATE writes a table that
+	// a table policy restricts, as the rows their new rows conflict with may be hidden from the user.
+	ErrRestrictedTableConflict = errors.NewKind("%s can't change table '%s', which is restricted by a table policy")
+
+	// ErrRestrictedTableHandler is returned when a HANDLER statement opens or reads a table that a table policy
+	// restricts, as handlers read the rows of the table without its row filters and column masks.
+	ErrRestrictedTableHandler = errors.NewKind("HANDLER can't read table '%s', which is restricted by a table policy")
+
 	// ErrRecursiveCTEMissingUnion is returned when a recursive CTE is not a UNION or UNION ALL node.
 	ErrRecursiveCTEMissingUnion = errors.NewKind("Recursive Common Table Expression '%s' should contain a UNION")
 
 	// ErrRecursiveCTENotUnion is returned when an INTERSECT or EXCEPT includes a Recursive CTE.
 	ErrRecursiveCTENotUnion = errors.NewKind("Recursive table reference in EXCEPT or INTERSECT operand is not allowed")
 
-	// ErrCteRecursionLimitExceeded is returned when a recursive CTE's execution stack depth exceeds the static limit.
-	ErrCteRecursionLimitExceeded = errors.NewKind("WITH RECURSIVE iteration limit exceeded")
+	// ErrCteRecursionLimitExceeded is returned when a recursive CTE needs more iterations than the
+	// cte_max_recursion_depth system variable allows.
+	ErrCteRecursionLimitExceeded = newMySQLKind("Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.", 3636, "HY000")
 
 	// ErrGrantRevokeIllegalPrivilege is returned when a GRANT or REVOKE statement is malformed, or attempts to use privilege incorrectly.
 	ErrGrantRevokeIllegalPrivilege = errors.NewKind("Illegal GRANT/REVOKE command")
@@ -771,6 +971,9 @@ var (
 	// ErrUnknownWindowName is returned when an over by clause references an unknown window definition
 	ErrUnknownWindowName = errors.NewKind("named window not found: '%s'")
 
+	// ErrDuplicateWindowName is returned when a WINDOW clause defines the same window name more than once
+	ErrDuplicateWindowName = errors.NewKind("window '%s' is defined twice")
+
 	// ErrUnexpectedNilRow is returned when an invalid operation is applied to an empty row
 	ErrUnexpectedNilRow = errors.NewKind("unexpected nil row")
 
@@ -808,6 +1011,9 @@ var (
 	// This matches MySQL ERROR 3837 (HY000).
 	ErrColumnFunctionalIndexDependency = newMySQLKind("Column '%s' has a functional index dependency and cannot be dropped or renamed.", 3837, "HY000")
 
+	// ErrFunctionalIndexPrimaryKey is returned when a primary key has a functional key part.
+	ErrFunctionalIndexPrimaryKey = newMySQLKind("The primary key cannot be a functional index", 3756, "HY000")
+
 	// ErrInvalidIndexPrefix is returned when a prefix index is not valid for the column type,
 	// or the prefix length exceeds the column's character length.
 	ErrInvalidIndexPrefix = newMySQLKind("incorrect prefix key '%s'; the used key part isn't a string, the used length is longer than the key part, or the storage engine doesn't support unique prefix keys", mysql.ERWrongSubKey)
@@ -877,6 +1083,15 @@ var (
 	// ErrValueOutOfRange is returned when a value is out of range for a type.
 	ErrValueOutOfRange = errors.NewKind("%v out of range for %v")
 
+	// ErrValueOutOfRangeForColumn is returned when a value stored in a numeric column is out of range for its type.
+	ErrValueOutOfRangeForColumn = newMySQLKind("Out of range value for column '%s' at row %d", mysql.ERWarnDataOutOfRange, "22003")
+
+	// ErrDataOutOfRange is returned when the result of an integer operation is out of range for its type.
+	ErrDataOutOfRange = newMySQLKind("%s value is out of range in '%s'", mysql.ERDataOutOfRange, "22003")
+
+	// ErrIncorrectDateValueForColumn is returned when a date stored in a column is not allowed by the sql_mode.
+	ErrIncorrectDateValueForColumn = newMySQLKind("Incorrect %s value: '%v' for column '%s' at row %d", mysql.ERTruncatedWrongValue, "22007")
+
 	ErrConvertingToSet   = errors.NewKind("value %v is not valid for this set")
 	ErrDuplicateEntrySet = errors.NewKind("duplicate entry: %v")
 	ErrInvalidSetValue   = errors.NewKind("value %v was not found in the set")
@@ -884,6 +1099,15 @@ var (
 	ErrNotPoint          = errors.NewKind("value of type %T is not a point")
 	ErrNotLineString     = errors.NewKind("value of type %T is not a linestring")
 
+	// ErrTooBigSet is returned when a SET column is defined with more than 64 members.
+	ErrTooBigSet = newMySQLKind("Too many strings for column %s and SET", mysql.ERTooBigSet, "HY000")
+
+	// ErrTooBigEnum is returned when an ENUM column is defined with more than 65535 members.
+	ErrTooBigEnum = newMySQLKind("Too many enumeration values for column %s.", 3504, "HY000")
+
+	// ErrTooLongSetEnumValue is returned when a member of an ENUM or SET column is longer than 255 characters.
+	ErrTooLongSetEnumValue = newMySQLKind("Too long enumeration/set value for column %s.", 3505, "HY000")
+
 	// ErrMergeJoinExpectsComparerFilters is returned when we attempt to build a merge join with an invalid filter.
 	ErrMergeJoinExpectsComparerFilters = errors.NewKind("merge join expects expression.Comparer filters, found: %T")
 
@@ -990,6 +1214,76 @@ var (
 	// ErrStoredGeneratedColumnForeignKeyConflict is returned when a foreign key references a column also referenced by
 	// a stored generated column
 	ErrStoredGeneratedColumnForeignKeyConflict = errors.NewKind("Cannot add foreign key on the base column of a stored generated column.")
+
+	// ErrUnknownTableInHandler is returned when a HANDLER statement names a handler that isn't open.
+	ErrUnknownTableInHandler = newMySQLKind("Unknown table '%s' in HANDLER", mysql.ERUnknownTable, mysql.SSUnknownTable)
+
+	// ErrKeyDoesNotExist is returned when a statement names an index that the table doesn't have.
+	ErrKeyDoesNotExist = newMySQLKind("Key '%s' doesn't exist in table '%s'", mysql.ERKeyDoesNotExist, mysql.SSClientError)
+
+	// ErrRegexpIndexOutOfBounds is returned when the position given to a regular expression function is past the end
+	// of the string it searches.
+	ErrRegexpIndexOutOfBounds = newMySQLKind("Index out of bounds in regular expression search.", 3686, "HY000")
+
+	// ErrRegexpTimeOut is returned when a regular expression search runs for longer than regexp_time_limit allows.
+	ErrRegexpTimeOut = newMySQLKind("Timeout exceeded in regular expression match.", 3699, "HY000")
+
+	// ErrUserDefinedFunctionExists is returned when registering a user-defined function whose name is already taken.
+	ErrUserDefinedFunctionExists = newMySQLKind("Function '%s' already exists", 1125, "HY000")
+
+	// ErrUserDefinedFunctionNotDefined is returned when dropping a user-defined function that isn't registered.
+	ErrUserDefinedFunctionNotDefined = newMySQLKind("Function '%s' is not defined", mysql.ERFunctionNotDefined, "HY000")
+
+	// ErrCantOpenLibrary is returned by CREATE FUNCTION ... SONAME when no factory is registered for the library named.
+	ErrCantOpenLibrary = newMySQLKind("Can't open shared library '%s'", 1126, "HY000")
+
+	// ErrCantFindLibraryFunction is returned by CREATE FUNCTION ... SONAME when the library doesn't provide the function.
+	ErrCantFindLibraryFunction = newMySQLKind("Can't find symbol '%s' in library", 1127, "HY000")
+
+	// ErrInvalidUserDefinedFunction is returned when registering a user-defined function with an invalid definition.
+	ErrInvalidUserDefinedFunction = errors.NewKind("invalid user-defined function '%s': %s")
+
+	// ErrSequencesNotSupported is returned when creating a sequence in a database that doesn't support them.
+	ErrSequencesNotSupported = errors.NewKind("database '%s' doesn't support sequences")
+
+	// ErrUnknownSequence is returned when a statement or function names a sequence that doesn't exist.
+	ErrUnknownSequence = newMySQLKind("Unknown SEQUENCE: '%s'", 4091, "42S02")
+
+	// ErrSequenceRunOut is returned when a sequence that doesn't cycle has generated all of its values.
+	ErrSequenceRunOut = newMySQLKind("Sequence '%s.%s' has run out", 4084, "HY000")
+
+	// ErrSequenceInvalidData is returned when the options of a sequence contradict each other, such as a start value
+	// outside the range of the sequence.
+	ErrSequenceInvalidData = newMySQLKind("Sequence '%s.%s' has out of range value for options", 4085, "HY000")
+
+	// ErrStoredFunctionsNotSupported is returned when attempting to create a stored function on a database that doesn't
+	// support them.
+	ErrStoredFunctionsNotSupported = errors.NewKind(`database "%s" doesn't support stored functions`)
+
+	// ErrStoredFunctionAlreadyExists is returned when a stored function with the same name already exists.
+	ErrStoredFunctionAlreadyExists = newMySQLKind("FUNCTION %s already exists", 1304, "42000")
+
+	// ErrStoredFunctionDoesNotExist is returned when a stored function does not exist.
+	ErrStoredFunctionDoesNotExist = newMySQLKind("FUNCTION %s does not exist", 1305, "42000")
+
+	// ErrStoredFunctionNoReturn is returned when a stored function is created without a RETURN statement.
+	ErrStoredFunctionNoReturn = newMySQLKind("No RETURN found in FUNCTION %s", 1320, "42000")
+
+	// ErrStoredFunctionEndedWithoutReturn is returned when a call to a stored function finishes without running a
+	// RETURN statement.
+	ErrStoredFunctionEndedWithoutReturn = newMySQLKind("FUNCTION %s ended without RETURN", 1321, "2F005")
+
+	// ErrStoredFunctionResultSet is returned when the body of a stored function has a statement that returns rows.
+	ErrStoredFunctionResultSet = newMySQLKind("Not allowed to return a result set from a function", 1415, "0A000")
+
+	// ErrStoredFunctionRecursive is returned when a stored function calls itself, directly or through another function.
+	ErrStoredFunctionRecursive = newMySQLKind("Recursive stored functions and triggers are not allowed.", 1424, "HY000")
+
+	// ErrStoredFunctionArgumentCount is returned when a stored function is called with the wrong number of arguments.
+	ErrStoredFunctionArgumentCount = newMySQLKind("Incorrect number of arguments for FUNCTION %s; expected %d, got %d", 1318, "42000")
+
+	// ErrReturnOutsideFunction is returned when a RETURN statement is used outside of a stored function.
+	ErrReturnOutsideFunction = newMySQLKind("RETURN is only allowed in a FUNCTION", 1313, "42000")
 )
 
 // CastSQLError returns a *mysql.SQLError with the error code and in some cases, also a SQL state, populated for the
@@ -1034,16 +1328,23 @@ func CastSQLError(err error) *mysql.SQLError {
 		code = mysql.ERBadNullError
 	case ErrNonAggregatedColumnWithoutGroupBy.Is(err):
 		code = mysql.ERMixOfGroupFuncAndFields
-	case ErrPrimaryKeyViolation.Is(err):
-		code = mysql.ERDupEntry
-	case ErrUniqueKeyViolation.Is(err):
+	case ErrPrimaryKeyViolation.Is(err), ErrUniqueKeyViolation.Is(err):
 		code = mysql.ERDupEntry
+		sqlState = mysql.SSDupKey
+		// clients parse the key and the duplicate values out of MySQL's message, so it's reproduced when possible
+		if e, ok := err.(*errors.Error); ok {
+			if ue, ok := e.Cause().(UniqueKeyError); ok && ue.Key != "" {
+				return mysql.NewSQLError(code, sqlState, "%s", ue.DuplicateEntryMessage())
+			}
+		}
 	case ErrPartitionNotFound.Is(err):
 		code = 1526 // TODO: Needs to be added to vitess
 	case ErrForeignKeyChildViolation.Is(err):
 		code = mysql.ErNoReferencedRow2 // test with mysql returns 1452 vs 1216
+		sqlState = mysql.SSConstraintViolation
 	case ErrForeignKeyParentViolation.Is(err):
 		code = mysql.ERRowIsReferenced2 // test with mysql returns 1451 vs 1215
+		sqlState = mysql.SSConstraintViolation
 	case ErrDuplicateEntry.Is(err):
 		code = mysql.ERDupEntry
 	case ErrInvalidJSONText.Is(err):
@@ -1134,6 +1435,12 @@ type UniqueKeyError struct {
 	keyStr   string
 	Existing Row
 	IsPK     bool
+	// Table is the name of the table with the violated key, if it's known.
+	Table string
+	// Key is the name of the violated key, if it's known.
+	Key string
+	// Values are the duplicate values of the key's columns, if they're known.
+	Values Row
 }
 
 func NewUniqueKeyErr(keyStr string, isPK bool, existing Row) error {
@@ -1150,10 +1457,55 @@ func NewUniqueKeyErr(keyStr string, isPK bool, existing Row) error {
 	}
 }
 
+// NewDuplicateKeyErr returns the error for an attempt to write the duplicate |values| to the key named |key| of the
+// table named |table|, which conflict with the row |existing|. Unlike NewUniqueKeyErr, the error it returns is reported
+// to clients with MySQL's message for ER_DUP_ENTRY, which names the key and the duplicate values.
+func NewDuplicateKeyErr(table, key string, values Row, isPK bool, existing Row) error {
+	keyStr := make([]string, len(values))
+	for i, v := range values {
+		keyStr[i] = fmt.Sprintf("%v", v)
+	}
+	ue := UniqueKeyError{
+		keyStr:   "[" + strings.Join(keyStr, ",") + "]",
+		IsPK:     isPK,
+		Existing: existing,
+		Table:    table,
+		Key:      key,
+		Values:   values,
+	}
+
+	if isPK {
+		return ErrPrimaryKeyViolation.Wrap(ue)
+	} else {
+		return ErrUniqueKeyViolation.Wrap(ue)
+	}
+}
+
 func (ue UniqueKeyError) Error() string {
 	return fmt.Sprintf("%s", ue.keyStr)
 }
 
+// DuplicateEntryMessage returns the message MySQL returns for the error, such as "Duplicate entry '1-a' for key
+// 't.PRIMARY'".
+func (ue UniqueKeyError) DuplicateEntryMessage() string {
+	vals := make([]string, len(ue.Values))
+	for i, v := range ue.Values {
+		switch v := v.(type) {
+		case nil:
+			vals[i] = "NULL"
+		case []byte:
+			vals[i] = string(v)
+		default:
+			vals[i] = fmt.Sprintf("%v", v)
+		}
+	}
+	key := ue.Key
+	if ue.Table != "" {
+		key = ue.Table + "." + key
+	}
+	return fmt.Sprintf("Duplicate entry '%s' for key '%s'", strings.Join(vals, "-"), key)
+}
+
 type WrappedInsertError struct {
 	Cause        error
 	OffendingRow Row
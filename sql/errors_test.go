@@ -67,3 +67,81 @@ func TestWrappedInsertError(t *testing.T) {
 		})
 	}
 }
+
+func TestConstraintViolationMessages(t *testing.T) {
+	fk := &ForeignKeyConstraint{
+		Name:           "fk_parent",
+		Database:       "mydb",
+		Table:          "child",
+		Columns:        []string{"a", "b"},
+		ParentDatabase: "mydb",
+		ParentTable:    "parent",
+		ParentColumns:  []string{"x", "y"},
+		OnUpdate:       ForeignKeyReferentialAction_DefaultAction,
+		OnDelete:       ForeignKeyReferentialAction_Cascade,
+	}
+	otherDbFk := *fk
+	otherDbFk.ParentDatabase = "otherdb"
+	otherDbFk.OnDelete = ForeignKeyReferentialAction_Restrict
+
+	tests := []struct {
+		name     string
+		err      error
+		code     int
+		sqlState string
+		message  string
+	}{
+		{
+			name:     "duplicate primary key",
+			err:      NewDuplicateKeyErr("t", "PRIMARY", Row{int64(1), "a"}, true, nil),
+			code:     mysql.ERDupEntry,
+			sqlState: mysql.SSDupKey,
+			message:  "Duplicate entry '1-a' for key 't.PRIMARY'",
+		},
+		{
+			name:     "duplicate unique key",
+			err:      NewDuplicateKeyErr("t", "uniq", Row{[]byte("abc")}, false, nil),
+			code:     mysql.ERDupEntry,
+			sqlState: mysql.SSDupKey,
+			message:  "Duplicate entry 'abc' for key 't.uniq'",
+		},
+		{
+			name:     "duplicate key without a name",
+			err:      NewUniqueKeyErr("[1]", true, nil),
+			code:     mysql.ERDupEntry,
+			sqlState: mysql.SSDupKey,
+			message:  "duplicate primary key given: [1]",
+		},
+		{
+			name:     "child row",
+			err:      ErrForeignKeyChildViolation.New(fk.Description()),
+			code:     mysql.ErNoReferencedRow2,
+			sqlState: mysql.SSConstraintViolation,
+			message:  "Cannot add or update a child row: a foreign key constraint fails (`mydb`.`child`, CONSTRAINT `fk_parent` FOREIGN KEY (`a`, `b`) REFERENCES `parent` (`x`, `y`) ON DELETE CASCADE)",
+		},
+		{
+			name:     "parent row in another database",
+			err:      ErrForeignKeyParentViolation.New(otherDbFk.Description()),
+			code:     mysql.ERRowIsReferenced2,
+			sqlState: mysql.SSConstraintViolation,
+			message:  "Cannot delete or update a parent row: a foreign key constraint fails (`mydb`.`child`, CONSTRAINT `fk_parent` FOREIGN KEY (`a`, `b`) REFERENCES `otherdb`.`parent` (`x`, `y`))",
+		},
+		{
+			name:     "cascade depth limit",
+			err:      ErrForeignKeyDepthLimit.New(),
+			code:     3008,
+			sqlState: "HY000",
+			message:  "Foreign key cascade delete/update exceeds max depth of 15.",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CastSQLError(test.err)
+			require.Error(t, err)
+			assert.Equal(t, test.code, err.Number())
+			assert.Equal(t, test.sqlState, err.SQLState())
+			assert.Equal(t, test.message, err.Message)
+		})
+	}
+}
@@ -132,6 +132,22 @@ func (e *Alias) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return e.Child.Eval(ctx, row)
 }
 
+// EvalValue implements the sql.ValueExpression interface.
+func (e *Alias) EvalValue(ctx *sql.Context, row sql.ValueRow) (sql.Value, error) {
+	return e.Child.(sql.ValueExpression).EvalValue(ctx, row)
+}
+
+// EvalBatch implements the sql.BatchExpression interface.
+func (e *Alias) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return sql.EvalBatch(ctx, e.Child, batch)
+}
+
+// IsValueExpression implements the sql.ValueExpression interface.
+func (e *Alias) IsValueExpression(ctx *sql.Context) bool {
+	child, ok := e.Child.(sql.ValueExpression)
+	return ok && child.IsValueExpression(ctx)
+}
+
 // Describe implements the sql.Describable interface
 func (e *Alias) Describe(ctx *sql.Context, options sql.DescribeOptions) string {
 	if options.Debug {
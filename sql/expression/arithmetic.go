@@ -17,6 +17,7 @@ package expression
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -203,11 +204,13 @@ func (a *Arithmetic) getReturnType(ctx *sql.Context) sql.Type {
 		}
 	}
 
-	if types.IsUnsigned(lTyp) && types.IsUnsigned(rTyp) {
-		return types.Uint64
-	}
-
 	if types.IsInteger(lTyp) && types.IsInteger(rTyp) {
+		// The result is unsigned when either operand is unsigned, unless NO_UNSIGNED_SUBTRACTION makes a difference signed
+		if types.IsUnsigned(lTyp) || types.IsUnsigned(rTyp) {
+			if a.Op != sqlparser.MinusStr || ctx == nil || ctx.Session == nil || !sql.LoadSqlMode(ctx).NoUnsignedSubtraction() {
+				return types.Uint64
+			}
+		}
 		return types.Int64
 	}
 
@@ -295,12 +298,45 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	return a.evalValues(ctx, row, a.Type(ctx), lval, rval)
+}
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (a *Arithmetic) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	// intervals and the scale of decimal divisions are evaluated from the row
+	if isInterval(a.LeftChild) || isInterval(a.RightChild) || hasDiv(a) {
+		return sql.EvalBatchRows(ctx, a, batch)
+	}
+	left, err := sql.EvalBatch(ctx, a.LeftChild, batch)
+	if err != nil {
+		return nil, err
+	}
+	right, err := sql.EvalBatch(ctx, a.RightChild, batch)
+	if err != nil {
+		return nil, err
+	}
+	typ := a.Type(ctx)
+	res := make(sql.ColumnVector, batch.Len)
+	for _, i := range batch.Selected() {
+		if res[i], err = a.evalValues(ctx, nil, typ, left[i], right[i]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// evalValues returns the result of the operation on |lval| and |rval|, the values of the children for |row|. |typ| is
+// the type of the expression.
+func (a *Arithmetic) evalValues(ctx *sql.Context, row sql.Row, typ sql.Type, lval, rval interface{}) (interface{}, error) {
 	if lval == nil || rval == nil {
 		return nil, nil
 	}
 
-	lval, rval, err = a.convertLeftRight(ctx, lval, rval)
+	if types.IsInteger(typ) {
+		return a.evalInteger(ctx, typ, lval, rval)
+	}
+
+	lval, rval, err := a.convertLeftRight(ctx, typ, lval, rval)
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +369,114 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return result, nil
 }
 
+// evalInteger returns the result of the operation on |lval| and |rval| as a value of |typ|, which is either BIGINT or
+// BIGINT UNSIGNED. Unlike Go integers, a result that doesn't fit in |typ| is an error rather than wrapping around.
+func (a *Arithmetic) evalInteger(ctx *sql.Context, typ sql.Type, lval, rval interface{}) (interface{}, error) {
+	lNeg, lMag, ok := integerOperand(ctx, a.LeftChild, lval)
+	if !ok {
+		return nil, nil
+	}
+	rNeg, rMag, ok := integerOperand(ctx, a.RightChild, rval)
+	if !ok {
+		return nil, nil
+	}
+
+	var neg, overflow bool
+	var mag uint64
+	switch strings.ToLower(a.Op) {
+	case sqlparser.PlusStr:
+		neg, mag, overflow = addSignMagnitude(lNeg, lMag, rNeg, rMag)
+	case sqlparser.MinusStr:
+		neg, mag, overflow = addSignMagnitude(lNeg, lMag, !rNeg, rMag)
+	case sqlparser.MultStr:
+		var hi uint64
+		hi, mag = bits.Mul64(lMag, rMag)
+		neg, overflow = lNeg != rNeg && mag != 0, hi != 0
+	default:
+		return nil, errUnableToEval.New(lval, a.Op, rval)
+	}
+
+	if types.IsUnsigned(typ) {
+		if overflow || neg {
+			return nil, sql.ErrDataOutOfRange.New("BIGINT UNSIGNED", a.String())
+		}
+		return mag, nil
+	}
+	if overflow || (!neg && mag > math.MaxInt64) || (neg && mag > 1<<63) {
+		return nil, sql.ErrDataOutOfRange.New("BIGINT", a.String())
+	}
+	if neg {
+		return int64(-mag), nil
+	}
+	return int64(mag), nil
+}
+
+// integerOperand returns the sign and magnitude of |val|, the value of the integer expression |child|. Returns false
+// if the value converts to NULL.
+func integerOperand(ctx *sql.Context, child sql.Expression, val interface{}) (neg bool, mag uint64, ok bool) {
+	switch v := val.(type) {
+	case int8:
+		return signMagnitude(int64(v))
+	case int16:
+		return signMagnitude(int64(v))
+	case int32:
+		return signMagnitude(int64(v))
+	case int64:
+		return signMagnitude(v)
+	case int:
+		return signMagnitude(int64(v))
+	case uint8:
+		return false, uint64(v), true
+	case uint16:
+		return false, uint64(v), true
+	case uint32:
+		return false, uint64(v), true
+	case uint64:
+		return false, v, true
+	case uint:
+		return false, uint64(v), true
+	case bool:
+		if v {
+			return false, 1, true
+		}
+		return false, 0, true
+	}
+
+	childTyp := child.Type(ctx)
+	var typ sql.Type = types.Int64
+	if types.IsUnsigned(childTyp) {
+		typ = types.Uint64
+	}
+	switch v := convertValueToType(ctx, typ, val, types.IsTime(childTyp)).(type) {
+	case int64:
+		return signMagnitude(v)
+	case uint64:
+		return false, v, true
+	}
+	return false, 0, false
+}
+
+// signMagnitude returns the sign and magnitude of |v|.
+func signMagnitude(v int64) (bool, uint64, bool) {
+	if v < 0 {
+		return true, uint64(-(v + 1)) + 1, true
+	}
+	return false, uint64(v), true
+}
+
+// addSignMagnitude adds two integers given by their sign and magnitude, reporting whether the magnitude of the sum
+// overflows a uint64.
+func addSignMagnitude(lNeg bool, lMag uint64, rNeg bool, rMag uint64) (neg bool, mag uint64, overflow bool) {
+	if lNeg == rNeg {
+		sum, carry := bits.Add64(lMag, rMag, 0)
+		return lNeg && sum != 0, sum, carry != 0
+	}
+	if lMag >= rMag {
+		return lNeg && lMag != rMag, lMag - rMag, false
+	}
+	return rNeg, rMag - lMag, false
+}
+
 func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	var lval, rval interface{}
 	var err error
@@ -364,8 +508,7 @@ func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{},
 	return lval, rval, nil
 }
 
-func (a *Arithmetic) convertLeftRight(ctx *sql.Context, left interface{}, right interface{}) (interface{}, interface{}, error) {
-	typ := a.Type(ctx)
+func (a *Arithmetic) convertLeftRight(ctx *sql.Context, typ sql.Type, left interface{}, right interface{}) (interface{}, interface{}, error) {
 
 	lIsTimeType := types.IsTime(a.LeftChild.Type(ctx))
 	rIsTimeType := types.IsTime(a.RightChild.Type(ctx))
@@ -395,6 +538,19 @@ func (a *Arithmetic) convertLeftRight(ctx *sql.Context, left interface{}, right
 	return left, right, nil
 }
 
+// hasDiv returns whether |expr| has a division among the operands whose scale getFinalScale considers.
+func hasDiv(expr sql.Expression) bool {
+	switch e := expr.(type) {
+	case *Div:
+		return true
+	case *Arithmetic:
+		return hasDiv(e.LeftChild) || hasDiv(e.RightChild)
+	case *Mod:
+		return hasDiv(e.LeftChild) || hasDiv(e.RightChild)
+	}
+	return false
+}
+
 func isInterval(expr sql.Expression) bool {
 	_, ok := expr.(*Interval)
 	return ok
@@ -468,7 +624,7 @@ func convertValueToType(ctx *sql.Context, typ sql.Type, val interface{}, isTimeT
 	return cval
 }
 
-// convertTimeTypeToString returns string value parsed from either time.Time or string
+// convertTimeTypeToString returns string value parsed from either time.Time, types.DateParts or string
 // representation. all the numbers are parsed up to seconds only. The location can be
 // different between two time.Time values, so we set it to default UTC location before
 // parsing. E.g:
@@ -478,6 +634,10 @@ func convertTimeTypeToString(val interface{}) interface{} {
 	if t, ok := val.(time.Time); ok {
 		val = t.In(time.UTC).Format("2006-01-02 15:04:05")
 	}
+	if d, ok := val.(types.DateParts); ok {
+		d.Microsecond = 0
+		val = d.String()
+	}
 	if t, ok := val.(string); ok {
 		nums := timeTypeRegex.FindAllString(t, -1)
 		val = strings.Join(nums, "")
@@ -544,9 +704,7 @@ func plus(lval, rval interface{}) (interface{}, error) {
 	case *apd.Decimal:
 		switch r := rval.(type) {
 		case *apd.Decimal:
-			res := new(apd.Decimal)
-			_, err := sql.DecimalCtx.Add(res, l, r)
-			return res, err
+			return types.DecimalAdd(l, r)
 		}
 	case time.Time:
 		switch r := rval.(type) {
@@ -623,9 +781,7 @@ func minus(lval, rval interface{}) (interface{}, error) {
 	case *apd.Decimal:
 		switch r := rval.(type) {
 		case *apd.Decimal:
-			res := new(apd.Decimal)
-			_, err := sql.DecimalCtx.Sub(res, l, r)
-			return res, err
+			return types.DecimalSub(l, r)
 		}
 	case time.Time:
 		switch r := rval.(type) {
@@ -694,9 +850,7 @@ func mult(lval, rval interface{}) (interface{}, error) {
 	case *apd.Decimal:
 		switch r := rval.(type) {
 		case *apd.Decimal:
-			res := new(apd.Decimal)
-			_, err := sql.DecimalCtx.Mul(res, l, r)
-			return res, err
+			return types.DecimalMul(l, r)
 		}
 	}
 
@@ -766,7 +920,14 @@ func (e *UnaryMinus) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	case uint32:
 		return -int32(n), nil
 	case uint64:
-		return -int64(n), nil
+		if n > 1<<63 {
+			if _, ok := e.Child.(*Literal); ok {
+				dec := types.DecimalFromUint64(n)
+				return dec.Neg(dec), nil
+			}
+			return nil, sql.ErrDataOutOfRange.New("BIGINT", e.String())
+		}
+		return int64(-n), nil
 	case *apd.Decimal:
 		res := new(apd.Decimal)
 		return res.Neg(n), nil
@@ -532,6 +532,68 @@ func TestMult(t *testing.T) {
 	}
 }
 
+func TestIntegerArithmeticOutOfRange(t *testing.T) {
+	var testCases = []struct {
+		name  string
+		expr  sql.Expression
+		exp   interface{}
+		isErr bool
+	}{
+		{
+			name: "unsigned plus negative",
+			expr: NewPlus(NewLiteral(uint64(5), types.Uint64), NewLiteral(int64(-3), types.Int64)),
+			exp:  uint64(2),
+		},
+		{
+			name: "max unsigned",
+			expr: NewMinus(NewLiteral(uint64(math.MaxUint64), types.Uint64), NewLiteral(int8(0), types.Int8)),
+			exp:  uint64(math.MaxUint64),
+		},
+		{
+			name:  "unsigned overflow",
+			expr:  NewPlus(NewLiteral(uint64(math.MaxUint64), types.Uint64), NewLiteral(int8(1), types.Int8)),
+			isErr: true,
+		},
+		{
+			name:  "unsigned underflow",
+			expr:  NewMinus(NewLiteral(uint64(5), types.Uint64), NewLiteral(int8(10), types.Int8)),
+			isErr: true,
+		},
+		{
+			name:  "unsigned multiplication overflow",
+			expr:  NewMult(NewLiteral(uint64(math.MaxUint64), types.Uint64), NewLiteral(uint64(2), types.Uint64)),
+			isErr: true,
+		},
+		{
+			name:  "signed overflow",
+			expr:  NewPlus(NewLiteral(int64(math.MaxInt64), types.Int64), NewLiteral(int8(1), types.Int8)),
+			isErr: true,
+		},
+		{
+			name: "min signed",
+			expr: NewMinus(NewLiteral(int64(-math.MaxInt64), types.Int64), NewLiteral(int8(1), types.Int8)),
+			exp:  int64(math.MinInt64),
+		},
+		{
+			name:  "signed underflow",
+			expr:  NewMult(NewLiteral(int64(math.MinInt64), types.Int64), NewLiteral(int8(-1), types.Int8)),
+			isErr: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.expr.Eval(sql.NewEmptyContext(), nil)
+			if tt.isErr {
+				require.True(t, sql.ErrDataOutOfRange.Is(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, result)
+		})
+	}
+}
+
 func TestMod(t *testing.T) {
 	// TODO: make this match the others
 	var testCases = []struct {
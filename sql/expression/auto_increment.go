@@ -34,6 +34,17 @@ type AutoIncrement struct {
 	UnaryExpressionStub
 	autoTbl sql.AutoIncrementTable
 	autoCol *sql.Column
+	// reserveCount is the number of values reserved at once from tables implementing sql.AutoIncrementRangeTable
+	reserveCount uint64
+	// reservation holds the values reserved for the current execution of the statement that haven't been used yet
+	reservation *autoIncrementReservation
+}
+
+// autoIncrementReservation is a range of values reserved from an sql.AutoIncrementRangeTable.
+type autoIncrementReservation struct {
+	next      uint64
+	remaining uint64
+	increment uint64
 }
 
 var _ sql.Expression = (*AutoIncrement)(nil)
@@ -58,9 +69,10 @@ func NewAutoIncrement(ctx *sql.Context, table sql.Table, given sql.Expression) (
 	}
 
 	return &AutoIncrement{
-		UnaryExpressionStub{Child: given},
-		autoTbl,
-		autoCol,
+		UnaryExpressionStub: UnaryExpressionStub{Child: given},
+		autoTbl:             autoTbl,
+		autoCol:             autoCol,
+		reserveCount:        1,
 	}, nil
 }
 
@@ -72,9 +84,10 @@ func NewAutoIncrementForColumn(ctx *sql.Context, table sql.Table, autoCol *sql.C
 	}
 
 	return &AutoIncrement{
-		UnaryExpressionStub{Child: given},
-		autoTbl,
-		autoCol,
+		UnaryExpressionStub: UnaryExpressionStub{Child: given},
+		autoTbl:             autoTbl,
+		autoCol:             autoCol,
+		reserveCount:        1,
 	}, nil
 }
 
@@ -128,15 +141,18 @@ func (i *AutoIncrement) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		given = nil
 	}
 
-	// Update integrator AUTO_INCREMENT sequence with our value
-	seq, err := i.autoTbl.GetNextAutoIncrementValue(ctx, given)
-	if err != nil {
-		return nil, err
-	}
-
-	// Use sequence value if NULL or 0 were provided
 	if given == nil {
+		// Use the next value of the sequence if NULL or 0 were provided
+		seq, err := i.nextValue(ctx)
+		if err != nil {
+			return nil, err
+		}
 		given = seq
+	} else {
+		// Update integrator AUTO_INCREMENT sequence with our value
+		if _, err = i.autoTbl.GetNextAutoIncrementValue(ctx, given); err != nil {
+			return nil, err
+		}
 	}
 
 	ret, inRange, err := i.Type(ctx).Convert(ctx, given)
@@ -149,6 +165,82 @@ func (i *AutoIncrement) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	return ret, nil
 }
 
+// nextValue returns the next value of the table's AUTO_INCREMENT sequence, following the series defined by the
+// auto_increment_increment and auto_increment_offset system variables.
+func (i *AutoIncrement) nextValue(ctx *sql.Context) (uint64, error) {
+	increment, offset, err := autoIncrementSeries(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rangeTbl, ok := i.autoTbl.(sql.AutoIncrementRangeTable)
+	if !ok {
+		seq, err := i.autoTbl.GetNextAutoIncrementValue(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		return sql.NextAutoIncrementInSeries(seq, increment, offset), nil
+	}
+
+	r := i.reservation
+	if r == nil {
+		r = &autoIncrementReservation{}
+	}
+	if r.remaining == 0 || r.increment != increment {
+		count := i.reserveCount
+		if count == 0 || i.reservation == nil {
+			count = 1
+		}
+		first, err := rangeTbl.ReserveAutoIncrementRange(ctx, count, increment, offset)
+		if err != nil {
+			return 0, err
+		}
+		*r = autoIncrementReservation{next: first, remaining: count, increment: increment}
+	}
+	val := r.next
+	r.next += increment
+	r.remaining--
+	return val, nil
+}
+
+// autoIncrementSeries returns the session values of auto_increment_increment and auto_increment_offset.
+func autoIncrementSeries(ctx *sql.Context) (increment uint64, offset uint64, err error) {
+	inc, err := ctx.GetSessionVariable(ctx, "auto_increment_increment")
+	if err != nil {
+		return 0, 0, err
+	}
+	off, err := ctx.GetSessionVariable(ctx, "auto_increment_offset")
+	if err != nil {
+		return 0, 0, err
+	}
+	incVal, ok := inc.(int64)
+	if !ok || incVal < 1 {
+		incVal = 1
+	}
+	offVal, ok := off.(int64)
+	if !ok || offVal < 1 {
+		offVal = 1
+	}
+	return uint64(incVal), uint64(offVal), nil
+}
+
+// WithReserveCount returns a copy of this expression that reserves |count| values at once from tables implementing
+// sql.AutoIncrementRangeTable. This should only be more than one when every row inserted by the statement generates a
+// value, so that no reserved values go unused.
+func (i *AutoIncrement) WithReserveCount(count uint64) *AutoIncrement {
+	ni := *i
+	ni.reserveCount = count
+	return &ni
+}
+
+// WithNewReservation returns a copy of this expression with no values reserved, to be used for a single execution
+// of its statement. Without a reservation, values are reserved from sql.AutoIncrementRangeTable one at a time.
+func (i *AutoIncrement) WithNewReservation() *AutoIncrement {
+	ni := *i
+	ni.reservation = &autoIncrementReservation{}
+	return &ni
+}
+
 func (i *AutoIncrement) String() string {
 	return fmt.Sprintf("AutoIncrement(%s)", i.Child.String())
 }
@@ -158,11 +250,9 @@ func (i *AutoIncrement) WithChildren(ctx *sql.Context, children ...sql.Expressio
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
 	}
-	return &AutoIncrement{
-		UnaryExpressionStub{Child: children[0]},
-		i.autoTbl,
-		i.autoCol,
-	}, nil
+	ni := *i
+	ni.Child = children[0]
+	return &ni, nil
 }
 
 // Children implements the Expression interface.
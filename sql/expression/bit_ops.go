@@ -166,10 +166,13 @@ func (b *BitOp) convertLeftRight(ctx *sql.Context, left interface{}, right inter
 
 	if types.IsText(lTyp) || types.IsText(rTyp) {
 		typ = types.Float64
-	} else if types.IsUnsigned(lTyp) && types.IsUnsigned(rTyp) {
+	} else if isUnsignedBitOperand(lTyp) && isUnsignedBitOperand(rTyp) {
 		typ = types.Uint64
 	} else if types.IsSigned(lTyp) && types.IsSigned(rTyp) {
 		typ = types.Int64
+	} else if isIntegerBitOperand(lTyp) && isIntegerBitOperand(rTyp) {
+		// Mixing signed and unsigned operands, so the signed one is used as its two's complement
+		return convertToUintBitOperand(ctx, lTyp, left), convertToUintBitOperand(ctx, rTyp, right), nil
 	} else {
 		typ = types.Float64
 	}
@@ -180,6 +183,28 @@ func (b *BitOp) convertLeftRight(ctx *sql.Context, left interface{}, right inter
 	return left, right, nil
 }
 
+// isUnsignedBitOperand returns whether values of |t| can be used as unsigned integers in bit operations without
+// conversion. BIT values are unsigned integers of up to 64 bits.
+func isUnsignedBitOperand(t sql.Type) bool {
+	return types.IsUnsigned(t) || types.IsBit(t)
+}
+
+// isIntegerBitOperand returns whether values of |t| are integers, signed or not.
+func isIntegerBitOperand(t sql.Type) bool {
+	return isUnsignedBitOperand(t) || types.IsSigned(t)
+}
+
+// convertToUintBitOperand converts |val| of the integer type |t| to a uint64.
+func convertToUintBitOperand(ctx *sql.Context, t sql.Type, val interface{}) interface{} {
+	if isUnsignedBitOperand(t) {
+		return convertValueToType(ctx, types.Uint64, val, false)
+	}
+	if i, ok := convertValueToType(ctx, types.Int64, val, false).(int64); ok {
+		return convertUintFromInt(i)
+	}
+	return nil
+}
+
 // convertUintFromInt returns any int64 value converted to uint64 value
 // including negative numbers. Mysql does not return negative result on
 // bit arithmetic operations, so all results are returned in uint64 type.
@@ -395,3 +420,79 @@ func shiftRight(lval, rval interface{}) (interface{}, error) {
 
 	return nil, errUnableToCast.New(lval, rval)
 }
+
+// BitNot is the bit inversion operator (~). Like the other bit operations it returns an unsigned 64-bit integer.
+// https://dev.mysql.com/doc/refman/8.0/en/bit-functions.html#operator_bitwise-invert
+type BitNot struct {
+	UnaryExpressionStub
+}
+
+var _ sql.Expression = (*BitNot)(nil)
+var _ sql.CollationCoercible = (*BitNot)(nil)
+
+// NewBitNot creates a new BitNot sql.Expression.
+func NewBitNot(child sql.Expression) *BitNot {
+	return &BitNot{UnaryExpressionStub{Child: child}}
+}
+
+func (b *BitNot) String() string {
+	return fmt.Sprintf("~%s", b.Child)
+}
+
+func (b *BitNot) DebugString(ctx *sql.Context) string {
+	return fmt.Sprintf("~%s", sql.DebugString(ctx, b.Child))
+}
+
+// Type implements the sql.Expression interface.
+func (b *BitNot) Type(ctx *sql.Context) sql.Type {
+	if typ := b.Child.Type(ctx); types.IsDeferredType(typ) {
+		return typ
+	}
+	return types.Uint64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*BitNot) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitNot) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitNot(children[0]), nil
+}
+
+// Eval implements the Expression interface.
+func (b *BitNot) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := b.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	childTyp := b.Child.Type(ctx)
+	var typ sql.Type
+	if isUnsignedBitOperand(childTyp) {
+		typ = types.Uint64
+	} else if types.IsSigned(childTyp) {
+		typ = types.Int64
+	} else {
+		typ = types.Float64
+	}
+
+	switch v := convertValueToType(ctx, typ, val, types.IsTime(childTyp)).(type) {
+	case uint64:
+		return ^v, nil
+	case int64:
+		return ^convertUintFromInt(v), nil
+	case float64:
+		return ^convertUintFromInt(int64(math.Round(v))), nil
+	default:
+		// values that can't be converted are treated as 0
+		return ^uint64(0), nil
+	}
+}
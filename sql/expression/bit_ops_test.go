@@ -167,6 +167,32 @@ func TestShiftRight(t *testing.T) {
 	}
 }
 
+func TestBitNot(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		val      interface{}
+		typ      sql.Type
+		expected interface{}
+	}{
+		{"~0", 0, types.Int64, uint64(18446744073709551615)},
+		{"~1", 1, types.Uint64, uint64(18446744073709551614)},
+		{"~-1", -1, types.Int64, uint64(0)},
+		{"~1.5", 1.5, types.Float64, uint64(18446744073709551613)},
+		{"~'3'", "3", types.Text, uint64(18446744073709551612)},
+		{"~b'101'", uint64(5), types.MustCreateBitType(3), uint64(18446744073709551610)},
+		{"~NULL", nil, types.Null, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			result, err := NewBitNot(NewLiteral(tt.val, tt.typ)).Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
 func TestAllUint64(t *testing.T) {
 	var testCases = []struct {
 		op        string
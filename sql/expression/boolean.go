@@ -68,6 +68,30 @@ func (e *Not) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return !b, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (e *Not) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	vals, err := sql.EvalBatch(ctx, e.Child, batch)
+	if err != nil {
+		return nil, err
+	}
+	res := make(sql.ColumnVector, batch.Len)
+	for _, i := range batch.Selected() {
+		v := vals[i]
+		if v == nil {
+			continue
+		}
+		b, ok := v.(bool)
+		if !ok {
+			b, err = sql.ConvertToBool(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+		}
+		res[i] = !b
+	}
+	return res, nil
+}
+
 func (e *Not) String() string {
 	return fmt.Sprintf("(NOT(%s))", e.Child)
 }
@@ -16,6 +16,7 @@ package expression
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -124,7 +125,12 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 	if err != nil {
 		return 0, err
 	}
+	return c.compareValues(ctx, left, right)
+}
 
+// compareValues compares |left| and |right|, the values of the children of the comparison.
+func (c *comparison) compareValues(ctx *sql.Context, left, right interface{}) (int, error) {
+	var err error
 	if left == nil || right == nil {
 		return 0, ErrNilOperand.New()
 	}
@@ -173,7 +179,7 @@ func (c *comparison) CompareValue(ctx *sql.Context, row sql.ValueRow) (int, erro
 	}
 
 	if lv.IsNull() || rv.IsNull() {
-		return 0, nil
+		return 0, ErrNilOperand.New()
 	}
 
 	lTyp, rTyp := c.LeftChild.Type(ctx).(sql.ValueType), c.RightChild.Type(ctx).(sql.ValueType)
@@ -197,6 +203,31 @@ func (c *comparison) CompareValue(ctx *sql.Context, row sql.ValueRow) (int, erro
 	return lTyp.CompareValue(ctx, lv, rv)
 }
 
+// evalBatch compares the children for the selected rows of |batch|, returning |result| of each comparison, or NULL for
+// rows with a NULL operand.
+func (c *comparison) evalBatch(ctx *sql.Context, batch *sql.RowBatch, result func(cmp int) bool) (sql.ColumnVector, error) {
+	left, err := sql.EvalBatch(ctx, c.Left(), batch)
+	if err != nil {
+		return nil, err
+	}
+	right, err := sql.EvalBatch(ctx, c.Right(), batch)
+	if err != nil {
+		return nil, err
+	}
+	res := make(sql.ColumnVector, batch.Len)
+	for _, i := range batch.Selected() {
+		cmp, err := c.compareValues(ctx, left[i], right[i])
+		if err != nil {
+			if ErrNilOperand.Is(err) {
+				continue
+			}
+			return nil, err
+		}
+		res[i] = result(cmp)
+	}
+	return res, nil
+}
+
 // IsValueExpression returns whether every child supports sql.ValueExpression
 func (c *comparison) IsValueExpression(ctx *sql.Context) bool {
 	l, ok := c.LeftChild.(sql.ValueExpression)
@@ -276,6 +307,14 @@ func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right any) (any, a
 	}
 
 	if types.IsTime(lTyp) || types.IsTime(rTyp) {
+		if l, r, compareType, ok := castTemporalAndNumber(ctx, left, right, lTyp, rTyp); ok {
+			return l, r, compareType, nil
+		}
+		if types.IsDatePartsValue(left) || types.IsDatePartsValue(right) {
+			// dates that a time.Time can't hold are compared by their parts, which the datetime type reads from the
+			// other side
+			return left, right, types.DatetimeMaxPrecision, nil
+		}
 		l, err := convertValue(ctx, left, ConvertToDatetime, lTyp, types.MaxDatetimePrecision, 0)
 		if err != nil {
 			return nil, nil, nil, err
@@ -287,6 +326,15 @@ func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right any) (any, a
 		return l, r, types.DatetimeMaxPrecision, nil
 	}
 
+	// UUIDs are compared as UUIDs when the other side converts to one, and otherwise by their text
+	if types.IsUuid(lTyp) || types.IsUuid(rTyp) {
+		l, _, lErr := types.Uuid.Convert(ctx, left)
+		r, _, rErr := types.Uuid.Convert(ctx, right)
+		if lErr == nil && rErr == nil {
+			return l, r, types.Uuid, nil
+		}
+	}
+
 	// Rely on types.JSON.Compare to handle JSON comparisons
 	if types.IsJSON(lTyp) || types.IsJSON(rTyp) {
 		return left, right, types.JSON, nil
@@ -305,72 +353,16 @@ func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right any) (any, a
 	}
 
 	if types.IsNumber(lTyp) || types.IsNumber(rTyp) {
-		if types.IsDecimal(lTyp) || types.IsDecimal(rTyp) {
-			// TODO: We need to set to the actual DECIMAL type
-			l, err := convertValue(ctx, left, ConvertToDecimal, lTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			r, err := convertValue(ctx, right, ConvertToDecimal, rTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			if types.IsDecimal(lTyp) {
-				return l, r, lTyp, nil
-			} else {
-				return l, r, rTyp, nil
-			}
-		}
-
-		if types.IsFloat(lTyp) || types.IsFloat(rTyp) {
-			l, err := convertValue(ctx, left, ConvertToDouble, lTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			r, err := convertValue(ctx, right, ConvertToDouble, rTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			if err != nil {
-				return nil, nil, nil, err
-			}
-
-			return l, r, types.Float64, nil
-		}
-
-		if types.IsSigned(lTyp) && types.IsSigned(rTyp) {
-			l, err := convertValue(ctx, left, ConvertToSigned, lTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			r, err := convertValue(ctx, right, ConvertToSigned, rTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			return l, r, types.Int64, nil
-		}
-
-		if types.IsUnsigned(lTyp) && types.IsUnsigned(rTyp) {
-			l, err := convertValue(ctx, left, ConvertToUnsigned, lTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			r, err := convertValue(ctx, right, ConvertToUnsigned, rTyp, 0, 0)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			return l, r, types.Uint64, nil
-		}
-
-		l, err := convertValue(ctx, left, ConvertToDouble, lTyp, 0, 0)
+		castTo, compareType := numericComparisonType(lTyp, rTyp)
+		l, err := convertValue(ctx, left, castTo, lTyp, 0, 0)
 		if err != nil {
 			return nil, nil, nil, err
 		}
-		r, err := convertValue(ctx, right, ConvertToDouble, rTyp, 0, 0)
+		r, err := convertValue(ctx, right, castTo, rTyp, 0, 0)
 		if err != nil {
 			return nil, nil, nil, err
 		}
-		return l, r, types.Float64, nil
+		return l, r, compareType, nil
 	}
 
 	l, err := convertValue(ctx, left, ConvertToChar, lTyp, 0, 0)
@@ -384,6 +376,68 @@ func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right any) (any, a
 	return l, r, types.LongText, nil
 }
 
+// isIntegerComparand returns whether values of |t| are compared as integers with other integers.
+func isIntegerComparand(t sql.Type) bool {
+	return types.IsInteger(t) || types.IsBit(t) || types.IsYear(t)
+}
+
+// numericComparisonType returns what values of |l| and |r| are cast to, and the type they are then compared with,
+// when at least one of them is a number. Following MySQL, integers of the same signedness are compared as integers,
+// integers of mixed signedness and decimals as decimals, and everything else, including strings, as doubles.
+// https://dev.mysql.com/doc/refman/8.0/en/type-conversion.html
+func numericComparisonType(l, r sql.Type) (string, sql.Type) {
+	lInt, rInt := isIntegerComparand(l), isIntegerComparand(r)
+	lUnsigned, rUnsigned := types.IsUnsigned(l) || types.IsBit(l), types.IsUnsigned(r) || types.IsBit(r)
+	switch {
+	case lInt && rInt && lUnsigned && rUnsigned:
+		return ConvertToUnsigned, types.Uint64
+	case lInt && rInt && !lUnsigned && !rUnsigned:
+		return ConvertToSigned, types.Int64
+	case (lInt || types.IsDecimal(l)) && (rInt || types.IsDecimal(r)):
+		return ConvertToDecimal, types.InternalDecimalType
+	default:
+		return ConvertToDouble, types.Float64
+	}
+}
+
+// castTemporalAndNumber converts |left| and |right| for the comparison of a DATE, DATETIME or TIMESTAMP with a number.
+// The number is read as a date when it's written as one, such as 20200101, and otherwise the date is compared as the
+// number it's written as. It returns false if the comparison isn't between a date and a number.
+func castTemporalAndNumber(ctx *sql.Context, left, right interface{}, lTyp, rTyp sql.Type) (interface{}, interface{}, sql.Type, bool) {
+	swapped := false
+	if types.IsTime(rTyp) {
+		left, right, lTyp, rTyp = right, left, rTyp, lTyp
+		swapped = true
+	}
+	if !types.IsTime(lTyp) || !types.IsNumber(rTyp) {
+		return nil, nil, nil, false
+	}
+	l, _, err := types.DatetimeMaxPrecision.Convert(ctx, left)
+	if err != nil {
+		return nil, nil, nil, false
+	}
+	t, ok := l.(time.Time)
+	if !ok {
+		return nil, nil, nil, false
+	}
+
+	var r interface{}
+	var compareType sql.Type
+	if d, ok := types.NumberToDatetime(right); ok {
+		l, r, compareType = t, d, types.DatetimeMaxPrecision
+	} else {
+		r, _, err = types.Float64.Convert(ctx, right)
+		if err != nil {
+			return nil, nil, nil, false
+		}
+		l, compareType = types.DatetimeToFloat(t, lTyp), types.Float64
+	}
+	if swapped {
+		return r, l, compareType, true
+	}
+	return l, r, compareType, true
+}
+
 // Type implements the Expression interface.
 func (*comparison) Type(ctx *sql.Context) sql.Type {
 	return types.Boolean
@@ -436,6 +490,13 @@ func (e *Equals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return result == 0, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (e *Equals) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return e.evalBatch(ctx, batch, func(cmp int) bool {
+		return cmp == 0
+	})
+}
+
 // WithChildren implements the Expression interface.
 func (e *Equals) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -594,6 +655,13 @@ func (gt *GreaterThan) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	return result == 1, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (gt *GreaterThan) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return gt.evalBatch(ctx, batch, func(cmp int) bool {
+		return cmp == 1
+	})
+}
+
 // WithChildren implements the Expression interface.
 func (gt *GreaterThan) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -618,6 +686,9 @@ func (gt *GreaterThan) DebugString(ctx *sql.Context) string {
 func (gt *GreaterThan) EvalValue(ctx *sql.Context, row sql.ValueRow) (sql.Value, error) {
 	cmp, err := gt.CompareValue(ctx, row)
 	if err != nil {
+		if ErrNilOperand.Is(err) {
+			return sql.NullValue, nil
+		}
 		return sql.NullValue, err
 	}
 	if cmp != 1 {
@@ -661,6 +732,13 @@ func (lt *LessThan) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return result == -1, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (lt *LessThan) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return lt.evalBatch(ctx, batch, func(cmp int) bool {
+		return cmp == -1
+	})
+}
+
 // WithChildren implements the Expression interface.
 func (lt *LessThan) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -685,6 +763,9 @@ func (lt *LessThan) DebugString(ctx *sql.Context) string {
 func (lt *LessThan) EvalValue(ctx *sql.Context, row sql.ValueRow) (sql.Value, error) {
 	cmp, err := lt.CompareValue(ctx, row)
 	if err != nil {
+		if ErrNilOperand.Is(err) {
+			return sql.NullValue, nil
+		}
 		return sql.NullValue, err
 	}
 	if cmp != -1 {
@@ -729,6 +810,13 @@ func (gte *GreaterThanOrEqual) Eval(ctx *sql.Context, row sql.Row) (interface{},
 	return result > -1, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (gte *GreaterThanOrEqual) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return gte.evalBatch(ctx, batch, func(cmp int) bool {
+		return cmp > -1
+	})
+}
+
 // WithChildren implements the Expression interface.
 func (gte *GreaterThanOrEqual) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -753,6 +841,9 @@ func (gte *GreaterThanOrEqual) DebugString(ctx *sql.Context) string {
 func (gte *GreaterThanOrEqual) EvalValue(ctx *sql.Context, row sql.ValueRow) (sql.Value, error) {
 	cmp, err := gte.CompareValue(ctx, row)
 	if err != nil {
+		if ErrNilOperand.Is(err) {
+			return sql.NullValue, nil
+		}
 		return sql.NullValue, err
 	}
 	if cmp == -1 {
@@ -799,6 +890,13 @@ func (lte *LessThanOrEqual) Eval(ctx *sql.Context, row sql.Row) (interface{}, er
 	return result < 1, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (lte *LessThanOrEqual) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return lte.evalBatch(ctx, batch, func(cmp int) bool {
+		return cmp < 1
+	})
+}
+
 // WithChildren implements the Expression interface.
 func (lte *LessThanOrEqual) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -823,6 +921,9 @@ func (lte *LessThanOrEqual) DebugString(ctx *sql.Context) string {
 func (lte *LessThanOrEqual) EvalValue(ctx *sql.Context, row sql.ValueRow) (sql.Value, error) {
 	cmp, err := lte.CompareValue(ctx, row)
 	if err != nil {
+		if ErrNilOperand.Is(err) {
+			return sql.NullValue, nil
+		}
 		return sql.NullValue, err
 	}
 	if cmp == 1 {
@@ -16,8 +16,12 @@ package expression
 
 import (
 	"encoding/binary"
+	"fmt"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/apd/v3"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
@@ -219,6 +223,61 @@ func TestGreaterThan(t *testing.T) {
 	}
 }
 
+func TestMixedTypeComparison(t *testing.T) {
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	datetime := time.Date(2020, 1, 1, 12, 34, 56, 0, time.UTC)
+	dec := func(s string) *apd.Decimal {
+		d, _, err := apd.NewFromString(s)
+		require.NoError(t, err)
+		return d
+	}
+
+	tests := []struct {
+		left     *Literal
+		right    *Literal
+		expected int
+	}{
+		// signed and unsigned integers are compared without losing precision
+		{NewLiteral(uint64(math.MaxUint64), types.Uint64), NewLiteral(int64(-1), types.Int64), 1},
+		{NewLiteral(uint64(math.MaxInt64), types.Uint64), NewLiteral(int64(math.MaxInt64-1), types.Int64), 1},
+		{NewLiteral(int64(math.MaxInt64), types.Int64), NewLiteral(int64(math.MaxInt64-1), types.Int64), 1},
+		{NewLiteral(uint64(1), types.MustCreateBitType(8)), NewLiteral(int64(-1), types.Int64), 1},
+		// integers and decimals are compared as decimals
+		{NewLiteral(int64(1), types.Int64), NewLiteral(dec("1.0"), types.MustCreateDecimalType(2, 1)), 0},
+		{NewLiteral(uint64(math.MaxUint64), types.Uint64), NewLiteral(dec("18446744073709551614.5"), types.MustCreateDecimalType(21, 1)), 1},
+		// strings and numbers are compared as doubles
+		{NewLiteral("1abc", types.LongText), NewLiteral(int64(1), types.Int64), 0},
+		{NewLiteral("123.456", types.LongText), NewLiteral(dec("123.456"), types.MustCreateDecimalType(6, 3)), 0},
+		{NewLiteral("1e2", types.LongText), NewLiteral(float64(100), types.Float64), 0},
+		{NewLiteral("abc", types.LongText), NewLiteral(int64(0), types.Int64), 0},
+		// numbers that are valid dates are compared as dates
+		{NewLiteral(date, types.Date), NewLiteral(int64(20200101), types.Int64), 0},
+		{NewLiteral(date, types.Date), NewLiteral(int64(200101), types.Int64), 0},
+		{NewLiteral(datetime, types.DatetimeMaxPrecision), NewLiteral(int64(20200101123456), types.Int64), 0},
+		{NewLiteral(datetime, types.DatetimeMaxPrecision), NewLiteral(int64(20200101), types.Int64), 1},
+		// other numbers are compared with the temporal value as a double
+		{NewLiteral(date, types.Date), NewLiteral(int64(2), types.Int64), 1},
+		{NewLiteral(date, types.Date), NewLiteral(float64(20200101.5), types.Float64), -1},
+		{NewLiteral(datetime, types.DatetimeMaxPrecision), NewLiteral(int64(20200101123457), types.Int64), -1},
+	}
+
+	ctx := sql.NewEmptyContext()
+	for _, test := range tests {
+		name := fmt.Sprintf("%v(%s) <=> %v(%s)", test.left.Value(), test.left.Type(ctx), test.right.Value(), test.right.Type(ctx))
+		t.Run(name, func(t *testing.T) {
+			cmp := newComparison(test.left, test.right)
+			res, err := cmp.Compare(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+
+			cmp = newComparison(test.right, test.left)
+			res, err = cmp.Compare(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, -test.expected, res)
+		})
+	}
+}
+
 func TestValueComparison(t *testing.T) {
 	t.Skip("TODO: write tests for comparison between sql.Values")
 }
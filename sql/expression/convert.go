@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -77,6 +78,9 @@ type Convert struct {
 	typeLength int
 	// typeScale is the optional scale parameter for types that support it (e.g. "decimal(10, 2)")
 	typeScale int
+	// charset is the optional character set for conversions to char (e.g. "char character set latin1"). Without it,
+	// strings are converted to the connection character set.
+	charset sql.CharacterSetID
 }
 
 var _ sql.Expression = (*Convert)(nil)
@@ -106,6 +110,15 @@ func NewConvertWithLengthAndScale(expr sql.Expression, castToType string, typeLe
 	}
 }
 
+// NewConvertWithCharacterSet creates a new Convert expression that will attempt to convert |expr| into the
+// |castToType| type, with |typeLength| specifying a length constraint of the converted type, and |charset| specifying
+// the character set of the string it is converted to.
+func NewConvertWithCharacterSet(expr sql.Expression, castToType string, typeLength int, charset sql.CharacterSetID) *Convert {
+	c := NewConvertWithLengthAndScale(expr, castToType, typeLength, 0)
+	c.charset = charset
+	return c
+}
+
 // GetConvertToType returns which type the both left and right values should be converted to.
 // If neither sql.Type represent number, then converted to string. Otherwise, we try to get
 // the appropriate type to avoid any precision loss.
@@ -160,7 +173,7 @@ func (c *Convert) Type(ctx *sql.Context) sql.Type {
 	case ConvertToBinary:
 		return types.LongBlob
 	case ConvertToChar, ConvertToNChar:
-		return types.LongText
+		return types.CreateLongText(c.collation(ctx))
 	case ConvertToDate:
 		return types.Date
 	case ConvertToDatetime:
@@ -179,7 +192,7 @@ func (c *Convert) Type(ctx *sql.Context) sql.Type {
 	case ConvertToSigned:
 		return types.Int64
 	case ConvertToTime:
-		return types.Time
+		return types.MustCreateTimeType(c.typeLength)
 	case ConvertToUnsigned:
 		return types.Uint64
 	case ConvertToYear:
@@ -195,7 +208,7 @@ func (c *Convert) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 	case ConvertToBinary:
 		return sql.Collation_binary, 2
 	case ConvertToChar, ConvertToNChar:
-		return ctx.GetCollation(), 2
+		return c.collation(ctx), 2
 	case ConvertToDate:
 		return sql.Collation_binary, 5
 	case ConvertToDatetime:
@@ -219,6 +232,20 @@ func (c *Convert) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 	}
 }
 
+// collation returns the collation of the strings produced by a conversion to char, which is the default collation of
+// the character set given to the conversion, or the connection collation when there isn't one.
+func (c *Convert) collation(ctx *sql.Context) sql.CollationID {
+	if c.charset != sql.CharacterSet_Unspecified {
+		return c.charset.DefaultCollation()
+	}
+	if ctx != nil && ctx.Session != nil {
+		if collation := ctx.GetCollation(); collation != sql.Collation_Unspecified {
+			return collation
+		}
+	}
+	return sql.Collation_Default
+}
+
 // String implements the Stringer interface.
 func (c *Convert) String() string {
 	extraTypeInfo := ""
@@ -229,6 +256,9 @@ func (c *Convert) String() string {
 			extraTypeInfo = fmt.Sprintf("(%d)", c.typeLength)
 		}
 	}
+	if c.charset != sql.CharacterSet_Unspecified {
+		extraTypeInfo += fmt.Sprintf(" character set %s", c.charset.Name())
+	}
 	return fmt.Sprintf("convert(%v, %v%s)", c.Child, c.castToType, extraTypeInfo)
 }
 
@@ -248,6 +278,10 @@ func (c *Convert) DebugString(ctx *sql.Context) string {
 		children = append(children, fmt.Sprintf("typeScale: %v", c.typeScale))
 	}
 
+	if c.charset != sql.CharacterSet_Unspecified {
+		children = append(children, fmt.Sprintf("charset: %v", c.charset.Name()))
+	}
+
 	children = append(children, sql.DebugString(ctx, c.Child))
 
 	_ = pr.WriteChildren(children...)
@@ -259,7 +293,9 @@ func (c *Convert) WithChildren(ctx *sql.Context, children ...sql.Expression) (sq
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
 	}
-	return NewConvertWithLengthAndScale(children[0], c.castToType, c.typeLength, c.typeScale), nil
+	n := NewConvertWithLengthAndScale(children[0], c.castToType, c.typeLength, c.typeScale)
+	n.charset = c.charset
+	return n, nil
 }
 
 // Eval implements the Expression interface.
@@ -273,6 +309,10 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if c.castToType == ConvertToChar || c.castToType == ConvertToNChar {
+		return c.convertToChar(ctx, val)
+	}
+
 	// Should always return nil, and a warning instead
 	casted, err := convertValue(ctx, val, c.castToType, c.Child.Type(ctx), c.typeLength, c.typeScale)
 	if err != nil {
@@ -286,6 +326,42 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return casted, nil
 }
 
+// convertToChar converts |val| to a string in the collation of this conversion. Binary strings are interpreted as
+// holding a string of its character set, and converting ones that aren't valid in it returns NULL with a warning.
+func (c *Convert) convertToChar(ctx *sql.Context, val any) (any, error) {
+	typ := c.Type(ctx).(sql.StringType)
+	charset := typ.CharacterSet()
+	if b, ok := val.([]byte); ok && charset != sql.CharacterSet_utf8mb4 && charset != sql.CharacterSet_binary {
+		decoded, ok := charset.Encoder().Decode(b)
+		if !ok {
+			ctx.Warn(mysql.ERInvalidCharacterString, "Invalid %s character string: '%X'", charset.Name(), b)
+			return nil, nil
+		}
+		val = string(decoded)
+	}
+	s, _, err := types.TypeAwareConversion(ctx, val, c.Child.Type(ctx), typ)
+	if err != nil {
+		if types.ErrBadCharsetString.Is(err) {
+			ctx.Warn(mysql.ERInvalidCharacterString, "Invalid %s character string: '%X'", charset.Name(), val)
+		}
+		return nil, nil
+	}
+	if str, ok := s.(string); ok && c.typeLength > 0 && utf8.RuneCountInString(str) > c.typeLength {
+		ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect CHAR(%d) value: '%s'", c.typeLength, str)
+	}
+	return truncateConvertedValue(s, c.typeLength)
+}
+
+// checkZeroDate returns |d|, which |val| was cast to, unless it is the zero date and NO_ZERO_DATE is enabled, in which
+// case the cast returns NULL with a warning.
+func checkZeroDate(ctx *sql.Context, val any, d any) any {
+	if t, ok := d.(time.Time); ok && t.Equal(types.ZeroTime) && sql.LoadSqlMode(ctx).NoZeroDate() {
+		ctx.Warn(mysql.ERTruncatedWrongValue, "%s", types.ErrConvertingToTime.New(val).Error())
+		return nil
+	}
+	return d
+}
+
 // convertValue converts a value from its current type to the specified target type for CAST/CONVERT operations.
 // It handles type-specific conversion logic and applies length/scale constraints where applicable.
 // If |typeLength| and |typeScale| are 0, they are ignored, otherwise they are used as constraints on the
@@ -336,7 +412,7 @@ func convertValue(ctx *sql.Context, val any, castTo string, originType sql.Type,
 			}
 			ctx.Warn(mysql.ERTruncatedWrongValue, "%s", err.Error())
 		}
-		return d, nil
+		return checkZeroDate(ctx, val, d), nil
 	case ConvertToDatetime:
 		_, isTime := val.(time.Time)
 		_, isString := val.(string)
@@ -351,7 +427,7 @@ func convertValue(ctx *sql.Context, val any, castTo string, originType sql.Type,
 			}
 			ctx.Warn(mysql.ERTruncatedWrongValue, "%s", err.Error())
 		}
-		return d, nil
+		return checkZeroDate(ctx, val, d), nil
 	case ConvertToDecimal:
 		value, err := types.ConvertHexBlobToDecimalForNumericContext(val, originType)
 		if err != nil {
@@ -412,7 +488,7 @@ func convertValue(ctx *sql.Context, val any, castTo string, originType sql.Type,
 		}
 		return num, nil
 	case ConvertToTime:
-		t, _, err := types.Time.Convert(ctx, val)
+		t, _, err := types.MustCreateTimeType(typeLength).Convert(ctx, val)
 		if err != nil {
 			return nil, nil
 		}
@@ -467,10 +543,11 @@ func truncateConvertedValue(val interface{}, typeLength int) (interface{}, error
 		}
 		return v[:typeLength], nil
 	case string:
-		if len(v) <= typeLength {
-			typeLength = len(v)
+		// strings are truncated to a length in characters
+		if utf8.RuneCountInString(v) <= typeLength {
+			return v, nil
 		}
-		return v[:typeLength], nil
+		return string([]rune(v)[:typeLength]), nil
 	default:
 		return nil, fmt.Errorf("unsupported type for truncation: %T", val)
 	}
@@ -120,6 +120,15 @@ func TestConvert(t *testing.T) {
 			expected:    "-",
 			expectedErr: false,
 		},
+		{
+			name:        "convert multibyte string with length constraint",
+			row:         nil,
+			expression:  NewLiteral("éab", types.LongText),
+			castTo:      ConvertToChar,
+			typeLength:  2,
+			expected:    "éa",
+			expectedErr: false,
+		},
 		{
 			name:        "convert int to string with length constraint larger than value",
 			row:         nil,
@@ -282,3 +291,19 @@ func TestConvert(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertWithCharacterSet(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	convert := NewConvertWithCharacterSet(NewLiteral([]byte{0xE9}, types.LongBlob), ConvertToChar, 0, sql.CharacterSet_latin1)
+	require.Equal(t, sql.Collation_latin1_swedish_ci, convert.Type(ctx).(sql.StringType).Collation())
+	val, err := convert.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, "é", val)
+
+	convert = NewConvertWithCharacterSet(NewLiteral([]byte{0xE9}, types.LongBlob), ConvertToChar, 0, sql.CharacterSet_utf8mb4)
+	val, err = convert.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, val)
+	require.Equal(t, uint16(1), ctx.WarningCount())
+}
@@ -68,7 +68,13 @@ func (c *ConvertUsing) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	} else if v, ok := val.(string); ok {
 		valBytes = encodings.StringToBytes(v)
 	}
-	newString := c.TargetCharSet.Encoder().EncodeReplaceUnknown(valBytes)
+	// Strings are held in UTF-8 regardless of their character set, so the conversion only replaces the characters that
+	// the target character set can't represent
+	encoder := c.TargetCharSet.Encoder()
+	newString := encoder.EncodeReplaceUnknown(valBytes)
+	if decoded, ok := encoder.Decode(newString); ok {
+		newString = decoded
+	}
 	return encodings.BytesToString(newString), nil
 }
 
@@ -60,6 +60,16 @@ func (t *AbsVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if val == nil {
 		return nil, nil
 	}
+	return t.abs(ctx, val)
+}
+
+// EvalBatch implements the sql.BatchExpression interface.
+func (t *AbsVal) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return evalUnaryBatch(ctx, t.Child, batch, t.abs)
+}
+
+// abs returns the absolute value of |val|, which isn't NULL.
+func (t *AbsVal) abs(ctx *sql.Context, val interface{}) (interface{}, error) {
 
 	// Fucking Golang
 	switch x := val.(type) {
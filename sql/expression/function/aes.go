@@ -0,0 +1,483 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/pbkdf2"
+	"crypto/sha512"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ErrAESMissingIV is returned when block_encryption_mode requires an initialization vector and none was given
+var ErrAESMissingIV = errors.NewKind("Incorrect parameter count in the call to native function '%s'")
+
+// ErrAESInvalidIV is returned when the initialization vector is shorter than the block_encryption_mode requires
+var ErrAESInvalidIV = errors.NewKind("The initialization vector supplied to %s is too short. Must be at least %d bytes long")
+
+// ErrAESInvalidKDFName is returned when the key derivation function argument is not a supported function
+var ErrAESInvalidKDFName = errors.NewKind("KDF method name is not valid. Please use hkdf or pbkdf2_hmac method name")
+
+// ErrAESInvalidKDFIterations is returned when the iteration count for pbkdf2_hmac is out of range
+var ErrAESInvalidKDFIterations = errors.NewKind("KDF option size is invalid, please provide valid size in the range of %d to %d")
+
+const (
+	aesGCMNonceSize      = 12
+	pbkdf2MinIterations  = 1000
+	pbkdf2MaxIterations  = 65535
+	pbkdf2DefIterations  = 1000
+	aesMaxArgumentsCount = 6
+)
+
+// aesMode is a block_encryption_mode value: the key length in bytes and the block cipher mode
+type aesMode struct {
+	keySize int
+	mode    string
+}
+
+// parseBlockEncryptionMode parses a value of block_encryption_mode such as aes-256-cbc
+func parseBlockEncryptionMode(s string) (aesMode, bool) {
+	parts := strings.Split(strings.ToLower(s), "-")
+	if len(parts) != 3 || parts[0] != "aes" {
+		return aesMode{}, false
+	}
+	bits, err := strconv.Atoi(parts[1])
+	if err != nil || (bits != 128 && bits != 192 && bits != 256) {
+		return aesMode{}, false
+	}
+	switch parts[2] {
+	case "ecb", "cbc", "cfb1", "cfb8", "cfb128", "ofb", "gcm":
+		return aesMode{keySize: bits / 8, mode: parts[2]}, true
+	}
+	return aesMode{}, false
+}
+
+// ivSize returns the number of initialization vector bytes used by the mode, which is zero for ecb
+func (m aesMode) ivSize() int {
+	switch m.mode {
+	case "ecb":
+		return 0
+	case "gcm":
+		return aesGCMNonceSize
+	}
+	return aes.BlockSize
+}
+
+// aesFunc holds the argument handling shared by AES_ENCRYPT and AES_DECRYPT:
+// (str, key_str [, init_vector [, kdf_name [, salt [, info | iterations]]]])
+type aesFunc struct {
+	expression.NaryExpression
+	name string
+}
+
+func newAESFunc(name string, args []sql.Expression) (aesFunc, error) {
+	if len(args) < 2 || len(args) > aesMaxArgumentsCount {
+		return aesFunc{}, sql.ErrInvalidArgumentNumber.New(strings.ToUpper(name), "2 to 6", len(args))
+	}
+	return aesFunc{NaryExpression: expression.NaryExpression{ChildExpressions: args}, name: name}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *aesFunc) FunctionName() string {
+	return f.name
+}
+
+// Type implements the sql.Expression interface.
+func (f *aesFunc) Type(ctx *sql.Context) sql.Type {
+	return types.LongBlob
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *aesFunc) IsNullable(ctx *sql.Context) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*aesFunc) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 4
+}
+
+// IsSessionDependent implements sql.SessionDependentExpression. The mode is read from block_encryption_mode.
+func (f *aesFunc) IsSessionDependent() bool {
+	return true
+}
+
+func (f *aesFunc) String() string {
+	args := make([]string, len(f.ChildExpressions))
+	for i, arg := range f.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(args, ","))
+}
+
+// evalBytes evaluates the argument at |i| as a binary string, returning nil for NULL
+func (f *aesFunc) evalBytes(ctx *sql.Context, row sql.Row, i int) ([]byte, error) {
+	val, err := f.ChildExpressions[i].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	val, _, err = types.LongBlob.Convert(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return val.([]byte), nil
+}
+
+// cipher evaluates the key, initialization vector and key derivation arguments and returns the block cipher and the
+// initialization vector to use. A nil block means the result is NULL.
+func (f *aesFunc) cipher(ctx *sql.Context, row sql.Row) (aesMode, cipher.Block, []byte, error) {
+	modeVal, err := ctx.GetSessionVariable(ctx, "block_encryption_mode")
+	if err != nil {
+		return aesMode{}, nil, nil, err
+	}
+	modeStr, _ := modeVal.(string)
+	mode, ok := parseBlockEncryptionMode(modeStr)
+	if !ok {
+		return aesMode{}, nil, nil, sql.ErrInvalidSystemVariableValue.New("block_encryption_mode", modeVal)
+	}
+
+	key, err := f.evalBytes(ctx, row, 1)
+	if err != nil || key == nil {
+		return aesMode{}, nil, nil, err
+	}
+
+	var iv []byte
+	if ivSize := mode.ivSize(); ivSize == 0 {
+		if len(f.ChildExpressions) > 2 {
+			ctx.Warn(1618, "<IV> option ignored")
+		}
+	} else {
+		if len(f.ChildExpressions) < 3 {
+			return aesMode{}, nil, nil, ErrAESMissingIV.New(f.name)
+		}
+		iv, err = f.evalBytes(ctx, row, 2)
+		if err != nil || iv == nil {
+			return aesMode{}, nil, nil, err
+		}
+		if len(iv) < ivSize {
+			return aesMode{}, nil, nil, ErrAESInvalidIV.New(f.name, ivSize)
+		}
+		iv = iv[:ivSize]
+	}
+
+	if len(f.ChildExpressions) > 3 {
+		key, err = f.deriveKey(ctx, row, key, mode.keySize)
+		if err != nil || key == nil {
+			return aesMode{}, nil, nil, err
+		}
+	} else {
+		key = foldAESKey(key, mode.keySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return aesMode{}, nil, nil, err
+	}
+	return mode, block, iv, nil
+}
+
+// foldAESKey returns the key of |size| bytes MySQL derives from a key string, by XORing the bytes of the key string
+// into a zeroed buffer, wrapping around at its end
+func foldAESKey(key []byte, size int) []byte {
+	res := make([]byte, size)
+	for i, b := range key {
+		res[i%size] ^= b
+	}
+	return res
+}
+
+// deriveKey applies the key derivation function named by the fourth argument to the key string
+func (f *aesFunc) deriveKey(ctx *sql.Context, row sql.Row, key []byte, size int) ([]byte, error) {
+	name, err := f.evalBytes(ctx, row, 3)
+	if err != nil || name == nil {
+		return nil, err
+	}
+	var salt []byte
+	if len(f.ChildExpressions) > 4 {
+		if salt, err = f.evalBytes(ctx, row, 4); err != nil {
+			return nil, err
+		}
+	}
+
+	switch strings.ToLower(string(name)) {
+	case "hkdf":
+		var info []byte
+		if len(f.ChildExpressions) > 5 {
+			if info, err = f.evalBytes(ctx, row, 5); err != nil {
+				return nil, err
+			}
+		}
+		return hkdf.Key(sha512.New, key, salt, string(info), size)
+	case "pbkdf2_hmac":
+		iterations := int64(pbkdf2DefIterations)
+		if len(f.ChildExpressions) > 5 {
+			val, err := f.ChildExpressions[5].Eval(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			if val != nil {
+				val, _, err = types.Int64.Convert(ctx, val)
+				if err != nil {
+					return nil, err
+				}
+				iterations = val.(int64)
+			}
+		}
+		if iterations < pbkdf2MinIterations || iterations > pbkdf2MaxIterations {
+			return nil, ErrAESInvalidKDFIterations.New(pbkdf2MinIterations, pbkdf2MaxIterations)
+		}
+		return pbkdf2.Key(sha512.New, string(key), salt, int(iterations), size)
+	}
+	return nil, ErrAESInvalidKDFName.New()
+}
+
+// cfbCrypt encrypts or decrypts |data| in CFB mode with a segment size of |bits|, which is 1, 8 or 128
+func cfbCrypt(block cipher.Block, iv, data []byte, bits int, decrypt bool) []byte {
+	res := make([]byte, len(data))
+	reg := bytes.Clone(iv)
+	out := make([]byte, aes.BlockSize)
+	switch bits {
+	case 1:
+		for i := range data {
+			for bit := 7; bit >= 0; bit-- {
+				block.Encrypt(out, reg)
+				in := (data[i] >> bit) & 1
+				c := in ^ (out[0] >> 7)
+				res[i] |= c << bit
+				if decrypt {
+					c = in
+				}
+				shiftLeftBit(reg, c)
+			}
+		}
+	case 8:
+		for i := range data {
+			block.Encrypt(out, reg)
+			res[i] = data[i] ^ out[0]
+			copy(reg, reg[1:])
+			if decrypt {
+				reg[len(reg)-1] = data[i]
+			} else {
+				reg[len(reg)-1] = res[i]
+			}
+		}
+	default:
+		for i := 0; i < len(data); i += aes.BlockSize {
+			block.Encrypt(out, reg)
+			end := min(i+aes.BlockSize, len(data))
+			for j := i; j < end; j++ {
+				res[j] = data[j] ^ out[j-i]
+			}
+			if decrypt {
+				copy(reg, data[i:end])
+			} else {
+				copy(reg, res[i:end])
+			}
+		}
+	}
+	return res
+}
+
+// shiftLeftBit shifts |reg| left by one bit, shifting in |b| as the lowest bit
+func shiftLeftBit(reg []byte, b byte) {
+	for i := 0; i < len(reg)-1; i++ {
+		reg[i] = reg[i]<<1 | reg[i+1]>>7
+	}
+	reg[len(reg)-1] = reg[len(reg)-1]<<1 | b
+}
+
+// ofbCrypt encrypts or decrypts |data| in OFB mode
+func ofbCrypt(block cipher.Block, iv, data []byte) []byte {
+	res := make([]byte, len(data))
+	stream := bytes.Clone(iv)
+	for i := 0; i < len(data); i += aes.BlockSize {
+		block.Encrypt(stream, stream)
+		end := min(i+aes.BlockSize, len(data))
+		for j := i; j < end; j++ {
+			res[j] = data[j] ^ stream[j-i]
+		}
+	}
+	return res
+}
+
+// AESEncrypt encrypts a string with AES using the key length and mode of block_encryption_mode.
+// https://dev.mysql.com/doc/refman/8.4/en/encryption-functions.html#function_aes-encrypt
+type AESEncrypt struct {
+	aesFunc
+}
+
+var _ sql.FunctionExpression = (*AESEncrypt)(nil)
+var _ sql.CollationCoercible = (*AESEncrypt)(nil)
+var _ sql.SessionDependentExpression = (*AESEncrypt)(nil)
+
+// NewAESEncrypt returns a new AES_ENCRYPT function expression
+func NewAESEncrypt(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunc("aes_encrypt", args)
+	if err != nil {
+		return nil, err
+	}
+	return &AESEncrypt{f}, nil
+}
+
+// Description implements sql.FunctionExpression
+func (f *AESEncrypt) Description() string {
+	return "encrypts a string using AES."
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *AESEncrypt) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewAESEncrypt(ctx, children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (f *AESEncrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	data, err := f.evalBytes(ctx, row, 0)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	mode, block, iv, err := f.cipher(ctx, row)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	switch mode.mode {
+	case "ecb", "cbc":
+		// PKCS#7 padding, which always adds at least one byte
+		padLen := aes.BlockSize - len(data)%aes.BlockSize
+		res := make([]byte, len(data)+padLen)
+		copy(res, data)
+		for i := len(data); i < len(res); i++ {
+			res[i] = byte(padLen)
+		}
+		if mode.mode == "cbc" {
+			cipher.NewCBCEncrypter(block, iv).CryptBlocks(res, res)
+			return res, nil
+		}
+		for i := 0; i < len(res); i += aes.BlockSize {
+			block.Encrypt(res[i:], res[i:])
+		}
+		return res, nil
+	case "cfb1":
+		return cfbCrypt(block, iv, data, 1, false), nil
+	case "cfb8":
+		return cfbCrypt(block, iv, data, 8, false), nil
+	case "cfb128":
+		return cfbCrypt(block, iv, data, 128, false), nil
+	case "ofb":
+		return ofbCrypt(block, iv, data), nil
+	default:
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		return gcm.Seal(nil, iv, data, nil), nil
+	}
+}
+
+// AESDecrypt decrypts a string encrypted by AES_ENCRYPT with the same key, initialization vector and
+// block_encryption_mode. It returns NULL if the padding or authentication tag of the input is invalid.
+// https://dev.mysql.com/doc/refman/8.4/en/encryption-functions.html#function_aes-decrypt
+type AESDecrypt struct {
+	aesFunc
+}
+
+var _ sql.FunctionExpression = (*AESDecrypt)(nil)
+var _ sql.CollationCoercible = (*AESDecrypt)(nil)
+var _ sql.SessionDependentExpression = (*AESDecrypt)(nil)
+
+// NewAESDecrypt returns a new AES_DECRYPT function expression
+func NewAESDecrypt(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunc("aes_decrypt", args)
+	if err != nil {
+		return nil, err
+	}
+	return &AESDecrypt{f}, nil
+}
+
+// Description implements sql.FunctionExpression
+func (f *AESDecrypt) Description() string {
+	return "decrypts a string encrypted by aes_encrypt."
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *AESDecrypt) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewAESDecrypt(ctx, children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (f *AESDecrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	data, err := f.evalBytes(ctx, row, 0)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	mode, block, iv, err := f.cipher(ctx, row)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	switch mode.mode {
+	case "ecb", "cbc":
+		if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+			return nil, nil
+		}
+		res := make([]byte, len(data))
+		if mode.mode == "cbc" {
+			cipher.NewCBCDecrypter(block, iv).CryptBlocks(res, data)
+		} else {
+			for i := 0; i < len(data); i += aes.BlockSize {
+				block.Decrypt(res[i:], data[i:])
+			}
+		}
+		padLen := int(res[len(res)-1])
+		if padLen == 0 || padLen > aes.BlockSize {
+			return nil, nil
+		}
+		for _, b := range res[len(res)-padLen:] {
+			if int(b) != padLen {
+				return nil, nil
+			}
+		}
+		return res[:len(res)-padLen], nil
+	case "cfb1":
+		return cfbCrypt(block, iv, data, 1, true), nil
+	case "cfb8":
+		return cfbCrypt(block, iv, data, 8, true), nil
+	case "cfb128":
+		return cfbCrypt(block, iv, data, 128, true), nil
+	case "ofb":
+		return ofbCrypt(block, iv, data), nil
+	default:
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		res, err := gcm.Open([]byte{}, iv, data, nil)
+		if err != nil {
+			return nil, nil
+		}
+		return res, nil
+	}
+}
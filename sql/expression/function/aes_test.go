@@ -0,0 +1,144 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestAESEncrypt(t *testing.T) {
+	nistKey, _ := hex.DecodeString("2b7e151628aed2a6abf7158809cf4f3c")
+	nistIV, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	nistText, _ := hex.DecodeString("6bc1bee22e409f96e93d7e117393172aae2d")
+
+	tests := []struct {
+		mode string
+		args []interface{}
+		exp  interface{}
+		err  bool
+	}{
+		{"aes-128-ecb", []interface{}{"text", "password"}, "F6BD0FA8DCB7F8CD4A2FAABC54668044", false},
+		{"aes-128-ecb", []interface{}{nil, "password"}, nil, false},
+		{"aes-128-ecb", []interface{}{"text", nil}, nil, false},
+		{"aes-256-cbc", []interface{}{"text", "My secret passphrase", "1234567890123456"}, "6CADB5DCD3FBFA6F5A0F315ACC8F9053", false},
+		{"aes-256-cfb8", []interface{}{"text", "My secret passphrase", "1234567890123456"}, "6131B61E", false},
+		{"aes-128-cfb1", []interface{}{nistText, nistKey, nistIV}, "68B3A264F838F5F8C3101070D1AB4C2E22E7", false},
+		{"aes-128-cfb8", []interface{}{nistText, nistKey, nistIV}, "3B79424C9C0DD436BACE9E0ED4586A4F32B9", false},
+		{"aes-128-cfb128", []interface{}{nistText, nistKey, nistIV}, "3B3FD92EB72DAD20333449F8E83CFB4AC8A6", false},
+		{"aes-128-ofb", []interface{}{nistText, nistKey, nistIV}, "3B3FD92EB72DAD20333449F8E83CFB4A7789", false},
+		{"aes-128-cbc", []interface{}{"text", "password"}, nil, true},
+		{"aes-128-cbc", []interface{}{"text", "password", "short"}, nil, true},
+		{"aes-128-gcm", []interface{}{"text", "password", "12345678901"}, nil, true},
+		{"aes-128-cbc", []interface{}{"text", "password", "1234567890123456", "sha1"}, nil, true},
+		{"aes-128-cbc", []interface{}{"text", "password", "1234567890123456", "pbkdf2_hmac", "salt", 10}, nil, true},
+	}
+
+	for _, test := range tests {
+		args := make([]sql.Expression, len(test.args))
+		for i, arg := range test.args {
+			if arg == nil {
+				args[i] = expression.NewLiteral(nil, types.Null)
+			} else if b, ok := arg.([]byte); ok {
+				args[i] = expression.NewLiteral(b, types.LongBlob)
+			} else if n, ok := arg.(int); ok {
+				args[i] = expression.NewLiteral(int64(n), types.Int64)
+			} else {
+				args[i] = expression.NewLiteral(arg, types.LongText)
+			}
+		}
+		f, err := NewAESEncrypt(sql.NewEmptyContext(), args...)
+		require.NoError(t, err)
+		t.Run(fmt.Sprintf("%s %s", test.mode, f), func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			require.NoError(t, ctx.SetSessionVariable(ctx, "block_encryption_mode", test.mode))
+			res, err := f.Eval(ctx, nil)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if test.exp == nil {
+				require.Nil(t, res)
+				return
+			}
+			require.Equal(t, test.exp, fmt.Sprintf("%X", res))
+		})
+	}
+}
+
+func TestAESDecrypt(t *testing.T) {
+	modes := []string{"aes-128-ecb", "aes-192-cbc", "aes-256-cfb1", "aes-128-cfb8", "aes-192-cfb128", "aes-256-ofb", "aes-128-gcm", "aes-256-gcm"}
+	kdfs := [][]string{nil, {"hkdf", "salt", "info"}, {"pbkdf2_hmac", "salt", "2000"}}
+	texts := []string{"", "a", "exactly 16 bytes", "a string that spans more than two blocks of AES"}
+
+	for _, mode := range modes {
+		for _, kdf := range kdfs {
+			for _, text := range texts {
+				args := []sql.Expression{
+					expression.NewLiteral(text, types.LongText),
+					expression.NewLiteral("a key that is longer than thirty-two bytes", types.LongText),
+					expression.NewLiteral("an initialization vector", types.LongText),
+				}
+				for _, arg := range kdf {
+					args = append(args, expression.NewLiteral(arg, types.LongText))
+				}
+				enc, err := NewAESEncrypt(sql.NewEmptyContext(), args...)
+				require.NoError(t, err)
+				t.Run(fmt.Sprintf("%s %s", mode, enc), func(t *testing.T) {
+					ctx := sql.NewEmptyContext()
+					require.NoError(t, ctx.SetSessionVariable(ctx, "block_encryption_mode", mode))
+					encrypted, err := enc.Eval(ctx, nil)
+					require.NoError(t, err)
+
+					decArgs := append([]sql.Expression{expression.NewLiteral(encrypted, types.LongBlob)}, args[1:]...)
+					dec, err := NewAESDecrypt(ctx, decArgs...)
+					require.NoError(t, err)
+					res, err := dec.Eval(ctx, nil)
+					require.NoError(t, err)
+					require.Equal(t, []byte(text), res)
+
+					// a different key fails the padding or authentication check in the padded and authenticated modes
+					if mode == "aes-128-ecb" || mode == "aes-192-cbc" || mode == "aes-128-gcm" {
+						decArgs[1] = expression.NewLiteral("another key", types.LongText)
+						dec, err = NewAESDecrypt(ctx, decArgs...)
+						require.NoError(t, err)
+						res, err = dec.Eval(ctx, nil)
+						require.NoError(t, err)
+						if mode == "aes-128-gcm" {
+							require.Nil(t, res)
+						} else if res != nil {
+							require.NotEqual(t, []byte(text), res)
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestFoldAESKey(t *testing.T) {
+	require.Equal(t, []byte("key\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"), foldAESKey([]byte("key"), 16))
+	folded := foldAESKey([]byte("abcdefghijklmnopq"), 16)
+	require.Equal(t, byte('a'^'q'), folded[0])
+	require.Equal(t, []byte("bcdefghijklmnop"), folded[1:])
+}
@@ -18,10 +18,12 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/vt/proto/query"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/sorters"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -91,13 +93,22 @@ func (g *GroupConcat) Window() *sql.WindowDefinition {
 
 // NewBuffer creates a new buffer for the aggregation.
 func (g *GroupConcat) NewBuffer(ctx *sql.Context) (sql.AggregationBuffer, error) {
-	var rows []sql.Row
-	distinctSet := make(map[string]bool)
-	return &groupConcatBuffer{
+	buf := &groupConcatBuffer{
 		gc:          g,
-		distinctSet: distinctSet,
-		rows:        rows,
-	}, nil
+		distinctSet: make(map[string]struct{}),
+	}
+	// The buffered rows only hold the sort values, so the sort conditions are rewritten to refer to them
+	if len(g.sortConditions) > 0 {
+		buf.sortConditions = make(sql.SortConditions, len(g.sortConditions))
+		for i, sc := range g.sortConditions {
+			buf.sortConditions[i] = sql.SortCondition{
+				Expr:         expression.NewGetField(i, sc.Expr.Type(ctx), sc.Expr.String(), true),
+				Order:        sc.Order,
+				NullOrdering: sc.NullOrdering,
+			}
+		}
+	}
+	return buf, nil
 }
 
 // NewWindowFunction implements sql.WindowAdaptableExpression
@@ -241,7 +252,10 @@ func (g *GroupConcat) WithChildren(ctx *sql.Context, children ...sql.Expression)
 	sortFieldMarker := len(g.sortConditions)
 	orderByExpr := children[:len(g.sortConditions)]
 
-	return NewGroupConcat(g.distinct, g.sortConditions.FromExpressions(ctx, orderByExpr...), g.separator, children[sortFieldMarker:], g.maxLen), nil
+	ng := *g
+	ng.sortConditions = g.sortConditions.FromExpressions(ctx, orderByExpr...)
+	ng.selectExprs = children[sortFieldMarker:]
+	return &ng, nil
 }
 
 // OutputExpressions implements the OrderedAggregation interface.
@@ -251,132 +265,192 @@ func (g *GroupConcat) OutputExpressions() []sql.Expression {
 
 type groupConcatBuffer struct {
 	gc          *GroupConcat
-	distinctSet map[string]bool
-	rows        []sql.Row
+	distinctSet map[string]struct{}
+	// rows hold the sort values of each value followed by the value itself. They're only kept when the values need
+	// to be sorted, otherwise the values are written to result as they come.
+	rows           []sql.Row
+	sortConditions sql.SortConditions
+	result         groupConcatResult
 }
 
 // Update implements the AggregationBuffer interface.
 func (g *groupConcatBuffer) Update(ctx *sql.Context, originalRow sql.Row) error {
-	evalRow, retType, err := evalExprs(ctx, g.gc.selectExprs, originalRow)
-	if err != nil {
-		return err
-	}
-
-	g.gc.returnType = retType
-
-	// Skip if this is a null row
-	if evalRow == nil {
+	// Once the result is longer than group_concat_max_len no other value can make it into it
+	if g.sortConditions == nil && g.result.truncated {
 		return nil
 	}
 
-	var v interface{}
-	var vs string
-	if types.IsBlobType(retType) {
-		v, _, err = types.Blob.Convert(ctx, evalRow[0])
-		if err != nil {
-			return err
-		}
-		vb, _, err := sql.Unwrap[[]byte](ctx, v)
-		if err != nil {
-			return err
-		}
-		vs = string(vb)
-		if len(vs) == 0 {
-			return nil
-		}
-	} else {
-		// Use type-aware conversion for enum types
-		if len(g.gc.selectExprs) > 0 {
-			vs, _, err = types.ConvertToCollatedString(ctx, evalRow[0], g.gc.selectExprs[0].Type(ctx))
-			if err != nil {
-				return err
-			}
-			if vs == "" {
-				return nil
-			}
-		} else {
-			v, _, err = types.LongText.Convert(ctx, evalRow[0])
-			if err != nil {
-				return err
-			}
-			if v == nil {
-				return nil
-			}
-			vs, _, err = sql.Unwrap[string](ctx, v)
-			if err != nil {
-				return err
-			}
-		}
+	vals, ok, err := g.gc.evalValues(ctx, originalRow)
+	if err != nil || !ok {
+		return err
 	}
+	vs := strings.Join(vals, "")
 
-	// Get the current array of rows and the map
 	// Check if distinct is active if so look at and update our map
 	if g.gc.distinct != "" {
-		// If this value exists go ahead and return nil
-		if _, ok := g.distinctSet[vs]; ok {
+		key := distinctKey(vals)
+		if _, ok := g.distinctSet[key]; ok {
 			return nil
-		} else {
-			g.distinctSet[vs] = true
 		}
+		g.distinctSet[key] = struct{}{}
 	}
 
-	// Append the current value to the end of the row. We want to preserve the row's original structure
-	// for sort ordering in the final step.
-	g.rows = append(g.rows, append(originalRow, vs))
+	if g.sortConditions == nil {
+		g.result.append(g.gc, vs)
+		return nil
+	}
 
+	// Only the values sorted on are kept along with the value, rather than the entire row. The sort conditions expect the
+	// value to be appended to the row, see sql.OrderedAggregation.
+	evalRow := append(originalRow[:len(originalRow):len(originalRow)], vs)
+	row := make(sql.Row, len(g.gc.sortConditions)+1)
+	for i, sc := range g.gc.sortConditions {
+		row[i], err = sc.Expr.Eval(ctx, evalRow)
+		if err != nil {
+			return err
+		}
+	}
+	row[len(row)-1] = vs
+	g.rows = append(g.rows, row)
 	return nil
 }
 
 // Eval implements the AggregationBuffer interface.
 // cc: https://dev.mysql.com/doc/refman/8.0/en/aggregate-functions.html#function_group-concat
 func (g *groupConcatBuffer) Eval(ctx *sql.Context) (interface{}, error) {
-	rows := g.rows
+	result := &g.result
+	if g.sortConditions != nil {
+		sorter := sorters.NewRowSorterWithRows(ctx, g.sortConditions, g.rows)
+		sort.Stable(sorter)
+		if err := sorter.GetError(); err != nil {
+			return nil, err
+		}
 
-	if len(rows) == 0 {
-		return nil, nil
+		result = &groupConcatResult{}
+		for _, row := range g.rows {
+			if !result.append(g.gc, row[len(row)-1].(string)) {
+				break
+			}
+		}
 	}
 
-	// Execute the order operation if it exists.
-	if g.gc.sortConditions != nil {
-		sorter := sorters.NewRowSorterWithRows(ctx, g.gc.sortConditions, rows)
+	return result.eval(ctx, g.gc)
+}
 
-		sort.Stable(sorter)
+// Dispose implements the Disposable interface.
+func (g *groupConcatBuffer) Dispose(ctx *sql.Context) {
+}
 
-		err := sorter.GetError()
-		if err != nil {
-			return nil, err
-		}
+// ERCutValueGroupConcat is the warning code for a GROUP_CONCAT result truncated to group_concat_max_len.
+const ERCutValueGroupConcat = 1260
+
+// groupConcatResult is a GROUP_CONCAT result that's built one value at a time.
+type groupConcatResult struct {
+	sb        strings.Builder
+	count     int
+	truncated bool
+}
+
+// append appends the value |vs| to the result, returning false if the result is already longer than the
+// group_concat_max_len of |gc|, in which case no more values need to be appended.
+func (r *groupConcatResult) append(gc *GroupConcat, vs string) bool {
+	if r.truncated {
+		return false
+	}
+	if r.count > 0 {
+		r.sb.WriteString(gc.separator)
 	}
+	r.sb.WriteString(vs)
+	r.count++
+	r.truncated = r.sb.Len() > gc.maxLen
+	return !r.truncated
+}
 
-	sb := strings.Builder{}
-	for i, row := range rows {
-		lastIdx := len(row) - 1
-		if i == 0 {
-			sb.WriteString(row[lastIdx].(string))
-		} else {
-			sb.WriteString(g.gc.separator)
-			sb.WriteString(row[lastIdx].(string))
+// eval returns the result, truncating it to the group_concat_max_len of |gc| with a warning if it's longer.
+func (r *groupConcatResult) eval(ctx *sql.Context, gc *GroupConcat) (interface{}, error) {
+	if r.count == 0 {
+		return nil, nil
+	}
+	ret := r.sb.String()
+	if !r.truncated {
+		return ret, nil
+	}
+
+	// Truncate to the maximum length in bytes without cutting a character in half
+	n := gc.maxLen
+	if !types.IsBlobType(gc.returnType) {
+		for n > 0 && !utf8.RuneStart(ret[n]) {
+			n--
 		}
+	}
 
-		// Don't allow the string to cross maxlen
-		if sb.Len() >= g.gc.maxLen {
-			break
+	cut := 1
+	if ctx.Session != nil {
+		for _, w := range ctx.Session.Warnings() {
+			if w.Code == ERCutValueGroupConcat {
+				cut++
+			}
 		}
 	}
+	ctx.Warn(ERCutValueGroupConcat, "Row %d was cut by GROUP_CONCAT()", cut)
+	return ret[:n], nil
+}
+
+// evalValues returns the values of the select expressions for |row| as strings, or false if the row is skipped
+// because one of them is NULL or they are all empty.
+func (g *GroupConcat) evalValues(ctx *sql.Context, row sql.Row) ([]string, bool, error) {
+	evalRow, retType, err := evalExprs(ctx, g.selectExprs, row)
+	if err != nil {
+		return nil, false, err
+	}
 
-	ret := sb.String()
+	g.returnType = retType
 
-	// There might be a couple of character differences even if we broke early in the loop
-	if len(ret) > g.gc.maxLen {
-		ret = ret[:g.gc.maxLen]
+	vals := make([]string, len(evalRow))
+	empty := true
+	for i, val := range evalRow {
+		// Skip if this is a null row
+		if val == nil {
+			return nil, false, nil
+		}
+
+		if types.IsBlobType(retType) {
+			v, _, err := types.Blob.Convert(ctx, val)
+			if err != nil {
+				return nil, false, err
+			}
+			vb, _, err := sql.Unwrap[[]byte](ctx, v)
+			if err != nil {
+				return nil, false, err
+			}
+			vals[i] = string(vb)
+		} else {
+			// Use type-aware conversion for enum types
+			vals[i], _, err = types.ConvertToCollatedString(ctx, val, g.selectExprs[i].Type(ctx))
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		empty = empty && vals[i] == ""
 	}
 
-	// Add this to handle any one off errors.
-	return ret, nil
+	if empty {
+		return nil, false, nil
+	}
+	return vals, true, nil
 }
 
-// Dispose implements the Disposable interface.
-func (g *groupConcatBuffer) Dispose(ctx *sql.Context) {
+// distinctKey returns the key that identifies the values |vals| for DISTINCT. With several values their lengths are
+// part of the key, so that ('1', '23') and ('12', '3') differ.
+func distinctKey(vals []string) string {
+	if len(vals) == 1 {
+		return vals[0]
+	}
+	var sb strings.Builder
+	for _, v := range vals {
+		sb.WriteString(fmt.Sprintf("%d:%s", len(v), v))
+	}
+	return sb.String()
 }
 
 func evalExprs(ctx *sql.Context, exprs []sql.Expression, row sql.Row) (sql.Row, sql.Type, error) {
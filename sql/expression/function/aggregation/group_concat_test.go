@@ -103,3 +103,78 @@ func TestGroupConcat_ReturnType(t *testing.T) {
 		require.Equal(t, tt.returnType, gc.Type(ctx))
 	}
 }
+
+func TestGroupConcat_MultipleExpressions(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	rows := []sql.Row{
+		{int64(1), "23"},
+		{int64(12), "3"},
+		{int64(1), "23"},
+		{int64(2), nil},
+		{int64(4), "5"},
+	}
+	exprs := []sql.Expression{
+		expression.NewGetField(0, types.Int64, "a", true),
+		expression.NewGetField(1, types.LongText, "b", true),
+	}
+
+	testCases := []struct {
+		name     string
+		distinct string
+		orderBy  sql.SortConditions
+		expected string
+	}{
+		{
+			name:     "all values",
+			expected: "123,123,123,45",
+		},
+		{
+			name:     "distinct values",
+			distinct: "distinct",
+			expected: "123,123,45",
+		},
+		{
+			name:     "distinct values ordered by several keys",
+			distinct: "distinct",
+			orderBy: sql.SortConditions{
+				{Expr: expression.NewGetField(1, types.LongText, "b", true), Order: sql.Descending},
+				{Expr: expression.NewGetField(0, types.Int64, "a", true), Order: sql.Ascending},
+			},
+			expected: "45,123,123",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			gc := NewGroupConcat(tt.distinct, tt.orderBy, ",", exprs, 1024)
+			buf, err := gc.NewBuffer(ctx)
+			require.NoError(t, err)
+			for _, row := range rows {
+				require.NoError(t, buf.Update(ctx, row))
+			}
+			result, err := buf.Eval(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+// Validates that a GROUP_CONCAT result longer than group_concat_max_len is cut with a warning, without splitting a
+// character in half
+func TestGroupConcat_TruncationWarning(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	gc := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, types.LongText, "text", true)}, 6)
+	buf, err := gc.NewBuffer(ctx)
+	require.NoError(t, err)
+	for _, row := range []sql.Row{{"éé"}, {"éé"}, {"éé"}} {
+		require.NoError(t, buf.Update(ctx, row))
+	}
+
+	result, err := buf.Eval(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "éé,", result)
+	require.Len(t, ctx.Warnings(), 1)
+	require.Equal(t, ERCutValueGroupConcat, ctx.Warnings()[0].Code)
+	require.Equal(t, "Row 1 was cut by GROUP_CONCAT()", ctx.Warnings()[0].Message)
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// GROUPING(expr [, expr] ...)
+//
+// Grouping returns a bit mask that tells which of its arguments are rolled up in the current row of a GROUP BY with
+// ROLLUP, CUBE or GROUPING SETS. The bit for the last argument is the least significant, and is set if that argument
+// is aggregated over in the row, which is then a super-aggregate row. Each argument must be one of the GROUP BY
+// expressions.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_grouping
+type Grouping struct {
+	Args   []sql.Expression
+	window *sql.WindowDefinition
+	id     sql.ColumnId
+}
+
+var _ sql.FunctionExpression = (*Grouping)(nil)
+var _ sql.Aggregation = (*Grouping)(nil)
+var _ sql.WindowAdaptableExpression = (*Grouping)(nil)
+var _ sql.CollationCoercible = (*Grouping)(nil)
+
+// NewGrouping creates a new Grouping function.
+func NewGrouping(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) == 0 {
+		return nil, sql.ErrInvalidArgumentNumber.New("GROUPING", "1 or more", 0)
+	}
+	if len(args) > 64 {
+		return nil, sql.ErrInvalidArgumentNumber.New("GROUPING", "64 or fewer", len(args))
+	}
+	return &Grouping{Args: args}, nil
+}
+
+// Id implements the Aggregation interface
+func (g *Grouping) Id() sql.ColumnId {
+	return g.id
+}
+
+// WithId implements the Aggregation interface
+func (g *Grouping) WithId(id sql.ColumnId) sql.IdExpression {
+	ret := *g
+	ret.id = id
+	return &ret
+}
+
+// FunctionName implements sql.FunctionExpression
+func (g *Grouping) FunctionName() string {
+	return "grouping"
+}
+
+// Description implements sql.FunctionExpression
+func (g *Grouping) Description() string {
+	return "distinguishes super-aggregate rows from regular grouped rows."
+}
+
+// Resolved implements the Expression interface.
+func (g *Grouping) Resolved() bool {
+	for _, arg := range g.Args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *Grouping) String() string {
+	args := make([]string, len(g.Args))
+	for i, arg := range g.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("GROUPING(%s)", strings.Join(args, ", "))
+}
+
+// Type implements the Expression interface.
+func (g *Grouping) Type(ctx *sql.Context) sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Grouping) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the Expression interface.
+func (g *Grouping) IsNullable(ctx *sql.Context) bool {
+	return false
+}
+
+// Children implements the Expression interface.
+func (g *Grouping) Children() []sql.Expression {
+	return g.Args
+}
+
+// WithChildren implements the Expression interface.
+func (g *Grouping) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(g.Args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), len(g.Args))
+	}
+	ret := *g
+	ret.Args = children
+	return &ret, nil
+}
+
+// WithWindow implements sql.Aggregation
+func (g *Grouping) WithWindow(ctx *sql.Context, window *sql.WindowDefinition) sql.WindowAdaptableExpression {
+	ng := *g
+	ng.window = window
+	return &ng
+}
+
+// Window implements sql.Aggregation
+func (g *Grouping) Window() *sql.WindowDefinition {
+	return g.window
+}
+
+// NewBuffer implements the Aggregation interface. The buffer it returns is for a grouping that rolls up none of the
+// arguments; use NewGroupingSetBuffer for the groups of a particular grouping set.
+func (g *Grouping) NewBuffer(ctx *sql.Context) (sql.AggregationBuffer, error) {
+	return &groupingBuffer{}, nil
+}
+
+// NewGroupingSetBuffer returns a buffer for the groups of a grouping set, in which the arguments for which |rolledUp|
+// returns true are aggregated over.
+func (g *Grouping) NewGroupingSetBuffer(rolledUp func(sql.Expression) bool) sql.AggregationBuffer {
+	var mask int64
+	for _, arg := range g.Args {
+		mask <<= 1
+		if rolledUp(arg) {
+			mask |= 1
+		}
+	}
+	return &groupingBuffer{mask: mask}
+}
+
+// NewWindowFunction implements sql.WindowAdaptableExpression
+func (g *Grouping) NewWindowFunction(ctx *sql.Context) (sql.WindowFunction, error) {
+	return nil, sql.ErrUnsupportedFeature.New("GROUPING as a window function")
+}
+
+// Eval implements the Expression interface.
+func (g *Grouping) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("Grouping")
+}
+
+// groupingBuffer is the buffer of a Grouping, whose value is fixed by the grouping set of its group.
+type groupingBuffer struct {
+	mask int64
+}
+
+// Update implements the AggregationBuffer interface.
+func (g *groupingBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (g *groupingBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return g.mask, nil
+}
+
+// Dispose implements the Disposable interface.
+func (g *groupingBuffer) Dispose(ctx *sql.Context) {
+}
@@ -71,7 +71,10 @@ func (j *JSONObjectAgg) Description() string {
 
 // Resolved implements the Expression interface.
 func (j *JSONObjectAgg) Resolved() bool {
-	return j.key.Resolved() && j.value.Resolved()
+	if !j.key.Resolved() || !j.value.Resolved() {
+		return false
+	}
+	return j.window == nil || windowResolved(j.window)
 }
 
 func (j *JSONObjectAgg) String() string {
@@ -95,16 +98,30 @@ func (j *JSONObjectAgg) IsNullable(ctx *sql.Context) bool {
 
 // Children implements the Expression interface.
 func (j *JSONObjectAgg) Children() []sql.Expression {
-	return []sql.Expression{j.key, j.value}
+	children := []sql.Expression{j.key, j.value}
+	if j.window != nil {
+		children = append(children, j.window.ToExpressions()...)
+	}
+	return children
 }
 
 // WithChildren implements the Expression interface.
 func (j *JSONObjectAgg) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
-	if len(children) != 2 {
+	if len(children) < 2 {
 		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
 	}
 
-	return NewJSONObjectAgg(ctx, children[0], children[1]), nil
+	nj := *j
+	nj.key = children[0]
+	nj.value = children[1]
+	if len(children) > 2 && j.window != nil {
+		w, err := j.window.FromExpressions(ctx, children[2:])
+		if err != nil {
+			return nil, err
+		}
+		nj.window = w
+	}
+	return &nj, nil
 }
 
 // WithWindow implements sql.Aggregation
@@ -127,7 +144,7 @@ func (j *JSONObjectAgg) NewBuffer(ctx *sql.Context) (sql.AggregationBuffer, erro
 
 // NewWindowFunction implements sql.WindowAdaptableExpression
 func (j *JSONObjectAgg) NewWindowFunction(ctx *sql.Context) (sql.WindowFunction, error) {
-	return NewWindowedJSONObjectAgg(j), nil
+	return NewWindowedJSONObjectAgg(j).WithWindow(ctx, j.window)
 }
 
 // Eval implements the Expression interface.
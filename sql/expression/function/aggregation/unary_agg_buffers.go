@@ -5,11 +5,11 @@ import (
 	"math"
 	"reflect"
 
-	"github.com/cespare/xxhash/v2"
 	"github.com/cockroachdb/apd/v3"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/hash"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -52,9 +52,12 @@ func (a *anyValueBuffer) Dispose(ctx *sql.Context) {
 }
 
 type sumBuffer struct {
-	sum   interface{} // sum is either *apd.Decimal or float64
-	expr  sql.Expression
-	isnil bool
+	sum interface{} // sum is either *apd.Decimal or float64
+	// decimalSum holds a sum of decimals for as long as it fits, in which case sum is not up to date
+	decimalSum   types.Decimal128
+	isDecimalSum bool
+	expr         sql.Expression
+	isnil        bool
 }
 
 func NewSumBuffer(child sql.Expression) *sumBuffer {
@@ -90,6 +93,20 @@ func (m *sumBuffer) PerformSum(ctx *sql.Context, v interface{}) {
 			v = val
 		}
 	}
+	if n, ok := v.(*apd.Decimal); ok && (m.isnil || m.isDecimalSum) {
+		if d, ok := types.NewDecimal128(n); ok {
+			if m.isnil {
+				m.decimalSum, m.isDecimalSum, m.isnil = d, true, false
+				return
+			}
+			if sum, ok := m.decimalSum.Add(d); ok {
+				m.decimalSum = sum
+				return
+			}
+		}
+	}
+	m.flushDecimalSum()
+
 	switch n := v.(type) {
 	case float64:
 		if m.isnil {
@@ -150,11 +167,20 @@ func (m *sumBuffer) PerformSum(ctx *sql.Context, v interface{}) {
 	}
 }
 
+// flushDecimalSum sets sum to the value of decimalSum, if it's being used.
+func (m *sumBuffer) flushDecimalSum() {
+	if m.isDecimalSum {
+		m.sum = m.decimalSum.Decimal()
+		m.isDecimalSum = false
+	}
+}
+
 // Eval implements the AggregationBuffer interface.
 func (m *sumBuffer) Eval(ctx *sql.Context) (interface{}, error) {
 	if m.isnil {
 		return nil, nil
 	}
+	m.flushDecimalSum()
 	return m.sum, nil
 }
 
@@ -447,51 +473,37 @@ func NewCountDistinctBuffer(children []sql.Expression) *countDistinctBuffer {
 
 // Update implements the AggregationBuffer interface.
 func (c *countDistinctBuffer) Update(ctx *sql.Context, row sql.Row) error {
-	var value interface{}
 	if len(c.exprs) == 0 {
 		return fmt.Errorf("no expressions")
 	}
+	var value sql.Row
+	var sch sql.Schema
 	if _, ok := c.exprs[0].(*expression.Star); ok {
 		value = row
 	} else {
-		val := make(sql.Row, len(c.exprs))
+		value = make(sql.Row, len(c.exprs))
 		for i, expr := range c.exprs {
 			v, err := expr.Eval(ctx, row)
 			if err != nil {
 				return err
 			}
-			// skip nil values
-			if v == nil {
-				return nil
-			}
-			val[i] = v
+			value[i] = v
 		}
-		value = val
+		sch = hash.ExprsToSchema(ctx, c.exprs...)
 	}
 
-	var str string
-	for _, val := range value.(sql.Row) {
+	for _, val := range value {
 		// skip nil values
 		if val == nil {
 			return nil
 		}
-		v, _, err := types.Text.Convert(ctx, val)
-		if err != nil {
-			return err
-		}
-		vv, ok := v.(string)
-		if !ok {
-			return fmt.Errorf("count distinct unable to hash value: %s", err)
-		}
-		str += vv + ","
 	}
 
-	hash := xxhash.New()
-	_, err := hash.WriteString(str)
+	// strings are hashed with their collation, so that values the collation considers equal are only counted once
+	h, err := hash.HashOf(ctx, sch, value)
 	if err != nil {
 		return err
 	}
-	h := hash.Sum64()
 	c.seen[h] = struct{}{}
 
 	return nil
@@ -0,0 +1,261 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// UserDefinedAggregation is a call to an aggregate sql.UserDefinedFunction registered by an integrator.
+type UserDefinedAggregation struct {
+	args   []sql.Expression
+	fn     *sql.UserDefinedFunction
+	window *sql.WindowDefinition
+	id     sql.ColumnId
+}
+
+var _ sql.FunctionExpression = (*UserDefinedAggregation)(nil)
+var _ sql.Aggregation = (*UserDefinedAggregation)(nil)
+var _ sql.WindowAdaptableExpression = (*UserDefinedAggregation)(nil)
+var _ sql.CollationCoercible = (*UserDefinedAggregation)(nil)
+
+// NewUserDefinedAggregationFunction returns the sql.Function for the aggregate user-defined function given.
+func NewUserDefinedAggregationFunction(fn sql.UserDefinedFunction) sql.Function {
+	return sql.FunctionN{
+		Name: strings.ToLower(fn.Name),
+		Fn: func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+			if err := fn.CheckArity(len(args)); err != nil {
+				return nil, err
+			}
+			return &UserDefinedAggregation{args: args, fn: &fn}, nil
+		},
+	}
+}
+
+// Id implements the Aggregation interface
+func (u *UserDefinedAggregation) Id() sql.ColumnId {
+	return u.id
+}
+
+// WithId implements the Aggregation interface
+func (u *UserDefinedAggregation) WithId(id sql.ColumnId) sql.IdExpression {
+	ret := *u
+	ret.id = id
+	return &ret
+}
+
+// FunctionName implements sql.FunctionExpression
+func (u *UserDefinedAggregation) FunctionName() string {
+	return strings.ToLower(u.fn.Name)
+}
+
+// Description implements sql.FunctionExpression
+func (u *UserDefinedAggregation) Description() string {
+	return u.fn.Comment
+}
+
+// Resolved implements the Expression interface.
+func (u *UserDefinedAggregation) Resolved() bool {
+	if !expression.ExpressionsResolved(u.args...) {
+		return false
+	}
+	return u.window == nil || windowResolved(u.window)
+}
+
+func (u *UserDefinedAggregation) String() string {
+	args := make([]string, len(u.args))
+	for i, arg := range u.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", u.FunctionName(), strings.Join(args, ","))
+}
+
+// Type implements the Expression interface.
+func (u *UserDefinedAggregation) Type(ctx *sql.Context) sql.Type {
+	return u.fn.Type(ctx, u.args)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (u *UserDefinedAggregation) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if st, ok := u.Type(ctx).(sql.StringType); ok {
+		return st.Collation(), 4
+	}
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the Expression interface.
+func (u *UserDefinedAggregation) IsNullable(ctx *sql.Context) bool {
+	return true
+}
+
+// Children implements the Expression interface.
+func (u *UserDefinedAggregation) Children() []sql.Expression {
+	children := append([]sql.Expression(nil), u.args...)
+	if u.window != nil {
+		children = append(children, u.window.ToExpressions()...)
+	}
+	return children
+}
+
+// WithChildren implements the Expression interface.
+func (u *UserDefinedAggregation) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) < len(u.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), len(u.args))
+	}
+
+	nu := *u
+	nu.args = children[:len(u.args)]
+	if len(children) > len(u.args) && u.window != nil {
+		w, err := u.window.FromExpressions(ctx, children[len(u.args):])
+		if err != nil {
+			return nil, err
+		}
+		nu.window = w
+	}
+	return &nu, nil
+}
+
+// WithWindow implements sql.Aggregation
+func (u *UserDefinedAggregation) WithWindow(ctx *sql.Context, window *sql.WindowDefinition) sql.WindowAdaptableExpression {
+	nu := *u
+	nu.window = window
+	return &nu
+}
+
+// Window implements sql.Aggregation
+func (u *UserDefinedAggregation) Window() *sql.WindowDefinition {
+	return u.window
+}
+
+// NewBuffer implements the Aggregation interface.
+func (u *UserDefinedAggregation) NewBuffer(ctx *sql.Context) (sql.AggregationBuffer, error) {
+	agg, err := u.fn.NewAggregator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &userDefinedAggregationBuffer{agg: agg, u: u}, nil
+}
+
+// NewWindowFunction implements sql.WindowAdaptableExpression
+func (u *UserDefinedAggregation) NewWindowFunction(ctx *sql.Context) (sql.WindowFunction, error) {
+	return (&userDefinedWindowFunction{u: u}).WithWindow(ctx, u.window)
+}
+
+// Eval implements the Expression interface.
+func (u *UserDefinedAggregation) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New(u.FunctionName())
+}
+
+// update evaluates the arguments against |row| and adds them to |agg|
+func (u *UserDefinedAggregation) update(ctx *sql.Context, agg sql.UserDefinedAggregator, row sql.Row) error {
+	args := make([]interface{}, len(u.args))
+	for i, arg := range u.args {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return err
+		}
+		if args[i], err = sql.UnwrapAny(ctx, val); err != nil {
+			return err
+		}
+	}
+	return agg.Update(ctx, args)
+}
+
+// result returns the result of |agg| converted to the type of the aggregation
+func (u *UserDefinedAggregation) result(ctx *sql.Context, agg sql.UserDefinedAggregator) (interface{}, error) {
+	res, err := agg.Result(ctx)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	res, _, err = u.Type(ctx).Convert(ctx, res)
+	return res, err
+}
+
+type userDefinedAggregationBuffer struct {
+	agg sql.UserDefinedAggregator
+	u   *UserDefinedAggregation
+}
+
+// Update implements the AggregationBuffer interface.
+func (b *userDefinedAggregationBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	return b.u.update(ctx, b.agg, row)
+}
+
+// Eval implements the AggregationBuffer interface.
+func (b *userDefinedAggregationBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return b.u.result(ctx, b.agg)
+}
+
+// Dispose implements the Disposable interface.
+func (b *userDefinedAggregationBuffer) Dispose(ctx *sql.Context) {
+	expression.Dispose(ctx, b.u)
+}
+
+// userDefinedWindowFunction computes a user-defined aggregation over each window frame with a new aggregator.
+type userDefinedWindowFunction struct {
+	u      *UserDefinedAggregation
+	framer sql.WindowFramer
+}
+
+var _ sql.WindowFunction = (*userDefinedWindowFunction)(nil)
+
+func (w *userDefinedWindowFunction) WithWindow(ctx *sql.Context, window *sql.WindowDefinition) (sql.WindowFunction, error) {
+	nw := *w
+	if window != nil && window.Frame != nil {
+		framer, err := window.Frame.NewFramer(window)
+		if err != nil {
+			return nil, err
+		}
+		nw.framer = framer
+	}
+	return &nw, nil
+}
+
+// Dispose implements the Disposable interface.
+func (w *userDefinedWindowFunction) Dispose(ctx *sql.Context) {
+	expression.Dispose(ctx, w.u)
+}
+
+// DefaultFramer returns a NewUnboundedPrecedingToCurrentRowFramer
+func (w *userDefinedWindowFunction) DefaultFramer() sql.WindowFramer {
+	if w.framer != nil {
+		return w.framer
+	}
+	return NewUnboundedPrecedingToCurrentRowFramer()
+}
+
+// StartPartition implements sql.WindowFunction
+func (w *userDefinedWindowFunction) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
+	w.Dispose(ctx)
+	return nil
+}
+
+// Compute implements sql.WindowFunction
+func (w *userDefinedWindowFunction) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
+	agg, err := w.u.fn.NewAggregator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := interval.Start; i < interval.End; i++ {
+		if err = w.u.update(ctx, agg, buf[i]); err != nil {
+			return nil, err
+		}
+	}
+	return w.u.result(ctx, agg)
+}
@@ -295,7 +295,7 @@ func (b *BitAndAgg) DefaultFramer() sql.WindowFramer {
 	if b.framer != nil {
 		return b.framer
 	}
-	return NewPartitionFramer()
+	return NewUnboundedPrecedingToCurrentRowFramer()
 }
 
 func (b *BitAndAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
@@ -362,7 +362,7 @@ func (b *BitOrAgg) DefaultFramer() sql.WindowFramer {
 	if b.framer != nil {
 		return b.framer
 	}
-	return NewPartitionFramer()
+	return NewUnboundedPrecedingToCurrentRowFramer()
 }
 
 func (b *BitOrAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
@@ -429,7 +429,7 @@ func (b *BitXorAgg) DefaultFramer() sql.WindowFramer {
 	if b.framer != nil {
 		return b.framer
 	}
-	return NewPartitionFramer()
+	return NewUnboundedPrecedingToCurrentRowFramer()
 }
 
 func (b *BitXorAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
@@ -492,12 +492,12 @@ func (a *MaxAgg) Dispose(ctx *sql.Context) {
 	expression.Dispose(ctx, a.expr)
 }
 
-// DefaultFramer returns a NewPartitionFramer
+// DefaultFramer returns a NewUnboundedPrecedingToCurrentRowFramer
 func (a *MaxAgg) DefaultFramer() sql.WindowFramer {
 	if a.framer != nil {
 		return a.framer
 	}
-	return NewPartitionFramer()
+	return NewUnboundedPrecedingToCurrentRowFramer()
 }
 
 func (a *MaxAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) error {
@@ -911,75 +911,35 @@ func (a *GroupConcatAgg) Compute(ctx *sql.Context, interval sql.WindowInterval,
 		}
 	}
 
-	sb := strings.Builder{}
-	for i, row := range rows {
-		lastIdx := len(row) - 1
-		if i == 0 {
-			sb.WriteString(row[lastIdx].(string))
-		} else {
-			sb.WriteString(a.gc.separator)
-			sb.WriteString(row[lastIdx].(string))
-		}
-
-		// Don't allow the string to cross maxlen
-		if sb.Len() >= a.gc.maxLen {
+	var result groupConcatResult
+	for _, row := range rows {
+		if !result.append(a.gc, row[len(row)-1].(string)) {
 			break
 		}
 	}
-
-	ret := sb.String()
-
-	// There might be a couple of character differences even if we broke early in the loop
-	if len(ret) > a.gc.maxLen {
-		ret = ret[:a.gc.maxLen]
-	}
-
-	// Add this to handle any one off errors.
-	return ret, nil
+	return result.eval(ctx, a.gc)
 }
 
 func (a *GroupConcatAgg) filterToDistinct(ctx *sql.Context, buf sql.WindowBuffer) ([]sql.Row, map[string]struct{}, error) {
 	rows := make([]sql.Row, 0)
 	distinct := make(map[string]struct{}, 0)
 	for _, row := range buf {
-		evalRow, retType, err := evalExprs(ctx, a.gc.selectExprs, row)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		a.gc.returnType = retType
-
-		// Skip if this is a null row
-		if evalRow == nil {
-			continue
-		}
-
-		var v interface{}
-		if retType == types.Blob {
-			v, _, err = types.Blob.Convert(ctx, evalRow[0])
-		} else {
-			v, _, err = types.LongText.Convert(ctx, evalRow[0])
-		}
-
+		vals, ok, err := a.gc.evalValues(ctx, row)
 		if err != nil {
 			return nil, nil, err
 		}
-
-		if v == nil {
+		if !ok {
 			continue
 		}
+		vs := strings.Join(vals, "")
 
-		vs := v.(string)
-
-		// Get the current array of rows and the map
 		// Check if distinct is active if so look at and update our map
 		if a.gc.distinct != "" {
-			// If this value exists go ahead and return nil
-			if _, ok := distinct[vs]; ok {
+			key := distinctKey(vals)
+			if _, ok := distinct[key]; ok {
 				continue
-			} else {
-				distinct[vs] = struct{}{}
 			}
+			distinct[key] = struct{}{}
 		}
 
 		// Append the current value to the end of the row. We want to preserve the row's original structure for
@@ -1018,6 +978,9 @@ func (a *WindowedJSONArrayAgg) Dispose(ctx *sql.Context) {
 
 // DefaultFramer returns a NewUnboundedPrecedingToCurrentRowFramer
 func (a *WindowedJSONArrayAgg) DefaultFramer() sql.WindowFramer {
+	if a.framer != nil {
+		return a.framer
+	}
 	return NewUnboundedPrecedingToCurrentRowFramer()
 }
 
@@ -1031,9 +994,12 @@ func (a *WindowedJSONArrayAgg) NewSlidingFrameInterval(added, dropped sql.Window
 }
 
 func (a *WindowedJSONArrayAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
+	if interval.End <= interval.Start {
+		return nil, nil
+	}
 	res, err := a.aggregateVals(ctx, interval, buf)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return types.JSONDocument{Val: res}, nil
 }
@@ -1067,8 +1033,6 @@ func (a *WindowedJSONArrayAgg) aggregateVals(ctx *sql.Context, interval sql.Wind
 type WindowedJSONObjectAgg struct {
 	j      *JSONObjectAgg
 	framer sql.WindowFramer
-	// we need to eval the partition before Compute to return nil key errors
-	vals map[string]interface{}
 }
 
 func NewWindowedJSONObjectAgg(j *JSONObjectAgg) *WindowedJSONObjectAgg {
@@ -1103,8 +1067,8 @@ func (a *WindowedJSONObjectAgg) DefaultFramer() sql.WindowFramer {
 
 func (a *WindowedJSONObjectAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
 	a.Dispose(ctx)
-	var err error
-	a.vals, err = a.aggregateVals(ctx, interval, buf)
+	// we need to eval the whole partition before Compute to return nil key errors for rows outside of any frame
+	_, err := a.aggregateVals(ctx, interval, buf)
 	return err
 }
 
@@ -1113,10 +1077,14 @@ func (a *WindowedJSONObjectAgg) NewSlidingFrameInterval(added, dropped sql.Windo
 }
 
 func (a *WindowedJSONObjectAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
-	if len(a.vals) == 0 {
+	vals, err := a.aggregateVals(ctx, interval, buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
 		return nil, nil
 	}
-	return types.JSONDocument{Val: a.vals}, nil
+	return types.JSONDocument{Val: vals}, nil
 }
 
 func (a *WindowedJSONObjectAgg) aggregateVals(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (map[string]interface{}, error) {
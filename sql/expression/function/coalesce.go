@@ -219,3 +219,41 @@ func (c *Coalesce) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	return nil, nil
 }
+
+// EvalBatch implements the sql.BatchExpression interface.
+func (c *Coalesce) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	typ := c.Type(ctx)
+	convert := !types.IsEnum(typ) && !types.IsSet(typ)
+	res := make(sql.ColumnVector, batch.Len)
+	// each argument is evaluated for the rows whose previous arguments were all NULL, like Eval
+	sel := batch.Selected()
+	for _, arg := range c.args {
+		if arg == nil {
+			continue
+		}
+		if len(sel) == 0 {
+			break
+		}
+		vals, err := sql.EvalBatch(ctx, arg, batch.WithSelected(sel))
+		if err != nil {
+			return nil, err
+		}
+		var nulls []int
+		for _, i := range sel {
+			val := vals[i]
+			if val == nil {
+				nulls = append(nulls, i)
+				continue
+			}
+			if convert {
+				val, _, err = typ.Convert(ctx, val)
+				if err != nil {
+					return nil, err
+				}
+			}
+			res[i] = val
+		}
+		sel = nulls
+	}
+	return res, nil
+}
@@ -16,6 +16,7 @@ package function
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -102,7 +103,7 @@ func (c *ConvertTz) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	if fromStr == "SYSTEM" {
+	if strings.EqualFold(fromStr, "SYSTEM") {
 		fromStr = sql.SystemTimezoneOffset()
 	}
 
@@ -111,7 +112,7 @@ func (c *ConvertTz) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	if toStr == "SYSTEM" {
+	if strings.EqualFold(toStr, "SYSTEM") {
 		toStr = sql.SystemTimezoneOffset()
 	}
 
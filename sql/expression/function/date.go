@@ -414,7 +414,7 @@ func (r *FromUnixtime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	if format == nil {
 		return nil, nil
 	}
-	return formatDate(format.(string), t)
+	return formatDateInLocale(format.(string), t, sql.LoadTimeLocale(ctx))
 }
 
 // IsNullable implements sql.Expression.
@@ -17,6 +17,7 @@ package function
 import (
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/lestrrat-go/strftime"
@@ -94,10 +95,6 @@ func twentyFourHourNoPadding(t time.Time) string {
 	return fmt.Sprintf("%d", t.Hour())
 }
 
-func fullMonthName(t time.Time) string {
-	return t.Month().String()
-}
-
 func ampmClockStr(t time.Time) string {
 	hour, ampm := twelveHour(t)
 	return fmt.Sprintf("%02d:%02d:%02d %s", hour, t.Minute(), t.Second(), ampm)
@@ -160,10 +157,6 @@ func yearMode1(t time.Time) string {
 	return strconv.FormatInt(int64(yr), 10)
 }
 
-func dayName(t time.Time) string {
-	return t.Weekday().String()
-}
-
 func yearTwoDigit(t time.Time) string {
 	return strconv.FormatInt(int64(t.Year())%100, 10)
 }
@@ -181,7 +174,6 @@ func (af AppendFuncWrapper) Append(bytes []byte, t time.Time) []byte {
 	return append(bytes, []byte(s)...)
 }
 
-var mysqlDateFormatSpec = strftime.NewSpecificationSet()
 var dateFormatSpecifierToFunc = map[byte]func(time.Time) string{
 	'a': nil,
 	'b': nil,
@@ -197,7 +189,7 @@ var dateFormatSpecifierToFunc = map[byte]func(time.Time) string{
 	'j': nil,
 	'k': twentyFourHourNoPadding,
 	'l': twelveHourNoPadding,
-	'M': fullMonthName,
+	'M': nil,
 	'm': nil,
 	'p': nil,
 	'r': ampmClockStr,
@@ -208,7 +200,7 @@ var dateFormatSpecifierToFunc = map[byte]func(time.Time) string{
 	'u': weekMode1,
 	'V': weekMode2,
 	'v': weekMode3,
-	'W': dayName,
+	'W': nil,
 	'w': nil,
 	'X': yearMode0,
 	'x': yearMode1,
@@ -216,17 +208,27 @@ var dateFormatSpecifierToFunc = map[byte]func(time.Time) string{
 	'y': yearTwoDigit,
 }
 
-func init() {
+// dateFormatSpecs caches the strftime specification set of each locale, by locale name
+var dateFormatSpecs sync.Map
+
+// newDateFormatSpec returns the strftime specification set for DATE_FORMAT in the given locale. Month and day names
+// come from the locale, every other specifier is the same in all locales.
+func newDateFormatSpec(l *sql.Locale) strftime.SpecificationSet {
+	spec := strftime.NewSpecificationSet()
 	for specifier, fn := range dateFormatSpecifierToFunc {
 		if fn != nil {
-			panicIfErr(mysqlDateFormatSpec.Set(specifier, wrap(fn)))
+			panicIfErr(spec.Set(specifier, wrap(fn)))
 		}
 	}
+	panicIfErr(spec.Set('a', wrap(l.AbbrevDayName)))
+	panicIfErr(spec.Set('b', wrap(l.AbbrevMonthName)))
+	panicIfErr(spec.Set('M', wrap(l.MonthName)))
+	panicIfErr(spec.Set('W', wrap(l.DayName)))
 
 	// replace any strftime specifiers that aren't supported
 	fn := func(b byte) {
 		if _, ok := dateFormatSpecifierToFunc[b]; !ok {
-			panicIfErr(mysqlDateFormatSpec.Set(b, wrap(func(time.Time) string {
+			panicIfErr(spec.Set(b, wrap(func(time.Time) string {
 				return string(b)
 			})))
 		}
@@ -237,10 +239,27 @@ func init() {
 		fn(i)
 		fn(i + capToLower)
 	}
+	return spec
 }
 
+// dateFormatSpec returns the cached strftime specification set for the given locale
+func dateFormatSpec(l *sql.Locale) strftime.SpecificationSet {
+	if spec, ok := dateFormatSpecs.Load(l.Name); ok {
+		return spec.(strftime.SpecificationSet)
+	}
+	spec, _ := dateFormatSpecs.LoadOrStore(l.Name, newDateFormatSpec(l))
+	return spec.(strftime.SpecificationSet)
+}
+
+// formatDate formats |t| with the MySQL date format string |format|, using English month and day names
 func formatDate(format string, t time.Time) (string, error) {
-	formatter, err := strftime.New(format, strftime.WithSpecificationSet(mysqlDateFormatSpec))
+	l, _ := sql.LookupLocale(sql.DefaultTimeLocale)
+	return formatDateInLocale(format, t, l)
+}
+
+// formatDateInLocale formats |t| with the MySQL date format string |format|, using the month and day names of |l|
+func formatDateInLocale(format string, t time.Time, l *sql.Locale) (string, error) {
+	formatter, err := strftime.New(format, strftime.WithSpecificationSet(dateFormatSpec(l)))
 
 	if err != nil {
 		return "", err
@@ -315,7 +334,7 @@ func (f *DateFormat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrInvalidArgumentDetails.New("DATE_FORMAT", "format must be a string")
 	}
 
-	return formatDate(formatStr, t)
+	return formatDateInLocale(formatStr, t, sql.LoadTimeLocale(ctx))
 }
 
 // Type implements the Expression interface.
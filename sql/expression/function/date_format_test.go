@@ -82,6 +82,30 @@ func TestDateFormatting(t *testing.T) {
 	}
 }
 
+func TestDateFormattingInLocale(t *testing.T) {
+	dt := time.Date(2020, 3, 1, 16, 5, 6, 0, time.UTC)
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"en_US", "Sunday Sun 1st March Mar PM"},
+		{"de_DE", "Sonntag So 1st März Mär PM"},
+		{"fr_FR", "dimanche dim 1st mars mar PM"},
+		{"es_MX", "domingo dom 1st marzo mar PM"},
+		{"ja_JP", "日曜日 日 1st 3月 3月 PM"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.locale, func(t *testing.T) {
+			l, ok := sql.LookupLocale(test.locale)
+			require.True(t, ok)
+			result, err := formatDateInLocale("%W %a %D %M %b %p", dt, l)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
 func TestUnsupportedSpecifiers(t *testing.T) {
 	testFunc := func(t *testing.T, b byte) {
 		if _, ok := dateFormatSpecifierToFunc[b]; !ok {
@@ -0,0 +1,106 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// DatabaseDumper writes the dump of the database named |database| to |w|.
+type DatabaseDumper func(ctx *sql.Context, w io.Writer, database string) error
+
+// DumpDatabase is the DUMP_DATABASE(name) function, which returns the SQL statements that recreate a database, in the
+// format of mysqldump. The dump is written by the engine the function is registered with.
+type DumpDatabase struct {
+	expression.UnaryExpressionStub
+	dumper DatabaseDumper
+}
+
+var _ sql.FunctionExpression = (*DumpDatabase)(nil)
+var _ sql.CollationCoercible = (*DumpDatabase)(nil)
+var _ sql.NonDeterministicExpression = (*DumpDatabase)(nil)
+
+// NewDumpDatabase returns the constructor of the DUMP_DATABASE function that dumps databases with |dumper|.
+func NewDumpDatabase(dumper DatabaseDumper) sql.CreateFunc1Args {
+	return func(ctx *sql.Context, e sql.Expression) sql.Expression {
+		return &DumpDatabase{UnaryExpressionStub: expression.UnaryExpressionStub{Child: e}, dumper: dumper}
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (d *DumpDatabase) FunctionName() string {
+	return "dump_database"
+}
+
+// Description implements sql.FunctionExpression
+func (d *DumpDatabase) Description() string {
+	return "returns the SQL statements that recreate the given database."
+}
+
+// String implements sql.Expression
+func (d *DumpDatabase) String() string {
+	return fmt.Sprintf("%s(%s)", d.FunctionName(), d.Child)
+}
+
+// Type implements sql.Expression
+func (d *DumpDatabase) Type(ctx *sql.Context) sql.Type {
+	return types.LongText
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*DumpDatabase) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (d *DumpDatabase) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (d *DumpDatabase) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	name, err := d.Child.Eval(ctx, row)
+	if err != nil || name == nil {
+		return nil, err
+	}
+	name, _, err = types.LongText.Convert(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	// the statements that read the database run within this one, which must not commit the transaction
+	_, err = sql.RunInterpreted(ctx, func(ctx *sql.Context) (struct{}, error) {
+		return struct{}{}, d.dumper(ctx, &sb, name.(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sb.String(), nil
+}
+
+// WithChildren implements sql.Expression
+func (d *DumpDatabase) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+	return NewDumpDatabase(d.dumper)(ctx, children[0]), nil
+}
@@ -61,3 +61,22 @@ func (uf *UnaryFunc) String() string {
 func (uf *UnaryFunc) Type(ctx *sql.Context) sql.Type {
 	return uf.RetType
 }
+
+// evalUnaryBatch evaluates |child| for the selected rows of |batch|, returning the result of |f| for each value that
+// isn't NULL. The results of NULL values are NULL.
+func evalUnaryBatch(ctx *sql.Context, child sql.Expression, batch *sql.RowBatch, f func(ctx *sql.Context, v interface{}) (interface{}, error)) (sql.ColumnVector, error) {
+	vals, err := sql.EvalBatch(ctx, child, batch)
+	if err != nil {
+		return nil, err
+	}
+	res := make(sql.ColumnVector, batch.Len)
+	for _, i := range batch.Selected() {
+		if vals[i] == nil {
+			continue
+		}
+		if res[i], err = f(ctx, vals[i]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
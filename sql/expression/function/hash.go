@@ -311,9 +311,13 @@ func (f *Compress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Prepend length of original string
-	lenHeader := make([]byte, 4)
+	lenHeader := make([]byte, compressHeaderSize)
 	binary.LittleEndian.PutUint32(lenHeader, uint32(len(valBytes)))
 	res := append(lenHeader, buf.Bytes()...)
+	// MySQL appends a period to results ending in a space, so that they survive being stored in a CHAR column
+	if res[len(res)-1] == ' ' {
+		res = append(res, '.')
+	}
 	return res, nil
 }
 
@@ -378,7 +382,7 @@ func (f *Uncompress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return []byte{}, nil
 	}
 	if len(valBytes) <= compressHeaderSize {
-		ctx.Warn(1258, "input data corrupted")
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
 		return nil, nil
 	}
 
@@ -386,26 +390,36 @@ func (f *Uncompress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	inBuf.Write(valBytes[compressHeaderSize:]) // skip length header
 	reader, err := zlib.NewReader(&inBuf)
 	if err != nil {
-		return nil, err
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return nil, nil
 	}
 	defer reader.Close()
 
 	outLen := binary.LittleEndian.Uint32(valBytes[:compressHeaderSize])
 	if outLen > compressMaxSize {
-		ctx.Warn(1258, "Uncompressed data too large; the maximum size is %d", compressMaxSize)
+		ctx.Warn(1256, "Uncompressed data size too large; the maximum size is %d (probably, length of uncompressed data was corrupted)", compressMaxSize)
 		return nil, nil
 	}
 
+	// the header only bounds the output, the stream may be delivered over several reads
 	outBuf := make([]byte, outLen)
-	readLen, err := reader.Read(outBuf)
-	if err != nil && err != io.EOF {
-		ctx.Warn(1258, "%s", err.Error())
+	readLen, err := io.ReadFull(reader, outBuf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
 		return nil, nil
 	}
-	// if we don't receive io.EOF, then received outLen was too small
 	if err == nil {
-		ctx.Warn(1258, "not enough room in output buffer")
-		return nil, nil
+		// the output buffer is full, so the stream must end here or outLen was too small
+		var extra [1]byte
+		n, err := reader.Read(extra[:])
+		if n > 0 {
+			ctx.Warn(1258, "ZLIB: Not enough room in the output buffer (probably, length of uncompressed data was corrupted)")
+			return nil, nil
+		}
+		if err != nil && err != io.EOF {
+			ctx.Warn(1259, "ZLIB: Input data corrupted")
+			return nil, nil
+		}
 	}
 	return outBuf[:readLen], nil
 }
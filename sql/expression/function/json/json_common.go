@@ -24,7 +24,7 @@ import (
 )
 
 var ErrInvalidPath = fmt.Errorf("Invalid JSON path expression")
-var ErrPathWildcard = fmt.Errorf("Path expressions may not contain the * and ** tokens")
+var ErrPathWildcard = fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range.")
 
 type invalidJson string
 
@@ -46,6 +46,18 @@ func getMutableJSONVal(ctx *sql.Context, row sql.Row, json sql.Expression) (type
 	return MutableJsonDoc(ctx, doc)
 }
 
+// getPartialJSONVal returns a JSONValue from the given row and expression that records the changes made by the mutation
+// functions as diffs against the underlying value, which is not copied. See types.JSONPartialUpdate.
+// nil will be returned only if the inputs are nil. This will not return an error, so callers must check.
+func getPartialJSONVal(ctx *sql.Context, row sql.Row, json sql.Expression) (types.MutableJSON, error) {
+	doc, err := getJSONDocumentFromRow(ctx, row, json)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+
+	return types.NewJSONPartialUpdate(doc), nil
+}
+
 // getSearchableJSONVal returns a SearchableJSONValue from the given row and expression. The underlying value is not copied
 // so it is intended to be used for read-only operations.
 // nil will be returned only if the inputs are nil. This will not return an error, so callers must check.
@@ -125,6 +137,28 @@ type pathValPair struct {
 	path string
 }
 
+// lookupSingleValue returns the value in |doc| at |path|, which can't be a path matching several values.
+func lookupSingleValue(ctx *sql.Context, doc sql.JSONWrapper, path string) (sql.JSONWrapper, error) {
+	jsonPath, err := types.ParseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if jsonPath.IsMultiValued() {
+		return nil, ErrPathWildcard
+	}
+	return types.LookupJSONValue(ctx, doc, path)
+}
+
+// checkSingleValuedPath returns ErrPathWildcard if |path| contains a wildcard, the ** ellipsis or an array range,
+// which functions that modify a document don't allow. Paths that fail to parse are left for the caller to report.
+func checkSingleValuedPath(path string) error {
+	jsonPath, err := types.ParseJSONPath(path)
+	if err == nil && jsonPath.IsMultiValued() {
+		return ErrPathWildcard
+	}
+	return nil
+}
+
 // buildPath builds a path from the given row and expression
 func buildPath(ctx *sql.Context, pathExp sql.Expression, row sql.Row) (*string, error) {
 	path, err := pathExp.Eval(ctx, row)
@@ -151,6 +185,9 @@ func buildPathValue(ctx *sql.Context, pathExp sql.Expression, valExp sql.Express
 	if path == nil {
 		return nil, nil
 	}
+	if err = checkSingleValuedPath(*path); err != nil {
+		return nil, err
+	}
 
 	val, err := valExp.Eval(ctx, row)
 	if err != nil {
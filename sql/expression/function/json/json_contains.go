@@ -147,7 +147,7 @@ func (j *JSONContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 			return nil, err
 		}
 
-		extracted, err := types.LookupJSONValue(ctx, target, path.(string))
+		extracted, err := lookupSingleValue(ctx, target, path.(string))
 		if err != nil {
 			return nil, err
 		}
@@ -111,9 +111,11 @@ func TestJSONContains(t *testing.T) {
 		{f, sql.Row{json, json, "$.foo"}, nil, nil},
 		{f, sql.Row{json, `"foo"`, "$.b.c"}, true, nil},
 		{f, sql.Row{json, `1`, "$.e[0][0]"}, true, nil},
-		{f, sql.Row{json, `1`, "$.e[0][*]"}, true, nil},
+		{f, sql.Row{json, `1`, "$.e[0][*]"}, nil, ErrPathWildcard},
 		{f, sql.Row{json, `1`, "$.e[0][0]"}, true, nil},
-		{f, sql.Row{json, `[1, 2]`, "$.e[0][*]"}, true, nil},
+		{f, sql.Row{json, `[1, 2]`, "$.e[0][*]"}, nil, ErrPathWildcard},
+		{f, sql.Row{json, `1`, "$**.e"}, nil, ErrPathWildcard},
+		{f, sql.Row{json, `[3, 4]`, "$.e[last]"}, true, nil},
 		{f, sql.Row{json, `[1, 2]`, "$.e[0]"}, true, nil},
 		{f, sql.Row{json, json, "$"}, true, nil},       // reflexivity
 		{f, sql.Row{json, goodMap, "$.e"}, false, nil}, // The path statement selects an array, which does not contain goodMap
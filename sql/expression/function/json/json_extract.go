@@ -90,8 +90,10 @@ func (j *JSONExtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return fmt.Errorf("expected types.JSONValue, found: %T", js), nil
 	}
 
-	var results = make([]sql.JSONWrapper, len(j.Paths))
-	for i, p := range j.Paths {
+	// With several paths, or with a path that can match several values, the matches are all returned in an array
+	var results []interface{}
+	var wrap bool
+	for _, p := range j.Paths {
 		path, err := p.Eval(ctx, row)
 		if err != nil {
 			return nil, err
@@ -106,17 +108,39 @@ func (j *JSONExtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return nil, nil
 		}
 
-		results[i], err = types.LookupJSONValue(ctx, searchable, path.(string))
+		jsonPath, err := types.ParseJSONPath(path.(string))
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract from expression '%s'; %s", j.JSON.String(), err.Error())
 		}
-	}
+		multiValued := jsonPath.IsMultiValued()
+		wrap = wrap || multiValued || len(j.Paths) > 1
+
+		res, err := types.LookupJSONValue(ctx, searchable, path.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract from expression '%s'; %s", j.JSON.String(), err.Error())
+		}
+		if res == nil {
+			continue
+		}
+		if !wrap {
+			return res, nil
+		}
 
-	if len(results) == 1 {
-		return results[0], nil
+		val, err := res.ToInterface(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if arr, ok := val.(types.JsonArray); ok && multiValued {
+			results = append(results, arr...)
+		} else {
+			results = append(results, val)
+		}
 	}
 
-	return types.ConcatenateJSONValues(ctx, results...)
+	if len(results) == 0 {
+		return nil, nil
+	}
+	return types.JSONDocument{Val: types.JsonArray(results)}, nil
 }
 
 // IsNullable implements the sql.Expression interface.
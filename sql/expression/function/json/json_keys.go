@@ -18,9 +18,6 @@ import (
 	"fmt"
 	"sort"
 
-	"github.com/dolthub/jsonpath"
-	"gopkg.in/src-d/go-errors.v1"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -105,11 +102,8 @@ func (j *JSONKeys) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	js, err := types.LookupJSONValue(ctx, doc, *path)
+	js, err := lookupSingleValue(ctx, doc, *path)
 	if err != nil {
-		if errors.Is(err, jsonpath.ErrKeyError) {
-			return nil, nil
-		}
 		return nil, err
 	}
 
@@ -95,7 +95,7 @@ func (j *JsonLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, strErr
 	}
 
-	res, err := types.LookupJSONValue(ctx, doc, path)
+	res, err := lookupSingleValue(ctx, doc, path)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,154 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// value MEMBER OF(json_array)
+//
+// JSONMemberOf Returns true (1) if value is an element of json_array, otherwise returns false (0). value must be a
+// scalar or a JSON document; if it is a scalar, the operator attempts to treat it as an element of a JSON array. A
+// string value is compared as a JSON string, rather than being parsed as a JSON document. If json_array isn't an
+// array, it's treated as an array holding only that value.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#operator_member-of
+type JSONMemberOf struct {
+	Value     sql.Expression
+	JSONArray sql.Expression
+}
+
+var _ sql.FunctionExpression = &JSONMemberOf{}
+var _ sql.CollationCoercible = &JSONMemberOf{}
+
+// NewJSONMemberOf creates a new JSONMemberOf expression.
+func NewJSONMemberOf(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("MEMBER OF", "2", len(args))
+	}
+	return &JSONMemberOf{Value: args[0], JSONArray: args[1]}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j *JSONMemberOf) FunctionName() string {
+	return "member of"
+}
+
+// Description implements sql.FunctionExpression
+func (j *JSONMemberOf) Description() string {
+	return "returns true (1) if value is an element of json_array, otherwise returns false (0)."
+}
+
+// Resolved implements sql.Expression
+func (j *JSONMemberOf) Resolved() bool {
+	return j.Value.Resolved() && j.JSONArray.Resolved()
+}
+
+// String implements sql.Expression
+func (j *JSONMemberOf) String() string {
+	return fmt.Sprintf("(%s MEMBER OF (%s))", j.Value.String(), j.JSONArray.String())
+}
+
+// Type implements sql.Expression
+func (j *JSONMemberOf) Type(ctx *sql.Context) sql.Type {
+	return types.Boolean
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*JSONMemberOf) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements sql.Expression
+func (j *JSONMemberOf) IsNullable(ctx *sql.Context) bool {
+	return j.Value.IsNullable(ctx) || j.JSONArray.IsNullable(ctx)
+}
+
+// Eval implements sql.Expression
+func (j *JSONMemberOf) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONMemberOf")
+	defer span.End()
+
+	val, err := j.Value.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	doc, err := getJSONDocumentFromRow(ctx, row, j.JSONArray)
+	if err != nil {
+		return nil, getJsonFunctionError("member of", 2, err)
+	}
+	if doc == nil {
+		return nil, nil
+	}
+	arrayVal, err := doc.ToInterface(ctx)
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := arrayVal.(types.JsonArray)
+	if !ok {
+		arr = types.JsonArray{arrayVal}
+	}
+
+	var candidate interface{}
+	switch v := val.(type) {
+	case sql.JSONWrapper:
+		candidate, err = v.ToInterface(ctx)
+		if err != nil {
+			return nil, err
+		}
+	case string:
+		candidate = v
+	case []byte:
+		candidate = string(v)
+	default:
+		converted, _, err := types.JSON.Convert(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		candidate, err = converted.(sql.JSONWrapper).ToInterface(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, elem := range arr {
+		if (candidate == nil) != (elem == nil) {
+			continue
+		}
+		cmp, err := types.CompareJSON(ctx, candidate, elem)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Children implements sql.Expression
+func (j *JSONMemberOf) Children() []sql.Expression {
+	return []sql.Expression{j.Value, j.JSONArray}
+}
+
+// WithChildren implements sql.Expression
+func (j *JSONMemberOf) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONMemberOf(ctx, children...)
+}
@@ -102,7 +102,7 @@ func (j JSONRemove) Description() string {
 }
 
 func (j JSONRemove) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	doc, err := getMutableJSONVal(ctx, row, j.doc)
+	doc, err := getPartialJSONVal(ctx, row, j.doc)
 	if err != nil || doc == nil {
 		return nil, getJsonFunctionError("json_remove", 1, err)
 	}
@@ -115,6 +115,9 @@ func (j JSONRemove) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		if path == nil {
 			return nil, nil
 		}
+		if err = checkSingleValuedPath(*path); err != nil {
+			return nil, err
+		}
 
 		doc, _, err = doc.Remove(ctx, *path)
 		if err != nil {
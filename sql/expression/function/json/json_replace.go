@@ -73,7 +73,7 @@ func (j JSONReplace) IsNullable(ctx *sql.Context) bool {
 }
 
 func (j JSONReplace) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	doc, err := getMutableJSONVal(ctx, row, j.doc)
+	doc, err := getPartialJSONVal(ctx, row, j.doc)
 	if err != nil || doc == nil {
 		return nil, getJsonFunctionError("json_replace", 1, err)
 	}
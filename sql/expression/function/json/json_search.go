@@ -18,9 +18,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/dolthub/jsonpath"
-	"gopkg.in/src-d/go-errors.v1"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -163,42 +160,6 @@ func (j *JSONSearch) IsNullable(ctx *sql.Context) bool {
 		(j.Escape != nil && j.Escape.IsNullable(ctx))
 }
 
-// jsonSearch recursively searches a JSON object for a string that matches the given matcher. It returns the path to the
-// matched string. If once is true, it will only return the first match, breaking out of the recursion early.
-func jsonSearch(json interface{}, matcher *expression.LikeMatcher, currPath string, once bool) ([]string, bool) {
-	switch js := json.(type) {
-	case string:
-		if matcher.Match(js) {
-			return []string{currPath}, once
-		}
-		return nil, false
-	case []interface{}:
-		var results []string
-		for i, v := range js {
-			path := fmt.Sprintf("%s[%d]", currPath, i)
-			res, stop := jsonSearch(v, matcher, path, once)
-			if stop {
-				return res, true
-			}
-			results = append(results, res...)
-		}
-		return results, false
-	case map[string]interface{}:
-		var results []string
-		for k, v := range js {
-			path := fmt.Sprintf("%s.%s", currPath, k)
-			res, stop := jsonSearch(v, matcher, path, once)
-			if stop {
-				return res, true
-			}
-			results = append(results, res...)
-		}
-		return results, false
-	default:
-		return nil, false
-	}
-}
-
 // Eval implements sql.Expression
 func (j *JSONSearch) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	span, ctx := ctx.Span(fmt.Sprintf("function.%s", j.FunctionName()))
@@ -274,24 +235,26 @@ func (j *JSONSearch) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
-	var paths []string
+	var paths []*types.JSONPath
 	if len(j.Paths) == 0 {
-		paths = []string{"$"}
+		paths = []*types.JSONPath{{}}
 	} else {
 		for _, p := range j.Paths {
 			if p == nil {
 				return nil, nil
 			}
 
-			path := "$"
-			if newPath, err := buildPath(ctx, p, row); err != nil {
+			path, err := buildPath(ctx, p, row)
+			if err != nil {
 				return nil, err
-			} else if newPath == nil {
+			} else if path == nil {
 				return nil, nil
-			} else {
-				path = *newPath
 			}
-			paths = append(paths, path)
+			jsonPath, err := types.ParseJSONPath(*path)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, jsonPath)
 		}
 	}
 
@@ -300,25 +263,27 @@ func (j *JSONSearch) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
+	// The strings searched are the ones matched by each path and the ones below them, which are visited in document
+	// order. The paths to the matches are only reported once, even when several paths reach them.
 	seen := make(map[string]struct{})
 	var results []string
 	for _, path := range paths {
-		js, err := jsonpath.JsonPathLookup(val, path)
-		if err != nil && !errors.Is(err, jsonpath.ErrKeyError) {
-			return nil, err
-		}
-
-		// Special Case: drop $[*] from path
-		if path == "$[*]" {
-			path = "$"
-		}
-
-		res, _ := jsonSearch(js, &lm, path, isOne)
-		for _, r := range res {
-			if _, ok := seen[r]; !ok {
-				seen[r] = struct{}{}
-				results = append(results, r)
+		for _, m := range path.WithDescendants().FindPaths(val) {
+			str, ok := m.Value.(string)
+			if !ok || !lm.Match(str) {
+				continue
+			}
+			if _, ok := seen[m.Path]; ok {
+				continue
 			}
+			seen[m.Path] = struct{}{}
+			results = append(results, m.Path)
+			if isOne {
+				break
+			}
+		}
+		if isOne && len(results) > 0 {
+			break
 		}
 	}
 
@@ -330,7 +295,7 @@ func (j *JSONSearch) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// Need to format single results as JSON strings, and multiple results as JSON arrays of strings
 	var finalResults interface{}
 	if len(results) == 1 {
-		finalResults = fmt.Sprintf(`"%s"`, results[0])
+		finalResults = types.JSONDocument{Val: results[0]}
 	} else {
 		finalResults = results
 	}
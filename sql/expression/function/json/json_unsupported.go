@@ -23,16 +23,6 @@ import (
 // ErrUnsupportedJSONFunction is returned when a unsupported JSON function is called.
 var ErrUnsupportedJSONFunction = errors.NewKind("unsupported JSON function: %s")
 
-// value MEMBER OF(json_array)
-//
-// Returns true (1) if value is an element of json_array, otherwise returns false (0). value must be a scalar or a JSON
-// document; if it is a scalar, the operator attempts to treat it as an element of a JSON array. Queries using
-// MEMBER OF() on JSON columns of InnoDB tables in the WHERE clause can be optimized using multi-valued indexes. See
-// Multi-Valued Indexes, for detailed information and examples.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#operator_member-of
-// TODO(andy): relocate
-
 //////////////////////////
 // JSON table functions //
 //////////////////////////
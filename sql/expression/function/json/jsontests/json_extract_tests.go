@@ -94,14 +94,31 @@ func JsonExtractTestCases(t *testing.T, prepare prepareJsonValue) []testCase {
 		{f: f4, row: sql.Row{jsonInput, "$.b.c", "$.b.d", "$.e[0][*]"}, expected: types.JSONDocument{Val: []interface{}{
 			"foo",
 			true,
-			[]interface{}{1., 2.},
+			1.,
+			2.,
 		}}},
+		{f: f3, row: sql.Row{jsonInput, "$.a[1]", "$.a[9]"}, expected: types.JSONDocument{Val: []interface{}{2.}}},
+		{f: f3, row: sql.Row{jsonInput, "$.a[8]", "$.a[9]"}},
+		{f: f2, row: sql.Row{jsonInput, "$.a[last]"}, expected: types.JSONDocument{Val: 4.}},
+		{f: f2, row: sql.Row{jsonInput, "$.a[last-1]"}, expected: types.JSONDocument{Val: 3.}},
+		{f: f2, row: sql.Row{jsonInput, "$.a[1 to 2]"}, expected: types.JSONDocument{Val: []interface{}{2., 3.}}},
+		{f: f2, row: sql.Row{jsonInput, "$.a[last-1 to last]"}, expected: types.JSONDocument{Val: []interface{}{3., 4.}}},
+		{f: f2, row: sql.Row{jsonInput, "$.a[0 to 0]"}, expected: types.JSONDocument{Val: []interface{}{1.}}},
+		{f: f2, row: sql.Row{jsonInput, "$.b.c[0]"}, expected: types.JSONDocument{Val: "foo"}},
+		{f: f2, row: sql.Row{jsonInput, "$**.c"}, expected: types.JSONDocument{Val: []interface{}{"foo"}}},
+		{f: f2, row: sql.Row{jsonInput, "$**.x"}},
+		{f: f2, row: sql.Row{jsonInput, "$.e[*][last]"}, expected: types.JSONDocument{Val: []interface{}{2., 4.}}},
 
 		{f: f2, row: sql.Row{jsonInput, `$.f."key.with.dots"`}, expected: types.JSONDocument{Val: float64(0)}},
 		{f: f2, row: sql.Row{jsonInput, `$.f."key with spaces"`}, expected: types.JSONDocument{Val: float64(1)}},
 		{f: f2, row: sql.Row{jsonInput, `$.f.key with spaces`}, expected: types.JSONDocument{Val: float64(1)}},
 		{f: f2, row: sql.Row{jsonInput, `$.f.key'with'squotes`}, expected: types.JSONDocument{Val: float64(3)}},
 		{f: f2, row: sql.Row{jsonInput, `$.f."key'with'squotes"`}, expected: types.JSONDocument{Val: float64(3)}},
+		{f: f2, row: sql.Row{jsonInput, `$.f."key\"with\"dquotes"`}, expected: types.JSONDocument{Val: float64(2)}},
+		{f: f2, row: sql.Row{jsonInput, `$.f."key\\with\\backslashes"`}, expected: types.JSONDocument{Val: float64(4)}},
+		{f: f2, row: sql.Row{jsonInput, `$.f.*`}, expected: types.JSONDocument{Val: []interface{}{
+			float64(0), float64(1), float64(2), float64(3), float64(4),
+		}}},
 
 		// Error when the document isn't JSON or a coercible string
 		{f: f2, row: sql.Row{1, `$.f`}, err: sql.ErrInvalidJSONArgument.New(1, "json_extract")},
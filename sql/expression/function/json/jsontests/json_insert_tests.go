@@ -135,7 +135,7 @@ func JsonInsertTestCases(t *testing.T, prepare prepareJsonValue) []testCase {
 			name: "path contains * wildcard",
 			f:    f1,
 			row:  sql.Row{jsonInput, "$.c.*", "test"},
-			err:  fmt.Errorf("Invalid JSON path expression. Expected field name after '.' at character 4 of $.c.*"),
+			err:  fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range."),
 		},
 		{
 			name: "path contains ** wildcard",
@@ -109,7 +109,31 @@ func JsonRemoveTestCases(t *testing.T, prepare prepareJsonValue) []testCase {
 			name: "path contains * wildcard",
 			f:    f1,
 			row:  sql.Row{json, "$.c.*", "test"},
-			err:  fmt.Errorf("Invalid JSON path expression. Expected field name after '.' at character 4 of $.c.*"),
+			err:  fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range."),
+		},
+		{
+			name: "path is a member wildcard",
+			f:    f1,
+			row:  sql.Row{json, "$.*"},
+			err:  fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range."),
+		},
+		{
+			name: "path contains an array wildcard",
+			f:    f1,
+			row:  sql.Row{json, "$.b[*]"},
+			err:  fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range."),
+		},
+		{
+			name: "path contains an ellipsis",
+			f:    f1,
+			row:  sql.Row{json, "$**.a"},
+			err:  fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range."),
+		},
+		{
+			name: "path contains an array range",
+			f:    f1,
+			row:  sql.Row{json, "$.b[0 to 1]"},
+			err:  fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range."),
 		},
 		{
 			name: "path contains ** wildcard",
@@ -116,7 +116,7 @@ func JsonReplaceTestCases(t *testing.T, prepare prepareJsonValue) []testCase {
 			name: "path contains * wildcard",
 			f:    f1,
 			row:  sql.Row{json, "$.c.*", "test"},
-			err:  fmt.Errorf("Invalid JSON path expression. Expected field name after '.' at character 4 of $.c.*"),
+			err:  fmt.Errorf("In this situation, path expressions may not contain the * and ** tokens or an array range."),
 		}, //
 		{
 			name: "path contains ** wildcard",
@@ -148,17 +148,34 @@ func TestJSONSearch(t *testing.T) {
 			exp: types.MustJSON(`"$[1][0].k"`),
 		},
 		{
-			// TODO: need to implement ** wildcard in jsonpath package
-			skip: true,
-			f:    f5,
-			row:  sql.Row{jsonInput, "all", "10", nil, "$**.k"},
-			exp:  types.MustJSON(`"$[1][0].k"`),
+			f:   f5,
+			row: sql.Row{jsonInput, "all", "10", nil, "$**.k"},
+			exp: types.MustJSON(`"$[1][0].k"`),
+		},
+		{
+			f:   f5,
+			row: sql.Row{jsonInput, "all", "10", nil, "$[*][0].k"},
+			exp: types.MustJSON(`"$[1][0].k"`),
+		},
+		{
+			f:   f5,
+			row: sql.Row{jsonInput, "all", "%b%", nil, "$[last-1 to last]"},
+			exp: types.MustJSON(`["$[2].x", "$[3].y"]`),
 		},
 		{
-			skip: true,
-			f:    f5,
-			row:  sql.Row{jsonInput, "all", "10", nil, "$[*][0].k"},
-			exp:  types.MustJSON(`"$[1][0].k"`),
+			f:   f5,
+			row: sql.Row{jsonInput, "all", "abc", nil, "$[last]"},
+			exp: nil,
+		},
+		{
+			f:   f3,
+			row: sql.Row{`{"d e": "abc", "bb": ["abc"], "a": {"c": "abc"}}`, "all", "abc"},
+			exp: types.MustJSON(`["$.a.c", "$.bb[0]", "$.\"d e\""]`),
+		},
+		{
+			f:   f3,
+			row: sql.Row{`{"d e": "abc", "bb": ["abc"], "a": {"c": "abc"}}`, "one", "abc"},
+			exp: types.MustJSON(`"$.a.c"`),
 		},
 		{
 			f:   f5,
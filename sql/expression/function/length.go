@@ -118,7 +118,16 @@ func (l *Length) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if val == nil {
 		return nil, nil
 	}
+	return l.length(ctx, val)
+}
+
+// EvalBatch implements the sql.BatchExpression interface.
+func (l *Length) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return evalUnaryBatch(ctx, l.Child, batch, l.length)
+}
 
+// length returns the length of |val|, which isn't NULL.
+func (l *Length) length(ctx *sql.Context, val interface{}) (interface{}, error) {
 	if wrapper, isWrapper := val.(sql.AnyWrapper); isWrapper && wrapper.IsExactLength() {
 		return int32(wrapper.MaxByteLength()), nil
 	}
@@ -15,9 +15,10 @@
 package function
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"io"
+	"io/fs"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -70,102 +71,42 @@ func (l *LoadFile) IsNullable(ctx *sql.Context) bool {
 // TODO: Allow FILE privileges for GRANT
 // Eval implements sql.Expression.
 func (l *LoadFile) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	dir, err := ctx.Session.GetSessionVariable(ctx, "secure_file_priv")
-	if err != nil {
-		return "", err
-	}
-
-	// Read the file: Ensure it fits the max byte size
-	file, err := l.getFile(ctx, row, dir.(string))
-	if err != nil {
-		// If the file doesn't exist we swallow that error
-		if os.IsNotExist(err) {
-			return nil, nil
-		}
-
-		return nil, err
-	}
-	if file == nil {
-		return nil, nil
-	}
-
-	defer file.Close()
-
-	size, isTooBig, err := isFileTooBig(ctx, file)
-	if err != nil {
-		return nil, err
-	}
-	// According to the mysql spec we must return NULL if the file is too big.
-	if isTooBig {
-		return nil, nil
-	}
-
-	// Finally, read the file
-	data := make([]byte, size)
-	_, err = file.Read(data)
-	if err != nil {
-		return nil, err
-	}
-
-	return data, nil
-}
-
-// getFile returns the file handler for the passed in filename. The file must be in the secure_file_priv
-// directory.
-func (l *LoadFile) getFile(ctx *sql.Context, row sql.Row, secureFileDir string) (*os.File, error) {
 	fileName, err := l.fileName.Eval(ctx, row)
-	if err != nil {
+	if err != nil || fileName == nil {
 		return nil, err
 	}
-
-	// If the secure_file_priv directory is not set, just read the file from whatever directory it is in
-	// Otherwise determine whether the file is in the secure_file_priv directory.
-	if secureFileDir == "" {
-		return os.Open(fileName.(string))
-	}
-
-	// Open the two directories (secure_file_priv and the file dir) and validate they are the same.
-	sDir, err := os.Open(secureFileDir)
+	fileName, _, err = types.LongText.Convert(ctx, fileName)
 	if err != nil {
 		return nil, err
 	}
 
-	sStat, err := sDir.Stat()
+	// The file must be in the secure_file_priv directory, and is read from the server's file system
+	file, err := sql.OpenSecureFile(ctx, fileName.(string))
 	if err != nil {
+		// If the file doesn't exist or may not be read we swallow that error
+		if errors.Is(err, fs.ErrNotExist) || sql.ErrSecureFilePriv.Is(err) {
+			return nil, nil
+		}
 		return nil, err
 	}
+	defer file.Close()
 
-	ffDir, err := os.Open(filepath.Dir(fileName.(string)))
+	maxSize, err := ctx.Session.GetSessionVariable(ctx, "max_allowed_packet")
 	if err != nil {
 		return nil, err
 	}
 
-	fStat, err := ffDir.Stat()
+	// Read the file: Ensure it fits the max byte size
+	data, err := io.ReadAll(io.LimitReader(file, maxSize.(int64)+1))
 	if err != nil {
 		return nil, err
 	}
-
-	// If the two directories are not equivalent we return nil
-	if !os.SameFile(sStat, fStat) {
+	// According to the mysql spec we must return NULL if the file is too big.
+	if int64(len(data)) > maxSize.(int64) {
 		return nil, nil
 	}
 
-	return os.Open(fileName.(string))
-}
-
-// isFileTooBig return the current file size and whether or not it is larger than max_allowed_packet.
-func isFileTooBig(ctx *sql.Context, file *os.File) (int64, bool, error) {
-	fi, err := file.Stat()
-	if err != nil {
-		return -1, false, err
-	}
-
-	val, err := ctx.Session.GetSessionVariable(ctx, "max_allowed_packet")
-	if err != nil {
-		return -1, false, err
-	}
-
-	return fi.Size(), fi.Size() > val.(int64), nil
+	return data, nil
 }
 
 // Children implements sql.Expression.
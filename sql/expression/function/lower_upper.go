@@ -58,7 +58,16 @@ func (l *Lower) Eval(
 	if v == nil {
 		return nil, nil
 	}
+	return l.lower(ctx, v)
+}
+
+// EvalBatch implements the sql.BatchExpression interface.
+func (l *Lower) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return evalUnaryBatch(ctx, l.Child, batch, l.lower)
+}
 
+// lower returns |v|, which isn't NULL, converted to lowercase.
+func (l *Lower) lower(ctx *sql.Context, v interface{}) (interface{}, error) {
 	vStr, collation, err := types.ConvertToCollatedString(ctx, v, l.Child.Type(ctx))
 	if err != nil {
 		return nil, err
@@ -124,7 +133,16 @@ func (u *Upper) Eval(
 	if v == nil {
 		return nil, nil
 	}
+	return u.upper(ctx, v)
+}
+
+// EvalBatch implements the sql.BatchExpression interface.
+func (u *Upper) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	return evalUnaryBatch(ctx, u.Child, batch, u.upper)
+}
 
+// upper returns |v|, which isn't NULL, converted to uppercase.
+func (u *Upper) upper(ctx *sql.Context, v interface{}) (interface{}, error) {
 	vStr, collation, err := types.ConvertToCollatedString(ctx, v, u.Child.Type(ctx))
 	if err != nil {
 		return nil, err
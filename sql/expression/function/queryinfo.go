@@ -195,7 +195,7 @@ func (*LastInsertId) CollationCoercibility(ctx *sql.Context) (collation sql.Coll
 
 // IsNullable implements sql.Expression
 func (r *LastInsertId) IsNullable(ctx *sql.Context) bool {
-	return false
+	return r.Child != nil && r.Child.IsNullable(ctx)
 }
 
 // Eval implements sql.Expression
@@ -210,18 +210,24 @@ func (r *LastInsertId) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return unsigned, nil
 	}
 
-	// If an expression is provided, we set the next insert id for this session as well as returning it
+	// If an expression is provided, we set the next insert id for this session as well as returning it. It's also the
+	// insert id reported in the result of the statement, unless the statement generates an AUTO_INCREMENT value.
 	res, err := r.Child.Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
+	if res == nil {
+		return nil, nil
+	}
 	id, _, err := types.Int64.Convert(ctx, res)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx.GetLastQueryInfo().LastInsertId.Store(id.(int64))
-	return id, nil
+	lastQueryInfo := ctx.GetLastQueryInfo()
+	lastQueryInfo.LastInsertId.Store(id.(int64))
+	lastQueryInfo.LastInsertIdSet.Store(true)
+	return uint64(id.(int64)), nil
 }
 
 // Children implements sql.Expression
@@ -213,16 +213,13 @@ func (r *RegexpInstr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	pos, err := r.Position.Eval(ctx, row)
+	textStr, _, err := sql.Unwrap[string](ctx, text)
 	if err != nil {
 		return nil, err
 	}
-	if pos == nil {
-		return nil, nil
-	}
-	pos, _, err = types.Int32.Convert(ctx, pos)
-	if err != nil {
+
+	pos, ok, err := evalRegexpPosition(ctx, r.Position, textStr, r.FunctionName(), row)
+	if err != nil || !ok {
 		return nil, err
 	}
 
@@ -249,16 +246,22 @@ func (r *RegexpInstr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ro := returnOption.(int32); ro != 0 && ro != 1 {
+		return nil, sql.ErrInvalidArgumentDetails.New(r.FunctionName(), "return_option must be 1 or 0")
+	}
 
-	err = r.re.SetMatchString(ctx, text.(string))
+	err = r.re.SetMatchString(ctx, textStr)
 	if err != nil {
 		return nil, err
 	}
-	index, err := r.re.IndexOf(ctx, int(pos.(int32)), int(occurrence.(int32)), returnOption.(int32) == 1)
+	index, err := r.re.IndexOf(ctx, pos, int(occurrence.(int32)), returnOption.(int32) == 1)
 	if err != nil {
 		return nil, err
 	}
 
+	if index > 0 {
+		index = utf16ToCharPosition(textStr, index)
+	}
 	outVal := int32(index)
 	if r.cacheVal {
 		r.cachedVal = outVal
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"unicode/utf16"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -245,6 +246,7 @@ func compileRegex(ctx *sql.Context, pattern, text, flags sql.Expression, funcNam
 	leftCollation, leftCoercibility := sql.GetCoercibility(ctx, text)
 	rightCollation, rightCoercibility := sql.GetCoercibility(ctx, pattern)
 	resolvedCollation, _ := sql.ResolveCoercibility(leftCollation, leftCoercibility, rightCollation, rightCoercibility)
+	// The match type given to the function overrides the case-sensitivity of the collation.
 	flagsStr := ""
 	if strings.HasSuffix(resolvedCollation.String(), "_ci") {
 		flagsStr = "i"
@@ -263,11 +265,11 @@ func compileRegex(ctx *sql.Context, pattern, text, flags sql.Expression, funcNam
 			return nil, err
 		}
 
-		flagsStr, _, err = sql.Unwrap[string](ctx, f)
+		matchType, _, err := sql.Unwrap[string](ctx, f)
 		if err != nil {
 			return nil, err
 		}
-		flagsStr, err = consolidateRegexpFlags(flagsStr, funcName)
+		flagsStr, err = consolidateRegexpFlags(flagsStr+matchType, funcName)
 		if err != nil {
 			return nil, err
 		}
@@ -294,7 +296,7 @@ func compileRegex(ctx *sql.Context, pattern, text, flags sql.Expression, funcNam
 	} else {
 		ctx.Warn(1193, `System variable for regular expressions "regexp_buffer_size" is missing`)
 	}
-	re := regex.CreateRegex(bufferSize)
+	re := newTimeLimitedRegex(ctx, regex.CreateRegex(bufferSize))
 	if err = re.SetRegexString(ctx, patternValStr, regexFlags); err != nil {
 		_ = re.Close()
 		return nil, err
@@ -302,6 +304,51 @@ func compileRegex(ctx *sql.Context, pattern, text, flags sql.Expression, funcNam
 	return re, nil
 }
 
+// evalRegexpPosition evaluates the position argument |position| of the function |funcName|, which counts the
+// characters of |text| from 1 and may be one past its end. It returns the position in UTF-16 code units, as the
+// regex library counts them, and false if the position is NULL.
+func evalRegexpPosition(ctx *sql.Context, position sql.Expression, text string, funcName string, row sql.Row) (int, bool, error) {
+	pos, err := position.Eval(ctx, row)
+	if err != nil || pos == nil {
+		return 0, false, err
+	}
+	pos, _, err = types.Int32.Convert(ctx, pos)
+	if err != nil {
+		return 0, false, err
+	}
+	p := int(pos.(int32))
+	if p <= 0 {
+		return 0, false, sql.ErrInvalidArgumentDetails.New(funcName, fmt.Sprintf("%d", p))
+	}
+
+	units := 1
+	for _, c := range text {
+		if p == 1 {
+			return units, true, nil
+		}
+		p--
+		units += utf16.RuneLen(c)
+	}
+	if p > 1 {
+		return 0, false, sql.ErrRegexpIndexOutOfBounds.New()
+	}
+	return units, true, nil
+}
+
+// utf16ToCharPosition returns the position in characters of |text| of the position |units| counted in UTF-16 code
+// units, both counting from 1.
+func utf16ToCharPosition(text string, units int) int {
+	pos := 1
+	for _, c := range text {
+		if units <= 1 {
+			break
+		}
+		units -= utf16.RuneLen(c)
+		pos++
+	}
+	return pos
+}
+
 // consolidateRegexpFlags consolidates regexp flags by removing duplicates, resolving order of conflicting flags, and
 // verifying that all flags are valid.
 func consolidateRegexpFlags(flags, funcName string) (string, error) {
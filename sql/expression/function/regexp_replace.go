@@ -19,8 +19,6 @@ import (
 	"strings"
 	"sync"
 
-	"gopkg.in/src-d/go-errors.v1"
-
 	"github.com/dolthub/go-mysql-server/internal/regex"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -241,24 +239,10 @@ func (r *RegexpReplace) Eval(ctx *sql.Context, row sql.Row) (val interface{}, er
 		return nil, err
 	}
 
-	pos, err := r.Position.Eval(ctx, row)
-	if err != nil {
-		return nil, err
-	}
-	if pos == nil {
-		return nil, nil
-	}
-	pos, _, err = types.Int32.Convert(ctx, pos)
-	if err != nil {
+	pos, ok, err := evalRegexpPosition(ctx, r.Position, text.(string), r.FunctionName(), row)
+	if err != nil || !ok {
 		return nil, err
 	}
-	if pos.(int32) <= 0 {
-		return nil, sql.ErrInvalidArgumentDetails.New(r.FunctionName(), fmt.Sprintf("%d", pos.(int32)))
-	}
-
-	if len(text.(string)) != 0 && int(pos.(int32)) > len(text.(string)) {
-		return nil, errors.NewKind("Index out of bounds for regular expression search.").New()
-	}
 
 	occurrence, err := r.Occurrence.Eval(ctx, row)
 	if err != nil {
@@ -277,7 +261,7 @@ func (r *RegexpReplace) Eval(ctx *sql.Context, row sql.Row) (val interface{}, er
 		return nil, err
 	}
 
-	result, err := r.re.Replace(ctx, rText.(string), int(pos.(int32)), int(occurrence.(int32)))
+	result, err := r.re.Replace(ctx, rText.(string), pos, int(occurrence.(int32)))
 	if err != nil {
 		return nil, err
 	}
@@ -200,16 +200,13 @@ func (r *RegexpSubstr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	if err != nil {
 		return nil, err
 	}
-
-	pos, err := r.Position.Eval(ctx, row)
+	textStr, _, err := sql.Unwrap[string](ctx, text)
 	if err != nil {
 		return nil, err
 	}
-	if pos == nil {
-		return nil, nil
-	}
-	pos, _, err = types.Int32.Convert(ctx, pos)
-	if err != nil {
+
+	pos, ok, err := evalRegexpPosition(ctx, r.Position, textStr, r.FunctionName(), row)
+	if err != nil || !ok {
 		return nil, err
 	}
 
@@ -225,11 +222,11 @@ func (r *RegexpSubstr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, err
 	}
 
-	err = r.re.SetMatchString(ctx, text.(string))
+	err = r.re.SetMatchString(ctx, textStr)
 	if err != nil {
 		return nil, err
 	}
-	substring, ok, err := r.re.Substring(ctx, int(pos.(int32)), int(occurrence.(int32)))
+	substring, ok, err := r.re.Substring(ctx, pos, int(occurrence.(int32)))
 	if err != nil {
 		return nil, err
 	}
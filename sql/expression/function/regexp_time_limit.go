@@ -0,0 +1,153 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/internal/regex"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// regexpTimeLimitStep is the time allowed for each step of regexp_time_limit. MySQL counts the steps of ICU's match
+// engine, which typically take around a millisecond, but the regex library doesn't expose them, so the limit is
+// enforced on the time a search takes instead. That time also covers building the result of a replacement, so a step
+// is given more than a millisecond to keep searches of large strings from running out of time.
+const regexpTimeLimitStep = 10 * time.Millisecond
+
+// timeLimitedRegex is a regex.Regex whose searches fail with sql.ErrRegexpTimeOut once they run for longer than its
+// limit, so that a pattern that backtracks without end can't hang a query. The regex library can't interrupt a search,
+// so a search that runs out of time is left to finish in the background, and the regex is closed once it does. The
+// regex can't be used after that.
+type timeLimitedRegex struct {
+	regex.Regex
+	limit     time.Duration
+	abandoned bool
+}
+
+var _ regex.Regex = (*timeLimitedRegex)(nil)
+
+// newTimeLimitedRegex returns |re| with its searches limited to the time allowed by regexp_time_limit, or |re| itself
+// if there's no limit.
+func newTimeLimitedRegex(ctx *sql.Context, re regex.Regex) regex.Regex {
+	_, val, ok := sql.SystemVariables.GetGlobal("regexp_time_limit")
+	if !ok {
+		ctx.Warn(1193, `System variable for regular expressions "regexp_time_limit" is missing`)
+		return re
+	}
+	steps, ok := val.(int64)
+	if !ok || steps <= 0 {
+		return re
+	}
+	return &timeLimitedRegex{Regex: re, limit: time.Duration(steps) * regexpTimeLimitStep}
+}
+
+// SetRegexString implements the interface regex.Regex.
+func (r *timeLimitedRegex) SetRegexString(ctx context.Context, regexStr string, flags regex.RegexFlags) error {
+	if r.abandoned {
+		return sql.ErrRegexpTimeOut.New()
+	}
+	return r.Regex.SetRegexString(ctx, regexStr, flags)
+}
+
+// SetMatchString implements the interface regex.Regex.
+func (r *timeLimitedRegex) SetMatchString(ctx context.Context, matchStr string) error {
+	if r.abandoned {
+		return sql.ErrRegexpTimeOut.New()
+	}
+	return r.Regex.SetMatchString(ctx, matchStr)
+}
+
+// IndexOf implements the interface regex.Regex.
+func (r *timeLimitedRegex) IndexOf(ctx context.Context, start int, occurrence int, endIndex bool) (int, error) {
+	var index int
+	err := r.search(ctx, func() (err error) {
+		index, err = r.Regex.IndexOf(ctx, start, occurrence, endIndex)
+		return err
+	})
+	return index, err
+}
+
+// Matches implements the interface regex.Regex.
+func (r *timeLimitedRegex) Matches(ctx context.Context, start int, occurrence int) (bool, error) {
+	var ok bool
+	err := r.search(ctx, func() (err error) {
+		ok, err = r.Regex.Matches(ctx, start, occurrence)
+		return err
+	})
+	return ok, err
+}
+
+// Replace implements the interface regex.Regex.
+func (r *timeLimitedRegex) Replace(ctx context.Context, replacementStr string, position int, occurrence int) (string, error) {
+	var replaced string
+	err := r.search(ctx, func() (err error) {
+		replaced, err = r.Regex.Replace(ctx, replacementStr, position, occurrence)
+		return err
+	})
+	return replaced, err
+}
+
+// Substring implements the interface regex.Regex.
+func (r *timeLimitedRegex) Substring(ctx context.Context, start int, occurrence int) (string, bool, error) {
+	var substring string
+	var ok bool
+	err := r.search(ctx, func() (err error) {
+		substring, ok, err = r.Regex.Substring(ctx, start, occurrence)
+		return err
+	})
+	return substring, ok, err
+}
+
+// Close implements the interface regex.Regex.
+func (r *timeLimitedRegex) Close() error {
+	if r.abandoned {
+		// the search that ran out of time closes the regex once it's done
+		return nil
+	}
+	return r.Regex.Close()
+}
+
+// search runs |f|, which searches with the regex, and waits for it until it runs out of time or the query is canceled.
+// The results written by |f| may only be read when search returns no error.
+func (r *timeLimitedRegex) search(ctx context.Context, f func() error) error {
+	if r.abandoned {
+		return sql.ErrRegexpTimeOut.New()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+	timer := time.NewTimer(r.limit)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		err = sql.ErrRegexpTimeOut.New()
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	r.abandoned = true
+	go func() {
+		<-done
+		_ = r.Regex.Close()
+	}()
+	return err
+}
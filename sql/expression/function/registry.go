@@ -36,6 +36,8 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "abs", Fn: NewAbsVal},
 	sql.Function1{Name: "acos", Fn: NewAcos},
 	sql.FunctionN{Name: "adddate", Fn: NewAddDate},
+	sql.FunctionN{Name: "aes_decrypt", Fn: NewAESDecrypt},
+	sql.FunctionN{Name: "aes_encrypt", Fn: NewAESEncrypt},
 	sql.Function1{Name: "any_value", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewAnyValue(e) }},
 	sql.Function1{Name: "ascii", Fn: NewAscii},
 	sql.Function1{Name: "asin", Fn: NewAsin},
@@ -72,6 +74,7 @@ var BuiltIns = []sql.Function{
 	sql.NewFunction0("current_date", NewCurrentDate),
 	sql.FunctionN{Name: "current_time", Fn: NewCurrTime},
 	sql.FunctionN{Name: "current_timestamp", Fn: NewNow},
+	sql.NewFunction0("current_role", NewCurrentRole),
 	sql.NewFunction0("current_user", NewCurrentUser),
 	sql.FunctionN{Name: "curtime", Fn: NewCurrTime},
 	sql.Function0{Name: "database", Fn: NewDatabase},
@@ -103,6 +106,7 @@ var BuiltIns = []sql.Function{
 	sql.Function2{Name: "get_format", Fn: NewGetFormat},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
+	sql.FunctionN{Name: "grouping", Fn: aggregation.NewGrouping},
 	sql.Function2{Name: "gtid_subtract", Fn: NewGtidSubtract},
 	sql.Function1{Name: "hex", Fn: NewHex},
 	sql.Function1{Name: "hour", Fn: NewHour},
@@ -176,6 +180,13 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "ltrim", Fn: NewLeftTrim},
 	sql.FunctionN{Name: "make_set", Fn: NewMakeSet},
 	sql.Function1{Name: "max", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewMax(e) }},
+	sql.Function2{Name: "mbrcontains", Fn: spatial.NewMBRContains},
+	sql.Function2{Name: "mbrcoveredby", Fn: spatial.NewMBRCoveredBy},
+	sql.Function2{Name: "mbrcovers", Fn: spatial.NewMBRCovers},
+	sql.Function2{Name: "mbrdisjoint", Fn: spatial.NewMBRDisjoint},
+	sql.Function2{Name: "mbrequals", Fn: spatial.NewMBREquals},
+	sql.Function2{Name: "mbrintersects", Fn: spatial.NewMBRIntersects},
+	sql.Function2{Name: "mbrwithin", Fn: spatial.NewMBRWithin},
 	sql.Function1{Name: "md5", Fn: NewMD5},
 	sql.Function1{Name: "microsecond", Fn: NewMicrosecond},
 	sql.FunctionN{Name: "mid", Fn: NewSubstring},
@@ -243,7 +254,10 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "st_aswkb", Fn: spatial.NewAsWKB},
 	sql.Function1{Name: "st_aswkt", Fn: spatial.NewAsWKT},
 	sql.Function1{Name: "st_astext", Fn: spatial.NewAsWKT},
+	sql.FunctionN{Name: "st_buffer", Fn: spatial.NewBuffer},
+	sql.FunctionN{Name: "st_buffer_strategy", Fn: spatial.NewBufferStrategy},
 	sql.FunctionN{Name: "st_distance", Fn: spatial.NewDistance},
+	sql.FunctionN{Name: "st_distance_sphere", Fn: spatial.NewDistanceSphere},
 	sql.Function1{Name: "st_dimension", Fn: spatial.NewDimension},
 	sql.Function2{Name: "st_disjoint", Fn: spatial.NewDisjoint},
 	sql.Function1{Name: "st_centroid", Fn: spatial.NewCentroid},
@@ -307,6 +321,7 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "st_srid", Fn: spatial.NewSRID},
 	sql.Function1{Name: "st_startpoint", Fn: spatial.NewStartPoint},
 	sql.Function1{Name: "st_swapxy", Fn: spatial.NewSwapXY},
+	sql.Function2{Name: "st_transform", Fn: spatial.NewTransform},
 	sql.Function1{Name: "st_validate", Fn: spatial.NewValidate},
 	sql.Function2{Name: "st_within", Fn: spatial.NewWithin},
 	sql.FunctionN{Name: "st_x", Fn: spatial.NewSTX},
@@ -350,6 +365,7 @@ var BuiltIns = []sql.Function{
 	sql.Function2{Name: "vec_distance_l2_squared", Fn: vector.NewL2SquaredDistance},
 	sql.Function2{Name: "vec_distance_euclidean", Fn: vector.NewEuclideanDistance},
 	sql.Function2{Name: "vec_distance_cosine", Fn: vector.NewCosineDistance},
+	sql.Function2{Name: "vec_distance_dot", Fn: vector.NewDotDistance},
 	sql.FunctionN{Name: "distance", Fn: vector.NewGenericDistance},
 	sql.Function1{Name: "string_to_vector", Fn: vector.NewStringToVector},
 	sql.Function1{Name: "to_vector", Fn: vector.NewStringToVector},
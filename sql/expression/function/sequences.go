@@ -0,0 +1,334 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// SequenceRef names the sequence a sequence function reads or advances. The database of the sequence is looked up in
+// the catalog each time the function is evaluated.
+type SequenceRef struct {
+	Catalog sql.Catalog
+	Db      string
+	Name    string
+}
+
+func (s SequenceRef) String() string {
+	return fmt.Sprintf("%s.%s", s.Db, s.Name)
+}
+
+// database returns the database of the sequence.
+func (s SequenceRef) database(ctx *sql.Context) (sql.SequenceDatabase, error) {
+	db, err := s.Catalog.Database(ctx, s.Db)
+	if err != nil {
+		return nil, err
+	}
+	seqDb, ok := db.(sql.SequenceDatabase)
+	if !ok {
+		return nil, sql.ErrUnknownSequence.New(s.String())
+	}
+	return seqDb, nil
+}
+
+// NextVal implements the NEXTVAL function and NEXT VALUE FOR, which advance a sequence and return its next value.
+type NextVal struct {
+	SequenceRef
+}
+
+var _ sql.FunctionExpression = (*NextVal)(nil)
+var _ sql.NonDeterministicExpression = (*NextVal)(nil)
+var _ sql.CollationCoercible = (*NextVal)(nil)
+
+// NewNextVal returns a new *NextVal expression for the sequence given.
+func NewNextVal(seq SequenceRef) *NextVal {
+	return &NextVal{SequenceRef: seq}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (n *NextVal) FunctionName() string {
+	return "nextval"
+}
+
+// Description implements sql.FunctionExpression
+func (n *NextVal) Description() string {
+	return "advances a sequence and returns its next value."
+}
+
+// Resolved implements sql.Expression
+func (n *NextVal) Resolved() bool {
+	return true
+}
+
+// String implements sql.Expression
+func (n *NextVal) String() string {
+	return fmt.Sprintf("%s(%s)", n.FunctionName(), n.SequenceRef)
+}
+
+// Type implements sql.Expression
+func (n *NextVal) Type(ctx *sql.Context) sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*NextVal) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements sql.Expression
+func (n *NextVal) IsNullable(ctx *sql.Context) bool {
+	return false
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (n *NextVal) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (n *NextVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	db, err := n.database(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := db.NextSequenceValue(ctx, n.Name)
+	if err != nil {
+		if sql.ErrUnknownSequence.Is(err) {
+			return nil, sql.ErrUnknownSequence.New(n.SequenceRef.String())
+		}
+		return nil, err
+	}
+	if sess, ok := ctx.Session.(sql.SequenceSession); ok {
+		sess.SetLastSequenceValue(n.Db, n.Name, value)
+	}
+	return value, nil
+}
+
+// Children implements sql.Expression
+func (n *NextVal) Children() []sql.Expression {
+	return nil
+}
+
+// WithChildren implements sql.Expression
+func (n *NextVal) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return sql.NillaryWithChildren(ctx, n, children...)
+}
+
+// LastVal implements the LASTVAL function and PREVIOUS VALUE FOR, which return the last value a sequence generated
+// for the session, or NULL if it hasn't generated one.
+type LastVal struct {
+	SequenceRef
+}
+
+var _ sql.FunctionExpression = (*LastVal)(nil)
+var _ sql.NonDeterministicExpression = (*LastVal)(nil)
+var _ sql.CollationCoercible = (*LastVal)(nil)
+
+// NewLastVal returns a new *LastVal expression for the sequence given.
+func NewLastVal(seq SequenceRef) *LastVal {
+	return &LastVal{SequenceRef: seq}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (l *LastVal) FunctionName() string {
+	return "lastval"
+}
+
+// Description implements sql.FunctionExpression
+func (l *LastVal) Description() string {
+	return "returns the last value a sequence generated for the session."
+}
+
+// Resolved implements sql.Expression
+func (l *LastVal) Resolved() bool {
+	return true
+}
+
+// String implements sql.Expression
+func (l *LastVal) String() string {
+	return fmt.Sprintf("%s(%s)", l.FunctionName(), l.SequenceRef)
+}
+
+// Type implements sql.Expression
+func (l *LastVal) Type(ctx *sql.Context) sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*LastVal) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements sql.Expression
+func (l *LastVal) IsNullable(ctx *sql.Context) bool {
+	return true
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (l *LastVal) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (l *LastVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	sess, ok := ctx.Session.(sql.SequenceSession)
+	if !ok {
+		return nil, nil
+	}
+	if value, ok := sess.LastSequenceValue(l.Db, l.Name); ok {
+		return value, nil
+	}
+	return nil, nil
+}
+
+// Children implements sql.Expression
+func (l *LastVal) Children() []sql.Expression {
+	return nil
+}
+
+// WithChildren implements sql.Expression
+func (l *LastVal) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return sql.NillaryWithChildren(ctx, l, children...)
+}
+
+// SetVal implements the SETVAL function, which sets the next value of a sequence. Like MariaDB's, it only moves a
+// sequence forward, and returns NULL when it doesn't change the sequence. The value given is the last one generated
+// when Used is true, which it is by default, and the next one otherwise.
+type SetVal struct {
+	SequenceRef
+	Value sql.Expression
+	// Used is the optional is_used argument, or nil
+	Used sql.Expression
+}
+
+var _ sql.FunctionExpression = (*SetVal)(nil)
+var _ sql.NonDeterministicExpression = (*SetVal)(nil)
+var _ sql.CollationCoercible = (*SetVal)(nil)
+
+// NewSetVal returns a new *SetVal expression for the sequence given. |used| may be nil.
+func NewSetVal(seq SequenceRef, value, used sql.Expression) *SetVal {
+	return &SetVal{SequenceRef: seq, Value: value, Used: used}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *SetVal) FunctionName() string {
+	return "setval"
+}
+
+// Description implements sql.FunctionExpression
+func (s *SetVal) Description() string {
+	return "sets the next value of a sequence."
+}
+
+// Resolved implements sql.Expression
+func (s *SetVal) Resolved() bool {
+	return s.Value.Resolved() && (s.Used == nil || s.Used.Resolved())
+}
+
+// String implements sql.Expression
+func (s *SetVal) String() string {
+	if s.Used == nil {
+		return fmt.Sprintf("%s(%s, %s)", s.FunctionName(), s.SequenceRef, s.Value)
+	}
+	return fmt.Sprintf("%s(%s, %s, %s)", s.FunctionName(), s.SequenceRef, s.Value, s.Used)
+}
+
+// Type implements sql.Expression
+func (s *SetVal) Type(ctx *sql.Context) sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*SetVal) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements sql.Expression
+func (s *SetVal) IsNullable(ctx *sql.Context) bool {
+	return true
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (s *SetVal) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (s *SetVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := s.Value.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	val, _, err = types.Int64.Convert(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	value := val.(int64)
+
+	used := true
+	if s.Used != nil {
+		val, err := s.Used.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if used, err = sql.ConvertToBool(ctx, val); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := s.database(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seq, ok, err := db.GetSequence(ctx, s.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrUnknownSequence.New(s.SequenceRef.String())
+	}
+	seq, ok = seq.SetValue(value, used)
+	if !ok {
+		return nil, nil
+	}
+	if err := db.UpdateSequence(ctx, seq); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Children implements sql.Expression
+func (s *SetVal) Children() []sql.Expression {
+	if s.Used == nil {
+		return []sql.Expression{s.Value}
+	}
+	return []sql.Expression{s.Value, s.Used}
+}
+
+// WithChildren implements sql.Expression
+func (s *SetVal) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(s.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), len(s.Children()))
+	}
+	ns := *s
+	ns.Value = children[0]
+	if len(children) == 2 {
+		ns.Used = children[1]
+	}
+	return &ns, nil
+}
@@ -229,6 +229,20 @@ func RoundFloatSlices(v interface{}, p float64) interface{} {
 	return nil
 }
 
+// roundGeoJSON rounds the coordinates of a GeoJSON object, including those of the members of a GeometryCollection
+func roundGeoJSON(obj map[string]interface{}, prec float64) {
+	if coords, ok := obj["coordinates"]; ok {
+		obj["coordinates"] = RoundFloatSlices(coords, prec)
+	}
+	if geoms, ok := obj["geometries"].([]interface{}); ok {
+		for _, g := range geoms {
+			if gObj, ok := g.(map[string]interface{}); ok {
+				roundGeoJSON(gObj, prec)
+			}
+		}
+	}
+}
+
 // getIntArg is a helper method that evaluates the given sql.Expression to an int type, errors on float32 and float64,
 // and returns nil
 func getIntArg(ctx *sql.Context, row sql.Row, expr sql.Expression) (interface{}, error) {
@@ -316,9 +330,7 @@ func (g *AsGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	// Round floats
 	prec := math.Pow10(pp)
-	if _, ok := obj["coordinates"]; ok {
-		obj["coordinates"] = RoundFloatSlices(obj["coordinates"], prec)
-	}
+	roundGeoJSON(obj, prec)
 
 	if len(g.ChildExpressions) == 2 {
 		return types.JSONDocument{Val: obj}, nil
@@ -336,49 +348,32 @@ func (g *AsGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if flag < 0 || flag > 7 {
 		return nil, sql.ErrInvalidArgumentDetails.New(g.FunctionName(), flag)
 	}
-	// TODO: the flags do very different things for when the SRID is GeoSpatial
-	switch flag {
-	// Flags 1,3,5 have bounding box
-	case 1, 3, 5:
+	// Flag 1 adds a bounding box, flag 2 a short format CRS URN and flag 4 a long format CRS URN, which wins over 2
+	if flag&1 != 0 {
 		// Don't find bounding box for empty geometries
-		if g, ok := val.(types.GeomColl); ok {
-			if len(g.Geoms) == 0 {
-				break
+		if gc, ok := val.(types.GeomColl); !ok || len(gc.Geoms) != 0 {
+			res := FindBBox(val)
+			for i, r := range res {
+				res[i] = math.Round(r*prec) / prec
+				if math.IsInf(res[i], 1) {
+					res[i] = math.MaxFloat64
+				} else if math.IsInf(res[i], -1) {
+					res[i] = -math.MaxFloat64
+				}
 			}
+			obj["bbox"] = res
 		}
-		res := FindBBox(val)
-		for i, r := range res {
-			res[i] = math.Round(r*prec) / prec
-			if math.IsInf(res[i], 1) {
-				res[i] = math.MaxFloat64
-			} else if math.IsInf(res[i], -1) {
-				res[i] = -math.MaxFloat64
-			}
+	}
+	// CRS obj only shows up if srid != 0
+	if srid := val.(types.GeometryValue).GetSRID(); flag&6 != 0 && srid != 0 {
+		sridStr := strconv.Itoa(int(srid))
+		name := "EPSG:" + sridStr
+		if flag&4 != 0 {
+			name = "urn:ogc:def:crs:EPSG::" + sridStr
 		}
-		obj["bbox"] = res
-	// Flag 2 and 4 add CRS URN (EPSG: <srid>); only shows up if SRID != 0
-	case 2, 4:
-		// CRS obj only shows up if srid != 0
-		srid := val.(types.GeometryValue).GetSRID()
-		if srid != 0 {
-			// Create CRS URN Object
-			crs := make(map[string]interface{})
-			crs["type"] = "name"
-
-			// Create properties
-			props := make(map[string]interface{})
-			// Flag 2 is short format CRS URN, while 4 is long format
-			sridStr := strconv.Itoa(int(srid))
-			if flag == 2 {
-				props["name"] = "EPSG:" + sridStr
-			} else {
-				props["name"] = "urn:ogc:def:crs:EPSG::" + sridStr
-			}
-			// Add properties to crs
-			crs["properties"] = props
-
-			// Add CRS to main object
-			obj["crs"] = crs
+		obj["crs"] = map[string]interface{}{
+			"type":       "name",
+			"properties": map[string]interface{}{"name": name},
 		}
 	}
 
@@ -663,6 +658,42 @@ func ParseGeoJsonData(obj map[string]interface{}) (interface{}, string, error) {
 	return res, gt, err
 }
 
+// parseGeoJsonCRS returns the SRID named by a GeoJSON "crs" member. Both the short "EPSG:<srid>" and the long
+// "urn:ogc:def:crs:EPSG::<srid>" forms are accepted, as is the OGC name for WGS 84 longitude-latitude.
+func parseGeoJsonCRS(crs interface{}) (uint32, error) {
+	crsObj, ok := crs.(map[string]interface{})
+	if !ok {
+		return 0, errors.New("member 'crs' must be of type 'object'")
+	}
+	if typ, ok := crsObj["type"].(string); !ok || typ != "name" {
+		return 0, errors.New("member 'crs.type' must be 'name'")
+	}
+	props, ok := crsObj["properties"].(map[string]interface{})
+	if !ok {
+		return 0, errors.New("missing required member 'crs.properties'")
+	}
+	name, ok := props["name"].(string)
+	if !ok {
+		return 0, errors.New("missing required member 'crs.properties.name'")
+	}
+	var sridStr string
+	switch {
+	case name == "urn:ogc:def:crs:OGC:1.3:CRS84":
+		return types.GeoSpatialSRID, nil
+	case strings.HasPrefix(name, "urn:ogc:def:crs:EPSG::"):
+		sridStr = strings.TrimPrefix(name, "urn:ogc:def:crs:EPSG::")
+	case strings.HasPrefix(name, "EPSG:"):
+		sridStr = strings.TrimPrefix(name, "EPSG:")
+	default:
+		return 0, fmt.Errorf("unsupported CRS '%s'", name)
+	}
+	srid, err := strconv.ParseUint(sridStr, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported CRS '%s'", name)
+	}
+	return uint32(srid), nil
+}
+
 // Eval implements the sql.Expression interface.
 func (g *GeomFromGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := g.ChildExpressions[0].Eval(ctx, row)
@@ -700,6 +731,16 @@ func (g *GeomFromGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, erro
 	if err != nil {
 		return nil, err
 	}
+	if crs, ok := obj["crs"]; ok && crs != nil {
+		srid, err := parseGeoJsonCRS(crs)
+		if err != nil {
+			return nil, err
+		}
+		if err = types.ValidateSRID(int(srid), g.FunctionName()); err != nil {
+			return nil, err
+		}
+		res = res.(types.GeometryValue).SetSRID(srid)
+	}
 	if len(g.ChildExpressions) == 1 {
 		return res, nil
 	}
@@ -0,0 +1,193 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// MBRPredicate is one of the MBR functions, such as MBRContains and MBRIntersects, which compare the minimum
+// bounding rectangles of two geometries rather than the geometries themselves.
+type MBRPredicate struct {
+	expression.BinaryExpressionStub
+	name        string
+	description string
+	compare     func(a, b mbr) bool
+}
+
+var _ sql.FunctionExpression = (*MBRPredicate)(nil)
+var _ sql.CollationCoercible = (*MBRPredicate)(nil)
+
+// mbr is the minimum bounding rectangle of a geometry. It's degenerate in a dimension when its minimum and maximum are
+// equal in that dimension, such as the rectangle of a point, or of a horizontal or vertical line.
+type mbr struct {
+	minX, minY, maxX, maxY float64
+}
+
+// covers returns whether |b| lies within |a|, including its boundary.
+func (a mbr) covers(b mbr) bool {
+	return a.minX <= b.minX && b.maxX <= a.maxX && a.minY <= b.minY && b.maxY <= a.maxY
+}
+
+// contains returns whether |b| lies within |a|, and their interiors share a point. Like ST_Contains, a rectangle
+// doesn't contain a rectangle that lies entirely on its boundary.
+func (a mbr) contains(b mbr) bool {
+	return a.covers(b) && interiorsOverlap(a.minX, a.maxX, b.minX, b.maxX) && interiorsOverlap(a.minY, a.maxY, b.minY, b.maxY)
+}
+
+// intersects returns whether |a| and |b| share any point, including on their boundaries.
+func (a mbr) intersects(b mbr) bool {
+	return a.minX <= b.maxX && b.minX <= a.maxX && a.minY <= b.maxY && b.minY <= a.maxY
+}
+
+// interiorsOverlap returns whether the interiors of two intervals share a point. The interior of a degenerate interval
+// is its single point.
+func interiorsOverlap(aMin, aMax, bMin, bMax float64) bool {
+	switch {
+	case aMin == aMax && bMin == bMax:
+		return aMin == bMin
+	case aMin == aMax:
+		return bMin < aMin && aMin < bMax
+	case bMin == bMax:
+		return aMin < bMin && bMin < aMax
+	default:
+		return aMin < bMax && bMin < aMax
+	}
+}
+
+// NewMBRContains creates a new MBRContains expression.
+func NewMBRContains(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return newMBRPredicate(g1, g2, "mbrcontains", "returns 1 or 0 to indicate whether the minimum bounding rectangle of g1 contains the minimum bounding rectangle of g2.",
+		func(a, b mbr) bool { return a.contains(b) })
+}
+
+// NewMBRCoveredBy creates a new MBRCoveredBy expression.
+func NewMBRCoveredBy(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return newMBRPredicate(g1, g2, "mbrcoveredby", "returns 1 or 0 to indicate whether the minimum bounding rectangle of g1 is covered by the minimum bounding rectangle of g2.",
+		func(a, b mbr) bool { return b.covers(a) })
+}
+
+// NewMBRCovers creates a new MBRCovers expression.
+func NewMBRCovers(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return newMBRPredicate(g1, g2, "mbrcovers", "returns 1 or 0 to indicate whether the minimum bounding rectangle of g1 covers the minimum bounding rectangle of g2.",
+		func(a, b mbr) bool { return a.covers(b) })
+}
+
+// NewMBRDisjoint creates a new MBRDisjoint expression.
+func NewMBRDisjoint(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return newMBRPredicate(g1, g2, "mbrdisjoint", "returns 1 or 0 to indicate whether the minimum bounding rectangles of g1 and g2 are disjoint.",
+		func(a, b mbr) bool { return !a.intersects(b) })
+}
+
+// NewMBREquals creates a new MBREquals expression.
+func NewMBREquals(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return newMBRPredicate(g1, g2, "mbrequals", "returns 1 or 0 to indicate whether the minimum bounding rectangles of g1 and g2 are the same.",
+		func(a, b mbr) bool { return a == b })
+}
+
+// NewMBRIntersects creates a new MBRIntersects expression.
+func NewMBRIntersects(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return newMBRPredicate(g1, g2, "mbrintersects", "returns 1 or 0 to indicate whether the minimum bounding rectangles of g1 and g2 intersect.",
+		func(a, b mbr) bool { return a.intersects(b) })
+}
+
+// NewMBRWithin creates a new MBRWithin expression.
+func NewMBRWithin(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return newMBRPredicate(g1, g2, "mbrwithin", "returns 1 or 0 to indicate whether the minimum bounding rectangle of g1 is within the minimum bounding rectangle of g2.",
+		func(a, b mbr) bool { return b.contains(a) })
+}
+
+func newMBRPredicate(g1, g2 sql.Expression, name, description string, compare func(a, b mbr) bool) *MBRPredicate {
+	return &MBRPredicate{
+		BinaryExpressionStub: expression.BinaryExpressionStub{
+			LeftChild:  g1,
+			RightChild: g2,
+		},
+		name:        name,
+		description: description,
+		compare:     compare,
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MBRPredicate) FunctionName() string {
+	return m.name
+}
+
+// Description implements sql.FunctionExpression
+func (m *MBRPredicate) Description() string {
+	return m.description
+}
+
+// IsNullable implements the sql.Expression interface.
+func (m *MBRPredicate) IsNullable(ctx *sql.Context) bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (m *MBRPredicate) Type(ctx *sql.Context) sql.Type {
+	return types.Boolean
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*MBRPredicate) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (m *MBRPredicate) String() string {
+	return fmt.Sprintf("%s(%s,%s)", m.FunctionName(), m.LeftChild.String(), m.RightChild.String())
+}
+
+// WithChildren implements the Expression interface.
+func (m *MBRPredicate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 2)
+	}
+	return newMBRPredicate(children[0], children[1], m.name, m.description, m.compare), nil
+}
+
+// CanUseSpatialIndex returns whether a spatial index can find the rows that satisfy the predicate, which it can when
+// the rectangles must share a point.
+func (m *MBRPredicate) CanUseSpatialIndex() bool {
+	return m.name != "mbrdisjoint"
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MBRPredicate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	geom1, err := m.LeftChild.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	geom2, err := m.RightChild.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	g1, g2, err := validateGeomComp(ctx, geom1, geom2, m.FunctionName())
+	if err != nil {
+		return nil, err
+	}
+	if g1 == nil || g2 == nil {
+		return nil, nil
+	}
+
+	var a, b mbr
+	a.minX, a.minY, a.maxX, a.maxY = g1.BBox()
+	b.minX, b.minY, b.maxX, b.maxY = g2.BBox()
+	return m.compare(a, b), nil
+}
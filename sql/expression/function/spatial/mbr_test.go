@@ -0,0 +1,86 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestMBRPredicates(t *testing.T) {
+	square := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: 0, Y: 0}, {X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 0}, {X: 0, Y: 0}}}}}
+	inner := types.Point{X: 2, Y: 2}
+	corner := types.Point{X: 4, Y: 4}
+	outer := types.Point{X: 5, Y: 5}
+	edge := types.LineString{Points: []types.Point{{X: 0, Y: 0}, {X: 0, Y: 4}}}
+	diagonal := types.LineString{Points: []types.Point{{X: 3, Y: 3}, {X: 6, Y: 6}}}
+
+	tests := []struct {
+		name     string
+		fn       func(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression
+		g1, g2   types.GeometryValue
+		expected interface{}
+	}{
+		{"mbrcontains inner point", NewMBRContains, square, inner, true},
+		{"mbrcontains corner point", NewMBRContains, square, corner, false},
+		{"mbrcontains edge", NewMBRContains, square, edge, false},
+		{"mbrcontains itself", NewMBRContains, square, square, true},
+		{"mbrcontains same point", NewMBRContains, inner, inner, true},
+		{"mbrcovers corner point", NewMBRCovers, square, corner, true},
+		{"mbrcovers edge", NewMBRCovers, square, edge, true},
+		{"mbrcovers crossing line", NewMBRCovers, square, diagonal, false},
+		{"mbrcoveredby corner point", NewMBRCoveredBy, corner, square, true},
+		{"mbrwithin inner point", NewMBRWithin, inner, square, true},
+		{"mbrwithin corner point", NewMBRWithin, corner, square, false},
+		{"mbrintersects corner point", NewMBRIntersects, square, corner, true},
+		{"mbrintersects crossing line", NewMBRIntersects, square, diagonal, true},
+		{"mbrintersects outer point", NewMBRIntersects, square, outer, false},
+		{"mbrdisjoint outer point", NewMBRDisjoint, square, outer, true},
+		{"mbrdisjoint inner point", NewMBRDisjoint, square, inner, false},
+		{"mbrequals itself", NewMBREquals, square, square, true},
+		{"mbrequals inner point", NewMBREquals, square, inner, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			f := tt.fn(ctx, expression.NewLiteral(tt.g1, types.GeometryType{}), expression.NewLiteral(tt.g2, types.GeometryType{}))
+			v, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+
+	t.Run("null argument", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		f := NewMBRContains(ctx, expression.NewLiteral(square, types.PolygonType{}), expression.NewLiteral(nil, types.Null))
+		v, err := f.Eval(ctx, nil)
+		require.NoError(t, err)
+		require.Nil(t, v)
+	})
+
+	t.Run("different srids", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		p := types.Point{SRID: types.GeoSpatialSRID, X: 1, Y: 2}
+		f := NewMBRIntersects(ctx, expression.NewLiteral(square, types.PolygonType{}), expression.NewLiteral(p, types.PointType{}))
+		_, err := f.Eval(ctx, nil)
+		require.True(t, sql.ErrDiffSRIDs.Is(err))
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// projPseudoMercator is the EPSG method 1024 used by SRID 3857
+	projPseudoMercator = "Popular Visualisation Pseudo Mercator"
+	// projTransverseMercator is the EPSG method 9807 used by UTM and most national grids
+	projTransverseMercator = "Transverse Mercator"
+	// wgs84Datum is the datum name that needs no TOWGS84 clause
+	wgs84Datum = "World Geodetic System 1984"
+)
+
+var (
+	srsSpheroidRegex   = regexp.MustCompile(`SPHEROID\["[^"]*",([-0-9.eE+]+),([-0-9.eE+]+)`)
+	srsDatumRegex      = regexp.MustCompile(`DATUM\["([^"]*)"`)
+	srsToWGS84Regex    = regexp.MustCompile(`TOWGS84\[([^\]]*)\]`)
+	srsPrimeMerRegex   = regexp.MustCompile(`PRIMEM\["[^"]*",([-0-9.eE+]+)`)
+	srsProjectionRegex = regexp.MustCompile(`PROJECTION\["([^"]*)"`)
+	srsParameterRegex  = regexp.MustCompile(`PARAMETER\["([^"]*)",([-0-9.eE+]+)`)
+	srsUnitRegex       = regexp.MustCompile(`UNIT\["[^"]*",([-0-9.eE+]+)`)
+)
+
+// spatialRefSys is the subset of a spatial reference system definition that coordinate transformations need. It is
+// parsed from the WKT definitions bundled in types.SupportedSRIDs.
+type spatialRefSys struct {
+	srid       uint32
+	geographic bool
+	// semiMajor and flattening describe the ellipsoid of the datum
+	semiMajor  float64
+	flattening float64
+	datum      string
+	// toWGS84 holds the seven Helmert parameters of the TOWGS84 clause, if there is one
+	toWGS84    []float64
+	primeMer   float64
+	projection string
+	params     map[string]float64
+	// linearUnit converts projected coordinates to metres
+	linearUnit float64
+}
+
+// lookupSRS parses the definition of the given SRID. The second return value is false if the SRID is unknown or
+// has no definition, which is the case for the Cartesian SRID 0.
+func lookupSRS(srid uint32) (*spatialRefSys, bool) {
+	ref, ok := types.SupportedSRIDs[srid]
+	if !ok || ref.Definition == "" {
+		return nil, false
+	}
+	def := ref.Definition
+	srs := &spatialRefSys{
+		srid:       srid,
+		geographic: strings.HasPrefix(def, "GEOGCS"),
+		params:     map[string]float64{},
+		linearUnit: 1,
+	}
+	if m := srsSpheroidRegex.FindStringSubmatch(def); m != nil {
+		srs.semiMajor, _ = strconv.ParseFloat(m[1], 64)
+		invFlat, _ := strconv.ParseFloat(m[2], 64)
+		if invFlat != 0 {
+			srs.flattening = 1 / invFlat
+		}
+	}
+	if m := srsDatumRegex.FindStringSubmatch(def); m != nil {
+		srs.datum = m[1]
+	}
+	if m := srsToWGS84Regex.FindStringSubmatch(def); m != nil {
+		for _, s := range strings.Split(m[1], ",") {
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return nil, false
+			}
+			srs.toWGS84 = append(srs.toWGS84, f)
+		}
+		for len(srs.toWGS84) < 7 {
+			srs.toWGS84 = append(srs.toWGS84, 0)
+		}
+	}
+	if m := srsPrimeMerRegex.FindStringSubmatch(def); m != nil {
+		srs.primeMer, _ = strconv.ParseFloat(m[1], 64)
+	}
+	if !srs.geographic {
+		if m := srsProjectionRegex.FindStringSubmatch(def); m != nil {
+			srs.projection = m[1]
+		}
+		for _, m := range srsParameterRegex.FindAllStringSubmatch(def, -1) {
+			srs.params[m[1]], _ = strconv.ParseFloat(m[2], 64)
+		}
+		// the last UNIT clause belongs to the projected coordinate system
+		if all := srsUnitRegex.FindAllStringSubmatch(def, -1); len(all) > 0 {
+			srs.linearUnit, _ = strconv.ParseFloat(all[len(all)-1][1], 64)
+		}
+	}
+	return srs, true
+}
+
+// canShiftDatum returns whether coordinates in this SRS can be moved to and from WGS 84.
+func (s *spatialRefSys) canShiftDatum() bool {
+	return s.datum == wgs84Datum || s.toWGS84 != nil
+}
+
+// canProject returns whether this SRS is geographic or uses a projection method that is implemented.
+func (s *spatialRefSys) canProject() bool {
+	return s.geographic || s.projection == projPseudoMercator || s.projection == projTransverseMercator
+}
+
+func (s *spatialRefSys) eccentricitySq() float64 {
+	return s.flattening * (2 - s.flattening)
+}
+
+// toGeographic converts a coordinate in this SRS to longitude and latitude in radians on the SRS's own datum.
+func (s *spatialRefSys) toGeographic(x, y float64) (lon, lat float64) {
+	if s.geographic {
+		return (x + s.primeMer) * math.Pi / 180, y * math.Pi / 180
+	}
+	x, y = x*s.linearUnit, y*s.linearUnit
+	switch s.projection {
+	case projPseudoMercator:
+		lon0 := s.params["Longitude of natural origin"] * math.Pi / 180
+		x -= s.params["False easting"] * s.linearUnit
+		y -= s.params["False northing"] * s.linearUnit
+		return lon0 + x/s.semiMajor, 2*math.Atan(math.Exp(y/s.semiMajor)) - math.Pi/2
+	case projTransverseMercator:
+		return s.inverseTransverseMercator(x, y)
+	}
+	return math.NaN(), math.NaN()
+}
+
+// fromGeographic converts longitude and latitude in radians on this SRS's datum into a coordinate of this SRS.
+func (s *spatialRefSys) fromGeographic(lon, lat float64) (x, y float64) {
+	if s.geographic {
+		return lon*180/math.Pi - s.primeMer, lat * 180 / math.Pi
+	}
+	switch s.projection {
+	case projPseudoMercator:
+		lon0 := s.params["Longitude of natural origin"] * math.Pi / 180
+		x = s.semiMajor*(lon-lon0) + s.params["False easting"]*s.linearUnit
+		y = s.semiMajor*math.Log(math.Tan(math.Pi/4+lat/2)) + s.params["False northing"]*s.linearUnit
+	case projTransverseMercator:
+		x, y = s.forwardTransverseMercator(lon, lat)
+	default:
+		return math.NaN(), math.NaN()
+	}
+	return x / s.linearUnit, y / s.linearUnit
+}
+
+// meridianArc returns the distance along the meridian from the equator to latitude lat.
+func (s *spatialRefSys) meridianArc(lat float64) float64 {
+	e2 := s.eccentricitySq()
+	e4, e6 := e2*e2, e2*e2*e2
+	return s.semiMajor * ((1-e2/4-3*e4/64-5*e6/256)*lat -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*lat) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*lat) -
+		(35*e6/3072)*math.Sin(6*lat))
+}
+
+// forwardTransverseMercator implements the series expansion from Snyder, "Map Projections: A Working Manual", p. 61.
+func (s *spatialRefSys) forwardTransverseMercator(lon, lat float64) (x, y float64) {
+	lat0 := s.params["Latitude of natural origin"] * math.Pi / 180
+	lon0 := s.params["Longitude of natural origin"] * math.Pi / 180
+	k0 := s.params["Scale factor at natural origin"]
+	e2 := s.eccentricitySq()
+	ep2 := e2 / (1 - e2)
+
+	sinLat, cosLat, tanLat := math.Sin(lat), math.Cos(lat), math.Tan(lat)
+	n := s.semiMajor / math.Sqrt(1-e2*sinLat*sinLat)
+	t := tanLat * tanLat
+	c := ep2 * cosLat * cosLat
+	a := (lon - lon0) * cosLat
+
+	x = k0 * n * (a + (1-t+c)*math.Pow(a, 3)/6 + (5-18*t+t*t+72*c-58*ep2)*math.Pow(a, 5)/120)
+	y = k0 * (s.meridianArc(lat) - s.meridianArc(lat0) + n*tanLat*(a*a/2+
+		(5-t+9*c+4*c*c)*math.Pow(a, 4)/24+
+		(61-58*t+t*t+600*c-330*ep2)*math.Pow(a, 6)/720))
+	return x + s.params["False easting"]*s.linearUnit, y + s.params["False northing"]*s.linearUnit
+}
+
+// inverseTransverseMercator is the inverse of forwardTransverseMercator.
+func (s *spatialRefSys) inverseTransverseMercator(x, y float64) (lon, lat float64) {
+	lat0 := s.params["Latitude of natural origin"] * math.Pi / 180
+	lon0 := s.params["Longitude of natural origin"] * math.Pi / 180
+	k0 := s.params["Scale factor at natural origin"]
+	e2 := s.eccentricitySq()
+	e4, e6 := e2*e2, e2*e2*e2
+	ep2 := e2 / (1 - e2)
+	x -= s.params["False easting"] * s.linearUnit
+	y -= s.params["False northing"] * s.linearUnit
+
+	m := s.meridianArc(lat0) + y/k0
+	mu := m / (s.semiMajor * (1 - e2/4 - 3*e4/64 - 5*e6/256))
+	e1 := (1 - math.Sqrt(1-e2)) / (1 + math.Sqrt(1-e2))
+	lat1 := mu + (3*e1/2-27*math.Pow(e1, 3)/32)*math.Sin(2*mu) +
+		(21*e1*e1/16-55*math.Pow(e1, 4)/32)*math.Sin(4*mu) +
+		(151*math.Pow(e1, 3)/96)*math.Sin(6*mu) +
+		(1097*math.Pow(e1, 4)/512)*math.Sin(8*mu)
+
+	sinLat1, cosLat1, tanLat1 := math.Sin(lat1), math.Cos(lat1), math.Tan(lat1)
+	c1 := ep2 * cosLat1 * cosLat1
+	t1 := tanLat1 * tanLat1
+	n1 := s.semiMajor / math.Sqrt(1-e2*sinLat1*sinLat1)
+	r1 := s.semiMajor * (1 - e2) / math.Pow(1-e2*sinLat1*sinLat1, 1.5)
+	d := x / (n1 * k0)
+
+	lat = lat1 - (n1*tanLat1/r1)*(d*d/2-
+		(5+3*t1+10*c1-4*c1*c1-9*ep2)*math.Pow(d, 4)/24+
+		(61+90*t1+298*c1+45*t1*t1-252*ep2-3*c1*c1)*math.Pow(d, 6)/720)
+	lon = lon0 + (d-(1+2*t1+c1)*math.Pow(d, 3)/6+
+		(5-2*c1+28*t1-3*c1*c1+8*ep2+24*t1*t1)*math.Pow(d, 5)/120)/cosLat1
+	return lon, lat
+}
+
+// toCartesian converts geodetic coordinates on this SRS's ellipsoid to earth-centered, earth-fixed coordinates.
+func (s *spatialRefSys) toCartesian(lon, lat float64) (x, y, z float64) {
+	e2 := s.eccentricitySq()
+	sinLat := math.Sin(lat)
+	n := s.semiMajor / math.Sqrt(1-e2*sinLat*sinLat)
+	return n * math.Cos(lat) * math.Cos(lon), n * math.Cos(lat) * math.Sin(lon), n * (1 - e2) * sinLat
+}
+
+// fromCartesian converts earth-centered, earth-fixed coordinates to geodetic coordinates on this SRS's ellipsoid.
+func (s *spatialRefSys) fromCartesian(x, y, z float64) (lon, lat float64) {
+	e2 := s.eccentricitySq()
+	p := math.Hypot(x, y)
+	lon = math.Atan2(y, x)
+	lat = math.Atan2(z, p*(1-e2))
+	for i := 0; i < 10; i++ {
+		sinLat := math.Sin(lat)
+		n := s.semiMajor / math.Sqrt(1-e2*sinLat*sinLat)
+		lat = math.Atan2(z+e2*n*sinLat, p)
+	}
+	return lon, lat
+}
+
+// helmert applies the TOWGS84 position vector transformation, or its inverse if toWGS84 is false.
+func (s *spatialRefSys) helmert(x, y, z float64, toWGS84 bool) (float64, float64, float64) {
+	if s.toWGS84 == nil {
+		return x, y, z
+	}
+	const arcSec = math.Pi / (180 * 3600)
+	p := s.toWGS84
+	tx, ty, tz := p[0], p[1], p[2]
+	rx, ry, rz := p[3]*arcSec, p[4]*arcSec, p[5]*arcSec
+	scale := 1 + p[6]*1e-6
+	if toWGS84 {
+		return tx + scale*(x-rz*y+ry*z),
+			ty + scale*(rz*x+y-rx*z),
+			tz + scale*(-ry*x+rx*y+z)
+	}
+	x, y, z = (x-tx)/scale, (y-ty)/scale, (z-tz)/scale
+	return x + rz*y - ry*z, -rz*x + y + rx*z, ry*x - rx*y + z
+}
+
+// transformPoint moves a point from the src SRS to the dst SRS.
+func transformPoint(src, dst *spatialRefSys, x, y float64) (float64, float64) {
+	lon, lat := src.toGeographic(x, y)
+	if src.datum != dst.datum || !equalHelmert(src.toWGS84, dst.toWGS84) {
+		cx, cy, cz := src.toCartesian(lon, lat)
+		cx, cy, cz = src.helmert(cx, cy, cz, true)
+		cx, cy, cz = dst.helmert(cx, cy, cz, false)
+		lon, lat = dst.fromCartesian(cx, cy, cz)
+	}
+	return dst.fromGeographic(lon, lat)
+}
+
+func equalHelmert(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,570 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// bufferStrategyKind identifies one of the strategies accepted by ST_BUFFER_STRATEGY
+type bufferStrategyKind byte
+
+const (
+	bufferPointCircle bufferStrategyKind = iota + 1
+	bufferPointSquare
+	bufferJoinRound
+	bufferJoinMiter
+	bufferEndRound
+	bufferEndFlat
+)
+
+// bufferStrategySize is the length of the binary value returned by ST_BUFFER_STRATEGY: the strategy kind followed
+// by its float64 argument
+const bufferStrategySize = 9
+
+// defaultPointsPerCircle is the number of segments used to approximate a full circle
+const defaultPointsPerCircle = 32
+
+// maxPointsPerCircle mirrors the max_points_in_geometry default
+const maxPointsPerCircle = 65536
+
+var bufferStrategyNames = map[string]bufferStrategyKind{
+	"point_circle": bufferPointCircle,
+	"point_square": bufferPointSquare,
+	"join_round":   bufferJoinRound,
+	"join_miter":   bufferJoinMiter,
+	"end_round":    bufferEndRound,
+	"end_flat":     bufferEndFlat,
+}
+
+// ErrInvalidBufferStrategy is thrown when a buffer strategy is malformed or given more than once
+var ErrInvalidBufferStrategy = errors.NewKind("Incorrect arguments to %s")
+
+// BufferStrategy is a function that returns a strategy value for ST_BUFFER.
+type BufferStrategy struct {
+	expression.NaryExpression
+}
+
+var _ sql.FunctionExpression = (*BufferStrategy)(nil)
+var _ sql.CollationCoercible = (*BufferStrategy)(nil)
+
+// NewBufferStrategy creates a new ST_BUFFER_STRATEGY expression.
+func NewBufferStrategy(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ST_BUFFER_STRATEGY", "1 or 2", len(args))
+	}
+	return &BufferStrategy{expression.NaryExpression{ChildExpressions: args}}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BufferStrategy) FunctionName() string {
+	return "st_buffer_strategy"
+}
+
+// Description implements sql.FunctionExpression
+func (b *BufferStrategy) Description() string {
+	return "returns a strategy value for st_buffer."
+}
+
+// Type implements the sql.Expression interface.
+func (b *BufferStrategy) Type(ctx *sql.Context) sql.Type {
+	return types.LongBlob
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*BufferStrategy) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 4
+}
+
+func (b *BufferStrategy) String() string {
+	var args = make([]string, len(b.ChildExpressions))
+	for i, arg := range b.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", b.FunctionName(), strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (b *BufferStrategy) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewBufferStrategy(ctx, children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (b *BufferStrategy) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	name, err := b.ChildExpressions[0].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if name == nil {
+		return nil, nil
+	}
+	name, _, err = types.LongText.Convert(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	str, err := sql.UnwrapAny(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	kind, ok := bufferStrategyNames[strings.ToLower(str.(string))]
+	if !ok {
+		return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+	}
+
+	// point_square and end_flat take no argument, every other strategy requires one
+	needsArg := kind != bufferPointSquare && kind != bufferEndFlat
+	if needsArg != (len(b.ChildExpressions) == 2) {
+		return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+	}
+
+	var val float64
+	if needsArg {
+		v, err := b.ChildExpressions[1].Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, nil
+		}
+		v, _, err = types.Float64.Convert(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		val = v.(float64)
+		if val <= 0 || (kind != bufferJoinMiter && val > maxPointsPerCircle) {
+			return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+		}
+	}
+
+	res := make([]byte, bufferStrategySize)
+	res[0] = byte(kind)
+	binary.LittleEndian.PutUint64(res[1:], math.Float64bits(val))
+	return res, nil
+}
+
+// bufferStrategies holds the point, join and end strategies in effect for a call to ST_BUFFER
+type bufferStrategies struct {
+	point      bufferStrategyKind
+	pointValue float64
+	join       bufferStrategyKind
+	joinValue  float64
+	end        bufferStrategyKind
+	endValue   float64
+}
+
+// Buffer is a function that returns the geometry of all points within a given distance of a geometry.
+// Points, multipoints whose buffers do not overlap, two point linestrings and convex polygons without holes are
+// supported; the result is exact for these inputs up to the circle approximation.
+type Buffer struct {
+	expression.NaryExpression
+}
+
+var _ sql.FunctionExpression = (*Buffer)(nil)
+var _ sql.CollationCoercible = (*Buffer)(nil)
+
+// NewBuffer creates a new ST_BUFFER expression.
+func NewBuffer(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 5 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ST_BUFFER", "2, 3, 4, or 5", len(args))
+	}
+	return &Buffer{expression.NaryExpression{ChildExpressions: args}}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *Buffer) FunctionName() string {
+	return "st_buffer"
+}
+
+// Description implements sql.FunctionExpression
+func (b *Buffer) Description() string {
+	return "returns a geometry that represents all points whose distance from the geometry is less than or equal to distance."
+}
+
+// Type implements the sql.Expression interface.
+func (b *Buffer) Type(ctx *sql.Context) sql.Type {
+	return types.GeometryType{}
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Buffer) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 4
+}
+
+func (b *Buffer) String() string {
+	var args = make([]string, len(b.ChildExpressions))
+	for i, arg := range b.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", b.FunctionName(), strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (b *Buffer) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewBuffer(ctx, children...)
+}
+
+// evalStrategies reads the optional strategy arguments, rejecting repeated categories
+func (b *Buffer) evalStrategies(ctx *sql.Context, row sql.Row) (*bufferStrategies, error) {
+	s := &bufferStrategies{
+		point:      bufferPointCircle,
+		pointValue: defaultPointsPerCircle,
+		join:       bufferJoinRound,
+		joinValue:  defaultPointsPerCircle,
+		end:        bufferEndRound,
+		endValue:   defaultPointsPerCircle,
+	}
+	var seenPoint, seenJoin, seenEnd bool
+	for _, expr := range b.ChildExpressions[2:] {
+		v, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, nil
+		}
+		v, err = sql.UnwrapAny(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		var buf []byte
+		switch v := v.(type) {
+		case []byte:
+			buf = v
+		case string:
+			buf = []byte(v)
+		}
+		if len(buf) != bufferStrategySize {
+			return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+		}
+		kind := bufferStrategyKind(buf[0])
+		val := math.Float64frombits(binary.LittleEndian.Uint64(buf[1:]))
+		switch kind {
+		case bufferPointCircle, bufferPointSquare:
+			if seenPoint {
+				return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+			}
+			seenPoint, s.point, s.pointValue = true, kind, val
+		case bufferJoinRound, bufferJoinMiter:
+			if seenJoin {
+				return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+			}
+			seenJoin, s.join, s.joinValue = true, kind, val
+		case bufferEndRound, bufferEndFlat:
+			if seenEnd {
+				return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+			}
+			seenEnd, s.end, s.endValue = true, kind, val
+		default:
+			return nil, ErrInvalidBufferStrategy.New(b.FunctionName())
+		}
+	}
+	return s, nil
+}
+
+// cleanCoord removes floating point noise introduced by trigonometry, so that for example cos(pi/2) is exactly zero
+func cleanCoord(v float64) float64 {
+	if r := math.Round(v); math.Abs(v-r) < 1e-9 {
+		if r == 0 {
+			// avoid negative zero
+			return 0
+		}
+		return r
+	}
+	return v
+}
+
+// arcPoints appends the points of an arc around c with radius r, going counterclockwise from angle start to end
+// with at most step radians between points. Both endpoints are included.
+func arcPoints(points []types.Point, srid uint32, c types.Point, r, start, end, step float64) []types.Point {
+	for end < start {
+		end += 2 * math.Pi
+	}
+	n := int(math.Ceil((end - start) / step))
+	if n < 1 {
+		n = 1
+	}
+	for i := 0; i <= n; i++ {
+		sin, cos := math.Sincos(start + (end-start)*float64(i)/float64(n))
+		points = append(points, types.Point{SRID: srid, X: cleanCoord(c.X + r*cos), Y: cleanCoord(c.Y + r*sin)})
+	}
+	return points
+}
+
+// closeRing returns the points as a closed linestring
+func closeRing(srid uint32, points []types.Point) types.LineString {
+	if len(points) > 0 && (points[0].X != points[len(points)-1].X || points[0].Y != points[len(points)-1].Y) {
+		points = append(points, points[0])
+	}
+	return types.LineString{SRID: srid, Points: points}
+}
+
+// bufferPoint returns the circle or square around p
+func bufferPoint(p types.Point, d float64, s *bufferStrategies) types.Polygon {
+	if s.point == bufferPointSquare {
+		return types.Polygon{SRID: p.SRID, Lines: []types.LineString{closeRing(p.SRID, []types.Point{
+			{SRID: p.SRID, X: p.X - d, Y: p.Y - d},
+			{SRID: p.SRID, X: p.X + d, Y: p.Y - d},
+			{SRID: p.SRID, X: p.X + d, Y: p.Y + d},
+			{SRID: p.SRID, X: p.X - d, Y: p.Y + d},
+		})}}
+	}
+	n := int(s.pointValue)
+	if n < 3 {
+		n = 3
+	}
+	step := 2 * math.Pi / float64(n)
+	points := arcPoints(nil, p.SRID, p, d, 0, 2*math.Pi-step, step)
+	return types.Polygon{SRID: p.SRID, Lines: []types.LineString{closeRing(p.SRID, points)}}
+}
+
+// bufferSegment returns the buffer around the segment from a to b using the end strategy
+func bufferSegment(a, b types.Point, d float64, s *bufferStrategies) types.Polygon {
+	srid := a.SRID
+	angle := math.Atan2(b.Y-a.Y, b.X-a.X)
+	if s.end == bufferEndFlat {
+		sin, cos := math.Sincos(angle + math.Pi/2)
+		ox, oy := d*cos, d*sin
+		return types.Polygon{SRID: srid, Lines: []types.LineString{closeRing(srid, []types.Point{
+			{SRID: srid, X: cleanCoord(a.X - ox), Y: cleanCoord(a.Y - oy)},
+			{SRID: srid, X: cleanCoord(b.X - ox), Y: cleanCoord(b.Y - oy)},
+			{SRID: srid, X: cleanCoord(b.X + ox), Y: cleanCoord(b.Y + oy)},
+			{SRID: srid, X: cleanCoord(a.X + ox), Y: cleanCoord(a.Y + oy)},
+		})}}
+	}
+	step := 2 * math.Pi / math.Max(s.endValue, 3)
+	points := arcPoints(nil, srid, b, d, angle-math.Pi/2, angle+math.Pi/2, step)
+	points = arcPoints(points, srid, a, d, angle+math.Pi/2, angle+3*math.Pi/2, step)
+	return types.Polygon{SRID: srid, Lines: []types.LineString{closeRing(srid, points)}}
+}
+
+// convexRing returns the vertices of a ring in counterclockwise order without the closing point, or false if the ring
+// is not convex
+func convexRing(l types.LineString) ([]types.Point, bool) {
+	points := l.Points
+	if len(points) > 1 && points[0] == points[len(points)-1] {
+		points = points[:len(points)-1]
+	}
+	// drop collinear vertices, they would produce zero length offset edges
+	var ring []types.Point
+	for i := range points {
+		prev, next := points[(i+len(points)-1)%len(points)], points[(i+1)%len(points)]
+		if cross2D(prev, points[i], next) != 0 {
+			ring = append(ring, points[i])
+		}
+	}
+	if len(ring) < 3 {
+		return nil, false
+	}
+	sign := 0.0
+	for i := range ring {
+		c := cross2D(ring[i], ring[(i+1)%len(ring)], ring[(i+2)%len(ring)])
+		if sign == 0 {
+			sign = c
+		} else if (c > 0) != (sign > 0) {
+			return nil, false
+		}
+	}
+	if sign < 0 {
+		for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+			ring[i], ring[j] = ring[j], ring[i]
+		}
+	}
+	return ring, true
+}
+
+// lineIntersection returns the intersection of the line through p1 with direction d1 and the line through p2 with
+// direction d2
+func lineIntersection(p1, d1, p2, d2 types.Point) (types.Point, bool) {
+	den := d1.X*d2.Y - d1.Y*d2.X
+	if den == 0 {
+		return types.Point{}, false
+	}
+	t := ((p2.X-p1.X)*d2.Y - (p2.Y-p1.Y)*d2.X) / den
+	return types.Point{SRID: p1.SRID, X: p1.X + t*d1.X, Y: p1.Y + t*d1.Y}, true
+}
+
+// growConvex returns the buffer of a counterclockwise convex ring for a positive distance
+func growConvex(ring []types.Point, d float64, s *bufferStrategies) types.Polygon {
+	srid := ring[0].SRID
+	n := len(ring)
+	normals := make([]float64, n)
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%n]
+		normals[i] = math.Atan2(b.Y-a.Y, b.X-a.X) - math.Pi/2
+	}
+	var points []types.Point
+	for i := range ring {
+		prev, cur := normals[(i+n-1)%n], normals[i]
+		v := ring[i]
+		if s.join == bufferJoinMiter {
+			ps, pc := math.Sincos(prev)
+			cs, cc := math.Sincos(cur)
+			p1 := types.Point{SRID: srid, X: v.X + d*pc, Y: v.Y + d*ps}
+			p2 := types.Point{SRID: srid, X: v.X + d*cc, Y: v.Y + d*cs}
+			m, ok := lineIntersection(p1, types.Point{X: -ps, Y: pc}, p2, types.Point{X: -cs, Y: cc})
+			// corners sharper than the miter limit are beveled
+			if ok && math.Hypot(m.X-v.X, m.Y-v.Y) <= s.joinValue*d {
+				points = append(points, types.Point{SRID: srid, X: cleanCoord(m.X), Y: cleanCoord(m.Y)})
+			} else {
+				points = append(points,
+					types.Point{SRID: srid, X: cleanCoord(p1.X), Y: cleanCoord(p1.Y)},
+					types.Point{SRID: srid, X: cleanCoord(p2.X), Y: cleanCoord(p2.Y)})
+			}
+			continue
+		}
+		points = arcPoints(points, srid, v, d, prev, cur, 2*math.Pi/math.Max(s.joinValue, 3))
+	}
+	return types.Polygon{SRID: srid, Lines: []types.LineString{closeRing(srid, points)}}
+}
+
+// shrinkConvex returns the ring clipped by every edge moved inward by d, which is the negative buffer of a convex ring
+func shrinkConvex(ring []types.Point, d float64) []types.Point {
+	n := len(ring)
+	res := ring
+	for i := 0; i < n && len(res) > 0; i++ {
+		a, b := ring[i], ring[(i+1)%n]
+		length := math.Hypot(b.X-a.X, b.Y-a.Y)
+		// inward normal of a counterclockwise edge
+		nx, ny := -(b.Y-a.Y)/length, (b.X-a.X)/length
+		side := func(p types.Point) float64 {
+			return (p.X-a.X)*nx + (p.Y-a.Y)*ny - d
+		}
+		var clipped []types.Point
+		for j := range res {
+			p, q := res[j], res[(j+1)%len(res)]
+			sp, sq := side(p), side(q)
+			if sp >= 0 {
+				clipped = append(clipped, p)
+			}
+			if (sp >= 0) != (sq >= 0) {
+				t := sp / (sp - sq)
+				clipped = append(clipped, types.Point{SRID: p.SRID, X: cleanCoord(p.X + t*(q.X-p.X)), Y: cleanCoord(p.Y + t*(q.Y-p.Y))})
+			}
+		}
+		res = clipped
+	}
+	if len(res) < 3 {
+		return nil
+	}
+	return res
+}
+
+// pointsOverlap returns whether any two buffers of radius d around the points would touch
+func pointsOverlap(points []types.Point, d float64) bool {
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			if calcPointDist(points[i], points[j]) <= 2*d {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Eval implements the sql.Expression interface.
+func (b *Buffer) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := b.ChildExpressions[0].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	dist, err := b.ChildExpressions[1].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil || dist == nil {
+		return nil, nil
+	}
+	gv, err := types.UnwrapGeometry(ctx, g)
+	if err != nil {
+		return nil, sql.ErrInvalidGISData.New(b.FunctionName())
+	}
+	dist, _, err = types.Float64.Convert(ctx, dist)
+	if err != nil {
+		return nil, err
+	}
+	d := dist.(float64)
+
+	srid := gv.GetSRID()
+	if srs, ok := lookupSRS(srid); ok && srs.geographic {
+		return nil, sql.ErrUnsupportedSRID.New(srid)
+	}
+
+	s, err := b.evalStrategies(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, nil
+	}
+
+	if d == 0 {
+		return gv, nil
+	}
+	empty := types.GeomColl{SRID: srid, Geoms: []types.GeometryValue{}}
+
+	switch v := gv.(type) {
+	case types.Point:
+		if d < 0 {
+			return empty, nil
+		}
+		return bufferPoint(v, d, s), nil
+	case types.MultiPoint:
+		if d < 0 {
+			return empty, nil
+		}
+		if pointsOverlap(v.Points, d) {
+			return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("MultiPoint with overlapping buffers", b.FunctionName())
+		}
+		polys := make([]types.Polygon, len(v.Points))
+		for i, p := range v.Points {
+			polys[i] = bufferPoint(p, d, s)
+		}
+		return types.MultiPolygon{SRID: srid, Polygons: polys}, nil
+	case types.LineString:
+		if d < 0 {
+			return empty, nil
+		}
+		if len(v.Points) != 2 {
+			return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("LineString with more than one segment", b.FunctionName())
+		}
+		return bufferSegment(v.Points[0], v.Points[1], d, s), nil
+	case types.Polygon:
+		if len(v.Lines) != 1 {
+			return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("Polygon with holes", b.FunctionName())
+		}
+		ring, ok := convexRing(v.Lines[0])
+		if !ok {
+			return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("non-convex Polygon", b.FunctionName())
+		}
+		if d > 0 {
+			return growConvex(ring, d, s), nil
+		}
+		shrunk := shrinkConvex(ring, -d)
+		if shrunk == nil {
+			return empty, nil
+		}
+		return types.Polygon{SRID: srid, Lines: []types.LineString{closeRing(srid, shrunk)}}, nil
+	}
+	return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New(geometryTypeName(gv), b.FunctionName())
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestBuffer(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	strategy := func(args ...interface{}) sql.Expression {
+		exprs := []sql.Expression{expression.NewLiteral(args[0], types.LongText)}
+		if len(args) > 1 {
+			exprs = append(exprs, expression.NewLiteral(args[1], types.Int64))
+		}
+		f, err := NewBufferStrategy(ctx, exprs...)
+		require.NoError(t, err)
+		return f
+	}
+	buffer := func(g types.GeometryValue, d float64, strategies ...sql.Expression) (interface{}, error) {
+		args := append([]sql.Expression{
+			expression.NewLiteral(g, types.GeometryType{}),
+			expression.NewLiteral(d, types.Float64),
+		}, strategies...)
+		f, err := NewBuffer(ctx, args...)
+		if err != nil {
+			return nil, err
+		}
+		return f.Eval(ctx, nil)
+	}
+
+	t.Run("point square", func(t *testing.T) {
+		require := require.New(t)
+		v, err := buffer(types.Point{X: 0, Y: 0}, 1, strategy("point_square"))
+		require.NoError(err)
+		require.Equal(types.Polygon{Lines: []types.LineString{{Points: []types.Point{
+			{X: -1, Y: -1}, {X: 1, Y: -1}, {X: 1, Y: 1}, {X: -1, Y: 1}, {X: -1, Y: -1},
+		}}}}, v)
+	})
+
+	t.Run("point circle", func(t *testing.T) {
+		require := require.New(t)
+		v, err := buffer(types.Point{X: 1, Y: 1}, 2, strategy("point_circle", 4))
+		require.NoError(err)
+		require.Equal(types.Polygon{Lines: []types.LineString{{Points: []types.Point{
+			{X: 3, Y: 1}, {X: 1, Y: 3}, {X: -1, Y: 1}, {X: 1, Y: -1}, {X: 3, Y: 1},
+		}}}}, v)
+	})
+
+	t.Run("segment with flat ends", func(t *testing.T) {
+		require := require.New(t)
+		l := types.LineString{Points: []types.Point{{X: 0, Y: 0}, {X: 4, Y: 0}}}
+		v, err := buffer(l, 1, strategy("end_flat"))
+		require.NoError(err)
+		require.Equal(types.Polygon{Lines: []types.LineString{{Points: []types.Point{
+			{X: 0, Y: -1}, {X: 4, Y: -1}, {X: 4, Y: 1}, {X: 0, Y: 1}, {X: 0, Y: -1},
+		}}}}, v)
+	})
+
+	t.Run("square with miter joins", func(t *testing.T) {
+		require := require.New(t)
+		sq := types.Polygon{Lines: []types.LineString{{Points: []types.Point{
+			{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 0, Y: 0},
+		}}}}
+		v, err := buffer(sq, 1, strategy("join_miter", 5))
+		require.NoError(err)
+		require.Equal(types.Polygon{Lines: []types.LineString{{Points: []types.Point{
+			{X: -1, Y: -1}, {X: 3, Y: -1}, {X: 3, Y: 3}, {X: -1, Y: 3}, {X: -1, Y: -1},
+		}}}}, v)
+
+		v, err = buffer(sq, -0.5)
+		require.NoError(err)
+		require.Equal(types.Polygon{Lines: []types.LineString{{Points: []types.Point{
+			{X: 0.5, Y: 0.5}, {X: 1.5, Y: 0.5}, {X: 1.5, Y: 1.5}, {X: 0.5, Y: 1.5}, {X: 0.5, Y: 0.5},
+		}}}}, v)
+
+		v, err = buffer(sq, -2)
+		require.NoError(err)
+		require.Equal(types.GeomColl{Geoms: []types.GeometryValue{}}, v)
+	})
+
+	t.Run("zero and negative distances", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{X: 1, Y: 2}
+		v, err := buffer(p, 0)
+		require.NoError(err)
+		require.Equal(p, v)
+		v, err = buffer(p, -1)
+		require.NoError(err)
+		require.Equal(types.GeomColl{Geoms: []types.GeometryValue{}}, v)
+	})
+
+	t.Run("invalid strategies", func(t *testing.T) {
+		require := require.New(t)
+		_, err := buffer(types.Point{}, 1, strategy("point_square"), strategy("point_circle", 8))
+		require.True(ErrInvalidBufferStrategy.Is(err))
+		_, err = strategy("point_circle").Eval(ctx, nil)
+		require.True(ErrInvalidBufferStrategy.Is(err))
+		_, err = strategy("end_flat", 3).Eval(ctx, nil)
+		require.True(ErrInvalidBufferStrategy.Is(err))
+		_, err = strategy("bogus").Eval(ctx, nil)
+		require.True(ErrInvalidBufferStrategy.Is(err))
+	})
+}
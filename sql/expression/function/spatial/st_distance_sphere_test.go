@@ -0,0 +1,80 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestDistanceSphere(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	distance := func(args ...interface{}) (interface{}, error) {
+		exprs := make([]sql.Expression, len(args))
+		for i, a := range args {
+			if g, ok := a.(types.GeometryValue); ok {
+				exprs[i] = expression.NewLiteral(g, types.GeometryType{})
+			} else {
+				exprs[i] = expression.NewLiteral(a, types.Float64)
+			}
+		}
+		f, err := NewDistanceSphere(ctx, exprs...)
+		if err != nil {
+			return nil, err
+		}
+		return f.Eval(ctx, nil)
+	}
+
+	t.Run("one degree along the equator", func(t *testing.T) {
+		require := require.New(t)
+		v, err := distance(types.Point{X: 0, Y: 0}, types.Point{X: 1, Y: 0})
+		require.NoError(err)
+		require.InDelta(111194.7, v.(float64), 0.1)
+	})
+
+	t.Run("geographic points with radius", func(t *testing.T) {
+		require := require.New(t)
+		a := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 0}
+		b := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 90}
+		v, err := distance(a, b, 1.0)
+		require.NoError(err)
+		require.InDelta(1.5707963, v.(float64), 1e-6)
+	})
+
+	t.Run("multipoint uses the closest pair", func(t *testing.T) {
+		require := require.New(t)
+		mp := types.MultiPoint{Points: []types.Point{{X: 50, Y: 50}, {X: 0, Y: 1}}}
+		v, err := distance(types.Point{X: 0, Y: 0}, mp)
+		require.NoError(err)
+		require.InDelta(111194.7, v.(float64), 0.1)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		require := require.New(t)
+		_, err := distance(types.Point{X: 0, Y: 0}, types.Point{X: 200, Y: 0})
+		require.True(ErrLongitudeOutOfRange.Is(err))
+		_, err = distance(types.Point{X: 0, Y: 0}, types.Point{X: 0, Y: 0}, 0.0)
+		require.True(ErrNonPositiveRadius.Is(err))
+		_, err = distance(types.Point{X: 0, Y: 0}, types.LineString{Points: []types.Point{{}, {X: 1}}})
+		require.True(sql.ErrUnsupportedGISTypeForSpatialFunc.Is(err))
+		_, err = distance(types.Point{SRID: 3857}, types.Point{SRID: 3857})
+		require.True(ErrNonGeographic.Is(err))
+	})
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"fmt"
+	"math"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ErrTransformSourceNotSupported is returned when ST_TRANSFORM cannot convert from the SRID of its argument
+var ErrTransformSourceNotSupported = errors.NewKind("Transformation from SRID %v is not supported.")
+
+// ErrTransformTargetNotSupported is returned when ST_TRANSFORM cannot convert to the requested SRID
+var ErrTransformTargetNotSupported = errors.NewKind("Transformation to SRID %v is not supported.")
+
+// ErrTransformSourceNoTOWGS84 is returned when the source SRS has no datum shift to WGS 84
+var ErrTransformSourceNoTOWGS84 = errors.NewKind("Transformation from SRID %v is not supported: the spatial reference system has no TOWGS84 clause.")
+
+// ErrTransformTargetNoTOWGS84 is returned when the target SRS has no datum shift to WGS 84
+var ErrTransformTargetNoTOWGS84 = errors.NewKind("Transformation to SRID %v is not supported: the spatial reference system has no TOWGS84 clause.")
+
+// Transform is a function that converts a geometry from one spatial reference system to another.
+// Geographic SRSs, Pseudo-Mercator and Transverse Mercator projections are supported. Datum shifts use the TOWGS84
+// clause of the bundled EPSG definitions.
+type Transform struct {
+	expression.BinaryExpressionStub
+}
+
+var _ sql.FunctionExpression = (*Transform)(nil)
+var _ sql.CollationCoercible = (*Transform)(nil)
+
+// NewTransform creates a new ST_TRANSFORM expression.
+func NewTransform(ctx *sql.Context, g, srid sql.Expression) sql.Expression {
+	return &Transform{expression.BinaryExpressionStub{LeftChild: g, RightChild: srid}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *Transform) FunctionName() string {
+	return "st_transform"
+}
+
+// Description implements sql.FunctionExpression
+func (t *Transform) Description() string {
+	return "returns the geometry transformed to the spatial reference system of the given SRID."
+}
+
+// Type implements the sql.Expression interface.
+func (t *Transform) Type(ctx *sql.Context) sql.Type {
+	return types.GeometryType{}
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Transform) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 4
+}
+
+func (t *Transform) String() string {
+	return fmt.Sprintf("%s(%s,%s)", t.FunctionName(), t.LeftChild, t.RightChild)
+}
+
+// WithChildren implements the Expression interface.
+func (t *Transform) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 2)
+	}
+	return NewTransform(ctx, children[0], children[1]), nil
+}
+
+// mapPoints returns a copy of g with every point replaced by the result of fn.
+func mapPoints(g types.GeometryValue, fn func(types.Point) (types.Point, error)) (types.GeometryValue, error) {
+	switch g := g.(type) {
+	case types.Point:
+		return fn(g)
+	case types.LineString:
+		points := make([]types.Point, len(g.Points))
+		for i, p := range g.Points {
+			np, err := fn(p)
+			if err != nil {
+				return nil, err
+			}
+			points[i] = np
+		}
+		return types.LineString{SRID: g.SRID, Points: points}, nil
+	case types.Polygon:
+		lines := make([]types.LineString, len(g.Lines))
+		for i, l := range g.Lines {
+			nl, err := mapPoints(l, fn)
+			if err != nil {
+				return nil, err
+			}
+			lines[i] = nl.(types.LineString)
+		}
+		return types.Polygon{SRID: g.SRID, Lines: lines}, nil
+	case types.MultiPoint:
+		points := make([]types.Point, len(g.Points))
+		for i, p := range g.Points {
+			np, err := fn(p)
+			if err != nil {
+				return nil, err
+			}
+			points[i] = np
+		}
+		return types.MultiPoint{SRID: g.SRID, Points: points}, nil
+	case types.MultiLineString:
+		lines := make([]types.LineString, len(g.Lines))
+		for i, l := range g.Lines {
+			nl, err := mapPoints(l, fn)
+			if err != nil {
+				return nil, err
+			}
+			lines[i] = nl.(types.LineString)
+		}
+		return types.MultiLineString{SRID: g.SRID, Lines: lines}, nil
+	case types.MultiPolygon:
+		polys := make([]types.Polygon, len(g.Polygons))
+		for i, p := range g.Polygons {
+			np, err := mapPoints(p, fn)
+			if err != nil {
+				return nil, err
+			}
+			polys[i] = np.(types.Polygon)
+		}
+		return types.MultiPolygon{SRID: g.SRID, Polygons: polys}, nil
+	case types.GeomColl:
+		geoms := make([]types.GeometryValue, len(g.Geoms))
+		for i, gg := range g.Geoms {
+			ng, err := mapPoints(gg, fn)
+			if err != nil {
+				return nil, err
+			}
+			geoms[i] = ng
+		}
+		return types.GeomColl{SRID: g.SRID, Geoms: geoms}, nil
+	}
+	return g, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (t *Transform) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := t.LeftChild.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, nil
+	}
+	s, err := getIntArg(ctx, row, t.RightChild)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, nil
+	}
+
+	gv, err := types.UnwrapGeometry(ctx, g)
+	if err != nil {
+		return nil, sql.ErrInvalidGISData.New(t.FunctionName())
+	}
+	if err = types.ValidateSRID(s.(int), t.FunctionName()); err != nil {
+		return nil, err
+	}
+	srcSRID, dstSRID := gv.GetSRID(), uint32(s.(int))
+	if srcSRID == dstSRID {
+		return gv, nil
+	}
+
+	src, ok := lookupSRS(srcSRID)
+	if !ok || !src.canProject() {
+		return nil, ErrTransformSourceNotSupported.New(srcSRID)
+	}
+	dst, ok := lookupSRS(dstSRID)
+	if !ok || !dst.canProject() {
+		return nil, ErrTransformTargetNotSupported.New(dstSRID)
+	}
+	if src.datum != dst.datum {
+		if !src.canShiftDatum() {
+			return nil, ErrTransformSourceNoTOWGS84.New(srcSRID)
+		}
+		if !dst.canShiftDatum() {
+			return nil, ErrTransformTargetNoTOWGS84.New(dstSRID)
+		}
+	}
+
+	res, err := mapPoints(gv, func(p types.Point) (types.Point, error) {
+		x, y := transformPoint(src, dst, p.X, p.Y)
+		if math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+			return types.Point{}, sql.ErrInvalidGISData.New(t.FunctionName())
+		}
+		return types.Point{SRID: dstSRID, X: x, Y: y}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.SetSRID(dstSRID), nil
+}
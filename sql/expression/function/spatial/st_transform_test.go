@@ -0,0 +1,97 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestTransform(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	transform := func(g types.GeometryValue, srid int) (interface{}, error) {
+		f := NewTransform(ctx, expression.NewLiteral(g, types.GeometryType{}), expression.NewLiteral(srid, types.Int32))
+		return f.Eval(ctx, nil)
+	}
+
+	t.Run("wgs 84 to pseudo mercator and back", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{SRID: types.GeoSpatialSRID, X: 10, Y: 45}
+		v, err := transform(p, 3857)
+		require.NoError(err)
+		merc := v.(types.Point)
+		require.Equal(uint32(3857), merc.SRID)
+		require.InDelta(1113194.9079, merc.X, 1e-3)
+		require.InDelta(5621521.4862, merc.Y, 1e-3)
+
+		v, err = transform(merc, 4326)
+		require.NoError(err)
+		require.InDelta(10.0, v.(types.Point).X, 1e-9)
+		require.InDelta(45.0, v.(types.Point).Y, 1e-9)
+	})
+
+	t.Run("wgs 84 to utm zone 33N", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{SRID: types.GeoSpatialSRID, X: 15, Y: 52}
+		v, err := transform(p, 32633)
+		require.NoError(err)
+		utm := v.(types.Point)
+		require.InDelta(500000.0, utm.X, 1e-3)
+		require.InDelta(5761038.2, utm.Y, 1)
+
+		v, err = transform(types.Point{SRID: types.GeoSpatialSRID, X: 13, Y: 52}, 32633)
+		require.NoError(err)
+		require.InDelta(362705.0, v.(types.Point).X, 1)
+	})
+
+	t.Run("linestring keeps its shape", func(t *testing.T) {
+		require := require.New(t)
+		l := types.LineString{SRID: types.GeoSpatialSRID, Points: []types.Point{
+			{SRID: types.GeoSpatialSRID, X: 0, Y: 0},
+			{SRID: types.GeoSpatialSRID, X: 1, Y: 1},
+		}}
+		v, err := transform(l, 3857)
+		require.NoError(err)
+		res := v.(types.LineString)
+		require.Len(res.Points, 2)
+		require.Equal(uint32(3857), res.Points[1].SRID)
+		require.InDelta(0.0, res.Points[0].X, 1e-9)
+	})
+
+	t.Run("same srid is a no-op", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{SRID: types.GeoSpatialSRID, X: 1, Y: 2}
+		v, err := transform(p, 4326)
+		require.NoError(err)
+		require.Equal(p, v)
+	})
+
+	t.Run("cartesian srid errors", func(t *testing.T) {
+		require := require.New(t)
+		_, err := transform(types.Point{X: 1, Y: 2}, 4326)
+		require.True(ErrTransformSourceNotSupported.Is(err))
+	})
+
+	t.Run("unknown srid errors", func(t *testing.T) {
+		require := require.New(t)
+		_, err := transform(types.Point{SRID: types.GeoSpatialSRID, X: 1, Y: 2}, 1234567)
+		require.True(sql.ErrNoSRID.Is(err))
+	})
+}
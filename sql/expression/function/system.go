@@ -115,3 +115,42 @@ func (c User) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func (c User) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	return NoArgFuncWithChildren(c, children)
 }
+
+// CurrentRole is the CURRENT_ROLE() function, which returns the roles that are active in the session.
+type CurrentRole struct {
+	NoArgFunc
+}
+
+var _ sql.FunctionExpression = CurrentRole{}
+var _ sql.CollationCoercible = CurrentRole{}
+
+func NewCurrentRole(ctx *sql.Context) sql.Expression {
+	return CurrentRole{
+		NoArgFunc: NoArgFunc{Name: "current_role", SQLType: types.LongText},
+	}
+}
+
+func (c CurrentRole) IsNonDeterministic() bool {
+	return true
+}
+
+// Description implements sql.FunctionExpression
+func (c CurrentRole) Description() string {
+	return "returns the active roles of the current session."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (CurrentRole) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_utf8mb3_general_ci, 3
+}
+
+// Eval implements sql.Expression
+func (c CurrentRole) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	roles, _ := ctx.Session.GetActiveRoles()
+	return sql.FormatRoleNames(roles), nil
+}
+
+// WithChildren implements sql.Expression
+func (c CurrentRole) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NoArgFuncWithChildren(c, children)
+}
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -57,12 +58,15 @@ func getDatePart(ctx *sql.Context,
 		return nil, err
 	}
 
-	date, err := getDate(ctx, val)
-	if err != nil {
-		return nil, err
-	}
-	if date == nil {
-		return nil, nil
+	var date interface{} = val
+	if _, ok := val.(types.DateParts); !ok {
+		date, err = getDate(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		if date == nil {
+			return nil, nil
+		}
 	}
 
 	part := f(date)
@@ -565,11 +569,30 @@ func (d *DayOfYear) WithChildren(ctx *sql.Context, children ...sql.Expression) (
 
 func datePartFunc(fn func(time.Time) interface{}) func(interface{}) interface{} {
 	return func(v interface{}) interface{} {
-		if v == nil {
-			return nil
+		switch v := v.(type) {
+		case time.Time:
+			return fn(v)
+		case types.DateParts:
+			// a day past the end of its month is read as a day of the next month, and a zero month or day has no date
+			if t, ok := v.Time(); ok {
+				return fn(t)
+			}
 		}
+		return nil
+	}
+}
 
-		return fn(v.(time.Time))
+// datePartsFunc is datePartFunc for the parts that are read as they're written, even those of the dates that a
+// time.Time can't hold, such as the zero month of '2020-00-15'.
+func datePartsFunc(fn func(types.DateParts) interface{}) func(interface{}) interface{} {
+	return func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case time.Time:
+			return fn(types.DatePartsOf(v, true))
+		case types.DateParts:
+			return fn(v)
+		}
+		return nil
 	}
 }
 
@@ -919,33 +942,18 @@ func calcDaynr(yyyy, mm, dd int32) int32 {
 }
 
 var (
-	year = datePartFunc(func(t time.Time) interface{} {
-		if t.Equal(types.ZeroTime) {
-			return 0
-		}
-		return t.Year()
-	})
-	month = datePartFunc(func(t time.Time) interface{} {
-		if t.Equal(types.ZeroTime) {
-			return 0
-		}
-		return int(t.Month())
-	})
-	day = datePartFunc(func(t time.Time) interface{} {
-		if t.Equal(types.ZeroTime) {
-			return 0
-		}
-		return t.Day()
-	})
+	year    = datePartsFunc(func(d types.DateParts) interface{} { return d.Year })
+	month   = datePartsFunc(func(d types.DateParts) interface{} { return d.Month })
+	day     = datePartsFunc(func(d types.DateParts) interface{} { return d.Day })
 	weekday = datePartFunc(func(t time.Time) interface{} {
 		if t.Equal(types.ZeroTime) {
 			return nil
 		}
 		return (int(t.Weekday()) + 6) % 7
 	})
-	hour      = datePartFunc(func(t time.Time) interface{} { return t.Hour() })
-	minute    = datePartFunc(func(t time.Time) interface{} { return t.Minute() })
-	second    = datePartFunc(func(t time.Time) interface{} { return t.Second() })
+	hour      = datePartsFunc(func(d types.DateParts) interface{} { return d.Hour })
+	minute    = datePartsFunc(func(d types.DateParts) interface{} { return d.Minute })
+	second    = datePartsFunc(func(d types.DateParts) interface{} { return d.Second })
 	dayOfWeek = datePartFunc(func(t time.Time) interface{} {
 		if t.Equal(types.ZeroTime) {
 			return nil
@@ -958,14 +966,11 @@ var (
 		}
 		return t.YearDay()
 	})
-	quarter = datePartFunc(func(t time.Time) interface{} {
-		if t.Equal(types.ZeroTime) {
-			return 0
-		}
-		return (int(t.Month())-1)/3 + 1
+	quarter = datePartsFunc(func(d types.DateParts) interface{} {
+		return (d.Month + 2) / 3
 	})
-	microsecond = datePartFunc(func(t time.Time) interface{} {
-		return uint64(t.Nanosecond()) / uint64(time.Microsecond)
+	microsecond = datePartsFunc(func(d types.DateParts) interface{} {
+		return uint64(d.Microsecond)
 	})
 )
 
@@ -1039,13 +1044,29 @@ func (n *Now) Description() string {
 
 // Type implements the sql.Expression interface.
 func (n *Now) Type(ctx *sql.Context) sql.Type {
-	// TODO: precision
 	if n.prec == nil {
 		return types.Datetime
 	}
+	if fsp, ok := literalPrecision(n.prec); ok {
+		return types.MustCreateDatetimeType(sqltypes.Datetime, fsp)
+	}
 	return types.DatetimeMaxPrecision
 }
 
+// literalPrecision returns the fractional second precision given by |prec| when it's a literal within the allowed
+// range, so that the type of the result can report it.
+func literalPrecision(prec sql.Expression) (int, bool) {
+	lit, ok := prec.(*expression.Literal)
+	if !ok {
+		return 0, false
+	}
+	fsp, ok := types.CoalesceInt(lit.Value())
+	if !ok || fsp < 0 || fsp > maxCurrTimestampPrecision {
+		return 0, false
+	}
+	return fsp, true
+}
+
 // CollationCoercibility implements the interface sql.CollationCoercible.
 func (*Now) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	return sql.Collation_binary, 5
@@ -1463,7 +1484,7 @@ func (d *DayName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	return t.Weekday().String(), nil
+	return sql.LoadTimeLocale(ctx).DayName(t), nil
 }
 
 func (d *DayName) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
@@ -1545,7 +1566,7 @@ func (d *MonthName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			ctx.Warn(1292, "%s", types.ErrConvertingToTime.New(val).Error())
 			return nil, nil
 		}
-		return v.Month().String(), nil
+		return sql.LoadTimeLocale(ctx).MonthName(v), nil
 	case nil:
 		return nil, nil
 	default:
@@ -1690,6 +1711,12 @@ func (c *CurrTime) Description() string {
 
 // Type implements the sql.Expression interface.
 func (c *CurrTime) Type(ctx *sql.Context) sql.Type {
+	if c.prec == nil {
+		return types.MustCreateTimeType(0)
+	}
+	if fsp, ok := literalPrecision(c.prec); ok {
+		return types.MustCreateTimeType(fsp)
+	}
 	return types.Time
 }
 
@@ -1739,8 +1766,7 @@ func (c *CurrTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	if t, ok := result.(time.Time); ok {
-		// TODO: this is wrong, we need to include nanoseconds
-		return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second()), nil
+		return types.Time.ConvertToTimespan(t)
 	} else {
 		return nil, fmt.Errorf("unexpected type %T for NOW() result", result)
 	}
@@ -246,20 +246,30 @@ func (d *DateAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	var dateVal interface{}
-	dateVal, _, err = types.DatetimeMaxRange.Convert(ctx, date)
-	if err != nil {
-		ctx.Warn(1292, "%s", err.Error())
-		return nil, nil
-	}
-	datetime, ok := dateVal.(time.Time)
-	if !ok || datetime.Equal(types.ZeroTime) {
-		ctx.Warn(1292, "Incorrect datetime value: '%s'", date)
-		return nil, nil
+	var res interface{}
+	if parts, ok := date.(types.DateParts); ok {
+		datetime, ok := delta.AddParts(parts)
+		if !ok {
+			ctx.Warn(1292, "Incorrect datetime value: '%s'", parts)
+			return nil, nil
+		}
+		res = types.ValidateTime(datetime)
+	} else {
+		var dateVal interface{}
+		dateVal, _, err = types.DatetimeMaxRange.Convert(ctx, date)
+		if err != nil {
+			ctx.Warn(1292, "%s", err.Error())
+			return nil, nil
+		}
+		datetime, ok := dateVal.(time.Time)
+		if !ok || datetime.Equal(types.ZeroTime) {
+			ctx.Warn(1292, "Incorrect datetime value: '%s'", date)
+			return nil, nil
+		}
+		res = types.ValidateTime(delta.Add(datetime))
 	}
 
 	// return appropriate type
-	res := types.ValidateTime(delta.Add(datetime))
 	if res == nil {
 		return nil, nil
 	}
@@ -399,20 +409,30 @@ func (d *DateSub) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	var dateVal interface{}
-	dateVal, _, err = types.DatetimeMaxRange.Convert(ctx, date)
-	if err != nil {
-		ctx.Warn(1292, "%s", err.Error())
-		return nil, nil
-	}
-	datetime, ok := dateVal.(time.Time)
-	if !ok || datetime.Equal(types.ZeroTime) {
-		ctx.Warn(1292, "Incorrect datetime value: '%s'", date)
-		return nil, nil
+	var res interface{}
+	if parts, ok := date.(types.DateParts); ok {
+		datetime, ok := delta.SubParts(parts)
+		if !ok {
+			ctx.Warn(1292, "Incorrect datetime value: '%s'", parts)
+			return nil, nil
+		}
+		res = types.ValidateTime(datetime)
+	} else {
+		var dateVal interface{}
+		dateVal, _, err = types.DatetimeMaxRange.Convert(ctx, date)
+		if err != nil {
+			ctx.Warn(1292, "%s", err.Error())
+			return nil, nil
+		}
+		datetime, ok := dateVal.(time.Time)
+		if !ok || datetime.Equal(types.ZeroTime) {
+			ctx.Warn(1292, "Incorrect datetime value: '%s'", date)
+			return nil, nil
+		}
+		res = types.ValidateTime(delta.Sub(datetime))
 	}
 
 	// return appropriate type
-	res := types.ValidateTime(delta.Sub(datetime))
 	if res == nil {
 		return nil, nil
 	}
@@ -527,8 +527,8 @@ func TestTimeDiff(t *testing.T) {
 		},
 		{
 			"time types 1",
-			expression.NewConvert(expression.NewLiteral("00:00:00.1", types.Text), expression.ConvertToTime),
-			expression.NewConvert(expression.NewLiteral("00:00:00.2", types.Text), expression.ConvertToTime),
+			expression.NewConvertWithLengthAndScale(expression.NewLiteral("00:00:00.1", types.Text), expression.ConvertToTime, 1, 0),
+			expression.NewConvertWithLengthAndScale(expression.NewLiteral("00:00:00.2", types.Text), expression.ConvertToTime, 1, 0),
 			toTimespan("-00:00:00.100000"),
 			false,
 		},
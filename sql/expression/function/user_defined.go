@@ -0,0 +1,128 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+)
+
+// UserDefinedFunctionCall is a call to a scalar sql.UserDefinedFunction registered by an integrator.
+type UserDefinedFunctionCall struct {
+	expression.NaryExpression
+	fn *sql.UserDefinedFunction
+}
+
+var _ sql.FunctionExpression = (*UserDefinedFunctionCall)(nil)
+var _ sql.CollationCoercible = (*UserDefinedFunctionCall)(nil)
+var _ sql.NonDeterministicExpression = (*UserDefinedFunctionCall)(nil)
+var _ sql.SessionDependentExpression = (*UserDefinedFunctionCall)(nil)
+
+// NewUserDefinedFunction returns the sql.Function for the user-defined function given, which is either a scalar or
+// an aggregate function.
+func NewUserDefinedFunction(fn sql.UserDefinedFunction) sql.Function {
+	if fn.IsAggregate() {
+		return aggregation.NewUserDefinedAggregationFunction(fn)
+	}
+	return sql.FunctionN{
+		Name: strings.ToLower(fn.Name),
+		Fn: func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+			if err := fn.CheckArity(len(args)); err != nil {
+				return nil, err
+			}
+			return &UserDefinedFunctionCall{NaryExpression: expression.NaryExpression{ChildExpressions: args}, fn: &fn}, nil
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (u *UserDefinedFunctionCall) FunctionName() string {
+	return strings.ToLower(u.fn.Name)
+}
+
+// Description implements sql.FunctionExpression
+func (u *UserDefinedFunctionCall) Description() string {
+	return u.fn.Comment
+}
+
+// Type implements the sql.Expression interface.
+func (u *UserDefinedFunctionCall) Type(ctx *sql.Context) sql.Type {
+	return u.fn.Type(ctx, u.ChildExpressions)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (u *UserDefinedFunctionCall) IsNullable(ctx *sql.Context) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (u *UserDefinedFunctionCall) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if st, ok := u.Type(ctx).(sql.StringType); ok {
+		return st.Collation(), 4
+	}
+	return sql.Collation_binary, 5
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (u *UserDefinedFunctionCall) IsNonDeterministic() bool {
+	return u.fn.Volatility == sql.FunctionVolatile
+}
+
+// IsSessionDependent implements sql.SessionDependentExpression
+func (u *UserDefinedFunctionCall) IsSessionDependent() bool {
+	return u.fn.Volatility == sql.FunctionStable
+}
+
+func (u *UserDefinedFunctionCall) String() string {
+	args := make([]string, len(u.ChildExpressions))
+	for i, arg := range u.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", u.FunctionName(), strings.Join(args, ","))
+}
+
+// WithChildren implements the sql.Expression interface.
+func (u *UserDefinedFunctionCall) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if err := u.fn.CheckArity(len(children)); err != nil {
+		return nil, err
+	}
+	nu := *u
+	nu.ChildExpressions = children
+	return &nu, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (u *UserDefinedFunctionCall) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	args := make([]interface{}, len(u.ChildExpressions))
+	for i, child := range u.ChildExpressions {
+		val, err := child.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if args[i], err = sql.UnwrapAny(ctx, val); err != nil {
+			return nil, err
+		}
+	}
+	res, err := u.fn.Eval(ctx, args)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	res, _, err = u.Type(ctx).Convert(ctx, res)
+	return res, err
+}
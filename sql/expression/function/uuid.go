@@ -175,18 +175,12 @@ func (u IsUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	switch str := str.(type) {
 	case string:
-		_, err := uuid.Parse(str)
-		if err != nil {
-			return false, nil
-		}
-
-		return true, nil
+		_, ok := types.ParseUuid(str)
+		return ok, nil
 	case []byte:
-		_, err := uuid.ParseBytes(str)
-		if err != nil {
-			return false, nil
-		}
-
+		_, ok := types.ParseUuid(string(str))
+		return ok, nil
+	case uuid.UUID:
 		return true, nil
 	default:
 		return false, nil
@@ -301,9 +295,9 @@ func (ub *UUIDToBin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, fmt.Errorf("invalid data format passed to UUID_TO_BIN")
 	}
 
-	parsed, err := uuid.Parse(uuidAsStr)
-	if err != nil {
-		return nil, sql.ErrUuidUnableToParse.New(uuidAsStr, err.Error())
+	parsed, ok := types.ParseUuid(uuidAsStr)
+	if !ok {
+		return nil, sql.ErrUuidUnableToParse.New(uuidAsStr, "invalid UUID format")
 	}
 
 	// If no swap flag is passed we can return uuid's byte format as is.
@@ -455,20 +449,23 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	// Get the inputted uuid as a string.
-	converted, _, err := types.MustCreateBinary(query.Type_VARBINARY, int64(16)).Convert(ctx, str)
-	if err != nil {
-		return nil, err
-	}
-
-	asBytes, ok := converted.([]byte)
+	parsed, ok := str.(uuid.UUID)
 	if !ok {
-		return nil, fmt.Errorf("invalid data format passed to BIN_TO_UUID")
-	}
+		// Get the inputted uuid as a string.
+		converted, _, err := types.MustCreateBinary(query.Type_VARBINARY, int64(16)).Convert(ctx, str)
+		if err != nil {
+			return nil, err
+		}
 
-	parsed, err := uuid.FromBytes(asBytes)
-	if err != nil {
-		return nil, sql.ErrUuidUnableToParse.New(asBytes, err.Error())
+		asBytes, ok := converted.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("invalid data format passed to BIN_TO_UUID")
+		}
+
+		parsed, err = uuid.FromBytes(asBytes)
+		if err != nil {
+			return nil, sql.ErrUuidUnableToParse.New(asBytes, err.Error())
+		}
 	}
 
 	// If no swap flag is passed we can return uuid's string format as is.
@@ -487,7 +484,7 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// If the swap flag is 0 we can return uuid's string format as is.
-	if sf.(int8) == 0 {
+	if sf == nil || sf.(int8) == 0 {
 		return parsed.String(), nil
 	} else if sf.(int8) == 1 {
 		encoding := unswapUUIDBytes(parsed)
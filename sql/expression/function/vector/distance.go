@@ -149,6 +149,60 @@ func (d DistanceCosine) Description() string {
 	return "returns the cosine distance between two vectors"
 }
 
+type DistanceDot struct{}
+
+var _ fmt.Stringer = DistanceDot{}
+var _ DistanceType = DistanceDot{}
+
+func (d DistanceDot) String() string {
+	return "VEC_DISTANCE_DOT"
+}
+
+// Eval returns the negated dot product of the vectors, so that vectors pointing the same way are the closest.
+func (d DistanceDot) Eval(left []float32, right []float32) (float64, error) {
+	if len(left) != len(right) {
+		return 0, fmt.Errorf("attempting to find distance between vectors of different lengths: %d vs %d", len(left), len(right))
+	}
+	var dotProduct float64 = 0
+	for i, l := range left {
+		dotProduct += float64(l) * float64(right[i])
+	}
+	return -dotProduct, nil
+}
+
+func (d DistanceDot) CanEval(other DistanceType) bool {
+	return other == DistanceDot{}
+}
+
+func (d DistanceDot) FunctionName() string {
+	return "vec_distance_dot"
+}
+
+func (d DistanceDot) Description() string {
+	return "returns the negated dot product of two vectors"
+}
+
+// DistanceTypeFromName returns the DistanceType of a metric named by the DISTANCE function, such as COSINE, returning
+// false if there's no such metric.
+func DistanceTypeFromName(name string) (DistanceType, bool) {
+	switch strings.ToUpper(name) {
+	case "EUCLIDEAN":
+		return DistanceEuclidean{}, true
+	case "COSINE":
+		return DistanceCosine{}, true
+	case "L2_SQUARED":
+		return DistanceL2Squared{}, true
+	case "DOT":
+		return DistanceDot{}, true
+	}
+	return nil, false
+}
+
+// DistanceTypeName returns the name of |distanceType| accepted by DistanceTypeFromName.
+func DistanceTypeName(distanceType DistanceType) string {
+	return strings.ToUpper(strings.TrimPrefix(distanceType.FunctionName(), "vec_distance_"))
+}
+
 type Distance struct {
 	DistanceType DistanceType
 	expression.BinaryExpressionStub
@@ -189,6 +243,12 @@ func NewCosineDistance(ctx *sql.Context, left, right sql.Expression) sql.Express
 	return NewDistance(ctx, DistanceCosine{}, left, right)
 }
 
+var _ sql.CreateFunc2Args = NewDotDistance
+
+func NewDotDistance(ctx *sql.Context, left, right sql.Expression) sql.Expression {
+	return NewDistance(ctx, DistanceDot{}, left, right)
+}
+
 func (d Distance) CollationCoercibility(_ *sql.Context) (collation sql.CollationID, coercibility byte) {
 	return sql.Collation_binary, 5
 }
@@ -256,10 +316,19 @@ var _ sql.Expression = (*GenericDistance)(nil)
 var _ sql.FunctionExpression = (*GenericDistance)(nil)
 var _ sql.CollationCoercible = (*GenericDistance)(nil)
 
+// NewGenericDistance creates a new DISTANCE expression. A metric given as a string literal returns a Distance, which
+// vector indexes can order rows by.
 func NewGenericDistance(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
 	if len(args) != 3 {
 		return nil, sql.ErrInvalidArgumentNumber.New("DISTANCE", "3", len(args))
 	}
+	if lit, ok := args[2].(*expression.Literal); ok {
+		if metric, ok := lit.Value().(string); ok {
+			if distanceType, ok := DistanceTypeFromName(metric); ok {
+				return NewDistance(ctx, distanceType, args[0], args[1]), nil
+			}
+		}
+	}
 	return &GenericDistance{NaryExpression: expression.NaryExpression{ChildExpressions: args}}, nil
 }
 
@@ -268,7 +337,7 @@ func (g *GenericDistance) FunctionName() string {
 }
 
 func (g *GenericDistance) Description() string {
-	return "returns the distance between two vectors using the specified metric (EUCLIDEAN or COSINE)"
+	return "returns the distance between two vectors using the specified metric (EUCLIDEAN, L2_SQUARED, COSINE or DOT)"
 }
 
 func (g *GenericDistance) Type(ctx *sql.Context) sql.Type {
@@ -316,24 +385,17 @@ func (g *GenericDistance) Eval(ctx *sql.Context, row sql.Row) (interface{}, erro
 		return nil, err
 	}
 	if metricVal == nil {
-		return nil, fmt.Errorf(`DISTANCE must be "EUCLIDEAN", "L2_SQUARED", or "COSINE", got NULL`)
+		return nil, fmt.Errorf(`DISTANCE must be "EUCLIDEAN", "L2_SQUARED", "COSINE", or "DOT", got NULL`)
 	}
 
 	metricStr, ok := metricVal.(string)
 	if !ok {
-		return nil, fmt.Errorf(`DISTANCE must be "EUCLIDEAN", "L2_SQUARED", or "COSINE", got %T`, metricVal)
+		return nil, fmt.Errorf(`DISTANCE must be "EUCLIDEAN", "L2_SQUARED", "COSINE", or "DOT", got %T`, metricVal)
 	}
 
-	var distanceType DistanceType
-	switch strings.ToUpper(metricStr) {
-	case "EUCLIDEAN":
-		distanceType = DistanceEuclidean{}
-	case "COSINE":
-		distanceType = DistanceCosine{}
-	case "L2_SQUARED":
-		distanceType = DistanceL2Squared{}
-	default:
-		return nil, fmt.Errorf(`DISTANCE must be "EUCLIDEAN", "L2_SQUARED", or "COSINE", got %s`, metricStr)
+	distanceType, ok := DistanceTypeFromName(metricStr)
+	if !ok {
+		return nil, fmt.Errorf(`DISTANCE must be "EUCLIDEAN", "L2_SQUARED", "COSINE", or "DOT", got %s`, metricStr)
 	}
 
 	return MeasureDistance(ctx, lval, rval, distanceType)
@@ -36,3 +36,48 @@ func TestDistance(t *testing.T) {
 	assert.NoError(t, err)
 	assert.InEpsilon(t, 25.0, result, 0.1)
 }
+
+func TestDotDistance(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	distance := NewDotDistance(ctx, jsonExpression(t, "[1.0, 2.0]"), jsonExpression(t, "[3.0, 4.0]"))
+	result, err := distance.Eval(ctx, nil)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, -11.0, result, 0.1)
+}
+
+func TestGenericDistance(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	left, right := jsonExpression(t, "[0.0, 0.0]"), jsonExpression(t, "[3.0, 4.0]")
+	distance, err := NewGenericDistance(ctx, left, right, expression.NewLiteral("euclidean", types.LongText))
+	assert.NoError(t, err)
+	assert.Equal(t, NewEuclideanDistance(ctx, left, right), distance)
+	result, err := distance.Eval(ctx, nil)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, 5.0, result, 0.1)
+
+	distance, err = NewGenericDistance(ctx, left, right, expression.NewLiteral("manhattan", types.LongText))
+	assert.NoError(t, err)
+	_, err = distance.Eval(ctx, nil)
+	assert.Error(t, err)
+}
+
+func TestDistanceTypeFromEngineAttribute(t *testing.T) {
+	for attribute, expected := range map[string]DistanceType{
+		"":                          DistanceL2Squared{},
+		"{}":                        DistanceL2Squared{},
+		`{"distance": "cosine"}`:    DistanceCosine{},
+		`{"distance": "DOT"}`:       DistanceDot{},
+		`{"distance": "euclidean"}`: DistanceEuclidean{},
+	} {
+		distanceType, err := DistanceTypeFromEngineAttribute(attribute)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, distanceType)
+	}
+	for _, attribute := range []string{"cosine", `{"distance": "manhattan"}`} {
+		_, err := DistanceTypeFromEngineAttribute(attribute)
+		assert.Error(t, err)
+	}
+
+	assert.Equal(t, "", EngineAttributeForDistanceType(DistanceL2Squared{}))
+	assert.Equal(t, `{"distance": "cosine"}`, EngineAttributeForDistanceType(DistanceCosine{}))
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vector
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DefaultIndexDistanceType is the distance function that a VECTOR index orders rows by when its definition doesn't
+// name one.
+var DefaultIndexDistanceType DistanceType = DistanceL2Squared{}
+
+// IndexAlterableTable represents a table that supports the creation of VECTOR indexes ordering rows by any distance
+// function. Tables that only implement sql.IndexAlterableTable may only create VECTOR indexes that use
+// DefaultIndexDistanceType. Renaming and deleting the VECTOR index are both handled by RenameIndex and DropIndex
+// respectively.
+type IndexAlterableTable interface {
+	sql.IndexAlterableTable
+	// CreateVectorIndex creates a VECTOR index for this table, which answers approximate nearest neighbor lookups for
+	// |distanceType|. The lookups are given to the table's IndexedTable in sql.IndexLookup.VectorOrderAndLimit, whose
+	// OrderBy is a Distance between the indexed column and a constant vector. The rows of the lookup are returned in
+	// ascending order of that distance, and only the first rows up to the limit need to be returned.
+	CreateVectorIndex(ctx *sql.Context, indexDef sql.IndexDef, distanceType DistanceType) error
+}
+
+// Index is a VECTOR index that reports the distance function it orders rows by.
+type Index interface {
+	sql.Index
+	// VectorDistanceType returns the distance function the index orders rows by.
+	VectorDistanceType() DistanceType
+}
+
+// indexEngineAttribute is the ENGINE_ATTRIBUTE of a VECTOR index definition, such as '{"distance": "cosine"}'.
+type indexEngineAttribute struct {
+	Distance string `json:"distance"`
+}
+
+// DistanceTypeFromEngineAttribute returns the distance function named by the ENGINE_ATTRIBUTE of a VECTOR index
+// definition, or DefaultIndexDistanceType if it doesn't name one.
+func DistanceTypeFromEngineAttribute(attribute string) (DistanceType, error) {
+	if strings.TrimSpace(attribute) == "" {
+		return DefaultIndexDistanceType, nil
+	}
+	var attr indexEngineAttribute
+	if err := json.Unmarshal([]byte(attribute), &attr); err != nil {
+		return nil, fmt.Errorf("invalid ENGINE_ATTRIBUTE for a vector index: %s", err.Error())
+	}
+	if attr.Distance == "" {
+		return DefaultIndexDistanceType, nil
+	}
+	distanceType, ok := DistanceTypeFromName(attr.Distance)
+	if !ok {
+		return nil, fmt.Errorf(`vector index distance must be "EUCLIDEAN", "L2_SQUARED", "COSINE", or "DOT", got %s`, attr.Distance)
+	}
+	return distanceType, nil
+}
+
+// EngineAttributeForDistanceType returns the ENGINE_ATTRIBUTE of a VECTOR index definition using |distanceType|, which
+// is empty for DefaultIndexDistanceType.
+func EngineAttributeForDistanceType(distanceType DistanceType) string {
+	if distanceType == nil || distanceType.CanEval(DefaultIndexDistanceType) {
+		return ""
+	}
+	return fmt.Sprintf(`{"distance": "%s"}`, strings.ToLower(DistanceTypeName(distanceType)))
+}
+
+// CreateIndex creates the VECTOR index |indexDef| on |table|, ordering rows by the distance function named by the
+// index's VectorDistance.
+func CreateIndex(ctx *sql.Context, table sql.IndexAlterableTable, indexDef sql.IndexDef) error {
+	distanceType := DefaultIndexDistanceType
+	if indexDef.VectorDistance != "" {
+		var ok bool
+		distanceType, ok = DistanceTypeFromName(indexDef.VectorDistance)
+		if !ok {
+			return fmt.Errorf(`vector index distance must be "EUCLIDEAN", "L2_SQUARED", "COSINE", or "DOT", got %s`, indexDef.VectorDistance)
+		}
+	}
+	if vectorTable, ok := table.(IndexAlterableTable); ok {
+		return vectorTable.CreateVectorIndex(ctx, indexDef, distanceType)
+	}
+	if !distanceType.CanEval(DefaultIndexDistanceType) {
+		return sql.ErrUnsupportedFeature.New(fmt.Sprintf("vector indexes using %s distance on table %s", DistanceTypeName(distanceType), table.Name()))
+	}
+	return table.CreateIndex(ctx, indexDef)
+}
@@ -149,6 +149,14 @@ func (p *GetField) EvalValue(ctx *sql.Context, row sql.ValueRow) (sql.Value, err
 	return row[p.fieldIndex], nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (p *GetField) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	if p.fieldIndex < 0 || p.fieldIndex >= len(batch.Columns) {
+		return nil, ErrIndexOutOfBounds.New(p.fieldIndex, len(batch.Columns))
+	}
+	return batch.Columns[p.fieldIndex], nil
+}
+
 // IsValueRowIter implements the ValueExpression interface.
 func (p *GetField) IsValueExpression(ctx *sql.Context) bool {
 	return true
@@ -236,6 +236,34 @@ func (td TimeDelta) Sub(t time.Time) time.Time {
 	return td.apply(t, -1)
 }
 
+// AddParts returns the date |d| plus the time delta, or false if |d| has a zero month or day.
+func (td TimeDelta) AddParts(d types.DateParts) (time.Time, bool) {
+	return td.applyParts(d, 1)
+}
+
+// SubParts returns the date |d| minus the time delta, or false if |d| has a zero month or day.
+func (td TimeDelta) SubParts(d types.DateParts) (time.Time, bool) {
+	return td.applyParts(d, -1)
+}
+
+// applyParts applies the time delta to the date |d|, using the specified sign, as MySQL does for the dates that a
+// time.Time can't hold. A day past the end of its month is kept through the years and months of the delta, so that
+// '2020-02-31' minus a month is '2020-01-31', and the rest of the delta is applied to the time that |d| is read as.
+func (td TimeDelta) applyParts(d types.DateParts, sign int64) (time.Time, bool) {
+	t, ok := d.Time()
+	if !ok {
+		return time.Time{}, false
+	}
+	if td.Years != 0 || td.Months != 0 {
+		first := time.Date(d.Year, time.Month(d.Month), 1, 0, 0, 0, 0, time.UTC)
+		first = TimeDelta{Years: td.Years, Months: td.Months}.apply(first, sign)
+		day := min(d.Day, daysInMonth(first.Year(), first.Month()))
+		t = time.Date(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+		td.Years, td.Months = 0, 0
+	}
+	return td.apply(t, sign), true
+}
+
 const (
 	day  = 24 * time.Hour
 	week = 7 * day
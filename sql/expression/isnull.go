@@ -63,6 +63,19 @@ func (e *IsNull) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return v == nil, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (e *IsNull) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	vals, err := sql.EvalBatch(ctx, e.Child, batch)
+	if err != nil {
+		return nil, err
+	}
+	res := make(sql.ColumnVector, batch.Len)
+	for _, i := range batch.Selected() {
+		res[i] = vals[i] == nil
+	}
+	return res, nil
+}
+
 func (e IsNull) String() string {
 	return e.Child.String() + " IS NULL"
 }
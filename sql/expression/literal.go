@@ -42,6 +42,13 @@ var _ sqlparser.Injectable = &Literal{}
 // NewLiteral creates a new Literal expression.
 func NewLiteral(value interface{}, fieldType sql.Type) *Literal {
 	val2, _ := sql.ConvertToValue(value)
+	// MEDIUMINT values are encoded as 32-bit integers, so only the type of the value differs
+	if fieldType != nil {
+		switch typ := fieldType.Type(); {
+		case typ == query.Type_INT24 && val2.Typ == query.Type_INT32, typ == query.Type_UINT24 && val2.Typ == query.Type_UINT32:
+			val2.Typ = typ
+		}
+	}
 	return &Literal{
 		Val:  value,
 		val2: val2,
@@ -156,9 +163,21 @@ func (lit *Literal) EvalValue(ctx *sql.Context, row sql.ValueRow) (sql.Value, er
 	return lit.val2, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (lit *Literal) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	res := make(sql.ColumnVector, batch.Len)
+	if lit.Val != nil {
+		for _, i := range batch.Selected() {
+			res[i] = lit.Val
+		}
+	}
+	return res, nil
+}
+
 // IsValueExpression implements the ValueExpression interface.
 func (lit *Literal) IsValueExpression(ctx *sql.Context) bool {
-	return types.IsInteger(lit.Typ)
+	// the value is read with the literal's type when it's returned in a result, so it must be encoded with that type
+	return types.IsInteger(lit.Typ) && lit.val2.Typ == lit.Typ.Type()
 }
 
 // Value returns the literal value.
@@ -193,6 +193,48 @@ func (a *And) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return true, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (a *And) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	left, err := sql.EvalBatch(ctx, a.LeftChild, batch)
+	if err != nil {
+		return nil, err
+	}
+	res := make(sql.ColumnVector, batch.Len)
+	// like Eval, the right child is only evaluated for the rows where the left child isn't false
+	var sel []int
+	for _, i := range batch.Selected() {
+		if left[i] != nil {
+			lvalBool, err := sql.ConvertToBool(ctx, left[i])
+			if err == nil && lvalBool == false {
+				res[i] = false
+				continue
+			}
+		}
+		sel = append(sel, i)
+	}
+	if len(sel) == 0 {
+		return res, nil
+	}
+
+	right, err := sql.EvalBatch(ctx, a.RightChild, batch.WithSelected(sel))
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range sel {
+		if right[i] != nil {
+			rvalBool, err := sql.ConvertToBool(ctx, right[i])
+			if err == nil && rvalBool == false {
+				res[i] = false
+				continue
+			}
+		}
+		if left[i] != nil && right[i] != nil {
+			res[i] = true
+		}
+	}
+	return res, nil
+}
+
 // WithChildren implements the Expression interface.
 func (a *And) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -288,6 +330,48 @@ func (o *Or) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return false, nil
 }
 
+// EvalBatch implements the sql.BatchExpression interface.
+func (o *Or) EvalBatch(ctx *sql.Context, batch *sql.RowBatch) (sql.ColumnVector, error) {
+	left, err := sql.EvalBatch(ctx, o.LeftChild, batch)
+	if err != nil {
+		return nil, err
+	}
+	res := make(sql.ColumnVector, batch.Len)
+	// like Eval, the right child is only evaluated for the rows where the left child isn't true
+	var sel []int
+	for _, i := range batch.Selected() {
+		if left[i] != nil {
+			lvalBool, err := sql.ConvertToBool(ctx, left[i])
+			if err == nil && lvalBool {
+				res[i] = true
+				continue
+			}
+		}
+		sel = append(sel, i)
+	}
+	if len(sel) == 0 {
+		return res, nil
+	}
+
+	right, err := sql.EvalBatch(ctx, o.RightChild, batch.WithSelected(sel))
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range sel {
+		if right[i] != nil {
+			rvalBool, err := sql.ConvertToBool(ctx, right[i])
+			if err == nil && rvalBool {
+				res[i] = true
+				continue
+			}
+		}
+		if left[i] != nil && right[i] != nil {
+			res[i] = false
+		}
+	}
+	return res, nil
+}
+
 // WithChildren implements the Expression interface.
 func (o *Or) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
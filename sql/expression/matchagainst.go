@@ -55,6 +55,13 @@ type MatchAgainst struct {
 	tableColOffsetOnce sync.Once
 	once               sync.Once
 	SearchModifier     fulltext.SearchModifier
+
+	// booleanTerms, columnPositions, collation, stopwords, and globalCounts are used by the boolean search mode
+	booleanTerms    []fulltext.BooleanTerm
+	columnPositions []int
+	collation       sql.CollationID
+	stopwords       fulltext.Stopwords
+	globalCounts    map[uint64]uint64
 }
 
 var _ sql.Expression = (*MatchAgainst)(nil)
@@ -249,6 +256,70 @@ func (expr *MatchAgainst) ColumnsAsGetFields() []*GetField {
 	return fields
 }
 
+// setup performs the one-time setup of the expression: evaluating the search string, caching the indexes of the
+// Full-Text tables, and preparing the search string for the search mode.
+func (expr *MatchAgainst) setup(ctx *sql.Context) error {
+	// Evaluate the expression, which should always result in a string literal
+	words, err := expr.Expr.Eval(ctx, nil)
+	if err != nil {
+		return err
+	}
+	wordsStr, ok := words.(string)
+	if !ok && words != nil {
+		return fmt.Errorf("expected WORD to be a string, but had type `%T`", words)
+	}
+	expr.evaluatedString = wordsStr
+	// Grab the indexes for the doc count, global count, and row count tables
+	if expr.docCountIndex, err = fulltextTablePrimaryKey(ctx, expr.DocCountTable); err != nil {
+		return err
+	}
+	if expr.globalCountIndex, err = fulltextTablePrimaryKey(ctx, expr.GlobalCountTable); err != nil {
+		return err
+	}
+	if expr.rowCountIndex, err = fulltextTablePrimaryKey(ctx, expr.RowCountTable); err != nil {
+		return err
+	}
+	collation := fulltext.GetCollationFromSchema(ctx, expr.DocCountTable.Schema(ctx))
+	// Create the parser now since it does a lot of preprocessing. We'll reset the iterators every call.
+	if expr.SearchModifier == fulltext.SearchModifier_Boolean {
+		if expr.booleanTerms, err = fulltext.ParseBooleanQuery(ctx, collation, wordsStr); err != nil {
+			return err
+		}
+		// Boolean mode parses the indexed columns of each row, so we need their positions within the parent table
+		fields := expr.ColumnsAsGetFields()
+		if fields == nil {
+			return fmt.Errorf("MATCH ... AGAINST ... expects its columns to be resolved")
+		}
+		parentSch := expr.ParentTable.Schema(ctx)
+		expr.columnPositions = make([]int, len(fields))
+		for i, field := range fields {
+			if expr.columnPositions[i] = parentSch.IndexOfColName(field.Name()); expr.columnPositions[i] < 0 {
+				return fmt.Errorf("column `%s` not found on the table `%s`", field.Name(), expr.ParentTable.Name())
+			}
+		}
+		expr.collation = collation
+		expr.stopwords = fulltext.SearchStopwords(ctx)
+		expr.globalCounts = make(map[uint64]uint64)
+	} else if expr.parser, err = fulltext.NewSearchParser(ctx, collation, wordsStr); err != nil {
+		return err
+	}
+	// Load the number of rows from the parent table, since it's used in the relevancy calculation
+	expr.parentRowCount, _, err = expr.ParentTable.(sql.StatisticsTable).RowCount(ctx)
+	return err
+}
+
+// fulltextTablePrimaryKey returns the primary key of the given Full-Text table, which should be its only index.
+func fulltextTablePrimaryKey(ctx *sql.Context, table sql.IndexAddressableTable) (sql.Index, error) {
+	indexes, err := table.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) != 1 || indexes[0].ID() != "PRIMARY" {
+		return nil, fmt.Errorf("expected to find a primary key on the table `%s`", table.Name())
+	}
+	return indexes[0], nil
+}
+
 // inNaturalLanguageMode calculates the relevancy using "IN NATURAL LANGUAGE MODE" (default mode). The returned float
 // value is the relevancy. When used under a FILTER node, a non-zero result is interpreted as "true", while a zero result
 // is interpreted as false. It is assumed that incoming rows will exactly match the schema of the parent table, meaning
@@ -266,61 +337,7 @@ func (expr *MatchAgainst) inNaturalLanguageMode(ctx *sql.Context, row sql.Row) (
 	// 6) Return the sum of all relevancy calculations.
 	var err error
 	expr.once.Do(func() {
-		// Evaluate the expression, which should always result in a string literal
-		words, nErr := expr.Expr.Eval(ctx, nil)
-		if nErr != nil {
-			err = nErr
-			return
-		}
-		wordsStr, ok := words.(string)
-		if !ok {
-			if words != nil {
-				err = fmt.Errorf("expected WORD to be a string, but had type `%T`", words)
-			}
-		}
-		expr.evaluatedString = wordsStr
-		// Grab the index for the doc count table
-		docCountIndexes, nErr := expr.DocCountTable.GetIndexes(ctx)
-		if nErr != nil {
-			err = nErr
-			return
-		}
-		if len(docCountIndexes) != 1 || docCountIndexes[0].ID() != "PRIMARY" {
-			err = fmt.Errorf("expected to find a primary key on the table `%s`", expr.DocCountTable.Name())
-		}
-		expr.docCountIndex = docCountIndexes[0]
-		// Grab the index for the global count table
-		globalCountIndexes, nErr := expr.GlobalCountTable.GetIndexes(ctx)
-		if nErr != nil {
-			err = nErr
-			return
-		}
-		if len(globalCountIndexes) != 1 || globalCountIndexes[0].ID() != "PRIMARY" {
-			err = fmt.Errorf("expected to find a primary key on the table `%s`", expr.GlobalCountTable.Name())
-		}
-		expr.globalCountIndex = globalCountIndexes[0]
-		// Grab the index for the row count table
-		rowCountIndexes, nErr := expr.RowCountTable.GetIndexes(ctx)
-		if nErr != nil {
-			err = nErr
-			return
-		}
-		if len(rowCountIndexes) != 1 || rowCountIndexes[0].ID() != "PRIMARY" {
-			err = fmt.Errorf("expected to find a primary key on the table `%s`", expr.RowCountTable.Name())
-		}
-		expr.rowCountIndex = rowCountIndexes[0]
-		// Create the parser now since it does a lot of preprocessing. We'll reset the iterators every call.
-		expr.parser, nErr = fulltext.NewDefaultParser(ctx, fulltext.GetCollationFromSchema(ctx, expr.DocCountTable.Schema(ctx)), wordsStr)
-		if nErr != nil {
-			err = nErr
-			return
-		}
-		// Load the number of rows from the parent table, since it's used in the relevancy calculation
-		expr.parentRowCount, _, nErr = expr.ParentTable.(sql.StatisticsTable).RowCount(ctx)
-		if nErr != nil {
-			err = nErr
-			return
-		}
+		err = expr.setup(ctx)
 	})
 	if err != nil {
 		return 0, err
@@ -382,30 +399,13 @@ func (expr *MatchAgainst) inNaturalLanguageMode(ctx *sql.Context, row sql.Row) (
 		}
 
 		// Otherwise, we've found a match, so we'll grab the global count as well
-		lookup = sql.IndexLookup{Ranges: sql.MySQLRangeCollection{
-			{
-				sql.ClosedRangeColumnExpr(wordStr, wordStr, expr.GlobalCountTable.Schema(ctx)[0].Type),
-			},
-		}, Index: expr.globalCountIndex}
-		editorData = expr.GlobalCountTable.IndexedAccess(ctx, lookup)
-		if err != nil {
-			return 0, err
-		}
-
-		partIter, err = editorData.LookupPartitions(ctx, lookup)
-		if err != nil {
-			return 0, err
-		}
-		globalCountRows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, editorData, partIter))
+		globalCount, ok, err := expr.lookupGlobalCount(ctx, wordStr)
 		if err != nil {
 			return 0, err
 		}
-		if len(globalCountRows) == 0 {
+		if !ok {
 			continue
-		} else if len(globalCountRows) > 1 {
-			return 0, fmt.Errorf("somehow there are duplicate entries within the Full-Text global count table")
 		}
-		globalCountRow := globalCountRows[0]
 
 		// Lastly, grab the number of unique words within this row from the row count
 		lookup = sql.IndexLookup{Ranges: sql.MySQLRangeCollection{
@@ -433,14 +433,8 @@ func (expr *MatchAgainst) inNaturalLanguageMode(ctx *sql.Context, row sql.Row) (
 		}
 		rowCountRow := rowCountRows[0]
 
-		// Calculate the relevancy (partially based on an old MySQL implementation)
-		// https://web.archive.org/web/20220122170304/http://dev.mysql.com/doc/internals/en/full-text-search.html
-		globalCount := float64(globalCountRow[len(globalCountRow)-1].(uint64))
 		uniqueWords := float64(rowCountRow[2].(uint64))
-		base := math.Log(docCount) + 1
-		normFactor := uniqueWords / (1 + 0.115*uniqueWords)
-		globalMult := math.Log(float64(expr.parentRowCount)/globalCount) + 1
-		accumulatedRelevancy += float32(base * normFactor * globalMult)
+		accumulatedRelevancy += expr.relevancy(docCount, uniqueWords, float64(globalCount))
 	}
 	if err != nil {
 		return 0, err
@@ -458,9 +452,238 @@ func (expr *MatchAgainst) inNaturalLanguageModeWithQueryExpansion(ctx *sql.Conte
 	return 0, fmt.Errorf("'IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION' has not yet been implemented")
 }
 
-// inBooleanMode calculates the result using "IN BOOLEAN MODE".
+// inBooleanMode calculates the result using "IN BOOLEAN MODE". Rather than looking up each word in the doc count table,
+// the indexed columns of the row are parsed, since truncated words and phrases cannot be found through the word alone.
+// The global counts of the matched words are still read from the index, so that relevancy is calculated the same as in
+// natural language mode. As with natural language mode, a row that matches always has a relevancy greater than zero.
 func (expr *MatchAgainst) inBooleanMode(ctx *sql.Context, row sql.Row) (float32, error) {
-	return 0, fmt.Errorf("'IN BOOLEAN MODE' has not yet been implemented")
+	var err error
+	expr.once.Do(func() {
+		err = expr.setup(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	colVals := make([]interface{}, len(expr.columnPositions))
+	for i, pos := range expr.columnPositions {
+		colVals[i] = row[pos]
+	}
+	parser, err := fulltext.NewDefaultParser(ctx, expr.collation, colVals...)
+	if err != nil {
+		return 0, err
+	}
+	doc := booleanDocument{parser: parser, uniqueWords: float64(parser.UniqueWordCount(ctx))}
+	word, _, reachedTheEnd, err := parser.Next(ctx)
+	for ; err == nil && !reachedTheEnd; word, _, reachedTheEnd, err = parser.Next(ctx) {
+		// Phrases skip stopwords, so the words that they're matched against must skip them as well
+		if !expr.stopwords.Contains(word) {
+			doc.words = append(doc.words, word)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	matched, relevancy, err := expr.matchBooleanTerms(ctx, expr.booleanTerms, &doc)
+	if err != nil || !matched {
+		return 0, err
+	}
+	// Negated words may lower the relevancy, but they never cause a matching row to not match
+	if relevancy < 0 {
+		relevancy = 0
+	}
+	// Due to how we handle floating to bool conversion, we need to add 0.5 to a match
+	return relevancy + 0.5, nil
+}
+
+// booleanDocument is the parsed indexed columns of a row, which the terms of a boolean mode search are matched against.
+type booleanDocument struct {
+	parser fulltext.DefaultParser
+	// words are the words of the document in order, which is used to match phrases
+	words       []string
+	uniqueWords float64
+}
+
+// matchBooleanTerms returns whether the given terms match the document, along with their relevancy. Excluded terms
+// must not match and required terms must match. When there are no required terms, then at least one optional term must
+// match.
+func (expr *MatchAgainst) matchBooleanTerms(ctx *sql.Context, terms []fulltext.BooleanTerm, doc *booleanDocument) (bool, float32, error) {
+	hasRequired := false
+	hasOptionalMatch := false
+	relevancy := float32(0)
+	for _, term := range terms {
+		matched, termRelevancy, err := expr.matchBooleanTerm(ctx, term, doc)
+		if err != nil {
+			return false, 0, err
+		}
+		switch term.Operator {
+		case fulltext.BooleanOperator_Exclude:
+			if matched {
+				return false, 0, nil
+			}
+		case fulltext.BooleanOperator_Require:
+			if !matched {
+				return false, 0, nil
+			}
+			hasRequired = true
+			relevancy += termRelevancy
+		case fulltext.BooleanOperator_Negate:
+			if matched {
+				relevancy -= termRelevancy
+			}
+		case fulltext.BooleanOperator_Increase:
+			if matched {
+				hasOptionalMatch = true
+				relevancy += termRelevancy * 1.5
+			}
+		case fulltext.BooleanOperator_Decrease:
+			if matched {
+				hasOptionalMatch = true
+				relevancy += termRelevancy * 0.5
+			}
+		default:
+			if matched {
+				hasOptionalMatch = true
+				relevancy += termRelevancy
+			}
+		}
+	}
+	return hasRequired || hasOptionalMatch, relevancy, nil
+}
+
+// matchBooleanTerm returns whether the given term matches the document, along with its relevancy.
+func (expr *MatchAgainst) matchBooleanTerm(ctx *sql.Context, term fulltext.BooleanTerm, doc *booleanDocument) (bool, float32, error) {
+	switch {
+	case len(term.Group) > 0:
+		return expr.matchBooleanTerms(ctx, term.Group, doc)
+	case term.Phrase:
+		if !expr.containsPhrase(doc.words, term.Words) {
+			return false, 0, nil
+		}
+		relevancy := float32(0)
+		for _, word := range term.Words {
+			wordRelevancy, err := expr.wordRelevancy(ctx, word, doc)
+			if err != nil {
+				return false, 0, err
+			}
+			relevancy += wordRelevancy
+		}
+		return true, relevancy, nil
+	case term.Prefix:
+		prefix := []rune(term.Words[0])
+		matched := false
+		relevancy := float32(0)
+		doc.parser.Reset()
+		word, reachedTheEnd, err := doc.parser.NextUnique(ctx)
+		for ; err == nil && !reachedTheEnd; word, reachedTheEnd, err = doc.parser.NextUnique(ctx) {
+			if runes := []rune(word); len(runes) < len(prefix) || !expr.wordsEqual(string(runes[:len(prefix)]), string(prefix)) {
+				continue
+			}
+			wordRelevancy, err := expr.wordRelevancy(ctx, word, doc)
+			if err != nil {
+				return false, 0, err
+			}
+			matched = true
+			relevancy += wordRelevancy
+		}
+		return matched, relevancy, err
+	default:
+		count, err := doc.parser.DocumentCount(ctx, term.Words[0])
+		if err != nil || count == 0 {
+			return false, 0, err
+		}
+		relevancy, err := expr.wordRelevancy(ctx, term.Words[0], doc)
+		return true, relevancy, err
+	}
+}
+
+// containsPhrase returns whether the given phrase appears within the words of a document.
+func (expr *MatchAgainst) containsPhrase(words []string, phrase []string) bool {
+	for start := 0; start+len(phrase) <= len(words); start++ {
+		found := true
+		for i := range phrase {
+			if !expr.wordsEqual(words[start+i], phrase[i]) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// wordsEqual returns whether the two words are equal under the collation of the index.
+func (expr *MatchAgainst) wordsEqual(left string, right string) bool {
+	leftHash, err := expr.collation.HashToUint(left)
+	if err != nil {
+		return false
+	}
+	rightHash, err := expr.collation.HashToUint(right)
+	return err == nil && leftHash == rightHash
+}
+
+// wordRelevancy returns the relevancy of a word that is contained within the document.
+func (expr *MatchAgainst) wordRelevancy(ctx *sql.Context, word string, doc *booleanDocument) (float32, error) {
+	docCount, err := doc.parser.DocumentCount(ctx, word)
+	if err != nil || docCount == 0 {
+		return 0, err
+	}
+	hash, err := expr.collation.HashToUint(word)
+	if err != nil {
+		return 0, err
+	}
+	globalCount, ok := expr.globalCounts[hash]
+	if !ok {
+		if globalCount, _, err = expr.lookupGlobalCount(ctx, word); err != nil {
+			return 0, err
+		}
+		expr.globalCounts[hash] = globalCount
+	}
+	// The row contains the word, so it's in at least one row, even if the index has yet to see the row
+	if globalCount == 0 {
+		globalCount = 1
+	}
+	return expr.relevancy(float64(docCount), doc.uniqueWords, float64(globalCount)), nil
+}
+
+// lookupGlobalCount returns the number of rows that contain the given word, according to the global count table.
+// Returns false if the word is not in the table.
+func (expr *MatchAgainst) lookupGlobalCount(ctx *sql.Context, word string) (uint64, bool, error) {
+	lookup := sql.IndexLookup{Ranges: sql.MySQLRangeCollection{
+		{
+			sql.ClosedRangeColumnExpr(word, word, expr.GlobalCountTable.Schema(ctx)[0].Type),
+		},
+	}, Index: expr.globalCountIndex}
+	editorData := expr.GlobalCountTable.IndexedAccess(ctx, lookup)
+	partIter, err := editorData.LookupPartitions(ctx, lookup)
+	if err != nil {
+		return 0, false, err
+	}
+	globalCountRows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, editorData, partIter))
+	if err != nil {
+		return 0, false, err
+	}
+	if len(globalCountRows) == 0 {
+		return 0, false, nil
+	} else if len(globalCountRows) > 1 {
+		return 0, false, fmt.Errorf("somehow there are duplicate entries within the Full-Text global count table")
+	}
+	globalCountRow := globalCountRows[0]
+	return globalCountRow[len(globalCountRow)-1].(uint64), true, nil
+}
+
+// relevancy calculates the relevancy of a word from the number of times it appears within a row, the number of unique
+// words within that row, and the number of rows that contain the word.
+func (expr *MatchAgainst) relevancy(docCount float64, uniqueWords float64, globalCount float64) float32 {
+	// Calculate the relevancy (partially based on an old MySQL implementation)
+	// https://web.archive.org/web/20220122170304/http://dev.mysql.com/doc/internals/en/full-text-search.html
+	base := math.Log(docCount) + 1
+	normFactor := uniqueWords / (1 + 0.115*uniqueWords)
+	globalMult := math.Log(float64(expr.parentRowCount)/globalCount) + 1
+	return float32(base * normFactor * globalMult)
 }
 
 // withQueryExpansion calculates the result using "WITH QUERY EXPANSION".
@@ -17,6 +17,7 @@ package expression
 import (
 	"fmt"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -72,7 +73,19 @@ func (s *SetField) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 	if val != nil {
-		convertedVal, _, err := getField.fieldType.Convert(ctx, val)
+		convertedVal, inRange, err := getField.fieldType.Convert(ctx, val)
+		if err == nil && inRange != sql.InRange && types.IsNumber(getField.fieldType) {
+			// Outside of strict mode, values out of the range of a numeric column are clamped to the nearest bound
+			if sql.LoadSqlMode(ctx).Strict() {
+				return nil, sql.NewWrappedTypeConversionError(val, getField.fieldIndex, sql.ErrValueOutOfRange.New(val, getField.fieldType))
+			}
+			convertedVal = types.ClampToRange(getField.fieldType, convertedVal, inRange)
+			ctx.Warn(mysql.ERWarnDataOutOfRange, "%s", sql.ErrValueOutOfRangeForColumn.New(getField.Name(), types.RowNumber(ctx)).Error())
+		}
+		if types.IsTime(getField.fieldType) {
+			strict := sql.LoadSqlMode(ctx).Strict()
+			convertedVal, err = types.ConvertDateForSqlMode(ctx, getField.fieldType, val, convertedVal, err, strict, getField.Name(), types.RowNumber(ctx))
+		}
 		if err != nil {
 			// Fill in error with information
 			if types.ErrLengthBeyondLimit.Is(err) {
@@ -0,0 +1,167 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var _ sql.TableFunction = (*UserDefinedTableFunction)(nil)
+var _ sql.ExecSourceRel = (*UserDefinedTableFunction)(nil)
+var _ sql.CollationCoercible = (*UserDefinedTableFunction)(nil)
+
+// UserDefinedTableFunction is a call to a sql.UserDefinedTableFunction registered by an integrator.
+type UserDefinedTableFunction struct {
+	fn       *sql.UserDefinedTableFunction
+	database sql.Database
+	args     []sql.Expression
+	schema   sql.Schema
+}
+
+// NewUserDefinedTableFunction returns the sql.TableFunction for the user-defined table function given.
+func NewUserDefinedTableFunction(fn sql.UserDefinedTableFunction) sql.TableFunction {
+	return &UserDefinedTableFunction{fn: &fn}
+}
+
+// NewInstance implements the sql.TableFunction interface.
+func (u *UserDefinedTableFunction) NewInstance(ctx *sql.Context, db sql.Database, args []sql.Expression) (sql.Node, error) {
+	if err := u.fn.CheckArity(len(args)); err != nil {
+		return nil, err
+	}
+	schema, err := u.fn.Schema(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(schema) == 0 {
+		return nil, sql.ErrInvalidUserDefinedFunction.New(u.fn.Name, "a table function must return at least one column")
+	}
+
+	nu := *u
+	nu.database = db
+	nu.args = args
+	nu.schema = make(sql.Schema, len(schema))
+	for i, col := range schema {
+		c := col.Copy()
+		c.Source = nu.Name()
+		if db != nil {
+			c.DatabaseSource = db.Name()
+		}
+		nu.schema[i] = c
+	}
+	return &nu, nil
+}
+
+// Name implements the sql.Nameable interface.
+func (u *UserDefinedTableFunction) Name() string {
+	return strings.ToLower(u.fn.Name)
+}
+
+// Description returns the comment of the function.
+func (u *UserDefinedTableFunction) Description() string {
+	return u.fn.Comment
+}
+
+// Database implements the sql.Databaser interface.
+func (u *UserDefinedTableFunction) Database() sql.Database {
+	return u.database
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (u *UserDefinedTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nu := *u
+	nu.database = database
+	return &nu, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (u *UserDefinedTableFunction) Resolved() bool {
+	for _, arg := range u.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (u *UserDefinedTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// Schema implements the sql.Node interface.
+func (u *UserDefinedTableFunction) Schema(ctx *sql.Context) sql.Schema {
+	return u.schema
+}
+
+// Children implements the sql.Node interface.
+func (u *UserDefinedTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (u *UserDefinedTableFunction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 0)
+	}
+	return u, nil
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (u *UserDefinedTableFunction) Expressions() []sql.Expression {
+	return u.args
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (u *UserDefinedTableFunction) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(u.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(exprs), len(u.args))
+	}
+	nu := *u
+	nu.args = exprs
+	return &nu, nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (u *UserDefinedTableFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.ExecSourceRel interface. The arguments are evaluated against |row|, which holds the
+// columns of the tables and outer scopes the function may refer to.
+func (u *UserDefinedTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	args := make([]interface{}, len(u.args))
+	for i, arg := range u.args {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if args[i], err = sql.UnwrapAny(ctx, v); err != nil {
+			return nil, err
+		}
+	}
+	return u.fn.RowIter(ctx, args)
+}
+
+// String implements the sql.Node interface.
+func (u *UserDefinedTableFunction) String() string {
+	args := make([]string, len(u.args))
+	for i, arg := range u.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", u.Name(), strings.Join(args, ", "))
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileSystem is the file system of the server, which is read by LOAD DATA INFILE and LOAD_FILE(), and written by
+// SELECT ... INTO OUTFILE and SELECT ... INTO DUMPFILE. Integrators may set their own file system in the Services of a
+// Context to keep these statements away from the disk of the host, or to give them files that don't live on a disk at
+// all. When no file system is set, the file system of the host is used.
+type FileSystem interface {
+	// Open opens the file |name| for reading. It returns an error wrapping fs.ErrNotExist if there is no such file.
+	Open(ctx *Context, name string) (io.ReadCloser, error)
+	// Create creates the file |name| for writing. It returns ErrFileExists if the file already exists.
+	Create(ctx *Context, name string) (io.WriteCloser, error)
+	// Resolve returns the absolute path of |name|, which is used to check whether the file is in the directory given
+	// by the secure_file_priv system variable. Any links in the path must be followed, so that a link in the directory
+	// can't be used to reach files outside of it.
+	Resolve(ctx *Context, name string) (string, error)
+}
+
+// OSFileSystem is the FileSystem of the host.
+type OSFileSystem struct{}
+
+var _ FileSystem = OSFileSystem{}
+
+// Open implements the FileSystem interface.
+func (OSFileSystem) Open(ctx *Context, name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+// Create implements the FileSystem interface.
+func (OSFileSystem) Create(ctx *Context, name string) (io.WriteCloser, error) {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+	if errors.Is(err, fs.ErrExist) {
+		return nil, ErrFileExists.New(name)
+	}
+	return file, err
+}
+
+// Resolve implements the FileSystem interface.
+func (OSFileSystem) Resolve(ctx *Context, name string) (string, error) {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return "", err
+	}
+	// the file itself may not exist yet, but the directory it's created in must
+	dir, err := filepath.EvalSymlinks(filepath.Dir(abs))
+	if err != nil {
+		return "", err
+	}
+	base := filepath.Base(abs)
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		return resolved, nil
+	}
+	return filepath.Join(dir, base), nil
+}
+
+// FileSystem returns the file system of the server, which is the one given in the Services of the context, or the
+// file system of the host if there is none.
+func (c *Context) FileSystem() FileSystem {
+	if c == nil || c.services.FileSystem == nil {
+		return OSFileSystem{}
+	}
+	return c.services.FileSystem
+}
+
+// OpenSecureFile opens the file |name| of the server's file system for reading, if the secure_file_priv system
+// variable allows it.
+func OpenSecureFile(ctx *Context, name string) (io.ReadCloser, error) {
+	if err := checkSecureFilePriv(ctx, name); err != nil {
+		return nil, err
+	}
+	return ctx.FileSystem().Open(ctx, name)
+}
+
+// CreateSecureFile creates the file |name| on the server's file system for writing, if the secure_file_priv system
+// variable allows it. It returns ErrFileExists if the file already exists.
+func CreateSecureFile(ctx *Context, name string) (io.WriteCloser, error) {
+	if err := checkSecureFilePriv(ctx, name); err != nil {
+		return nil, err
+	}
+	return ctx.FileSystem().Create(ctx, name)
+}
+
+// checkSecureFilePriv returns ErrSecureFilePriv if the file |name| may not be read or written by statements. When
+// secure_file_priv is empty, any file may be, and when it's NULL, none may be. Otherwise, the file must be in the
+// directory it names, or in one of its subdirectories.
+func checkSecureFilePriv(ctx *Context, name string) error {
+	_, secureFileDir, ok := SystemVariables.GetGlobal("secure_file_priv")
+	if !ok {
+		return ErrUnknownSystemVariable.New("secure_file_priv")
+	}
+	if secureFileDir == nil {
+		return ErrSecureFilePriv.New()
+	}
+	dir, _ := secureFileDir.(string)
+	if dir == "" {
+		return nil
+	}
+
+	fileSystem := ctx.FileSystem()
+	dir, err := fileSystem.Resolve(ctx, dir)
+	if err != nil {
+		return err
+	}
+	path, err := fileSystem.Resolve(ctx, name)
+	if err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ErrSecureFilePriv.New()
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// BooleanOperator is the operator that precedes a term of a query in boolean mode.
+type BooleanOperator byte
+
+const (
+	// BooleanOperator_None marks an optional term, which contributes to the relevancy when it matches.
+	BooleanOperator_None BooleanOperator = iota
+	// BooleanOperator_Require (+) marks a term that must match.
+	BooleanOperator_Require
+	// BooleanOperator_Exclude (-) marks a term that must not match.
+	BooleanOperator_Exclude
+	// BooleanOperator_Increase (>) marks an optional term whose contribution to the relevancy is increased.
+	BooleanOperator_Increase
+	// BooleanOperator_Decrease (<) marks an optional term whose contribution to the relevancy is decreased.
+	BooleanOperator_Decrease
+	// BooleanOperator_Negate (~) marks a term whose contribution to the relevancy is negative. Unlike an excluded term,
+	// a match does not remove the row.
+	BooleanOperator_Negate
+)
+
+// BooleanTerm is a term of a query in boolean mode. A term is either a word, a phrase, or a parenthesized group of
+// terms.
+type BooleanTerm struct {
+	// Operator is the operator that precedes the term.
+	Operator BooleanOperator
+	// Words holds the word of the term, or the words of a phrase. A group has no words.
+	Words []string
+	// Phrase is whether the words must appear together and in order.
+	Phrase bool
+	// Prefix is whether the word ended with the truncation operator (*), matching any word that it's a prefix of.
+	Prefix bool
+	// Group holds the terms of a parenthesized group.
+	Group []BooleanTerm
+}
+
+// ParseBooleanQuery parses the search string of a MATCH ... AGAINST ... IN BOOLEAN MODE expression into its terms.
+// Words are split the same way as the DefaultParser splits documents, so words that would not be indexed are dropped,
+// along with stopwords, unless they're truncated with the * operator.
+func ParseBooleanQuery(ctx *sql.Context, collation sql.CollationID, query string) ([]BooleanTerm, error) {
+	p := booleanQueryParser{ctx: ctx, collation: collation, query: []rune(query), stopwords: SearchStopwords(ctx)}
+	return p.parseTerms(0)
+}
+
+// BooleanQueryWords returns the words that a row must contain at least one of to match the given terms, which are the
+// words of the terms that may cause a match. Returns false when such a term is truncated with the * operator, as its
+// words can't be looked up in an index.
+func BooleanQueryWords(terms []BooleanTerm) ([]string, bool) {
+	var words []string
+	for _, term := range terms {
+		if term.Operator == BooleanOperator_Exclude || term.Operator == BooleanOperator_Negate {
+			continue
+		}
+		if term.Prefix {
+			return nil, false
+		}
+		if len(term.Group) > 0 {
+			groupWords, ok := BooleanQueryWords(term.Group)
+			if !ok {
+				return nil, false
+			}
+			words = append(words, groupWords...)
+			continue
+		}
+		words = append(words, term.Words...)
+	}
+	return words, true
+}
+
+// booleanQueryParser holds the state of ParseBooleanQuery.
+type booleanQueryParser struct {
+	ctx       *sql.Context
+	collation sql.CollationID
+	query     []rune
+	pos       int
+	stopwords Stopwords
+}
+
+// parseTerms parses terms until the end of the query, or the end of the group at the given depth.
+func (p *booleanQueryParser) parseTerms(depth int) ([]BooleanTerm, error) {
+	var terms []BooleanTerm
+	operator := BooleanOperator_None
+	for p.pos < len(p.query) {
+		r := p.query[p.pos]
+		switch {
+		case r == '+' || r == '-' || r == '>' || r == '<' || r == '~':
+			// Only the operator that is closest to the term applies
+			operator = booleanOperators[r]
+			p.pos++
+		case r == '(':
+			p.pos++
+			group, err := p.parseTerms(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			if len(group) > 0 {
+				terms = append(terms, BooleanTerm{Operator: operator, Group: group})
+			}
+			operator = BooleanOperator_None
+		case r == ')':
+			p.pos++
+			if depth > 0 {
+				return terms, nil
+			}
+		case r == '"':
+			p.pos++
+			end := p.pos
+			for end < len(p.query) && p.query[end] != '"' {
+				end++
+			}
+			parser, err := NewSearchParser(p.ctx, p.collation, string(p.query[p.pos:end]))
+			if err != nil {
+				return nil, err
+			}
+			var words []string
+			word, _, reachedTheEnd, err := parser.Next(p.ctx)
+			for ; err == nil && !reachedTheEnd; word, _, reachedTheEnd, err = parser.Next(p.ctx) {
+				words = append(words, word)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if len(words) > 0 {
+				terms = append(terms, BooleanTerm{Operator: operator, Words: words, Phrase: true})
+			}
+			operator = BooleanOperator_None
+			p.pos = end + 1
+		case isWordRune(r):
+			start := p.pos
+			for p.pos < len(p.query) && (isWordRune(p.query[p.pos]) ||
+				(p.query[p.pos] == '\'' && p.pos+1 < len(p.query) && isWordRune(p.query[p.pos+1]))) {
+				p.pos++
+			}
+			word := strings.Trim(string(p.query[start:p.pos]), "'")
+			prefix := p.pos < len(p.query) && p.query[p.pos] == '*'
+			if prefix {
+				p.pos++
+			}
+			if prefix && len(word) > 0 || len(word) >= minTokenSize() && !p.stopwords.Contains(word) {
+				terms = append(terms, BooleanTerm{Operator: operator, Words: []string{word}, Prefix: prefix})
+			}
+			operator = BooleanOperator_None
+		default:
+			// Separators reset any operator that wasn't followed by a term
+			operator = BooleanOperator_None
+			p.pos++
+		}
+	}
+	return terms, nil
+}
+
+var booleanOperators = map[rune]BooleanOperator{
+	'+': BooleanOperator_Require,
+	'-': BooleanOperator_Exclude,
+	'>': BooleanOperator_Increase,
+	'<': BooleanOperator_Decrease,
+	'~': BooleanOperator_Negate,
+}
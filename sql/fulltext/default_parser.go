@@ -22,6 +22,10 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
+// defaultMinTokenSize is the length in bytes of the shortest word that is indexed when the innodb_ft_min_token_size
+// system variable is unavailable, matching its default.
+const defaultMinTokenSize = 3
+
 // parserState represents the state of the parser as it iterates over runes.
 type parserState byte
 
@@ -53,6 +57,17 @@ type parserWord struct {
 
 // NewDefaultParser creates a new DefaultParser.
 func NewDefaultParser(ctx *sql.Context, collation sql.CollationID, colVals ...interface{}) (parser DefaultParser, err error) {
+	return newParser(ctx, collation, nil, colVals)
+}
+
+// NewSearchParser creates a new DefaultParser for a search string, which skips the stopwords of the current session.
+func NewSearchParser(ctx *sql.Context, collation sql.CollationID, colVals ...interface{}) (parser DefaultParser, err error) {
+	return newParser(ctx, collation, SearchStopwords(ctx), colVals)
+}
+
+// newParser creates a new DefaultParser that skips words that are shorter than the minimum token size, along with the
+// given stopwords.
+func newParser(ctx *sql.Context, collation sql.CollationID, stopwords Stopwords, colVals []interface{}) (parser DefaultParser, err error) {
 	//TODO: implement exact matching using double quotes
 	sb := strings.Builder{}
 	for i, colVal := range colVals {
@@ -80,12 +95,16 @@ func NewDefaultParser(ctx *sql.Context, collation sql.CollationID, colVals ...in
 	document := sb.String()
 
 	// We preprocess the document so that it's easier to calculate counts
+	minLength := minTokenSize()
+	keep := func(word parserWord) bool {
+		return len(word.Word) >= minLength && !stopwords.Contains(word.Word)
+	}
 	var words []parserWord
 	var buildingWord []rune
 	state := parserState_Whitespace
 	position := uint64(0)
 	for i, r := range document {
-		isCharacter := ((unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsDigit(r)) && !unicode.IsPunct(r)) || r == '_'
+		isCharacter := isWordRune(r)
 		isApostrophe := r == '\''
 
 		switch state {
@@ -103,7 +122,7 @@ func NewDefaultParser(ctx *sql.Context, collation sql.CollationID, colVals ...in
 					state = parserState_Apostrophe
 				} else {
 					word := newParserWord(string(buildingWord), position)
-					if len(word.Word) >= 3 {
+					if keep(word) {
 						words = append(words, word)
 					}
 					buildingWord = buildingWord[:0]
@@ -116,7 +135,7 @@ func NewDefaultParser(ctx *sql.Context, collation sql.CollationID, colVals ...in
 		case parserState_Apostrophe:
 			if !isCharacter {
 				word := newParserWord(string(buildingWord), position)
-				if len(word.Word) >= 3 {
+				if keep(word) {
 					words = append(words, word)
 				}
 				buildingWord = buildingWord[:0]
@@ -130,7 +149,7 @@ func NewDefaultParser(ctx *sql.Context, collation sql.CollationID, colVals ...in
 	}
 	{ // Grab the last word if there is one
 		word := newParserWord(string(buildingWord), position)
-		if len(word.Word) >= 3 {
+		if keep(word) {
 			words = append(words, word)
 		}
 	}
@@ -215,3 +234,8 @@ func newParserWord(word string, position uint64) parserWord {
 		Position: position,
 	}
 }
+
+// isWordRune returns whether the given rune is part of a word, rather than a separator between words.
+func isWordRune(r rune) bool {
+	return ((unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsDigit(r)) && !unicode.IsPunct(r)) || r == '_'
+}
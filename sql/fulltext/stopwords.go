@@ -0,0 +1,72 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Stopwords are the words that searches ignore. Unlike words that are too short, stopwords are still indexed, so that
+// whether they're searched may be changed with the innodb_ft_enable_stopword system variable without rebuilding the
+// indexes.
+type Stopwords map[string]struct{}
+
+// defaultStopwords are the words of InnoDB's default stopword list, INFORMATION_SCHEMA.INNODB_FT_DEFAULT_STOPWORD.
+var defaultStopwords = Stopwords{
+	"a": {}, "about": {}, "an": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {}, "com": {}, "de": {}, "en": {},
+	"for": {}, "from": {}, "how": {}, "i": {}, "in": {}, "is": {}, "it": {}, "la": {}, "of": {}, "on": {}, "or": {},
+	"that": {}, "the": {}, "this": {}, "to": {}, "was": {}, "what": {}, "when": {}, "where": {}, "who": {}, "will": {},
+	"with": {}, "und": {}, "www": {},
+}
+
+// SearchStopwords returns the stopwords that the searches of the current session ignore, which are the default
+// stopwords unless the innodb_ft_enable_stopword system variable disables them.
+func SearchStopwords(ctx *sql.Context) Stopwords {
+	if ctx == nil || ctx.Session == nil {
+		return defaultStopwords
+	}
+	enabled, err := ctx.GetSessionVariable(ctx, "innodb_ft_enable_stopword")
+	if err != nil {
+		return defaultStopwords
+	}
+	if v, ok := enabled.(int8); ok && v == 0 {
+		return nil
+	}
+	return defaultStopwords
+}
+
+// Contains returns whether the given word is a stopword.
+func (s Stopwords) Contains(word string) bool {
+	_, ok := s[strings.ToLower(word)]
+	return ok
+}
+
+// minTokenSize returns the length in bytes of the shortest word that is indexed and searched, which is set by the
+// innodb_ft_min_token_size system variable.
+func minTokenSize() int {
+	if sql.SystemVariables == nil {
+		return defaultMinTokenSize
+	}
+	_, size, ok := sql.SystemVariables.GetGlobal("innodb_ft_min_token_size")
+	if !ok {
+		return defaultMinTokenSize
+	}
+	if size, ok := size.(int64); ok {
+		return int(size)
+	}
+	return defaultMinTokenSize
+}
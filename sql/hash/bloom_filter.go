@@ -0,0 +1,91 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hash
+
+import "math"
+
+// BloomFilter is a fixed-size probabilistic set of uint64 hashes. MayContain never returns a false negative for a
+// hash that was added, but may return false positives at roughly the rate the filter was sized for. A BloomFilter is
+// not safe for concurrent writes, but may be read concurrently once it is fully built.
+type BloomFilter struct {
+	bits   []uint64
+	nBits  uint64
+	nHash  int
+	nAdded int
+}
+
+// NewBloomFilter returns a BloomFilter sized to hold |n| entries with a false positive rate of approximately |fpRate|.
+func NewBloomFilter(n int, fpRate float64) *BloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.01
+	}
+	m := math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	nBits := uint64(m+63) &^ 63
+	k := int(math.Round(m / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomFilter{
+		bits:  make([]uint64, nBits/64),
+		nBits: nBits,
+		nHash: k,
+	}
+}
+
+// Add inserts |h| into the filter.
+func (b *BloomFilter) Add(h uint64) {
+	h1, h2 := bloomHashes(h)
+	for i := 0; i < b.nHash; i++ {
+		pos := (h1 + uint64(i)*h2) % b.nBits
+		b.bits[pos/64] |= 1 << (pos % 64)
+	}
+	b.nAdded++
+}
+
+// MayContain returns false if |h| was definitely never added to the filter, and true if it may have been.
+func (b *BloomFilter) MayContain(h uint64) bool {
+	h1, h2 := bloomHashes(h)
+	for i := 0; i < b.nHash; i++ {
+		pos := (h1 + uint64(i)*h2) % b.nBits
+		if b.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of hashes added to the filter.
+func (b *BloomFilter) Len() int {
+	return b.nAdded
+}
+
+// bloomHashes derives the two hashes used for double hashing from |h|. The input is remixed so that poorly distributed
+// inputs, such as small integers used directly as hash keys, still spread across the filter.
+func bloomHashes(h uint64) (uint64, uint64) {
+	h1 := mix64(h)
+	h2 := mix64(h1) | 1
+	return h1, h2
+}
+
+// mix64 is the splitmix64 finalizer.
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	const n = 10000
+	bf := NewBloomFilter(n, 0.01)
+	for i := uint64(0); i < n; i++ {
+		bf.Add(i * 2)
+	}
+	require.Equal(t, n, bf.Len())
+
+	// no false negatives
+	for i := uint64(0); i < n; i++ {
+		require.True(t, bf.MayContain(i*2))
+	}
+
+	// false positives near the requested rate
+	var fp int
+	for i := uint64(0); i < n; i++ {
+		if bf.MayContain(i*2 + 1) {
+			fp++
+		}
+	}
+	require.Less(t, fp, n/50)
+}
+
+func TestBloomFilterEmpty(t *testing.T) {
+	bf := NewBloomFilter(0, 0.01)
+	require.False(t, bf.MayContain(0))
+	require.False(t, bf.MayContain(12345))
+}
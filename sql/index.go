@@ -27,6 +27,9 @@ type IndexDef struct {
 	Storage    IndexUsing
 	// Predicate is the WHERE clause expression for partial indexes. May be nil.
 	Predicate Expression
+	// VectorDistance names the distance function a VECTOR index orders rows by, such as COSINE. It is empty for the
+	// default distance function.
+	VectorDistance string
 }
 
 func (i *IndexDef) String() string {
@@ -371,7 +371,7 @@ func (b *MySQLIndexBuilder) convertKey(ctx *Context, colType Type, keyType Type,
 		if !IsConvertibleKeyType(colType, keyType) {
 			return nil, Overflow, ErrInvalidValueType.New(key, colType)
 		}
-		k, inRange, err := colType.Convert(ctx, key)
+		k, inRange, err := convertRangeKey(ctx, colType, key)
 		if err != nil && !ErrTruncatedIncorrect.Is(err) {
 			return nil, Overflow, err
 		}
@@ -380,6 +380,14 @@ func (b *MySQLIndexBuilder) convertKey(ctx *Context, colType Type, keyType Type,
 	}
 }
 
+// convertRangeKey converts |key| to |typ| for the range of an index lookup.
+func convertRangeKey(ctx *Context, typ Type, key interface{}) (interface{}, ConvertInRange, error) {
+	if rt, ok := typ.(RangeKeyType); ok {
+		return rt.ConvertRangeKey(ctx, key)
+	}
+	return typ.Convert(ctx, key)
+}
+
 // GreaterOrEqual represents colExpr >= key.
 func (b *MySQLIndexBuilder) GreaterOrEqual(ctx *Context, colExpr string, keyType Type, key interface{}) *MySQLIndexBuilder {
 	if b.isInvalid {
@@ -748,7 +756,7 @@ func (b *EqualityIndexBuilder) AddEquality(ctx *Context, colIdx int, k interface
 
 	var err error
 	var inRange ConvertInRange
-	k, inRange, err = typ.Convert(ctx, k)
+	k, inRange, err = convertRangeKey(ctx, typ, k)
 	if err != nil {
 		return err
 	}
@@ -272,9 +272,8 @@ func getRowFromColumn(ctx *sql.Context, curOrdPos int, col *sql.Column, catName,
 	charName, collName, charMaxLen, charOctetLen := getCharAndCollNamesAndCharMaxAndOctetLens(ctx, col.Type)
 
 	numericPrecision, numericScale := getColumnPrecisionAndScale(col.Type)
-	if types.IsTimespan(col.Type) {
-		// TODO: TIME length not yet supported
-		datetimePrecision = 6
+	if timeType, ok := col.Type.(types.TimeType); ok {
+		datetimePrecision = timeType.Precision()
 	} else if dtType, ok := col.Type.(sql.DatetimeType); ok {
 		datetimePrecision = dtType.Precision()
 	}
@@ -379,8 +378,9 @@ func getRowsFromViews(ctx *sql.Context, catalog sql.Catalog, db DbWithNames, pri
 	}
 	privSetDb := privSet.Database(db.Database.Name())
 	for _, view := range views {
-		// TODO: figure out how auth works in this case
+		// The view's columns are only read here, so the statement that created it isn't authorized again
 		builder := planbuilder.New(ctx, catalog, nil)
+		builder.DisableAuth()
 		node, _, _, _, err := builder.Parse(view.CreateViewStatement, nil, false)
 		if err != nil {
 			continue // sometimes views contains views from other databases
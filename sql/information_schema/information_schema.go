@@ -36,6 +36,8 @@ const (
 	AdministrableRoleAuthorizationsTableName = "administrable_role_authorizations"
 	// ApplicableRolesTableName is the name of the APPLICABLE_ROLES table.
 	ApplicableRolesTableName = "applicable_roles"
+	// BackgroundJobsTableName is the name of the BACKGROUND_JOBS table, which isn't part of MySQL's information schema.
+	BackgroundJobsTableName = "background_jobs"
 	// CharacterSetsTableName is the name of the CHARACTER_SETS table
 	CharacterSetsTableName = "character_sets"
 	// CheckConstraintsTableName is the name of CHECK_CONSTRAINTS table
@@ -198,6 +200,15 @@ var applicableRolesSchema = Schema{
 	{Name: "IS_MANDATORY", Type: types.MustCreateString(sqltypes.VarChar, 3, Collation_Information_Schema_Default), Default: planbuilder.MustStringToColumnDefaultValue(sqlCtx, `""`, types.LongText, false), Nullable: false, Source: ApplicableRolesTableName},
 }
 
+var backgroundJobsSchema = Schema{
+	{Name: "NAME", Type: types.MustCreateString(sqltypes.VarChar, 512, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "STATE", Type: types.MustCreateString(sqltypes.VarChar, 16, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "STARTED", Type: types.Datetime, Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "FINISHED", Type: types.Datetime, Default: nil, Nullable: true, Source: BackgroundJobsTableName},
+	{Name: "RESTARTS", Type: types.Uint64, Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "LAST_ERROR", Type: types.MustCreateString(sqltypes.VarChar, 65535, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: BackgroundJobsTableName},
+}
+
 var characterSetsSchema = Schema{
 	{Name: "CHARACTER_SET_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: CharacterSetsTableName},
 	{Name: "DEFAULT_COLLATE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: CharacterSetsTableName},
@@ -562,7 +573,7 @@ var routinesSchema = Schema{
 	{Name: "CHARACTER_SET_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: RoutinesTableName},
 	{Name: "COLLATION_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: RoutinesTableName},
 	{Name: "DTD_IDENTIFIER", Type: types.LongText, Default: nil, Nullable: true, Source: RoutinesTableName},
-	{Name: "ROUTINE_BODY", Type: types.MustCreateString(sqltypes.VarChar, 3, Collation_Information_Schema_Default), Default: planbuilder.MustStringToColumnDefaultValue(sqlCtx, `""`, types.MustCreateString(sqltypes.VarChar, 3, Collation_Information_Schema_Default), false), Nullable: false, Source: RoutinesTableName},
+	{Name: "ROUTINE_BODY", Type: types.MustCreateString(sqltypes.VarChar, 8, Collation_Information_Schema_Default), Default: planbuilder.MustStringToColumnDefaultValue(sqlCtx, `""`, types.MustCreateString(sqltypes.VarChar, 8, Collation_Information_Schema_Default), false), Nullable: false, Source: RoutinesTableName},
 	{Name: "ROUTINE_DEFINITION", Type: types.LongText, Default: nil, Nullable: true, Source: RoutinesTableName},
 	{Name: "EXTERNAL_NAME", Type: types.MustCreateBinary(sqltypes.Binary, 0), Default: nil, Nullable: true, Source: RoutinesTableName},
 	{Name: "EXTERNAL_LANGUAGE", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: planbuilder.MustStringToColumnDefaultValue(sqlCtx, `"SQL"`, types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), false), Nullable: false, Source: RoutinesTableName},
@@ -579,6 +590,8 @@ var routinesSchema = Schema{
 	{Name: "CHARACTER_SET_CLIENT", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: RoutinesTableName},
 	{Name: "COLLATION_CONNECTION", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: RoutinesTableName},
 	{Name: "DATABASE_COLLATION", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: RoutinesTableName},
+	// IS_VALID is not in MySQL. It's NO if a table or column that the routine body refers to no longer exists.
+	{Name: "IS_VALID", Type: types.MustCreateString(sqltypes.VarChar, 3, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: RoutinesTableName},
 }
 
 var schemaPrivilegesSchema = Schema{
@@ -720,6 +733,8 @@ var triggersSchema = Schema{
 	{Name: "CHARACTER_SET_CLIENT", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TriggersTableName},
 	{Name: "COLLATION_CONNECTION", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TriggersTableName},
 	{Name: "DATABASE_COLLATION", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TriggersTableName},
+	// IS_VALID is not in MySQL. It's NO if a table or column that the trigger body refers to no longer exists.
+	{Name: "IS_VALID", Type: types.MustCreateString(sqltypes.VarChar, 3, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TriggersTableName},
 }
 
 var userAttributesSchema = Schema{
@@ -753,15 +768,109 @@ var viewTableUsageSchema = Schema{
 	{Name: "TABLE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ViewTableUsageTableName},
 }
 
+// backgroundJobsRowIter implements the sql.RowIter for the information_schema.BACKGROUND_JOBS table.
+// administrableRoleAuthorizationsRowIter implements the sql.RowIter for the
+// information_schema.ADMINISTRABLE_ROLE_AUTHORIZATIONS table, which holds the applicable roles that the current user
+// may grant to others.
+func administrableRoleAuthorizationsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return applicableRoleRows(ctx, c, true)
+}
+
+// applicableRolesRowIter implements the sql.RowIter for the information_schema.APPLICABLE_ROLES table, which holds the
+// roles that apply to the current user.
+func applicableRolesRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return applicableRoleRows(ctx, c, false)
+}
+
+// applicableRoleRows returns the rows of the roles that apply to the current user. Only the roles granted with the
+// admin option are returned if |grantable| is true.
+func applicableRoleRows(ctx *Context, c Catalog, grantable bool) (RowIter, error) {
+	db, err := c.Database(ctx, "mysql")
+	if err != nil {
+		return nil, err
+	}
+	mysqlDb, ok := db.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, ErrDatabaseNotFound.New("mysql")
+	}
+
+	reader := mysqlDb.Reader()
+	defer reader.Close()
+
+	client := ctx.Session.Client()
+	user := mysqlDb.GetUser(reader, client.User, client.Address, false)
+	if user == nil {
+		return EmptyIter, nil
+	}
+
+	var rows []Row
+	for _, role := range mysqlDb.ApplicableRoles(ctx, reader, user) {
+		if grantable && !role.WithAdminOption {
+			continue
+		}
+		rows = append(rows, Row{
+			user.User,                     // user
+			user.Host,                     // host
+			role.Grantee.Name,             // grantee
+			role.Grantee.Host,             // grantee_host
+			role.Role.Name,                // role_name
+			role.Role.Host,                // role_host
+			yesOrNo(role.WithAdminOption), // is_grantable
+			yesOrNo(role.IsDefault),       // is_default
+			yesOrNo(role.IsMandatory),     // is_mandatory
+		})
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// yesOrNo returns the YES or NO that the information schema shows for |b|.
+func yesOrNo(b bool) string {
+	if b {
+		return "YES"
+	}
+	return "NO"
+}
+
+func backgroundJobsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	prov, ok := c.(BackgroundJobProvider)
+	if !ok {
+		return RowsToRowIter(), nil
+	}
+	jobs := prov.BackgroundJobs()
+	var rows = make([]Row, len(jobs))
+	for i, job := range jobs {
+		var finished, lastError interface{}
+		if !job.Finished.IsZero() {
+			finished = job.Finished.UTC()
+		}
+		if job.LastError != nil {
+			lastError = job.LastError.Error()
+		}
+		rows[i] = Row{
+			job.Name,             // name
+			string(job.State),    // state
+			job.Started.UTC(),    // started
+			finished,             // finished
+			uint64(job.Restarts), // restarts
+			lastError,            // last_error
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 // characterSetsRowIter implements the sql.RowIter for the information_schema.CHARACTER_SETS table.
 func characterSetsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
-	for _, c := range SupportedCharsets {
+	iter := NewCharacterSetsIterator()
+	for c, ok := iter.Next(); ok; c, ok = iter.Next() {
+		if !c.ID.IsSupported() {
+			continue
+		}
 		rows = append(rows, Row{
-			c.String(),                  // character_set_name
-			c.DefaultCollation().Name(), // default_collation_name
-			c.Description(),             // description
-			uint64(c.MaxLength()),       // maxlen
+			c.Name,                    // character_set_name
+			c.DefaultCollation.Name(), // default_collation_name
+			c.Description,             // description
+			uint64(c.MaxLength),       // maxlen
 		})
 	}
 	return RowsToRowIter(rows...), nil
@@ -815,6 +924,9 @@ func collationCharacterSetApplicabilityRowIter(ctx *Context, c Catalog) (RowIter
 	var rows []Row
 	collIter := NewCollationsIterator()
 	for c, ok := collIter.Next(); ok; c, ok = collIter.Next() {
+		if !c.ID.IsSupported() {
+			continue
+		}
 		rows = append(rows, Row{
 			c.Name,                  // collation_name
 			c.CharacterSet.String(), // character_set_name
@@ -828,6 +940,9 @@ func collationsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
 	collIter := NewCollationsIterator()
 	for c, ok := collIter.Next(); ok; c, ok = collIter.Next() {
+		if !c.ID.IsSupported() {
+			continue
+		}
 		rows = append(rows, Row{
 			c.Name,                // collation_name
 			c.CharacterSet.Name(), // character_set_name
@@ -922,6 +1037,45 @@ func columnsExtensionsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 }
 
 // enginesRowIter implements the sql.RowIter for the information_schema.ENGINES table.
+// enabledRolesRowIter implements the sql.RowIter for the information_schema.ENABLED_ROLES table, which holds the roles
+// that are active in the session, along with the roles granted to them.
+func enabledRolesRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	db, err := c.Database(ctx, "mysql")
+	if err != nil {
+		return nil, err
+	}
+	mysqlDb, ok := db.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, ErrDatabaseNotFound.New("mysql")
+	}
+
+	reader := mysqlDb.Reader()
+	defer reader.Close()
+
+	client := ctx.Session.Client()
+	user := mysqlDb.GetUser(reader, client.User, client.Address, false)
+	if user == nil {
+		return EmptyIter, nil
+	}
+
+	defaultRoles := make(map[RoleName]struct{})
+	for _, role := range mysqlDb.DefaultRoles(reader, user) {
+		defaultRoles[role] = struct{}{}
+	}
+	var rows []Row
+	for _, role := range mysqlDb.RoleClosure(reader, mysqlDb.ActiveRoles(ctx, reader, user)) {
+		roleName := mysql_db.RoleNameOf(role)
+		_, isDefault := defaultRoles[roleName]
+		rows = append(rows, Row{
+			role.User,          // role_name
+			role.Host,          // role_host
+			yesOrNo(isDefault), // is_default
+			yesOrNo(mysqlDb.IsMandatoryRole(ctx, roleName)), // is_mandatory
+		})
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 func enginesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	var rows []Row
 	for _, c := range SupportedEngines {
@@ -1163,7 +1317,9 @@ func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
 		for name, progress := range proc.Progress {
 			status = append(status, fmt.Sprintf("%s(%s)", name, progress))
 		}
-		if len(status) == 0 && proc.Command == ProcessCommandQuery {
+		if proc.State != "" {
+			status = []string{proc.State}
+		} else if len(status) == 0 && proc.Command == ProcessCommandQuery {
 			status = []string{"running"}
 		}
 		sort.Strings(status)
@@ -1490,6 +1646,9 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					return nil, iErr
 				}
 
+				// statistics are only used for estimates, so a table without them is still shown
+				tableStats, _ := c.GetTableStats(ctx, "", db.Database.Name(), tbl)
+
 				for _, index := range indexes {
 					var (
 						nonUnique    int
@@ -1498,6 +1657,7 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 						comment      = ""
 						isVisible    string
 					)
+					cardinalities := IndexCardinalities(index, tableStats)
 					indexName = index.ID()
 					if index.IsUnique() {
 						nonUnique = 0
@@ -1516,21 +1676,23 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 						if col != nil {
 							i += 1
 							var (
-								collation   string
-								nullable    string
-								cardinality int64
-								subPart     interface{}
+								collation interface{}
+								nullable  string
+								subPart   interface{}
 							)
 
 							seqInIndex := i
 							colName := strings.Replace(col.Name, "`", "", -1) // get rid of backticks
 
 							// collation is "A" for ASC ; "D" for DESC ; "NULL" for not sorted
-							collation = "A"
+							if !index.IsFullText() && !index.IsSpatial() && !index.IsVector() {
+								collation = "A"
+							}
 
-							// TODO : cardinality is an estimate of the number of unique values in the index.
+							// cardinality is an estimate of the number of unique values in the index.
+							cardinality := cardinalities[j]
 
-							if j < len(index.PrefixLengths()) {
+							if j < len(index.PrefixLengths()) && index.PrefixLengths()[j] > 0 {
 								subPart = int64(index.PrefixLengths()[j])
 							}
 
@@ -1950,9 +2112,12 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 				// session's current database so that ParseWithOptions can correctly resolve references.
 				ctx.SetCurrentDatabase(db.Database.Name())
 				triggerSqlMode := NewSqlModeFromString(trigger.SqlMode)
-				// TODO: figure out how auth works in this case
+				// Triggers are filtered by the TRIGGER privilege, so the statement that created them isn't authorized again
 				builder := planbuilder.New(ctx, c, nil)
+				builder.DisableAuth()
 				builder.SetParserOptions(triggerSqlMode.ParserOptions())
+				// The body isn't resolved, so that a trigger referring to a dropped table or column is still listed
+				builder.TriggerCtx().LoadOnly = true
 				parsedTrigger, _, _, _, err := builder.Parse(trigger.CreateStatement, nil, false)
 				if err != nil {
 					return nil, err
@@ -1963,6 +2128,9 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 				}
 				triggerPlan.CreatedAt = trigger.CreatedAt // Keep stored created time
 				triggerPlan.SqlMode = triggerSqlMode.String()
+				if trigger.Definer != "" {
+					triggerPlan.Definer = trigger.Definer
+				}
 				triggerPlans = append(triggerPlans, triggerPlan)
 			}
 
@@ -2000,11 +2168,16 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 			// These are grouped as such just to use the index as the action order. No special importance on the arrangement,
 			// or the fact that these are slices in a larger slice rather than separate counts.
 			for _, planGroup := range [][]*plan.CreateTrigger{beforeDelete, beforeInsert, beforeUpdate, afterDelete, afterInsert, afterUpdate} {
-				for order, triggerPlan := range planGroup {
+				// the action order is the position of a trigger among the triggers of its table with the same timing and event
+				actionOrders := make(map[string]int64)
+				for _, triggerPlan := range planGroup {
 					triggerEvent := strings.ToUpper(triggerPlan.TriggerEvent)
 					triggerTime := strings.ToUpper(triggerPlan.TriggerTime)
 					tableName := triggerPlan.Table.(*plan.ResolvedTable).Name()
+					actionOrders[strings.ToLower(tableName)]++
+					order := actionOrders[strings.ToLower(tableName)]
 					definer := removeBackticks(triggerPlan.Definer)
+					isValid := routineValidity(ctx, c, db.Database.Name(), triggerPlan.CreateTriggerString, NewSqlModeFromString(triggerPlan.SqlMode).ParserOptions())
 
 					// triggers cannot be created on table that is not in current schema, so the trigger_name = event_object_schema
 					privTblSet := privDbSet.Table(tableName)
@@ -2019,7 +2192,7 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 							db.CatalogName,          // event_object_catalog
 							db.SchemaName,           // event_object_schema
 							tableName,               // event_object_table
-							int64(order + 1),        // action_order
+							order,                   // action_order
 							nil,                     // action_condition
 							triggerPlan.BodyString,  // action_statement
 							"ROW",                   // action_orientation
@@ -2034,6 +2207,7 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 							characterSetClient,      // character_set_client
 							collationConnection,     // collation_connection
 							dbCollation.String(),    // database_collation
+							isValid,                 // is_valid
 						})
 					}
 				}
@@ -2043,6 +2217,16 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// routineValidity returns "YES" if the tables and columns that the body of |createStatement|, the CREATE statement of
+// a trigger or stored procedure in the database |dbName|, refers to all exist in |c|, and "NO" otherwise.
+func routineValidity(ctx *Context, c Catalog, dbName, createStatement string, opts sqlparser.ParserOptions) string {
+	deps, err := planbuilder.RoutineDependencies(ctx, createStatement, opts)
+	if err != nil || planbuilder.CheckRoutineDependencies(ctx, c, dbName, deps) != nil {
+		return "NO"
+	}
+	return "YES"
+}
+
 // userAttributesRowIter implements the sql.RowIter for the information_schema.USER_ATTRIBUTES table.
 func userAttributesRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
 	var rows []Row
@@ -2149,12 +2333,17 @@ func GetInformationSchemaTables() map[string]Table {
 		AdministrableRoleAuthorizationsTableName: &InformationSchemaTable{
 			TableName:   AdministrableRoleAuthorizationsTableName,
 			TableSchema: administrableRoleAuthorizationsSchema,
-			Reader:      emptyReader,
+			Reader:      administrableRoleAuthorizationsRowIter,
 		},
 		ApplicableRolesTableName: &InformationSchemaTable{
 			TableName:   ApplicableRolesTableName,
 			TableSchema: applicableRolesSchema,
-			Reader:      emptyReader,
+			Reader:      applicableRolesRowIter,
+		},
+		BackgroundJobsTableName: &InformationSchemaTable{
+			TableName:   BackgroundJobsTableName,
+			TableSchema: backgroundJobsSchema,
+			Reader:      backgroundJobsRowIter,
 		},
 		CharacterSetsTableName: &InformationSchemaTable{
 			TableName:   CharacterSetsTableName,
@@ -2195,7 +2384,7 @@ func GetInformationSchemaTables() map[string]Table {
 		EnabledRolesTablesName: &InformationSchemaTable{
 			TableName:   EnabledRolesTablesName,
 			TableSchema: enabledRolesSchema,
-			Reader:      emptyReader,
+			Reader:      enabledRolesRowIter,
 		},
 		EnginesTableName: &InformationSchemaTable{
 			TableName:   EnginesTableName,
@@ -2235,7 +2424,7 @@ func GetInformationSchemaTables() map[string]Table {
 		PartitionsTableName: &InformationSchemaTable{
 			TableName:   PartitionsTableName,
 			TableSchema: partitionsSchema,
-			Reader:      emptyReader,
+			Reader:      partitionsRowIter,
 		},
 		PluginsTableName: &InformationSchemaTable{
 			TableName:   PluginsTableName,
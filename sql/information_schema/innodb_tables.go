@@ -438,14 +438,16 @@ var innoDBVirtualSchema = Schema{
 func innoDBTempTableRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
 	for _, db := range c.AllDatabases(ctx) {
-		tb, ok := db.(TemporaryTableDatabase)
-		if !ok {
-			continue
+		var tables []Table
+		if tb, ok := db.(TemporaryTableDatabase); ok {
+			var err error
+			tables, err = tb.GetAllTemporaryTables(ctx)
+			if err != nil {
+				return nil, err
+			}
 		}
-
-		tables, err := tb.GetAllTemporaryTables(ctx)
-		if err != nil {
-			return nil, err
+		if tts, ok := ctx.Session.(TemporaryTableSession); ok {
+			tables = append(tables, tts.GetTemporaryTables(db.Name())...)
 		}
 
 		for i, table := range tables {
@@ -0,0 +1,126 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"strings"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// partitionsRowIter implements the sql.RowIter for the information_schema.PARTITIONS table, which has a row for each
+// partition of the partitioned tables.
+func partitionsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
+	var rows []Row
+
+	databases, err := AllDatabasesWithNames(ctx, cat, false)
+	if err != nil {
+		return nil, err
+	}
+
+	y2k, _, _ := types.Timestamp.Convert(ctx, "2000-01-01 00:00:00")
+	for _, db := range databases {
+		err := DBTableIter(ctx, db.Database, func(t Table) (cont bool, err error) {
+			pt, ok := t.(PartitionedTable)
+			if !ok {
+				return true, nil
+			}
+			scheme := pt.PartitionScheme(ctx)
+			if !scheme.IsPartitioned() {
+				return true, nil
+			}
+
+			sch := t.Schema(ctx)
+			var typ Type
+			if idx := sch.IndexOfColName(scheme.Column); idx >= 0 {
+				typ = sch[idx].Type
+			}
+			method := scheme.Method.String()
+			if scheme.Linear {
+				method = "LINEAR " + method
+			}
+			if scheme.Columns {
+				method += " COLUMNS"
+			}
+
+			for i, def := range scheme.Partitions {
+				var description interface{}
+				switch scheme.Method {
+				case PartitionMethodRange:
+					description = "MAXVALUE"
+					if def.LessThan != nil {
+						if description, err = scheme.ValueString(ctx, typ, def.LessThan); err != nil {
+							return false, err
+						}
+					}
+				case PartitionMethodList:
+					values := make([]string, len(def.Values))
+					for j, v := range def.Values {
+						if values[j], err = scheme.ValueString(ctx, typ, v); err != nil {
+							return false, err
+						}
+					}
+					description = strings.Join(values, ",")
+				}
+
+				var tableRows uint64
+				partition, err := pt.WithPartitionNames(ctx, []string{def.Name})
+				if err != nil {
+					return false, err
+				}
+				if st, ok := partition.(StatisticsTable); ok {
+					if tableRows, _, err = st.RowCount(ctx); err != nil {
+						return false, err
+					}
+				}
+
+				rows = append(rows, Row{
+					db.CatalogName,            // table_catalog
+					db.SchemaName,             // table_schema
+					t.Name(),                  // table_name
+					def.Name,                  // partition_name
+					nil,                       // subpartition_name
+					uint32(i + 1),             // partition_ordinal_position
+					nil,                       // subpartition_ordinal_position
+					method,                    // partition_method
+					nil,                       // subpartition_method
+					"`" + scheme.Column + "`", // partition_expression
+					nil,                       // subpartition_expression
+					description,               // partition_description
+					tableRows,                 // table_rows
+					0,                         // avg_row_length
+					0,                         // data_length
+					0,                         // max_data_length
+					0,                         // index_length
+					0,                         // data_free
+					y2k,                       // create_time
+					nil,                       // update_time
+					nil,                       // check_time
+					nil,                       // checksum
+					"",                        // partition_comment
+					"default",                 // nodegroup
+					nil,                       // tablespace_name
+				})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
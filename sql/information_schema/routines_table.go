@@ -17,6 +17,7 @@ package information_schema
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	. "github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
@@ -161,8 +162,10 @@ func routinesRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure) (R
 			}
 
 			// todo shortcircuit routineDef->procedure.CreateProcedureString?
-			// TODO: figure out how auth works in this case
+			// Routines are filtered by the privileges on their database, so the statement that created them isn't
+			// authorized again
 			builder := planbuilder.New(ctx, c, nil)
+			builder.DisableAuth()
 			parsedProcedure, _, _, _, err := builder.Parse(procedure.CreateProcedureString, nil, false)
 			if err != nil {
 				continue
@@ -202,6 +205,10 @@ func routinesRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure) (R
 			if procedure.SecurityContext == plan.ProcedureSecurityContext_Invoker {
 				securityType = "INVOKER"
 			}
+			isValid := "YES"
+			if !procedure.IsExternal() {
+				isValid = routineValidity(ctx, c, dbName, procedure.CreateProcedureString, LoadSqlMode(ctx).ParserOptions())
+			}
 			rows = append(rows, Row{
 				procedure.Name,             // specific_name NOT NULL
 				"def",                      // routine_catalog
@@ -234,15 +241,162 @@ func routinesRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure) (R
 				characterSetClient,         // character_set_client NOT NULL
 				collationConnection,        // collation_connection NOT NULL
 				dbCollation.String(),       // database_collation NOT NULL
+				isValid,                    // is_valid NOT NULL
 			})
 		}
 	}
 
-	// TODO: need to add FUNCTIONS routine_type
+	storedFunctions, err := storedFunctionDefinitions(ctx, c, privSet)
+	if err != nil {
+		return nil, err
+	}
+	for _, sf := range storedFunctions {
+		fn := sf.StoredFunctionDetails
+		dbName := sf.Database().Name()
+		dtdId, dataType := getDtdIdAndDataType(sf.ReturnType)
+		charName, collName, charMaxLen, charOctetLen := getCharAndCollNamesAndCharMaxAndOctetLens(ctx, sf.ReturnType)
+		numericPrecision, numericScale := getColumnPrecisionAndScale(sf.ReturnType)
+		isDeterministic = "NO"
+		if plan.IsDeterministic(sf.Characteristics) {
+			isDeterministic = "YES"
+		}
+		sqlDataAccess = "CONTAINS SQL"
+		for _, ch := range sf.Characteristics {
+			if ch == plan.Characteristic_ContainsSql {
+				sqlDataAccess = "CONTAINS SQL"
+			} else if ch == plan.Characteristic_NoSql {
+				sqlDataAccess = "NO SQL"
+			} else if ch == plan.Characteristic_ReadsSqlData {
+				sqlDataAccess = "READS SQL DATA"
+			} else if ch == plan.Characteristic_ModifiesSqlData {
+				sqlDataAccess = "MODIFIES SQL DATA"
+			}
+		}
+		securityType = "DEFINER"
+		if sf.SecurityContext == plan.ProcedureSecurityContext_Invoker {
+			securityType = "INVOKER"
+		}
+		dbCollation := plan.GetDatabaseCollation(ctx, sf.Database())
+		isValid := routineValidity(ctx, c, dbName, fn.CreateStatement, NewSqlModeFromString(fn.SqlMode).ParserOptions())
+		rows = append(rows, Row{
+			fn.Name,                     // specific_name NOT NULL
+			"def",                       // routine_catalog
+			dbName,                      // routine_schema
+			fn.Name,                     // routine_name NOT NULL
+			"FUNCTION",                  // routine_type NOT NULL
+			dataType,                    // data_type
+			charMaxLen,                  // character_maximum_length
+			charOctetLen,                // character_octet_length
+			numericPrecision,            // numeric_precision
+			numericScale,                // numeric_scale
+			nil,                         // datetime_precision
+			charName,                    // character_set_name
+			collName,                    // collation_name
+			dtdId,                       // dtd_identifier
+			"SQL",                       // routine_body NOT NULL
+			sf.BodyString,               // routine_definition
+			nil,                         // external_name
+			"SQL",                       // external_language NOT NULL
+			"SQL",                       // parameter_style NOT NULL
+			isDeterministic,             // is_deterministic NOT NULL
+			sqlDataAccess,               // sql_data_access NOT NULL
+			nil,                         // sql_path
+			securityType,                // security_type NOT NULL
+			fn.CreatedAt.UTC(),          // created NOT NULL
+			fn.ModifiedAt.UTC(),         // last_altered NOT NULL
+			fn.SqlMode,                  // sql_mode NOT NULL
+			sf.Comment,                  // routine_comment NOT NULL
+			removeBackticks(fn.Definer), // definer NOT NULL
+			characterSetClient,          // character_set_client NOT NULL
+			collationConnection,         // collation_connection NOT NULL
+			dbCollation.String(),        // database_collation NOT NULL
+			isValid,                     // is_valid NOT NULL
+		})
+	}
+
+	// Functions registered by the integrator are global, so they are listed in the mysql schema like loadable
+	// functions. Calling them requires no privileges.
+	if udfp, ok := c.(UserDefinedFunctionProvider); ok {
+		for _, fn := range udfp.UserDefinedFunctions(ctx) {
+			rows = append(rows, userDefinedFunctionRoutineRow(ctx, fn, sqlMode, characterSetClient, collationConnection))
+		}
+	}
 
 	return RowsToRowIter(rows...), nil
 }
 
+// storedFunctionDefinitions returns the definitions of the stored functions in the databases that the privileges
+// |privSet| allow to see. Functions whose CREATE FUNCTION statement no longer parses are skipped.
+func storedFunctionDefinitions(ctx *Context, c Catalog, privSet PrivilegeSet) ([]*plan.CreateFunction, error) {
+	var definitions []*plan.CreateFunction
+	for _, db := range c.AllDatabases(ctx) {
+		fdb, ok := db.(StoredFunctionDatabase)
+		if !ok || !hasRoutinePrivsOnDB(privSet, db.Name()) {
+			continue
+		}
+		functions, err := fdb.GetStoredFunctions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fn := range functions {
+			// Routines are filtered by the privileges on their database, so the statement that created them isn't
+			// authorized again
+			definition, err := planbuilder.StoredFunctionDefinition(ctx, c, db, fn)
+			if err != nil {
+				continue
+			}
+			definitions = append(definitions, definition)
+		}
+	}
+	return definitions, nil
+}
+
+// userDefinedFunctionRoutineRow returns the information_schema.ROUTINES row for a user-defined function
+func userDefinedFunctionRoutineRow(ctx *Context, fn UserDefinedFunction, sqlMode string, characterSetClient, collationConnection interface{}) Row {
+	dtdId, dataType := getDtdIdAndDataType(fn.ReturnType)
+	charName, collName, charMaxLen, charOctetLen := getCharAndCollNamesAndCharMaxAndOctetLens(ctx, fn.ReturnType)
+	numericPrecision, numericScale := getColumnPrecisionAndScale(fn.ReturnType)
+	isDeterministic := "NO"
+	if fn.Volatility == FunctionImmutable {
+		isDeterministic = "YES"
+	}
+	name := strings.ToLower(fn.Name)
+	return Row{
+		name,                       // specific_name NOT NULL
+		"def",                      // routine_catalog
+		"mysql",                    // routine_schema
+		name,                       // routine_name NOT NULL
+		"FUNCTION",                 // routine_type NOT NULL
+		dataType,                   // data_type
+		charMaxLen,                 // character_maximum_length
+		charOctetLen,               // character_octet_length
+		numericPrecision,           // numeric_precision
+		numericScale,               // numeric_scale
+		nil,                        // datetime_precision
+		charName,                   // character_set_name
+		collName,                   // collation_name
+		dtdId,                      // dtd_identifier
+		"EXTERNAL",                 // routine_body NOT NULL
+		nil,                        // routine_definition
+		name,                       // external_name
+		"GO",                       // external_language NOT NULL
+		"SQL",                      // parameter_style NOT NULL
+		isDeterministic,            // is_deterministic NOT NULL
+		"NO SQL",                   // sql_data_access NOT NULL
+		nil,                        // sql_path
+		"INVOKER",                  // security_type NOT NULL
+		fn.CreatedAt.UTC(),         // created NOT NULL
+		fn.CreatedAt.UTC(),         // last_altered NOT NULL
+		sqlMode,                    // sql_mode NOT NULL
+		fn.Comment,                 // routine_comment NOT NULL
+		"",                         // definer NOT NULL
+		characterSetClient,         // character_set_client NOT NULL
+		collationConnection,        // collation_connection NOT NULL
+		Collation_Default.String(), // database_collation NOT NULL
+		"YES",                      // is_valid NOT NULL
+	}
+}
+
 // parametersRowIter implements the sql.RowIter for the information_schema.PARAMETERS table.
 func parametersRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure) (RowIter, error) {
 	var rows []Row
@@ -291,9 +445,8 @@ func parametersRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure)
 
 				if types.IsDatetimeType(param.Type) || types.IsTimestampType(param.Type) {
 					datetimePrecision = 0
-				} else if types.IsTimespan(param.Type) {
-					// TODO: TIME length not yet supported
-					datetimePrecision = 6
+				} else if timeType, ok := param.Type.(types.TimeType); ok {
+					datetimePrecision = timeType.Precision()
 				}
 
 				rows = append(rows, Row{
@@ -317,11 +470,59 @@ func parametersRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure)
 			}
 		}
 	}
-	// TODO: need to add FUNCTIONS routine_type
+	storedFunctions, err := storedFunctionDefinitions(ctx, c, privSet)
+	if err != nil {
+		return nil, err
+	}
+	for _, sf := range storedFunctions {
+		dbName := sf.Database().Name()
+		name := sf.StoredFunctionDetails.Name
+		// the return value of a function is listed as a parameter at position 0, with no mode or name
+		rows = append(rows, storedFunctionParameterRow(ctx, dbName, name, 0, nil, nil, sf.ReturnType))
+		for i, param := range sf.Params {
+			rows = append(rows, storedFunctionParameterRow(ctx, dbName, name, uint64(i+1), "IN", param.Name, param.Type))
+		}
+	}
 
 	return RowsToRowIter(rows...), nil
 }
 
+// storedFunctionParameterRow returns the information_schema.PARAMETERS row for a parameter or the return value of a
+// stored function.
+func storedFunctionParameterRow(ctx *Context, dbName, name string, ordinalPos uint64, parameterMode, parameterName interface{}, typ Type) Row {
+	var datetimePrecision interface{}
+	dtdId, dataType := getDtdIdAndDataType(typ)
+	charName, collName, charMaxLen, charOctetLen := getCharAndCollNamesAndCharMaxAndOctetLens(ctx, typ)
+	numericPrecision, numericScale := getColumnPrecisionAndScale(typ)
+	// float types get nil for numericScale, but it gets 0 for this table
+	if IsNumberType(typ) {
+		numericScale = 0
+	}
+	if types.IsDatetimeType(typ) || types.IsTimestampType(typ) {
+		datetimePrecision = 0
+	} else if timeType, ok := typ.(types.TimeType); ok {
+		datetimePrecision = timeType.Precision()
+	}
+	return Row{
+		"def",             // specific_catalog
+		dbName,            // specific_schema
+		name,              // specific_name
+		ordinalPos,        // ordinal_position
+		parameterMode,     // parameter_mode
+		parameterName,     // parameter_name
+		dataType,          // data_type
+		charMaxLen,        // character_maximum_length
+		charOctetLen,      // character_octet_length
+		numericPrecision,  // numeric_precision
+		numericScale,      // numeric_scale
+		datetimePrecision, // datetime_precision
+		charName,          // character_set_name
+		collName,          // collation_name
+		dtdId,             // dtd_identifier
+		"FUNCTION",        // routine_type
+	}
+}
+
 // hasRoutinePrivsOnDB returns bool value whether privilegeSet has either global or database level `CREATE ROUTINE` or `ALTER ROUTINE` or `EXECUTE` privileges.
 func hasRoutinePrivsOnDB(privSet PrivilegeSet, dbName string) bool {
 	return privSet.Has(PrivilegeType_CreateRoutine) || privSet.Has(PrivilegeType_AlterRoutine) || privSet.Has(PrivilegeType_Execute) ||
@@ -93,6 +93,7 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 		err := DBTableIter(ctx, db.Database, func(t Table) (cont bool, err error) {
 			tableCollation = t.Collation().String()
 			comment := ""
+			createOptions := ""
 			if db.Database.Name() != InformationSchemaDatabaseName {
 				if st, ok := t.(StatisticsTable); ok {
 					tableRows, _, err = st.RowCount(ctx)
@@ -131,6 +132,10 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				if commentedTable, ok := t.(CommentedTable); ok {
 					comment = commentedTable.Comment()
 				}
+
+				if pt, ok := t.(PartitionedTable); ok && pt.PartitionScheme(ctx).IsPartitioned() {
+					createOptions = "partitioned"
+				}
 			}
 
 			rows = append(rows, Row{
@@ -153,7 +158,7 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				nil,            // check_time
 				tableCollation, // table_collation
 				nil,            // checksum
-				"",             // create_options
+				createOptions,  // create_options
 				comment,        // table_comment
 			})
 
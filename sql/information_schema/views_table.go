@@ -82,8 +82,9 @@ func viewsRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
 			if !hasGlobalShowViewPriv && !hasDbShowViewPriv && !privTblSet.Has(PrivilegeType_ShowView) {
 				continue
 			}
-			// TODO: figure out how auth works in this case
+			// Views are filtered by the SHOW VIEW privilege, so the statement that created them isn't authorized again
 			builder := planbuilder.New(ctx, catalog, nil)
+			builder.DisableAuth()
 			builder.SetParserOptions(NewSqlModeFromString(view.SqlMode).ParserOptions())
 			parsedView, _, _, _, err := builder.Parse(view.CreateViewStatement, nil, false)
 			if err != nil {
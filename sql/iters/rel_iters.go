@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/dolthub/jsonpath"
 
@@ -72,127 +73,43 @@ type JsonTableColOpts struct {
 	ErrOnEmp  bool
 }
 
-// JsonTableCol represents a column in a json table.
+// JsonTableCol represents a column in a json table, or a NESTED PATH of columns.
 type JsonTableCol struct {
-	err      error
-	Opts     *JsonTableColOpts
-	Path     string          // if there are nested columns, this is a schema Path, otherwise it is a col Path
-	Cols     []*JsonTableCol // nested columns
-	data     []interface{}
-	pos      int
-	currSib  int
-	finished bool // exhausted all rows in data
-}
-
-// IsSibling returns if the jsonTableCol contains multiple columns
-func (c *JsonTableCol) IsSibling() bool {
-	return len(c.Cols) != 0
-}
-
-// NextSibling starts at the current sibling and moves to the next unfinished sibling
-// if there are no more unfinished siblings, it sets c.currSib to the first sibling and returns true
-// if the c.currSib is unfinished, nothing changes
-func (c *JsonTableCol) NextSibling() bool {
-	for i := c.currSib; i < len(c.Cols); i++ {
-		if c.Cols[i].IsSibling() && !c.Cols[i].finished {
-			c.currSib = i
-			return false
-		}
-	}
-	c.currSib = 0
-	for i := 0; i < len(c.Cols); i++ {
-		if c.Cols[i].IsSibling() {
-			c.currSib = i
-			break
-		}
-	}
-	return true
+	Opts *JsonTableColOpts
+	Path string          // if there are nested columns, this is the path of their rows, otherwise it is a col Path
+	Cols []*JsonTableCol // nested columns
 }
 
-// LoadData loads the data for this column from the given object and c.path
-// LoadData will always wrap the data in a slice to ensure it is iterable
-// Additionally, this function will set the c.currSib to the first sibling
-func (c *JsonTableCol) LoadData(obj interface{}) {
-	var data interface{}
-	data, c.err = jsonpath.JsonPathLookup(obj, c.Path)
-	if d, ok := data.([]interface{}); ok {
-		c.data = d
-	} else {
-		c.data = []interface{}{data}
-	}
-	c.pos = 0
-
-	c.NextSibling()
+// IsNested returns whether the column is a NESTED PATH of columns
+func (c *JsonTableCol) IsNested() bool {
+	return c.Opts == nil
 }
 
-// Reset clears the column's data and error, and recursively resets all nested columns
-func (c *JsonTableCol) Reset() {
-	c.data, c.err = nil, nil
-	c.finished = false
+// width returns the number of columns in the rows of the column
+func (c *JsonTableCol) width() int {
+	if !c.IsNested() {
+		return 1
+	}
+	w := 0
 	for _, col := range c.Cols {
-		col.Reset()
+		w += col.width()
 	}
+	return w
 }
 
-// Next returns the next row for this column.
-func (c *JsonTableCol) Next(ctx *sql.Context, obj interface{}, pass bool, ord int) (sql.Row, error) {
-	// nested column should recurse
-	if len(c.Cols) != 0 {
-		if c.data == nil {
-			c.LoadData(obj)
-		}
-
-		var innerObj interface{}
-		if !c.finished {
-			innerObj = c.data[c.pos]
-		}
-
-		var row sql.Row
-		for i, col := range c.Cols {
-			innerPass := len(col.Cols) != 0 && i != c.currSib
-			rowPart, err := col.Next(ctx, innerObj, pass || innerPass, c.pos+1)
-			if err != nil {
-				return nil, err
-			}
-			row = append(row, rowPart...)
-		}
-
-		if pass {
-			return row, nil
-		}
-
-		if c.NextSibling() {
-			for _, col := range c.Cols {
-				col.Reset()
-			}
-			c.pos++
-		}
-
-		if c.pos >= len(c.data) {
-			c.finished = true
-		}
-
-		return row, nil
-	}
-
-	// this should only apply to nested columns, maybe...
-	if pass {
-		return sql.Row{nil}, nil
+// value returns the value of the column for |obj|, the |ord|th match of the path of its rows.
+func (c *JsonTableCol) value(ctx *sql.Context, obj interface{}, ord int) (interface{}, error) {
+	// FOR ORDINALITY is a special case
+	if c.Opts.ForOrd {
+		return ord, nil
 	}
 
-	// FOR ORDINAL is a special case
-	if c.Opts != nil && c.Opts.ForOrd {
-		return sql.Row{ord}, nil
-	}
-
-	// TODO: cache this?
 	val, err := jsonpath.JsonPathLookup(obj, c.Path)
 	if c.Opts.Exists {
 		if err != nil {
-			return sql.Row{0}, nil
-		} else {
-			return sql.Row{1}, nil
+			return 0, nil
 		}
+		return 1, nil
 	}
 
 	// key error means empty
@@ -207,77 +124,105 @@ func (c *JsonTableCol) Next(ctx *sql.Context, obj interface{}, pass bool, ord in
 	if err != nil {
 		if c.Opts.ErrOnErr {
 			if sql.ErrTruncatedIncorrect.Is(err) {
-				return nil, sql.ErrInvalidJSONText.New(c.pos+1, "JSON_TABLE", "Invalid value.")
+				return nil, sql.ErrInvalidJSONText.New(1, "JSON_TABLE", "Invalid value.")
 			}
-			return nil, sql.ErrInvalidJSONText.New(c.pos+1, "JSON_TABLE", err.Error())
+			return nil, sql.ErrInvalidJSONText.New(1, "JSON_TABLE", err.Error())
 		}
 		val, _, err = c.Opts.Typ.Convert(ctx, c.Opts.DefErrVal)
 		if err != nil {
 			if sql.ErrTruncatedIncorrect.Is(err) {
-				return nil, sql.ErrInvalidJSONText.New(c.pos+1, "JSON_TABLE", "Invalid value.")
+				return nil, sql.ErrInvalidJSONText.New(1, "JSON_TABLE", "Invalid value.")
 			}
-			return nil, sql.ErrInvalidJSONText.New(c.pos+1, "JSON_TABLE", err.Error())
+			return nil, sql.ErrInvalidJSONText.New(1, "JSON_TABLE", err.Error())
 		}
 	}
-
-	// Base columns are always finished
-	c.finished = true
-	return sql.Row{val}, nil
+	return val, nil
 }
 
-type JsonTableRowIter struct {
-	Data    []interface{}
-	Cols    []*JsonTableCol
-	pos     int
-	currSib int
+// JsonTableMatches returns the values in |obj| that |path| matches. A path matches a single value, even if that value
+// is an array, unless it has a wildcard, range or list of array indexes, in which case the array it evaluates to holds
+// its matches. A path that finds nothing matches nothing.
+func JsonTableMatches(obj interface{}, path string) []interface{} {
+	data, err := jsonpath.JsonPathLookup(obj, path)
+	if err != nil {
+		return nil
+	}
+	if strings.ContainsAny(path, "*:,") || strings.Contains(path, "..") {
+		if matches, ok := data.([]interface{}); ok {
+			return matches
+		}
+	}
+	return []interface{}{data}
 }
 
-// NextSibling starts at the current sibling and moves to the next unfinished sibling
-// if there are no more unfinished siblings, it resets to the first sibling
-func (j *JsonTableRowIter) NextSibling() bool {
-	for i := j.currSib; i < len(j.Cols); i++ {
-		if !j.Cols[i].finished && len(j.Cols[i].Cols) != 0 {
-			j.currSib = i
-			return false
+// jsonTableRows returns the rows of |cols| for |obj|, the |ord|th match of the path of their rows. A row is returned
+// for each row of each NESTED PATH, in which the columns of its sibling NESTED PATHs are NULL. If none of the NESTED
+// PATHs have rows, a single row is returned in which all of their columns are NULL.
+func jsonTableRows(ctx *sql.Context, cols []*JsonTableCol, obj interface{}, ord int) ([]sql.Row, error) {
+	type nestedCol struct {
+		col    *JsonTableCol
+		offset int
+	}
+	var row sql.Row
+	var nested []nestedCol
+	for _, col := range cols {
+		if col.IsNested() {
+			nested = append(nested, nestedCol{col: col, offset: len(row)})
+			row = append(row, make(sql.Row, col.width())...)
+			continue
+		}
+		val, err := col.value(ctx, obj, ord)
+		if err != nil {
+			return nil, err
 		}
+		row = append(row, val)
 	}
-	j.currSib = 0
-	for i := 0; i < len(j.Cols); i++ {
-		if len(j.Cols[i].Cols) != 0 {
-			j.currSib = i
-			break
+
+	var rows []sql.Row
+	for _, n := range nested {
+		for i, match := range JsonTableMatches(obj, n.col.Path) {
+			nestedRows, err := jsonTableRows(ctx, n.col.Cols, match, i+1)
+			if err != nil {
+				return nil, err
+			}
+			for _, nestedRow := range nestedRows {
+				r := row.Copy()
+				copy(r[n.offset:], nestedRow)
+				rows = append(rows, r)
+			}
 		}
 	}
-	return true
+	if len(rows) == 0 {
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
 
-func (j *JsonTableRowIter) ResetAll() {
-	for _, col := range j.Cols {
-		col.Reset()
-	}
+// JsonTableRowIter is the row iterator of a json table. Its rows are those of each of the matches of its root path in
+// |Data|, in order.
+type JsonTableRowIter struct {
+	Data []interface{}
+	Cols []*JsonTableCol
+	// rows are the remaining rows of the last match
+	rows []sql.Row
+	pos  int
 }
 
 func (j *JsonTableRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	if j.pos >= len(j.Data) {
-		return nil, io.EOF
-	}
-	obj := j.Data[j.pos]
-
-	var row sql.Row
-	for i, col := range j.Cols {
-		pass := len(col.Cols) != 0 && i != j.currSib
-		rowPart, err := col.Next(ctx, obj, pass, j.pos+1)
+	for len(j.rows) == 0 {
+		if j.pos >= len(j.Data) {
+			return nil, io.EOF
+		}
+		rows, err := jsonTableRows(ctx, j.Cols, j.Data[j.pos], j.pos+1)
 		if err != nil {
 			return nil, err
 		}
-		row = append(row, rowPart...)
-	}
-
-	if j.NextSibling() {
-		j.ResetAll()
+		j.rows = rows
 		j.pos++
 	}
 
+	row := j.rows[0]
+	j.rows = j.rows[1:]
 	return row, nil
 }
 
@@ -378,6 +323,38 @@ func (li *LimitIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return childRow, nil
 }
 
+// NextValueRow implements the sql.ValueRowIter interface.
+func (li *LimitIter) NextValueRow(ctx *sql.Context) (sql.ValueRow, error) {
+	childIter := li.ChildIter.(sql.ValueRowIter)
+	if li.currentPos >= li.Limit {
+		if li.CalcFoundRows {
+			for {
+				_, err := childIter.NextValueRow(ctx)
+				if err != nil {
+					return nil, err
+				}
+				li.currentPos++
+			}
+		}
+
+		return nil, io.EOF
+	}
+
+	childRow, err := childIter.NextValueRow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	li.currentPos++
+
+	return childRow, nil
+}
+
+// IsValueRowIter implements the sql.ValueRowIter interface.
+func (li *LimitIter) IsValueRowIter(ctx *sql.Context) bool {
+	childIter, ok := li.ChildIter.(sql.ValueRowIter)
+	return ok && childIter.IsValueRowIter(ctx)
+}
+
 func (li *LimitIter) Close(ctx *sql.Context) error {
 	err := li.ChildIter.Close(ctx)
 	if err != nil {
@@ -0,0 +1,51 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+)
+
+// LoadableFunctionFactory stands in for a shared library named by CREATE FUNCTION ... SONAME. Shared libraries are
+// never loaded; instead the statement asks the factory registered for the library's name for the function, which is
+// then registered as a UserDefinedFunction. The factory returns false if the library doesn't provide a function with
+// the name given. The function's name and return type are taken from the statement, and |aggregate| is true for
+// CREATE AGGREGATE FUNCTION.
+type LoadableFunctionFactory func(ctx *Context, name string, aggregate bool) (UserDefinedFunction, bool)
+
+var loadableFunctionLibraries = struct {
+	sync.RWMutex
+	factories map[string]LoadableFunctionFactory
+}{factories: make(map[string]LoadableFunctionFactory)}
+
+// RegisterLoadableFunctionLibrary registers the factory used for CREATE FUNCTION ... SONAME |library|. Library names
+// are matched exactly, as file names would be. Passing a nil factory removes the library.
+func RegisterLoadableFunctionLibrary(library string, factory LoadableFunctionFactory) {
+	loadableFunctionLibraries.Lock()
+	defer loadableFunctionLibraries.Unlock()
+	if factory == nil {
+		delete(loadableFunctionLibraries.factories, library)
+		return
+	}
+	loadableFunctionLibraries.factories[library] = factory
+}
+
+// GetLoadableFunctionLibrary returns the factory registered for |library|.
+func GetLoadableFunctionLibrary(library string) (LoadableFunctionFactory, bool) {
+	loadableFunctionLibraries.RLock()
+	defer loadableFunctionLibraries.RUnlock()
+	factory, ok := loadableFunctionLibraries.factories[library]
+	return factory, ok
+}
@@ -0,0 +1,268 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// TimeLocaleSessionVar is the system variable that names the locale used for month and day names
+	TimeLocaleSessionVar = "lc_time_names"
+	// DefaultTimeLocale is the locale used when lc_time_names is not set
+	DefaultTimeLocale = "en_US"
+)
+
+// Locale holds the month and day names of a locale, as used by DATE_FORMAT, MONTHNAME and DAYNAME.
+// https://dev.mysql.com/doc/refman/8.4/en/locale-support.html
+type Locale struct {
+	Name string
+	// MonthNames and AbbrevMonthNames start with January
+	MonthNames       [12]string
+	AbbrevMonthNames [12]string
+	// DayNames and AbbrevDayNames start with Sunday, the same as time.Weekday
+	DayNames       [7]string
+	AbbrevDayNames [7]string
+}
+
+// MonthName returns the name of the month of |t|
+func (l *Locale) MonthName(t time.Time) string {
+	return l.MonthNames[t.Month()-1]
+}
+
+// AbbrevMonthName returns the abbreviated name of the month of |t|
+func (l *Locale) AbbrevMonthName(t time.Time) string {
+	return l.AbbrevMonthNames[t.Month()-1]
+}
+
+// DayName returns the name of the weekday of |t|
+func (l *Locale) DayName(t time.Time) string {
+	return l.DayNames[t.Weekday()]
+}
+
+// AbbrevDayName returns the abbreviated name of the weekday of |t|
+func (l *Locale) AbbrevDayName(t time.Time) string {
+	return l.AbbrevDayNames[t.Weekday()]
+}
+
+// localeNames holds the names shared by the locales of a language
+type localeNames struct {
+	months, abbrevMonths [12]string
+	days, abbrevDays     [7]string
+}
+
+var englishNames = localeNames{
+	months:       [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	abbrevMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	days:         [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	abbrevDays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+}
+
+var germanNames = localeNames{
+	months:       [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	abbrevMonths: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	days:         [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	abbrevDays:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+}
+
+var frenchNames = localeNames{
+	months:       [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	abbrevMonths: [12]string{"jan", "fév", "mar", "avr", "mai", "jun", "jui", "aoû", "sep", "oct", "nov", "déc"},
+	days:         [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	abbrevDays:   [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+}
+
+var spanishNames = localeNames{
+	months:       [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	abbrevMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+	days:         [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	abbrevDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+}
+
+var italianNames = localeNames{
+	months:       [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+	abbrevMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+	days:         [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+	abbrevDays:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+}
+
+var portugueseNames = localeNames{
+	months:       [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	abbrevMonths: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+	days:         [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+	abbrevDays:   [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+}
+
+var dutchNames = localeNames{
+	months:       [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+	abbrevMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	days:         [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+	abbrevDays:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+}
+
+var swedishNames = localeNames{
+	months:       [12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+	abbrevMonths: [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	days:         [7]string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+	abbrevDays:   [7]string{"sön", "mån", "tis", "ons", "tor", "fre", "lör"},
+}
+
+var danishNames = localeNames{
+	months:       [12]string{"januar", "februar", "marts", "april", "maj", "juni", "juli", "august", "september", "oktober", "november", "december"},
+	abbrevMonths: [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	days:         [7]string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"},
+	abbrevDays:   [7]string{"søn", "man", "tir", "ons", "tor", "fre", "lør"},
+}
+
+var norwegianNames = localeNames{
+	months:       [12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+	abbrevMonths: [12]string{"jan", "feb", "mar", "apr", "mai", "jun", "jul", "aug", "sep", "okt", "nov", "des"},
+	days:         [7]string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"},
+	abbrevDays:   [7]string{"sø", "ma", "ti", "on", "to", "fr", "lø"},
+}
+
+var finnishNames = localeNames{
+	months:       [12]string{"tammikuu", "helmikuu", "maaliskuu", "huhtikuu", "toukokuu", "kesäkuu", "heinäkuu", "elokuu", "syyskuu", "lokakuu", "marraskuu", "joulukuu"},
+	abbrevMonths: [12]string{"tammi", "helmi", "maalis", "huhti", "touko", "kesä", "heinä", "elo", "syys", "loka", "marras", "joulu"},
+	days:         [7]string{"sunnuntai", "maanantai", "tiistai", "keskiviikko", "torstai", "perjantai", "lauantai"},
+	abbrevDays:   [7]string{"su", "ma", "ti", "ke", "to", "pe", "la"},
+}
+
+var polishNames = localeNames{
+	months:       [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+	abbrevMonths: [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+	days:         [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+	abbrevDays:   [7]string{"nie", "pon", "wto", "śro", "czw", "pią", "sob"},
+}
+
+var czechNames = localeNames{
+	months:       [12]string{"leden", "únor", "březen", "duben", "květen", "červen", "červenec", "srpen", "září", "říjen", "listopad", "prosinec"},
+	abbrevMonths: [12]string{"led", "úno", "bře", "dub", "kvě", "čen", "čec", "srp", "zář", "říj", "lis", "pro"},
+	days:         [7]string{"Neděle", "Pondělí", "Úterý", "Středa", "Čtvrtek", "Pátek", "Sobota"},
+	abbrevDays:   [7]string{"Ne", "Po", "Út", "St", "Čt", "Pá", "So"},
+}
+
+var russianNames = localeNames{
+	months:       [12]string{"Января", "Февраля", "Марта", "Апреля", "Мая", "Июня", "Июля", "Августа", "Сентября", "Октября", "Ноября", "Декабря"},
+	abbrevMonths: [12]string{"Янв", "Фев", "Мар", "Апр", "Май", "Июн", "Июл", "Авг", "Сен", "Окт", "Ноя", "Дек"},
+	days:         [7]string{"Воскресенье", "Понедельник", "Вторник", "Среда", "Четверг", "Пятница", "Суббота"},
+	abbrevDays:   [7]string{"Вск", "Пнд", "Втр", "Срд", "Чтв", "Птн", "Сбт"},
+}
+
+var ukrainianNames = localeNames{
+	months:       [12]string{"Січень", "Лютий", "Березень", "Квітень", "Травень", "Червень", "Липень", "Серпень", "Вересень", "Жовтень", "Листопад", "Грудень"},
+	abbrevMonths: [12]string{"Січ", "Лют", "Бер", "Кві", "Тра", "Чер", "Лип", "Сер", "Вер", "Жов", "Лис", "Гру"},
+	days:         [7]string{"Неділя", "Понеділок", "Вівторок", "Середа", "Четвер", "П'ятниця", "Субота"},
+	abbrevDays:   [7]string{"Нд", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"},
+}
+
+var turkishNames = localeNames{
+	months:       [12]string{"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"},
+	abbrevMonths: [12]string{"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"},
+	days:         [7]string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+	abbrevDays:   [7]string{"Paz", "Pzt", "Sal", "Çrş", "Prş", "Cum", "Cts"},
+}
+
+var japaneseNames = localeNames{
+	months:       [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	abbrevMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	days:         [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	abbrevDays:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+}
+
+var chineseNames = localeNames{
+	months:       [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	abbrevMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	days:         [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+	abbrevDays:   [7]string{"日", "一", "二", "三", "四", "五", "六"},
+}
+
+var koreanNames = localeNames{
+	months:       [12]string{"일월", "이월", "삼월", "사월", "오월", "유월", "칠월", "팔월", "구월", "시월", "십일월", "십이월"},
+	abbrevMonths: [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+	days:         [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+	abbrevDays:   [7]string{"일", "월", "화", "수", "목", "금", "토"},
+}
+
+// locales maps the lowercase name of every supported locale to its names
+var locales = func() map[string]*Locale {
+	byLanguage := map[*localeNames][]string{
+		&englishNames:    {"en_US", "en_AU", "en_CA", "en_GB", "en_IN", "en_NZ", "en_PH", "en_ZA", "en_ZW"},
+		&germanNames:     {"de_DE", "de_AT", "de_BE", "de_CH", "de_LU"},
+		&frenchNames:     {"fr_FR", "fr_BE", "fr_CA", "fr_CH", "fr_LU"},
+		&spanishNames:    {"es_ES", "es_AR", "es_BO", "es_CL", "es_CO", "es_CR", "es_DO", "es_EC", "es_GT", "es_HN", "es_MX", "es_NI", "es_PA", "es_PE", "es_PR", "es_PY", "es_SV", "es_US", "es_UY", "es_VE"},
+		&italianNames:    {"it_IT", "it_CH"},
+		&portugueseNames: {"pt_PT", "pt_BR"},
+		&dutchNames:      {"nl_NL", "nl_BE"},
+		&swedishNames:    {"sv_SE", "sv_FI"},
+		&danishNames:     {"da_DK"},
+		&norwegianNames:  {"nb_NO", "no_NO"},
+		&finnishNames:    {"fi_FI"},
+		&polishNames:     {"pl_PL"},
+		&czechNames:      {"cs_CZ"},
+		&russianNames:    {"ru_RU", "ru_UA"},
+		&ukrainianNames:  {"uk_UA"},
+		&turkishNames:    {"tr_TR"},
+		&japaneseNames:   {"ja_JP"},
+		&chineseNames:    {"zh_CN", "zh_HK", "zh_TW"},
+		&koreanNames:     {"ko_KR"},
+	}
+	res := make(map[string]*Locale)
+	for names, localeNames := range byLanguage {
+		for _, name := range localeNames {
+			res[strings.ToLower(name)] = &Locale{
+				Name:             name,
+				MonthNames:       names.months,
+				AbbrevMonthNames: names.abbrevMonths,
+				DayNames:         names.days,
+				AbbrevDayNames:   names.abbrevDays,
+			}
+		}
+	}
+	return res
+}()
+
+// LookupLocale returns the locale with the given name, such as de_DE. Names are case-insensitive.
+func LookupLocale(name string) (*Locale, bool) {
+	l, ok := locales[strings.ToLower(name)]
+	return l, ok
+}
+
+// LocaleNames returns the names of every supported locale, sorted.
+func LocaleNames() []string {
+	names := make([]string, 0, len(locales))
+	for _, l := range locales {
+		names = append(names, l.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadTimeLocale returns the locale named by the lc_time_names session variable, or en_US if it is unset or unknown.
+func LoadTimeLocale(ctx *Context) *Locale {
+	if ctx != nil && ctx.Session != nil {
+		if val, err := ctx.GetSessionVariable(ctx, TimeLocaleSessionVar); err == nil {
+			if name, ok := val.(string); ok {
+				if l, ok := LookupLocale(name); ok {
+					return l
+				}
+			}
+		}
+	}
+	l, _ := LookupLocale(DefaultTimeLocale)
+	return l
+}
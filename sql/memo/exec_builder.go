@@ -299,11 +299,16 @@ func (b *ExecBuilder) buildMergeJoin(ctx *sql.Context, j *MergeJoin, children ..
 }
 
 func (b *ExecBuilder) buildLateralJoin(ctx *sql.Context, j *LateralJoin, children ...sql.Node) (sql.Node, error) {
+	op := j.Op.AsLateral()
 	if len(j.Filter) == 0 {
+		if op == plan.JoinTypeLateralLeft {
+			// a left join without a filter still returns the left rows that have no right rows
+			return plan.NewJoin(ctx, children[0], children[1], op, expression.NewLiteral(true, types.Boolean)), nil
+		}
 		return plan.NewLateralCrossJoin(ctx, children[0], children[1]), nil
 	}
 	filters := b.buildFilterConjunction(ctx, j.Filter...)
-	return plan.NewJoin(ctx, children[0], children[1], j.Op.AsLateral(), filters), nil
+	return plan.NewJoin(ctx, children[0], children[1], op, filters), nil
 }
 
 func (b *ExecBuilder) buildSubqueryAlias(ctx *sql.Context, r *SubqueryAlias, children ...sql.Node) (sql.Node, error) {
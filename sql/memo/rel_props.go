@@ -357,6 +357,9 @@ func (m *Memo) statsForRel(ctx *sql.Context, rel RelExpr) sql.Statistic {
 		// Assume that the smaller set is surjective onto the larger set, and at least one of the sets is uniformly distributed.
 		// If so, then the odds that a random element of each set matches can be computed as:
 		selectivity := 1.0 / float64(distinct)
+		if sel, ok := joinSelectivity(ctx, jp.Left, jp.Right, jp.Filter); ok {
+			selectivity = sel
+		}
 
 		var injective bool
 		var smallestLeft sql.Statistic
@@ -474,32 +477,34 @@ func (m *Memo) statsForRel(ctx *sql.Context, rel RelExpr) sql.Statistic {
 		stat = &stats.Statistic{RowCnt: defaultTableSize / 2}
 
 	case SourceRel:
-		var table sql.Table
-		var dbName string
-		switch tn := rel.TableIdNode().(type) {
-		case sql.TableNode:
-			table = tn.UnderlyingTable()
-			dbName = tn.Database().Name()
-		case *plan.TableAlias:
-			if tn, ok := tn.Child.(sql.TableNode); ok {
-				dbName = tn.Database().Name()
-				table = tn.UnderlyingTable()
-			} else {
-				return &stats.Statistic{RowCnt: defaultTableSize}
-			}
-		default:
+		table, dbName, ok := sourceRelTable(rel)
+		if !ok {
 			return &stats.Statistic{RowCnt: defaultTableSize}
 		}
+		var card uint64
+		var known bool
 		if prov := rel.Group().m.StatsProvider(); prov != nil {
-			if card, err := prov.RowCount(ctx, "", dbName, table); err == nil {
-				return &stats.Statistic{RowCnt: card}
+			if cnt, err := prov.RowCount(ctx, "", dbName, table); err == nil {
+				card, known = cnt, true
 			}
 		}
+		// tables that haven't been analyzed may still estimate their own size
+		if prov, ok := table.(sql.StatisticsProvider); ok && card == 0 {
+			if cnt, ok, err := prov.EstimatedRowCount(ctx); err == nil && ok {
+				card, known = cnt, true
+			}
+		}
+		if known {
+			return &stats.Statistic{RowCnt: card}
+		}
 		return &stats.Statistic{RowCnt: defaultTableSize}
 
 	case *Filter:
-		// TODO: should determine actual filter selectivity based on histograms...
-		card := float64(rel.Child.RelProps.GetStats().RowCount()) * defaultFilterSelectivity
+		selectivity := defaultFilterSelectivity
+		if sel, ok := filterSelectivity(ctx, rel.Child, rel.Filters); ok {
+			selectivity = sel
+		}
+		card := float64(rel.Child.RelProps.GetStats().RowCount()) * selectivity
 		stat = &stats.Statistic{RowCnt: uint64(card)}
 
 	case *Project:
@@ -0,0 +1,263 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memo
+
+import (
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// sourceRelTable returns the table read by |rel| and the name of its database, if it reads one.
+func sourceRelTable(rel SourceRel) (sql.Table, string, bool) {
+	tn, ok := rel.TableIdNode().(sql.TableNode)
+	if !ok {
+		alias, ok := rel.TableIdNode().(*plan.TableAlias)
+		if !ok {
+			return nil, "", false
+		}
+		if tn, ok = alias.Child.(sql.TableNode); !ok {
+			return nil, "", false
+		}
+	}
+	var dbName string
+	if db := tn.Database(); db != nil {
+		dbName = db.Name()
+	}
+	return tn.UnderlyingTable(), dbName, true
+}
+
+// findSourceRel returns the data source below |grp| with the table id |id|.
+func findSourceRel(grp *ExprGroup, id sql.TableId) (SourceRel, bool) {
+	if src, ok := grp.First.(SourceRel); ok {
+		return src, src.TableIdNode() != nil && src.TableIdNode().Id() == id
+	}
+	for _, child := range grp.First.Children() {
+		if src, ok := findSourceRel(child, id); ok {
+			return src, true
+		}
+	}
+	return nil, false
+}
+
+// columnStatistics returns the statistics that the table read by the column |gf| provides for it, if |gf| reads from a
+// table below one of |grps| that implements sql.StatisticsProvider.
+func columnStatistics(ctx *sql.Context, gf *expression.GetField, grps ...*ExprGroup) (sql.ColumnStatistics, bool) {
+	for _, grp := range grps {
+		src, ok := findSourceRel(grp, gf.TableId())
+		if !ok {
+			continue
+		}
+		table, _, ok := sourceRelTable(src)
+		if !ok {
+			break
+		}
+		prov, ok := table.(sql.StatisticsProvider)
+		if !ok {
+			break
+		}
+		colStats, ok, err := prov.ColumnStatistics(ctx, gf.Name())
+		if err != nil || !ok {
+			break
+		}
+		return colStats, true
+	}
+	return sql.ColumnStatistics{}, false
+}
+
+// filterSelectivity estimates the fraction of the rows of |child| that satisfy every one of |filters|, using the
+// statistics of the tables below |child| that implement sql.StatisticsProvider. Filters that can't be estimated are
+// ignored. It returns false if none of the filters can be estimated.
+func filterSelectivity(ctx *sql.Context, child *ExprGroup, filters []sql.Expression) (float64, bool) {
+	sel := 1.0
+	var estimated bool
+	for _, f := range filters {
+		if s, ok := exprSelectivity(ctx, child, f); ok {
+			sel *= s
+			estimated = true
+		}
+	}
+	return sel, estimated
+}
+
+// exprSelectivity estimates the fraction of the rows of |child| for which |e| is true.
+func exprSelectivity(ctx *sql.Context, child *ExprGroup, e sql.Expression) (float64, bool) {
+	switch e := e.(type) {
+	case *expression.And:
+		l, lok := exprSelectivity(ctx, child, e.LeftChild)
+		r, rok := exprSelectivity(ctx, child, e.RightChild)
+		if !lok && !rok {
+			return 0, false
+		}
+		if !lok {
+			l = 1
+		}
+		if !rok {
+			r = 1
+		}
+		return l * r, true
+	case *expression.Or:
+		l, lok := exprSelectivity(ctx, child, e.LeftChild)
+		r, rok := exprSelectivity(ctx, child, e.RightChild)
+		if !lok || !rok {
+			return 0, false
+		}
+		return l + r - l*r, true
+	case *expression.Not:
+		if s, ok := exprSelectivity(ctx, child, e.Child); ok {
+			return 1 - s, true
+		}
+	case *expression.IsNull:
+		if gf, ok := e.Child.(*expression.GetField); ok {
+			if colStats, ok := columnStatistics(ctx, gf, child); ok {
+				return colStats.NullFraction, true
+			}
+		}
+	case *expression.Equals, *expression.NullSafeEquals:
+		gf, _, ok := columnComparedToLiteral(e.(expression.Comparer))
+		if !ok {
+			return 0, false
+		}
+		if colStats, ok := columnStatistics(ctx, gf, child); ok && colStats.DistinctCount > 0 {
+			return (1 - colStats.NullFraction) / float64(colStats.DistinctCount), true
+		}
+	case *expression.InTuple:
+		gf, ok := e.Left().(*expression.GetField)
+		if !ok {
+			return 0, false
+		}
+		tup, ok := e.Right().(expression.Tuple)
+		if !ok {
+			return 0, false
+		}
+		if colStats, ok := columnStatistics(ctx, gf, child); ok && colStats.DistinctCount > 0 {
+			sel := float64(len(tup)) / float64(colStats.DistinctCount)
+			return (1 - colStats.NullFraction) * math.Min(1, sel), true
+		}
+	case *expression.LessThan, *expression.LessThanOrEqual, *expression.GreaterThan, *expression.GreaterThanOrEqual:
+		gf, lit, ok := columnComparedToLiteral(e.(expression.Comparer))
+		if !ok {
+			return 0, false
+		}
+		colStats, ok := columnStatistics(ctx, gf, child)
+		if !ok {
+			return 0, false
+		}
+		below, ok := fractionBelow(ctx, gf.Type(ctx), colStats.Histogram, lit.Value())
+		if !ok {
+			return 0, false
+		}
+		// a column on the right hand side of the comparison reverses it
+		_, lessThan := e.(*expression.LessThan)
+		_, lessThanOrEqual := e.(*expression.LessThanOrEqual)
+		if _, ok := e.(expression.Comparer).Left().(*expression.GetField); !ok {
+			lessThan, lessThanOrEqual = !lessThan && !lessThanOrEqual, false
+		}
+		if !lessThan && !lessThanOrEqual {
+			below = 1 - below
+		}
+		return (1 - colStats.NullFraction) * below, true
+	}
+	return 0, false
+}
+
+// columnComparedToLiteral returns the column and the literal that |cmp| compares, if it compares a column to a
+// literal.
+func columnComparedToLiteral(cmp expression.Comparer) (*expression.GetField, *expression.Literal, bool) {
+	if gf, ok := cmp.Left().(*expression.GetField); ok {
+		if lit, ok := cmp.Right().(*expression.Literal); ok {
+			return gf, lit, true
+		}
+	}
+	if gf, ok := cmp.Right().(*expression.GetField); ok {
+		if lit, ok := cmp.Left().(*expression.Literal); ok {
+			return gf, lit, true
+		}
+	}
+	return nil, nil, false
+}
+
+// fractionBelow estimates the fraction of the non-NULL values described by |hist| that are less than |val|. Every
+// bucket whose upper bound is less than |val| is counted in full, and the first bucket whose upper bound isn't is
+// counted by half.
+func fractionBelow(ctx *sql.Context, typ sql.Type, hist sql.Histogram, val interface{}) (float64, bool) {
+	if len(hist) == 0 || val == nil {
+		return 0, false
+	}
+	var total, below float64
+	var passed bool
+	for _, b := range hist {
+		rows := float64(b.RowCount())
+		total += rows
+		if passed {
+			continue
+		}
+		bound := b.UpperBound()
+		if len(bound) == 0 {
+			return 0, false
+		}
+		cmp, err := typ.Compare(ctx, bound[0], val)
+		if err != nil {
+			return 0, false
+		}
+		if cmp < 0 {
+			below += rows
+		} else {
+			below += rows / 2
+			passed = true
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	return below / total, true
+}
+
+// joinSelectivity estimates the fraction of the pairs of rows of |left| and |right| that satisfy every equality in
+// |filters| between a column of each, using the statistics of the tables that implement sql.StatisticsProvider. As
+// in the heuristic estimate, each value of the column with fewer distinct values is assumed to match a value of the
+// other. It returns false if none of the filters can be estimated.
+func joinSelectivity(ctx *sql.Context, left, right *ExprGroup, filters []sql.Expression) (float64, bool) {
+	sel := 1.0
+	var estimated bool
+	for _, f := range filters {
+		eq, ok := f.(*expression.Equals)
+		if !ok {
+			continue
+		}
+		l, ok := eq.Left().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		r, ok := eq.Right().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		lStats, lok := columnStatistics(ctx, l, left, right)
+		rStats, rok := columnStatistics(ctx, r, left, right)
+		if !lok || !rok {
+			continue
+		}
+		distinct := math.Max(float64(lStats.DistinctCount), float64(rStats.DistinctCount))
+		if distinct == 0 {
+			continue
+		}
+		sel *= (1 - lStats.NullFraction) * (1 - rStats.NullFraction) / distinct
+		estimated = true
+	}
+	return sel, estimated
+}
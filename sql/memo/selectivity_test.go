@@ -0,0 +1,138 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/stats"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestFilterSelectivity(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	x := expression.NewGetFieldWithTable(0, 1, types.Int64, "", "dummy", "x", true)
+	y := expression.NewGetFieldWithTable(1, 1, types.Int64, "", "dummy", "y", true)
+
+	tests := []struct {
+		name      string
+		filters   []sql.Expression
+		sel       float64
+		estimated bool
+	}{
+		{
+			name:      "equality",
+			filters:   []sql.Expression{expression.NewEquals(x, expression.NewLiteral(int64(5), types.Int64))},
+			sel:       .2,
+			estimated: true,
+		},
+		{
+			name:      "is null",
+			filters:   []sql.Expression{expression.NewIsNull(x)},
+			sel:       .2,
+			estimated: true,
+		},
+		{
+			name:      "is not null",
+			filters:   []sql.Expression{expression.NewNot(expression.NewIsNull(x))},
+			sel:       .8,
+			estimated: true,
+		},
+		{
+			name:      "less than",
+			filters:   []sql.Expression{expression.NewLessThan(x, expression.NewLiteral(int64(25), types.Int64))},
+			sel:       .8 * (1 + 1 + .5) / 4,
+			estimated: true,
+		},
+		{
+			name:      "greater than with column on the right",
+			filters:   []sql.Expression{expression.NewGreaterThan(expression.NewLiteral(int64(25), types.Int64), x)},
+			sel:       .8 * (1 + 1 + .5) / 4,
+			estimated: true,
+		},
+		{
+			name: "conjunction with a column without statistics",
+			filters: []sql.Expression{
+				expression.NewEquals(x, expression.NewLiteral(int64(5), types.Int64)),
+				expression.NewEquals(y, expression.NewLiteral(int64(5), types.Int64)),
+			},
+			sel:       .2,
+			estimated: true,
+		},
+		{
+			name:      "column without statistics",
+			filters:   []sql.Expression{expression.NewEquals(y, expression.NewLiteral(int64(5), types.Int64))},
+			estimated: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grp := newExprGroup(ctx, NewMemo(nil, nil, nil, nil, nil), 1, &TableScan{
+				sourceBase: &sourceBase{relBase: &relBase{}},
+				Table: plan.NewResolvedTable(
+					&statisticsTable{
+						dummyTable: &dummyTable{
+							schema: sql.NewPrimaryKeySchema(sql.Schema{
+								{Name: "x", Source: "dummy", Type: types.Int64, Nullable: true},
+								{Name: "y", Source: "dummy", Type: types.Int64, Nullable: true},
+							}),
+						},
+						rowCount: 100,
+						columns: map[string]sql.ColumnStatistics{
+							"x": {
+								DistinctCount: 4,
+								NullFraction:  .2,
+								Histogram: sql.Histogram{
+									stats.NewHistogramBucket(20, 1, 0, 1, sql.Row{int64(10)}, nil, nil),
+									stats.NewHistogramBucket(20, 1, 0, 1, sql.Row{int64(20)}, nil, nil),
+									stats.NewHistogramBucket(20, 1, 0, 1, sql.Row{int64(30)}, nil, nil),
+									stats.NewHistogramBucket(20, 1, 0, 1, sql.Row{int64(40)}, nil, nil),
+								},
+							},
+						},
+					}, nil, nil).WithId(1).WithColumns(sql.NewColSet(1, 2)),
+			})
+
+			sel, ok := filterSelectivity(ctx, grp, tt.filters)
+			require.Equal(t, tt.estimated, ok)
+			if tt.estimated {
+				require.InDelta(t, tt.sel, sel, 1e-9)
+			}
+		})
+	}
+}
+
+type statisticsTable struct {
+	*dummyTable
+	rowCount uint64
+	columns  map[string]sql.ColumnStatistics
+}
+
+var _ sql.StatisticsProvider = (*statisticsTable)(nil)
+
+func (t *statisticsTable) EstimatedRowCount(*sql.Context) (uint64, bool, error) {
+	return t.rowCount, true, nil
+}
+
+func (t *statisticsTable) ColumnStatistics(_ *sql.Context, column string) (sql.ColumnStatistics, bool, error) {
+	colStats, ok := t.columns[column]
+	return colStats, ok, nil
+}
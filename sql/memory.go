@@ -140,8 +140,10 @@ func HasAvailableMemory(r Reporter) bool {
 type MemoryManager struct {
 	reporter Reporter
 	caches   map[uint64]Disposable
-	token    uint64
-	mu       sync.RWMutex
+	// queries accounts for the memory buffered by every query using this manager
+	queries *MemoryTracker
+	token   uint64
+	mu      sync.RWMutex
 }
 
 // NewMemoryManager creates a new manager with the given memory reporter. If nil is given,
@@ -154,9 +156,27 @@ func NewMemoryManager(r Reporter) *MemoryManager {
 	return &MemoryManager{
 		reporter: r,
 		caches:   make(map[uint64]Disposable),
+		queries:  NewMemoryTracker("server", nil, 0),
 	}
 }
 
+// SetQueryMemoryLimit sets the number of bytes that the queries using this manager may buffer at once, across all
+// sessions. A query that would take them past the limit fails with ErrOutOfResources. A limit of 0 removes the limit.
+func (m *MemoryManager) SetQueryMemoryLimit(limit int64) {
+	m.queries.SetLimit(limit)
+}
+
+// QueryMemory returns the tracker of the memory buffered by all the queries using this manager.
+func (m *MemoryManager) QueryMemory() *MemoryTracker {
+	return m.queries
+}
+
+// NewQueryTracker returns a tracker for the memory buffered by a single query, which may buffer up to |limit| bytes,
+// or an unlimited amount if |limit| is 0. Its usage also counts towards the limit of the manager.
+func (m *MemoryManager) NewQueryTracker(limit int64) *MemoryTracker {
+	return NewMemoryTracker("query", m.queries, limit)
+}
+
 // HasAvailable reports whether the memory manager has any available memory.
 func (m *MemoryManager) HasAvailable() bool {
 	return HasAvailableMemory(m.reporter)
@@ -179,7 +199,7 @@ func (m *MemoryManager) NewLRUCache(ctx *Context, size uint) (KeyValueCache, Dis
 // NewHistoryCache returns an empty history cache and a function to dispose it when it's
 // no longer needed.
 func (m *MemoryManager) NewHistoryCache(ctx *Context) (KeyValueCache, DisposeFunc) {
-	c := newHistoryCache(m, m.reporter)
+	c := newHistoryCache(m, m.reporter, ctx.MemoryTracker())
 	pos := m.addCache(c)
 	return c, func() {
 		c.Dispose(ctx)
@@ -190,7 +210,7 @@ func (m *MemoryManager) NewHistoryCache(ctx *Context) (KeyValueCache, DisposeFun
 // NewRowsCache returns an empty rows cache and a function to dispose it when it's
 // no longer needed.
 func (m *MemoryManager) NewRowsCache(ctx *Context) (RowsCache, DisposeFunc) {
-	c := newRowsCache(m, m.reporter)
+	c := newRowsCache(m, m.reporter, ctx.MemoryTracker())
 	pos := m.addCache(c)
 	return c, func() {
 		c.Dispose(ctx)
@@ -201,7 +221,7 @@ func (m *MemoryManager) NewRowsCache(ctx *Context) (RowsCache, DisposeFunc) {
 // NewRows2Cache returns an empty rows cache and a function to dispose it when it's
 // no longer needed.
 func (m *MemoryManager) NewRows2Cache(ctx *Context) (ValueRowsCache, DisposeFunc) {
-	c := newRowsCache(m, m.reporter)
+	c := newRowsCache(m, m.reporter, ctx.MemoryTracker())
 	pos := m.addCache(c)
 	return c, func() {
 		c.Dispose(ctx)
@@ -92,3 +92,62 @@ func (m mockMemory) Free() {
 		m.f()
 	}
 }
+
+func TestMemoryTracker(t *testing.T) {
+	require := require.New(t)
+	server := NewMemoryTracker("server", nil, 100)
+	q1 := NewMemoryTracker("query", server, 60)
+	q2 := NewMemoryTracker("query", server, 0)
+
+	require.NoError(q1.Grow(50))
+	require.Equal(int64(50), server.Used())
+
+	// the query limit is exceeded
+	err := q1.Grow(20)
+	require.True(ErrOutOfResources.Is(err))
+	require.Equal(int64(50), q1.Used())
+
+	// the server limit is exceeded, and nothing is charged to the query
+	require.NoError(q2.Grow(40))
+	err = q2.Grow(20)
+	require.True(ErrOutOfResources.Is(err))
+	require.Equal(int64(40), q2.Used())
+	require.Equal(int64(90), server.Used())
+
+	q1.Shrink(30)
+	require.Equal(int64(20), q1.Used())
+	require.Equal(int64(50), q1.Peak())
+	require.Equal(int64(60), server.Used())
+
+	q2.Release()
+	require.Equal(int64(0), q2.Used())
+	require.Equal(int64(20), server.Used())
+
+	// a nil tracker tracks nothing
+	var nilTracker *MemoryTracker
+	require.NoError(nilTracker.Grow(1000))
+	nilTracker.Shrink(1000)
+	require.Equal(int64(0), nilTracker.Used())
+}
+
+func TestRowsCacheMemoryTracking(t *testing.T) {
+	require := require.New(t)
+	m := NewMemoryManager(fixedReporter(5, 50))
+	ctx := NewEmptyContext()
+	tracker := m.NewQueryTracker(0)
+	ctx.SetMemoryTracker(tracker)
+
+	rc, dispose := m.NewRowsCache(ctx)
+	require.NoError(rc.Add(Row{1, "foo"}))
+	require.Equal(EstimateRowSize(Row{1, "foo"}), tracker.Used())
+	require.Equal(tracker.Used(), m.QueryMemory().Used())
+	dispose()
+	require.Equal(int64(0), tracker.Used())
+	require.Equal(int64(0), m.QueryMemory().Used())
+
+	tracker.SetLimit(1)
+	rc, dispose = m.NewRowsCache(ctx)
+	defer dispose()
+	require.True(ErrOutOfResources.Is(rc.Add(Row{1, "foo"})))
+	require.Len(rc.Get(), 0)
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// QueryMemoryLimitSessionVar is the session variable that limits the number of bytes a single query may buffer. A
+// value of 0 means that queries are only limited by the server-wide limit of their MemoryManager.
+const QueryMemoryLimitSessionVar = "query_memory_limit"
+
+// MemoryTracker accounts for the memory buffered by the operators of a query, such as the rows held by sorts, hash
+// aggregations and joins, and the results spooled by the server. Operators charge a tracker with Grow before they
+// buffer data, and credit it with Shrink once they release it. A tracker with a parent charges the parent as well, so
+// that the usage of every query is also accounted for by the server-wide tracker of its MemoryManager.
+//
+// Accounting is approximate: it is meant to stop a runaway query before it exhausts the memory of the process, not to
+// measure its exact footprint. All methods may be called on a nil tracker, which tracks nothing.
+type MemoryTracker struct {
+	parent *MemoryTracker
+	// name identifies the tracker in the error returned when its limit is exceeded
+	name  string
+	mu    sync.Mutex
+	limit int64
+	used  int64
+	peak  int64
+}
+
+// NewMemoryTracker returns a new tracker that charges its usage to |parent|, if it's not nil, and that refuses to grow
+// past |limit| bytes, unless |limit| is 0.
+func NewMemoryTracker(name string, parent *MemoryTracker, limit int64) *MemoryTracker {
+	return &MemoryTracker{parent: parent, name: name, limit: limit}
+}
+
+// Grow charges |bytes| to the tracker and its ancestors. If that would take any of them past its limit, nothing is
+// charged and ErrOutOfResources is returned.
+func (t *MemoryTracker) Grow(bytes int64) error {
+	if t == nil || bytes <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	if t.limit > 0 && t.used+bytes > t.limit {
+		t.mu.Unlock()
+		return ErrOutOfResources.New(t.name, t.limit)
+	}
+	t.used += bytes
+	if t.used > t.peak {
+		t.peak = t.used
+	}
+	t.mu.Unlock()
+
+	if err := t.parent.Grow(bytes); err != nil {
+		t.mu.Lock()
+		t.used -= bytes
+		t.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Shrink credits |bytes| previously charged with Grow back to the tracker and its ancestors.
+func (t *MemoryTracker) Shrink(bytes int64) {
+	if t == nil || bytes <= 0 {
+		return
+	}
+	t.mu.Lock()
+	if bytes > t.used {
+		bytes = t.used
+	}
+	t.used -= bytes
+	t.mu.Unlock()
+	t.parent.Shrink(bytes)
+}
+
+// Release credits everything charged to the tracker back to its ancestors. It's called once the query the tracker
+// accounts for has finished.
+func (t *MemoryTracker) Release() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	used := t.used
+	t.used = 0
+	t.mu.Unlock()
+	t.parent.Shrink(used)
+}
+
+// Used returns the number of bytes currently charged to the tracker.
+func (t *MemoryTracker) Used() int64 {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.used
+}
+
+// Peak returns the largest number of bytes ever charged to the tracker at once.
+func (t *MemoryTracker) Peak() int64 {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.peak
+}
+
+// Limit returns the number of bytes the tracker may grow to, or 0 if it has no limit.
+func (t *MemoryTracker) Limit() int64 {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.limit
+}
+
+// SetLimit sets the number of bytes the tracker may grow to. A limit of 0 removes the limit. Memory already charged
+// is not affected.
+func (t *MemoryTracker) SetLimit(limit int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limit = limit
+}
+
+const (
+	// valueOverhead is the approximate size of a value stored in a Row: its interface header
+	valueOverhead = int64(unsafe.Sizeof(interface{}(nil)))
+	// defaultValueSize is the approximate size of the data referenced by a value of a type with no better estimate
+	defaultValueSize = 16
+)
+
+// EstimateRowSize returns the approximate number of bytes that buffering |row| holds in memory.
+func EstimateRowSize(row Row) int64 {
+	size := int64(unsafe.Sizeof(row))
+	for _, v := range row {
+		size += EstimateValueSize(v)
+	}
+	return size
+}
+
+// EstimateValueRowSize returns the approximate number of bytes that buffering |row| holds in memory.
+func EstimateValueRowSize(row ValueRow) int64 {
+	size := int64(unsafe.Sizeof(row))
+	for _, v := range row {
+		size += int64(unsafe.Sizeof(v)) + int64(len(v.Val))
+	}
+	return size
+}
+
+// EstimateValueSize returns the approximate number of bytes that buffering |v| holds in memory.
+func EstimateValueSize(v interface{}) int64 {
+	switch v := v.(type) {
+	case nil:
+		return valueOverhead
+	case string:
+		return valueOverhead + int64(len(v))
+	case []byte:
+		return valueOverhead + int64(cap(v))
+	case Row:
+		return valueOverhead + EstimateRowSize(v)
+	case []interface{}:
+		return valueOverhead + EstimateRowSize(v)
+	case bool, int8, uint8, int16, uint16, int32, uint32, int64, uint64, int, uint, float32, float64:
+		return valueOverhead
+	default:
+		return valueOverhead + defaultValueSize
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"sync"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
@@ -43,7 +44,12 @@ const DefaultAuthMethod = mysql.MysqlNativePassword
 // on the mysql_clear_password plugin, that integrators can use to provide extended authentication options,
 // through the use of registering PlaintextAuthPlugins with MySQLDb.
 type authServer struct {
+	// mu guards authMethods and pluginMethods. Auth methods may be added while listeners read them, so authMethods is
+	// replaced rather than changed when a method is added.
+	mu          sync.RWMutex
 	authMethods []mysql.AuthMethod
+	// pluginMethods are the indexes in authMethods of the methods added by AddAuthMethod, by plugin name
+	pluginMethods map[string]int
 }
 
 var _ mysql.AuthServer = (*authServer)(nil)
@@ -80,9 +86,37 @@ func newAuthServer(db *MySQLDb) *authServer {
 
 // AuthMethods implements the mysql.AuthServer interface.
 func (as *authServer) AuthMethods() []mysql.AuthMethod {
+	as.mu.RLock()
+	defer as.mu.RUnlock()
 	return as.authMethods
 }
 
+// addPluginMethod adds |method|, the auth method of the plugin |plugin|, replacing the method the plugin already has.
+func (as *authServer) addPluginMethod(plugin string, method mysql.AuthMethod) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	methods := make([]mysql.AuthMethod, len(as.authMethods), len(as.authMethods)+1)
+	copy(methods, as.authMethods)
+	if i, ok := as.pluginMethods[plugin]; ok {
+		methods[i] = method
+	} else {
+		if as.pluginMethods == nil {
+			as.pluginMethods = make(map[string]int)
+		}
+		as.pluginMethods[plugin] = len(methods)
+		methods = append(methods, method)
+	}
+	as.authMethods = methods
+}
+
+// hasPluginMethod returns whether an auth method was added for the plugin |plugin|.
+func (as *authServer) hasPluginMethod(plugin string) bool {
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+	_, ok := as.pluginMethods[plugin]
+	return ok
+}
+
 // DefaultAuthMethodDescription implements the mysql.AuthServer interface.
 func (db *authServer) DefaultAuthMethodDescription() mysql.AuthMethodDescription {
 	return DefaultAuthMethod
@@ -139,11 +173,15 @@ func (n noopCachingStorage) UserEntryWithCacheHash(conn *mysql.Conn, _ []byte, u
 		}
 
 		// validate any extra connection security requirements, such as SSL or a client cert
-		if err = validateConnectionSecurity(userEntry, conn); err != nil {
+		if err = ValidateConnectionSecurity(userEntry, conn); err != nil {
 			return nil, mysql.AuthRejected, err
 		}
 
-		if userEntry.AuthString == "" {
+		authenticated := userEntry.AuthString == ""
+		if err = db.RecordLogin(userEntry, authenticated); err != nil {
+			return nil, mysql.AuthRejected, err
+		}
+		if authenticated {
 			return sql.MysqlConnectionUser{User: user, Host: host}, mysql.AuthAccepted, nil
 		} else {
 			return nil, mysql.AuthRejected, nil
@@ -196,10 +234,11 @@ func (s sha2PlainTextStorage) UserEntryWithPassword(conn *mysql.Conn, user strin
 	}
 
 	// validate any extra connection security requirements, such as SSL or a client cert
-	if err = validateConnectionSecurity(userEntry, conn); err != nil {
+	if err = ValidateConnectionSecurity(userEntry, conn); err != nil {
 		return nil, err
 	}
 
+	var authenticated bool
 	if len(userEntry.AuthString) > 0 {
 		digestType, iterations, salt, _, err := mysql.DeserializeCachingSha2PasswordAuthString([]byte(userEntry.AuthString))
 		if err != nil {
@@ -214,13 +253,16 @@ func (s sha2PlainTextStorage) UserEntryWithPassword(conn *mysql.Conn, user strin
 		if err != nil {
 			return nil, err
 		}
-
-		if userEntry.AuthString != string(authString) {
-			return nil, newAccessDeniedError(user)
-		}
-	} else if len(password) > 0 {
+		authenticated = userEntry.AuthString == string(authString)
+	} else {
 		// password is nil or empty, therefore no password is set
 		// a password was given and the account has no password set, therefore access is denied
+		authenticated = len(password) == 0
+	}
+	if err = db.RecordLogin(userEntry, authenticated); err != nil {
+		return nil, err
+	}
+	if !authenticated {
 		return nil, newAccessDeniedError(user)
 	}
 
@@ -279,9 +321,16 @@ func (f extendedAuthPlainTextStorage) UserEntryWithPassword(conn *mysql.Conn, us
 
 	authed, err := authplugin.Authenticate(db, user, userEntry, password)
 	if err != nil {
+		// a plugin that fails to authenticate the user counts as a failed login
+		if err := db.RecordLogin(userEntry, false); err != nil {
+			return nil, err
+		}
 		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError,
 			"Access denied for user '%v': %v", user, err)
 	}
+	if err = db.RecordLogin(userEntry, authed); err != nil {
+		return nil, err
+	}
 	if !authed {
 		return nil, newAccessDeniedError(user)
 	}
@@ -359,28 +408,35 @@ func (nphs *nativePasswordHashStorage) UserEntryWithHash(conn *mysql.Conn, salt
 	}
 
 	// validate any extra connection security requirements, such as SSL or a client cert
-	if err = validateConnectionSecurity(userEntry, conn); err != nil {
+	if err = ValidateConnectionSecurity(userEntry, conn); err != nil {
 		return nil, err
 	}
 
+	var authenticated bool
 	if len(userEntry.AuthString) > 0 {
-		if !validateMysqlNativePassword(authResponse, salt, userEntry.AuthString) {
-			return nil, newAccessDeniedError(user)
-		}
-	} else if len(authResponse) > 0 {
+		authenticated = validateMysqlNativePassword(authResponse, salt, userEntry.AuthString)
+	} else {
 		// password is nil or empty, therefore no password is set
 		// a password was given and the account has no password set, therefore access is denied
+		authenticated = len(authResponse) == 0
+	}
+	if err = db.RecordLogin(userEntry, authenticated); err != nil {
+		return nil, err
+	}
+	if !authenticated {
 		return nil, newAccessDeniedError(user)
 	}
 
 	return sql.MysqlConnectionUser{User: userEntry.User, Host: userEntry.Host}, nil
 }
 
-// validateConnectionSecurity examines the security properties of |conn| (e.g. TLS,
+// ValidateConnectionSecurity examines the security properties of |conn| (e.g. TLS,
 // selected cipher, X509 client certs) and validates specific connection properties
-// based on what |userEntry| has configured. An error is returned if any validation
-// issues were detected, otherwise nil is returned.
-func validateConnectionSecurity(userEntry *User, conn *mysql.Conn) error {
+// based on what |userEntry| has configured. The requirements hold whatever the auth
+// plugin of |userEntry| is, so auth methods added with AddAuthMethod check them as
+// well before authenticating. An error is returned if any validation issues were
+// detected, otherwise nil is returned.
+func ValidateConnectionSecurity(userEntry *User, conn *mysql.Conn) error {
 	switch userEntry.SslType {
 	case "":
 		// No connection security validation needed
@@ -540,6 +596,26 @@ func (decoyAuthSubject) Allows(method mysql.AuthMethodDescription) bool {
 	return method == DefaultAuthMethod
 }
 
+// AddAuthMethod adds |method| to the auth methods that are negotiated with clients. The method authenticates the
+// accounts whose plugin is |plugin|, and accounts may be created with |plugin| once it's added. A plugin has one auth
+// method, so adding another replaces it, and the servers that share this MySQLDb authenticate the plugin's accounts
+// with the method that was added last.
+func (db *MySQLDb) AddAuthMethod(plugin string, method mysql.AuthMethod) {
+	db.authServer.addPluginMethod(plugin, method)
+}
+
+// ConnectionAccount returns the account that |user| logs in as over |conn|, along with the host of the connection.
+// The account is nil if there is no account that matches.
+func (db *MySQLDb) ConnectionAccount(conn *mysql.Conn, user string) (*User, string, error) {
+	host, err := extractHostAddress(conn.RemoteAddr())
+	if err != nil {
+		return nil, "", err
+	}
+	rd := db.Reader()
+	defer rd.Close()
+	return db.GetUser(rd, user, host, false), host, nil
+}
+
 // extractHostAddress extracts the host address from |addr|, checking to see if it is a unix socket, and if
 // so, returning "localhost" as the host.
 func extractHostAddress(addr net.Addr) (host string, err error) {
@@ -0,0 +1,82 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"encoding/json"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+)
+
+// DefaultRole represents a default role of a user from the default_roles Grant Table. The default roles of a user are
+// the roles that are active when they log in, unless activate_all_roles_on_login is set.
+type DefaultRole struct {
+	Host     string
+	User     string
+	RoleHost string
+	RoleUser string
+}
+
+func DefaultRoleToRow(ctx *sql.Context, r *DefaultRole) (sql.Row, error) {
+	row := make(sql.Row, len(defaultRolesTblSchema))
+	row[defaultRolesTblColIndex_HOST] = r.Host
+	row[defaultRolesTblColIndex_USER] = r.User
+	row[defaultRolesTblColIndex_DEFAULT_ROLE_HOST] = r.RoleHost
+	row[defaultRolesTblColIndex_DEFAULT_ROLE_USER] = r.RoleUser
+	return row, nil
+}
+
+func DefaultRoleFromRow(ctx *sql.Context, row sql.Row) (*DefaultRole, error) {
+	if err := defaultRolesTblSchema.CheckRow(ctx, row); err != nil {
+		return nil, err
+	}
+	return &DefaultRole{
+		Host:     row[defaultRolesTblColIndex_HOST].(string),
+		User:     row[defaultRolesTblColIndex_USER].(string),
+		RoleHost: row[defaultRolesTblColIndex_DEFAULT_ROLE_HOST].(string),
+		RoleUser: row[defaultRolesTblColIndex_DEFAULT_ROLE_USER].(string),
+	}, nil
+}
+
+func DefaultRoleEquals(left, right *DefaultRole) bool {
+	return *left == *right
+}
+
+var DefaultRoleOps = in_mem_table.ValueOps[*DefaultRole]{
+	ToRow:   DefaultRoleToRow,
+	FromRow: DefaultRoleFromRow,
+	UpdateWithRow: func(ctx *sql.Context, row sql.Row, e *DefaultRole) (*DefaultRole, error) {
+		return DefaultRoleFromRow(ctx, row)
+	},
+}
+
+// FromJson implements the interface in_mem_table.Entry.
+func (r *DefaultRole) FromJson(ctx *sql.Context, jsonStr string) (*DefaultRole, error) {
+	newDefaultRole := &DefaultRole{}
+	if err := json.Unmarshal([]byte(jsonStr), newDefaultRole); err != nil {
+		return nil, err
+	}
+	return newDefaultRole, nil
+}
+
+// ToJson implements the interface in_mem_table.Entry.
+func (r *DefaultRole) ToJson(ctx *sql.Context) (string, error) {
+	jsonData, err := json.Marshal(*r)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonData), nil
+}
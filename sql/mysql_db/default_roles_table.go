@@ -0,0 +1,140 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"sync"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const defaultRolesTblName = "default_roles"
+
+var defaultRolesTblSchema sql.Schema
+
+// DefaultRolesPrimaryKey is a key that represents the primary key for the "default_roles" Grant Table.
+type DefaultRolesPrimaryKey struct {
+	Host     string
+	User     string
+	RoleHost string
+	RoleUser string
+}
+
+// DefaultRolesUserKey is a secondary key that represents the user columns on the "default_roles" Grant Table.
+type DefaultRolesUserKey struct {
+	Host string
+	User string
+}
+
+// DefaultRolesRoleKey is a secondary key that represents the role columns on the "default_roles" Grant Table.
+type DefaultRolesRoleKey struct {
+	RoleHost string
+	RoleUser string
+}
+
+type DefaultRolePrimaryKeyer struct{}
+type DefaultRoleUserKeyer struct{}
+type DefaultRoleRoleKeyer struct{}
+
+var _ in_mem_table.Keyer[*DefaultRole] = DefaultRolePrimaryKeyer{}
+var _ in_mem_table.Keyer[*DefaultRole] = DefaultRoleUserKeyer{}
+var _ in_mem_table.Keyer[*DefaultRole] = DefaultRoleRoleKeyer{}
+
+func (DefaultRolePrimaryKeyer) GetKey(r *DefaultRole) any {
+	return DefaultRolesPrimaryKey{
+		Host:     r.Host,
+		User:     r.User,
+		RoleHost: r.RoleHost,
+		RoleUser: r.RoleUser,
+	}
+}
+
+func (DefaultRoleUserKeyer) GetKey(r *DefaultRole) any {
+	return DefaultRolesUserKey{
+		Host: r.Host,
+		User: r.User,
+	}
+}
+
+func (DefaultRoleRoleKeyer) GetKey(r *DefaultRole) any {
+	return DefaultRolesRoleKey{
+		RoleHost: r.RoleHost,
+		RoleUser: r.RoleUser,
+	}
+}
+
+func NewDefaultRolesIndexedSetTable(lock, rlock sync.Locker) *in_mem_table.IndexedSetTable[*DefaultRole] {
+	set := in_mem_table.NewIndexedSet[*DefaultRole](
+		DefaultRoleEquals,
+		[]in_mem_table.Keyer[*DefaultRole]{
+			DefaultRolePrimaryKeyer{},
+			DefaultRoleUserKeyer{},
+			DefaultRoleRoleKeyer{},
+		},
+	)
+	return in_mem_table.NewIndexedSetTable[*DefaultRole](
+		defaultRolesTblName,
+		defaultRolesTblSchema,
+		sql.Collation_utf8mb3_bin,
+		set,
+		DefaultRoleOps,
+		lock,
+		rlock,
+	)
+}
+
+// init creates the schema for the "default_roles" Grant Table.
+func init() {
+	// Types
+	char32_utf8_bin := types.MustCreateString(sqltypes.Char, 32, sql.Collation_utf8_bin)
+	char255_ascii_general_ci := types.MustCreateString(sqltypes.Char, 255, sql.Collation_ascii_general_ci)
+
+	// Column Templates
+	char32_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     char32_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", char32_utf8_bin), char32_utf8_bin, true, false),
+		Nullable: false,
+	}
+	char255_ascii_general_ci_not_null_default_empty := &sql.Column{
+		Type:     char255_ascii_general_ci,
+		Default:  mustDefault(expression.NewLiteral("", char255_ascii_general_ci), char255_ascii_general_ci, true, false),
+		Nullable: false,
+	}
+	char255_ascii_general_ci_not_null_default_percent := &sql.Column{
+		Type:     char255_ascii_general_ci,
+		Default:  mustDefault(expression.NewLiteral("%", char255_ascii_general_ci), char255_ascii_general_ci, true, false),
+		Nullable: false,
+	}
+
+	defaultRolesTblSchema = sql.Schema{
+		columnTemplate("HOST", defaultRolesTblName, true, char255_ascii_general_ci_not_null_default_empty),
+		columnTemplate("USER", defaultRolesTblName, true, char32_utf8_bin_not_null_default_empty),
+		columnTemplate("DEFAULT_ROLE_HOST", defaultRolesTblName, true, char255_ascii_general_ci_not_null_default_percent),
+		columnTemplate("DEFAULT_ROLE_USER", defaultRolesTblName, true, char32_utf8_bin_not_null_default_empty),
+	}
+}
+
+// These represent the column indexes of the "default_roles" Grant Table.
+const (
+	defaultRolesTblColIndex_HOST int = iota
+	defaultRolesTblColIndex_USER
+	defaultRolesTblColIndex_DEFAULT_ROLE_HOST
+	defaultRolesTblColIndex_DEFAULT_ROLE_USER
+)
@@ -0,0 +1,71 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ParseDefiner splits |definer|, an account name such as `user`@`host` as it's stored with views, routines, triggers
+// and events, into its user and host. The host is % when it's left out.
+func ParseDefiner(definer string) (user string, host string) {
+	parts := splitUnquoted(definer, '@')
+	user, host = unquoteRolePart(parts[0]), "%"
+	if len(parts) > 1 {
+		host = unquoteRolePart(strings.Join(parts[1:], "@"))
+	}
+	return user, host
+}
+
+// GetDefiner returns the account named by |definer|. Unlike GetUser, a definer never matches the anonymous user, as it
+// always names an account rather than a client. Returns nil if the account doesn't exist.
+func (db *MySQLDb) GetDefiner(fetcher UserFetcher, definer string) *User {
+	user, host := ParseDefiner(definer)
+	if account := db.GetUser(fetcher, user, host, false); account != nil && account.User == user {
+		return account
+	}
+	return nil
+}
+
+// DefinerPrivilegeSet returns the privileges that the statements of a view, routine or trigger with SQL SECURITY
+// DEFINER run with: those of the account named by |definer|, along with those of the roles that are active when the
+// account logs in. Returns false if the account doesn't exist.
+func (db *MySQLDb) DefinerPrivilegeSet(ctx *sql.Context, definer string) (PrivilegeSet, bool) {
+	rd := db.Reader()
+	defer rd.Close()
+
+	user := db.GetDefiner(rd, definer)
+	if user == nil {
+		return PrivilegeSet{}, false
+	}
+	privSet := user.PrivilegeSet.Copy()
+	for _, role := range db.RoleClosure(rd, db.LoginRoles(ctx, rd, user)) {
+		privSet.UnionWith(role.PrivilegeSet)
+	}
+	return privSet, true
+}
+
+// Impersonate replaces the privileges of the session with |privSet| until the returned function is called, so that
+// the statements that run in the meantime are authorized against |privSet| rather than the privileges of the session's
+// user.
+func (db *MySQLDb) Impersonate(ctx *sql.Context, privSet PrivilegeSet) func() {
+	initialPrivSet, initialCounter := ctx.Session.GetPrivilegeSet()
+	ctx.Session.SetPrivilegeSet(privSet, db.updateCounter.Load())
+	return func() {
+		ctx.Session.SetPrivilegeSet(initialPrivSet, initialCounter)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+)
+
+// erUserLockWrongPassword is the error code of a login to an account that has been locked by failed logins.
+const erUserLockWrongPassword = 3955
+
+// failedLogins tracks the consecutive failed logins of accounts that have FailedLoginAttempts and PasswordLockTime set,
+// along with the accounts that they have temporarily locked. As in MySQL, this state isn't persisted, so restarting
+// the server unlocks every account.
+type failedLogins struct {
+	mu       sync.Mutex
+	accounts map[UserPrimaryKey]*failedLoginState
+}
+
+// failedLoginState is the failed-login tracking state of an account.
+type failedLoginState struct {
+	attempts uint16
+	locked   bool
+	lockedAt time.Time
+	// lockDays is the number of days the account was locked for, which is PasswordLockTimeUnbounded when it stays
+	// locked until it's unlocked.
+	lockDays int16
+}
+
+// lockedUntil returns the time that the account is unlocked at, and false if it stays locked until it's unlocked.
+func (s *failedLoginState) lockedUntil() (time.Time, bool) {
+	if s.lockDays == PasswordLockTimeUnbounded {
+		return time.Time{}, false
+	}
+	return s.lockedAt.Add(time.Duration(s.lockDays) * 24 * time.Hour), true
+}
+
+// AccountLock is the failed-login tracking state of an account, as shown by performance_schema.account_locks.
+type AccountLock struct {
+	User string
+	Host string
+	// FailedLogins is the number of consecutive failed logins of the account.
+	FailedLogins uint16
+	Locked       bool
+	// LockedUntil is the time that a locked account is unlocked at, and is the zero time for an account that stays
+	// locked until it's unlocked.
+	LockedUntil time.Time
+}
+
+// RecordLogin records a login to the account |user|, which was |authenticated| or not, and returns an error if the
+// account is temporarily locked by failed logins, in which case the login must be rejected even if it was
+// authenticated. A failed login that reaches the FailedLoginAttempts of the account locks it. Logins are only tracked
+// for accounts that have both FailedLoginAttempts and PasswordLockTime set.
+func (db *MySQLDb) RecordLogin(user *User, authenticated bool) error {
+	if user.FailedLoginAttempts == 0 || user.PasswordLockTime == 0 {
+		return nil
+	}
+
+	fl := &db.failedLogins
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	key := UserPrimaryKey{Host: user.Host, User: user.User}
+	state := fl.accounts[key]
+	now := time.Now()
+	if state != nil && state.locked {
+		if until, ok := state.lockedUntil(); !ok || now.Before(until) {
+			return newAccountLockedError(user, state, now)
+		}
+		// the lock has expired, so the login is counted as though there were no failed logins before it
+		state = nil
+		delete(fl.accounts, key)
+	}
+
+	if authenticated {
+		delete(fl.accounts, key)
+		return nil
+	}
+	if state == nil {
+		if fl.accounts == nil {
+			fl.accounts = make(map[UserPrimaryKey]*failedLoginState)
+		}
+		state = &failedLoginState{}
+		fl.accounts[key] = state
+	}
+	state.attempts++
+	if state.attempts >= user.FailedLoginAttempts {
+		state.locked = true
+		state.lockedAt = now
+		state.lockDays = user.PasswordLockTime
+		return newAccountLockedError(user, state, now)
+	}
+	return nil
+}
+
+// ResetFailedLogins clears the failed logins of the account |user|@|host|, unlocking it if they've locked it.
+func (db *MySQLDb) ResetFailedLogins(user, host string) {
+	db.failedLogins.mu.Lock()
+	defer db.failedLogins.mu.Unlock()
+	delete(db.failedLogins.accounts, UserPrimaryKey{Host: host, User: user})
+}
+
+// AccountLocks returns the failed-login tracking state of every account that has failed logins, or that has been
+// locked by them, ordered by user and host.
+func (db *MySQLDb) AccountLocks() []AccountLock {
+	db.failedLogins.mu.Lock()
+	defer db.failedLogins.mu.Unlock()
+	now := time.Now()
+	locks := make([]AccountLock, 0, len(db.failedLogins.accounts))
+	for key, state := range db.failedLogins.accounts {
+		lock := AccountLock{User: key.User, Host: key.Host, FailedLogins: state.attempts, Locked: state.locked}
+		if state.locked {
+			until, ok := state.lockedUntil()
+			if ok && !now.Before(until) {
+				// the lock has expired, and is cleared by the next login
+				continue
+			}
+			lock.LockedUntil = until
+		}
+		locks = append(locks, lock)
+	}
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].User != locks[j].User {
+			return locks[i].User < locks[j].User
+		}
+		return locks[i].Host < locks[j].Host
+	})
+	return locks
+}
+
+// newAccountLockedError returns the error of a login to |user|, which is locked as given by |state|.
+func newAccountLockedError(user *User, state *failedLoginState, now time.Time) error {
+	days, remaining := "unlimited", "unlimited"
+	if until, ok := state.lockedUntil(); ok {
+		days = fmt.Sprint(state.lockDays)
+		remaining = fmt.Sprint(int64((until.Sub(now) + 24*time.Hour - 1) / (24 * time.Hour)))
+	}
+	return mysql.NewSQLError(erUserLockWrongPassword, mysql.SSUnknownSQLState,
+		"Access denied for user '%s'@'%s'. Account is blocked for %s day(s) (%s day(s) remaining) due to %d consecutive failed logins.",
+		user.User, user.Host, days, remaining, state.attempts)
+}
@@ -59,6 +59,8 @@ type MySQLDb struct {
 	*authServer
 
 	role_edges          *in_mem_table.IndexedSetTable[*RoleEdge]
+	default_roles       *in_mem_table.IndexedSetTable[*DefaultRole]
+	proxies_priv        *in_mem_table.IndexedSetTable[*ProxyPriv]
 	replica_source_info *in_mem_table.IndexedSetTable[*ReplicaSourceInfo]
 	user                *in_mem_table.IndexedSetTable[*User]
 	db                  *in_mem_table.MultiIndexedSetTable[*User]
@@ -71,13 +73,18 @@ type MySQLDb struct {
 	help_keyword  *mysqlTable
 	help_category *mysqlTable
 
+	time_zone                 *mysqlTable
+	time_zone_name            *mysqlTable
+	time_zone_transition      *mysqlTable
+	time_zone_transition_type *mysqlTable
+	time_zone_leap_second     *mysqlTable
+
 	//TODO: add the rest of these tables
 	//columns_priv     *mysqlTable
-	//proxies_priv     *mysqlTable
-	//default_roles    *mysqlTable
 	//password_history *mysqlTable
 
 	plugins       map[string]PlaintextAuthPlugin
+	failedLogins  failedLogins
 	updateCounter atomic.Uint64
 	lock          sync.RWMutex
 	enabled       atomic.Bool
@@ -98,6 +105,8 @@ func CreateEmptyMySQLDb() *MySQLDb {
 	userSet, userTable := NewUserIndexedSetTable(lock, rlock)
 	mysqlDb.user = userTable
 	mysqlDb.role_edges = NewRoleEdgesIndexedSetTable(lock, rlock)
+	mysqlDb.default_roles = NewDefaultRolesIndexedSetTable(lock, rlock)
+	mysqlDb.proxies_priv = NewProxiesPrivIndexedSetTable(lock, rlock)
 	mysqlDb.replica_source_info = NewReplicaSourceInfoIndexedSetTable(lock, rlock)
 
 	// Help tables
@@ -118,6 +127,10 @@ func CreateEmptyMySQLDb() *MySQLDb {
 		helpRelationSchema,
 		mysqlDb)
 
+	// Time zone tables
+	mysqlDb.time_zone, mysqlDb.time_zone_name, mysqlDb.time_zone_transition, mysqlDb.time_zone_transition_type,
+		mysqlDb.time_zone_leap_second = newTimeZoneTables(mysqlDb)
+
 	// multi tables
 	mysqlDb.db = NewUserDBIndexedSetTable(userSet, lock, rlock)
 	mysqlDb.tables_priv = NewUserTablesIndexedSetTable(userSet, lock, rlock)
@@ -134,6 +147,8 @@ type Reader struct {
 	close             func()
 	users             in_mem_table.IndexedSet[*User]
 	roleEdges         in_mem_table.IndexedSet[*RoleEdge]
+	defaultRoles      in_mem_table.IndexedSet[*DefaultRole]
+	proxiesPriv       in_mem_table.IndexedSet[*ProxyPriv]
 	replicaSourceInfo in_mem_table.IndexedSet[*ReplicaSourceInfo]
 }
 
@@ -176,6 +191,14 @@ func (r *Reader) GetToUserRoleEdges(key RoleEdgesToKey) []*RoleEdge {
 	return r.roleEdges.GetMany(RoleEdgeToKeyer{}, key)
 }
 
+func (r *Reader) GetFromUserRoleEdges(key RoleEdgesFromKey) []*RoleEdge {
+	return r.roleEdges.GetMany(RoleEdgeFromKeyer{}, key)
+}
+
+func (r *Reader) GetDefaultRoles(key DefaultRolesUserKey) []*DefaultRole {
+	return r.defaultRoles.GetMany(DefaultRoleUserKeyer{}, key)
+}
+
 func (r *Reader) VisitUsers(cb func(*User)) {
 	r.users.VisitEntries(cb)
 }
@@ -184,6 +207,18 @@ func (r *Reader) VisitRoleEdges(cb func(*RoleEdge)) {
 	r.roleEdges.VisitEntries(cb)
 }
 
+func (r *Reader) VisitDefaultRoles(cb func(*DefaultRole)) {
+	r.defaultRoles.VisitEntries(cb)
+}
+
+func (r *Reader) GetProxyPrivs(key ProxiesPrivUserKey) []*ProxyPriv {
+	return r.proxiesPriv.GetMany(ProxyPrivUserKeyer{}, key)
+}
+
+func (r *Reader) VisitProxyPrivs(cb func(*ProxyPriv)) {
+	r.proxiesPriv.VisitEntries(cb)
+}
+
 func (r *Reader) VisitReplicaSourceInfos(cb func(*ReplicaSourceInfo)) {
 	r.replicaSourceInfo.VisitEntries(cb)
 }
@@ -224,6 +259,14 @@ func (ed *Editor) GetToUserRoleEdges(key RoleEdgesToKey) []*RoleEdge {
 	return ed.reader.GetToUserRoleEdges(key)
 }
 
+func (ed *Editor) GetFromUserRoleEdges(key RoleEdgesFromKey) []*RoleEdge {
+	return ed.reader.GetFromUserRoleEdges(key)
+}
+
+func (ed *Editor) GetDefaultRoles(key DefaultRolesUserKey) []*DefaultRole {
+	return ed.reader.GetDefaultRoles(key)
+}
+
 func (ed *Editor) VisitUsers(cb func(*User)) {
 	ed.reader.VisitUsers(cb)
 }
@@ -232,6 +275,18 @@ func (ed *Editor) VisitRoleEdges(cb func(*RoleEdge)) {
 	ed.reader.VisitRoleEdges(cb)
 }
 
+func (ed *Editor) VisitDefaultRoles(cb func(*DefaultRole)) {
+	ed.reader.VisitDefaultRoles(cb)
+}
+
+func (ed *Editor) GetProxyPrivs(key ProxiesPrivUserKey) []*ProxyPriv {
+	return ed.reader.GetProxyPrivs(key)
+}
+
+func (ed *Editor) VisitProxyPrivs(cb func(*ProxyPriv)) {
+	ed.reader.VisitProxyPrivs(cb)
+}
+
 func (ed *Editor) VisitReplicaSourceInfos(cb func(*ReplicaSourceInfo)) {
 	ed.reader.VisitReplicaSourceInfos(cb)
 }
@@ -266,6 +321,40 @@ func (ed *Editor) RemoveRoleEdgesToKey(key RoleEdgesToKey) {
 	ed.reader.roleEdges.RemoveMany(RoleEdgeToKeyer{}, key)
 }
 
+func (ed *Editor) PutDefaultRole(dr *DefaultRole) {
+	if old, ok := ed.reader.defaultRoles.Get(dr); ok {
+		ed.reader.defaultRoles.Remove(old)
+	}
+	ed.reader.defaultRoles.Put(dr)
+}
+
+func (ed *Editor) RemoveDefaultRolesUserKey(key DefaultRolesUserKey) {
+	ed.reader.defaultRoles.RemoveMany(DefaultRoleUserKeyer{}, key)
+}
+
+func (ed *Editor) RemoveDefaultRolesRoleKey(key DefaultRolesRoleKey) {
+	ed.reader.defaultRoles.RemoveMany(DefaultRoleRoleKeyer{}, key)
+}
+
+func (ed *Editor) PutProxyPriv(pp *ProxyPriv) {
+	if old, ok := ed.reader.proxiesPriv.Get(pp); ok {
+		ed.reader.proxiesPriv.Remove(old)
+	}
+	ed.reader.proxiesPriv.Put(pp)
+}
+
+func (ed *Editor) RemoveProxyPriv(key ProxiesPrivPrimaryKey) {
+	ed.reader.proxiesPriv.RemoveMany(ProxyPrivPrimaryKeyer{}, key)
+}
+
+func (ed *Editor) RemoveProxyPrivsUserKey(key ProxiesPrivUserKey) {
+	ed.reader.proxiesPriv.RemoveMany(ProxyPrivUserKeyer{}, key)
+}
+
+func (ed *Editor) RemoveProxyPrivsProxiedKey(key ProxiesPrivProxiedKey) {
+	ed.reader.proxiesPriv.RemoveMany(ProxyPrivProxiedKeyer{}, key)
+}
+
 func (ed *Editor) RemoveReplicaSourceInfo(k ReplicaSourceInfoPrimaryKey) {
 	ed.reader.replicaSourceInfo.RemoveMany(ReplicaSourceInfoPrimaryKeyer{}, k)
 }
@@ -287,6 +376,8 @@ func (db *MySQLDb) unlockedReader() *Reader {
 	return &Reader{
 		users:             db.user.Set(),
 		roleEdges:         db.role_edges.Set(),
+		defaultRoles:      db.default_roles.Set(),
+		proxiesPriv:       db.proxies_priv.Set(),
 		replicaSourceInfo: db.replica_source_info.Set(),
 	}
 }
@@ -296,6 +387,8 @@ func (db *MySQLDb) Reader() *Reader {
 	return &Reader{
 		users:             db.user.Set(),
 		roleEdges:         db.role_edges.Set(),
+		defaultRoles:      db.default_roles.Set(),
+		proxiesPriv:       db.proxies_priv.Set(),
 		replicaSourceInfo: db.replica_source_info.Set(),
 		close: func() {
 			db.lock.RUnlock()
@@ -399,6 +492,24 @@ func (db *MySQLDb) LoadData(ctx *sql.Context, buf []byte) (err error) {
 		ed.PutRoleEdge(role)
 	}
 
+	// Fill in the default_roles table
+	for i := 0; i < serialMySQLDb.DefaultRolesLength(); i++ {
+		serialDefaultRole := new(serial.DefaultRole)
+		if !serialMySQLDb.DefaultRoles(serialDefaultRole, i) {
+			continue
+		}
+		ed.PutDefaultRole(LoadDefaultRole(serialDefaultRole))
+	}
+
+	// Fill in the proxies_priv table
+	for i := 0; i < serialMySQLDb.ProxiesPrivLength(); i++ {
+		serialProxyPriv := new(serial.ProxyPriv)
+		if !serialMySQLDb.ProxiesPriv(serialProxyPriv, i) {
+			continue
+		}
+		ed.PutProxyPriv(LoadProxyPriv(serialProxyPriv))
+	}
+
 	// Fill in the ReplicaSourceInfo table
 	for i := 0; i < serialMySQLDb.ReplicaSourceInfoLength(); i++ {
 		serialReplicaSourceInfo := new(serial.ReplicaSourceInfo)
@@ -449,6 +560,8 @@ func (db *MySQLDb) OverwriteUsersAndGrantData(ctx *sql.Context, ed *Editor, buf
 	// our maps at all.
 	var users []*User
 	var edges []*RoleEdge
+	var defaultRoles []*DefaultRole
+	var proxyPrivs []*ProxyPriv
 
 	// Load all users
 	for i := 0; i < serialMySQLDb.UserLength(); i++ {
@@ -477,14 +590,40 @@ func (db *MySQLDb) OverwriteUsersAndGrantData(ctx *sql.Context, ed *Editor, buf
 		edges = append(edges, LoadRoleEdge(serialRoleEdge))
 	}
 
+	// Load all default roles
+	for i := 0; i < serialMySQLDb.DefaultRolesLength(); i++ {
+		serialDefaultRole := new(serial.DefaultRole)
+		if !serialMySQLDb.DefaultRoles(serialDefaultRole, i) {
+			continue
+		}
+		defaultRoles = append(defaultRoles, LoadDefaultRole(serialDefaultRole))
+	}
+
+	// Load all proxy grants
+	for i := 0; i < serialMySQLDb.ProxiesPrivLength(); i++ {
+		serialProxyPriv := new(serial.ProxyPriv)
+		if !serialMySQLDb.ProxiesPriv(serialProxyPriv, i) {
+			continue
+		}
+		proxyPrivs = append(proxyPrivs, LoadProxyPriv(serialProxyPriv))
+	}
+
 	ed.reader.users.Clear()
 	ed.reader.roleEdges.Clear()
+	ed.reader.defaultRoles.Clear()
+	ed.reader.proxiesPriv.Clear()
 	for _, u := range users {
 		ed.PutUser(u)
 	}
 	for _, e := range edges {
 		ed.PutRoleEdge(e)
 	}
+	for _, dr := range defaultRoles {
+		ed.PutDefaultRole(dr)
+	}
+	for _, pp := range proxyPrivs {
+		ed.PutProxyPriv(pp)
+	}
 
 	return
 }
@@ -499,8 +638,10 @@ func (db *MySQLDb) SetPlugins(plugins map[string]PlaintextAuthPlugin) {
 }
 
 func (db *MySQLDb) VerifyPlugin(plugin string) error {
-	_, ok := db.plugins[plugin]
-	if ok {
+	if _, ok := db.plugins[plugin]; ok {
+		return nil
+	}
+	if db.authServer.hasPluginMethod(plugin) {
 		return nil
 	}
 	return fmt.Errorf(`must provide authentication plugin for unsupported authentication format`)
@@ -652,7 +793,8 @@ func (db *MySQLDb) GetUser(fetcher UserFetcher, user string, host string, roleSe
 }
 
 // UserActivePrivilegeSet fetches the User, and returns their entire active privilege set. This takes into account the
-// active roles, which are set in the context, therefore the user is also pulled from the context.
+// active roles of the session, along with the roles granted to them, therefore the user is also pulled from the
+// context.
 func (db *MySQLDb) UserActivePrivilegeSet(ctx *sql.Context) PrivilegeSet {
 	if privSet, counter := ctx.Session.GetPrivilegeSet(); db.updateCounter.Load() == counter {
 		// If the counters are equal, we can guarantee that the privilege set exists and is valid
@@ -669,18 +811,8 @@ func (db *MySQLDb) UserActivePrivilegeSet(ctx *sql.Context) PrivilegeSet {
 	}
 
 	privSet := user.PrivilegeSet.Copy()
-	roleEdgeEntries := rd.GetToUserRoleEdges(RoleEdgesToKey{
-		ToHost: user.Host,
-		ToUser: user.User,
-	})
-	//TODO: filter the active roles using the context, rather than using every granted roles
-	//TODO: System variable "activate_all_roles_on_login", if set, will set all roles as active upon logging in
-	for _, roleEdgeEntry := range roleEdgeEntries {
-		roleEdge := roleEdgeEntry
-		role := db.GetUser(rd, roleEdge.FromUser, roleEdge.FromHost, true)
-		if role != nil {
-			privSet.UnionWith(role.PrivilegeSet)
-		}
+	for _, role := range db.RoleClosure(rd, db.ActiveRoles(ctx, rd, user)) {
+		privSet.UnionWith(role.PrivilegeSet)
 	}
 
 	ctx.Session.SetPrivilegeSet(privSet, db.updateCounter.Load())
@@ -742,14 +874,13 @@ func (db *MySQLDb) UserHasPrivileges(ctx *sql.Context, operations ...sql.Privile
 	for _, operation := range operations {
 
 		for _, operationPriv := range operation.StaticPrivileges {
-			if privSet.Has(operationPriv) {
-				//TODO: Handle partial revokes
-				continue
-			}
 			database := operation.Database
 			if database == "" {
 				database = ctx.GetCurrentDatabase()
 			}
+			if privSet.Has(operationPriv) && !privSet.IsRestricted(database, operationPriv) {
+				continue
+			}
 			dbSet := privSet.Database(database)
 			if dbSet.Has(operationPriv) {
 				continue
@@ -799,6 +930,10 @@ func (db *MySQLDb) GetTableInsensitive(_ *sql.Context, tblName string) (sql.Tabl
 		return db.user, true, nil
 	case roleEdgesTblName:
 		return db.role_edges, true, nil
+	case defaultRolesTblName:
+		return db.default_roles, true, nil
+	case proxiesPrivTblName:
+		return db.proxies_priv, true, nil
 	case dbTblName:
 		return db.db, true, nil
 	case tablesPrivTblName:
@@ -815,6 +950,16 @@ func (db *MySQLDb) GetTableInsensitive(_ *sql.Context, tblName string) (sql.Tabl
 		return db.help_category, true, nil
 	case helpRelationTableName:
 		return db.help_relation, true, nil
+	case timeZoneTableName:
+		return db.time_zone, true, nil
+	case timeZoneNameTableName:
+		return db.time_zone_name, true, nil
+	case timeZoneTransitionTableName:
+		return db.time_zone_transition, true, nil
+	case timeZoneTransitionTypeTableName:
+		return db.time_zone_transition_type, true, nil
+	case timeZoneLeapSecondTableName:
+		return db.time_zone_leap_second, true, nil
 	default:
 		return nil, false, nil
 	}
@@ -828,11 +973,18 @@ func (db *MySQLDb) GetTableNames(ctx *sql.Context) ([]string, error) {
 		tablesPrivTblName,
 		procsPrivTblName,
 		roleEdgesTblName,
+		defaultRolesTblName,
+		proxiesPrivTblName,
 		replicaSourceInfoTblName,
 		helpTopicTableName,
 		helpKeywordTableName,
 		helpCategoryTableName,
 		helpRelationTableName,
+		timeZoneTableName,
+		timeZoneNameTableName,
+		timeZoneTransitionTableName,
+		timeZoneTransitionTypeTableName,
+		timeZoneLeapSecondTableName,
 	}, nil
 }
 
@@ -919,6 +1071,44 @@ func (db *MySQLDb) Persist(ctx *sql.Context, ed *Editor) error {
 		return roles[i].FromHost < roles[j].FromHost
 	})
 
+	// Extract all default role entries from table, and sort
+	var defaultRoles []*DefaultRole
+	ed.VisitDefaultRoles(func(v *DefaultRole) {
+		defaultRoles = append(defaultRoles, v)
+	})
+	sort.Slice(defaultRoles, func(i, j int) bool {
+		left, right := defaultRoles[i], defaultRoles[j]
+		if left.Host != right.Host {
+			return left.Host < right.Host
+		}
+		if left.User != right.User {
+			return left.User < right.User
+		}
+		if left.RoleHost != right.RoleHost {
+			return left.RoleHost < right.RoleHost
+		}
+		return left.RoleUser < right.RoleUser
+	})
+
+	// Extract all proxy grant entries from table, and sort
+	var proxyPrivs []*ProxyPriv
+	ed.VisitProxyPrivs(func(v *ProxyPriv) {
+		proxyPrivs = append(proxyPrivs, v)
+	})
+	sort.Slice(proxyPrivs, func(i, j int) bool {
+		left, right := proxyPrivs[i], proxyPrivs[j]
+		if left.Host != right.Host {
+			return left.Host < right.Host
+		}
+		if left.User != right.User {
+			return left.User < right.User
+		}
+		if left.ProxiedHost != right.ProxiedHost {
+			return left.ProxiedHost < right.ProxiedHost
+		}
+		return left.ProxiedUser < right.ProxiedUser
+	})
+
 	// Extract all replica source info entries from table, and sort
 	var replicaSourceInfos []*ReplicaSourceInfo
 	ed.VisitReplicaSourceInfos(func(v *ReplicaSourceInfo) {
@@ -942,6 +1132,8 @@ func (db *MySQLDb) Persist(ctx *sql.Context, ed *Editor) error {
 	roleEdge := serializeRoleEdge(b, roles)
 	replicaSourceInfo := serializeReplicaSourceInfo(b, replicaSourceInfos)
 	superUser := serializeUser(b, superUsers)
+	defaultRole := serializeDefaultRoles(b, defaultRoles)
+	proxyPriv := serializeProxyPrivs(b, proxyPrivs)
 
 	// Write MySQL DB
 	serial.MySQLDbStart(b)
@@ -949,6 +1141,8 @@ func (db *MySQLDb) Persist(ctx *sql.Context, ed *Editor) error {
 	serial.MySQLDbAddRoleEdges(b, roleEdge)
 	serial.MySQLDbAddReplicaSourceInfo(b, replicaSourceInfo)
 	serial.MySQLDbAddSuperUser(b, superUser)
+	serial.MySQLDbAddDefaultRoles(b, defaultRole)
+	serial.MySQLDbAddProxiesPriv(b, proxyPriv)
 	mysqlDbOffset := serial.MySQLDbEnd(b)
 
 	// Finish writing
@@ -15,6 +15,7 @@
 package mysql_db
 
 import (
+	"strings"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -105,6 +106,16 @@ func loadPrivilegeSet(serialPrivilegeSet *serial.PrivilegeSet) *PrivilegeSet {
 		databases[database.Name()] = *database
 	}
 
+	restrictions := make(map[string]PrivilegeSetDatabase, serialPrivilegeSet.RestrictionsLength())
+	for i := 0; i < serialPrivilegeSet.RestrictionsLength(); i++ {
+		serialRestriction := new(serial.PrivilegeSetDatabase)
+		if !serialPrivilegeSet.Restrictions(serialRestriction, i) {
+			continue
+		}
+		restriction := loadDatabase(serialRestriction)
+		restrictions[strings.ToLower(restriction.Name())] = *restriction
+	}
+
 	globalDynamic := make(map[string]bool)
 	for i := 0; i < serialPrivilegeSet.GlobalDynamicLength(); i++ {
 		globalDynamic[string(serialPrivilegeSet.GlobalDynamic(i))] = serialPrivilegeSet.GlobalDynamicWgo(i)
@@ -113,6 +124,7 @@ func loadPrivilegeSet(serialPrivilegeSet *serial.PrivilegeSet) *PrivilegeSet {
 		globalStatic:  loadPrivilegeTypes(serialPrivilegeSet.GlobalStaticLength(), serialPrivilegeSet.GlobalStatic),
 		globalDynamic: globalDynamic,
 		databases:     databases,
+		restrictions:  restrictions,
 	}
 }
 
@@ -128,28 +140,84 @@ func LoadUser(serialUser *serial.User) *User {
 	}
 
 	return &User{
-		User:                string(serialUser.User()),
-		Host:                string(serialUser.Host()),
-		PrivilegeSet:        *privilegeSet,
-		Plugin:              string(serialUser.Plugin()),
-		AuthString:          string(serialUser.Password()),
-		PasswordLastChanged: time.Unix(serialUser.PasswordLastChanged(), 0),
-		Locked:              serialUser.Locked(),
-		Attributes:          attributes,
-		Identity:            string(serialUser.Identity()),
-		SslType:             string(serialUser.SslType()),
-		SslCipher:           string(serialUser.SslCipher()),
-		X509Issuer:          string(serialUser.X509Issuer()),
-		X509Subject:         string(serialUser.X509Subject()),
+		User:                 string(serialUser.User()),
+		Host:                 string(serialUser.Host()),
+		PrivilegeSet:         *privilegeSet,
+		Plugin:               string(serialUser.Plugin()),
+		AuthString:           string(serialUser.Password()),
+		PasswordLastChanged:  time.Unix(serialUser.PasswordLastChanged(), 0),
+		Locked:               serialUser.Locked(),
+		Attributes:           attributes,
+		Identity:             string(serialUser.Identity()),
+		SslType:              string(serialUser.SslType()),
+		SslCipher:            string(serialUser.SslCipher()),
+		X509Issuer:           string(serialUser.X509Issuer()),
+		X509Subject:          string(serialUser.X509Subject()),
+		MaxQuestions:         serialUser.MaxQuestions(),
+		MaxUpdates:           serialUser.MaxUpdates(),
+		PasswordExpired:      serialUser.PasswordExpired(),
+		PasswordLifetime:     loadNullableUint16(serialUser.PasswordLifetime()),
+		PasswordReuseHistory: loadNullableUint16(serialUser.PasswordReuseHistory()),
+		PasswordReuseTime:    loadNullableUint16(serialUser.PasswordReuseTime()),
+		PasswordHistory:      loadPasswordHistory(serialUser),
+		FailedLoginAttempts:  serialUser.FailedLoginAttempts(),
+		PasswordLockTime:     serialUser.PasswordLockTime(),
+	}
+}
+
+func loadPasswordHistory(serialUser *serial.User) []PasswordHistoryEntry {
+	var history []PasswordHistoryEntry
+	serialEntry := new(serial.PasswordHistory)
+	for i := 0; i < serialUser.PasswordHistoryLength(); i++ {
+		if !serialUser.PasswordHistory(serialEntry, i) {
+			continue
+		}
+		history = append(history, PasswordHistoryEntry{
+			Plugin:     string(serialEntry.Plugin()),
+			AuthString: string(serialEntry.AuthString()),
+			Changed:    time.Unix(serialEntry.Changed(), 0),
+		})
 	}
+	return history
+}
+
+// loadNullableUint16 returns the value of a serialized nullable uint16, where -1 represents nil.
+func loadNullableUint16(val int32) *uint16 {
+	if val < 0 {
+		return nil
+	}
+	v := uint16(val)
+	return &v
 }
 
 func LoadRoleEdge(serialRoleEdge *serial.RoleEdge) *RoleEdge {
 	return &RoleEdge{
-		FromHost: string(serialRoleEdge.FromHost()),
-		FromUser: string(serialRoleEdge.FromUser()),
-		ToHost:   string(serialRoleEdge.ToHost()),
-		ToUser:   string(serialRoleEdge.ToUser()),
+		FromHost:        string(serialRoleEdge.FromHost()),
+		FromUser:        string(serialRoleEdge.FromUser()),
+		ToHost:          string(serialRoleEdge.ToHost()),
+		ToUser:          string(serialRoleEdge.ToUser()),
+		WithAdminOption: serialRoleEdge.WithAdminOption(),
+	}
+}
+
+func LoadDefaultRole(serialDefaultRole *serial.DefaultRole) *DefaultRole {
+	return &DefaultRole{
+		Host:     string(serialDefaultRole.Host()),
+		User:     string(serialDefaultRole.User()),
+		RoleHost: string(serialDefaultRole.RoleHost()),
+		RoleUser: string(serialDefaultRole.RoleUser()),
+	}
+}
+
+func LoadProxyPriv(serialProxyPriv *serial.ProxyPriv) *ProxyPriv {
+	return &ProxyPriv{
+		Host:        string(serialProxyPriv.Host()),
+		User:        string(serialProxyPriv.User()),
+		ProxiedHost: string(serialProxyPriv.ProxiedHost()),
+		ProxiedUser: string(serialProxyPriv.ProxiedUser()),
+		WithGrant:   serialProxyPriv.WithGrant(),
+		Grantor:     string(serialProxyPriv.Grantor()),
+		Timestamp:   time.Unix(serialProxyPriv.Timestamp(), 0).UTC(),
 	}
 }
 
@@ -116,6 +116,12 @@ func serializeRoutines(b *flatbuffers.Builder, routines []PrivilegeSetRoutine) f
 
 // serializeDatabases writes the given Privilege Set Databases into the flatbuffer Builder, and returns the offset
 func serializeDatabases(b *flatbuffers.Builder, databases []PrivilegeSetDatabase) flatbuffers.UOffsetT {
+	return serializeDatabaseVector(b, serial.PrivilegeSetStartDatabasesVector, databases)
+}
+
+// serializeDatabaseVector writes the given Privilege Set Databases into the flatbuffer Builder using the given vector
+// start function, and returns the offset
+func serializeDatabaseVector(b *flatbuffers.Builder, start func(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT, databases []PrivilegeSetDatabase) flatbuffers.UOffsetT {
 	// Write database variables, and save offsets
 	offsets := make([]flatbuffers.UOffsetT, len(databases))
 	for i, database := range databases {
@@ -133,7 +139,7 @@ func serializeDatabases(b *flatbuffers.Builder, databases []PrivilegeSetDatabase
 	}
 
 	// Write database offsets (order already reversed)
-	return serializeVectorOffsets(b, serial.PrivilegeSetStartDatabasesVector, offsets)
+	return serializeVectorOffsets(b, start, offsets)
 }
 
 func serializePrivilegeSet(b *flatbuffers.Builder, ps *PrivilegeSet) flatbuffers.UOffsetT {
@@ -141,6 +147,7 @@ func serializePrivilegeSet(b *flatbuffers.Builder, ps *PrivilegeSet) flatbuffers
 	globalStatic := serializePrivilegeTypes(b, serial.PrivilegeSetStartGlobalStaticVector, ps.ToSlice())
 	globalDynamicStrs, globalDynamicWgos := serializeGlobalDynamic(b, ps.globalDynamic)
 	databases := serializeDatabases(b, ps.getDatabases())
+	restrictions := serializeDatabaseVector(b, serial.PrivilegeSetStartRestrictionsVector, ps.getRestrictions())
 
 	// Write PrivilegeSet
 	serial.PrivilegeSetStart(b)
@@ -148,6 +155,7 @@ func serializePrivilegeSet(b *flatbuffers.Builder, ps *PrivilegeSet) flatbuffers
 	serial.PrivilegeSetAddGlobalDynamic(b, globalDynamicStrs)
 	serial.PrivilegeSetAddDatabases(b, databases)
 	serial.PrivilegeSetAddGlobalDynamicWgo(b, globalDynamicWgos)
+	serial.PrivilegeSetAddRestrictions(b, restrictions)
 	return serial.PrivilegeSetEnd(b)
 }
 
@@ -176,6 +184,7 @@ func serializeUser(b *flatbuffers.Builder, users []*User) flatbuffers.UOffsetT {
 		sslCipher := b.CreateString(user.SslCipher)
 		x509Issuer := b.CreateString(user.X509Issuer)
 		x509Subject := b.CreateString(user.X509Subject)
+		passwordHistory := serializePasswordHistory(b, user.PasswordHistory)
 
 		serial.UserStart(b)
 		serial.UserAddUser(b, userName)
@@ -191,6 +200,15 @@ func serializeUser(b *flatbuffers.Builder, users []*User) flatbuffers.UOffsetT {
 		serial.UserAddSslCipher(b, sslCipher)
 		serial.UserAddX509Issuer(b, x509Issuer)
 		serial.UserAddX509Subject(b, x509Subject)
+		serial.UserAddMaxQuestions(b, user.MaxQuestions)
+		serial.UserAddMaxUpdates(b, user.MaxUpdates)
+		serial.UserAddPasswordExpired(b, user.PasswordExpired)
+		serial.UserAddPasswordLifetime(b, serializeNullableUint16(user.PasswordLifetime))
+		serial.UserAddPasswordReuseHistory(b, serializeNullableUint16(user.PasswordReuseHistory))
+		serial.UserAddPasswordReuseTime(b, serializeNullableUint16(user.PasswordReuseTime))
+		serial.UserAddFailedLoginAttempts(b, user.FailedLoginAttempts)
+		serial.UserAddPasswordLockTime(b, user.PasswordLockTime)
+		serial.UserAddPasswordHistory(b, passwordHistory)
 
 		offsets[len(users)-i-1] = serial.UserEnd(b) // reverse order
 	}
@@ -199,6 +217,29 @@ func serializeUser(b *flatbuffers.Builder, users []*User) flatbuffers.UOffsetT {
 	return serializeVectorOffsets(b, serial.MySQLDbStartUserVector, offsets)
 }
 
+func serializePasswordHistory(b *flatbuffers.Builder, history []PasswordHistoryEntry) flatbuffers.UOffsetT {
+	offsets := make([]flatbuffers.UOffsetT, len(history))
+	for i, entry := range history {
+		plugin := b.CreateString(entry.Plugin)
+		authString := b.CreateString(entry.AuthString)
+
+		serial.PasswordHistoryStart(b)
+		serial.PasswordHistoryAddPlugin(b, plugin)
+		serial.PasswordHistoryAddAuthString(b, authString)
+		serial.PasswordHistoryAddChanged(b, entry.Changed.Unix())
+		offsets[len(history)-i-1] = serial.PasswordHistoryEnd(b) // reverse order
+	}
+	return serializeVectorOffsets(b, serial.UserStartPasswordHistoryVector, offsets)
+}
+
+// serializeNullableUint16 returns |val| as it's serialized, where -1 represents nil.
+func serializeNullableUint16(val *uint16) int32 {
+	if val == nil {
+		return -1
+	}
+	return int32(*val)
+}
+
 func serializeRoleEdge(b *flatbuffers.Builder, roleEdges []*RoleEdge) flatbuffers.UOffsetT {
 	offsets := make([]flatbuffers.UOffsetT, len(roleEdges))
 	for i, roleEdge := range roleEdges {
@@ -228,6 +269,50 @@ func serializeRoleEdge(b *flatbuffers.Builder, roleEdges []*RoleEdge) flatbuffer
 	return serializeVectorOffsets(b, serial.MySQLDbStartRoleEdgesVector, offsets)
 }
 
+func serializeDefaultRoles(b *flatbuffers.Builder, defaultRoles []*DefaultRole) flatbuffers.UOffsetT {
+	offsets := make([]flatbuffers.UOffsetT, len(defaultRoles))
+	for i, defaultRole := range defaultRoles {
+		host := b.CreateString(defaultRole.Host)
+		user := b.CreateString(defaultRole.User)
+		roleHost := b.CreateString(defaultRole.RoleHost)
+		roleUser := b.CreateString(defaultRole.RoleUser)
+
+		serial.DefaultRoleStart(b)
+		serial.DefaultRoleAddHost(b, host)
+		serial.DefaultRoleAddUser(b, user)
+		serial.DefaultRoleAddRoleHost(b, roleHost)
+		serial.DefaultRoleAddRoleUser(b, roleUser)
+		offsets[len(defaultRoles)-i-1] = serial.DefaultRoleEnd(b) // reverse order
+	}
+
+	// Write default_roles vector (already in reversed order)
+	return serializeVectorOffsets(b, serial.MySQLDbStartDefaultRolesVector, offsets)
+}
+
+func serializeProxyPrivs(b *flatbuffers.Builder, proxyPrivs []*ProxyPriv) flatbuffers.UOffsetT {
+	offsets := make([]flatbuffers.UOffsetT, len(proxyPrivs))
+	for i, proxyPriv := range proxyPrivs {
+		host := b.CreateString(proxyPriv.Host)
+		user := b.CreateString(proxyPriv.User)
+		proxiedHost := b.CreateString(proxyPriv.ProxiedHost)
+		proxiedUser := b.CreateString(proxyPriv.ProxiedUser)
+		grantor := b.CreateString(proxyPriv.Grantor)
+
+		serial.ProxyPrivStart(b)
+		serial.ProxyPrivAddHost(b, host)
+		serial.ProxyPrivAddUser(b, user)
+		serial.ProxyPrivAddProxiedHost(b, proxiedHost)
+		serial.ProxyPrivAddProxiedUser(b, proxiedUser)
+		serial.ProxyPrivAddWithGrant(b, proxyPriv.WithGrant)
+		serial.ProxyPrivAddGrantor(b, grantor)
+		serial.ProxyPrivAddTimestamp(b, proxyPriv.Timestamp.Unix())
+		offsets[len(proxyPrivs)-i-1] = serial.ProxyPrivEnd(b) // reverse order
+	}
+
+	// Write proxies_priv vector (already in reversed order)
+	return serializeVectorOffsets(b, serial.MySQLDbStartProxiesPrivVector, offsets)
+}
+
 func serializeReplicaSourceInfo(b *flatbuffers.Builder, replicaSourceInfos []*ReplicaSourceInfo) flatbuffers.UOffsetT {
 	offsets := make([]flatbuffers.UOffsetT, len(replicaSourceInfos))
 
@@ -15,7 +15,10 @@
 package mysql_db
 
 import (
+	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -87,6 +90,33 @@ func TestMySQLDbOverwriteUsersAndGrantsData(t *testing.T) {
 	rd.Close()
 }
 
+func TestMySQLDbPersistsRoles(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	db := CreateEmptyMySQLDb()
+	p := &capturingPersistence{}
+	db.SetPersister(p)
+
+	ed := db.Editor()
+	db.AddSuperUser(ed, "tester", "localhost", "")
+	ed.PutUser(&User{User: "role", Host: "%", PrivilegeSet: NewPrivilegeSet(), IsRole: true})
+	ed.PutRoleEdge(&RoleEdge{FromHost: "%", FromUser: "role", ToHost: "localhost", ToUser: "tester", WithAdminOption: true})
+	ed.PutDefaultRole(&DefaultRole{Host: "localhost", User: "tester", RoleHost: "%", RoleUser: "role"})
+	require.NoError(t, db.Persist(ctx, ed))
+	ed.Close()
+
+	loaded := CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(ctx, p.buf))
+	rd := loaded.Reader()
+	defer rd.Close()
+
+	edges := rd.GetToUserRoleEdges(RoleEdgesToKey{ToHost: "localhost", ToUser: "tester"})
+	require.Len(t, edges, 1)
+	require.True(t, edges[0].WithAdminOption)
+	defaultRoles := rd.GetDefaultRoles(DefaultRolesUserKey{Host: "localhost", User: "tester"})
+	require.Len(t, defaultRoles, 1)
+	require.True(t, DefaultRoleEquals(&DefaultRole{Host: "localhost", User: "tester", RoleHost: "%", RoleUser: "role"}, defaultRoles[0]))
+}
+
 func TestMatchesHostPattern(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -208,3 +238,145 @@ func TestGetUserWithWildcardAuthentication(t *testing.T) {
 		})
 	}
 }
+
+func TestMySQLDbPersistsPartialRevokesAndProxies(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	db := CreateEmptyMySQLDb()
+	p := &capturingPersistence{}
+	db.SetPersister(p)
+
+	privSet := NewPrivilegeSet()
+	privSet.AddGlobalStatic(sql.PrivilegeType_Select, sql.PrivilegeType_Insert)
+	privSet.AddRestriction("MyDb", sql.PrivilegeType_Select, sql.PrivilegeType_Delete)
+	proxyPriv := &ProxyPriv{
+		Host:        "localhost",
+		User:        "tester",
+		ProxiedHost: "%",
+		ProxiedUser: "target",
+		WithGrant:   true,
+		Grantor:     "root@localhost",
+		Timestamp:   time.Unix(184301, 0).UTC(),
+	}
+	ed := db.Editor()
+	ed.PutUser(&User{User: "tester", Host: "localhost", PrivilegeSet: privSet})
+	ed.PutProxyPriv(proxyPriv)
+	require.NoError(t, db.Persist(ctx, ed))
+	ed.Close()
+
+	loaded := CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(ctx, p.buf))
+	rd := loaded.Reader()
+	defer rd.Close()
+	loadedUser := loaded.GetUser(rd, "tester", "localhost", false)
+	require.NotNil(t, loadedUser)
+	require.True(t, loadedUser.PrivilegeSet.Equals(privSet))
+	require.True(t, loadedUser.PrivilegeSet.IsRestricted("mydb", sql.PrivilegeType_Select))
+	require.False(t, loadedUser.PrivilegeSet.IsRestricted("mydb", sql.PrivilegeType_Delete))
+	proxyPrivs := rd.GetProxyPrivs(ProxiesPrivUserKey{Host: "localhost", User: "tester"})
+	require.Len(t, proxyPrivs, 1)
+	require.True(t, ProxyPrivEquals(proxyPriv, proxyPrivs[0]))
+}
+
+func TestMySQLDbPersistsPasswordPolicies(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	db := CreateEmptyMySQLDb()
+	p := &capturingPersistence{}
+	db.SetPersister(p)
+
+	history, reuseTime := uint16(2), uint16(0)
+	user := &User{
+		User:                 "tester",
+		Host:                 "localhost",
+		PrivilegeSet:         NewPrivilegeSet(),
+		Plugin:               "mysql_native_password",
+		AuthString:           nativePasswordAuthString("pass2"),
+		PasswordLastChanged:  time.Unix(184301, 0).UTC(),
+		PasswordExpired:      true,
+		PasswordReuseHistory: &history,
+		PasswordReuseTime:    &reuseTime,
+		PasswordHistory: []PasswordHistoryEntry{
+			{Plugin: "mysql_native_password", AuthString: nativePasswordAuthString("pass2"), Changed: time.Unix(184301, 0).UTC()},
+			{Plugin: "mysql_native_password", AuthString: nativePasswordAuthString("pass1"), Changed: time.Unix(184201, 0).UTC()},
+		},
+		FailedLoginAttempts: 3,
+		PasswordLockTime:    PasswordLockTimeUnbounded,
+	}
+	ed := db.Editor()
+	ed.PutUser(user)
+	require.NoError(t, db.Persist(ctx, ed))
+	ed.Close()
+
+	loaded := CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(ctx, p.buf))
+	rd := loaded.Reader()
+	defer rd.Close()
+	loadedUser := loaded.GetUser(rd, "tester", "localhost", false)
+	require.NotNil(t, loadedUser)
+	require.True(t, UserEquals(user, loadedUser))
+	require.Nil(t, loadedUser.PasswordLifetime)
+	require.True(t, loadedUser.PasswordReused("pass1", time.Now()))
+	require.False(t, loadedUser.PasswordReused("pass3", time.Now()))
+}
+
+func TestFailedLoginsLockAccounts(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	db := CreateEmptyMySQLDb()
+	user := &User{User: "tester", Host: "localhost", PrivilegeSet: NewPrivilegeSet(), FailedLoginAttempts: 2, PasswordLockTime: 1}
+	untracked := &User{User: "untracked", Host: "localhost", PrivilegeSet: NewPrivilegeSet(), FailedLoginAttempts: 2}
+
+	require.NoError(t, db.RecordLogin(user, false))
+	require.NoError(t, db.RecordLogin(user, true))
+	require.NoError(t, db.RecordLogin(user, false))
+	require.Error(t, db.RecordLogin(user, false))
+	// a locked account can't log in, even with the right password
+	require.Error(t, db.RecordLogin(user, true))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, db.RecordLogin(untracked, false))
+	}
+
+	locks := db.AccountLocks()
+	require.Len(t, locks, 1)
+	require.Equal(t, "tester", locks[0].User)
+	require.Equal(t, uint16(2), locks[0].FailedLogins)
+	require.True(t, locks[0].Locked)
+
+	rows, err := db.accountLocksRows(ctx)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, sql.Row{"tester", "localhost", uint32(2), "YES", locks[0].LockedUntil.UTC()}, rows[0])
+
+	db.ResetFailedLogins("tester", "localhost")
+	require.Empty(t, db.AccountLocks())
+	require.NoError(t, db.RecordLogin(user, true))
+}
+
+// erroringPlugin is a PlaintextAuthPlugin that can't reach the service it authenticates against.
+type erroringPlugin struct{}
+
+func (erroringPlugin) Authenticate(*MySQLDb, string, *User, string) (bool, error) {
+	return false, fmt.Errorf("directory unavailable")
+}
+
+func TestErroringPluginsRecordFailedLogins(t *testing.T) {
+	db := CreateEmptyMySQLDb()
+	db.SetEnabled(true)
+	db.SetPlugins(map[string]PlaintextAuthPlugin{"erroring": erroringPlugin{}})
+	ed := db.Editor()
+	ed.PutUser(&User{User: "tester", Host: "localhost", PrivilegeSet: NewPrivilegeSet(), Plugin: "erroring", FailedLoginAttempts: 2, PasswordLockTime: 1})
+	ed.Close()
+
+	storage := extendedAuthPlainTextStorage{db: db}
+	addr := &net.UnixAddr{Name: "/tmp/mysql.sock", Net: "unix"}
+	_, err := storage.UserEntryWithPassword(nil, "tester", "secret", addr)
+	require.ErrorContains(t, err, "directory unavailable")
+	require.Len(t, db.AccountLocks(), 1)
+	require.False(t, db.AccountLocks()[0].Locked)
+
+	// the second failure in a row locks the account
+	_, err = storage.UserEntryWithPassword(nil, "tester", "secret", addr)
+	require.Error(t, err)
+	locks := db.AccountLocks()
+	require.Len(t, locks, 1)
+	require.Equal(t, uint16(2), locks[0].FailedLogins)
+	require.True(t, locks[0].Locked)
+}
@@ -27,6 +27,8 @@ type mysqlTable struct {
 	db   *MySQLDb
 	name string
 	sch  sql.Schema
+	// rows returns the contents of a table whose rows are computed rather than stored, and is nil for empty tables
+	rows func(ctx *sql.Context) ([]sql.Row, error)
 }
 
 var _ sql.Table = (*mysqlTable)(nil)
@@ -68,5 +70,12 @@ func (t *mysqlTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 
 // PartitionRows implements the interface sql.Table.
 func (t *mysqlTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	return sql.RowsToRowIter(nil), nil
+	if t.rows == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	rows, err := t.rows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
@@ -0,0 +1,182 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// PasswordHasExpired returns whether the password of the user has expired at |now|, either manually or because it's
+// older than its lifetime.
+func (u *User) PasswordHasExpired(now time.Time) bool {
+	if u.PasswordExpired {
+		return true
+	}
+	var lifetime uint64
+	if u.PasswordLifetime != nil {
+		lifetime = uint64(*u.PasswordLifetime)
+	} else {
+		lifetime = globalPasswordPolicy("default_password_lifetime")
+	}
+	if lifetime == 0 || u.PasswordLastChanged.IsZero() {
+		return false
+	}
+	return now.After(u.PasswordLastChanged.Add(time.Duration(lifetime) * 24 * time.Hour))
+}
+
+// PasswordReusePolicy returns the number of previous passwords of the user that may not be reused, and the number of
+// days before a previous password may be reused, which default to the values of the password_history and
+// password_reuse_interval system variables.
+func (u *User) PasswordReusePolicy() (history uint64, days uint64) {
+	if u.PasswordReuseHistory != nil {
+		history = uint64(*u.PasswordReuseHistory)
+	} else {
+		history = globalPasswordPolicy("password_history")
+	}
+	if u.PasswordReuseTime != nil {
+		days = uint64(*u.PasswordReuseTime)
+	} else {
+		days = globalPasswordPolicy("password_reuse_interval")
+	}
+	return history, days
+}
+
+// globalPasswordPolicy returns the value of the password policy system variable |name|.
+func globalPasswordPolicy(name string) uint64 {
+	_, val, ok := sql.SystemVariables.GetGlobal(name)
+	if !ok {
+		return 0
+	}
+	switch val := val.(type) {
+	case int64:
+		if val > 0 {
+			return uint64(val)
+		}
+	case uint64:
+		return val
+	}
+	return 0
+}
+
+// PasswordReused returns whether |password| may not be the new password of the user, as it's one of the previous
+// passwords that its reuse policies keep it from using again. The policies only cover passwords that are hashed by
+// the mysql_native_password and caching_sha2_password plugins.
+func (u *User) PasswordReused(password string, now time.Time) bool {
+	history, days := u.PasswordReusePolicy()
+	for i, entry := range u.PasswordHistory {
+		if uint64(i) >= history && !withinDays(entry.Changed, days, now) {
+			continue
+		}
+		if entry.matches(password) {
+			return true
+		}
+	}
+	return false
+}
+
+// PasswordChanged records that the password of the user was changed to its current one at |now|. The new password is
+// added to the password history, which drops the passwords that the reuse policies no longer cover, and a password that
+// had expired no longer is.
+func (u *User) PasswordChanged(now time.Time) {
+	u.PasswordLastChanged = now
+	u.PasswordExpired = false
+
+	history, days := u.PasswordReusePolicy()
+	if history == 0 && days == 0 {
+		u.PasswordHistory = nil
+		return
+	}
+	entries := []PasswordHistoryEntry{{Plugin: u.Plugin, AuthString: u.AuthString, Changed: now}}
+	for i, entry := range u.PasswordHistory {
+		if uint64(i+1) < history || withinDays(entry.Changed, days, now) {
+			entries = append(entries, entry)
+		}
+	}
+	u.PasswordHistory = entries
+}
+
+// withinDays returns whether |now| is less than |days| days after |t|.
+func withinDays(t time.Time, days uint64, now time.Time) bool {
+	return days > 0 && now.Before(t.Add(time.Duration(days)*24*time.Hour))
+}
+
+// matches returns whether |password| is the password of the entry.
+func (e PasswordHistoryEntry) matches(password string) bool {
+	switch e.Plugin {
+	case string(mysql.MysqlNativePassword):
+		return e.AuthString == nativePasswordAuthString(password)
+	case string(mysql.CachingSha2Password):
+		if e.AuthString == "" {
+			return password == ""
+		}
+		_, iterations, salt, _, err := mysql.DeserializeCachingSha2PasswordAuthString([]byte(e.AuthString))
+		if err != nil {
+			return false
+		}
+		authString, err := mysql.SerializeCachingSha2PasswordAuthString(password, salt, iterations)
+		return err == nil && e.AuthString == string(authString)
+	default:
+		return false
+	}
+}
+
+// nativePasswordAuthString returns the authentication string of |password| for the mysql_native_password plugin.
+func nativePasswordAuthString(password string) string {
+	if password == "" {
+		return ""
+	}
+	s1 := sha1.Sum([]byte(password))
+	s2 := sha1.Sum(s1[:])
+	return "*" + strings.ToUpper(hex.EncodeToString(s2[:]))
+}
+
+// SandboxMode returns whether the session of |ctx| is in sandbox mode, in which its user must set a new password
+// before running any other statement. Sessions are put in sandbox mode when the password of their user has expired by
+// the time they run their first statement.
+func (db *MySQLDb) SandboxMode(ctx *sql.Context) bool {
+	sandboxed, ok := ctx.Session.GetSandboxMode()
+	if ok {
+		return sandboxed
+	}
+	user := db.sessionUser(ctx)
+	sandboxed = user != nil && user.PasswordHasExpired(time.Now())
+	ctx.Session.SetSandboxMode(sandboxed, true)
+	return sandboxed
+}
+
+// IsSessionUser returns whether the account named by |user| and |host| is the one the session of |ctx| is
+// authenticated as. An empty host is any host.
+func (db *MySQLDb) IsSessionUser(ctx *sql.Context, user, host string) bool {
+	if host == "" {
+		host = "%"
+	}
+	sessionUser := db.sessionUser(ctx)
+	return sessionUser != nil && sessionUser.User == user && sessionUser.Host == host
+}
+
+// sessionUser returns the account the session of |ctx| is authenticated as, or nil if there is no such account.
+func (db *MySQLDb) sessionUser(ctx *sql.Context) *User {
+	rd := db.Reader()
+	defer rd.Close()
+	client := ctx.Session.Client()
+	return db.GetUser(rd, client.User, client.Address, false)
+}
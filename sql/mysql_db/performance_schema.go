@@ -0,0 +1,109 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// PerformanceSchemaDatabaseName is the name of the performance_schema database.
+const PerformanceSchemaDatabaseName = "performance_schema"
+
+const accountLocksTblName = "account_locks"
+
+var accountLocksSchema sql.Schema
+
+func init() {
+	char32_utf8mb4_bin := types.MustCreateString(sqltypes.Char, 32, sql.Collation_utf8mb4_bin)
+	char255_ascii_general_ci := types.MustCreateString(sqltypes.Char, 255, sql.Collation_ascii_general_ci)
+	varchar3_utf8mb4_0900_ai_ci := types.MustCreateString(sqltypes.VarChar, 3, sql.Collation_utf8mb4_0900_ai_ci)
+
+	accountLocksSchema = sql.Schema{
+		performanceSchemaColumn("USER", accountLocksTblName, char32_utf8mb4_bin, false),
+		performanceSchemaColumn("HOST", accountLocksTblName, char255_ascii_general_ci, false),
+		performanceSchemaColumn("FAILED_LOGINS", accountLocksTblName, types.Uint32, false),
+		performanceSchemaColumn("LOCKED", accountLocksTblName, varchar3_utf8mb4_0900_ai_ci, false),
+		performanceSchemaColumn("LOCKED_UNTIL", accountLocksTblName, types.Timestamp, true),
+	}
+}
+
+func performanceSchemaColumn(name string, source string, typ sql.Type, nullable bool) *sql.Column {
+	return &sql.Column{
+		Name:           name,
+		Source:         source,
+		DatabaseSource: PerformanceSchemaDatabaseName,
+		Type:           typ,
+		Nullable:       nullable,
+	}
+}
+
+// performanceSchema is the performance_schema database. It only has the tables whose state is kept by the MySQLDb,
+// which is account_locks so far. The account_locks table shows the accounts that have failed logins, as tracked for
+// accounts with FAILED_LOGIN_ATTEMPTS and PASSWORD_LOCK_TIME set, along with whether they've been locked by them.
+type performanceSchema struct {
+	accountLocks *mysqlTable
+}
+
+var _ sql.Database = (*performanceSchema)(nil)
+
+// PerformanceSchema returns the performance_schema database of |db|.
+func (db *MySQLDb) PerformanceSchema() sql.Database {
+	accountLocks := newEmptyMySQLTable(accountLocksTblName, accountLocksSchema, db)
+	accountLocks.rows = db.accountLocksRows
+	return &performanceSchema{accountLocks: accountLocks}
+}
+
+// Name implements the interface sql.Database.
+func (ps *performanceSchema) Name() string {
+	return PerformanceSchemaDatabaseName
+}
+
+// GetTableInsensitive implements the interface sql.Database.
+func (ps *performanceSchema) GetTableInsensitive(_ *sql.Context, tblName string) (sql.Table, bool, error) {
+	switch strings.ToLower(tblName) {
+	case accountLocksTblName:
+		return ps.accountLocks, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// GetTableNames implements the interface sql.Database.
+func (ps *performanceSchema) GetTableNames(ctx *sql.Context) ([]string, error) {
+	return []string{accountLocksTblName}, nil
+}
+
+// accountLocksRows returns the rows of the account_locks table.
+func (db *MySQLDb) accountLocksRows(ctx *sql.Context) ([]sql.Row, error) {
+	locks := db.AccountLocks()
+	rows := make([]sql.Row, len(locks))
+	for i, lock := range locks {
+		locked := "NO"
+		var lockedUntil interface{}
+		if lock.Locked {
+			locked = "YES"
+			if !lock.LockedUntil.IsZero() {
+				lockedUntil = lock.LockedUntil.UTC()
+			}
+		}
+		rows[i] = sql.Row{lock.User, lock.Host, uint32(lock.FailedLogins), locked, lockedUntil}
+	}
+	return rows, nil
+}
@@ -27,6 +27,9 @@ type PrivilegeSet struct {
 	globalStatic  map[sql.PrivilegeType]struct{}
 	globalDynamic map[string]bool
 	databases     map[string]PrivilegeSetDatabase
+	// restrictions are the global static privileges that have been partially revoked from a database, which only
+	// hold the database-level privileges of each entry.
+	restrictions map[string]PrivilegeSetDatabase
 }
 
 var _ sql.PrivilegeSet = PrivilegeSet{}
@@ -37,6 +40,7 @@ func NewPrivilegeSet() PrivilegeSet {
 		make(map[sql.PrivilegeType]struct{}),
 		make(map[string]bool),
 		make(map[string]PrivilegeSetDatabase),
+		make(map[string]PrivilegeSetDatabase),
 	}
 }
 
@@ -78,6 +82,7 @@ func NewPrivilegeSetWithAllPrivileges() PrivilegeSet {
 		},
 		make(map[string]bool),
 		make(map[string]PrivilegeSetDatabase),
+		make(map[string]PrivilegeSetDatabase),
 	}
 }
 
@@ -95,14 +100,39 @@ func (ps PrivilegeSet) AddGlobalDynamic(withGrantOption bool, privileges ...stri
 	}
 }
 
-// AddDatabase adds the given database privilege(s).
+// AddDatabase adds the given database privilege(s). A global privilege that was partially revoked from the database has
+// its restriction lifted instead, as the global privilege then applies to the database again.
 func (ps PrivilegeSet) AddDatabase(dbName string, privileges ...sql.PrivilegeType) {
 	dbSet := ps.getUseableDb(dbName)
 	for _, priv := range privileges {
+		if ps.IsRestricted(dbName, priv) {
+			ps.RemoveRestriction(dbName, priv)
+			continue
+		}
 		dbSet.privs[priv] = struct{}{}
 	}
 }
 
+// AddRestriction partially revokes the given global static privilege(s) from the database, so that they no longer
+// apply to it. Privileges that aren't held globally are ignored.
+func (ps PrivilegeSet) AddRestriction(dbName string, privileges ...sql.PrivilegeType) {
+	lowerDbName := strings.ToLower(dbName)
+	for _, priv := range privileges {
+		if _, ok := ps.globalStatic[priv]; !ok {
+			continue
+		}
+		restriction, ok := ps.restrictions[lowerDbName]
+		if !ok {
+			restriction = PrivilegeSetDatabase{
+				name:  dbName,
+				privs: make(map[sql.PrivilegeType]struct{}),
+			}
+			ps.restrictions[lowerDbName] = restriction
+		}
+		restriction.privs[priv] = struct{}{}
+	}
+}
+
 // AddTable adds the given table privilege(s).
 func (ps PrivilegeSet) AddTable(dbName string, tblName string, privileges ...sql.PrivilegeType) {
 	tblSet := ps.getUseableDb(dbName).getUseableTbl(tblName)
@@ -126,10 +156,13 @@ func (ps PrivilegeSet) AddColumn(dbName string, tblName string, colName string,
 	}
 }
 
-// RemoveGlobalStatic removes the given global static privilege(s).
+// RemoveGlobalStatic removes the given global static privilege(s), along with any of their restrictions.
 func (ps PrivilegeSet) RemoveGlobalStatic(privileges ...sql.PrivilegeType) {
 	for _, priv := range privileges {
 		delete(ps.globalStatic, priv)
+		for _, restriction := range ps.restrictions {
+			ps.RemoveRestriction(restriction.name, priv)
+		}
 	}
 }
 
@@ -155,6 +188,21 @@ func (ps PrivilegeSet) RemoveDatabase(dbName string, privileges ...sql.Privilege
 	}
 }
 
+// RemoveRestriction lifts the partial revokes of the given global static privilege(s) from the database.
+func (ps PrivilegeSet) RemoveRestriction(dbName string, privileges ...sql.PrivilegeType) {
+	lowerDbName := strings.ToLower(dbName)
+	restriction, ok := ps.restrictions[lowerDbName]
+	if !ok {
+		return
+	}
+	for _, priv := range privileges {
+		delete(restriction.privs, priv)
+	}
+	if len(restriction.privs) == 0 {
+		delete(ps.restrictions, lowerDbName)
+	}
+}
+
 // RemoveTable removes the given table privilege(s).
 func (ps PrivilegeSet) RemoveTable(dbName string, tblName string, privileges ...sql.PrivilegeType) {
 	// We don't use the getUseable functions since we don't want to create new maps if they don't already exist
@@ -208,6 +256,16 @@ func (ps PrivilegeSet) HasDynamic(privileges ...string) bool {
 	return true
 }
 
+// IsRestricted returns whether the given global static privilege has been partially revoked from the database.
+func (ps PrivilegeSet) IsRestricted(dbName string, privilege sql.PrivilegeType) bool {
+	restriction, ok := ps.restrictions[strings.ToLower(dbName)]
+	if !ok {
+		return false
+	}
+	_, ok = restriction.privs[privilege]
+	return ok
+}
+
 // HasPrivileges returns whether this PrivilegeSet has any privileges at any level.
 func (ps PrivilegeSet) HasPrivileges() bool {
 	if len(ps.globalStatic) > 0 || len(ps.globalDynamic) > 0 {
@@ -256,6 +314,29 @@ func (ps PrivilegeSet) GetDatabases() []sql.PrivilegeSetDatabase {
 	return dbSets
 }
 
+// GetRestrictions returns the partial revokes of every database, where the privileges of each database are the global
+// privileges that don't apply to it.
+func (ps PrivilegeSet) GetRestrictions() []sql.PrivilegeSetDatabase {
+	restrictions := ps.getRestrictions()
+	dbSets := make([]sql.PrivilegeSetDatabase, len(restrictions))
+	for i, restriction := range restrictions {
+		dbSets[i] = restriction
+	}
+	return dbSets
+}
+
+// getRestrictions returns the partial revokes of every database as the native type.
+func (ps PrivilegeSet) getRestrictions() []PrivilegeSetDatabase {
+	restrictions := make([]PrivilegeSetDatabase, 0, len(ps.restrictions))
+	for _, restriction := range ps.restrictions {
+		restrictions = append(restrictions, restriction)
+	}
+	sort.Slice(restrictions, func(i, j int) bool {
+		return restrictions[i].name < restrictions[j].name
+	})
+	return restrictions
+}
+
 // getDatabases returns all databases of the native type.
 func (ps PrivilegeSet) getDatabases() []PrivilegeSetDatabase {
 	dbSets := make([]PrivilegeSetDatabase, 0, len(ps.databases))
@@ -272,8 +353,32 @@ func (ps PrivilegeSet) getDatabases() []PrivilegeSetDatabase {
 	return dbSets
 }
 
-// UnionWith merges the given set of privileges to the calling set of privileges.
+// UnionWith merges the given set of privileges to the calling set of privileges. A partially revoked privilege stays
+// revoked from a database only if neither set holds it globally without that restriction.
 func (ps PrivilegeSet) UnionWith(other PrivilegeSet) {
+	for _, restriction := range ps.getRestrictions() {
+		for priv := range restriction.privs {
+			if other.Has(priv) && !other.IsRestricted(restriction.name, priv) {
+				ps.RemoveRestriction(restriction.name, priv)
+			}
+		}
+	}
+	for _, otherRestriction := range other.getRestrictions() {
+		for priv := range otherRestriction.privs {
+			if _, ok := ps.globalStatic[priv]; ok {
+				continue
+			}
+			restriction, ok := ps.restrictions[strings.ToLower(otherRestriction.name)]
+			if !ok {
+				restriction = PrivilegeSetDatabase{
+					name:  otherRestriction.name,
+					privs: make(map[sql.PrivilegeType]struct{}),
+				}
+				ps.restrictions[strings.ToLower(otherRestriction.name)] = restriction
+			}
+			restriction.privs[priv] = struct{}{}
+		}
+	}
 	for priv := range other.globalStatic {
 		ps.globalStatic[priv] = struct{}{}
 	}
@@ -286,10 +391,11 @@ func (ps PrivilegeSet) UnionWith(other PrivilegeSet) {
 	}
 }
 
-// ClearGlobal removes all global privileges.
+// ClearGlobal removes all global privileges, along with their restrictions.
 func (ps *PrivilegeSet) ClearGlobal() {
 	ps.globalStatic = make(map[sql.PrivilegeType]struct{})
 	ps.globalDynamic = make(map[string]bool)
+	ps.restrictions = make(map[string]PrivilegeSetDatabase)
 }
 
 // ClearDatabase removes all privileges for the given database.
@@ -321,6 +427,7 @@ func (ps *PrivilegeSet) ClearAll() {
 	ps.globalStatic = make(map[sql.PrivilegeType]struct{})
 	ps.globalDynamic = make(map[string]bool)
 	ps.databases = make(map[string]PrivilegeSetDatabase)
+	ps.restrictions = make(map[string]PrivilegeSetDatabase)
 }
 
 // Equals returns whether the given set of privileges is equivalent to the calling set.
@@ -328,7 +435,8 @@ func (ps PrivilegeSet) Equals(otherPrivSet sql.PrivilegeSet) bool {
 	otherPs := otherPrivSet.(PrivilegeSet)
 	if len(ps.globalStatic) != len(otherPs.globalStatic) ||
 		len(ps.globalDynamic) != len(otherPs.globalDynamic) ||
-		len(ps.databases) != len(otherPs.databases) {
+		len(ps.databases) != len(otherPs.databases) ||
+		len(ps.restrictions) != len(otherPs.restrictions) {
 		return false
 	}
 	for priv := range ps.globalStatic {
@@ -346,6 +454,11 @@ func (ps PrivilegeSet) Equals(otherPrivSet sql.PrivilegeSet) bool {
 			return false
 		}
 	}
+	for dbName, restriction := range ps.restrictions {
+		if !restriction.Equals(otherPs.restrictions[dbName]) {
+			return false
+		}
+	}
 	return true
 }
 
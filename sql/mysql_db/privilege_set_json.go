@@ -25,6 +25,7 @@ import (
 type privilegeSetMarshaler struct {
 	GlobalStatic []string
 	Databases    []privilegeSetMarshalerDatabase
+	Restrictions []privilegeSetMarshalerDatabase `json:",omitempty"`
 }
 
 // privilegeSetMarshalerDatabase handles marshaling duties to and from JSON for a database in a PrivilegeSet.
@@ -98,6 +99,13 @@ func (ps PrivilegeSet) MarshalJSON() ([]byte, error) {
 			}
 		}
 	}
+	for _, restriction := range ps.getRestrictions() {
+		rm := privilegeSetMarshalerDatabase{Name: restriction.Name()}
+		for _, priv := range restriction.ToSlice() {
+			rm.Privileges = append(rm.Privileges, priv.String())
+		}
+		psm.Restrictions = append(psm.Restrictions, rm)
+	}
 
 	return json.Marshal(psm)
 }
@@ -108,6 +116,7 @@ func (ps *PrivilegeSet) UnmarshalJSON(jsonData []byte) error {
 	ps.globalStatic = make(map[sql.PrivilegeType]struct{})
 	ps.globalDynamic = make(map[string]bool)
 	ps.databases = make(map[string]PrivilegeSetDatabase)
+	ps.restrictions = make(map[string]PrivilegeSetDatabase)
 	psm := privilegeSetMarshaler{}
 	err := json.Unmarshal(jsonData, &psm)
 	if err != nil {
@@ -149,5 +158,14 @@ func (ps *PrivilegeSet) UnmarshalJSON(jsonData []byte) error {
 			}
 		}
 	}
+	for _, restriction := range psm.Restrictions {
+		for _, privStr := range restriction.Privileges {
+			priv, ok := sql.PrivilegeTypeFromString(privStr)
+			if !ok {
+				return fmt.Errorf(`unknown privilege type: "%s"`, priv)
+			}
+			ps.AddRestriction(restriction.Name, priv)
+		}
+	}
 	return nil
 }
@@ -51,6 +51,9 @@ func (pdp PrivilegedDatabaseProvider) Database(ctx *sql.Context, name string) (s
 	if strings.ToLower(name) == "mysql" {
 		return pdp.grantTables, nil
 	}
+	if strings.EqualFold(name, PerformanceSchemaDatabaseName) {
+		return pdp.grantTables.PerformanceSchema(), nil
+	}
 
 	db, providerErr := pdp.provider.Database(ctx, name)
 	if sql.ErrDatabaseNotFound.Is(providerErr) {
@@ -80,7 +83,7 @@ func (pdp PrivilegedDatabaseProvider) Database(ctx *sql.Context, name string) (s
 
 // HasDatabase implements the interface sql.DatabaseProvider.
 func (pdp PrivilegedDatabaseProvider) HasDatabase(ctx *sql.Context, name string) bool {
-	if strings.EqualFold(name, "mysql") {
+	if strings.EqualFold(name, "mysql") || strings.EqualFold(name, PerformanceSchemaDatabaseName) {
 		return true
 	}
 
@@ -148,7 +151,9 @@ var _ sql.TableDropper = PrivilegedDatabase{}
 var _ sql.TableRenamer = PrivilegedDatabase{}
 var _ sql.TriggerDatabase = PrivilegedDatabase{}
 var _ sql.StoredProcedureDatabase = PrivilegedDatabase{}
+var _ sql.StoredFunctionDatabase = PrivilegedDatabase{}
 var _ sql.EventDatabase = PrivilegedDatabase{}
+var _ sql.SequenceDatabase = PrivilegedDatabase{}
 var _ sql.TableCopierDatabase = PrivilegedDatabase{}
 var _ sql.ReadOnlyDatabase = PrivilegedDatabase{}
 var _ sql.TemporaryTableDatabase = PrivilegedDatabase{}
@@ -329,6 +334,38 @@ func (pdb PrivilegedDatabase) DropStoredProcedure(ctx *sql.Context, name string)
 	return sql.ErrStoredProceduresNotSupported.New(pdb.db.Name())
 }
 
+// GetStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) GetStoredFunction(ctx *sql.Context, name string) (sql.StoredFunctionDetails, bool, error) {
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.GetStoredFunction(ctx, name)
+	}
+	return sql.StoredFunctionDetails{}, false, sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
+// GetStoredFunctions implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) GetStoredFunctions(ctx *sql.Context) ([]sql.StoredFunctionDetails, error) {
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.GetStoredFunctions(ctx)
+	}
+	return nil, sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
+// SaveStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) SaveStoredFunction(ctx *sql.Context, sfd sql.StoredFunctionDetails) error {
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.SaveStoredFunction(ctx, sfd)
+	}
+	return sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
+// DropStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) DropStoredFunction(ctx *sql.Context, name string) error {
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.DropStoredFunction(ctx, name)
+	}
+	return sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
 // GetEvent implements sql.EventDatabase
 func (pdb PrivilegedDatabase) GetEvent(ctx *sql.Context, name string) (sql.EventDefinition, bool, error) {
 	if db, ok := pdb.db.(sql.EventDatabase); ok {
@@ -384,6 +421,54 @@ func (pdb PrivilegedDatabase) UpdateLastExecuted(ctx *sql.Context, eventName str
 	return sql.ErrEventsNotSupported.New(pdb.db.Name())
 }
 
+// GetSequence implements sql.SequenceDatabase
+func (pdb PrivilegedDatabase) GetSequence(ctx *sql.Context, name string) (sql.SequenceDefinition, bool, error) {
+	if db, ok := pdb.db.(sql.SequenceDatabase); ok {
+		return db.GetSequence(ctx, name)
+	}
+	return sql.SequenceDefinition{}, false, nil
+}
+
+// GetSequences implements sql.SequenceDatabase
+func (pdb PrivilegedDatabase) GetSequences(ctx *sql.Context) ([]sql.SequenceDefinition, error) {
+	if db, ok := pdb.db.(sql.SequenceDatabase); ok {
+		return db.GetSequences(ctx)
+	}
+	return nil, nil
+}
+
+// CreateSequence implements sql.SequenceDatabase
+func (pdb PrivilegedDatabase) CreateSequence(ctx *sql.Context, seq sql.SequenceDefinition) error {
+	if db, ok := pdb.db.(sql.SequenceDatabase); ok {
+		return db.CreateSequence(ctx, seq)
+	}
+	return sql.ErrSequencesNotSupported.New(pdb.db.Name())
+}
+
+// UpdateSequence implements sql.SequenceDatabase
+func (pdb PrivilegedDatabase) UpdateSequence(ctx *sql.Context, seq sql.SequenceDefinition) error {
+	if db, ok := pdb.db.(sql.SequenceDatabase); ok {
+		return db.UpdateSequence(ctx, seq)
+	}
+	return sql.ErrSequencesNotSupported.New(pdb.db.Name())
+}
+
+// DropSequence implements sql.SequenceDatabase
+func (pdb PrivilegedDatabase) DropSequence(ctx *sql.Context, name string) error {
+	if db, ok := pdb.db.(sql.SequenceDatabase); ok {
+		return db.DropSequence(ctx, name)
+	}
+	return sql.ErrSequencesNotSupported.New(pdb.db.Name())
+}
+
+// NextSequenceValue implements sql.SequenceDatabase
+func (pdb PrivilegedDatabase) NextSequenceValue(ctx *sql.Context, name string) (int64, error) {
+	if db, ok := pdb.db.(sql.SequenceDatabase); ok {
+		return db.NextSequenceValue(ctx, name)
+	}
+	return 0, sql.ErrSequencesNotSupported.New(pdb.db.Name())
+}
+
 // CreateView implements sql.ViewDatabase
 func (pdb PrivilegedDatabase) CreateView(ctx *sql.Context, name string, selectStatement, createViewStmt string) error {
 	if db, ok := pdb.db.(sql.ViewDatabase); ok {
@@ -0,0 +1,158 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const proxiesPrivTblName = "proxies_priv"
+
+var proxiesPrivTblSchema sql.Schema
+
+// ProxiesPrivPrimaryKey is a key that represents the primary key for the "proxies_priv" Grant Table.
+type ProxiesPrivPrimaryKey struct {
+	Host        string
+	User        string
+	ProxiedHost string
+	ProxiedUser string
+}
+
+// ProxiesPrivUserKey is a secondary key that represents the user columns on the "proxies_priv" Grant Table.
+type ProxiesPrivUserKey struct {
+	Host string
+	User string
+}
+
+// ProxiesPrivProxiedKey is a secondary key that represents the proxied user columns on the "proxies_priv" Grant Table.
+type ProxiesPrivProxiedKey struct {
+	ProxiedHost string
+	ProxiedUser string
+}
+
+type ProxyPrivPrimaryKeyer struct{}
+type ProxyPrivUserKeyer struct{}
+type ProxyPrivProxiedKeyer struct{}
+
+var _ in_mem_table.Keyer[*ProxyPriv] = ProxyPrivPrimaryKeyer{}
+var _ in_mem_table.Keyer[*ProxyPriv] = ProxyPrivUserKeyer{}
+var _ in_mem_table.Keyer[*ProxyPriv] = ProxyPrivProxiedKeyer{}
+
+func (ProxyPrivPrimaryKeyer) GetKey(p *ProxyPriv) any {
+	return ProxiesPrivPrimaryKey{
+		Host:        p.Host,
+		User:        p.User,
+		ProxiedHost: p.ProxiedHost,
+		ProxiedUser: p.ProxiedUser,
+	}
+}
+
+func (ProxyPrivUserKeyer) GetKey(p *ProxyPriv) any {
+	return ProxiesPrivUserKey{
+		Host: p.Host,
+		User: p.User,
+	}
+}
+
+func (ProxyPrivProxiedKeyer) GetKey(p *ProxyPriv) any {
+	return ProxiesPrivProxiedKey{
+		ProxiedHost: p.ProxiedHost,
+		ProxiedUser: p.ProxiedUser,
+	}
+}
+
+func NewProxiesPrivIndexedSetTable(lock, rlock sync.Locker) *in_mem_table.IndexedSetTable[*ProxyPriv] {
+	set := in_mem_table.NewIndexedSet[*ProxyPriv](
+		ProxyPrivEquals,
+		[]in_mem_table.Keyer[*ProxyPriv]{
+			ProxyPrivPrimaryKeyer{},
+			ProxyPrivUserKeyer{},
+			ProxyPrivProxiedKeyer{},
+		},
+	)
+	return in_mem_table.NewIndexedSetTable[*ProxyPriv](
+		proxiesPrivTblName,
+		proxiesPrivTblSchema,
+		sql.Collation_utf8mb3_bin,
+		set,
+		ProxyPrivOps,
+		lock,
+		rlock,
+	)
+}
+
+// init creates the schema for the "proxies_priv" Grant Table.
+func init() {
+	// Types
+	char32_utf8_bin := types.MustCreateString(sqltypes.Char, 32, sql.Collation_utf8_bin)
+	char255_ascii_general_ci := types.MustCreateString(sqltypes.Char, 255, sql.Collation_ascii_general_ci)
+	varchar288_utf8_bin := types.MustCreateString(sqltypes.VarChar, 288, sql.Collation_utf8_bin)
+
+	// Column Templates
+	char32_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     char32_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", char32_utf8_bin), char32_utf8_bin, true, false),
+		Nullable: false,
+	}
+	char255_ascii_general_ci_not_null_default_empty := &sql.Column{
+		Type:     char255_ascii_general_ci,
+		Default:  mustDefault(expression.NewLiteral("", char255_ascii_general_ci), char255_ascii_general_ci, true, false),
+		Nullable: false,
+	}
+	tinyint1_not_null_default_0 := &sql.Column{
+		Type:     types.Int8,
+		Default:  mustDefault(expression.NewLiteral(int8(0), types.Int8), types.Int8, true, false),
+		Nullable: false,
+	}
+	varchar288_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     varchar288_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", varchar288_utf8_bin), varchar288_utf8_bin, true, false),
+		Nullable: false,
+	}
+	timestamp_not_null_default_epoch := &sql.Column{
+		Type:     types.Timestamp,
+		Default:  mustDefault(expression.NewLiteral(time.Unix(1, 0).UTC(), types.Timestamp), types.Timestamp, true, false),
+		Nullable: false,
+	}
+
+	proxiesPrivTblSchema = sql.Schema{
+		columnTemplate("Host", proxiesPrivTblName, true, char255_ascii_general_ci_not_null_default_empty),
+		columnTemplate("User", proxiesPrivTblName, true, char32_utf8_bin_not_null_default_empty),
+		columnTemplate("Proxied_host", proxiesPrivTblName, true, char255_ascii_general_ci_not_null_default_empty),
+		columnTemplate("Proxied_user", proxiesPrivTblName, true, char32_utf8_bin_not_null_default_empty),
+		columnTemplate("With_grant", proxiesPrivTblName, false, tinyint1_not_null_default_0),
+		columnTemplate("Grantor", proxiesPrivTblName, false, varchar288_utf8_bin_not_null_default_empty),
+		columnTemplate("Timestamp", proxiesPrivTblName, false, timestamp_not_null_default_epoch),
+	}
+}
+
+// These represent the column indexes of the "proxies_priv" Grant Table.
+const (
+	proxiesPrivTblColIndex_Host int = iota
+	proxiesPrivTblColIndex_User
+	proxiesPrivTblColIndex_Proxied_host
+	proxiesPrivTblColIndex_Proxied_user
+	proxiesPrivTblColIndex_With_grant
+	proxiesPrivTblColIndex_Grantor
+	proxiesPrivTblColIndex_Timestamp
+)
@@ -0,0 +1,58 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"testing"
+)
+
+func TestProxiesPrivTableSchema(t *testing.T) {
+	// Each column has a constant index that it expects to match, therefore if a column's position is updated and the
+	// variable referencing it hasn't also been updated, this will throw a panic.
+	for i, col := range proxiesPrivTblSchema {
+		switch col.Name {
+		case "Host":
+			if proxiesPrivTblColIndex_Host != i {
+				t.FailNow()
+			}
+		case "User":
+			if proxiesPrivTblColIndex_User != i {
+				t.FailNow()
+			}
+		case "Proxied_host":
+			if proxiesPrivTblColIndex_Proxied_host != i {
+				t.FailNow()
+			}
+		case "Proxied_user":
+			if proxiesPrivTblColIndex_Proxied_user != i {
+				t.FailNow()
+			}
+		case "With_grant":
+			if proxiesPrivTblColIndex_With_grant != i {
+				t.FailNow()
+			}
+		case "Grantor":
+			if proxiesPrivTblColIndex_Grantor != i {
+				t.FailNow()
+			}
+		case "Timestamp":
+			if proxiesPrivTblColIndex_Timestamp != i {
+				t.FailNow()
+			}
+		default:
+			t.Errorf(`col "%s" does not have a constant`, col.Name)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+)
+
+// ProxyPriv represents a proxy grant from the proxies_priv Grant Table, which allows the user to act as the proxied
+// user.
+type ProxyPriv struct {
+	Host        string
+	User        string
+	ProxiedHost string
+	ProxiedUser string
+	WithGrant   bool
+	Grantor     string
+	Timestamp   time.Time
+}
+
+func ProxyPrivToRow(ctx *sql.Context, p *ProxyPriv) (sql.Row, error) {
+	row := make(sql.Row, len(proxiesPrivTblSchema))
+	row[proxiesPrivTblColIndex_Host] = p.Host
+	row[proxiesPrivTblColIndex_User] = p.User
+	row[proxiesPrivTblColIndex_Proxied_host] = p.ProxiedHost
+	row[proxiesPrivTblColIndex_Proxied_user] = p.ProxiedUser
+	if p.WithGrant {
+		row[proxiesPrivTblColIndex_With_grant] = int8(1)
+	} else {
+		row[proxiesPrivTblColIndex_With_grant] = int8(0)
+	}
+	row[proxiesPrivTblColIndex_Grantor] = p.Grantor
+	row[proxiesPrivTblColIndex_Timestamp] = p.Timestamp
+	return row, nil
+}
+
+func ProxyPrivFromRow(ctx *sql.Context, row sql.Row) (*ProxyPriv, error) {
+	if err := proxiesPrivTblSchema.CheckRow(ctx, row); err != nil {
+		return nil, err
+	}
+	timestamp, ok := row[proxiesPrivTblColIndex_Timestamp].(time.Time)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type for Timestamp value: %T", row[proxiesPrivTblColIndex_Timestamp])
+	}
+	return &ProxyPriv{
+		Host:        row[proxiesPrivTblColIndex_Host].(string),
+		User:        row[proxiesPrivTblColIndex_User].(string),
+		ProxiedHost: row[proxiesPrivTblColIndex_Proxied_host].(string),
+		ProxiedUser: row[proxiesPrivTblColIndex_Proxied_user].(string),
+		WithGrant:   row[proxiesPrivTblColIndex_With_grant].(int8) != 0,
+		Grantor:     row[proxiesPrivTblColIndex_Grantor].(string),
+		Timestamp:   timestamp,
+	}, nil
+}
+
+func ProxyPrivEquals(left, right *ProxyPriv) bool {
+	return left.Host == right.Host &&
+		left.User == right.User &&
+		left.ProxiedHost == right.ProxiedHost &&
+		left.ProxiedUser == right.ProxiedUser &&
+		left.WithGrant == right.WithGrant &&
+		left.Grantor == right.Grantor &&
+		left.Timestamp.Equal(right.Timestamp)
+}
+
+var ProxyPrivOps = in_mem_table.ValueOps[*ProxyPriv]{
+	ToRow:   ProxyPrivToRow,
+	FromRow: ProxyPrivFromRow,
+	UpdateWithRow: func(ctx *sql.Context, row sql.Row, e *ProxyPriv) (*ProxyPriv, error) {
+		return ProxyPrivFromRow(ctx, row)
+	},
+}
+
+// ProxiedUserString returns the proxied user and host as a formatted string using the quotes given, in the same way as
+// User.UserHostToString.
+func (p *ProxyPriv) ProxiedUserString(quote string) string {
+	return User{User: p.ProxiedUser, Host: p.ProxiedHost}.UserHostToString(quote)
+}
+
+// FromJson implements the interface in_mem_table.Entry.
+func (p *ProxyPriv) FromJson(ctx *sql.Context, jsonStr string) (*ProxyPriv, error) {
+	newProxyPriv := &ProxyPriv{}
+	if err := json.Unmarshal([]byte(jsonStr), newProxyPriv); err != nil {
+		return nil, err
+	}
+	return newProxyPriv, nil
+}
+
+// ToJson implements the interface in_mem_table.Entry.
+func (p *ProxyPriv) ToJson(ctx *sql.Context) (string, error) {
+	jsonData, err := json.Marshal(*p)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonData), nil
+}
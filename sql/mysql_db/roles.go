@@ -0,0 +1,314 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RoleFetcher reads the users, role grants and default roles of the privilege tables. Both Reader and Editor are
+// RoleFetchers.
+type RoleFetcher interface {
+	UserFetcher
+	GetToUserRoleEdges(key RoleEdgesToKey) []*RoleEdge
+	GetDefaultRoles(key DefaultRolesUserKey) []*DefaultRole
+}
+
+// ApplicableRole is a role that applies to a user, either because it's granted to them, or to one of the roles that
+// are granted to them, or because it's a mandatory role. These are the rows of information_schema.applicable_roles.
+type ApplicableRole struct {
+	// Grantee is the user or role that the role is granted to.
+	Grantee         sql.RoleName
+	Role            sql.RoleName
+	WithAdminOption bool
+	// IsDefault is whether the role is a default role of the grantee.
+	IsDefault bool
+	// IsMandatory is whether the role is named by the mandatory_roles system variable.
+	IsMandatory bool
+}
+
+// RoleNameOf returns the name of |user| as a role.
+func RoleNameOf(user *User) sql.RoleName {
+	return sql.RoleName{Name: user.User, Host: user.Host}
+}
+
+// MandatoryRoles returns the roles that the mandatory_roles system variable names, which are granted to every user.
+// Roles that don't exist are skipped.
+func (db *MySQLDb) MandatoryRoles(ctx *sql.Context, fetcher UserFetcher) []sql.RoleName {
+	_, val, ok := sql.SystemVariables.GetGlobal("mandatory_roles")
+	if !ok {
+		return nil
+	}
+	list, _ := val.(string)
+	var roles []sql.RoleName
+	for _, role := range ParseRoleNames(list) {
+		if _, ok := fetcher.GetUser(UserPrimaryKey{Host: role.Host, User: role.Name}); ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// IsMandatoryRole returns whether |role| is named by the mandatory_roles system variable.
+func (db *MySQLDb) IsMandatoryRole(ctx *sql.Context, role sql.RoleName) bool {
+	_, val, ok := sql.SystemVariables.GetGlobal("mandatory_roles")
+	if !ok {
+		return false
+	}
+	list, _ := val.(string)
+	for _, mandatory := range ParseRoleNames(list) {
+		if mandatory == role {
+			return true
+		}
+	}
+	return false
+}
+
+// GrantedRoles returns the roles that |user| may activate, which are the roles granted to them and the mandatory
+// roles, sorted by name.
+func (db *MySQLDb) GrantedRoles(ctx *sql.Context, fetcher RoleFetcher, user *User) []sql.RoleName {
+	var roles []sql.RoleName
+	for _, edge := range fetcher.GetToUserRoleEdges(RoleEdgesToKey{ToHost: user.Host, ToUser: user.User}) {
+		roles = append(roles, sql.RoleName{Name: edge.FromUser, Host: edge.FromHost})
+	}
+	roles = append(roles, db.MandatoryRoles(ctx, fetcher)...)
+	return sortRoleNames(roles)
+}
+
+// DefaultRoles returns the default roles of |user|, sorted by name.
+func (db *MySQLDb) DefaultRoles(fetcher RoleFetcher, user *User) []sql.RoleName {
+	var roles []sql.RoleName
+	for _, defaultRole := range fetcher.GetDefaultRoles(DefaultRolesUserKey{Host: user.Host, User: user.User}) {
+		roles = append(roles, sql.RoleName{Name: defaultRole.RoleUser, Host: defaultRole.RoleHost})
+	}
+	return sortRoleNames(roles)
+}
+
+// LoginRoles returns the roles that are active when |user| logs in. These are all of the roles that they may activate
+// when the activate_all_roles_on_login system variable is set, and their default roles otherwise.
+func (db *MySQLDb) LoginRoles(ctx *sql.Context, fetcher RoleFetcher, user *User) []sql.RoleName {
+	granted := db.GrantedRoles(ctx, fetcher, user)
+	if _, val, ok := sql.SystemVariables.GetGlobal("activate_all_roles_on_login"); ok {
+		if all, err := sql.ConvertToBool(ctx, val); err == nil && all {
+			return granted
+		}
+	}
+	return intersectRoleNames(db.DefaultRoles(fetcher, user), granted)
+}
+
+// ActiveRoles returns the roles that are active in the session of |ctx|, whose user is |user|. The session's roles are
+// set to the login roles of the user if they haven't been set yet. Roles that have been revoked from the user since they
+// were activated are left out.
+func (db *MySQLDb) ActiveRoles(ctx *sql.Context, fetcher RoleFetcher, user *User) []sql.RoleName {
+	roles, ok := ctx.Session.GetActiveRoles()
+	if !ok {
+		roles = db.LoginRoles(ctx, fetcher, user)
+		ctx.Session.SetActiveRoles(roles, true)
+		return roles
+	}
+	return intersectRoleNames(roles, db.GrantedRoles(ctx, fetcher, user))
+}
+
+// RoleClosure returns the roles given, along with every role that is granted to them, directly or through other roles.
+// The role graph may have cycles, as the privilege tables may be edited directly, so each role is only visited once.
+func (db *MySQLDb) RoleClosure(fetcher RoleFetcher, roles []sql.RoleName) []*User {
+	visited := make(map[sql.RoleName]struct{})
+	var closure []*User
+	queue := append([]sql.RoleName(nil), roles...)
+	for len(queue) > 0 {
+		role := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[role]; ok {
+			continue
+		}
+		visited[role] = struct{}{}
+		user, ok := fetcher.GetUser(UserPrimaryKey{Host: role.Host, User: role.Name})
+		if !ok {
+			continue
+		}
+		closure = append(closure, user)
+		for _, edge := range fetcher.GetToUserRoleEdges(RoleEdgesToKey{ToHost: role.Host, ToUser: role.Name}) {
+			queue = append(queue, sql.RoleName{Name: edge.FromUser, Host: edge.FromHost})
+		}
+	}
+	return closure
+}
+
+// IsGrantedTo returns whether |grantee| is |role|, or is granted to it through the role graph. Granting |role| to
+// |grantee| would create a cycle in the graph when this is true.
+func (db *MySQLDb) IsGrantedTo(fetcher RoleFetcher, grantee, role sql.RoleName) bool {
+	for _, user := range db.RoleClosure(fetcher, []sql.RoleName{role}) {
+		if RoleNameOf(user) == grantee {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplicableRoles returns the roles that apply to |user|: the roles granted to them, the roles granted to those roles
+// through the role graph, and the mandatory roles.
+func (db *MySQLDb) ApplicableRoles(ctx *sql.Context, fetcher RoleFetcher, user *User) []ApplicableRole {
+	mandatory := make(map[sql.RoleName]struct{})
+	for _, role := range db.MandatoryRoles(ctx, fetcher) {
+		mandatory[role] = struct{}{}
+	}
+	isDefault := func(grantee, role sql.RoleName) bool {
+		for _, defaultRole := range fetcher.GetDefaultRoles(DefaultRolesUserKey{Host: grantee.Host, User: grantee.Name}) {
+			if defaultRole.RoleUser == role.Name && defaultRole.RoleHost == role.Host {
+				return true
+			}
+		}
+		return false
+	}
+
+	var roles []ApplicableRole
+	seen := make(map[[2]sql.RoleName]struct{})
+	add := func(grantee, role sql.RoleName, withAdminOption bool) bool {
+		key := [2]sql.RoleName{grantee, role}
+		if _, ok := seen[key]; ok {
+			return false
+		}
+		seen[key] = struct{}{}
+		_, isMandatory := mandatory[role]
+		roles = append(roles, ApplicableRole{
+			Grantee:         grantee,
+			Role:            role,
+			WithAdminOption: withAdminOption,
+			IsDefault:       isDefault(grantee, role),
+			IsMandatory:     isMandatory,
+		})
+		return true
+	}
+
+	visited := make(map[sql.RoleName]struct{})
+	queue := []sql.RoleName{RoleNameOf(user)}
+	for len(queue) > 0 {
+		grantee := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[grantee]; ok {
+			continue
+		}
+		visited[grantee] = struct{}{}
+		for _, edge := range fetcher.GetToUserRoleEdges(RoleEdgesToKey{ToHost: grantee.Host, ToUser: grantee.Name}) {
+			role := sql.RoleName{Name: edge.FromUser, Host: edge.FromHost}
+			add(grantee, role, edge.WithAdminOption)
+			queue = append(queue, role)
+		}
+		if grantee == RoleNameOf(user) {
+			for role := range mandatory {
+				if add(grantee, role, false) {
+					queue = append(queue, role)
+				}
+			}
+		}
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		left, right := roles[i], roles[j]
+		if left.Grantee != right.Grantee {
+			return compareRoleNames(left.Grantee, right.Grantee) < 0
+		}
+		return compareRoleNames(left.Role, right.Role) < 0
+	})
+	return roles
+}
+
+// ParseRoleNames parses a comma-separated list of role names, such as the value of the mandatory_roles system
+// variable. Each name may be quoted, and may be followed by @ and a host, which is % when it's left out.
+func ParseRoleNames(list string) []sql.RoleName {
+	var roles []sql.RoleName
+	for _, entry := range splitUnquoted(list, ',') {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		parts := splitUnquoted(entry, '@')
+		role := sql.RoleName{Name: unquoteRolePart(parts[0]), Host: "%"}
+		if len(parts) > 1 {
+			role.Host = unquoteRolePart(strings.Join(parts[1:], "@"))
+		}
+		roles = append(roles, role)
+	}
+	return roles
+}
+
+// splitUnquoted splits |s| at each |sep| that isn't within quotes.
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '`' || c == '\'' || c == '"':
+			quote = c
+		case c == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unquoteRolePart returns the user or host part of a role name without its quotes.
+func unquoteRolePart(part string) string {
+	part = strings.TrimSpace(part)
+	if len(part) >= 2 {
+		if q := part[0]; (q == '`' || q == '\'' || q == '"') && part[len(part)-1] == q {
+			return strings.ReplaceAll(part[1:len(part)-1], string([]byte{q, q}), string(q))
+		}
+	}
+	return part
+}
+
+func compareRoleNames(left, right sql.RoleName) int {
+	if c := strings.Compare(left.Name, right.Name); c != 0 {
+		return c
+	}
+	return strings.Compare(left.Host, right.Host)
+}
+
+// sortRoleNames sorts |roles| by name and removes duplicates.
+func sortRoleNames(roles []sql.RoleName) []sql.RoleName {
+	sort.Slice(roles, func(i, j int) bool {
+		return compareRoleNames(roles[i], roles[j]) < 0
+	})
+	deduped := roles[:0]
+	for i, role := range roles {
+		if i == 0 || role != roles[i-1] {
+			deduped = append(deduped, role)
+		}
+	}
+	return deduped
+}
+
+// intersectRoleNames returns the roles of |roles| that are also in |allowed|.
+func intersectRoleNames(roles, allowed []sql.RoleName) []sql.RoleName {
+	var intersection []sql.RoleName
+	for _, role := range roles {
+		for _, allowedRole := range allowed {
+			if role == allowedRole {
+				intersection = append(intersection, role)
+				break
+			}
+		}
+	}
+	return intersection
+}
@@ -577,8 +577,28 @@ func (rcv *PrivilegeSet) MutateGlobalDynamicWgo(j int, n bool) bool {
 	return false
 }
 
+func (rcv *PrivilegeSet) Restrictions(obj *PrivilegeSetDatabase, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *PrivilegeSet) RestrictionsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
 func PrivilegeSetStart(builder *flatbuffers.Builder) {
-	builder.StartObject(4)
+	builder.StartObject(5)
 }
 func PrivilegeSetAddGlobalStatic(builder *flatbuffers.Builder, globalStatic flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(globalStatic), 0)
@@ -604,10 +624,95 @@ func PrivilegeSetAddGlobalDynamicWgo(builder *flatbuffers.Builder, globalDynamic
 func PrivilegeSetStartGlobalDynamicWgoVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(1, numElems, 1)
 }
+func PrivilegeSetAddRestrictions(builder *flatbuffers.Builder, restrictions flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(restrictions), 0)
+}
+func PrivilegeSetStartRestrictionsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
 func PrivilegeSetEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
 
+type PasswordHistory struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsPasswordHistory(buf []byte, offset flatbuffers.UOffsetT) *PasswordHistory {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &PasswordHistory{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishPasswordHistoryBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsPasswordHistory(buf []byte, offset flatbuffers.UOffsetT) *PasswordHistory {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &PasswordHistory{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedPasswordHistoryBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *PasswordHistory) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *PasswordHistory) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *PasswordHistory) Plugin() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *PasswordHistory) AuthString() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *PasswordHistory) Changed() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *PasswordHistory) MutateChanged(n int64) bool {
+	return rcv._tab.MutateInt64Slot(8, n)
+}
+
+func PasswordHistoryStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func PasswordHistoryAddPlugin(builder *flatbuffers.Builder, plugin flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(plugin), 0)
+}
+func PasswordHistoryAddAuthString(builder *flatbuffers.Builder, authString flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(authString), 0)
+}
+func PasswordHistoryAddChanged(builder *flatbuffers.Builder, changed int64) {
+	builder.PrependInt64Slot(2, changed, 0)
+}
+func PasswordHistoryEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
 type User struct {
 	_tab flatbuffers.Table
 }
@@ -760,8 +865,124 @@ func (rcv *User) X509Subject() []byte {
 	return nil
 }
 
+func (rcv *User) MaxQuestions() uint64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(30))
+	if o != 0 {
+		return rcv._tab.GetUint64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *User) MutateMaxQuestions(n uint64) bool {
+	return rcv._tab.MutateUint64Slot(30, n)
+}
+
+func (rcv *User) MaxUpdates() uint64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(32))
+	if o != 0 {
+		return rcv._tab.GetUint64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *User) MutateMaxUpdates(n uint64) bool {
+	return rcv._tab.MutateUint64Slot(32, n)
+}
+
+func (rcv *User) PasswordExpired() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(34))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *User) MutatePasswordExpired(n bool) bool {
+	return rcv._tab.MutateBoolSlot(34, n)
+}
+
+func (rcv *User) PasswordLifetime() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(36))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return -1
+}
+
+func (rcv *User) MutatePasswordLifetime(n int32) bool {
+	return rcv._tab.MutateInt32Slot(36, n)
+}
+
+func (rcv *User) PasswordReuseHistory() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(38))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return -1
+}
+
+func (rcv *User) MutatePasswordReuseHistory(n int32) bool {
+	return rcv._tab.MutateInt32Slot(38, n)
+}
+
+func (rcv *User) PasswordReuseTime() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(40))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return -1
+}
+
+func (rcv *User) MutatePasswordReuseTime(n int32) bool {
+	return rcv._tab.MutateInt32Slot(40, n)
+}
+
+func (rcv *User) FailedLoginAttempts() uint16 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(42))
+	if o != 0 {
+		return rcv._tab.GetUint16(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *User) MutateFailedLoginAttempts(n uint16) bool {
+	return rcv._tab.MutateUint16Slot(42, n)
+}
+
+func (rcv *User) PasswordLockTime() int16 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(44))
+	if o != 0 {
+		return rcv._tab.GetInt16(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *User) MutatePasswordLockTime(n int16) bool {
+	return rcv._tab.MutateInt16Slot(44, n)
+}
+
+func (rcv *User) PasswordHistory(obj *PasswordHistory, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(46))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *User) PasswordHistoryLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(46))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
 func UserStart(builder *flatbuffers.Builder) {
-	builder.StartObject(13)
+	builder.StartObject(22)
 }
 func UserAddUser(builder *flatbuffers.Builder, user flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(user), 0)
@@ -802,6 +1023,36 @@ func UserAddX509Issuer(builder *flatbuffers.Builder, x509Issuer flatbuffers.UOff
 func UserAddX509Subject(builder *flatbuffers.Builder, x509Subject flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(12, flatbuffers.UOffsetT(x509Subject), 0)
 }
+func UserAddMaxQuestions(builder *flatbuffers.Builder, maxQuestions uint64) {
+	builder.PrependUint64Slot(13, maxQuestions, 0)
+}
+func UserAddMaxUpdates(builder *flatbuffers.Builder, maxUpdates uint64) {
+	builder.PrependUint64Slot(14, maxUpdates, 0)
+}
+func UserAddPasswordExpired(builder *flatbuffers.Builder, passwordExpired bool) {
+	builder.PrependBoolSlot(15, passwordExpired, false)
+}
+func UserAddPasswordLifetime(builder *flatbuffers.Builder, passwordLifetime int32) {
+	builder.PrependInt32Slot(16, passwordLifetime, -1)
+}
+func UserAddPasswordReuseHistory(builder *flatbuffers.Builder, passwordReuseHistory int32) {
+	builder.PrependInt32Slot(17, passwordReuseHistory, -1)
+}
+func UserAddPasswordReuseTime(builder *flatbuffers.Builder, passwordReuseTime int32) {
+	builder.PrependInt32Slot(18, passwordReuseTime, -1)
+}
+func UserAddFailedLoginAttempts(builder *flatbuffers.Builder, failedLoginAttempts uint16) {
+	builder.PrependUint16Slot(19, failedLoginAttempts, 0)
+}
+func UserAddPasswordLockTime(builder *flatbuffers.Builder, passwordLockTime int16) {
+	builder.PrependInt16Slot(20, passwordLockTime, 0)
+}
+func UserAddPasswordHistory(builder *flatbuffers.Builder, passwordHistory flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(21, flatbuffers.UOffsetT(passwordHistory), 0)
+}
+func UserStartPasswordHistoryVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
 func UserEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -907,6 +1158,219 @@ func RoleEdgeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
 
+type DefaultRole struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsDefaultRole(buf []byte, offset flatbuffers.UOffsetT) *DefaultRole {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &DefaultRole{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishDefaultRoleBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsDefaultRole(buf []byte, offset flatbuffers.UOffsetT) *DefaultRole {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &DefaultRole{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedDefaultRoleBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *DefaultRole) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *DefaultRole) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *DefaultRole) Host() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *DefaultRole) User() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *DefaultRole) RoleHost() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *DefaultRole) RoleUser() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func DefaultRoleStart(builder *flatbuffers.Builder) {
+	builder.StartObject(4)
+}
+func DefaultRoleAddHost(builder *flatbuffers.Builder, host flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(host), 0)
+}
+func DefaultRoleAddUser(builder *flatbuffers.Builder, user flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(user), 0)
+}
+func DefaultRoleAddRoleHost(builder *flatbuffers.Builder, roleHost flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(roleHost), 0)
+}
+func DefaultRoleAddRoleUser(builder *flatbuffers.Builder, roleUser flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(roleUser), 0)
+}
+func DefaultRoleEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
+type ProxyPriv struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsProxyPriv(buf []byte, offset flatbuffers.UOffsetT) *ProxyPriv {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &ProxyPriv{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishProxyPrivBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsProxyPriv(buf []byte, offset flatbuffers.UOffsetT) *ProxyPriv {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &ProxyPriv{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedProxyPrivBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *ProxyPriv) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *ProxyPriv) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *ProxyPriv) Host() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *ProxyPriv) User() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *ProxyPriv) ProxiedHost() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *ProxyPriv) ProxiedUser() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *ProxyPriv) WithGrant() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *ProxyPriv) MutateWithGrant(n bool) bool {
+	return rcv._tab.MutateBoolSlot(12, n)
+}
+
+func (rcv *ProxyPriv) Grantor() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *ProxyPriv) Timestamp() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *ProxyPriv) MutateTimestamp(n int64) bool {
+	return rcv._tab.MutateInt64Slot(16, n)
+}
+
+func ProxyPrivStart(builder *flatbuffers.Builder) {
+	builder.StartObject(7)
+}
+func ProxyPrivAddHost(builder *flatbuffers.Builder, host flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(host), 0)
+}
+func ProxyPrivAddUser(builder *flatbuffers.Builder, user flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(user), 0)
+}
+func ProxyPrivAddProxiedHost(builder *flatbuffers.Builder, proxiedHost flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(proxiedHost), 0)
+}
+func ProxyPrivAddProxiedUser(builder *flatbuffers.Builder, proxiedUser flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(proxiedUser), 0)
+}
+func ProxyPrivAddWithGrant(builder *flatbuffers.Builder, withGrant bool) {
+	builder.PrependBoolSlot(4, withGrant, false)
+}
+func ProxyPrivAddGrantor(builder *flatbuffers.Builder, grantor flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(grantor), 0)
+}
+func ProxyPrivAddTimestamp(builder *flatbuffers.Builder, timestamp int64) {
+	builder.PrependInt64Slot(6, timestamp, 0)
+}
+func ProxyPrivEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
 type ReplicaSourceInfo struct {
 	_tab flatbuffers.Table
 }
@@ -1153,8 +1617,48 @@ func (rcv *MySQLDb) SuperUserLength() int {
 	return 0
 }
 
+func (rcv *MySQLDb) DefaultRoles(obj *DefaultRole, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *MySQLDb) DefaultRolesLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *MySQLDb) ProxiesPriv(obj *ProxyPriv, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *MySQLDb) ProxiesPrivLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
 func MySQLDbStart(builder *flatbuffers.Builder) {
-	builder.StartObject(4)
+	builder.StartObject(6)
 }
 func MySQLDbAddUser(builder *flatbuffers.Builder, user flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(user), 0)
@@ -1180,6 +1684,18 @@ func MySQLDbAddSuperUser(builder *flatbuffers.Builder, superUser flatbuffers.UOf
 func MySQLDbStartSuperUserVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(4, numElems, 4)
 }
+func MySQLDbAddDefaultRoles(builder *flatbuffers.Builder, defaultRoles flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(defaultRoles), 0)
+}
+func MySQLDbStartDefaultRolesVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func MySQLDbAddProxiesPriv(builder *flatbuffers.Builder, proxiesPriv flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(proxiesPriv), 0)
+}
+func MySQLDbStartProxiesPrivVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
 func MySQLDbEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
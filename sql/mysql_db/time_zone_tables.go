@@ -0,0 +1,228 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const timeZoneTableName = "time_zone"
+const timeZoneNameTableName = "time_zone_name"
+const timeZoneTransitionTableName = "time_zone_transition"
+const timeZoneTransitionTypeTableName = "time_zone_transition_type"
+const timeZoneLeapSecondTableName = "time_zone_leap_second"
+
+var timeZoneSchema sql.Schema
+var timeZoneNameSchema sql.Schema
+var timeZoneTransitionSchema sql.Schema
+var timeZoneTransitionTypeSchema sql.Schema
+var timeZoneLeapSecondSchema sql.Schema
+
+func init() {
+	char64_utf8mb3_general_ci := types.MustCreateString(sqltypes.Char, 64, sql.Collation_utf8mb3_general_ci)
+	char8_utf8mb3_general_ci := types.MustCreateString(sqltypes.Char, 8, sql.Collation_utf8mb3_general_ci)
+	enum_Y_N_utf8mb3_general_ci := types.MustCreateEnumType([]string{"Y", "N"}, sql.Collation_utf8mb3_general_ci)
+
+	timeZoneSchema = sql.Schema{
+		columnTemplate("Time_zone_id", timeZoneTableName, true, &sql.Column{
+			Type:          types.Uint32,
+			AutoIncrement: true,
+		}),
+		columnTemplate("Use_leap_seconds", timeZoneTableName, false, &sql.Column{
+			Type: enum_Y_N_utf8mb3_general_ci,
+		}),
+	}
+
+	timeZoneNameSchema = sql.Schema{
+		columnTemplate("Name", timeZoneNameTableName, true, &sql.Column{
+			Type: char64_utf8mb3_general_ci,
+		}),
+		columnTemplate("Time_zone_id", timeZoneNameTableName, false, &sql.Column{
+			Type: types.Uint32,
+		}),
+	}
+
+	timeZoneTransitionSchema = sql.Schema{
+		columnTemplate("Time_zone_id", timeZoneTransitionTableName, true, &sql.Column{
+			Type: types.Uint32,
+		}),
+		columnTemplate("Transition_time", timeZoneTransitionTableName, true, &sql.Column{
+			Type: types.Int64,
+		}),
+		columnTemplate("Transition_type_id", timeZoneTransitionTableName, false, &sql.Column{
+			Type: types.Uint32,
+		}),
+	}
+
+	timeZoneTransitionTypeSchema = sql.Schema{
+		columnTemplate("Time_zone_id", timeZoneTransitionTypeTableName, true, &sql.Column{
+			Type: types.Uint32,
+		}),
+		columnTemplate("Transition_type_id", timeZoneTransitionTypeTableName, true, &sql.Column{
+			Type: types.Uint32,
+		}),
+		columnTemplate("Offset", timeZoneTransitionTypeTableName, false, &sql.Column{
+			Type: types.Int32,
+		}),
+		columnTemplate("Is_DST", timeZoneTransitionTypeTableName, false, &sql.Column{
+			Type: types.Uint8,
+		}),
+		columnTemplate("Abbreviation", timeZoneTransitionTypeTableName, false, &sql.Column{
+			Type: char8_utf8mb3_general_ci,
+		}),
+	}
+
+	timeZoneLeapSecondSchema = sql.Schema{
+		columnTemplate("Transition_time", timeZoneLeapSecondTableName, true, &sql.Column{
+			Type: types.Int64,
+		}),
+		columnTemplate("Correction", timeZoneLeapSecondTableName, false, &sql.Column{
+			Type: types.Int32,
+		}),
+	}
+}
+
+// newTimeZoneTables returns the mysql.time_zone tables, whose contents are read from the sql.TimeZoneProvider when
+// they are queried. Transitions are listed for the range of a signed 32 bit timestamp, as in MySQL.
+func newTimeZoneTables(db *MySQLDb) (timeZone, name, transition, transitionType, leapSecond *mysqlTable) {
+	timeZone = newEmptyMySQLTable(timeZoneTableName, timeZoneSchema, db)
+	timeZone.rows = func(ctx *sql.Context) ([]sql.Row, error) {
+		names := sortedTimeZoneNames()
+		rows := make([]sql.Row, len(names))
+		for i := range names {
+			// Use_leap_seconds is always 'N'
+			rows[i] = sql.Row{uint32(i + 1), uint16(2)}
+		}
+		return rows, nil
+	}
+
+	name = newEmptyMySQLTable(timeZoneNameTableName, timeZoneNameSchema, db)
+	name.rows = func(ctx *sql.Context) ([]sql.Row, error) {
+		names := sortedTimeZoneNames()
+		rows := make([]sql.Row, len(names))
+		for i, n := range names {
+			rows[i] = sql.Row{n, uint32(i + 1)}
+		}
+		return rows, nil
+	}
+
+	transition = newEmptyMySQLTable(timeZoneTransitionTableName, timeZoneTransitionSchema, db)
+	transition.rows = func(ctx *sql.Context) ([]sql.Row, error) {
+		var rows []sql.Row
+		err := forEachTimeZone(func(id uint32, zone zoneTransitions) {
+			for _, tr := range zone.transitions {
+				rows = append(rows, sql.Row{id, tr.time, tr.typeID})
+			}
+		})
+		return rows, err
+	}
+
+	transitionType = newEmptyMySQLTable(timeZoneTransitionTypeTableName, timeZoneTransitionTypeSchema, db)
+	transitionType.rows = func(ctx *sql.Context) ([]sql.Row, error) {
+		var rows []sql.Row
+		err := forEachTimeZone(func(id uint32, zone zoneTransitions) {
+			for i, zt := range zone.types {
+				isDST := uint8(0)
+				if zt.isDST {
+					isDST = 1
+				}
+				rows = append(rows, sql.Row{id, uint32(i), int32(zt.offset), isDST, zt.abbreviation})
+			}
+		})
+		return rows, err
+	}
+
+	// leap seconds are not applied, which is the same as loading the zones without the right/ prefix
+	leapSecond = newEmptyMySQLTable(timeZoneLeapSecondTableName, timeZoneLeapSecondSchema, db)
+	return timeZone, name, transition, transitionType, leapSecond
+}
+
+// sortedTimeZoneNames returns the names of the provider's zones, sorted. A zone's id is its position in this list
+// plus one.
+func sortedTimeZoneNames() []string {
+	names := append([]string(nil), sql.GetTimeZoneProvider().Names()...)
+	sort.Strings(names)
+	return names
+}
+
+// zoneType is a local time type of a zone: its offset from UTC in seconds, whether it is daylight saving time and its
+// abbreviation
+type zoneType struct {
+	offset       int
+	isDST        bool
+	abbreviation string
+}
+
+type zoneTransition struct {
+	time   int64
+	typeID uint32
+}
+
+// zoneTransitions holds the local time types of a zone and the times at which the zone changes between them. The
+// first type is in effect before the first transition.
+type zoneTransitions struct {
+	types       []zoneType
+	transitions []zoneTransition
+}
+
+// forEachTimeZone calls |fn| with the id and transitions of every zone of the provider
+func forEachTimeZone(fn func(id uint32, zone zoneTransitions)) error {
+	for i, name := range sortedTimeZoneNames() {
+		loc, err := sql.LoadTimeZone(name)
+		if err != nil {
+			return err
+		}
+		fn(uint32(i+1), loadZoneTransitions(loc))
+	}
+	return nil
+}
+
+// loadZoneTransitions returns the transitions of |loc| within the range of a signed 32 bit timestamp
+func loadZoneTransitions(loc *time.Location) zoneTransitions {
+	var res zoneTransitions
+	typeIDs := make(map[zoneType]uint32)
+	typeID := func(t time.Time) uint32 {
+		abbreviation, offset := t.Zone()
+		zt := zoneType{offset: offset, isDST: t.IsDST(), abbreviation: abbreviation}
+		id, ok := typeIDs[zt]
+		if !ok {
+			id = uint32(len(res.types))
+			typeIDs[zt] = id
+			res.types = append(res.types, zt)
+		}
+		return id
+	}
+
+	t := time.Unix(math.MinInt32, 0).In(loc)
+	end := time.Unix(math.MaxInt32, 0)
+	typeID(t)
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || !next.After(t) || !next.Before(end) {
+			break
+		}
+		next = next.In(loc)
+		res.transitions = append(res.transitions, zoneTransition{time: next.Unix(), typeID: typeID(next)})
+		t = next
+	}
+	return res
+}
@@ -47,12 +47,65 @@ type User struct {
 	X509Subject string
 	X509Issuer  string
 
+	// MaxQuestions and MaxUpdates are the number of statements, and of statements that change data, that the user may
+	// execute per hour. Zero means that the user isn't limited.
+	MaxQuestions uint64
+	MaxUpdates   uint64
+
+	// PasswordExpired is true when the password has been expired manually, after which the user may only set a new
+	// password.
+	PasswordExpired bool
+	// PasswordLifetime is the number of days that a password is valid for, PasswordReuseHistory is the number of
+	// previous passwords that may not be reused, and PasswordReuseTime is the number of days before a previous
+	// password may be reused. Zero turns each policy off, and nil uses the default_password_lifetime, password_history
+	// and password_reuse_interval system variables respectively.
+	PasswordLifetime     *uint16
+	PasswordReuseHistory *uint16
+	PasswordReuseTime    *uint16
+	// PasswordHistory holds the current and previous passwords of the user, newest first, as long as the reuse
+	// policies need them.
+	PasswordHistory []PasswordHistoryEntry
+	// FailedLoginAttempts is the number of consecutive failed logins after which the account is locked for
+	// PasswordLockTime days, or until it's unlocked when PasswordLockTime is PasswordLockTimeUnbounded. Failed logins
+	// are only tracked when both are non-zero.
+	FailedLoginAttempts uint16
+	PasswordLockTime    int16
+
 	// IsRole is an additional field that states whether the User represents a role or user. In MySQL this must be a
 	// hidden column, therefore it's represented here as an additional field.
 	IsRole bool
 	// TODO: add the remaining fields
 }
 
+// PasswordLockTimeUnbounded is the PasswordLockTime of accounts that stay locked after too many failed logins until
+// they're unlocked.
+const PasswordLockTimeUnbounded = -1
+
+// PasswordHistoryEntry is a password that a user has had, stored as the authentication string of its plugin.
+type PasswordHistoryEntry struct {
+	Plugin     string
+	AuthString string
+	Changed    time.Time
+}
+
+// passwordLockingAttributes are the failed-login tracking options of a user, which MySQL stores as the
+// Password_locking member of the User_attributes column.
+type passwordLockingAttributes struct {
+	FailedLoginAttempts  int64 `json:"failed_login_attempts"`
+	PasswordLockTimeDays int64 `json:"password_lock_time_days"`
+}
+
+const passwordLockingAttribute = "Password_locking"
+
+// privilegeRestriction is a partial revoke of global privileges from a database, which MySQL stores as an element of
+// the Restrictions member of the User_attributes column.
+type privilegeRestriction struct {
+	Database   string   `json:"Database"`
+	Privileges []string `json:"Privileges"`
+}
+
+const restrictionsAttribute = "Restrictions"
+
 func UserToRow(ctx *sql.Context, u *User) (sql.Row, error) {
 	row := make(sql.Row, len(userTblSchema))
 	var err error
@@ -72,8 +125,18 @@ func UserToRow(ctx *sql.Context, u *User) (sql.Row, error) {
 	if u.Locked {
 		row[userTblColIndex_account_locked] = uint16(2)
 	}
-	if u.Attributes != nil {
-		row[userTblColIndex_User_attributes] = *u.Attributes
+	if u.PasswordExpired {
+		row[userTblColIndex_password_expired] = uint16(2)
+	}
+	row[userTblColIndex_password_lifetime] = nullableUint16(u.PasswordLifetime)
+	row[userTblColIndex_Password_reuse_history] = nullableUint16(u.PasswordReuseHistory)
+	row[userTblColIndex_Password_reuse_time] = nullableUint16(u.PasswordReuseTime)
+	attributes, err := u.userAttributes()
+	if err != nil {
+		return nil, err
+	}
+	if attributes != nil {
+		row[userTblColIndex_User_attributes] = *attributes
 	}
 	row[userTblColIndex_ssl_type] = u.SslType
 	// ssl_cipher, x509_issuer, x509_subject are all represented as BLOBs,
@@ -81,6 +144,8 @@ func UserToRow(ctx *sql.Context, u *User) (sql.Row, error) {
 	row[userTblColIndex_ssl_cipher] = []byte(u.SslCipher)
 	row[userTblColIndex_x509_issuer] = []byte(u.X509Issuer)
 	row[userTblColIndex_x509_subject] = []byte(u.X509Subject)
+	row[userTblColIndex_max_questions] = uint32(u.MaxQuestions)
+	row[userTblColIndex_max_updates] = uint32(u.MaxUpdates)
 
 	u.privSetToRow(ctx, row)
 	return row, nil
@@ -91,10 +156,20 @@ func UserFromRow(ctx *sql.Context, row sql.Row) (*User, error) {
 		return nil, err
 	}
 	//TODO: once the remaining fields are added, fill those in as well
-	var attributes *string
 	passwordLastChanged := time.Now().UTC()
-	if val, ok := row[userTblColIndex_User_attributes].(string); ok {
-		attributes = &val
+	attributes, locking, restrictions, err := parseUserAttributes(ctx, row[userTblColIndex_User_attributes])
+	if err != nil {
+		return nil, err
+	}
+	privSet := UserRowToPrivSet(ctx, row)
+	for _, restriction := range restrictions {
+		for _, privStr := range restriction.Privileges {
+			priv, ok := sql.PrivilegeTypeFromString(privStr)
+			if !ok {
+				return nil, fmt.Errorf(`unknown privilege type: "%s"`, privStr)
+			}
+			privSet.AddRestriction(restriction.Database, priv)
+		}
 	}
 	if val, ok := row[userTblColIndex_password_last_changed].(time.Time); ok {
 		passwordLastChanged = val
@@ -122,29 +197,149 @@ func UserFromRow(ctx *sql.Context, row sql.Row) (*User, error) {
 	}
 
 	return &User{
-		User:                row[userTblColIndex_User].(string),
-		Host:                row[userTblColIndex_Host].(string),
-		PrivilegeSet:        UserRowToPrivSet(ctx, row),
-		Plugin:              row[userTblColIndex_plugin].(string),
-		AuthString:          row[userTblColIndex_authentication_string].(string),
-		PasswordLastChanged: passwordLastChanged,
-		Locked:              row[userTblColIndex_account_locked].(uint16) == 2,
-		Attributes:          attributes,
-		Identity:            row[userTblColIndex_identity].(string),
-		IsRole:              false,
-		SslType:             sslType,
-		SslCipher:           string(row[userTblColIndex_ssl_cipher].([]byte)),
-		X509Issuer:          string(row[userTblColIndex_x509_issuer].([]byte)),
-		X509Subject:         string(row[userTblColIndex_x509_subject].([]byte)),
+		User:                 row[userTblColIndex_User].(string),
+		Host:                 row[userTblColIndex_Host].(string),
+		PrivilegeSet:         privSet,
+		Plugin:               row[userTblColIndex_plugin].(string),
+		AuthString:           row[userTblColIndex_authentication_string].(string),
+		PasswordLastChanged:  passwordLastChanged,
+		Locked:               row[userTblColIndex_account_locked].(uint16) == 2,
+		Attributes:           attributes,
+		Identity:             row[userTblColIndex_identity].(string),
+		IsRole:               false,
+		SslType:              sslType,
+		SslCipher:            string(row[userTblColIndex_ssl_cipher].([]byte)),
+		X509Issuer:           string(row[userTblColIndex_x509_issuer].([]byte)),
+		X509Subject:          string(row[userTblColIndex_x509_subject].([]byte)),
+		MaxQuestions:         uint64(row[userTblColIndex_max_questions].(uint32)),
+		MaxUpdates:           uint64(row[userTblColIndex_max_updates].(uint32)),
+		PasswordExpired:      row[userTblColIndex_password_expired].(uint16) == 2,
+		PasswordLifetime:     rowUint16(row[userTblColIndex_password_lifetime]),
+		PasswordReuseHistory: rowUint16(row[userTblColIndex_Password_reuse_history]),
+		PasswordReuseTime:    rowUint16(row[userTblColIndex_Password_reuse_time]),
+		FailedLoginAttempts:  uint16(locking.FailedLoginAttempts),
+		PasswordLockTime:     int16(locking.PasswordLockTimeDays),
 	}, nil
 }
 
+// userAttributes returns the value of the User_attributes column of |u|, which holds its attributes along with its
+// failed-login tracking options and the partial revokes of its privileges.
+func (u *User) userAttributes() (*string, error) {
+	restrictions := u.PrivilegeSet.getRestrictions()
+	if u.FailedLoginAttempts == 0 && u.PasswordLockTime == 0 && len(restrictions) == 0 {
+		return u.Attributes, nil
+	}
+	attributes := make(map[string]interface{})
+	if u.Attributes != nil {
+		if err := json.Unmarshal([]byte(*u.Attributes), &attributes); err != nil {
+			return nil, err
+		}
+	}
+	if u.FailedLoginAttempts != 0 || u.PasswordLockTime != 0 {
+		attributes[passwordLockingAttribute] = passwordLockingAttributes{
+			FailedLoginAttempts:  int64(u.FailedLoginAttempts),
+			PasswordLockTimeDays: int64(u.PasswordLockTime),
+		}
+	}
+	if len(restrictions) > 0 {
+		restrictionAttributes := make([]privilegeRestriction, len(restrictions))
+		for i, restriction := range restrictions {
+			restrictionAttributes[i].Database = restriction.Name()
+			for _, priv := range restriction.ToSlice() {
+				restrictionAttributes[i].Privileges = append(restrictionAttributes[i].Privileges, priv.String())
+			}
+		}
+		attributes[restrictionsAttribute] = restrictionAttributes
+	}
+	str, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+	val := string(str)
+	return &val, nil
+}
+
+// parseUserAttributes splits the User_attributes column |val| into the attributes of the user, its failed-login
+// tracking options and the partial revokes of its privileges.
+func parseUserAttributes(ctx *sql.Context, val interface{}) (*string, passwordLockingAttributes, []privilegeRestriction, error) {
+	var locking passwordLockingAttributes
+	var restrictions []privilegeRestriction
+	var str string
+	switch val := val.(type) {
+	case nil:
+		return nil, locking, nil, nil
+	case string:
+		str = val
+	case sql.JSONWrapper:
+		doc, err := val.ToInterface(ctx)
+		if err != nil {
+			return nil, locking, nil, err
+		}
+		b, err := json.Marshal(doc)
+		if err != nil {
+			return nil, locking, nil, err
+		}
+		str = string(b)
+	default:
+		return nil, locking, nil, fmt.Errorf("unexpected type for User_attributes value: %T", val)
+	}
+
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(str), &attributes); err != nil {
+		// attributes that aren't a JSON object can't hold any options
+		return &str, locking, nil, nil
+	}
+	lockingVal, hasLocking := attributes[passwordLockingAttribute]
+	restrictionsVal, hasRestrictions := attributes[restrictionsAttribute]
+	if !hasLocking && !hasRestrictions {
+		return &str, locking, nil, nil
+	}
+	if hasLocking {
+		if err := json.Unmarshal(lockingVal, &locking); err != nil {
+			return nil, locking, nil, err
+		}
+		delete(attributes, passwordLockingAttribute)
+	}
+	if hasRestrictions {
+		if err := json.Unmarshal(restrictionsVal, &restrictions); err != nil {
+			return nil, locking, nil, err
+		}
+		delete(attributes, restrictionsAttribute)
+	}
+	if len(attributes) == 0 {
+		return nil, locking, restrictions, nil
+	}
+	b, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, locking, nil, err
+	}
+	str = string(b)
+	return &str, locking, restrictions, nil
+}
+
+// nullableUint16 returns |val| as a value of a nullable SMALLINT UNSIGNED column.
+func nullableUint16(val *uint16) interface{} {
+	if val == nil {
+		return nil
+	}
+	return *val
+}
+
+// rowUint16 returns the value of a nullable SMALLINT UNSIGNED column.
+func rowUint16(val interface{}) *uint16 {
+	if v, ok := val.(uint16); ok {
+		return &v
+	}
+	return nil
+}
+
 func UserUpdateWithRow(ctx *sql.Context, row sql.Row, u *User) (*User, error) {
 	updatedUser, err := UserFromRow(ctx, row)
 	if err != nil {
 		return nil, err
 	}
 	updatedUser.IsRole = u.IsRole
+	updatedUser.PasswordHistory = u.PasswordHistory
 	return updatedUser, nil
 }
 
@@ -171,12 +366,35 @@ func UserEquals(left, right *User) bool {
 		left.SslType != right.SslType ||
 		left.X509Issuer != right.X509Issuer ||
 		left.X509Subject != right.X509Subject ||
-		left.SslCipher != right.SslCipher {
+		left.SslCipher != right.SslCipher ||
+		left.MaxQuestions != right.MaxQuestions ||
+		left.MaxUpdates != right.MaxUpdates ||
+		left.PasswordExpired != right.PasswordExpired ||
+		!uint16PtrEquals(left.PasswordLifetime, right.PasswordLifetime) ||
+		!uint16PtrEquals(left.PasswordReuseHistory, right.PasswordReuseHistory) ||
+		!uint16PtrEquals(left.PasswordReuseTime, right.PasswordReuseTime) ||
+		left.FailedLoginAttempts != right.FailedLoginAttempts ||
+		left.PasswordLockTime != right.PasswordLockTime ||
+		len(left.PasswordHistory) != len(right.PasswordHistory) {
 		return false
 	}
+	for i := range left.PasswordHistory {
+		if left.PasswordHistory[i].Plugin != right.PasswordHistory[i].Plugin ||
+			left.PasswordHistory[i].AuthString != right.PasswordHistory[i].AuthString ||
+			!left.PasswordHistory[i].Changed.Equal(right.PasswordHistory[i].Changed) {
+			return false
+		}
+	}
 	return true
 }
 
+func uint16PtrEquals(left, right *uint16) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+	return *left == *right
+}
+
 func UserCopy(u *User) *User {
 	uu := *u
 	uu.PrivilegeSet = NewPrivilegeSet()
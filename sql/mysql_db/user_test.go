@@ -29,6 +29,7 @@ func TestUserJson(t *testing.T) {
 	// Time is converted differently by the JSON functions depending on the OS, therefore a string comparison cannot
 	// be made without additional modifications.
 	ctx := sql.NewEmptyContext()
+	passwordReuseTime := uint16(10)
 	testUser := &User{
 		User:                "tester",
 		Host:                "localhost",
@@ -39,6 +40,15 @@ func TestUserJson(t *testing.T) {
 		Locked:              false,
 		Attributes:          nil,
 		IsRole:              false,
+		MaxQuestions:        100,
+		MaxUpdates:          10,
+		PasswordExpired:     true,
+		PasswordReuseTime:   &passwordReuseTime,
+		PasswordHistory: []PasswordHistoryEntry{
+			{Plugin: "mysql_native_password", AuthString: "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19", Changed: time.Unix(184301, 0)},
+		},
+		FailedLoginAttempts: 3,
+		PasswordLockTime:    PasswordLockTimeUnbounded,
 	}
 	testUser.PrivilegeSet.AddGlobalStatic(sql.PrivilegeType_Super)
 	testUser.PrivilegeSet.AddDatabase("some_db", sql.PrivilegeType_Select, sql.PrivilegeType_Insert)
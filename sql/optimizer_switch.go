@@ -0,0 +1,194 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+)
+
+const (
+	OptimizerSwitchSessionVar = "optimizer_switch"
+
+	// OptimizerSwitchDerivedMerge controls whether derived tables and views are merged into the outer query block.
+	OptimizerSwitchDerivedMerge = "derived_merge"
+
+	// OptimizerSwitchSkipScan controls whether grouping and DISTINCT queries may skip over the rows of an index that
+	// share a prefix, reading a single row for each group.
+	OptimizerSwitchSkipScan = "skip_scan"
+
+	// OptimizerSwitchEngineConditionPushdown controls whether conditions may be pushed down into tables that evaluate
+	// filters themselves, including runtime filters built from the other side of a hash join.
+	OptimizerSwitchEngineConditionPushdown = "engine_condition_pushdown"
+)
+
+// DefaultOptimizerSwitch is the default value of the optimizer_switch system variable in MySQL 8.
+var DefaultOptimizerSwitch = strings.Join([]string{
+	"index_merge=on",
+	"index_merge_union=on",
+	"index_merge_sort_union=on",
+	"index_merge_intersection=on",
+	"engine_condition_pushdown=on",
+	"index_condition_pushdown=on",
+	"mrr=on",
+	"mrr_cost_based=on",
+	"block_nested_loop=on",
+	"batched_key_access=off",
+	"materialization=on",
+	"semijoin=on",
+	"loosescan=on",
+	"firstmatch=on",
+	"duplicateweedout=on",
+	"subquery_materialization_cost_based=on",
+	"use_index_extensions=on",
+	"condition_fanout_filter=on",
+	"derived_merge=on",
+	"use_invisible_indexes=off",
+	"skip_scan=on",
+	"hash_join=on",
+	"subquery_to_derived=off",
+	"prefer_ordering_index=on",
+	"hypergraph_optimizer=off",
+	"derived_condition_pushdown=on",
+}, ",")
+
+var defaultOptimizerSwitch = parseOptimizerSwitchFlags(DefaultOptimizerSwitch)
+
+// optimizerSwitchFlagNames lists the flags of DefaultOptimizerSwitch in order.
+var optimizerSwitchFlagNames = func() []string {
+	pairs := strings.Split(DefaultOptimizerSwitch, ",")
+	names := make([]string, len(pairs))
+	for i, pair := range pairs {
+		names[i], _, _ = strings.Cut(pair, "=")
+	}
+	return names
+}()
+
+// OptimizerSwitch encodes the flags of the optimizer_switch system variable.
+type OptimizerSwitch struct {
+	flags map[string]bool
+}
+
+// LoadOptimizerSwitch loads the optimizer_switch flags using the session data contained in |ctx|. Flags that are not
+// present in the session value take their default value, which mirrors how MySQL applies a partial assignment such as
+// SET optimizer_switch = 'derived_merge=off'.
+func LoadOptimizerSwitch(ctx *Context) *OptimizerSwitch {
+	val, err := ctx.Session.GetSessionVariable(ctx, OptimizerSwitchSessionVar)
+	if err != nil {
+		return &OptimizerSwitch{flags: defaultOptimizerSwitch}
+	}
+	s, ok := val.(string)
+	if !ok {
+		ctx.GetLogger().Warnf("optimizer_switch system variable value is invalid: '%v'", val)
+		return &OptimizerSwitch{flags: defaultOptimizerSwitch}
+	}
+	return NewOptimizerSwitchFromString(s)
+}
+
+// NewOptimizerSwitchFromString returns a new OptimizerSwitch from a comma-delimited list of flag=value pairs (e.g.
+// "derived_merge=off,hash_join=on"). The value "default" resets a flag to its default value.
+func NewOptimizerSwitchFromString(s string) *OptimizerSwitch {
+	if s == DefaultOptimizerSwitch {
+		return &OptimizerSwitch{flags: defaultOptimizerSwitch}
+	}
+	flags := make(map[string]bool, len(defaultOptimizerSwitch))
+	for k, v := range defaultOptimizerSwitch {
+		flags[k] = v
+	}
+	for k, v := range parseOptimizerSwitchFlags(s) {
+		flags[k] = v
+	}
+	return &OptimizerSwitch{flags: flags}
+}
+
+// MergeOptimizerSwitch applies the flag assignments in |assigned| to the optimizer_switch value |current| and returns
+// the full list of flags, in the order MySQL reports them. Flags that |assigned| doesn't mention keep their value from
+// |current|, a flag set to "default" takes its default value, and the value "default" resets every flag. Unknown
+// flags and values other than on, off and default are an error, as in MySQL.
+func MergeOptimizerSwitch(current, assigned string) (string, error) {
+	if strings.EqualFold(strings.TrimSpace(assigned), "default") {
+		return DefaultOptimizerSwitch, nil
+	}
+	flags := NewOptimizerSwitchFromString(current).flags
+	merged := make(map[string]bool, len(flags))
+	for k, v := range flags {
+		merged[k] = v
+	}
+	for _, pair := range strings.Split(strings.ToLower(assigned), ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		name = strings.TrimSpace(name)
+		if _, known := defaultOptimizerSwitch[name]; !ok || !known {
+			return "", ErrInvalidSystemVariableValue.New(OptimizerSwitchSessionVar, assigned)
+		}
+		switch strings.TrimSpace(value) {
+		case "on":
+			merged[name] = true
+		case "off":
+			merged[name] = false
+		case "default":
+			merged[name] = defaultOptimizerSwitch[name]
+		default:
+			return "", ErrInvalidSystemVariableValue.New(OptimizerSwitchSessionVar, assigned)
+		}
+	}
+
+	pairs := make([]string, len(optimizerSwitchFlagNames))
+	for i, name := range optimizerSwitchFlagNames {
+		value := "off"
+		if merged[name] {
+			value = "on"
+		}
+		pairs[i] = name + "=" + value
+	}
+	return strings.Join(pairs, ","), nil
+}
+
+// parseOptimizerSwitchFlags parses the flag=value pairs in |s|. Malformed entries and flags set to "default" are
+// omitted from the result.
+func parseOptimizerSwitchFlags(s string) map[string]bool {
+	flags := make(map[string]bool)
+	for _, pair := range strings.Split(strings.ToLower(s), ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(value) {
+		case "on":
+			flags[strings.TrimSpace(name)] = true
+		case "off":
+			flags[strings.TrimSpace(name)] = false
+		}
+	}
+	return flags
+}
+
+// FlagEnabled returns whether the optimizer switch |flag| is on. Unknown flags are reported as off.
+func (s *OptimizerSwitch) FlagEnabled(flag string) bool {
+	return s.flags[strings.ToLower(flag)]
+}
+
+// DerivedMerge returns whether derived tables and views may be merged into the outer query block.
+func (s *OptimizerSwitch) DerivedMerge() bool {
+	return s.FlagEnabled(OptimizerSwitchDerivedMerge)
+}
+
+// SkipScan returns whether index scans may skip ahead to the next distinct prefix of an index.
+func (s *OptimizerSwitch) SkipScan() bool {
+	return s.FlagEnabled(OptimizerSwitchSkipScan)
+}
+
+// EngineConditionPushdown returns whether conditions may be pushed into tables that evaluate filters themselves.
+func (s *OptimizerSwitch) EngineConditionPushdown() bool {
+	return s.FlagEnabled(OptimizerSwitchEngineConditionPushdown)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizerSwitch(t *testing.T) {
+	s := NewOptimizerSwitchFromString(DefaultOptimizerSwitch)
+	assert.True(t, s.DerivedMerge())
+	assert.True(t, s.SkipScan())
+	assert.True(t, s.FlagEnabled("hash_join"))
+	assert.False(t, s.FlagEnabled("batched_key_access"))
+	assert.False(t, s.FlagEnabled("fake_flag"))
+
+	// flags missing from a partial assignment keep their default value
+	s = NewOptimizerSwitchFromString("derived_merge=off")
+	assert.False(t, s.DerivedMerge())
+	assert.True(t, s.SkipScan())
+	assert.True(t, s.FlagEnabled("hash_join"))
+
+	s = NewOptimizerSwitchFromString("HASH_JOIN=OFF, derived_merge=default")
+	assert.True(t, s.DerivedMerge())
+	assert.False(t, s.FlagEnabled("hash_join"))
+
+	s = NewOptimizerSwitchFromString("derived_merge,batched_key_access=on")
+	assert.True(t, s.DerivedMerge())
+	assert.True(t, s.FlagEnabled("batched_key_access"))
+}
+
+func TestMergeOptimizerSwitch(t *testing.T) {
+	merged, err := MergeOptimizerSwitch(DefaultOptimizerSwitch, "derived_merge=off")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Replace(DefaultOptimizerSwitch, "derived_merge=on", "derived_merge=off", 1), merged)
+
+	// flags set by an earlier assignment keep their value
+	merged, err = MergeOptimizerSwitch(merged, "SKIP_SCAN=OFF, derived_merge=default")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Replace(DefaultOptimizerSwitch, "skip_scan=on", "skip_scan=off", 1), merged)
+
+	merged, err = MergeOptimizerSwitch(merged, "default")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultOptimizerSwitch, merged)
+
+	_, err = MergeOptimizerSwitch(DefaultOptimizerSwitch, "fake_flag=on")
+	assert.True(t, ErrInvalidSystemVariableValue.Is(err))
+	_, err = MergeOptimizerSwitch(DefaultOptimizerSwitch, "derived_merge")
+	assert.True(t, ErrInvalidSystemVariableValue.Is(err))
+}
@@ -14,7 +14,13 @@
 
 package sql
 
-import "io"
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+)
 
 // Partition represents a partition from a SQL table.
 type Partition interface {
@@ -48,3 +54,315 @@ func (i *slicePartitionIter) Close(*Context) error {
 	i.partitions = nil
 	return nil
 }
+
+// PartitionedTable is a table whose rows are divided into named partitions by the value of one of its columns, like a
+// table defined with MySQL's PARTITION BY RANGE, LIST or HASH. The analyzer uses the table's PartitionScheme to prune
+// the partitions that the filters of a query rule out, so that scans of the table only read the partitions that may
+// hold matching rows.
+type PartitionedTable interface {
+	Table
+	// PartitionScheme returns how the rows of the table are divided into partitions.
+	PartitionScheme(ctx *Context) PartitionScheme
+	// WithPartitionNames returns a version of this table whose Partitions only returns the partitions named, in the
+	// order of its PartitionScheme. A zero-length slice of names is valid and indicates that no partition may hold
+	// matching rows.
+	WithPartitionNames(ctx *Context, names []string) (Table, error)
+	// PartitionNames returns the names of the partitions the table has been restricted to by WithPartitionNames, or nil
+	// if it hasn't been restricted.
+	PartitionNames() []string
+}
+
+// PartitionAlterableTable is a PartitionedTable whose partitioning is defined by the PARTITION BY clause of CREATE
+// TABLE and ALTER TABLE statements, and changed by their partition management clauses. Such tables store each row they
+// write in the partition given by PartitionScheme.PartitionFor, failing writes of rows that belong to no partition.
+// Tables that don't implement this interface are created without partitions.
+type PartitionAlterableTable interface {
+	PartitionedTable
+	// SetPartitionScheme partitions the table with |scheme|, moving its rows into their new partitions. A zero
+	// PartitionScheme removes the partitioning of the table.
+	SetPartitionScheme(ctx *Context, scheme PartitionScheme) error
+	// AddPartitions adds the partitions given after the existing partitions of the table. Adding partitions to a table
+	// partitioned by HASH or KEY moves its rows into their new partitions.
+	AddPartitions(ctx *Context, partitions []PartitionDefinition) error
+	// DropPartitions drops the partitions named, and the rows they hold, from a table partitioned by RANGE or LIST.
+	DropPartitions(ctx *Context, names []string) error
+	// TruncatePartitions deletes the rows of the partitions named.
+	TruncatePartitions(ctx *Context, names []string) error
+}
+
+// PartitionMethod is the method by which the rows of a PartitionedTable are assigned to its partitions.
+type PartitionMethod byte
+
+const (
+	// PartitionMethodRange assigns each row to the first partition whose LessThan bound is greater than the value of
+	// the partitioning column. Rows with a NULL value belong to the first partition.
+	PartitionMethodRange PartitionMethod = iota + 1
+	// PartitionMethodList assigns each row to the partition whose Values include the value of the partitioning
+	// column.
+	PartitionMethodList
+	// PartitionMethodHash assigns each row to the partition at the position of the absolute value of the integer
+	// value of the partitioning column, modulo the number of partitions. Rows with a NULL value belong to the first
+	// partition.
+	PartitionMethodHash
+	// PartitionMethodKey assigns each row to the partition at the position of a hash of the value of the partitioning
+	// column, modulo the number of partitions. Rows with a NULL value belong to the first partition.
+	PartitionMethodKey
+)
+
+// String returns the name of the partitioning method as used by the PARTITION BY clause.
+func (m PartitionMethod) String() string {
+	switch m {
+	case PartitionMethodRange:
+		return "RANGE"
+	case PartitionMethodList:
+		return "LIST"
+	case PartitionMethodHash:
+		return "HASH"
+	case PartitionMethodKey:
+		return "KEY"
+	default:
+		return ""
+	}
+}
+
+// PartitionScheme describes how the rows of a PartitionedTable are divided into partitions.
+type PartitionScheme struct {
+	// Method is the method by which rows are assigned to partitions.
+	Method PartitionMethod
+	// Column is the name of the column whose value determines the partition of a row.
+	Column string
+	// Partitions are the definitions of the table's partitions, in order.
+	Partitions []PartitionDefinition
+	// Linear is true for tables partitioned by LINEAR HASH or LINEAR KEY, which assign rows to partitions with a
+	// powers-of-two algorithm rather than a modulus.
+	Linear bool
+	// Columns is true for tables partitioned by RANGE COLUMNS or LIST COLUMNS, whose bounds and values may be of any
+	// type rather than only integers.
+	Columns bool
+}
+
+// IsPartitioned returns whether the scheme divides a table into partitions. The zero PartitionScheme doesn't.
+func (s PartitionScheme) IsPartitioned() bool {
+	return s.Method != 0 && len(s.Partitions) > 0
+}
+
+// PartitionIndex returns the position of the partition named, or -1 if the scheme has no such partition. Partition
+// names are case-insensitive.
+func (s PartitionScheme) PartitionIndex(name string) int {
+	for i, def := range s.Partitions {
+		if strings.EqualFold(def.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Validate returns an error if the partitions of the scheme don't define a valid partitioning of a table whose
+// partitioning column has the type |typ|: partition names must be unique, the bounds of RANGE partitions must be
+// increasing with only the last one being MAXVALUE, and no value may belong to more than one LIST partition.
+func (s PartitionScheme) Validate(ctx *Context, typ Type) error {
+	names := make(map[string]struct{}, len(s.Partitions))
+	var values []interface{}
+	for i, def := range s.Partitions {
+		lower := strings.ToLower(def.Name)
+		if _, ok := names[lower]; ok {
+			return ErrDuplicatePartitionName.New(def.Name)
+		}
+		names[lower] = struct{}{}
+
+		switch s.Method {
+		case PartitionMethodRange:
+			if def.LessThan == nil {
+				if i < len(s.Partitions)-1 {
+					return ErrPartitionMaxValue.New()
+				}
+				continue
+			}
+			if i > 0 {
+				cmp, err := typ.Compare(ctx, s.Partitions[i-1].LessThan, def.LessThan)
+				if err != nil {
+					return err
+				}
+				if cmp >= 0 {
+					return ErrPartitionRangeNotIncreasing.New()
+				}
+			}
+		case PartitionMethodList:
+			for _, v := range def.Values {
+				for _, other := range values {
+					if v == nil || other == nil {
+						if v == nil && other == nil {
+							return ErrPartitionListDuplicateValue.New()
+						}
+						continue
+					}
+					cmp, err := typ.Compare(ctx, v, other)
+					if err != nil {
+						return err
+					}
+					if cmp == 0 {
+						return ErrPartitionListDuplicateValue.New()
+					}
+				}
+				values = append(values, v)
+			}
+		}
+	}
+	return nil
+}
+
+// PartitionFor returns the position of the partition that holds the rows whose partitioning column, of type |typ|,
+// has the value |val|. Returns ErrNoPartitionForValue if none of the partitions of a table partitioned by RANGE or
+// LIST may hold the value.
+func (s PartitionScheme) PartitionFor(ctx *Context, typ Type, val interface{}) (int, error) {
+	n := len(s.Partitions)
+	switch s.Method {
+	case PartitionMethodRange:
+		if val == nil && n > 0 {
+			return 0, nil
+		}
+		for i, def := range s.Partitions {
+			if def.LessThan == nil {
+				return i, nil
+			}
+			cmp, err := typ.Compare(ctx, val, def.LessThan)
+			if err != nil {
+				return 0, err
+			}
+			if cmp < 0 {
+				return i, nil
+			}
+		}
+	case PartitionMethodList:
+		for i, def := range s.Partitions {
+			for _, v := range def.Values {
+				if v == nil || val == nil {
+					if v == nil && val == nil {
+						return i, nil
+					}
+					continue
+				}
+				cmp, err := typ.Compare(ctx, val, v)
+				if err != nil {
+					return 0, err
+				}
+				if cmp == 0 {
+					return i, nil
+				}
+			}
+		}
+	case PartitionMethodHash, PartitionMethodKey:
+		if n == 0 {
+			break
+		}
+		if val == nil {
+			return 0, nil
+		}
+		h, err := s.hashOf(ctx, typ, val)
+		if err != nil {
+			return 0, err
+		}
+		if !s.Linear {
+			return int(h % uint64(n)), nil
+		}
+		// LINEAR partitioning masks the hash with the smallest power of two that covers the partitions, halving the
+		// mask until the result names a partition
+		mask := uint64(1)
+		for mask < uint64(n) {
+			mask <<= 1
+		}
+		i := h & (mask - 1)
+		for i >= uint64(n) {
+			mask >>= 1
+			i = h & (mask - 1)
+		}
+		return int(i), nil
+	}
+	if val == nil {
+		val = "NULL"
+	}
+	return 0, ErrNoPartitionForValue.New(val)
+}
+
+// hashOf returns the hash that assigns rows with the non-NULL value |val| of the partitioning column to partitions.
+// HASH partitioning uses the absolute value of the integer value of the column, and KEY partitioning a hash of the
+// value that's equal for the values the type of the column considers equal.
+func (s PartitionScheme) hashOf(ctx *Context, typ Type, val interface{}) (uint64, error) {
+	if s.Method == PartitionMethodHash {
+		var i int64
+		switch v := val.(type) {
+		case int8:
+			i = int64(v)
+		case int16:
+			i = int64(v)
+		case int32:
+			i = int64(v)
+		case int64:
+			i = v
+		case int:
+			i = int64(v)
+		case uint8:
+			return uint64(v), nil
+		case uint16:
+			return uint64(v), nil
+		case uint32:
+			return uint64(v), nil
+		case uint64:
+			return v, nil
+		default:
+			return 0, fmt.Errorf("HASH partitioning requires an integer value, got %v", val)
+		}
+		if i < 0 {
+			i = -i
+		}
+		return uint64(i), nil
+	}
+
+	hash := xxhash.New()
+	if st, ok := typ.(StringType); ok {
+		converted, _, err := st.Convert(ctx, val)
+		if err != nil {
+			return 0, err
+		}
+		if str, ok := converted.(string); ok {
+			if err = st.Collation().WriteWeightString(hash, str); err != nil {
+				return 0, err
+			}
+			return hash.Sum64(), nil
+		}
+	}
+	if _, err := fmt.Fprintf(hash, "%v", val); err != nil {
+		return 0, err
+	}
+	return hash.Sum64(), nil
+}
+
+// ValueString returns |v|, a bound or value of a partition of the column of type |typ|, as it's written in the
+// definition of the partition.
+func (s PartitionScheme) ValueString(ctx *Context, typ Type, v interface{}) (string, error) {
+	if v == nil {
+		return "NULL", nil
+	}
+	if !s.Columns || typ == nil {
+		return fmt.Sprintf("%v", v), nil
+	}
+	val, err := typ.SQL(ctx, nil, v)
+	if err != nil {
+		return "", err
+	}
+	if IsNumberType(typ) {
+		return val.ToString(), nil
+	}
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(val.ToString(), "'", "''")), nil
+}
+
+// PartitionDefinition defines a partition of a PartitionedTable.
+type PartitionDefinition struct {
+	// Name is the name of the partition.
+	Name string
+	// LessThan is the exclusive upper bound of the values in a partition of a table partitioned by RANGE, or nil for
+	// MAXVALUE. The partitions of such a table are in ascending order of their bounds.
+	LessThan interface{}
+	// Values are the values in a partition of a table partitioned by LIST, which may include nil for NULL.
+	Values []interface{}
+}
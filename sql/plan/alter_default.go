@@ -233,7 +233,7 @@ func getTableFromDatabase(ctx *sql.Context, db sql.Database, tableNode sql.Node)
 	// Grab the table fresh from the database.
 	tableName := getTableName(tableNode)
 
-	table, ok, err := db.GetTableInsensitive(ctx, tableName)
+	table, ok, err := sql.GetSessionTableInsensitive(ctx, db, tableName)
 	if err != nil {
 		return nil, err
 	}
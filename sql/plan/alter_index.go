@@ -74,6 +74,8 @@ type AlterIndex struct {
 	DisableKeys bool
 	// Predicate is the WHERE clause expression for partial indexes. May be nil.
 	Predicate sql.Expression
+	// VectorDistance names the distance function a VECTOR index orders rows by, or is empty for the default.
+	VectorDistance string
 }
 
 var _ sql.SchemaTarget = (*AlterIndex)(nil)
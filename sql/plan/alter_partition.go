@@ -0,0 +1,161 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+type PartitionAction byte
+
+const (
+	// PartitionAction_Partition partitions the table with a new PartitionScheme
+	PartitionAction_Partition PartitionAction = iota
+	// PartitionAction_Remove removes the partitioning of the table
+	PartitionAction_Remove
+	// PartitionAction_Add adds partitions to the table
+	PartitionAction_Add
+	// PartitionAction_Drop drops partitions, and their rows, from the table
+	PartitionAction_Drop
+	// PartitionAction_Truncate deletes the rows of partitions of the table
+	PartitionAction_Truncate
+	// PartitionAction_Coalesce removes partitions from a table partitioned by HASH or KEY, keeping their rows
+	PartitionAction_Coalesce
+)
+
+// String returns the clause of an ALTER TABLE statement that performs the action.
+func (a PartitionAction) String() string {
+	switch a {
+	case PartitionAction_Partition:
+		return "partition by"
+	case PartitionAction_Remove:
+		return "remove partitioning"
+	case PartitionAction_Add:
+		return "add partition"
+	case PartitionAction_Drop:
+		return "drop partition"
+	case PartitionAction_Truncate:
+		return "truncate partition"
+	case PartitionAction_Coalesce:
+		return "coalesce partition"
+	default:
+		return "unknown partition action"
+	}
+}
+
+// AlterPartition is a node describing a partition management clause of an ALTER TABLE statement, or the PARTITION BY
+// clause that partitions a table again.
+type AlterPartition struct {
+	ddlNode
+	Table  sql.Node
+	Action PartitionAction
+	// Scheme is the new partitioning of the table for PartitionAction_Partition
+	Scheme sql.PartitionScheme
+	// Definitions are the partitions added by PartitionAction_Add. The partitions added to a table partitioned by HASH
+	// or KEY are only named.
+	Definitions []sql.PartitionDefinition
+	// Names are the partitions dropped or truncated. Truncating partitions without names truncates all of them.
+	Names []string
+	// Count is the number of partitions added to or removed from a table partitioned by HASH or KEY, when they're not
+	// named
+	Count int
+}
+
+var _ sql.Node = (*AlterPartition)(nil)
+var _ sql.Databaser = (*AlterPartition)(nil)
+var _ sql.CollationCoercible = (*AlterPartition)(nil)
+
+func NewAlterPartition(table *ResolvedTable, action PartitionAction) *AlterPartition {
+	return &AlterPartition{
+		ddlNode: ddlNode{Db: table.SqlDatabase},
+		Table:   table,
+		Action:  action,
+	}
+}
+
+// WithDatabase implements the interface sql.Databaser
+func (ap *AlterPartition) WithDatabase(db sql.Database) (sql.Node, error) {
+	nap := *ap
+	nap.Db = db
+	return &nap, nil
+}
+
+// IsReadOnly implements the interface sql.Node
+func (ap *AlterPartition) IsReadOnly() bool {
+	return false
+}
+
+// String implements the interface sql.Node
+func (ap *AlterPartition) String() string {
+	switch ap.Action {
+	case PartitionAction_Drop, PartitionAction_Truncate:
+		if len(ap.Names) == 0 {
+			return fmt.Sprintf("alter table %s %s all", ap.Table.String(), ap.Action)
+		}
+		return fmt.Sprintf("alter table %s %s %s", ap.Table.String(), ap.Action, strings.Join(ap.Names, ", "))
+	case PartitionAction_Partition:
+		return fmt.Sprintf("alter table %s %s %s(%s)", ap.Table.String(), ap.Action, ap.Scheme.Method, ap.Scheme.Column)
+	case PartitionAction_Add, PartitionAction_Coalesce:
+		if ap.Count > 0 {
+			return fmt.Sprintf("alter table %s %s %d", ap.Table.String(), ap.Action, ap.Count)
+		}
+		names := make([]string, len(ap.Definitions))
+		for i, def := range ap.Definitions {
+			names[i] = def.Name
+		}
+		return fmt.Sprintf("alter table %s %s (%s)", ap.Table.String(), ap.Action, strings.Join(names, ", "))
+	default:
+		return fmt.Sprintf("alter table %s %s", ap.Table.String(), ap.Action)
+	}
+}
+
+// DebugString implements the interface sql.Node
+func (ap *AlterPartition) DebugString(ctx *sql.Context) string {
+	return ap.String()
+}
+
+// Resolved implements the interface sql.Node
+func (ap *AlterPartition) Resolved() bool {
+	return ap.Table.Resolved() && ap.ddlNode.Resolved()
+}
+
+// Schema implements the interface sql.Node
+func (ap *AlterPartition) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Children implements the interface sql.Node
+func (ap *AlterPartition) Children() []sql.Node {
+	return []sql.Node{ap.Table}
+}
+
+// WithChildren implements the interface sql.Node
+func (ap *AlterPartition) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(ap, len(children), 1)
+	}
+	nap := *ap
+	nap.Table = children[0]
+	return &nap, nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*AlterPartition) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
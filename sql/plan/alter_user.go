@@ -23,7 +23,11 @@ import (
 
 // AlterUser represents the statement ALTER USER.
 type AlterUser struct {
-	MySQLDb  sql.Database
+	MySQLDb         sql.Database
+	AccountLimits   *AccountLimits
+	PasswordOptions *PasswordOptions
+	// Locked is set by ACCOUNT LOCK and ACCOUNT UNLOCK, and is nil when neither is given.
+	Locked   *bool
 	User     AuthenticatedUser
 	IfExists bool
 }
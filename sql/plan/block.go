@@ -24,6 +24,7 @@ type Block struct {
 	Pref       *expression.ProcedureReference
 	statements []sql.Node
 	rowIterSch sql.Schema
+	alterTable bool
 }
 
 // RepresentsBlock is an interface that defines whether a node contains a Block node, or contains multiple child
@@ -61,6 +62,17 @@ func NewBlock(statements []sql.Node) *Block {
 	return &Block{statements: statements}
 }
 
+// NewAlterTableBlock creates a new *Block node for the statements of a multi-clause ALTER TABLE. Its statements are
+// executed as a single schema change: if any of them fails, the changes made by the ones before it are rolled back.
+func NewAlterTableBlock(statements []sql.Node) *Block {
+	return &Block{statements: statements, alterTable: true}
+}
+
+// IsAlterTable returns whether this block holds the statements of a multi-clause ALTER TABLE.
+func (b *Block) IsAlterTable() bool {
+	return b.alterTable
+}
+
 // Resolved implements the sql.Node interface.
 func (b *Block) Resolved() bool {
 	for _, s := range b.statements {
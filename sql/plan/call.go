@@ -206,6 +206,11 @@ func (c *Call) Dispose(ctx *sql.Context) {
 	}
 }
 
+// Catalog returns the catalog that the procedure was resolved with.
+func (c *Call) Catalog() sql.Catalog {
+	return c.cat
+}
+
 // SetStatementRunner implements the sql.InterpreterNode interface.
 func (c *Call) SetStatementRunner(ctx *sql.Context, runner sql.StatementRunner) sql.Node {
 	nc := *c
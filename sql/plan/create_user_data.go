@@ -81,7 +81,9 @@ type AccountLimits struct {
 	MaxUserConnections    *int64
 }
 
-// PasswordOptions states how to handle a user's passwords.
+// PasswordOptions states how to handle a user's passwords. Options that aren't given are nil, and options that are set
+// to DEFAULT are PasswordOptionDefault. An ExpirationTime of zero never expires the password, and a LockTime of
+// PasswordLockTimeUnbounded keeps an account that's locked by failed logins locked until it's unlocked.
 type PasswordOptions struct {
 	ExpirationTime *int64
 	History        *int64
@@ -89,9 +91,18 @@ type PasswordOptions struct {
 	FailedAttempts *int64
 	LockTime       *int64
 
+	// Expire expires the password right away, as PASSWORD EXPIRE does.
+	Expire                 bool
 	RequireCurrentOptional bool
 }
 
+const (
+	// PasswordOptionDefault is the value of a PasswordOptions field that's set to DEFAULT.
+	PasswordOptionDefault int64 = -1
+	// PasswordLockTimeUnbounded is the LockTime of PASSWORD_LOCK_TIME UNBOUNDED.
+	PasswordLockTimeUnbounded int64 = -1
+)
+
 // CachingSha2PasswordAuthentication implements the Authentication interface for the
 // caching_sha2_password auth plugin.
 type CachingSha2PasswordAuthentication struct {
@@ -166,6 +177,19 @@ func (a AuthenticationMysqlNativePassword) AuthString() (string, error) {
 	return "*" + strings.ToUpper(hex.EncodeToString(s2)), nil
 }
 
+// AuthenticationPassword returns the password that |auth| hashes, and false if |auth| doesn't hash a password, as its
+// plugin authenticates users some other way.
+func AuthenticationPassword(auth Authentication) (string, bool) {
+	switch auth := auth.(type) {
+	case AuthenticationMysqlNativePassword:
+		return string(auth), true
+	case CachingSha2PasswordAuthentication:
+		return auth.password, true
+	default:
+		return "", false
+	}
+}
+
 // NewDefaultAuthentication returns the given password with the default
 // authentication method.
 func NewDefaultAuthentication(password string) Authentication {
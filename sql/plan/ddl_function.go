@@ -0,0 +1,203 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// CreateFunction is the CREATE FUNCTION statement for a stored function written in SQL.
+type CreateFunction struct {
+	ddlNode ddlNode
+
+	StoredFunctionDetails sql.StoredFunctionDetails
+	BodyString            string
+	Params                []ProcedureParam
+	ReturnType            sql.Type
+	Characteristics       []Characteristic
+	SecurityContext       ProcedureSecurityContext
+	Comment               string
+	IfNotExists           bool
+}
+
+var _ sql.Node = (*CreateFunction)(nil)
+var _ sql.Databaser = (*CreateFunction)(nil)
+var _ sql.DebugStringer = (*CreateFunction)(nil)
+var _ sql.CollationCoercible = (*CreateFunction)(nil)
+
+// NewCreateFunction returns a *CreateFunction node.
+func NewCreateFunction(
+	db sql.Database,
+	storedFunctionDetails sql.StoredFunctionDetails,
+	bodyString string,
+	params []ProcedureParam,
+	returnType sql.Type,
+	characteristics []Characteristic,
+	securityContext ProcedureSecurityContext,
+	comment string,
+	ifNotExists bool,
+) *CreateFunction {
+	return &CreateFunction{
+		ddlNode:               ddlNode{db},
+		StoredFunctionDetails: storedFunctionDetails,
+		BodyString:            bodyString,
+		Params:                params,
+		ReturnType:            returnType,
+		Characteristics:       characteristics,
+		SecurityContext:       securityContext,
+		Comment:               comment,
+		IfNotExists:           ifNotExists,
+	}
+}
+
+// IsDeterministic returns whether |characteristics| declare a routine DETERMINISTIC. The last of DETERMINISTIC and
+// NOT DETERMINISTIC wins, and routines are not deterministic by default.
+func IsDeterministic(characteristics []Characteristic) bool {
+	deterministic := false
+	for _, ch := range characteristics {
+		if ch == Characteristic_Deterministic {
+			deterministic = true
+		} else if ch == Characteristic_NotDeterministic {
+			deterministic = false
+		}
+	}
+	return deterministic
+}
+
+// Database implements the sql.Databaser interface.
+func (c *CreateFunction) Database() sql.Database {
+	return c.ddlNode.Db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (c *CreateFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	cf := *c
+	cf.ddlNode.Db = database
+	return &cf, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (c *CreateFunction) Resolved() bool {
+	return c.ddlNode.Resolved()
+}
+
+func (c *CreateFunction) IsReadOnly() bool {
+	return false
+}
+
+// Schema implements the sql.Node interface.
+func (c *CreateFunction) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Children implements the sql.Node interface.
+func (c *CreateFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CreateFunction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CreateFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// String implements the sql.Node interface.
+func (c *CreateFunction) String() string {
+	return c.StoredFunctionDetails.CreateStatement
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (c *CreateFunction) DebugString(ctx *sql.Context) string {
+	return c.StoredFunctionDetails.CreateStatement
+}
+
+// DropFunction is the DROP FUNCTION statement for a stored function written in SQL.
+type DropFunction struct {
+	Db           sql.Database
+	FunctionName string
+	IfExists     bool
+}
+
+var _ sql.Databaser = (*DropFunction)(nil)
+var _ sql.Node = (*DropFunction)(nil)
+var _ sql.CollationCoercible = (*DropFunction)(nil)
+
+// NewDropFunction creates a new *DropFunction node.
+func NewDropFunction(db sql.Database, functionName string, ifExists bool) *DropFunction {
+	return &DropFunction{
+		Db:           db,
+		FunctionName: strings.ToLower(functionName),
+		IfExists:     ifExists,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DropFunction) Resolved() bool {
+	_, ok := d.Db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+func (d *DropFunction) IsReadOnly() bool {
+	return false
+}
+
+// String implements the sql.Node interface.
+func (d *DropFunction) String() string {
+	ifExists := ""
+	if d.IfExists {
+		ifExists = "IF EXISTS "
+	}
+	return fmt.Sprintf("DROP FUNCTION %s%s", ifExists, d.FunctionName)
+}
+
+// Schema implements the sql.Node interface.
+func (d *DropFunction) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Children implements the sql.Node interface.
+func (d *DropFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DropFunction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(d, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*DropFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Database implements the sql.Databaser interface.
+func (d *DropFunction) Database() sql.Database {
+	return d.Db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (d *DropFunction) WithDatabase(db sql.Database) (sql.Node, error) {
+	nd := *d
+	nd.Db = db
+	return &nd, nil
+}
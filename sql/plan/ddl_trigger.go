@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/dolthub/vitess/go/vt/sqlparser"
@@ -138,22 +139,20 @@ func OrderTriggers(triggers []*CreateTrigger) (beforeTriggers []*CreateTrigger,
 	copy(orderedTriggers, triggers)
 
 Top:
-	for i, trigger := range triggers {
+	for _, trigger := range triggers {
 		if trigger.TriggerOrder != nil {
 			ref := trigger.TriggerOrder.OtherTriggerName
-			// remove the trigger from the slice
-			orderedTriggers = append(orderedTriggers[:i], orderedTriggers[i+1:]...)
+			// remove the trigger from the slice, where earlier triggers may have moved it
+			orderedTriggers = slices.DeleteFunc(orderedTriggers, func(t *CreateTrigger) bool {
+				return t == trigger
+			})
 			// then find where to reinsert it
 			for j, t := range orderedTriggers {
 				if t.TriggerName == ref {
 					if trigger.TriggerOrder.PrecedesOrFollows == sqlparser.PrecedesStr {
-						orderedTriggers = append(orderedTriggers[:j], append(triggers[i:i+1], orderedTriggers[j:]...)...)
+						orderedTriggers = slices.Insert(orderedTriggers, j, trigger)
 					} else if trigger.TriggerOrder.PrecedesOrFollows == sqlparser.FollowsStr {
-						if len(orderedTriggers) == j-1 {
-							orderedTriggers = append(orderedTriggers, triggers[i])
-						} else {
-							orderedTriggers = append(orderedTriggers[:j+1], append(triggers[i:i+1], orderedTriggers[j+1:]...)...)
-						}
+						orderedTriggers = slices.Insert(orderedTriggers, j+1, trigger)
 					} else {
 						panic("unexpected value for trigger order")
 					}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -177,3 +178,43 @@ func (d *DescribeQuery) WithQuery(child sql.Node) sql.Node {
 	res.Child = child
 	return &res
 }
+
+// PrunedColumnCounts returns the number of columns read from the tables
+// accessed by |n|, including tables in subquery expressions, and the
+// number of columns those tables define. The difference between the two
+// is the number of columns pruned from table scans.
+func PrunedColumnCounts(ctx *sql.Context, n sql.Node) (read int, total int) {
+	countTable := func(table sql.Table, cols sql.ColSet) {
+		defined := cols.Len()
+		total += defined
+		if pt, ok := table.(sql.ProjectedTable); ok && pt.Projections() != nil {
+			read += len(pt.Projections())
+		} else {
+			read += defined
+		}
+	}
+
+	transform.InspectWithOpaque(ctx, n, func(ctx *sql.Context, n sql.Node) bool {
+		switch n := n.(type) {
+		case *ResolvedTable:
+			countTable(n.UnderlyingTable(), n.Columns())
+		case *IndexedTableAccess:
+			countTable(n.Table, n.Columns())
+		}
+		if ne, ok := n.(sql.Expressioner); ok {
+			for _, e := range ne.Expressions() {
+				sql.Inspect(ctx, e, func(ctx *sql.Context, e sql.Expression) bool {
+					if sq, ok := e.(*Subquery); ok {
+						subRead, subTotal := PrunedColumnCounts(ctx, sq.Query)
+						read += subRead
+						total += subTotal
+						return false
+					}
+					return true
+				})
+			}
+		}
+		return true
+	})
+	return read, total
+}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // Filter skips rows that don't match a certain expression.
@@ -149,7 +150,7 @@ func (i *FilterIter) NextValueRow(ctx *sql.Context) (sql.ValueRow, error) {
 		if err != nil {
 			return nil, err
 		}
-		if res.Val[0] == 1 {
+		if sql.IsTrueValue(res) {
 			return row, nil
 		}
 	}
@@ -157,8 +158,9 @@ func (i *FilterIter) NextValueRow(ctx *sql.Context) (sql.ValueRow, error) {
 
 // IsValueRowIter implements the sql.ValueRowIter interface.
 func (i *FilterIter) IsValueRowIter(ctx *sql.Context) bool {
+	// only integer conditions are supported, as other types must be converted to check whether they're true
 	cond, ok := i.cond.(sql.ValueExpression)
-	if !ok || !cond.IsValueExpression(ctx) {
+	if !ok || !cond.IsValueExpression(ctx) || !types.IsInteger(i.cond.Type(ctx)) {
 		return false
 	}
 	childIter, ok := i.childIter.(sql.ValueRowIter)
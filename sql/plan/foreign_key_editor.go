@@ -158,8 +158,7 @@ func (fkEditor *ForeignKeyEditor) OnUpdateRestrict(ctx *sql.Context, refActionDa
 	}
 	defer rowIter.Close(ctx)
 	if _, err = rowIter.Next(ctx); err == nil {
-		return sql.ErrForeignKeyParentViolation.New(refActionData.ForeignKey.Name,
-			refActionData.ForeignKey.Table, refActionData.ForeignKey.ParentTable, refActionData.RowMapper.GetKeyString(old))
+		return sql.ErrForeignKeyParentViolation.New(refActionData.ForeignKey.Description())
 	}
 	if err != io.EOF {
 		return err
@@ -346,8 +345,7 @@ func (fkEditor *ForeignKeyEditor) OnDeleteRestrict(ctx *sql.Context, refActionDa
 	}
 	defer rowIter.Close(ctx)
 	if _, err = rowIter.Next(ctx); err == nil {
-		return sql.ErrForeignKeyParentViolation.New(refActionData.ForeignKey.Name,
-			refActionData.ForeignKey.Table, refActionData.ForeignKey.ParentTable, refActionData.RowMapper.GetKeyString(row))
+		return sql.ErrForeignKeyParentViolation.New(refActionData.ForeignKey.Description())
 	}
 	if err != io.EOF {
 		return err
@@ -540,8 +538,7 @@ func (reference *ForeignKeyReferenceHandler) CheckReference(ctx *sql.Context, ro
 		}
 		// partial NULL is a violation
 		if nullCount != 0 {
-			return sql.ErrForeignKeyChildViolation.New(reference.ForeignKey.Name, reference.ForeignKey.Table,
-				reference.ForeignKey.ParentTable, reference.RowMapper.GetKeyString(row))
+			return sql.ErrForeignKeyChildViolation.New(reference.ForeignKey.Description())
 		}
 	} else {
 		// any NULL exempts the row
@@ -560,8 +557,7 @@ func (reference *ForeignKeyReferenceHandler) CheckReference(ctx *sql.Context, ro
 	if err != nil && err != io.EOF {
 		// For SET types, conversion failures during foreign key validation should be treated as foreign key violations
 		if sql.ErrConvertingToSet.Is(err) || sql.ErrInvalidSetValue.Is(err) {
-			return sql.ErrForeignKeyChildViolation.New(reference.ForeignKey.Name, reference.ForeignKey.Table,
-				reference.ForeignKey.ParentTable, reference.RowMapper.GetKeyString(row))
+			return sql.ErrForeignKeyChildViolation.New(reference.ForeignKey.Description())
 		}
 		return err
 	}
@@ -594,8 +590,7 @@ func (reference *ForeignKeyReferenceHandler) CheckReference(ctx *sql.Context, ro
 		}
 	}
 
-	return sql.ErrForeignKeyChildViolation.New(reference.ForeignKey.Name, reference.ForeignKey.Table,
-		reference.ForeignKey.ParentTable, reference.RowMapper.GetKeyString(row))
+	return sql.ErrForeignKeyChildViolation.New(reference.ForeignKey.Description())
 }
 
 // validateColumnTypeConstraints enforces foreign key type validation between child and parent columns in a foreign key relationship.
@@ -633,12 +628,7 @@ func (reference *ForeignKeyReferenceHandler) validateColumnTypeConstraints(ctx *
 		}
 
 		if hasViolation {
-			return sql.ErrForeignKeyChildViolation.New(
-				reference.ForeignKey.Name,
-				reference.ForeignKey.Table,
-				reference.ForeignKey.ParentTable,
-				mapper.GetKeyString(childRow),
-			)
+			return sql.ErrForeignKeyChildViolation.New(reference.ForeignKey.Description())
 		}
 	}
 	return nil
@@ -0,0 +1,116 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DiagnosticsItem is an item of a GET DIAGNOSTICS statement, which assigns the information |Name| to the user variable
+// |Variable|.
+type DiagnosticsItem struct {
+	Variable string
+	Name     string
+}
+
+// GetDiagnostics is the GET DIAGNOSTICS statement outside of a stored procedure, which assigns information from the
+// diagnostics area of the session to user variables. Within a stored procedure, the statement is run by the procedure
+// interpreter instead.
+type GetDiagnostics struct {
+	// ConditionNumber is the number of the condition whose information is read, or nil for statement information.
+	ConditionNumber sql.Expression
+	Items           []DiagnosticsItem
+}
+
+var _ sql.Node = (*GetDiagnostics)(nil)
+var _ sql.Expressioner = (*GetDiagnostics)(nil)
+var _ sql.CollationCoercible = (*GetDiagnostics)(nil)
+
+// NewGetDiagnostics returns a new *GetDiagnostics node.
+func NewGetDiagnostics(conditionNumber sql.Expression, items []DiagnosticsItem) *GetDiagnostics {
+	return &GetDiagnostics{
+		ConditionNumber: conditionNumber,
+		Items:           items,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (g *GetDiagnostics) Resolved() bool {
+	return g.ConditionNumber == nil || g.ConditionNumber.Resolved()
+}
+
+// String implements the sql.Node interface.
+func (g *GetDiagnostics) String() string {
+	var sb strings.Builder
+	sb.WriteString("GET DIAGNOSTICS ")
+	if g.ConditionNumber != nil {
+		sb.WriteString(fmt.Sprintf("CONDITION %s ", g.ConditionNumber))
+	}
+	for i, item := range g.Items {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("@%s = %s", item.Variable, strings.ToUpper(item.Name)))
+	}
+	return sb.String()
+}
+
+// Schema implements the sql.Node interface.
+func (g *GetDiagnostics) Schema(ctx *sql.Context) sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (g *GetDiagnostics) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (g *GetDiagnostics) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(g, children...)
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (g *GetDiagnostics) IsReadOnly() bool {
+	return true
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (g *GetDiagnostics) Expressions() []sql.Expression {
+	if g.ConditionNumber == nil {
+		return nil
+	}
+	return []sql.Expression{g.ConditionNumber}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (g *GetDiagnostics) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(g.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(exprs), len(g.Expressions()))
+	}
+	ng := *g
+	if len(exprs) > 0 {
+		ng.ConditionNumber = exprs[0]
+	}
+	return &ng, nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*GetDiagnostics) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
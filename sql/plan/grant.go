@@ -266,30 +266,33 @@ func (n *Grant) grantAllGlobalPrivileges(user *mysql_db.User) {
 	)
 }
 
+// allDatabasePrivileges are all of the privileges that may be granted at the database level, except for the grant
+// privilege (which has special rules for its assignment).
+var allDatabasePrivileges = []sql.PrivilegeType{
+	sql.PrivilegeType_Alter,
+	sql.PrivilegeType_AlterRoutine,
+	sql.PrivilegeType_Create,
+	sql.PrivilegeType_CreateRoutine,
+	sql.PrivilegeType_CreateTempTable,
+	sql.PrivilegeType_CreateView,
+	sql.PrivilegeType_Delete,
+	sql.PrivilegeType_Drop,
+	sql.PrivilegeType_Event,
+	sql.PrivilegeType_Execute,
+	sql.PrivilegeType_Index,
+	sql.PrivilegeType_Insert,
+	sql.PrivilegeType_LockTables,
+	sql.PrivilegeType_References,
+	sql.PrivilegeType_Select,
+	sql.PrivilegeType_ShowView,
+	sql.PrivilegeType_Trigger,
+	sql.PrivilegeType_Update,
+}
+
 // grantAllDatabasePrivileges adds all database privileges to the given user, except for the grant privilege (which has
 // special rules for its assignment).
 func (n *Grant) grantAllDatabasePrivileges(user *mysql_db.User, dbName string) {
-	user.PrivilegeSet.AddDatabase(
-		dbName,
-		sql.PrivilegeType_Alter,
-		sql.PrivilegeType_AlterRoutine,
-		sql.PrivilegeType_Create,
-		sql.PrivilegeType_CreateRoutine,
-		sql.PrivilegeType_CreateTempTable,
-		sql.PrivilegeType_CreateView,
-		sql.PrivilegeType_Delete,
-		sql.PrivilegeType_Drop,
-		sql.PrivilegeType_Event,
-		sql.PrivilegeType_Execute,
-		sql.PrivilegeType_Index,
-		sql.PrivilegeType_Insert,
-		sql.PrivilegeType_LockTables,
-		sql.PrivilegeType_References,
-		sql.PrivilegeType_Select,
-		sql.PrivilegeType_ShowView,
-		sql.PrivilegeType_Trigger,
-		sql.PrivilegeType_Update,
-	)
+	user.PrivilegeSet.AddDatabase(dbName, allDatabasePrivileges...)
 }
 
 // grantAllTablePrivileges adds all table privileges to the given user, except for the grant privilege (which has
@@ -657,12 +660,14 @@ func (*GrantRole) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 
 // GrantProxy represents the statement GRANT PROXY.
 type GrantProxy struct {
+	MySQLDb         sql.Database
 	On              UserName
 	To              []UserName
 	WithGrantOption bool
 }
 
 var _ sql.Node = (*GrantProxy)(nil)
+var _ sql.Databaser = (*GrantProxy)(nil)
 var _ sql.CollationCoercible = (*GrantProxy)(nil)
 var _ sql.AuthorizationCheckerNode = (*GrantProxy)(nil)
 
@@ -689,9 +694,22 @@ func (n *GrantProxy) String() string {
 	return fmt.Sprintf("GrantProxy(On: %s, To: %s)", n.On.String(""), strings.Join(users, ", "))
 }
 
+// Database implements the interface sql.Databaser.
+func (n *GrantProxy) Database() sql.Database {
+	return n.MySQLDb
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *GrantProxy) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.MySQLDb = db
+	return &nn, nil
+}
+
 // Resolved implements the interface sql.Node.
 func (n *GrantProxy) Resolved() bool {
-	return true
+	_, ok := n.MySQLDb.(sql.UnresolvedDatabase)
+	return !ok
 }
 
 func (n *GrantProxy) IsReadOnly() bool {
@@ -713,8 +731,48 @@ func (n *GrantProxy) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.N
 
 // CheckAuth implements the interface sql.AuthorizationCheckerNode.
 func (n *GrantProxy) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
-	//TODO: add this when proxy support is added
-	return true
+	return checkProxyAuth(ctx, opChecker, n.MySQLDb, n.On)
+}
+
+// checkProxyAuth returns whether the current user may grant or revoke the PROXY privilege on the proxied user |on|,
+// which requires either the privileges to update the grant tables, or the PROXY privilege with grant option on that
+// user or on the anonymous user, which stands for every user.
+func checkProxyAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker, db sql.Database, on UserName) bool {
+	if opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{}, sql.PrivilegeType_Super)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{Database: "mysql"}, sql.PrivilegeType_Update)) {
+		return true
+	}
+	mysqlDb, ok := db.(*mysql_db.MySQLDb)
+	if !ok {
+		return false
+	}
+	client := ctx.Session.Client()
+	reader := mysqlDb.Reader()
+	defer reader.Close()
+
+	user := mysqlDb.GetUser(reader, client.User, client.Address, false)
+	if user == nil {
+		return false
+	}
+	proxiedHost := proxiedHostOf(on)
+	for _, proxyPriv := range reader.GetProxyPrivs(mysql_db.ProxiesPrivUserKey{Host: user.Host, User: user.User}) {
+		if !proxyPriv.WithGrant {
+			continue
+		}
+		if (proxyPriv.ProxiedUser == on.Name && proxyPriv.ProxiedHost == proxiedHost) ||
+			(proxyPriv.ProxiedUser == "" && proxyPriv.ProxiedHost == "") {
+			return true
+		}
+	}
+	return false
+}
+
+// proxiedHostOf returns the host of the proxied user |on|, as it's stored in the proxies_priv table.
+func proxiedHostOf(on UserName) string {
+	if on.AnyHost {
+		return "%"
+	}
+	return on.Host
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
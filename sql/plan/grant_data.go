@@ -212,12 +212,17 @@ func (p *Privilege) String() string {
 	return sb.String()
 }
 
+// DynamicPrivilege_SetUserId is the dynamic privilege required to name an account other than the current user as the
+// DEFINER of a view, routine, trigger or event.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_set-user-id
+const DynamicPrivilege_SetUserId = "set_user_id"
+
 // IsValidDynamic returns whether the given dynamic privilege is valid. If the privilege is NOT dynamic, or the dynamic
 // privilege is not supported, then this returns false.
 func (p *Privilege) IsValidDynamic() bool {
 	if p.Type == PrivilegeType_Dynamic {
 		switch p.Dynamic {
-		case DynamicPrivilege_ReplicationSlaveAdmin, DynamicPrivilege_CloneAdmin:
+		case DynamicPrivilege_ReplicationSlaveAdmin, DynamicPrivilege_CloneAdmin, DynamicPrivilege_SetUserId:
 			return true
 		}
 	}
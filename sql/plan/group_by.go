@@ -32,6 +32,10 @@ type GroupBy struct {
 	UnaryNode
 	SelectDeps   []sql.Expression
 	GroupByExprs []sql.Expression
+	// GroupingSets are the combinations of GroupByExprs, by index, that the rows are grouped by in a single pass over
+	// the child, as for ROLLUP and CUBE. The GroupByExprs missing from a grouping set are NULL in the rows of its
+	// groups. Nil groups the rows by all of the GroupByExprs.
+	GroupingSets [][]int
 }
 
 var _ sql.Expressioner = (*GroupBy)(nil)
@@ -52,6 +56,32 @@ func NewGroupBy(selectDeps, groupByExprs []sql.Expression, child sql.Node) *Grou
 	}
 }
 
+// WithGroupingSets returns a copy of this node that groups its rows by each of |sets| instead of by all of its
+// GroupByExprs.
+func (g *GroupBy) WithGroupingSets(sets [][]int) *GroupBy {
+	ret := *g
+	ret.GroupingSets = sets
+	return &ret
+}
+
+// RolledUp returns whether the group-by expression at index |i| is missing from any of the grouping sets, in which
+// case it is NULL in the rows of the super-aggregate groups of those sets.
+func (g *GroupBy) RolledUp(i int) bool {
+	for _, set := range g.GroupingSets {
+		found := false
+		for _, j := range set {
+			if j == i {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+	return false
+}
+
 // Resolved implements the Resolvable interface.
 func (g *GroupBy) Resolved() bool {
 	return g.UnaryNode.Child.Resolved() &&
@@ -65,6 +95,17 @@ func (g *GroupBy) IsReadOnly() bool {
 
 // Schema implements the Node interface.
 func (g *GroupBy) Schema(ctx *sql.Context) sql.Schema {
+	// group-by expressions are NULL in the super-aggregate rows of the grouping sets they're missing from
+	var rolledUp map[string]bool
+	for i, e := range g.GroupByExprs {
+		if g.RolledUp(i) {
+			if rolledUp == nil {
+				rolledUp = make(map[string]bool)
+			}
+			rolledUp[strings.ToLower(e.String())] = true
+		}
+	}
+
 	var s = make(sql.Schema, len(g.SelectDeps))
 	for i, e := range g.SelectDeps {
 		var name string
@@ -87,7 +128,7 @@ func (g *GroupBy) Schema(ctx *sql.Context) sql.Schema {
 		s[i] = &sql.Column{
 			Name:           name,
 			Type:           e.Type(ctx),
-			Nullable:       e.IsNullable(ctx),
+			Nullable:       e.IsNullable(ctx) || rolledUp[strings.ToLower(e.String())],
 			Source:         table,
 			DatabaseSource: db,
 		}
@@ -102,7 +143,7 @@ func (g *GroupBy) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node
 		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
 	}
 
-	return NewGroupBy(g.SelectDeps, g.GroupByExprs, children[0]), nil
+	return NewGroupBy(g.SelectDeps, g.GroupByExprs, children[0]).WithGroupingSets(g.GroupingSets), nil
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -123,7 +164,7 @@ func (g *GroupBy) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sq
 	grouping := make([]sql.Expression, len(g.GroupByExprs))
 	copy(grouping, exprs[len(g.SelectDeps):])
 
-	return NewGroupBy(agg, grouping, g.Child), nil
+	return NewGroupBy(agg, grouping, g.Child).WithGroupingSets(g.GroupingSets), nil
 }
 
 func (g *GroupBy) String() string {
@@ -140,11 +181,14 @@ func (g *GroupBy) String() string {
 		grouping[i] = g.String()
 	}
 
-	_ = pr.WriteChildren(
+	children := []string{
 		fmt.Sprintf("select: %s", strings.Join(selectDeps, ", ")),
 		fmt.Sprintf("group: %s", strings.Join(grouping, ", ")),
-		g.Child.String(),
-	)
+	}
+	if g.GroupingSets != nil {
+		children = append(children, fmt.Sprintf("grouping sets: %s", g.groupingSetsString(grouping)))
+	}
+	_ = pr.WriteChildren(append(children, g.Child.String())...)
 	return pr.String()
 }
 
@@ -162,11 +206,14 @@ func (g *GroupBy) DebugString(ctx *sql.Context) string {
 		grouping[i] = sql.DebugString(ctx, g)
 	}
 
-	_ = pr.WriteChildren(
+	children := []string{
 		fmt.Sprintf("select: %s", strings.Join(selectDeps, ", ")),
 		fmt.Sprintf("group: %s", strings.Join(grouping, ", ")),
-		sql.DebugString(ctx, g.Child),
-	)
+	}
+	if g.GroupingSets != nil {
+		children = append(children, fmt.Sprintf("grouping sets: %s", g.groupingSetsString(grouping)))
+	}
+	_ = pr.WriteChildren(append(children, sql.DebugString(ctx, g.Child))...)
 	return pr.String()
 }
 
@@ -184,14 +231,30 @@ func (g *GroupBy) Describe(ctx *sql.Context, options sql.DescribeOptions) string
 		grouping[i] = sql.Describe(ctx, g, options)
 	}
 
-	_ = pr.WriteChildren(
+	children := []string{
 		fmt.Sprintf("select: %s", strings.Join(selectDeps, ", ")),
 		fmt.Sprintf("group: %s", strings.Join(grouping, ", ")),
-		sql.Describe(ctx, g.Child, options),
-	)
+	}
+	if g.GroupingSets != nil {
+		children = append(children, fmt.Sprintf("grouping sets: %s", g.groupingSetsString(grouping)))
+	}
+	_ = pr.WriteChildren(append(children, sql.Describe(ctx, g.Child, options))...)
 	return pr.String()
 }
 
+// groupingSetsString returns the grouping sets of this node as lists of the |grouping| expressions they include.
+func (g *GroupBy) groupingSetsString(grouping []string) string {
+	sets := make([]string, len(g.GroupingSets))
+	for i, set := range g.GroupingSets {
+		exprs := make([]string, len(set))
+		for j, idx := range set {
+			exprs[j] = grouping[idx]
+		}
+		sets[i] = "(" + strings.Join(exprs, ", ") + ")"
+	}
+	return strings.Join(sets, ", ")
+}
+
 // Expressions implements the Expressioner interface.
 func (g *GroupBy) Expressions() []sql.Expression {
 	var exprs []sql.Expression
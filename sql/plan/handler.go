@@ -0,0 +1,275 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// HandlerOpen opens a table for reading with HANDLER ... READ statements.
+type HandlerOpen struct {
+	// Name is the name of the handler, which is the alias given to the table or else the table's name.
+	Name     string
+	Database string
+	Table    string
+}
+
+var _ sql.Node = (*HandlerOpen)(nil)
+var _ sql.CollationCoercible = (*HandlerOpen)(nil)
+
+// NewHandlerOpen returns a new *HandlerOpen node.
+func NewHandlerOpen(name, database, table string) *HandlerOpen {
+	return &HandlerOpen{Name: name, Database: database, Table: table}
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerOpen) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerOpen) Resolved() bool {
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (h *HandlerOpen) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerOpen) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerOpen) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(h, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerOpen) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (h *HandlerOpen) String() string {
+	return fmt.Sprintf("HandlerOpen(%s.%s AS %s)", h.Database, h.Table, h.Name)
+}
+
+// HandlerReadType is the position that a HANDLER ... READ statement reads from.
+type HandlerReadType byte
+
+const (
+	// HandlerReadFirst reads from the first row.
+	HandlerReadFirst HandlerReadType = iota
+	// HandlerReadNext reads from the row after the last one read.
+	HandlerReadNext
+	// HandlerReadPrev reads backwards from the row before the last one read.
+	HandlerReadPrev
+	// HandlerReadLast reads backwards from the last row.
+	HandlerReadLast
+	// HandlerReadEqual reads the rows whose key is equal to the key given.
+	HandlerReadEqual
+	// HandlerReadGreaterOrEqual reads from the first row whose key is greater than or equal to the key given.
+	HandlerReadGreaterOrEqual
+	// HandlerReadGreater reads from the first row whose key is greater than the key given.
+	HandlerReadGreater
+	// HandlerReadLessOrEqual reads backwards from the last row whose key is less than or equal to the key given.
+	HandlerReadLessOrEqual
+	// HandlerReadLess reads backwards from the last row whose key is less than the key given.
+	HandlerReadLess
+)
+
+// String returns the syntax of the read type.
+func (t HandlerReadType) String() string {
+	switch t {
+	case HandlerReadFirst:
+		return "FIRST"
+	case HandlerReadNext:
+		return "NEXT"
+	case HandlerReadPrev:
+		return "PREV"
+	case HandlerReadLast:
+		return "LAST"
+	case HandlerReadEqual:
+		return "="
+	case HandlerReadGreaterOrEqual:
+		return ">="
+	case HandlerReadGreater:
+		return ">"
+	case HandlerReadLessOrEqual:
+		return "<="
+	case HandlerReadLess:
+		return "<"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// IsKey returns whether the read type positions the handler on a key.
+func (t HandlerReadType) IsKey() bool {
+	return t >= HandlerReadEqual
+}
+
+// IsBackwards returns whether the read type reads rows in descending order.
+func (t HandlerReadType) IsBackwards() bool {
+	return t == HandlerReadPrev || t == HandlerReadLast || t == HandlerReadLessOrEqual || t == HandlerReadLess
+}
+
+// HandlerRead reads rows of a table opened with HANDLER ... OPEN, either in the order of one of its indexes or in
+// its natural order, continuing from the handler's last position.
+type HandlerRead struct {
+	// Name is the name of the open handler.
+	Name string
+	// Table is the handler's table.
+	Table sql.Table
+	// Index is the index that the rows are read in the order of, or nil to read them in the table's natural order.
+	Index sql.Index
+	Type  HandlerReadType
+	// Key are the values of the key for read types that position the handler on a key.
+	Key []sql.Expression
+	// Filter is the condition of the WHERE clause, or nil if there isn't one.
+	Filter sql.Expression
+	// Limit and Offset are the expressions of the LIMIT clause. When they're nil, a single row is read.
+	Limit  sql.Expression
+	Offset sql.Expression
+}
+
+var _ sql.Node = (*HandlerRead)(nil)
+var _ sql.CollationCoercible = (*HandlerRead)(nil)
+
+// NewHandlerRead returns a new *HandlerRead node.
+func NewHandlerRead(name string, table sql.Table, index sql.Index, typ HandlerReadType, key []sql.Expression) *HandlerRead {
+	return &HandlerRead{
+		Name:  name,
+		Table: table,
+		Index: index,
+		Type:  typ,
+		Key:   key,
+	}
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerRead) Schema(ctx *sql.Context) sql.Schema {
+	return h.Table.Schema(ctx)
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerRead) Resolved() bool {
+	return expression.ExpressionsResolved(h.Key...) &&
+		(h.Filter == nil || h.Filter.Resolved()) &&
+		(h.Limit == nil || h.Limit.Resolved()) &&
+		(h.Offset == nil || h.Offset.Resolved())
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (h *HandlerRead) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerRead) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerRead) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(h, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerRead) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (h *HandlerRead) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("HandlerRead(%s", h.Name))
+	if h.Index != nil {
+		sb.WriteString(fmt.Sprintf(" %s", h.Index.ID()))
+	}
+	sb.WriteString(fmt.Sprintf(" %s", h.Type))
+	if len(h.Key) > 0 {
+		key := make([]string, len(h.Key))
+		for i, e := range h.Key {
+			key[i] = e.String()
+		}
+		sb.WriteString(fmt.Sprintf(" (%s)", strings.Join(key, ", ")))
+	}
+	if h.Filter != nil {
+		sb.WriteString(fmt.Sprintf(" WHERE %s", h.Filter))
+	}
+	if h.Limit != nil {
+		sb.WriteString(fmt.Sprintf(" LIMIT %s", h.Limit))
+	}
+	if h.Offset != nil {
+		sb.WriteString(fmt.Sprintf(" OFFSET %s", h.Offset))
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// HandlerClose closes a table opened with HANDLER ... OPEN.
+type HandlerClose struct {
+	// Name is the name of the open handler.
+	Name string
+}
+
+var _ sql.Node = (*HandlerClose)(nil)
+var _ sql.CollationCoercible = (*HandlerClose)(nil)
+
+// NewHandlerClose returns a new *HandlerClose node.
+func NewHandlerClose(name string) *HandlerClose {
+	return &HandlerClose{Name: name}
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerClose) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerClose) Resolved() bool {
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (h *HandlerClose) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerClose) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerClose) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(h, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerClose) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (h *HandlerClose) String() string {
+	return fmt.Sprintf("HandlerClose(%s)", h.Name)
+}
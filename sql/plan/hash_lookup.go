@@ -61,6 +61,9 @@ type HashLookup struct {
 	Lookup        *map[interface{}][]sql.Row
 	leftKeySch    sql.Schema
 	JoinType      JoinType
+	// RuntimeFilter, if set, is pushed into the probe side of the join and is built from the lookup's keys once the
+	// lookup has been materialized.
+	RuntimeFilter *RuntimeFilter
 }
 
 var _ sql.Node = (*HashLookup)(nil)
@@ -169,4 +172,7 @@ func (n *HashLookup) GetHashKey(ctx *sql.Context, e sql.Expression, row sql.Row)
 
 func (n *HashLookup) Dispose(ctx *sql.Context) {
 	n.Lookup = nil
+	if n.RuntimeFilter != nil {
+		n.RuntimeFilter.Reset()
+	}
 }
@@ -69,16 +69,86 @@ func (l *LoadData) IsReadOnly() bool {
 	return false
 }
 
+// SplitLines is a bufio.SplitFunc that splits the file into lines. A LINES TERMINATED BY sequence doesn't end the line
+// when it follows the FIELDS ESCAPED BY character, or when it's within a field enclosed by the FIELDS ENCLOSED BY
+// character, so that values written by SELECT ... INTO OUTFILE may contain the line terminator. Each line includes its
+// terminator, so the parser can tell a terminated line from one ended by the end of the file.
 func (l *LoadData) SplitLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
 	// Return Nothing if at end of file and no data passed.
 	if atEOF && len(data) == 0 {
 		return 0, nil, nil
 	}
 
-	// Find the index of the LINES TERMINATED BY delim.
-	if i := bytes.Index(data, []byte(l.LinesTerminatedBy)); i >= 0 {
-		// Include the terminator in the token so parser can detect EOF vs terminated lines
-		return i + len(l.LinesTerminatedBy), data[0 : i+len(l.LinesTerminatedBy)], nil
+	if l.LinesTerminatedBy != "" {
+		// When the escape and enclosure characters are the same, they are only escaped by doubling them, and the end
+		// of a line is always the end of the line.
+		hasEsc := l.FieldsEscapedBy != "" && l.FieldsEscapedBy != l.FieldsEnclosedBy
+		hasEnc := l.FieldsEnclosedBy != "" && l.FieldsEscapedBy != l.FieldsEnclosedBy
+		awaitingPrefix := l.LinesStartingBy != ""
+		fieldStart := !awaitingPrefix
+		inEnclosure := false
+		for i := 0; i < len(data); i++ {
+			if hasEsc && data[i] == l.FieldsEscapedBy[0] {
+				if i+1 >= len(data) && !atEOF {
+					return 0, nil, nil
+				}
+				i++
+				fieldStart = false
+				continue
+			}
+
+			if inEnclosure {
+				if data[i] != l.FieldsEnclosedBy[0] {
+					continue
+				}
+				// a doubled enclosure character is part of the field
+				if i+1 < len(data) && data[i+1] == l.FieldsEnclosedBy[0] {
+					i++
+					continue
+				}
+				// and a single one only closes the field when it's followed by the end of the field
+				endsField, more := hasPrefixAt(data, i+1, l.FieldsTerminatedBy, atEOF)
+				if !endsField && !more {
+					endsField, more = hasPrefixAt(data, i+1, l.LinesTerminatedBy, atEOF)
+				}
+				if more {
+					return 0, nil, nil
+				}
+				inEnclosure = !endsField && i+1 < len(data)
+				continue
+			}
+
+			if fieldStart && hasEnc && data[i] == l.FieldsEnclosedBy[0] {
+				inEnclosure = true
+				fieldStart = false
+				continue
+			}
+
+			if found, more := hasPrefixAt(data, i, l.LinesTerminatedBy, atEOF); more {
+				return 0, nil, nil
+			} else if found {
+				end := i + len(l.LinesTerminatedBy)
+				return end, data[:end], nil
+			}
+
+			if awaitingPrefix {
+				if found, more := hasPrefixAt(data, i, l.LinesStartingBy, atEOF); more {
+					return 0, nil, nil
+				} else if found {
+					awaitingPrefix = false
+					fieldStart = true
+					i += len(l.LinesStartingBy) - 1
+					continue
+				}
+			} else if found, more := hasPrefixAt(data, i, l.FieldsTerminatedBy, atEOF); more {
+				return 0, nil, nil
+			} else if found {
+				fieldStart = true
+				i += len(l.FieldsTerminatedBy) - 1
+				continue
+			}
+			fieldStart = false
+		}
 	}
 
 	// If at end of file with data return the data (no terminator present = EOF)
@@ -89,6 +159,19 @@ func (l *LoadData) SplitLines(data []byte, atEOF bool) (advance int, token []byt
 	return
 }
 
+// hasPrefixAt returns whether |data| continues with |prefix| at index |i|. If the data ends before it can tell, and
+// the end of the file hasn't been reached, it returns |more| to ask for more data.
+func hasPrefixAt(data []byte, i int, prefix string, atEOF bool) (found bool, more bool) {
+	if prefix == "" {
+		return false, false
+	}
+	rest := data[i:]
+	if len(rest) < len(prefix) {
+		return false, !atEOF && bytes.HasPrefix([]byte(prefix), rest)
+	}
+	return bytes.HasPrefix(rest, []byte(prefix)), false
+}
+
 func (l *LoadData) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
 	if len(children) != 0 {
 		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 0)
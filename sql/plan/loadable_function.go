@@ -0,0 +1,135 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// CreateLoadableFunction is CREATE [AGGREGATE] FUNCTION ... SONAME, which registers a user-defined function provided
+// by the sql.LoadableFunctionFactory registered for the library named.
+type CreateLoadableFunction struct {
+	Name        string
+	ReturnType  sql.Type
+	Aggregate   bool
+	Library     string
+	IfNotExists bool
+	Catalog     sql.Catalog
+}
+
+var _ sql.Node = (*CreateLoadableFunction)(nil)
+var _ sql.CollationCoercible = (*CreateLoadableFunction)(nil)
+
+// NewCreateLoadableFunction returns a new *CreateLoadableFunction node.
+func NewCreateLoadableFunction(name string, returnType sql.Type, aggregate bool, library string, ifNotExists bool) *CreateLoadableFunction {
+	return &CreateLoadableFunction{
+		Name:        name,
+		ReturnType:  returnType,
+		Aggregate:   aggregate,
+		Library:     library,
+		IfNotExists: ifNotExists,
+	}
+}
+
+// Schema implements the sql.Node interface.
+func (c *CreateLoadableFunction) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Resolved implements the sql.Node interface.
+func (c *CreateLoadableFunction) Resolved() bool {
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (c *CreateLoadableFunction) IsReadOnly() bool {
+	return false
+}
+
+// Children implements the sql.Node interface.
+func (c *CreateLoadableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CreateLoadableFunction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CreateLoadableFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (c *CreateLoadableFunction) String() string {
+	aggregate := ""
+	if c.Aggregate {
+		aggregate = "Aggregate"
+	}
+	return fmt.Sprintf("Create%sLoadableFunction(%s RETURNS %s SONAME '%s')", aggregate, c.Name, c.ReturnType, c.Library)
+}
+
+// DropLoadableFunction is DROP FUNCTION for a function created with CREATE FUNCTION ... SONAME, or any other
+// user-defined function registered with the catalog.
+type DropLoadableFunction struct {
+	Name     string
+	IfExists bool
+	Catalog  sql.Catalog
+}
+
+var _ sql.Node = (*DropLoadableFunction)(nil)
+var _ sql.CollationCoercible = (*DropLoadableFunction)(nil)
+
+// NewDropLoadableFunction returns a new *DropLoadableFunction node.
+func NewDropLoadableFunction(name string, ifExists bool) *DropLoadableFunction {
+	return &DropLoadableFunction{Name: name, IfExists: ifExists}
+}
+
+// Schema implements the sql.Node interface.
+func (d *DropLoadableFunction) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DropLoadableFunction) Resolved() bool {
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (d *DropLoadableFunction) IsReadOnly() bool {
+	return false
+}
+
+// Children implements the sql.Node interface.
+func (d *DropLoadableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DropLoadableFunction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(d, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*DropLoadableFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (d *DropLoadableFunction) String() string {
+	return fmt.Sprintf("DropLoadableFunction(%s)", d.Name)
+}
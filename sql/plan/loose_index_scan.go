@@ -0,0 +1,134 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// LooseIndexScan reads a single row for each distinct value of a prefix of an index's columns, seeking past the
+// remaining rows of each group. It is placed beneath GROUP BY and DISTINCT nodes whose grouping columns are a prefix
+// of the index, so that the parent only sees one row per group. MySQL reports this access method as
+// "Using index for group-by".
+type LooseIndexScan struct {
+	UnaryNode
+	// PrefixLen is the number of leading index columns that define a group.
+	PrefixLen int
+	// KeyOrdinals are the positions in the child's schema of the leading PrefixLen index columns, followed by the
+	// position of the index column after the prefix when ReadMin is set.
+	KeyOrdinals []int
+	// ReadMin is set when the row returned for a group must hold the smallest non-NULL value of the index column
+	// following the prefix, as needed to evaluate MIN() of that column.
+	ReadMin bool
+}
+
+var _ sql.Node = (*LooseIndexScan)(nil)
+var _ sql.CollationCoercible = (*LooseIndexScan)(nil)
+
+// NewLooseIndexScan creates a new LooseIndexScan over the static index access |ita|.
+func NewLooseIndexScan(ita *IndexedTableAccess, prefixLen int, keyOrdinals []int, readMin bool) *LooseIndexScan {
+	return &LooseIndexScan{
+		UnaryNode:   UnaryNode{Child: ita},
+		PrefixLen:   prefixLen,
+		KeyOrdinals: keyOrdinals,
+		ReadMin:     readMin,
+	}
+}
+
+// IndexedTable returns the index access that this scan seeks through.
+func (l *LooseIndexScan) IndexedTable() *IndexedTableAccess {
+	return looseIndexScanTable(l.Child)
+}
+
+// PrependRow returns the outer scope row prepended to every result row, if the index access was wrapped in a
+// *PrependNode when evaluating a subquery.
+func (l *LooseIndexScan) PrependRow() sql.Row {
+	if p, ok := l.Child.(*PrependNode); ok {
+		return p.Row
+	}
+	return nil
+}
+
+func looseIndexScanTable(n sql.Node) *IndexedTableAccess {
+	switch n := n.(type) {
+	case *IndexedTableAccess:
+		return n
+	case *PrependNode:
+		return looseIndexScanTable(n.Child)
+	default:
+		return nil
+	}
+}
+
+// WithChildren implements the Node interface.
+func (l *LooseIndexScan) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 1)
+	}
+	if looseIndexScanTable(children[0]) == nil {
+		return nil, fmt.Errorf("invalid child for LooseIndexScan: %T", children[0])
+	}
+	ret := *l
+	ret.Child = children[0]
+	return &ret, nil
+}
+
+func (l *LooseIndexScan) IsReadOnly() bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (l *LooseIndexScan) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, l.Child)
+}
+
+func (l *LooseIndexScan) describeChildren() []string {
+	exprs := l.IndexedTable().Index().Expressions()
+	children := []string{
+		"Using index for group-by",
+		fmt.Sprintf("prefix: [%s]", strings.ToLower(strings.Join(exprs[:l.PrefixLen], ", "))),
+	}
+	if l.ReadMin {
+		children = append(children, fmt.Sprintf("min: %s", strings.ToLower(exprs[l.PrefixLen])))
+	}
+	return children
+}
+
+// Describe implements sql.Describable
+func (l *LooseIndexScan) Describe(ctx *sql.Context, options sql.DescribeOptions) string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("LooseIndexScan")
+	_ = p.WriteChildren(append(l.describeChildren(), sql.Describe(ctx, l.Child, options))...)
+	return p.String()
+}
+
+// String implements fmt.Stringer
+func (l *LooseIndexScan) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("LooseIndexScan")
+	_ = p.WriteChildren(append(l.describeChildren(), l.Child.String())...)
+	return p.String()
+}
+
+// DebugString implements sql.DebugStringer
+func (l *LooseIndexScan) DebugString(ctx *sql.Context) string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("LooseIndexScan")
+	_ = p.WriteChildren(append(l.describeChildren(), sql.DebugString(ctx, l.Child))...)
+	return p.String()
+}
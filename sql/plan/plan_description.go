@@ -0,0 +1,155 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// PlanDescriptionVersion is the version of the format of PlanDescription. Fields may be added to the format without
+// changing its version, but the version is incremented whenever a field is removed or its meaning changes, so that
+// tools that read descriptions can tell which they understand.
+const PlanDescriptionVersion = 1
+
+// PlanDescription is a machine-readable description of the plans of a query, for external tools that render and
+// compare query plans. It's serialized as JSON, following PlanDescriptionJSONSchema.
+type PlanDescription struct {
+	// Version is the PlanDescriptionVersion of the description.
+	Version int `json:"version"`
+	// Query is the query whose plans are described.
+	Query string `json:"query"`
+	// Logical is the plan of the query as built from its syntax, before analysis.
+	Logical *PlanNodeDescription `json:"logical"`
+	// Physical is the plan of the query as executed, after analysis and costing.
+	Physical *PlanNodeDescription `json:"physical"`
+}
+
+// PlanNodeDescription describes a node of a plan, along with its children.
+type PlanNodeDescription struct {
+	// Operator is the kind of the node, such as "Project", "Filter", "IndexedTableAccess" or, for joins, the join type,
+	// such as "InnerJoin" or "LookupJoin".
+	Operator string `json:"operator"`
+	// Name is the name of the node, such as the name or alias of a table, if it has one.
+	Name string `json:"name,omitempty"`
+	// Database is the database of the table of the node, if it has one.
+	Database string `json:"database,omitempty"`
+	// Index is the ID of the index the node reads, if it has one.
+	Index string `json:"index,omitempty"`
+	// Expressions are the expressions of the node, such as its filters, projections or sort fields.
+	Expressions []string `json:"expressions,omitempty"`
+	// Columns are the names of the columns of the rows the node returns.
+	Columns []string `json:"columns,omitempty"`
+	// EstimatedRows and EstimatedCost are the estimates of the costing of the node, if it was costed.
+	EstimatedRows *uint64  `json:"estimated_rows,omitempty"`
+	EstimatedCost *float64 `json:"estimated_cost,omitempty"`
+	// Children are the descriptions of the child nodes of the node.
+	Children []*PlanNodeDescription `json:"children,omitempty"`
+	// Subqueries are the descriptions of the plans of the subqueries in the expressions of the node.
+	Subqueries []*PlanNodeDescription `json:"subqueries,omitempty"`
+}
+
+// PlanDescriptionJSONSchema is the JSON schema of the serialization of a PlanDescription.
+const PlanDescriptionJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "PlanDescription",
+  "type": "object",
+  "required": ["version", "query", "logical", "physical"],
+  "properties": {
+    "version": {"type": "integer", "const": 1},
+    "query": {"type": "string"},
+    "logical": {"$ref": "#/$defs/node"},
+    "physical": {"$ref": "#/$defs/node"}
+  },
+  "$defs": {
+    "node": {
+      "type": "object",
+      "required": ["operator"],
+      "properties": {
+        "operator": {"type": "string"},
+        "name": {"type": "string"},
+        "database": {"type": "string"},
+        "index": {"type": "string"},
+        "expressions": {"type": "array", "items": {"type": "string"}},
+        "columns": {"type": "array", "items": {"type": "string"}},
+        "estimated_rows": {"type": "integer", "minimum": 0},
+        "estimated_cost": {"type": "number"},
+        "children": {"type": "array", "items": {"$ref": "#/$defs/node"}},
+        "subqueries": {"type": "array", "items": {"$ref": "#/$defs/node"}}
+      }
+    }
+  }
+}`
+
+// DescribePlanNode returns the description of the plan rooted at |n|.
+func DescribePlanNode(ctx *sql.Context, n sql.Node) *PlanNodeDescription {
+	if n == nil {
+		return nil
+	}
+
+	d := &PlanNodeDescription{Operator: operatorName(n)}
+	if nameable, ok := n.(sql.Nameable); ok {
+		d.Name = nameable.Name()
+	}
+	if databaser, ok := n.(sql.Databaser); ok && databaser.Database() != nil {
+		d.Database = databaser.Database().Name()
+	}
+	if ita, ok := n.(*IndexedTableAccess); ok && ita.Index() != nil {
+		d.Index = ita.Index().ID()
+	}
+	if n.Resolved() {
+		for _, col := range n.Schema(ctx) {
+			d.Columns = append(d.Columns, col.Name)
+		}
+	}
+	if stats, ok := sql.GetDescribeStats(n); ok {
+		rows, cost := stats.EstimatedRowCount, stats.Cost
+		d.EstimatedRows, d.EstimatedCost = &rows, &cost
+	}
+
+	if exprs, ok := n.(sql.Expressioner); ok {
+		for _, e := range exprs.Expressions() {
+			if e == nil {
+				continue
+			}
+			d.Expressions = append(d.Expressions, e.String())
+			transform.InspectExpr(ctx, e, func(ctx *sql.Context, e sql.Expression) bool {
+				if sq, ok := e.(*Subquery); ok {
+					d.Subqueries = append(d.Subqueries, DescribePlanNode(ctx, sq.Query))
+				}
+				return false
+			})
+		}
+	}
+
+	for _, child := range n.Children() {
+		d.Children = append(d.Children, DescribePlanNode(ctx, child))
+	}
+	return d
+}
+
+// operatorName returns the Operator of the description of |n|.
+func operatorName(n sql.Node) string {
+	if j, ok := n.(*JoinNode); ok {
+		return j.Op.String()
+	}
+	t := reflect.TypeOf(n)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
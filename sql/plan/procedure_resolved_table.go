@@ -138,7 +138,7 @@ func (t *ProcedureResolvedTable) NewestTable(ctx *sql.Context) (*ResolvedTable,
 	if IsDualTable(t.ResolvedTable) {
 		return t.ResolvedTable, nil
 	} else if t.ResolvedTable.AsOf == nil {
-		tbl, ok, err := t.ResolvedTable.SqlDatabase.GetTableInsensitive(ctx, t.ResolvedTable.Table.Name())
+		tbl, ok, err := sql.GetSessionTableInsensitive(ctx, t.ResolvedTable.SqlDatabase, t.ResolvedTable.Table.Name())
 		if err != nil {
 			return nil, err
 		} else if !ok {
@@ -265,6 +265,7 @@ func shouldSetFoundRows(ctx *sql.Context, node sql.Node) bool {
 func AddTrackedRowIter(ctx *sql.Context, node sql.Node, iter sql.RowIter) sql.RowIter {
 	trackedIter := NewTrackedRowIter(node, iter, nil, func() {
 		ctx.ProcessList.EndQuery(ctx)
+		ctx.MemoryTracker().Release()
 		if span := ctx.RootSpan(); span != nil {
 			span.End()
 		}
@@ -463,6 +464,7 @@ func IsDDLNode(node sql.Node) bool {
 		*CreateView, *DropView,
 		*CreateIndex, *AlterIndex, *DropIndex,
 		*CreateProcedure, *DropProcedure,
+		*CreateFunction, *DropFunction,
 		*CreateEvent, *DropEvent,
 		*CreateForeignKey, *DropForeignKey,
 		*CreateCheck, *DropCheck,
@@ -62,7 +62,7 @@ var _ sql.Expressioner = (*RecursiveCte)(nil)
 var _ sql.CollationCoercible = (*RecursiveCte)(nil)
 var _ TableIdNode = (*RecursiveCte)(nil)
 
-func NewRecursiveCte(initial, recursive sql.Node, name string, outputCols []string, deduplicate bool, l sql.Expression, sc sql.SortConditions) *RecursiveCte {
+func NewRecursiveCte(initial, recursive sql.Node, name string, outputCols []string, deduplicate bool, l, o sql.Expression, sc sql.SortConditions) *RecursiveCte {
 	return &RecursiveCte{
 		ColumnNames: outputCols,
 		union: &SetOp{
@@ -70,6 +70,7 @@ func NewRecursiveCte(initial, recursive sql.Node, name string, outputCols []stri
 			BinaryNode:     BinaryNode{left: initial, right: recursive},
 			Distinct:       deduplicate,
 			Limit:          l,
+			Offset:         o,
 			SortConditions: sc,
 		},
 		name: name,
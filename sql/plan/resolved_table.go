@@ -156,6 +156,16 @@ func (t *ResolvedTable) String() string {
 		}
 	}
 
+	if lt, ok := table.(sql.LimitedTable); ok {
+		if limit, ok := lt.Limit(); ok {
+			children = append(children, fmt.Sprintf("limit: %d", limit))
+		}
+	}
+
+	if pt, ok := table.(sql.PartitionedTable); ok && pt.PartitionNames() != nil {
+		children = append(children, fmt.Sprintf("partitions: %v", pt.PartitionNames()))
+	}
+
 	pr.WriteChildren(children...)
 	return pr.String()
 }
@@ -209,6 +219,16 @@ func TableDebugString(ctx *sql.Context, table sql.Table, additionalChildren ...s
 		}
 	}
 
+	if lt, ok := table.(sql.LimitedTable); ok {
+		if limit, ok := lt.Limit(); ok {
+			children = append(children, fmt.Sprintf("limit: %d", limit))
+		}
+	}
+
+	if pt, ok := table.(sql.PartitionedTable); ok && pt.PartitionNames() != nil {
+		children = append(children, fmt.Sprintf("partitions: %v", pt.PartitionNames()))
+	}
+
 	pr.WriteChildren(append(children, additionalChildren...)...)
 	return pr.String()
 }
@@ -292,6 +292,22 @@ func (n *Revoke) HandleGlobalPrivileges(user *mysql_db.User) error {
 	return nil
 }
 
+// HandlePartialRevokes restricts the global privileges of the user from applying to the given database, for each
+// privilege being revoked that the user holds globally. This is only used when the partial_revokes system variable is
+// enabled, and should be called before HandleDatabasePrivileges.
+func (n *Revoke) HandlePartialRevokes(user *mysql_db.User, dbName string) {
+	for _, priv := range n.Privileges {
+		switch priv.Type {
+		case PrivilegeType_All:
+			user.PrivilegeSet.AddRestriction(dbName, allDatabasePrivileges...)
+		case PrivilegeType_Usage, PrivilegeType_Dynamic:
+			// Neither of these are restricted at the database level
+		default:
+			user.PrivilegeSet.AddRestriction(dbName, convertToSqlPrivilegeType(false, priv)...)
+		}
+	}
+}
+
 // HandleDatabasePrivileges  handles removing database privileges from a user.
 func (n *Revoke) HandleDatabasePrivileges(user *mysql_db.User, dbName string) error {
 	for i, priv := range n.Privileges {
@@ -548,13 +564,15 @@ func (*RevokeRole) CollationCoercibility(ctx *sql.Context) (collation sql.Collat
 
 // RevokeProxy represents the statement REVOKE PROXY.
 type RevokeProxy struct {
+	MySQLDb           sql.Database
 	On                UserName
 	From              []UserName
 	IfExists          bool
-	ignoreUnknownUser bool
+	IgnoreUnknownUser bool
 }
 
 var _ sql.Node = (*RevokeProxy)(nil)
+var _ sql.Databaser = (*RevokeProxy)(nil)
 var _ sql.CollationCoercible = (*RevokeProxy)(nil)
 var _ sql.AuthorizationCheckerNode = (*RevokeProxy)(nil)
 
@@ -564,7 +582,7 @@ func NewRevokeProxy(on UserName, from []UserName, ifExists, ignoreUnknownUser bo
 		On:                on,
 		From:              from,
 		IfExists:          ifExists,
-		ignoreUnknownUser: ignoreUnknownUser,
+		IgnoreUnknownUser: ignoreUnknownUser,
 	}
 }
 
@@ -582,9 +600,22 @@ func (n *RevokeProxy) String() string {
 	return fmt.Sprintf("RevokeProxy(On: %s, From: %s)", n.On.String(""), strings.Join(users, ", "))
 }
 
+// Database implements the interface sql.Databaser.
+func (n *RevokeProxy) Database() sql.Database {
+	return n.MySQLDb
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *RevokeProxy) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.MySQLDb = db
+	return &nn, nil
+}
+
 // Resolved implements the interface sql.Node.
 func (n *RevokeProxy) Resolved() bool {
-	return true
+	_, ok := n.MySQLDb.(sql.UnresolvedDatabase)
+	return !ok
 }
 
 func (n *RevokeProxy) IsReadOnly() bool {
@@ -606,8 +637,7 @@ func (n *RevokeProxy) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.
 
 // CheckAuth implements the interface sql.AuthorizationCheckerNode.
 func (n *RevokeProxy) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
-	// TODO: add this when proxy support is added
-	return true
+	return checkProxyAuth(ctx, opChecker, n.MySQLDb, n.On)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -0,0 +1,152 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/hash"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// RuntimeFilterMaxKeys is the largest number of distinct build side keys for which a runtime filter is built.
+	// Past this point the filter is unlikely to be selective enough to pay for itself.
+	RuntimeFilterMaxKeys = 1 << 20
+	// runtimeFilterFalsePositiveRate is the target false positive rate of the bloom filter.
+	runtimeFilterFalsePositiveRate = 0.01
+)
+
+// RuntimeFilter is a filter expression that is pushed into the probe side of a hash join. Once the build side of the
+// join has been materialized into its hash lookup, the filter is populated with a bloom filter of the build side keys,
+// and probe rows whose join key definitely has no match are discarded at the scan rather than flowing through the
+// join. Until the filter is built, and whenever it's skipped because the build side is too large, every row passes.
+type RuntimeFilter struct {
+	// Key is the probe side join key, with field indexes relative to the table it is pushed into.
+	Key    sql.Expression
+	lookup *HashLookup
+	state  *runtimeFilterState
+}
+
+type runtimeFilterState struct {
+	filter atomic.Pointer[hash.BloomFilter]
+}
+
+var _ sql.Expression = (*RuntimeFilter)(nil)
+var _ sql.CollationCoercible = (*RuntimeFilter)(nil)
+
+// NewRuntimeFilter returns a new RuntimeFilter that checks |key| against the build side keys of |lookup|.
+func NewRuntimeFilter(key sql.Expression, lookup *HashLookup) *RuntimeFilter {
+	return &RuntimeFilter{
+		Key:    key,
+		lookup: lookup,
+		state:  new(runtimeFilterState),
+	}
+}
+
+// Build populates the filter from the keys of |lookup|, which must be fully materialized.
+func (f *RuntimeFilter) Build(lookup map[interface{}][]sql.Row) {
+	if len(lookup) > RuntimeFilterMaxKeys {
+		return
+	}
+	bf := hash.NewBloomFilter(len(lookup), runtimeFilterFalsePositiveRate)
+	for k := range lookup {
+		bf.Add(runtimeFilterKey(k))
+	}
+	f.state.filter.Store(bf)
+}
+
+// Reset clears any filter built by a previous execution, so that every row passes until it is built again.
+func (f *RuntimeFilter) Reset() {
+	f.state.filter.Store(nil)
+}
+
+// Built returns whether the filter has been populated from the build side of its join.
+func (f *RuntimeFilter) Built() bool {
+	return f.state.filter.Load() != nil
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *RuntimeFilter) Resolved() bool {
+	return f.Key.Resolved()
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *RuntimeFilter) IsNullable(ctx *sql.Context) bool {
+	return false
+}
+
+// Type implements the sql.Expression interface.
+func (f *RuntimeFilter) Type(ctx *sql.Context) sql.Type {
+	return types.Boolean
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (f *RuntimeFilter) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Eval implements the sql.Expression interface.
+func (f *RuntimeFilter) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	bf := f.state.filter.Load()
+	if bf == nil {
+		return true, nil
+	}
+	key, _, err := f.lookup.GetHashKey(ctx, f.Key, row)
+	if err != nil {
+		// The join reports conversion errors for this row itself; the filter must not discard it first.
+		return true, nil
+	}
+	return bf.MayContain(runtimeFilterKey(key)), nil
+}
+
+// Children implements the sql.Expression interface.
+func (f *RuntimeFilter) Children() []sql.Expression {
+	return []sql.Expression{f.Key}
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *RuntimeFilter) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	nf := *f
+	nf.Key = children[0]
+	return &nf, nil
+}
+
+func (f *RuntimeFilter) String() string {
+	return fmt.Sprintf("RUNTIME_FILTER(%s)", f.Key)
+}
+
+func (f *RuntimeFilter) DebugString(ctx *sql.Context) string {
+	return fmt.Sprintf("RUNTIME_FILTER(%s)", sql.DebugString(ctx, f.Key))
+}
+
+// runtimeFilterKey converts a key produced by HashLookup.GetHashKey into a bloom filter hash.
+func runtimeFilterKey(k interface{}) uint64 {
+	switch k := k.(type) {
+	case uint64:
+		return k
+	case string:
+		return xxhash.Sum64String(k)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// SchemaChanges returns the changes to catalog objects that executing |n| makes, in the order it makes them. A
+// statement that alters the same object more than once, such as an ALTER TABLE with several clauses, reports the
+// change once. CREATE OR REPLACE statements report the object as created, whether or not it existed before.
+func SchemaChanges(ctx *sql.Context, n sql.Node) []sql.SchemaChange {
+	var changes []sql.SchemaChange
+	add := func(action sql.SchemaChangeAction, object sql.SchemaObjectType, db, name string) {
+		change := sql.SchemaChange{Action: action, Object: object, Database: db, Name: name}
+		for _, c := range changes {
+			if c == change {
+				return
+			}
+		}
+		changes = append(changes, change)
+	}
+	alterTable := func(db sql.Database, table sql.Node) {
+		add(sql.SchemaChangeAltered, sql.SchemaObjectTable, tableNodeDatabase(table, db), tableNodeName(table))
+	}
+
+	transform.InspectWithOpaque(ctx, n, func(ctx *sql.Context, n sql.Node) bool {
+		switch n := n.(type) {
+		case *CreateDB:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectDatabase, n.DbName, "")
+		case *CreateSchema:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectDatabase, n.DbName, "")
+		case *DropDB:
+			add(sql.SchemaChangeDropped, sql.SchemaObjectDatabase, n.DbName, "")
+		case *DropSchema:
+			add(sql.SchemaChangeDropped, sql.SchemaObjectDatabase, n.DbName, "")
+		case *AlterDB:
+			add(sql.SchemaChangeAltered, sql.SchemaObjectDatabase, n.Database(ctx), "")
+		case *CreateTable:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectTable, databaseName(n.Db), n.Name())
+		case *DropTable:
+			for _, t := range n.Tables {
+				add(sql.SchemaChangeDropped, sql.SchemaObjectTable, tableNodeDatabase(t, nil), tableNodeName(t))
+			}
+		case *RenameTable:
+			for i := range n.OldNames {
+				change := sql.SchemaChange{
+					Action:   sql.SchemaChangeRenamed,
+					Object:   sql.SchemaObjectTable,
+					Database: databaseName(n.Db),
+					Name:     n.OldNames[i],
+					NewName:  n.NewNames[i],
+				}
+				changes = append(changes, change)
+			}
+		case *AddColumn:
+			alterTable(n.Db, n.Table)
+		case *DropColumn:
+			alterTable(n.Db, n.Table)
+		case *RenameColumn:
+			alterTable(n.Db, n.Table)
+		case *ModifyColumn:
+			alterTable(n.Db, n.Table)
+		case *AlterDefaultSet:
+			alterTable(n.Db, n.Table)
+		case *AlterDefaultDrop:
+			alterTable(n.Db, n.Table)
+		case *AlterAutoIncrement:
+			alterTable(n.Db, n.Table)
+		case *AlterTableCollation:
+			alterTable(n.Db, n.Table)
+		case *AlterTableComment:
+			alterTable(n.Db, n.Table)
+		case *AlterPartition:
+			alterTable(n.Db, n.Table)
+		case *AlterPK:
+			alterTable(n.Db, n.Table)
+		case *CreateCheck:
+			alterTable(n.Db, n.Table)
+		case *DropCheck:
+			alterTable(n.Db, n.Table)
+		case *AlterIndex:
+			alterTable(n.Db, n.Table)
+		case *CreateIndex:
+			add(sql.SchemaChangeAltered, sql.SchemaObjectTable, tableNodeDatabase(n.Table, nil), tableNodeName(n.Table))
+		case *DropIndex:
+			add(sql.SchemaChangeAltered, sql.SchemaObjectTable, tableNodeDatabase(n.Table, nil), tableNodeName(n.Table))
+		case *CreateForeignKey:
+			add(sql.SchemaChangeAltered, sql.SchemaObjectTable, n.FkDef.Database, n.FkDef.Table)
+		case *DropForeignKey:
+			add(sql.SchemaChangeAltered, sql.SchemaObjectTable, n.Database(), n.Table)
+		case *RenameForeignKey:
+			add(sql.SchemaChangeAltered, sql.SchemaObjectTable, n.Database(), n.Table)
+		case *CreateView:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectView, databaseName(n.Database()), n.Name)
+		case *SingleDropView:
+			add(sql.SchemaChangeDropped, sql.SchemaObjectView, databaseName(n.Database()), n.ViewName)
+		case *CreateTrigger:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectTrigger, databaseName(n.Db), n.TriggerName)
+		case *DropTrigger:
+			add(sql.SchemaChangeDropped, sql.SchemaObjectTrigger, databaseName(n.Db), n.TriggerName)
+		case *CreateProcedure:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectProcedure, databaseName(n.ddlNode.Db), n.StoredProcDetails.Name)
+		case *DropProcedure:
+			add(sql.SchemaChangeDropped, sql.SchemaObjectProcedure, databaseName(n.Db), n.ProcedureName)
+		case *CreateFunction:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectFunction, databaseName(n.ddlNode.Db), n.StoredFunctionDetails.Name)
+		case *DropFunction:
+			add(sql.SchemaChangeDropped, sql.SchemaObjectFunction, databaseName(n.Db), n.FunctionName)
+		case *CreateEvent:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectEvent, databaseName(n.Db), n.EventName)
+		case *AlterEvent:
+			if n.AlterName {
+				changes = append(changes, sql.SchemaChange{
+					Action:   sql.SchemaChangeRenamed,
+					Object:   sql.SchemaObjectEvent,
+					Database: databaseName(n.Db),
+					Name:     n.EventName,
+					NewName:  n.RenameToName,
+				})
+			} else {
+				add(sql.SchemaChangeAltered, sql.SchemaObjectEvent, databaseName(n.Db), n.EventName)
+			}
+		case *DropEvent:
+			add(sql.SchemaChangeDropped, sql.SchemaObjectEvent, databaseName(n.Db), n.EventName)
+		case *CreateSequence:
+			add(sql.SchemaChangeCreated, sql.SchemaObjectSequence, databaseName(n.Db), n.Name)
+		case *AlterSequence:
+			add(sql.SchemaChangeAltered, sql.SchemaObjectSequence, databaseName(n.Db), n.Name)
+		case *DropSequence:
+			for i, name := range n.Names {
+				add(sql.SchemaChangeDropped, sql.SchemaObjectSequence, databaseName(n.Dbs[i]), name)
+			}
+		default:
+			return true
+		}
+		// the children of DDL nodes are the objects they change or their definitions, not further statements
+		return false
+	})
+	return changes
+}
+
+// databaseName returns the name of |db|, or the empty string if it's nil.
+func databaseName(db sql.Database) string {
+	if db == nil {
+		return ""
+	}
+	return db.Name()
+}
+
+// tableNodeName returns the name of the table |n| refers to.
+func tableNodeName(n sql.Node) string {
+	if nameable, ok := n.(sql.Nameable); ok {
+		return nameable.Name()
+	}
+	return ""
+}
+
+// tableNodeDatabase returns the name of the database of the table |n| refers to, or the name of |db| if |n| doesn't
+// record it.
+func tableNodeDatabase(n sql.Node, db sql.Database) string {
+	if databaser, ok := n.(interface{ Database() sql.Database }); ok && databaser.Database() != nil {
+		return databaser.Database().Name()
+	}
+	return databaseName(db)
+}
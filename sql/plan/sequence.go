@@ -0,0 +1,301 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// SequenceOptions are the options given to CREATE SEQUENCE or ALTER SEQUENCE. Options that aren't given are nil.
+type SequenceOptions struct {
+	Increment *int64
+	MinValue  *int64
+	MaxValue  *int64
+	Start     *int64
+	Cache     *int64
+	Cycle     *bool
+	// NoMinValue and NoMaxValue are NO MINVALUE and NO MAXVALUE, which give the bound its default
+	NoMinValue bool
+	NoMaxValue bool
+	// Restart is the RESTART option of ALTER SEQUENCE, which restarts the sequence with RestartWith, or with its start
+	// value if RestartWith is nil
+	Restart     bool
+	RestartWith *int64
+}
+
+// Definition returns the sequence created by CREATE SEQUENCE with the options given. Options that aren't given have
+// the defaults of MariaDB.
+func (o SequenceOptions) Definition(name string) sql.SequenceDefinition {
+	seq := sql.SequenceDefinition{Name: name, Increment: 1, Cache: 1000}
+	if o.Increment != nil {
+		seq.Increment = *o.Increment
+	}
+	seq.MinValue, seq.MaxValue = sql.DefaultSequenceBounds(seq.Increment)
+	seq = o.Apply(seq)
+	if o.Start == nil {
+		seq.Start = seq.MinValue
+		if seq.Increment < 0 {
+			seq.Start = seq.MaxValue
+		}
+	}
+	seq.Next = seq.Start
+	return seq
+}
+
+// Apply returns |seq| changed by the options given. The value the sequence generates next only changes with RESTART.
+func (o SequenceOptions) Apply(seq sql.SequenceDefinition) sql.SequenceDefinition {
+	for _, opt := range []struct {
+		dst *int64
+		src *int64
+	}{
+		{&seq.Increment, o.Increment},
+		{&seq.MinValue, o.MinValue},
+		{&seq.MaxValue, o.MaxValue},
+		{&seq.Start, o.Start},
+		{&seq.Cache, o.Cache},
+	} {
+		if opt.src != nil {
+			*opt.dst = *opt.src
+		}
+	}
+	if o.Cycle != nil {
+		seq.Cycle = *o.Cycle
+	}
+	minValue, maxValue := sql.DefaultSequenceBounds(seq.Increment)
+	if o.NoMinValue {
+		seq.MinValue = minValue
+	}
+	if o.NoMaxValue {
+		seq.MaxValue = maxValue
+	}
+	if o.Restart {
+		seq.Next, seq.Exhausted = seq.Start, false
+		if o.RestartWith != nil {
+			seq.Next = *o.RestartWith
+		}
+	}
+	return seq
+}
+
+// CreateSequence is CREATE SEQUENCE, which creates a sequence in a sql.SequenceDatabase.
+type CreateSequence struct {
+	ddlNode
+	Name        string
+	Options     SequenceOptions
+	IfNotExists bool
+	// OrReplace is CREATE OR REPLACE SEQUENCE, which replaces an existing sequence with the same name
+	OrReplace bool
+}
+
+var _ sql.Node = (*CreateSequence)(nil)
+var _ sql.Databaser = (*CreateSequence)(nil)
+var _ sql.CollationCoercible = (*CreateSequence)(nil)
+
+// NewCreateSequence returns a new *CreateSequence node.
+func NewCreateSequence(db sql.Database, name string, options SequenceOptions, ifNotExists, orReplace bool) *CreateSequence {
+	return &CreateSequence{
+		ddlNode:     ddlNode{Db: db},
+		Name:        name,
+		Options:     options,
+		IfNotExists: ifNotExists,
+		OrReplace:   orReplace,
+	}
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (c *CreateSequence) WithDatabase(db sql.Database) (sql.Node, error) {
+	nc := *c
+	nc.Db = db
+	return &nc, nil
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (c *CreateSequence) IsReadOnly() bool {
+	return false
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CreateSequence) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CreateSequence) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (c *CreateSequence) String() string {
+	return fmt.Sprintf("CreateSequence(%s.%s)", c.Db.Name(), c.Name)
+}
+
+// AlterSequence is ALTER SEQUENCE, which changes the options of a sequence.
+type AlterSequence struct {
+	ddlNode
+	Name     string
+	Options  SequenceOptions
+	IfExists bool
+}
+
+var _ sql.Node = (*AlterSequence)(nil)
+var _ sql.Databaser = (*AlterSequence)(nil)
+var _ sql.CollationCoercible = (*AlterSequence)(nil)
+
+// NewAlterSequence returns a new *AlterSequence node.
+func NewAlterSequence(db sql.Database, name string, options SequenceOptions, ifExists bool) *AlterSequence {
+	return &AlterSequence{
+		ddlNode:  ddlNode{Db: db},
+		Name:     name,
+		Options:  options,
+		IfExists: ifExists,
+	}
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (a *AlterSequence) WithDatabase(db sql.Database) (sql.Node, error) {
+	na := *a
+	na.Db = db
+	return &na, nil
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (a *AlterSequence) IsReadOnly() bool {
+	return false
+}
+
+// WithChildren implements the sql.Node interface.
+func (a *AlterSequence) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(a, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*AlterSequence) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (a *AlterSequence) String() string {
+	return fmt.Sprintf("AlterSequence(%s.%s)", a.Db.Name(), a.Name)
+}
+
+// DropSequence is DROP SEQUENCE, which drops sequences from their databases. The sequence named by each element of
+// Names is dropped from the database at the same position of Dbs.
+type DropSequence struct {
+	Dbs      []sql.Database
+	Names    []string
+	IfExists bool
+}
+
+var _ sql.Node = (*DropSequence)(nil)
+var _ sql.CollationCoercible = (*DropSequence)(nil)
+
+// NewDropSequence returns a new *DropSequence node.
+func NewDropSequence(dbs []sql.Database, names []string, ifExists bool) *DropSequence {
+	return &DropSequence{Dbs: dbs, Names: names, IfExists: ifExists}
+}
+
+// Schema implements the sql.Node interface.
+func (d *DropSequence) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DropSequence) Resolved() bool {
+	for _, db := range d.Dbs {
+		if _, ok := db.(sql.UnresolvedDatabase); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (d *DropSequence) IsReadOnly() bool {
+	return false
+}
+
+// Children implements the sql.Node interface.
+func (d *DropSequence) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DropSequence) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(d, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*DropSequence) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (d *DropSequence) String() string {
+	names := make([]string, len(d.Names))
+	for i, name := range d.Names {
+		names[i] = d.Dbs[i].Name() + "." + name
+	}
+	return fmt.Sprintf("DropSequence(%s)", strings.Join(names, ", "))
+}
+
+// ShowCreateSequence is SHOW CREATE SEQUENCE, which returns a CREATE SEQUENCE statement for a sequence.
+type ShowCreateSequence struct {
+	ddlNode
+	Name string
+}
+
+var _ sql.Node = (*ShowCreateSequence)(nil)
+var _ sql.Databaser = (*ShowCreateSequence)(nil)
+var _ sql.CollationCoercible = (*ShowCreateSequence)(nil)
+
+// NewShowCreateSequence returns a new *ShowCreateSequence node.
+func NewShowCreateSequence(db sql.Database, name string) *ShowCreateSequence {
+	return &ShowCreateSequence{ddlNode: ddlNode{Db: db}, Name: name}
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (s *ShowCreateSequence) WithDatabase(db sql.Database) (sql.Node, error) {
+	ns := *s
+	ns.Db = db
+	return &ns, nil
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowCreateSequence) Schema(ctx *sql.Context) sql.Schema {
+	return sql.Schema{
+		&sql.Column{Name: "Table", Type: types.LongText, Nullable: false},
+		&sql.Column{Name: "Create Table", Type: types.LongText, Nullable: false},
+	}
+}
+
+// IsReadOnly implements the sql.Node interface.
+func (s *ShowCreateSequence) IsReadOnly() bool {
+	return true
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowCreateSequence) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ShowCreateSequence) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (s *ShowCreateSequence) String() string {
+	return fmt.Sprintf("ShowCreateSequence(%s.%s)", s.Db.Name(), s.Name)
+}
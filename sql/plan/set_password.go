@@ -0,0 +1,99 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// SetPassword represents the statement SET PASSWORD, which sets the password of an account without changing its
+// authentication plugin. It's the only statement that a user whose password has expired may run, besides ALTER USER
+// for its own account.
+type SetPassword struct {
+	MySQLDb sql.Database
+	// User is the account given by SET PASSWORD FOR, and is nil for the account of the current user.
+	User     *UserName
+	Password string
+}
+
+var _ sql.Node = (*SetPassword)(nil)
+var _ sql.Databaser = (*SetPassword)(nil)
+var _ sql.CollationCoercible = (*SetPassword)(nil)
+
+// NewSetPassword returns a new SetPassword node.
+func NewSetPassword(mysqlDb sql.Database, user *UserName, password string) *SetPassword {
+	return &SetPassword{
+		MySQLDb:  mysqlDb,
+		User:     user,
+		Password: password,
+	}
+}
+
+// Schema implements the interface sql.Node.
+func (n *SetPassword) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.Node.
+func (n *SetPassword) String() string {
+	if n.User == nil {
+		return "SetPassword"
+	}
+	return fmt.Sprintf("SetPassword(%s)", n.User.String("`"))
+}
+
+// Database implements the interface sql.Databaser.
+func (n *SetPassword) Database() sql.Database {
+	return n.MySQLDb
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *SetPassword) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.MySQLDb = db
+	return &nn, nil
+}
+
+// Resolved implements the interface sql.Node.
+func (n *SetPassword) Resolved() bool {
+	_, ok := n.MySQLDb.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (n *SetPassword) IsReadOnly() bool {
+	return false
+}
+
+// Children implements the interface sql.Node.
+func (n *SetPassword) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *SetPassword) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*SetPassword) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// RoleSelection is the choice of roles made by SET ROLE and SET DEFAULT ROLE.
+type RoleSelection byte
+
+const (
+	// RoleSelection_List selects the roles that are named.
+	RoleSelection_List RoleSelection = iota
+	// RoleSelection_Default selects the default roles of the user, which only SET ROLE may select.
+	RoleSelection_Default
+	// RoleSelection_None selects no roles.
+	RoleSelection_None
+	// RoleSelection_All selects every role that is granted to the user.
+	RoleSelection_All
+	// RoleSelection_AllExcept selects every role that is granted to the user, other than the roles that are named.
+	RoleSelection_AllExcept
+)
+
+// String returns the SQL of the selection of |roles|.
+func (s RoleSelection) String(roles []UserName) string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.String("`")
+	}
+	switch s {
+	case RoleSelection_Default:
+		return "DEFAULT"
+	case RoleSelection_None:
+		return "NONE"
+	case RoleSelection_All:
+		return "ALL"
+	case RoleSelection_AllExcept:
+		return "ALL EXCEPT " + strings.Join(names, ", ")
+	default:
+		return strings.Join(names, ", ")
+	}
+}
+
+// SetRole represents the statement SET ROLE, which sets the roles that are active in the session.
+type SetRole struct {
+	MySQLDb   sql.Database
+	Selection RoleSelection
+	Roles     []UserName
+}
+
+var _ sql.Node = (*SetRole)(nil)
+var _ sql.Databaser = (*SetRole)(nil)
+var _ sql.CollationCoercible = (*SetRole)(nil)
+
+// NewSetRole returns a new SetRole node.
+func NewSetRole(mysqlDb sql.Database, selection RoleSelection, roles []UserName) *SetRole {
+	return &SetRole{
+		MySQLDb:   mysqlDb,
+		Selection: selection,
+		Roles:     roles,
+	}
+}
+
+// Schema implements the interface sql.Node.
+func (n *SetRole) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.Node.
+func (n *SetRole) String() string {
+	return fmt.Sprintf("SetRole(%s)", n.Selection.String(n.Roles))
+}
+
+// Database implements the interface sql.Databaser.
+func (n *SetRole) Database() sql.Database {
+	return n.MySQLDb
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *SetRole) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.MySQLDb = db
+	return &nn, nil
+}
+
+// Resolved implements the interface sql.Node.
+func (n *SetRole) Resolved() bool {
+	_, ok := n.MySQLDb.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (n *SetRole) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the interface sql.Node.
+func (n *SetRole) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *SetRole) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*SetRole) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// SetDefaultRole represents the statements SET DEFAULT ROLE and ALTER USER ... DEFAULT ROLE, which set the roles that
+// are active when the users given log in.
+type SetDefaultRole struct {
+	MySQLDb   sql.Database
+	Selection RoleSelection
+	Roles     []UserName
+	Users     []UserName
+	// IfExists skips the users that don't exist, which only ALTER USER IF EXISTS does.
+	IfExists bool
+}
+
+var _ sql.Node = (*SetDefaultRole)(nil)
+var _ sql.Databaser = (*SetDefaultRole)(nil)
+var _ sql.CollationCoercible = (*SetDefaultRole)(nil)
+
+// NewSetDefaultRole returns a new SetDefaultRole node.
+func NewSetDefaultRole(mysqlDb sql.Database, selection RoleSelection, roles []UserName, users []UserName, ifExists bool) *SetDefaultRole {
+	return &SetDefaultRole{
+		MySQLDb:   mysqlDb,
+		Selection: selection,
+		Roles:     roles,
+		Users:     users,
+		IfExists:  ifExists,
+	}
+}
+
+// Schema implements the interface sql.Node.
+func (n *SetDefaultRole) Schema(ctx *sql.Context) sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.Node.
+func (n *SetDefaultRole) String() string {
+	users := make([]string, len(n.Users))
+	for i, user := range n.Users {
+		users[i] = user.String("")
+	}
+	return fmt.Sprintf("SetDefaultRole(%s, To: %s)", n.Selection.String(n.Roles), strings.Join(users, ", "))
+}
+
+// Database implements the interface sql.Databaser.
+func (n *SetDefaultRole) Database() sql.Database {
+	return n.MySQLDb
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *SetDefaultRole) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.MySQLDb = db
+	return &nn, nil
+}
+
+// Resolved implements the interface sql.Node.
+func (n *SetDefaultRole) Resolved() bool {
+	_, ok := n.MySQLDb.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (n *SetDefaultRole) IsReadOnly() bool {
+	return false
+}
+
+// Children implements the interface sql.Node.
+func (n *SetDefaultRole) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *SetDefaultRole) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*SetDefaultRole) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SharedResult is the body of a common table expression marked with the CACHED hint, e.g.
+//
+//	WITH totals AS (SELECT ...) SELECT /*+ CACHED(totals) */ ... FROM totals
+//
+// The first statement in a session to execute it stores its result in the engine's sql.SharedResultCache, and later
+// statements in the session that define the same expression read the stored rows instead of executing the child, until
+// one of the tables the result was computed from is written to.
+//
+// The stored result must not depend on the statement that reads it, so filters are never pushed below this node.
+type SharedResult struct {
+	UnaryNode
+	// Name is the name of the common table expression.
+	Name string
+	// Key identifies the expression in the cache. Expressions with the same key must produce the same result.
+	Key string
+	// Tables are the tables the result is computed from, as keys returned by sql.SharedResultTableKey.
+	Tables []string
+}
+
+var _ sql.Node = (*SharedResult)(nil)
+var _ sql.CollationCoercible = (*SharedResult)(nil)
+
+// NewSharedResult returns a new SharedResult for the common table expression |name| with body |child|.
+func NewSharedResult(name, key string, tables []string, child sql.Node) *SharedResult {
+	return &SharedResult{
+		UnaryNode: UnaryNode{Child: child},
+		Name:      name,
+		Key:       key,
+		Tables:    tables,
+	}
+}
+
+func (n *SharedResult) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("SharedResult(%s)", n.Name)
+	_ = pr.WriteChildren(n.Child.String())
+	return pr.String()
+}
+
+func (n *SharedResult) DebugString(ctx *sql.Context) string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("SharedResult(%s)", n.Name)
+	children := []string{fmt.Sprintf("tables: %v", n.Tables), sql.DebugString(ctx, n.Child)}
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
+
+func (n *SharedResult) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	nn := *n
+	nn.UnaryNode.Child = children[0]
+	return &nn, nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (n *SharedResult) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, n.Child)
+}
+
+func (n *SharedResult) IsReadOnly() bool {
+	return n.Child.IsReadOnly()
+}
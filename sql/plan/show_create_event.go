@@ -29,6 +29,7 @@ type ShowCreateEvent struct {
 var _ sql.Databaser = (*ShowCreateEvent)(nil)
 var _ sql.Node = (*ShowCreateEvent)(nil)
 var _ sql.CollationCoercible = (*ShowCreateEvent)(nil)
+var _ sql.AuthorizationCheckerNode = (*ShowCreateEvent)(nil)
 
 var showCreateEventSchema = sql.Schema{
 	&sql.Column{Name: "Event", Type: types.LongText, Nullable: false},
@@ -94,3 +95,9 @@ func (s *ShowCreateEvent) WithDatabase(db sql.Database) (sql.Node, error) {
 	ns.db = db
 	return &ns, nil
 }
+
+// CheckAuth implements the sql.AuthorizationCheckerNode interface.
+func (s *ShowCreateEvent) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	subject := sql.PrivilegeCheckSubject{Database: s.db.Name()}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Event))
+}
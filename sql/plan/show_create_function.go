@@ -0,0 +1,97 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ShowCreateFunction shows the CREATE statement of a stored function written in SQL.
+type ShowCreateFunction struct {
+	db           sql.Database
+	FunctionName string
+}
+
+var _ sql.Databaser = (*ShowCreateFunction)(nil)
+var _ sql.Node = (*ShowCreateFunction)(nil)
+var _ sql.CollationCoercible = (*ShowCreateFunction)(nil)
+
+var showCreateFunctionSchema = sql.Schema{
+	&sql.Column{Name: "Function", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "sql_mode", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Create Function", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "character_set_client", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "collation_connection", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Database Collation", Type: types.LongText, Nullable: false},
+}
+
+// NewShowCreateFunction creates a new ShowCreateFunction node for SHOW CREATE FUNCTION statements.
+func NewShowCreateFunction(db sql.Database, function string) *ShowCreateFunction {
+	return &ShowCreateFunction{
+		db:           db,
+		FunctionName: strings.ToLower(function),
+	}
+}
+
+// String implements the sql.Node interface.
+func (s *ShowCreateFunction) String() string {
+	return fmt.Sprintf("SHOW CREATE FUNCTION %s", s.FunctionName)
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowCreateFunction) Resolved() bool {
+	_, ok := s.db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+func (s *ShowCreateFunction) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (s *ShowCreateFunction) Children() []sql.Node {
+	return nil
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowCreateFunction) Schema(ctx *sql.Context) sql.Schema {
+	return showCreateFunctionSchema
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowCreateFunction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ShowCreateFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Database implements the sql.Databaser interface.
+func (s *ShowCreateFunction) Database() sql.Database {
+	return s.db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (s *ShowCreateFunction) WithDatabase(db sql.Database) (sql.Node, error) {
+	ns := *s
+	ns.db = db
+	return &ns, nil
+}
@@ -35,6 +35,8 @@ type ShowCreateTable struct {
 	checks           sql.CheckConstraints
 	targetSchema     sql.Schema
 	IsView           bool
+	// DbName is the database of the table or view, which the privileges to show the definition of a view are checked on
+	DbName string
 }
 
 var _ sql.Node = (*ShowCreateTable)(nil)
@@ -42,6 +44,7 @@ var _ sql.Expressioner = (*ShowCreateTable)(nil)
 var _ sql.SchemaTarget = (*ShowCreateTable)(nil)
 var _ sql.CheckConstraintNode = (*ShowCreateTable)(nil)
 var _ sql.CollationCoercible = (*ShowCreateTable)(nil)
+var _ sql.AuthorizationCheckerNode = (*ShowCreateTable)(nil)
 var _ Versionable = (*ShowCreateTable)(nil)
 
 // NewShowCreateTable creates a new ShowCreateTable node.
@@ -186,3 +189,14 @@ func (sc *ShowCreateTable) String() string {
 
 	return fmt.Sprintf("SHOW CREATE %s %s %s", t, name, asOfClause)
 }
+
+// CheckAuth implements the sql.AuthorizationCheckerNode interface. Showing the definition of a view takes the SHOW VIEW
+// and SELECT privileges on it. Tables that the user has no privileges on can't be resolved, so they need no check here.
+func (sc *ShowCreateTable) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	view, ok := sc.Child.(*SubqueryAlias)
+	if !ok {
+		return true
+	}
+	subject := sql.PrivilegeCheckSubject{Database: sc.DbName, Table: view.Name()}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_ShowView, sql.PrivilegeType_Select))
+}
@@ -25,11 +25,14 @@ import (
 type ShowCreateTrigger struct {
 	db          sql.Database
 	TriggerName string
+	// Table is the name of the table of the trigger, which the TRIGGER privilege is checked on
+	Table string
 }
 
 var _ sql.Databaser = (*ShowCreateTrigger)(nil)
 var _ sql.Node = (*ShowCreateTrigger)(nil)
 var _ sql.CollationCoercible = (*ShowCreateTrigger)(nil)
+var _ sql.AuthorizationCheckerNode = (*ShowCreateTrigger)(nil)
 
 var showCreateTriggerSchema = sql.Schema{
 	&sql.Column{Name: "Trigger", Type: types.LongText, Nullable: false},
@@ -95,3 +98,9 @@ func (s *ShowCreateTrigger) WithDatabase(db sql.Database) (sql.Node, error) {
 	ns.db = db
 	return &ns, nil
 }
+
+// CheckAuth implements the sql.AuthorizationCheckerNode interface.
+func (s *ShowCreateTrigger) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	subject := sql.PrivilegeCheckSubject{Database: s.db.Name(), Table: s.Table}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Trigger))
+}
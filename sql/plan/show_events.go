@@ -27,6 +27,7 @@ type ShowEvents struct {
 var _ sql.Databaser = (*ShowEvents)(nil)
 var _ sql.Node = (*ShowEvents)(nil)
 var _ sql.CollationCoercible = (*ShowEvents)(nil)
+var _ sql.AuthorizationCheckerNode = (*ShowEvents)(nil)
 
 var showEventsSchema = sql.Schema{
 	&sql.Column{Name: "Db", Type: types.LongText, Nullable: false},
@@ -173,3 +174,9 @@ func (s *ShowEvents) WithDatabase(db sql.Database) (sql.Node, error) {
 	ns.db = db
 	return &ns, nil
 }
+
+// CheckAuth implements the sql.AuthorizationCheckerNode interface.
+func (s *ShowEvents) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	subject := sql.PrivilegeCheckSubject{Database: s.db.Name()}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Event))
+}
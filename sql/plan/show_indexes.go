@@ -25,6 +25,8 @@ import (
 type ShowIndexes struct {
 	UnaryNode
 	IndexesToShow []sql.Index
+	// Stats provides the statistics that the cardinality of each index is estimated from
+	Stats sql.StatsProvider
 }
 
 // NewShowIndexes creates a new ShowIndexes node. The node must represent a table.
@@ -46,6 +48,7 @@ func (n *ShowIndexes) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.
 	return &ShowIndexes{
 		UnaryNode:     UnaryNode{children[0]},
 		IndexesToShow: n.IndexesToShow,
+		Stats:         n.Stats,
 	}, nil
 }
 
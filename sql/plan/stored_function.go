@@ -0,0 +1,195 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/procedures"
+)
+
+// StoredFunction is a call to a stored function written in SQL, which is created with CREATE FUNCTION. Its body is
+// run by the interpreter each time the call is evaluated.
+type StoredFunction struct {
+	Name            string
+	Db              string
+	Args            []sql.Expression
+	Params          []ProcedureParam
+	ReturnType      sql.Type
+	Deterministic   bool
+	CreateStatement string
+	ParserOptions   ast.ParserOptions
+	Runner          sql.StatementRunner
+}
+
+var _ sql.FunctionExpression = (*StoredFunction)(nil)
+var _ sql.CollationCoercible = (*StoredFunction)(nil)
+var _ sql.NonDeterministicExpression = (*StoredFunction)(nil)
+var _ procedures.InterpreterExpr = (*StoredFunction)(nil)
+
+// storedFunctionCallsKey is the context key of the stored functions that are being evaluated, which may not be called
+// again until they return.
+type storedFunctionCallsKey struct{}
+
+// NewStoredFunction returns a *StoredFunction that calls the function |name| of the database |db| with |args|. Its
+// body is parsed from |createStatement|, the statement that created the function, with |parserOptions|.
+func NewStoredFunction(name, db string, args []sql.Expression, params []ProcedureParam, returnType sql.Type, deterministic bool, createStatement string, parserOptions ast.ParserOptions) *StoredFunction {
+	return &StoredFunction{
+		Name:            strings.ToLower(name),
+		Db:              db,
+		Args:            args,
+		Params:          params,
+		ReturnType:      returnType,
+		Deterministic:   deterministic,
+		CreateStatement: createStatement,
+		ParserOptions:   parserOptions,
+	}
+}
+
+// FunctionName implements the sql.FunctionExpression interface.
+func (f *StoredFunction) FunctionName() string {
+	return f.Name
+}
+
+// Description implements the sql.FunctionExpression interface.
+func (f *StoredFunction) Description() string {
+	return "stored function"
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *StoredFunction) Resolved() bool {
+	return expression.ExpressionsResolved(f.Args...)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *StoredFunction) IsNullable(ctx *sql.Context) bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (f *StoredFunction) Type(ctx *sql.Context) sql.Type {
+	return f.ReturnType
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (f *StoredFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if st, ok := f.ReturnType.(sql.StringType); ok {
+		return st.Collation(), 4
+	}
+	return sql.Collation_binary, 5
+}
+
+// IsNonDeterministic implements the sql.NonDeterministicExpression interface. Functions that aren't declared
+// DETERMINISTIC are evaluated for every row.
+func (f *StoredFunction) IsNonDeterministic() bool {
+	return !f.Deterministic
+}
+
+// Children implements the sql.Expression interface.
+func (f *StoredFunction) Children() []sql.Expression {
+	return f.Args
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *StoredFunction) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(f.Args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.Args))
+	}
+	nf := *f
+	nf.Args = children
+	return &nf, nil
+}
+
+// SetStatementRunner implements the procedures.InterpreterExpr interface.
+func (f *StoredFunction) SetStatementRunner(ctx *sql.Context, runner sql.StatementRunner) sql.Expression {
+	nf := *f
+	nf.Runner = runner
+	return &nf
+}
+
+// String implements the sql.Expression interface.
+func (f *StoredFunction) String() string {
+	args := make([]string, len(f.Args))
+	for i, arg := range f.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", f.Name, strings.Join(args, ","))
+}
+
+// Eval implements the sql.Expression interface.
+func (f *StoredFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if f.Runner == nil {
+		return nil, fmt.Errorf("stored function %s can't be called here", f.Name)
+	}
+	// MySQL doesn't allow stored functions to call themselves, directly or through other functions
+	qualifiedName := f.Db + "." + f.Name
+	calls, _ := ctx.Context.Value(storedFunctionCallsKey{}).([]string)
+	for _, call := range calls {
+		if call == qualifiedName {
+			return nil, sql.ErrStoredFunctionRecursive.New()
+		}
+	}
+
+	stack := procedures.NewInterpreterStack()
+	stack.SetDatabase(f.Db)
+	for i, arg := range f.Args {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		param := f.Params[i]
+		val, _, err = param.Type.Convert(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		stack.NewVariableWithValue(strings.ToLower(param.Name), param.Type, val)
+	}
+
+	ops, err := f.operations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	calls = append(calls[:len(calls):len(calls)], qualifiedName)
+	fnCtx := ctx.WithContext(context.WithValue(ctx.Context, storedFunctionCallsKey{}, calls))
+	val, ok, err := procedures.CallFunction(fnCtx, f.Runner, stack, ops)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrStoredFunctionEndedWithoutReturn.New(f.Name)
+	}
+	val, _, err = f.ReturnType.Convert(ctx, val)
+	return val, err
+}
+
+// operations returns the interpreter operations of the body of the function. The interpreter substitutes the values of
+// variables into the statements that it runs, so each call parses the body again rather than sharing its statements.
+func (f *StoredFunction) operations(ctx *sql.Context) ([]*procedures.InterpreterOperation, error) {
+	stmt, err := ast.ParseWithOptions(ctx, f.CreateStatement, f.ParserOptions)
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := stmt.(*ast.DDL)
+	if !ok || ddl.FunctionSpec == nil {
+		return nil, fmt.Errorf("invalid CREATE FUNCTION statement: %s", f.CreateStatement)
+	}
+	return procedures.Parse(ddl.FunctionSpec.Body)
+}
@@ -54,6 +54,10 @@ type Subquery struct {
 	cacheMu sync.Mutex
 	// Whether results have been cached
 	resultsCached bool
+	// Cached results of a correlated subquery by the values it's correlated with, if they can be cached
+	correlatedCache *correlatedCache
+	// Whether correlatedCache has been initialized
+	correlatedCacheInit bool
 
 	// volatile indicates that the expression contains a non-deterministic function
 	volatile bool
@@ -124,7 +128,7 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return s.cache[0], nil
 	}
 
-	rows, err := s.evalMultiple(ctx, row)
+	rows, err := s.evalCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -283,7 +287,7 @@ func (s *Subquery) EvalMultiple(ctx *sql.Context, row sql.Row) ([]any, error) {
 		return s.cache, nil
 	}
 
-	result, err := s.evalMultiple(ctx, row)
+	result, err := s.evalCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -359,7 +363,7 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 		return s.hashCache, nil
 	}
 
-	result, err := s.evalMultiple(ctx, row)
+	result, err := s.evalCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -396,6 +400,9 @@ func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 	if cached {
 		return len(s.cache) > 0, nil
 	}
+	if result, ok := s.cachedCorrelatedResult(ctx, row); ok {
+		return len(result) > 0, nil
+	}
 
 	// Any source of rows, as well as any node that alters the schema of its children, needs to be wrapped so that its
 	// result rows are prepended with the scope row.
@@ -586,6 +593,10 @@ func (s *Subquery) Dispose(ctx *sql.Context) {
 		s.disposeFunc()
 		s.disposeFunc = nil
 	}
+	if s.correlatedCache != nil {
+		s.correlatedCache.dispose()
+		s.correlatedCache, s.correlatedCacheInit = nil, false
+	}
 	disposeNode(ctx, s.Query)
 }
 
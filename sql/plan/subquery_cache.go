@@ -0,0 +1,184 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/hash"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// correlatedCacheSize is the number of distinct outer values whose results a correlated subquery remembers.
+const correlatedCacheSize = 1024
+
+// correlatedCache memoizes the results of a correlated subquery by the values of the outer row it references, so that
+// outer rows that repeat those values don't execute the subquery again. It's bounded, evicting the least recently used
+// results once full.
+type correlatedCache struct {
+	// rowLen is the length of the outer rows the subquery is evaluated with
+	rowLen int
+	// indexes are the positions in the outer row of the columns the subquery references
+	indexes []int
+	// sch describes the types of the columns at |indexes|
+	sch     sql.Schema
+	cache   sql.KeyValueCache
+	dispose sql.DisposeFunc
+}
+
+// correlatedResult is the result of a correlated subquery for one set of values of the outer columns it references.
+type correlatedResult struct {
+	key    sql.Row
+	result []any
+}
+
+// newCorrelatedCache returns a cache for the results of |s| when it's evaluated with outer rows of length |rowLen|, or
+// nil if the outer columns that |s| is correlated with can't all be located in the outer row. Since the subquery is
+// executed with the outer row prepended to its own rows, every field of the subquery, or of subqueries nested in it,
+// whose index is below |rowLen| references the outer row.
+func newCorrelatedCache(ctx *sql.Context, s *Subquery, rowLen int) *correlatedCache {
+	outer := make(map[int]*expression.GetField)
+	var found sql.ColSet
+	var inspect func(n sql.Node)
+	inspect = func(n sql.Node) {
+		transform.InspectExpressions(ctx, n, func(ctx *sql.Context, e sql.Expression) bool {
+			switch e := e.(type) {
+			case *expression.GetField:
+				if e.Index() < rowLen {
+					outer[e.Index()] = e
+					if s.correlated.Contains(e.Id()) {
+						found.Add(e.Id())
+					}
+				}
+			case *Subquery:
+				inspect(e.Query)
+			}
+			return true
+		})
+	}
+	inspect(s.Query)
+	if !found.Equals(s.correlated) {
+		return nil
+	}
+
+	c := &correlatedCache{rowLen: rowLen}
+	for idx := range outer {
+		c.indexes = append(c.indexes, idx)
+	}
+	sort.Ints(c.indexes)
+	for _, idx := range c.indexes {
+		c.sch = append(c.sch, &sql.Column{Type: outer[idx].Type(ctx)})
+	}
+	c.cache, c.dispose = ctx.Memory.NewLRUCache(ctx, correlatedCacheSize)
+	return c
+}
+
+// key returns the values of the columns of |row| the subquery references, and their hash, or false if |row| isn't
+// like the rows the cache was created for.
+func (c *correlatedCache) key(ctx *sql.Context, row sql.Row) (sql.Row, uint64, bool) {
+	if len(row) != c.rowLen {
+		return nil, 0, false
+	}
+	key := make(sql.Row, len(c.indexes))
+	for i, idx := range c.indexes {
+		key[i] = row[idx]
+	}
+	h, err := hash.HashOf(ctx, c.sch, key)
+	if err != nil {
+		return nil, 0, false
+	}
+	return key, h, true
+}
+
+// get returns the cached result for |key|, whose hash is |h|. Results for different values with the same hash are
+// never returned.
+func (c *correlatedCache) get(key sql.Row, h uint64) ([]any, bool) {
+	v, err := c.cache.Get(h)
+	if err != nil {
+		return nil, false
+	}
+	res := v.(correlatedResult)
+	if !reflect.DeepEqual(res.key, key) {
+		return nil, false
+	}
+	return res.result, true
+}
+
+func (c *correlatedCache) put(key sql.Row, h uint64, result []any) error {
+	return c.cache.Put(h, correlatedResult{key: key, result: result})
+}
+
+// correlatedResults returns the cache for the results of this correlated subquery, creating it for outer rows like
+// |row| on first use, or nil if its results can't be cached by the values of the outer row.
+func (s *Subquery) correlatedResults(ctx *sql.Context, row sql.Row) *correlatedCache {
+	if s.correlated.Empty() || s.volatile || ctx.Memory == nil {
+		return nil
+	}
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if !s.correlatedCacheInit {
+		s.correlatedCache, s.correlatedCacheInit = newCorrelatedCache(ctx, s, len(row)), true
+	}
+	return s.correlatedCache
+}
+
+// evalCorrelated returns all rows returned by the subquery for the outer row |row|, reusing the results of earlier
+// outer rows with the same values for the columns the subquery references.
+func (s *Subquery) evalCorrelated(ctx *sql.Context, row sql.Row) ([]any, error) {
+	c := s.correlatedResults(ctx, row)
+	if c == nil {
+		return s.evalMultiple(ctx, row)
+	}
+	key, h, ok := c.key(ctx, row)
+	if !ok {
+		return s.evalMultiple(ctx, row)
+	}
+
+	s.cacheMu.Lock()
+	result, cached := c.get(key, h)
+	s.cacheMu.Unlock()
+	if cached {
+		return result, nil
+	}
+
+	result, err := s.evalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if err = c.put(key, h, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// cachedCorrelatedResult returns the result of the subquery cached for the outer row |row|, if there is one.
+func (s *Subquery) cachedCorrelatedResult(ctx *sql.Context, row sql.Row) ([]any, bool) {
+	c := s.correlatedResults(ctx, row)
+	if c == nil {
+		return nil, false
+	}
+	key, h, ok := c.key(ctx, row)
+	if !ok {
+		return nil, false
+	}
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	return c.get(key, h)
+}
@@ -58,7 +58,7 @@ func (tc *TableCopier) ProcessCreateTable(ctx *sql.Context, b sql.NodeExecBuilde
 		return sql.RowsToRowIter(), err
 	}
 
-	table, tableExists, err := tc.db.GetTableInsensitive(ctx, ct.Name())
+	table, tableExists, err := sql.GetSessionTableInsensitive(ctx, tc.db, ct.Name())
 	if err != nil {
 		return sql.RowsToRowIter(), err
 	}
@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -135,13 +136,42 @@ func (u *updatableJoinTable) Collation() sql.CollationID {
 // Updater implements the sql.UpdatableTable interface.
 func (u *updatableJoinTable) Updater(ctx *sql.Context) sql.RowUpdater {
 	updaters, _ := getUpdaters(u.updateTargets, ctx)
+	joinSchema := u.joinNode.Schema(ctx)
 	return &updatableJoinUpdater{
 		updaterMap: updaters,
-		schemaMap:  RecreateTableSchemaFromJoinSchema(u.joinNode.Schema(ctx)),
-		joinSchema: u.joinNode.Schema(ctx),
+		tableOrder: joinTableOrder(updaters, joinSchema),
+		schemaMap:  RecreateTableSchemaFromJoinSchema(joinSchema),
+		joinSchema: joinSchema,
 	}
 }
 
+// joinTableOrder returns the names of the tables of |updaters| in the order they appear in |joinSchema|, which is the
+// order MySQL updates the tables of a multi-table UPDATE in.
+func joinTableOrder(updaters map[string]sql.RowUpdater, joinSchema sql.Schema) []string {
+	order := make([]string, 0, len(updaters))
+	seen := make(map[string]struct{}, len(updaters))
+	for _, c := range joinSchema {
+		name := strings.ToLower(c.Source)
+		if _, ok := updaters[name]; !ok {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		order = append(order, name)
+	}
+	// updaters whose tables aren't in the join schema are updated last, in a stable order
+	var rest []string
+	for name := range updaters {
+		if _, ok := seen[name]; !ok {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(order, rest...)
+}
+
 // RecreateTableSchemaFromJoinSchema takes a join schema and recreates each individual tables schema.
 func RecreateTableSchemaFromJoinSchema(joinSchema sql.Schema) map[string]sql.Schema {
 	ret := make(map[string]sql.Schema, 0)
@@ -162,6 +192,8 @@ func RecreateTableSchemaFromJoinSchema(joinSchema sql.Schema) map[string]sql.Sch
 // table.
 type updatableJoinUpdater struct {
 	updaterMap map[string]sql.RowUpdater
+	// tableOrder is the order the tables of |updaterMap| are updated in
+	tableOrder []string
 	schemaMap  map[string]sql.Schema
 	joinSchema sql.Schema
 }
@@ -205,7 +237,8 @@ func (u *updatableJoinUpdater) Update(ctx *sql.Context, old sql.Row, new sql.Row
 	tableToOldRowMap := SplitRowIntoTableRowMap(old, u.joinSchema)
 	tableToNewRowMap := SplitRowIntoTableRowMap(new, u.joinSchema)
 
-	for tableName, updater := range u.updaterMap {
+	for _, tableName := range u.tableOrder {
+		updater := u.updaterMap[tableName]
 		oldRow := tableToOldRowMap[tableName]
 		newRow := tableToNewRowMap[tableName]
 		schema := u.schemaMap[tableName]
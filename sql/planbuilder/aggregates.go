@@ -36,6 +36,8 @@ type groupBy struct {
 	aggs     map[string]scopeColumn
 	grouping map[string]bool
 	inCols   []scopeColumn
+	// groupingSets are the combinations of grouping cols, by index, for ROLLUP and CUBE, or nil for a plain GROUP BY
+	groupingSets [][]int
 }
 
 func (g *groupBy) addInCol(c scopeColumn) {
@@ -89,77 +91,198 @@ func (b *Builder) needsAggregation(fromScope *scope, sel *ast.Select) bool {
 }
 
 func (b *Builder) buildGroupingCols(fromScope, projScope *scope, groupby ast.GroupBy, selects ast.SelectExprs) []sql.Expression {
-	// grouping col will either be:
-	// 1) alias into targets
-	// 2) a column reference
-	// 3) an index into selects
-	// 4) a simple non-aggregate expression
 	groupings := make([]sql.Expression, 0)
 	fromScope.initGroupBy()
 
 	g := fromScope.groupBy
+	// each grouping set is a combination of the grouping cols of the items of the GROUP BY. Plain expressions are in
+	// every set, while ROLLUP and CUBE multiply the sets of the other items by their own.
+	sets := [][]int{{}}
+	hasSets := false
+	addCol := func(e ast.Expr) int {
+		col := b.buildGroupingCol(fromScope, projScope, e, selects)
+		g.addInCol(col)
+		groupings = append(groupings, col.scalar)
+		return len(groupings) - 1
+	}
+	addCols := func(exprs ast.Exprs) []int {
+		idxs := make([]int, len(exprs))
+		for i, e := range exprs {
+			if _, ok := e.(*ast.GroupingSetsExpr); ok {
+				b.handleErr(sql.ErrUnsupportedSyntax.New(ast.String(e)))
+			}
+			idxs[i] = addCol(e)
+		}
+		return idxs
+	}
 	for _, e := range groupby {
-		var col scopeColumn
 		switch e := e.(type) {
-		case *ast.ColName:
-			var ok bool
-			// GROUP BY binds to column references before projections.
-			dbName := strings.ToLower(e.Qualifier.DbQualifier.String())
-			tblName := strings.ToLower(e.Qualifier.Name.String())
-			colName := strings.ToLower(e.Name.String())
-			col, ok = fromScope.resolveColumn(dbName, tblName, colName, true, false)
-			if !ok {
-				col, ok = projScope.resolveColumn(dbName, tblName, colName, true, true)
+		case *ast.GroupingSetsExpr:
+			hasSets = true
+			switch e.Type {
+			case ast.WithRollupStr:
+				sets = crossGroupingSets(sets, rollupGroupingSets(addCols(e.Exprs)))
+			case ast.CubeStr:
+				sets = crossGroupingSets(sets, cubeGroupingSets(addCols(e.Exprs)))
+			case ast.GroupingSetsStr:
+				sets = crossGroupingSets(sets, explicitGroupingSets(e.Sets, addCols))
 			}
-
-			if !ok {
-				b.handleErr(sql.ErrColumnNotFound.New(e.Name.String()))
-			}
-		case *ast.SQLVal:
-			// literal -> index into targets
-			v, ok := b.normalizeIntVal(e)
-			if !ok {
-				b.handleErr(fmt.Errorf("expected integer order by literal"))
-			}
-			idx, _, err := types.Int64.Convert(b.ctx, v)
-			if err != nil {
-				b.handleErr(err)
-			}
-			intIdx, ok := idx.(int64)
-			if !ok {
-				b.handleErr(fmt.Errorf("expected integer order by literal"))
-			}
-			if intIdx < 1 {
-				// TODO: this actually works in MySQL
-				b.handleErr(fmt.Errorf("expected positive integer order by literal"))
-			}
-			if int(intIdx) > len(selects) {
-				b.handleErr(fmt.Errorf("column ordinal out of range: %d", intIdx))
+			continue
+		case *ast.FuncExpr:
+			if isRollupFunc(e) {
+				hasSets = true
+				exprs := make(ast.Exprs, len(e.Exprs))
+				for i, arg := range e.Exprs {
+					aliased, ok := arg.(*ast.AliasedExpr)
+					if !ok {
+						b.handleErr(sql.ErrUnsupportedSyntax.New(ast.String(e)))
+					}
+					exprs[i] = aliased.Expr
+				}
+				sets = crossGroupingSets(sets, rollupGroupingSets(addCols(exprs)))
+				continue
 			}
-			col = projScope.cols[intIdx-1]
-		default:
-			expr := b.buildScalar(fromScope, e)
-			col = scopeColumn{
-				col:      expr.String(),
-				typ:      nil,
-				scalar:   expr,
-				nullable: expr.IsNullable(b.ctx),
+		}
+
+		idx := addCol(e)
+		for i := range sets {
+			sets[i] = append(sets[i], idx)
+		}
+	}
+	if hasSets {
+		g.groupingSets = sets
+	}
+
+	return groupings
+}
+
+// isRollupFunc returns whether |f| is the ROLLUP(...) item of a GROUP BY, rather than a function call.
+func isRollupFunc(f *ast.FuncExpr) bool {
+	return f.Name.Lowered() == "rollup" && f.Qualifier.IsEmpty() && len(f.Exprs) > 0
+}
+
+// rollupGroupingSets returns the grouping sets of a ROLLUP of the grouping cols at |idxs|: every prefix of |idxs|,
+// from longest to shortest.
+func rollupGroupingSets(idxs []int) [][]int {
+	sets := make([][]int, 0, len(idxs)+1)
+	for i := len(idxs); i >= 0; i-- {
+		sets = append(sets, idxs[:i:i])
+	}
+	return sets
+}
+
+// cubeGroupingSets returns the grouping sets of a CUBE of the grouping cols at |idxs|: every subset of |idxs|.
+func cubeGroupingSets(idxs []int) [][]int {
+	sets := make([][]int, 0, 1<<len(idxs))
+	for mask := (1 << len(idxs)) - 1; mask >= 0; mask-- {
+		set := make([]int, 0, len(idxs))
+		for i, idx := range idxs {
+			if mask&(1<<(len(idxs)-1-i)) != 0 {
+				set = append(set, idx)
 			}
 		}
-		if col.scalar == nil {
-			gf := expression.NewGetFieldWithTable(int(col.id), int(col.tableId), col.typ, col.db, col.table, col.col, col.nullable)
-			id, ok := fromScope.getExpr(gf.String(), true)
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// explicitGroupingSets returns the grouping sets of a GROUPING SETS(...) item. An expression that appears in several
+// of the |sets| is added as a grouping col only once, with |addCols|.
+func explicitGroupingSets(sets []ast.Exprs, addCols func(ast.Exprs) []int) [][]int {
+	seen := make(map[string]int)
+	ret := make([][]int, len(sets))
+	for i, set := range sets {
+		ret[i] = make([]int, 0, len(set))
+		for _, e := range set {
+			key := strings.ToLower(ast.String(e))
+			idx, ok := seen[key]
 			if !ok {
-				err := sql.ErrColumnNotFound.New(gf.String())
-				b.handleErr(err)
+				idx = addCols(ast.Exprs{e})[0]
+				seen[key] = idx
 			}
-			col.scalar = gf.WithIndex(int(id))
+			ret[i] = append(ret[i], idx)
 		}
-		g.addInCol(col)
-		groupings = append(groupings, col.scalar)
 	}
+	return ret
+}
 
-	return groupings
+// crossGroupingSets returns the union of each of the grouping sets |a| with each of |b|.
+func crossGroupingSets(a, b [][]int) [][]int {
+	ret := make([][]int, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			set := make([]int, 0, len(x)+len(y))
+			set = append(set, x...)
+			ret = append(ret, append(set, y...))
+		}
+	}
+	return ret
+}
+
+// buildGroupingCol builds the grouping col for the GROUP BY expression |e|.
+func (b *Builder) buildGroupingCol(fromScope, projScope *scope, e ast.Expr, selects ast.SelectExprs) scopeColumn {
+	// grouping col will either be:
+	// 1) alias into targets
+	// 2) a column reference
+	// 3) an index into selects
+	// 4) a simple non-aggregate expression
+	var col scopeColumn
+	switch e := e.(type) {
+	case *ast.ColName:
+		var ok bool
+		// GROUP BY binds to column references before projections.
+		dbName := strings.ToLower(e.Qualifier.DbQualifier.String())
+		tblName := strings.ToLower(e.Qualifier.Name.String())
+		colName := strings.ToLower(e.Name.String())
+		col, ok = fromScope.resolveColumn(dbName, tblName, colName, true, false)
+		if !ok {
+			col, ok = projScope.resolveColumn(dbName, tblName, colName, true, true)
+		}
+
+		if !ok {
+			b.handleErr(sql.ErrColumnNotFound.New(e.Name.String()))
+		}
+	case *ast.SQLVal:
+		// literal -> index into targets
+		v, ok := b.normalizeIntVal(e)
+		if !ok {
+			b.handleErr(fmt.Errorf("expected integer order by literal"))
+		}
+		idx, _, err := types.Int64.Convert(b.ctx, v)
+		if err != nil {
+			b.handleErr(err)
+		}
+		intIdx, ok := idx.(int64)
+		if !ok {
+			b.handleErr(fmt.Errorf("expected integer order by literal"))
+		}
+		if intIdx < 1 {
+			// TODO: this actually works in MySQL
+			b.handleErr(fmt.Errorf("expected positive integer order by literal"))
+		}
+		if int(intIdx) > len(selects) {
+			b.handleErr(fmt.Errorf("column ordinal out of range: %d", intIdx))
+		}
+		col = projScope.cols[intIdx-1]
+	default:
+		expr := b.buildScalar(fromScope, e)
+		col = scopeColumn{
+			col:      expr.String(),
+			typ:      nil,
+			scalar:   expr,
+			nullable: expr.IsNullable(b.ctx),
+		}
+	}
+	if col.scalar == nil {
+		gf := expression.NewGetFieldWithTable(int(col.id), int(col.tableId), col.typ, col.db, col.table, col.col, col.nullable)
+		id, ok := fromScope.getExpr(gf.String(), true)
+		if !ok {
+			err := sql.ErrColumnNotFound.New(gf.String())
+			b.handleErr(err)
+		}
+		col.scalar = gf.WithIndex(int(id))
+	}
+	return col
 }
 
 func (b *Builder) buildNameConst(fromScope *scope, f *ast.FuncExpr) sql.Expression {
@@ -261,7 +384,11 @@ func (b *Builder) buildAggregation(fromScope, projScope *scope, groupingCols []s
 			selectStr[e.String()] = true
 		}
 	}
+	b.validateGroupingFuncs(group, groupingCols)
 	gb := plan.NewGroupBy(selectDeps, groupingCols, fromScope.node)
+	if group.groupingSets != nil {
+		gb = gb.WithGroupingSets(group.groupingSets)
+	}
 	outScope.node = gb
 
 	if len(aliases) > 0 {
@@ -270,13 +397,32 @@ func (b *Builder) buildAggregation(fromScope, projScope *scope, groupingCols []s
 	return outScope
 }
 
+// validateGroupingFuncs checks that the arguments of every GROUPING function of |group| are among its |groupingCols|.
+func (b *Builder) validateGroupingFuncs(group *groupBy, groupingCols []sql.Expression) {
+	grouped := make(map[string]bool, len(groupingCols))
+	for _, e := range groupingCols {
+		grouped[strings.ToLower(e.String())] = true
+	}
+	for _, e := range group.aggregations() {
+		g, ok := e.scalar.(*aggregation.Grouping)
+		if !ok {
+			continue
+		}
+		for i, arg := range g.Args {
+			if !grouped[strings.ToLower(arg.String())] {
+				b.handleErr(sql.ErrFieldInGroupingNotGroupBy.New(i + 1))
+			}
+		}
+	}
+}
+
 // IsAggregateFunc is a hacky "extension point" to allow for other dialects to declare additional aggregate functions
 var IsAggregateFunc = IsMySQLAggregateFuncName
 
 func IsMySQLAggregateFuncName(name string) bool {
 	switch name {
 	case "avg", "bit_and", "bit_or", "bit_xor", "count",
-		"group_concat", "json_arrayagg", "json_objectagg",
+		"group_concat", "grouping", "json_arrayagg", "json_objectagg",
 		"max", "min", "std", "stddev_pop", "stddev_samp",
 		"stddev", "sum", "var_pop", "var_samp", "variance",
 		"first", "last", "any_value":
@@ -286,6 +432,17 @@ func IsMySQLAggregateFuncName(name string) bool {
 	}
 }
 
+// isUserDefinedAggregateFunc returns whether the function named is an aggregate function registered by an integrator
+// with the catalog's sql.UserDefinedFunctionProvider
+func (b *Builder) isUserDefinedAggregateFunc(name string) bool {
+	p, ok := b.cat.(sql.UserDefinedFunctionProvider)
+	if !ok {
+		return false
+	}
+	fn, ok := p.UserDefinedFunction(b.ctx, name)
+	return ok && fn.IsAggregate()
+}
+
 // buildAggregateFunc tags aggregate functions in the correct scope
 // and makes the aggregate available for reference by other clauses.
 func (b *Builder) buildAggregateFunc(inScope *scope, name string, e *ast.FuncExpr) sql.Expression {
@@ -522,7 +679,8 @@ func IsMySQLWindowFuncName(name string) bool {
 		"rank", "dense_rank",
 		"ntile",
 		"std", "stddev", "stddev_pop", "stddev_samp",
-		"variance", "var_pop", "var_samp":
+		"variance", "var_pop", "var_samp",
+		"bit_and", "bit_or", "bit_xor", "json_objectagg":
 		return true
 	default:
 		return false
@@ -657,26 +815,37 @@ func (b *Builder) buildNamedWindows(fromScope *scope, window ast.Window) {
 	// topo sort first
 	adj := make(map[string]*ast.WindowDef)
 	for _, w := range window {
-		adj[w.Name.Lowered()] = w
+		name := w.Name.Lowered()
+		if _, ok := adj[name]; ok {
+			b.handleErr(sql.ErrDuplicateWindowName.New(w.Name.String()))
+		}
+		adj[name] = w
 	}
 
 	var topo []*ast.WindowDef
 	var seen map[string]bool
+	sorted := make(map[string]bool)
 	var dfs func(string)
 	dfs = func(name string) {
 		if ok, _ := seen[name]; ok {
 			b.handleErr(sql.ErrCircularWindowInheritance.New())
 		}
 		seen[name] = true
-		cur := adj[name]
-		if ref := cur.NameRef.Lowered(); ref != "" {
+		cur, ok := adj[name]
+		if !ok {
+			b.handleErr(sql.ErrUnknownWindowName.New(name))
+		}
+		if ref := cur.NameRef.Lowered(); ref != "" && !sorted[ref] {
 			dfs(ref)
 		}
 		topo = append(topo, cur)
+		sorted[name] = true
 	}
-	for _, w := range adj {
-		seen = make(map[string]bool)
-		dfs(w.Name.Lowered())
+	for _, w := range window {
+		if !sorted[w.Name.Lowered()] {
+			seen = make(map[string]bool)
+			dfs(w.Name.Lowered())
+		}
 	}
 
 	fromScope.windowDefs = make(map[string]*sql.WindowDefinition)
@@ -714,7 +883,11 @@ func (b *Builder) buildWindowDef(fromScope *scope, def *ast.WindowDef) *sql.Wind
 	frame := b.NewFrame(fromScope, def.Frame)
 
 	windowDef := sql.NewWindowDefinition(partitions, sortConditions, frame, def.NameRef.Lowered(), def.Name.Lowered())
-	if ref, ok := fromScope.windowDefs[def.NameRef.Lowered()]; ok {
+	if windowDef.Ref != "" {
+		ref, ok := fromScope.windowDefs[windowDef.Ref]
+		if !ok {
+			b.handleErr(sql.ErrUnknownWindowName.New(def.NameRef.String()))
+		}
 		// this is only safe if windows are built in topo order
 		windowDef = b.mergeWindowDefs(windowDef, ref)
 		// collapse dependencies if any reference this window
@@ -740,11 +913,15 @@ func (b *Builder) mergeWindowDefs(def, ref *sql.WindowDefinition) *sql.WindowDef
 	if ref.Ref != "" {
 		panic("unreachable; cannot merge unresolved window definition")
 	}
+	defName := def.Name
+	if defName == "" {
+		defName = "<unnamed window>"
+	}
 
 	var orderBy sql.SortConditions
 	switch {
 	case len(def.OrderBy) > 0 && len(ref.OrderBy) > 0:
-		err := sql.ErrInvalidWindowInheritance.New("", "", "both contain order by clause")
+		err := sql.ErrInvalidWindowInheritance.New(defName, ref.Name, "both contain order by clause")
 		b.handleErr(err)
 	case len(def.OrderBy) > 0:
 		orderBy = def.OrderBy
@@ -756,7 +933,7 @@ func (b *Builder) mergeWindowDefs(def, ref *sql.WindowDefinition) *sql.WindowDef
 	var partitionBy []sql.Expression
 	switch {
 	case len(def.PartitionBy) > 0 && len(ref.PartitionBy) > 0:
-		err := sql.ErrInvalidWindowInheritance.New("", "", "both contain partition by clause")
+		err := sql.ErrInvalidWindowInheritance.New(defName, ref.Name, "both contain partition by clause")
 		b.handleErr(err)
 	case len(def.PartitionBy) > 0:
 		partitionBy = def.PartitionBy
@@ -783,7 +960,7 @@ func (b *Builder) mergeWindowDefs(def, ref *sql.WindowDefinition) *sql.WindowDef
 			df := def.Frame.String()
 			rf := ref.Frame.String()
 			if df != rf {
-				err := sql.ErrInvalidWindowInheritance.New("", "", "both contain different frame clauses")
+				err := sql.ErrInvalidWindowInheritance.New(defName, ref.Name, "both contain different frame clauses")
 				b.handleErr(err)
 			}
 			frame = def.Frame
@@ -817,7 +994,7 @@ func (b *Builder) analyzeHaving(fromScope, projScope *scope, having *ast.Where)
 			return false, nil
 		case *ast.FuncExpr:
 			name := n.Name.Lowered()
-			if IsAggregateFunc(name) {
+			if (IsAggregateFunc(name) || b.isUserDefinedAggregateFunc(name)) && n.Over == nil {
 				// record aggregate
 				// TODO: this should get projScope as well
 				_ = b.buildAggregateFunc(fromScope, name, n)
@@ -0,0 +1,165 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// alterTableAlgorithm returns the algorithm and lock requested by the ALGORITHM and LOCK clauses of the ALTER TABLE
+// statement |query|. The parser accepts these clauses but doesn't keep them, so they're scanned from the query.
+func alterTableAlgorithm(query string, options ast.ParserOptions) (sql.AlterAlgorithm, sql.AlterLock) {
+	tokenizer := ast.NewStringTokenizer(query)
+	if options.AnsiQuotes {
+		tokenizer = ast.NewStringTokenizerForAnsiQuotes(query)
+	}
+	var tokens []int
+	for {
+		typ, _ := tokenizer.Scan()
+		if typ == 0 || typ == ast.LEX_ERROR {
+			break
+		}
+		tokens = append(tokens, typ)
+	}
+
+	algorithm, lock := sql.AlterAlgorithmDefault, sql.AlterLockDefault
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i] != ast.ALGORITHM && tokens[i] != ast.LOCK {
+			continue
+		}
+		j := i + 1
+		if j < len(tokens) && tokens[j] == '=' {
+			j++
+		}
+		if j >= len(tokens) {
+			break
+		}
+		switch tokens[j] {
+		case ast.INSTANT:
+			algorithm = sql.AlterAlgorithmInstant
+		case ast.INPLACE:
+			algorithm = sql.AlterAlgorithmInplace
+		case ast.COPY:
+			algorithm = sql.AlterAlgorithmCopy
+		case ast.NONE:
+			lock = sql.AlterLockNone
+		case ast.SHARED:
+			lock = sql.AlterLockShared
+		case ast.EXCLUSIVE:
+			lock = sql.AlterLockExclusive
+		case ast.DEFAULT:
+			if tokens[i] == ast.ALGORITHM {
+				algorithm = sql.AlterAlgorithmDefault
+			} else {
+				lock = sql.AlterLockDefault
+			}
+		}
+	}
+	return algorithm, lock
+}
+
+// validateAlterAlgorithm returns an error if the table altered by |c| can't apply |statements|, the nodes built for
+// the clauses of |c|, with the algorithm and lock requested by |query|.
+func (b *Builder) validateAlterAlgorithm(inScope *scope, query string, c *ast.AlterTable, statements []sql.Node) {
+	algorithm, lock := alterTableAlgorithm(query, b.parserOpts)
+	if algorithm == sql.AlterAlgorithmDefault && lock == sql.AlterLockDefault {
+		return
+	}
+
+	required, requiredLock := sql.AlterAlgorithmDefault, sql.AlterLockDefault
+	tableScope, ok := b.buildResolvedTableForTablename(inScope, c.Table, nil)
+	if rt, isRt := tableScope.node.(*plan.ResolvedTable); ok && isRt {
+		if aat, ok := rt.UnderlyingTable().(sql.AlterAlgorithmTable); ok {
+			sch := rt.Schema(b.ctx)
+			for _, n := range statements {
+				alg, l := aat.AlterAlgorithm(b.ctx, b.alterOperation(n, sch))
+				required, requiredLock = max(required, alg), max(requiredLock, l)
+			}
+		}
+	}
+
+	if err := sql.ValidateAlterAlgorithm(algorithm, lock, required, requiredLock); err != nil {
+		b.handleErr(err)
+	}
+}
+
+// alterOperation describes |n|, a node built for a clause of an ALTER TABLE statement on a table with the schema
+// |sch|, to an sql.AlterAlgorithmTable.
+func (b *Builder) alterOperation(n sql.Node, sch sql.Schema) sql.AlterOperation {
+	column := func(name string) *sql.Column {
+		if idx := sch.IndexOfColName(name); idx >= 0 {
+			return sch[idx]
+		}
+		return nil
+	}
+
+	switch n := n.(type) {
+	case *plan.AddColumn:
+		return sql.AlterOperation{Kind: sql.AlterOperationAddColumn, NewColumn: n.Column(), Order: n.Order(b.ctx)}
+	case *plan.DropColumn:
+		return sql.AlterOperation{Kind: sql.AlterOperationDropColumn, OldColumn: column(n.Column)}
+	case *plan.RenameColumn:
+		op := sql.AlterOperation{Kind: sql.AlterOperationRenameColumn, OldColumn: column(n.ColumnName)}
+		if op.OldColumn != nil {
+			op.NewColumn = op.OldColumn.Copy()
+			op.NewColumn.Name = n.NewColumnName
+		}
+		return op
+	case *plan.ModifyColumn:
+		return sql.AlterOperation{Kind: sql.AlterOperationModifyColumn, OldColumn: column(n.Column()), NewColumn: n.NewColumn(), Order: n.Order(b.ctx)}
+	case *plan.AlterDefaultSet:
+		return sql.AlterOperation{Kind: sql.AlterOperationSetColumnDefault, OldColumn: column(n.ColumnName)}
+	case *plan.AlterDefaultDrop:
+		return sql.AlterOperation{Kind: sql.AlterOperationSetColumnDefault, OldColumn: column(n.ColumnName)}
+	case *plan.AlterIndex:
+		switch n.Action {
+		case plan.IndexAction_Create:
+			return sql.AlterOperation{Kind: sql.AlterOperationAddIndex, IndexConstraint: n.Constraint}
+		case plan.IndexAction_Drop:
+			return sql.AlterOperation{Kind: sql.AlterOperationDropIndex}
+		case plan.IndexAction_Rename:
+			return sql.AlterOperation{Kind: sql.AlterOperationRenameIndex}
+		}
+	case *plan.AlterPK:
+		if n.Action == plan.PrimaryKeyAction_Create {
+			return sql.AlterOperation{Kind: sql.AlterOperationAddPrimaryKey}
+		}
+		return sql.AlterOperation{Kind: sql.AlterOperationDropPrimaryKey}
+	case *plan.CreateForeignKey:
+		fkChecks, err := b.ctx.GetSessionVariable(b.ctx, "foreign_key_checks")
+		if err != nil {
+			b.handleErr(err)
+		}
+		return sql.AlterOperation{Kind: sql.AlterOperationAddForeignKey, Validates: fkChecks != int8(0)}
+	case *plan.DropForeignKey:
+		return sql.AlterOperation{Kind: sql.AlterOperationDropForeignKey}
+	case *plan.CreateCheck:
+		return sql.AlterOperation{Kind: sql.AlterOperationAddCheck, Validates: n.Check.Enforced && !n.Check.IsNotValid}
+	case *plan.DropCheck:
+		return sql.AlterOperation{Kind: sql.AlterOperationDropCheck}
+	case *plan.AlterAutoIncrement:
+		return sql.AlterOperation{Kind: sql.AlterOperationAutoIncrement}
+	case *plan.AlterTableCollation:
+		return sql.AlterOperation{Kind: sql.AlterOperationCollation}
+	case *plan.AlterTableComment:
+		return sql.AlterOperation{Kind: sql.AlterOperationComment}
+	case *plan.RenameTable:
+		return sql.AlterOperation{Kind: sql.AlterOperationRenameTable}
+	}
+	return sql.AlterOperation{Kind: sql.AlterOperationOther}
+}
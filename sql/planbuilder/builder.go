@@ -52,8 +52,14 @@ type Builder struct {
 	authEnabled  bool
 	multiDDL     bool
 	insertActive bool
-	parserOpts   ast.ParserOptions
-	overrides    sql.BuilderOverrides
+	// alterAddedColumns are the columns added by the clauses of the ALTER TABLE being built so far
+	alterAddedColumns []*sql.Column
+	parserOpts        ast.ParserOptions
+	overrides         sql.BuilderOverrides
+	// restrictedTables are the tables whose policies are being applied, by lowercase database and table name
+	restrictedTables map[string]struct{}
+	// tablesToChange are the tables of the UPDATE or DELETE being built that are restricted by restrictTablesToChange
+	tablesToChange map[*ast.AliasedTableExpr]struct{}
 }
 
 // BindvarContext holds bind variable replacement literals.
@@ -199,10 +205,13 @@ func (b *Builder) Reset() {
 	b.currentDatabase = nil
 	b.procCtx = nil
 	b.multiDDL = false
+	b.alterAddedColumns = nil
 	b.insertActive = false
 	b.triggerCtx = nil
 	b.viewCtx = nil
 	b.nesting = 0
+	b.restrictedTables = nil
+	b.tablesToChange = nil
 	b.qFlags = &sql.QueryFlags{}
 	b.authQueryState = b.cat.AuthorizationHandler().NewQueryState(b.ctx)
 }
@@ -363,10 +372,14 @@ func (b *Builder) buildSubquery(inScope *scope, stmt ast.Statement, subQuery str
 		return b.buildKill(inScope, n)
 	case *ast.Signal:
 		return b.buildSignal(inScope, n)
+	case *ast.GetDiagnostics:
+		return b.buildGetDiagnostics(inScope, n)
 	case *ast.LockTables:
 		return b.buildLockTables(inScope, n)
 	case *ast.UnlockTables:
 		return b.buildUnlockTables(inScope, n)
+	case *ast.Handler:
+		return b.buildHandler(inScope, n)
 	case *ast.CreateUser:
 		return b.buildCreateUser(inScope, n)
 	case *ast.RenameUser:
@@ -377,6 +390,12 @@ func (b *Builder) buildSubquery(inScope *scope, stmt ast.Statement, subQuery str
 		return b.buildCreateRole(inScope, n)
 	case *ast.DropRole:
 		return b.buildDropRole(inScope, n)
+	case *ast.SetRole:
+		return b.buildSetRole(inScope, n)
+	case *ast.SetDefaultRole:
+		return b.buildSetDefaultRole(inScope, n)
+	case *ast.SetPassword:
+		return b.buildSetPassword(inScope, n)
 	case *ast.GrantPrivilege:
 		return b.buildGrantPrivilege(inScope, n)
 	case *ast.GrantRole:
@@ -0,0 +1,148 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RenameColumnReferences returns |createStatement|, a CREATE VIEW, CREATE TRIGGER or CREATE PROCEDURE statement in the
+// database |dbName|, with its references to the column |oldName| of |table| renamed to |newName|, along with its body:
+// the SELECT statement of a view, or the statement of a trigger or procedure. It returns empty strings if the statement
+// has no such references. References are found as they are by RoutineDependencies, except that a column that isn't
+// qualified is also taken to be a column of |table| in a statement that refers to several tables, if |table| is one of
+// them. A view selecting a renamed column without an alias selects it with its old name as the alias, so the columns of
+// the view keep their names. The statements returned are formatted by the parser rather than kept as written.
+func RenameColumnReferences(ctx *sql.Context, createStatement string, opts ast.ParserOptions, dbName, table, oldName, newName string) (string, string, error) {
+	stmt, err := ast.ParseWithOptions(ctx, createStatement, opts)
+	if err != nil {
+		return "", "", err
+	}
+	ddl, ok := stmt.(*ast.DDL)
+	if !ok {
+		return "", "", nil
+	}
+
+	d := &routineDeps{
+		locals:   make(map[string]struct{}),
+		excluded: make(map[string]struct{}),
+		indexes:  make(map[[2]string]int),
+		rename: &columnRename{
+			database: dbName,
+			table:    table,
+			oldName:  oldName,
+			newName:  newName,
+			renamed:  make(map[*ast.ColIdent]ast.ColIdent),
+		},
+	}
+	var body ast.SQLNode
+	switch {
+	case ddl.ViewSpec != nil:
+		body = ddl.ViewSpec.ViewExpr
+	case ddl.TriggerSpec != nil:
+		body = ddl.TriggerSpec.Body
+		d.triggerTable = ddl.Table
+	case ddl.ProcedureSpec != nil:
+		body = ddl.ProcedureSpec.Body
+		for _, param := range ddl.ProcedureSpec.Params {
+			d.locals[strings.ToLower(param.Name)] = struct{}{}
+		}
+	}
+	if body == nil {
+		return "", "", nil
+	}
+
+	if err := ast.Walk(d.collectNames, body); err != nil {
+		return "", "", err
+	}
+	if err := ast.Walk(d.visit, body); err != nil {
+		return "", "", err
+	}
+	if len(d.rename.renamed) == 0 {
+		return "", "", nil
+	}
+
+	if ddl.ViewSpec != nil && len(ddl.ViewSpec.Columns) == 0 {
+		// the names of the columns of a view are those of the first SELECT of a UNION
+		sel := ddl.ViewSpec.ViewExpr
+		for union, ok := sel.(*ast.SetOp); ok; union, ok = sel.(*ast.SetOp) {
+			sel = union.Left
+		}
+		if sel, ok := sel.(*ast.Select); ok {
+			for _, expr := range sel.SelectExprs {
+				aliased, ok := expr.(*ast.AliasedExpr)
+				if !ok || !aliased.As.IsEmpty() {
+					continue
+				}
+				if col, ok := aliased.Expr.(*ast.ColName); ok {
+					if name, ok := d.rename.renamed[&col.Name]; ok {
+						aliased.As = name
+					}
+				}
+			}
+		}
+	}
+	return ast.String(ddl), ast.String(body), nil
+}
+
+// RenameColumnInCheck returns |checkExpression|, the expression of a check constraint, with its references to the column
+// |oldName| renamed to |newName|, quoted with |formatter|, or an empty string if it has none. A check constraint only
+// refers to the columns of its table, so every identifier that isn't the name of a function is a column.
+func RenameColumnInCheck(checkExpression string, formatter sql.SchemaFormatter, oldName, newName string) string {
+	tokens := scanQueryTokens(checkExpression, ast.ParserOptions{})
+	var sb strings.Builder
+	prevEnd := 0
+	for i, token := range tokens {
+		if token.typ != ast.ID || !strings.EqualFold(token.val, oldName) || (i+1 < len(tokens) && tokens[i+1].typ == '(') {
+			continue
+		}
+		sb.WriteString(checkExpression[prevEnd:token.start])
+		sb.WriteString(formatter.QuoteIdentifier(newName))
+		prevEnd = token.end
+	}
+	if prevEnd == 0 {
+		return ""
+	}
+	sb.WriteString(checkExpression[prevEnd:])
+	return sb.String()
+}
+
+// columnRename is a column whose references in a parsed statement are renamed by RenameColumnReferences.
+type columnRename struct {
+	// database is the database of the statement, which is the database of its tables that aren't qualified
+	database string
+	table    string
+	oldName  string
+	newName  string
+	// renamed are the column references that were renamed, with their names as written
+	renamed map[*ast.ColIdent]ast.ColIdent
+}
+
+// isTable returns whether |dep| is the table of the renamed column.
+func (r *columnRename) isTable(dep RoutineDependency) bool {
+	return (dep.Database == "" || strings.EqualFold(dep.Database, r.database)) && strings.EqualFold(dep.Table, r.table)
+}
+
+// apply renames |col| if it's the renamed column.
+func (r *columnRename) apply(col *ast.ColIdent) {
+	if col.EqualString(r.oldName) {
+		r.renamed[col] = *col
+		*col = ast.NewColIdent(r.newName)
+	}
+}
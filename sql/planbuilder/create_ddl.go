@@ -61,6 +61,9 @@ func (b *Builder) buildCreateTrigger(inScope *scope, subQuery string, fullQuery
 	}
 
 	triggerCtx := b.TriggerCtx()
+	if !triggerCtx.LoadOnly && !triggerCtx.Call {
+		b.authorizeDefiner(c.TriggerSpec.Definer)
+	}
 
 	if _, ok := tableScope.node.(*plan.ResolvedTable); !ok {
 		// Old versions of GMS/Dolt permitted creating an invalid trigger on VIEW
@@ -140,6 +143,17 @@ func getCurrentUserForDefiner(ctx *sql.Context, definer string) string {
 	return definer
 }
 
+// withViewDefiner adds a DEFINER clause naming |definer| to |createView|, a CREATE VIEW statement without one, so that
+// the view's definer is stored with its definition.
+func (b *Builder) withViewDefiner(createView, definer string) string {
+	for _, token := range scanQueryTokens(createView, b.parserOpts) {
+		if token.typ == ast.SQL || token.typ == ast.VIEW {
+			return createView[:token.start] + "DEFINER = " + definer + " " + createView[token.start:]
+		}
+	}
+	return createView
+}
+
 func (b *Builder) buildProcedureParams(procParams []ast.ProcedureParam) []plan.ProcedureParam {
 	var params []plan.ProcedureParam
 	for _, param := range procParams {
@@ -213,6 +227,7 @@ func (b *Builder) buildCreateProcedure(inScope *scope, subQuery string, fullQuer
 	}
 
 	b.validateCreateProcedure(inScope, subQuery)
+	b.authorizeDefiner(c.ProcedureSpec.Definer)
 
 	var db sql.Database = nil
 	if dbName := c.ProcedureSpec.ProcName.Qualifier.String(); dbName != "" {
@@ -228,6 +243,7 @@ func (b *Builder) buildCreateProcedure(inScope *scope, subQuery string, fullQuer
 		CreatedAt:       now,
 		ModifiedAt:      now,
 		SqlMode:         sql.LoadSqlMode(b.ctx).String(),
+		Definer:         getCurrentUserForDefiner(b.ctx, c.ProcedureSpec.Definer),
 	}
 
 	bodyStr := strings.TrimSpace(fullQuery[c.SubStatementPositionStart:c.SubStatementPositionEnd])
@@ -263,12 +279,16 @@ func (b *Builder) validateStatement(inScope *scope, stmt ast.Statement) {
 			if s.TriggerSpec != nil {
 				b.handleErr(fmt.Errorf("can't create a TRIGGER from within another stored routine"))
 			}
+			if s.FunctionSpec != nil {
+				b.handleErr(fmt.Errorf("can't create a FUNCTION from within another stored routine"))
+			}
 		}
 	case *ast.DBDDL:
 		b.handleErr(fmt.Errorf("DBDDL in CREATE PROCEDURE not yet supported"))
 	case *ast.Declare:
 		if s.Condition != nil {
 			dc := s.Condition
+			var errCode int64
 			if dc.SqlStateValue != "" {
 				if len(dc.SqlStateValue) != 5 {
 					err := fmt.Errorf("SQLSTATE VALUE must be a string with length 5 consisting of only integers")
@@ -285,11 +305,9 @@ func (b *Builder) validateStatement(inScope *scope, stmt ast.Statement) {
 					err := fmt.Errorf("invalid value '%s' for MySQL error code", string(dc.MysqlErrorCode.Val))
 					b.handleErr(err)
 				}
-				//TODO: implement MySQL error code support
-				err = sql.ErrUnsupportedSyntax.New(ast.String(s))
-				b.handleErr(err)
+				errCode = int64(number)
 			}
-			inScope.proc.AddCondition(plan.NewDeclareCondition(dc.Name, 0, ""))
+			inScope.proc.AddCondition(plan.NewDeclareCondition(dc.Name, errCode, dc.SqlStateValue))
 		} else if s.Variables != nil {
 			typ, err := types.ColumnTypeToType(&s.Variables.VarType)
 			if err != nil {
@@ -304,14 +322,25 @@ func (b *Builder) validateStatement(inScope *scope, stmt ast.Statement) {
 		} else if s.Cursor != nil {
 			inScope.proc.AddCursor(s.Cursor.Name)
 		} else if s.Handler != nil {
-			switch s.Handler.ConditionValues[0].ValueType {
-			case ast.DeclareHandlerCondition_NotFound:
-			case ast.DeclareHandlerCondition_SqlException:
-			default:
-				err := sql.ErrUnsupportedSyntax.New(ast.String(s))
-				b.handleErr(err)
+			for _, cond := range s.Handler.ConditionValues {
+				switch cond.ValueType {
+				case ast.DeclareHandlerCondition_SqlState:
+					if len(cond.String) != 5 || cond.String[0:2] == "00" {
+						b.handleErr(fmt.Errorf("invalid SQLSTATE VALUE: '%s'", cond.String))
+					}
+				case ast.DeclareHandlerCondition_MysqlErrorCode:
+					number, err := strconv.ParseUint(string(cond.MysqlErrorCode.Val), 10, 64)
+					if err != nil || number == 0 {
+						b.handleErr(fmt.Errorf("invalid value '%s' for MySQL error code", string(cond.MysqlErrorCode.Val)))
+					}
+				case ast.DeclareHandlerCondition_ConditionName:
+					if inScope.proc.GetCondition(cond.String) == nil {
+						b.handleErr(sql.ErrDeclareConditionNotFound.New(cond.String))
+					}
+				}
 			}
 			inScope.proc.AddHandler(nil)
+			b.validateStatement(inScope, s.Handler.Statement)
 		}
 	case *ast.BeginEndBlock:
 		blockScope := inScope.push()
@@ -346,14 +375,9 @@ func (b *Builder) validateStatement(inScope *scope, stmt ast.Statement) {
 			b.handleErr(err)
 		}
 	case *ast.Signal:
-		if s.ConditionName != "" {
-			signalName := strings.ToLower(s.ConditionName)
-			condition := inScope.proc.GetCondition(signalName)
-			if condition == nil {
-				err := sql.ErrDeclareConditionNotFound.New(signalName)
-				b.handleErr(err)
-			}
-		}
+		b.validateSignalCondition(inScope, s.ConditionName)
+	case *ast.Resignal:
+		b.validateSignalCondition(inScope, s.ConditionName)
 	case *ast.FetchCursor:
 		if !inScope.proc.HasCursor(s.Name) {
 			b.handleErr(sql.ErrCursorNotFound.New(s.Name))
@@ -385,6 +409,22 @@ func (b *Builder) validateStatement(inScope *scope, stmt ast.Statement) {
 	}
 }
 
+// validateSignalCondition validates the condition named by a SIGNAL or RESIGNAL statement, if it names one.
+func (b *Builder) validateSignalCondition(inScope *scope, conditionName string) {
+	if conditionName == "" {
+		return
+	}
+	signalName := strings.ToLower(conditionName)
+	condition := inScope.proc.GetCondition(signalName)
+	if condition == nil {
+		err := sql.ErrDeclareConditionNotFound.New(signalName)
+		b.handleErr(err)
+	}
+	if condition.SqlStateValue == "" {
+		b.handleErr(sql.ErrSignalOnlySqlState.New())
+	}
+}
+
 func (b *Builder) validateCreateProcedure(inScope *scope, createStmt string) {
 	stmt, _, _, _ := b.parser.ParseWithOptions(b.ctx, createStmt, ';', false, b.parserOpts)
 	procStmt := stmt.(*ast.DDL)
@@ -407,6 +447,11 @@ func (b *Builder) validateCreateProcedure(inScope *scope, createStmt string) {
 
 	bodyStmt := procStmt.ProcedureSpec.Body
 	b.validateStatement(inScope, bodyStmt)
+	walkRoutineStatements(bodyStmt, func(stmt ast.Statement) {
+		if _, ok := stmt.(*ast.Return); ok {
+			b.handleErr(sql.ErrReturnOutsideFunction.New())
+		}
+	})
 
 	// TODO: check for limit clauses that are not integers
 }
@@ -427,6 +472,7 @@ func (b *Builder) buildCreateEvent(inScope *scope, subQuery string, fullQuery st
 		dbName = b.ctx.GetCurrentDatabase()
 	}
 	database := b.resolveDb(dbName)
+	b.authorizeDefiner(c.EventSpec.Definer)
 	definer := getCurrentUserForDefiner(b.ctx, c.EventSpec.Definer)
 
 	// both 'undefined' and 'not preserve' are considered 'not preserve'
@@ -502,16 +548,21 @@ func (b *Builder) buildAlterUser(inScope *scope, _ string, c *ast.DDL) (outScope
 	accountWithAuth := ast.AccountWithAuth{AccountName: c.User, Auth1: c.Authentication}
 	user := b.buildAuthenticatedUser(accountWithAuth)
 
-	if c.Authentication.RandomPassword {
+	if c.Authentication != nil && c.Authentication.RandomPassword {
 		b.handleErr(fmt.Errorf("random password generation is not currently supported; " +
 			"you can request support at https://github.com/dolthub/dolt/issues/new"))
 	}
 
+	passwordOptions, locked := b.buildPasswordOptions(c.PassLockItems)
+
 	outScope = inScope.push()
 	outScope.node = &plan.AlterUser{
-		IfExists: c.IfExists,
-		User:     user,
-		MySQLDb:  database,
+		IfExists:        c.IfExists,
+		User:            user,
+		AccountLimits:   b.buildAccountLimits(c.AccountLimits),
+		PasswordOptions: passwordOptions,
+		Locked:          locked,
+		MySQLDb:         database,
 	}
 	return outScope
 }
@@ -526,6 +577,7 @@ func (b *Builder) buildAlterEvent(inScope *scope, subQuery string, fullQuery str
 		database = b.currentDb()
 	}
 
+	b.authorizeDefiner(c.EventSpec.Definer)
 	definer := getCurrentUserForDefiner(b.ctx, c.EventSpec.Definer)
 
 	var (
@@ -666,6 +718,13 @@ func (b *Builder) buildCreateView(inScope *scope, subQuery string, fullQuery str
 	b.qFlags.Set(sql.QFlagRelSubquery)
 
 	definer := getCurrentUserForDefiner(b.ctx, c.ViewSpec.Definer)
+	if b.ViewCtx().DbName == "" {
+		// the definitions of existing views are bound with their definer's privileges, so only new views are checked
+		b.authorizeDefiner(c.ViewSpec.Definer)
+	}
+	if c.ViewSpec.Definer == "" {
+		subQuery = b.withViewDefiner(subQuery, definer)
+	}
 
 	if c.ViewSpec.CheckOption == ast.ViewCheckOptionLocal {
 		err := sql.ErrUnsupportedSyntax.New("WITH LOCAL CHECK OPTION")
@@ -16,16 +16,25 @@ package planbuilder
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 )
 
 func (b *Builder) buildWith(inScope *scope, with *ast.With) (outScope *scope) {
+	return b.buildWithCachedCtes(inScope, with, nil)
+}
+
+// buildWithCachedCtes builds the common table expressions in |with|. Those named in |cached| share their results with
+// later statements in the session that define the same expression, see plan.SharedResult.
+func (b *Builder) buildWithCachedCtes(inScope *scope, with *ast.With, cached map[string]struct{}) (outScope *scope) {
 	// resolveCommonTableExpressions operates on With nodes. It replaces any matching UnresolvedTable references in the
 	// tree with the subqueries defined in the CTEs.
 
@@ -44,12 +53,17 @@ func (b *Builder) buildWith(inScope *scope, with *ast.With) (outScope *scope) {
 
 	for _, cte := range with.Ctes {
 		ate := cte.AliasedTableExpr
+		cteName := strings.ToLower(ate.As.String())
+		if _, ok := ate.Expr.(*ast.ValuesStatement); ok {
+			// a VALUES statement can't refer to any table, so it's never recursive
+			inScope.addCte(cteName, b.buildCte(outScope, ate, cteName, columnsToStrings(cte.Columns)))
+			continue
+		}
 		sq, ok := ate.Expr.(*ast.Subquery)
 		if !ok {
 			b.handleErr(sql.ErrUnsupportedFeature.New(fmt.Sprintf("Unsupported type of common table expression %T", ate.Expr)))
 		}
 
-		cteName := strings.ToLower(ate.As.String())
 		var cteScope *scope
 		if with.Recursive {
 			switch n := sq.Select.(type) {
@@ -68,6 +82,9 @@ func (b *Builder) buildWith(inScope *scope, with *ast.With) (outScope *scope) {
 			}
 		} else {
 			cteScope = b.buildCte(outScope, ate, cteName, columnsToStrings(cte.Columns))
+			if _, ok := cached[cteName]; ok {
+				b.shareCteResult(cteScope, cteName)
+			}
 		}
 		inScope.addCte(cteName, cteScope)
 	}
@@ -80,6 +97,10 @@ func (b *Builder) buildCte(inScope *scope, e ast.TableExpr, name string, columns
 	switch n := cteScope.node.(type) {
 	case *plan.SubqueryAlias:
 		cteScope.node = n.WithColumnNames(columns)
+	case *plan.ValueDerivedTable:
+		if len(columns) > 0 {
+			cteScope.node = n.WithColumNames(columns)
+		}
 	}
 	return cteScope
 }
@@ -171,6 +192,7 @@ func (b *Builder) buildRecursiveCte(inScope *scope, union *ast.SetOp, name strin
 		distinct = false
 	}
 	limit := b.buildLimit(inScope, union.Limit)
+	offset := b.buildOffset(inScope, union.Limit)
 
 	orderByScope := b.analyzeOrderBy(cteScope, leftScope, union.OrderBy)
 	sortConditions := b.buildSortConditions(orderByScope, transform.SameTree)
@@ -180,7 +202,7 @@ func (b *Builder) buildRecursiveCte(inScope *scope, union *ast.SetOp, name strin
 
 	b.qFlags.Set(sql.QFlagRelSubquery)
 	cteScope.node = plan.NewSubqueryAlias(name, "",
-		plan.NewRecursiveCte(rInit, rightScope.node, name, columns, distinct, limit, sortConditions).
+		plan.NewRecursiveCte(rInit, rightScope.node, name, columns, distinct, limit, offset, sortConditions).
 			WithSchema(recSch).WithWorking(rTable).WithId(tableId).WithColumns(cols)).
 		WithColumnNames(columns).WithCorrelated(corr).WithVolatile(vol).WithScopeMapping(scopeMapping).
 		WithId(tableId).WithColumns(cols)
@@ -243,3 +265,120 @@ func hasRecursiveTable(name string, s ast.SelectStatement) bool {
 	}, s)
 	return found
 }
+
+// cachedHintRegex matches the CACHED(cte [, cte]...) optimizer hint.
+var cachedHintRegex = regexp.MustCompile(`(?i)\bcached\s*\(([^)]*)\)`)
+
+// cachedCteHints returns the names of the common table expressions named by CACHED hints in |comments|.
+func cachedCteHints(comments ast.Comments) map[string]struct{} {
+	var names map[string]struct{}
+	for _, c := range comments {
+		comment := string(c)
+		if !strings.HasPrefix(comment, "/*+") {
+			continue
+		}
+		for _, m := range cachedHintRegex.FindAllStringSubmatch(comment, -1) {
+			for _, name := range strings.Split(m[1], ",") {
+				name = strings.ToLower(strings.Trim(strings.TrimSpace(name), "`"))
+				if name == "" {
+					continue
+				}
+				if names == nil {
+					names = make(map[string]struct{})
+				}
+				names[name] = struct{}{}
+			}
+		}
+	}
+	return names
+}
+
+// shareCteResult wraps the body of the common table expression |name| in a *plan.SharedResult, if its result is
+// fully determined by the tables it reads. Expressions that are correlated, non-deterministic, or that read variables,
+// parameters, session state or system tables are left as they are, with a warning.
+func (b *Builder) shareCteResult(cteScope *scope, name string) {
+	sqa, ok := cteScope.node.(*plan.SubqueryAlias)
+	if !ok {
+		return
+	}
+	tables, ok := sharedResultTables(b.ctx, sqa.Child)
+	if !ok || sqa.Volatile || !sqa.Correlated.Empty() {
+		b.ctx.Warn(0, "CACHED hint ignored: the result of common table expression '%s' cannot be shared", name)
+		return
+	}
+
+	keys := make([]string, 0, len(tables))
+	for k := range tables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var key strings.Builder
+	fmt.Fprintf(&key, "%s\n", strings.ToLower(b.ctx.GetCurrentDatabase()))
+	for _, k := range keys {
+		fmt.Fprintf(&key, "%s%s\n", k, tables[k])
+	}
+	key.WriteString(sqa.Child.String())
+
+	cteScope.node = sqa.WithChild(plan.NewSharedResult(name, key.String(), keys, sqa.Child))
+}
+
+// sharedResultTables returns the tables read by |n|, as keys returned by sql.SharedResultTableKey mapped to a
+// description of the version of the table read, or false if the result of |n| depends on anything other than the
+// contents of those tables.
+func sharedResultTables(ctx *sql.Context, n sql.Node) (map[string]string, bool) {
+	tables := make(map[string]string)
+	ok := true
+	var inspectExprs func(e sql.Expression) bool
+	var inspectNode func(ctx *sql.Context, n sql.Node) bool
+	inspectExprs = func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.UserVar, *expression.SystemVar, *expression.ProcedureParam, *expression.BindVar:
+			ok = false
+		case *plan.Subquery:
+			transform.InspectWithOpaque(ctx, e.Query, inspectNode)
+		case sql.SessionDependentExpression:
+			if e.IsSessionDependent() {
+				ok = false
+			}
+		}
+		return ok
+	}
+	inspectNode = func(ctx *sql.Context, n sql.Node) bool {
+		if !ok {
+			return false
+		}
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			db := ""
+			if n.SqlDatabase != nil {
+				db = n.SqlDatabase.Name()
+			}
+			switch strings.ToLower(db) {
+			case sql.InformationSchemaDatabaseName, "performance_schema", "mysql", "sys":
+				// system tables change without being written to
+				ok = false
+				return false
+			}
+			asOf := ""
+			if n.AsOf != nil {
+				asOf = fmt.Sprintf(" as of %v", n.AsOf)
+			}
+			tables[sql.SharedResultTableKey(db, n.Name())] = asOf
+		case *plan.RecursiveCte, *plan.RecursiveTable:
+		case sql.TableNode:
+			// other table sources, such as table functions, may produce different rows each time they're read
+			ok = false
+			return false
+		}
+		if ne, isExprs := n.(sql.Expressioner); isExprs {
+			for _, e := range ne.Expressions() {
+				transform.InspectExpr(ctx, e, func(ctx *sql.Context, e sql.Expression) bool {
+					return !inspectExprs(e)
+				})
+			}
+		}
+		return ok
+	}
+	transform.InspectWithOpaque(ctx, n, inspectNode)
+	return tables, ok
+}
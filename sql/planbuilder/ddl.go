@@ -21,11 +21,13 @@ import (
 	"strings"
 
 	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/vector"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -95,6 +97,7 @@ func (b *Builder) buildAlterTable(inScope *scope, query string, c *ast.AlterTabl
 	b.multiDDL = true
 	defer func() {
 		b.multiDDL = false
+		b.alterAddedColumns = nil
 	}()
 
 	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, c.Auth); err != nil && b.authEnabled {
@@ -106,8 +109,18 @@ func (b *Builder) buildAlterTable(inScope *scope, query string, c *ast.AlterTabl
 		scopes := b.buildAlterTableClause(inScope, c.Statements[i])
 		for _, scope := range scopes {
 			statements = append(statements, scope.node)
+			if ac, ok := scope.node.(*plan.AddColumn); ok {
+				b.alterAddedColumns = append(b.alterAddedColumns, ac.Column())
+			}
 		}
 	}
+	if partScope, ok := b.buildAlterPartition(inScope, c); ok {
+		statements = append(statements, partScope.node)
+	}
+	if alterTableConverts(query, b.parserOpts) {
+		statements = b.buildConvertCharacterSet(inScope, statements)
+	}
+	b.validateAlterAlgorithm(inScope, query, c, statements)
 
 	if len(statements) == 1 {
 		outScope = inScope.push()
@@ -116,7 +129,7 @@ func (b *Builder) buildAlterTable(inScope *scope, query string, c *ast.AlterTabl
 	}
 
 	outScope = inScope.push()
-	outScope.node = plan.NewBlock(statements)
+	outScope.node = plan.NewAlterTableBlock(statements)
 	return
 }
 
@@ -137,13 +150,22 @@ func (b *Builder) buildDDL(inScope *scope, subQuery string, fullQuery string, c
 		if c.ProcedureSpec != nil {
 			return b.buildCreateProcedure(inScope, subQuery, fullQuery, c)
 		}
+		if c.FunctionSpec != nil {
+			return b.buildCreateFunction(inScope, subQuery, fullQuery, c)
+		}
+		if c.LoadableFunctionSpec != nil {
+			return b.buildCreateLoadableFunction(inScope, c)
+		}
 		if c.EventSpec != nil {
 			return b.buildCreateEvent(inScope, subQuery, fullQuery, c)
 		}
 		if c.ViewSpec != nil {
 			return b.buildCreateView(inScope, subQuery, fullQuery, c)
 		}
-		return b.buildCreateTable(inScope, c)
+		if c.SequenceSpec != nil {
+			return b.buildCreateSequence(inScope, c)
+		}
+		return b.buildCreateTable(inScope, subQuery, c)
 	case ast.DropStr:
 		// get database
 		if c.TriggerSpec != nil {
@@ -164,6 +186,9 @@ func (b *Builder) buildDDL(inScope *scope, subQuery string, fullQuery string, c
 			outScope.node = plan.NewDropProcedure(b.resolveDb(dbName), procName, c.IfExists)
 			return
 		}
+		if c.FunctionSpec != nil {
+			return b.buildDropFunction(inScope, c)
+		}
 		if c.EventSpec != nil {
 			dbName := c.EventSpec.EventName.Qualifier.String()
 			if dbName == "" {
@@ -176,12 +201,17 @@ func (b *Builder) buildDDL(inScope *scope, subQuery string, fullQuery string, c
 		if len(c.FromViews) != 0 {
 			return b.buildDropView(inScope, c)
 		}
+		if c.SequenceSpec != nil {
+			return b.buildDropSequence(inScope, c)
+		}
 		return b.buildDropTable(inScope, c)
 	case ast.AlterStr:
 		if c.EventSpec != nil {
 			return b.buildAlterEvent(inScope, subQuery, fullQuery, c)
 		} else if !c.User.IsEmpty() {
 			return b.buildAlterUser(inScope, subQuery, c)
+		} else if c.SequenceSpec != nil {
+			return b.buildAlterSequence(inScope, c)
 		}
 		b.handleErr(sql.ErrUnsupportedFeature.New(ast.String(c)))
 	case ast.RenameStr:
@@ -303,7 +333,7 @@ func (b *Builder) buildTruncateTable(inScope *scope, c *ast.DDL) (outScope *scop
 	return
 }
 
-func (b *Builder) buildCreateTable(inScope *scope, c *ast.DDL) (outScope *scope) {
+func (b *Builder) buildCreateTable(inScope *scope, query string, c *ast.DDL) (outScope *scope) {
 	outScope = inScope.push()
 	if c.OptLike != nil {
 		return b.buildCreateTableLike(inScope, c)
@@ -326,11 +356,14 @@ func (b *Builder) buildCreateTable(inScope *scope, c *ast.DDL) (outScope *scope)
 		return outScope
 	}
 
-	idxDefs := b.buildIndexDefs(inScope, c.TableSpec)
-
-	schema, collation, tblOpts := b.tableSpecToSchema(inScope, outScope, database, strings.ToLower(c.Table.Name.String()), c.TableSpec, false)
+	tableName := strings.ToLower(c.Table.Name.String())
+	schema, collation, tblOpts := b.tableSpecToSchema(inScope, outScope, database, tableName, c.TableSpec, false)
 	fkDefs, chDefs := b.buildConstraintsDefs(outScope, c.Table, c.TableSpec)
 
+	// functional key parts refer to the columns of the new table, and are backed by hidden generated columns
+	idxDefs := b.buildIndexDefs(outScope, c.TableSpec)
+	schema.Schema = b.addFunctionalIndexColumns(database, tableName, schema.Schema, idxDefs)
+
 	schema.Schema = assignColumnIndexesInSchema(b.ctx, schema.Schema)
 	chDefs = assignColumnIndexesInCheckDefs(b.ctx, chDefs, schema.Schema)
 
@@ -348,6 +381,15 @@ func (b *Builder) buildCreateTable(inScope *scope, c *ast.DDL) (outScope *scope)
 		}
 	}
 
+	if c.TableSpec.PartitionOpt != nil {
+		uniqueKeys, hasPk := uniqueKeyColumns(schema, idxDefs)
+		scheme := b.buildPartitionScheme(outScope, c.TableSpec.PartitionOpt, schema.Schema, uniqueKeys, hasPk)
+		if tblOpts == nil {
+			tblOpts = make(map[string]interface{})
+		}
+		tblOpts["partition_scheme"] = scheme
+	}
+
 	tableSpec := &plan.TableSpec{
 		Schema:    schema,
 		IdxDefs:   idxDefs,
@@ -620,6 +662,10 @@ func (b *Builder) buildAlterTableClause(inScope *scope, ddl *ast.DDL) []*scope {
 			err := fmt.Errorf("expected resolved table: %s", ddl.Table.Name.String())
 			b.handleErr(err)
 		}
+		// Expressions in this clause can reference the columns added by the clauses before it
+		for _, col := range b.alterAddedColumns {
+			tableScope.newColumn(scopeColumn{db: rt.Database().Name(), table: rt.Name(), col: col.Name, typ: col.Type, nullable: col.Nullable})
+		}
 
 		if ddl.ColumnAction != "" {
 			columnActionOutscope := b.buildAlterTableColumnAction(tableScope, ddl, rt)
@@ -886,6 +932,61 @@ func (b *Builder) bindForeignKeyFromTable(fk *sql.ForeignKeyConstraint, table *p
 	}
 }
 
+// addFunctionalIndexColumns replaces the functional key parts of |idxDefs|, the indexes of a new table, with hidden
+// generated columns that compute them, and returns |schema| with those columns added. Unnamed indexes with functional
+// key parts are named like MySQL names them, since their hidden columns are named after them.
+func (b *Builder) addFunctionalIndexColumns(db sql.Database, tableName string, schema sql.Schema, idxDefs sql.IndexDefs) sql.Schema {
+	names := make(map[string]struct{})
+	for _, idxDef := range idxDefs {
+		names[strings.ToLower(idxDef.Name)] = struct{}{}
+	}
+	for _, idxDef := range idxDefs {
+		for i, col := range idxDef.Columns {
+			if col.Expression == nil {
+				continue
+			}
+			if idxDef.IsPrimary() {
+				b.handleErr(sql.ErrFunctionalIndexPrimaryKey.New())
+			}
+			if idxDef.Name == "" {
+				idxDef.Name = functionalIndexName(names)
+				names[idxDef.Name] = struct{}{}
+			}
+
+			typ := col.Expression.Type(b.ctx)
+			generated, err := sql.NewColumnDefaultValue(col.Expression, typ, false, true, true)
+			if err != nil {
+				b.handleErr(err)
+			}
+			hidden := &sql.Column{
+				Name:           sql.HiddenSystemColumnName(idxDef.Name, i),
+				Type:           typ,
+				Generated:      generated,
+				Source:         tableName,
+				DatabaseSource: db.Name(),
+				Nullable:       true,
+				Virtual:        true,
+				HiddenSystem:   true,
+			}
+			schema = append(schema, hidden)
+			idxDef.Columns[i] = sql.IndexColumn{Name: hidden.Name}
+		}
+	}
+	return schema
+}
+
+// functionalIndexName returns the name MySQL gives an unnamed index with functional key parts: functional_index, with
+// a numeric suffix if that name is one of |taken|.
+func functionalIndexName(taken map[string]struct{}) string {
+	name := "functional_index"
+	for i := 2; ; i++ {
+		if _, ok := taken[name]; !ok {
+			return name
+		}
+		name = fmt.Sprintf("functional_index_%d", i)
+	}
+}
+
 func columnOrderToColumnOrder(order *ast.ColumnOrder) *sql.ColumnOrder {
 	if order == nil {
 		return nil
@@ -921,12 +1022,17 @@ func (b *Builder) buildIndexDefs(inScope *scope, spec *ast.TableSpec) (idxDefs s
 				comment = string(option.Value.Val)
 			}
 		}
+		var vectorDistance string
+		if constraint == sql.IndexConstraint_Vector {
+			vectorDistance = b.vectorIndexDistance(idxDef.Options)
+		}
 		idxDefs = append(idxDefs, &sql.IndexDef{
-			Name:       idxDef.Info.Name.String(),
-			Storage:    sql.IndexUsing_Default, // TODO: add vitess support for USING
-			Constraint: constraint,
-			Columns:    columns,
-			Comment:    comment,
+			Name:           idxDef.Info.Name.String(),
+			Storage:        sql.IndexUsing_Default, // TODO: add vitess support for USING
+			Constraint:     constraint,
+			Columns:        columns,
+			Comment:        comment,
+			VectorDistance: vectorDistance,
 		})
 	}
 
@@ -1031,6 +1137,25 @@ func (b *Builder) buildReferentialAction(action ast.ReferenceAction) sql.Foreign
 	}
 }
 
+// vectorIndexDistance returns the name of the distance function given by the ENGINE_ATTRIBUTE of a VECTOR index's
+// |options|, such as '{"distance": "cosine"}', or an empty string for the default distance function.
+func (b *Builder) vectorIndexDistance(options []*ast.IndexOption) string {
+	for _, option := range options {
+		if !strings.EqualFold(option.Name, ast.KeywordString(ast.ENGINE_ATTRIBUTE)) || option.Value == nil {
+			continue
+		}
+		distanceType, err := vector.DistanceTypeFromEngineAttribute(string(option.Value.Val))
+		if err != nil {
+			b.handleErr(err)
+		}
+		if distanceType.CanEval(vector.DefaultIndexDistanceType) {
+			return ""
+		}
+		return vector.DistanceTypeName(distanceType)
+	}
+	return ""
+}
+
 func (b *Builder) buildAlterIndex(inScope *scope, ddl *ast.DDL, table *plan.ResolvedTable) (outScope *scope) {
 	outScope = inScope
 	switch strings.ToLower(ddl.IndexSpec.Action) {
@@ -1097,6 +1222,9 @@ func (b *Builder) buildAlterIndex(inScope *scope, ddl *ast.DDL, table *plan.Reso
 			comment,
 			predicate,
 		)
+		if constraint == sql.IndexConstraint_Vector {
+			createIndex.VectorDistance = b.vectorIndexDistance(ddl.IndexSpec.Options)
+		}
 		outScope.node = b.modifySchemaTarget(inScope, createIndex, table.Schema(b.ctx))
 		return
 	case ast.DropStr:
@@ -1281,6 +1409,69 @@ func (b *Builder) buildAlterCollationSpec(inScope *scope, ddl *ast.DDL, table *p
 	return
 }
 
+// alterTableConverts returns whether the ALTER TABLE statement |query| has a CONVERT TO CHARACTER SET clause. The
+// parser builds the same node for it as for DEFAULT CHARACTER SET, so the clause is scanned from the query.
+func alterTableConverts(query string, options ast.ParserOptions) bool {
+	tokens := scanQueryTokens(query, options)
+	for i := 0; i+1 < len(tokens); i++ {
+		if tokens[i].typ == ast.CONVERT && tokens[i+1].typ == ast.TO {
+			return true
+		}
+	}
+	return false
+}
+
+// buildConvertCharacterSet adds to |statements|, before the node changing the collation of the table, a node
+// modifying each of the table's character columns to the new collation, so that their values are converted too.
+func (b *Builder) buildConvertCharacterSet(inScope *scope, statements []sql.Node) []sql.Node {
+	var converted []sql.Node
+	for _, n := range statements {
+		atc, ok := n.(*plan.AlterTableCollation)
+		if !ok {
+			converted = append(converted, n)
+			continue
+		}
+		table := atc.Table.(*plan.ResolvedTable)
+		resolvedSchema := b.resolveSchemaDefaults(inScope, table.Schema(b.ctx))
+		for _, c := range resolvedSchema {
+			typ, ok := c.Type.(sql.TypeWithCollation)
+			if !ok || typ.Collation() == sql.Collation_binary || typ.Collation() == atc.Collation {
+				continue
+			}
+			newType, err := convertedCollationType(typ, atc.Collation)
+			if err != nil {
+				b.handleErr(err)
+			}
+			colCopy := *c
+			colCopy.Type = newType
+			modifyColumn := plan.NewModifyColumnResolved(table, c.Name, colCopy, nil)
+			converted = append(converted, b.modifySchemaTarget(inScope, modifyColumn, table.Schema(b.ctx)))
+		}
+		converted = append(converted, atc)
+	}
+	return converted
+}
+
+// convertedCollationType returns the type |typ| is converted to by CONVERT TO CHARACTER SET with |collation|. As in
+// MySQL, TEXT types are promoted so that they hold as many characters as before, and VARCHAR types too long for the new
+// character set become TEXT types.
+func convertedCollationType(typ sql.TypeWithCollation, collation sql.CollationID) (sql.Type, error) {
+	st, ok := typ.(sql.StringType)
+	if !ok {
+		return typ.WithNewCollation(collation)
+	}
+	maxBytes := st.MaxCharacterLength() * collation.CharacterSet().MaxLength()
+	switch st.Type() {
+	case sqltypes.Text:
+		return types.CreateString(sqltypes.Text, min(maxBytes, types.LongTextBlobMax), collation)
+	case sqltypes.VarChar:
+		if maxBytes > types.TextBlobMax {
+			return types.CreateString(sqltypes.Text, min(maxBytes, types.LongTextBlobMax), collation)
+		}
+	}
+	return typ.WithNewCollation(collation)
+}
+
 func (b *Builder) buildAlterCommentSpec(inScope *scope, ddl *ast.DDL, table *plan.ResolvedTable) (outScope *scope) {
 	outScope = inScope
 	outScope.node = plan.NewAlterTableComment(table, ddl.AlterCommentSpec.Comment)
@@ -1650,8 +1841,23 @@ func getPkOrdinals(ts *ast.TableSpec) []int {
 func (b *Builder) columnDefinitionToColumn(inScope *scope, cd *ast.ColumnDefinition, indexes []*ast.IndexDefinition) *sql.Column {
 	internalTyp, err := types.ColumnTypeToType(&cd.Type)
 	if err != nil {
+		// ENUM and SET definition errors name the column they're defined on
+		switch {
+		case types.ErrTooManySetValues.Is(err):
+			err = sql.ErrTooBigSet.New(cd.Name.String())
+		case types.ErrTooManyEnumValues.Is(err):
+			err = sql.ErrTooBigEnum.New(cd.Name.String())
+		case types.ErrEnumValueTooLong.Is(err):
+			err = sql.ErrTooLongSetEnumValue.New(cd.Name.String())
+		}
 		b.handleErr(err)
 	}
+	if internalTyp == types.Uuid {
+		// the uuid_binary_compatibility session variable creates UUID columns as BINARY(16) instead
+		if val, err := b.ctx.GetSessionVariable(b.ctx, sql.UuidBinaryCompatibilitySessionVar); err == nil && val == int8(1) {
+			internalTyp = types.MustCreateBinary(sqltypes.Binary, 16)
+		}
+	}
 
 	// Primary key info can either be specified in the column's type info (for in-line declarations), or in a slice of
 	// indexes attached to the table def. We have to check both places to find if a column is part of the primary key
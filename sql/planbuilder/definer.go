@@ -17,6 +17,7 @@ package planbuilder
 import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
 // mockDefiner temporarily impersonates the definer during binding. It clones the current authorization state
@@ -49,3 +50,57 @@ func (b *Builder) mockDefiner(privileges ...sql.PrivilegeType) func() {
 		b.ctx.SetPrivilegeSet(initialPrivilegeSet, initialCounter)
 	}
 }
+
+// ImpersonateDefiner temporarily binds with the privileges of |definer|, the account that defined a view, routine or
+// trigger with SQL SECURITY DEFINER, along with the global |privileges| that binding the object's definition takes. It
+// is a no-op when authorization is disabled. Returns ErrNoSuchDefiner if the account doesn't exist. Callers must call
+// the returned restore function.
+func (b *Builder) ImpersonateDefiner(definer string, privileges ...sql.PrivilegeType) (func(), error) {
+	state, ok := b.authQueryState.(defaultAuthorizationQueryState)
+	if !ok || !state.enabled || !b.authEnabled {
+		return func() {}, nil
+	}
+	privilegeSet, ok := state.db.DefinerPrivilegeSet(b.ctx, definer)
+	if !ok {
+		user, host := mysql_db.ParseDefiner(definer)
+		return nil, sql.ErrNoSuchDefiner.New(user, host)
+	}
+	privilegeSet.AddGlobalStatic(privileges...)
+
+	initialAuthQueryState := b.authQueryState
+	state.privSet = privilegeSet
+	b.authQueryState = state
+	restorePrivileges := state.db.Impersonate(b.ctx, privilegeSet)
+
+	return func() {
+		b.authQueryState = initialAuthQueryState
+		restorePrivileges()
+	}, nil
+}
+
+// authorizeDefiner checks that the current user may create an object whose DEFINER clause names |definer|. Naming an
+// account other than the current user takes the SUPER or SET_USER_ID privilege. Naming an account that doesn't exist
+// only warns, as the account may be created before the object is used.
+func (b *Builder) authorizeDefiner(definer string) {
+	state, ok := b.authQueryState.(defaultAuthorizationQueryState)
+	if definer == "" || !ok || !state.enabled || !b.authEnabled {
+		return
+	}
+	user, host := mysql_db.ParseDefiner(definer)
+	client := b.ctx.Session.Client()
+	isCurrentUser := user == state.user.User && (host == state.user.Host || host == client.Address)
+	if !isCurrentUser &&
+		!state.db.UserHasPrivileges(b.ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{}, sql.PrivilegeType_Super)) &&
+		!state.db.UserHasPrivileges(b.ctx, sql.NewDynamicPrivilegedOperation(plan.DynamicPrivilege_SetUserId)) {
+		b.handleErr(sql.ErrSetDefinerDenied.New())
+	}
+
+	account := func() *mysql_db.User {
+		rd := state.db.Reader()
+		defer rd.Close()
+		return state.db.GetDefiner(rd, definer)
+	}()
+	if account == nil {
+		b.ctx.Warn(1449, "%s", sql.ErrNoSuchDefiner.New(user, host).Error())
+	}
+}
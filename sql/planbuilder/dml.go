@@ -65,6 +65,9 @@ func (b *Builder) buildInsert(inScope *scope, i *ast.Insert) (outScope *scope) {
 		}
 	}
 	isReplace := i.Action == ast.ReplaceStr
+	if rt != nil && (isReplace || len(i.OnDup) > 0) {
+		b.rejectRestrictedConflicts(i.Table, isReplace)
+	}
 
 	var columns []string
 	{
@@ -104,7 +107,7 @@ func (b *Builder) buildInsert(inScope *scope, i *ast.Insert) (outScope *scope) {
 			inScope.insertColumnAliases = make(map[string]string)
 			for i, destColumn := range columns {
 				sourceColumn := aliasedValues.Columns[i].Lowered()
-				inScope.insertColumnAliases[destColumn] = sourceColumn
+				inScope.insertColumnAliases[strings.ToLower(destColumn)] = sourceColumn
 			}
 		}
 	}
@@ -124,8 +127,7 @@ func (b *Builder) buildInsert(inScope *scope, i *ast.Insert) (outScope *scope) {
 			if len(srcScope.cols) == 0 {
 				// The to-be-inserted values can be referenced via the provided alias.
 				c.table = combinedScope.insertTableAlias
-				if len(combinedScope.insertColumnAliases) > 0 {
-					aliasColumnName := combinedScope.insertColumnAliases[c.col]
+				if aliasColumnName, ok := combinedScope.insertColumnAliases[c.col]; ok {
 					c.col = aliasColumnName
 					c.originalCol = aliasColumnName
 				}
@@ -463,7 +465,19 @@ func (b *Builder) buildDelete(inScope *scope, d *ast.Delete) (outScope *scope) {
 	sql.IncrementStatusVariable(b.ctx, "Com_delete", 1)
 	b.qFlags.Set(sql.QFlagDelete)
 
-	outScope = b.buildFrom(inScope, d.TableExprs)
+	tableExprs := d.TableExprs
+	if len(d.Partitions) > 0 && len(tableExprs) == 1 {
+		// the PARTITION clause of a single-table DELETE restricts the table it deletes from
+		if ate, ok := tableExprs[0].(*ast.AliasedTableExpr); ok && len(ate.Partitions) == 0 {
+			withPartitions := *ate
+			withPartitions.Partitions = d.Partitions
+			tableExprs = ast.TableExprs{&withPartitions}
+		}
+	}
+
+	tableExprs, where := b.restrictTablesToChange(tableExprs, d.Where, d.OrderBy, d.Returning)
+	outScope = b.buildFrom(inScope, tableExprs)
+	outScope.node = b.lockRowsToChange(outScope.node)
 
 	// Capture the table node for simple DELETEs before buildWhere wraps it
 	var targets []sql.Node
@@ -472,7 +486,7 @@ func (b *Builder) buildDelete(inScope *scope, d *ast.Delete) (outScope *scope) {
 		targets = []sql.Node{outScope.node}
 	}
 
-	b.buildWhere(outScope, d.Where)
+	b.buildWhere(outScope, where)
 	orderByScope := b.analyzeOrderBy(outScope, outScope, d.OrderBy)
 	b.buildOrderBy(outScope, orderByScope)
 	offset := b.buildOffset(outScope, d.Limit)
@@ -525,6 +539,21 @@ func (b *Builder) buildDelete(inScope *scope, d *ast.Delete) (outScope *scope) {
 	return
 }
 
+// lockRowsToChange returns |n|, the table an UPDATE or DELETE statement changes, locking the rows read from it
+// exclusively, as a SELECT ... FOR UPDATE would, so that they're changed as last committed by other transactions.
+// Statements that read from more than one table lock the rows they change when changing them instead.
+func (b *Builder) lockRowsToChange(n sql.Node) sql.Node {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		return b.withRowLock(n, nil, sql.RowLock{Mode: sql.RowLockExclusive})
+	case *plan.TableAlias:
+		if _, ok := n.Child.(*plan.ResolvedTable); ok {
+			return b.withRowLock(n, nil, sql.RowLock{Mode: sql.RowLockExclusive})
+		}
+	}
+	return n
+}
+
 // buildUpdate builds a Update node from |u|. If the update joins tables, the returned Update node's
 // children will have a JoinNode, which will later be replaced by an UpdateJoin node during analysis. We
 // don't create the UpdateJoin node here, because some query plans, such as IN SUBQUERY nodes, require
@@ -536,14 +565,20 @@ func (b *Builder) buildUpdate(inScope *scope, u *ast.Update) (outScope *scope) {
 	sql.IncrementStatusVariable(b.ctx, "Com_update", 1)
 	b.qFlags.Set(sql.QFlagUpdate)
 
-	outScope = b.buildFrom(inScope, u.TableExprs)
+	reads := []ast.SQLNode{u.OrderBy, u.Returning}
+	for _, expr := range u.Exprs {
+		reads = append(reads, expr.Expr)
+	}
+	tableExprs, where := b.restrictTablesToChange(u.TableExprs, u.Where, reads...)
+	outScope = b.buildFrom(inScope, tableExprs)
 
 	_, foundJoin := outScope.node.(*plan.JoinNode)
+	outScope.node = b.lockRowsToChange(outScope.node)
 
 	// default expressions only resolve to target table
 	updateExprs := b.assignmentExprsToUpdateExprs(outScope, u.Exprs)
 
-	b.buildWhere(outScope, u.Where)
+	b.buildWhere(outScope, where)
 
 	orderByScope := b.analyzeOrderBy(outScope, b.newScope(), u.OrderBy)
 
@@ -702,9 +737,7 @@ func (b *Builder) buildInto(inScope *scope, into *ast.Into) {
 		intoNode := plan.NewInto(inScope.node, nil, into.Outfile, "")
 
 		if into.Charset != "" {
-			// TODO: deal with charset; error for now
-			intoNode.Charset = into.Charset
-			b.handleErr(sql.ErrUnsupportedFeature.New("CHARSET in INTO OUTFILE"))
+			intoNode.Charset = b.fileCharacterSet(into.Charset, false)
 		}
 
 		if into.Fields != nil {
@@ -763,6 +796,10 @@ func (b *Builder) buildInto(inScope *scope, into *ast.Into) {
 
 func (b *Builder) loadChecksFromTable(inScope *scope, table sql.Table) []*sql.CheckConstraint {
 	var loadedChecks []*sql.CheckConstraint
+	if _, ok := table.(sql.CheckTable); !ok {
+		// Tables with virtual columns are wrapped in a VirtualColumnTable, which doesn't expose the checks
+		table = sql.GetUnderlyingTable(table)
+	}
 	if checkTable, ok := table.(sql.CheckTable); ok {
 		checks, err := checkTable.GetChecks(b.ctx)
 		if err != nil {
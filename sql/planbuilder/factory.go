@@ -114,8 +114,8 @@ func aliasTrackAndReplace(ctx *sql.Context, adj map[sql.ColumnId]sql.Expression,
 func (f *factory) buildConvert(ctx *sql.Context, expr sql.Expression, castToType string, typeLength, typeScale int) (sql.Expression, error) {
 	n := expression.NewConvertWithLengthAndScale(expr, castToType, typeLength, typeScale)
 	{
-		// deduplicate redundant convert
-		if expr.Type(ctx).Equals(n.Type(ctx)) {
+		// deduplicate redundant convert; the type of a string conversion doesn't reflect the length it truncates to
+		if expr.Type(ctx).Equals(n.Type(ctx)) && (typeLength == 0 || !types.IsText(n.Type(ctx))) {
 			f.log(ctx, "eliminated convert")
 			return expr, nil
 		}
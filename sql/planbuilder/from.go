@@ -66,11 +66,32 @@ func (b *Builder) isLateral(te ast.TableExpr) bool {
 		return true
 	case *ast.AliasedTableExpr:
 		return t.Lateral
+	case *ast.TableFuncExpr:
+		// like JSON_TABLE, a table function may take its arguments from the tables before it
+		return tableFuncReferencesColumns(t)
 	default:
 		return false
 	}
 }
 
+// tableFuncReferencesColumns returns whether any argument of the table function |t| refers to a column.
+func tableFuncReferencesColumns(t *ast.TableFuncExpr) bool {
+	var found bool
+	for _, expr := range t.Exprs {
+		_ = ast.Walk(func(node ast.SQLNode) (bool, error) {
+			switch node.(type) {
+			case *ast.ColName:
+				found = true
+			case *ast.Subquery:
+				// a subquery's columns are resolved in its own scope
+				return false, nil
+			}
+			return !found, nil
+		}, expr)
+	}
+	return found
+}
+
 func (b *Builder) isUsingJoin(te *ast.JoinTableExpr) bool {
 	return te.Condition.Using != nil ||
 		strings.EqualFold(te.Join, ast.NaturalJoinStr) ||
@@ -96,9 +117,9 @@ func (b *Builder) buildJoin(inScope *scope, te *ast.JoinTableExpr) (outScope *sc
 	// collect column  definitions
 	leftScope := b.buildDataSource(inScope, te.LeftExpr)
 
-	// TODO lateral join right will see left outputs
+	// a lateral right side sees the columns of the left side, except in a right join, where the left side depends on it
 	rightInScope := inScope
-	if b.isLateral(te.RightExpr) && te.Join != ast.RightJoinStr {
+	if b.isLateral(te.RightExpr) && te.Join != ast.RightJoinStr && te.Join != ast.NaturalRightJoinStr {
 		rightInScope = leftScope
 	}
 	rightScope := b.buildDataSource(rightInScope, te.RightExpr)
@@ -264,12 +285,21 @@ func (b *Builder) buildUsingJoin(inScope, leftScope, rightScope *scope, te *ast.
 		return outScope
 	}
 
+	lateral := b.isLateral(te.RightExpr)
 	switch strings.ToLower(te.Join) {
 	// TODO handle ast.FullOuterJoinStr, ast.NaturalFullJoinStr case https://github.com/dolthub/dolt/issues/10295
 	case ast.JoinStr, ast.NaturalJoinStr:
-		outScope.node = plan.NewInnerJoin(b.ctx, leftScope.node, rightScope.node, filter)
+		if lateral {
+			outScope.node = plan.NewJoin(b.ctx, leftScope.node, rightScope.node, plan.JoinTypeLateralInner, filter)
+		} else {
+			outScope.node = plan.NewInnerJoin(b.ctx, leftScope.node, rightScope.node, filter)
+		}
 	case ast.LeftJoinStr, ast.NaturalLeftJoinStr:
-		outScope.node = plan.NewLeftOuterJoin(b.ctx, leftScope.node, rightScope.node, filter)
+		if lateral {
+			outScope.node = plan.NewJoin(b.ctx, leftScope.node, rightScope.node, plan.JoinTypeLateralLeft, filter)
+		} else {
+			outScope.node = plan.NewLeftOuterJoin(b.ctx, leftScope.node, rightScope.node, filter)
+		}
 	case ast.RightJoinStr, ast.NaturalRightJoinStr:
 		outScope.node = plan.NewLeftOuterJoin(b.ctx, rightScope.node, leftScope.node, filter)
 	default:
@@ -297,12 +327,17 @@ func (b *Builder) buildDataSource(inScope *scope, te ast.TableExpr) (outScope *s
 			if cteScope := inScope.getCte(tableName); cteScope != nil {
 				outScope = cteScope.aliasCte(b.ctx, tAlias)
 				outScope.parent = inScope
+			} else if restrictedScope, ok := b.buildRestrictedTable(inScope, t, e); ok {
+				return restrictedScope
 			} else {
 				var ok bool
 				outScope, ok = b.buildTablescan(inScope, e, t.AsOf)
 				if !ok {
 					b.handleErr(sql.ErrTableNotFound.New(tableName))
 				}
+				if len(t.Partitions) > 0 {
+					outScope.node = b.selectPartitions(outScope.node, t.Partitions)
+				}
 			}
 			if tAlias != "" {
 				outScope.setTableAlias(tAlias)
@@ -835,9 +870,19 @@ func (b *Builder) resolveView(name string, database sql.Database, asOf interface
 			if err != nil {
 				b.handleErr(err)
 			}
-			// TODO: Once view definers are persisted, load the real definer client
-			restoreInvoker := b.mockDefiner(sql.PrivilegeType_CreateView)
-			defer restoreInvoker()
+			// A view is bound with the privileges of its definer, unless it has SQL SECURITY INVOKER. Views stored
+			// without a DEFINER clause are bound with the invoker's privileges.
+			var restorePrivileges func()
+			if ddl, ok := stmt.(*ast.DDL); ok && ddl.ViewSpec != nil && ddl.ViewSpec.Definer != "" &&
+				!strings.EqualFold(ddl.ViewSpec.Security, "invoker") {
+				restorePrivileges, err = b.ImpersonateDefiner(ddl.ViewSpec.Definer, sql.PrivilegeType_CreateView)
+				if err != nil {
+					b.handleErr(err)
+				}
+			} else {
+				restorePrivileges = b.mockDefiner(sql.PrivilegeType_CreateView)
+			}
+			defer restorePrivileges()
 			viewScope, _, err := b.bindOnlyWithDatabase(database, stmt, viewDef.CreateViewStatement)
 			if err != nil {
 				// TODO: Need to account for non-existing functions or
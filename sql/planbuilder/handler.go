@@ -0,0 +1,135 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var handlerReadTypes = map[string]plan.HandlerReadType{
+	ast.HandlerFirstStr: plan.HandlerReadFirst,
+	ast.HandlerNextStr:  plan.HandlerReadNext,
+	ast.HandlerPrevStr:  plan.HandlerReadPrev,
+	ast.HandlerLastStr:  plan.HandlerReadLast,
+	ast.EqualStr:        plan.HandlerReadEqual,
+	ast.GreaterEqualStr: plan.HandlerReadGreaterOrEqual,
+	ast.GreaterThanStr:  plan.HandlerReadGreater,
+	ast.LessEqualStr:    plan.HandlerReadLessOrEqual,
+	ast.LessThanStr:     plan.HandlerReadLess,
+}
+
+func (b *Builder) buildHandler(inScope *scope, n *ast.Handler) (outScope *scope) {
+	if _, ok := b.ctx.Session.(sql.TableHandlerSession); !ok {
+		b.handleErr(sql.ErrUnsupportedFeature.New("HANDLER"))
+	}
+
+	outScope = inScope.push()
+	switch n.Action {
+	case ast.HandlerOpenStr:
+		outScope.node = b.buildHandlerOpen(n.Table.DbQualifier.String(), n.Table.Name.String(), n.Alias.String())
+	case ast.HandlerReadStr:
+		outScope.node = b.buildHandlerRead(n)
+	default:
+		outScope.node = plan.NewHandlerClose(n.Table.Name.String())
+	}
+	return outScope
+}
+
+func (b *Builder) buildHandlerOpen(dbName, tableName, alias string) sql.Node {
+	tableScope, ok := b.buildResolvedTable(b.newScope(), dbName, "", tableName, nil)
+	if !ok {
+		b.handleErr(sql.ErrTableNotFound.New(tableName))
+	}
+	rt, ok := tableScope.node.(*plan.ResolvedTable)
+	if !ok {
+		b.handleErr(sql.ErrTableNotFound.New(tableName))
+	}
+
+	dbName = rt.SqlDatabase.Name()
+	auth := ast.AuthInformation{
+		AuthType:    ast.AuthType_SELECT,
+		TargetType:  ast.AuthTargetType_SingleTableIdentifier,
+		TargetNames: []string{dbName, rt.Name()},
+	}
+	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, auth); err != nil && b.authEnabled {
+		b.handleErr(err)
+	}
+	b.rejectRestrictedHandler(dbName, rt.Name())
+
+	name := alias
+	if name == "" {
+		name = tableName
+	}
+	return plan.NewHandlerOpen(name, dbName, rt.Name())
+}
+
+// buildHandlerRead builds a HANDLER ... READ, which reads from the handler named by the statement's table.
+func (b *Builder) buildHandlerRead(n *ast.Handler) sql.Node {
+	name := n.Table.Name.String()
+	handler, ok := b.ctx.Session.(sql.TableHandlerSession).GetTableHandler(name)
+	if !ok {
+		b.handleErr(sql.ErrUnknownTableInHandler.New(name))
+	}
+	tableScope, ok := b.buildResolvedTable(b.newScope(), handler.Database, "", handler.Table, nil)
+	if !ok {
+		b.handleErr(sql.ErrTableNotFound.New(handler.Table))
+	}
+	rt, ok := tableScope.node.(*plan.ResolvedTable)
+	if !ok {
+		b.handleErr(sql.ErrTableNotFound.New(handler.Table))
+	}
+	// the table may have been restricted for the user since the handler was opened
+	b.rejectRestrictedHandler(rt.SqlDatabase.Name(), rt.Name())
+	sch := rt.Schema(b.ctx)
+
+	var index sql.Index
+	if indexName := n.Index.String(); indexName != "" {
+		if ia, ok := rt.Table.(sql.IndexAddressable); ok {
+			indexes, err := ia.GetIndexes(b.ctx)
+			if err != nil {
+				b.handleErr(err)
+			}
+			for _, idx := range indexes {
+				if strings.EqualFold(idx.ID(), indexName) {
+					index = idx
+					break
+				}
+			}
+		}
+		if index == nil {
+			b.handleErr(sql.ErrKeyDoesNotExist.New(indexName, name))
+		}
+		if len(n.Key) > len(index.Expressions()) {
+			b.handleErr(sql.ErrTooManyKeyParts.New(len(index.Expressions())))
+		}
+	}
+
+	key := make([]sql.Expression, len(n.Key))
+	for i, e := range n.Key {
+		key[i] = b.buildScalar(tableScope, e)
+	}
+	read := plan.NewHandlerRead(name, rt.Table, index, handlerReadTypes[n.Position], key)
+	if n.Where != nil {
+		read.Filter = assignColumnIndexes(b.ctx, b.buildScalar(tableScope, n.Where.Expr), sch)
+	}
+	read.Limit = b.buildLimit(tableScope, n.Limit)
+	read.Offset = b.buildOffset(tableScope, n.Limit)
+	return read
+}
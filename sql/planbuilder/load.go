@@ -100,8 +100,7 @@ func (b *Builder) buildLoad(inScope *scope, d *ast.Load) (outScope *scope) {
 
 	ld := plan.NewLoadData(bool(d.Local), d.Infile, sch, colNames, userVars, ignoreNumVal, d.IgnoreOrReplace)
 	if d.Charset != "" {
-		// TODO: deal with charset; ignore for now
-		ld.Charset = d.Charset
+		ld.Charset = b.fileCharacterSet(d.Charset, true)
 	}
 
 	if d.Fields != nil {
@@ -180,3 +179,23 @@ func (b *Builder) buildLoad(inScope *scope, d *ast.Load) (outScope *scope) {
 	}
 	return outScope
 }
+
+// fileCharacterSet returns the name of the character set given by the CHARACTER SET clause of LOAD DATA, when |load|
+// is true, or of SELECT ... INTO OUTFILE. Files can't be loaded from character sets that don't contain ASCII, as their
+// FIELDS and LINES options couldn't be found in them.
+func (b *Builder) fileCharacterSet(name string, load bool) string {
+	charset, err := sql.ParseCharacterSet(name)
+	if err != nil {
+		b.handleErr(err)
+	}
+	if charset.Encoder() == nil {
+		b.handleErr(sql.ErrCharSetNotYetImplementedTemp.New(charset.Name()))
+	}
+	if load {
+		switch charset {
+		case sql.CharacterSet_ucs2, sql.CharacterSet_utf16, sql.CharacterSet_utf16le, sql.CharacterSet_utf32:
+			b.handleErr(sql.ErrLoadDataCharacterSet.New(charset.Name()))
+		}
+	}
+	return charset.Name()
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// loadableFunctionReturnTypes are the types of the values returned by loadable functions for each RETURNS clause.
+var loadableFunctionReturnTypes = map[string]sql.Type{
+	ast.LoadableFunctionStringStr:  types.LongText,
+	ast.LoadableFunctionIntegerStr: types.Int64,
+	ast.LoadableFunctionRealStr:    types.Float64,
+	ast.LoadableFunctionDecimalStr: types.MustCreateDecimalType(types.DecimalTypeMaxPrecision, types.DecimalTypeMaxScale),
+}
+
+// buildCreateLoadableFunction builds a CREATE FUNCTION ... SONAME, which loads a function from a library.
+func (b *Builder) buildCreateLoadableFunction(inScope *scope, c *ast.DDL) (outScope *scope) {
+	b.authorizeLoadableFunction(ast.AuthType_INSERT)
+	spec := c.LoadableFunctionSpec
+	node := plan.NewCreateLoadableFunction(spec.FuncName.String(), loadableFunctionReturnTypes[spec.ReturnType], spec.Aggregate, spec.Library, c.IfNotExists)
+	node.Catalog = b.cat
+	outScope = inScope.push()
+	outScope.node = node
+	return outScope
+}
+
+// authorizeLoadableFunction checks for the privilege on mysql.func that MySQL requires to create or drop a loadable
+// function.
+func (b *Builder) authorizeLoadableFunction(authType string) {
+	auth := ast.AuthInformation{
+		AuthType:    authType,
+		TargetType:  ast.AuthTargetType_SingleTableIdentifier,
+		TargetNames: []string{"mysql", "func"},
+	}
+	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, auth); err != nil && b.authEnabled {
+		b.handleErr(err)
+	}
+}
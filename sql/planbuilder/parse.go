@@ -17,6 +17,7 @@ package planbuilder
 import (
 	goerrors "errors"
 	"runtime/trace"
+	"strings"
 
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 	"go.opentelemetry.io/otel/attribute"
@@ -120,3 +121,53 @@ func (b *Builder) bindOnly(stmt ast.Statement, s string, queryFlags *sql.QueryFl
 	outScope := b.build(nil, stmt, s)
 	return outScope, err
 }
+
+// queryToken is a token scanned from a query, with its start and end in the query.
+type queryToken struct {
+	typ        int
+	val        string
+	start, end int
+}
+
+// scanQueryTokens returns the tokens of |query|.
+func scanQueryTokens(query string, options ast.ParserOptions) []queryToken {
+	tokenizer := ast.NewStringTokenizer(query)
+	if options.AnsiQuotes {
+		tokenizer = ast.NewStringTokenizerForAnsiQuotes(query)
+	}
+	var tokens []queryToken
+	prevEnd := 0
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 || typ == ast.LEX_ERROR {
+			break
+		}
+		end := min(max(tokenizer.Position-1, prevEnd), len(query))
+		// the text before a token may hold whitespace, and the markers of executable comments
+		start := prevEnd
+	skip:
+		for start < end {
+			switch {
+			case strings.ContainsRune(" \t\r\n", rune(query[start])):
+				start++
+			case strings.HasPrefix(query[start:end], "/*!"):
+				start += 3
+				for start < end && query[start] >= '0' && query[start] <= '9' {
+					start++
+				}
+			case strings.HasPrefix(query[start:end], "*/"):
+				start += 2
+			default:
+				break skip
+			}
+		}
+		// the tokenizer reads past some keywords, such as FOR, to look at the token that follows them, so the end of a
+		// token is taken from its text when it's written as scanned
+		if n := len(val); n > 0 && start+n <= end && strings.EqualFold(query[start:start+n], string(val)) {
+			end = start + n
+		}
+		tokens = append(tokens, queryToken{typ: typ, val: string(val), start: start, end: end})
+		prevEnd = end
+	}
+	return tokens
+}
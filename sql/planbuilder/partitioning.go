@@ -0,0 +1,444 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// buildPartitionScheme returns the partitioning defined by the PARTITION BY clause |opt| of a table with the schema
+// |sch|, whose unique keys are made of the columns in |uniqueKeys|. The first unique key is the primary key, if the
+// table has one.
+func (b *Builder) buildPartitionScheme(inScope *scope, opt *ast.PartitionOption, sch sql.Schema, uniqueKeys [][]string, hasPk bool) sql.PartitionScheme {
+	if opt.SubPartition != nil {
+		b.handleErr(sql.ErrUnsupportedFeature.New("subpartitioning"))
+	}
+	scheme := sql.PartitionScheme{Linear: opt.IsLinear}
+	switch strings.ToLower(opt.PartitionType) {
+	case "hash":
+		scheme.Method = sql.PartitionMethodHash
+	case "key":
+		scheme.Method = sql.PartitionMethodKey
+	case "range":
+		scheme.Method = sql.PartitionMethodRange
+	case "list":
+		scheme.Method = sql.PartitionMethodList
+	default:
+		b.handleErr(sql.ErrUnsupportedFeature.New(fmt.Sprintf("partitioning by %s", opt.PartitionType)))
+	}
+	// RANGE COLUMNS and LIST COLUMNS name their columns, while RANGE and LIST partition by an expression
+	scheme.Columns = opt.ColList != nil && scheme.Method != sql.PartitionMethodKey
+
+	var columns []string
+	switch {
+	case scheme.Method == sql.PartitionMethodKey && len(opt.ColList) == 0:
+		// KEY () partitions by the primary key
+		if hasPk && len(uniqueKeys) > 0 {
+			columns = uniqueKeys[0]
+		}
+	case opt.ColList != nil:
+		for _, col := range opt.ColList {
+			columns = append(columns, col.String())
+		}
+	case opt.Expr != nil:
+		expr := opt.Expr
+		for {
+			paren, ok := expr.(*ast.ParenExpr)
+			if !ok {
+				break
+			}
+			expr = paren.Expr
+		}
+		if col, ok := expr.(*ast.ColName); ok && col.Qualifier.IsEmpty() {
+			columns = []string{col.Name.String()}
+		} else {
+			b.handleErr(sql.ErrUnsupportedFeature.New("partitioning by an expression"))
+		}
+	}
+	if len(columns) == 0 {
+		b.handleErr(sql.ErrPartitionFieldNotFound.New())
+	} else if len(columns) > 1 {
+		b.handleErr(sql.ErrUnsupportedFeature.New("partitioning by more than one column"))
+	}
+
+	idx := sch.IndexOfColName(columns[0])
+	if idx < 0 {
+		b.handleErr(sql.ErrPartitionFieldNotFound.New())
+	}
+	col := sch[idx]
+	scheme.Column = col.Name
+	if col.Virtual {
+		// rows are stored without their virtual columns, so they can't be assigned to partitions by them
+		b.handleErr(sql.ErrUnsupportedFeature.New("partitioning by a virtual column"))
+	}
+	if !partitionColumnTypeAllowed(scheme, col.Type) {
+		b.handleErr(sql.ErrPartitionFieldType.New(col.Name))
+	}
+
+	for i, key := range uniqueKeys {
+		found := false
+		for _, name := range key {
+			if strings.EqualFold(name, col.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			kind := "UNIQUE INDEX"
+			if i == 0 && hasPk {
+				kind = "PRIMARY KEY"
+			}
+			b.handleErr(sql.ErrUniqueKeyNeedsPartitionColumns.New(kind))
+		}
+	}
+
+	count := b.partitionCount(opt.Partitions)
+	switch scheme.Method {
+	case sql.PartitionMethodRange, sql.PartitionMethodList:
+		if len(opt.Definitions) == 0 {
+			b.handleErr(sql.ErrPartitionsMustBeDefined.New(scheme.Method))
+		}
+		if count > 0 && count != len(opt.Definitions) {
+			b.handleErr(sql.ErrPartitionWrongCount.New())
+		}
+		scheme.Partitions = b.buildPartitionDefinitions(inScope, scheme, col.Type, opt.Definitions)
+	default:
+		if count > 0 && len(opt.Definitions) > 0 && count != len(opt.Definitions) {
+			b.handleErr(sql.ErrPartitionWrongCount.New())
+		}
+		if len(opt.Definitions) > 0 {
+			scheme.Partitions = b.buildPartitionDefinitions(inScope, scheme, col.Type, opt.Definitions)
+		} else {
+			scheme.Partitions = hashPartitionDefinitions(0, max(count, 1))
+		}
+	}
+
+	if err := scheme.Validate(b.ctx, col.Type); err != nil {
+		b.handleErr(err)
+	}
+	return scheme
+}
+
+// partitionCount returns the number of partitions given by |val|, the number of PARTITIONS, COALESCE PARTITION or
+// ADD PARTITION PARTITIONS, or 0 if |val| is nil.
+func (b *Builder) partitionCount(val *ast.SQLVal) int {
+	if val == nil {
+		return 0
+	}
+	count, err := strconv.Atoi(string(val.Val))
+	if err != nil {
+		b.handleErr(sql.ErrSyntaxError.New(err.Error()))
+	}
+	return count
+}
+
+// hashPartitionDefinitions returns |count| partitions named like the partitions MySQL creates for tables partitioned
+// by HASH or KEY without naming them, starting at p|first|.
+func hashPartitionDefinitions(first, count int) []sql.PartitionDefinition {
+	defs := make([]sql.PartitionDefinition, count)
+	for i := range defs {
+		defs[i] = sql.PartitionDefinition{Name: fmt.Sprintf("p%d", first+i)}
+	}
+	return defs
+}
+
+// partitionColumnTypeAllowed returns whether a table can be partitioned by |scheme| on a column of type |typ|. RANGE,
+// LIST and HASH partitioning need integers, while RANGE COLUMNS and LIST COLUMNS also accept strings and dates, and KEY
+// partitioning accepts most types.
+func partitionColumnTypeAllowed(scheme sql.PartitionScheme, typ sql.Type) bool {
+	switch {
+	case types.IsInteger(typ):
+		return true
+	case types.IsTextBlob(typ), types.IsJSON(typ), types.IsGeometry(typ):
+		return false
+	case scheme.Method == sql.PartitionMethodKey:
+		return true
+	case scheme.Columns:
+		return types.IsText(typ) || types.IsBinaryType(typ) || types.IsDateType(typ) || types.IsDatetimeType(typ)
+	default:
+		return false
+	}
+}
+
+// buildPartitionDefinitions returns the partitions defined by |defs| for a table partitioned by |scheme| on a column
+// of type |typ|.
+func (b *Builder) buildPartitionDefinitions(inScope *scope, scheme sql.PartitionScheme, typ sql.Type, defs []*ast.PartitionDefinition) []sql.PartitionDefinition {
+	ret := make([]sql.PartitionDefinition, len(defs))
+	for i, def := range defs {
+		name := def.Name.String()
+		ret[i].Name = name
+		hasLessThan := def.Limit != nil || def.Maxvalue
+		switch scheme.Method {
+		case sql.PartitionMethodRange:
+			if def.Values != nil {
+				b.handleErr(sql.ErrPartitionWrongValues.New("LIST", "IN"))
+			}
+			if !hasLessThan {
+				b.handleErr(sql.ErrPartitionRequiresValues.New("RANGE", "LESS THAN"))
+			}
+			if !def.Maxvalue {
+				ret[i].LessThan = b.buildPartitionValue(inScope, scheme, typ, name, def.Limit)
+				if ret[i].LessThan == nil {
+					b.handleErr(sql.ErrPartitionColumnValueType.New())
+				}
+			}
+		case sql.PartitionMethodList:
+			if hasLessThan {
+				b.handleErr(sql.ErrPartitionWrongValues.New("RANGE", "LESS THAN"))
+			}
+			if def.Values == nil {
+				b.handleErr(sql.ErrPartitionRequiresValues.New("LIST", "IN"))
+			}
+			ret[i].Values = make([]interface{}, len(def.Values))
+			for j, val := range def.Values {
+				ret[i].Values[j] = b.buildPartitionValue(inScope, scheme, typ, name, val)
+			}
+		default:
+			if hasLessThan {
+				b.handleErr(sql.ErrPartitionWrongValues.New("RANGE", "LESS THAN"))
+			}
+			if def.Values != nil {
+				b.handleErr(sql.ErrPartitionWrongValues.New("LIST", "IN"))
+			}
+		}
+	}
+	return ret
+}
+
+// buildPartitionValue returns the value of |expr|, a bound or a value of the partition |name| of a table partitioned
+// by |scheme| on a column of type |typ|.
+func (b *Builder) buildPartitionValue(inScope *scope, scheme sql.PartitionScheme, typ sql.Type, name string, expr ast.Expr) interface{} {
+	e := b.buildScalar(inScope, expr)
+	val, err := e.Eval(b.ctx, nil)
+	if err != nil {
+		b.handleErr(err)
+	}
+	if val == nil {
+		return nil
+	}
+
+	if !scheme.Columns {
+		if !types.IsInteger(e.Type(b.ctx)) {
+			b.handleErr(sql.ErrPartitionValueNotInt.New(name))
+		}
+		if types.IsUnsigned(e.Type(b.ctx)) {
+			val, _, err = types.Uint64.Convert(b.ctx, val)
+		} else {
+			val, _, err = types.Int64.Convert(b.ctx, val)
+		}
+		if err != nil {
+			b.handleErr(err)
+		}
+		return val
+	}
+	converted, inRange, err := typ.Convert(b.ctx, val)
+	if err != nil || inRange != sql.InRange {
+		b.handleErr(sql.ErrPartitionColumnValueType.New())
+	}
+	return converted
+}
+
+// uniqueKeyColumns returns the columns of the primary key and the unique indexes of a new table with the schema |sch|
+// and the indexes |idxDefs|, and whether the table has a primary key, which is the first unique key.
+func uniqueKeyColumns(sch sql.PrimaryKeySchema, idxDefs sql.IndexDefs) ([][]string, bool) {
+	var keys [][]string
+	hasPk := len(sch.PkOrdinals) > 0
+	if hasPk {
+		pk := make([]string, len(sch.PkOrdinals))
+		for i, ord := range sch.PkOrdinals {
+			pk[i] = sch.Schema[ord].Name
+		}
+		keys = append(keys, pk)
+	}
+	for _, def := range idxDefs {
+		if def.IsPrimary() && hasPk || !def.IsUnique() {
+			continue
+		}
+		cols := make([]string, len(def.Columns))
+		for i, col := range def.Columns {
+			cols[i] = col.Name
+		}
+		if def.IsPrimary() {
+			hasPk = true
+			keys = append([][]string{cols}, keys...)
+			continue
+		}
+		keys = append(keys, cols)
+	}
+	return keys, hasPk
+}
+
+// tableUniqueKeyColumns returns the columns of the primary key and the unique indexes of |table|, and whether the table
+// has a primary key, which is the first unique key.
+func (b *Builder) tableUniqueKeyColumns(table sql.Table) ([][]string, bool) {
+	var keys [][]string
+	hasPk := false
+	if pkTable, ok := table.(sql.PrimaryKeyTable); ok {
+		pkSch := pkTable.PrimaryKeySchema(b.ctx)
+		keys, hasPk = uniqueKeyColumns(pkSch, nil)
+	}
+	idxTable, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return keys, hasPk
+	}
+	indexes, err := idxTable.GetIndexes(b.ctx)
+	if err != nil {
+		b.handleErr(err)
+	}
+	for _, idx := range indexes {
+		if !idx.IsUnique() || strings.EqualFold(idx.ID(), "PRIMARY") {
+			continue
+		}
+		var cols []string
+		for _, expr := range idx.Expressions() {
+			cols = append(cols, expr[strings.IndexByte(expr, '.')+1:])
+		}
+		keys = append(keys, cols)
+	}
+	return keys, hasPk
+}
+
+// alterPartitionActions maps the partition management clauses of ALTER TABLE to their actions. Clauses that aren't
+// listed, like ANALYZE PARTITION, are ignored.
+var alterPartitionActions = map[string]plan.PartitionAction{
+	ast.AddStr:      plan.PartitionAction_Add,
+	ast.DropStr:     plan.PartitionAction_Drop,
+	ast.TruncateStr: plan.PartitionAction_Truncate,
+	ast.CoalesceStr: plan.PartitionAction_Coalesce,
+	ast.RemoveStr:   plan.PartitionAction_Remove,
+}
+
+// buildAlterPartition returns the node for the PARTITION BY clause or the partition management clause of the ALTER
+// TABLE statement |c|, or false if it has none.
+func (b *Builder) buildAlterPartition(inScope *scope, c *ast.AlterTable) (*scope, bool) {
+	var spec *ast.PartitionSpec
+	var action plan.PartitionAction
+	for _, s := range c.PartitionSpecs {
+		if a, ok := alterPartitionActions[s.Action]; ok {
+			if spec != nil {
+				b.handleErr(sql.ErrUnsupportedFeature.New("more than one partition management clause"))
+			}
+			spec, action = s, a
+		}
+	}
+	switch {
+	case c.PartitionOption != nil:
+		if spec != nil && action != plan.PartitionAction_Remove {
+			b.handleErr(sql.ErrUnsupportedFeature.New("more than one partition management clause"))
+		}
+		action = plan.PartitionAction_Partition
+	case spec == nil:
+		return nil, false
+	}
+	if len(c.Statements) > 0 && action != plan.PartitionAction_Partition && action != plan.PartitionAction_Remove {
+		b.handleErr(sql.ErrUnsupportedFeature.New("partition management combined with other ALTER TABLE clauses"))
+	}
+
+	tableScope, ok := b.buildResolvedTableForTablename(inScope, c.Table, nil)
+	if !ok {
+		b.handleErr(sql.ErrTableNotFound.New(c.Table.Name.String()))
+	}
+	rt, ok := tableScope.node.(*plan.ResolvedTable)
+	if !ok {
+		b.handleErr(sql.ErrTableNotFound.New(c.Table.Name.String()))
+	}
+
+	n := plan.NewAlterPartition(rt, action)
+	if spec != nil && action != plan.PartitionAction_Remove {
+		for _, name := range spec.Names {
+			n.Names = append(n.Names, name.String())
+		}
+		n.Count = b.partitionCount(spec.Number)
+	}
+	pt, isPartitioned := rt.UnderlyingTable().(sql.PartitionAlterableTable)
+	switch action {
+	case plan.PartitionAction_Partition:
+		if !isPartitioned {
+			// tables that don't support partitioning ignore PARTITION BY, like CREATE TABLE does
+			return nil, false
+		}
+		uniqueKeys, hasPk := b.tableUniqueKeyColumns(rt.UnderlyingTable())
+		n.Scheme = b.buildPartitionScheme(inScope, c.PartitionOption, rt.Schema(b.ctx), uniqueKeys, hasPk)
+	case plan.PartitionAction_Add:
+		if !isPartitioned || !pt.PartitionScheme(b.ctx).IsPartitioned() {
+			b.handleErr(sql.ErrPartitionMgmtOnNonPartitioned.New())
+		}
+		scheme := pt.PartitionScheme(b.ctx)
+		sch := rt.Schema(b.ctx)
+		idx := sch.IndexOfColName(scheme.Column)
+		if idx < 0 {
+			b.handleErr(sql.ErrPartitionFieldNotFound.New())
+		}
+		switch {
+		case n.Count == 0:
+			n.Definitions = b.buildPartitionDefinitions(inScope, scheme, sch[idx].Type, spec.Definitions)
+		case scheme.Method == sql.PartitionMethodRange || scheme.Method == sql.PartitionMethodList:
+			b.handleErr(sql.ErrPartitionsMustBeDefined.New(scheme.Method))
+		default:
+			n.Definitions = hashPartitionDefinitions(len(scheme.Partitions), n.Count)
+		}
+	}
+
+	outScope := inScope.push()
+	outScope.node = n
+	return outScope, true
+}
+
+// selectPartitions restricts |n|, the table of a table expression with a PARTITION clause, to the partitions named by
+// the clause.
+func (b *Builder) selectPartitions(n sql.Node, partitions ast.Partitions) sql.Node {
+	rt, ok := n.(*plan.ResolvedTable)
+	if !ok {
+		b.handleErr(sql.ErrPartitionClauseOnNonPartitioned.New())
+	}
+	pt, ok := rt.UnderlyingTable().(sql.PartitionedTable)
+	if !ok || !pt.PartitionScheme(b.ctx).IsPartitioned() {
+		b.handleErr(sql.ErrPartitionClauseOnNonPartitioned.New())
+	}
+
+	scheme := pt.PartitionScheme(b.ctx)
+	selected := make([]bool, len(scheme.Partitions))
+	for _, p := range partitions {
+		idx := scheme.PartitionIndex(p.String())
+		if idx < 0 {
+			b.handleErr(sql.ErrUnknownPartition.New(p.String(), rt.Name()))
+		}
+		selected[idx] = true
+	}
+	names := make([]string, 0, len(partitions))
+	for i, def := range scheme.Partitions {
+		if selected[i] {
+			names = append(names, def.Name)
+		}
+	}
+
+	table, err := pt.WithPartitionNames(b.ctx, names)
+	if err != nil {
+		b.handleErr(err)
+	}
+	newRt, err := rt.WithTable(b.ctx, table)
+	if err != nil {
+		b.handleErr(err)
+	}
+	return newRt
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"strconv"
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// buildSetPassword builds SET PASSWORD FOR, which sets the password of the user given.
+func (b *Builder) buildSetPassword(inScope *scope, n *ast.SetPassword) (outScope *scope) {
+	user := convertAccountName(n.User)[0]
+	b.authorizeAlterUser([]plan.UserName{user})
+	outScope = inScope.push()
+	outScope.node = plan.NewSetPassword(b.resolveDb("mysql"), &user, n.Password)
+	return outScope
+}
+
+// buildPasswordOptions returns the password options of CREATE USER or ALTER USER from their |items|. It returns nil
+// options if there are none, and a nil lock if neither ACCOUNT LOCK nor ACCOUNT UNLOCK is given. When an option is
+// given more than once, the last one is used.
+func (b *Builder) buildPasswordOptions(items []ast.PassLockItem) (*plan.PasswordOptions, *bool) {
+	var options *plan.PasswordOptions
+	var locked *bool
+	option := func() *plan.PasswordOptions {
+		if options == nil {
+			options = &plan.PasswordOptions{}
+		}
+		return options
+	}
+	// the parser leaves the value empty for DEFAULT, and for PASSWORD_LOCK_TIME UNBOUNDED
+	value := func(val *ast.SQLVal, empty int64) *int64 {
+		if val == nil {
+			return &empty
+		}
+		n, err := strconv.ParseInt(string(val.Val), 10, 64)
+		if err != nil {
+			b.handleErr(err)
+		}
+		return &n
+	}
+
+	for _, item := range items {
+		switch item.PassLockItemType {
+		case ast.PassLockItemType_PassExpire:
+			option().Expire = true
+		case ast.PassLockItemType_PassExpireDefault:
+			expire := plan.PasswordOptionDefault
+			option().ExpirationTime, option().Expire = &expire, false
+		case ast.PassLockItemType_PassExpireNever:
+			never := int64(0)
+			option().ExpirationTime, option().Expire = &never, false
+		case ast.PassLockItemType_PassExpireInterval:
+			option().ExpirationTime, option().Expire = value(item.Value, plan.PasswordOptionDefault), false
+		case ast.PassLockItemType_PassHistory:
+			option().History = value(item.Value, plan.PasswordOptionDefault)
+		case ast.PassLockItemType_PassReuseInterval:
+			option().ReuseInterval = value(item.Value, plan.PasswordOptionDefault)
+		case ast.PassLockItemType_PassReqCurrentDefault:
+			option().RequireCurrentOptional = false
+		case ast.PassLockItemType_PassReqCurrentOptional:
+			option().RequireCurrentOptional = true
+		case ast.PassLockItemType_PassFailedLogins:
+			option().FailedAttempts = value(item.Value, 0)
+		case ast.PassLockItemType_PassLockTime:
+			option().LockTime = value(item.Value, plan.PasswordLockTimeUnbounded)
+		case ast.PassLockItemType_AccountLock:
+			lock := true
+			locked = &lock
+		case ast.PassLockItemType_AccountUnlock:
+			lock := false
+			locked = &lock
+		}
+	}
+	return options, locked
+}
+
+// buildSetPasswordExpr builds SET PASSWORD = 'password', which sets the password of the current user, from the
+// expression of a SET statement. It returns false if the expression sets something else.
+func (b *Builder) buildSetPasswordExpr(expr *ast.SetVarExpr) (sql.Node, bool) {
+	if expr.Scope != ast.SetScope_None || !strings.EqualFold(expr.Name.String(), "password") {
+		return nil, false
+	}
+	val, ok := expr.Expr.(*ast.SQLVal)
+	if !ok || val.Type != ast.StrVal {
+		b.handleErr(sql.ErrSyntaxError.New("SET PASSWORD must be given a quoted password"))
+	}
+	return plan.NewSetPassword(b.resolveDb("mysql"), nil, string(val.Val)), true
+}
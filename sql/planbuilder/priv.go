@@ -179,6 +179,52 @@ func (b *Builder) buildAuthenticatedUser(user ast.AccountWithAuth) plan.Authenti
 	return authUser
 }
 
+// buildAccountLimits converts the resource limits of a CREATE USER or ALTER USER statement, returning nil if the
+// statement doesn't set any.
+func (b *Builder) buildAccountLimits(limits *ast.AccountLimits) *plan.AccountLimits {
+	if limits == nil {
+		return nil
+	}
+	var maxQueries *int64
+	if limits.MaxQueriesPerHour != nil {
+		if val, err := strconv.ParseInt(string(limits.MaxQueriesPerHour.Val), 10, 64); err != nil {
+			b.handleErr(err)
+		} else {
+			maxQueries = &val
+		}
+	}
+	var maxUpdates *int64
+	if limits.MaxUpdatesPerHour != nil {
+		if val, err := strconv.ParseInt(string(limits.MaxUpdatesPerHour.Val), 10, 64); err != nil {
+			b.handleErr(err)
+		} else {
+			maxUpdates = &val
+		}
+	}
+	var maxConnections *int64
+	if limits.MaxConnectionsPerHour != nil {
+		if val, err := strconv.ParseInt(string(limits.MaxConnectionsPerHour.Val), 10, 64); err != nil {
+			b.handleErr(err)
+		} else {
+			maxConnections = &val
+		}
+	}
+	var maxUserConnections *int64
+	if limits.MaxUserConnections != nil {
+		if val, err := strconv.ParseInt(string(limits.MaxUserConnections.Val), 10, 64); err != nil {
+			b.handleErr(err)
+		} else {
+			maxUserConnections = &val
+		}
+	}
+	return &plan.AccountLimits{
+		MaxQueriesPerHour:     maxQueries,
+		MaxUpdatesPerHour:     maxUpdates,
+		MaxConnectionsPerHour: maxConnections,
+		MaxUserConnections:    maxUserConnections,
+	}
+}
+
 func (b *Builder) buildCreateUser(inScope *scope, n *ast.CreateUser) (outScope *scope) {
 	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, n.Auth); err != nil && b.authEnabled {
 		b.handleErr(err)
@@ -202,98 +248,8 @@ func (b *Builder) buildCreateUser(inScope *scope, n *ast.CreateUser) (outScope *
 			Subject: n.TLSOptions.Subject,
 		}
 	}
-	var accountLimits *plan.AccountLimits
-	if n.AccountLimits != nil {
-		var maxQueries *int64
-		if n.AccountLimits.MaxQueriesPerHour != nil {
-			if val, err := strconv.ParseInt(string(n.AccountLimits.MaxQueriesPerHour.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				maxQueries = &val
-			}
-		}
-		var maxUpdates *int64
-		if n.AccountLimits.MaxUpdatesPerHour != nil {
-			if val, err := strconv.ParseInt(string(n.AccountLimits.MaxUpdatesPerHour.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				maxUpdates = &val
-			}
-		}
-		var maxConnections *int64
-		if n.AccountLimits.MaxConnectionsPerHour != nil {
-			if val, err := strconv.ParseInt(string(n.AccountLimits.MaxConnectionsPerHour.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				maxConnections = &val
-			}
-		}
-		var maxUserConnections *int64
-		if n.AccountLimits.MaxUserConnections != nil {
-			if val, err := strconv.ParseInt(string(n.AccountLimits.MaxUserConnections.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				maxUserConnections = &val
-			}
-		}
-		accountLimits = &plan.AccountLimits{
-			MaxQueriesPerHour:     maxQueries,
-			MaxUpdatesPerHour:     maxUpdates,
-			MaxConnectionsPerHour: maxConnections,
-			MaxUserConnections:    maxUserConnections,
-		}
-	}
-	var passwordOptions *plan.PasswordOptions
-	if n.PasswordOptions != nil {
-		var expirationTime *int64
-		if n.PasswordOptions.ExpirationTime != nil {
-			if val, err := strconv.ParseInt(string(n.PasswordOptions.ExpirationTime.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				expirationTime = &val
-			}
-		}
-		var history *int64
-		if n.PasswordOptions.History != nil {
-			if val, err := strconv.ParseInt(string(n.PasswordOptions.History.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				history = &val
-			}
-		}
-		var reuseInterval *int64
-		if n.PasswordOptions.ReuseInterval != nil {
-			if val, err := strconv.ParseInt(string(n.PasswordOptions.ReuseInterval.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				reuseInterval = &val
-			}
-		}
-		var failedAttempts *int64
-		if n.PasswordOptions.FailedAttempts != nil {
-			if val, err := strconv.ParseInt(string(n.PasswordOptions.FailedAttempts.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				failedAttempts = &val
-			}
-		}
-		var lockTime *int64
-		if n.PasswordOptions.LockTime != nil {
-			if val, err := strconv.ParseInt(string(n.PasswordOptions.LockTime.Val), 10, 64); err != nil {
-				b.handleErr(err)
-			} else {
-				lockTime = &val
-			}
-		}
-		passwordOptions = &plan.PasswordOptions{
-			RequireCurrentOptional: n.PasswordOptions.RequireCurrentOptional,
-			ExpirationTime:         expirationTime,
-			History:                history,
-			ReuseInterval:          reuseInterval,
-			FailedAttempts:         failedAttempts,
-			LockTime:               lockTime,
-		}
-	}
+	accountLimits := b.buildAccountLimits(n.AccountLimits)
+	passwordOptions, locked := b.buildPasswordOptions(n.PassLockItems)
 	database := b.resolveDb("mysql")
 
 	outScope.node = &plan.CreateUser{
@@ -303,7 +259,7 @@ func (b *Builder) buildCreateUser(inScope *scope, n *ast.CreateUser) (outScope *
 		TLSOptions:      tlsOptions,
 		AccountLimits:   accountLimits,
 		PasswordOptions: passwordOptions,
-		Locked:          n.Locked,
+		Locked:          locked != nil && *locked,
 		Attribute:       n.Attribute,
 		MySQLDb:         database,
 	}
@@ -492,12 +448,12 @@ func (b *Builder) buildGrantRole(inScope *scope, n *ast.GrantRole) (outScope *sc
 
 func (b *Builder) buildGrantProxy(inScope *scope, n *ast.GrantProxy) (outScope *scope) {
 	outScope = inScope.push()
-
-	outScope.node = plan.NewGrantProxy(
-		convertAccountName(n.On)[0],
-		convertAccountName(n.To...),
-		n.WithGrantOption,
-	)
+	outScope.node = &plan.GrantProxy{
+		On:              convertAccountName(n.On)[0],
+		To:              convertAccountName(n.To...),
+		WithGrantOption: n.WithGrantOption,
+		MySQLDb:         b.resolveDb("mysql"),
+	}
 	n.Auth.Extra = outScope.node
 	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, n.Auth); err != nil && b.authEnabled {
 		b.handleErr(err)
@@ -547,11 +503,18 @@ func (b *Builder) buildRevokeRole(inScope *scope, n *ast.RevokeRole) (outScope *
 }
 
 func (b *Builder) buildRevokeProxy(inScope *scope, n *ast.RevokeProxy) (outScope *scope) {
+	outScope = inScope.push()
+	outScope.node = &plan.RevokeProxy{
+		On:                convertAccountName(n.On)[0],
+		From:              convertAccountName(n.From...),
+		IfExists:          n.IfExists,
+		IgnoreUnknownUser: n.IgnoreUnknownUser,
+		MySQLDb:           b.resolveDb("mysql"),
+	}
+	n.Auth.Extra = outScope.node
 	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, n.Auth); err != nil && b.authEnabled {
 		b.handleErr(err)
 	}
-	outScope = inScope.push()
-	outScope.node = plan.NewRevokeProxy(convertAccountName(n.On)[0], convertAccountName(n.From...), n.IfExists, n.IgnoreUnknownUser)
 	return
 }
 
@@ -263,10 +263,14 @@ func BuildProcedureHelper(ctx *sql.Context, cat sql.Catalog, isCreateProc bool,
 
 	procParams := b.buildProcedureParams(procStmt.ProcedureSpec.Params)
 	characteristics, securityType, comment := b.buildProcedureCharacteristics(procStmt.ProcedureSpec.Characteristics)
+	definer := procStmt.ProcedureSpec.Definer
+	if definer == "" {
+		definer = procDetails.Definer
+	}
 
 	proc = plan.NewProcedure(
 		procDetails.Name,
-		procStmt.ProcedureSpec.Definer,
+		definer,
 		procParams,
 		securityType,
 		comment,
@@ -712,6 +716,31 @@ func (b *Builder) buildSignal(inScope *scope, s *ast.Signal) (outScope *scope) {
 	return outScope
 }
 
+// buildGetDiagnostics builds a GET DIAGNOSTICS statement outside of a stored procedure, which can only assign user
+// variables. The diagnostics area of a handler, read by GET STACKED DIAGNOSTICS, only exists within a procedure.
+func (b *Builder) buildGetDiagnostics(inScope *scope, g *ast.GetDiagnostics) (outScope *scope) {
+	if g.Stacked {
+		b.handleErr(sql.ErrGetStackedDiagnosticsWithoutHandler.New())
+	}
+	items := make([]plan.DiagnosticsItem, len(g.Items))
+	for i, item := range g.Items {
+		if !strings.HasPrefix(item.Target, "@") {
+			b.handleErr(sql.ErrUndeclaredVariable.New(item.Target))
+		}
+		items[i] = plan.DiagnosticsItem{
+			Variable: strings.TrimPrefix(item.Target, "@"),
+			Name:     string(item.Name),
+		}
+	}
+	var conditionNumber sql.Expression
+	if g.ConditionNumber != nil {
+		conditionNumber = b.buildScalar(inScope, g.ConditionNumber)
+	}
+	outScope = inScope.push()
+	outScope.node = plan.NewGetDiagnostics(conditionNumber, items)
+	return outScope
+}
+
 func (b *Builder) buildSignalConditionItemName(name ast.SignalConditionItemName) plan.SignalConditionItemName {
 	// We convert to our own plan equivalents to keep a separation between the parser and implementation
 	switch name {
@@ -0,0 +1,68 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// buildSetRole builds SET ROLE.
+func (b *Builder) buildSetRole(inScope *scope, n *ast.SetRole) (outScope *scope) {
+	outScope = inScope.push()
+	outScope.node = plan.NewSetRole(b.resolveDb("mysql"), roleSelection(n.Type), convertAccountName(n.Roles...))
+	return outScope
+}
+
+// buildSetDefaultRole builds SET DEFAULT ROLE, and ALTER USER ... DEFAULT ROLE.
+func (b *Builder) buildSetDefaultRole(inScope *scope, n *ast.SetDefaultRole) (outScope *scope) {
+	outScope = inScope.push()
+	users := convertAccountName(n.Users...)
+	b.authorizeAlterUser(users)
+	outScope.node = plan.NewSetDefaultRole(b.resolveDb("mysql"), roleSelection(n.Type), convertAccountName(n.Roles...), users, n.IfExists)
+	return outScope
+}
+
+// roleSelection returns the plan's equivalent of |selection|.
+func roleSelection(selection ast.RoleSelectionType) plan.RoleSelection {
+	switch selection {
+	case ast.RoleSelectionType_Default:
+		return plan.RoleSelection_Default
+	case ast.RoleSelectionType_None:
+		return plan.RoleSelection_None
+	case ast.RoleSelectionType_All:
+		return plan.RoleSelection_All
+	case ast.RoleSelectionType_AllExcept:
+		return plan.RoleSelection_AllExcept
+	default:
+		return plan.RoleSelection_List
+	}
+}
+
+// authorizeAlterUser checks that |users| may be altered, which setting their default roles or their passwords also
+// needs.
+func (b *Builder) authorizeAlterUser(users []plan.UserName) {
+	for _, user := range users {
+		auth := ast.AuthInformation{
+			AuthType:    ast.AuthType_ALTER_USER,
+			TargetType:  ast.AuthTargetType_Ignore,
+			TargetNames: []string{user.Name, user.Host},
+		}
+		if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, auth); err != nil && b.authEnabled {
+			b.handleErr(err)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RoutineDependency is a table that the body of a trigger, stored procedure or stored function refers to, along with
+// the columns of the table that it refers to by name.
+type RoutineDependency struct {
+	// Database is the database qualifying the table in the body, or empty if it's the database of the routine.
+	Database string
+	Table    string
+	Columns  []string
+}
+
+// RoutineDependencies returns the tables and columns that the body of |createStatement|, a CREATE TRIGGER, CREATE
+// PROCEDURE or CREATE FUNCTION statement, depends on. Routine bodies aren't resolved until they're executed, so these
+// are found in the parsed body rather than in a plan. Only columns that can be attributed to a table without resolving
+// the body are included: those qualified by a table name or alias, the NEW and OLD columns of a trigger, the columns in
+// the column list of an INSERT, and unqualified columns of a statement that refers to a single table. Tables that the
+// body creates itself and the names of common table expressions aren't dependencies.
+func RoutineDependencies(ctx *sql.Context, createStatement string, opts ast.ParserOptions) ([]RoutineDependency, error) {
+	stmt, err := ast.ParseWithOptions(ctx, createStatement, opts)
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := stmt.(*ast.DDL)
+	if !ok {
+		return nil, nil
+	}
+
+	d := &routineDeps{
+		locals:   make(map[string]struct{}),
+		excluded: make(map[string]struct{}),
+		indexes:  make(map[[2]string]int),
+	}
+	var body ast.Statement
+	switch {
+	case ddl.TriggerSpec != nil:
+		body = ddl.TriggerSpec.Body
+		d.triggerTable = ddl.Table
+	case ddl.ProcedureSpec != nil:
+		body = ddl.ProcedureSpec.Body
+		for _, param := range ddl.ProcedureSpec.Params {
+			d.locals[strings.ToLower(param.Name)] = struct{}{}
+		}
+	case ddl.FunctionSpec != nil:
+		body = ddl.FunctionSpec.Body
+		for _, param := range ddl.FunctionSpec.Params {
+			d.locals[strings.ToLower(param.Name)] = struct{}{}
+		}
+	default:
+		return nil, nil
+	}
+	if body == nil {
+		return nil, nil
+	}
+
+	if err := ast.Walk(d.collectNames, body); err != nil {
+		return nil, err
+	}
+	if err := ast.Walk(d.visit, body); err != nil {
+		return nil, err
+	}
+	return d.deps, nil
+}
+
+// CheckRoutineDependencies returns an error describing the first of |deps|, the dependencies of a trigger or stored
+// procedure in the database |dbName|, that no longer exists in |cat|, or nil if they all do.
+func CheckRoutineDependencies(ctx *sql.Context, cat sql.Catalog, dbName string, deps []RoutineDependency) error {
+	for _, dep := range deps {
+		db := dep.Database
+		if db == "" {
+			db = dbName
+		}
+		table, _, err := cat.Table(ctx, db, dep.Table)
+		if err != nil {
+			return err
+		}
+		sch := table.Schema(ctx)
+		for _, col := range dep.Columns {
+			if sch.IndexOfColName(col) < 0 {
+				return sql.ErrTableColumnNotFound.New(table.Name(), col)
+			}
+		}
+	}
+	return nil
+}
+
+// routineDeps accumulates the dependencies of the body of a trigger or stored procedure.
+type routineDeps struct {
+	// triggerTable is the table of a trigger, to which its NEW and OLD columns belong
+	triggerTable ast.TableName
+	// locals are the lowercased names of the parameters and local variables of the body
+	locals map[string]struct{}
+	// excluded are the lowercased names of the tables created and the common table expressions defined in the body
+	excluded map[string]struct{}
+	deps     []RoutineDependency
+	// indexes are the indexes in |deps| of each lowercased database and table name
+	indexes map[[2]string]int
+	// rename is the column whose references are renamed, if any
+	rename *columnRename
+}
+
+// collectNames records the local variables, common table expressions and created tables of the body.
+func (d *routineDeps) collectNames(node ast.SQLNode) (bool, error) {
+	switch n := node.(type) {
+	case *ast.Declare:
+		if n != nil && n.Variables != nil {
+			for _, name := range n.Variables.Names {
+				d.locals[name.Lowered()] = struct{}{}
+			}
+		}
+	case *ast.With:
+		if n == nil {
+			break
+		}
+		for _, cte := range n.Ctes {
+			d.excluded[strings.ToLower(cte.As.String())] = struct{}{}
+		}
+	case *ast.DDL:
+		if n != nil && n.Action == ast.CreateStr && !n.Table.IsEmpty() {
+			d.excluded[strings.ToLower(n.Table.Name.String())] = struct{}{}
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// visit records the dependencies of each statement in the body.
+func (d *routineDeps) visit(node ast.SQLNode) (bool, error) {
+	switch n := node.(type) {
+	case *ast.DDL:
+		return false, nil
+	case ast.SelectStatement, *ast.Insert, *ast.Update, *ast.Delete:
+		return false, d.addStatement(n)
+	case *ast.ColName:
+		if n != nil {
+			d.addColumn(n, nil, nil, nil)
+		}
+	}
+	return true, nil
+}
+
+// addStatement records the tables that |stmt| refers to, and the columns that can be attributed to them. Subqueries
+// are treated as part of the statement.
+func (d *routineDeps) addStatement(stmt ast.SQLNode) error {
+	aliases := make(map[string]int)
+	var tables []int
+	selectAliases := make(map[string]struct{})
+	var cols []*ast.ColName
+
+	addTable := func(name ast.TableName, alias ast.TableIdent) int {
+		idx := d.addTable(name)
+		if idx < 0 {
+			return idx
+		}
+		tables = append(tables, idx)
+		if alias.IsEmpty() {
+			alias = name.Name
+		}
+		aliases[strings.ToLower(alias.String())] = idx
+		return idx
+	}
+
+	err := ast.Walk(func(node ast.SQLNode) (bool, error) {
+		switch n := node.(type) {
+		case *ast.AliasedTableExpr:
+			if n == nil {
+				break
+			}
+			if name, ok := n.Expr.(ast.TableName); ok {
+				addTable(name, n.As)
+			}
+		case *ast.Insert:
+			if n == nil {
+				break
+			}
+			if idx := addTable(n.Table, ast.TableIdent{}); idx >= 0 {
+				for i := range n.Columns {
+					d.addColumnToTable(idx, &n.Columns[i])
+				}
+			}
+		case *ast.AliasedExpr:
+			if n != nil && !n.As.IsEmpty() {
+				selectAliases[n.As.Lowered()] = struct{}{}
+			}
+		case *ast.ColName:
+			if n != nil {
+				cols = append(cols, n)
+			}
+		}
+		return true, nil
+	}, stmt)
+	if err != nil {
+		return err
+	}
+
+	for _, col := range cols {
+		d.addColumn(col, aliases, tables, selectAliases)
+	}
+	return nil
+}
+
+// addColumn attributes |col| to one of the tables of the statement it's in, if possible. |aliases| are the indexes in
+// |d.deps| of the tables of the statement by alias, |tables| are the indexes of all of them, and |selectAliases| are
+// the aliases of its select expressions. Outside a statement, only the NEW and OLD columns of a trigger are
+// attributed.
+func (d *routineDeps) addColumn(col *ast.ColName, aliases map[string]int, tables []int, selectAliases map[string]struct{}) {
+	name := col.Name.String()
+	if strings.HasPrefix(name, "@") {
+		return
+	}
+
+	qualifier := strings.ToLower(col.Qualifier.Name.String())
+	if qualifier != "" {
+		if col.Qualifier.DbQualifier.IsEmpty() && !d.triggerTable.IsEmpty() && (qualifier == "new" || qualifier == "old") {
+			if idx := d.addTable(d.triggerTable); idx >= 0 {
+				d.addColumnToTable(idx, &col.Name)
+			}
+		} else if idx, ok := aliases[qualifier]; ok {
+			d.addColumnToTable(idx, &col.Name)
+		}
+		return
+	}
+
+	if _, ok := d.locals[col.Name.Lowered()]; ok {
+		return
+	}
+	if _, ok := selectAliases[col.Name.Lowered()]; ok {
+		return
+	}
+	if len(tables) == 1 {
+		d.addColumnToTable(tables[0], &col.Name)
+	} else if d.rename != nil {
+		// a column that isn't qualified is taken to be the renamed column if its table is one of the statement's,
+		// since the statement would be ambiguous otherwise
+		for _, idx := range tables {
+			if d.rename.isTable(d.deps[idx]) {
+				d.rename.apply(&col.Name)
+				break
+			}
+		}
+	}
+}
+
+// addTable records a dependency on the table |name|, and returns its index in |d.deps|, or -1 if the table isn't a
+// dependency.
+func (d *routineDeps) addTable(name ast.TableName) int {
+	table := name.Name.String()
+	db := name.DbQualifier.String()
+	if strings.EqualFold(table, "dual") {
+		return -1
+	}
+	if db == "" {
+		if _, ok := d.excluded[strings.ToLower(table)]; ok {
+			return -1
+		}
+	}
+
+	key := [2]string{strings.ToLower(db), strings.ToLower(table)}
+	if idx, ok := d.indexes[key]; ok {
+		return idx
+	}
+	d.deps = append(d.deps, RoutineDependency{Database: db, Table: table})
+	d.indexes[key] = len(d.deps) - 1
+	return len(d.deps) - 1
+}
+
+// addColumnToTable records a dependency on the column |col| of the table at |idx| in |d.deps|, and renames it if it's
+// the renamed column.
+func (d *routineDeps) addColumnToTable(idx int, col *ast.ColIdent) {
+	dep := &d.deps[idx]
+	if d.rename != nil && d.rename.isTable(*dep) {
+		d.rename.apply(col)
+	}
+	for _, c := range dep.Columns {
+		if col.EqualString(c) {
+			return
+		}
+	}
+	dep.Columns = append(dep.Columns, col.String())
+}
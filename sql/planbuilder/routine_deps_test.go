@@ -0,0 +1,79 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"testing"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRoutineDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []RoutineDependency
+	}{
+		{
+			name:  "trigger",
+			query: "create trigger trg before insert on t1 for each row begin set new.b = new.a * 2; insert into t2 (x, y) values (new.a, old.c); update t3 set z = 1 where w > 0; end",
+			expected: []RoutineDependency{
+				{Table: "t1", Columns: []string{"b", "a", "c"}},
+				{Table: "t2", Columns: []string{"x", "y"}},
+				{Table: "t3", Columns: []string{"z", "w"}},
+			},
+		},
+		{
+			name: "procedure",
+			query: `create procedure p(in v int) begin
+				declare q int;
+				select a, b as bb from t1 where a = v order by bb;
+				select x.c, y.d from t2 x join other.t3 y on x.id = y.id;
+				with cte as (select 1 as k from t4) select k from cte;
+				create table tmp (i int);
+				insert into tmp select * from t5;
+				select 1 from dual;
+				if v > 0 then delete from t6 where g = q; end if;
+			end`,
+			expected: []RoutineDependency{
+				{Table: "t1", Columns: []string{"a", "b"}},
+				{Table: "t2", Columns: []string{"c", "id"}},
+				{Database: "other", Table: "t3", Columns: []string{"d", "id"}},
+				{Table: "t4"},
+				{Table: "t5"},
+				{Table: "t6", Columns: []string{"g"}},
+			},
+		},
+		{
+			name:  "subquery",
+			query: "create procedure p() select count(*) from t1 where e in (select f from t2)",
+			expected: []RoutineDependency{
+				{Table: "t1"},
+				{Table: "t2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, err := RoutineDependencies(sql.NewEmptyContext(), tt.query, ast.ParserOptions{})
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, deps)
+		})
+	}
+}
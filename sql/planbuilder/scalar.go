@@ -15,6 +15,7 @@
 package planbuilder
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"strconv"
@@ -196,9 +197,11 @@ func (b *Builder) buildScalar(inScope *scope, e ast.Expr) (ex sql.Expression) {
 		name := v.Name.Lowered()
 		if name == "name_const" {
 			return b.buildNameConst(inScope, v)
+		} else if isSequenceFunction(v) {
+			return b.buildSequenceFunction(inScope, v)
 		} else if name == "icu_version" {
 			return expression.NewLiteral(icuVersion, types.MustCreateString(query.Type_VARCHAR, int64(len(icuVersion)), sql.Collation_Default))
-		} else if IsAggregateFunc(name) && v.Over == nil {
+		} else if (IsAggregateFunc(name) || b.isUserDefinedAggregateFunc(name)) && v.Over == nil {
 			// TODO this assumes aggregate is in the same scope
 			// also need to avoid nested aggregates
 			return b.buildAggregateFunc(inScope, name, v)
@@ -211,8 +214,17 @@ func (b *Builder) buildScalar(inScope *scope, e ast.Expr) (ex sql.Expression) {
 			schemaName = inScope.schemaName
 		}
 
+		// built-in functions take precedence over stored functions, unless the stored function is qualified by its database
+		if v.Qualifier.String() != "" {
+			if sf := b.buildStoredFunction(inScope, v); sf != nil {
+				return sf
+			}
+		}
 		f, ok := b.cat.Function(b.ctx, schemaName, name)
 		if !ok {
+			if sf := b.buildStoredFunction(inScope, v); sf != nil {
+				return sf
+			}
 			// check if this a table function accidentally used in a scalar context
 			_, ok := b.cat.TableFunction(b.ctx, name)
 			if ok {
@@ -365,6 +377,13 @@ func (b *Builder) buildScalar(inScope *scope, e ast.Expr) (ex sql.Expression) {
 			}
 		}
 		expr := b.buildScalar(inScope, v.Expr)
+		if v.Type.Charset != "" {
+			charset, err := sql.ParseCharacterSet(v.Type.Charset)
+			if err != nil {
+				b.handleErr(err)
+			}
+			return expression.NewConvertWithCharacterSet(expr, v.Type.Type, typeLength, charset)
+		}
 		ret, err := b.f.buildConvert(b.ctx, expr, v.Type.Type, typeLength, typeScale)
 		if err != nil {
 			b.handleErr(err)
@@ -372,6 +391,12 @@ func (b *Builder) buildScalar(inScope *scope, e ast.Expr) (ex sql.Expression) {
 		return ret
 	case ast.InjectedExpr:
 		return b.buildInjectedExpr(inScope, v)
+	case *ast.MemberOfExpr:
+		memberOf, err := json.NewJSONMemberOf(b.ctx, b.buildScalar(inScope, v.Value), b.buildScalar(inScope, v.JSONArray))
+		if err != nil {
+			b.handleErr(err)
+		}
+		return memberOf
 	case *ast.RangeCond:
 		val := b.buildScalar(inScope, v.Left)
 		lower := b.buildScalar(inScope, v.From)
@@ -612,6 +637,9 @@ func (b *Builder) buildUnaryScalar(inScope *scope, e *ast.UnaryExpr) sql.Express
 		c := b.buildScalar(inScope, e.Expr)
 		b.qFlags.Set(sql.QFlgNotExpr)
 		return expression.NewNot(c)
+	case ast.TildaStr:
+		c := b.buildScalar(inScope, e.Expr)
+		return expression.NewBitNot(c)
 	case ast.BinaryStr:
 		c := b.buildScalar(inScope, e.Expr)
 		return expression.NewBinary(c)
@@ -739,10 +767,42 @@ func (b *Builder) typeExpandComparisonLiteral(left, right sql.Expression) (sql.E
 	return left, right
 }
 
+// typeComparisonBinaryLiteral returns |expr|, built from |astExpr| and compared to |other|, with the type MySQL gives
+// hexadecimal and bit literals in comparisons. These literals are binary strings, unless they're compared to a number,
+// in which case they're the unsigned integer they represent.
+func (b *Builder) typeComparisonBinaryLiteral(astExpr ast.Expr, expr, other sql.Expression) sql.Expression {
+	val, ok := astExpr.(*ast.SQLVal)
+	if !ok {
+		return expr
+	}
+	lit, ok := expr.(*expression.Literal)
+	if !ok || lit.Value() == nil {
+		return expr
+	}
+	otherType := other.Type(b.ctx)
+	switch val.Type {
+	case ast.HexVal, ast.HexNum:
+		bytes, ok := lit.Value().([]byte)
+		if !ok || len(bytes) > 8 || !types.IsNumber(otherType) {
+			return expr
+		}
+		return expression.NewLiteral(binary.BigEndian.Uint64(append(make([]byte, 8-len(bytes)), bytes...)), types.Uint64)
+	case ast.BitVal:
+		bitType, ok := lit.Type(b.ctx).(types.BitType)
+		if !ok || !types.IsText(otherType) {
+			return expr
+		}
+		return expression.NewLiteral(bitType.Bytes(lit.Value().(uint64)), types.LongBlob)
+	default:
+		return expr
+	}
+}
+
 func (b *Builder) buildComparison(inScope *scope, c *ast.ComparisonExpr) sql.Expression {
 	left := b.buildScalar(inScope, c.Left)
 	right := b.buildScalar(inScope, c.Right)
 
+	left, right = b.typeComparisonBinaryLiteral(c.Left, left, right), b.typeComparisonBinaryLiteral(c.Right, right, left)
 	left, right = b.typeExpandComparisonLiteral(left, right)
 
 	var escape sql.Expression = nil
@@ -1115,7 +1175,12 @@ func (b *Builder) ConvertVal(v *ast.SQLVal) sql.Expression {
 			b.handleErr(err)
 		}
 
-		return expression.NewLiteral(res, types.Uint64)
+		// Bit literals are numbers in numeric contexts and binary strings elsewhere, which is how BIT values behave
+		numOfBits := len(v.Val)
+		if numOfBits > types.BitTypeMaxBits {
+			numOfBits = types.BitTypeMaxBits
+		}
+		return expression.NewLiteral(res, types.MustCreateBitType(uint8(numOfBits)))
 	}
 
 	b.handleErr(sql.ErrInvalidSQLValType.New(v.Type))
@@ -1167,7 +1232,6 @@ func (b *Builder) buildMatchAgainst(inScope *scope, v *ast.MatchExpr) *expressio
 		err = fmt.Errorf(`"IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION" is not supported yet`)
 	case ast.BooleanModeStr:
 		searchModifier = fulltext.SearchModifier_Boolean
-		err = fmt.Errorf(`"IN BOOLEAN MODE" is not supported yet`)
 	case ast.QueryExpansionStr:
 		searchModifier = fulltext.SearchModifier_QueryExpansion
 		err = fmt.Errorf(`"WITH QUERY EXPANSION" is not supported yet`)
@@ -16,6 +16,7 @@ package planbuilder
 
 import (
 	"fmt"
+	"strings"
 
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -30,7 +32,7 @@ func (b *Builder) buildSelectStmt(inScope *scope, s ast.SelectStatement) (outSco
 	switch s := s.(type) {
 	case *ast.Select:
 		if s.With != nil {
-			cteScope := b.buildWith(inScope, s.With)
+			cteScope := b.buildWithCachedCtes(inScope, s.With, cachedCteHints(s.Comments))
 			return b.buildSelect(cteScope, s)
 		}
 		return b.buildSelect(inScope, s)
@@ -123,7 +125,7 @@ func (b *Builder) buildSelect(inScope *scope, s *ast.Select) (outScope *scope) {
 		outScope.node = l
 	}
 
-	b.buildForUpdateOf(s.Lock, fromScope)
+	outScope.node = b.buildLockingClause(s.Lock, fromScope, outScope.node)
 
 	return
 }
@@ -267,21 +269,74 @@ func (b *Builder) renameSource(scope *scope, table string, cols []string) {
 	}
 }
 
-// buildForUpdateOf builds the `FOR UPDATE OF` clause, ensuring that all tables listed are
-// present in the clause. `FOR UPDATE` in general is a no-op, so `FOR UPDATE OF` is
-// also a no-op: https://www.dolthub.com/blog/2023-10-23-hold-my-beer/
-// TODO: implement actual row-level locking for `FOR UPDATE` clauses in general.
-func (b *Builder) buildForUpdateOf(lock *ast.Lock, fromScope *scope) {
-	if lock == nil {
-		return
+// buildLockingClause builds the locking clause of a SELECT, such as `FOR UPDATE` or `LOCK IN SHARE MODE`, by passing
+// the lock to each table of |fromScope| read by |n| that implements sql.LockableTable, or only to the tables named in
+// a `FOR UPDATE OF` clause, all of which must be present in the scope. Tables of subqueries aren't locked.
+func (b *Builder) buildLockingClause(lock *ast.Lock, fromScope *scope, n sql.Node) sql.Node {
+	if lock == nil || lock.Type == "" {
+		return n
 	}
 
+	var names map[string]struct{}
 	for _, tableName := range lock.Tables {
 		tableNameStr := tableName.Name.String()
 
 		if !fromScope.hasTable(tableNameStr) {
 			b.handleErr(sql.ErrUnresolvedTableLock.New(tableNameStr))
-			return
 		}
+		if names == nil {
+			names = make(map[string]struct{})
+		}
+		names[strings.ToLower(tableNameStr)] = struct{}{}
+	}
+
+	rowLock := sql.RowLock{Mode: sql.RowLockExclusive}
+	if lock.Type == ast.ShareModeStr || strings.HasPrefix(lock.Type, ast.ForShareStr) {
+		rowLock.Mode = sql.RowLockShared
+	}
+	switch {
+	case strings.HasSuffix(lock.Type, " nowait"):
+		rowLock.Wait = sql.RowLockNowait
+	case strings.HasSuffix(lock.Type, " skip locked"):
+		rowLock.Wait = sql.RowLockSkipLocked
+	}
+
+	return b.withRowLock(n, names, rowLock)
+}
+
+// withRowLock returns |n| with |rowLock| taken on the rows read from its tables that implement sql.LockableTable. If
+// |names| is not nil, only the tables with those lowercase names or aliases are locked.
+func (b *Builder) withRowLock(n sql.Node, names map[string]struct{}, rowLock sql.RowLock) sql.Node {
+	ret, _, err := transform.NodeWithCtx(b.ctx, n, nil, func(ctx *sql.Context, c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		rt, ok := c.Node.(*plan.ResolvedTable)
+		if !ok {
+			return c.Node, transform.SameTree, nil
+		}
+		if names != nil {
+			name := rt.Name()
+			if ta, ok := c.Parent.(*plan.TableAlias); ok {
+				name = ta.Name()
+			}
+			if _, ok := names[strings.ToLower(name)]; !ok {
+				return c.Node, transform.SameTree, nil
+			}
+		}
+		table := rt.Table
+		if mtw, ok := table.(sql.MutableTableWrapper); ok {
+			table = mtw.Underlying()
+		}
+		lockable, ok := table.(sql.LockableTable)
+		if !ok {
+			return c.Node, transform.SameTree, nil
+		}
+		locked, err := rt.WithTable(ctx, lockable.WithRowLock(rowLock))
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return locked, transform.NewTree, nil
+	})
+	if err != nil {
+		b.handleErr(err)
 	}
+	return ret
 }
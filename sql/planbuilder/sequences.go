@@ -0,0 +1,218 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// buildCreateSequence builds CREATE SEQUENCE.
+func (b *Builder) buildCreateSequence(inScope *scope, c *ast.DDL) (outScope *scope) {
+	outScope = inScope.push()
+	options := b.sequenceOptions(c.SequenceSpec, false)
+	outScope.node = plan.NewCreateSequence(b.sequenceDb(c.Table), c.Table.Name.String(), options, c.IfNotExists, c.OrReplace)
+	return outScope
+}
+
+// buildAlterSequence builds ALTER SEQUENCE.
+func (b *Builder) buildAlterSequence(inScope *scope, c *ast.DDL) (outScope *scope) {
+	outScope = inScope.push()
+	options := b.sequenceOptions(c.SequenceSpec, true)
+	outScope.node = plan.NewAlterSequence(b.sequenceDb(c.Table), c.Table.Name.String(), options, c.IfExists)
+	return outScope
+}
+
+// buildDropSequence builds DROP SEQUENCE.
+func (b *Builder) buildDropSequence(inScope *scope, c *ast.DDL) (outScope *scope) {
+	outScope = inScope.push()
+	var dbs []sql.Database
+	var names []string
+	for _, t := range c.FromTables {
+		dbs, names = append(dbs, b.sequenceDb(t)), append(names, t.Name.String())
+	}
+	outScope.node = plan.NewDropSequence(dbs, names, c.IfExists)
+	return outScope
+}
+
+// buildShowCreateSequence builds SHOW CREATE SEQUENCE.
+func (b *Builder) buildShowCreateSequence(inScope *scope, s *ast.Show) (outScope *scope) {
+	outScope = inScope.push()
+	outScope.node = plan.NewShowCreateSequence(b.sequenceDb(s.Table), s.Table.Name.String())
+	return outScope
+}
+
+// sequenceDb returns the database of the sequence named, which defaults to the current database.
+func (b *Builder) sequenceDb(name ast.TableName) sql.Database {
+	dbName := name.DbQualifier.String()
+	if dbName == "" {
+		dbName = b.ctx.GetCurrentDatabase()
+	}
+	return b.resolveDb(dbName)
+}
+
+// sequenceOptions returns the options of CREATE SEQUENCE, or of ALTER SEQUENCE if |alter| is true, which also takes
+// RESTART.
+func (b *Builder) sequenceOptions(spec *ast.SequenceSpec, alter bool) plan.SequenceOptions {
+	var o plan.SequenceOptions
+	number := func(opt *ast.SequenceOption) *int64 {
+		n, err := strconv.ParseInt(opt.Value, 10, 64)
+		if err != nil {
+			b.handleErr(sql.ErrSyntaxError.New(fmt.Sprintf("syntax error in sequence statement near '%s'", opt.Value)))
+		}
+		return &n
+	}
+	cycle := func(c bool) *bool {
+		return &c
+	}
+	for _, opt := range spec.Options {
+		switch opt.Name {
+		case ast.SequenceIncrementStr:
+			o.Increment = number(opt)
+		case ast.SequenceMinValueStr:
+			o.MinValue = number(opt)
+		case ast.SequenceMaxValueStr:
+			o.MaxValue = number(opt)
+		case ast.SequenceStartStr:
+			o.Start = number(opt)
+		case ast.SequenceCacheStr:
+			o.Cache = number(opt)
+		case ast.SequenceNoMinValueStr:
+			o.NoMinValue = true
+		case ast.SequenceNoMaxValueStr:
+			o.NoMaxValue = true
+		case ast.SequenceNoCacheStr:
+			o.Cache = new(int64)
+		case ast.SequenceCycleStr:
+			o.Cycle = cycle(true)
+		case ast.SequenceNoCycleStr:
+			o.Cycle = cycle(false)
+		case ast.SequenceRestartStr:
+			if !alter {
+				b.handleErr(sql.ErrSyntaxError.New("syntax error in sequence statement near 'restart'"))
+			}
+			o.Restart = true
+			if opt.Value != "" {
+				o.RestartWith = number(opt)
+			}
+		case ast.SequenceEngineStr:
+			// MariaDB stores sequences in tables, whose engine is ignored here
+		}
+	}
+	return o
+}
+
+// authorizeSequence checks for the privilege a function needs on the sequence named. Sequences share their privileges
+// with tables.
+func (b *Builder) authorizeSequence(db, name string, authType string) {
+	auth := ast.AuthInformation{
+		AuthType:    authType,
+		TargetType:  ast.AuthTargetType_SingleTableIdentifier,
+		TargetNames: []string{db, name},
+	}
+	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, auth); err != nil && b.authEnabled {
+		b.handleErr(err)
+	}
+}
+
+// buildSequenceFunction builds a call to NEXTVAL, LASTVAL or SETVAL. The first argument of each names the sequence,
+// either as an identifier or as a string.
+func (b *Builder) buildSequenceFunction(inScope *scope, f *ast.FuncExpr) sql.Expression {
+	name := f.Name.Lowered()
+	argCount := len(f.Exprs)
+	if name == "setval" && (argCount < 2 || argCount > 3) || name != "setval" && argCount != 1 {
+		b.handleErr(sql.ErrInvalidArgumentNumber.New(strings.ToUpper(name), "1", argCount))
+	}
+	aliased, ok := f.Exprs[0].(*ast.AliasedExpr)
+	if !ok {
+		b.handleErr(sql.ErrInvalidArgument.New(strings.ToUpper(name)))
+	}
+
+	var dbName, seqName string
+	switch e := aliased.Expr.(type) {
+	case *ast.ColName:
+		dbName, seqName = e.Qualifier.Name.String(), e.Name.String()
+	case *ast.SQLVal:
+		if e.Type != ast.StrVal {
+			b.handleErr(sql.ErrInvalidArgument.New(strings.ToUpper(name)))
+		}
+		seqName = string(e.Val)
+		if i := strings.IndexByte(seqName, '.'); i >= 0 {
+			dbName, seqName = seqName[:i], seqName[i+1:]
+		}
+	default:
+		b.handleErr(sql.ErrInvalidArgument.New(strings.ToUpper(name)))
+	}
+	if dbName == "" {
+		dbName = b.ctx.GetCurrentDatabase()
+	}
+
+	db := b.resolveDb(dbName)
+	var exists bool
+	if seqDb, ok := db.(sql.SequenceDatabase); ok {
+		var err error
+		if _, exists, err = seqDb.GetSequence(b.ctx, seqName); err != nil {
+			b.handleErr(err)
+		}
+	}
+	if !exists {
+		b.handleErr(sql.ErrUnknownSequence.New(db.Name() + "." + seqName))
+	}
+
+	ref := function.SequenceRef{Catalog: b.cat, Db: db.Name(), Name: seqName}
+	var ret sql.Expression
+	switch name {
+	case "nextval":
+		b.authorizeSequence(ref.Db, ref.Name, ast.AuthType_INSERT)
+		ret = function.NewNextVal(ref)
+	case "lastval":
+		b.authorizeSequence(ref.Db, ref.Name, ast.AuthType_SELECT)
+		ret = function.NewLastVal(ref)
+	default:
+		b.authorizeSequence(ref.Db, ref.Name, ast.AuthType_INSERT)
+		value := b.selectExprToExpression(inScope, f.Exprs[1])
+		var used sql.Expression
+		if argCount == 3 {
+			used = b.selectExprToExpression(inScope, f.Exprs[2])
+		}
+		ret = function.NewSetVal(ref, value, used)
+	}
+
+	b.qFlags.Set(sql.QFlagUndeferrableExprs)
+	if inScope.nearestSubquery() != nil {
+		inScope.nearestSubquery().markVolatile()
+	}
+	return ret
+}
+
+// isSequenceFunction returns whether |f| is a call to one of the functions of sequences.
+func isSequenceFunction(f *ast.FuncExpr) bool {
+	if !f.Qualifier.IsEmpty() {
+		return false
+	}
+	switch f.Name.Lowered() {
+	case "nextval", "lastval", "setval":
+		return true
+	default:
+		return false
+	}
+}
@@ -28,6 +28,14 @@ import (
 )
 
 func (b *Builder) buildSet(inScope *scope, n *ast.Set) (outScope *scope) {
+	if len(n.Exprs) == 1 {
+		if node, ok := b.buildSetPasswordExpr(n.Exprs[0]); ok {
+			outScope = inScope.push()
+			outScope.node = node
+			return outScope
+		}
+	}
+
 	var setVarExprs []*ast.SetVarExpr
 	for _, setExpr := range n.Exprs {
 		switch strings.ToLower(setExpr.Name.String()) {
@@ -34,6 +34,23 @@ func (b *Builder) buildShow(inScope *scope, s *ast.Show) (outScope *scope) {
 	if err := b.cat.AuthorizationHandler().HandleAuth(b.ctx, b.authQueryState, s.Auth); err != nil && b.authEnabled {
 		b.handleErr(err)
 	}
+	outScope = b.buildShowStatement(inScope, s)
+	// The parser doesn't know the privileges that most SHOW statements take, so the nodes that show objects which
+	// need a privilege check it themselves
+	if b.authEnabled {
+		transform.Inspect(outScope.node, func(n sql.Node) bool {
+			if checker, ok := n.(sql.AuthorizationCheckerNode); ok {
+				if err := b.cat.AuthorizationHandler().HandleAuthNode(b.ctx, b.authQueryState, checker); err != nil {
+					b.handleErr(err)
+				}
+			}
+			return true
+		})
+	}
+	return outScope
+}
+
+func (b *Builder) buildShowStatement(inScope *scope, s *ast.Show) (outScope *scope) {
 	showType := strings.ToLower(s.Type)
 	switch showType {
 	case "processlist":
@@ -49,8 +66,12 @@ func (b *Builder) buildShow(inScope *scope, s *ast.Show) (outScope *scope) {
 		return b.buildShowTrigger(inScope, s)
 	case ast.CreateProcedureStr:
 		return b.buildShowProcedure(inScope, s)
+	case ast.CreateFunctionStr:
+		return b.buildShowFunction(inScope, s)
 	case ast.CreateEventStr:
 		return b.buildShowEvent(inScope, s)
+	case ast.CreateSequenceStr:
+		return b.buildShowCreateSequence(inScope, s)
 	case "triggers":
 		return b.buildShowAllTriggers(inScope, s)
 	case "events":
@@ -139,7 +160,7 @@ func (b *Builder) showTargetInfo(inScope *scope, s *ast.Show) (db, tableName str
 
 func (b *Builder) buildShowTable(inScope *scope, s *ast.Show) (outScope *scope) {
 	outScope = inScope.push()
-	_, tableName, asOf, asOfExpr := b.showTargetInfo(inScope, s)
+	dbName, tableName, asOf, asOfExpr := b.showTargetInfo(inScope, s)
 
 	tableScope, ok := b.buildResolvedTableForTablename(inScope, s.Table, asOf)
 	if !ok {
@@ -158,6 +179,7 @@ func (b *Builder) buildShowTable(inScope *scope, s *ast.Show) (outScope *scope)
 	}
 
 	showCreate := plan.NewShowCreateTableWithAsOf(b.ctx, tableScope.node, false, asOfExpr)
+	showCreate.DbName = dbName
 	outScope.node = showCreate
 
 	if rt != nil {
@@ -222,7 +244,9 @@ func (b *Builder) newShowCreateViewNode(db, tableName string, asOfExpr sql.Expre
 		}
 	}
 	subqueryAlias := plan.NewSubqueryAlias(tableName, textDef, plan.NewEmptyTableWithSchema(nil))
-	return plan.NewShowCreateTableWithAsOf(b.ctx, subqueryAlias, true, asOfExpr), nil
+	showCreate := plan.NewShowCreateTableWithAsOf(b.ctx, subqueryAlias, true, asOfExpr)
+	showCreate.DbName = database.Name()
+	return showCreate, nil
 }
 
 // viewSelectBody parses the CREATE VIEW statement in |viewDef| and returns the SELECT body text,
@@ -266,18 +290,46 @@ func (b *Builder) buildShowTrigger(inScope *scope, s *ast.Show) (outScope *scope
 	if err != nil {
 		b.handleErr(err)
 	}
-	outScope.node = plan.NewShowCreateTrigger(db, s.Table.Name.String())
+	showCreateTrigger := plan.NewShowCreateTrigger(db, s.Table.Name.String())
+	showCreateTrigger.Table = b.triggerTableName(db, showCreateTrigger.TriggerName)
+	outScope.node = showCreateTrigger
 	return
 }
 
+// triggerTableName returns the name of the table of trigger |name| in |db|, or an empty string if there's no such
+// trigger. The error for a trigger that doesn't exist is left to SHOW CREATE TRIGGER.
+func (b *Builder) triggerTableName(db sql.Database, name string) string {
+	triggerDb, ok := db.(sql.TriggerDatabase)
+	if !ok {
+		return ""
+	}
+	triggers, err := triggerDb.GetTriggers(b.ctx)
+	if err != nil {
+		b.handleErr(err)
+	}
+	for _, trigger := range triggers {
+		if !strings.EqualFold(trigger.Name, name) {
+			continue
+		}
+		stmt, _, _, err := b.parser.ParseWithOptions(b.ctx, trigger.CreateStatement, ';', false, sql.NewSqlModeFromString(trigger.SqlMode).ParserOptions())
+		if err != nil {
+			return ""
+		}
+		if ddl, ok := stmt.(*ast.DDL); ok {
+			return ddl.Table.Name.String()
+		}
+	}
+	return ""
+}
+
 func (b *Builder) buildShowAllTriggers(inScope *scope, s *ast.Show) (outScope *scope) {
-	dbName := s.Table.DbQualifier.String()
+	var dbName string
+	if s.ShowTablesOpt != nil {
+		dbName = s.ShowTablesOpt.DbName
+	}
 	if dbName == "" {
 		dbName = b.ctx.GetCurrentDatabase()
 	}
-	if dbName == "" && &s.ShowTablesOpt != nil {
-		dbName = s.ShowTablesOpt.DbName
-	}
 	db := b.resolveDb(dbName)
 
 	b.qFlags.Set(sql.QFlagSetDatabase)
@@ -295,7 +347,6 @@ func (b *Builder) buildShowAllTriggers(inScope *scope, s *ast.Show) (outScope *s
 	}
 	var filter sql.Expression
 	if s.ShowTablesOpt != nil {
-		dbName = s.ShowTablesOpt.DbName
 		if s.ShowTablesOpt.Filter != nil {
 			if s.ShowTablesOpt.Filter.Filter != nil {
 				filter = b.buildScalar(outScope, s.ShowTablesOpt.Filter.Filter)
@@ -349,7 +400,9 @@ func (b *Builder) buildShowEvent(inScope *scope, s *ast.Show) (outScope *scope)
 func (b *Builder) buildShowAllEvents(inScope *scope, s *ast.Show) (outScope *scope) {
 	outScope = inScope.push()
 	var dbName string
-
+	if s.ShowTablesOpt != nil {
+		dbName = s.ShowTablesOpt.DbName
+	}
 	if dbName == "" {
 		dbName = b.ctx.GetCurrentDatabase()
 	}
@@ -366,7 +419,6 @@ func (b *Builder) buildShowAllEvents(inScope *scope, s *ast.Show) (outScope *sco
 	}
 	var filter sql.Expression
 	if s.ShowTablesOpt != nil {
-		dbName = s.ShowTablesOpt.DbName
 		if s.ShowTablesOpt.Filter != nil {
 			if s.ShowTablesOpt.Filter.Filter != nil {
 				filter = b.buildScalar(outScope, s.ShowTablesOpt.Filter.Filter)
@@ -411,6 +463,19 @@ func (b *Builder) buildShowProcedure(inScope *scope, s *ast.Show) (outScope *sco
 	return
 }
 
+func (b *Builder) buildShowFunction(inScope *scope, s *ast.Show) (outScope *scope) {
+	outScope = inScope.push()
+	var db sql.Database
+	dbName := s.Table.DbQualifier.String()
+	if dbName != "" {
+		db = b.resolveDb(dbName)
+	} else {
+		db = b.currentDb()
+	}
+	outScope.node = plan.NewShowCreateFunction(db, s.Table.Name.String())
+	return
+}
+
 func (b *Builder) buildShowProcedureStatus(inScope *scope, s *ast.Show) (outScope *scope) {
 	var filter sql.Expression
 
@@ -548,6 +613,7 @@ func (b *Builder) buildShowIndex(inScope *scope, s *ast.Show) (outScope *scope)
 		b.handleErr(err)
 	}
 	showIdx := plan.NewShowIndexes(tableScope.node)
+	showIdx.Stats = b.cat
 	switch n := tableScope.node.(type) {
 	case *plan.ResolvedTable:
 		showIdx.IndexesToShow = b.getInfoSchemaIndexes(n)
@@ -680,7 +746,7 @@ func (b *Builder) buildAsOfExpr(inScope *scope, time ast.Expr) sql.Expression {
 		return expression.NewLiteral(v.String(), types.LongText)
 	case *ast.FuncExpr:
 		// todo(max): more specific validation for nested ASOF functions
-		if IsWindowFunc(v.Name.Lowered()) || IsAggregateFunc(v.Name.Lowered()) {
+		if IsWindowFunc(v.Name.Lowered()) || IsAggregateFunc(v.Name.Lowered()) || b.isUserDefinedAggregateFunc(v.Name.Lowered()) {
 			err := sql.ErrInvalidAsOfExpression.New(v)
 			b.handleErr(err)
 		}
@@ -1001,7 +1067,11 @@ func (b *Builder) buildShowCharset(inScope *scope, s *ast.Show) (outScope *scope
 	outScope = inScope.push()
 
 	showCharset := plan.NewShowCharset()
-	showCharset.CharacterSetTable = b.resolveTable("character_sets", "information_schema", nil)
+	charsetTable := b.resolveTable("character_sets", "information_schema", nil)
+	if ct, ok := charsetTable.Table.(sql.CatalogTable); ok {
+		charsetTable.Table = ct.AssignCatalog(b.cat)
+	}
+	showCharset.CharacterSetTable = charsetTable
 
 	var node sql.Node = showCharset
 	for _, c := range node.Schema(b.ctx) {
@@ -0,0 +1,249 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func (b *Builder) buildCreateFunction(inScope *scope, subQuery string, fullQuery string, c *ast.DDL) (outScope *scope) {
+	if b.qFlags.IsSet(sql.QFlagCreateEvent) || b.qFlags.IsSet(sql.QFlagCreateTrigger) || b.qFlags.IsSet(sql.QFlagCreateProcedure) {
+		b.handleErr(fmt.Errorf("can't create a FUNCTION from within another stored routine"))
+	}
+
+	spec := c.FunctionSpec
+	name := strings.ToLower(spec.FuncName.Name.String())
+	params, returnType, characteristics, securityType, comment := b.buildFunctionSignature(spec)
+	b.validateCreateFunction(inScope, name, spec, params)
+	b.authorizeDefiner(spec.Definer)
+
+	var db sql.Database = nil
+	if dbName := spec.FuncName.Qualifier.String(); dbName != "" {
+		db = b.resolveDb(dbName)
+	} else {
+		db = b.currentDb()
+	}
+
+	now := time.Now()
+	sfd := sql.StoredFunctionDetails{
+		Name:            name,
+		CreateStatement: subQuery,
+		CreatedAt:       now,
+		ModifiedAt:      now,
+		SqlMode:         sql.LoadSqlMode(b.ctx).String(),
+		Definer:         getCurrentUserForDefiner(b.ctx, spec.Definer),
+	}
+
+	bodyStr := strings.TrimSpace(fullQuery[c.SubStatementPositionStart:c.SubStatementPositionEnd])
+
+	outScope = inScope.push()
+	outScope.node = plan.NewCreateFunction(db, sfd, bodyStr, params, returnType, characteristics, securityType, comment, c.IfNotExists)
+	return outScope
+}
+
+// buildFunctionSignature returns the parameters, return type and characteristics of the stored function |spec|.
+func (b *Builder) buildFunctionSignature(spec *ast.FunctionSpec) ([]plan.ProcedureParam, sql.Type, []plan.Characteristic, plan.ProcedureSecurityContext, string) {
+	params := b.buildProcedureParams(spec.Params)
+	returnType, err := types.ColumnTypeToType(&spec.ReturnType)
+	if err != nil {
+		b.handleErr(err)
+	}
+	characteristics, securityType, comment := b.buildProcedureCharacteristics(spec.Characteristics)
+	return params, returnType, characteristics, securityType, comment
+}
+
+func (b *Builder) validateCreateFunction(inScope *scope, name string, spec *ast.FunctionSpec, params []plan.ProcedureParam) {
+	paramNames := make(map[string]struct{})
+	for _, param := range params {
+		paramName := strings.ToLower(param.Name)
+		if _, ok := paramNames[paramName]; ok {
+			b.handleErr(sql.ErrDeclareVariableDuplicate.New(paramName))
+		}
+		paramNames[paramName] = struct{}{}
+	}
+
+	inScope.initProc()
+	for _, p := range params {
+		inScope.proc.AddVar(b.ctx, expression.NewProcedureParam(strings.ToLower(p.Name), p.Type))
+	}
+	b.validateStatement(inScope, spec.Body)
+
+	hasReturn := false
+	walkRoutineStatements(spec.Body, func(stmt ast.Statement) {
+		switch s := stmt.(type) {
+		case *ast.Return:
+			hasReturn = true
+		case *ast.Select:
+			if s.Into == nil {
+				b.handleErr(sql.ErrStoredFunctionResultSet.New())
+			}
+		case *ast.SetOp:
+			if s.Into == nil {
+				b.handleErr(sql.ErrStoredFunctionResultSet.New())
+			}
+		case *ast.Show:
+			b.handleErr(sql.ErrStoredFunctionResultSet.New())
+		}
+	})
+	if !hasReturn {
+		b.handleErr(sql.ErrStoredFunctionNoReturn.New(name))
+	}
+}
+
+// walkRoutineStatements calls |visit| on |stmt| and on every statement nested in it, such as the statements of blocks,
+// loops, conditionals and handlers.
+func walkRoutineStatements(stmt ast.Statement, visit func(ast.Statement)) {
+	if stmt == nil {
+		return
+	}
+	visit(stmt)
+	walkAll := func(stmts ast.Statements) {
+		for _, s := range stmts {
+			walkRoutineStatements(s, visit)
+		}
+	}
+	switch s := stmt.(type) {
+	case *ast.BeginEndBlock:
+		walkAll(s.Statements)
+	case *ast.Loop:
+		walkAll(s.Statements)
+	case *ast.Repeat:
+		walkAll(s.Statements)
+	case *ast.While:
+		walkAll(s.Statements)
+	case *ast.IfStatement:
+		for _, cond := range s.Conditions {
+			walkAll(cond.Statements)
+		}
+		walkAll(s.Else)
+	case *ast.CaseStatement:
+		for _, c := range s.Cases {
+			walkAll(c.Statements)
+		}
+		walkAll(s.Else)
+	case *ast.Declare:
+		if s.Handler != nil {
+			walkRoutineStatements(s.Handler.Statement, visit)
+		}
+	}
+}
+
+func (b *Builder) buildDropFunction(inScope *scope, c *ast.DDL) (outScope *scope) {
+	outScope = inScope.push()
+	dbName := c.FunctionSpec.FuncName.Qualifier.String()
+	funcName := c.FunctionSpec.FuncName.Name.String()
+	// A loadable function has the same namespace as the stored functions, and MySQL drops it first
+	if dbName == "" {
+		if udfp, ok := b.cat.(sql.UserDefinedFunctionProvider); ok {
+			if _, ok := udfp.UserDefinedFunction(b.ctx, funcName); ok {
+				b.authorizeLoadableFunction(ast.AuthType_DELETE)
+				node := plan.NewDropLoadableFunction(funcName, c.IfExists)
+				node.Catalog = b.cat
+				outScope.node = node
+				return outScope
+			}
+		}
+		dbName = b.ctx.GetCurrentDatabase()
+	}
+	outScope.node = plan.NewDropFunction(b.resolveDb(dbName), funcName, c.IfExists)
+	return outScope
+}
+
+// buildStoredFunction returns a call to the stored function named by |f|, or nil if there isn't one. Unqualified names
+// are looked up in the database of the routine or view being built, or else the current database.
+func (b *Builder) buildStoredFunction(inScope *scope, f *ast.FuncExpr) sql.Expression {
+	var db sql.Database
+	if dbName := f.Qualifier.String(); dbName != "" {
+		// the qualifier may not name a database, as in the dotted access of a column named like a function call
+		var err error
+		if db, err = b.cat.Database(b.ctx, dbName); err != nil {
+			return nil
+		}
+	} else if b.ProcCtx().DbName != "" {
+		db = b.resolveDb(b.ProcCtx().DbName)
+	} else if b.ViewCtx().DbName != "" {
+		db = b.resolveDb(b.ViewCtx().DbName)
+	} else if b.ctx.GetCurrentDatabase() != "" {
+		db = b.currentDb()
+	}
+	fdb, ok := db.(sql.StoredFunctionDatabase)
+	if !ok {
+		return nil
+	}
+	details, ok, err := fdb.GetStoredFunction(b.ctx, f.Name.String())
+	if err != nil {
+		b.handleErr(err)
+	}
+	if !ok {
+		return nil
+	}
+
+	b.qFlags.Set(sql.QFlagStoredFunction)
+	cf := b.parseStoredFunction(db, details)
+	if len(f.Exprs) != len(cf.Params) {
+		b.handleErr(sql.ErrStoredFunctionArgumentCount.New(db.Name()+"."+details.Name, len(cf.Params), len(f.Exprs)))
+	}
+	args := make([]sql.Expression, len(f.Exprs))
+	for i, e := range f.Exprs {
+		args[i] = b.selectExprToExpression(inScope, e)
+	}
+	parserOpts := sql.NewSqlModeFromString(details.SqlMode).ParserOptions()
+	return plan.NewStoredFunction(details.Name, db.Name(), args, cf.Params, cf.ReturnType, plan.IsDeterministic(cf.Characteristics), details.CreateStatement, parserOpts)
+}
+
+// parseStoredFunction parses the CREATE FUNCTION statement of the stored function |details| of |db|, without
+// validating its body.
+func (b *Builder) parseStoredFunction(db sql.Database, details sql.StoredFunctionDetails) *plan.CreateFunction {
+	stmt, _, _, err := b.parser.ParseWithOptions(b.ctx, details.CreateStatement, ';', false, sql.NewSqlModeFromString(details.SqlMode).ParserOptions())
+	if err != nil {
+		b.handleErr(err)
+	}
+	ddl, ok := stmt.(*ast.DDL)
+	if !ok || ddl.FunctionSpec == nil {
+		b.handleErr(fmt.Errorf("invalid CREATE FUNCTION statement: %s", details.CreateStatement))
+	}
+	spec := ddl.FunctionSpec
+	params, returnType, characteristics, securityType, comment := b.buildFunctionSignature(spec)
+	bodyStr := strings.TrimSpace(details.CreateStatement[ddl.SubStatementPositionStart:ddl.SubStatementPositionEnd])
+	return plan.NewCreateFunction(db, details, bodyStr, params, returnType, characteristics, securityType, comment, ddl.IfNotExists)
+}
+
+// StoredFunctionDefinition returns the definition of the stored function |details| of |db| as it was created, for
+// information_schema.
+func StoredFunctionDefinition(ctx *sql.Context, cat sql.Catalog, db sql.Database, details sql.StoredFunctionDetails) (cf *plan.CreateFunction, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case parseErr:
+				err = r.err
+			default:
+				panic(r)
+			}
+		}
+	}()
+	b := New(ctx, cat, nil)
+	b.DisableAuth()
+	cf = b.parseStoredFunction(db, details)
+	return cf, nil
+}
@@ -0,0 +1,366 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// tableRestriction is the restriction that the policies of a table place on the current user, parsed from the
+// sql.TableRestriction of each policy
+type tableRestriction struct {
+	// filter is the conjunction of the row filters of the policies, or nil if rows aren't filtered
+	filter ast.Expr
+	// masks are the column masks of the policies, by lowercase column name
+	masks map[string]columnMask
+}
+
+// columnMask is the expression read in place of a column, and the name of the policy that masks it
+type columnMask struct {
+	policy string
+	expr   ast.Expr
+}
+
+// tableRestriction returns the restriction that the policies registered for the table |table| in the database |db|
+// place on the current user, or nil if the table isn't restricted for them.
+func (b *Builder) tableRestriction(db, table string) *tableRestriction {
+	provider, ok := b.cat.(sql.TablePolicyProvider)
+	if !ok {
+		return nil
+	}
+	var restriction *tableRestriction
+	for _, policy := range provider.TablePolicies(b.ctx, db, table) {
+		r, err := policy.Apply(b.ctx)
+		if err != nil {
+			b.handleErr(err)
+		}
+		if r == nil || (r.RowFilter == "" && len(r.ColumnMasks) == 0) {
+			continue
+		}
+		if restriction == nil {
+			restriction = &tableRestriction{masks: make(map[string]columnMask)}
+		}
+
+		// the masks and filter of a policy are parsed as the select list and WHERE clause of a query, in order of
+		// column name
+		cols := make([]string, 0, len(r.ColumnMasks))
+		for col := range r.ColumnMasks {
+			cols = append(cols, col)
+		}
+		sort.Strings(cols)
+		query := "select 1"
+		if len(cols) > 0 {
+			exprs := make([]string, len(cols))
+			for i, col := range cols {
+				exprs[i] = r.ColumnMasks[col]
+			}
+			query = "select " + strings.Join(exprs, ", ")
+		}
+		if r.RowFilter != "" {
+			query += " from dual where " + r.RowFilter
+		}
+		stmt, _, _, err := b.parser.ParseWithOptions(b.ctx, query, ';', false, b.parserOpts)
+		if err != nil {
+			b.handleErr(sql.ErrInvalidTablePolicy.New(policy.Name, err.Error()))
+		}
+		sel, ok := stmt.(*ast.Select)
+		if !ok || (len(cols) > 0 && len(sel.SelectExprs) != len(cols)) {
+			b.handleErr(sql.ErrInvalidTablePolicy.New(policy.Name, "invalid column masks"))
+		}
+		for i, col := range cols {
+			aliased, ok := sel.SelectExprs[i].(*ast.AliasedExpr)
+			if !ok {
+				b.handleErr(sql.ErrInvalidTablePolicy.New(policy.Name, fmt.Sprintf("invalid mask for column '%s'", col)))
+			}
+			if _, ok := restriction.masks[strings.ToLower(col)]; !ok {
+				restriction.masks[strings.ToLower(col)] = columnMask{policy: policy.Name, expr: aliased.Expr}
+			}
+		}
+		if sel.Where != nil {
+			restriction.filter = andExprs(restriction.filter, &ast.ParenExpr{Expr: sel.Where.Expr})
+		}
+	}
+	return restriction
+}
+
+// restrictedTableDb returns the name of the database of |tableName|, as it's resolved by buildResolvedTable.
+func (b *Builder) restrictedTableDb(tableName ast.TableName) string {
+	if db := tableName.DbQualifier.String(); db != "" {
+		return db
+	}
+	if b.ViewCtx().DbName != "" {
+		return b.ViewCtx().DbName
+	}
+	return b.ctx.GetCurrentDatabase()
+}
+
+// buildRestrictedTable builds |te|, which reads the table |tableName|, as a derived table that selects the masked
+// columns of the rows that pass the row filters of the table's policies. It returns false if the table isn't
+// restricted for the current user, or if it's changed by the UPDATE or DELETE being built, which restricts it itself.
+func (b *Builder) buildRestrictedTable(inScope *scope, te *ast.AliasedTableExpr, tableName ast.TableName) (outScope *scope, ok bool) {
+	if _, ok := b.tablesToChange[te]; ok {
+		delete(b.tablesToChange, te)
+		return nil, false
+	}
+	db := b.restrictedTableDb(tableName)
+	name := tableName.Name.String()
+	key := strings.ToLower(db + "." + name)
+	if _, ok := b.restrictedTables[key]; ok || db == "" {
+		// the table in the derived table that restricts it is read as it is
+		return nil, false
+	}
+	restriction := b.tableRestriction(db, name)
+	if restriction == nil {
+		return nil, false
+	}
+
+	sel := &ast.Select{SelectExprs: ast.SelectExprs{&ast.StarExpr{}}}
+	if len(restriction.masks) > 0 {
+		sel.SelectExprs = b.maskedColumns(db, name, restriction.masks)
+	}
+	table := *te
+	table.As = ast.TableIdent{}
+	sel.From = ast.TableExprs{&table}
+	if restriction.filter != nil {
+		sel.Where = ast.NewWhere(ast.WhereStr, restriction.filter)
+	}
+	alias := te.As
+	if alias.IsEmpty() {
+		alias = ast.NewTableIdent(name)
+	}
+
+	if b.restrictedTables == nil {
+		b.restrictedTables = make(map[string]struct{})
+	}
+	b.restrictedTables[key] = struct{}{}
+	defer delete(b.restrictedTables, key)
+	derived := &ast.AliasedTableExpr{Auth: ast.AuthInformation{AuthType: ast.AuthType_IGNORE}, Expr: &ast.Subquery{Select: sel}, As: alias}
+	return b.buildDataSource(inScope, derived), true
+}
+
+// maskedColumns returns the select list of the derived table that restricts the table |name| in the database |db|,
+// which reads the masks of the columns that have one and the other columns as they are.
+func (b *Builder) maskedColumns(db, name string, masks map[string]columnMask) ast.SelectExprs {
+	database, err := b.cat.Database(b.ctx, db)
+	if err != nil {
+		b.handleErr(err)
+	}
+	tab, _, err := b.cat.DatabaseTable(b.ctx, database, name)
+	if err != nil {
+		b.handleErr(err)
+	}
+
+	var exprs ast.SelectExprs
+	masked := 0
+	for _, c := range tab.Schema(b.ctx) {
+		if sql.IsHiddenSystemColumn(c.Name) {
+			continue
+		}
+		if mask, ok := masks[strings.ToLower(c.Name)]; ok {
+			exprs = append(exprs, &ast.AliasedExpr{Expr: mask.expr, As: ast.NewColIdent(c.Name)})
+			masked++
+		} else {
+			exprs = append(exprs, &ast.AliasedExpr{Expr: &ast.ColName{Name: ast.NewColIdent(c.Name)}})
+		}
+	}
+	if masked < len(masks) {
+		for col, mask := range masks {
+			if tab.Schema(b.ctx).IndexOfColName(col) < 0 {
+				b.handleErr(sql.ErrInvalidTablePolicy.New(mask.policy, fmt.Sprintf("unknown column '%s' in table '%s'", col, name)))
+			}
+		}
+	}
+	return exprs
+}
+
+// rejectRestrictedConflicts returns ErrRestrictedTableConflict if the table |tableName|, which a REPLACE or an INSERT
+// ... ON DUPLICATE KEY UPDATE statement writes, is restricted for the current user. These statements change the rows
+// that their new rows conflict with, which may be rows the user can't see or columns they can't read.
+func (b *Builder) rejectRestrictedConflicts(tableName ast.TableName, isReplace bool) {
+	db := b.restrictedTableDb(tableName)
+	if db == "" || b.tableRestriction(db, tableName.Name.String()) == nil {
+		return
+	}
+	statement := "INSERT ... ON DUPLICATE KEY UPDATE"
+	if isReplace {
+		statement = "REPLACE"
+	}
+	b.handleErr(sql.ErrRestrictedTableConflict.New(statement, tableName.Name.String()))
+}
+
+// rejectRestrictedHandler returns ErrRestrictedTableHandler if the table |table| in the database |db|, which a HANDLER
+// statement opens or reads, is restricted for the current user. Handlers read the rows of a table directly, in index
+// order, so they can't be filtered or masked.
+func (b *Builder) rejectRestrictedHandler(db, table string) {
+	if b.tableRestriction(db, table) != nil {
+		b.handleErr(sql.ErrRestrictedTableHandler.New(table))
+	}
+}
+
+// restrictTablesToChange adds the row filters of the policies of the tables that an UPDATE or DELETE statement
+// changes to its WHERE clause, or to the ON condition of the outer joins they're on the inner side of. These tables
+// can't be replaced with derived tables, as the tables that are only read are, so they aren't masked. Their masked
+// columns may not be read by |reads|, the expressions of the statement other than its tables and WHERE clause, so
+// that they can't be copied into other columns or tested by the conditions of the statement.
+func (b *Builder) restrictTablesToChange(tableExprs ast.TableExprs, where *ast.Where, reads ...ast.SQLNode) (ast.TableExprs, *ast.Where) {
+	var filter ast.Expr
+	masks := make(map[string]maskedTable)
+	restricted := make(ast.TableExprs, len(tableExprs))
+	for i, te := range tableExprs {
+		var f ast.Expr
+		restricted[i], f = b.restrictTableToChange(te, masks)
+		filter = andExprs(filter, f)
+	}
+	if len(masks) > 0 {
+		reads = append(reads, tableExprs)
+		if where != nil {
+			reads = append(reads, where)
+		}
+		for _, read := range reads {
+			b.rejectMaskedColumns(read, masks)
+		}
+	}
+	if filter == nil {
+		return restricted, where
+	}
+	if where == nil {
+		return restricted, ast.NewWhere(ast.WhereStr, filter)
+	}
+	return restricted, ast.NewWhere(ast.WhereStr, andExprs(&ast.ParenExpr{Expr: where.Expr}, filter))
+}
+
+// maskedTable is the name of a table changed by an UPDATE or DELETE statement and the masks of its columns
+type maskedTable struct {
+	name  string
+	masks map[string]columnMask
+}
+
+// rejectMaskedColumns returns ErrMaskedColumnRead if |node| reads a column of |masks|, the masked tables of an UPDATE
+// or DELETE by table name or alias. Columns that aren't qualified are taken to be of any of the tables.
+func (b *Builder) rejectMaskedColumns(node ast.SQLNode, masks map[string]maskedTable) {
+	_ = ast.Walk(func(node ast.SQLNode) (bool, error) {
+		col, ok := node.(*ast.ColName)
+		if !ok {
+			return true, nil
+		}
+		name := col.Name.Lowered()
+		for qualifier, table := range masks {
+			if !col.Qualifier.IsEmpty() && !strings.EqualFold(col.Qualifier.Name.String(), qualifier) {
+				continue
+			}
+			if mask, ok := table.masks[name]; ok {
+				b.handleErr(sql.ErrMaskedColumnRead.New(col.Name.String(), mask.policy, table.name))
+			}
+		}
+		return true, nil
+	}, node)
+}
+
+// restrictTableToChange returns |te| with the row filters of the tables in it that are on the inner side of outer
+// joins added to their ON conditions, along with the row filters of the other tables. The tables in it that have masked
+// columns are added to |masks|.
+func (b *Builder) restrictTableToChange(te ast.TableExpr, masks map[string]maskedTable) (ast.TableExpr, ast.Expr) {
+	switch te := te.(type) {
+	case *ast.AliasedTableExpr:
+		tableName, ok := te.Expr.(ast.TableName)
+		if !ok {
+			return te, nil
+		}
+		db := b.restrictedTableDb(tableName)
+		if db == "" {
+			return te, nil
+		}
+		restriction := b.tableRestriction(db, tableName.Name.String())
+		if restriction == nil {
+			return te, nil
+		}
+		if b.tablesToChange == nil {
+			b.tablesToChange = make(map[*ast.AliasedTableExpr]struct{})
+		}
+		b.tablesToChange[te] = struct{}{}
+		qualifier := tableName
+		if !te.As.IsEmpty() {
+			qualifier = ast.TableName{Name: te.As}
+		}
+		if len(restriction.masks) > 0 {
+			masks[qualifier.Name.String()] = maskedTable{name: tableName.Name.String(), masks: restriction.masks}
+		}
+		if restriction.filter == nil {
+			return te, nil
+		}
+		qualifyColumns(restriction.filter, qualifier)
+		return te, restriction.filter
+	case *ast.ParenTableExpr:
+		var filter ast.Expr
+		paren := &ast.ParenTableExpr{Exprs: make(ast.TableExprs, len(te.Exprs))}
+		for i, e := range te.Exprs {
+			var f ast.Expr
+			paren.Exprs[i], f = b.restrictTableToChange(e, masks)
+			filter = andExprs(filter, f)
+		}
+		return paren, filter
+	case *ast.JoinTableExpr:
+		join := *te
+		var leftFilter, rightFilter ast.Expr
+		join.LeftExpr, leftFilter = b.restrictTableToChange(te.LeftExpr, masks)
+		join.RightExpr, rightFilter = b.restrictTableToChange(te.RightExpr, masks)
+		if len(te.Condition.Using) == 0 {
+			switch strings.ToLower(te.Join) {
+			case ast.LeftJoinStr:
+				join.Condition.On = andExprs(te.Condition.On, rightFilter)
+				rightFilter = nil
+			case ast.RightJoinStr:
+				join.Condition.On = andExprs(te.Condition.On, leftFilter)
+				leftFilter = nil
+			}
+		}
+		return &join, andExprs(leftFilter, rightFilter)
+	default:
+		return te, nil
+	}
+}
+
+// qualifyColumns qualifies the columns in |expr| that aren't qualified with |table|, leaving those in subqueries.
+func qualifyColumns(expr ast.Expr, table ast.TableName) {
+	_ = ast.Walk(func(node ast.SQLNode) (bool, error) {
+		switch n := node.(type) {
+		case *ast.Subquery:
+			return false, nil
+		case *ast.ColName:
+			if n.Qualifier.IsEmpty() {
+				n.Qualifier = table
+			}
+		}
+		return true, nil
+	}, expr)
+}
+
+// andExprs returns the conjunction of |left| and |right|, either of which may be nil.
+func andExprs(left, right ast.Expr) ast.Expr {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	default:
+		return &ast.AndExpr{Left: left, Right: right}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+)
+
+// PrefetchRowIter is a RowIter that reads ahead of its consumer. A goroutine calls Next on the wrapped iterator and
+// buffers up to a fixed number of rows, so that the latency of fetching rows from slow storage overlaps with the work
+// of processing rows already fetched. Rows and errors are returned in the order the wrapped iterator produced them.
+type PrefetchRowIter struct {
+	iter   RowIter
+	rows   chan prefetchedRow
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+type prefetchedRow struct {
+	row Row
+	err error
+}
+
+var _ RowIter = (*PrefetchRowIter)(nil)
+
+// NewPrefetchRowIter returns a new PrefetchRowIter that keeps up to |n| rows from |iter| in flight. The wrapped
+// iterator is read in a goroutine, using its own copy of |ctx| that is cancelled when the PrefetchRowIter is closed.
+// That copy still shares the session of |ctx| with the query, so |iter| must be safe to call concurrently with the rest
+// of the query, and must not modify the session.
+func NewPrefetchRowIter(ctx *Context, iter RowIter, n int) *PrefetchRowIter {
+	if n < 1 {
+		n = 1
+	}
+	prefetchCtx, cancel := ctx.NewSubContext()
+	i := &PrefetchRowIter{
+		iter:   iter,
+		rows:   make(chan prefetchedRow, n),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go i.prefetch(prefetchCtx)
+	return i
+}
+
+// prefetch reads rows from the wrapped iterator until it returns an error, including io.EOF, or |ctx| is cancelled.
+func (i *PrefetchRowIter) prefetch(ctx *Context) {
+	defer close(i.done)
+	defer close(i.rows)
+	for {
+		row, err := i.iter.Next(ctx)
+		select {
+		case i.rows <- prefetchedRow{row: row, err: err}:
+		case <-ctx.Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Next implements the RowIter interface.
+func (i *PrefetchRowIter) Next(ctx *Context) (Row, error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+	select {
+	case r, ok := <-i.rows:
+		if !ok {
+			// the prefetching goroutine was cancelled before the wrapped iterator finished
+			i.err = context.Canceled
+			return nil, i.err
+		}
+		if r.err != nil {
+			i.err = r.err
+		}
+		return r.row, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Close implements the RowIter interface. It stops the prefetching goroutine and waits for it to exit before closing
+// the wrapped iterator.
+func (i *PrefetchRowIter) Close(ctx *Context) error {
+	i.cancel()
+	<-i.done
+	return i.iter.Close(ctx)
+}
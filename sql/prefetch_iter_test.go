@@ -0,0 +1,85 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testRowIter returns the rows 0 through n-1, followed by err.
+type testRowIter struct {
+	n      int
+	i      int
+	err    error
+	closed bool
+}
+
+func (t *testRowIter) Next(*Context) (Row, error) {
+	if t.i >= t.n {
+		return nil, t.err
+	}
+	t.i++
+	return Row{t.i - 1}, nil
+}
+
+func (t *testRowIter) Close(*Context) error {
+	t.closed = true
+	return nil
+}
+
+func TestPrefetchRowIter(t *testing.T) {
+	ctx := NewEmptyContext()
+
+	t.Run("all rows", func(t *testing.T) {
+		child := &testRowIter{n: 100, err: io.EOF}
+		rows, err := RowIterToRows(ctx, NewPrefetchRowIter(ctx, child, 4))
+		require.NoError(t, err)
+		require.Len(t, rows, 100)
+		for i, r := range rows {
+			require.Equal(t, Row{i}, r)
+		}
+		require.True(t, child.closed)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		expected := errors.New("remote error")
+		iter := NewPrefetchRowIter(ctx, &testRowIter{n: 3, err: expected}, 2)
+		for i := 0; i < 3; i++ {
+			row, err := iter.Next(ctx)
+			require.NoError(t, err)
+			require.Equal(t, Row{i}, row)
+		}
+		_, err := iter.Next(ctx)
+		require.Equal(t, expected, err)
+		_, err = iter.Next(ctx)
+		require.Equal(t, expected, err)
+		require.NoError(t, iter.Close(ctx))
+	})
+
+	t.Run("close before exhausted", func(t *testing.T) {
+		child := &testRowIter{n: 1000, err: io.EOF}
+		iter := NewPrefetchRowIter(ctx, child, 2)
+		row, err := iter.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, Row{0}, row)
+		require.NoError(t, iter.Close(ctx))
+		require.True(t, child.closed)
+		require.Less(t, child.i, 1000)
+	})
+}
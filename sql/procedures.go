@@ -36,9 +36,21 @@ type StoredProcedureDetails struct {
 	CreatedAt       time.Time // The time that the stored procedure was created.
 	ModifiedAt      time.Time // The time of the last modification to the stored procedure.
 	SqlMode         string    // The SQL_MODE when this procedure was defined.
+	Definer         string    // The account that defined this procedure, used when CreateStatement has no DEFINER clause.
 	SchemaName      string    // The name of the schema that this stored procedure belongs to, for databases that support schemas.
 }
 
+// StoredFunctionDetails are the details of a stored function written in SQL. Integrators only need to store and
+// retrieve the given details for a stored function, as the engine handles all parsing and processing.
+type StoredFunctionDetails struct {
+	Name            string    // The name of this stored function. Names must be unique within a database.
+	CreateStatement string    // The CREATE statement for this stored function.
+	CreatedAt       time.Time // The time that the stored function was created.
+	ModifiedAt      time.Time // The time of the last modification to the stored function.
+	SqlMode         string    // The SQL_MODE when this function was defined.
+	Definer         string    // The account that defined this function, used when CreateStatement has no DEFINER clause.
+}
+
 // ExternalStoredProcedureDetails are the details of an external stored procedure. Compared to standard stored
 // procedures, external ones are considered "built-in", in that they're not created by the user, and may not be modified
 // or deleted by a user. In addition, they're implemented as a function taking standard parameters, compared to stored
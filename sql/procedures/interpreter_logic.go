@@ -378,52 +378,55 @@ func query(ctx *sql.Context, runner sql.StatementRunner, stmt ast.Statement) (sq
 	return sch, sql.RowsToRowIter(rows...), nil
 }
 
-// handleError handles errors that occur during the execution of a procedure according to the defined handlers.
-func handleError(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, statements []*InterpreterOperation, counter int, err error) (int, error) {
-	if err == nil {
-		return counter, nil
-	}
+// notFoundCondition is the condition raised by a FETCH from a cursor that has no more rows.
+var notFoundCondition = mysql.NewSQLError(1329, "02000", "No data - zero rows fetched, selected, or processed")
 
-	var matchingHandler *InterpreterHandler
-	for _, handler := range stack.ListHandlers() {
-		if errors.Is(err, expression.FetchEOF) {
-			if handler.Condition == ast.DeclareHandlerCondition_NotFound {
-				matchingHandler = handler
-			}
-			break
-		}
-		switch handler.Condition {
-		case ast.DeclareHandlerCondition_MysqlErrorCode:
-		case ast.DeclareHandlerCondition_SqlState:
-		case ast.DeclareHandlerCondition_ConditionName:
-		case ast.DeclareHandlerCondition_SqlWarning:
-		case ast.DeclareHandlerCondition_NotFound:
-		case ast.DeclareHandlerCondition_SqlException:
-			matchingHandler = handler
-			break
-		}
+// conditionOf returns the condition raised by |err|, which handlers of the procedure may handle.
+func conditionOf(err error) *mysql.SQLError {
+	if errors.Is(err, expression.FetchEOF) {
+		return notFoundCondition
 	}
+	return sql.CastSQLError(err)
+}
 
-	if matchingHandler == nil {
-		return -1, err
+// handleError handles errors that occur during the execution of a procedure according to the defined handlers. A
+// warning raised by SIGNAL that isn't handled is added to the session, and execution continues.
+func handleError(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, statements []*InterpreterOperation, counter int, err error, asOf *ast.AsOf) (int, error) {
+	if err == nil {
+		return counter, nil
 	}
 
-	handlerOps := make([]*InterpreterOperation, 0, 1)
-	err = ConvertStmt(&handlerOps, stack, matchingHandler.Statement)
-	if err != nil {
+	cond := conditionOf(err)
+	matchingHandler := stack.FindHandler(cond)
+	if matchingHandler == nil {
+		if strings.HasPrefix(cond.State, "01") {
+			ctx.Session.Warn(&sql.Warning{
+				Level:   "Warning",
+				Code:    cond.Num,
+				Message: cond.Message,
+				State:   cond.State,
+			})
+			return counter, nil
+		}
+		if cond == notFoundCondition {
+			return -1, cond
+		}
 		return -1, err
 	}
 
-	_, _, _, rowIter, err := execOp(ctx, runner, stack, handlerOps[0], handlerOps, nil, -1)
+	prevDepth := stack.PushHandled(matchingHandler, cond)
+	restoreDiagnostics := pushDiagnostics(ctx, stack, cond)
+	err = execHandler(ctx, runner, stack, matchingHandler, asOf)
+	restoreDiagnostics()
+	stack.PopHandled(prevDepth)
 	if err != nil {
-		return -1, err
-	}
-	if rowIter != nil {
-		for {
-			_, err = rowIter.Next(ctx)
-			if err != nil {
-				return -1, err
-			}
+		// an error raised by the statement of a handler may be handled by the handlers of enclosing scopes. Once a
+		// CONTINUE handler has handled it, this handler completes with its own action.
+		prevDepth = stack.PushHandled(matchingHandler, cond)
+		hCounter, hErr := handleError(ctx, runner, stack, statements, counter, err, asOf)
+		stack.PopHandled(prevDepth)
+		if hErr != nil {
+			return hCounter, hErr
 		}
 	}
 
@@ -431,6 +434,10 @@ func handleError(ctx *sql.Context, runner sql.StatementRunner, stack *Interprete
 	case ast.DeclareHandlerAction_Continue:
 		return counter, nil
 	case ast.DeclareHandlerAction_Exit:
+		// leave the block that the handler was declared in, along with any blocks nested in it
+		for stack.stack.Len() >= matchingHandler.Depth {
+			stack.PopScope(ctx)
+		}
 		remainingEndScopes := 1
 		var newCounter int
 		for newCounter = matchingHandler.Counter; newCounter < len(statements); newCounter++ {
@@ -452,6 +459,275 @@ func handleError(ctx *sql.Context, runner sql.StatementRunner, stack *Interprete
 	return counter, nil
 }
 
+// pushDiagnostics adds |cond|, the condition that a handler is about to handle, to the diagnostics area of the session,
+// and saves a copy of the area as the stacked diagnostics area that GET STACKED DIAGNOSTICS reads while the handler
+// executes. The returned function restores the diagnostics area once the handler completes, without the handled
+// condition.
+func pushDiagnostics(ctx *sql.Context, stack *InterpreterStack, cond *mysql.SQLError) func() {
+	prevArea := sql.DiagnosticsArea(ctx)
+	level := "Error"
+	if strings.HasPrefix(cond.State, "01") || strings.HasPrefix(cond.State, "02") {
+		level = "Warning"
+	}
+	ctx.Session.Warn(&sql.Warning{
+		Level:   level,
+		Code:    cond.Num,
+		Message: cond.Message,
+		State:   cond.State,
+	})
+	stack.PushDiagnostics(sql.DiagnosticsArea(ctx))
+	return func() {
+		stack.PopDiagnostics()
+		ctx.Session.ClearWarnings()
+		for _, w := range prevArea {
+			ctx.Session.Warn(w)
+		}
+	}
+}
+
+// execHandler executes the statement of |handler| in the scope that the handler was declared in, so that variables
+// declared in deeper scopes aren't visible to it. Errors raised by the statement are returned rather than handled.
+func execHandler(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, handler *InterpreterHandler, asOf *ast.AsOf) error {
+	handlerOps, err := Parse(handler.Statement)
+	if err != nil {
+		return err
+	}
+
+	var detached []*InterpreterScopeDetails
+	for stack.stack.Len() > handler.Depth {
+		detached = append(detached, stack.stack.Pop())
+	}
+	defer func() {
+		// the statement may have failed within a block of its own
+		for stack.stack.Len() > handler.Depth {
+			stack.PopScope(ctx)
+		}
+		for i := len(detached) - 1; i >= 0; i-- {
+			stack.stack.Push(detached[i])
+		}
+	}()
+
+	for counter := 0; counter < len(handlerOps); counter++ {
+		newCounter, _, _, rowIter, err := execOp(ctx, runner, stack, handlerOps[counter], handlerOps, asOf, counter)
+		if err != nil {
+			return err
+		}
+		if rowIter != nil {
+			if _, err = sql.RowIterToRows(ctx, rowIter); err != nil {
+				return err
+			}
+		}
+		counter = newCounter
+	}
+	return nil
+}
+
+// signal returns the condition raised by |s|, a SIGNAL or RESIGNAL statement. For a RESIGNAL, |handled| is the
+// condition being handled, whose SQLSTATE and condition information items are kept unless |s| replaces them.
+func signal(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, s *ast.Signal, handled *mysql.SQLError, asOf *ast.AsOf) error {
+	var msgTxt string
+	var sqlState string
+	var mysqlErrNo int
+	if handled != nil {
+		sqlState, mysqlErrNo, msgTxt = handled.State, handled.Num, handled.Message
+	}
+	if s.ConditionName != "" {
+		cond := stack.GetCondition(s.ConditionName)
+		if cond == nil {
+			return sql.ErrDeclareConditionNotFound.New(s.ConditionName)
+		}
+		if cond.SQLState == "" {
+			return sql.ErrSignalOnlySqlState.New()
+		}
+		sqlState = cond.SQLState
+	} else if s.SqlStateValue != "" {
+		sqlState = s.SqlStateValue
+	}
+
+	if len(sqlState) != 5 {
+		return fmt.Errorf("SQLSTATE VALUE must be a string with length 5 consisting of only integers")
+	}
+
+	for _, item := range s.Info {
+		val, err := signalItemValue(ctx, runner, stack, item, asOf)
+		if err != nil {
+			return err
+		}
+		switch item.ConditionItemName {
+		case ast.SignalConditionItemName_MysqlErrno:
+			num, err := strconv.ParseInt(val, 10, 64)
+			if err != nil || num == 0 {
+				return fmt.Errorf("invalid value '%s' for MySQL error code", val)
+			}
+			mysqlErrNo = int(num)
+		case ast.SignalConditionItemName_MessageText:
+			if len(val) > 128 {
+				return fmt.Errorf("signal condition information item MESSAGE_TEXT has max length of 128")
+			}
+			msgTxt = val
+		default:
+			if len(val) > 64 {
+				return fmt.Errorf("signal condition information item %s has max length of 64", strings.ToUpper(string(item.ConditionItemName)))
+			}
+		}
+	}
+
+	if mysqlErrNo == 0 {
+		switch sqlState[0:2] {
+		case "01":
+			mysqlErrNo = 1642
+		case "02":
+			mysqlErrNo = 1643
+		default:
+			mysqlErrNo = 1644
+		}
+	}
+
+	if msgTxt == "" {
+		switch sqlState[0:2] {
+		case "00":
+			return fmt.Errorf("invalid SQLSTATE VALUE: '%s'", sqlState)
+		case "01":
+			msgTxt = "Unhandled user-defined warning condition"
+		case "02":
+			msgTxt = "Unhandled user-defined not found condition"
+		default:
+			msgTxt = "Unhandled user-defined exception condition"
+		}
+	}
+
+	return mysql.NewSQLError(mysqlErrNo, sqlState, "%s", msgTxt)
+}
+
+// variableValue returns the value of the variable |iv|. A variable that hasn't been set since its declaration holds its
+// default as an expression, which is evaluated here.
+func variableValue(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, iv *InterpreterVariable, asOf *ast.AsOf) (any, error) {
+	expr, ok := iv.Value.(ast.Expr)
+	if !ok {
+		return iv.Value, nil
+	}
+	newExpr, err := replaceVariablesInExpr(ctx, stack, expr, asOf)
+	if err != nil {
+		return nil, err
+	}
+	selectStmt := &ast.Select{
+		SelectExprs: ast.SelectExprs{
+			&ast.AliasedExpr{
+				Expr: newExpr.(ast.Expr),
+			},
+		},
+	}
+	_, rowIter, _, err := runner.QueryWithBindings(ctx, "", selectStmt, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, rowIter)
+	if err != nil {
+		return nil, err
+	}
+	val, _, err := iv.Type.Convert(ctx, rows[0][0])
+	return val, err
+}
+
+// signalItemValue returns the value of |item|, a condition information item of a SIGNAL or RESIGNAL statement. Its
+// value may be a literal or an expression, such as a variable.
+func signalItemValue(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, item ast.SignalInfo, asOf *ast.AsOf) (string, error) {
+	if val, ok := item.Value.(*ast.SQLVal); ok {
+		return string(val.Val), nil
+	}
+	val, err := exprValue(ctx, runner, stack, item.Value, asOf)
+	if err != nil {
+		return "", err
+	}
+	if val == nil {
+		return "", fmt.Errorf("invalid value '%v' for signal condition information item '%s'", ast.String(item.Value), strings.ToUpper(string(item.ConditionItemName)))
+	}
+	val, _, err = types.LongText.Convert(ctx, val)
+	if err != nil {
+		return "", err
+	}
+	return val.(string), nil
+}
+
+// exprValue returns the value of |expr|, which may reference the variables of the procedure.
+func exprValue(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, expr ast.Expr, asOf *ast.AsOf) (any, error) {
+	newExpr, err := replaceVariablesInExpr(ctx, stack, expr, asOf)
+	if err != nil {
+		return nil, err
+	}
+	selectStmt := &ast.Select{
+		SelectExprs: ast.SelectExprs{
+			&ast.AliasedExpr{
+				Expr: newExpr.(ast.Expr),
+			},
+		},
+	}
+	_, rowIter, _, err := runner.QueryWithBindings(ctx, "", selectStmt, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, rowIter)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != 1 {
+		return nil, sql.ErrExpectedSingleRow.New()
+	}
+	return rows[0][0], nil
+}
+
+// getDiagnostics executes |g|, a GET DIAGNOSTICS statement, which assigns information from the current diagnostics area
+// of the session, or from the stacked diagnostics area of the executing handler, to variables.
+func getDiagnostics(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, g *ast.GetDiagnostics, asOf *ast.AsOf) error {
+	area := sql.DiagnosticsArea(ctx)
+	if g.Stacked {
+		var ok bool
+		if area, ok = stack.StackedDiagnostics(); !ok {
+			return sql.ErrGetStackedDiagnosticsWithoutHandler.New()
+		}
+	}
+
+	var cond *sql.Warning
+	if g.ConditionNumber != nil {
+		num, err := exprValue(ctx, runner, stack, g.ConditionNumber, asOf)
+		if err != nil {
+			return err
+		}
+		num, _, err = types.Int64.Convert(ctx, num)
+		if err != nil || num == nil {
+			return sql.ErrInvalidConditionNumber.New()
+		}
+		if cond, err = sql.DiagnosticsCondition(area, num.(int64)); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range g.Items {
+		var val any
+		if cond != nil {
+			val = sql.ConditionInformation(cond, string(item.Name))
+		} else {
+			var err error
+			if val, err = sql.StatementInformation(ctx, area, string(item.Name)); err != nil {
+				return err
+			}
+		}
+		varName := strings.ToLower(item.Target)
+		if strings.HasPrefix(varName, "@") {
+			if err := ctx.SetUserVariable(ctx, strings.TrimPrefix(varName, "@"), val, types.ApproximateTypeFromValue(val)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := stack.SetVariable(varName, val); err != nil {
+			if err = ctx.Session.SetStoredProcParam(varName, val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, operation *InterpreterOperation, statements []*InterpreterOperation, asOf *ast.AsOf, counter int) (int, sql.Schema, sql.RowIter, sql.RowIter, error) {
 	switch operation.OpCode {
 	case OpCode_Select:
@@ -473,20 +749,40 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 			return counter, sch, rowIter, rowIter, nil
 		}
 
+		// the statement is run again by loops and later calls, so it keeps its INTO clause
 		selectInto := selectStmt.Into
-		selectStmt.Into = nil
-		schema, rowIter, _, err := runner.QueryWithBindings(ctx, "", selectStmt, nil, nil)
+		withoutInto := *selectStmt
+		withoutInto.Into = nil
+		schema, rowIter, _, err := runner.QueryWithBindings(ctx, "", &withoutInto, nil, nil)
 		if err != nil {
 			return 0, nil, nil, nil, err
 		}
 		row, err := rowIter.Next(ctx)
-		if err != nil {
+		if err == io.EOF {
+			if err = rowIter.Close(ctx); err != nil {
+				return 0, nil, nil, nil, err
+			}
+			// selecting no rows leaves the variables unchanged, and is only a warning unless a handler handles it
+			if stack.FindHandler(notFoundCondition) != nil {
+				return 0, nil, nil, nil, notFoundCondition
+			}
+			ctx.Session.Warn(&sql.Warning{
+				Level:   "Warning",
+				Code:    notFoundCondition.Num,
+				Message: notFoundCondition.Message,
+				State:   notFoundCondition.State,
+			})
+			break
+		} else if err != nil {
 			return 0, nil, nil, nil, err
 		}
 		if _, err = rowIter.Next(ctx); err != io.EOF {
 			if rErr := rowIter.Close(ctx); rErr != nil {
 				return 0, nil, nil, nil, rErr
 			}
+			if err == nil {
+				err = sql.ErrMoreThanOneRow.New()
+			}
 			return 0, nil, nil, nil, err
 		}
 		if err = rowIter.Close(ctx); err != nil {
@@ -544,16 +840,26 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 		}
 
 		if handler := declareStmt.Handler; handler != nil {
-			if len(handler.ConditionValues) != 1 {
-				return 0, nil, nil, nil, sql.ErrUnsupportedSyntax.New(ast.String(declareStmt))
-			}
-
-			hCond := handler.ConditionValues[0]
-			switch hCond.ValueType {
-			case ast.DeclareHandlerCondition_NotFound:
-			case ast.DeclareHandlerCondition_SqlException:
-			default:
-				return 0, nil, nil, nil, sql.ErrUnsupportedSyntax.New(ast.String(declareStmt))
+			conds := make([]ast.DeclareHandlerCondition, len(handler.ConditionValues))
+			for i, hCond := range handler.ConditionValues {
+				if hCond.ValueType == ast.DeclareHandlerCondition_ConditionName {
+					cond := stack.GetCondition(hCond.String)
+					if cond == nil {
+						return 0, nil, nil, nil, sql.ErrDeclareConditionNotFound.New(hCond.String)
+					}
+					if cond.SQLState != "" {
+						hCond = ast.DeclareHandlerCondition{
+							ValueType: ast.DeclareHandlerCondition_SqlState,
+							String:    cond.SQLState,
+						}
+					} else {
+						hCond = ast.DeclareHandlerCondition{
+							ValueType:      ast.DeclareHandlerCondition_MysqlErrorCode,
+							MysqlErrorCode: ast.NewIntVal([]byte(strconv.FormatInt(cond.MySQLErrCode, 10))),
+						}
+					}
+				}
+				conds[i] = hCond
 			}
 
 			switch handler.Action {
@@ -563,7 +869,7 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 				return 0, nil, nil, nil, fmt.Errorf("unsupported handler action: %s", handler.Action)
 			}
 
-			stack.NewHandler(hCond.ValueType, handler.Action, handler.Statement, counter)
+			stack.NewHandler(conds, handler.Action, handler.Statement, counter)
 		}
 
 		if vars := declareStmt.Variables; vars != nil {
@@ -574,7 +880,8 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 				}
 				varName := strings.ToLower(decl.String())
 				if vars.VarType.Default == nil {
-					stack.NewVariable(varName, varType)
+					// variables declared without a DEFAULT start out as NULL
+					stack.NewVariableWithValue(varName, varType, nil)
 					continue
 				}
 				stack.NewVariableWithValue(varName, varType, vars.VarType.Default)
@@ -583,93 +890,22 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 
 	case OpCode_Signal:
 		signalStmt := operation.PrimaryData.(*ast.Signal)
-		var msgTxt string
-		var sqlState string
-		var mysqlErrNo int
-		if signalStmt.ConditionName == "" {
-			sqlState = signalStmt.SqlStateValue
-			if sqlState[0:2] == "01" {
-				return 0, nil, nil, nil, fmt.Errorf("warnings not yet implemented")
-			}
-		} else {
-			cond := stack.GetCondition(signalStmt.ConditionName)
-			if cond == nil {
-				return 0, nil, nil, nil, sql.ErrDeclareConditionNotFound.New(signalStmt.ConditionName)
-			}
-			sqlState = cond.SQLState
-			mysqlErrNo = int(cond.MySQLErrCode)
-		}
-
-		if len(sqlState) != 5 {
-			return 0, nil, nil, nil, fmt.Errorf("SQLSTATE VALUE must be a string with length 5 consisting of only integers")
-		}
-
-		for _, item := range signalStmt.Info {
-			switch item.ConditionItemName {
-			case ast.SignalConditionItemName_MysqlErrno:
-				switch val := item.Value.(type) {
-				case *ast.SQLVal:
-					num, err := strconv.ParseInt(string(val.Val), 10, 64)
-					if err != nil || num == 0 {
-						return 0, nil, nil, nil, fmt.Errorf("invalid value '%s' for MySQL error code", string(val.Val))
-					}
-					mysqlErrNo = int(num)
-				case *ast.ColName:
-					return 0, nil, nil, nil, fmt.Errorf("unsupported signal message text type: %T", val)
-				default:
-					return 0, nil, nil, nil, fmt.Errorf("invalid value '%v' for signal condition information item MESSAGE_TEXT", val)
-				}
-			case ast.SignalConditionItemName_MessageText:
-				switch val := item.Value.(type) {
-				case *ast.SQLVal:
-					msgTxt = string(val.Val)
-					if len(msgTxt) > 128 {
-						return 0, nil, nil, nil, fmt.Errorf("signal condition information item MESSAGE_TEXT has max length of 128")
-					}
-				case *ast.ColName:
-					return 0, nil, nil, nil, fmt.Errorf("unsupported signal message text type: %T", val)
-				default:
-					return 0, nil, nil, nil, fmt.Errorf("invalid value '%v' for signal condition information item MESSAGE_TEXT", val)
-				}
-			default:
-				switch val := item.Value.(type) {
-				case *ast.SQLVal:
-					msgTxt = string(val.Val)
-					if len(msgTxt) > 64 {
-						return 0, nil, nil, nil, fmt.Errorf("signal condition information item %s has max length of 64", strings.ToUpper(string(item.ConditionItemName)))
-					}
-				default:
-					return 0, nil, nil, nil, fmt.Errorf("invalid value '%v' for signal condition information item '%s''", item.Value, strings.ToUpper(string(item.ConditionItemName)))
-				}
-			}
-		}
+		return 0, nil, nil, nil, signal(ctx, runner, stack, signalStmt, nil, asOf)
 
-		if mysqlErrNo == 0 {
-			switch sqlState[0:2] {
-			case "01":
-				mysqlErrNo = 1642
-			case "02":
-				mysqlErrNo = 1643
-			default:
-				mysqlErrNo = 1644
-			}
+	case OpCode_Resignal:
+		resignalStmt := operation.PrimaryData.(*ast.Resignal)
+		handled := stack.Handled()
+		if handled == nil {
+			return 0, nil, nil, nil, sql.ErrResignalWithoutHandler.New()
 		}
+		return 0, nil, nil, nil, signal(ctx, runner, stack, &resignalStmt.Signal, handled, asOf)
 
-		if msgTxt == "" {
-			switch sqlState[0:2] {
-			case "00":
-				return 0, nil, nil, nil, fmt.Errorf("invalid SQLSTATE VALUE: '%s'", sqlState)
-			case "01":
-				msgTxt = "Unhandled user-defined warning condition"
-			case "02":
-				msgTxt = "Unhandled user-defined not found condition"
-			default:
-				msgTxt = "Unhandled user-defined exception condition"
-			}
+	case OpCode_GetDiagnostics:
+		getDiagnosticsStmt := operation.PrimaryData.(*ast.GetDiagnostics)
+		if err := getDiagnostics(ctx, runner, stack, getDiagnosticsStmt, asOf); err != nil {
+			return 0, nil, nil, nil, err
 		}
 
-		return 0, nil, nil, nil, mysql.NewSQLError(mysqlErrNo, sqlState, "%s", msgTxt)
-
 	case OpCode_Open:
 		openCur := operation.PrimaryData.(*ast.OpenCursor)
 		cursor := stack.GetCursor(openCur.Name)
@@ -720,7 +956,10 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 			}
 			err = stack.SetVariable(varName, row[i])
 			if err != nil {
-				return 0, nil, nil, nil, err
+				err = ctx.Session.SetStoredProcParam(varName, row[i])
+				if err != nil {
+					return 0, nil, nil, nil, err
+				}
 			}
 		}
 
@@ -851,8 +1090,9 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 	case OpCode_Goto:
 		// We must compare to the index - 1, so that the increment hits our target
 		if counter <= operation.Index {
+			// every operation that is skipped over is considered, so that the scopes of blocks that are left are ended
 			for ; counter < operation.Index-1; counter++ {
-				switch statements[counter].OpCode {
+				switch statements[counter+1].OpCode {
 				case OpCode_ScopeBegin:
 					stack.PushScope()
 				case OpCode_ScopeEnd:
@@ -863,9 +1103,6 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 			}
 		} else {
 			for ; counter > operation.Index-1; counter-- {
-				if counter == -1 {
-					print()
-				}
 				switch statements[counter].OpCode {
 				case OpCode_ScopeBegin:
 					stack.PopScope(ctx)
@@ -882,6 +1119,25 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 		if err != nil {
 			return 0, nil, nil, nil, err
 		}
+		// put stack variables used by EXECUTE ... USING into session variables
+		if executeStmt, ok := stmt.(*ast.Execute); ok {
+			for _, varName := range executeStmt.VarList {
+				if strings.HasPrefix(varName, "@") {
+					continue
+				}
+				iv := stack.GetVariable(varName)
+				if iv == nil {
+					continue
+				}
+				val, err := variableValue(ctx, runner, stack, iv, asOf)
+				if err != nil {
+					return 0, nil, nil, nil, err
+				}
+				spp := ctx.Session.NewStoredProcParam(varName, &sql.StoredProcParam{})
+				spp.Type = iv.Type
+				spp.Value = val
+			}
+		}
 		sch, rowIter, err := query(ctx, runner, stmt.(ast.Statement))
 		if err != nil {
 			return 0, nil, nil, nil, err
@@ -891,6 +1147,28 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 	case OpCode_Exception:
 		return 0, nil, nil, nil, operation.Error
 
+	case OpCode_Return:
+		selectStmt := operation.PrimaryData.(*ast.Select)
+		for i := range selectStmt.SelectExprs {
+			newNode, err := replaceVariablesInExpr(ctx, stack, selectStmt.SelectExprs[i], asOf)
+			if err != nil {
+				return 0, nil, nil, nil, err
+			}
+			selectStmt.SelectExprs[i] = newNode.(ast.SelectExpr)
+		}
+		_, rowIter, _, err := runner.QueryWithBindings(ctx, "", selectStmt, nil, nil)
+		if err != nil {
+			return 0, nil, nil, nil, err
+		}
+		rows, err := sql.RowIterToRows(ctx, rowIter)
+		if err != nil {
+			return 0, nil, nil, nil, err
+		}
+		stack.SetReturnValue(rows[0][0])
+		// the function ends here, so the cursors of the scopes that are left open are closed
+		stack.CloseCursors(ctx)
+		return len(statements), nil, nil, nil, nil
+
 	case OpCode_ScopeBegin:
 		stack.PushScope()
 
@@ -907,7 +1185,6 @@ func execOp(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStac
 // Call runs the contained operations on the given runner.
 func Call(ctx *sql.Context, iNode InterpreterNode) (sql.RowIter, *InterpreterStack, error) {
 	// Set up the initial state of the function
-	counter := -1 // We increment before accessing, so start at -1
 	stack := NewInterpreterStack()
 
 	var asOf *ast.AsOf
@@ -926,17 +1203,49 @@ func Call(ctx *sql.Context, iNode InterpreterNode) (sql.RowIter, *InterpreterSta
 		}
 	}
 
-	var selIter sql.RowIter
-	var selSch sql.Schema
-
-	// Run the statements
-	var rowIters []sql.RowIter
-	var retSch sql.Schema
-	runner := iNode.GetRunner()
-	statements := iNode.GetStatements()
 	if dbNode, isDbNode := iNode.(sql.Databaser); isDbNode {
 		stack.SetDatabase(dbNode.Database().Name())
 	}
+	selIter, selSch, rowIters, retSch, err := run(ctx, iNode.GetRunner(), stack, iNode.GetStatements(), asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if selIter != nil {
+		iNode.SetSchema(selSch)
+		return selIter, stack, nil
+	}
+	if len(rowIters) == 0 {
+		iNode.SetSchema(types.OkResultSchema)
+		rowIters = append(rowIters, sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}))
+	} else if retSch != nil {
+		iNode.SetSchema(retSch)
+	} else {
+		// If we have rowIters but no meaningful schema, return OkResult
+		// This ensures CALL statements always return proper result sets for MySQL protocol
+		iNode.SetSchema(types.OkResultSchema)
+		rowIters = []sql.RowIter{sql.RowsToRowIter(sql.Row{types.NewOkResult(0)})}
+	}
+
+	return rowIters[len(rowIters)-1], stack, nil
+}
+
+// CallFunction runs the |statements| of a stored function on the given runner, with its parameters already declared
+// in |stack|. It returns the value of the RETURN statement that ended the function, or false if the function ended
+// without one.
+func CallFunction(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, statements []*InterpreterOperation) (any, bool, error) {
+	_, _, _, _, err := run(ctx, runner, stack, statements, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	val, ok := stack.ReturnValue()
+	return val, ok, nil
+}
+
+// run runs |statements| on the given runner. It returns the iterator and schema of the last SELECT, and the
+// iterators of the other statements that returned rows along with the schema of the last of them.
+func run(ctx *sql.Context, runner sql.StatementRunner, stack *InterpreterStack, statements []*InterpreterOperation, asOf *ast.AsOf) (selIter sql.RowIter, selSch sql.Schema, rowIters []sql.RowIter, retSch sql.Schema, err error) {
+	counter := -1 // We increment before accessing, so start at -1
 	for {
 		counter++
 		if counter < 0 {
@@ -952,9 +1261,10 @@ func Call(ctx *sql.Context, iNode InterpreterNode) (sql.RowIter, *InterpreterSta
 		operation := statements[counter]
 		newCounter, sch, newSelIter, rowIter, err := execOp(subCtx, runner, stack, operation, statements, asOf, counter)
 		if err != nil {
-			hCounter, hErr := handleError(subCtx, runner, stack, statements, counter, err)
+			hCounter, hErr := handleError(subCtx, runner, stack, statements, counter, err, asOf)
 			if hErr != nil && hErr != io.EOF {
-				return nil, nil, hErr
+				stack.CloseCursors(ctx)
+				return nil, nil, nil, nil, hErr
 			}
 			if hErr == io.EOF {
 				newCounter = hCounter
@@ -972,22 +1282,5 @@ func Call(ctx *sql.Context, iNode InterpreterNode) (sql.RowIter, *InterpreterSta
 		}
 		counter = newCounter
 	}
-
-	if selIter != nil {
-		iNode.SetSchema(selSch)
-		return selIter, stack, nil
-	}
-	if len(rowIters) == 0 {
-		iNode.SetSchema(types.OkResultSchema)
-		rowIters = append(rowIters, sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}))
-	} else if retSch != nil {
-		iNode.SetSchema(retSch)
-	} else {
-		// If we have rowIters but no meaningful schema, return OkResult
-		// This ensures CALL statements always return proper result sets for MySQL protocol
-		iNode.SetSchema(types.OkResultSchema)
-		rowIters = []sql.RowIter{sql.RowsToRowIter(sql.Row{types.NewOkResult(0)})}
-	}
-
-	return rowIters[len(rowIters)-1], stack, nil
+	return selIter, selSch, rowIters, retSch, nil
 }
@@ -19,6 +19,8 @@ const (
 	OpCode_Select OpCode = iota
 	OpCode_Declare
 	OpCode_Signal
+	OpCode_Resignal
+	OpCode_GetDiagnostics
 	OpCode_Open
 	OpCode_Fetch
 	OpCode_Close
@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
+	"github.com/dolthub/vitess/go/mysql"
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 )
 
@@ -97,12 +98,14 @@ type InterpreterCursor struct {
 	Schema     sql.Schema
 }
 
-// InterpreterHandler is a declare handler that specifies an Action during an error Condition.
+// InterpreterHandler is a declare handler that specifies an Action during any of its error Conditions. Conditions
+// named by a DECLARE ... CONDITION are resolved to their MySQL error code or SQLSTATE when the handler is declared.
 type InterpreterHandler struct {
-	Statement ast.Statement
-	Condition ast.DeclareHandlerConditionValue
-	Action    ast.DeclareHandlerAction
-	Counter   int // This is used to track the current position in the stack for the handler
+	Statement  ast.Statement
+	Conditions []ast.DeclareHandlerCondition
+	Action     ast.DeclareHandlerAction
+	Counter    int // This is used to track the current position in the stack for the handler
+	Depth      int // This is the depth of the scope that the handler was declared in
 }
 
 // InterpreterVariable is a variable that lives on the stack.
@@ -163,6 +166,17 @@ type InterpreterScopeDetails struct {
 // general purpose.
 type InterpreterStack struct {
 	stack *Stack[*InterpreterScopeDetails]
+
+	// handled are the conditions being handled by the handlers that are executing, with the innermost last.
+	handled []*mysql.SQLError
+	// stackedDiagnostics are the stacked diagnostics areas of the handlers that are executing, with the innermost last.
+	stackedDiagnostics [][]*sql.Warning
+	// handlerDepth is the depth of the scope of the innermost handler that is executing, or zero if none are. Handlers
+	// declared at this depth or deeper are not active.
+	handlerDepth int
+	// returnValue is the value of the RETURN statement that ended a stored function, if returned is true.
+	returnValue any
+	returned    bool
 }
 
 // NewInterpreterStack creates a new InterpreterStack.
@@ -283,12 +297,13 @@ func (is *InterpreterStack) GetCursor(name string) *InterpreterCursor {
 }
 
 // NewHandler creates a new handler in the current scope.
-func (is *InterpreterStack) NewHandler(cond ast.DeclareHandlerConditionValue, action ast.DeclareHandlerAction, stmt ast.Statement, counter int) {
+func (is *InterpreterStack) NewHandler(conds []ast.DeclareHandlerCondition, action ast.DeclareHandlerAction, stmt ast.Statement, counter int) {
 	is.stack.Peek().handlers = append(is.stack.Peek().handlers, &InterpreterHandler{
-		Condition: cond,
-		Action:    action,
-		Statement: stmt,
-		Counter:   counter,
+		Conditions: conds,
+		Action:     action,
+		Statement:  stmt,
+		Counter:    counter,
+		Depth:      is.stack.Len(),
 	})
 }
 
@@ -303,6 +318,106 @@ func (is *InterpreterStack) ListHandlers() []*InterpreterHandler {
 	return handlers
 }
 
+// FindHandler returns the handler for |cond|, or nil if there is none. Handlers of the innermost scope are preferred,
+// and within a scope, handlers for a MySQL error code are preferred over those for a SQLSTATE, which are preferred over
+// those for a class of conditions, such as SQLEXCEPTION.
+func (is *InterpreterStack) FindHandler(cond *mysql.SQLError) *InterpreterHandler {
+	for i := 0; i < is.stack.Len(); i++ {
+		if is.handlerDepth > 0 && is.stack.Len()-i >= is.handlerDepth {
+			continue
+		}
+		var match *InterpreterHandler
+		var matchPrecedence int
+		for _, handler := range is.stack.PeekDepth(i).handlers {
+			for _, hCond := range handler.Conditions {
+				if precedence := handlerPrecedence(hCond, cond); precedence > matchPrecedence {
+					match, matchPrecedence = handler, precedence
+				}
+			}
+		}
+		if match != nil {
+			return match
+		}
+	}
+	return nil
+}
+
+// handlerPrecedence returns the precedence of a handler for |hCond| when handling |cond|, or zero if it doesn't
+// handle it.
+func handlerPrecedence(hCond ast.DeclareHandlerCondition, cond *mysql.SQLError) int {
+	var class string
+	if len(cond.State) >= 2 {
+		class = cond.State[0:2]
+	}
+	switch hCond.ValueType {
+	case ast.DeclareHandlerCondition_MysqlErrorCode:
+		if num, err := strconv.Atoi(string(hCond.MysqlErrorCode.Val)); err == nil && num == cond.Num {
+			return 3
+		}
+	case ast.DeclareHandlerCondition_SqlState:
+		if hCond.String == cond.State {
+			return 2
+		}
+	case ast.DeclareHandlerCondition_SqlWarning:
+		if class == "01" {
+			return 1
+		}
+	case ast.DeclareHandlerCondition_NotFound:
+		if class == "02" {
+			return 1
+		}
+	case ast.DeclareHandlerCondition_SqlException:
+		if class != "00" && class != "01" && class != "02" {
+			return 1
+		}
+	}
+	return 0
+}
+
+// PushHandled records that |cond| is being handled by |handler|, whose statement is about to be executed. Until the
+// matching call to PopHandled, |cond| is the condition that RESIGNAL signals, and the handlers declared in the same
+// scope as |handler| or deeper are not active. Returns the depth of the previous innermost handler.
+func (is *InterpreterStack) PushHandled(handler *InterpreterHandler, cond *mysql.SQLError) int {
+	is.handled = append(is.handled, cond)
+	prevDepth := is.handlerDepth
+	is.handlerDepth = handler.Depth
+	return prevDepth
+}
+
+// PopHandled records that the innermost handler finished executing, restoring |prevDepth| as returned by PushHandled.
+func (is *InterpreterStack) PopHandled(prevDepth int) {
+	is.handled = is.handled[:len(is.handled)-1]
+	is.handlerDepth = prevDepth
+}
+
+// Handled returns the condition being handled by the innermost executing handler, or nil if no handler is executing.
+func (is *InterpreterStack) Handled() *mysql.SQLError {
+	if len(is.handled) == 0 {
+		return nil
+	}
+	return is.handled[len(is.handled)-1]
+}
+
+// PushDiagnostics records |area| as the stacked diagnostics area of the handler that is about to be executed, which
+// GET STACKED DIAGNOSTICS reads until the matching call to PopDiagnostics.
+func (is *InterpreterStack) PushDiagnostics(area []*sql.Warning) {
+	is.stackedDiagnostics = append(is.stackedDiagnostics, area)
+}
+
+// PopDiagnostics removes the stacked diagnostics area of the innermost handler, which finished executing.
+func (is *InterpreterStack) PopDiagnostics() {
+	is.stackedDiagnostics = is.stackedDiagnostics[:len(is.stackedDiagnostics)-1]
+}
+
+// StackedDiagnostics returns the stacked diagnostics area of the innermost executing handler. Returns false if no
+// handler is executing.
+func (is *InterpreterStack) StackedDiagnostics() ([]*sql.Warning, bool) {
+	if len(is.stackedDiagnostics) == 0 {
+		return nil, false
+	}
+	return is.stackedDiagnostics[len(is.stackedDiagnostics)-1], true
+}
+
 // NewLabel creates a new label in the current scope.
 func (is *InterpreterStack) NewLabel(name string, index int) {
 	is.stack.Peek().labels[name] = index
@@ -334,6 +449,17 @@ func (is *InterpreterStack) GetLabel(name string) int {
 	return -1
 }
 
+// SetReturnValue sets the value of the RETURN statement that ended a stored function.
+func (is *InterpreterStack) SetReturnValue(val any) {
+	is.returnValue = val
+	is.returned = true
+}
+
+// ReturnValue returns the value of the RETURN statement that ended a stored function, or false if none has run.
+func (is *InterpreterStack) ReturnValue() (any, bool) {
+	return is.returnValue, is.returned
+}
+
 // PushScope creates a new scope.
 func (is *InterpreterStack) PushScope() {
 	is.stack.Push(&InterpreterScopeDetails{
@@ -346,6 +472,19 @@ func (is *InterpreterStack) PushScope() {
 	})
 }
 
+// CloseCursors closes the open cursors of every scope, such as when a procedure exits with an error.
+func (is *InterpreterStack) CloseCursors(ctx *sql.Context) {
+	for i := 0; i < is.stack.Len(); i++ {
+		for _, cursor := range is.stack.PeekDepth(i).cursors {
+			if cursor == nil || cursor.RowIter == nil {
+				continue
+			}
+			cursor.RowIter.Close(ctx)
+			cursor.RowIter = nil
+		}
+	}
+}
+
 // PopScope removes the current scope.
 func (is *InterpreterStack) PopScope(ctx *sql.Context) {
 	scope := is.stack.Pop()
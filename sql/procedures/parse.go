@@ -114,6 +114,20 @@ func ConvertStmt(ops *[]*InterpreterOperation, stack *InterpreterStack, stmt ast
 		}
 		*ops = append(*ops, signalOp)
 
+	case *ast.Resignal:
+		resignalOp := &InterpreterOperation{
+			OpCode:      OpCode_Resignal,
+			PrimaryData: s,
+		}
+		*ops = append(*ops, resignalOp)
+
+	case *ast.GetDiagnostics:
+		getDiagnosticsOp := &InterpreterOperation{
+			OpCode:      OpCode_GetDiagnostics,
+			PrimaryData: s,
+		}
+		*ops = append(*ops, getDiagnosticsOp)
+
 	case *ast.Set:
 		if len(s.Exprs) != 1 {
 			panic("unexpected number of set expressions")
@@ -275,45 +289,33 @@ func ConvertStmt(ops *[]*InterpreterOperation, stack *InterpreterStack, stmt ast
 		resolveGoToIndexes(ops, s.Label, loopStart, whileOp.Index, loopStart, whileOp.Index)
 
 	case *ast.Repeat:
-		// repeat statements always run at least once
-		onceStart := len(*ops)
+		// repeat statements always run at least once, and ITERATE restarts the body without checking the condition
+		loopStart := len(*ops)
+		if s.Label != "" {
+			stack.NewLabel(s.Label, loopStart)
+		}
 		for _, repeatStmt := range s.Statements {
 			if err := ConvertStmt(ops, stack, repeatStmt); err != nil {
 				return err
 			}
 		}
 
-		loopStart := len(*ops)
-		if s.Label != "" {
-			stack.NewLabel(s.Label, loopStart)
-		}
-		repeatCond := &ast.NotExpr{Expr: s.Condition}
 		selectCond := &ast.Select{
 			SelectExprs: ast.SelectExprs{
 				&ast.AliasedExpr{
-					Expr: repeatCond,
+					Expr: s.Condition,
 				},
 			},
 		}
-		repeatOp := &InterpreterOperation{
+		// the body is run again while the condition is false
+		untilOp := &InterpreterOperation{
 			OpCode:      OpCode_If,
 			PrimaryData: selectCond,
+			Index:       loopStart,
 		}
-		*ops = append(*ops, repeatOp)
-
-		for _, repeatStmt := range s.Statements {
-			if err := ConvertStmt(ops, stack, repeatStmt); err != nil {
-				return err
-			}
-		}
-
-		gotoOp := &InterpreterOperation{
-			OpCode: OpCode_Goto,
-			Index:  loopStart,
-		}
-		*ops = append(*ops, gotoOp)
-		repeatOp.Index = len(*ops) // end of repeat block
-		resolveGoToIndexes(ops, s.Label, onceStart, repeatOp.Index, loopStart, repeatOp.Index)
+		*ops = append(*ops, untilOp)
+		loopEnd := len(*ops)
+		resolveGoToIndexes(ops, s.Label, loopStart, loopEnd, loopStart, loopEnd)
 
 	case *ast.Loop:
 		loopStart := len(*ops)
@@ -342,6 +344,19 @@ func ConvertStmt(ops *[]*InterpreterOperation, stack *InterpreterStack, stmt ast
 		}
 		*ops = append(*ops, iterateOp)
 
+	case *ast.Return:
+		returnOp := &InterpreterOperation{
+			OpCode: OpCode_Return,
+			PrimaryData: &ast.Select{
+				SelectExprs: ast.SelectExprs{
+					&ast.AliasedExpr{
+						Expr: s.Expr,
+					},
+				},
+			},
+		}
+		*ops = append(*ops, returnOp)
+
 	case *ast.Leave:
 		leaveOp := &InterpreterOperation{
 			OpCode: OpCode_Goto,
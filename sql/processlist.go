@@ -77,6 +77,10 @@ type ProcessList interface {
 	// RemovePartitionProgress removes an existing partition tracking progress from the
 	// process with the given pid, if it exists.
 	RemovePartitionProgress(pid uint64, tableName, partitionName string)
+
+	// UpdateQueryState sets the state shown for the query of the process with the
+	// given pid, if it exists. The state is cleared when the query ends.
+	UpdateQueryState(pid uint64, state string)
 }
 
 type ProcessCommand string
@@ -93,13 +97,15 @@ const (
 // Process represents a process in the SQL server.
 type Process struct {
 	// The time of the last Command transition
-	StartedAt  time.Time
-	Progress   map[string]TableProgress
-	Kill       context.CancelFunc
-	Host       string
-	Database   string
-	User       string
-	Command    ProcessCommand
+	StartedAt time.Time
+	Progress  map[string]TableProgress
+	Kill      context.CancelFunc
+	Host      string
+	Database  string
+	User      string
+	Command   ProcessCommand
+	// State describes what the query is doing, when it's more than "running"
+	State      string
 	Query      string
 	QueryPid   uint64
 	Connection uint32
@@ -190,3 +196,4 @@ func (e EmptyProcessList) AddPartitionProgress(pid uint64, tableName, partitionN
 }
 func (e EmptyProcessList) RemoveTableProgress(pid uint64, name string)                         {}
 func (e EmptyProcessList) RemovePartitionProgress(pid uint64, tableName, partitionName string) {}
+func (e EmptyProcessList) UpdateQueryState(pid uint64, state string)                           {}
@@ -58,6 +58,9 @@ const (
 	QFlagCreateTrigger
 	QFlagCreateProcedure
 	QFlagAnalyzeProcedure
+
+	// QFlagStoredFunction indicates that the query calls a stored function, whose body is run by the interpreter
+	QFlagStoredFunction
 )
 
 type QueryFlags struct {
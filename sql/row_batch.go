@@ -0,0 +1,198 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io"
+)
+
+// RowBatchSize is the number of rows in the batches read from a RowIter by RowIterToBatchIter.
+const RowBatchSize = 1024
+
+// VectorizedExecution is the name of the session variable that enables batch execution of filters and projections.
+const VectorizedExecution = "vectorized_execution"
+
+// batchIdentity holds the indexes of a batch of RowBatchSize rows in which every row is selected.
+var batchIdentity = func() []int {
+	sel := make([]int, RowBatchSize)
+	for i := range sel {
+		sel[i] = i
+	}
+	return sel
+}()
+
+// ColumnVector holds the values of a single column, or the results of an expression, for every row of a RowBatch.
+type ColumnVector []interface{}
+
+// RowBatch is a batch of rows stored as column vectors, which are evaluated together by a BatchExpression. Every
+// column holds a value for each of the Len rows of the batch.
+type RowBatch struct {
+	Columns []ColumnVector
+	Len     int
+	// Sel holds the indexes of the selected rows, in ascending order. When nil, every row is selected. Rows that aren't
+	// selected have been filtered out, and aren't evaluated or returned.
+	Sel []int
+}
+
+// Selected returns the indexes of the selected rows of the batch.
+func (b *RowBatch) Selected() []int {
+	if b.Sel != nil {
+		return b.Sel
+	}
+	if b.Len <= RowBatchSize {
+		return batchIdentity[:b.Len]
+	}
+	sel := make([]int, b.Len)
+	for i := range sel {
+		sel[i] = i
+	}
+	return sel
+}
+
+// WithSelected returns a copy of the batch whose selected rows are |sel|.
+func (b *RowBatch) WithSelected(sel []int) *RowBatch {
+	nb := *b
+	nb.Sel = sel
+	return &nb
+}
+
+// Row writes the values of row |i| of the batch into |row|, which is allocated if it's too small, and returns it.
+func (b *RowBatch) Row(i int, row Row) Row {
+	if len(row) != len(b.Columns) {
+		row = make(Row, len(b.Columns))
+	}
+	for c, col := range b.Columns {
+		row[c] = col[i]
+	}
+	return row
+}
+
+// EvalBatch evaluates |e| for the selected rows of |batch|. Expressions that aren't BatchExpressions are evaluated one
+// row at a time.
+func EvalBatch(ctx *Context, e Expression, batch *RowBatch) (ColumnVector, error) {
+	if be, ok := e.(BatchExpression); ok {
+		return be.EvalBatch(ctx, batch)
+	}
+	return EvalBatchRows(ctx, e, batch)
+}
+
+// EvalBatchRows evaluates |e| for the selected rows of |batch| one row at a time, for expressions that can't evaluate
+// some batches at once.
+func EvalBatchRows(ctx *Context, e Expression, batch *RowBatch) (ColumnVector, error) {
+	res := make(ColumnVector, batch.Len)
+	var row Row
+	for _, i := range batch.Selected() {
+		row = batch.Row(i, row)
+		v, err := e.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+// BatchIter is an iterator that produces RowBatches.
+type BatchIter interface {
+	// NextBatch returns the next batch, which has at least one selected row. It returns io.EOF once there are no more
+	// rows.
+	NextBatch(ctx *Context) (*RowBatch, error)
+	Closer
+}
+
+// RowIterToBatchIter returns a BatchIter over the rows of |iter|, in batches of up to RowBatchSize rows. If |iter| was
+// returned by BatchIterToRowIter, its BatchIter is returned instead.
+func RowIterToBatchIter(iter RowIter) BatchIter {
+	if ri, ok := iter.(*batchRowIter); ok && ri.batch == nil {
+		return ri.iter
+	}
+	return &rowBatchIter{iter: iter}
+}
+
+// BatchIterToRowIter returns a RowIter over the selected rows of the batches of |iter|.
+func BatchIterToRowIter(iter BatchIter) RowIter {
+	return &batchRowIter{iter: iter}
+}
+
+type rowBatchIter struct {
+	iter RowIter
+	done bool
+}
+
+var _ BatchIter = (*rowBatchIter)(nil)
+
+// NextBatch implements the BatchIter interface.
+func (i *rowBatchIter) NextBatch(ctx *Context) (*RowBatch, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+	var batch *RowBatch
+	for n := 0; n < RowBatchSize; n++ {
+		row, err := i.iter.Next(ctx)
+		if err == io.EOF {
+			i.done = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			batch = &RowBatch{Columns: make([]ColumnVector, len(row))}
+			for c := range batch.Columns {
+				batch.Columns[c] = make(ColumnVector, 0, RowBatchSize)
+			}
+		}
+		for c, v := range row {
+			batch.Columns[c] = append(batch.Columns[c], v)
+		}
+		batch.Len++
+	}
+	if batch == nil {
+		return nil, io.EOF
+	}
+	return batch, nil
+}
+
+// Close implements the BatchIter interface.
+func (i *rowBatchIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
+
+type batchRowIter struct {
+	iter  BatchIter
+	batch *RowBatch
+	sel   []int
+}
+
+var _ RowIter = (*batchRowIter)(nil)
+
+// Next implements the RowIter interface.
+func (i *batchRowIter) Next(ctx *Context) (Row, error) {
+	for len(i.sel) == 0 {
+		batch, err := i.iter.NextBatch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		i.batch, i.sel = batch, batch.Selected()
+	}
+	row := i.batch.Row(i.sel[0], nil)
+	i.sel = i.sel[1:]
+	return row, nil
+}
+
+// Close implements the RowIter interface.
+func (i *batchRowIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestBatchIters(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	rows := make([]sql.Row, sql.RowBatchSize+10)
+	for i := range rows {
+		rows[i] = sql.NewRow(int64(i), "row")
+	}
+
+	iter := sql.RowIterToBatchIter(sql.RowsToRowIter(rows...))
+	batch, err := iter.NextBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sql.RowBatchSize, batch.Len)
+	batch, err = iter.NextBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 10, batch.Len)
+	require.Equal(t, sql.NewRow(int64(sql.RowBatchSize+3), "row"), batch.Row(3, nil))
+	_, err = iter.NextBatch(ctx)
+	require.Equal(t, io.EOF, err)
+
+	res, err := sql.RowIterToRows(ctx, sql.BatchIterToRowIter(sql.RowIterToBatchIter(sql.RowsToRowIter(rows...))))
+	require.NoError(t, err)
+	require.Equal(t, rows, res)
+
+	// a RowIter returned by BatchIterToRowIter is unwrapped rather than read a row at a time
+	batches := sql.RowIterToBatchIter(sql.RowsToRowIter(rows...))
+	require.Equal(t, batches, sql.RowIterToBatchIter(sql.BatchIterToRowIter(batches)))
+}
+
+func TestEvalBatch(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	rows := []sql.Row{
+		{int64(1), int64(2), "Abc", nil},
+		{int64(-3), nil, "dEf", float64(1.5)},
+		{nil, int64(4), nil, float64(-2)},
+		{int64(0), int64(0), "", nil},
+		{int64(5), int64(-5), "ghi", float64(0)},
+	}
+	a := expression.NewGetField(0, types.Int64, "a", true)
+	b := expression.NewGetField(1, types.Int64, "b", true)
+	s := expression.NewGetField(2, types.LongText, "s", true)
+	f := expression.NewGetField(3, types.Float64, "f", true)
+	coalesce, err := function.NewCoalesce(ctx, f, a, expression.NewLiteral(int64(7), types.Int64))
+	require.NoError(t, err)
+
+	exprs := []sql.Expression{
+		expression.NewEquals(a, b),
+		expression.NewGreaterThan(a, b),
+		expression.NewLessThan(a, expression.NewLiteral(int64(1), types.Int64)),
+		expression.NewGreaterThanOrEqual(a, b),
+		expression.NewLessThanOrEqual(a, b),
+		expression.NewPlus(a, b),
+		expression.NewMult(expression.NewMinus(a, b), a),
+		expression.NewAnd(expression.NewGreaterThan(a, b), expression.NewIsNull(f)),
+		expression.NewOr(expression.NewLessThan(a, b), expression.NewIsNull(f)),
+		expression.NewNot(expression.NewEquals(a, b)),
+		function.NewAbsVal(ctx, a),
+		function.NewLength(ctx, s),
+		function.NewLower(ctx, s),
+		function.NewUpper(ctx, s),
+		coalesce,
+		expression.NewAlias(ctx, "alias", a),
+	}
+
+	batch := sql.RowIterToBatchIter(sql.RowsToRowIter(rows...))
+	rb, err := batch.NextBatch(ctx)
+	require.NoError(t, err)
+	for _, e := range exprs {
+		t.Run(e.String(), func(t *testing.T) {
+			require.Implements(t, (*sql.BatchExpression)(nil), e)
+			for _, sel := range [][]int{nil, {1, 2, 4}} {
+				res, err := sql.EvalBatch(ctx, e, rb.WithSelected(sel))
+				require.NoError(t, err)
+				for _, i := range rb.WithSelected(sel).Selected() {
+					expected, err := e.Eval(ctx, rows[i])
+					require.NoError(t, err)
+					require.Equal(t, expected, res[i], "row %d", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// RowLockMode is the mode in which a locking read locks the rows it reads.
+type RowLockMode byte
+
+const (
+	// RowLockShared is the mode of LOCK IN SHARE MODE. Other transactions may also lock rows in this mode, but can't
+	// update them until the lock is released.
+	RowLockShared RowLockMode = iota + 1
+	// RowLockExclusive is the mode of FOR UPDATE. Other transactions can't lock or update rows locked in this mode
+	// until the lock is released.
+	RowLockExclusive
+)
+
+// RowLockWait is what a locking read does when a row it reads is locked by another transaction in a conflicting mode.
+type RowLockWait byte
+
+const (
+	// RowLockWaitDefault waits for the other transaction to release its lock.
+	RowLockWaitDefault RowLockWait = iota
+	// RowLockNowait fails the read with ErrLockNowait, as with NOWAIT.
+	RowLockNowait
+	// RowLockSkipLocked leaves the row out of the result, as with SKIP LOCKED.
+	RowLockSkipLocked
+)
+
+// RowLock is the lock a locking read, such as SELECT ... FOR UPDATE, takes on the rows it reads.
+type RowLock struct {
+	Mode RowLockMode
+	Wait RowLockWait
+}
+
+// String returns the locking clause of the lock.
+func (l RowLock) String() string {
+	s := "FOR UPDATE"
+	if l.Mode == RowLockShared {
+		s = "LOCK IN SHARE MODE"
+	}
+	switch l.Wait {
+	case RowLockNowait:
+		s += " NOWAIT"
+	case RowLockSkipLocked:
+		s += " SKIP LOCKED"
+	}
+	return s
+}
+
+// RowLockWaitState is the state shown in the process list for a query that's waiting for a row lock.
+const RowLockWaitState = "waiting for row lock"
+
+// WaitForRowLock calls |wait|, which blocks until a row lock held by another transaction is released, while showing
+// RowLockWaitState as the state of the query of |ctx| in the process list. LockableTable implementations should wait
+// for row locks with this function.
+func WaitForRowLock(ctx *Context, wait func() error) error {
+	ctx.ProcessList.UpdateQueryState(ctx.Pid(), RowLockWaitState)
+	defer ctx.ProcessList.UpdateQueryState(ctx.Pid(), "")
+	return wait()
+}
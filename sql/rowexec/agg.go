@@ -17,11 +17,14 @@ package rowexec
 import (
 	"errors"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/errguard"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/hash"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 type groupByIter struct {
@@ -116,11 +119,28 @@ type groupByGroupingIter struct {
 	dispose       sql.DisposeFunc
 	selectedExprs []sql.Expression
 	groupByExprs  []sql.Expression
-	keys          []uint64
+	// groupingSets are the combinations of groupByExprs, by index, that rows are grouped by, or nil to group them by
+	// all of groupByExprs
+	groupingSets [][]int
+	// rolledUp holds the string forms of the groupByExprs missing from each of the groupingSets
+	rolledUp []map[string]bool
+	// rollup is set when each of the groupingSets is a prefix of the first, as for ROLLUP, and the groups are then
+	// returned with each super-aggregate row after the groups it sums up
+	rollup bool
+	keys   []uint64
+	// groups holds the grouping set and the group-by values of each of the keys, to order rollup groups
+	groups []rollupGroup
 	// buffers to reduce slice allocations
-	keyRow sql.Row
-	keySch sql.Schema
-	pos    int
+	keyRow   sql.Row
+	groupRow sql.Row
+	keySch   sql.Schema
+	pos      int
+}
+
+// rollupGroup is the grouping set and the group-by values of a group of a rollup.
+type rollupGroup struct {
+	set  int
+	vals sql.Row
 }
 
 func newGroupByGroupingIter(
@@ -141,6 +161,60 @@ func newGroupByGroupingIter(
 	}
 }
 
+// newGroupingSetsIter returns an iterator that groups the rows of |child| by each of the grouping |sets| of
+// |groupByExprs| in a single pass, as for ROLLUP and CUBE. The groups of every set are returned, with the
+// |selectedExprs| that are group-by expressions missing from the set evaluating to NULL.
+func newGroupingSetsIter(
+	ctx *sql.Context,
+	selectedExprs, groupByExprs []sql.Expression,
+	sets [][]int,
+	child sql.RowIter,
+) *groupByGroupingIter {
+	iter := newGroupByGroupingIter(ctx, selectedExprs, groupByExprs, child)
+	iter.groupingSets = sets
+	// the index of the grouping set is part of the key, to keep apart groups of different sets with the same values
+	iter.keyRow = make(sql.Row, len(groupByExprs)+1)
+	iter.keySch = append(iter.keySch, &sql.Column{Type: types.Int64})
+
+	iter.rolledUp = make([]map[string]bool, len(sets))
+	for s, set := range sets {
+		included := make(map[string]bool)
+		for _, idx := range set {
+			included[strings.ToLower(groupByExprs[idx].String())] = true
+		}
+		rolledUp := make(map[string]bool)
+		for _, e := range groupByExprs {
+			if str := strings.ToLower(e.String()); !included[str] {
+				rolledUp[str] = true
+			}
+		}
+		iter.rolledUp[s] = rolledUp
+	}
+	iter.rollup = isRollup(sets)
+	if iter.rollup {
+		iter.groupRow = make(sql.Row, len(groupByExprs))
+	}
+	return iter
+}
+
+// isRollup returns whether each of the grouping |sets| is a prefix of the first, as for ROLLUP.
+func isRollup(sets [][]int) bool {
+	if len(sets) == 0 {
+		return false
+	}
+	for _, set := range sets[1:] {
+		if len(set) > len(sets[0]) {
+			return false
+		}
+		for j := range set {
+			if set[j] != sets[0][j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (i *groupByGroupingIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if i.aggregations == nil {
 		i.aggregations, i.dispose = ctx.Memory.NewHistoryCache(ctx)
@@ -185,36 +259,42 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 		}
 	})
 
+	numSets := 1
+	if i.groupingSets != nil {
+		numSets = len(i.groupingSets)
+	}
 	errguard.Go(eg, func() error {
 		for {
 			row, ok := <-rowChan
 			if !ok {
 				return nil
 			}
-			key, err := i.groupingKey(subCtx, row)
-			if err != nil {
-				return err
-			}
+			for set := 0; set < numSets; set++ {
+				key, err := i.groupingKey(subCtx, row, set)
+				if err != nil {
+					return err
+				}
 
-			buf, err := i.get(key)
-			if errors.Is(err, sql.ErrKeyNotFound) {
-				buf = make([]sql.AggregationBuffer, len(i.selectedExprs))
-				for j, a := range i.selectedExprs {
-					buf[j], err = newAggregationBuffer(ctx, a)
+				buf, err := i.get(key)
+				if errors.Is(err, sql.ErrKeyNotFound) {
+					buf, err = i.newBuffers(ctx, set)
 					if err != nil {
 						return err
 					}
+					if err = i.aggregations.Put(key, buf); err != nil {
+						return err
+					}
+					i.keys = append(i.keys, key)
+					if i.rollup {
+						i.groups = append(i.groups, rollupGroup{set: set, vals: i.groupRow.Copy()})
+					}
+				} else if err != nil {
+					return err
 				}
-				if err = i.aggregations.Put(key, buf); err != nil {
+				err = updateBuffers(subCtx, buf, row)
+				if err != nil {
 					return err
 				}
-				i.keys = append(i.keys, key)
-			} else if err != nil {
-				return err
-			}
-			err = updateBuffers(subCtx, buf, row)
-			if err != nil {
-				return err
 			}
 		}
 	})
@@ -224,6 +304,67 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 		return err
 	}
 
+	if i.rollup {
+		return i.sortRollup(ctx)
+	}
+	return nil
+}
+
+// sortRollup orders the keys of a rollup the way MySQL returns them: groups in ascending order of their group-by
+// values, with each super-aggregate row right after the groups it sums up, and the grand total last.
+func (i *groupByGroupingIter) sortRollup(ctx *sql.Context) error {
+	order := i.groupingSets[0]
+	idxs := make([]int, len(i.keys))
+	for j := range idxs {
+		idxs[j] = j
+	}
+
+	var sortErr error
+	sort.SliceStable(idxs, func(a, b int) bool {
+		if sortErr != nil {
+			return false
+		}
+		ga, gb := i.groups[idxs[a]], i.groups[idxs[b]]
+		for pos, idx := range order {
+			inA, inB := pos < len(i.groupingSets[ga.set]), pos < len(i.groupingSets[gb.set])
+			switch {
+			case inA && !inB:
+				return true
+			case !inA && inB:
+				return false
+			case !inA && !inB:
+				return false
+			}
+
+			va, vb := ga.vals[idx], gb.vals[idx]
+			if va == nil || vb == nil {
+				if va == nil && vb == nil {
+					continue
+				}
+				return va == nil
+			}
+			cmp, err := i.groupByExprs[idx].Type(ctx).Compare(ctx, va, vb)
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	if sortErr != nil {
+		return sortErr
+	}
+
+	keys := make([]uint64, len(idxs))
+	groups := make([]rollupGroup, len(idxs))
+	for j, idx := range idxs {
+		keys[j] = i.keys[idx]
+		groups[j] = i.groups[idx]
+	}
+	i.keys, i.groups = keys, groups
 	return nil
 }
 
@@ -264,12 +405,63 @@ func (i *groupByGroupingIter) Dispose(ctx *sql.Context) {
 	}
 }
 
-func (i *groupByGroupingIter) groupingKey(ctx *sql.Context, row sql.Row) (uint64, error) {
+// newBuffers returns the aggregation buffers for a new group of the grouping set at index |set|.
+func (i *groupByGroupingIter) newBuffers(ctx *sql.Context, set int) ([]sql.AggregationBuffer, error) {
+	buf := make([]sql.AggregationBuffer, len(i.selectedExprs))
+	for j, a := range i.selectedExprs {
+		if i.groupingSets == nil {
+			var err error
+			buf[j], err = newAggregationBuffer(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		rolledUp := i.rolledUp[set]
+		switch a := a.(type) {
+		case *aggregation.Grouping:
+			buf[j] = a.NewGroupingSetBuffer(func(e sql.Expression) bool {
+				return rolledUp[strings.ToLower(e.String())]
+			})
+		case sql.Aggregation:
+			var err error
+			buf[j], err = a.NewBuffer(ctx)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			if rolledUp[strings.ToLower(a.String())] {
+				buf[j] = nullBuffer{}
+				continue
+			}
+			var err error
+			buf[j], err = newAggregationBuffer(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return buf, nil
+}
+
+// groupingKey returns the key of the group of |row| in the grouping set at index |set|.
+func (i *groupByGroupingIter) groupingKey(ctx *sql.Context, row sql.Row, set int) (uint64, error) {
 	for idx, expr := range i.groupByExprs {
+		if i.groupingSets != nil && i.rolledUp[set][strings.ToLower(expr.String())] {
+			i.keyRow[idx] = nil
+			if i.rollup {
+				i.groupRow[idx] = nil
+			}
+			continue
+		}
 		v, err := expr.Eval(ctx, row)
 		if err != nil {
 			return 0, err
 		}
+		if i.rollup {
+			i.groupRow[idx] = v
+		}
 
 		// TODO: this should be moved into hash.HashOf
 		typ := expr.Type(ctx)
@@ -283,9 +475,30 @@ func (i *groupByGroupingIter) groupingKey(ctx *sql.Context, row sql.Row) (uint64
 
 		i.keyRow[idx] = v
 	}
+	if i.groupingSets != nil {
+		i.keyRow[len(i.groupByExprs)] = int64(set)
+	}
 	return hash.HashOf(ctx, i.keySch, i.keyRow)
 }
 
+// nullBuffer is the buffer of a group-by expression in the groups of a grouping set that it's missing from, which is
+// always NULL.
+type nullBuffer struct{}
+
+// Update implements the AggregationBuffer interface.
+func (nullBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (nullBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return nil, nil
+}
+
+// Dispose implements the Disposable interface.
+func (nullBuffer) Dispose(ctx *sql.Context) {
+}
+
 func newAggregationBuffer(ctx *sql.Context, expr sql.Expression) (sql.AggregationBuffer, error) {
 	switch n := expr.(type) {
 	case sql.Aggregation:
@@ -0,0 +1,159 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// useBatchIters returns whether a filter or projection of |exprs| over |child| is executed a batch of rows at a time.
+// Batches are used for scans of tables and filters over them when the vectorized_execution session variable is set,
+// and every expression can be evaluated a batch at a time. Rows past a LIMIT may be evaluated in a batch, so batches
+// are opt-in.
+func useBatchIters(ctx *sql.Context, child sql.Node, exprs ...sql.Expression) bool {
+	if val, err := ctx.GetSessionVariable(ctx, sql.VectorizedExecution); err != nil || val != int8(1) {
+		return false
+	}
+	if f, ok := child.(*plan.Filter); ok {
+		if !canEvalBatch(ctx, f.Expression) {
+			return false
+		}
+		child = f.Child
+	}
+	return isTableScan(child) && canEvalBatch(ctx, exprs...)
+}
+
+// isTableScan returns whether |n| reads the rows of a table.
+func isTableScan(n sql.Node) bool {
+	switch n := n.(type) {
+	case *plan.ResolvedTable, *plan.IndexedTableAccess:
+		return true
+	case *plan.TableAlias:
+		return isTableScan(n.Child)
+	}
+	return false
+}
+
+// canEvalBatch returns whether every expression in |exprs|, and all of their children, are sql.BatchExpressions.
+func canEvalBatch(ctx *sql.Context, exprs ...sql.Expression) bool {
+	for _, e := range exprs {
+		if transform.InspectExpr(ctx, e, func(ctx *sql.Context, e sql.Expression) bool {
+			_, ok := e.(sql.BatchExpression)
+			return !ok
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// batchFilterIter selects the rows of each batch of its child for which the condition is true.
+type batchFilterIter struct {
+	cond      sql.Expression
+	childIter sql.BatchIter
+}
+
+var _ sql.BatchIter = (*batchFilterIter)(nil)
+
+// NextBatch implements the sql.BatchIter interface.
+func (i *batchFilterIter) NextBatch(ctx *sql.Context) (*sql.RowBatch, error) {
+	for {
+		batch, err := i.childIter.NextBatch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res, err := sql.EvalBatch(ctx, i.cond, batch)
+		if err != nil {
+			return nil, err
+		}
+		var sel []int
+		for _, r := range batch.Selected() {
+			if res[r] == nil {
+				continue
+			}
+			// like sql.EvaluateCondition, results that aren't booleans are converted to them
+			ok, err := sql.ConvertToBool(ctx, res[r])
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				sel = append(sel, r)
+			}
+		}
+		if len(sel) > 0 {
+			return batch.WithSelected(sel), nil
+		}
+	}
+}
+
+// Close implements the sql.BatchIter interface.
+func (i *batchFilterIter) Close(ctx *sql.Context) error {
+	return i.childIter.Close(ctx)
+}
+
+// batchProjectIter evaluates its projections for each batch of its child.
+type batchProjectIter struct {
+	projs     []sql.Expression
+	childIter sql.BatchIter
+}
+
+var _ sql.BatchIter = (*batchProjectIter)(nil)
+
+// NextBatch implements the sql.BatchIter interface.
+func (i *batchProjectIter) NextBatch(ctx *sql.Context) (*sql.RowBatch, error) {
+	batch, err := i.childIter.NextBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &sql.RowBatch{
+		Columns: make([]sql.ColumnVector, len(i.projs)),
+		Len:     batch.Len,
+		Sel:     batch.Sel,
+	}
+	for c, proj := range i.projs {
+		col, err := sql.EvalBatch(ctx, proj, batch)
+		if err != nil {
+			return nil, err
+		}
+		res.Columns[c] = normalizeNegativeZeroVector(col, batch.Selected())
+	}
+	return res, nil
+}
+
+// Close implements the sql.BatchIter interface.
+func (i *batchProjectIter) Close(ctx *sql.Context) error {
+	return i.childIter.Close(ctx)
+}
+
+// normalizeNegativeZeroVector returns |col| with the negative zeros of the rows in |sel| replaced by zeros, like
+// ProjectRow. |col| is copied rather than modified, as it may be shared.
+func normalizeNegativeZeroVector(col sql.ColumnVector, sel []int) sql.ColumnVector {
+	var res sql.ColumnVector
+	for _, r := range sel {
+		switch col[r] {
+		case float32(0), float64(0):
+			if res == nil {
+				res = append(sql.ColumnVector(nil), col...)
+			}
+			res[r] = normalizeNegativeZeros(col[r])
+		}
+	}
+	if res == nil {
+		return col
+	}
+	return res
+}
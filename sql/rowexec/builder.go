@@ -28,6 +28,8 @@ type BaseBuilder struct {
 	PriorityBuilder sql.NodeExecBuilder
 	EngineOverrides sql.EngineOverrides
 	Runner          sql.StatementRunner
+	// SharedResults holds the results of common table expressions shared between the statements of a session.
+	SharedResults   *sql.SharedResultCache
 	schemaFormatter sql.SchemaFormatter
 }
 
@@ -40,6 +42,7 @@ func NewBuilder(priority sql.NodeExecBuilder, overrides sql.EngineOverrides) *Ba
 		PriorityBuilder: priority,
 		EngineOverrides: overrides,
 		Runner:          nil, // This is often set later (directly on the variable), as it's not yet available during creation
+		SharedResults:   sql.NewSharedResultCache(),
 		schemaFormatter: sql.GetSchemaFormatter(overrides),
 	}
 }
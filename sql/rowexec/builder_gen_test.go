@@ -99,6 +99,7 @@ func TestGenBuilder(t *testing.T) {
 		"IfElseBlock":               "*plan.IfElseBlock",
 		"IndexedInSubqueryFilter":   "*plan.IndexedInSubqueryFilter",
 		"IndexedTableAccess":        "*plan.IndexedTableAccess",
+		"LooseIndexScan":            "*plan.LooseIndexScan",
 		"InsertInto":                "*plan.InsertInto",
 		"InsertDestination":         "*plan.InsertDestination",
 		"Into":                      "*plan.Into",
@@ -119,6 +120,15 @@ func TestGenBuilder(t *testing.T) {
 		"PrepareQuery":              "*plan.PrepareQuery",
 		"ExecuteQuery":              "*plan.ExecuteQuery",
 		"DeallocateQuery":           "*plan.DeallocateQuery",
+		"HandlerOpen":               "*plan.HandlerOpen",
+		"HandlerRead":               "*plan.HandlerRead",
+		"HandlerClose":              "*plan.HandlerClose",
+		"CreateLoadableFunction":    "*plan.CreateLoadableFunction",
+		"DropLoadableFunction":      "*plan.DropLoadableFunction",
+		"CreateSequence":            "*plan.CreateSequence",
+		"AlterSequence":             "*plan.AlterSequence",
+		"DropSequence":              "*plan.DropSequence",
+		"ShowCreateSequence":        "*plan.ShowCreateSequence",
 		"Procedure":                 "*plan.Procedure",
 		"ProcedureResolvedTable":    "*plan.ProcedureResolvedTable",
 		"QueryProcess":              "*plan.QueryProcess",
@@ -142,6 +152,7 @@ func TestGenBuilder(t *testing.T) {
 		"ShowCharset":               "*plan.ShowCharset",
 		"ShowCreateDatabase":        "*plan.ShowCreateDatabase",
 		"ShowCreateProcedure":       "*plan.ShowCreateProcedure",
+		"ShowCreateFunction":        "*plan.ShowCreateFunction",
 		"ShowCreateTable":           "*plan.ShowCreateTable",
 		"ShowCreateTrigger":         "*plan.ShowCreateTrigger",
 		"ShowGrants":                "*plan.ShowGrants",
@@ -156,6 +167,7 @@ func TestGenBuilder(t *testing.T) {
 		"ShowVariables":             "*plan.ShowVariables",
 		"ShowWarnings":              "*plan.ShowWarnings",
 		"Signal":                    "*plan.Signal",
+		"GetDiagnostics":            "*plan.GetDiagnostics",
 		"SignalName":                "*plan.SignalName",
 		"Sort":                      "*plan.Sort",
 		"TopN":                      "*plan.TopN",
@@ -52,10 +52,14 @@ func (i *cachedResultsIter) Next(ctx *sql.Context) (sql.Row, error) {
 		if err == io.EOF {
 			i.saveResultsInNode()
 		}
-	} else {
-		i.results = append(i.results, r)
+		return r, err
 	}
-	return r, err
+	// the results are held until the query finishes, which releases their memory
+	if err = ctx.MemoryTracker().Grow(sql.EstimateRowSize(r)); err != nil {
+		return nil, err
+	}
+	i.results = append(i.results, r)
+	return r, nil
 }
 
 func (i *cachedResultsIter) saveResultsInNode() {
@@ -18,15 +18,19 @@ import (
 	"bufio"
 	"fmt"
 	"io"
-	"os"
+	"math"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
 
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/vector"
 	"github.com/dolthub/go-mysql-server/sql/fulltext"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -79,19 +83,23 @@ func (b *BaseBuilder) buildLoadData(ctx *sql.Context, n *plan.LoadData, row sql.
 			return nil, err
 		}
 	} else {
-		_, secureFileDir, ok := sql.SystemVariables.GetGlobal("secure_file_priv")
-		if !ok {
-			return nil, fmt.Errorf("error: secure_file_priv variable was not found")
+		reader, err = sql.OpenSecureFile(ctx, n.File)
+		if err != nil {
+			return nil, sql.ErrLoadDataCannotOpen.New(err.Error())
 		}
+	}
 
-		if err = isUnderSecureFileDir(secureFileDir, n.File); err != nil {
-			return nil, sql.ErrLoadDataCannotOpen.New(err.Error())
+	// the lines of the file are converted from its character set, unless it's the character set of strings already
+	var decoder encodings.Encoder
+	if n.Charset != "" {
+		charset, err := sql.ParseCharacterSet(n.Charset)
+		if err != nil {
+			reader.Close()
+			return nil, err
 		}
-		file, fileErr := os.Open(n.File)
-		if fileErr != nil {
-			return nil, sql.ErrLoadDataCannotOpen.New(fileErr.Error())
+		if charset != sql.CharacterSet_utf8mb4 && charset != sql.CharacterSet_utf8mb3 && charset != sql.CharacterSet_binary {
+			decoder = charset.Encoder()
 		}
-		reader = file
 	}
 
 	scanner := bufio.NewScanner(reader)
@@ -122,6 +130,8 @@ func (b *BaseBuilder) buildLoadData(ctx *sql.Context, n *plan.LoadData, row sql.
 		destSch:       n.DestSch,
 		reader:        reader,
 		scanner:       scanner,
+		decoder:       decoder,
+		charset:       n.Charset,
 		colCount:      len(n.ColNames), // Needs to be the original column count
 		fieldToColMap: fieldToColMap,
 		setExprs:      n.SetExprs,
@@ -288,6 +298,9 @@ func (b *BaseBuilder) buildRenameTable(ctx *sql.Context, n *plan.RenameTable, ro
 			}
 		}
 	}
+	for _, oldName := range n.OldNames {
+		warnDependentRoutines(ctx, n.Db, oldName)
+	}
 	if b.EngineOverrides.Hooks.RenameTable.PostSQLExecution != nil {
 		if err := b.EngineOverrides.Hooks.RenameTable.PostSQLExecution(ctx, b.Runner, n); err != nil {
 			return nil, err
@@ -343,6 +356,7 @@ func (b *BaseBuilder) buildModifyColumn(ctx *sql.Context, n *plan.ModifyColumn,
 		alterable: alterable,
 		overrides: b.EngineOverrides,
 		runner:    b.Runner,
+		formatter: b.schemaFormatter,
 	}, nil
 }
 
@@ -546,7 +560,7 @@ func (b *BaseBuilder) buildCreateSchema(ctx *sql.Context, n *plan.CreateSchema,
 }
 
 func (b *BaseBuilder) buildAlterDefaultDrop(ctx *sql.Context, n *plan.AlterDefaultDrop, row sql.Row) (sql.RowIter, error) {
-	table, ok, err := n.Db.GetTableInsensitive(ctx, getTableName(n.Table))
+	table, ok, err := sql.GetSessionTableInsensitive(ctx, n.Db, getTableName(n.Table))
 	if err != nil {
 		return nil, err
 	}
@@ -624,32 +638,36 @@ func (b *BaseBuilder) buildAlterUser(ctx *sql.Context, a *plan.AlterUser, _ sql.
 		}
 		return nil, sql.ErrUserAlterFailure.New(user.UserName.String("'"))
 	}
+	// the entry is changed on a copy, so that a statement that fails doesn't change it
+	userEntry := *previousUserEntry
+	previousUserEntry = &userEntry
+
+	applyAccountLimits(previousUserEntry, a.AccountLimits)
+	applyPasswordOptions(mysqlDb, previousUserEntry, a.PasswordOptions)
+	if a.Locked != nil {
+		previousUserEntry.Locked = *a.Locked
+		if !*a.Locked {
+			mysqlDb.ResetFailedLogins(previousUserEntry.User, previousUserEntry.Host)
+		}
+	}
 
-	// Default the auth plugin and authorization string to the currently configured values.
 	// We can only change the auth info if a new password was specified, otherwise, we don't
 	// have a plaintext password to process into an authorization string for the auth plugin.
-	plugin := previousUserEntry.Plugin
-	authString := previousUserEntry.AuthString
-	identity := previousUserEntry.Identity
 	if user.Auth1 != nil {
-		plugin = user.Auth1.Plugin()
-		var err error
-		authString, err = user.Auth1.AuthString()
-		if err != nil {
+		plugin := user.Auth1.Plugin()
+		if plugin != string(mysql.MysqlNativePassword) && plugin != string(mysql.CachingSha2Password) {
+			if err := mysqlDb.VerifyPlugin(plugin); err != nil {
+				return nil, sql.ErrUserAlterFailure.New(err)
+			}
+		}
+		if err := changePassword(ctx, mysqlDb, editor, previousUserEntry, user.Auth1, user.Identity); err != nil {
 			return nil, err
 		}
-		identity = user.Identity
 	}
-	if plugin != string(mysql.MysqlNativePassword) && plugin != string(mysql.CachingSha2Password) {
-		if err := mysqlDb.VerifyPlugin(plugin); err != nil {
-			return nil, sql.ErrUserAlterFailure.New(err)
-		}
+	if a.PasswordOptions != nil && a.PasswordOptions.Expire {
+		previousUserEntry.PasswordExpired = true
 	}
-
-	previousUserEntry.Plugin = plugin
-	previousUserEntry.AuthString = authString
-	previousUserEntry.Identity = identity
-	previousUserEntry.PasswordLastChanged = time.Now().UTC()
+	editor.RemoveUser(userPk)
 	editor.PutUser(previousUserEntry)
 
 	if err := mysqlDb.Persist(ctx, editor); err != nil {
@@ -713,7 +731,7 @@ func (b *BaseBuilder) buildCreateUser(ctx *sql.Context, n *plan.CreateUser, _ sq
 		// TODO: attributes should probably not be nil, but setting it to &n.Attribute causes unexpected behavior
 		// TODO: validate all of the data
 		sslType, sslCipher, x509Issuer, x509Subject := parseTlsOptions(n.TLSOptions)
-		editor.PutUser(&mysql_db.User{
+		newUser := &mysql_db.User{
 			User:                user.UserName.Name,
 			Host:                user.UserName.Host,
 			PrivilegeSet:        mysql_db.NewPrivilegeSet(),
@@ -728,7 +746,28 @@ func (b *BaseBuilder) buildCreateUser(ctx *sql.Context, n *plan.CreateUser, _ sq
 			X509Issuer:          x509Issuer,
 			X509Subject:         x509Subject,
 			SslCipher:           sslCipher,
-		})
+		}
+		applyAccountLimits(newUser, n.AccountLimits)
+		applyPasswordOptions(mysqlDb, newUser, n.PasswordOptions)
+		newUser.Locked = n.Locked
+		if authString != "" {
+			newUser.PasswordChanged(newUser.PasswordLastChanged)
+		}
+		if n.PasswordOptions != nil && n.PasswordOptions.Expire {
+			newUser.PasswordExpired = true
+		}
+		editor.PutUser(newUser)
+
+		// the default roles must exist, but they don't need to be granted yet
+		defaultRoles := make([]sql.RoleName, len(n.DefaultRoles))
+		for i, defaultRole := range n.DefaultRoles {
+			role := mysqlDb.GetUser(editor, defaultRole.Name, defaultRole.Host, true)
+			if role == nil {
+				return nil, sql.ErrGrantRevokeRoleDoesNotExist.New(defaultRole.String("`"))
+			}
+			defaultRoles[i] = mysql_db.RoleNameOf(role)
+		}
+		setDefaultRoles(editor, newUser, defaultRoles)
 	}
 	if err := mysqlDb.Persist(ctx, editor); err != nil {
 		return nil, err
@@ -736,6 +775,83 @@ func (b *BaseBuilder) buildCreateUser(ctx *sql.Context, n *plan.CreateUser, _ sq
 	return rowIterWithOkResultWithZeroRowsAffected(), nil
 }
 
+// applyAccountLimits sets the resource limits of |user| that |limits| specifies, leaving the others unchanged. A limit
+// of zero removes the limit.
+func applyAccountLimits(user *mysql_db.User, limits *plan.AccountLimits) {
+	if limits == nil {
+		return
+	}
+	if limits.MaxQueriesPerHour != nil {
+		user.MaxQuestions = accountLimitValue(*limits.MaxQueriesPerHour)
+	}
+	if limits.MaxUpdatesPerHour != nil {
+		user.MaxUpdates = accountLimitValue(*limits.MaxUpdatesPerHour)
+	}
+}
+
+// accountLimitValue returns the value stored in the mysql.user table for the account limit |val|, which is clamped to
+// the range of the table's columns.
+func accountLimitValue(val int64) uint64 {
+	if val < 0 {
+		return 0
+	}
+	if val > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint64(val)
+}
+
+// applyPasswordOptions sets the password options of |user| that |options| gives, leaving the others unchanged. The
+// failed logins of the account are cleared when its failed-login tracking options are set. PASSWORD EXPIRE isn't
+// applied, as it must be applied after any new password is set.
+func applyPasswordOptions(mysqlDb *mysql_db.MySQLDb, user *mysql_db.User, options *plan.PasswordOptions) {
+	if options == nil {
+		return
+	}
+	if options.ExpirationTime != nil {
+		user.PasswordLifetime = passwordPolicyValue(*options.ExpirationTime)
+	}
+	if options.History != nil {
+		user.PasswordReuseHistory = passwordPolicyValue(*options.History)
+	}
+	if options.ReuseInterval != nil {
+		user.PasswordReuseTime = passwordPolicyValue(*options.ReuseInterval)
+	}
+	if options.FailedAttempts != nil || options.LockTime != nil {
+		if options.FailedAttempts != nil {
+			user.FailedLoginAttempts = uint16(clampInt64(*options.FailedAttempts, 0, math.MaxInt16))
+		}
+		if options.LockTime != nil {
+			if *options.LockTime == plan.PasswordLockTimeUnbounded {
+				user.PasswordLockTime = mysql_db.PasswordLockTimeUnbounded
+			} else {
+				user.PasswordLockTime = int16(clampInt64(*options.LockTime, 0, math.MaxInt16))
+			}
+		}
+		mysqlDb.ResetFailedLogins(user.User, user.Host)
+	}
+}
+
+// passwordPolicyValue returns the value stored in the mysql.user table for the password policy option |val|, which is
+// nil for DEFAULT.
+func passwordPolicyValue(val int64) *uint16 {
+	if val == plan.PasswordOptionDefault {
+		return nil
+	}
+	v := uint16(clampInt64(val, 0, math.MaxUint16))
+	return &v
+}
+
+func clampInt64(val, min, max int64) int64 {
+	if val < min {
+		return min
+	}
+	if val > max {
+		return max
+	}
+	return val
+}
+
 // parseTlsOptions examples |tlsOptions| and returns the sslType, sslCipher, x509Issuer, and x509Subject values. If |tlsOptions| is nil,
 // then all returned values are empty strings. All returned values are the values MySQL shows in the mysql.user system table, for the
 // columns with the same names.
@@ -844,6 +960,29 @@ func (b *BaseBuilder) buildDropProcedure(ctx *sql.Context, n *plan.DropProcedure
 	return rowIterWithOkResultWithZeroRowsAffected(), nil
 }
 
+func (b *BaseBuilder) buildDropFunction(ctx *sql.Context, n *plan.DropFunction, row sql.Row) (sql.RowIter, error) {
+	fnDb, ok := n.Db.(sql.StoredFunctionDatabase)
+	if !ok {
+		if n.IfExists {
+			return rowIterWithOkResultWithZeroRowsAffected(), nil
+		} else {
+			return nil, sql.ErrStoredFunctionsNotSupported.New(n.Db.Name())
+		}
+	}
+	err := fnDb.DropStoredFunction(ctx, n.FunctionName)
+	if n.IfExists && sql.ErrStoredFunctionDoesNotExist.Is(err) {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Note",
+			Code:    sql.CastSQLError(err).Num,
+			Message: err.Error(),
+		})
+		return rowIterWithOkResultWithZeroRowsAffected(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
 func (b *BaseBuilder) buildDropDB(ctx *sql.Context, n *plan.DropDB, row sql.Row) (sql.RowIter, error) {
 	exists := n.Catalog.HasDatabase(ctx, n.DbName)
 	if !exists {
@@ -1017,6 +1156,10 @@ func (b *BaseBuilder) buildRenameColumn(ctx *sql.Context, n *plan.RenameColumn,
 	if err = alterable.ModifyColumn(ctx, n.ColumnName, col, nil); err != nil {
 		return nil, err
 	}
+	if err = renameColumnReferences(ctx, b.schemaFormatter, n.Db, alterable, n.ColumnName, n.NewColumnName); err != nil {
+		return nil, err
+	}
+	warnDependentRoutines(ctx, n.Db, tbl.Name(), n.ColumnName)
 	if b.EngineOverrides.Hooks.TableRenameColumn.PostSQLExecution != nil {
 		if err = b.EngineOverrides.Hooks.TableRenameColumn.PostSQLExecution(ctx, b.Runner, n); err != nil {
 			return nil, err
@@ -1143,7 +1286,8 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 		maybePrivDb = privDb.Unwrap()
 	}
 
-	if v, ok := maybePrivDb.(sql.SchemaObjectNameValidator); ok {
+	// a temporary table may have the name of a permanent table, which it shadows
+	if v, ok := maybePrivDb.(sql.SchemaObjectNameValidator); ok && !n.Temporary() {
 		nameAlreadyUsed, err := v.ValidateNewTableName(ctx, n.Name(), n.IfNotExists())
 		if err != nil {
 			return nil, err
@@ -1154,11 +1298,11 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 
 	comment, _ := n.TableOpts["comment"].(string)
 	if n.Temporary() {
-		creatable, ok := maybePrivDb.(sql.TemporaryTableCreator)
-		if !ok {
-			return sql.RowsToRowIter(), sql.ErrTemporaryTableNotSupported.New()
+		if creatable, ok := maybePrivDb.(sql.TemporaryTableCreator); ok {
+			err = creatable.CreateTemporaryTable(ctx, n.Name(), n.PkSchema(), n.Collation)
+		} else {
+			err = createSessionTemporaryTable(ctx, n.Db.Name(), n.Name(), n.PkSchema(), n.Collation, comment)
 		}
-		err = creatable.CreateTemporaryTable(ctx, n.Name(), n.PkSchema(), n.Collation)
 	} else {
 		switch creatable := maybePrivDb.(type) {
 		case sql.IndexedTableCreator:
@@ -1203,7 +1347,7 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 		return sql.RowsToRowIter(), err
 	}
 
-	if vdb, vok := n.Db.(sql.ViewDatabase); vok {
+	if vdb, vok := n.Db.(sql.ViewDatabase); vok && !n.Temporary() {
 		_, ok, err := vdb.GetViewDefinition(ctx, n.Name())
 		if err != nil {
 			return nil, err
@@ -1216,7 +1360,7 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 	// TODO: in the event that foreign keys or indexes aren't supported, you'll be left with a created table and no
 	//  foreign keys/indexes This also means that if a foreign key or index fails, the table will still have been
 	//  created anyways, just without the foreign key or index https://github.com/dolthub/dolt/issues/11082
-	tableNode, ok, err := n.Db.GetTableInsensitive(ctx, n.Name())
+	tableNode, ok, err := sql.GetSessionTableInsensitive(ctx, n.Db, n.Name())
 	if err != nil {
 		return sql.RowsToRowIter(), err
 	}
@@ -1263,6 +1407,26 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 		}
 	}
 
+	if compression, hasCompression := n.TableOpts["compression"]; hasCompression {
+		alterable, ok := tableNode.(sql.CompressionAlterableTable)
+		if ok {
+			err = alterable.ModifyCompression(ctx, compression.(string))
+			if err != nil {
+				return sql.RowsToRowIter(), err
+			}
+		}
+	}
+
+	if scheme, ok := n.TableOpts["partition_scheme"].(sql.PartitionScheme); ok {
+		// tables that don't support partitioning are created without partitions
+		if alterable, ok := tableNode.(sql.PartitionAlterableTable); ok {
+			err = alterable.SetPartitionScheme(ctx, scheme)
+			if err != nil {
+				return sql.RowsToRowIter(), err
+			}
+		}
+	}
+
 	var nonPrimaryIdxes sql.IndexDefs
 	for _, def := range n.Indexes() {
 		if !def.IsPrimary() {
@@ -1300,6 +1464,32 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 	return rowIterWithOkResultWithZeroRowsAffected(), nil
 }
 
+// createSessionTemporaryTable creates a temporary table for a database that doesn't implement
+// sql.TemporaryTableCreator. The table is kept in memory by the session rather than by the database, so its changes
+// never reach the database's transactions or binlog, and it's dropped along with the session.
+func createSessionTemporaryTable(ctx *sql.Context, dbName, name string, schema sql.PrimaryKeySchema, collation sql.CollationID, comment string) error {
+	tts, ok := ctx.Session.(sql.TemporaryTableSession)
+	if !ok {
+		return sql.ErrTemporaryTableNotSupported.New()
+	}
+	if !tts.AddTemporaryTable(dbName, memory.NewTemporaryTable(ctx, dbName, name, schema, collation, comment)) {
+		return sql.ErrTableAlreadyExists.New(name)
+	}
+	return nil
+}
+
+// dropSessionTemporaryTable drops |table| if it's one of the temporary tables of the session, returning whether it was.
+func dropSessionTemporaryTable(ctx *sql.Context, table *plan.ResolvedTable) bool {
+	tts, ok := ctx.Session.(sql.TemporaryTableSession)
+	if !ok || table.SqlDatabase == nil {
+		return false
+	}
+	if tmp := getTempTable(table); tmp == nil || !tmp.IsTemporary() {
+		return false
+	}
+	return tts.DropTemporaryTable(table.SqlDatabase.Name(), table.Name())
+}
+
 // buildCreateTableForeignKeys creates, validates, and resolves the foreign keys that are part of a CreateTable
 func (b *BaseBuilder) buildCreateTableForeignKeys(ctx *sql.Context, n *plan.CreateTable, tableNode sql.Table) error {
 	fkTbl, ok := tableNode.(sql.ForeignKeyTable)
@@ -1420,7 +1610,11 @@ func createIndexesForCreateTable(ctx *sql.Context, db sql.Database, tableNode sq
 			continue
 		}
 
-		err = idxAltTbl.CreateIndex(ctx, *idxDef)
+		if idxDef.IsVector() {
+			err = vector.CreateIndex(ctx, idxAltTbl, *idxDef)
+		} else {
+			err = idxAltTbl.CreateIndex(ctx, *idxDef)
+		}
 		if err != nil {
 			return err
 		}
@@ -1433,6 +1627,9 @@ func createIndexesForCreateTable(ctx *sql.Context, db sql.Database, tableNode sq
 
 	// Evaluate our Full-Text indexes now
 	if len(fulltextIndexes) > 0 {
+		if tmp := getTempTable(idxAltTbl); tmp != nil && tmp.IsTemporary() {
+			return sql.ErrTemporaryTablesFullTextSupport.New()
+		}
 		var database fulltext.Database
 		database, err = getFulltextDatabase(db)
 		if err != nil {
@@ -1454,6 +1651,14 @@ func (b *BaseBuilder) buildCreateProcedure(ctx *sql.Context, n *plan.CreateProce
 	}, nil
 }
 
+func (b *BaseBuilder) buildCreateFunction(ctx *sql.Context, n *plan.CreateFunction, row sql.Row) (sql.RowIter, error) {
+	return &createFunctionIter{
+		sfd:         n.StoredFunctionDetails,
+		db:          n.Database(),
+		ifNotExists: n.IfNotExists,
+	}, nil
+}
+
 func (b *BaseBuilder) buildCreateTrigger(ctx *sql.Context, n *plan.CreateTrigger, row sql.Row) (sql.RowIter, error) {
 	sqlMode := sql.LoadSqlMode(ctx)
 	return &createTriggerIter{
@@ -1462,6 +1667,7 @@ func (b *BaseBuilder) buildCreateTrigger(ctx *sql.Context, n *plan.CreateTrigger
 			CreateStatement: n.CreateTriggerString,
 			CreatedAt:       n.CreatedAt,
 			SqlMode:         sqlMode.String(),
+			Definer:         n.Definer,
 		},
 		db: n.Database(),
 	}, nil
@@ -1524,6 +1730,89 @@ func (b *BaseBuilder) buildAlterTableComment(ctx *sql.Context, n *plan.AlterTabl
 	return rowIterWithOkResultWithZeroRowsAffected(), alterable.ModifyComment(ctx, n.Comment)
 }
 
+func (b *BaseBuilder) buildAlterPartition(ctx *sql.Context, n *plan.AlterPartition, row sql.Row) (sql.RowIter, error) {
+	tbl, err := getTableFromDatabase(ctx, n.Database(), n.Table)
+	if err != nil {
+		return nil, err
+	}
+	alterable, ok := tbl.(sql.PartitionAlterableTable)
+	if !ok {
+		return nil, sql.ErrPartitionMgmtOnNonPartitioned.New()
+	}
+	if n.Action == plan.PartitionAction_Partition {
+		return rowIterWithOkResultWithZeroRowsAffected(), alterable.SetPartitionScheme(ctx, n.Scheme)
+	}
+
+	scheme := alterable.PartitionScheme(ctx)
+	if !scheme.IsPartitioned() {
+		return nil, sql.ErrPartitionMgmtOnNonPartitioned.New()
+	}
+	isHash := scheme.Method == sql.PartitionMethodHash || scheme.Method == sql.PartitionMethodKey
+	for _, name := range n.Names {
+		if scheme.PartitionIndex(name) < 0 {
+			if n.Action == plan.PartitionAction_Drop {
+				return nil, sql.ErrPartitionNonExistent.New("DROP")
+			}
+			return nil, sql.ErrPartitionNonExistent.New("TRUNCATE")
+		}
+	}
+
+	switch n.Action {
+	case plan.PartitionAction_Remove:
+		err = alterable.SetPartitionScheme(ctx, sql.PartitionScheme{})
+	case plan.PartitionAction_Add:
+		sch := tbl.Schema(ctx)
+		idx := sch.IndexOfColName(scheme.Column)
+		if idx < 0 {
+			return nil, sql.ErrPartitionFieldNotFound.New()
+		}
+		// partitions can only be added after the last partition of a table partitioned by RANGE
+		if scheme.Method == sql.PartitionMethodRange && scheme.Partitions[len(scheme.Partitions)-1].LessThan == nil {
+			return nil, sql.ErrPartitionRangeNotIncreasing.New()
+		}
+		newScheme := scheme
+		newScheme.Partitions = append(slices.Clone(scheme.Partitions), n.Definitions...)
+		if err = newScheme.Validate(ctx, sch[idx].Type); err != nil {
+			return nil, err
+		}
+		err = alterable.AddPartitions(ctx, n.Definitions)
+	case plan.PartitionAction_Drop:
+		if isHash {
+			return nil, sql.ErrPartitionOnlyOnRangeList.New("DROP")
+		}
+		dropped := make(map[string]struct{})
+		for _, name := range n.Names {
+			dropped[strings.ToLower(name)] = struct{}{}
+		}
+		if len(dropped) >= len(scheme.Partitions) {
+			return nil, sql.ErrDropLastPartition.New()
+		}
+		err = alterable.DropPartitions(ctx, n.Names)
+	case plan.PartitionAction_Truncate:
+		names := n.Names
+		if len(names) == 0 {
+			for _, def := range scheme.Partitions {
+				names = append(names, def.Name)
+			}
+		}
+		err = alterable.TruncatePartitions(ctx, names)
+	case plan.PartitionAction_Coalesce:
+		if !isHash {
+			return nil, sql.ErrPartitionCoalesceOnlyOnHashKey.New()
+		}
+		if n.Count >= len(scheme.Partitions) {
+			return nil, sql.ErrDropLastPartition.New()
+		}
+		newScheme := scheme
+		newScheme.Partitions = slices.Clone(scheme.Partitions[:len(scheme.Partitions)-n.Count])
+		err = alterable.SetPartitionScheme(ctx, newScheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
 func (b *BaseBuilder) buildCreateForeignKey(ctx *sql.Context, n *plan.CreateForeignKey, row sql.Row) (sql.RowIter, error) {
 	db, err := n.DbProvider.Database(ctx, n.FkDef.Database)
 	if err != nil {
@@ -1617,3 +1906,154 @@ func (b *BaseBuilder) buildCreateForeignKey(ctx *sql.Context, n *plan.CreateFore
 func rowIterWithOkResultWithZeroRowsAffected() sql.RowIter {
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0)))
 }
+
+// renameColumnReferences renames the column |oldName| of |table| to |newName| in the check constraints of the table, and
+// in the views and triggers of |db| that refer to it. Check constraints and triggers are replaced by dropping and
+// creating them again, along with the ones after them, so they keep their order.
+func renameColumnReferences(ctx *sql.Context, formatter sql.SchemaFormatter, db sql.Database, table sql.Table, oldName, newName string) error {
+	if strings.EqualFold(oldName, newName) {
+		return nil
+	}
+
+	checkTable, isCheckTable := table.(sql.CheckTable)
+	alterable, isAlterable := table.(sql.CheckAlterableTable)
+	if isCheckTable && isAlterable {
+		checks, err := checkTable.GetChecks(ctx)
+		if err != nil {
+			return err
+		}
+		// the checks are changed below, and then dropped, which may change the slice the table returned
+		checks = slices.Clone(checks)
+		first := -1
+		for i := range checks {
+			if expr := planbuilder.RenameColumnInCheck(checks[i].CheckExpression, formatter, oldName, newName); expr != "" {
+				checks[i].CheckExpression = expr
+				if first < 0 {
+					first = i
+				}
+			}
+		}
+		if first >= 0 {
+			for _, check := range checks[first:] {
+				if err = alterable.DropCheck(ctx, check.Name); err != nil {
+					return err
+				}
+			}
+			for i := range checks[first:] {
+				if err = alterable.CreateCheck(ctx, &checks[first+i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// views and triggers that can't be parsed are left as they are
+	if viewDb, ok := db.(sql.ViewDatabase); ok {
+		views, err := viewDb.AllViews(ctx)
+		if err != nil {
+			return err
+		}
+		for _, view := range views {
+			opts := sql.NewSqlModeFromString(view.SqlMode).ParserOptions()
+			createStatement, definition, err := planbuilder.RenameColumnReferences(ctx, view.CreateViewStatement, opts, db.Name(), table.Name(), oldName, newName)
+			if err != nil || createStatement == "" {
+				continue
+			}
+			if err = viewDb.DropView(ctx, view.Name); err != nil {
+				return err
+			}
+			if err = viewDb.CreateView(ctx, view.Name, definition, createStatement); err != nil {
+				return err
+			}
+		}
+	}
+
+	if triggerDb, ok := db.(sql.TriggerDatabase); ok {
+		triggers, err := triggerDb.GetTriggers(ctx)
+		if err != nil {
+			return err
+		}
+		first := -1
+		for i, trigger := range triggers {
+			opts := sql.NewSqlModeFromString(trigger.SqlMode).ParserOptions()
+			createStatement, _, err := planbuilder.RenameColumnReferences(ctx, trigger.CreateStatement, opts, db.Name(), table.Name(), oldName, newName)
+			if err != nil || createStatement == "" {
+				continue
+			}
+			triggers[i].CreateStatement = createStatement
+			if first < 0 {
+				first = i
+			}
+		}
+		if first >= 0 {
+			for _, trigger := range triggers[first:] {
+				if err = triggerDb.DropTrigger(ctx, trigger.Name); err != nil {
+					return err
+				}
+			}
+			for _, trigger := range triggers[first:] {
+				if err = triggerDb.CreateTrigger(ctx, trigger); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// warnDependentRoutines adds a warning for each trigger, stored procedure and stored function of |db| whose body refers
+// to |table|, which a DDL statement has just dropped or renamed, or to one of its |columns|, if any are given. The
+// bodies of routines aren't resolved until they're executed, so they're left in place and will fail when they are, and
+// are reported as invalid by information_schema. Failures to load or parse them are ignored, since the statement has
+// already taken effect.
+func warnDependentRoutines(ctx *sql.Context, db sql.Database, table string, columns ...string) {
+	dropped := fmt.Sprintf(`table "%s"`, table)
+	dependsOn := func(createStatement, sqlMode string) bool {
+		deps, err := planbuilder.RoutineDependencies(ctx, createStatement, sql.NewSqlModeFromString(sqlMode).ParserOptions())
+		if err != nil {
+			return false
+		}
+		for _, dep := range deps {
+			if (dep.Database != "" && !strings.EqualFold(dep.Database, db.Name())) || !strings.EqualFold(dep.Table, table) {
+				continue
+			}
+			if len(columns) == 0 {
+				return true
+			}
+			for _, col := range dep.Columns {
+				for _, c := range columns {
+					if strings.EqualFold(col, c) {
+						dropped = fmt.Sprintf(`column "%s.%s"`, table, c)
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+
+	if triggerDb, ok := db.(sql.TriggerDatabase); ok {
+		triggers, _ := triggerDb.GetTriggers(ctx)
+		for _, trigger := range triggers {
+			if dependsOn(trigger.CreateStatement, trigger.SqlMode) {
+				ctx.Warn(mysql.ERUnknownError, "%s", sql.ErrRoutineDependencyDropped.New("trigger", trigger.Name, dropped).Error())
+			}
+		}
+	}
+	if procDb, ok := db.(sql.StoredProcedureDatabase); ok {
+		procs, _ := procDb.GetStoredProcedures(ctx)
+		for _, proc := range procs {
+			if dependsOn(proc.CreateStatement, proc.SqlMode) {
+				ctx.Warn(mysql.ERUnknownError, "%s", sql.ErrRoutineDependencyDropped.New("procedure", proc.Name, dropped).Error())
+			}
+		}
+	}
+	if funcDb, ok := db.(sql.StoredFunctionDatabase); ok {
+		funcs, _ := funcDb.GetStoredFunctions(ctx)
+		for _, fn := range funcs {
+			if dependsOn(fn.CreateStatement, fn.SqlMode) {
+				ctx.Warn(mysql.ERUnknownError, "%s", sql.ErrRoutineDependencyDropped.New("function", fn.Name, dropped).Error())
+			}
+		}
+	}
+}
@@ -29,7 +29,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/vector"
 	"github.com/dolthub/go-mysql-server/sql/fulltext"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -41,6 +43,9 @@ import (
 type loadDataIter struct {
 	reader  io.ReadCloser
 	scanner *bufio.Scanner
+	// decoder converts lines from the character set of the file, and is nil when they need no conversion
+	decoder encodings.Encoder
+	charset string
 
 	fieldsTerminatedBy string
 	linesTerminatedBy  string
@@ -80,7 +85,15 @@ func (l *loadDataIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr erro
 			}
 			return nil, io.EOF
 		}
-		exprs, err = l.parseFields(ctx, l.scanner.Text())
+		line := l.scanner.Text()
+		if l.decoder != nil {
+			decoded, ok := l.decoder.Decode(l.scanner.Bytes())
+			if !ok {
+				return nil, sql.ErrCharSetInvalidString.New(l.charset, l.scanner.Text())
+			}
+			line = string(decoded)
+		}
+		exprs, err = l.parseFields(ctx, line)
 		if err != nil {
 			return nil, err
 		}
@@ -148,9 +161,13 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 		line = line[:len(line)-len(l.linesTerminatedBy)]
 	}
 
-	var fields []string
+	// Fields that are NULL are nil, and the others are strings
+	var fields []interface{}
 	var currentField strings.Builder
 	inEnclosure := false
+	// enclosed is whether the current field was enclosed, and escapedNull whether it began with the escaped N
+	enclosed := false
+	escapedNull := false
 	termLen := len(l.fieldsTerminatedBy)
 	hasEnc := l.fieldsEnclosedBy != ""
 	hasEsc := l.fieldsEscapedBy != ""
@@ -158,6 +175,20 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 	// False only at EOF with enc==esc: ambiguous whether final char closes field or is literal data
 	normalLineTerm := hasTerminator || !encEqualsEsc
 
+	// endField appends the current field to the fields. The escaped N is read as NULL, and so is the word NULL when
+	// fields may be enclosed or there's no escape character, unless the word itself was enclosed.
+	endField := func() {
+		field := currentField.String()
+		if field == "NULL" && (escapedNull || (!enclosed && (hasEnc || !hasEsc))) {
+			fields = append(fields, nil)
+		} else {
+			fields = append(fields, field)
+		}
+		currentField.Reset()
+		enclosed = false
+		escapedNull = false
+	}
+
 	for i := 0; i < len(line); i++ {
 		ch := line[i]
 		isEncChar := hasEnc && ch == l.fieldsEnclosedBy[0]
@@ -165,13 +196,14 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 		isEscChar := hasEsc && !encEqualsEsc && ch == l.fieldsEscapedBy[0]
 
 		// Start enclosure at beginning of field
-		if isEncChar && !inEnclosure && currentField.Len() == 0 {
+		if isEncChar && !inEnclosure && currentField.Len() == 0 && !enclosed {
 			inEnclosure = true
+			enclosed = true
 			continue
 		}
 
-		// Special case: escaped enclosure character does not end enclosure and is written literally
-		if isEncChar && inEnclosure && encEqualsEsc && i+1 < len(line) && line[i+1] == l.fieldsEnclosedBy[0] {
+		// A doubled enclosure character does not end the enclosure and is written literally
+		if isEncChar && inEnclosure && i+1 < len(line) && line[i+1] == l.fieldsEnclosedBy[0] {
 			currentField.WriteByte(l.fieldsEnclosedBy[0])
 			i++
 			continue
@@ -194,6 +226,7 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 			i++
 			switch line[i] {
 			case 'N':
+				escapedNull = currentField.Len() == 0
 				currentField.WriteString("NULL")
 			case 'Z':
 				currentField.WriteByte(26)
@@ -215,8 +248,7 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 
 		// Handle field terminator (only outside enclosures)
 		if !inEnclosure && i+termLen <= len(line) && line[i:i+termLen] == l.fieldsTerminatedBy {
-			fields = append(fields, currentField.String())
-			currentField.Reset()
+			endField()
 			i += termLen - 1
 			continue
 		}
@@ -224,14 +256,16 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 		currentField.WriteByte(ch)
 	}
 
-	lastField := currentField.String()
 	// If still in enclosure at EOF when enc==esc, prepend the opening enclosure that was stripped
 	if inEnclosure {
-		lastField = string(l.fieldsEnclosedBy[0]) + lastField
+		unterminated := currentField.String()
+		currentField.Reset()
+		currentField.WriteString(string(l.fieldsEnclosedBy[0]) + unterminated)
+		enclosed = false
 	}
-	fields = append(fields, lastField)
+	endField()
 
-	exprs, colListRow, rowFieldToColMap, err := l.inputPreprocessor(ctx, fields)
+	exprs, colListRow, rowFieldToColMap, missing, err := l.inputPreprocessor(ctx, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -254,21 +288,21 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 		field := colListRow[exprIdx]
 		destCol := l.destSch[destColIdx]
 
-		if field != nil {
+		if !missing[exprIdx] {
 			switch field {
+			case nil:
+				exprs[exprIdx] = expression.NewLiteral(nil, types.Null)
 			case "":
 				if _, ok := destCol.Type.(sql.StringType); ok {
 					exprs[exprIdx] = expression.NewLiteral(field, types.LongText)
 				}
-			case "NULL":
-				exprs[exprIdx] = expression.NewLiteral(nil, types.Null)
 			default:
 				exprs[exprIdx] = expression.NewLiteral(field, types.LongText)
 			}
 			continue
 		}
 
-		// If the field is still nil, the input line did not contain enough fields to satisfy the column list. For
+		// If the field is missing, the input line did not contain enough fields to satisfy the column list. For
 		// non-nullable columns, MySQL treats this as a data truncation and assigns the implicit "zero value" for the
 		// data type (e.g. an empty string or 0) instead of the explicit schema default.
 		if !destCol.Nullable && !destCol.AutoIncrement {
@@ -286,15 +320,18 @@ func (l *loadDataIter) parseFields(ctx *sql.Context, line string) (exprs []sql.E
 // a column), and to reindex new field positions without user variables into a [sql.Row], and [sql.Expression] array.
 // Per row results can differentiate, and we only care about column fields for expressions anyway, so we don't include
 // user variables in the returned results of this function. If a user variable is included in the returned [sql.Row], it
-// could mess with the projection of other fields, because it offsets anything that comes after it.
+// could mess with the projection of other fields, because it offsets anything that comes after it. The fields of the
+// column list that the line had too few fields for are marked in |missing|, as their values in the row are nil, just as
+// NULL fields are.
 //
 // For more information on preprocessors, see the documentation for "[Input Preprocessing]".
 //
 // [Input Preprocessing]: https://dev.mysql.com/doc/refman/9.5/en/load-data.html#load-data-input-preprocessing
-func (l *loadDataIter) inputPreprocessor(ctx *sql.Context, parsedFields []string) (expressions []sql.Expression, colListRow sql.Row, rowFieldToColMap map[int]int, err error) {
+func (l *loadDataIter) inputPreprocessor(ctx *sql.Context, parsedFields []interface{}) (expressions []sql.Expression, colListRow sql.Row, rowFieldToColMap map[int]int, missing []bool, err error) {
 	colListRow = make(sql.Row, len(l.destSch))
 	expressions = make([]sql.Expression, len(l.destSch))
 	rowFieldToColMap = make(map[int]int)
+	missing = make([]bool, len(l.destSch))
 	// colListIdx must only increment on column fields or preprocessors.
 	colListIdx := 0
 	for fieldIdx, destColIdx := range l.fieldToColMap {
@@ -304,7 +341,7 @@ func (l *loadDataIter) inputPreprocessor(ctx *sql.Context, parsedFields []string
 			if fieldIdx >= len(parsedFields) {
 				err = ctx.SetUserVariable(ctx, userVar.Name, nil, types.Null)
 				if err != nil {
-					return nil, nil, nil, err
+					return nil, nil, nil, nil, err
 				}
 				continue
 			}
@@ -313,7 +350,7 @@ func (l *loadDataIter) inputPreprocessor(ctx *sql.Context, parsedFields []string
 			fieldType := types.ApproximateTypeFromValue(field)
 			err = ctx.SetUserVariable(ctx, userVar.Name, field, fieldType)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, nil, err
 			}
 			continue
 		}
@@ -336,6 +373,7 @@ func (l *loadDataIter) inputPreprocessor(ctx *sql.Context, parsedFields []string
 		}
 
 		if fieldIdx >= len(parsedFields) {
+			missing[colListIdx] = true
 			colListIdx++
 			continue
 		}
@@ -347,7 +385,7 @@ func (l *loadDataIter) inputPreprocessor(ctx *sql.Context, parsedFields []string
 		colListIdx++
 	}
 
-	return expressions, colListRow, rowFieldToColMap, nil
+	return expressions, colListRow, rowFieldToColMap, missing, nil
 }
 
 type modifyColumnIter struct {
@@ -355,6 +393,7 @@ type modifyColumnIter struct {
 	alterable sql.AlterableTable
 	overrides sql.EngineOverrides
 	runner    sql.StatementRunner
+	formatter sql.SchemaFormatter
 	runOnce   bool
 }
 
@@ -419,9 +458,9 @@ func (i *modifyColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 				if tblSch[idx].Type.Type() == i.m.NewColumn().Type.Type() {
 					switch i.m.NewColumn().Type.Type() {
 					case sqltypes.Char, sqltypes.VarChar, sqltypes.Binary, sqltypes.VarBinary:
-						oldType := tblSch[idx].Type.(sql.StringType)
-						newType := i.m.NewColumn().Type.(sql.StringType)
-						if oldType.Collation() != newType.Collation() || oldType.MaxCharacterLength() > newType.MaxCharacterLength() {
+						oldType, oldOk := tblSch[idx].Type.(sql.StringType)
+						newType, newOk := i.m.NewColumn().Type.(sql.StringType)
+						if !oldOk || !newOk || oldType.Collation() != newType.Collation() || oldType.MaxCharacterLength() > newType.MaxCharacterLength() {
 							return nil, sql.ErrForeignKeyTypeChange.New(i.m.Column())
 						}
 					default:
@@ -460,6 +499,9 @@ func (i *modifyColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 			return nil, err
 		}
 		if rewritten {
+			if err = i.renameReferences(ctx); err != nil {
+				return nil, err
+			}
 			if i.overrides.Hooks.TableModifyColumn.PostSQLExecution != nil {
 				if err = i.overrides.Hooks.TableModifyColumn.PostSQLExecution(ctx, i.runner, i.m); err != nil {
 					return nil, err
@@ -478,6 +520,9 @@ func (i *modifyColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = i.renameReferences(ctx); err != nil {
+		return nil, err
+	}
 
 	if hasFullText {
 		if err = rebuildFullText(ctx, i.alterable.Name(), i.m.Db); err != nil {
@@ -492,6 +537,19 @@ func (i *modifyColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return sql.NewRow(types.NewOkResult(0)), nil
 }
 
+// renameReferences renames the column in the check constraints, views and triggers that refer to it, if it was
+// renamed, and warns about the stored procedures that still refer to it by its old name.
+func (i *modifyColumnIter) renameReferences(ctx *sql.Context) error {
+	if strings.EqualFold(i.m.Column(), i.m.NewColumn().Name) {
+		return nil
+	}
+	if err := renameColumnReferences(ctx, i.formatter, i.m.Db, i.alterable, i.m.Column(), i.m.NewColumn().Name); err != nil {
+		return err
+	}
+	warnDependentRoutines(ctx, i.m.Db, i.alterable.Name(), i.m.Column())
+	return nil
+}
+
 func handleFkColumnRename(ctx *sql.Context, fkTable sql.ForeignKeyTable, db sql.Database, oldName string, newName string) error {
 	lowerOldName := strings.ToLower(oldName)
 	if lowerOldName == strings.ToLower(newName) {
@@ -698,7 +756,7 @@ func (i *modifyColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTabl
 			}
 		}
 
-		newRow, err := projectRowWithTypes(ctx, targetSchema, newSch, projections, r)
+		newRow, err := projectRowWithTypes(ctx, newSch, projections, r)
 		if err != nil {
 			_ = inserter.DiscardChanges(ctx, err)
 			_ = inserter.Close(ctx)
@@ -1053,14 +1111,14 @@ func (i *loggingKeyValueIter) Close(ctx *sql.Context) error {
 
 // projectRowWithTypes projects the row given with the projections given and additionally converts them to the
 // corresponding types found in the schema given, using the standard type conversion logic.
-func projectRowWithTypes(ctx *sql.Context, oldSchema, newSchema sql.Schema, projections []sql.Expression, r sql.Row) (sql.Row, error) {
+func projectRowWithTypes(ctx *sql.Context, newSchema sql.Schema, projections []sql.Expression, r sql.Row) (sql.Row, error) {
 	newRow, err := ProjectRow(ctx, projections, r)
 	if err != nil {
 		return nil, err
 	}
 
 	for i := range newRow {
-		converted, inRange, err := types.TypeAwareConversion(ctx, newRow[i], oldSchema[i].Type, newSchema[i].Type)
+		converted, inRange, err := types.ConvertColumnValue(ctx, newRow[i], projections[i].Type(ctx), newSchema[i].Type)
 		if err != nil {
 			if sql.ErrNotMatchingSRID.Is(err) {
 				err = sql.ErrNotMatchingSRIDWithColName.New(newSchema[i].Name, err)
@@ -1084,7 +1142,7 @@ func getTableFromDatabase(ctx *sql.Context, db sql.Database, tableNode sql.Node)
 	// Grab the table fresh from the database.
 	tableName := getTableName(tableNode)
 
-	table, ok, err := db.GetTableInsensitive(ctx, tableName)
+	table, ok, err := sql.GetSessionTableInsensitive(ctx, db, tableName)
 	if err != nil {
 		return nil, err
 	}
@@ -1845,6 +1903,48 @@ func (c *createProcedureIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
+// createFunctionIter is the row iterator for *CreateFunction.
+type createFunctionIter struct {
+	db          sql.Database
+	sfd         sql.StoredFunctionDetails
+	ifNotExists bool
+	once        sync.Once
+}
+
+// Next implements the sql.RowIter interface.
+func (c *createFunctionIter) Next(ctx *sql.Context) (sql.Row, error) {
+	run := false
+	c.once.Do(func() {
+		run = true
+	})
+	if !run {
+		return nil, io.EOF
+	}
+	fdb, ok := c.db.(sql.StoredFunctionDatabase)
+	if !ok {
+		return nil, sql.ErrStoredFunctionsNotSupported.New(c.db.Name())
+	}
+
+	err := fdb.SaveStoredFunction(ctx, c.sfd)
+	if c.ifNotExists && sql.ErrStoredFunctionAlreadyExists.Is(err) {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Note",
+			Code:    sql.CastSQLError(err).Num,
+			Message: err.Error(),
+		})
+		return sql.Row{types.NewOkResult(0)}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return sql.Row{types.NewOkResult(0)}, nil
+}
+
+// Close implements the sql.RowIter interface.
+func (c *createFunctionIter) Close(ctx *sql.Context) error {
+	return nil
+}
+
 type createTriggerIter struct {
 	ctx        *sql.Context
 	db         sql.Database
@@ -1910,6 +2010,7 @@ func (i *dropColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 			return nil, err
 		}
 		if rewritten {
+			warnDependentRoutines(ctx, i.d.Db, i.alterable.Name(), i.d.Column)
 			if i.overrides.Hooks.TableDropColumn.PostSQLExecution != nil {
 				if err = i.overrides.Hooks.TableDropColumn.PostSQLExecution(ctx, i.runner, i.d); err != nil {
 					return nil, err
@@ -1931,6 +2032,7 @@ func (i *dropColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	warnDependentRoutines(ctx, i.d.Db, i.alterable.Name(), i.d.Column)
 
 	if hasFullText {
 		if err = rebuildFullText(ctx, i.alterable.Name(), i.d.Db); err != nil {
@@ -2081,41 +2183,90 @@ func (b *BaseBuilder) executeCreateCheck(ctx *sql.Context, c *plan.CreateCheck)
 		return err
 	}
 
-	// check existing rows in table, unless the constraint was created with NOT VALID
-	if !c.Check.IsNotValid {
-		var res interface{}
-		rowIter, err := b.buildNodeExec(ctx, c.Table, nil)
-		if err != nil {
+	// check existing rows in table, unless the constraint was created with NOT VALID or NOT ENFORCED
+	if c.Check.Enforced && !c.Check.IsNotValid {
+		// Earlier clauses of the same ALTER TABLE may have added or dropped columns, so the rows are read from the table
+		// as it is now
+		if err = validateExistingRows(ctx, table, c.Table, c.Check); err != nil {
 			return err
 		}
+	}
 
-		for {
-			row, err := rowIter.Next(ctx)
-			if err == io.EOF {
-				break
-			}
+	check, err := plan.NewCheckDefinition(ctx, c.Check, b.schemaFormatter)
+	if err != nil {
+		return err
+	}
 
-			if err != nil {
-				return err
-			}
+	return chAlterable.CreateCheck(ctx, check)
+}
 
-			res, err = sql.EvaluateCondition(ctx, c.Check.Expr, row)
-			if err != nil {
-				return err
-			}
+// validateExistingRows returns an error if any of the rows of |table| violates |check|. The column references of the
+// check are resolved against the current schema of |table|, and the values of its virtual columns are computed with
+// the projections of |plannedTable|, the table the check was analyzed against.
+func validateExistingRows(ctx *sql.Context, table sql.Table, plannedTable *plan.ResolvedTable, check *sql.CheckConstraint) (err error) {
+	sch := table.Schema(ctx)
+	checkExpr, _, err := transform.Expr(ctx, check.Expr, func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		idx := sch.IndexOfColName(gf.Name())
+		if idx < 0 {
+			return nil, transform.SameTree, sql.ErrTableColumnNotFound.New(table.Name(), gf.Name())
+		}
+		return gf.WithIndex(idx), transform.NewTree, nil
+	})
+	if err != nil {
+		return err
+	}
 
-			if sql.IsFalse(res) {
-				return sql.ErrCheckConstraintViolated.New(c.Check.Name)
+	var projections []sql.Expression
+	if vct, ok := plan.FindVirtualColumnTable(plannedTable.Table); ok {
+		plannedSch := vct.Schema(ctx)
+		projections = make([]sql.Expression, len(sch))
+		for i, col := range sch {
+			if idx := plannedSch.IndexOfColName(col.Name); col.Virtual && idx >= 0 {
+				projections[i] = assignColumnIndexes(ctx, vct.Projections[idx], sch)
+			} else {
+				projections[i] = expression.NewGetFieldWithTable(i, 1, col.Type, col.DatabaseSource, table.Name(), col.Name, col.Nullable)
 			}
 		}
 	}
 
-	check, err := plan.NewCheckDefinition(ctx, c.Check, b.schemaFormatter)
+	partitions, err := table.Partitions(ctx)
 	if err != nil {
 		return err
 	}
+	rowIter := sql.NewTableRowIter(ctx, table, partitions)
+	defer func() {
+		if cerr := rowIter.Close(ctx); err == nil {
+			err = cerr
+		}
+	}()
 
-	return chAlterable.CreateCheck(ctx, check)
+	for {
+		row, err := rowIter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if projections != nil {
+			row, err = ProjectRow(ctx, projections, row)
+			if err != nil {
+				return err
+			}
+		}
+
+		res, err := sql.EvaluateCondition(ctx, checkExpr, row)
+		if err != nil {
+			return err
+		}
+		if sql.IsFalse(res) {
+			return sql.ErrCheckConstraintViolated.New(check.Name)
+		}
+	}
 }
 
 func (b *BaseBuilder) executeDropCheck(ctx *sql.Context, n *plan.DropCheck) error {
@@ -2220,8 +2371,13 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 		// currentSchema tracks the table's schema as hidden system columns are added below, since an
 		// index may contain more than one functional expression. n.Table.Schema(ctx) reflects only the
 		// schema as of when the table was resolved and does not observe the AddColumn calls made by
-		// createHiddenSystemColumn on earlier loop iterations.
-		currentSchema := n.Table.Schema(ctx).Copy()
+		// createHiddenSystemColumn on earlier loop iterations. It starts from the target schema, whose
+		// generated column expressions have been resolved, as the rows projected into the hidden
+		// columns must also compute the table's other virtual columns.
+		currentSchema := n.TargetSchema().Copy()
+		if currentSchema == nil {
+			currentSchema = n.Table.Schema(ctx).Copy()
+		}
 		for i, idxCol := range n.Columns {
 			if idxCol.Expression != nil {
 				newColumn, err := b.createHiddenSystemColumn(ctx, n, idxCol.Expression, i, currentSchema)
@@ -2249,12 +2405,13 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 		indexName := n.IndexName
 		// TODO: this should really be a pointer, but there are too many interfaces that expect a value
 		indexDef := sql.IndexDef{
-			Name:       indexName,
-			Columns:    n.Columns,
-			Constraint: n.Constraint,
-			Storage:    n.Using,
-			Comment:    n.Comment,
-			Predicate:  n.Predicate,
+			Name:           indexName,
+			Columns:        n.Columns,
+			Constraint:     n.Constraint,
+			Storage:        n.Using,
+			Comment:        n.Comment,
+			Predicate:      n.Predicate,
+			VectorDistance: n.VectorDistance,
 		}
 		if len(indexName) == 0 {
 			indexDef.Name, err = getIndexNameGenerator(n.Db).GenerateIndexName(ctx, n.Table.Name(), indexDef, idxAltTbl)
@@ -2264,6 +2421,9 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 		}
 
 		if indexDef.IsFullText() {
+			if tmp := getTempTable(idxAltTbl); tmp != nil && tmp.IsTemporary() {
+				return sql.ErrTemporaryTablesFullTextSupport.New()
+			}
 			var database fulltext.Database
 			database, err = getFulltextDatabase(n.Db)
 			if err != nil {
@@ -2304,7 +2464,11 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 			}
 		}
 
-		err = idxAltTbl.CreateIndex(ctx, indexDef)
+		if indexDef.IsVector() {
+			err = vector.CreateIndex(ctx, idxAltTbl, indexDef)
+		} else {
+			err = idxAltTbl.CreateIndex(ctx, indexDef)
+		}
 		if err != nil {
 			if sql.ErrDuplicateKey.Is(err) && n.IfNotExists {
 				return nil
@@ -2827,7 +2991,7 @@ func hasFullText(ctx *sql.Context, tbl sql.Table) bool {
 
 // rebuildFullText rebuilds all Full-Text indexes on the given table.
 func rebuildFullText(ctx *sql.Context, tblName string, db sql.Database) error {
-	updatedTable, ok, err := db.GetTableInsensitive(ctx, tblName)
+	updatedTable, ok, err := sql.GetSessionTableInsensitive(ctx, db, tblName)
 	if err != nil {
 		return err
 	}
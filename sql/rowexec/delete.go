@@ -21,6 +21,7 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/hash"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -30,6 +31,28 @@ type schemaPositionDeleter struct {
 	deleter     sql.RowDeleter
 	schemaStart int
 	schemaEnd   int
+	// dedupe is whether the same row of the target can be returned more than once by the child iterator, as it can be
+	// for a DELETE FROM JOIN statement when the row joins with several rows of the other tables
+	dedupe  bool
+	deleted sql.KeyValueCache
+	dispose sql.DisposeFunc
+}
+
+// alreadyDeleted returns whether |row| has already been deleted by this deleter, and records it as deleted otherwise.
+func (d *schemaPositionDeleter) alreadyDeleted(ctx *sql.Context, row sql.Row) (bool, error) {
+	if d.deleted == nil {
+		d.deleted, d.dispose = ctx.Memory.NewHistoryCache(ctx)
+	}
+	key, err := hash.HashOf(ctx, nil, row)
+	if err != nil {
+		return false, err
+	}
+	if _, err = d.deleted.Get(key); err == nil {
+		return true, nil
+	} else if err != sql.ErrKeyNotFound {
+		return false, err
+	}
+	return false, d.deleted.Put(key, struct{}{})
 }
 
 // findSourcePosition searches the specified |schema| for the first group of columns whose source is |name|,
@@ -71,54 +94,84 @@ type deleteIter struct {
 	returnExprs  []sql.Expression
 	schema       sql.Schema
 	returnSchema sql.Schema
+	accumulator  *deleteRowHandler
 	closed       bool
 }
 
 func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
-	row, err := d.childIter.Next(ctx)
+	for {
+		row, err := d.childIter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrRowEditCanceled) {
+				continue
+			}
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
 
-	if err != nil {
-		if errors.Is(err, sql.ErrRowEditCanceled) {
-			return d.Next(ctx)
+		deleted, err := d.deleteRow(ctx, row)
+		if err != nil {
+			return nil, err
 		}
-		return nil, err
-	}
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
+		// every target row of this join row was already deleted by an earlier one
+		if !deleted {
+			continue
+		}
+
+		if len(d.returnExprs) > 0 {
+			var retExprRow sql.Row
+			for _, returnExpr := range d.returnExprs {
+				result, err := returnExpr.Eval(ctx, row)
+				if err != nil {
+					return nil, err
+				}
+				retExprRow = append(retExprRow, result)
+			}
+			return retExprRow, nil
+		}
+
+		return row, nil
 	}
+}
 
+// deleteRow deletes the rows of each target table in |row|, a row from the child iterator, and returns whether any of
+// them hadn't already been deleted.
+func (d *deleteIter) deleteRow(ctx *sql.Context, row sql.Row) (bool, error) {
 	// For each target table from which we are deleting rows, reduce the row from our child iterator to just
 	// the columns that are part of that target table. This means looking at the position in the schema for
 	// the target table and also removing any prepended columns contributed by outer scopes.
 	fullSchemaLength := len(d.schema)
 	rowLength := len(row)
-	for _, deleter := range d.deleters {
+	deletedAny := false
+	for i := range d.deleters {
+		deleter := &d.deleters[i]
 		schemaLength := deleter.schemaEnd - deleter.schemaStart
 		subSlice := row
 		if schemaLength < rowLength {
 			subSlice = row[(rowLength - fullSchemaLength + deleter.schemaStart):(rowLength - fullSchemaLength + deleter.schemaEnd)]
 		}
-		err = deleter.deleter.Delete(ctx, subSlice)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if len(d.returnExprs) > 0 {
-		var retExprRow sql.Row
-		for _, returnExpr := range d.returnExprs {
-			result, err := returnExpr.Eval(ctx, row)
+		if deleter.dedupe {
+			done, err := deleter.alreadyDeleted(ctx, subSlice)
 			if err != nil {
-				return nil, err
+				return false, err
 			}
-			retExprRow = append(retExprRow, result)
+			if done {
+				continue
+			}
+		}
+		if err := deleter.deleter.Delete(ctx, subSlice); err != nil {
+			return false, err
+		}
+		deletedAny = true
+		if d.accumulator != nil {
+			d.accumulator.handleRowDeleted()
 		}
-		return retExprRow, nil
 	}
-
-	return row, nil
+	return deletedAny, nil
 }
 
 func (d *deleteIter) Close(ctx *sql.Context) error {
@@ -132,6 +185,9 @@ func (d *deleteIter) Close(ctx *sql.Context) error {
 			if err != nil && firstErr == nil {
 				firstErr = err
 			}
+			if deleter.dispose != nil {
+				deleter.dispose()
+			}
 		}
 		err := d.childIter.Close(ctx)
 
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/fulltext"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -49,7 +50,15 @@ func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row
 		}
 	}
 
-	rowIter, err := b.buildNodeExec(ctx, ii.Source, row)
+	source := ii.Source
+	if ii.FirstGeneratedAutoIncRowIdx >= 0 {
+		source, err = withAutoIncrementReservations(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rowIter, err := b.buildNodeExec(ctx, source, row)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +124,18 @@ func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row
 	}
 }
 
+// withAutoIncrementReservations returns |source| with its AutoIncrement expressions replaced by ones that reserve
+// their own values, since the values reserved for one execution of an insert can't be used by another.
+func withAutoIncrementReservations(ctx *sql.Context, source sql.Node) (sql.Node, error) {
+	source, _, err := transform.NodeExprs(ctx, source, func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if ai, ok := e.(*expression.AutoIncrement); ok {
+			return ai.WithNewReservation(), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	return source, err
+}
+
 func (b *BaseBuilder) buildDeleteFrom(ctx *sql.Context, n *plan.DeleteFrom, row sql.Row) (sql.RowIter, error) {
 	iter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
@@ -147,7 +168,13 @@ func (b *BaseBuilder) buildDeleteFrom(ctx *sql.Context, n *plan.DeleteFrom, row
 		if err != nil {
 			return nil, err
 		}
-		schemaPositionDeleters[i] = schemaPositionDeleter{deleter, int(start), int(end)}
+		schemaPositionDeleters[i] = schemaPositionDeleter{
+			deleter:     deleter,
+			schemaStart: int(start),
+			schemaEnd:   int(end),
+			// rows of keyless tables can't be told apart from their duplicates, so each must be deleted
+			dedupe: int(end-start) < len(schema) && !sql.IsKeyless(schema[start:end]),
+		}
 	}
 	return newDeleteIter(iter, schema, schemaPositionDeleters, n.Returning, n.Schema(ctx)), nil
 }
@@ -176,7 +203,7 @@ func (b *BaseBuilder) buildDropForeignKey(ctx *sql.Context, n *plan.DropForeignK
 	if err != nil {
 		return nil, err
 	}
-	tbl, ok, err := db.GetTableInsensitive(ctx, n.Table)
+	tbl, ok, err := sql.GetSessionTableInsensitive(ctx, db, n.Table)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +242,9 @@ func (b *BaseBuilder) buildDropTable(ctx *sql.Context, n *plan.DropTable, _ sql.
 	for _, table := range sortedTables {
 		tbl := table.(*plan.ResolvedTable)
 		curdb = tbl.SqlDatabase
+		if dropSessionTemporaryTable(ctx, tbl) {
+			continue
+		}
 
 		droppable := tbl.SqlDatabase.(sql.TableDropper)
 
@@ -274,6 +304,11 @@ func (b *BaseBuilder) buildDropTable(ctx *sql.Context, n *plan.DropTable, _ sql.
 		}
 	}
 
+	for _, table := range sortedTables {
+		tbl := table.(*plan.ResolvedTable)
+		warnDependentRoutines(ctx, tbl.SqlDatabase, tbl.Name())
+	}
+
 	if b.EngineOverrides.Hooks.DropTable.PostSQLExecution != nil {
 		if err = b.EngineOverrides.Hooks.DropTable.PostSQLExecution(ctx, b.Runner, n); err != nil {
 			return nil, err
@@ -449,7 +484,7 @@ func (b *BaseBuilder) buildRenameForeignKey(ctx *sql.Context, n *plan.RenameFore
 	if err != nil {
 		return nil, err
 	}
-	tbl, ok, err := db.GetTableInsensitive(ctx, n.Table)
+	tbl, ok, err := sql.GetSessionTableInsensitive(ctx, db, n.Table)
 	if err != nil {
 		return nil, err
 	}
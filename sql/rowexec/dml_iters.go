@@ -15,6 +15,7 @@
 package rowexec
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"sync"
@@ -30,6 +31,10 @@ import (
 
 const TriggerSavePointPrefix = "__go_mysql_server_trigger_savepoint__"
 
+// AlterTableSavePointName is the name of the savepoint that multi-clause ALTER TABLE statements roll back to when one
+// of their clauses fails.
+const AlterTableSavePointName = "__go_mysql_server_alter_table_savepoint__"
+
 type triggerRollbackIter struct {
 	child         sql.RowIter
 	savePointName string
@@ -368,24 +373,33 @@ type updateIgnoreAccumulatorRowHandler interface {
 }
 
 type insertRowHandler struct {
-	lastInsertIdGetter        func(row sql.Row) int64
-	rowsAffected              int
-	lastInsertId              uint64
-	updatedAutoIncrementValue bool
+	lastInsertIdGetter func(row sql.Row) int64
+	// generatedInsertIdGetter returns the first AUTO_INCREMENT value generated by the statement, or 0 if none was
+	generatedInsertIdGetter func() uint64
+	rowsAffected            int
+	lastInsertId            uint64
 }
 
 func (i *insertRowHandler) handleRowUpdate(ctx *sql.Context, row sql.Row) error {
 	i.rowsAffected++
-	if !i.updatedAutoIncrementValue {
-		i.updatedAutoIncrementValue = true
-		if i.lastInsertIdGetter != nil {
-			i.lastInsertId = uint64(i.lastInsertIdGetter(row))
-		}
+	if i.rowsAffected == 1 && i.lastInsertIdGetter != nil {
+		i.lastInsertId = uint64(i.lastInsertIdGetter(row))
 	}
 	return nil
 }
 
-func (i *insertRowHandler) getLastInsertId() uint64 {
+// getLastInsertId returns the insert ID reported for the statement. Like MySQL, this is the first AUTO_INCREMENT value
+// generated by the statement, or the argument of LAST_INSERT_ID(expr) if the statement called it and generated no
+// values, or else the AUTO_INCREMENT value of the first row inserted.
+func (i *insertRowHandler) getLastInsertId(ctx *sql.Context) uint64 {
+	if i.generatedInsertIdGetter != nil {
+		if id := i.generatedInsertIdGetter(); id != 0 {
+			return id
+		}
+	}
+	if lastQueryInfo := ctx.GetLastQueryInfo(); lastQueryInfo.LastInsertIdSet.Load() {
+		return uint64(lastQueryInfo.LastInsertId.Load())
+	}
 	return i.lastInsertId
 }
 
@@ -399,21 +413,19 @@ type replaceRowHandler struct {
 	rowsAffected int
 }
 
+// handleRowUpdate implements the accumulatorRowHandler interface. Only the inserted row is counted here; the rows it
+// replaced are counted by the insertIter as it deletes them, since a row can conflict with a different row on each of
+// the unique keys of the table.
 func (r *replaceRowHandler) handleRowUpdate(ctx *sql.Context, row sql.Row) error {
 	r.rowsAffected++
-
-	// If a row was deleted as well as inserted, increment the counter again. A row was deleted if at least one column in
-	// the first half of the row is non-null.
-	for i := 0; i < len(row)/2; i++ {
-		if row[i] != nil {
-			r.rowsAffected++
-			break
-		}
-	}
-
 	return nil
 }
 
+// handleRowDeleted is called by an insertIter for each row a REPLACE deletes.
+func (r *replaceRowHandler) handleRowDeleted() {
+	r.rowsAffected++
+}
+
 func (r *replaceRowHandler) okResult() types.OkResult {
 	return types.NewOkResult(r.rowsAffected)
 }
@@ -563,11 +575,18 @@ type deleteRowHandler struct {
 	rowsAffected int
 }
 
+// handleRowUpdate implements the accumulatorRowHandler interface. Deleted rows are counted by the deleteIter instead,
+// since a row of a DELETE FROM JOIN statement can delete a row from each of its targets, or none at all when they were
+// already deleted.
 func (u *deleteRowHandler) handleRowUpdate(ctx *sql.Context, row sql.Row) error {
-	u.rowsAffected++
 	return nil
 }
 
+// handleRowDeleted is called by a deleteIter for each row it deletes.
+func (u *deleteRowHandler) handleRowDeleted() {
+	u.rowsAffected++
+}
+
 func (u *deleteRowHandler) okResult() types.OkResult {
 	return types.NewOkResult(u.rowsAffected)
 }
@@ -612,16 +631,21 @@ func getRowHandler(clientFoundRowsToggled bool, iter sql.RowIter) accumulatorRow
 		return rowHandler
 	case *insertIter:
 		if i.replacer != nil {
-			return &replaceRowHandler{}
+			rowHandler := &replaceRowHandler{}
+			i.replaceAccumulator = rowHandler
+			return rowHandler
 		}
 		if i.updater != nil {
 			return &onDuplicateUpdateHandler{schema: i.schema, clientFoundRowsCapability: clientFoundRowsToggled}
 		}
 		return &insertRowHandler{
-			lastInsertIdGetter: i.getAutoIncVal,
+			lastInsertIdGetter:      i.getAutoIncVal,
+			generatedInsertIdGetter: i.getGeneratedInsertId,
 		}
 	case *deleteIter:
-		return &deleteRowHandler{}
+		rowHandler := &deleteRowHandler{}
+		i.accumulator = rowHandler
+		return rowHandler
 	default:
 		return nil
 	}
@@ -771,7 +795,11 @@ func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
 				lastInsertId := lastQueryInfo.LastInsertId.Load()
 				res.InsertID = uint64(lastInsertId)
 			case *insertRowHandler:
-				res.InsertID = rowHandler.lastInsertId
+				res.InsertID = rowHandler.getLastInsertId(ctx)
+			default:
+				if lastQueryInfo.LastInsertIdSet.Load() {
+					res.InsertID = uint64(lastQueryInfo.LastInsertId.Load())
+				}
 			}
 			// By definition, ROW_COUNT() is equal to RowsAffected.
 			lastQueryInfo.RowCount.Store(int64(res.RowsAffected))
@@ -808,6 +836,7 @@ type updateSourceIter struct {
 	updateExprs *plan.UpdateExprs
 	tableSchema sql.Schema
 	ignore      bool
+	rowNumber   int64
 }
 
 func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -816,7 +845,10 @@ func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, err
 	}
 
-	newRow, err := applyUpdateExpressionsWithIgnore(ctx, u.updateExprs, u.tableSchema, oldRow, u.ignore)
+	// The row number is reported by warnings for values that are out of range for their column
+	u.rowNumber++
+	rowCtx := ctx.WithContext(context.WithValue(ctx.Context, types.RowNumberKey, u.rowNumber))
+	newRow, err := applyUpdateExpressionsWithIgnore(rowCtx, u.updateExprs, u.tableSchema, oldRow, u.ignore)
 	if err != nil {
 		return nil, err
 	}
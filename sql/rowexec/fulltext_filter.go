@@ -17,10 +17,12 @@ package rowexec
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/fulltext"
+	"github.com/dolthub/go-mysql-server/sql/hash"
 )
 
 // FulltextFilterTable handles row iteration for filters involving Full-Text indexes, as they behave differently than
@@ -63,7 +65,7 @@ func (f *FulltextFilterTable) Collation() sql.CollationID {
 
 // Partitions implements the interface sql.IndexedTable.
 func (f *FulltextFilterTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
-	if f.MatchAgainst.KeyCols.Type == fulltext.KeyType_None {
+	if f.scansTable(ctx) {
 		return f.Table.Partitions(ctx)
 	}
 	return &fulltextFilterTablePartitionIter{false}, nil
@@ -71,8 +73,7 @@ func (f *FulltextFilterTable) Partitions(ctx *sql.Context) (sql.PartitionIter, e
 
 // PartitionRows implements the interface sql.IndexedTable.
 func (f *FulltextFilterTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	// Keyless just iterates over the entire table. Not the most performant, but it works.
-	if f.MatchAgainst.KeyCols.Type == fulltext.KeyType_None {
+	if f.scansTable(ctx) {
 		return f.Table.PartitionRows(ctx, partition)
 	}
 
@@ -88,7 +89,18 @@ func (f *FulltextFilterTable) PartitionRows(ctx *sql.Context, partition sql.Part
 		}
 	}
 	collation := fulltext.GetCollationFromSchema(ctx, f.MatchAgainst.DocCountTable.Schema(ctx))
-	parser, err := fulltext.NewDefaultParser(ctx, collation, wordsStr)
+	var parser fulltext.DefaultParser
+	if f.MatchAgainst.SearchModifier == fulltext.SearchModifier_Boolean {
+		// A row matches a boolean search only if it contains a word of a term that may cause a match
+		terms, err := fulltext.ParseBooleanQuery(ctx, collation, wordsStr)
+		if err != nil {
+			return nil, err
+		}
+		searchWords, _ := fulltext.BooleanQueryWords(terms)
+		parser, err = fulltext.NewDefaultParser(ctx, collation, strings.Join(searchWords, " "))
+	} else {
+		parser, err = fulltext.NewSearchParser(ctx, collation, wordsStr)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -136,9 +148,39 @@ func (f *FulltextFilterTable) PartitionRows(ctx *sql.Context, partition sql.Part
 		docCountIndex: docCountIndexes[0],
 		parentIter:    nil,
 		docCountIter:  nil,
+		seenKeys:      make(map[uint64]struct{}),
 	}, nil
 }
 
+// scansTable returns whether the filter iterates over the entire table, rather than only the rows that contain the
+// words of the search. Keyless tables cannot find their rows from the index tables. Not the most performant, but it
+// works. Boolean mode may match rows that contain none of the words exactly through truncated words, so searches with
+// them also need every row. The MatchAgainst expression is still evaluated over every returned row.
+func (f *FulltextFilterTable) scansTable(ctx *sql.Context) bool {
+	if f.MatchAgainst.KeyCols.Type == fulltext.KeyType_None {
+		return true
+	}
+	switch f.MatchAgainst.SearchModifier {
+	case fulltext.SearchModifier_NaturalLanguage:
+		return false
+	case fulltext.SearchModifier_Boolean:
+		words, err := f.MatchAgainst.Expr.Eval(ctx, nil)
+		if err != nil {
+			return true
+		}
+		wordsStr, _ := words.(string)
+		collation := fulltext.GetCollationFromSchema(ctx, f.MatchAgainst.DocCountTable.Schema(ctx))
+		terms, err := fulltext.ParseBooleanQuery(ctx, collation, wordsStr)
+		if err != nil {
+			return true
+		}
+		_, ok := fulltext.BooleanQueryWords(terms)
+		return !ok
+	default:
+		return true
+	}
+}
+
 // LookupPartitions implements the interface sql.IndexedTable.
 func (f *FulltextFilterTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup) (sql.PartitionIter, error) {
 	return f.Partitions(ctx)
@@ -184,6 +226,8 @@ type fulltextFilterTableRowIter struct {
 	parentIter    *sql.TableRowIter
 	docCountIter  *sql.TableRowIter
 	parser        fulltext.DefaultParser
+	// seenKeys holds the keys of the parent rows that have been returned, as a row may contain several of the words
+	seenKeys map[uint64]struct{}
 }
 
 var _ sql.RowIter = (*fulltextFilterTableRowIter)(nil)
@@ -232,9 +276,20 @@ func (f *fulltextFilterTableRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 				return nil, err
 			}
 
+			// Skip the rows that were already returned for an earlier word
+			key := docRow[1 : len(docRow)-1]
+			keyHash, err := hash.HashOf(ctx, f.matchAgainst.DocCountTable.Schema(ctx)[1:len(docRow)-1], key)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := f.seenKeys[keyHash]; ok {
+				continue
+			}
+			f.seenKeys[keyHash] = struct{}{}
+
 			// Get the key so that we may get rows from the parent table
 			ranges := make(sql.MySQLRange, len(docRow)-2)
-			for i, val := range docRow[1 : len(docRow)-1] {
+			for i, val := range key {
 				ranges[i] = sql.ClosedRangeColumnExpr(val, val, f.matchAgainst.DocCountTable.Schema(ctx)[i+1].Type)
 			}
 			lookup := sql.IndexLookup{Ranges: sql.MySQLRangeCollection{ranges}, Index: f.parentIndex}
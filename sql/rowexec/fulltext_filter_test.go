@@ -0,0 +1,66 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/fulltext"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestFulltextFilterTableScansTable(t *testing.T) {
+	db := memory.NewDatabase("test")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	docCountTable := memory.NewTable(ctx, db.BaseDatabase, "test_fts_doc_count", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "word", Type: types.LongText, PrimaryKey: true},
+		{Name: "pk", Type: types.Int64, PrimaryKey: true},
+		{Name: "count", Type: types.Uint64},
+	}), nil)
+
+	tests := []struct {
+		search   string
+		modifier fulltext.SearchModifier
+		keyType  fulltext.KeyType
+		scans    bool
+	}{
+		{"apple banana", fulltext.SearchModifier_NaturalLanguage, fulltext.KeyType_Primary, false},
+		{"apple banana", fulltext.SearchModifier_NaturalLanguage, fulltext.KeyType_None, true},
+		{"apple banana", fulltext.SearchModifier_Boolean, fulltext.KeyType_Primary, false},
+		{"+apple -banana ~cherry", fulltext.SearchModifier_Boolean, fulltext.KeyType_Primary, false},
+		{`+"apple banana" +(cherry juice)`, fulltext.SearchModifier_Boolean, fulltext.KeyType_Primary, false},
+		{"+apple -banana*", fulltext.SearchModifier_Boolean, fulltext.KeyType_Primary, false},
+		{"+apple banana*", fulltext.SearchModifier_Boolean, fulltext.KeyType_Primary, true},
+		{"+apple +(cherry juice*)", fulltext.SearchModifier_Boolean, fulltext.KeyType_Primary, true},
+		{"apple banana", fulltext.SearchModifier_Boolean, fulltext.KeyType_None, true},
+		{"apple banana", fulltext.SearchModifier_QueryExpansion, fulltext.KeyType_Primary, true},
+	}
+	for _, test := range tests {
+		t.Run(test.search, func(t *testing.T) {
+			matchAgainst := expression.NewMatchAgainst(nil, expression.NewLiteral(test.search, types.LongText), test.modifier)
+			matchAgainst.DocCountTable = docCountTable
+			matchAgainst.KeyCols = fulltext.KeyColumns{Type: test.keyType}
+			filter := &FulltextFilterTable{MatchAgainst: matchAgainst}
+			require.Equal(t, test.scans, filter.scansTable(ctx))
+		})
+	}
+}
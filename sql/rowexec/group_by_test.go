@@ -170,6 +170,91 @@ func TestGroupByAggregationGrouping(t *testing.T) {
 	require.Equal(expected, rows)
 }
 
+func TestGroupByGroupingSets(t *testing.T) {
+	db := memory.NewDatabase("test")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	childSchema := sql.Schema{
+		{Name: "col1", Type: types.LongText, Source: "test"},
+		{Name: "col2", Type: types.Int64, Source: "test"},
+	}
+	child := memory.NewTable(ctx, db.BaseDatabase, "test", sql.NewPrimaryKeySchema(childSchema), nil)
+	for _, r := range []sql.Row{
+		sql.NewRow("x", int64(1)),
+		sql.NewRow("x", int64(2)),
+		sql.NewRow("y", int64(1)),
+	} {
+		require.NoError(t, child.Insert(ctx, r))
+	}
+
+	col1 := expression.NewGetFieldWithTable(0, 1, types.LongText, "", "test", "col1", false)
+	col2 := expression.NewGetFieldWithTable(1, 1, types.Int64, "", "test", "col2", false)
+	grouping, err := aggregation.NewGrouping(ctx, col1, col2)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		sets     [][]int
+		expected []sql.Row
+	}{
+		{
+			name: "rollup",
+			sets: [][]int{{0, 1}, {0}, {}},
+			expected: []sql.Row{
+				{"x", int64(1), int64(1), int64(0)},
+				{"x", int64(2), int64(1), int64(0)},
+				{"y", int64(1), int64(1), int64(0)},
+				{"x", nil, int64(2), int64(1)},
+				{"y", nil, int64(1), int64(1)},
+				{nil, nil, int64(3), int64(3)},
+			},
+		},
+		{
+			name: "cube",
+			sets: [][]int{{0, 1}, {0}, {1}, {}},
+			expected: []sql.Row{
+				{"x", int64(1), int64(1), int64(0)},
+				{"x", int64(2), int64(1), int64(0)},
+				{"y", int64(1), int64(1), int64(0)},
+				{"x", nil, int64(2), int64(1)},
+				{"y", nil, int64(1), int64(1)},
+				{nil, int64(1), int64(2), int64(2)},
+				{nil, int64(2), int64(1), int64(2)},
+				{nil, nil, int64(3), int64(3)},
+			},
+		},
+		{
+			name: "grouping sets",
+			sets: [][]int{{0}, {1}},
+			expected: []sql.Row{
+				{"x", nil, int64(2), int64(1)},
+				{"y", nil, int64(1), int64(1)},
+				{nil, int64(1), int64(2), int64(2)},
+				{nil, int64(2), int64(1), int64(2)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gb := plan.NewGroupBy(
+				[]sql.Expression{col1, col2, aggregation.NewCount(expression.NewStar()), grouping},
+				[]sql.Expression{col1, col2},
+				plan.NewResolvedTable(child, nil, nil),
+			).WithGroupingSets(tt.sets)
+
+			schema := gb.Schema(ctx)
+			require.True(t, schema[0].Nullable)
+			require.True(t, schema[1].Nullable)
+
+			rows, err := NodeToRows(ctx, gb)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expected, rows)
+		})
+	}
+}
+
 func TestGroupByCollations(t *testing.T) {
 	ctx := sql.NewEmptyContext()
 	tString := types.MustCreateString(query.Type_VARCHAR, 255, sql.Collation_utf8mb4_0900_ai_ci)
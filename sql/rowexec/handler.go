@@ -0,0 +1,284 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/iters"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func (b *BaseBuilder) buildHandlerOpen(ctx *sql.Context, n *plan.HandlerOpen, row sql.Row) (sql.RowIter, error) {
+	hs, ok := ctx.Session.(sql.TableHandlerSession)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("HANDLER")
+	}
+	if !hs.OpenTableHandler(n.Name, &sql.TableHandler{Database: n.Database, Table: n.Table}) {
+		return nil, sql.ErrDuplicateAliasOrTable.New(n.Name)
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+func (b *BaseBuilder) buildHandlerClose(ctx *sql.Context, n *plan.HandlerClose, row sql.Row) (sql.RowIter, error) {
+	hs, ok := ctx.Session.(sql.TableHandlerSession)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("HANDLER")
+	}
+	if !hs.CloseTableHandler(n.Name) {
+		return nil, sql.ErrUnknownTableInHandler.New(n.Name)
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+func (b *BaseBuilder) buildHandlerRead(ctx *sql.Context, n *plan.HandlerRead, row sql.Row) (sql.RowIter, error) {
+	hs, ok := ctx.Session.(sql.TableHandlerSession)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("HANDLER")
+	}
+	handler, ok := hs.GetTableHandler(n.Name)
+	if !ok {
+		return nil, sql.ErrUnknownTableInHandler.New(n.Name)
+	}
+
+	limit := int64(1)
+	if n.Limit != nil {
+		var err error
+		limit, err = iters.GetInt64Value(ctx, n.Limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var offset int64
+	if n.Offset != nil {
+		var err error
+		offset, err = iters.GetInt64Value(ctx, n.Offset)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	indexName := ""
+	if n.Index != nil {
+		indexName = n.Index.ID()
+	}
+	var cols []int
+	if n.Index != nil {
+		var err error
+		cols, err = handlerIndexColumns(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// NEXT and PREV continue from the handler's position unless it was positioned on another index, all other reads
+	// reposition the handler on the table as it is now
+	continues := (n.Type == plan.HandlerReadNext || n.Type == plan.HandlerReadPrev) &&
+		handler.Rows != nil && strings.EqualFold(handler.Index, indexName)
+	if !continues {
+		rows, err := handlerTableRows(ctx, n, cols)
+		if err != nil {
+			return nil, err
+		}
+		handler.Index = indexName
+		handler.Rows = rows
+		handler.Pos = -1
+		if n.Type.IsBackwards() {
+			handler.Pos = len(rows)
+		}
+	}
+	rows := handler.Rows
+
+	var key sql.Row
+	if n.Type.IsKey() {
+		key = make(sql.Row, len(n.Key))
+		for i, e := range n.Key {
+			val, err := e.Eval(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			key[i], _, err = n.Table.Schema(ctx)[cols[i]].Type.Convert(ctx, val)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	pos := handler.Pos
+	switch n.Type {
+	case plan.HandlerReadFirst, plan.HandlerReadNext:
+		pos = max(pos+1, 0)
+	case plan.HandlerReadPrev, plan.HandlerReadLast:
+		pos = min(pos-1, len(rows)-1)
+	case plan.HandlerReadEqual, plan.HandlerReadGreaterOrEqual, plan.HandlerReadGreater:
+		var err error
+		pos, err = searchHandlerRows(ctx, n, rows, cols, key, n.Type != plan.HandlerReadGreater)
+		if err != nil {
+			return nil, err
+		}
+	case plan.HandlerReadLessOrEqual, plan.HandlerReadLess:
+		var err error
+		pos, err = searchHandlerRows(ctx, n, rows, cols, key, n.Type == plan.HandlerReadLess)
+		if err != nil {
+			return nil, err
+		}
+		pos--
+	}
+
+	step := 1
+	if n.Type.IsBackwards() {
+		step = -1
+	}
+	var result []sql.Row
+	handler.Pos = pos - step
+	for ; pos >= 0 && pos < len(rows) && int64(len(result)) < limit; pos += step {
+		if n.Type == plan.HandlerReadEqual {
+			cmp, err := compareHandlerKey(ctx, n, rows[pos], cols, key)
+			if err != nil {
+				return nil, err
+			}
+			if cmp != 0 {
+				break
+			}
+		}
+		handler.Pos = pos
+		if n.Filter != nil {
+			res, err := sql.EvaluateCondition(ctx, n.Filter, rows[pos])
+			if err != nil {
+				return nil, err
+			}
+			if !sql.IsTrue(res) {
+				continue
+			}
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		result = append(result, rows[pos])
+	}
+	if pos < 0 || pos >= len(rows) {
+		// the read ran out of rows, so the handler is left past the end of them
+		handler.Pos = min(max(pos, -1), len(rows))
+	}
+
+	return sql.RowsToRowIter(result...), nil
+}
+
+// handlerIndexColumns returns the positions in the table's schema of the columns of the index of |n|.
+func handlerIndexColumns(ctx *sql.Context, n *plan.HandlerRead) ([]int, error) {
+	sch := n.Table.Schema(ctx)
+	exprs := n.Index.Expressions()
+	cols := make([]int, len(exprs))
+	for i, expr := range exprs {
+		colName := expr[strings.LastIndex(expr, ".")+1:]
+		cols[i] = sch.IndexOfColName(colName)
+		if cols[i] < 0 {
+			return nil, fmt.Errorf("index %s on table %s cannot be read by HANDLER", n.Index.ID(), n.Table.Name())
+		}
+	}
+	return cols, nil
+}
+
+// handlerTableRows returns the rows of the table of |n| in the order of its index, or in the table's natural order if
+// it doesn't have one.
+func handlerTableRows(ctx *sql.Context, n *plan.HandlerRead, cols []int) ([]sql.Row, error) {
+	table := n.Table
+	var partitions sql.PartitionIter
+	var err error
+	if n.Index != nil {
+		ia, ok := n.Table.(sql.IndexAddressable)
+		if !ok {
+			return nil, sql.ErrKeyDoesNotExist.New(n.Index.ID(), n.Table.Name())
+		}
+		lookup, err := sql.NewMySQLIndexBuilder(ctx, n.Index).Build(ctx)
+		if err != nil {
+			return nil, err
+		}
+		indexedTable := ia.IndexedAccess(ctx, lookup)
+		partitions, err = indexedTable.LookupPartitions(ctx, lookup)
+		if err != nil {
+			return nil, err
+		}
+		table = indexedTable
+	} else {
+		partitions, err = table.Partitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, partitions))
+	if err != nil {
+		return nil, err
+	}
+	if n.Index == nil {
+		return rows, nil
+	}
+
+	// each partition of the lookup is in index order, but the partitions needn't be in order with each other
+	var sortErr error
+	sch := n.Table.Schema(ctx)
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, col := range cols {
+			cmp, err := sch[col].Type.Compare(ctx, rows[i][col], rows[j][col])
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	return rows, sortErr
+}
+
+// compareHandlerKey compares the index columns |cols| of |row| to the leading columns of the index given by |key|.
+func compareHandlerKey(ctx *sql.Context, n *plan.HandlerRead, row sql.Row, cols []int, key sql.Row) (int, error) {
+	sch := n.Table.Schema(ctx)
+	for i, val := range key {
+		cmp, err := sch[cols[i]].Type.Compare(ctx, row[cols[i]], val)
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+// searchHandlerRows returns the position of the first of the |rows| whose key is greater than or equal to |key| when
+// |inclusive|, or greater than |key| otherwise. The rows before it are the ones whose key is less than |key|, or less
+// than or equal to |key| respectively.
+func searchHandlerRows(ctx *sql.Context, n *plan.HandlerRead, rows []sql.Row, cols []int, key sql.Row, inclusive bool) (int, error) {
+	var err error
+	pos := sort.Search(len(rows), func(i int) bool {
+		cmp, cmpErr := compareHandlerKey(ctx, n, rows[i], cols, key)
+		if cmpErr != nil {
+			err = cmpErr
+		}
+		if inclusive {
+			return cmp >= 0
+		}
+		return cmp > 0
+	})
+	return pos, err
+}
@@ -36,6 +36,8 @@ type insertIter struct {
 	inserter  sql.RowInserter
 	replacer  sql.RowReplacer
 	updater   sql.RowUpdater
+	// replaceAccumulator counts the rows deleted by a REPLACE, which deletes every row that conflicts with the new row
+	replaceAccumulator *replaceRowHandler
 
 	ctx                 *sql.Context
 	onDupKeyUpdateExprs *plan.UpdateExprs
@@ -48,6 +50,8 @@ type insertIter struct {
 	returnExprs      []sql.Expression
 	insertExprs      []sql.Expression
 
+	// generatedInsertId is the first AUTO_INCREMENT value generated by the statement, or 0 if none has been
+	generatedInsertId           uint64
 	firstGeneratedAutoIncRowIdx int
 	rowNumber                   int64
 	closed                      bool
@@ -135,11 +139,31 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 				converted, inRange, cErr = col.Type.Convert(ctxWithColumnInfo, val)
 			}
 			if cErr == nil && inRange != sql.InRange {
+				// Outside of strict mode, values out of the range of a numeric column are clamped to the nearest bound
+				if i.ignore || !sql.LoadSqlMode(ctx).Strict() {
+					row[idx] = types.ClampToRange(col.Type, converted, inRange)
+					ctx.Warn(mysql.ERWarnDataOutOfRange, "%s", sql.ErrValueOutOfRangeForColumn.New(col.Name, i.rowNumber).Error())
+					continue
+				}
 				cErr = sql.ErrValueOutOfRange.New(val, col.Type)
 			}
+			if types.IsTime(col.Type) {
+				strict := !i.ignore && sql.LoadSqlMode(ctx).Strict()
+				converted, cErr = types.ConvertDateForSqlMode(ctx, col.Type, val, converted, cErr, strict, col.Name, i.rowNumber)
+			}
 			if sql.ErrTruncatedIncorrect.Is(cErr) {
 				cErr = sql.ErrInvalidValue.New(val, col.Type)
 			}
+			if types.ErrConvertingToEnum.Is(cErr) || sql.ErrInvalidSetValue.Is(cErr) || sql.ErrConvertingToSet.Is(cErr) {
+				cErr = types.ErrDataTruncatedForColumnAtRow.New(col.Name, i.rowNumber)
+				// Outside of strict mode, values that aren't members of an ENUM or SET are stored as the empty string
+				if !i.ignore && sql.LoadSqlMode(ctx).Strict() {
+					return nil, sql.NewWrappedInsertError(origRow, cErr)
+				}
+				row[idx] = col.Type.Zero()
+				ctx.Warn(mysql.ERWarnDataTruncated, "%s", cErr.Error())
+				continue
+			}
 			if cErr != nil {
 				// Ignore individual column errors when INSERT IGNORE, UPDATE IGNORE, etc. is specified.
 				// For JSON column types, always throw an error. MySQL throws the following error even when
@@ -154,7 +178,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 						row[idx] = converted
 						// Add a warning instead
 						ctx.Session.Warn(&sql.Warning{
-							Level:   "Note",
+							Level:   "Warning",
 							Code:    sql.CastSQLError(cErr).Num,
 							Message: cErr.Error(),
 						})
@@ -169,8 +193,6 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 						cErr = types.ErrLengthBeyondLimit.New(row[idx], col.Name)
 					case sql.ErrNotMatchingSRID.Is(cErr):
 						cErr = sql.ErrNotMatchingSRIDWithColName.New(col.Name, cErr)
-					case types.ErrConvertingToEnum.Is(cErr), sql.ErrInvalidSetValue.Is(cErr), sql.ErrConvertingToSet.Is(cErr):
-						cErr = types.ErrDataTruncatedForColumnAtRow.New(col.Name, i.rowNumber)
 					}
 					return nil, sql.NewWrappedInsertError(origRow, cErr)
 				}
@@ -202,6 +224,9 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 					i.rowSource = nil
 					return nil, sql.NewWrappedInsertError(row, err)
 				}
+				if i.replaceAccumulator != nil {
+					i.replaceAccumulator.handleRowDeleted()
+				}
 				// the row had to be deleted, write the values into the toReturn row
 				copy(toReturn, ue.Existing)
 			} else {
@@ -355,10 +380,15 @@ func (i *insertIter) updateLastInsertId(ctx *sql.Context, row sql.Row) {
 	if i.firstGeneratedAutoIncRowIdx == 0 {
 		autoIncVal := i.getAutoIncVal(row)
 		ctx.GetLastQueryInfo().LastInsertId.Store(autoIncVal)
+		i.generatedInsertId = uint64(autoIncVal)
 	}
 	i.firstGeneratedAutoIncRowIdx--
 }
 
+func (i *insertIter) getGeneratedInsertId() uint64 {
+	return i.generatedInsertId
+}
+
 func (i *insertIter) getAutoIncVal(row sql.Row) int64 {
 	for i, expr := range i.insertExprs {
 		if _, ok := expr.(*expression.AutoIncrement); ok {
@@ -381,8 +411,30 @@ func (i *insertIter) ignoreOrClose(ctx *sql.Context, row sql.Row, err error) err
 // cc. https://dev.mysql.com/doc/refman/8.0/en/sql-mode.html#sql-mode-strict
 func convertDataAndWarn(ctx *sql.Context, tableSchema sql.Schema, row sql.Row, columnIdx int, err error) sql.Row {
 	if types.ErrLengthBeyondLimit.Is(err) {
-		maxLength := tableSchema[columnIdx].Type.(sql.StringType).MaxCharacterLength()
-		row[columnIdx] = row[columnIdx].(string)[:maxLength] // truncate string
+		typ := tableSchema[columnIdx].Type.(sql.StringType)
+		maxLength := typ.MaxCharacterLength()
+		// Binary strings are truncated to their length in bytes, other strings to their length in characters
+		switch v := row[columnIdx].(type) {
+		case []byte:
+			row[columnIdx] = v[:maxLength]
+		case string:
+			if types.IsBinaryType(typ) {
+				row[columnIdx] = v[:maxLength]
+			} else {
+				row[columnIdx] = string([]rune(v)[:maxLength])
+			}
+		default:
+			row[columnIdx] = typ.Zero()
+		}
+		if converted, _, cErr := typ.Convert(ctx, row[columnIdx]); cErr == nil {
+			row[columnIdx] = converted
+		}
+	} else if sql.ErrValueOutOfRange.Is(err) && types.IsNumber(tableSchema[columnIdx].Type) {
+		typ := tableSchema[columnIdx].Type
+		converted, inRange, _ := typ.Convert(ctx, row[columnIdx])
+		row[columnIdx] = types.ClampToRange(typ, converted, inRange)
+		ctx.Warn(mysql.ERWarnDataOutOfRange, "%s", sql.ErrValueOutOfRangeForColumn.New(tableSchema[columnIdx].Name, types.RowNumber(ctx)).Error())
+		return row
 	} else if types.ErrBadCharsetString.Is(err) {
 		switch v := row[columnIdx].(type) {
 		case string:
@@ -403,7 +455,7 @@ func convertDataAndWarn(ctx *sql.Context, tableSchema sql.Schema, row sql.Row, c
 	// Add a warning instead
 	if ctx != nil && ctx.Session != nil {
 		ctx.Session.Warn(&sql.Warning{
-			Level:   "Note",
+			Level:   "Warning",
 			Code:    sqlerr.Num,
 			Message: err.Error(),
 		})
@@ -421,9 +473,9 @@ func warnOnIgnorableError(ctx *sql.Context, row sql.Row, err error) error {
 			// Add a warning instead
 			if ctx != nil && ctx.Session != nil {
 				ctx.Session.Warn(&sql.Warning{
-					Level:   "Note",
+					Level:   "Warning",
 					Code:    sqlerr.Num,
-					Message: err.Error(),
+					Message: sqlerr.Message,
 				})
 			}
 
@@ -0,0 +1,67 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func (b *BaseBuilder) buildCreateLoadableFunction(ctx *sql.Context, n *plan.CreateLoadableFunction, row sql.Row) (sql.RowIter, error) {
+	provider, ok := n.Catalog.(sql.UserDefinedFunctionProvider)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("CREATE FUNCTION ... SONAME")
+	}
+	if _, ok := provider.UserDefinedFunction(ctx, n.Name); ok {
+		if n.IfNotExists {
+			ctx.Warn(mysql.ERUDFExists, "%s", sql.ErrUserDefinedFunctionExists.New(n.Name).Error())
+			return rowIterWithOkResultWithZeroRowsAffected(), nil
+		}
+		return nil, sql.ErrUserDefinedFunctionExists.New(n.Name)
+	}
+
+	factory, ok := sql.GetLoadableFunctionLibrary(n.Library)
+	if !ok {
+		return nil, sql.ErrCantOpenLibrary.New(n.Library)
+	}
+	fn, ok := factory(ctx, n.Name, n.Aggregate)
+	if !ok || fn.IsAggregate() != n.Aggregate {
+		return nil, sql.ErrCantFindLibraryFunction.New(n.Name)
+	}
+	// the statement decides the name and return type, as it would for a function in a shared library
+	fn.Name = n.Name
+	fn.ReturnType = n.ReturnType
+	if err := provider.RegisterUserDefinedFunction(ctx, fn); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+func (b *BaseBuilder) buildDropLoadableFunction(ctx *sql.Context, n *plan.DropLoadableFunction, row sql.Row) (sql.RowIter, error) {
+	provider, ok := n.Catalog.(sql.UserDefinedFunctionProvider)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("DROP FUNCTION")
+	}
+	if _, ok := provider.UserDefinedFunction(ctx, n.Name); !ok && n.IfExists {
+		ctx.Warn(mysql.ERFunctionNotDefined, "%s", sql.ErrUserDefinedFunctionNotDefined.New(n.Name).Error())
+		return rowIterWithOkResultWithZeroRowsAffected(), nil
+	}
+	if err := provider.DropUserDefinedFunction(ctx, n.Name); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// looseIndexScanIter returns one row for each distinct prefix of an index. Every group is found with a fresh lookup
+// for the first row whose prefix sorts after the previous group's prefix, so rows within a group are never read.
+type looseIndexScanIter struct {
+	n     *plan.LooseIndexScan
+	table sql.IndexedTable
+	index sql.Index
+	cets  []sql.ColumnExpressionType
+	// prepend is the outer scope row prepended to every result row
+	prepend sql.Row
+	// last holds the key values of the most recently returned group
+	last sql.Row
+	done bool
+}
+
+var _ sql.RowIter = (*looseIndexScanIter)(nil)
+
+func newLooseIndexScanIter(ctx *sql.Context, n *plan.LooseIndexScan) *looseIndexScanIter {
+	ita := n.IndexedTable()
+	return &looseIndexScanIter{
+		n:       n,
+		table:   ita.Table,
+		index:   ita.Index(),
+		cets:    ita.Index().ColumnExpressionTypes(ctx),
+		prepend: n.PrependRow(),
+	}
+}
+
+// Next implements the sql.RowIter interface.
+func (i *looseIndexScanIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+
+	var row sql.Row
+	var err error
+	if i.last == nil {
+		row, err = i.firstRow(ctx, i.allRange())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// The next group starts at the first row whose prefix is greater than the last group's prefix. Rows that
+		// share the leading j columns of the last prefix are considered before rows that share fewer.
+		for j := i.n.PrefixLen - 1; j >= 0 && row == nil; j-- {
+			row, err = i.firstRow(ctx, i.nextGroupRange(j))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if row == nil {
+		i.done = true
+		return nil, io.EOF
+	}
+
+	i.last = make(sql.Row, i.n.PrefixLen)
+	for j := range i.last {
+		i.last[j] = row[i.n.KeyOrdinals[j]]
+	}
+
+	if i.n.ReadMin {
+		// NULLs sort first in the index but are ignored by MIN, so seek past them
+		minRow, err := i.firstRow(ctx, i.minRange())
+		if err != nil {
+			return nil, err
+		}
+		if minRow != nil {
+			row = minRow
+		}
+	}
+	if i.prepend != nil {
+		row = append(i.prepend.Copy(), row...)
+	}
+	return row, nil
+}
+
+// Close implements the sql.RowIter interface.
+func (i *looseIndexScanIter) Close(*sql.Context) error {
+	return nil
+}
+
+// firstRow returns the row in |rang| that sorts first in the index, or nil if the range is empty. Each partition is
+// sorted on its own, so the first row of every partition is compared.
+func (i *looseIndexScanIter) firstRow(ctx *sql.Context, rang sql.MySQLRange) (sql.Row, error) {
+	lookup := sql.IndexLookup{Index: i.index, Ranges: sql.MySQLRangeCollection{rang}}
+	partIter, err := i.table.LookupPartitions(ctx, lookup)
+	if err != nil {
+		return nil, err
+	}
+	defer partIter.Close(ctx)
+
+	var first sql.Row
+	for {
+		part, err := partIter.Next(ctx)
+		if err == io.EOF {
+			return first, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rowIter, err := i.table.PartitionRows(ctx, part)
+		if err != nil {
+			return nil, err
+		}
+		row, err := rowIter.Next(ctx)
+		if cerr := rowIter.Close(ctx); cerr != nil && err == nil {
+			err = cerr
+		}
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if first == nil {
+			first = row
+			continue
+		}
+		less, err := i.less(ctx, row, first)
+		if err != nil {
+			return nil, err
+		}
+		if less {
+			first = row
+		}
+	}
+}
+
+// less returns whether |a| sorts before |b| on the index columns read by this scan.
+func (i *looseIndexScanIter) less(ctx *sql.Context, a, b sql.Row) (bool, error) {
+	for j, ord := range i.n.KeyOrdinals {
+		av, bv := a[ord], b[ord]
+		if av == nil || bv == nil {
+			if av == nil && bv == nil {
+				continue
+			}
+			return av == nil, nil
+		}
+		cmp, err := i.cets[j].Type.Compare(ctx, av, bv)
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return cmp < 0, nil
+		}
+	}
+	return false, nil
+}
+
+// allRange returns a range covering every row of the index.
+func (i *looseIndexScanIter) allRange() sql.MySQLRange {
+	rang := make(sql.MySQLRange, len(i.cets))
+	for j, cet := range i.cets {
+		rang[j] = sql.AllRangeColumnExpr(cet.Type)
+	}
+	return rang
+}
+
+// nextGroupRange returns the range of rows that match the leading |j| columns of the last prefix and sort after it
+// on the column at |j|.
+func (i *looseIndexScanIter) nextGroupRange(j int) sql.MySQLRange {
+	rang := i.allRange()
+	for k := 0; k < j; k++ {
+		rang[k] = equalRangeColumnExpr(i.last[k], i.cets[k].Type)
+	}
+	if i.last[j] == nil {
+		rang[j] = sql.NotNullRangeColumnExpr(i.cets[j].Type)
+	} else {
+		rang[j] = sql.GreaterThanRangeColumnExpr(i.last[j], i.cets[j].Type)
+	}
+	return rang
+}
+
+// minRange returns the range of rows in the last group with a non-NULL value in the column after the prefix.
+func (i *looseIndexScanIter) minRange() sql.MySQLRange {
+	rang := i.allRange()
+	for k := 0; k < i.n.PrefixLen; k++ {
+		rang[k] = equalRangeColumnExpr(i.last[k], i.cets[k].Type)
+	}
+	rang[i.n.PrefixLen] = sql.NotNullRangeColumnExpr(i.cets[i.n.PrefixLen].Type)
+	return rang
+}
+
+// equalRangeColumnExpr returns a range matching exactly |val|, which may be NULL.
+func equalRangeColumnExpr(val interface{}, typ sql.Type) sql.MySQLRangeColumnExpr {
+	if val == nil {
+		return sql.NullRangeColumnExpr(typ)
+	}
+	return sql.ClosedRangeColumnExpr(val, val, typ)
+}
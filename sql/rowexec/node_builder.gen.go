@@ -52,6 +52,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildAlterTableCollation(ctx, n, row)
 	case *plan.AlterTableComment:
 		return b.buildAlterTableComment(ctx, n, row)
+	case *plan.AlterPartition:
+		return b.buildAlterPartition(ctx, n, row)
 	case *plan.CreateRole:
 		return b.buildCreateRole(ctx, n, row)
 	case *plan.Loop:
@@ -140,6 +142,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildSetOp(ctx, n, row)
 	case *plan.IndexedTableAccess:
 		return b.buildIndexedTableAccess(ctx, n, row)
+	case *plan.LooseIndexScan:
+		return b.buildLooseIndexScan(ctx, n, row)
 	case *plan.TableAlias:
 		return b.buildTableAlias(ctx, n, row)
 	case *plan.AddColumn:
@@ -156,6 +160,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildHaving(ctx, n, row)
 	case *plan.Signal:
 		return b.buildSignal(ctx, n, row)
+	case *plan.GetDiagnostics:
+		return b.buildGetDiagnostics(ctx, n, row)
 	case *plan.ExternalProcedure:
 		return b.buildExternalProcedure(ctx, n, row)
 	case *plan.Into:
@@ -226,6 +232,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildAlterDefaultDrop(ctx, n, row)
 	case *plan.CachedResults:
 		return b.buildCachedResults(ctx, n, row)
+	case *plan.SharedResult:
+		return b.buildSharedResult(ctx, n, row)
 	case *plan.CreateDB:
 		return b.buildCreateDB(ctx, n, row)
 	case *plan.CreateSchema:
@@ -264,6 +272,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildRenameUser(ctx, n, row)
 	case *plan.ShowCreateProcedure:
 		return b.buildShowCreateProcedure(ctx, n, row)
+	case *plan.ShowCreateFunction:
+		return b.buildShowCreateFunction(ctx, n, row)
 	case *plan.Commit:
 		return b.buildCommit(ctx, n, row)
 	case *plan.DeferredFilteredTable:
@@ -302,6 +312,28 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildDropTrigger(ctx, n, row)
 	case *plan.DeallocateQuery:
 		return b.buildDeallocateQuery(ctx, n, row)
+	case *plan.CreateLoadableFunction:
+		return b.buildCreateLoadableFunction(ctx, n, row)
+	case *plan.DropLoadableFunction:
+		return b.buildDropLoadableFunction(ctx, n, row)
+	case *plan.CreateFunction:
+		return b.buildCreateFunction(ctx, n, row)
+	case *plan.DropFunction:
+		return b.buildDropFunction(ctx, n, row)
+	case *plan.HandlerOpen:
+		return b.buildHandlerOpen(ctx, n, row)
+	case *plan.HandlerRead:
+		return b.buildHandlerRead(ctx, n, row)
+	case *plan.HandlerClose:
+		return b.buildHandlerClose(ctx, n, row)
+	case *plan.CreateSequence:
+		return b.buildCreateSequence(ctx, n, row)
+	case *plan.AlterSequence:
+		return b.buildAlterSequence(ctx, n, row)
+	case *plan.DropSequence:
+		return b.buildDropSequence(ctx, n, row)
+	case *plan.ShowCreateSequence:
+		return b.buildShowCreateSequence(ctx, n, row)
 	case *plan.RollbackSavepoint:
 		return b.buildRollbackSavepoint(ctx, n, row)
 	case *plan.ReleaseSavepoint:
@@ -356,6 +388,12 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildCaseStatement(ctx, n, row)
 	case *plan.GrantRole:
 		return b.buildGrantRole(ctx, n, row)
+	case *plan.SetRole:
+		return b.buildSetRole(ctx, n, row)
+	case *plan.SetDefaultRole:
+		return b.buildSetDefaultRole(ctx, n, row)
+	case *plan.SetPassword:
+		return b.buildSetPassword(ctx, n, row)
 	case *plan.GrantProxy:
 		return b.buildGrantProxy(ctx, n, row)
 	case *plan.Offset:
@@ -87,6 +87,39 @@ func (b *BaseBuilder) buildSignalName(ctx *sql.Context, n *plan.SignalName, row
 	return nil, fmt.Errorf("%T has no execution iterator", n)
 }
 
+func (b *BaseBuilder) buildGetDiagnostics(ctx *sql.Context, n *plan.GetDiagnostics, row sql.Row) (sql.RowIter, error) {
+	area := sql.DiagnosticsArea(ctx)
+	var cond *sql.Warning
+	if n.ConditionNumber != nil {
+		num, err := n.ConditionNumber.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		num, _, err = types.Int64.Convert(ctx, num)
+		if err != nil || num == nil {
+			return nil, sql.ErrInvalidConditionNumber.New()
+		}
+		if cond, err = sql.DiagnosticsCondition(area, num.(int64)); err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range n.Items {
+		var val any
+		if cond != nil {
+			val = sql.ConditionInformation(cond, item.Name)
+		} else {
+			var err error
+			if val, err = sql.StatementInformation(ctx, area, item.Name); err != nil {
+				return nil, err
+			}
+		}
+		if err := ctx.SetUserVariable(ctx, item.Variable, val, types.ApproximateTypeFromValue(val)); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
 func (b *BaseBuilder) buildRepeat(ctx *sql.Context, n *plan.Repeat, row sql.Row) (sql.RowIter, error) {
 	return b.buildLoop(ctx, n.Loop, row)
 }
@@ -111,7 +144,30 @@ func (b *BaseBuilder) buildTransformedNamedNode(ctx *sql.Context, n *plan.Transf
 	return b.buildNodeExec(ctx, n.Child, row)
 }
 
-func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (sql.RowIter, error) {
+func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (_ sql.RowIter, retErr error) {
+	if n.IsAlterTable() {
+		// The clauses of an ALTER TABLE are one schema change: if any of them fails, the ones before it are undone
+		ts, ok := ctx.Session.(sql.TransactionSession)
+		if ok {
+			if err := startTransaction(ctx); err != nil {
+				return nil, err
+			}
+			if err := ts.CreateSavepoint(ctx, ctx.GetTransaction(), AlterTableSavePointName); err != nil {
+				return nil, err
+			}
+			defer func() {
+				if retErr != nil {
+					if err := ts.RollbackToSavepoint(ctx, ctx.GetTransaction(), AlterTableSavePointName); err != nil {
+						ctx.GetLogger().WithError(err).Errorf("Unexpected error when calling RollbackToSavepoint during ALTER TABLE")
+					}
+				}
+				if err := ts.ReleaseSavepoint(ctx, ctx.GetTransaction(), AlterTableSavePointName); err != nil {
+					ctx.GetLogger().WithError(err).Errorf("Unexpected error when calling ReleaseSavepoint during ALTER TABLE")
+				}
+			}()
+		}
+	}
+
 	var returnRows []sql.Row
 	var returnNode sql.Node
 	var returnIter sql.RowIter
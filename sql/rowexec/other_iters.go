@@ -185,6 +185,9 @@ func (h *hashLookupGeneratingIter) Next(ctx *sql.Context) (sql.Row, error) {
 		// We wait until we finish the child iter before caching the Lookup map.
 		// This is because some plans may not fully exhaust the iterator.
 		h.n.Lookup = h.lookup
+		if h.n.RuntimeFilter != nil {
+			h.n.RuntimeFilter.Build(*h.lookup)
+		}
 		return nil, io.EOF
 	}
 	if err != nil {
@@ -196,6 +199,10 @@ func (h *hashLookupGeneratingIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the lookup is held until the query finishes, which releases its memory
+	if err = ctx.MemoryTracker().Grow(sql.EstimateRowSize(childRow)); err != nil {
+		return nil, err
+	}
 	(*(h.lookup))[key] = append((*(h.lookup))[key], childRow)
 	return childRow, nil
 }
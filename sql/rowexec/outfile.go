@@ -0,0 +1,150 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"bufio"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// writeOutfile writes |rows| to the file of SELECT ... INTO OUTFILE, in the format given by its FIELDS and LINES
+// options. The file is created on the server's file system, and must not exist yet.
+func writeOutfile(ctx *sql.Context, n *plan.Into, rows []sql.Row) error {
+	var charset encodings.Encoder
+	if n.Charset != "" {
+		cs, err := sql.ParseCharacterSet(n.Charset)
+		if err != nil {
+			return err
+		}
+		if cs != sql.CharacterSet_binary {
+			charset = cs.Encoder()
+		}
+	}
+
+	file, err := sql.CreateSecureFile(ctx, n.Outfile)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(file)
+
+	sch := n.Child.Schema(ctx)
+	var line []byte
+	for _, r := range rows {
+		line = append(line[:0], n.LinesStartingBy...)
+		for i, val := range r {
+			if i != 0 {
+				line = append(line, n.FieldsTerminatedBy...)
+			}
+			if val == nil {
+				if n.FieldsEscapedBy == "" {
+					line = append(line, "NULL"...)
+				} else {
+					line = append(line, n.FieldsEscapedBy[0], 'N')
+				}
+				continue
+			}
+
+			field, err := outfileValue(ctx, sch[i].Type, val, charset)
+			if err != nil {
+				file.Close()
+				return err
+			}
+			// OPTIONALLY only encloses strings
+			enclosed := n.FieldsEnclosedBy != "" &&
+				(!n.FieldsEnclosedByOpt || types.IsText(sch[i].Type) || types.IsEnum(sch[i].Type) || types.IsSet(sch[i].Type))
+			if enclosed {
+				line = append(line, n.FieldsEnclosedBy...)
+			}
+			line = appendOutfileField(line, field, n, enclosed)
+			if enclosed {
+				line = append(line, n.FieldsEnclosedBy...)
+			}
+		}
+		line = append(line, n.LinesTerminatedBy...)
+		if _, err = w.Write(line); err != nil {
+			file.Close()
+			return err
+		}
+	}
+
+	err = w.Flush()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// outfileValue returns the bytes that |val| is written as by SELECT ... INTO OUTFILE and INTO DUMPFILE. Strings are
+// converted to |charset| when it's given, and are otherwise written in the character set of their type, as MySQL
+// writes them without conversion. Binary values are never converted.
+func outfileValue(ctx *sql.Context, typ sql.Type, val interface{}, charset encodings.Encoder) ([]byte, error) {
+	if types.IsBinaryType(typ) {
+		sqlVal, err := typ.SQL(ctx, nil, val)
+		if err != nil {
+			return nil, err
+		}
+		return sqlVal.Raw(), nil
+	}
+
+	var str []byte
+	if s, ok := val.(string); ok {
+		str = []byte(s)
+	} else {
+		sqlVal, err := typ.SQL(ctx, nil, val)
+		if err != nil {
+			return nil, err
+		}
+		str = sqlVal.Raw()
+	}
+	if charset == nil {
+		if stringType, ok := typ.(sql.StringType); ok {
+			charset = stringType.CharacterSet().Encoder()
+		}
+	}
+	if charset == nil {
+		return str, nil
+	}
+	return charset.EncodeReplaceUnknown(str), nil
+}
+
+// appendOutfileField appends |field| to |line|, with the characters that would be ambiguous when the file is read by
+// LOAD DATA preceded by the FIELDS ESCAPED BY character. Those are the escape character itself, the enclosure
+// character of |enclosed| fields, and the first characters of the field and line terminators of fields that aren't
+// enclosed. A NUL byte is written as the escape character followed by 0. Nothing is escaped when the escape character
+// is empty.
+func appendOutfileField(line, field []byte, n *plan.Into, enclosed bool) []byte {
+	if n.FieldsEscapedBy == "" {
+		return append(line, field...)
+	}
+	escape := n.FieldsEscapedBy[0]
+	for _, c := range field {
+		switch {
+		case c == 0:
+			line = append(line, escape, '0')
+			continue
+		case c == escape,
+			enclosed && c == n.FieldsEnclosedBy[0],
+			!enclosed && n.FieldsTerminatedBy != "" && c == n.FieldsTerminatedBy[0],
+			!enclosed && n.LinesTerminatedBy != "" && c == n.LinesTerminatedBy[0]:
+			line = append(line, escape)
+		}
+		line = append(line, c)
+	}
+	return line
+}
@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -56,19 +58,11 @@ func (b *BaseBuilder) buildDropUser(ctx *sql.Context, n *plan.DropUser, row sql.
 			return nil, sql.ErrUserDeletionFailure.New(user.String("'"))
 		}
 
-		//TODO: if a user is mentioned in the "mandatory_roles" (users and roles are interchangeable) system variable then they cannot be dropped
-		editor.RemoveUser(mysql_db.UserPrimaryKey{
-			Host: existingUser.Host,
-			User: existingUser.User,
-		})
-		editor.RemoveRoleEdgesFromKey(mysql_db.RoleEdgesFromKey{
-			FromHost: existingUser.Host,
-			FromUser: existingUser.User,
-		})
-		editor.RemoveRoleEdgesToKey(mysql_db.RoleEdgesToKey{
-			ToHost: existingUser.Host,
-			ToUser: existingUser.User,
-		})
+		// users and roles are interchangeable, so a user named by mandatory_roles can't be dropped either
+		if mysqlDb.IsMandatoryRole(ctx, mysql_db.RoleNameOf(existingUser)) {
+			return nil, sql.ErrMandatoryRole.New(mysql_db.RoleNameOf(existingUser).String("`"))
+		}
+		removeUserAndRoles(editor, existingUser)
 	}
 	if err := mysqlDb.Persist(ctx, editor); err != nil {
 		return nil, err
@@ -105,7 +99,9 @@ func (b *BaseBuilder) buildRevokeRole(ctx *sql.Context, n *plan.RevokeRole, row
 				}
 				return nil, err
 			}
-			//TODO: if a role is mentioned in the "mandatory_roles" system variable then they cannot be revoked
+			if mysqlDb.IsMandatoryRole(ctx, mysql_db.RoleNameOf(role)) {
+				return nil, sql.ErrMandatoryRole.New(mysql_db.RoleNameOf(role).String("`"))
+			}
 			editor.RemoveRoleEdge(mysql_db.RoleEdgesPrimaryKey{
 				FromHost: role.Host,
 				FromUser: role.User,
@@ -144,16 +140,10 @@ func (b *BaseBuilder) buildDropRole(ctx *sql.Context, n *plan.DropRole, row sql.
 			return nil, sql.ErrRoleDeletionFailure.New(role.String("'"))
 		}
 
-		//TODO: if a role is mentioned in the "mandatory_roles" system variable then they cannot be dropped
-		editor.RemoveUser(userPk)
-		editor.RemoveRoleEdgesFromKey(mysql_db.RoleEdgesFromKey{
-			FromHost: existingUser.Host,
-			FromUser: existingUser.User,
-		})
-		editor.RemoveRoleEdgesToKey(mysql_db.RoleEdgesToKey{
-			ToHost: existingUser.Host,
-			ToUser: existingUser.User,
-		})
+		if mysqlDb.IsMandatoryRole(ctx, mysql_db.RoleNameOf(existingUser)) {
+			return nil, sql.ErrMandatoryRole.New(mysql_db.RoleNameOf(existingUser).String("`"))
+		}
+		removeUserAndRoles(editor, existingUser)
 	}
 	if err := mysqlDb.Persist(ctx, editor); err != nil {
 		return nil, err
@@ -161,8 +151,299 @@ func (b *BaseBuilder) buildDropRole(ctx *sql.Context, n *plan.DropRole, row sql.
 	return rowIterWithOkResultWithZeroRowsAffected(), nil
 }
 
+// removeUserAndRoles removes |user| from the privilege tables, along with its role grants and default roles, both as
+// a user and as a role, and its proxy grants.
+func removeUserAndRoles(editor *mysql_db.Editor, user *mysql_db.User) {
+	editor.RemoveUser(mysql_db.UserPrimaryKey{
+		Host: user.Host,
+		User: user.User,
+	})
+	editor.RemoveRoleEdgesFromKey(mysql_db.RoleEdgesFromKey{
+		FromHost: user.Host,
+		FromUser: user.User,
+	})
+	editor.RemoveRoleEdgesToKey(mysql_db.RoleEdgesToKey{
+		ToHost: user.Host,
+		ToUser: user.User,
+	})
+	editor.RemoveDefaultRolesUserKey(mysql_db.DefaultRolesUserKey{
+		Host: user.Host,
+		User: user.User,
+	})
+	editor.RemoveDefaultRolesRoleKey(mysql_db.DefaultRolesRoleKey{
+		RoleHost: user.Host,
+		RoleUser: user.User,
+	})
+	editor.RemoveProxyPrivsUserKey(mysql_db.ProxiesPrivUserKey{
+		Host: user.Host,
+		User: user.User,
+	})
+}
+
+func (b *BaseBuilder) buildSetRole(ctx *sql.Context, n *plan.SetRole, row sql.Row) (sql.RowIter, error) {
+	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+
+	reader := mysqlDb.Reader()
+	defer reader.Close()
+
+	client := ctx.Session.Client()
+	user := mysqlDb.GetUser(reader, client.User, client.Address, false)
+	var granted []sql.RoleName
+	userName := sql.RoleName{Name: client.User, Host: client.Address}
+	if user != nil {
+		granted = mysqlDb.GrantedRoles(ctx, reader, user)
+		userName = mysql_db.RoleNameOf(user)
+	}
+
+	var roles []sql.RoleName
+	switch n.Selection {
+	case plan.RoleSelection_Default:
+		if user != nil {
+			roles = mysqlDb.DefaultRoles(reader, user)
+		}
+	case plan.RoleSelection_None:
+	case plan.RoleSelection_All, plan.RoleSelection_AllExcept:
+		except := make(map[sql.RoleName]struct{})
+		for _, role := range n.Roles {
+			except[roleNameOf(role)] = struct{}{}
+		}
+		for _, role := range granted {
+			if _, ok := except[role]; !ok {
+				roles = append(roles, role)
+			}
+		}
+	default:
+	ROLES:
+		for _, role := range n.Roles {
+			name := roleNameOf(role)
+			for _, grantedRole := range granted {
+				if grantedRole == name {
+					roles = append(roles, name)
+					continue ROLES
+				}
+			}
+			return nil, sql.ErrRoleNotGranted.New(name.String("`"), userName.String("`"))
+		}
+	}
+
+	ctx.Session.SetActiveRoles(roles, true)
+	ctx.Session.SetPrivilegeSet(nil, 0)
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+func (b *BaseBuilder) buildSetPassword(ctx *sql.Context, n *plan.SetPassword, row sql.Row) (sql.RowIter, error) {
+	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+
+	editor := mysqlDb.Editor()
+	defer editor.Close()
+
+	var user *mysql_db.User
+	if n.User == nil {
+		client := ctx.Session.Client()
+		user = mysqlDb.GetUser(editor, client.User, client.Address, false)
+		if user == nil {
+			userName := plan.UserName{Name: client.User, Host: client.Address}
+			return nil, sql.ErrUserAlterFailure.New(userName.String("'"))
+		}
+	} else {
+		userName := *n.User
+		if userName.AnyHost {
+			userName.Host = "%"
+		}
+		user, ok = editor.GetUser(mysql_db.UserPrimaryKey{Host: userName.Host, User: userName.Name})
+		if !ok {
+			return nil, sql.ErrUserAlterFailure.New(userName.String("'"))
+		}
+	}
+
+	// the account keeps its authentication plugin, which must store a password
+	var auth plan.Authentication
+	switch user.Plugin {
+	case string(mysql.MysqlNativePassword):
+		auth = plan.AuthenticationMysqlNativePassword(n.Password)
+	case string(mysql.CachingSha2Password):
+		auth = plan.NewCachingSha2PasswordAuthentication(n.Password)
+	default:
+		return nil, sql.ErrSetPasswordAuthPlugin.New(user.User, user.Host)
+	}
+	if err := changePassword(ctx, mysqlDb, editor, user, auth, ""); err != nil {
+		return nil, err
+	}
+	editor.PutUser(user)
+	if err := mysqlDb.Persist(ctx, editor); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+// changePassword sets the authentication of |user| to |auth|, whose password must be one that the reuse policies of
+// the user allow. The new password is recorded in the password history of the user, and a session whose user's
+// password is changed leaves sandbox mode.
+func changePassword(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb, editor *mysql_db.Editor, user *mysql_db.User, auth plan.Authentication, identity string) error {
+	authString, err := auth.AuthString()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	if password, ok := plan.AuthenticationPassword(auth); ok && user.PasswordReused(password, now) {
+		return sql.ErrPasswordHistory.New(user.User, user.Host)
+	}
+
+	user.Plugin = auth.Plugin()
+	user.AuthString = authString
+	user.Identity = identity
+	user.PasswordChanged(now)
+
+	client := ctx.Session.Client()
+	if sessionUser := mysqlDb.GetUser(editor, client.User, client.Address, false); sessionUser != nil &&
+		sessionUser.User == user.User && sessionUser.Host == user.Host {
+		ctx.Session.SetSandboxMode(false, true)
+	}
+	return nil
+}
+
+func (b *BaseBuilder) buildSetDefaultRole(ctx *sql.Context, n *plan.SetDefaultRole, row sql.Row) (sql.RowIter, error) {
+	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+
+	editor := mysqlDb.Editor()
+	defer editor.Close()
+
+	for _, targetUser := range n.Users {
+		user := mysqlDb.GetUser(editor, targetUser.Name, targetUser.Host, false)
+		if user == nil {
+			if n.IfExists {
+				continue
+			}
+			return nil, sql.ErrUserAlterFailure.New(targetUser.String("'"))
+		}
+		roles, err := defaultRoleSelection(ctx, mysqlDb, editor, user, n.Selection, n.Roles)
+		if err != nil {
+			return nil, err
+		}
+		setDefaultRoles(editor, user, roles)
+	}
+	if err := mysqlDb.Persist(ctx, editor); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+// defaultRoleSelection returns the roles that |selection| makes the default roles of |user|. Each role that is named
+// must be granted to the user.
+func defaultRoleSelection(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb, editor *mysql_db.Editor, user *mysql_db.User, selection plan.RoleSelection, names []plan.UserName) ([]sql.RoleName, error) {
+	granted := mysqlDb.GrantedRoles(ctx, editor, user)
+	switch selection {
+	case plan.RoleSelection_None:
+		return nil, nil
+	case plan.RoleSelection_All:
+		return granted, nil
+	}
+	roles := make([]sql.RoleName, len(names))
+ROLES:
+	for i, role := range names {
+		roles[i] = roleNameOf(role)
+		for _, grantedRole := range granted {
+			if grantedRole == roles[i] {
+				continue ROLES
+			}
+		}
+		return nil, sql.ErrRoleNotGranted.New(roles[i].String("`"), mysql_db.RoleNameOf(user).String("`"))
+	}
+	return roles, nil
+}
+
+// setDefaultRoles replaces the default roles of |user| with |roles|.
+func setDefaultRoles(editor *mysql_db.Editor, user *mysql_db.User, roles []sql.RoleName) {
+	editor.RemoveDefaultRolesUserKey(mysql_db.DefaultRolesUserKey{
+		Host: user.Host,
+		User: user.User,
+	})
+	for _, role := range roles {
+		editor.PutDefaultRole(&mysql_db.DefaultRole{
+			Host:     user.Host,
+			User:     user.User,
+			RoleHost: role.Host,
+			RoleUser: role.Name,
+		})
+	}
+}
+
+// roleNameOf returns the role that |name| refers to. A role with no host is a role of any host.
+func roleNameOf(name plan.UserName) sql.RoleName {
+	host := name.Host
+	if name.AnyHost || host == "" {
+		host = "%"
+	}
+	return sql.RoleName{Name: name.Name, Host: host}
+}
+
 func (b *BaseBuilder) buildRevokeProxy(ctx *sql.Context, n *plan.RevokeProxy, row sql.Row) (sql.RowIter, error) {
-	return nil, fmt.Errorf("%T has no execution iterator", n)
+	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+
+	editor := mysqlDb.Editor()
+	defer editor.Close()
+
+	proxied := proxiedUserKey(n.On)
+	for _, revokeUser := range n.From {
+		user := mysqlDb.GetUser(editor, revokeUser.Name, revokeUser.Host, false)
+		if user == nil {
+			err := sql.ErrRevokeUserDoesNotExist.New(revokeUser.Name, revokeUser.Host)
+			if n.IgnoreUnknownUser {
+				ctx.Warn(3162, "%s", err.Error())
+				continue
+			}
+			return nil, err
+		}
+		key := mysql_db.ProxiesPrivPrimaryKey{
+			Host:        user.Host,
+			User:        user.User,
+			ProxiedHost: proxied.ProxiedHost,
+			ProxiedUser: proxied.ProxiedUser,
+		}
+		if !hasProxyPriv(editor, key) {
+			if n.IfExists {
+				continue
+			}
+			return nil, sql.ErrRevokeUserDoesNotExist.New(user.User, user.Host)
+		}
+		editor.RemoveProxyPriv(key)
+	}
+	if err := mysqlDb.Persist(ctx, editor); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+// proxiedUserKey returns the proxied user |on| as it's stored in the proxies_priv table, where the anonymous user
+// ”@” stands for every user.
+func proxiedUserKey(on plan.UserName) mysql_db.ProxiesPrivProxiedKey {
+	host := on.Host
+	if on.AnyHost {
+		host = "%"
+	}
+	return mysql_db.ProxiesPrivProxiedKey{ProxiedHost: host, ProxiedUser: on.Name}
+}
+
+// hasProxyPriv returns whether the proxy grant with the primary key |key| exists.
+func hasProxyPriv(editor *mysql_db.Editor, key mysql_db.ProxiesPrivPrimaryKey) bool {
+	for _, proxyPriv := range editor.GetProxyPrivs(mysql_db.ProxiesPrivUserKey{Host: key.Host, User: key.User}) {
+		if proxyPriv.ProxiedHost == key.ProxiedHost && proxyPriv.ProxiedUser == key.ProxiedUser {
+			return true
+		}
+	}
+	return false
 }
 
 func (b *BaseBuilder) buildGrantRole(ctx *sql.Context, n *plan.GrantRole, row sql.Row) (sql.RowIter, error) {
@@ -184,6 +465,10 @@ func (b *BaseBuilder) buildGrantRole(ctx *sql.Context, n *plan.GrantRole, row sq
 			if role == nil {
 				return nil, sql.ErrGrantRevokeRoleDoesNotExist.New(targetRole.String("`"))
 			}
+			roleName, userName := mysql_db.RoleNameOf(role), mysql_db.RoleNameOf(user)
+			if mysqlDb.IsGrantedTo(editor, userName, roleName) {
+				return nil, sql.ErrRoleGrantedToItself.New(userName.String("`"), roleName.String("`"))
+			}
 			editor.PutRoleEdge(&mysql_db.RoleEdge{
 				FromHost:        role.Host,
 				FromUser:        role.User,
@@ -200,7 +485,48 @@ func (b *BaseBuilder) buildGrantRole(ctx *sql.Context, n *plan.GrantRole, row sq
 }
 
 func (b *BaseBuilder) buildGrantProxy(ctx *sql.Context, n *plan.GrantProxy, row sql.Row) (sql.RowIter, error) {
-	return nil, fmt.Errorf("%T has no execution iterator", n)
+	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+
+	editor := mysqlDb.Editor()
+	defer editor.Close()
+
+	client := ctx.Session.Client()
+	proxied := proxiedUserKey(n.On)
+	for _, grantUser := range n.To {
+		user := mysqlDb.GetUser(editor, grantUser.Name, grantUser.Host, false)
+		if user == nil {
+			return nil, sql.ErrGrantUserDoesNotExist.New()
+		}
+		key := mysql_db.ProxiesPrivPrimaryKey{
+			Host:        user.Host,
+			User:        user.User,
+			ProxiedHost: proxied.ProxiedHost,
+			ProxiedUser: proxied.ProxiedUser,
+		}
+		withGrant := n.WithGrantOption
+		// Granting the PROXY privilege again keeps its grant option
+		for _, proxyPriv := range editor.GetProxyPrivs(mysql_db.ProxiesPrivUserKey{Host: key.Host, User: key.User}) {
+			if proxyPriv.ProxiedHost == key.ProxiedHost && proxyPriv.ProxiedUser == key.ProxiedUser {
+				withGrant = withGrant || proxyPriv.WithGrant
+			}
+		}
+		editor.PutProxyPriv(&mysql_db.ProxyPriv{
+			Host:        key.Host,
+			User:        key.User,
+			ProxiedHost: key.ProxiedHost,
+			ProxiedUser: key.ProxiedUser,
+			WithGrant:   withGrant,
+			Grantor:     fmt.Sprintf("%s@%s", client.User, client.Address),
+			Timestamp:   time.Now().UTC().Truncate(time.Second),
+		})
+	}
+	if err := mysqlDb.Persist(ctx, editor); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
 }
 
 func (b *BaseBuilder) buildRenameUser(ctx *sql.Context, n *plan.RenameUser, row sql.Row) (sql.RowIter, error) {
@@ -256,7 +582,14 @@ func (b *BaseBuilder) buildRevoke(ctx *sql.Context, n *plan.Revoke, row sql.Row)
 			if n.ObjectType != plan.ObjectType_Any {
 				return nil, sql.ErrGrantRevokeIllegalPrivilege.New()
 			}
+			partialRevokes, err := partialRevokesEnabled(ctx)
+			if err != nil {
+				return nil, err
+			}
 			for _, user := range users {
+				if partialRevokes {
+					n.HandlePartialRevokes(user, database)
+				}
 				if err := n.HandleDatabasePrivileges(user, database); err != nil {
 					return nil, err
 				}
@@ -291,6 +624,16 @@ func (b *BaseBuilder) buildRevoke(ctx *sql.Context, n *plan.Revoke, row sql.Row)
 	return rowIterWithOkResultWithZeroRowsAffected(), nil
 }
 
+// partialRevokesEnabled returns whether the partial_revokes system variable is set, which allows revoking a global
+// privilege from a specific database.
+func partialRevokesEnabled(ctx *sql.Context) (bool, error) {
+	_, val, ok := sql.SystemVariables.GetGlobal("partial_revokes")
+	if !ok {
+		return false, nil
+	}
+	return sql.ConvertToBool(ctx, val)
+}
+
 func (b *BaseBuilder) buildGrant(ctx *sql.Context, n *plan.Grant, row sql.Row) (sql.RowIter, error) {
 	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
 	if !ok {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/procedures"
 )
@@ -235,7 +236,12 @@ func (b *BaseBuilder) buildCall(ctx *sql.Context, n *plan.Call, row sql.Row) (sq
 	defer ctx.SetTransaction(oldTx)
 	ctx.SetTransaction(nil)
 
+	restorePrivileges, err := impersonateProcedureDefiner(ctx, n)
+	if err != nil {
+		return nil, err
+	}
 	rowIter, _, err := procedures.Call(ctx, n)
+	restorePrivileges()
 	if err != nil {
 		return nil, err
 	}
@@ -435,3 +441,27 @@ func (b *BaseBuilder) buildIterate(ctx *sql.Context, n *plan.Iterate, row sql.Ro
 func (b *BaseBuilder) buildWhile(ctx *sql.Context, n *plan.While, row sql.Row) (sql.RowIter, error) {
 	return b.buildLoop(ctx, n.Loop, row)
 }
+
+// impersonateProcedureDefiner gives the session the privileges of the definer of the procedure that |n| calls, when it
+// has SQL SECURITY DEFINER, so that the statements of the procedure are authorized against them. The returned function
+// restores the privileges of the session.
+func impersonateProcedureDefiner(ctx *sql.Context, n *plan.Call) (func(), error) {
+	proc := n.Procedure
+	if proc.SecurityContext != plan.ProcedureSecurityContext_Definer || proc.Definer == "" || n.Catalog() == nil {
+		return func() {}, nil
+	}
+	db, err := n.Catalog().Database(ctx, "mysql")
+	if err != nil {
+		return func() {}, nil
+	}
+	mysqlDb, ok := db.(*mysql_db.MySQLDb)
+	if !ok || !mysqlDb.Enabled() {
+		return func() {}, nil
+	}
+	privSet, ok := mysqlDb.DefinerPrivilegeSet(ctx, proc.Definer)
+	if !ok {
+		user, host := mysql_db.ParseDefiner(proc.Definer)
+		return nil, sql.ErrNoSuchDefiner.New(user, host)
+	}
+	return mysqlDb.Impersonate(ctx, privSet), nil
+}
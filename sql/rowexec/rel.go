@@ -15,16 +15,13 @@
 package rowexec
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
 	"reflect"
-	"strings"
 
 	"github.com/cockroachdb/apd/v3"
-	"github.com/dolthub/jsonpath"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -207,21 +204,15 @@ func (b *BaseBuilder) buildJSONTable(ctx *sql.Context, n *plan.JSONTable, row sq
 		return nil, err
 	}
 
-	jsonPathData, err := jsonpath.JsonPathLookup(jsonData, n.RootPath)
+	cols, err := b.buildJSONTableCols(ctx, n.Cols, row)
 	if err != nil {
-		jsonPathData = []interface{}{}
-	}
-	if _, ok := jsonPathData.([]interface{}); !ok {
-		jsonPathData = []interface{}{jsonPathData}
+		return nil, err
 	}
 
-	cols, err := b.buildJSONTableCols(ctx, n.Cols, row)
-
 	rowIter := &iters.JsonTableRowIter{
-		Data: jsonPathData.([]interface{}),
+		Data: iters.JsonTableMatches(jsonData, n.RootPath),
 		Cols: cols,
 	}
-	rowIter.NextSibling() // set to first sibling
 
 	return rowIter, nil
 }
@@ -322,6 +313,13 @@ func (b *BaseBuilder) buildProject(ctx *sql.Context, n *plan.Project, row sql.Ro
 		return nil, err
 	}
 
+	if !n.IncludesNestedIters && useBatchIters(ctx, n.Child, n.Projections...) {
+		return sql.NewSpanIter(span, sql.BatchIterToRowIter(&batchProjectIter{
+			projs:     n.Projections,
+			childIter: sql.RowIterToBatchIter(i),
+		})), nil
+	}
+
 	return sql.NewSpanIter(span, &ProjectIter{
 		projs:          n.Projections,
 		canDefer:       n.CanDefer,
@@ -420,6 +418,8 @@ func (b *BaseBuilder) buildGroupBy(ctx *sql.Context, n *plan.GroupBy, row sql.Ro
 	var iter sql.RowIter
 	if len(n.GroupByExprs) == 0 {
 		iter = newGroupByIter(n.SelectDeps, i)
+	} else if n.GroupingSets != nil {
+		iter = newGroupingSetsIter(ctx, n.SelectDeps, n.GroupByExprs, n.GroupingSets, i)
 	} else {
 		iter = newGroupByGroupingIter(ctx, n.SelectDeps, n.GroupByExprs, i)
 	}
@@ -436,6 +436,13 @@ func (b *BaseBuilder) buildFilter(ctx *sql.Context, n *plan.Filter, row sql.Row)
 		return nil, err
 	}
 
+	if useBatchIters(ctx, n.Child, n.Expression) {
+		return sql.NewSpanIter(span, sql.BatchIterToRowIter(&batchFilterIter{
+			cond:      n.Expression,
+			childIter: sql.RowIterToBatchIter(i),
+		})), nil
+	}
+
 	return sql.NewSpanIter(span, plan.NewFilterIter(n.Expression, i)), nil
 }
 
@@ -448,6 +455,10 @@ func (b *BaseBuilder) buildDeclareHandler(ctx *sql.Context, n *plan.DeclareHandl
 }
 
 func (b *BaseBuilder) buildRecursiveCte(ctx *sql.Context, n *plan.RecursiveCte, row sql.Row) (sql.RowIter, error) {
+	maxDepth, err := ctx.GetSessionVariable(ctx, "cte_max_recursion_depth")
+	if err != nil {
+		return nil, err
+	}
 	var iter sql.RowIter = &recursiveCteIter{
 		init:        n.Left(),
 		rec:         n.Right(),
@@ -455,22 +466,44 @@ func (b *BaseBuilder) buildRecursiveCte(ctx *sql.Context, n *plan.RecursiveCte,
 		working:     n.Working,
 		temp:        make([]sql.Row, 0),
 		deduplicate: n.Union().Distinct,
+		maxDepth:    maxDepth.(int64),
 		b:           b,
 	}
-	if n.Union().Limit != nil && len(n.Union().SortConditions) > 0 {
-		limit, err := iters.GetInt64Value(ctx, n.Union().Limit)
+	union := n.Union()
+	var offset int64
+	if union.Offset != nil {
+		offset, err = iters.GetInt64Value(ctx, union.Offset)
 		if err != nil {
 			return nil, err
 		}
-		iter = iters.NewTopRowsIter(n.Union().SortConditions, limit, false, iter)
-	} else if n.Union().Limit != nil {
-		limit, err := iters.GetInt64Value(ctx, n.Union().Limit)
+	}
+	if len(union.SortConditions) > 0 {
+		if union.Limit != nil {
+			limit, err := iters.GetInt64Value(ctx, union.Limit)
+			if err != nil {
+				return nil, err
+			}
+			iter = iters.NewTopRowsIter(union.SortConditions, limit+offset, false, iter)
+		} else {
+			iter = iters.NewSortIter(union.SortConditions, iter)
+		}
+		if offset > 0 {
+			iter = &offsetIter{skip: offset, childIter: iter}
+		}
+		return iter, nil
+	}
+
+	// Limit must wrap offset, and not vice-versa, so that skipped rows don't count toward the returned row count.
+	// Without sort conditions, the limit stops the recursion as soon as enough rows have been produced.
+	if offset > 0 {
+		iter = &offsetIter{skip: offset, childIter: iter}
+	}
+	if union.Limit != nil {
+		limit, err := iters.GetInt64Value(ctx, union.Limit)
 		if err != nil {
 			return nil, err
 		}
 		iter = &iters.LimitIter{Limit: limit, ChildIter: iter}
-	} else if len(n.Union().SortConditions) > 0 {
-		iter = iters.NewSortIter(n.Union().SortConditions, iter)
 	}
 	return iter, nil
 }
@@ -545,46 +578,6 @@ func (b *BaseBuilder) populateMax1Results(ctx *sql.Context, n *plan.Max1Row, row
 	return nil
 }
 
-// isUnderSecureFileDir ensures that fileStr is under secureFileDir or a subdirectory of secureFileDir, errors otherwise
-func isUnderSecureFileDir(secureFileDir interface{}, fileStr string) error {
-	if secureFileDir == nil || secureFileDir == "" {
-		return nil
-	}
-	sStat, err := os.Stat(secureFileDir.(string))
-	if err != nil {
-		return err
-	}
-	fStat, err := os.Stat(filepath.Dir(fileStr))
-	if err != nil {
-		return err
-	}
-	if os.SameFile(sStat, fStat) {
-		return nil
-	}
-
-	fileAbsPath, filePathErr := filepath.Abs(fileStr)
-	if filePathErr != nil {
-		return filePathErr
-	}
-	secureFileDirAbsPath, _ := filepath.Abs(secureFileDir.(string))
-	if strings.HasPrefix(fileAbsPath, secureFileDirAbsPath) {
-		return nil
-	}
-	return sql.ErrSecureFilePriv.New()
-}
-
-// createIfNotExists creates a file if it does not exist, errors otherwise
-func createIfNotExists(fileStr string) (*os.File, error) {
-	if _, fErr := os.Stat(fileStr); fErr == nil {
-		return nil, sql.ErrFileExists.New(fileStr)
-	}
-	file, fileErr := os.OpenFile(fileStr, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0640)
-	if fileErr != nil {
-		return nil, fileErr
-	}
-	return file, nil
-}
-
 func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.Into")
 	defer span.End()
@@ -598,56 +591,11 @@ func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sq
 		return nil, err
 	}
 
-	var secureFileDir interface{}
-	if n.Outfile != "" || n.Dumpfile != "" {
-		var ok bool
-		_, secureFileDir, ok = sql.SystemVariables.GetGlobal("secure_file_priv")
-		if !ok {
-			return nil, fmt.Errorf("error: secure_file_priv variable was not found")
-		}
-	}
-
 	if n.Outfile != "" {
 		// TODO: MySQL has relative paths from the "data dir"
-		if err = isUnderSecureFileDir(secureFileDir, n.Outfile); err != nil {
+		if err = writeOutfile(ctx, n, rows); err != nil {
 			return nil, err
 		}
-		file, fileErr := createIfNotExists(n.Outfile)
-		if fileErr != nil {
-			return nil, fileErr
-		}
-		defer file.Close()
-
-		sch := n.Child.Schema(ctx)
-		for _, r := range rows {
-			file.WriteString(n.LinesStartingBy)
-			for i, val := range r {
-				if i != 0 {
-					file.WriteString(n.FieldsTerminatedBy)
-				}
-				if val == nil {
-					if len(n.FieldsEscapedBy) == 0 {
-						file.WriteString("NULL")
-					} else {
-						file.WriteString(fmt.Sprintf("%sN", n.FieldsEscapedBy))
-					}
-					continue
-				}
-				if !n.FieldsEnclosedByOpt || types.IsText(sch[i].Type) {
-					if strVal, ok := val.(string); ok {
-						if len(n.LinesTerminatedBy) != 0 {
-							strVal = strings.Replace(strVal, n.LinesTerminatedBy, n.FieldsEscapedBy+n.LinesTerminatedBy, -1)
-						}
-						file.WriteString(fmt.Sprintf("%s%v%s", n.FieldsEnclosedBy, strVal, n.FieldsEnclosedBy))
-					} else {
-						file.WriteString(fmt.Sprintf("%s%v%s", n.FieldsEnclosedBy, val, n.FieldsEnclosedBy))
-					}
-				} else {
-					file.WriteString(fmt.Sprintf("%v", val))
-				}
-			}
-			file.WriteString(n.LinesTerminatedBy)
-		}
 		return sql.RowsToRowIter(sql.Row{types.NewOkResult(len(rows))}), nil
 	}
 
@@ -657,19 +605,32 @@ func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sq
 	}
 
 	if n.Dumpfile != "" {
-		if err = isUnderSecureFileDir(secureFileDir, n.Dumpfile); err != nil {
+		file, err := sql.CreateSecureFile(ctx, n.Dumpfile)
+		if err != nil {
 			return nil, err
 		}
-		file, fileErr := createIfNotExists(n.Dumpfile)
-		if fileErr != nil {
-			return nil, fileErr
-		}
-		defer file.Close()
+		w := bufio.NewWriter(file)
 		if rowNum == 1 {
-			for _, val := range rows[0] {
-				file.WriteString(fmt.Sprintf("%v", val))
+			sch := n.Child.Schema(ctx)
+			for i, val := range rows[0] {
+				if val == nil {
+					continue
+				}
+				field, err := outfileValue(ctx, sch[i].Type, val, nil)
+				if err != nil {
+					file.Close()
+					return nil, err
+				}
+				w.Write(field)
 			}
 		}
+		err = w.Flush()
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
 		return sql.RowsToRowIter(sql.Row{types.NewOkResult(rowNum)}), nil
 	}
 
@@ -796,6 +757,10 @@ func (b *BaseBuilder) buildIndexedTableAccess(ctx *sql.Context, n *plan.IndexedT
 		return sql.RowsToRowIter(), nil
 	}
 
+	// lookup joins build a new lookup for every row of their left side, so don't start one for a canceled query
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
 	partIter, err := n.Table.LookupPartitions(ctx, lookup)
 	if err != nil {
 		return nil, err
@@ -803,6 +768,10 @@ func (b *BaseBuilder) buildIndexedTableAccess(ctx *sql.Context, n *plan.IndexedT
 
 	var tableIter sql.RowIter
 	tableIter = sql.NewTableRowIter(ctx, n.Table, partIter)
+	// a strict lookup returns at most one row, so there is nothing to read ahead
+	if n.IsStatic() || !n.IsStrictLookup(ctx) {
+		tableIter = prefetchTableRows(ctx, n.UnderlyingTable(), tableIter)
+	}
 
 	if vct, ok := plan.FindVirtualColumnTable(n.Table); ok {
 		tableIter, err = b.buildVirtualColumnTable(ctx, vct, tableIter, row)
@@ -814,6 +783,11 @@ func (b *BaseBuilder) buildIndexedTableAccess(ctx *sql.Context, n *plan.IndexedT
 	return sql.NewSpanIter(span, tableIter), nil
 }
 
+func (b *BaseBuilder) buildLooseIndexScan(ctx *sql.Context, n *plan.LooseIndexScan, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.LooseIndexScan")
+	return sql.NewSpanIter(span, newLooseIndexScanIter(ctx, n)), nil
+}
+
 func (b *BaseBuilder) buildSetOp(ctx *sql.Context, s *plan.SetOp, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.SetOp")
 	var iter sql.RowIter
@@ -936,6 +910,7 @@ func (b *BaseBuilder) buildResolvedTable(ctx *sql.Context, n *plan.ResolvedTable
 
 	var iter sql.RowIter
 	iter = sql.NewTableRowIter(ctx, n.Table, partitions)
+	iter = prefetchTableRows(ctx, n.UnderlyingTable(), iter)
 
 	if vct, ok := plan.FindVirtualColumnTable(n.Table); ok {
 		iter, err = b.buildVirtualColumnTable(ctx, vct, iter, row)
@@ -947,6 +922,14 @@ func (b *BaseBuilder) buildResolvedTable(ctx *sql.Context, n *plan.ResolvedTable
 	return sql.NewSpanIter(span, iter), nil
 }
 
+// prefetchTableRows wraps |iter| to read rows ahead of the query if |table| is a sql.PrefetchTable.
+func prefetchTableRows(ctx *sql.Context, table sql.Table, iter sql.RowIter) sql.RowIter {
+	if pt, ok := table.(sql.PrefetchTable); ok && pt.PrefetchRows() > 0 {
+		return sql.NewPrefetchRowIter(ctx, iter, pt.PrefetchRows())
+	}
+	return iter
+}
+
 func (b *BaseBuilder) buildTableCount(_ *sql.Context, n *plan.TableCountLookup, _ sql.Row) (sql.RowIter, error) {
 	return sql.RowsToRowIter(sql.Row{int64(n.Count())}), nil
 }
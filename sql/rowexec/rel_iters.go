@@ -120,6 +120,25 @@ func (i *offsetIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return row, nil
 }
 
+// NextValueRow implements the sql.ValueRowIter interface.
+func (i *offsetIter) NextValueRow(ctx *sql.Context) (sql.ValueRow, error) {
+	childIter := i.childIter.(sql.ValueRowIter)
+	for i.skip > 0 {
+		_, err := childIter.NextValueRow(ctx)
+		if err != nil {
+			return nil, err
+		}
+		i.skip--
+	}
+	return childIter.NextValueRow(ctx)
+}
+
+// IsValueRowIter implements the sql.ValueRowIter interface.
+func (i *offsetIter) IsValueRowIter(ctx *sql.Context) bool {
+	childIter, ok := i.childIter.(sql.ValueRowIter)
+	return ok && childIter.IsValueRowIter(ctx)
+}
+
 func (i *offsetIter) Close(ctx *sql.Context) error {
 	return i.childIter.Close(ctx)
 }
@@ -132,8 +151,12 @@ type ProjectIter struct {
 	projs          []sql.Expression
 	canDefer       bool
 	hasNestedIters bool
+	// arena allocates the rows returned by NextValueRow
+	arena sql.ValueArena
 }
 
+var _ sql.ValueRowIter = (*ProjectIter)(nil)
+
 type nestedIterState struct {
 	projections    []sql.Expression
 	sourceRow      sql.Row
@@ -153,6 +176,37 @@ func (i *ProjectIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return ProjectRow(ctx, i.projs, childRow)
 }
 
+// NextValueRow implements the sql.ValueRowIter interface.
+func (i *ProjectIter) NextValueRow(ctx *sql.Context) (sql.ValueRow, error) {
+	childRow, err := i.childIter.(sql.ValueRowIter).NextValueRow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := i.arena.NewValueRow(len(i.projs))
+	for j, proj := range i.projs {
+		row[j], err = proj.(sql.ValueExpression).EvalValue(ctx, childRow)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// IsValueRowIter implements the sql.ValueRowIter interface.
+func (i *ProjectIter) IsValueRowIter(ctx *sql.Context) bool {
+	if i.hasNestedIters {
+		return false
+	}
+	for _, proj := range i.projs {
+		valExpr, ok := proj.(sql.ValueExpression)
+		if !ok || !valExpr.IsValueExpression(ctx) {
+			return false
+		}
+	}
+	childIter, ok := i.childIter.(sql.ValueRowIter)
+	return ok && childIter.IsValueRowIter(ctx)
+}
+
 func (i *ProjectIter) Close(ctx *sql.Context) error {
 	return i.childIter.Close(ctx)
 }
@@ -396,6 +450,23 @@ func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expr
 	if err != nil {
 		return err
 	}
+	if assigned, ok := val.(string); ok && strings.EqualFold(sysVar.Name, sql.OptimizerSwitchSessionVar) {
+		// A partial optimizer_switch assignment only changes the flags it names, and the variable keeps the full list
+		var current interface{}
+		if sysVar.Scope.IsSessionOnly() {
+			current, err = ctx.GetSessionVariable(ctx, sysVar.Name)
+			if err != nil {
+				return err
+			}
+		} else {
+			_, current, _ = sql.SystemVariables.GetGlobal(sysVar.Name)
+		}
+		currentStr, _ := current.(string)
+		val, err = sql.MergeOptimizerSwitch(currentStr, assigned)
+		if err != nil {
+			return err
+		}
+	}
 	err = sysVar.Scope.SetValue(ctx, sysVar.Name, val)
 	if err != nil {
 		return err
@@ -546,8 +617,6 @@ func (d *declareHandlerIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
-const cteRecursionLimit = 10001
-
 // recursiveCteIter exhaustively executes a recursive
 // relation [rec] populated by an [init] base case.
 // Refer to RecursiveCte for more details.
@@ -568,8 +637,10 @@ type recursiveCteIter struct {
 	row sql.Row
 	// buffer to collect intermediate results for next recursion
 	temp []sql.Row
-	// number of recursive iterations finishe
-	cycle int
+	// number of recursive iterations finished
+	cycle int64
+	// maximum number of recursive iterations, from cte_max_recursion_depth
+	maxDepth int64
 	// true if UNION, false if UNION ALL
 	deduplicate bool
 }
@@ -639,8 +710,8 @@ func (r *recursiveCteIter) resetIter(ctx *sql.Context) error {
 		return io.EOF
 	}
 	r.cycle++
-	if r.cycle > cteRecursionLimit {
-		return sql.ErrCteRecursionLimitExceeded.New()
+	if r.cycle > r.maxDepth {
+		return sql.ErrCteRecursionLimitExceeded.New(r.cycle)
 	}
 
 	if r.working != nil {
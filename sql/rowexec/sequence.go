@@ -0,0 +1,139 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"fmt"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// erUnknownSequence is the error code of sql.ErrUnknownSequence, which MySQL doesn't define
+const erUnknownSequence = 4091
+
+func (b *BaseBuilder) buildCreateSequence(ctx *sql.Context, n *plan.CreateSequence, row sql.Row) (sql.RowIter, error) {
+	db, ok := n.Db.(sql.SequenceDatabase)
+	if !ok {
+		return nil, sql.ErrSequencesNotSupported.New(n.Db.Name())
+	}
+	seq := n.Options.Definition(n.Name)
+	if err := seq.Validate(n.Db.Name()); err != nil {
+		return nil, err
+	}
+
+	// sequences share their names with tables
+	_, tableExists, err := n.Db.GetTableInsensitive(ctx, n.Name)
+	if err != nil {
+		return nil, err
+	}
+	_, seqExists, err := db.GetSequence(ctx, n.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case seqExists && n.OrReplace:
+		if err := db.DropSequence(ctx, n.Name); err != nil {
+			return nil, err
+		}
+	case (seqExists || tableExists) && n.IfNotExists:
+		ctx.Warn(mysql.ERTableExists, "%s", sql.ErrTableAlreadyExists.New(n.Name).Error())
+		return rowIterWithOkResultWithZeroRowsAffected(), nil
+	case seqExists || tableExists:
+		return nil, sql.ErrTableAlreadyExists.New(n.Name)
+	}
+
+	if err := db.CreateSequence(ctx, seq); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+func (b *BaseBuilder) buildAlterSequence(ctx *sql.Context, n *plan.AlterSequence, row sql.Row) (sql.RowIter, error) {
+	db, ok := n.Db.(sql.SequenceDatabase)
+	if !ok {
+		return nil, sql.ErrSequencesNotSupported.New(n.Db.Name())
+	}
+	seq, exists, err := db.GetSequence(ctx, n.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		err := sql.ErrUnknownSequence.New(n.Db.Name() + "." + n.Name)
+		if n.IfExists {
+			ctx.Warn(erUnknownSequence, "%s", err.Error())
+			return rowIterWithOkResultWithZeroRowsAffected(), nil
+		}
+		return nil, err
+	}
+
+	seq = n.Options.Apply(seq)
+	if err := seq.Validate(n.Db.Name()); err != nil {
+		return nil, err
+	}
+	if err := db.UpdateSequence(ctx, seq); err != nil {
+		return nil, err
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+func (b *BaseBuilder) buildDropSequence(ctx *sql.Context, n *plan.DropSequence, row sql.Row) (sql.RowIter, error) {
+	for i, name := range n.Names {
+		var exists bool
+		db, ok := n.Dbs[i].(sql.SequenceDatabase)
+		if ok {
+			var err error
+			if _, exists, err = db.GetSequence(ctx, name); err != nil {
+				return nil, err
+			}
+		}
+		if !exists {
+			err := sql.ErrUnknownSequence.New(n.Dbs[i].Name() + "." + name)
+			if n.IfExists {
+				ctx.Warn(erUnknownSequence, "%s", err.Error())
+				continue
+			}
+			return nil, err
+		}
+		if err := db.DropSequence(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), nil
+}
+
+func (b *BaseBuilder) buildShowCreateSequence(ctx *sql.Context, n *plan.ShowCreateSequence, row sql.Row) (sql.RowIter, error) {
+	var seq sql.SequenceDefinition
+	var exists bool
+	if db, ok := n.Db.(sql.SequenceDatabase); ok {
+		var err error
+		if seq, exists, err = db.GetSequence(ctx, n.Name); err != nil {
+			return nil, err
+		}
+	}
+	if !exists {
+		return nil, sql.ErrUnknownSequence.New(n.Db.Name() + "." + n.Name)
+	}
+
+	cycle := "nocycle"
+	if seq.Cycle {
+		cycle = "cycle"
+	}
+	stmt := fmt.Sprintf("CREATE SEQUENCE %s start with %d minvalue %d maxvalue %d increment by %d cache %d %s",
+		b.schemaFormatter.QuoteIdentifier(seq.Name), seq.Start, seq.MinValue, seq.MaxValue, seq.Increment, seq.Cache, cycle)
+	return sql.RowsToRowIter(sql.Row{seq.Name, stmt}), nil
+}
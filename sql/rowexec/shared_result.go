@@ -0,0 +1,87 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func (b *BaseBuilder) buildSharedResult(ctx *sql.Context, n *plan.SharedResult, row sql.Row) (sql.RowIter, error) {
+	if b.SharedResults == nil {
+		return b.buildNodeExec(ctx, n.Child, row)
+	}
+	if rows, ok := b.SharedResults.Get(ctx.Session.ID(), n.Key); ok {
+		return sql.RowsToRowIter(rows...), nil
+	}
+
+	// The snapshot is taken before the child starts reading, so that a write that races with it invalidates the result
+	snapshot := b.SharedResults.Snapshot(n.Tables)
+	ci, err := b.buildNodeExec(ctx, n.Child, row)
+	if err != nil {
+		return nil, err
+	}
+	return &sharedResultIter{
+		cache:     b.SharedResults,
+		key:       n.Key,
+		snapshot:  snapshot,
+		childIter: ci,
+	}, nil
+}
+
+// sharedResultIter returns the rows of its child, and stores them in a sql.SharedResultCache once the child is
+// exhausted. A result that isn't read to the end, or that outgrows the cache, is not stored.
+type sharedResultIter struct {
+	cache     *sql.SharedResultCache
+	key       string
+	snapshot  sql.SharedResultSnapshot
+	childIter sql.RowIter
+	results   []sql.Row
+	// overflow is set once the result can no longer be stored
+	overflow bool
+}
+
+var _ sql.RowIter = (*sharedResultIter)(nil)
+
+func (i *sharedResultIter) Next(ctx *sql.Context) (sql.Row, error) {
+	r, err := i.childIter.Next(ctx)
+	if err == io.EOF {
+		if !i.overflow {
+			i.cache.Put(ctx.Session.ID(), i.key, i.snapshot, i.results)
+		}
+		// the result is only stored once, even if the iterator is read past its end
+		i.overflow = true
+		i.results = nil
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !i.overflow {
+		if len(i.results) < sql.SharedResultMaxRows {
+			i.results = append(i.results, r)
+		} else {
+			i.overflow = true
+			i.results = nil
+		}
+	}
+	return r, nil
+}
+
+func (i *sharedResultIter) Close(ctx *sql.Context) error {
+	return i.childIter.Close(ctx)
+}
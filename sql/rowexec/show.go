@@ -23,31 +23,36 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func (b *BaseBuilder) buildShowCharset(ctx *sql.Context, n *plan.ShowCharset, row sql.Row) (sql.RowIter, error) {
-	//TODO: use the information_schema table instead, currently bypassing it to show currently-implemented charsets
-	//ri, err := sc.CharacterSetTable.RowIter(ctx, row)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//return &showCharsetIter{originalIter: ri}, nil
+	if n.CharacterSetTable == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	iter, err := b.buildNodeExec(ctx, n.CharacterSetTable, row)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, iter)
+	if err != nil {
+		return nil, err
+	}
 
-	var rows []sql.Row
-	iter := sql.NewCharacterSetsIterator()
-	for charset, ok := iter.Next(); ok; charset, ok = iter.Next() {
-		if charset.Encoder != nil && charset.BinaryCollation.Sorter() != nil && charset.DefaultCollation.Sorter() != nil {
-			rows = append(rows, sql.Row{
-				charset.Name,
-				charset.Description,
-				charset.DefaultCollation.String(),
-				uint64(charset.MaxLength),
-			})
+	// information_schema.character_sets orders its columns as (name, default collation, description, maxlen)
+	for i, r := range rows {
+		maxLen, _, err := types.Uint64.Convert(ctx, r[3])
+		if err != nil {
+			return nil, err
 		}
+		rows[i] = sql.Row{r[0], r[2], r[1], maxLen}
 	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(string) < rows[j][0].(string)
+	})
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -81,6 +86,10 @@ func (b *BaseBuilder) buildDescribeQuery(ctx *sql.Context, n *plan.DescribeQuery
 				rows = append(rows, sql.NewRow(l))
 			}
 		}
+		if n.Format.Estimates || n.Format.Analyze {
+			read, total := plan.PrunedColumnCounts(ctx, n.Child)
+			rows = append(rows, sql.NewRow(fmt.Sprintf("pruned columns: %d of %d", total-read, total)))
+		}
 		return sql.RowsToRowIter(rows...), nil
 	}
 
@@ -137,7 +146,9 @@ func (b *BaseBuilder) buildShowProcessList(ctx *sql.Context, n *plan.ShowProcess
 			status = append(status, printer.String())
 		}
 
-		if len(status) == 0 && proc.Command == sql.ProcessCommandQuery {
+		if proc.State != "" {
+			status = []string{proc.State}
+		} else if len(status) == 0 && proc.Command == sql.ProcessCommandQuery {
 			status = []string{"running"}
 		}
 
@@ -302,6 +313,41 @@ func (b *BaseBuilder) buildShowCreateProcedure(ctx *sql.Context, n *plan.ShowCre
 	}
 }
 
+func (b *BaseBuilder) buildShowCreateFunction(ctx *sql.Context, n *plan.ShowCreateFunction, row sql.Row) (sql.RowIter, error) {
+	characterSetClient, err := ctx.GetSessionVariable(ctx, "character_set_client")
+	if err != nil {
+		return nil, err
+	}
+	collationConnection, err := ctx.GetSessionVariable(ctx, "collation_connection")
+	if err != nil {
+		return nil, err
+	}
+	collationServer, err := ctx.GetSessionVariable(ctx, "collation_server")
+	if err != nil {
+		return nil, err
+	}
+
+	fnDb, ok := n.Database().(sql.StoredFunctionDatabase)
+	if !ok {
+		return nil, sql.ErrStoredFunctionsNotSupported.New(n.Database().Name())
+	}
+	fn, ok, err := fnDb.GetStoredFunction(ctx, n.FunctionName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrStoredFunctionDoesNotExist.New(n.FunctionName)
+	}
+	return sql.RowsToRowIter(sql.Row{
+		fn.Name,             // Function
+		fn.SqlMode,          // sql_mode
+		fn.CreateStatement,  // Create Function
+		characterSetClient,  // character_set_client
+		collationConnection, // collation_connection
+		collationServer,     // Database Collation
+	}), nil
+}
+
 func (b *BaseBuilder) buildShowCreateDatabase(ctx *sql.Context, n *plan.ShowCreateDatabase, row sql.Row) (sql.RowIter, error) {
 	var name = n.Database().Name()
 
@@ -426,9 +472,10 @@ func (b *BaseBuilder) buildShowCreateTrigger(ctx *sql.Context, n *plan.ShowCreat
 			if err != nil {
 				return nil, err
 			}
+			sqlMode := sql.NewSqlModeFromString(trigger.SqlMode).String()
 			return sql.RowsToRowIter(sql.Row{
 				trigger.Name,            // Trigger
-				"",                      // sql_mode
+				sqlMode,                 // sql_mode
 				trigger.CreateStatement, // SQL Original Statement
 				characterSetClient,      // character_set_client
 				collationConnection,     // collation_connection
@@ -444,6 +491,17 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 	span, _ := ctx.Span("plan.ShowColumns")
 
 	schema := n.TargetSchema()
+
+	var keys map[string]string
+	switch table := n.Child.(type) {
+	case *plan.ResolvedTable:
+		keys = showColumnKeys(ctx, n, table)
+	case *plan.SubqueryAlias:
+		// no key info for views
+	default:
+		panic(fmt.Sprintf("unexpected type %T", n.Child))
+	}
+
 	var rows = make([]sql.Row, 0, len(schema))
 	for _, col := range schema {
 		if col.HiddenSystem && !n.Extended {
@@ -452,8 +510,8 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 
 		var row sql.Row
 		var collation interface{}
-		if types.IsTextOnly(col.Type) {
-			collation = sql.Collation_Default.String()
+		if twc, ok := col.Type.(sql.TypeWithCollation); ok && (types.IsTextOnly(col.Type) || types.IsEnum(col.Type) || types.IsSet(col.Type)) {
+			collation = twc.Collation().String()
 		}
 
 		var null = "NO"
@@ -461,44 +519,21 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 			null = "YES"
 		}
 
-		key := ""
-		switch table := n.Child.(type) {
-		case *plan.ResolvedTable:
-			if col.PrimaryKey {
-				key = "PRI"
-			} else if isPriCol(ctx, n, col, table) {
-				key = "PRI"
-			} else if isUnqCol(ctx, n, col, table) {
-				key = "UNI"
-			} else if isMulCol(ctx, n, col, table) {
-				key = "MUL"
-			}
-		case *plan.SubqueryAlias:
-			// no key info for views
-		default:
-			panic(fmt.Sprintf("unexpected type %T", n.Child))
-		}
+		key := keys[strings.ToLower(col.Name)]
 
+		// MySQL formats defaults the same way for SHOW COLUMNS as for information_schema.columns: string
+		// literals are unquoted and expressions lose their enclosing parentheses. Generated columns have no default.
 		var defaultVal interface{}
-		if col.Default != nil {
-			defaultVal = col.Default.String()
-		} else {
-			// From: https://dev.mysql.com/doc/refman/8.0/en/show-columns.html
-			// The default value for the column. This is NULL if the column has an explicit default of NULL,
-			// or if the column definition includes no DEFAULT clause.
-			defaultVal = nil
+		if col.Generated == nil {
+			defaultVal = information_schema.GetColumnDefault(ctx, col.Default)
 		}
 
-		extra := col.Extra
-		// If extra is not defined, fill it here.
-		if extra == "" && !col.Default.IsLiteral() {
-			extra = "DEFAULT_GENERATED"
-		}
+		extra := showColumnExtra(ctx, col)
 
 		if n.Full {
 			row = sql.Row{
 				col.Name,
-				col.Type.String(),
+				showColumnType(col.Type),
 				collation,
 				null,
 				key,
@@ -510,7 +545,7 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 		} else {
 			row = sql.Row{
 				col.Name,
-				col.Type.String(),
+				showColumnType(col.Type),
 				null,
 				key,
 				defaultVal,
@@ -524,6 +559,39 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 	return sql.NewSpanIter(span, sql.RowsToRowIter(rows...)), nil
 }
 
+// showColumnType returns the Type column of SHOW COLUMNS, which omits the character set and collation of the column.
+func showColumnType(typ sql.Type) string {
+	if twc, ok := typ.(sql.TypeWithCollation); ok {
+		return twc.StringWithTableCollation(twc.Collation())
+	}
+	return typ.String()
+}
+
+// showColumnExtra returns the Extra column of SHOW COLUMNS for |col|.
+func showColumnExtra(ctx *sql.Context, col *sql.Column) string {
+	if col.Generated != nil {
+		if col.Virtual {
+			return "VIRTUAL GENERATED"
+		}
+		return "STORED GENERATED"
+	}
+
+	extra := col.Extra
+	// If extra is not defined, fill it here.
+	if extra == "" && !col.Default.IsLiteral() {
+		extra = "DEFAULT_GENERATED"
+	}
+	if col.OnUpdate != nil {
+		onUpdate := fmt.Sprintf("on update %v", information_schema.GetColumnDefault(ctx, col.OnUpdate))
+		if extra == "" {
+			extra = onUpdate
+		} else if !strings.Contains(extra, "on update") {
+			extra += " " + onUpdate
+		}
+	}
+	return extra
+}
+
 func (b *BaseBuilder) buildShowVariables(ctx *sql.Context, n *plan.ShowVariables, row sql.Row) (sql.RowIter, error) {
 	var rows []sql.Row
 	var sysVars map[string]interface{}
@@ -535,8 +603,29 @@ func (b *BaseBuilder) buildShowVariables(ctx *sql.Context, n *plan.ShowVariables
 	}
 
 	for k, v := range sysVars {
+		if sysVar, globalVal, ok := sql.SystemVariables.GetGlobal(k); ok {
+			if msv, ok := sysVar.(*sql.MysqlSystemVariable); ok && n.Global && msv.Scope.IsSessionOnly() {
+				// SHOW GLOBAL VARIABLES omits variables that only exist in the session
+				continue
+			}
+			if n.Global || sysVar.IsGlobalOnly() {
+				// global-only variables have no session value, so the session displays the current global value
+				v = globalVal
+			}
+		}
+
+		// SHOW VARIABLES displays boolean values as "ON" or "OFF".
+		if boolVal, isBoolVal := v.(int8); isBoolVal {
+			switch boolVal {
+			case 0:
+				v = "OFF"
+			case 1:
+				v = "ON"
+			}
+		}
+
 		if n.Filter != nil {
-			res, err := n.Filter.Eval(ctx, sql.Row{strings.ToLower(k)})
+			res, err := n.Filter.Eval(ctx, sql.Row{strings.ToLower(k), v})
 			if err != nil {
 				return nil, err
 			}
@@ -545,24 +634,12 @@ func (b *BaseBuilder) buildShowVariables(ctx *sql.Context, n *plan.ShowVariables
 				ctx.Warn(1292, "%s", err.Error())
 				continue
 			}
-			if res.(int8) == 0 {
+			if res == nil || res.(int8) == 0 {
 				continue
 			}
 		}
 
-		// SHOW VARIABLES displays boolean values as "ON" or "OFF".
-		if boolVal, isBoolVal := v.(int8); isBoolVal {
-			switch boolVal {
-			case 0:
-				rows = append(rows, sql.NewRow(k, "OFF"))
-			case 1:
-				rows = append(rows, sql.NewRow(k, "ON"))
-			default:
-				rows = append(rows, sql.NewRow(k, v))
-			}
-		} else {
-			rows = append(rows, sql.NewRow(k, v))
-		}
+		rows = append(rows, sql.NewRow(k, v))
 	}
 
 	sort.Slice(rows, func(i, j int) bool {
@@ -577,7 +654,8 @@ func (b *BaseBuilder) buildShowTriggers(ctx *sql.Context, n *plan.ShowTriggers,
 	for _, trigger := range n.Triggers {
 		triggerEvent := strings.ToUpper(trigger.TriggerEvent)
 		triggerTime := strings.ToUpper(trigger.TriggerTime)
-		tableName := trigger.Table.(sql.Nameable).Name()
+		tableName := getTableName(trigger.Table)
+		definer := strings.ReplaceAll(trigger.Definer, "`", "")
 		characterSetClient, err := ctx.GetSessionVariable(ctx, "character_set_client")
 		if err != nil {
 			return nil, err
@@ -597,8 +675,8 @@ func (b *BaseBuilder) buildShowTriggers(ctx *sql.Context, n *plan.ShowTriggers,
 			trigger.BodyString,  // Statement
 			triggerTime,         // Timing
 			trigger.CreatedAt,   // Created
-			"",                  // sql_mode
-			"",                  // Definer
+			trigger.SqlMode,     // sql_mode
+			definer,             // Definer
 			characterSetClient,  // character_set_client
 			collationConnection, // collation_connection
 			collationServer,     // Database Collation
@@ -649,13 +727,33 @@ func (b *BaseBuilder) buildShowGrants(ctx *sql.Context, n *plan.ShowGrants, row
 		return nil, sql.ErrShowGrantsUserDoesNotExist.New(n.For.Name, n.For.Host)
 	}
 
-	//TODO: implement USING, perhaps by creating a new context with the chosen roles set as the active roles
+	// The privileges of any roles named by USING are shown as though they belonged to the user
+	privSet := user.PrivilegeSet
+	if len(n.Using) > 0 {
+		granted := mysqlDb.GrantedRoles(ctx, reader, user)
+		roles := make([]sql.RoleName, len(n.Using))
+	ROLES:
+		for i, role := range n.Using {
+			roles[i] = roleNameOf(role)
+			for _, grantedRole := range granted {
+				if grantedRole == roles[i] {
+					continue ROLES
+				}
+			}
+			return nil, sql.ErrRoleNotGranted.New(roles[i].String("`"), mysql_db.RoleNameOf(user).String("`"))
+		}
+		privSet = privSet.Copy()
+		for _, role := range mysqlDb.RoleClosure(reader, roles) {
+			privSet.UnionWith(role.PrivilegeSet)
+		}
+	}
+
 	var rows []sql.Row
 	userStr := user.UserHostToString("`")
-	privStr := generatePrivStrings("*", "*", userStr, user.PrivilegeSet.ToSlice())
+	privStr := generatePrivStrings("*", "*", userStr, privSet.ToSlice())
 	rows = append(rows, sql.Row{privStr})
 
-	for _, db := range user.PrivilegeSet.GetDatabases() {
+	for _, db := range privSet.GetDatabases() {
 		dbStr := fmt.Sprintf("`%s`", db.Name())
 		if privStr = generatePrivStrings(dbStr, "*", userStr, db.ToSlice()); len(privStr) != 0 {
 			rows = append(rows, sql.Row{privStr})
@@ -676,6 +774,26 @@ func (b *BaseBuilder) buildShowGrants(ctx *sql.Context, n *plan.ShowGrants, row
 		// TODO: display column privileges
 	}
 
+	for _, restriction := range privSet.GetRestrictions() {
+		dbStr := fmt.Sprintf("`%s`", restriction.Name())
+		rows = append(rows, sql.Row{generateRevokeStrings(dbStr, userStr, restriction.ToSlice())})
+	}
+
+	proxyPrivs := reader.GetProxyPrivs(mysql_db.ProxiesPrivUserKey{
+		Host: user.Host,
+		User: user.User,
+	})
+	sort.Slice(proxyPrivs, func(i, j int) bool {
+		return proxyPrivs[i].ProxiedUserString("`") < proxyPrivs[j].ProxiedUserString("`")
+	})
+	for _, proxyPriv := range proxyPrivs {
+		privStr = fmt.Sprintf("GRANT PROXY ON %s TO %s", proxyPriv.ProxiedUserString("`"), userStr)
+		if proxyPriv.WithGrant {
+			privStr += " WITH GRANT OPTION"
+		}
+		rows = append(rows, sql.Row{privStr})
+	}
+
 	sb := strings.Builder{}
 
 	roleEdges := reader.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{
@@ -693,7 +811,7 @@ func (b *BaseBuilder) buildShowGrants(ctx *sql.Context, n *plan.ShowGrants, row
 	}
 
 	sb.Reset()
-	for i, dynamicPrivWithWgo := range user.PrivilegeSet.ToSliceDynamic(true) {
+	for i, dynamicPrivWithWgo := range privSet.ToSliceDynamic(true) {
 		if i > 0 {
 			sb.WriteString(", ")
 		}
@@ -703,7 +821,7 @@ func (b *BaseBuilder) buildShowGrants(ctx *sql.Context, n *plan.ShowGrants, row
 		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s ON *.* TO %s WITH GRANT OPTION", sb.String(), user.UserHostToString("`"))})
 	}
 	sb.Reset()
-	for i, dynamicPrivWithoutWgo := range user.PrivilegeSet.ToSliceDynamic(false) {
+	for i, dynamicPrivWithoutWgo := range privSet.ToSliceDynamic(false) {
 		if i > 0 {
 			sb.WriteString(", ")
 		}
@@ -721,9 +839,20 @@ func (b *BaseBuilder) buildShowIndexes(ctx *sql.Context, n *plan.ShowIndexes, ro
 		panic(fmt.Sprintf("unexpected type %T", n.Child))
 	}
 
+	// statistics are only used for estimates, so a table without them is still shown
+	var stats []sql.Statistic
+	if n.Stats != nil && table.SqlDatabase != nil {
+		var schemaName string
+		if schTab, ok := table.UnderlyingTable().(sql.DatabaseSchemaTable); ok {
+			schemaName = schTab.DatabaseSchema().SchemaName()
+		}
+		stats, _ = n.Stats.GetTableStats(ctx, schemaName, table.SqlDatabase.Name(), table.UnderlyingTable())
+	}
+
 	return &showIndexesIter{
 		table: table,
 		idxs:  newIndexesToShow(n.IndexesToShow),
+		stats: stats,
 	}, nil
 }
 
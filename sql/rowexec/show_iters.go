@@ -17,10 +17,12 @@ package rowexec
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/vector"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -98,6 +100,19 @@ func tableToStatusRow(ctx *sql.Context, table sql.Table) (sql.Row, error) {
 		comment = ct.Comment()
 	}
 
+	rowFormat := "Fixed"
+	var checksum, createOptions interface{}
+	if ct := getCompressedTable(table); ct != nil && ct.Compression() != "" {
+		stats, err := ct.CompressionStats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rowFormat = "Compressed"
+		checksum = strconv.FormatUint(stats.Checksum, 10)
+		createOptions = fmt.Sprintf("COMPRESSION=\"%s\" COMPRESSED_PARTITIONS=%d/%d UNCOMPRESSED_LENGTH=%d COMPRESSED_LENGTH=%d",
+			ct.Compression(), stats.CompressedPartitions, stats.Partitions, stats.UncompressedLength, stats.CompressedLength)
+	}
+
 	return sql.NewRow(
 		table.Name(), // Name
 		"InnoDB",     // Engine
@@ -105,7 +120,7 @@ func tableToStatusRow(ctx *sql.Context, table sql.Table) (sql.Row, error) {
 		// column now reports a hardcoded value of 10, which is the last .frm file
 		// version used in MySQL 5.7.
 		"10",                       // Version
-		"Fixed",                    // Row_format
+		rowFormat,                  // Row_format
 		numRows,                    // Rows
 		uint64(avgLength),          // Avg_row_length
 		dataLength,                 // Data_length
@@ -117,41 +132,44 @@ func tableToStatusRow(ctx *sql.Context, table sql.Table) (sql.Row, error) {
 		nil,                        // Update_time
 		nil,                        // Check_time
 		table.Collation().String(), // Collation
-		nil,                        // Checksum
-		nil,                        // Create_options
+		checksum,                   // Checksum
+		createOptions,              // Create_options
 		comment,                    // Comment
 	), nil
 }
 
 // generatePrivStrings creates a formatted GRANT <privilege_list> on <global/database/table> to <user@host> string
 func generatePrivStrings(db, tbl, user string, privs []sql.PrivilegeType) string {
-	sb := strings.Builder{}
+	privStrs := make([]string, 0, len(privs))
 	withGrantOption := ""
-	for i, priv := range privs {
-		privStr := priv.String()
-		if privStr == sql.PrivilegeType_GrantOption.String() {
-			if len(privs) > 1 {
-				withGrantOption = " WITH GRANT OPTION"
-			}
-		} else {
-			if i > 0 {
-				sb.WriteString(", ")
-			}
-			sb.WriteString(privStr)
+	for _, priv := range privs {
+		if priv == sql.PrivilegeType_GrantOption {
+			withGrantOption = " WITH GRANT OPTION"
+			continue
 		}
+		privStrs = append(privStrs, priv.String())
 	}
 	// handle special case for empty global and database privileges
-	privStr := sb.String()
+	privStr := strings.Join(privStrs, ", ")
 	if len(privStr) == 0 {
-		if db == "*" {
-			privStr = "USAGE"
-		} else {
+		if db != "*" && len(withGrantOption) == 0 {
 			return ""
 		}
+		privStr = "USAGE"
 	}
 	return fmt.Sprintf("GRANT %s ON %s.%s TO %s%s", privStr, db, tbl, user, withGrantOption)
 }
 
+// generateRevokeStrings creates a formatted REVOKE <privilege_list> on <database> from <user@host> string, which
+// represents a partial revoke of global privileges.
+func generateRevokeStrings(db, user string, privs []sql.PrivilegeType) string {
+	privStrs := make([]string, len(privs))
+	for i, priv := range privs {
+		privStrs[i] = priv.String()
+	}
+	return fmt.Sprintf("REVOKE %s ON %s.* FROM %s", strings.Join(privStrs, ", "), db, user)
+}
+
 // generateRoutinePrivStrings creates a formatted GRANT <PRILEDGE_LIST> on <ROUTINE_TYPE> <ROUTINE> to <user@host> string
 func generateRoutinePrivStrings(db, routine, routine_type, user string, privs []sql.PrivilegeType) string {
 	privStrs := make([]string, 0, len(privs))
@@ -225,6 +243,8 @@ func (i *indexesToShow) next() (*idxToShow, error) {
 type showIndexesIter struct {
 	table *plan.ResolvedTable
 	idxs  *indexesToShow
+	// stats are the statistics of the table, which the cardinality of its indexes is estimated from
+	stats []sql.Statistic
 }
 
 func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -261,81 +281,128 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 		nonUnique = 1
 	}
 
+	// only indexes that keep their keys in order have a collation
+	var collation interface{} = "A"
+	if show.index.IsFullText() || show.index.IsSpatial() || show.index.IsVector() {
+		collation = nil
+	}
+
+	var subPart interface{}
+	if prefixLengths := show.index.PrefixLengths(); show.exPosition < len(prefixLengths) && prefixLengths[show.exPosition] > 0 {
+		subPart = int64(prefixLengths[show.exPosition])
+	}
+
+	cardinality := sql.IndexCardinalities(show.index, i.stats)[show.exPosition]
+
 	return sql.NewRow(
 		show.index.Table(),     // "Table" string
 		nonUnique,              // "Non_unique" int32, Values [0, 1]
 		show.index.ID(),        // "Key_name" string
 		show.exPosition+1,      // "Seq_in_index" int32
 		columnName,             // "Column_name" string
-		nil,                    // "Collation" string, Values [A, D, NULL]
-		int64(0),               // "Cardinality" int64 (not calculated)
-		nil,                    // "Sub_part" int64
+		collation,              // "Collation" string, Values [A, D, NULL]
+		cardinality,            // "Cardinality" int64
+		subPart,                // "Sub_part" int64
 		nil,                    // "Packed" string
 		nullable,               // "Null" string, Values [YES, '']
 		show.index.IndexType(), // "Index_type" string
-		show.index.Comment(),   // "Comment" string
-		"",                     // "Index_comment" string
+		"",                     // "Comment" string
+		show.index.Comment(),   // "Index_comment" string
 		visible,                // "Visible" string, Values [YES, NO]
 		expression,             // "Expression" string
 	), nil
 }
 
-// isPriCol checks if this column is the first column in a unique index
-func isPriCol(ctx *sql.Context, s *plan.ShowColumns, col *sql.Column, table sql.Table) bool {
-	for _, idx := range s.Indexes {
-		if !idx.IsUnique() {
-			continue
+// showColumnKeys returns the value of the Key column of SHOW COLUMNS for each column of |table|, keyed by lower-cased
+// column name. MySQL reports PRI for every column of the primary key, UNI for the column of a single column UNIQUE
+// index, and MUL for the first column of any other index, preferring them in that order. If the table has no primary
+// key, the first UNIQUE index whose columns are all NOT NULL is reported as the primary key instead.
+func showColumnKeys(ctx *sql.Context, s *plan.ShowColumns, table sql.Table) map[string]string {
+	keys := make(map[string]string)
+	setKey := func(col *sql.Column, key string) {
+		name := strings.ToLower(col.Name)
+		if showColumnKeyRank(key) > showColumnKeyRank(keys[name]) {
+			keys[name] = key
 		}
-		// TODO: also have to determine that there are no primary keys defined later
-		//   this currently works because the primary key shows up as unique, so the condition below satisfies it
-		//   but I am not confident that this is always true
-		idxExprs := idx.Expressions()
-		firstIndexCol := plan.GetColumnFromIndexExpr(ctx, idxExprs[0], table)
-		if firstIndexCol == nil || firstIndexCol.Name != col.Name {
-			return false
-		}
-		for _, expr := range idxExprs {
-			idxCol := plan.GetColumnFromIndexExpr(ctx, expr, table)
-			if idxCol == nil || idxCol.Nullable {
-				return false
-			}
+	}
+
+	hasPk := false
+	for _, col := range s.TargetSchema() {
+		if col.PrimaryKey {
+			setKey(col, "PRI")
+			hasPk = true
 		}
-		return true
 	}
-	return false
-}
 
-// isUnqCol checks if this the values in this column must be unique
-func isUnqCol(ctx *sql.Context, s *plan.ShowColumns, col *sql.Column, table sql.Table) bool {
 	for _, idx := range s.Indexes {
-		// Column is in a unique index by itself
-		idxExprs := idx.Expressions()
-		if !idx.IsUnique() || len(idxExprs) > 1 {
+		var cols []*sql.Column
+		for _, expr := range idx.Expressions() {
+			cols = append(cols, plan.GetColumnFromIndexExpr(ctx, expr, table))
+		}
+		if len(cols) == 0 {
 			continue
 		}
-		firstIndexCol := plan.GetColumnFromIndexExpr(ctx, idxExprs[0], table)
-		if firstIndexCol != nil && firstIndexCol.Name == col.Name {
-			return true
+
+		if idx.ID() == "PRIMARY" {
+			hasPk = true
+			for _, col := range cols {
+				if col != nil {
+					setKey(col, "PRI")
+				}
+			}
+			continue
 		}
-	}
-	return false
-}
 
-// isMulCol checks if values in this column can be non-unique and that it's the first column in an index
-func isMulCol(ctx *sql.Context, s *plan.ShowColumns, col *sql.Column, table sql.Table) bool {
-	for _, idx := range s.Indexes {
-		idxExprs := idx.Expressions()
-		firstIdxCol := plan.GetColumnFromIndexExpr(ctx, idxExprs[0], table)
-		// Not first column in index, ignore
-		if firstIdxCol == nil || firstIdxCol.Name != col.Name {
+		if cols[0] == nil {
+			// functional key parts are not attributed to any column
 			continue
 		}
-		// Is part of non-unique key or not the only column in the key
-		if !idx.IsUnique() || len(idxExprs) > 1 {
-			return true
+		if idx.IsUnique() && len(cols) == 1 {
+			setKey(cols[0], "UNI")
+		} else {
+			setKey(cols[0], "MUL")
 		}
 	}
-	return false
+
+	if !hasPk {
+		for _, idx := range s.Indexes {
+			if !idx.IsUnique() {
+				continue
+			}
+			var cols []*sql.Column
+			for _, expr := range idx.Expressions() {
+				col := plan.GetColumnFromIndexExpr(ctx, expr, table)
+				if col == nil || col.Nullable {
+					cols = nil
+					break
+				}
+				cols = append(cols, col)
+			}
+			if len(cols) == 0 {
+				continue
+			}
+			for _, col := range cols {
+				setKey(col, "PRI")
+			}
+			break
+		}
+	}
+
+	return keys
+}
+
+// showColumnKeyRank returns the precedence of a SHOW COLUMNS key indicator, higher values taking priority.
+func showColumnKeyRank(key string) int {
+	switch key {
+	case "PRI":
+		return 3
+	case "UNI":
+		return 2
+	case "MUL":
+		return 1
+	default:
+		return 0
+	}
 }
 
 func (i *showIndexesIter) Close(*sql.Context) error {
@@ -514,6 +581,11 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 
 		indexDefn, shouldInclude := i.formatter.GenerateCreateTableIndexDefinition(index.IsUnique(), index.IsSpatial(),
 			index.IsFullText(), index.IsVector(), index.ID(), indexCols, index.Comment())
+		if vectorIndex, ok := index.(vector.Index); ok && index.IsVector() {
+			if attribute := vector.EngineAttributeForDistanceType(vectorIndex.VectorDistanceType()); attribute != "" {
+				indexDefn += fmt.Sprintf(" ENGINE_ATTRIBUTE '%s'", attribute)
+			}
+		}
 		if shouldInclude {
 			colStmts = append(colStmts, indexDefn)
 		}
@@ -574,9 +646,88 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		}
 	}
 
+	if compressedTable := getCompressedTable(table); compressedTable != nil {
+		if compression := compressedTable.Compression(); compression != "" {
+			createStmt += fmt.Sprintf(" COMPRESSION='%s'", compression)
+		}
+	}
+
+	if partitionedTable := getPartitionedTable(table); partitionedTable != nil {
+		if scheme := partitionedTable.PartitionScheme(ctx); scheme.IsPartitioned() {
+			clause, err := partitionSchemeClause(ctx, i.formatter, scheme, table.Schema(ctx))
+			if err != nil {
+				return "", err
+			}
+			createStmt += "\n" + clause
+		}
+	}
+
 	return createStmt, nil
 }
 
+// partitionSchemeClause returns the PARTITION BY clause that defines |scheme| for a table with the schema |sch|, in an
+// executable comment as MySQL shows it.
+func partitionSchemeClause(ctx *sql.Context, formatter sql.SchemaFormatter, scheme sql.PartitionScheme, sch sql.Schema) (string, error) {
+	method := scheme.Method.String()
+	if scheme.Linear {
+		method = "LINEAR " + method
+	}
+	var sb strings.Builder
+	if scheme.Columns {
+		fmt.Fprintf(&sb, "/*!50500 PARTITION BY %s  COLUMNS(%s)", method, scheme.Column)
+	} else {
+		fmt.Fprintf(&sb, "/*!50100 PARTITION BY %s (%s)", method, formatter.QuoteIdentifier(scheme.Column))
+	}
+
+	if scheme.Method == sql.PartitionMethodHash || scheme.Method == sql.PartitionMethodKey {
+		defaultNames := true
+		for i, def := range scheme.Partitions {
+			defaultNames = defaultNames && def.Name == fmt.Sprintf("p%d", i)
+		}
+		if defaultNames {
+			fmt.Fprintf(&sb, "\nPARTITIONS %d */", len(scheme.Partitions))
+			return sb.String(), nil
+		}
+	}
+
+	var typ sql.Type
+	if idx := sch.IndexOfColName(scheme.Column); idx >= 0 {
+		typ = sch[idx].Type
+	}
+	for i, def := range scheme.Partitions {
+		if i == 0 {
+			sb.WriteString("\n(")
+		} else {
+			sb.WriteString(",\n ")
+		}
+		fmt.Fprintf(&sb, "PARTITION %s", def.Name)
+		switch scheme.Method {
+		case sql.PartitionMethodRange:
+			if def.LessThan == nil {
+				sb.WriteString(" VALUES LESS THAN MAXVALUE")
+			} else {
+				bound, err := scheme.ValueString(ctx, typ, def.LessThan)
+				if err != nil {
+					return "", err
+				}
+				fmt.Fprintf(&sb, " VALUES LESS THAN (%s)", bound)
+			}
+		case sql.PartitionMethodList:
+			values := make([]string, len(def.Values))
+			for j, v := range def.Values {
+				var err error
+				if values[j], err = scheme.ValueString(ctx, typ, v); err != nil {
+					return "", err
+				}
+			}
+			fmt.Fprintf(&sb, " VALUES IN (%s)", strings.Join(values, ","))
+		}
+		sb.WriteString(" ENGINE = InnoDB")
+	}
+	sb.WriteString(") */")
+	return sb.String(), nil
+}
+
 func produceCreateViewStatement(view *plan.SubqueryAlias) string {
 	return fmt.Sprintf(
 		"CREATE VIEW `%s` AS %s",
@@ -628,6 +779,32 @@ func getTargetRowSizeTable(t sql.Table) sql.TargetRowSizeTable {
 	}
 }
 
+func getCompressedTable(t sql.Table) sql.CompressedTable {
+	switch t := t.(type) {
+	case sql.CompressedTable:
+		return t
+	case sql.TableWrapper:
+		return getCompressedTable(t.Underlying())
+	case *plan.ResolvedTable:
+		return getCompressedTable(t.Table)
+	default:
+		return nil
+	}
+}
+
+func getPartitionedTable(t sql.Table) sql.PartitionedTable {
+	switch t := t.(type) {
+	case sql.PartitionedTable:
+		return t
+	case sql.TableWrapper:
+		return getPartitionedTable(t.Underlying())
+	case *plan.ResolvedTable:
+		return getPartitionedTable(t.Table)
+	default:
+		return nil
+	}
+}
+
 func getTempTable(t sql.Table) sql.TemporaryTable {
 	switch t := t.(type) {
 	case sql.TemporaryTable:
@@ -17,6 +17,7 @@ package rowexec
 import (
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/memory"
@@ -181,3 +182,50 @@ func TestShowColumnsFull(t *testing.T) {
 
 	require.Equal(expected, rows)
 }
+
+func TestShowColumnsFormatting(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	db := memory.NewDatabase("mydb")
+	schema := sql.Schema{
+		{Name: "a", Source: "foo", Type: types.Int64},
+		{Name: "b", Source: "foo", Type: types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb4_general_ci), Nullable: true, Default: planbuilder.MustStringToColumnDefaultValue(ctx, "'abc'", types.Text, true)},
+		{Name: "c", Source: "foo", Type: types.Int64, Nullable: true, Generated: planbuilder.MustStringToColumnDefaultValue(ctx, "1", types.Int64, true), Virtual: true},
+		{Name: "d", Source: "foo", Type: types.Int64, Nullable: true, Generated: planbuilder.MustStringToColumnDefaultValue(ctx, "1", types.Int64, true)},
+	}
+	memTable := memory.NewTable(ctx, db.BaseDatabase, "foo", sql.NewPrimaryKeySchema(schema), nil)
+	table := NewResolvedTable(memTable, nil, nil)
+
+	showColumns, err := NewShowColumns(true, table).WithTargetSchema(schema)
+	require.NoError(err)
+
+	// A unique index over NOT NULL columns is reported as the primary key when the table has none
+	showColumns.(*ShowColumns).Indexes = []sql.Index{
+		&memory.Index{
+			DB:        "mydb",
+			TableName: "foo",
+			Tbl:       memTable,
+			Name:      "a",
+			Exprs: []sql.Expression{
+				expression.NewGetFieldWithTable(0, 1, types.Int64, "", "foo", "a", false),
+			},
+			Unique: true,
+		},
+	}
+
+	iter, err := DefaultBuilder.Build(ctx, showColumns, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	expected := []sql.Row{
+		{"a", "bigint", nil, "NO", "PRI", nil, "", "", ""},
+		{"b", "varchar(10)", "utf8mb4_general_ci", "YES", "", "abc", "", "", ""},
+		{"c", "bigint", nil, "YES", "", nil, "VIRTUAL GENERATED", "", ""},
+		{"d", "bigint", nil, "YES", "", nil, "STORED GENERATED", "", ""},
+	}
+
+	require.Equal(expected, rows)
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// StatementTimeoutState is the state shown in the process list for a statement that has exceeded its
+// max_execution_time.
+const StatementTimeoutState = "statement timeout"
+
+// errStatementTimeout is the cause of the cancellation of a statement's context once its timeout elapses.
+var errStatementTimeout = errors.New(StatementTimeoutState)
+
+// WithStatementTimeout returns a copy of |ctx| that is canceled once |timeout| elapses, along with a function that
+// releases its timer. When the timeout elapses, the query of |ctx| is marked as timed out in the process list.
+func WithStatementTimeout(ctx *sql.Context, timeout time.Duration) (*sql.Context, context.CancelFunc) {
+	newCtx, cancel := context.WithTimeoutCause(ctx.Context, timeout, errStatementTimeout)
+	timeoutCtx := ctx.WithContext(newCtx)
+	stop := context.AfterFunc(newCtx, func() {
+		if context.Cause(newCtx) == errStatementTimeout {
+			ctx.ProcessList.UpdateQueryState(ctx.Pid(), StatementTimeoutState)
+		}
+	})
+	return timeoutCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// StatementTimeoutIter executes its child with a context returned by WithStatementTimeout, and returns
+// sql.ErrQueryTimeout in place of the error the child returns once the timeout has elapsed.
+type StatementTimeoutIter struct {
+	iter   sql.RowIter
+	ctx    *sql.Context
+	cancel context.CancelFunc
+}
+
+var _ sql.RowIter = (*StatementTimeoutIter)(nil)
+var _ sql.ValueRowIter = (*StatementTimeoutIter)(nil)
+
+// AddStatementTimeout returns a new iterator that executes |iter| with |ctx|, which must have been returned by
+// WithStatementTimeout along with |cancel|. If |cancel| is nil, then the original iterator is returned.
+func AddStatementTimeout(ctx *sql.Context, iter sql.RowIter, cancel context.CancelFunc) sql.RowIter {
+	if cancel == nil {
+		return iter
+	}
+	return &StatementTimeoutIter{
+		iter:   iter,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (i *StatementTimeoutIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(i.ctx)
+	if err != nil && err != io.EOF && context.Cause(i.ctx) == errStatementTimeout {
+		return nil, sql.ErrQueryTimeout.New()
+	}
+	return row, err
+}
+
+// NextValueRow implements the interface sql.ValueRowIter.
+func (i *StatementTimeoutIter) NextValueRow(ctx *sql.Context) (sql.ValueRow, error) {
+	row, err := i.iter.(sql.ValueRowIter).NextValueRow(i.ctx)
+	if err != nil && err != io.EOF && context.Cause(i.ctx) == errStatementTimeout {
+		return nil, sql.ErrQueryTimeout.New()
+	}
+	return row, err
+}
+
+// IsValueRowIter implements the interface sql.ValueRowIter.
+func (i *StatementTimeoutIter) IsValueRowIter(ctx *sql.Context) bool {
+	iter, ok := i.iter.(sql.ValueRowIter)
+	return ok && iter.IsValueRowIter(i.ctx)
+}
+
+// Close implements the interface sql.RowIter.
+func (i *StatementTimeoutIter) Close(ctx *sql.Context) error {
+	defer i.cancel()
+	return i.iter.Close(ctx)
+}
+
+func (i *StatementTimeoutIter) GetIter() sql.RowIter {
+	return i.iter
+}
+
+func (i *StatementTimeoutIter) WithChildIter(childIter sql.RowIter) sql.RowIter {
+	ni := *i
+	ni.iter = childIter
+	return &ni
+}
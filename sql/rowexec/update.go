@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/hash"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 type updateIter struct {
@@ -70,7 +71,7 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 				return nil, u.ignoreOrError(ctx, newRow, err)
 			}
 
-			err = u.updater.Update(ctx, oldRow, newRow)
+			err = u.update(ctx, oldRow, newRow)
 			if err != nil {
 				return nil, u.ignoreOrError(ctx, newRow, err)
 			}
@@ -94,6 +95,32 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return oldAndNewRow, nil
 }
 
+// update writes |newRow| over |oldRow|. The edits that JSON_SET, JSON_REPLACE and JSON_REMOVE made to the JSON
+// documents of |oldRow| are passed along to updaters that can apply them without writing the whole documents.
+func (u *updateIter) update(ctx *sql.Context, oldRow, newRow sql.Row) error {
+	jsonUpdater, ok := u.updater.(sql.JSONUpdater)
+	if !ok {
+		return u.updater.Update(ctx, oldRow, newRow)
+	}
+	var diffs map[int][]sql.JSONDiff
+	for i, val := range newRow {
+		partialUpdate, ok := val.(*types.JSONPartialUpdate)
+		if !ok {
+			continue
+		}
+		if colDiffs, ok := partialUpdate.DiffsFrom(oldRow[i]); ok {
+			if diffs == nil {
+				diffs = make(map[int][]sql.JSONDiff)
+			}
+			diffs[i] = colDiffs
+		}
+	}
+	if diffs == nil {
+		return u.updater.Update(ctx, oldRow, newRow)
+	}
+	return jsonUpdater.UpdateJSON(ctx, oldRow, newRow, diffs)
+}
+
 // Applies the update expressions given to the row given, returning the new resultant row. In the case that ignore is
 // provided and there is a type conversion error, this function sets the value to the zero value as per the MySQL standard.
 // TODO: This can probably be combined with insertIter.handleOnDuplicateKeyUpdate or insertIter.applyUpdates
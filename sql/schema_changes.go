@@ -0,0 +1,179 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SchemaObjectType is the type of catalog object a SchemaChange applies to.
+type SchemaObjectType byte
+
+const (
+	SchemaObjectDatabase SchemaObjectType = iota
+	SchemaObjectTable
+	SchemaObjectView
+	SchemaObjectTrigger
+	SchemaObjectProcedure
+	SchemaObjectEvent
+	SchemaObjectSequence
+	SchemaObjectFunction
+)
+
+func (t SchemaObjectType) String() string {
+	switch t {
+	case SchemaObjectDatabase:
+		return "database"
+	case SchemaObjectTable:
+		return "table"
+	case SchemaObjectView:
+		return "view"
+	case SchemaObjectTrigger:
+		return "trigger"
+	case SchemaObjectProcedure:
+		return "procedure"
+	case SchemaObjectEvent:
+		return "event"
+	case SchemaObjectSequence:
+		return "sequence"
+	case SchemaObjectFunction:
+		return "function"
+	default:
+		return "unknown"
+	}
+}
+
+// SchemaChangeAction is the kind of change a SchemaChange describes.
+type SchemaChangeAction byte
+
+const (
+	SchemaChangeCreated SchemaChangeAction = iota
+	SchemaChangeAltered
+	SchemaChangeDropped
+	SchemaChangeRenamed
+)
+
+func (a SchemaChangeAction) String() string {
+	switch a {
+	case SchemaChangeCreated:
+		return "created"
+	case SchemaChangeAltered:
+		return "altered"
+	case SchemaChangeDropped:
+		return "dropped"
+	case SchemaChangeRenamed:
+		return "renamed"
+	default:
+		return "unknown"
+	}
+}
+
+// SchemaChange describes a change made to a catalog object by a DDL statement.
+type SchemaChange struct {
+	Action SchemaChangeAction
+	Object SchemaObjectType
+	// Database is the database of the object, or the object itself if it's a database
+	Database string
+	// Name is the name of the object, which is empty if it's a database
+	Name string
+	// NewName is the name of the object after it was renamed, and is empty for other actions
+	NewName string
+}
+
+func (c SchemaChange) String() string {
+	name := c.Database
+	if c.Name != "" {
+		name = fmt.Sprintf("%s.%s", c.Database, c.Name)
+	}
+	if c.Action == SchemaChangeRenamed {
+		return fmt.Sprintf("%s %s %s to %s", c.Object, name, c.Action, c.NewName)
+	}
+	return fmt.Sprintf("%s %s %s", c.Object, name, c.Action)
+}
+
+// SchemaChangeListener is notified of the schema changes published to a SchemaChangeNotifier.
+type SchemaChangeListener interface {
+	// SchemaChanged is called after |change| has been made by a statement run in |ctx|.
+	SchemaChanged(ctx *Context, change SchemaChange)
+}
+
+// SchemaChangeListenerFunc is a function that implements SchemaChangeListener.
+type SchemaChangeListenerFunc func(ctx *Context, change SchemaChange)
+
+// SchemaChanged implements SchemaChangeListener.
+func (f SchemaChangeListenerFunc) SchemaChanged(ctx *Context, change SchemaChange) {
+	f(ctx, change)
+}
+
+// SchemaChangeNotifier delivers the schema changes made by DDL statements to the listeners subscribed to it, so that
+// caches of plans, view definitions, triggers and the like can be invalidated when the objects they were built from
+// change. Changes made by statements run through the engine are published once the statement completes successfully.
+// Integrators that change schemas by other means may publish those changes themselves.
+//
+// Listeners are called synchronously, in the order they subscribed, on the goroutine of the statement that made the
+// change. They must not run statements or subscribe to the notifier they are called from.
+type SchemaChangeNotifier struct {
+	mu        sync.Mutex
+	nextID    uint64
+	listeners []subscribedListener
+}
+
+type subscribedListener struct {
+	id       uint64
+	listener SchemaChangeListener
+}
+
+// NewSchemaChangeNotifier returns a new SchemaChangeNotifier with no listeners.
+func NewSchemaChangeNotifier() *SchemaChangeNotifier {
+	return &SchemaChangeNotifier{}
+}
+
+// Subscribe adds |listener| to the notifier, and returns a function that removes it again.
+func (n *SchemaChangeNotifier) Subscribe(listener SchemaChangeListener) (unsubscribe func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.nextID++
+	id := n.nextID
+	n.listeners = append(n.listeners, subscribedListener{id: id, listener: listener})
+	return func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		for i, l := range n.listeners {
+			if l.id == id {
+				// copy so that a concurrent Publish keeps the slice it read
+				listeners := make([]subscribedListener, 0, len(n.listeners)-1)
+				listeners = append(listeners, n.listeners[:i]...)
+				n.listeners = append(listeners, n.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Publish notifies every listener of each of |changes|.
+func (n *SchemaChangeNotifier) Publish(ctx *Context, changes ...SchemaChange) {
+	if len(changes) == 0 {
+		return
+	}
+	n.mu.Lock()
+	listeners := n.listeners
+	n.mu.Unlock()
+	for _, change := range changes {
+		for _, l := range listeners {
+			l.listener.SchemaChanged(ctx, change)
+		}
+	}
+}
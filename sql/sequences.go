@@ -0,0 +1,128 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// SequenceDefinition describes a sequence, a database object created with CREATE SEQUENCE that generates a series of
+// integers for NEXTVAL and NEXT VALUE FOR.
+type SequenceDefinition struct {
+	// Name is the name of the sequence. It's unique among the sequences and the tables of its database.
+	Name string
+	// Start is the first value of the sequence, which ALTER SEQUENCE ... RESTART restarts it with.
+	Start int64
+	// Increment is added to each value to generate the next one. A negative increment makes a descending sequence.
+	Increment int64
+	// MinValue and MaxValue bound the values of the sequence.
+	MinValue int64
+	MaxValue int64
+	// Cache is the number of values the sequence may reserve at once. Sequences keep it for SHOW CREATE SEQUENCE only.
+	Cache int64
+	// Cycle is whether the sequence starts again from its bound once it has generated its last value.
+	Cycle bool
+	// Next is the value the sequence generates next.
+	Next int64
+	// Exhausted is whether the value after the last one generated is outside the bounds of the sequence, which Next
+	// can't always represent.
+	Exhausted bool
+}
+
+// SequenceDatabase is a database that stores sequences. The engine generates the values of a sequence with
+// SequenceDefinition.Advance, so integrators only need to store and retrieve the definitions. The values a sequence
+// generates aren't transactional: they're never given back, even when the transaction that took them rolls back.
+type SequenceDatabase interface {
+	Database
+	// GetSequence returns the sequence with the name given, compared case-insensitively, and whether it exists.
+	GetSequence(ctx *Context, name string) (SequenceDefinition, bool, error)
+	// GetSequences returns all the sequences of the database.
+	GetSequences(ctx *Context) ([]SequenceDefinition, error)
+	// CreateSequence stores a new sequence. It returns ErrTableAlreadyExists if a sequence with the same name exists.
+	CreateSequence(ctx *Context, seq SequenceDefinition) error
+	// UpdateSequence replaces the sequence with the same name as |seq|. It returns ErrUnknownSequence if there isn't
+	// one.
+	UpdateSequence(ctx *Context, seq SequenceDefinition) error
+	// DropSequence removes the sequence with the name given. It returns ErrUnknownSequence if there isn't one.
+	DropSequence(ctx *Context, name string) error
+	// NextSequenceValue returns the next value of the sequence with the name given, and stores the sequence advanced
+	// past it with SequenceDefinition.Advance. It must do so atomically, so that no two sessions get the same value.
+	// It returns ErrSequenceRunOut once a sequence that doesn't cycle has no values left.
+	NextSequenceValue(ctx *Context, name string) (int64, error)
+}
+
+// SequenceSession is a Session that remembers the last value each sequence generated for it, which LASTVAL returns.
+// Sessions that embed BaseSession implement it.
+type SequenceSession interface {
+	Session
+	// SetLastSequenceValue records |value| as the last value generated for the session by the sequence named.
+	SetLastSequenceValue(db, name string, value int64)
+	// LastSequenceValue returns the last value generated for the session by the sequence named, if there is one.
+	LastSequenceValue(db, name string) (int64, bool)
+}
+
+// DefaultSequenceBounds returns the minimum and maximum values of a sequence with the increment given, when they aren't
+// specified. They're those of MariaDB, which leave room for a value outside the bounds of the sequence.
+func DefaultSequenceBounds(increment int64) (int64, int64) {
+	if increment < 0 {
+		return -9223372036854775807, -1
+	}
+	return 1, 9223372036854775806
+}
+
+// Validate returns ErrSequenceInvalidData if the options of the sequence contradict each other. The sequence is
+// qualified by |db| in the error.
+func (s SequenceDefinition) Validate(db string) error {
+	if s.Increment == 0 || s.MinValue >= s.MaxValue || s.Start < s.MinValue || s.Start > s.MaxValue || s.Cache < 0 {
+		return ErrSequenceInvalidData.New(db, s.Name)
+	}
+	return nil
+}
+
+// Advance returns the next value of the sequence, and the sequence that generates the value after it. It returns false
+// if the sequence has run out of values, which only happens for sequences that don't cycle.
+func (s SequenceDefinition) Advance() (int64, SequenceDefinition, bool) {
+	if s.Exhausted || s.Next < s.MinValue || s.Next > s.MaxValue {
+		if !s.Cycle {
+			s.Exhausted = true
+			return 0, s, false
+		}
+		s.Next = s.MinValue
+		if s.Increment < 0 {
+			s.Next = s.MaxValue
+		}
+	}
+	value := s.Next
+	return value, s.after(value), true
+}
+
+// SetValue returns the sequence changed so that |value| is the next value it generates, or the value after it if
+// |used| is true, and whether it was changed. Like MariaDB's SETVAL, it only moves a sequence forward: values that
+// an ascending sequence has already passed, and the same for a descending sequence, are ignored.
+func (s SequenceDefinition) SetValue(value int64, used bool) (SequenceDefinition, bool) {
+	if !s.Exhausted && (s.Increment > 0 && value < s.Next || s.Increment < 0 && value > s.Next) {
+		return s, false
+	}
+	if used {
+		return s.after(value), true
+	}
+	s.Next, s.Exhausted = value, false
+	return s, true
+}
+
+// after returns the sequence that generates the value following |value|.
+func (s SequenceDefinition) after(value int64) SequenceDefinition {
+	s.Next = value + s.Increment
+	// the addition overflows when the sign of the result differs from that of both operands
+	overflow := (value >= 0) == (s.Increment >= 0) && (s.Next >= 0) != (value >= 0)
+	s.Exhausted = overflow || s.Next < s.MinValue || s.Next > s.MaxValue
+	return s
+}
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -42,9 +44,20 @@ const (
 const (
 	CurrentDBSessionVar  = "current_database"
 	AutoCommitSessionVar = "autocommit"
+	// PreparedStmtReoptimizeSessionVar controls when prepared statements are re-optimized for their bind values. With
+	// PreparedStmtReoptimizeAlways, every execution is planned for the values bound to it. With
+	// PreparedStmtReoptimizeOnFingerprintChange, the plan of a read-only prepared statement is reused until its
+	// fingerprint, made up of its bind values and the session state that affects planning, changes.
+	PreparedStmtReoptimizeSessionVar          = "prepared_stmt_reoptimize"
+	PreparedStmtReoptimizeAlways              = "ALWAYS"
+	PreparedStmtReoptimizeOnFingerprintChange = "ON_FINGERPRINT_CHANGE"
+	// UuidBinaryCompatibilitySessionVar controls whether columns declared with the UUID type are created as BINARY(16)
+	// columns, which hold the results of UUID_TO_BIN and are compatible with MySQL, rather than as UUID columns.
+	UuidBinaryCompatibilitySessionVar = "uuid_binary_compatibility"
 	// TODO: how does character set and collation get matched?
 	characterSetConnectionSysVarName = "character_set_connection"
 	characterSetResultsSysVarName    = "character_set_results"
+	characterSetClientSysVarName     = "character_set_client"
 	collationConnectionSysVarName    = "collation_connection"
 )
 
@@ -61,6 +74,41 @@ type Client struct {
 	Capabilities uint32
 }
 
+// RoleName is the name of a role, which is a user name and a host, as roles are stored in the privilege tables.
+type RoleName struct {
+	Name string
+	Host string
+}
+
+// String returns the role name as a formatted string using the quotes given, such as `role`@`%` for the backtick.
+// A quote that is part of the name or host is escaped by doubling it, as MySQL does.
+func (r RoleName) String(quote string) string {
+	replacement := quote + quote
+	name := strings.ReplaceAll(r.Name, quote, replacement)
+	host := strings.ReplaceAll(r.Host, quote, replacement)
+	return fmt.Sprintf("%s%s%s@%s%s%s", quote, name, quote, quote, host, quote)
+}
+
+// FormatRoleNames returns the role names given as CURRENT_ROLE() returns them: sorted, quoted with backticks and
+// separated by commas, or NONE when there are no roles.
+func FormatRoleNames(roles []RoleName) string {
+	if len(roles) == 0 {
+		return "NONE"
+	}
+	sorted := append([]RoleName(nil), roles...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Host < sorted[j].Host
+	})
+	names := make([]string, len(sorted))
+	for i, role := range sorted {
+		names[i] = role.String("`")
+	}
+	return strings.Join(names, ",")
+}
+
 // Session holds the session data.
 type Session interface {
 	// Address of the server.
@@ -167,6 +215,8 @@ type Session interface {
 	GetCharacterSet() CharacterSetID
 	// GetCharacterSetResults returns the result character set for this session (defined by the system variable `character_set_results`).
 	GetCharacterSetResults() CharacterSetID
+	// GetCharacterSetClient returns the character set that this session's statements are sent in (defined by the system variable `character_set_client`).
+	GetCharacterSetClient() CharacterSetID
 	// GetCollation returns the collation for this session (defined by the system variable `collation_connection`).
 	GetCollation() CollationID
 	// GetPrivilegeSet returns the cached privilege set associated with this session, along with its counter. The
@@ -176,6 +226,20 @@ type Session interface {
 	// value of zero will force the cache to reload. This is an internal function and is not intended to be used by
 	// integrators.
 	SetPrivilegeSet(newPs PrivilegeSet, counter uint64)
+	// GetActiveRoles returns the roles that are active in this session, and whether they have been set. They're set
+	// when the privileges of the session's user are first loaded, to the roles that the user logs in with, and are
+	// changed by SET ROLE.
+	GetActiveRoles() ([]RoleName, bool)
+	// SetActiveRoles sets the roles that are active in this session. Passing false for |ok| unsets them, so that they
+	// are set to the login roles of the user once more. The cached privilege set must be reset after the roles change.
+	SetActiveRoles(roles []RoleName, ok bool)
+	// GetSandboxMode returns whether this session is in sandbox mode, in which its user must set a new password before
+	// running any other statement, and whether that has been determined yet. It's determined when the session runs its
+	// first statement, from whether the password of its user has expired.
+	GetSandboxMode() (sandboxed bool, ok bool)
+	// SetSandboxMode sets whether this session is in sandbox mode. Passing false for |ok| unsets it, so that it's
+	// determined once more.
+	SetSandboxMode(sandboxed bool, ok bool)
 	// ValidateSession provides integrators a chance to do any custom validation of this session before any query is
 	// executed in it. For example, Dolt uses this hook to validate that the session's working set is valid.
 	ValidateSession(ctx *Context) error
@@ -257,6 +321,8 @@ type (
 		Level   string
 		Message string
 		Code    int
+		// State is the SQLSTATE of the condition, which is HY000 if it's empty.
+		State string
 	}
 )
 
@@ -265,6 +331,8 @@ type LastQueryInfo struct {
 	FoundRows      atomic.Int64 // Session-level Found Rows for the last executed query
 	LastInsertId   atomic.Int64 // Session-level ID for the last executed insert query
 	LastInsertUUID atomic.Value // Session-level UUID for the last executed insert query
+	// LastInsertIdSet is whether LAST_INSERT_ID(expr) set LastInsertId during the statement being executed
+	LastInsertIdSet atomic.Bool
 }
 
 func defaultLastQueryInfo() *LastQueryInfo {
@@ -289,6 +357,8 @@ type Context struct {
 	tracer      trace.Tracer
 	rootSpan    trace.Span
 	Memory      *MemoryManager
+	// memTracker accounts for the memory buffered by the current query
+	memTracker  *MemoryTracker
 	query       string
 	pid         uint64
 	interpreted bool
@@ -567,6 +637,24 @@ func (c *Context) Pid() uint64 {
 	return c.pid
 }
 
+// MemoryTracker returns the tracker that the operators of the current query charge the memory they buffer to, or nil
+// if the memory of the query isn't tracked.
+func (c *Context) MemoryTracker() *MemoryTracker {
+	if c == nil {
+		return nil
+	}
+	return c.memTracker
+}
+
+// SetMemoryTracker sets the tracker that the operators of the current query charge the memory they buffer to. Any
+// memory still charged to the previous tracker is released.
+func (c *Context) SetMemoryTracker(t *MemoryTracker) {
+	if c.memTracker != nil && c.memTracker != t {
+		c.memTracker.Release()
+	}
+	c.memTracker = t
+}
+
 // Query returns the query string associated with this context.
 func (c *Context) Query() string {
 	if c == nil {
@@ -652,6 +740,8 @@ func (c *Context) WithClient(client Client) *Context {
 	nc := *c
 	nc.Session.SetClient(client)
 	nc.Session.SetPrivilegeSet(nil, 0)
+	nc.Session.SetActiveRoles(nil, false)
+	nc.Session.SetSandboxMode(false, false)
 	return &nc
 }
 
@@ -725,13 +815,14 @@ func (c *Context) NewErrgroup() (*errgroup.Group, *Context) {
 // Services are handles to optional or plugin functionality that can be
 // used by the SQL implementation in certain situations. An integrator can set
 // methods on Services for a given *Context and different parts of go-mysql-server
-// will inspect it in order to fulfill their implementations. Currently, the
-// KillConnection service is available. Set these with |WithServices|; the
-// implementation will access them through the corresponding methods on
-// *Context, such as |KillConnection|.
+// will inspect it in order to fulfill their implementations. Set these with
+// |WithServices|; the implementation will access them through the
+// corresponding methods on *Context, such as |KillConnection|.
 type Services struct {
 	KillConnection func(connID uint32) error
 	LoadInfile     func(filename string) (io.ReadCloser, error)
+	// FileSystem is the file system of the server, see |FileSystem|.
+	FileSystem FileSystem
 }
 
 // NewSpanIter creates a RowIter executed in the given span.
@@ -0,0 +1,160 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// SharedResultMaxRows is the largest result set that is kept in a SharedResultCache. Larger results are returned to the
+// statement that computed them, but not shared with later statements.
+const SharedResultMaxRows = 100_000
+
+// SharedResultCache holds the materialized results of common table expressions marked with the CACHED hint, so that
+// later statements in the same session that define the same expression can reuse them rather than recomputing them.
+// Each result records the tables it was computed from, and is discarded when any of them is written to, or when the
+// cache is invalidated as a whole. Results are never shared between sessions.
+//
+// Writes made through the engine invalidate the results that depend on them automatically. Integrators that change
+// table data by other means must call InvalidateTable or InvalidateAll.
+type SharedResultCache struct {
+	mu sync.Mutex
+	// epoch is incremented whenever the cache is invalidated as a whole
+	epoch uint64
+	// versions holds the version of each table that has been written to, keyed by SharedResultTableKey
+	versions map[string]uint64
+	results  map[uint32]map[string]*sharedResult
+}
+
+type sharedResult struct {
+	snapshot SharedResultSnapshot
+	rows     []Row
+}
+
+// SharedResultSnapshot records the state of the tables a result is computed from when its computation begins. A
+// result is only valid while none of those tables have been written to since its snapshot was taken.
+type SharedResultSnapshot struct {
+	epoch    uint64
+	tables   []string
+	versions []uint64
+}
+
+// NewSharedResultCache returns a new, empty SharedResultCache.
+func NewSharedResultCache() *SharedResultCache {
+	return &SharedResultCache{
+		versions: make(map[string]uint64),
+		results:  make(map[uint32]map[string]*sharedResult),
+	}
+}
+
+// SharedResultTableKey returns the key that identifies the table |table| in database |db| in a SharedResultCache.
+func SharedResultTableKey(db, table string) string {
+	return strings.ToLower(db) + "." + strings.ToLower(table)
+}
+
+// Snapshot returns a snapshot of the current state of |tables|, which are keys returned by SharedResultTableKey.
+func (c *SharedResultCache) Snapshot(tables []string) SharedResultSnapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	versions := make([]uint64, len(tables))
+	for i, t := range tables {
+		versions[i] = c.versions[t]
+	}
+	return SharedResultSnapshot{epoch: c.epoch, tables: tables, versions: versions}
+}
+
+// isCurrent returns whether no table in |s| has been written to since it was taken. The caller must hold the lock.
+func (c *SharedResultCache) isCurrent(s SharedResultSnapshot) bool {
+	if s.epoch != c.epoch {
+		return false
+	}
+	for i, t := range s.tables {
+		if c.versions[t] != s.versions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the result stored under |key| for the session with id |sessionID|, if there is one and none of the tables
+// it was computed from have been written to since.
+func (c *SharedResultCache) Get(sessionID uint32, key string) ([]Row, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.results[sessionID][key]
+	if !ok {
+		return nil, false
+	}
+	if !c.isCurrent(r.snapshot) {
+		delete(c.results[sessionID], key)
+		return nil, false
+	}
+	return r.rows, true
+}
+
+// Put stores |rows| under |key| for the session with id |sessionID|. |snapshot| must have been taken before the
+// computation of |rows| began; if any of its tables have been written to since, the rows may be out of date and are
+// not stored.
+func (c *SharedResultCache) Put(sessionID uint32, key string, snapshot SharedResultSnapshot, rows []Row) {
+	if len(rows) > SharedResultMaxRows {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.isCurrent(snapshot) {
+		return
+	}
+	results, ok := c.results[sessionID]
+	if !ok {
+		results = make(map[string]*sharedResult)
+		c.results[sessionID] = results
+	}
+	results[key] = &sharedResult{snapshot: snapshot, rows: rows}
+}
+
+// Len returns the number of results stored for the session with id |sessionID|, including any that are no longer valid
+// but haven't been discarded yet.
+func (c *SharedResultCache) Len(sessionID uint32) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.results[sessionID])
+}
+
+// InvalidateTable discards every result computed from the table |table| in database |db|.
+func (c *SharedResultCache) InvalidateTable(db, table string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.versions[SharedResultTableKey(db, table)]++
+}
+
+// InvalidateAll discards every result in the cache.
+func (c *SharedResultCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epoch++
+	// the epoch supersedes every table version, so they don't need to be remembered any longer
+	c.versions = make(map[string]uint64)
+	for id := range c.results {
+		delete(c.results, id)
+	}
+}
+
+// ClearSession discards the results of the session with id |sessionID|.
+func (c *SharedResultCache) ClearSession(sessionID uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.results, sessionID)
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedResultCache(t *testing.T) {
+	rows := []Row{{1}, {2}}
+	tables := []string{SharedResultTableKey("db", "t1"), SharedResultTableKey("db", "t2")}
+
+	t.Run("get and put", func(t *testing.T) {
+		require := require.New(t)
+		c := NewSharedResultCache()
+		_, ok := c.Get(1, "k")
+		require.False(ok)
+
+		c.Put(1, "k", c.Snapshot(tables), rows)
+		res, ok := c.Get(1, "k")
+		require.True(ok)
+		require.Equal(rows, res)
+
+		// results aren't shared between sessions
+		_, ok = c.Get(2, "k")
+		require.False(ok)
+	})
+
+	t.Run("writes invalidate dependent results", func(t *testing.T) {
+		require := require.New(t)
+		c := NewSharedResultCache()
+		c.Put(1, "k", c.Snapshot(tables), rows)
+		c.Put(1, "other", c.Snapshot([]string{SharedResultTableKey("db", "t3")}), rows)
+
+		c.InvalidateTable("DB", "T2")
+		_, ok := c.Get(1, "k")
+		require.False(ok)
+		_, ok = c.Get(1, "other")
+		require.True(ok)
+		require.Equal(1, c.Len(1))
+	})
+
+	t.Run("results computed during a write are not stored", func(t *testing.T) {
+		require := require.New(t)
+		c := NewSharedResultCache()
+		snapshot := c.Snapshot(tables)
+		c.InvalidateTable("db", "t1")
+		c.Put(1, "k", snapshot, rows)
+		_, ok := c.Get(1, "k")
+		require.False(ok)
+		require.Equal(0, c.Len(1))
+	})
+
+	t.Run("invalidate all", func(t *testing.T) {
+		require := require.New(t)
+		c := NewSharedResultCache()
+		snapshot := c.Snapshot(tables)
+		c.Put(1, "k", snapshot, rows)
+		c.Put(2, "k", snapshot, rows)
+		c.InvalidateAll()
+		_, ok := c.Get(1, "k")
+		require.False(ok)
+		_, ok = c.Get(2, "k")
+		require.False(ok)
+
+		// a snapshot from before the invalidation is never current again
+		c.Put(1, "k", snapshot, rows)
+		_, ok = c.Get(1, "k")
+		require.False(ok)
+	})
+
+	t.Run("clear session", func(t *testing.T) {
+		require := require.New(t)
+		c := NewSharedResultCache()
+		c.Put(1, "k", c.Snapshot(tables), rows)
+		c.Put(2, "k", c.Snapshot(tables), rows)
+		c.ClearSession(1)
+		require.Equal(0, c.Len(1))
+		require.Equal(1, c.Len(2))
+	})
+}
@@ -40,10 +40,11 @@ const (
 	StrictTransTables      = "STRICT_TRANS_TABLES"
 	StrictAllTables        = "STRICT_ALL_TABLES"
 	NoZeroInDate           = "NO_ZERO_IN_DATE"
+	NoZeroDate             = "NO_ZERO_DATE"
 	AllowInvalidDates      = "ALLOW_INVALID_DATES"
 	ErrorForDivisionByZero = "ERROR_FOR_DIVISION_BY_ZERO"
-	// Traditional mode includes STRICT_TRANS_TABLES, STRICT_ALL_TABLES, NO_ZERO_IN_DATE, ERROR_FOR_DIVISION_BY_ZERO,
-	// and NO_ENGINE_SUBSTITUTION
+	// Traditional mode includes STRICT_TRANS_TABLES, STRICT_ALL_TABLES, NO_ZERO_IN_DATE, NO_ZERO_DATE,
+	// ERROR_FOR_DIVISION_BY_ZERO, and NO_ENGINE_SUBSTITUTION
 	Traditional            = "TRADITIONAL"
 	HighNotPrecedence      = "HIGH_NOT_PRECEDENCE"
 	NoEngineSubstitution   = "NO_ENGINE_SUBSTITUTION"
@@ -107,6 +108,7 @@ var sqlModeBitMap = map[uint64]string{
 	modeStrictTransTables:      StrictTransTables,
 	modeStrictAllTables:        StrictAllTables,
 	modeNoZeroInDate:           NoZeroInDate,
+	modeNoZeroDate:             NoZeroDate,
 	modeAllowInvalidDates:      AllowInvalidDates,
 	modeErrorForDivisionByZero: ErrorForDivisionByZero,
 	modeTraditional:            Traditional,
@@ -224,6 +226,37 @@ func (s *SqlMode) Strict() bool {
 	return s.StrictAllTables() || s.StrictTransTables()
 }
 
+// NoUnsignedSubtraction returns true if NO_UNSIGNED_SUBTRACTION SQL mode is enabled, in which case subtracting
+// integers produces a signed result even when one of the operands is unsigned.
+func (s *SqlMode) NoUnsignedSubtraction() bool {
+	return s.ModeEnabled(NoUnsignedSubtraction)
+}
+
+// NoZeroDate returns true if NO_ZERO_DATE SQL mode is enabled, in which case '0000-00-00' is an error in strict mode
+// and a warning otherwise. Note that TRADITIONAL mode is a compound mode that includes NO_ZERO_DATE.
+func (s *SqlMode) NoZeroDate() bool {
+	return s.ModeEnabled(NoZeroDate) || s.ModeEnabled(Traditional)
+}
+
+// NoZeroInDate returns true if NO_ZERO_IN_DATE SQL mode is enabled, in which case dates with a zero month or day
+// (but not the zero date itself) are an error in strict mode and a warning otherwise. Note that TRADITIONAL mode is a
+// compound mode that includes NO_ZERO_IN_DATE.
+func (s *SqlMode) NoZeroInDate() bool {
+	return s.ModeEnabled(NoZeroInDate) || s.ModeEnabled(Traditional)
+}
+
+// AllowInvalidDates returns true if ALLOW_INVALID_DATES SQL mode is enabled, in which case the day of a date is only
+// checked to be between 1 and 31, rather than within the days of its month.
+func (s *SqlMode) AllowInvalidDates() bool {
+	return s.ModeEnabled(AllowInvalidDates)
+}
+
+// TimeTruncateFractional returns true if TIME_TRUNCATE_FRACTIONAL SQL mode is enabled, in which case fractional
+// seconds beyond the precision of a TIME, DATETIME or TIMESTAMP column are truncated instead of rounded.
+func (s *SqlMode) TimeTruncateFractional() bool {
+	return s.ModeEnabled(TimeTruncateFractional)
+}
+
 // ModeEnabled returns true if |mode| was explicitly specified in the SQL_MODE string that was used to
 // create this SqlMode instance. Note this function does not support expanding compound modes into the
 // individual modes they contain (e.g. if "ANSI" is the SQL_MODE string, then this function will not
@@ -33,6 +33,29 @@ type StatisticsTable interface {
 	RowCount(ctx *Context) (uint64, bool, error)
 }
 
+// StatisticsProvider is a table that can estimate the distribution of its own data, for integrators whose storage
+// maintains statistics of its own. The optimizer consults it to estimate the cardinality of scans of the table and the
+// selectivity of filters and joins over its columns, and falls back to heuristics for anything it has no estimate for.
+type StatisticsProvider interface {
+	Table
+	// EstimatedRowCount returns an estimate of the number of rows in the table, and whether an estimate is available.
+	EstimatedRowCount(ctx *Context) (uint64, bool, error)
+	// ColumnStatistics returns an estimate of the distribution of the values of the column named |column|, and
+	// whether an estimate is available.
+	ColumnStatistics(ctx *Context, column string) (ColumnStatistics, bool, error)
+}
+
+// ColumnStatistics describes the distribution of the values of a column, as estimated by a StatisticsProvider.
+type ColumnStatistics struct {
+	// DistinctCount is the number of distinct non-NULL values in the column, or 0 if it's unknown.
+	DistinctCount uint64
+	// NullFraction is the fraction of the rows of the table in which the column is NULL, between 0 and 1.
+	NullFraction float64
+	// Histogram describes the distribution of the non-NULL values of the column, in ascending order of the first
+	// value of each bucket's upper bound. It may be empty.
+	Histogram Histogram
+}
+
 // StatsProvider is a catalog extension for databases that can
 // build and provide index statistics.
 type StatsProvider interface {
@@ -161,6 +184,27 @@ func (q StatQualifier) Index() string {
 	return q.Idx
 }
 
+// IndexCardinalities returns, for each prefix of the key parts of |idx|, an estimate of the number of distinct values
+// it takes, as reported by SHOW INDEX and information_schema.STATISTICS. |stats| are the statistics of the index's
+// table. The estimate for a prefix comes from the statistic of |idx| over exactly that many columns; providers that
+// only keep a statistic over every column of an index provide an estimate for the whole key, but not its prefixes.
+// Prefixes without an estimate are reported as 0.
+func IndexCardinalities(idx Index, stats []Statistic) []int64 {
+	ret := make([]int64, len(idx.Expressions()))
+	id := strings.ToLower(idx.ID())
+	table := strings.ToLower(idx.Table())
+	for _, stat := range stats {
+		qual := stat.Qualifier()
+		if qual.Index() != id || qual.Table() != table {
+			continue
+		}
+		if n := len(stat.Columns()); n > 0 && n <= len(ret) {
+			ret[n-1] = int64(stat.DistinctCount())
+		}
+	}
+	return ret
+}
+
 // Histogram is a collection of non-overlapping buckets that
 // estimate the costing statistics for an index prefix.
 // Note that a non-unique key can cross bucket boundaries.
@@ -0,0 +1,95 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// errCallTimeout is the cause of the cancellation of a context returned by WithCallTimeout once its timeout elapses.
+var errCallTimeout = errors.New("storage call timeout")
+
+// WithCallTimeout returns a copy of |ctx| for a single call into a storage implementation, whose deadline is |timeout|
+// from now or the deadline of |ctx|, whichever is earlier, along with a function that releases its timer. The returned
+// context is still canceled along with |ctx|. A |timeout| of zero or less leaves the deadline of |ctx| unchanged.
+func WithCallTimeout(ctx *Context, timeout time.Duration) (*Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	newCtx, cancel := context.WithTimeoutCause(ctx.Context, timeout, errCallTimeout)
+	return ctx.WithContext(newCtx), cancel
+}
+
+// CallWithTimeout calls |f| with a context returned by WithCallTimeout for |ctx| and |timeout|. If |f| returns an
+// error after the timeout of the call has elapsed, ErrStorageCallTimeout is returned in its place. Errors caused by the
+// cancellation or deadline of |ctx| itself are returned unchanged.
+func CallWithTimeout[T any](ctx *Context, timeout time.Duration, f func(*Context) (T, error)) (T, error) {
+	callCtx, cancel := WithCallTimeout(ctx, timeout)
+	defer cancel()
+	ret, err := f(callCtx)
+	if err != nil && context.Cause(callCtx) == errCallTimeout {
+		var zero T
+		return zero, ErrStorageCallTimeout.New(timeout)
+	}
+	return ret, err
+}
+
+// TimeoutPartitionIter is a PartitionIter that bounds each call to Next of the iterator it wraps with CallWithTimeout.
+type TimeoutPartitionIter struct {
+	iter    PartitionIter
+	timeout time.Duration
+}
+
+var _ PartitionIter = (*TimeoutPartitionIter)(nil)
+
+// NewTimeoutPartitionIter returns a new TimeoutPartitionIter that bounds each call to Next of |iter| by |timeout|.
+func NewTimeoutPartitionIter(iter PartitionIter, timeout time.Duration) *TimeoutPartitionIter {
+	return &TimeoutPartitionIter{iter: iter, timeout: timeout}
+}
+
+// Next implements the PartitionIter interface.
+func (i *TimeoutPartitionIter) Next(ctx *Context) (Partition, error) {
+	return CallWithTimeout(ctx, i.timeout, i.iter.Next)
+}
+
+// Close implements the PartitionIter interface.
+func (i *TimeoutPartitionIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
+
+// TimeoutRowIter is a RowIter that bounds each call to Next of the iterator it wraps with CallWithTimeout.
+type TimeoutRowIter struct {
+	iter    RowIter
+	timeout time.Duration
+}
+
+var _ RowIter = (*TimeoutRowIter)(nil)
+
+// NewTimeoutRowIter returns a new TimeoutRowIter that bounds each call to Next of |iter| by |timeout|.
+func NewTimeoutRowIter(iter RowIter, timeout time.Duration) *TimeoutRowIter {
+	return &TimeoutRowIter{iter: iter, timeout: timeout}
+}
+
+// Next implements the RowIter interface.
+func (i *TimeoutRowIter) Next(ctx *Context) (Row, error) {
+	return CallWithTimeout(ctx, i.timeout, i.iter.Next)
+}
+
+// Close implements the RowIter interface.
+func (i *TimeoutRowIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
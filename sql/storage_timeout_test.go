@@ -0,0 +1,74 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// blockingRowIter blocks in Next until its context is done, and then returns the context's error.
+type blockingRowIter struct{}
+
+func (blockingRowIter) Next(ctx *Context) (Row, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (blockingRowIter) Close(*Context) error {
+	return nil
+}
+
+func TestCallWithTimeout(t *testing.T) {
+	t.Run("returns the result of a call that finishes in time", func(t *testing.T) {
+		ctx := NewEmptyContext()
+		iter := NewTimeoutRowIter(&testRowIter{n: 1, err: io.EOF}, time.Minute)
+		row, err := iter.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, Row{0}, row)
+		_, err = iter.Next(ctx)
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("times out a call that takes too long", func(t *testing.T) {
+		ctx := NewEmptyContext()
+		iter := NewTimeoutRowIter(blockingRowIter{}, 10*time.Millisecond)
+		_, err := iter.Next(ctx)
+		require.True(t, ErrStorageCallTimeout.Is(err))
+		// the query itself is unaffected
+		require.NoError(t, ctx.Err())
+	})
+
+	t.Run("keeps the deadline of the query", func(t *testing.T) {
+		queryCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		ctx := NewContext(queryCtx)
+		iter := NewTimeoutRowIter(blockingRowIter{}, time.Minute)
+		_, err := iter.Next(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.False(t, ErrStorageCallTimeout.Is(err))
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		ctx := NewEmptyContext()
+		callCtx, cancel := WithCallTimeout(ctx, 0)
+		defer cancel()
+		require.Same(t, ctx, callCtx)
+	})
+}
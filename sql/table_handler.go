@@ -0,0 +1,44 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// TableHandler is a table opened with HANDLER ... OPEN. It stays open for the rest of the session, or until it's closed
+// with HANDLER ... CLOSE, and remembers the position of its last HANDLER ... READ.
+type TableHandler struct {
+	// Database is the name of the database of the opened table.
+	Database string
+	// Table is the name of the opened table.
+	Table string
+	// Index is the name of the index the handler was last positioned on, or the empty string if it was last positioned
+	// in the natural order of the table.
+	Index string
+	// Rows are the rows of the table in the order of Index, as they were when the handler was last positioned. They're
+	// nil if the handler hasn't been positioned yet.
+	Rows []Row
+	// Pos is the position in Rows of the last row read. It's -1 before the first row and len(Rows) after the last one.
+	Pos int
+}
+
+// TableHandlerSession is a Session that keeps the tables opened with HANDLER statements. Sessions that embed
+// BaseSession implement it.
+type TableHandlerSession interface {
+	Session
+	// OpenTableHandler opens |handler| under |name|, returning false if a handler with that name is already open.
+	OpenTableHandler(name string, handler *TableHandler) bool
+	// GetTableHandler returns the handler open under |name|, if there is one.
+	GetTableHandler(name string) (*TableHandler, bool)
+	// CloseTableHandler closes the handler open under |name|, returning false if there isn't one.
+	CloseTableHandler(name string) bool
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "strings"
+
+// TablePolicy restricts what the users of an engine can see of a table. It's applied whenever a query is analyzed, so
+// integrators can isolate the tenants of a multi-tenant deployment, or hide sensitive values, without having to route
+// queries through views. For each query reading the table, the table is replaced by a derived table that selects the
+// masked columns of the rows that pass the row filter. The tables that UPDATE and DELETE statements change are filtered
+// by the row filter, and those statements can't read their masked columns. REPLACE and INSERT ... ON DUPLICATE KEY
+// UPDATE statements can't write a restricted table, as they change the rows their new rows conflict with, and HANDLER
+// statements can't read one. The rows written by other INSERT statements aren't checked.
+type TablePolicy struct {
+	// Name identifies the policy, case-insensitive.
+	Name string
+	// Database is the name of the database of the table, or empty to apply the policy to tables in every database.
+	Database string
+	// Table is the name of the table the policy applies to.
+	Table string
+	// Apply returns the restriction that the policy places on the current user of |ctx|, or nil if the table isn't
+	// restricted for them. The user's account is ctx.Session.Client(), and their active roles are returned by
+	// ctx.Session.GetActiveRoles().
+	Apply func(ctx *Context) (*TableRestriction, error)
+}
+
+// TableRestriction is the restriction a TablePolicy places on the queries of a user.
+type TableRestriction struct {
+	// RowFilter is a boolean SQL expression, like the condition of a WHERE clause, that the rows the user may read or
+	// change must satisfy. It may refer to the columns of the table. An empty filter doesn't restrict the rows.
+	RowFilter string
+	// ColumnMasks maps the names of columns to SQL expressions whose values the user reads instead of those of the
+	// columns. The expressions may refer to the columns of the table. When several policies mask a column, the mask of
+	// the policy registered first is used.
+	ColumnMasks map[string]string
+}
+
+// Validate returns an error if the definition of the policy is incomplete.
+func (p *TablePolicy) Validate() error {
+	switch {
+	case p.Name == "":
+		return ErrInvalidTablePolicy.New(p.Name, "a name is required")
+	case p.Table == "":
+		return ErrInvalidTablePolicy.New(p.Name, "a table is required")
+	case p.Apply == nil:
+		return ErrInvalidTablePolicy.New(p.Name, "Apply must be set")
+	}
+	return nil
+}
+
+// AppliesTo returns whether the policy applies to the table |table| in the database |db|.
+func (p *TablePolicy) AppliesTo(db, table string) bool {
+	return strings.EqualFold(p.Table, table) && (p.Database == "" || strings.EqualFold(p.Database, db))
+}
+
+// TablePolicyProvider is implemented by catalogs that let integrators register table policies.
+type TablePolicyProvider interface {
+	// RegisterTablePolicy adds the policy given, returning an error if a policy with its name exists.
+	RegisterTablePolicy(ctx *Context, policy TablePolicy) error
+	// DropTablePolicy removes the policy with the name given, returning an error if it doesn't exist.
+	DropTablePolicy(ctx *Context, name string) error
+	// TablePolicies returns the policies that apply to the table |table| in the database |db|, in the order they were
+	// registered.
+	TablePolicies(ctx *Context, db, table string) []TablePolicy
+}
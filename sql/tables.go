@@ -19,6 +19,12 @@ import (
 )
 
 // Table is a SQL table.
+//
+// Every call into a table, and into the partition and row iterators it returns, receives the context of the query that
+// makes it. That context carries the deadline of the query, such as the one set by max_execution_time, and is canceled
+// when the query is killed or its client goes away. Implementations must use it for any blocking work they do, and
+// should return its error as soon as it's done, checking it periodically during calls that may run for a long time.
+// WithCallTimeout, CallWithTimeout, TimeoutPartitionIter and TimeoutRowIter bound individual calls further.
 type Table interface {
 	Nameable
 	fmt.Stringer
@@ -100,6 +106,27 @@ type FilteredTable interface {
 	WithFilters(ctx *Context, filters []Expression) Table
 }
 
+// FilterPushdownTable is a FilteredTable whose filters are applied by the system its rows come from, such as a remote
+// server, rather than by the engine. The analyzer pushes the filters on a scan of the table into it while planning a
+// query, and removes the filters the table handles from the plan. The filters it's given refer only to the table's own
+// columns, by name: the indexes of their fields don't correspond to the table's schema.
+type FilterPushdownTable interface {
+	FilteredTable
+	// PushdownFilters returns whether the analyzer should push filters into this table.
+	PushdownFilters() bool
+}
+
+// LimitedTable is a table that can stop returning rows after a given number of them, such as one whose rows come from
+// a remote server. The analyzer pushes the LIMIT on a scan of the table into it, together with any OFFSET, when
+// nothing between the two changes which rows the scan returns. The engine still applies the LIMIT itself.
+type LimitedTable interface {
+	Table
+	// WithLimit returns a version of this table whose row iterators return at most |limit| rows between them.
+	WithLimit(ctx *Context, limit uint64) Table
+	// Limit returns the limit applied to this table, and whether one has been.
+	Limit() (uint64, bool)
+}
+
 // CommentedTable is a table that has a comment on it.
 type CommentedTable interface {
 	Table
@@ -121,6 +148,30 @@ type ProjectedTable interface {
 	Projections() []string
 }
 
+// PrefetchTable is a table whose rows are slow to fetch, such as one backed by remote storage. Scans of a
+// PrefetchTable read rows ahead of the query that consumes them in a separate goroutine, overlapping the latency of
+// fetching rows with the work of processing them. The table's row iterators must be goroutine-safe: they are called
+// from a goroutine other than the one that executes the query, and may be read while the same statement writes to the
+// table. The context given to their Next method is a copy of the query's context, but shares its session, which is not
+// safe for concurrent use, so the iterators must not modify the session.
+type PrefetchTable interface {
+	Table
+	// PrefetchRows returns the number of rows to keep in flight ahead of the query. A value less than 1 disables
+	// prefetching.
+	PrefetchRows() int
+}
+
+// LockableTable is a table that can lock the rows it reads for a locking read, such as SELECT ... FOR UPDATE. Locking
+// reads of tables that don't implement this interface don't lock any rows.
+type LockableTable interface {
+	Table
+	// WithRowLock returns a version of this table whose row iterators lock each row they return with |lock|, for the
+	// transaction of the context they're called with. When a row is locked by another transaction in a conflicting
+	// mode, the iterator must wait for the lock with WaitForRowLock if |lock.Wait| is RowLockWaitDefault, return
+	// ErrLockNowait if it's RowLockNowait, and skip the row if it's RowLockSkipLocked.
+	WithRowLock(lock RowLock) Table
+}
+
 // IndexAddressable is a table that can be scanned through a primary index
 type IndexAddressable interface {
 	// IndexedAccess returns a table that can perform scans constrained to
@@ -151,7 +202,8 @@ type IndexAddressableTable interface {
 // IndexedTable is a table with an index chosen for range scans
 type IndexedTable interface {
 	Table
-	// LookupPartitions returns partitions scanned by the given IndexLookup
+	// LookupPartitions returns partitions scanned by the given IndexLookup. As with the methods of Table, the context
+	// carries the deadline of the query and must be honored by the lookup and the iterators it returns.
 	LookupPartitions(*Context, IndexLookup) (PartitionIter, error)
 }
 
@@ -275,6 +327,38 @@ type TargetRowSizeTable interface {
 	GetTargetRowSize() uint64
 }
 
+// CompressionAlterableTable represents a table that supports compressing its storage.
+type CompressionAlterableTable interface {
+	Table
+	// ModifyCompression sets the compression algorithm of the table's storage, as named by the COMPRESSION table
+	// option. The algorithm "none" disables compression.
+	ModifyCompression(ctx *Context, algorithm string) error
+}
+
+// CompressedTable represents a table that can report the compression of its storage.
+type CompressedTable interface {
+	Table
+	// Compression returns the compression algorithm of the table's storage, or the empty string if it isn't
+	// compressed.
+	Compression() string
+	// CompressionStats returns statistics about the compressed storage of the table.
+	CompressionStats(ctx *Context) (CompressionStats, error)
+}
+
+// CompressionStats describes the compressed storage of a table.
+type CompressionStats struct {
+	// Partitions is the number of partitions of the table.
+	Partitions int
+	// CompressedPartitions is the number of partitions currently stored compressed.
+	CompressedPartitions int
+	// UncompressedLength is the size in bytes of the compressed partitions before compression.
+	UncompressedLength uint64
+	// CompressedLength is the size in bytes of the compressed partitions.
+	CompressedLength uint64
+	// Checksum is a checksum of the contents of the compressed partitions.
+	Checksum uint64
+}
+
 // PrimaryKeyTable is a table with a primary key.
 type PrimaryKeyTable interface {
 	// PrimaryKeySchema returns this table's PrimaryKeySchema
@@ -366,6 +450,41 @@ type AutoIncrementTable interface {
 	AutoIncrementSetter(*Context) AutoIncrementSetter
 }
 
+// AutoIncrementRangeTable is an AutoIncrementTable that reserves ranges of its AUTO_INCREMENT sequence atomically.
+// Inserts into these tables take the values they generate from reserved ranges instead of calling
+// GetNextAutoIncrementValue, so that integrators can persist the sequence once per range rather than once per row,
+// and so that concurrent inserts never hand out the same value. Values that are reserved but not used by the statement
+// that reserved them are lost, as they are in MySQL.
+type AutoIncrementRangeTable interface {
+	AutoIncrementTable
+	// ReserveAutoIncrementRange reserves |count| values of the AUTO_INCREMENT sequence, |increment| apart, and returns
+	// the first of them. The first value is the smallest one that is no less than the next value of the sequence and
+	// is equal to |offset| modulo |increment|, matching the semantics of the auto_increment_increment and
+	// auto_increment_offset system variables. The sequence must advance past the last value reserved before this
+	// method returns.
+	ReserveAutoIncrementRange(ctx *Context, count, increment, offset uint64) (uint64, error)
+}
+
+// NextAutoIncrementInSeries returns the smallest value that is no less than |next| and is equal to |offset| modulo
+// |increment|. As in MySQL, an |offset| greater than |increment| is ignored.
+func NextAutoIncrementInSeries(next, increment, offset uint64) uint64 {
+	if increment <= 1 {
+		return next
+	}
+	if offset > increment || offset == 0 {
+		offset = 1
+	}
+	if next <= offset {
+		return offset
+	}
+	steps := (next - offset + increment - 1) / increment
+	if val := offset + steps*increment; val >= next {
+		return val
+	}
+	// the series overflows, so there are no more values in it
+	return next
+}
+
 // AutoIncrementGetter provides support for reading a table's AUTO_INCREMENT value.
 // This can include tables that don't implement AutoIncrementTable if the table is a read-only snapshot
 // of an auto-incremented table.
@@ -421,6 +540,37 @@ type RowUpdater interface {
 	Closer
 }
 
+// JSONUpdater is a RowUpdater that can apply the changes made to JSON columns by JSON_SET, JSON_REPLACE and
+// JSON_REMOVE as a list of edits to the stored documents, rather than writing each changed document in full.
+type JSONUpdater interface {
+	RowUpdater
+	// UpdateJSON updates the given row, like Update. |diffs| holds, by column index, the edits that turn the JSON
+	// document in |old| into the one in |new|, applied in order. Columns without an entry in |diffs| are written as in
+	// Update.
+	UpdateJSON(ctx *Context, old Row, new Row, diffs map[int][]JSONDiff) error
+}
+
+// JSONDiffType is the kind of edit made by a JSONDiff.
+type JSONDiffType byte
+
+const (
+	// JSONDiffReplace replaces the value at the path of the diff, which exists in the document.
+	JSONDiffReplace JSONDiffType = iota
+	// JSONDiffInsert adds a value at the path of the diff, as a new object member or at the end of an array.
+	JSONDiffInsert
+	// JSONDiffRemove removes the value at the path of the diff.
+	JSONDiffRemove
+)
+
+// JSONDiff is an edit of a JSON document. Its path locates a single value without wildcards or the last keyword, such
+// as $.a[2].b, and is resolved against the document as left by the edits before it.
+type JSONDiff struct {
+	Type JSONDiffType
+	Path string
+	// Value is the value that's written at Path, which is nil for a JSONDiffRemove.
+	Value JSONWrapper
+}
+
 // TableEditor is the combination of interfaces that allow any table edit operation:
 // i.e. INSERT, UPDATE, DELETE, REPLACE
 type TableEditor interface {
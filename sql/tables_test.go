@@ -0,0 +1,47 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextAutoIncrementInSeries(t *testing.T) {
+	tests := []struct {
+		next, increment, offset uint64
+		expected                uint64
+	}{
+		{1, 1, 1, 1},
+		{7, 1, 1, 7},
+		{1, 10, 1, 1},
+		{2, 10, 1, 11},
+		{1, 10, 5, 5},
+		{5, 10, 5, 5},
+		{6, 10, 5, 15},
+		{28, 10, 5, 35},
+		// an offset greater than the increment is ignored
+		{36, 10, 20, 41},
+		{math.MaxUint64 - 1, 10, 1, math.MaxUint64 - 1},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d %d %d", test.next, test.increment, test.offset), func(t *testing.T) {
+			assert.Equal(t, test.expected, NextAutoIncrementInSeries(test.next, test.increment, test.offset))
+		})
+	}
+}
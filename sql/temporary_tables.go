@@ -0,0 +1,46 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// TemporaryTableSession is a Session that keeps the temporary tables the engine creates for databases that don't
+// implement TemporaryTableCreator. These tables live in a namespace private to the session: they shadow permanent
+// tables of the same name for the session that created them, and they're dropped when the session ends. Sessions that
+// embed BaseSession implement it.
+type TemporaryTableSession interface {
+	Session
+	// AddTemporaryTable adds |table| to the temporary tables of the database named |db|, returning false if there
+	// already is a temporary table with its name.
+	AddTemporaryTable(db string, table Table) bool
+	// GetTemporaryTable returns the temporary table named |name| of the database named |db|, if there is one.
+	GetTemporaryTable(db, name string) (Table, bool)
+	// DropTemporaryTable drops the temporary table named |name| of the database named |db|, returning false if there
+	// isn't one.
+	DropTemporaryTable(db, name string) bool
+	// GetTemporaryTables returns the temporary tables of the database named |db|, sorted by name.
+	GetTemporaryTables(db string) []Table
+}
+
+// GetSessionTableInsensitive returns the table named |name| of |db|, matched case-insensitively, as seen by the session
+// of |ctx|: a temporary table of the session shadows the permanent table of |db| with the same name.
+func GetSessionTableInsensitive(ctx *Context, db Database, name string) (Table, bool, error) {
+	if ctx != nil {
+		if tts, ok := ctx.Session.(TemporaryTableSession); ok {
+			if table, ok := tts.GetTemporaryTable(db.Name(), name); ok {
+				return table, true, nil
+			}
+		}
+	}
+	return db.GetTableInsensitive(ctx, name)
+}
@@ -50,32 +50,45 @@ const (
 var offsetRegex = regexp.MustCompile(`(?m)^([+\-])(\d{1,2}):(\d{2})$`)
 
 // ConvertTimeZone converts |datetime| from one timezone to another. |fromLocation| and |toLocation| can be either
-// the name of a timezone (e.g. "UTC") or a MySQL-formatted timezone offset (e.g. "+01:00"). If the time was converted
-// successfully, then the second return value will be true, otherwise the time was not able to be converted.
-// TODO: This function relies on ConvertTimeToLocation functioning incorrectly to get the correct result. The resulting
-// time is still the same location as the original time, just with time values shifted.
+// the name of a timezone (e.g. "UTC") or a MySQL-formatted timezone offset (e.g. "+01:00"). The wall clock time of
+// |datetime| is read in |fromLocation|, and the result holds the wall clock time of that instant in |toLocation|, in
+// the location of |datetime|. If the time was converted successfully, then the second return value will be true,
+// otherwise the time was not able to be converted.
 func ConvertTimeZone(datetime time.Time, fromLocation string, toLocation string) (time.Time, bool) {
 	if fromLocation == toLocation {
 		return datetime, true
 	}
-	convertedFromTime, err := ConvertTimeToLocation(datetime, fromLocation)
+	fromLoc, err := timeZoneLocation(fromLocation)
 	if err != nil {
 		return time.Time{}, false
 	}
-	convertedToTime, err := ConvertTimeToLocation(datetime, toLocation)
+	toLoc, err := timeZoneLocation(toLocation)
 	if err != nil {
 		return time.Time{}, false
 	}
 
-	delta := convertedFromTime.Sub(convertedToTime)
-	return datetime.Add(delta), true
+	instant := time.Date(datetime.Year(), datetime.Month(), datetime.Day(), datetime.Hour(), datetime.Minute(), datetime.Second(), datetime.Nanosecond(), fromLoc)
+	wall := instant.In(toLoc)
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), datetime.Location()), true
+}
+
+// timeZoneLocation returns the location for the name of a timezone or a MySQL-formatted timezone offset
+func timeZoneLocation(location string) (*time.Location, error) {
+	if loc, err := LoadTimeZone(location); err == nil {
+		return loc, nil
+	}
+	duration, err := MySQLOffsetToDuration(location)
+	if err != nil {
+		return nil, ErrInvalidTimeZone.New(location)
+	}
+	return time.FixedZone(location, int(duration/time.Second)), nil
 }
 
 func ValidTimeZone(str string) bool {
 	if strings.ToUpper(str) == "SYSTEM" || offsetRegex.MatchString(str) {
 		return true
 	}
-	_, err := time.LoadLocation(str)
+	_, err := LoadTimeZone(str)
 	return err == nil
 }
 
@@ -134,7 +147,7 @@ func SecondsToMySQLOffset(offset int) string {
 // datetime is in the given location. The converted time also assumes UTC as its location.
 func ConvertTimeToLocation(datetime time.Time, location string) (time.Time, error) {
 	// Try to load the timezone location string first
-	loc, err := time.LoadLocation(location)
+	loc, err := LoadTimeZone(location)
 	if err == nil {
 		return getCopy(datetime, loc), nil
 	}
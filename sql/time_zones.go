@@ -0,0 +1,107 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+	"time"
+	// the zone database is embedded so that named zones work on hosts without one installed
+	_ "time/tzdata"
+)
+
+// TimeZoneProvider supplies the named time zones used by the time_zone system variable, CONVERT_TZ and the
+// mysql.time_zone tables. Integrators can replace the default provider, which uses the IANA time zone database, with
+// SetTimeZoneProvider.
+type TimeZoneProvider interface {
+	// Names returns the names of every zone the provider can load.
+	Names() []string
+	// LoadLocation returns the zone with the given name. Names are matched case-insensitively against Names before
+	// being passed to LoadLocation, names not returned by Names are passed as given.
+	LoadLocation(name string) (*time.Location, error)
+}
+
+type ianaTimeZoneProvider struct{}
+
+var _ TimeZoneProvider = ianaTimeZoneProvider{}
+
+// Names implements TimeZoneProvider.
+func (ianaTimeZoneProvider) Names() []string {
+	return ianaTimeZoneNames
+}
+
+// LoadLocation implements TimeZoneProvider.
+func (ianaTimeZoneProvider) LoadLocation(name string) (*time.Location, error) {
+	return time.LoadLocation(name)
+}
+
+// timeZones holds the current TimeZoneProvider, the canonical spelling of its zone names by lowercase name and the
+// zones loaded so far
+var timeZones = struct {
+	sync.RWMutex
+	provider TimeZoneProvider
+	names    map[string]string
+	loaded   map[string]*time.Location
+}{provider: ianaTimeZoneProvider{}}
+
+// SetTimeZoneProvider replaces the provider of named time zones. Passing nil restores the default provider.
+func SetTimeZoneProvider(p TimeZoneProvider) {
+	if p == nil {
+		p = ianaTimeZoneProvider{}
+	}
+	timeZones.Lock()
+	defer timeZones.Unlock()
+	timeZones.provider = p
+	timeZones.names = nil
+	timeZones.loaded = nil
+}
+
+// GetTimeZoneProvider returns the current provider of named time zones.
+func GetTimeZoneProvider() TimeZoneProvider {
+	timeZones.RLock()
+	defer timeZones.RUnlock()
+	return timeZones.provider
+}
+
+// LoadTimeZone returns the named time zone from the current TimeZoneProvider. Names are case-insensitive, so
+// 'us/eastern' loads US/Eastern.
+func LoadTimeZone(name string) (*time.Location, error) {
+	timeZones.RLock()
+	loc, ok := timeZones.loaded[name]
+	timeZones.RUnlock()
+	if ok {
+		return loc, nil
+	}
+
+	timeZones.Lock()
+	defer timeZones.Unlock()
+	if timeZones.names == nil {
+		timeZones.names = make(map[string]string)
+		for _, n := range timeZones.provider.Names() {
+			timeZones.names[strings.ToLower(n)] = n
+		}
+		timeZones.loaded = make(map[string]*time.Location)
+	}
+	canonical, ok := timeZones.names[strings.ToLower(name)]
+	if !ok {
+		canonical = name
+	}
+	loc, err := timeZones.provider.LoadLocation(canonical)
+	if err != nil {
+		return nil, err
+	}
+	timeZones.loaded[name] = loc
+	return loc, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTimeZoneProvider struct{}
+
+func (testTimeZoneProvider) Names() []string {
+	return []string{"Test/Zone"}
+}
+
+func (testTimeZoneProvider) LoadLocation(name string) (*time.Location, error) {
+	if name == "Test/Zone" {
+		return time.FixedZone("TZT", 5*60*60), nil
+	}
+	return nil, fmt.Errorf("unknown zone %s", name)
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	loc, err := LoadTimeZone("us/eastern")
+	require.NoError(t, err)
+	assert.Equal(t, "US/Eastern", loc.String())
+
+	_, err = LoadTimeZone("Not/AZone")
+	assert.Error(t, err)
+
+	SetTimeZoneProvider(testTimeZoneProvider{})
+	defer SetTimeZoneProvider(nil)
+
+	loc, err = LoadTimeZone("test/zone")
+	require.NoError(t, err)
+	assert.Equal(t, "TZT", loc.String())
+	assert.True(t, ValidTimeZone("Test/Zone"))
+	assert.False(t, ValidTimeZone("US/Eastern"))
+}
+
+func TestConvertTimeZone(t *testing.T) {
+	tests := []struct {
+		datetime time.Time
+		from     string
+		to       string
+		expected time.Time
+	}{
+		{
+			datetime: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC),
+			from:     "US/Eastern",
+			to:       "UTC",
+			expected: time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			datetime: time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC),
+			from:     "US/Eastern",
+			to:       "UTC",
+			expected: time.Date(2024, 7, 15, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			datetime: time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC),
+			from:     "Europe/Berlin",
+			to:       "+00:00",
+			expected: time.Date(2024, 7, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			datetime: time.Date(2024, 3, 10, 23, 30, 0, 0, time.UTC),
+			from:     "-01:30",
+			to:       "asia/kolkata",
+			expected: time.Date(2024, 3, 11, 6, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s to %s", tt.datetime, tt.from, tt.to), func(t *testing.T) {
+			res, ok := ConvertTimeZone(tt.datetime, tt.from, tt.to)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+
+	_, ok := ConvertTimeZone(time.Now(), "Not/AZone", "UTC")
+	assert.False(t, ok)
+}
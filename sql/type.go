@@ -354,6 +354,15 @@ type SpatialColumnType interface {
 	MatchSRID(interface{}) error
 }
 
+// RangeKeyType is a Type whose values include some that Convert rejects, such as the dates that DATE and DATETIME
+// columns store as their parts. The keys of the ranges of index lookups on columns of these types are converted with
+// ConvertRangeKey, so that they can match those values.
+type RangeKeyType interface {
+	Type
+	// ConvertRangeKey converts |key| to this type for the range of an index lookup.
+	ConvertRangeKey(ctx context.Context, key interface{}) (interface{}, ConvertInRange, error)
+}
+
 // SystemVariableType represents a SQL type specifically (and only) used in system variables. Assigning any non-system
 // variables a SystemVariableType will cause errors.
 type SystemVariableType interface {
@@ -47,6 +47,8 @@ var (
 type BitType interface {
 	sql.Type
 	NumberOfBits() uint8
+	// Bytes returns the binary string form of the given value.
+	Bytes(v uint64) []byte
 }
 
 type BitType_ struct {
@@ -220,18 +222,20 @@ func (t BitType_) SQL(ctx *sql.Context, dest []byte, v interface{}) (sqltypes.Va
 	if err != nil {
 		return sqltypes.Value{}, err
 	}
-	bitVal := value.(uint64)
+	return sqltypes.MakeTrusted(sqltypes.Bit, t.Bytes(value.(uint64))), nil
+}
 
+// Bytes returns the binary string form of |v|, which is the big endian representation of its bits padded to a whole
+// number of bytes. This is how BIT values are returned to clients and used in string contexts.
+func (t BitType_) Bytes(v uint64) []byte {
 	var data []byte
 	for i := uint64(0); i < uint64(t.numOfBits); i += 8 {
-		data = append(data, byte(bitVal>>i))
+		data = append(data, byte(v>>i))
 	}
 	for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
 		data[i], data[j] = data[j], data[i]
 	}
-	val := data
-
-	return sqltypes.MakeTrusted(sqltypes.Bit, val), nil
+	return data
 }
 
 // SQLValue implements ValueType interface.
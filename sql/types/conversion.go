@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/cockroachdb/apd/v3"
 	"github.com/dolthub/vitess/go/mysql"
@@ -28,6 +29,7 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
 )
 
 // ApproximateTypeFromValue returns the closest matching type to the given value. For example, an int16 will return SMALLINT.
@@ -65,6 +67,11 @@ func ApproximateTypeFromValue(val interface{}) sql.Type {
 		return Time
 	case time.Time:
 		return DatetimeMaxPrecision
+	case DateParts:
+		if v.HasTime {
+			return DatetimeMaxPrecision
+		}
+		return Date
 	case float32:
 		return Float32
 	case float64:
@@ -356,14 +363,10 @@ func ColumnTypeToType(ct *sqlparser.ColumnType) (sql.Type, error) {
 			if err != nil {
 				return nil, err
 			}
-			switch length {
-			case 0, 1, 2, 3, 4, 5:
-				return nil, fmt.Errorf("TIME length not yet supported")
-			case 6:
-				return Time, nil
-			default:
-				return nil, fmt.Errorf("TIME only supports a length from 0 to 6")
+			if length > 6 || length < 0 {
+				return nil, fmt.Errorf("TIME supports precision from 0 to 6")
 			}
+			return CreateTimeType(int(length))
 		}
 		return Time, nil
 	case "timestamp":
@@ -445,6 +448,8 @@ func ColumnTypeToType(ct *sqlparser.ColumnType) (sql.Type, error) {
 			return nil, sql.ErrInvalidColTypeDefinition.New(ct.String(), fmt.Sprintf("VECTOR dimension must be between 1 and %d", MaxVectorDimensions))
 		}
 		return CreateVectorType(int(dimensions))
+	case sqlparser.UuidStr:
+		return Uuid, nil
 	default:
 		return nil, fmt.Errorf("unknown type: %v", ct.Type)
 	}
@@ -761,7 +766,7 @@ func GeneralizeTypes(a, b sql.Type) sql.Type {
 
 // TypeAwareConversion converts a value to a specified type, with awareness of the value's original type. This is
 // necessary because some types, such as EnumType and SetType, are stored as ints and require information from the
-// original type to properly convert to strings.
+// original type to properly convert to strings. TimeType values are formatted with the precision of their original type.
 func TypeAwareConversion(ctx *sql.Context, val interface{}, originalType sql.Type, convertedType sql.Type) (interface{}, sql.ConvertInRange, error) {
 	if val == nil {
 		return nil, sql.InRange, nil
@@ -773,9 +778,66 @@ func TypeAwareConversion(ctx *sql.Context, val interface{}, originalType sql.Typ
 			return nil, sql.InRange, err
 		}
 	}
+	if bitType, ok := originalType.(BitType); ok && IsText(convertedType) {
+		if bv, ok := val.(uint64); ok {
+			val = bitType.Bytes(bv)
+		}
+	}
+	if timeType, ok := originalType.(TimeType); ok && IsText(convertedType) {
+		if ts, ok := val.(Timespan); ok {
+			val = string(ts.appendBytesWithPrecision(nil, timeType.Precision()))
+		}
+	}
 	return convertedType.Convert(ctx, val)
 }
 
+// ConvertColumnValue converts the value |val| of a column from |originalType| to |convertedType|, as when the type of
+// the column is changed. Unlike TypeAwareConversion, strings are also transcoded to the character set of
+// |convertedType|: a string with characters the character set can't represent is an error in strict mode, and
+// otherwise has them replaced with '?', as in MySQL.
+func ConvertColumnValue(ctx *sql.Context, val interface{}, originalType sql.Type, convertedType sql.Type) (interface{}, sql.ConvertInRange, error) {
+	converted, inRange, err := TypeAwareConversion(ctx, val, originalType, convertedType)
+	if err != nil || inRange != sql.InRange {
+		return converted, inRange, err
+	}
+	st, ok := convertedType.(sql.StringType)
+	if !ok || IsBinaryType(st) {
+		return converted, inRange, nil
+	}
+	str, ok := converted.(string)
+	if !ok || !utf8.ValidString(str) {
+		// strings that aren't valid UTF-8 are taken to be encoded in the character set already
+		return converted, inRange, nil
+	}
+	encoder := st.CharacterSet().Encoder()
+	if _, ok := encoder.Encode(encodings.StringToBytes(str)); ok {
+		return converted, inRange, nil
+	}
+	if sql.LoadSqlMode(ctx).Strict() {
+		colName, rowNum := getColumnContext(ctx)
+		return nil, sql.InRange, ErrBadCharsetString.New(formatUnencodableRune(encoder, str), colName, rowNum)
+	}
+	decoded, _ := encoder.Decode(encoder.EncodeReplaceUnknown(encodings.StringToBytes(str)))
+	return string(decoded), inRange, nil
+}
+
+// formatUnencodableRune formats the UTF-8 bytes of the first character of |str| that |encoder| can't encode, as MySQL
+// shows them in errors.
+func formatUnencodableRune(encoder encodings.Encoder, str string) string {
+	for i, r := range str {
+		b := encodings.StringToBytes(str[i : i+utf8.RuneLen(r)])
+		if _, ok := encoder.EncodeRune(b); ok {
+			continue
+		}
+		var sb strings.Builder
+		for _, c := range b {
+			sb.WriteString(fmt.Sprintf(invalidByteFormat, c))
+		}
+		return sb.String()
+	}
+	return fallbackInvalidByte
+}
+
 // ConvertOrTruncate converts the value |i| to type |t| and returns the converted value; if the value does not convert
 // cleanly and the type is automatically coerced (i.e. string and numeric types), then a warning is logged and the
 // value is truncated to the Zero value for type |t|. If the value does not convert and the type is not automatically
@@ -83,12 +83,12 @@ func TestColumnTypeToType_Time(t *testing.T) {
 		err      bool
 	}{
 		{"", Time, false},
-		{"0", nil, true},
-		{"1", nil, true},
-		{"2", nil, true},
-		{"3", nil, true},
-		{"4", nil, true},
-		{"5", nil, true},
+		{"0", MustCreateTimeType(0), false},
+		{"1", MustCreateTimeType(1), false},
+		{"2", MustCreateTimeType(2), false},
+		{"3", MustCreateTimeType(3), false},
+		{"4", MustCreateTimeType(4), false},
+		{"5", MustCreateTimeType(5), false},
 		{"6", Time, false},
 		{"7", nil, true},
 	}
@@ -26,6 +26,7 @@ import (
 	"unicode"
 
 	"github.com/cockroachdb/apd/v3"
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 	"gopkg.in/src-d/go-errors.v1"
@@ -71,6 +72,163 @@ func IsZeroTimeStr(time string) bool {
 	return strings.HasPrefix("00:00:00.000000", time)
 }
 
+// datePartsRegex matches the year, month and day at the start of a date or datetime string.
+var datePartsRegex = regexp.MustCompile(`^\s*(\d{1,4})[-/.](\d{1,2})[-/.](\d{1,2})(?:$|\D)`)
+
+// dateParts returns the year, month and day written at the start of |s|, whether or not they form a real date.
+func dateParts(s string) (year, month, day int, ok bool) {
+	match := datePartsRegex.FindStringSubmatch(s)
+	if match == nil {
+		return 0, 0, 0, false
+	}
+	year, _ = strconv.Atoi(match[1])
+	month, _ = strconv.Atoi(match[2])
+	day, _ = strconv.Atoi(match[3])
+	return year, month, day, true
+}
+
+// IsZeroInDateStr returns whether |s| is a date with a zero month or day, such as '2020-00-15', other than the zero
+// date itself.
+func IsZeroInDateStr(s string) bool {
+	year, month, day, ok := dateParts(s)
+	if !ok || (year == 0 && month == 0 && day == 0) {
+		return false
+	}
+	return (month == 0 || day == 0) && month <= 12 && day <= 31
+}
+
+// IsInvalidDateStr returns whether |s| is a date whose day is within 1 to 31 but past the end of its month, such as
+// '2020-02-30'.
+func IsInvalidDateStr(s string) bool {
+	year, month, day, ok := dateParts(s)
+	if !ok || month < 1 || month > 12 || day < 1 || day > 31 {
+		return false
+	}
+	return day > time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// datetimePartsRegex matches the year, month, day and any time of day at the start of a date or datetime string.
+var datetimePartsRegex = regexp.MustCompile(`^\s*(\d{1,4})[-/.](\d{1,2})[-/.](\d{1,2})(?:[ T](\d{1,2}):(\d{1,2}):(\d{1,2})(?:\.(\d{1,6}))?)?(?:$|\D)`)
+
+// DateParts is a DATE or DATETIME value kept as the parts it was written with, for the dates that the sql_mode allows
+// but a time.Time can't hold: those with a zero month or day, such as '2020-00-15', and those with a day past the end
+// of their month, such as '2020-02-30'.
+type DateParts struct {
+	Year, Month, Day                  int
+	Hour, Minute, Second, Microsecond int
+	// HasTime is set for DATETIME values, which are written with their time of day
+	HasTime bool
+}
+
+// ParseDateParts returns the DateParts written in |s|, with the time of day when |hasTime| is set. It returns false if
+// |s| doesn't start with a date.
+func ParseDateParts(s string, hasTime bool) (DateParts, bool) {
+	match := datetimePartsRegex.FindStringSubmatch(s)
+	if match == nil {
+		return DateParts{}, false
+	}
+	var parts [7]int
+	for i, m := range match[1:] {
+		if i == 6 && len(m) > 0 {
+			// fractional seconds are written as microseconds
+			m += strings.Repeat("0", 6-len(m))
+		}
+		parts[i], _ = strconv.Atoi(m)
+	}
+	if !hasTime {
+		return DateParts{Year: parts[0], Month: parts[1], Day: parts[2]}, true
+	}
+	return DateParts{
+		Year:        parts[0],
+		Month:       parts[1],
+		Day:         parts[2],
+		Hour:        parts[3],
+		Minute:      parts[4],
+		Second:      parts[5],
+		Microsecond: parts[6],
+		HasTime:     true,
+	}, true
+}
+
+// IsDatePartsValue returns whether |v| is DateParts, or a string with a date that a time.Time can't hold, which are
+// compared by their parts.
+func IsDatePartsValue(v interface{}) bool {
+	switch v := v.(type) {
+	case DateParts:
+		return true
+	case string:
+		return IsZeroInDateStr(v) || IsInvalidDateStr(v)
+	}
+	return false
+}
+
+// DatePartsOf returns the parts of the time |t|, with its time of day when |hasTime| is set.
+func DatePartsOf(t time.Time, hasTime bool) DateParts {
+	if t.Equal(ZeroTime) {
+		return DateParts{HasTime: hasTime}
+	}
+	parts := DateParts{Year: t.Year(), Month: int(t.Month()), Day: t.Day(), HasTime: hasTime}
+	if hasTime {
+		parts.Hour, parts.Minute, parts.Second = t.Clock()
+		parts.Microsecond = t.Nanosecond() / int(time.Microsecond)
+	}
+	return parts
+}
+
+// Time returns |d| as a time.Time, with a day past the end of its month read as that many days into the next month, as
+// MySQL reads it in date arithmetic: '2020-02-31' is read as '2020-03-02'. It returns false if |d| has a zero month or
+// day, which can't be read as a time.
+func (d DateParts) Time() (time.Time, bool) {
+	if d.Month == 0 || d.Day == 0 {
+		return time.Time{}, false
+	}
+	return time.Date(d.Year, time.Month(d.Month), d.Day, d.Hour, d.Minute, d.Second, d.Microsecond*int(time.Microsecond), time.UTC), true
+}
+
+// Compare returns -1, 0 or 1 as |d| is before, the same as or after |other|.
+func (d DateParts) Compare(other DateParts) int {
+	a := [...]int{d.Year, d.Month, d.Day, d.Hour, d.Minute, d.Second, d.Microsecond}
+	b := [...]int{other.Year, other.Month, other.Day, other.Hour, other.Minute, other.Second, other.Microsecond}
+	for i := range a {
+		if a[i] < b[i] {
+			return -1
+		} else if a[i] > b[i] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// String returns |d| as MySQL writes it, such as '2020-02-30' or '2020-00-15 10:30:00'.
+func (d DateParts) String() string {
+	precision := 0
+	if d.Microsecond != 0 {
+		precision = MaxDatetimePrecision
+	}
+	return string(d.appendFormat(nil, precision))
+}
+
+// appendFormat appends |d| to |dest|, with the fractional seconds of a DATETIME written to |precision| digits.
+func (d DateParts) appendFormat(dest []byte, precision int) []byte {
+	dest = append(dest,
+		'0'+byte(d.Year/1000),
+		'0'+byte(d.Year/100%10),
+		'0'+byte(d.Year/10%10),
+		'0'+byte(d.Year%10),
+		'-',
+		'0'+byte(d.Month/10),
+		'0'+byte(d.Month%10),
+		'-',
+		'0'+byte(d.Day/10),
+		'0'+byte(d.Day%10),
+	)
+	if !d.HasTime {
+		return dest
+	}
+	dest = append(dest, ' ')
+	return appendTimeFormat(dest, int64(d.Hour), int64(d.Minute), int64(d.Second), int64(d.Microsecond), precision)
+}
+
 const MinDatetimeStringLength = 8 // length of "2000-1-1"
 
 const MaxDatetimePrecision = 6
@@ -163,6 +321,7 @@ type datetimeType struct {
 
 var _ sql.DatetimeType = datetimeType{}
 var _ sql.CollationCoercible = datetimeType{}
+var _ sql.RangeKeyType = datetimeType{}
 
 // CreateDatetimeType creates a Type dealing with all temporal types that are not TIME nor YEAR.
 func CreateDatetimeType(baseType query.Type, precision int) (sql.DatetimeType, error) {
@@ -198,6 +357,18 @@ func (t datetimeType) Compare(ctx context.Context, a interface{}, b interface{})
 		return res, nil
 	}
 
+	if IsDatePartsValue(a) || IsDatePartsValue(b) {
+		ap, err := t.toDateParts(ctx, a)
+		if err != nil {
+			return 0, err
+		}
+		bp, err := t.toDateParts(ctx, b)
+		if err != nil {
+			return 0, err
+		}
+		return ap.Compare(bp), nil
+	}
+
 	var at time.Time
 	var bt time.Time
 	var ok bool
@@ -228,6 +399,44 @@ func (t datetimeType) Compare(ctx context.Context, a interface{}, b interface{})
 	return 0, nil
 }
 
+// toDateParts returns |v| as the DateParts of a value of this type.
+func (t datetimeType) toDateParts(ctx context.Context, v interface{}) (DateParts, error) {
+	hasTime := t.baseType != sqltypes.Date
+	var str string
+	switch v := v.(type) {
+	case DateParts:
+		if !hasTime {
+			return DateParts{Year: v.Year, Month: v.Month, Day: v.Day}, nil
+		}
+		v.HasTime = true
+		return v, nil
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	}
+	if IsZeroInDateStr(str) || IsInvalidDateStr(str) {
+		if parts, ok := ParseDateParts(str, hasTime); ok {
+			return parts, nil
+		}
+	}
+	res, err := ConvertToTime(ctx, v, t)
+	if err != nil {
+		return DateParts{}, err
+	}
+	return DatePartsOf(res, hasTime), nil
+}
+
+// ConvertRangeKey implements the sql.RangeKeyType interface. Keys with a date that a time.Time can't hold are
+// converted to their DateParts, which is how they're compared with the values of DATE and DATETIME columns.
+func (t datetimeType) ConvertRangeKey(ctx context.Context, key interface{}) (interface{}, sql.ConvertInRange, error) {
+	if t.baseType != sqltypes.Timestamp && IsDatePartsValue(key) {
+		parts, err := t.toDateParts(ctx, key)
+		return parts, sql.InRange, err
+	}
+	return t.Convert(ctx, key)
+}
+
 // CompareValue implements the ValueType interface
 func (t datetimeType) CompareValue(ctx *sql.Context, a, b sql.Value) (int, error) {
 	panic("TODO: implement CompareValue for DatetimeType")
@@ -238,6 +447,10 @@ func (t datetimeType) Convert(ctx context.Context, v interface{}) (interface{},
 	if v == nil {
 		return nil, sql.InRange, nil
 	}
+	if _, ok := v.(DateParts); ok && t.baseType != sqltypes.Timestamp {
+		parts, err := t.toDateParts(ctx, v)
+		return parts, sql.InRange, err
+	}
 	res, err := ConvertToTime(ctx, v, t)
 	if err != nil && !sql.ErrTruncatedIncorrect.Is(err) {
 		return nil, sql.InRange, err
@@ -265,12 +478,17 @@ func ConvertToTime(ctx context.Context, v interface{}, t datetimeType) (time.Tim
 		return ZeroTime, nil
 	}
 
-	// Round the date to the precision of this type
+	// Round the date to the precision of this type, or truncate it when TIME_TRUNCATE_FRACTIONAL is enabled
+	truncationDuration := time.Microsecond
 	if t.precision < MaxDatetimePrecision {
-		truncationDuration := time.Second / time.Duration(precisionConversion[t.precision])
-		res = res.Round(truncationDuration)
-	} else {
-		res = res.Round(time.Microsecond)
+		truncationDuration = time.Second / time.Duration(precisionConversion[t.precision])
+	}
+	if res.Nanosecond()%int(truncationDuration) != 0 {
+		if truncatesFractionalSeconds(ctx) {
+			res = res.Truncate(truncationDuration)
+		} else {
+			res = res.Round(truncationDuration)
+		}
 	}
 
 	if t == DatetimeMaxRange {
@@ -299,6 +517,55 @@ func ConvertToTime(ctx context.Context, v interface{}, t datetimeType) (time.Tim
 	return res, err
 }
 
+// ConvertDateForSqlMode applies the NO_ZERO_DATE, NO_ZERO_IN_DATE and ALLOW_INVALID_DATES sql_mode rules to |val|,
+// which was converted to |converted| with |convErr| for the DATE, DATETIME or TIMESTAMP |column|. Dates these modes
+// reject are an error in |strict| mode, and are otherwise stored as the zero date with a warning. Dates these modes
+// allow but a time.Time can't hold, such as '2020-00-15' or '2020-02-30', are stored in DATE and DATETIME columns as
+// their DateParts. The returned error is nil when the returned value should be stored.
+func ConvertDateForSqlMode(ctx *sql.Context, typ sql.Type, val, converted interface{}, convErr error, strict bool, column string, row int64) (interface{}, error) {
+	sqlMode := sql.LoadSqlMode(ctx)
+	typeName := "datetime"
+	if typ.Type() == sqltypes.Date {
+		typeName = "date"
+	}
+
+	if convErr == nil {
+		if t, ok := converted.(time.Time); !ok || !t.Equal(ZeroTime) || !sqlMode.NoZeroDate() {
+			return converted, nil
+		}
+		err := sql.ErrIncorrectDateValueForColumn.New(typeName, val, column, row)
+		if strict {
+			return nil, err
+		}
+		ctx.Warn(mysql.ERTruncatedWrongValue, "%s", err.Error())
+		return converted, nil
+	}
+
+	var str string
+	switch v := val.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	}
+	allowed := IsZeroInDateStr(str) && !sqlMode.NoZeroInDate() || IsInvalidDateStr(str) && sqlMode.AllowInvalidDates()
+	if allowed && typ.Type() != sqltypes.Timestamp {
+		if parts, ok := ParseDateParts(str, typ.Type() == sqltypes.Datetime); ok {
+			return parts, nil
+		}
+	}
+	switch {
+	case strict:
+		return nil, convErr
+	case sql.ErrTruncatedIncorrect.Is(convErr) && converted != nil:
+		// Trailing characters after a date are dropped
+		ctx.Warn(mysql.ERWarnDataTruncated, "%s", ErrDataTruncatedForColumnAtRow.New(column, row).Error())
+		return converted, nil
+	}
+	ctx.Warn(mysql.ERWarnDataTruncated, "%s", ErrDataTruncatedForColumnAtRow.New(column, row).Error())
+	return ZeroTime, nil
+}
+
 // ConvertWithoutRangeCheck converts the parameter to time.Time without checking the range.
 func (t datetimeType) ConvertWithoutRangeCheck(ctx context.Context, v interface{}) (time.Time, error) {
 	var res time.Time
@@ -316,6 +583,10 @@ func (t datetimeType) ConvertWithoutRangeCheck(ctx context.Context, v interface{
 		if IsZeroTimestampStr(value) {
 			return ZeroTime, nil
 		}
+		if _, month, day, ok := dateParts(value); ok && (month > 12 || day > 31 || IsInvalidDateStr(value)) {
+			// Parsing would otherwise drop the last digit of the day and find a different date
+			return ZeroTime, ErrConvertingToTime.New(v)
+		}
 		// TODO: consider not using time.Parse if we want to match MySQL exactly ('2010-06-03 11:22.:.:.:.:' is a valid timestamp)
 		var parsed bool
 		res, parsed, err = parseDatetime(value)
@@ -324,6 +595,8 @@ func (t datetimeType) ConvertWithoutRangeCheck(ctx context.Context, v interface{
 		}
 	case time.Time:
 		res = value.UTC()
+	case DateParts:
+		return ZeroTime, ErrConvertingToTime.New(value)
 	// For most integer values, we just return an error (but MySQL is more lenient for some of these). A special case
 	// is zero values, which are important when converting from postgres defaults.
 	case int:
@@ -463,7 +736,12 @@ func (t datetimeType) MaxTextResponseByteLength(*sql.Context) uint32 {
 	case sqltypes.Date:
 		return uint32(len(sql.DateLayout))
 	case sqltypes.Datetime, sqltypes.Timestamp:
-		return uint32(len(sql.TimestampDatetimeLayout))
+		// 19 characters are required without fractional seconds (i.e. len("2006-01-02 15:04:05")), and each fractional
+		// digit adds one more after the decimal point
+		if t.precision == 0 {
+			return 19
+		}
+		return 20 + uint32(t.precision)
 	default:
 		panic(sql.ErrInvalidBaseType.New(t.baseType.String(), "datetime"))
 	}
@@ -480,6 +758,14 @@ func (t datetimeType) SQL(ctx *sql.Context, dest []byte, v interface{}) (sqltype
 		return sqltypes.NULL, nil
 	}
 
+	if parts, ok := v.(DateParts); ok && t.baseType != sqltypes.Timestamp {
+		parts, err := t.toDateParts(ctx, parts)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
+		return sqltypes.MakeTrusted(t.baseType, parts.appendFormat(dest, t.precision)), nil
+	}
+
 	vt, err := ConvertToTime(ctx, v, t)
 	if err != nil {
 		return sqltypes.Value{}, err
@@ -611,6 +897,117 @@ func (t datetimeType) MinimumTime() time.Time {
 	return datetimeTypeMinDatetime
 }
 
+// NumberToDatetime reads the number |v| as a date written in one of the numeric forms MySQL accepts, YYYYMMDD,
+// YYMMDD, YYYYMMDDhhmmss or YYMMDDhhmmss, with any fractional part as microseconds. Two digit years from 70 to 99
+// are in the 1900s and the rest are in the 2000s. It returns false if |v| isn't a number written as a valid date.
+func NumberToDatetime(v interface{}) (time.Time, bool) {
+	var nr int64
+	var micros int64
+	switch n := v.(type) {
+	case int8:
+		nr = int64(n)
+	case int16:
+		nr = int64(n)
+	case int32:
+		nr = int64(n)
+	case int64:
+		nr = n
+	case int:
+		nr = int64(n)
+	case uint8:
+		nr = int64(n)
+	case uint16:
+		nr = int64(n)
+	case uint32:
+		nr = int64(n)
+	case uint:
+		return NumberToDatetime(uint64(n))
+	case uint64:
+		if n > math.MaxInt64 {
+			return time.Time{}, false
+		}
+		nr = int64(n)
+	case float32:
+		return NumberToDatetime(float64(n))
+	case float64:
+		if n < 0 || n > math.MaxInt64 {
+			return time.Time{}, false
+		}
+		integer, frac := math.Modf(n)
+		nr, micros = int64(integer), int64(math.Round(frac*1e6))
+	case *apd.Decimal:
+		var integer, frac apd.Decimal
+		n.Modf(&integer, &frac)
+		i, err := integer.Int64()
+		if err != nil {
+			return time.Time{}, false
+		}
+		if _, err := sql.DecimalCtx.Mul(&frac, &frac, apd.New(1, 6)); err != nil {
+			return time.Time{}, false
+		}
+		f, err := frac.Float64()
+		if err != nil {
+			return time.Time{}, false
+		}
+		nr, micros = i, int64(math.Round(f))
+	default:
+		return time.Time{}, false
+	}
+
+	switch {
+	case nr == 0 && micros == 0:
+		return ZeroTime, true
+	case nr < 101:
+		return time.Time{}, false
+	case nr <= 691231:
+		nr = (nr + 20000000) * 1000000
+	case nr < 700101:
+		return time.Time{}, false
+	case nr <= 991231:
+		nr = (nr + 19000000) * 1000000
+	case nr < 10000101:
+		return time.Time{}, false
+	case nr <= 99991231:
+		nr = nr * 1000000
+	case nr < 101000000:
+		return time.Time{}, false
+	case nr <= 691231235959:
+		nr = nr + 20000000000000
+	case nr < 700101000000:
+		return time.Time{}, false
+	case nr <= 991231235959:
+		nr = nr + 19000000000000
+	case nr > 99991231235959:
+		return time.Time{}, false
+	}
+
+	date, clock := nr/1000000, nr%1000000
+	year, month, day := int(date/10000), int(date/100%100), int(date%100)
+	hour, minute, second := int(clock/10000), int(clock/100%100), int(clock%100)
+	if month < 1 || month > 12 || day < 1 || hour > 23 || minute > 59 || second > 59 || micros < 0 || micros > 999999 {
+		return time.Time{}, false
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, second, int(micros)*int(time.Microsecond), time.UTC)
+	if t.Day() != day {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// DatetimeToFloat returns the number that MySQL uses for |t| of the DATE, DATETIME or TIMESTAMP type |typ| in a
+// numeric context, such as 20200101 for a DATE or 20200101103000.5 for a DATETIME.
+func DatetimeToFloat(t time.Time, typ sql.Type) float64 {
+	if t.Equal(ZeroTime) {
+		return 0
+	}
+	date := float64(t.Year()*10000 + int(t.Month())*100 + t.Day())
+	if typ.Type() == sqltypes.Date {
+		return date
+	}
+	clock := float64(t.Hour()*10000 + t.Minute()*100 + t.Second())
+	return date*1000000 + clock + float64(t.Nanosecond()/int(time.Microsecond))/1e6
+}
+
 // ValidateTime receives a time and returns either that time or nil if it's
 // not a valid time.
 func ValidateTime(t time.Time) interface{} {
@@ -414,6 +414,96 @@ func TestDatetimeString(t *testing.T) {
 	}
 }
 
+func TestZeroAndInvalidDateStr(t *testing.T) {
+	tests := []struct {
+		val         string
+		zeroInDate  bool
+		invalidDate bool
+	}{
+		{"0000-00-00", false, false},
+		{"0000-00-00 00:00:00", false, false},
+		{"2020-00-15", true, false},
+		{"2020-01-00 10:00:00", true, false},
+		{"2020-02-30", false, true},
+		{"2021-02-29", false, true},
+		{"2020-02-29", false, false},
+		{"2020-04-31 10:00:00", false, true},
+		{"2020-13-01", false, false},
+		{"2020-02-32", false, false},
+		{"garbage", false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.val, func(t *testing.T) {
+			assert.Equal(t, test.zeroInDate, IsZeroInDateStr(test.val))
+			assert.Equal(t, test.invalidDate, IsInvalidDateStr(test.val))
+		})
+	}
+}
+
+func TestDateParts(t *testing.T) {
+	tests := []struct {
+		val      string
+		typ      sql.Type
+		expected string
+	}{
+		{"2020-00-15", Date, "2020-00-15"},
+		{"2020-00-15 10:30:00", Date, "2020-00-15"},
+		{"2020-02-30", Datetime, "2020-02-30 00:00:00"},
+		{"2020-02-30 10:30:05.25", Datetime, "2020-02-30 10:30:05"},
+		{"2020-02-30 10:30:05.25", Datetime3, "2020-02-30 10:30:05.250"},
+		{"0999-04-31", Date, "0999-04-31"},
+	}
+
+	ctx := sql.NewEmptyContext()
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			parts, ok := ParseDateParts(test.val, test.typ.Type() == sqltypes.Datetime)
+			require.True(t, ok)
+			val, err := test.typ.SQL(ctx, nil, parts)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, val.ToString())
+
+			cmp, err := test.typ.Compare(ctx, parts, test.val)
+			require.NoError(t, err)
+			assert.Equal(t, 0, cmp)
+			cmp, err = test.typ.Compare(ctx, parts, "2020-03-01")
+			require.NoError(t, err)
+			assert.Equal(t, -1, cmp)
+		})
+	}
+}
+
+func TestNumberToDatetime(t *testing.T) {
+	tests := []struct {
+		val      interface{}
+		expected time.Time
+		ok       bool
+	}{
+		{int64(0), ZeroTime, true},
+		{int64(20200101), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{int64(200101), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{int64(991231), time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC), true},
+		{int64(20200101123456), time.Date(2020, 1, 1, 12, 34, 56, 0, time.UTC), true},
+		{uint32(20200229), time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), true},
+		{float64(20200101123456.5), time.Date(2020, 1, 1, 12, 34, 56, 500000000, time.UTC), true},
+		{int64(2), time.Time{}, false},
+		{int64(20201301), time.Time{}, false},
+		{int64(-20200101), time.Time{}, false},
+		{"20200101", time.Time{}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v", test.val), func(t *testing.T) {
+			res, ok := NumberToDatetime(test.val)
+			require.Equal(t, test.ok, ok)
+			if ok {
+				assert.True(t, test.expected.Equal(res), "expected %v, got %v", test.expected, res)
+			}
+		})
+	}
+}
+
 func TestDatetimeZero(t *testing.T) {
 	_, ok := MustCreateDatetimeType(sqltypes.Date, 0).Zero().(time.Time)
 	require.True(t, ok)
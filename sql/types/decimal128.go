@@ -0,0 +1,238 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/cockroachdb/apd/v3"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// decimal128MaxScale is the largest scale a Decimal128 may have. It's the number of decimal digits that always fit in
+// 128 bits, so that any two values may be rescaled to the same scale without overflowing on the scale alone.
+const decimal128MaxScale = 38
+
+// decimal128Pow10 holds the powers of ten that fit in 128 bits, as {hi, lo} pairs.
+var decimal128Pow10 [decimal128MaxScale + 1][2]uint64
+
+func init() {
+	decimal128Pow10[0] = [2]uint64{0, 1}
+	for i := 1; i < len(decimal128Pow10); i++ {
+		hi, lo := bits.Mul64(decimal128Pow10[i-1][1], 10)
+		decimal128Pow10[i] = [2]uint64{decimal128Pow10[i-1][0]*10 + hi, lo}
+	}
+}
+
+// Decimal128 is a fixed-point decimal whose coefficient is held in 128 bits. It's used as a fast path for the
+// arithmetic of *apd.Decimal values, which are allocated and computed with arbitrary precision. Operations report
+// whether their result fits, and callers fall back to *apd.Decimal when it doesn't. The value of a Decimal128 is
+// (hi<<64 | lo) * 10^-scale, negated if neg is set.
+type Decimal128 struct {
+	hi    uint64
+	lo    uint64
+	scale int32
+	neg   bool
+}
+
+// NewDecimal128 returns the Decimal128 of the given decimal, or false if it's not a finite value whose coefficient
+// fits in 128 bits. Decimals with a positive exponent are not converted, so results keep the exponents that
+// *apd.Decimal arithmetic would give them.
+func NewDecimal128(d *apd.Decimal) (Decimal128, bool) {
+	if d.Form != apd.Finite || d.Exponent > 0 || d.Exponent < -decimal128MaxScale {
+		return Decimal128{}, false
+	}
+	res := Decimal128{scale: -d.Exponent}
+	if d.Coeff.IsUint64() {
+		res.lo = d.Coeff.Uint64()
+	} else {
+		if d.Coeff.BitLen() > 128 {
+			return Decimal128{}, false
+		}
+		for i, w := range d.Coeff.Bits() {
+			shift := uint(i * bits.UintSize)
+			if shift < 64 {
+				res.lo |= uint64(w) << shift
+			} else {
+				res.hi |= uint64(w) << (shift - 64)
+			}
+		}
+	}
+	res.neg = d.Negative && !res.isZero()
+	return res, true
+}
+
+// Decimal returns the value as an *apd.Decimal.
+func (d Decimal128) Decimal() *apd.Decimal {
+	res := new(apd.Decimal)
+	if d.hi == 0 {
+		res.Coeff.SetUint64(d.lo)
+	} else {
+		coeff := new(big.Int).SetUint64(d.hi)
+		coeff.Lsh(coeff, 64).Or(coeff, new(big.Int).SetUint64(d.lo))
+		res.Coeff.SetMathBigInt(coeff)
+	}
+	res.Exponent = -d.scale
+	res.Negative = d.neg
+	return res
+}
+
+// Add returns d + o, or false if the result doesn't fit.
+func (d Decimal128) Add(o Decimal128) (Decimal128, bool) {
+	d, o, ok := d.rescale(o)
+	if !ok {
+		return Decimal128{}, false
+	}
+	if d.neg == o.neg {
+		lo, carry := bits.Add64(d.lo, o.lo, 0)
+		hi, carry := bits.Add64(d.hi, o.hi, carry)
+		if carry != 0 {
+			return Decimal128{}, false
+		}
+		return Decimal128{hi: hi, lo: lo, scale: d.scale, neg: d.neg}, true
+	}
+	// the signs differ, so the magnitude of the result is the difference of the magnitudes
+	if d.cmpAbs(o) < 0 {
+		d, o = o, d
+	}
+	lo, borrow := bits.Sub64(d.lo, o.lo, 0)
+	hi, _ := bits.Sub64(d.hi, o.hi, borrow)
+	res := Decimal128{hi: hi, lo: lo, scale: d.scale, neg: d.neg}
+	res.neg = res.neg && !res.isZero()
+	return res, true
+}
+
+// Sub returns d - o, or false if the result doesn't fit.
+func (d Decimal128) Sub(o Decimal128) (Decimal128, bool) {
+	o.neg = !o.neg && !o.isZero()
+	return d.Add(o)
+}
+
+// Mul returns d * o, or false if the result doesn't fit.
+func (d Decimal128) Mul(o Decimal128) (Decimal128, bool) {
+	scale := d.scale + o.scale
+	if scale > decimal128MaxScale {
+		return Decimal128{}, false
+	}
+	if d.hi != 0 && o.hi != 0 {
+		return Decimal128{}, false
+	}
+	if d.hi != 0 {
+		d, o = o, d
+	}
+	// d fits in 64 bits, so the product is d.lo*o.lo + (d.lo*o.hi)<<64
+	hi, lo := bits.Mul64(d.lo, o.lo)
+	crossHi, crossLo := bits.Mul64(d.lo, o.hi)
+	if crossHi != 0 {
+		return Decimal128{}, false
+	}
+	hi, carry := bits.Add64(hi, crossLo, 0)
+	if carry != 0 {
+		return Decimal128{}, false
+	}
+	res := Decimal128{hi: hi, lo: lo, scale: scale, neg: d.neg != o.neg}
+	res.neg = res.neg && !res.isZero()
+	return res, true
+}
+
+// rescale returns d and o with the larger of their scales, or false if a coefficient doesn't fit.
+func (d Decimal128) rescale(o Decimal128) (Decimal128, Decimal128, bool) {
+	var ok bool
+	if d.scale < o.scale {
+		d, ok = d.mulPow10(o.scale - d.scale)
+	} else if o.scale < d.scale {
+		o, ok = o.mulPow10(d.scale - o.scale)
+	} else {
+		ok = true
+	}
+	return d, o, ok
+}
+
+// mulPow10 returns d with its coefficient multiplied by 10^n and its scale increased by n.
+func (d Decimal128) mulPow10(n int32) (Decimal128, bool) {
+	pow := decimal128Pow10[n]
+	res, ok := Decimal128{hi: d.hi, lo: d.lo}.Mul(Decimal128{hi: pow[0], lo: pow[1]})
+	if !ok {
+		return Decimal128{}, false
+	}
+	res.scale = d.scale + n
+	res.neg = d.neg
+	return res, true
+}
+
+func (d Decimal128) isZero() bool {
+	return d.hi == 0 && d.lo == 0
+}
+
+// cmpAbs compares the magnitudes of d and o, which must have the same scale.
+func (d Decimal128) cmpAbs(o Decimal128) int {
+	switch {
+	case d.hi != o.hi:
+		if d.hi < o.hi {
+			return -1
+		}
+		return 1
+	case d.lo != o.lo:
+		if d.lo < o.lo {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// DecimalAdd returns a + b. Operands whose coefficients fit in 128 bits are added without arbitrary precision.
+func DecimalAdd(a, b *apd.Decimal) (*apd.Decimal, error) {
+	if x, ok := NewDecimal128(a); ok {
+		if y, ok := NewDecimal128(b); ok {
+			if res, ok := x.Add(y); ok {
+				return res.Decimal(), nil
+			}
+		}
+	}
+	res := new(apd.Decimal)
+	_, err := sql.DecimalCtx.Add(res, a, b)
+	return res, err
+}
+
+// DecimalSub returns a - b. Operands whose coefficients fit in 128 bits are subtracted without arbitrary precision.
+func DecimalSub(a, b *apd.Decimal) (*apd.Decimal, error) {
+	if x, ok := NewDecimal128(a); ok {
+		if y, ok := NewDecimal128(b); ok {
+			if res, ok := x.Sub(y); ok {
+				return res.Decimal(), nil
+			}
+		}
+	}
+	res := new(apd.Decimal)
+	_, err := sql.DecimalCtx.Sub(res, a, b)
+	return res, err
+}
+
+// DecimalMul returns a * b. Operands whose product fits in 128 bits are multiplied without arbitrary precision.
+func DecimalMul(a, b *apd.Decimal) (*apd.Decimal, error) {
+	if x, ok := NewDecimal128(a); ok {
+		if y, ok := NewDecimal128(b); ok {
+			if res, ok := x.Mul(y); ok {
+				return res.Decimal(), nil
+			}
+		}
+	}
+	res := new(apd.Decimal)
+	_, err := sql.DecimalCtx.Mul(res, a, b)
+	return res, err
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDecimal128(t *testing.T) {
+	values := []string{
+		"0", "0.00", "-0.0", "1", "-1", "0.5", "-0.25", "123.456", "-99999.99999", "18446744073709551615",
+		"18446744073709551616", "-18446744073709551617.5", "0.000000000000000000000000000001",
+		"99999999999999999999999999999999999.999", "-170141183460469231731687303715884105727",
+		"340282366920938463463374607431768211455", "340282366920938463463374607431768211456", "1E+2",
+		"12345678901234567890.0123456789",
+	}
+	for _, a := range values {
+		for _, b := range values {
+			x := mustParseDecimal(t, a)
+			y := mustParseDecimal(t, b)
+			t.Run(fmt.Sprintf("%s and %s", a, b), func(t *testing.T) {
+				res, err := DecimalAdd(x, y)
+				require.NoError(t, err)
+				assertDecimalEqual(t, sql.DecimalCtx.Add, x, y, res)
+				res, err = DecimalSub(x, y)
+				require.NoError(t, err)
+				assertDecimalEqual(t, sql.DecimalCtx.Sub, x, y, res)
+				res, err = DecimalMul(x, y)
+				require.NoError(t, err)
+				assertDecimalEqual(t, sql.DecimalCtx.Mul, x, y, res)
+			})
+		}
+	}
+}
+
+func TestDecimal128Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randDecimal := func() *apd.Decimal {
+		var sb strings.Builder
+		if r.Intn(2) == 0 {
+			sb.WriteByte('-')
+		}
+		for i := r.Intn(25) + 1; i > 0; i-- {
+			sb.WriteByte(byte('0' + r.Intn(10)))
+		}
+		if scale := r.Intn(20); scale > 0 {
+			sb.WriteByte('.')
+			for i := 0; i < scale; i++ {
+				sb.WriteByte(byte('0' + r.Intn(10)))
+			}
+		}
+		return mustParseDecimal(t, sb.String())
+	}
+	for i := 0; i < 10000; i++ {
+		x, y := randDecimal(), randDecimal()
+		res, err := DecimalAdd(x, y)
+		require.NoError(t, err)
+		assertDecimalEqual(t, sql.DecimalCtx.Add, x, y, res)
+		res, err = DecimalMul(x, y)
+		require.NoError(t, err)
+		assertDecimalEqual(t, sql.DecimalCtx.Mul, x, y, res)
+	}
+}
+
+func TestNewDecimal128(t *testing.T) {
+	for _, s := range []string{"1E+2", "NaN", "Infinity", "340282366920938463463374607431768211456", "1E-39"} {
+		_, ok := NewDecimal128(mustParseDecimal(t, s))
+		assert.False(t, ok, s)
+	}
+	d, ok := NewDecimal128(mustParseDecimal(t, "-340282366920938463463374607431768211455.0"))
+	require.False(t, ok)
+	d, ok = NewDecimal128(mustParseDecimal(t, "-34028236692093846346337460743176821145.5"))
+	require.True(t, ok)
+	assert.Equal(t, "-34028236692093846346337460743176821145.5", d.Decimal().String())
+}
+
+func BenchmarkDecimalSum(b *testing.B) {
+	values := make([]*apd.Decimal, 1024)
+	for i := range values {
+		values[i] = apd.New(int64(i*7919)-3000000, -2)
+	}
+	b.Run("apd", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := apd.New(0, 0)
+			for _, v := range values {
+				_, _ = sql.DecimalCtx.Add(sum, sum, v)
+			}
+		}
+	})
+	b.Run("Decimal128", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var sum Decimal128
+			for _, v := range values {
+				d, _ := NewDecimal128(v)
+				sum, _ = sum.Add(d)
+			}
+			_ = sum.Decimal()
+		}
+	})
+}
+
+func mustParseDecimal(t *testing.T, s string) *apd.Decimal {
+	d, _, err := apd.NewFromString(s)
+	require.NoError(t, err)
+	return d
+}
+
+// assertDecimalEqual asserts that |res| is the result of |op| on |x| and |y|, with the same exponent
+func assertDecimalEqual(t *testing.T, op func(d, x, y *apd.Decimal) (apd.Condition, error), x, y, res *apd.Decimal) {
+	expected := new(apd.Decimal)
+	_, err := op(expected, x, y)
+	require.NoError(t, err)
+	if expected.IsZero() {
+		// the sign of a zero result isn't kept
+		expected.Negative = false
+		res = new(apd.Decimal).Set(res)
+		res.Negative = false
+	}
+	assert.Equal(t, expected.String(), res.String(), "%s and %s", x, y)
+}
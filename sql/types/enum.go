@@ -38,11 +38,18 @@ const (
 	// EnumTypeMaxElements returns the maximum number of enumerations for the Enum type.
 	EnumTypeMaxElements = 65535
 	// / An ENUM column can have a maximum of 65,535 distinct elements.
+	// EnumTypeMaxValueLength is the maximum number of characters in an element of an Enum or Set type.
+	EnumTypeMaxValueLength = 255
 )
 
 var (
 	ErrConvertingToEnum = errors.NewKind("value %v is not valid for this Enum")
 
+	// ErrTooManyEnumValues is returned when an Enum type is defined with more than EnumTypeMaxElements elements.
+	ErrTooManyEnumValues = errors.NewKind("number of values is too large")
+	// ErrEnumValueTooLong is returned when an element of an Enum or Set type is longer than EnumTypeMaxValueLength.
+	ErrEnumValueTooLong = errors.NewKind("value %s is too long")
+
 	ErrDataTruncatedForColumn      = errors.NewKind("Data truncated for column '%s'")
 	ErrDataTruncatedForColumnAtRow = errors.NewKind("Data truncated for column '%s' at row %d")
 
@@ -67,7 +74,7 @@ func CreateEnumType(values []string, collation sql.CollationID) (sql.EnumType, e
 		return nil, fmt.Errorf("number of values may not be zero")
 	}
 	if len(values) > EnumTypeMaxElements {
-		return nil, fmt.Errorf("number of values is too large")
+		return nil, ErrTooManyEnumValues.New()
 	}
 
 	// maxResponseByteLength for an enum type is the bytes required to send back the largest enum value,
@@ -83,6 +90,9 @@ func CreateEnumType(values []string, collation sql.CollationID) (sql.EnumType, e
 			value = strings.TrimRight(value, " ")
 		}
 		values[i] = value
+		if utf8.RuneCountInString(value) > EnumTypeMaxValueLength {
+			return nil, ErrEnumValueTooLong.New(value)
+		}
 		hashedVal, err := collation.HashToUint(value)
 		if err != nil {
 			return nil, err
@@ -255,7 +265,7 @@ func (t EnumType) SQL(ctx *sql.Context, dest []byte, v interface{}) (sqltypes.Va
 	if resultCharset == sql.CharacterSet_Unspecified || resultCharset == sql.CharacterSet_binary {
 		resultCharset = t.collation.CharacterSet()
 	}
-	encodedBytes, ok := resultCharset.Encoder().Encode(encodings.StringToBytes(value))
+	encodedBytes, ok := encodeResult(resultCharset, encodings.StringToBytes(value))
 	if !ok {
 		snippet := value
 		if len(snippet) > 50 {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -108,7 +109,10 @@ func TestEnumCreateTooLarge(t *testing.T) {
 		vals[i] = strconv.Itoa(i)
 	}
 	_, err := CreateEnumType(vals, sql.Collation_Default)
-	require.Error(t, err)
+	require.True(t, ErrTooManyEnumValues.Is(err))
+
+	_, err = CreateEnumType([]string{"a", strings.Repeat("b", 256)}, sql.Collation_Default)
+	require.True(t, ErrEnumValueTooLong.Is(err))
 }
 
 func TestEnumConvert(t *testing.T) {
@@ -329,6 +329,11 @@ func writeMarshalledValue(writer io.Writer, val interface{}) error {
 		writer.Write([]byte(val.Format(sql.DatetimeLayoutNoTrim)))
 		writer.Write([]byte{'"'})
 		return nil
+	case DateParts:
+		writer.Write([]byte{'"'})
+		writer.Write(val.appendFormat(nil, MaxDatetimePrecision))
+		writer.Write([]byte{'"'})
+		return nil
 	case *apd.Decimal:
 		writer.Write([]byte(val.Text('f')))
 		return nil
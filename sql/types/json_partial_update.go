@@ -0,0 +1,367 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// JSONPartialUpdate is a MutableJSON that records the edits made to a document as a list of sql.JSONDiff, rather than
+// copying the document and editing the copy. The updated document is only built once its value is needed, so that an
+// UPDATE of a large document that only sets a few of its members can hand the diffs to a sql.JSONUpdater without the
+// engine copying or serializing the document. Edits that can't be described as diffs, such as ones that wrap a value
+// in an array, are applied to the updated document, and the diffs are dropped from then on.
+type JSONPartialUpdate struct {
+	base sql.JSONWrapper
+	// doc is the updated document, which is nil until it's built.
+	doc   MutableJSON
+	diffs []sql.JSONDiff
+	// steps are the steps of the paths of the diffs, to tell whether a later edit depends on them.
+	steps [][]jsonPathStep
+	// full is whether an edit couldn't be described as a diff, so that the document must be written in full.
+	full bool
+}
+
+var _ MutableJSON = &JSONPartialUpdate{}
+var _ fmt.Stringer = &JSONPartialUpdate{}
+var _ driver.Valuer = &JSONPartialUpdate{}
+
+// NewJSONPartialUpdate returns a JSONPartialUpdate of the document |base|, which is never modified.
+func NewJSONPartialUpdate(base sql.JSONWrapper) *JSONPartialUpdate {
+	return &JSONPartialUpdate{base: base}
+}
+
+// DiffsFrom returns the edits that turn |old| into this document, or false if the document wasn't edited from |old|
+// or some of its edits can't be described as diffs.
+func (j *JSONPartialUpdate) DiffsFrom(old interface{}) ([]sql.JSONDiff, bool) {
+	if j.full || !isSameJSONDocument(j.base, old) {
+		return nil, false
+	}
+	return j.diffs, true
+}
+
+// Clone implements sql.JSONWrapper.
+func (j *JSONPartialUpdate) Clone(ctx context.Context) sql.JSONWrapper {
+	if j.full {
+		return j.doc.Clone(ctx)
+	}
+	return &JSONPartialUpdate{
+		base:  j.base,
+		diffs: append([]sql.JSONDiff(nil), j.diffs...),
+		steps: append([][]jsonPathStep(nil), j.steps...),
+	}
+}
+
+// ToInterface implements sql.JSONWrapper.
+func (j *JSONPartialUpdate) ToInterface(ctx context.Context) (interface{}, error) {
+	if err := j.build(ctx); err != nil {
+		return nil, err
+	}
+	return j.doc.ToInterface(ctx)
+}
+
+// Insert implements MutableJSON.
+func (j *JSONPartialUpdate) Insert(ctx context.Context, path string, val sql.JSONWrapper) (MutableJSON, bool, error) {
+	return j.edit(ctx, path, val, INSERT)
+}
+
+// Remove implements MutableJSON.
+func (j *JSONPartialUpdate) Remove(ctx context.Context, path string) (MutableJSON, bool, error) {
+	return j.edit(ctx, path, nil, REMOVE)
+}
+
+// Set implements MutableJSON.
+func (j *JSONPartialUpdate) Set(ctx context.Context, path string, val sql.JSONWrapper) (MutableJSON, bool, error) {
+	return j.edit(ctx, path, val, SET)
+}
+
+// Replace implements MutableJSON.
+func (j *JSONPartialUpdate) Replace(ctx context.Context, path string, val sql.JSONWrapper) (MutableJSON, bool, error) {
+	return j.edit(ctx, path, val, REPLACE)
+}
+
+// ArrayInsert implements MutableJSON.
+func (j *JSONPartialUpdate) ArrayInsert(ctx context.Context, path string, val sql.JSONWrapper) (MutableJSON, bool, error) {
+	return j.editFully(ctx, path, val, ARRAY_INSERT)
+}
+
+// ArrayAppend implements MutableJSON.
+func (j *JSONPartialUpdate) ArrayAppend(ctx context.Context, path string, val sql.JSONWrapper) (MutableJSON, bool, error) {
+	return j.editFully(ctx, path, val, ARRAY_APPEND)
+}
+
+// Value implements driver.Valuer for interoperability with other go libraries
+func (j *JSONPartialUpdate) Value() (driver.Value, error) {
+	return JsonToMySqlString(context.Background(), j)
+}
+
+// JSONPartialUpdate implements the fmt.Stringer interface.
+func (j *JSONPartialUpdate) String() string {
+	s, err := JsonToMySqlString(context.Background(), j)
+	if err != nil {
+		return fmt.Sprintf("error while stringifying JSON: %s", err.Error())
+	}
+	return s
+}
+
+// edit applies the edit of type |mode| at |path|, recording it as a diff when it can be described as one.
+func (j *JSONPartialUpdate) edit(ctx context.Context, path string, val sql.JSONWrapper, mode int) (MutableJSON, bool, error) {
+	if j.full {
+		return j.editFully(ctx, path, val, mode)
+	}
+	diff, steps, ok, err := j.diff(ctx, path, val, mode)
+	if err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		return j.editFully(ctx, path, val, mode)
+	}
+	if steps == nil {
+		// the edit doesn't change the document
+		return j, false, nil
+	}
+
+	if j.doc != nil {
+		if j.doc, err = applyJSONDiff(ctx, j.doc, diff); err != nil {
+			return nil, false, err
+		}
+	}
+	j.diffs = append(j.diffs, diff)
+	j.steps = append(j.steps, steps)
+	return j, true, nil
+}
+
+// editFully applies the edit of type |mode| at |path| to the updated document, after which it can no longer be
+// described by diffs.
+func (j *JSONPartialUpdate) editFully(ctx context.Context, path string, val sql.JSONWrapper, mode int) (MutableJSON, bool, error) {
+	if err := j.build(ctx); err != nil {
+		return nil, false, err
+	}
+	var doc MutableJSON
+	var changed bool
+	var err error
+	switch mode {
+	case SET:
+		doc, changed, err = j.doc.Set(ctx, path, val)
+	case INSERT:
+		doc, changed, err = j.doc.Insert(ctx, path, val)
+	case REPLACE:
+		doc, changed, err = j.doc.Replace(ctx, path, val)
+	case REMOVE:
+		doc, changed, err = j.doc.Remove(ctx, path)
+	case ARRAY_APPEND:
+		doc, changed, err = j.doc.ArrayAppend(ctx, path, val)
+	case ARRAY_INSERT:
+		doc, changed, err = j.doc.ArrayInsert(ctx, path, val)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	j.doc, j.full = doc, true
+	j.diffs, j.steps = nil, nil
+	return j, changed, nil
+}
+
+// diff returns the diff made by the edit of type |mode| at |path|, along with the steps of its path, which are nil if
+// the edit doesn't change the document. It returns false if the edit can't be described as a diff, in which case it
+// must be applied to the whole document to get its result or error.
+func (j *JSONPartialUpdate) diff(ctx context.Context, path string, val sql.JSONWrapper, mode int) (sql.JSONDiff, []jsonPathStep, bool, error) {
+	p, err := ParseJSONPath(strings.TrimSpace(path))
+	if err != nil || len(p.legs) == 0 {
+		return sql.JSONDiff{}, nil, false, nil
+	}
+	for _, leg := range p.legs {
+		if leg.kind != jsonPathMember && leg.kind != jsonPathArrayCell {
+			return sql.JSONDiff{}, nil, false, nil
+		}
+	}
+
+	// An edit that doesn't depend on the edits before it can be resolved against the original document, which saves
+	// building the updated one
+	var doc interface{}
+	if j.doc == nil && j.isIndependent(p) {
+		doc, err = j.base.ToInterface(ctx)
+	} else if err = j.build(ctx); err == nil {
+		doc, err = j.doc.ToInterface(ctx)
+	}
+	if err != nil {
+		return sql.JSONDiff{}, nil, false, err
+	}
+
+	// Paths that reach a value by treating it as an array holding only itself are left to the whole document
+	if _, steps, ok := p.locate(doc); ok {
+		if len(steps) != len(p.legs) {
+			return sql.JSONDiff{}, nil, false, nil
+		}
+		switch mode {
+		case INSERT:
+			return sql.JSONDiff{}, nil, true, nil
+		case REMOVE:
+			return sql.JSONDiff{Type: sql.JSONDiffRemove, Path: formatJSONPath(steps)}, steps, true, nil
+		default:
+			return sql.JSONDiff{Type: sql.JSONDiffReplace, Path: formatJSONPath(steps), Value: val}, steps, true, nil
+		}
+	}
+	if mode == REPLACE || mode == REMOVE {
+		return sql.JSONDiff{}, nil, true, nil
+	}
+
+	parent := &JSONPath{legs: p.legs[:len(p.legs)-1]}
+	parentVal, steps, ok := parent.locate(doc)
+	if !ok || len(steps) != len(parent.legs) {
+		return sql.JSONDiff{}, nil, false, nil
+	}
+	last := p.legs[len(p.legs)-1]
+	switch parentVal := parentVal.(type) {
+	case JsonObject:
+		if last.kind != jsonPathMember {
+			return sql.JSONDiff{}, nil, false, nil
+		}
+		steps = append(steps, memberStep(last.member))
+	case JsonArray:
+		if last.kind != jsonPathArrayCell {
+			return sql.JSONDiff{}, nil, true, nil
+		}
+		// a cell past the end of an array is added at its end
+		if last.from.resolve(len(parentVal)) < len(parentVal) {
+			return sql.JSONDiff{}, nil, false, nil
+		}
+		steps = append(steps, indexStep(len(parentVal)))
+	default:
+		if last.kind != jsonPathMember {
+			return sql.JSONDiff{}, nil, false, nil
+		}
+		return sql.JSONDiff{}, nil, true, nil
+	}
+	return sql.JSONDiff{Type: sql.JSONDiffInsert, Path: formatJSONPath(steps), Value: val}, steps, true, nil
+}
+
+// isIndependent returns whether the value at |p| is unaffected by the diffs made so far, which is the case when its
+// path leads to a different member of an object than each of theirs before reaching an array.
+func (j *JSONPartialUpdate) isIndependent(p *JSONPath) bool {
+	for _, steps := range j.steps {
+		diverges := false
+		for i := 0; i < len(p.legs) && i < len(steps); i++ {
+			if p.legs[i].kind != jsonPathMember || steps[i].index >= 0 {
+				break
+			}
+			if p.legs[i].member != steps[i].member {
+				diverges = true
+				break
+			}
+		}
+		if !diverges {
+			return false
+		}
+	}
+	return true
+}
+
+// build builds the updated document by applying the diffs to a copy of the original one, if it isn't built yet.
+func (j *JSONPartialUpdate) build(ctx context.Context) error {
+	if j.doc != nil {
+		return nil
+	}
+	doc, err := ApplyJSONDiffs(ctx, j.base, j.diffs)
+	if err != nil {
+		return err
+	}
+	j.doc = doc
+	return nil
+}
+
+// ApplyJSONDiffs returns a copy of |doc| with |diffs| applied to it in order. |doc| is left unchanged.
+func ApplyJSONDiffs(ctx context.Context, doc sql.JSONWrapper, diffs []sql.JSONDiff) (MutableJSON, error) {
+	// Clone the document even if it isn't mutable, since some implementations cache the result of ToInterface
+	cloned := doc.Clone(ctx)
+	res, ok := cloned.(MutableJSON)
+	if !ok {
+		val, err := cloned.ToInterface(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res = JSONDocument{Val: val}
+	}
+	for _, diff := range diffs {
+		var err error
+		if res, err = applyJSONDiff(ctx, res, diff); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// applyJSONDiff applies |diff| to |doc|, returning the updated document. The value of the diff is copied, since later
+// edits of the document may modify it.
+func applyJSONDiff(ctx context.Context, doc MutableJSON, diff sql.JSONDiff) (MutableJSON, error) {
+	var err error
+	switch diff.Type {
+	case sql.JSONDiffReplace:
+		doc, _, err = doc.Replace(ctx, diff.Path, diff.Value.Clone(ctx))
+	case sql.JSONDiffInsert:
+		doc, _, err = doc.Insert(ctx, diff.Path, diff.Value.Clone(ctx))
+	case sql.JSONDiffRemove:
+		doc, _, err = doc.Remove(ctx, diff.Path)
+	}
+	return doc, err
+}
+
+// isSameJSONDocument returns whether |a| and |b| hold the same document in memory. Telling this apart doesn't require
+// comparing the documents, which is the work a JSONPartialUpdate saves.
+func isSameJSONDocument(a sql.JSONWrapper, b interface{}) bool {
+	switch a := a.(type) {
+	case JSONDocument:
+		switch b := b.(type) {
+		case JSONDocument:
+			return isSameJSONValue(a.Val, b.Val)
+		case *JSONDocument:
+			return isSameJSONValue(a.Val, b.Val)
+		}
+		return false
+	case *JSONDocument:
+		switch b := b.(type) {
+		case JSONDocument:
+			return isSameJSONValue(a.Val, b.Val)
+		case *JSONDocument:
+			return isSameJSONValue(a.Val, b.Val)
+		}
+		return false
+	}
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	return av.Kind() == reflect.Pointer && av.Type() == bv.Type() && av.Pointer() == bv.Pointer()
+}
+
+// isSameJSONValue returns whether the unwrapped JSON values |a| and |b| are the same value in memory, or are equal
+// scalars.
+func isSameJSONValue(a, b interface{}) bool {
+	switch a := a.(type) {
+	case JsonObject:
+		b, ok := b.(JsonObject)
+		return ok && reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+	case JsonArray:
+		b, ok := b.(JsonArray)
+		return ok && len(a) == len(b) && reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+	}
+	if a == nil || b == nil {
+		return a == b
+	}
+	return reflect.TypeOf(a) == reflect.TypeOf(b) && reflect.TypeOf(a).Comparable() && a == b
+}
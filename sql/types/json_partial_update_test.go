@@ -0,0 +1,210 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+type jsonEdit struct {
+	mode int
+	path string
+	val  string
+}
+
+func TestJSONPartialUpdate(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		edits []jsonEdit
+		want  string
+		diffs []sql.JSONDiff
+		// full is whether the edits can't be described as diffs, so that the document must be written in full
+		full bool
+	}{
+		{
+			name: "replace and insert object members",
+			doc:  `{"a": 1, "b": {"c": 2}}`,
+			edits: []jsonEdit{
+				{mode: SET, path: "$.a", val: `10`},
+				{mode: SET, path: "$.b.d", val: `"x"`},
+				{mode: REPLACE, path: "$.b.c", val: `[3]`},
+			},
+			want: `{"a": 10, "b": {"c": [3], "d": "x"}}`,
+			diffs: []sql.JSONDiff{
+				{Type: sql.JSONDiffReplace, Path: "$.a", Value: MustJSON(`10`)},
+				{Type: sql.JSONDiffInsert, Path: "$.b.d", Value: MustJSON(`"x"`)},
+				{Type: sql.JSONDiffReplace, Path: "$.b.c", Value: MustJSON(`[3]`)},
+			},
+		},
+		{
+			name: "edits that don't change the document",
+			doc:  `{"a": 1, "b": [1]}`,
+			edits: []jsonEdit{
+				{mode: REPLACE, path: "$.c", val: `1`},
+				{mode: REMOVE, path: "$.c"},
+				{mode: INSERT, path: "$.a", val: `2`},
+				{mode: SET, path: "$.b.d", val: `3`},
+				{mode: SET, path: "$.a.d", val: `3`},
+			},
+			want:  `{"a": 1, "b": [1]}`,
+			diffs: []sql.JSONDiff{},
+		},
+		{
+			name: "array cells are resolved to their positions",
+			doc:  `{"a": [1, 2, 3]}`,
+			edits: []jsonEdit{
+				{mode: SET, path: "$.a[last]", val: `4`},
+				{mode: SET, path: "$.a[7]", val: `5`},
+				{mode: REMOVE, path: "$.a[0]"},
+				{mode: SET, path: "$.a[last]", val: `6`},
+			},
+			want: `{"a": [2, 4, 6]}`,
+			diffs: []sql.JSONDiff{
+				{Type: sql.JSONDiffReplace, Path: "$.a[2]", Value: MustJSON(`4`)},
+				{Type: sql.JSONDiffInsert, Path: "$.a[3]", Value: MustJSON(`5`)},
+				{Type: sql.JSONDiffRemove, Path: "$.a[0]"},
+				{Type: sql.JSONDiffReplace, Path: "$.a[2]", Value: MustJSON(`6`)},
+			},
+		},
+		{
+			name: "edits depending on earlier edits",
+			doc:  `{"a": {"b": 1}}`,
+			edits: []jsonEdit{
+				{mode: SET, path: "$.a", val: `{"c": 2}`},
+				{mode: SET, path: "$.a.d", val: `3`},
+				{mode: REMOVE, path: "$.a.b"},
+				{mode: REPLACE, path: "$.a.c", val: `4`},
+			},
+			want: `{"a": {"c": 4, "d": 3}}`,
+			diffs: []sql.JSONDiff{
+				{Type: sql.JSONDiffReplace, Path: "$.a", Value: MustJSON(`{"c": 2}`)},
+				{Type: sql.JSONDiffInsert, Path: "$.a.d", Value: MustJSON(`3`)},
+				{Type: sql.JSONDiffReplace, Path: "$.a.c", Value: MustJSON(`4`)},
+			},
+		},
+		{
+			name: "quoted member names",
+			doc:  `{"a b": 1}`,
+			edits: []jsonEdit{
+				{mode: SET, path: `$."a b"`, val: `2`},
+			},
+			want: `{"a b": 2}`,
+			diffs: []sql.JSONDiff{
+				{Type: sql.JSONDiffReplace, Path: `$."a b"`, Value: MustJSON(`2`)},
+			},
+		},
+		{
+			name: "replacing the whole document",
+			doc:  `{"a": 1}`,
+			edits: []jsonEdit{
+				{mode: SET, path: "$.a", val: `2`},
+				{mode: SET, path: "$", val: `[1]`},
+			},
+			want: `[1]`,
+			full: true,
+		},
+		{
+			name: "wrapping a value in an array",
+			doc:  `{"a": 1}`,
+			edits: []jsonEdit{
+				{mode: SET, path: "$.a[1]", val: `2`},
+				{mode: SET, path: "$.b", val: `3`},
+			},
+			want: `{"a": [1, 2], "b": 3}`,
+			full: true,
+		},
+		{
+			name: "array appends",
+			doc:  `{"a": [1]}`,
+			edits: []jsonEdit{
+				{mode: ARRAY_APPEND, path: "$.a", val: `2`},
+			},
+			want: `{"a": [1, 2]}`,
+			full: true,
+		},
+	}
+
+	ctx := context.Background()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			base := MustJSON(test.doc)
+			update := NewJSONPartialUpdate(base)
+			var doc MutableJSON = update
+			for _, edit := range test.edits {
+				var err error
+				switch edit.mode {
+				case SET:
+					doc, _, err = doc.Set(ctx, edit.path, MustJSON(edit.val))
+				case INSERT:
+					doc, _, err = doc.Insert(ctx, edit.path, MustJSON(edit.val))
+				case REPLACE:
+					doc, _, err = doc.Replace(ctx, edit.path, MustJSON(edit.val))
+				case REMOVE:
+					doc, _, err = doc.Remove(ctx, edit.path)
+				case ARRAY_APPEND:
+					doc, _, err = doc.ArrayAppend(ctx, edit.path, MustJSON(edit.val))
+				}
+				require.NoError(t, err)
+			}
+
+			diffs, ok := update.DiffsFrom(base)
+			require.Equal(t, !test.full, ok)
+			if ok {
+				require.Equal(t, len(test.diffs), len(diffs))
+				for i, diff := range diffs {
+					require.Equal(t, test.diffs[i].Type, diff.Type)
+					require.Equal(t, test.diffs[i].Path, diff.Path)
+					require.Equal(t, test.diffs[i].Value, diff.Value)
+				}
+				applied, err := ApplyJSONDiffs(ctx, base, diffs)
+				require.NoError(t, err)
+				cmp, err := CompareJSON(ctx, applied, MustJSON(test.want))
+				require.NoError(t, err)
+				require.Zero(t, cmp, "diffs applied to %s", test.doc)
+			}
+
+			cmp, err := CompareJSON(ctx, doc, MustJSON(test.want))
+			require.NoError(t, err)
+			require.Zero(t, cmp, "got %s", doc)
+
+			// the original document is left unchanged
+			cmp, err = CompareJSON(ctx, base, MustJSON(test.doc))
+			require.NoError(t, err)
+			require.Zero(t, cmp)
+		})
+	}
+
+	t.Run("diffs apply only to the document they were made from", func(t *testing.T) {
+		base := MustJSON(`{"a": 1}`)
+		update := NewJSONPartialUpdate(base)
+		_, _, err := update.Set(ctx, "$.a", MustJSON(`2`))
+		require.NoError(t, err)
+
+		_, ok := update.DiffsFrom(base)
+		require.True(t, ok)
+		_, ok = update.DiffsFrom(&base)
+		require.True(t, ok)
+		_, ok = update.DiffsFrom(MustJSON(`{"a": 1}`))
+		require.False(t, ok)
+		_, ok = update.DiffsFrom(nil)
+		require.False(t, ok)
+	})
+}
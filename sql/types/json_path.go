@@ -0,0 +1,509 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// JSONPath is a parsed MySQL JSON path expression, which can be evaluated against JSON documents. Besides member
+// accesses and array indexes, it supports the member and array wildcards (.* and [*]), array ranges ([1 to 3]),
+// indexes relative to the end of an array ([last-1]), and the ** ellipsis, which matches every value below the
+// current one. See https://dev.mysql.com/doc/refman/8.4/en/json.html#json-path-syntax
+type JSONPath struct {
+	legs []jsonPathLeg
+	// ellipsis is whether the path contains the ** token, which can match the same value more than once.
+	ellipsis bool
+}
+
+// JSONPathMatch is a value matched by a JSONPath, along with the path that locates it in the document.
+type JSONPathMatch struct {
+	Path  string
+	Value interface{}
+}
+
+type jsonPathLegKind byte
+
+const (
+	jsonPathMember         jsonPathLegKind = iota // a named member, such as .key
+	jsonPathMemberWildcard                        // every member of an object, .*
+	jsonPathArrayCell                             // a single array element, such as [3] or [last-1]
+	jsonPathArrayRange                            // a range of array elements, such as [1 to 3]
+	jsonPathArrayWildcard                         // every element of an array, [*]
+	jsonPathEllipsis                              // the current value and every value below it, **
+)
+
+// jsonArrayIndex is an array index in a path, which is either counted from the start of the array or, for the last
+// keyword, back from its end.
+type jsonArrayIndex struct {
+	n        int
+	fromLast bool
+}
+
+// resolve returns the position of the index in an array of |length| elements, which may be out of its bounds.
+func (i jsonArrayIndex) resolve(length int) int {
+	if i.fromLast {
+		return length - 1 - i.n
+	}
+	return i.n
+}
+
+type jsonPathLeg struct {
+	kind     jsonPathLegKind
+	member   string
+	from, to jsonArrayIndex
+}
+
+// jsonPathStep is a step of the path to a matched value, either a member name or an array index.
+type jsonPathStep struct {
+	member string
+	// index is the array index of the step, or -1 for a member step.
+	index int
+}
+
+func memberStep(member string) jsonPathStep {
+	return jsonPathStep{member: member, index: -1}
+}
+
+func indexStep(index int) jsonPathStep {
+	return jsonPathStep{index: index}
+}
+
+type jsonPathMatch struct {
+	val  interface{}
+	path []jsonPathStep
+}
+
+// ParseJSONPath parses the JSON path expression |path|.
+func ParseJSONPath(path string) (*JSONPath, error) {
+	p := &jsonPathParser{path: path}
+	p.skipSpace()
+	if p.pos >= len(path) || path[p.pos] != '$' {
+		return nil, fmt.Errorf("Invalid JSON path expression. Path must start with '$', but received: '%s'", path)
+	}
+	p.pos++
+
+	res := &JSONPath{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(path) {
+			break
+		}
+		var leg jsonPathLeg
+		var err error
+		switch path[p.pos] {
+		case '.':
+			leg, err = p.parseMember()
+		case '[':
+			leg, err = p.parseArrayLocation()
+		case '*':
+			if !strings.HasPrefix(path[p.pos:], "**") {
+				return nil, p.errorf("Expected '.' or '['")
+			}
+			p.pos += 2
+			leg = jsonPathLeg{kind: jsonPathEllipsis}
+			res.ellipsis = true
+		default:
+			return nil, p.errorf("Expected '.' or '['")
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.legs = append(res.legs, leg)
+	}
+
+	if len(res.legs) > 0 && res.legs[len(res.legs)-1].kind == jsonPathEllipsis {
+		return nil, p.errorf("Expected '.' or '[' after '**'")
+	}
+	return res, nil
+}
+
+// IsMultiValued returns whether the path contains a wildcard, an array range or the ** ellipsis, so that it can match
+// more than one value.
+func (p *JSONPath) IsMultiValued() bool {
+	for _, leg := range p.legs {
+		switch leg.kind {
+		case jsonPathMemberWildcard, jsonPathArrayRange, jsonPathArrayWildcard, jsonPathEllipsis:
+			return true
+		}
+	}
+	return false
+}
+
+// WithDescendants returns a copy of the path that matches the values matched by this path and every value below them.
+func (p *JSONPath) WithDescendants() *JSONPath {
+	legs := append(p.legs[:len(p.legs):len(p.legs)], jsonPathLeg{kind: jsonPathEllipsis})
+	return &JSONPath{legs: legs, ellipsis: true}
+}
+
+// Find returns the values in |doc| matched by the path, in document order.
+func (p *JSONPath) Find(doc interface{}) []interface{} {
+	matches := p.eval(doc, false)
+	if len(matches) == 0 {
+		return nil
+	}
+	vals := make([]interface{}, len(matches))
+	for i, m := range matches {
+		vals[i] = m.val
+	}
+	return vals
+}
+
+// FindPaths returns the values in |doc| matched by the path along with the paths to them, in document order.
+func (p *JSONPath) FindPaths(doc interface{}) []JSONPathMatch {
+	matches := p.eval(doc, true)
+	if len(matches) == 0 {
+		return nil
+	}
+	res := make([]JSONPathMatch, len(matches))
+	for i, m := range matches {
+		res[i] = JSONPathMatch{Path: formatJSONPath(m.path), Value: m.val}
+	}
+	return res
+}
+
+// locate returns the value matched by a path that isn't multi-valued in |doc| along with the steps to it, and whether
+// the path matches a value at all.
+func (p *JSONPath) locate(doc interface{}) (interface{}, []jsonPathStep, bool) {
+	matches := p.eval(doc, true)
+	if len(matches) != 1 {
+		return nil, nil, false
+	}
+	return matches[0].val, matches[0].path, true
+}
+
+// eval returns the matches of the path in |doc|. The paths to the matches are tracked only when |withPaths| is set or
+// they're needed to remove the duplicates that an ellipsis can match.
+func (p *JSONPath) eval(doc interface{}, withPaths bool) []jsonPathMatch {
+	withPaths = withPaths || p.ellipsis
+	matches := []jsonPathMatch{{val: doc}}
+	for _, leg := range p.legs {
+		var next []jsonPathMatch
+		for _, m := range matches {
+			next = leg.apply(m, withPaths, next)
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		matches = next
+	}
+
+	if p.ellipsis {
+		seen := make(map[string]struct{}, len(matches))
+		deduped := matches[:0]
+		for _, m := range matches {
+			key := formatJSONPath(m.path)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			deduped = append(deduped, m)
+		}
+		matches = deduped
+	}
+	return matches
+}
+
+// apply appends the values that the leg matches in |m| to |res|.
+func (leg jsonPathLeg) apply(m jsonPathMatch, withPaths bool, res []jsonPathMatch) []jsonPathMatch {
+	switch leg.kind {
+	case jsonPathMember:
+		// A member access matches only objects
+		if obj, ok := m.val.(JsonObject); ok {
+			if v, ok := obj[leg.member]; ok {
+				res = append(res, m.child(memberStep(leg.member), v, withPaths))
+			}
+		}
+	case jsonPathMemberWildcard:
+		if obj, ok := m.val.(JsonObject); ok {
+			for _, k := range sortKeys(obj) {
+				res = append(res, m.child(memberStep(k), obj[k], withPaths))
+			}
+		}
+	case jsonPathArrayWildcard:
+		if arr, ok := m.val.(JsonArray); ok {
+			for i, v := range arr {
+				res = append(res, m.child(indexStep(i), v, withPaths))
+			}
+		}
+	case jsonPathArrayCell, jsonPathArrayRange:
+		arr, ok := m.val.(JsonArray)
+		if !ok {
+			// A value that isn't an array is treated as an array holding only that value
+			if from, to := leg.bounds(1); from <= 0 && to >= 0 {
+				res = append(res, m)
+			}
+			return res
+		}
+		from, to := leg.bounds(len(arr))
+		for i := max(from, 0); i <= to && i < len(arr); i++ {
+			res = append(res, m.child(indexStep(i), arr[i], withPaths))
+		}
+	case jsonPathEllipsis:
+		res = m.appendDescendants(withPaths, res)
+	}
+	return res
+}
+
+// bounds returns the first and last positions selected by an array cell or range leg in an array of |length|
+// elements. A range is cut short at the end of the array, while a cell outside of it selects nothing.
+func (leg jsonPathLeg) bounds(length int) (int, int) {
+	from := leg.from.resolve(length)
+	if leg.kind != jsonPathArrayRange {
+		return from, from
+	}
+	return max(from, 0), min(leg.to.resolve(length), length-1)
+}
+
+// child returns the match of the value |v| found at |step| below |m|.
+func (m jsonPathMatch) child(step jsonPathStep, v interface{}, withPaths bool) jsonPathMatch {
+	if !withPaths {
+		return jsonPathMatch{val: v}
+	}
+	return jsonPathMatch{val: v, path: append(m.path[:len(m.path):len(m.path)], step)}
+}
+
+// appendDescendants appends |m| and every value below it to |res|, in document order.
+func (m jsonPathMatch) appendDescendants(withPaths bool, res []jsonPathMatch) []jsonPathMatch {
+	res = append(res, m)
+	switch v := m.val.(type) {
+	case JsonObject:
+		for _, k := range sortKeys(v) {
+			res = m.child(memberStep(k), v[k], withPaths).appendDescendants(withPaths, res)
+		}
+	case JsonArray:
+		for i, e := range v {
+			res = m.child(indexStep(i), e, withPaths).appendDescendants(withPaths, res)
+		}
+	}
+	return res
+}
+
+// formatJSONPath returns the path expression locating the value reached by |steps|, quoting the member names that
+// aren't identifiers as MySQL does.
+func formatJSONPath(steps []jsonPathStep) string {
+	var sb strings.Builder
+	sb.WriteByte('$')
+	for _, step := range steps {
+		if step.index >= 0 {
+			sb.WriteByte('[')
+			sb.WriteString(strconv.Itoa(step.index))
+			sb.WriteByte(']')
+			continue
+		}
+		sb.WriteByte('.')
+		sb.WriteString(quoteJSONPathMember(step.member))
+	}
+	return sb.String()
+}
+
+// quoteJSONPathMember returns |member| as it's written in a path expression, which is double-quoted unless it's an
+// identifier.
+func quoteJSONPathMember(member string) string {
+	if isJSONPathIdentifier(member) {
+		return member
+	}
+	quoted, err := json.Marshal(member)
+	if err != nil {
+		return strconv.Quote(member)
+	}
+	return string(quoted)
+}
+
+// isJSONPathIdentifier returns whether |member| is an ECMAScript identifier, which doesn't need quoting in a path.
+func isJSONPathIdentifier(member string) bool {
+	if member == "" {
+		return false
+	}
+	for i, r := range member {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// jsonPathParser reads the legs of a path expression.
+type jsonPathParser struct {
+	path string
+	pos  int
+}
+
+func (p *jsonPathParser) errorf(msg string) error {
+	return fmt.Errorf("Invalid JSON path expression. %s at character %d of %s", msg, p.pos, p.path)
+}
+
+func (p *jsonPathParser) skipSpace() {
+	for p.pos < len(p.path) && isJSONPathSpace(p.path[p.pos]) {
+		p.pos++
+	}
+}
+
+func isJSONPathSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// parseMember reads a member leg, which is a member name or the member wildcard following a '.'.
+func (p *jsonPathParser) parseMember() (jsonPathLeg, error) {
+	p.pos++
+	p.skipSpace()
+	if p.pos >= len(p.path) {
+		return jsonPathLeg{}, p.errorf("Expected field name after '.'")
+	}
+
+	switch c := p.path[p.pos]; {
+	case c == '*' && !strings.HasPrefix(p.path[p.pos:], "**"):
+		p.pos++
+		return jsonPathLeg{kind: jsonPathMemberWildcard}, nil
+	case c == '"':
+		member, err := p.parseQuotedMember()
+		if err != nil {
+			return jsonPathLeg{}, err
+		}
+		return jsonPathLeg{kind: jsonPathMember, member: member}, nil
+	}
+
+	// An unquoted member name runs until the next leg
+	start := p.pos
+	for p.pos < len(p.path) && p.path[p.pos] != '.' && p.path[p.pos] != '[' && !strings.HasPrefix(p.path[p.pos:], "**") {
+		p.pos++
+	}
+	member := strings.TrimRight(p.path[start:p.pos], " \t\n\r")
+	if member == "" {
+		p.pos = start
+		return jsonPathLeg{}, p.errorf("Expected field name after '.'")
+	}
+	return jsonPathLeg{kind: jsonPathMember, member: member}, nil
+}
+
+// parseQuotedMember reads a double-quoted member name, which is unescaped as a JSON string.
+func (p *jsonPathParser) parseQuotedMember() (string, error) {
+	start := p.pos
+	p.pos++
+	for p.pos < len(p.path) && p.path[p.pos] != '"' {
+		if p.path[p.pos] == '\\' {
+			p.pos++
+		}
+		p.pos++
+	}
+	if p.pos >= len(p.path) {
+		return "", p.errorf(`'"' expected`)
+	}
+	p.pos++
+
+	var member string
+	if err := json.Unmarshal([]byte(p.path[start:p.pos]), &member); err != nil {
+		// Not a valid JSON string, so only drop the backslashes before escaped characters
+		var sb strings.Builder
+		quoted := p.path[start+1 : p.pos-1]
+		for i := 0; i < len(quoted); i++ {
+			if quoted[i] == '\\' && i+1 < len(quoted) {
+				i++
+			}
+			sb.WriteByte(quoted[i])
+		}
+		member = sb.String()
+	}
+	return member, nil
+}
+
+// parseArrayLocation reads an array leg enclosed in brackets, which is an index, a range or the array wildcard.
+func (p *jsonPathParser) parseArrayLocation() (jsonPathLeg, error) {
+	end := strings.IndexByte(p.path[p.pos:], ']')
+	if end < 0 {
+		return jsonPathLeg{}, fmt.Errorf("Invalid JSON path expression. Missing ']'")
+	}
+	end += p.pos
+	p.pos++
+	p.skipSpace()
+
+	var leg jsonPathLeg
+	if p.pos < end && p.path[p.pos] == '*' {
+		p.pos++
+		leg.kind = jsonPathArrayWildcard
+	} else {
+		from, err := p.parseArrayIndex(end)
+		if err != nil {
+			return jsonPathLeg{}, err
+		}
+		leg.kind, leg.from = jsonPathArrayCell, from
+
+		p.skipSpace()
+		if p.pos < end && p.pos > 0 && isJSONPathSpace(p.path[p.pos-1]) && strings.HasPrefix(p.path[p.pos:end], "to") {
+			p.pos += len("to")
+			to, err := p.parseArrayIndex(end)
+			if err != nil {
+				return jsonPathLeg{}, err
+			}
+			// A range is empty if it ends before it starts, which is only known for indexes counted the same way
+			if from.fromLast == to.fromLast && ((!from.fromLast && from.n > to.n) || (from.fromLast && from.n < to.n)) {
+				return jsonPathLeg{}, p.errorf("Invalid array range")
+			}
+			leg.kind, leg.to = jsonPathArrayRange, to
+		}
+	}
+
+	p.skipSpace()
+	if p.pos != end {
+		return jsonPathLeg{}, p.errorf("Expected ']'")
+	}
+	p.pos++
+	return leg, nil
+}
+
+// parseArrayIndex reads a non-negative index, the last keyword or last-N before |end|.
+func (p *jsonPathParser) parseArrayIndex(end int) (jsonArrayIndex, error) {
+	p.skipSpace()
+	if strings.HasPrefix(p.path[p.pos:end], "last") {
+		p.pos += len("last")
+		p.skipSpace()
+		if p.pos >= end || p.path[p.pos] != '-' {
+			return jsonArrayIndex{fromLast: true}, nil
+		}
+		p.pos++
+		p.skipSpace()
+		n, ok := p.parseNumber(end)
+		if !ok {
+			return jsonArrayIndex{}, p.errorf("Expected a positive integer after 'last-'")
+		}
+		return jsonArrayIndex{n: n, fromLast: true}, nil
+	}
+
+	n, ok := p.parseNumber(end)
+	if !ok {
+		return jsonArrayIndex{}, p.errorf("Expected an array index")
+	}
+	return jsonArrayIndex{n: n}, nil
+}
+
+// parseNumber reads a run of digits before |end|, which must be no greater than math.MaxInt32.
+func (p *jsonPathParser) parseNumber(end int) (int, bool) {
+	start := p.pos
+	n := 0
+	for ; p.pos < end && p.path[p.pos] >= '0' && p.path[p.pos] <= '9'; p.pos++ {
+		n = n*10 + int(p.path[p.pos]-'0')
+		if n > math.MaxInt32 {
+			p.pos = start
+			return 0, false
+		}
+	}
+	return n, p.pos > start
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPathFind(t *testing.T) {
+	doc := `{"a": [1, 2, 3, 4], "b": {"c": "foo", "a": {"k": 5}}, "d e": {"k": 6}, "k": 7, "s": "bar"}`
+	var val interface{}
+	require.NoError(t, json.Unmarshal([]byte(doc), &val))
+
+	tests := []struct {
+		path      string
+		want      []interface{}
+		wantPaths []string
+		multi     bool
+	}{
+		{path: "$", want: []interface{}{val}, wantPaths: []string{"$"}},
+		{path: "$.b.c", want: []interface{}{"foo"}, wantPaths: []string{"$.b.c"}},
+		{path: `$."d e".k`, want: []interface{}{6.0}, wantPaths: []string{`$."d e".k`}},
+		{path: `$ . "d e" . k`, want: []interface{}{6.0}, wantPaths: []string{`$."d e".k`}},
+		{path: "$.a[1]", want: []interface{}{2.0}, wantPaths: []string{"$.a[1]"}},
+		{path: "$.a[last]", want: []interface{}{4.0}, wantPaths: []string{"$.a[3]"}},
+		{path: "$.a[last-1]", want: []interface{}{3.0}, wantPaths: []string{"$.a[2]"}},
+		{path: "$.a[last - 9]"},
+		{path: "$.a[9]"},
+		{path: "$.a.b"},
+		{path: "$.s.b"},
+		{path: "$.s[0]", want: []interface{}{"bar"}, wantPaths: []string{"$.s"}},
+		{path: "$.s[last]", want: []interface{}{"bar"}, wantPaths: []string{"$.s"}},
+		{path: "$.s[1]"},
+		{path: "$.a[1 to 2]", want: []interface{}{2.0, 3.0}, wantPaths: []string{"$.a[1]", "$.a[2]"}, multi: true},
+		{path: "$.a[last-1 to last]", want: []interface{}{3.0, 4.0}, wantPaths: []string{"$.a[2]", "$.a[3]"}, multi: true},
+		{path: "$.a[2 to 9]", want: []interface{}{3.0, 4.0}, wantPaths: []string{"$.a[2]", "$.a[3]"}, multi: true},
+		{path: "$.a[5 to 9]", multi: true},
+		{path: "$.a[*]", want: []interface{}{1.0, 2.0, 3.0, 4.0}, wantPaths: []string{"$.a[0]", "$.a[1]", "$.a[2]", "$.a[3]"}, multi: true},
+		{path: "$.s[*]", multi: true},
+		{path: "$.b.*", want: []interface{}{map[string]interface{}{"k": 5.0}, "foo"}, wantPaths: []string{"$.b.a", "$.b.c"}, multi: true},
+		{path: "$.a.*", multi: true},
+		{path: "$**.k", want: []interface{}{7.0, 5.0, 6.0}, wantPaths: []string{"$.k", "$.b.a.k", `$."d e".k`}, multi: true},
+		{path: "$**.a[0]", want: []interface{}{1.0, map[string]interface{}{"k": 5.0}}, wantPaths: []string{"$.a[0]", "$.b.a"}, multi: true},
+		{path: "$**.a**.k", want: []interface{}{5.0}, wantPaths: []string{"$.b.a.k"}, multi: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			p, err := ParseJSONPath(test.path)
+			require.NoError(t, err)
+			require.Equal(t, test.multi, p.IsMultiValued())
+			require.Equal(t, test.want, p.Find(val))
+
+			var paths []string
+			for _, m := range p.FindPaths(val) {
+				paths = append(paths, m.Path)
+			}
+			require.Equal(t, test.wantPaths, paths)
+		})
+	}
+}
+
+func TestParseJSONPathErrors(t *testing.T) {
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{"", "Invalid JSON path expression. Path must start with '$', but received: ''"},
+		{"a.b", "Invalid JSON path expression. Path must start with '$', but received: 'a.b'"},
+		{"$.", "Invalid JSON path expression. Expected field name after '.' at character 2 of $."},
+		{"$a", "Invalid JSON path expression. Expected '.' or '[' at character 1 of $a"},
+		{`$."a`, `Invalid JSON path expression. '"' expected at character 4 of $."a`},
+		{"$[", "Invalid JSON path expression. Missing ']'"},
+		{"$[]", "Invalid JSON path expression. Expected an array index at character 2 of $[]"},
+		{"$[a]", "Invalid JSON path expression. Expected an array index at character 2 of $[a]"},
+		{"$[last-]", "Invalid JSON path expression. Expected a positive integer after 'last-' at character 7 of $[last-]"},
+		{"$[1 2]", "Invalid JSON path expression. Expected ']' at character 4 of $[1 2]"},
+		{"$[3 to 1]", "Invalid JSON path expression. Invalid array range at character 8 of $[3 to 1]"},
+		{"$[99999999999999999999]", "Invalid JSON path expression. Expected an array index at character 2 of $[99999999999999999999]"},
+		{"$**", "Invalid JSON path expression. Expected '.' or '[' after '**' at character 3 of $**"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			_, err := ParseJSONPath(test.path)
+			require.Error(t, err)
+			require.Equal(t, test.err, err.Error())
+		})
+	}
+}
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"io"
 	"maps"
-	"math"
 	"regexp"
 	"slices"
 	"strconv"
@@ -30,7 +29,6 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/apd/v3"
-	"github.com/dolthub/jsonpath"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -238,274 +236,21 @@ func LookupJSONValue(ctx context.Context, j sql.JSONWrapper, path string) (sql.J
 }
 
 func lookupJson(j interface{}, path string) (SearchableJSON, error) {
-	// Lookup(obj) throws an error if obj is nil. We want lookups on a json null
-	// to always result in sql NULL, except in the case of the identity lookup
-	// $.
-	if j == nil {
-		return nil, nil
-	}
-
-	c, err := jsonpath.Compile(path)
+	p, err := ParseJSONPath(path)
 	if err != nil {
-		// Until we throw out jsonpath, let's at least make this error better.
-		if err.Error() == "should start with '$'" {
-			err = fmt.Errorf("Invalid JSON path expression. Path must start with '$', but received: '%s'", path)
-		}
-		// jsonpath poorly handles unmatched [] in paths.
-		if strings.Contains(err.Error(), "len(tail) should") {
-			return nil, fmt.Errorf("Invalid JSON path expression. Missing ']'")
-		}
 		return nil, err
 	}
 
-	// For non-object, non-array candidates, if the path is not "$", return SQL NULL
-	_, isObject := j.(JsonObject)
-	_, isArray := j.(JsonArray)
-	if !isObject && !isArray {
+	// A path that can match several values returns all of them wrapped in an array, while any other path returns the
+	// value it matches. Either returns SQL NULL when nothing matches.
+	vals := p.Find(j)
+	if len(vals) == 0 {
 		return nil, nil
 	}
-
-	// The jsonpath library treats a member access against an array as a search
-	// of its elements, but MySQL treats it as no match and returns SQL NULL.
-	if memberAccessOnNonObject(j, path) {
-		return nil, nil
-	}
-
-	val, err := c.Lookup(j)
-	if err != nil {
-		if strings.Contains(err.Error(), "key error") {
-			// A missing key results in a SQL null
-			return nil, nil
-		}
-		if strings.Contains(err.Error(), "index out of range") {
-			// A array index out of bounds results in a SQL null
-			return nil, nil
-		}
-		if strings.Contains(err.Error(), "object is not map") {
-			// A key lookup against a non-object (e.g. descending into a
-			// scalar or array with an object key) results in a SQL null
-			return nil, nil
-		}
-		if strings.Contains(err.Error(), "object is not Slice") {
-			// An index lookup against a non-array (e.g. descending into a
-			// scalar or object with an array index) results in a SQL null
-			return nil, nil
-		}
-		return nil, err
-	}
-
-	return JSONDocument{Val: val}, nil
-}
-
-// memberAccessOnNonObject reports whether evaluating |path| against |document|
-// would apply a member access, .key or .*, to a value that is not an object.
-// MySQL matches a member access only against objects and returns SQL NULL
-// otherwise, while the jsonpath library would instead search the elements of an
-// array.
-//
-// It walks only the legs whose result is a single value, namely member accesses,
-// the member wildcard, and non-negative array indices, and reports true only
-// when it is certain a member access lands on a non-object. Any other leg, such
-// as an array-cell wildcard, a range, or the ** ellipsis, stops the walk and
-// reports false so the caller falls back to the jsonpath library.
-//
-// The walk keeps no per-leg state on the heap because lookups happen once per
-// row. See [Allocating on the Stack] and the [MySQL JSON path syntax].
-//
-// [Allocating on the Stack]: https://go.dev/blog/allocation-optimizations
-// [MySQL JSON path syntax]: https://dev.mysql.com/doc/refman/8.4/en/json.html#json-path-syntax
-func memberAccessOnNonObject(document interface{}, path string) bool {
-	if !strings.HasPrefix(path, "$") {
-		return false
-	}
-	scanner := jsonPathScanner{path: path, position: 1}
-	current := document
-	for {
-		switch leg := scanner.next(); leg.kind {
-		case legMember, legMemberWildcard:
-			object, isObject := current.(JsonObject)
-			if !isObject {
-				// A member access matches only objects in MySQL.
-				return true
-			}
-			if leg.kind == legMemberWildcard {
-				// .* selects every member, a multi-valued result we leave to the
-				// jsonpath library.
-				return false
-			}
-			child, exists := object[leg.member]
-			if !exists {
-				return false
-			}
-			current = child
-		case legIndex:
-			array, isArray := current.(JsonArray)
-			if !isArray {
-				// MySQL auto-wraps a non-array as a single-element array, so only
-				// index 0 selects the value and any other index misses.
-				if leg.index != 0 {
-					return false
-				}
-				continue
-			}
-			if leg.index >= len(array) {
-				return false
-			}
-			current = array[leg.index]
-		default:
-			// legEnd or legUnsupported: there is nothing more to follow, so leave
-			// the remaining path to the jsonpath library.
-			return false
-		}
-	}
-}
-
-// jsonPathLegKind classifies one leg of a JSON path. See [jsonPathScanner].
-type jsonPathLegKind int
-
-const (
-	legEnd            jsonPathLegKind = iota // the path has been fully consumed
-	legUnsupported                           // a leg the scanner does not model
-	legMember                                // a named member, such as .key
-	legMemberWildcard                        // the member wildcard, .*
-	legIndex                                 // a non-negative array index, such as [3]
-)
-
-// jsonPathLeg is one leg of a JSON path, produced by [jsonPathScanner.next]. It
-// is returned by value so that walking a path allocates nothing.
-type jsonPathLeg struct {
-	kind   jsonPathLegKind
-	member string // the member name, set when kind is legMember
-	index  int    // the array index, set when kind is legIndex
-}
-
-// jsonPathScanner reads the legs of a MySQL JSON path one at a time.
-type jsonPathScanner struct {
-	path     string
-	position int
-}
-
-// next returns the leg beginning at the current position and advances past it.
-// It returns kind [legEnd] once the path is consumed and [legUnsupported] for
-// any syntax the scanner does not model.
-func (scanner *jsonPathScanner) next() jsonPathLeg {
-	if scanner.position >= len(scanner.path) {
-		return jsonPathLeg{kind: legEnd}
-	}
-	switch scanner.path[scanner.position] {
-	case '.':
-		return scanner.scanMember()
-	case '[':
-		return scanner.scanIndex()
-	default:
-		return jsonPathLeg{kind: legUnsupported}
-	}
-}
-
-// scanMember reads a "." leg, which is either a member name or the member
-// wildcard .*.
-func (scanner *jsonPathScanner) scanMember() jsonPathLeg {
-	scanner.position++ // consume the '.'
-	if scanner.position >= len(scanner.path) {
-		return jsonPathLeg{kind: legUnsupported}
-	}
-	if scanner.path[scanner.position] == '*' {
-		scanner.position++
-		return jsonPathLeg{kind: legMemberWildcard}
-	}
-	member, ok := scanner.scanMemberName()
-	if !ok {
-		return jsonPathLeg{kind: legUnsupported}
-	}
-	return jsonPathLeg{kind: legMember, member: member}
-}
-
-// scanIndex reads a "[...]" leg. Only a non-negative integer index is modelled,
-// so array-cell wildcards and ranges report [legUnsupported].
-func (scanner *jsonPathScanner) scanIndex() jsonPathLeg {
-	closeBracket := strings.IndexByte(scanner.path[scanner.position:], ']')
-	if closeBracket < 0 {
-		return jsonPathLeg{kind: legUnsupported}
-	}
-	contents := scanner.path[scanner.position+1 : scanner.position+closeBracket]
-	index, ok := parseNonNegativeInt(strings.TrimSpace(contents))
-	if !ok {
-		return jsonPathLeg{kind: legUnsupported}
-	}
-	scanner.position += closeBracket + 1
-	return jsonPathLeg{kind: legIndex, index: index}
-}
-
-// scanMemberName reads the member name after a ".". It returns a substring of
-// the path so the common case allocates nothing, unescaping into a new string
-// only when a quoted name actually contains a backslash. It returns false for an
-// empty unquoted name or an unterminated quoted name.
-func (scanner *jsonPathScanner) scanMemberName() (string, bool) {
-	if scanner.path[scanner.position] != '"' {
-		start := scanner.position
-		for scanner.position < len(scanner.path) &&
-			scanner.path[scanner.position] != '.' &&
-			scanner.path[scanner.position] != '[' {
-			scanner.position++
-		}
-		return scanner.path[start:scanner.position], scanner.position > start
-	}
-	scanner.position++ // consume the opening quote
-	start := scanner.position
-	escaped := false
-	for scanner.position < len(scanner.path) && scanner.path[scanner.position] != '"' {
-		if scanner.path[scanner.position] == '\\' {
-			escaped = true
-			scanner.position++ // skip the escaped character
-		}
-		scanner.position++
-	}
-	if scanner.position >= len(scanner.path) {
-		return "", false
-	}
-	member := scanner.path[start:scanner.position]
-	scanner.position++ // consume the closing quote
-	if !escaped {
-		return member, true
-	}
-	return unescapeJSONMemberName(member), true
-}
-
-// unescapeJSONMemberName drops the backslash escapes from a quoted member name.
-func unescapeJSONMemberName(member string) string {
-	var builder strings.Builder
-	builder.Grow(len(member))
-	for position := 0; position < len(member); position++ {
-		if member[position] == '\\' && position+1 < len(member) {
-			position++ // drop the backslash and keep the byte it escaped
-		}
-		builder.WriteByte(member[position])
-	}
-	return builder.String()
-}
-
-// parseNonNegativeInt reports the integer value of |text| when |text| is a
-// non-empty run of ASCII digits no greater than [math.MaxInt32], and false
-// otherwise. It avoids strconv.Atoi, whose error value would escape and allocate
-// for every array-cell wildcard or range leg it rejects. MySQL caps array
-// indices well below MaxInt32, so rejecting larger values also keeps the
-// accumulator from overflowing into a negative index that could panic a caller.
-func parseNonNegativeInt(text string) (int, bool) {
-	if len(text) == 0 {
-		return 0, false
-	}
-	value := 0
-	for position := 0; position < len(text); position++ {
-		digit := text[position]
-		if digit < '0' || digit > '9' {
-			return 0, false
-		}
-		value = value*10 + int(digit-'0')
-		if value > math.MaxInt32 {
-			return 0, false
-		}
+	if p.IsMultiValued() {
+		return JSONDocument{Val: JsonArray(vals)}, nil
 	}
-	return value, true
+	return JSONDocument{Val: vals[0]}, nil
 }
 
 var _ driver.Valuer = JSONDocument{}
@@ -345,9 +345,10 @@ func TestJsonPathErrors(t *testing.T) {
 
 // TestJsonLookupTypeMismatch verifies that looking up a path that descends into
 // a value of the wrong type (an object key against a non-object, or an array
-// index against a non-array) resolves to SQL NULL rather than surfacing an error
-// from the underlying jsonpath library. This matches the behavior already used
-// for missing keys and out-of-range indices.
+// index against a non-array) resolves to SQL NULL rather than surfacing an error.
+// This matches the behavior already used for missing keys and out-of-range
+// indices. A non-array is treated as an array holding only itself, so only an
+// index other than 0 misses.
 func TestJsonLookupTypeMismatch(t *testing.T) {
 	ctx := context.Background()
 
@@ -363,16 +364,16 @@ func TestJsonLookupTypeMismatch(t *testing.T) {
 			path: `$."a"."b"`,
 		},
 		{
-			// Array index against a scalar (e.g. '{"a":1}' #> '{a,0}').
+			// Array index against a scalar (e.g. '{"a":1}' #> '{a,1}').
 			desc: "array index on scalar",
 			doc:  `{"a": 1}`,
-			path: `$."a"[0]`,
+			path: `$."a"[1]`,
 		},
 		{
 			// Array index against an object.
 			desc: "array index on object",
 			doc:  `{"a": {"b": 2}}`,
-			path: `$."a"[0]`,
+			path: `$."a"[1]`,
 		},
 	}
 
@@ -416,6 +416,16 @@ func (t NumberTypeImpl_) Convert(ctx context.Context, v interface{}) (interface{
 	}
 }
 
+// ClampToRange returns |v|, the result of converting a value to the number type |t| that |inRange| reports was out of
+// range, as the nearest value within the range of |t|. Converting a negative value to an unsigned type wraps it around
+// as CAST does, but a value stored in an unsigned column is clamped to zero instead.
+func ClampToRange(t sql.Type, v any, inRange sql.ConvertInRange) any {
+	if inRange == sql.Underflow && IsUnsigned(t) {
+		return t.Zero()
+	}
+	return v
+}
+
 func (t NumberTypeImpl_) ConvertRound(ctx context.Context, v interface{}) (any, sql.ConvertInRange, error) {
 	// This operates specifically on Integer base types and when v is a string
 	if _, isStr := v.(string); !isStr {
@@ -834,10 +844,10 @@ func (t NumberTypeImpl_) SQLValue(ctx *sql.Context, v sql.Value, dest []byte) (s
 		dest = strconv.AppendUint(dest, x, 10)
 	case sqltypes.Float32:
 		x := values.ReadFloat32(v.Val)
-		dest = strconv.AppendFloat(dest, float64(x), 'f', -1, 32)
+		dest = strconv.AppendFloat(dest, float64(x), 'g', -1, 32)
 	case sqltypes.Float64:
 		x := values.ReadFloat64(v.Val)
-		dest = strconv.AppendFloat(dest, x, 'f', -1, 64)
+		dest = strconv.AppendFloat(dest, x, 'g', -1, 64)
 	default:
 		panic(sql.ErrInvalidBaseType.New(t.baseType.String(), "number"))
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/cockroachdb/apd/v3"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/encodings"
@@ -38,6 +39,11 @@ const (
 	SetTypeMaxElements = 64
 )
 
+var (
+	// ErrTooManySetValues is returned when a Set type is defined with more than SetTypeMaxElements elements.
+	ErrTooManySetValues = errors.NewKind("number of values is too large")
+)
+
 var (
 	setValueType = reflect.TypeOf(uint64(0))
 )
@@ -61,7 +67,7 @@ func CreateSetType(values []string, collation sql.CollationID) (sql.SetType, err
 	}
 	// A SET column can have a maximum of 64 distinct members.
 	if len(values) > SetTypeMaxElements {
-		return nil, fmt.Errorf("number of values is too large")
+		return nil, ErrTooManySetValues.New()
 	}
 
 	hashedValToBit := make(map[uint64]uint64)
@@ -78,6 +84,9 @@ func CreateSetType(values []string, collation sql.CollationID) (sql.SetType, err
 			// Trailing spaces are automatically deleted from SET member values in the table definition when a table is created.
 			value = strings.TrimRight(value, " ")
 		}
+		if utf8.RuneCountInString(value) > EnumTypeMaxValueLength {
+			return nil, ErrEnumValueTooLong.New(value)
+		}
 
 		hashedVal, err := collation.HashToUint(value)
 		if err != nil {
@@ -247,7 +256,7 @@ func (t SetType) SQL(ctx *sql.Context, dest []byte, v interface{}) (sqltypes.Val
 	if resultCharset == sql.CharacterSet_Unspecified || resultCharset == sql.CharacterSet_binary {
 		resultCharset = t.collation.CharacterSet()
 	}
-	encodedBytes, ok := resultCharset.Encoder().Encode(encodings.StringToBytes(value))
+	encodedBytes, ok := encodeResult(resultCharset, encodings.StringToBytes(value))
 	if !ok {
 		snippet := value
 		if len(snippet) > 50 {
@@ -444,15 +453,11 @@ func (t SetType) convertStringToBitField(str string) (uint64, error) {
 			}
 		}
 
+		// A number that doesn't match any member is treated as a bit field, so '3' becomes the first two members
 		asUint, err := strconv.ParseUint(val, 10, 64)
-		if err == nil {
-			if asUint == 0 {
-				continue
-			}
-			if _, ok := t.bitToVal[asUint]; ok {
-				bitField |= asUint
-				continue
-			}
+		if err == nil && asUint <= t.allValuesBitField() {
+			bitField |= asUint
+			continue
 		}
 		return 0, sql.ErrInvalidSetValue.New(val)
 	}
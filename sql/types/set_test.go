@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -140,7 +141,10 @@ func TestSetCreateTooLarge(t *testing.T) {
 		vals[i] = strconv.Itoa(i)
 	}
 	_, err := CreateSetType(vals, sql.Collation_Default)
-	require.Error(t, err)
+	require.True(t, ErrTooManySetValues.Is(err))
+
+	_, err = CreateSetType([]string{"a", strings.Repeat("b", 256)}, sql.Collation_Default)
+	require.True(t, ErrEnumValueTooLong.Is(err))
 }
 
 func TestSetConvert(t *testing.T) {
@@ -178,10 +182,13 @@ func TestSetConvert(t *testing.T) {
 		{[]string{"", "one", "two"}, sql.Collation_Default, ",one,two", ",one,two", false},
 		{[]string{"", "one", "two"}, sql.Collation_Default, "one,,two", ",one,two", false},
 		{[]string{"one", "two"}, sql.Collation_Default, ",one,two", "one,two", false},
+		{[]string{"one", "two", "three"}, sql.Collation_Default, "3", "one,two", false},
+		{[]string{"one", "two", "three"}, sql.Collation_Default, "one,4", "one,three", false},
 
 		{[]string{"one", "two"}, sql.Collation_Default, 4, nil, true},
 		{[]string{"one", "two"}, sql.Collation_Default, "three", nil, true},
 		{[]string{"one", "two"}, sql.Collation_Default, "one,two,three", nil, true},
+		{[]string{"one", "two"}, sql.Collation_Default, "4", nil, true},
 		{[]string{"a", "b", "c"}, sql.Collation_binary, "b,c  ,a", nil, true},
 		{[]string{"one", "two"}, sql.Collation_binary, "ONE", nil, true},
 		{[]string{"one", "two"}, sql.Collation_Default, time.Date(2019, 12, 12, 12, 12, 12, 0, time.UTC), nil, true},
@@ -36,7 +36,7 @@ func BenchmarkVarchar10SQL(b *testing.B) {
 
 func BenchmarkTimespanSQL(b *testing.B) {
 	var res sqltypes.Value
-	t := TimespanType_{}
+	t := Time
 	ctx := sql.NewEmptyContext()
 	for i := 0; i < b.N; i++ {
 		res, _ = t.SQL(ctx, nil, i%60)
@@ -26,6 +26,7 @@ import (
 	"github.com/cockroachdb/apd/v3"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/google/uuid"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -304,6 +305,11 @@ func (t StringType) Compare(ctx context.Context, a interface{}, b interface{}) (
 		}
 	}
 
+	// The weight of each byte of a binary string is its value
+	if t.collation == sql.Collation_binary {
+		return strings2.Compare(as, bs), nil
+	}
+
 	encoder := t.collation.CharacterSet().Encoder()
 	getRuneWeight := t.collation.Sorter()
 	for len(as) > 0 && len(bs) > 0 {
@@ -324,6 +330,29 @@ func (t StringType) Compare(ctx context.Context, a interface{}, b interface{}) (
 		bs = bs[bRead:]
 	}
 
+	// With PAD SPACE, the shorter string is compared as though it was padded with spaces to the length of the longer
+	// one, so trailing spaces are ignored
+	if t.collation.IsPadSpace() && len(as) != len(bs) {
+		rest, sign := as, 1
+		if len(bs) > 0 {
+			rest, sign = bs, -1
+		}
+		spaceWeight := getRuneWeight(' ')
+		for len(rest) > 0 {
+			r, read := encoder.NextRune(rest)
+			if read == 0 || read == utf8.RuneError {
+				return 0, fmt.Errorf("malformed string encountered while comparing")
+			}
+			if weight := getRuneWeight(r); weight < spaceWeight {
+				return -sign, nil
+			} else if weight > spaceWeight {
+				return sign, nil
+			}
+			rest = rest[read:]
+		}
+		return 0, nil
+	}
+
 	// Strings are equal up to the compared length, so shorter strings sort before longer strings
 	if len(as) < len(bs) {
 		return -1, nil
@@ -428,6 +457,10 @@ func ConvertToBytes(ctx context.Context, v interface{}, t sql.StringType, dest [
 		start = 0
 	case time.Time:
 		val = s.AppendFormat(dest, sql.TimestampDatetimeLayout)
+	case DateParts:
+		val = append(dest, s.String()...)
+	case Timespan:
+		val = append(dest, s.Bytes()...)
 	case *apd.Decimal:
 		val = append(dest, s.Text('f')...)
 	case sql.JSONWrapper:
@@ -452,6 +485,8 @@ func ConvertToBytes(ctx context.Context, v interface{}, t sql.StringType, dest [
 		start = 0
 	case GeometryValue:
 		return s.Serialize(), nil
+	case uuid.UUID:
+		val = append(dest, s.String()...)
 	default:
 		return nil, sql.ErrConvertToSQL.New(s, t)
 	}
@@ -542,6 +577,15 @@ func getColumnContext(ctx context.Context) (string, int64) {
 	return colName, rowNum
 }
 
+// RowNumber returns the number of the row being written that was stored in |ctx| under RowNumberKey, or 1 for
+// statements that don't number their rows.
+func RowNumber(ctx context.Context) int64 {
+	if num, ok := ctx.Value(RowNumberKey).(int64); ok {
+		return num
+	}
+	return 1
+}
+
 // TruncateInvalidUTF8 truncates data at the first invalid UTF-8 byte sequence.
 func TruncateInvalidUTF8(data []byte) []byte {
 	for i := 0; i < len(data); {
@@ -673,6 +717,17 @@ func ConvertToCollatedString(ctx context.Context, val interface{}, typ sql.Type)
 				}
 			}
 		}
+	} else if bitType, ok := typ.(BitType); ok {
+		// BIT values are binary strings in string contexts
+		collation = sql.Collation_binary
+		if bitVal, ok := val.(uint64); ok {
+			content = string(bitType.Bytes(bitVal))
+		} else {
+			content, err = convertToLongTextString(ctx, val)
+			if err != nil {
+				return "", sql.Collation_Unspecified, err
+			}
+		}
 	} else {
 		collation = sql.Collation_Default
 		content, err = convertToLongTextString(ctx, val)
@@ -777,7 +832,7 @@ func (t StringType) SQL(ctx *sql.Context, dest []byte, v interface{}) (sqltypes.
 		if resultCharset == sql.CharacterSet_Unspecified || resultCharset == sql.CharacterSet_binary {
 			resultCharset = t.collation.CharacterSet()
 		}
-		encodedBytes, ok := resultCharset.Encoder().Encode(valueBytes)
+		encodedBytes, ok := encodeResult(resultCharset, valueBytes)
 		if !ok {
 			snippet := valueBytes
 			if len(snippet) > 50 {
@@ -811,7 +866,7 @@ func (t StringType) SQLValue(ctx *sql.Context, v sql.Value, dest []byte) (sqltyp
 	if charset == sql.CharacterSet_Unspecified || charset == sql.CharacterSet_binary {
 		charset = t.collation.CharacterSet()
 	}
-	res, ok := charset.Encoder().Encode(v.Val)
+	res, ok := encodeResult(charset, v.Val)
 	if !ok {
 		if len(v.Val) > 50 {
 			v.Val = v.Val[:50]
@@ -823,6 +878,19 @@ func (t StringType) SQLValue(ctx *sql.Context, v sql.Value, dest []byte) (sqltyp
 	return sqltypes.MakeTrusted(t.baseType, res), nil
 }
 
+// encodeResult encodes |val| in the result character set |charset|. As in MySQL, characters of a valid string that
+// |charset| can't represent are replaced with '?', so only strings that aren't valid UTF-8 fail to encode.
+func encodeResult(charset sql.CharacterSetID, val []byte) ([]byte, bool) {
+	encoder := charset.Encoder()
+	if res, ok := encoder.Encode(val); ok {
+		return res, true
+	}
+	if !utf8.Valid(val) {
+		return nil, false
+	}
+	return encoder.EncodeReplaceUnknown(val), true
+}
+
 // String implements Type interface.
 func (t StringType) String() string {
 	return t.StringWithTableCollation(sql.Collation_Default)
@@ -16,6 +16,7 @@ package types
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"reflect"
 	"strconv"
@@ -32,7 +33,8 @@ import (
 )
 
 var (
-	Time TimeType = TimespanType_{}
+	// Time is the TIME type with microsecond precision, which is used when a TIME column doesn't specify a precision.
+	Time TimeType = TimespanType_{precision: MaxTimePrecision}
 
 	ErrConvertingToTimeType = errors.NewKind("value %v is not a valid Time")
 
@@ -46,13 +48,17 @@ var (
 	timeValueType = reflect.TypeOf(Timespan(0))
 )
 
+// MaxTimePrecision is the maximum number of fractional second digits of a TIME type.
+const MaxTimePrecision = 6
+
 // TimeType represents the TIME type.
 // https://dev.mysql.com/doc/refman/8.0/en/time.html
-// TIME is implemented as TIME(6).
+// TIME without a precision is implemented as TIME(6).
 // The type of the returned value is Timespan.
-// TODO: implement parameters on the TIME type
 type TimeType interface {
 	sql.Type
+	// Precision returns the number of fractional second digits that values of this type hold.
+	Precision() int
 	// ConvertToTimespan returns a Timespan from the given interface. Follows the same conversion rules as
 	// Convert(), in that this will process the value based on its base-10 visual representation (for example, Convert()
 	// will interpret the value `1234` as 12 minutes and 34 seconds). Returns an error for nil values.
@@ -67,16 +73,43 @@ type TimeType interface {
 	MicrosecondsToTimespan(v int64) Timespan
 }
 
-type TimespanType_ struct{}
+type TimespanType_ struct {
+	precision int
+}
 
 var _ TimeType = TimespanType_{}
 var _ sql.CollationCoercible = TimespanType_{}
 
+// CreateTimeType creates a TIME type holding |precision| fractional second digits.
+func CreateTimeType(precision int) (TimeType, error) {
+	if precision < 0 || precision > MaxTimePrecision {
+		return nil, fmt.Errorf("precision must be between 0 and 6, got %d", precision)
+	}
+	return TimespanType_{precision: precision}, nil
+}
+
+// MustCreateTimeType is the same as CreateTimeType except it panics on errors.
+func MustCreateTimeType(precision int) TimeType {
+	t, err := CreateTimeType(precision)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Precision implements the TimeType interface.
+func (t TimespanType_) Precision() int {
+	return t.precision
+}
+
 // MaxTextResponseByteLength implements the Type interface
 func (t TimespanType_) MaxTextResponseByteLength(*sql.Context) uint32 {
-	// 10 digits are required for a text representation without microseconds, but with microseconds
-	// requires 17, so return 17 as an upper limit (i.e. len(+123:00:00.999999"))
-	return 17
+	// 10 characters are required for a text representation without fractional seconds (i.e. len("-838:59:59")), and
+	// each fractional digit adds one more after the decimal point
+	if t.precision == 0 {
+		return 10
+	}
+	return 11 + uint32(t.precision)
 }
 
 // Timespan is the value type returned by TimeType.Convert().
@@ -110,7 +143,43 @@ func (t TimespanType_) Convert(c context.Context, v interface{}) (interface{}, s
 		return nil, sql.InRange, nil
 	}
 	ret, err := t.ConvertToTimespan(v)
-	return ret, sql.InRange, err
+	if err != nil {
+		return ret, sql.InRange, err
+	}
+	return t.roundToPrecision(c, ret), sql.InRange, nil
+}
+
+// roundToPrecision rounds the fractional seconds of |ts| to the precision of this type, or truncates them when the
+// TIME_TRUNCATE_FRACTIONAL SQL mode is enabled.
+func (t TimespanType_) roundToPrecision(ctx context.Context, ts Timespan) Timespan {
+	if t.precision >= MaxTimePrecision {
+		return ts
+	}
+	unit := precisionUnits[t.precision]
+	abs := int64Abs(int64(ts))
+	remainder := abs % unit
+	if remainder == 0 {
+		return ts
+	}
+	abs -= remainder
+	if remainder*2 >= unit && !truncatesFractionalSeconds(ctx) {
+		abs += unit
+	}
+	if ts < 0 {
+		abs = -abs
+	}
+	return t.MicrosecondsToTimespan(abs)
+}
+
+// precisionUnits is the number of microseconds in the smallest unit of each fractional second precision.
+var precisionUnits = [MaxTimePrecision + 1]int64{
+	1_000_000, 100_000, 10_000, 1_000, 100, 10, 1,
+}
+
+// truncatesFractionalSeconds returns whether the TIME_TRUNCATE_FRACTIONAL SQL mode is enabled for |ctx|.
+func truncatesFractionalSeconds(ctx context.Context) bool {
+	sqlCtx, ok := ctx.(*sql.Context)
+	return ok && sqlCtx != nil && sqlCtx.Session != nil && sql.LoadSqlMode(sqlCtx).TimeTruncateFractional()
 }
 
 // ConvertToTimespan converts the given interface value to a Timespan. This follows the conversion rules of MySQL, which
@@ -246,13 +315,13 @@ func (t TimespanType_) ConvertToTimeDuration(v interface{}) (time.Duration, erro
 
 // Equals implements the Type interface.
 func (t TimespanType_) Equals(otherType sql.Type) bool {
-	_, ok := otherType.(TimespanType_)
-	return ok
+	ot, ok := otherType.(TimespanType_)
+	return ok && t.precision == ot.precision
 }
 
 // Promote implements the Type interface.
 func (t TimespanType_) Promote() sql.Type {
-	return t
+	return Time
 }
 
 // SQL implements Type interface.
@@ -266,7 +335,7 @@ func (t TimespanType_) SQL(_ *sql.Context, dest []byte, v interface{}) (sqltypes
 		return sqltypes.Value{}, err
 	}
 
-	dest = ti.AppendBytes(dest)
+	dest = ti.appendBytesWithPrecision(dest, t.precision)
 	return sqltypes.MakeTrusted(sqltypes.Time, dest), nil
 }
 
@@ -277,13 +346,16 @@ func (t TimespanType_) SQLValue(ctx *sql.Context, v sql.Value, dest []byte) (sql
 	}
 
 	x := values.ReadInt64(v.Val)
-	dest = Timespan(x).AppendBytes(dest)
+	dest = Timespan(x).appendBytesWithPrecision(dest, t.precision)
 	return sqltypes.MakeTrusted(sqltypes.Time, dest), nil
 }
 
 // String implements Type interface.
 func (t TimespanType_) String() string {
-	return "time(6)"
+	if t.precision == 0 {
+		return "time"
+	}
+	return fmt.Sprintf("time(%d)", t.precision)
 }
 
 // Type implements Type interface.
@@ -499,19 +571,18 @@ func (t Timespan) Bytes() []byte {
 	return ret[:i]
 }
 
-// precision is the number of digits of sub-second precision.
-// For the timespan type, this is currently always 6 (microsecond precision)
-// See https://github.com/dolthub/dolt/issues/10661
-func (t Timespan) precision() int {
-	return 6
+// AppendBytes appends the Timespan to |dest| with microsecond precision.
+func (t Timespan) AppendBytes(dest []byte) []byte {
+	return t.appendBytesWithPrecision(dest, MaxTimePrecision)
 }
 
-func (t Timespan) AppendBytes(dest []byte) []byte {
+// appendBytesWithPrecision appends the Timespan to |dest| with |precision| fractional second digits.
+func (t Timespan) appendBytesWithPrecision(dest []byte, precision int) []byte {
 	isNeg, h, m, s, ms := t.timespanToUnits()
 	if isNeg {
 		dest = append(dest, '-')
 	}
-	dest = appendTimeFormat(dest, int64(h), int64(m), int64(s), int64(ms), t.precision())
+	dest = appendTimeFormat(dest, int64(h), int64(m), int64(s), int64(ms), precision)
 	return dest
 }
 
@@ -218,8 +218,42 @@ func TestTimeConvertToTimeDuration(t *testing.T) {
 	}
 }
 
+func TestTimePrecision(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	tests := []struct {
+		precision   int
+		val         interface{}
+		expectedStr string
+	}{
+		{0, "11:12:13.4", "11:12:13"},
+		{0, "11:12:13.5", "11:12:14"},
+		{0, "-11:12:13.5", "-11:12:14"},
+		{0, "11:12:59.999999", "11:13:00"},
+		{2, "11:12:13.4567", "11:12:13.46"},
+		{2, "11:12:13.4549", "11:12:13.45"},
+		{3, "-00:00:01.0005", "-00:00:01.001"},
+		{6, "11:12:13.1234567", "11:12:13.123457"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.precision, test.val), func(t *testing.T) {
+			typ := MustCreateTimeType(test.precision)
+			val, _, err := typ.Convert(ctx, test.val)
+			require.NoError(t, err)
+			res, err := typ.SQL(ctx, nil, val)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedStr, res.ToString())
+		})
+	}
+
+	_, err := CreateTimeType(7)
+	require.Error(t, err)
+}
+
 func TestTimeString(t *testing.T) {
 	require.Equal(t, "time(6)", Time.String())
+	require.Equal(t, "time", MustCreateTimeType(0).String())
+	require.Equal(t, "time(3)", MustCreateTimeType(3).String())
 }
 
 func TestTimeZero(t *testing.T) {
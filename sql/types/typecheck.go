@@ -158,7 +158,7 @@ func IsTextBlob(t sql.Type) bool {
 
 // IsTextOnly checks if t is CHAR, VARCHAR, or one of the TEXTs.
 func IsTextOnly(t sql.Type) bool {
-	if t == nil {
+	if t == nil || IsUuid(t) {
 		return false
 	}
 	switch t.Type() {
@@ -247,6 +247,12 @@ func IsYear(t sql.Type) bool {
 	return ok
 }
 
+// IsUuid checks if t is the UUID type.
+func IsUuid(t sql.Type) bool {
+	_, ok := t.(UuidType)
+	return ok
+}
+
 // IsVectorConvertable checks if t can be implicitly converted to a vector of floats.
 func IsVectorConvertable(t sql.Type) bool {
 	if t == nil {
@@ -0,0 +1,188 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/google/uuid"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// uuidStringLength is the length of the text form of a UUID, such as 6ccd780c-baba-1026-9564-5b8c656024db.
+const uuidStringLength = 36
+
+var (
+	// Uuid is the UUID type. Its values are uuid.UUIDs, which are stored in 16 bytes rather than the 36 characters of
+	// their text form, and are returned to clients as text.
+	Uuid sql.Type = UuidType{}
+
+	ErrConvertingToUuid = errors.NewKind("value %v is not a valid UUID")
+
+	uuidValueType = reflect.TypeOf(uuid.UUID{})
+)
+
+// UuidType is the type of the UUID type.
+type UuidType struct{}
+
+var _ sql.Type = UuidType{}
+var _ sql.CollationCoercible = UuidType{}
+
+// Compare implements Type interface. Version 1 UUIDs, whose first bytes hold the low bits of the time they were
+// generated, are ordered by that time, like the results of UUID_TO_BIN(uuid, 1). Other UUIDs are ordered by their
+// bytes, which orders version 7 UUIDs by time as well.
+func (t UuidType) Compare(ctx context.Context, a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := CompareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	av, _, err := t.Convert(ctx, a)
+	if err != nil {
+		return 0, err
+	}
+	bv, _, err := t.Convert(ctx, b)
+	if err != nil {
+		return 0, err
+	}
+	au, bu := av.(uuid.UUID), bv.(uuid.UUID)
+	ak, bk := uuidSortKey(au), uuidSortKey(bu)
+	if res := bytes.Compare(ak[:], bk[:]); res != 0 {
+		return res, nil
+	}
+	return bytes.Compare(au[:], bu[:]), nil
+}
+
+// uuidSortKey returns the bytes |u| is ordered by. The time fields of version 1 UUIDs are swapped so that the most
+// significant bits of the time come first.
+func uuidSortKey(u uuid.UUID) uuid.UUID {
+	if u.Version() != 1 || u.Variant() != uuid.RFC4122 {
+		return u
+	}
+	var key uuid.UUID
+	copy(key[0:2], u[6:8])
+	copy(key[2:4], u[4:6])
+	copy(key[4:8], u[0:4])
+	copy(key[8:], u[8:])
+	return key
+}
+
+// Convert implements Type interface. Strings are parsed in any of the formats accepted by IS_UUID, and binary strings
+// of 16 bytes are taken as the bytes of the UUID.
+func (t UuidType) Convert(ctx context.Context, v interface{}) (interface{}, sql.ConvertInRange, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, sql.InRange, nil
+	case uuid.UUID:
+		return v, sql.InRange, nil
+	case string:
+		if u, ok := ParseUuid(v); ok {
+			return u, sql.InRange, nil
+		}
+	case []byte:
+		if len(v) == 16 {
+			u, _ := uuid.FromBytes(v)
+			return u, sql.InRange, nil
+		}
+		if u, ok := ParseUuid(string(v)); ok {
+			return u, sql.InRange, nil
+		}
+	case sql.StringWrapper:
+		s, err := v.Unwrap(ctx)
+		if err != nil {
+			return nil, sql.InRange, err
+		}
+		return t.Convert(ctx, s)
+	}
+	return nil, sql.InRange, ErrConvertingToUuid.New(v)
+}
+
+// ParseUuid parses the text form of a UUID, returning false if |s| isn't one. The hexadecimal digits may be of either
+// case, and may be grouped by dashes and enclosed in curly braces, like MySQL's IS_UUID.
+func ParseUuid(s string) (uuid.UUID, bool) {
+	switch len(s) {
+	case 32, uuidStringLength:
+	case uuidStringLength + 2:
+		if s[0] != '{' {
+			return uuid.UUID{}, false
+		}
+	default:
+		return uuid.UUID{}, false
+	}
+	u, err := uuid.Parse(s)
+	return u, err == nil
+}
+
+// Equals implements the Type interface.
+func (t UuidType) Equals(otherType sql.Type) bool {
+	_, ok := otherType.(UuidType)
+	return ok
+}
+
+// MaxTextResponseByteLength implements the Type interface
+func (t UuidType) MaxTextResponseByteLength(*sql.Context) uint32 {
+	return uuidStringLength
+}
+
+// Promote implements the Type interface.
+func (t UuidType) Promote() sql.Type {
+	return t
+}
+
+// SQL implements Type interface.
+func (t UuidType) SQL(ctx *sql.Context, dest []byte, v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	v, _, err := t.Convert(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	stop := len(dest)
+	dest = append(dest, v.(uuid.UUID).String()...)
+	return sqltypes.MakeTrusted(sqltypes.Char, dest[stop:]), nil
+}
+
+// String implements Type interface.
+func (t UuidType) String() string {
+	return "uuid"
+}
+
+// Type implements Type interface.
+func (t UuidType) Type() query.Type {
+	return sqltypes.Char
+}
+
+// ValueType implements Type interface.
+func (t UuidType) ValueType() reflect.Type {
+	return uuidValueType
+}
+
+// Zero implements Type interface.
+func (t UuidType) Zero() interface{} {
+	return uuid.Nil
+}
+
+// CollationCoercibility implements sql.CollationCoercible interface.
+func (UuidType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_ascii_bin, 5
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestUuidConvert(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	expected := uuid.MustParse("6ccd780c-baba-1026-9564-5b8c656024db")
+	for _, v := range []interface{}{
+		expected,
+		"6ccd780c-baba-1026-9564-5b8c656024db",
+		"6CCD780CBABA102695645B8C656024DB",
+		"{6ccd780c-baba-1026-9564-5b8c656024db}",
+		[]byte("6ccd780c-baba-1026-9564-5b8c656024db"),
+		expected[:],
+	} {
+		res, _, err := Uuid.Convert(ctx, v)
+		require.NoError(t, err, "%v", v)
+		assert.Equal(t, expected, res, "%v", v)
+	}
+	for _, v := range []interface{}{"6ccd780c", "[6ccd780c-baba-1026-9564-5b8c656024db]", 1, []byte{1, 2}} {
+		_, _, err := Uuid.Convert(ctx, v)
+		assert.True(t, ErrConvertingToUuid.Is(err), "%v", v)
+	}
+}
+
+func TestUuidCompare(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	// version 1 UUIDs are ordered by time, and others by their bytes
+	ordered := []string{
+		"01890000-0000-7000-8000-000000000001",
+		"01890000-0001-7000-8000-000000000001",
+		"0c27a000-0000-401e-8000-000000000001",
+		"3c27a000-0000-11ee-8000-000000000001",
+		"0c27a000-0000-11ef-8000-000000000001",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			res, err := Uuid.Compare(ctx, ordered[i], ordered[j])
+			require.NoError(t, err)
+			switch {
+			case i < j:
+				assert.Equal(t, -1, res, "%s < %s", ordered[i], ordered[j])
+			case i > j:
+				assert.Equal(t, 1, res, "%s > %s", ordered[i], ordered[j])
+			default:
+				assert.Equal(t, 0, res, "%s = %s", ordered[i], ordered[j])
+			}
+		}
+	}
+
+	val, err := Uuid.SQL(ctx, nil, ordered[0])
+	require.NoError(t, err)
+	assert.Equal(t, ordered[0], val.ToString())
+}
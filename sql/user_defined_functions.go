@@ -0,0 +1,208 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"time"
+)
+
+// FunctionVolatility describes how the result of a user-defined function may change between calls with the same
+// arguments. The engine uses it to decide which results may be reused.
+type FunctionVolatility byte
+
+const (
+	// FunctionVolatile functions may return a different result on every call, such as RAND(). This is the zero value,
+	// so functions that do not declare a volatility are never cached.
+	FunctionVolatile FunctionVolatility = iota
+	// FunctionStable functions return the same result for the same arguments within a statement, but may depend on the
+	// state of the session, such as a system variable.
+	FunctionStable
+	// FunctionImmutable functions always return the same result for the same arguments.
+	FunctionImmutable
+)
+
+// String returns the volatility as it is written in a CREATE FUNCTION characteristic.
+func (v FunctionVolatility) String() string {
+	switch v {
+	case FunctionImmutable:
+		return "IMMUTABLE"
+	case FunctionStable:
+		return "STABLE"
+	default:
+		return "VOLATILE"
+	}
+}
+
+// UserDefinedFunction is a function implemented in Go that an integrator registers with the engine at runtime, rather
+// than adding it to the built-in function registry. Exactly one of Eval, for scalar functions, or NewAggregator, for
+// aggregate functions, must be set.
+type UserDefinedFunction struct {
+	// Name is the name the function is called by. It's case-insensitive and may not be the name of a built-in function.
+	Name string
+	// MinArgs is the minimum number of arguments the function accepts.
+	MinArgs int
+	// MaxArgs is the maximum number of arguments the function accepts, or -1 if it accepts any number above MinArgs.
+	MaxArgs int
+	// ReturnType is the type returned by the function. It's shown by SHOW FUNCTION STATUS and the routines table, and
+	// is used for every call when InferType is nil.
+	ReturnType Type
+	// InferType optionally returns the type of a call to the function from the types of its arguments, overriding
+	// ReturnType.
+	InferType func(ctx *Context, argTypes []Type) Type
+	// Volatility describes when results of the function may be reused.
+	Volatility FunctionVolatility
+	// Comment is a description of the function.
+	Comment string
+	// Eval returns the result of a scalar function for the given arguments. Arguments are unwrapped before they are
+	// passed, and the result is converted to the function's type.
+	Eval func(ctx *Context, args []interface{}) (interface{}, error)
+	// NewAggregator returns the state of an aggregate function for a new group.
+	NewAggregator func(ctx *Context) (UserDefinedAggregator, error)
+	// CreatedAt is the time the function was registered. It's set by the engine.
+	CreatedAt time.Time
+}
+
+// UserDefinedAggregator holds the state of a user-defined aggregate function for a single group.
+type UserDefinedAggregator interface {
+	// Update adds a row's arguments to the group.
+	Update(ctx *Context, args []interface{}) error
+	// Result returns the result for the rows added so far.
+	Result(ctx *Context) (interface{}, error)
+}
+
+// IsAggregate returns whether the function is an aggregate function.
+func (f *UserDefinedFunction) IsAggregate() bool {
+	return f.NewAggregator != nil
+}
+
+// Validate returns an error if the definition of the function is incomplete.
+func (f *UserDefinedFunction) Validate() error {
+	switch {
+	case f.Name == "":
+		return ErrInvalidUserDefinedFunction.New(f.Name, "a name is required")
+	case (f.Eval == nil) == (f.NewAggregator == nil):
+		return ErrInvalidUserDefinedFunction.New(f.Name, "exactly one of Eval and NewAggregator must be set")
+	case f.ReturnType == nil:
+		return ErrInvalidUserDefinedFunction.New(f.Name, "a return type is required")
+	case f.MinArgs < 0 || (f.MaxArgs >= 0 && f.MaxArgs < f.MinArgs):
+		return ErrInvalidUserDefinedFunction.New(f.Name, "invalid number of arguments")
+	case f.IsAggregate() && f.MinArgs == 0:
+		return ErrInvalidUserDefinedFunction.New(f.Name, "aggregate functions take at least one argument")
+	}
+	return nil
+}
+
+// CheckArity returns an error if the function does not accept |n| arguments.
+func (f *UserDefinedFunction) CheckArity(n int) error {
+	return checkArity(f.Name, f.MinArgs, f.MaxArgs, n)
+}
+
+// checkArity returns an error if the function |name|, which takes from |minArgs| to |maxArgs| arguments, does not
+// accept |n| arguments. |maxArgs| is -1 if there is no upper limit.
+func checkArity(name string, minArgs, maxArgs, n int) error {
+	if n >= minArgs && (maxArgs < 0 || n <= maxArgs) {
+		return nil
+	}
+	expected := fmt.Sprint(minArgs)
+	if maxArgs < 0 {
+		expected = fmt.Sprintf("at least %d", minArgs)
+	} else if maxArgs != minArgs {
+		expected = fmt.Sprintf("%d to %d", minArgs, maxArgs)
+	}
+	return ErrInvalidArgumentNumber.New(name, expected, n)
+}
+
+// Type returns the type of a call to the function with the arguments given.
+func (f *UserDefinedFunction) Type(ctx *Context, args []Expression) Type {
+	if f.InferType != nil {
+		argTypes := make([]Type, len(args))
+		for i, arg := range args {
+			argTypes[i] = arg.Type(ctx)
+		}
+		if t := f.InferType(ctx, argTypes); t != nil {
+			return t
+		}
+	}
+	return f.ReturnType
+}
+
+// UserDefinedFunctionProvider is implemented by catalogs that let integrators register user-defined functions.
+type UserDefinedFunctionProvider interface {
+	// RegisterUserDefinedFunction adds the function given, returning an error if a function with its name exists.
+	RegisterUserDefinedFunction(ctx *Context, fn UserDefinedFunction) error
+	// DropUserDefinedFunction removes the function with the name given, returning an error if it doesn't exist.
+	DropUserDefinedFunction(ctx *Context, name string) error
+	// UserDefinedFunction returns the function with the name given, case-insensitive.
+	UserDefinedFunction(ctx *Context, name string) (UserDefinedFunction, bool)
+	// UserDefinedFunctions returns every registered function, sorted by name.
+	UserDefinedFunctions(ctx *Context) []UserDefinedFunction
+}
+
+// UserDefinedTableFunction is a table function implemented in Go that an integrator registers with the engine at
+// runtime. It's called in the FROM clause of a query, like a table, and its arguments may refer to the columns of the
+// tables before it in the FROM clause, or to those of an outer query.
+type UserDefinedTableFunction struct {
+	// Name is the name the function is called by. It's case-insensitive.
+	Name string
+	// MinArgs is the minimum number of arguments the function accepts.
+	MinArgs int
+	// MaxArgs is the maximum number of arguments the function accepts, or -1 if it accepts any number above MinArgs.
+	MaxArgs int
+	// Schema returns the columns of the rows returned by a call with the arguments given. It's called when the query
+	// is analyzed, before the arguments can be evaluated, so it may only depend on their types or on those that are
+	// literals.
+	Schema func(ctx *Context, args []Expression) (Schema, error)
+	// RowIter returns the rows returned by a call with the argument values given. Each row must match the schema
+	// returned by Schema for the call.
+	RowIter func(ctx *Context, args []interface{}) (RowIter, error)
+	// Comment is a description of the function.
+	Comment string
+	// CreatedAt is the time the function was registered. It's set by the engine.
+	CreatedAt time.Time
+}
+
+// Validate returns an error if the definition of the table function is incomplete.
+func (f *UserDefinedTableFunction) Validate() error {
+	switch {
+	case f.Name == "":
+		return ErrInvalidUserDefinedFunction.New(f.Name, "a name is required")
+	case f.Schema == nil:
+		return ErrInvalidUserDefinedFunction.New(f.Name, "a schema is required")
+	case f.RowIter == nil:
+		return ErrInvalidUserDefinedFunction.New(f.Name, "RowIter must be set")
+	case f.MinArgs < 0 || (f.MaxArgs >= 0 && f.MaxArgs < f.MinArgs):
+		return ErrInvalidUserDefinedFunction.New(f.Name, "invalid number of arguments")
+	}
+	return nil
+}
+
+// CheckArity returns an error if the table function does not accept |n| arguments.
+func (f *UserDefinedTableFunction) CheckArity(n int) error {
+	return checkArity(f.Name, f.MinArgs, f.MaxArgs, n)
+}
+
+// UserDefinedTableFunctionProvider is implemented by catalogs that let integrators register user-defined table
+// functions.
+type UserDefinedTableFunctionProvider interface {
+	// RegisterUserDefinedTableFunction adds the table function given, returning an error if a table function with its
+	// name exists.
+	RegisterUserDefinedTableFunction(ctx *Context, fn UserDefinedTableFunction) error
+	// DropUserDefinedTableFunction removes the table function with the name given, returning an error if it doesn't
+	// exist.
+	DropUserDefinedTableFunction(ctx *Context, name string) error
+	// UserDefinedTableFunction returns the table function with the name given, case-insensitive.
+	UserDefinedTableFunction(ctx *Context, name string) (UserDefinedTableFunction, bool)
+}
@@ -27,12 +27,20 @@ type SessionUserVariables interface {
 	GetUserVariable(ctx *Context, varName string) (Type, interface{}, error)
 }
 
+// UserVariableIterator is implemented by SessionUserVariables and Sessions that can list all of their user variables.
+type UserVariableIterator interface {
+	// IterUserVariables calls |cb| with the lowercased name, type and value of each user variable, stopping at the
+	// first error.
+	IterUserVariables(ctx *Context, cb func(name string, typ Type, val interface{}) error) error
+}
+
 type UserVars struct {
 	userVars map[string]TypedValue
 	mu       *sync.RWMutex
 }
 
 var _ SessionUserVariables = (*UserVars)(nil)
+var _ UserVariableIterator = (*UserVars)(nil)
 
 func NewUserVars() SessionUserVariables {
 	return &UserVars{
@@ -59,3 +67,20 @@ func (u *UserVars) GetUserVariable(ctx *Context, varName string) (Type, interfac
 
 	return val.Typ, val.Value, nil
 }
+
+// IterUserVariables implements the UserVariableIterator interface.
+func (u *UserVars) IterUserVariables(ctx *Context, cb func(name string, typ Type, val interface{}) error) error {
+	u.mu.RLock()
+	vars := make(map[string]TypedValue, len(u.userVars))
+	for name, val := range u.userVars {
+		vars[name] = val
+	}
+	u.mu.RUnlock()
+
+	for name, val := range vars {
+		if err := cb(name, val.Typ, val.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/dolthub/go-mysql-server/sql/values"
+)
+
+const (
+	valueArenaValues = 1024
+	valueArenaBytes  = 16 * 1024
+)
+
+// ValueArena allocates ValueRows and the bytes of their values from blocks shared by many rows, rather than allocating
+// every field on its own. A block is freed by the garbage collector once none of the rows allocated from it are
+// referenced, so rows may be passed on and kept like any other ValueRow. A ValueArena is not safe for concurrent use.
+type ValueArena struct {
+	values []Value
+	bytes  []byte
+}
+
+// NewValueRow returns a ValueRow of |n| NULL values.
+func (a *ValueArena) NewValueRow(n int) ValueRow {
+	if n > valueArenaValues/4 {
+		return make(ValueRow, n)
+	}
+	if len(a.values) < n {
+		a.values = make([]Value, valueArenaValues)
+	}
+	row := a.values[:n:n]
+	a.values = a.values[n:]
+	return row
+}
+
+// Bytes returns a non-nil slice of |n| bytes.
+func (a *ValueArena) Bytes(n int) []byte {
+	if n > valueArenaBytes/4 {
+		return make([]byte, n)
+	}
+	if len(a.bytes) < n || a.bytes == nil {
+		a.bytes = make([]byte, valueArenaBytes)
+	}
+	buf := a.bytes[:n:n]
+	a.bytes = a.bytes[n:]
+	return buf
+}
+
+// EncodeValue returns the Value of |v| for a column whose type is |typ|, allocated from this arena. Numbers are
+// encoded with the width of |typ|, and strings and byte slices are copied. Any other type of value returns an error.
+func (a *ValueArena) EncodeValue(typ query.Type, v interface{}) (Value, error) {
+	if v == nil {
+		return Value{Typ: typ}, nil
+	}
+	switch typ {
+	case query.Type_INT8, query.Type_INT16, query.Type_INT24, query.Type_INT32, query.Type_INT64:
+		i, ok := valueAsInt64(v)
+		if !ok {
+			break
+		}
+		switch typ {
+		case query.Type_INT8:
+			return Value{Typ: typ, Val: values.WriteInt8(a.Bytes(int(values.Int8Size)), int8(i))}, nil
+		case query.Type_INT16:
+			return Value{Typ: typ, Val: values.WriteInt16(a.Bytes(int(values.Int16Size)), int16(i))}, nil
+		case query.Type_INT64:
+			return Value{Typ: typ, Val: values.WriteInt64(a.Bytes(int(values.Int64Size)), i)}, nil
+		default:
+			return Value{Typ: typ, Val: values.WriteInt32(a.Bytes(int(values.Int32Size)), int32(i))}, nil
+		}
+	case query.Type_UINT8, query.Type_UINT16, query.Type_UINT24, query.Type_UINT32, query.Type_UINT64:
+		u, ok := valueAsUint64(v)
+		if !ok {
+			break
+		}
+		switch typ {
+		case query.Type_UINT8:
+			return Value{Typ: typ, Val: values.WriteUint8(a.Bytes(int(values.Uint8Size)), uint8(u))}, nil
+		case query.Type_UINT16:
+			return Value{Typ: typ, Val: values.WriteUint16(a.Bytes(int(values.Uint16Size)), uint16(u))}, nil
+		case query.Type_UINT64:
+			return Value{Typ: typ, Val: values.WriteUint64(a.Bytes(int(values.Uint64Size)), u)}, nil
+		default:
+			return Value{Typ: typ, Val: values.WriteUint32(a.Bytes(int(values.Uint32Size)), uint32(u))}, nil
+		}
+	case query.Type_FLOAT32, query.Type_FLOAT64:
+		var f float64
+		switch v := v.(type) {
+		case float32:
+			f = float64(v)
+		case float64:
+			f = v
+		default:
+			return Value{}, fmt.Errorf("unable to encode %T as a value of type %s", v, typ)
+		}
+		if typ == query.Type_FLOAT32 {
+			return Value{Typ: typ, Val: values.WriteFloat32(a.Bytes(int(values.Float32Size)), float32(f))}, nil
+		}
+		return Value{Typ: typ, Val: values.WriteFloat64(a.Bytes(int(values.Float64Size)), f)}, nil
+	case query.Type_CHAR, query.Type_VARCHAR, query.Type_TEXT, query.Type_BINARY, query.Type_VARBINARY, query.Type_BLOB:
+		switch s := v.(type) {
+		case string:
+			buf := a.Bytes(len(s))
+			copy(buf, s)
+			return Value{Typ: typ, Val: buf}, nil
+		case []byte:
+			buf := a.Bytes(len(s))
+			copy(buf, s)
+			return Value{Typ: typ, Val: buf}, nil
+		}
+	}
+	return Value{}, fmt.Errorf("unable to encode %T as a value of type %s", v, typ)
+}
+
+func valueAsInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func valueAsUint64(v interface{}) (uint64, bool) {
+	switch v := v.(type) {
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case uint:
+		return uint64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql/values"
+)
+
+func TestValueArena(t *testing.T) {
+	var arena ValueArena
+
+	row := arena.NewValueRow(3)
+	require.Len(t, row, 3)
+	require.Equal(t, 3, cap(row))
+	for _, v := range row {
+		require.True(t, v.IsNull())
+	}
+	// rows allocated from the same block must not overlap
+	next := arena.NewValueRow(2)
+	next[0] = TrueValue
+	require.True(t, row[2].IsNull())
+	require.Len(t, arena.NewValueRow(valueArenaValues), valueArenaValues)
+
+	v, err := arena.EncodeValue(query.Type_INT8, int8(-3))
+	require.NoError(t, err)
+	require.Equal(t, int8(-3), values.ReadInt8(v.Val))
+	v, err = arena.EncodeValue(query.Type_INT24, int32(-70000))
+	require.NoError(t, err)
+	require.Equal(t, int32(-70000), values.ReadInt24(v.Val))
+	v, err = arena.EncodeValue(query.Type_INT64, true)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), values.ReadInt64(v.Val))
+	v, err = arena.EncodeValue(query.Type_UINT64, uint64(18446744073709551615))
+	require.NoError(t, err)
+	require.Equal(t, uint64(18446744073709551615), values.ReadUint64(v.Val))
+	v, err = arena.EncodeValue(query.Type_FLOAT32, float64(1.5))
+	require.NoError(t, err)
+	require.Equal(t, float32(1.5), values.ReadFloat32(v.Val))
+	v, err = arena.EncodeValue(query.Type_FLOAT64, float64(-2.25))
+	require.NoError(t, err)
+	require.Equal(t, -2.25, values.ReadFloat64(v.Val))
+
+	v, err = arena.EncodeValue(query.Type_VARCHAR, "")
+	require.NoError(t, err)
+	require.False(t, v.IsNull())
+	require.Empty(t, v.Val)
+	b := []byte("abc")
+	v, err = arena.EncodeValue(query.Type_BLOB, b)
+	require.NoError(t, err)
+	b[0] = 'x'
+	require.Equal(t, "abc", string(v.Val))
+
+	v, err = arena.EncodeValue(query.Type_INT32, nil)
+	require.NoError(t, err)
+	require.True(t, v.IsNull())
+	require.Equal(t, query.Type_INT32, v.Typ)
+
+	_, err = arena.EncodeValue(query.Type_INT32, "1")
+	require.Error(t, err)
+	_, err = arena.EncodeValue(query.Type_DATETIME, int64(1))
+	require.Error(t, err)
+}
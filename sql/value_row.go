@@ -44,6 +44,20 @@ var TrueValue = Value{
 	Typ: query.Type_INT8,
 }
 
+// IsTrueValue returns whether |v|, which must be NULL or an integer, is true. Integers of every width are true when
+// they're not zero, which is when any of their bytes are not zero.
+func IsTrueValue(v Value) bool {
+	if v.IsNull() {
+		return false
+	}
+	for _, b := range v.Val {
+		if b != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // ValueRow is a slice of values
 type ValueRow []Value
 
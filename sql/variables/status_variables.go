@@ -16,6 +16,7 @@ package variables
 
 import (
 	"sync/atomic"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -117,6 +118,8 @@ func InitStatusVariables() {
 					Var: sysVar,
 					Val: &atomic.Uint64{},
 				}
+			case time.Duration:
+				globalVars.varVals[sysVar.GetName()] = sql.NewElapsedStatusVarValue(sysVar)
 			default:
 				globalVars.varVals[sysVar.GetName()] = &sql.ImmutableStatusVarValue{
 					Var: sysVar,
@@ -129,7 +132,7 @@ func InitStatusVariables() {
 	}
 	for _, sysVar := range statusVars {
 		switch sysVar.GetDefault().(type) {
-		case atomic.Uint64:
+		case atomic.Uint64, time.Duration:
 			sql.StatusVariables.SetGlobal(sysVar.GetName(), 0)
 		default:
 			sql.StatusVariables.SetGlobal(sysVar.GetName(), sysVar.GetDefault())
@@ -4201,13 +4204,13 @@ var statusVars = map[string]sql.StatusVariable{
 		Name:    "Uptime",
 		Scope:   sql.StatusVariableScope_Global,
 		Type:    types.NewSystemIntType("Uptime", 0, 0, false),
-		Default: atomic.Uint64{},
+		Default: time.Duration(0),
 	},
 	"Uptime_since_flush_status": &sql.MySQLStatusVariable{
 		Name:    "Uptime_since_flush_status",
 		Scope:   sql.StatusVariableScope_Global,
 		Type:    types.NewSystemIntType("Uptime_since_flush_status", 0, 0, false),
-		Default: atomic.Uint64{},
+		Default: time.Duration(0),
 	},
 	"validate_password_dictionary_file_last_parsed": &sql.MySQLStatusVariable{
 		Name:    "validate_password_dictionary_file_last_parsed",
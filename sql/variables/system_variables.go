@@ -463,8 +463,15 @@ var systemVars = map[string]sql.SystemVariable{
 		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
 		Dynamic:           true,
 		SetVarHintApplies: false,
-		Type:              types.NewSystemStringType("block_encryption_mode"),
-		Default:           "aes-128-ecb",
+		Type: types.NewSystemEnumType("block_encryption_mode",
+			"aes-128-ecb", "aes-192-ecb", "aes-256-ecb",
+			"aes-128-cbc", "aes-192-cbc", "aes-256-cbc",
+			"aes-128-cfb1", "aes-192-cfb1", "aes-256-cfb1",
+			"aes-128-cfb8", "aes-192-cfb8", "aes-256-cfb8",
+			"aes-128-cfb128", "aes-192-cfb128", "aes-256-cfb128",
+			"aes-128-ofb", "aes-192-ofb", "aes-256-ofb",
+			"aes-128-gcm", "aes-192-gcm", "aes-256-gcm"),
+		Default: "aes-128-ecb",
 	},
 	"bulk_insert_buffer_size": &sql.MysqlSystemVariable{
 		Name:              "bulk_insert_buffer_size",
@@ -1076,6 +1083,22 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("inmemory_joins"),
 		Default:           int8(0),
 	},
+	sql.UuidBinaryCompatibilitySessionVar: &sql.MysqlSystemVariable{
+		Name:              sql.UuidBinaryCompatibilitySessionVar,
+		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType(sql.UuidBinaryCompatibilitySessionVar),
+		Default:           int8(0),
+	},
+	sql.VectorizedExecution: &sql.MysqlSystemVariable{
+		Name:              sql.VectorizedExecution,
+		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType(sql.VectorizedExecution),
+		Default:           int8(0),
+	},
 	"disable_merge_join": &sql.MysqlSystemVariable{
 		Name:              sql.DisableMergeJoin,
 		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
@@ -1100,15 +1123,33 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("innodb_buffer_pool_size", 5242880, math.MaxInt64, false),
 		Default:           int64(134217728),
 	},
-	// Row locking is currently not supported. This variable is provided for 3p tools, and we always return the
-	// Lowest value allowed by MySQL, which is 1. If you attempt to set this value to anything other than 1, errors ensue.
+	// Whether searches of Full-Text indexes ignore the words of InnoDB's default stopword list
+	"innodb_ft_enable_stopword": &sql.MysqlSystemVariable{
+		Name:              "innodb_ft_enable_stopword",
+		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType("innodb_ft_enable_stopword"),
+		Default:           int8(1),
+	},
+	// The length in bytes of the shortest word that Full-Text indexes store and search
+	"innodb_ft_min_token_size": &sql.MysqlSystemVariable{
+		Name:              "innodb_ft_min_token_size",
+		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Global),
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("innodb_ft_min_token_size", 0, 16, false),
+		Default:           int64(3),
+	},
+	// The number of seconds a statement waits for a row lock held by another transaction before failing with
+	// ErrLockWaitTimeout, for integrators that implement row locking.
 	"innodb_lock_wait_timeout": &sql.MysqlSystemVariable{
 		Name:              "innodb_lock_wait_timeout",
 		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
 		Dynamic:           true,
-		SetVarHintApplies: false,
-		Type:              types.NewSystemIntType("innodb_lock_wait_timeout", 1, 1, false),
-		Default:           int64(1),
+		SetVarHintApplies: true,
+		Type:              types.NewSystemIntType("innodb_lock_wait_timeout", 1, 1073741824, false),
+		Default:           int64(50),
 	},
 	"innodb_stats_auto_recalc": &sql.MysqlSystemVariable{
 		Name:              "innodb_stats_auto_recalc",
@@ -1251,8 +1292,8 @@ var systemVars = map[string]sql.SystemVariable{
 		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
 		Dynamic:           true,
 		SetVarHintApplies: false,
-		Type:              types.NewSystemStringType("lc_time_names"),
-		Default:           "",
+		Type:              types.NewSystemEnumType("lc_time_names", sql.LocaleNames()...),
+		Default:           sql.DefaultTimeLocale,
 	},
 	"license": &sql.MysqlSystemVariable{
 		Name:              "license",
@@ -1860,15 +1901,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("optimizer_search_depth", 0, 62, false),
 		Default:           int64(62),
 	},
-	// TODO: add proper support for this
-	// "optimizer_switch": &sql.MysqlSystemVariable{
-	//	Name: "optimizer_switch",
-	//	Scope: sql.GetMysqlScope(SystemVariableScope_Both)
-	//	Dynamic: true,
-	//	SetVarHintApplies: true,
-	//	Type: NewSystemSetType("optimizer_switch"),
-	//	Default: "",
-	// },
+	"optimizer_switch": &sql.MysqlSystemVariable{
+		Name:              "optimizer_switch",
+		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemStringType("optimizer_switch"),
+		Default:           sql.DefaultOptimizerSwitch,
+	},
 	"optimizer_trace": &sql.MysqlSystemVariable{
 		Name:              "optimizer_trace",
 		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
@@ -2016,6 +2056,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("preload_buffer_size", 1024, 1073741824, false),
 		Default:           int64(32768),
 	},
+	sql.PreparedStmtReoptimizeSessionVar: &sql.MysqlSystemVariable{
+		Name:              sql.PreparedStmtReoptimizeSessionVar,
+		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemEnumType(sql.PreparedStmtReoptimizeSessionVar, sql.PreparedStmtReoptimizeAlways, sql.PreparedStmtReoptimizeOnFingerprintChange),
+		Default:           sql.PreparedStmtReoptimizeAlways,
+	},
 	"print_identified_with_as_hex": &sql.MysqlSystemVariable{
 		Name:              "print_identified_with_as_hex",
 		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
@@ -2073,6 +2121,14 @@ var systemVars = map[string]sql.SystemVariable{
 	//	Type: NewSystemIntType("query_alloc_block_size", 1024, 4294967295, false),
 	//	Default: int64(8192),
 	// },
+	"query_memory_limit": &sql.MysqlSystemVariable{
+		Name:              sql.QueryMemoryLimitSessionVar,
+		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemIntType(sql.QueryMemoryLimitSessionVar, 0, 9223372036854775807, false),
+		Default:           int64(0),
+	},
 	"query_cache_size": &sql.MysqlSystemVariable{
 		Name:              "query_cache_size",
 		Scope:             sql.GetMysqlScope(sql.SystemVariableScope_Global),
@@ -0,0 +1,55 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const maxExecutionTimeSessionVar = "max_execution_time"
+
+// maxExecutionTime returns how long the statement |n| may run for in the session of |ctx|, or 0 if it has no limit. As
+// in MySQL, max_execution_time only applies to read-only SELECT statements.
+func maxExecutionTime(ctx *sql.Context, n sql.Node) time.Duration {
+	if !plan.IsReadOnly(n) || !plan.NodeRepresentsSelect(ctx, n) {
+		return 0
+	}
+	val, err := ctx.GetSessionVariable(ctx, maxExecutionTimeSessionVar)
+	if err != nil {
+		return 0
+	}
+	ms, _, err := types.Int64.Convert(ctx, val)
+	if err != nil || ms.(int64) <= 0 {
+		return 0
+	}
+	return time.Duration(ms.(int64)) * time.Millisecond
+}
+
+// withMaxExecutionTime returns the context to execute |n| with, which is canceled once the statement's
+// max_execution_time elapses, and a function that stops its timer. If |n| has no time limit, it returns |ctx| and a
+// nil function.
+func withMaxExecutionTime(ctx *sql.Context, n sql.Node) (*sql.Context, context.CancelFunc) {
+	timeout := maxExecutionTime(ctx, n)
+	if timeout == 0 {
+		return ctx, nil
+	}
+	return rowexec.WithStatementTimeout(ctx, timeout)
+}
@@ -0,0 +1,516 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "github.com/dolthub/vitess/go/vt/proto/binlogdata"
+)
+
+// TestFormatDescriptionEvent tests both MySQL 5.6 and MariaDB 10.0
+// FormatDescriptionEvent is working properly.
+func TestFormatDescriptionEvent(t *testing.T) {
+	// MySQL 5.6
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	event := NewFormatDescriptionEvent(f, m)
+	if !event.IsValid() {
+		t.Fatalf("IsValid() returned false")
+	}
+	if !event.IsFormatDescription() {
+		t.Fatalf("IsFormatDescription returned false")
+	}
+	gotF, err := event.Format()
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	if !reflect.DeepEqual(gotF, f) {
+		t.Fatalf("Parsed BinlogFormat doesn't match, got:\n%v\nexpected:\n%v", gotF, f)
+	}
+
+	// MariaDB
+	f = NewMariaDBBinlogFormat()
+	m = NewTestBinlogMetadata()
+
+	event = NewFormatDescriptionEvent(f, m)
+	if !event.IsValid() {
+		t.Fatalf("IsValid() returned false")
+	}
+	if !event.IsFormatDescription() {
+		t.Fatalf("IsFormatDescription returned false")
+	}
+	gotF, err = event.Format()
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	if !reflect.DeepEqual(gotF, f) {
+		t.Fatalf("Parsed BinlogFormat doesn't match, got:\n%v\nexpected:\n%v", gotF, f)
+	}
+}
+
+func TestQueryEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	q := Query{
+		Database: "my database",
+		SQL:      "my query",
+		Charset: &binlogdatapb.Charset{
+			Client: 0x1234,
+			Conn:   0x5678,
+			Server: 0x9abc,
+		},
+	}
+	event := NewQueryEvent(f, m, q)
+	if !event.IsValid() {
+		t.Fatalf("NewQueryEvent returned an invalid event")
+	}
+	if !event.IsQuery() {
+		t.Fatalf("NewQueryEvent returned a non-query event: %v", event)
+	}
+	event, _, err := event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+
+	gotQ, err := event.Query(f)
+	if err != nil {
+		t.Fatalf("event.Query() failed: %v", err)
+	}
+	if !reflect.DeepEqual(gotQ, q) {
+		t.Fatalf("event.Query() returned %v was expecting %v", gotQ, q)
+	}
+}
+
+func TestXIDEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	event := NewXIDEvent(f, m)
+	if !event.IsValid() {
+		t.Fatalf("NewXIDEvent().IsValid() is false")
+	}
+	if !event.IsXID() {
+		t.Fatalf("NewXIDEvent().IsXID() is false")
+	}
+}
+
+func TestIntVarEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	event := NewIntVarEvent(f, m, IntVarLastInsertID, 0x123456789abcdef0)
+	if !event.IsValid() {
+		t.Fatalf("NewIntVarEvent().IsValid() is false")
+	}
+	if !event.IsIntVar() {
+		t.Fatalf("NewIntVarEvent().IsIntVar() is false")
+	}
+	name, value, err := event.IntVar(f)
+	if name != IntVarLastInsertID || value != 0x123456789abcdef0 || err != nil {
+		t.Fatalf("IntVar() returned %v/%v/%v", name, value, err)
+	}
+
+	event = NewIntVarEvent(f, m, IntVarInvalidInt, 0x123456789abcdef0)
+	if !event.IsValid() {
+		t.Fatalf("NewIntVarEvent().IsValid() is false")
+	}
+	if !event.IsIntVar() {
+		t.Fatalf("NewIntVarEvent().IsIntVar() is false")
+	}
+	name, value, err = event.IntVar(f)
+	if err == nil {
+		t.Fatalf("IntVar(invalid) returned %v/%v/%v", name, value, err)
+	}
+}
+
+func TestUpdateChecksum(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	q := Query{
+		Database: "my database",
+		SQL:      "my query",
+		Charset: &binlogdatapb.Charset{
+			Client: 0x1234,
+			Conn:   0x5678,
+			Server: 0x9abc,
+		},
+	}
+	event := NewQueryEvent(f, m, q)
+	bytes := event.Bytes()
+
+	// Calling UpdateChecksum without changing the event should not change the checksum
+	oldChecksum := append([]byte{}, bytes[len(bytes)-4:]...)
+	UpdateChecksum(f, event)
+	newChecksum := append([]byte{}, bytes[len(bytes)-4:]...)
+	require.Equal(t, oldChecksum, newChecksum)
+	require.Equal(t, []byte{0x65, 0xaa, 0x33, 0x0e}, newChecksum)
+
+	// Calling UpdateChecksum after changing the event should generate a new checksum
+	binary.LittleEndian.PutUint32(bytes[13:13+4], uint32(420))
+	UpdateChecksum(f, event)
+	newChecksum = append([]byte{}, bytes[len(bytes)-4:]...)
+	require.NotEqual(t, oldChecksum, newChecksum)
+	require.Equal(t, []byte{0x26, 0xD0, 0xa4, 0x05}, newChecksum)
+}
+
+func TestPreviousGtidsEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	gtidSetString := "32a5b8c9-4716-40f5-9a9b-3d7be0cb33d7:1-42"
+	gtidSet, err := ParseMysql56GTIDSet(gtidSetString)
+	require.NoError(t, err)
+
+	event := NewPreviousGtidsEvent(f, m, gtidSet.(Mysql56GTIDSet))
+	require.True(t, event.IsPreviousGTIDs())
+	position, err := event.PreviousGTIDs(f)
+	require.NoError(t, err)
+	require.Equal(t, gtidSetString, position.String())
+}
+
+func TestInvalidEvents(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	// InvalidEvent
+	event := NewInvalidEvent()
+	if event.IsValid() {
+		t.Fatalf("NewInvalidEvent().IsValid() is true")
+	}
+
+	// InvalidFormatDescriptionEvent
+	event = NewInvalidFormatDescriptionEvent(f, m)
+	if !event.IsValid() {
+		t.Fatalf("NewInvalidFormatDescriptionEvent().IsValid() is false")
+	}
+	if !event.IsFormatDescription() {
+		t.Fatalf("NewInvalidFormatDescriptionEvent().IsFormatDescription() is false")
+	}
+	if _, err := event.Format(); err == nil {
+		t.Fatalf("NewInvalidFormatDescriptionEvent().Format() returned err=nil")
+	}
+
+	// InvalidQueryEvent
+	event = NewInvalidQueryEvent(f, m)
+	if !event.IsValid() {
+		t.Fatalf("NewInvalidQueryEvent().IsValid() is false")
+	}
+	if !event.IsQuery() {
+		t.Fatalf("NewInvalidQueryEvent().IsQuery() is false")
+	}
+	if _, err := event.Query(f); err == nil {
+		t.Fatalf("NewInvalidQueryEvent().Query() returned err=nil")
+	}
+}
+
+func TestMariadDBGTIDEVent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+	m.ServerID = 0x87654321
+
+	// With built-in begin.
+	event := NewMariaDBGTIDEvent(f, m, MariadbGTID{Domain: 0, Sequence: 0x123456789abcdef0}, true)
+	if !event.IsValid() {
+		t.Fatalf("NewMariaDBGTIDEvent().IsValid() is false")
+	}
+	if !event.IsGTID() {
+		t.Fatalf("NewMariaDBGTIDEvent().IsGTID() if false")
+	}
+	event, _, err := event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+
+	gtid, hasBegin, err := event.GTID(f)
+	if err != nil {
+		t.Fatalf("NewMariaDBGTIDEvent().GTID() returned error: %v", err)
+	}
+	if !hasBegin {
+		t.Fatalf("NewMariaDBGTIDEvent() didn't store hasBegin properly.")
+	}
+	mgtid, ok := gtid.(MariadbGTID)
+	if !ok {
+		t.Fatalf("NewMariaDBGTIDEvent().GTID() returned a non-MariaDBGTID GTID")
+	}
+	if mgtid.Domain != 0 || mgtid.Server != 0x87654321 || mgtid.Sequence != 0x123456789abcdef0 {
+		t.Fatalf("NewMariaDBGTIDEvent().GTID() returned invalid GITD: %v", mgtid)
+	}
+
+	// Without built-in begin.
+	event = NewMariaDBGTIDEvent(f, m, MariadbGTID{Domain: 0, Sequence: 0x123456789abcdef0}, false)
+	if !event.IsValid() {
+		t.Fatalf("NewMariaDBGTIDEvent().IsValid() is false")
+	}
+	if !event.IsGTID() {
+		t.Fatalf("NewMariaDBGTIDEvent().IsGTID() if false")
+	}
+	event, _, err = event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+
+	gtid, hasBegin, err = event.GTID(f)
+	if err != nil {
+		t.Fatalf("NewMariaDBGTIDEvent().GTID() returned error: %v", err)
+	}
+	if hasBegin {
+		t.Fatalf("NewMariaDBGTIDEvent() didn't store hasBegin properly.")
+	}
+	mgtid, ok = gtid.(MariadbGTID)
+	if !ok {
+		t.Fatalf("NewMariaDBGTIDEvent().GTID() returned a non-MariaDBGTID GTID")
+	}
+	if mgtid.Domain != 0 || mgtid.Server != 0x87654321 || mgtid.Sequence != 0x123456789abcdef0 {
+		t.Fatalf("NewMariaDBGTIDEvent().GTID() returned invalid GITD: %v", mgtid)
+	}
+}
+
+func TestTableMapEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	tm := &TableMap{
+		Flags:    0x8090,
+		Database: "my_database",
+		Name:     "my_table",
+		Types: []byte{
+			TypeLongLong,
+			TypeLongLong,
+			TypeLongLong,
+			TypeLongLong,
+			TypeLongLong,
+			TypeTime,
+			TypeLongLong,
+			TypeLongLong,
+			TypeLongLong,
+			TypeVarchar,
+		},
+		CanBeNull: NewServerBitmap(10),
+		Metadata: []uint16{
+			0,
+			0,
+			0,
+			0,
+			0,
+			0,
+			0,
+			0,
+			0,
+			384, // Length of the varchar field.
+		},
+	}
+	tm.CanBeNull.Set(1, true)
+	tm.CanBeNull.Set(2, true)
+	tm.CanBeNull.Set(5, true)
+	tm.CanBeNull.Set(9, true)
+
+	event, err := NewTableMapEvent(f, m, 0x102030405060, tm)
+	require.NoError(t, err)
+	if !event.IsValid() {
+		t.Fatalf("NewTableMapEvent().IsValid() is false")
+	}
+	if !event.IsTableMap() {
+		t.Fatalf("NewTableMapEvent().IsTableMap() if false")
+	}
+
+	event, _, err = event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+
+	tableID := event.TableID(f)
+	if tableID != 0x102030405060 {
+		t.Fatalf("NewTableMapEvent().TableID returned %x", tableID)
+	}
+	gotTm, err := event.TableMap(f)
+	if err != nil {
+		t.Fatalf("NewTableMapEvent().TableMapEvent() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(gotTm, tm) {
+		t.Fatalf("NewTableMapEvent().TableMapEvent() got TableMap:\n%v\nexpected:\n%v", gotTm, tm)
+	}
+}
+
+// Test serialization of TableMap events that contain optional metadata (e.g. column names, enum values).
+func TestTableMapEventWithOptionalMetadata(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	tm := &TableMap{
+		Flags:    0x8090,
+		Database: "my_database",
+		Name:     "my_table",
+		Types: []byte{
+			TypeLongLong,
+			TypeLongLong,
+			TypeLongLong,
+		},
+		CanBeNull: NewServerBitmap(10),
+		Metadata: []uint16{
+			0,
+			0,
+			0,
+		},
+		OptionalEnumValues:           [][]string{{"apple", "orange"}, {"red", "green"}},
+		OptionalSetValues:            [][]string{{"one", "two", "three"}},
+		OptionalColumnNames:          []string{"foo", "bar", "baz"},
+		OptionalColumnCollations:     []uint64{0, 0, 0},
+		OptionalEnumAndSetCollations: []uint64{0, 0, 0},
+	}
+	tm.CanBeNull.Set(1, true)
+	tm.CanBeNull.Set(2, true)
+	tm.CanBeNull.Set(5, true)
+	tm.CanBeNull.Set(9, true)
+
+	event, err := NewTableMapEvent(f, m, 0x102030405060, tm)
+	require.NoError(t, err)
+	if !event.IsValid() {
+		t.Fatalf("NewTableMapEvent().IsValid() is false")
+	}
+	if !event.IsTableMap() {
+		t.Fatalf("NewTableMapEvent().IsTableMap() if false")
+	}
+
+	event, _, err = event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+
+	tableID := event.TableID(f)
+	if tableID != 0x102030405060 {
+		t.Fatalf("NewTableMapEvent().TableID returned %x", tableID)
+	}
+
+	// NOTE: Vitess doesn't currently include support for deserializing optional table map data (only serializing it)
+	//       so instead of doing round-trip testing of the values, we use static expected bytes that we know have been
+	//       serialized and work correctly with replication clients.
+	var expectedBytes = []byte{
+		0x98, 0x68, 0xe9, 0x53, 0x13, 0x1, 0x0, 0x0, 0x0, 0x81, 0x0, 0x0, 0x0, 0x4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x60,
+		0x50, 0x40, 0x30, 0x20, 0x10, 0x90, 0x80, 0xb, 0x6d, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+		0x65, 0x0, 0x8, 0x6d, 0x79, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x0, 0x3, 0x8, 0x8, 0x8, 0x0, 0x26, 0x2,
+		0x3, 0x3, 0x0, 0x0, 0x0, 0x4, 0xc, 0x3, 0x66, 0x6f, 0x6f, 0x3, 0x62, 0x61, 0x72, 0x3, 0x62, 0x61, 0x7a,
+		0x6, 0x19, 0x2, 0x5, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x6, 0x6f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x2, 0x3,
+		0x72, 0x65, 0x64, 0x5, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x5, 0xf, 0x3, 0x3, 0x6f, 0x6e, 0x65, 0x3, 0x74,
+		0x77, 0x6f, 0x5, 0x74, 0x68, 0x72, 0x65, 0x65, 0xb, 0x3, 0x0, 0x0, 0x0,
+	}
+	require.Equal(t, expectedBytes, event.Bytes())
+}
+
+func TestRowsEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	m := NewTestBinlogMetadata()
+
+	tableID := uint64(0x102030405060)
+
+	tm := &TableMap{
+		Flags:    0x8090,
+		Database: "my_database",
+		Name:     "my_table",
+		Types: []byte{
+			TypeLong,
+			TypeVarchar,
+		},
+		CanBeNull: NewServerBitmap(2),
+		Metadata: []uint16{
+			0,
+			384,
+		},
+	}
+	tm.CanBeNull.Set(1, true)
+
+	// Do an update packet with all fields set.
+	rows := Rows{
+		Flags:           0x1234,
+		IdentifyColumns: NewServerBitmap(2),
+		DataColumns:     NewServerBitmap(2),
+		Rows: []Row{
+			{
+				NullIdentifyColumns: NewServerBitmap(2),
+				NullColumns:         NewServerBitmap(2),
+				Identify: []byte{
+					0x10, 0x20, 0x30, 0x40, // long
+					0x03, 0x00, // len('abc')
+					'a', 'b', 'c', // 'abc'
+				},
+				Data: []byte{
+					0x10, 0x20, 0x30, 0x40, // long
+					0x04, 0x00, // len('abcd')
+					'a', 'b', 'c', 'd', // 'abcd'
+				},
+			},
+		},
+	}
+
+	// All rows are included, none are NULL.
+	rows.IdentifyColumns.Set(0, true)
+	rows.IdentifyColumns.Set(1, true)
+	rows.DataColumns.Set(0, true)
+	rows.DataColumns.Set(1, true)
+
+	// Test the Rows we just created, to be sure.
+	// 1076895760 is 0x40302010.
+	identifies, _ := rows.StringIdentifiesForTests(tm, 0)
+	if expected := []string{"1076895760", "abc"}; !reflect.DeepEqual(identifies, expected) {
+		t.Fatalf("bad Rows identify, got %v expected %v", identifies, expected)
+	}
+	values, _ := rows.StringValuesForTests(tm, 0)
+	if expected := []string{"1076895760", "abcd"}; !reflect.DeepEqual(values, expected) {
+		t.Fatalf("bad Rows data, got %v expected %v", values, expected)
+	}
+
+	event := NewUpdateRowsEvent(f, m, 0x102030405060, rows)
+	if !event.IsValid() {
+		t.Fatalf("NewRowsEvent().IsValid() is false")
+	}
+	if !event.IsUpdateRows() {
+		t.Fatalf("NewRowsEvent().IsUpdateRows() if false")
+	}
+
+	event, _, err := event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+
+	tableID = event.TableID(f)
+	if tableID != 0x102030405060 {
+		t.Fatalf("NewRowsEvent().TableID returned %x", tableID)
+	}
+	gotRows, err := event.Rows(f, tm)
+	if err != nil {
+		t.Fatalf("NewRowsEvent().Rows() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(gotRows, rows) {
+		t.Fatalf("NewRowsEvent().Rows() got Rows:\n%v\nexpected:\n%v", gotRows, rows)
+	}
+}
+
+// NewTestBinlogMetadata returns a simple BinlogStream with hardcoded values for testing.
+func NewTestBinlogMetadata() BinlogEventMetadata {
+	return BinlogEventMetadata{
+		ServerID:        1,
+		NextLogPosition: 4,
+		Timestamp:       1407805592,
+	}
+}
@@ -0,0 +1,27730 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
+//line sql.y:18
+package sqlparser
+
+import __yyfmt__ "fmt"
+
+//line sql.y:18
+
+import "fmt"
+import "strings"
+
+//import "runtime/debug"
+
+func setParseTree(yylex interface{}, stmt Statement) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func setAllowComments(yylex interface{}, allow bool) {
+	yylex.(*Tokenizer).AllowComments = allow
+}
+
+func incNesting(yylex interface{}) bool {
+	yylex.(*Tokenizer).nesting++
+	if yylex.(*Tokenizer).nesting == 200 {
+		return true
+	}
+	return false
+}
+
+func decNesting(yylex interface{}) {
+	yylex.(*Tokenizer).nesting--
+}
+
+func statementSeen(yylex interface{}) {
+	if yylex.(*Tokenizer).stopAfterFirstStmt {
+		yylex.(*Tokenizer).stopped = true
+	}
+}
+
+func yyPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).Position
+}
+
+func yyOldPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).OldPosition
+}
+
+func yyTokenStart(yylex interface{}) int {
+	return yylex.(*Tokenizer).TokenStart
+}
+
+func yySpecialCommentMode(yylex interface{}) bool {
+	tkn := yylex.(*Tokenizer)
+	return tkn.specialComment != nil
+}
+
+func tryCastExpr(v interface{}) Expr {
+	e, _ := v.(Expr)
+	return e
+}
+
+func tryCastStatement(v interface{}) Statement {
+	e, _ := v.(Statement)
+	return e
+}
+
+//line sql.y:79
+type yySymType struct {
+	yys   int
+	val   interface{}
+	bytes []byte
+}
+
+const STRING_TYPE_PREFIX_NON_KEYWORD = 57346
+const LEX_ERROR = 57347
+const FOR_SYSTEM_TIME = 57348
+const FOR_VERSION = 57349
+const EXCEPT = 57350
+const UNION = 57351
+const INTERSECT = 57352
+const SELECT = 57353
+const STREAM = 57354
+const INSERT = 57355
+const UPDATE = 57356
+const DELETE = 57357
+const FROM = 57358
+const WHERE = 57359
+const GROUP = 57360
+const HAVING = 57361
+const ORDER = 57362
+const BY = 57363
+const LIMIT = 57364
+const OFFSET = 57365
+const FOR = 57366
+const CALL = 57367
+const RETURNING = 57368
+const ALL = 57369
+const DISTINCT = 57370
+const AS = 57371
+const EXISTS = 57372
+const ASC = 57373
+const DESC = 57374
+const DUPLICATE = 57375
+const DEFAULT = 57376
+const SET = 57377
+const LOCK = 57378
+const UNLOCK = 57379
+const KEYS = 57380
+const OF = 57381
+const OUTFILE = 57382
+const DUMPFILE = 57383
+const DATA = 57384
+const LOAD = 57385
+const LINES = 57386
+const TERMINATED = 57387
+const ESCAPED = 57388
+const ENCLOSED = 57389
+const OPTIONALLY = 57390
+const STARTING = 57391
+const UNIQUE = 57392
+const KEY = 57393
+const SYSTEM_TIME = 57394
+const CONTAINED = 57395
+const VERSION = 57396
+const VERSIONS = 57397
+const VALUES = 57398
+const LAST_INSERT_ID = 57399
+const SQL_CALC_FOUND_ROWS = 57400
+const NEXT = 57401
+const VALUE = 57402
+const SHARE = 57403
+const MODE = 57404
+const PREVIOUS_VALUE = 57405
+const SQL_NO_CACHE = 57406
+const SQL_CACHE = 57407
+const JOIN = 57408
+const STRAIGHT_JOIN = 57409
+const LEFT = 57410
+const RIGHT = 57411
+const INNER = 57412
+const OUTER = 57413
+const CROSS = 57414
+const NATURAL = 57415
+const USE = 57416
+const FORCE = 57417
+const ON = 57418
+const USING = 57419
+const STRING = 57420
+const ID = 57421
+const HEX = 57422
+const INTEGRAL = 57423
+const FLOAT = 57424
+const HEXNUM = 57425
+const VALUE_ARG = 57426
+const LIST_ARG = 57427
+const COMMENT = 57428
+const COMMENT_KEYWORD = 57429
+const BIT_LITERAL = 57430
+const NULL = 57431
+const TRUE = 57432
+const FALSE = 57433
+const OFF = 57434
+const INTO = 57435
+const OR = 57436
+const XOR = 57437
+const AND = 57438
+const NOT = 57439
+const BETWEEN = 57440
+const CASE = 57441
+const WHEN = 57442
+const THEN = 57443
+const ELSE = 57444
+const ELSEIF = 57445
+const END = 57446
+const LE = 57447
+const GE = 57448
+const NE = 57449
+const NULL_SAFE_EQUAL = 57450
+const IS = 57451
+const LIKE = 57452
+const REGEXP = 57453
+const IN = 57454
+const ASSIGNMENT_OP = 57455
+const MEMBER_OF = 57456
+const UNBOUNDED = 57457
+const PARTITION = 57458
+const RANGE = 57459
+const ROWS = 57460
+const GROUPS = 57461
+const PRECEDING = 57462
+const FOLLOWING = 57463
+const SHIFT_LEFT = 57464
+const SHIFT_RIGHT = 57465
+const DIV = 57466
+const MOD = 57467
+const CONCAT = 57468
+const UNARY = 57469
+const COLLATE = 57470
+const BINARY = 57471
+const UNDERSCORE_ARMSCII8 = 57472
+const UNDERSCORE_ASCII = 57473
+const UNDERSCORE_BIG5 = 57474
+const UNDERSCORE_BINARY = 57475
+const UNDERSCORE_CP1250 = 57476
+const UNDERSCORE_CP1251 = 57477
+const UNDERSCORE_CP1256 = 57478
+const UNDERSCORE_CP1257 = 57479
+const UNDERSCORE_CP850 = 57480
+const UNDERSCORE_CP852 = 57481
+const UNDERSCORE_CP866 = 57482
+const UNDERSCORE_CP932 = 57483
+const UNDERSCORE_DEC8 = 57484
+const UNDERSCORE_EUCJPMS = 57485
+const UNDERSCORE_EUCKR = 57486
+const UNDERSCORE_GB18030 = 57487
+const UNDERSCORE_GB2312 = 57488
+const UNDERSCORE_GBK = 57489
+const UNDERSCORE_GEOSTD8 = 57490
+const UNDERSCORE_GREEK = 57491
+const UNDERSCORE_HEBREW = 57492
+const UNDERSCORE_HP8 = 57493
+const UNDERSCORE_KEYBCS2 = 57494
+const UNDERSCORE_KOI8R = 57495
+const UNDERSCORE_KOI8U = 57496
+const UNDERSCORE_LATIN1 = 57497
+const UNDERSCORE_LATIN2 = 57498
+const UNDERSCORE_LATIN5 = 57499
+const UNDERSCORE_LATIN7 = 57500
+const UNDERSCORE_MACCE = 57501
+const UNDERSCORE_MACROMAN = 57502
+const UNDERSCORE_SJIS = 57503
+const UNDERSCORE_SWE7 = 57504
+const UNDERSCORE_TIS620 = 57505
+const UNDERSCORE_UCS2 = 57506
+const UNDERSCORE_UJIS = 57507
+const UNDERSCORE_UTF16 = 57508
+const UNDERSCORE_UTF16LE = 57509
+const UNDERSCORE_UTF32 = 57510
+const UNDERSCORE_UTF8 = 57511
+const UNDERSCORE_UTF8MB3 = 57512
+const UNDERSCORE_UTF8MB4 = 57513
+const INTERVAL = 57514
+const JSON_EXTRACT_OP = 57515
+const JSON_UNQUOTE_EXTRACT_OP = 57516
+const CREATE = 57517
+const ALTER = 57518
+const DROP = 57519
+const RENAME = 57520
+const ANALYZE = 57521
+const ADD = 57522
+const MODIFY = 57523
+const CHANGE = 57524
+const SCHEMA = 57525
+const TABLE = 57526
+const INDEX = 57527
+const INDEXES = 57528
+const VIEW = 57529
+const TO = 57530
+const IGNORE = 57531
+const IF = 57532
+const PRIMARY = 57533
+const COLUMN = 57534
+const SPATIAL = 57535
+const VECTOR = 57536
+const FULLTEXT = 57537
+const KEY_BLOCK_SIZE = 57538
+const CHECK = 57539
+const ACTION = 57540
+const CASCADE = 57541
+const CONSTRAINT = 57542
+const FOREIGN = 57543
+const NO = 57544
+const REFERENCES = 57545
+const RESTRICT = 57546
+const FIRST = 57547
+const AFTER = 57548
+const LAST = 57549
+const SHOW = 57550
+const DESCRIBE = 57551
+const EXPLAIN = 57552
+const DATE = 57553
+const ESCAPE = 57554
+const REPAIR = 57555
+const OPTIMIZE = 57556
+const TRUNCATE = 57557
+const FORMAT = 57558
+const EXTENDED = 57559
+const PLAN = 57560
+const MAXVALUE = 57561
+const REORGANIZE = 57562
+const LESS = 57563
+const THAN = 57564
+const PROCEDURE = 57565
+const TRIGGER = 57566
+const TRIGGERS = 57567
+const FUNCTION = 57568
+const STATUS = 57569
+const VARIABLES = 57570
+const WARNINGS = 57571
+const ERRORS = 57572
+const KILL = 57573
+const CONNECTION = 57574
+const SEQUENCE = 57575
+const ENABLE = 57576
+const DISABLE = 57577
+const EACH = 57578
+const ROW = 57579
+const BEFORE = 57580
+const FOLLOWS = 57581
+const PRECEDES = 57582
+const DEFINER = 57583
+const INVOKER = 57584
+const INOUT = 57585
+const OUT = 57586
+const DETERMINISTIC = 57587
+const CONTAINS = 57588
+const READS = 57589
+const MODIFIES = 57590
+const SQL = 57591
+const SECURITY = 57592
+const TEMPORARY = 57593
+const ALGORITHM = 57594
+const MERGE = 57595
+const TEMPTABLE = 57596
+const UNDEFINED = 57597
+const EVENT = 57598
+const EVENTS = 57599
+const SCHEDULE = 57600
+const EVERY = 57601
+const STARTS = 57602
+const ENDS = 57603
+const COMPLETION = 57604
+const PRESERVE = 57605
+const CASCADED = 57606
+const INSTANT = 57607
+const INPLACE = 57608
+const COPY = 57609
+const DISCARD = 57610
+const IMPORT = 57611
+const SHARED = 57612
+const EXCLUSIVE = 57613
+const WITHOUT = 57614
+const VALIDATION = 57615
+const COALESCE = 57616
+const EXCHANGE = 57617
+const REBUILD = 57618
+const REMOVE = 57619
+const PARTITIONING = 57620
+const CLASS_ORIGIN = 57621
+const SUBCLASS_ORIGIN = 57622
+const MESSAGE_TEXT = 57623
+const MYSQL_ERRNO = 57624
+const CONSTRAINT_CATALOG = 57625
+const CONSTRAINT_SCHEMA = 57626
+const CONSTRAINT_NAME = 57627
+const CATALOG_NAME = 57628
+const SCHEMA_NAME = 57629
+const TABLE_NAME = 57630
+const COLUMN_NAME = 57631
+const CURSOR_NAME = 57632
+const SIGNAL = 57633
+const RESIGNAL = 57634
+const SQLSTATE = 57635
+const DECLARE = 57636
+const CONDITION = 57637
+const CURSOR = 57638
+const CONTINUE = 57639
+const EXIT = 57640
+const UNDO = 57641
+const HANDLER = 57642
+const FOUND = 57643
+const SQLWARNING = 57644
+const SQLEXCEPTION = 57645
+const FETCH = 57646
+const OPEN = 57647
+const CLOSE = 57648
+const LOOP = 57649
+const LEAVE = 57650
+const ITERATE = 57651
+const REPEAT = 57652
+const UNTIL = 57653
+const WHILE = 57654
+const DO = 57655
+const RETURN = 57656
+const RETURNS = 57657
+const AGGREGATE = 57658
+const SONAME = 57659
+const USER = 57660
+const IDENTIFIED = 57661
+const ROLE = 57662
+const REUSE = 57663
+const GRANT = 57664
+const GRANTS = 57665
+const REVOKE = 57666
+const NONE = 57667
+const ATTRIBUTE = 57668
+const RANDOM = 57669
+const PASSWORD = 57670
+const INITIAL = 57671
+const AUTHENTICATION = 57672
+const SSL = 57673
+const X509 = 57674
+const CIPHER = 57675
+const ISSUER = 57676
+const SUBJECT = 57677
+const ACCOUNT = 57678
+const EXPIRE = 57679
+const NEVER = 57680
+const OPTION = 57681
+const OPTIONAL = 57682
+const ADMIN = 57683
+const PRIVILEGES = 57684
+const MAX_QUERIES_PER_HOUR = 57685
+const MAX_UPDATES_PER_HOUR = 57686
+const MAX_CONNECTIONS_PER_HOUR = 57687
+const MAX_USER_CONNECTIONS = 57688
+const FLUSH = 57689
+const FAILED_LOGIN_ATTEMPTS = 57690
+const PASSWORD_LOCK_TIME = 57691
+const REQUIRE = 57692
+const PROXY = 57693
+const ROUTINE = 57694
+const TABLESPACE = 57695
+const CLIENT = 57696
+const SLAVE = 57697
+const EXECUTE = 57698
+const FILE = 57699
+const RELOAD = 57700
+const REPLICATION = 57701
+const SHUTDOWN = 57702
+const SUPER = 57703
+const USAGE = 57704
+const LOGS = 57705
+const ENGINE = 57706
+const ERROR = 57707
+const GENERAL = 57708
+const HOSTS = 57709
+const BINLOG = 57710
+const OPTIMIZER_COSTS = 57711
+const RELAY = 57712
+const SLOW = 57713
+const USER_RESOURCES = 57714
+const NO_WRITE_TO_BINLOG = 57715
+const CHANNEL = 57716
+const UNKNOWN = 57717
+const APPLICATION_PASSWORD_ADMIN = 57718
+const AUDIT_ABORT_EXEMPT = 57719
+const AUDIT_ADMIN = 57720
+const AUTHENTICATION_POLICY_ADMIN = 57721
+const BACKUP_ADMIN = 57722
+const BINLOG_ADMIN = 57723
+const BINLOG_ENCRYPTION_ADMIN = 57724
+const CLONE_ADMIN = 57725
+const CONNECTION_ADMIN = 57726
+const ENCRYPTION_KEY_ADMIN = 57727
+const FIREWALL_ADMIN = 57728
+const FIREWALL_EXEMPT = 57729
+const FIREWALL_USER = 57730
+const FLUSH_OPTIMIZER_COSTS = 57731
+const FLUSH_STATUS = 57732
+const FLUSH_TABLES = 57733
+const FLUSH_USER_RESOURCES = 57734
+const GROUP_REPLICATION_ADMIN = 57735
+const GROUP_REPLICATION_STREAM = 57736
+const INNODB_REDO_LOG_ARCHIVE = 57737
+const INNODB_REDO_LOG_ENABLE = 57738
+const NDB_STORED_USER = 57739
+const PASSWORDLESS_USER_ADMIN = 57740
+const PERSIST_RO_VARIABLES_ADMIN = 57741
+const REPLICATION_APPLIER = 57742
+const REPLICATION_SLAVE_ADMIN = 57743
+const RESOURCE_GROUP_ADMIN = 57744
+const RESOURCE_GROUP_USER = 57745
+const ROLE_ADMIN = 57746
+const SENSITIVE_VARIABLES_OBSERVER = 57747
+const SESSION_VARIABLES_ADMIN = 57748
+const SET_USER_ID = 57749
+const SHOW_ROUTINE = 57750
+const SKIP_QUERY_REWRITE = 57751
+const SYSTEM_VARIABLES_ADMIN = 57752
+const TABLE_ENCRYPTION_ADMIN = 57753
+const TP_CONNECTION_ADMIN = 57754
+const VERSION_TOKEN_ADMIN = 57755
+const XA_RECOVER_ADMIN = 57756
+const REPLICA = 57757
+const REPLICAS = 57758
+const SOURCE = 57759
+const STOP = 57760
+const RESET = 57761
+const FILTER = 57762
+const LOG = 57763
+const MASTER = 57764
+const SOURCE_HOST = 57765
+const SOURCE_SSL = 57766
+const SOURCE_USER = 57767
+const SOURCE_PASSWORD = 57768
+const SOURCE_PORT = 57769
+const SOURCE_CONNECT_RETRY = 57770
+const SOURCE_RETRY_COUNT = 57771
+const SOURCE_AUTO_POSITION = 57772
+const REPLICATE_DO_TABLE = 57773
+const REPLICATE_IGNORE_TABLE = 57774
+const IO_THREAD = 57775
+const SQL_THREAD = 57776
+const BEGIN = 57777
+const START = 57778
+const TRANSACTION = 57779
+const COMMIT = 57780
+const ROLLBACK = 57781
+const SAVEPOINT = 57782
+const WORK = 57783
+const RELEASE = 57784
+const CHAIN = 57785
+const CONSISTENT = 57786
+const SNAPSHOT = 57787
+const BIT = 57788
+const TINYINT = 57789
+const SMALLINT = 57790
+const MEDIUMINT = 57791
+const INT = 57792
+const INTEGER = 57793
+const BIGINT = 57794
+const INTNUM = 57795
+const SERIAL = 57796
+const INT1 = 57797
+const INT2 = 57798
+const INT3 = 57799
+const INT4 = 57800
+const INT8 = 57801
+const REAL = 57802
+const DOUBLE = 57803
+const FLOAT_TYPE = 57804
+const DECIMAL = 57805
+const NUMERIC = 57806
+const DEC = 57807
+const FIXED = 57808
+const PRECISION = 57809
+const TIME = 57810
+const TIMESTAMP = 57811
+const DATETIME = 57812
+const CHAR = 57813
+const VARCHAR = 57814
+const BOOL = 57815
+const CHARACTER = 57816
+const VARBINARY = 57817
+const NCHAR = 57818
+const NVARCHAR = 57819
+const NATIONAL = 57820
+const VARYING = 57821
+const VARCHARACTER = 57822
+const TEXT = 57823
+const TINYTEXT = 57824
+const MEDIUMTEXT = 57825
+const LONGTEXT = 57826
+const LONG = 57827
+const BLOB = 57828
+const TINYBLOB = 57829
+const MEDIUMBLOB = 57830
+const LONGBLOB = 57831
+const JSON = 57832
+const ENUM = 57833
+const GEOMETRY = 57834
+const POINT = 57835
+const LINESTRING = 57836
+const POLYGON = 57837
+const GEOMETRYCOLLECTION = 57838
+const MULTIPOINT = 57839
+const MULTILINESTRING = 57840
+const MULTIPOLYGON = 57841
+const LOCAL = 57842
+const LOW_PRIORITY = 57843
+const SKIP = 57844
+const LOCKED = 57845
+const NULLX = 57846
+const AUTO_INCREMENT = 57847
+const APPROXNUM = 57848
+const SIGNED = 57849
+const UNSIGNED = 57850
+const ZEROFILL = 57851
+const SRID = 57852
+const COLLATION = 57853
+const DATABASES = 57854
+const SCHEMAS = 57855
+const TABLES = 57856
+const FULL = 57857
+const PROCESSLIST = 57858
+const COLUMNS = 57859
+const FIELDS = 57860
+const ENGINES = 57861
+const PLUGINS = 57862
+const NAMES = 57863
+const CHARSET = 57864
+const GLOBAL = 57865
+const SESSION = 57866
+const ISOLATION = 57867
+const LEVEL = 57868
+const READ = 57869
+const WRITE = 57870
+const ONLY = 57871
+const REPEATABLE = 57872
+const COMMITTED = 57873
+const UNCOMMITTED = 57874
+const SERIALIZABLE = 57875
+const ENCRYPTION = 57876
+const CURRENT_TIMESTAMP = 57877
+const NOW = 57878
+const DATABASE = 57879
+const CURRENT_DATE = 57880
+const CURRENT_USER = 57881
+const CURRENT_TIME = 57882
+const LOCALTIME = 57883
+const LOCALTIMESTAMP = 57884
+const UTC_DATE = 57885
+const UTC_TIME = 57886
+const UTC_TIMESTAMP = 57887
+const REPLACE = 57888
+const CONVERT = 57889
+const CAST = 57890
+const POSITION = 57891
+const SUBSTR = 57892
+const SUBSTRING = 57893
+const TRIM = 57894
+const LEADING = 57895
+const TRAILING = 57896
+const BOTH = 57897
+const GROUP_CONCAT = 57898
+const SEPARATOR = 57899
+const TIMESTAMPADD = 57900
+const TIMESTAMPDIFF = 57901
+const EXTRACT = 57902
+const GET_FORMAT = 57903
+const OVER = 57904
+const WINDOW = 57905
+const GROUPING = 57906
+const CURRENT = 57907
+const AVG = 57908
+const BIT_AND = 57909
+const BIT_OR = 57910
+const BIT_XOR = 57911
+const COUNT = 57912
+const JSON_ARRAYAGG = 57913
+const JSON_OBJECTAGG = 57914
+const MAX = 57915
+const MIN = 57916
+const STDDEV_POP = 57917
+const STDDEV = 57918
+const STD = 57919
+const STDDEV_SAMP = 57920
+const SUM = 57921
+const VAR_POP = 57922
+const VARIANCE = 57923
+const VAR_SAMP = 57924
+const CUME_DIST = 57925
+const DENSE_RANK = 57926
+const FIRST_VALUE = 57927
+const LAG = 57928
+const LAST_VALUE = 57929
+const LEAD = 57930
+const NTH_VALUE = 57931
+const NTILE = 57932
+const ROW_NUMBER = 57933
+const PERCENT_RANK = 57934
+const RANK = 57935
+const DUAL = 57936
+const JSON_TABLE = 57937
+const PATH = 57938
+const AVG_ROW_LENGTH = 57939
+const CHECKSUM = 57940
+const COMPACT = 57941
+const COMPRESSED = 57942
+const COMPRESSION = 57943
+const DISK = 57944
+const DIRECTORY = 57945
+const DELAY_KEY_WRITE = 57946
+const DYNAMIC = 57947
+const ENGINE_ATTRIBUTE = 57948
+const ENCRYPTED = 57949
+const ENCRYPTION_KEY_ID = 57950
+const HASH = 57951
+const INSERT_METHOD = 57952
+const ITEF_QUOTES = 57953
+const LIST = 57954
+const MIN_ROWS = 57955
+const MAX_ROWS = 57956
+const PACK_KEYS = 57957
+const MEMORY = 57958
+const PAGE_CHECKSUM = 57959
+const PAGE_COMPRESSED = 57960
+const PAGE_COMPRESSION_LEVEL = 57961
+const PARTITIONS = 57962
+const REDUNDANT = 57963
+const ROW_FORMAT = 57964
+const SECONDARY_ENGINE = 57965
+const SECONDARY_ENGINE_ATTRIBUTE = 57966
+const STATS_AUTO_RECALC = 57967
+const STATS_PERSISTENT = 57968
+const STATS_SAMPLE_PAGES = 57969
+const STORAGE = 57970
+const SUBPARTITION = 57971
+const SUBPARTITIONS = 57972
+const TABLE_CHECKSUM = 57973
+const TRANSACTIONAL = 57974
+const VERSIONING = 57975
+const YES = 57976
+const PREPARE = 57977
+const DEALLOCATE = 57978
+const MATCH = 57979
+const AGAINST = 57980
+const BOOLEAN = 57981
+const LANGUAGE = 57982
+const WITH = 57983
+const QUERY = 57984
+const EXPANSION = 57985
+const MICROSECOND = 57986
+const SECOND = 57987
+const MINUTE = 57988
+const HOUR = 57989
+const DAY = 57990
+const WEEK = 57991
+const MONTH = 57992
+const QUARTER = 57993
+const YEAR = 57994
+const SECOND_MICROSECOND = 57995
+const MINUTE_MICROSECOND = 57996
+const MINUTE_SECOND = 57997
+const HOUR_MICROSECOND = 57998
+const HOUR_SECOND = 57999
+const HOUR_MINUTE = 58000
+const DAY_MICROSECOND = 58001
+const DAY_SECOND = 58002
+const DAY_MINUTE = 58003
+const DAY_HOUR = 58004
+const YEAR_MONTH = 58005
+const NAME = 58006
+const SYSTEM = 58007
+const ACCESSIBLE = 58008
+const ASENSITIVE = 58009
+const CUBE = 58010
+const DELAYED = 58011
+const DISTINCTROW = 58012
+const EMPTY = 58013
+const FLOAT4 = 58014
+const FLOAT8 = 58015
+const GET = 58016
+const HIGH_PRIORITY = 58017
+const INSENSITIVE = 58018
+const IO_AFTER_GTIDS = 58019
+const IO_BEFORE_GTIDS = 58020
+const LINEAR = 58021
+const MASTER_BIND = 58022
+const MASTER_SSL_VERIFY_SERVER_CERT = 58023
+const MIDDLEINT = 58024
+const PURGE = 58025
+const READ_WRITE = 58026
+const RLIKE = 58027
+const SENSITIVE = 58028
+const SPECIFIC = 58029
+const SQL_BIG_RESULT = 58030
+const SQL_SMALL_RESULT = 58031
+const UNUSED = 58032
+const DESCRIPTION = 58033
+const LATERAL = 58034
+const MEMBER = 58035
+const RECURSIVE = 58036
+const BUCKETS = 58037
+const CLONE = 58038
+const COMPONENT = 58039
+const DEFINITION = 58040
+const ENFORCED = 58041
+const NOT_ENFORCED = 58042
+const EXCLUDE = 58043
+const GEOMCOLLECTION = 58044
+const GET_MASTER_PUBLIC_KEY = 58045
+const HISTOGRAM = 58046
+const HISTORY = 58047
+const INACTIVE = 58048
+const INVISIBLE = 58049
+const MASTER_COMPRESSION_ALGORITHMS = 58050
+const MASTER_PUBLIC_KEY_PATH = 58051
+const MASTER_TLS_CIPHERSUITES = 58052
+const MASTER_ZSTD_COMPRESSION_LEVEL = 58053
+const NESTED = 58054
+const NETWORK_NAMESPACE = 58055
+const NOWAIT = 58056
+const NULLS = 58057
+const OJ = 58058
+const OLD = 58059
+const ORDINALITY = 58060
+const ORGANIZATION = 58061
+const OTHERS = 58062
+const PERSIST = 58063
+const PERSIST_ONLY = 58064
+const PRIVILEGE_CHECKS_USER = 58065
+const PROCESS = 58066
+const REFERENCE = 58067
+const REQUIRE_ROW_FORMAT = 58068
+const RESOURCE = 58069
+const RESPECT = 58070
+const RESTART = 58071
+const RETAIN = 58072
+const SECONDARY = 58073
+const SECONDARY_LOAD = 58074
+const SECONDARY_UNLOAD = 58075
+const THREAD_PRIORITY = 58076
+const TIES = 58077
+const VCPU = 58078
+const VISIBLE = 58079
+const INFILE = 58080
+const ROLLUP = 58081
+const SETS = 58082
+const WITH_ROLLUP = 58083
+const ACTIVE = 58084
+const ANY = 58085
+const ARRAY = 58086
+const ASCII = 58087
+const AT = 58088
+const AUTOEXTEND_SIZE = 58089
+const GENERATED = 58090
+const ALWAYS = 58091
+const STORED = 58092
+const VIRTUAL = 58093
+const TARGET_ROW_SIZE = 58094
+const TOAST_TUPLE_TARGET = 58095
+const NVAR = 58096
+const PASSWORD_LOCK = 58097
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"STRING_TYPE_PREFIX_NON_KEYWORD",
+	"LEX_ERROR",
+	"FOR_SYSTEM_TIME",
+	"FOR_VERSION",
+	"EXCEPT",
+	"UNION",
+	"INTERSECT",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"CALL",
+	"RETURNING",
+	"ALL",
+	"DISTINCT",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"DUPLICATE",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"UNLOCK",
+	"KEYS",
+	"OF",
+	"OUTFILE",
+	"DUMPFILE",
+	"DATA",
+	"LOAD",
+	"LINES",
+	"TERMINATED",
+	"ESCAPED",
+	"ENCLOSED",
+	"OPTIONALLY",
+	"STARTING",
+	"UNIQUE",
+	"KEY",
+	"SYSTEM_TIME",
+	"CONTAINED",
+	"VERSION",
+	"VERSIONS",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"SQL_CALC_FOUND_ROWS",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"PREVIOUS_VALUE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
+	"USE",
+	"FORCE",
+	"ON",
+	"USING",
+	"'('",
+	"','",
+	"')'",
+	"'@'",
+	"':'",
+	"STRING",
+	"ID",
+	"HEX",
+	"INTEGRAL",
+	"FLOAT",
+	"HEXNUM",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
+	"BIT_LITERAL",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"OFF",
+	"INTO",
+	"OR",
+	"XOR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"ELSEIF",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"ASSIGNMENT_OP",
+	"MEMBER_OF",
+	"UNBOUNDED",
+	"PARTITION",
+	"RANGE",
+	"ROWS",
+	"GROUPS",
+	"PRECEDING",
+	"FOLLOWING",
+	"'|'",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"CONCAT",
+	"'^'",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_ARMSCII8",
+	"UNDERSCORE_ASCII",
+	"UNDERSCORE_BIG5",
+	"UNDERSCORE_BINARY",
+	"UNDERSCORE_CP1250",
+	"UNDERSCORE_CP1251",
+	"UNDERSCORE_CP1256",
+	"UNDERSCORE_CP1257",
+	"UNDERSCORE_CP850",
+	"UNDERSCORE_CP852",
+	"UNDERSCORE_CP866",
+	"UNDERSCORE_CP932",
+	"UNDERSCORE_DEC8",
+	"UNDERSCORE_EUCJPMS",
+	"UNDERSCORE_EUCKR",
+	"UNDERSCORE_GB18030",
+	"UNDERSCORE_GB2312",
+	"UNDERSCORE_GBK",
+	"UNDERSCORE_GEOSTD8",
+	"UNDERSCORE_GREEK",
+	"UNDERSCORE_HEBREW",
+	"UNDERSCORE_HP8",
+	"UNDERSCORE_KEYBCS2",
+	"UNDERSCORE_KOI8R",
+	"UNDERSCORE_KOI8U",
+	"UNDERSCORE_LATIN1",
+	"UNDERSCORE_LATIN2",
+	"UNDERSCORE_LATIN5",
+	"UNDERSCORE_LATIN7",
+	"UNDERSCORE_MACCE",
+	"UNDERSCORE_MACROMAN",
+	"UNDERSCORE_SJIS",
+	"UNDERSCORE_SWE7",
+	"UNDERSCORE_TIS620",
+	"UNDERSCORE_UCS2",
+	"UNDERSCORE_UJIS",
+	"UNDERSCORE_UTF16",
+	"UNDERSCORE_UTF16LE",
+	"UNDERSCORE_UTF32",
+	"UNDERSCORE_UTF8",
+	"UNDERSCORE_UTF8MB3",
+	"UNDERSCORE_UTF8MB4",
+	"INTERVAL",
+	"'.'",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"MODIFY",
+	"CHANGE",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"INDEXES",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"PRIMARY",
+	"COLUMN",
+	"SPATIAL",
+	"VECTOR",
+	"FULLTEXT",
+	"KEY_BLOCK_SIZE",
+	"CHECK",
+	"ACTION",
+	"CASCADE",
+	"CONSTRAINT",
+	"FOREIGN",
+	"NO",
+	"REFERENCES",
+	"RESTRICT",
+	"FIRST",
+	"AFTER",
+	"LAST",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"FORMAT",
+	"EXTENDED",
+	"PLAN",
+	"MAXVALUE",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"TRIGGERS",
+	"FUNCTION",
+	"STATUS",
+	"VARIABLES",
+	"WARNINGS",
+	"ERRORS",
+	"KILL",
+	"CONNECTION",
+	"SEQUENCE",
+	"ENABLE",
+	"DISABLE",
+	"EACH",
+	"ROW",
+	"BEFORE",
+	"FOLLOWS",
+	"PRECEDES",
+	"DEFINER",
+	"INVOKER",
+	"INOUT",
+	"OUT",
+	"DETERMINISTIC",
+	"CONTAINS",
+	"READS",
+	"MODIFIES",
+	"SQL",
+	"SECURITY",
+	"TEMPORARY",
+	"ALGORITHM",
+	"MERGE",
+	"TEMPTABLE",
+	"UNDEFINED",
+	"EVENT",
+	"EVENTS",
+	"SCHEDULE",
+	"EVERY",
+	"STARTS",
+	"ENDS",
+	"COMPLETION",
+	"PRESERVE",
+	"CASCADED",
+	"INSTANT",
+	"INPLACE",
+	"COPY",
+	"DISCARD",
+	"IMPORT",
+	"SHARED",
+	"EXCLUSIVE",
+	"WITHOUT",
+	"VALIDATION",
+	"COALESCE",
+	"EXCHANGE",
+	"REBUILD",
+	"REMOVE",
+	"PARTITIONING",
+	"CLASS_ORIGIN",
+	"SUBCLASS_ORIGIN",
+	"MESSAGE_TEXT",
+	"MYSQL_ERRNO",
+	"CONSTRAINT_CATALOG",
+	"CONSTRAINT_SCHEMA",
+	"CONSTRAINT_NAME",
+	"CATALOG_NAME",
+	"SCHEMA_NAME",
+	"TABLE_NAME",
+	"COLUMN_NAME",
+	"CURSOR_NAME",
+	"SIGNAL",
+	"RESIGNAL",
+	"SQLSTATE",
+	"DECLARE",
+	"CONDITION",
+	"CURSOR",
+	"CONTINUE",
+	"EXIT",
+	"UNDO",
+	"HANDLER",
+	"FOUND",
+	"SQLWARNING",
+	"SQLEXCEPTION",
+	"FETCH",
+	"OPEN",
+	"CLOSE",
+	"LOOP",
+	"LEAVE",
+	"ITERATE",
+	"REPEAT",
+	"UNTIL",
+	"WHILE",
+	"DO",
+	"RETURN",
+	"RETURNS",
+	"AGGREGATE",
+	"SONAME",
+	"USER",
+	"IDENTIFIED",
+	"ROLE",
+	"REUSE",
+	"GRANT",
+	"GRANTS",
+	"REVOKE",
+	"NONE",
+	"ATTRIBUTE",
+	"RANDOM",
+	"PASSWORD",
+	"INITIAL",
+	"AUTHENTICATION",
+	"SSL",
+	"X509",
+	"CIPHER",
+	"ISSUER",
+	"SUBJECT",
+	"ACCOUNT",
+	"EXPIRE",
+	"NEVER",
+	"OPTION",
+	"OPTIONAL",
+	"ADMIN",
+	"PRIVILEGES",
+	"MAX_QUERIES_PER_HOUR",
+	"MAX_UPDATES_PER_HOUR",
+	"MAX_CONNECTIONS_PER_HOUR",
+	"MAX_USER_CONNECTIONS",
+	"FLUSH",
+	"FAILED_LOGIN_ATTEMPTS",
+	"PASSWORD_LOCK_TIME",
+	"REQUIRE",
+	"PROXY",
+	"ROUTINE",
+	"TABLESPACE",
+	"CLIENT",
+	"SLAVE",
+	"EXECUTE",
+	"FILE",
+	"RELOAD",
+	"REPLICATION",
+	"SHUTDOWN",
+	"SUPER",
+	"USAGE",
+	"LOGS",
+	"ENGINE",
+	"ERROR",
+	"GENERAL",
+	"HOSTS",
+	"BINLOG",
+	"OPTIMIZER_COSTS",
+	"RELAY",
+	"SLOW",
+	"USER_RESOURCES",
+	"NO_WRITE_TO_BINLOG",
+	"CHANNEL",
+	"UNKNOWN",
+	"APPLICATION_PASSWORD_ADMIN",
+	"AUDIT_ABORT_EXEMPT",
+	"AUDIT_ADMIN",
+	"AUTHENTICATION_POLICY_ADMIN",
+	"BACKUP_ADMIN",
+	"BINLOG_ADMIN",
+	"BINLOG_ENCRYPTION_ADMIN",
+	"CLONE_ADMIN",
+	"CONNECTION_ADMIN",
+	"ENCRYPTION_KEY_ADMIN",
+	"FIREWALL_ADMIN",
+	"FIREWALL_EXEMPT",
+	"FIREWALL_USER",
+	"FLUSH_OPTIMIZER_COSTS",
+	"FLUSH_STATUS",
+	"FLUSH_TABLES",
+	"FLUSH_USER_RESOURCES",
+	"GROUP_REPLICATION_ADMIN",
+	"GROUP_REPLICATION_STREAM",
+	"INNODB_REDO_LOG_ARCHIVE",
+	"INNODB_REDO_LOG_ENABLE",
+	"NDB_STORED_USER",
+	"PASSWORDLESS_USER_ADMIN",
+	"PERSIST_RO_VARIABLES_ADMIN",
+	"REPLICATION_APPLIER",
+	"REPLICATION_SLAVE_ADMIN",
+	"RESOURCE_GROUP_ADMIN",
+	"RESOURCE_GROUP_USER",
+	"ROLE_ADMIN",
+	"SENSITIVE_VARIABLES_OBSERVER",
+	"SESSION_VARIABLES_ADMIN",
+	"SET_USER_ID",
+	"SHOW_ROUTINE",
+	"SKIP_QUERY_REWRITE",
+	"SYSTEM_VARIABLES_ADMIN",
+	"TABLE_ENCRYPTION_ADMIN",
+	"TP_CONNECTION_ADMIN",
+	"VERSION_TOKEN_ADMIN",
+	"XA_RECOVER_ADMIN",
+	"REPLICA",
+	"REPLICAS",
+	"SOURCE",
+	"STOP",
+	"RESET",
+	"FILTER",
+	"LOG",
+	"MASTER",
+	"SOURCE_HOST",
+	"SOURCE_SSL",
+	"SOURCE_USER",
+	"SOURCE_PASSWORD",
+	"SOURCE_PORT",
+	"SOURCE_CONNECT_RETRY",
+	"SOURCE_RETRY_COUNT",
+	"SOURCE_AUTO_POSITION",
+	"REPLICATE_DO_TABLE",
+	"REPLICATE_IGNORE_TABLE",
+	"IO_THREAD",
+	"SQL_THREAD",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"SAVEPOINT",
+	"WORK",
+	"RELEASE",
+	"CHAIN",
+	"CONSISTENT",
+	"SNAPSHOT",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"SERIAL",
+	"INT1",
+	"INT2",
+	"INT3",
+	"INT4",
+	"INT8",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"DEC",
+	"FIXED",
+	"PRECISION",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"NVARCHAR",
+	"NATIONAL",
+	"VARYING",
+	"VARCHARACTER",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"LONG",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"LOCAL",
+	"LOW_PRIORITY",
+	"SKIP",
+	"LOCKED",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"SRID",
+	"COLLATION",
+	"DATABASES",
+	"SCHEMAS",
+	"TABLES",
+	"FULL",
+	"PROCESSLIST",
+	"COLUMNS",
+	"FIELDS",
+	"ENGINES",
+	"PLUGINS",
+	"NAMES",
+	"CHARSET",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"ENCRYPTION",
+	"CURRENT_TIMESTAMP",
+	"NOW",
+	"DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_USER",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"CAST",
+	"POSITION",
+	"SUBSTR",
+	"SUBSTRING",
+	"TRIM",
+	"LEADING",
+	"TRAILING",
+	"BOTH",
+	"GROUP_CONCAT",
+	"SEPARATOR",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"EXTRACT",
+	"GET_FORMAT",
+	"OVER",
+	"WINDOW",
+	"GROUPING",
+	"CURRENT",
+	"AVG",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"COUNT",
+	"JSON_ARRAYAGG",
+	"JSON_OBJECTAGG",
+	"MAX",
+	"MIN",
+	"STDDEV_POP",
+	"STDDEV",
+	"STD",
+	"STDDEV_SAMP",
+	"SUM",
+	"VAR_POP",
+	"VARIANCE",
+	"VAR_SAMP",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"ROW_NUMBER",
+	"PERCENT_RANK",
+	"RANK",
+	"DUAL",
+	"JSON_TABLE",
+	"PATH",
+	"AVG_ROW_LENGTH",
+	"CHECKSUM",
+	"COMPACT",
+	"COMPRESSED",
+	"COMPRESSION",
+	"DISK",
+	"DIRECTORY",
+	"DELAY_KEY_WRITE",
+	"DYNAMIC",
+	"ENGINE_ATTRIBUTE",
+	"ENCRYPTED",
+	"ENCRYPTION_KEY_ID",
+	"HASH",
+	"INSERT_METHOD",
+	"ITEF_QUOTES",
+	"LIST",
+	"MIN_ROWS",
+	"MAX_ROWS",
+	"PACK_KEYS",
+	"MEMORY",
+	"PAGE_CHECKSUM",
+	"PAGE_COMPRESSED",
+	"PAGE_COMPRESSION_LEVEL",
+	"PARTITIONS",
+	"REDUNDANT",
+	"ROW_FORMAT",
+	"SECONDARY_ENGINE",
+	"SECONDARY_ENGINE_ATTRIBUTE",
+	"STATS_AUTO_RECALC",
+	"STATS_PERSISTENT",
+	"STATS_SAMPLE_PAGES",
+	"STORAGE",
+	"SUBPARTITION",
+	"SUBPARTITIONS",
+	"TABLE_CHECKSUM",
+	"TRANSACTIONAL",
+	"VERSIONING",
+	"YES",
+	"PREPARE",
+	"DEALLOCATE",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"WITH",
+	"QUERY",
+	"EXPANSION",
+	"MICROSECOND",
+	"SECOND",
+	"MINUTE",
+	"HOUR",
+	"DAY",
+	"WEEK",
+	"MONTH",
+	"QUARTER",
+	"YEAR",
+	"SECOND_MICROSECOND",
+	"MINUTE_MICROSECOND",
+	"MINUTE_SECOND",
+	"HOUR_MICROSECOND",
+	"HOUR_SECOND",
+	"HOUR_MINUTE",
+	"DAY_MICROSECOND",
+	"DAY_SECOND",
+	"DAY_MINUTE",
+	"DAY_HOUR",
+	"YEAR_MONTH",
+	"NAME",
+	"SYSTEM",
+	"ACCESSIBLE",
+	"ASENSITIVE",
+	"CUBE",
+	"DELAYED",
+	"DISTINCTROW",
+	"EMPTY",
+	"FLOAT4",
+	"FLOAT8",
+	"GET",
+	"HIGH_PRIORITY",
+	"INSENSITIVE",
+	"IO_AFTER_GTIDS",
+	"IO_BEFORE_GTIDS",
+	"LINEAR",
+	"MASTER_BIND",
+	"MASTER_SSL_VERIFY_SERVER_CERT",
+	"MIDDLEINT",
+	"PURGE",
+	"READ_WRITE",
+	"RLIKE",
+	"SENSITIVE",
+	"SPECIFIC",
+	"SQL_BIG_RESULT",
+	"SQL_SMALL_RESULT",
+	"UNUSED",
+	"DESCRIPTION",
+	"LATERAL",
+	"MEMBER",
+	"RECURSIVE",
+	"BUCKETS",
+	"CLONE",
+	"COMPONENT",
+	"DEFINITION",
+	"ENFORCED",
+	"NOT_ENFORCED",
+	"EXCLUDE",
+	"GEOMCOLLECTION",
+	"GET_MASTER_PUBLIC_KEY",
+	"HISTOGRAM",
+	"HISTORY",
+	"INACTIVE",
+	"INVISIBLE",
+	"MASTER_COMPRESSION_ALGORITHMS",
+	"MASTER_PUBLIC_KEY_PATH",
+	"MASTER_TLS_CIPHERSUITES",
+	"MASTER_ZSTD_COMPRESSION_LEVEL",
+	"NESTED",
+	"NETWORK_NAMESPACE",
+	"NOWAIT",
+	"NULLS",
+	"OJ",
+	"OLD",
+	"ORDINALITY",
+	"ORGANIZATION",
+	"OTHERS",
+	"PERSIST",
+	"PERSIST_ONLY",
+	"PRIVILEGE_CHECKS_USER",
+	"PROCESS",
+	"REFERENCE",
+	"REQUIRE_ROW_FORMAT",
+	"RESOURCE",
+	"RESPECT",
+	"RESTART",
+	"RETAIN",
+	"SECONDARY",
+	"SECONDARY_LOAD",
+	"SECONDARY_UNLOAD",
+	"THREAD_PRIORITY",
+	"TIES",
+	"VCPU",
+	"VISIBLE",
+	"INFILE",
+	"ROLLUP",
+	"SETS",
+	"WITH_ROLLUP",
+	"ACTIVE",
+	"ANY",
+	"ARRAY",
+	"ASCII",
+	"AT",
+	"AUTOEXTEND_SIZE",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
+	"TARGET_ROW_SIZE",
+	"TOAST_TUPLE_TARGET",
+	"NVAR",
+	"PASSWORD_LOCK",
+	"';'",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line yacctab:1
+var yyExca = [...]int16{
+	-1, 0,
+	1, 1336,
+	91, 1336,
+	775, 1336,
+	-2, 82,
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 53,
+	203, 1942,
+	204, 1963,
+	-2, 382,
+	-1, 67,
+	246, 1291,
+	247, 1291,
+	-2, 1280,
+	-1, 98,
+	275, 382,
+	-2, 1948,
+	-1, 102,
+	8, 61,
+	9, 61,
+	10, 61,
+	-2, 54,
+	-1, 103,
+	8, 64,
+	9, 64,
+	-2, 55,
+	-1, 568,
+	1, 2667,
+	6, 2667,
+	7, 2667,
+	29, 2667,
+	191, 2667,
+	775, 2667,
+	-2, 1326,
+	-1, 583,
+	191, 1975,
+	-2, 1969,
+	-1, 584,
+	191, 1976,
+	-2, 1970,
+	-1, 691,
+	1, 766,
+	775, 766,
+	-2, 764,
+	-1, 700,
+	1, 1432,
+	8, 1432,
+	9, 1432,
+	10, 1432,
+	17, 1432,
+	18, 1432,
+	19, 1432,
+	20, 1432,
+	22, 1432,
+	24, 1432,
+	26, 1432,
+	35, 1432,
+	36, 1432,
+	66, 1432,
+	67, 1432,
+	68, 1432,
+	69, 1432,
+	70, 1432,
+	72, 1432,
+	73, 1432,
+	76, 1432,
+	77, 1432,
+	79, 1432,
+	80, 1432,
+	98, 1432,
+	534, 1432,
+	582, 1432,
+	660, 1432,
+	775, 1432,
+	-2, 1957,
+	-1, 705,
+	1, 1540,
+	8, 1540,
+	9, 1540,
+	10, 1540,
+	17, 1540,
+	18, 1540,
+	19, 1540,
+	20, 1540,
+	22, 1540,
+	24, 1540,
+	26, 1540,
+	35, 1540,
+	36, 1540,
+	66, 1540,
+	67, 1540,
+	68, 1540,
+	69, 1540,
+	70, 1540,
+	72, 1540,
+	73, 1540,
+	76, 1540,
+	77, 1540,
+	79, 1540,
+	80, 1540,
+	98, 1540,
+	534, 1540,
+	582, 1540,
+	660, 1540,
+	775, 1540,
+	-2, 1957,
+	-1, 733,
+	191, 2361,
+	-2, 1554,
+	-1, 766,
+	191, 2469,
+	-2, 1832,
+	-1, 767,
+	191, 2551,
+	-2, 1556,
+	-1, 768,
+	191, 2381,
+	-2, 1557,
+	-1, 837,
+	191, 2332,
+	-2, 1794,
+	-1, 840,
+	191, 2347,
+	-2, 1710,
+	-1, 843,
+	191, 2350,
+	-2, 1710,
+	-1, 844,
+	191, 2561,
+	-2, 1710,
+	-1, 846,
+	191, 2348,
+	-2, 1710,
+	-1, 847,
+	191, 2562,
+	-2, 1710,
+	-1, 848,
+	191, 2563,
+	-2, 1710,
+	-1, 907,
+	191, 2349,
+	-2, 1710,
+	-1, 990,
+	191, 2449,
+	-2, 1710,
+	-1, 991,
+	191, 2450,
+	-2, 1710,
+	-1, 1107,
+	111, 2680,
+	122, 2680,
+	191, 2680,
+	-2, 1924,
+	-1, 1108,
+	111, 2813,
+	122, 2813,
+	191, 2813,
+	-2, 1925,
+	-1, 1113,
+	111, 2708,
+	122, 2708,
+	191, 2708,
+	-2, 1926,
+	-1, 1114,
+	111, 2759,
+	122, 2759,
+	191, 2759,
+	-2, 1927,
+	-1, 1115,
+	111, 2760,
+	122, 2760,
+	191, 2760,
+	-2, 1928,
+	-1, 1116,
+	111, 2607,
+	122, 2607,
+	191, 2607,
+	-2, 1933,
+	-1, 1118,
+	111, 2736,
+	122, 2736,
+	191, 2736,
+	-2, 1935,
+	-1, 1312,
+	461, 1305,
+	-2, 1309,
+	-1, 1314,
+	461, 1305,
+	-2, 1309,
+	-1, 1364,
+	1, 2013,
+	775, 2013,
+	-2, 1957,
+	-1, 1449,
+	1, 766,
+	775, 766,
+	-2, 764,
+	-1, 1451,
+	1, 767,
+	775, 767,
+	-2, 764,
+	-1, 1474,
+	1, 1433,
+	8, 1433,
+	9, 1433,
+	10, 1433,
+	17, 1433,
+	18, 1433,
+	19, 1433,
+	20, 1433,
+	22, 1433,
+	24, 1433,
+	26, 1433,
+	35, 1433,
+	36, 1433,
+	66, 1433,
+	67, 1433,
+	68, 1433,
+	69, 1433,
+	70, 1433,
+	72, 1433,
+	73, 1433,
+	76, 1433,
+	77, 1433,
+	79, 1433,
+	80, 1433,
+	98, 1433,
+	534, 1433,
+	582, 1433,
+	660, 1433,
+	775, 1433,
+	-2, 1957,
+	-1, 1485,
+	1, 1540,
+	8, 1540,
+	9, 1540,
+	10, 1540,
+	17, 1540,
+	18, 1540,
+	19, 1540,
+	20, 1540,
+	22, 1540,
+	24, 1540,
+	26, 1540,
+	35, 1540,
+	36, 1540,
+	66, 1540,
+	67, 1540,
+	68, 1540,
+	69, 1540,
+	70, 1540,
+	72, 1540,
+	73, 1540,
+	76, 1540,
+	77, 1540,
+	79, 1540,
+	80, 1540,
+	98, 1540,
+	534, 1540,
+	582, 1540,
+	660, 1540,
+	775, 1540,
+	-2, 1957,
+	-1, 1787,
+	216, 1125,
+	220, 1125,
+	-2, 875,
+	-1, 1788,
+	216, 1212,
+	220, 1212,
+	-2, 876,
+	-1, 1811,
+	1, 766,
+	775, 766,
+	-2, 764,
+	-1, 1813,
+	1, 766,
+	775, 766,
+	-2, 764,
+	-1, 2390,
+	191, 1979,
+	-2, 1806,
+	-1, 2393,
+	191, 2906,
+	-2, 1809,
+	-1, 2394,
+	191, 2907,
+	-2, 1810,
+	-1, 2396,
+	191, 1978,
+	-2, 1974,
+	-1, 2552,
+	77, 101,
+	79, 101,
+	-2, 105,
+	-1, 2576,
+	191, 2473,
+	-2, 1929,
+	-1, 2583,
+	146, 764,
+	493, 764,
+	541, 764,
+	-2, 990,
+	-1, 2683,
+	86, 854,
+	135, 854,
+	136, 854,
+	-2, 167,
+	-1, 2806,
+	50, 1012,
+	210, 1015,
+	212, 1012,
+	213, 1012,
+	214, 1012,
+	-2, 1132,
+	-1, 2889,
+	8, 62,
+	9, 62,
+	10, 62,
+	-2, 1586,
+	-1, 2906,
+	1, 1478,
+	8, 1478,
+	9, 1478,
+	10, 1478,
+	17, 1478,
+	18, 1478,
+	19, 1478,
+	20, 1478,
+	22, 1478,
+	24, 1478,
+	26, 1478,
+	35, 1478,
+	36, 1478,
+	66, 1478,
+	67, 1478,
+	68, 1478,
+	69, 1478,
+	70, 1478,
+	72, 1478,
+	73, 1478,
+	76, 1478,
+	77, 1478,
+	79, 1478,
+	80, 1478,
+	98, 1478,
+	534, 1478,
+	582, 1478,
+	660, 1478,
+	775, 1478,
+	-2, 1957,
+	-1, 3377,
+	1, 1540,
+	8, 1540,
+	9, 1540,
+	10, 1540,
+	17, 1540,
+	18, 1540,
+	19, 1540,
+	20, 1540,
+	22, 1540,
+	24, 1540,
+	26, 1540,
+	35, 1540,
+	36, 1540,
+	66, 1540,
+	67, 1540,
+	68, 1540,
+	69, 1540,
+	70, 1540,
+	72, 1540,
+	73, 1540,
+	76, 1540,
+	77, 1540,
+	79, 1540,
+	80, 1540,
+	98, 1540,
+	534, 1540,
+	582, 1540,
+	660, 1540,
+	775, 1540,
+	-2, 1957,
+	-1, 3491,
+	1, 1880,
+	26, 1880,
+	76, 1880,
+	775, 1880,
+	-2, 1957,
+	-1, 3752,
+	50, 1012,
+	210, 1015,
+	212, 1012,
+	213, 1012,
+	214, 1012,
+	-2, 1132,
+	-1, 3773,
+	210, 1016,
+	216, 1125,
+	220, 1125,
+	-2, 1014,
+	-1, 3980,
+	79, 2244,
+	80, 2244,
+	191, 2244,
+	-2, 1334,
+	-1, 3981,
+	78, 1891,
+	256, 1891,
+	-2, 2293,
+	-1, 3982,
+	78, 1892,
+	256, 1892,
+	-2, 2871,
+	-1, 4248,
+	8, 62,
+	9, 62,
+	10, 62,
+	-2, 1887,
+	-1, 4387,
+	47, 1990,
+	-2, 1988,
+	-1, 4657,
+	8, 62,
+	9, 62,
+	10, 62,
+	-2, 1888,
+	-1, 4664,
+	8, 62,
+	9, 62,
+	10, 62,
+	-2, 131,
+	-1, 4680,
+	319, 478,
+	-2, 2063,
+	-1, 4681,
+	319, 479,
+	-2, 2104,
+	-1, 4682,
+	319, 480,
+	-2, 2281,
+	-1, 4755,
+	8, 62,
+	9, 62,
+	10, 62,
+	-2, 131,
+	-1, 4974,
+	106, 464,
+	108, 464,
+	110, 464,
+	-2, 82,
+	-1, 5042,
+	108, 471,
+	109, 471,
+	110, 471,
+	-2, 82,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 92235
+
+var yyAct = [...]int16{
+	779, 48, 4991, 4926, 4978, 4964, 4660, 3094, 1240, 2573,
+	725, 4928, 4832, 4066, 2572, 4824, 4649, 4825, 4738, 4381,
+	4806, 515, 4686, 4528, 8, 4527, 7, 4687, 4807, 4559,
+	771, 3499, 4526, 6, 4286, 4556, 26, 4560, 29, 2299,
+	4552, 4529, 9, 1603, 4551, 2491, 4376, 4550, 4674, 48,
+	4566, 3917, 4535, 4673, 4534, 4500, 4383, 4339, 1477, 4662,
+	2639, 3093, 3636, 732, 3467, 752, 735, 739, 1484, 2490,
+	4604, 2885, 4289, 4647, 1709, 4421, 4206, 4199, 4382, 4394,
+	4070, 2821, 4146, 3759, 3144, 3887, 572, 575, 3229, 4147,
+	4385, 1783, 4324, 173, 623, 517, 1850, 2424, 3986, 3978,
+	596, 716, 4242, 1526, 173, 702, 679, 116, 3367, 4138,
+	3727, 4183, 3712, 4217, 173, 3174, 3680, 683, 1784, 2676,
+	110, 3667, 4072, 1849, 4182, 778, 686, 2873, 1184, 2588,
+	3500, 1852, 1479, 3970, 3302, 173, 3764, 1218, 1794, 3874,
+	1270, 2395, 3105, 3265, 1322, 3893, 3157, 3876, 3882, 686,
+	1198, 845, 1194, 2368, 3853, 2744, 2290, 3841, 3923, 1789,
+	173, 1196, 1481, 1634, 2797, 1633, 1795, 2654, 114, 2569,
+	145, 3832, 1455, 2803, 2804, 1323, 2769, 3020, 3045, 2890,
+	3710, 2357, 3698, 3365, 173, 1299, 3669, 2680, 1443, 1200,
+	2623, 2640, 666, 1112, 753, 3475, 4661, 3046, 517, 173,
+	750, 751, 1259, 2594, 3427, 744, 1476, 738, 2353, 2373,
+	2715, 1483, 2801, 2355, 1855, 2269, 721, 2751, 2291, 2223,
+	1823, 2618, 2682, 3129, 512, 1109, 1679, 1513, 2149, 1683,
+	2478, 2400, 1522, 3048, 2876, 1516, 700, 696, 2281, 2233,
+	1362, 1546, 2229, 1351, 1334, 1353, 2192, 3269, 1335, 3476,
+	1718, 1239, 1213, 1189, 1187, 1682, 1102, 1106, 1450, 2554,
+	1539, 742, 709, 580, 1462, 2360, 3987, 4525, 3, 1454,
+	697, 682, 598, 1188, 2185, 1220, 1453, 1452, 1228, 1229,
+	599, 1231, 2184, 2148, 1814, 1225, 2592, 138, 1349, 134,
+	1182, 2441, 692, 719, 5042, 4218, 5036, 1209, 5026, 5017,
+	4974, 728, 4972, 4970, 94, 4941, 4938, 4937, 4936, 4921,
+	4919, 4780, 1318, 4776, 4771, 108, 117, 4423, 4422, 3562,
+	4503, 97, 2221, 2658, 1230, 4629, 2141, 1254, 3895, 726,
+	2701, 4159, 2700, 1333, 2919, 3693, 102, 2916, 105, 2141,
+	4154, 4155, 4152, 4153, 107, 3637, 3649, 3650, 4187, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	3639, 4158, 3696, 688, 4185, 1504, 706, 3762, 4999, 3694,
+	715, 4960, 4958, 5034, 4998, 4959, 3557, 4188, 1190, 3760,
+	3315, 3148, 1826, 681, 4617, 4616, 694, 4963, 595, 131,
+	3695, 591, 4645, 4073, 4901, 2504, 2502, 2501, 2500, 2503,
+	2499, 2498, 2497, 4075, 46, 2511, 4509, 2510, 2509, 3428,
+	2508, 2507, 2506, 2505, 2833, 1417, 2698, 46, 1701, 4308,
+	3172, 4663, 46, 4821, 2141, 3101, 4591, 4177, 2354, 1702,
+	3108, 3178, 1183, 3366, 1233, 2698, 4644, 4508, 3457, 4403,
+	526, 1552, 4040, 3821, 4992, 4607, 3113, 3112, 4334, 1554,
+	1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562,
+	1566, 1555, 3496, 131, 1565, 77, 3497, 96, 4037, 3834,
+	46, 97, 3109, 3870, 4132, 51, 3528, 3854, 97, 4271,
+	4180, 4727, 3527, 3638, 97, 3325, 4707, 3324, 3115, 97,
+	3091, 4628, 3431, 4272, 4181, 46, 4371, 4136, 3092, 2492,
+	2504, 2502, 2501, 2500, 2503, 2499, 2498, 2497, 2493, 2494,
+	2511, 2495, 2510, 2509, 2496, 2508, 2507, 2506, 2505, 3490,
+	3120, 1701, 4078, 4653, 4390, 578, 2996, 3962, 1217, 1418,
+	567, 160, 1702, 156, 2736, 157, 4492, 97, 2564, 2565,
+	3496, 173, 2575, 3095, 3497, 1312, 4653, 3349, 2308, 1306,
+	695, 3511, 3512, 123, 121, 122, 593, 517, 2272, 2273,
+	2742, 4650, 97, 3510, 4648, 4076, 4077, 4079, 4080, 4081,
+	2785, 162, 161, 1684, 163, 1685, 3614, 1096, 2224, 1364,
+	1366, 158, 3615, 3616, 4650, 1274, 1275, 3034, 2563, 1279,
+	3033, 542, 4729, 3035, 690, 3521, 104, 2227, 3106, 148,
+	4630, 1376, 147, 3915, 166, 559, 3111, 2250, 4227, 104,
+	3114, 77, 1203, 3118, 104, 3116, 1296, 1297, 3104, 173,
+	4225, 51, 97, 2225, 2226, 1276, 1278, 4654, 1277, 1395,
+	1464, 1467, 1468, 1469, 1465, 1280, 1466, 1471, 4220, 589,
+	2877, 2878, 588, 4219, 1403, 1294, 3107, 1295, 1296, 1297,
+	4654, 1307, 1308, 1464, 1467, 1468, 1469, 1465, 1281, 1466,
+	1471, 2325, 104, 1468, 1469, 3402, 164, 3400, 165, 675,
+	4576, 2741, 1282, 4750, 4998, 623, 1359, 4959, 4957, 693,
+	1184, 1412, 2590, 2591, 1444, 2605, 1265, 104, 2595, 1447,
+	2604, 4569, 2610, 2595, 3288, 2611, 2598, 2597, 3792, 2599,
+	2515, 2619, 1475, 1480, 3943, 3947, 3945, 2274, 1498, 1499,
+	1184, 686, 1184, 1184, 2781, 2720, 1184, 562, 2721, 565,
+	587, 670, 3546, 672, 669, 1266, 1184, 1420, 1421, 3670,
+	671, 2190, 4186, 173, 1575, 1577, 4605, 1309, 1579, 3244,
+	4751, 3436, 3434, 3437, 3433, 1315, 670, 2270, 2271, 3442,
+	1415, 3432, 3429, 1416, 3733, 3430, 5033, 3440, 4999, 517,
+	4997, 4810, 4773, 4996, 4960, 4774, 2280, 4775, 3921, 1472,
+	1594, 3439, 4714, 2279, 1598, 1599, 1600, 1601, 1602, 2278,
+	1606, 3919, 1378, 4615, 3167, 1519, 2277, 2683, 3441, 3443,
+	2276, 2275, 668, 4374, 1439, 4570, 676, 1399, 1400, 4175,
+	3378, 1365, 4167, 4165, 3294, 3292, 1369, 1584, 1585, 1586,
+	1587, 1588, 1589, 1590, 1446, 3894, 2917, 4365, 4478, 2914,
+	3687, 4809, 3222, 1608, 1609, 1610, 1611, 1612, 1613, 1614,
+	1615, 1616, 1617, 1618, 1619, 1620, 1621, 1622, 1293, 1625,
+	1626, 1628, 3295, 3378, 1628, 1628, 3378, 1635, 1635, 1635,
+	1638, 1639, 1640, 1641, 1642, 1643, 1644, 1645, 1646, 1647,
+	1648, 1649, 1650, 1651, 1652, 1653, 1654, 1655, 1656, 1657,
+	1658, 1659, 1660, 1661, 1662, 1663, 1664, 1665, 1666, 1667,
+	1668, 1490, 1321, 1448, 3761, 159, 1458, 1525, 1543, 3106,
+	4772, 2737, 4910, 3640, 4839, 2306, 74, 4909, 691, 3293,
+	1523, 3833, 3877, 3883, 3884, 3885, 3886, 4122, 1635, 4827,
+	3880, 3641, 4575, 1545, 4328, 4577, 3731, 4578, 3726, 4582,
+	3110, 3226, 3878, 3879, 2684, 3103, 1392, 2261, 3856, 3171,
+	2262, 4586, 4445, 3444, 3227, 4444, 4442, 3107, 1474, 1273,
+	149, 2685, 3520, 2307, 3681, 3682, 3683, 3684, 3685, 4100,
+	2763, 3762, 4704, 3438, 3435, 2309, 4305, 2517, 2518, 2516,
+	4074, 1604, 4317, 706, 706, 1378, 592, 3184, 4315, 1402,
+	117, 4312, 566, 4367, 3690, 3364, 4752, 3797, 4404, 3169,
+	3922, 1693, 2870, 167, 1440, 3162, 2677, 4098, 1378, 135,
+	3519, 1635, 1635, 3920, 3643, 4127, 1576, 3728, 4091, 4089,
+	4606, 4502, 4505, 4299, 1627, 2283, 3165, 1631, 1632, 4721,
+	4700, 4372, 5039, 1636, 1637, 153, 2757, 3106, 5019, 1624,
+	5038, 4651, 4507, 1268, 3642, 579, 3121, 5018, 2918, 3106,
+	1440, 2915, 759, 5015, 760, 762, 763, 764, 765, 3772,
+	4583, 3287, 761, 2437, 4651, 4934, 1501, 117, 1501, 1501,
+	1506, 1506, 1501, 118, 1500, 1411, 1505, 1505, 4309, 3173,
+	1512, 3859, 3857, 4706, 1441, 3107, 118, 3855, 4493, 2227,
+	3179, 118, 4982, 1669, 4135, 1377, 4627, 3107, 1507, 4768,
+	4134, 1267, 3314, 3858, 4766, 4767, 2687, 2212, 1350, 3294,
+	4719, 1370, 137, 4174, 3305, 2225, 2226, 1672, 1470, 1320,
+	4840, 2601, 142, 151, 150, 124, 2191, 2212, 2602, 2621,
+	1314, 4808, 623, 3123, 3671, 3672, 1385, 4923, 1691, 95,
+	1316, 1470, 3090, 4203, 3673, 3369, 576, 3674, 3351, 1470,
+	173, 3359, 3361, 3360, 2211, 1298, 4166, 3353, 573, 4164,
+	4366, 4622, 143, 4479, 118, 3678, 3292, 4484, 3675, 154,
+	147, 3681, 3682, 3683, 3684, 3685, 1670, 1671, 2686, 3892,
+	148, 152, 4297, 3102, 1536, 1537, 1535, 4340, 1459, 3370,
+	1112, 4298, 1820, 3369, 3785, 577, 3170, 4296, 1112, 2434,
+	1383, 1184, 4295, 1538, 4294, 1184, 2770, 2773, 2771, 2772,
+	2774, 2775, 2776, 2777, 1832, 1833, 1831, 4292, 1226, 4441,
+	4293, 4435, 4436, 1379, 1386, 1387, 1389, 1390, 1391, 4306,
+	1393, 1394, 2263, 1396, 1397, 1398, 147, 1401, 4745, 1404,
+	1405, 1406, 1407, 1408, 4523, 139, 1388, 140, 517, 141,
+	689, 4314, 3799, 3800, 4311, 4431, 1808, 3689, 623, 1222,
+	1221, 3163, 1223, 116, 1825, 2151, 4840, 1384, 2286, 1380,
+	2670, 2671, 4669, 4670, 1536, 1537, 1535, 2665, 3888, 3889,
+	623, 1227, 173, 3206, 3207, 1226, 1851, 1778, 1779, 1780,
+	1781, 1782, 1224, 1538, 173, 173, 1809, 1700, 1859, 1290,
+	1291, 1381, 1382, 1310, 147, 1425, 2677, 3798, 2234, 2664,
+	1292, 517, 517, 517, 517, 152, 1857, 1289, 2287, 1288,
+	1798, 1801, 1676, 4932, 1706, 4927, 173, 173, 173, 173,
+	173, 173, 173, 1287, 173, 1803, 1286, 1352, 1352, 1184,
+	3354, 4930, 1184, 4716, 4609, 1824, 1319, 2236, 1687, 571,
+	2235, 1830, 4139, 4140, 4284, 97, 1694, 1352, 2186, 3891,
+	5000, 1437, 2194, 574, 2196, 4829, 4828, 3272, 1786, 1212,
+	3180, 2195, 3842, 1805, 1806, 3843, 2636, 3844, 1203, 1203,
+	3770, 1818, 1819, 1210, 3284, 1821, 574, 5045, 2178, 2698,
+	1828, 3272, 1793, 4968, 1796, 1797, 3282, 1212, 5040, 3281,
+	2198, 5027, 5006, 623, 1435, 1219, 149, 1785, 667, 4590,
+	1673, 1674, 3355, 1473, 695, 4470, 4359, 4939, 3304, 4173,
+	2218, 4170, 2179, 574, 3890, 3724, 1374, 3289, 3221, 3217,
+	2152, 2156, 2157, 2141, 1212, 3187, 2214, 3186, 2260, 3038,
+	2145, 2145, 2145, 2145, 3786, 3787, 3788, 2166, 1486, 1488,
+	2167, 2168, 2169, 2228, 1839, 2678, 2435, 2436, 1486, 1488,
+	2174, 2758, 2267, 4608, 1837, 1835, 136, 1843, 1317, 2657,
+	2182, 3280, 117, 1216, 2155, 2340, 2339, 2338, 132, 1212,
+	1215, 702, 702, 702, 702, 4362, 2296, 3717, 173, 173,
+	1457, 1705, 2243, 686, 1241, 2294, 1184, 2154, 2170, 173,
+	2172, 1480, 1232, 2334, 2177, 1183, 1817, 4777, 173, 2143,
+	2147, 173, 173, 173, 173, 4800, 510, 2241, 3230, 1431,
+	2216, 2292, 1211, 686, 3181, 1810, 3368, 1807, 1804, 3272,
+	2139, 173, 1847, 1848, 1203, 686, 2635, 3273, 1210, 1829,
+	1430, 1426, 1427, 1428, 1429, 97, 1432, 1433, 1434, 1436,
+	1211, 574, 1846, 4156, 1373, 4052, 3278, 3272, 1604, 2333,
+	4929, 4931, 3275, 2328, 3914, 3274, 3279, 2150, 3769, 151,
+	150, 3509, 3190, 2411, 2337, 2311, 4966, 3189, 2679, 4967,
+	4620, 4965, 1487, 2359, 173, 723, 1859, 1211, 1581, 1582,
+	3219, 517, 1487, 3278, 3272, 2392, 3303, 97, 1184, 3275,
+	2966, 3218, 3274, 3279, 2396, 3043, 2193, 3304, 2187, 117,
+	2327, 2312, 1580, 1578, 2173, 2315, 2935, 2188, 1212, 677,
+	2908, 2389, 2335, 2792, 2699, 2666, 2963, 1606, 702, 1212,
+	2399, 2238, 1211, 2408, 2409, 2410, 2559, 2412, 2413, 2414,
+	2415, 2416, 2417, 2418, 2419, 2420, 2421, 2422, 2423, 1604,
+	2428, 2371, 1583, 2197, 1704, 2430, 686, 2432, 173, 2210,
+	2282, 2285, 132, 2215, 1597, 2239, 132, 2482, 2433, 2438,
+	104, 1596, 2444, 1595, 2447, 2242, 1544, 1701, 1357, 1256,
+	1180, 173, 2203, 2204, 127, 2333, 2206, 4370, 1702, 2426,
+	2425, 1438, 3271, 2401, 2575, 702, 1583, 2284, 4469, 2462,
+	4468, 2730, 2209, 2470, 1565, 2464, 2467, 1566, 1555, 1311,
+	1580, 1565, 2140, 2480, 4157, 1555, 3030, 2336, 1565, 3721,
+	1543, 4482, 623, 2574, 173, 173, 173, 728, 2302, 2512,
+	2513, 2181, 2300, 130, 2288, 4048, 4046, 2377, 2378, 2379,
+	4148, 2305, 706, 706, 706, 706, 1859, 4241, 2303, 2304,
+	1194, 2313, 2314, 2427, 2316, 2392, 2326, 1583, 3295, 1196,
+	696, 128, 706, 117, 2396, 3293, 841, 3280, 117, 1112,
+	842, 1211, 3352, 3699, 129, 4190, 1581, 1582, 3272, 2388,
+	1538, 2580, 1211, 1203, 3271, 3303, 3273, 1210, 715, 3298,
+	759, 4785, 760, 762, 763, 764, 765, 3301, 2805, 4047,
+	761, 2437, 1581, 1582, 3748, 3747, 3568, 3566, 1604, 2568,
+	3021, 2643, 2374, 4191, 2459, 2460, 3253, 3252, 3313, 3250,
+	3249, 2385, 3701, 3700, 1495, 1496, 4512, 4511, 2582, 2402,
+	1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566,
+	1555, 517, 3312, 1565, 4746, 4747, 44, 1489, 4743, 4744,
+	3311, 3310, 3309, 517, 104, 3308, 1300, 173, 104, 3307,
+	3306, 2407, 2905, 3040, 3039, 117, 2902, 2694, 3749, 2709,
+	3567, 2587, 2520, 1497, 4786, 2899, 2405, 2406, 2404, 173,
+	3254, 2364, 2525, 3251, 2527, 173, 173, 2530, 1181, 706,
+	173, 1284, 1486, 1488, 117, 1209, 1701, 4460, 2553, 1272,
+	3240, 2551, 2579, 3239, 2645, 2646, 2647, 1702, 2648, 2649,
+	2448, 2449, 2450, 2451, 2452, 1336, 1337, 1338, 1339, 1340,
+	1341, 1342, 1343, 1344, 1345, 1346, 1347, 3041, 2630, 2631,
+	2632, 2633, 2634, 3238, 2476, 1535, 3237, 2434, 3236, 2581,
+	1302, 2651, 3235, 1482, 2600, 2603, 1537, 1535, 2606, 2607,
+	2608, 2609, 1538, 2692, 2693, 2638, 706, 2557, 2561, 2560,
+	720, 1301, 2566, 3234, 1538, 3257, 112, 2659, 3233, 3037,
+	2642, 2669, 2780, 2617, 2710, 2779, 2586, 2211, 1536, 1537,
+	1535, 2208, 2585, 2584, 1327, 2626, 2627, 2628, 2629, 5031,
+	2596, 1235, 1234, 2644, 2637, 3459, 1285, 1538, 2479, 2244,
+	2979, 1271, 2247, 2248, 2249, 2479, 2251, 2252, 5025, 5005,
+	2253, 4917, 2620, 2622, 2254, 2625, 119, 2255, 125, 4844,
+	3175, 2256, 2257, 1812, 2258, 2259, 1487, 4822, 2431, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346,
+	1347, 4099, 1304, 3260, 4093, 2827, 3259, 2614, 2615, 2616,
+	1313, 2653, 4862, 3211, 1495, 1496, 2361, 2455, 2456, 2457,
+	3560, 5001, 2695, 2461, 3378, 2463, 2466, 2469, 4200, 2474,
+	2475, 5009, 4979, 5008, 4675, 2485, 4843, 1489, 4842, 2830,
+	2828, 2823, 3260, 4176, 2827, 3259, 2825, 1097, 1098, 1099,
+	2660, 2519, 2662, 2521, 2522, 2932, 2933, 2934, 2526, 1214,
+	2528, 2529, 2668, 1497, 5044, 2672, 2534, 2535, 2536, 2537,
+	2538, 2539, 2540, 2541, 2542, 2543, 2544, 2545, 2830, 2828,
+	2823, 5002, 1486, 1488, 4675, 2825, 4762, 109, 4761, 2381,
+	2383, 2384, 2232, 4894, 3261, 3262, 1552, 2382, 4891, 2375,
+	2824, 2826, 2829, 2831, 1554, 1553, 1563, 1564, 1556, 1557,
+	1558, 1559, 1560, 1561, 1562, 1566, 1555, 2750, 2361, 1565,
+	5043, 1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561,
+	1562, 1566, 1555, 3261, 3262, 1565, 3359, 3361, 3360, 2824,
+	2826, 2829, 2831, 723, 1567, 1568, 1569, 1570, 1571, 1572,
+	1573, 5021, 173, 4893, 2435, 2436, 4703, 1552, 4890, 4598,
+	4588, 1628, 1536, 1537, 1535, 1554, 1553, 1563, 1564, 1556,
+	1557, 1558, 1559, 1560, 1561, 1562, 1566, 1555, 1184, 1552,
+	1565, 1538, 173, 4475, 4335, 4281, 4476, 1554, 1553, 1563,
+	1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566, 1555,
+	4034, 2194, 1565, 2196, 2716, 4198, 4029, 4027, 3862, 3860,
+	2195, 97, 4032, 1536, 1537, 1535, 1487, 4028, 4197, 3861,
+	4675, 3614, 1096, 2403, 3580, 3581, 3583, 3615, 3616, 3582,
+	3584, 3585, 1538, 173, 723, 686, 2369, 2370, 4477, 686,
+	2547, 3390, 4196, 2724, 3586, 3587, 3588, 3589, 1536, 1537,
+	1535, 4368, 3359, 3361, 3360, 2728, 5029, 2231, 4195, 2749,
+	4189, 4149, 723, 3387, 3359, 3361, 3360, 1538, 2489, 4111,
+	1475, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566, 1555,
+	4110, 1447, 1565, 2707, 1558, 1559, 1560, 1561, 1562, 1566,
+	1555, 2711, 2957, 1565, 2956, 4109, 4056, 2713, 1536, 1537,
+	1535, 4688, 4055, 4369, 1536, 1537, 1535, 3810, 2925, 4988,
+	702, 1536, 1537, 1535, 1536, 1537, 1535, 1538, 3743, 2894,
+	2895, 2896, 2740, 1538, 2718, 3742, 2743, 1536, 1537, 1535,
+	1538, 2788, 3741, 1538, 2926, 4846, 3740, 2927, 3739, 686,
+	173, 1552, 3656, 2767, 3563, 2958, 1538, 3867, 686, 1554,
+	1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562,
+	1566, 1555, 3134, 1374, 1565, 3132, 4817, 3119, 1368, 2766,
+	2936, 1367, 1456, 1859, 3692, 3691, 1536, 1537, 1535, 1536,
+	1537, 1535, 2392, 3142, 4765, 2897, 2898, 3461, 5030, 2900,
+	2901, 2396, 5020, 2903, 2904, 1538, 5014, 4816, 1538, 3359,
+	3361, 3360, 1536, 1537, 1535, 4943, 2793, 4935, 2389, 584,
+	4778, 2872, 3865, 1519, 1519, 1536, 1537, 1535, 4759, 2350,
+	4758, 1538, 1449, 4692, 2723, 3384, 2887, 2970, 4691, 4685,
+	2352, 4684, 2727, 4443, 1538, 2893, 2755, 4342, 2733, 2732,
+	2731, 3969, 2888, 2739, 3789, 2193, 3200, 3199, 2346, 2938,
+	2939, 2940, 2747, 2748, 2212, 2722, 2351, 2754, 2706, 2348,
+	2705, 2201, 4815, 2811, 3359, 3361, 3360, 2429, 2202, 2794,
+	2189, 1845, 176, 1844, 513, 525, 1813, 1811, 176, 1360,
+	1354, 2762, 586, 176, 2765, 2347, 1331, 4814, 4811, 4798,
+	4726, 723, 4701, 176, 4990, 665, 4637, 4631, 173, 4440,
+	4439, 2784, 4373, 4316, 2786, 176, 2789, 2349, 4313, 1330,
+	2401, 4303, 4301, 4291, 176, 2971, 2972, 2973, 4283, 4282,
+	4270, 2928, 4269, 2931, 2802, 4237, 4179, 2892, 176, 1523,
+	2881, 706, 723, 4178, 1552, 702, 2345, 4108, 702, 176,
+	1202, 4107, 1554, 1553, 1563, 1564, 1556, 1557, 1558, 1559,
+	1560, 1561, 1562, 1566, 1555, 4106, 623, 1565, 4105, 2342,
+	173, 1859, 3036, 176, 665, 173, 2581, 4096, 173, 4095,
+	2344, 706, 2200, 2943, 4094, 1552, 4060, 513, 176, 1857,
+	2923, 2921, 2922, 1554, 1553, 1563, 1564, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1566, 1555, 2343, 4054, 1565, 2941,
+	4050, 4030, 4025, 4016, 4012, 4007, 4006, 4005, 3863, 1112,
+	3852, 3840, 3836, 3829, 3828, 2952, 2937, 3827, 3746, 3738,
+	3737, 3736, 3621, 3971, 3513, 3399, 3990, 3398, 3396, 3255,
+	2946, 3130, 3042, 1593, 1592, 1591, 2787, 1444, 2738, 2704,
+	2205, 2980, 1548, 678, 1551, 1838, 2402, 2341, 4489, 723,
+	2555, 1567, 1568, 1569, 1570, 1571, 1572, 1573, 1409, 1549,
+	1550, 1547, 2960, 1552, 2907, 723, 2453, 723, 173, 723,
+	173, 1554, 1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560,
+	1561, 1562, 1566, 1555, 3138, 4883, 1565, 3141, 2978, 4819,
+	723, 3898, 4749, 3138, 4711, 4341, 3955, 3138, 4709, 3898,
+	723, 4349, 723, 3138, 4518, 2555, 623, 173, 173, 4280,
+	3024, 2556, 3205, 2558, 517, 3898, 4425, 4363, 723, 3898,
+	4320, 3378, 723, 2791, 723, 173, 3898, 4210, 3216, 2141,
+	4130, 2141, 4129, 3898, 4064, 4279, 3052, 3898, 3897, 3632,
+	3631, 3026, 3628, 3629, 3027, 3628, 3627, 2907, 723, 3138,
+	3137, 1824, 2675, 2995, 2997, 3136, 2760, 2759, 2453, 2734,
+	3204, 3004, 3005, 3006, 4086, 3028, 2556, 1687, 2141, 3208,
+	3209, 2745, 3031, 2329, 723, 3193, 1708, 1707, 3496, 2745,
+	3122, 3124, 3497, 3659, 3625, 3125, 3126, 3624, 3127, 3128,
+	3044, 3623, 111, 2296, 3023, 1552, 706, 2674, 2366, 706,
+	97, 2265, 2294, 1554, 1553, 1563, 1564, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1566, 1555, 728, 3131, 1565, 3025,
+	3990, 2141, 2453, 3133, 2264, 3146, 3213, 1372, 2292, 3247,
+	4638, 2375, 2761, 2329, 3202, 4253, 1371, 4214, 2329, 1372,
+	3378, 3990, 3898, 2145, 3198, 4087, 2698, 2329, 3660, 3630,
+	3397, 2907, 3232, 2213, 2365, 2562, 2990, 2947, 2948, 2949,
+	2950, 2951, 3223, 2988, 1374, 2987, 2907, 2778, 2764, 2207,
+	2703, 2141, 2697, 2367, 1442, 3168, 2222, 1836, 3201, 1834,
+	3385, 1552, 3215, 3388, 3176, 2976, 3391, 3358, 1681, 1554,
+	1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562,
+	1566, 1555, 1445, 97, 1565, 4710, 2886, 3214, 3139, 3140,
+	1859, 702, 3197, 4517, 4463, 4461, 4285, 4045, 2593, 2392,
+	176, 2624, 2595, 3372, 3243, 3242, 3143, 173, 2396, 3270,
+	173, 3276, 3283, 2619, 3286, 173, 513, 2877, 2878, 3376,
+	3212, 173, 1378, 3297, 2667, 2389, 2613, 2612, 1791, 1790,
+	1356, 3145, 3220, 3266, 3277, 3392, 3263, 4112, 2717, 3350,
+	3225, 1263, 1262, 3228, 5024, 5023, 4995, 3299, 4994, 4961,
+	4955, 4953, 3414, 4906, 3241, 4904, 4896, 3468, 4895, 3374,
+	4826, 4205, 4201, 3971, 3658, 3652, 3419, 3380, 3381, 3382,
+	3246, 3196, 3195, 3258, 3164, 2880, 2874, 2696, 176, 2266,
+	2237, 3493, 3498, 1375, 2324, 3448, 702, 3449, 3450, 2323,
+	3451, 3452, 1527, 1528, 3453, 561, 2321, 2319, 2884, 2883,
+	2882, 2322, 2320, 2318, 2317, 3503, 4741, 4643, 3404, 144,
+	2920, 3462, 3463, 3464, 3465, 3445, 173, 2362, 3447, 3371,
+	4694, 1629, 3373, 1530, 4466, 3408, 3407, 4410, 4171, 1532,
+	1531, 4145, 1529, 3779, 3778, 3620, 3619, 3415, 3618, 3492,
+	3135, 1802, 1792, 4633, 4, 3409, 4636, 4632, 4497, 1478,
+	47, 3501, 4635, 4388, 4386, 4434, 665, 3458, 4433, 4319,
+	3414, 585, 3561, 4695, 2363, 3379, 2726, 563, 564, 3577,
+	176, 155, 2725, 728, 2199, 4192, 4193, 1514, 3291, 3401,
+	3494, 3393, 3403, 3395, 3358, 3290, 3245, 4784, 4983, 1515,
+	4163, 4001, 176, 115, 3790, 173, 3565, 3411, 47, 2643,
+	2795, 3412, 1703, 1348, 1332, 3416, 1329, 517, 1444, 3417,
+	1328, 1269, 4352, 4351, 1456, 4244, 2369, 2370, 513, 4103,
+	4101, 3460, 3514, 3446, 1325, 1326, 3697, 4104, 4102, 517,
+	2661, 1414, 1473, 4639, 3573, 3569, 4587, 4325, 623, 4067,
+	3505, 4044, 3507, 3508, 3686, 3415, 3654, 1324, 2442, 2443,
+	1423, 2289, 706, 2201, 2200, 4243, 3554, 1510, 1511, 1508,
+	1509, 1502, 1503, 4850, 3477, 3478, 3479, 3480, 3481, 3482,
+	3483, 3484, 3485, 3486, 3487, 3925, 3406, 173, 4849, 4848,
+	3052, 4288, 2783, 3924, 2487, 3358, 2487, 3633, 3634, 1692,
+	1630, 1305, 3506, 3661, 717, 3622, 4733, 4732, 4731, 4730,
+	4498, 4455, 4446, 4408, 4223, 3515, 3264, 718, 3469, 3470,
+	3471, 3472, 3473, 3474, 111, 4222, 3651, 3928, 2745, 4779,
+	3719, 3151, 3152, 3153, 4908, 4907, 4907, 1786, 4125, 3732,
+	3730, 3729, 3771, 173, 173, 3231, 2989, 2967, 2964, 2930,
+	2714, 2171, 1533, 1261, 3709, 1260, 4908, 706, 117, 4514,
+	3617, 3688, 710, 3645, 3646, 4880, 517, 3707, 2642, 714,
+	713, 2567, 3495, 3576, 517, 517, 517, 517, 113, 3574,
+	3575, 73, 517, 517, 3571, 517, 3572, 3718, 4542, 70,
+	4544, 23, 4543, 22, 4545, 24, 4693, 3626, 517, 517,
+	3644, 173, 517, 1, 3635, 4626, 3655, 3657, 4546, 25,
+	704, 3720, 4540, 18, 54, 3725, 3714, 4539, 17, 2756,
+	3677, 2245, 3676, 4538, 16, 3734, 4541, 19, 4537, 15,
+	622, 3702, 3703, 3875, 3872, 3704, 3705, 3706, 4531, 11,
+	3881, 3358, 3679, 623, 4567, 41, 3166, 3713, 4565, 39,
+	4564, 38, 4568, 42, 4563, 33, 4562, 32, 3716, 3715,
+	4561, 31, 4621, 3774, 3775, 3776, 4558, 28, 1604, 4483,
+	3781, 3782, 4097, 3784, 3767, 4557, 27, 4536, 14, 3735,
+	4533, 13, 4532, 12, 4530, 10, 2871, 3802, 1822, 4304,
+	3807, 1238, 2673, 686, 1786, 3766, 1361, 4634, 4387, 4495,
+	2811, 3751, 4494, 3263, 3801, 3750, 4071, 3666, 3768, 3665,
+	3156, 3155, 1355, 3839, 2735, 3744, 3745, 3904, 3905, 3906,
+	3907, 3908, 3909, 3910, 3911, 3912, 2220, 3766, 3960, 3267,
+	3780, 3918, 3268, 1785, 3188, 3256, 4603, 4602, 2681, 4465,
+	3777, 4453, 2782, 3793, 2268, 3795, 2768, 1424, 2583, 1199,
+	4131, 3758, 3931, 4333, 3757, 3896, 3808, 3756, 3811, 1186,
+	3813, 3815, 3817, 3819, 3979, 126, 2708, 1283, 534, 3823,
+	3099, 3837, 4496, 1358, 3098, 3117, 2589, 1451, 4266, 3097,
+	3913, 3096, 3503, 4589, 3100, 2296, 1715, 1714, 3957, 3958,
+	3959, 1712, 3973, 1713, 2294, 1710, 1717, 1716, 540, 176,
+	1695, 4679, 3968, 665, 3873, 1534, 772, 146, 3300, 673,
+	3899, 1202, 674, 133, 1574, 3032, 1110, 1111, 1100, 4783,
+	2292, 2910, 3975, 1490, 4510, 4389, 4499, 4039, 3501, 4668,
+	3974, 1521, 4391, 4221, 4737, 3927, 2977, 3926, 1623, 2477,
+	741, 2486, 687, 3985, 3358, 4240, 4393, 2380, 755, 754,
+	3929, 1628, 1628, 1628, 1635, 1635, 1635, 1638, 1639, 1640,
+	1641, 1594, 2514, 1608, 1609, 1579, 1610, 1611, 1612, 1613,
+	1614, 1615, 1616, 1617, 1618, 1619, 1620, 1621, 4652, 1625,
+	1626, 1642, 1643, 1644, 1645, 1635, 1635, 1635, 3932, 3933,
+	3934, 3935, 3936, 3972, 3942, 4043, 3995, 1788, 2924, 3489,
+	3488, 3491, 3553, 3648, 1422, 730, 1494, 4049, 1800, 1800,
+	1493, 4051, 1492, 3963, 1491, 1485, 4015, 699, 2548, 3210,
+	3977, 1463, 1461, 1460, 1841, 3989, 1677, 2879, 665, 665,
+	2875, 176, 3996, 698, 665, 703, 3991, 3992, 3993, 3994,
+	50, 2929, 1202, 176, 176, 1303, 3961, 3358, 3988, 4402,
+	665, 665, 120, 173, 712, 711, 176, 722, 724, 173,
+	513, 513, 513, 513, 4017, 2650, 30, 21, 4057, 20,
+	4026, 4010, 1257, 2800, 4013, 176, 176, 176, 176, 176,
+	176, 176, 2822, 176, 1236, 3938, 3939, 3940, 52, 3941,
+	59, 58, 57, 55, 56, 3150, 2663, 3944, 4678, 3946,
+	176, 176, 4925, 4942, 4977, 665, 1419, 4083, 4084, 4085,
+	43, 176, 40, 4088, 1627, 1631, 1632, 37, 173, 4058,
+	1636, 1637, 2746, 36, 35, 34, 4554, 4553, 4555, 4133,
+	4549, 117, 4548, 4547, 4864, 4833, 5, 106, 4116, 4053,
+	103, 45, 4068, 2638, 2, 0, 0, 0, 3918, 0,
+	1669, 1670, 1671, 0, 0, 0, 0, 1202, 0, 1624,
+	0, 0, 0, 0, 517, 1202, 0, 0, 0, 0,
+	0, 4063, 0, 665, 665, 665, 0, 4082, 1202, 0,
+	517, 0, 0, 0, 0, 0, 0, 4092, 0, 0,
+	517, 4090, 0, 4128, 4113, 0, 0, 0, 0, 0,
+	0, 4119, 0, 0, 0, 0, 4121, 517, 0, 665,
+	4141, 4142, 0, 0, 0, 0, 0, 0, 4120, 0,
+	0, 0, 0, 4123, 4124, 4114, 4126, 0, 0, 4143,
+	4115, 0, 0, 0, 3822, 0, 3824, 176, 176, 0,
+	0, 0, 176, 0, 1202, 0, 0, 0, 176, 0,
+	0, 0, 3845, 3846, 3847, 0, 0, 176, 665, 4162,
+	176, 176, 176, 176, 0, 3270, 3869, 3276, 4151, 0,
+	4137, 0, 176, 0, 0, 0, 0, 4144, 0, 0,
+	176, 4168, 3358, 0, 176, 0, 4216, 0, 0, 3266,
+	3277, 0, 0, 3766, 0, 4172, 4160, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 4169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3766,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4208, 4207, 4209, 176, 0, 0, 0, 0, 0, 0,
+	513, 3414, 0, 0, 0, 0, 0, 4250, 0, 0,
+	3979, 0, 0, 0, 0, 702, 0, 0, 4255, 0,
+	0, 4224, 4226, 0, 0, 0, 0, 0, 0, 0,
+	0, 3918, 3918, 0, 0, 686, 0, 0, 1202, 0,
+	1202, 0, 3503, 1202, 686, 173, 4228, 4229, 4230, 4231,
+	1202, 4204, 4202, 4184, 4235, 1202, 1202, 1202, 4238, 4239,
+	4194, 0, 0, 0, 0, 176, 0, 176, 116, 0,
+	0, 0, 3358, 0, 0, 4275, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3415, 0, 3501, 0,
+	176, 0, 0, 0, 3997, 3998, 3999, 0, 0, 0,
+	0, 0, 0, 0, 0, 4245, 4249, 0, 0, 3358,
+	0, 4287, 0, 0, 0, 4018, 4019, 4020, 4213, 0,
+	0, 0, 4247, 0, 0, 0, 0, 4263, 4264, 0,
+	4036, 0, 0, 176, 176, 176, 0, 4246, 0, 4278,
+	4257, 0, 0, 4254, 4267, 0, 0, 696, 0, 0,
+	0, 4258, 665, 665, 0, 0, 4212, 0, 0, 0,
+	0, 0, 1552, 0, 0, 4337, 4338, 0, 1202, 0,
+	1554, 1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561,
+	1562, 1566, 1555, 0, 0, 1565, 0, 0, 4276, 0,
+	0, 0, 0, 0, 4273, 0, 0, 0, 0, 0,
+	1552, 0, 0, 4259, 4260, 4261, 4262, 4361, 1554, 1553,
+	1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566,
+	1555, 0, 0, 1565, 4290, 0, 0, 4336, 0, 0,
+	517, 0, 517, 0, 517, 0, 0, 0, 0, 4300,
+	4307, 0, 4329, 4330, 4327, 4332, 0, 0, 4302, 4310,
+	513, 0, 1800, 1800, 1800, 4318, 1800, 1800, 4345, 0,
+	4322, 4326, 513, 0, 0, 1202, 176, 4347, 0, 0,
+	0, 3965, 0, 0, 0, 0, 706, 117, 0, 4331,
+	0, 4250, 4415, 0, 0, 4377, 4321, 0, 176, 4354,
+	0, 4355, 0, 0, 176, 176, 665, 665, 665, 176,
+	4353, 4343, 4344, 0, 4323, 0, 4378, 4379, 4380, 2936,
+	0, 0, 4346, 0, 0, 1552, 2643, 4429, 4356, 0,
+	4358, 0, 4360, 1554, 1553, 1563, 1564, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1566, 1555, 0, 0, 1565, 0,
+	4414, 0, 0, 4375, 0, 173, 517, 0, 0, 4412,
+	0, 0, 4430, 0, 4432, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4454, 0, 0, 0, 0, 4424,
+	4459, 0, 0, 0, 0, 4428, 4427, 4409, 0, 4411,
+	0, 4413, 4416, 0, 0, 0, 0, 0, 0, 4418,
+	0, 4407, 0, 4419, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4437, 0, 0, 0, 0, 0,
+	0, 0, 0, 4447, 0, 0, 4480, 0, 4438, 0,
+	517, 0, 0, 173, 0, 0, 0, 4504, 1610, 0,
+	0, 0, 4458, 3358, 4392, 4395, 0, 0, 0, 0,
+	0, 0, 0, 4448, 0, 4467, 3979, 0, 0, 4451,
+	4452, 0, 4450, 0, 0, 4449, 0, 0, 0, 0,
+	1184, 4522, 0, 1184, 0, 0, 0, 1604, 4462, 4464,
+	0, 0, 4519, 0, 4472, 2642, 0, 0, 0, 0,
+	4457, 0, 4513, 0, 0, 0, 0, 0, 4490, 0,
+	4524, 4481, 2643, 0, 2643, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4515, 4474, 0, 0, 4471, 0,
+	0, 1184, 0, 0, 0, 0, 0, 0, 4520, 4521,
+	0, 0, 517, 0, 517, 0, 0, 0, 0, 0,
+	0, 517, 0, 0, 0, 0, 4642, 0, 4618, 0,
+	0, 0, 0, 0, 0, 0, 4585, 0, 4584, 117,
+	0, 0, 4599, 1184, 4601, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 4665, 3503, 4592, 4671, 4593,
+	0, 0, 0, 0, 0, 0, 4656, 4600, 4641, 0,
+	4208, 4207, 0, 0, 117, 4614, 0, 0, 0, 4619,
+	0, 4623, 0, 0, 0, 0, 0, 4611, 4625, 0,
+	0, 176, 4596, 0, 0, 0, 1184, 0, 1184, 0,
+	0, 4666, 3501, 4640, 1184, 0, 4655, 0, 0, 4612,
+	1604, 0, 4658, 0, 0, 0, 4667, 0, 0, 0,
+	4689, 176, 0, 0, 0, 0, 4698, 0, 0, 0,
+	4717, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2642, 0, 2642, 0, 0, 0, 0, 0, 4683,
+	4659, 4728, 4720, 0, 0, 0, 0, 0, 1202, 0,
+	3468, 0, 0, 4739, 0, 0, 4696, 0, 0, 0,
+	0, 0, 176, 0, 176, 0, 0, 4699, 176, 0,
+	0, 4702, 0, 1202, 0, 4742, 0, 0, 1202, 0,
+	4724, 0, 0, 0, 4712, 0, 0, 0, 4753, 0,
+	0, 0, 4757, 117, 0, 4395, 1604, 4722, 4208, 4207,
+	0, 0, 0, 4725, 4734, 665, 665, 117, 117, 4723,
+	0, 0, 4756, 0, 4735, 0, 0, 4791, 4718, 0,
+	0, 2643, 0, 623, 4782, 0, 4793, 4799, 4795, 4790,
+	0, 4748, 4763, 0, 4057, 4754, 4760, 0, 0, 0,
+	4813, 0, 0, 0, 4781, 4802, 0, 0, 0, 0,
+	1606, 517, 0, 0, 0, 0, 0, 0, 4812, 0,
+	0, 4672, 4676, 3358, 4769, 0, 686, 0, 176, 176,
+	4690, 4801, 0, 1184, 1202, 4792, 1184, 176, 4803, 4831,
+	4804, 4794, 1184, 1184, 1184, 1184, 0, 1184, 1184, 0,
+	4838, 1184, 4837, 1184, 0, 4845, 0, 0, 4847, 4836,
+	117, 1202, 4841, 4882, 4835, 4856, 4857, 4858, 4834, 4854,
+	4861, 0, 0, 1184, 4854, 4875, 3503, 4874, 4854, 4763,
+	4878, 4865, 0, 4830, 4873, 0, 4879, 4877, 4823, 4876,
+	4739, 4851, 4871, 4872, 4740, 4796, 4870, 4852, 4892, 4869,
+	4888, 3918, 4866, 4899, 4867, 4889, 4868, 3358, 4900, 4902,
+	1184, 4905, 4903, 4805, 1184, 0, 4912, 1184, 0, 0,
+	1184, 4915, 3501, 0, 4916, 4881, 0, 4764, 0, 2574,
+	4922, 4933, 4913, 4885, 0, 4838, 4918, 4837, 0, 4920,
+	2642, 686, 0, 0, 4836, 0, 0, 4897, 4944, 4835,
+	0, 0, 0, 4834, 0, 0, 0, 0, 0, 623,
+	4949, 4911, 4945, 0, 0, 4946, 0, 0, 0, 1604,
+	0, 0, 4952, 0, 0, 0, 0, 0, 4924, 4884,
+	0, 0, 0, 0, 4969, 0, 0, 176, 0, 0,
+	1184, 1604, 1184, 0, 0, 0, 1184, 0, 0, 0,
+	4971, 0, 0, 0, 0, 0, 0, 1184, 1184, 1184,
+	1184, 0, 1184, 0, 0, 0, 4948, 4854, 0, 4854,
+	0, 0, 0, 4980, 0, 0, 0, 4859, 4954, 0,
+	0, 4956, 4987, 0, 4854, 4854, 4854, 0, 0, 4854,
+	0, 1184, 0, 1184, 0, 1184, 0, 1184, 0, 176,
+	0, 0, 5010, 0, 176, 5012, 0, 176, 176, 176,
+	0, 0, 0, 0, 0, 0, 5022, 665, 4854, 5007,
+	4854, 4740, 4854, 0, 0, 0, 0, 117, 0, 0,
+	1184, 0, 0, 0, 0, 0, 0, 0, 1184, 0,
+	0, 0, 0, 0, 0, 0, 0, 1184, 0, 0,
+	1184, 0, 5028, 0, 0, 0, 0, 1184, 0, 0,
+	0, 0, 4940, 1184, 0, 4854, 0, 5013, 2330, 2331,
+	2332, 0, 5037, 0, 4854, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4854, 0, 0, 0, 0, 0,
+	4854, 0, 3825, 3826, 0, 0, 0, 0, 0, 0,
+	3835, 0, 0, 3838, 0, 0, 0, 176, 0, 176,
+	3848, 3849, 3850, 3851, 0, 0, 1202, 1202, 3864, 3866,
+	3868, 0, 0, 0, 665, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3871, 0, 117, 0, 0,
+	0, 0, 0, 0, 0, 0, 176, 176, 665, 1202,
+	0, 0, 0, 513, 0, 0, 0, 0, 0, 0,
+	0, 3954, 0, 0, 176, 0, 0, 665, 0, 3964,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 5011, 0, 0, 3086, 0, 0, 0, 5016, 0,
+	0, 0, 0, 0, 0, 2454, 0, 0, 0, 0,
+	0, 0, 0, 2458, 1202, 0, 0, 0, 665, 1456,
+	1202, 0, 1202, 1552, 0, 1202, 0, 3058, 0, 0,
+	0, 1554, 1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560,
+	1561, 1562, 1566, 1555, 0, 0, 1565, 0, 2523, 2524,
+	3953, 1202, 1202, 0, 0, 0, 2531, 2532, 2533, 0,
+	0, 0, 0, 0, 3047, 0, 0, 0, 0, 0,
+	1552, 0, 0, 0, 2546, 0, 0, 3055, 1554, 1553,
+	1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566,
+	1555, 0, 0, 1565, 3952, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 4003, 4004, 0, 3456, 0,
+	2805, 0, 0, 4011, 0, 0, 4014, 0, 0, 0,
+	0, 0, 0, 4021, 4022, 4023, 4024, 3357, 0, 0,
+	0, 0, 4031, 4033, 4035, 0, 0, 0, 4038, 0,
+	0, 4041, 4042, 0, 0, 0, 1202, 0, 3455, 1552,
+	0, 0, 0, 0, 1202, 1202, 1202, 1554, 1553, 1563,
+	1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566, 1555,
+	0, 0, 1565, 0, 0, 0, 176, 0, 0, 176,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 3059,
+	176, 0, 3454, 1552, 0, 1202, 0, 0, 0, 0,
+	3068, 1554, 1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560,
+	1561, 1562, 1566, 1555, 0, 1552, 1565, 0, 0, 0,
+	0, 0, 0, 1554, 1553, 1563, 1564, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1566, 1555, 3057, 3080, 1565, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1552, 1202, 0, 0, 0,
+	0, 0, 2796, 1554, 1553, 1563, 1564, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1566, 1555, 0, 0, 1565, 0,
+	0, 0, 0, 0, 0, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 665, 1552,
+	0, 0, 0, 1202, 0, 0, 0, 1554, 1553, 1563,
+	1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566, 1555,
+	0, 0, 1565, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3075, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 3357, 0, 0, 3418, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 3084, 0, 0,
+	0, 0, 0, 0, 176, 0, 0, 0, 3065, 1552,
+	0, 0, 0, 0, 0, 0, 513, 1554, 1553, 1563,
+	1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566, 1555,
+	0, 0, 1565, 0, 0, 1800, 1800, 0, 513, 0,
+	0, 0, 0, 1202, 0, 1202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1202,
+	1202, 1202, 1202, 0, 0, 0, 0, 0, 0, 665,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3077, 0, 0, 0, 0, 3357, 176, 665, 0, 0,
+	0, 0, 0, 0, 0, 1202, 1202, 0, 0, 0,
+	0, 0, 0, 2869, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 665, 0, 1202,
+	0, 665, 3389, 0, 0, 0, 0, 0, 1456, 0,
+	2818, 665, 0, 0, 0, 0, 2842, 0, 0, 0,
+	0, 0, 176, 176, 1552, 0, 0, 0, 0, 3050,
+	0, 0, 1554, 1553, 1563, 1564, 1556, 1557, 1558, 1559,
+	1560, 1561, 1562, 1566, 1555, 513, 0, 1565, 0, 2817,
+	0, 0, 0, 513, 513, 513, 513, 0, 3062, 0,
+	1202, 513, 513, 1202, 513, 0, 2839, 0, 0, 0,
+	0, 0, 0, 1202, 0, 1202, 0, 513, 513, 1202,
+	176, 513, 0, 0, 0, 0, 1202, 0, 1202, 1202,
+	1202, 1202, 1202, 1202, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2719, 0, 0, 0, 0, 0, 0,
+	0, 3357, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3051, 3053, 0, 0, 3056, 0, 740, 3061, 0,
+	3066, 3063, 3064, 0, 3067, 3060, 0, 3070, 3069, 3071,
+	0, 3072, 3073, 3074, 665, 0, 3076, 3078, 3079, 3081,
+	3082, 3083, 1202, 0, 0, 3054, 3085, 0, 1202, 0,
+	0, 0, 0, 0, 0, 3087, 0, 0, 0, 2808,
+	3753, 2820, 176, 3752, 2819, 2810, 0, 1202, 2843, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2852,
+	171, 0, 516, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 2790, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 685, 0, 2841, 2863, 2814, 2813, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2809, 685, 0, 0, 0,
+	0, 0, 3386, 1121, 0, 2889, 0, 171, 1192, 0,
+	0, 3754, 3755, 0, 0, 2833, 0, 0, 0, 0,
+	0, 3049, 0, 1202, 1552, 2906, 3088, 3089, 1202, 0,
+	0, 171, 1554, 1553, 1563, 1564, 1556, 1557, 1558, 1559,
+	1560, 1561, 1562, 1566, 1555, 516, 171, 1565, 3383, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 3357, 0, 0, 0, 0, 0,
+	1552, 2859, 0, 0, 0, 0, 0, 0, 1554, 1553,
+	1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566,
+	1555, 0, 0, 1565, 0, 0, 2867, 0, 2961, 0,
+	1552, 2944, 0, 2945, 0, 0, 0, 2849, 1554, 1553,
+	1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1566,
+	1555, 0, 0, 1565, 0, 0, 0, 2953, 2954, 2955,
+	0, 0, 0, 2959, 0, 2962, 0, 3086, 2965, 0,
+	0, 2968, 2969, 0, 0, 0, 2974, 2975, 0, 0,
+	0, 0, 2981, 2982, 2983, 0, 0, 2984, 0, 0,
+	0, 2986, 1456, 0, 0, 0, 0, 3357, 0, 0,
+	3058, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 176, 0,
+	1202, 2991, 2992, 2993, 2994, 0, 0, 2998, 2999, 3000,
+	3001, 3002, 3003, 0, 0, 0, 3007, 3008, 3009, 3010,
+	3011, 3012, 3013, 3014, 3015, 3016, 3017, 3018, 0, 3019,
+	3055, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2942, 0, 0, 0, 0, 0, 0,
+	0, 1202, 0, 0, 0, 0, 0, 176, 2835, 0,
+	0, 0, 0, 0, 665, 1552, 0, 0, 0, 665,
+	665, 0, 665, 1554, 1553, 1563, 1564, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1566, 1555, 0, 2846, 1565, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2812, 0, 0, 513, 0, 0, 0, 1800, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1202, 0, 513,
+	0, 0, 0, 1524, 0, 0, 0, 0, 0, 513,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3059, 0, 0, 0, 513, 0, 0, 0,
+	2836, 2837, 0, 3068, 2840, 0, 0, 2845, 0, 2850,
+	2847, 2848, 0, 2851, 2844, 0, 2854, 2853, 2855, 0,
+	2856, 2857, 2858, 0, 0, 2860, 2861, 2862, 2864, 2865,
+	2866, 0, 0, 0, 2838, 2868, 170, 0, 0, 3057,
+	3080, 0, 560, 0, 2832, 0, 0, 170, 171, 0,
+	0, 582, 0, 2909, 0, 0, 0, 170, 0, 0,
+	0, 0, 3357, 0, 516, 0, 0, 0, 0, 684,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1202, 0,
+	0, 0, 684, 729, 0, 0, 0, 1552, 0, 1120,
+	0, 0, 0, 170, 1202, 1554, 1553, 1563, 1564, 1556,
+	1557, 1558, 1559, 1560, 1561, 1562, 1566, 1555, 0, 0,
+	1565, 0, 0, 0, 0, 0, 171, 170, 0, 0,
+	0, 0, 0, 0, 0, 3075, 0, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 2212, 0, 0, 0,
+	2834, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3084, 0, 0, 0, 176, 0, 0, 0, 0, 0,
+	0, 3065, 0, 176, 176, 0, 0, 0, 0, 0,
+	0, 556, 0, 0, 0, 1552, 0, 0, 0, 0,
+	0, 0, 3357, 1554, 1553, 1563, 1564, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1566, 1555, 0, 0, 1565, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 685, 0,
+	0, 0, 3377, 0, 0, 0, 0, 0, 0, 3357,
+	0, 0, 0, 0, 0, 1202, 0, 0, 0, 0,
+	171, 0, 1202, 3077, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1121, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 0, 0, 0,
+	0, 0, 0, 0, 0, 3420, 0, 1202, 3421, 3422,
+	3423, 3424, 3425, 3426, 0, 0, 0, 0, 0, 0,
+	527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 46, 0, 0, 0, 0, 0, 0,
+	0, 0, 3050, 0, 0, 665, 0, 77, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 51, 0, 0,
+	0, 0, 0, 0, 0, 1800, 0, 530, 1202, 0,
+	0, 3062, 0, 0, 0, 0, 541, 554, 555, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
+	1202, 513, 0, 513, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 4576, 0, 0, 543,
+	0, 0, 0, 0, 0, 536, 0, 544, 539, 0,
+	0, 549, 550, 0, 0, 0, 0, 4569, 0, 0,
+	4976, 4979, 4975, 0, 3051, 3053, 1202, 0, 3056, 0,
+	0, 3061, 0, 3066, 3063, 3064, 0, 3067, 3060, 551,
+	3070, 3069, 3071, 0, 3072, 3073, 3074, 0, 0, 3076,
+	3078, 3079, 3081, 3082, 3083, 0, 0, 0, 3054, 3085,
+	0, 1552, 0, 0, 0, 0, 0, 0, 3087, 1554,
+	1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562,
+	1566, 1555, 0, 0, 1565, 0, 0, 0, 0, 0,
+	0, 0, 0, 3647, 176, 513, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 546, 53, 98, 61, 60,
+	63, 0, 0, 0, 0, 104, 0, 0, 0, 0,
+	0, 4570, 0, 0, 547, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 665, 0, 538, 0, 67,
+	100, 99, 0, 0, 0, 0, 62, 1202, 0, 0,
+	0, 0, 0, 0, 0, 0, 1800, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
+	0, 0, 176, 3357, 3049, 0, 0, 0, 0, 3088,
+	3089, 0, 170, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 537, 552, 0,
+	1202, 0, 0, 0, 553, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 74, 75, 0, 4572, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 4581, 4573, 4574, 4575, 4579,
+	4580, 4577, 0, 4578, 0, 4582, 0, 0, 0, 0,
+	0, 0, 0, 85, 0, 86, 528, 171, 0, 0,
+	1121, 0, 0, 0, 0, 0, 0, 0, 1121, 1202,
+	0, 513, 0, 513, 684, 0, 0, 0, 91, 0,
+	513, 0, 0, 0, 0, 0, 0, 65, 0, 0,
+	0, 0, 0, 545, 531, 532, 170, 559, 0, 1202,
+	0, 533, 535, 0, 529, 558, 557, 0, 0, 0,
+	0, 1120, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1202, 0, 0,
+	0, 0, 3901, 3902, 3903, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 548, 0, 0, 516, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4583, 4571, 3930, 71,
+	72, 79, 0, 80, 0, 0, 0, 0, 0, 3937,
+	0, 0, 1202, 0, 0, 0, 0, 0, 0, 171,
+	769, 0, 0, 0, 0, 0, 0, 3948, 3949, 3950,
+	3951, 171, 171, 0, 2153, 3956, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3966, 3967, 516, 516,
+	516, 516, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 171, 171, 171, 171, 171, 171,
+	0, 171, 0, 0, 0, 3976, 0, 0, 1202, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 581, 780,
+	781, 782, 783, 784, 785, 786, 787, 788, 789, 790,
+	791, 792, 793, 794, 795, 796, 797, 798, 799, 800,
+	801, 802, 803, 804, 805, 806, 807, 808, 809, 810,
+	811, 812, 813, 814, 815, 816, 817, 818, 819, 820,
+	821, 0, 0, 0, 665, 0, 1119, 0, 0, 0,
+	513, 1191, 0, 3357, 0, 2869, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 0, 1202, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1456, 0, 2818, 0, 0, 0, 0, 0, 2842, 0,
+	0, 0, 0, 0, 0, 0, 64, 66, 0, 0,
+	0, 0, 95, 0, 0, 0, 0, 0, 0, 0,
+	1202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2817, 0, 0, 0, 171, 171, 0, 0, 0,
+	685, 0, 2293, 0, 0, 76, 171, 3357, 2839, 0,
+	0, 0, 0, 0, 92, 171, 0, 0, 171, 171,
+	171, 171, 0, 1202, 0, 0, 0, 0, 0, 0,
+	685, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	0, 2805, 685, 0, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 4118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	665, 171, 0, 1680, 0, 0, 1120, 0, 516, 0,
+	0, 0, 2391, 0, 1120, 0, 0, 0, 0, 0,
+	0, 2808, 2807, 2820, 2827, 2806, 2819, 2810, 0, 0,
+	2843, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2852, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2830, 2828,
+	2823, 0, 0, 0, 0, 2825, 0, 0, 0, 0,
+	0, 0, 0, 685, 0, 171, 0, 2841, 2863, 2814,
+	2813, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2481, 0, 0, 0, 2809, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2815, 2816, 0, 0, 2833, 0, 2824,
+	2826, 2829, 2831, 0, 0, 1816, 582, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 0, 0, 0, 0,
+	0, 171, 171, 171, 0, 0, 0, 170, 170, 1121,
+	0, 1816, 582, 582, 0, 0, 1854, 0, 0, 0,
+	1856, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2391, 2859, 0, 0, 1192, 0, 0, 170,
+	170, 170, 170, 170, 170, 170, 0, 170, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2867, 0,
+	0, 0, 0, 0, 2175, 2176, 0, 0, 0, 2849,
+	0, 0, 0, 0, 0, 2183, 0, 0, 0, 4232,
+	4233, 4234, 0, 4236, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 4248, 0, 4251,
+	4252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4256, 0, 0, 0, 0, 0, 0, 0, 516, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	516, 0, 0, 0, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 0, 0,
+	0, 0, 171, 171, 0, 4274, 0, 171, 0, 0,
+	0, 0, 0, 4277, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 170, 0, 0, 0, 684, 0, 0, 0,
+	2835, 0, 170, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 0, 1854, 170, 170, 170, 170, 0, 0,
+	0, 0, 0, 0, 0, 0, 684, 0, 0, 2846,
+	0, 0, 0, 0, 170, 0, 0, 0, 684, 0,
+	0, 0, 2812, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2358, 0, 0, 0, 0, 0, 4348, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1119, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 729,
+	0, 2798, 2799, 0, 0, 0, 0, 170, 0, 0,
+	0, 0, 2836, 2837, 4364, 0, 2840, 0, 1856, 2845,
+	0, 2850, 2847, 2848, 0, 2851, 2844, 0, 2854, 2853,
+	2855, 0, 2856, 2857, 2858, 0, 0, 2860, 2861, 2862,
+	2864, 2865, 2866, 0, 0, 0, 2838, 2868, 0, 0,
+	0, 0, 0, 2358, 0, 0, 2832, 0, 0, 0,
+	4398, 4399, 4400, 4401, 0, 0, 0, 0, 0, 0,
+	4405, 4406, 0, 0, 0, 0, 0, 0, 0, 684,
+	0, 170, 2358, 2358, 2358, 0, 0, 4417, 2358, 0,
+	2358, 2358, 2358, 0, 2358, 2358, 0, 0, 0, 1120,
+	2358, 0, 0, 0, 170, 4420, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2358, 2358, 2358, 2358,
+	0, 0, 2358, 2358, 2358, 2358, 2358, 2358, 0, 0,
+	0, 2358, 2358, 2358, 2358, 2358, 2358, 2358, 2358, 2358,
+	2358, 2358, 2358, 0, 0, 0, 0, 170, 170, 170,
+	0, 0, 0, 0, 0, 1120, 0, 0, 0, 0,
+	0, 0, 2834, 0, 0, 0, 0, 0, 4456, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1856, 171,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	4485, 4486, 4487, 4488, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4506, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4516, 0, 0, 0,
+	171, 0, 685, 0, 0, 0, 685, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 0, 0, 0, 0, 0, 4594,
+	4595, 4597, 582, 1854, 0, 582, 0, 0, 0, 0,
+	0, 0, 170, 0, 4613, 0, 0, 0, 170, 170,
+	0, 705, 0, 170, 0, 0, 0, 0, 0, 0,
+	4624, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 4646, 0, 0, 0, 0, 4657, 0, 0,
+	0, 0, 4664, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 685, 171, 0, 0,
+	0, 0, 0, 1119, 169, 685, 3177, 0, 3182, 3183,
+	3185, 1119, 1696, 0, 0, 570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 590, 0, 0, 0, 0,
+	0, 0, 0, 1121, 1121, 0, 0, 0, 0, 2391,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4705, 0, 0, 0, 4708, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1185, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1237, 0, 0, 0, 0,
+	0, 0, 0, 4736, 0, 0, 0, 0, 0, 0,
+	1255, 0, 0, 0, 0, 0, 0, 0, 3248, 0,
+	0, 0, 1815, 581, 0, 0, 0, 0, 4755, 0,
+	0, 0, 3285, 0, 0, 0, 0, 0, 0, 0,
+	0, 3296, 0, 1840, 0, 0, 0, 0, 1815, 581,
+	581, 0, 0, 1853, 0, 171, 0, 3316, 3317, 3318,
+	3319, 3320, 3321, 3322, 3323, 0, 0, 3326, 3327, 3328,
+	3329, 3330, 3331, 3332, 3333, 3334, 3335, 3336, 3337, 3338,
+	3339, 3340, 3341, 3342, 3343, 3344, 3345, 3346, 3347, 3348,
+	0, 3362, 3363, 0, 0, 0, 0, 0, 0, 4818,
+	0, 4820, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 0,
+	0, 0, 171, 0, 0, 171, 0, 0, 0, 1121,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2219, 0,
+	0, 0, 0, 4887, 0, 0, 2230, 0, 0, 0,
+	0, 0, 0, 0, 4898, 170, 0, 0, 0, 2246,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 170, 171, 684, 0,
+	0, 0, 684, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 4947, 0, 2298, 0, 4950, 4951, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1853, 4962, 0, 0, 171, 171, 0, 0, 0, 0,
+	0, 516, 3516, 3517, 3518, 0, 3522, 3523, 3524, 3525,
+	3526, 0, 171, 3529, 3530, 3531, 3532, 3533, 3534, 3535,
+	3536, 3537, 3538, 3539, 3540, 3541, 3542, 3543, 3544, 3545,
+	0, 3547, 3548, 3549, 3550, 3551, 3552, 0, 3555, 3556,
+	4993, 3558, 3559, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 684, 170, 0, 0, 0, 0, 0, 0,
+	0, 684, 0, 0, 0, 2298, 0, 0, 0, 0,
+	0, 0, 1258, 0, 0, 0, 0, 0, 0, 2293,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1120,
+	1120, 0, 0, 0, 0, 1856, 0, 0, 0, 2298,
+	0, 2298, 0, 0, 2439, 0, 0, 0, 0, 0,
+	0, 2440, 0, 0, 0, 0, 2298, 2446, 2298, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1119, 0, 0, 0,
+	1363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2358, 0, 0, 0, 0,
+	0, 0, 2358, 2358, 2358, 2358, 2358, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2391, 0, 0, 0,
+	2358, 0, 1119, 0, 171, 0, 0, 171, 0, 0,
+	0, 170, 171, 0, 0, 0, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 2298, 0, 0, 0, 1191,
+	770, 3791, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 3830, 3831, 0,
+	0, 0, 0, 170, 0, 0, 0, 0, 170, 0,
+	0, 170, 3029, 1856, 0, 1120, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 514, 0, 0, 0, 0,
+	0, 0, 0, 615, 172, 609, 620, 602, 0, 0,
+	0, 3502, 0, 171, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2655, 0, 610, 0,
+	0, 0, 0, 0, 0, 172, 0, 0, 0, 581,
+	1853, 0, 581, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 1193, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 729, 0,
+	0, 170, 0, 170, 172, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 514, 172,
+	0, 0, 0, 0, 516, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 170, 0, 0, 0, 0, 516, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 4000, 0, 4002, 0, 0, 0, 0, 0,
+	4008, 4009, 0, 0, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 601, 600, 603, 0,
+	0, 0, 0, 0, 0, 0, 608, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 612, 0, 0, 0, 0, 616,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 171, 0, 0, 0, 619, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 516, 0, 0, 0, 0, 0, 0,
+	0, 516, 516, 516, 516, 0, 0, 604, 0, 516,
+	516, 0, 516, 0, 0, 0, 0, 0, 0, 0,
+	0, 4059, 0, 4061, 4062, 516, 516, 0, 171, 516,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2358, 1856, 607, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 170, 0, 0, 0, 0, 170, 0,
+	0, 0, 0, 0, 170, 729, 0, 0, 0, 0,
+	2358, 1678, 0, 0, 0, 0, 0, 605, 606, 613,
+	2240, 617, 618, 621, 0, 0, 0, 0, 0, 1738,
+	685, 0, 0, 0, 0, 0, 0, 624, 625, 626,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 0, 0, 1753, 2729,
+	0, 0, 0, 4161, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 0, 0, 0, 0, 1120, 0, 170,
+	0, 0, 0, 0, 2753, 0, 0, 514, 0, 2753,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3502, 0,
+	0, 2293, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1725, 0, 1827, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1842, 1678, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 3591, 0, 0, 0, 0, 2158, 2159, 2160,
+	2161, 2162, 2163, 2164, 0, 2165, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2891, 0, 1749, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1739, 0, 0, 0, 0, 0,
+	0, 0, 2913, 0, 0, 0, 1119, 1119, 0, 0,
+	0, 0, 2298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	171, 0, 0, 0, 4265, 0, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 514,
+	0, 0, 0, 0, 0, 0, 170, 170, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1678,
+	1678, 0, 0, 0, 0, 171, 0, 0, 0, 0,
+	2301, 0, 0, 0, 0, 0, 0, 0, 0, 2310,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 611, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 516, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
+	0, 0, 1119, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 516, 2376, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1754,
+	1757, 1758, 1759, 1760, 1761, 1762, 684, 1711, 1763, 1764,
+	1765, 1767, 1768, 1769, 1770, 1772, 1774, 1775, 1776, 1777,
+	0, 1740, 1741, 1742, 1722, 1721, 1755, 1723, 1726, 1720,
+	1724, 1719, 0, 0, 1727, 1728, 1729, 1730, 1731, 1732,
+	1733, 1734, 1735, 1736, 1737, 1744, 1745, 1746, 1747, 1748,
+	1750, 1751, 1752, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 3154, 3158, 0,
+	0, 0, 2488, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1120, 0, 0, 0, 0, 0,
+	3194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2550, 0, 2552, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 685, 0, 0, 0, 0, 0, 0, 0,
+	0, 685, 171, 0, 0, 2753, 0, 0, 3502, 0,
+	0, 3224, 0, 2753, 0, 0, 2753, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1756, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2298, 2298, 0, 1743, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 0, 0, 0, 1773, 1771, 0, 0, 0,
+	0, 0, 0, 0, 0, 1766, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2656, 0,
+	0, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 0, 0, 0, 0,
+	2688, 0, 0, 0, 0, 0, 2690, 2691, 0, 0,
+	0, 1678, 0, 0, 0, 0, 0, 3375, 0, 0,
+	0, 4610, 0, 0, 0, 3375, 3375, 3375, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 514, 2298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2298, 516, 0, 516,
+	0, 516, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1858, 0, 0,
+	0, 514, 514, 514, 514, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 172, 172, 172,
+	172, 172, 172, 0, 172, 0, 0, 3466, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1119, 0, 0, 0, 4713, 0,
+	4715, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2298, 0, 0, 0, 0, 0,
+	0, 0, 171, 516, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1854, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3590,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
+	171, 0, 0, 0, 0, 0, 0, 4797, 0, 0,
+	0, 0, 0, 0, 3375, 0, 3653, 0, 172, 172,
+	0, 0, 0, 0, 0, 2295, 0, 0, 0, 172,
+	3662, 3663, 3664, 3668, 0, 0, 0, 0, 172, 0,
+	0, 172, 172, 172, 172, 0, 0, 0, 684, 0,
+	0, 0, 0, 2702, 0, 0, 0, 684, 170, 0,
+	0, 172, 0, 0, 1120, 0, 3375, 3375, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1738, 0, 0, 2712, 0, 0, 0, 0, 0, 0,
+	3723, 0, 0, 0, 0, 0, 0, 0, 0, 516,
+	0, 516, 0, 0, 0, 0, 0, 0, 516, 0,
+	0, 0, 0, 0, 172, 0, 0, 0, 0, 0,
+	0, 514, 0, 0, 4770, 2390, 0, 0, 0, 1753,
+	0, 0, 0, 0, 1363, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2891, 3502, 0, 3783, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2891, 0, 2891, 0, 0, 0,
+	3803, 0, 0, 0, 0, 0, 0, 2891, 0, 2891,
+	3812, 2891, 2891, 2891, 2891, 0, 4491, 0, 172, 0,
+	0, 0, 1725, 0, 0, 0, 0, 1738, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 3375, 0, 0, 1753, 0, 0, 3900,
+	0, 0, 0, 0, 172, 172, 172, 0, 1749, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3916, 0,
+	0, 0, 0, 0, 0, 1739, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2390, 0, 0, 0, 1193,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1725,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 516, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 1119, 0, 685, 2298, 0, 0, 0, 0, 2891,
+	0, 514, 0, 0, 0, 1749, 0, 0, 0, 0,
+	0, 0, 0, 514, 0, 0, 0, 172, 0, 0,
+	0, 0, 1739, 0, 0, 0, 0, 0, 0, 2985,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 172, 172, 0, 0, 0,
+	172, 0, 3502, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3022, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 685, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1754, 1757, 1758, 1759, 1760, 1761, 1762, 0, 1711, 1763,
+	1764, 1765, 1767, 1768, 1769, 1770, 1772, 1774, 1775, 1776,
+	1777, 3158, 1740, 1741, 1742, 1722, 1721, 1755, 1723, 1726,
+	1720, 1724, 1719, 0, 0, 1727, 1728, 1729, 1730, 1731,
+	1732, 1733, 1734, 1735, 1736, 1737, 1744, 1745, 1746, 1747,
+	1748, 1750, 1751, 1752, 0, 0, 0, 0, 0, 3147,
+	0, 3149, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3375, 0, 0, 0, 0, 0, 1120, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3191, 3192,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3203, 1754, 1757, 1758,
+	1759, 1760, 1761, 1762, 0, 1711, 1763, 1764, 1765, 1767,
+	1768, 1769, 1770, 1772, 1774, 1775, 1776, 1777, 4150, 1740,
+	1741, 1742, 1722, 1721, 1755, 1723, 1726, 1720, 1724, 1719,
+	0, 0, 1727, 1728, 1729, 1730, 1731, 1732, 1733, 1734,
+	1735, 1736, 1737, 1744, 1745, 1746, 1747, 1748, 1750, 1751,
+	1752, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1756, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1743, 0, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1773, 1771, 0, 0,
+	0, 0, 0, 0, 0, 0, 1766, 0, 0, 46,
+	0, 0, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 1853, 0, 77, 0, 0, 0, 0, 0, 4211,
+	101, 0, 0, 51, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 684,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	1756, 0, 4576, 0, 0, 0, 0, 0, 1678, 0,
+	0, 1678, 0, 1743, 0, 0, 3405, 0, 0, 0,
+	0, 0, 0, 4569, 0, 0, 0, 0, 5041, 0,
+	0, 0, 0, 1773, 1771, 0, 0, 0, 1120, 0,
+	0, 1119, 0, 1766, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 0, 0, 684, 0, 3375, 0, 0, 0,
+	0, 0, 0, 2298, 0, 0, 0, 0, 0, 0,
+	0, 0, 53, 98, 61, 60, 63, 0, 0, 0,
+	0, 104, 0, 0, 0, 0, 0, 4570, 0, 0,
+	0, 0, 2390, 0, 0, 0, 0, 0, 3668, 0,
+	0, 0, 0, 0, 0, 67, 100, 99, 0, 0,
+	0, 0, 62, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3564, 0, 0, 4350,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 46, 0, 0, 0, 0, 0, 0, 0,
+	0, 4357, 0, 0, 0, 0, 77, 0, 74, 75,
+	0, 4572, 0, 101, 0, 0, 51, 0, 0, 0,
+	0, 4581, 4573, 4574, 4575, 4579, 4580, 4577, 172, 4578,
+	0, 4582, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 86, 0, 0, 0, 0, 0, 2298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3708, 97,
+	0, 0, 0, 0, 91, 4576, 0, 0, 0, 0,
+	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4569, 0, 0, 0,
+	172, 5035, 0, 0, 0, 172, 0, 0, 172, 0,
+	1858, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1678, 1678, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 4583, 4571, 0, 71, 72, 79, 0, 80,
+	0, 0, 0, 0, 0, 0, 0, 0, 3375, 0,
+	0, 0, 3804, 0, 0, 53, 98, 61, 60, 63,
+	0, 0, 0, 0, 104, 0, 0, 0, 0, 0,
+	4570, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	172, 0, 0, 0, 0, 0, 0, 0, 67, 100,
+	99, 0, 0, 0, 0, 62, 0, 0, 0, 0,
+	0, 4501, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 172, 0,
+	0, 0, 0, 0, 514, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3375, 74, 75, 0, 4572, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4581, 4573, 4574, 4575, 4579, 4580,
+	4577, 0, 4578, 0, 4582, 0, 0, 0, 0, 0,
+	2298, 0, 85, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 2295, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1119, 0, 91, 3375, 0,
+	0, 0, 64, 66, 0, 0, 65, 0, 95, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 76, 0, 0, 0, 0, 0, 0, 0, 0,
+	92, 0, 0, 2298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 4583, 4571, 0, 71, 72,
+	79, 0, 80, 0, 0, 0, 0, 0, 0, 2390,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	172, 0, 0, 0, 0, 172, 0, 0, 0, 4501,
+	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4065, 0, 0, 0, 0, 0,
+	4069, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2298,
+	0, 0, 0, 0, 0, 0, 172, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4117,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1119, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 64, 66, 0, 0, 0,
+	467, 95, 0, 0, 2298, 172, 0, 0, 0, 0,
+	0, 372, 0, 0, 0, 0, 0, 514, 0, 0,
+	0, 274, 0, 0, 0, 0, 0, 0, 0, 273,
+	259, 0, 0, 0, 76, 0, 0, 0, 0, 514,
+	0, 0, 499, 92, 0, 329, 0, 0, 497, 441,
+	354, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1552, 489, 0, 0, 0, 0, 391, 298, 1554,
+	1553, 1563, 1564, 1556, 1557, 1558, 1559, 1560, 1561, 1562,
+	1566, 1555, 0, 0, 1565, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 514, 0, 0, 0,
+	0, 0, 0, 0, 514, 514, 514, 514, 0, 0,
+	0, 0, 514, 514, 0, 514, 0, 0, 355, 0,
+	0, 0, 0, 316, 0, 0, 0, 0, 514, 514,
+	0, 172, 514, 326, 0, 208, 0, 0, 0, 368,
+	0, 0, 0, 211, 328, 0, 0, 0, 260, 0,
+	407, 0, 488, 0, 293, 0, 0, 406, 330, 480,
+	0, 0, 487, 0, 461, 498, 504, 286, 0, 249,
+	437, 276, 269, 0, 0, 0, 299, 390, 264, 321,
+	0, 0, 0, 256, 0, 0, 0, 436, 477, 214,
+	349, 478, 503, 0, 287, 428, 288, 460, 279, 250,
+	393, 229, 319, 0, 0, 270, 314, 0, 0, 506,
+	496, 240, 289, 401, 405, 382, 236, 468, 350, 360,
+	253, 255, 254, 230, 429, 475, 243, 258, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 308, 300, 0,
+	0, 0, 376, 239, 0, 0, 0, 0, 492, 0,
+	272, 0, 420, 213, 447, 494, 0, 422, 421, 0,
+	307, 0, 0, 0, 400, 0, 317, 218, 0, 508,
+	235, 324, 469, 0, 292, 367, 0, 377, 210, 395,
+	344, 346, 343, 347, 297, 0, 0, 0, 397, 425,
+	474, 237, 444, 0, 0, 0, 413, 0, 0, 0,
+	337, 281, 285, 301, 312, 224, 0, 404, 445, 495,
+	0, 232, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2295, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 448, 465, 415, 295, 336, 338,
+	450, 451, 456, 452, 453, 449, 455, 454, 411, 412,
+	322, 457, 222, 459, 486, 244, 423, 427, 507, 0,
+	231, 252, 446, 225, 0, 0, 0, 0, 0, 0,
+	0, 223, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 296, 0, 483, 484, 261, 0, 0,
+	226, 0, 0, 364, 371, 363, 0, 0, 479, 0,
+	0, 0, 0, 0, 0, 0, 0, 325, 284, 303,
+	388, 332, 389, 304, 358, 357, 359, 334, 0, 443,
+	335, 0, 220, 0, 442, 0, 4426, 458, 241, 0,
+	0, 473, 0, 396, 242, 294, 282, 387, 362, 233,
+	306, 439, 323, 331, 0, 0, 375, 408, 248, 490,
+	438, 277, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4473, 0, 0, 0, 0, 0,
+	0, 0, 0, 384, 221, 234, 245, 246, 247, 271,
+	268, 266, 275, 283, 0, 0, 309, 318, 172, 333,
+	352, 345, 381, 348, 0, 0, 0, 383, 402, 426,
+	432, 433, 462, 463, 464, 466, 470, 471, 472, 0,
+	500, 0, 392, 263, 0, 212, 227, 327, 0, 399,
+	291, 351, 430, 353, 313, 262, 505, 356, 398, 509,
+	0, 0, 0, 0, 514, 0, 0, 0, 0, 0,
+	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	514, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	514, 0, 0, 0, 0, 0, 0, 493, 267, 0,
+	0, 0, 228, 238, 251, 265, 280, 514, 290, 302,
+	305, 310, 311, 315, 320, 339, 340, 341, 342, 365,
+	366, 369, 370, 373, 374, 378, 379, 380, 385, 386,
+	394, 0, 403, 414, 416, 417, 418, 419, 431, 434,
+	435, 481, 482, 501, 502, 0, 424, 440, 0, 209,
+	0, 216, 0, 217, 219, 0, 215, 0, 0, 476,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	514, 0, 514, 0, 514, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 514, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	514, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 514, 0, 514, 0, 0, 0, 0, 0,
+	0, 514, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 931, 1074, 0, 46, 467, 830, 1078, 918,
+	941, 1088, 947, 949, 1014, 893, 989, 372, 938, 894,
+	1039, 0, 0, 885, 734, 886, 919, 274, 733, 1048,
+	992, 1076, 975, 1007, 1017, 273, 259, 982, 981, 1065,
+	930, 929, 1012, 1061, 1075, 0, 0, 185, 499, 203,
+	838, 329, 0, 841, 497, 441, 354, 842, 0, 0,
+	973, 0, 822, 823, 958, 1016, 905, 1003, 1080, 939,
+	1008, 1081, 97, 0, 723, 0, 0, 759, 583, 760,
+	762, 763, 764, 765, 0, 0, 184, 761, 766, 767,
+	768, 0, 968, 1013, 1093, 884, 731, 748, 889, 837,
+	0, 1066, 926, 927, 278, 0, 0, 0, 0, 0,
+	0, 0, 971, 988, 1032, 955, 0, 0, 489, 1019,
+	1028, 1043, 948, 391, 298, 0, 0, 0, 0, 745,
+	746, 0, 0, 0, 0, 855, 0, 0, 747, 0,
+	899, 743, 780, 781, 782, 783, 784, 785, 786, 787,
+	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
+	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
+	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 749, 0, 0, 0, 904, 882,
+	924, 1034, 883, 881, 355, 896, 825, 1607, 956, 316,
+	204, 1070, 954, 853, 1022, 900, 1052, 1086, 942, 326,
+	898, 208, 895, 901, 940, 368, 1031, 1037, 835, 211,
+	328, 1049, 920, 933, 756, 0, 407, 1009, 488, 737,
+	293, 514, 995, 406, 330, 480, 1023, 1072, 487, 943,
+	461, 498, 504, 286, 976, 249, 437, 276, 269, 925,
+	1042, 888, 299, 390, 264, 321, 959, 1015, 921, 256,
+	1026, 1002, 1054, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 1050, 1036, 1056, 916, 902, 908,
+	903, 932, 1073, 308, 300, 1057, 1055, 934, 376, 239,
+	986, 979, 972, 839, 492, 1089, 272, 1038, 420, 213,
+	447, 494, 193, 422, 421, 946, 307, 1040, 194, 183,
+	400, 195, 317, 218, 1060, 508, 235, 324, 469, 736,
+	292, 367, 1011, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 1035, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 1079, 337, 281, 285, 301,
+	312, 224, 1010, 404, 445, 495, 1004, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 1033, 231, 252, 446, 225,
+	1068, 1051, 997, 961, 967, 890, 0, 223, 962, 963,
+	964, 965, 966, 1029, 923, 935, 915, 1005, 914, 296,
+	1021, 757, 758, 261, 828, 1083, 226, 897, 1082, 364,
+	371, 363, 1085, 1084, 479, 1069, 998, 985, 983, 891,
+	1067, 996, 984, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 987, 443, 335, 0, 220, 0,
+	442, 1077, 1095, 458, 241, 909, 1044, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	1025, 1092, 375, 408, 248, 490, 438, 277, 907, 1096,
+	854, 840, 843, 846, 990, 991, 844, 847, 848, 856,
+	826, 827, 829, 831, 832, 833, 978, 1071, 892, 836,
+	1047, 849, 850, 851, 852, 1018, 1090, 824, 257, 773,
+	868, 869, 870, 774, 871, 872, 775, 776, 873, 874,
+	875, 876, 777, 877, 878, 879, 857, 858, 859, 860,
+	861, 862, 863, 864, 867, 865, 866, 0, 974, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	834, 212, 227, 327, 1605, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 1045, 1001, 0, 951,
+	953, 952, 911, 913, 912, 910, 1094, 361, 1063, 880,
+	887, 906, 917, 922, 928, 936, 937, 945, 950, 960,
+	969, 970, 980, 993, 994, 1000, 1024, 1027, 1041, 1046,
+	1053, 1058, 1059, 493, 267, 977, 999, 1030, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 957, 424, 440, 0, 209, 0, 216, 0, 217,
+	219, 944, 215, 1062, 1087, 476, 485, 1006, 1020, 931,
+	1074, 0, 0, 467, 830, 1078, 918, 941, 1088, 947,
+	949, 1014, 893, 989, 372, 938, 894, 1039, 0, 0,
+	885, 734, 886, 919, 274, 733, 1048, 992, 1076, 975,
+	1007, 1017, 273, 259, 982, 981, 1065, 930, 929, 1012,
+	1061, 1075, 0, 0, 185, 499, 203, 838, 329, 0,
+	841, 497, 441, 354, 842, 0, 0, 973, 0, 822,
+	823, 958, 1016, 905, 1003, 1080, 939, 1008, 1081, 97,
+	0, 0, 0, 0, 759, 583, 760, 762, 763, 764,
+	765, 0, 0, 184, 761, 766, 767, 768, 0, 968,
+	1013, 1093, 884, 731, 748, 889, 837, 4675, 1066, 926,
+	927, 278, 0, 0, 0, 0, 0, 0, 0, 971,
+	988, 1032, 955, 0, 0, 489, 1019, 1028, 1043, 948,
+	391, 298, 0, 0, 0, 0, 745, 746, 0, 0,
+	0, 0, 855, 0, 0, 747, 0, 899, 743, 780,
+	781, 782, 783, 784, 785, 786, 787, 788, 789, 790,
+	791, 792, 793, 794, 795, 796, 797, 798, 799, 800,
+	801, 802, 803, 804, 805, 806, 807, 808, 809, 810,
+	811, 812, 813, 814, 815, 816, 817, 818, 819, 820,
+	821, 749, 0, 0, 0, 904, 882, 924, 1034, 883,
+	881, 355, 896, 825, 1064, 956, 316, 204, 1070, 954,
+	853, 1022, 900, 1052, 1086, 942, 326, 898, 208, 895,
+	901, 940, 368, 1031, 1037, 835, 211, 328, 1049, 920,
+	933, 756, 0, 407, 1009, 488, 737, 293, 0, 995,
+	406, 330, 480, 1023, 1072, 487, 943, 461, 498, 504,
+	286, 976, 249, 437, 276, 269, 925, 1042, 888, 299,
+	390, 264, 321, 959, 1015, 921, 256, 1026, 1002, 1054,
+	436, 477, 214, 349, 478, 503, 179, 287, 428, 288,
+	460, 279, 250, 393, 229, 319, 0, 0, 270, 314,
+	0, 0, 506, 496, 240, 289, 401, 405, 382, 236,
+	468, 350, 360, 253, 255, 254, 230, 429, 475, 243,
+	258, 1050, 1036, 1056, 916, 902, 908, 903, 932, 1073,
+	308, 300, 1057, 1055, 934, 376, 239, 986, 979, 972,
+	839, 492, 1089, 272, 1038, 420, 213, 447, 494, 193,
+	422, 421, 946, 307, 1040, 194, 183, 400, 195, 317,
+	218, 1060, 508, 235, 324, 469, 736, 292, 367, 1011,
+	377, 210, 395, 344, 346, 343, 347, 297, 188, 196,
+	1035, 397, 425, 474, 237, 444, 186, 189, 198, 413,
+	199, 200, 1079, 337, 281, 285, 301, 312, 224, 1010,
+	404, 445, 495, 1004, 232, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 448, 465, 415,
+	295, 336, 338, 450, 451, 456, 452, 453, 449, 455,
+	454, 411, 412, 322, 457, 222, 459, 486, 244, 423,
+	427, 507, 1033, 231, 252, 446, 225, 1068, 1051, 997,
+	961, 967, 890, 0, 223, 962, 963, 964, 965, 966,
+	1029, 923, 935, 915, 1005, 914, 296, 1021, 757, 758,
+	261, 828, 1083, 226, 897, 1082, 364, 371, 363, 1085,
+	1084, 479, 1069, 998, 985, 983, 891, 1067, 996, 984,
+	325, 284, 303, 388, 332, 389, 304, 358, 357, 359,
+	334, 987, 443, 335, 0, 220, 0, 442, 1077, 1095,
+	458, 241, 909, 1044, 473, 191, 396, 242, 294, 282,
+	387, 362, 233, 306, 439, 323, 331, 1025, 1092, 375,
+	408, 248, 490, 438, 277, 907, 1096, 854, 840, 843,
+	846, 990, 991, 844, 847, 848, 856, 826, 827, 829,
+	831, 832, 833, 978, 1071, 892, 836, 1047, 849, 850,
+	851, 852, 1018, 1090, 824, 257, 773, 868, 869, 870,
+	774, 871, 872, 775, 776, 873, 874, 875, 876, 777,
+	877, 878, 879, 857, 858, 859, 860, 861, 862, 863,
+	864, 867, 865, 866, 0, 974, 384, 221, 234, 245,
+	246, 247, 271, 268, 266, 275, 283, 0, 0, 309,
+	318, 0, 333, 352, 345, 381, 348, 0, 0, 0,
+	383, 402, 426, 432, 433, 462, 463, 464, 466, 470,
+	471, 472, 0, 500, 0, 392, 263, 834, 212, 227,
+	327, 1091, 399, 291, 351, 430, 353, 313, 262, 505,
+	356, 398, 509, 1045, 1001, 0, 951, 953, 952, 911,
+	913, 912, 910, 1094, 361, 1063, 880, 887, 906, 917,
+	922, 928, 936, 937, 945, 950, 960, 969, 970, 980,
+	993, 994, 1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059,
+	493, 267, 977, 999, 1030, 228, 238, 251, 265, 280,
+	0, 290, 302, 305, 310, 311, 315, 320, 339, 340,
+	341, 342, 365, 366, 369, 370, 373, 374, 378, 379,
+	380, 385, 386, 394, 197, 403, 414, 416, 417, 418,
+	419, 431, 434, 435, 481, 482, 501, 502, 957, 424,
+	440, 0, 209, 0, 216, 0, 217, 219, 944, 215,
+	1062, 1087, 476, 485, 1006, 1020, 931, 1074, 0, 0,
+	467, 830, 1078, 918, 941, 1088, 947, 949, 1014, 893,
+	989, 372, 938, 894, 1039, 0, 0, 885, 734, 886,
+	919, 274, 733, 1048, 992, 1076, 975, 1007, 1017, 273,
+	259, 982, 981, 1065, 930, 929, 1012, 1061, 1075, 0,
+	0, 185, 499, 203, 838, 329, 0, 841, 497, 441,
+	354, 842, 0, 0, 973, 0, 822, 823, 958, 1016,
+	905, 1003, 1080, 939, 1008, 1081, 97, 0, 723, 0,
+	0, 759, 583, 760, 762, 763, 764, 765, 0, 0,
+	184, 761, 766, 767, 768, 0, 968, 1013, 1093, 884,
+	731, 748, 889, 837, 0, 1066, 926, 927, 278, 0,
+	0, 0, 0, 0, 0, 0, 971, 988, 1032, 955,
+	0, 0, 489, 1019, 1028, 1043, 948, 391, 298, 0,
+	0, 0, 0, 745, 746, 0, 0, 0, 0, 855,
+	0, 0, 747, 0, 899, 743, 780, 781, 782, 783,
+	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
+	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
+	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
+	814, 815, 816, 817, 818, 819, 820, 821, 749, 0,
+	0, 0, 904, 882, 924, 1034, 883, 881, 355, 896,
+	825, 1064, 956, 316, 204, 1070, 954, 853, 1022, 900,
+	1052, 1086, 942, 326, 898, 208, 895, 901, 940, 368,
+	1031, 1037, 835, 211, 328, 1049, 920, 933, 756, 0,
+	407, 1009, 488, 737, 293, 0, 995, 406, 330, 480,
+	1023, 1072, 487, 943, 461, 498, 504, 286, 976, 249,
+	437, 276, 269, 925, 1042, 888, 299, 390, 264, 321,
+	959, 1015, 921, 256, 1026, 1002, 1054, 436, 477, 214,
+	349, 478, 503, 179, 287, 428, 288, 460, 279, 250,
+	393, 229, 319, 0, 0, 270, 314, 0, 0, 506,
+	496, 240, 289, 401, 405, 382, 236, 468, 350, 360,
+	253, 255, 254, 230, 429, 475, 243, 258, 1050, 1036,
+	1056, 916, 902, 908, 903, 932, 1073, 308, 300, 1057,
+	1055, 934, 376, 239, 986, 979, 972, 839, 492, 1089,
+	272, 1038, 420, 213, 447, 494, 193, 422, 421, 946,
+	307, 1040, 194, 183, 400, 195, 317, 218, 1060, 508,
+	235, 324, 469, 736, 292, 367, 1011, 377, 210, 395,
+	344, 346, 343, 347, 297, 188, 196, 1035, 397, 425,
+	474, 237, 444, 186, 189, 198, 413, 199, 200, 1079,
+	337, 281, 285, 301, 312, 224, 1010, 404, 445, 495,
+	1004, 232, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 448, 465, 415, 295, 336, 338,
+	450, 451, 456, 452, 453, 449, 455, 454, 411, 412,
+	322, 457, 222, 459, 486, 244, 423, 427, 507, 1033,
+	231, 252, 446, 225, 1068, 1051, 997, 961, 967, 890,
+	0, 223, 962, 963, 964, 965, 966, 1029, 923, 935,
+	915, 1005, 914, 296, 1021, 757, 758, 261, 828, 1083,
+	226, 897, 1082, 364, 371, 363, 1085, 1084, 479, 1069,
+	998, 985, 983, 891, 1067, 996, 984, 325, 284, 303,
+	388, 332, 389, 304, 358, 357, 359, 334, 987, 443,
+	335, 0, 220, 0, 442, 1077, 1095, 458, 241, 909,
+	1044, 473, 191, 396, 242, 294, 282, 387, 362, 233,
+	306, 439, 323, 331, 1025, 1092, 375, 408, 248, 490,
+	438, 277, 907, 1096, 854, 840, 843, 846, 990, 991,
+	844, 847, 848, 856, 826, 827, 829, 831, 832, 833,
+	978, 1071, 892, 836, 1047, 849, 850, 851, 852, 1018,
+	1090, 824, 257, 773, 868, 869, 870, 774, 871, 872,
+	775, 776, 873, 874, 875, 876, 777, 877, 878, 879,
+	857, 858, 859, 860, 861, 862, 863, 864, 867, 865,
+	866, 0, 974, 384, 221, 234, 245, 246, 247, 271,
+	268, 266, 275, 283, 0, 0, 309, 318, 0, 333,
+	352, 345, 381, 348, 0, 0, 0, 383, 402, 426,
+	432, 433, 462, 463, 464, 466, 470, 471, 472, 0,
+	500, 0, 392, 263, 834, 212, 227, 327, 1091, 399,
+	291, 351, 430, 353, 313, 262, 505, 356, 398, 509,
+	1045, 1001, 0, 951, 953, 952, 911, 913, 912, 910,
+	1094, 361, 1063, 880, 887, 906, 917, 922, 928, 936,
+	937, 945, 950, 960, 969, 970, 980, 993, 994, 1000,
+	1024, 1027, 1041, 1046, 1053, 1058, 1059, 493, 267, 977,
+	999, 1030, 228, 238, 251, 265, 280, 0, 290, 302,
+	305, 310, 311, 315, 320, 339, 340, 341, 342, 365,
+	366, 369, 370, 373, 374, 378, 379, 380, 385, 386,
+	394, 197, 403, 414, 416, 417, 418, 419, 431, 434,
+	435, 481, 482, 501, 502, 957, 424, 440, 0, 209,
+	0, 216, 0, 217, 219, 944, 215, 1062, 1087, 476,
+	485, 1006, 1020, 931, 1074, 0, 0, 467, 830, 1078,
+	918, 941, 1088, 947, 949, 1014, 893, 989, 372, 938,
+	894, 1039, 0, 0, 885, 734, 886, 919, 274, 733,
+	1048, 992, 1076, 975, 1007, 1017, 273, 259, 982, 981,
+	1065, 930, 929, 1012, 1061, 1075, 0, 0, 185, 499,
+	203, 838, 329, 0, 841, 497, 441, 354, 842, 0,
+	0, 973, 0, 822, 823, 958, 1016, 905, 1003, 1080,
+	939, 1008, 1081, 97, 0, 0, 0, 0, 759, 583,
+	760, 762, 763, 764, 765, 0, 0, 184, 761, 766,
+	767, 768, 0, 968, 1013, 1093, 884, 731, 748, 889,
+	837, 0, 1066, 926, 927, 278, 0, 0, 0, 0,
+	0, 0, 0, 971, 988, 1032, 955, 0, 0, 489,
+	1019, 1028, 1043, 948, 391, 298, 0, 0, 0, 0,
+	745, 746, 2356, 0, 0, 0, 855, 0, 0, 747,
+	0, 899, 743, 780, 781, 782, 783, 784, 785, 786,
+	787, 788, 789, 790, 791, 792, 793, 794, 795, 796,
+	797, 798, 799, 800, 801, 802, 803, 804, 805, 806,
+	807, 808, 809, 810, 811, 812, 813, 814, 815, 816,
+	817, 818, 819, 820, 821, 749, 0, 0, 0, 904,
+	882, 924, 1034, 883, 881, 355, 896, 825, 1064, 956,
+	316, 204, 1070, 954, 853, 1022, 900, 1052, 1086, 942,
+	326, 898, 208, 895, 901, 940, 368, 1031, 1037, 835,
+	211, 328, 1049, 920, 933, 756, 0, 407, 1009, 488,
+	737, 293, 0, 995, 406, 330, 480, 1023, 1072, 487,
+	943, 461, 498, 504, 286, 976, 249, 437, 276, 269,
+	925, 1042, 888, 299, 390, 264, 321, 959, 1015, 921,
+	256, 1026, 1002, 1054, 436, 477, 214, 349, 478, 503,
+	179, 287, 428, 288, 460, 279, 250, 393, 229, 319,
+	0, 0, 270, 314, 0, 0, 506, 496, 240, 289,
+	401, 405, 382, 236, 468, 350, 360, 253, 255, 254,
+	230, 429, 475, 243, 258, 1050, 1036, 1056, 916, 902,
+	908, 903, 932, 1073, 308, 300, 1057, 1055, 934, 376,
+	239, 986, 979, 972, 839, 492, 1089, 272, 1038, 420,
+	213, 447, 494, 193, 422, 421, 946, 307, 1040, 194,
+	183, 400, 195, 317, 218, 1060, 508, 235, 324, 469,
+	736, 292, 367, 1011, 377, 210, 395, 344, 346, 343,
+	347, 297, 188, 196, 1035, 397, 425, 474, 237, 444,
+	186, 189, 198, 413, 199, 200, 1079, 337, 281, 285,
+	301, 312, 224, 1010, 404, 445, 495, 1004, 232, 491,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 448, 465, 415, 295, 336, 338, 450, 451, 456,
+	452, 453, 449, 455, 454, 411, 412, 322, 457, 222,
+	459, 486, 244, 423, 427, 507, 1033, 231, 252, 446,
+	225, 1068, 1051, 997, 961, 967, 890, 0, 223, 962,
+	963, 964, 965, 966, 1029, 923, 935, 915, 1005, 914,
+	296, 1021, 757, 758, 261, 828, 1083, 226, 897, 1082,
+	364, 371, 363, 1085, 1084, 479, 1069, 998, 985, 983,
+	891, 1067, 996, 984, 325, 284, 303, 388, 332, 389,
+	304, 358, 357, 359, 334, 987, 443, 335, 0, 220,
+	0, 442, 1077, 1095, 458, 241, 909, 1044, 473, 191,
+	396, 242, 294, 282, 387, 362, 233, 306, 439, 323,
+	331, 1025, 1092, 375, 408, 248, 490, 438, 277, 907,
+	1096, 854, 840, 843, 846, 990, 991, 844, 847, 848,
+	856, 826, 827, 829, 831, 832, 833, 978, 1071, 892,
+	836, 1047, 849, 850, 851, 852, 1018, 1090, 824, 257,
+	773, 868, 869, 870, 774, 871, 872, 775, 776, 873,
+	874, 875, 876, 777, 877, 878, 879, 857, 858, 859,
+	860, 861, 862, 863, 864, 867, 865, 866, 0, 974,
+	384, 221, 234, 245, 246, 247, 271, 268, 266, 275,
+	283, 0, 0, 309, 318, 0, 333, 352, 345, 381,
+	348, 0, 0, 0, 383, 402, 426, 432, 433, 462,
+	463, 464, 466, 470, 471, 472, 0, 500, 0, 392,
+	263, 834, 212, 227, 327, 1091, 399, 291, 351, 430,
+	353, 313, 262, 505, 356, 398, 509, 1045, 1001, 0,
+	951, 953, 952, 911, 913, 912, 910, 1094, 361, 1063,
+	880, 887, 906, 917, 922, 928, 936, 937, 945, 950,
+	960, 969, 970, 980, 993, 994, 1000, 1024, 1027, 1041,
+	1046, 1053, 1058, 1059, 493, 267, 977, 999, 1030, 228,
+	238, 251, 265, 280, 0, 290, 302, 305, 310, 311,
+	315, 320, 339, 340, 341, 342, 365, 366, 369, 370,
+	373, 374, 378, 379, 380, 385, 386, 394, 197, 403,
+	414, 416, 417, 418, 419, 431, 434, 435, 481, 482,
+	501, 502, 957, 424, 440, 0, 209, 0, 216, 0,
+	217, 219, 944, 215, 1062, 1087, 476, 485, 1006, 1020,
+	931, 1074, 0, 0, 467, 830, 1078, 918, 941, 1088,
+	947, 949, 1014, 893, 989, 372, 938, 894, 1039, 0,
+	0, 885, 734, 886, 919, 274, 733, 1048, 992, 1076,
+	975, 1007, 1017, 273, 259, 982, 981, 1065, 930, 929,
+	1012, 1061, 1075, 0, 0, 185, 499, 203, 838, 329,
+	0, 841, 497, 441, 354, 842, 0, 0, 973, 0,
+	822, 823, 958, 1016, 905, 1003, 1080, 939, 1008, 1081,
+	97, 0, 0, 0, 0, 759, 583, 760, 762, 763,
+	764, 765, 0, 0, 184, 761, 766, 767, 768, 0,
+	968, 1013, 1093, 884, 731, 748, 889, 837, 0, 1066,
+	926, 927, 278, 0, 0, 0, 0, 0, 0, 0,
+	971, 988, 1032, 955, 0, 0, 489, 1019, 1028, 1043,
+	948, 391, 298, 0, 0, 0, 0, 745, 746, 727,
+	0, 0, 0, 855, 0, 0, 747, 0, 899, 743,
+	780, 781, 782, 783, 784, 785, 786, 787, 788, 789,
+	790, 791, 792, 793, 794, 795, 796, 797, 798, 799,
+	800, 801, 802, 803, 804, 805, 806, 807, 808, 809,
+	810, 811, 812, 813, 814, 815, 816, 817, 818, 819,
+	820, 821, 749, 0, 0, 0, 904, 882, 924, 1034,
+	883, 881, 355, 896, 825, 1064, 956, 316, 204, 1070,
+	954, 853, 1022, 900, 1052, 1086, 942, 326, 898, 208,
+	895, 901, 940, 368, 1031, 1037, 835, 211, 328, 1049,
+	920, 933, 756, 0, 407, 1009, 488, 737, 293, 0,
+	995, 406, 330, 480, 1023, 1072, 487, 943, 461, 498,
+	504, 286, 976, 249, 437, 276, 269, 925, 1042, 888,
+	299, 390, 264, 321, 959, 1015, 921, 256, 1026, 1002,
+	1054, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 1050, 1036, 1056, 916, 902, 908, 903, 932,
+	1073, 308, 300, 1057, 1055, 934, 376, 239, 986, 979,
+	972, 839, 492, 1089, 272, 1038, 420, 213, 447, 494,
+	193, 422, 421, 946, 307, 1040, 194, 183, 400, 195,
+	317, 218, 1060, 508, 235, 324, 469, 736, 292, 367,
+	1011, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 1035, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 1079, 337, 281, 285, 301, 312, 224,
+	1010, 404, 445, 495, 1004, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 1033, 231, 252, 446, 225, 1068, 1051,
+	997, 961, 967, 890, 0, 223, 962, 963, 964, 965,
+	966, 1029, 923, 935, 915, 1005, 914, 296, 1021, 757,
+	758, 261, 828, 1083, 226, 897, 1082, 364, 371, 363,
+	1085, 1084, 479, 1069, 998, 985, 983, 891, 1067, 996,
+	984, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 987, 443, 335, 0, 220, 0, 442, 1077,
+	1095, 458, 241, 909, 1044, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 1025, 1092,
+	375, 408, 248, 490, 438, 277, 907, 1096, 854, 840,
+	843, 846, 990, 991, 844, 847, 848, 856, 826, 827,
+	829, 831, 832, 833, 978, 1071, 892, 836, 1047, 849,
+	850, 851, 852, 1018, 1090, 824, 257, 773, 868, 869,
+	870, 774, 871, 872, 775, 776, 873, 874, 875, 876,
+	777, 877, 878, 879, 857, 858, 859, 860, 861, 862,
+	863, 864, 867, 865, 866, 0, 974, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 834, 212,
+	227, 327, 1091, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 1045, 1001, 0, 951, 953, 952,
+	911, 913, 912, 910, 1094, 361, 1063, 880, 887, 906,
+	917, 922, 928, 936, 937, 945, 950, 960, 969, 970,
+	980, 993, 994, 1000, 1024, 1027, 1041, 1046, 1053, 1058,
+	1059, 493, 267, 977, 999, 1030, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 957,
+	424, 440, 0, 209, 0, 216, 0, 217, 219, 944,
+	215, 1062, 1087, 476, 485, 1006, 1020, 931, 1074, 0,
+	0, 467, 830, 1078, 918, 941, 1088, 947, 949, 1014,
+	893, 989, 372, 938, 894, 1039, 0, 0, 885, 734,
+	886, 919, 274, 733, 1048, 992, 1076, 975, 1007, 1017,
+	273, 259, 982, 981, 1065, 930, 929, 1012, 1061, 1075,
+	0, 0, 185, 499, 203, 838, 329, 0, 841, 497,
+	441, 354, 842, 0, 0, 973, 0, 822, 823, 958,
+	1016, 905, 1003, 1080, 939, 2576, 1081, 97, 0, 0,
+	0, 0, 2578, 583, 760, 762, 763, 764, 765, 0,
+	0, 184, 761, 766, 767, 768, 2577, 968, 1013, 1093,
+	884, 731, 748, 889, 837, 0, 1066, 926, 927, 278,
+	0, 0, 0, 0, 0, 0, 0, 971, 988, 1032,
+	955, 0, 0, 489, 1019, 1028, 1043, 948, 391, 298,
+	0, 0, 0, 0, 745, 746, 0, 0, 0, 0,
+	855, 0, 0, 747, 0, 899, 743, 780, 781, 782,
+	783, 784, 785, 786, 787, 788, 789, 790, 791, 792,
+	793, 794, 795, 796, 797, 798, 799, 800, 801, 802,
+	803, 804, 805, 806, 807, 808, 809, 810, 811, 812,
+	813, 814, 815, 816, 817, 818, 819, 820, 821, 749,
+	0, 0, 0, 904, 882, 924, 1034, 883, 881, 355,
+	896, 825, 1064, 956, 316, 204, 1070, 954, 853, 1022,
+	900, 1052, 1086, 942, 326, 898, 208, 895, 901, 940,
+	368, 1031, 1037, 835, 211, 328, 1049, 920, 933, 756,
+	0, 407, 1009, 488, 737, 293, 0, 995, 406, 330,
+	480, 1023, 1072, 487, 943, 461, 498, 504, 286, 976,
+	249, 437, 276, 269, 925, 1042, 888, 299, 390, 264,
+	321, 959, 1015, 921, 256, 1026, 1002, 1054, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 1050,
+	1036, 1056, 916, 902, 908, 903, 932, 1073, 308, 300,
+	1057, 1055, 934, 376, 239, 986, 979, 972, 839, 492,
+	1089, 272, 1038, 420, 213, 447, 494, 193, 422, 421,
+	946, 307, 1040, 194, 183, 400, 195, 317, 218, 1060,
+	508, 235, 324, 469, 736, 292, 367, 1011, 377, 210,
+	395, 344, 346, 343, 347, 297, 188, 196, 1035, 397,
+	425, 474, 237, 444, 186, 189, 198, 413, 199, 200,
+	1079, 337, 281, 285, 301, 312, 224, 1010, 404, 445,
+	495, 1004, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	1033, 231, 252, 446, 225, 1068, 1051, 997, 961, 967,
+	890, 0, 223, 962, 963, 964, 965, 966, 1029, 923,
+	935, 915, 1005, 914, 296, 1021, 757, 758, 261, 828,
+	1083, 226, 897, 1082, 364, 371, 363, 1085, 1084, 479,
+	1069, 998, 985, 983, 891, 1067, 996, 984, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 987,
+	443, 335, 0, 220, 0, 442, 1077, 1095, 458, 241,
+	909, 1044, 473, 191, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 1025, 1092, 375, 408, 248,
+	490, 438, 277, 907, 1096, 854, 840, 843, 846, 990,
+	991, 844, 847, 848, 856, 826, 827, 829, 831, 832,
+	833, 978, 1071, 892, 836, 1047, 849, 850, 851, 852,
+	1018, 1090, 824, 257, 773, 868, 869, 870, 774, 871,
+	872, 775, 776, 873, 874, 875, 876, 777, 877, 878,
+	879, 857, 858, 859, 860, 861, 862, 863, 864, 867,
+	865, 866, 0, 974, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 834, 212, 227, 327, 1091,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 1045, 1001, 0, 951, 953, 952, 911, 913, 912,
+	910, 1094, 361, 1063, 880, 887, 906, 917, 922, 928,
+	936, 937, 945, 950, 960, 969, 970, 980, 993, 994,
+	1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059, 493, 267,
+	977, 999, 1030, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 197, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 957, 424, 440, 0,
+	209, 0, 216, 0, 217, 219, 944, 215, 1062, 1087,
+	476, 485, 1006, 1020, 931, 1074, 0, 0, 467, 830,
+	1078, 918, 941, 1088, 947, 949, 1014, 893, 989, 372,
+	938, 894, 1039, 0, 0, 885, 734, 886, 919, 274,
+	733, 1048, 992, 1076, 975, 1007, 1017, 273, 259, 982,
+	981, 1065, 930, 929, 1012, 1061, 1075, 0, 0, 185,
+	499, 203, 838, 329, 0, 841, 497, 441, 354, 842,
+	0, 0, 973, 0, 822, 823, 958, 1016, 905, 1003,
+	1080, 939, 1008, 1081, 97, 0, 0, 0, 0, 2468,
+	583, 760, 762, 763, 764, 765, 0, 0, 184, 761,
+	766, 767, 768, 0, 968, 1013, 1093, 884, 731, 748,
+	889, 837, 0, 1066, 926, 927, 278, 0, 0, 0,
+	0, 0, 0, 0, 971, 988, 1032, 955, 0, 0,
+	489, 1019, 1028, 1043, 948, 391, 298, 0, 0, 0,
+	0, 745, 746, 2356, 0, 0, 0, 855, 0, 0,
+	747, 0, 899, 743, 780, 781, 782, 783, 784, 785,
+	786, 787, 788, 789, 790, 791, 792, 793, 794, 795,
+	796, 797, 798, 799, 800, 801, 802, 803, 804, 805,
+	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
+	816, 817, 818, 819, 820, 821, 749, 0, 0, 0,
+	904, 882, 924, 1034, 883, 881, 355, 896, 825, 1064,
+	956, 316, 204, 1070, 954, 853, 1022, 900, 1052, 1086,
+	942, 326, 898, 208, 895, 901, 940, 368, 1031, 1037,
+	835, 211, 328, 1049, 920, 933, 756, 0, 407, 1009,
+	488, 737, 293, 0, 995, 406, 330, 480, 1023, 1072,
+	487, 943, 461, 498, 504, 286, 976, 249, 437, 276,
+	269, 925, 1042, 888, 299, 390, 264, 321, 959, 1015,
+	921, 256, 1026, 1002, 1054, 436, 477, 214, 349, 478,
+	503, 179, 287, 428, 288, 460, 279, 250, 393, 229,
+	319, 0, 0, 270, 314, 0, 0, 506, 496, 240,
+	289, 401, 405, 382, 236, 468, 350, 360, 253, 255,
+	254, 230, 429, 475, 243, 258, 1050, 1036, 1056, 916,
+	902, 908, 903, 932, 1073, 308, 300, 1057, 1055, 934,
+	376, 239, 986, 979, 972, 839, 492, 1089, 272, 1038,
+	420, 213, 447, 494, 193, 422, 421, 946, 307, 1040,
+	194, 183, 400, 195, 317, 218, 1060, 508, 235, 324,
+	469, 736, 292, 367, 1011, 377, 210, 395, 344, 346,
+	343, 347, 297, 188, 196, 1035, 397, 425, 474, 237,
+	444, 186, 189, 198, 413, 199, 200, 1079, 337, 281,
+	285, 301, 312, 224, 1010, 404, 445, 495, 1004, 232,
+	491, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 448, 465, 415, 295, 336, 338, 450, 451,
+	456, 452, 453, 449, 455, 454, 411, 412, 322, 457,
+	222, 459, 486, 244, 423, 427, 507, 1033, 231, 252,
+	446, 225, 1068, 1051, 997, 961, 967, 890, 0, 223,
+	962, 963, 964, 965, 966, 1029, 923, 935, 915, 1005,
+	914, 296, 1021, 757, 758, 261, 828, 1083, 226, 897,
+	1082, 364, 371, 363, 1085, 1084, 479, 1069, 998, 985,
+	983, 891, 1067, 996, 984, 325, 284, 303, 388, 332,
+	389, 304, 358, 357, 359, 334, 987, 443, 335, 0,
+	220, 0, 442, 1077, 1095, 458, 241, 909, 1044, 473,
+	191, 396, 242, 294, 282, 387, 362, 233, 306, 439,
+	323, 331, 1025, 1092, 375, 408, 248, 490, 438, 277,
+	907, 1096, 854, 840, 843, 846, 990, 991, 844, 847,
+	848, 856, 826, 827, 829, 831, 832, 833, 978, 1071,
+	892, 836, 1047, 849, 850, 851, 852, 1018, 1090, 824,
+	257, 773, 868, 869, 870, 774, 871, 872, 775, 776,
+	873, 874, 875, 876, 777, 877, 878, 879, 857, 858,
+	859, 860, 861, 862, 863, 864, 867, 865, 866, 0,
+	974, 384, 221, 234, 245, 246, 247, 271, 268, 266,
+	275, 283, 0, 0, 309, 318, 0, 333, 352, 345,
+	381, 348, 0, 0, 0, 383, 402, 426, 432, 433,
+	462, 463, 464, 466, 470, 471, 472, 0, 500, 0,
+	392, 263, 834, 212, 227, 327, 1091, 399, 291, 351,
+	430, 353, 313, 262, 505, 356, 398, 509, 1045, 1001,
+	0, 951, 953, 952, 911, 913, 912, 910, 1094, 361,
+	1063, 880, 887, 906, 917, 922, 928, 936, 937, 945,
+	950, 960, 969, 970, 980, 993, 994, 1000, 1024, 1027,
+	1041, 1046, 1053, 1058, 1059, 493, 267, 977, 999, 1030,
+	228, 238, 251, 265, 280, 0, 290, 302, 305, 310,
+	311, 315, 320, 339, 340, 341, 342, 365, 366, 369,
+	370, 373, 374, 378, 379, 380, 385, 386, 394, 197,
+	403, 414, 416, 417, 418, 419, 431, 434, 435, 481,
+	482, 501, 502, 957, 424, 440, 0, 209, 0, 216,
+	0, 217, 219, 944, 215, 1062, 1087, 476, 485, 1006,
+	1020, 931, 1074, 0, 0, 467, 830, 1078, 918, 941,
+	1088, 947, 949, 1014, 893, 989, 372, 938, 894, 1039,
+	0, 0, 885, 734, 886, 919, 274, 733, 1048, 992,
+	1076, 975, 1007, 1017, 273, 259, 982, 981, 1065, 930,
+	929, 1012, 1061, 1075, 0, 0, 185, 499, 203, 838,
+	329, 0, 841, 497, 441, 354, 842, 0, 0, 973,
+	0, 822, 823, 958, 1016, 905, 1003, 1080, 939, 1008,
+	1081, 97, 0, 0, 0, 0, 2465, 583, 760, 762,
+	763, 764, 765, 0, 0, 184, 761, 766, 767, 768,
+	0, 968, 1013, 1093, 884, 731, 748, 889, 837, 0,
+	1066, 926, 927, 278, 0, 0, 0, 0, 0, 0,
+	0, 971, 988, 1032, 955, 0, 0, 489, 1019, 1028,
+	1043, 948, 391, 298, 0, 0, 0, 0, 745, 746,
+	2356, 0, 0, 0, 855, 0, 0, 747, 0, 899,
+	743, 780, 781, 782, 783, 784, 785, 786, 787, 788,
+	789, 790, 791, 792, 793, 794, 795, 796, 797, 798,
+	799, 800, 801, 802, 803, 804, 805, 806, 807, 808,
+	809, 810, 811, 812, 813, 814, 815, 816, 817, 818,
+	819, 820, 821, 749, 0, 0, 0, 904, 882, 924,
+	1034, 883, 881, 355, 896, 825, 1064, 956, 316, 204,
+	1070, 954, 853, 1022, 900, 1052, 1086, 942, 326, 898,
+	208, 895, 901, 940, 368, 1031, 1037, 835, 211, 328,
+	1049, 920, 933, 756, 0, 407, 1009, 488, 737, 293,
+	0, 995, 406, 330, 480, 1023, 1072, 487, 943, 461,
+	498, 504, 286, 976, 249, 437, 276, 269, 925, 1042,
+	888, 299, 390, 264, 321, 959, 1015, 921, 256, 1026,
+	1002, 1054, 436, 477, 214, 349, 478, 503, 179, 287,
+	428, 288, 460, 279, 250, 393, 229, 319, 0, 0,
+	270, 314, 0, 0, 506, 496, 240, 289, 401, 405,
+	382, 236, 468, 350, 360, 253, 255, 254, 230, 429,
+	475, 243, 258, 1050, 1036, 1056, 916, 902, 908, 903,
+	932, 1073, 308, 300, 1057, 1055, 934, 376, 239, 986,
+	979, 972, 839, 492, 1089, 272, 1038, 420, 213, 447,
+	494, 193, 422, 421, 946, 307, 1040, 194, 183, 400,
+	195, 317, 218, 1060, 508, 235, 324, 469, 736, 292,
+	367, 1011, 377, 210, 395, 344, 346, 343, 347, 297,
+	188, 196, 1035, 397, 425, 474, 237, 444, 186, 189,
+	198, 413, 199, 200, 1079, 337, 281, 285, 301, 312,
+	224, 1010, 404, 445, 495, 1004, 232, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 448,
+	465, 415, 295, 336, 338, 450, 451, 456, 452, 453,
+	449, 455, 454, 411, 412, 322, 457, 222, 459, 486,
+	244, 423, 427, 507, 1033, 231, 252, 446, 225, 1068,
+	1051, 997, 961, 967, 890, 0, 223, 962, 963, 964,
+	965, 966, 1029, 923, 935, 915, 1005, 914, 296, 1021,
+	757, 758, 261, 828, 1083, 226, 897, 1082, 364, 371,
+	363, 1085, 1084, 479, 1069, 998, 985, 983, 891, 1067,
+	996, 984, 325, 284, 303, 388, 332, 389, 304, 358,
+	357, 359, 334, 987, 443, 335, 0, 220, 0, 442,
+	1077, 1095, 458, 241, 909, 1044, 473, 191, 396, 242,
+	294, 282, 387, 362, 233, 306, 439, 323, 331, 1025,
+	1092, 375, 408, 248, 490, 438, 277, 907, 1096, 854,
+	840, 843, 846, 990, 991, 844, 847, 848, 856, 826,
+	827, 829, 831, 832, 833, 978, 1071, 892, 836, 1047,
+	849, 850, 851, 852, 1018, 1090, 824, 257, 773, 868,
+	869, 870, 774, 871, 872, 775, 776, 873, 874, 875,
+	876, 777, 877, 878, 879, 857, 858, 859, 860, 861,
+	862, 863, 864, 867, 865, 866, 0, 974, 384, 221,
+	234, 245, 246, 247, 271, 268, 266, 275, 283, 0,
+	0, 309, 318, 0, 333, 352, 345, 381, 348, 0,
+	0, 0, 383, 402, 426, 432, 433, 462, 463, 464,
+	466, 470, 471, 472, 0, 500, 0, 392, 263, 834,
+	212, 227, 327, 1091, 399, 291, 351, 430, 353, 313,
+	262, 505, 356, 398, 509, 1045, 1001, 0, 951, 953,
+	952, 911, 913, 912, 910, 1094, 361, 1063, 880, 887,
+	906, 917, 922, 928, 936, 937, 945, 950, 960, 969,
+	970, 980, 993, 994, 1000, 1024, 1027, 1041, 1046, 1053,
+	1058, 1059, 493, 267, 977, 999, 1030, 228, 238, 251,
+	265, 280, 0, 290, 302, 305, 310, 311, 315, 320,
+	339, 340, 341, 342, 365, 366, 369, 370, 373, 374,
+	378, 379, 380, 385, 386, 394, 197, 403, 414, 416,
+	417, 418, 419, 431, 434, 435, 481, 482, 501, 502,
+	957, 424, 440, 0, 209, 0, 216, 0, 217, 219,
+	944, 215, 1062, 1087, 476, 485, 1006, 1020, 931, 1074,
+	0, 46, 467, 830, 1078, 918, 941, 1088, 947, 949,
+	1014, 893, 989, 372, 938, 894, 1039, 0, 0, 885,
+	734, 886, 919, 274, 733, 1048, 992, 1076, 975, 1007,
+	1017, 273, 259, 982, 981, 1065, 930, 929, 1012, 1061,
+	1075, 0, 0, 185, 499, 203, 838, 329, 0, 841,
+	497, 441, 354, 842, 0, 0, 973, 0, 822, 823,
+	958, 1016, 905, 1003, 1080, 939, 1008, 1081, 97, 0,
+	0, 0, 0, 759, 583, 760, 762, 763, 764, 765,
+	0, 0, 184, 761, 766, 767, 768, 0, 968, 1013,
+	1093, 884, 731, 748, 889, 837, 0, 1066, 926, 927,
+	278, 0, 0, 0, 0, 0, 0, 0, 971, 988,
+	1032, 955, 0, 0, 489, 1019, 1028, 1043, 948, 391,
+	298, 0, 0, 0, 0, 745, 746, 0, 0, 0,
+	0, 855, 0, 0, 747, 0, 899, 743, 780, 781,
+	782, 783, 784, 785, 786, 787, 788, 789, 790, 791,
+	792, 793, 794, 795, 796, 797, 798, 799, 800, 801,
+	802, 803, 804, 805, 806, 807, 808, 809, 810, 811,
+	812, 813, 814, 815, 816, 817, 818, 819, 820, 821,
+	749, 0, 0, 0, 904, 882, 924, 1034, 883, 881,
+	355, 896, 825, 1607, 956, 316, 204, 1070, 954, 853,
+	1022, 900, 1052, 1086, 942, 326, 898, 208, 895, 901,
+	940, 368, 1031, 1037, 835, 211, 328, 1049, 920, 933,
+	756, 0, 407, 1009, 488, 737, 293, 0, 995, 406,
+	330, 480, 1023, 1072, 487, 943, 461, 498, 504, 286,
+	976, 249, 437, 276, 269, 925, 1042, 888, 299, 390,
+	264, 321, 959, 1015, 921, 256, 1026, 1002, 1054, 436,
+	477, 214, 349, 478, 503, 179, 287, 428, 288, 460,
+	279, 250, 393, 229, 319, 0, 0, 270, 314, 0,
+	0, 506, 496, 240, 289, 401, 405, 382, 236, 468,
+	350, 360, 253, 255, 254, 230, 429, 475, 243, 258,
+	1050, 1036, 1056, 916, 902, 908, 903, 932, 1073, 308,
+	300, 1057, 1055, 934, 376, 239, 986, 979, 972, 839,
+	492, 1089, 272, 1038, 420, 213, 447, 494, 193, 422,
+	421, 946, 307, 1040, 194, 183, 400, 195, 317, 218,
+	1060, 508, 235, 324, 469, 736, 292, 367, 1011, 377,
+	210, 395, 344, 346, 343, 347, 297, 188, 196, 1035,
+	397, 425, 474, 237, 444, 186, 189, 198, 413, 199,
+	200, 1079, 337, 281, 285, 301, 312, 224, 1010, 404,
+	445, 495, 1004, 232, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 448, 465, 415, 295,
+	336, 338, 450, 451, 456, 452, 453, 449, 455, 454,
+	411, 412, 322, 457, 222, 459, 486, 244, 423, 427,
+	507, 1033, 231, 252, 446, 225, 1068, 1051, 997, 961,
+	967, 890, 0, 223, 962, 963, 964, 965, 966, 1029,
+	923, 935, 915, 1005, 914, 296, 1021, 757, 758, 261,
+	828, 1083, 226, 897, 1082, 364, 371, 363, 1085, 1084,
+	479, 1069, 998, 985, 983, 891, 1067, 996, 984, 325,
+	284, 303, 388, 332, 389, 304, 358, 357, 359, 334,
+	987, 443, 335, 0, 220, 0, 442, 1077, 1095, 458,
+	241, 909, 1044, 473, 191, 396, 242, 294, 282, 387,
+	362, 233, 306, 439, 323, 331, 1025, 1092, 375, 408,
+	248, 490, 438, 277, 907, 1096, 854, 840, 843, 846,
+	990, 991, 844, 847, 848, 856, 826, 827, 829, 831,
+	832, 833, 978, 1071, 892, 836, 1047, 849, 850, 851,
+	852, 1018, 1090, 824, 257, 773, 868, 869, 870, 774,
+	871, 872, 775, 776, 873, 874, 875, 876, 777, 877,
+	878, 879, 857, 858, 859, 860, 861, 862, 863, 864,
+	867, 865, 866, 0, 974, 384, 221, 234, 245, 246,
+	247, 271, 268, 266, 275, 283, 0, 0, 309, 318,
+	0, 333, 352, 345, 381, 348, 0, 0, 0, 383,
+	402, 426, 432, 433, 462, 463, 464, 466, 470, 471,
+	472, 0, 500, 0, 392, 263, 834, 212, 227, 327,
+	1605, 399, 291, 351, 430, 353, 313, 262, 505, 356,
+	398, 509, 1045, 1001, 0, 951, 953, 952, 911, 913,
+	912, 910, 1094, 361, 1063, 880, 887, 906, 917, 922,
+	928, 936, 937, 945, 950, 960, 969, 970, 980, 993,
+	994, 1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059, 493,
+	267, 977, 999, 1030, 228, 238, 251, 265, 280, 0,
+	290, 302, 305, 310, 311, 315, 320, 339, 340, 341,
+	342, 365, 366, 369, 370, 373, 374, 378, 379, 380,
+	385, 386, 394, 197, 403, 414, 416, 417, 418, 419,
+	431, 434, 435, 481, 482, 501, 502, 957, 424, 440,
+	0, 209, 0, 216, 0, 217, 219, 944, 215, 1062,
+	1087, 476, 485, 1006, 1020, 931, 1074, 0, 0, 467,
+	830, 1078, 918, 941, 1088, 947, 949, 1014, 893, 989,
+	372, 938, 894, 1039, 0, 0, 885, 734, 886, 919,
+	274, 733, 1048, 992, 1076, 975, 1007, 1017, 273, 259,
+	982, 981, 1065, 930, 929, 1012, 1061, 1075, 0, 0,
+	185, 499, 203, 838, 329, 0, 2372, 497, 441, 354,
+	842, 0, 0, 973, 0, 822, 823, 958, 1016, 905,
+	1003, 1080, 939, 1008, 1081, 97, 0, 0, 0, 0,
+	759, 583, 760, 762, 763, 764, 765, 0, 0, 184,
+	761, 766, 767, 768, 0, 968, 1013, 1093, 884, 731,
+	748, 889, 837, 0, 1066, 926, 927, 278, 0, 0,
+	0, 0, 0, 0, 0, 971, 988, 1032, 955, 0,
+	0, 489, 1019, 1028, 1043, 948, 391, 298, 0, 0,
+	0, 0, 745, 746, 727, 0, 0, 0, 855, 0,
+	0, 747, 0, 899, 743, 780, 781, 782, 783, 784,
+	785, 786, 787, 788, 789, 790, 791, 792, 793, 794,
+	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
+	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
+	815, 816, 817, 818, 819, 820, 821, 749, 0, 0,
+	0, 904, 882, 924, 1034, 883, 881, 355, 896, 825,
+	1064, 956, 316, 204, 1070, 954, 853, 1022, 900, 1052,
+	1086, 942, 326, 898, 208, 895, 901, 940, 368, 1031,
+	1037, 835, 211, 328, 1049, 920, 933, 756, 0, 407,
+	1009, 488, 737, 293, 0, 995, 406, 330, 480, 1023,
+	1072, 487, 943, 461, 498, 504, 286, 976, 249, 437,
+	276, 269, 925, 1042, 888, 299, 390, 264, 321, 959,
+	1015, 921, 256, 1026, 1002, 1054, 436, 477, 214, 349,
+	478, 503, 179, 287, 428, 288, 460, 279, 250, 393,
+	229, 319, 0, 0, 270, 314, 0, 0, 506, 496,
+	240, 289, 401, 405, 382, 236, 468, 350, 360, 253,
+	255, 254, 230, 429, 475, 243, 258, 1050, 1036, 1056,
+	916, 902, 908, 903, 932, 1073, 308, 300, 1057, 1055,
+	934, 376, 239, 986, 979, 972, 839, 492, 1089, 272,
+	1038, 420, 213, 447, 494, 193, 422, 421, 946, 307,
+	1040, 194, 183, 400, 195, 317, 218, 1060, 508, 235,
+	324, 469, 736, 292, 367, 1011, 377, 210, 395, 344,
+	346, 343, 347, 297, 188, 196, 1035, 397, 425, 474,
+	237, 444, 186, 189, 198, 413, 199, 200, 1079, 337,
+	281, 285, 301, 312, 224, 1010, 404, 445, 495, 1004,
+	232, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 448, 465, 415, 295, 336, 338, 450,
+	451, 456, 452, 453, 449, 455, 454, 411, 412, 322,
+	457, 222, 459, 486, 244, 423, 427, 507, 1033, 231,
+	252, 446, 225, 1068, 1051, 997, 961, 967, 890, 0,
+	223, 962, 963, 964, 965, 966, 1029, 923, 935, 915,
+	1005, 914, 296, 1021, 757, 758, 261, 828, 1083, 226,
+	897, 1082, 364, 371, 363, 1085, 1084, 479, 1069, 998,
+	985, 983, 891, 1067, 996, 984, 325, 284, 303, 388,
+	332, 389, 304, 358, 357, 359, 334, 987, 443, 335,
+	0, 220, 0, 442, 1077, 1095, 458, 241, 909, 1044,
+	473, 191, 396, 242, 294, 282, 387, 362, 233, 306,
+	439, 323, 331, 1025, 1092, 375, 408, 248, 490, 438,
+	277, 907, 1096, 854, 840, 843, 846, 990, 991, 844,
+	847, 848, 856, 826, 827, 829, 831, 832, 833, 978,
+	1071, 892, 836, 1047, 849, 850, 851, 852, 1018, 1090,
+	824, 257, 773, 868, 869, 870, 774, 871, 872, 775,
+	776, 873, 874, 875, 876, 777, 877, 878, 879, 857,
+	858, 859, 860, 861, 862, 863, 864, 867, 865, 866,
+	0, 974, 384, 221, 234, 245, 246, 247, 271, 268,
+	266, 275, 283, 0, 0, 309, 318, 0, 333, 352,
+	345, 381, 348, 0, 0, 0, 383, 402, 426, 432,
+	433, 462, 463, 464, 466, 470, 471, 472, 0, 500,
+	0, 392, 263, 834, 212, 227, 327, 1091, 399, 291,
+	351, 430, 353, 313, 262, 505, 356, 398, 509, 1045,
+	1001, 0, 951, 953, 952, 911, 913, 912, 910, 1094,
+	361, 1063, 880, 887, 906, 917, 922, 928, 936, 937,
+	945, 950, 960, 969, 970, 980, 993, 994, 1000, 1024,
+	1027, 1041, 1046, 1053, 1058, 1059, 493, 267, 977, 999,
+	1030, 228, 238, 251, 265, 280, 0, 290, 302, 305,
+	310, 311, 315, 320, 339, 340, 341, 342, 365, 366,
+	369, 370, 373, 374, 378, 379, 380, 385, 386, 394,
+	197, 403, 414, 416, 417, 418, 419, 431, 434, 435,
+	481, 482, 501, 502, 957, 424, 440, 0, 209, 0,
+	216, 0, 217, 219, 944, 215, 1062, 1087, 476, 485,
+	1006, 1020, 931, 1074, 0, 0, 467, 830, 1078, 918,
+	941, 1088, 947, 949, 1014, 893, 989, 372, 938, 894,
+	1039, 0, 0, 885, 734, 886, 919, 274, 733, 1048,
+	992, 1076, 975, 1007, 1017, 273, 259, 982, 981, 1065,
+	930, 929, 1012, 1061, 1075, 0, 0, 185, 499, 203,
+	838, 329, 0, 841, 497, 441, 354, 842, 0, 0,
+	973, 0, 822, 823, 958, 1016, 905, 1003, 1080, 939,
+	1008, 1081, 97, 0, 2217, 0, 0, 759, 583, 760,
+	762, 763, 764, 765, 0, 0, 184, 761, 766, 767,
+	768, 0, 968, 1013, 1093, 884, 731, 748, 889, 837,
+	0, 1066, 926, 927, 278, 0, 0, 0, 0, 0,
+	0, 0, 971, 988, 1032, 955, 0, 0, 489, 1019,
+	1028, 1043, 948, 391, 298, 0, 0, 0, 0, 745,
+	746, 0, 0, 0, 0, 855, 0, 0, 747, 0,
+	899, 743, 780, 781, 782, 783, 784, 785, 786, 787,
+	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
+	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
+	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 749, 0, 0, 0, 904, 882,
+	924, 1034, 883, 881, 355, 896, 825, 1064, 956, 316,
+	204, 1070, 954, 853, 1022, 900, 1052, 1086, 942, 326,
+	898, 208, 895, 901, 940, 368, 1031, 1037, 835, 211,
+	328, 1049, 920, 933, 756, 0, 407, 1009, 488, 737,
+	293, 0, 995, 406, 330, 480, 1023, 1072, 487, 943,
+	461, 498, 504, 286, 976, 249, 437, 276, 269, 925,
+	1042, 888, 299, 390, 264, 321, 959, 1015, 921, 256,
+	1026, 1002, 1054, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 1050, 1036, 1056, 916, 902, 908,
+	903, 932, 1073, 308, 300, 1057, 1055, 934, 376, 239,
+	986, 979, 972, 839, 492, 1089, 272, 1038, 420, 213,
+	447, 494, 193, 422, 421, 946, 307, 1040, 194, 183,
+	400, 195, 317, 218, 1060, 508, 235, 324, 469, 736,
+	292, 367, 1011, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 1035, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 1079, 337, 281, 285, 301,
+	312, 224, 1010, 404, 445, 495, 1004, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 1033, 231, 252, 446, 225,
+	1068, 1051, 997, 961, 967, 890, 0, 223, 962, 963,
+	964, 965, 966, 1029, 923, 935, 915, 1005, 914, 296,
+	1021, 757, 758, 261, 828, 1083, 226, 897, 1082, 364,
+	371, 363, 1085, 1084, 479, 1069, 998, 985, 983, 891,
+	1067, 996, 984, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 987, 443, 335, 0, 220, 0,
+	442, 1077, 1095, 458, 241, 909, 1044, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	1025, 1092, 375, 408, 248, 490, 438, 277, 907, 1096,
+	854, 840, 843, 846, 990, 991, 844, 847, 848, 856,
+	826, 827, 829, 831, 832, 833, 978, 1071, 892, 836,
+	1047, 849, 850, 851, 852, 1018, 1090, 824, 257, 773,
+	868, 869, 870, 774, 871, 872, 775, 776, 873, 874,
+	875, 876, 777, 877, 878, 879, 857, 858, 859, 860,
+	861, 862, 863, 864, 867, 865, 866, 0, 974, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	834, 212, 227, 327, 1091, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 1045, 1001, 0, 951,
+	953, 952, 911, 913, 912, 910, 1094, 361, 1063, 880,
+	887, 906, 917, 922, 928, 936, 937, 945, 950, 960,
+	969, 970, 980, 993, 994, 1000, 1024, 1027, 1041, 1046,
+	1053, 1058, 1059, 493, 267, 977, 999, 1030, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 957, 424, 440, 0, 209, 0, 216, 0, 217,
+	219, 944, 215, 1062, 1087, 476, 485, 1006, 1020, 931,
+	1074, 0, 0, 467, 830, 1078, 918, 941, 1088, 947,
+	949, 1014, 893, 989, 372, 938, 894, 1039, 0, 0,
+	885, 734, 886, 919, 274, 733, 1048, 992, 1076, 975,
+	1007, 1017, 273, 259, 982, 981, 1065, 930, 929, 1012,
+	1061, 1075, 0, 0, 185, 499, 203, 838, 329, 0,
+	841, 497, 441, 354, 842, 0, 0, 973, 0, 822,
+	823, 958, 1016, 905, 1003, 1080, 939, 1008, 1081, 97,
+	0, 0, 0, 0, 759, 583, 760, 762, 763, 764,
+	765, 0, 0, 184, 761, 766, 767, 768, 0, 968,
+	1013, 1093, 884, 731, 748, 889, 837, 0, 1066, 926,
+	927, 278, 0, 0, 0, 0, 0, 0, 0, 971,
+	988, 1032, 955, 0, 0, 489, 1019, 1028, 1043, 948,
+	391, 298, 0, 0, 0, 0, 745, 746, 0, 0,
+	0, 0, 855, 0, 0, 747, 0, 899, 743, 780,
+	781, 782, 783, 784, 785, 786, 787, 788, 789, 790,
+	791, 792, 793, 794, 795, 796, 797, 798, 799, 800,
+	801, 802, 803, 804, 805, 806, 807, 808, 809, 810,
+	811, 812, 813, 814, 815, 816, 817, 818, 819, 820,
+	821, 749, 0, 0, 0, 904, 882, 924, 1034, 883,
+	881, 355, 896, 825, 1064, 956, 316, 204, 1070, 954,
+	853, 1022, 900, 1052, 1086, 942, 326, 898, 208, 895,
+	901, 940, 368, 1031, 1037, 835, 211, 328, 1049, 920,
+	933, 756, 0, 407, 1009, 488, 737, 293, 0, 995,
+	406, 330, 480, 1023, 1072, 487, 943, 461, 498, 504,
+	286, 976, 249, 437, 276, 269, 925, 1042, 888, 299,
+	390, 264, 321, 959, 1015, 921, 256, 1026, 1002, 1054,
+	436, 477, 214, 349, 478, 503, 179, 287, 428, 288,
+	460, 279, 250, 393, 229, 319, 0, 0, 270, 314,
+	0, 0, 506, 496, 240, 289, 401, 405, 382, 236,
+	468, 350, 360, 253, 255, 254, 230, 429, 475, 243,
+	258, 1050, 1036, 1056, 916, 902, 908, 903, 932, 1073,
+	308, 300, 1057, 1055, 934, 376, 239, 986, 979, 972,
+	839, 492, 1089, 272, 1038, 420, 213, 447, 494, 193,
+	422, 421, 946, 307, 1040, 194, 183, 400, 195, 317,
+	218, 1060, 508, 235, 324, 469, 736, 292, 367, 1011,
+	377, 210, 395, 344, 346, 343, 347, 297, 188, 196,
+	1035, 397, 425, 474, 237, 444, 186, 189, 198, 413,
+	199, 200, 1079, 337, 281, 285, 301, 312, 224, 1010,
+	404, 445, 495, 1004, 232, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 448, 465, 415,
+	295, 336, 338, 450, 451, 456, 452, 453, 449, 455,
+	454, 411, 412, 322, 457, 222, 459, 486, 244, 423,
+	427, 507, 1033, 231, 252, 446, 225, 1068, 1051, 997,
+	961, 967, 890, 0, 223, 962, 963, 964, 965, 966,
+	1029, 923, 935, 915, 1005, 914, 296, 1021, 757, 758,
+	261, 828, 1083, 226, 897, 1082, 364, 371, 363, 1085,
+	1084, 479, 1069, 998, 985, 983, 891, 1067, 996, 984,
+	325, 284, 303, 388, 332, 389, 304, 358, 357, 359,
+	334, 987, 443, 335, 0, 220, 0, 442, 1077, 1095,
+	458, 241, 909, 1044, 473, 191, 396, 242, 294, 282,
+	387, 362, 233, 306, 439, 323, 331, 1025, 1092, 375,
+	408, 248, 490, 438, 277, 907, 1096, 854, 840, 843,
+	846, 990, 991, 844, 847, 848, 856, 826, 827, 829,
+	831, 832, 833, 978, 1071, 892, 836, 1047, 849, 850,
+	851, 852, 1018, 1090, 824, 257, 773, 868, 869, 870,
+	774, 871, 872, 775, 776, 873, 874, 875, 876, 777,
+	877, 878, 879, 857, 858, 859, 860, 861, 862, 863,
+	864, 867, 865, 866, 0, 974, 384, 221, 234, 245,
+	246, 247, 271, 268, 266, 275, 283, 0, 0, 309,
+	318, 0, 333, 352, 345, 381, 348, 0, 0, 0,
+	383, 402, 426, 432, 433, 462, 463, 464, 466, 470,
+	471, 472, 0, 500, 0, 392, 263, 834, 212, 227,
+	327, 1091, 399, 291, 351, 430, 353, 313, 262, 505,
+	356, 398, 509, 1045, 1001, 0, 951, 953, 952, 911,
+	913, 912, 910, 1094, 361, 1063, 880, 887, 906, 917,
+	922, 928, 936, 937, 945, 950, 960, 969, 970, 980,
+	993, 994, 1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059,
+	493, 267, 977, 999, 1030, 228, 238, 251, 265, 280,
+	0, 290, 302, 305, 310, 311, 315, 320, 339, 340,
+	341, 342, 365, 366, 369, 370, 373, 374, 378, 379,
+	380, 385, 386, 394, 197, 403, 414, 416, 417, 418,
+	419, 431, 434, 435, 481, 482, 501, 502, 957, 424,
+	440, 0, 209, 0, 216, 0, 217, 219, 944, 215,
+	1062, 1087, 476, 485, 1006, 1020, 931, 1074, 0, 0,
+	467, 830, 1078, 918, 941, 1088, 947, 949, 1014, 893,
+	989, 372, 938, 894, 1039, 0, 0, 885, 1138, 886,
+	919, 274, 1520, 1048, 992, 1076, 975, 1007, 1017, 273,
+	259, 982, 981, 1065, 930, 929, 1012, 1061, 1075, 0,
+	0, 185, 499, 203, 838, 329, 0, 841, 497, 441,
+	354, 842, 0, 0, 973, 0, 822, 823, 958, 1016,
+	905, 1003, 1080, 939, 1008, 1081, 97, 0, 0, 0,
+	0, 759, 583, 760, 762, 763, 764, 765, 0, 0,
+	184, 761, 766, 767, 768, 0, 968, 1013, 1093, 884,
+	1155, 748, 889, 837, 0, 1066, 926, 927, 278, 0,
+	0, 0, 0, 0, 0, 0, 971, 988, 1032, 955,
+	0, 0, 489, 1019, 1028, 1043, 948, 391, 298, 0,
+	0, 0, 0, 745, 746, 0, 0, 0, 0, 855,
+	0, 0, 747, 0, 899, 743, 780, 781, 782, 783,
+	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
+	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
+	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
+	814, 815, 816, 817, 818, 819, 820, 821, 749, 0,
+	0, 0, 904, 882, 924, 1034, 883, 881, 355, 896,
+	825, 1064, 956, 316, 204, 1070, 954, 853, 1022, 900,
+	1052, 1086, 942, 326, 898, 208, 895, 901, 940, 368,
+	1031, 1037, 835, 211, 328, 1049, 920, 933, 756, 0,
+	407, 1009, 488, 737, 293, 0, 995, 406, 330, 480,
+	1023, 1072, 487, 943, 461, 498, 504, 286, 976, 249,
+	437, 276, 269, 925, 1042, 888, 299, 390, 264, 321,
+	959, 1015, 921, 256, 1026, 1002, 1054, 436, 477, 214,
+	349, 478, 503, 179, 287, 428, 288, 460, 279, 250,
+	393, 229, 319, 0, 0, 270, 314, 0, 0, 506,
+	496, 240, 289, 401, 405, 382, 236, 468, 350, 360,
+	253, 255, 254, 230, 429, 475, 243, 258, 1050, 1036,
+	1056, 916, 902, 908, 903, 932, 1073, 308, 300, 1057,
+	1055, 934, 376, 239, 986, 979, 972, 839, 492, 1089,
+	272, 1038, 420, 213, 447, 494, 193, 422, 421, 946,
+	307, 1040, 194, 183, 400, 195, 317, 218, 1060, 508,
+	235, 324, 469, 736, 292, 367, 1011, 377, 210, 395,
+	344, 346, 343, 347, 297, 188, 196, 1035, 397, 425,
+	474, 237, 444, 186, 189, 198, 413, 199, 200, 1079,
+	337, 281, 285, 301, 312, 224, 1010, 404, 445, 495,
+	1004, 232, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 448, 465, 415, 295, 336, 338,
+	450, 451, 456, 452, 453, 449, 455, 454, 411, 412,
+	322, 457, 222, 459, 486, 244, 423, 427, 507, 1033,
+	231, 252, 446, 225, 1068, 1051, 997, 961, 967, 890,
+	0, 223, 962, 963, 964, 965, 966, 1029, 923, 935,
+	915, 1005, 914, 296, 1021, 757, 758, 261, 828, 1083,
+	226, 897, 1082, 364, 371, 363, 1085, 1084, 479, 1069,
+	998, 985, 983, 891, 1067, 996, 984, 325, 284, 303,
+	388, 332, 389, 304, 358, 357, 359, 334, 987, 443,
+	335, 0, 220, 0, 442, 1077, 1095, 458, 241, 909,
+	1044, 473, 191, 396, 242, 294, 282, 387, 362, 233,
+	306, 439, 323, 331, 1025, 1092, 375, 408, 248, 490,
+	438, 277, 907, 1096, 854, 840, 843, 846, 990, 991,
+	844, 847, 848, 856, 826, 827, 829, 831, 832, 833,
+	978, 1071, 892, 836, 1047, 849, 850, 851, 852, 1018,
+	1090, 824, 257, 773, 868, 869, 870, 774, 871, 872,
+	775, 776, 873, 874, 875, 876, 777, 877, 878, 879,
+	857, 858, 859, 860, 861, 862, 863, 864, 867, 865,
+	866, 0, 974, 384, 221, 234, 245, 246, 247, 271,
+	268, 266, 275, 283, 0, 0, 309, 318, 0, 333,
+	352, 345, 381, 348, 0, 0, 0, 383, 402, 426,
+	432, 433, 462, 463, 464, 466, 470, 471, 472, 0,
+	500, 0, 392, 263, 834, 212, 227, 327, 1091, 399,
+	291, 351, 430, 353, 313, 262, 505, 356, 398, 509,
+	1045, 1001, 0, 951, 953, 952, 911, 913, 912, 910,
+	1094, 361, 1063, 880, 887, 906, 917, 922, 928, 936,
+	937, 945, 950, 960, 969, 970, 980, 993, 994, 1000,
+	1024, 1027, 1041, 1046, 1053, 1058, 1059, 493, 267, 977,
+	999, 1030, 228, 238, 251, 265, 280, 0, 290, 302,
+	305, 310, 311, 315, 320, 339, 340, 341, 342, 365,
+	366, 369, 370, 373, 374, 378, 379, 380, 385, 386,
+	394, 197, 403, 414, 416, 417, 418, 419, 431, 434,
+	435, 481, 482, 501, 502, 957, 424, 440, 0, 209,
+	0, 216, 0, 217, 219, 944, 215, 1062, 1087, 476,
+	485, 1006, 1020, 931, 1074, 0, 0, 467, 830, 1078,
+	918, 941, 1088, 947, 949, 1014, 893, 989, 372, 938,
+	894, 1039, 0, 0, 885, 1138, 886, 919, 274, 1520,
+	1048, 992, 1076, 975, 1007, 1017, 273, 259, 982, 981,
+	1065, 930, 929, 1012, 1061, 1075, 0, 0, 185, 499,
+	203, 838, 329, 0, 841, 497, 441, 354, 842, 0,
+	0, 973, 0, 822, 823, 958, 1016, 905, 1003, 1080,
+	939, 1008, 1081, 97, 0, 0, 0, 0, 759, 583,
+	760, 762, 763, 764, 765, 0, 0, 184, 761, 766,
+	767, 768, 0, 968, 1013, 1093, 884, 1155, 748, 889,
+	837, 0, 1066, 926, 927, 278, 0, 0, 0, 0,
+	0, 0, 0, 971, 988, 1032, 955, 0, 0, 489,
+	1019, 1028, 1043, 948, 391, 298, 0, 0, 0, 0,
+	745, 746, 0, 0, 0, 0, 855, 0, 0, 747,
+	0, 899, 743, 780, 781, 782, 783, 784, 785, 786,
+	787, 788, 789, 790, 791, 792, 793, 794, 795, 796,
+	797, 798, 799, 800, 801, 802, 803, 804, 805, 806,
+	807, 808, 809, 810, 811, 812, 813, 814, 815, 816,
+	817, 818, 819, 820, 821, 749, 0, 0, 0, 904,
+	882, 924, 1034, 883, 881, 355, 896, 825, 1064, 956,
+	316, 204, 1070, 954, 853, 1022, 900, 1052, 1086, 942,
+	326, 898, 208, 895, 901, 940, 368, 1031, 1037, 835,
+	211, 328, 1049, 920, 933, 756, 0, 407, 1009, 488,
+	737, 293, 0, 4886, 406, 330, 480, 1023, 1072, 487,
+	943, 461, 498, 504, 286, 976, 249, 437, 276, 269,
+	925, 1042, 888, 299, 390, 264, 321, 959, 1015, 921,
+	256, 1026, 1002, 1054, 436, 477, 214, 349, 478, 503,
+	179, 287, 428, 288, 460, 279, 250, 393, 229, 319,
+	0, 0, 270, 314, 0, 0, 506, 496, 240, 289,
+	401, 405, 382, 236, 468, 350, 360, 253, 255, 254,
+	230, 429, 475, 243, 258, 1050, 1036, 1056, 916, 902,
+	908, 903, 932, 1073, 308, 300, 1057, 1055, 934, 376,
+	239, 986, 979, 972, 839, 492, 1089, 272, 1038, 420,
+	213, 447, 494, 193, 422, 421, 946, 307, 1040, 194,
+	183, 400, 195, 317, 218, 1060, 508, 235, 324, 469,
+	736, 292, 367, 1011, 377, 210, 395, 344, 346, 343,
+	347, 297, 188, 196, 1035, 397, 425, 474, 237, 444,
+	186, 189, 198, 413, 199, 200, 1079, 337, 281, 285,
+	301, 312, 224, 1010, 404, 445, 495, 1004, 232, 491,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 448, 465, 415, 295, 336, 338, 450, 451, 456,
+	452, 453, 449, 455, 454, 411, 412, 322, 457, 222,
+	459, 486, 244, 423, 427, 507, 1033, 231, 252, 446,
+	225, 1068, 1051, 997, 961, 967, 890, 0, 223, 962,
+	963, 964, 965, 966, 1029, 923, 935, 915, 1005, 914,
+	296, 1021, 757, 758, 261, 828, 1083, 226, 897, 1082,
+	364, 371, 363, 1085, 1084, 479, 1069, 998, 985, 983,
+	891, 1067, 996, 984, 325, 284, 303, 388, 332, 389,
+	304, 358, 357, 359, 334, 987, 443, 335, 0, 220,
+	0, 442, 1077, 1095, 458, 241, 909, 1044, 473, 191,
+	396, 242, 294, 282, 387, 362, 233, 306, 439, 323,
+	331, 1025, 1092, 375, 408, 248, 490, 438, 277, 907,
+	1096, 854, 840, 843, 846, 990, 991, 844, 847, 848,
+	856, 826, 827, 829, 831, 832, 833, 978, 1071, 892,
+	836, 1047, 849, 850, 851, 852, 1018, 1090, 824, 257,
+	773, 868, 869, 870, 774, 871, 872, 775, 776, 873,
+	874, 875, 876, 777, 877, 878, 879, 857, 858, 859,
+	860, 861, 862, 863, 864, 867, 865, 866, 0, 974,
+	384, 221, 234, 245, 246, 247, 271, 268, 266, 275,
+	283, 0, 0, 309, 318, 0, 333, 352, 345, 381,
+	348, 0, 0, 0, 383, 402, 426, 432, 433, 462,
+	463, 464, 466, 470, 471, 472, 0, 500, 0, 392,
+	263, 834, 212, 227, 327, 1091, 399, 291, 351, 430,
+	353, 313, 262, 505, 356, 398, 509, 1045, 1001, 0,
+	951, 953, 952, 911, 913, 912, 910, 1094, 361, 1063,
+	880, 887, 906, 917, 922, 928, 936, 937, 945, 950,
+	960, 969, 970, 980, 993, 994, 1000, 1024, 1027, 1041,
+	1046, 1053, 1058, 1059, 493, 267, 977, 999, 1030, 228,
+	238, 251, 265, 280, 0, 290, 302, 305, 310, 311,
+	315, 320, 339, 340, 341, 342, 365, 366, 369, 370,
+	373, 374, 378, 379, 380, 385, 386, 394, 197, 403,
+	414, 416, 417, 418, 419, 431, 434, 435, 481, 482,
+	501, 502, 957, 424, 440, 0, 209, 0, 216, 0,
+	217, 219, 944, 215, 1062, 1087, 476, 485, 1006, 1020,
+	931, 1074, 0, 0, 467, 830, 1078, 918, 941, 1088,
+	947, 949, 1014, 893, 989, 372, 938, 894, 1039, 0,
+	0, 885, 734, 886, 919, 274, 733, 1048, 992, 1076,
+	975, 1007, 1017, 273, 259, 982, 981, 1065, 930, 929,
+	1012, 1061, 1075, 0, 0, 185, 499, 203, 838, 329,
+	0, 841, 497, 441, 354, 842, 0, 0, 973, 0,
+	822, 823, 958, 1016, 905, 1003, 1080, 939, 1008, 1081,
+	97, 0, 0, 0, 0, 759, 583, 760, 762, 763,
+	764, 765, 0, 0, 184, 761, 766, 767, 768, 0,
+	968, 1013, 1093, 884, 731, 748, 889, 837, 0, 1066,
+	926, 927, 278, 0, 0, 0, 0, 0, 0, 0,
+	971, 988, 1032, 955, 0, 0, 489, 1019, 1028, 1043,
+	948, 391, 298, 0, 0, 0, 0, 745, 746, 0,
+	0, 0, 0, 855, 0, 0, 747, 0, 899, 743,
+	780, 781, 782, 783, 784, 785, 786, 787, 788, 789,
+	790, 791, 792, 793, 794, 795, 796, 797, 798, 799,
+	800, 801, 802, 803, 804, 805, 806, 807, 808, 809,
+	810, 811, 812, 813, 814, 815, 816, 817, 818, 819,
+	820, 821, 749, 0, 0, 0, 904, 882, 924, 1034,
+	883, 881, 355, 896, 825, 1064, 956, 316, 204, 1070,
+	954, 853, 1022, 900, 1052, 1086, 942, 326, 898, 208,
+	895, 901, 940, 368, 1031, 1037, 835, 211, 328, 1049,
+	920, 933, 756, 0, 407, 1009, 488, 737, 293, 0,
+	995, 406, 330, 480, 1023, 1072, 487, 943, 461, 498,
+	504, 286, 976, 249, 437, 276, 269, 925, 1042, 888,
+	299, 390, 264, 321, 959, 1015, 921, 256, 1026, 1002,
+	1054, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 1050, 1036, 1056, 916, 902, 908, 903, 932,
+	1073, 308, 300, 1057, 1055, 934, 376, 239, 986, 979,
+	972, 839, 492, 1089, 272, 1038, 420, 213, 447, 494,
+	193, 422, 421, 946, 307, 1040, 194, 183, 400, 195,
+	317, 218, 1060, 508, 235, 324, 469, 736, 292, 367,
+	1011, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 1035, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 1079, 337, 281, 285, 301, 312, 224,
+	1010, 404, 445, 495, 1004, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 1033, 231, 252, 446, 225, 1068, 1051,
+	997, 961, 967, 890, 0, 223, 962, 963, 964, 965,
+	966, 1029, 923, 935, 915, 1005, 914, 296, 1021, 757,
+	758, 261, 828, 1083, 226, 897, 1082, 364, 371, 363,
+	1085, 1084, 479, 1069, 998, 985, 983, 891, 1067, 996,
+	984, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 987, 443, 335, 0, 220, 0, 442, 1077,
+	1095, 458, 241, 909, 1044, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 1025, 1092,
+	375, 408, 248, 490, 438, 277, 907, 1096, 854, 840,
+	843, 846, 990, 991, 844, 847, 848, 856, 826, 827,
+	829, 831, 832, 833, 978, 1071, 892, 836, 1047, 849,
+	850, 851, 852, 1018, 1090, 4397, 257, 773, 868, 869,
+	870, 774, 871, 872, 775, 776, 873, 874, 875, 876,
+	777, 877, 878, 879, 857, 858, 859, 860, 861, 862,
+	863, 864, 867, 865, 866, 0, 974, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 834, 212,
+	227, 327, 1091, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 1045, 1001, 0, 951, 953, 952,
+	911, 913, 912, 910, 1094, 361, 1063, 880, 887, 4396,
+	917, 922, 928, 936, 937, 945, 950, 960, 969, 970,
+	980, 993, 994, 1000, 1024, 1027, 1041, 1046, 1053, 1058,
+	1059, 493, 267, 977, 999, 1030, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 957,
+	424, 440, 0, 209, 0, 216, 0, 217, 219, 944,
+	215, 1062, 1087, 476, 485, 1006, 1020, 931, 1074, 0,
+	0, 467, 830, 1078, 918, 941, 1088, 947, 949, 1014,
+	893, 989, 372, 938, 894, 1039, 0, 0, 885, 1138,
+	886, 919, 274, 1520, 1048, 992, 1076, 975, 1007, 1017,
+	273, 259, 982, 981, 1065, 930, 929, 1012, 1061, 1075,
+	0, 0, 185, 499, 203, 838, 329, 0, 841, 497,
+	441, 354, 842, 0, 0, 973, 0, 822, 823, 958,
+	1016, 905, 1003, 1080, 939, 1008, 1081, 97, 0, 0,
+	0, 0, 759, 583, 760, 762, 763, 764, 765, 0,
+	0, 184, 761, 766, 767, 768, 0, 968, 1013, 1093,
+	884, 1155, 748, 889, 837, 0, 1066, 926, 927, 278,
+	0, 0, 0, 0, 0, 0, 0, 971, 988, 1032,
+	955, 0, 0, 489, 1019, 1028, 1043, 948, 391, 298,
+	0, 0, 0, 0, 745, 746, 0, 0, 0, 0,
+	855, 0, 0, 747, 0, 899, 743, 780, 781, 782,
+	783, 784, 785, 786, 787, 788, 789, 790, 791, 792,
+	793, 794, 795, 796, 797, 798, 799, 800, 801, 802,
+	803, 804, 805, 806, 807, 808, 809, 810, 811, 812,
+	813, 814, 815, 816, 817, 818, 819, 820, 821, 749,
+	0, 0, 0, 904, 882, 924, 1034, 883, 881, 355,
+	896, 825, 1064, 956, 316, 204, 1070, 954, 853, 1022,
+	900, 1052, 1086, 942, 326, 898, 208, 895, 901, 940,
+	368, 1031, 1037, 835, 211, 328, 1049, 920, 933, 756,
+	0, 407, 1009, 488, 737, 293, 0, 995, 406, 330,
+	480, 1023, 1072, 487, 943, 461, 498, 504, 286, 976,
+	249, 437, 276, 269, 925, 1042, 888, 299, 390, 264,
+	321, 959, 1015, 921, 256, 1026, 1002, 1054, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 1050,
+	1036, 1056, 916, 902, 908, 903, 932, 1073, 308, 300,
+	1057, 1055, 934, 376, 239, 986, 979, 972, 839, 492,
+	1089, 272, 1038, 420, 213, 447, 494, 193, 422, 421,
+	946, 307, 1040, 194, 183, 400, 195, 317, 218, 1060,
+	508, 235, 324, 469, 736, 292, 367, 1011, 377, 210,
+	395, 344, 346, 343, 347, 297, 188, 196, 1035, 397,
+	425, 474, 237, 444, 186, 189, 198, 413, 199, 200,
+	1079, 337, 281, 285, 301, 312, 224, 1010, 404, 445,
+	495, 1004, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	1033, 231, 252, 446, 225, 1068, 1051, 997, 961, 967,
+	890, 0, 223, 962, 963, 964, 965, 966, 1029, 923,
+	935, 915, 1005, 914, 296, 1021, 757, 758, 261, 828,
+	1083, 226, 897, 1082, 364, 371, 363, 1085, 1084, 479,
+	1069, 998, 985, 983, 891, 1067, 996, 984, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 987,
+	443, 335, 0, 220, 0, 442, 1077, 1095, 458, 241,
+	909, 1044, 473, 191, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 1025, 1092, 375, 408, 248,
+	490, 438, 277, 907, 1096, 854, 840, 843, 846, 990,
+	991, 844, 847, 848, 856, 826, 827, 829, 831, 832,
+	833, 2471, 2472, 2473, 836, 1047, 849, 850, 851, 852,
+	1018, 1090, 824, 257, 773, 868, 869, 870, 774, 871,
+	872, 775, 776, 873, 874, 875, 876, 777, 877, 878,
+	879, 857, 858, 859, 860, 861, 862, 863, 864, 867,
+	865, 866, 0, 974, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 834, 212, 227, 327, 1091,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 1045, 1001, 0, 951, 953, 952, 911, 913, 912,
+	910, 1094, 361, 1063, 880, 887, 906, 917, 922, 928,
+	936, 937, 945, 950, 960, 969, 970, 980, 993, 994,
+	1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059, 493, 267,
+	977, 999, 1030, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 197, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 957, 424, 440, 0,
+	209, 0, 216, 0, 217, 219, 944, 215, 1062, 1087,
+	476, 485, 1006, 1020, 1929, 2109, 0, 3980, 467, 1963,
+	2113, 1912, 1942, 2131, 1948, 1951, 2032, 1878, 2001, 372,
+	1939, 1879, 2061, 1862, 1917, 1866, 1930, 1867, 1914, 274,
+	1910, 2074, 2004, 2111, 1983, 2025, 2035, 273, 259, 1993,
+	1992, 2099, 1928, 1927, 2030, 2088, 2110, 1982, 0, 185,
+	499, 203, 3981, 329, 2085, 520, 3982, 441, 354, 0,
+	523, 522, 1978, 2094, 1999, 2063, 1961, 2034, 1894, 2017,
+	2115, 1940, 2026, 2116, 97, 0, 723, 0, 0, 0,
+	1201, 0, 0, 0, 0, 0, 0, 0, 184, 0,
+	2022, 2107, 1933, 521, 1973, 2031, 2136, 1865, 2018, 0,
+	1870, 1881, 2130, 2100, 1924, 1925, 278, 0, 0, 0,
+	0, 0, 0, 0, 1976, 2000, 2052, 1958, 0, 0,
+	489, 2037, 2047, 2066, 1950, 391, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 1919, 0, 2015, 0, 0,
+	0, 0, 1886, 1872, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1972, 0, 0, 0,
+	1893, 1863, 1921, 2054, 1864, 1861, 355, 1882, 2068, 3984,
+	1959, 316, 204, 2104, 1957, 1956, 2041, 1887, 2078, 2128,
+	1943, 326, 1885, 208, 1880, 1888, 1941, 368, 2051, 2059,
+	190, 211, 328, 2075, 1915, 1932, 260, 2297, 407, 2027,
+	488, 519, 293, 0, 2008, 406, 330, 480, 2042, 2106,
+	487, 1944, 461, 498, 504, 286, 1984, 249, 437, 276,
+	269, 1923, 2065, 1869, 299, 390, 264, 321, 1962, 2033,
+	1916, 256, 2045, 2016, 2080, 436, 477, 214, 349, 478,
+	503, 179, 287, 428, 288, 460, 279, 250, 393, 229,
+	319, 0, 0, 270, 314, 0, 0, 506, 496, 240,
+	289, 401, 405, 382, 236, 468, 350, 360, 253, 255,
+	254, 230, 429, 475, 243, 258, 2076, 2058, 2082, 1909,
+	1889, 1900, 1890, 1931, 2108, 308, 300, 2083, 2081, 1934,
+	376, 239, 1997, 1990, 1977, 2055, 492, 2132, 272, 2060,
+	420, 213, 447, 494, 193, 422, 421, 1947, 307, 2062,
+	194, 183, 400, 195, 317, 218, 2087, 508, 235, 324,
+	469, 518, 292, 367, 2029, 377, 210, 395, 344, 346,
+	343, 347, 297, 188, 196, 2057, 397, 425, 474, 237,
+	444, 186, 189, 198, 413, 199, 200, 2114, 337, 281,
+	285, 301, 312, 224, 2028, 404, 445, 495, 2019, 232,
+	491, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 448, 465, 415, 295, 336, 338, 450, 451,
+	456, 452, 453, 449, 455, 454, 411, 412, 322, 457,
+	222, 459, 486, 244, 423, 427, 507, 2053, 231, 252,
+	446, 225, 2102, 2077, 2010, 1965, 1971, 1871, 0, 223,
+	1966, 1967, 1968, 1969, 1970, 2049, 1920, 1936, 1908, 2023,
+	1907, 296, 2040, 483, 484, 261, 1883, 2122, 226, 1884,
+	2121, 364, 371, 363, 2125, 2123, 479, 2103, 2011, 1996,
+	1994, 1876, 2101, 2009, 1995, 325, 284, 303, 388, 332,
+	389, 304, 358, 357, 359, 334, 1998, 443, 335, 0,
+	220, 0, 442, 2112, 2138, 458, 241, 1902, 2069, 473,
+	191, 396, 242, 294, 282, 387, 362, 233, 306, 439,
+	323, 331, 2044, 2135, 375, 408, 248, 490, 438, 277,
+	1898, 0, 1901, 1896, 1899, 1897, 2002, 2003, 2117, 2118,
+	2119, 2056, 1891, 205, 206, 2095, 2096, 207, 1989, 2105,
+	1877, 0, 2073, 201, 202, 187, 192, 2036, 2133, 1949,
+	257, 177, 1873, 1874, 1875, 178, 1979, 1980, 180, 181,
+	2091, 2090, 2089, 2092, 182, 2126, 2124, 2127, 1892, 1913,
+	1935, 1985, 1986, 1988, 2020, 2021, 2067, 2039, 2048, 1922,
+	1981, 384, 221, 234, 245, 246, 247, 271, 268, 266,
+	275, 283, 0, 0, 309, 318, 0, 333, 352, 345,
+	381, 348, 0, 0, 0, 383, 402, 426, 432, 433,
+	462, 463, 464, 466, 470, 471, 472, 0, 500, 0,
+	392, 263, 2007, 212, 227, 327, 3983, 399, 291, 351,
+	430, 353, 313, 262, 505, 356, 398, 509, 2070, 2014,
+	0, 1953, 1955, 1954, 1904, 1906, 1905, 1903, 2137, 361,
+	2097, 1860, 1868, 1895, 1911, 1918, 1926, 1937, 1938, 1946,
+	1952, 1964, 1974, 1975, 1991, 2005, 2006, 2013, 2043, 2046,
+	2064, 2072, 2079, 2084, 2086, 493, 267, 1987, 2012, 2050,
+	228, 238, 251, 265, 280, 0, 290, 302, 305, 310,
+	311, 315, 320, 339, 340, 341, 342, 365, 366, 369,
+	370, 373, 374, 378, 379, 380, 385, 386, 394, 197,
+	403, 414, 416, 417, 418, 419, 431, 434, 435, 481,
+	482, 501, 502, 1960, 424, 440, 0, 209, 0, 216,
+	0, 217, 219, 1945, 215, 2093, 2129, 476, 485, 2024,
+	2038, 1929, 2109, 0, 2071, 467, 1963, 2113, 1912, 1942,
+	2131, 1948, 1951, 2032, 1878, 2001, 372, 1939, 1879, 2061,
+	1862, 1917, 1866, 1930, 1867, 1914, 274, 1910, 2074, 2004,
+	2111, 1983, 2025, 2035, 273, 259, 1993, 1992, 2099, 1928,
+	1927, 2030, 2088, 2110, 1982, 0, 185, 499, 203, 2120,
+	329, 2085, 520, 497, 441, 354, 0, 523, 522, 1978,
+	2094, 1999, 2063, 1961, 2034, 1894, 2017, 2115, 1940, 2026,
+	2116, 0, 0, 0, 0, 0, 0, 583, 0, 2397,
+	0, 2398, 0, 0, 0, 184, 0, 2022, 2107, 1933,
+	521, 1973, 2031, 2136, 1865, 2018, 0, 1870, 1881, 2130,
+	2100, 1924, 1925, 278, 0, 0, 0, 0, 0, 0,
+	0, 1976, 2000, 2052, 1958, 0, 0, 489, 2037, 2047,
+	2066, 1950, 391, 298, 0, 0, 0, 0, 0, 0,
+	3394, 0, 1919, 0, 2015, 0, 0, 0, 0, 1886,
+	1872, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1972, 0, 0, 0, 1893, 1863, 1921,
+	2054, 1864, 1861, 355, 1882, 2068, 2098, 1959, 316, 204,
+	2104, 1957, 1956, 2041, 1887, 2078, 2128, 1943, 326, 1885,
+	208, 1880, 1888, 1941, 368, 2051, 2059, 190, 211, 328,
+	2075, 1915, 1932, 260, 0, 407, 2027, 488, 2394, 293,
+	0, 2008, 406, 330, 480, 2042, 2106, 487, 1944, 461,
+	498, 504, 286, 1984, 249, 437, 276, 269, 1923, 2065,
+	1869, 299, 390, 264, 321, 1962, 2033, 1916, 256, 2045,
+	2016, 2080, 436, 477, 214, 349, 478, 503, 179, 287,
+	428, 288, 460, 279, 250, 393, 229, 319, 0, 0,
+	270, 314, 0, 0, 506, 496, 240, 289, 401, 405,
+	382, 236, 468, 350, 360, 253, 255, 254, 230, 429,
+	475, 243, 258, 2076, 2058, 2082, 1909, 1889, 1900, 1890,
+	1931, 2108, 308, 300, 2083, 2081, 1934, 376, 239, 1997,
+	1990, 1977, 2055, 492, 2132, 272, 2060, 420, 213, 447,
+	494, 193, 422, 421, 1947, 307, 2062, 194, 183, 400,
+	195, 317, 218, 2087, 508, 235, 324, 469, 2393, 292,
+	367, 2029, 377, 210, 395, 344, 346, 343, 347, 297,
+	188, 196, 2057, 397, 425, 474, 237, 444, 186, 189,
+	198, 413, 199, 200, 2114, 337, 281, 285, 301, 312,
+	224, 2028, 404, 445, 495, 2019, 232, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 448,
+	465, 415, 295, 336, 338, 450, 451, 456, 452, 453,
+	449, 455, 454, 411, 412, 322, 457, 222, 459, 486,
+	244, 423, 427, 507, 2053, 231, 252, 446, 225, 2102,
+	2077, 2010, 1965, 1971, 1871, 0, 223, 1966, 1967, 1968,
+	1969, 1970, 2049, 1920, 1936, 1908, 2023, 1907, 296, 2040,
+	483, 484, 261, 1883, 2122, 226, 1884, 2121, 364, 371,
+	363, 2125, 2123, 479, 2103, 2011, 1996, 1994, 1876, 2101,
+	2009, 1995, 325, 284, 303, 388, 332, 389, 304, 358,
+	357, 359, 334, 1998, 443, 335, 0, 220, 0, 442,
+	2112, 2138, 458, 241, 1902, 2069, 473, 191, 396, 242,
+	294, 282, 387, 362, 233, 306, 439, 323, 331, 2044,
+	2135, 375, 408, 248, 490, 438, 277, 1898, 0, 1901,
+	1896, 1899, 1897, 2002, 2003, 2117, 2118, 2119, 2056, 1891,
+	205, 206, 2095, 2096, 207, 1989, 2105, 1877, 0, 2073,
+	201, 202, 187, 192, 2036, 2133, 1949, 257, 177, 1873,
+	1874, 1875, 178, 1979, 1980, 180, 181, 2091, 2090, 2089,
+	2092, 182, 2126, 2124, 2127, 1892, 1913, 1935, 1985, 1986,
+	1988, 2020, 2021, 2067, 2039, 2048, 1922, 1981, 384, 221,
+	234, 245, 246, 247, 271, 268, 266, 275, 283, 0,
+	0, 309, 318, 0, 333, 352, 345, 381, 348, 0,
+	0, 0, 383, 402, 426, 432, 433, 462, 463, 464,
+	466, 470, 471, 472, 0, 500, 0, 392, 263, 2007,
+	212, 227, 327, 2134, 399, 291, 351, 430, 353, 313,
+	262, 505, 356, 398, 509, 2070, 2014, 0, 1953, 1955,
+	1954, 1904, 1906, 1905, 1903, 2137, 361, 2097, 1860, 1868,
+	1895, 1911, 1918, 1926, 1937, 1938, 1946, 1952, 1964, 1974,
+	1975, 1991, 2005, 2006, 2013, 2043, 2046, 2064, 2072, 2079,
+	2084, 2086, 493, 267, 1987, 2012, 2050, 228, 238, 251,
+	265, 280, 0, 290, 302, 305, 310, 311, 315, 320,
+	339, 340, 341, 342, 365, 366, 369, 370, 373, 374,
+	378, 379, 380, 385, 386, 394, 197, 403, 414, 416,
+	417, 418, 419, 431, 434, 435, 481, 482, 501, 502,
+	1960, 424, 440, 0, 209, 0, 216, 0, 217, 219,
+	1945, 215, 2093, 2129, 476, 485, 2024, 2038, 1929, 2109,
+	0, 2071, 467, 1963, 2113, 1912, 1942, 2131, 1948, 1951,
+	2032, 1878, 2001, 372, 1939, 1879, 2061, 1862, 1917, 1866,
+	1930, 1867, 1914, 274, 1910, 2074, 2004, 2111, 1983, 2025,
+	2035, 273, 259, 1993, 1992, 2099, 1928, 1927, 2030, 2088,
+	2110, 1982, 0, 185, 499, 203, 2120, 329, 2085, 520,
+	497, 441, 354, 0, 523, 522, 1978, 2094, 1999, 2063,
+	1961, 2034, 1894, 2017, 2115, 1940, 2026, 2116, 0, 0,
+	0, 0, 0, 0, 583, 0, 2397, 0, 2398, 0,
+	0, 0, 184, 0, 2022, 2107, 1933, 521, 1973, 2031,
+	2136, 1865, 2018, 0, 1870, 1881, 2130, 2100, 1924, 1925,
+	278, 0, 0, 0, 0, 0, 0, 0, 1976, 2000,
+	2052, 1958, 0, 0, 489, 2037, 2047, 2066, 1950, 391,
+	298, 0, 0, 0, 0, 0, 0, 2387, 0, 1919,
+	0, 2015, 0, 0, 0, 0, 1886, 1872, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1972, 0, 0, 0, 1893, 1863, 1921, 2054, 1864, 1861,
+	355, 1882, 2068, 2098, 1959, 316, 204, 2104, 1957, 1956,
+	2041, 1887, 2078, 2128, 1943, 326, 1885, 208, 1880, 1888,
+	1941, 368, 2051, 2059, 190, 211, 328, 2075, 1915, 1932,
+	260, 0, 407, 2027, 488, 2394, 293, 0, 2008, 406,
+	330, 480, 2042, 2106, 487, 1944, 461, 498, 504, 286,
+	1984, 249, 437, 276, 269, 1923, 2065, 1869, 299, 390,
+	264, 321, 1962, 2033, 1916, 256, 2045, 2016, 2080, 436,
+	477, 214, 349, 478, 503, 179, 287, 428, 288, 460,
+	279, 250, 393, 229, 319, 0, 0, 270, 314, 0,
+	0, 506, 496, 240, 289, 401, 405, 382, 236, 468,
+	350, 360, 253, 255, 254, 230, 429, 475, 243, 258,
+	2076, 2058, 2082, 1909, 1889, 1900, 1890, 1931, 2108, 308,
+	300, 2083, 2081, 1934, 376, 239, 1997, 1990, 1977, 2055,
+	492, 2132, 272, 2060, 420, 213, 447, 494, 193, 422,
+	421, 1947, 307, 2062, 194, 183, 400, 195, 317, 218,
+	2087, 508, 235, 324, 469, 2393, 292, 367, 2029, 377,
+	210, 395, 344, 346, 343, 347, 297, 188, 196, 2057,
+	397, 425, 474, 237, 444, 186, 189, 198, 413, 199,
+	200, 2114, 337, 281, 285, 301, 312, 224, 2028, 404,
+	445, 495, 2019, 232, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 448, 465, 415, 295,
+	336, 338, 450, 451, 456, 452, 453, 449, 455, 454,
+	411, 412, 322, 457, 222, 459, 486, 244, 423, 427,
+	507, 2053, 231, 252, 446, 225, 2102, 2077, 2010, 1965,
+	1971, 1871, 0, 223, 1966, 1967, 1968, 1969, 1970, 2049,
+	1920, 1936, 1908, 2023, 1907, 296, 2040, 483, 484, 261,
+	1883, 2122, 226, 1884, 2121, 364, 371, 363, 2125, 2123,
+	479, 2103, 2011, 1996, 1994, 1876, 2101, 2009, 1995, 325,
+	284, 303, 388, 332, 389, 304, 358, 357, 359, 334,
+	1998, 443, 335, 0, 220, 0, 442, 2112, 2138, 458,
+	241, 1902, 2069, 473, 191, 396, 242, 294, 282, 387,
+	362, 233, 306, 439, 323, 331, 2044, 2135, 375, 408,
+	248, 490, 438, 277, 1898, 0, 1901, 1896, 1899, 1897,
+	2002, 2003, 2117, 2118, 2119, 2056, 1891, 205, 206, 2095,
+	2096, 207, 1989, 2105, 1877, 0, 2073, 201, 202, 187,
+	192, 2036, 2133, 1949, 257, 177, 1873, 1874, 1875, 178,
+	1979, 1980, 180, 181, 2091, 2090, 2089, 2092, 182, 2126,
+	2124, 2127, 1892, 1913, 1935, 1985, 1986, 1988, 2020, 2021,
+	2067, 2039, 2048, 1922, 1981, 384, 221, 234, 245, 246,
+	247, 271, 268, 266, 275, 283, 0, 0, 309, 318,
+	0, 333, 352, 345, 381, 348, 0, 0, 0, 383,
+	402, 426, 432, 433, 462, 463, 464, 466, 470, 471,
+	472, 0, 500, 0, 392, 263, 2007, 212, 227, 327,
+	2134, 399, 291, 351, 430, 353, 313, 262, 505, 356,
+	398, 509, 2070, 2014, 0, 1953, 1955, 1954, 1904, 1906,
+	1905, 1903, 2137, 361, 2097, 1860, 1868, 1895, 1911, 1918,
+	1926, 1937, 1938, 1946, 1952, 1964, 1974, 1975, 1991, 2005,
+	2006, 2013, 2043, 2046, 2064, 2072, 2079, 2084, 2086, 493,
+	267, 1987, 2012, 2050, 228, 238, 251, 265, 280, 0,
+	290, 302, 305, 310, 311, 315, 320, 339, 340, 341,
+	342, 365, 366, 369, 370, 373, 374, 378, 379, 380,
+	385, 386, 394, 197, 403, 414, 416, 417, 418, 419,
+	431, 434, 435, 481, 482, 501, 502, 1960, 424, 440,
+	0, 209, 0, 216, 0, 217, 219, 1945, 215, 2093,
+	2129, 476, 485, 2024, 2038, 1929, 2109, 0, 2071, 467,
+	1963, 2113, 1912, 1942, 2131, 1948, 1951, 2032, 1878, 2001,
+	372, 1939, 1879, 2061, 1862, 1917, 1866, 1930, 1867, 1914,
+	274, 1910, 2074, 2004, 2111, 1983, 2025, 2035, 273, 259,
+	1993, 1992, 2099, 1928, 1927, 2030, 2088, 2110, 1982, 0,
+	185, 499, 203, 2120, 329, 2085, 520, 497, 441, 354,
+	0, 523, 522, 1978, 2094, 1999, 2063, 1961, 2034, 1894,
+	2017, 2115, 1940, 2026, 2116, 0, 0, 0, 0, 0,
+	0, 583, 0, 2397, 0, 2398, 0, 0, 0, 184,
+	0, 2022, 2107, 1933, 521, 1973, 2031, 2136, 1865, 2018,
+	0, 1870, 1881, 2130, 2100, 1924, 1925, 278, 0, 0,
+	0, 0, 0, 0, 0, 1976, 2000, 2052, 1958, 0,
+	0, 489, 2037, 2047, 2066, 1950, 391, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 1919, 0, 2015, 0,
+	0, 0, 0, 1886, 1872, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1972, 0, 0,
+	0, 1893, 1863, 1921, 2054, 1864, 1861, 355, 1882, 2068,
+	2098, 1959, 316, 204, 2104, 1957, 1956, 2041, 1887, 2078,
+	2128, 1943, 326, 1885, 208, 1880, 1888, 1941, 368, 2051,
+	2059, 190, 211, 328, 2075, 1915, 1932, 260, 0, 407,
+	2027, 488, 2394, 293, 0, 2008, 406, 330, 480, 2042,
+	2106, 487, 1944, 461, 498, 504, 286, 1984, 249, 437,
+	276, 269, 1923, 2065, 1869, 299, 390, 264, 321, 1962,
+	2033, 1916, 256, 2045, 2016, 2080, 436, 477, 214, 349,
+	478, 503, 179, 287, 428, 288, 460, 279, 250, 393,
+	229, 319, 0, 0, 270, 314, 0, 0, 506, 496,
+	240, 289, 401, 405, 382, 236, 468, 350, 360, 253,
+	255, 254, 230, 429, 475, 243, 258, 2076, 2058, 2082,
+	1909, 1889, 1900, 1890, 1931, 2108, 308, 300, 2083, 2081,
+	1934, 376, 239, 1997, 1990, 1977, 2055, 492, 2132, 272,
+	2060, 420, 213, 447, 494, 193, 422, 421, 1947, 307,
+	2062, 194, 183, 400, 195, 317, 218, 2087, 508, 235,
+	324, 469, 2393, 292, 367, 2029, 377, 210, 395, 344,
+	346, 343, 347, 297, 188, 196, 2057, 397, 425, 474,
+	237, 444, 186, 189, 198, 413, 199, 200, 2114, 337,
+	281, 285, 301, 312, 224, 2028, 404, 445, 495, 2019,
+	232, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 448, 465, 415, 295, 336, 338, 450,
+	451, 456, 452, 453, 449, 455, 454, 411, 412, 322,
+	457, 222, 459, 486, 244, 423, 427, 507, 2053, 231,
+	252, 446, 225, 2102, 2077, 2010, 1965, 1971, 1871, 0,
+	223, 1966, 1967, 1968, 1969, 1970, 2049, 1920, 1936, 1908,
+	2023, 1907, 296, 2040, 483, 484, 261, 1883, 2122, 226,
+	1884, 2121, 364, 371, 363, 2125, 2123, 479, 2103, 2011,
+	1996, 1994, 1876, 2101, 2009, 1995, 325, 284, 303, 388,
+	332, 389, 304, 358, 357, 359, 334, 1998, 443, 335,
+	0, 220, 0, 442, 2112, 2138, 458, 241, 1902, 2069,
+	473, 191, 396, 242, 294, 282, 387, 362, 233, 306,
+	439, 323, 331, 2044, 2135, 375, 408, 248, 490, 438,
+	277, 1898, 0, 1901, 1896, 1899, 1897, 2002, 2003, 2117,
+	2118, 2119, 2056, 1891, 205, 206, 2095, 2096, 207, 1989,
+	2105, 1877, 0, 2073, 201, 202, 187, 192, 2036, 2133,
+	1949, 257, 177, 1873, 1874, 1875, 178, 1979, 1980, 180,
+	181, 2091, 2090, 2089, 2092, 182, 2126, 2124, 2127, 1892,
+	1913, 1935, 1985, 1986, 1988, 2020, 2021, 2067, 2039, 2048,
+	1922, 1981, 384, 221, 234, 245, 246, 247, 271, 268,
+	266, 275, 283, 0, 0, 309, 318, 0, 333, 352,
+	345, 381, 348, 0, 0, 0, 383, 402, 426, 432,
+	433, 462, 463, 464, 466, 470, 471, 472, 0, 500,
+	0, 392, 263, 2007, 212, 227, 327, 2134, 399, 291,
+	351, 430, 353, 313, 262, 505, 356, 398, 509, 2070,
+	2014, 0, 1953, 1955, 1954, 1904, 1906, 1905, 1903, 2137,
+	361, 2097, 1860, 1868, 1895, 1911, 1918, 1926, 1937, 1938,
+	1946, 1952, 1964, 1974, 1975, 1991, 2005, 2006, 2013, 2043,
+	2046, 2064, 2072, 2079, 2084, 2086, 493, 267, 1987, 2012,
+	2050, 228, 238, 251, 265, 280, 0, 290, 302, 305,
+	310, 311, 315, 320, 339, 340, 341, 342, 365, 366,
+	369, 370, 373, 374, 378, 379, 380, 385, 386, 394,
+	197, 403, 414, 416, 417, 418, 419, 431, 434, 435,
+	481, 482, 501, 502, 1960, 424, 440, 0, 209, 0,
+	216, 0, 217, 219, 1945, 215, 2093, 2129, 476, 485,
+	2024, 2038, 1929, 2109, 0, 2071, 467, 1963, 2113, 1912,
+	1942, 2131, 1948, 1951, 2032, 1878, 2001, 372, 1939, 1879,
+	2061, 1862, 1917, 1866, 1930, 1867, 1914, 274, 1910, 2074,
+	2004, 2111, 1983, 2025, 2035, 273, 259, 1993, 1992, 2099,
+	1928, 1927, 2030, 2088, 2110, 1982, 0, 185, 499, 203,
+	2120, 329, 2085, 520, 497, 441, 354, 0, 523, 522,
+	1978, 2094, 1999, 2063, 1961, 2034, 1894, 2017, 2115, 1940,
+	2026, 2116, 0, 0, 0, 0, 0, 0, 1201, 0,
+	0, 0, 0, 0, 0, 0, 184, 0, 2022, 2107,
+	1933, 521, 1973, 2031, 2136, 1865, 2018, 0, 1870, 1881,
+	2130, 2100, 1924, 1925, 278, 0, 0, 0, 0, 0,
+	0, 0, 1976, 2000, 2052, 1958, 0, 0, 489, 2037,
+	2047, 2066, 1950, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 1919, 0, 2015, 0, 0, 0, 0,
+	1886, 1872, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1972, 0, 0, 0, 1893, 1863,
+	1921, 2054, 1864, 1861, 355, 1882, 2068, 2098, 1959, 316,
+	204, 2104, 1957, 1956, 2041, 1887, 2078, 2128, 1943, 326,
+	1885, 208, 1880, 1888, 1941, 368, 2051, 2059, 190, 211,
+	328, 2075, 1915, 1932, 260, 2297, 407, 2027, 488, 519,
+	293, 0, 2008, 406, 330, 480, 2042, 2106, 487, 1944,
+	461, 498, 504, 286, 1984, 249, 437, 276, 269, 1923,
+	2065, 1869, 299, 390, 264, 321, 1962, 2033, 1916, 256,
+	2045, 2016, 2080, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 2076, 2058, 2082, 1909, 1889, 1900,
+	1890, 1931, 2108, 308, 300, 2083, 2081, 1934, 376, 239,
+	1997, 1990, 1977, 2055, 492, 2132, 272, 2060, 420, 213,
+	447, 494, 193, 422, 421, 1947, 307, 2062, 194, 183,
+	400, 195, 317, 218, 2087, 508, 235, 324, 469, 518,
+	292, 367, 2029, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 2057, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 2114, 337, 281, 285, 301,
+	312, 224, 2028, 404, 445, 495, 2019, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 2053, 231, 252, 446, 225,
+	2102, 2077, 2010, 1965, 1971, 1871, 0, 223, 1966, 1967,
+	1968, 1969, 1970, 2049, 1920, 1936, 1908, 2023, 1907, 296,
+	2040, 483, 484, 261, 1883, 2122, 226, 1884, 2121, 364,
+	371, 363, 2125, 2123, 479, 2103, 2011, 1996, 1994, 1876,
+	2101, 2009, 1995, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 1998, 443, 335, 0, 220, 0,
+	442, 2112, 2138, 458, 241, 1902, 2069, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	2044, 2135, 375, 408, 248, 490, 438, 277, 1898, 0,
+	1901, 1896, 1899, 1897, 2002, 2003, 2117, 2118, 2119, 2056,
+	1891, 205, 206, 2095, 2096, 207, 1989, 2105, 1877, 0,
+	2073, 201, 202, 187, 192, 2036, 2133, 1949, 257, 177,
+	1873, 1874, 1875, 178, 1979, 1980, 180, 181, 2091, 2090,
+	2089, 2092, 182, 2126, 2124, 2127, 1892, 1913, 1935, 1985,
+	1986, 1988, 2020, 2021, 2067, 2039, 2048, 1922, 1981, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	2007, 212, 227, 327, 2134, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 2070, 2014, 0, 1953,
+	1955, 1954, 1904, 1906, 1905, 1903, 2137, 361, 2097, 1860,
+	1868, 1895, 1911, 1918, 1926, 1937, 1938, 1946, 1952, 1964,
+	1974, 1975, 1991, 2005, 2006, 2013, 2043, 2046, 2064, 2072,
+	2079, 2084, 2086, 493, 267, 1987, 2012, 2050, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 1960, 424, 440, 0, 209, 0, 216, 0, 217,
+	219, 1945, 215, 2093, 2129, 476, 485, 2024, 2038, 931,
+	1074, 0, 0, 467, 1143, 1078, 918, 941, 1088, 947,
+	949, 1014, 893, 989, 372, 938, 894, 1039, 0, 0,
+	885, 1138, 886, 919, 274, 1520, 1048, 992, 1076, 975,
+	1007, 1017, 273, 259, 982, 981, 1065, 930, 929, 1012,
+	1061, 1075, 0, 0, 185, 499, 203, 1176, 329, 0,
+	520, 497, 441, 354, 0, 523, 522, 973, 0, 1150,
+	1163, 958, 1016, 905, 1003, 1080, 939, 1008, 1081, 0,
+	0, 0, 0, 0, 0, 583, 0, 0, 0, 0,
+	0, 0, 0, 184, 0, 1158, 1172, 1139, 521, 968,
+	1013, 1093, 884, 1155, 0, 889, 1128, 0, 1066, 926,
+	927, 278, 0, 0, 0, 0, 0, 0, 0, 971,
+	988, 1032, 955, 0, 0, 489, 1019, 1028, 1043, 948,
+	391, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1154, 0, 0, 0, 0, 899, 1124, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1144, 0, 0, 0, 904, 882, 924, 1034, 883,
+	881, 355, 896, 1165, 1064, 956, 316, 204, 1070, 954,
+	1142, 1022, 900, 1052, 1086, 942, 326, 898, 208, 895,
+	901, 940, 368, 1031, 1037, 190, 211, 328, 1049, 920,
+	933, 260, 3504, 407, 1009, 488, 2484, 293, 0, 995,
+	406, 330, 480, 1023, 1072, 487, 943, 461, 498, 504,
+	286, 976, 249, 437, 276, 269, 925, 1042, 888, 299,
+	390, 264, 321, 959, 1015, 921, 256, 1026, 1002, 1054,
+	436, 477, 214, 349, 478, 503, 179, 287, 428, 288,
+	460, 279, 250, 393, 229, 319, 0, 0, 270, 314,
+	0, 0, 506, 496, 240, 289, 401, 405, 382, 236,
+	468, 350, 360, 253, 255, 254, 230, 429, 475, 243,
+	258, 1050, 1036, 1056, 916, 902, 908, 903, 932, 1073,
+	308, 300, 1057, 1055, 934, 376, 239, 986, 979, 972,
+	1161, 492, 1089, 272, 1038, 420, 213, 447, 494, 193,
+	422, 421, 946, 307, 1040, 194, 183, 400, 195, 317,
+	218, 1060, 508, 235, 324, 469, 2483, 292, 367, 1011,
+	377, 210, 395, 344, 346, 343, 347, 297, 188, 196,
+	1035, 397, 425, 474, 237, 444, 186, 189, 198, 413,
+	199, 200, 1079, 337, 281, 285, 301, 312, 224, 1010,
+	404, 445, 495, 1004, 232, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 448, 465, 415,
+	295, 336, 338, 450, 451, 456, 452, 453, 449, 455,
+	454, 411, 412, 322, 457, 222, 459, 486, 244, 423,
+	427, 507, 1033, 231, 252, 446, 225, 1068, 1051, 997,
+	961, 967, 890, 0, 223, 962, 963, 964, 965, 966,
+	1029, 923, 935, 915, 1005, 914, 296, 1021, 483, 484,
+	261, 1129, 1083, 226, 897, 1082, 364, 371, 363, 1085,
+	1084, 479, 1069, 998, 985, 983, 891, 1067, 996, 984,
+	325, 284, 303, 388, 332, 389, 304, 358, 357, 359,
+	334, 987, 443, 335, 0, 220, 0, 442, 1077, 1095,
+	458, 241, 909, 1044, 473, 191, 396, 242, 294, 282,
+	387, 362, 233, 306, 439, 323, 331, 1025, 1092, 375,
+	408, 248, 490, 438, 277, 1134, 0, 1136, 1132, 1135,
+	1133, 1151, 1152, 1173, 1174, 1175, 1162, 1130, 205, 206,
+	1170, 1171, 207, 978, 1071, 892, 0, 1047, 201, 202,
+	187, 192, 1018, 1090, 1141, 257, 177, 1125, 1126, 1127,
+	178, 1145, 1146, 180, 181, 1168, 1167, 1166, 1169, 182,
+	1178, 1177, 1179, 1131, 1137, 1140, 1147, 1148, 1149, 1156,
+	1157, 1164, 1159, 1160, 0, 974, 384, 221, 234, 245,
+	246, 247, 271, 268, 266, 275, 283, 0, 0, 309,
+	318, 0, 333, 352, 345, 381, 348, 0, 0, 0,
+	383, 402, 426, 432, 433, 462, 463, 464, 466, 470,
+	471, 472, 0, 500, 0, 392, 263, 1153, 212, 227,
+	327, 1091, 399, 291, 351, 430, 353, 313, 262, 505,
+	356, 398, 509, 1045, 1001, 0, 951, 953, 952, 911,
+	913, 912, 910, 1094, 361, 1063, 880, 887, 906, 917,
+	922, 928, 936, 937, 945, 950, 960, 969, 970, 980,
+	993, 994, 1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059,
+	493, 267, 977, 999, 1030, 228, 238, 251, 265, 280,
+	0, 290, 302, 305, 310, 311, 315, 320, 339, 340,
+	341, 342, 365, 366, 369, 370, 373, 374, 378, 379,
+	380, 385, 386, 394, 197, 403, 414, 416, 417, 418,
+	419, 431, 434, 435, 481, 482, 501, 502, 957, 424,
+	440, 0, 209, 0, 216, 0, 217, 219, 944, 215,
+	1062, 1087, 476, 485, 1006, 1020, 1929, 2109, 0, 2071,
+	467, 1963, 2113, 1912, 1942, 2131, 1948, 1951, 2032, 1878,
+	2001, 372, 1939, 1879, 2061, 1862, 1917, 1866, 1930, 1867,
+	1914, 274, 1910, 2074, 2004, 2111, 1983, 2025, 2035, 273,
+	259, 1993, 1992, 2099, 1928, 1927, 2030, 2088, 2110, 1982,
+	0, 185, 499, 203, 2120, 329, 2085, 520, 497, 441,
+	354, 0, 523, 522, 1978, 2094, 1999, 2063, 1961, 2034,
+	1894, 2017, 2115, 1940, 2026, 2116, 0, 0, 0, 0,
+	0, 0, 175, 0, 0, 0, 0, 0, 0, 0,
+	184, 0, 2022, 2107, 1933, 521, 1973, 2031, 2136, 1865,
+	2018, 0, 1870, 1881, 2130, 2100, 1924, 1925, 278, 0,
+	0, 0, 0, 0, 0, 0, 1976, 2000, 2052, 1958,
+	0, 0, 489, 2037, 2047, 2066, 1950, 391, 298, 0,
+	0, 0, 0, 0, 0, 3030, 0, 1919, 0, 2015,
+	0, 0, 0, 0, 1886, 1872, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1972, 0,
+	0, 0, 1893, 1863, 1921, 2054, 1864, 1861, 355, 1882,
+	2068, 2098, 1959, 316, 204, 2104, 1957, 1956, 2041, 1887,
+	2078, 2128, 1943, 326, 1885, 208, 1880, 1888, 1941, 368,
+	2051, 2059, 190, 211, 328, 2075, 1915, 1932, 260, 0,
+	407, 2027, 488, 519, 293, 0, 2008, 406, 330, 480,
+	2042, 2106, 487, 1944, 461, 498, 504, 286, 1984, 249,
+	437, 276, 269, 1923, 2065, 1869, 299, 390, 264, 321,
+	1962, 2033, 1916, 256, 2045, 2016, 2080, 436, 477, 214,
+	349, 478, 503, 0, 287, 428, 288, 460, 279, 250,
+	393, 229, 319, 0, 0, 270, 314, 0, 0, 506,
+	496, 240, 289, 401, 405, 382, 236, 468, 350, 360,
+	253, 255, 254, 230, 429, 475, 243, 258, 2076, 2058,
+	2082, 1909, 1889, 1900, 1890, 1931, 2108, 308, 300, 2083,
+	2081, 1934, 376, 239, 1997, 1990, 1977, 2055, 492, 2132,
+	272, 2060, 420, 213, 447, 494, 193, 422, 421, 1947,
+	307, 2062, 194, 183, 400, 195, 317, 218, 2087, 508,
+	235, 324, 469, 518, 292, 367, 2029, 377, 210, 395,
+	344, 346, 343, 347, 297, 188, 196, 2057, 397, 425,
+	474, 237, 444, 186, 189, 198, 413, 199, 200, 2114,
+	337, 281, 285, 301, 312, 224, 2028, 404, 445, 495,
+	2019, 232, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 448, 465, 415, 295, 336, 338,
+	450, 451, 456, 452, 453, 449, 455, 454, 411, 412,
+	322, 457, 222, 459, 486, 244, 423, 427, 507, 2053,
+	231, 252, 446, 225, 2102, 2077, 2010, 1965, 1971, 1871,
+	0, 223, 1966, 1967, 1968, 1969, 1970, 2049, 1920, 1936,
+	1908, 2023, 1907, 296, 2040, 483, 484, 261, 1883, 2122,
+	226, 1884, 2121, 364, 371, 363, 2125, 2123, 479, 2103,
+	2011, 1996, 1994, 1876, 2101, 2009, 1995, 325, 284, 303,
+	388, 332, 389, 304, 358, 357, 359, 334, 1998, 443,
+	335, 0, 220, 0, 442, 2112, 2138, 458, 241, 1902,
+	2069, 473, 191, 396, 242, 294, 282, 387, 362, 233,
+	306, 439, 323, 331, 2044, 2135, 375, 408, 248, 490,
+	438, 277, 1898, 0, 1901, 1896, 1899, 1897, 2002, 2003,
+	2117, 2118, 2119, 2056, 1891, 0, 0, 2095, 2096, 0,
+	1989, 2105, 1877, 0, 2073, 201, 202, 187, 192, 2036,
+	2133, 1949, 257, 0, 1873, 1874, 1875, 0, 1979, 1980,
+	0, 0, 2091, 2090, 2089, 2092, 0, 2126, 2124, 2127,
+	1892, 1913, 1935, 1985, 1986, 1988, 2020, 2021, 2067, 2039,
+	2048, 1922, 1981, 384, 221, 234, 245, 246, 247, 271,
+	268, 266, 275, 283, 0, 0, 309, 318, 0, 333,
+	352, 345, 381, 348, 0, 0, 0, 383, 402, 426,
+	432, 433, 462, 463, 464, 466, 470, 471, 472, 0,
+	500, 0, 392, 263, 2007, 212, 227, 327, 2134, 399,
+	291, 351, 430, 353, 313, 262, 505, 356, 398, 509,
+	2070, 2014, 0, 1953, 1955, 1954, 1904, 1906, 1905, 1903,
+	2137, 361, 2097, 1860, 1868, 1895, 1911, 1918, 1926, 1937,
+	1938, 1946, 1952, 1964, 1974, 1975, 1991, 2005, 2006, 2013,
+	2043, 2046, 2064, 2072, 2079, 2084, 2086, 493, 267, 1987,
+	2012, 2050, 228, 238, 251, 265, 280, 0, 290, 302,
+	305, 310, 311, 315, 320, 339, 340, 341, 342, 365,
+	366, 369, 370, 373, 374, 378, 379, 380, 385, 386,
+	394, 197, 403, 414, 416, 417, 418, 419, 431, 434,
+	435, 481, 482, 501, 502, 1960, 424, 440, 0, 209,
+	0, 216, 0, 217, 219, 1945, 215, 2093, 2129, 476,
+	485, 2024, 2038, 931, 1074, 0, 0, 467, 1143, 1078,
+	918, 941, 1088, 947, 949, 1014, 893, 989, 372, 938,
+	894, 1039, 0, 0, 885, 1138, 886, 919, 274, 1520,
+	1048, 992, 1076, 975, 1007, 1017, 273, 259, 982, 981,
+	1065, 930, 929, 1012, 1061, 1075, 0, 0, 185, 499,
+	203, 1176, 329, 0, 520, 497, 441, 354, 0, 523,
+	522, 973, 0, 1150, 1163, 958, 1016, 905, 1003, 1080,
+	939, 1008, 1081, 0, 0, 0, 0, 0, 0, 583,
+	0, 0, 0, 0, 0, 0, 0, 184, 0, 1158,
+	1172, 1139, 521, 968, 1013, 1093, 884, 1155, 0, 889,
+	1128, 0, 1066, 926, 927, 278, 0, 0, 0, 0,
+	0, 0, 0, 971, 988, 1032, 955, 0, 0, 489,
+	1019, 1028, 1043, 948, 391, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1154, 0, 0, 0,
+	0, 899, 1124, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1144, 0, 0, 0, 904,
+	882, 924, 1034, 883, 881, 355, 896, 1165, 1064, 956,
+	316, 204, 1070, 954, 1142, 1022, 900, 1052, 1086, 942,
+	326, 898, 208, 895, 901, 940, 368, 1031, 1037, 190,
+	211, 328, 1049, 920, 933, 260, 0, 407, 1009, 488,
+	2484, 293, 0, 995, 406, 330, 480, 1023, 1072, 487,
+	943, 461, 498, 504, 286, 976, 249, 437, 276, 269,
+	925, 1042, 888, 299, 390, 264, 321, 959, 1015, 921,
+	256, 1026, 1002, 1054, 436, 477, 214, 349, 478, 503,
+	179, 287, 428, 288, 460, 279, 250, 393, 229, 319,
+	0, 0, 270, 314, 0, 0, 506, 496, 240, 289,
+	401, 405, 382, 236, 468, 350, 360, 253, 255, 254,
+	230, 429, 475, 243, 258, 1050, 1036, 1056, 916, 902,
+	908, 903, 932, 1073, 308, 300, 1057, 1055, 934, 376,
+	239, 986, 979, 972, 1161, 492, 1089, 272, 1038, 420,
+	213, 447, 494, 193, 422, 421, 946, 307, 1040, 194,
+	183, 400, 195, 317, 218, 1060, 508, 235, 324, 469,
+	2483, 292, 367, 1011, 377, 210, 395, 344, 346, 343,
+	347, 297, 188, 196, 1035, 397, 425, 474, 237, 444,
+	186, 189, 198, 413, 199, 200, 1079, 337, 281, 285,
+	301, 312, 224, 1010, 404, 445, 495, 1004, 232, 491,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 448, 465, 415, 295, 336, 338, 450, 451, 456,
+	452, 453, 449, 455, 454, 411, 412, 322, 457, 222,
+	459, 486, 244, 423, 427, 507, 1033, 231, 252, 446,
+	225, 1068, 1051, 997, 961, 967, 890, 0, 223, 962,
+	963, 964, 965, 966, 1029, 923, 935, 915, 1005, 914,
+	296, 1021, 483, 484, 261, 1129, 1083, 226, 897, 1082,
+	364, 371, 363, 1085, 1084, 479, 1069, 998, 985, 983,
+	891, 1067, 996, 984, 325, 284, 303, 388, 332, 389,
+	304, 358, 357, 359, 334, 987, 443, 335, 0, 220,
+	0, 442, 1077, 1095, 458, 241, 909, 1044, 473, 191,
+	396, 242, 294, 282, 387, 362, 233, 306, 439, 323,
+	331, 1025, 1092, 375, 408, 248, 490, 438, 277, 1134,
+	0, 1136, 1132, 1135, 1133, 1151, 1152, 1173, 1174, 1175,
+	1162, 1130, 205, 206, 1170, 1171, 207, 978, 1071, 892,
+	0, 1047, 201, 202, 187, 192, 1018, 1090, 1141, 257,
+	177, 1125, 1126, 1127, 178, 1145, 1146, 180, 181, 1168,
+	1167, 1166, 1169, 182, 1178, 1177, 1179, 1131, 1137, 1140,
+	1147, 1148, 1149, 1156, 1157, 1164, 1159, 1160, 0, 974,
+	384, 221, 234, 245, 246, 247, 271, 268, 266, 275,
+	283, 0, 0, 309, 318, 0, 333, 352, 345, 381,
+	348, 0, 0, 0, 383, 402, 426, 432, 433, 462,
+	463, 464, 466, 470, 471, 472, 0, 500, 0, 392,
+	263, 1153, 212, 227, 327, 1091, 399, 291, 351, 430,
+	353, 313, 262, 505, 356, 398, 509, 1045, 1001, 0,
+	951, 953, 952, 911, 913, 912, 910, 1094, 361, 1063,
+	880, 887, 906, 917, 922, 928, 936, 937, 945, 950,
+	960, 969, 970, 980, 993, 994, 1000, 1024, 1027, 1041,
+	1046, 1053, 1058, 1059, 493, 267, 977, 999, 1030, 228,
+	238, 251, 265, 280, 0, 290, 302, 305, 310, 311,
+	315, 320, 339, 340, 341, 342, 365, 366, 369, 370,
+	373, 374, 378, 379, 380, 385, 386, 394, 197, 403,
+	414, 416, 417, 418, 419, 431, 434, 435, 481, 482,
+	501, 502, 957, 424, 440, 0, 209, 0, 216, 0,
+	217, 219, 944, 215, 1062, 1087, 476, 485, 1006, 1020,
+	1929, 2109, 0, 2071, 467, 1963, 2113, 1912, 1942, 2131,
+	1948, 1951, 2032, 1878, 2001, 372, 1939, 1879, 2061, 1862,
+	1917, 1866, 1930, 1867, 1914, 274, 1910, 2074, 2004, 2111,
+	1983, 2025, 2035, 273, 259, 1993, 1992, 2099, 1928, 1927,
+	2030, 2088, 2110, 1982, 0, 185, 499, 203, 2120, 329,
+	2085, 520, 497, 441, 354, 0, 523, 522, 1978, 2094,
+	1999, 2063, 1961, 2034, 1894, 2017, 2115, 1940, 2026, 2116,
+	0, 0, 0, 0, 0, 0, 175, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 2022, 2107, 1933, 521,
+	1973, 2031, 2136, 1865, 2018, 0, 1870, 1881, 2130, 2100,
+	1924, 1925, 278, 0, 0, 0, 0, 0, 0, 0,
+	1976, 2000, 2052, 1958, 0, 0, 489, 2037, 2047, 2066,
+	1950, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 1919, 0, 2015, 0, 0, 0, 0, 1886, 1872,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1972, 0, 0, 0, 1893, 1863, 1921, 2054,
+	1864, 1861, 355, 1882, 2068, 2098, 1959, 316, 204, 2104,
+	1957, 1956, 2041, 1887, 2078, 2128, 1943, 326, 1885, 208,
+	1880, 1888, 1941, 368, 2051, 2059, 190, 211, 328, 2075,
+	1915, 1932, 260, 0, 407, 2027, 488, 519, 293, 0,
+	2008, 406, 330, 480, 2042, 2106, 487, 1944, 461, 498,
+	504, 286, 1984, 249, 437, 276, 269, 1923, 2065, 1869,
+	299, 390, 264, 321, 1962, 2033, 1916, 256, 2045, 2016,
+	2080, 436, 477, 214, 349, 478, 503, 0, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 2076, 2058, 2082, 1909, 1889, 1900, 1890, 1931,
+	2108, 308, 300, 2083, 2081, 1934, 376, 239, 1997, 1990,
+	1977, 2055, 492, 2132, 272, 2060, 420, 213, 447, 494,
+	193, 422, 421, 1947, 307, 2062, 194, 183, 400, 195,
+	317, 218, 2087, 508, 235, 324, 469, 518, 292, 367,
+	2029, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 2057, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 2114, 337, 281, 285, 301, 312, 224,
+	2028, 404, 445, 495, 2019, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 2053, 231, 252, 446, 225, 2102, 2077,
+	2010, 1965, 1971, 1871, 0, 223, 1966, 1967, 1968, 1969,
+	1970, 2049, 1920, 1936, 1908, 2023, 1907, 296, 2040, 483,
+	484, 261, 1883, 2122, 226, 1884, 2121, 364, 371, 363,
+	2125, 2123, 479, 2103, 2011, 1996, 1994, 1876, 2101, 2009,
+	1995, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 1998, 443, 335, 0, 220, 0, 442, 2112,
+	2138, 458, 241, 1902, 2069, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 2044, 2135,
+	375, 408, 248, 490, 438, 277, 1898, 0, 1901, 1896,
+	1899, 1897, 2002, 2003, 2117, 2118, 2119, 2056, 1891, 0,
+	0, 2095, 2096, 0, 1989, 2105, 1877, 0, 2073, 201,
+	202, 187, 192, 2036, 2133, 1949, 257, 0, 1873, 1874,
+	1875, 0, 1979, 1980, 0, 0, 2091, 2090, 2089, 2092,
+	0, 2126, 2124, 2127, 1892, 1913, 1935, 1985, 1986, 1988,
+	2020, 2021, 2067, 2039, 2048, 1922, 1981, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 2007, 212,
+	227, 327, 2134, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 2070, 2014, 0, 1953, 1955, 1954,
+	1904, 1906, 1905, 1903, 2137, 361, 2097, 1860, 1868, 1895,
+	1911, 1918, 1926, 1937, 1938, 1946, 1952, 1964, 1974, 1975,
+	1991, 2005, 2006, 2013, 2043, 2046, 2064, 2072, 2079, 2084,
+	2086, 493, 267, 1987, 2012, 2050, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 1960,
+	424, 440, 0, 209, 0, 216, 0, 217, 219, 1945,
+	215, 2093, 2129, 476, 485, 2024, 2038, 931, 1074, 0,
+	0, 467, 1143, 1078, 918, 941, 1088, 947, 949, 1014,
+	893, 989, 372, 938, 894, 1039, 0, 0, 885, 1138,
+	886, 919, 274, 1520, 1048, 992, 1076, 975, 1007, 1017,
+	273, 259, 982, 981, 1065, 930, 929, 1012, 1061, 1075,
+	0, 0, 185, 499, 203, 1176, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 973, 0, 1150, 1163, 958,
+	1016, 905, 1003, 1080, 939, 1008, 1081, 0, 0, 0,
+	0, 0, 0, 583, 0, 1517, 0, 0, 1518, 0,
+	0, 184, 0, 1158, 1172, 1139, 0, 968, 1013, 1093,
+	884, 1155, 0, 889, 1128, 0, 1066, 926, 927, 278,
+	0, 0, 0, 0, 0, 0, 0, 971, 988, 1032,
+	955, 0, 0, 489, 1019, 1028, 1043, 948, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1154, 0, 0, 0, 0, 899, 1124, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1144,
+	0, 0, 0, 904, 882, 924, 1034, 883, 881, 355,
+	896, 1165, 1064, 956, 316, 204, 1070, 954, 1142, 1022,
+	900, 1052, 1086, 942, 326, 898, 208, 895, 901, 940,
+	368, 1031, 1037, 190, 211, 328, 1049, 920, 933, 260,
+	0, 407, 1009, 488, 1123, 293, 0, 995, 406, 330,
+	480, 1023, 1072, 487, 943, 461, 498, 504, 286, 976,
+	249, 437, 276, 269, 925, 1042, 888, 299, 390, 264,
+	321, 959, 1015, 921, 256, 1026, 1002, 1054, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 1050,
+	1036, 1056, 916, 902, 908, 903, 932, 1073, 308, 300,
+	1057, 1055, 934, 376, 239, 986, 979, 972, 1161, 492,
+	1089, 272, 1038, 420, 213, 447, 494, 193, 422, 421,
+	946, 307, 1040, 194, 183, 400, 195, 317, 218, 1060,
+	508, 235, 324, 469, 1122, 292, 367, 1011, 377, 210,
+	395, 344, 346, 343, 347, 297, 188, 196, 1035, 397,
+	425, 474, 237, 444, 186, 189, 198, 413, 199, 200,
+	1079, 337, 281, 285, 301, 312, 224, 1010, 404, 445,
+	495, 1004, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	1033, 231, 252, 446, 225, 1068, 1051, 997, 961, 967,
+	890, 0, 223, 962, 963, 964, 965, 966, 1029, 923,
+	935, 915, 1005, 914, 296, 1021, 483, 484, 261, 1129,
+	1083, 226, 897, 1082, 364, 371, 363, 1085, 1084, 479,
+	1069, 998, 985, 983, 891, 1067, 996, 984, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 987,
+	443, 335, 0, 220, 0, 442, 1077, 1095, 458, 241,
+	909, 1044, 473, 191, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 1025, 1092, 375, 408, 248,
+	490, 438, 277, 1134, 0, 1136, 1132, 1135, 1133, 1151,
+	1152, 1173, 1174, 1175, 1162, 1130, 205, 206, 1170, 1171,
+	207, 978, 1071, 892, 0, 1047, 201, 202, 187, 192,
+	1018, 1090, 1141, 257, 177, 1125, 1126, 1127, 178, 1145,
+	1146, 180, 181, 1168, 1167, 1166, 1169, 182, 1178, 1177,
+	1179, 1131, 1137, 1140, 1147, 1148, 1149, 1156, 1157, 1164,
+	1159, 1160, 0, 974, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 1153, 212, 227, 327, 1091,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 1045, 1001, 0, 951, 953, 952, 911, 913, 912,
+	910, 1094, 361, 1063, 880, 887, 906, 917, 922, 928,
+	936, 937, 945, 950, 960, 969, 970, 980, 993, 994,
+	1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059, 493, 267,
+	977, 999, 1030, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 197, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 957, 424, 440, 0,
+	209, 0, 216, 0, 217, 219, 944, 215, 1062, 1087,
+	476, 485, 1006, 1020, 931, 1074, 0, 0, 467, 1143,
+	1078, 918, 941, 1088, 947, 949, 1014, 893, 989, 372,
+	938, 894, 1039, 0, 0, 885, 1138, 886, 919, 274,
+	1520, 1048, 992, 1076, 975, 1007, 1017, 273, 259, 982,
+	981, 1065, 930, 929, 1012, 1061, 1075, 0, 0, 185,
+	499, 203, 1176, 329, 0, 0, 497, 441, 354, 0,
+	0, 0, 973, 0, 1150, 1163, 958, 1016, 905, 1003,
+	1080, 939, 1008, 1081, 0, 0, 0, 0, 0, 0,
+	583, 0, 0, 0, 0, 0, 0, 0, 184, 0,
+	1158, 1172, 1139, 0, 968, 1013, 1093, 884, 1155, 0,
+	889, 1128, 0, 1066, 926, 927, 278, 0, 0, 0,
+	0, 0, 0, 0, 971, 988, 1032, 955, 0, 0,
+	489, 1019, 1028, 1043, 948, 391, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1154, 0, 0,
+	0, 0, 899, 1124, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1144, 0, 0, 0,
+	904, 882, 924, 1034, 883, 881, 355, 896, 1165, 1064,
+	956, 316, 204, 1070, 954, 1142, 1022, 900, 1052, 1086,
+	942, 326, 898, 208, 895, 901, 940, 368, 1031, 1037,
+	190, 211, 328, 1049, 920, 933, 260, 0, 407, 1009,
+	488, 1123, 293, 0, 995, 406, 330, 480, 1023, 1072,
+	487, 943, 461, 498, 504, 286, 976, 249, 437, 276,
+	269, 925, 1042, 888, 299, 390, 264, 321, 959, 1015,
+	921, 256, 1026, 1002, 1054, 436, 477, 214, 349, 478,
+	503, 179, 287, 428, 288, 460, 279, 250, 393, 229,
+	319, 0, 0, 270, 314, 0, 0, 506, 496, 240,
+	289, 401, 405, 382, 236, 468, 350, 360, 253, 255,
+	254, 230, 429, 475, 243, 258, 1050, 1036, 1056, 916,
+	902, 908, 903, 932, 1073, 308, 300, 1057, 1055, 934,
+	376, 239, 986, 979, 972, 1161, 492, 1089, 272, 1038,
+	420, 213, 447, 494, 193, 422, 421, 946, 307, 1040,
+	194, 183, 400, 195, 317, 218, 1060, 508, 235, 324,
+	469, 1122, 292, 367, 1011, 377, 210, 395, 344, 346,
+	343, 347, 297, 188, 196, 1035, 397, 425, 474, 237,
+	444, 186, 189, 198, 413, 199, 200, 1079, 337, 281,
+	285, 301, 312, 224, 1010, 404, 445, 495, 1004, 232,
+	491, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 448, 465, 415, 295, 336, 338, 450, 451,
+	456, 452, 453, 449, 455, 454, 411, 412, 322, 457,
+	222, 459, 486, 244, 423, 427, 507, 1033, 231, 252,
+	446, 225, 1068, 1051, 997, 961, 967, 890, 0, 223,
+	962, 963, 964, 965, 966, 1029, 923, 935, 915, 1005,
+	914, 296, 1021, 483, 484, 261, 1129, 1083, 226, 897,
+	1082, 364, 371, 363, 1085, 1084, 479, 1069, 998, 985,
+	983, 891, 1067, 996, 984, 325, 284, 303, 388, 332,
+	389, 304, 358, 357, 359, 334, 987, 443, 335, 0,
+	220, 0, 442, 1077, 1095, 458, 241, 909, 1044, 473,
+	191, 396, 242, 294, 282, 387, 362, 233, 306, 439,
+	323, 331, 1025, 1092, 375, 408, 248, 490, 438, 277,
+	1134, 0, 1136, 1132, 1135, 1133, 1151, 1152, 1173, 1174,
+	1175, 1162, 1130, 205, 206, 1170, 1171, 207, 978, 1071,
+	892, 0, 1047, 201, 202, 187, 192, 1018, 1090, 1141,
+	257, 177, 1125, 1126, 1127, 178, 1145, 1146, 180, 181,
+	1168, 1167, 1166, 1169, 182, 1178, 1177, 1179, 1131, 1137,
+	1140, 1147, 1148, 1149, 1156, 1157, 1164, 1159, 1160, 0,
+	974, 384, 221, 234, 245, 246, 247, 271, 268, 266,
+	275, 283, 0, 0, 309, 318, 0, 333, 352, 345,
+	381, 348, 0, 0, 0, 383, 402, 426, 432, 433,
+	462, 463, 464, 466, 470, 471, 472, 0, 500, 0,
+	392, 263, 1153, 212, 227, 327, 1091, 399, 291, 351,
+	430, 353, 313, 262, 505, 356, 398, 509, 1045, 1001,
+	0, 951, 953, 952, 911, 913, 912, 910, 1094, 361,
+	1063, 880, 887, 906, 917, 922, 928, 936, 937, 945,
+	950, 960, 969, 970, 980, 993, 994, 1000, 1024, 1027,
+	1041, 1046, 1053, 1058, 1059, 493, 267, 977, 999, 1030,
+	228, 238, 251, 265, 280, 0, 290, 302, 305, 310,
+	311, 315, 320, 339, 340, 341, 342, 365, 366, 369,
+	370, 373, 374, 378, 379, 380, 385, 386, 394, 197,
+	403, 414, 416, 417, 418, 419, 431, 434, 435, 481,
+	482, 501, 502, 957, 424, 440, 0, 209, 0, 216,
+	0, 217, 219, 944, 215, 1062, 1087, 476, 485, 1006,
+	1020, 931, 1074, 0, 0, 467, 1143, 1078, 918, 941,
+	1088, 947, 949, 1014, 893, 989, 372, 938, 894, 1039,
+	0, 0, 885, 1138, 886, 919, 274, 1520, 1048, 992,
+	1076, 975, 1007, 1017, 273, 259, 982, 981, 1065, 930,
+	929, 1012, 1061, 1075, 0, 0, 185, 499, 203, 1176,
+	329, 0, 0, 497, 441, 354, 0, 0, 0, 973,
+	0, 1150, 1163, 958, 1016, 905, 1003, 1080, 939, 1008,
+	1081, 0, 0, 0, 0, 0, 0, 583, 0, 0,
+	0, 0, 0, 0, 0, 184, 0, 1158, 1172, 1139,
+	0, 968, 1013, 1093, 884, 1155, 0, 889, 1128, 0,
+	1066, 926, 927, 278, 0, 0, 0, 0, 0, 0,
+	0, 971, 988, 1032, 955, 0, 0, 489, 1019, 1028,
+	1043, 948, 391, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1154, 0, 0, 0, 0, 899,
+	1124, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1144, 0, 0, 0, 904, 882, 924,
+	1034, 883, 881, 355, 896, 1165, 1064, 956, 316, 204,
+	1070, 954, 1142, 1022, 900, 1052, 1086, 942, 326, 898,
+	208, 895, 901, 940, 368, 1031, 1037, 190, 211, 328,
+	1049, 920, 933, 260, 0, 407, 1009, 488, 1123, 293,
+	0, 995, 406, 330, 480, 1023, 1072, 487, 943, 461,
+	498, 504, 286, 976, 249, 437, 276, 269, 925, 1042,
+	888, 299, 390, 264, 321, 959, 1015, 921, 256, 1026,
+	1002, 1054, 436, 477, 214, 349, 478, 503, 179, 287,
+	428, 288, 460, 279, 250, 393, 229, 319, 0, 0,
+	270, 314, 0, 0, 506, 496, 240, 289, 401, 405,
+	382, 236, 468, 350, 360, 253, 255, 254, 230, 429,
+	475, 243, 258, 1050, 1036, 1056, 916, 902, 908, 903,
+	932, 1073, 308, 300, 1057, 1055, 934, 376, 239, 986,
+	979, 972, 1161, 492, 1089, 272, 1038, 420, 213, 447,
+	494, 193, 422, 421, 946, 307, 1040, 194, 183, 400,
+	195, 317, 218, 1060, 508, 235, 324, 469, 1122, 292,
+	367, 1011, 377, 210, 395, 344, 346, 343, 347, 297,
+	188, 196, 1035, 397, 425, 474, 237, 444, 186, 189,
+	198, 413, 199, 200, 1079, 337, 281, 285, 301, 312,
+	224, 1010, 404, 445, 495, 1004, 232, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 448,
+	465, 415, 295, 336, 338, 450, 451, 456, 452, 453,
+	449, 455, 454, 411, 412, 322, 457, 222, 459, 486,
+	244, 423, 427, 507, 1033, 231, 252, 446, 225, 1068,
+	1051, 997, 961, 967, 890, 0, 223, 962, 963, 964,
+	965, 966, 1029, 923, 935, 915, 1005, 914, 296, 1021,
+	483, 484, 261, 1129, 1083, 226, 1117, 1082, 364, 371,
+	363, 1085, 1084, 479, 1069, 998, 985, 983, 891, 1067,
+	996, 984, 325, 284, 303, 388, 332, 389, 304, 358,
+	357, 359, 1113, 987, 443, 335, 0, 220, 0, 442,
+	1077, 1095, 458, 241, 909, 1044, 473, 191, 396, 242,
+	294, 282, 387, 1118, 1116, 1107, 1108, 323, 331, 1025,
+	1092, 375, 408, 248, 490, 438, 277, 1134, 0, 1136,
+	1132, 1135, 1133, 1151, 1152, 1173, 1174, 1175, 1162, 1130,
+	205, 206, 1170, 1171, 207, 978, 1071, 892, 0, 1047,
+	201, 202, 187, 192, 1018, 1090, 1141, 257, 177, 1125,
+	1126, 1127, 178, 1145, 1146, 180, 181, 1168, 1167, 1166,
+	1169, 182, 1178, 1177, 1179, 1131, 1137, 1140, 1147, 1148,
+	1149, 1156, 1157, 1164, 1159, 1160, 0, 974, 384, 221,
+	234, 245, 246, 247, 271, 268, 266, 275, 283, 0,
+	0, 309, 318, 0, 333, 352, 345, 381, 348, 0,
+	0, 0, 383, 402, 426, 432, 433, 462, 463, 464,
+	466, 470, 471, 472, 0, 500, 0, 392, 263, 1153,
+	212, 227, 327, 1091, 399, 291, 351, 430, 353, 313,
+	262, 505, 356, 398, 509, 1045, 1001, 0, 951, 953,
+	952, 911, 913, 912, 910, 1094, 361, 1063, 880, 887,
+	906, 917, 922, 928, 936, 937, 945, 950, 960, 969,
+	970, 980, 993, 994, 1000, 1024, 1027, 1041, 1046, 1053,
+	1058, 1059, 493, 267, 977, 999, 1030, 228, 238, 251,
+	265, 280, 0, 290, 302, 305, 310, 311, 315, 320,
+	339, 340, 341, 342, 365, 366, 369, 370, 373, 374,
+	378, 379, 380, 1114, 1115, 394, 197, 403, 414, 416,
+	417, 418, 419, 431, 434, 435, 481, 482, 501, 502,
+	957, 424, 440, 0, 209, 0, 216, 0, 217, 219,
+	944, 215, 1062, 1087, 476, 485, 1006, 1020, 931, 1074,
+	0, 0, 467, 1143, 1078, 918, 941, 1088, 947, 949,
+	1014, 893, 989, 372, 938, 894, 1039, 0, 0, 885,
+	1138, 886, 919, 274, 1520, 1048, 992, 1076, 975, 1007,
+	1017, 273, 259, 982, 981, 1065, 930, 929, 1012, 1061,
+	1075, 0, 0, 185, 499, 203, 1176, 329, 0, 0,
+	497, 441, 354, 0, 0, 0, 973, 0, 1150, 1163,
+	958, 1016, 905, 1003, 1080, 939, 1008, 1081, 0, 0,
+	0, 0, 0, 0, 583, 0, 0, 0, 0, 0,
+	0, 0, 184, 0, 1158, 1172, 1139, 0, 968, 1013,
+	1093, 884, 1155, 0, 889, 1128, 0, 1066, 926, 927,
+	278, 0, 0, 0, 0, 0, 0, 0, 971, 988,
+	1032, 955, 0, 0, 489, 1019, 1028, 1043, 948, 391,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1154, 0, 0, 0, 0, 899, 1124, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1144, 0, 0, 0, 904, 882, 924, 1034, 883, 881,
+	355, 896, 1165, 1064, 956, 316, 204, 1070, 954, 1142,
+	1022, 900, 1052, 1086, 942, 326, 898, 208, 895, 901,
+	940, 368, 1031, 1037, 190, 211, 328, 1049, 920, 933,
+	260, 0, 407, 1009, 488, 1123, 293, 0, 995, 406,
+	330, 480, 1023, 1072, 487, 943, 461, 498, 504, 286,
+	976, 249, 437, 276, 269, 925, 1042, 888, 299, 390,
+	264, 321, 959, 1015, 921, 256, 1026, 1002, 1054, 436,
+	477, 214, 349, 478, 503, 179, 287, 428, 288, 460,
+	279, 250, 393, 229, 319, 0, 0, 270, 314, 0,
+	0, 506, 496, 240, 289, 401, 405, 382, 236, 468,
+	350, 360, 253, 255, 254, 230, 429, 475, 243, 258,
+	1050, 1036, 1056, 916, 902, 908, 903, 932, 1073, 308,
+	300, 1057, 1055, 934, 376, 239, 986, 979, 972, 1161,
+	492, 1089, 272, 1038, 420, 213, 447, 494, 193, 422,
+	421, 946, 307, 1040, 194, 183, 400, 195, 317, 218,
+	1060, 508, 235, 324, 469, 1122, 292, 367, 1011, 377,
+	210, 395, 344, 346, 343, 347, 297, 188, 196, 1035,
+	397, 425, 474, 237, 444, 186, 189, 198, 413, 199,
+	200, 1079, 337, 281, 285, 301, 312, 224, 1010, 404,
+	445, 495, 1004, 232, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 448, 465, 415, 295,
+	336, 338, 450, 451, 456, 452, 453, 449, 455, 454,
+	411, 412, 322, 457, 222, 459, 1686, 244, 423, 427,
+	507, 1033, 231, 252, 446, 225, 1068, 1051, 997, 961,
+	967, 890, 0, 223, 962, 963, 964, 965, 966, 1029,
+	923, 935, 915, 1005, 914, 296, 1021, 483, 484, 261,
+	1129, 1083, 226, 897, 1082, 364, 371, 363, 1085, 1084,
+	479, 1069, 998, 985, 983, 891, 1067, 996, 984, 325,
+	284, 303, 388, 332, 389, 304, 358, 357, 359, 334,
+	987, 443, 335, 0, 220, 0, 442, 1077, 1095, 458,
+	241, 909, 1044, 473, 191, 396, 242, 294, 282, 387,
+	362, 233, 306, 439, 323, 331, 1025, 1092, 375, 408,
+	248, 490, 438, 277, 1134, 0, 1136, 1132, 1135, 1133,
+	1151, 1152, 1173, 1174, 1175, 1162, 1130, 205, 206, 1170,
+	1171, 207, 978, 1071, 892, 0, 1047, 201, 202, 187,
+	192, 1018, 1090, 1141, 257, 177, 1125, 1126, 1127, 178,
+	1145, 1146, 180, 181, 1168, 1167, 1166, 1169, 182, 1178,
+	1177, 1179, 1131, 1137, 1140, 1147, 1148, 1149, 1156, 1157,
+	1164, 1159, 1160, 0, 974, 384, 221, 234, 245, 246,
+	247, 271, 268, 266, 275, 283, 0, 0, 309, 318,
+	0, 333, 352, 345, 381, 348, 0, 0, 0, 383,
+	402, 426, 432, 433, 462, 463, 464, 466, 470, 471,
+	472, 0, 500, 0, 392, 263, 1153, 212, 227, 327,
+	1091, 399, 291, 351, 430, 353, 313, 262, 505, 356,
+	398, 509, 1045, 1001, 0, 951, 953, 952, 911, 913,
+	912, 910, 1094, 361, 1063, 880, 887, 906, 917, 922,
+	928, 936, 937, 945, 950, 960, 969, 970, 980, 993,
+	994, 1000, 1024, 1027, 1041, 1046, 1053, 1058, 1059, 493,
+	267, 977, 999, 1030, 228, 238, 251, 265, 280, 0,
+	290, 302, 305, 310, 311, 315, 320, 339, 340, 341,
+	342, 365, 366, 369, 370, 373, 374, 378, 379, 380,
+	385, 386, 394, 197, 403, 414, 416, 417, 418, 419,
+	431, 434, 435, 481, 482, 501, 502, 957, 424, 440,
+	0, 209, 0, 216, 0, 217, 219, 944, 215, 1062,
+	1087, 476, 485, 1006, 1020, 931, 1074, 0, 0, 467,
+	1143, 1078, 918, 941, 1088, 947, 949, 1014, 893, 989,
+	372, 938, 894, 1039, 0, 0, 885, 1138, 886, 919,
+	274, 1105, 1048, 992, 1076, 975, 1007, 1017, 273, 259,
+	982, 981, 1065, 930, 929, 1012, 1061, 1075, 0, 0,
+	185, 499, 203, 1176, 329, 0, 0, 497, 441, 354,
+	0, 0, 0, 973, 0, 1150, 1163, 958, 1016, 905,
+	1003, 1080, 939, 1008, 1081, 0, 0, 0, 0, 0,
+	0, 583, 0, 0, 0, 0, 0, 0, 0, 184,
+	0, 1158, 1172, 1139, 0, 968, 1013, 1093, 884, 1155,
+	0, 889, 1128, 0, 1066, 926, 927, 278, 0, 0,
+	0, 0, 0, 0, 0, 971, 988, 1032, 955, 0,
+	0, 489, 1019, 1028, 1043, 948, 391, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1154, 0,
+	0, 0, 0, 899, 1124, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1144, 0, 0,
+	0, 904, 882, 924, 1034, 883, 881, 355, 896, 1165,
+	1064, 956, 316, 204, 1070, 954, 1142, 1022, 900, 1052,
+	1086, 942, 326, 898, 208, 895, 901, 940, 368, 1031,
+	1037, 190, 211, 328, 1049, 920, 933, 260, 0, 407,
+	1009, 488, 1123, 293, 0, 995, 406, 330, 480, 1023,
+	1072, 487, 943, 461, 498, 504, 286, 976, 249, 437,
+	276, 269, 925, 1042, 888, 299, 390, 264, 321, 959,
+	1015, 921, 256, 1026, 1002, 1054, 436, 477, 214, 349,
+	478, 503, 179, 287, 428, 288, 460, 279, 250, 393,
+	229, 319, 0, 0, 270, 314, 0, 0, 506, 496,
+	240, 289, 401, 405, 382, 236, 468, 350, 360, 253,
+	255, 254, 230, 429, 475, 243, 258, 1050, 1036, 1056,
+	916, 902, 908, 903, 932, 1073, 308, 300, 1057, 1055,
+	934, 376, 239, 986, 979, 972, 1161, 492, 1089, 272,
+	1038, 420, 213, 447, 494, 193, 1103, 421, 946, 307,
+	1040, 194, 183, 400, 1104, 317, 218, 1060, 508, 235,
+	324, 469, 1122, 292, 367, 1011, 377, 210, 395, 344,
+	346, 343, 347, 297, 188, 196, 1035, 397, 425, 474,
+	237, 444, 186, 189, 198, 413, 199, 200, 1079, 337,
+	281, 285, 301, 312, 224, 1010, 404, 445, 495, 1004,
+	232, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 448, 465, 415, 295, 336, 338, 450,
+	451, 456, 452, 453, 449, 455, 454, 411, 412, 322,
+	457, 222, 459, 1101, 244, 423, 427, 507, 1033, 231,
+	252, 446, 225, 1068, 1051, 997, 961, 967, 890, 0,
+	223, 962, 963, 964, 965, 966, 1029, 923, 935, 915,
+	1005, 914, 296, 1021, 483, 484, 261, 1129, 1083, 226,
+	1117, 1082, 364, 371, 363, 1085, 1084, 479, 1069, 998,
+	985, 983, 891, 1067, 996, 984, 325, 284, 303, 388,
+	332, 389, 304, 358, 357, 359, 1113, 987, 443, 335,
+	0, 220, 0, 442, 1077, 1095, 458, 241, 909, 1044,
+	473, 191, 396, 242, 294, 282, 387, 1118, 1116, 1107,
+	1108, 323, 331, 1025, 1092, 375, 408, 248, 490, 438,
+	277, 1134, 0, 1136, 1132, 1135, 1133, 1151, 1152, 1173,
+	1174, 1175, 1162, 1130, 205, 206, 1170, 1171, 207, 978,
+	1071, 892, 0, 1047, 201, 202, 187, 192, 1018, 1090,
+	1141, 257, 177, 1125, 1126, 1127, 178, 1145, 1146, 180,
+	181, 1168, 1167, 1166, 1169, 182, 1178, 1177, 1179, 1131,
+	1137, 1140, 1147, 1148, 1149, 1156, 1157, 1164, 1159, 1160,
+	0, 974, 384, 221, 234, 245, 246, 247, 271, 268,
+	266, 275, 283, 0, 0, 309, 318, 0, 333, 352,
+	345, 381, 348, 0, 0, 0, 383, 402, 426, 432,
+	433, 462, 463, 464, 466, 470, 471, 472, 0, 500,
+	0, 392, 263, 1153, 212, 227, 327, 1091, 399, 291,
+	351, 430, 353, 313, 262, 505, 356, 398, 509, 1045,
+	1001, 0, 951, 953, 952, 911, 913, 912, 910, 1094,
+	361, 1063, 880, 887, 906, 917, 922, 928, 936, 937,
+	945, 950, 960, 969, 970, 980, 993, 994, 1000, 1024,
+	1027, 1041, 1046, 1053, 1058, 1059, 493, 267, 977, 999,
+	1030, 228, 238, 251, 265, 280, 0, 290, 302, 305,
+	310, 311, 315, 320, 339, 340, 341, 342, 365, 366,
+	369, 370, 373, 374, 378, 379, 380, 1114, 1115, 394,
+	197, 403, 414, 416, 417, 418, 419, 431, 434, 435,
+	481, 482, 501, 502, 957, 424, 440, 0, 209, 0,
+	216, 0, 217, 219, 944, 215, 1062, 1087, 476, 485,
+	1006, 1020, 1929, 2109, 0, 2071, 467, 1963, 2113, 1912,
+	1942, 2131, 1948, 1951, 2032, 1878, 2001, 372, 1939, 1879,
+	2061, 1862, 1917, 1866, 1930, 1867, 1914, 274, 1910, 2074,
+	2004, 2111, 1983, 2025, 2035, 273, 259, 1993, 1992, 2099,
+	1928, 1927, 2030, 2088, 2110, 1982, 0, 0, 499, 0,
+	2120, 329, 2085, 0, 497, 441, 354, 0, 0, 0,
+	1978, 2094, 1999, 2063, 1961, 2034, 1894, 2017, 2115, 1940,
+	2026, 2116, 0, 0, 0, 0, 0, 3351, 3356, 0,
+	3359, 3361, 3360, 0, 0, 0, 3353, 0, 2022, 2107,
+	1933, 0, 1973, 2031, 2136, 1865, 2018, 0, 1870, 1881,
+	2130, 2100, 1924, 1925, 278, 0, 0, 0, 0, 0,
+	0, 0, 1976, 2000, 2052, 1958, 0, 0, 489, 2037,
+	2047, 2066, 1950, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 1919, 0, 2015, 0, 0, 0, 0,
+	1886, 1872, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1972, 0, 0, 0, 1893, 1863,
+	1921, 2054, 1864, 1861, 355, 1882, 2068, 2098, 1959, 316,
+	0, 2104, 1957, 1956, 2041, 1887, 2078, 2128, 1943, 326,
+	1885, 208, 1880, 1888, 1941, 368, 2051, 2059, 0, 211,
+	328, 2075, 1915, 1932, 260, 0, 407, 2027, 488, 0,
+	293, 0, 2008, 406, 330, 480, 2042, 2106, 487, 1944,
+	461, 498, 504, 286, 1984, 249, 437, 276, 269, 1923,
+	2065, 1869, 299, 390, 264, 321, 1962, 2033, 1916, 256,
+	2045, 2016, 2080, 436, 477, 214, 349, 478, 503, 3354,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 2076, 2058, 2082, 1909, 1889, 1900,
+	1890, 1931, 2108, 308, 300, 2083, 2081, 1934, 376, 239,
+	1997, 1990, 1977, 2055, 492, 2132, 272, 2060, 420, 213,
+	447, 494, 0, 422, 421, 1947, 307, 2062, 0, 0,
+	400, 3355, 317, 218, 2087, 508, 235, 324, 469, 0,
+	292, 367, 2029, 377, 210, 395, 344, 346, 343, 347,
+	297, 0, 0, 2057, 397, 425, 474, 237, 444, 0,
+	0, 0, 413, 0, 0, 2114, 337, 281, 285, 301,
+	312, 224, 2028, 404, 445, 495, 2019, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 2053, 231, 252, 446, 225,
+	2102, 2077, 2010, 1965, 1971, 1871, 0, 223, 1966, 1967,
+	1968, 1969, 1970, 2049, 1920, 1936, 1908, 2023, 1907, 296,
+	2040, 483, 484, 261, 1883, 2122, 226, 1884, 2121, 364,
+	371, 363, 2125, 2123, 479, 2103, 2011, 1996, 1994, 1876,
+	2101, 2009, 1995, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 1998, 443, 335, 0, 220, 0,
+	442, 2112, 2138, 458, 241, 1902, 2069, 473, 0, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	2044, 2135, 375, 408, 248, 490, 438, 277, 1898, 0,
+	1901, 1896, 1899, 1897, 2002, 2003, 2117, 2118, 2119, 2056,
+	1891, 0, 0, 2095, 2096, 0, 1989, 2105, 1877, 0,
+	2073, 0, 0, 0, 0, 2036, 2133, 1949, 257, 0,
+	1873, 1874, 1875, 0, 1979, 1980, 0, 0, 2091, 2090,
+	2089, 2092, 0, 2126, 2124, 2127, 1892, 1913, 1935, 1985,
+	1986, 1988, 2020, 2021, 2067, 2039, 2048, 1922, 1981, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	2007, 212, 227, 327, 2134, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 2070, 2014, 0, 1953,
+	1955, 1954, 1904, 1906, 1905, 1903, 2137, 361, 2097, 1860,
+	1868, 1895, 1911, 1918, 1926, 1937, 1938, 1946, 1952, 1964,
+	1974, 1975, 1991, 2005, 2006, 2013, 2043, 2046, 2064, 2072,
+	2079, 2084, 2086, 493, 267, 1987, 2012, 2050, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 0, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 1960, 424, 440, 0, 209, 0, 216, 0, 217,
+	219, 1945, 215, 2093, 2129, 476, 485, 2024, 2038, 1929,
+	2109, 0, 2071, 467, 1963, 2113, 1912, 1942, 2131, 1948,
+	1951, 2032, 1878, 2001, 372, 1939, 1879, 2061, 1862, 1917,
+	1866, 1930, 1867, 1914, 274, 1910, 2074, 2004, 2111, 1983,
+	2025, 2035, 273, 259, 1993, 1992, 2099, 1928, 1927, 2030,
+	2088, 2110, 1982, 0, 0, 499, 0, 2120, 329, 2085,
+	0, 497, 441, 354, 0, 0, 0, 1978, 2094, 1999,
+	2063, 1961, 2034, 1894, 2017, 2115, 1940, 2026, 2116, 0,
+	0, 0, 0, 0, 0, 1201, 0, 2397, 0, 2398,
+	0, 0, 0, 0, 0, 2022, 2107, 1933, 0, 1973,
+	2031, 2136, 1865, 2018, 0, 1870, 1881, 2130, 2100, 1924,
+	1925, 278, 0, 0, 0, 0, 0, 0, 0, 1976,
+	2000, 2052, 1958, 0, 0, 489, 2037, 2047, 2066, 1950,
+	391, 298, 0, 0, 0, 0, 0, 0, 4215, 0,
+	1919, 0, 2015, 0, 0, 0, 0, 1886, 1872, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1972, 0, 0, 0, 1893, 1863, 1921, 2054, 1864,
+	1861, 355, 1882, 2068, 2098, 1959, 316, 0, 2104, 1957,
+	1956, 2041, 1887, 2078, 2128, 1943, 326, 1885, 208, 1880,
+	1888, 1941, 368, 2051, 2059, 0, 211, 328, 2075, 1915,
+	1932, 260, 0, 407, 2027, 488, 0, 293, 0, 2008,
+	406, 330, 480, 2042, 2106, 487, 1944, 461, 498, 504,
+	286, 1984, 249, 437, 276, 269, 1923, 2065, 1869, 299,
+	390, 264, 321, 1962, 2033, 1916, 256, 2045, 2016, 2080,
+	436, 477, 214, 349, 478, 503, 0, 287, 428, 288,
+	460, 279, 250, 393, 229, 319, 0, 0, 270, 314,
+	0, 0, 506, 496, 240, 289, 401, 405, 382, 236,
+	468, 350, 360, 253, 255, 254, 230, 429, 475, 243,
+	258, 2076, 2058, 2082, 1909, 1889, 1900, 1890, 1931, 2108,
+	308, 300, 2083, 2081, 1934, 376, 239, 1997, 1990, 1977,
+	2055, 492, 2132, 272, 2060, 420, 213, 447, 494, 0,
+	422, 421, 1947, 307, 2062, 0, 0, 400, 0, 317,
+	218, 2087, 508, 235, 324, 469, 0, 292, 367, 2029,
+	377, 210, 395, 344, 346, 343, 347, 297, 0, 0,
+	2057, 397, 425, 474, 237, 444, 0, 0, 0, 413,
+	0, 0, 2114, 337, 281, 285, 301, 312, 224, 2028,
+	404, 445, 495, 2019, 232, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 448, 465, 415,
+	295, 336, 338, 450, 451, 456, 452, 453, 449, 455,
+	454, 411, 412, 322, 457, 222, 459, 486, 244, 423,
+	427, 507, 2053, 231, 252, 446, 225, 2102, 2077, 2010,
+	1965, 1971, 1871, 0, 223, 1966, 1967, 1968, 1969, 1970,
+	2049, 1920, 1936, 1908, 2023, 1907, 296, 2040, 483, 484,
+	261, 1883, 2122, 226, 1884, 2121, 364, 371, 363, 2125,
+	2123, 479, 2103, 2011, 1996, 1994, 1876, 2101, 2009, 1995,
+	325, 284, 303, 388, 332, 389, 304, 358, 357, 359,
+	334, 1998, 443, 335, 0, 220, 0, 442, 2112, 2138,
+	458, 241, 1902, 2069, 473, 0, 396, 242, 294, 282,
+	387, 362, 233, 306, 439, 323, 331, 2044, 2135, 375,
+	408, 248, 490, 438, 277, 1898, 0, 1901, 1896, 1899,
+	1897, 2002, 2003, 2117, 2118, 2119, 2056, 1891, 0, 0,
+	2095, 2096, 0, 1989, 2105, 1877, 0, 2073, 0, 0,
+	0, 0, 2036, 2133, 1949, 257, 0, 1873, 1874, 1875,
+	0, 1979, 1980, 0, 0, 2091, 2090, 2089, 2092, 0,
+	2126, 2124, 2127, 1892, 1913, 1935, 1985, 1986, 1988, 2020,
+	2021, 2067, 2039, 2048, 1922, 1981, 384, 221, 234, 245,
+	246, 247, 271, 268, 266, 275, 283, 0, 0, 309,
+	318, 0, 333, 352, 345, 381, 348, 0, 0, 0,
+	383, 402, 426, 432, 433, 462, 463, 464, 466, 470,
+	471, 472, 0, 500, 0, 392, 263, 2007, 212, 227,
+	327, 2134, 399, 291, 351, 430, 353, 313, 262, 505,
+	356, 398, 509, 2070, 2014, 0, 1953, 1955, 1954, 1904,
+	1906, 1905, 1903, 2137, 361, 2097, 1860, 1868, 1895, 1911,
+	1918, 1926, 1937, 1938, 1946, 1952, 1964, 1974, 1975, 1991,
+	2005, 2006, 2013, 2043, 2046, 2064, 2072, 2079, 2084, 2086,
+	493, 267, 1987, 2012, 2050, 228, 238, 251, 265, 280,
+	0, 290, 302, 305, 310, 311, 315, 320, 339, 340,
+	341, 342, 365, 366, 369, 370, 373, 374, 378, 379,
+	380, 385, 386, 394, 0, 403, 414, 416, 417, 418,
+	419, 431, 434, 435, 481, 482, 501, 502, 1960, 424,
+	440, 0, 209, 0, 216, 0, 217, 219, 1945, 215,
+	2093, 2129, 476, 485, 2024, 2038, 1929, 2109, 0, 2071,
+	467, 1963, 2113, 1912, 1942, 2131, 1948, 1951, 2032, 1878,
+	2001, 372, 1939, 1879, 2061, 1862, 1917, 1866, 1930, 1867,
+	1914, 274, 1910, 2074, 2004, 2111, 1983, 2025, 2035, 273,
+	259, 1993, 1992, 2099, 1928, 1927, 2030, 2088, 2110, 1982,
+	0, 0, 499, 0, 2120, 329, 2085, 0, 497, 441,
+	354, 0, 0, 0, 1978, 2094, 1999, 2063, 1961, 2034,
+	1894, 2017, 2115, 1940, 2026, 2116, 0, 0, 0, 0,
+	0, 0, 1201, 0, 2397, 0, 2398, 0, 0, 0,
+	0, 0, 2022, 2107, 1933, 0, 1973, 2031, 2136, 1865,
+	2018, 0, 1870, 1881, 2130, 2100, 1924, 1925, 278, 0,
+	0, 0, 0, 0, 0, 0, 1976, 2000, 2052, 1958,
+	0, 0, 489, 2037, 2047, 2066, 1950, 391, 298, 0,
+	0, 0, 0, 0, 0, 3413, 0, 1919, 0, 2015,
+	0, 0, 0, 0, 1886, 1872, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1972, 0,
+	0, 0, 1893, 1863, 1921, 2054, 1864, 1861, 355, 1882,
+	2068, 2098, 1959, 316, 0, 2104, 1957, 1956, 2041, 1887,
+	2078, 2128, 1943, 326, 1885, 208, 1880, 1888, 1941, 368,
+	2051, 2059, 0, 211, 328, 2075, 1915, 1932, 260, 0,
+	407, 2027, 488, 0, 293, 0, 2008, 406, 330, 480,
+	2042, 2106, 487, 1944, 461, 498, 504, 286, 1984, 249,
+	437, 276, 269, 1923, 2065, 1869, 299, 390, 264, 321,
+	1962, 2033, 1916, 256, 2045, 2016, 2080, 436, 477, 214,
+	349, 478, 503, 0, 287, 428, 288, 460, 279, 250,
+	393, 229, 319, 0, 0, 270, 314, 0, 0, 506,
+	496, 240, 289, 401, 405, 382, 236, 468, 350, 360,
+	253, 255, 254, 230, 429, 475, 243, 258, 2076, 2058,
+	2082, 1909, 1889, 1900, 1890, 1931, 2108, 308, 300, 2083,
+	2081, 1934, 376, 239, 1997, 1990, 1977, 2055, 492, 2132,
+	272, 2060, 420, 213, 447, 494, 0, 422, 421, 1947,
+	307, 2062, 0, 0, 400, 0, 317, 218, 2087, 508,
+	235, 324, 469, 0, 292, 367, 2029, 377, 210, 395,
+	344, 346, 343, 347, 297, 0, 0, 2057, 397, 425,
+	474, 237, 444, 0, 0, 0, 413, 0, 0, 2114,
+	337, 281, 285, 301, 312, 224, 2028, 404, 445, 495,
+	2019, 232, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 448, 465, 415, 295, 336, 338,
+	450, 451, 456, 452, 453, 449, 455, 454, 411, 412,
+	322, 457, 222, 459, 486, 244, 423, 427, 507, 2053,
+	231, 252, 446, 225, 2102, 2077, 2010, 1965, 1971, 1871,
+	0, 223, 1966, 1967, 1968, 1969, 1970, 2049, 1920, 1936,
+	1908, 2023, 1907, 296, 2040, 483, 484, 261, 1883, 2122,
+	226, 1884, 2121, 364, 371, 363, 2125, 2123, 479, 2103,
+	2011, 1996, 1994, 1876, 2101, 2009, 1995, 325, 284, 303,
+	388, 332, 389, 304, 358, 357, 359, 334, 1998, 443,
+	335, 0, 220, 0, 442, 2112, 2138, 458, 241, 1902,
+	2069, 473, 0, 396, 242, 294, 282, 387, 362, 233,
+	306, 439, 323, 331, 2044, 2135, 375, 408, 248, 490,
+	438, 277, 1898, 0, 1901, 1896, 1899, 1897, 2002, 2003,
+	2117, 2118, 2119, 2056, 1891, 0, 0, 2095, 2096, 0,
+	1989, 2105, 1877, 0, 2073, 0, 0, 0, 0, 2036,
+	2133, 1949, 257, 0, 1873, 1874, 1875, 0, 1979, 1980,
+	0, 0, 2091, 2090, 2089, 2092, 0, 2126, 2124, 2127,
+	1892, 1913, 1935, 1985, 1986, 1988, 2020, 2021, 2067, 2039,
+	2048, 1922, 1981, 384, 221, 234, 245, 246, 247, 271,
+	268, 266, 275, 283, 0, 0, 309, 318, 0, 333,
+	352, 345, 381, 348, 0, 0, 0, 383, 402, 426,
+	432, 433, 462, 463, 464, 466, 470, 471, 472, 0,
+	500, 0, 392, 263, 2007, 212, 227, 327, 2134, 399,
+	291, 351, 430, 353, 313, 262, 505, 356, 398, 509,
+	2070, 2014, 0, 1953, 1955, 1954, 1904, 1906, 1905, 1903,
+	2137, 361, 2097, 1860, 1868, 1895, 1911, 1918, 1926, 1937,
+	1938, 1946, 1952, 1964, 1974, 1975, 1991, 2005, 2006, 2013,
+	2043, 2046, 2064, 2072, 2079, 2084, 2086, 493, 267, 1987,
+	2012, 2050, 228, 238, 251, 265, 280, 0, 290, 302,
+	305, 310, 311, 315, 320, 339, 340, 341, 342, 365,
+	366, 369, 370, 373, 374, 378, 379, 380, 385, 386,
+	394, 0, 403, 414, 416, 417, 418, 419, 431, 434,
+	435, 481, 482, 501, 502, 1960, 424, 440, 0, 209,
+	0, 216, 0, 217, 219, 1945, 215, 2093, 2129, 476,
+	485, 2024, 2038, 1929, 2109, 0, 2071, 467, 1963, 2113,
+	1912, 1942, 2131, 1948, 1951, 2032, 1878, 2001, 372, 1939,
+	1879, 2061, 1862, 1917, 1866, 1930, 1867, 1914, 274, 1910,
+	2074, 2004, 2111, 1983, 2025, 2035, 273, 259, 1993, 1992,
+	2099, 1928, 1927, 2030, 2088, 2110, 1982, 0, 0, 499,
+	0, 2120, 329, 2085, 0, 497, 441, 354, 0, 0,
+	0, 1978, 2094, 1999, 2063, 1961, 2034, 1894, 2017, 2115,
+	1940, 2026, 2116, 0, 0, 0, 0, 0, 0, 1201,
+	0, 2397, 0, 2398, 0, 0, 0, 0, 0, 2022,
+	2107, 1933, 0, 1973, 2031, 2136, 1865, 2018, 0, 1870,
+	1881, 2130, 2100, 1924, 1925, 278, 0, 0, 0, 0,
+	0, 0, 0, 1976, 2000, 2052, 1958, 0, 0, 489,
+	2037, 2047, 2066, 1950, 391, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 1919, 0, 2015, 0, 0, 0,
+	0, 1886, 1872, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1972, 0, 0, 0, 1893,
+	1863, 1921, 2054, 1864, 1861, 355, 1882, 2068, 2098, 1959,
+	316, 0, 2104, 1957, 1956, 2041, 1887, 2078, 2128, 1943,
+	326, 1885, 208, 1880, 1888, 1941, 368, 2051, 2059, 0,
+	211, 328, 2075, 1915, 1932, 260, 0, 407, 2027, 488,
+	0, 293, 0, 2008, 406, 330, 480, 2042, 2106, 487,
+	1944, 461, 498, 504, 286, 1984, 249, 437, 276, 269,
+	1923, 2065, 1869, 299, 390, 264, 321, 1962, 2033, 1916,
+	256, 2045, 2016, 2080, 436, 477, 214, 349, 478, 503,
+	0, 287, 428, 288, 460, 279, 250, 393, 229, 319,
+	0, 0, 270, 314, 0, 0, 506, 496, 240, 289,
+	401, 405, 382, 236, 468, 350, 360, 253, 255, 254,
+	230, 429, 475, 243, 258, 2076, 2058, 2082, 1909, 1889,
+	1900, 1890, 1931, 2108, 308, 300, 2083, 2081, 1934, 376,
+	239, 1997, 1990, 1977, 2055, 492, 2132, 272, 2060, 420,
+	213, 447, 494, 0, 422, 421, 1947, 307, 2062, 0,
+	0, 400, 0, 317, 218, 2087, 508, 235, 324, 469,
+	0, 292, 367, 2029, 377, 210, 395, 344, 346, 343,
+	347, 297, 0, 0, 2057, 397, 425, 474, 237, 444,
+	0, 0, 0, 413, 0, 0, 2114, 337, 281, 285,
+	301, 312, 224, 2028, 404, 445, 495, 2019, 232, 491,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 448, 465, 415, 295, 336, 338, 450, 451, 456,
+	452, 453, 449, 455, 454, 411, 412, 322, 457, 222,
+	459, 486, 244, 423, 427, 507, 2053, 231, 252, 446,
+	225, 2102, 2077, 2010, 1965, 1971, 1871, 0, 223, 1966,
+	1967, 1968, 1969, 1970, 2049, 1920, 1936, 1908, 2023, 1907,
+	296, 2040, 483, 484, 261, 1883, 2122, 226, 1884, 2121,
+	364, 371, 363, 2125, 2123, 479, 2103, 2011, 1996, 1994,
+	1876, 2101, 2009, 1995, 325, 284, 303, 388, 332, 389,
+	304, 358, 357, 359, 334, 1998, 443, 335, 0, 220,
+	0, 442, 2112, 2138, 458, 241, 1902, 2069, 473, 0,
+	396, 242, 294, 282, 387, 362, 233, 306, 439, 323,
+	331, 2044, 2135, 375, 408, 248, 490, 438, 277, 1898,
+	0, 1901, 1896, 1899, 1897, 2002, 2003, 2117, 2118, 2119,
+	2056, 1891, 0, 0, 2095, 2096, 0, 1989, 2105, 1877,
+	0, 2073, 0, 0, 0, 0, 2036, 2133, 1949, 257,
+	0, 1873, 1874, 1875, 0, 1979, 1980, 0, 0, 2091,
+	2090, 2089, 2092, 0, 2126, 2124, 2127, 1892, 1913, 1935,
+	1985, 1986, 1988, 2020, 2021, 2067, 2039, 2048, 1922, 1981,
+	384, 221, 234, 245, 246, 247, 271, 268, 266, 275,
+	283, 0, 0, 309, 318, 0, 333, 352, 345, 381,
+	348, 0, 0, 0, 383, 402, 426, 432, 433, 462,
+	463, 464, 466, 470, 471, 472, 0, 500, 0, 392,
+	263, 2007, 212, 227, 327, 2134, 399, 291, 351, 430,
+	353, 313, 262, 505, 356, 398, 509, 2070, 2014, 0,
+	1953, 1955, 1954, 1904, 1906, 1905, 1903, 2137, 361, 2097,
+	1860, 1868, 1895, 1911, 1918, 1926, 1937, 1938, 1946, 1952,
+	1964, 1974, 1975, 1991, 2005, 2006, 2013, 2043, 2046, 2064,
+	2072, 2079, 2084, 2086, 493, 267, 1987, 2012, 2050, 228,
+	238, 251, 265, 280, 0, 290, 302, 305, 310, 311,
+	315, 320, 339, 340, 341, 342, 365, 366, 369, 370,
+	373, 374, 378, 379, 380, 385, 386, 394, 0, 403,
+	414, 416, 417, 418, 419, 431, 434, 435, 481, 482,
+	501, 502, 1960, 424, 440, 0, 209, 0, 216, 0,
+	217, 219, 1945, 215, 2093, 2129, 476, 485, 2024, 2038,
+	1929, 2109, 0, 2071, 467, 1963, 2113, 1912, 1942, 2131,
+	1948, 1951, 2032, 1878, 2001, 372, 1939, 1879, 2061, 1862,
+	1917, 1866, 1930, 1867, 1914, 274, 1910, 2074, 2004, 2111,
+	1983, 2025, 2035, 273, 259, 1993, 1992, 2099, 1928, 1927,
+	2030, 2088, 2110, 1982, 0, 0, 499, 0, 2120, 329,
+	2085, 0, 497, 441, 354, 0, 0, 0, 1978, 2094,
+	1999, 2063, 1961, 2034, 1894, 2017, 2115, 1940, 2026, 2116,
+	0, 0, 0, 0, 0, 4268, 3356, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2022, 2107, 1933, 0,
+	1973, 2031, 2136, 1865, 2018, 0, 1870, 1881, 2130, 2100,
+	1924, 1925, 278, 0, 0, 0, 0, 0, 0, 0,
+	1976, 2000, 2052, 1958, 0, 0, 489, 2037, 2047, 2066,
+	1950, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 1919, 0, 2015, 0, 0, 0, 0, 1886, 1872,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1972, 0, 0, 0, 1893, 1863, 1921, 2054,
+	1864, 1861, 355, 1882, 2068, 2098, 1959, 316, 0, 2104,
+	1957, 1956, 2041, 1887, 2078, 2128, 1943, 326, 1885, 208,
+	1880, 1888, 1941, 368, 2051, 2059, 0, 211, 328, 2075,
+	1915, 1932, 260, 0, 407, 2027, 488, 0, 293, 0,
+	2008, 406, 330, 480, 2042, 2106, 487, 1944, 461, 498,
+	504, 286, 1984, 249, 437, 276, 269, 1923, 2065, 1869,
+	299, 390, 264, 321, 1962, 2033, 1916, 256, 2045, 2016,
+	2080, 436, 477, 214, 349, 478, 503, 0, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 2076, 2058, 2082, 1909, 1889, 1900, 1890, 1931,
+	2108, 308, 300, 2083, 2081, 1934, 376, 239, 1997, 1990,
+	1977, 2055, 492, 2132, 272, 2060, 420, 213, 447, 494,
+	0, 422, 421, 1947, 307, 2062, 0, 0, 400, 0,
+	317, 218, 2087, 508, 235, 324, 469, 0, 292, 367,
+	2029, 377, 210, 395, 344, 346, 343, 347, 297, 0,
+	0, 2057, 397, 425, 474, 237, 444, 0, 0, 0,
+	413, 0, 0, 2114, 337, 281, 285, 301, 312, 224,
+	2028, 404, 445, 495, 2019, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 2053, 231, 252, 446, 225, 2102, 2077,
+	2010, 1965, 1971, 1871, 0, 223, 1966, 1967, 1968, 1969,
+	1970, 2049, 1920, 1936, 1908, 2023, 1907, 296, 2040, 483,
+	484, 261, 1883, 2122, 226, 1884, 2121, 364, 371, 363,
+	2125, 2123, 479, 2103, 2011, 1996, 1994, 1876, 2101, 2009,
+	1995, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 1998, 443, 335, 0, 220, 0, 442, 2112,
+	2138, 458, 241, 1902, 2069, 473, 0, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 2044, 2135,
+	375, 408, 248, 490, 438, 277, 1898, 0, 1901, 1896,
+	1899, 1897, 2002, 2003, 2117, 2118, 2119, 2056, 1891, 0,
+	0, 2095, 2096, 0, 1989, 2105, 1877, 0, 2073, 0,
+	0, 0, 0, 2036, 2133, 1949, 257, 0, 1873, 1874,
+	1875, 0, 1979, 1980, 0, 0, 2091, 2090, 2089, 2092,
+	0, 2126, 2124, 2127, 1892, 1913, 1935, 1985, 1986, 1988,
+	2020, 2021, 2067, 2039, 2048, 1922, 1981, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 2007, 212,
+	227, 327, 2134, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 2070, 2014, 0, 1953, 1955, 1954,
+	1904, 1906, 1905, 1903, 2137, 361, 2097, 1860, 1868, 1895,
+	1911, 1918, 1926, 1937, 1938, 1946, 1952, 1964, 1974, 1975,
+	1991, 2005, 2006, 2013, 2043, 2046, 2064, 2072, 2079, 2084,
+	2086, 493, 267, 1987, 2012, 2050, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 0, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 1960,
+	424, 440, 0, 209, 0, 216, 0, 217, 219, 1945,
+	215, 2093, 2129, 476, 485, 2024, 2038, 1929, 2109, 0,
+	2071, 467, 1963, 2113, 1912, 1942, 2131, 1948, 1951, 2032,
+	1878, 2001, 372, 1939, 1879, 2061, 1862, 1917, 1866, 1930,
+	1867, 1914, 274, 1910, 2074, 2004, 2111, 1983, 2025, 2035,
+	273, 259, 1993, 1992, 2099, 1928, 1927, 2030, 2088, 2110,
+	1982, 0, 0, 499, 0, 2120, 329, 2085, 0, 497,
+	441, 354, 0, 0, 0, 1978, 2094, 1999, 2063, 1961,
+	2034, 1894, 2017, 2115, 1940, 2026, 2116, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2022, 2107, 1933, 0, 1973, 2031, 2136,
+	1865, 2018, 0, 1870, 1881, 2130, 2100, 1924, 1925, 278,
+	0, 0, 0, 0, 0, 0, 0, 1976, 2000, 2052,
+	1958, 0, 0, 489, 2037, 2047, 2066, 1950, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 1919, 0,
+	2015, 0, 0, 0, 0, 1886, 1872, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1972,
+	0, 0, 0, 1893, 1863, 1921, 2054, 1864, 1861, 355,
+	1882, 2068, 2098, 1959, 316, 0, 2104, 1957, 1956, 2041,
+	1887, 2078, 2128, 1943, 326, 1885, 208, 1880, 1888, 1941,
+	368, 2051, 2059, 0, 211, 328, 2075, 1915, 1932, 260,
+	0, 407, 2027, 488, 0, 293, 0, 2008, 406, 330,
+	480, 2042, 2106, 487, 1944, 461, 498, 504, 286, 1984,
+	249, 437, 276, 269, 1923, 2065, 1869, 299, 390, 264,
+	321, 1962, 2033, 1916, 256, 2045, 2016, 2080, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 2076,
+	2058, 2082, 1909, 1889, 1900, 1890, 1931, 2108, 308, 300,
+	2083, 2081, 1934, 376, 239, 1997, 1990, 1977, 2055, 492,
+	2132, 272, 2060, 420, 213, 447, 494, 0, 422, 421,
+	1947, 307, 2062, 0, 0, 400, 0, 317, 218, 2087,
+	508, 235, 324, 469, 0, 292, 367, 2029, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 2057, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	2114, 337, 281, 285, 301, 312, 224, 2028, 404, 445,
+	495, 2019, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	2053, 231, 252, 446, 225, 2102, 2077, 2010, 1965, 1971,
+	1871, 0, 223, 1966, 1967, 1968, 1969, 1970, 2049, 1920,
+	1936, 1908, 2023, 1907, 296, 2040, 483, 484, 261, 1883,
+	2122, 226, 1884, 2121, 364, 371, 363, 2125, 2123, 479,
+	2103, 2011, 1996, 1994, 1876, 2101, 2009, 1995, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 1998,
+	443, 335, 0, 220, 0, 442, 2112, 2138, 458, 241,
+	1902, 2069, 473, 2445, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 2044, 2135, 375, 408, 248,
+	490, 438, 277, 1898, 0, 1901, 1896, 1899, 1897, 2002,
+	2003, 2117, 2118, 2119, 2056, 1891, 0, 0, 2095, 2096,
+	0, 1989, 2105, 1877, 0, 2073, 0, 0, 0, 0,
+	2036, 2133, 1949, 257, 0, 1873, 1874, 1875, 0, 1979,
+	1980, 0, 0, 2091, 2090, 2089, 2092, 0, 2126, 2124,
+	2127, 1892, 1913, 1935, 1985, 1986, 1988, 2020, 2021, 2067,
+	2039, 2048, 1922, 1981, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 2007, 212, 227, 327, 2134,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 2070, 2014, 0, 1953, 1955, 1954, 1904, 1906, 1905,
+	1903, 2137, 361, 2097, 1860, 1868, 1895, 1911, 1918, 1926,
+	1937, 1938, 1946, 1952, 1964, 1974, 1975, 1991, 2005, 2006,
+	2013, 2043, 2046, 2064, 2072, 2079, 2084, 2086, 493, 267,
+	1987, 2012, 2050, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 1960, 424, 440, 0,
+	209, 0, 216, 0, 217, 219, 1945, 215, 2093, 2129,
+	476, 485, 2024, 2038, 1929, 2109, 0, 2071, 467, 1963,
+	2113, 1912, 1942, 2131, 1948, 1951, 2032, 1878, 2001, 372,
+	1939, 1879, 2061, 1862, 1917, 1866, 1930, 1867, 1914, 274,
+	1910, 2074, 2004, 2111, 1983, 2025, 2035, 273, 259, 1993,
+	1992, 2099, 1928, 1927, 2030, 2088, 2110, 1982, 0, 0,
+	499, 0, 2120, 329, 2085, 0, 497, 441, 354, 0,
+	0, 0, 1978, 2094, 1999, 2063, 1961, 2034, 1894, 2017,
+	2115, 1940, 2026, 2116, 0, 0, 0, 0, 0, 0,
+	1201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2022, 2107, 1933, 0, 1973, 2031, 2136, 1865, 2018, 0,
+	1870, 1881, 2130, 2100, 1924, 1925, 278, 0, 0, 0,
+	0, 0, 0, 0, 1976, 2000, 2052, 1958, 0, 0,
+	489, 2037, 2047, 2066, 1950, 391, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 1919, 0, 2015, 0, 0,
+	0, 0, 1886, 1872, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1972, 0, 0, 0,
+	1893, 1863, 1921, 2054, 1864, 1861, 355, 1882, 2068, 2098,
+	1959, 316, 0, 2104, 1957, 1956, 2041, 1887, 2078, 2128,
+	1943, 326, 1885, 208, 1880, 1888, 1941, 368, 2051, 2059,
+	0, 211, 328, 2075, 1915, 1932, 260, 0, 407, 2027,
+	488, 0, 293, 0, 2008, 406, 330, 480, 2042, 2106,
+	487, 1944, 461, 498, 504, 286, 1984, 249, 437, 276,
+	269, 1923, 2065, 1869, 299, 390, 264, 321, 1962, 2033,
+	1916, 256, 2045, 2016, 2080, 436, 477, 214, 349, 478,
+	503, 0, 287, 428, 288, 460, 279, 250, 393, 229,
+	319, 0, 0, 270, 314, 0, 0, 506, 496, 240,
+	289, 401, 405, 382, 236, 468, 350, 360, 253, 255,
+	254, 230, 429, 475, 243, 258, 2076, 2058, 2082, 1909,
+	1889, 1900, 1890, 1931, 2108, 308, 300, 2083, 2081, 1934,
+	376, 239, 1997, 1990, 1977, 2055, 492, 2132, 272, 2060,
+	420, 213, 447, 494, 0, 422, 421, 1947, 307, 2062,
+	0, 0, 400, 0, 317, 218, 2087, 508, 235, 324,
+	469, 0, 292, 367, 2029, 377, 210, 395, 344, 346,
+	343, 347, 297, 0, 0, 2057, 397, 425, 474, 237,
+	444, 0, 0, 0, 413, 0, 0, 2114, 337, 281,
+	285, 301, 312, 224, 2028, 404, 445, 495, 2019, 232,
+	491, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 448, 465, 415, 295, 336, 338, 450, 451,
+	456, 452, 453, 449, 455, 454, 411, 412, 322, 457,
+	222, 459, 486, 244, 423, 427, 507, 2053, 231, 252,
+	446, 225, 2102, 2077, 2010, 1965, 1971, 1871, 0, 223,
+	1966, 1967, 1968, 1969, 1970, 2049, 1920, 1936, 1908, 2023,
+	1907, 296, 2040, 483, 484, 261, 1883, 2122, 226, 1884,
+	2121, 364, 371, 363, 2125, 2123, 479, 2103, 2011, 1996,
+	1994, 1876, 2101, 2009, 1995, 325, 284, 303, 388, 332,
+	389, 304, 358, 357, 359, 334, 1998, 443, 335, 0,
+	220, 0, 442, 2112, 2138, 458, 241, 1902, 2069, 473,
+	0, 396, 242, 294, 282, 387, 362, 233, 306, 439,
+	323, 331, 2044, 2135, 375, 408, 248, 490, 438, 277,
+	1898, 0, 1901, 1896, 1899, 1897, 2002, 2003, 2117, 2118,
+	2119, 2056, 1891, 0, 0, 2095, 2096, 0, 1989, 2105,
+	1877, 0, 2073, 0, 0, 0, 0, 2036, 2133, 1949,
+	257, 0, 1873, 1874, 1875, 0, 1979, 1980, 0, 0,
+	2091, 2090, 2089, 2092, 0, 2126, 2124, 2127, 1892, 1913,
+	1935, 1985, 1986, 1988, 2020, 2021, 2067, 2039, 2048, 1922,
+	1981, 384, 221, 234, 245, 246, 247, 271, 268, 266,
+	275, 283, 0, 0, 309, 318, 0, 333, 352, 345,
+	381, 348, 0, 0, 0, 383, 402, 426, 432, 433,
+	462, 463, 464, 466, 470, 471, 472, 0, 500, 0,
+	392, 263, 2007, 212, 227, 327, 2134, 399, 291, 351,
+	430, 353, 313, 262, 505, 356, 398, 509, 2070, 2014,
+	0, 1953, 1955, 1954, 1904, 1906, 1905, 1903, 2137, 361,
+	2097, 1860, 1868, 1895, 1911, 1918, 1926, 1937, 1938, 1946,
+	1952, 1964, 1974, 1975, 1991, 2005, 2006, 2013, 2043, 2046,
+	2064, 2072, 2079, 2084, 2086, 493, 267, 1987, 2012, 2050,
+	228, 238, 251, 265, 280, 0, 290, 302, 305, 310,
+	311, 315, 320, 339, 340, 341, 342, 4384, 366, 369,
+	370, 373, 374, 378, 379, 380, 385, 386, 394, 0,
+	403, 414, 416, 417, 418, 419, 431, 434, 435, 481,
+	482, 501, 502, 1960, 424, 440, 0, 209, 0, 216,
+	0, 217, 219, 1945, 215, 2093, 2129, 476, 485, 2024,
+	2038, 1929, 2109, 0, 2071, 467, 1963, 2113, 1912, 1942,
+	2131, 1948, 1951, 2032, 1878, 2001, 372, 1939, 1879, 2061,
+	1862, 1917, 1866, 1930, 1867, 1914, 274, 1910, 2074, 2004,
+	2111, 1983, 2025, 2035, 273, 259, 1993, 1992, 2099, 1928,
+	1927, 2030, 2088, 2110, 1982, 0, 0, 499, 0, 2120,
+	329, 2085, 0, 497, 441, 354, 0, 0, 0, 1978,
+	2094, 1999, 2063, 1961, 2034, 1894, 2017, 2115, 1940, 2026,
+	2116, 0, 0, 0, 0, 0, 0, 3356, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2022, 2107, 1933,
+	0, 1973, 2031, 2136, 1865, 2018, 0, 1870, 1881, 2130,
+	2100, 1924, 1925, 278, 0, 0, 0, 0, 0, 0,
+	0, 1976, 2000, 2052, 1958, 0, 0, 489, 2037, 2047,
+	2066, 1950, 391, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 1919, 0, 2015, 0, 0, 0, 0, 1886,
+	1872, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1972, 0, 0, 0, 1893, 1863, 1921,
+	2054, 1864, 1861, 355, 1882, 2068, 2098, 1959, 316, 0,
+	2104, 1957, 1956, 2041, 1887, 2078, 2128, 1943, 326, 1885,
+	208, 1880, 1888, 1941, 368, 2051, 2059, 0, 211, 328,
+	2075, 1915, 1932, 260, 0, 407, 2027, 488, 0, 293,
+	0, 2008, 406, 330, 480, 2042, 2106, 487, 1944, 461,
+	498, 504, 286, 1984, 249, 437, 276, 269, 1923, 2065,
+	1869, 299, 390, 264, 321, 1962, 2033, 1916, 256, 2045,
+	2016, 2080, 436, 477, 214, 349, 478, 503, 0, 287,
+	428, 288, 460, 279, 250, 393, 229, 319, 0, 0,
+	270, 314, 0, 0, 506, 496, 240, 289, 401, 405,
+	382, 236, 468, 350, 360, 253, 255, 254, 230, 429,
+	475, 243, 258, 2076, 2058, 2082, 1909, 1889, 1900, 1890,
+	1931, 2108, 308, 300, 2083, 2081, 1934, 376, 239, 1997,
+	1990, 1977, 2055, 492, 2132, 272, 2060, 420, 213, 447,
+	494, 0, 422, 421, 1947, 307, 2062, 0, 0, 400,
+	0, 317, 218, 2087, 508, 235, 324, 469, 0, 292,
+	367, 2029, 377, 210, 395, 344, 346, 343, 347, 297,
+	0, 0, 2057, 397, 425, 474, 237, 444, 0, 0,
+	0, 413, 0, 0, 2114, 337, 281, 285, 301, 312,
+	224, 2028, 404, 445, 495, 2019, 232, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 448,
+	465, 415, 295, 336, 338, 450, 451, 456, 452, 453,
+	449, 455, 454, 411, 412, 322, 457, 222, 459, 486,
+	244, 423, 427, 507, 2053, 231, 252, 446, 225, 2102,
+	2077, 2010, 1965, 1971, 1871, 0, 223, 1966, 1967, 1968,
+	1969, 1970, 2049, 1920, 1936, 1908, 2023, 1907, 296, 2040,
+	483, 484, 261, 1883, 2122, 226, 1884, 2121, 364, 371,
+	363, 2125, 2123, 479, 2103, 2011, 1996, 1994, 1876, 2101,
+	2009, 1995, 325, 284, 303, 388, 332, 389, 304, 358,
+	357, 359, 334, 1998, 443, 335, 0, 220, 0, 442,
+	2112, 2138, 458, 241, 1902, 2069, 473, 0, 396, 242,
+	294, 282, 387, 362, 233, 306, 439, 323, 331, 2044,
+	2135, 375, 408, 248, 490, 438, 277, 1898, 0, 1901,
+	1896, 1899, 1897, 2002, 2003, 2117, 2118, 2119, 2056, 1891,
+	0, 0, 2095, 2096, 0, 1989, 2105, 1877, 0, 2073,
+	0, 0, 0, 0, 2036, 2133, 1949, 257, 0, 1873,
+	1874, 1875, 0, 1979, 1980, 0, 0, 2091, 2090, 2089,
+	2092, 0, 2126, 2124, 2127, 1892, 1913, 1935, 1985, 1986,
+	1988, 2020, 2021, 2067, 2039, 2048, 1922, 1981, 384, 221,
+	234, 245, 246, 247, 271, 268, 266, 275, 283, 0,
+	0, 309, 318, 0, 333, 352, 345, 381, 348, 0,
+	0, 0, 383, 402, 426, 432, 433, 462, 463, 464,
+	466, 470, 471, 472, 0, 500, 0, 392, 263, 2007,
+	212, 227, 327, 2134, 399, 291, 351, 430, 353, 313,
+	262, 505, 356, 398, 509, 2070, 2014, 0, 1953, 1955,
+	1954, 1904, 1906, 1905, 1903, 2137, 361, 2097, 1860, 1868,
+	1895, 1911, 1918, 1926, 1937, 1938, 1946, 1952, 1964, 1974,
+	1975, 1991, 2005, 2006, 2013, 2043, 2046, 2064, 2072, 2079,
+	2084, 2086, 493, 267, 1987, 2012, 2050, 228, 238, 251,
+	265, 280, 0, 290, 302, 305, 310, 311, 315, 320,
+	339, 340, 341, 342, 365, 366, 369, 370, 373, 374,
+	378, 379, 380, 385, 386, 394, 0, 403, 414, 416,
+	417, 418, 419, 431, 434, 435, 481, 482, 501, 502,
+	1960, 424, 440, 0, 209, 0, 216, 0, 217, 219,
+	1945, 215, 2093, 2129, 476, 485, 2024, 2038, 1929, 2109,
+	0, 2071, 467, 1963, 2113, 1912, 1942, 2131, 1948, 1951,
+	2032, 1878, 2001, 372, 1939, 1879, 2061, 1862, 1917, 1866,
+	1930, 1867, 1914, 274, 1910, 2074, 2004, 2111, 1983, 2025,
+	2035, 273, 259, 1993, 1992, 2099, 1928, 1927, 2030, 2088,
+	2110, 1982, 0, 0, 499, 0, 2120, 329, 2085, 0,
+	497, 441, 354, 0, 0, 0, 1978, 2094, 1999, 2063,
+	1961, 2034, 1894, 2017, 2115, 1940, 2026, 2116, 0, 0,
+	0, 0, 0, 0, 1201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2022, 2107, 1933, 0, 1973, 2031,
+	2136, 1865, 2018, 0, 1870, 1881, 2130, 2100, 1924, 1925,
+	278, 0, 0, 0, 0, 0, 0, 0, 1976, 2000,
+	2052, 1958, 0, 0, 489, 2037, 2047, 2066, 1950, 391,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 1919,
+	0, 2015, 0, 0, 0, 0, 1886, 1872, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1972, 0, 0, 0, 1893, 1863, 1921, 2054, 1864, 1861,
+	355, 1882, 2068, 2098, 1959, 316, 0, 2104, 1957, 1956,
+	2041, 1887, 2078, 2128, 1943, 326, 1885, 208, 1880, 1888,
+	1941, 368, 2051, 2059, 0, 211, 328, 2075, 1915, 1932,
+	260, 0, 407, 2027, 488, 0, 293, 0, 2008, 406,
+	330, 480, 2042, 2106, 487, 1944, 461, 498, 504, 286,
+	1984, 249, 437, 276, 269, 1923, 2065, 1869, 299, 390,
+	264, 321, 1962, 2033, 1916, 256, 2045, 2016, 2080, 436,
+	477, 214, 349, 478, 503, 0, 287, 428, 288, 460,
+	279, 250, 393, 229, 319, 0, 0, 270, 314, 0,
+	0, 506, 496, 240, 289, 401, 405, 382, 236, 468,
+	350, 360, 253, 255, 254, 230, 429, 475, 243, 258,
+	2076, 2058, 2082, 1909, 1889, 1900, 1890, 1931, 2108, 308,
+	300, 2083, 2081, 1934, 376, 239, 1997, 1990, 1977, 2055,
+	492, 2132, 272, 2060, 420, 213, 447, 494, 0, 422,
+	421, 1947, 307, 2062, 0, 0, 400, 0, 317, 218,
+	2087, 508, 235, 324, 469, 0, 292, 367, 2029, 377,
+	210, 395, 344, 346, 343, 347, 297, 0, 0, 2057,
+	397, 425, 474, 237, 444, 0, 0, 0, 413, 0,
+	0, 2114, 337, 281, 285, 301, 312, 224, 2028, 404,
+	445, 495, 2019, 232, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 448, 465, 415, 295,
+	336, 338, 450, 451, 456, 452, 453, 449, 455, 454,
+	411, 412, 322, 457, 222, 459, 486, 244, 423, 427,
+	507, 2053, 231, 252, 446, 225, 2102, 2077, 2010, 1965,
+	1971, 1871, 0, 223, 1966, 1967, 1968, 1969, 1970, 2049,
+	1920, 1936, 1908, 2023, 1907, 296, 2040, 483, 484, 261,
+	1883, 2122, 226, 1884, 2121, 364, 371, 363, 2125, 2123,
+	479, 2103, 2011, 1996, 1994, 1876, 2101, 2009, 1995, 325,
+	284, 303, 388, 332, 389, 304, 358, 357, 359, 334,
+	1998, 443, 335, 0, 220, 0, 442, 2112, 2138, 458,
+	241, 1902, 2069, 473, 0, 396, 242, 294, 282, 387,
+	362, 233, 306, 439, 323, 331, 2044, 2135, 375, 408,
+	248, 490, 438, 277, 1898, 0, 1901, 1896, 1899, 1897,
+	2002, 2003, 2117, 2118, 2119, 2056, 1891, 0, 0, 2095,
+	2096, 0, 1989, 2105, 1877, 0, 2073, 0, 0, 0,
+	0, 2036, 2133, 1949, 257, 0, 1873, 1874, 1875, 0,
+	1979, 1980, 0, 0, 2091, 2090, 2089, 2092, 0, 2126,
+	2124, 2127, 1892, 1913, 1935, 1985, 1986, 1988, 2020, 2021,
+	2067, 2039, 2048, 1922, 1981, 384, 221, 234, 245, 246,
+	247, 271, 268, 266, 275, 283, 0, 0, 309, 318,
+	0, 333, 352, 345, 381, 348, 0, 0, 0, 383,
+	402, 426, 432, 433, 462, 463, 464, 466, 470, 471,
+	472, 0, 500, 0, 392, 263, 2007, 212, 227, 327,
+	2134, 399, 291, 351, 430, 353, 313, 262, 505, 356,
+	398, 509, 2070, 2014, 0, 1953, 1955, 1954, 1904, 1906,
+	1905, 1903, 2137, 361, 2097, 1860, 1868, 1895, 1911, 1918,
+	1926, 1937, 1938, 1946, 1952, 1964, 1974, 1975, 1991, 2005,
+	2006, 2013, 2043, 2046, 2064, 2072, 2079, 2084, 2086, 493,
+	267, 1987, 2012, 2050, 228, 238, 251, 265, 280, 0,
+	290, 302, 305, 310, 311, 315, 320, 339, 340, 341,
+	342, 365, 366, 369, 370, 373, 374, 378, 379, 380,
+	385, 386, 394, 0, 403, 414, 416, 417, 418, 419,
+	431, 434, 435, 481, 482, 501, 502, 1960, 424, 440,
+	0, 209, 0, 216, 0, 217, 219, 1945, 215, 2093,
+	2129, 476, 485, 2024, 2038, 1929, 2109, 0, 2071, 467,
+	1963, 2113, 1912, 1942, 2131, 1948, 1951, 2032, 1878, 2001,
+	372, 1939, 1879, 2061, 1862, 1917, 1866, 1930, 1867, 1914,
+	274, 1910, 2074, 2004, 2111, 1983, 2025, 2035, 273, 259,
+	1993, 1992, 2099, 1928, 1927, 2030, 2088, 2110, 1982, 0,
+	0, 499, 0, 2120, 329, 2085, 0, 497, 441, 354,
+	0, 0, 0, 1978, 2094, 1999, 2063, 1961, 2034, 1894,
+	2017, 2115, 1940, 2026, 2116, 0, 0, 0, 0, 0,
+	0, 4677, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2022, 2107, 1933, 0, 1973, 2031, 2136, 1865, 2018,
+	0, 1870, 1881, 2130, 2100, 1924, 1925, 278, 0, 0,
+	0, 0, 0, 0, 0, 1976, 2000, 2052, 1958, 0,
+	0, 489, 2037, 2047, 2066, 1950, 391, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 1919, 0, 2015, 0,
+	0, 0, 0, 1886, 1872, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1972, 0, 0,
+	0, 1893, 1863, 1921, 2054, 1864, 1861, 355, 1882, 2068,
+	2098, 1959, 316, 0, 2104, 1957, 1956, 2041, 1887, 2078,
+	2128, 1943, 326, 1885, 208, 1880, 1888, 1941, 368, 2051,
+	2059, 0, 211, 328, 2075, 1915, 1932, 260, 0, 407,
+	2027, 488, 0, 293, 0, 2008, 406, 330, 480, 2042,
+	2106, 487, 1944, 461, 498, 504, 286, 1984, 249, 437,
+	276, 269, 1923, 2065, 1869, 299, 390, 264, 321, 1962,
+	2033, 1916, 256, 2045, 2016, 2080, 436, 477, 214, 349,
+	478, 503, 0, 287, 428, 288, 460, 279, 250, 393,
+	229, 319, 0, 0, 270, 314, 0, 0, 506, 496,
+	240, 289, 401, 405, 382, 236, 468, 350, 360, 253,
+	255, 254, 230, 429, 475, 243, 258, 2076, 2058, 2082,
+	1909, 1889, 1900, 4680, 4681, 4682, 308, 300, 2083, 2081,
+	1934, 376, 239, 1997, 1990, 1977, 2055, 492, 2132, 272,
+	2060, 420, 213, 447, 494, 0, 422, 421, 1947, 307,
+	2062, 0, 0, 400, 0, 317, 218, 2087, 508, 235,
+	324, 469, 0, 292, 367, 2029, 377, 210, 395, 344,
+	346, 343, 347, 297, 0, 0, 2057, 397, 425, 474,
+	237, 444, 0, 0, 0, 413, 0, 0, 2114, 337,
+	281, 285, 301, 312, 224, 2028, 404, 445, 495, 2019,
+	232, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 448, 465, 415, 295, 336, 338, 450,
+	451, 456, 452, 453, 449, 455, 454, 411, 412, 322,
+	457, 222, 459, 486, 244, 423, 427, 507, 2053, 231,
+	252, 446, 225, 2102, 2077, 2010, 1965, 1971, 1871, 0,
+	223, 1966, 1967, 1968, 1969, 1970, 2049, 1920, 1936, 1908,
+	2023, 1907, 296, 2040, 483, 484, 261, 1883, 2122, 226,
+	1884, 2121, 364, 371, 363, 2125, 2123, 479, 2103, 2011,
+	1996, 1994, 1876, 2101, 2009, 1995, 325, 284, 303, 388,
+	332, 389, 304, 358, 357, 359, 334, 1998, 443, 335,
+	0, 220, 0, 442, 2112, 2138, 458, 241, 1902, 2069,
+	473, 0, 396, 242, 294, 282, 387, 362, 233, 306,
+	439, 323, 331, 2044, 2135, 375, 408, 248, 490, 438,
+	277, 1898, 0, 1901, 1896, 1899, 1897, 2002, 2003, 2117,
+	2118, 2119, 2056, 1891, 0, 0, 2095, 2096, 0, 1989,
+	2105, 1877, 0, 2073, 0, 0, 0, 0, 2036, 2133,
+	1949, 257, 0, 1873, 1874, 1875, 0, 1979, 1980, 0,
+	0, 2091, 2090, 2089, 2092, 0, 2126, 2124, 2127, 1892,
+	1913, 1935, 1985, 1986, 1988, 2020, 2021, 2067, 2039, 2048,
+	1922, 1981, 384, 221, 234, 245, 246, 247, 271, 268,
+	266, 275, 283, 0, 0, 309, 318, 0, 333, 352,
+	345, 381, 348, 0, 0, 0, 383, 402, 426, 432,
+	433, 462, 463, 464, 466, 470, 471, 472, 0, 500,
+	0, 392, 263, 2007, 212, 227, 327, 2134, 399, 291,
+	351, 430, 353, 313, 262, 505, 356, 398, 509, 2070,
+	2014, 0, 1953, 1955, 1954, 1904, 1906, 1905, 1903, 2137,
+	361, 2097, 1860, 1868, 1895, 1911, 1918, 1926, 1937, 1938,
+	1946, 1952, 1964, 1974, 1975, 1991, 2005, 2006, 2013, 2043,
+	2046, 2064, 2072, 2079, 2084, 2086, 493, 267, 1987, 2012,
+	2050, 228, 238, 251, 265, 280, 0, 290, 302, 305,
+	310, 311, 315, 320, 339, 340, 341, 342, 365, 366,
+	369, 370, 373, 374, 378, 379, 380, 385, 386, 394,
+	0, 403, 414, 416, 417, 418, 419, 431, 434, 435,
+	481, 482, 501, 502, 1960, 424, 440, 0, 209, 0,
+	216, 0, 217, 219, 1945, 215, 2093, 2129, 476, 485,
+	2024, 2038, 467, 3601, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 372, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 274, 0, 0, 0, 0, 0, 0,
+	0, 273, 259, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 499, 0, 3608, 329, 0, 841,
+	497, 441, 354, 842, 0, 0, 0, 0, 3592, 3593,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 0,
+	0, 0, 0, 759, 583, 760, 762, 763, 764, 765,
+	0, 0, 0, 761, 2437, 3578, 3579, 0, 0, 0,
+	0, 0, 0, 0, 0, 3607, 0, 0, 0, 0,
+	278, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 489, 0, 0, 0, 0, 391,
+	298, 0, 0, 0, 0, 0, 3570, 0, 0, 0,
+	0, 3612, 0, 0, 0, 0, 0, 0, 780, 781,
+	782, 783, 784, 785, 786, 787, 788, 789, 790, 791,
+	792, 793, 794, 795, 796, 797, 798, 799, 800, 801,
+	802, 803, 804, 805, 806, 807, 808, 809, 810, 811,
+	812, 813, 814, 815, 816, 817, 818, 819, 820, 821,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	355, 0, 3596, 0, 0, 316, 0, 0, 0, 3610,
+	0, 0, 0, 0, 0, 326, 0, 208, 0, 0,
+	0, 368, 0, 0, 3606, 211, 328, 0, 0, 0,
+	756, 0, 407, 0, 488, 3594, 293, 0, 0, 406,
+	330, 480, 0, 0, 487, 0, 461, 498, 504, 286,
+	0, 249, 437, 276, 269, 0, 0, 0, 299, 390,
+	264, 321, 0, 0, 0, 256, 0, 0, 0, 436,
+	477, 214, 349, 478, 503, 0, 287, 428, 288, 460,
+	279, 250, 393, 229, 319, 0, 0, 270, 314, 0,
+	0, 506, 496, 240, 289, 401, 405, 382, 236, 468,
+	350, 360, 253, 255, 254, 230, 429, 475, 243, 258,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
+	300, 0, 0, 0, 376, 239, 0, 0, 0, 3609,
+	492, 0, 272, 0, 420, 213, 447, 494, 0, 422,
+	421, 0, 307, 0, 0, 0, 400, 0, 317, 218,
+	0, 508, 235, 324, 469, 0, 292, 367, 0, 377,
+	210, 395, 344, 346, 343, 347, 297, 0, 0, 0,
+	397, 425, 474, 237, 444, 0, 0, 0, 413, 0,
+	0, 0, 337, 281, 285, 301, 312, 224, 0, 404,
+	445, 495, 0, 232, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 448, 465, 415, 295,
+	336, 338, 450, 451, 456, 452, 453, 449, 455, 454,
+	411, 412, 322, 457, 222, 459, 486, 244, 423, 427,
+	507, 0, 231, 252, 446, 225, 0, 0, 0, 0,
+	0, 0, 0, 223, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 0, 757, 758, 261,
+	3599, 0, 226, 0, 0, 364, 371, 363, 0, 0,
+	479, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	284, 303, 388, 332, 389, 304, 358, 357, 359, 334,
+	0, 443, 335, 0, 220, 0, 442, 0, 0, 458,
+	241, 0, 0, 473, 0, 396, 242, 294, 282, 387,
+	362, 233, 306, 439, 323, 331, 0, 0, 375, 408,
+	248, 490, 438, 277, 3614, 1096, 3611, 3580, 3581, 3583,
+	3615, 3616, 3582, 3584, 3585, 3613, 3597, 3598, 3600, 3602,
+	3603, 3604, 0, 0, 0, 836, 0, 3586, 3587, 3588,
+	3589, 0, 0, 3595, 257, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 384, 221, 234, 245, 246,
+	247, 271, 268, 266, 275, 283, 0, 0, 309, 318,
+	0, 333, 352, 345, 381, 348, 0, 0, 0, 383,
+	402, 426, 432, 433, 462, 463, 464, 466, 470, 471,
+	472, 0, 500, 0, 392, 263, 3605, 212, 227, 327,
+	0, 399, 291, 351, 430, 353, 313, 262, 505, 356,
+	398, 509, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 493,
+	267, 0, 0, 0, 228, 238, 251, 265, 280, 0,
+	290, 302, 305, 310, 311, 315, 320, 339, 340, 341,
+	342, 365, 366, 369, 370, 373, 374, 378, 379, 380,
+	385, 386, 394, 0, 403, 414, 416, 417, 418, 419,
+	431, 434, 435, 481, 482, 501, 502, 0, 424, 440,
+	0, 209, 0, 216, 0, 217, 219, 0, 215, 0,
+	0, 476, 485, 615, 467, 609, 620, 602, 0, 0,
+	0, 0, 0, 0, 0, 372, 0, 0, 0, 1410,
+	0, 0, 0, 0, 0, 274, 0, 0, 610, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 499, 0, 0, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 663, 664, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 601, 600, 603, 0,
+	0, 0, 355, 0, 0, 0, 608, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 612, 0, 0, 211, 328, 616,
+	0, 0, 260, 0, 407, 0, 488, 0, 293, 0,
+	0, 406, 330, 480, 0, 619, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 604, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	0, 422, 421, 607, 307, 0, 0, 0, 400, 0,
+	317, 218, 0, 508, 235, 324, 469, 0, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 0,
+	0, 0, 1413, 425, 474, 237, 444, 605, 606, 613,
+	614, 617, 618, 621, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 624, 625, 626,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 0, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 611, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 0, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 615, 467, 609, 620, 602,
+	0, 0, 0, 0, 0, 0, 0, 372, 0, 0,
+	0, 594, 0, 0, 0, 0, 0, 274, 0, 0,
+	610, 0, 0, 0, 0, 273, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 499, 0,
+	0, 329, 0, 0, 497, 441, 354, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 663, 664, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 489, 0,
+	0, 0, 0, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 601, 600,
+	603, 0, 0, 0, 355, 0, 0, 0, 608, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	0, 208, 0, 0, 0, 368, 612, 0, 0, 211,
+	328, 616, 0, 0, 260, 0, 407, 0, 488, 0,
+	293, 0, 0, 406, 330, 480, 0, 619, 487, 0,
+	461, 498, 504, 286, 0, 249, 437, 276, 269, 0,
+	0, 0, 299, 390, 264, 321, 0, 0, 0, 256,
+	0, 0, 0, 436, 477, 214, 349, 478, 503, 604,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 308, 300, 0, 0, 0, 376, 239,
+	0, 0, 0, 0, 492, 0, 272, 0, 420, 213,
+	447, 494, 0, 422, 421, 607, 307, 0, 0, 0,
+	400, 0, 317, 218, 0, 508, 235, 324, 469, 0,
+	292, 367, 0, 377, 210, 395, 344, 346, 343, 347,
+	297, 0, 0, 0, 597, 425, 474, 237, 444, 605,
+	606, 613, 614, 617, 618, 621, 337, 281, 285, 301,
+	312, 224, 0, 404, 445, 495, 0, 232, 491, 624,
+	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
+	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
+	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
+	655, 656, 657, 658, 659, 660, 661, 662, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 0, 231, 252, 446, 225,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 296,
+	0, 483, 484, 261, 0, 0, 226, 0, 0, 364,
+	371, 363, 0, 0, 479, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 0, 443, 335, 0, 220, 0,
+	442, 0, 0, 458, 241, 0, 0, 473, 0, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	0, 0, 375, 408, 248, 490, 438, 277, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	0, 212, 227, 327, 0, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 493, 267, 0, 0, 0, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 611, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 0, 424, 440, 0, 209, 467, 216, 0, 217,
+	219, 0, 215, 0, 0, 476, 485, 372, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 0,
+	0, 0, 0, 0, 0, 273, 259, 0, 0, 0,
+	0, 0, 0, 0, 1208, 1212, 0, 185, 499, 203,
+	0, 329, 0, 520, 497, 441, 354, 0, 523, 522,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1201, 0,
+	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
+	0, 521, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 489, 0,
+	0, 0, 0, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 0, 1211, 316,
+	204, 0, 0, 0, 1204, 0, 1205, 1207, 1206, 326,
+	1203, 208, 0, 1197, 1210, 368, 0, 0, 190, 211,
+	328, 0, 0, 0, 260, 1195, 407, 0, 488, 519,
+	293, 0, 0, 406, 330, 480, 0, 0, 487, 0,
+	461, 498, 504, 286, 0, 249, 437, 276, 269, 0,
+	0, 0, 299, 390, 264, 321, 0, 0, 0, 256,
+	0, 0, 0, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 308, 300, 0, 0, 0, 376, 239,
+	0, 0, 0, 0, 492, 0, 272, 0, 420, 213,
+	447, 494, 193, 422, 421, 0, 307, 0, 194, 183,
+	400, 195, 317, 218, 0, 508, 235, 324, 469, 518,
+	292, 367, 0, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 0, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 0, 337, 281, 285, 301,
+	312, 224, 0, 404, 445, 495, 0, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 0, 231, 252, 446, 225,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 296,
+	0, 483, 484, 261, 0, 0, 226, 0, 0, 364,
+	371, 363, 0, 0, 479, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 0, 443, 335, 0, 220, 0,
+	442, 0, 0, 458, 241, 0, 0, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	0, 0, 375, 408, 248, 490, 438, 277, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 205, 206, 0, 0, 207, 0, 0, 0, 0,
+	0, 201, 202, 187, 192, 0, 0, 0, 257, 177,
+	0, 0, 0, 178, 0, 0, 180, 181, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	0, 212, 227, 327, 0, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 493, 267, 0, 0, 0, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 0, 424, 440, 0, 209, 467, 216, 0, 217,
+	219, 0, 215, 0, 0, 476, 485, 372, 0, 0,
+	0, 0, 0, 1540, 0, 0, 0, 274, 0, 0,
+	0, 0, 0, 0, 0, 273, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 499, 203,
+	0, 329, 0, 520, 497, 441, 354, 0, 523, 522,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1542, 1541, 0,
+	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
+	0, 521, 0, 1536, 1537, 1535, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 1538, 0, 0, 0, 0, 0, 489, 0,
+	0, 0, 0, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 0, 0, 316,
+	204, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	0, 208, 0, 0, 0, 368, 0, 0, 190, 211,
+	328, 0, 0, 0, 260, 0, 407, 0, 488, 519,
+	293, 0, 0, 406, 330, 480, 0, 0, 487, 0,
+	461, 498, 504, 286, 0, 249, 437, 276, 269, 0,
+	0, 0, 299, 390, 264, 321, 0, 0, 0, 256,
+	0, 0, 0, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 308, 300, 0, 0, 0, 376, 239,
+	0, 0, 0, 0, 492, 0, 272, 0, 420, 213,
+	447, 494, 193, 422, 421, 0, 307, 0, 194, 183,
+	400, 195, 317, 218, 0, 508, 235, 324, 469, 518,
+	292, 367, 0, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 0, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 0, 337, 281, 285, 301,
+	312, 224, 0, 404, 445, 495, 0, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 0, 231, 252, 446, 225,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 296,
+	0, 483, 484, 261, 0, 0, 226, 0, 0, 364,
+	371, 363, 0, 0, 479, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 0, 443, 335, 0, 220, 0,
+	442, 0, 0, 458, 241, 0, 0, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	0, 0, 375, 408, 248, 490, 438, 277, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 205, 206, 0, 0, 207, 0, 0, 0, 0,
+	0, 201, 202, 187, 192, 0, 0, 0, 257, 177,
+	0, 0, 0, 178, 0, 0, 180, 181, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	0, 212, 227, 327, 0, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 493, 267, 0, 0, 0, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 0, 424, 440, 0, 209, 467, 216, 0, 217,
+	219, 0, 215, 0, 0, 476, 485, 372, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 0,
+	0, 0, 0, 0, 0, 273, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 499, 203,
+	0, 329, 0, 520, 497, 441, 354, 0, 523, 522,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2652, 0,
+	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
+	0, 521, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 489, 0,
+	0, 0, 0, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 0, 0, 316,
+	204, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	3806, 208, 0, 0, 3805, 368, 0, 0, 190, 211,
+	328, 0, 0, 0, 260, 0, 407, 0, 488, 519,
+	293, 0, 0, 406, 330, 480, 0, 0, 487, 0,
+	461, 498, 504, 286, 0, 249, 437, 276, 269, 0,
+	0, 0, 299, 390, 264, 321, 0, 0, 0, 256,
+	0, 0, 0, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 308, 300, 0, 0, 0, 376, 239,
+	0, 0, 0, 0, 492, 0, 272, 0, 420, 213,
+	447, 494, 193, 422, 421, 0, 307, 0, 194, 183,
+	400, 195, 317, 218, 0, 508, 235, 324, 469, 518,
+	292, 367, 0, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 0, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 0, 337, 281, 285, 301,
+	312, 224, 0, 404, 445, 495, 0, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 0, 231, 252, 446, 225,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 296,
+	0, 483, 484, 261, 0, 0, 226, 0, 0, 364,
+	371, 363, 0, 0, 479, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 0, 443, 335, 0, 220, 0,
+	442, 0, 0, 458, 241, 0, 0, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	0, 0, 375, 408, 248, 490, 438, 277, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 205, 206, 0, 0, 207, 0, 0, 0, 0,
+	0, 201, 202, 187, 192, 0, 0, 0, 257, 177,
+	0, 0, 0, 178, 0, 0, 180, 181, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	0, 212, 227, 327, 0, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 493, 267, 0, 0, 0, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 0, 424, 440, 0, 209, 467, 216, 0, 217,
+	219, 0, 215, 0, 0, 476, 485, 372, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 0,
+	0, 0, 0, 0, 0, 273, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 499, 203,
+	0, 329, 0, 520, 497, 441, 354, 0, 523, 522,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3773, 0,
+	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
+	0, 521, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 489, 0,
+	0, 0, 0, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 0, 0, 316,
+	204, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	1203, 208, 0, 0, 1210, 368, 0, 0, 190, 211,
+	328, 0, 0, 0, 260, 0, 407, 0, 488, 519,
+	293, 0, 0, 406, 330, 480, 0, 0, 487, 0,
+	461, 498, 504, 286, 0, 249, 437, 276, 269, 0,
+	0, 0, 299, 390, 264, 321, 0, 0, 0, 256,
+	0, 0, 0, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 308, 300, 0, 0, 0, 376, 239,
+	0, 0, 0, 0, 492, 0, 272, 0, 420, 213,
+	447, 494, 193, 422, 421, 0, 307, 0, 194, 183,
+	400, 195, 317, 218, 0, 508, 235, 324, 469, 518,
+	292, 367, 0, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 0, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 0, 337, 281, 285, 301,
+	312, 224, 0, 404, 445, 495, 0, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 0, 231, 252, 446, 225,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 296,
+	0, 483, 484, 261, 0, 0, 226, 0, 0, 364,
+	371, 363, 0, 0, 479, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 0, 443, 335, 0, 220, 0,
+	442, 0, 0, 458, 241, 0, 0, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	0, 0, 375, 408, 248, 490, 438, 277, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 205, 206, 0, 0, 207, 0, 0, 0, 0,
+	0, 201, 202, 187, 192, 0, 0, 0, 257, 177,
+	0, 0, 0, 178, 0, 0, 180, 181, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	0, 212, 227, 327, 0, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 493, 267, 0, 0, 0, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 0, 424, 440, 0, 209, 467, 216, 0, 217,
+	219, 0, 215, 0, 0, 476, 485, 372, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 0,
+	0, 0, 0, 0, 0, 273, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 499, 203,
+	0, 329, 0, 520, 497, 441, 354, 0, 523, 522,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1542, 1541, 0,
+	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
+	0, 521, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 489, 0,
+	0, 0, 0, 391, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 0, 0, 316,
+	204, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	0, 208, 0, 0, 0, 368, 0, 0, 190, 211,
+	328, 0, 0, 0, 260, 2386, 407, 0, 488, 519,
+	293, 0, 0, 406, 330, 480, 0, 0, 487, 0,
+	461, 498, 504, 286, 0, 249, 437, 276, 269, 0,
+	0, 0, 299, 390, 264, 321, 0, 0, 0, 256,
+	0, 0, 0, 436, 477, 214, 349, 478, 503, 179,
+	287, 428, 288, 460, 279, 250, 393, 229, 319, 0,
+	0, 270, 314, 0, 0, 506, 496, 240, 289, 401,
+	405, 382, 236, 468, 350, 360, 253, 255, 254, 230,
+	429, 475, 243, 258, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 308, 300, 0, 0, 0, 376, 239,
+	0, 0, 0, 0, 492, 0, 272, 0, 420, 213,
+	447, 494, 193, 422, 421, 0, 307, 0, 194, 183,
+	400, 195, 317, 218, 0, 508, 235, 324, 469, 518,
+	292, 367, 0, 377, 210, 395, 344, 346, 343, 347,
+	297, 188, 196, 0, 397, 425, 474, 237, 444, 186,
+	189, 198, 413, 199, 200, 0, 337, 281, 285, 301,
+	312, 224, 0, 404, 445, 495, 0, 232, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	448, 465, 415, 295, 336, 338, 450, 451, 456, 452,
+	453, 449, 455, 454, 411, 412, 322, 457, 222, 459,
+	486, 244, 423, 427, 507, 0, 231, 252, 446, 225,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 296,
+	0, 483, 484, 261, 0, 0, 226, 0, 0, 364,
+	371, 363, 0, 0, 479, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 284, 303, 388, 332, 389, 304,
+	358, 357, 359, 334, 0, 443, 335, 0, 220, 0,
+	442, 0, 0, 458, 241, 0, 0, 473, 191, 396,
+	242, 294, 282, 387, 362, 233, 306, 439, 323, 331,
+	0, 0, 375, 408, 248, 490, 438, 277, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 205, 206, 0, 0, 207, 0, 0, 0, 0,
+	0, 201, 202, 187, 192, 0, 0, 0, 257, 177,
+	0, 0, 0, 178, 0, 0, 180, 181, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 384,
+	221, 234, 245, 246, 247, 271, 268, 266, 275, 283,
+	0, 0, 309, 318, 0, 333, 352, 345, 381, 348,
+	0, 0, 0, 383, 402, 426, 432, 433, 462, 463,
+	464, 466, 470, 471, 472, 0, 500, 0, 392, 263,
+	0, 212, 227, 327, 0, 399, 291, 351, 430, 353,
+	313, 262, 505, 356, 398, 509, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 493, 267, 0, 0, 0, 228, 238,
+	251, 265, 280, 0, 290, 302, 305, 310, 311, 315,
+	320, 339, 340, 341, 342, 365, 366, 369, 370, 373,
+	374, 378, 379, 380, 385, 386, 394, 197, 403, 414,
+	416, 417, 418, 419, 431, 434, 435, 481, 482, 501,
+	502, 0, 424, 440, 0, 209, 0, 216, 0, 217,
+	219, 0, 215, 46, 467, 476, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 96, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 707, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 104, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 0, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 174, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 708, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 118, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 701, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1787, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 1203, 208,
+	0, 0, 1210, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 2641, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3763, 0, 0, 0, 0, 0, 3765, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2652, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 1203, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2146, 2144, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2142, 2144, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2652, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3765, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2144, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1264, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1253, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 520, 497, 441, 354, 0, 523, 522, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 511, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 521,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 519, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 518, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 707, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 0, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 174, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3410, 708, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 701, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 707, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 0, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 174, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 708, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 701, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	569, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 571, 568, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 174, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 2689, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 174, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
+	2549, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 0, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 174, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 0, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 467, 216, 0, 217, 219, 0,
+	215, 0, 0, 476, 485, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 0, 273, 259, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 499, 203, 0, 329,
+	0, 0, 497, 441, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 0, 0, 0,
+	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 0, 0,
+	0, 391, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 0, 0, 316, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 0, 208,
+	0, 0, 0, 368, 0, 0, 190, 211, 328, 0,
+	0, 0, 260, 0, 407, 0, 488, 0, 293, 0,
+	0, 406, 330, 480, 0, 0, 487, 0, 461, 498,
+	504, 286, 0, 249, 437, 276, 269, 0, 0, 0,
+	299, 390, 264, 321, 0, 0, 0, 256, 0, 0,
+	0, 436, 477, 214, 349, 478, 503, 179, 287, 428,
+	288, 460, 279, 250, 393, 229, 319, 0, 0, 270,
+	314, 0, 0, 506, 496, 240, 289, 401, 405, 382,
+	236, 468, 350, 360, 253, 255, 254, 230, 429, 475,
+	243, 258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 308, 300, 0, 0, 0, 376, 239, 0, 0,
+	0, 0, 492, 0, 272, 0, 420, 213, 447, 494,
+	193, 422, 421, 0, 307, 0, 194, 183, 400, 195,
+	317, 218, 0, 508, 235, 324, 469, 174, 292, 367,
+	0, 377, 210, 395, 344, 346, 343, 347, 297, 188,
+	196, 0, 397, 425, 474, 237, 444, 186, 189, 198,
+	413, 199, 200, 0, 337, 281, 285, 301, 312, 224,
+	0, 404, 445, 495, 0, 232, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 448, 465,
+	415, 295, 336, 338, 450, 451, 456, 452, 453, 449,
+	455, 454, 411, 412, 322, 457, 222, 459, 486, 244,
+	423, 427, 507, 0, 231, 252, 446, 225, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 483,
+	484, 261, 0, 0, 226, 0, 0, 364, 371, 363,
+	0, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 284, 303, 388, 332, 389, 304, 358, 357,
+	359, 334, 0, 443, 335, 0, 220, 0, 442, 0,
+	0, 458, 241, 0, 0, 473, 191, 396, 242, 294,
+	282, 387, 362, 233, 306, 439, 323, 331, 0, 0,
+	375, 408, 248, 490, 438, 277, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	206, 0, 0, 207, 0, 0, 0, 0, 0, 201,
+	202, 187, 192, 0, 0, 0, 257, 177, 0, 0,
+	0, 178, 0, 0, 180, 181, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 221, 234,
+	245, 246, 247, 271, 268, 266, 275, 283, 0, 0,
+	309, 318, 0, 333, 352, 345, 381, 348, 0, 0,
+	0, 383, 402, 426, 432, 433, 462, 463, 464, 466,
+	470, 471, 472, 0, 500, 0, 392, 263, 0, 212,
+	227, 327, 2283, 399, 291, 351, 430, 353, 313, 262,
+	505, 356, 398, 509, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 267, 0, 0, 0, 228, 238, 251, 265,
+	280, 0, 290, 302, 305, 310, 311, 315, 320, 339,
+	340, 341, 342, 365, 366, 369, 370, 373, 374, 378,
+	379, 380, 385, 386, 394, 197, 403, 414, 416, 417,
+	418, 419, 431, 434, 435, 481, 482, 501, 502, 0,
+	424, 440, 0, 209, 0, 216, 0, 217, 219, 0,
+	215, 467, 0, 476, 485, 1675, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 499, 203, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 0, 0, 0, 0, 0,
+	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 204, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 190, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 193, 422, 421,
+	0, 307, 0, 194, 183, 400, 195, 317, 218, 0,
+	508, 235, 324, 469, 174, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 188, 196, 0, 397,
+	425, 474, 237, 444, 186, 189, 198, 413, 199, 200,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 191, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 205, 206, 0, 0,
+	207, 0, 0, 0, 0, 0, 201, 202, 187, 192,
+	0, 0, 0, 257, 177, 0, 0, 0, 178, 0,
+	0, 180, 181, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 197, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 499, 203, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 0, 0, 0, 0, 0,
+	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 168, 0, 316, 204, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 190, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 193, 422, 421,
+	0, 307, 0, 194, 183, 400, 195, 317, 218, 0,
+	508, 235, 324, 469, 174, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 188, 196, 0, 397,
+	425, 474, 237, 444, 186, 189, 198, 413, 199, 200,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 191, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 205, 206, 0, 0,
+	207, 0, 0, 0, 0, 0, 201, 202, 187, 192,
+	0, 0, 0, 257, 177, 0, 0, 0, 178, 0,
+	0, 180, 181, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 197, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 499, 203, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 0, 0, 0, 0, 0,
+	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 204, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 190, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 193, 422, 421,
+	0, 307, 0, 194, 183, 400, 195, 317, 218, 0,
+	508, 235, 324, 469, 174, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 188, 196, 0, 397,
+	425, 474, 237, 444, 186, 189, 198, 413, 199, 200,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 191, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 205, 206, 0, 0,
+	207, 0, 0, 0, 0, 0, 201, 202, 187, 192,
+	0, 0, 0, 257, 177, 0, 0, 0, 178, 0,
+	0, 180, 181, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 197, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 205, 206, 0, 0,
+	207, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 177, 0, 0, 0, 178, 0,
+	0, 180, 181, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 680, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 179, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 205, 206, 0, 0,
+	207, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 177, 0, 0, 0, 178, 0,
+	0, 180, 181, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 759, 1201, 760, 762, 763, 764, 765, 0,
+	0, 0, 761, 2437, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 756,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 757, 758, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 4789, 0, 0, 0,
+	0, 0, 274, 4787, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 663, 664, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 4788, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 1690, 0, 0, 0,
+	0, 0, 274, 1688, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 663, 664, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 1689, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3159, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 3160, 3161, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 2571, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 663, 664, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 2570, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 1698, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1697, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1699, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 2232, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 2231, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 663, 664, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 2180, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	1212, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1799, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 1211, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 663, 664, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 723,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 3820, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 3818, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 3816, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 3814, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 3809, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 3796, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 3794, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 3722, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 3711, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 97, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 2752, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 2912,
+	2911, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1799, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 583, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 0, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 0, 424, 440, 0,
+	209, 467, 216, 0, 217, 219, 0, 215, 0, 0,
+	476, 485, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
+	273, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 0, 0, 329, 0, 0, 497,
+	441, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 524, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 391, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 208, 0, 0, 0,
+	368, 0, 0, 0, 211, 328, 0, 0, 0, 260,
+	0, 407, 0, 488, 0, 293, 0, 0, 406, 330,
+	480, 0, 0, 487, 0, 461, 498, 504, 286, 0,
+	249, 437, 276, 269, 0, 0, 0, 299, 390, 264,
+	321, 0, 0, 0, 256, 0, 0, 0, 436, 477,
+	214, 349, 478, 503, 0, 287, 428, 288, 460, 279,
+	250, 393, 229, 319, 0, 0, 270, 314, 0, 0,
+	506, 496, 240, 289, 401, 405, 382, 236, 468, 350,
+	360, 253, 255, 254, 230, 429, 475, 243, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 308, 300,
+	0, 0, 0, 376, 239, 0, 0, 0, 0, 492,
+	0, 272, 0, 420, 213, 447, 494, 0, 422, 421,
+	0, 307, 0, 0, 0, 400, 0, 317, 218, 0,
+	508, 235, 324, 469, 0, 292, 367, 0, 377, 210,
+	395, 344, 346, 343, 347, 297, 0, 0, 0, 397,
+	425, 474, 237, 444, 0, 0, 0, 413, 0, 0,
+	0, 337, 281, 285, 301, 312, 224, 0, 404, 445,
+	495, 0, 232, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 448, 465, 415, 295, 336,
+	338, 450, 451, 456, 452, 453, 449, 455, 454, 411,
+	412, 322, 457, 222, 459, 486, 244, 423, 427, 507,
+	0, 231, 252, 446, 225, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 483, 484, 261, 0,
+	0, 226, 0, 0, 364, 371, 363, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 284,
+	303, 388, 332, 389, 304, 358, 357, 359, 334, 0,
+	443, 335, 0, 220, 0, 442, 0, 0, 458, 241,
+	0, 0, 473, 0, 396, 242, 294, 282, 387, 362,
+	233, 306, 439, 323, 331, 0, 0, 375, 408, 248,
+	490, 438, 277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 384, 221, 234, 245, 246, 247,
+	271, 268, 266, 275, 283, 0, 0, 309, 318, 0,
+	333, 352, 345, 381, 348, 0, 0, 0, 383, 402,
+	426, 432, 433, 462, 463, 464, 466, 470, 471, 472,
+	0, 500, 0, 392, 263, 0, 212, 227, 327, 0,
+	399, 291, 351, 430, 353, 313, 262, 505, 356, 398,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 267,
+	0, 0, 0, 228, 238, 251, 265, 280, 0, 290,
+	302, 305, 310, 311, 315, 320, 339, 340, 341, 342,
+	365, 366, 369, 370, 373, 374, 378, 379, 380, 385,
+	386, 394, 46, 403, 414, 416, 417, 418, 419, 431,
+	434, 435, 481, 482, 501, 502, 77, 424, 440, 0,
+	209, 0, 216, 101, 217, 219, 51, 215, 0, 0,
+	476, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 46, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 77, 0, 0, 0, 0, 0, 0, 101, 97,
+	0, 51, 0, 0, 0, 4576, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4569, 0, 0, 0,
+	0, 5032, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	4576, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 4569, 0, 0, 0, 0, 5004, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 53, 98, 61, 60, 63,
+	0, 0, 0, 0, 104, 0, 0, 0, 0, 0,
+	4570, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 46, 0, 0, 0, 67, 100,
+	99, 0, 0, 0, 0, 62, 0, 0, 77, 0,
+	53, 98, 61, 60, 63, 101, 0, 0, 51, 104,
+	0, 0, 0, 0, 0, 4570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 67, 100, 99, 0, 0, 0, 0,
+	62, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 97, 0, 0, 0, 0, 0, 4576, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 74, 75, 0, 4572, 0, 0, 0, 4569, 0,
+	0, 0, 0, 5003, 4581, 4573, 4574, 4575, 4579, 4580,
+	4577, 0, 4578, 0, 4582, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 74, 75, 0, 4572,
+	0, 0, 0, 0, 0, 0, 0, 91, 0, 4581,
+	4573, 4574, 4575, 4579, 4580, 4577, 65, 4578, 0, 4582,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 86,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 53, 98, 61,
+	60, 63, 91, 0, 46, 0, 104, 0, 0, 0,
+	0, 65, 4570, 0, 0, 0, 0, 0, 77, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 51, 0,
+	67, 100, 99, 0, 0, 0, 0, 62, 0, 0,
+	0, 0, 0, 0, 0, 4583, 4571, 0, 71, 72,
+	79, 0, 80, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 97, 0, 0, 0, 0, 0, 4576, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4583, 4571, 0, 71, 72, 79, 0, 80, 4569, 0,
+	0, 0, 0, 4989, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 74, 75, 0, 4572, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4581, 4573, 4574, 4575,
+	4579, 4580, 4577, 0, 4578, 0, 4582, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 86, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 46, 0, 0, 0, 0, 0, 0, 91,
+	0, 0, 0, 0, 0, 0, 77, 0, 65, 0,
+	0, 0, 0, 101, 0, 0, 51, 53, 98, 61,
+	60, 63, 0, 0, 0, 0, 104, 0, 0, 0,
+	0, 0, 4570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 46, 0, 0, 0,
+	67, 100, 99, 0, 0, 0, 0, 62, 0, 97,
+	77, 0, 0, 0, 0, 4576, 0, 101, 0, 0,
+	51, 0, 0, 0, 0, 64, 66, 0, 0, 0,
+	0, 95, 0, 0, 0, 0, 4569, 4583, 4571, 0,
+	71, 72, 79, 0, 80, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 97, 76, 0, 0, 0, 0, 4576,
+	64, 66, 0, 92, 0, 0, 95, 0, 0, 0,
+	0, 0, 0, 74, 75, 0, 4572, 0, 0, 0,
+	4569, 0, 0, 0, 0, 4985, 4581, 4573, 4574, 4575,
+	4579, 4580, 4577, 0, 4578, 0, 4582, 0, 0, 76,
+	0, 0, 0, 0, 85, 0, 86, 0, 92, 0,
+	0, 0, 0, 0, 0, 53, 98, 61, 60, 63,
+	0, 0, 0, 0, 104, 0, 0, 0, 0, 91,
+	4570, 0, 0, 0, 0, 0, 0, 0, 65, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 67, 100,
+	99, 0, 0, 0, 0, 62, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 53,
+	98, 61, 60, 63, 0, 0, 0, 0, 104, 0,
+	0, 0, 0, 0, 4570, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 67, 100, 99, 0, 0, 0, 0, 62,
+	0, 0, 0, 0, 0, 0, 0, 4583, 4571, 0,
+	71, 72, 79, 0, 80, 0, 0, 64, 66, 0,
+	0, 74, 75, 95, 4572, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4581, 4573, 4574, 4575, 4579, 4580,
+	4577, 4986, 4578, 0, 4582, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 86, 0, 76, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 74, 75, 91, 4572, 0,
+	0, 0, 0, 0, 0, 0, 65, 0, 4581, 4573,
+	4574, 4575, 4579, 4580, 4577, 0, 4578, 0, 4582, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 86, 0,
+	0, 0, 0, 0, 0, 46, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
+	0, 91, 0, 0, 0, 0, 101, 0, 0, 51,
+	65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	46, 0, 0, 0, 0, 4583, 4571, 0, 71, 72,
+	79, 0, 80, 0, 77, 0, 0, 0, 0, 0,
+	0, 101, 97, 0, 51, 0, 0, 0, 4576, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 64, 66, 4569,
+	0, 0, 0, 95, 4984, 0, 0, 0, 0, 4583,
+	4571, 0, 71, 72, 79, 0, 80, 97, 4981, 0,
+	0, 0, 0, 4576, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 76, 0, 0, 0,
+	0, 0, 0, 0, 4569, 92, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 53, 98,
+	61, 60, 63, 0, 0, 0, 0, 104, 0, 0,
+	0, 0, 0, 4570, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 46, 0, 0,
+	0, 67, 100, 99, 0, 0, 0, 0, 62, 0,
+	0, 77, 0, 53, 98, 61, 60, 63, 101, 0,
+	0, 51, 104, 0, 0, 0, 0, 0, 4570, 0,
+	0, 0, 0, 0, 0, 64, 66, 0, 0, 0,
+	0, 95, 0, 0, 0, 0, 67, 100, 99, 0,
+	0, 0, 0, 62, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	4576, 0, 0, 0, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 74, 75, 0, 4572, 0, 64,
+	66, 4569, 0, 0, 0, 95, 4973, 4581, 4573, 4574,
+	4575, 4579, 4580, 4577, 0, 4578, 0, 4582, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 86, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 76, 74,
+	75, 0, 4572, 0, 0, 0, 0, 92, 0, 0,
+	91, 0, 4581, 4573, 4574, 4575, 4579, 4580, 4577, 65,
+	4578, 0, 4582, 0, 0, 0, 0, 0, 0, 0,
+	85, 0, 86, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	53, 98, 61, 60, 63, 91, 0, 46, 0, 104,
+	0, 0, 0, 0, 65, 4570, 0, 0, 0, 0,
+	0, 77, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 51, 0, 67, 100, 99, 0, 0, 0, 0,
+	62, 0, 0, 0, 0, 0, 0, 0, 4583, 4571,
+	0, 71, 72, 79, 0, 80, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	4576, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 4583, 4571, 0, 71, 72, 79, 0,
+	80, 4569, 0, 0, 0, 0, 4914, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 74, 75, 0, 4572,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4581,
+	4573, 4574, 4575, 4579, 4580, 4577, 0, 4578, 0, 4582,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 86,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 0, 46, 0, 0, 0, 0, 0,
+	0, 65, 0, 0, 0, 0, 0, 0, 77, 0,
+	53, 98, 61, 60, 63, 101, 0, 0, 51, 104,
+	0, 0, 0, 0, 0, 4570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 67, 100, 99, 0, 0, 0, 0,
+	62, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 97, 0, 0, 0, 0, 0, 4576, 64, 66,
+	0, 0, 0, 0, 95, 0, 0, 0, 0, 0,
+	4583, 4571, 0, 71, 72, 79, 0, 80, 4569, 0,
+	0, 0, 0, 4863, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 76, 0, 0,
+	0, 0, 0, 64, 66, 0, 92, 0, 0, 95,
+	0, 0, 0, 0, 0, 0, 74, 75, 0, 4572,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4581,
+	4573, 4574, 4575, 4579, 4580, 4577, 0, 4578, 0, 4582,
+	0, 0, 76, 0, 0, 0, 0, 85, 0, 86,
+	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 53, 98, 61,
+	60, 63, 91, 0, 46, 0, 104, 0, 0, 0,
+	0, 65, 4570, 0, 0, 0, 0, 0, 77, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 51, 0,
+	67, 100, 99, 0, 0, 0, 0, 62, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 97, 0, 0, 0, 0, 0, 4576, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4583, 4571, 0, 71, 72, 79, 0, 80, 4569, 0,
+	64, 66, 0, 0, 0, 0, 95, 0, 0, 0,
+	0, 0, 0, 74, 75, 0, 4572, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4581, 4573, 4574, 4575,
+	4579, 4580, 4577, 0, 4578, 0, 4582, 0, 0, 76,
+	0, 0, 0, 0, 85, 0, 86, 0, 92, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	0, 46, 0, 0, 0, 0, 0, 0, 65, 0,
+	0, 0, 0, 0, 0, 77, 0, 53, 98, 61,
+	60, 63, 101, 0, 0, 51, 104, 0, 0, 0,
+	0, 0, 4570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 46, 0, 0, 0,
+	67, 100, 99, 0, 0, 0, 0, 62, 0, 0,
+	77, 0, 0, 0, 0, 0, 0, 101, 97, 0,
+	51, 0, 0, 0, 4576, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 4583, 4571, 0,
+	71, 72, 79, 0, 80, 4569, 0, 0, 0, 0,
+	4855, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 97, 0, 0, 0, 0, 0, 4576,
+	64, 66, 0, 0, 0, 0, 95, 0, 0, 0,
+	0, 0, 0, 74, 75, 0, 4572, 0, 0, 0,
+	4569, 0, 0, 0, 0, 4853, 4581, 4573, 4574, 4575,
+	4579, 4580, 4577, 4860, 4578, 0, 4582, 0, 0, 76,
+	0, 0, 0, 0, 85, 0, 86, 0, 92, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 53, 98, 61, 60, 63, 91,
+	0, 0, 0, 104, 0, 0, 0, 0, 65, 4570,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 67, 100, 99,
+	0, 0, 0, 0, 62, 0, 0, 0, 0, 53,
+	98, 61, 60, 63, 0, 0, 0, 0, 104, 0,
+	0, 0, 0, 0, 4570, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 67, 100, 99, 0, 0, 0, 0, 62,
+	0, 0, 0, 0, 0, 0, 0, 4583, 4571, 0,
+	71, 72, 79, 0, 80, 0, 0, 64, 66, 0,
+	0, 0, 0, 95, 0, 0, 0, 0, 0, 0,
+	74, 75, 0, 4572, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 4581, 4573, 4574, 4575, 4579, 4580, 4577,
+	0, 4578, 0, 4582, 0, 0, 76, 0, 0, 0,
+	0, 85, 0, 86, 0, 92, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 74, 75, 0, 4572, 0,
+	0, 0, 0, 0, 0, 0, 91, 0, 4581, 4573,
+	4574, 4575, 4579, 4580, 4577, 65, 4578, 0, 4582, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 86, 0,
+	0, 0, 0, 0, 0, 46, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
+	0, 91, 0, 0, 0, 0, 101, 0, 0, 51,
+	65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 46,
+	0, 0, 0, 0, 4583, 4571, 0, 71, 72, 79,
+	0, 80, 97, 77, 0, 0, 0, 0, 4576, 0,
+	101, 0, 0, 51, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 64, 66, 4569,
+	0, 0, 0, 95, 4697, 0, 0, 0, 0, 4583,
+	4571, 0, 71, 72, 79, 0, 80, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 4576, 0, 0, 0, 76, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	0, 0, 0, 4569, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 53, 98,
+	61, 60, 63, 0, 0, 0, 0, 104, 0, 0,
+	0, 0, 0, 4570, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 67, 100, 99, 0, 0, 0, 0, 62, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 53, 98, 61, 60, 63, 0, 0, 0,
+	0, 104, 0, 0, 0, 0, 0, 4570, 0, 0,
+	0, 0, 0, 0, 64, 66, 0, 0, 0, 0,
+	95, 0, 0, 0, 0, 67, 100, 99, 0, 0,
+	0, 0, 62, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 76, 74, 75, 0, 4572, 0, 64,
+	66, 0, 92, 0, 0, 95, 0, 4581, 4573, 4574,
+	4575, 4579, 4580, 4577, 0, 4578, 0, 4582, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 86, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 76, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 74, 75,
+	91, 4572, 0, 0, 0, 0, 0, 0, 0, 65,
+	0, 4581, 4573, 4574, 4575, 4579, 4580, 4577, 0, 4578,
+	0, 4582, 0, 46, 49, 0, 0, 0, 0, 85,
+	0, 86, 0, 0, 0, 0, 0, 77, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 51, 81, 82,
+	0, 0, 0, 0, 91, 78, 0, 0, 0, 0,
+	0, 0, 0, 65, 0, 0, 0, 0, 96, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 68, 0, 4583, 4571,
+	97, 71, 72, 79, 0, 80, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 4583, 4571, 0, 71, 72, 79, 0, 80,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 53, 98, 61, 60,
+	63, 0, 0, 87, 0, 104, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 67,
+	100, 99, 0, 0, 0, 0, 62, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 64, 66,
+	0, 0, 0, 0, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 74, 75, 0, 0, 0, 76, 0, 0,
+	0, 83, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 0, 64, 66, 0, 0, 0, 0, 95, 0,
+	0, 0, 0, 85, 0, 86, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 0,
+	0, 76, 0, 0, 0, 0, 0, 65, 0, 0,
+	92, 0, 0, 0, 0, 0, 0, 0, 0, 93,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
+	90, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 69, 88, 0, 71,
+	72, 79, 0, 80, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 64, 66, 0, 0,
+	0, 0, 95, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 76, 0, 0, 0, 0,
+	0, 0, 0, 0, 92,
+}
+
+var yyPact = [...]int32{
+	91532, -1000, -460, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2016, 3234, -1000, 3234, 411, -1000,
+	540, -1000, 1635, 890, -1000, -1000, -1000, -1000, -1000, -1000,
+	812, 329, 64909, 1293, 58902, 88159, -214, 6383, 87409, 257,
+	-1000, 257, 512, 61152, 1054, 1091, 441, 86659, 3059, 2418,
+	261, 109, 106, 65659, 305, 45394, 1179, 414, 290, 296,
+	289, 277, 1452, 2580, -1000, 66409, 974, -1000, 342, -1000,
+	-1000, -1000, -1000, -1000, 60402, 3282, 3291, 3282, -1000, -1000,
+	3212, 3226, -1000, -1000, 3212, 2609, 2609, -1000, 66409, 16262,
+	-1000, -1000, -1000, -1000, -1000, 35437, 1631, 1447, -1000, 65659,
+	46144, 3234, -1000, 1256, 1247, -37, 1176, 1176, 997, 1016,
+	1176, 1176, -420, 1176, 1279, -1000, 956, 1861, -1000, -1000,
+	-1000, -1000, 1860, 65659, 74659, 1271, 1179, 1179, 1179, 1179,
+	1179, 1179, 1179, 1179, 1179, 1179, 1179, 58152, 65659, -1000,
+	1468, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	65659, 3269, 3267, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2905, 2904, 57402, 641, 3116, 1862,
+	383, 1862, -1000, -1000, 1855, -1000, 1070, 1067, 1053, 1051,
+	1033, 1044, 112, 71, 1820, 1903, 3207, -1000, 2845, 3212,
+	3212, 1820, -1000, 1037, -1000, -1000, -1000, -1000, -1000, -1000,
+	1551, -1000, -1000, 1929, 1929, 661, 1241, 1089, 1094, 1089,
+	3158, 1853, 3115, 3111, 2446, 3109, 1711, 3108, 1004, 2416,
+	2892, -1000, 1467, -1000, -1000, 157, -1000, 2415, 65659, -1000,
+	255, -1000, 2305, 2302, 730, 2780, 1327, 2947, -1000, -1000,
+	704, 910, 2884, 887, 2884, 2884, 2884, 568, 2884, 2884,
+	96, 2884, 2884, 2884, 424, 2884, 438, 2884, 2884, 2884,
+	2884, 2884, -1000, 2597, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 44632, 3141, 314, -1000,
+	-131, 275, 3163, 1138, -1000, -1000, -1000, 412, -1000, 1447,
+	67159, 2815, -1000, 2845, -1000, -1000, -1000, 2843, 2845, -1000,
+	1179, 2358, 1267, 1179, 893, -1000, 587, -1000, -1000, -1000,
+	3143, 2845, 49902, -1000, -1000, 1798, -1000, 2845, 2845, 1447,
+	3174, 1447, 1447, 3172, 3170, 1447, 3093, 32369, 21631, 3005,
+	2016, -1000, -1000, -1000, -1000, 1447, 3266, -1000, 46894, 1465,
+	-1000, 21631, 2560, 2845, 2845, -1000, 1402, 1499, -1000, 1376,
+	1441, -1000, -1000, 22398, 22398, 22398, 22398, 22398, 22398, 22398,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2572, 2571, 2570, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2845,
+	1462, 1460, 1453, 2845, 2845, 2845, 2845, 2845, -1000, 19330,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845,
+	2845, 2845, 2845, 2845, 2845, 2845, 2845, 21631, 2845, 2845,
+	2845, 3001, 3206, 2845, 2845, -1000, 2845, 2845, 2845, 2845,
+	2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845,
+	2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845,
+	2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2845, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2845, 2845, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2845, 1186, 1186, 64159,
+	2829, 29, 34670, 69409, 3205, 642, -1000, -1000, -1000, -1000,
+	33136, 71659, 1765, -1000, -1000, -1000, -1000, 3107, -1000, -1000,
+	1443, 1441, 1402, 1401, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1447, -1000, -1000, 3234, 393, -1000, 2717, -1000, -1000, -1000,
+	-1000, 9134, 9134, 9134, 9134, 9134, 9134, 50652, -1000, 2891,
+	-1000, -1000, -1000, 2890, 3031, 1408, 1408, 1408, 73909, 85909,
+	3030, -1000, -1000, 3212, -1000, 1176, 1176, 1024, 2413, 1901,
+	2412, 86659, 86659, 1176, 1176, -1000, 903, 1176, 74659, 74659,
+	-302, 65659, 1176, 930, 74659, 922, 2820, 1238, 2818, 1237,
+	2584, 1179, 85159, 65659, 65659, 2409, 2407, 86659, 86659, 86659,
+	74659, 74659, 86659, -1000, -1000, -1000, 31602, -1000, 1496, 2812,
+	54402, 53652, 56652, 56652, -1000, -1000, -1000, 999, -1000, 1862,
+	-1000, 6941, 21631, 1176, 1176, 65659, 65659, 65659, 65659, 65659,
+	65659, 65659, -1000, 65659, -1000, -1000, 1862, -1000, -1000, 1862,
+	1862, 1862, 1820, 3265, 1820, -1000, -1000, -1000, 80, 1862,
+	87409, 87409, 21631, -1000, 22398, 73159, 1574, -1000, -1000, 1862,
+	-1000, 87409, 1117, 1117, 1117, -1000, 2406, 647, 459, -1000,
+	1089, 393, -1000, -1000, 3075, 3165, 3164, 2404, 1711, 1711,
+	-1000, 2577, 1711, 2810, -1000, 1850, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1711, -1000,
+	1023, 2794, 22398, -1000, 1003, 3158, 20864, 85159, -435, -1000,
+	-1000, 2817, -1000, 549, 3143, 72409, -1000, -1000, -1000, 1085,
+	2944, 1085, 8782, 74659, 74659, 74659, -1000, 2884, 85159, -1000,
+	2884, 2884, 2884, 74, 2884, 2884, -1000, -1000, 2884, -1000,
+	-1000, -1000, 2884, -1000, -1000, 2884, -1000, -1000, -1000, 2884,
+	2884, -1000, 2884, 2884, -1000, -1000, -1000, -1000, -1000, 74659,
+	851, 2768, 2805, 2943, -1000, 1235, 297, 11, 244, -1000,
+	-1000, -1000, -1000, -1000, -1000, 409, 408, 404, 397, -1000,
+	-1000, -1000, -1000, 391, 384, -1000, -1000, 63402, 63402, 1041,
+	-1000, 2815, 67159, 3162, 28534, 974, -1000, 21631, 65659, 3120,
+	-1000, 3120, -1000, -1000, -1000, 402, -1000, 65659, 74659, 86659,
+	60402, 60402, 60402, 60402, -1000, 2978, 2977, -1000, 2971, 2970,
+	2958, 595, 67159, -1000, 3143, 411, 2714, 2609, 2609, 587,
+	49902, -1000, 2845, -1000, 67159, 1364, 1253, 1252, 1251, 3075,
+	-1000, -1000, -1000, -1000, -1000, 2563, 2452, 2423, 15495, 22398,
+	-1000, -1000, -1000, -1000, -1000, 3282, 3282, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1938, 3043, 1740, 2795, -1000, -1000, -1000,
+	-1000, 2814, -1000, 2225, 1440, 20097, 2040, -1000, -1000, -1000,
+	-1000, -1000, -1000, 65659, -1000, 21631, 21631, 21631, 2015, -1000,
+	49144, -1000, -1000, -1000, 27000, 1642, 22398, 2153, 1737, 22398,
+	22398, 22398, 2845, 22398, 22398, 22398, 22398, 22398, 22398, 22398,
+	22398, 22398, 22398, 22398, 22398, 1586, 22398, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2403, -1000, 393, 42340, 15495,
+	42340, 949, 949, 85159, 1538, 1538, 1538, 1538, 1538, 1538,
+	11808, -1000, -1000, -1000, 3161, 40039, 85159, 42340, 3161, 3161,
+	3161, 3161, 3161, 2607, 2232, 66409, 19330, 60402, 15495, 15495,
+	15495, 2609, 21631, 21631, 15495, 22398, 15495, 18563, 17796, 24699,
+	15495, 15495, 3161, 1879, 2232, 30835, 15495, -1000, 2609, 3202,
+	65659, -1000, -1000, -1000, -1000, 2202, -1000, -1000, -164, -164,
+	-164, 470, 15495, 15495, 15495, 15495, 2609, 2609, 15495, 15495,
+	15495, 15495, 15495, 15495, 2609, 2609, 2609, 15495, 15495, 15495,
+	15495, 15495, 15495, 15495, 15495, 15495, 15495, 15495, 15495, -1000,
+	-1000, -1000, 2174, 62652, 60402, 65659, 2709, 2654, -1000, -1000,
+	1425, 33903, 2796, -1000, 43, -9, 29, -1000, -1000, -1000,
+	3293, 2304, 74659, 70909, -1000, 1528, -1000, -1000, -1000, -1000,
+	17029, -1000, -1000, -1000, 27767, -1000, 3212, -1000, 46144, -1000,
+	156, -1000, -1000, -1000, -1000, -1000, 2860, -1000, 2864, 206,
+	610, 2864, 192, 187, 2864, 2864, 2864, 2864, -1000, -1000,
+	-1000, 201, -1000, -1000, -1000, -1000, -1000, 2889, 2888, -1000,
+	2860, 2860, 2860, -1000, -1000, -1000, -1000, -1000, -1000, 2864,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2875,
+	623, 2875, 2863, 2875, 2863, 2863, 2863, 2863, -1000, -1000,
+	-1000, -1000, -1000, 1306, 1143, -1000, -1000, -1000, -1000, -1000,
+	51402, 21631, 85909, 85909, 85909, -1000, 85909, 85909, -1000, -1000,
+	-1000, -1000, 55152, 3093, 3005, 85159, 65659, 1243, -421, 1176,
+	930, 3120, 3140, 3120, 1032, -1000, 1414, 2886, 86659, 86659,
+	990, 86659, 2738, -1000, 648, 2304, 1349, 703, 61902, -1000,
+	-1000, -1000, -1000, -1000, 65659, 65659, 74659, 74659, 74659, 65659,
+	2941, 2813, -1000, 2812, -1000, -1000, -1000, -1000, -1000, 2787,
+	-1000, 2304, -1000, -1000, 1413, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -391,
+	-393, 65659, -1000, -1000, -1000, -1000, -1000, -1000, 2811, -1000,
+	2811, -1000, -1000, -1000, 2576, 2232, 2396, 2394, 3158, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1833, -1000, -1000, -1000, -1000,
+	1862, 65659, 3158, 3264, -1000, -1000, -1000, 2232, 1993, 2901,
+	2845, 2609, -1000, -1000, -1000, 254, -1000, 256, -1000, -1000,
+	-1000, 2391, -1000, -1000, -1000, -1000, -1000, 459, -1000, 22398,
+	3073, 3067, -1000, 2810, 2810, -1000, 2810, 1711, 67909, 2810,
+	-1000, 1597, 1846, 2390, 6302, -1000, -1000, -1000, 2699, -1000,
+	326, 2575, 65659, -1000, 67159, 152, -1000, 13, 67159, 3241,
+	2053, -1000, -1000, 83659, -1000, -1000, -1000, 1085, 83659, -1000,
+	424, 356, -1000, 1234, -1000, 2697, -1000, -1000, -1000, -1000,
+	2884, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1085, 609, 2809, 1085, 74659, 74659, 744, 2808, -1000,
+	1844, 1841, -1000, -1000, 250, -1000, -1000, -1000, -1000, 3198,
+	-1000, -1000, 345, 24, -1000, 345, 2573, 22398, -1000, 2845,
+	2664, -1000, 1412, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2845, 703, -1000, -1000, -1000, -1000, 3105, 1765, 1765, 1765,
+	7136, 948, 2940, 2881, 2939, 564, -1000, -1000, -1000, -1000,
+	2974, -1000, 2973, 2972, -1000, -1000, -1000, 2848, 67159, 60402,
+	-1000, -1000, -1000, 2609, 85159, 1354, -1000, 67159, 2845, 2845,
+	2845, 22398, 22398, 1734, -1000, 22398, 22398, 1725, -1000, 22398,
+	22398, 1721, -1000, 2609, 2807, -1000, -1000, 2232, 1409, 6214,
+	-1000, 84409, 298, 295, 2989, 32369, 32369, 21631, -1000, -1000,
+	-1000, 27767, 2268, -1000, 3263, -1000, -1000, 1642, 1826, 1814,
+	-1000, -1000, 1981, -1000, -1000, -1000, -1000, -1000, 1405, 2845,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6558,
+	-1000, -1000, -1000, -1000, 2153, 22398, 22398, 22398, 1993, 6558,
+	6032, 21631, 1677, 2009, 1538, 2167, 2167, 1545, 1545, 1545,
+	1545, 1545, 2156, 2156, -1000, -1000, -1000, -1000, 1552, 2609,
+	-1000, 2688, -1000, -1000, 2572, 2571, 2570, -1000, -1000, -1000,
+	-1000, 15495, -1000, -1000, -1000, -1000, -1000, -1000, 15495, 15495,
+	15495, 15495, 15495, 21631, -1000, 2688, 2688, 2688, -1000, 2235,
+	2336, 2605, 5877, 2688, 1590, 3262, 2688, 1564, 3261, 2688,
+	2431, 22398, 22398, 22398, 2688, 2688, 15495, 1872, -1000, 21631,
+	2609, 1485, 2609, 1402, 1401, 2688, -1000, 65659, -1000, 2609,
+	2806, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2804, 3260, 2797, -1000, -1000, -1000, -1000, 2688,
+	2609, 2688, 2688, -55, -55, 2609, 2688, 2609, 2688, 2688,
+	2609, -55, -55, -55, 2688, 2688, 2688, 2688, 2688, 2688,
+	2688, 2688, 2688, 2688, 2688, 2688, -1000, 2609, 1665, 65659,
+	-1000, 2779, 1665, 2762, 60402, -1000, -1000, 60402, 87409, 30068,
+	-1000, 33136, 29, 41, -1000, -1000, 2796, 74659, 1838, 1212,
+	-1000, -1000, 2304, -1000, -1000, 1760, -1000, -1000, 2569, 2232,
+	-1000, 1394, 3093, 5155, -1000, -1000, -1000, -1000, 396, 85,
+	-1000, -1000, -1000, 2301, -1000, 434, 615, 2864, -1000, -1000,
+	-1000, 2864, 2864, -1000, 2864, 2864, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2568, 2568, -1000, -1000, -1000, -1000, -1000, 2299,
+	-1000, 2875, -1000, -1000, 2296, -1000, -1000, -1000, -1000, -1000,
+	396, 396, 396, 396, 396, 3029, 1408, -1000, -1000, 2690,
+	-1000, 2860, 2860, 22398, 2323, -1000, -1000, -1000, -1000, -1000,
+	2868, -1000, -1000, -1000, 16262, 2894, 2845, 65659, -303, 65659,
+	-1000, -1000, -1000, 3248, -1000, -1000, 85159, 70159, 907, 2938,
+	-1000, -1000, 672, 415, 74659, 640, 828, 399, -1000, 1898,
+	-1000, 703, -1000, 410, 1266, 1765, 307, 1765, -1000, -1000,
+	1210, -1000, 1208, -1000, -1000, 1334, 65659, 65659, 74659, 85159,
+	2936, 2935, -1000, 56652, -1000, -1000, -1000, -1000, 3241, 2383,
+	2382, -1000, 1820, 1862, 65659, -1000, 22398, 74659, -1000, 1015,
+	-1000, -1000, -1000, -1000, 6558, 22398, 22398, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2794, -1000, 1935, -1000, -1000, -1000, -1000,
+	77, -1000, -1000, -1000, 3212, 21631, 3241, 2845, -1000, -1000,
+	-1000, 1202, 1390, 1379, 83659, 1201, -1000, 462, 74659, -1000,
+	85159, -1000, 83659, 563, 593, 83659, 1290, 3259, 2793, -1000,
+	1837, 1832, 1811, 1807, 1805, 1802, 1772, 1769, 297, 2867,
+	2866, -1000, -1000, 346, -1000, 3090, -1000, -1000, 6558, -1000,
+	-1000, 28534, 42340, -1000, -1000, 1765, 1716, -1000, 1713, 2566,
+	1866, 1827, -1000, -1000, -1000, 3225, 1547, 1326, 1160, 1765,
+	1136, 548, 1200, 3087, 3080, 774, 717, -1000, 1765, 1136,
+	1558, 802, -1000, 1715, 1714, 1710, 1707, 1706, 1705, 1697,
+	1673, 785, -304, -1000, 1765, 1765, 1765, 1765, 1765, 1765,
+	1765, 1765, -135, -137, 1765, 1765, 1765, 1765, 1765, 1765,
+	1765, 1765, 1765, 1765, 1765, 1765, 1765, 1765, 1765, 1765,
+	1765, 1765, 1765, 1765, 1765, 1765, 1765, 36204, 1765, 1765,
+	636, -227, -1000, 1309, 892, -1000, -1000, 21631, 2865, -1000,
+	21631, -1000, -1000, -1000, -1000, -1000, 85159, 2848, 587, -1000,
+	2662, -1000, -1000, 1354, 85159, 85159, 85159, 5847, 2238, 2845,
+	5801, 2076, 2845, 5551, 2054, 2845, 3143, 15495, 26233, 2565,
+	2791, 2564, 2562, -1000, 145, -1000, 65659, 143, -1000, 65659,
+	2986, -1000, -1000, -1000, 65659, 3200, 3010, 3009, -1000, 3241,
+	59652, 16262, -1000, -1000, -1000, 37738, 15495, -1000, 1993, 6558,
+	5416, -1000, 22398, 2083, -1000, -1000, 2609, 2688, 2688, 2688,
+	2688, 2688, 2232, -1000, -1000, -1000, 262, 1586, 262, -1000,
+	1586, 22398, -1000, 22398, 22398, -1000, 22398, 22398, -1000, -1000,
+	22398, 5346, 5302, 5262, -219, -1000, 2792, 1865, -1000, 21631,
+	2300, -1000, -1000, -1000, -1000, -1000, -1000, 22398, 22398, 22398,
+	22398, -1000, -1000, -1000, -1000, -1000, 82909, -1000, -55, -55,
+	-55, -55, -55, -55, -1000, -1000, -1000, -55, -55, -55,
+	-55, -55, -55, -55, -55, -55, -55, -55, -55, -1000,
+	484, 2845, -1000, 29301, 3241, 60402, 2774, 2774, -1000, 1360,
+	-1000, -1000, -1000, 17, 1, -1000, 2304, 2561, 74659, -1000,
+	-1000, -1000, -1000, 38505, -1000, -1000, -1000, 6028, -1000, 1765,
+	1765, 1765, 449, 1765, 1765, 1765, 1765, 1765, -140, -146,
+	1765, 1765, 1765, 1765, 1765, 1765, 1765, 1765, 1765, 1765,
+	1765, 1765, 1765, 1765, 1765, 1765, 1765, 266, 1765, 1765,
+	1765, 1765, 1765, 1765, 36204, 1765, 1765, -308, 1765, 1765,
+	-1000, -1000, 1946, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2845, -449, 2278, 65659, -1000, 3101, 1703, 43870, 3276,
+	-1000, 3027, -1000, 3025, 3024, 2559, 52902, -1000, -1000, 2731,
+	2727, 2724, -1000, 2864, -1000, -1000, -1000, -1000, -1000, 2686,
+	-1000, 2683, 2790, -1000, 2680, 85909, 85909, 268, 51402, 3125,
+	3125, 2472, -372, 85159, 2929, 85159, 3157, 2845, 2276, 703,
+	2928, -1000, -1000, -1000, -1000, 2723, 2789, -1000, 9134, 85159,
+	85159, 85159, 85159, 645, 871, 645, -227, 801, -1000, 74659,
+	471, 648, 891, 2311, -348, 3136, -1000, 1667, 1667, 1667,
+	-1000, -1000, 1667, 1667, 1667, 41573, 65659, 74659, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 82159, 82159, -1000, -1000, -1000,
+	-1000, 3158, -1000, 1820, 6558, 2304, -1000, -1000, 6558, 6558,
+	1665, 1264, -1000, -1000, 2232, 3212, 21631, 74659, 1562, 81409,
+	1198, 74659, 560, 337, -1000, 3255, 3254, 558, 3253, -1000,
+	360, 74659, 744, 2558, 2557, 2556, 2272, 2270, 2266, 2259,
+	2252, -1000, 65659, 65659, 2555, -1000, -1000, -1000, 1701, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1653, 5624, 1827, 1639,
+	1622, 1620, 1613, -1000, 253, 52152, 1176, 1336, 1150, -1000,
+	-1000, 400, -1000, 48394, 55152, 55152, 55152, 1179, 3023, 3022,
+	85159, 55152, 55152, 85159, 55152, 1140, 2380, 3099, 1765, 205,
+	-1000, -1000, -1000, 80659, -1000, 79909, 943, 55902, 55152, 85159,
+	65659, 47644, -1000, -1000, -1000, -1000, 79159, 2241, 85159, 85159,
+	78409, 77659, 76909, 76159, -1000, -209, 1045, 21631, 1045, 2070,
+	2070, 2554, 2551, 2550, 1765, 1765, 248, 2070, 2549, 248,
+	2070, 41573, 2548, 1131, 1045, 1045, 1045, 2070, 2070, 2070,
+	2070, 2547, 443, 2145, 2545, 2070, 2398, 2333, 1045, -1000,
+	-174, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2070, 2845, 70909, 555, 541, 1005, 1197, 1057,
+	50, 2232, 85159, 2232, 2678, -1000, -1000, 2028, 85159, -1000,
+	2640, 2640, 2640, 22398, 22398, 22398, 22398, 22398, 22398, 22398,
+	22398, 22398, 67159, -1000, -1000, 1353, 67, 85159, -1000, 105,
+	-1000, 260, -1000, 247, -1000, -1000, -1000, 3199, 3199, 3239,
+	-1000, 2788, -1000, -1000, -1000, -1000, 2609, -1000, 22398, 6558,
+	-1000, -1000, -55, -55, -55, -55, -55, 2609, 2860, 2860,
+	2860, -1000, 2860, 2863, 218, -1000, -1000, -1000, -1000, -1000,
+	2860, 236, 2860, 235, -1000, 2609, 2609, 2609, 2472, 5240,
+	5196, 5117, 2692, 2472, 22398, 22398, 22398, 2845, -49, -1000,
+	2232, 21631, 5070, 4162, 2472, 2472, -1000, -1000, 2377, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2927, 2927,
+	29301, 3143, -1000, 25466, -1000, 974, -1000, -1000, 85159, 2782,
+	-1000, 1765, 1546, 1765, 1765, 3234, 2774, -1000, -1000, 1559,
+	-1000, -1000, -1000, -1000, 2787, -1000, 1045, 1045, 1045, 1765,
+	3096, 1765, 2070, 2070, 2544, 2543, 2542, 1765, 1765, 248,
+	2070, 2541, 248, 2070, 41573, 2540, 1131, 1045, 1045, 1045,
+	2070, 2070, 2070, 2070, 2539, 443, -1000, 2143, 2538, 2070,
+	2198, 2186, 1045, -1000, -179, 2070, 2845, -210, 2070, 2070,
+	-1000, 22398, 3152, -1000, 2859, 1632, -1000, -1000, -1000, -1000,
+	949, 2537, -1000, -1000, -1000, -1000, -1000, 22398, -1000, -1000,
+	2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845,
+	2845, 1344, 2845, 2845, 2845, 2845, 2845, 2845, 2845, 2845,
+	2845, 2845, 2845, 2845, 2845, 2845, 2845, 21631, 2845, 2845,
+	2845, 2845, 2845, 2845, 2845, 2845, 2845, 22, -1000, -1000,
+	-1000, -1000, 1142, -1000, -1000, -1000, -1000, -1000, 2534, -1000,
+	2236, -1000, 2230, -1000, -1000, 268, -1000, 41573, 1765, 2513,
+	1765, 1765, -1000, -1000, -1000, -1000, -1000, 3125, -1000, -1000,
+	-1000, 2674, 65659, -1000, -1000, 3150, -1000, -1000, 65659, 301,
+	70159, -1000, 9134, 9134, 9134, 2704, 2786, -1000, 9134, 663,
+	-1000, -1000, -1000, -1000, -1000, 50, 662, 555, -1000, 1923,
+	-1000, -1000, -1000, 2511, 2506, 2504, 2304, 649, 1920, 602,
+	-1000, 3139, 3138, 2495, 2492, 2478, 2474, 2229, -1000, -1000,
+	2214, 2203, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2900, 85159, 2783, -1000, 1862, 3158, 105, 65659, -1000, 2607,
+	337, -1000, -1000, -1000, 74659, 337, -1000, -1000, 566, 74659,
+	74659, 3252, 74659, 658, 1290, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2672, 2670, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1298, 1363, 433, 432, -165, -1000, 544, 446,
+	-1000, -1000, -1000, 55902, 1108, 9134, 9134, 85909, 1408, -1000,
+	3020, 1595, 2195, -1000, -1000, -1000, -1000, 85159, -1000, 55152,
+	2781, -376, -378, 1498, -395, -1000, -1000, -1000, -1000, 55902,
+	1765, 1586, 3095, 767, 431, 764, 430, -1000, -1000, -1000,
+	-1000, 1108, 1369, 1194, -1000, 3017, 55152, 1192, 721, 427,
+	-1000, 1965, -233, 2781, -1000, 2781, -1000, 2781, -1000, 2781,
+	-1000, -1000, -1000, 2232, -1000, -1000, -1000, -1000, -1000, -1000,
+	2470, 2463, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-141, -1000, 41573, -1000, -1000, 555, -1000, 8, 2194, 1669,
+	3079, 541, -1000, 2192, 2176, 2152, 2139, 1956, -1000, 2926,
+	86659, -1000, 841, 2925, 1687, 949, 2667, -1000, 85159, -1000,
+	-1000, -1000, -1000, -1000, 6558, 6558, 4047, 6558, 6558, 4009,
+	6558, 6558, 2778, -1000, 36971, 2845, -1000, 80, -1000, 121,
+	-1000, 116, -1000, -1000, -1000, -1000, -1000, 3236, 3223, -1000,
+	-1000, 6558, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 536,
+	524, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 22398, 22398, 22398, 22398, -1000, 2472, 2472, 2472,
+	22398, 2609, 2462, 2232, 22398, 22398, -1000, -1000, 1602, -1000,
+	3169, 3122, 3169, 2567, 67159, 2609, 2742, 2664, 2609, 406,
+	-1000, -1000, -1000, 66409, 60402, 2776, -1000, 2845, 2662, 3234,
+	29301, 21631, 21631, 21631, 21631, 3212, -1000, -1000, -1000, -1000,
+	1586, 1765, 39272, -1000, -1000, -1000, -1000, -1000, 2459, 2457,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -142, -1000, 41573,
+	-1000, -1000, -1000, 2472, 2845, 85159, -1000, -1000, -1000, -1000,
+	-1000, 2472, 42340, -1000, -1000, 2675, 2649, -1000, -1000, 2119,
+	-1000, 2456, 2455, -1000, 1112, 2858, -1000, -1000, -348, 3197,
+	-1000, 301, -1000, 2450, 929, 936, -1000, 916, 914, 909,
+	894, 902, -1000, -1000, -1000, -1000, 669, 85159, -1000, 2449,
+	2925, 2448, 864, 594, -1000, -1000, -1000, -1000, 398, 648,
+	-1000, 888, 2445, 885, 2440, -1000, -1000, 624, -1000, -1000,
+	-1000, -1000, 3057, 2660, -1000, 1862, 80, -1000, 3241, 3148,
+	337, 3148, 556, 1290, 1290, 74659, 1290, -1000, -1000, -1000,
+	-1000, -200, 2118, 22398, 2845, 2845, 896, 2635, -1000, -1000,
+	2373, -1000, -1000, 2894, 1176, 85909, 2642, -1000, 85159, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3119, 3118, -1000, -1000,
+	1108, 1586, -1000, 1586, -1000, -1000, -1000, -1000, -1000, 55152,
+	85159, 55152, 1189, 55152, -1000, -1000, 2845, 1262, -1000, -1000,
+	-1000, -1000, 2658, -1000, -1000, 783, 2237, 1477, -88, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 679,
+	2439, 419, -1000, -1000, 1309, 844, -1000, -1000, -1000, -269,
+	-1000, -1000, 22398, 22398, 22398, -1000, 40806, 3050, 3048, -1000,
+	-1000, -58, 21631, 23932, -1000, -1000, -1000, -1000, 2472, 2472,
+	2472, 2472, -1000, -1000, -1000, 318, -1000, -1000, 2472, 2472,
+	3234, 3222, -1000, -1000, 3016, -1000, 3169, 2848, -1000, -1000,
+	2742, 406, -1000, 974, -1000, 14728, -1000, 3212, -1000, 2232,
+	2232, 2232, 2232, -1000, -1000, 1586, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2658, -452, 22398, 2656, -1000, 2845, -1000,
+	-1000, -1000, -1000, -1000, 65659, 51402, 393, -1000, 980, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3056, 3053, 941, 9134,
+	-1000, -1000, 1005, -1000, -1000, 2437, 2436, -1000, 853, 2369,
+	-1000, 588, -1000, -1000, 585, -1000, -1000, 3221, -348, 22398,
+	-1000, -1000, 3050, 3212, -1000, 74659, 3148, -1000, -1000, -1000,
+	-1000, 1290, -1000, 2845, 3220, -1000, 2472, 85159, 22398, 2845,
+	1766, -1000, -1000, 396, 396, 2857, 85909, 2856, -1000, 1595,
+	3008, 22398, -1000, -1000, 1534, 1532, -1000, -1000, 1188, 55152,
+	-1000, 1595, 65659, 41573, -1000, -1000, -1000, 2117, -1000, -1000,
+	2172, 784, -1000, -1000, -1000, 1005, 1566, 868, 2472, 2472,
+	2472, 2589, -1000, 10302, 453, -1000, -1000, 3040, 3219, -1000,
+	85159, -1000, 2232, 241, -1000, 2232, 2845, 243, -1000, -1000,
+	-1000, -1000, 2609, 364, -255, -1000, -1000, 1680, 21631, 2772,
+	3275, -1000, -1000, -1000, -1000, 406, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2472, -1000, 2855, 2644, -1000, 393,
+	393, 968, 91208, -1000, -1000, -1000, -1000, 301, 1956, -1000,
+	-1000, 584, -1000, 3147, -1000, -1000, 2094, 6558, 1181, -1000,
+	-234, -1000, -1000, -1000, 1595, -331, -1000, 2640, 2472, 75409,
+	2093, 51402, 2894, 51402, -1000, 353, 1203, 6558, 1765, 1586,
+	55152, -1000, 2642, 123, -1000, -282, -1000, -283, -1000, -1000,
+	91208, 1956, 1370, 861, 1687, -1000, -1000, -1000, 2609, 40806,
+	461, -412, 64, 2434, 3038, 3046, 3039, -1000, 2433, 2771,
+	-1000, 3144, -1000, 23932, 21631, 2845, -1000, 2985, -223, -270,
+	2609, 460, 460, 2763, 29301, 2609, -452, 85159, 268, -239,
+	2609, -1000, 393, 994, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13961,
+	21631, 265, 43107, 2367, 2365, 91208, 2239, 91208, 21631, 2364,
+	2359, 3044, 22398, 91154, -1000, 678, -1000, 2429, -1000, 2848,
+	2090, 613, 2642, 435, -1000, -1000, 2640, -1000, -1000, 2638,
+	2847, 2634, -1000, 353, -1000, 1765, 389, 1765, 1082, 2845,
+	1586, -1000, -1000, -1000, 798, -1000, -1000, -1000, -1000, 677,
+	949, -1000, 1687, -1000, 3143, -1000, -1000, 2427, -134, -1000,
+	2845, 56, 3218, 3217, -1000, 3216, 3215, -1000, 85159, 2845,
+	-1000, 2607, 21631, -1000, 2984, -1000, -1000, -1000, 437, -1000,
+	1699, 962, 1695, -1000, 949, -1000, 2761, -1000, -1000, 2632,
+	-1000, 268, -1000, 457, -1000, -239, 2609, -1000, -1000, 2356,
+	2354, -1000, 2134, 1998, -1000, 21631, 2297, 770, 760, 10185,
+	-1000, -1000, -1000, -1000, -1000, -1000, -461, -1000, 436, -462,
+	1155, -1000, -1000, 2346, -1000, 3243, 6558, -1000, -464, -1000,
+	-1000, -1000, 3092, 1727, 68659, -1000, 2845, 896, -1000, 268,
+	51402, 268, -1000, 41573, 1765, 2426, 1257, 21631, -1000, -1000,
+	-1000, -1000, -269, -1000, 1566, 67159, 727, 2425, 40806, 2845,
+	2424, 2399, 2334, 2303, -1000, -1000, -1000, 2630, -1000, 13194,
+	2232, -237, 1906, -1000, -1000, -1000, -1000, -1000, -164, 2924,
+	551, 1130, 1129, -239, -1000, -1000, 440, -1000, -1000, -1000,
+	1948, 1894, 91208, -1000, 2248, 91208, 3195, 3194, 3179, 105,
+	42340, 90815, 90770, 91208, 91208, 21631, 90603, 91208, 1934, -1000,
+	90413, 586, 91208, -1000, 29301, -1000, -1000, -1000, -1000, 3287,
+	2304, 22398, 2845, -1000, 2625, -1000, -1000, 41573, -1000, 23165,
+	-1000, 2607, 91208, -1000, 1566, -1000, 2084, 2079, 2922, 2920,
+	949, -1000, 2589, 40806, -1000, -1000, -1000, -1000, -1000, 21631,
+	-1000, -267, 437, -1000, 2919, 2917, 3250, -1000, 539, 534,
+	-1000, -239, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 90246,
+	66409, 440, 1886, 91208, -1000, -465, 91208, -466, 1031, 393,
+	1219, 1528, -1000, 719, -1000, 2343, -467, -468, -469, 1065,
+	21631, -470, 2341, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2761,
+	74659, 2472, 22398, 268, -1000, 2472, 2609, -1000, -1000, -1000,
+	2915, 949, -1000, 2914, 949, -12, -13, 2913, -1000, 2589,
+	-1000, -275, -1000, -1000, 3272, -1000, 3251, 1338, 1338, -1000,
+	-1000, -1000, -1000, -472, -1000, -1000, -1000, -1000, -473, 90056,
+	-475, 6532, -1000, -1000, -1000, 89889, -1000, -1000, -1000, -1000,
+	752, -1000, -1000, 3094, -1000, -1000, 89844, 89505, 89451, 91208,
+	2219, 89293, 2435, -1000, -1000, -1000, 2304, -205, 2472, -1000,
+	-1000, -1000, -1000, 380, 2912, -315, 2910, 379, 376, -1000,
+	-1000, -16, -1000, -1000, -1000, -1000, -1000, 1123, 2007, -1000,
+	89103, -1000, 88936, 1884, 91208, 1173, 91208, 1943, -1000, 21631,
+	-1000, 1219, -1000, 22398, 2332, 707, 21631, -476, 698, 687,
+	2328, -1000, 2085, -205, 374, -316, 2909, 2908, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1883, -1000, -1000, -477, 1172, 91208,
+	-1000, 2169, -1000, 6558, -1000, 2324, 1859, 88891, -1000, -1000,
+	-1000, -1000, -1000, 372, -317, -1000, 11161, -1000, -479, 91208,
+	-1000, 691, 681, -1000, -1000, 1169, 10878, -481, 2056, 2000,
+	-1000, 1158, 91208, -1000, -1000, -1000,
+}
+
+var yyPgo = [...]int16{
+	0, 3774, 290, 1741, 267, 3771, 304, 344, 336, 58,
+	3084, 3770, 338, 3767, 365, 3766, 32, 25, 23, 41,
+	12, 3414, 3412, 3410, 3407, 37, 3765, 27, 3764, 54,
+	52, 35, 50, 3763, 3405, 3762, 3760, 3758, 3396, 288,
+	243, 245, 47, 3757, 3756, 44, 40, 29, 3390, 3386,
+	3384, 3382, 3755, 3754, 3753, 242, 3752, 186, 3747, 3380,
+	3378, 3374, 3742, 3740, 88, 3736, 22, 53, 48, 3734,
+	4, 3733, 244, 333, 248, 3, 3732, 3728, 11, 250,
+	3726, 3725, 81, 3368, 3724, 3723, 3722, 3721, 3720, 3718,
+	3714, 173, 3712, 3703, 3358, 3702, 3356, 3699, 3353, 3347,
+	3342, 3338, 3324, 246, 3697, 3322, 3320, 3318, 3696, 3035,
+	274, 545, 282, 3695, 157, 171, 1946, 1826, 291, 3685,
+	262, 3684, 3682, 3679, 530, 312, 3676, 210, 103, 428,
+	208, 209, 213, 181, 3675, 79, 206, 383, 3671, 10,
+	3670, 132, 3665, 270, 3663, 271, 3089, 236, 261, 234,
+	3660, 3657, 202, 3656, 3654, 3653, 264, 3652, 3651, 284,
+	8051, 3649, 3648, 226, 3647, 162, 3645, 175, 3644, 3642,
+	3640, 3636, 263, 131, 108, 211, 155, 3635, 207, 3634,
+	3633, 241, 3631, 3630, 3629, 99, 66, 63, 3628, 144,
+	235, 3608, 76, 69, 45, 3592, 201, 65, 200, 194,
+	165, 3579, 3578, 151, 3577, 231, 43, 3576, 3575, 3573,
+	3572, 125, 98, 3570, 67, 3569, 230, 3568, 3566, 3565,
+	3564, 18, 3563, 3562, 120, 3561, 109, 3559, 232, 249,
+	195, 3556, 3555, 55, 64, 3554, 73, 16, 13, 167,
+	72, 34, 153, 101, 227, 218, 156, 188, 112, 180,
+	71, 177, 179, 102, 3551, 283, 228, 265, 133, 31,
+	3549, 3548, 255, 3547, 130, 257, 225, 229, 256, 3546,
+	3545, 158, 292, 3544, 185, 295, 591, 140, 192, 137,
+	3049, 293, 170, 3543, 3542, 3539, 3538, 134, 68, 143,
+	3537, 57, 287, 285, 289, 59, 39, 3536, 2429, 5777,
+	30, 8720, 21, 159, 118, 141, 7010, 56, 260, 3535,
+	84, 3532, 3531, 3530, 6213, 214, 117, 97, 205, 3528,
+	204, 74, 129, 3527, 3526, 3525, 3523, 3521, 3517, 3516,
+	203, 286, 3514, 3513, 77, 3511, 3509, 51, 61, 9,
+	7, 3508, 172, 277, 276, 269, 3507, 258, 75, 3506,
+	3505, 221, 190, 3504, 3503, 3502, 3500, 223, 136, 254,
+	253, 247, 142, 166, 3498, 138, 3497, 3496, 91, 5432,
+	164, 3495, 3489, 547, 154, 178, 233, 3487, 3484, 3483,
+	83, 3481, 3480, 2, 3479, 191, 60, 62, 196, 6,
+	197, 3478, 3477, 238, 3476, 3474, 176, 215, 3472, 189,
+	150, 82, 3471, 89, 3469, 187, 3468, 222, 169, 182,
+	3467, 3466, 70, 174, 3465, 212, 5, 15, 17, 3464,
+	3462, 3459, 3456, 3444, 3442, 3441, 3440, 146, 3439, 3437,
+	121, 80, 3436, 122, 113, 90, 3432, 3429, 3428, 3427,
+	3426, 240, 219, 96, 280, 123, 14, 3422, 3421, 8,
+	3419, 220, 3418, 119, 3416, 3402, 145, 3399, 3392, 85,
+	127, 46, 116, 3376, 3372, 148, 183, 3370, 147, 139,
+	3363, 272, 388, 3360, 601, 3351, 239, 217, 92, 110,
+	3349, 111, 124, 3344, 115, 3340, 19, 78, 3335, 20,
+	28, 1752, 3333, 0, 3571, 259, 3326, 163, 3311, 3308,
+	266, 3302,
+}
+
+var yyR1 = [...]int16{
+	0, 492, 492, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 260, 260, 108, 4, 4,
+	10, 10, 9, 15, 6, 6, 11, 11, 11, 12,
+	12, 7, 7, 13, 13, 14, 14, 5, 5, 139,
+	139, 8, 8, 138, 138, 138, 257, 257, 257, 257,
+	254, 254, 140, 140, 137, 137, 145, 16, 16, 16,
+	122, 122, 17, 18, 18, 18, 18, 495, 495, 154,
+	154, 152, 152, 153, 153, 251, 251, 253, 253, 19,
+	19, 19, 19, 19, 19, 19, 19, 19, 19, 408,
+	408, 408, 262, 262, 267, 267, 267, 270, 270, 270,
+	270, 238, 239, 240, 241, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 483, 483,
+	483, 483, 483, 405, 405, 406, 406, 407, 407, 407,
+	407, 407, 407, 407, 407, 407, 407, 409, 409, 409,
+	484, 484, 484, 484, 484, 295, 295, 295, 295, 447,
+	447, 463, 463, 463, 464, 464, 462, 462, 462, 462,
+	462, 466, 466, 467, 467, 465, 465, 465, 465, 469,
+	469, 470, 470, 468, 468, 468, 468, 468, 468, 468,
+	468, 468, 468, 468, 468, 468, 468, 468, 450, 450,
+	450, 59, 59, 59, 59, 59, 64, 64, 60, 60,
+	60, 60, 60, 60, 471, 471, 471, 471, 471, 471,
+	471, 471, 471, 471, 471, 471, 471, 471, 471, 471,
+	471, 471, 471, 471, 471, 471, 471, 471, 471, 471,
+	471, 471, 471, 471, 471, 471, 471, 472, 472, 473,
+	473, 473, 473, 473, 473, 473, 473, 473, 473, 473,
+	473, 473, 473, 473, 473, 473, 473, 473, 473, 473,
+	473, 473, 473, 473, 473, 473, 473, 473, 473, 473,
+	473, 473, 473, 473, 473, 473, 473, 473, 474, 474,
+	475, 475, 476, 476, 476, 476, 477, 477, 477, 477,
+	477, 478, 478, 478, 478, 478, 478, 478, 479, 479,
+	480, 480, 3, 2, 425, 425, 426, 426, 427, 427,
+	427, 427, 428, 428, 429, 429, 430, 431, 431, 432,
+	432, 433, 433, 433, 433, 433, 433, 433, 433, 433,
+	433, 32, 32, 32, 32, 32, 32, 294, 294, 290,
+	290, 290, 292, 292, 293, 293, 293, 449, 449, 449,
+	443, 443, 443, 445, 445, 444, 444, 444, 446, 446,
+	451, 451, 451, 451, 451, 454, 454, 453, 453, 453,
+	453, 453, 453, 453, 453, 453, 453, 455, 455, 455,
+	452, 452, 173, 173, 456, 456, 461, 461, 457, 457,
+	458, 458, 460, 460, 460, 459, 459, 459, 459, 334,
+	334, 192, 192, 80, 80, 81, 81, 81, 227, 227,
+	20, 20, 20, 20, 20, 20, 26, 26, 29, 29,
+	29, 29, 67, 67, 68, 30, 30, 30, 30, 69,
+	69, 70, 33, 33, 33, 33, 33, 33, 77, 77,
+	77, 76, 76, 75, 75, 75, 75, 75, 75, 35,
+	36, 37, 496, 496, 496, 71, 71, 42, 42, 42,
+	45, 45, 45, 46, 46, 46, 43, 44, 47, 31,
+	31, 31, 31, 78, 78, 73, 73, 72, 72, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 34, 34, 34, 34, 34, 34, 38, 38, 38,
+	39, 39, 40, 40, 41, 41, 25, 424, 424, 424,
+	66, 66, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 27, 27, 27, 27, 27, 28, 28, 28, 28,
+	28, 28, 28, 28, 28, 28, 28, 28, 28, 89,
+	89, 371, 372, 372, 372, 372, 372, 372, 372, 372,
+	358, 358, 359, 359, 359, 359, 359, 359, 348, 348,
+	348, 322, 322, 322, 322, 322, 322, 322, 322, 322,
+	322, 322, 322, 322, 322, 322, 322, 322, 321, 321,
+	321, 321, 321, 321, 325, 325, 323, 323, 323, 323,
+	323, 323, 323, 323, 323, 323, 323, 323, 323, 323,
+	323, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 326, 326, 326, 326, 326, 327, 327, 327, 327,
+	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
+	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
+	327, 327, 327, 329, 328, 328, 328, 328, 328, 328,
+	328, 328, 328, 357, 357, 331, 331, 330, 330, 330,
+	351, 351, 352, 352, 352, 349, 349, 349, 350, 350,
+	335, 335, 335, 335, 335, 335, 335, 335, 335, 336,
+	353, 337, 337, 338, 338, 338, 338, 338, 338, 339,
+	339, 340, 340, 340, 342, 342, 347, 347, 346, 346,
+	346, 346, 346, 346, 343, 343, 343, 343, 343, 343,
+	344, 344, 344, 345, 356, 356, 356, 356, 356, 332,
+	51, 51, 61, 392, 392, 392, 392, 392, 392, 392,
+	392, 392, 392, 392, 392, 392, 392, 392, 392, 393,
+	393, 398, 398, 284, 284, 285, 285, 62, 62, 62,
+	62, 62, 179, 179, 65, 65, 65, 394, 394, 396,
+	396, 396, 396, 396, 396, 396, 396, 395, 395, 397,
+	397, 360, 360, 389, 389, 388, 388, 387, 387, 387,
+	387, 387, 387, 387, 369, 369, 370, 370, 384, 384,
+	384, 384, 384, 384, 384, 384, 384, 364, 364, 364,
+	363, 363, 365, 365, 368, 368, 368, 386, 386, 385,
+	385, 385, 361, 361, 361, 399, 399, 399, 399, 399,
+	113, 113, 362, 362, 362, 400, 366, 366, 367, 367,
+	367, 417, 418, 416, 416, 416, 416, 416, 180, 180,
+	180, 391, 391, 391, 390, 390, 390, 390, 390, 390,
+	390, 390, 390, 390, 390, 390, 390, 390, 390, 390,
+	390, 390, 390, 390, 390, 390, 390, 390, 390, 390,
+	390, 390, 390, 390, 390, 390, 390, 390, 390, 390,
+	390, 390, 390, 390, 390, 390, 390, 390, 390, 390,
+	390, 390, 390, 390, 114, 114, 114, 115, 115, 341,
+	341, 373, 373, 373, 373, 373, 491, 491, 491, 374,
+	374, 374, 374, 374, 374, 482, 482, 481, 481, 481,
+	375, 375, 376, 377, 377, 377, 378, 378, 378, 380,
+	380, 291, 291, 381, 381, 382, 382, 379, 379, 379,
+	383, 383, 421, 421, 421, 420, 420, 83, 83, 83,
+	83, 83, 88, 85, 85, 84, 84, 84, 93, 93,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 82, 82, 304, 304, 87, 87, 226,
+	226, 226, 289, 289, 414, 414, 415, 415, 413, 413,
+	413, 413, 413, 413, 413, 413, 413, 413, 413, 413,
+	413, 413, 413, 413, 413, 413, 413, 413, 413, 413,
+	413, 413, 402, 402, 401, 401, 403, 404, 404, 404,
+	404, 404, 410, 410, 411, 411, 412, 412, 412, 86,
+	86, 86, 86, 174, 174, 22, 22, 90, 90, 448,
+	448, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+	23, 23, 23, 419, 419, 419, 24, 24, 94, 95,
+	95, 95, 303, 303, 303, 303, 303, 98, 98, 98,
+	98, 255, 255, 256, 256, 99, 99, 99, 99, 100,
+	100, 100, 100, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 134,
+	276, 276, 275, 275, 274, 274, 274, 277, 277, 277,
+	277, 319, 319, 319, 97, 97, 97, 109, 109, 104,
+	104, 107, 107, 107, 107, 110, 110, 111, 111, 112,
+	112, 105, 106, 48, 49, 49, 49, 49, 50, 102,
+	102, 102, 103, 103, 103, 103, 124, 124, 125, 125,
+	498, 498, 498, 101, 499, 116, 117, 117, 119, 119,
+	119, 120, 120, 120, 121, 121, 121, 281, 281, 281,
+	281, 281, 281, 281, 118, 118, 118, 128, 128, 132,
+	132, 132, 132, 131, 131, 131, 131, 229, 229, 234,
+	311, 311, 208, 208, 230, 230, 235, 235, 235, 236,
+	236, 237, 237, 237, 237, 237, 232, 232, 231, 231,
+	233, 195, 195, 195, 195, 193, 193, 193, 193, 193,
+	193, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 191, 191, 309, 309,
+	309, 309, 308, 308, 308, 136, 136, 141, 141, 143,
+	143, 143, 143, 143, 143, 143, 143, 146, 210, 210,
+	500, 500, 164, 164, 165, 165, 165, 165, 166, 166,
+	166, 166, 166, 168, 168, 168, 169, 169, 169, 169,
+	169, 169, 170, 171, 171, 167, 167, 167, 189, 189,
+	249, 249, 250, 250, 248, 248, 252, 252, 142, 142,
+	144, 144, 144, 144, 149, 149, 150, 150, 151, 151,
+	316, 316, 316, 155, 155, 155, 157, 156, 156, 156,
+	156, 156, 156, 158, 158, 485, 486, 486, 487, 487,
+	487, 487, 488, 488, 488, 488, 488, 488, 488, 488,
+	488, 488, 489, 489, 490, 490, 159, 159, 161, 162,
+	162, 160, 160, 160, 160, 160, 160, 172, 172, 163,
+	175, 175, 175, 175, 176, 176, 133, 133, 133, 133,
+	133, 133, 133, 133, 273, 273, 178, 178, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 204, 204,
+	204, 204, 204, 204, 181, 181, 181, 181, 181, 181,
+	181, 127, 127, 205, 205, 205, 148, 147, 147, 130,
+	130, 129, 129, 206, 206, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 198, 198, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 203, 203, 203, 203, 203,
+	497, 497, 200, 200, 199, 199, 199, 199, 199, 199,
+	123, 123, 123, 123, 123, 317, 317, 317, 318, 318,
+	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
+	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
+	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
+	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 79, 79, 217, 217, 126, 126, 215, 215,
+	216, 218, 218, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 186, 186, 186, 186, 186, 186, 186, 186,
+	186, 186, 186, 188, 188, 188, 219, 219, 219, 207,
+	207, 135, 135, 135, 220, 220, 221, 221, 222, 222,
+	223, 224, 224, 225, 225, 228, 242, 242, 242, 243,
+	243, 243, 243, 190, 190, 190, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	183, 183, 182, 182, 182, 184, 184, 184, 184, 185,
+	185, 501, 501, 247, 247, 246, 246, 245, 245, 245,
+	245, 245, 245, 245, 258, 258, 209, 209, 212, 212,
+	211, 213, 259, 259, 264, 264, 264, 264, 261, 261,
+	265, 265, 265, 265, 268, 268, 269, 269, 269, 266,
+	266, 266, 266, 263, 263, 263, 313, 313, 313, 313,
+	271, 271, 280, 280, 278, 278, 279, 279, 272, 272,
+	333, 333, 333, 286, 286, 287, 287, 288, 288, 282,
+	282, 282, 282, 283, 283, 310, 310, 305, 305, 306,
+	306, 312, 312, 307, 307, 314, 314, 315, 315, 315,
+	315, 422, 422, 423, 423, 423, 354, 354, 437, 437,
+	355, 355, 438, 438, 439, 439, 434, 434, 435, 435,
+	436, 436, 436, 52, 440, 440, 441, 441, 442, 442,
+	442, 442, 53, 54, 54, 54, 54, 54, 54, 55,
+	55, 57, 57, 57, 57, 57, 56, 56, 56, 58,
+	58, 58, 63, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 301, 301, 301, 301, 301, 301,
+	301, 301, 301, 301, 301, 301, 301, 301, 301, 301,
+	301, 301, 301, 301, 301, 301, 302, 302, 302, 302,
+	302, 302, 299, 299, 299, 299, 299, 299, 300, 300,
+	300, 493, 494,
+}
+
+var yyR2 = [...]int8{
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 2, 13, 5, 7,
+	3, 3, 1, 5, 1, 1, 1, 3, 3, 3,
+	3, 1, 3, 1, 1, 1, 1, 1, 3, 2,
+	1, 10, 2, 0, 2, 2, 0, 2, 3, 6,
+	1, 3, 0, 2, 1, 3, 4, 9, 9, 10,
+	1, 1, 10, 9, 8, 7, 7, 1, 1, 1,
+	3, 1, 3, 1, 3, 0, 4, 0, 2, 3,
+	4, 5, 4, 4, 4, 6, 4, 7, 7, 1,
+	1, 1, 1, 3, 3, 2, 2, 2, 2, 2,
+	1, 0, 0, 0, 0, 2, 4, 3, 3, 2,
+	3, 12, 12, 14, 13, 15, 5, 5, 15, 16,
+	12, 15, 9, 9, 9, 4, 15, 1, 6, 9,
+	8, 5, 7, 0, 1, 1, 2, 1, 3, 3,
+	3, 2, 2, 3, 3, 3, 3, 1, 2, 2,
+	0, 3, 3, 6, 3, 0, 3, 4, 4, 0,
+	3, 0, 2, 2, 1, 3, 1, 1, 2, 2,
+	2, 0, 2, 1, 2, 2, 2, 2, 2, 0,
+	1, 1, 2, 2, 3, 3, 5, 3, 3, 4,
+	5, 4, 4, 2, 2, 2, 2, 2, 0, 2,
+	2, 9, 10, 9, 5, 7, 0, 3, 9, 9,
+	9, 10, 6, 8, 2, 3, 2, 3, 3, 3,
+	4, 3, 3, 2, 2, 3, 2, 2, 2, 3,
+	2, 2, 3, 2, 2, 2, 3, 3, 2, 3,
+	3, 2, 2, 2, 2, 2, 1, 1, 3, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 3,
+	1, 3, 0, 1, 1, 1, 1, 3, 1, 3,
+	3, 0, 2, 5, 5, 5, 7, 5, 0, 3,
+	0, 3, 4, 4, 0, 1, 1, 3, 2, 3,
+	3, 3, 0, 1, 1, 3, 2, 0, 1, 1,
+	2, 2, 2, 2, 1, 2, 2, 3, 3, 3,
+	3, 2, 4, 5, 4, 6, 7, 2, 3, 3,
+	3, 3, 0, 3, 0, 3, 3, 1, 1, 1,
+	3, 2, 1, 1, 3, 3, 2, 1, 1, 3,
+	1, 2, 5, 4, 6, 0, 1, 4, 3, 3,
+	3, 6, 6, 5, 5, 5, 5, 4, 3, 5,
+	1, 3, 1, 3, 3, 5, 0, 5, 0, 3,
+	0, 3, 0, 3, 4, 0, 1, 1, 3, 0,
+	2, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	1, 1, 1, 1, 1, 1, 4, 2, 5, 8,
+	4, 7, 1, 2, 5, 7, 10, 8, 11, 1,
+	2, 5, 5, 5, 5, 6, 5, 7, 1, 1,
+	1, 1, 3, 1, 1, 1, 2, 1, 1, 2,
+	2, 5, 0, 1, 2, 1, 3, 5, 7, 8,
+	7, 9, 10, 7, 9, 10, 2, 2, 2, 2,
+	4, 2, 4, 2, 3, 1, 3, 3, 3, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 4, 3, 2, 4, 3, 4, 4,
+	1, 3, 1, 3, 3, 3, 4, 0, 2, 3,
+	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 5,
+	5, 5, 1, 1, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 0, 1,
+	1, 0, 2, 2, 3, 2, 2, 2, 2, 2,
+	2, 2, 2, 6, 8, 3, 6, 2, 3, 1,
+	1, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 3, 2, 2, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 1, 2, 3, 2, 3,
+	3, 2, 3, 3, 2, 3, 4, 2, 2, 2,
+	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 4, 4, 1, 1, 1, 1, 1, 1, 2,
+	1, 1, 1, 1, 3, 0, 3, 0, 3, 3,
+	0, 5, 0, 3, 5, 0, 1, 1, 0, 1,
+	2, 3, 3, 2, 2, 2, 2, 2, 4, 3,
+	1, 0, 1, 3, 3, 3, 2, 2, 2, 0,
+	1, 2, 2, 2, 0, 1, 0, 1, 1, 1,
+	1, 2, 2, 2, 5, 5, 5, 4, 4, 4,
+	4, 4, 4, 4, 2, 1, 2, 1, 2, 2,
+	5, 5, 3, 2, 2, 2, 2, 1, 1, 1,
+	1, 3, 2, 1, 1, 2, 2, 3, 3, 0,
+	3, 0, 3, 1, 1, 0, 1, 5, 4, 2,
+	3, 3, 0, 1, 0, 1, 1, 1, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 1, 3, 5,
+	5, 5, 4, 0, 1, 1, 2, 2, 3, 2,
+	3, 3, 1, 1, 0, 1, 1, 1, 3, 5,
+	3, 3, 3, 5, 3, 2, 2, 1, 1, 1,
+	1, 1, 0, 1, 0, 1, 1, 1, 3, 3,
+	3, 4, 3, 2, 1, 11, 12, 12, 13, 13,
+	0, 1, 3, 2, 1, 5, 1, 1, 0, 2,
+	2, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	1, 0, 2, 3, 3, 3, 3, 4, 5, 3,
+	3, 4, 3, 3, 3, 4, 4, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 2, 4, 4, 3,
+	5, 3, 3, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+	0, 1, 6, 1, 4, 5, 5, 6, 5, 0,
+	1, 0, 3, 1, 1, 0, 2, 0, 8, 9,
+	0, 2, 0, 1, 2, 0, 2, 1, 1, 1,
+	1, 1, 5, 4, 3, 7, 5, 5, 1, 3,
+	5, 4, 9, 11, 9, 2, 2, 3, 3, 4,
+	4, 4, 4, 3, 3, 3, 3, 6, 5, 4,
+	4, 5, 5, 8, 4, 5, 7, 2, 2, 2,
+	2, 3, 4, 3, 4, 1, 3, 3, 3, 3,
+	4, 5, 5, 3, 7, 6, 5, 2, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 4, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 4, 4,
+	3, 5, 3, 1, 1, 1, 1, 7, 7, 0,
+	1, 2, 0, 1, 0, 1, 1, 2, 5, 4,
+	3, 4, 4, 4, 4, 3, 3, 4, 4, 3,
+	7, 6, 8, 3, 3, 3, 3, 3, 3, 3,
+	3, 2, 0, 3, 1, 3, 4, 0, 6, 6,
+	4, 5, 0, 1, 1, 2, 3, 4, 3, 8,
+	11, 12, 15, 0, 3, 3, 3, 3, 5, 3,
+	5, 6, 6, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 0, 1, 1, 3, 2, 3, 8,
+	5, 1, 1, 1, 1, 1, 1, 4, 4, 4,
+	4, 1, 3, 1, 1, 2, 2, 4, 4, 3,
+	3, 3, 3, 3, 4, 3, 5, 5, 5, 4,
+	4, 4, 4, 4, 4, 3, 2, 2, 6, 2,
+	4, 3, 3, 4, 4, 5, 8, 9, 6, 3,
+	4, 4, 2, 4, 3, 2, 4, 5, 6, 2,
+	6, 6, 3, 3, 4, 2, 4, 3, 3, 3,
+	0, 1, 1, 1, 0, 2, 2, 0, 2, 3,
+	2, 0, 1, 1, 2, 4, 1, 0, 1, 2,
+	1, 2, 4, 4, 5, 0, 1, 0, 3, 0,
+	2, 4, 4, 2, 3, 4, 4, 5, 3, 4,
+	5, 4, 1, 1, 1, 1, 0, 3, 0, 1,
+	1, 1, 1, 3, 0, 2, 0, 2, 1, 2,
+	2, 1, 2, 2, 1, 2, 2, 0, 2, 2,
+	2, 2, 2, 2, 0, 1, 1, 3, 5, 1,
+	1, 3, 5, 1, 2, 3, 5, 2, 2, 6,
+	0, 1, 0, 3, 0, 1, 0, 2, 2, 4,
+	1, 2, 2, 2, 2, 2, 0, 2, 1, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 0, 1,
+	2, 2, 1, 1, 1, 1, 3, 1, 1, 1,
+	4, 5, 1, 2, 3, 1, 1, 2, 2, 4,
+	0, 1, 2, 6, 1, 3, 2, 4, 1, 1,
+	1, 1, 1, 5, 5, 8, 5, 5, 8, 5,
+	5, 8, 2, 2, 2, 3, 4, 4, 0, 1,
+	3, 1, 0, 3, 1, 3, 1, 3, 4, 6,
+	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
+	1, 1, 1, 1, 2, 2, 1, 2, 3, 2,
+	3, 3, 2, 2, 2, 12, 1, 3, 3, 3,
+	6, 7, 2, 3, 3, 4, 4, 5, 5, 8,
+	8, 3, 3, 4, 3, 4, 1, 3, 3, 2,
+	1, 1, 3, 1, 1, 1, 1, 1, 3, 3,
+	0, 5, 5, 5, 0, 2, 1, 3, 3, 3,
+	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
+	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
+	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 1, 1, 1, 3, 1, 3, 0,
+	1, 1, 3, 1, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 5, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 2, 2, 2, 2, 3, 3, 1, 1, 1,
+	1, 1, 1, 5, 6, 6, 6, 5, 5, 5,
+	6, 5, 5, 6, 5, 5, 5, 5, 6, 5,
+	5, 5, 4, 4, 5, 5, 5, 5, 5, 5,
+	4, 4, 4, 4, 4, 4, 4, 3, 6, 6,
+	4, 6, 6, 6, 4, 8, 8, 8, 8, 4,
+	6, 7, 7, 7, 9, 4, 7, 5, 4, 4,
+	4, 4, 2, 4, 3, 2, 2, 1, 2, 2,
+	2, 8, 8, 6, 6, 3, 4, 2, 2, 2,
+	0, 2, 1, 3, 4, 4, 4, 4, 4, 4,
+	0, 3, 4, 7, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 3, 3, 3, 3, 1, 2, 2, 1, 2,
+	1, 1, 1, 1, 1, 2, 1, 2, 2, 1,
+	2, 1, 1, 1, 0, 1, 0, 2, 1, 2,
+	4, 0, 2, 1, 1, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
+	5, 5, 1, 2, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 2, 3, 3, 0, 3, 4, 1,
+	3, 1, 4, 5, 1, 3, 2, 1, 0, 2,
+	1, 0, 3, 1, 3, 2, 0, 1, 1, 0,
+	2, 4, 4, 1, 1, 1, 0, 2, 4, 3,
+	4, 6, 5, 4, 2, 4, 3, 4, 6, 5,
+	1, 4, 1, 3, 4, 1, 4, 3, 6, 2,
+	3, 1, 1, 0, 3, 1, 3, 3, 1, 1,
+	1, 1, 1, 1, 0, 5, 1, 3, 2, 3,
+	3, 1, 1, 3, 3, 3, 3, 3, 1, 3,
+	1, 2, 2, 3, 1, 1, 1, 1, 1, 3,
+	3, 4, 3, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 0, 2, 0, 3, 0, 1,
+	0, 3, 3, 0, 1, 1, 1, 0, 1, 1,
+	1, 1, 1, 0, 1, 0, 2, 1, 1, 1,
+	1, 1, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 1, 1, 0, 1, 0, 4,
+	0, 1, 0, 3, 0, 3, 0, 4, 0, 2,
+	0, 4, 4, 3, 1, 3, 2, 4, 1, 2,
+	1, 2, 2, 3, 5, 6, 7, 10, 3, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	3, 3, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1,
+}
+
+var yyChk = [...]int16{
+	-1000, -492, -1, -4, -10, -15, -16, -17, -18, -19,
+	-21, -83, -22, -23, -24, -94, -98, -99, -100, -96,
+	-97, -104, -105, -106, -102, -101, -31, -34, -38, -25,
+	-108, -48, -49, -50, -52, -53, -54, -58, -59, -60,
+	-62, -61, -51, -63, -117, -5, 11, -146, -493, 12,
+	-140, 35, -89, 194, -483, -85, -84, -86, -87, -88,
+	197, 196, 234, 198, 654, 375, 655, 227, 74, 454,
+	-107, 457, 458, -498, 310, 311, 693, 25, 43, 459,
+	461, 36, 37, 319, 250, 341, 343, 201, 455, 437,
+	438, 366, 702, 387, -6, 660, 56, 78, 195, 229,
+	228, 32, -8, -11, 203, -12, -13, -7, 775, 91,
+	-224, 20, -116, -499, -224, -10, -9, -4, 660, -116,
+	-122, 14, 15, 13, 565, -116, -371, 29, -3, 119,
+	78, -8, 11, -283, -294, 99, 556, 202, -292, 335,
+	337, 339, 212, 252, -280, -282, -290, 260, 270, 50,
+	214, 213, 271, 203, 337, -280, 204, 206, 252, 556,
+	202, 243, 242, 245, 337, 339, 275, 654, 203, -160,
+	-314, -299, -301, -300, 355, 84, -298, 585, 589, 275,
+	592, 593, 598, 345, 92, 53, 375, 579, 367, 376,
+	224, 534, 580, 338, 344, 347, 368, 743, 377, 379,
+	380, 577, 578, 55, 206, 567, 568, 571, 217, 761,
+	360, 225, 657, 335, 271, 768, 763, 765, 349, 766,
+	524, 616, 454, 473, 387, 465, 492, 658, 714, 283,
+	305, 462, 393, 541, 617, 352, 298, 373, 715, 325,
+	293, 530, 536, 308, 457, 618, 619, 620, 550, 251,
+	281, 716, 463, 302, 304, 303, 265, 584, 309, 42,
+	230, 489, 667, 655, 260, 717, 623, 710, 622, 254,
+	287, 621, 332, 41, 33, 624, 253, 553, 110, 280,
+	718, 383, 538, 625, 510, 384, 249, 276, 278, 294,
+	720, 662, 356, 236, 537, 439, 485, 366, 130, 258,
+	320, 385, 721, 511, 515, 722, 542, 342, 319, 628,
+	723, 724, 386, 666, 288, 725, 205, 348, 629, 284,
+	726, 261, 452, 544, 353, 509, 215, 659, 226, 57,
+	240, 545, 513, 631, 519, 522, 440, 382, 441, 727,
+	728, 729, 730, 364, 362, 633, 363, 365, 635, 272,
+	300, 663, 632, 665, 62, 200, 669, 517, 516, 518,
+	301, 683, 540, 497, 495, 731, 732, 357, 221, 733,
+	734, 496, 23, 735, 736, 548, 324, 359, 737, 738,
+	739, 634, 297, 639, 615, 740, 741, 539, 512, 514,
+	259, 129, 654, 282, 742, 361, 535, 370, 670, 661,
+	346, 295, 640, 744, 389, 296, 239, 232, 549, 434,
+	435, 450, 451, 378, 745, 438, 746, 747, 748, 749,
+	334, 340, 339, 458, 758, 371, 641, 459, 277, 306,
+	664, 750, 642, 643, 751, 752, 269, 252, 552, 543,
+	759, 61, 526, 521, 374, 390, 464, 336, 436, 447,
+	442, 443, 445, 446, 449, 448, 444, 453, 529, 455,
+	279, 246, 644, 645, 646, 437, 647, 12, 299, 354,
+	648, 649, 650, 533, 372, 307, 771, 270, 273, 500,
+	241, 753, 754, 487, 488, 772, 456, 244, 234, 124,
+	551, 394, 330, 709, 337, 391, 292, 60, 247, 54,
+	652, 755, 756, 274, 248, 668, 291, 460, 351, 671,
+	203, 84, -303, -298, -301, -302, -299, -300, 355, 235,
+	59, 97, 65, 64, 84, -298, 654, 147, 493, 541,
+	194, 531, 532, 538, -364, 539, 242, 434, 374, 245,
+	-319, 203, -276, 236, 244, 530, 342, 361, 589, 248,
+	249, 276, 435, 441, 204, 205, 38, 543, 542, 534,
+	-314, -109, 460, -109, -109, 207, 460, -124, 236, 198,
+	-160, 235, -78, 84, 312, -78, 35, 84, 84, 584,
+	-172, -306, -314, 84, -298, 42, 84, 459, 533, 533,
+	-160, 86, 661, 251, 27, -472, -446, 370, -471, -444,
+	195, 194, 15, 196, 275, 375, 376, 341, 204, 13,
+	36, 743, 222, 377, 378, 11, 227, 379, 380, 243,
+	14, 381, -473, -449, 395, 396, 397, 398, 399, 400,
+	401, 402, 403, 404, 405, 406, 407, 408, 409, 410,
+	411, 412, 413, 414, 415, 416, 417, 418, 419, 420,
+	421, 422, 423, 424, 425, 426, 427, 428, 429, 430,
+	431, 432, 433, 83, 84, -298, -278, 209, 378, 434,
+	456, 434, 434, -285, -284, 392, 519, 147, 83, -139,
+	713, -137, -145, -316, -314, -299, -300, -210, -500, 256,
+	252, 556, -272, 337, -292, 208, -141, -143, -144, -164,
+	-147, 711, -493, -142, -485, -160, -148, 84, 614, -120,
+	10, -119, -121, 9, 8, -120, -243, 22, 21, -281,
+	-117, -243, -494, 80, -494, -139, -131, 137, -133, -314,
+	-177, 102, -187, 34, 30, -186, 355, 235, -178, -214,
+	-299, -213, -148, 147, -318, 135, 136, 144, 103, 190,
+	-198, -196, -197, -199, -201, -202, 230, 487, 488, 83,
+	85, 93, 86, 87, 88, 89, 94, 95, 96, -306,
+	-301, -300, -297, 585, 589, 592, 593, 598, -211, -493,
+	148, 149, 150, 151, 152, 153, 154, 155, 156, 157,
+	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 189, 68, 69, 583, 202, 566, 567, 490, 568,
+	13, 569, 570, 571, 656, 224, 575, 105, 56, 329,
+	557, 59, 63, 558, 562, -203, 559, 563, 564, 577,
+	578, 579, 580, 209, 556, 141, 565, 602, 603, 604,
+	605, 606, 607, 608, 609, 611, 612, 610, 586, 587,
+	588, 590, 591, 594, 595, 596, 597, 599, 600, 601,
+	685, 199, 195, 198, 101, 29, 31, 686, 257, 104,
+	471, 505, 574, 21, 25, 218, 201, 493, 216, 146,
+	211, 219, 314, 316, 194, 72, 687, 554, 315, 531,
+	681, 678, 680, 679, 484, 482, 313, 688, 15, 32,
+	228, 264, 689, 480, 196, 255, 108, 109, 690, 47,
+	46, 8, 317, 229, 323, 481, 691, 692, 24, 75,
+	220, 16, 214, 245, 767, 693, 341, 18, 128, 19,
+	694, 675, 677, 676, 208, 121, 204, 757, 70, 262,
+	695, 469, 474, 475, 476, 477, 478, 470, 98, 696,
+	697, 118, 328, 66, 614, 38, 250, 711, 572, 327,
+	698, 44, 43, 504, 508, 503, 326, 520, 119, 22,
+	560, 561, 36, 699, 700, 238, 507, 468, 502, 712,
+	701, 673, 267, 73, 392, 483, 773, 39, 76, 233,
+	388, 358, 48, 99, 20, 263, 71, 40, 581, 125,
+	774, 486, 210, 242, 702, 546, 266, 703, 126, 479,
+	713, 222, 120, 461, 197, 369, 311, 223, 333, 26,
+	343, 704, 256, 127, 532, 672, 705, 576, 35, 227,
+	310, 467, 212, 706, 268, 322, 312, 321, 707, 708,
+	350, 49, 769, 684, 203, 45, 107, 506, 466, 501,
+	207, 573, 243, 318, 9, 50, 37, 527, 14, 381,
+	74, 77, 494, 491, 499, 498, 213, 770, 17, 331,
+	582, 660, 547, 100, 682, 528, 555, -116, -116, -116,
+	-261, 456, -268, 339, 347, 34, -265, 542, 543, -266,
+	-269, -263, -214, 519, 740, 741, 541, 493, 540, -306,
+	-314, -299, 355, 235, 147, 586, 587, 588, 105, 490,
+	566, 602, 557, 559, 554, 558, 556, 603, 30, 96,
+	604, 583, 209, 13, 190, 590, 591, 605, 606, 607,
+	68, 560, 561, 656, 141, 102, 608, 609, 94, 611,
+	612, 329, 565, 69, 610, 202, 596, 595, 594, 597,
+	569, 570, 95, 562, 563, 564, 56, 600, 599, 601,
+	29, -3, -2, -6, -493, -160, -372, -359, -362, -360,
+	-361, -306, -299, -301, -302, 231, -300, 219, -400, -384,
+	-399, 84, -298, 216, 210, 212, 214, 213, 50, -363,
+	220, 204, 51, -224, -116, 204, 206, 565, -279, 209,
+	-279, 243, 242, 245, 275, -293, 268, 245, -279, -279,
+	744, -279, 203, -292, 111, 111, -90, -160, -448, -443,
+	-449, 203, -278, -278, -278, -278, -278, -278, -278, -278,
+	-278, -278, -278, 84, -303, -160, 191, -95, -160, -152,
+	16, 16, 77, 77, 84, -303, 84, 440, 382, 35,
+	-277, 119, 17, 556, 202, 203, 242, 245, 243, 206,
+	252, 275, -277, -366, 16, 121, 246, 246, 246, 246,
+	246, 247, 246, -275, 533, 535, 536, 537, -276, -274,
+	16, 121, 17, -134, 119, 24, -493, -243, -243, -274,
+	246, 138, -111, 101, -111, 84, 459, 207, -125, 237,
+	-124, -125, -189, -167, 29, 6, 7, 111, 35, 35,
+	83, 60, 35, -73, -72, -74, 298, 299, 300, 301,
+	302, 303, 304, 305, 306, 307, 308, 309, 35, -39,
+	84, -40, 314, -41, 84, -424, 78, 191, -354, 519,
+	84, -440, -441, -160, 324, 546, 325, 86, 86, 76,
+	361, 76, 79, 207, 79, 76, -474, 371, 78, -474,
+	339, 371, 372, 270, 337, 206, -474, -474, 339, -474,
+	-474, -474, 358, -474, -474, 533, -474, -474, -474, 373,
+	374, -474, 531, 206, -474, -474, -474, -474, -474, 81,
+	27, -472, -446, 370, 30, 436, 439, 546, 660, -65,
+	452, 453, -179, 27, -392, 147, 383, 384, 385, 386,
+	382, 361, 388, 389, 390, 246, 391, 203, 533, 382,
+	-6, -137, 79, -247, -493, 79, -211, -493, -278, 84,
+	-347, -346, -343, -344, -345, -342, 34, 203, -278, 275,
+	-155, -157, -156, -158, 66, 70, 72, 67, 68, 69,
+	534, 73, -288, 29, -147, -493, -136, -9, -146, -141,
+	-493, -165, 125, -175, -288, -166, 74, 208, 75, 29,
+	-167, -168, -169, -170, -171, 6, 7, 55, -493, -493,
+	-7, -8, 27, 28, -14, -7, -12, -14, 27, 28,
+	27, 28, -7, -244, 24, 36, -190, 86, 89, -214,
+	34, -225, -228, -133, -314, -239, -128, 27, 28, 67,
+	58, 65, 64, 16, -309, 101, 99, 100, 118, -308,
+	29, 84, 83, -303, 191, -133, -181, 121, 102, 119,
+	120, 104, 123, 132, 131, 143, 135, 136, 137, 138,
+	139, 140, 141, 133, 134, 146, 142, 111, 112, 113,
+	114, 115, 116, 117, -273, -493, -148, -493, 191, -493,
+	191, 192, 193, 191, -187, -187, -187, -187, -187, -187,
+	-187, 83, 83, 83, -493, 191, 191, 191, -493, -493,
+	-493, -493, -493, -206, -133, 660, -493, 203, -493, -493,
+	-493, -493, -493, -493, -493, -493, -493, -493, -493, -493,
+	-493, -493, -493, -217, -133, -493, -493, -497, -493, 60,
+	24, -497, -497, -200, -497, -493, -200, -200, -493, -493,
+	-493, -493, -493, -493, -493, -493, -493, -493, -493, -493,
+	-493, -493, -493, -493, -493, -493, -493, -493, -493, -493,
+	-493, -493, -493, -493, -493, -493, -493, -493, -493, -200,
+	-200, -200, -493, -272, -272, 16, -152, -153, -160, -163,
+	-314, 79, -262, -267, 544, 546, 456, -266, 34, 344,
+	27, -446, 24, 339, -266, -313, -306, 83, 34, 147,
+	-370, 111, 122, 35, 191, -2, -224, 80, 79, -321,
+	-325, 473, -327, -326, -328, -329, -323, -324, -79, 497,
+	495, 491, 490, 493, 496, 147, 494, 500, 501, 502,
+	503, 504, 505, 506, 507, 508, 509, 510, 35, 230,
+	487, 488, 489, 671, 511, 512, 513, 514, 515, 213,
+	516, 517, 518, 84, 465, 492, 658, 466, 467, 468,
+	469, 470, 471, 474, 475, 476, 701, 477, 478, 479,
+	480, 692, 481, 691, 482, 483, 484, 485, -321, -321,
+	-321, -321, -321, -368, -304, -399, -400, 84, -298, -303,
+	78, 78, 51, -363, -365, -363, -363, -363, -368, 84,
+	-298, -368, 51, -243, -281, -279, -279, -294, 212, 252,
+	-292, 84, 102, 84, -159, -306, -314, -172, -279, -279,
+	269, -279, -452, -451, -443, -446, 684, -160, -279, -293,
+	-443, 274, 272, 273, 79, 207, 79, 207, 81, -278,
+	-306, -154, -160, -152, 84, 84, -159, -172, -172, -445,
+	-443, -446, -173, -306, -314, -315, -314, -296, -301, -302,
+	685, 199, 27, 195, 198, 101, 29, 31, 686, 257,
+	104, 471, 147, 586, 587, 588, 505, 574, 21, 25,
+	218, 105, 201, 490, 493, 216, 146, 211, 219, 314,
+	316, 566, 602, 194, 72, 687, 557, 559, 554, 558,
+	315, 556, 531, 681, 678, 680, 679, 484, 482, 313,
+	34, 688, 15, 603, 32, 228, 264, 28, 689, 139,
+	480, 196, 613, 255, 108, 109, 690, 47, 46, 8,
+	30, 317, 229, 96, 323, 604, 481, 691, 692, 24,
+	75, 220, 16, 214, 245, 767, 693, 341, 18, 583,
+	128, 19, 694, 675, 677, 676, 209, 208, 121, 204,
+	757, 70, 262, 13, 695, 469, 474, 475, 476, 477,
+	478, 470, 190, 98, 696, 697, 118, 328, 66, 590,
+	591, 614, 51, 38, 250, 605, 606, 711, 607, 572,
+	327, 698, 44, 43, 504, 508, 503, 326, 520, 68,
+	119, 22, 560, 561, 36, 699, 700, 656, 238, 507,
+	468, 502, 712, 701, 673, 141, 267, 73, 102, 392,
+	608, 609, 94, 483, 773, 39, 76, 233, 388, 358,
+	48, 99, 20, 263, 71, 40, 581, 125, 774, 611,
+	486, 210, 242, 702, 546, 266, 703, 126, 612, 479,
+	713, 222, 120, 461, 197, 329, 565, 369, 311, 223,
+	333, 26, 343, 69, 704, 256, 127, 610, 202, 532,
+	672, 11, 705, 576, 35, 227, 310, 467, 212, 706,
+	268, 322, 312, 321, 707, 58, 708, 350, 49, 596,
+	595, 594, 597, 769, 67, 569, 570, 684, 203, 45,
+	107, 506, 466, 501, 207, 573, 243, 95, 318, 9,
+	50, 37, 527, 14, 381, 74, 77, 562, 563, 564,
+	56, 494, 491, 499, 600, 498, 599, 601, 213, 770,
+	106, 17, 331, 582, 660, 547, 100, 682, 528, 14,
+	196, 79, 83, -256, 84, -303, 83, -256, -255, -256,
+	-255, 246, -277, 83, -318, -133, -279, -279, -160, -160,
+	-160, -160, -160, -160, -160, -160, -277, -277, -277, -277,
+	-274, 16, -274, -275, -277, -314, -314, -133, -187, -443,
+	558, 137, -277, -314, -112, -110, 221, -110, -112, 84,
+	84, 459, -103, -4, -18, -16, -17, -125, -9, 39,
+	29, 29, 84, -73, -73, 83, -73, 79, 111, -73,
+	-39, 111, 84, 79, -187, -39, -189, 80, -206, -306,
+	-422, 757, 79, -442, 29, 546, 547, 520, -288, -55,
+	-306, 224, 59, -476, 203, 245, 242, 76, -476, -471,
+	378, -445, -444, -443, -474, -475, -306, -474, -474, -474,
+	533, -474, -474, -474, -474, -474, -474, -474, -474, -474,
+	-449, 76, 79, 361, 76, 16, 76, 207, -395, -397,
+	450, 451, 547, 548, 463, 382, 382, 382, 382, 382,
+	382, -393, -152, 660, -393, -152, 207, 257, -145, 29,
+	-246, -245, -307, -299, -300, -301, -302, 231, -306, -296,
+	-500, -160, -347, -344, -343, -345, 493, 541, 146, 553,
+	-160, -443, -173, -143, -143, -141, -143, 66, 66, 66,
+	71, 66, 71, 71, 66, 66, -156, -316, -288, 79,
+	-494, -494, -494, -9, -493, -316, -175, -288, 204, 204,
+	204, 104, 16, 53, 27, 104, 16, 53, 27, 104,
+	16, 53, 27, -130, -129, -132, 137, -133, -314, -187,
+	-257, 98, 14, 61, 121, 79, 23, 79, -242, 31,
+	32, 191, 59, -131, -257, 79, -160, -133, -133, -133,
+	-204, 94, 102, 95, 96, -308, 231, 137, -315, -307,
+	-301, -299, -300, 355, 235, -305, -296, 86, 88, -187,
+	-205, -211, -148, 90, 121, 119, 120, 104, -187, -187,
+	-187, -493, -187, -187, -187, -187, -187, -187, -187, -187,
+	-187, -187, -187, -187, -317, 84, 83, 147, -187, 84,
+	-307, -129, -307, -186, 230, 487, 488, 94, -186, -306,
+	-306, -118, 27, 28, -307, 534, -306, -307, -118, -118,
+	-118, -118, -118, 79, -494, -129, -129, -129, -494, -133,
+	-133, -129, -187, -129, -214, 83, -129, -214, 83, -129,
+	-187, 572, 573, 574, -129, -129, -118, -215, -216, 106,
+	-214, -299, -302, 355, 235, -129, -494, 24, -160, 86,
+	-193, -194, 663, 672, 673, 675, 678, 671, 670, 669,
+	667, 666, 665, 668, 664, 682, 681, 680, 679, 677,
+	676, 674, -193, -193, -195, 230, 489, 487, 488, -129,
+	-130, -129, -129, -494, -494, -132, -129, -132, -129, -129,
+	-132, -494, -494, -494, -129, -129, -129, -129, -129, -129,
+	-129, -129, -129, -129, -129, -129, -494, 86, -162, 98,
+	-160, -136, -160, -152, -495, 16, 77, -495, 79, 191,
+	-265, -268, 79, 545, 547, 548, -262, 8, -443, -408,
+	344, 27, -446, -339, -340, 146, 76, 97, 83, -133,
+	-307, -315, -243, -391, -359, -360, -361, -362, -322, -349,
+	526, 527, -331, 78, -330, 78, -79, 491, 490, 493,
+	-330, 491, 498, -330, 498, 498, -330, -330, -330, -330,
+	491, 494, 78, 78, -331, -331, -331, -330, -351, 78,
+	-351, 486, -351, -352, 78, -351, -352, -352, -352, -352,
+	-322, -322, -322, -322, -322, 210, 50, -399, -400, -386,
+	-385, 84, -303, -493, -133, -368, -368, -368, -368, -368,
+	-113, -304, 84, -244, -239, -306, -160, 206, 744, -279,
+	-347, 30, -347, -80, 257, 225, 191, 78, -172, -173,
+	260, 261, -172, -447, 79, 34, -453, 338, 86, 209,
+	-405, -406, -407, 84, 221, 238, 455, 383, -160, 235,
+	-160, -160, -443, -443, -449, -152, 76, 79, 79, 191,
+	723, 723, -160, 79, 83, 84, 84, -189, -367, 16,
+	121, -277, -160, -189, 16, -127, 231, 77, -497, -494,
+	461, 462, 84, -103, -187, 39, 39, -72, -186, -306,
+	84, -74, -41, -40, 80, -423, 208, 565, 83, -441,
+	-316, 519, 547, -316, -176, 17, -56, -181, -55, 226,
+	84, -477, 137, -306, -476, -477, -480, 660, 207, 80,
+	79, -474, -476, 341, 79, -476, -445, -443, -394, -396,
+	442, 444, 445, 443, 446, 447, 448, 449, 79, 111,
+	111, 464, -398, 24, -393, 546, -393, 83, -187, -147,
+	-494, 79, 191, -211, -405, 35, -369, -370, -369, -369,
+	-93, -415, -376, -91, -413, 125, 199, 196, 195, 271,
+	201, -342, 566, 254, 253, 287, 288, 75, 36, 200,
+	197, -82, -92, 234, 293, 239, 294, 198, 233, 295,
+	232, 296, 660, 291, 766, 524, 616, 617, 650, 92,
+	620, 251, 42, 204, 630, 623, 553, 626, 627, 383,
+	625, 629, 215, 633, 632, 634, 636, 637, 638, 347,
+	641, 642, 643, 252, 644, 645, 646, 372, 651, 9,
+	34, -454, -453, -460, 76, -150, -149, 76, 77, -151,
+	76, -149, 66, 66, 66, -250, 78, -316, -141, -494,
+	-252, -306, -175, -316, -493, -493, -493, -187, -187, 121,
+	-187, -187, 121, -187, -187, 121, -494, 79, 191, 79,
+	-254, 41, 40, -306, 521, 733, 39, 521, 733, 39,
+	61, -190, -190, -228, -188, 60, 86, 89, -239, -138,
+	16, -239, 94, 95, 96, 191, -493, -205, -187, -187,
+	-187, -127, 101, -133, -494, -494, -130, -129, -129, -129,
+	-129, -129, -133, -494, -494, -494, 79, 77, 29, -494,
+	77, 121, -494, 16, 16, -494, 16, 16, -494, -494,
+	16, -187, -187, -187, -494, -494, -129, -218, -216, 108,
+	-133, -494, -494, -494, -494, -160, -494, 79, 79, 16,
+	79, -494, -494, -494, -494, -229, 581, -229, -494, -494,
+	-494, -494, -494, -494, -229, -229, -229, -494, -494, -494,
+	-494, -494, -494, -494, -494, -494, -494, -494, -494, -494,
+	-251, 125, -160, 35, -251, 77, -136, -136, -163, -314,
+	137, -267, -270, 549, 546, 552, -446, 111, 207, 84,
+	83, 147, 83, 191, -244, -375, -390, 79, -376, 766,
+	524, 616, -342, 617, 650, 92, 620, 251, 42, 204,
+	630, 623, 553, 626, 627, 383, 625, 629, 215, 633,
+	632, 634, 636, 637, 638, 347, 641, 455, 642, 643,
+	252, 644, 645, 646, 372, 651, 9, 660, 771, 772,
+	726, 94, 102, -338, -340, 147, -335, -336, -353, -356,
+	-332, 29, 767, 529, 222, -362, 493, 541, 34, 76,
+	524, 210, 51, 50, 214, 92, 219, -350, 528, 86,
+	86, 592, -330, 498, -330, -330, -330, -330, -330, -357,
+	83, -357, 86, -351, 86, 51, -365, 80, 79, -331,
+	-331, -187, 80, 78, -310, 77, -247, -160, 684, -160,
+	-81, 13, 14, 15, -306, -425, -426, -427, -306, 121,
+	262, 263, 78, 334, 76, 334, -463, 369, -451, 339,
+	348, 101, 21, 660, -484, 102, -407, -369, 21, 660,
+	84, 238, -369, -369, 660, -369, 207, 207, -419, 223,
+	218, -160, -160, -443, -306, 76, 76, -256, -176, 84,
+	84, -274, -277, -160, -187, -446, 248, 249, -187, -187,
+	-161, 98, -442, -243, -133, -176, -493, 207, 191, 191,
+	-477, 207, 360, -445, -306, -477, 358, 341, -477, -64,
+	208, 16, 79, 111, 111, 111, 111, 111, 111, 111,
+	111, -397, 78, 78, 393, 36, -245, -307, -369, 84,
+	83, 147, 84, 83, 147, 83, -414, 79, -415, 199,
+	196, 287, 288, -413, 21, -289, -363, -421, -420, -361,
+	-362, 125, 211, 219, 219, 216, -289, -363, 210, 220,
+	125, 219, 216, -289, 204, -369, -289, 493, 146, 207,
+	38, 38, 372, 125, 372, 125, -369, -289, 211, -363,
+	-286, 219, -287, 207, 29, 292, 125, 125, 125, 125,
+	125, 125, 125, 125, 297, 684, -369, -369, -369, -369,
+	-369, -369, -369, -369, 622, 622, -369, -369, -369, -369,
+	-369, -369, -369, -369, -369, -369, -369, -369, -369, -369,
+	-369, -369, -369, -369, -369, -369, -369, -369, -369, -373,
+	-481, 83, -491, 92, 275, 347, 84, -298, -296, 86,
+	88, 87, -369, -369, 339, -466, 660, -174, 197, 281,
+	277, -133, 78, -133, -248, -306, -250, -494, 79, -175,
+	-248, -248, -248, 101, 207, -493, 101, 207, -493, 101,
+	207, -493, -288, -132, 137, -315, 83, 79, 83, 83,
+	522, -152, 522, -152, 62, -160, 16, 56, 56, -176,
+	613, -136, -131, 137, -307, -305, -130, -127, 101, -187,
+	-494, -494, -494, -494, -494, -494, -494, -320, 147, 490,
+	493, 230, 489, 482, 480, 692, 479, 481, 691, 509,
+	495, 526, 487, 527, 671, -317, -320, -317, -187, -187,
+	-187, -187, -187, -187, 16, 16, 16, 657, -224, 110,
+	-133, 107, -187, -187, -187, -187, -306, -234, -493, -229,
+	-229, -229, -229, -229, -229, -230, -229, -230, -230, -230,
+	-230, -230, -230, -230, -230, -230, -230, -230, -183, -184,
+	35, -182, -9, -493, -185, -501, 56, 60, -493, -259,
+	-264, -214, -299, -302, 231, -176, -136, -176, -176, 191,
+	546, 550, 551, 83, -445, -390, -369, -369, -369, 541,
+	493, 146, -369, -369, -369, -369, -369, 622, 622, -369,
+	-369, -369, -369, -369, -369, -369, -369, -369, -369, -369,
+	-369, -369, -369, -369, -369, -369, 456, -369, -369, -369,
+	-369, -369, -369, -373, -481, -369, -369, 684, -369, -369,
+	94, -493, 768, 86, -160, 35, 84, 147, 83, -186,
+	136, -318, -178, -197, -198, -196, -199, -493, 95, 96,
+	557, 558, 562, 559, 563, 564, 577, 578, 579, 580,
+	-306, -314, 68, 69, 235, 583, 202, 566, 567, 490,
+	568, 13, 569, 570, 571, 656, 224, 105, 56, 329,
+	209, 556, 141, 565, 554, 560, 561, 14, 51, 51,
+	51, 83, -304, 80, 80, 80, -330, 80, 79, 80,
+	79, 80, 79, -368, -368, -388, -387, 77, 215, 92,
+	625, 643, 756, 726, -385, -242, -242, -494, -180, 718,
+	719, -248, 76, -306, 29, -247, 86, -405, 76, 80,
+	79, -321, -306, -306, -306, -428, -429, -430, -306, -57,
+	84, 469, 470, 479, 482, 277, -57, -466, 344, -464,
+	-462, 350, 351, 352, 353, 354, -446, 349, -453, 346,
+	83, 84, 83, 683, 717, 738, 710, 30, -409, 86,
+	136, 135, -409, -409, -409, -409, -409, -481, -160, -443,
+	-249, 78, -248, -249, -189, -274, -251, 203, -243, -206,
+	-445, 137, 137, -306, 207, -445, 358, -479, 660, 16,
+	16, 358, 16, 394, -445, -396, 83, 83, 83, 86,
+	86, 86, 86, 86, -152, -152, 83, 84, 83, 147,
+	-375, -91, 199, 196, 287, 288, -377, -378, -381, -380,
+	126, 631, 698, 78, -358, 84, -303, -279, -282, 212,
+	210, -493, 639, 84, -304, -304, -304, -278, 51, 51,
+	-252, -304, -304, -306, -304, 34, 284, 285, 286, 84,
+	35, -369, 493, -252, 27, -252, 27, 34, 344, 289,
+	290, -358, -304, -306, -160, 220, 216, -304, -252, 27,
+	86, -252, -306, -252, 27, -252, 27, -252, 27, -252,
+	27, 652, -373, -133, -373, -491, -491, 83, 83, 83,
+	-369, -369, -115, 653, 221, -491, 83, -115, -491, -481,
+	83, -114, 221, 224, 226, -373, -373, -373, -491, -491,
+	-491, -491, 83, -374, 34, 624, 485, 619, 640, 618,
+	84, 94, 83, 83, -491, 34, -491, 34, -491, -373,
+	647, -491, -493, -408, -469, -470, -468, 347, 367, 368,
+	355, -467, -465, 362, 363, 364, 365, -459, 253, 254,
+	207, 282, 102, -456, 765, 278, -248, 80, 79, -165,
+	-306, -494, -494, -494, -187, -187, -187, -187, -187, -187,
+	-187, -187, -187, -316, 191, 536, -306, -337, -338, 521,
+	733, 521, 733, -271, 24, 16, -271, -219, 18, -239,
+	-494, -187, -230, -230, -230, -230, -230, -494, -331, -331,
+	-331, -331, -352, 486, -331, 470, -331, 470, -494, -494,
+	-494, -494, 24, 24, 24, 24, -494, -187, -187, -187,
+	-493, -126, 576, -133, 79, 79, -494, -494, -311, 84,
+	-258, 76, -258, -259, -288, -9, -494, -246, -185, -493,
+	11, 56, 60, 660, 203, -209, -212, -500, -252, -176,
+	79, -370, -370, -370, -370, -224, -176, -373, -373, -373,
+	-369, 35, -369, -491, -491, 83, 83, 83, -369, -369,
+	-115, -491, 83, -115, -491, -481, 83, -114, -373, -373,
+	-373, -491, -491, -491, -491, 83, -374, 84, 94, 83,
+	83, -491, 34, -491, 34, -491, -373, 647, -491, -493,
+	652, -491, -491, -187, 29, 78, 84, 147, 83, -186,
+	83, -187, 191, -203, 83, 86, 86, -387, -481, -369,
+	83, -369, -369, -242, 80, -160, -238, 29, -484, -160,
+	-431, -432, -433, 92, 659, 102, 264, 265, 221, 266,
+	267, 268, -427, -321, -321, -321, 80, 79, -321, 336,
+	-456, 336, -469, 101, 83, 83, 83, -455, 338, 101,
+	347, 21, 29, 21, 29, 83, 83, 83, 83, 86,
+	86, 86, 77, -248, -277, -189, -337, -160, -494, -479,
+	-445, -479, 341, -445, -445, 16, -445, 337, -64, 80,
+	80, -382, 639, -493, 536, 628, 51, -358, -226, 224,
+	225, -321, -321, -368, -365, 51, -401, -403, 125, 86,
+	-306, -304, 718, 719, 718, 719, 35, 196, 756, 726,
+	-358, -369, -317, 35, 372, 372, 372, 372, -226, -287,
+	207, 51, -304, 207, 372, 372, 98, 660, 83, 83,
+	621, 635, -482, -481, -468, 356, 724, 340, 369, 86,
+	86, 124, 36, 37, -465, 86, 86, 86, 86, -334,
+	92, 76, -173, 282, -460, 76, -192, -186, -197, -186,
+	80, -306, 79, 79, 79, 137, -493, -434, -275, 522,
+	522, -222, 19, 21, -337, 84, -337, 84, -187, -187,
+	-187, -187, -494, -494, -494, -187, -494, 83, -187, -187,
+	-208, 125, -253, 26, 33, -253, -258, -316, -494, -185,
+	-493, -494, -494, 79, -211, -493, -494, -224, -264, -133,
+	-133, -133, -133, -243, -317, -369, -341, -481, 83, 83,
+	83, 621, 635, -482, -494, -493, -248, -494, -307, 80,
+	80, 86, 83, 83, 222, 78, -241, -238, 24, -240,
+	-433, 83, 268, 264, 268, 268, 268, 268, 269, 334,
+	-430, 83, -460, 83, -450, 92, 345, -462, 21, 660,
+	-453, 346, 83, 83, 346, 83, 83, 338, -484, 42,
+	80, -277, -434, -176, -478, 29, -479, -478, 358, -64,
+	-64, -445, -64, -379, 648, 86, -187, -493, -493, -291,
+	271, 80, 84, -322, -322, -310, -279, -368, -494, 79,
+	-306, 34, 34, -226, -317, -317, -304, -306, -304, 207,
+	-304, -493, 203, 79, -494, 34, 357, 190, 34, 86,
+	190, 584, 332, 83, 374, -174, -461, -194, -187, -187,
+	-187, -486, -487, -307, 731, -435, 44, -438, 45, -232,
+	582, -223, -133, -207, -135, -133, 687, 583, -494, -494,
+	-494, -494, -123, 121, 660, -494, -494, -224, 21, -128,
+	51, -253, -250, -185, -9, -493, -212, -494, -243, -317,
+	-494, -348, 770, 769, -187, 80, -160, -386, -9, -493,
+	-241, 255, -241, 42, 42, 260, 261, -321, -459, 83,
+	83, 346, 83, 84, 347, 347, 21, -187, -435, -243,
+	-443, -478, -64, -402, -493, 21, -494, -248, -187, -493,
+	111, 78, -368, 78, -403, -404, 56, -187, 146, 146,
+	207, -304, -401, -160, -481, 86, 34, 86, 34, 359,
+	-238, -459, 135, -457, 279, -494, -494, -494, -494, 79,
+	-321, 24, 83, 615, -436, -437, -355, 48, 21, -231,
+	-233, -306, 760, 79, -493, 759, -494, 658, 73, 661,
+	-235, 127, 126, -206, 14, -9, -494, 78, 80, -238,
+	-9, -9, -493, 256, -27, -4, -16, -17, -18, -19,
+	-21, -83, -22, -23, -29, -30, -24, -94, -98, -99,
+	-100, -96, -107, -105, -106, -102, -101, -33, -35, -36,
+	-42, -45, -46, -43, -44, -37, -31, -34, -38, -47,
+	-25, -48, -49, -50, -59, -60, -32, -61, -51, 105,
+	209, 455, 313, 324, 325, 326, 84, 329, 331, 327,
+	328, 323, 333, 454, -431, -334, 347, 29, 86, -333,
+	208, 660, -401, -380, -494, -494, -248, -494, 86, -386,
+	-310, -386, -410, -411, -412, 383, 647, 92, 240, 121,
+	-369, -317, -304, -494, -82, 660, 667, 667, -27, -334,
+	190, -458, 280, -192, -494, -487, -488, 615, 30, 737,
+	536, 83, 49, 45, -439, 46, 47, 83, 79, 29,
+	-135, -206, -493, 62, 659, 662, -494, -236, 104, -237,
+	124, 584, -191, 86, 190, -236, -259, -494, -348, -248,
+	-389, -388, -295, 660, -494, -238, -9, -240, -227, 258,
+	259, -238, -133, -67, -68, 106, -133, 84, -77, -312,
+	316, 317, 318, -307, 84, 84, -66, -27, 82, -66,
+	-133, 84, 84, -496, 16, 59, -187, 110, -66, -240,
+	332, 83, -250, 86, 339, -494, 628, 51, -494, 80,
+	78, 80, -412, -369, 383, -369, 241, -493, -317, 292,
+	-238, 332, -186, -192, -461, -288, 83, 615, -493, 536,
+	21, 21, 21, 21, -233, -234, -494, -220, -221, -493,
+	-133, 62, -237, 129, 130, 256, 129, 130, -186, 80,
+	216, 283, 519, -238, -295, -494, -241, -238, 84, 84,
+	-67, 110, 108, -68, -133, 107, 314, 315, 319, -321,
+	79, 775, 454, 326, 329, 331, 775, 332, 84, 16,
+	775, -241, -238, -260, 35, 44, 127, 34, 344, 27,
+	-446, -493, -291, -389, -386, -389, -481, -369, 83, -493,
+	238, -206, -238, -194, -461, -316, -489, -490, 384, 94,
+	34, 83, -486, -493, 83, 83, 83, 83, -494, 79,
+	-494, 660, 101, -193, -417, -418, 76, 358, 216, 216,
+	-295, -238, -20, -26, -19, -25, -16, -17, -18, 454,
+	660, -241, 110, 108, 105, -66, 107, -66, 24, 24,
+	24, -337, -307, 110, -27, 110, -66, -66, -66, -133,
+	330, -66, 98, 110, -28, -47, -32, -30, -29, -42,
+	-45, -46, -19, -16, -17, -18, -25, -31, -27, -259,
+	8, -187, -493, 80, -481, -187, 238, -494, -27, -490,
+	94, 34, -489, 94, 34, 76, 76, -186, -494, -486,
+	-221, 661, -237, -418, 76, -417, 76, 15, 14, 358,
+	358, -295, -238, -66, 110, -139, -20, 105, -66, 775,
+	-66, 775, -78, 86, -9, -76, -75, 86, -78, 321,
+	102, 322, 84, -339, 326, 84, 775, 775, 775, 332,
+	-133, 775, -71, 84, -238, -238, -446, -494, -187, -389,
+	-494, -494, -238, 76, -186, 76, -186, 690, 384, 690,
+	384, 76, -494, 662, -416, 223, 218, 221, 35, -416,
+	775, -238, 775, 110, 775, 110, 108, -69, -70, 109,
+	-27, 79, 320, 34, 110, 110, 330, -66, 110, 110,
+	79, -383, 649, -494, 76, 76, 384, 384, 690, 384,
+	217, 34, 94, 110, 110, 105, 209, -66, 110, 108,
+	-70, -133, -75, -187, 84, 326, -133, 775, 329, 331,
+	84, 86, -383, 76, 76, 105, 775, 209, -66, 107,
+	84, 110, 110, 384, 690, 110, 775, -66, 329, 331,
+	209, 110, 775, 84, 84, 209,
+}
+
+var yyDef = [...]int16{
+	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
+	30, 31, 32, 33, 34, 35, 36, 37, 38, 39,
+	40, 41, 42, 43, 44, 1851, 1334, 1851, 0, 1334,
+	0, 1334, 0, -2, 157, 1017, 1018, 1019, 1020, 1021,
+	0, 1942, 0, 0, 0, 0, 0, -2, 1296, 1297,
+	1300, 1297, 1297, 1326, 0, 531, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1944, 0, 0, 0,
+	0, 815, 0, 0, 67, 0, 1440, 2921, -2, 1330,
+	1331, 1332, -2, -2, 0, 56, 0, 63, 2, 1337,
+	1859, 0, 1347, 1336, 1859, 0, 0, 52, 0, 0,
+	1334, 1334, 1334, 90, 91, 0, 135, 0, 139, 0,
+	0, 1851, 1334, 0, 0, 0, 1946, 1946, 384, 0,
+	1946, 1946, 1961, 1946, 0, 1964, 382, 0, 1943, 1959,
+	1960, 1962, 0, 0, 0, 0, 1944, 1944, 1944, 1944,
+	1944, 1944, 1944, 1944, 1944, 1944, 1944, 0, 0, 1207,
+	1531, 1533, 1534, 1535, 1536, 1975, 1976, 2912, 2913, 2914,
+	2915, 2916, 2917, 2884, 2885, 2886, 2887, 2888, 2889, 2890,
+	2891, 2892, 2893, 2894, 2895, 2896, 2897, 2898, 2899, 2900,
+	2901, 2902, 2903, 2904, 2905, 2918, 2919, 2920, 2582, 2583,
+	2584, 2585, 2586, 2587, 2588, 2589, 2590, 2591, 2592, 2593,
+	2594, 2595, 2596, 2597, 2598, 2599, 2600, 2601, 2602, 2603,
+	2604, 2605, 2606, 2607, 2608, 2609, 2610, 2611, 2612, 2613,
+	2614, 2615, 2616, 2617, 2618, 2619, 2620, 2621, 2622, 2623,
+	2624, 2625, 2626, 2627, 2628, 2629, 2630, 2631, 2632, 2633,
+	2634, 2635, 2636, 2637, 2638, 2639, 2640, 2641, 2642, 2643,
+	2644, 2645, 2646, 2647, 2648, 2649, 2650, 2651, 2652, 2653,
+	2654, 2655, 2656, 2657, 2658, 2659, 2660, 2661, 2662, 2663,
+	2664, 2665, 2666, 2667, 2668, 2669, 2670, 2671, 2672, 2673,
+	2674, 2675, 2676, 2677, 2678, 2679, 2680, 2681, 2682, 2683,
+	2684, 2685, 2686, 2687, 2688, 2689, 2690, 2691, 2692, 2693,
+	2694, 2695, 2696, 2697, 2698, 2699, 2700, 2701, 2702, 2703,
+	2704, 2705, 2706, 2707, 2708, 2709, 2710, 2711, 2712, 2713,
+	2714, 2715, 2716, 2717, 2718, 2719, 2720, 2721, 2722, 2723,
+	2724, 2725, 2726, 2727, 2728, 2729, 2730, 2731, 2732, 2733,
+	2734, 2735, 2736, 2737, 2738, 2739, 2740, 2741, 2742, 2743,
+	2744, 2745, 2746, 2747, 2748, 2749, 2750, 2751, 2752, 2753,
+	2754, 2755, 2756, 2757, 2758, 2759, 2760, 2761, 2762, 2763,
+	2764, 2765, 2766, 2767, 2768, 2769, 2770, 2771, 2772, 2773,
+	2774, 2775, 2776, 2777, 2778, 2779, 2780, 2781, 2782, 2783,
+	2784, 2785, 2786, 2787, 2788, 2789, 2790, 2791, 2792, 2793,
+	2794, 2795, 2796, 2797, 2798, 2799, 2800, 2801, 2802, 2803,
+	2804, 2805, 2806, 2807, 2808, 2809, 2810, 2811, 2812, 2813,
+	2814, 2815, 2816, 2817, 2818, 2819, 2820, 2821, 2822, 2823,
+	2824, 2825, 2826, 2827, 2828, 2829, 2830, 2831, 2832, 2833,
+	2834, 2835, 2836, 2837, 2838, 2839, 2840, 2841, 2842, 2843,
+	2844, 2845, 2846, 2847, 2848, 2849, 2850, 2851, 2852, 2853,
+	2854, 2855, 2856, 2857, 2858, 2859, 2860, 2861, 2862, 2863,
+	2864, 2865, 2866, 2867, 2868, 2869, 2870, 2871, 2872, 2873,
+	2874, 2875, 2876, 2877, 2878, 2879, 2880, 2881, 2882, 2883,
+	0, 0, 0, 1212, 1213, 1214, 1215, 1216, 2906, 2907,
+	2908, 2909, 2910, 2911, 1225, 1226, 0, 0, 0, 1287,
+	0, 1287, 1246, 1247, 0, 1249, 0, 0, 0, 0,
+	0, 0, 0, 1280, 1284, 1262, 1265, 1269, 0, 1859,
+	1859, 1284, 1275, 0, 867, 868, 869, 1292, 1293, 1281,
+	1294, 1299, 1298, 1307, 1307, 0, 1298, 1328, -2, 1328,
+	1468, 0, 509, 511, 0, 532, 0, 535, 0, 0,
+	547, 1537, 0, -2, -2, 1986, 1313, 0, 0, 2012,
+	0, 2029, 0, 0, 0, 0, 0, 2771, 277, 398,
+	318, 318, 318, 318, 318, 318, 318, 0, 318, 318,
+	0, 318, 318, 318, 2787, 318, 0, 318, 318, 318,
+	318, 318, 276, 397, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 289, 290, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 302, 303, 304,
+	305, 306, 307, 308, 309, 310, 311, 312, 313, 314,
+	315, 316, 317, 387, 388, 389, 0, 0, 0, 819,
+	1301, 824, 822, 0, 816, 813, 814, 0, 2032, 83,
+	0, 70, 84, 1893, 1490, 1491, 1492, 1437, 0, 1441,
+	1944, -2, 0, 1944, 0, 1949, 72, 1427, 1428, 1429,
+	-2, 0, 0, 1435, 1436, -2, 1587, 1975, 0, 0,
+	1341, 0, 0, 1338, 1344, 0, 1866, 0, 0, 132,
+	1335, 50, 51, 2922, 62, 0, 0, 1363, 1418, 0,
+	1546, 2465, 1552, -2, 2378, 1595, 1596, 1597, 1598, 1599,
+	1600, 1601, 1602, 2323, 0, 0, 0, 0, 0, 2420,
+	1627, 1628, 1629, 1630, 1631, 1632, 2634, 2857, 2858, 1822,
+	1826, 1827, 1828, 1829, 1830, 1831, -2, -2, -2, 1803,
+	1804, 1819, 0, 2912, 2913, 2915, 2916, 2917, 1911, 0,
+	1728, 1729, 1730, 1731, 1732, 1733, 1734, 1735, 1736, 1737,
+	1738, 1739, 1740, 1741, 1742, 1743, 1744, 1745, 1746, 1747,
+	1748, 1749, 1750, 1751, 1752, 1753, 1754, 1755, 1756, 1757,
+	1758, 1759, 1760, 1761, 1762, 1763, 1764, 1765, 1766, 1767,
+	1768, 1769, 2446, 2510, 2397, 2515, 2342, 2918, 2334, 2919,
+	2411, 2539, 2540, 2920, 2454, 2891, 0, -2, 2564, 2502,
+	-2, 0, 0, -2, -2, 1697, -2, -2, -2, 2902,
+	2903, 2888, 2893, 2404, 2352, 2462, 2503, 2343, 2364, 2383,
+	2432, 2433, 2435, 2467, 2468, 2486, 2495, 2514, 2324, 2325,
+	2326, 2427, 2428, 2536, 2535, 2534, 2537, 2570, 2568, 2571,
+	2312, 2313, 2314, 2315, 2316, 2317, 2318, 2319, 2320, 2321,
+	2322, 2327, 2328, 2329, 2330, 2331, 2333, 2335, 2336, 2337,
+	2338, 2339, 2340, 2341, 2344, 2345, 2346, -2, 2351, 2353,
+	2354, 2355, 2356, 2357, 2358, 2359, 2360, 2362, 2363, 2365,
+	2366, 2367, 2368, 2369, 2370, 2371, 2372, 2373, 2374, 2375,
+	2376, 2377, 2379, 2380, 2382, 2384, 2385, 2386, 2387, 2388,
+	2389, 2390, 2391, 2392, 2393, 2394, 2395, 2396, 2398, 2399,
+	2400, 2401, 2402, 2403, 2405, 2406, 2407, 2408, 2409, 2410,
+	2412, 2413, 2414, 2415, 2416, 2417, 2418, 2419, 2421, 2422,
+	2423, 2424, 2425, 2426, 2429, 2430, 2431, 2434, 2436, 2437,
+	2438, 2439, 2440, 2441, 2442, 2443, 2444, 2445, 2447, 2448,
+	-2, -2, 2451, 2452, 2453, 2455, 2456, 2457, 2458, 2459,
+	2460, 2461, 2463, 2464, 2466, 2470, 2471, 2472, 2473, 2474,
+	2475, 2476, 2477, 2478, 2479, 2480, 2481, 2482, 2483, 2484,
+	2485, 2487, 2488, 2489, 2490, 2491, 2492, 2493, 2494, 2496,
+	2497, 2498, 2499, 2500, 2501, 2504, 2505, 2506, 2507, 2508,
+	2509, 2511, 2512, 2513, 2516, 2517, 2518, 2519, 2520, 2521,
+	2522, 2523, 2524, 2525, 2526, 2527, 2528, 2529, 2530, 2531,
+	2532, 2533, 2538, 2541, 2542, 2543, 2544, 2545, 2546, 2547,
+	2548, 2549, 2550, 2552, 2553, 2554, 2555, 2556, 2557, 2558,
+	2559, 2560, 2565, 2566, 2567, 2569, 2572, 2573, 2574, 2575,
+	2576, 2577, 2578, 2579, 2580, 2581, 0, 1948, 1948, 0,
+	109, 2860, 0, 2796, 2896, 2361, 1918, -2, -2, 1920,
+	0, 0, 0, -2, -2, -2, -2, 2335, -2, 1803,
+	0, 0, 0, 0, 2323, 2324, 2325, 2326, 2332, 2334,
+	2342, 2343, 2347, 2348, 2349, 2350, 2352, 2364, 2378, 2381,
+	2383, 2397, 2404, 2411, 2420, 2427, 2428, 2432, 2433, 2435,
+	2446, 2449, 2450, 2454, 2462, 2465, 2467, 2468, 2469, 2486,
+	2495, 2502, 2503, 2510, 2514, 2515, 2534, 2535, 2536, 2537,
+	2539, 2540, 2551, 2561, 2562, 2563, 2564, 2568, 2570, 2571,
+	0, 137, 138, 1851, 0, 140, 0, 612, 613, 614,
+	615, 0, 0, 0, 0, 0, 0, 874, 894, 0,
+	884, 1969, 1970, 0, 0, 0, 872, 0, 874, 874,
+	0, 870, 871, 1859, 1347, 1946, 1946, 382, 0, 0,
+	0, 0, 0, 1946, 1946, 377, 0, 1946, 0, 0,
+	0, 0, 1946, 384, 0, 0, 1185, 0, 1186, 0,
+	392, 1944, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1231, 1232, 1206, 0, 1208, 101, 1211,
+	0, 0, 0, 0, 1229, 1230, 1233, 0, 1278, 1287,
+	1235, 0, 0, 1946, 1946, 0, 0, 0, 0, 0,
+	0, 0, 1245, 0, 896, 897, 1287, 1251, 1252, 1287,
+	1287, 1287, 1284, 0, 1284, 1259, 1282, 1283, 0, 1287,
+	0, 0, 0, 1264, 0, 0, 0, 1272, 1273, 1287,
+	1277, 0, -2, 1305, -2, 1314, 0, 0, 82, 1329,
+	1328, 0, 1333, 1469, 0, 0, 0, 0, 0, 0,
+	513, 0, 0, 534, 515, 0, 519, 520, 521, 522,
+	523, 524, 525, 526, 527, 528, 529, 530, 0, 537,
+	0, 540, 0, 542, 0, 1468, 0, 0, 1981, 1987,
+	1318, 2003, 2004, 0, -2, 0, 2018, 2030, 2031, 322,
+	0, 322, 0, 0, 0, 0, 244, 318, 0, 246,
+	318, 318, 318, 0, 318, 318, 253, 254, 318, 256,
+	257, 258, 318, 260, 261, 318, 263, 264, 265, 318,
+	318, 268, 318, 318, 271, 272, 273, 274, 275, 396,
+	0, 0, 0, 2771, 1945, 0, 0, 0, 0, 820,
+	825, 826, 821, 823, 792, 0, 0, 0, 0, 797,
+	798, 799, 800, 0, 0, 803, 804, 809, 809, 0,
+	68, 69, 0, 0, 0, 1440, 1438, 0, 0, -2,
+	1024, -2, 768, 769, 770, 0, 765, 0, 0, 0,
+	0, 0, 0, 0, 1493, 0, 0, 1496, 0, 0,
+	0, 0, 0, 1958, -2, 0, 0, 0, 0, 1425,
+	0, 1442, 0, 1444, 0, -2, 0, 0, 0, 1958,
+	1448, 1449, 1450, 1451, 1452, 0, 0, 0, 1589, 0,
+	60, 61, 1342, 1343, 57, 65, 66, 58, 1339, 1340,
+	1345, 1346, 59, 76, 0, 0, 1860, 1863, 1864, 1865,
+	2361, 1852, 1853, 1856, 0, 0, 76, 1348, 1349, 1350,
+	1351, 1352, 1353, 0, 1364, 0, 0, 0, 0, 1419,
+	0, 1422, 1423, 1424, 0, 1550, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1574, 1575, 1576,
+	1577, 1578, 1579, 1580, 1553, 0, 1567, 0, 0, 0,
+	0, 0, 0, 0, 1619, 1620, 1621, 1622, 1623, 1624,
+	0, 1823, 1824, 1825, 1354, 0, 0, 0, 1354, 1354,
+	1354, 1354, 1354, 0, 1593, 2577, 0, 2542, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1354, 0, 1795, 0, 0, 1692, 0, 0,
+	0, 1695, 1696, 1698, 1712, 0, 1699, 1700, 0, 0,
+	0, 0, 0, 1589, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1707,
+	1708, 1709, 0, 0, 0, 0, 0, 0, 101, 103,
+	1531, 0, 110, 122, 0, 0, 2860, 1921, 112, 113,
+	114, 116, 0, 0, 1922, 759, 1936, 1937, 1938, 1939,
+	0, 856, 857, 1934, 0, 136, 1859, 911, 0, 631,
+	735, 649, 650, 651, 652, 653, 725, 655, 727, 0,
+	727, 727, 1792, 1793, 727, 727, 727, 727, 700, 701,
+	702, 703, 706, 707, 708, 709, 710, 0, 0, 681,
+	725, 725, 725, 685, 714, 715, 716, 717, 718, 727,
+	720, 721, 722, 713, 656, 657, 658, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 669, 670, 730,
+	730, 730, 732, 730, 732, 732, 732, 732, 631, 631,
+	631, 631, 631, 0, 0, 883, 893, -2, -2, 1126,
+	0, 0, 874, 874, 874, 873, 874, 874, 865, 875,
+	876, 866, 890, 1866, 132, 0, 0, 0, 0, 1946,
+	384, -2, 0, -2, 0, 1526, 0, 0, 0, 0,
+	0, 0, 189, 420, 400, 155, 0, 163, 0, 378,
+	383, 379, 380, 381, 0, 0, 0, 0, 391, 0,
+	0, 1193, 99, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
+	393, 1201, 1202, 422, 0, 1532, 1977, 1978, 1979, 1980,
+	2033, 2034, 2035, 2036, 2037, 2038, 2039, 2040, 2041, 2042,
+	2043, 2044, 2045, 2046, 2047, 2048, 2049, 2050, 2051, 2052,
+	2053, 2054, 2055, 2056, 2057, 2058, 2059, 2060, 2061, 2062,
+	2063, 2064, 2065, 2066, 2067, 2068, 2069, 2070, 2071, 2072,
+	2073, 2074, 2075, 2076, 2077, 2078, 2079, 2080, 2081, 2082,
+	2083, 2084, 2085, 2086, 2087, 2088, 2089, 2090, 2091, 2092,
+	2093, 2094, 2095, 2096, 2097, 2098, 2099, 2100, 2101, 2102,
+	2103, 2104, 2105, 2106, 2107, 2108, 2109, 2110, 2111, 2112,
+	2113, 2114, 2115, 2116, 2117, 2118, 2119, 2120, 2121, 2122,
+	2123, 2124, 2125, 2126, 2127, 2128, 2129, 2130, 2131, 2132,
+	2133, 2134, 2135, 2136, 2137, 2138, 2139, 2140, 2141, 2142,
+	2143, 2144, 2145, 2146, 2147, 2148, 2149, 2150, 2151, 2152,
+	2153, 2154, 2155, 2156, 2157, 2158, 2159, 2160, 2161, 2162,
+	2163, 2164, 2165, 2166, 2167, 2168, 2169, 2170, 2171, 2172,
+	2173, 2174, 2175, 2176, 2177, 2178, 2179, 2180, 2181, 2182,
+	2183, 2184, 2185, 2186, 2187, 2188, 2189, 2190, 2191, 2192,
+	2193, 2194, 2195, 2196, 2197, 2198, 2199, 2200, 2201, 2202,
+	2203, 2204, 2205, 2206, 2207, 2208, 2209, 2210, 2211, 2212,
+	2213, 2214, 2215, 2216, 2217, 2218, 2219, 2220, 2221, 2222,
+	2223, 2224, 2225, 2226, 2227, 2228, 2229, 2230, 2231, 2232,
+	2233, 2234, 2235, 2236, 2237, 2238, 2239, 2240, 2241, 2242,
+	2243, 2244, 2245, 2246, 2247, 2248, 2249, 2250, 2251, 2252,
+	2253, 2254, 2255, 2256, 2257, 2258, 2259, 2260, 2261, 2262,
+	2263, 2264, 2265, 2266, 2267, 2268, 2269, 2270, 2271, 2272,
+	2273, 2274, 2275, 2276, 2277, 2278, 2279, 2280, 2281, 2282,
+	2283, 2284, 2285, 2286, 2287, 2288, 2289, 2290, 2291, 2292,
+	2293, 2294, 2295, 2296, 2297, 2298, 2299, 2300, 2301, 2302,
+	2303, 2304, 2305, 2306, 2307, 2308, 2309, 2310, 2311, 0,
+	0, 0, 1217, 1219, 1223, 1224, 1218, 1220, 1227, 1221,
+	1228, 1276, 1234, 1288, 0, 1290, 0, 0, 1468, 1239,
+	1240, 1241, 1242, 1243, 1244, 898, 1250, 1253, 1254, 1261,
+	1287, 0, 1468, 0, 1260, 1285, 1286, 1263, 1581, 1266,
+	1710, 0, 1274, 1295, 1311, 0, 1306, 0, 1312, 1316,
+	1315, 0, 1319, 1322, 1323, 1324, 1325, 82, 1321, 0,
+	0, 0, 1327, 510, 512, 514, 533, 0, 0, 536,
+	539, 0, 0, 0, 0, 538, 546, 548, 0, 1538,
+	1983, 0, 0, 2006, 0, 2008, 2010, 0, 0, 1544,
+	0, 2019, 2020, 0, 323, 324, 325, 322, 0, 278,
+	0, 340, 399, 0, 245, 0, 320, 247, 248, 249,
+	318, 251, 252, 255, 259, 262, 266, 267, 269, 270,
+	395, 322, 0, 0, 322, 0, 0, 0, 818, 837,
+	0, 0, 1302, 1303, 0, 793, 794, 795, 796, 811,
+	802, 805, 809, 0, 806, 809, 0, 0, 85, 0,
+	0, 1895, 1898, 1899, 1900, 1901, 1902, 1903, 1973, 1974,
+	0, 163, 1023, 771, 772, 773, 0, 854, 854, 854,
+	764, 405, 432, 1486, 1488, 0, 1483, 1494, 1495, 1497,
+	0, 1499, 0, 0, 1502, 1503, 1504, 1472, 0, 0,
+	1434, 1586, 1588, 0, 0, 1540, 1446, 0, 0, 0,
+	0, 0, 0, 0, 1462, 0, 0, 0, 1463, 0,
+	0, 0, 1464, 0, 1590, 1591, 1359, 1360, 0, 0,
+	48, 0, 1867, 1874, 0, 0, 0, 0, 1855, 1857,
+	1858, 0, 0, 132, 73, 132, 53, 1547, 1548, 1549,
+	1551, 1568, 0, 1570, 1572, 1420, 1421, 1365, 0, 1805,
+	-2, 1807, 1808, -2, -2, 1811, -2, 1967, 1968, 1558,
+	1559, 1583, 1584, 1585, 0, 0, 0, 0, 1581, 1563,
+	0, 0, 1604, 1605, 1606, 1607, 1608, 1609, 1610, 1611,
+	1612, 1613, 1614, 1615, 1618, 1725, 1726, 1727, 1626, 0,
+	1817, 0, 1818, 1616, 0, 0, 0, 1832, 1617, 1812,
+	1625, 1589, 1355, 1356, 1815, 1816, 1813, 1814, 0, 0,
+	0, 0, 0, 0, 1910, 0, 0, 0, 1667, 0,
+	0, 0, 0, 0, 1599, 1822, 0, 1599, 1822, 0,
+	0, 2436, 2549, 2328, 0, 0, 0, 1801, 1798, 0,
+	0, 0, 0, 2906, 2907, 0, 1711, 0, 1694, 0,
+	0, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403,
+	1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413,
+	1414, 1415, 0, 0, 0, 1391, 1392, 1393, 1394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1705, 0, 105, 0,
+	1530, 0, -2, 0, 0, 97, 98, 0, 0, 0,
+	1919, 0, 0, 0, 125, 126, 111, 0, 0, 0,
+	119, 120, 121, 1923, 760, 0, -2, 1930, 1822, 1932,
+	1805, 0, 1866, -2, 616, 617, 618, 619, 622, 738,
+	736, 737, 654, 0, 686, 0, 727, 727, 1792, 1793,
+	688, 727, 727, 691, 727, 727, 694, 697, 698, 699,
+	704, 705, 0, 0, 682, 683, 684, 719, 671, 0,
+	672, 730, 674, 675, 0, 676, 677, 678, 679, 680,
+	623, 624, 625, 626, 627, 0, 872, 882, 892, 0,
+	877, 725, 725, 0, 0, 858, 860, 861, 862, 864,
+	0, 891, 1125, 342, 0, 1965, 1893, 0, 0, 0,
+	146, 1947, 147, 0, 443, 444, 0, 344, 0, 0,
+	385, 386, 0, 191, 0, 0, 401, 0, 180, 0,
+	161, 164, 165, -2, 0, 854, 854, 854, 609, 610,
+	0, 1187, 0, 1189, 390, 1203, 0, 0, 0, 0,
+	0, 0, 102, 0, 1289, 1236, 1237, 1238, 1544, 0,
+	0, 1255, 1284, 1287, 0, 1279, 0, 0, 1267, 0,
+	1310, 1308, 1317, 1320, 1465, 0, 0, 516, 517, 518,
+	544, 545, 543, 541, 549, 0, 1984, 1985, 1982, 2005,
+	0, 2009, 2011, 2014, 1859, 0, 1544, 0, 2026, 2027,
+	2028, 0, 326, 328, 0, 0, 234, 0, 0, 319,
+	0, 250, 0, 0, 0, 0, 236, 0, 817, 827,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1304, 801, 0, 807, 0, 808, 790, 791, 86,
+	1894, 0, 0, 1439, 1022, 854, 0, 855, 0, 0,
+	1134, 1026, 1027, 1028, 1136, 0, -2, 1132, 1132, 854,
+	1132, 0, 0, 0, 0, 0, 0, 1065, 854, 1132,
+	1953, 0, 1078, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1123, 1124, 854, 854, 854, 854, 854, 854,
+	854, 854, 0, 0, 854, 854, 854, 854, 854, 854,
+	854, 854, 854, 854, 854, 854, 854, 854, 854, 854,
+	854, 854, 854, 854, 854, 854, 854, 0, 854, 854,
+	0, 201, 406, 1183, 0, 1480, 1487, 0, 0, 1481,
+	0, 1482, 1498, 1500, 1501, 1430, 0, 1472, 1426, -2,
+	0, 1476, 1445, 1540, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 0, 0, 0,
+	77, 2647, 0, 80, 0, 1869, 0, 0, 1876, 0,
+	0, 1861, 1862, 1854, 0, 0, 0, 0, 1357, 1544,
+	0, 0, 1569, 1571, 1573, 0, 1589, 1560, 1581, 1564,
+	0, 1561, 0, 0, 1555, 1665, 0, 0, 0, 0,
+	0, 0, 1594, 1663, 1664, 1666, 0, 0, 0, 1670,
+	0, 0, 1674, 0, 0, 1718, 0, 0, 1719, 1679,
+	0, 0, 0, 0, 0, 1685, 1851, 0, 1799, 0,
+	0, 1688, 1689, 1690, 1691, 1693, 1713, 0, 0, 0,
+	0, 1714, 1715, 1716, 1717, 1652, 0, 1653, 0, 0,
+	0, 0, 0, 0, 1660, 1661, 1662, 1374, 1374, 1374,
+	1374, 1374, 1374, 1374, 1374, 1374, 1374, 1374, 1374, 1706,
+	0, 0, 1529, 0, 1544, 0, 1544, 1544, 104, 0,
+	1539, 123, 124, 0, 0, 130, 115, 0, 0, 761,
+	762, 763, 1931, 0, 343, 611, 912, 764, 991, 854,
+	854, 854, 0, 854, 854, 854, 854, 854, 0, 0,
+	854, 854, 854, 854, 854, 854, 854, 854, 854, 854,
+	854, 854, 854, 854, 854, 854, 854, 0, 854, 854,
+	854, 854, 854, 854, 0, 854, 854, 0, 854, 854,
+	632, 633, 0, 635, 636, 637, 638, 639, 640, 641,
+	642, 0, 0, 0, 0, 647, 0, 0, 0, 0,
+	750, 0, 785, 787, 0, 0, 0, 648, 739, 0,
+	0, 0, 687, 727, 695, 689, 690, 692, 693, 0,
+	723, 0, 0, 673, 0, 874, 874, 842, 0, 1856,
+	1856, 0, 908, 0, 0, 0, 0, 1893, 0, 163,
+	0, 445, 446, 447, 1527, 0, 345, 346, 0, 0,
+	0, 0, 352, 0, 0, 0, 201, 0, 421, 0,
+	0, 0, 0, 0, 158, 0, 166, 0, 0, 0,
+	171, 172, 0, 0, 0, 0, 0, 0, 1191, 1204,
+	1205, 1192, 100, 394, 423, 0, 0, 1222, 1248, 899,
+	900, 1468, 1258, 1284, 1582, 1268, 1270, 1271, 1466, 1467,
+	105, 0, 2007, 2015, 1545, 1859, 0, 0, 0, 0,
+	0, 0, 0, 338, 321, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 838, 0, 0, 0, 810, 1896, 1897, 0, 777,
+	778, 779, 780, 781, 782, 783, 990, 764, 1135, 0,
+	0, 0, 0, 1137, 999, 0, 1946, 0, 0, 1035,
+	1036, 0, 1133, 1013, 0, 0, 0, 1944, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 854, 0,
+	1057, 1058, 1059, 0, 1060, 0, 0, 0, 0, 0,
+	0, 0, 1954, 1955, 1956, 1077, 0, 0, 0, 0,
+	0, 0, 0, 0, 1161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 854, 854, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1116,
+	1117, 971, 972, 973, 974, 975, 987, 988, 989, 976,
+	977, 978, 0, 0, 0, 209, 0, 435, 0, 0,
+	0, 1484, 0, 1489, 0, 1474, 1431, -2, 0, 1447,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1592, 1361, 0, 0, 0, 78, 751,
+	1868, 1870, 1875, 1877, 1873, 49, 1833, 0, 0, 1836,
+	74, 75, 132, 1366, 1820, 1821, 0, 1562, 0, 1565,
+	1603, 1633, 1374, 1374, 1374, 1374, 1374, 0, 725, 725,
+	725, 1775, 725, 732, 1778, 1780, 1781, 1782, 1783, 1784,
+	725, 1786, 725, 1789, 1791, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1796, 1687,
+	1802, 0, 0, 0, 0, 0, 1367, 1368, 1370, 1654,
+	1655, 1656, 1657, 1658, 1659, 1637, 1375, 1638, 1639, 1641,
+	1642, 1644, 1645, 1646, 1647, 1649, 1650, 1651, 1904, 1904,
+	0, -2, 1885, 0, 1882, 1440, 1891, 1892, 0, 1544,
+	1912, 0, 0, 0, 0, 1851, 1544, 95, 96, 0,
+	127, 128, 129, 117, 118, 913, 0, 0, 0, 854,
+	0, 854, 0, 0, 0, 0, 0, 854, 854, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 944, 0, 0, 0,
+	0, 0, 0, 955, 956, 0, 0, 0, 0, 0,
+	634, 0, 0, 645, 0, 0, 756, 757, 758, 740,
+	0, 0, 743, 744, 745, 746, 747, 0, 1556, 1557,
+	1710, 1710, 1710, 1710, 1710, 1710, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1794, 0, 0,
+	0, 0, 0, 0, 1710, 1710, 1710, 0, 784, 786,
+	788, 789, 0, 726, 728, 729, 696, 711, 0, 712,
+	0, 733, 0, 859, 863, 841, 845, 0, 854, 0,
+	854, 854, 852, 853, 878, 879, 880, 1856, 895, 909,
+	910, 0, 0, 1966, 131, 0, 180, 162, 0, 357,
+	0, 348, 0, 0, 0, 0, 353, 354, 0, 0,
+	2021, 2022, 2023, 2024, 2025, 0, 0, 209, 192, 193,
+	194, 196, 197, 0, 0, 0, 190, 0, 403, 0,
+	408, 409, 410, 0, 0, 0, 0, 0, 168, 177,
+	0, 0, 169, 170, 173, 174, 175, 176, 1188, 1190,
+	0, 0, 1471, 1210, 1287, 1468, 751, 0, 2016, 0,
+	338, 327, 329, 330, 0, 338, 341, 235, 0, 0,
+	0, 0, 0, 0, 236, 828, 829, 830, 831, 832,
+	833, 834, 835, 836, 0, 0, 812, 774, 775, 776,
+	1025, 1029, -2, 1132, 0, 0, 1005, 993, 0, 0,
+	1003, 1004, 1000, 0, 1129, 0, 0, 874, 872, 1961,
+	0, 0, 0, -2, 1037, 1038, 1061, 0, 1063, 0,
+	1140, 0, 0, 0, 0, 1043, 1044, 1045, 1046, 0,
+	854, 0, 0, 0, 0, 0, 0, 1066, 1067, 1068,
+	1069, 1129, 0, 0, 1073, 0, 0, 0, 1145, 1146,
+	1149, 0, 0, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
+	1160, 1122, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
+	0, 0, 1089, 967, 968, 1090, 1091, 1092, 1093, 1094,
+	1095, 1096, 964, 965, 966, 1097, 1098, 1099, 1100, 1101,
+	1102, 1103, 1104, 1105, 979, 980, 981, 982, 983, 984,
+	1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115,
+	0, 1120, 0, 1127, 1128, 210, 211, 0, 0, 0,
+	0, 202, 203, 0, 0, 0, 0, 439, 436, 437,
+	0, 433, 0, 432, 0, 0, 0, 1473, 0, 1443,
+	1477, 1541, 1542, 1543, 1453, 1454, 0, 1456, 1457, 0,
+	1459, 1460, 0, 1479, 0, 0, 81, 1996, 752, 0,
+	1872, 0, 1879, 1834, 1940, 1941, 1835, 1848, 0, 1358,
+	1634, 1566, 1636, 1640, 1635, 1643, 1648, 1668, 1770, 751,
+	751, 1776, 1777, 1779, 1785, 1787, 1788, 1790, 1672, 1669,
+	1671, 1673, 0, 0, 0, 0, 1680, 0, 0, 0,
+	0, 0, 0, 1800, 0, 0, 1703, 1704, 1372, 1371,
+	107, 0, 107, 1904, 0, 0, 0, 0, 0, 0,
+	-2, -2, -2, 2307, 2271, 1889, 1906, 0, 0, 1851,
+	0, 0, 0, 0, 0, 1859, 94, 914, 915, 916,
+	0, 854, 0, 919, 920, 922, 923, 924, 0, 0,
+	927, 928, 929, 930, 931, 932, 933, 934, 935, 936,
+	937, 938, 939, 940, 941, 942, 943, 945, 946, 947,
+	948, 949, 950, 951, 952, 953, 954, 0, 959, 0,
+	961, 962, 963, 0, 0, 0, 753, 754, 755, 741,
+	742, 0, 0, 749, 724, 0, 0, 846, 847, 0,
+	849, 0, 0, 881, 0, 0, 134, 131, 160, 0,
+	133, 358, 359, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 347, 349, 350, 351, 0, 0, 356, 0,
+	432, 0, 228, 0, 198, 199, 200, 402, 0, 0,
+	407, 0, 0, 0, 0, 181, 182, 0, 184, 180,
+	178, 179, 0, 0, 1256, 1287, 1996, 1528, 1544, 331,
+	338, 331, 0, 236, 236, 0, 236, 237, 243, 839,
+	840, 1007, 0, 0, 0, 0, 1001, 0, 1031, 1130,
+	0, 631, 631, 1965, 1946, 874, 0, 1164, 0, 1139,
+	1062, 1064, 1039, 1041, 1040, 1042, 0, 0, 1049, 1050,
+	1129, 0, 1054, 0, 1141, 1142, 1143, 1144, 1070, 0,
+	0, 0, 0, 0, 1147, 1148, 0, 0, 1087, 1088,
+	1118, 1119, 0, 985, 212, 213, 0, 0, 0, 223,
+	224, 225, 226, 227, 204, 205, 206, 207, 208, 1179,
+	0, 0, 1184, 434, 1183, 0, 426, 441, 442, 0,
+	1485, 1475, 0, 0, 0, 1362, 0, 1998, 1992, 1871,
+	1878, 1386, 0, 0, 1771, 1772, 1773, 1774, 0, 0,
+	0, 0, 1681, 1682, 1683, 1720, 1686, 1797, 0, 0,
+	1851, 0, 87, 132, 0, 88, 107, 1472, -2, 1883,
+	0, 0, 1890, 1440, 1908, 0, 106, 1859, 1913, 1914,
+	1915, 1916, 1917, 93, 917, 0, 921, 969, 970, 925,
+	926, 957, 958, 0, 628, 0, 0, 748, 0, 731,
+	734, 848, 850, 851, 0, 0, 0, 134, 0, 134,
+	360, 361, 362, 363, 365, 366, 0, 0, 0, 0,
+	355, 152, 435, 153, 154, 0, 0, 195, 0, 0,
+	404, 0, 413, 415, 0, 414, 416, 0, 159, 0,
+	1470, 1257, 1998, 1859, 231, 0, 331, 233, 339, 238,
+	240, 236, 239, 1162, 0, 1006, 0, 0, 0, 0,
+	0, 1030, 1131, 620, 621, 0, 874, 0, 1138, 0,
+	1167, 0, 1048, 1051, 1052, 1055, 1071, 1072, 0, 0,
+	1076, 0, 0, 0, 1121, 214, 215, 0, 217, 218,
+	0, 0, 131, 440, 438, 435, 424, 428, 0, 0,
+	0, 0, 1506, 0, 2739, 79, 2000, -2, 0, 71,
+	0, 1849, 1850, 1837, 1839, 1841, 2346, 2397, 1675, 1677,
+	1676, 1678, 0, 0, 0, 1701, 1702, 1376, 0, 108,
+	0, 89, 1881, 1884, 1886, 0, 1907, 1909, 92, 918,
+	960, 643, 629, 630, 0, 646, 0, 0, 131, 0,
+	0, 0, 82, 367, 368, 369, 370, 357, 439, 229,
+	230, 0, 418, 0, 411, 412, 0, 1209, 1950, 2017,
+	332, 232, 241, 992, 0, 999, 994, 0, 0, 0,
+	0, 0, 1965, 0, 1165, 1172, 0, 1047, 854, 0,
+	0, 1075, 0, 1151, 986, 0, 219, 0, 221, 222,
+	82, 439, 0, 430, 0, 1455, 1458, 1461, 0, 0,
+	0, 0, 0, 0, 1999, 1994, 0, 1991, 0, 1387,
+	1388, 0, 1838, 0, 0, 0, 1684, 0, 0, 0,
+	0, 0, 0, 1373, 0, 0, 628, 0, 843, 185,
+	0, 131, 0, 133, 131, 552, 553, 554, 555, 556,
+	557, 558, 559, 560, 561, 562, 563, 564, 565, 566,
+	567, 568, 569, 570, 571, 572, 573, 574, 575, 576,
+	577, 578, 579, 580, 581, 582, 583, 584, 585, 586,
+	587, 588, 589, 590, 591, 592, 593, 594, 595, 0,
+	0, 0, 0, 0, 0, 82, 0, 82, 0, 0,
+	0, 492, 0, 82, 133, 0, 417, 0, 183, 1472,
+	0, 0, 0, 0, 995, 996, 0, 998, 1002, 0,
+	0, 0, 1166, 1173, 1174, 854, 0, 854, 0, 0,
+	0, 1056, 1074, 1150, 0, 1123, 216, 220, 131, 1180,
+	0, 425, 0, 426, 1957, 1507, 1508, 0, 0, 1509,
+	0, 0, 0, 0, 1997, 0, 0, 1993, 0, 0,
+	1840, 0, 0, 1721, 0, 1724, 1369, 1377, 0, 1380,
+	0, 0, 0, 1416, 0, 1378, 1905, -2, 644, 0,
+	141, 844, 142, 0, -2, 185, 0, 134, 131, 0,
+	0, 150, 0, 0, 462, 0, 0, 1969, 0, 0,
+	-2, -2, -2, 1971, 489, 490, 0, 550, 0, 0,
+	0, 506, 507, 0, 493, 0, 508, 371, 0, 134,
+	131, 419, 45, 0, 0, 1163, 0, 1001, 997, 843,
+	0, 843, 1175, 0, 854, 0, 0, 0, 1053, 1152,
+	1181, 131, 0, 426, 429, 0, 1512, 0, 0, 0,
+	0, 0, 0, 0, 1389, 1390, 1842, 0, 1844, 0,
+	1847, 1722, 0, 1381, 1382, 1383, 1384, 1385, 0, 885,
+	0, 0, 0, 185, 144, -2, 82, 134, 448, 449,
+	0, 0, 82, 463, 0, 82, 0, 0, 0, 751,
+	0, 82, 82, 82, 82, 0, 82, 82, 0, 494,
+	82, 82, 82, 47, 0, 1951, 1952, 333, 334, 335,
+	337, 0, 0, 1032, 0, 1034, 1176, 0, 1178, 0,
+	1170, 0, 82, 427, 431, 1505, 1513, 1514, 0, 0,
+	0, 1521, 0, 0, 2001, 2002, 1995, 1989, 1843, 0,
+	1846, 0, 0, 1417, 886, 887, 0, 186, 0, 0,
+	143, 185, 131, 450, 451, 452, 453, 454, 455, 82,
+	0, 82, 0, 82, 460, 0, 82, 0, 0, 0,
+	0, 759, 1972, 0, 551, 372, 0, 0, 0, 0,
+	0, 0, 0, 374, 131, 596, 597, 598, 599, 600,
+	601, 602, 603, 604, 605, 606, 607, 608, 131, 46,
+	0, 0, 0, 843, 1177, 0, 2455, 1171, 131, 1515,
+	0, 0, 1516, 0, 0, 0, 0, 0, 1510, 0,
+	1845, 0, 1379, 888, 0, 889, 0, 0, 0, 187,
+	188, 145, 148, 0, 457, 83, 131, 458, 0, 82,
+	0, 82, 472, 473, 474, 82, 481, 483, 484, 485,
+	0, 487, 488, 476, 497, 373, 82, 82, 82, 82,
+	0, 82, 491, 495, 151, 156, 336, 1010, 0, 1033,
+	1168, 1169, 1182, 0, 0, 0, 0, 1517, 1518, 1522,
+	1524, 0, 1511, 1723, 901, 903, 904, 0, 0, 902,
+	82, 149, 82, 0, -2, 0, 82, 0, 469, 0,
+	475, 0, 486, 0, 375, 0, 0, 0, 0, 0,
+	0, 1008, 0, 1010, 0, 0, 0, 0, 1523, 1525,
+	905, 906, 907, 456, 0, 461, 465, 0, 0, 82,
+	470, 0, 482, 477, 376, 498, 0, 82, 500, 503,
+	496, 1011, 1009, 0, 0, 459, 82, 467, 0, 82,
+	499, 0, 0, 1519, 1520, 0, 82, 0, 501, 504,
+	466, 0, -2, 502, 505, 468,
+}
+
+var yyTok1 = [...]int16{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 103, 3, 3, 3, 140, 132, 3,
+	78, 80, 137, 135, 79, 136, 191, 138, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 82, 775,
+	112, 111, 113, 3, 81, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 143, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 131, 3, 144,
+}
+
+var yyTok2 = [...]int16{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 76, 77, 83, 84, 85, 86,
+	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 104, 105, 106, 107,
+	108, 109, 110, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	133, 134, 139, 141, 142, 145, 146, 147, 148, 149,
+	150, 151, 152, 153, 154, 155, 156, 157, 158, 159,
+	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	190, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	251, 252, 253, 254, 255, 256, 257, 258, 259, 260,
+	261, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276,
+}
+
+var yyTok3 = [...]uint16{
+	57600, 277, 57601, 278, 57602, 279, 57603, 280, 57604, 281,
+	57605, 282, 57606, 283, 57607, 284, 57608, 285, 57609, 286,
+	57610, 287, 57611, 288, 57612, 289, 57613, 290, 57614, 291,
+	57615, 292, 57616, 293, 57617, 294, 57618, 295, 57619, 296,
+	57620, 297, 57621, 298, 57622, 299, 57623, 300, 57624, 301,
+	57625, 302, 57626, 303, 57627, 304, 57628, 305, 57629, 306,
+	57630, 307, 57631, 308, 57632, 309, 57633, 310, 57634, 311,
+	57635, 312, 57636, 313, 57637, 314, 57638, 315, 57639, 316,
+	57640, 317, 57641, 318, 57642, 319, 57643, 320, 57644, 321,
+	57645, 322, 57646, 323, 57647, 324, 57648, 325, 57649, 326,
+	57650, 327, 57651, 328, 57652, 329, 57653, 330, 57654, 331,
+	57655, 332, 57656, 333, 57657, 334, 57658, 335, 57659, 336,
+	57660, 337, 57661, 338, 57662, 339, 57663, 340, 57664, 341,
+	57665, 342, 57666, 343, 57667, 344, 57668, 345, 57669, 346,
+	57670, 347, 57671, 348, 57672, 349, 57673, 350, 57674, 351,
+	57675, 352, 57676, 353, 57677, 354, 57678, 355, 57679, 356,
+	57680, 357, 57681, 358, 57682, 359, 57683, 360, 57684, 361,
+	57685, 362, 57686, 363, 57687, 364, 57688, 365, 57689, 366,
+	57690, 367, 57691, 368, 57692, 369, 57693, 370, 57694, 371,
+	57695, 372, 57696, 373, 57697, 374, 57698, 375, 57699, 376,
+	57700, 377, 57701, 378, 57702, 379, 57703, 380, 57704, 381,
+	57705, 382, 57706, 383, 57707, 384, 57708, 385, 57709, 386,
+	57710, 387, 57711, 388, 57712, 389, 57713, 390, 57714, 391,
+	57715, 392, 57716, 393, 57717, 394, 57718, 395, 57719, 396,
+	57720, 397, 57721, 398, 57722, 399, 57723, 400, 57724, 401,
+	57725, 402, 57726, 403, 57727, 404, 57728, 405, 57729, 406,
+	57730, 407, 57731, 408, 57732, 409, 57733, 410, 57734, 411,
+	57735, 412, 57736, 413, 57737, 414, 57738, 415, 57739, 416,
+	57740, 417, 57741, 418, 57742, 419, 57743, 420, 57744, 421,
+	57745, 422, 57746, 423, 57747, 424, 57748, 425, 57749, 426,
+	57750, 427, 57751, 428, 57752, 429, 57753, 430, 57754, 431,
+	57755, 432, 57756, 433, 57757, 434, 57758, 435, 57759, 436,
+	57760, 437, 57761, 438, 57762, 439, 57763, 440, 57764, 441,
+	57765, 442, 57766, 443, 57767, 444, 57768, 445, 57769, 446,
+	57770, 447, 57771, 448, 57772, 449, 57773, 450, 57774, 451,
+	57775, 452, 57776, 453, 57777, 454, 57778, 455, 57779, 456,
+	57780, 457, 57781, 458, 57782, 459, 57783, 460, 57784, 461,
+	57785, 462, 57786, 463, 57787, 464, 57788, 465, 57789, 466,
+	57790, 467, 57791, 468, 57792, 469, 57793, 470, 57794, 471,
+	57795, 472, 57796, 473, 57797, 474, 57798, 475, 57799, 476,
+	57800, 477, 57801, 478, 57802, 479, 57803, 480, 57804, 481,
+	57805, 482, 57806, 483, 57807, 484, 57808, 485, 57809, 486,
+	57810, 487, 57811, 488, 57812, 489, 57813, 490, 57814, 491,
+	57815, 492, 57816, 493, 57817, 494, 57818, 495, 57819, 496,
+	57820, 497, 57821, 498, 57822, 499, 57823, 500, 57824, 501,
+	57825, 502, 57826, 503, 57827, 504, 57828, 505, 57829, 506,
+	57830, 507, 57831, 508, 57832, 509, 57833, 510, 57834, 511,
+	57835, 512, 57836, 513, 57837, 514, 57838, 515, 57839, 516,
+	57840, 517, 57841, 518, 57842, 519, 57843, 520, 57844, 521,
+	57845, 522, 57846, 523, 57847, 524, 57848, 525, 57849, 526,
+	57850, 527, 57851, 528, 57852, 529, 57853, 530, 57854, 531,
+	57855, 532, 57856, 533, 57857, 534, 57858, 535, 57859, 536,
+	57860, 537, 57861, 538, 57862, 539, 57863, 540, 57864, 541,
+	57865, 542, 57866, 543, 57867, 544, 57868, 545, 57869, 546,
+	57870, 547, 57871, 548, 57872, 549, 57873, 550, 57874, 551,
+	57875, 552, 57876, 553, 57877, 554, 57878, 555, 57879, 556,
+	57880, 557, 57881, 558, 57882, 559, 57883, 560, 57884, 561,
+	57885, 562, 57886, 563, 57887, 564, 57888, 565, 57889, 566,
+	57890, 567, 57891, 568, 57892, 569, 57893, 570, 57894, 571,
+	57895, 572, 57896, 573, 57897, 574, 57898, 575, 57899, 576,
+	57900, 577, 57901, 578, 57902, 579, 57903, 580, 57904, 581,
+	57905, 582, 57906, 583, 57907, 584, 57908, 585, 57909, 586,
+	57910, 587, 57911, 588, 57912, 589, 57913, 590, 57914, 591,
+	57915, 592, 57916, 593, 57917, 594, 57918, 595, 57919, 596,
+	57920, 597, 57921, 598, 57922, 599, 57923, 600, 57924, 601,
+	57925, 602, 57926, 603, 57927, 604, 57928, 605, 57929, 606,
+	57930, 607, 57931, 608, 57932, 609, 57933, 610, 57934, 611,
+	57935, 612, 57936, 613, 57937, 614, 57938, 615, 57939, 616,
+	57940, 617, 57941, 618, 57942, 619, 57943, 620, 57944, 621,
+	57945, 622, 57946, 623, 57947, 624, 57948, 625, 57949, 626,
+	57950, 627, 57951, 628, 57952, 629, 57953, 630, 57954, 631,
+	57955, 632, 57956, 633, 57957, 634, 57958, 635, 57959, 636,
+	57960, 637, 57961, 638, 57962, 639, 57963, 640, 57964, 641,
+	57965, 642, 57966, 643, 57967, 644, 57968, 645, 57969, 646,
+	57970, 647, 57971, 648, 57972, 649, 57973, 650, 57974, 651,
+	57975, 652, 57976, 653, 57977, 654, 57978, 655, 57979, 656,
+	57980, 657, 57981, 658, 57982, 659, 57983, 660, 57984, 661,
+	57985, 662, 57986, 663, 57987, 664, 57988, 665, 57989, 666,
+	57990, 667, 57991, 668, 57992, 669, 57993, 670, 57994, 671,
+	57995, 672, 57996, 673, 57997, 674, 57998, 675, 57999, 676,
+	58000, 677, 58001, 678, 58002, 679, 58003, 680, 58004, 681,
+	58005, 682, 58006, 683, 58007, 684, 58008, 685, 58009, 686,
+	58010, 687, 58011, 688, 58012, 689, 58013, 690, 58014, 691,
+	58015, 692, 58016, 693, 58017, 694, 58018, 695, 58019, 696,
+	58020, 697, 58021, 698, 58022, 699, 58023, 700, 58024, 701,
+	58025, 702, 58026, 703, 58027, 704, 58028, 705, 58029, 706,
+	58030, 707, 58031, 708, 58032, 709, 58033, 710, 58034, 711,
+	58035, 712, 58036, 713, 58037, 714, 58038, 715, 58039, 716,
+	58040, 717, 58041, 718, 58042, 719, 58043, 720, 58044, 721,
+	58045, 722, 58046, 723, 58047, 724, 58048, 725, 58049, 726,
+	58050, 727, 58051, 728, 58052, 729, 58053, 730, 58054, 731,
+	58055, 732, 58056, 733, 58057, 734, 58058, 735, 58059, 736,
+	58060, 737, 58061, 738, 58062, 739, 58063, 740, 58064, 741,
+	58065, 742, 58066, 743, 58067, 744, 58068, 745, 58069, 746,
+	58070, 747, 58071, 748, 58072, 749, 58073, 750, 58074, 751,
+	58075, 752, 58076, 753, 58077, 754, 58078, 755, 58079, 756,
+	58080, 757, 58081, 758, 58082, 759, 58083, 760, 58084, 761,
+	58085, 762, 58086, 763, 58087, 764, 58088, 765, 58089, 766,
+	58090, 767, 58091, 768, 58092, 769, 58093, 770, 58094, 771,
+	58095, 772, 58096, 773, 58097, 774, 0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := int(yyPact[state])
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := int(yyExca[i])
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = int(yyTok1[0])
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = int(yyTok1[char])
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = int(yyTok2[char-yyPrivate])
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = int(yyTok3[i+0])
+		if token == char {
+			token = int(yyTok3[i+1])
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = int(yyTok2[1]) /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = int(yyPact[yystate])
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = int(yyDef[yystate])
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = int(yyExca[xi+0])
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = int(yyExca[xi+1])
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= int(yyR2[yyn])
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = int(yyAct[yyg])
+	} else {
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:581
+		{
+			setParseTree(yylex, tryCastStatement(yyDollar[1].val))
+		}
+	case 2:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:585
+		{
+			setParseTree(yylex, tryCastStatement(yyDollar[1].val))
+			statementSeen(yylex)
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:592
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 4:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:596
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 44:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:639
+		{
+			setParseTree(yylex, nil)
+		}
+	case 45:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:644
+		{
+			yyVAL.val = AssignmentExprs(nil)
+		}
+	case 46:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:648
+		{
+			yyVAL.val = yyDollar[2].val.(AssignmentExprs)
+		}
+	case 47:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:654
+		{
+			yyVAL.val = &Load{
+				Local:           yyDollar[3].val.(BoolVal),
+				Infile:          yyDollar[4].val.(string),
+				IgnoreOrReplace: yyDollar[5].val.(string),
+				Table:           yyDollar[6].val.(TableName),
+				Partition:       yyDollar[7].val.(Partitions),
+				Charset:         yyDollar[8].val.(string),
+				Fields:          yyDollar[9].val.(*Fields),
+				Lines:           yyDollar[10].val.(*Lines),
+				IgnoreNum:       yyDollar[11].val.(*SQLVal),
+				Columns:         yyDollar[12].val.(Columns),
+				SetExprs:        yyDollar[13].val.(AssignmentExprs),
+				Auth: AuthInformation{
+					AuthType:   AuthType_FILE,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 48:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:676
+		{
+			s := yyDollar[1].val.(SelectStatement)
+			s.SetOrderBy(yyDollar[2].val.(OrderBy))
+			s.SetLimit(yyDollar[3].val.(*Limit))
+			s.SetLock(yyDollar[4].val)
+			if err := s.SetInto(yyDollar[5].val.(*Into)); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = s
+		}
+	case 49:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:689
+		{
+			tableName := yyDollar[7].val.(TableName)
+			yyVAL.val = &Select{
+				Comments:    Comments(yyDollar[2].val.(Comments)),
+				QueryOpts:   yyDollar[3].val.(QueryOpts),
+				SelectExprs: SelectExprs{Nextval{Expr: tryCastExpr(yyDollar[6].val)}},
+				From: TableExprs{&AliasedTableExpr{
+					Expr: tableName,
+					Auth: AuthInformation{
+						AuthType:    AuthType_SELECT,
+						TargetType:  AuthTargetType_SingleTableIdentifier,
+						TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+					},
+				}},
+			}
+		}
+	case 50:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:708
+		{
+			yyVAL.val = &Select{
+				SelectExprs: SelectExprs{&StarExpr{}},
+				From: TableExprs{&AliasedTableExpr{
+					Expr: yyDollar[1].val.(SimpleTableExpr),
+					Auth: AuthInformation{AuthType: AuthType_IGNORE},
+				}},
+				OrderBy: yyDollar[2].val.(OrderBy),
+				Limit:   yyDollar[3].val.(*Limit),
+			}
+		}
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:720
+		{
+			yyVAL.val = yyDollar[2].val.(SelectStatement)
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:726
+		{
+			if yyDollar[1].val.(SelectStatement).GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 53:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:736
+		{
+			yyVAL.val = &Stream{Comments: Comments(yyDollar[2].val.(Comments)), SelectExpr: yyDollar[3].val.(SelectExpr), Table: yyDollar[5].val.(TableName)}
+		}
+	case 54:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:744
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:748
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:755
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 57:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:759
+		{
+			yyVAL.val = &SetOp{Type: yyDollar[2].val.(string), Left: yyDollar[1].val.(SelectStatement), Right: yyDollar[3].val.(SelectStatement)}
+		}
+	case 58:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:763
+		{
+			yyVAL.val = &SetOp{Type: yyDollar[2].val.(string), Left: yyDollar[1].val.(SelectStatement), Right: yyDollar[3].val.(SelectStatement)}
+		}
+	case 59:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:772
+		{
+			yyVAL.val = &SetOp{Type: yyDollar[2].val.(string), Left: yyDollar[1].val.(SelectStatement), Right: yyDollar[3].val.(SelectStatement)}
+		}
+	case 60:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:776
+		{
+			yyVAL.val = &SetOp{Type: yyDollar[2].val.(string), Left: yyDollar[1].val.(SelectStatement), Right: yyDollar[3].val.(SelectStatement)}
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:783
+		{
+			if yyDollar[1].val.(SelectStatement).GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 62:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:791
+		{
+			yyVAL.val = &ParenSelect{Select: yyDollar[2].val.(SelectStatement)}
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:799
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:803
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:811
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:815
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:821
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 68:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:825
+		{
+			with := yyDollar[2].val.(*With)
+			selectStatement := yyDollar[3].val.(SelectStatement)
+			handleCTEAuth(selectStatement, with)
+			selectStatement.SetWith(with)
+			yyVAL.val = selectStatement
+		}
+	case 69:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:835
+		{
+			yyVAL.val = &With{Ctes: yyDollar[2].val.([]*CommonTableExpr), Recursive: true}
+		}
+	case 70:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:838
+		{
+			yyVAL.val = &With{Ctes: yyDollar[1].val.([]*CommonTableExpr), Recursive: false}
+		}
+	case 71:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:844
+		{
+			yyVAL.val = &Select{
+				Comments:    Comments(yyDollar[2].val.(Comments)),
+				QueryOpts:   yyDollar[3].val.(QueryOpts),
+				SelectExprs: yyDollar[4].val.(SelectExprs),
+				Into:        yyDollar[5].val.(*Into),
+				From:        SetAuthType(yyDollar[6].val.(TableExprs), AuthType_SELECT, true).(TableExprs),
+				Where:       NewWhere(WhereStr, tryCastExpr(yyDollar[7].val)),
+				GroupBy:     GroupBy(yyDollar[8].val.(Exprs)),
+				Having:      NewWhere(HavingStr, tryCastExpr(yyDollar[9].val)),
+				Window:      yyDollar[10].val.(Window),
+			}
+		}
+	case 72:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:858
+		{
+			yyVAL.val = &Select{
+				SelectExprs: SelectExprs{&StarExpr{}},
+				From:        TableExprs{SetAuthType(yyDollar[2].val.(TableExpr), AuthType_SELECT, true).(TableExpr)},
+			}
+		}
+	case 73:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:866
+		{
+			yyVAL.val = TableExprs(nil)
+		}
+	case 74:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:870
+		{
+			yyVAL.val = TableExprs(nil)
+		}
+	case 75:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:874
+		{
+			yyVAL.val = yyDollar[2].val.(TableExprs)
+		}
+	case 76:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:883
+		{
+			yyVAL.val = (*Into)(nil)
+		}
+	case 77:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:887
+		{
+			yyVAL.val = &Into{Variables: yyDollar[2].val.(Variables)}
+		}
+	case 78:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:891
+		{
+			yyVAL.val = &Into{Dumpfile: string(yyDollar[3].bytes)}
+		}
+	case 79:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:895
+		{
+			yyVAL.val = &Into{Outfile: string(yyDollar[3].bytes), Charset: yyDollar[4].val.(string), Fields: yyDollar[5].val.(*Fields), Lines: yyDollar[6].val.(*Lines)}
+		}
+	case 80:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:901
+		{
+			yyVAL.val = Variables{yyDollar[1].val.(ColIdent)}
+		}
+	case 81:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:905
+		{
+			yyVAL.val = append(yyVAL.val.(Variables), yyDollar[3].val.(ColIdent))
+		}
+	case 82:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:910
+		{
+			yyVAL.val = (*With)(nil)
+		}
+	case 83:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:914
+		{
+			yyVAL.val = yyDollar[2].val.(*With)
+		}
+	case 84:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:920
+		{
+			yyVAL.val = []*CommonTableExpr{yyDollar[1].val.(*CommonTableExpr)}
+		}
+	case 85:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:924
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*CommonTableExpr), yyDollar[3].val.(*CommonTableExpr))
+		}
+	case 86:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:930
+		{
+			yyVAL.val = &CommonTableExpr{
+				&AliasedTableExpr{
+					Expr: yyDollar[4].val.(SimpleTableExpr),
+					As:   yyDollar[1].val.(TableIdent),
+					Auth: AuthInformation{AuthType: AuthType_IGNORE},
+				},
+				yyDollar[2].val.(Columns)}
+		}
+	case 87:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:942
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[7].val.(*Insert)
+			ins.Action = yyDollar[2].val.(string)
+			ins.Comments = yyDollar[3].val.(Comments)
+			ins.Ignore = yyDollar[4].val.(string)
+			tableName := yyDollar[5].val.(TableName)
+			ins.Table = tableName
+			authType := AuthType_INSERT
+			if ins.Action == ReplaceStr {
+				authType = AuthType_REPLACE
+			}
+			ins.Auth = AuthInformation{
+				AuthType:    authType,
+				TargetType:  AuthTargetType_SingleTableIdentifier,
+				TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+			}
+			ins.Partitions = yyDollar[6].val.(Partitions)
+			ins.OnDup = OnDup(yyDollar[8].val.(AssignmentExprs))
+			ins.Returning = yyDollar[9].val.(SelectExprs)
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(ins, with)
+			ins.With = with
+			yyVAL.val = ins
+		}
+	case 88:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:968
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[7].val.(*Insert)
+			ins.Action = yyDollar[2].val.(string)
+			ins.Comments = yyDollar[3].val.(Comments)
+			ins.Ignore = yyDollar[4].val.(string)
+			tableName := yyDollar[5].val.(TableName)
+			ins.Table = tableName
+			authType := AuthType_INSERT
+			if ins.Action == ReplaceStr {
+				authType = AuthType_REPLACE
+			}
+			ins.Auth = AuthInformation{
+				AuthType:    authType,
+				TargetType:  AuthTargetType_SingleTableIdentifier,
+				TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+			}
+			ins.Partitions = yyDollar[6].val.(Partitions)
+			ins.OnDup = OnDup(yyDollar[8].val.(AssignmentExprs))
+			ins.Returning = yyDollar[9].val.(SelectExprs)
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(ins, with)
+			ins.With = with
+			yyVAL.val = ins
+		}
+	case 89:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:994
+		{
+			cols := make(Columns, 0, len(yyDollar[8].val.(AssignmentExprs)))
+			vals := make(ValTuple, 0, len(yyDollar[9].val.(AssignmentExprs)))
+			for _, updateList := range yyDollar[8].val.(AssignmentExprs) {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			tableName := yyDollar[5].val.(TableName)
+			authType := AuthType_INSERT
+			if yyDollar[2].val.(string) == ReplaceStr {
+				authType = AuthType_REPLACE
+			}
+			ins := &Insert{
+				Action:     yyDollar[2].val.(string),
+				Comments:   Comments(yyDollar[3].val.(Comments)),
+				Ignore:     yyDollar[4].val.(string),
+				Table:      tableName,
+				Partitions: yyDollar[6].val.(Partitions),
+				Columns:    cols,
+				Rows:       &AliasedValues{Values: Values{vals}},
+				OnDup:      OnDup(yyDollar[9].val.(AssignmentExprs)),
+				Returning:  yyDollar[10].val.(SelectExprs),
+				Auth: AuthInformation{
+					AuthType:    authType,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(ins, with)
+			ins.With = with
+			yyVAL.val = ins
+		}
+	case 90:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1030
+		{
+			yyVAL.val = InsertStr
+		}
+	case 91:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1034
+		{
+			yyVAL.val = ReplaceStr
+		}
+	case 92:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1040
+		{
+			update := &Update{
+				Comments:   Comments(yyDollar[3].val.(Comments)),
+				Ignore:     yyDollar[4].val.(string),
+				TableExprs: SetAuthType(yyDollar[5].val.(TableExprs), AuthType_UPDATE, true).(TableExprs),
+				Exprs:      yyDollar[7].val.(AssignmentExprs),
+				Where:      NewWhere(WhereStr, tryCastExpr(yyDollar[8].val)),
+				OrderBy:    yyDollar[9].val.(OrderBy),
+				Limit:      yyDollar[10].val.(*Limit),
+			}
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(update, with)
+			update.With = with
+			yyVAL.val = update
+		}
+	case 93:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1058
+		{
+			tableName := yyDollar[5].val.(TableName)
+			delete := &Delete{
+				Comments: Comments(yyDollar[3].val.(Comments)),
+				TableExprs: TableExprs{&AliasedTableExpr{
+					Expr: tableName,
+					Auth: AuthInformation{
+						AuthType:    AuthType_DELETE,
+						TargetType:  AuthTargetType_SingleTableIdentifier,
+						TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+					},
+				}},
+				Partitions: yyDollar[6].val.(Partitions),
+				Where:      NewWhere(WhereStr, tryCastExpr(yyDollar[7].val)),
+				OrderBy:    yyDollar[8].val.(OrderBy),
+				Limit:      yyDollar[9].val.(*Limit),
+			}
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(delete, with)
+			delete.With = with
+			yyVAL.val = delete
+		}
+	case 94:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1081
+		{
+			delete := &Delete{
+				Comments:   Comments(yyDollar[3].val.(Comments)),
+				Targets:    yyDollar[5].val.(TableNames),
+				TableExprs: SetAuthType(yyDollar[7].val.(TableExprs), AuthType_DELETE, true).(TableExprs),
+				Where:      NewWhere(WhereStr, tryCastExpr(yyDollar[8].val)),
+			}
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(delete, with)
+			delete.With = with
+			yyVAL.val = delete
+		}
+	case 95:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1094
+		{
+			delete := &Delete{
+				Comments:   Comments(yyDollar[3].val.(Comments)),
+				Targets:    yyDollar[4].val.(TableNames),
+				TableExprs: SetAuthType(yyDollar[6].val.(TableExprs), AuthType_DELETE, true).(TableExprs),
+				Where:      NewWhere(WhereStr, tryCastExpr(yyDollar[7].val)),
+			}
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(delete, with)
+			delete.With = with
+			yyVAL.val = delete
+		}
+	case 96:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1107
+		{
+			tableNames := yyDollar[4].val.(TableNames)
+			authTargetNames := make([]string, len(tableNames)*2)
+			for i, tableName := range tableNames {
+				authTargetNames[2*i] = tableName.DbQualifier.String()
+				authTargetNames[2*i+1] = tableName.Name.String()
+			}
+			delete := &Delete{
+				Comments:   Comments(yyDollar[3].val.(Comments)),
+				Targets:    tableNames,
+				TableExprs: SetAuthType(yyDollar[6].val.(TableExprs), AuthType_DELETE, true).(TableExprs),
+				Where:      NewWhere(WhereStr, tryCastExpr(yyDollar[7].val)),
+			}
+			with := yyDollar[1].val.(*With)
+			handleCTEAuth(delete, with)
+			delete.With = with
+			yyVAL.val = delete
+		}
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1127
+		{
+		}
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1128
+		{
+		}
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1132
+		{
+			yyVAL.val = TableNames{yyDollar[1].val.(TableName).ToViewName()}
+		}
+	case 100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1136
+		{
+			yyVAL.val = append(yyVAL.val.(TableNames), yyDollar[3].val.(TableName).ToViewName())
+		}
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1142
+		{
+			yyVAL.val = TableNames{yyDollar[1].val.(TableName)}
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1146
+		{
+			yyVAL.val = append(yyVAL.val.(TableNames), yyDollar[3].val.(TableName))
+		}
+	case 103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1152
+		{
+			yyVAL.val = TableNames{yyDollar[1].val.(TableName)}
+		}
+	case 104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1156
+		{
+			yyVAL.val = append(yyVAL.val.(TableNames), yyDollar[3].val.(TableName))
+		}
+	case 105:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1161
+		{
+			yyVAL.val = Partitions(nil)
+		}
+	case 106:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1165
+		{
+			yyVAL.val = yyDollar[3].val.(Partitions)
+		}
+	case 107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1170
+		{
+			yyVAL.val = SelectExprs(nil)
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1174
+		{
+			yyVAL.val = yyDollar[2].val.(SelectExprs)
+		}
+	case 109:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1180
+		{
+			yyVAL.val = &Set{Comments: Comments(yyDollar[2].val.(Comments)), Exprs: yyDollar[3].val.(SetVarExprs)}
+		}
+	case 110:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1184
+		{
+			for i := 0; i < len(yyDollar[4].val.(SetVarExprs)); i++ {
+				yyDollar[4].val.(SetVarExprs)[i].Scope = SetScope_None
+			}
+			yyVAL.val = &Set{Comments: Comments(yyDollar[2].val.(Comments)), Exprs: yyDollar[4].val.(SetVarExprs)}
+		}
+	case 111:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1191
+		{
+			for i := 0; i < len(yyDollar[5].val.(SetVarExprs)); i++ {
+				yyDollar[5].val.(SetVarExprs)[i].Scope = yyDollar[3].val.(SetScope)
+			}
+			yyVAL.val = &Set{Comments: Comments(yyDollar[2].val.(Comments)), Exprs: yyDollar[5].val.(SetVarExprs)}
+		}
+	case 112:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1199
+		{
+			yyVAL.val = &SetRole{Type: RoleSelectionType_Default, Auth: AuthInformation{AuthType: AuthType_IGNORE}}
+		}
+	case 113:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1203
+		{
+			yyVAL.val = &SetRole{Type: RoleSelectionType_None, Auth: AuthInformation{AuthType: AuthType_IGNORE}}
+		}
+	case 114:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1207
+		{
+			yyVAL.val = &SetRole{Type: RoleSelectionType_All, Auth: AuthInformation{AuthType: AuthType_IGNORE}}
+		}
+	case 115:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1211
+		{
+			yyVAL.val = &SetRole{Type: RoleSelectionType_AllExcept, Roles: yyDollar[6].val.([]AccountName), Auth: AuthInformation{AuthType: AuthType_IGNORE}}
+		}
+	case 116:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1215
+		{
+			yyVAL.val = &SetRole{Type: RoleSelectionType_Roles, Roles: yyDollar[4].val.([]AccountName), Auth: AuthInformation{AuthType: AuthType_IGNORE}}
+		}
+	case 117:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1219
+		{
+			yyVAL.val = &SetPassword{User: yyDollar[5].val.(AccountName), Password: string(yyDollar[7].bytes), Auth: AuthInformation{AuthType: AuthType_IGNORE}}
+		}
+	case 118:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1223
+		{
+			selection := yyDollar[5].val.(*SetDefaultRole)
+			selection.Users = yyDollar[7].val.([]AccountName)
+			selection.Auth = AuthInformation{AuthType: AuthType_IGNORE}
+			yyVAL.val = selection
+		}
+	case 119:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1233
+		{
+			yyVAL.val = &SetDefaultRole{Type: RoleSelectionType_None}
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1237
+		{
+			yyVAL.val = &SetDefaultRole{Type: RoleSelectionType_All}
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1241
+		{
+			yyVAL.val = &SetDefaultRole{Type: RoleSelectionType_Roles, Roles: yyDollar[1].val.([]AccountName)}
+		}
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1247
+		{
+			yyVAL.val = SetVarExprs{yyDollar[1].val.(*SetVarExpr)}
+		}
+	case 123:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1251
+		{
+			yyVAL.val = append(yyVAL.val.(SetVarExprs), yyDollar[3].val.(*SetVarExpr))
+		}
+	case 124:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1257
+		{
+			yyVAL.val = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].val.(string)))}
+		}
+	case 125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1261
+		{
+			yyVAL.val = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
+		}
+	case 126:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1265
+		{
+			yyVAL.val = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
+		}
+	case 127:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1271
+		{
+			yyVAL.val = IsolationLevelRepeatableRead
+		}
+	case 128:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1275
+		{
+			yyVAL.val = IsolationLevelReadCommitted
+		}
+	case 129:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1279
+		{
+			yyVAL.val = IsolationLevelReadUncommitted
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1283
+		{
+			yyVAL.val = IsolationLevelSerializable
+		}
+	case 131:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1288
+		{
+			yyVAL.val = yyPosition(yylex)
+		}
+	case 132:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1293
+		{
+			yyVAL.val = yyOldPosition(yylex)
+		}
+	case 133:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1298
+		{
+			yyVAL.val = yyTokenStart(yylex)
+		}
+	case 134:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1303
+		{
+			yyVAL.val = yySpecialCommentMode(yylex)
+		}
+	case 135:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1309
+		{
+			yyDollar[1].val.(*DDL).TableSpec = yyDollar[2].val.(*TableSpec)
+			if len(yyDollar[1].val.(*DDL).TableSpec.Constraints) > 0 {
+				yyDollar[1].val.(*DDL).ConstraintAction = AddStr
+			}
+			yyVAL.val = yyDollar[1].val.(*DDL)
+		}
+	case 136:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1317
+		{
+			yyDollar[1].val.(*DDL).TableSpec = yyDollar[2].val.(*TableSpec)
+			yyDollar[1].val.(*DDL).OptSelect = &OptSelect{Select: yyDollar[4].val.(SelectStatement)}
+			if len(yyDollar[1].val.(*DDL).TableSpec.Constraints) > 0 {
+				yyDollar[1].val.(*DDL).ConstraintAction = AddStr
+			}
+			yyVAL.val = yyDollar[1].val.(*DDL)
+		}
+	case 137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1326
+		{
+			yyDollar[1].val.(*DDL).TableSpec = yyDollar[2].val.(*TableSpec)
+			yyDollar[1].val.(*DDL).OptSelect = &OptSelect{Select: yyDollar[3].val.(SelectStatement)}
+			if len(yyDollar[1].val.(*DDL).TableSpec.Constraints) > 0 {
+				yyDollar[1].val.(*DDL).ConstraintAction = AddStr
+			}
+			yyVAL.val = yyDollar[1].val.(*DDL)
+		}
+	case 138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1335
+		{
+			yyDollar[1].val.(*DDL).OptSelect = &OptSelect{Select: yyDollar[3].val.(SelectStatement)}
+			yyVAL.val = yyDollar[1].val.(*DDL)
+		}
+	case 139:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1344
+		{
+			yyDollar[1].val.(*DDL).OptSelect = &OptSelect{Select: yyDollar[2].val.(SelectStatement)}
+			yyVAL.val = yyDollar[1].val.(*DDL)
+		}
+	case 140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1349
+		{
+			yyDollar[1].val.(*DDL).OptLike = &OptLike{LikeTables: []TableName{yyDollar[3].val.(TableName)}}
+			yyVAL.val = yyDollar[1].val.(*DDL)
+		}
+	case 141:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1354
+		{
+			// For consistency, we always return AlterTable for any ALTER TABLE-equivalent statements
+			tableName := yyDollar[8].val.(TableName)
+			ddl := &DDL{
+				Action:      AlterStr,
+				Table:       tableName,
+				IfNotExists: yyDollar[4].val.(int) != 0,
+				IndexSpec: &IndexSpec{
+					Action:      CreateStr,
+					ToName:      yyDollar[5].val.(ColIdent),
+					Using:       yyDollar[6].val.(ColIdent),
+					Type:        yyDollar[2].val.(string),
+					Fields:      yyDollar[10].val.([]*IndexField),
+					Options:     yyDollar[12].val.([]*IndexOption),
+					ifNotExists: yyDollar[4].val.(int) != 0,
+				},
+				Auth: AuthInformation{
+					AuthType:    AuthType_INDEX,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+			yyVAL.val = &AlterTable{
+				Table:      yyDollar[8].val.(TableName),
+				Statements: []*DDL{ddl},
+				Auth:       AuthInformation{AuthType: AuthType_IGNORE},
+			}
+		}
+	case 142:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1383
+		{
+			viewName := yyDollar[5].val.(TableName)
+			yyDollar[2].val.(*ViewSpec).ViewName = viewName.ToViewName()
+			yyDollar[2].val.(*ViewSpec).ViewExpr = yyDollar[10].val.(SelectStatement)
+			yyDollar[2].val.(*ViewSpec).Columns = yyDollar[6].val.(Columns)
+			yyDollar[2].val.(*ViewSpec).CheckOption = yyDollar[12].val.(ViewCheckOption)
+			yyVAL.val = &DDL{
+				Action:                    CreateStr,
+				ViewSpec:                  yyDollar[2].val.(*ViewSpec),
+				IfNotExists:               yyDollar[4].val.(int) != 0,
+				SpecialCommentMode:        yyDollar[9].val.(bool),
+				SubStatementPositionStart: yyDollar[8].val.(int),
+				SubStatementPositionEnd:   yyDollar[11].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE_VIEW,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{viewName.DbQualifier.String()},
+				},
+			}
+		}
+	case 143:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line sql.y:1404
+		{
+			// Accept parenthesized SELECT for MySQL compatibility (single level only)
+			viewName := yyDollar[5].val.(TableName)
+			yyDollar[2].val.(*ViewSpec).ViewName = viewName.ToViewName()
+			yyDollar[2].val.(*ViewSpec).ViewExpr = &ParenSelect{Select: yyDollar[11].val.(SelectStatement)}
+			yyDollar[2].val.(*ViewSpec).Columns = yyDollar[6].val.(Columns)
+			yyDollar[2].val.(*ViewSpec).CheckOption = yyDollar[14].val.(ViewCheckOption)
+			yyVAL.val = &DDL{
+				Action:             CreateStr,
+				ViewSpec:           yyDollar[2].val.(*ViewSpec),
+				IfNotExists:        yyDollar[4].val.(int) != 0,
+				SpecialCommentMode: yyDollar[9].val.(bool),
+				// Capture inner SELECT span, not parentheses
+				SubStatementPositionStart: yyDollar[8].val.(int),
+				SubStatementPositionEnd:   yyDollar[13].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE_VIEW,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{viewName.DbQualifier.String()},
+				},
+			}
+		}
+	case 144:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:1427
+		{
+			viewName := yyDollar[6].val.(TableName)
+			yyDollar[4].val.(*ViewSpec).ViewName = viewName.ToViewName()
+			yyDollar[4].val.(*ViewSpec).ViewExpr = yyDollar[11].val.(SelectStatement)
+			yyDollar[4].val.(*ViewSpec).Columns = yyDollar[7].val.(Columns)
+			yyDollar[4].val.(*ViewSpec).CheckOption = yyDollar[13].val.(ViewCheckOption)
+			yyVAL.val = &DDL{
+				Action:                    CreateStr,
+				ViewSpec:                  yyDollar[4].val.(*ViewSpec),
+				SpecialCommentMode:        yyDollar[10].val.(bool),
+				SubStatementPositionStart: yyDollar[9].val.(int),
+				SubStatementPositionEnd:   yyDollar[12].val.(int) - 1,
+				OrReplace:                 true,
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE_VIEW,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{viewName.DbQualifier.String()},
+				},
+			}
+		}
+	case 145:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:1448
+		{
+			// Accept parenthesized SELECT for MySQL compatibility (single level only)
+			viewName := yyDollar[6].val.(TableName)
+			yyDollar[4].val.(*ViewSpec).ViewName = viewName.ToViewName()
+			yyDollar[4].val.(*ViewSpec).ViewExpr = &ParenSelect{Select: yyDollar[12].val.(SelectStatement)}
+			yyDollar[4].val.(*ViewSpec).Columns = yyDollar[7].val.(Columns)
+			yyDollar[4].val.(*ViewSpec).CheckOption = yyDollar[15].val.(ViewCheckOption)
+			yyVAL.val = &DDL{
+				Action:             CreateStr,
+				ViewSpec:           yyDollar[4].val.(*ViewSpec),
+				SpecialCommentMode: yyDollar[10].val.(bool),
+				// Capture inner SELECT span, not parentheses
+				SubStatementPositionStart: yyDollar[9].val.(int),
+				SubStatementPositionEnd:   yyDollar[14].val.(int) - 1,
+				OrReplace:                 true,
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE_VIEW,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{viewName.DbQualifier.String()},
+				},
+			}
+		}
+	case 146:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1471
+		{
+			var ne bool
+			if yyDollar[3].val.(int) != 0 {
+				ne = true
+			}
+			yyVAL.val = &DBDDL{
+				Action:           CreateStr,
+				SchemaOrDatabase: "database",
+				DBName:           string(yyDollar[4].bytes),
+				IfNotExists:      ne,
+				CharsetCollate:   yyDollar[5].val.([]*CharsetAndCollate),
+				Auth: AuthInformation{
+					AuthType:   AuthType_CREATE,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 147:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1489
+		{
+			var ne bool
+			if yyDollar[3].val.(int) != 0 {
+				ne = true
+			}
+			yyVAL.val = &DBDDL{
+				Action:           CreateStr,
+				SchemaOrDatabase: "schema",
+				DBName:           string(yyDollar[4].bytes),
+				IfNotExists:      ne,
+				CharsetCollate:   yyDollar[5].val.([]*CharsetAndCollate),
+				Auth: AuthInformation{
+					AuthType:   AuthType_CREATE,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 148:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:1507
+		{
+			tableName := yyDollar[8].val.(TableName)
+			yyVAL.val = &DDL{
+				Action: CreateStr,
+				Table:  tableName,
+				TriggerSpec: &TriggerSpec{
+					TrigName: yyDollar[4].val.(TriggerName),
+					Definer:  yyDollar[2].val.(string),
+					Time:     yyDollar[5].val.(string),
+					Event:    yyDollar[6].val.(string),
+					Body:     tryCastStatement(yyDollar[14].val),
+				},
+				SpecialCommentMode:        yyDollar[13].val.(bool),
+				SubStatementPositionStart: yyDollar[12].val.(int),
+				SubStatementPositionEnd:   yyDollar[15].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_TRIGGER,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 149:
+		yyDollar = yyS[yypt-16 : yypt+1]
+//line sql.y:1530
+		{
+			tableName := yyDollar[8].val.(TableName)
+			yyVAL.val = &DDL{
+				Action: CreateStr,
+				Table:  tableName,
+				TriggerSpec: &TriggerSpec{
+					TrigName: yyDollar[4].val.(TriggerName),
+					Definer:  yyDollar[2].val.(string),
+					Time:     yyDollar[5].val.(string),
+					Event:    yyDollar[6].val.(string),
+					Order:    yyDollar[12].val.(*TriggerOrder),
+					Body:     tryCastStatement(yyDollar[15].val),
+				},
+				SpecialCommentMode:        yyDollar[14].val.(bool),
+				SubStatementPositionStart: yyDollar[13].val.(int),
+				SubStatementPositionEnd:   yyDollar[16].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_TRIGGER,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 150:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1554
+		{
+			procName := yyDollar[4].val.(ProcedureName)
+			yyVAL.val = &DDL{
+				Action: CreateStr,
+				ProcedureSpec: &ProcedureSpec{
+					ProcName:        procName,
+					Definer:         yyDollar[2].val.(string),
+					Params:          yyDollar[6].val.([]ProcedureParam),
+					Characteristics: yyDollar[8].val.([]Characteristic),
+					Body:            tryCastStatement(yyDollar[11].val),
+				},
+				SpecialCommentMode:        yyDollar[10].val.(bool),
+				SubStatementPositionStart: yyDollar[9].val.(int),
+				SubStatementPositionEnd:   yyDollar[12].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE_ROUTINE,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{procName.Qualifier.String()},
+				},
+			}
+		}
+	case 151:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:1576
+		{
+			var notExists bool
+			if yyDollar[4].val.(int) != 0 {
+				notExists = true
+			}
+			funcName := yyDollar[5].val.(ProcedureName)
+			yyVAL.val = &DDL{
+				Action:      CreateStr,
+				IfNotExists: notExists,
+				FunctionSpec: &FunctionSpec{
+					FuncName:        funcName,
+					Definer:         yyDollar[2].val.(string),
+					Params:          yyDollar[7].val.([]ProcedureParam),
+					ReturnType:      yyDollar[10].val.(ColumnType),
+					Characteristics: yyDollar[11].val.([]Characteristic),
+					Body:            tryCastStatement(yyDollar[14].val),
+				},
+				SpecialCommentMode:        yyDollar[13].val.(bool),
+				SubStatementPositionStart: yyDollar[12].val.(int),
+				SubStatementPositionEnd:   yyDollar[15].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE_ROUTINE,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{funcName.Qualifier.String()},
+				},
+			}
+		}
+	case 152:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1604
+		{
+			if yyDollar[2].val.(string) != "" || !yyDollar[5].val.(ProcedureName).Qualifier.IsEmpty() {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = &DDL{
+				Action:      CreateStr,
+				IfNotExists: yyDollar[4].val.(int) != 0,
+				LoadableFunctionSpec: &LoadableFunctionSpec{
+					FuncName:   yyDollar[5].val.(ProcedureName).Name,
+					ReturnType: yyDollar[7].val.(string),
+					Library:    string(yyDollar[9].bytes),
+				},
+			}
+		}
+	case 153:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1620
+		{
+			if !yyDollar[5].val.(ProcedureName).Qualifier.IsEmpty() {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = &DDL{
+				Action:      CreateStr,
+				IfNotExists: yyDollar[4].val.(int) != 0,
+				LoadableFunctionSpec: &LoadableFunctionSpec{
+					FuncName:   yyDollar[5].val.(ProcedureName).Name,
+					Aggregate:  true,
+					ReturnType: yyDollar[7].val.(string),
+					Library:    string(yyDollar[9].bytes),
+				},
+			}
+		}
+	case 154:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1637
+		{
+			var notExists bool
+			if yyDollar[3].val.(int) != 0 {
+				notExists = true
+			}
+			tlsOptions, err := NewTLSOptions(yyDollar[6].val.([]TLSOptionItem))
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			accountLimits, err := NewAccountLimits(yyDollar[7].val.([]AccountLimitItem))
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			passwordOptions, locked := NewPasswordOptionsWithLock(yyDollar[8].val.([]PassLockItem))
+			yyVAL.val = &CreateUser{
+				IfNotExists:     notExists,
+				Users:           yyDollar[4].val.([]AccountWithAuth),
+				DefaultRoles:    yyDollar[5].val.([]AccountName),
+				TLSOptions:      tlsOptions,
+				AccountLimits:   accountLimits,
+				PasswordOptions: passwordOptions,
+				PassLockItems:   yyDollar[8].val.([]PassLockItem),
+				Locked:          locked,
+				Attribute:       yyDollar[9].val.(string),
+				Auth: AuthInformation{
+					AuthType:   AuthType_CREATE_USER,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 155:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1670
+		{
+			var notExists bool
+			if yyDollar[3].val.(int) != 0 {
+				notExists = true
+			}
+			yyVAL.val = &CreateRole{
+				IfNotExists: notExists,
+				Roles:       yyDollar[4].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_CREATE_ROLE,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 156:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:1685
+		{
+			eventName := yyDollar[5].val.(EventName)
+			var notExists bool
+			if yyDollar[4].val.(int) != 0 {
+				notExists = true
+			}
+			yyVAL.val = &DDL{
+				Action: CreateStr,
+				EventSpec: &EventSpec{
+					EventName:            eventName,
+					Definer:              yyDollar[2].val.(string),
+					IfNotExists:          notExists,
+					OnSchedule:           yyDollar[8].val.(*EventScheduleSpec),
+					OnCompletionPreserve: yyDollar[9].val.(EventOnCompletion),
+					Status:               yyDollar[10].val.(EventStatus),
+					Comment:              yyDollar[11].val.(*SQLVal),
+					Body:                 tryCastStatement(yyDollar[14].val),
+				},
+				SubStatementPositionStart: yyDollar[13].val.(int),
+				SubStatementPositionEnd:   yyDollar[15].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_EVENT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{eventName.Qualifier.String()},
+				},
+			}
+		}
+	case 157:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1713
+		{
+			yyVAL.val = tryCastStatement(yyDollar[1].val)
+		}
+	case 158:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1719
+		{
+			yyVAL.val = &CreateSpatialRefSys{
+				SRID:    NewIntVal(yyDollar[5].bytes),
+				SrsAttr: yyDollar[6].val.(*SrsAttribute),
+				Auth: AuthInformation{
+					AuthType:    AuthType_INSERT,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{"mysql", "st_spatial_references_systems"},
+				},
+			}
+		}
+	case 159:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1731
+		{
+			yyVAL.val = &CreateSpatialRefSys{
+				SRID:        NewIntVal(yyDollar[8].bytes),
+				IfNotExists: true,
+				SrsAttr:     yyDollar[9].val.(*SrsAttribute),
+				Auth: AuthInformation{
+					AuthType:    AuthType_INSERT,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{"mysql", "st_spatial_references_systems"},
+				},
+			}
+		}
+	case 160:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1744
+		{
+			yyVAL.val = &CreateSpatialRefSys{
+				SRID:      NewIntVal(yyDollar[7].bytes),
+				OrReplace: true,
+				SrsAttr:   yyDollar[8].val.(*SrsAttribute),
+				Auth: AuthInformation{
+					AuthType:    AuthType_INSERT,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{"mysql", "st_spatial_references_systems"},
+				},
+			}
+		}
+	case 161:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1757
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &DDL{
+				Action:       CreateStr,
+				Table:        tableName,
+				IfNotExists:  yyDollar[3].val.(int) != 0,
+				SequenceSpec: &SequenceSpec{Options: yyDollar[5].val.([]*SequenceOption)},
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 162:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1772
+		{
+			tableName := yyDollar[6].val.(TableName)
+			yyVAL.val = &DDL{
+				Action:       CreateStr,
+				Table:        tableName,
+				OrReplace:    true,
+				IfNotExists:  yyDollar[5].val.(int) != 0,
+				SequenceSpec: &SequenceSpec{Options: yyDollar[7].val.([]*SequenceOption)},
+				Auth: AuthInformation{
+					AuthType:    AuthType_CREATE,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 163:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1789
+		{
+			yyVAL.val = []*SequenceOption(nil)
+		}
+	case 164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1793
+		{
+			yyVAL.val = yyDollar[1].val.([]*SequenceOption)
+		}
+	case 165:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1799
+		{
+			yyVAL.val = []*SequenceOption{yyDollar[1].val.(*SequenceOption)}
+		}
+	case 166:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1803
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*SequenceOption), yyDollar[2].val.(*SequenceOption))
+		}
+	case 167:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1810
+		{
+			name := strings.ToLower(string(yyDollar[1].bytes))
+			switch name {
+			case SequenceNoMinValueStr, SequenceNoMaxValueStr, SequenceNoCacheStr, SequenceCycleStr, SequenceNoCycleStr, SequenceRestartStr:
+			default:
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = &SequenceOption{Name: name}
+		}
+	case 168:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1821
+		{
+			name := strings.ToLower(string(yyDollar[1].bytes))
+			switch name {
+			case SequenceIncrementStr, SequenceMinValueStr, SequenceCacheStr, SequenceRestartStr:
+			default:
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = &SequenceOption{Name: name, Value: string(yyDollar[3].bytes)}
+		}
+	case 169:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1832
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), SequenceIncrementStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = &SequenceOption{Name: SequenceIncrementStr, Value: string(yyDollar[3].bytes)}
+		}
+	case 170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1840
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), SequenceRestartStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = &SequenceOption{Name: SequenceRestartStr, Value: string(yyDollar[3].bytes)}
+		}
+	case 171:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1848
+		{
+			var name string
+			switch strings.ToLower(string(yyDollar[2].bytes)) {
+			case SequenceMinValueStr:
+				name = SequenceNoMinValueStr
+			case SequenceCacheStr:
+				name = SequenceNoCacheStr
+			case SequenceCycleStr:
+				name = SequenceNoCycleStr
+			default:
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = &SequenceOption{Name: name}
+		}
+	case 172:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1864
+		{
+			yyVAL.val = &SequenceOption{Name: SequenceNoMaxValueStr}
+		}
+	case 173:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1868
+		{
+			yyVAL.val = &SequenceOption{Name: SequenceMaxValueStr, Value: string(yyDollar[3].bytes)}
+		}
+	case 174:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1872
+		{
+			yyVAL.val = &SequenceOption{Name: SequenceStartStr, Value: string(yyDollar[3].bytes)}
+		}
+	case 175:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1876
+		{
+			yyVAL.val = &SequenceOption{Name: SequenceStartStr, Value: string(yyDollar[3].bytes)}
+		}
+	case 176:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1880
+		{
+			yyVAL.val = &SequenceOption{Name: SequenceEngineStr, Value: string(yyDollar[3].bytes)}
+		}
+	case 178:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1887
+		{
+			yyVAL.bytes = append([]byte("-"), yyDollar[2].bytes...)
+		}
+	case 179:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1891
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 180:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1896
+		{
+			yyVAL.val = new(SrsAttribute)
+		}
+	case 181:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1900
+		{
+			if yyDollar[1].val.(*SrsAttribute).Name != "" {
+				yylex.Error("multiple definitions of attribute name")
+				return 1
+			}
+			yyDollar[1].val.(*SrsAttribute).Name = string(yyDollar[3].bytes)
+			yyVAL.val = yyDollar[1].val.(*SrsAttribute)
+		}
+	case 182:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1909
+		{
+			if yyDollar[1].val.(*SrsAttribute).Definition != "" {
+				yylex.Error("multiple definitions of attribute definition")
+				return 1
+			}
+			yyDollar[1].val.(*SrsAttribute).Definition = string(yyDollar[3].bytes)
+			yyVAL.val = yyDollar[1].val.(*SrsAttribute)
+		}
+	case 183:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1918
+		{
+			if yyDollar[1].val.(*SrsAttribute).Organization != "" {
+				yylex.Error("multiple definitions of attribute organization")
+				return 1
+			}
+			yyDollar[1].val.(*SrsAttribute).Organization = string(yyDollar[3].bytes)
+			yyDollar[1].val.(*SrsAttribute).OrgID = NewIntVal(yyDollar[6].bytes)
+			yyVAL.val = yyDollar[1].val.(*SrsAttribute)
+		}
+	case 184:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1928
+		{
+			if yyDollar[1].val.(*SrsAttribute).Description != "" {
+				yylex.Error("multiple definitions of attribute description")
+				return 1
+			}
+			yyDollar[1].val.(*SrsAttribute).Description = string(yyDollar[3].bytes)
+			yyVAL.val = yyDollar[1].val.(*SrsAttribute)
+		}
+	case 185:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1939
+		{
+			yyVAL.val = ViewCheckOptionUnspecified
+		}
+	case 186:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1943
+		{
+			yyVAL.val = ViewCheckOptionCascaded
+		}
+	case 187:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1947
+		{
+			yyVAL.val = ViewCheckOptionCascaded
+		}
+	case 188:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1951
+		{
+			yyVAL.val = ViewCheckOptionLocal
+		}
+	case 189:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1956
+		{
+			yyVAL.val = []AccountName(nil)
+		}
+	case 190:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1960
+		{
+			yyVAL.val = yyDollar[3].val.([]AccountName)
+		}
+	case 191:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1965
+		{
+			yyVAL.val = []TLSOptionItem(nil)
+		}
+	case 192:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1969
+		{
+			yyVAL.val = []TLSOptionItem(nil)
+		}
+	case 193:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1973
+		{
+			yyVAL.val = yyDollar[2].val.([]TLSOptionItem)
+		}
+	case 194:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1979
+		{
+			yyVAL.val = []TLSOptionItem{yyDollar[1].val.(TLSOptionItem)}
+		}
+	case 195:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1983
+		{
+			yyVAL.val = append(yyDollar[1].val.([]TLSOptionItem), yyDollar[3].val.(TLSOptionItem))
+		}
+	case 196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1989
+		{
+			yyVAL.val = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_SSL, ItemData: ""}
+		}
+	case 197:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1993
+		{
+			yyVAL.val = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_X509, ItemData: ""}
+		}
+	case 198:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1997
+		{
+			yyVAL.val = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Cipher, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 199:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2001
+		{
+			yyVAL.val = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Issuer, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 200:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2005
+		{
+			yyVAL.val = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Subject, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 201:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2010
+		{
+			yyVAL.val = []AccountLimitItem(nil)
+		}
+	case 202:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2014
+		{
+			yyVAL.val = yyDollar[2].val.([]AccountLimitItem)
+		}
+	case 203:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2020
+		{
+			yyVAL.val = []AccountLimitItem{yyDollar[1].val.(AccountLimitItem)}
+		}
+	case 204:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2024
+		{
+			yyVAL.val = append(yyDollar[1].val.([]AccountLimitItem), yyDollar[2].val.(AccountLimitItem))
+		}
+	case 205:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2030
+		{
+			yyVAL.val = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Queries_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 206:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2034
+		{
+			yyVAL.val = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Updates_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 207:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2038
+		{
+			yyVAL.val = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Connections_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 208:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2042
+		{
+			yyVAL.val = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Connections, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 209:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2047
+		{
+			yyVAL.val = []PassLockItem(nil)
+		}
+	case 210:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2051
+		{
+			yyVAL.val = yyDollar[1].val.([]PassLockItem)
+		}
+	case 211:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2057
+		{
+			yyVAL.val = []PassLockItem{yyDollar[1].val.(PassLockItem)}
+		}
+	case 212:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2061
+		{
+			yyVAL.val = append(yyDollar[1].val.([]PassLockItem), yyDollar[2].val.(PassLockItem))
+		}
+	case 213:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2067
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassExpire, Value: nil}
+		}
+	case 214:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2071
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassExpireDefault, Value: nil}
+		}
+	case 215:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2075
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassExpireNever, Value: nil}
+		}
+	case 216:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2079
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassExpireInterval, Value: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 217:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2083
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassHistory, Value: nil}
+		}
+	case 218:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2087
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassHistory, Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 219:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2091
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassReuseInterval, Value: nil}
+		}
+	case 220:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2095
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassReuseInterval, Value: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 221:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2099
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassReqCurrentDefault, Value: nil}
+		}
+	case 222:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2103
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassReqCurrentOptional, Value: nil}
+		}
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2107
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassFailedLogins, Value: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 224:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2111
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassLockTime, Value: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 225:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2115
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_PassLockTime, Value: nil}
+		}
+	case 226:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2119
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_AccountLock, Value: nil}
+		}
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2123
+		{
+			yyVAL.val = PassLockItem{PassLockItemType: PassLockItemType_AccountUnlock, Value: nil}
+		}
+	case 228:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2128
+		{
+			yyVAL.val = ""
+		}
+	case 229:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2132
+		{
+			comment := string(yyDollar[2].bytes)
+			yyVAL.val = `{"comment": "` + escapeDoubleQuotes(comment) + `"}`
+		}
+	case 230:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2137
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 231:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2143
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.val = &GrantPrivilege{
+				Privileges:      allPriv,
+				ObjectType:      yyDollar[4].val.(GrantObjectType),
+				PrivilegeLevel:  yyDollar[5].val.(PrivilegeLevel),
+				To:              yyDollar[7].val.([]AccountName),
+				WithGrantOption: yyDollar[8].val.(bool),
+				As:              yyDollar[9].val.(*GrantUserAssumption),
+				Auth: AuthInformation{
+					AuthType:   AuthType_GRANT_PRIVILEGE,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 232:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2159
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.val = &GrantPrivilege{
+				Privileges:      allPriv,
+				ObjectType:      yyDollar[5].val.(GrantObjectType),
+				PrivilegeLevel:  yyDollar[6].val.(PrivilegeLevel),
+				To:              yyDollar[8].val.([]AccountName),
+				WithGrantOption: yyDollar[9].val.(bool),
+				As:              yyDollar[10].val.(*GrantUserAssumption),
+				Auth: AuthInformation{
+					AuthType:   AuthType_GRANT_PRIVILEGE,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 233:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2175
+		{
+			yyVAL.val = &GrantPrivilege{
+				Privileges:      yyDollar[2].val.([]Privilege),
+				ObjectType:      yyDollar[4].val.(GrantObjectType),
+				PrivilegeLevel:  yyDollar[5].val.(PrivilegeLevel),
+				To:              yyDollar[7].val.([]AccountName),
+				WithGrantOption: yyDollar[8].val.(bool),
+				As:              yyDollar[9].val.(*GrantUserAssumption),
+				Auth: AuthInformation{
+					AuthType:   AuthType_GRANT_PRIVILEGE,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 234:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2190
+		{
+			yyVAL.val = &GrantRole{
+				Roles:           yyDollar[2].val.([]AccountName),
+				To:              yyDollar[4].val.([]AccountName),
+				WithAdminOption: yyDollar[5].val.(bool),
+				Auth: AuthInformation{
+					AuthType:   AuthType_GRANT_ROLE,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 235:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2202
+		{
+			yyVAL.val = &GrantProxy{
+				On:              yyDollar[4].val.(AccountName),
+				To:              yyDollar[6].val.([]AccountName),
+				WithGrantOption: yyDollar[7].val.(bool),
+				Auth: AuthInformation{
+					AuthType:   AuthType_GRANT_PROXY,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 236:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2215
+		{
+			yyVAL.val = false
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2219
+		{
+			yyVAL.val = true
+		}
+	case 238:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2225
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.val = &RevokePrivilege{
+				IfExists:       yyDollar[2].val.(int) == 1,
+				Privileges:     allPriv,
+				ObjectType:     yyDollar[5].val.(GrantObjectType),
+				PrivilegeLevel: yyDollar[6].val.(PrivilegeLevel),
+				From:           yyDollar[8].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REVOKE_PRIVILEGE,
+					TargetType: AuthTargetType_Ignore,
+				},
+				IgnoreUnknownUser: yyDollar[9].val.(bool),
+			}
+		}
+	case 239:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2241
+		{
+			yyVAL.val = &RevokePrivilege{
+				IfExists:       yyDollar[2].val.(int) == 1,
+				Privileges:     yyDollar[3].val.([]Privilege),
+				ObjectType:     yyDollar[5].val.(GrantObjectType),
+				PrivilegeLevel: yyDollar[6].val.(PrivilegeLevel),
+				From:           yyDollar[8].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REVOKE_PRIVILEGE,
+					TargetType: AuthTargetType_Ignore,
+				},
+				IgnoreUnknownUser: yyDollar[9].val.(bool),
+			}
+		}
+	case 240:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2256
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.val = &RevokePrivilege{
+				IfExists:       yyDollar[2].val.(int) == 1,
+				Privileges:     allPriv,
+				ObjectType:     GrantObjectType_Any,
+				PrivilegeLevel: PrivilegeLevel{Database: "*", TableRoutine: "*"},
+				From:           yyDollar[8].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REVOKE_ALL,
+					TargetType: AuthTargetType_Ignore,
+				},
+				IgnoreUnknownUser: yyDollar[9].val.(bool),
+			}
+		}
+	case 241:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2272
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.val = &RevokePrivilege{
+				IfExists:       yyDollar[2].val.(int) == 1,
+				Privileges:     allPriv,
+				ObjectType:     GrantObjectType_Any,
+				PrivilegeLevel: PrivilegeLevel{Database: "*", TableRoutine: "*"},
+				From:           yyDollar[9].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REVOKE_ALL,
+					TargetType: AuthTargetType_Ignore,
+				},
+				IgnoreUnknownUser: yyDollar[10].val.(bool),
+			}
+		}
+	case 242:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2288
+		{
+			yyVAL.val = &RevokeRole{
+				IfExists: yyDollar[2].val.(int) == 1,
+				Roles:    yyDollar[3].val.([]AccountName),
+				From:     yyDollar[5].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REVOKE_ROLE,
+					TargetType: AuthTargetType_Ignore,
+				},
+				IgnoreUnknownUser: yyDollar[6].val.(bool),
+			}
+		}
+	case 243:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2301
+		{
+			yyVAL.val = &RevokeProxy{
+				IfExists: yyDollar[2].val.(int) == 1,
+				On:       yyDollar[5].val.(AccountName),
+				From:     yyDollar[7].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REVOKE_PROXY,
+					TargetType: AuthTargetType_Ignore,
+				},
+				IgnoreUnknownUser: yyDollar[8].val.(bool),
+			}
+		}
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2316
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Alter, Columns: yyDollar[2].val.([]string)}
+		}
+	case 245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2320
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_AlterRoutine, Columns: yyDollar[3].val.([]string)}
+		}
+	case 246:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2324
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Create, Columns: yyDollar[2].val.([]string)}
+		}
+	case 247:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2328
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_CreateRole, Columns: yyDollar[3].val.([]string)}
+		}
+	case 248:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2332
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_CreateRoutine, Columns: yyDollar[3].val.([]string)}
+		}
+	case 249:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2336
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_CreateTablespace, Columns: yyDollar[3].val.([]string)}
+		}
+	case 250:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2340
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_CreateTemporaryTables, Columns: yyDollar[4].val.([]string)}
+		}
+	case 251:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2344
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_CreateUser, Columns: yyDollar[3].val.([]string)}
+		}
+	case 252:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2348
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_CreateView, Columns: yyDollar[3].val.([]string)}
+		}
+	case 253:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2352
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Delete, Columns: yyDollar[2].val.([]string)}
+		}
+	case 254:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2356
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Drop, Columns: yyDollar[2].val.([]string)}
+		}
+	case 255:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2360
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_DropRole, Columns: yyDollar[3].val.([]string)}
+		}
+	case 256:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2364
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Event, Columns: yyDollar[2].val.([]string)}
+		}
+	case 257:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2368
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Execute, Columns: yyDollar[2].val.([]string)}
+		}
+	case 258:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2372
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_File, Columns: yyDollar[2].val.([]string)}
+		}
+	case 259:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2376
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_GrantOption, Columns: yyDollar[3].val.([]string)}
+		}
+	case 260:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2380
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Index, Columns: yyDollar[2].val.([]string)}
+		}
+	case 261:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2384
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Insert, Columns: yyDollar[2].val.([]string)}
+		}
+	case 262:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2388
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_LockTables, Columns: yyDollar[3].val.([]string)}
+		}
+	case 263:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2392
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Process, Columns: yyDollar[2].val.([]string)}
+		}
+	case 264:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2396
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_References, Columns: yyDollar[2].val.([]string)}
+		}
+	case 265:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2400
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Reload, Columns: yyDollar[2].val.([]string)}
+		}
+	case 266:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2404
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_ReplicationClient, Columns: yyDollar[3].val.([]string)}
+		}
+	case 267:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2408
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_ReplicationSlave, Columns: yyDollar[3].val.([]string)}
+		}
+	case 268:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2412
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Select, Columns: yyDollar[2].val.([]string)}
+		}
+	case 269:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2416
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_ShowDatabases, Columns: yyDollar[3].val.([]string)}
+		}
+	case 270:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2420
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_ShowView, Columns: yyDollar[3].val.([]string)}
+		}
+	case 271:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2424
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Shutdown, Columns: yyDollar[2].val.([]string)}
+		}
+	case 272:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2428
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Super, Columns: yyDollar[2].val.([]string)}
+		}
+	case 273:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2432
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Trigger, Columns: yyDollar[2].val.([]string)}
+		}
+	case 274:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2436
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Update, Columns: yyDollar[2].val.([]string)}
+		}
+	case 275:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2440
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Usage, Columns: yyDollar[2].val.([]string)}
+		}
+	case 276:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2444
+		{
+			yyVAL.val = Privilege{Type: PrivilegeType_Dynamic, DynamicName: strings.ToLower(string(yyDollar[1].bytes))}
+		}
+	case 277:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2450
+		{
+			yyVAL.val = []Privilege{yyDollar[1].val.(Privilege)}
+		}
+	case 278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2454
+		{
+			yyVAL.val = append(yyDollar[1].val.([]Privilege), yyDollar[3].val.(Privilege))
+		}
+	case 318:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2500
+		{
+			yyVAL.val = []string(nil)
+		}
+	case 319:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2504
+		{
+			yyVAL.val = yyDollar[2].val.([]string)
+		}
+	case 320:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2510
+		{
+			yyVAL.val = []string{yyDollar[1].val.(ColIdent).String()}
+		}
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2514
+		{
+			yyVAL.val = append(yyDollar[1].val.([]string), yyDollar[3].val.(ColIdent).String())
+		}
+	case 322:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2519
+		{
+			yyVAL.val = GrantObjectType_Any
+		}
+	case 323:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2523
+		{
+			yyVAL.val = GrantObjectType_Table
+		}
+	case 324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2527
+		{
+			yyVAL.val = GrantObjectType_Function
+		}
+	case 325:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2531
+		{
+			yyVAL.val = GrantObjectType_Procedure
+		}
+	case 326:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2537
+		{
+			yyVAL.val = PrivilegeLevel{Database: "", TableRoutine: "*"}
+		}
+	case 327:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2541
+		{
+			yyVAL.val = PrivilegeLevel{Database: "*", TableRoutine: "*"}
+		}
+	case 328:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2545
+		{
+			yyVAL.val = PrivilegeLevel{Database: "", TableRoutine: yyDollar[1].val.(ColIdent).String()}
+		}
+	case 329:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2549
+		{
+			yyVAL.val = PrivilegeLevel{Database: yyDollar[1].val.(ColIdent).String(), TableRoutine: "*"}
+		}
+	case 330:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2553
+		{
+			yyVAL.val = PrivilegeLevel{Database: yyDollar[1].val.(ColIdent).String(), TableRoutine: yyDollar[3].val.(ColIdent).String()}
+		}
+	case 331:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2558
+		{
+			yyVAL.val = (*GrantUserAssumption)(nil)
+		}
+	case 332:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2562
+		{
+			yyVAL.val = &GrantUserAssumption{Type: GrantUserAssumptionType_Default, User: yyDollar[2].val.(AccountName), Roles: nil}
+		}
+	case 333:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2566
+		{
+			yyVAL.val = &GrantUserAssumption{Type: GrantUserAssumptionType_Default, User: yyDollar[2].val.(AccountName), Roles: nil}
+		}
+	case 334:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2570
+		{
+			yyVAL.val = &GrantUserAssumption{Type: GrantUserAssumptionType_None, User: yyDollar[2].val.(AccountName), Roles: nil}
+		}
+	case 335:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2574
+		{
+			yyVAL.val = &GrantUserAssumption{Type: GrantUserAssumptionType_All, User: yyDollar[2].val.(AccountName), Roles: nil}
+		}
+	case 336:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2578
+		{
+			yyVAL.val = &GrantUserAssumption{Type: GrantUserAssumptionType_AllExcept, User: yyDollar[2].val.(AccountName), Roles: yyDollar[7].val.([]AccountName)}
+		}
+	case 337:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2582
+		{
+			yyVAL.val = &GrantUserAssumption{Type: GrantUserAssumptionType_Roles, User: yyDollar[2].val.(AccountName), Roles: yyDollar[5].val.([]AccountName)}
+		}
+	case 338:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2587
+		{
+			yyVAL.val = false
+		}
+	case 339:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2591
+		{
+			yyVAL.val = true
+		}
+	case 340:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2596
+		{
+			yyVAL.val = false
+		}
+	case 341:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2600
+		{
+			yyVAL.val = true
+		}
+	case 342:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2607
+		{
+			if yyDollar[1].val.(SelectStatement).GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyDollar[1].val.(SelectStatement).SetOrderBy(yyDollar[2].val.(OrderBy))
+			yyDollar[1].val.(SelectStatement).SetLimit(yyDollar[3].val.(*Limit))
+			yyDollar[1].val.(SelectStatement).SetLock(yyDollar[4].val.(string))
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 343:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2620
+		{
+			if yyDollar[1].val.(SelectStatement).GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyDollar[1].val.(SelectStatement).SetOrderBy(yyDollar[2].val.(OrderBy))
+			yyDollar[1].val.(SelectStatement).SetLimit(yyDollar[3].val.(*Limit))
+			yyDollar[1].val.(SelectStatement).SetLock(yyDollar[4].val.(string))
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 344:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2632
+		{
+			yyVAL.val = []ProcedureParam(nil)
+		}
+	case 345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2636
+		{
+			yyVAL.val = yyDollar[1].val.([]ProcedureParam)
+		}
+	case 346:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2642
+		{
+			yyVAL.val = []ProcedureParam{yyDollar[1].val.(ProcedureParam)}
+		}
+	case 347:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2646
+		{
+			yyVAL.val = append(yyVAL.val.([]ProcedureParam), yyDollar[3].val.(ProcedureParam))
+		}
+	case 348:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2652
+		{
+			yyVAL.val = ProcedureParam{Direction: ProcedureParamDirection_In, Name: yyDollar[1].val.(ColIdent).String(), Type: yyDollar[2].val.(ColumnType)}
+		}
+	case 349:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2656
+		{
+			yyVAL.val = ProcedureParam{Direction: ProcedureParamDirection_In, Name: yyDollar[2].val.(ColIdent).String(), Type: yyDollar[3].val.(ColumnType)}
+		}
+	case 350:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2660
+		{
+			yyVAL.val = ProcedureParam{Direction: ProcedureParamDirection_Inout, Name: yyDollar[2].val.(ColIdent).String(), Type: yyDollar[3].val.(ColumnType)}
+		}
+	case 351:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2664
+		{
+			yyVAL.val = ProcedureParam{Direction: ProcedureParamDirection_Out, Name: yyDollar[2].val.(ColIdent).String(), Type: yyDollar[3].val.(ColumnType)}
+		}
+	case 352:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2669
+		{
+			yyVAL.val = []ProcedureParam(nil)
+		}
+	case 353:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2673
+		{
+			yyVAL.val = yyDollar[1].val.([]ProcedureParam)
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2679
+		{
+			yyVAL.val = []ProcedureParam{yyDollar[1].val.(ProcedureParam)}
+		}
+	case 355:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2683
+		{
+			yyVAL.val = append(yyVAL.val.([]ProcedureParam), yyDollar[3].val.(ProcedureParam))
+		}
+	case 356:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2689
+		{
+			yyVAL.val = ProcedureParam{Direction: ProcedureParamDirection_In, Name: yyDollar[1].val.(ColIdent).String(), Type: yyDollar[2].val.(ColumnType)}
+		}
+	case 357:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2694
+		{
+			yyVAL.val = []Characteristic(nil)
+		}
+	case 358:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2698
+		{
+			yyVAL.val = yyDollar[1].val.([]Characteristic)
+		}
+	case 359:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2704
+		{
+			yyVAL.val = []Characteristic{yyDollar[1].val.(Characteristic)}
+		}
+	case 360:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2708
+		{
+			yyVAL.val = append(yyVAL.val.([]Characteristic), yyDollar[2].val.(Characteristic))
+		}
+	case 361:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2714
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_Comment, Comment: string(yyDollar[2].bytes)}
+		}
+	case 362:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2718
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_LanguageSql}
+		}
+	case 363:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2722
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_NotDeterministic}
+		}
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2726
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_Deterministic}
+		}
+	case 365:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2730
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_ContainsSql}
+		}
+	case 366:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2734
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_NoSql}
+		}
+	case 367:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2738
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_ReadsSqlData}
+		}
+	case 368:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2742
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_ModifiesSqlData}
+		}
+	case 369:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2746
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_SqlSecurityDefiner}
+		}
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2750
+		{
+			yyVAL.val = Characteristic{Type: CharacteristicValue_SqlSecurityInvoker}
+		}
+	case 371:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2756
+		{
+			yyVAL.val = &BeginEndBlock{Label: ""}
+		}
+	case 372:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2760
+		{
+			yyVAL.val = &BeginEndBlock{Label: string(yyDollar[1].bytes)}
+		}
+	case 373:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2764
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[5].bytes) {
+				yylex.Error("End-label " + string(yyDollar[5].bytes) + " without match")
+				return 1
+			}
+			yyVAL.val = &BeginEndBlock{Label: label}
+		}
+	case 374:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2773
+		{
+			yyVAL.val = &BeginEndBlock{Label: "", Statements: yyDollar[2].val.(Statements)}
+		}
+	case 375:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2777
+		{
+			yyVAL.val = &BeginEndBlock{Label: string(yyDollar[1].bytes), Statements: yyDollar[4].val.(Statements)}
+		}
+	case 376:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2781
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[7].bytes) {
+				yylex.Error("End-label " + string(yyDollar[7].bytes) + " without match")
+				return 1
+			}
+			yyVAL.val = &BeginEndBlock{Label: label, Statements: yyDollar[4].val.(Statements)}
+		}
+	case 377:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2792
+		{
+			yyVAL.val = &ViewSpec{Algorithm: "", Definer: yyDollar[1].val.(string), Security: yyDollar[2].val.(string)}
+		}
+	case 378:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2796
+		{
+			yyVAL.val = &ViewSpec{Algorithm: yyDollar[1].val.(string), Definer: yyDollar[2].val.(string), Security: yyDollar[3].val.(string)}
+		}
+	case 379:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2802
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 380:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2806
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 381:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2810
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 382:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2815
+		{
+			yyVAL.val = ""
+		}
+	case 383:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2819
+		{
+			yyVAL.val = yyDollar[3].val.(AccountName).String()
+		}
+	case 384:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2824
+		{
+			yyVAL.val = ""
+		}
+	case 385:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2828
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 386:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2832
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 387:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2838
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2842
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 389:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2846
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 390:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2852
+		{
+			anyHost := false
+			if yyDollar[3].val.(string) == "%" {
+				anyHost = true
+			}
+			yyVAL.val = AccountName{Name: yyDollar[1].val.(string), Host: yyDollar[3].val.(string), AnyHost: anyHost}
+		}
+	case 391:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2860
+		{
+			yyVAL.val = AccountName{Name: yyDollar[1].val.(string), Host: "", AnyHost: false}
+		}
+	case 392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2864
+		{
+			yyVAL.val = AccountName{Name: yyDollar[1].val.(string), Host: "", AnyHost: true}
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2870
+		{
+			yyVAL.val = []AccountName{yyDollar[1].val.(AccountName)}
+		}
+	case 394:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2874
+		{
+			yyVAL.val = append(yyDollar[1].val.([]AccountName), yyDollar[3].val.(AccountName))
+		}
+	case 395:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2880
+		{
+			if len(yyDollar[1].val.(string)) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.val = AccountName{Name: yyDollar[1].val.(string), Host: yyDollar[3].val.(string), AnyHost: false}
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2888
+		{
+			if len(yyDollar[1].val.(string)) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.val = AccountName{Name: yyDollar[1].val.(string), Host: "", AnyHost: false}
+		}
+	case 397:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2896
+		{
+			if len(yyDollar[1].val.(string)) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.val = AccountName{Name: yyDollar[1].val.(string), Host: "", AnyHost: true}
+		}
+	case 398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2906
+		{
+			yyVAL.val = []AccountName{yyDollar[1].val.(AccountName)}
+		}
+	case 399:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2910
+		{
+			yyVAL.val = append(yyDollar[1].val.([]AccountName), yyDollar[3].val.(AccountName))
+		}
+	case 400:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2916
+		{
+			yyVAL.val = AccountWithAuth{AccountName: yyDollar[1].val.(AccountName)}
+		}
+	case 401:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2920
+		{
+			yyVAL.val = AccountWithAuth{AccountName: yyDollar[1].val.(AccountName), Auth1: yyDollar[2].val.(*Authentication)}
+		}
+	case 402:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2924
+		{
+			yyVAL.val = AccountWithAuth{AccountName: yyDollar[1].val.(AccountName), Auth1: yyDollar[2].val.(*Authentication), AuthInitial: yyDollar[5].val.(*Authentication)}
+		}
+	case 403:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2928
+		{
+			yyVAL.val = AccountWithAuth{AccountName: yyDollar[1].val.(AccountName), Auth1: yyDollar[2].val.(*Authentication), Auth2: yyDollar[4].val.(*Authentication)}
+		}
+	case 404:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2932
+		{
+			yyVAL.val = AccountWithAuth{AccountName: yyDollar[1].val.(AccountName), Auth1: yyDollar[2].val.(*Authentication), Auth2: yyDollar[4].val.(*Authentication), Auth3: yyDollar[6].val.(*Authentication)}
+		}
+	case 405:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2937
+		{
+			yyVAL.val = (*Authentication)(nil)
+		}
+	case 406:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2941
+		{
+			yyVAL.val = yyDollar[1].val
+		}
+	case 407:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2947
+		{
+			yyVAL.val = &Authentication{RandomPassword: true}
+		}
+	case 408:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2951
+		{
+			yyVAL.val = &Authentication{Password: string(yyDollar[3].bytes)}
+		}
+	case 409:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2955
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes)}
+		}
+	case 410:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2959
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes)}
+		}
+	case 411:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2963
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes), RandomPassword: true}
+		}
+	case 412:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2967
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes), RandomPassword: true}
+		}
+	case 413:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2971
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes), Password: string(yyDollar[5].bytes)}
+		}
+	case 414:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2975
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes), Password: string(yyDollar[5].bytes)}
+		}
+	case 415:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2979
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes), Identity: string(yyDollar[5].bytes)}
+		}
+	case 416:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2983
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes), Identity: string(yyDollar[5].bytes)}
+		}
+	case 417:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2989
+		{
+			yyVAL.val = &Authentication{RandomPassword: true}
+		}
+	case 418:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2993
+		{
+			yyVAL.val = &Authentication{Password: string(yyDollar[3].bytes)}
+		}
+	case 419:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2997
+		{
+			yyVAL.val = &Authentication{Plugin: string(yyDollar[3].bytes), Identity: string(yyDollar[5].bytes)}
+		}
+	case 420:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3003
+		{
+			yyVAL.val = []AccountWithAuth{yyDollar[1].val.(AccountWithAuth)}
+		}
+	case 421:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3007
+		{
+			yyVAL.val = append(yyDollar[1].val.([]AccountWithAuth), yyDollar[3].val.(AccountWithAuth))
+		}
+	case 422:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3013
+		{
+			yyVAL.val = EventName{Name: yyDollar[1].val.(ColIdent)}
+		}
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3017
+		{
+			yyVAL.val = EventName{Qualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 424:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3023
+		{
+			yyVAL.val = &EventScheduleSpec{At: &EventScheduleTimeSpec{EventTimestamp: tryCastExpr(yyDollar[2].val), EventIntervals: yyDollar[3].val.([]IntervalExpr)}}
+		}
+	case 425:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3027
+		{
+			yyVAL.val = &EventScheduleSpec{EveryInterval: IntervalExpr{Expr: tryCastExpr(yyDollar[2].val), Unit: string(yyDollar[3].bytes)}, Starts: yyDollar[4].val.(*EventScheduleTimeSpec), Ends: yyDollar[5].val.(*EventScheduleTimeSpec)}
+		}
+	case 426:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3032
+		{
+			yyVAL.val = []IntervalExpr{}
+		}
+	case 427:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3036
+		{
+			yyVAL.val = append(yyDollar[1].val.([]IntervalExpr), IntervalExpr{Expr: tryCastExpr(yyDollar[4].val), Unit: string(yyDollar[5].bytes)})
+		}
+	case 428:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3041
+		{
+			yyVAL.val = (*EventScheduleTimeSpec)(nil)
+		}
+	case 429:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3045
+		{
+			yyVAL.val = &EventScheduleTimeSpec{EventTimestamp: tryCastExpr(yyDollar[2].val), EventIntervals: yyDollar[3].val.([]IntervalExpr)}
+		}
+	case 430:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3050
+		{
+			yyVAL.val = (*EventScheduleTimeSpec)(nil)
+		}
+	case 431:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3054
+		{
+			yyVAL.val = &EventScheduleTimeSpec{EventTimestamp: tryCastExpr(yyDollar[2].val), EventIntervals: yyDollar[3].val.([]IntervalExpr)}
+		}
+	case 432:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3059
+		{
+			yyVAL.val = EventOnCompletion_Undefined
+		}
+	case 433:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3063
+		{
+			yyVAL.val = EventOnCompletion_Preserve
+		}
+	case 434:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3067
+		{
+			yyVAL.val = EventOnCompletion_NotPreserve
+		}
+	case 435:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3072
+		{
+			yyVAL.val = EventStatus_Undefined
+		}
+	case 436:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3076
+		{
+			yyVAL.val = EventStatus_Enable
+		}
+	case 437:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3080
+		{
+			yyVAL.val = EventStatus_Disable
+		}
+	case 438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3084
+		{
+			yyVAL.val = EventStatus_DisableOnSlave
+		}
+	case 439:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3089
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 440:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3093
+		{
+			yyVAL.val = NewStrVal(yyDollar[2].bytes)
+		}
+	case 441:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3099
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 442:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3103
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 443:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3109
+		{
+			yyVAL.val = BeforeStr
+		}
+	case 444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3113
+		{
+			yyVAL.val = AfterStr
+		}
+	case 445:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3119
+		{
+			yyVAL.val = InsertStr
+		}
+	case 446:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3123
+		{
+			yyVAL.val = UpdateStr
+		}
+	case 447:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3127
+		{
+			yyVAL.val = DeleteStr
+		}
+	case 448:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3133
+		{
+			yyVAL.val = &TriggerOrder{PrecedesOrFollows: FollowsStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 449:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3137
+		{
+			yyVAL.val = &TriggerOrder{PrecedesOrFollows: PrecedesStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3143
+		{
+			yyVAL.val = tryCastStatement(yyDollar[1].val)
+		}
+	case 456:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3154
+		{
+			yyVAL.val = &BeginEndBlock{Statements: yyDollar[2].val.(Statements)}
+		}
+	case 457:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3158
+		{
+			yyVAL.val = &BeginEndBlock{}
+		}
+	case 458:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3164
+		{
+			yyVAL.val = &CaseStatement{Expr: tryCastExpr(yyDollar[2].val), Cases: yyDollar[3].val.([]CaseStatementCase)}
+		}
+	case 459:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3168
+		{
+			yyVAL.val = &CaseStatement{Expr: tryCastExpr(yyDollar[2].val), Cases: yyDollar[3].val.([]CaseStatementCase), Else: yyDollar[5].val.(Statements)}
+		}
+	case 460:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3172
+		{
+			yyVAL.val = &CaseStatement{Expr: nil, Cases: yyDollar[2].val.([]CaseStatementCase)}
+		}
+	case 461:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3176
+		{
+			yyVAL.val = &CaseStatement{Expr: nil, Cases: yyDollar[2].val.([]CaseStatementCase), Else: yyDollar[4].val.(Statements)}
+		}
+	case 462:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3182
+		{
+			yyVAL.val = []CaseStatementCase{yyDollar[1].val.(CaseStatementCase)}
+		}
+	case 463:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3186
+		{
+			yyVAL.val = append(yyVAL.val.([]CaseStatementCase), yyDollar[2].val.(CaseStatementCase))
+		}
+	case 464:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3192
+		{
+			yyVAL.val = CaseStatementCase{Case: tryCastExpr(yyDollar[2].val), Statements: yyDollar[4].val.(Statements)}
+		}
+	case 465:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3198
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: tryCastExpr(yyDollar[2].val), Statements: yyDollar[4].val.(Statements)}}
+			yyVAL.val = &IfStatement{Conditions: conds}
+		}
+	case 466:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3203
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: tryCastExpr(yyDollar[2].val), Statements: yyDollar[4].val.(Statements)}}
+			yyVAL.val = &IfStatement{Conditions: conds, Else: yyDollar[7].val.(Statements)}
+		}
+	case 467:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3208
+		{
+			conds := yyDollar[6].val.([]IfStatementCondition)
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: tryCastExpr(yyDollar[2].val), Statements: yyDollar[4].val.(Statements)}}, conds...)
+			yyVAL.val = &IfStatement{Conditions: conds}
+		}
+	case 468:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:3214
+		{
+			conds := yyDollar[6].val.([]IfStatementCondition)
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: tryCastExpr(yyDollar[2].val), Statements: yyDollar[4].val.(Statements)}}, conds...)
+			yyVAL.val = &IfStatement{Conditions: conds, Else: yyDollar[8].val.(Statements)}
+		}
+	case 469:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3222
+		{
+			yyVAL.val = []IfStatementCondition{yyDollar[1].val.(IfStatementCondition)}
+		}
+	case 470:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3226
+		{
+			yyVAL.val = append(yyVAL.val.([]IfStatementCondition), yyDollar[2].val.(IfStatementCondition))
+		}
+	case 471:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3232
+		{
+			yyVAL.val = IfStatementCondition{Expr: tryCastExpr(yyDollar[2].val), Statements: yyDollar[4].val.(Statements)}
+		}
+	case 472:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3238
+		{
+			yyVAL.val = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), SqlStateValue: string(yyDollar[5].bytes)}}
+		}
+	case 473:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3242
+		{
+			yyVAL.val = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), MysqlErrorCode: NewIntVal(yyDollar[5].bytes)}}
+		}
+	case 474:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3246
+		{
+			yyVAL.val = &Declare{Cursor: &DeclareCursor{Name: string(yyDollar[2].bytes), SelectStmt: yyDollar[5].val.(SelectStatement)}}
+		}
+	case 475:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3250
+		{
+			yyVAL.val = &Declare{Handler: &DeclareHandler{Action: yyDollar[2].val.(DeclareHandlerAction), ConditionValues: yyDollar[5].val.([]DeclareHandlerCondition), Statement: tryCastStatement(yyDollar[6].val)}}
+		}
+	case 476:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3254
+		{
+			ct := yyDollar[3].val.(ColumnType)
+			ct.Charset = yyDollar[4].val.(string)
+			ct.Collate = yyDollar[5].val.(string)
+			yyVAL.val = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].val.([]ColIdent), VarType: ct}}
+		}
+	case 477:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3261
+		{
+			ct := yyDollar[3].val.(ColumnType)
+			ct.Charset = yyDollar[4].val.(string)
+			ct.Collate = yyDollar[5].val.(string)
+			ct.Default = tryCastExpr(yyDollar[7].val)
+			yyVAL.val = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].val.([]ColIdent), VarType: ct}}
+		}
+	case 478:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3271
+		{
+			yyVAL.val = DeclareHandlerAction_Continue
+		}
+	case 479:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3275
+		{
+			yyVAL.val = DeclareHandlerAction_Exit
+		}
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3279
+		{
+			yyVAL.val = DeclareHandlerAction_Undo
+		}
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3285
+		{
+			yyVAL.val = []DeclareHandlerCondition{yyDollar[1].val.(DeclareHandlerCondition)}
+		}
+	case 482:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3289
+		{
+			yyVAL.val = append(yyVAL.val.([]DeclareHandlerCondition), yyDollar[3].val.(DeclareHandlerCondition))
+		}
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3295
+		{
+			yyVAL.val = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_MysqlErrorCode, MysqlErrorCode: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 484:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3299
+		{
+			yyVAL.val = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlState, String: string(yyDollar[1].bytes)}
+		}
+	case 485:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3303
+		{
+			yyVAL.val = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlWarning}
+		}
+	case 486:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3307
+		{
+			yyVAL.val = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_NotFound}
+		}
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3311
+		{
+			yyVAL.val = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlException}
+		}
+	case 488:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3315
+		{
+			yyVAL.val = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_ConditionName, String: string(yyDollar[1].bytes)}
+		}
+	case 489:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3321
+		{
+			yyVAL.val = &OpenCursor{Name: string(yyDollar[2].bytes)}
+		}
+	case 490:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3327
+		{
+			yyVAL.val = &CloseCursor{Name: string(yyDollar[2].bytes)}
+		}
+	case 491:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3333
+		{
+			yyVAL.val = &FetchCursor{Name: string(yyDollar[3].bytes), Variables: yyDollar[5].val.([]string)}
+		}
+	case 492:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3338
+		{
+		}
+	case 494:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3341
+		{
+		}
+	case 495:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3345
+		{
+			yyVAL.val = []string{string(yyDollar[1].bytes)}
+		}
+	case 496:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3349
+		{
+			yyVAL.val = append(yyVAL.val.([]string), string(yyDollar[3].bytes))
+		}
+	case 497:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3355
+		{
+			yyVAL.val = &Loop{Label: "", Statements: yyDollar[2].val.(Statements)}
+		}
+	case 498:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3359
+		{
+			yyVAL.val = &Loop{Label: string(yyDollar[1].bytes), Statements: yyDollar[4].val.(Statements)}
+		}
+	case 499:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3363
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[8].bytes) {
+				yylex.Error("End-label " + string(yyDollar[8].bytes) + " without match")
+				return 1
+			}
+			yyVAL.val = &Loop{Label: label, Statements: yyDollar[4].val.(Statements)}
+		}
+	case 500:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3374
+		{
+			yyVAL.val = &Repeat{Label: "", Statements: yyDollar[2].val.(Statements), Condition: tryCastExpr(yyDollar[5].val)}
+		}
+	case 501:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:3378
+		{
+			yyVAL.val = &Repeat{Label: string(yyDollar[1].bytes), Statements: yyDollar[4].val.(Statements), Condition: tryCastExpr(yyDollar[7].val)}
+		}
+	case 502:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3382
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[10].bytes) {
+				yylex.Error("End-label " + string(yyDollar[10].bytes) + " without match")
+				return 1
+			}
+			yyVAL.val = &Repeat{Label: label, Statements: yyDollar[4].val.(Statements), Condition: tryCastExpr(yyDollar[7].val)}
+		}
+	case 503:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3393
+		{
+			yyVAL.val = &While{Label: "", Condition: tryCastExpr(yyDollar[2].val), Statements: yyDollar[4].val.(Statements)}
+		}
+	case 504:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:3397
+		{
+			yyVAL.val = &While{Label: string(yyDollar[1].bytes), Condition: tryCastExpr(yyDollar[4].val), Statements: yyDollar[6].val.(Statements)}
+		}
+	case 505:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3401
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[10].bytes) {
+				yylex.Error("End-label " + string(yyDollar[10].bytes) + " without match")
+				return 1
+			}
+			yyVAL.val = &While{Label: label, Condition: tryCastExpr(yyDollar[4].val), Statements: yyDollar[6].val.(Statements)}
+		}
+	case 506:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3412
+		{
+			yyVAL.val = &Leave{Label: string(yyDollar[2].bytes)}
+		}
+	case 507:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3418
+		{
+			yyVAL.val = &Iterate{Label: string(yyDollar[2].bytes)}
+		}
+	case 508:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3424
+		{
+			yyVAL.val = &Return{Expr: tryCastExpr(yyDollar[2].val)}
+		}
+	case 509:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3430
+		{
+			yyVAL.val = &Signal{SqlStateValue: string(yyDollar[2].bytes)}
+		}
+	case 510:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3434
+		{
+			yyVAL.val = &Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].val.([]SignalInfo)}
+		}
+	case 511:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3438
+		{
+			yyVAL.val = &Signal{ConditionName: string(yyDollar[2].bytes)}
+		}
+	case 512:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3442
+		{
+			yyVAL.val = &Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].val.([]SignalInfo)}
+		}
+	case 513:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3448
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 514:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3452
+		{
+			yyVAL.bytes = yyDollar[3].bytes
+		}
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3458
+		{
+			yyVAL.val = []SignalInfo{yyDollar[1].val.(SignalInfo)}
+		}
+	case 516:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3462
+		{
+			yyVAL.val = append(yyVAL.val.([]SignalInfo), yyDollar[3].val.(SignalInfo))
+		}
+	case 517:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3468
+		{
+			yyVAL.val = SignalInfo{ConditionItemName: yyDollar[1].val.(SignalConditionItemName), Value: tryCastExpr(yyDollar[3].val).(*SQLVal)}
+		}
+	case 518:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3472
+		{
+			yyVAL.val = SignalInfo{ConditionItemName: yyDollar[1].val.(SignalConditionItemName), Value: &ColName{Name: yyDollar[3].val.(ColIdent)}}
+		}
+	case 519:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3478
+		{
+			yyVAL.val = SignalConditionItemName_ClassOrigin
+		}
+	case 520:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3482
+		{
+			yyVAL.val = SignalConditionItemName_SubclassOrigin
+		}
+	case 521:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3486
+		{
+			yyVAL.val = SignalConditionItemName_MessageText
+		}
+	case 522:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3490
+		{
+			yyVAL.val = SignalConditionItemName_MysqlErrno
+		}
+	case 523:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3494
+		{
+			yyVAL.val = SignalConditionItemName_ConstraintCatalog
+		}
+	case 524:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3498
+		{
+			yyVAL.val = SignalConditionItemName_ConstraintSchema
+		}
+	case 525:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3502
+		{
+			yyVAL.val = SignalConditionItemName_ConstraintName
+		}
+	case 526:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3506
+		{
+			yyVAL.val = SignalConditionItemName_CatalogName
+		}
+	case 527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3510
+		{
+			yyVAL.val = SignalConditionItemName_SchemaName
+		}
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3514
+		{
+			yyVAL.val = SignalConditionItemName_TableName
+		}
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3518
+		{
+			yyVAL.val = SignalConditionItemName_ColumnName
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3522
+		{
+			yyVAL.val = SignalConditionItemName_CursorName
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3528
+		{
+			yyVAL.val = &Resignal{}
+		}
+	case 532:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3532
+		{
+			yyVAL.val = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes)}}
+		}
+	case 533:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3536
+		{
+			yyVAL.val = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].val.([]SignalInfo)}}
+		}
+	case 534:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3540
+		{
+			yyVAL.val = &Resignal{Signal{Info: yyDollar[3].val.([]SignalInfo)}}
+		}
+	case 535:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3544
+		{
+			yyVAL.val = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes)}}
+		}
+	case 536:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3548
+		{
+			yyVAL.val = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].val.([]SignalInfo)}}
+		}
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3554
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), DiagnosticsStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = yyDollar[3].val.(*GetDiagnostics)
+		}
+	case 538:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3562
+		{
+			if !strings.EqualFold(string(yyDollar[3].bytes), DiagnosticsStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = yyDollar[4].val.(*GetDiagnostics)
+		}
+	case 539:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3570
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), StackedStr) || !strings.EqualFold(string(yyDollar[3].bytes), DiagnosticsStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			g := yyDollar[4].val.(*GetDiagnostics)
+			g.Stacked = true
+			yyVAL.val = g
+		}
+	case 540:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3582
+		{
+			items := yyDollar[1].val.([]DiagnosticsItem)
+			for _, item := range items {
+				if item.Name != DiagnosticsItemName_Number && item.Name != DiagnosticsItemName_RowCount {
+					yylex.Error("syntax error")
+					return 1
+				}
+			}
+			yyVAL.val = &GetDiagnostics{Items: items}
+		}
+	case 541:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3593
+		{
+			items := yyDollar[3].val.([]DiagnosticsItem)
+			for _, item := range items {
+				if item.Name == DiagnosticsItemName_Number || item.Name == DiagnosticsItemName_RowCount {
+					yylex.Error("syntax error")
+					return 1
+				}
+			}
+			yyVAL.val = &GetDiagnostics{ConditionNumber: tryCastExpr(yyDollar[2].val), Items: items}
+		}
+	case 542:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3606
+		{
+			yyVAL.val = []DiagnosticsItem{yyDollar[1].val.(DiagnosticsItem)}
+		}
+	case 543:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3610
+		{
+			yyVAL.val = append(yyVAL.val.([]DiagnosticsItem), yyDollar[3].val.(DiagnosticsItem))
+		}
+	case 544:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3616
+		{
+			name := DiagnosticsItemName(strings.ToLower(string(yyDollar[3].bytes)))
+			switch name {
+			case DiagnosticsItemName_Number, DiagnosticsItemName_RowCount, DiagnosticsItemName_ReturnedSqlState:
+			default:
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = DiagnosticsItem{Target: string(yyDollar[1].bytes), Name: name}
+		}
+	case 545:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3627
+		{
+			yyVAL.val = DiagnosticsItem{Target: string(yyDollar[1].bytes), Name: DiagnosticsItemName(yyDollar[3].val.(SignalConditionItemName))}
+		}
+	case 546:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3633
+		{
+			procName := yyDollar[2].val.(ProcedureName)
+			exprs := yyDollar[3].val.(Exprs)
+			yyVAL.val = &Call{
+				ProcName: procName,
+				Params:   exprs,
+				AsOf:     tryCastExpr(yyDollar[4].val),
+				Auth: AuthInformation{
+					AuthType:    AuthType_CALL,
+					TargetType:  AuthTargetType_Ignore,
+					TargetNames: []string{procName.Qualifier.String(), procName.Name.String(), fmt.Sprintf("%d", len(exprs))},
+				},
+			}
+		}
+	case 547:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3649
+		{
+			yyVAL.val = Exprs(nil)
+		}
+	case 548:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3653
+		{
+			yyVAL.val = Exprs(nil)
+		}
+	case 549:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3657
+		{
+			yyVAL.val = yyDollar[2].val.(Exprs)
+		}
+	case 550:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3663
+		{
+			yyVAL.val = Statements{tryCastStatement(yyDollar[1].val)}
+		}
+	case 551:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3667
+		{
+			yyVAL.val = append(yyVAL.val.(Statements), tryCastStatement(yyDollar[3].val))
+		}
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3673
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 609:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3739
+		{
+			var temp bool
+			authType := AuthType_CREATE
+			if yyDollar[2].val.(int) != 0 {
+				temp = true
+				authType = AuthType_CREATE_TEMP
+			}
+
+			var ne bool
+			if yyDollar[4].val.(int) != 0 {
+				ne = true
+			}
+
+			tableName := yyDollar[5].val.(TableName)
+			yyVAL.val = &DDL{
+				Action:      CreateStr,
+				Table:       tableName,
+				IfNotExists: ne,
+				Temporary:   temp,
+				Auth: AuthInformation{
+					AuthType:    authType,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{tableName.DbQualifier.String()},
+				},
+			}
+		}
+	case 610:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3766
+		{
+			authType := AuthType_CREATE
+			var temp bool
+			if yyDollar[2].val.(int) != 0 {
+				temp = true
+				authType = AuthType_CREATE_TEMP
+			}
+
+			var ne bool
+			if yyDollar[4].val.(int) != 0 {
+				ne = true
+			}
+
+			yyVAL.val = &DDL{
+				Action: CreateStr,
+				Table: TableName{
+					Name: NewTableIdent(string(yyDollar[5].bytes)),
+				},
+				IfNotExists: ne,
+				Temporary:   temp,
+				Auth: AuthInformation{
+					AuthType:    authType,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{""},
+				},
+			}
+		}
+	case 611:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3796
+		{
+			yyVAL.val = yyDollar[2].val.(*TableSpec)
+			for _, opt := range yyDollar[4].val.([]*TableOption) {
+				yyVAL.val.(*TableSpec).AddTableOption(opt)
+			}
+			yyVAL.val.(*TableSpec).PartitionOpt = yyDollar[5].val.(*PartitionOption)
+		}
+	case 612:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3806
+		{
+			yyVAL.val = &TableSpec{}
+			yyVAL.val.(*TableSpec).AddColumn(yyDollar[1].val.(*ColumnDefinition))
+		}
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3811
+		{
+			yyVAL.val = &TableSpec{}
+			yyVAL.val.(*TableSpec).AddConstraint(yyDollar[1].val.(*ConstraintDefinition))
+		}
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3816
+		{
+			yyVAL.val = &TableSpec{}
+			yyVAL.val.(*TableSpec).AddIndex(yyDollar[1].val.(*IndexDefinition))
+		}
+	case 615:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3821
+		{
+			yyVAL.val = &TableSpec{}
+			yyVAL.val.(*TableSpec).AddConstraint(yyDollar[1].val.(*ConstraintDefinition))
+		}
+	case 616:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3826
+		{
+			yyVAL.val.(*TableSpec).AddColumn(yyDollar[3].val.(*ColumnDefinition))
+		}
+	case 617:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3830
+		{
+			yyVAL.val.(*TableSpec).AddIndex(yyDollar[3].val.(*IndexDefinition))
+		}
+	case 618:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3834
+		{
+			yyVAL.val.(*TableSpec).AddConstraint(yyDollar[3].val.(*ConstraintDefinition))
+		}
+	case 619:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3838
+		{
+			yyVAL.val.(*TableSpec).AddConstraint(yyDollar[3].val.(*ConstraintDefinition))
+		}
+	case 620:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3844
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: *ctp}
+		}
+	case 621:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3855
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: *ctp}
+		}
+	case 622:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3868
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: yyDollar[1].val.(ColIdent), Type: *ctp}
+		}
+	case 623:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3879
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: *ctp}
+		}
+	case 624:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3890
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: *ctp}
+		}
+	case 625:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3901
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: *ctp}
+		}
+	case 626:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3912
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: *ctp}
+		}
+	case 627:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3923
+		{
+			ct1 := yyDollar[2].val.(ColumnType)
+			ct2 := yyDollar[3].val.(ColumnType)
+			ctp := &ct1
+			if err := ctp.merge(ct2); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: *ctp}
+		}
+	case 628:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3935
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 629:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3939
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 630:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3943
+		{
+			yyVAL.val = BoolVal(true)
+		}
+	case 631:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3948
+		{
+			yyVAL.val = ColumnType{}
+		}
+	case 632:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3952
+		{
+			yyVAL.val = yyDollar[1].val.(ColumnType)
+		}
+	case 633:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3956
+		{
+			opt := ColumnType{Null: BoolVal(true), NotNull: BoolVal(false), sawnull: true}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 634:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3967
+		{
+			opt := ColumnType{Null: BoolVal(false), NotNull: BoolVal(true), sawnull: true}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 635:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3978
+		{
+			opt := ColumnType{Charset: yyDollar[2].val.(string)}
+			ct := yyDollar[1].val.(ColumnType)
+			if err := ct.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = ct
+		}
+	case 636:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3988
+		{
+			opt := ColumnType{Collate: yyDollar[2].val.(string)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 637:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3999
+		{
+			opt := ColumnType{BinaryCollate: true}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 638:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4010
+		{
+			opt := ColumnType{Default: tryCastExpr(yyDollar[2].val)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 639:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4021
+		{
+			opt := ColumnType{OnUpdate: tryCastExpr(yyDollar[2].val)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 640:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4032
+		{
+			opt := ColumnType{Autoincrement: yyDollar[2].val.(BoolVal), sawai: true}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 641:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4043
+		{
+			opt := ColumnType{KeyOpt: yyDollar[2].val.(ColumnKeyOption)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 642:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4054
+		{
+			opt := ColumnType{Comment: yyDollar[2].val.(*SQLVal)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 643:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4065
+		{
+			opt := ColumnType{GeneratedExpr: &ParenExpr{tryCastExpr(yyDollar[4].val)}, Stored: yyDollar[6].val.(BoolVal)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 644:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4076
+		{
+			opt := ColumnType{GeneratedExpr: &ParenExpr{tryCastExpr(yyDollar[6].val)}, Stored: yyDollar[8].val.(BoolVal)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 645:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4087
+		{
+			opt := ColumnType{SRID: NewIntVal(yyDollar[3].bytes)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 646:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4099
+		{
+			opt := ColumnType{ForeignKeyDef: &ForeignKeyDefinition{ReferencedTable: yyDollar[3].val.(TableName), ReferencedColumns: yyDollar[5].val.(Columns)}}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 647:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4110
+		{
+			opt := ColumnType{Constraint: yyDollar[2].val.(*ConstraintDefinition)}
+			ct := yyDollar[1].val.(ColumnType)
+			ctp := &ct
+			if err := ctp.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = *ctp
+		}
+	case 648:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4123
+		{
+			ct := yyDollar[1].val.(ColumnType)
+			ct.Unsigned = yyDollar[2].val.(BoolVal)
+			ct.Zerofill = yyDollar[3].val.(BoolVal)
+			yyVAL.val = ct
+		}
+	case 649:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4130
+		{
+			yyVAL.val = ColumnType{Type: "bigint", Unsigned: true, NotNull: true, Autoincrement: true, KeyOpt: colKeyUnique}
+		}
+	case 654:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4140
+		{
+			ct := yyDollar[1].val.(ColumnType)
+			ct.Length = yyDollar[2].val.(*SQLVal)
+			yyVAL.val = ct
+		}
+	case 655:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4146
+		{
+			yyVAL.val = yyDollar[1].val.(ColumnType)
+		}
+	case 656:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4152
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 657:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4156
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 658:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4160
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 659:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4164
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 660:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4168
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 661:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4172
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 662:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4176
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 663:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4180
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 664:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4184
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 665:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4188
+		{
+			yyVAL.val = ColumnType{Type: "tinyint"}
+		}
+	case 666:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4192
+		{
+			yyVAL.val = ColumnType{Type: "smallint"}
+		}
+	case 667:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4196
+		{
+			yyVAL.val = ColumnType{Type: "mediumint"}
+		}
+	case 668:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4200
+		{
+			yyVAL.val = ColumnType{Type: "mediumint"}
+		}
+	case 669:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4204
+		{
+			yyVAL.val = ColumnType{Type: "int"}
+		}
+	case 670:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4208
+		{
+			yyVAL.val = ColumnType{Type: "bigint"}
+		}
+	case 671:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4214
+		{
+			ct := ColumnType{Type: string(yyDollar[1].bytes)}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 672:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4221
+		{
+			ct := ColumnType{Type: string(yyDollar[1].bytes)}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 673:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4228
+		{
+			ct := ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+			ct.Length = yyDollar[3].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[3].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 674:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4235
+		{
+			ct := ColumnType{Type: "double"}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 675:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4242
+		{
+			ct := ColumnType{Type: string(yyDollar[1].bytes)}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 676:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4249
+		{
+			ct := ColumnType{Type: "float"}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 677:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4256
+		{
+			ct := ColumnType{Type: string(yyDollar[1].bytes)}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 678:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4263
+		{
+			ct := ColumnType{Type: "decimal"}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 679:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4270
+		{
+			ct := ColumnType{Type: string(yyDollar[1].bytes)}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 680:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4277
+		{
+			ct := ColumnType{Type: string(yyDollar[1].bytes)}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 681:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4286
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 682:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4290
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 683:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4294
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 684:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4298
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 685:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4302
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 686:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4308
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 687:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4312
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].val.(*SQLVal)}
+		}
+	case 688:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4316
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 689:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4320
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].val.(*SQLVal)}
+		}
+	case 690:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4324
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].val.(*SQLVal)}
+		}
+	case 691:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4328
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 692:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4332
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].val.(*SQLVal)}
+		}
+	case 693:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4336
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].val.(*SQLVal)}
+		}
+	case 694:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4340
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 695:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4344
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].val.(*SQLVal)}
+		}
+	case 696:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4348
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Length: yyDollar[4].val.(*SQLVal)}
+		}
+	case 697:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4352
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 698:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4356
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 699:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4360
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 700:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4364
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 701:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4368
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 702:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4372
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 703:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4376
+		{
+			yyVAL.val = ColumnType{Type: "mediumtext"}
+		}
+	case 704:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4380
+		{
+			yyVAL.val = ColumnType{Type: "mediumtext"}
+		}
+	case 705:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4384
+		{
+			yyVAL.val = ColumnType{Type: "mediumblob"}
+		}
+	case 706:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4388
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 707:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4392
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 708:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4396
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 709:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4400
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 710:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4404
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 711:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4408
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].val.([]string)}
+		}
+	case 712:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4413
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].val.([]string)}
+		}
+	case 713:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4419
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), UuidStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = ColumnType{Type: UuidStr}
+		}
+	case 714:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4429
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 715:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4433
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 716:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4437
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 717:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4441
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 718:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4445
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 719:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4449
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 720:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4453
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 721:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4457
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 722:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4461
+		{
+			yyVAL.val = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 723:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4467
+		{
+			yyVAL.val = make([]string, 0, 4)
+			yyVAL.val = append(yyVAL.val.([]string), string(yyDollar[1].bytes))
+		}
+	case 724:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4472
+		{
+			yyVAL.val = append(yyDollar[1].val.([]string), string(yyDollar[3].bytes))
+		}
+	case 725:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4477
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 726:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4481
+		{
+			yyVAL.val = NewIntVal(yyDollar[2].bytes)
+		}
+	case 727:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4486
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 728:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4490
+		{
+			yyVAL.val = NewIntVal(yyDollar[2].bytes)
+		}
+	case 729:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4494
+		{
+			yyVAL.val = NewValArg(yyDollar[2].bytes)
+		}
+	case 730:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4499
+		{
+			yyVAL.val = LengthScaleOption{}
+		}
+	case 731:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4503
+		{
+			yyVAL.val = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 732:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4511
+		{
+			yyVAL.val = LengthScaleOption{}
+		}
+	case 733:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4515
+		{
+			yyVAL.val = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 734:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4521
+		{
+			yyVAL.val = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 735:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4529
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 736:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4533
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 737:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4537
+		{
+			yyVAL.val = BoolVal(true)
+		}
+	case 738:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4542
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 739:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4546
+		{
+			yyVAL.val = BoolVal(true)
+		}
+	case 740:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4552
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 741:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4556
+		{
+			if num, ok := tryCastExpr(yyDollar[3].val).(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.val = num
+				} else {
+					yyVAL.val = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.val = &UnaryExpr{Operator: UMinusStr, Expr: tryCastExpr(yyDollar[3].val)}
+			}
+		}
+	case 742:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4570
+		{
+			yyVAL.val = &UnaryExpr{Operator: yyDollar[2].val.(string), Expr: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 743:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4574
+		{
+			yyVAL.val = yyDollar[2].val.(BoolVal)
+		}
+	case 744:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4578
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 745:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4582
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 746:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4586
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 747:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4590
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 748:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4594
+		{
+			yyVAL.val = &ParenExpr{tryCastExpr(yyDollar[3].val)}
+		}
+	case 749:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4600
+		{
+			yyVAL.val = tryCastExpr(yyDollar[3].val)
+		}
+	case 750:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4606
+		{
+			yyVAL.val = BoolVal(true)
+		}
+	case 751:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4611
+		{
+			yyVAL.val = ""
+		}
+	case 752:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4615
+		{
+			yyVAL.val = yyDollar[1].val.(string)
+		}
+	case 753:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4621
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 754:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4625
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 755:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4629
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 756:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4633
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 757:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4637
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 758:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4641
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 759:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4647
+		{
+			yyVAL.val = ""
+		}
+	case 760:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4651
+		{
+			yyVAL.val = yyDollar[1].val.(string)
+		}
+	case 761:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4657
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 762:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4661
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 763:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4665
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 764:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4670
+		{
+			yyVAL.val = false
+		}
+	case 765:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4674
+		{
+			yyVAL.val = true
+		}
+	case 766:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4679
+		{
+			yyVAL.val = []*CharsetAndCollate(nil)
+		}
+	case 767:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4683
+		{
+			yyVAL.val = yyDollar[1].val.([]*CharsetAndCollate)
+		}
+	case 768:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4689
+		{
+			yyVAL.val = []*CharsetAndCollate{yyDollar[1].val.(*CharsetAndCollate)}
+		}
+	case 769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4693
+		{
+			yyVAL.val = []*CharsetAndCollate{yyDollar[1].val.(*CharsetAndCollate)}
+		}
+	case 770:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4697
+		{
+			yyVAL.val = []*CharsetAndCollate{yyDollar[1].val.(*CharsetAndCollate)}
+		}
+	case 771:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4701
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*CharsetAndCollate), yyDollar[2].val.(*CharsetAndCollate))
+		}
+	case 772:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4705
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*CharsetAndCollate), yyDollar[2].val.(*CharsetAndCollate))
+		}
+	case 773:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4709
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*CharsetAndCollate), yyDollar[2].val.(*CharsetAndCollate))
+		}
+	case 774:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4715
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Value: string(yyDollar[5].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 775:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4719
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Value: string(yyDollar[5].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 776:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4723
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Value: string(yyDollar[5].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 777:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4727
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 778:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4731
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 779:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4735
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 780:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4741
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 781:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4745
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 782:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4749
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 783:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4755
+		{
+			yyVAL.val = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].val.(bool)}
+		}
+	case 784:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4761
+		{
+			yyVAL.val = colKeyPrimary
+		}
+	case 785:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4765
+		{
+			yyVAL.val = colKey
+		}
+	case 786:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4769
+		{
+			yyVAL.val = colKeyUniqueKey
+		}
+	case 787:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4773
+		{
+			yyVAL.val = colKeyUnique
+		}
+	case 788:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4777
+		{
+			yyVAL.val = colKeyFulltextKey
+		}
+	case 789:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4783
+		{
+			yyVAL.val = NewStrVal(yyDollar[2].bytes)
+		}
+	case 790:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4789
+		{
+			yyVAL.val = &PurgeBinaryLogs{To: string(yyDollar[5].bytes)}
+		}
+	case 791:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4793
+		{
+			yyVAL.val = &PurgeBinaryLogs{Before: tryCastExpr(yyDollar[5].val)}
+		}
+	case 792:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4799
+		{
+			yyVAL.val = &Flush{
+				Type:   yyDollar[2].val.(string),
+				Option: yyDollar[3].val.(*FlushOption),
+				Auth: AuthInformation{
+					AuthType:    AuthType_RELOAD,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{"mysql"},
+				},
+			}
+		}
+	case 793:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4813
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 794:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4817
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 795:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4821
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 796:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4825
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 797:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4829
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4833
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 799:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4837
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 800:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4841
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 801:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4845
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Channel: yyDollar[3].val.(string)}
+		}
+	case 802:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4849
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 803:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4853
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 804:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4857
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 805:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4861
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes), ReadLock: yyDollar[2].val.(bool)}
+		}
+	case 806:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4865
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes), ReadLock: yyDollar[2].val.(bool)}
+		}
+	case 807:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4869
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[2].val.(TableNames), ReadLock: yyDollar[3].val.(bool)}
+		}
+	case 808:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4873
+		{
+			yyVAL.val = &FlushOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[2].val.(TableNames), ReadLock: yyDollar[3].val.(bool)}
+		}
+	case 809:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4878
+		{
+			yyVAL.val = false
+		}
+	case 810:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4880
+		{
+			yyVAL.val = true
+		}
+	case 811:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4883
+		{
+			yyVAL.val = ""
+		}
+	case 812:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4885
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 813:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4889
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 814:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4891
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 815:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4894
+		{
+			yyVAL.val = ""
+		}
+	case 816:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4896
+		{
+			yyVAL.val = yyDollar[1].val.(string)
+		}
+	case 817:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4900
+		{
+			yyVAL.val = &ChangeReplicationSource{
+				Options: yyDollar[5].val.([]*ReplicationOption),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 818:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4910
+		{
+			yyVAL.val = &ChangeReplicationFilter{
+				Options: yyDollar[4].val.([]*ReplicationOption),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 819:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4920
+		{
+			yyVAL.val = &StartReplica{
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 820:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4929
+		{
+			yyVAL.val = &StopReplica{
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 821:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4938
+		{
+			yyVAL.val = &ResetReplica{
+				All: yyDollar[3].val.(bool),
+				Auth: AuthInformation{
+					AuthType:   AuthType_RELOAD,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 822:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4949
+		{
+			yyVAL.val = false
+		}
+	case 823:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4951
+		{
+			yyVAL.val = true
+		}
+	case 824:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4954
+		{
+			yyVAL.bytes = nil
+		}
+	case 825:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4958
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 826:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4962
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 827:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4968
+		{
+			yyVAL.val = []*ReplicationOption{yyDollar[1].val.(*ReplicationOption)}
+		}
+	case 828:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4972
+		{
+			yyVAL.val = append(yyVAL.val.([]*ReplicationOption), yyDollar[3].val.(*ReplicationOption))
+		}
+	case 829:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4978
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 830:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4982
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 831:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4986
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 832:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4990
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: mustAtoi(yylex, string(yyDollar[3].bytes))}
+		}
+	case 833:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4994
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: mustAtoi(yylex, string(yyDollar[3].bytes))}
+		}
+	case 834:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4998
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: mustAtoi(yylex, string(yyDollar[3].bytes))}
+		}
+	case 835:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5002
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: mustAtoi(yylex, string(yyDollar[3].bytes))}
+		}
+	case 836:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5006
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: mustAtoi(yylex, string(yyDollar[3].bytes))}
+		}
+	case 837:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5012
+		{
+			yyVAL.val = []*ReplicationOption{yyDollar[1].val.(*ReplicationOption)}
+		}
+	case 838:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5016
+		{
+			yyVAL.val = append(yyVAL.val.([]*ReplicationOption), yyDollar[3].val.(*ReplicationOption))
+		}
+	case 839:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5022
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: yyDollar[4].val.(TableNames)}
+		}
+	case 840:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5026
+		{
+			yyVAL.val = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: yyDollar[4].val.(TableNames)}
+		}
+	case 841:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5032
+		{
+			yyVAL.val = &IndexDefinition{Info: yyDollar[1].val.(*IndexInfo), Fields: yyDollar[3].val.([]*IndexField), Options: yyDollar[5].val.([]*IndexOption)}
+		}
+	case 842:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5036
+		{
+			yyVAL.val = &IndexDefinition{Info: yyDollar[1].val.(*IndexInfo), Fields: yyDollar[3].val.([]*IndexField)}
+		}
+	case 843:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5041
+		{
+			yyVAL.val = []*IndexOption(nil)
+		}
+	case 844:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5045
+		{
+			yyVAL.val = yyDollar[1].val.([]*IndexOption)
+		}
+	case 845:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5051
+		{
+			yyVAL.val = []*IndexOption{yyDollar[1].val.(*IndexOption)}
+		}
+	case 846:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5055
+		{
+			yyVAL.val = append(yyVAL.val.([]*IndexOption), yyDollar[2].val.(*IndexOption))
+		}
+	case 847:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5061
+		{
+			yyVAL.val = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 848:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5065
+		{
+			// should not be string
+			yyVAL.val = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 849:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5070
+		{
+			yyVAL.val = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 850:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5074
+		{
+			yyVAL.val = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 851:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5078
+		{
+			yyVAL.val = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 852:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5082
+		{
+			yyVAL.val = &IndexOption{Name: string(yyDollar[1].bytes), Value: nil}
+		}
+	case 853:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5086
+		{
+			yyVAL.val = &IndexOption{Name: string(yyDollar[1].bytes), Value: nil}
+		}
+	case 854:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5092
+		{
+			yyVAL.val = ""
+		}
+	case 855:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5096
+		{
+			yyVAL.val = string(yyDollar[1].val.(string))
+		}
+	case 856:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5102
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5106
+		{
+			yyVAL.val = ":="
+		}
+	case 858:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5114
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 859:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5118
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 860:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5122
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].val.(string)), Name: NewColIdent(yyDollar[3].val.(string)), Spatial: true, Unique: false}
+		}
+	case 861:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5126
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].val.(string)), Name: NewColIdent(yyDollar[3].val.(string)), Fulltext: true}
+		}
+	case 862:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5130
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].val.(string)), Name: NewColIdent(yyDollar[3].val.(string)), Vector: true}
+		}
+	case 863:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5134
+		{
+			var name string
+			name = yyDollar[2].val.(string)
+			if name == "" {
+				name = yyDollar[5].val.(string)
+			}
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].val.(string)), Name: NewColIdent(name), Unique: true}
+		}
+	case 864:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5143
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].val.(string)), Name: NewColIdent(yyDollar[3].val.(string)), Unique: true}
+		}
+	case 865:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5147
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].val.(string)), Unique: true}
+		}
+	case 866:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5151
+		{
+			yyVAL.val = &IndexInfo{Type: string(yyDollar[1].val.(string)), Name: NewColIdent(yyDollar[2].val.(string)), Unique: false}
+		}
+	case 867:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5157
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 868:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5161
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 869:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5165
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 870:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5171
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 871:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5175
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 872:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5180
+		{
+			yyVAL.val = ""
+		}
+	case 873:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5184
+		{
+			yyVAL.val = yyDollar[1].val.(string)
+		}
+	case 874:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5189
+		{
+			yyVAL.val = ""
+		}
+	case 875:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5193
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 876:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5197
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 877:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5203
+		{
+			yyVAL.val = []*IndexField{yyDollar[1].val.(*IndexField)}
+		}
+	case 878:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5207
+		{
+			yyVAL.val = append(yyVAL.val.([]*IndexField), yyDollar[3].val.(*IndexField))
+		}
+	case 879:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5213
+		{
+			yyVAL.val = &IndexField{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].val.(*SQLVal), Order: yyDollar[3].val.(string)}
+		}
+	case 880:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5217
+		{
+			yyVAL.val = &IndexField{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].val.(*SQLVal), Order: yyDollar[3].val.(string)}
+		}
+	case 881:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5221
+		{
+			yyVAL.val = &IndexField{Expression: tryCastExpr(yyDollar[2].val), Order: yyDollar[4].val.(string)}
+		}
+	case 882:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5227
+		{
+			yyVAL.val = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].val.(ConstraintInfo)}
+		}
+	case 883:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5231
+		{
+			yyVAL.val = &ConstraintDefinition{Details: yyDollar[2].val.(ConstraintInfo)}
+		}
+	case 884:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5235
+		{
+			yyVAL.val = &ConstraintDefinition{Details: yyDollar[1].val.(ConstraintInfo)}
+		}
+	case 885:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:5241
+		{
+			yyVAL.val = &ForeignKeyDefinition{Source: yyDollar[5].val.(Columns), ReferencedTable: yyDollar[8].val.(TableName), ReferencedColumns: yyDollar[10].val.(Columns), Index: string(yyDollar[3].bytes)}
+		}
+	case 886:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:5245
+		{
+			yyVAL.val = &ForeignKeyDefinition{Source: yyDollar[5].val.(Columns), ReferencedTable: yyDollar[8].val.(TableName), ReferencedColumns: yyDollar[10].val.(Columns), OnDelete: yyDollar[12].val.(ReferenceAction), Index: string(yyDollar[3].bytes)}
+		}
+	case 887:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:5249
+		{
+			yyVAL.val = &ForeignKeyDefinition{Source: yyDollar[5].val.(Columns), ReferencedTable: yyDollar[8].val.(TableName), ReferencedColumns: yyDollar[10].val.(Columns), OnUpdate: yyDollar[12].val.(ReferenceAction), Index: string(yyDollar[3].bytes)}
+		}
+	case 888:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:5253
+		{
+			yyVAL.val = &ForeignKeyDefinition{Source: yyDollar[5].val.(Columns), ReferencedTable: yyDollar[8].val.(TableName), ReferencedColumns: yyDollar[10].val.(Columns), OnDelete: yyDollar[12].val.(ReferenceAction), OnUpdate: yyDollar[13].val.(ReferenceAction), Index: string(yyDollar[3].bytes)}
+		}
+	case 889:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:5257
+		{
+			yyVAL.val = &ForeignKeyDefinition{Source: yyDollar[5].val.(Columns), ReferencedTable: yyDollar[8].val.(TableName), ReferencedColumns: yyDollar[10].val.(Columns), OnDelete: yyDollar[13].val.(ReferenceAction), OnUpdate: yyDollar[12].val.(ReferenceAction), Index: string(yyDollar[3].bytes)}
+		}
+	case 890:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5262
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 891:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5266
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 892:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5272
+		{
+			yyVAL.val = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].val.(ConstraintInfo)}
+		}
+	case 893:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5276
+		{
+			yyVAL.val = &ConstraintDefinition{Details: yyDollar[2].val.(ConstraintInfo)}
+		}
+	case 894:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5280
+		{
+			yyVAL.val = &ConstraintDefinition{Details: yyDollar[1].val.(ConstraintInfo)}
+		}
+	case 895:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5286
+		{
+			yyVAL.val = &CheckConstraintDefinition{Expr: tryCastExpr(yyDollar[3].val), Enforced: yyDollar[5].val.(bool)}
+		}
+	case 896:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5292
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5296
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 898:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5301
+		{
+			yyVAL.val = ""
+		}
+	case 899:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5305
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 900:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5309
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 901:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5315
+		{
+			yyVAL.val = yyDollar[3].val.(ReferenceAction)
+		}
+	case 902:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5321
+		{
+			yyVAL.val = yyDollar[3].val.(ReferenceAction)
+		}
+	case 903:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5327
+		{
+			yyVAL.val = Restrict
+		}
+	case 904:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5331
+		{
+			yyVAL.val = Cascade
+		}
+	case 905:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5335
+		{
+			yyVAL.val = NoAction
+		}
+	case 906:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5339
+		{
+			yyVAL.val = SetDefault
+		}
+	case 907:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5343
+		{
+			yyVAL.val = SetNull
+		}
+	case 908:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5348
+		{
+			yyVAL.val = true
+		}
+	case 909:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5352
+		{
+			yyVAL.val = true
+		}
+	case 910:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5356
+		{
+			yyVAL.val = false
+		}
+	case 911:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5361
+		{
+			yyVAL.val = []*TableOption(nil)
+		}
+	case 912:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5365
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*TableOption), yyDollar[2].val.(*TableOption))
+		}
+	case 913:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5369
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*TableOption), yyDollar[3].val.(*TableOption))
+		}
+	case 914:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5375
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 915:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5379
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 916:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5383
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 917:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5387
+		{
+			yyVAL.val = &TableOption{Name: "CHARACTER SET", Value: yyDollar[4].val.(string)}
+		}
+	case 918:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5391
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Value: yyDollar[5].val.(string)}
+		}
+	case 919:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5395
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 920:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5399
+		{
+			yyVAL.val = &TableOption{Name: "CHECKSUM", Value: string(yyDollar[3].bytes)}
+		}
+	case 921:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5403
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[2].bytes), Value: yyDollar[4].val.(string)}
+		}
+	case 922:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5407
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 923:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5411
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 924:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5415
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 925:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5419
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes)}
+		}
+	case 926:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5423
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes)}
+		}
+	case 927:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5427
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 928:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5431
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 929:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5435
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 930:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5439
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 931:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5443
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 932:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5447
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 933:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5451
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 934:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5455
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 935:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5459
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 936:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5463
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 937:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5467
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 938:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5471
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 939:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5475
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 940:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5479
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 941:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5483
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 942:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5487
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 943:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5491
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 944:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5495
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes) + string(yyDollar[2].bytes)}
+		}
+	case 945:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5499
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 946:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5503
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 947:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5507
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 948:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5511
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 949:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5515
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 950:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5519
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 951:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5523
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 952:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5527
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 953:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5531
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 954:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5535
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].val.(string)}
+		}
+	case 955:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5539
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: yyDollar[2].val.(string)}
+		}
+	case 956:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5543
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[2].bytes)}
+		}
+	case 957:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5547
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes)}
+		}
+	case 958:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5551
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes)}
+		}
+	case 959:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5555
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 960:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5559
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: "(" + yyDollar[4].val.(string) + ")"}
+		}
+	case 961:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5563
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 962:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5567
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 963:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5571
+		{
+			yyVAL.val = &TableOption{Name: string(yyDollar[1].bytes), Value: string(yyDollar[3].bytes)}
+		}
+	case 964:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5577
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 965:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5581
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 966:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5585
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 967:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5591
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 968:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5595
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 969:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5601
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 970:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5605
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 971:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5611
+		{
+			yyVAL.val = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 972:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5615
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 973:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5620
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 974:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5624
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 975:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5628
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 976:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5634
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 977:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5638
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 978:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5642
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 979:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5648
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 980:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5652
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 981:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5656
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 982:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5660
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 983:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5664
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 984:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5668
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 985:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5674
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 986:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5678
+		{
+			yyVAL.val = yyDollar[1].val.(string) + "," + string(yyDollar[3].bytes)
+		}
+	case 990:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5688
+		{
+			yyVAL.val = (*PartitionOption)(nil)
+		}
+	case 991:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5692
+		{
+			yyVAL.val = yyDollar[1].val.(*PartitionOption)
+		}
+	case 992:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5698
+		{
+			yyDollar[3].val.(*PartitionOption).Partitions = yyDollar[4].val.(*SQLVal)
+			yyDollar[3].val.(*PartitionOption).SubPartition = yyDollar[5].val.(*SubPartition)
+			yyDollar[3].val.(*PartitionOption).Definitions = yyDollar[6].val.([]*PartitionDefinition)
+			yyVAL.val = yyDollar[3].val.(*PartitionOption)
+		}
+	case 993:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5707
+		{
+			yyVAL.val = yyDollar[1].val.(*PartitionOption)
+		}
+	case 994:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5711
+		{
+			yyVAL.val = &PartitionOption{
+				PartitionType: string(yyDollar[1].val.(string)),
+				Expr:          tryCastExpr(yyDollar[3].val),
+			}
+		}
+	case 995:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5718
+		{
+			yyVAL.val = &PartitionOption{
+				PartitionType: string(yyDollar[1].val.(string)),
+				ColList:       yyDollar[4].val.(Columns),
+			}
+		}
+	case 996:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5727
+		{
+			yyVAL.val = &PartitionOption{
+				IsLinear:      yyDollar[1].val.(bool),
+				PartitionType: string(yyDollar[2].bytes),
+				Expr:          tryCastExpr(yyDollar[4].val),
+			}
+		}
+	case 997:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5735
+		{
+			yyVAL.val = &PartitionOption{
+				IsLinear:      yyDollar[1].val.(bool),
+				PartitionType: string(yyDollar[2].bytes),
+				KeyAlgorithm:  yyDollar[3].val.(string),
+				ColList:       yyDollar[5].val.(Columns),
+			}
+		}
+	case 998:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5744
+		{
+			yyVAL.val = &PartitionOption{
+				IsLinear:      yyDollar[1].val.(bool),
+				PartitionType: string(yyDollar[2].bytes),
+				KeyAlgorithm:  yyDollar[3].val.(string),
+				ColList:       Columns{},
+			}
+		}
+	case 999:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5754
+		{
+			yyVAL.val = false
+		}
+	case 1000:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5758
+		{
+			yyVAL.val = true
+		}
+	case 1001:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5763
+		{
+			yyVAL.val = ""
+		}
+	case 1002:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5767
+		{
+			yyVAL.val = string(yyDollar[1].bytes) + " = " + string(yyDollar[3].bytes)
+		}
+	case 1003:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5773
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1004:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5777
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1005:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5782
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 1006:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5786
+		{
+			yyVAL.val = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1007:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5791
+		{
+			yyVAL.val = (*SubPartition)(nil)
+		}
+	case 1008:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5795
+		{
+			yyVAL.val = &SubPartition{
+				IsLinear:      yyDollar[3].val.(bool),
+				PartitionType: string(yyDollar[4].bytes),
+				Expr:          tryCastExpr(yyDollar[6].val),
+				SubPartitions: yyDollar[8].val.(*SQLVal),
+			}
+		}
+	case 1009:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:5804
+		{
+			yyVAL.val = &SubPartition{
+				IsLinear:      yyDollar[3].val.(bool),
+				PartitionType: string(yyDollar[4].bytes),
+				KeyAlgorithm:  yyDollar[5].val.(string),
+				Expr:          tryCastExpr(yyDollar[7].val),
+				SubPartitions: yyDollar[9].val.(*SQLVal),
+			}
+		}
+	case 1010:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5815
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 1011:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5819
+		{
+			yyVAL.val = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1012:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5824
+		{
+			yyVAL.val = ""
+		}
+	case 1013:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5828
+		{
+			yyVAL.val = ""
+		}
+	case 1014:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5832
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 1015:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5837
+		{
+			yyVAL.val = string("")
+		}
+	case 1016:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5841
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 1022:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5854
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &DDL{
+				Action:       AlterStr,
+				Table:        tableName,
+				IfExists:     yyDollar[3].val.(int) != 0,
+				SequenceSpec: &SequenceSpec{Options: yyDollar[5].val.([]*SequenceOption)},
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 1023:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5871
+		{
+			yyVAL.val = &DBDDL{
+				Action:           AlterStr,
+				SchemaOrDatabase: "database",
+				DBName:           string(yyDollar[3].bytes),
+				CharsetCollate:   yyDollar[4].val.([]*CharsetAndCollate),
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{string(yyDollar[3].bytes)},
+				},
+			}
+		}
+	case 1024:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5885
+		{
+			yyVAL.val = &DBDDL{
+				Action:           AlterStr,
+				SchemaOrDatabase: "database",
+				CharsetCollate:   yyDollar[3].val.([]*CharsetAndCollate),
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{""},
+				},
+			}
+		}
+	case 1025:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:5900
+		{
+			tableName := yyDollar[4].val.(TableName)
+			ddls := yyDollar[5].val.([]*DDL)
+			for i := 0; i < len(ddls); i++ {
+				ddl := ddls[i]
+				if ddl.Action == RenameStr {
+					ddl.FromTables = append(TableNames{tableName}, ddl.FromTables...)
+				} else {
+					ddl.Table = tableName
+				}
+				PrependAuthTargetNames(ddl, []string{tableName.DbQualifier.String(), tableName.Name.String()})
+			}
+			yyVAL.val = &AlterTable{
+				Table:           tableName,
+				Statements:      ddls,
+				PartitionSpecs:  yyDollar[6].val.([]*PartitionSpec),
+				PartitionOption: yyDollar[7].val.(*PartitionOption),
+				Auth:            AuthInformation{AuthType: AuthType_IGNORE},
+			}
+		}
+	case 1026:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5921
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &AlterTable{
+				Table:          tableName,
+				PartitionSpecs: yyDollar[5].val.([]*PartitionSpec),
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 1027:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5934
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &AlterTable{
+				Table:           tableName,
+				PartitionOption: yyDollar[5].val.(*PartitionOption),
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 1028:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5949
+		{
+			yyVAL.val = []*DDL{yyDollar[1].val.(*DDL)}
+		}
+	case 1029:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5953
+		{
+			yyVAL.val = append(yyVAL.val.([]*DDL), yyDollar[3].val.(*DDL))
+		}
+	case 1030:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5959
+		{
+			ddl := &DDL{
+				Action:       AlterStr,
+				ColumnAction: AddStr,
+				TableSpec:    &TableSpec{},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddColumn(yyDollar[4].val.(*ColumnDefinition))
+			ddl.Column = yyDollar[4].val.(*ColumnDefinition).Name
+			if ddl.TableSpec.Constraints != nil {
+				ddl.ConstraintAction = AddStr
+			}
+			yyVAL.val = ddl
+		}
+	case 1031:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5977
+		{
+			ddl := &DDL{
+				Action:       AlterStr,
+				ColumnAction: AddStr,
+				TableSpec:    &TableSpec{},
+				ColumnOrder:  yyDollar[4].val.(*ColumnOrder),
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddColumn(yyDollar[3].val.(*ColumnDefinition))
+			ddl.Column = yyDollar[3].val.(*ColumnDefinition).Name
+			if ddl.TableSpec.Constraints != nil {
+				ddl.ConstraintAction = AddStr
+			}
+			yyVAL.val = ddl
+		}
+	case 1032:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:5996
+		{
+			yyVAL.val = &DDL{
+				Action:      AlterStr,
+				IfNotExists: yyDollar[3].val.(int) != 0,
+				IndexSpec: &IndexSpec{
+					Action:      CreateStr,
+					ToName:      NewColIdent(yyDollar[4].val.(string)),
+					Using:       yyDollar[5].val.(ColIdent),
+					Fields:      yyDollar[7].val.([]*IndexField),
+					Options:     yyDollar[9].val.([]*IndexOption),
+					ifNotExists: yyDollar[3].val.(int) != 0,
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1033:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:6015
+		{
+			idxName := yyDollar[6].val.(string)
+			if len(idxName) == 0 {
+				idxName = yyDollar[2].val.(string)
+			}
+			yyVAL.val = &DDL{
+				Action:      AlterStr,
+				IfNotExists: yyDollar[5].val.(int) != 0,
+				IndexSpec: &IndexSpec{
+					Action:      CreateStr,
+					ToName:      NewColIdent(idxName),
+					Type:        yyDollar[3].val.(string),
+					Using:       yyDollar[7].val.(ColIdent),
+					Fields:      yyDollar[9].val.([]*IndexField),
+					Options:     yyDollar[11].val.([]*IndexOption),
+					ifNotExists: yyDollar[5].val.(int) != 0,
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1034:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:6041
+		{
+			ddl := &DDL{
+				Action: AlterStr,
+				IndexSpec: &IndexSpec{
+					Action: CreateStr,
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.IndexSpec = &IndexSpec{
+				Action:  CreateStr,
+				Using:   NewColIdent(""),
+				ToName:  NewColIdent(yyDollar[2].val.(string)),
+				Type:    PrimaryStr,
+				Fields:  yyDollar[7].val.([]*IndexField),
+				Options: yyDollar[9].val.([]*IndexOption),
+			}
+			yyVAL.val = ddl
+		}
+	case 1035:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6063
+		{
+			ddl := &DDL{
+				Action:           AlterStr,
+				ConstraintAction: AddStr,
+				TableSpec:        &TableSpec{},
+				Auth: AuthInformation{
+					AuthType:   AuthType_FOREIGN_KEY,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddConstraint(yyDollar[2].val.(*ConstraintDefinition))
+			yyVAL.val = ddl
+		}
+	case 1036:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6077
+		{
+			ddl := &DDL{
+				Action:           AlterStr,
+				ConstraintAction: AddStr,
+				TableSpec:        &TableSpec{},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddConstraint(yyDollar[2].val.(*ConstraintDefinition))
+			yyVAL.val = ddl
+		}
+	case 1037:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6091
+		{
+			yyVAL.val = &DDL{
+				Action:           AlterStr,
+				ConstraintAction: DropStr,
+				TableSpec: &TableSpec{
+					Constraints: []*ConstraintDefinition{
+						&ConstraintDefinition{
+							Name: string(yyDollar[3].bytes),
+						},
+					},
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1038:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6109
+		{
+			yyVAL.val = &DDL{
+				Action:           AlterStr,
+				ConstraintAction: DropStr,
+				TableSpec: &TableSpec{
+					Constraints: []*ConstraintDefinition{
+						&ConstraintDefinition{
+							Name:    string(yyDollar[3].bytes),
+							Details: &CheckConstraintDefinition{},
+						},
+					},
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1039:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6128
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1040:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6138
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1041:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6148
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1042:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6158
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1043:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6168
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1044:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6178
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1045:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6188
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1046:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6198
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1047:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6208
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				DefaultSpec: &DefaultSpec{
+					Action: SetStr,
+					Column: yyDollar[3].val.(ColIdent),
+					Value:  tryCastExpr(yyDollar[6].val),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1048:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6223
+		{
+			colName := yyDollar[3].val.(ColIdent)
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				DefaultSpec: &DefaultSpec{
+					Action: DropStr,
+					Column: colName,
+				},
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER,
+					TargetType:  AuthTargetType_TableColumn,
+					TargetNames: []string{colName.String()},
+				},
+			}
+		}
+	case 1049:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6239
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1050:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6249
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1051:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6259
+		{
+			ddl := &DDL{
+				Action:       AlterStr,
+				ColumnAction: ChangeStr,
+				TableSpec:    &TableSpec{},
+				Column:       NewColIdent(string(yyDollar[3].bytes)),
+				ColumnOrder:  yyDollar[5].val.(*ColumnOrder),
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddColumn(yyDollar[4].val.(*ColumnDefinition))
+			yyVAL.val = ddl
+		}
+	case 1052:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6275
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				AlterCollationSpec: &AlterCollationSpec{
+					CharacterSet: yyDollar[5].val.(string),
+					Collation:    "",
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1053:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:6289
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				AlterCollationSpec: &AlterCollationSpec{
+					CharacterSet: yyDollar[5].val.(string),
+					Collation:    yyDollar[8].val.(string),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1054:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6303
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				AlterCollationSpec: &AlterCollationSpec{
+					CharacterSet: "",
+					Collation:    yyDollar[4].val.(string),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1055:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6317
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				AlterCollationSpec: &AlterCollationSpec{
+					CharacterSet: yyDollar[5].val.(string),
+					Collation:    "",
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1056:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:6331
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				AlterCollationSpec: &AlterCollationSpec{
+					CharacterSet: yyDollar[5].val.(string),
+					Collation:    yyDollar[7].val.(string),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1057:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6345
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				IndexSpec: &IndexSpec{
+					Action: string(yyDollar[1].bytes),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1058:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6358
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				IndexSpec: &IndexSpec{
+					Action: string(yyDollar[1].bytes),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1059:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6371
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1060:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6381
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1061:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6391
+		{
+			yyVAL.val = &DDL{
+				Action:       AlterStr,
+				ColumnAction: DropStr,
+				Column:       NewColIdent(string(yyDollar[3].bytes)),
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1062:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6403
+		{
+			yyVAL.val = &DDL{
+				Action:   AlterStr,
+				IfExists: yyDollar[3].val.(int) != 0,
+				IndexSpec: &IndexSpec{
+					Action:   DropStr,
+					ToName:   yyDollar[4].val.(ColIdent),
+					ifExists: yyDollar[3].val.(int) != 0,
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1063:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6419
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				IndexSpec: &IndexSpec{
+					Action: DropStr,
+					Type:   PrimaryStr,
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1064:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6433
+		{
+			ddl := &DDL{
+				Action:           AlterStr,
+				ConstraintAction: DropStr,
+				TableSpec:        &TableSpec{},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddConstraint(&ConstraintDefinition{
+				Name:    string(yyDollar[4].bytes),
+				Details: &ForeignKeyDefinition{},
+			})
+			yyVAL.val = ddl
+		}
+	case 1065:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6450
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1066:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6460
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_LOCK,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1067:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6470
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_LOCK,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1068:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6480
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_LOCK,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1069:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6490
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_LOCK,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1070:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6500
+		{
+			ddl := &DDL{
+				Action:       AlterStr,
+				ColumnAction: ModifyStr,
+				TableSpec:    &TableSpec{},
+				ColumnOrder:  yyDollar[4].val.(*ColumnOrder),
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddColumn(yyDollar[3].val.(*ColumnDefinition))
+			if len(ddl.TableSpec.Constraints) > 0 {
+				ddl.ConstraintAction = AddStr
+			}
+			ddl.Column = yyDollar[3].val.(*ColumnDefinition).Name
+			yyVAL.val = ddl
+		}
+	case 1071:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6520
+		{
+			yyVAL.val = &DDL{
+				Action:       AlterStr,
+				ColumnAction: RenameStr,
+				Column:       NewColIdent(string(yyDollar[3].bytes)),
+				ToColumn:     NewColIdent(string(yyDollar[5].bytes)),
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1072:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6533
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				IndexSpec: &IndexSpec{
+					Action:   RenameStr,
+					FromName: yyDollar[3].val.(ColIdent),
+					ToName:   yyDollar[5].val.(ColIdent),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_INDEX,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1073:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6548
+		{
+			// Change this to a rename statement
+			tableName := yyDollar[3].val.(TableName)
+			yyVAL.val = &DDL{
+				Action:   RenameStr,
+				ToTables: TableNames{tableName},
+				Auth: AuthInformation{
+					AuthType:    AuthType_RENAME,
+					TargetType:  AuthTargetType_Ignore,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 1074:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:6562
+		{
+			ddl := &DDL{
+				Action:           AlterStr,
+				ConstraintAction: RenameStr,
+				TableSpec:        &TableSpec{},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddConstraint(&ConstraintDefinition{
+				Name:    string(yyDollar[5].bytes),
+				Details: &ForeignKeyDefinition{},
+			})
+			ddl.TableSpec.AddConstraint(&ConstraintDefinition{
+				Name:    string(yyDollar[7].bytes),
+				Details: &ForeignKeyDefinition{},
+			})
+			yyVAL.val = ddl
+		}
+	case 1075:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6583
+		{
+			ddl := &DDL{
+				Action:           AlterStr,
+				ConstraintAction: RenameStr,
+				TableSpec:        &TableSpec{},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddConstraint(&ConstraintDefinition{
+				Name:    string(yyDollar[4].bytes),
+				Details: &CheckConstraintDefinition{},
+			})
+			ddl.TableSpec.AddConstraint(&ConstraintDefinition{
+				Name:    string(yyDollar[6].bytes),
+				Details: &CheckConstraintDefinition{},
+			})
+			yyVAL.val = ddl
+		}
+	case 1076:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6604
+		{
+			ddl := &DDL{
+				Action:           AlterStr,
+				ConstraintAction: RenameStr,
+				TableSpec:        &TableSpec{},
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+			ddl.TableSpec.AddConstraint(&ConstraintDefinition{
+				Name: string(yyDollar[3].bytes),
+			})
+			ddl.TableSpec.AddConstraint(&ConstraintDefinition{
+				Name: string(yyDollar[5].bytes),
+			})
+			yyVAL.val = ddl
+		}
+	case 1077:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6623
+		{
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				Auth: AuthInformation{
+					AuthType:   AuthType_ALTER,
+					TargetType: AuthTargetType_SingleTableIdentifier,
+				},
+			}
+		}
+	case 1078:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6633
+		{
+			ddl := yyDollar[1].val.(*DDL)
+			ddl.Auth = AuthInformation{
+				AuthType:   AuthType_ALTER,
+				TargetType: AuthTargetType_SingleTableIdentifier,
+			}
+			yyVAL.val = ddl
+		}
+	case 1079:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6645
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1080:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6649
+		{
+			yyVAL.val = &DDL{Action: AlterStr, AutoIncSpec: &AutoIncSpec{Value: tryCastExpr(yyDollar[3].val)}}
+		}
+	case 1081:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6653
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1082:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6657
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1083:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6661
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1084:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6665
+		{
+			yyVAL.val = &DDL{Action: AlterStr, AlterCommentSpec: &AlterCommentSpec{Comment: string(yyDollar[3].bytes)}}
+		}
+	case 1085:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6669
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1086:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6673
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1087:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6677
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1088:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6681
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1089:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6685
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1090:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6689
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1091:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6693
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1092:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6697
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1093:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6701
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1094:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6705
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1095:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6709
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1096:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6713
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1097:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6717
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1098:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6721
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1099:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6725
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6729
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1101:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6733
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6737
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1103:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6741
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6745
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6749
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6753
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1107:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6757
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1108:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6761
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1109:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6765
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1110:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6769
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6773
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1112:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6777
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1113:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6781
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1114:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6785
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1115:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6789
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6793
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1117:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6797
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1118:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6801
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1119:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6805
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1120:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6809
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1121:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6813
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1122:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6817
+		{
+			yyVAL.val = &DDL{Action: AlterStr}
+		}
+	case 1123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6823
+		{
+			yyVAL.val = true
+		}
+	case 1124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6827
+		{
+			yyVAL.val = false
+		}
+	case 1127:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:6837
+		{
+			selection := yyDollar[7].val.(*SetDefaultRole)
+			selection.Users = []AccountName{yyDollar[4].val.(AccountName)}
+			selection.AlterUser = true
+			selection.IfExists = yyDollar[3].val.(int) != 0
+			selection.Auth = AuthInformation{AuthType: AuthType_IGNORE}
+			yyVAL.val = selection
+		}
+	case 1128:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:6846
+		{
+			var ifExists bool
+			if yyDollar[3].val.(int) != 0 {
+				ifExists = true
+			}
+			accountName := yyDollar[4].val.(AccountName)
+			accountLimits, err := NewAccountLimits(yyDollar[6].val.([]AccountLimitItem))
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &DDL{
+				Action:         AlterStr,
+				User:           accountName,
+				Authentication: yyDollar[5].val.(*Authentication),
+				IfExists:       ifExists,
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER_USER,
+					TargetType:  AuthTargetType_Ignore,
+					TargetNames: []string{accountName.Name, accountName.Host},
+				},
+				AccountLimits: accountLimits,
+				PassLockItems: yyDollar[7].val.([]PassLockItem),
+			}
+		}
+	case 1129:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6873
+		{
+			yyVAL.val = (*ColumnOrder)(nil)
+		}
+	case 1130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6877
+		{
+			yyVAL.val = &ColumnOrder{First: true}
+		}
+	case 1131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6881
+		{
+			yyVAL.val = &ColumnOrder{AfterColumn: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 1132:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6886
+		{
+		}
+	case 1133:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6888
+		{
+		}
+	case 1134:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6891
+		{
+			yyVAL.val = []*PartitionSpec(nil)
+		}
+	case 1135:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6895
+		{
+			yyVAL.val = yyDollar[1].val.([]*PartitionSpec)
+		}
+	case 1136:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6901
+		{
+			yyVAL.val = []*PartitionSpec{yyDollar[1].val.(*PartitionSpec)}
+		}
+	case 1137:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6905
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*PartitionSpec), yyDollar[2].val.(*PartitionSpec))
+		}
+	case 1138:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6911
+		{
+			yyVAL.val = &PartitionSpec{Action: AddStr, Definitions: yyDollar[4].val.([]*PartitionDefinition)}
+		}
+	case 1139:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6915
+		{
+			yyVAL.val = &PartitionSpec{Action: AddStr, Number: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 1140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6919
+		{
+			yyVAL.val = &PartitionSpec{Action: DropStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1141:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6923
+		{
+			yyVAL.val = &PartitionSpec{Action: DiscardStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1142:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6927
+		{
+			yyVAL.val = &PartitionSpec{Action: DiscardStr, IsAll: true}
+		}
+	case 1143:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6931
+		{
+			yyVAL.val = &PartitionSpec{Action: ImportStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1144:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6935
+		{
+			yyVAL.val = &PartitionSpec{Action: ImportStr, IsAll: true}
+		}
+	case 1145:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6939
+		{
+			yyVAL.val = &PartitionSpec{Action: TruncateStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1146:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6943
+		{
+			yyVAL.val = &PartitionSpec{Action: TruncateStr, IsAll: true}
+		}
+	case 1147:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6947
+		{
+			yyVAL.val = &PartitionSpec{Action: TruncateStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1148:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6951
+		{
+			yyVAL.val = &PartitionSpec{Action: TruncateStr, IsAll: true}
+		}
+	case 1149:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6955
+		{
+			yyVAL.val = &PartitionSpec{Action: CoalesceStr, Number: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 1150:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:6959
+		{
+			yyVAL.val = &PartitionSpec{Action: ReorganizeStr, Names: yyDollar[3].val.(Partitions), Definitions: yyDollar[6].val.([]*PartitionDefinition)}
+		}
+	case 1151:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6963
+		{
+			yyVAL.val = &PartitionSpec{Action: ExchangeStr, Names: Partitions{yyDollar[3].val.(ColIdent)}, TableName: yyDollar[6].val.(TableName)}
+		}
+	case 1152:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:6967
+		{
+			yyVAL.val = &PartitionSpec{Action: ExchangeStr, Names: Partitions{yyDollar[3].val.(ColIdent)}, TableName: yyDollar[6].val.(TableName), WithValidation: yyDollar[7].val.(bool)}
+		}
+	case 1153:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6971
+		{
+			yyVAL.val = &PartitionSpec{Action: AnalyzeStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1154:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6975
+		{
+			yyVAL.val = &PartitionSpec{Action: AnalyzeStr, IsAll: true}
+		}
+	case 1155:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6988
+		{
+			yyVAL.val = &PartitionSpec{Action: OptimizeStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6992
+		{
+			yyVAL.val = &PartitionSpec{Action: OptimizeStr, IsAll: true}
+		}
+	case 1157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6996
+		{
+			yyVAL.val = &PartitionSpec{Action: RebuildStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7000
+		{
+			yyVAL.val = &PartitionSpec{Action: RebuildStr, IsAll: true}
+		}
+	case 1159:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7004
+		{
+			yyVAL.val = &PartitionSpec{Action: RepairStr, Names: yyDollar[3].val.(Partitions)}
+		}
+	case 1160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7008
+		{
+			yyVAL.val = &PartitionSpec{Action: RepairStr, IsAll: true}
+		}
+	case 1161:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7012
+		{
+			yyVAL.val = &PartitionSpec{Action: RemoveStr}
+		}
+	case 1162:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7017
+		{
+			yyVAL.val = []*PartitionDefinition(nil)
+		}
+	case 1163:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7021
+		{
+			yyVAL.val = yyDollar[2].val.([]*PartitionDefinition)
+		}
+	case 1164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7027
+		{
+			yyVAL.val = []*PartitionDefinition{yyDollar[1].val.(*PartitionDefinition)}
+		}
+	case 1165:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7031
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*PartitionDefinition), yyDollar[3].val.(*PartitionDefinition))
+		}
+	case 1166:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7037
+		{
+			def := yyDollar[3].val.(*PartitionDefinition)
+			def.Name = yyDollar[2].val.(ColIdent)
+			def.Options = yyDollar[4].val.([]*TableOption)
+			yyVAL.val = def
+		}
+	case 1167:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7045
+		{
+			yyVAL.val = &PartitionDefinition{}
+		}
+	case 1168:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7049
+		{
+			yyVAL.val = &PartitionDefinition{Limit: tryCastExpr(yyDollar[5].val)}
+		}
+	case 1169:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7053
+		{
+			yyVAL.val = &PartitionDefinition{Maxvalue: true}
+		}
+	case 1170:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7057
+		{
+			yyVAL.val = &PartitionDefinition{Maxvalue: true}
+		}
+	case 1171:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7061
+		{
+			yyVAL.val = &PartitionDefinition{Values: yyDollar[4].val.(Exprs)}
+		}
+	case 1172:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7066
+		{
+			yyVAL.val = []*TableOption(nil)
+		}
+	case 1173:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7070
+		{
+			yyVAL.val = yyDollar[1].val.([]*TableOption)
+		}
+	case 1174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7076
+		{
+			yyVAL.val = []*TableOption{yyDollar[1].val.(*TableOption)}
+		}
+	case 1175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7080
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*TableOption), yyDollar[2].val.(*TableOption))
+		}
+	case 1176:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7086
+		{
+			yyVAL.val = &TableOption{Name: keywordStrings[ENGINE], Value: string(yyDollar[3].bytes)}
+		}
+	case 1177:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7090
+		{
+			yyVAL.val = &TableOption{Name: keywordStrings[ENGINE], Value: string(yyDollar[4].bytes)}
+		}
+	case 1178:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7094
+		{
+			yyVAL.val = &TableOption{Name: keywordStrings[COMMENT_KEYWORD], Value: string(yyDollar[3].bytes)}
+		}
+	case 1179:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7100
+		{
+			eventName := yyDollar[4].val.(EventName)
+			renameName := yyDollar[6].val.(EventName)
+			targetNames := []string{eventName.Qualifier.String()}
+			if len(renameName.Qualifier.String()) > 0 {
+				targetNames = append(targetNames, renameName.Qualifier.String())
+			}
+			es := &EventSpec{
+				EventName:            eventName,
+				Definer:              yyDollar[2].val.(string),
+				OnCompletionPreserve: yyDollar[5].val.(EventOnCompletion),
+				RenameName:           renameName,
+				Status:               yyDollar[7].val.(EventStatus),
+				Comment:              yyDollar[8].val.(*SQLVal),
+			}
+			if err := es.ValidateAlterEvent(); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = &DDL{
+				Action:    AlterStr,
+				EventSpec: es,
+				Auth: AuthInformation{
+					AuthType:    AuthType_EVENT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: targetNames,
+				},
+			}
+		}
+	case 1180:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:7130
+		{
+			eventName := yyDollar[4].val.(EventName)
+			renameName := yyDollar[9].val.(EventName)
+			targetNames := []string{eventName.Qualifier.String()}
+			if len(renameName.Qualifier.String()) > 0 {
+				targetNames = append(targetNames, renameName.Qualifier.String())
+			}
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				EventSpec: &EventSpec{
+					EventName:            eventName,
+					Definer:              yyDollar[2].val.(string),
+					OnSchedule:           yyDollar[7].val.(*EventScheduleSpec),
+					OnCompletionPreserve: yyDollar[8].val.(EventOnCompletion),
+					RenameName:           renameName,
+					Status:               yyDollar[10].val.(EventStatus),
+					Comment:              yyDollar[11].val.(*SQLVal),
+				},
+				Auth: AuthInformation{
+					AuthType:    AuthType_EVENT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: targetNames,
+				},
+			}
+		}
+	case 1181:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:7156
+		{
+			eventName := yyDollar[4].val.(EventName)
+			renameName := yyDollar[6].val.(EventName)
+			targetNames := []string{eventName.Qualifier.String()}
+			if len(renameName.Qualifier.String()) > 0 {
+				targetNames = append(targetNames, renameName.Qualifier.String())
+			}
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				EventSpec: &EventSpec{
+					EventName:            eventName,
+					Definer:              yyDollar[2].val.(string),
+					OnCompletionPreserve: yyDollar[5].val.(EventOnCompletion),
+					RenameName:           renameName,
+					Status:               yyDollar[7].val.(EventStatus),
+					Comment:              yyDollar[8].val.(*SQLVal),
+					Body:                 tryCastStatement(yyDollar[11].val),
+				},
+				SubStatementPositionStart: yyDollar[10].val.(int),
+				SubStatementPositionEnd:   yyDollar[12].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_EVENT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: targetNames,
+				},
+			}
+		}
+	case 1182:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:7184
+		{
+			eventName := yyDollar[4].val.(EventName)
+			renameName := yyDollar[9].val.(EventName)
+			targetNames := []string{eventName.Qualifier.String()}
+			if len(renameName.Qualifier.String()) > 0 {
+				targetNames = append(targetNames, renameName.Qualifier.String())
+			}
+			yyVAL.val = &DDL{
+				Action: AlterStr,
+				EventSpec: &EventSpec{
+					EventName:            eventName,
+					Definer:              yyDollar[2].val.(string),
+					OnSchedule:           yyDollar[7].val.(*EventScheduleSpec),
+					OnCompletionPreserve: yyDollar[8].val.(EventOnCompletion),
+					RenameName:           renameName,
+					Status:               yyDollar[10].val.(EventStatus),
+					Comment:              yyDollar[11].val.(*SQLVal),
+					Body:                 tryCastStatement(yyDollar[14].val),
+				},
+				SubStatementPositionStart: yyDollar[13].val.(int),
+				SubStatementPositionEnd:   yyDollar[15].val.(int) - 1,
+				Auth: AuthInformation{
+					AuthType:    AuthType_EVENT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: targetNames,
+				},
+			}
+		}
+	case 1183:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7214
+		{
+			yyVAL.val = EventName{}
+		}
+	case 1184:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7218
+		{
+			yyVAL.val = yyDollar[3].val.(EventName)
+		}
+	case 1185:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7224
+		{
+			yyVAL.val = yyDollar[3].val.(*DDL)
+		}
+	case 1186:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7228
+		{
+			yyVAL.val = &RenameUser{
+				Accounts: yyDollar[3].val.([]AccountRename),
+				Auth: AuthInformation{
+					AuthType:   AuthType_CREATE_USER,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1187:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7240
+		{
+			fromTableName := yyDollar[1].val.(TableName)
+			toTableName := yyDollar[3].val.(TableName)
+			yyVAL.val = &DDL{
+				Action: RenameStr,
+				FromTables: TableNames{
+					fromTableName,
+				},
+				ToTables: TableNames{
+					toTableName,
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_RENAME,
+					TargetType: AuthTargetType_Ignore,
+					TargetNames: []string{
+						fromTableName.DbQualifier.String(),
+						fromTableName.Name.String(),
+						toTableName.DbQualifier.String(),
+						toTableName.Name.String(),
+					},
+				},
+			}
+		}
+	case 1188:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7264
+		{
+			yyVAL.val = yyDollar[1].val.(*DDL)
+			fromTableName := yyDollar[3].val.(TableName)
+			toTableName := yyDollar[5].val.(TableName)
+			yyVAL.val.(*DDL).FromTables = append(yyVAL.val.(*DDL).FromTables, fromTableName)
+			yyVAL.val.(*DDL).ToTables = append(yyVAL.val.(*DDL).ToTables, toTableName)
+			yyVAL.val.(*DDL).Auth.TargetNames = append(yyVAL.val.(*DDL).Auth.TargetNames,
+				fromTableName.DbQualifier.String(),
+				fromTableName.Name.String(),
+				toTableName.DbQualifier.String(),
+				toTableName.Name.String(),
+			)
+		}
+	case 1189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7280
+		{
+			yyVAL.val = []AccountRename{{From: yyDollar[1].val.(AccountName), To: yyDollar[3].val.(AccountName)}}
+		}
+	case 1190:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7284
+		{
+			yyVAL.val = append(yyDollar[1].val.([]AccountRename), AccountRename{From: yyDollar[3].val.(AccountName), To: yyDollar[5].val.(AccountName)})
+		}
+	case 1191:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7290
+		{
+			var temp bool
+			if yyDollar[2].val.(int) != 0 {
+				temp = true
+			}
+
+			var exists bool
+			if yyDollar[4].val.(int) != 0 {
+				exists = true
+			}
+
+			tableNames := yyDollar[5].val.(TableNames)
+			yyVAL.val = &DDL{
+				Action:     DropStr,
+				FromTables: tableNames,
+				IfExists:   exists,
+				Temporary:  temp,
+				Auth: AuthInformation{
+					AuthType:    AuthType_DROP,
+					TargetType:  AuthTargetType_MultipleTableIdentifiers,
+					TargetNames: tableNames.AuthMultipleTableIdentifiers(),
+				},
+			}
+		}
+	case 1192:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7315
+		{
+			// For consistency, we always use a AlterTable for ALTER TABLE equivalent statements
+			tableName := yyDollar[6].val.(TableName)
+			ddl := &DDL{
+				Action: AlterStr,
+				Table:  tableName,
+				IndexSpec: &IndexSpec{
+					Action:   DropStr,
+					ToName:   yyDollar[4].val.(ColIdent),
+					ifExists: yyDollar[3].val.(int) != 0,
+				},
+				IfExists: yyDollar[3].val.(int) != 0,
+				Auth: AuthInformation{
+					AuthType:    AuthType_INDEX,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+			yyVAL.val = &AlterTable{
+				Table:      tableName,
+				Statements: []*DDL{ddl},
+				Auth:       AuthInformation{AuthType: AuthType_IGNORE},
+			}
+		}
+	case 1193:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7340
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			tableNames := yyDollar[4].val.(TableNames)
+			yyVAL.val = &DDL{
+				Action:    DropStr,
+				FromViews: tableNames,
+				IfExists:  exists,
+				Auth: AuthInformation{
+					AuthType:    AuthType_DROP,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: tableNames.DbQualifiers(),
+				},
+			}
+		}
+	case 1194:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7358
+		{
+			tableNames := yyDollar[4].val.(TableNames)
+			yyVAL.val = &DDL{
+				Action:       DropStr,
+				FromTables:   tableNames,
+				IfExists:     yyDollar[3].val.(int) != 0,
+				SequenceSpec: &SequenceSpec{},
+				Auth: AuthInformation{
+					AuthType:    AuthType_DROP,
+					TargetType:  AuthTargetType_MultipleTableIdentifiers,
+					TargetNames: tableNames.AuthMultipleTableIdentifiers(),
+				},
+			}
+		}
+	case 1195:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7373
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			yyVAL.val = &DBDDL{
+				Action:           DropStr,
+				SchemaOrDatabase: "database",
+				DBName:           string(yyDollar[4].bytes),
+				IfExists:         exists,
+				Auth: AuthInformation{
+					AuthType:   AuthType_DROP,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1196:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7390
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			yyVAL.val = &DBDDL{
+				Action:           DropStr,
+				SchemaOrDatabase: "schema",
+				DBName:           string(yyDollar[4].bytes),
+				IfExists:         exists,
+				Auth: AuthInformation{
+					AuthType:   AuthType_DROP,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1197:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7407
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			triggerName := yyDollar[4].val.(TriggerName)
+			yyVAL.val = &DDL{
+				Action: DropStr,
+				TriggerSpec: &TriggerSpec{
+					TrigName: triggerName,
+				},
+				IfExists: exists,
+				Auth: AuthInformation{
+					AuthType:    AuthType_TRIGGER,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{triggerName.Qualifier.String(), triggerName.Name.String()},
+				},
+			}
+		}
+	case 1198:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7427
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			procName := yyDollar[4].val.(ProcedureName)
+			yyVAL.val = &DDL{
+				Action: DropStr,
+				ProcedureSpec: &ProcedureSpec{
+					ProcName: procName,
+				},
+				IfExists: exists,
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER_ROUTINE,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{procName.Qualifier.String()},
+				},
+			}
+		}
+	case 1199:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7447
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			funcName := yyDollar[4].val.(ProcedureName)
+			yyVAL.val = &DDL{
+				Action: DropStr,
+				FunctionSpec: &FunctionSpec{
+					FuncName: funcName,
+				},
+				IfExists: exists,
+				Auth: AuthInformation{
+					AuthType:    AuthType_ALTER_ROUTINE,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{funcName.Qualifier.String()},
+				},
+			}
+		}
+	case 1200:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7467
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			yyVAL.val = &DropUser{
+				IfExists:     exists,
+				AccountNames: yyDollar[4].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_CREATE_USER,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1201:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7482
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			yyVAL.val = &DropRole{
+				IfExists: exists,
+				Roles:    yyDollar[4].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_DROP_ROLE,
+					TargetType: AuthTargetType_Ignore,
+				},
+			}
+		}
+	case 1202:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7497
+		{
+			var exists bool
+			if yyDollar[3].val.(int) != 0 {
+				exists = true
+			}
+			eventName := yyDollar[4].val.(EventName)
+			yyVAL.val = &DDL{
+				Action:    DropStr,
+				EventSpec: &EventSpec{EventName: eventName},
+				IfExists:  exists,
+				Auth: AuthInformation{
+					AuthType:    AuthType_EVENT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{eventName.Qualifier.String()},
+				},
+			}
+		}
+	case 1203:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7516
+		{
+
+		}
+	case 1204:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7520
+		{
+			yyVAL.val = Restrict
+		}
+	case 1205:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7524
+		{
+			yyVAL.val = Cascade
+		}
+	case 1206:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7530
+		{
+			tableName := yyDollar[3].val.(TableName)
+			yyVAL.val = &DDL{
+				Action: TruncateStr,
+				Table:  tableName,
+				Auth: AuthInformation{
+					AuthType:    AuthType_DROP,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 1207:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7543
+		{
+			tableName := yyDollar[2].val.(TableName)
+			yyVAL.val = &DDL{
+				Action: TruncateStr,
+				Table:  tableName,
+				Auth: AuthInformation{
+					AuthType:    AuthType_DROP,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 1208:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7558
+		{
+			yyVAL.val = tryCastStatement(yyDollar[3].val)
+		}
+	case 1209:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7564
+		{
+			yyVAL.val = &Analyze{Tables: []TableName{yyDollar[1].val.(TableName)}, Action: UpdateStr, Columns: yyDollar[5].val.(Columns), Using: tryCastExpr(yyDollar[8].val)}
+		}
+	case 1210:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7568
+		{
+			yyVAL.val = &Analyze{Tables: []TableName{yyDollar[1].val.(TableName)}, Action: DropStr, Columns: yyDollar[5].val.(Columns)}
+		}
+	case 1211:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7572
+		{
+			yyVAL.val = &Analyze{Tables: yyDollar[1].val.(TableNames)}
+		}
+	case 1217:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7585
+		{
+			yyVAL.val = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].bytes)}
+		}
+	case 1218:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7589
+		{
+			yyVAL.val = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].bytes)}
+		}
+	case 1219:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7593
+		{
+			yyVAL.val = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].val.(string))}
+		}
+	case 1220:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7597
+		{
+			yyVAL.val = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].val.(string))}
+		}
+	case 1221:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7603
+		{
+			yyVAL.val = []string{yyDollar[1].val.(string)}
+		}
+	case 1222:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7607
+		{
+			yyVAL.val = append(yyDollar[1].val.([]string), yyDollar[3].val.(string))
+		}
+	case 1223:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7614
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1224:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7618
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1225:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7624
+		{
+			yyVAL.val = &Execute{Name: string(yyDollar[2].bytes)}
+		}
+	case 1226:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7628
+		{
+			yyVAL.val = &Execute{Name: string(yyDollar[2].bytes)}
+		}
+	case 1227:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7632
+		{
+			yyVAL.val = &Execute{Name: string(yyDollar[2].bytes), VarList: yyDollar[4].val.([]string)}
+		}
+	case 1228:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7636
+		{
+			yyVAL.val = &Execute{Name: string(yyDollar[2].bytes), VarList: yyDollar[4].val.([]string)}
+		}
+	case 1229:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7642
+		{
+			yyVAL.val = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7646
+		{
+			yyVAL.val = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1231:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7650
+		{
+			yyVAL.val = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1232:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7654
+		{
+			yyVAL.val = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7660
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION_CLIENT,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1234:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7671
+		{
+			yyVAL.val = &Show{
+				Type:   CharsetStr,
+				Filter: yyDollar[4].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7682
+		{
+			yyVAL.val = &Show{
+				Type:   string(yyDollar[2].bytes),
+				Filter: yyDollar[3].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1236:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7693
+		{
+			yyVAL.val = &Show{
+				Type:        string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				IfNotExists: yyDollar[4].val.(int) == 1,
+				Database:    string(yyDollar[5].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1237:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7705
+		{
+			yyVAL.val = &Show{
+				Type:        string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				IfNotExists: yyDollar[4].val.(int) == 1,
+				Database:    string(yyDollar[5].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1238:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7717
+		{
+			showTablesOpt := &ShowTablesOpt{AsOf: tryCastExpr(yyDollar[5].val)}
+			yyVAL.val = &Show{
+				Type:          CreateTableStr,
+				Table:         yyDollar[4].val.(TableName),
+				ShowTablesOpt: showTablesOpt,
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1239:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7730
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &Show{
+				Type:  CreateProcedureStr,
+				Table: yyDollar[4].val.(TableName),
+				Auth: AuthInformation{
+					AuthType:    AuthType_SHOW_CREATE_PROCEDURE,
+					TargetType:  AuthTargetType_Ignore,
+					TargetNames: []string{tableName.DbQualifier.String()},
+				},
+			}
+		}
+	case 1240:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7743
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &Show{
+				Type:  CreateFunctionStr,
+				Table: yyDollar[4].val.(TableName),
+				Auth: AuthInformation{
+					AuthType:    AuthType_SHOW_CREATE_PROCEDURE,
+					TargetType:  AuthTargetType_Ignore,
+					TargetNames: []string{tableName.DbQualifier.String()},
+				},
+			}
+		}
+	case 1241:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7756
+		{
+			yyVAL.val = &Show{
+				Type:  CreateTriggerStr,
+				Table: yyDollar[4].val.(TableName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1242:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7767
+		{
+			yyVAL.val = &Show{
+				Type:  string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Table: yyDollar[4].val.(TableName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1243:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7778
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &Show{
+				Type:  CreateSequenceStr,
+				Table: tableName,
+				Auth: AuthInformation{
+					AuthType:    AuthType_SELECT,
+					TargetType:  AuthTargetType_SingleTableIdentifier,
+					TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+				},
+			}
+		}
+	case 1244:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7791
+		{
+			yyVAL.val = &Show{
+				Type:  CreateEventStr,
+				Table: yyDollar[4].val.(TableName),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7802
+		{
+			yyVAL.val = &Show{
+				Type:   string(yyDollar[2].bytes),
+				Filter: yyDollar[3].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1246:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7813
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1247:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7823
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1248:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7833
+		{
+			yyVAL.val = &Show{
+				Type:               IndexStr,
+				Table:              yyDollar[4].val.(TableName),
+				Database:           yyDollar[5].val.(string),
+				ShowIndexFilterOpt: tryCastExpr(yyDollar[6].val),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1249:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7846
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1250:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7856
+		{
+			yyVAL.val = &Show{
+				Type:   string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Filter: yyDollar[4].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1251:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7867
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION_CLIENT,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1252:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7877
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION_CLIENT,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1253:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7887
+		{
+			yyVAL.val = &Show{
+				Type:   string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Filter: yyDollar[4].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1254:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7898
+		{
+			yyVAL.val = &Show{
+				Scope:  yyDollar[2].val.(string),
+				Type:   string(yyDollar[3].bytes),
+				Filter: yyDollar[4].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1255:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7910
+		{
+			yyVAL.val = &Show{
+				Type:     string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Database: yyDollar[4].val.(string),
+				Filter:   yyDollar[5].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1256:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7922
+		{
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].val.(string), AsOf: tryCastExpr(yyDollar[7].val), Filter: yyDollar[8].val.(*ShowFilter)}
+			yyVAL.val = &Show{
+				Type:          string(yyDollar[3].val.(string)),
+				ShowTablesOpt: showTablesOpt,
+				Table:         yyDollar[5].val.(TableName),
+				Full:          yyDollar[2].val.(bool),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1257:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:7936
+		{
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[7].val.(string), AsOf: tryCastExpr(yyDollar[8].val), Filter: yyDollar[9].val.(*ShowFilter)}
+			yyVAL.val = &Show{
+				Type:          string(yyDollar[4].val.(string)),
+				ShowTablesOpt: showTablesOpt,
+				Table:         yyDollar[6].val.(TableName),
+				Full:          yyDollar[3].val.(bool),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+				Extended: true,
+			}
+		}
+	case 1258:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7951
+		{
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[4].val.(string), Filter: yyDollar[6].val.(*ShowFilter), AsOf: tryCastExpr(yyDollar[5].val)}
+			yyVAL.val = &Show{
+				Type:          string(yyDollar[3].bytes),
+				ShowTablesOpt: showTablesOpt,
+				Full:          yyDollar[2].val.(bool),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1259:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7964
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[3].bytes),
+				Full: yyDollar[2].val.(bool),
+				Auth: AuthInformation{
+					AuthType:   AuthType_PROCESS,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1260:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7975
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes),
+				ShowTablesOpt: &ShowTablesOpt{
+					DbName: yyDollar[3].val.(string),
+					Filter: yyDollar[4].val.(*ShowFilter),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1261:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7989
+		{
+			yyVAL.val = &Show{
+				Scope:  yyDollar[2].val.(string),
+				Type:   string(yyDollar[3].bytes),
+				Filter: yyDollar[4].val.(*ShowFilter),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1262:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8001
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1263:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8011
+		{
+			yyVAL.val = &Show{
+				Type:                   string(yyDollar[2].bytes),
+				ShowCollationFilterOpt: tryCastExpr(yyDollar[4].val),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1264:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8022
+		{
+			cmp := tryCastExpr(yyDollar[3].val).(*ComparisonExpr)
+			cmp.Left = &ColName{Name: NewColIdent("collation")}
+			yyVAL.val = &Show{
+				Type:                   string(yyDollar[2].bytes),
+				ShowCollationFilterOpt: cmp,
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1265:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8035
+		{
+			yyVAL.val = &ShowGrants{
+				Auth: AuthInformation{
+					AuthType:    AuthType_SELECT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{"mysql"},
+				},
+			}
+		}
+	case 1266:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8045
+		{
+			an := yyDollar[4].val.(AccountName)
+			yyVAL.val = &ShowGrants{
+				For: &an,
+				Auth: AuthInformation{
+					AuthType:    AuthType_SELECT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{"mysql"},
+				},
+			}
+		}
+	case 1267:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8057
+		{
+			yyVAL.val = &ShowGrants{
+				CurrentUser: true,
+				Auth: AuthInformation{
+					AuthType:    AuthType_SELECT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{"mysql"},
+				},
+			}
+		}
+	case 1268:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8068
+		{
+			an := yyDollar[4].val.(AccountName)
+			yyVAL.val = &ShowGrants{
+				For:   &an,
+				Using: yyDollar[6].val.([]AccountName),
+				Auth: AuthInformation{
+					AuthType:    AuthType_SELECT,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{"mysql"},
+				},
+			}
+		}
+	case 1269:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8081
+		{
+			yyVAL.val = &ShowPrivileges{
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1270:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8090
+		{
+			yyVAL.val = &Show{
+				Type:      string(yyDollar[6].bytes),
+				CountStar: true,
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1271:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8101
+		{
+			yyVAL.val = &Show{
+				Type:      string(yyDollar[6].bytes),
+				CountStar: true,
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1272:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8112
+		{
+			yyVAL.val = &Show{
+				Type:  string(yyDollar[2].bytes),
+				Limit: yyDollar[3].val.(*Limit),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1273:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8123
+		{
+			yyVAL.val = &Show{
+				Type:  string(yyDollar[2].bytes),
+				Limit: yyDollar[3].val.(*Limit),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1274:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8134
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes),
+				ShowTablesOpt: &ShowTablesOpt{
+					DbName: yyDollar[3].val.(string),
+					Filter: yyDollar[4].val.(*ShowFilter),
+				},
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1275:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8148
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1276:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8158
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION_CLIENT,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1277:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8168
+		{
+			yyVAL.val = &Show{
+				Type: "BINARY LOG STATUS",
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8178
+		{
+			yyVAL.val = &Show{
+				Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_REPLICATION_CLIENT,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 1279:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8190
+		{
+			yyVAL.val = &ComparisonExpr{Operator: LikeStr, Right: tryCastExpr(yyDollar[2].val), Escape: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1280:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8196
+		{
+			yyVAL.val = false
+		}
+	case 1281:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8200
+		{
+			yyVAL.val = true
+		}
+	case 1282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8206
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1283:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8210
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1284:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8216
+		{
+			yyVAL.val = ""
+		}
+	case 1285:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8220
+		{
+			yyVAL.val = yyDollar[2].val.(TableIdent).v
+		}
+	case 1286:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8224
+		{
+			yyVAL.val = yyDollar[2].val.(TableIdent).v
+		}
+	case 1287:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8230
+		{
+			yyVAL.val = (*ShowFilter)(nil)
+		}
+	case 1288:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8234
+		{
+			yyVAL.val = &ShowFilter{Like: string(yyDollar[2].bytes)}
+		}
+	case 1289:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8238
+		{
+			yyVAL.val = &ShowFilter{Like: string(yyDollar[3].bytes)}
+		}
+	case 1290:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8242
+		{
+			yyVAL.val = &ShowFilter{Filter: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1291:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8248
+		{
+			yyVAL.val = ""
+		}
+	case 1292:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8252
+		{
+			yyVAL.val = SessionStr
+		}
+	case 1293:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8256
+		{
+			yyVAL.val = GlobalStr
+		}
+	case 1294:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8262
+		{
+			tableIdent := yyDollar[2].val.(TableIdent)
+			yyVAL.val = &Use{
+				DBName: tableIdent,
+				Auth: AuthInformation{
+					AuthType:    AuthType_VISIBLE,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{tableIdent.String()},
+				},
+			}
+		}
+	case 1295:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8274
+		{
+			firstTableIdent := yyDollar[2].val.(TableIdent)
+			tableIdent := TableIdent{v: firstTableIdent.v + "/" + yyDollar[4].val.(TableIdent).v}
+			yyVAL.val = &Use{
+				DBName: tableIdent,
+				Auth: AuthInformation{
+					AuthType:    AuthType_VISIBLE,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{firstTableIdent.String()},
+				},
+			}
+		}
+	case 1296:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8287
+		{
+			yyVAL.val = &Use{
+				DBName: TableIdent{v: ""},
+				Auth: AuthInformation{
+					AuthType:    AuthType_VISIBLE,
+					TargetType:  AuthTargetType_DatabaseIdentifiers,
+					TargetNames: []string{""},
+				},
+			}
+		}
+	case 1297:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8299
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 1298:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8303
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 1299:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8309
+		{
+			yyVAL.val = &Begin{}
+		}
+	case 1300:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8313
+		{
+			yyVAL.val = tryCastStatement(yyDollar[1].val)
+		}
+	case 1301:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8319
+		{
+			yyVAL.val = &Begin{}
+		}
+	case 1302:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8323
+		{
+			yyVAL.val = &Begin{TransactionCharacteristic: TxReadWrite}
+		}
+	case 1303:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8327
+		{
+			yyVAL.val = &Begin{TransactionCharacteristic: TxReadOnly}
+		}
+	case 1304:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8331
+		{
+			yyVAL.val = &Begin{}
+		}
+	case 1305:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8336
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 1306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8340
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 1307:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8345
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 1308:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8349
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 1309:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8354
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 1310:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8358
+		{
+			yyVAL.bytes = []byte(nil)
+		}
+	case 1311:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8364
+		{
+			yyVAL.val = &Commit{}
+		}
+	case 1312:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8370
+		{
+			yyVAL.val = &Rollback{}
+		}
+	case 1313:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8376
+		{
+			yyVAL.val = &Savepoint{Identifier: string(yyDollar[2].bytes)}
+		}
+	case 1314:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8382
+		{
+			yyVAL.val = &RollbackSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 1315:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8386
+		{
+			yyVAL.val = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 1316:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8390
+		{
+			yyVAL.val = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 1317:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8394
+		{
+			yyVAL.val = &RollbackSavepoint{Identifier: string(yyDollar[5].bytes)}
+		}
+	case 1318:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8400
+		{
+			yyVAL.val = &ReleaseSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 1319:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8406
+		{
+			yyVAL.val = &Explain{ExplainFormat: yyDollar[2].val.(string), Plan: yyDollar[3].val.(bool), Statement: tryCastStatement(yyDollar[4].val)}
+		}
+	case 1320:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8410
+		{
+			yyVAL.val = &Explain{ExplainFormat: yyDollar[3].val.(string), Plan: yyDollar[4].val.(bool), Statement: tryCastStatement(yyDollar[5].val)}
+		}
+	case 1321:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8414
+		{
+			yyVAL.val = &Explain{Analyze: true, Plan: yyDollar[3].val.(bool), ExplainFormat: TreeStr, Statement: yyDollar[4].val.(SelectStatement)}
+		}
+	case 1322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8420
+		{
+			yyVAL.val = yyDollar[1].val.(SelectStatement)
+		}
+	case 1326:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8428
+		{
+			yyVAL.val = ""
+		}
+	case 1327:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8432
+		{
+			yyVAL.val = string(yyDollar[3].bytes)
+		}
+	case 1328:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8437
+		{
+			yyVAL.val = false
+		}
+	case 1329:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8441
+		{
+			yyVAL.val = true
+		}
+	case 1333:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8453
+		{
+			showTablesOpt := &ShowTablesOpt{AsOf: tryCastExpr(yyDollar[3].val)}
+			yyVAL.val = &Show{
+				Type:          "columns",
+				Table:         yyDollar[2].val.(TableName),
+				ShowTablesOpt: showTablesOpt,
+				Auth: AuthInformation{
+					AuthType:   AuthType_SHOW,
+					TargetType: AuthTargetType_TODO,
+				},
+			}
+		}
+	case 1334:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8473
+		{
+			setAllowComments(yylex, true)
+		}
+	case 1335:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8477
+		{
+			// this is an extension of the previous rule, so
+			// we use $2 here
+			yyVAL.val = yyDollar[2].val
+			setAllowComments(yylex, false)
+		}
+	case 1336:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8485
+		{
+			yyVAL.val = Comments(nil)
+		}
+	case 1337:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8489
+		{
+			yyVAL.val = append(yyDollar[1].val.(Comments), yyDollar[2].bytes)
+		}
+	case 1338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8495
+		{
+			yyVAL.val = UnionStr
+		}
+	case 1339:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8499
+		{
+			yyVAL.val = UnionAllStr
+		}
+	case 1340:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8503
+		{
+			yyVAL.val = UnionDistinctStr
+		}
+	case 1341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8509
+		{
+			yyVAL.val = IntersectStr
+		}
+	case 1342:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8513
+		{
+			yyVAL.val = IntersectAllStr
+		}
+	case 1343:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8517
+		{
+			yyVAL.val = IntersectDistinctStr
+		}
+	case 1344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8523
+		{
+			yyVAL.val = ExceptStr
+		}
+	case 1345:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8527
+		{
+			yyVAL.val = ExceptAllStr
+		}
+	case 1346:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8531
+		{
+			yyVAL.val = ExceptDistinctStr
+		}
+	case 1347:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8536
+		{
+			yyVAL.val = QueryOpts{}
+		}
+	case 1348:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8540
+		{
+			opt := QueryOpts{All: true}
+			qo := yyDollar[1].val.(QueryOpts)
+			qop := &qo
+			if err := qop.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = qo
+		}
+	case 1349:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8551
+		{
+			opt := QueryOpts{Distinct: true}
+			qo := yyDollar[1].val.(QueryOpts)
+			qop := &qo
+			if err := qop.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = qo
+		}
+	case 1350:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8562
+		{
+			opt := QueryOpts{StraightJoinHint: true}
+			qo := yyDollar[1].val.(QueryOpts)
+			qop := &qo
+			if err := qop.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = qo
+		}
+	case 1351:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8573
+		{
+			opt := QueryOpts{SQLCalcFoundRows: true}
+			qo := yyDollar[1].val.(QueryOpts)
+			qop := &qo
+			if err := qop.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = qo
+		}
+	case 1352:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8584
+		{
+			opt := QueryOpts{SQLCache: true}
+			qo := yyDollar[1].val.(QueryOpts)
+			qop := &qo
+			if err := qop.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = qo
+		}
+	case 1353:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8595
+		{
+			opt := QueryOpts{SQLNoCache: true}
+			qo := yyDollar[1].val.(QueryOpts)
+			qop := &qo
+			if err := qop.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.val = qo
+		}
+	case 1354:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8607
+		{
+			yyVAL.val = ""
+		}
+	case 1355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8611
+		{
+			yyVAL.val = AllStr
+		}
+	case 1356:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8615
+		{
+			yyVAL.val = DistinctStr
+		}
+	case 1357:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8621
+		{
+			if ae, ok := yyDollar[2].val.(SelectExpr).(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[1].val.(int)
+				ae.EndParsePos = yyDollar[3].val.(int) - 1
+			}
+			yyVAL.val = SelectExprs{yyDollar[2].val.(SelectExpr)}
+		}
+	case 1358:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8629
+		{
+			if ae, ok := yyDollar[4].val.(SelectExpr).(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[3].val.(int)
+				ae.EndParsePos = yyDollar[5].val.(int) - 1
+			}
+			yyVAL.val = append(yyVAL.val.(SelectExprs), yyDollar[4].val.(SelectExpr))
+		}
+	case 1359:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8640
+		{
+			yyVAL.val = &StarExpr{}
+		}
+	case 1360:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8644
+		{
+			yyVAL.val = &AliasedExpr{Expr: tryCastExpr(yyDollar[1].val)}
+		}
+	case 1361:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8648
+		{
+			yyVAL.val = &StarExpr{TableName: TableName{Name: yyDollar[1].val.(TableIdent)}}
+		}
+	case 1362:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8652
+		{
+			yyVAL.val = &StarExpr{TableName: TableName{DbQualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(TableIdent)}}
+		}
+	case 1363:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8658
+		{
+			yyVAL.val = &StarExpr{}
+		}
+	case 1364:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8662
+		{
+			yyVAL.val = &AliasedExpr{Expr: tryCastExpr(yyDollar[1].val), As: yyDollar[2].val.(ColIdent)}
+		}
+	case 1365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8666
+		{
+			yyVAL.val = &StarExpr{TableName: TableName{Name: yyDollar[1].val.(TableIdent)}}
+		}
+	case 1366:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8670
+		{
+			yyVAL.val = &StarExpr{TableName: TableName{DbQualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(TableIdent)}}
+		}
+	case 1367:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8676
+		{
+			yyVAL.val = &Over{NameRef: yyDollar[2].val.(ColIdent)}
+		}
+	case 1368:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8680
+		{
+			yyVAL.val = (*Over)(yyDollar[2].val.(*WindowDef))
+		}
+	case 1369:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8686
+		{
+			yyVAL.val = &WindowDef{NameRef: yyDollar[2].val.(ColIdent), PartitionBy: yyDollar[3].val.(Exprs), OrderBy: yyDollar[4].val.(OrderBy), Frame: yyDollar[5].val.(*Frame)}
+		}
+	case 1370:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8691
+		{
+			yyVAL.val = ColIdent{}
+		}
+	case 1371:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8694
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1372:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8699
+		{
+			yyVAL.val = Exprs(nil)
+		}
+	case 1373:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8703
+		{
+			yyVAL.val = yyDollar[3].val.(Exprs)
+		}
+	case 1374:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8708
+		{
+			yyVAL.val = (*Over)(nil)
+		}
+	case 1375:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8712
+		{
+			yyVAL.val = yyDollar[1].val.(*Over)
+		}
+	case 1376:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8717
+		{
+			yyVAL.val = (*Frame)(nil)
+		}
+	case 1377:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8721
+		{
+			yyVAL.val = &Frame{Unit: RowsUnit, Extent: yyDollar[2].val.(*FrameExtent)}
+		}
+	case 1378:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8725
+		{
+			yyVAL.val = &Frame{Unit: RangeUnit, Extent: yyDollar[2].val.(*FrameExtent)}
+		}
+	case 1379:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8732
+		{
+			startBound := yyDollar[2].val.(*FrameBound)
+			endBound := yyDollar[4].val.(*FrameBound)
+			switch {
+			case startBound.Type == UnboundedFollowing:
+				yylex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			case endBound.Type == UnboundedPreceding:
+				yylex.Error("frame end cannot be UNBOUNDED PRECEDING")
+				return 1
+			case startBound.Type == CurrentRow && endBound.Type == ExprPreceding:
+				yylex.Error("frame starting from current row cannot have preceding rows")
+				return 1
+			case startBound.Type == ExprFollowing && endBound.Type == ExprPreceding:
+				yylex.Error("frame starting from following row cannot have preceding rows")
+				return 1
+			case startBound.Type == ExprFollowing && endBound.Type == CurrentRow:
+				yylex.Error("frame starting from following row cannot have preceding rows")
+				return 1
+			}
+			yyVAL.val = &FrameExtent{Start: startBound, End: endBound}
+		}
+	case 1380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8755
+		{
+			startBound := yyDollar[1].val.(*FrameBound)
+			switch {
+			case startBound.Type == UnboundedFollowing:
+				yylex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			case startBound.Type == ExprFollowing:
+				yylex.Error("frame starting from following row cannot end with current row")
+				return 1
+			}
+			yyVAL.val = &FrameExtent{Start: startBound}
+		}
+	case 1381:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8770
+		{
+			yyVAL.val = &FrameBound{Type: UnboundedPreceding}
+		}
+	case 1382:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8774
+		{
+			yyVAL.val = &FrameBound{Type: UnboundedFollowing}
+		}
+	case 1383:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8778
+		{
+			yyVAL.val = &FrameBound{Type: CurrentRow}
+		}
+	case 1384:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8782
+		{
+			yyVAL.val = &FrameBound{
+				Expr: tryCastExpr(yyDollar[1].val),
+				Type: ExprPreceding,
+			}
+		}
+	case 1385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8789
+		{
+			yyVAL.val = &FrameBound{
+				Expr: tryCastExpr(yyDollar[1].val),
+				Type: ExprFollowing,
+			}
+		}
+	case 1386:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8797
+		{
+			yyVAL.val = Window(nil)
+		}
+	case 1387:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8800
+		{
+			yyVAL.val = yyDollar[2].val.(Window)
+		}
+	case 1388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8806
+		{
+			yyVAL.val = Window{yyDollar[1].val.(*WindowDef)}
+		}
+	case 1389:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8809
+		{
+			yyVAL.val = append(yyDollar[1].val.(Window), yyDollar[3].val.(*WindowDef))
+		}
+	case 1390:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8815
+		{
+			def := yyDollar[3].val.(*WindowDef)
+			def.Name = yyDollar[1].val.(ColIdent)
+			yyVAL.val = def
+		}
+	case 1416:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8855
+		{
+			yyVAL.val = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1417:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8859
+		{
+			yyVAL.val = &IntervalExpr{Expr: tryCastExpr(yyDollar[2].val), Unit: string(yyDollar[3].bytes)}
+		}
+	case 1418:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8864
+		{
+			yyVAL.val = ColIdent{}
+		}
+	case 1419:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8868
+		{
+			yyVAL.val = yyDollar[1].val.(ColIdent)
+		}
+	case 1420:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8872
+		{
+			yyVAL.val = yyDollar[2].val.(ColIdent)
+		}
+	case 1421:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8876
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[2].bytes))
+		}
+	case 1422:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8882
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1423:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8886
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1424:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8890
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1425:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8896
+		{
+			yyVAL.val = TableExprs{yyDollar[1].val.(TableExpr)}
+		}
+	case 1426:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8900
+		{
+			yyVAL.val = append(yyVAL.val.(TableExprs), yyDollar[3].val.(TableExpr))
+		}
+	case 1429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8910
+		{
+			yyVAL.val = yyDollar[1].val.(*AliasedTableExpr)
+		}
+	case 1430:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8914
+		{
+			switch n := yyDollar[1].val.(SimpleTableExpr).(type) {
+			case *Subquery:
+				n.Columns = yyDollar[4].val.(Columns)
+			case *ValuesStatement:
+				n.Columns = yyDollar[4].val.(Columns)
+			}
+			yyVAL.val = &AliasedTableExpr{
+				Lateral: false,
+				Expr:    yyDollar[1].val.(SimpleTableExpr),
+				As:      yyDollar[3].val.(TableIdent),
+				Auth:    AuthInformation{AuthType: AuthType_IGNORE},
+			}
+		}
+	case 1431:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8929
+		{
+			switch n := yyDollar[2].val.(SimpleTableExpr).(type) {
+			case *Subquery:
+				n.Columns = yyDollar[5].val.(Columns)
+			case *ValuesStatement:
+				n.Columns = yyDollar[5].val.(Columns)
+			}
+			yyVAL.val = &AliasedTableExpr{
+				Lateral: true,
+				Expr:    yyDollar[2].val.(SimpleTableExpr),
+				As:      yyDollar[4].val.(TableIdent),
+				Auth:    AuthInformation{AuthType: AuthType_IGNORE},
+			}
+		}
+	case 1432:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8944
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 1433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8950
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 1434:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8956
+		{
+			yyVAL.val = &ParenTableExpr{Exprs: yyDollar[2].val.(TableExprs)}
+		}
+	case 1437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8964
+		{
+			yyVAL.val = &ValuesStatement{Rows: yyDollar[2].val.(Values)}
+		}
+	case 1438:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8970
+		{
+			yyVAL.val = Values{yyDollar[2].val.(ValTuple)}
+		}
+	case 1439:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8974
+		{
+			yyVAL.val = append(yyVAL.val.(Values), yyDollar[4].val.(ValTuple))
+		}
+	case 1440:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8979
+		{
+		}
+	case 1441:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8981
+		{
+		}
+	case 1442:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8985
+		{
+			yyVAL.val = yyDollar[2].val.(*AliasedTableExpr)
+			tableName := yyDollar[1].val.(TableName)
+			yyVAL.val.(*AliasedTableExpr).Expr = tableName
+			yyVAL.val.(*AliasedTableExpr).Auth = AuthInformation{
+				TargetType:  AuthTargetType_SingleTableIdentifier,
+				TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+			}
+		}
+	case 1443:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8995
+		{
+			yyVAL.val = yyDollar[6].val.(*AliasedTableExpr)
+			tableName := yyDollar[1].val.(TableName)
+			yyVAL.val.(*AliasedTableExpr).Expr = tableName
+			yyVAL.val.(*AliasedTableExpr).Partitions = yyDollar[4].val.(Partitions)
+			yyVAL.val.(*AliasedTableExpr).Auth = AuthInformation{
+				TargetType:  AuthTargetType_SingleTableIdentifier,
+				TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+			}
+		}
+	case 1444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9010
+		{
+			yyVAL.val = &AliasedTableExpr{Hints: yyDollar[1].val.(*IndexHints)}
+		}
+	case 1445:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9014
+		{
+			yyVAL.val = &AliasedTableExpr{As: yyDollar[2].val.(TableIdent), Hints: yyDollar[3].val.(*IndexHints)}
+		}
+	case 1446:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9018
+		{
+			yyVAL.val = &AliasedTableExpr{AsOf: yyDollar[1].val.(*AsOf), Hints: yyDollar[2].val.(*IndexHints)}
+		}
+	case 1447:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9022
+		{
+			yyVAL.val = &AliasedTableExpr{AsOf: yyDollar[1].val.(*AsOf), As: yyDollar[3].val.(TableIdent), Hints: yyDollar[4].val.(*IndexHints)}
+		}
+	case 1448:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9028
+		{
+			yyVAL.val = yyDollar[1].val.(*AsOf)
+		}
+	case 1449:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9032
+		{
+			yyVAL.val = yyDollar[1].val.(*AsOf)
+		}
+	case 1450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9036
+		{
+			yyVAL.val = yyDollar[1].val.(*AsOf)
+		}
+	case 1451:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9040
+		{
+			yyVAL.val = yyDollar[1].val.(*AsOf)
+		}
+	case 1452:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9044
+		{
+			yyVAL.val = yyDollar[1].val.(*AsOf)
+		}
+	case 1453:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9050
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[3].val), End: tryCastExpr(yyDollar[5].val), EndInclusive: true}
+		}
+	case 1454:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9054
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[3].val), End: tryCastExpr(yyDollar[5].val)}
+		}
+	case 1455:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:9058
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[5].val), End: tryCastExpr(yyDollar[7].val), StartInclusive: true, EndInclusive: true}
+		}
+	case 1456:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9064
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[3].val), End: tryCastExpr(yyDollar[5].val), EndInclusive: true}
+		}
+	case 1457:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9068
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[3].val), End: tryCastExpr(yyDollar[5].val)}
+		}
+	case 1458:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:9072
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[5].val), End: tryCastExpr(yyDollar[7].val), StartInclusive: true, EndInclusive: true}
+		}
+	case 1459:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9076
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[3].val), End: tryCastExpr(yyDollar[5].val), EndInclusive: true}
+		}
+	case 1460:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9080
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[3].val), End: tryCastExpr(yyDollar[5].val)}
+		}
+	case 1461:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:9084
+		{
+			yyVAL.val = &AsOf{Start: tryCastExpr(yyDollar[5].val), End: tryCastExpr(yyDollar[7].val), StartInclusive: true, EndInclusive: true}
+		}
+	case 1462:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9090
+		{
+			yyVAL.val = &AsOf{All: true}
+		}
+	case 1463:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9096
+		{
+			yyVAL.val = &AsOf{All: true}
+		}
+	case 1464:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9100
+		{
+			yyVAL.val = &AsOf{All: true}
+		}
+	case 1465:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9106
+		{
+			yyVAL.val = &AsOf{Time: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1466:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9110
+		{
+			yyVAL.val = &AsOf{Time: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1467:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9114
+		{
+			yyVAL.val = &AsOf{Time: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1468:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9119
+		{
+			yyVAL.val = Expr(nil)
+		}
+	case 1469:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9123
+		{
+			yyVAL.val = yyDollar[1].val.(*AsOf).Time
+		}
+	case 1470:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9129
+		{
+			yyVAL.val = yyDollar[2].val.(Columns)
+		}
+	case 1471:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9133
+		{
+			yyVAL.val = yyDollar[1].val.(Columns)
+		}
+	case 1472:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9138
+		{
+			yyVAL.val = Columns(nil)
+		}
+	case 1473:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9142
+		{
+			yyVAL.val = yyDollar[2].val.(Columns)
+		}
+	case 1474:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9148
+		{
+			yyVAL.val = Columns{yyDollar[1].val.(ColIdent)}
+		}
+	case 1475:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9152
+		{
+			yyVAL.val = append(yyVAL.val.(Columns), yyDollar[3].val.(ColIdent))
+		}
+	case 1476:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9158
+		{
+			yyVAL.val = Partitions{yyDollar[1].val.(ColIdent)}
+		}
+	case 1477:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9162
+		{
+			yyVAL.val = append(yyVAL.val.(Partitions), yyDollar[3].val.(ColIdent))
+		}
+	case 1478:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9168
+		{
+			yyVAL.val = &TableFuncExpr{Name: string(yyDollar[1].bytes), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1479:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9172
+		{
+			yyVAL.val = &TableFuncExpr{Name: string(yyDollar[1].bytes), Exprs: yyDollar[3].val.(SelectExprs), Alias: yyDollar[6].val.(TableIdent)}
+		}
+	case 1480:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9186
+		{
+			yyVAL.val = &JoinTableExpr{LeftExpr: yyDollar[1].val.(TableExpr), Join: yyDollar[2].val.(string), RightExpr: yyDollar[3].val.(TableExpr), Condition: yyDollar[4].val.(JoinCondition)}
+		}
+	case 1481:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9190
+		{
+			yyVAL.val = &JoinTableExpr{LeftExpr: yyDollar[1].val.(TableExpr), Join: yyDollar[2].val.(string), RightExpr: yyDollar[3].val.(TableExpr), Condition: yyDollar[4].val.(JoinCondition)}
+		}
+	case 1482:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9194
+		{
+			yyVAL.val = &JoinTableExpr{LeftExpr: yyDollar[1].val.(TableExpr), Join: yyDollar[2].val.(string), RightExpr: yyDollar[3].val.(TableExpr), Condition: yyDollar[4].val.(JoinCondition)}
+		}
+	case 1483:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9198
+		{
+			yyVAL.val = &JoinTableExpr{LeftExpr: yyDollar[1].val.(TableExpr), Join: yyDollar[2].val.(string), RightExpr: yyDollar[3].val.(TableExpr)}
+		}
+	case 1484:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9204
+		{
+			yyVAL.val = JoinCondition{On: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1485:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9206
+		{
+			yyVAL.val = JoinCondition{Using: yyDollar[3].val.(Columns)}
+		}
+	case 1486:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9210
+		{
+			yyVAL.val = JoinCondition{}
+		}
+	case 1487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9212
+		{
+			yyVAL.val = yyDollar[1].val.(JoinCondition)
+		}
+	case 1488:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9216
+		{
+			yyVAL.val = JoinCondition{}
+		}
+	case 1489:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9218
+		{
+			yyVAL.val = JoinCondition{On: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1491:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9223
+		{
+			yyVAL.val = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1492:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9227
+		{
+			yyVAL.val = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1493:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9233
+		{
+			yyVAL.val = JoinStr
+		}
+	case 1494:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9237
+		{
+			yyVAL.val = JoinStr
+		}
+	case 1495:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9241
+		{
+			yyVAL.val = JoinStr
+		}
+	case 1496:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9247
+		{
+			yyVAL.val = StraightJoinStr
+		}
+	case 1497:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9253
+		{
+			yyVAL.val = LeftJoinStr
+		}
+	case 1498:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9257
+		{
+			yyVAL.val = LeftJoinStr
+		}
+	case 1499:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9261
+		{
+			yyVAL.val = RightJoinStr
+		}
+	case 1500:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9265
+		{
+			yyVAL.val = RightJoinStr
+		}
+	case 1501:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9269
+		{
+			yyVAL.val = FullOuterJoinStr
+		}
+	case 1502:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9273
+		{
+			yyVAL.val = FullOuterJoinStr
+		}
+	case 1503:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9279
+		{
+			yyVAL.val = NaturalJoinStr
+		}
+	case 1504:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9283
+		{
+			if yyDollar[2].val.(string) == LeftJoinStr {
+				yyVAL.val = NaturalLeftJoinStr
+			} else if yyDollar[2].val.(string) == RightJoinStr {
+				yyVAL.val = NaturalRightJoinStr
+			} else if yyDollar[2].val.(string) == FullOuterJoinStr {
+				yyVAL.val = NaturalFullJoinStr
+			}
+		}
+	case 1505:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:9295
+		{
+			yyDollar[8].val.(*JSONTableSpec).Path = string(yyDollar[5].bytes)
+			yyVAL.val = &JSONTableExpr{Data: tryCastExpr(yyDollar[3].val), Spec: yyDollar[8].val.(*JSONTableSpec), Alias: yyDollar[12].val.(TableIdent)}
+		}
+	case 1506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9302
+		{
+			yyVAL.val = &JSONTableSpec{}
+			yyVAL.val.(*JSONTableSpec).AddColumn(yyDollar[1].val.(*JSONTableColDef))
+		}
+	case 1507:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9307
+		{
+			yyVAL.val.(*JSONTableSpec).AddColumn(yyDollar[3].val.(*JSONTableColDef))
+		}
+	case 1508:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9313
+		{
+			yyVAL.val = &JSONTableColDef{Name: yyDollar[1].val.(ColIdent), Type: yyDollar[2].val.(ColumnType), Opts: yyDollar[3].val.(JSONTableColOpts)}
+		}
+	case 1509:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9317
+		{
+			yyVAL.val = &JSONTableColDef{Name: yyDollar[1].val.(ColIdent), Type: ColumnType{Type: "INTEGER", Unsigned: true, Autoincrement: true}}
+		}
+	case 1510:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9321
+		{
+			yyDollar[5].val.(*JSONTableSpec).Path = string(yyDollar[2].bytes)
+			yyVAL.val = &JSONTableColDef{Spec: yyDollar[5].val.(*JSONTableSpec)}
+		}
+	case 1511:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:9326
+		{
+			yyDollar[6].val.(*JSONTableSpec).Path = string(yyDollar[3].bytes)
+			yyVAL.val = &JSONTableColDef{Spec: yyDollar[6].val.(*JSONTableSpec)}
+		}
+	case 1512:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9333
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes)}
+		}
+	case 1513:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9337
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnEmpty: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1514:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9341
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnError: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1515:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9345
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnEmpty: tryCastExpr(yyDollar[3].val), ValOnError: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1516:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9349
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnEmpty: tryCastExpr(yyDollar[4].val), ValOnError: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1517:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9353
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnEmpty: true}
+		}
+	case 1518:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9357
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnError: true}
+		}
+	case 1519:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:9361
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnEmpty: true, ErrorOnError: true}
+		}
+	case 1520:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:9365
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnEmpty: true, ErrorOnError: true}
+		}
+	case 1521:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9369
+		{
+			yyVAL.val = JSONTableColOpts{Path: string(yyDollar[3].bytes), Exists: true}
+		}
+	case 1522:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9375
+		{
+			yyVAL.val = &NullVal{}
+		}
+	case 1523:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9379
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 1524:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9385
+		{
+			yyVAL.val = &NullVal{}
+		}
+	case 1525:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9389
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 1526:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9395
+		{
+			yyVAL.val = TriggerName{Name: yyDollar[1].val.(ColIdent)}
+		}
+	case 1527:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9399
+		{
+			yyVAL.val = TriggerName{Qualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1528:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9405
+		{
+			yyVAL.val = yyDollar[3].val.(TableName)
+		}
+	case 1529:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9411
+		{
+			yyVAL.val = yyDollar[2].val.(TableName)
+		}
+	case 1530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9415
+		{
+			yyVAL.val = yyDollar[1].val.(TableName)
+		}
+	case 1531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9421
+		{
+			yyVAL.val = TableName{Name: yyDollar[1].val.(TableIdent)}
+		}
+	case 1532:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9425
+		{
+			yyVAL.val = TableName{DbQualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(TableIdent)}
+		}
+	case 1533:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9429
+		{
+			yyVAL.val = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1534:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9433
+		{
+			yyVAL.val = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1535:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9437
+		{
+			yyVAL.val = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1536:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9441
+		{
+			yyVAL.val = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9447
+		{
+			yyVAL.val = ProcedureName{Name: yyDollar[1].val.(ColIdent)}
+		}
+	case 1538:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9451
+		{
+			yyVAL.val = ProcedureName{Qualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1539:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9457
+		{
+			yyVAL.val = TableName{Name: yyDollar[1].val.(TableIdent)}
+		}
+	case 1540:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9462
+		{
+			yyVAL.val = (*IndexHints)(nil)
+		}
+	case 1541:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9466
+		{
+			yyVAL.val = &IndexHints{Type: UseStr, Indexes: yyDollar[4].val.(Columns)}
+		}
+	case 1542:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9470
+		{
+			yyVAL.val = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].val.(Columns)}
+		}
+	case 1543:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9474
+		{
+			yyVAL.val = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].val.(Columns)}
+		}
+	case 1544:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9479
+		{
+			yyVAL.val = Expr(nil)
+		}
+	case 1545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9483
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 1546:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9489
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1547:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9493
+		{
+			yyVAL.val = &AndExpr{Left: tryCastExpr(yyDollar[1].val), Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1548:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9497
+		{
+			yyVAL.val = &OrExpr{Left: tryCastExpr(yyDollar[1].val), Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1549:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9501
+		{
+			yyVAL.val = &XorExpr{Left: tryCastExpr(yyDollar[1].val), Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1550:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9505
+		{
+			yyVAL.val = &NotExpr{Expr: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1551:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9509
+		{
+			yyVAL.val = &IsExpr{Operator: yyDollar[3].val.(string), Expr: tryCastExpr(yyDollar[1].val)}
+		}
+	case 1552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9513
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1553:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9517
+		{
+			yyVAL.val = &Default{ColName: yyDollar[2].val.(string)}
+		}
+	case 1554:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9523
+		{
+			yyVAL.val = ""
+		}
+	case 1555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9527
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 1556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9533
+		{
+			yyVAL.val = BoolVal(true)
+		}
+	case 1557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9537
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 1558:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9543
+		{
+			yyVAL.val = &ComparisonExpr{Left: tryCastExpr(yyDollar[1].val), Operator: yyDollar[2].val.(string), Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1559:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9547
+		{
+			yyVAL.val = &ComparisonExpr{Left: tryCastExpr(yyDollar[1].val), Operator: InStr, Right: yyDollar[3].val.(ColTuple)}
+		}
+	case 1560:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9551
+		{
+			yyVAL.val = &ComparisonExpr{Left: tryCastExpr(yyDollar[1].val), Operator: NotInStr, Right: yyDollar[4].val.(ColTuple)}
+		}
+	case 1561:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9555
+		{
+			yyVAL.val = &ComparisonExpr{Left: tryCastExpr(yyDollar[1].val), Operator: LikeStr, Right: tryCastExpr(yyDollar[3].val), Escape: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1562:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9559
+		{
+			yyVAL.val = &ComparisonExpr{Left: tryCastExpr(yyDollar[1].val), Operator: NotLikeStr, Right: tryCastExpr(yyDollar[4].val), Escape: tryCastExpr(yyDollar[5].val)}
+		}
+	case 1563:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9563
+		{
+			yyVAL.val = &ComparisonExpr{Left: tryCastExpr(yyDollar[1].val), Operator: RegexpStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1564:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9567
+		{
+			yyVAL.val = &ComparisonExpr{Left: tryCastExpr(yyDollar[1].val), Operator: NotRegexpStr, Right: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1565:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9571
+		{
+			yyVAL.val = &RangeCond{Left: tryCastExpr(yyDollar[1].val), Operator: BetweenStr, From: tryCastExpr(yyDollar[3].val), To: tryCastExpr(yyDollar[5].val)}
+		}
+	case 1566:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9575
+		{
+			yyVAL.val = &RangeCond{Left: tryCastExpr(yyDollar[1].val), Operator: NotBetweenStr, From: tryCastExpr(yyDollar[4].val), To: tryCastExpr(yyDollar[6].val)}
+		}
+	case 1567:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9579
+		{
+			yyVAL.val = &ExistsExpr{Subquery: yyDollar[2].val.(*Subquery)}
+		}
+	case 1568:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9585
+		{
+			yyVAL.val = IsNullStr
+		}
+	case 1569:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9589
+		{
+			yyVAL.val = IsNotNullStr
+		}
+	case 1570:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9593
+		{
+			yyVAL.val = IsTrueStr
+		}
+	case 1571:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9597
+		{
+			yyVAL.val = IsNotTrueStr
+		}
+	case 1572:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9601
+		{
+			yyVAL.val = IsFalseStr
+		}
+	case 1573:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9605
+		{
+			yyVAL.val = IsNotFalseStr
+		}
+	case 1574:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9611
+		{
+			yyVAL.val = EqualStr
+		}
+	case 1575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9615
+		{
+			yyVAL.val = LessThanStr
+		}
+	case 1576:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9619
+		{
+			yyVAL.val = GreaterThanStr
+		}
+	case 1577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9623
+		{
+			yyVAL.val = LessEqualStr
+		}
+	case 1578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9627
+		{
+			yyVAL.val = GreaterEqualStr
+		}
+	case 1579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9631
+		{
+			yyVAL.val = NotEqualStr
+		}
+	case 1580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9635
+		{
+			yyVAL.val = NullSafeEqualStr
+		}
+	case 1581:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9640
+		{
+			yyVAL.val = Expr(nil)
+		}
+	case 1582:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9644
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 1583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9650
+		{
+			yyVAL.val = yyDollar[1].val.(ValTuple)
+		}
+	case 1584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9654
+		{
+			yyVAL.val = yyDollar[1].val.(*Subquery)
+		}
+	case 1585:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9658
+		{
+			yyVAL.val = ListArg(yyDollar[1].bytes)
+		}
+	case 1586:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9664
+		{
+			yyVAL.val = &Subquery{Select: yyDollar[2].val.(SelectStatement)}
+		}
+	case 1587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9670
+		{
+			yyVAL.val = yyDollar[1].val.(*Subquery)
+		}
+	case 1588:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9674
+		{
+			yyVAL.val = yyDollar[2].val.(SimpleTableExpr)
+		}
+	case 1589:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:9679
+		{
+			yyVAL.val = SelectExprs(nil)
+		}
+	case 1591:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9686
+		{
+			yyVAL.val = SelectExprs{yyDollar[1].val.(SelectExpr)}
+		}
+	case 1592:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9690
+		{
+			yyVAL.val = append(yyDollar[1].val.(SelectExprs), yyDollar[3].val.(SelectExpr))
+		}
+	case 1593:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9696
+		{
+			yyVAL.val = Exprs{tryCastExpr(yyDollar[1].val)}
+		}
+	case 1594:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9700
+		{
+			yyVAL.val = append(yyDollar[1].val.(Exprs), tryCastExpr(yyDollar[3].val))
+		}
+	case 1595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9706
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1596:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9710
+		{
+			yyVAL.val = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1597:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9714
+		{
+			yyVAL.val = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1598:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9718
+		{
+			yyVAL.val = yyDollar[1].val.(BoolVal)
+		}
+	case 1599:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9722
+		{
+			yyVAL.val = yyDollar[1].val.(*ColName)
+		}
+	case 1600:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9726
+		{
+			yyVAL.val = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1601:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9730
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1602:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9734
+		{
+			yyVAL.val = yyDollar[1].val.(*Subquery)
+		}
+	case 1603:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9738
+		{
+			yyVAL.val = &MemberOfExpr{Value: tryCastExpr(yyDollar[1].val), JSONArray: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1604:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9742
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: BitAndStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1605:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9746
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: BitOrStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1606:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9750
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: BitXorStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1607:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9754
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: PlusStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1608:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9758
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: MinusStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1609:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9762
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: MultStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1610:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9766
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: DivStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1611:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9770
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: IntDivStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1612:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9774
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: ModStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1613:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9778
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: ModStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1614:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9782
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: ShiftLeftStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1615:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9786
+		{
+			yyVAL.val = &BinaryExpr{Left: tryCastExpr(yyDollar[1].val), Operator: ShiftRightStr, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1616:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9790
+		{
+			yyVAL.val = &BinaryExpr{Left: yyDollar[1].val.(*ColName), Operator: JSONExtractOp, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1617:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9794
+		{
+			yyVAL.val = &BinaryExpr{Left: yyDollar[1].val.(*ColName), Operator: JSONUnquoteExtractOp, Right: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1618:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9798
+		{
+			yyVAL.val = &CollateExpr{Expr: tryCastExpr(yyDollar[1].val), Collation: yyDollar[3].val.(string)}
+		}
+	case 1619:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9802
+		{
+			yyVAL.val = &UnaryExpr{Operator: BinaryStr, Expr: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1620:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9806
+		{
+			yyVAL.val = &UnaryExpr{Operator: yyDollar[1].val.(string), Expr: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1621:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9810
+		{
+			if num, ok := tryCastExpr(yyDollar[2].val).(*SQLVal); ok && num.Type == IntVal {
+				yyVAL.val = num
+			} else {
+				yyVAL.val = &UnaryExpr{Operator: UPlusStr, Expr: tryCastExpr(yyDollar[2].val)}
+			}
+		}
+	case 1622:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9818
+		{
+			if num, ok := tryCastExpr(yyDollar[2].val).(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.val = num
+				} else {
+					yyVAL.val = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.val = &UnaryExpr{Operator: UMinusStr, Expr: tryCastExpr(yyDollar[2].val)}
+			}
+		}
+	case 1623:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9832
+		{
+			yyVAL.val = &UnaryExpr{Operator: TildaStr, Expr: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1624:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:9836
+		{
+			yyVAL.val = &UnaryExpr{Operator: BangStr, Expr: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1625:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9840
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyVAL.val = &IntervalExpr{Expr: tryCastExpr(yyDollar[2].val), Unit: yyDollar[3].val.(ColIdent).String()}
+		}
+	case 1626:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:9848
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent("CONCAT"), Exprs: []SelectExpr{&AliasedExpr{Expr: tryCastExpr(yyDollar[1].val)}, &AliasedExpr{Expr: tryCastExpr(yyDollar[3].val)}}}
+		}
+	case 1633:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9864
+		{
+			yyVAL.val = &FuncExpr{Name: yyDollar[1].val.(ColIdent), Distinct: yyDollar[3].val.(string) == DistinctStr, Exprs: yyDollar[4].val.(SelectExprs)}
+		}
+	case 1634:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9868
+		{
+			yyVAL.val = &FuncExpr{Qualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(ColIdent), Exprs: yyDollar[5].val.(SelectExprs)}
+		}
+	case 1635:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9878
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].val.(SelectExprs), Distinct: yyDollar[3].val.(string) == DistinctStr, Over: yyDollar[6].val.(*Over)}
+		}
+	case 1636:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9882
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].val.(SelectExprs), Distinct: yyDollar[3].val.(string) == DistinctStr, Over: yyDollar[6].val.(*Over)}
+		}
+	case 1637:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9886
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1638:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9890
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1639:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9894
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1640:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9898
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].val.(SelectExprs), Distinct: yyDollar[3].val.(string) == DistinctStr, Over: yyDollar[6].val.(*Over)}
+		}
+	case 1641:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9902
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1642:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9906
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1643:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9910
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].val.(SelectExprs), Distinct: yyDollar[3].val.(string) == DistinctStr, Over: yyDollar[6].val.(*Over)}
+		}
+	case 1644:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9914
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1645:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9918
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1646:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9922
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1647:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9926
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1648:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:9930
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].val.(SelectExprs), Distinct: yyDollar[3].val.(string) == DistinctStr, Over: yyDollar[6].val.(*Over)}
+		}
+	case 1649:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9934
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1650:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9938
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1651:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9942
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1652:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9951
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].val.(*Over)}
+		}
+	case 1653:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9955
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].val.(*Over)}
+		}
+	case 1654:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9959
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].val.(SelectExpr)}, Over: yyDollar[5].val.(*Over)}
+		}
+	case 1655:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9963
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1656:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9967
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].val.(SelectExpr)}, Over: yyDollar[5].val.(*Over)}
+		}
+	case 1657:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9971
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1658:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9975
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs), Over: yyDollar[5].val.(*Over)}
+		}
+	case 1659:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:9979
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].val.(SelectExpr)}, Over: yyDollar[5].val.(*Over)}
+		}
+	case 1660:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9983
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].val.(*Over)}
+		}
+	case 1661:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9987
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].val.(*Over)}
+		}
+	case 1662:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:9991
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].val.(*Over)}
+		}
+	case 1663:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10002
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1664:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10006
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1665:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10010
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1666:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10014
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1667:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10018
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1668:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10022
+		{
+			yyVAL.val = &ConvertExpr{Name: string(yyDollar[1].bytes), Expr: tryCastExpr(yyDollar[3].val), Type: yyDollar[5].val.(*ConvertType)}
+		}
+	case 1669:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10026
+		{
+			yyVAL.val = &ConvertExpr{Name: string(yyDollar[1].bytes), Expr: tryCastExpr(yyDollar[3].val), Type: yyDollar[5].val.(*ConvertType)}
+		}
+	case 1670:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10030
+		{
+			yyVAL.val = &CharExpr{Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1671:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10034
+		{
+			yyVAL.val = &CharExpr{Exprs: yyDollar[3].val.(SelectExprs), Type: yyDollar[5].val.(string)}
+		}
+	case 1672:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10038
+		{
+			yyVAL.val = &ConvertUsingExpr{Expr: tryCastExpr(yyDollar[3].val), Type: yyDollar[5].val.(string)}
+		}
+	case 1673:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10042
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent("LOCATE"), Exprs: []SelectExpr{&AliasedExpr{Expr: tryCastExpr(yyDollar[3].val)}, &AliasedExpr{Expr: tryCastExpr(yyDollar[5].val)}}}
+		}
+	case 1674:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10046
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1675:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:10050
+		{
+			yyVAL.val = &SubstrExpr{Name: yyDollar[3].val.(*ColName), From: tryCastExpr(yyDollar[5].val), To: tryCastExpr(yyDollar[7].val)}
+		}
+	case 1676:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:10054
+		{
+			yyVAL.val = &SubstrExpr{Name: yyDollar[3].val.(*ColName), From: tryCastExpr(yyDollar[5].val), To: tryCastExpr(yyDollar[7].val)}
+		}
+	case 1677:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:10058
+		{
+			yyVAL.val = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: tryCastExpr(yyDollar[5].val), To: tryCastExpr(yyDollar[7].val)}
+		}
+	case 1678:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:10062
+		{
+			yyVAL.val = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: tryCastExpr(yyDollar[5].val), To: tryCastExpr(yyDollar[7].val)}
+		}
+	case 1679:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10066
+		{
+			yyVAL.val = &TrimExpr{Pattern: NewStrVal([]byte(" ")), Str: tryCastExpr(yyDollar[3].val), Dir: Both}
+		}
+	case 1680:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10070
+		{
+			yyVAL.val = &TrimExpr{Pattern: tryCastExpr(yyDollar[3].val), Str: tryCastExpr(yyDollar[5].val), Dir: Both}
+		}
+	case 1681:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:10074
+		{
+			yyVAL.val = &TrimExpr{Pattern: tryCastExpr(yyDollar[4].val), Str: tryCastExpr(yyDollar[6].val), Dir: Leading}
+		}
+	case 1682:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:10078
+		{
+			yyVAL.val = &TrimExpr{Pattern: tryCastExpr(yyDollar[4].val), Str: tryCastExpr(yyDollar[6].val), Dir: Trailing}
+		}
+	case 1683:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:10082
+		{
+			yyVAL.val = &TrimExpr{Pattern: tryCastExpr(yyDollar[4].val), Str: tryCastExpr(yyDollar[6].val), Dir: Both}
+		}
+	case 1684:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:10086
+		{
+			yyVAL.val = &MatchExpr{Columns: yyDollar[3].val.(SelectExprs), Expr: tryCastExpr(yyDollar[7].val), Option: yyDollar[8].val.(string)}
+		}
+	case 1685:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10090
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1686:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:10094
+		{
+			yyVAL.val = &GroupConcatExpr{Distinct: yyDollar[3].val.(string), Exprs: yyDollar[4].val.(SelectExprs), OrderBy: yyDollar[5].val.(OrderBy), Separator: yyDollar[6].val.(Separator)}
+		}
+	case 1687:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:10098
+		{
+			yyVAL.val = &CaseExpr{Expr: tryCastExpr(yyDollar[2].val), Whens: yyDollar[3].val.([]*When), Else: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1688:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10102
+		{
+			yyVAL.val = &ValuesFuncExpr{Name: yyDollar[3].val.(*ColName)}
+		}
+	case 1689:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10106
+		{
+			yyVAL.val = &ValuesFuncExpr{Name: NewColName(string(yyDollar[3].bytes))}
+		}
+	case 1690:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10111
+		{
+			yyVAL.val = &ValuesFuncExpr{Name: NewColName(string(yyDollar[3].bytes))}
+		}
+	case 1691:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10115
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1692:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10126
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1693:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10131
+		{
+			tableName := yyDollar[4].val.(TableName)
+			yyVAL.val = &FuncExpr{
+				Name:  NewColIdent("nextval"),
+				Exprs: SelectExprs{&AliasedExpr{Expr: &ColName{Name: NewColIdent(tableName.Name.String()), Qualifier: TableName{Name: tableName.DbQualifier}}}},
+			}
+		}
+	case 1694:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10139
+		{
+			tableName := yyDollar[3].val.(TableName)
+			yyVAL.val = &FuncExpr{
+				Name:  NewColIdent("lastval"),
+				Exprs: SelectExprs{&AliasedExpr{Expr: &ColName{Name: NewColIdent(tableName.Name.String()), Qualifier: TableName{Name: tableName.DbQualifier}}}},
+			}
+		}
+	case 1695:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10147
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1696:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10151
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1697:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10156
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1698:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10160
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: tryCastExpr(yyDollar[2].val)}}}
+		}
+	case 1699:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10164
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: tryCastExpr(yyDollar[2].val)}}}
+		}
+	case 1700:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10168
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: tryCastExpr(yyDollar[2].val)}}}
+		}
+	case 1701:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:10172
+		{
+			yyVAL.val = &TimestampFuncExpr{Name: string("timestampadd"), Unit: string(yyDollar[3].bytes), Expr1: tryCastExpr(yyDollar[5].val), Expr2: tryCastExpr(yyDollar[7].val)}
+		}
+	case 1702:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:10176
+		{
+			yyVAL.val = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: string(yyDollar[3].bytes), Expr1: tryCastExpr(yyDollar[5].val), Expr2: tryCastExpr(yyDollar[7].val)}
+		}
+	case 1703:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10180
+		{
+			yyVAL.val = &ExtractFuncExpr{Name: string(yyDollar[1].bytes), Unit: string(yyDollar[3].bytes), Expr: tryCastExpr(yyDollar[5].val)}
+		}
+	case 1704:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10184
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: NewStrVal(yyDollar[3].bytes)}, &AliasedExpr{Expr: tryCastExpr(yyDollar[5].val)}}}
+		}
+	case 1705:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10192
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1706:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10196
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: NewIntVal(yyDollar[3].bytes)}}}
+		}
+	case 1707:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10200
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: tryCastExpr(yyDollar[2].val)}}}
+		}
+	case 1708:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10204
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: tryCastExpr(yyDollar[2].val)}}}
+		}
+	case 1709:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10208
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: tryCastExpr(yyDollar[2].val)}}}
+		}
+	case 1712:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10221
+		{
+			yyVAL.val = NewIntVal([]byte("0"))
+		}
+	case 1713:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10225
+		{
+			yyVAL.val = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1714:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10235
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1715:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10239
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1716:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10243
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1717:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10247
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1718:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10251
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1719:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10255
+		{
+			yyVAL.val = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].val.(SelectExprs)}
+		}
+	case 1720:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10261
+		{
+			yyVAL.val = ""
+		}
+	case 1721:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10265
+		{
+			yyVAL.val = BooleanModeStr
+		}
+	case 1722:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10269
+		{
+			yyVAL.val = NaturalLanguageModeStr
+		}
+	case 1723:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:10273
+		{
+			yyVAL.val = NaturalLanguageModeWithQueryExpansionStr
+		}
+	case 1724:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10277
+		{
+			yyVAL.val = QueryExpansionStr
+		}
+	case 1725:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10283
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1726:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10287
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1727:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10291
+		{
+			yyVAL.val = string(yyDollar[1].bytes)
+		}
+	case 1728:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10297
+		{
+			yyVAL.val = Armscii8Str
+		}
+	case 1729:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10301
+		{
+			yyVAL.val = AsciiStr
+		}
+	case 1730:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10305
+		{
+			yyVAL.val = Big5Str
+		}
+	case 1731:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10309
+		{
+			yyVAL.val = UBinaryStr
+		}
+	case 1732:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10313
+		{
+			yyVAL.val = Cp1250Str
+		}
+	case 1733:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10317
+		{
+			yyVAL.val = Cp1251Str
+		}
+	case 1734:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10321
+		{
+			yyVAL.val = Cp1256Str
+		}
+	case 1735:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10325
+		{
+			yyVAL.val = Cp1257Str
+		}
+	case 1736:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10329
+		{
+			yyVAL.val = Cp850Str
+		}
+	case 1737:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10333
+		{
+			yyVAL.val = Cp852Str
+		}
+	case 1738:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10337
+		{
+			yyVAL.val = Cp866Str
+		}
+	case 1739:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10341
+		{
+			yyVAL.val = Cp932Str
+		}
+	case 1740:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10345
+		{
+			yyVAL.val = Dec8Str
+		}
+	case 1741:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10349
+		{
+			yyVAL.val = EucjpmsStr
+		}
+	case 1742:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10353
+		{
+			yyVAL.val = EuckrStr
+		}
+	case 1743:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10357
+		{
+			yyVAL.val = Gb18030Str
+		}
+	case 1744:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10361
+		{
+			yyVAL.val = Gb2312Str
+		}
+	case 1745:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10365
+		{
+			yyVAL.val = GbkStr
+		}
+	case 1746:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10369
+		{
+			yyVAL.val = Geostd8Str
+		}
+	case 1747:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10373
+		{
+			yyVAL.val = GreekStr
+		}
+	case 1748:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10377
+		{
+			yyVAL.val = HebrewStr
+		}
+	case 1749:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10381
+		{
+			yyVAL.val = Hp8Str
+		}
+	case 1750:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10385
+		{
+			yyVAL.val = Keybcs2Str
+		}
+	case 1751:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10389
+		{
+			yyVAL.val = Koi8rStr
+		}
+	case 1752:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10393
+		{
+			yyVAL.val = Koi8uStr
+		}
+	case 1753:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10397
+		{
+			yyVAL.val = Latin1Str
+		}
+	case 1754:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10401
+		{
+			yyVAL.val = Latin2Str
+		}
+	case 1755:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10405
+		{
+			yyVAL.val = Latin5Str
+		}
+	case 1756:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10409
+		{
+			yyVAL.val = Latin7Str
+		}
+	case 1757:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10413
+		{
+			yyVAL.val = MacceStr
+		}
+	case 1758:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10417
+		{
+			yyVAL.val = MacromanStr
+		}
+	case 1759:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10421
+		{
+			yyVAL.val = SjisStr
+		}
+	case 1760:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10425
+		{
+			yyVAL.val = Swe7Str
+		}
+	case 1761:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10429
+		{
+			yyVAL.val = Tis620Str
+		}
+	case 1762:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10433
+		{
+			yyVAL.val = Ucs2Str
+		}
+	case 1763:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10437
+		{
+			yyVAL.val = UjisStr
+		}
+	case 1764:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10441
+		{
+			yyVAL.val = Utf16Str
+		}
+	case 1765:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10445
+		{
+			yyVAL.val = Utf16leStr
+		}
+	case 1766:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10449
+		{
+			yyVAL.val = Utf32Str
+		}
+	case 1767:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10453
+		{
+			yyVAL.val = Utf8mb3Str
+		}
+	case 1768:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10457
+		{
+			yyVAL.val = Utf8mb3Str
+		}
+	case 1769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10461
+		{
+			yyVAL.val = Utf8mb4Str
+		}
+	case 1770:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10467
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 1771:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10471
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal), Charset: yyDollar[3].val.(string), Operator: CharacterSetStr}
+		}
+	case 1772:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10475
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal), Charset: string(yyDollar[3].bytes)}
+		}
+	case 1773:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10479
+		{
+			yyVAL.val = &ConvertType{Type: "CHAR", Length: yyDollar[2].val.(*SQLVal), Charset: yyDollar[3].val.(string), Operator: CharacterSetStr}
+		}
+	case 1774:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10483
+		{
+			yyVAL.val = &ConvertType{Type: "CHAR", Length: yyDollar[2].val.(*SQLVal), Charset: string(yyDollar[3].bytes)}
+		}
+	case 1775:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10487
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1776:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10491
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 1777:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10495
+		{
+			ct := &ConvertType{Type: string(yyDollar[1].bytes)}
+			ct.Length = yyDollar[2].val.(LengthScaleOption).Length
+			ct.Scale = yyDollar[2].val.(LengthScaleOption).Scale
+			yyVAL.val = ct
+		}
+	case 1778:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10502
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1779:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10506
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1780:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10510
+		{
+			yyVAL.val = &ConvertType{Type: "double"}
+		}
+	case 1781:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10514
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1782:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10518
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1783:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10522
+		{
+			yyVAL.val = &ConvertType{Type: "float"}
+		}
+	case 1784:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10526
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1785:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10530
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 1786:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10534
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1787:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10538
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1788:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10542
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].val.(*SQLVal)}
+		}
+	case 1789:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10546
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1790:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10550
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1791:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10554
+		{
+			yyVAL.val = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1792:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10560
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 1793:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10564
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 1794:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10569
+		{
+			yyVAL.val = Expr(nil)
+		}
+	case 1795:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10573
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1796:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10578
+		{
+			yyVAL.val = Separator{SeparatorString: "", DefaultSeparator: true}
+		}
+	case 1797:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10582
+		{
+			yyVAL.val = Separator{SeparatorString: string(yyDollar[2].bytes), DefaultSeparator: false}
+		}
+	case 1798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10588
+		{
+			yyVAL.val = []*When{yyDollar[1].val.(*When)}
+		}
+	case 1799:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10592
+		{
+			yyVAL.val = append(yyDollar[1].val.([]*When), yyDollar[2].val.(*When))
+		}
+	case 1800:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10598
+		{
+			yyVAL.val = &When{Cond: tryCastExpr(yyDollar[2].val), Val: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1801:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10603
+		{
+			yyVAL.val = Expr(nil)
+		}
+	case 1802:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10607
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 1803:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10613
+		{
+			yyVAL.val = &ColName{Name: yyDollar[1].val.(ColIdent)}
+		}
+	case 1804:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10617
+		{
+			yyVAL.val = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1805:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10621
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: yyDollar[1].val.(TableIdent)}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1806:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10625
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: yyDollar[1].val.(TableIdent)}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1807:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10629
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: yyDollar[1].val.(TableIdent)}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1808:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10633
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: yyDollar[1].val.(TableIdent)}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1809:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10637
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: yyDollar[1].val.(TableIdent)}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1810:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10641
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: yyDollar[1].val.(TableIdent)}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1811:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10645
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: yyDollar[1].val.(TableIdent)}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1812:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10649
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1813:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10653
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1814:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10657
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1815:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10661
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1816:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10665
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1817:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10669
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1818:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10673
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].val.(ColIdent)}
+		}
+	case 1819:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10677
+		{
+			yyVAL.val = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1820:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:10681
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{DbQualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(TableIdent)}, Name: yyDollar[5].val.(ColIdent)}
+		}
+	case 1821:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:10685
+		{
+			yyVAL.val = &ColName{Qualifier: TableName{DbQualifier: yyDollar[1].val.(TableIdent), Name: yyDollar[3].val.(TableIdent)}, Name: yyDollar[5].val.(ColIdent)}
+		}
+	case 1822:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10691
+		{
+			yyVAL.val = NewStrVal(yyDollar[1].bytes)
+		}
+	case 1823:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10695
+		{
+			yyVAL.val = NewStrVal(yyDollar[2].bytes)
+		}
+	case 1824:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10699
+		{
+			yyVAL.val = NewStrVal(yyDollar[2].bytes)
+		}
+	case 1825:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10703
+		{
+			yyVAL.val = NewStrVal(yyDollar[2].bytes)
+		}
+	case 1826:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10707
+		{
+			yyVAL.val = NewHexVal(yyDollar[1].bytes)
+		}
+	case 1827:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10711
+		{
+			yyVAL.val = NewBitVal(yyDollar[1].bytes)
+		}
+	case 1828:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10715
+		{
+			yyVAL.val = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1829:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10719
+		{
+			yyVAL.val = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 1830:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10723
+		{
+			yyVAL.val = NewHexNum(yyDollar[1].bytes)
+		}
+	case 1831:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10727
+		{
+			yyVAL.val = NewValArg(yyDollar[1].bytes)
+		}
+	case 1832:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10731
+		{
+			yyVAL.val = &NullVal{}
+		}
+	case 1833:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10738
+		{
+			yyVAL.val = NewIntVal([]byte("1"))
+		}
+	case 1834:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10742
+		{
+			yyVAL.val = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1835:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10746
+		{
+			yyVAL.val = NewValArg(yyDollar[1].bytes)
+		}
+	case 1836:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10751
+		{
+			yyVAL.val = Exprs(nil)
+		}
+	case 1837:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10755
+		{
+			yyVAL.val = yyDollar[3].val.(Exprs)
+		}
+	case 1838:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10759
+		{
+			yyVAL.val = Exprs{&GroupingSetsExpr{Type: WithRollupStr, Exprs: yyDollar[3].val.(Exprs)}}
+		}
+	case 1839:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10765
+		{
+			yyVAL.val = Exprs{tryCastExpr(yyDollar[1].val)}
+		}
+	case 1840:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10769
+		{
+			yyVAL.val = append(yyDollar[1].val.(Exprs), tryCastExpr(yyDollar[3].val))
+		}
+	case 1841:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10775
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1842:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10779
+		{
+			yyVAL.val = &GroupingSetsExpr{Type: CubeStr, Exprs: yyDollar[3].val.(Exprs)}
+		}
+	case 1843:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:10783
+		{
+			yyVAL.val = &GroupingSetsExpr{Type: GroupingSetsStr, Sets: yyDollar[4].val.([]Exprs)}
+		}
+	case 1844:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10789
+		{
+			yyVAL.val = []Exprs{yyDollar[1].val.(Exprs)}
+		}
+	case 1845:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10793
+		{
+			yyVAL.val = append(yyDollar[1].val.([]Exprs), yyDollar[3].val.(Exprs))
+		}
+	case 1846:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10799
+		{
+			yyVAL.val = Exprs{}
+		}
+	case 1847:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10803
+		{
+			switch expr := tryCastExpr(yyDollar[1].val).(type) {
+			case ValTuple:
+				yyVAL.val = Exprs(expr)
+			case *ParenExpr:
+				yyVAL.val = Exprs{expr.Expr}
+			default:
+				yyVAL.val = Exprs{expr}
+			}
+		}
+	case 1848:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10815
+		{
+			yyVAL.val = Expr(nil)
+		}
+	case 1849:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10819
+		{
+			yyVAL.val = tryCastExpr(yyDollar[2].val)
+		}
+	case 1850:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10825
+		{
+			yyVAL.val = tryCastExpr(yyDollar[1].val)
+		}
+	case 1851:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10830
+		{
+			yyVAL.val = OrderBy(nil)
+		}
+	case 1852:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10834
+		{
+			yyVAL.val = yyDollar[3].val.(OrderBy)
+		}
+	case 1853:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10840
+		{
+			yyVAL.val = OrderBy{yyDollar[1].val.(*Order)}
+		}
+	case 1854:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10844
+		{
+			yyVAL.val = append(yyDollar[1].val.(OrderBy), yyDollar[3].val.(*Order))
+		}
+	case 1855:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10850
+		{
+			yyVAL.val = &Order{Expr: tryCastExpr(yyDollar[1].val), Direction: yyDollar[2].val.(string)}
+		}
+	case 1856:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10855
+		{
+			yyVAL.val = AscScr
+		}
+	case 1857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10859
+		{
+			yyVAL.val = AscScr
+		}
+	case 1858:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10863
+		{
+			yyVAL.val = DescScr
+		}
+	case 1859:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10868
+		{
+			yyVAL.val = (*Limit)(nil)
+		}
+	case 1860:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10872
+		{
+			yyVAL.val = &Limit{Rowcount: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1861:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10876
+		{
+			yyVAL.val = &Limit{Offset: tryCastExpr(yyDollar[2].val), Rowcount: tryCastExpr(yyDollar[4].val)}
+		}
+	case 1862:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10880
+		{
+			yyVAL.val = &Limit{Offset: tryCastExpr(yyDollar[4].val), Rowcount: tryCastExpr(yyDollar[2].val)}
+		}
+	case 1863:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10886
+		{
+			yyVAL.val = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1864:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10890
+		{
+			yyVAL.val = NewValArg(yyDollar[1].bytes)
+		}
+	case 1865:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:10894
+		{
+			yyVAL.val = yyDollar[1].val.(*ColName)
+		}
+	case 1866:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:10899
+		{
+			yyVAL.val = ""
+		}
+	case 1867:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10903
+		{
+			yyVAL.val = ForUpdateStr
+		}
+	case 1868:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10907
+		{
+			yyVAL.val = ForUpdateSkipLockedStr
+		}
+	case 1869:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10911
+		{
+			yyVAL.val = ForUpdateNowaitStr
+		}
+	case 1870:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10915
+		{
+			tables := yyDollar[4].val.(TableNames)
+			var tableNames []string
+			for _, t := range tables {
+				tableNames = append(tableNames, t.String())
+			}
+			lockStr := ForUpdateOfStr + " " + strings.Join(tableNames, ", ")
+			yyVAL.val = &Lock{
+				Type:   lockStr,
+				Tables: tables,
+			}
+		}
+	case 1871:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10928
+		{
+			tables := yyDollar[4].val.(TableNames)
+			var tableNames []string
+			for _, t := range tables {
+				tableNames = append(tableNames, t.String())
+			}
+			lockStr := ForUpdateOfStr + " " + strings.Join(tableNames, ", ") + " skip locked"
+			yyVAL.val = &Lock{
+				Type:   lockStr,
+				Tables: tables,
+			}
+		}
+	case 1872:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:10941
+		{
+			tables := yyDollar[4].val.(TableNames)
+			var tableNames []string
+			for _, t := range tables {
+				tableNames = append(tableNames, t.String())
+			}
+			lockStr := ForUpdateOfStr + " " + strings.Join(tableNames, ", ") + " nowait"
+			yyVAL.val = &Lock{
+				Type:   lockStr,
+				Tables: tables,
+			}
+		}
+	case 1873:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10954
+		{
+			yyVAL.val = ShareModeStr
+		}
+	case 1874:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:10958
+		{
+			yyVAL.val = ForShareStr
+		}
+	case 1875:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10962
+		{
+			yyVAL.val = ForShareSkipLockedStr
+		}
+	case 1876:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:10966
+		{
+			yyVAL.val = ForShareNowaitStr
+		}
+	case 1877:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:10970
+		{
+			tables := yyDollar[4].val.(TableNames)
+			var tableNames []string
+			for _, t := range tables {
+				tableNames = append(tableNames, t.String())
+			}
+			lockStr := ForShareOfStr + " " + strings.Join(tableNames, ", ")
+			yyVAL.val = &Lock{
+				Type:   lockStr,
+				Tables: tables,
+			}
+		}
+	case 1878:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:10983
+		{
+			tables := yyDollar[4].val.(TableNames)
+			var tableNames []string
+			for _, t := range tables {
+				tableNames = append(tableNames, t.String())
+			}
+			lockStr := ForShareOfStr + " " + strings.Join(tableNames, ", ") + " skip locked"
+			yyVAL.val = &Lock{
+				Type:   lockStr,
+				Tables: tables,
+			}
+		}
+	case 1879:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:10996
+		{
+			tables := yyDollar[4].val.(TableNames)
+			var tableNames []string
+			for _, t := range tables {
+				tableNames = append(tableNames, t.String())
+			}
+			lockStr := ForShareOfStr + " " + strings.Join(tableNames, ", ") + " nowait"
+			yyVAL.val = &Lock{
+				Type:   lockStr,
+				Tables: tables,
+			}
+		}
+	case 1880:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11011
+		{
+			yyVAL.val = yyDollar[1].val.(*Insert)
+		}
+	case 1881:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11015
+		{
+			yyVAL.val = yyDollar[1].val.(*Insert)
+			// Rows is guarenteed to be an *AliasedValues here.
+			rows := yyVAL.val.(*Insert).Rows.(*AliasedValues)
+			rows.As = yyDollar[3].val.(TableIdent)
+			if yyDollar[4].val.(Columns) != nil {
+				rows.Columns = yyDollar[4].val.(Columns)
+			}
+			yyVAL.val.(*Insert).Rows = rows
+		}
+	case 1882:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11035
+		{
+			yyVAL.val = yyDollar[1].val.(*Insert)
+		}
+	case 1883:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11039
+		{
+			yyDollar[3].val.(*Insert).Columns = []ColIdent{}
+			yyVAL.val = yyDollar[3].val.(*Insert)
+		}
+	case 1884:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11044
+		{
+			yyDollar[4].val.(*Insert).Columns = yyDollar[2].val.(Columns)
+			yyVAL.val = yyDollar[4].val.(*Insert)
+		}
+	case 1885:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11051
+		{
+			yyVAL.val = &Insert{Rows: yyDollar[1].val.(SelectStatement)}
+		}
+	case 1886:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11055
+		{
+			yyVAL.val = &Insert{Columns: yyDollar[2].val.(Columns), Rows: yyDollar[4].val.(SelectStatement)}
+		}
+	case 1887:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11059
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.val = &Insert{Rows: yyDollar[2].val.(SelectStatement)}
+		}
+	case 1888:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:11064
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.val = &Insert{Columns: yyDollar[2].val.(Columns), Rows: yyDollar[5].val.(SelectStatement)}
+		}
+	case 1889:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11071
+		{
+			yyVAL.val = &Insert{Rows: &AliasedValues{Values: yyDollar[2].val.(Values)}, Auth: AuthInformation{AuthType: AuthType_IGNORE}}
+		}
+	case 1890:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11075
+		{
+			yyVAL.val = yyDollar[2].val.(*Insert)
+		}
+	case 1893:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11084
+		{
+			yyVAL.val = Columns(nil)
+		}
+	case 1894:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11088
+		{
+			yyVAL.val = yyDollar[2].val.(Columns)
+		}
+	case 1895:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11094
+		{
+			yyVAL.val = Columns{yyDollar[1].val.(ColIdent)}
+		}
+	case 1896:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11098
+		{
+			yyVAL.val = append(yyVAL.val.(Columns), yyDollar[3].val.(ColIdent))
+		}
+	case 1897:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11104
+		{
+			yyVAL.val = yyDollar[3].val.(ColIdent)
+		}
+	case 1898:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11108
+		{
+			yyVAL.val = yyDollar[1].val.(ColIdent)
+		}
+	case 1899:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11112
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1900:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11116
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1901:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11120
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1902:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11124
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1903:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11128
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1904:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11133
+		{
+			yyVAL.val = AssignmentExprs(nil)
+		}
+	case 1905:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:11137
+		{
+			yyVAL.val = yyDollar[5].val.(AssignmentExprs)
+		}
+	case 1906:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11143
+		{
+			yyVAL.val = Values{yyDollar[1].val.(ValTuple)}
+		}
+	case 1907:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11147
+		{
+			yyVAL.val = append(yyDollar[1].val.(Values), yyDollar[3].val.(ValTuple))
+		}
+	case 1908:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11153
+		{
+			yyVAL.val = yyDollar[2].val.(ValTuple)
+		}
+	case 1909:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11157
+		{
+			yyVAL.val = ValTuple{}
+		}
+	case 1910:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11163
+		{
+			yyVAL.val = ValTuple(yyDollar[2].val.(Exprs))
+		}
+	case 1911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11169
+		{
+			if len(yyDollar[1].val.(ValTuple)) == 1 {
+				yyVAL.val = &ParenExpr{yyDollar[1].val.(ValTuple)[0]}
+			} else {
+				yyVAL.val = yyDollar[1].val.(ValTuple)
+			}
+		}
+	case 1912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11179
+		{
+			yyVAL.val = AssignmentExprs{yyDollar[1].val.(*AssignmentExpr)}
+		}
+	case 1913:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11183
+		{
+			yyVAL.val = append(yyDollar[1].val.(AssignmentExprs), yyDollar[3].val.(*AssignmentExpr))
+		}
+	case 1914:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11189
+		{
+			yyVAL.val = &AssignmentExpr{Name: yyDollar[1].val.(*ColName), Expr: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1915:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11192
+		{
+			yyVAL.val = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1916:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11196
+		{
+			yyVAL.val = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1917:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11200
+		{
+			yyVAL.val = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: tryCastExpr(yyDollar[3].val)}
+		}
+	case 1918:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11206
+		{
+			yyVAL.val = SetVarExprs{yyDollar[1].val.(*SetVarExpr)}
+		}
+	case 1919:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11210
+		{
+			yyVAL.val = append(yyDollar[1].val.(SetVarExprs), yyDollar[3].val.(*SetVarExpr))
+		}
+	case 1920:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11216
+		{
+			colName, scope, _, err := VarScopeForColName(yyDollar[1].val.(*SetVarExpr).Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyDollar[1].val.(*SetVarExpr).Name = colName
+			yyDollar[1].val.(*SetVarExpr).Scope = scope
+			yyVAL.val = yyDollar[1].val.(*SetVarExpr)
+		}
+	case 1921:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11227
+		{
+			_, scope, _, err := VarScopeForColName(yyDollar[2].val.(*SetVarExpr).Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].val.(*SetVarExpr).Name.Name.val))
+				return 1
+			}
+			yyDollar[2].val.(*SetVarExpr).Scope = yyDollar[1].val.(SetScope)
+			yyVAL.val = yyDollar[2].val.(*SetVarExpr)
+		}
+	case 1922:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11240
+		{
+			_, scope, _, err := VarScopeForColName(yyDollar[2].val.(*SetVarExpr).Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].val.(*SetVarExpr).Name.Name.val))
+				return 1
+			}
+			yyDollar[2].val.(*SetVarExpr).Scope = yyDollar[1].val.(SetScope)
+			yyVAL.val = yyDollar[2].val.(*SetVarExpr)
+		}
+	case 1923:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11253
+		{
+			yyVAL.val = &SetVarExpr{Name: NewColName(string(yyDollar[1].bytes)), Expr: tryCastExpr(yyDollar[2].val), Scope: SetScope_Session}
+		}
+	case 1924:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11259
+		{
+			yyVAL.val = SetScope_Global
+		}
+	case 1925:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11263
+		{
+			yyVAL.val = SetScope_Session
+		}
+	case 1926:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11269
+		{
+			yyVAL.val = SetScope_Session
+		}
+	case 1927:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11273
+		{
+			yyVAL.val = SetScope_Persist
+		}
+	case 1928:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11277
+		{
+			yyVAL.val = SetScope_PersistOnly
+		}
+	case 1929:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11283
+		{
+			yyVAL.val = &SetVarExpr{Name: yyDollar[1].val.(*ColName), Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 1930:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11287
+		{
+			yyVAL.val = &SetVarExpr{Name: yyDollar[1].val.(*ColName), Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 1931:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11291
+		{
+			// NOTE: This is a fix to allow MySQL dumps to load cleanly when they contain the following:
+			//       SET @@GLOBAL.GTID_PURGED= /*!80000 '+'*/ 'beabe64c-9dc6-11ed-8021-a0f9021e8e70:1-126';
+			//       The full fix is for any adjacent single-quoted or double-quoted strings to be concatenated but
+			//       this fixes the most pressing case. For more details, see: https://github.com/dolthub/dolt/issues/5232
+			// In other places we can correctly concatenate adjacent string literals, but the special comments break it
+
+			yyVAL.val = &SetVarExpr{Name: yyDollar[1].val.(*ColName), Expr: NewStrVal([]byte(string(yyDollar[3].bytes) + string(yyDollar[4].bytes))), Scope: SetScope_None}
+		}
+	case 1932:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11301
+		{
+			yyVAL.val = &SetVarExpr{Name: yyDollar[1].val.(*ColName), Expr: tryCastExpr(yyDollar[3].val), Scope: SetScope_None}
+		}
+	case 1934:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11308
+		{
+			yyVAL.bytes = []byte("charset")
+		}
+	case 1936:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11315
+		{
+			yyVAL.val = NewStrVal([]byte(yyDollar[1].val.(ColIdent).String()))
+		}
+	case 1937:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11319
+		{
+			yyVAL.val = NewStrVal(yyDollar[1].bytes)
+		}
+	case 1938:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11323
+		{
+			yyVAL.val = &Default{}
+		}
+	case 1939:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11327
+		{
+			yyVAL.val = NewStrVal(yyDollar[1].bytes)
+		}
+	case 1942:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11336
+		{
+			yyVAL.val = 0
+		}
+	case 1943:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11338
+		{
+			yyVAL.val = 1
+		}
+	case 1944:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11341
+		{
+			yyVAL.val = 0
+		}
+	case 1945:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11343
+		{
+			yyVAL.val = 1
+		}
+	case 1946:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11346
+		{
+			yyVAL.val = 0
+		}
+	case 1947:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11348
+		{
+			yyVAL.val = 1
+		}
+	case 1948:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11351
+		{
+			yyVAL.val = ""
+		}
+	case 1949:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11353
+		{
+			yyVAL.val = IgnoreStr
+		}
+	case 1950:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11356
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 1951:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11358
+		{
+			yyVAL.val = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1952:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11360
+		{
+			yyVAL.val = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1953:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11363
+		{
+			yyVAL.val = struct{}{}
+		}
+	case 1954:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11365
+		{
+			yyVAL.val = struct{}{}
+		}
+	case 1955:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11369
+		{
+			yyVAL.val = struct{}{}
+		}
+	case 1956:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11371
+		{
+			yyVAL.val = struct{}{}
+		}
+	case 1957:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11374
+		{
+			yyVAL.val = struct{}{}
+		}
+	case 1958:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11376
+		{
+			yyVAL.val = struct{}{}
+		}
+	case 1959:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11380
+		{
+			yyVAL.val = UniqueStr
+		}
+	case 1960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11382
+		{
+			yyVAL.val = FulltextStr
+		}
+	case 1961:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11384
+		{
+			yyVAL.val = SpatialStr
+		}
+	case 1962:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11386
+		{
+			yyVAL.val = VectorStr
+		}
+	case 1963:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11389
+		{
+			yyVAL.val = ""
+		}
+	case 1964:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11391
+		{
+			yyVAL.val = yyDollar[1].val.(string)
+		}
+	case 1965:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11394
+		{
+			yyVAL.val = ColIdent{}
+		}
+	case 1966:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11396
+		{
+			yyVAL.val = yyDollar[2].val.(ColIdent)
+		}
+	case 1967:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11400
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1968:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11404
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1969:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11410
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1970:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11414
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1971:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11420
+		{
+			yyVAL.val = []ColIdent{yyDollar[1].val.(ColIdent)}
+		}
+	case 1972:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11424
+		{
+			yyVAL.val = append(yyVAL.val.([]ColIdent), yyDollar[3].val.(ColIdent))
+		}
+	case 1974:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11431
+		{
+			yyVAL.val = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1975:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11437
+		{
+			yyVAL.val = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1976:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11441
+		{
+			yyVAL.val = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1978:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11448
+		{
+			yyVAL.val = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1979:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11452
+		{
+			yyVAL.val = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1980:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11456
+		{
+			yyVAL.val = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1981:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11461
+		{
+			yyVAL.val = string("")
+		}
+	case 1982:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11463
+		{
+			yyVAL.val = string(yyDollar[2].bytes)
+		}
+	case 1983:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11466
+		{
+			yyVAL.val = string("")
+		}
+	case 1984:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11468
+		{
+			yyVAL.val = IgnoreStr
+		}
+	case 1985:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11470
+		{
+			yyVAL.val = ReplaceStr
+		}
+	case 1986:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11473
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 1987:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11475
+		{
+			yyVAL.val = BoolVal(true)
+		}
+	case 1988:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11478
+		{
+			yyVAL.val = (*EnclosedBy)(nil)
+		}
+	case 1989:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11482
+		{
+			yyVAL.val = &EnclosedBy{Optionally: yyDollar[1].val.(BoolVal), Delim: NewStrVal(yyDollar[4].bytes)}
+		}
+	case 1990:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11487
+		{
+			yyVAL.val = BoolVal(false)
+		}
+	case 1991:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11491
+		{
+			yyVAL.val = BoolVal(true)
+		}
+	case 1992:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11496
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 1993:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11500
+		{
+			yyVAL.val = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1994:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11505
+		{
+			yyVAL.val = (*SQLVal)(nil)
+		}
+	case 1995:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11509
+		{
+			yyVAL.val = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1996:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11517
+		{
+			yyVAL.val = (*Fields)(nil)
+		}
+	case 1997:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11521
+		{
+			yyVAL.val = &Fields{TerminatedBy: yyDollar[2].val.(*SQLVal), EnclosedBy: yyDollar[3].val.(*EnclosedBy), EscapedBy: yyDollar[4].val.(*SQLVal)}
+		}
+	case 1998:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11526
+		{
+			yyVAL.val = (*Lines)(nil)
+		}
+	case 1999:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11530
+		{
+			yyVAL.val = yyDollar[2].val
+		}
+	case 2000:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:11535
+		{
+			yyVAL.val = &Lines{}
+		}
+	case 2001:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11539
+		{
+			if yyDollar[1].val == nil {
+				yyVAL.val = &Lines{StartingBy: NewStrVal(yyDollar[4].bytes)}
+			} else {
+				yyDollar[1].val.(*Lines).StartingBy = NewStrVal(yyDollar[4].bytes)
+				yyVAL.val = yyDollar[1].val
+			}
+		}
+	case 2002:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11548
+		{
+			if yyDollar[1].val == nil {
+				yyVAL.val = &Lines{TerminatedBy: NewStrVal(yyDollar[4].bytes)}
+			} else {
+				yyDollar[1].val.(*Lines).TerminatedBy = NewStrVal(yyDollar[4].bytes)
+				yyVAL.val = yyDollar[1].val
+			}
+		}
+	case 2003:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11559
+		{
+			yyVAL.val = &LockTables{Tables: yyDollar[3].val.(TableAndLockTypes)}
+		}
+	case 2004:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11565
+		{
+			yyVAL.val = TableAndLockTypes{yyDollar[1].val.(*TableAndLockType)}
+		}
+	case 2005:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11569
+		{
+			yyVAL.val = append(yyDollar[1].val.(TableAndLockTypes), yyDollar[3].val.(*TableAndLockType))
+		}
+	case 2006:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11575
+		{
+			tableName := yyDollar[1].val.(TableName)
+			yyVAL.val = &TableAndLockType{
+				Table: &AliasedTableExpr{
+					Expr: tableName,
+					Auth: AuthInformation{
+						AuthType:    AuthType_LOCK,
+						TargetType:  AuthTargetType_SingleTableIdentifier,
+						TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+					},
+				},
+				Lock: yyDollar[2].val.(LockType),
+			}
+		}
+	case 2007:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:11590
+		{
+			tableName := yyDollar[1].val.(TableName)
+			yyVAL.val = &TableAndLockType{
+				Table: &AliasedTableExpr{
+					Expr: tableName,
+					As:   yyDollar[3].val.(TableIdent),
+					Auth: AuthInformation{
+						AuthType:    AuthType_LOCK,
+						TargetType:  AuthTargetType_SingleTableIdentifier,
+						TargetNames: []string{tableName.DbQualifier.String(), tableName.Name.String()},
+					},
+				},
+				Lock: yyDollar[4].val.(LockType),
+			}
+		}
+	case 2008:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11608
+		{
+			yyVAL.val = LockRead
+		}
+	case 2009:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11612
+		{
+			yyVAL.val = LockReadLocal
+		}
+	case 2010:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11616
+		{
+			yyVAL.val = LockWrite
+		}
+	case 2011:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11620
+		{
+			yyVAL.val = LockLowPriorityWrite
+		}
+	case 2012:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11626
+		{
+			yyVAL.val = &UnlockTables{}
+		}
+	case 2013:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11632
+		{
+			yyVAL.val = &Handler{Action: HandlerOpenStr, Table: yyDollar[2].val.(TableName)}
+		}
+	case 2014:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:11636
+		{
+			yyVAL.val = &Handler{Action: HandlerOpenStr, Table: yyDollar[2].val.(TableName), Alias: yyDollar[5].val.(TableIdent)}
+		}
+	case 2015:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:11640
+		{
+			yyVAL.val = &Handler{Action: HandlerReadStr, Table: yyDollar[2].val.(TableName), Position: yyDollar[4].val.(string), Where: NewWhere(WhereStr, tryCastExpr(yyDollar[5].val)), Limit: yyDollar[6].val.(*Limit)}
+		}
+	case 2016:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:11644
+		{
+			yyVAL.val = &Handler{Action: HandlerReadStr, Table: yyDollar[2].val.(TableName), Index: yyDollar[4].val.(ColIdent), Position: yyDollar[5].val.(string), Where: NewWhere(WhereStr, tryCastExpr(yyDollar[6].val)), Limit: yyDollar[7].val.(*Limit)}
+		}
+	case 2017:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:11648
+		{
+			switch yyDollar[5].val.(string) {
+			case NotEqualStr, NullSafeEqualStr:
+				yylex.Error(fmt.Errorf("HANDLER can't read with %s", yyDollar[5].val.(string)).Error())
+				return 1
+			}
+			yyVAL.val = &Handler{Action: HandlerReadStr, Table: yyDollar[2].val.(TableName), Index: yyDollar[4].val.(ColIdent), Position: yyDollar[5].val.(string), Key: yyDollar[7].val.(Exprs), Where: NewWhere(WhereStr, tryCastExpr(yyDollar[9].val)), Limit: yyDollar[10].val.(*Limit)}
+		}
+	case 2018:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11657
+		{
+			yyVAL.val = &Handler{Action: HandlerCloseStr, Table: yyDollar[2].val.(TableName)}
+		}
+	case 2019:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11663
+		{
+			yyVAL.val = HandlerFirstStr
+		}
+	case 2020:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11667
+		{
+			yyVAL.val = HandlerNextStr
+		}
+	case 2021:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11674
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), LoadableFunctionStringStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = LoadableFunctionStringStr
+		}
+	case 2022:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11682
+		{
+			yyVAL.val = LoadableFunctionIntegerStr
+		}
+	case 2023:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11686
+		{
+			yyVAL.val = LoadableFunctionIntegerStr
+		}
+	case 2024:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11690
+		{
+			yyVAL.val = LoadableFunctionRealStr
+		}
+	case 2025:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11694
+		{
+			yyVAL.val = LoadableFunctionDecimalStr
+		}
+	case 2027:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11702
+		{
+			yyVAL.val = HandlerLastStr
+		}
+	case 2028:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:11706
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), HandlerPrevStr) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.val = HandlerPrevStr
+		}
+	case 2029:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11716
+		{
+			yyVAL.val = &Kill{
+				Connection: true,
+				ConnID:     NewIntVal(yyDollar[2].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SUPER,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 2030:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11727
+		{
+			yyVAL.val = &Kill{
+				ConnID: NewIntVal(yyDollar[3].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SUPER,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 2031:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:11737
+		{
+			yyVAL.val = &Kill{
+				Connection: true,
+				ConnID:     NewIntVal(yyDollar[3].bytes),
+				Auth: AuthInformation{
+					AuthType:   AuthType_SUPER,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 2032:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:11750
+		{
+			base64Str := string(yyDollar[2].bytes)
+			if base64Str == "" {
+				yylex.Error("You have an error in your SQL syntax")
+				return 1
+			}
+			yyVAL.val = &Binlog{
+				Base64Str: base64Str,
+				Auth: AuthInformation{
+					AuthType:   AuthType_BINLOG,
+					TargetType: AuthTargetType_Global,
+				},
+			}
+		}
+	case 2921:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:12695
+		{
+			if incNesting(yylex) {
+				yylex.Error("max nesting level reached")
+				return 1
+			}
+		}
+	case 2922:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:12704
+		{
+			decNesting(yylex)
+		}
+	}
+	goto yystack /* stack new state and value */
+}